@@ -0,0 +1,221 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/presence"
+	socialV1 "github.com/VoidMesh/api/api/proto/social/v1"
+	"github.com/VoidMesh/api/api/services/checkpoint"
+	"github.com/VoidMesh/api/api/services/inventory"
+	"github.com/VoidMesh/api/api/services/social"
+	"github.com/spf13/cobra"
+)
+
+func newAdminCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "admin",
+		Short: "One-off support and moderation tasks",
+	}
+	cmd.AddCommand(
+		newAdminReportsCommand(cfg),
+		newAdminResolveReportCommand(cfg),
+		newAdminCheckpointsCommand(cfg),
+		newAdminRestoreCheckpointCommand(cfg),
+		newAdminReconcileInventoryCommand(cfg),
+	)
+	return cmd
+}
+
+func newAdminReportsCommand(cfg *config) *cobra.Command {
+	var (
+		resolved bool
+		limit    int32
+	)
+	cmd := &cobra.Command{
+		Use:   "reports",
+		Short: "List player reports, oldest first",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			pool, err := cfg.openPool(ctx)
+			if err != nil {
+				return err
+			}
+			defer pool.Close()
+
+			reportStatus := socialV1.ReportStatus_REPORT_STATUS_OPEN
+			if resolved {
+				reportStatus = socialV1.ReportStatus_REPORT_STATUS_RESOLVED
+			}
+			reports, err := social.NewServiceWithPool(pool, presence.NewTracker(presence.DefaultTTL)).ListReports(ctx, reportStatus, 0, limit)
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, "ID\tREPORTED\tREPORTER\tREASON\tCREATED\tDETAILS")
+			for _, report := range reports {
+				fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\n", report.Id, report.ReportedId, report.ReporterId,
+					report.Reason, report.CreatedAt.AsTime().Format(time.RFC3339), report.Details)
+			}
+			return w.Flush()
+		},
+	}
+	cmd.Flags().BoolVar(&resolved, "resolved", false, "list resolved reports instead of open ones")
+	cmd.Flags().Int32Var(&limit, "limit", social.DefaultReportListLimit, "maximum reports to list")
+	return cmd
+}
+
+func newAdminResolveReportCommand(cfg *config) *cobra.Command {
+	var moderatorID, resolution string
+	cmd := &cobra.Command{
+		Use:   "resolve-report <report-id>",
+		Short: "Close a player report with a resolution",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			reportID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid report ID %q", args[0])
+			}
+
+			ctx := cmd.Context()
+			pool, err := cfg.openPool(ctx)
+			if err != nil {
+				return err
+			}
+			defer pool.Close()
+
+			report, err := social.NewServiceWithPool(pool, presence.NewTracker(presence.DefaultTTL)).ResolveReport(ctx, reportID, moderatorID, resolution)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Report %d resolved: %s\n", report.Id, report.Resolution)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&moderatorID, "moderator", "", "user ID recorded as resolving the report")
+	cmd.Flags().StringVar(&resolution, "resolution", "", "what was done about the report")
+	_ = cmd.MarkFlagRequired("moderator")
+	_ = cmd.MarkFlagRequired("resolution")
+	return cmd
+}
+
+func newAdminCheckpointsCommand(cfg *config) *cobra.Command {
+	var limit int32
+	cmd := &cobra.Command{
+		Use:   "checkpoints <character-id>",
+		Short: "List a character's checkpoints, newest first",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			pool, err := cfg.openPool(ctx)
+			if err != nil {
+				return err
+			}
+			defer pool.Close()
+
+			checkpoints, err := checkpoint.NewServiceWithPool(pool).ListCheckpoints(ctx, args[0], limit)
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, "ID\tCREATED\tREASON\tPOSITION\tITEMS")
+			for _, c := range checkpoints {
+				fmt.Fprintf(w, "%d\t%s\t%s\t%d,%d\t%d\n", c.Id, c.CreatedAt.AsTime().Format(time.RFC3339), c.Reason, c.X, c.Y, len(c.Inventory))
+			}
+			return w.Flush()
+		},
+	}
+	cmd.Flags().Int32Var(&limit, "limit", checkpoint.DefaultListLimit, "maximum checkpoints to list")
+	return cmd
+}
+
+func newAdminRestoreCheckpointCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "restore-checkpoint <checkpoint-id>",
+		Short: "Restore a character's position and inventory from a checkpoint",
+		Long: `Restore a character from a checkpoint. The character's current state is
+checkpointed first, so the restore can itself be undone.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			checkpointID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid checkpoint ID %q", args[0])
+			}
+
+			ctx := cmd.Context()
+			pool, err := cfg.openPool(ctx)
+			if err != nil {
+				return err
+			}
+			defer pool.Close()
+
+			restored, preRestoreID, err := checkpoint.NewServiceWithPool(pool).Restore(ctx, checkpointID)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Character %s restored to %d,%d with %d items; previous state saved as checkpoint %d\n",
+				restored.CharacterId, restored.X, restored.Y, len(restored.Inventory), preRestoreID)
+			return nil
+		},
+	}
+}
+
+func newAdminReconcileInventoryCommand(cfg *config) *cobra.Command {
+	var dryRun bool
+	cmd := &cobra.Command{
+		Use:   "reconcile-inventory",
+		Short: "Merge duplicate inventory stacks and report overfull ones",
+		Long: `Merge rows holding the same item for the same character into the oldest row,
+and list stacks holding more than their item's stack size. Overfull stacks are
+only reported: inventories hold one stack per item until item instances exist.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			pool, err := cfg.openPool(ctx)
+			if err != nil {
+				return err
+			}
+			defer pool.Close()
+
+			report, err := inventory.NewServiceWithPool(pool, nil).Reconcile(ctx, dryRun)
+			if report != nil {
+				printReconcileReport(cmd, report)
+			}
+			return err
+		},
+	}
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "only report what would be merged")
+	return cmd
+}
+
+func printReconcileReport(cmd *cobra.Command, report *inventory.ReconcileReport) {
+	out := cmd.OutOrStdout()
+	verb := "Merged"
+	if report.DryRun {
+		verb = "Would merge"
+	}
+	fmt.Fprintf(out, "%s %d duplicate stacks\n", verb, len(report.Merges))
+	if len(report.Merges) > 0 {
+		w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "CHARACTER\tITEM\tROWS\tQUANTITY")
+		for _, merge := range report.Merges {
+			fmt.Fprintf(w, "%s\t%d\t%v\t%d\n", merge.CharacterID, merge.ItemID, merge.InventoryIDs, merge.Quantity)
+		}
+		w.Flush()
+	}
+
+	fmt.Fprintf(out, "Found %d overfull stacks\n", len(report.Overfull))
+	if len(report.Overfull) > 0 {
+		w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "ROW\tCHARACTER\tITEM\tQUANTITY\tSTACK SIZE")
+		for _, stack := range report.Overfull {
+			fmt.Fprintf(w, "%d\t%s\t%d\t%d\t%d\n", stack.InventoryID, stack.CharacterID, stack.ItemID, stack.Quantity, stack.StackSize)
+		}
+		w.Flush()
+	}
+}
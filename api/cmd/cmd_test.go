@@ -0,0 +1,178 @@
+package cmd
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/db/migrations"
+	"github.com/VoidMesh/api/api/internal/seed"
+	"github.com/VoidMesh/api/api/internal/storage/sqlite"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// run executes the command line with args and returns its output
+func run(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	root := NewRootCommand()
+	var out bytes.Buffer
+	root.SetOut(&out)
+	root.SetErr(&out)
+	root.SetArgs(args)
+	err := root.Execute()
+	return out.String(), err
+}
+
+func TestRootCommand_Subcommands(t *testing.T) {
+	root := NewRootCommand()
+	for _, path := range [][]string{
+		{"serve"},
+		{"migrate"},
+		{"pregen"},
+		{"export-region"},
+		{"seed"},
+		{"admin", "reports"},
+		{"admin", "resolve-report"},
+		{"admin", "checkpoints"},
+		{"admin", "restore-checkpoint"},
+		{"admin", "reconcile-inventory"},
+	} {
+		cmd, _, err := root.Find(path)
+		require.NoError(t, err, path)
+		assert.Equal(t, path[len(path)-1], cmd.Name())
+		assert.NotNil(t, cmd.InheritedFlags().Lookup("database-url"), "%v shares the root config flags", path)
+	}
+}
+
+func TestRootCommand_HelpDoesNotLeakDatabaseURL(t *testing.T) {
+	t.Setenv("DATABASE_URL", "postgres://meower:secret@db:5432/meower")
+	out, err := run(t, "migrate", "--help")
+	require.NoError(t, err)
+	assert.NotContains(t, out, "secret")
+}
+
+func TestCommands_ValidateBeforeConnecting(t *testing.T) {
+	t.Setenv("DATABASE_URL", "")
+	t.Setenv("LOG_LEVEL", "error")
+
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"missing database", []string{"migrate"}, "database URL is required"},
+		{"serve migrate without database", []string{"serve", "--migrate"}, "database URL is required"},
+		{"two databases", []string{"migrate", "--database-url", "postgres://db", "--sqlite", "game.db"}, "none of the others can be"},
+		{"unexpected argument", []string{"serve", "extra"}, "unknown command"},
+		{"radius too large", []string{"pregen", "--radius", "65"}, "radius must be between 0 and 64"},
+		{"region too large", []string{"export-region", "--max-x", "32"}, "limited to 32 chunks per side"},
+		{"inverted region", []string{"export-region", "--min-y", "1"}, "must not be less than"},
+		{"unknown export format", []string{"export-region", "--format", "png"}, "unknown format"},
+		{"bad report ID", []string{"admin", "resolve-report", "abc", "--moderator", "x", "--resolution", "y"}, "invalid report ID"},
+		{"missing resolution", []string{"admin", "resolve-report", "1", "--moderator", "x"}, "resolution"},
+		{"bad checkpoint ID", []string{"admin", "restore-checkpoint", "latest"}, "invalid checkpoint ID"},
+		{"missing fixture file", []string{"seed", "does-not-exist.yaml"}, "does-not-exist.yaml"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := run(t, tt.args...)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+func TestCommands_LoadChunkTemplatesBeforeConnecting(t *testing.T) {
+	t.Setenv("DATABASE_URL", "")
+	t.Setenv("CHUNK_TEMPLATES_PATH", "does-not-exist.yaml")
+
+	for _, args := range [][]string{{"pregen"}, {"export-region"}, {"serve", "--seed"}} {
+		_, err := run(t, args...)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to load chunk templates", args)
+	}
+}
+
+func TestMigrations_SchemaIsEmbedded(t *testing.T) {
+	assert.True(t, strings.Contains(migrations.Schema, "CREATE TABLE\n  worlds"), "migrate checks for the worlds table")
+	assert.True(t, strings.Contains(migrations.Schema, "CREATE TABLE\n  schema_version"), "migrate records the checksum")
+	assert.Len(t, migrations.Checksum(), 64)
+}
+
+func TestMigrate_SQLite(t *testing.T) {
+	t.Setenv("LOG_LEVEL", "error")
+	path := filepath.Join(t.TempDir(), "game.db")
+
+	_, err := run(t, "migrate", "--sqlite", path, "--check")
+	require.ErrorIs(t, err, errSchemaMissing)
+
+	_, err = run(t, "migrate", "--sqlite", path)
+	require.NoError(t, err)
+	_, err = run(t, "migrate", "--sqlite", path, "--check")
+	require.NoError(t, err, "the applied schema is current")
+
+	file, err := sqlite.Open(t.Context(), path)
+	require.NoError(t, err)
+	defer file.Close()
+	q := db.New(file)
+	version, err := q.GetSchemaVersion(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, migrations.Checksum(), version.Checksum)
+
+	require.NoError(t, q.SetSchemaVersion(t.Context(), db.SetSchemaVersionParams{Checksum: "older", AppliedAt: version.AppliedAt}))
+	_, err = run(t, "migrate", "--sqlite", path)
+	require.ErrorIs(t, err, errSchemaOutdated)
+	assert.Contains(t, err.Error(), "older")
+}
+
+func TestPrepareDatabase_SeedsChunksInTheSeededWorld(t *testing.T) {
+	t.Setenv("LOG_LEVEL", "error")
+	t.Setenv("CHUNK_TEMPLATES_PATH", "")
+	path := filepath.Join(t.TempDir(), "game.db")
+	_, err := run(t, "migrate", "--sqlite", path)
+	require.NoError(t, err)
+
+	// A world created before seeding is the default world, so the seeded one is not
+	file, err := sqlite.Open(t.Context(), path)
+	require.NoError(t, err)
+	defer file.Close()
+	q := db.New(file)
+	existing, err := q.CreateWorld(t.Context(), db.CreateWorldParams{Name: "Existing World", Seed: 1})
+	require.NoError(t, err)
+
+	require.NoError(t, prepareDatabase(t.Context(), &config{sqlitePath: path}, true, true))
+
+	fixtures, err := seed.LoadFixtures(nil)
+	require.NoError(t, err)
+	var seeded db.World
+	worlds, err := q.ListWorlds(t.Context())
+	require.NoError(t, err)
+	for _, w := range worlds {
+		if w.Name == fixtures.World.Name {
+			seeded = w
+		}
+	}
+	require.True(t, seeded.ID.Valid, "the fixture world is created")
+
+	chunk, err := q.GetChunk(t.Context(), db.GetChunkParams{WorldID: seeded.ID, ChunkX: 0, ChunkY: 0})
+	require.NoError(t, err)
+	assert.Equal(t, seeded.ID, chunk.WorldID)
+	_, err = q.GetChunk(t.Context(), db.GetChunkParams{WorldID: existing.ID, ChunkX: 0, ChunkY: 0})
+	assert.ErrorIs(t, err, pgx.ErrNoRows, "nothing is generated in the default world")
+}
+
+func TestMigrations_ChecksumIgnoresComments(t *testing.T) {
+	original := migrations.Schema
+	t.Cleanup(func() { migrations.Schema = original })
+	checksum := migrations.Checksum()
+
+	migrations.Schema = "-- A new comment\n" + strings.ReplaceAll(original, "\n", "\n\n")
+	assert.Equal(t, checksum, migrations.Checksum())
+
+	migrations.Schema = original + "\nCREATE INDEX extra ON worlds (name);"
+	assert.NotEqual(t, checksum, migrations.Checksum())
+}
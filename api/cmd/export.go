@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/worldexport"
+	"github.com/VoidMesh/api/api/services/chunk"
+	"github.com/VoidMesh/api/api/services/world"
+	"github.com/spf13/cobra"
+)
+
+// maxExportSpan bounds each side of an exported region, in chunks
+const maxExportSpan = 32
+
+func newExportRegionCommand(cfg *config) *cobra.Command {
+	var (
+		minX, minY, maxX, maxY int32
+		format, output         string
+	)
+	cmd := &cobra.Command{
+		Use:   "export-region",
+		Short: "Export a rectangle of chunks from the default world as a Tiled map or GeoJSON",
+		Long: `Write the terrain and resource nodes of every chunk from (min-x, min-y) to
+(max-x, max-y) inclusive, in chunk coordinates, for level designers and external tools.
+Chunks that have not been generated yet are generated first, as when a player visits them.
+
+Formats: ` + strings.Join(worldexport.Formats, ", ") + `. Tiled maps use one ` + fmt.Sprint(worldexport.TileSize) + `px tile per cell and
+refer to a terrain.png tileset image with one tile per terrain type.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if maxX < minX || maxY < minY {
+				return fmt.Errorf("max-x and max-y must not be less than min-x and min-y")
+			}
+			if maxX-minX >= maxExportSpan || maxY-minY >= maxExportSpan {
+				return fmt.Errorf("regions are limited to %d chunks per side", maxExportSpan)
+			}
+			// Checked here as well as by Write so a typo fails before connecting
+			if !slices.Contains(worldexport.Formats, format) {
+				return fmt.Errorf("unknown format %q, want one of %s", format, strings.Join(worldexport.Formats, ", "))
+			}
+
+			if err := useChunkTemplates(); err != nil {
+				return err
+			}
+
+			logger := logging.WithComponent("export-region")
+			ctx := cmd.Context()
+			pool, err := cfg.openPool(ctx)
+			if err != nil {
+				return err
+			}
+			defer pool.Close()
+
+			worldService := world.NewServiceWithPool(pool, world.NewDefaultLoggerWrapper())
+			defaultWorld, err := worldService.GetDefaultWorld(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to get default world: %w", err)
+			}
+			chunkService := chunk.NewServiceWithPool(pool, worldService, world.NewSeeds(worldService))
+
+			chunks, err := chunkService.GetChunksInRange(ctx, minX, maxX, minY, maxY)
+			if err != nil {
+				return fmt.Errorf("failed to load chunks: %w", err)
+			}
+			region, err := worldexport.NewRegion(defaultWorld.Name, minX, minY, maxX, maxY, chunks)
+			if err != nil {
+				return err
+			}
+
+			var w io.Writer = cmd.OutOrStdout()
+			if output != "" {
+				file, err := os.Create(output)
+				if err != nil {
+					return err
+				}
+				defer file.Close()
+				w = file
+			}
+			if err := worldexport.Write(w, region, format); err != nil {
+				return fmt.Errorf("failed to write %s: %w", format, err)
+			}
+			logger.Info("Region exported", "world", defaultWorld.Name, "format", format, "chunks", len(chunks), "output", output)
+			return nil
+		},
+	}
+	cmd.Flags().Int32Var(&minX, "min-x", 0, "chunk X coordinate of the left edge")
+	cmd.Flags().Int32Var(&minY, "min-y", 0, "chunk Y coordinate of the top edge")
+	cmd.Flags().Int32Var(&maxX, "max-x", 0, "chunk X coordinate of the right edge")
+	cmd.Flags().Int32Var(&maxY, "max-y", 0, "chunk Y coordinate of the bottom edge")
+	cmd.Flags().StringVar(&format, "format", worldexport.FormatTiledJSON, "output format: "+strings.Join(worldexport.Formats, ", "))
+	cmd.Flags().StringVarP(&output, "output", "o", "", "file to write (default stdout)")
+	return cmd
+}
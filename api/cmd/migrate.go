@@ -0,0 +1,157 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/db/migrations"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/storage/sqlite"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// errSchemaMissing is returned by migrate --check when the schema has not been applied
+	errSchemaMissing = errors.New("database schema has not been applied")
+	// errSchemaOutdated is returned when the database was created from a different
+	// schema.sql than the one embedded in the binary
+	errSchemaOutdated = errors.New("database schema does not match this binary")
+)
+
+// undefinedTable is the SQLSTATE of a query on a table that does not exist
+const undefinedTable = "42P01"
+
+// schemaState is what migrate found in the database
+type schemaState int
+
+const (
+	schemaMissing     schemaState = iota // No tables yet
+	schemaUnversioned                    // Tables without a recorded checksum, e.g. from docker's initdb
+	schemaCurrent                        // Created from the embedded schema
+	schemaOutdated                       // Created from a different schema
+)
+
+func newMigrateCommand(cfg *config) *cobra.Command {
+	var check bool
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Apply the database schema to an empty database",
+		Long: `Apply db/migrations/schema.sql, which is embedded in the binary, in a single
+transaction, and record its checksum. A database that already has the schema is
+compared against the checksum instead: migrate fails if it was created from a
+different schema.sql, since there are no incremental migrations to bring it up
+to date.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			pool, err := cfg.openPool(ctx)
+			if err != nil {
+				return err
+			}
+			defer pool.Close()
+			return migrateSchema(ctx, pool, check)
+		},
+	}
+	cmd.Flags().BoolVar(&check, "check", false, "only report whether the schema is applied and current; exits non-zero if it is not")
+	return cmd
+}
+
+// migrateSchema applies the embedded schema to an empty database, or checks that an existing
+// one was created from it. With check set an empty database is an error instead.
+func migrateSchema(ctx context.Context, pool storage.Pool, check bool) error {
+	logger := logging.WithComponent("migrate")
+	state, checksum, err := schemaStatus(ctx, pool)
+	if err != nil {
+		return err
+	}
+
+	switch state {
+	case schemaCurrent:
+		logger.Info("Database schema is up to date", "checksum", checksum)
+		return nil
+	case schemaOutdated:
+		return fmt.Errorf("%w: database has checksum %s, binary has %s", errSchemaOutdated, checksum, migrations.Checksum())
+	case schemaUnversioned:
+		logger.Warn("Database schema has no recorded checksum, so it cannot be checked against this binary")
+		return nil
+	case schemaMissing:
+		if check {
+			return errSchemaMissing
+		}
+	}
+
+	if err := applySchema(ctx, pool); err != nil {
+		return err
+	}
+	logger.Info("Database schema applied", "checksum", migrations.Checksum())
+	return nil
+}
+
+// schemaStatus compares the checksum recorded when the schema was applied with the
+// embedded schema's, and returns the recorded one
+func schemaStatus(ctx context.Context, pool storage.Pool) (schemaState, string, error) {
+	q := db.New(pool)
+	version, err := q.GetSchemaVersion(ctx)
+	switch {
+	case err == nil:
+		if version.Checksum == migrations.Checksum() {
+			return schemaCurrent, version.Checksum, nil
+		}
+		return schemaOutdated, version.Checksum, nil
+	case errors.Is(err, pgx.ErrNoRows):
+		return schemaUnversioned, "", nil
+	case !isUndefinedTable(err):
+		return 0, "", fmt.Errorf("failed to check schema: %w", err)
+	}
+
+	// Databases created before the checksum was recorded have no schema_version table
+	if _, err := q.GetDefaultWorld(ctx); err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		if isUndefinedTable(err) {
+			return schemaMissing, "", nil
+		}
+		return 0, "", fmt.Errorf("failed to check schema: %w", err)
+	}
+	return schemaUnversioned, "", nil
+}
+
+func isUndefinedTable(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == undefinedTable
+}
+
+// applySchema runs the embedded schema and records its checksum in one transaction, so
+// a failure leaves the database empty
+func applySchema(ctx context.Context, pool storage.Pool) error {
+	schema := migrations.Schema
+	if _, ok := pool.(*sqlite.DB); ok {
+		schema = sqlite.TranslateSchema(schema)
+	}
+
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, schema); err != nil {
+		return fmt.Errorf("failed to apply schema: %w", err)
+	}
+	err = db.New(tx).SetSchemaVersion(ctx, db.SetSchemaVersionParams{
+		Checksum:  migrations.Checksum(),
+		AppliedAt: pgtype.Timestamp{Time: time.Now(), Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record schema checksum: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit schema: %w", err)
+	}
+	return nil
+}
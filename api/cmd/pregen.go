@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/services/chunk"
+	"github.com/VoidMesh/api/api/services/world"
+	"github.com/spf13/cobra"
+)
+
+// maxPregenRadius keeps a typo from generating hundreds of thousands of chunks
+const maxPregenRadius = 64
+
+func newPregenCommand(cfg *config) *cobra.Command {
+	var centerX, centerY, radius int32
+	cmd := &cobra.Command{
+		Use:   "pregen",
+		Short: "Pre-generate chunks and resource nodes in the default world",
+		Long: `Generate every chunk within a radius of a centre chunk so players entering the
+area do not wait on generation. Chunks that already exist are left as they are.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if radius < 0 || radius > maxPregenRadius {
+				return fmt.Errorf("radius must be between 0 and %d", maxPregenRadius)
+			}
+
+			if err := useChunkTemplates(); err != nil {
+				return err
+			}
+
+			logger := logging.WithComponent("pregen")
+			ctx := cmd.Context()
+			pool, err := cfg.openPool(ctx)
+			if err != nil {
+				return err
+			}
+			defer pool.Close()
+
+			worldService := world.NewServiceWithPool(pool, world.NewDefaultLoggerWrapper())
+			defaultWorld, err := worldService.GetDefaultWorld(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to get default world: %w", err)
+			}
+			chunkService := chunk.NewServiceWithPool(pool, worldService, world.NewSeeds(worldService))
+
+			start := time.Now()
+			chunks, err := chunkService.GetChunksInRadius(ctx, centerX, centerY, radius)
+			if err != nil {
+				return fmt.Errorf("failed to generate chunks: %w", err)
+			}
+			logger.Info("Chunks ready", "world", defaultWorld.Name, "center_x", centerX, "center_y", centerY,
+				"radius", radius, "count", len(chunks), "duration", time.Since(start))
+			return nil
+		},
+	}
+	cmd.Flags().Int32Var(&centerX, "center-x", 0, "chunk X coordinate of the centre")
+	cmd.Flags().Int32Var(&centerY, "center-y", 0, "chunk Y coordinate of the centre")
+	cmd.Flags().Int32Var(&radius, "radius", 4, "radius in chunks around the centre")
+	return cmd
+}
@@ -0,0 +1,109 @@
+// Package cmd implements the API binary's command line. Every subcommand shares the
+// root's config flags and database wiring, so operations tooling runs from the same
+// binary as the server with the same settings.
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/VoidMesh/api/api/internal/chunktemplate"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/storage/sqlite"
+	"github.com/VoidMesh/api/api/services/chunk"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/spf13/cobra"
+)
+
+// config holds the settings shared by every subcommand. Flags default to the
+// environment variables the server has always read.
+type config struct {
+	databaseURL string
+	sqlitePath  string
+	logLevel    string
+}
+
+// openPool connects to the configured database: the SQLite file when --sqlite is set,
+// Postgres otherwise
+func (c *config) openPool(ctx context.Context) (storage.Pool, error) {
+	if c.sqlitePath != "" {
+		return sqlite.Open(ctx, c.sqlitePath)
+	}
+	if c.databaseURL == "" {
+		return nil, fmt.Errorf("a database URL is required: set DATABASE_URL or pass --database-url")
+	}
+	pool, err := pgxpool.New(ctx, c.databaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+	if err := pool.Ping(ctx); err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+	return pool, nil
+}
+
+// useChunkTemplates registers the authored chunks in $CHUNK_TEMPLATES_PATH, as the
+// server does, so commands that generate chunks produce the same terrain
+func useChunkTemplates() error {
+	path := os.Getenv("CHUNK_TEMPLATES_PATH")
+	if path == "" {
+		return nil
+	}
+	templates, err := chunktemplate.LoadManifest(path)
+	if err != nil {
+		return fmt.Errorf("failed to load chunk templates: %w", err)
+	}
+	chunk.SetTemplates(templates)
+	return nil
+}
+
+// NewRootCommand builds the command tree
+func NewRootCommand() *cobra.Command {
+	cfg := &config{}
+	root := &cobra.Command{
+		Use:           "api-server",
+		Short:         "VoidMesh API server and operations tooling",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			// Environment fallbacks are applied here rather than as flag defaults so
+			// help output never prints the database password
+			if cfg.databaseURL == "" && cfg.sqlitePath == "" {
+				cfg.databaseURL = os.Getenv("DATABASE_URL")
+			}
+			logging.InitLogger()
+			if cfg.logLevel != "" {
+				logging.SetLevel(cfg.logLevel)
+			}
+		},
+	}
+
+	flags := root.PersistentFlags()
+	flags.StringVar(&cfg.databaseURL, "database-url", "", "PostgreSQL connection URL (default $DATABASE_URL)")
+	flags.StringVar(&cfg.sqlitePath, "sqlite", "", "use this SQLite database file instead of PostgreSQL; it is created if missing")
+	flags.StringVar(&cfg.logLevel, "log-level", "", "debug, info, warn or error; overrides $LOG_LEVEL")
+
+	root.MarkFlagsMutuallyExclusive("database-url", "sqlite")
+
+	root.AddCommand(
+		newServeCommand(cfg),
+		newMigrateCommand(cfg),
+		newPregenCommand(cfg),
+		newExportRegionCommand(cfg),
+		newSeedCommand(cfg),
+		newAdminCommand(cfg),
+	)
+	return root
+}
+
+// Execute runs the command line and returns the process exit code
+func Execute() int {
+	if err := NewRootCommand().Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/seed"
+	"github.com/spf13/cobra"
+)
+
+func newSeedCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "seed [fixture.yaml ...]",
+		Short: "Seed a database with a world, users, characters and chunks from fixtures",
+		Long: `Load declarative fixtures into the database. Without arguments the embedded
+internal/seed/fixtures/dev.yaml is used; later files add users on top of earlier ones
+and override world and chunk settings. Records that already exist are skipped.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			logger := logging.WithComponent("seed")
+			fixtures, err := seed.LoadFixtures(args)
+			if err != nil {
+				return err
+			}
+			logger.Info("Fixtures loaded", "world", fixtures.World.Name, "users", len(fixtures.Users), "chunk_radius", fixtures.Chunks.Radius)
+
+			if err := useChunkTemplates(); err != nil {
+				return err
+			}
+			ctx := cmd.Context()
+			pool, err := cfg.openPool(ctx)
+			if err != nil {
+				return err
+			}
+			defer pool.Close()
+
+			start := time.Now()
+			if err := seed.NewSeeder(pool, logger).Seed(ctx, fixtures); err != nil {
+				return err
+			}
+			logger.Info("Database seeded", "duration", time.Since(start))
+			return nil
+		},
+	}
+}
@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/seed"
+	"github.com/VoidMesh/api/api/server"
+	"github.com/spf13/cobra"
+)
+
+func newServeCommand(cfg *config) *cobra.Command {
+	var (
+		address         string
+		shutdownTimeout = server.DefaultShutdownTimeout
+		migrate         bool
+		seedDev         bool
+	)
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Run the gRPC API server",
+		Long: `Run the gRPC API server until it receives SIGINT or SIGTERM. On shutdown it stops
+accepting connections, waits for in-flight requests and background jobs, then
+closes the database pool.
+
+The schema, balance config, sprite atlas and dev fixtures are embedded, so the
+binary needs nothing but a database. For a local world on an empty database:
+
+  api-server serve --database-url postgres://... --migrate --seed
+
+or, without Postgres, on a SQLite file that is created and migrated on start:
+
+  api-server serve --sqlite game.db --seed`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			logger := logging.GetLogger()
+			logger.Info("VoidMesh API server starting up")
+			logger.Debug("Debug logging enabled for maximum visibility")
+
+			// A SQLite file starts empty, so it is always migrated
+			migrate = migrate || cfg.sqlitePath != ""
+			if migrate || seedDev {
+				if err := prepareDatabase(cmd.Context(), cfg, migrate, seedDev); err != nil {
+					return err
+				}
+			}
+
+			config := server.ConfigFromEnv()
+			config.Address = address
+			config.DatabaseURL = cfg.databaseURL
+			config.SQLitePath = cfg.sqlitePath
+			config.ShutdownTimeout = shutdownTimeout
+			return server.Serve(config)
+		},
+	}
+	cmd.Flags().StringVar(&address, "address", ":50051", "address to listen on")
+	cmd.Flags().DurationVar(&shutdownTimeout, "shutdown-timeout", shutdownTimeout, "how long to wait for requests and jobs to finish on shutdown")
+	cmd.Flags().BoolVar(&migrate, "migrate", false, "apply the embedded schema first if the database is empty, and check it is current otherwise")
+	cmd.Flags().BoolVar(&seedDev, "seed", false, "load the embedded dev fixtures first; records that already exist are skipped")
+	return cmd
+}
+
+// prepareDatabase applies the schema and seeds the dev fixtures before the server
+// starts, so a quick-start needs no separate migrate and seed runs
+func prepareDatabase(ctx context.Context, cfg *config, migrate, seedDev bool) error {
+	// Parsing the fixtures and templates first fails fast without touching the database
+	var fixtures *seed.Fixtures
+	if seedDev {
+		var err error
+		if fixtures, err = seed.LoadFixtures(nil); err != nil {
+			return err
+		}
+		if err := useChunkTemplates(); err != nil {
+			return err
+		}
+	}
+
+	pool, err := cfg.openPool(ctx)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+
+	if migrate {
+		if err := migrateSchema(ctx, pool, false); err != nil {
+			return err
+		}
+	}
+
+	if fixtures != nil {
+		logger := logging.WithComponent("seed")
+		if err := seed.NewSeeder(pool, logger).Seed(ctx, fixtures); err != nil {
+			return err
+		}
+		logger.Info("Database seeded", "world", fixtures.World.Name, "users", len(fixtures.Users))
+	}
+	return nil
+}
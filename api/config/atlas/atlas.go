@@ -0,0 +1,10 @@
+// Package atlas embeds the default sprite atlas so clients can look up every sprite the
+// server names even when no atlas directory is supplied.
+package atlas
+
+import _ "embed"
+
+// Sprites is the default sprite atlas. It describes the sheets without their images.
+//
+//go:embed sprites.yaml
+var Sprites []byte
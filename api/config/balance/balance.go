@@ -0,0 +1,10 @@
+// Package balance embeds the default game balance data files so the server
+// always has a valid configuration even when no override path is supplied.
+package balance
+
+import _ "embed"
+
+// ResourceNodes is the default resource node balance configuration.
+//
+//go:embed resource_nodes.yaml
+var ResourceNodes []byte
@@ -0,0 +1,51 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: copyfrom.go
+
+package db
+
+import (
+	"context"
+)
+
+// iteratorForCopyResourceNodes implements pgx.CopyFromSource.
+type iteratorForCopyResourceNodes struct {
+	rows                 []CopyResourceNodesParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCopyResourceNodes) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCopyResourceNodes) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ResourceNodeTypeID,
+		r.rows[0].WorldID,
+		r.rows[0].ChunkX,
+		r.rows[0].ChunkY,
+		r.rows[0].ClusterID,
+		r.rows[0].X,
+		r.rows[0].Y,
+		r.rows[0].Size,
+		r.rows[0].Quality,
+	}, nil
+}
+
+func (r iteratorForCopyResourceNodes) Err() error {
+	return nil
+}
+
+// Bulk insert for chunk generation; one COPY instead of a statement per node
+func (q *Queries) CopyResourceNodes(ctx context.Context, arg []CopyResourceNodesParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"resource_nodes"}, []string{"resource_node_type_id", "world_id", "chunk_x", "chunk_y", "cluster_id", "x", "y", "size", "quality"}, &iteratorForCopyResourceNodes{rows: arg})
+}
@@ -314,7 +314,7 @@ func TestDatabaseErrorHandling(t *testing.T) {
 
 		// Test foreign key constraint violation
 		mockPool.ExpectQuery("INSERT INTO characters").
-			WithArgs(mustParseUUID("999e8400-e29b-41d4-a716-446655440000"), "OrphanCharacter", int32(0), int32(0), int32(0), int32(0)).
+			WithArgs(mustParseUUID("999e8400-e29b-41d4-a716-446655440000"), "OrphanCharacter", int32(0), int32(0), int32(0), int32(0), pgtype.UUID{}).
 			WillReturnError(sql.ErrConnDone) // Simulating foreign key constraint violation
 
 		_, err = queries.CreateCharacter(createTestContext(), CreateCharacterParams{
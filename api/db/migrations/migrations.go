@@ -0,0 +1,26 @@
+// Package migrations embeds the database schema so the API binary can apply it
+// with the migrate command.
+package migrations
+
+import (
+	"crypto/sha256"
+	_ "embed"
+	"encoding/hex"
+	"regexp"
+	"strings"
+)
+
+// Schema creates every table and index and inserts the default world
+//
+//go:embed schema.sql
+var Schema string
+
+var commentRe = regexp.MustCompile(`--[^\n]*`)
+
+// Checksum identifies the schema's statements. Comments and whitespace are left out, so
+// editing a comment doesn't make existing databases look out of date.
+func Checksum() string {
+	statements := strings.Join(strings.Fields(commentRe.ReplaceAllString(Schema, "")), " ")
+	sum := sha256.Sum256([]byte(statements))
+	return hex.EncodeToString(sum[:])
+}
@@ -15,25 +15,30 @@ CREATE TABLE
   );
 
 -- Game world tables
+CREATE TABLE
+  worlds (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name text NOT NULL,
+    seed bigint NOT NULL,
+    created_at timestamp NOT NULL DEFAULT NOW()
+  );
+
+-- Characters belong to exactly one world; all gameplay state hangs off the character
 CREATE TABLE
   characters (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid (),
     user_id UUID REFERENCES users (id) ON DELETE CASCADE,
+    world_id UUID NOT NULL REFERENCES worlds (id) ON DELETE CASCADE,
     name text NOT NULL,
     x integer NOT NULL DEFAULT 0,
     y integer NOT NULL DEFAULT 0,
     chunk_x integer NOT NULL DEFAULT 0,
     chunk_y integer NOT NULL DEFAULT 0,
     created_at timestamp NOT NULL DEFAULT NOW (),
-    UNIQUE (user_id, name)
-  );
-
-CREATE TABLE
-  worlds (
-    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
-    name text NOT NULL,
-    seed bigint NOT NULL,
-    created_at timestamp NOT NULL DEFAULT NOW()
+    facing integer NOT NULL DEFAULT 3, -- character.v1.Facing, south until the character first turns
+    action_state integer NOT NULL DEFAULT 1, -- character.v1.ActionState, kept so reconnecting clients see the last state
+    equipped_title text NOT NULL DEFAULT '', -- Cosmetic ID of the title shown with the name; empty for none
+    UNIQUE (world_id, user_id, name)
   );
 
 CREATE TABLE
@@ -43,6 +48,11 @@ CREATE TABLE
     chunk_y integer NOT NULL,
     chunk_data bytea NOT NULL,
     generated_at timestamp NOT NULL DEFAULT NOW(),
+    quarantined_at timestamp, -- Set when chunk_data failed to decode and was regenerated from the seed
+    corrupt_chunk_data bytea, -- The undecodable blob, kept for investigation
+    passability bytea, -- One bit per cell, set when walkable; NULL until computed for chunks stored before it existed
+    last_accessed_at timestamp, -- NULL until the first flushed read; generated_at stands in
+    access_count bigint NOT NULL DEFAULT 0, -- Reads, flushed in batches by the chunk service
     PRIMARY KEY (world_id, chunk_x, chunk_y)
   );
 
@@ -58,6 +68,7 @@ CREATE TABLE
     x integer NOT NULL, -- Global X coordinate
     y integer NOT NULL, -- Global Y coordinate
     size integer NOT NULL DEFAULT 1,
+    quality integer NOT NULL DEFAULT 2, -- resource_node.v1.ResourceNodeQuality, normal for nodes stored before quality tiers
     created_at timestamp NOT NULL DEFAULT NOW(),
     FOREIGN KEY (world_id, chunk_x, chunk_y) REFERENCES chunks (world_id, chunk_x, chunk_y) ON DELETE CASCADE,
     UNIQUE (world_id, x, y)
@@ -77,6 +88,7 @@ CREATE TABLE
   );
 
 -- Resource node drop system
+-- Drop tables are static game data shared by every world
 CREATE TABLE
   resource_node_drops (
     id SERIAL PRIMARY KEY,
@@ -90,6 +102,7 @@ CREATE TABLE
   );
 
 -- Character inventory system
+-- Inventories are world-scoped through their character
 CREATE TABLE
   character_inventories (
     id SERIAL PRIMARY KEY,
@@ -101,11 +114,854 @@ CREATE TABLE
     UNIQUE (character_id, item_id)
   );
 
+-- Short-lived harvest locks so two characters can't harvest the same node at
+-- once. Expired rows are taken over by the next harvester.
+CREATE TABLE
+  resource_node_reservations (
+    resource_node_id integer PRIMARY KEY REFERENCES resource_nodes(id) ON DELETE CASCADE,
+    character_id UUID NOT NULL REFERENCES characters(id) ON DELETE CASCADE,
+    reserved_at timestamp NOT NULL DEFAULT NOW(),
+    expires_at timestamp NOT NULL
+  );
+
+-- Transactional outbox: events are written in the same transaction as the
+-- mutation that caused them and published later by the outbox dispatcher
+CREATE TABLE
+  outbox_events (
+    id BIGSERIAL PRIMARY KEY,
+    event_type text NOT NULL,
+    aggregate_id text NOT NULL,
+    payload jsonb NOT NULL DEFAULT '{}',
+    dedup_key text NOT NULL UNIQUE, -- consumers use this to drop redeliveries
+    created_at timestamp NOT NULL DEFAULT NOW(),
+    locked_until timestamp, -- dispatcher lease; expired leases are retried
+    attempts integer NOT NULL DEFAULT 0,
+    last_error text,
+    published_at timestamp
+  );
+
+-- Read model maintained by the chunk summary projection so map overlays don't
+-- have to unmarshal chunk blobs. Histograms are keyed by proto enum value.
+CREATE TABLE
+  chunk_summaries (
+    world_id UUID NOT NULL,
+    chunk_x integer NOT NULL,
+    chunk_y integer NOT NULL,
+    terrain_histogram jsonb NOT NULL DEFAULT '{}', -- terrain type -> cell count
+    node_counts jsonb NOT NULL DEFAULT '{}', -- resource node type -> node count
+    harvest_count integer NOT NULL DEFAULT 0,
+    last_modified timestamp NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (world_id, chunk_x, chunk_y),
+    FOREIGN KEY (world_id, chunk_x, chunk_y) REFERENCES chunks (world_id, chunk_x, chunk_y) ON DELETE CASCADE
+  );
+
+-- Per-cell terrain edits (digging, tilling, filling) applied on top of the
+-- generated chunk blob when the chunk is loaded, in id order
+CREATE TABLE
+  chunk_deltas (
+    id BIGSERIAL PRIMARY KEY,
+    world_id UUID NOT NULL,
+    chunk_x integer NOT NULL,
+    chunk_y integer NOT NULL,
+    cell_index integer NOT NULL, -- row-major index into the chunk's cells
+    terrain_type integer NOT NULL, -- chunk.v1.TerrainType after the edit
+    previous_terrain_type integer NOT NULL,
+    character_id UUID REFERENCES characters(id) ON DELETE SET NULL, -- NULL for system edits
+    created_at timestamp NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (world_id, chunk_x, chunk_y) REFERENCES chunks (world_id, chunk_x, chunk_y) ON DELETE CASCADE
+  );
+
+-- Periodic snapshots of character state so support can restore a character after
+-- lost items or a bugged position. inventory is a JSON array of {item_id, quantity}
+-- sorted by item_id; inventory_hash lets unchanged states be skipped.
+CREATE TABLE
+  character_checkpoints (
+    id BIGSERIAL PRIMARY KEY,
+    character_id UUID NOT NULL REFERENCES characters(id) ON DELETE CASCADE,
+    x integer NOT NULL,
+    y integer NOT NULL,
+    chunk_x integer NOT NULL,
+    chunk_y integer NOT NULL,
+    inventory jsonb NOT NULL,
+    inventory_hash text NOT NULL,
+    reason text NOT NULL, -- periodic, pre_restore
+    created_at timestamp NOT NULL DEFAULT NOW()
+  );
+
+-- Friend requests and friendships. The requester inserts a pending row which the
+-- addressee accepts; declined requests and removed friends are deleted.
+CREATE TABLE
+  friendships (
+    requester_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    addressee_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status text NOT NULL DEFAULT 'pending', -- pending, accepted
+    created_at timestamp NOT NULL DEFAULT NOW(),
+    responded_at timestamp,
+    PRIMARY KEY (requester_id, addressee_id),
+    CHECK (requester_id <> addressee_id)
+  );
+
+-- Direct messages between friends. delivered_at stays NULL until the recipient
+-- has been sent the message, so messages to offline users are delivered on connect.
+CREATE TABLE
+  direct_messages (
+    id BIGSERIAL PRIMARY KEY,
+    sender_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    recipient_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    body text NOT NULL,
+    created_at timestamp NOT NULL DEFAULT NOW(),
+    delivered_at timestamp
+  );
+
+-- Users a player has blocked. Blocking works in both directions: neither user
+-- can send the other friend requests or direct messages.
+CREATE TABLE
+  user_blocks (
+    blocker_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    blocked_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at timestamp NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (blocker_id, blocked_id),
+    CHECK (blocker_id <> blocked_id)
+  );
+
+-- Player reports for moderator review. context is a JSON snapshot taken when the
+-- report is filed (recent messages between the users), so later deletes do not
+-- remove the evidence.
+CREATE TABLE
+  player_reports (
+    id BIGSERIAL PRIMARY KEY,
+    reporter_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reported_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reason text NOT NULL, -- harassment, cheating, spam, offensive_name, other
+    details text NOT NULL DEFAULT '',
+    context jsonb NOT NULL,
+    status text NOT NULL DEFAULT 'open', -- open, resolved
+    resolution text NOT NULL DEFAULT '',
+    resolved_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at timestamp NOT NULL DEFAULT NOW(),
+    resolved_at timestamp
+  );
+
+-- Per-user notification inbox. dedup_key makes emission from at-least-once
+-- events idempotent.
+CREATE TABLE
+  notifications (
+    id BIGSERIAL PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    type text NOT NULL, -- friend_request, friend_accepted, trade_completed, quest_completed
+    title text NOT NULL,
+    body text NOT NULL DEFAULT '',
+    data jsonb NOT NULL DEFAULT '{}',
+    dedup_key text NOT NULL UNIQUE,
+    created_at timestamp NOT NULL DEFAULT NOW(),
+    read_at timestamp
+  );
+
+-- Debug recordings of every mutating event in a rectangle of chunks for a time
+-- window, replayed step by step by the debug tool. Events are stored in the order
+-- they were recorded; their payload is the event as published.
+CREATE TABLE
+  region_recordings (
+    id BIGSERIAL PRIMARY KEY,
+    world_id UUID NOT NULL REFERENCES worlds(id) ON DELETE CASCADE,
+    min_chunk_x integer NOT NULL,
+    min_chunk_y integer NOT NULL,
+    max_chunk_x integer NOT NULL,
+    max_chunk_y integer NOT NULL,
+    started_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    started_at timestamp NOT NULL DEFAULT NOW(),
+    ends_at timestamp NOT NULL,
+    stopped_at timestamp,
+    CHECK (min_chunk_x <= max_chunk_x AND min_chunk_y <= max_chunk_y)
+  );
+
+CREATE TABLE
+  region_recording_events (
+    id BIGSERIAL PRIMARY KEY,
+    recording_id BIGINT NOT NULL REFERENCES region_recordings(id) ON DELETE CASCADE,
+    event_type text NOT NULL, -- character.moved, character.created, chunk.generated, resource.harvested, terrain.modified
+    character_id UUID,
+    x integer NOT NULL, -- cell for positional events, the chunk origin otherwise
+    y integer NOT NULL,
+    chunk_x integer NOT NULL,
+    chunk_y integer NOT NULL,
+    payload jsonb NOT NULL,
+    occurred_at timestamp NOT NULL
+  );
+
+-- Shard registry for multi-instance deployments. Instances heartbeat into
+-- shard_instances; a world is served by the instance recorded in world_shards
+-- and is taken over by another instance once its owner stops heartbeating.
+CREATE TABLE
+  shard_instances (
+    instance_id text PRIMARY KEY,
+    address text NOT NULL, -- host:port clients reconnect to
+    heartbeat_at timestamp NOT NULL DEFAULT NOW()
+  );
+
+CREATE TABLE
+  world_shards (
+    world_id UUID PRIMARY KEY REFERENCES worlds(id) ON DELETE CASCADE,
+    instance_id text NOT NULL REFERENCES shard_instances(instance_id),
+    assigned_at timestamp NOT NULL DEFAULT NOW()
+  );
+
+-- Credentials for server-to-server integrations (analytics pipelines, companion
+-- services). key_id is the public part of the key used to look it up; only a
+-- SHA-256 hash of the secret part is stored.
+CREATE TABLE
+  api_keys (
+    id BIGSERIAL PRIMARY KEY,
+    key_id text NOT NULL UNIQUE,
+    secret_hash bytea NOT NULL,
+    name text NOT NULL,
+    scopes text[] NOT NULL, -- world:read, chunk:read, resource:read, terrain:read
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at timestamp NOT NULL DEFAULT NOW(),
+    expires_at timestamp, -- NULL never expires
+    revoked_at timestamp,
+    last_used_at timestamp
+  );
+
+CREATE TABLE
+  protected_regions (
+    id BIGSERIAL PRIMARY KEY,
+    world_id UUID NOT NULL REFERENCES worlds(id) ON DELETE CASCADE,
+    name text NOT NULL,
+    flags text[] NOT NULL, -- no_harvest, no_build, no_pvp, safe_zone
+    polygon integer[] NOT NULL, -- vertices as x0, y0, x1, y1, ... in world cell corners
+    min_x integer NOT NULL, -- bounding box of the vertices, for lookups
+    min_y integer NOT NULL,
+    max_x integer NOT NULL,
+    max_y integer NOT NULL,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at timestamp NOT NULL DEFAULT NOW(),
+    updated_at timestamp NOT NULL DEFAULT NOW(),
+    CHECK (min_x < max_x AND min_y < max_y)
+  );
+
+-- A character's claim on a chunk; paid_until is pushed back by each upkeep payment and
+-- unpaid claims are released
+CREATE TABLE
+  land_claims (
+    id BIGSERIAL PRIMARY KEY,
+    world_id UUID NOT NULL REFERENCES worlds(id) ON DELETE CASCADE,
+    chunk_x integer NOT NULL,
+    chunk_y integer NOT NULL,
+    character_id UUID NOT NULL REFERENCES characters(id) ON DELETE CASCADE,
+    claimed_at timestamp NOT NULL,
+    paid_until timestamp NOT NULL,
+    UNIQUE (world_id, chunk_x, chunk_y)
+  );
+
+-- Completed tutorial steps; a character's current step is the first one without a row
+CREATE TABLE
+  tutorial_steps (
+    character_id UUID NOT NULL REFERENCES characters(id) ON DELETE CASCADE,
+    step text NOT NULL,
+    completed_at timestamp NOT NULL,
+    PRIMARY KEY (character_id, step)
+  );
+
+-- Feature flags gate new subsystems. A flag is on for a user when it is enabled, the
+-- user's world is allowed and the user is listed or falls within the rollout percentage
+CREATE TABLE
+  feature_flags (
+    name text PRIMARY KEY,
+    description text NOT NULL DEFAULT '',
+    enabled boolean NOT NULL,
+    rollout_percentage integer NOT NULL CHECK (rollout_percentage BETWEEN 0 AND 100),
+    user_ids UUID[] NOT NULL DEFAULT '{}', -- Always on for these users
+    world_ids UUID[] NOT NULL DEFAULT '{}', -- Empty allows every world
+    updated_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    updated_at timestamp NOT NULL
+  );
+
+-- Experiments split new worlds or characters between parameter variants so balance
+-- changes can be measured; assignments are permanent and exported to analytics
+CREATE TABLE
+  experiments (
+    name text PRIMARY KEY,
+    description text NOT NULL DEFAULT '',
+    subject text NOT NULL, -- world or character
+    variants jsonb NOT NULL, -- [{"name": "control", "weight": 50, "params": {"resource_density": 1}}]
+    started_at timestamp NOT NULL, -- Only subjects created from this time on are assigned
+    stopped_at timestamp, -- Stopped experiments assign no one new
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL
+  );
+
+CREATE TABLE
+  experiment_assignments (
+    experiment text NOT NULL REFERENCES experiments(name) ON DELETE CASCADE,
+    subject_id UUID NOT NULL, -- World or character ID
+    variant text NOT NULL,
+    assigned_at timestamp NOT NULL,
+    PRIMARY KEY (experiment, subject_id)
+  );
+
+-- The checksum of the schema.sql a database was created from, recorded by the migrate
+-- command so it can tell whether the database matches the binary
+CREATE TABLE
+  schema_version (
+    id boolean PRIMARY KEY DEFAULT true CHECK (id),
+    checksum text NOT NULL,
+    applied_at timestamp NOT NULL DEFAULT NOW()
+  );
+
+-- Maintenance mode is a single row so it survives restarts and reaches every instance.
+-- While enabled, non-admin logins are rejected; from freeze_at non-admin writes are too
+CREATE TABLE
+  maintenance_mode (
+    id boolean PRIMARY KEY DEFAULT true CHECK (id),
+    enabled boolean NOT NULL,
+    message text NOT NULL DEFAULT '',
+    eta timestamp, -- When the server is expected back, shown to players
+    freeze_at timestamp, -- End of the grace period for open sessions
+    updated_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    updated_at timestamp NOT NULL
+  );
+
+-- Announcements are broadcast to every online player; players who connect later see the
+-- ones that have not expired
+CREATE TABLE
+  announcements (
+    id BIGSERIAL PRIMARY KEY,
+    message text NOT NULL,
+    severity text NOT NULL, -- info, warning or critical
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at timestamp NOT NULL,
+    expires_at timestamp -- NULL never expires
+  );
+
+-- Activity feed projected from domain events, so players can review what happened while
+-- they were away. Entries without a character (trades) belong to all of the user's characters.
+CREATE TABLE
+  character_activity (
+    id BIGSERIAL PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    character_id UUID REFERENCES characters(id) ON DELETE CASCADE,
+    kind text NOT NULL, -- harvest, craft, trade or death
+    details jsonb NOT NULL DEFAULT '{}',
+    dedup_key text NOT NULL UNIQUE, -- source event key, so redelivery adds nothing
+    occurred_at timestamp NOT NULL
+  );
+
+-- Player organization of inventory stacks. Stacks without a row are unflagged
+-- and, having no sort position, are listed after the sorted ones.
+CREATE TABLE
+  character_inventory_flags (
+    inventory_id integer PRIMARY KEY REFERENCES character_inventories (id) ON DELETE CASCADE,
+    character_id UUID NOT NULL REFERENCES characters (id) ON DELETE CASCADE,
+    favorite boolean NOT NULL DEFAULT false,
+    tags text[] NOT NULL DEFAULT '{}',
+    sort_position integer
+  );
+
+-- Per-world inventory rules. Worlds without a row have unlimited inventories.
+CREATE TABLE
+  world_inventory_settings (
+    world_id UUID PRIMARY KEY REFERENCES worlds (id) ON DELETE CASCADE,
+    inventory_slots integer CHECK (inventory_slots > 0), -- NULL: unlimited
+    harvest_overflow text NOT NULL DEFAULT 'reject', -- 'reject', 'ground', 'inbox'
+    updated_by UUID REFERENCES users (id) ON DELETE SET NULL,
+    updated_at timestamp NOT NULL DEFAULT NOW()
+  );
+
+-- Items lying on the ground, e.g. harvest yields that did not fit in an inventory.
+-- Anyone in range can pick them up until they expire.
+CREATE TABLE
+  ground_drops (
+    id bigserial PRIMARY KEY,
+    world_id UUID NOT NULL REFERENCES worlds (id) ON DELETE CASCADE,
+    x integer NOT NULL,
+    y integer NOT NULL,
+    item_id integer NOT NULL REFERENCES items (id) ON DELETE CASCADE,
+    quantity integer NOT NULL CHECK (quantity > 0),
+    dropped_by UUID REFERENCES characters (id) ON DELETE SET NULL,
+    created_at timestamp NOT NULL DEFAULT NOW(),
+    expires_at timestamp NOT NULL
+  );
+
+-- Items held for a character until it has room to claim them
+CREATE TABLE
+  inbox_items (
+    id bigserial PRIMARY KEY,
+    character_id UUID NOT NULL REFERENCES characters (id) ON DELETE CASCADE,
+    item_id integer NOT NULL REFERENCES items (id) ON DELETE CASCADE,
+    quantity integer NOT NULL CHECK (quantity > 0),
+    reason text NOT NULL,
+    created_at timestamp NOT NULL DEFAULT NOW()
+  );
+
+-- Mail to characters from other characters or the system (sender_id NULL). Attached
+-- items and currency are held in escrow until claimed; unclaimed attachments of player
+-- mail go back to the sender when the mail expires.
+CREATE TABLE
+  mail (
+    id bigserial PRIMARY KEY,
+    recipient_id UUID NOT NULL REFERENCES characters (id) ON DELETE CASCADE,
+    sender_id UUID REFERENCES characters (id) ON DELETE SET NULL,
+    sender_name text NOT NULL,
+    subject text NOT NULL,
+    body text NOT NULL DEFAULT '',
+    currency integer NOT NULL DEFAULT 0 CHECK (currency >= 0),
+    returned boolean NOT NULL DEFAULT false, -- Returned mail is not returned again
+    created_at timestamp NOT NULL DEFAULT NOW(),
+    expires_at timestamp NOT NULL,
+    claimed_at timestamp
+  );
+
+CREATE TABLE
+  mail_attachments (
+    id bigserial PRIMARY KEY,
+    mail_id bigint NOT NULL REFERENCES mail (id) ON DELETE CASCADE,
+    item_id integer NOT NULL REFERENCES items (id) ON DELETE CASCADE,
+    quantity integer NOT NULL CHECK (quantity > 0)
+  );
+
+-- Market listings. The listed items are held in escrow from the seller's inventory until
+-- the listing is bought (the seller is paid by mail) or expires (the items go back by mail).
+CREATE TABLE
+  market_listings (
+    id bigserial PRIMARY KEY,
+    world_id UUID NOT NULL REFERENCES worlds (id) ON DELETE CASCADE,
+    seller_id UUID NOT NULL REFERENCES characters (id) ON DELETE CASCADE,
+    item_id integer NOT NULL REFERENCES items (id) ON DELETE CASCADE,
+    quantity integer NOT NULL CHECK (quantity > 0),
+    unit_price integer NOT NULL CHECK (unit_price > 0), -- The buyout costs unit_price * quantity
+    created_at timestamp NOT NULL DEFAULT NOW(),
+    expires_at timestamp NOT NULL
+  );
+
+-- Completed market sales, kept for price history
+CREATE TABLE
+  market_sales (
+    id bigserial PRIMARY KEY,
+    world_id UUID NOT NULL REFERENCES worlds (id) ON DELETE CASCADE,
+    item_id integer NOT NULL REFERENCES items (id) ON DELETE CASCADE,
+    quantity integer NOT NULL CHECK (quantity > 0),
+    unit_price integer NOT NULL CHECK (unit_price > 0),
+    sold_at timestamp NOT NULL
+  );
+
+-- Rare events (meteors, treasure chests) spawned in chunks players were recently in.
+-- Characters nearby contribute until progress reaches required; the rewards are split
+-- between them by contribution.
+CREATE TABLE
+  rare_events (
+    id bigserial PRIMARY KEY,
+    world_id UUID NOT NULL REFERENCES worlds (id) ON DELETE CASCADE,
+    kind text NOT NULL, -- meteor or treasure_chest
+    chunk_x integer NOT NULL,
+    chunk_y integer NOT NULL,
+    x integer NOT NULL, -- World cell coordinates
+    y integer NOT NULL,
+    progress integer NOT NULL DEFAULT 0,
+    required integer NOT NULL CHECK (required > 0),
+    spawned_at timestamp NOT NULL,
+    expires_at timestamp NOT NULL,
+    ended_at timestamp, -- Set when the event is completed or expires
+    outcome text, -- completed or expired
+    rewarded_at timestamp -- Set once the rewards of a completed event were sent
+  );
+
+CREATE TABLE
+  rare_event_contributions (
+    rare_event_id bigint NOT NULL REFERENCES rare_events (id) ON DELETE CASCADE,
+    character_id UUID NOT NULL REFERENCES characters (id) ON DELETE CASCADE,
+    amount integer NOT NULL CHECK (amount > 0),
+    last_contributed_at timestamp NOT NULL,
+    PRIMARY KEY (rare_event_id, character_id)
+  );
+
+-- Generic game objects (mobs, drops, structures, crops). Kind-specific state lives in
+-- components, a JSON object of named components encoded by internal/entity, so new kinds
+-- need no schema change. version is incremented by every update for optimistic locking.
+CREATE TABLE
+  world_entities (
+    id bigserial PRIMARY KEY,
+    world_id UUID NOT NULL REFERENCES worlds (id) ON DELETE CASCADE,
+    type text NOT NULL,
+    chunk_x integer NOT NULL,
+    chunk_y integer NOT NULL,
+    x integer NOT NULL, -- World cell coordinates
+    y integer NOT NULL,
+    components jsonb NOT NULL DEFAULT '{}',
+    version integer NOT NULL DEFAULT 1,
+    created_at timestamp NOT NULL,
+    updated_at timestamp NOT NULL
+  );
+
+-- Per-world simulation speed for testing long-running mechanics. Time-dependent systems
+-- divide their durations by time_scale; worlds without a row run at normal speed.
+CREATE TABLE
+  world_time_scales (
+    world_id UUID PRIMARY KEY REFERENCES worlds (id) ON DELETE CASCADE,
+    time_scale double precision NOT NULL CHECK (time_scale > 0),
+    updated_by UUID REFERENCES users (id) ON DELETE SET NULL,
+    updated_at timestamp NOT NULL DEFAULT NOW()
+  );
+
+-- Worlds an admin has paused to investigate an exploit or for maintenance of that world
+-- only. While a world has a row, non-admin writes in it are rejected and its background
+-- jobs skip it; reads keep working.
+CREATE TABLE
+  world_pauses (
+    world_id UUID PRIMARY KEY REFERENCES worlds (id) ON DELETE CASCADE,
+    reason text NOT NULL DEFAULT '',
+    paused_by UUID REFERENCES users (id) ON DELETE SET NULL,
+    paused_at timestamp NOT NULL DEFAULT NOW()
+  );
+
+-- Shared history of a world (rare events, first region discoveries, market milestones)
+-- projected from domain events and shown to its players as a timeline.
+CREATE TABLE
+  world_timeline (
+    id bigserial PRIMARY KEY,
+    world_id UUID NOT NULL REFERENCES worlds (id) ON DELETE CASCADE,
+    kind text NOT NULL, -- rare_event_spawned, rare_event_completed, region_discovered, market_first_sale or market_milestone
+    details jsonb NOT NULL DEFAULT '{}',
+    dedup_key text NOT NULL UNIQUE, -- one entry per happening, however often its event is delivered
+    occurred_at timestamp NOT NULL
+  );
+
+-- Resource spawn density of each region (a square of chunks) of a world, updated on a
+-- schedule from chunk_summaries: heavily harvested regions thin out and untouched ones
+-- recover. harvest_count is the regions' summary total at the last update, so the next
+-- update only counts newer harvests. Regions without a row use the frontier density.
+CREATE TABLE
+  region_maturity (
+    world_id UUID NOT NULL REFERENCES worlds (id) ON DELETE CASCADE,
+    region_x integer NOT NULL,
+    region_y integer NOT NULL,
+    density double precision NOT NULL CHECK (density > 0),
+    harvest_count bigint NOT NULL DEFAULT 0,
+    updated_at timestamp NOT NULL,
+    PRIMARY KEY (world_id, region_x, region_y)
+  );
+
+-- Time-limited buffs and debuffs on characters. effect_key names a definition in the
+-- status_effect service; magnitude is per stack, e.g. 0.25 for 25% faster.
+CREATE TABLE
+  character_status_effects (
+    character_id UUID NOT NULL REFERENCES characters (id) ON DELETE CASCADE,
+    effect_key text NOT NULL,
+    effect_type integer NOT NULL, -- character.v1.StatusEffectType
+    magnitude double precision NOT NULL,
+    stacks integer NOT NULL DEFAULT 1 CHECK (stacks > 0),
+    source text NOT NULL, -- What applied it, e.g. "item:12" or "event:harvest_festival"
+    applied_at timestamp NOT NULL,
+    expires_at timestamp NOT NULL,
+    PRIMARY KEY (character_id, effect_key)
+  );
+
+-- Timed processing (cooking, smelting) at a station entity. Inputs are taken when the
+-- job starts; outputs are recorded then too, so recipe changes never affect running jobs.
+CREATE TABLE
+  processing_jobs (
+    id bigserial PRIMARY KEY,
+    character_id UUID NOT NULL REFERENCES characters (id) ON DELETE CASCADE,
+    world_id UUID NOT NULL REFERENCES worlds (id) ON DELETE CASCADE,
+    recipe_id text NOT NULL,
+    station_entity_id bigint NOT NULL, -- world_entities row; jobs finish even if it is removed
+    outputs jsonb NOT NULL, -- [{"item_id": 1, "quantity": 2}]
+    started_at timestamp NOT NULL,
+    ready_at timestamp NOT NULL,
+    notified_at timestamp -- When the owner was told the job is ready
+  );
+
+-- Named map markers a character places for its compass
+CREATE TABLE
+  character_waypoints (
+    id BIGSERIAL PRIMARY KEY,
+    character_id UUID NOT NULL REFERENCES characters(id) ON DELETE CASCADE,
+    name text NOT NULL,
+    x integer NOT NULL, -- World cell coordinates
+    y integer NOT NULL,
+    created_at timestamp NOT NULL
+  );
+
+-- Rare resource nodes a character has found, shown on its compass. A node is
+-- discovered the first time the character harvests it.
+CREATE TABLE
+  character_discoveries (
+    character_id UUID NOT NULL REFERENCES characters(id) ON DELETE CASCADE,
+    resource_node_id integer NOT NULL REFERENCES resource_nodes(id) ON DELETE CASCADE,
+    discovered_at timestamp NOT NULL,
+    PRIMARY KEY (character_id, resource_node_id)
+  );
+
+-- First discoveries: the character whose action first generated a chunk, and the first to
+-- harvest each resource node type in a region (a square of chunks). Names are copied so
+-- the credit outlives the character.
+CREATE TABLE
+  chunk_discoveries (
+    world_id UUID NOT NULL,
+    chunk_x integer NOT NULL,
+    chunk_y integer NOT NULL,
+    character_id UUID REFERENCES characters(id) ON DELETE SET NULL,
+    character_name text NOT NULL,
+    discovered_at timestamp NOT NULL,
+    PRIMARY KEY (world_id, chunk_x, chunk_y),
+    FOREIGN KEY (world_id, chunk_x, chunk_y) REFERENCES chunks (world_id, chunk_x, chunk_y) ON DELETE CASCADE
+  );
+
+CREATE TABLE
+  resource_discoveries (
+    world_id UUID NOT NULL REFERENCES worlds (id) ON DELETE CASCADE,
+    region_x integer NOT NULL,
+    region_y integer NOT NULL,
+    resource_node_type_id integer NOT NULL,
+    chunk_x integer NOT NULL, -- Where the first harvest happened
+    chunk_y integer NOT NULL,
+    character_id UUID REFERENCES characters(id) ON DELETE SET NULL,
+    character_name text NOT NULL,
+    discovered_at timestamp NOT NULL,
+    PRIMARY KEY (world_id, region_x, region_y, resource_node_type_id)
+  );
+
+-- Titles and badges characters have unlocked through achievements and first discoveries
+CREATE TABLE
+  character_cosmetics (
+    character_id UUID NOT NULL REFERENCES characters(id) ON DELETE CASCADE,
+    cosmetic_id text NOT NULL, -- See internal/cosmetic
+    unlocked_at timestamp NOT NULL,
+    PRIMARY KEY (character_id, cosmetic_id)
+  );
+
+-- Per-character counts of the events achievements are defined over
+CREATE TABLE
+  achievement_counters (
+    character_id UUID NOT NULL REFERENCES characters(id) ON DELETE CASCADE,
+    counter text NOT NULL, -- The event type counted, e.g. resource.harvested
+    value bigint NOT NULL DEFAULT 0,
+    updated_at timestamp NOT NULL,
+    PRIMARY KEY (character_id, counter)
+  );
+
+-- Achievements characters have unlocked; claimed_at is set once the reward is granted
+CREATE TABLE
+  character_achievements (
+    character_id UUID NOT NULL REFERENCES characters(id) ON DELETE CASCADE,
+    achievement_id text NOT NULL,
+    unlocked_at timestamp NOT NULL,
+    claimed_at timestamp,
+    PRIMARY KEY (character_id, achievement_id)
+  );
+
+-- Instanced interiors of placed structures: a small grid of cells of their own,
+-- generated from the structure type's template when first opened and kept, so template
+-- changes never reshape existing interiors. Interior cells have their own coordinates,
+-- (0, 0) at the top left; characters enter and leave on the door cell.
+CREATE TABLE
+  interiors (
+    id bigserial PRIMARY KEY,
+    structure_id bigint NOT NULL UNIQUE REFERENCES world_entities (id) ON DELETE CASCADE,
+    world_id UUID NOT NULL REFERENCES worlds (id) ON DELETE CASCADE,
+    template text NOT NULL,
+    width integer NOT NULL CHECK (width > 0),
+    height integer NOT NULL CHECK (height > 0),
+    cells bytea NOT NULL, -- One chunk.v1.TerrainType per byte, row-major
+    door_x integer NOT NULL,
+    door_y integer NOT NULL,
+    created_at timestamp NOT NULL
+  );
+
+-- Characters inside an interior and where they stand on its grid. Their world position
+-- stays beside the structure, where they come back out.
+CREATE TABLE
+  character_interiors (
+    character_id UUID PRIMARY KEY REFERENCES characters (id) ON DELETE CASCADE,
+    interior_id bigint NOT NULL REFERENCES interiors (id) ON DELETE CASCADE,
+    x integer NOT NULL,
+    y integer NOT NULL,
+    entered_at timestamp NOT NULL
+  );
+
+-- Furniture placed in interiors, one piece per cell. Each piece is an item taken from
+-- the placer's inventory and given back when it is picked up.
+CREATE TABLE
+  interior_furniture (
+    id bigserial PRIMARY KEY,
+    interior_id bigint NOT NULL REFERENCES interiors (id) ON DELETE CASCADE,
+    item_id integer NOT NULL REFERENCES items (id) ON DELETE CASCADE,
+    x integer NOT NULL,
+    y integer NOT NULL,
+    placed_by UUID REFERENCES characters (id) ON DELETE SET NULL,
+    placed_at timestamp NOT NULL,
+    UNIQUE (interior_id, x, y)
+  );
+
+-- Dungeon instances: a bounded map generated from the seed whenever it is needed and
+-- never stored, bound to a party of characters until it expires. Instance cells have their
+-- own coordinates, (0, 0) at the top left; characters enter and leave on the entrance.
+CREATE TABLE
+  dungeon_instances (
+    id bigserial PRIMARY KEY,
+    world_id UUID NOT NULL REFERENCES worlds (id) ON DELETE CASCADE,
+    leader_id UUID NOT NULL REFERENCES characters (id) ON DELETE CASCADE,
+    seed bigint NOT NULL,
+    created_at timestamp NOT NULL,
+    expires_at timestamp NOT NULL
+  );
+
+-- The party bound to a dungeon instance, one instance per character. Members inside the
+-- instance have their position on its grid; their world position stays where they entered.
+CREATE TABLE
+  dungeon_members (
+    character_id UUID PRIMARY KEY REFERENCES characters (id) ON DELETE CASCADE,
+    dungeon_id bigint NOT NULL REFERENCES dungeon_instances (id) ON DELETE CASCADE,
+    inside boolean NOT NULL DEFAULT false,
+    x integer NOT NULL DEFAULT 0,
+    y integer NOT NULL DEFAULT 0,
+    joined_at timestamp NOT NULL
+  );
+
+-- Generated resource nodes of dungeon instances that have been harvested. Each node
+-- yields once per instance.
+CREATE TABLE
+  dungeon_harvests (
+    dungeon_id bigint NOT NULL REFERENCES dungeon_instances (id) ON DELETE CASCADE,
+    node_id integer NOT NULL,
+    character_id UUID REFERENCES characters (id) ON DELETE SET NULL,
+    harvested_at timestamp NOT NULL,
+    PRIMARY KEY (dungeon_id, node_id)
+  );
+
+-- Currency entering the economy (created, e.g. harvested) and leaving it (destroyed, e.g.
+-- market taxes and fees) per world, for inflation monitoring. Each movement is recorded
+-- once under its dedup_key.
+CREATE TABLE
+  economy_ledger (
+    id bigserial PRIMARY KEY,
+    world_id UUID NOT NULL REFERENCES worlds (id) ON DELETE CASCADE,
+    source text NOT NULL,
+    created bigint NOT NULL DEFAULT 0 CHECK (created >= 0),
+    destroyed bigint NOT NULL DEFAULT 0 CHECK (destroyed >= 0),
+    dedup_key text NOT NULL UNIQUE,
+    recorded_at timestamp NOT NULL
+  );
+
+-- Bank vaults: item storage kept apart from inventories and used at banks. Personal
+-- vaults have an owner, one per character; guild vaults have none and are shared with the
+-- characters in bank_vault_members.
+CREATE TABLE
+  bank_vaults (
+    id bigserial PRIMARY KEY,
+    world_id UUID NOT NULL REFERENCES worlds (id) ON DELETE CASCADE,
+    owner_id UUID UNIQUE REFERENCES characters (id) ON DELETE CASCADE,
+    name text NOT NULL DEFAULT '',
+    created_by UUID REFERENCES characters (id) ON DELETE SET NULL,
+    created_at timestamp NOT NULL
+  );
+
+-- Items stored in a vault, one row per item
+CREATE TABLE
+  bank_vault_items (
+    vault_id bigint NOT NULL REFERENCES bank_vaults (id) ON DELETE CASCADE,
+    item_id integer NOT NULL REFERENCES items (id) ON DELETE CASCADE,
+    quantity integer NOT NULL CHECK (quantity >= 0),
+    PRIMARY KEY (vault_id, item_id)
+  );
+
+-- Members of guild vaults and their permission tier: view, deposit, withdraw or manage.
+-- Each tier includes the ones before it.
+CREATE TABLE
+  bank_vault_members (
+    vault_id bigint NOT NULL REFERENCES bank_vaults (id) ON DELETE CASCADE,
+    character_id UUID NOT NULL REFERENCES characters (id) ON DELETE CASCADE,
+    tier text NOT NULL CHECK (tier IN ('view', 'deposit', 'withdraw', 'manage')),
+    added_at timestamp NOT NULL,
+    PRIMARY KEY (vault_id, character_id)
+  );
+
+-- Deposits (positive quantity) and withdrawals (negative) of every vault
+CREATE TABLE
+  bank_transactions (
+    id bigserial PRIMARY KEY,
+    vault_id bigint NOT NULL REFERENCES bank_vaults (id) ON DELETE CASCADE,
+    character_id UUID REFERENCES characters (id) ON DELETE SET NULL,
+    item_id integer NOT NULL REFERENCES items (id) ON DELETE CASCADE,
+    quantity integer NOT NULL CHECK (quantity <> 0),
+    created_at timestamp NOT NULL
+  );
+
+-- Every rename of a character, kept for moderation and name lookups. Rows outlive the
+-- character so reports naming a former character can still be traced to its user.
+CREATE TABLE
+  character_name_history (
+    id bigserial PRIMARY KEY,
+    character_id UUID REFERENCES characters (id) ON DELETE SET NULL,
+    user_id UUID REFERENCES users (id) ON DELETE SET NULL,
+    world_id UUID NOT NULL REFERENCES worlds (id) ON DELETE CASCADE,
+    old_name text NOT NULL,
+    new_name text NOT NULL,
+    renamed_at timestamp NOT NULL
+  );
+
+-- Login sessions, one per issued token, so users can review and revoke the devices they
+-- are logged in on. Tokens are identified by their SHA-256 hash.
+CREATE TABLE
+  user_sessions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid (),
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    token_hash bytea NOT NULL UNIQUE,
+    ip_address text NOT NULL,
+    user_agent text NOT NULL,
+    created_at timestamp NOT NULL,
+    last_seen_at timestamp NOT NULL,
+    expires_at timestamp NOT NULL, -- The token's expiry
+    revoked_at timestamp
+  );
+
+-- External accounts (OAuth providers) users can log in with
+CREATE TABLE
+  user_identities (
+    id BIGSERIAL PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    provider text NOT NULL, -- discord, google
+    subject text NOT NULL, -- The provider's ID for the account
+    email text NOT NULL, -- As last reported by the provider
+    username text NOT NULL,
+    linked_at timestamp NOT NULL,
+    last_login_at timestamp,
+    UNIQUE (provider, subject),
+    UNIQUE (user_id, provider)
+  );
+
+-- Versions of the terms of service and privacy policy. Players must have accepted the
+-- latest required version of each kind to use gameplay RPCs.
+CREATE TABLE
+  consent_documents (
+    kind text NOT NULL CHECK (kind IN ('terms', 'privacy')),
+    version integer NOT NULL CHECK (version > 0),
+    url text NOT NULL,
+    summary text NOT NULL DEFAULT '', -- What changed, shown when players are asked to accept
+    required boolean NOT NULL, -- false for edits players need not accept again
+    published_by UUID REFERENCES users (id) ON DELETE SET NULL,
+    published_at timestamp NOT NULL,
+    PRIMARY KEY (kind, version)
+  );
+
+-- Every document version a user accepted, kept as the record of their consent
+CREATE TABLE
+  user_consents (
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    kind text NOT NULL,
+    version integer NOT NULL,
+    accepted_at timestamp NOT NULL,
+    ip_address text NOT NULL DEFAULT '',
+    PRIMARY KEY (user_id, kind, version),
+    FOREIGN KEY (kind, version) REFERENCES consent_documents (kind, version)
+  );
+
 -- Create indexes for performance
 CREATE INDEX idx_characters_user_id ON characters (user_id);
-CREATE INDEX idx_characters_position ON characters (chunk_x, chunk_y);
+CREATE INDEX idx_characters_world_id ON characters (world_id);
+CREATE INDEX idx_characters_position ON characters (world_id, chunk_x, chunk_y);
 CREATE INDEX idx_chunks_world_id ON chunks (world_id);
 CREATE INDEX idx_chunks_position ON chunks (world_id, chunk_x, chunk_y);
+CREATE INDEX idx_chunks_last_accessed ON chunks (world_id, (COALESCE(last_accessed_at, generated_at)));
 CREATE INDEX idx_resource_nodes_world_id ON resource_nodes (world_id);
 CREATE INDEX idx_resource_nodes_chunk ON resource_nodes (world_id, chunk_x, chunk_y);
 CREATE INDEX idx_resource_nodes_type ON resource_nodes (resource_node_type_id);
@@ -118,6 +974,60 @@ CREATE INDEX idx_resource_node_drops_resource_type ON resource_node_drops (resou
 CREATE INDEX idx_resource_node_drops_item ON resource_node_drops (item_id);
 CREATE INDEX idx_character_inventories_character_id ON character_inventories (character_id);
 CREATE INDEX idx_character_inventories_item_id ON character_inventories (item_id);
+CREATE INDEX idx_outbox_events_pending ON outbox_events (id) WHERE published_at IS NULL;
+CREATE INDEX idx_chunk_deltas_chunk ON chunk_deltas (world_id, chunk_x, chunk_y, id);
+CREATE INDEX idx_character_checkpoints_character ON character_checkpoints (character_id, id DESC);
+CREATE INDEX idx_character_checkpoints_created_at ON character_checkpoints (created_at);
+CREATE INDEX idx_friendships_addressee ON friendships (addressee_id);
+CREATE INDEX idx_dungeon_members_dungeon ON dungeon_members (dungeon_id);
+CREATE INDEX idx_dungeon_instances_expires ON dungeon_instances (expires_at);
+CREATE INDEX idx_economy_ledger_world ON economy_ledger (world_id, recorded_at);
+CREATE UNIQUE INDEX idx_bank_vaults_guild_name ON bank_vaults (world_id, lower(name)) WHERE owner_id IS NULL;
+CREATE INDEX idx_bank_vaults_created_by ON bank_vaults (created_by) WHERE owner_id IS NULL;
+CREATE INDEX idx_bank_vault_members_character ON bank_vault_members (character_id);
+CREATE INDEX idx_bank_transactions_vault ON bank_transactions (vault_id, id);
+CREATE INDEX idx_characters_world_name ON characters (world_id, lower(name));
+CREATE INDEX idx_character_name_history_character ON character_name_history (character_id, renamed_at DESC);
+CREATE INDEX idx_character_name_history_old_name ON character_name_history (world_id, lower(old_name));
+CREATE INDEX idx_character_name_history_new_name ON character_name_history (world_id, lower(new_name));
+CREATE INDEX idx_user_sessions_user ON user_sessions (user_id, user_agent);
+CREATE INDEX idx_user_sessions_expires ON user_sessions (expires_at);
+CREATE INDEX idx_user_identities_user ON user_identities (user_id);
+CREATE INDEX idx_direct_messages_undelivered ON direct_messages (recipient_id, id) WHERE delivered_at IS NULL;
+CREATE INDEX idx_direct_messages_conversation ON direct_messages (sender_id, recipient_id, id);
+CREATE INDEX idx_user_blocks_blocked ON user_blocks (blocked_id);
+CREATE INDEX idx_player_reports_status ON player_reports (status, id);
+CREATE INDEX idx_notifications_user ON notifications (user_id, id DESC);
+CREATE INDEX idx_notifications_unread ON notifications (user_id) WHERE read_at IS NULL;
+CREATE INDEX idx_region_recording_events_recording ON region_recording_events (recording_id, id);
+CREATE INDEX idx_protected_regions_bounds ON protected_regions (world_id, min_x, max_x, min_y, max_y);
+CREATE INDEX idx_land_claims_character ON land_claims (character_id);
+CREATE INDEX idx_land_claims_paid_until ON land_claims (paid_until);
+CREATE INDEX idx_experiment_assignments_subject ON experiment_assignments (subject_id);
+CREATE INDEX idx_character_activity_character ON character_activity (character_id, id DESC);
+CREATE INDEX idx_character_activity_user ON character_activity (user_id, id DESC) WHERE character_id IS NULL;
+CREATE INDEX idx_character_activity_occurred_at ON character_activity (occurred_at);
+CREATE INDEX idx_character_inventory_flags_character ON character_inventory_flags (character_id);
+CREATE INDEX idx_ground_drops_position ON ground_drops (world_id, x, y);
+CREATE INDEX idx_ground_drops_expires_at ON ground_drops (expires_at);
+CREATE INDEX idx_inbox_items_character ON inbox_items (character_id, id);
+CREATE INDEX idx_mail_recipient ON mail (recipient_id, id);
+CREATE INDEX idx_mail_expires_at ON mail (expires_at);
+CREATE INDEX idx_mail_attachments_mail ON mail_attachments (mail_id);
+CREATE INDEX idx_market_listings_browse ON market_listings (world_id, unit_price, id);
+CREATE INDEX idx_market_listings_seller ON market_listings (seller_id);
+CREATE INDEX idx_market_listings_expires_at ON market_listings (expires_at);
+CREATE INDEX idx_market_sales_item ON market_sales (world_id, item_id, sold_at);
+CREATE INDEX idx_rare_events_world ON rare_events (world_id, spawned_at);
+CREATE INDEX idx_rare_events_unrewarded ON rare_events (id) WHERE outcome = 'completed' AND rewarded_at IS NULL;
+CREATE INDEX idx_world_entities_chunk ON world_entities (world_id, chunk_x, chunk_y, type);
+CREATE INDEX idx_world_entities_position ON world_entities (world_id, x, y);
+CREATE INDEX idx_character_status_effects_expires_at ON character_status_effects (expires_at);
+CREATE INDEX idx_processing_jobs_character ON processing_jobs (character_id, id);
+CREATE INDEX idx_processing_jobs_unnotified ON processing_jobs (ready_at) WHERE notified_at IS NULL;
+CREATE INDEX idx_character_waypoints_character ON character_waypoints (character_id, id);
+CREATE INDEX idx_world_timeline_world ON world_timeline (world_id, id);
+CREATE INDEX idx_resource_discoveries_chunk ON resource_discoveries (world_id, chunk_x, chunk_y);
 
 
 -- Insert default world
@@ -142,7 +1052,23 @@ INSERT INTO items (name, description, item_type, rarity, stack_size, visual_data
   ('Stone', 'Common stone pieces', 'material', 'common', 64, '{"sprite": "stone", "color": "#696969"}'),
   ('Dirt', 'Rich soil and dirt', 'material', 'common', 64, '{"sprite": "dirt", "color": "#8B4513"}'),
   ('Algae', 'Underwater plant matter', 'material', 'common', 64, '{"sprite": "algae", "color": "#006400"}'),
-  ('Shells', 'Decorative seashells', 'material', 'uncommon', 64, '{"sprite": "shells", "color": "#F5DEB3"}');
+  ('Shells', 'Decorative seashells', 'material', 'uncommon', 64, '{"sprite": "shells", "color": "#F5DEB3"}'),
+
+  -- Processed at stations (campfire, furnace)
+  ('Grilled Fish', 'Fish cooked over a campfire', 'food', 'common', 32, '{"sprite": "grilled_fish", "color": "#D2691E"}'),
+  ('Herbal Tea', 'A warming brew of steeped herbs', 'food', 'common', 32, '{"sprite": "herbal_tea", "color": "#9ACD32"}'),
+  ('Metal Ingot', 'Minerals smelted into a workable bar', 'material', 'uncommon', 64, '{"sprite": "metal_ingot", "color": "#B0C4DE"}'),
+  ('Glass', 'Clear glass fired from shells and stone', 'material', 'uncommon', 64, '{"sprite": "glass", "color": "#E0FFFF"}'),
+
+  -- Made at a workbench and placed in interiors
+  ('Wooden Chair', 'A simple chair to furnish a home', 'furniture', 'common', 16, '{"sprite": "wooden_chair", "color": "#A0522D"}'),
+  ('Wooden Table', 'A sturdy table to furnish a home', 'furniture', 'common', 16, '{"sprite": "wooden_table", "color": "#8B5A2B"}'),
+  ('Glass Lamp', 'A lamp of glass and metal that lights a room', 'furniture', 'uncommon', 16, '{"sprite": "glass_lamp", "color": "#FFFACD"}'),
+
+  -- Caught only by fishing, depending on weather and time of day
+  ('Night Eel', 'A slippery eel that feeds after dark', 'material', 'uncommon', 64, '{"sprite": "night_eel", "color": "#2F4F4F"}'),
+  ('Storm Pike', 'A fierce pike that bites in rough weather', 'material', 'uncommon', 64, '{"sprite": "storm_pike", "color": "#4682B4"}'),
+  ('Golden Carp', 'A rare carp that rises in the still light of dawn and dusk', 'material', 'rare', 64, '{"sprite": "golden_carp", "color": "#FFD700"}');
 
 -- Insert resource node drop configurations
 INSERT INTO resource_node_drops (resource_node_type_id, item_id, chance, min_quantity, max_quantity) VALUES
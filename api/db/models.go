@@ -8,17 +8,131 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
-type Character struct {
-	ID        pgtype.UUID
-	UserID    pgtype.UUID
+type AchievementCounter struct {
+	CharacterID pgtype.UUID
+	Counter     string
+	Value       int64
+	UpdatedAt   pgtype.Timestamp
+}
+
+type Announcement struct {
+	ID        int64
+	Message   string
+	Severity  string
+	CreatedBy pgtype.UUID
+	CreatedAt pgtype.Timestamp
+	ExpiresAt pgtype.Timestamp
+}
+
+type ApiKey struct {
+	ID         int64
+	KeyID      string
+	SecretHash []byte
+	Name       string
+	Scopes     []string
+	CreatedBy  pgtype.UUID
+	CreatedAt  pgtype.Timestamp
+	ExpiresAt  pgtype.Timestamp
+	RevokedAt  pgtype.Timestamp
+	LastUsedAt pgtype.Timestamp
+}
+
+type BankTransaction struct {
+	ID          int64
+	VaultID     int64
+	CharacterID pgtype.UUID
+	ItemID      int32
+	Quantity    int32
+	CreatedAt   pgtype.Timestamp
+}
+
+type BankVault struct {
+	ID        int64
+	WorldID   pgtype.UUID
+	OwnerID   pgtype.UUID
 	Name      string
-	X         int32
-	Y         int32
-	ChunkX    int32
-	ChunkY    int32
+	CreatedBy pgtype.UUID
 	CreatedAt pgtype.Timestamp
 }
 
+type BankVaultItem struct {
+	VaultID  int64
+	ItemID   int32
+	Quantity int32
+}
+
+type BankVaultMember struct {
+	VaultID     int64
+	CharacterID pgtype.UUID
+	Tier        string
+	AddedAt     pgtype.Timestamp
+}
+
+type Character struct {
+	ID            pgtype.UUID
+	UserID        pgtype.UUID
+	WorldID       pgtype.UUID
+	Name          string
+	X             int32
+	Y             int32
+	ChunkX        int32
+	ChunkY        int32
+	CreatedAt     pgtype.Timestamp
+	Facing        int32
+	ActionState   int32
+	EquippedTitle string
+}
+
+type CharacterAchievement struct {
+	CharacterID   pgtype.UUID
+	AchievementID string
+	UnlockedAt    pgtype.Timestamp
+	ClaimedAt     pgtype.Timestamp
+}
+
+type CharacterActivity struct {
+	ID          int64
+	UserID      pgtype.UUID
+	CharacterID pgtype.UUID
+	Kind        string
+	Details     []byte
+	DedupKey    string
+	OccurredAt  pgtype.Timestamp
+}
+
+type CharacterCheckpoint struct {
+	ID            int64
+	CharacterID   pgtype.UUID
+	X             int32
+	Y             int32
+	ChunkX        int32
+	ChunkY        int32
+	Inventory     []byte
+	InventoryHash string
+	Reason        string
+	CreatedAt     pgtype.Timestamp
+}
+
+type CharacterCosmetic struct {
+	CharacterID pgtype.UUID
+	CosmeticID  string
+	UnlockedAt  pgtype.Timestamp
+}
+
+type CharacterDiscovery struct {
+	CharacterID    pgtype.UUID
+	ResourceNodeID int32
+	DiscoveredAt   pgtype.Timestamp
+}
+
+type CharacterInterior struct {
+	CharacterID pgtype.UUID
+	InteriorID  int64
+	X           int32
+	Y           int32
+	EnteredAt   pgtype.Timestamp
+}
+
 type CharacterInventory struct {
 	ID          int32
 	CharacterID pgtype.UUID
@@ -28,12 +142,220 @@ type CharacterInventory struct {
 	UpdatedAt   pgtype.Timestamp
 }
 
+type CharacterInventoryFlag struct {
+	InventoryID  int32
+	CharacterID  pgtype.UUID
+	Favorite     bool
+	Tags         []string
+	SortPosition pgtype.Int4
+}
+
+type CharacterNameHistory struct {
+	ID          int64
+	CharacterID pgtype.UUID
+	UserID      pgtype.UUID
+	WorldID     pgtype.UUID
+	OldName     string
+	NewName     string
+	RenamedAt   pgtype.Timestamp
+}
+
+type CharacterStatusEffect struct {
+	CharacterID pgtype.UUID
+	EffectKey   string
+	EffectType  int32
+	Magnitude   float64
+	Stacks      int32
+	Source      string
+	AppliedAt   pgtype.Timestamp
+	ExpiresAt   pgtype.Timestamp
+}
+
+type CharacterWaypoint struct {
+	ID          int64
+	CharacterID pgtype.UUID
+	Name        string
+	X           int32
+	Y           int32
+	CreatedAt   pgtype.Timestamp
+}
+
 type Chunk struct {
+	WorldID          pgtype.UUID
+	ChunkX           int32
+	ChunkY           int32
+	ChunkData        []byte
+	GeneratedAt      pgtype.Timestamp
+	QuarantinedAt    pgtype.Timestamp
+	CorruptChunkData []byte
+	Passability      []byte
+	LastAccessedAt   pgtype.Timestamp
+	AccessCount      int64
+}
+
+type ChunkDelta struct {
+	ID                  int64
+	WorldID             pgtype.UUID
+	ChunkX              int32
+	ChunkY              int32
+	CellIndex           int32
+	TerrainType         int32
+	PreviousTerrainType int32
+	CharacterID         pgtype.UUID
+	CreatedAt           pgtype.Timestamp
+}
+
+type ChunkDiscovery struct {
+	WorldID       pgtype.UUID
+	ChunkX        int32
+	ChunkY        int32
+	CharacterID   pgtype.UUID
+	CharacterName string
+	DiscoveredAt  pgtype.Timestamp
+}
+
+type ChunkSummary struct {
+	WorldID          pgtype.UUID
+	ChunkX           int32
+	ChunkY           int32
+	TerrainHistogram []byte
+	NodeCounts       []byte
+	HarvestCount     int32
+	LastModified     pgtype.Timestamp
+}
+
+type ConsentDocument struct {
+	Kind        string
+	Version     int32
+	Url         string
+	Summary     string
+	Required    bool
+	PublishedBy pgtype.UUID
+	PublishedAt pgtype.Timestamp
+}
+
+type DirectMessage struct {
+	ID          int64
+	SenderID    pgtype.UUID
+	RecipientID pgtype.UUID
+	Body        string
+	CreatedAt   pgtype.Timestamp
+	DeliveredAt pgtype.Timestamp
+}
+
+type DungeonHarvest struct {
+	DungeonID   int64
+	NodeID      int32
+	CharacterID pgtype.UUID
+	HarvestedAt pgtype.Timestamp
+}
+
+type DungeonInstance struct {
+	ID        int64
+	WorldID   pgtype.UUID
+	LeaderID  pgtype.UUID
+	Seed      int64
+	CreatedAt pgtype.Timestamp
+	ExpiresAt pgtype.Timestamp
+}
+
+type DungeonMember struct {
+	CharacterID pgtype.UUID
+	DungeonID   int64
+	Inside      bool
+	X           int32
+	Y           int32
+	JoinedAt    pgtype.Timestamp
+}
+
+type EconomyLedger struct {
+	ID         int64
+	WorldID    pgtype.UUID
+	Source     string
+	Created    int64
+	Destroyed  int64
+	DedupKey   string
+	RecordedAt pgtype.Timestamp
+}
+
+type Experiment struct {
+	Name        string
+	Description string
+	Subject     string
+	Variants    []byte
+	StartedAt   pgtype.Timestamp
+	StoppedAt   pgtype.Timestamp
+	CreatedBy   pgtype.UUID
+}
+
+type ExperimentAssignment struct {
+	Experiment string
+	SubjectID  pgtype.UUID
+	Variant    string
+	AssignedAt pgtype.Timestamp
+}
+
+type FeatureFlag struct {
+	Name              string
+	Description       string
+	Enabled           bool
+	RolloutPercentage int32
+	UserIds           []pgtype.UUID
+	WorldIds          []pgtype.UUID
+	UpdatedBy         pgtype.UUID
+	UpdatedAt         pgtype.Timestamp
+}
+
+type Friendship struct {
+	RequesterID pgtype.UUID
+	AddresseeID pgtype.UUID
+	Status      string
+	CreatedAt   pgtype.Timestamp
+	RespondedAt pgtype.Timestamp
+}
+
+type GroundDrop struct {
+	ID        int64
+	WorldID   pgtype.UUID
+	X         int32
+	Y         int32
+	ItemID    int32
+	Quantity  int32
+	DroppedBy pgtype.UUID
+	CreatedAt pgtype.Timestamp
+	ExpiresAt pgtype.Timestamp
+}
+
+type InboxItem struct {
+	ID          int64
+	CharacterID pgtype.UUID
+	ItemID      int32
+	Quantity    int32
+	Reason      string
+	CreatedAt   pgtype.Timestamp
+}
+
+type Interior struct {
+	ID          int64
+	StructureID int64
 	WorldID     pgtype.UUID
-	ChunkX      int32
-	ChunkY      int32
-	ChunkData   []byte
-	GeneratedAt pgtype.Timestamp
+	Template    string
+	Width       int32
+	Height      int32
+	Cells       []byte
+	DoorX       int32
+	DoorY       int32
+	CreatedAt   pgtype.Timestamp
+}
+
+type InteriorFurniture struct {
+	ID         int64
+	InteriorID int64
+	ItemID     int32
+	X          int32
+	Y          int32
+	PlacedBy   pgtype.UUID
+	PlacedAt   pgtype.Timestamp
 }
 
 type Item struct {
@@ -47,6 +369,204 @@ type Item struct {
 	CreatedAt   pgtype.Timestamp
 }
 
+type LandClaim struct {
+	ID          int64
+	WorldID     pgtype.UUID
+	ChunkX      int32
+	ChunkY      int32
+	CharacterID pgtype.UUID
+	ClaimedAt   pgtype.Timestamp
+	PaidUntil   pgtype.Timestamp
+}
+
+type Mail struct {
+	ID          int64
+	RecipientID pgtype.UUID
+	SenderID    pgtype.UUID
+	SenderName  string
+	Subject     string
+	Body        string
+	Currency    int32
+	Returned    bool
+	CreatedAt   pgtype.Timestamp
+	ExpiresAt   pgtype.Timestamp
+	ClaimedAt   pgtype.Timestamp
+}
+
+type MailAttachment struct {
+	ID       int64
+	MailID   int64
+	ItemID   int32
+	Quantity int32
+}
+
+type MaintenanceMode struct {
+	ID        bool
+	Enabled   bool
+	Message   string
+	Eta       pgtype.Timestamp
+	FreezeAt  pgtype.Timestamp
+	UpdatedBy pgtype.UUID
+	UpdatedAt pgtype.Timestamp
+}
+
+type MarketListing struct {
+	ID        int64
+	WorldID   pgtype.UUID
+	SellerID  pgtype.UUID
+	ItemID    int32
+	Quantity  int32
+	UnitPrice int32
+	CreatedAt pgtype.Timestamp
+	ExpiresAt pgtype.Timestamp
+}
+
+type MarketSale struct {
+	ID        int64
+	WorldID   pgtype.UUID
+	ItemID    int32
+	Quantity  int32
+	UnitPrice int32
+	SoldAt    pgtype.Timestamp
+}
+
+type Notification struct {
+	ID        int64
+	UserID    pgtype.UUID
+	Type      string
+	Title     string
+	Body      string
+	Data      []byte
+	DedupKey  string
+	CreatedAt pgtype.Timestamp
+	ReadAt    pgtype.Timestamp
+}
+
+type OutboxEvent struct {
+	ID          int64
+	EventType   string
+	AggregateID string
+	Payload     []byte
+	DedupKey    string
+	CreatedAt   pgtype.Timestamp
+	LockedUntil pgtype.Timestamp
+	Attempts    int32
+	LastError   pgtype.Text
+	PublishedAt pgtype.Timestamp
+}
+
+type PlayerReport struct {
+	ID         int64
+	ReporterID pgtype.UUID
+	ReportedID pgtype.UUID
+	Reason     string
+	Details    string
+	Context    []byte
+	Status     string
+	Resolution string
+	ResolvedBy pgtype.UUID
+	CreatedAt  pgtype.Timestamp
+	ResolvedAt pgtype.Timestamp
+}
+
+type ProcessingJob struct {
+	ID              int64
+	CharacterID     pgtype.UUID
+	WorldID         pgtype.UUID
+	RecipeID        string
+	StationEntityID int64
+	Outputs         []byte
+	StartedAt       pgtype.Timestamp
+	ReadyAt         pgtype.Timestamp
+	NotifiedAt      pgtype.Timestamp
+}
+
+type ProtectedRegion struct {
+	ID        int64
+	WorldID   pgtype.UUID
+	Name      string
+	Flags     []string
+	Polygon   []int32
+	MinX      int32
+	MinY      int32
+	MaxX      int32
+	MaxY      int32
+	CreatedBy pgtype.UUID
+	CreatedAt pgtype.Timestamp
+	UpdatedAt pgtype.Timestamp
+}
+
+type RareEvent struct {
+	ID         int64
+	WorldID    pgtype.UUID
+	Kind       string
+	ChunkX     int32
+	ChunkY     int32
+	X          int32
+	Y          int32
+	Progress   int32
+	Required   int32
+	SpawnedAt  pgtype.Timestamp
+	ExpiresAt  pgtype.Timestamp
+	EndedAt    pgtype.Timestamp
+	Outcome    pgtype.Text
+	RewardedAt pgtype.Timestamp
+}
+
+type RareEventContribution struct {
+	RareEventID       int64
+	CharacterID       pgtype.UUID
+	Amount            int32
+	LastContributedAt pgtype.Timestamp
+}
+
+type RegionMaturity struct {
+	WorldID      pgtype.UUID
+	RegionX      int32
+	RegionY      int32
+	Density      float64
+	HarvestCount int64
+	UpdatedAt    pgtype.Timestamp
+}
+
+type RegionRecording struct {
+	ID        int64
+	WorldID   pgtype.UUID
+	MinChunkX int32
+	MinChunkY int32
+	MaxChunkX int32
+	MaxChunkY int32
+	StartedBy pgtype.UUID
+	StartedAt pgtype.Timestamp
+	EndsAt    pgtype.Timestamp
+	StoppedAt pgtype.Timestamp
+}
+
+type RegionRecordingEvent struct {
+	ID          int64
+	RecordingID int64
+	EventType   string
+	CharacterID pgtype.UUID
+	X           int32
+	Y           int32
+	ChunkX      int32
+	ChunkY      int32
+	Payload     []byte
+	OccurredAt  pgtype.Timestamp
+}
+
+type ResourceDiscovery struct {
+	WorldID            pgtype.UUID
+	RegionX            int32
+	RegionY            int32
+	ResourceNodeTypeID int32
+	ChunkX             int32
+	ChunkY             int32
+	CharacterID        pgtype.UUID
+	CharacterName      string
+	DiscoveredAt       pgtype.Timestamp
+}
+
 type ResourceNode struct {
 	ID                 int32
 	ResourceNodeTypeID int32
@@ -57,6 +577,7 @@ type ResourceNode struct {
 	X                  int32
 	Y                  int32
 	Size               int32
+	Quality            int32
 	CreatedAt          pgtype.Timestamp
 }
 
@@ -70,6 +591,31 @@ type ResourceNodeDrop struct {
 	CreatedAt          pgtype.Timestamp
 }
 
+type ResourceNodeReservation struct {
+	ResourceNodeID int32
+	CharacterID    pgtype.UUID
+	ReservedAt     pgtype.Timestamp
+	ExpiresAt      pgtype.Timestamp
+}
+
+type SchemaVersion struct {
+	ID        bool
+	Checksum  string
+	AppliedAt pgtype.Timestamp
+}
+
+type ShardInstance struct {
+	InstanceID  string
+	Address     string
+	HeartbeatAt pgtype.Timestamp
+}
+
+type TutorialStep struct {
+	CharacterID pgtype.UUID
+	Step        string
+	CompletedAt pgtype.Timestamp
+}
+
 type User struct {
 	ID                   pgtype.UUID
 	Username             string
@@ -85,9 +631,97 @@ type User struct {
 	FailedLoginAttempts  pgtype.Int4
 }
 
+type UserBlock struct {
+	BlockerID pgtype.UUID
+	BlockedID pgtype.UUID
+	CreatedAt pgtype.Timestamp
+}
+
+type UserConsent struct {
+	UserID     pgtype.UUID
+	Kind       string
+	Version    int32
+	AcceptedAt pgtype.Timestamp
+	IpAddress  string
+}
+
+type UserIdentity struct {
+	ID          int64
+	UserID      pgtype.UUID
+	Provider    string
+	Subject     string
+	Email       string
+	Username    string
+	LinkedAt    pgtype.Timestamp
+	LastLoginAt pgtype.Timestamp
+}
+
+type UserSession struct {
+	ID         pgtype.UUID
+	UserID     pgtype.UUID
+	TokenHash  []byte
+	IpAddress  string
+	UserAgent  string
+	CreatedAt  pgtype.Timestamp
+	LastSeenAt pgtype.Timestamp
+	ExpiresAt  pgtype.Timestamp
+	RevokedAt  pgtype.Timestamp
+}
+
 type World struct {
 	ID        pgtype.UUID
 	Name      string
 	Seed      int64
 	CreatedAt pgtype.Timestamp
 }
+
+type WorldEntity struct {
+	ID         int64
+	WorldID    pgtype.UUID
+	Type       string
+	ChunkX     int32
+	ChunkY     int32
+	X          int32
+	Y          int32
+	Components []byte
+	Version    int32
+	CreatedAt  pgtype.Timestamp
+	UpdatedAt  pgtype.Timestamp
+}
+
+type WorldInventorySetting struct {
+	WorldID         pgtype.UUID
+	InventorySlots  pgtype.Int4
+	HarvestOverflow string
+	UpdatedBy       pgtype.UUID
+	UpdatedAt       pgtype.Timestamp
+}
+
+type WorldPause struct {
+	WorldID  pgtype.UUID
+	Reason   string
+	PausedBy pgtype.UUID
+	PausedAt pgtype.Timestamp
+}
+
+type WorldShard struct {
+	WorldID    pgtype.UUID
+	InstanceID string
+	AssignedAt pgtype.Timestamp
+}
+
+type WorldTimeScale struct {
+	WorldID   pgtype.UUID
+	TimeScale float64
+	UpdatedBy pgtype.UUID
+	UpdatedAt pgtype.Timestamp
+}
+
+type WorldTimeline struct {
+	ID         int64
+	WorldID    pgtype.UUID
+	Kind       string
+	Details    []byte
+	DedupKey   string
+	OccurredAt pgtype.Timestamp
+}
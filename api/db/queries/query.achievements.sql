@@ -0,0 +1,32 @@
+-- name: IncrementAchievementCounter :one
+-- Returns the counter's new value
+INSERT INTO achievement_counters (character_id, counter, value, updated_at)
+VALUES ($1, $2, 1, $3)
+ON CONFLICT (character_id, counter) DO UPDATE
+SET value = achievement_counters.value + 1, updated_at = EXCLUDED.updated_at
+RETURNING value;
+
+-- name: ListAchievementCounters :many
+SELECT * FROM achievement_counters
+WHERE character_id = $1;
+
+-- name: UnlockAchievement :execrows
+-- Does nothing when the character has already unlocked the achievement
+INSERT INTO character_achievements (character_id, achievement_id, unlocked_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (character_id, achievement_id) DO NOTHING;
+
+-- name: ListCharacterAchievements :many
+SELECT * FROM character_achievements
+WHERE character_id = $1
+ORDER BY unlocked_at, achievement_id;
+
+-- name: GetCharacterAchievementForUpdate :one
+SELECT * FROM character_achievements
+WHERE character_id = $1 AND achievement_id = $2
+FOR UPDATE;
+
+-- name: ClaimAchievement :exec
+UPDATE character_achievements
+SET claimed_at = $3
+WHERE character_id = $1 AND achievement_id = $2;
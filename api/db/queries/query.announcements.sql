@@ -0,0 +1,16 @@
+-- Announcement Operations
+
+-- name: CreateAnnouncement :one
+INSERT INTO announcements (message, severity, created_by, created_at, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: ListActiveAnnouncements :many
+-- Announcements after an ID that have not expired, oldest first
+SELECT * FROM announcements
+WHERE id > sqlc.arg(after_id)
+  AND (expires_at IS NULL OR expires_at > sqlc.arg(now)::timestamp)
+ORDER BY id;
+
+-- name: GetLatestAnnouncementID :one
+SELECT COALESCE(MAX(id), 0)::bigint FROM announcements;
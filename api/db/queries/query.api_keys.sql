@@ -0,0 +1,25 @@
+-- API Key Operations
+
+-- name: CreateApiKey :one
+INSERT INTO api_keys (key_id, secret_hash, name, scopes, created_by, created_at, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: GetApiKeyByKeyID :one
+SELECT * FROM api_keys
+WHERE key_id = $1;
+
+-- name: ListApiKeys :many
+SELECT * FROM api_keys
+ORDER BY id;
+
+-- name: RevokeApiKey :one
+UPDATE api_keys
+SET revoked_at = $2
+WHERE id = $1 AND revoked_at IS NULL
+RETURNING *;
+
+-- name: TouchApiKey :exec
+UPDATE api_keys
+SET last_used_at = $2
+WHERE id = $1;
@@ -0,0 +1,103 @@
+-- Bank Operations
+
+-- name: GetBankVault :one
+SELECT * FROM bank_vaults
+WHERE id = $1;
+
+-- name: GetPersonalBankVault :one
+SELECT * FROM bank_vaults
+WHERE owner_id = $1;
+
+-- name: CreatePersonalBankVault :one
+-- Returns the existing vault when the character already has one
+INSERT INTO bank_vaults (world_id, owner_id, created_by, created_at)
+VALUES (sqlc.arg(world_id), sqlc.arg(owner_id), sqlc.arg(owner_id), sqlc.arg(created_at))
+ON CONFLICT (owner_id) DO UPDATE SET owner_id = EXCLUDED.owner_id
+RETURNING *;
+
+-- name: CreateGuildBankVault :one
+-- Returns no row when the world already has a guild vault of that name
+INSERT INTO bank_vaults (world_id, name, created_by, created_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (world_id, lower(name)) WHERE owner_id IS NULL DO NOTHING
+RETURNING *;
+
+-- name: CountGuildBankVaultsCreatedBy :one
+SELECT COUNT(*) FROM bank_vaults
+WHERE created_by = $1 AND owner_id IS NULL;
+
+-- name: ListGuildBankVaultsByMember :many
+SELECT bank_vaults.*, bank_vault_members.tier
+FROM bank_vaults
+JOIN bank_vault_members ON bank_vault_members.vault_id = bank_vaults.id
+WHERE bank_vault_members.character_id = $1
+ORDER BY lower(bank_vaults.name), bank_vaults.id;
+
+-- name: ListBankVaultItems :many
+SELECT
+  bank_vault_items.*,
+  items.name AS item_name,
+  items.stack_size
+FROM bank_vault_items
+JOIN items ON items.id = bank_vault_items.item_id
+WHERE bank_vault_items.vault_id = $1
+ORDER BY items.name, bank_vault_items.item_id;
+
+-- name: AddBankVaultItem :one
+INSERT INTO bank_vault_items (vault_id, item_id, quantity)
+VALUES ($1, $2, $3)
+ON CONFLICT (vault_id, item_id) DO UPDATE SET quantity = bank_vault_items.quantity + EXCLUDED.quantity
+RETURNING *;
+
+-- name: RemoveBankVaultItemQuantity :one
+-- Returns no row when the vault holds less than the quantity
+UPDATE bank_vault_items
+SET quantity = quantity - sqlc.arg(quantity)
+WHERE vault_id = $1 AND item_id = $2 AND quantity >= sqlc.arg(quantity)
+RETURNING *;
+
+-- name: DeleteEmptyBankVaultItems :exec
+DELETE FROM bank_vault_items
+WHERE vault_id = $1 AND quantity = 0;
+
+-- name: GetBankVaultMember :one
+SELECT * FROM bank_vault_members
+WHERE vault_id = $1 AND character_id = $2;
+
+-- name: UpsertBankVaultMember :one
+INSERT INTO bank_vault_members (vault_id, character_id, tier, added_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (vault_id, character_id) DO UPDATE SET tier = EXCLUDED.tier
+RETURNING *;
+
+-- name: DeleteBankVaultMember :execrows
+DELETE FROM bank_vault_members
+WHERE vault_id = $1 AND character_id = $2;
+
+-- name: ListBankVaultMembers :many
+SELECT
+  bank_vault_members.*,
+  characters.name AS character_name
+FROM bank_vault_members
+JOIN characters ON characters.id = bank_vault_members.character_id
+WHERE bank_vault_members.vault_id = $1
+ORDER BY bank_vault_members.added_at, characters.name;
+
+-- name: CreateBankTransaction :one
+INSERT INTO bank_transactions (vault_id, character_id, item_id, quantity, created_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: ListBankTransactions :many
+-- A vault's transactions, newest first, before the id cursor (0 for the newest)
+SELECT
+  bank_transactions.*,
+  items.name AS item_name,
+  COALESCE(characters.name, '')::text AS character_name
+FROM bank_transactions
+JOIN items ON items.id = bank_transactions.item_id
+LEFT JOIN characters ON characters.id = bank_transactions.character_id
+WHERE bank_transactions.vault_id = sqlc.arg(vault_id)
+  AND (sqlc.arg(before_id)::bigint = 0 OR bank_transactions.id < sqlc.arg(before_id)::bigint)
+ORDER BY bank_transactions.id DESC
+LIMIT sqlc.arg(max_transactions);
@@ -0,0 +1,19 @@
+-- Character Activity Operations
+
+-- name: CreateCharacterActivity :exec
+-- Does nothing when an entry with the same dedup key exists
+INSERT INTO character_activity (user_id, character_id, kind, details, dedup_key, occurred_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (dedup_key) DO NOTHING;
+
+-- name: ListCharacterActivity :many
+-- A character's activity and its user's account-wide activity before an entry ID, newest first
+SELECT * FROM character_activity
+WHERE (character_id = sqlc.arg(character_id) OR (character_id IS NULL AND user_id = sqlc.arg(user_id)))
+  AND id < sqlc.arg(before_id)
+ORDER BY id DESC
+LIMIT sqlc.arg(max_entries);
+
+-- name: DeleteCharacterActivityBefore :execrows
+DELETE FROM character_activity
+WHERE occurred_at < $1;
@@ -0,0 +1,37 @@
+-- Character Checkpoint Operations
+
+-- name: CreateCharacterCheckpoint :one
+INSERT INTO character_checkpoints (character_id, x, y, chunk_x, chunk_y, inventory, inventory_hash, reason, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING *;
+
+-- name: GetCharacterCheckpoint :one
+SELECT * FROM character_checkpoints
+WHERE id = $1;
+
+-- name: GetLatestCharacterCheckpoint :one
+SELECT * FROM character_checkpoints
+WHERE character_id = $1
+ORDER BY id DESC
+LIMIT 1;
+
+-- name: ListCharacterCheckpoints :many
+SELECT * FROM character_checkpoints
+WHERE character_id = $1
+ORDER BY id DESC
+LIMIT $2;
+
+-- name: ListCharactersAfter :many
+-- Keyset pagination over all characters for the checkpoint job
+SELECT * FROM characters
+WHERE id > $1
+ORDER BY id
+LIMIT $2;
+
+-- name: DeleteCharacterCheckpointsBefore :execrows
+DELETE FROM character_checkpoints
+WHERE created_at < $1;
+
+-- name: DeleteCharacterInventory :exec
+DELETE FROM character_inventories
+WHERE character_id = $1;
@@ -0,0 +1,20 @@
+-- name: ListInventoryFlags :many
+SELECT * FROM character_inventory_flags
+WHERE character_id = $1;
+
+-- name: UpsertInventoryFlags :one
+INSERT INTO character_inventory_flags (inventory_id, character_id, favorite, tags)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (inventory_id) DO UPDATE
+SET favorite = EXCLUDED.favorite, tags = EXCLUDED.tags
+RETURNING *;
+
+-- name: SetInventorySortPositions :exec
+-- Positions are 1-based in the order of inventory_ids; stacks of other characters are ignored
+INSERT INTO character_inventory_flags (inventory_id, character_id, sort_position)
+SELECT ci.id, ci.character_id, p.position::integer
+FROM unnest(@inventory_ids::integer[]) WITH ORDINALITY AS p(inventory_id, position)
+JOIN character_inventories ci ON ci.id = p.inventory_id
+WHERE ci.character_id = @character_id
+ON CONFLICT (inventory_id) DO UPDATE
+SET sort_position = EXCLUDED.sort_position;
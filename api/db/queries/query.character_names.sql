@@ -0,0 +1,45 @@
+-- name: CharacterNameInUse :one
+-- Names are unique per world ignoring case; exclude_id skips the character being renamed
+SELECT EXISTS (
+  SELECT 1 FROM characters
+  WHERE world_id = sqlc.arg(world_id)
+    AND lower(name) = lower(sqlc.arg(name))
+    AND id IS DISTINCT FROM sqlc.narg(exclude_id)::uuid
+);
+
+-- name: RenameCharacter :one
+UPDATE characters
+SET name = $2
+WHERE id = $1
+RETURNING *;
+
+-- name: CreateCharacterNameChange :one
+INSERT INTO character_name_history (character_id, user_id, world_id, old_name, new_name, renamed_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetLatestCharacterRename :one
+SELECT renamed_at FROM character_name_history
+WHERE character_id = $1
+ORDER BY renamed_at DESC
+LIMIT 1;
+
+-- name: ListCharacterNameHistory :many
+-- Newest first
+SELECT * FROM character_name_history
+WHERE character_id = $1
+ORDER BY renamed_at DESC, id DESC
+LIMIT $2;
+
+-- name: LookupCharacterName :many
+-- Renames from or to a name in a world, ignoring case, newest first
+SELECT * FROM character_name_history
+WHERE world_id = sqlc.arg(world_id)
+  AND (lower(old_name) = lower(sqlc.arg(name)) OR lower(new_name) = lower(sqlc.arg(name)))
+ORDER BY renamed_at DESC, id DESC
+LIMIT sqlc.arg(max_results);
+
+-- name: ListCharactersByWorldAndName :many
+SELECT * FROM characters
+WHERE world_id = $1 AND lower(name) = lower(sqlc.arg(name))
+ORDER BY created_at;
@@ -0,0 +1,37 @@
+-- Character Status Effect Operations
+
+-- name: ListActiveStatusEffects :many
+SELECT * FROM character_status_effects
+WHERE character_id = ANY(@character_ids::uuid[]) AND expires_at > @now
+ORDER BY character_id, expires_at, effect_key;
+
+-- name: ApplyStatusEffect :one
+-- Applies an effect in one statement so concurrent applications stack correctly. An
+-- expired row is replaced outright; otherwise "intensity" adds a stack (up to
+-- max_stacks) and "extend" adds the new duration to the time remaining.
+INSERT INTO character_status_effects (character_id, effect_key, effect_type, magnitude, stacks, source, applied_at, expires_at)
+VALUES (@character_id, @effect_key, @effect_type, @magnitude, 1, @source, @applied_at, @expires_at)
+ON CONFLICT (character_id, effect_key) DO UPDATE
+SET effect_type = EXCLUDED.effect_type,
+    magnitude = EXCLUDED.magnitude,
+    source = EXCLUDED.source,
+    applied_at = EXCLUDED.applied_at,
+    stacks = CASE
+      WHEN character_status_effects.expires_at <= EXCLUDED.applied_at THEN 1
+      WHEN @stacking::text = 'intensity' THEN LEAST(character_status_effects.stacks + 1, @max_stacks::integer)
+      ELSE character_status_effects.stacks
+    END,
+    expires_at = CASE
+      WHEN @stacking::text = 'extend' AND character_status_effects.expires_at > EXCLUDED.applied_at
+        THEN character_status_effects.expires_at + (EXCLUDED.expires_at - EXCLUDED.applied_at)
+      ELSE EXCLUDED.expires_at
+    END
+RETURNING *;
+
+-- name: RemoveStatusEffect :execrows
+DELETE FROM character_status_effects
+WHERE character_id = $1 AND effect_key = $2;
+
+-- name: DeleteExpiredStatusEffects :execrows
+DELETE FROM character_status_effects
+WHERE expires_at <= $1;
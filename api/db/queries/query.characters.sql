@@ -1,12 +1,21 @@
 -- name: CreateCharacter :one
-INSERT INTO characters (user_id, name, x, y, chunk_x, chunk_y)
-VALUES ($1, $2, $3, $4, $5, $6)
+-- A NULL world_id places the character in the default (oldest) world
+INSERT INTO characters (user_id, world_id, name, x, y, chunk_x, chunk_y)
+VALUES (
+  $1,
+  COALESCE(sqlc.narg(world_id)::uuid, (SELECT id FROM worlds ORDER BY created_at ASC LIMIT 1)),
+  $2, $3, $4, $5, $6
+)
 RETURNING *;
 
 -- name: GetCharacterById :one
 SELECT * FROM characters
 WHERE id = $1;
 
+-- name: ListCharactersByIds :many
+SELECT * FROM characters
+WHERE id = ANY(sqlc.arg(ids)::uuid[]);
+
 -- name: GetCharacterByUserAndName :one
 SELECT * FROM characters
 WHERE user_id = $1 AND name = $2;
@@ -16,9 +25,21 @@ SELECT * FROM characters
 WHERE user_id = $1
 ORDER BY created_at DESC;
 
+-- name: GetCharactersByUserInWorld :many
+SELECT * FROM characters
+WHERE user_id = $1 AND world_id = $2
+ORDER BY created_at DESC;
+
 -- name: UpdateCharacterPosition :one
 UPDATE characters
-SET x = $2, y = $3, chunk_x = $4, chunk_y = $5
+SET x = $2, y = $3, chunk_x = $4, chunk_y = $5, facing = $6, action_state = $7
+WHERE id = $1
+RETURNING *;
+
+-- name: UpdateCharacterActionState :one
+-- Changes what the character is doing without moving it
+UPDATE characters
+SET action_state = $2
 WHERE id = $1
 RETURNING *;
 
@@ -28,4 +49,14 @@ WHERE id = $1;
 
 -- name: GetCharactersInChunk :many
 SELECT * FROM characters
-WHERE chunk_x = $1 AND chunk_y = $2;
\ No newline at end of file
+WHERE world_id = $1 AND chunk_x = $2 AND chunk_y = $3;
+
+-- name: ListCharactersInArea :many
+-- The chunk range narrows the scan to the position index before the exact cell bounds
+SELECT * FROM characters
+WHERE world_id = sqlc.arg(world_id)
+  AND chunk_x BETWEEN sqlc.arg(min_chunk_x) AND sqlc.arg(max_chunk_x)
+  AND chunk_y BETWEEN sqlc.arg(min_chunk_y) AND sqlc.arg(max_chunk_y)
+  AND x BETWEEN sqlc.arg(min_x) AND sqlc.arg(max_x)
+  AND y BETWEEN sqlc.arg(min_y) AND sqlc.arg(max_y)
+ORDER BY id;
@@ -0,0 +1,24 @@
+-- Chunk Delta Operations
+
+-- name: CreateChunkDelta :one
+INSERT INTO chunk_deltas (world_id, chunk_x, chunk_y, cell_index, terrain_type, previous_terrain_type, character_id, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING *;
+
+-- name: ListChunkDeltas :many
+SELECT * FROM chunk_deltas
+WHERE world_id = $1 AND chunk_x = $2 AND chunk_y = $3
+ORDER BY id;
+
+-- name: ListCompactableChunks :many
+-- Chunks with enough pending deltas, or with deltas old enough, to fold into the base blob
+SELECT world_id, chunk_x, chunk_y, count(*)::integer AS delta_count
+FROM chunk_deltas
+GROUP BY world_id, chunk_x, chunk_y
+HAVING count(*) >= sqlc.arg(min_deltas)::integer OR min(created_at) <= sqlc.arg(created_before)::timestamp
+ORDER BY count(*) DESC
+LIMIT sqlc.arg(max_chunks)::integer;
+
+-- name: DeleteChunkDeltas :execrows
+DELETE FROM chunk_deltas
+WHERE id = ANY(sqlc.arg(ids)::bigint[]);
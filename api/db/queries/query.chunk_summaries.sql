@@ -0,0 +1,62 @@
+-- Chunk Summary Projection
+
+-- name: UpsertChunkSummary :one
+-- Harvest counts are accumulated separately and survive a rebuild
+INSERT INTO chunk_summaries (world_id, chunk_x, chunk_y, terrain_histogram, node_counts, last_modified)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (world_id, chunk_x, chunk_y) DO UPDATE
+SET terrain_histogram = EXCLUDED.terrain_histogram,
+    node_counts = EXCLUDED.node_counts,
+    last_modified = EXCLUDED.last_modified
+RETURNING *;
+
+-- name: IncrementChunkHarvestCount :execrows
+UPDATE chunk_summaries
+SET harvest_count = harvest_count + 1,
+    last_modified = $4
+WHERE world_id = $1 AND chunk_x = $2 AND chunk_y = $3;
+
+-- name: GetChunkSummariesInRange :many
+SELECT * FROM chunk_summaries
+WHERE world_id = $1 AND
+      chunk_x >= $2 AND chunk_x <= $3 AND
+      chunk_y >= $4 AND chunk_y <= $5
+ORDER BY chunk_y, chunk_x;
+
+-- name: CountResourceNodesByTypeInChunk :many
+SELECT resource_node_type_id, COUNT(*) AS node_count
+FROM resource_nodes
+WHERE world_id = $1 AND chunk_x = $2 AND chunk_y = $3
+GROUP BY resource_node_type_id;
+
+-- name: ListStaleChunkSummaries :many
+-- Chunks with no summary yet, or whose resource nodes were written after the
+-- summary (node generation commits separately from the chunk itself)
+SELECT c.world_id, c.chunk_x, c.chunk_y
+FROM chunks c
+LEFT JOIN chunk_summaries cs
+  ON cs.world_id = c.world_id AND cs.chunk_x = c.chunk_x AND cs.chunk_y = c.chunk_y
+WHERE cs.world_id IS NULL
+   OR cs.last_modified < (
+     SELECT MAX(rn.created_at) FROM resource_nodes rn
+     WHERE rn.world_id = c.world_id AND rn.chunk_x = c.chunk_x AND rn.chunk_y = c.chunk_y
+   )
+LIMIT $1;
+
+-- name: SumChunkHarvestsByRegion :many
+-- Regions are squares of region_size chunks; flooring keeps negative chunks in the right region.
+-- Regions come in (region_y, region_x) order after the cursor, at most row_limit of them, so
+-- an update can work through a large world in batches; min_chunk_y is the cursor row's
+-- first chunk row and lets the scan skip the rows before it
+SELECT FLOOR(chunk_x::float8 / sqlc.arg(region_size)::integer)::integer AS region_x,
+       FLOOR(chunk_y::float8 / sqlc.arg(region_size)::integer)::integer AS region_y,
+       SUM(harvest_count)::bigint AS harvest_count,
+       COUNT(*)::integer AS chunk_count
+FROM chunk_summaries
+WHERE world_id = sqlc.arg(world_id)
+  AND chunk_y >= sqlc.arg(min_chunk_y)::integer
+GROUP BY 1, 2
+HAVING (FLOOR(chunk_y::float8 / sqlc.arg(region_size)::integer)::integer, FLOOR(chunk_x::float8 / sqlc.arg(region_size)::integer)::integer)
+       > (sqlc.arg(after_region_y)::integer, sqlc.arg(after_region_x)::integer)
+ORDER BY 2, 1
+LIMIT sqlc.arg(row_limit)::integer;
@@ -1,6 +1,6 @@
 -- name: CreateChunk :one
-INSERT INTO chunks (world_id, chunk_x, chunk_y, chunk_data)
-VALUES ($1, $2, $3, $4)
+INSERT INTO chunks (world_id, chunk_x, chunk_y, chunk_data, passability)
+VALUES ($1, $2, $3, $4, $5)
 RETURNING *;
 
 -- name: GetChunk :one
@@ -14,6 +14,24 @@ AND chunk_x >= $2 AND chunk_x <= $3
 AND chunk_y >= $4 AND chunk_y <= $5
 ORDER BY chunk_x, chunk_y;
 
+-- name: GetChunkPassability :one
+SELECT passability FROM chunks
+WHERE world_id = $1 AND chunk_x = $2 AND chunk_y = $3;
+
+-- name: BackfillChunkPassability :exec
+-- Stores a mask computed on read for a chunk that has none, or has one of the wrong size
+UPDATE chunks
+SET passability = $4
+WHERE world_id = $1 AND chunk_x = $2 AND chunk_y = $3
+  AND (passability IS NULL OR octet_length(passability) <> octet_length($4));
+
+-- name: SetCellPassability :exec
+-- Updates one cell's bit; chunks without a valid mask are left to be backfilled on read
+UPDATE chunks
+SET passability = set_bit(passability, sqlc.arg(cell_index)::integer, sqlc.arg(passable)::integer)
+WHERE world_id = sqlc.arg(world_id) AND chunk_x = sqlc.arg(chunk_x) AND chunk_y = sqlc.arg(chunk_y)
+  AND octet_length(passability) * 8 > sqlc.arg(cell_index)::integer;
+
 -- name: ChunkExists :one
 SELECT EXISTS(
     SELECT 1 FROM chunks
@@ -22,4 +40,55 @@ SELECT EXISTS(
 
 -- name: DeleteChunk :exec
 DELETE FROM chunks
-WHERE world_id = $1 AND chunk_x = $2 AND chunk_y = $3;
\ No newline at end of file
+WHERE world_id = $1 AND chunk_x = $2 AND chunk_y = $3;
+-- name: UpdateChunkData :exec
+UPDATE chunks
+SET chunk_data = $4, passability = $5
+WHERE world_id = $1 AND chunk_x = $2 AND chunk_y = $3;
+
+-- name: QuarantineChunk :exec
+-- The mask is cleared because it included edits; it is recomputed from the repaired chunk
+UPDATE chunks
+SET corrupt_chunk_data = chunk_data, chunk_data = $4, quarantined_at = $5, passability = NULL
+WHERE world_id = $1 AND chunk_x = $2 AND chunk_y = $3;
+
+-- name: RecordChunkAccesses :exec
+-- Adds a batch of counted reads; each chunk's last access only moves forward
+UPDATE chunks c
+SET access_count = c.access_count + a.hits,
+    last_accessed_at = GREATEST(c.last_accessed_at, a.accessed_at)
+FROM (
+    -- Set-returning functions in one select list are zipped row by row
+    SELECT
+      unnest(sqlc.arg(world_ids)::uuid[]) AS world_id,
+      unnest(sqlc.arg(chunk_xs)::integer[]) AS chunk_x,
+      unnest(sqlc.arg(chunk_ys)::integer[]) AS chunk_y,
+      unnest(sqlc.arg(hits)::bigint[]) AS hits,
+      unnest(sqlc.arg(accessed_ats)::timestamp[]) AS accessed_at
+) a
+WHERE c.world_id = a.world_id AND c.chunk_x = a.chunk_x AND c.chunk_y = a.chunk_y;
+
+-- name: ListColdChunks :many
+-- A world's chunks not read since a time, least recently read first
+SELECT world_id, chunk_x, chunk_y, generated_at, last_accessed_at, access_count
+FROM chunks
+WHERE world_id = sqlc.arg(world_id)
+  AND COALESCE(last_accessed_at, generated_at) < sqlc.arg(idle_since)
+ORDER BY COALESCE(last_accessed_at, generated_at), chunk_x, chunk_y
+LIMIT sqlc.arg(max_chunks);
+
+-- name: ListMostAccessedChunks :many
+SELECT world_id, chunk_x, chunk_y, generated_at, last_accessed_at, access_count
+FROM chunks
+WHERE world_id = $1
+ORDER BY access_count DESC, chunk_x, chunk_y
+LIMIT sqlc.arg(max_chunks);
+
+-- name: ListRecentlyAccessedChunks :many
+-- A world's chunks read since a time, i.e. chunks players are in or near
+SELECT chunk_x, chunk_y
+FROM chunks
+WHERE world_id = sqlc.arg(world_id)
+  AND last_accessed_at >= sqlc.arg(accessed_since)
+ORDER BY chunk_x, chunk_y
+LIMIT sqlc.arg(max_chunks);
@@ -0,0 +1,43 @@
+-- Compass Operations
+
+-- name: CreateWaypoint :one
+INSERT INTO character_waypoints (character_id, name, x, y, created_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: CountWaypoints :one
+SELECT COUNT(*) FROM character_waypoints
+WHERE character_id = $1;
+
+-- name: ListWaypoints :many
+SELECT * FROM character_waypoints
+WHERE character_id = $1
+ORDER BY id;
+
+-- name: DeleteWaypoint :execrows
+DELETE FROM character_waypoints
+WHERE id = $1 AND character_id = $2;
+
+-- name: CreateDiscovery :exec
+INSERT INTO character_discoveries (character_id, resource_node_id, discovered_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (character_id, resource_node_id) DO NOTHING;
+
+-- name: ListDiscoveredNodes :many
+-- Nodes removed since they were discovered drop out with their discovery
+SELECT rn.*
+FROM character_discoveries cd
+JOIN resource_nodes rn ON rn.id = cd.resource_node_id
+WHERE cd.character_id = $1
+ORDER BY cd.discovered_at;
+
+-- name: ListFriendCharactersInWorld :many
+-- Characters of the user's accepted friends in a world
+SELECT c.*
+FROM characters c
+JOIN friendships f ON f.status = 'accepted' AND (
+    (f.requester_id = sqlc.arg(user_id) AND f.addressee_id = c.user_id) OR
+    (f.addressee_id = sqlc.arg(user_id) AND f.requester_id = c.user_id)
+)
+WHERE c.world_id = sqlc.arg(world_id)
+ORDER BY c.name;
@@ -0,0 +1,29 @@
+-- name: ListLatestConsentDocuments :many
+-- The current version of each kind
+SELECT DISTINCT ON (kind) * FROM consent_documents
+ORDER BY kind, version DESC;
+
+-- name: ListRequiredConsentVersions :many
+SELECT kind, max(version)::integer AS version FROM consent_documents
+WHERE required
+GROUP BY kind;
+
+-- name: PublishConsentDocument :one
+-- Versions are numbered per kind; two concurrent publishes collide on the primary key
+INSERT INTO consent_documents (kind, version, url, summary, required, published_by, published_at)
+SELECT sqlc.arg(kind)::text, coalesce(max(version), 0) + 1, sqlc.arg(url)::text, sqlc.arg(summary)::text,
+  sqlc.arg(required)::boolean, sqlc.arg(published_by)::uuid, sqlc.arg(published_at)::timestamp
+FROM consent_documents
+WHERE kind = sqlc.arg(kind)
+RETURNING *;
+
+-- name: ListLatestUserConsents :many
+-- The latest version of each kind the user accepted
+SELECT DISTINCT ON (kind) * FROM user_consents
+WHERE user_id = $1
+ORDER BY kind, version DESC;
+
+-- name: CreateUserConsent :exec
+INSERT INTO user_consents (user_id, kind, version, accepted_at, ip_address)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (user_id, kind, version) DO NOTHING;
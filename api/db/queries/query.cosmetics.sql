@@ -0,0 +1,25 @@
+-- name: UnlockCosmetic :execrows
+-- Does nothing when the character has already unlocked the cosmetic
+INSERT INTO character_cosmetics (character_id, cosmetic_id, unlocked_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (character_id, cosmetic_id) DO NOTHING;
+
+-- name: ListCharacterCosmetics :many
+SELECT * FROM character_cosmetics
+WHERE character_id = $1
+ORDER BY unlocked_at, cosmetic_id;
+
+-- name: EquipCharacterTitle :execrows
+-- Sets the character's title, or clears it with an empty title. Does nothing unless the
+-- character has unlocked the title.
+UPDATE characters
+SET equipped_title = sqlc.arg(title)::text
+WHERE id = sqlc.arg(character_id)
+  AND (
+    sqlc.arg(title)::text = ''
+    OR EXISTS (
+      SELECT 1 FROM character_cosmetics
+      WHERE character_cosmetics.character_id = characters.id
+        AND character_cosmetics.cosmetic_id = sqlc.arg(title)::text
+    )
+  );
@@ -0,0 +1,26 @@
+-- Direct Message Operations
+
+-- name: CreateDirectMessage :one
+INSERT INTO direct_messages (sender_id, recipient_id, body, created_at)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: ListUndeliveredDirectMessages :many
+SELECT * FROM direct_messages
+WHERE recipient_id = $1 AND delivered_at IS NULL
+ORDER BY id
+LIMIT $2;
+
+-- name: MarkDirectMessagesDelivered :exec
+UPDATE direct_messages
+SET delivered_at = sqlc.arg(delivered_at)
+WHERE id = ANY(sqlc.arg(ids)::bigint[]) AND delivered_at IS NULL;
+
+-- name: ListConversation :many
+-- Messages between two users before a message ID, newest first
+SELECT * FROM direct_messages
+WHERE ((sender_id = sqlc.arg(user_a) AND recipient_id = sqlc.arg(user_b))
+    OR (sender_id = sqlc.arg(user_b) AND recipient_id = sqlc.arg(user_a)))
+  AND id < sqlc.arg(before_id)
+ORDER BY id DESC
+LIMIT sqlc.arg(max_messages);
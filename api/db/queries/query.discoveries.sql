@@ -0,0 +1,27 @@
+-- First Discovery Operations
+
+-- name: CreateChunkDiscovery :execrows
+-- Does nothing when the chunk was already credited
+INSERT INTO chunk_discoveries (world_id, chunk_x, chunk_y, character_id, character_name, discovered_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (world_id, chunk_x, chunk_y) DO NOTHING;
+
+-- name: CreateResourceDiscovery :execrows
+-- Does nothing when the node type was already harvested in the region
+INSERT INTO resource_discoveries (world_id, region_x, region_y, resource_node_type_id, chunk_x, chunk_y, character_id, character_name, discovered_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT (world_id, region_x, region_y, resource_node_type_id) DO NOTHING;
+
+-- name: ListChunkDiscoveriesInRange :many
+SELECT * FROM chunk_discoveries
+WHERE world_id = sqlc.arg(world_id)
+  AND chunk_x BETWEEN sqlc.arg(min_chunk_x) AND sqlc.arg(max_chunk_x)
+  AND chunk_y BETWEEN sqlc.arg(min_chunk_y) AND sqlc.arg(max_chunk_y);
+
+-- name: ListResourceDiscoveriesInRange :many
+-- Resource discoveries whose first harvest happened in the range
+SELECT * FROM resource_discoveries
+WHERE world_id = sqlc.arg(world_id)
+  AND chunk_x BETWEEN sqlc.arg(min_chunk_x) AND sqlc.arg(max_chunk_x)
+  AND chunk_y BETWEEN sqlc.arg(min_chunk_y) AND sqlc.arg(max_chunk_y)
+ORDER BY discovered_at;
@@ -0,0 +1,62 @@
+-- Dungeon Operations
+
+-- name: CreateDungeonInstance :one
+INSERT INTO dungeon_instances (world_id, leader_id, seed, created_at, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetDungeonInstance :one
+SELECT * FROM dungeon_instances
+WHERE id = $1;
+
+-- name: DeleteDungeonInstance :execrows
+DELETE FROM dungeon_instances
+WHERE id = $1;
+
+-- name: ListExpiredDungeonInstances :many
+SELECT * FROM dungeon_instances
+WHERE expires_at <= $1
+ORDER BY id
+LIMIT $2;
+
+-- name: AddDungeonMember :execrows
+-- Does nothing when the character is already bound to an instance
+INSERT INTO dungeon_members (character_id, dungeon_id, joined_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (character_id) DO NOTHING;
+
+-- name: GetDungeonMember :one
+SELECT * FROM dungeon_members
+WHERE character_id = $1;
+
+-- name: ListDungeonMembers :many
+SELECT * FROM dungeon_members
+WHERE dungeon_id = $1
+ORDER BY joined_at, character_id;
+
+-- name: EnterDungeon :execrows
+-- Does nothing when the character is already inside
+UPDATE dungeon_members
+SET inside = true, x = $2, y = $3
+WHERE character_id = $1 AND NOT inside;
+
+-- name: UpdateDungeonPosition :execrows
+UPDATE dungeon_members
+SET x = $2, y = $3
+WHERE character_id = $1 AND inside;
+
+-- name: LeaveDungeon :execrows
+UPDATE dungeon_members
+SET inside = false
+WHERE character_id = $1 AND inside;
+
+-- name: ListDungeonHarvests :many
+SELECT * FROM dungeon_harvests
+WHERE dungeon_id = $1
+ORDER BY node_id;
+
+-- name: CreateDungeonHarvest :execrows
+-- Does nothing when the node was already harvested
+INSERT INTO dungeon_harvests (dungeon_id, node_id, character_id, harvested_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (dungeon_id, node_id) DO NOTHING;
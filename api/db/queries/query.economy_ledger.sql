@@ -0,0 +1,18 @@
+-- name: RecordEconomyFlow :execrows
+-- Records a currency movement; a dedup key already recorded is skipped
+INSERT INTO economy_ledger (world_id, source, created, destroyed, dedup_key, recorded_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (dedup_key) DO NOTHING;
+
+-- name: GetEconomyStats :many
+-- Currency created and destroyed per period and source since a time. Period is a
+-- date_trunc field such as 'day' or 'hour'.
+SELECT
+  date_trunc(sqlc.arg(period)::text, recorded_at)::timestamp AS period_start,
+  source,
+  SUM(created)::bigint AS created,
+  SUM(destroyed)::bigint AS destroyed
+FROM economy_ledger
+WHERE world_id = $1 AND recorded_at >= sqlc.arg(since)
+GROUP BY period_start, source
+ORDER BY period_start, source;
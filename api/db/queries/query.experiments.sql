@@ -0,0 +1,45 @@
+-- Experiment Operations
+
+-- name: CreateExperiment :one
+-- Returns no rows when an experiment with the name exists
+INSERT INTO experiments (name, description, subject, variants, started_at, created_by)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (name) DO NOTHING
+RETURNING *;
+
+-- name: ListExperiments :many
+SELECT * FROM experiments
+ORDER BY started_at, name;
+
+-- name: ListActiveExperiments :many
+-- Experiments a subject created at created_at takes part in
+SELECT * FROM experiments
+WHERE subject = sqlc.arg(subject)
+  AND stopped_at IS NULL
+  AND started_at <= sqlc.arg(created_at)
+ORDER BY name;
+
+-- name: StopExperiment :one
+UPDATE experiments
+SET stopped_at = $2
+WHERE name = $1 AND stopped_at IS NULL
+RETURNING *;
+
+-- name: CreateExperimentAssignment :one
+-- Returns no rows when the subject is already assigned
+INSERT INTO experiment_assignments (experiment, subject_id, variant, assigned_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (experiment, subject_id) DO NOTHING
+RETURNING *;
+
+-- name: ListExperimentAssignments :many
+SELECT a.experiment, a.variant, e.variants
+FROM experiment_assignments a
+JOIN experiments e ON e.name = a.experiment
+WHERE a.subject_id = $1
+ORDER BY a.experiment;
+
+-- name: CountExperimentAssignments :many
+SELECT experiment, variant, COUNT(*) AS assignments
+FROM experiment_assignments
+GROUP BY experiment, variant;
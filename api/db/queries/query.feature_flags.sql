@@ -0,0 +1,23 @@
+-- Feature Flag Operations
+
+-- name: UpsertFeatureFlag :one
+INSERT INTO feature_flags (name, description, enabled, rollout_percentage, user_ids, world_ids, updated_by, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (name) DO UPDATE
+SET description = EXCLUDED.description,
+    enabled = EXCLUDED.enabled,
+    rollout_percentage = EXCLUDED.rollout_percentage,
+    user_ids = EXCLUDED.user_ids,
+    world_ids = EXCLUDED.world_ids,
+    updated_by = EXCLUDED.updated_by,
+    updated_at = EXCLUDED.updated_at
+RETURNING *;
+
+-- name: ListFeatureFlags :many
+SELECT * FROM feature_flags
+ORDER BY name;
+
+-- name: DeleteFeatureFlag :one
+DELETE FROM feature_flags
+WHERE name = $1
+RETURNING *;
@@ -0,0 +1,42 @@
+-- Friendship Operations
+
+-- name: CreateFriendRequest :one
+INSERT INTO friendships (requester_id, addressee_id, created_at)
+VALUES ($1, $2, $3)
+ON CONFLICT DO NOTHING
+RETURNING *;
+
+-- name: GetFriendshipBetween :one
+SELECT * FROM friendships
+WHERE (requester_id = sqlc.arg(user_a) AND addressee_id = sqlc.arg(user_b))
+   OR (requester_id = sqlc.arg(user_b) AND addressee_id = sqlc.arg(user_a));
+
+-- name: AcceptFriendRequest :one
+UPDATE friendships
+SET status = 'accepted', responded_at = $3
+WHERE requester_id = $1 AND addressee_id = $2 AND status = 'pending'
+RETURNING *;
+
+-- name: DeclineFriendRequest :execrows
+DELETE FROM friendships
+WHERE requester_id = $1 AND addressee_id = $2 AND status = 'pending';
+
+-- name: DeleteFriendship :execrows
+DELETE FROM friendships
+WHERE (requester_id = sqlc.arg(user_a) AND addressee_id = sqlc.arg(user_b))
+   OR (requester_id = sqlc.arg(user_b) AND addressee_id = sqlc.arg(user_a));
+
+-- name: ListFriendships :many
+-- Friendships and pending requests involving the user, with the other user's names
+SELECT
+  f.requester_id,
+  f.addressee_id,
+  f.status,
+  f.created_at,
+  u.id AS other_user_id,
+  u.username AS other_username,
+  u.display_name AS other_display_name
+FROM friendships f
+JOIN users u ON u.id = CASE WHEN f.requester_id = sqlc.arg(user_id) THEN f.addressee_id ELSE f.requester_id END
+WHERE f.requester_id = sqlc.arg(user_id) OR f.addressee_id = sqlc.arg(user_id)
+ORDER BY u.username;
@@ -0,0 +1,62 @@
+-- Interior Operations
+
+-- name: GetInterior :one
+SELECT * FROM interiors
+WHERE id = $1;
+
+-- name: GetInteriorByStructure :one
+SELECT * FROM interiors
+WHERE structure_id = $1;
+
+-- name: CreateInterior :one
+-- Returns no row when the structure's interior was created concurrently
+INSERT INTO interiors (structure_id, world_id, template, width, height, cells, door_x, door_y, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT (structure_id) DO NOTHING
+RETURNING *;
+
+-- name: GetCharacterInterior :one
+SELECT * FROM character_interiors
+WHERE character_id = $1;
+
+-- name: EnterInterior :execrows
+-- Does nothing when the character is already inside an interior
+INSERT INTO character_interiors (character_id, interior_id, x, y, entered_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (character_id) DO NOTHING;
+
+-- name: UpdateCharacterInteriorPosition :execrows
+UPDATE character_interiors
+SET x = $2, y = $3
+WHERE character_id = $1;
+
+-- name: ExitInterior :execrows
+DELETE FROM character_interiors
+WHERE character_id = $1;
+
+-- name: ListInteriorFurniture :many
+SELECT
+  interior_furniture.*,
+  items.name AS item_name
+FROM interior_furniture
+JOIN items ON items.id = interior_furniture.item_id
+WHERE interior_furniture.interior_id = $1
+ORDER BY interior_furniture.id;
+
+-- name: InteriorFurnitureExistsAt :one
+SELECT EXISTS (
+  SELECT 1 FROM interior_furniture
+  WHERE interior_id = $1 AND x = $2 AND y = $3
+);
+
+-- name: CreateInteriorFurniture :one
+-- Returns no row when the cell is already furnished
+INSERT INTO interior_furniture (interior_id, item_id, x, y, placed_by, placed_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (interior_id, x, y) DO NOTHING
+RETURNING *;
+
+-- name: DeleteInteriorFurniture :one
+DELETE FROM interior_furniture
+WHERE id = $1 AND interior_id = $2
+RETURNING *;
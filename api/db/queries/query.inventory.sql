@@ -90,4 +90,39 @@ WHERE character_id = $1;
 
 -- name: GetInventoryItemTotalQuantity :one
 SELECT COALESCE(SUM(quantity), 0) FROM character_inventories
-WHERE character_id = $1;
\ No newline at end of file
+WHERE character_id = $1;
+
+-- name: ListDuplicateInventoryStacks :many
+-- Rows sharing a character and item. The unique constraint prevents them, but databases
+-- created before it was added may still have some
+SELECT
+  character_id,
+  item_id,
+  array_agg(id ORDER BY id)::integer[] AS inventory_ids,
+  SUM(quantity)::integer AS total_quantity
+FROM character_inventories
+GROUP BY character_id, item_id
+HAVING COUNT(*) > 1
+ORDER BY character_id, item_id;
+
+-- name: ListOverfullInventoryStacks :many
+SELECT ci.id, ci.character_id, ci.item_id, ci.quantity, i.stack_size
+FROM character_inventories ci
+JOIN items i ON ci.item_id = i.id
+WHERE ci.quantity > i.stack_size
+ORDER BY ci.id;
+
+-- name: MergeInventoryStacks :one
+-- Moves the quantity of every other row for the character and item into keep_id and
+-- deletes them, in one statement
+WITH merged AS (
+  DELETE FROM character_inventories d
+  WHERE d.character_id = @character_id AND d.item_id = @item_id AND d.id <> @keep_id
+  RETURNING d.quantity
+)
+UPDATE character_inventories ci
+SET
+  quantity = ci.quantity + (SELECT COALESCE(SUM(m.quantity), 0) FROM merged m)::integer,
+  updated_at = NOW()
+WHERE ci.id = @keep_id
+RETURNING ci.quantity;
@@ -0,0 +1,77 @@
+-- name: GetWorldInventorySettings :one
+SELECT * FROM world_inventory_settings
+WHERE world_id = $1;
+
+-- name: UpsertWorldInventorySettings :one
+INSERT INTO world_inventory_settings (world_id, inventory_slots, harvest_overflow, updated_by, updated_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (world_id) DO UPDATE
+SET
+  inventory_slots = EXCLUDED.inventory_slots,
+  harvest_overflow = EXCLUDED.harvest_overflow,
+  updated_by = EXCLUDED.updated_by,
+  updated_at = EXCLUDED.updated_at
+RETURNING *;
+
+-- name: CreateGroundDrop :one
+INSERT INTO ground_drops (world_id, x, y, item_id, quantity, dropped_by, created_at, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING *;
+
+-- name: ListGroundDropsNear :many
+SELECT gd.*, i.name AS item_name, i.stack_size
+FROM ground_drops gd
+JOIN items i ON gd.item_id = i.id
+WHERE gd.world_id = @world_id
+  AND gd.x BETWEEN @min_x::integer AND @max_x::integer
+  AND gd.y BETWEEN @min_y::integer AND @max_y::integer
+  AND gd.expires_at > @now
+ORDER BY gd.id;
+
+-- name: GetGroundDropForUpdate :one
+SELECT gd.*, i.name AS item_name, i.stack_size
+FROM ground_drops gd
+JOIN items i ON gd.item_id = i.id
+WHERE gd.id = $1
+FOR UPDATE OF gd;
+
+-- name: UpdateGroundDropQuantity :exec
+UPDATE ground_drops
+SET quantity = $2
+WHERE id = $1;
+
+-- name: DeleteGroundDrop :exec
+DELETE FROM ground_drops
+WHERE id = $1;
+
+-- name: DeleteExpiredGroundDrops :execrows
+DELETE FROM ground_drops
+WHERE expires_at <= $1;
+
+-- name: CreateInboxItem :one
+INSERT INTO inbox_items (character_id, item_id, quantity, reason, created_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: ListInboxItems :many
+SELECT ii.*, i.name AS item_name, i.stack_size
+FROM inbox_items ii
+JOIN items i ON ii.item_id = i.id
+WHERE ii.character_id = $1
+ORDER BY ii.id;
+
+-- name: GetInboxItemForUpdate :one
+SELECT ii.*, i.name AS item_name, i.stack_size
+FROM inbox_items ii
+JOIN items i ON ii.item_id = i.id
+WHERE ii.id = $1
+FOR UPDATE OF ii;
+
+-- name: UpdateInboxItemQuantity :exec
+UPDATE inbox_items
+SET quantity = $2
+WHERE id = $1;
+
+-- name: DeleteInboxItem :exec
+DELETE FROM inbox_items
+WHERE id = $1;
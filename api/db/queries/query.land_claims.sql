@@ -0,0 +1,46 @@
+-- Land Claim Operations
+
+-- name: CreateLandClaim :one
+-- Returns no rows when the chunk is already claimed
+INSERT INTO land_claims (world_id, chunk_x, chunk_y, character_id, claimed_at, paid_until)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (world_id, chunk_x, chunk_y) DO NOTHING
+RETURNING *;
+
+-- name: GetLandClaimAt :one
+SELECT * FROM land_claims
+WHERE world_id = $1 AND chunk_x = $2 AND chunk_y = $3;
+
+-- name: CountLandClaimsByCharacter :one
+SELECT COUNT(*) FROM land_claims
+WHERE character_id = $1;
+
+-- name: ListLandClaimsByCharacter :many
+SELECT * FROM land_claims
+WHERE character_id = $1
+ORDER BY id;
+
+-- name: ListLandClaimsInRange :many
+SELECT * FROM land_claims
+WHERE world_id = sqlc.arg(world_id)
+  AND chunk_x BETWEEN sqlc.arg(min_chunk_x)::integer AND sqlc.arg(max_chunk_x)::integer
+  AND chunk_y BETWEEN sqlc.arg(min_chunk_y)::integer AND sqlc.arg(max_chunk_y)::integer
+ORDER BY id;
+
+-- name: ListLandClaimsDue :many
+-- Claims in paused worlds are left alone until the world is resumed
+SELECT * FROM land_claims
+WHERE paid_until <= $1
+  AND world_id NOT IN (SELECT world_id FROM world_pauses)
+ORDER BY paid_until, id
+LIMIT $2;
+
+-- name: ExtendLandClaim :exec
+UPDATE land_claims
+SET paid_until = $2
+WHERE id = $1;
+
+-- name: DeleteLandClaim :one
+DELETE FROM land_claims
+WHERE id = $1 AND character_id = $2
+RETURNING *;
@@ -0,0 +1,49 @@
+-- Mail Operations
+
+-- name: CreateMail :one
+INSERT INTO mail (recipient_id, sender_id, sender_name, subject, body, currency, returned, created_at, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING *;
+
+-- name: CreateMailAttachment :exec
+INSERT INTO mail_attachments (mail_id, item_id, quantity)
+VALUES ($1, $2, $3);
+
+-- name: ListMail :many
+-- The character's newest mail first
+SELECT * FROM mail
+WHERE recipient_id = $1
+ORDER BY id DESC
+LIMIT sqlc.arg(max_mail);
+
+-- name: ListMailAttachments :many
+SELECT ma.*, i.name AS item_name, i.stack_size
+FROM mail_attachments ma
+JOIN items i ON ma.item_id = i.id
+WHERE ma.mail_id = ANY(sqlc.arg(mail_ids)::bigint[])
+ORDER BY ma.id;
+
+-- name: GetMailForUpdate :one
+SELECT * FROM mail
+WHERE id = $1
+FOR UPDATE;
+
+-- name: MarkMailClaimed :exec
+UPDATE mail
+SET claimed_at = $2
+WHERE id = $1;
+
+-- name: MoveMailAttachments :exec
+UPDATE mail_attachments
+SET mail_id = sqlc.arg(to_mail_id)
+WHERE mail_id = sqlc.arg(from_mail_id);
+
+-- name: DeleteMail :exec
+DELETE FROM mail
+WHERE id = $1;
+
+-- name: ListExpiredMail :many
+SELECT id FROM mail
+WHERE expires_at <= $1
+ORDER BY id
+LIMIT $2;
@@ -0,0 +1,17 @@
+-- Maintenance Mode Operations
+
+-- name: GetMaintenanceMode :one
+SELECT * FROM maintenance_mode
+WHERE id;
+
+-- name: UpsertMaintenanceMode :one
+INSERT INTO maintenance_mode (enabled, message, eta, freeze_at, updated_by, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (id) DO UPDATE
+SET enabled = EXCLUDED.enabled,
+    message = EXCLUDED.message,
+    eta = EXCLUDED.eta,
+    freeze_at = EXCLUDED.freeze_at,
+    updated_by = EXCLUDED.updated_by,
+    updated_at = EXCLUDED.updated_at
+RETURNING *;
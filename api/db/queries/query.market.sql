@@ -0,0 +1,76 @@
+-- Market Operations
+
+-- name: CreateMarketListing :one
+INSERT INTO market_listings (world_id, seller_id, item_id, quantity, unit_price, created_at, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: CountMarketListingsBySeller :one
+SELECT COUNT(*) FROM market_listings
+WHERE seller_id = $1;
+
+-- name: GetMarketListingForUpdate :one
+SELECT * FROM market_listings
+WHERE id = $1
+FOR UPDATE;
+
+-- name: DeleteMarketListing :exec
+DELETE FROM market_listings
+WHERE id = $1;
+
+-- name: SearchMarketListings :many
+-- A world's open listings, cheapest first, after the (unit_price, id) cursor. Zero and
+-- empty filters match everything.
+SELECT l.*, i.name AS item_name, i.item_type, i.rarity
+FROM market_listings l
+JOIN items i ON i.id = l.item_id
+WHERE l.world_id = sqlc.arg(world_id)
+  AND l.expires_at > sqlc.arg(now)
+  AND (l.unit_price, l.id) > (sqlc.arg(after_unit_price)::integer, sqlc.arg(after_id)::bigint)
+  AND (sqlc.arg(item_id)::integer = 0 OR l.item_id = sqlc.arg(item_id)::integer)
+  AND (sqlc.arg(item_type)::text = '' OR i.item_type = sqlc.arg(item_type)::text)
+  AND (sqlc.arg(name_prefix)::text = '' OR i.name ILIKE sqlc.arg(name_prefix)::text || '%')
+  AND (sqlc.arg(max_unit_price)::integer = 0 OR l.unit_price <= sqlc.arg(max_unit_price)::integer)
+ORDER BY l.unit_price, l.id
+LIMIT sqlc.arg(max_listings);
+
+-- name: ListMarketListingsBySeller :many
+-- The seller's listings, including expired ones not yet returned, newest first
+SELECT l.*, i.name AS item_name, i.item_type, i.rarity
+FROM market_listings l
+JOIN items i ON i.id = l.item_id
+WHERE l.seller_id = $1
+ORDER BY l.id DESC;
+
+-- name: ListExpiredMarketListings :many
+SELECT id FROM market_listings
+WHERE expires_at <= $1
+ORDER BY expires_at, id
+LIMIT $2;
+
+-- name: CreateMarketSale :one
+INSERT INTO market_sales (world_id, item_id, quantity, unit_price, sold_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id;
+
+-- name: CountMarketSalesUpTo :one
+-- Counts a world's sales up to and including a sale, overall and of one item
+SELECT COUNT(*) AS sales,
+       COUNT(*) FILTER (WHERE item_id = sqlc.arg(item_id)) AS item_sales
+FROM market_sales
+WHERE world_id = sqlc.arg(world_id)
+  AND id <= sqlc.arg(sale_id);
+
+-- name: GetMarketPriceHistory :many
+-- Daily sales of an item since a time; the average is weighted by quantity
+SELECT
+  date_trunc('day', sold_at)::timestamp AS day,
+  COUNT(*) AS sales,
+  SUM(quantity)::bigint AS volume,
+  MIN(unit_price)::integer AS min_unit_price,
+  MAX(unit_price)::integer AS max_unit_price,
+  (SUM(unit_price::bigint * quantity) / SUM(quantity))::integer AS avg_unit_price
+FROM market_sales
+WHERE world_id = $1 AND item_id = $2 AND sold_at >= sqlc.arg(since)
+GROUP BY date_trunc('day', sold_at)
+ORDER BY day;
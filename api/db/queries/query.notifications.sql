@@ -0,0 +1,31 @@
+-- Notification Operations
+
+-- name: CreateNotification :one
+-- Returns no rows when a notification with the same dedup key exists
+INSERT INTO notifications (user_id, type, title, body, data, dedup_key, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (dedup_key) DO NOTHING
+RETURNING *;
+
+-- name: ListNotifications :many
+-- A user's notifications before a notification ID, newest first
+SELECT * FROM notifications
+WHERE user_id = sqlc.arg(user_id)
+  AND id < sqlc.arg(before_id)
+  AND (NOT sqlc.arg(unread_only)::boolean OR read_at IS NULL)
+ORDER BY id DESC
+LIMIT sqlc.arg(max_notifications);
+
+-- name: CountUnreadNotifications :one
+SELECT COUNT(*) FROM notifications
+WHERE user_id = $1 AND read_at IS NULL;
+
+-- name: MarkNotificationsRead :execrows
+UPDATE notifications
+SET read_at = sqlc.arg(read_at)
+WHERE user_id = sqlc.arg(user_id) AND id = ANY(sqlc.arg(ids)::bigint[]) AND read_at IS NULL;
+
+-- name: MarkAllNotificationsRead :execrows
+UPDATE notifications
+SET read_at = $2
+WHERE user_id = $1 AND read_at IS NULL;
@@ -0,0 +1,48 @@
+-- name: InsertOutboxEvent :exec
+-- Duplicate dedup keys are ignored so retried mutations don't enqueue twice
+INSERT INTO outbox_events (event_type, aggregate_id, payload, dedup_key)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (dedup_key) DO NOTHING;
+
+-- name: ClaimOutboxEvents :many
+-- Lease a batch of unpublished events; SKIP LOCKED lets several dispatchers run side by side
+UPDATE outbox_events
+SET locked_until = sqlc.arg(locked_until)::timestamp
+WHERE id IN (
+    SELECT id FROM outbox_events
+    WHERE published_at IS NULL
+    AND (locked_until IS NULL OR locked_until <= sqlc.arg(now)::timestamp)
+    ORDER BY id
+    LIMIT sqlc.arg(batch_size)
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: MarkOutboxEventPublished :exec
+UPDATE outbox_events
+SET published_at = $2, locked_until = NULL
+WHERE id = $1;
+
+-- name: MarkOutboxEventFailed :exec
+-- Keep the lease until the backoff expires so the event is retried later
+UPDATE outbox_events
+SET attempts = attempts + 1, last_error = $2, locked_until = $3
+WHERE id = $1;
+
+-- name: DeletePublishedOutboxEvents :execrows
+DELETE FROM outbox_events
+WHERE published_at IS NOT NULL AND published_at < $1;
+
+-- name: GetLatestOutboxEventID :one
+SELECT COALESCE(MAX(id), 0)::bigint FROM outbox_events;
+
+-- name: OutboxEventExists :one
+-- Published events are deleted after the retention period
+SELECT EXISTS (SELECT 1 FROM outbox_events WHERE id = $1);
+
+-- name: ListOutboxEventsAfter :many
+-- Events of the given types enqueued after a sequence number, for clients catching up
+SELECT * FROM outbox_events
+WHERE id > sqlc.arg(after_id) AND event_type = ANY(sqlc.arg(event_types)::text[])
+ORDER BY id
+LIMIT sqlc.arg(max_events);
@@ -0,0 +1,23 @@
+-- Player Report Operations
+
+-- name: CreatePlayerReport :one
+INSERT INTO player_reports (reporter_id, reported_id, reason, details, context, created_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetPlayerReport :one
+SELECT * FROM player_reports
+WHERE id = $1;
+
+-- name: ListPlayerReports :many
+-- Reports with the given status after a report ID, oldest first
+SELECT * FROM player_reports
+WHERE status = sqlc.arg(status) AND id > sqlc.arg(after_id)
+ORDER BY id
+LIMIT sqlc.arg(max_reports);
+
+-- name: ResolvePlayerReport :one
+UPDATE player_reports
+SET status = 'resolved', resolution = $2, resolved_by = $3, resolved_at = $4
+WHERE id = $1 AND status = 'open'
+RETURNING *;
@@ -0,0 +1,35 @@
+-- Processing Job Operations
+
+-- name: CountProcessingJobsByCharacter :one
+SELECT COUNT(*) FROM processing_jobs
+WHERE character_id = $1;
+
+-- name: CreateProcessingJob :one
+INSERT INTO processing_jobs (character_id, world_id, recipe_id, station_entity_id, outputs, started_at, ready_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: ListProcessingJobsByCharacter :many
+SELECT * FROM processing_jobs
+WHERE character_id = $1
+ORDER BY ready_at, id;
+
+-- name: GetProcessingJobForUpdate :one
+SELECT * FROM processing_jobs
+WHERE id = $1 AND character_id = $2
+FOR UPDATE;
+
+-- name: DeleteProcessingJob :exec
+DELETE FROM processing_jobs
+WHERE id = $1;
+
+-- name: ListUnnotifiedReadyProcessingJobs :many
+SELECT * FROM processing_jobs
+WHERE notified_at IS NULL AND ready_at <= $1
+ORDER BY ready_at, id
+LIMIT $2;
+
+-- name: MarkProcessingJobNotified :execrows
+UPDATE processing_jobs
+SET notified_at = $2
+WHERE id = $1 AND notified_at IS NULL;
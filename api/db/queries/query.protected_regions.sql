@@ -0,0 +1,30 @@
+-- Protected Region Operations
+
+-- name: CreateProtectedRegion :one
+INSERT INTO protected_regions (world_id, name, flags, polygon, min_x, min_y, max_x, max_y, created_by, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $10)
+RETURNING *;
+
+-- name: UpdateProtectedRegion :one
+UPDATE protected_regions
+SET name = $2, flags = $3, polygon = $4, min_x = $5, min_y = $6, max_x = $7, max_y = $8, updated_at = $9
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteProtectedRegion :one
+DELETE FROM protected_regions
+WHERE id = $1
+RETURNING *;
+
+-- name: ListProtectedRegions :many
+SELECT * FROM protected_regions
+WHERE world_id = $1
+ORDER BY id;
+
+-- name: ListProtectedRegionsInBounds :many
+-- Regions whose bounding box overlaps the half-open cell rectangle [min_x, max_x) x [min_y, max_y)
+SELECT * FROM protected_regions
+WHERE world_id = sqlc.arg(world_id)
+  AND min_x < sqlc.arg(max_x)::integer AND max_x > sqlc.arg(min_x)::integer
+  AND min_y < sqlc.arg(max_y)::integer AND max_y > sqlc.arg(min_y)::integer
+ORDER BY id;
@@ -0,0 +1,68 @@
+-- Rare Event Operations
+
+-- name: CreateRareEvent :one
+INSERT INTO rare_events (world_id, kind, chunk_x, chunk_y, x, y, required, spawned_at, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING *;
+
+-- name: CountActiveRareEvents :one
+SELECT COUNT(*) FROM rare_events
+WHERE world_id = $1 AND ended_at IS NULL;
+
+-- name: CountRareEventsSpawnedSince :one
+SELECT COUNT(*) FROM rare_events
+WHERE world_id = $1 AND spawned_at > sqlc.arg(since);
+
+-- name: GetRareEvent :one
+SELECT * FROM rare_events
+WHERE id = $1;
+
+-- name: ListActiveRareEvents :many
+SELECT * FROM rare_events
+WHERE world_id = $1 AND ended_at IS NULL
+ORDER BY id;
+
+-- name: AddRareEventContribution :one
+-- Returns no rows when the character contributed less than the cooldown ago
+INSERT INTO rare_event_contributions (rare_event_id, character_id, amount, last_contributed_at)
+VALUES (sqlc.arg(rare_event_id), sqlc.arg(character_id), sqlc.arg(amount), sqlc.arg(now))
+ON CONFLICT (rare_event_id, character_id) DO UPDATE
+SET amount = rare_event_contributions.amount + EXCLUDED.amount,
+    last_contributed_at = EXCLUDED.last_contributed_at
+WHERE rare_event_contributions.last_contributed_at <= sqlc.arg(cooled_down_before)::timestamp
+RETURNING amount;
+
+-- name: AdvanceRareEvent :one
+-- Adds progress to an event in progress, completing it when progress reaches required.
+-- Returns no rows when the event has ended or expired.
+UPDATE rare_events
+SET progress = LEAST(progress + sqlc.arg(amount)::integer, required),
+    ended_at = CASE WHEN progress + sqlc.arg(amount)::integer >= required THEN sqlc.arg(now)::timestamp END,
+    outcome = CASE WHEN progress + sqlc.arg(amount)::integer >= required THEN 'completed' END
+WHERE id = sqlc.arg(id)
+  AND ended_at IS NULL
+  AND expires_at > sqlc.arg(now)::timestamp
+RETURNING *;
+
+-- name: ExpireRareEvents :many
+UPDATE rare_events
+SET ended_at = expires_at, outcome = 'expired'
+WHERE ended_at IS NULL AND expires_at <= $1
+RETURNING *;
+
+-- name: ListUnrewardedRareEvents :many
+SELECT * FROM rare_events
+WHERE outcome = 'completed' AND rewarded_at IS NULL
+ORDER BY id
+LIMIT $1;
+
+-- name: MarkRareEventRewarded :execrows
+-- Affects no rows when another instance already sent the rewards
+UPDATE rare_events
+SET rewarded_at = $2
+WHERE id = $1 AND rewarded_at IS NULL;
+
+-- name: ListRareEventContributions :many
+SELECT * FROM rare_event_contributions
+WHERE rare_event_id = $1
+ORDER BY amount DESC, character_id;
@@ -0,0 +1,13 @@
+-- Region Maturity Operations
+
+-- name: ListRegionMaturity :many
+SELECT * FROM region_maturity
+WHERE world_id = $1;
+
+-- name: UpsertRegionMaturity :exec
+INSERT INTO region_maturity (world_id, region_x, region_y, density, harvest_count, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (world_id, region_x, region_y) DO UPDATE
+SET density = EXCLUDED.density,
+    harvest_count = EXCLUDED.harvest_count,
+    updated_at = EXCLUDED.updated_at;
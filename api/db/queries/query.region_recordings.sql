@@ -0,0 +1,42 @@
+-- Region Recording Operations
+
+-- name: CreateRegionRecording :one
+INSERT INTO region_recordings (world_id, min_chunk_x, min_chunk_y, max_chunk_x, max_chunk_y, started_by, started_at, ends_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING *;
+
+-- name: GetRegionRecording :one
+SELECT * FROM region_recordings
+WHERE id = $1;
+
+-- name: ListRegionRecordings :many
+SELECT * FROM region_recordings
+ORDER BY id DESC
+LIMIT $1;
+
+-- name: ListActiveRegionRecordings :many
+-- Recordings that have not been stopped or run out at the given time
+SELECT * FROM region_recordings
+WHERE stopped_at IS NULL AND ends_at > $1
+ORDER BY id;
+
+-- name: StopRegionRecording :one
+UPDATE region_recordings
+SET stopped_at = $2
+WHERE id = $1 AND stopped_at IS NULL AND ends_at > $2
+RETURNING *;
+
+-- name: CreateRegionRecordingEvent :exec
+INSERT INTO region_recording_events (recording_id, event_type, character_id, x, y, chunk_x, chunk_y, payload, occurred_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+
+-- name: CountRegionRecordingEvents :one
+SELECT COUNT(*) FROM region_recording_events
+WHERE recording_id = $1;
+
+-- name: ListRegionRecordingEvents :many
+-- The first events of a recording in the order they were recorded
+SELECT * FROM region_recording_events
+WHERE recording_id = $1
+ORDER BY id
+LIMIT $2;
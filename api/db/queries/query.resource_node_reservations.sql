@@ -0,0 +1,19 @@
+-- Resource Node Reservation Operations
+
+-- name: AcquireResourceNodeReservation :one
+-- Returns no rows when another character holds an unexpired reservation.
+-- The conflicting row is locked by ON CONFLICT, so concurrent callers serialize here.
+INSERT INTO resource_node_reservations (resource_node_id, character_id, reserved_at, expires_at)
+VALUES (sqlc.arg(resource_node_id), sqlc.arg(character_id), sqlc.arg(now), sqlc.arg(expires_at))
+ON CONFLICT (resource_node_id) DO UPDATE
+SET character_id = EXCLUDED.character_id,
+    reserved_at = EXCLUDED.reserved_at,
+    expires_at = EXCLUDED.expires_at
+WHERE resource_node_reservations.expires_at <= EXCLUDED.reserved_at
+   OR resource_node_reservations.character_id = EXCLUDED.character_id
+RETURNING *;
+
+-- name: ReleaseResourceNodeReservation :exec
+DELETE FROM resource_node_reservations
+WHERE resource_node_id = $1 AND character_id = $2;
+
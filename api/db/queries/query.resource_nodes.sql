@@ -9,11 +9,27 @@ INSERT INTO resource_nodes (
   cluster_id,
   x,
   y,
-  size
+  size,
+  quality
 )
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 RETURNING *;
 
+-- name: CopyResourceNodes :copyfrom
+-- Bulk insert for chunk generation; one COPY instead of a statement per node
+INSERT INTO resource_nodes (
+  resource_node_type_id,
+  world_id,
+  chunk_x,
+  chunk_y,
+  cluster_id,
+  x,
+  y,
+  size,
+  quality
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+
 -- name: GetResourceNode :one
 SELECT
   rn.*
@@ -0,0 +1,12 @@
+-- Schema Version Operations
+
+-- name: GetSchemaVersion :one
+SELECT * FROM schema_version
+WHERE id;
+
+-- name: SetSchemaVersion :exec
+INSERT INTO schema_version (checksum, applied_at)
+VALUES ($1, $2)
+ON CONFLICT (id) DO UPDATE
+SET checksum = EXCLUDED.checksum,
+    applied_at = EXCLUDED.applied_at;
@@ -0,0 +1,30 @@
+-- Shard Registry Operations
+
+-- name: HeartbeatShardInstance :exec
+INSERT INTO shard_instances (instance_id, address, heartbeat_at)
+VALUES (sqlc.arg(instance_id), sqlc.arg(address), sqlc.arg(now))
+ON CONFLICT (instance_id) DO UPDATE
+SET address = EXCLUDED.address,
+    heartbeat_at = EXCLUDED.heartbeat_at;
+
+-- name: GetWorldShard :one
+SELECT ws.world_id, ws.instance_id, si.address, si.heartbeat_at
+FROM world_shards ws
+JOIN shard_instances si ON si.instance_id = ws.instance_id
+WHERE ws.world_id = $1;
+
+-- name: ClaimWorldShard :one
+-- Assigns the world to the instance unless another instance that heartbeated
+-- after stale_before owns it. Returns no rows when the current owner is healthy.
+INSERT INTO world_shards (world_id, instance_id, assigned_at)
+VALUES (sqlc.arg(world_id), sqlc.arg(instance_id), sqlc.arg(now))
+ON CONFLICT (world_id) DO UPDATE
+SET instance_id = EXCLUDED.instance_id,
+    assigned_at = EXCLUDED.assigned_at
+WHERE world_shards.instance_id = EXCLUDED.instance_id
+   OR NOT EXISTS (
+     SELECT 1 FROM shard_instances si
+     WHERE si.instance_id = world_shards.instance_id
+       AND si.heartbeat_at > sqlc.arg(stale_before)
+   )
+RETURNING *;
@@ -0,0 +1,11 @@
+-- Tutorial Operations
+
+-- name: ListTutorialSteps :many
+SELECT * FROM tutorial_steps
+WHERE character_id = $1
+ORDER BY completed_at;
+
+-- name: CompleteTutorialStep :exec
+INSERT INTO tutorial_steps (character_id, step, completed_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (character_id, step) DO NOTHING;
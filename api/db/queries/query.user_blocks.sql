@@ -0,0 +1,35 @@
+-- User Block Operations
+
+-- name: CreateUserBlock :exec
+INSERT INTO user_blocks (blocker_id, blocked_id, created_at)
+VALUES ($1, $2, $3)
+ON CONFLICT DO NOTHING;
+
+-- name: DeleteUserBlock :execrows
+DELETE FROM user_blocks
+WHERE blocker_id = $1 AND blocked_id = $2;
+
+-- name: IsBlockedBetween :one
+-- Whether either user has blocked the other
+SELECT EXISTS (
+  SELECT 1 FROM user_blocks
+  WHERE (blocker_id = sqlc.arg(user_a) AND blocked_id = sqlc.arg(user_b))
+     OR (blocker_id = sqlc.arg(user_b) AND blocked_id = sqlc.arg(user_a))
+);
+
+-- name: ListBlockRelations :many
+-- Users the user has blocked or been blocked by
+SELECT (CASE WHEN blocker_id = sqlc.arg(user_id) THEN blocked_id ELSE blocker_id END)::uuid AS user_id
+FROM user_blocks
+WHERE blocker_id = sqlc.arg(user_id) OR blocked_id = sqlc.arg(user_id);
+
+-- name: ListUserBlocks :many
+SELECT
+  b.blocked_id,
+  b.created_at,
+  u.username,
+  u.display_name
+FROM user_blocks b
+JOIN users u ON u.id = b.blocked_id
+WHERE b.blocker_id = $1
+ORDER BY u.username;
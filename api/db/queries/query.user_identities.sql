@@ -0,0 +1,28 @@
+-- name: GetUserIdentity :one
+SELECT * FROM user_identities
+WHERE provider = $1 AND subject = $2;
+
+-- name: CreateUserIdentity :one
+INSERT INTO user_identities (user_id, provider, subject, email, username, linked_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: ListUserIdentities :many
+SELECT * FROM user_identities
+WHERE user_id = $1
+ORDER BY linked_at, id;
+
+-- name: CountUserIdentities :one
+SELECT count(*) FROM user_identities
+WHERE user_id = $1;
+
+-- name: DeleteUserIdentity :one
+DELETE FROM user_identities
+WHERE user_id = $1 AND provider = $2
+RETURNING *;
+
+-- name: TouchUserIdentity :exec
+-- Records a login and what the provider now reports for the account
+UPDATE user_identities
+SET last_login_at = sqlc.arg(last_login_at), email = sqlc.arg(email), username = sqlc.arg(username)
+WHERE id = sqlc.arg(id);
@@ -0,0 +1,41 @@
+-- name: CreateUserSession :one
+INSERT INTO user_sessions (user_id, token_hash, ip_address, user_agent, created_at, last_seen_at, expires_at)
+VALUES (sqlc.arg(user_id), sqlc.arg(token_hash), sqlc.arg(ip_address), sqlc.arg(user_agent), sqlc.arg(created_at), sqlc.arg(created_at), sqlc.arg(expires_at))
+RETURNING *;
+
+-- name: CountUserSessions :one
+SELECT count(*) FROM user_sessions
+WHERE user_id = $1;
+
+-- name: UserSessionDeviceKnown :one
+-- Whether the user has logged in with the user agent before, in any kept session
+SELECT EXISTS (
+  SELECT 1 FROM user_sessions
+  WHERE user_id = $1 AND user_agent = $2
+);
+
+-- name: GetUserSessionByTokenHash :one
+SELECT * FROM user_sessions
+WHERE token_hash = $1;
+
+-- name: ListActiveUserSessions :many
+-- Most recently seen first
+SELECT * FROM user_sessions
+WHERE user_id = sqlc.arg(user_id) AND revoked_at IS NULL AND expires_at > sqlc.arg(now)
+ORDER BY last_seen_at DESC, created_at DESC;
+
+-- name: RevokeUserSession :one
+UPDATE user_sessions
+SET revoked_at = sqlc.arg(revoked_at)
+WHERE id = sqlc.arg(id) AND user_id = sqlc.arg(user_id) AND revoked_at IS NULL
+RETURNING *;
+
+-- name: TouchUserSession :exec
+UPDATE user_sessions
+SET last_seen_at = $2
+WHERE id = $1;
+
+-- name: DeleteUserSessionsEndedBefore :execrows
+-- Sessions that expired or were revoked before the cutoff
+DELETE FROM user_sessions
+WHERE expires_at < sqlc.arg(cutoff) OR revoked_at < sqlc.arg(cutoff);
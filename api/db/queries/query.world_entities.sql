@@ -0,0 +1,61 @@
+-- World Entity Operations
+
+-- name: CreateWorldEntity :one
+INSERT INTO world_entities (world_id, type, chunk_x, chunk_y, x, y, components, created_at, updated_at)
+VALUES (sqlc.arg(world_id), sqlc.arg(type), sqlc.arg(chunk_x), sqlc.arg(chunk_y), sqlc.arg(x), sqlc.arg(y), sqlc.arg(components), sqlc.arg(now), sqlc.arg(now))
+RETURNING *;
+
+-- name: GetWorldEntity :one
+SELECT * FROM world_entities
+WHERE id = $1;
+
+-- name: ListWorldEntitiesInChunk :many
+SELECT * FROM world_entities
+WHERE world_id = $1 AND chunk_x = $2 AND chunk_y = $3
+ORDER BY id;
+
+-- name: ListWorldEntitiesInChunkRange :many
+-- An empty type lists entities of every type
+SELECT * FROM world_entities
+WHERE world_id = sqlc.arg(world_id)
+  AND chunk_x BETWEEN sqlc.arg(min_chunk_x) AND sqlc.arg(max_chunk_x)
+  AND chunk_y BETWEEN sqlc.arg(min_chunk_y) AND sqlc.arg(max_chunk_y)
+  AND (sqlc.arg(type)::text = '' OR type = sqlc.arg(type))
+ORDER BY chunk_x, chunk_y, id;
+
+-- name: UpdateWorldEntity :one
+-- Returns no rows when the entity is gone or was updated since version was read
+UPDATE world_entities
+SET chunk_x = sqlc.arg(chunk_x), chunk_y = sqlc.arg(chunk_y), x = sqlc.arg(x), y = sqlc.arg(y),
+    components = sqlc.arg(components), version = version + 1, updated_at = sqlc.arg(now)
+WHERE id = sqlc.arg(id) AND version = sqlc.arg(version)
+RETURNING *;
+
+-- name: DeleteWorldEntity :execrows
+DELETE FROM world_entities
+WHERE id = $1;
+
+-- name: ListWorldEntitiesInRect :many
+-- Bounds are inclusive world cell coordinates; empty types lists entities of every type
+SELECT * FROM world_entities
+WHERE world_id = sqlc.arg(world_id)
+  AND x BETWEEN sqlc.arg(min_x) AND sqlc.arg(max_x)
+  AND y BETWEEN sqlc.arg(min_y) AND sqlc.arg(max_y)
+  AND (cardinality(sqlc.arg(types)::text[]) = 0 OR type = ANY(sqlc.arg(types)::text[]))
+ORDER BY id
+LIMIT sqlc.arg(max_results);
+
+-- name: ListWorldEntitiesWithinRadius :many
+-- Nearest first; the bounding box lets the position index narrow the scan before the
+-- exact distance check
+SELECT * FROM world_entities
+WHERE world_id = sqlc.arg(world_id)
+  AND x BETWEEN sqlc.arg(center_x)::integer - sqlc.arg(radius)::integer AND sqlc.arg(center_x)::integer + sqlc.arg(radius)::integer
+  AND y BETWEEN sqlc.arg(center_y)::integer - sqlc.arg(radius)::integer AND sqlc.arg(center_y)::integer + sqlc.arg(radius)::integer
+  AND (x::bigint - sqlc.arg(center_x)::integer) * (x::bigint - sqlc.arg(center_x)::integer)
+    + (y::bigint - sqlc.arg(center_y)::integer) * (y::bigint - sqlc.arg(center_y)::integer)
+    <= sqlc.arg(radius)::bigint * sqlc.arg(radius)::bigint
+  AND (cardinality(sqlc.arg(types)::text[]) = 0 OR type = ANY(sqlc.arg(types)::text[]))
+ORDER BY (x::bigint - sqlc.arg(center_x)::integer) * (x::bigint - sqlc.arg(center_x)::integer)
+    + (y::bigint - sqlc.arg(center_y)::integer) * (y::bigint - sqlc.arg(center_y)::integer), id
+LIMIT sqlc.arg(max_results);
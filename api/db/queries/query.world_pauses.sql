@@ -0,0 +1,18 @@
+-- World Pause Operations
+
+-- name: ListWorldPauses :many
+SELECT * FROM world_pauses
+ORDER BY paused_at, world_id;
+
+-- name: UpsertWorldPause :one
+INSERT INTO world_pauses (world_id, reason, paused_by, paused_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (world_id) DO UPDATE
+SET reason = EXCLUDED.reason,
+    paused_by = EXCLUDED.paused_by,
+    paused_at = EXCLUDED.paused_at
+RETURNING *;
+
+-- name: DeleteWorldPause :execrows
+DELETE FROM world_pauses
+WHERE world_id = $1;
@@ -0,0 +1,17 @@
+-- World Time Scale Operations
+
+-- name: ListWorldTimeScales :many
+SELECT * FROM world_time_scales;
+
+-- name: GetWorldTimeScale :one
+SELECT * FROM world_time_scales
+WHERE world_id = $1;
+
+-- name: UpsertWorldTimeScale :one
+INSERT INTO world_time_scales (world_id, time_scale, updated_by, updated_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (world_id) DO UPDATE
+SET time_scale = EXCLUDED.time_scale,
+    updated_by = EXCLUDED.updated_by,
+    updated_at = EXCLUDED.updated_at
+RETURNING *;
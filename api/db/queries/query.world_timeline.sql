@@ -0,0 +1,33 @@
+-- World Timeline Operations
+
+-- name: CreateWorldTimelineEntry :exec
+-- Does nothing when an entry with the same dedup key exists
+INSERT INTO world_timeline (world_id, kind, details, dedup_key, occurred_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (dedup_key) DO NOTHING;
+
+-- name: ListWorldTimeline :many
+-- A world's entries before an entry ID, newest first
+SELECT * FROM world_timeline
+WHERE world_id = sqlc.arg(world_id)
+  AND id < sqlc.arg(before_id)
+ORDER BY id DESC
+LIMIT sqlc.arg(max_entries);
+
+-- name: ListWorldTimelineAfter :many
+-- A world's entries after an entry ID, oldest first
+SELECT * FROM world_timeline
+WHERE world_id = sqlc.arg(world_id)
+  AND id > sqlc.arg(after_id)
+ORDER BY id
+LIMIT sqlc.arg(max_entries);
+
+-- name: ListAllWorldTimelineAfter :many
+-- Entries of every world after an entry ID, oldest first
+SELECT * FROM world_timeline
+WHERE id > sqlc.arg(after_id)
+ORDER BY id
+LIMIT sqlc.arg(max_entries);
+
+-- name: GetLatestWorldTimelineID :one
+SELECT COALESCE(MAX(id), 0)::bigint FROM world_timeline;
@@ -0,0 +1,156 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.achievements.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const claimAchievement = `-- name: ClaimAchievement :exec
+UPDATE character_achievements
+SET claimed_at = $3
+WHERE character_id = $1 AND achievement_id = $2
+`
+
+type ClaimAchievementParams struct {
+	CharacterID   pgtype.UUID
+	AchievementID string
+	ClaimedAt     pgtype.Timestamp
+}
+
+func (q *Queries) ClaimAchievement(ctx context.Context, arg ClaimAchievementParams) error {
+	_, err := q.db.Exec(ctx, claimAchievement, arg.CharacterID, arg.AchievementID, arg.ClaimedAt)
+	return err
+}
+
+const getCharacterAchievementForUpdate = `-- name: GetCharacterAchievementForUpdate :one
+SELECT character_id, achievement_id, unlocked_at, claimed_at FROM character_achievements
+WHERE character_id = $1 AND achievement_id = $2
+FOR UPDATE
+`
+
+type GetCharacterAchievementForUpdateParams struct {
+	CharacterID   pgtype.UUID
+	AchievementID string
+}
+
+func (q *Queries) GetCharacterAchievementForUpdate(ctx context.Context, arg GetCharacterAchievementForUpdateParams) (CharacterAchievement, error) {
+	row := q.db.QueryRow(ctx, getCharacterAchievementForUpdate, arg.CharacterID, arg.AchievementID)
+	var i CharacterAchievement
+	err := row.Scan(
+		&i.CharacterID,
+		&i.AchievementID,
+		&i.UnlockedAt,
+		&i.ClaimedAt,
+	)
+	return i, err
+}
+
+const incrementAchievementCounter = `-- name: IncrementAchievementCounter :one
+INSERT INTO achievement_counters (character_id, counter, value, updated_at)
+VALUES ($1, $2, 1, $3)
+ON CONFLICT (character_id, counter) DO UPDATE
+SET value = achievement_counters.value + 1, updated_at = EXCLUDED.updated_at
+RETURNING value
+`
+
+type IncrementAchievementCounterParams struct {
+	CharacterID pgtype.UUID
+	Counter     string
+	UpdatedAt   pgtype.Timestamp
+}
+
+// Returns the counter's new value
+func (q *Queries) IncrementAchievementCounter(ctx context.Context, arg IncrementAchievementCounterParams) (int64, error) {
+	row := q.db.QueryRow(ctx, incrementAchievementCounter, arg.CharacterID, arg.Counter, arg.UpdatedAt)
+	var value int64
+	err := row.Scan(&value)
+	return value, err
+}
+
+const listAchievementCounters = `-- name: ListAchievementCounters :many
+SELECT character_id, counter, value, updated_at FROM achievement_counters
+WHERE character_id = $1
+`
+
+func (q *Queries) ListAchievementCounters(ctx context.Context, characterID pgtype.UUID) ([]AchievementCounter, error) {
+	rows, err := q.db.Query(ctx, listAchievementCounters, characterID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AchievementCounter
+	for rows.Next() {
+		var i AchievementCounter
+		if err := rows.Scan(
+			&i.CharacterID,
+			&i.Counter,
+			&i.Value,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCharacterAchievements = `-- name: ListCharacterAchievements :many
+SELECT character_id, achievement_id, unlocked_at, claimed_at FROM character_achievements
+WHERE character_id = $1
+ORDER BY unlocked_at, achievement_id
+`
+
+func (q *Queries) ListCharacterAchievements(ctx context.Context, characterID pgtype.UUID) ([]CharacterAchievement, error) {
+	rows, err := q.db.Query(ctx, listCharacterAchievements, characterID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CharacterAchievement
+	for rows.Next() {
+		var i CharacterAchievement
+		if err := rows.Scan(
+			&i.CharacterID,
+			&i.AchievementID,
+			&i.UnlockedAt,
+			&i.ClaimedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const unlockAchievement = `-- name: UnlockAchievement :execrows
+INSERT INTO character_achievements (character_id, achievement_id, unlocked_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (character_id, achievement_id) DO NOTHING
+`
+
+type UnlockAchievementParams struct {
+	CharacterID   pgtype.UUID
+	AchievementID string
+	UnlockedAt    pgtype.Timestamp
+}
+
+// Does nothing when the character has already unlocked the achievement
+func (q *Queries) UnlockAchievement(ctx context.Context, arg UnlockAchievementParams) (int64, error) {
+	result, err := q.db.Exec(ctx, unlockAchievement, arg.CharacterID, arg.AchievementID, arg.UnlockedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
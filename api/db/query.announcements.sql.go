@@ -0,0 +1,99 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.announcements.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createAnnouncement = `-- name: CreateAnnouncement :one
+
+INSERT INTO announcements (message, severity, created_by, created_at, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, message, severity, created_by, created_at, expires_at
+`
+
+type CreateAnnouncementParams struct {
+	Message   string
+	Severity  string
+	CreatedBy pgtype.UUID
+	CreatedAt pgtype.Timestamp
+	ExpiresAt pgtype.Timestamp
+}
+
+// Announcement Operations
+func (q *Queries) CreateAnnouncement(ctx context.Context, arg CreateAnnouncementParams) (Announcement, error) {
+	row := q.db.QueryRow(ctx, createAnnouncement,
+		arg.Message,
+		arg.Severity,
+		arg.CreatedBy,
+		arg.CreatedAt,
+		arg.ExpiresAt,
+	)
+	var i Announcement
+	err := row.Scan(
+		&i.ID,
+		&i.Message,
+		&i.Severity,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const getLatestAnnouncementID = `-- name: GetLatestAnnouncementID :one
+SELECT COALESCE(MAX(id), 0)::bigint FROM announcements
+`
+
+func (q *Queries) GetLatestAnnouncementID(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, getLatestAnnouncementID)
+	var column_1 int64
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const listActiveAnnouncements = `-- name: ListActiveAnnouncements :many
+SELECT id, message, severity, created_by, created_at, expires_at FROM announcements
+WHERE id > $1
+  AND (expires_at IS NULL OR expires_at > $2::timestamp)
+ORDER BY id
+`
+
+type ListActiveAnnouncementsParams struct {
+	AfterID int64
+	Now     pgtype.Timestamp
+}
+
+// Announcements after an ID that have not expired, oldest first
+func (q *Queries) ListActiveAnnouncements(ctx context.Context, arg ListActiveAnnouncementsParams) ([]Announcement, error) {
+	rows, err := q.db.Query(ctx, listActiveAnnouncements, arg.AfterID, arg.Now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Announcement
+	for rows.Next() {
+		var i Announcement
+		if err := rows.Scan(
+			&i.ID,
+			&i.Message,
+			&i.Severity,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
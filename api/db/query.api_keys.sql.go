@@ -0,0 +1,161 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.api_keys.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createApiKey = `-- name: CreateApiKey :one
+
+INSERT INTO api_keys (key_id, secret_hash, name, scopes, created_by, created_at, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, key_id, secret_hash, name, scopes, created_by, created_at, expires_at, revoked_at, last_used_at
+`
+
+type CreateApiKeyParams struct {
+	KeyID      string
+	SecretHash []byte
+	Name       string
+	Scopes     []string
+	CreatedBy  pgtype.UUID
+	CreatedAt  pgtype.Timestamp
+	ExpiresAt  pgtype.Timestamp
+}
+
+// API Key Operations
+func (q *Queries) CreateApiKey(ctx context.Context, arg CreateApiKeyParams) (ApiKey, error) {
+	row := q.db.QueryRow(ctx, createApiKey,
+		arg.KeyID,
+		arg.SecretHash,
+		arg.Name,
+		arg.Scopes,
+		arg.CreatedBy,
+		arg.CreatedAt,
+		arg.ExpiresAt,
+	)
+	var i ApiKey
+	err := row.Scan(
+		&i.ID,
+		&i.KeyID,
+		&i.SecretHash,
+		&i.Name,
+		&i.Scopes,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+		&i.LastUsedAt,
+	)
+	return i, err
+}
+
+const getApiKeyByKeyID = `-- name: GetApiKeyByKeyID :one
+SELECT id, key_id, secret_hash, name, scopes, created_by, created_at, expires_at, revoked_at, last_used_at FROM api_keys
+WHERE key_id = $1
+`
+
+func (q *Queries) GetApiKeyByKeyID(ctx context.Context, keyID string) (ApiKey, error) {
+	row := q.db.QueryRow(ctx, getApiKeyByKeyID, keyID)
+	var i ApiKey
+	err := row.Scan(
+		&i.ID,
+		&i.KeyID,
+		&i.SecretHash,
+		&i.Name,
+		&i.Scopes,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+		&i.LastUsedAt,
+	)
+	return i, err
+}
+
+const listApiKeys = `-- name: ListApiKeys :many
+SELECT id, key_id, secret_hash, name, scopes, created_by, created_at, expires_at, revoked_at, last_used_at FROM api_keys
+ORDER BY id
+`
+
+func (q *Queries) ListApiKeys(ctx context.Context) ([]ApiKey, error) {
+	rows, err := q.db.Query(ctx, listApiKeys)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ApiKey
+	for rows.Next() {
+		var i ApiKey
+		if err := rows.Scan(
+			&i.ID,
+			&i.KeyID,
+			&i.SecretHash,
+			&i.Name,
+			&i.Scopes,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.ExpiresAt,
+			&i.RevokedAt,
+			&i.LastUsedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const revokeApiKey = `-- name: RevokeApiKey :one
+UPDATE api_keys
+SET revoked_at = $2
+WHERE id = $1 AND revoked_at IS NULL
+RETURNING id, key_id, secret_hash, name, scopes, created_by, created_at, expires_at, revoked_at, last_used_at
+`
+
+type RevokeApiKeyParams struct {
+	ID        int64
+	RevokedAt pgtype.Timestamp
+}
+
+func (q *Queries) RevokeApiKey(ctx context.Context, arg RevokeApiKeyParams) (ApiKey, error) {
+	row := q.db.QueryRow(ctx, revokeApiKey, arg.ID, arg.RevokedAt)
+	var i ApiKey
+	err := row.Scan(
+		&i.ID,
+		&i.KeyID,
+		&i.SecretHash,
+		&i.Name,
+		&i.Scopes,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+		&i.LastUsedAt,
+	)
+	return i, err
+}
+
+const touchApiKey = `-- name: TouchApiKey :exec
+UPDATE api_keys
+SET last_used_at = $2
+WHERE id = $1
+`
+
+type TouchApiKeyParams struct {
+	ID         int64
+	LastUsedAt pgtype.Timestamp
+}
+
+func (q *Queries) TouchApiKey(ctx context.Context, arg TouchApiKeyParams) error {
+	_, err := q.db.Exec(ctx, touchApiKey, arg.ID, arg.LastUsedAt)
+	return err
+}
@@ -0,0 +1,478 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.bank.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const addBankVaultItem = `-- name: AddBankVaultItem :one
+INSERT INTO bank_vault_items (vault_id, item_id, quantity)
+VALUES ($1, $2, $3)
+ON CONFLICT (vault_id, item_id) DO UPDATE SET quantity = bank_vault_items.quantity + EXCLUDED.quantity
+RETURNING vault_id, item_id, quantity
+`
+
+type AddBankVaultItemParams struct {
+	VaultID  int64
+	ItemID   int32
+	Quantity int32
+}
+
+func (q *Queries) AddBankVaultItem(ctx context.Context, arg AddBankVaultItemParams) (BankVaultItem, error) {
+	row := q.db.QueryRow(ctx, addBankVaultItem, arg.VaultID, arg.ItemID, arg.Quantity)
+	var i BankVaultItem
+	err := row.Scan(&i.VaultID, &i.ItemID, &i.Quantity)
+	return i, err
+}
+
+const countGuildBankVaultsCreatedBy = `-- name: CountGuildBankVaultsCreatedBy :one
+SELECT COUNT(*) FROM bank_vaults
+WHERE created_by = $1 AND owner_id IS NULL
+`
+
+func (q *Queries) CountGuildBankVaultsCreatedBy(ctx context.Context, createdBy pgtype.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countGuildBankVaultsCreatedBy, createdBy)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createBankTransaction = `-- name: CreateBankTransaction :one
+INSERT INTO bank_transactions (vault_id, character_id, item_id, quantity, created_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, vault_id, character_id, item_id, quantity, created_at
+`
+
+type CreateBankTransactionParams struct {
+	VaultID     int64
+	CharacterID pgtype.UUID
+	ItemID      int32
+	Quantity    int32
+	CreatedAt   pgtype.Timestamp
+}
+
+func (q *Queries) CreateBankTransaction(ctx context.Context, arg CreateBankTransactionParams) (BankTransaction, error) {
+	row := q.db.QueryRow(ctx, createBankTransaction,
+		arg.VaultID,
+		arg.CharacterID,
+		arg.ItemID,
+		arg.Quantity,
+		arg.CreatedAt,
+	)
+	var i BankTransaction
+	err := row.Scan(
+		&i.ID,
+		&i.VaultID,
+		&i.CharacterID,
+		&i.ItemID,
+		&i.Quantity,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createGuildBankVault = `-- name: CreateGuildBankVault :one
+INSERT INTO bank_vaults (world_id, name, created_by, created_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (world_id, lower(name)) WHERE owner_id IS NULL DO NOTHING
+RETURNING id, world_id, owner_id, name, created_by, created_at
+`
+
+type CreateGuildBankVaultParams struct {
+	WorldID   pgtype.UUID
+	Name      string
+	CreatedBy pgtype.UUID
+	CreatedAt pgtype.Timestamp
+}
+
+// Returns no row when the world already has a guild vault of that name
+func (q *Queries) CreateGuildBankVault(ctx context.Context, arg CreateGuildBankVaultParams) (BankVault, error) {
+	row := q.db.QueryRow(ctx, createGuildBankVault,
+		arg.WorldID,
+		arg.Name,
+		arg.CreatedBy,
+		arg.CreatedAt,
+	)
+	var i BankVault
+	err := row.Scan(
+		&i.ID,
+		&i.WorldID,
+		&i.OwnerID,
+		&i.Name,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createPersonalBankVault = `-- name: CreatePersonalBankVault :one
+INSERT INTO bank_vaults (world_id, owner_id, created_by, created_at)
+VALUES ($1, $2, $2, $3)
+ON CONFLICT (owner_id) DO UPDATE SET owner_id = EXCLUDED.owner_id
+RETURNING id, world_id, owner_id, name, created_by, created_at
+`
+
+type CreatePersonalBankVaultParams struct {
+	WorldID   pgtype.UUID
+	OwnerID   pgtype.UUID
+	CreatedAt pgtype.Timestamp
+}
+
+// Returns the existing vault when the character already has one
+func (q *Queries) CreatePersonalBankVault(ctx context.Context, arg CreatePersonalBankVaultParams) (BankVault, error) {
+	row := q.db.QueryRow(ctx, createPersonalBankVault, arg.WorldID, arg.OwnerID, arg.CreatedAt)
+	var i BankVault
+	err := row.Scan(
+		&i.ID,
+		&i.WorldID,
+		&i.OwnerID,
+		&i.Name,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteBankVaultMember = `-- name: DeleteBankVaultMember :execrows
+DELETE FROM bank_vault_members
+WHERE vault_id = $1 AND character_id = $2
+`
+
+type DeleteBankVaultMemberParams struct {
+	VaultID     int64
+	CharacterID pgtype.UUID
+}
+
+func (q *Queries) DeleteBankVaultMember(ctx context.Context, arg DeleteBankVaultMemberParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteBankVaultMember, arg.VaultID, arg.CharacterID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteEmptyBankVaultItems = `-- name: DeleteEmptyBankVaultItems :exec
+DELETE FROM bank_vault_items
+WHERE vault_id = $1 AND quantity = 0
+`
+
+func (q *Queries) DeleteEmptyBankVaultItems(ctx context.Context, vaultID int64) error {
+	_, err := q.db.Exec(ctx, deleteEmptyBankVaultItems, vaultID)
+	return err
+}
+
+const getBankVault = `-- name: GetBankVault :one
+
+SELECT id, world_id, owner_id, name, created_by, created_at FROM bank_vaults
+WHERE id = $1
+`
+
+// Bank Operations
+func (q *Queries) GetBankVault(ctx context.Context, id int64) (BankVault, error) {
+	row := q.db.QueryRow(ctx, getBankVault, id)
+	var i BankVault
+	err := row.Scan(
+		&i.ID,
+		&i.WorldID,
+		&i.OwnerID,
+		&i.Name,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getBankVaultMember = `-- name: GetBankVaultMember :one
+SELECT vault_id, character_id, tier, added_at FROM bank_vault_members
+WHERE vault_id = $1 AND character_id = $2
+`
+
+type GetBankVaultMemberParams struct {
+	VaultID     int64
+	CharacterID pgtype.UUID
+}
+
+func (q *Queries) GetBankVaultMember(ctx context.Context, arg GetBankVaultMemberParams) (BankVaultMember, error) {
+	row := q.db.QueryRow(ctx, getBankVaultMember, arg.VaultID, arg.CharacterID)
+	var i BankVaultMember
+	err := row.Scan(
+		&i.VaultID,
+		&i.CharacterID,
+		&i.Tier,
+		&i.AddedAt,
+	)
+	return i, err
+}
+
+const getPersonalBankVault = `-- name: GetPersonalBankVault :one
+SELECT id, world_id, owner_id, name, created_by, created_at FROM bank_vaults
+WHERE owner_id = $1
+`
+
+func (q *Queries) GetPersonalBankVault(ctx context.Context, ownerID pgtype.UUID) (BankVault, error) {
+	row := q.db.QueryRow(ctx, getPersonalBankVault, ownerID)
+	var i BankVault
+	err := row.Scan(
+		&i.ID,
+		&i.WorldID,
+		&i.OwnerID,
+		&i.Name,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listBankTransactions = `-- name: ListBankTransactions :many
+SELECT
+  bank_transactions.id, bank_transactions.vault_id, bank_transactions.character_id, bank_transactions.item_id, bank_transactions.quantity, bank_transactions.created_at,
+  items.name AS item_name,
+  COALESCE(characters.name, '')::text AS character_name
+FROM bank_transactions
+JOIN items ON items.id = bank_transactions.item_id
+LEFT JOIN characters ON characters.id = bank_transactions.character_id
+WHERE bank_transactions.vault_id = $1
+  AND ($2::bigint = 0 OR bank_transactions.id < $2::bigint)
+ORDER BY bank_transactions.id DESC
+LIMIT $3
+`
+
+type ListBankTransactionsParams struct {
+	VaultID         int64
+	BeforeID        int64
+	MaxTransactions int32
+}
+
+type ListBankTransactionsRow struct {
+	ID            int64
+	VaultID       int64
+	CharacterID   pgtype.UUID
+	ItemID        int32
+	Quantity      int32
+	CreatedAt     pgtype.Timestamp
+	ItemName      string
+	CharacterName string
+}
+
+// A vault's transactions, newest first, before the id cursor (0 for the newest)
+func (q *Queries) ListBankTransactions(ctx context.Context, arg ListBankTransactionsParams) ([]ListBankTransactionsRow, error) {
+	rows, err := q.db.Query(ctx, listBankTransactions, arg.VaultID, arg.BeforeID, arg.MaxTransactions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListBankTransactionsRow
+	for rows.Next() {
+		var i ListBankTransactionsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.VaultID,
+			&i.CharacterID,
+			&i.ItemID,
+			&i.Quantity,
+			&i.CreatedAt,
+			&i.ItemName,
+			&i.CharacterName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listBankVaultItems = `-- name: ListBankVaultItems :many
+SELECT
+  bank_vault_items.vault_id, bank_vault_items.item_id, bank_vault_items.quantity,
+  items.name AS item_name,
+  items.stack_size
+FROM bank_vault_items
+JOIN items ON items.id = bank_vault_items.item_id
+WHERE bank_vault_items.vault_id = $1
+ORDER BY items.name, bank_vault_items.item_id
+`
+
+type ListBankVaultItemsRow struct {
+	VaultID   int64
+	ItemID    int32
+	Quantity  int32
+	ItemName  string
+	StackSize int32
+}
+
+func (q *Queries) ListBankVaultItems(ctx context.Context, vaultID int64) ([]ListBankVaultItemsRow, error) {
+	rows, err := q.db.Query(ctx, listBankVaultItems, vaultID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListBankVaultItemsRow
+	for rows.Next() {
+		var i ListBankVaultItemsRow
+		if err := rows.Scan(
+			&i.VaultID,
+			&i.ItemID,
+			&i.Quantity,
+			&i.ItemName,
+			&i.StackSize,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listBankVaultMembers = `-- name: ListBankVaultMembers :many
+SELECT
+  bank_vault_members.vault_id, bank_vault_members.character_id, bank_vault_members.tier, bank_vault_members.added_at,
+  characters.name AS character_name
+FROM bank_vault_members
+JOIN characters ON characters.id = bank_vault_members.character_id
+WHERE bank_vault_members.vault_id = $1
+ORDER BY bank_vault_members.added_at, characters.name
+`
+
+type ListBankVaultMembersRow struct {
+	VaultID       int64
+	CharacterID   pgtype.UUID
+	Tier          string
+	AddedAt       pgtype.Timestamp
+	CharacterName string
+}
+
+func (q *Queries) ListBankVaultMembers(ctx context.Context, vaultID int64) ([]ListBankVaultMembersRow, error) {
+	rows, err := q.db.Query(ctx, listBankVaultMembers, vaultID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListBankVaultMembersRow
+	for rows.Next() {
+		var i ListBankVaultMembersRow
+		if err := rows.Scan(
+			&i.VaultID,
+			&i.CharacterID,
+			&i.Tier,
+			&i.AddedAt,
+			&i.CharacterName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGuildBankVaultsByMember = `-- name: ListGuildBankVaultsByMember :many
+SELECT bank_vaults.id, bank_vaults.world_id, bank_vaults.owner_id, bank_vaults.name, bank_vaults.created_by, bank_vaults.created_at, bank_vault_members.tier
+FROM bank_vaults
+JOIN bank_vault_members ON bank_vault_members.vault_id = bank_vaults.id
+WHERE bank_vault_members.character_id = $1
+ORDER BY lower(bank_vaults.name), bank_vaults.id
+`
+
+type ListGuildBankVaultsByMemberRow struct {
+	ID        int64
+	WorldID   pgtype.UUID
+	OwnerID   pgtype.UUID
+	Name      string
+	CreatedBy pgtype.UUID
+	CreatedAt pgtype.Timestamp
+	Tier      string
+}
+
+func (q *Queries) ListGuildBankVaultsByMember(ctx context.Context, characterID pgtype.UUID) ([]ListGuildBankVaultsByMemberRow, error) {
+	rows, err := q.db.Query(ctx, listGuildBankVaultsByMember, characterID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListGuildBankVaultsByMemberRow
+	for rows.Next() {
+		var i ListGuildBankVaultsByMemberRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorldID,
+			&i.OwnerID,
+			&i.Name,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.Tier,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const removeBankVaultItemQuantity = `-- name: RemoveBankVaultItemQuantity :one
+UPDATE bank_vault_items
+SET quantity = quantity - $3
+WHERE vault_id = $1 AND item_id = $2 AND quantity >= $3
+RETURNING vault_id, item_id, quantity
+`
+
+type RemoveBankVaultItemQuantityParams struct {
+	VaultID  int64
+	ItemID   int32
+	Quantity int32
+}
+
+// Returns no row when the vault holds less than the quantity
+func (q *Queries) RemoveBankVaultItemQuantity(ctx context.Context, arg RemoveBankVaultItemQuantityParams) (BankVaultItem, error) {
+	row := q.db.QueryRow(ctx, removeBankVaultItemQuantity, arg.VaultID, arg.ItemID, arg.Quantity)
+	var i BankVaultItem
+	err := row.Scan(&i.VaultID, &i.ItemID, &i.Quantity)
+	return i, err
+}
+
+const upsertBankVaultMember = `-- name: UpsertBankVaultMember :one
+INSERT INTO bank_vault_members (vault_id, character_id, tier, added_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (vault_id, character_id) DO UPDATE SET tier = EXCLUDED.tier
+RETURNING vault_id, character_id, tier, added_at
+`
+
+type UpsertBankVaultMemberParams struct {
+	VaultID     int64
+	CharacterID pgtype.UUID
+	Tier        string
+	AddedAt     pgtype.Timestamp
+}
+
+func (q *Queries) UpsertBankVaultMember(ctx context.Context, arg UpsertBankVaultMemberParams) (BankVaultMember, error) {
+	row := q.db.QueryRow(ctx, upsertBankVaultMember,
+		arg.VaultID,
+		arg.CharacterID,
+		arg.Tier,
+		arg.AddedAt,
+	)
+	var i BankVaultMember
+	err := row.Scan(
+		&i.VaultID,
+		&i.CharacterID,
+		&i.Tier,
+		&i.AddedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,104 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.character_activity.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createCharacterActivity = `-- name: CreateCharacterActivity :exec
+
+INSERT INTO character_activity (user_id, character_id, kind, details, dedup_key, occurred_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (dedup_key) DO NOTHING
+`
+
+type CreateCharacterActivityParams struct {
+	UserID      pgtype.UUID
+	CharacterID pgtype.UUID
+	Kind        string
+	Details     []byte
+	DedupKey    string
+	OccurredAt  pgtype.Timestamp
+}
+
+// Character Activity Operations
+// Does nothing when an entry with the same dedup key exists
+func (q *Queries) CreateCharacterActivity(ctx context.Context, arg CreateCharacterActivityParams) error {
+	_, err := q.db.Exec(ctx, createCharacterActivity,
+		arg.UserID,
+		arg.CharacterID,
+		arg.Kind,
+		arg.Details,
+		arg.DedupKey,
+		arg.OccurredAt,
+	)
+	return err
+}
+
+const deleteCharacterActivityBefore = `-- name: DeleteCharacterActivityBefore :execrows
+DELETE FROM character_activity
+WHERE occurred_at < $1
+`
+
+func (q *Queries) DeleteCharacterActivityBefore(ctx context.Context, occurredAt pgtype.Timestamp) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteCharacterActivityBefore, occurredAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const listCharacterActivity = `-- name: ListCharacterActivity :many
+SELECT id, user_id, character_id, kind, details, dedup_key, occurred_at FROM character_activity
+WHERE (character_id = $1 OR (character_id IS NULL AND user_id = $2))
+  AND id < $3
+ORDER BY id DESC
+LIMIT $4
+`
+
+type ListCharacterActivityParams struct {
+	CharacterID pgtype.UUID
+	UserID      pgtype.UUID
+	BeforeID    int64
+	MaxEntries  int32
+}
+
+// A character's activity and its user's account-wide activity before an entry ID, newest first
+func (q *Queries) ListCharacterActivity(ctx context.Context, arg ListCharacterActivityParams) ([]CharacterActivity, error) {
+	rows, err := q.db.Query(ctx, listCharacterActivity,
+		arg.CharacterID,
+		arg.UserID,
+		arg.BeforeID,
+		arg.MaxEntries,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CharacterActivity
+	for rows.Next() {
+		var i CharacterActivity
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.CharacterID,
+			&i.Kind,
+			&i.Details,
+			&i.DedupKey,
+			&i.OccurredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
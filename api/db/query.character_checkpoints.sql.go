@@ -0,0 +1,220 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.character_checkpoints.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createCharacterCheckpoint = `-- name: CreateCharacterCheckpoint :one
+
+INSERT INTO character_checkpoints (character_id, x, y, chunk_x, chunk_y, inventory, inventory_hash, reason, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING id, character_id, x, y, chunk_x, chunk_y, inventory, inventory_hash, reason, created_at
+`
+
+type CreateCharacterCheckpointParams struct {
+	CharacterID   pgtype.UUID
+	X             int32
+	Y             int32
+	ChunkX        int32
+	ChunkY        int32
+	Inventory     []byte
+	InventoryHash string
+	Reason        string
+	CreatedAt     pgtype.Timestamp
+}
+
+// Character Checkpoint Operations
+func (q *Queries) CreateCharacterCheckpoint(ctx context.Context, arg CreateCharacterCheckpointParams) (CharacterCheckpoint, error) {
+	row := q.db.QueryRow(ctx, createCharacterCheckpoint,
+		arg.CharacterID,
+		arg.X,
+		arg.Y,
+		arg.ChunkX,
+		arg.ChunkY,
+		arg.Inventory,
+		arg.InventoryHash,
+		arg.Reason,
+		arg.CreatedAt,
+	)
+	var i CharacterCheckpoint
+	err := row.Scan(
+		&i.ID,
+		&i.CharacterID,
+		&i.X,
+		&i.Y,
+		&i.ChunkX,
+		&i.ChunkY,
+		&i.Inventory,
+		&i.InventoryHash,
+		&i.Reason,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteCharacterCheckpointsBefore = `-- name: DeleteCharacterCheckpointsBefore :execrows
+DELETE FROM character_checkpoints
+WHERE created_at < $1
+`
+
+func (q *Queries) DeleteCharacterCheckpointsBefore(ctx context.Context, createdAt pgtype.Timestamp) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteCharacterCheckpointsBefore, createdAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteCharacterInventory = `-- name: DeleteCharacterInventory :exec
+DELETE FROM character_inventories
+WHERE character_id = $1
+`
+
+func (q *Queries) DeleteCharacterInventory(ctx context.Context, characterID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteCharacterInventory, characterID)
+	return err
+}
+
+const getCharacterCheckpoint = `-- name: GetCharacterCheckpoint :one
+SELECT id, character_id, x, y, chunk_x, chunk_y, inventory, inventory_hash, reason, created_at FROM character_checkpoints
+WHERE id = $1
+`
+
+func (q *Queries) GetCharacterCheckpoint(ctx context.Context, id int64) (CharacterCheckpoint, error) {
+	row := q.db.QueryRow(ctx, getCharacterCheckpoint, id)
+	var i CharacterCheckpoint
+	err := row.Scan(
+		&i.ID,
+		&i.CharacterID,
+		&i.X,
+		&i.Y,
+		&i.ChunkX,
+		&i.ChunkY,
+		&i.Inventory,
+		&i.InventoryHash,
+		&i.Reason,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getLatestCharacterCheckpoint = `-- name: GetLatestCharacterCheckpoint :one
+SELECT id, character_id, x, y, chunk_x, chunk_y, inventory, inventory_hash, reason, created_at FROM character_checkpoints
+WHERE character_id = $1
+ORDER BY id DESC
+LIMIT 1
+`
+
+func (q *Queries) GetLatestCharacterCheckpoint(ctx context.Context, characterID pgtype.UUID) (CharacterCheckpoint, error) {
+	row := q.db.QueryRow(ctx, getLatestCharacterCheckpoint, characterID)
+	var i CharacterCheckpoint
+	err := row.Scan(
+		&i.ID,
+		&i.CharacterID,
+		&i.X,
+		&i.Y,
+		&i.ChunkX,
+		&i.ChunkY,
+		&i.Inventory,
+		&i.InventoryHash,
+		&i.Reason,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listCharacterCheckpoints = `-- name: ListCharacterCheckpoints :many
+SELECT id, character_id, x, y, chunk_x, chunk_y, inventory, inventory_hash, reason, created_at FROM character_checkpoints
+WHERE character_id = $1
+ORDER BY id DESC
+LIMIT $2
+`
+
+type ListCharacterCheckpointsParams struct {
+	CharacterID pgtype.UUID
+	Limit       int32
+}
+
+func (q *Queries) ListCharacterCheckpoints(ctx context.Context, arg ListCharacterCheckpointsParams) ([]CharacterCheckpoint, error) {
+	rows, err := q.db.Query(ctx, listCharacterCheckpoints, arg.CharacterID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CharacterCheckpoint
+	for rows.Next() {
+		var i CharacterCheckpoint
+		if err := rows.Scan(
+			&i.ID,
+			&i.CharacterID,
+			&i.X,
+			&i.Y,
+			&i.ChunkX,
+			&i.ChunkY,
+			&i.Inventory,
+			&i.InventoryHash,
+			&i.Reason,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCharactersAfter = `-- name: ListCharactersAfter :many
+SELECT id, user_id, world_id, name, x, y, chunk_x, chunk_y, created_at, facing, action_state, equipped_title FROM characters
+WHERE id > $1
+ORDER BY id
+LIMIT $2
+`
+
+type ListCharactersAfterParams struct {
+	ID    pgtype.UUID
+	Limit int32
+}
+
+// Keyset pagination over all characters for the checkpoint job
+func (q *Queries) ListCharactersAfter(ctx context.Context, arg ListCharactersAfterParams) ([]Character, error) {
+	rows, err := q.db.Query(ctx, listCharactersAfter, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Character
+	for rows.Next() {
+		var i Character
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.WorldID,
+			&i.Name,
+			&i.X,
+			&i.Y,
+			&i.ChunkX,
+			&i.ChunkY,
+			&i.CreatedAt,
+			&i.Facing,
+			&i.ActionState,
+			&i.EquippedTitle,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
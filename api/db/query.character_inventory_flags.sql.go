@@ -0,0 +1,97 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.character_inventory_flags.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const listInventoryFlags = `-- name: ListInventoryFlags :many
+SELECT inventory_id, character_id, favorite, tags, sort_position FROM character_inventory_flags
+WHERE character_id = $1
+`
+
+func (q *Queries) ListInventoryFlags(ctx context.Context, characterID pgtype.UUID) ([]CharacterInventoryFlag, error) {
+	rows, err := q.db.Query(ctx, listInventoryFlags, characterID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CharacterInventoryFlag
+	for rows.Next() {
+		var i CharacterInventoryFlag
+		if err := rows.Scan(
+			&i.InventoryID,
+			&i.CharacterID,
+			&i.Favorite,
+			&i.Tags,
+			&i.SortPosition,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setInventorySortPositions = `-- name: SetInventorySortPositions :exec
+INSERT INTO character_inventory_flags (inventory_id, character_id, sort_position)
+SELECT ci.id, ci.character_id, p.position::integer
+FROM unnest($1::integer[]) WITH ORDINALITY AS p(inventory_id, position)
+JOIN character_inventories ci ON ci.id = p.inventory_id
+WHERE ci.character_id = $2
+ON CONFLICT (inventory_id) DO UPDATE
+SET sort_position = EXCLUDED.sort_position
+`
+
+type SetInventorySortPositionsParams struct {
+	InventoryIds []int32
+	CharacterID  pgtype.UUID
+}
+
+// Positions are 1-based in the order of inventory_ids; stacks of other characters are ignored
+func (q *Queries) SetInventorySortPositions(ctx context.Context, arg SetInventorySortPositionsParams) error {
+	_, err := q.db.Exec(ctx, setInventorySortPositions, arg.InventoryIds, arg.CharacterID)
+	return err
+}
+
+const upsertInventoryFlags = `-- name: UpsertInventoryFlags :one
+INSERT INTO character_inventory_flags (inventory_id, character_id, favorite, tags)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (inventory_id) DO UPDATE
+SET favorite = EXCLUDED.favorite, tags = EXCLUDED.tags
+RETURNING inventory_id, character_id, favorite, tags, sort_position
+`
+
+type UpsertInventoryFlagsParams struct {
+	InventoryID int32
+	CharacterID pgtype.UUID
+	Favorite    bool
+	Tags        []string
+}
+
+func (q *Queries) UpsertInventoryFlags(ctx context.Context, arg UpsertInventoryFlagsParams) (CharacterInventoryFlag, error) {
+	row := q.db.QueryRow(ctx, upsertInventoryFlags,
+		arg.InventoryID,
+		arg.CharacterID,
+		arg.Favorite,
+		arg.Tags,
+	)
+	var i CharacterInventoryFlag
+	err := row.Scan(
+		&i.InventoryID,
+		&i.CharacterID,
+		&i.Favorite,
+		&i.Tags,
+		&i.SortPosition,
+	)
+	return i, err
+}
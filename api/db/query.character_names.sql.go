@@ -0,0 +1,246 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.character_names.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const characterNameInUse = `-- name: CharacterNameInUse :one
+SELECT EXISTS (
+  SELECT 1 FROM characters
+  WHERE world_id = $1
+    AND lower(name) = lower($2)
+    AND id IS DISTINCT FROM $3::uuid
+)
+`
+
+type CharacterNameInUseParams struct {
+	WorldID   pgtype.UUID
+	Name      string
+	ExcludeID pgtype.UUID
+}
+
+// Names are unique per world ignoring case; exclude_id skips the character being renamed
+func (q *Queries) CharacterNameInUse(ctx context.Context, arg CharacterNameInUseParams) (bool, error) {
+	row := q.db.QueryRow(ctx, characterNameInUse, arg.WorldID, arg.Name, arg.ExcludeID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const createCharacterNameChange = `-- name: CreateCharacterNameChange :one
+INSERT INTO character_name_history (character_id, user_id, world_id, old_name, new_name, renamed_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, character_id, user_id, world_id, old_name, new_name, renamed_at
+`
+
+type CreateCharacterNameChangeParams struct {
+	CharacterID pgtype.UUID
+	UserID      pgtype.UUID
+	WorldID     pgtype.UUID
+	OldName     string
+	NewName     string
+	RenamedAt   pgtype.Timestamp
+}
+
+func (q *Queries) CreateCharacterNameChange(ctx context.Context, arg CreateCharacterNameChangeParams) (CharacterNameHistory, error) {
+	row := q.db.QueryRow(ctx, createCharacterNameChange,
+		arg.CharacterID,
+		arg.UserID,
+		arg.WorldID,
+		arg.OldName,
+		arg.NewName,
+		arg.RenamedAt,
+	)
+	var i CharacterNameHistory
+	err := row.Scan(
+		&i.ID,
+		&i.CharacterID,
+		&i.UserID,
+		&i.WorldID,
+		&i.OldName,
+		&i.NewName,
+		&i.RenamedAt,
+	)
+	return i, err
+}
+
+const getLatestCharacterRename = `-- name: GetLatestCharacterRename :one
+SELECT renamed_at FROM character_name_history
+WHERE character_id = $1
+ORDER BY renamed_at DESC
+LIMIT 1
+`
+
+func (q *Queries) GetLatestCharacterRename(ctx context.Context, characterID pgtype.UUID) (pgtype.Timestamp, error) {
+	row := q.db.QueryRow(ctx, getLatestCharacterRename, characterID)
+	var renamed_at pgtype.Timestamp
+	err := row.Scan(&renamed_at)
+	return renamed_at, err
+}
+
+const listCharacterNameHistory = `-- name: ListCharacterNameHistory :many
+SELECT id, character_id, user_id, world_id, old_name, new_name, renamed_at FROM character_name_history
+WHERE character_id = $1
+ORDER BY renamed_at DESC, id DESC
+LIMIT $2
+`
+
+type ListCharacterNameHistoryParams struct {
+	CharacterID pgtype.UUID
+	Limit       int32
+}
+
+// Newest first
+func (q *Queries) ListCharacterNameHistory(ctx context.Context, arg ListCharacterNameHistoryParams) ([]CharacterNameHistory, error) {
+	rows, err := q.db.Query(ctx, listCharacterNameHistory, arg.CharacterID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CharacterNameHistory
+	for rows.Next() {
+		var i CharacterNameHistory
+		if err := rows.Scan(
+			&i.ID,
+			&i.CharacterID,
+			&i.UserID,
+			&i.WorldID,
+			&i.OldName,
+			&i.NewName,
+			&i.RenamedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCharactersByWorldAndName = `-- name: ListCharactersByWorldAndName :many
+SELECT id, user_id, world_id, name, x, y, chunk_x, chunk_y, created_at, facing, action_state, equipped_title FROM characters
+WHERE world_id = $1 AND lower(name) = lower($2)
+ORDER BY created_at
+`
+
+type ListCharactersByWorldAndNameParams struct {
+	WorldID pgtype.UUID
+	Name    string
+}
+
+func (q *Queries) ListCharactersByWorldAndName(ctx context.Context, arg ListCharactersByWorldAndNameParams) ([]Character, error) {
+	rows, err := q.db.Query(ctx, listCharactersByWorldAndName, arg.WorldID, arg.Name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Character
+	for rows.Next() {
+		var i Character
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.WorldID,
+			&i.Name,
+			&i.X,
+			&i.Y,
+			&i.ChunkX,
+			&i.ChunkY,
+			&i.CreatedAt,
+			&i.Facing,
+			&i.ActionState,
+			&i.EquippedTitle,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lookupCharacterName = `-- name: LookupCharacterName :many
+SELECT id, character_id, user_id, world_id, old_name, new_name, renamed_at FROM character_name_history
+WHERE world_id = $1
+  AND (lower(old_name) = lower($2) OR lower(new_name) = lower($2))
+ORDER BY renamed_at DESC, id DESC
+LIMIT $3
+`
+
+type LookupCharacterNameParams struct {
+	WorldID    pgtype.UUID
+	Name       string
+	MaxResults int32
+}
+
+// Renames from or to a name in a world, ignoring case, newest first
+func (q *Queries) LookupCharacterName(ctx context.Context, arg LookupCharacterNameParams) ([]CharacterNameHistory, error) {
+	rows, err := q.db.Query(ctx, lookupCharacterName, arg.WorldID, arg.Name, arg.MaxResults)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CharacterNameHistory
+	for rows.Next() {
+		var i CharacterNameHistory
+		if err := rows.Scan(
+			&i.ID,
+			&i.CharacterID,
+			&i.UserID,
+			&i.WorldID,
+			&i.OldName,
+			&i.NewName,
+			&i.RenamedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const renameCharacter = `-- name: RenameCharacter :one
+UPDATE characters
+SET name = $2
+WHERE id = $1
+RETURNING id, user_id, world_id, name, x, y, chunk_x, chunk_y, created_at, facing, action_state, equipped_title
+`
+
+type RenameCharacterParams struct {
+	ID   pgtype.UUID
+	Name string
+}
+
+func (q *Queries) RenameCharacter(ctx context.Context, arg RenameCharacterParams) (Character, error) {
+	row := q.db.QueryRow(ctx, renameCharacter, arg.ID, arg.Name)
+	var i Character
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.WorldID,
+		&i.Name,
+		&i.X,
+		&i.Y,
+		&i.ChunkX,
+		&i.ChunkY,
+		&i.CreatedAt,
+		&i.Facing,
+		&i.ActionState,
+		&i.EquippedTitle,
+	)
+	return i, err
+}
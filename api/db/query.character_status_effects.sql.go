@@ -0,0 +1,147 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.character_status_effects.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const applyStatusEffect = `-- name: ApplyStatusEffect :one
+INSERT INTO character_status_effects (character_id, effect_key, effect_type, magnitude, stacks, source, applied_at, expires_at)
+VALUES ($1, $2, $3, $4, 1, $5, $6, $7)
+ON CONFLICT (character_id, effect_key) DO UPDATE
+SET effect_type = EXCLUDED.effect_type,
+    magnitude = EXCLUDED.magnitude,
+    source = EXCLUDED.source,
+    applied_at = EXCLUDED.applied_at,
+    stacks = CASE
+      WHEN character_status_effects.expires_at <= EXCLUDED.applied_at THEN 1
+      WHEN $8::text = 'intensity' THEN LEAST(character_status_effects.stacks + 1, $9::integer)
+      ELSE character_status_effects.stacks
+    END,
+    expires_at = CASE
+      WHEN $8::text = 'extend' AND character_status_effects.expires_at > EXCLUDED.applied_at
+        THEN character_status_effects.expires_at + (EXCLUDED.expires_at - EXCLUDED.applied_at)
+      ELSE EXCLUDED.expires_at
+    END
+RETURNING character_id, effect_key, effect_type, magnitude, stacks, source, applied_at, expires_at
+`
+
+type ApplyStatusEffectParams struct {
+	CharacterID pgtype.UUID
+	EffectKey   string
+	EffectType  int32
+	Magnitude   float64
+	Source      string
+	AppliedAt   pgtype.Timestamp
+	ExpiresAt   pgtype.Timestamp
+	Stacking    string
+	MaxStacks   int32
+}
+
+// Applies an effect in one statement so concurrent applications stack correctly. An
+// expired row is replaced outright; otherwise "intensity" adds a stack (up to
+// max_stacks) and "extend" adds the new duration to the time remaining.
+func (q *Queries) ApplyStatusEffect(ctx context.Context, arg ApplyStatusEffectParams) (CharacterStatusEffect, error) {
+	row := q.db.QueryRow(ctx, applyStatusEffect,
+		arg.CharacterID,
+		arg.EffectKey,
+		arg.EffectType,
+		arg.Magnitude,
+		arg.Source,
+		arg.AppliedAt,
+		arg.ExpiresAt,
+		arg.Stacking,
+		arg.MaxStacks,
+	)
+	var i CharacterStatusEffect
+	err := row.Scan(
+		&i.CharacterID,
+		&i.EffectKey,
+		&i.EffectType,
+		&i.Magnitude,
+		&i.Stacks,
+		&i.Source,
+		&i.AppliedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const deleteExpiredStatusEffects = `-- name: DeleteExpiredStatusEffects :execrows
+DELETE FROM character_status_effects
+WHERE expires_at <= $1
+`
+
+func (q *Queries) DeleteExpiredStatusEffects(ctx context.Context, expiresAt pgtype.Timestamp) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteExpiredStatusEffects, expiresAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const listActiveStatusEffects = `-- name: ListActiveStatusEffects :many
+
+SELECT character_id, effect_key, effect_type, magnitude, stacks, source, applied_at, expires_at FROM character_status_effects
+WHERE character_id = ANY($1::uuid[]) AND expires_at > $2
+ORDER BY character_id, expires_at, effect_key
+`
+
+type ListActiveStatusEffectsParams struct {
+	CharacterIds []pgtype.UUID
+	Now          pgtype.Timestamp
+}
+
+// Character Status Effect Operations
+func (q *Queries) ListActiveStatusEffects(ctx context.Context, arg ListActiveStatusEffectsParams) ([]CharacterStatusEffect, error) {
+	rows, err := q.db.Query(ctx, listActiveStatusEffects, arg.CharacterIds, arg.Now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CharacterStatusEffect
+	for rows.Next() {
+		var i CharacterStatusEffect
+		if err := rows.Scan(
+			&i.CharacterID,
+			&i.EffectKey,
+			&i.EffectType,
+			&i.Magnitude,
+			&i.Stacks,
+			&i.Source,
+			&i.AppliedAt,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const removeStatusEffect = `-- name: RemoveStatusEffect :execrows
+DELETE FROM character_status_effects
+WHERE character_id = $1 AND effect_key = $2
+`
+
+type RemoveStatusEffectParams struct {
+	CharacterID pgtype.UUID
+	EffectKey   string
+}
+
+func (q *Queries) RemoveStatusEffect(ctx context.Context, arg RemoveStatusEffectParams) (int64, error) {
+	result, err := q.db.Exec(ctx, removeStatusEffect, arg.CharacterID, arg.EffectKey)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
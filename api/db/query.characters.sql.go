@@ -12,20 +12,26 @@ import (
 )
 
 const createCharacter = `-- name: CreateCharacter :one
-INSERT INTO characters (user_id, name, x, y, chunk_x, chunk_y)
-VALUES ($1, $2, $3, $4, $5, $6)
-RETURNING id, user_id, name, x, y, chunk_x, chunk_y, created_at
+INSERT INTO characters (user_id, world_id, name, x, y, chunk_x, chunk_y)
+VALUES (
+  $1,
+  COALESCE($7::uuid, (SELECT id FROM worlds ORDER BY created_at ASC LIMIT 1)),
+  $2, $3, $4, $5, $6
+)
+RETURNING id, user_id, world_id, name, x, y, chunk_x, chunk_y, created_at, facing, action_state, equipped_title
 `
 
 type CreateCharacterParams struct {
-	UserID pgtype.UUID
-	Name   string
-	X      int32
-	Y      int32
-	ChunkX int32
-	ChunkY int32
+	UserID  pgtype.UUID
+	Name    string
+	X       int32
+	Y       int32
+	ChunkX  int32
+	ChunkY  int32
+	WorldID pgtype.UUID
 }
 
+// A NULL world_id places the character in the default (oldest) world
 func (q *Queries) CreateCharacter(ctx context.Context, arg CreateCharacterParams) (Character, error) {
 	row := q.db.QueryRow(ctx, createCharacter,
 		arg.UserID,
@@ -34,17 +40,22 @@ func (q *Queries) CreateCharacter(ctx context.Context, arg CreateCharacterParams
 		arg.Y,
 		arg.ChunkX,
 		arg.ChunkY,
+		arg.WorldID,
 	)
 	var i Character
 	err := row.Scan(
 		&i.ID,
 		&i.UserID,
+		&i.WorldID,
 		&i.Name,
 		&i.X,
 		&i.Y,
 		&i.ChunkX,
 		&i.ChunkY,
 		&i.CreatedAt,
+		&i.Facing,
+		&i.ActionState,
+		&i.EquippedTitle,
 	)
 	return i, err
 }
@@ -60,7 +71,7 @@ func (q *Queries) DeleteCharacter(ctx context.Context, id pgtype.UUID) error {
 }
 
 const getCharacterById = `-- name: GetCharacterById :one
-SELECT id, user_id, name, x, y, chunk_x, chunk_y, created_at FROM characters
+SELECT id, user_id, world_id, name, x, y, chunk_x, chunk_y, created_at, facing, action_state, equipped_title FROM characters
 WHERE id = $1
 `
 
@@ -70,18 +81,22 @@ func (q *Queries) GetCharacterById(ctx context.Context, id pgtype.UUID) (Charact
 	err := row.Scan(
 		&i.ID,
 		&i.UserID,
+		&i.WorldID,
 		&i.Name,
 		&i.X,
 		&i.Y,
 		&i.ChunkX,
 		&i.ChunkY,
 		&i.CreatedAt,
+		&i.Facing,
+		&i.ActionState,
+		&i.EquippedTitle,
 	)
 	return i, err
 }
 
 const getCharacterByUserAndName = `-- name: GetCharacterByUserAndName :one
-SELECT id, user_id, name, x, y, chunk_x, chunk_y, created_at FROM characters
+SELECT id, user_id, world_id, name, x, y, chunk_x, chunk_y, created_at, facing, action_state, equipped_title FROM characters
 WHERE user_id = $1 AND name = $2
 `
 
@@ -96,18 +111,22 @@ func (q *Queries) GetCharacterByUserAndName(ctx context.Context, arg GetCharacte
 	err := row.Scan(
 		&i.ID,
 		&i.UserID,
+		&i.WorldID,
 		&i.Name,
 		&i.X,
 		&i.Y,
 		&i.ChunkX,
 		&i.ChunkY,
 		&i.CreatedAt,
+		&i.Facing,
+		&i.ActionState,
+		&i.EquippedTitle,
 	)
 	return i, err
 }
 
 const getCharactersByUser = `-- name: GetCharactersByUser :many
-SELECT id, user_id, name, x, y, chunk_x, chunk_y, created_at FROM characters
+SELECT id, user_id, world_id, name, x, y, chunk_x, chunk_y, created_at, facing, action_state, equipped_title FROM characters
 WHERE user_id = $1
 ORDER BY created_at DESC
 `
@@ -124,12 +143,60 @@ func (q *Queries) GetCharactersByUser(ctx context.Context, userID pgtype.UUID) (
 		if err := rows.Scan(
 			&i.ID,
 			&i.UserID,
+			&i.WorldID,
+			&i.Name,
+			&i.X,
+			&i.Y,
+			&i.ChunkX,
+			&i.ChunkY,
+			&i.CreatedAt,
+			&i.Facing,
+			&i.ActionState,
+			&i.EquippedTitle,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCharactersByUserInWorld = `-- name: GetCharactersByUserInWorld :many
+SELECT id, user_id, world_id, name, x, y, chunk_x, chunk_y, created_at, facing, action_state, equipped_title FROM characters
+WHERE user_id = $1 AND world_id = $2
+ORDER BY created_at DESC
+`
+
+type GetCharactersByUserInWorldParams struct {
+	UserID  pgtype.UUID
+	WorldID pgtype.UUID
+}
+
+func (q *Queries) GetCharactersByUserInWorld(ctx context.Context, arg GetCharactersByUserInWorldParams) ([]Character, error) {
+	rows, err := q.db.Query(ctx, getCharactersByUserInWorld, arg.UserID, arg.WorldID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Character
+	for rows.Next() {
+		var i Character
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.WorldID,
 			&i.Name,
 			&i.X,
 			&i.Y,
 			&i.ChunkX,
 			&i.ChunkY,
 			&i.CreatedAt,
+			&i.Facing,
+			&i.ActionState,
+			&i.EquippedTitle,
 		); err != nil {
 			return nil, err
 		}
@@ -142,17 +209,122 @@ func (q *Queries) GetCharactersByUser(ctx context.Context, userID pgtype.UUID) (
 }
 
 const getCharactersInChunk = `-- name: GetCharactersInChunk :many
-SELECT id, user_id, name, x, y, chunk_x, chunk_y, created_at FROM characters
-WHERE chunk_x = $1 AND chunk_y = $2
+SELECT id, user_id, world_id, name, x, y, chunk_x, chunk_y, created_at, facing, action_state, equipped_title FROM characters
+WHERE world_id = $1 AND chunk_x = $2 AND chunk_y = $3
 `
 
 type GetCharactersInChunkParams struct {
-	ChunkX int32
-	ChunkY int32
+	WorldID pgtype.UUID
+	ChunkX  int32
+	ChunkY  int32
 }
 
 func (q *Queries) GetCharactersInChunk(ctx context.Context, arg GetCharactersInChunkParams) ([]Character, error) {
-	rows, err := q.db.Query(ctx, getCharactersInChunk, arg.ChunkX, arg.ChunkY)
+	rows, err := q.db.Query(ctx, getCharactersInChunk, arg.WorldID, arg.ChunkX, arg.ChunkY)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Character
+	for rows.Next() {
+		var i Character
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.WorldID,
+			&i.Name,
+			&i.X,
+			&i.Y,
+			&i.ChunkX,
+			&i.ChunkY,
+			&i.CreatedAt,
+			&i.Facing,
+			&i.ActionState,
+			&i.EquippedTitle,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCharactersByIds = `-- name: ListCharactersByIds :many
+SELECT id, user_id, world_id, name, x, y, chunk_x, chunk_y, created_at, facing, action_state, equipped_title FROM characters
+WHERE id = ANY($1::uuid[])
+`
+
+func (q *Queries) ListCharactersByIds(ctx context.Context, ids []pgtype.UUID) ([]Character, error) {
+	rows, err := q.db.Query(ctx, listCharactersByIds, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Character
+	for rows.Next() {
+		var i Character
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.WorldID,
+			&i.Name,
+			&i.X,
+			&i.Y,
+			&i.ChunkX,
+			&i.ChunkY,
+			&i.CreatedAt,
+			&i.Facing,
+			&i.ActionState,
+			&i.EquippedTitle,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCharactersInArea = `-- name: ListCharactersInArea :many
+SELECT id, user_id, world_id, name, x, y, chunk_x, chunk_y, created_at, facing, action_state, equipped_title FROM characters
+WHERE world_id = $1
+  AND chunk_x BETWEEN $2 AND $3
+  AND chunk_y BETWEEN $4 AND $5
+  AND x BETWEEN $6 AND $7
+  AND y BETWEEN $8 AND $9
+ORDER BY id
+`
+
+type ListCharactersInAreaParams struct {
+	WorldID   pgtype.UUID
+	MinChunkX int32
+	MaxChunkX int32
+	MinChunkY int32
+	MaxChunkY int32
+	MinX      int32
+	MaxX      int32
+	MinY      int32
+	MaxY      int32
+}
+
+// The chunk range narrows the scan to the position index before the exact cell bounds
+func (q *Queries) ListCharactersInArea(ctx context.Context, arg ListCharactersInAreaParams) ([]Character, error) {
+	rows, err := q.db.Query(ctx, listCharactersInArea,
+		arg.WorldID,
+		arg.MinChunkX,
+		arg.MaxChunkX,
+		arg.MinChunkY,
+		arg.MaxChunkY,
+		arg.MinX,
+		arg.MaxX,
+		arg.MinY,
+		arg.MaxY,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -163,12 +335,16 @@ func (q *Queries) GetCharactersInChunk(ctx context.Context, arg GetCharactersInC
 		if err := rows.Scan(
 			&i.ID,
 			&i.UserID,
+			&i.WorldID,
 			&i.Name,
 			&i.X,
 			&i.Y,
 			&i.ChunkX,
 			&i.ChunkY,
 			&i.CreatedAt,
+			&i.Facing,
+			&i.ActionState,
+			&i.EquippedTitle,
 		); err != nil {
 			return nil, err
 		}
@@ -180,19 +356,54 @@ func (q *Queries) GetCharactersInChunk(ctx context.Context, arg GetCharactersInC
 	return items, nil
 }
 
+const updateCharacterActionState = `-- name: UpdateCharacterActionState :one
+UPDATE characters
+SET action_state = $2
+WHERE id = $1
+RETURNING id, user_id, world_id, name, x, y, chunk_x, chunk_y, created_at, facing, action_state, equipped_title
+`
+
+type UpdateCharacterActionStateParams struct {
+	ID          pgtype.UUID
+	ActionState int32
+}
+
+// Changes what the character is doing without moving it
+func (q *Queries) UpdateCharacterActionState(ctx context.Context, arg UpdateCharacterActionStateParams) (Character, error) {
+	row := q.db.QueryRow(ctx, updateCharacterActionState, arg.ID, arg.ActionState)
+	var i Character
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.WorldID,
+		&i.Name,
+		&i.X,
+		&i.Y,
+		&i.ChunkX,
+		&i.ChunkY,
+		&i.CreatedAt,
+		&i.Facing,
+		&i.ActionState,
+		&i.EquippedTitle,
+	)
+	return i, err
+}
+
 const updateCharacterPosition = `-- name: UpdateCharacterPosition :one
 UPDATE characters
-SET x = $2, y = $3, chunk_x = $4, chunk_y = $5
+SET x = $2, y = $3, chunk_x = $4, chunk_y = $5, facing = $6, action_state = $7
 WHERE id = $1
-RETURNING id, user_id, name, x, y, chunk_x, chunk_y, created_at
+RETURNING id, user_id, world_id, name, x, y, chunk_x, chunk_y, created_at, facing, action_state, equipped_title
 `
 
 type UpdateCharacterPositionParams struct {
-	ID     pgtype.UUID
-	X      int32
-	Y      int32
-	ChunkX int32
-	ChunkY int32
+	ID          pgtype.UUID
+	X           int32
+	Y           int32
+	ChunkX      int32
+	ChunkY      int32
+	Facing      int32
+	ActionState int32
 }
 
 func (q *Queries) UpdateCharacterPosition(ctx context.Context, arg UpdateCharacterPositionParams) (Character, error) {
@@ -202,17 +413,23 @@ func (q *Queries) UpdateCharacterPosition(ctx context.Context, arg UpdateCharact
 		arg.Y,
 		arg.ChunkX,
 		arg.ChunkY,
+		arg.Facing,
+		arg.ActionState,
 	)
 	var i Character
 	err := row.Scan(
 		&i.ID,
 		&i.UserID,
+		&i.WorldID,
 		&i.Name,
 		&i.X,
 		&i.Y,
 		&i.ChunkX,
 		&i.ChunkY,
 		&i.CreatedAt,
+		&i.Facing,
+		&i.ActionState,
+		&i.EquippedTitle,
 	)
 	return i, err
 }
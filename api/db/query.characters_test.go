@@ -33,13 +33,13 @@ func TestCreateCharacter(t *testing.T) {
 				now := time.Now()
 				testCharacterID := generateTestUUID()
 				rows := pgxmock.NewRows([]string{
-					"id", "user_id", "name", "x", "y", "chunk_x", "chunk_y", "created_at",
+					"id", "user_id", "world_id", "name", "x", "y", "chunk_x", "chunk_y", "created_at", "facing", "action_state", "equipped_title",
 				}).AddRow(
-					testCharacterID, "550e8400-e29b-41d4-a716-446655440000", "TestHero",
-					int32(100), int32(200), int32(1), int32(2), pgtype.Timestamp{Time: now, Valid: true},
+					testCharacterID, "550e8400-e29b-41d4-a716-446655440000", "650e8400-e29b-41d4-a716-446655440000", "TestHero",
+					int32(100), int32(200), int32(1), int32(2), pgtype.Timestamp{Time: now, Valid: true}, int32(3), int32(1), "",
 				)
 				mock.ExpectQuery("INSERT INTO characters").
-					WithArgs(mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), "TestHero", int32(100), int32(200), int32(1), int32(2)).
+					WithArgs(mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), "TestHero", int32(100), int32(200), int32(1), int32(2), pgtype.UUID{}).
 					WillReturnRows(rows)
 			},
 			wantErr: false,
@@ -64,7 +64,7 @@ func TestCreateCharacter(t *testing.T) {
 			},
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				mock.ExpectQuery("INSERT INTO characters").
-					WithArgs(mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), "ExistingHero", int32(0), int32(0), int32(0), int32(0)).
+					WithArgs(mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), "ExistingHero", int32(0), int32(0), int32(0), int32(0), pgtype.UUID{}).
 					WillReturnError(sql.ErrConnDone) // Simulate unique constraint violation
 			},
 			wantErr: true,
@@ -81,7 +81,7 @@ func TestCreateCharacter(t *testing.T) {
 			},
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				mock.ExpectQuery("INSERT INTO characters").
-					WithArgs(mustParseUUID("999e8400-e29b-41d4-a716-446655440000"), "OrphanHero", int32(0), int32(0), int32(0), int32(0)).
+					WithArgs(mustParseUUID("999e8400-e29b-41d4-a716-446655440000"), "OrphanHero", int32(0), int32(0), int32(0), int32(0), pgtype.UUID{}).
 					WillReturnError(sql.ErrConnDone) // Simulate foreign key constraint violation
 			},
 			wantErr: true,
@@ -125,10 +125,10 @@ func TestGetCharacterById(t *testing.T) {
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				now := time.Now()
 				rows := pgxmock.NewRows([]string{
-					"id", "user_id", "name", "x", "y", "chunk_x", "chunk_y", "created_at",
+					"id", "user_id", "world_id", "name", "x", "y", "chunk_x", "chunk_y", "created_at", "facing", "action_state", "equipped_title",
 				}).AddRow(
-					"750e8400-e29b-41d4-a716-446655440000", "550e8400-e29b-41d4-a716-446655440000", "TestHero",
-					int32(100), int32(200), int32(1), int32(2), pgtype.Timestamp{Time: now, Valid: true},
+					"750e8400-e29b-41d4-a716-446655440000", "550e8400-e29b-41d4-a716-446655440000", "650e8400-e29b-41d4-a716-446655440000", "TestHero",
+					int32(100), int32(200), int32(1), int32(2), pgtype.Timestamp{Time: now, Valid: true}, int32(3), int32(1), "",
 				)
 				mock.ExpectQuery("SELECT (.+) FROM characters WHERE id = \\$1").
 					WithArgs(mustParseUUID("750e8400-e29b-41d4-a716-446655440000")).
@@ -191,15 +191,15 @@ func TestGetCharactersByUser(t *testing.T) {
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				now := time.Now()
 				rows := pgxmock.NewRows([]string{
-					"id", "user_id", "name", "x", "y", "chunk_x", "chunk_y", "created_at",
+					"id", "user_id", "world_id", "name", "x", "y", "chunk_x", "chunk_y", "created_at", "facing", "action_state", "equipped_title",
 				}).
 					AddRow(
-						generateTestUUID(), "550e8400-e29b-41d4-a716-446655440000", "Character1",
-						int32(10), int32(20), int32(0), int32(0), pgtype.Timestamp{Time: now, Valid: true},
+						generateTestUUID(), "550e8400-e29b-41d4-a716-446655440000", "650e8400-e29b-41d4-a716-446655440000", "Character1",
+						int32(10), int32(20), int32(0), int32(0), pgtype.Timestamp{Time: now, Valid: true}, int32(3), int32(1), "",
 					).
 					AddRow(
-						generateTestUUID(), "550e8400-e29b-41d4-a716-446655440000", "Character2",
-						int32(50), int32(60), int32(1), int32(1), pgtype.Timestamp{Time: now.Add(-time.Hour), Valid: true},
+						generateTestUUID(), "550e8400-e29b-41d4-a716-446655440000", "650e8400-e29b-41d4-a716-446655440000", "Character2",
+						int32(50), int32(60), int32(1), int32(1), pgtype.Timestamp{Time: now.Add(-time.Hour), Valid: true}, int32(3), int32(1), "",
 					)
 				mock.ExpectQuery("SELECT (.+) FROM characters WHERE user_id = \\$1 ORDER BY created_at DESC").
 					WithArgs(mustParseUUID("550e8400-e29b-41d4-a716-446655440000")).
@@ -219,7 +219,7 @@ func TestGetCharactersByUser(t *testing.T) {
 			userID: mustParseUUID("999e8400-e29b-41d4-a716-446655440000"),
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				rows := pgxmock.NewRows([]string{
-					"id", "user_id", "name", "x", "y", "chunk_x", "chunk_y", "created_at",
+					"id", "user_id", "world_id", "name", "x", "y", "chunk_x", "chunk_y", "created_at", "facing", "action_state", "equipped_title",
 				})
 				mock.ExpectQuery("SELECT (.+) FROM characters WHERE user_id = \\$1 ORDER BY created_at DESC").
 					WithArgs(mustParseUUID("999e8400-e29b-41d4-a716-446655440000")).
@@ -255,6 +255,33 @@ func TestGetCharactersByUser(t *testing.T) {
 	}
 }
 
+func TestGetCharactersByUserInWorld(t *testing.T) {
+	mockPool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockPool.Close()
+
+	params := GetCharactersByUserInWorldParams{
+		UserID:  mustParseUUID("550e8400-e29b-41d4-a716-446655440000"),
+		WorldID: mustParseUUID("650e8400-e29b-41d4-a716-446655440000"),
+	}
+	rows := pgxmock.NewRows([]string{
+		"id", "user_id", "world_id", "name", "x", "y", "chunk_x", "chunk_y", "created_at", "facing", "action_state", "equipped_title",
+	}).
+		AddRow(
+			generateTestUUID(), "550e8400-e29b-41d4-a716-446655440000", "650e8400-e29b-41d4-a716-446655440000", "Character1",
+			int32(10), int32(20), int32(0), int32(0), pgtype.Timestamp{Time: time.Now(), Valid: true}, int32(3), int32(1), "",
+		)
+	mockPool.ExpectQuery("SELECT (.+) FROM characters WHERE user_id = \\$1 AND world_id = \\$2 ORDER BY created_at DESC").
+		WithArgs(params.UserID, params.WorldID).
+		WillReturnRows(rows)
+
+	characters, err := New(mockPool).GetCharactersByUserInWorld(createTestContext(), params)
+	require.NoError(t, err)
+	require.Len(t, characters, 1)
+	assert.Equal(t, params.WorldID, characters[0].WorldID)
+	assert.NoError(t, mockPool.ExpectationsWereMet())
+}
+
 func TestGetCharacterByUserAndName(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -272,10 +299,10 @@ func TestGetCharacterByUserAndName(t *testing.T) {
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				now := time.Now()
 				rows := pgxmock.NewRows([]string{
-					"id", "user_id", "name", "x", "y", "chunk_x", "chunk_y", "created_at",
+					"id", "user_id", "world_id", "name", "x", "y", "chunk_x", "chunk_y", "created_at", "facing", "action_state", "equipped_title",
 				}).AddRow(
-					generateTestUUID(), "550e8400-e29b-41d4-a716-446655440000", "UniqueHero",
-					int32(75), int32(125), int32(2), int32(3), pgtype.Timestamp{Time: now, Valid: true},
+					generateTestUUID(), "550e8400-e29b-41d4-a716-446655440000", "650e8400-e29b-41d4-a716-446655440000", "UniqueHero",
+					int32(75), int32(125), int32(2), int32(3), pgtype.Timestamp{Time: now, Valid: true}, int32(3), int32(1), "",
 				)
 				mock.ExpectQuery("SELECT (.+) FROM characters WHERE user_id = \\$1 AND name = \\$2").
 					WithArgs(mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), "UniqueHero").
@@ -337,24 +364,25 @@ func TestGetCharactersInChunk(t *testing.T) {
 		{
 			name: "characters in same chunk",
 			params: GetCharactersInChunkParams{
-				ChunkX: 5,
-				ChunkY: 10,
+				WorldID: mustParseUUID("650e8400-e29b-41d4-a716-446655440000"),
+				ChunkX:  5,
+				ChunkY:  10,
 			},
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				now := time.Now()
 				rows := pgxmock.NewRows([]string{
-					"id", "user_id", "name", "x", "y", "chunk_x", "chunk_y", "created_at",
+					"id", "user_id", "world_id", "name", "x", "y", "chunk_x", "chunk_y", "created_at", "facing", "action_state", "equipped_title",
 				}).
 					AddRow(
-						generateTestUUID(), generateTestUUID(), "Hero1",
-						int32(500), int32(1000), int32(5), int32(10), pgtype.Timestamp{Time: now, Valid: true},
+						generateTestUUID(), generateTestUUID(), "650e8400-e29b-41d4-a716-446655440000", "Hero1",
+						int32(500), int32(1000), int32(5), int32(10), pgtype.Timestamp{Time: now, Valid: true}, int32(3), int32(1), "",
 					).
 					AddRow(
-						generateTestUUID(), generateTestUUID(), "Hero2",
-						int32(510), int32(1020), int32(5), int32(10), pgtype.Timestamp{Time: now, Valid: true},
+						generateTestUUID(), generateTestUUID(), "650e8400-e29b-41d4-a716-446655440000", "Hero2",
+						int32(510), int32(1020), int32(5), int32(10), pgtype.Timestamp{Time: now, Valid: true}, int32(3), int32(1), "",
 					)
-				mock.ExpectQuery("SELECT (.+) FROM characters WHERE chunk_x = \\$1 AND chunk_y = \\$2").
-					WithArgs(int32(5), int32(10)).
+				mock.ExpectQuery("SELECT (.+) FROM characters WHERE world_id = \\$1 AND chunk_x = \\$2 AND chunk_y = \\$3").
+					WithArgs(mustParseUUID("650e8400-e29b-41d4-a716-446655440000"), int32(5), int32(10)).
 					WillReturnRows(rows)
 			},
 			wantErr: false,
@@ -369,15 +397,16 @@ func TestGetCharactersInChunk(t *testing.T) {
 		{
 			name: "empty chunk",
 			params: GetCharactersInChunkParams{
-				ChunkX: 999,
-				ChunkY: 999,
+				WorldID: mustParseUUID("650e8400-e29b-41d4-a716-446655440000"),
+				ChunkX:  999,
+				ChunkY:  999,
 			},
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				rows := pgxmock.NewRows([]string{
-					"id", "user_id", "name", "x", "y", "chunk_x", "chunk_y", "created_at",
+					"id", "user_id", "world_id", "name", "x", "y", "chunk_x", "chunk_y", "created_at", "facing", "action_state", "equipped_title",
 				})
-				mock.ExpectQuery("SELECT (.+) FROM characters WHERE chunk_x = \\$1 AND chunk_y = \\$2").
-					WithArgs(int32(999), int32(999)).
+				mock.ExpectQuery("SELECT (.+) FROM characters WHERE world_id = \\$1 AND chunk_x = \\$2 AND chunk_y = \\$3").
+					WithArgs(mustParseUUID("650e8400-e29b-41d4-a716-446655440000"), int32(999), int32(999)).
 					WillReturnRows(rows)
 			},
 			wantErr: false,
@@ -430,13 +459,13 @@ func TestUpdateCharacterPosition(t *testing.T) {
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				now := time.Now()
 				rows := pgxmock.NewRows([]string{
-					"id", "user_id", "name", "x", "y", "chunk_x", "chunk_y", "created_at",
+					"id", "user_id", "world_id", "name", "x", "y", "chunk_x", "chunk_y", "created_at", "facing", "action_state", "equipped_title",
 				}).AddRow(
-					"750e8400-e29b-41d4-a716-446655440000", "550e8400-e29b-41d4-a716-446655440000", "TestHero",
-					int32(150), int32(250), int32(3), int32(5), pgtype.Timestamp{Time: now, Valid: true},
+					"750e8400-e29b-41d4-a716-446655440000", "550e8400-e29b-41d4-a716-446655440000", "650e8400-e29b-41d4-a716-446655440000", "TestHero",
+					int32(150), int32(250), int32(3), int32(5), pgtype.Timestamp{Time: now, Valid: true}, int32(3), int32(1), "",
 				)
-				mock.ExpectQuery("UPDATE characters SET x = \\$2, y = \\$3, chunk_x = \\$4, chunk_y = \\$5 WHERE id = \\$1").
-					WithArgs(mustParseUUID("750e8400-e29b-41d4-a716-446655440000"), int32(150), int32(250), int32(3), int32(5)).
+				mock.ExpectQuery("UPDATE characters SET x = \\$2, y = \\$3, chunk_x = \\$4, chunk_y = \\$5, facing = \\$6, action_state = \\$7 WHERE id = \\$1").
+					WithArgs(mustParseUUID("750e8400-e29b-41d4-a716-446655440000"), int32(150), int32(250), int32(3), int32(5), int32(0), int32(0)).
 					WillReturnRows(rows)
 			},
 			wantErr: false,
@@ -458,8 +487,8 @@ func TestUpdateCharacterPosition(t *testing.T) {
 				ChunkY: 6,
 			},
 			setupMock: func(mock pgxmock.PgxPoolIface) {
-				mock.ExpectQuery("UPDATE characters SET x = \\$2, y = \\$3, chunk_x = \\$4, chunk_y = \\$5 WHERE id = \\$1").
-					WithArgs(mustParseUUID("750e8400-e29b-41d4-a716-446655440000"), int32(160), int32(260), int32(4), int32(6)).
+				mock.ExpectQuery("UPDATE characters SET x = \\$2, y = \\$3, chunk_x = \\$4, chunk_y = \\$5, facing = \\$6, action_state = \\$7 WHERE id = \\$1").
+					WithArgs(mustParseUUID("750e8400-e29b-41d4-a716-446655440000"), int32(160), int32(260), int32(4), int32(6), int32(0), int32(0)).
 					WillReturnError(sql.ErrNoRows) // Simulate optimistic locking failure or non-existent character
 			},
 			wantErr: true,
@@ -476,13 +505,13 @@ func TestUpdateCharacterPosition(t *testing.T) {
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				now := time.Now()
 				rows := pgxmock.NewRows([]string{
-					"id", "user_id", "name", "x", "y", "chunk_x", "chunk_y", "created_at",
+					"id", "user_id", "world_id", "name", "x", "y", "chunk_x", "chunk_y", "created_at", "facing", "action_state", "equipped_title",
 				}).AddRow(
-					"750e8400-e29b-41d4-a716-446655440000", "550e8400-e29b-41d4-a716-446655440000", "TestHero",
-					int32(1000), int32(1000), int32(10), int32(10), pgtype.Timestamp{Time: now, Valid: true},
+					"750e8400-e29b-41d4-a716-446655440000", "550e8400-e29b-41d4-a716-446655440000", "650e8400-e29b-41d4-a716-446655440000", "TestHero",
+					int32(1000), int32(1000), int32(10), int32(10), pgtype.Timestamp{Time: now, Valid: true}, int32(3), int32(1), "",
 				)
-				mock.ExpectQuery("UPDATE characters SET x = \\$2, y = \\$3, chunk_x = \\$4, chunk_y = \\$5 WHERE id = \\$1").
-					WithArgs(mustParseUUID("750e8400-e29b-41d4-a716-446655440000"), int32(1000), int32(1000), int32(10), int32(10)).
+				mock.ExpectQuery("UPDATE characters SET x = \\$2, y = \\$3, chunk_x = \\$4, chunk_y = \\$5, facing = \\$6, action_state = \\$7 WHERE id = \\$1").
+					WithArgs(mustParseUUID("750e8400-e29b-41d4-a716-446655440000"), int32(1000), int32(1000), int32(10), int32(10), int32(0), int32(0)).
 					WillReturnRows(rows)
 			},
 			wantErr: false,
@@ -602,14 +631,14 @@ func TestCharacterBusinessLogic(t *testing.T) {
 
 		now := time.Now()
 		rows := pgxmock.NewRows([]string{
-			"id", "user_id", "name", "x", "y", "chunk_x", "chunk_y", "created_at",
+			"id", "user_id", "world_id", "name", "x", "y", "chunk_x", "chunk_y", "created_at", "facing", "action_state", "equipped_title",
 		}).AddRow(
-			"750e8400-e29b-41d4-a716-446655440000", "550e8400-e29b-41d4-a716-446655440000", "TestHero",
-			int32(-100), int32(-200), int32(-1), int32(-2), pgtype.Timestamp{Time: now, Valid: true},
+			"750e8400-e29b-41d4-a716-446655440000", "550e8400-e29b-41d4-a716-446655440000", "650e8400-e29b-41d4-a716-446655440000", "TestHero",
+			int32(-100), int32(-200), int32(-1), int32(-2), pgtype.Timestamp{Time: now, Valid: true}, int32(3), int32(1), "",
 		)
 
-		mockPool.ExpectQuery("UPDATE characters SET x = \\$2, y = \\$3, chunk_x = \\$4, chunk_y = \\$5 WHERE id = \\$1").
-			WithArgs(mustParseUUID("750e8400-e29b-41d4-a716-446655440000"), int32(-100), int32(-200), int32(-1), int32(-2)).
+		mockPool.ExpectQuery("UPDATE characters SET x = \\$2, y = \\$3, chunk_x = \\$4, chunk_y = \\$5, facing = \\$6, action_state = \\$7 WHERE id = \\$1").
+			WithArgs(mustParseUUID("750e8400-e29b-41d4-a716-446655440000"), int32(-100), int32(-200), int32(-1), int32(-2), int32(0), int32(0)).
 			WillReturnRows(rows)
 
 		character, err := queries.UpdateCharacterPosition(createTestContext(), params)
@@ -640,7 +669,7 @@ func TestCharacterBusinessLogic(t *testing.T) {
 		}
 
 		mockPool.ExpectQuery("INSERT INTO characters").
-			WithArgs(mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), "", int32(0), int32(0), int32(0), int32(0)).
+			WithArgs(mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), "", int32(0), int32(0), int32(0), int32(0), pgtype.UUID{}).
 			WillReturnError(sql.ErrConnDone) // Simulate constraint violation
 
 		_, err = queries.CreateCharacter(createTestContext(), params)
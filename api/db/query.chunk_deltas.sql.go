@@ -0,0 +1,160 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.chunk_deltas.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createChunkDelta = `-- name: CreateChunkDelta :one
+
+INSERT INTO chunk_deltas (world_id, chunk_x, chunk_y, cell_index, terrain_type, previous_terrain_type, character_id, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id, world_id, chunk_x, chunk_y, cell_index, terrain_type, previous_terrain_type, character_id, created_at
+`
+
+type CreateChunkDeltaParams struct {
+	WorldID             pgtype.UUID
+	ChunkX              int32
+	ChunkY              int32
+	CellIndex           int32
+	TerrainType         int32
+	PreviousTerrainType int32
+	CharacterID         pgtype.UUID
+	CreatedAt           pgtype.Timestamp
+}
+
+// Chunk Delta Operations
+func (q *Queries) CreateChunkDelta(ctx context.Context, arg CreateChunkDeltaParams) (ChunkDelta, error) {
+	row := q.db.QueryRow(ctx, createChunkDelta,
+		arg.WorldID,
+		arg.ChunkX,
+		arg.ChunkY,
+		arg.CellIndex,
+		arg.TerrainType,
+		arg.PreviousTerrainType,
+		arg.CharacterID,
+		arg.CreatedAt,
+	)
+	var i ChunkDelta
+	err := row.Scan(
+		&i.ID,
+		&i.WorldID,
+		&i.ChunkX,
+		&i.ChunkY,
+		&i.CellIndex,
+		&i.TerrainType,
+		&i.PreviousTerrainType,
+		&i.CharacterID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteChunkDeltas = `-- name: DeleteChunkDeltas :execrows
+DELETE FROM chunk_deltas
+WHERE id = ANY($1::bigint[])
+`
+
+func (q *Queries) DeleteChunkDeltas(ctx context.Context, ids []int64) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteChunkDeltas, ids)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const listChunkDeltas = `-- name: ListChunkDeltas :many
+SELECT id, world_id, chunk_x, chunk_y, cell_index, terrain_type, previous_terrain_type, character_id, created_at FROM chunk_deltas
+WHERE world_id = $1 AND chunk_x = $2 AND chunk_y = $3
+ORDER BY id
+`
+
+type ListChunkDeltasParams struct {
+	WorldID pgtype.UUID
+	ChunkX  int32
+	ChunkY  int32
+}
+
+func (q *Queries) ListChunkDeltas(ctx context.Context, arg ListChunkDeltasParams) ([]ChunkDelta, error) {
+	rows, err := q.db.Query(ctx, listChunkDeltas, arg.WorldID, arg.ChunkX, arg.ChunkY)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChunkDelta
+	for rows.Next() {
+		var i ChunkDelta
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorldID,
+			&i.ChunkX,
+			&i.ChunkY,
+			&i.CellIndex,
+			&i.TerrainType,
+			&i.PreviousTerrainType,
+			&i.CharacterID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCompactableChunks = `-- name: ListCompactableChunks :many
+SELECT world_id, chunk_x, chunk_y, count(*)::integer AS delta_count
+FROM chunk_deltas
+GROUP BY world_id, chunk_x, chunk_y
+HAVING count(*) >= $1::integer OR min(created_at) <= $2::timestamp
+ORDER BY count(*) DESC
+LIMIT $3::integer
+`
+
+type ListCompactableChunksParams struct {
+	MinDeltas     int32
+	CreatedBefore pgtype.Timestamp
+	MaxChunks     int32
+}
+
+type ListCompactableChunksRow struct {
+	WorldID    pgtype.UUID
+	ChunkX     int32
+	ChunkY     int32
+	DeltaCount int32
+}
+
+// Chunks with enough pending deltas, or with deltas old enough, to fold into the base blob
+func (q *Queries) ListCompactableChunks(ctx context.Context, arg ListCompactableChunksParams) ([]ListCompactableChunksRow, error) {
+	rows, err := q.db.Query(ctx, listCompactableChunks, arg.MinDeltas, arg.CreatedBefore, arg.MaxChunks)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCompactableChunksRow
+	for rows.Next() {
+		var i ListCompactableChunksRow
+		if err := rows.Scan(
+			&i.WorldID,
+			&i.ChunkX,
+			&i.ChunkY,
+			&i.DeltaCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
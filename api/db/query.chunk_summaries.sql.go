@@ -0,0 +1,279 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.chunk_summaries.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countResourceNodesByTypeInChunk = `-- name: CountResourceNodesByTypeInChunk :many
+SELECT resource_node_type_id, COUNT(*) AS node_count
+FROM resource_nodes
+WHERE world_id = $1 AND chunk_x = $2 AND chunk_y = $3
+GROUP BY resource_node_type_id
+`
+
+type CountResourceNodesByTypeInChunkParams struct {
+	WorldID pgtype.UUID
+	ChunkX  int32
+	ChunkY  int32
+}
+
+type CountResourceNodesByTypeInChunkRow struct {
+	ResourceNodeTypeID int32
+	NodeCount          int64
+}
+
+func (q *Queries) CountResourceNodesByTypeInChunk(ctx context.Context, arg CountResourceNodesByTypeInChunkParams) ([]CountResourceNodesByTypeInChunkRow, error) {
+	rows, err := q.db.Query(ctx, countResourceNodesByTypeInChunk, arg.WorldID, arg.ChunkX, arg.ChunkY)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CountResourceNodesByTypeInChunkRow
+	for rows.Next() {
+		var i CountResourceNodesByTypeInChunkRow
+		if err := rows.Scan(&i.ResourceNodeTypeID, &i.NodeCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getChunkSummariesInRange = `-- name: GetChunkSummariesInRange :many
+SELECT world_id, chunk_x, chunk_y, terrain_histogram, node_counts, harvest_count, last_modified FROM chunk_summaries
+WHERE world_id = $1 AND
+      chunk_x >= $2 AND chunk_x <= $3 AND
+      chunk_y >= $4 AND chunk_y <= $5
+ORDER BY chunk_y, chunk_x
+`
+
+type GetChunkSummariesInRangeParams struct {
+	WorldID  pgtype.UUID
+	ChunkX   int32
+	ChunkX_2 int32
+	ChunkY   int32
+	ChunkY_2 int32
+}
+
+func (q *Queries) GetChunkSummariesInRange(ctx context.Context, arg GetChunkSummariesInRangeParams) ([]ChunkSummary, error) {
+	rows, err := q.db.Query(ctx, getChunkSummariesInRange,
+		arg.WorldID,
+		arg.ChunkX,
+		arg.ChunkX_2,
+		arg.ChunkY,
+		arg.ChunkY_2,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChunkSummary
+	for rows.Next() {
+		var i ChunkSummary
+		if err := rows.Scan(
+			&i.WorldID,
+			&i.ChunkX,
+			&i.ChunkY,
+			&i.TerrainHistogram,
+			&i.NodeCounts,
+			&i.HarvestCount,
+			&i.LastModified,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const incrementChunkHarvestCount = `-- name: IncrementChunkHarvestCount :execrows
+UPDATE chunk_summaries
+SET harvest_count = harvest_count + 1,
+    last_modified = $4
+WHERE world_id = $1 AND chunk_x = $2 AND chunk_y = $3
+`
+
+type IncrementChunkHarvestCountParams struct {
+	WorldID      pgtype.UUID
+	ChunkX       int32
+	ChunkY       int32
+	LastModified pgtype.Timestamp
+}
+
+func (q *Queries) IncrementChunkHarvestCount(ctx context.Context, arg IncrementChunkHarvestCountParams) (int64, error) {
+	result, err := q.db.Exec(ctx, incrementChunkHarvestCount,
+		arg.WorldID,
+		arg.ChunkX,
+		arg.ChunkY,
+		arg.LastModified,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const listStaleChunkSummaries = `-- name: ListStaleChunkSummaries :many
+SELECT c.world_id, c.chunk_x, c.chunk_y
+FROM chunks c
+LEFT JOIN chunk_summaries cs
+  ON cs.world_id = c.world_id AND cs.chunk_x = c.chunk_x AND cs.chunk_y = c.chunk_y
+WHERE cs.world_id IS NULL
+   OR cs.last_modified < (
+     SELECT MAX(rn.created_at) FROM resource_nodes rn
+     WHERE rn.world_id = c.world_id AND rn.chunk_x = c.chunk_x AND rn.chunk_y = c.chunk_y
+   )
+LIMIT $1
+`
+
+type ListStaleChunkSummariesRow struct {
+	WorldID pgtype.UUID
+	ChunkX  int32
+	ChunkY  int32
+}
+
+// Chunks with no summary yet, or whose resource nodes were written after the
+// summary (node generation commits separately from the chunk itself)
+func (q *Queries) ListStaleChunkSummaries(ctx context.Context, limit int32) ([]ListStaleChunkSummariesRow, error) {
+	rows, err := q.db.Query(ctx, listStaleChunkSummaries, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListStaleChunkSummariesRow
+	for rows.Next() {
+		var i ListStaleChunkSummariesRow
+		if err := rows.Scan(&i.WorldID, &i.ChunkX, &i.ChunkY); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const sumChunkHarvestsByRegion = `-- name: SumChunkHarvestsByRegion :many
+SELECT FLOOR(chunk_x::float8 / $1::integer)::integer AS region_x,
+       FLOOR(chunk_y::float8 / $1::integer)::integer AS region_y,
+       SUM(harvest_count)::bigint AS harvest_count,
+       COUNT(*)::integer AS chunk_count
+FROM chunk_summaries
+WHERE world_id = $2
+  AND chunk_y >= $3::integer
+GROUP BY 1, 2
+HAVING (FLOOR(chunk_y::float8 / $1::integer)::integer, FLOOR(chunk_x::float8 / $1::integer)::integer)
+       > ($4::integer, $5::integer)
+ORDER BY 2, 1
+LIMIT $6::integer
+`
+
+type SumChunkHarvestsByRegionParams struct {
+	RegionSize   int32
+	WorldID      pgtype.UUID
+	MinChunkY    int32
+	AfterRegionY int32
+	AfterRegionX int32
+	RowLimit     int32
+}
+
+type SumChunkHarvestsByRegionRow struct {
+	RegionX      int32
+	RegionY      int32
+	HarvestCount int64
+	ChunkCount   int32
+}
+
+// Regions are squares of region_size chunks; flooring keeps negative chunks in the right region.
+// Regions come in (region_y, region_x) order after the cursor, at most row_limit of them, so
+// an update can work through a large world in batches; min_chunk_y is the cursor row's
+// first chunk row and lets the scan skip the rows before it
+func (q *Queries) SumChunkHarvestsByRegion(ctx context.Context, arg SumChunkHarvestsByRegionParams) ([]SumChunkHarvestsByRegionRow, error) {
+	rows, err := q.db.Query(ctx, sumChunkHarvestsByRegion,
+		arg.RegionSize,
+		arg.WorldID,
+		arg.MinChunkY,
+		arg.AfterRegionY,
+		arg.AfterRegionX,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SumChunkHarvestsByRegionRow
+	for rows.Next() {
+		var i SumChunkHarvestsByRegionRow
+		if err := rows.Scan(
+			&i.RegionX,
+			&i.RegionY,
+			&i.HarvestCount,
+			&i.ChunkCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertChunkSummary = `-- name: UpsertChunkSummary :one
+
+INSERT INTO chunk_summaries (world_id, chunk_x, chunk_y, terrain_histogram, node_counts, last_modified)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (world_id, chunk_x, chunk_y) DO UPDATE
+SET terrain_histogram = EXCLUDED.terrain_histogram,
+    node_counts = EXCLUDED.node_counts,
+    last_modified = EXCLUDED.last_modified
+RETURNING world_id, chunk_x, chunk_y, terrain_histogram, node_counts, harvest_count, last_modified
+`
+
+type UpsertChunkSummaryParams struct {
+	WorldID          pgtype.UUID
+	ChunkX           int32
+	ChunkY           int32
+	TerrainHistogram []byte
+	NodeCounts       []byte
+	LastModified     pgtype.Timestamp
+}
+
+// Chunk Summary Projection
+// Harvest counts are accumulated separately and survive a rebuild
+func (q *Queries) UpsertChunkSummary(ctx context.Context, arg UpsertChunkSummaryParams) (ChunkSummary, error) {
+	row := q.db.QueryRow(ctx, upsertChunkSummary,
+		arg.WorldID,
+		arg.ChunkX,
+		arg.ChunkY,
+		arg.TerrainHistogram,
+		arg.NodeCounts,
+		arg.LastModified,
+	)
+	var i ChunkSummary
+	err := row.Scan(
+		&i.WorldID,
+		&i.ChunkX,
+		&i.ChunkY,
+		&i.TerrainHistogram,
+		&i.NodeCounts,
+		&i.HarvestCount,
+		&i.LastModified,
+	)
+	return i, err
+}
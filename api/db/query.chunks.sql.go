@@ -11,6 +11,31 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const backfillChunkPassability = `-- name: BackfillChunkPassability :exec
+UPDATE chunks
+SET passability = $4
+WHERE world_id = $1 AND chunk_x = $2 AND chunk_y = $3
+  AND (passability IS NULL OR octet_length(passability) <> octet_length($4))
+`
+
+type BackfillChunkPassabilityParams struct {
+	WorldID     pgtype.UUID
+	ChunkX      int32
+	ChunkY      int32
+	Passability []byte
+}
+
+// Stores a mask computed on read for a chunk that has none, or has one of the wrong size
+func (q *Queries) BackfillChunkPassability(ctx context.Context, arg BackfillChunkPassabilityParams) error {
+	_, err := q.db.Exec(ctx, backfillChunkPassability,
+		arg.WorldID,
+		arg.ChunkX,
+		arg.ChunkY,
+		arg.Passability,
+	)
+	return err
+}
+
 const chunkExists = `-- name: ChunkExists :one
 SELECT EXISTS(
     SELECT 1 FROM chunks
@@ -32,16 +57,17 @@ func (q *Queries) ChunkExists(ctx context.Context, arg ChunkExistsParams) (bool,
 }
 
 const createChunk = `-- name: CreateChunk :one
-INSERT INTO chunks (world_id, chunk_x, chunk_y, chunk_data)
-VALUES ($1, $2, $3, $4)
-RETURNING world_id, chunk_x, chunk_y, chunk_data, generated_at
+INSERT INTO chunks (world_id, chunk_x, chunk_y, chunk_data, passability)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING world_id, chunk_x, chunk_y, chunk_data, generated_at, quarantined_at, corrupt_chunk_data, passability, last_accessed_at, access_count
 `
 
 type CreateChunkParams struct {
-	WorldID   pgtype.UUID
-	ChunkX    int32
-	ChunkY    int32
-	ChunkData []byte
+	WorldID     pgtype.UUID
+	ChunkX      int32
+	ChunkY      int32
+	ChunkData   []byte
+	Passability []byte
 }
 
 func (q *Queries) CreateChunk(ctx context.Context, arg CreateChunkParams) (Chunk, error) {
@@ -50,6 +76,7 @@ func (q *Queries) CreateChunk(ctx context.Context, arg CreateChunkParams) (Chunk
 		arg.ChunkX,
 		arg.ChunkY,
 		arg.ChunkData,
+		arg.Passability,
 	)
 	var i Chunk
 	err := row.Scan(
@@ -58,6 +85,11 @@ func (q *Queries) CreateChunk(ctx context.Context, arg CreateChunkParams) (Chunk
 		&i.ChunkY,
 		&i.ChunkData,
 		&i.GeneratedAt,
+		&i.QuarantinedAt,
+		&i.CorruptChunkData,
+		&i.Passability,
+		&i.LastAccessedAt,
+		&i.AccessCount,
 	)
 	return i, err
 }
@@ -79,7 +111,7 @@ func (q *Queries) DeleteChunk(ctx context.Context, arg DeleteChunkParams) error
 }
 
 const getChunk = `-- name: GetChunk :one
-SELECT world_id, chunk_x, chunk_y, chunk_data, generated_at FROM chunks
+SELECT world_id, chunk_x, chunk_y, chunk_data, generated_at, quarantined_at, corrupt_chunk_data, passability, last_accessed_at, access_count FROM chunks
 WHERE world_id = $1 AND chunk_x = $2 AND chunk_y = $3
 `
 
@@ -98,12 +130,35 @@ func (q *Queries) GetChunk(ctx context.Context, arg GetChunkParams) (Chunk, erro
 		&i.ChunkY,
 		&i.ChunkData,
 		&i.GeneratedAt,
+		&i.QuarantinedAt,
+		&i.CorruptChunkData,
+		&i.Passability,
+		&i.LastAccessedAt,
+		&i.AccessCount,
 	)
 	return i, err
 }
 
+const getChunkPassability = `-- name: GetChunkPassability :one
+SELECT passability FROM chunks
+WHERE world_id = $1 AND chunk_x = $2 AND chunk_y = $3
+`
+
+type GetChunkPassabilityParams struct {
+	WorldID pgtype.UUID
+	ChunkX  int32
+	ChunkY  int32
+}
+
+func (q *Queries) GetChunkPassability(ctx context.Context, arg GetChunkPassabilityParams) ([]byte, error) {
+	row := q.db.QueryRow(ctx, getChunkPassability, arg.WorldID, arg.ChunkX, arg.ChunkY)
+	var passability []byte
+	err := row.Scan(&passability)
+	return passability, err
+}
+
 const getChunks = `-- name: GetChunks :many
-SELECT world_id, chunk_x, chunk_y, chunk_data, generated_at FROM chunks
+SELECT world_id, chunk_x, chunk_y, chunk_data, generated_at, quarantined_at, corrupt_chunk_data, passability, last_accessed_at, access_count FROM chunks
 WHERE world_id = $1
 AND chunk_x >= $2 AND chunk_x <= $3 
 AND chunk_y >= $4 AND chunk_y <= $5
@@ -139,6 +194,112 @@ func (q *Queries) GetChunks(ctx context.Context, arg GetChunksParams) ([]Chunk,
 			&i.ChunkY,
 			&i.ChunkData,
 			&i.GeneratedAt,
+			&i.QuarantinedAt,
+			&i.CorruptChunkData,
+			&i.Passability,
+			&i.LastAccessedAt,
+			&i.AccessCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listColdChunks = `-- name: ListColdChunks :many
+SELECT world_id, chunk_x, chunk_y, generated_at, last_accessed_at, access_count
+FROM chunks
+WHERE world_id = $1
+  AND COALESCE(last_accessed_at, generated_at) < $2
+ORDER BY COALESCE(last_accessed_at, generated_at), chunk_x, chunk_y
+LIMIT $3
+`
+
+type ListColdChunksParams struct {
+	WorldID   pgtype.UUID
+	IdleSince pgtype.Timestamp
+	MaxChunks int32
+}
+
+type ListColdChunksRow struct {
+	WorldID        pgtype.UUID
+	ChunkX         int32
+	ChunkY         int32
+	GeneratedAt    pgtype.Timestamp
+	LastAccessedAt pgtype.Timestamp
+	AccessCount    int64
+}
+
+// A world's chunks not read since a time, least recently read first
+func (q *Queries) ListColdChunks(ctx context.Context, arg ListColdChunksParams) ([]ListColdChunksRow, error) {
+	rows, err := q.db.Query(ctx, listColdChunks, arg.WorldID, arg.IdleSince, arg.MaxChunks)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListColdChunksRow
+	for rows.Next() {
+		var i ListColdChunksRow
+		if err := rows.Scan(
+			&i.WorldID,
+			&i.ChunkX,
+			&i.ChunkY,
+			&i.GeneratedAt,
+			&i.LastAccessedAt,
+			&i.AccessCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMostAccessedChunks = `-- name: ListMostAccessedChunks :many
+SELECT world_id, chunk_x, chunk_y, generated_at, last_accessed_at, access_count
+FROM chunks
+WHERE world_id = $1
+ORDER BY access_count DESC, chunk_x, chunk_y
+LIMIT $2
+`
+
+type ListMostAccessedChunksParams struct {
+	WorldID   pgtype.UUID
+	MaxChunks int32
+}
+
+type ListMostAccessedChunksRow struct {
+	WorldID        pgtype.UUID
+	ChunkX         int32
+	ChunkY         int32
+	GeneratedAt    pgtype.Timestamp
+	LastAccessedAt pgtype.Timestamp
+	AccessCount    int64
+}
+
+func (q *Queries) ListMostAccessedChunks(ctx context.Context, arg ListMostAccessedChunksParams) ([]ListMostAccessedChunksRow, error) {
+	rows, err := q.db.Query(ctx, listMostAccessedChunks, arg.WorldID, arg.MaxChunks)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListMostAccessedChunksRow
+	for rows.Next() {
+		var i ListMostAccessedChunksRow
+		if err := rows.Scan(
+			&i.WorldID,
+			&i.ChunkX,
+			&i.ChunkY,
+			&i.GeneratedAt,
+			&i.LastAccessedAt,
+			&i.AccessCount,
 		); err != nil {
 			return nil, err
 		}
@@ -149,3 +310,158 @@ func (q *Queries) GetChunks(ctx context.Context, arg GetChunksParams) ([]Chunk,
 	}
 	return items, nil
 }
+
+const listRecentlyAccessedChunks = `-- name: ListRecentlyAccessedChunks :many
+SELECT chunk_x, chunk_y
+FROM chunks
+WHERE world_id = $1
+  AND last_accessed_at >= $2
+ORDER BY chunk_x, chunk_y
+LIMIT $3
+`
+
+type ListRecentlyAccessedChunksParams struct {
+	WorldID       pgtype.UUID
+	AccessedSince pgtype.Timestamp
+	MaxChunks     int32
+}
+
+type ListRecentlyAccessedChunksRow struct {
+	ChunkX int32
+	ChunkY int32
+}
+
+// A world's chunks read since a time, i.e. chunks players are in or near
+func (q *Queries) ListRecentlyAccessedChunks(ctx context.Context, arg ListRecentlyAccessedChunksParams) ([]ListRecentlyAccessedChunksRow, error) {
+	rows, err := q.db.Query(ctx, listRecentlyAccessedChunks, arg.WorldID, arg.AccessedSince, arg.MaxChunks)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRecentlyAccessedChunksRow
+	for rows.Next() {
+		var i ListRecentlyAccessedChunksRow
+		if err := rows.Scan(&i.ChunkX, &i.ChunkY); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const quarantineChunk = `-- name: QuarantineChunk :exec
+UPDATE chunks
+SET corrupt_chunk_data = chunk_data, chunk_data = $4, quarantined_at = $5, passability = NULL
+WHERE world_id = $1 AND chunk_x = $2 AND chunk_y = $3
+`
+
+type QuarantineChunkParams struct {
+	WorldID       pgtype.UUID
+	ChunkX        int32
+	ChunkY        int32
+	ChunkData     []byte
+	QuarantinedAt pgtype.Timestamp
+}
+
+// The mask is cleared because it included edits; it is recomputed from the repaired chunk
+func (q *Queries) QuarantineChunk(ctx context.Context, arg QuarantineChunkParams) error {
+	_, err := q.db.Exec(ctx, quarantineChunk,
+		arg.WorldID,
+		arg.ChunkX,
+		arg.ChunkY,
+		arg.ChunkData,
+		arg.QuarantinedAt,
+	)
+	return err
+}
+
+const recordChunkAccesses = `-- name: RecordChunkAccesses :exec
+UPDATE chunks c
+SET access_count = c.access_count + a.hits,
+    last_accessed_at = GREATEST(c.last_accessed_at, a.accessed_at)
+FROM (
+    -- Set-returning functions in one select list are zipped row by row
+    SELECT
+      unnest($1::uuid[]) AS world_id,
+      unnest($2::integer[]) AS chunk_x,
+      unnest($3::integer[]) AS chunk_y,
+      unnest($4::bigint[]) AS hits,
+      unnest($5::timestamp[]) AS accessed_at
+) a
+WHERE c.world_id = a.world_id AND c.chunk_x = a.chunk_x AND c.chunk_y = a.chunk_y
+`
+
+type RecordChunkAccessesParams struct {
+	WorldIds    []pgtype.UUID
+	ChunkXs     []int32
+	ChunkYs     []int32
+	Hits        []int64
+	AccessedAts []pgtype.Timestamp
+}
+
+// Adds a batch of counted reads; each chunk's last access only moves forward
+func (q *Queries) RecordChunkAccesses(ctx context.Context, arg RecordChunkAccessesParams) error {
+	_, err := q.db.Exec(ctx, recordChunkAccesses,
+		arg.WorldIds,
+		arg.ChunkXs,
+		arg.ChunkYs,
+		arg.Hits,
+		arg.AccessedAts,
+	)
+	return err
+}
+
+const setCellPassability = `-- name: SetCellPassability :exec
+UPDATE chunks
+SET passability = set_bit(passability, $1::integer, $2::integer)
+WHERE world_id = $3 AND chunk_x = $4 AND chunk_y = $5
+  AND octet_length(passability) * 8 > $1::integer
+`
+
+type SetCellPassabilityParams struct {
+	CellIndex int32
+	Passable  int32
+	WorldID   pgtype.UUID
+	ChunkX    int32
+	ChunkY    int32
+}
+
+// Updates one cell's bit; chunks without a valid mask are left to be backfilled on read
+func (q *Queries) SetCellPassability(ctx context.Context, arg SetCellPassabilityParams) error {
+	_, err := q.db.Exec(ctx, setCellPassability,
+		arg.CellIndex,
+		arg.Passable,
+		arg.WorldID,
+		arg.ChunkX,
+		arg.ChunkY,
+	)
+	return err
+}
+
+const updateChunkData = `-- name: UpdateChunkData :exec
+UPDATE chunks
+SET chunk_data = $4, passability = $5
+WHERE world_id = $1 AND chunk_x = $2 AND chunk_y = $3
+`
+
+type UpdateChunkDataParams struct {
+	WorldID     pgtype.UUID
+	ChunkX      int32
+	ChunkY      int32
+	ChunkData   []byte
+	Passability []byte
+}
+
+func (q *Queries) UpdateChunkData(ctx context.Context, arg UpdateChunkDataParams) error {
+	_, err := q.db.Exec(ctx, updateChunkData,
+		arg.WorldID,
+		arg.ChunkX,
+		arg.ChunkY,
+		arg.ChunkData,
+		arg.Passability,
+	)
+	return err
+}
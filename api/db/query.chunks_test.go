@@ -30,12 +30,12 @@ func TestCreateChunk(t *testing.T) {
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				now := time.Now()
 				rows := pgxmock.NewRows([]string{
-					"world_id", "chunk_x", "chunk_y", "chunk_data", "generated_at",
+					"world_id", "chunk_x", "chunk_y", "chunk_data", "generated_at", "quarantined_at", "corrupt_chunk_data", "passability", "last_accessed_at", "access_count",
 				}).AddRow(
-					"550e8400-e29b-41d4-a716-446655440000", int32(10), int32(20), []byte{0x01, 0x02, 0x03, 0x04}, pgtype.Timestamp{Time: now, Valid: true},
+					"550e8400-e29b-41d4-a716-446655440000", int32(10), int32(20), []byte{0x01, 0x02, 0x03, 0x04}, pgtype.Timestamp{Time: now, Valid: true}, pgtype.Timestamp{}, []byte(nil), []byte(nil), pgtype.Timestamp{}, int64(0),
 				)
 				mock.ExpectQuery("INSERT INTO chunks").
-					WithArgs(mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(10), int32(20), []byte{0x01, 0x02, 0x03, 0x04}).
+					WithArgs(mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(10), int32(20), []byte{0x01, 0x02, 0x03, 0x04}, []byte(nil)).
 					WillReturnRows(rows)
 			},
 			wantErr: false,
@@ -58,12 +58,12 @@ func TestCreateChunk(t *testing.T) {
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				now := time.Now()
 				rows := pgxmock.NewRows([]string{
-					"world_id", "chunk_x", "chunk_y", "chunk_data", "generated_at",
+					"world_id", "chunk_x", "chunk_y", "chunk_data", "generated_at", "quarantined_at", "corrupt_chunk_data", "passability", "last_accessed_at", "access_count",
 				}).AddRow(
-					"550e8400-e29b-41d4-a716-446655440000", int32(-5), int32(-10), []byte{0xFF, 0xFE, 0xFD}, pgtype.Timestamp{Time: now, Valid: true},
+					"550e8400-e29b-41d4-a716-446655440000", int32(-5), int32(-10), []byte{0xFF, 0xFE, 0xFD}, pgtype.Timestamp{Time: now, Valid: true}, pgtype.Timestamp{}, []byte(nil), []byte(nil), pgtype.Timestamp{}, int64(0),
 				)
 				mock.ExpectQuery("INSERT INTO chunks").
-					WithArgs(mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(-5), int32(-10), []byte{0xFF, 0xFE, 0xFD}).
+					WithArgs(mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(-5), int32(-10), []byte{0xFF, 0xFE, 0xFD}, []byte(nil)).
 					WillReturnRows(rows)
 			},
 			wantErr: false,
@@ -85,12 +85,12 @@ func TestCreateChunk(t *testing.T) {
 				now := time.Now()
 				largeData := make([]byte, 65536)
 				rows := pgxmock.NewRows([]string{
-					"world_id", "chunk_x", "chunk_y", "chunk_data", "generated_at",
+					"world_id", "chunk_x", "chunk_y", "chunk_data", "generated_at", "quarantined_at", "corrupt_chunk_data", "passability", "last_accessed_at", "access_count",
 				}).AddRow(
-					"550e8400-e29b-41d4-a716-446655440000", int32(0), int32(0), largeData, pgtype.Timestamp{Time: now, Valid: true},
+					"550e8400-e29b-41d4-a716-446655440000", int32(0), int32(0), largeData, pgtype.Timestamp{Time: now, Valid: true}, pgtype.Timestamp{}, []byte(nil), []byte(nil), pgtype.Timestamp{}, int64(0),
 				)
 				mock.ExpectQuery("INSERT INTO chunks").
-					WithArgs(mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(0), int32(0), largeData).
+					WithArgs(mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(0), int32(0), largeData, []byte(nil)).
 					WillReturnRows(rows)
 			},
 			wantErr: false,
@@ -111,12 +111,12 @@ func TestCreateChunk(t *testing.T) {
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				now := time.Now()
 				rows := pgxmock.NewRows([]string{
-					"world_id", "chunk_x", "chunk_y", "chunk_data", "generated_at",
+					"world_id", "chunk_x", "chunk_y", "chunk_data", "generated_at", "quarantined_at", "corrupt_chunk_data", "passability", "last_accessed_at", "access_count",
 				}).AddRow(
-					"550e8400-e29b-41d4-a716-446655440000", int32(5), int32(5), []byte{}, pgtype.Timestamp{Time: now, Valid: true},
+					"550e8400-e29b-41d4-a716-446655440000", int32(5), int32(5), []byte{}, pgtype.Timestamp{Time: now, Valid: true}, pgtype.Timestamp{}, []byte(nil), []byte(nil), pgtype.Timestamp{}, int64(0),
 				)
 				mock.ExpectQuery("INSERT INTO chunks").
-					WithArgs(mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(5), int32(5), []byte{}).
+					WithArgs(mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(5), int32(5), []byte{}, []byte(nil)).
 					WillReturnRows(rows)
 			},
 			wantErr: false,
@@ -136,7 +136,7 @@ func TestCreateChunk(t *testing.T) {
 			},
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				mock.ExpectQuery("INSERT INTO chunks").
-					WithArgs(mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(1), int32(1), []byte{0x01}).
+					WithArgs(mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(1), int32(1), []byte{0x01}, []byte(nil)).
 					WillReturnError(sql.ErrConnDone) // Simulate primary key constraint violation
 			},
 			wantErr: true,
@@ -151,7 +151,7 @@ func TestCreateChunk(t *testing.T) {
 			},
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				mock.ExpectQuery("INSERT INTO chunks").
-					WithArgs(mustParseUUID("999e8400-e29b-41d4-a716-446655440000"), int32(1), int32(1), []byte{0x01}).
+					WithArgs(mustParseUUID("999e8400-e29b-41d4-a716-446655440000"), int32(1), int32(1), []byte{0x01}, []byte(nil)).
 					WillReturnError(sql.ErrConnDone) // Simulate foreign key constraint violation
 			},
 			wantErr: true,
@@ -199,9 +199,9 @@ func TestGetChunk(t *testing.T) {
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				now := time.Now()
 				rows := pgxmock.NewRows([]string{
-					"world_id", "chunk_x", "chunk_y", "chunk_data", "generated_at",
+					"world_id", "chunk_x", "chunk_y", "chunk_data", "generated_at", "quarantined_at", "corrupt_chunk_data", "passability", "last_accessed_at", "access_count",
 				}).AddRow(
-					"550e8400-e29b-41d4-a716-446655440000", int32(10), int32(20), []byte{0x01, 0x02, 0x03}, pgtype.Timestamp{Time: now, Valid: true},
+					"550e8400-e29b-41d4-a716-446655440000", int32(10), int32(20), []byte{0x01, 0x02, 0x03}, pgtype.Timestamp{Time: now, Valid: true}, pgtype.Timestamp{}, []byte(nil), []byte(nil), pgtype.Timestamp{}, int64(0),
 				)
 				mock.ExpectQuery("SELECT (.+) FROM chunks WHERE world_id = \\$1 AND chunk_x = \\$2 AND chunk_y = \\$3").
 					WithArgs(mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(10), int32(20)).
@@ -240,9 +240,9 @@ func TestGetChunk(t *testing.T) {
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				now := time.Now()
 				rows := pgxmock.NewRows([]string{
-					"world_id", "chunk_x", "chunk_y", "chunk_data", "generated_at",
+					"world_id", "chunk_x", "chunk_y", "chunk_data", "generated_at", "quarantined_at", "corrupt_chunk_data", "passability", "last_accessed_at", "access_count",
 				}).AddRow(
-					"550e8400-e29b-41d4-a716-446655440000", int32(-5), int32(-10), []byte{0xFF}, pgtype.Timestamp{Time: now, Valid: true},
+					"550e8400-e29b-41d4-a716-446655440000", int32(-5), int32(-10), []byte{0xFF}, pgtype.Timestamp{Time: now, Valid: true}, pgtype.Timestamp{}, []byte(nil), []byte(nil), pgtype.Timestamp{}, int64(0),
 				)
 				mock.ExpectQuery("SELECT (.+) FROM chunks WHERE world_id = \\$1 AND chunk_x = \\$2 AND chunk_y = \\$3").
 					WithArgs(mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(-5), int32(-10)).
@@ -300,19 +300,19 @@ func TestGetChunks(t *testing.T) {
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				now := time.Now()
 				rows := pgxmock.NewRows([]string{
-					"world_id", "chunk_x", "chunk_y", "chunk_data", "generated_at",
+					"world_id", "chunk_x", "chunk_y", "chunk_data", "generated_at", "quarantined_at", "corrupt_chunk_data", "passability", "last_accessed_at", "access_count",
 				}).
 					AddRow(
-						"550e8400-e29b-41d4-a716-446655440000", int32(0), int32(0), []byte{0x00}, pgtype.Timestamp{Time: now, Valid: true},
+						"550e8400-e29b-41d4-a716-446655440000", int32(0), int32(0), []byte{0x00}, pgtype.Timestamp{Time: now, Valid: true}, pgtype.Timestamp{}, []byte(nil), []byte(nil), pgtype.Timestamp{}, int64(0),
 					).
 					AddRow(
-						"550e8400-e29b-41d4-a716-446655440000", int32(0), int32(1), []byte{0x01}, pgtype.Timestamp{Time: now, Valid: true},
+						"550e8400-e29b-41d4-a716-446655440000", int32(0), int32(1), []byte{0x01}, pgtype.Timestamp{Time: now, Valid: true}, pgtype.Timestamp{}, []byte(nil), []byte(nil), pgtype.Timestamp{}, int64(0),
 					).
 					AddRow(
-						"550e8400-e29b-41d4-a716-446655440000", int32(1), int32(0), []byte{0x10}, pgtype.Timestamp{Time: now, Valid: true},
+						"550e8400-e29b-41d4-a716-446655440000", int32(1), int32(0), []byte{0x10}, pgtype.Timestamp{Time: now, Valid: true}, pgtype.Timestamp{}, []byte(nil), []byte(nil), pgtype.Timestamp{}, int64(0),
 					).
 					AddRow(
-						"550e8400-e29b-41d4-a716-446655440000", int32(1), int32(1), []byte{0x11}, pgtype.Timestamp{Time: now, Valid: true},
+						"550e8400-e29b-41d4-a716-446655440000", int32(1), int32(1), []byte{0x11}, pgtype.Timestamp{Time: now, Valid: true}, pgtype.Timestamp{}, []byte(nil), []byte(nil), pgtype.Timestamp{}, int64(0),
 					)
 				mock.ExpectQuery("SELECT (.+) FROM chunks WHERE world_id = \\$1 AND chunk_x >= \\$2 AND chunk_x <= \\$3 AND chunk_y >= \\$4 AND chunk_y <= \\$5 ORDER BY chunk_x, chunk_y").
 					WithArgs(mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(0), int32(2), int32(0), int32(2)).
@@ -348,7 +348,7 @@ func TestGetChunks(t *testing.T) {
 			},
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				rows := pgxmock.NewRows([]string{
-					"world_id", "chunk_x", "chunk_y", "chunk_data", "generated_at",
+					"world_id", "chunk_x", "chunk_y", "chunk_data", "generated_at", "quarantined_at", "corrupt_chunk_data", "passability", "last_accessed_at", "access_count",
 				})
 				mock.ExpectQuery("SELECT (.+) FROM chunks WHERE world_id = \\$1 AND chunk_x >= \\$2 AND chunk_x <= \\$3 AND chunk_y >= \\$4 AND chunk_y <= \\$5 ORDER BY chunk_x, chunk_y").
 					WithArgs(mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(100), int32(102), int32(100), int32(102)).
@@ -371,9 +371,9 @@ func TestGetChunks(t *testing.T) {
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				now := time.Now()
 				rows := pgxmock.NewRows([]string{
-					"world_id", "chunk_x", "chunk_y", "chunk_data", "generated_at",
+					"world_id", "chunk_x", "chunk_y", "chunk_data", "generated_at", "quarantined_at", "corrupt_chunk_data", "passability", "last_accessed_at", "access_count",
 				}).AddRow(
-					"550e8400-e29b-41d4-a716-446655440000", int32(5), int32(10), []byte{0xAB, 0xCD}, pgtype.Timestamp{Time: now, Valid: true},
+					"550e8400-e29b-41d4-a716-446655440000", int32(5), int32(10), []byte{0xAB, 0xCD}, pgtype.Timestamp{Time: now, Valid: true}, pgtype.Timestamp{}, []byte(nil), []byte(nil), pgtype.Timestamp{}, int64(0),
 				)
 				mock.ExpectQuery("SELECT (.+) FROM chunks WHERE world_id = \\$1 AND chunk_x >= \\$2 AND chunk_x <= \\$3 AND chunk_y >= \\$4 AND chunk_y <= \\$5 ORDER BY chunk_x, chunk_y").
 					WithArgs(mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(5), int32(5), int32(10), int32(10)).
@@ -399,13 +399,13 @@ func TestGetChunks(t *testing.T) {
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				now := time.Now()
 				rows := pgxmock.NewRows([]string{
-					"world_id", "chunk_x", "chunk_y", "chunk_data", "generated_at",
+					"world_id", "chunk_x", "chunk_y", "chunk_data", "generated_at", "quarantined_at", "corrupt_chunk_data", "passability", "last_accessed_at", "access_count",
 				}).
 					AddRow(
-						"550e8400-e29b-41d4-a716-446655440000", int32(-2), int32(-1), []byte{0xFE}, pgtype.Timestamp{Time: now, Valid: true},
+						"550e8400-e29b-41d4-a716-446655440000", int32(-2), int32(-1), []byte{0xFE}, pgtype.Timestamp{Time: now, Valid: true}, pgtype.Timestamp{}, []byte(nil), []byte(nil), pgtype.Timestamp{}, int64(0),
 					).
 					AddRow(
-						"550e8400-e29b-41d4-a716-446655440000", int32(-1), int32(-2), []byte{0xFD}, pgtype.Timestamp{Time: now, Valid: true},
+						"550e8400-e29b-41d4-a716-446655440000", int32(-1), int32(-2), []byte{0xFD}, pgtype.Timestamp{Time: now, Valid: true}, pgtype.Timestamp{}, []byte(nil), []byte(nil), pgtype.Timestamp{}, int64(0),
 					)
 				mock.ExpectQuery("SELECT (.+) FROM chunks WHERE world_id = \\$1 AND chunk_x >= \\$2 AND chunk_x <= \\$3 AND chunk_y >= \\$4 AND chunk_y <= \\$5 ORDER BY chunk_x, chunk_y").
 					WithArgs(mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(-2), int32(0), int32(-2), int32(0)).
@@ -668,13 +668,13 @@ func TestChunkBusinessLogic(t *testing.T) {
 
 		now := time.Now()
 		rows := pgxmock.NewRows([]string{
-			"world_id", "chunk_x", "chunk_y", "chunk_data", "generated_at",
+			"world_id", "chunk_x", "chunk_y", "chunk_data", "generated_at", "quarantined_at", "corrupt_chunk_data", "passability", "last_accessed_at", "access_count",
 		}).AddRow(
-			"550e8400-e29b-41d4-a716-446655440000", int32(2147483647), int32(-2147483648), []byte{0x01}, pgtype.Timestamp{Time: now, Valid: true},
+			"550e8400-e29b-41d4-a716-446655440000", int32(2147483647), int32(-2147483648), []byte{0x01}, pgtype.Timestamp{Time: now, Valid: true}, pgtype.Timestamp{}, []byte(nil), []byte(nil), pgtype.Timestamp{}, int64(0),
 		)
 
 		mockPool.ExpectQuery("INSERT INTO chunks").
-			WithArgs(mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(2147483647), int32(-2147483648), []byte{0x01}).
+			WithArgs(mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(2147483647), int32(-2147483648), []byte{0x01}, []byte(nil)).
 			WillReturnRows(rows)
 
 		chunk, err := queries.CreateChunk(createTestContext(), params)
@@ -714,13 +714,13 @@ func TestChunkBusinessLogic(t *testing.T) {
 
 				now := time.Now()
 				rows := pgxmock.NewRows([]string{
-					"world_id", "chunk_x", "chunk_y", "chunk_data", "generated_at",
+					"world_id", "chunk_x", "chunk_y", "chunk_data", "generated_at", "quarantined_at", "corrupt_chunk_data", "passability", "last_accessed_at", "access_count",
 				}).AddRow(
-					"550e8400-e29b-41d4-a716-446655440000", int32(0), int32(0), tc.data, pgtype.Timestamp{Time: now, Valid: true},
+					"550e8400-e29b-41d4-a716-446655440000", int32(0), int32(0), tc.data, pgtype.Timestamp{Time: now, Valid: true}, pgtype.Timestamp{}, []byte(nil), []byte(nil), pgtype.Timestamp{}, int64(0),
 				)
 
 				mockPool.ExpectQuery("INSERT INTO chunks").
-					WithArgs(mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(0), int32(0), tc.data).
+					WithArgs(mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(0), int32(0), tc.data, []byte(nil)).
 					WillReturnRows(rows)
 
 				chunk, err := queries.CreateChunk(createTestContext(), params)
@@ -750,7 +750,7 @@ func TestChunkBusinessLogic(t *testing.T) {
 		}
 
 		rows := pgxmock.NewRows([]string{
-			"world_id", "chunk_x", "chunk_y", "chunk_data", "generated_at",
+			"world_id", "chunk_x", "chunk_y", "chunk_data", "generated_at", "quarantined_at", "corrupt_chunk_data", "passability", "last_accessed_at", "access_count",
 		})
 
 		mockPool.ExpectQuery("SELECT (.+) FROM chunks WHERE world_id = \\$1 AND chunk_x >= \\$2 AND chunk_x <= \\$3 AND chunk_y >= \\$4 AND chunk_y <= \\$5 ORDER BY chunk_x, chunk_y").
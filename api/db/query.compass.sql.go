@@ -0,0 +1,219 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.compass.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countWaypoints = `-- name: CountWaypoints :one
+SELECT COUNT(*) FROM character_waypoints
+WHERE character_id = $1
+`
+
+func (q *Queries) CountWaypoints(ctx context.Context, characterID pgtype.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countWaypoints, characterID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createDiscovery = `-- name: CreateDiscovery :exec
+INSERT INTO character_discoveries (character_id, resource_node_id, discovered_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (character_id, resource_node_id) DO NOTHING
+`
+
+type CreateDiscoveryParams struct {
+	CharacterID    pgtype.UUID
+	ResourceNodeID int32
+	DiscoveredAt   pgtype.Timestamp
+}
+
+func (q *Queries) CreateDiscovery(ctx context.Context, arg CreateDiscoveryParams) error {
+	_, err := q.db.Exec(ctx, createDiscovery, arg.CharacterID, arg.ResourceNodeID, arg.DiscoveredAt)
+	return err
+}
+
+const createWaypoint = `-- name: CreateWaypoint :one
+
+INSERT INTO character_waypoints (character_id, name, x, y, created_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, character_id, name, x, y, created_at
+`
+
+type CreateWaypointParams struct {
+	CharacterID pgtype.UUID
+	Name        string
+	X           int32
+	Y           int32
+	CreatedAt   pgtype.Timestamp
+}
+
+// Compass Operations
+func (q *Queries) CreateWaypoint(ctx context.Context, arg CreateWaypointParams) (CharacterWaypoint, error) {
+	row := q.db.QueryRow(ctx, createWaypoint,
+		arg.CharacterID,
+		arg.Name,
+		arg.X,
+		arg.Y,
+		arg.CreatedAt,
+	)
+	var i CharacterWaypoint
+	err := row.Scan(
+		&i.ID,
+		&i.CharacterID,
+		&i.Name,
+		&i.X,
+		&i.Y,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteWaypoint = `-- name: DeleteWaypoint :execrows
+DELETE FROM character_waypoints
+WHERE id = $1 AND character_id = $2
+`
+
+type DeleteWaypointParams struct {
+	ID          int64
+	CharacterID pgtype.UUID
+}
+
+func (q *Queries) DeleteWaypoint(ctx context.Context, arg DeleteWaypointParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteWaypoint, arg.ID, arg.CharacterID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const listDiscoveredNodes = `-- name: ListDiscoveredNodes :many
+SELECT rn.id, rn.resource_node_type_id, rn.world_id, rn.chunk_x, rn.chunk_y, rn.cluster_id, rn.x, rn.y, rn.size, rn.quality, rn.created_at
+FROM character_discoveries cd
+JOIN resource_nodes rn ON rn.id = cd.resource_node_id
+WHERE cd.character_id = $1
+ORDER BY cd.discovered_at
+`
+
+// Nodes removed since they were discovered drop out with their discovery
+func (q *Queries) ListDiscoveredNodes(ctx context.Context, characterID pgtype.UUID) ([]ResourceNode, error) {
+	rows, err := q.db.Query(ctx, listDiscoveredNodes, characterID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ResourceNode
+	for rows.Next() {
+		var i ResourceNode
+		if err := rows.Scan(
+			&i.ID,
+			&i.ResourceNodeTypeID,
+			&i.WorldID,
+			&i.ChunkX,
+			&i.ChunkY,
+			&i.ClusterID,
+			&i.X,
+			&i.Y,
+			&i.Size,
+			&i.Quality,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listFriendCharactersInWorld = `-- name: ListFriendCharactersInWorld :many
+SELECT c.id, c.user_id, c.world_id, c.name, c.x, c.y, c.chunk_x, c.chunk_y, c.created_at, c.facing, c.action_state, c.equipped_title
+FROM characters c
+JOIN friendships f ON f.status = 'accepted' AND (
+    (f.requester_id = $1 AND f.addressee_id = c.user_id) OR
+    (f.addressee_id = $1 AND f.requester_id = c.user_id)
+)
+WHERE c.world_id = $2
+ORDER BY c.name
+`
+
+type ListFriendCharactersInWorldParams struct {
+	UserID  pgtype.UUID
+	WorldID pgtype.UUID
+}
+
+// Characters of the user's accepted friends in a world
+func (q *Queries) ListFriendCharactersInWorld(ctx context.Context, arg ListFriendCharactersInWorldParams) ([]Character, error) {
+	rows, err := q.db.Query(ctx, listFriendCharactersInWorld, arg.UserID, arg.WorldID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Character
+	for rows.Next() {
+		var i Character
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.WorldID,
+			&i.Name,
+			&i.X,
+			&i.Y,
+			&i.ChunkX,
+			&i.ChunkY,
+			&i.CreatedAt,
+			&i.Facing,
+			&i.ActionState,
+			&i.EquippedTitle,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWaypoints = `-- name: ListWaypoints :many
+SELECT id, character_id, name, x, y, created_at FROM character_waypoints
+WHERE character_id = $1
+ORDER BY id
+`
+
+func (q *Queries) ListWaypoints(ctx context.Context, characterID pgtype.UUID) ([]CharacterWaypoint, error) {
+	rows, err := q.db.Query(ctx, listWaypoints, characterID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CharacterWaypoint
+	for rows.Next() {
+		var i CharacterWaypoint
+		if err := rows.Scan(
+			&i.ID,
+			&i.CharacterID,
+			&i.Name,
+			&i.X,
+			&i.Y,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,176 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.consents.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createUserConsent = `-- name: CreateUserConsent :exec
+INSERT INTO user_consents (user_id, kind, version, accepted_at, ip_address)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (user_id, kind, version) DO NOTHING
+`
+
+type CreateUserConsentParams struct {
+	UserID     pgtype.UUID
+	Kind       string
+	Version    int32
+	AcceptedAt pgtype.Timestamp
+	IpAddress  string
+}
+
+func (q *Queries) CreateUserConsent(ctx context.Context, arg CreateUserConsentParams) error {
+	_, err := q.db.Exec(ctx, createUserConsent,
+		arg.UserID,
+		arg.Kind,
+		arg.Version,
+		arg.AcceptedAt,
+		arg.IpAddress,
+	)
+	return err
+}
+
+const listLatestConsentDocuments = `-- name: ListLatestConsentDocuments :many
+SELECT DISTINCT ON (kind) kind, version, url, summary, required, published_by, published_at FROM consent_documents
+ORDER BY kind, version DESC
+`
+
+// The current version of each kind
+func (q *Queries) ListLatestConsentDocuments(ctx context.Context) ([]ConsentDocument, error) {
+	rows, err := q.db.Query(ctx, listLatestConsentDocuments)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ConsentDocument
+	for rows.Next() {
+		var i ConsentDocument
+		if err := rows.Scan(
+			&i.Kind,
+			&i.Version,
+			&i.Url,
+			&i.Summary,
+			&i.Required,
+			&i.PublishedBy,
+			&i.PublishedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLatestUserConsents = `-- name: ListLatestUserConsents :many
+SELECT DISTINCT ON (kind) user_id, kind, version, accepted_at, ip_address FROM user_consents
+WHERE user_id = $1
+ORDER BY kind, version DESC
+`
+
+// The latest version of each kind the user accepted
+func (q *Queries) ListLatestUserConsents(ctx context.Context, userID pgtype.UUID) ([]UserConsent, error) {
+	rows, err := q.db.Query(ctx, listLatestUserConsents, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UserConsent
+	for rows.Next() {
+		var i UserConsent
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Kind,
+			&i.Version,
+			&i.AcceptedAt,
+			&i.IpAddress,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRequiredConsentVersions = `-- name: ListRequiredConsentVersions :many
+SELECT kind, max(version)::integer AS version FROM consent_documents
+WHERE required
+GROUP BY kind
+`
+
+type ListRequiredConsentVersionsRow struct {
+	Kind    string
+	Version int32
+}
+
+func (q *Queries) ListRequiredConsentVersions(ctx context.Context) ([]ListRequiredConsentVersionsRow, error) {
+	rows, err := q.db.Query(ctx, listRequiredConsentVersions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRequiredConsentVersionsRow
+	for rows.Next() {
+		var i ListRequiredConsentVersionsRow
+		if err := rows.Scan(&i.Kind, &i.Version); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const publishConsentDocument = `-- name: PublishConsentDocument :one
+INSERT INTO consent_documents (kind, version, url, summary, required, published_by, published_at)
+SELECT $1::text, coalesce(max(version), 0) + 1, $2::text, $3::text,
+  $4::boolean, $5::uuid, $6::timestamp
+FROM consent_documents
+WHERE kind = $1
+RETURNING kind, version, url, summary, required, published_by, published_at
+`
+
+type PublishConsentDocumentParams struct {
+	Kind        string
+	Url         string
+	Summary     string
+	Required    bool
+	PublishedBy pgtype.UUID
+	PublishedAt pgtype.Timestamp
+}
+
+// Versions are numbered per kind; two concurrent publishes collide on the primary key
+func (q *Queries) PublishConsentDocument(ctx context.Context, arg PublishConsentDocumentParams) (ConsentDocument, error) {
+	row := q.db.QueryRow(ctx, publishConsentDocument,
+		arg.Kind,
+		arg.Url,
+		arg.Summary,
+		arg.Required,
+		arg.PublishedBy,
+		arg.PublishedAt,
+	)
+	var i ConsentDocument
+	err := row.Scan(
+		&i.Kind,
+		&i.Version,
+		&i.Url,
+		&i.Summary,
+		&i.Required,
+		&i.PublishedBy,
+		&i.PublishedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,88 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.cosmetics.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const equipCharacterTitle = `-- name: EquipCharacterTitle :execrows
+UPDATE characters
+SET equipped_title = $1::text
+WHERE id = $2
+  AND (
+    $1::text = ''
+    OR EXISTS (
+      SELECT 1 FROM character_cosmetics
+      WHERE character_cosmetics.character_id = characters.id
+        AND character_cosmetics.cosmetic_id = $1::text
+    )
+  )
+`
+
+type EquipCharacterTitleParams struct {
+	Title       string
+	CharacterID pgtype.UUID
+}
+
+// Sets the character's title, or clears it with an empty title. Does nothing unless the
+// character has unlocked the title.
+func (q *Queries) EquipCharacterTitle(ctx context.Context, arg EquipCharacterTitleParams) (int64, error) {
+	result, err := q.db.Exec(ctx, equipCharacterTitle, arg.Title, arg.CharacterID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const listCharacterCosmetics = `-- name: ListCharacterCosmetics :many
+SELECT character_id, cosmetic_id, unlocked_at FROM character_cosmetics
+WHERE character_id = $1
+ORDER BY unlocked_at, cosmetic_id
+`
+
+func (q *Queries) ListCharacterCosmetics(ctx context.Context, characterID pgtype.UUID) ([]CharacterCosmetic, error) {
+	rows, err := q.db.Query(ctx, listCharacterCosmetics, characterID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CharacterCosmetic
+	for rows.Next() {
+		var i CharacterCosmetic
+		if err := rows.Scan(&i.CharacterID, &i.CosmeticID, &i.UnlockedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const unlockCosmetic = `-- name: UnlockCosmetic :execrows
+INSERT INTO character_cosmetics (character_id, cosmetic_id, unlocked_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (character_id, cosmetic_id) DO NOTHING
+`
+
+type UnlockCosmeticParams struct {
+	CharacterID pgtype.UUID
+	CosmeticID  string
+	UnlockedAt  pgtype.Timestamp
+}
+
+// Does nothing when the character has already unlocked the cosmetic
+func (q *Queries) UnlockCosmetic(ctx context.Context, arg UnlockCosmeticParams) (int64, error) {
+	result, err := q.db.Exec(ctx, unlockCosmetic, arg.CharacterID, arg.CosmeticID, arg.UnlockedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,150 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.direct_messages.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createDirectMessage = `-- name: CreateDirectMessage :one
+
+INSERT INTO direct_messages (sender_id, recipient_id, body, created_at)
+VALUES ($1, $2, $3, $4)
+RETURNING id, sender_id, recipient_id, body, created_at, delivered_at
+`
+
+type CreateDirectMessageParams struct {
+	SenderID    pgtype.UUID
+	RecipientID pgtype.UUID
+	Body        string
+	CreatedAt   pgtype.Timestamp
+}
+
+// Direct Message Operations
+func (q *Queries) CreateDirectMessage(ctx context.Context, arg CreateDirectMessageParams) (DirectMessage, error) {
+	row := q.db.QueryRow(ctx, createDirectMessage,
+		arg.SenderID,
+		arg.RecipientID,
+		arg.Body,
+		arg.CreatedAt,
+	)
+	var i DirectMessage
+	err := row.Scan(
+		&i.ID,
+		&i.SenderID,
+		&i.RecipientID,
+		&i.Body,
+		&i.CreatedAt,
+		&i.DeliveredAt,
+	)
+	return i, err
+}
+
+const listConversation = `-- name: ListConversation :many
+SELECT id, sender_id, recipient_id, body, created_at, delivered_at FROM direct_messages
+WHERE ((sender_id = $1 AND recipient_id = $2)
+    OR (sender_id = $2 AND recipient_id = $1))
+  AND id < $3
+ORDER BY id DESC
+LIMIT $4
+`
+
+type ListConversationParams struct {
+	UserA       pgtype.UUID
+	UserB       pgtype.UUID
+	BeforeID    int64
+	MaxMessages int32
+}
+
+// Messages between two users before a message ID, newest first
+func (q *Queries) ListConversation(ctx context.Context, arg ListConversationParams) ([]DirectMessage, error) {
+	rows, err := q.db.Query(ctx, listConversation,
+		arg.UserA,
+		arg.UserB,
+		arg.BeforeID,
+		arg.MaxMessages,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []DirectMessage
+	for rows.Next() {
+		var i DirectMessage
+		if err := rows.Scan(
+			&i.ID,
+			&i.SenderID,
+			&i.RecipientID,
+			&i.Body,
+			&i.CreatedAt,
+			&i.DeliveredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUndeliveredDirectMessages = `-- name: ListUndeliveredDirectMessages :many
+SELECT id, sender_id, recipient_id, body, created_at, delivered_at FROM direct_messages
+WHERE recipient_id = $1 AND delivered_at IS NULL
+ORDER BY id
+LIMIT $2
+`
+
+type ListUndeliveredDirectMessagesParams struct {
+	RecipientID pgtype.UUID
+	Limit       int32
+}
+
+func (q *Queries) ListUndeliveredDirectMessages(ctx context.Context, arg ListUndeliveredDirectMessagesParams) ([]DirectMessage, error) {
+	rows, err := q.db.Query(ctx, listUndeliveredDirectMessages, arg.RecipientID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []DirectMessage
+	for rows.Next() {
+		var i DirectMessage
+		if err := rows.Scan(
+			&i.ID,
+			&i.SenderID,
+			&i.RecipientID,
+			&i.Body,
+			&i.CreatedAt,
+			&i.DeliveredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markDirectMessagesDelivered = `-- name: MarkDirectMessagesDelivered :exec
+UPDATE direct_messages
+SET delivered_at = $1
+WHERE id = ANY($2::bigint[]) AND delivered_at IS NULL
+`
+
+type MarkDirectMessagesDeliveredParams struct {
+	DeliveredAt pgtype.Timestamp
+	Ids         []int64
+}
+
+func (q *Queries) MarkDirectMessagesDelivered(ctx context.Context, arg MarkDirectMessagesDeliveredParams) error {
+	_, err := q.db.Exec(ctx, markDirectMessagesDelivered, arg.DeliveredAt, arg.Ids)
+	return err
+}
@@ -0,0 +1,183 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.discoveries.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createChunkDiscovery = `-- name: CreateChunkDiscovery :execrows
+
+INSERT INTO chunk_discoveries (world_id, chunk_x, chunk_y, character_id, character_name, discovered_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (world_id, chunk_x, chunk_y) DO NOTHING
+`
+
+type CreateChunkDiscoveryParams struct {
+	WorldID       pgtype.UUID
+	ChunkX        int32
+	ChunkY        int32
+	CharacterID   pgtype.UUID
+	CharacterName string
+	DiscoveredAt  pgtype.Timestamp
+}
+
+// First Discovery Operations
+// Does nothing when the chunk was already credited
+func (q *Queries) CreateChunkDiscovery(ctx context.Context, arg CreateChunkDiscoveryParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createChunkDiscovery,
+		arg.WorldID,
+		arg.ChunkX,
+		arg.ChunkY,
+		arg.CharacterID,
+		arg.CharacterName,
+		arg.DiscoveredAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const createResourceDiscovery = `-- name: CreateResourceDiscovery :execrows
+INSERT INTO resource_discoveries (world_id, region_x, region_y, resource_node_type_id, chunk_x, chunk_y, character_id, character_name, discovered_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT (world_id, region_x, region_y, resource_node_type_id) DO NOTHING
+`
+
+type CreateResourceDiscoveryParams struct {
+	WorldID            pgtype.UUID
+	RegionX            int32
+	RegionY            int32
+	ResourceNodeTypeID int32
+	ChunkX             int32
+	ChunkY             int32
+	CharacterID        pgtype.UUID
+	CharacterName      string
+	DiscoveredAt       pgtype.Timestamp
+}
+
+// Does nothing when the node type was already harvested in the region
+func (q *Queries) CreateResourceDiscovery(ctx context.Context, arg CreateResourceDiscoveryParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createResourceDiscovery,
+		arg.WorldID,
+		arg.RegionX,
+		arg.RegionY,
+		arg.ResourceNodeTypeID,
+		arg.ChunkX,
+		arg.ChunkY,
+		arg.CharacterID,
+		arg.CharacterName,
+		arg.DiscoveredAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const listChunkDiscoveriesInRange = `-- name: ListChunkDiscoveriesInRange :many
+SELECT world_id, chunk_x, chunk_y, character_id, character_name, discovered_at FROM chunk_discoveries
+WHERE world_id = $1
+  AND chunk_x BETWEEN $2 AND $3
+  AND chunk_y BETWEEN $4 AND $5
+`
+
+type ListChunkDiscoveriesInRangeParams struct {
+	WorldID   pgtype.UUID
+	MinChunkX int32
+	MaxChunkX int32
+	MinChunkY int32
+	MaxChunkY int32
+}
+
+func (q *Queries) ListChunkDiscoveriesInRange(ctx context.Context, arg ListChunkDiscoveriesInRangeParams) ([]ChunkDiscovery, error) {
+	rows, err := q.db.Query(ctx, listChunkDiscoveriesInRange,
+		arg.WorldID,
+		arg.MinChunkX,
+		arg.MaxChunkX,
+		arg.MinChunkY,
+		arg.MaxChunkY,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChunkDiscovery
+	for rows.Next() {
+		var i ChunkDiscovery
+		if err := rows.Scan(
+			&i.WorldID,
+			&i.ChunkX,
+			&i.ChunkY,
+			&i.CharacterID,
+			&i.CharacterName,
+			&i.DiscoveredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listResourceDiscoveriesInRange = `-- name: ListResourceDiscoveriesInRange :many
+SELECT world_id, region_x, region_y, resource_node_type_id, chunk_x, chunk_y, character_id, character_name, discovered_at FROM resource_discoveries
+WHERE world_id = $1
+  AND chunk_x BETWEEN $2 AND $3
+  AND chunk_y BETWEEN $4 AND $5
+ORDER BY discovered_at
+`
+
+type ListResourceDiscoveriesInRangeParams struct {
+	WorldID   pgtype.UUID
+	MinChunkX int32
+	MaxChunkX int32
+	MinChunkY int32
+	MaxChunkY int32
+}
+
+// Resource discoveries whose first harvest happened in the range
+func (q *Queries) ListResourceDiscoveriesInRange(ctx context.Context, arg ListResourceDiscoveriesInRangeParams) ([]ResourceDiscovery, error) {
+	rows, err := q.db.Query(ctx, listResourceDiscoveriesInRange,
+		arg.WorldID,
+		arg.MinChunkX,
+		arg.MaxChunkX,
+		arg.MinChunkY,
+		arg.MaxChunkY,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ResourceDiscovery
+	for rows.Next() {
+		var i ResourceDiscovery
+		if err := rows.Scan(
+			&i.WorldID,
+			&i.RegionX,
+			&i.RegionY,
+			&i.ResourceNodeTypeID,
+			&i.ChunkX,
+			&i.ChunkY,
+			&i.CharacterID,
+			&i.CharacterName,
+			&i.DiscoveredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,305 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.dungeons.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const addDungeonMember = `-- name: AddDungeonMember :execrows
+INSERT INTO dungeon_members (character_id, dungeon_id, joined_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (character_id) DO NOTHING
+`
+
+type AddDungeonMemberParams struct {
+	CharacterID pgtype.UUID
+	DungeonID   int64
+	JoinedAt    pgtype.Timestamp
+}
+
+// Does nothing when the character is already bound to an instance
+func (q *Queries) AddDungeonMember(ctx context.Context, arg AddDungeonMemberParams) (int64, error) {
+	result, err := q.db.Exec(ctx, addDungeonMember, arg.CharacterID, arg.DungeonID, arg.JoinedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const createDungeonHarvest = `-- name: CreateDungeonHarvest :execrows
+INSERT INTO dungeon_harvests (dungeon_id, node_id, character_id, harvested_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (dungeon_id, node_id) DO NOTHING
+`
+
+type CreateDungeonHarvestParams struct {
+	DungeonID   int64
+	NodeID      int32
+	CharacterID pgtype.UUID
+	HarvestedAt pgtype.Timestamp
+}
+
+// Does nothing when the node was already harvested
+func (q *Queries) CreateDungeonHarvest(ctx context.Context, arg CreateDungeonHarvestParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createDungeonHarvest,
+		arg.DungeonID,
+		arg.NodeID,
+		arg.CharacterID,
+		arg.HarvestedAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const createDungeonInstance = `-- name: CreateDungeonInstance :one
+
+INSERT INTO dungeon_instances (world_id, leader_id, seed, created_at, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, world_id, leader_id, seed, created_at, expires_at
+`
+
+type CreateDungeonInstanceParams struct {
+	WorldID   pgtype.UUID
+	LeaderID  pgtype.UUID
+	Seed      int64
+	CreatedAt pgtype.Timestamp
+	ExpiresAt pgtype.Timestamp
+}
+
+// Dungeon Operations
+func (q *Queries) CreateDungeonInstance(ctx context.Context, arg CreateDungeonInstanceParams) (DungeonInstance, error) {
+	row := q.db.QueryRow(ctx, createDungeonInstance,
+		arg.WorldID,
+		arg.LeaderID,
+		arg.Seed,
+		arg.CreatedAt,
+		arg.ExpiresAt,
+	)
+	var i DungeonInstance
+	err := row.Scan(
+		&i.ID,
+		&i.WorldID,
+		&i.LeaderID,
+		&i.Seed,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const deleteDungeonInstance = `-- name: DeleteDungeonInstance :execrows
+DELETE FROM dungeon_instances
+WHERE id = $1
+`
+
+func (q *Queries) DeleteDungeonInstance(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteDungeonInstance, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const enterDungeon = `-- name: EnterDungeon :execrows
+UPDATE dungeon_members
+SET inside = true, x = $2, y = $3
+WHERE character_id = $1 AND NOT inside
+`
+
+type EnterDungeonParams struct {
+	CharacterID pgtype.UUID
+	X           int32
+	Y           int32
+}
+
+// Does nothing when the character is already inside
+func (q *Queries) EnterDungeon(ctx context.Context, arg EnterDungeonParams) (int64, error) {
+	result, err := q.db.Exec(ctx, enterDungeon, arg.CharacterID, arg.X, arg.Y)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getDungeonInstance = `-- name: GetDungeonInstance :one
+SELECT id, world_id, leader_id, seed, created_at, expires_at FROM dungeon_instances
+WHERE id = $1
+`
+
+func (q *Queries) GetDungeonInstance(ctx context.Context, id int64) (DungeonInstance, error) {
+	row := q.db.QueryRow(ctx, getDungeonInstance, id)
+	var i DungeonInstance
+	err := row.Scan(
+		&i.ID,
+		&i.WorldID,
+		&i.LeaderID,
+		&i.Seed,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const getDungeonMember = `-- name: GetDungeonMember :one
+SELECT character_id, dungeon_id, inside, x, y, joined_at FROM dungeon_members
+WHERE character_id = $1
+`
+
+func (q *Queries) GetDungeonMember(ctx context.Context, characterID pgtype.UUID) (DungeonMember, error) {
+	row := q.db.QueryRow(ctx, getDungeonMember, characterID)
+	var i DungeonMember
+	err := row.Scan(
+		&i.CharacterID,
+		&i.DungeonID,
+		&i.Inside,
+		&i.X,
+		&i.Y,
+		&i.JoinedAt,
+	)
+	return i, err
+}
+
+const leaveDungeon = `-- name: LeaveDungeon :execrows
+UPDATE dungeon_members
+SET inside = false
+WHERE character_id = $1 AND inside
+`
+
+func (q *Queries) LeaveDungeon(ctx context.Context, characterID pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, leaveDungeon, characterID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const listDungeonHarvests = `-- name: ListDungeonHarvests :many
+SELECT dungeon_id, node_id, character_id, harvested_at FROM dungeon_harvests
+WHERE dungeon_id = $1
+ORDER BY node_id
+`
+
+func (q *Queries) ListDungeonHarvests(ctx context.Context, dungeonID int64) ([]DungeonHarvest, error) {
+	rows, err := q.db.Query(ctx, listDungeonHarvests, dungeonID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []DungeonHarvest
+	for rows.Next() {
+		var i DungeonHarvest
+		if err := rows.Scan(
+			&i.DungeonID,
+			&i.NodeID,
+			&i.CharacterID,
+			&i.HarvestedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDungeonMembers = `-- name: ListDungeonMembers :many
+SELECT character_id, dungeon_id, inside, x, y, joined_at FROM dungeon_members
+WHERE dungeon_id = $1
+ORDER BY joined_at, character_id
+`
+
+func (q *Queries) ListDungeonMembers(ctx context.Context, dungeonID int64) ([]DungeonMember, error) {
+	rows, err := q.db.Query(ctx, listDungeonMembers, dungeonID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []DungeonMember
+	for rows.Next() {
+		var i DungeonMember
+		if err := rows.Scan(
+			&i.CharacterID,
+			&i.DungeonID,
+			&i.Inside,
+			&i.X,
+			&i.Y,
+			&i.JoinedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listExpiredDungeonInstances = `-- name: ListExpiredDungeonInstances :many
+SELECT id, world_id, leader_id, seed, created_at, expires_at FROM dungeon_instances
+WHERE expires_at <= $1
+ORDER BY id
+LIMIT $2
+`
+
+type ListExpiredDungeonInstancesParams struct {
+	ExpiresAt pgtype.Timestamp
+	Limit     int32
+}
+
+func (q *Queries) ListExpiredDungeonInstances(ctx context.Context, arg ListExpiredDungeonInstancesParams) ([]DungeonInstance, error) {
+	rows, err := q.db.Query(ctx, listExpiredDungeonInstances, arg.ExpiresAt, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []DungeonInstance
+	for rows.Next() {
+		var i DungeonInstance
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorldID,
+			&i.LeaderID,
+			&i.Seed,
+			&i.CreatedAt,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateDungeonPosition = `-- name: UpdateDungeonPosition :execrows
+UPDATE dungeon_members
+SET x = $2, y = $3
+WHERE character_id = $1 AND inside
+`
+
+type UpdateDungeonPositionParams struct {
+	CharacterID pgtype.UUID
+	X           int32
+	Y           int32
+}
+
+func (q *Queries) UpdateDungeonPosition(ctx context.Context, arg UpdateDungeonPositionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateDungeonPosition, arg.CharacterID, arg.X, arg.Y)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
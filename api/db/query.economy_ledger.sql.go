@@ -0,0 +1,95 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.economy_ledger.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const getEconomyStats = `-- name: GetEconomyStats :many
+SELECT
+  date_trunc($2::text, recorded_at)::timestamp AS period_start,
+  source,
+  SUM(created)::bigint AS created,
+  SUM(destroyed)::bigint AS destroyed
+FROM economy_ledger
+WHERE world_id = $1 AND recorded_at >= $3
+GROUP BY period_start, source
+ORDER BY period_start, source
+`
+
+type GetEconomyStatsParams struct {
+	WorldID pgtype.UUID
+	Period  string
+	Since   pgtype.Timestamp
+}
+
+type GetEconomyStatsRow struct {
+	PeriodStart pgtype.Timestamp
+	Source      string
+	Created     int64
+	Destroyed   int64
+}
+
+// Currency created and destroyed per period and source since a time. Period is a
+// date_trunc field such as 'day' or 'hour'.
+func (q *Queries) GetEconomyStats(ctx context.Context, arg GetEconomyStatsParams) ([]GetEconomyStatsRow, error) {
+	rows, err := q.db.Query(ctx, getEconomyStats, arg.WorldID, arg.Period, arg.Since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetEconomyStatsRow
+	for rows.Next() {
+		var i GetEconomyStatsRow
+		if err := rows.Scan(
+			&i.PeriodStart,
+			&i.Source,
+			&i.Created,
+			&i.Destroyed,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const recordEconomyFlow = `-- name: RecordEconomyFlow :execrows
+INSERT INTO economy_ledger (world_id, source, created, destroyed, dedup_key, recorded_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (dedup_key) DO NOTHING
+`
+
+type RecordEconomyFlowParams struct {
+	WorldID    pgtype.UUID
+	Source     string
+	Created    int64
+	Destroyed  int64
+	DedupKey   string
+	RecordedAt pgtype.Timestamp
+}
+
+// Records a currency movement; a dedup key already recorded is skipped
+func (q *Queries) RecordEconomyFlow(ctx context.Context, arg RecordEconomyFlowParams) (int64, error) {
+	result, err := q.db.Exec(ctx, recordEconomyFlow,
+		arg.WorldID,
+		arg.Source,
+		arg.Created,
+		arg.Destroyed,
+		arg.DedupKey,
+		arg.RecordedAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
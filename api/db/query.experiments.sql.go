@@ -0,0 +1,253 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.experiments.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countExperimentAssignments = `-- name: CountExperimentAssignments :many
+SELECT experiment, variant, COUNT(*) AS assignments
+FROM experiment_assignments
+GROUP BY experiment, variant
+`
+
+type CountExperimentAssignmentsRow struct {
+	Experiment  string
+	Variant     string
+	Assignments int64
+}
+
+func (q *Queries) CountExperimentAssignments(ctx context.Context) ([]CountExperimentAssignmentsRow, error) {
+	rows, err := q.db.Query(ctx, countExperimentAssignments)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CountExperimentAssignmentsRow
+	for rows.Next() {
+		var i CountExperimentAssignmentsRow
+		if err := rows.Scan(&i.Experiment, &i.Variant, &i.Assignments); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const createExperiment = `-- name: CreateExperiment :one
+
+INSERT INTO experiments (name, description, subject, variants, started_at, created_by)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (name) DO NOTHING
+RETURNING name, description, subject, variants, started_at, stopped_at, created_by
+`
+
+type CreateExperimentParams struct {
+	Name        string
+	Description string
+	Subject     string
+	Variants    []byte
+	StartedAt   pgtype.Timestamp
+	CreatedBy   pgtype.UUID
+}
+
+// Experiment Operations
+// Returns no rows when an experiment with the name exists
+func (q *Queries) CreateExperiment(ctx context.Context, arg CreateExperimentParams) (Experiment, error) {
+	row := q.db.QueryRow(ctx, createExperiment,
+		arg.Name,
+		arg.Description,
+		arg.Subject,
+		arg.Variants,
+		arg.StartedAt,
+		arg.CreatedBy,
+	)
+	var i Experiment
+	err := row.Scan(
+		&i.Name,
+		&i.Description,
+		&i.Subject,
+		&i.Variants,
+		&i.StartedAt,
+		&i.StoppedAt,
+		&i.CreatedBy,
+	)
+	return i, err
+}
+
+const createExperimentAssignment = `-- name: CreateExperimentAssignment :one
+INSERT INTO experiment_assignments (experiment, subject_id, variant, assigned_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (experiment, subject_id) DO NOTHING
+RETURNING experiment, subject_id, variant, assigned_at
+`
+
+type CreateExperimentAssignmentParams struct {
+	Experiment string
+	SubjectID  pgtype.UUID
+	Variant    string
+	AssignedAt pgtype.Timestamp
+}
+
+// Returns no rows when the subject is already assigned
+func (q *Queries) CreateExperimentAssignment(ctx context.Context, arg CreateExperimentAssignmentParams) (ExperimentAssignment, error) {
+	row := q.db.QueryRow(ctx, createExperimentAssignment,
+		arg.Experiment,
+		arg.SubjectID,
+		arg.Variant,
+		arg.AssignedAt,
+	)
+	var i ExperimentAssignment
+	err := row.Scan(
+		&i.Experiment,
+		&i.SubjectID,
+		&i.Variant,
+		&i.AssignedAt,
+	)
+	return i, err
+}
+
+const listActiveExperiments = `-- name: ListActiveExperiments :many
+SELECT name, description, subject, variants, started_at, stopped_at, created_by FROM experiments
+WHERE subject = $1
+  AND stopped_at IS NULL
+  AND started_at <= $2
+ORDER BY name
+`
+
+type ListActiveExperimentsParams struct {
+	Subject   string
+	CreatedAt pgtype.Timestamp
+}
+
+// Experiments a subject created at created_at takes part in
+func (q *Queries) ListActiveExperiments(ctx context.Context, arg ListActiveExperimentsParams) ([]Experiment, error) {
+	rows, err := q.db.Query(ctx, listActiveExperiments, arg.Subject, arg.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Experiment
+	for rows.Next() {
+		var i Experiment
+		if err := rows.Scan(
+			&i.Name,
+			&i.Description,
+			&i.Subject,
+			&i.Variants,
+			&i.StartedAt,
+			&i.StoppedAt,
+			&i.CreatedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listExperimentAssignments = `-- name: ListExperimentAssignments :many
+SELECT a.experiment, a.variant, e.variants
+FROM experiment_assignments a
+JOIN experiments e ON e.name = a.experiment
+WHERE a.subject_id = $1
+ORDER BY a.experiment
+`
+
+type ListExperimentAssignmentsRow struct {
+	Experiment string
+	Variant    string
+	Variants   []byte
+}
+
+func (q *Queries) ListExperimentAssignments(ctx context.Context, subjectID pgtype.UUID) ([]ListExperimentAssignmentsRow, error) {
+	rows, err := q.db.Query(ctx, listExperimentAssignments, subjectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListExperimentAssignmentsRow
+	for rows.Next() {
+		var i ListExperimentAssignmentsRow
+		if err := rows.Scan(&i.Experiment, &i.Variant, &i.Variants); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listExperiments = `-- name: ListExperiments :many
+SELECT name, description, subject, variants, started_at, stopped_at, created_by FROM experiments
+ORDER BY started_at, name
+`
+
+func (q *Queries) ListExperiments(ctx context.Context) ([]Experiment, error) {
+	rows, err := q.db.Query(ctx, listExperiments)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Experiment
+	for rows.Next() {
+		var i Experiment
+		if err := rows.Scan(
+			&i.Name,
+			&i.Description,
+			&i.Subject,
+			&i.Variants,
+			&i.StartedAt,
+			&i.StoppedAt,
+			&i.CreatedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const stopExperiment = `-- name: StopExperiment :one
+UPDATE experiments
+SET stopped_at = $2
+WHERE name = $1 AND stopped_at IS NULL
+RETURNING name, description, subject, variants, started_at, stopped_at, created_by
+`
+
+type StopExperimentParams struct {
+	Name      string
+	StoppedAt pgtype.Timestamp
+}
+
+func (q *Queries) StopExperiment(ctx context.Context, arg StopExperimentParams) (Experiment, error) {
+	row := q.db.QueryRow(ctx, stopExperiment, arg.Name, arg.StoppedAt)
+	var i Experiment
+	err := row.Scan(
+		&i.Name,
+		&i.Description,
+		&i.Subject,
+		&i.Variants,
+		&i.StartedAt,
+		&i.StoppedAt,
+		&i.CreatedBy,
+	)
+	return i, err
+}
@@ -0,0 +1,120 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.feature_flags.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const deleteFeatureFlag = `-- name: DeleteFeatureFlag :one
+DELETE FROM feature_flags
+WHERE name = $1
+RETURNING name, description, enabled, rollout_percentage, user_ids, world_ids, updated_by, updated_at
+`
+
+func (q *Queries) DeleteFeatureFlag(ctx context.Context, name string) (FeatureFlag, error) {
+	row := q.db.QueryRow(ctx, deleteFeatureFlag, name)
+	var i FeatureFlag
+	err := row.Scan(
+		&i.Name,
+		&i.Description,
+		&i.Enabled,
+		&i.RolloutPercentage,
+		&i.UserIds,
+		&i.WorldIds,
+		&i.UpdatedBy,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listFeatureFlags = `-- name: ListFeatureFlags :many
+SELECT name, description, enabled, rollout_percentage, user_ids, world_ids, updated_by, updated_at FROM feature_flags
+ORDER BY name
+`
+
+func (q *Queries) ListFeatureFlags(ctx context.Context) ([]FeatureFlag, error) {
+	rows, err := q.db.Query(ctx, listFeatureFlags)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FeatureFlag
+	for rows.Next() {
+		var i FeatureFlag
+		if err := rows.Scan(
+			&i.Name,
+			&i.Description,
+			&i.Enabled,
+			&i.RolloutPercentage,
+			&i.UserIds,
+			&i.WorldIds,
+			&i.UpdatedBy,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertFeatureFlag = `-- name: UpsertFeatureFlag :one
+
+INSERT INTO feature_flags (name, description, enabled, rollout_percentage, user_ids, world_ids, updated_by, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (name) DO UPDATE
+SET description = EXCLUDED.description,
+    enabled = EXCLUDED.enabled,
+    rollout_percentage = EXCLUDED.rollout_percentage,
+    user_ids = EXCLUDED.user_ids,
+    world_ids = EXCLUDED.world_ids,
+    updated_by = EXCLUDED.updated_by,
+    updated_at = EXCLUDED.updated_at
+RETURNING name, description, enabled, rollout_percentage, user_ids, world_ids, updated_by, updated_at
+`
+
+type UpsertFeatureFlagParams struct {
+	Name              string
+	Description       string
+	Enabled           bool
+	RolloutPercentage int32
+	UserIds           []pgtype.UUID
+	WorldIds          []pgtype.UUID
+	UpdatedBy         pgtype.UUID
+	UpdatedAt         pgtype.Timestamp
+}
+
+// Feature Flag Operations
+func (q *Queries) UpsertFeatureFlag(ctx context.Context, arg UpsertFeatureFlagParams) (FeatureFlag, error) {
+	row := q.db.QueryRow(ctx, upsertFeatureFlag,
+		arg.Name,
+		arg.Description,
+		arg.Enabled,
+		arg.RolloutPercentage,
+		arg.UserIds,
+		arg.WorldIds,
+		arg.UpdatedBy,
+		arg.UpdatedAt,
+	)
+	var i FeatureFlag
+	err := row.Scan(
+		&i.Name,
+		&i.Description,
+		&i.Enabled,
+		&i.RolloutPercentage,
+		&i.UserIds,
+		&i.WorldIds,
+		&i.UpdatedBy,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
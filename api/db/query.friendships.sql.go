@@ -0,0 +1,181 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.friendships.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const acceptFriendRequest = `-- name: AcceptFriendRequest :one
+UPDATE friendships
+SET status = 'accepted', responded_at = $3
+WHERE requester_id = $1 AND addressee_id = $2 AND status = 'pending'
+RETURNING requester_id, addressee_id, status, created_at, responded_at
+`
+
+type AcceptFriendRequestParams struct {
+	RequesterID pgtype.UUID
+	AddresseeID pgtype.UUID
+	RespondedAt pgtype.Timestamp
+}
+
+func (q *Queries) AcceptFriendRequest(ctx context.Context, arg AcceptFriendRequestParams) (Friendship, error) {
+	row := q.db.QueryRow(ctx, acceptFriendRequest, arg.RequesterID, arg.AddresseeID, arg.RespondedAt)
+	var i Friendship
+	err := row.Scan(
+		&i.RequesterID,
+		&i.AddresseeID,
+		&i.Status,
+		&i.CreatedAt,
+		&i.RespondedAt,
+	)
+	return i, err
+}
+
+const createFriendRequest = `-- name: CreateFriendRequest :one
+
+INSERT INTO friendships (requester_id, addressee_id, created_at)
+VALUES ($1, $2, $3)
+ON CONFLICT DO NOTHING
+RETURNING requester_id, addressee_id, status, created_at, responded_at
+`
+
+type CreateFriendRequestParams struct {
+	RequesterID pgtype.UUID
+	AddresseeID pgtype.UUID
+	CreatedAt   pgtype.Timestamp
+}
+
+// Friendship Operations
+func (q *Queries) CreateFriendRequest(ctx context.Context, arg CreateFriendRequestParams) (Friendship, error) {
+	row := q.db.QueryRow(ctx, createFriendRequest, arg.RequesterID, arg.AddresseeID, arg.CreatedAt)
+	var i Friendship
+	err := row.Scan(
+		&i.RequesterID,
+		&i.AddresseeID,
+		&i.Status,
+		&i.CreatedAt,
+		&i.RespondedAt,
+	)
+	return i, err
+}
+
+const declineFriendRequest = `-- name: DeclineFriendRequest :execrows
+DELETE FROM friendships
+WHERE requester_id = $1 AND addressee_id = $2 AND status = 'pending'
+`
+
+type DeclineFriendRequestParams struct {
+	RequesterID pgtype.UUID
+	AddresseeID pgtype.UUID
+}
+
+func (q *Queries) DeclineFriendRequest(ctx context.Context, arg DeclineFriendRequestParams) (int64, error) {
+	result, err := q.db.Exec(ctx, declineFriendRequest, arg.RequesterID, arg.AddresseeID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteFriendship = `-- name: DeleteFriendship :execrows
+DELETE FROM friendships
+WHERE (requester_id = $1 AND addressee_id = $2)
+   OR (requester_id = $2 AND addressee_id = $1)
+`
+
+type DeleteFriendshipParams struct {
+	UserA pgtype.UUID
+	UserB pgtype.UUID
+}
+
+func (q *Queries) DeleteFriendship(ctx context.Context, arg DeleteFriendshipParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteFriendship, arg.UserA, arg.UserB)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getFriendshipBetween = `-- name: GetFriendshipBetween :one
+SELECT requester_id, addressee_id, status, created_at, responded_at FROM friendships
+WHERE (requester_id = $1 AND addressee_id = $2)
+   OR (requester_id = $2 AND addressee_id = $1)
+`
+
+type GetFriendshipBetweenParams struct {
+	UserA pgtype.UUID
+	UserB pgtype.UUID
+}
+
+func (q *Queries) GetFriendshipBetween(ctx context.Context, arg GetFriendshipBetweenParams) (Friendship, error) {
+	row := q.db.QueryRow(ctx, getFriendshipBetween, arg.UserA, arg.UserB)
+	var i Friendship
+	err := row.Scan(
+		&i.RequesterID,
+		&i.AddresseeID,
+		&i.Status,
+		&i.CreatedAt,
+		&i.RespondedAt,
+	)
+	return i, err
+}
+
+const listFriendships = `-- name: ListFriendships :many
+SELECT
+  f.requester_id,
+  f.addressee_id,
+  f.status,
+  f.created_at,
+  u.id AS other_user_id,
+  u.username AS other_username,
+  u.display_name AS other_display_name
+FROM friendships f
+JOIN users u ON u.id = CASE WHEN f.requester_id = $1 THEN f.addressee_id ELSE f.requester_id END
+WHERE f.requester_id = $1 OR f.addressee_id = $1
+ORDER BY u.username
+`
+
+type ListFriendshipsRow struct {
+	RequesterID      pgtype.UUID
+	AddresseeID      pgtype.UUID
+	Status           string
+	CreatedAt        pgtype.Timestamp
+	OtherUserID      pgtype.UUID
+	OtherUsername    string
+	OtherDisplayName string
+}
+
+// Friendships and pending requests involving the user, with the other user's names
+func (q *Queries) ListFriendships(ctx context.Context, userID pgtype.UUID) ([]ListFriendshipsRow, error) {
+	rows, err := q.db.Query(ctx, listFriendships, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListFriendshipsRow
+	for rows.Next() {
+		var i ListFriendshipsRow
+		if err := rows.Scan(
+			&i.RequesterID,
+			&i.AddresseeID,
+			&i.Status,
+			&i.CreatedAt,
+			&i.OtherUserID,
+			&i.OtherUsername,
+			&i.OtherDisplayName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,323 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.interiors.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createInterior = `-- name: CreateInterior :one
+INSERT INTO interiors (structure_id, world_id, template, width, height, cells, door_x, door_y, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT (structure_id) DO NOTHING
+RETURNING id, structure_id, world_id, template, width, height, cells, door_x, door_y, created_at
+`
+
+type CreateInteriorParams struct {
+	StructureID int64
+	WorldID     pgtype.UUID
+	Template    string
+	Width       int32
+	Height      int32
+	Cells       []byte
+	DoorX       int32
+	DoorY       int32
+	CreatedAt   pgtype.Timestamp
+}
+
+// Returns no row when the structure's interior was created concurrently
+func (q *Queries) CreateInterior(ctx context.Context, arg CreateInteriorParams) (Interior, error) {
+	row := q.db.QueryRow(ctx, createInterior,
+		arg.StructureID,
+		arg.WorldID,
+		arg.Template,
+		arg.Width,
+		arg.Height,
+		arg.Cells,
+		arg.DoorX,
+		arg.DoorY,
+		arg.CreatedAt,
+	)
+	var i Interior
+	err := row.Scan(
+		&i.ID,
+		&i.StructureID,
+		&i.WorldID,
+		&i.Template,
+		&i.Width,
+		&i.Height,
+		&i.Cells,
+		&i.DoorX,
+		&i.DoorY,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createInteriorFurniture = `-- name: CreateInteriorFurniture :one
+INSERT INTO interior_furniture (interior_id, item_id, x, y, placed_by, placed_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (interior_id, x, y) DO NOTHING
+RETURNING id, interior_id, item_id, x, y, placed_by, placed_at
+`
+
+type CreateInteriorFurnitureParams struct {
+	InteriorID int64
+	ItemID     int32
+	X          int32
+	Y          int32
+	PlacedBy   pgtype.UUID
+	PlacedAt   pgtype.Timestamp
+}
+
+// Returns no row when the cell is already furnished
+func (q *Queries) CreateInteriorFurniture(ctx context.Context, arg CreateInteriorFurnitureParams) (InteriorFurniture, error) {
+	row := q.db.QueryRow(ctx, createInteriorFurniture,
+		arg.InteriorID,
+		arg.ItemID,
+		arg.X,
+		arg.Y,
+		arg.PlacedBy,
+		arg.PlacedAt,
+	)
+	var i InteriorFurniture
+	err := row.Scan(
+		&i.ID,
+		&i.InteriorID,
+		&i.ItemID,
+		&i.X,
+		&i.Y,
+		&i.PlacedBy,
+		&i.PlacedAt,
+	)
+	return i, err
+}
+
+const deleteInteriorFurniture = `-- name: DeleteInteriorFurniture :one
+DELETE FROM interior_furniture
+WHERE id = $1 AND interior_id = $2
+RETURNING id, interior_id, item_id, x, y, placed_by, placed_at
+`
+
+type DeleteInteriorFurnitureParams struct {
+	ID         int64
+	InteriorID int64
+}
+
+func (q *Queries) DeleteInteriorFurniture(ctx context.Context, arg DeleteInteriorFurnitureParams) (InteriorFurniture, error) {
+	row := q.db.QueryRow(ctx, deleteInteriorFurniture, arg.ID, arg.InteriorID)
+	var i InteriorFurniture
+	err := row.Scan(
+		&i.ID,
+		&i.InteriorID,
+		&i.ItemID,
+		&i.X,
+		&i.Y,
+		&i.PlacedBy,
+		&i.PlacedAt,
+	)
+	return i, err
+}
+
+const enterInterior = `-- name: EnterInterior :execrows
+INSERT INTO character_interiors (character_id, interior_id, x, y, entered_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (character_id) DO NOTHING
+`
+
+type EnterInteriorParams struct {
+	CharacterID pgtype.UUID
+	InteriorID  int64
+	X           int32
+	Y           int32
+	EnteredAt   pgtype.Timestamp
+}
+
+// Does nothing when the character is already inside an interior
+func (q *Queries) EnterInterior(ctx context.Context, arg EnterInteriorParams) (int64, error) {
+	result, err := q.db.Exec(ctx, enterInterior,
+		arg.CharacterID,
+		arg.InteriorID,
+		arg.X,
+		arg.Y,
+		arg.EnteredAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const exitInterior = `-- name: ExitInterior :execrows
+DELETE FROM character_interiors
+WHERE character_id = $1
+`
+
+func (q *Queries) ExitInterior(ctx context.Context, characterID pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, exitInterior, characterID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getCharacterInterior = `-- name: GetCharacterInterior :one
+SELECT character_id, interior_id, x, y, entered_at FROM character_interiors
+WHERE character_id = $1
+`
+
+func (q *Queries) GetCharacterInterior(ctx context.Context, characterID pgtype.UUID) (CharacterInterior, error) {
+	row := q.db.QueryRow(ctx, getCharacterInterior, characterID)
+	var i CharacterInterior
+	err := row.Scan(
+		&i.CharacterID,
+		&i.InteriorID,
+		&i.X,
+		&i.Y,
+		&i.EnteredAt,
+	)
+	return i, err
+}
+
+const getInterior = `-- name: GetInterior :one
+
+SELECT id, structure_id, world_id, template, width, height, cells, door_x, door_y, created_at FROM interiors
+WHERE id = $1
+`
+
+// Interior Operations
+func (q *Queries) GetInterior(ctx context.Context, id int64) (Interior, error) {
+	row := q.db.QueryRow(ctx, getInterior, id)
+	var i Interior
+	err := row.Scan(
+		&i.ID,
+		&i.StructureID,
+		&i.WorldID,
+		&i.Template,
+		&i.Width,
+		&i.Height,
+		&i.Cells,
+		&i.DoorX,
+		&i.DoorY,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getInteriorByStructure = `-- name: GetInteriorByStructure :one
+SELECT id, structure_id, world_id, template, width, height, cells, door_x, door_y, created_at FROM interiors
+WHERE structure_id = $1
+`
+
+func (q *Queries) GetInteriorByStructure(ctx context.Context, structureID int64) (Interior, error) {
+	row := q.db.QueryRow(ctx, getInteriorByStructure, structureID)
+	var i Interior
+	err := row.Scan(
+		&i.ID,
+		&i.StructureID,
+		&i.WorldID,
+		&i.Template,
+		&i.Width,
+		&i.Height,
+		&i.Cells,
+		&i.DoorX,
+		&i.DoorY,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const interiorFurnitureExistsAt = `-- name: InteriorFurnitureExistsAt :one
+SELECT EXISTS (
+  SELECT 1 FROM interior_furniture
+  WHERE interior_id = $1 AND x = $2 AND y = $3
+)
+`
+
+type InteriorFurnitureExistsAtParams struct {
+	InteriorID int64
+	X          int32
+	Y          int32
+}
+
+func (q *Queries) InteriorFurnitureExistsAt(ctx context.Context, arg InteriorFurnitureExistsAtParams) (bool, error) {
+	row := q.db.QueryRow(ctx, interiorFurnitureExistsAt, arg.InteriorID, arg.X, arg.Y)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const listInteriorFurniture = `-- name: ListInteriorFurniture :many
+SELECT
+  interior_furniture.id, interior_furniture.interior_id, interior_furniture.item_id, interior_furniture.x, interior_furniture.y, interior_furniture.placed_by, interior_furniture.placed_at,
+  items.name AS item_name
+FROM interior_furniture
+JOIN items ON items.id = interior_furniture.item_id
+WHERE interior_furniture.interior_id = $1
+ORDER BY interior_furniture.id
+`
+
+type ListInteriorFurnitureRow struct {
+	ID         int64
+	InteriorID int64
+	ItemID     int32
+	X          int32
+	Y          int32
+	PlacedBy   pgtype.UUID
+	PlacedAt   pgtype.Timestamp
+	ItemName   string
+}
+
+func (q *Queries) ListInteriorFurniture(ctx context.Context, interiorID int64) ([]ListInteriorFurnitureRow, error) {
+	rows, err := q.db.Query(ctx, listInteriorFurniture, interiorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListInteriorFurnitureRow
+	for rows.Next() {
+		var i ListInteriorFurnitureRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.InteriorID,
+			&i.ItemID,
+			&i.X,
+			&i.Y,
+			&i.PlacedBy,
+			&i.PlacedAt,
+			&i.ItemName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateCharacterInteriorPosition = `-- name: UpdateCharacterInteriorPosition :execrows
+UPDATE character_interiors
+SET x = $2, y = $3
+WHERE character_id = $1
+`
+
+type UpdateCharacterInteriorPositionParams struct {
+	CharacterID pgtype.UUID
+	X           int32
+	Y           int32
+}
+
+func (q *Queries) UpdateCharacterInteriorPosition(ctx context.Context, arg UpdateCharacterInteriorPositionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateCharacterInteriorPosition, arg.CharacterID, arg.X, arg.Y)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -267,6 +267,123 @@ func (q *Queries) InventoryItemExists(ctx context.Context, arg InventoryItemExis
 	return exists, err
 }
 
+const listDuplicateInventoryStacks = `-- name: ListDuplicateInventoryStacks :many
+SELECT
+  character_id,
+  item_id,
+  array_agg(id ORDER BY id)::integer[] AS inventory_ids,
+  SUM(quantity)::integer AS total_quantity
+FROM character_inventories
+GROUP BY character_id, item_id
+HAVING COUNT(*) > 1
+ORDER BY character_id, item_id
+`
+
+type ListDuplicateInventoryStacksRow struct {
+	CharacterID   pgtype.UUID
+	ItemID        int32
+	InventoryIds  []int32
+	TotalQuantity int32
+}
+
+// Rows sharing a character and item. The unique constraint prevents them, but databases
+// created before it was added may still have some
+func (q *Queries) ListDuplicateInventoryStacks(ctx context.Context) ([]ListDuplicateInventoryStacksRow, error) {
+	rows, err := q.db.Query(ctx, listDuplicateInventoryStacks)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListDuplicateInventoryStacksRow
+	for rows.Next() {
+		var i ListDuplicateInventoryStacksRow
+		if err := rows.Scan(
+			&i.CharacterID,
+			&i.ItemID,
+			&i.InventoryIds,
+			&i.TotalQuantity,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOverfullInventoryStacks = `-- name: ListOverfullInventoryStacks :many
+SELECT ci.id, ci.character_id, ci.item_id, ci.quantity, i.stack_size
+FROM character_inventories ci
+JOIN items i ON ci.item_id = i.id
+WHERE ci.quantity > i.stack_size
+ORDER BY ci.id
+`
+
+type ListOverfullInventoryStacksRow struct {
+	ID          int32
+	CharacterID pgtype.UUID
+	ItemID      int32
+	Quantity    int32
+	StackSize   int32
+}
+
+func (q *Queries) ListOverfullInventoryStacks(ctx context.Context) ([]ListOverfullInventoryStacksRow, error) {
+	rows, err := q.db.Query(ctx, listOverfullInventoryStacks)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListOverfullInventoryStacksRow
+	for rows.Next() {
+		var i ListOverfullInventoryStacksRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CharacterID,
+			&i.ItemID,
+			&i.Quantity,
+			&i.StackSize,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const mergeInventoryStacks = `-- name: MergeInventoryStacks :one
+WITH merged AS (
+  DELETE FROM character_inventories d
+  WHERE d.character_id = $2 AND d.item_id = $3 AND d.id <> $1
+  RETURNING d.quantity
+)
+UPDATE character_inventories ci
+SET
+  quantity = ci.quantity + (SELECT COALESCE(SUM(m.quantity), 0) FROM merged m)::integer,
+  updated_at = NOW()
+WHERE ci.id = $1
+RETURNING ci.quantity
+`
+
+type MergeInventoryStacksParams struct {
+	KeepID      int32
+	CharacterID pgtype.UUID
+	ItemID      int32
+}
+
+// Moves the quantity of every other row for the character and item into keep_id and
+// deletes them, in one statement
+func (q *Queries) MergeInventoryStacks(ctx context.Context, arg MergeInventoryStacksParams) (int32, error) {
+	row := q.db.QueryRow(ctx, mergeInventoryStacks, arg.KeepID, arg.CharacterID, arg.ItemID)
+	var quantity int32
+	err := row.Scan(&quantity)
+	return quantity, err
+}
+
 const removeInventoryItemQuantity = `-- name: RemoveInventoryItemQuantity :one
 UPDATE character_inventories
 SET 
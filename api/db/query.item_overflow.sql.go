@@ -0,0 +1,408 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.item_overflow.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createGroundDrop = `-- name: CreateGroundDrop :one
+INSERT INTO ground_drops (world_id, x, y, item_id, quantity, dropped_by, created_at, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id, world_id, x, y, item_id, quantity, dropped_by, created_at, expires_at
+`
+
+type CreateGroundDropParams struct {
+	WorldID   pgtype.UUID
+	X         int32
+	Y         int32
+	ItemID    int32
+	Quantity  int32
+	DroppedBy pgtype.UUID
+	CreatedAt pgtype.Timestamp
+	ExpiresAt pgtype.Timestamp
+}
+
+func (q *Queries) CreateGroundDrop(ctx context.Context, arg CreateGroundDropParams) (GroundDrop, error) {
+	row := q.db.QueryRow(ctx, createGroundDrop,
+		arg.WorldID,
+		arg.X,
+		arg.Y,
+		arg.ItemID,
+		arg.Quantity,
+		arg.DroppedBy,
+		arg.CreatedAt,
+		arg.ExpiresAt,
+	)
+	var i GroundDrop
+	err := row.Scan(
+		&i.ID,
+		&i.WorldID,
+		&i.X,
+		&i.Y,
+		&i.ItemID,
+		&i.Quantity,
+		&i.DroppedBy,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const createInboxItem = `-- name: CreateInboxItem :one
+INSERT INTO inbox_items (character_id, item_id, quantity, reason, created_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, character_id, item_id, quantity, reason, created_at
+`
+
+type CreateInboxItemParams struct {
+	CharacterID pgtype.UUID
+	ItemID      int32
+	Quantity    int32
+	Reason      string
+	CreatedAt   pgtype.Timestamp
+}
+
+func (q *Queries) CreateInboxItem(ctx context.Context, arg CreateInboxItemParams) (InboxItem, error) {
+	row := q.db.QueryRow(ctx, createInboxItem,
+		arg.CharacterID,
+		arg.ItemID,
+		arg.Quantity,
+		arg.Reason,
+		arg.CreatedAt,
+	)
+	var i InboxItem
+	err := row.Scan(
+		&i.ID,
+		&i.CharacterID,
+		&i.ItemID,
+		&i.Quantity,
+		&i.Reason,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteExpiredGroundDrops = `-- name: DeleteExpiredGroundDrops :execrows
+DELETE FROM ground_drops
+WHERE expires_at <= $1
+`
+
+func (q *Queries) DeleteExpiredGroundDrops(ctx context.Context, expiresAt pgtype.Timestamp) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteExpiredGroundDrops, expiresAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteGroundDrop = `-- name: DeleteGroundDrop :exec
+DELETE FROM ground_drops
+WHERE id = $1
+`
+
+func (q *Queries) DeleteGroundDrop(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, deleteGroundDrop, id)
+	return err
+}
+
+const deleteInboxItem = `-- name: DeleteInboxItem :exec
+DELETE FROM inbox_items
+WHERE id = $1
+`
+
+func (q *Queries) DeleteInboxItem(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, deleteInboxItem, id)
+	return err
+}
+
+const getGroundDropForUpdate = `-- name: GetGroundDropForUpdate :one
+SELECT gd.id, gd.world_id, gd.x, gd.y, gd.item_id, gd.quantity, gd.dropped_by, gd.created_at, gd.expires_at, i.name AS item_name, i.stack_size
+FROM ground_drops gd
+JOIN items i ON gd.item_id = i.id
+WHERE gd.id = $1
+FOR UPDATE OF gd
+`
+
+type GetGroundDropForUpdateRow struct {
+	ID        int64
+	WorldID   pgtype.UUID
+	X         int32
+	Y         int32
+	ItemID    int32
+	Quantity  int32
+	DroppedBy pgtype.UUID
+	CreatedAt pgtype.Timestamp
+	ExpiresAt pgtype.Timestamp
+	ItemName  string
+	StackSize int32
+}
+
+func (q *Queries) GetGroundDropForUpdate(ctx context.Context, id int64) (GetGroundDropForUpdateRow, error) {
+	row := q.db.QueryRow(ctx, getGroundDropForUpdate, id)
+	var i GetGroundDropForUpdateRow
+	err := row.Scan(
+		&i.ID,
+		&i.WorldID,
+		&i.X,
+		&i.Y,
+		&i.ItemID,
+		&i.Quantity,
+		&i.DroppedBy,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.ItemName,
+		&i.StackSize,
+	)
+	return i, err
+}
+
+const getInboxItemForUpdate = `-- name: GetInboxItemForUpdate :one
+SELECT ii.id, ii.character_id, ii.item_id, ii.quantity, ii.reason, ii.created_at, i.name AS item_name, i.stack_size
+FROM inbox_items ii
+JOIN items i ON ii.item_id = i.id
+WHERE ii.id = $1
+FOR UPDATE OF ii
+`
+
+type GetInboxItemForUpdateRow struct {
+	ID          int64
+	CharacterID pgtype.UUID
+	ItemID      int32
+	Quantity    int32
+	Reason      string
+	CreatedAt   pgtype.Timestamp
+	ItemName    string
+	StackSize   int32
+}
+
+func (q *Queries) GetInboxItemForUpdate(ctx context.Context, id int64) (GetInboxItemForUpdateRow, error) {
+	row := q.db.QueryRow(ctx, getInboxItemForUpdate, id)
+	var i GetInboxItemForUpdateRow
+	err := row.Scan(
+		&i.ID,
+		&i.CharacterID,
+		&i.ItemID,
+		&i.Quantity,
+		&i.Reason,
+		&i.CreatedAt,
+		&i.ItemName,
+		&i.StackSize,
+	)
+	return i, err
+}
+
+const getWorldInventorySettings = `-- name: GetWorldInventorySettings :one
+SELECT world_id, inventory_slots, harvest_overflow, updated_by, updated_at FROM world_inventory_settings
+WHERE world_id = $1
+`
+
+func (q *Queries) GetWorldInventorySettings(ctx context.Context, worldID pgtype.UUID) (WorldInventorySetting, error) {
+	row := q.db.QueryRow(ctx, getWorldInventorySettings, worldID)
+	var i WorldInventorySetting
+	err := row.Scan(
+		&i.WorldID,
+		&i.InventorySlots,
+		&i.HarvestOverflow,
+		&i.UpdatedBy,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listGroundDropsNear = `-- name: ListGroundDropsNear :many
+SELECT gd.id, gd.world_id, gd.x, gd.y, gd.item_id, gd.quantity, gd.dropped_by, gd.created_at, gd.expires_at, i.name AS item_name, i.stack_size
+FROM ground_drops gd
+JOIN items i ON gd.item_id = i.id
+WHERE gd.world_id = $1
+  AND gd.x BETWEEN $2::integer AND $3::integer
+  AND gd.y BETWEEN $4::integer AND $5::integer
+  AND gd.expires_at > $6
+ORDER BY gd.id
+`
+
+type ListGroundDropsNearParams struct {
+	WorldID pgtype.UUID
+	MinX    int32
+	MaxX    int32
+	MinY    int32
+	MaxY    int32
+	Now     pgtype.Timestamp
+}
+
+type ListGroundDropsNearRow struct {
+	ID        int64
+	WorldID   pgtype.UUID
+	X         int32
+	Y         int32
+	ItemID    int32
+	Quantity  int32
+	DroppedBy pgtype.UUID
+	CreatedAt pgtype.Timestamp
+	ExpiresAt pgtype.Timestamp
+	ItemName  string
+	StackSize int32
+}
+
+func (q *Queries) ListGroundDropsNear(ctx context.Context, arg ListGroundDropsNearParams) ([]ListGroundDropsNearRow, error) {
+	rows, err := q.db.Query(ctx, listGroundDropsNear,
+		arg.WorldID,
+		arg.MinX,
+		arg.MaxX,
+		arg.MinY,
+		arg.MaxY,
+		arg.Now,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListGroundDropsNearRow
+	for rows.Next() {
+		var i ListGroundDropsNearRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorldID,
+			&i.X,
+			&i.Y,
+			&i.ItemID,
+			&i.Quantity,
+			&i.DroppedBy,
+			&i.CreatedAt,
+			&i.ExpiresAt,
+			&i.ItemName,
+			&i.StackSize,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInboxItems = `-- name: ListInboxItems :many
+SELECT ii.id, ii.character_id, ii.item_id, ii.quantity, ii.reason, ii.created_at, i.name AS item_name, i.stack_size
+FROM inbox_items ii
+JOIN items i ON ii.item_id = i.id
+WHERE ii.character_id = $1
+ORDER BY ii.id
+`
+
+type ListInboxItemsRow struct {
+	ID          int64
+	CharacterID pgtype.UUID
+	ItemID      int32
+	Quantity    int32
+	Reason      string
+	CreatedAt   pgtype.Timestamp
+	ItemName    string
+	StackSize   int32
+}
+
+func (q *Queries) ListInboxItems(ctx context.Context, characterID pgtype.UUID) ([]ListInboxItemsRow, error) {
+	rows, err := q.db.Query(ctx, listInboxItems, characterID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListInboxItemsRow
+	for rows.Next() {
+		var i ListInboxItemsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CharacterID,
+			&i.ItemID,
+			&i.Quantity,
+			&i.Reason,
+			&i.CreatedAt,
+			&i.ItemName,
+			&i.StackSize,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateGroundDropQuantity = `-- name: UpdateGroundDropQuantity :exec
+UPDATE ground_drops
+SET quantity = $2
+WHERE id = $1
+`
+
+type UpdateGroundDropQuantityParams struct {
+	ID       int64
+	Quantity int32
+}
+
+func (q *Queries) UpdateGroundDropQuantity(ctx context.Context, arg UpdateGroundDropQuantityParams) error {
+	_, err := q.db.Exec(ctx, updateGroundDropQuantity, arg.ID, arg.Quantity)
+	return err
+}
+
+const updateInboxItemQuantity = `-- name: UpdateInboxItemQuantity :exec
+UPDATE inbox_items
+SET quantity = $2
+WHERE id = $1
+`
+
+type UpdateInboxItemQuantityParams struct {
+	ID       int64
+	Quantity int32
+}
+
+func (q *Queries) UpdateInboxItemQuantity(ctx context.Context, arg UpdateInboxItemQuantityParams) error {
+	_, err := q.db.Exec(ctx, updateInboxItemQuantity, arg.ID, arg.Quantity)
+	return err
+}
+
+const upsertWorldInventorySettings = `-- name: UpsertWorldInventorySettings :one
+INSERT INTO world_inventory_settings (world_id, inventory_slots, harvest_overflow, updated_by, updated_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (world_id) DO UPDATE
+SET
+  inventory_slots = EXCLUDED.inventory_slots,
+  harvest_overflow = EXCLUDED.harvest_overflow,
+  updated_by = EXCLUDED.updated_by,
+  updated_at = EXCLUDED.updated_at
+RETURNING world_id, inventory_slots, harvest_overflow, updated_by, updated_at
+`
+
+type UpsertWorldInventorySettingsParams struct {
+	WorldID         pgtype.UUID
+	InventorySlots  pgtype.Int4
+	HarvestOverflow string
+	UpdatedBy       pgtype.UUID
+	UpdatedAt       pgtype.Timestamp
+}
+
+func (q *Queries) UpsertWorldInventorySettings(ctx context.Context, arg UpsertWorldInventorySettingsParams) (WorldInventorySetting, error) {
+	row := q.db.QueryRow(ctx, upsertWorldInventorySettings,
+		arg.WorldID,
+		arg.InventorySlots,
+		arg.HarvestOverflow,
+		arg.UpdatedBy,
+		arg.UpdatedAt,
+	)
+	var i WorldInventorySetting
+	err := row.Scan(
+		&i.WorldID,
+		&i.InventorySlots,
+		&i.HarvestOverflow,
+		&i.UpdatedBy,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
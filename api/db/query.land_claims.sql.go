@@ -0,0 +1,259 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.land_claims.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countLandClaimsByCharacter = `-- name: CountLandClaimsByCharacter :one
+SELECT COUNT(*) FROM land_claims
+WHERE character_id = $1
+`
+
+func (q *Queries) CountLandClaimsByCharacter(ctx context.Context, characterID pgtype.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countLandClaimsByCharacter, characterID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createLandClaim = `-- name: CreateLandClaim :one
+
+INSERT INTO land_claims (world_id, chunk_x, chunk_y, character_id, claimed_at, paid_until)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (world_id, chunk_x, chunk_y) DO NOTHING
+RETURNING id, world_id, chunk_x, chunk_y, character_id, claimed_at, paid_until
+`
+
+type CreateLandClaimParams struct {
+	WorldID     pgtype.UUID
+	ChunkX      int32
+	ChunkY      int32
+	CharacterID pgtype.UUID
+	ClaimedAt   pgtype.Timestamp
+	PaidUntil   pgtype.Timestamp
+}
+
+// Land Claim Operations
+// Returns no rows when the chunk is already claimed
+func (q *Queries) CreateLandClaim(ctx context.Context, arg CreateLandClaimParams) (LandClaim, error) {
+	row := q.db.QueryRow(ctx, createLandClaim,
+		arg.WorldID,
+		arg.ChunkX,
+		arg.ChunkY,
+		arg.CharacterID,
+		arg.ClaimedAt,
+		arg.PaidUntil,
+	)
+	var i LandClaim
+	err := row.Scan(
+		&i.ID,
+		&i.WorldID,
+		&i.ChunkX,
+		&i.ChunkY,
+		&i.CharacterID,
+		&i.ClaimedAt,
+		&i.PaidUntil,
+	)
+	return i, err
+}
+
+const deleteLandClaim = `-- name: DeleteLandClaim :one
+DELETE FROM land_claims
+WHERE id = $1 AND character_id = $2
+RETURNING id, world_id, chunk_x, chunk_y, character_id, claimed_at, paid_until
+`
+
+type DeleteLandClaimParams struct {
+	ID          int64
+	CharacterID pgtype.UUID
+}
+
+func (q *Queries) DeleteLandClaim(ctx context.Context, arg DeleteLandClaimParams) (LandClaim, error) {
+	row := q.db.QueryRow(ctx, deleteLandClaim, arg.ID, arg.CharacterID)
+	var i LandClaim
+	err := row.Scan(
+		&i.ID,
+		&i.WorldID,
+		&i.ChunkX,
+		&i.ChunkY,
+		&i.CharacterID,
+		&i.ClaimedAt,
+		&i.PaidUntil,
+	)
+	return i, err
+}
+
+const extendLandClaim = `-- name: ExtendLandClaim :exec
+UPDATE land_claims
+SET paid_until = $2
+WHERE id = $1
+`
+
+type ExtendLandClaimParams struct {
+	ID        int64
+	PaidUntil pgtype.Timestamp
+}
+
+func (q *Queries) ExtendLandClaim(ctx context.Context, arg ExtendLandClaimParams) error {
+	_, err := q.db.Exec(ctx, extendLandClaim, arg.ID, arg.PaidUntil)
+	return err
+}
+
+const getLandClaimAt = `-- name: GetLandClaimAt :one
+SELECT id, world_id, chunk_x, chunk_y, character_id, claimed_at, paid_until FROM land_claims
+WHERE world_id = $1 AND chunk_x = $2 AND chunk_y = $3
+`
+
+type GetLandClaimAtParams struct {
+	WorldID pgtype.UUID
+	ChunkX  int32
+	ChunkY  int32
+}
+
+func (q *Queries) GetLandClaimAt(ctx context.Context, arg GetLandClaimAtParams) (LandClaim, error) {
+	row := q.db.QueryRow(ctx, getLandClaimAt, arg.WorldID, arg.ChunkX, arg.ChunkY)
+	var i LandClaim
+	err := row.Scan(
+		&i.ID,
+		&i.WorldID,
+		&i.ChunkX,
+		&i.ChunkY,
+		&i.CharacterID,
+		&i.ClaimedAt,
+		&i.PaidUntil,
+	)
+	return i, err
+}
+
+const listLandClaimsByCharacter = `-- name: ListLandClaimsByCharacter :many
+SELECT id, world_id, chunk_x, chunk_y, character_id, claimed_at, paid_until FROM land_claims
+WHERE character_id = $1
+ORDER BY id
+`
+
+func (q *Queries) ListLandClaimsByCharacter(ctx context.Context, characterID pgtype.UUID) ([]LandClaim, error) {
+	rows, err := q.db.Query(ctx, listLandClaimsByCharacter, characterID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []LandClaim
+	for rows.Next() {
+		var i LandClaim
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorldID,
+			&i.ChunkX,
+			&i.ChunkY,
+			&i.CharacterID,
+			&i.ClaimedAt,
+			&i.PaidUntil,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLandClaimsDue = `-- name: ListLandClaimsDue :many
+SELECT id, world_id, chunk_x, chunk_y, character_id, claimed_at, paid_until FROM land_claims
+WHERE paid_until <= $1
+  AND world_id NOT IN (SELECT world_id FROM world_pauses)
+ORDER BY paid_until, id
+LIMIT $2
+`
+
+type ListLandClaimsDueParams struct {
+	PaidUntil pgtype.Timestamp
+	Limit     int32
+}
+
+// Claims in paused worlds are left alone until the world is resumed
+func (q *Queries) ListLandClaimsDue(ctx context.Context, arg ListLandClaimsDueParams) ([]LandClaim, error) {
+	rows, err := q.db.Query(ctx, listLandClaimsDue, arg.PaidUntil, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []LandClaim
+	for rows.Next() {
+		var i LandClaim
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorldID,
+			&i.ChunkX,
+			&i.ChunkY,
+			&i.CharacterID,
+			&i.ClaimedAt,
+			&i.PaidUntil,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLandClaimsInRange = `-- name: ListLandClaimsInRange :many
+SELECT id, world_id, chunk_x, chunk_y, character_id, claimed_at, paid_until FROM land_claims
+WHERE world_id = $1
+  AND chunk_x BETWEEN $2::integer AND $3::integer
+  AND chunk_y BETWEEN $4::integer AND $5::integer
+ORDER BY id
+`
+
+type ListLandClaimsInRangeParams struct {
+	WorldID   pgtype.UUID
+	MinChunkX int32
+	MaxChunkX int32
+	MinChunkY int32
+	MaxChunkY int32
+}
+
+func (q *Queries) ListLandClaimsInRange(ctx context.Context, arg ListLandClaimsInRangeParams) ([]LandClaim, error) {
+	rows, err := q.db.Query(ctx, listLandClaimsInRange,
+		arg.WorldID,
+		arg.MinChunkX,
+		arg.MaxChunkX,
+		arg.MinChunkY,
+		arg.MaxChunkY,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []LandClaim
+	for rows.Next() {
+		var i LandClaim
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorldID,
+			&i.ChunkX,
+			&i.ChunkY,
+			&i.CharacterID,
+			&i.ClaimedAt,
+			&i.PaidUntil,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
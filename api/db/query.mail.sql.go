@@ -0,0 +1,265 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.mail.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createMail = `-- name: CreateMail :one
+
+INSERT INTO mail (recipient_id, sender_id, sender_name, subject, body, currency, returned, created_at, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING id, recipient_id, sender_id, sender_name, subject, body, currency, returned, created_at, expires_at, claimed_at
+`
+
+type CreateMailParams struct {
+	RecipientID pgtype.UUID
+	SenderID    pgtype.UUID
+	SenderName  string
+	Subject     string
+	Body        string
+	Currency    int32
+	Returned    bool
+	CreatedAt   pgtype.Timestamp
+	ExpiresAt   pgtype.Timestamp
+}
+
+// Mail Operations
+func (q *Queries) CreateMail(ctx context.Context, arg CreateMailParams) (Mail, error) {
+	row := q.db.QueryRow(ctx, createMail,
+		arg.RecipientID,
+		arg.SenderID,
+		arg.SenderName,
+		arg.Subject,
+		arg.Body,
+		arg.Currency,
+		arg.Returned,
+		arg.CreatedAt,
+		arg.ExpiresAt,
+	)
+	var i Mail
+	err := row.Scan(
+		&i.ID,
+		&i.RecipientID,
+		&i.SenderID,
+		&i.SenderName,
+		&i.Subject,
+		&i.Body,
+		&i.Currency,
+		&i.Returned,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.ClaimedAt,
+	)
+	return i, err
+}
+
+const createMailAttachment = `-- name: CreateMailAttachment :exec
+INSERT INTO mail_attachments (mail_id, item_id, quantity)
+VALUES ($1, $2, $3)
+`
+
+type CreateMailAttachmentParams struct {
+	MailID   int64
+	ItemID   int32
+	Quantity int32
+}
+
+func (q *Queries) CreateMailAttachment(ctx context.Context, arg CreateMailAttachmentParams) error {
+	_, err := q.db.Exec(ctx, createMailAttachment, arg.MailID, arg.ItemID, arg.Quantity)
+	return err
+}
+
+const deleteMail = `-- name: DeleteMail :exec
+DELETE FROM mail
+WHERE id = $1
+`
+
+func (q *Queries) DeleteMail(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, deleteMail, id)
+	return err
+}
+
+const getMailForUpdate = `-- name: GetMailForUpdate :one
+SELECT id, recipient_id, sender_id, sender_name, subject, body, currency, returned, created_at, expires_at, claimed_at FROM mail
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetMailForUpdate(ctx context.Context, id int64) (Mail, error) {
+	row := q.db.QueryRow(ctx, getMailForUpdate, id)
+	var i Mail
+	err := row.Scan(
+		&i.ID,
+		&i.RecipientID,
+		&i.SenderID,
+		&i.SenderName,
+		&i.Subject,
+		&i.Body,
+		&i.Currency,
+		&i.Returned,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.ClaimedAt,
+	)
+	return i, err
+}
+
+const listExpiredMail = `-- name: ListExpiredMail :many
+SELECT id FROM mail
+WHERE expires_at <= $1
+ORDER BY id
+LIMIT $2
+`
+
+type ListExpiredMailParams struct {
+	ExpiresAt pgtype.Timestamp
+	Limit     int32
+}
+
+func (q *Queries) ListExpiredMail(ctx context.Context, arg ListExpiredMailParams) ([]int64, error) {
+	rows, err := q.db.Query(ctx, listExpiredMail, arg.ExpiresAt, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMail = `-- name: ListMail :many
+SELECT id, recipient_id, sender_id, sender_name, subject, body, currency, returned, created_at, expires_at, claimed_at FROM mail
+WHERE recipient_id = $1
+ORDER BY id DESC
+LIMIT $2
+`
+
+type ListMailParams struct {
+	RecipientID pgtype.UUID
+	MaxMail     int32
+}
+
+// The character's newest mail first
+func (q *Queries) ListMail(ctx context.Context, arg ListMailParams) ([]Mail, error) {
+	rows, err := q.db.Query(ctx, listMail, arg.RecipientID, arg.MaxMail)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Mail
+	for rows.Next() {
+		var i Mail
+		if err := rows.Scan(
+			&i.ID,
+			&i.RecipientID,
+			&i.SenderID,
+			&i.SenderName,
+			&i.Subject,
+			&i.Body,
+			&i.Currency,
+			&i.Returned,
+			&i.CreatedAt,
+			&i.ExpiresAt,
+			&i.ClaimedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMailAttachments = `-- name: ListMailAttachments :many
+SELECT ma.id, ma.mail_id, ma.item_id, ma.quantity, i.name AS item_name, i.stack_size
+FROM mail_attachments ma
+JOIN items i ON ma.item_id = i.id
+WHERE ma.mail_id = ANY($1::bigint[])
+ORDER BY ma.id
+`
+
+type ListMailAttachmentsRow struct {
+	ID        int64
+	MailID    int64
+	ItemID    int32
+	Quantity  int32
+	ItemName  string
+	StackSize int32
+}
+
+func (q *Queries) ListMailAttachments(ctx context.Context, mailIds []int64) ([]ListMailAttachmentsRow, error) {
+	rows, err := q.db.Query(ctx, listMailAttachments, mailIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListMailAttachmentsRow
+	for rows.Next() {
+		var i ListMailAttachmentsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.MailID,
+			&i.ItemID,
+			&i.Quantity,
+			&i.ItemName,
+			&i.StackSize,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markMailClaimed = `-- name: MarkMailClaimed :exec
+UPDATE mail
+SET claimed_at = $2
+WHERE id = $1
+`
+
+type MarkMailClaimedParams struct {
+	ID        int64
+	ClaimedAt pgtype.Timestamp
+}
+
+func (q *Queries) MarkMailClaimed(ctx context.Context, arg MarkMailClaimedParams) error {
+	_, err := q.db.Exec(ctx, markMailClaimed, arg.ID, arg.ClaimedAt)
+	return err
+}
+
+const moveMailAttachments = `-- name: MoveMailAttachments :exec
+UPDATE mail_attachments
+SET mail_id = $1
+WHERE mail_id = $2
+`
+
+type MoveMailAttachmentsParams struct {
+	ToMailID   int64
+	FromMailID int64
+}
+
+func (q *Queries) MoveMailAttachments(ctx context.Context, arg MoveMailAttachmentsParams) error {
+	_, err := q.db.Exec(ctx, moveMailAttachments, arg.ToMailID, arg.FromMailID)
+	return err
+}
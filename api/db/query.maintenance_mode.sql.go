@@ -0,0 +1,78 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.maintenance_mode.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const getMaintenanceMode = `-- name: GetMaintenanceMode :one
+
+SELECT id, enabled, message, eta, freeze_at, updated_by, updated_at FROM maintenance_mode
+WHERE id
+`
+
+// Maintenance Mode Operations
+func (q *Queries) GetMaintenanceMode(ctx context.Context) (MaintenanceMode, error) {
+	row := q.db.QueryRow(ctx, getMaintenanceMode)
+	var i MaintenanceMode
+	err := row.Scan(
+		&i.ID,
+		&i.Enabled,
+		&i.Message,
+		&i.Eta,
+		&i.FreezeAt,
+		&i.UpdatedBy,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertMaintenanceMode = `-- name: UpsertMaintenanceMode :one
+INSERT INTO maintenance_mode (enabled, message, eta, freeze_at, updated_by, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (id) DO UPDATE
+SET enabled = EXCLUDED.enabled,
+    message = EXCLUDED.message,
+    eta = EXCLUDED.eta,
+    freeze_at = EXCLUDED.freeze_at,
+    updated_by = EXCLUDED.updated_by,
+    updated_at = EXCLUDED.updated_at
+RETURNING id, enabled, message, eta, freeze_at, updated_by, updated_at
+`
+
+type UpsertMaintenanceModeParams struct {
+	Enabled   bool
+	Message   string
+	Eta       pgtype.Timestamp
+	FreezeAt  pgtype.Timestamp
+	UpdatedBy pgtype.UUID
+	UpdatedAt pgtype.Timestamp
+}
+
+func (q *Queries) UpsertMaintenanceMode(ctx context.Context, arg UpsertMaintenanceModeParams) (MaintenanceMode, error) {
+	row := q.db.QueryRow(ctx, upsertMaintenanceMode,
+		arg.Enabled,
+		arg.Message,
+		arg.Eta,
+		arg.FreezeAt,
+		arg.UpdatedBy,
+		arg.UpdatedAt,
+	)
+	var i MaintenanceMode
+	err := row.Scan(
+		&i.ID,
+		&i.Enabled,
+		&i.Message,
+		&i.Eta,
+		&i.FreezeAt,
+		&i.UpdatedBy,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,381 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.market.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countMarketListingsBySeller = `-- name: CountMarketListingsBySeller :one
+SELECT COUNT(*) FROM market_listings
+WHERE seller_id = $1
+`
+
+func (q *Queries) CountMarketListingsBySeller(ctx context.Context, sellerID pgtype.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countMarketListingsBySeller, sellerID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countMarketSalesUpTo = `-- name: CountMarketSalesUpTo :one
+SELECT COUNT(*) AS sales,
+       COUNT(*) FILTER (WHERE item_id = $1) AS item_sales
+FROM market_sales
+WHERE world_id = $2
+  AND id <= $3
+`
+
+type CountMarketSalesUpToParams struct {
+	ItemID  int32
+	WorldID pgtype.UUID
+	SaleID  int64
+}
+
+type CountMarketSalesUpToRow struct {
+	Sales     int64
+	ItemSales int64
+}
+
+// Counts a world's sales up to and including a sale, overall and of one item
+func (q *Queries) CountMarketSalesUpTo(ctx context.Context, arg CountMarketSalesUpToParams) (CountMarketSalesUpToRow, error) {
+	row := q.db.QueryRow(ctx, countMarketSalesUpTo, arg.ItemID, arg.WorldID, arg.SaleID)
+	var i CountMarketSalesUpToRow
+	err := row.Scan(&i.Sales, &i.ItemSales)
+	return i, err
+}
+
+const createMarketListing = `-- name: CreateMarketListing :one
+
+INSERT INTO market_listings (world_id, seller_id, item_id, quantity, unit_price, created_at, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, world_id, seller_id, item_id, quantity, unit_price, created_at, expires_at
+`
+
+type CreateMarketListingParams struct {
+	WorldID   pgtype.UUID
+	SellerID  pgtype.UUID
+	ItemID    int32
+	Quantity  int32
+	UnitPrice int32
+	CreatedAt pgtype.Timestamp
+	ExpiresAt pgtype.Timestamp
+}
+
+// Market Operations
+func (q *Queries) CreateMarketListing(ctx context.Context, arg CreateMarketListingParams) (MarketListing, error) {
+	row := q.db.QueryRow(ctx, createMarketListing,
+		arg.WorldID,
+		arg.SellerID,
+		arg.ItemID,
+		arg.Quantity,
+		arg.UnitPrice,
+		arg.CreatedAt,
+		arg.ExpiresAt,
+	)
+	var i MarketListing
+	err := row.Scan(
+		&i.ID,
+		&i.WorldID,
+		&i.SellerID,
+		&i.ItemID,
+		&i.Quantity,
+		&i.UnitPrice,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const createMarketSale = `-- name: CreateMarketSale :one
+INSERT INTO market_sales (world_id, item_id, quantity, unit_price, sold_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id
+`
+
+type CreateMarketSaleParams struct {
+	WorldID   pgtype.UUID
+	ItemID    int32
+	Quantity  int32
+	UnitPrice int32
+	SoldAt    pgtype.Timestamp
+}
+
+func (q *Queries) CreateMarketSale(ctx context.Context, arg CreateMarketSaleParams) (int64, error) {
+	row := q.db.QueryRow(ctx, createMarketSale,
+		arg.WorldID,
+		arg.ItemID,
+		arg.Quantity,
+		arg.UnitPrice,
+		arg.SoldAt,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const deleteMarketListing = `-- name: DeleteMarketListing :exec
+DELETE FROM market_listings
+WHERE id = $1
+`
+
+func (q *Queries) DeleteMarketListing(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, deleteMarketListing, id)
+	return err
+}
+
+const getMarketListingForUpdate = `-- name: GetMarketListingForUpdate :one
+SELECT id, world_id, seller_id, item_id, quantity, unit_price, created_at, expires_at FROM market_listings
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetMarketListingForUpdate(ctx context.Context, id int64) (MarketListing, error) {
+	row := q.db.QueryRow(ctx, getMarketListingForUpdate, id)
+	var i MarketListing
+	err := row.Scan(
+		&i.ID,
+		&i.WorldID,
+		&i.SellerID,
+		&i.ItemID,
+		&i.Quantity,
+		&i.UnitPrice,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const getMarketPriceHistory = `-- name: GetMarketPriceHistory :many
+SELECT
+  date_trunc('day', sold_at)::timestamp AS day,
+  COUNT(*) AS sales,
+  SUM(quantity)::bigint AS volume,
+  MIN(unit_price)::integer AS min_unit_price,
+  MAX(unit_price)::integer AS max_unit_price,
+  (SUM(unit_price::bigint * quantity) / SUM(quantity))::integer AS avg_unit_price
+FROM market_sales
+WHERE world_id = $1 AND item_id = $2 AND sold_at >= $3
+GROUP BY date_trunc('day', sold_at)
+ORDER BY day
+`
+
+type GetMarketPriceHistoryParams struct {
+	WorldID pgtype.UUID
+	ItemID  int32
+	Since   pgtype.Timestamp
+}
+
+type GetMarketPriceHistoryRow struct {
+	Day          pgtype.Timestamp
+	Sales        int64
+	Volume       int64
+	MinUnitPrice int32
+	MaxUnitPrice int32
+	AvgUnitPrice int32
+}
+
+// Daily sales of an item since a time; the average is weighted by quantity
+func (q *Queries) GetMarketPriceHistory(ctx context.Context, arg GetMarketPriceHistoryParams) ([]GetMarketPriceHistoryRow, error) {
+	rows, err := q.db.Query(ctx, getMarketPriceHistory, arg.WorldID, arg.ItemID, arg.Since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetMarketPriceHistoryRow
+	for rows.Next() {
+		var i GetMarketPriceHistoryRow
+		if err := rows.Scan(
+			&i.Day,
+			&i.Sales,
+			&i.Volume,
+			&i.MinUnitPrice,
+			&i.MaxUnitPrice,
+			&i.AvgUnitPrice,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listExpiredMarketListings = `-- name: ListExpiredMarketListings :many
+SELECT id FROM market_listings
+WHERE expires_at <= $1
+ORDER BY expires_at, id
+LIMIT $2
+`
+
+type ListExpiredMarketListingsParams struct {
+	ExpiresAt pgtype.Timestamp
+	Limit     int32
+}
+
+func (q *Queries) ListExpiredMarketListings(ctx context.Context, arg ListExpiredMarketListingsParams) ([]int64, error) {
+	rows, err := q.db.Query(ctx, listExpiredMarketListings, arg.ExpiresAt, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMarketListingsBySeller = `-- name: ListMarketListingsBySeller :many
+SELECT l.id, l.world_id, l.seller_id, l.item_id, l.quantity, l.unit_price, l.created_at, l.expires_at, i.name AS item_name, i.item_type, i.rarity
+FROM market_listings l
+JOIN items i ON i.id = l.item_id
+WHERE l.seller_id = $1
+ORDER BY l.id DESC
+`
+
+type ListMarketListingsBySellerRow struct {
+	ID        int64
+	WorldID   pgtype.UUID
+	SellerID  pgtype.UUID
+	ItemID    int32
+	Quantity  int32
+	UnitPrice int32
+	CreatedAt pgtype.Timestamp
+	ExpiresAt pgtype.Timestamp
+	ItemName  string
+	ItemType  string
+	Rarity    string
+}
+
+// The seller's listings, including expired ones not yet returned, newest first
+func (q *Queries) ListMarketListingsBySeller(ctx context.Context, sellerID pgtype.UUID) ([]ListMarketListingsBySellerRow, error) {
+	rows, err := q.db.Query(ctx, listMarketListingsBySeller, sellerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListMarketListingsBySellerRow
+	for rows.Next() {
+		var i ListMarketListingsBySellerRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorldID,
+			&i.SellerID,
+			&i.ItemID,
+			&i.Quantity,
+			&i.UnitPrice,
+			&i.CreatedAt,
+			&i.ExpiresAt,
+			&i.ItemName,
+			&i.ItemType,
+			&i.Rarity,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchMarketListings = `-- name: SearchMarketListings :many
+SELECT l.id, l.world_id, l.seller_id, l.item_id, l.quantity, l.unit_price, l.created_at, l.expires_at, i.name AS item_name, i.item_type, i.rarity
+FROM market_listings l
+JOIN items i ON i.id = l.item_id
+WHERE l.world_id = $1
+  AND l.expires_at > $2
+  AND (l.unit_price, l.id) > ($3::integer, $4::bigint)
+  AND ($5::integer = 0 OR l.item_id = $5::integer)
+  AND ($6::text = '' OR i.item_type = $6::text)
+  AND ($7::text = '' OR i.name ILIKE $7::text || '%')
+  AND ($8::integer = 0 OR l.unit_price <= $8::integer)
+ORDER BY l.unit_price, l.id
+LIMIT $9
+`
+
+type SearchMarketListingsParams struct {
+	WorldID        pgtype.UUID
+	Now            pgtype.Timestamp
+	AfterUnitPrice int32
+	AfterID        int64
+	ItemID         int32
+	ItemType       string
+	NamePrefix     string
+	MaxUnitPrice   int32
+	MaxListings    int32
+}
+
+type SearchMarketListingsRow struct {
+	ID        int64
+	WorldID   pgtype.UUID
+	SellerID  pgtype.UUID
+	ItemID    int32
+	Quantity  int32
+	UnitPrice int32
+	CreatedAt pgtype.Timestamp
+	ExpiresAt pgtype.Timestamp
+	ItemName  string
+	ItemType  string
+	Rarity    string
+}
+
+// A world's open listings, cheapest first, after the (unit_price, id) cursor. Zero and
+// empty filters match everything.
+func (q *Queries) SearchMarketListings(ctx context.Context, arg SearchMarketListingsParams) ([]SearchMarketListingsRow, error) {
+	rows, err := q.db.Query(ctx, searchMarketListings,
+		arg.WorldID,
+		arg.Now,
+		arg.AfterUnitPrice,
+		arg.AfterID,
+		arg.ItemID,
+		arg.ItemType,
+		arg.NamePrefix,
+		arg.MaxUnitPrice,
+		arg.MaxListings,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchMarketListingsRow
+	for rows.Next() {
+		var i SearchMarketListingsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorldID,
+			&i.SellerID,
+			&i.ItemID,
+			&i.Quantity,
+			&i.UnitPrice,
+			&i.CreatedAt,
+			&i.ExpiresAt,
+			&i.ItemName,
+			&i.ItemType,
+			&i.Rarity,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,160 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.notifications.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countUnreadNotifications = `-- name: CountUnreadNotifications :one
+SELECT COUNT(*) FROM notifications
+WHERE user_id = $1 AND read_at IS NULL
+`
+
+func (q *Queries) CountUnreadNotifications(ctx context.Context, userID pgtype.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countUnreadNotifications, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createNotification = `-- name: CreateNotification :one
+
+INSERT INTO notifications (user_id, type, title, body, data, dedup_key, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (dedup_key) DO NOTHING
+RETURNING id, user_id, type, title, body, data, dedup_key, created_at, read_at
+`
+
+type CreateNotificationParams struct {
+	UserID    pgtype.UUID
+	Type      string
+	Title     string
+	Body      string
+	Data      []byte
+	DedupKey  string
+	CreatedAt pgtype.Timestamp
+}
+
+// Notification Operations
+// Returns no rows when a notification with the same dedup key exists
+func (q *Queries) CreateNotification(ctx context.Context, arg CreateNotificationParams) (Notification, error) {
+	row := q.db.QueryRow(ctx, createNotification,
+		arg.UserID,
+		arg.Type,
+		arg.Title,
+		arg.Body,
+		arg.Data,
+		arg.DedupKey,
+		arg.CreatedAt,
+	)
+	var i Notification
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Type,
+		&i.Title,
+		&i.Body,
+		&i.Data,
+		&i.DedupKey,
+		&i.CreatedAt,
+		&i.ReadAt,
+	)
+	return i, err
+}
+
+const listNotifications = `-- name: ListNotifications :many
+SELECT id, user_id, type, title, body, data, dedup_key, created_at, read_at FROM notifications
+WHERE user_id = $1
+  AND id < $2
+  AND (NOT $3::boolean OR read_at IS NULL)
+ORDER BY id DESC
+LIMIT $4
+`
+
+type ListNotificationsParams struct {
+	UserID           pgtype.UUID
+	BeforeID         int64
+	UnreadOnly       bool
+	MaxNotifications int32
+}
+
+// A user's notifications before a notification ID, newest first
+func (q *Queries) ListNotifications(ctx context.Context, arg ListNotificationsParams) ([]Notification, error) {
+	rows, err := q.db.Query(ctx, listNotifications,
+		arg.UserID,
+		arg.BeforeID,
+		arg.UnreadOnly,
+		arg.MaxNotifications,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Notification
+	for rows.Next() {
+		var i Notification
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Type,
+			&i.Title,
+			&i.Body,
+			&i.Data,
+			&i.DedupKey,
+			&i.CreatedAt,
+			&i.ReadAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markAllNotificationsRead = `-- name: MarkAllNotificationsRead :execrows
+UPDATE notifications
+SET read_at = $2
+WHERE user_id = $1 AND read_at IS NULL
+`
+
+type MarkAllNotificationsReadParams struct {
+	UserID pgtype.UUID
+	ReadAt pgtype.Timestamp
+}
+
+func (q *Queries) MarkAllNotificationsRead(ctx context.Context, arg MarkAllNotificationsReadParams) (int64, error) {
+	result, err := q.db.Exec(ctx, markAllNotificationsRead, arg.UserID, arg.ReadAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const markNotificationsRead = `-- name: MarkNotificationsRead :execrows
+UPDATE notifications
+SET read_at = $1
+WHERE user_id = $2 AND id = ANY($3::bigint[]) AND read_at IS NULL
+`
+
+type MarkNotificationsReadParams struct {
+	ReadAt pgtype.Timestamp
+	UserID pgtype.UUID
+	Ids    []int64
+}
+
+func (q *Queries) MarkNotificationsRead(ctx context.Context, arg MarkNotificationsReadParams) (int64, error) {
+	result, err := q.db.Exec(ctx, markNotificationsRead, arg.ReadAt, arg.UserID, arg.Ids)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,203 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.outbox.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const claimOutboxEvents = `-- name: ClaimOutboxEvents :many
+UPDATE outbox_events
+SET locked_until = $1::timestamp
+WHERE id IN (
+    SELECT id FROM outbox_events
+    WHERE published_at IS NULL
+    AND (locked_until IS NULL OR locked_until <= $2::timestamp)
+    ORDER BY id
+    LIMIT $3
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, event_type, aggregate_id, payload, dedup_key, created_at, locked_until, attempts, last_error, published_at
+`
+
+type ClaimOutboxEventsParams struct {
+	LockedUntil pgtype.Timestamp
+	Now         pgtype.Timestamp
+	BatchSize   int32
+}
+
+// Lease a batch of unpublished events; SKIP LOCKED lets several dispatchers run side by side
+func (q *Queries) ClaimOutboxEvents(ctx context.Context, arg ClaimOutboxEventsParams) ([]OutboxEvent, error) {
+	rows, err := q.db.Query(ctx, claimOutboxEvents, arg.LockedUntil, arg.Now, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []OutboxEvent
+	for rows.Next() {
+		var i OutboxEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventType,
+			&i.AggregateID,
+			&i.Payload,
+			&i.DedupKey,
+			&i.CreatedAt,
+			&i.LockedUntil,
+			&i.Attempts,
+			&i.LastError,
+			&i.PublishedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deletePublishedOutboxEvents = `-- name: DeletePublishedOutboxEvents :execrows
+DELETE FROM outbox_events
+WHERE published_at IS NOT NULL AND published_at < $1
+`
+
+func (q *Queries) DeletePublishedOutboxEvents(ctx context.Context, publishedAt pgtype.Timestamp) (int64, error) {
+	result, err := q.db.Exec(ctx, deletePublishedOutboxEvents, publishedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getLatestOutboxEventID = `-- name: GetLatestOutboxEventID :one
+SELECT COALESCE(MAX(id), 0)::bigint FROM outbox_events
+`
+
+func (q *Queries) GetLatestOutboxEventID(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, getLatestOutboxEventID)
+	var column_1 int64
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const insertOutboxEvent = `-- name: InsertOutboxEvent :exec
+INSERT INTO outbox_events (event_type, aggregate_id, payload, dedup_key)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (dedup_key) DO NOTHING
+`
+
+type InsertOutboxEventParams struct {
+	EventType   string
+	AggregateID string
+	Payload     []byte
+	DedupKey    string
+}
+
+// Duplicate dedup keys are ignored so retried mutations don't enqueue twice
+func (q *Queries) InsertOutboxEvent(ctx context.Context, arg InsertOutboxEventParams) error {
+	_, err := q.db.Exec(ctx, insertOutboxEvent,
+		arg.EventType,
+		arg.AggregateID,
+		arg.Payload,
+		arg.DedupKey,
+	)
+	return err
+}
+
+const listOutboxEventsAfter = `-- name: ListOutboxEventsAfter :many
+SELECT id, event_type, aggregate_id, payload, dedup_key, created_at, locked_until, attempts, last_error, published_at FROM outbox_events
+WHERE id > $1 AND event_type = ANY($2::text[])
+ORDER BY id
+LIMIT $3
+`
+
+type ListOutboxEventsAfterParams struct {
+	AfterID    int64
+	EventTypes []string
+	MaxEvents  int32
+}
+
+// Events of the given types enqueued after a sequence number, for clients catching up
+func (q *Queries) ListOutboxEventsAfter(ctx context.Context, arg ListOutboxEventsAfterParams) ([]OutboxEvent, error) {
+	rows, err := q.db.Query(ctx, listOutboxEventsAfter, arg.AfterID, arg.EventTypes, arg.MaxEvents)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []OutboxEvent
+	for rows.Next() {
+		var i OutboxEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventType,
+			&i.AggregateID,
+			&i.Payload,
+			&i.DedupKey,
+			&i.CreatedAt,
+			&i.LockedUntil,
+			&i.Attempts,
+			&i.LastError,
+			&i.PublishedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markOutboxEventFailed = `-- name: MarkOutboxEventFailed :exec
+UPDATE outbox_events
+SET attempts = attempts + 1, last_error = $2, locked_until = $3
+WHERE id = $1
+`
+
+type MarkOutboxEventFailedParams struct {
+	ID          int64
+	LastError   pgtype.Text
+	LockedUntil pgtype.Timestamp
+}
+
+// Keep the lease until the backoff expires so the event is retried later
+func (q *Queries) MarkOutboxEventFailed(ctx context.Context, arg MarkOutboxEventFailedParams) error {
+	_, err := q.db.Exec(ctx, markOutboxEventFailed, arg.ID, arg.LastError, arg.LockedUntil)
+	return err
+}
+
+const markOutboxEventPublished = `-- name: MarkOutboxEventPublished :exec
+UPDATE outbox_events
+SET published_at = $2, locked_until = NULL
+WHERE id = $1
+`
+
+type MarkOutboxEventPublishedParams struct {
+	ID          int64
+	PublishedAt pgtype.Timestamp
+}
+
+func (q *Queries) MarkOutboxEventPublished(ctx context.Context, arg MarkOutboxEventPublishedParams) error {
+	_, err := q.db.Exec(ctx, markOutboxEventPublished, arg.ID, arg.PublishedAt)
+	return err
+}
+
+const outboxEventExists = `-- name: OutboxEventExists :one
+SELECT EXISTS (SELECT 1 FROM outbox_events WHERE id = $1)
+`
+
+// Published events are deleted after the retention period
+func (q *Queries) OutboxEventExists(ctx context.Context, id int64) (bool, error) {
+	row := q.db.QueryRow(ctx, outboxEventExists, id)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
@@ -0,0 +1,163 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.player_reports.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createPlayerReport = `-- name: CreatePlayerReport :one
+
+INSERT INTO player_reports (reporter_id, reported_id, reason, details, context, created_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, reporter_id, reported_id, reason, details, context, status, resolution, resolved_by, created_at, resolved_at
+`
+
+type CreatePlayerReportParams struct {
+	ReporterID pgtype.UUID
+	ReportedID pgtype.UUID
+	Reason     string
+	Details    string
+	Context    []byte
+	CreatedAt  pgtype.Timestamp
+}
+
+// Player Report Operations
+func (q *Queries) CreatePlayerReport(ctx context.Context, arg CreatePlayerReportParams) (PlayerReport, error) {
+	row := q.db.QueryRow(ctx, createPlayerReport,
+		arg.ReporterID,
+		arg.ReportedID,
+		arg.Reason,
+		arg.Details,
+		arg.Context,
+		arg.CreatedAt,
+	)
+	var i PlayerReport
+	err := row.Scan(
+		&i.ID,
+		&i.ReporterID,
+		&i.ReportedID,
+		&i.Reason,
+		&i.Details,
+		&i.Context,
+		&i.Status,
+		&i.Resolution,
+		&i.ResolvedBy,
+		&i.CreatedAt,
+		&i.ResolvedAt,
+	)
+	return i, err
+}
+
+const getPlayerReport = `-- name: GetPlayerReport :one
+SELECT id, reporter_id, reported_id, reason, details, context, status, resolution, resolved_by, created_at, resolved_at FROM player_reports
+WHERE id = $1
+`
+
+func (q *Queries) GetPlayerReport(ctx context.Context, id int64) (PlayerReport, error) {
+	row := q.db.QueryRow(ctx, getPlayerReport, id)
+	var i PlayerReport
+	err := row.Scan(
+		&i.ID,
+		&i.ReporterID,
+		&i.ReportedID,
+		&i.Reason,
+		&i.Details,
+		&i.Context,
+		&i.Status,
+		&i.Resolution,
+		&i.ResolvedBy,
+		&i.CreatedAt,
+		&i.ResolvedAt,
+	)
+	return i, err
+}
+
+const listPlayerReports = `-- name: ListPlayerReports :many
+SELECT id, reporter_id, reported_id, reason, details, context, status, resolution, resolved_by, created_at, resolved_at FROM player_reports
+WHERE status = $1 AND id > $2
+ORDER BY id
+LIMIT $3
+`
+
+type ListPlayerReportsParams struct {
+	Status     string
+	AfterID    int64
+	MaxReports int32
+}
+
+// Reports with the given status after a report ID, oldest first
+func (q *Queries) ListPlayerReports(ctx context.Context, arg ListPlayerReportsParams) ([]PlayerReport, error) {
+	rows, err := q.db.Query(ctx, listPlayerReports, arg.Status, arg.AfterID, arg.MaxReports)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PlayerReport
+	for rows.Next() {
+		var i PlayerReport
+		if err := rows.Scan(
+			&i.ID,
+			&i.ReporterID,
+			&i.ReportedID,
+			&i.Reason,
+			&i.Details,
+			&i.Context,
+			&i.Status,
+			&i.Resolution,
+			&i.ResolvedBy,
+			&i.CreatedAt,
+			&i.ResolvedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const resolvePlayerReport = `-- name: ResolvePlayerReport :one
+UPDATE player_reports
+SET status = 'resolved', resolution = $2, resolved_by = $3, resolved_at = $4
+WHERE id = $1 AND status = 'open'
+RETURNING id, reporter_id, reported_id, reason, details, context, status, resolution, resolved_by, created_at, resolved_at
+`
+
+type ResolvePlayerReportParams struct {
+	ID         int64
+	Resolution string
+	ResolvedBy pgtype.UUID
+	ResolvedAt pgtype.Timestamp
+}
+
+func (q *Queries) ResolvePlayerReport(ctx context.Context, arg ResolvePlayerReportParams) (PlayerReport, error) {
+	row := q.db.QueryRow(ctx, resolvePlayerReport,
+		arg.ID,
+		arg.Resolution,
+		arg.ResolvedBy,
+		arg.ResolvedAt,
+	)
+	var i PlayerReport
+	err := row.Scan(
+		&i.ID,
+		&i.ReporterID,
+		&i.ReportedID,
+		&i.Reason,
+		&i.Details,
+		&i.Context,
+		&i.Status,
+		&i.Resolution,
+		&i.ResolvedBy,
+		&i.CreatedAt,
+		&i.ResolvedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,202 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.processing_jobs.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countProcessingJobsByCharacter = `-- name: CountProcessingJobsByCharacter :one
+
+SELECT COUNT(*) FROM processing_jobs
+WHERE character_id = $1
+`
+
+// Processing Job Operations
+func (q *Queries) CountProcessingJobsByCharacter(ctx context.Context, characterID pgtype.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countProcessingJobsByCharacter, characterID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createProcessingJob = `-- name: CreateProcessingJob :one
+INSERT INTO processing_jobs (character_id, world_id, recipe_id, station_entity_id, outputs, started_at, ready_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, character_id, world_id, recipe_id, station_entity_id, outputs, started_at, ready_at, notified_at
+`
+
+type CreateProcessingJobParams struct {
+	CharacterID     pgtype.UUID
+	WorldID         pgtype.UUID
+	RecipeID        string
+	StationEntityID int64
+	Outputs         []byte
+	StartedAt       pgtype.Timestamp
+	ReadyAt         pgtype.Timestamp
+}
+
+func (q *Queries) CreateProcessingJob(ctx context.Context, arg CreateProcessingJobParams) (ProcessingJob, error) {
+	row := q.db.QueryRow(ctx, createProcessingJob,
+		arg.CharacterID,
+		arg.WorldID,
+		arg.RecipeID,
+		arg.StationEntityID,
+		arg.Outputs,
+		arg.StartedAt,
+		arg.ReadyAt,
+	)
+	var i ProcessingJob
+	err := row.Scan(
+		&i.ID,
+		&i.CharacterID,
+		&i.WorldID,
+		&i.RecipeID,
+		&i.StationEntityID,
+		&i.Outputs,
+		&i.StartedAt,
+		&i.ReadyAt,
+		&i.NotifiedAt,
+	)
+	return i, err
+}
+
+const deleteProcessingJob = `-- name: DeleteProcessingJob :exec
+DELETE FROM processing_jobs
+WHERE id = $1
+`
+
+func (q *Queries) DeleteProcessingJob(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, deleteProcessingJob, id)
+	return err
+}
+
+const getProcessingJobForUpdate = `-- name: GetProcessingJobForUpdate :one
+SELECT id, character_id, world_id, recipe_id, station_entity_id, outputs, started_at, ready_at, notified_at FROM processing_jobs
+WHERE id = $1 AND character_id = $2
+FOR UPDATE
+`
+
+type GetProcessingJobForUpdateParams struct {
+	ID          int64
+	CharacterID pgtype.UUID
+}
+
+func (q *Queries) GetProcessingJobForUpdate(ctx context.Context, arg GetProcessingJobForUpdateParams) (ProcessingJob, error) {
+	row := q.db.QueryRow(ctx, getProcessingJobForUpdate, arg.ID, arg.CharacterID)
+	var i ProcessingJob
+	err := row.Scan(
+		&i.ID,
+		&i.CharacterID,
+		&i.WorldID,
+		&i.RecipeID,
+		&i.StationEntityID,
+		&i.Outputs,
+		&i.StartedAt,
+		&i.ReadyAt,
+		&i.NotifiedAt,
+	)
+	return i, err
+}
+
+const listProcessingJobsByCharacter = `-- name: ListProcessingJobsByCharacter :many
+SELECT id, character_id, world_id, recipe_id, station_entity_id, outputs, started_at, ready_at, notified_at FROM processing_jobs
+WHERE character_id = $1
+ORDER BY ready_at, id
+`
+
+func (q *Queries) ListProcessingJobsByCharacter(ctx context.Context, characterID pgtype.UUID) ([]ProcessingJob, error) {
+	rows, err := q.db.Query(ctx, listProcessingJobsByCharacter, characterID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ProcessingJob
+	for rows.Next() {
+		var i ProcessingJob
+		if err := rows.Scan(
+			&i.ID,
+			&i.CharacterID,
+			&i.WorldID,
+			&i.RecipeID,
+			&i.StationEntityID,
+			&i.Outputs,
+			&i.StartedAt,
+			&i.ReadyAt,
+			&i.NotifiedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUnnotifiedReadyProcessingJobs = `-- name: ListUnnotifiedReadyProcessingJobs :many
+SELECT id, character_id, world_id, recipe_id, station_entity_id, outputs, started_at, ready_at, notified_at FROM processing_jobs
+WHERE notified_at IS NULL AND ready_at <= $1
+ORDER BY ready_at, id
+LIMIT $2
+`
+
+type ListUnnotifiedReadyProcessingJobsParams struct {
+	ReadyAt pgtype.Timestamp
+	Limit   int32
+}
+
+func (q *Queries) ListUnnotifiedReadyProcessingJobs(ctx context.Context, arg ListUnnotifiedReadyProcessingJobsParams) ([]ProcessingJob, error) {
+	rows, err := q.db.Query(ctx, listUnnotifiedReadyProcessingJobs, arg.ReadyAt, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ProcessingJob
+	for rows.Next() {
+		var i ProcessingJob
+		if err := rows.Scan(
+			&i.ID,
+			&i.CharacterID,
+			&i.WorldID,
+			&i.RecipeID,
+			&i.StationEntityID,
+			&i.Outputs,
+			&i.StartedAt,
+			&i.ReadyAt,
+			&i.NotifiedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markProcessingJobNotified = `-- name: MarkProcessingJobNotified :execrows
+UPDATE processing_jobs
+SET notified_at = $2
+WHERE id = $1 AND notified_at IS NULL
+`
+
+type MarkProcessingJobNotifiedParams struct {
+	ID         int64
+	NotifiedAt pgtype.Timestamp
+}
+
+func (q *Queries) MarkProcessingJobNotified(ctx context.Context, arg MarkProcessingJobNotifiedParams) (int64, error) {
+	result, err := q.db.Exec(ctx, markProcessingJobNotified, arg.ID, arg.NotifiedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
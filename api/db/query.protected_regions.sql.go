@@ -0,0 +1,234 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.protected_regions.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createProtectedRegion = `-- name: CreateProtectedRegion :one
+
+INSERT INTO protected_regions (world_id, name, flags, polygon, min_x, min_y, max_x, max_y, created_by, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $10)
+RETURNING id, world_id, name, flags, polygon, min_x, min_y, max_x, max_y, created_by, created_at, updated_at
+`
+
+type CreateProtectedRegionParams struct {
+	WorldID   pgtype.UUID
+	Name      string
+	Flags     []string
+	Polygon   []int32
+	MinX      int32
+	MinY      int32
+	MaxX      int32
+	MaxY      int32
+	CreatedBy pgtype.UUID
+	CreatedAt pgtype.Timestamp
+}
+
+// Protected Region Operations
+func (q *Queries) CreateProtectedRegion(ctx context.Context, arg CreateProtectedRegionParams) (ProtectedRegion, error) {
+	row := q.db.QueryRow(ctx, createProtectedRegion,
+		arg.WorldID,
+		arg.Name,
+		arg.Flags,
+		arg.Polygon,
+		arg.MinX,
+		arg.MinY,
+		arg.MaxX,
+		arg.MaxY,
+		arg.CreatedBy,
+		arg.CreatedAt,
+	)
+	var i ProtectedRegion
+	err := row.Scan(
+		&i.ID,
+		&i.WorldID,
+		&i.Name,
+		&i.Flags,
+		&i.Polygon,
+		&i.MinX,
+		&i.MinY,
+		&i.MaxX,
+		&i.MaxY,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteProtectedRegion = `-- name: DeleteProtectedRegion :one
+DELETE FROM protected_regions
+WHERE id = $1
+RETURNING id, world_id, name, flags, polygon, min_x, min_y, max_x, max_y, created_by, created_at, updated_at
+`
+
+func (q *Queries) DeleteProtectedRegion(ctx context.Context, id int64) (ProtectedRegion, error) {
+	row := q.db.QueryRow(ctx, deleteProtectedRegion, id)
+	var i ProtectedRegion
+	err := row.Scan(
+		&i.ID,
+		&i.WorldID,
+		&i.Name,
+		&i.Flags,
+		&i.Polygon,
+		&i.MinX,
+		&i.MinY,
+		&i.MaxX,
+		&i.MaxY,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listProtectedRegions = `-- name: ListProtectedRegions :many
+SELECT id, world_id, name, flags, polygon, min_x, min_y, max_x, max_y, created_by, created_at, updated_at FROM protected_regions
+WHERE world_id = $1
+ORDER BY id
+`
+
+func (q *Queries) ListProtectedRegions(ctx context.Context, worldID pgtype.UUID) ([]ProtectedRegion, error) {
+	rows, err := q.db.Query(ctx, listProtectedRegions, worldID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ProtectedRegion
+	for rows.Next() {
+		var i ProtectedRegion
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorldID,
+			&i.Name,
+			&i.Flags,
+			&i.Polygon,
+			&i.MinX,
+			&i.MinY,
+			&i.MaxX,
+			&i.MaxY,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProtectedRegionsInBounds = `-- name: ListProtectedRegionsInBounds :many
+SELECT id, world_id, name, flags, polygon, min_x, min_y, max_x, max_y, created_by, created_at, updated_at FROM protected_regions
+WHERE world_id = $1
+  AND min_x < $2::integer AND max_x > $3::integer
+  AND min_y < $4::integer AND max_y > $5::integer
+ORDER BY id
+`
+
+type ListProtectedRegionsInBoundsParams struct {
+	WorldID pgtype.UUID
+	MaxX    int32
+	MinX    int32
+	MaxY    int32
+	MinY    int32
+}
+
+// Regions whose bounding box overlaps the half-open cell rectangle [min_x, max_x) x [min_y, max_y)
+func (q *Queries) ListProtectedRegionsInBounds(ctx context.Context, arg ListProtectedRegionsInBoundsParams) ([]ProtectedRegion, error) {
+	rows, err := q.db.Query(ctx, listProtectedRegionsInBounds,
+		arg.WorldID,
+		arg.MaxX,
+		arg.MinX,
+		arg.MaxY,
+		arg.MinY,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ProtectedRegion
+	for rows.Next() {
+		var i ProtectedRegion
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorldID,
+			&i.Name,
+			&i.Flags,
+			&i.Polygon,
+			&i.MinX,
+			&i.MinY,
+			&i.MaxX,
+			&i.MaxY,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateProtectedRegion = `-- name: UpdateProtectedRegion :one
+UPDATE protected_regions
+SET name = $2, flags = $3, polygon = $4, min_x = $5, min_y = $6, max_x = $7, max_y = $8, updated_at = $9
+WHERE id = $1
+RETURNING id, world_id, name, flags, polygon, min_x, min_y, max_x, max_y, created_by, created_at, updated_at
+`
+
+type UpdateProtectedRegionParams struct {
+	ID        int64
+	Name      string
+	Flags     []string
+	Polygon   []int32
+	MinX      int32
+	MinY      int32
+	MaxX      int32
+	MaxY      int32
+	UpdatedAt pgtype.Timestamp
+}
+
+func (q *Queries) UpdateProtectedRegion(ctx context.Context, arg UpdateProtectedRegionParams) (ProtectedRegion, error) {
+	row := q.db.QueryRow(ctx, updateProtectedRegion,
+		arg.ID,
+		arg.Name,
+		arg.Flags,
+		arg.Polygon,
+		arg.MinX,
+		arg.MinY,
+		arg.MaxX,
+		arg.MaxY,
+		arg.UpdatedAt,
+	)
+	var i ProtectedRegion
+	err := row.Scan(
+		&i.ID,
+		&i.WorldID,
+		&i.Name,
+		&i.Flags,
+		&i.Polygon,
+		&i.MinX,
+		&i.MinY,
+		&i.MaxX,
+		&i.MaxY,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
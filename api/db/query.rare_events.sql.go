@@ -0,0 +1,369 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.rare_events.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const addRareEventContribution = `-- name: AddRareEventContribution :one
+INSERT INTO rare_event_contributions (rare_event_id, character_id, amount, last_contributed_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (rare_event_id, character_id) DO UPDATE
+SET amount = rare_event_contributions.amount + EXCLUDED.amount,
+    last_contributed_at = EXCLUDED.last_contributed_at
+WHERE rare_event_contributions.last_contributed_at <= $5::timestamp
+RETURNING amount
+`
+
+type AddRareEventContributionParams struct {
+	RareEventID      int64
+	CharacterID      pgtype.UUID
+	Amount           int32
+	Now              pgtype.Timestamp
+	CooledDownBefore pgtype.Timestamp
+}
+
+// Returns no rows when the character contributed less than the cooldown ago
+func (q *Queries) AddRareEventContribution(ctx context.Context, arg AddRareEventContributionParams) (int32, error) {
+	row := q.db.QueryRow(ctx, addRareEventContribution,
+		arg.RareEventID,
+		arg.CharacterID,
+		arg.Amount,
+		arg.Now,
+		arg.CooledDownBefore,
+	)
+	var amount int32
+	err := row.Scan(&amount)
+	return amount, err
+}
+
+const advanceRareEvent = `-- name: AdvanceRareEvent :one
+UPDATE rare_events
+SET progress = LEAST(progress + $1::integer, required),
+    ended_at = CASE WHEN progress + $1::integer >= required THEN $2::timestamp END,
+    outcome = CASE WHEN progress + $1::integer >= required THEN 'completed' END
+WHERE id = $3
+  AND ended_at IS NULL
+  AND expires_at > $2::timestamp
+RETURNING id, world_id, kind, chunk_x, chunk_y, x, y, progress, required, spawned_at, expires_at, ended_at, outcome, rewarded_at
+`
+
+type AdvanceRareEventParams struct {
+	Amount int32
+	Now    pgtype.Timestamp
+	ID     int64
+}
+
+// Adds progress to an event in progress, completing it when progress reaches required.
+// Returns no rows when the event has ended or expired.
+func (q *Queries) AdvanceRareEvent(ctx context.Context, arg AdvanceRareEventParams) (RareEvent, error) {
+	row := q.db.QueryRow(ctx, advanceRareEvent, arg.Amount, arg.Now, arg.ID)
+	var i RareEvent
+	err := row.Scan(
+		&i.ID,
+		&i.WorldID,
+		&i.Kind,
+		&i.ChunkX,
+		&i.ChunkY,
+		&i.X,
+		&i.Y,
+		&i.Progress,
+		&i.Required,
+		&i.SpawnedAt,
+		&i.ExpiresAt,
+		&i.EndedAt,
+		&i.Outcome,
+		&i.RewardedAt,
+	)
+	return i, err
+}
+
+const countActiveRareEvents = `-- name: CountActiveRareEvents :one
+SELECT COUNT(*) FROM rare_events
+WHERE world_id = $1 AND ended_at IS NULL
+`
+
+func (q *Queries) CountActiveRareEvents(ctx context.Context, worldID pgtype.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countActiveRareEvents, worldID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countRareEventsSpawnedSince = `-- name: CountRareEventsSpawnedSince :one
+SELECT COUNT(*) FROM rare_events
+WHERE world_id = $1 AND spawned_at > $2
+`
+
+type CountRareEventsSpawnedSinceParams struct {
+	WorldID pgtype.UUID
+	Since   pgtype.Timestamp
+}
+
+func (q *Queries) CountRareEventsSpawnedSince(ctx context.Context, arg CountRareEventsSpawnedSinceParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countRareEventsSpawnedSince, arg.WorldID, arg.Since)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createRareEvent = `-- name: CreateRareEvent :one
+
+INSERT INTO rare_events (world_id, kind, chunk_x, chunk_y, x, y, required, spawned_at, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING id, world_id, kind, chunk_x, chunk_y, x, y, progress, required, spawned_at, expires_at, ended_at, outcome, rewarded_at
+`
+
+type CreateRareEventParams struct {
+	WorldID   pgtype.UUID
+	Kind      string
+	ChunkX    int32
+	ChunkY    int32
+	X         int32
+	Y         int32
+	Required  int32
+	SpawnedAt pgtype.Timestamp
+	ExpiresAt pgtype.Timestamp
+}
+
+// Rare Event Operations
+func (q *Queries) CreateRareEvent(ctx context.Context, arg CreateRareEventParams) (RareEvent, error) {
+	row := q.db.QueryRow(ctx, createRareEvent,
+		arg.WorldID,
+		arg.Kind,
+		arg.ChunkX,
+		arg.ChunkY,
+		arg.X,
+		arg.Y,
+		arg.Required,
+		arg.SpawnedAt,
+		arg.ExpiresAt,
+	)
+	var i RareEvent
+	err := row.Scan(
+		&i.ID,
+		&i.WorldID,
+		&i.Kind,
+		&i.ChunkX,
+		&i.ChunkY,
+		&i.X,
+		&i.Y,
+		&i.Progress,
+		&i.Required,
+		&i.SpawnedAt,
+		&i.ExpiresAt,
+		&i.EndedAt,
+		&i.Outcome,
+		&i.RewardedAt,
+	)
+	return i, err
+}
+
+const expireRareEvents = `-- name: ExpireRareEvents :many
+UPDATE rare_events
+SET ended_at = expires_at, outcome = 'expired'
+WHERE ended_at IS NULL AND expires_at <= $1
+RETURNING id, world_id, kind, chunk_x, chunk_y, x, y, progress, required, spawned_at, expires_at, ended_at, outcome, rewarded_at
+`
+
+func (q *Queries) ExpireRareEvents(ctx context.Context, expiresAt pgtype.Timestamp) ([]RareEvent, error) {
+	rows, err := q.db.Query(ctx, expireRareEvents, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RareEvent
+	for rows.Next() {
+		var i RareEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorldID,
+			&i.Kind,
+			&i.ChunkX,
+			&i.ChunkY,
+			&i.X,
+			&i.Y,
+			&i.Progress,
+			&i.Required,
+			&i.SpawnedAt,
+			&i.ExpiresAt,
+			&i.EndedAt,
+			&i.Outcome,
+			&i.RewardedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRareEvent = `-- name: GetRareEvent :one
+SELECT id, world_id, kind, chunk_x, chunk_y, x, y, progress, required, spawned_at, expires_at, ended_at, outcome, rewarded_at FROM rare_events
+WHERE id = $1
+`
+
+func (q *Queries) GetRareEvent(ctx context.Context, id int64) (RareEvent, error) {
+	row := q.db.QueryRow(ctx, getRareEvent, id)
+	var i RareEvent
+	err := row.Scan(
+		&i.ID,
+		&i.WorldID,
+		&i.Kind,
+		&i.ChunkX,
+		&i.ChunkY,
+		&i.X,
+		&i.Y,
+		&i.Progress,
+		&i.Required,
+		&i.SpawnedAt,
+		&i.ExpiresAt,
+		&i.EndedAt,
+		&i.Outcome,
+		&i.RewardedAt,
+	)
+	return i, err
+}
+
+const listActiveRareEvents = `-- name: ListActiveRareEvents :many
+SELECT id, world_id, kind, chunk_x, chunk_y, x, y, progress, required, spawned_at, expires_at, ended_at, outcome, rewarded_at FROM rare_events
+WHERE world_id = $1 AND ended_at IS NULL
+ORDER BY id
+`
+
+func (q *Queries) ListActiveRareEvents(ctx context.Context, worldID pgtype.UUID) ([]RareEvent, error) {
+	rows, err := q.db.Query(ctx, listActiveRareEvents, worldID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RareEvent
+	for rows.Next() {
+		var i RareEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorldID,
+			&i.Kind,
+			&i.ChunkX,
+			&i.ChunkY,
+			&i.X,
+			&i.Y,
+			&i.Progress,
+			&i.Required,
+			&i.SpawnedAt,
+			&i.ExpiresAt,
+			&i.EndedAt,
+			&i.Outcome,
+			&i.RewardedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRareEventContributions = `-- name: ListRareEventContributions :many
+SELECT rare_event_id, character_id, amount, last_contributed_at FROM rare_event_contributions
+WHERE rare_event_id = $1
+ORDER BY amount DESC, character_id
+`
+
+func (q *Queries) ListRareEventContributions(ctx context.Context, rareEventID int64) ([]RareEventContribution, error) {
+	rows, err := q.db.Query(ctx, listRareEventContributions, rareEventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RareEventContribution
+	for rows.Next() {
+		var i RareEventContribution
+		if err := rows.Scan(
+			&i.RareEventID,
+			&i.CharacterID,
+			&i.Amount,
+			&i.LastContributedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUnrewardedRareEvents = `-- name: ListUnrewardedRareEvents :many
+SELECT id, world_id, kind, chunk_x, chunk_y, x, y, progress, required, spawned_at, expires_at, ended_at, outcome, rewarded_at FROM rare_events
+WHERE outcome = 'completed' AND rewarded_at IS NULL
+ORDER BY id
+LIMIT $1
+`
+
+func (q *Queries) ListUnrewardedRareEvents(ctx context.Context, limit int32) ([]RareEvent, error) {
+	rows, err := q.db.Query(ctx, listUnrewardedRareEvents, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RareEvent
+	for rows.Next() {
+		var i RareEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorldID,
+			&i.Kind,
+			&i.ChunkX,
+			&i.ChunkY,
+			&i.X,
+			&i.Y,
+			&i.Progress,
+			&i.Required,
+			&i.SpawnedAt,
+			&i.ExpiresAt,
+			&i.EndedAt,
+			&i.Outcome,
+			&i.RewardedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markRareEventRewarded = `-- name: MarkRareEventRewarded :execrows
+UPDATE rare_events
+SET rewarded_at = $2
+WHERE id = $1 AND rewarded_at IS NULL
+`
+
+type MarkRareEventRewardedParams struct {
+	ID         int64
+	RewardedAt pgtype.Timestamp
+}
+
+// Affects no rows when another instance already sent the rewards
+func (q *Queries) MarkRareEventRewarded(ctx context.Context, arg MarkRareEventRewardedParams) (int64, error) {
+	result, err := q.db.Exec(ctx, markRareEventRewarded, arg.ID, arg.RewardedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,76 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.region_maturity.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const listRegionMaturity = `-- name: ListRegionMaturity :many
+
+SELECT world_id, region_x, region_y, density, harvest_count, updated_at FROM region_maturity
+WHERE world_id = $1
+`
+
+// Region Maturity Operations
+func (q *Queries) ListRegionMaturity(ctx context.Context, worldID pgtype.UUID) ([]RegionMaturity, error) {
+	rows, err := q.db.Query(ctx, listRegionMaturity, worldID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RegionMaturity
+	for rows.Next() {
+		var i RegionMaturity
+		if err := rows.Scan(
+			&i.WorldID,
+			&i.RegionX,
+			&i.RegionY,
+			&i.Density,
+			&i.HarvestCount,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertRegionMaturity = `-- name: UpsertRegionMaturity :exec
+INSERT INTO region_maturity (world_id, region_x, region_y, density, harvest_count, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (world_id, region_x, region_y) DO UPDATE
+SET density = EXCLUDED.density,
+    harvest_count = EXCLUDED.harvest_count,
+    updated_at = EXCLUDED.updated_at
+`
+
+type UpsertRegionMaturityParams struct {
+	WorldID      pgtype.UUID
+	RegionX      int32
+	RegionY      int32
+	Density      float64
+	HarvestCount int64
+	UpdatedAt    pgtype.Timestamp
+}
+
+func (q *Queries) UpsertRegionMaturity(ctx context.Context, arg UpsertRegionMaturityParams) error {
+	_, err := q.db.Exec(ctx, upsertRegionMaturity,
+		arg.WorldID,
+		arg.RegionX,
+		arg.RegionY,
+		arg.Density,
+		arg.HarvestCount,
+		arg.UpdatedAt,
+	)
+	return err
+}
@@ -0,0 +1,274 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.region_recordings.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countRegionRecordingEvents = `-- name: CountRegionRecordingEvents :one
+SELECT COUNT(*) FROM region_recording_events
+WHERE recording_id = $1
+`
+
+func (q *Queries) CountRegionRecordingEvents(ctx context.Context, recordingID int64) (int64, error) {
+	row := q.db.QueryRow(ctx, countRegionRecordingEvents, recordingID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createRegionRecording = `-- name: CreateRegionRecording :one
+
+INSERT INTO region_recordings (world_id, min_chunk_x, min_chunk_y, max_chunk_x, max_chunk_y, started_by, started_at, ends_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id, world_id, min_chunk_x, min_chunk_y, max_chunk_x, max_chunk_y, started_by, started_at, ends_at, stopped_at
+`
+
+type CreateRegionRecordingParams struct {
+	WorldID   pgtype.UUID
+	MinChunkX int32
+	MinChunkY int32
+	MaxChunkX int32
+	MaxChunkY int32
+	StartedBy pgtype.UUID
+	StartedAt pgtype.Timestamp
+	EndsAt    pgtype.Timestamp
+}
+
+// Region Recording Operations
+func (q *Queries) CreateRegionRecording(ctx context.Context, arg CreateRegionRecordingParams) (RegionRecording, error) {
+	row := q.db.QueryRow(ctx, createRegionRecording,
+		arg.WorldID,
+		arg.MinChunkX,
+		arg.MinChunkY,
+		arg.MaxChunkX,
+		arg.MaxChunkY,
+		arg.StartedBy,
+		arg.StartedAt,
+		arg.EndsAt,
+	)
+	var i RegionRecording
+	err := row.Scan(
+		&i.ID,
+		&i.WorldID,
+		&i.MinChunkX,
+		&i.MinChunkY,
+		&i.MaxChunkX,
+		&i.MaxChunkY,
+		&i.StartedBy,
+		&i.StartedAt,
+		&i.EndsAt,
+		&i.StoppedAt,
+	)
+	return i, err
+}
+
+const createRegionRecordingEvent = `-- name: CreateRegionRecordingEvent :exec
+INSERT INTO region_recording_events (recording_id, event_type, character_id, x, y, chunk_x, chunk_y, payload, occurred_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+`
+
+type CreateRegionRecordingEventParams struct {
+	RecordingID int64
+	EventType   string
+	CharacterID pgtype.UUID
+	X           int32
+	Y           int32
+	ChunkX      int32
+	ChunkY      int32
+	Payload     []byte
+	OccurredAt  pgtype.Timestamp
+}
+
+func (q *Queries) CreateRegionRecordingEvent(ctx context.Context, arg CreateRegionRecordingEventParams) error {
+	_, err := q.db.Exec(ctx, createRegionRecordingEvent,
+		arg.RecordingID,
+		arg.EventType,
+		arg.CharacterID,
+		arg.X,
+		arg.Y,
+		arg.ChunkX,
+		arg.ChunkY,
+		arg.Payload,
+		arg.OccurredAt,
+	)
+	return err
+}
+
+const getRegionRecording = `-- name: GetRegionRecording :one
+SELECT id, world_id, min_chunk_x, min_chunk_y, max_chunk_x, max_chunk_y, started_by, started_at, ends_at, stopped_at FROM region_recordings
+WHERE id = $1
+`
+
+func (q *Queries) GetRegionRecording(ctx context.Context, id int64) (RegionRecording, error) {
+	row := q.db.QueryRow(ctx, getRegionRecording, id)
+	var i RegionRecording
+	err := row.Scan(
+		&i.ID,
+		&i.WorldID,
+		&i.MinChunkX,
+		&i.MinChunkY,
+		&i.MaxChunkX,
+		&i.MaxChunkY,
+		&i.StartedBy,
+		&i.StartedAt,
+		&i.EndsAt,
+		&i.StoppedAt,
+	)
+	return i, err
+}
+
+const listActiveRegionRecordings = `-- name: ListActiveRegionRecordings :many
+SELECT id, world_id, min_chunk_x, min_chunk_y, max_chunk_x, max_chunk_y, started_by, started_at, ends_at, stopped_at FROM region_recordings
+WHERE stopped_at IS NULL AND ends_at > $1
+ORDER BY id
+`
+
+// Recordings that have not been stopped or run out at the given time
+func (q *Queries) ListActiveRegionRecordings(ctx context.Context, endsAt pgtype.Timestamp) ([]RegionRecording, error) {
+	rows, err := q.db.Query(ctx, listActiveRegionRecordings, endsAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RegionRecording
+	for rows.Next() {
+		var i RegionRecording
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorldID,
+			&i.MinChunkX,
+			&i.MinChunkY,
+			&i.MaxChunkX,
+			&i.MaxChunkY,
+			&i.StartedBy,
+			&i.StartedAt,
+			&i.EndsAt,
+			&i.StoppedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRegionRecordingEvents = `-- name: ListRegionRecordingEvents :many
+SELECT id, recording_id, event_type, character_id, x, y, chunk_x, chunk_y, payload, occurred_at FROM region_recording_events
+WHERE recording_id = $1
+ORDER BY id
+LIMIT $2
+`
+
+type ListRegionRecordingEventsParams struct {
+	RecordingID int64
+	Limit       int32
+}
+
+// The first events of a recording in the order they were recorded
+func (q *Queries) ListRegionRecordingEvents(ctx context.Context, arg ListRegionRecordingEventsParams) ([]RegionRecordingEvent, error) {
+	rows, err := q.db.Query(ctx, listRegionRecordingEvents, arg.RecordingID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RegionRecordingEvent
+	for rows.Next() {
+		var i RegionRecordingEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.RecordingID,
+			&i.EventType,
+			&i.CharacterID,
+			&i.X,
+			&i.Y,
+			&i.ChunkX,
+			&i.ChunkY,
+			&i.Payload,
+			&i.OccurredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRegionRecordings = `-- name: ListRegionRecordings :many
+SELECT id, world_id, min_chunk_x, min_chunk_y, max_chunk_x, max_chunk_y, started_by, started_at, ends_at, stopped_at FROM region_recordings
+ORDER BY id DESC
+LIMIT $1
+`
+
+func (q *Queries) ListRegionRecordings(ctx context.Context, limit int32) ([]RegionRecording, error) {
+	rows, err := q.db.Query(ctx, listRegionRecordings, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RegionRecording
+	for rows.Next() {
+		var i RegionRecording
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorldID,
+			&i.MinChunkX,
+			&i.MinChunkY,
+			&i.MaxChunkX,
+			&i.MaxChunkY,
+			&i.StartedBy,
+			&i.StartedAt,
+			&i.EndsAt,
+			&i.StoppedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const stopRegionRecording = `-- name: StopRegionRecording :one
+UPDATE region_recordings
+SET stopped_at = $2
+WHERE id = $1 AND stopped_at IS NULL AND ends_at > $2
+RETURNING id, world_id, min_chunk_x, min_chunk_y, max_chunk_x, max_chunk_y, started_by, started_at, ends_at, stopped_at
+`
+
+type StopRegionRecordingParams struct {
+	ID        int64
+	StoppedAt pgtype.Timestamp
+}
+
+func (q *Queries) StopRegionRecording(ctx context.Context, arg StopRegionRecordingParams) (RegionRecording, error) {
+	row := q.db.QueryRow(ctx, stopRegionRecording, arg.ID, arg.StoppedAt)
+	var i RegionRecording
+	err := row.Scan(
+		&i.ID,
+		&i.WorldID,
+		&i.MinChunkX,
+		&i.MinChunkY,
+		&i.MaxChunkX,
+		&i.MaxChunkY,
+		&i.StartedBy,
+		&i.StartedAt,
+		&i.EndsAt,
+		&i.StoppedAt,
+	)
+	return i, err
+}
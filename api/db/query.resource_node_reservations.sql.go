@@ -0,0 +1,67 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.resource_node_reservations.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const acquireResourceNodeReservation = `-- name: AcquireResourceNodeReservation :one
+
+INSERT INTO resource_node_reservations (resource_node_id, character_id, reserved_at, expires_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (resource_node_id) DO UPDATE
+SET character_id = EXCLUDED.character_id,
+    reserved_at = EXCLUDED.reserved_at,
+    expires_at = EXCLUDED.expires_at
+WHERE resource_node_reservations.expires_at <= EXCLUDED.reserved_at
+   OR resource_node_reservations.character_id = EXCLUDED.character_id
+RETURNING resource_node_id, character_id, reserved_at, expires_at
+`
+
+type AcquireResourceNodeReservationParams struct {
+	ResourceNodeID int32
+	CharacterID    pgtype.UUID
+	Now            pgtype.Timestamp
+	ExpiresAt      pgtype.Timestamp
+}
+
+// Resource Node Reservation Operations
+// Returns no rows when another character holds an unexpired reservation.
+// The conflicting row is locked by ON CONFLICT, so concurrent callers serialize here.
+func (q *Queries) AcquireResourceNodeReservation(ctx context.Context, arg AcquireResourceNodeReservationParams) (ResourceNodeReservation, error) {
+	row := q.db.QueryRow(ctx, acquireResourceNodeReservation,
+		arg.ResourceNodeID,
+		arg.CharacterID,
+		arg.Now,
+		arg.ExpiresAt,
+	)
+	var i ResourceNodeReservation
+	err := row.Scan(
+		&i.ResourceNodeID,
+		&i.CharacterID,
+		&i.ReservedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const releaseResourceNodeReservation = `-- name: ReleaseResourceNodeReservation :exec
+DELETE FROM resource_node_reservations
+WHERE resource_node_id = $1 AND character_id = $2
+`
+
+type ReleaseResourceNodeReservationParams struct {
+	ResourceNodeID int32
+	CharacterID    pgtype.UUID
+}
+
+func (q *Queries) ReleaseResourceNodeReservation(ctx context.Context, arg ReleaseResourceNodeReservationParams) error {
+	_, err := q.db.Exec(ctx, releaseResourceNodeReservation, arg.ResourceNodeID, arg.CharacterID)
+	return err
+}
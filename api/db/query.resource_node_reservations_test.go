@@ -0,0 +1,92 @@
+package db
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcquireResourceNodeReservation(t *testing.T) {
+	characterID := mustParseUUID("550e8400-e29b-41d4-a716-446655440001")
+	now := pgtype.Timestamp{Time: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), Valid: true}
+	expiresAt := pgtype.Timestamp{Time: now.Time.Add(5 * time.Second), Valid: true}
+	params := AcquireResourceNodeReservationParams{
+		ResourceNodeID: 42,
+		CharacterID:    characterID,
+		Now:            now,
+		ExpiresAt:      expiresAt,
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(mock pgxmock.PgxPoolIface)
+		wantErr   error
+	}{
+		{
+			name: "reservation acquired",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{"resource_node_id", "character_id", "reserved_at", "expires_at"}).
+					AddRow(int32(42), characterID, now, expiresAt)
+				mock.ExpectQuery("INSERT INTO resource_node_reservations").
+					WithArgs(int32(42), characterID, now, expiresAt).
+					WillReturnRows(rows)
+			},
+		},
+		{
+			name: "held by another character",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery("INSERT INTO resource_node_reservations").
+					WithArgs(int32(42), characterID, now, expiresAt).
+					WillReturnRows(pgxmock.NewRows([]string{"resource_node_id", "character_id", "reserved_at", "expires_at"}))
+			},
+			wantErr: pgx.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPool, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockPool.Close()
+
+			queries := New(mockPool)
+			tt.setupMock(mockPool)
+
+			reservation, err := queries.AcquireResourceNodeReservation(createTestContext(), params)
+
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr))
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, int32(42), reservation.ResourceNodeID)
+				assert.Equal(t, expiresAt, reservation.ExpiresAt)
+			}
+
+			assert.NoError(t, mockPool.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestReleaseResourceNodeReservation(t *testing.T) {
+	mockPool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockPool.Close()
+
+	characterID := mustParseUUID("550e8400-e29b-41d4-a716-446655440001")
+	mockPool.ExpectExec("DELETE FROM resource_node_reservations").
+		WithArgs(int32(42), characterID).
+		WillReturnResult(pgxmock.NewResult("DELETE", 1))
+
+	err = New(mockPool).ReleaseResourceNodeReservation(createTestContext(), ReleaseResourceNodeReservationParams{
+		ResourceNodeID: 42,
+		CharacterID:    characterID,
+	})
+	require.NoError(t, err)
+	assert.NoError(t, mockPool.ExpectationsWereMet())
+}
@@ -11,6 +11,18 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type CopyResourceNodesParams struct {
+	ResourceNodeTypeID int32
+	WorldID            pgtype.UUID
+	ChunkX             int32
+	ChunkY             int32
+	ClusterID          string
+	X                  int32
+	Y                  int32
+	Size               int32
+	Quality            int32
+}
+
 const countResourceNodesByType = `-- name: CountResourceNodesByType :one
 SELECT COUNT(*) FROM resource_nodes
 WHERE world_id = $1 AND chunk_x = $2 AND chunk_y = $3 AND resource_node_type_id = $4
@@ -63,10 +75,11 @@ INSERT INTO resource_nodes (
   cluster_id,
   x,
   y,
-  size
+  size,
+  quality
 )
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
-RETURNING id, resource_node_type_id, world_id, chunk_x, chunk_y, cluster_id, x, y, size, created_at
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING id, resource_node_type_id, world_id, chunk_x, chunk_y, cluster_id, x, y, size, quality, created_at
 `
 
 type CreateResourceNodeParams struct {
@@ -78,6 +91,7 @@ type CreateResourceNodeParams struct {
 	X                  int32
 	Y                  int32
 	Size               int32
+	Quality            int32
 }
 
 // Resource Node Operations
@@ -91,6 +105,7 @@ func (q *Queries) CreateResourceNode(ctx context.Context, arg CreateResourceNode
 		arg.X,
 		arg.Y,
 		arg.Size,
+		arg.Quality,
 	)
 	var i ResourceNode
 	err := row.Scan(
@@ -103,6 +118,7 @@ func (q *Queries) CreateResourceNode(ctx context.Context, arg CreateResourceNode
 		&i.X,
 		&i.Y,
 		&i.Size,
+		&i.Quality,
 		&i.CreatedAt,
 	)
 	return i, err
@@ -126,7 +142,7 @@ func (q *Queries) DeleteResourceNodesInChunk(ctx context.Context, arg DeleteReso
 
 const getResourceNode = `-- name: GetResourceNode :one
 SELECT
-  rn.id, rn.resource_node_type_id, rn.world_id, rn.chunk_x, rn.chunk_y, rn.cluster_id, rn.x, rn.y, rn.size, rn.created_at
+  rn.id, rn.resource_node_type_id, rn.world_id, rn.chunk_x, rn.chunk_y, rn.cluster_id, rn.x, rn.y, rn.size, rn.quality, rn.created_at
 FROM resource_nodes rn
 WHERE rn.id = $1
 `
@@ -144,6 +160,7 @@ func (q *Queries) GetResourceNode(ctx context.Context, id int32) (ResourceNode,
 		&i.X,
 		&i.Y,
 		&i.Size,
+		&i.Quality,
 		&i.CreatedAt,
 	)
 	return i, err
@@ -151,7 +168,7 @@ func (q *Queries) GetResourceNode(ctx context.Context, id int32) (ResourceNode,
 
 const getResourceNodesInChunk = `-- name: GetResourceNodesInChunk :many
 SELECT
-  rn.id, rn.resource_node_type_id, rn.world_id, rn.chunk_x, rn.chunk_y, rn.cluster_id, rn.x, rn.y, rn.size, rn.created_at
+  rn.id, rn.resource_node_type_id, rn.world_id, rn.chunk_x, rn.chunk_y, rn.cluster_id, rn.x, rn.y, rn.size, rn.quality, rn.created_at
 FROM resource_nodes rn
 WHERE rn.world_id = $1 AND rn.chunk_x = $2 AND rn.chunk_y = $3
 `
@@ -181,6 +198,7 @@ func (q *Queries) GetResourceNodesInChunk(ctx context.Context, arg GetResourceNo
 			&i.X,
 			&i.Y,
 			&i.Size,
+			&i.Quality,
 			&i.CreatedAt,
 		); err != nil {
 			return nil, err
@@ -195,7 +213,7 @@ func (q *Queries) GetResourceNodesInChunk(ctx context.Context, arg GetResourceNo
 
 const getResourceNodesInChunkRange = `-- name: GetResourceNodesInChunkRange :many
 SELECT
-  rn.id, rn.resource_node_type_id, rn.world_id, rn.chunk_x, rn.chunk_y, rn.cluster_id, rn.x, rn.y, rn.size, rn.created_at
+  rn.id, rn.resource_node_type_id, rn.world_id, rn.chunk_x, rn.chunk_y, rn.cluster_id, rn.x, rn.y, rn.size, rn.quality, rn.created_at
 FROM resource_nodes rn
 WHERE rn.world_id = $1 AND
       rn.chunk_x >= $2 AND rn.chunk_x <= $3 AND
@@ -235,6 +253,7 @@ func (q *Queries) GetResourceNodesInChunkRange(ctx context.Context, arg GetResou
 			&i.X,
 			&i.Y,
 			&i.Size,
+			&i.Quality,
 			&i.CreatedAt,
 		); err != nil {
 			return nil, err
@@ -249,7 +268,7 @@ func (q *Queries) GetResourceNodesInChunkRange(ctx context.Context, arg GetResou
 
 const getResourceNodesInChunks = `-- name: GetResourceNodesInChunks :many
 SELECT
-  rn.id, rn.resource_node_type_id, rn.world_id, rn.chunk_x, rn.chunk_y, rn.cluster_id, rn.x, rn.y, rn.size, rn.created_at
+  rn.id, rn.resource_node_type_id, rn.world_id, rn.chunk_x, rn.chunk_y, rn.cluster_id, rn.x, rn.y, rn.size, rn.quality, rn.created_at
 FROM resource_nodes rn
 WHERE rn.world_id = $1 AND (
       (rn.chunk_x = $2 AND rn.chunk_y = $3) OR
@@ -305,6 +324,7 @@ func (q *Queries) GetResourceNodesInChunks(ctx context.Context, arg GetResourceN
 			&i.X,
 			&i.Y,
 			&i.Size,
+			&i.Quality,
 			&i.CreatedAt,
 		); err != nil {
 			return nil, err
@@ -319,7 +339,7 @@ func (q *Queries) GetResourceNodesInChunks(ctx context.Context, arg GetResourceN
 
 const getResourceNodesInCluster = `-- name: GetResourceNodesInCluster :many
 SELECT
-  rn.id, rn.resource_node_type_id, rn.world_id, rn.chunk_x, rn.chunk_y, rn.cluster_id, rn.x, rn.y, rn.size, rn.created_at
+  rn.id, rn.resource_node_type_id, rn.world_id, rn.chunk_x, rn.chunk_y, rn.cluster_id, rn.x, rn.y, rn.size, rn.quality, rn.created_at
 FROM resource_nodes rn
 WHERE rn.cluster_id = $1
 `
@@ -343,6 +363,7 @@ func (q *Queries) GetResourceNodesInCluster(ctx context.Context, clusterID strin
 			&i.X,
 			&i.Y,
 			&i.Size,
+			&i.Quality,
 			&i.CreatedAt,
 		); err != nil {
 			return nil, err
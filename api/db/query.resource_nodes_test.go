@@ -1,6 +1,7 @@
 package db
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"strings"
@@ -32,16 +33,17 @@ func TestCreateResourceNode(t *testing.T) {
 				X:               512,
 				Y:               768,
 				Size:               3,
+				Quality:            2,
 			},
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				now := time.Now()
 				rows := pgxmock.NewRows([]string{
-					"id", "resource_node_type_id", "world_id", "chunk_x", "chunk_y", "cluster_id", "x", "y", "size", "created_at",
+					"id", "resource_node_type_id", "world_id", "chunk_x", "chunk_y", "cluster_id", "x", "y", "size", "quality", "created_at",
 				}).AddRow(
-					int32(1), int32(1), "550e8400-e29b-41d4-a716-446655440000", int32(10), int32(20), "cluster_wood_001", int32(512), int32(768), int32(3), pgtype.Timestamp{Time: now, Valid: true},
+					int32(1), int32(1), "550e8400-e29b-41d4-a716-446655440000", int32(10), int32(20), "cluster_wood_001", int32(512), int32(768), int32(3), int32(2), pgtype.Timestamp{Time: now, Valid: true},
 				)
 				mock.ExpectQuery("INSERT INTO resource_nodes").
-					WithArgs(int32(1), mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(10), int32(20), "cluster_wood_001", int32(512), int32(768), int32(3)).
+					WithArgs(int32(1), mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(10), int32(20), "cluster_wood_001", int32(512), int32(768), int32(3), int32(2)).
 					WillReturnRows(rows)
 			},
 			wantErr: false,
@@ -54,6 +56,7 @@ func TestCreateResourceNode(t *testing.T) {
 				assert.Equal(t, int32(512), node.X)
 				assert.Equal(t, int32(768), node.Y)
 				assert.Equal(t, int32(3), node.Size)
+				assert.Equal(t, int32(2), node.Quality)
 				assert.True(t, node.CreatedAt.Valid)
 			},
 		},
@@ -72,12 +75,12 @@ func TestCreateResourceNode(t *testing.T) {
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				now := time.Now()
 				rows := pgxmock.NewRows([]string{
-					"id", "resource_node_type_id", "world_id", "chunk_x", "chunk_y", "cluster_id", "x", "y", "size", "created_at",
+					"id", "resource_node_type_id", "world_id", "chunk_x", "chunk_y", "cluster_id", "x", "y", "size", "quality", "created_at",
 				}).AddRow(
-					int32(2), int32(2), "550e8400-e29b-41d4-a716-446655440000", int32(0), int32(0), "cluster_stone_001", int32(0), int32(0), int32(1), pgtype.Timestamp{Time: now, Valid: true},
+					int32(2), int32(2), "550e8400-e29b-41d4-a716-446655440000", int32(0), int32(0), "cluster_stone_001", int32(0), int32(0), int32(1), int32(2), pgtype.Timestamp{Time: now, Valid: true},
 				)
 				mock.ExpectQuery("INSERT INTO resource_nodes").
-					WithArgs(int32(2), mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(0), int32(0), "cluster_stone_001", int32(0), int32(0), int32(1)).
+					WithArgs(int32(2), mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(0), int32(0), "cluster_stone_001", int32(0), int32(0), int32(1), int32(0)).
 					WillReturnRows(rows)
 			},
 			wantErr: false,
@@ -103,12 +106,12 @@ func TestCreateResourceNode(t *testing.T) {
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				now := time.Now()
 				rows := pgxmock.NewRows([]string{
-					"id", "resource_node_type_id", "world_id", "chunk_x", "chunk_y", "cluster_id", "x", "y", "size", "created_at",
+					"id", "resource_node_type_id", "world_id", "chunk_x", "chunk_y", "cluster_id", "x", "y", "size", "quality", "created_at",
 				}).AddRow(
-					int32(3), int32(3), "550e8400-e29b-41d4-a716-446655440000", int32(5), int32(5), "cluster_iron_mega", int32(256), int32(384), int32(50), pgtype.Timestamp{Time: now, Valid: true},
+					int32(3), int32(3), "550e8400-e29b-41d4-a716-446655440000", int32(5), int32(5), "cluster_iron_mega", int32(256), int32(384), int32(50), int32(2), pgtype.Timestamp{Time: now, Valid: true},
 				)
 				mock.ExpectQuery("INSERT INTO resource_nodes").
-					WithArgs(int32(3), mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(5), int32(5), "cluster_iron_mega", int32(256), int32(384), int32(50)).
+					WithArgs(int32(3), mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(5), int32(5), "cluster_iron_mega", int32(256), int32(384), int32(50), int32(0)).
 					WillReturnRows(rows)
 			},
 			wantErr: false,
@@ -133,12 +136,12 @@ func TestCreateResourceNode(t *testing.T) {
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				now := time.Now()
 				rows := pgxmock.NewRows([]string{
-					"id", "resource_node_type_id", "world_id", "chunk_x", "chunk_y", "cluster_id", "x", "y", "size", "created_at",
+					"id", "resource_node_type_id", "world_id", "chunk_x", "chunk_y", "cluster_id", "x", "y", "size", "quality", "created_at",
 				}).AddRow(
-					int32(4), int32(1), "550e8400-e29b-41d4-a716-446655440000", int32(-5), int32(-10), "cluster_neg_coords", int32(-100), int32(-200), int32(2), pgtype.Timestamp{Time: now, Valid: true},
+					int32(4), int32(1), "550e8400-e29b-41d4-a716-446655440000", int32(-5), int32(-10), "cluster_neg_coords", int32(-100), int32(-200), int32(2), int32(2), pgtype.Timestamp{Time: now, Valid: true},
 				)
 				mock.ExpectQuery("INSERT INTO resource_nodes").
-					WithArgs(int32(1), mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(-5), int32(-10), "cluster_neg_coords", int32(-100), int32(-200), int32(2)).
+					WithArgs(int32(1), mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(-5), int32(-10), "cluster_neg_coords", int32(-100), int32(-200), int32(2), int32(0)).
 					WillReturnRows(rows)
 			},
 			wantErr: false,
@@ -163,7 +166,7 @@ func TestCreateResourceNode(t *testing.T) {
 			},
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				mock.ExpectQuery("INSERT INTO resource_nodes").
-					WithArgs(int32(1), mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(1), int32(1), "cluster_duplicate", int32(100), int32(100), int32(1)).
+					WithArgs(int32(1), mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(1), int32(1), "cluster_duplicate", int32(100), int32(100), int32(1), int32(0)).
 					WillReturnError(sql.ErrConnDone) // Simulate unique constraint violation
 			},
 			wantErr: true,
@@ -182,7 +185,7 @@ func TestCreateResourceNode(t *testing.T) {
 			},
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				mock.ExpectQuery("INSERT INTO resource_nodes").
-					WithArgs(int32(1), mustParseUUID("999e8400-e29b-41d4-a716-446655440000"), int32(1), int32(1), "cluster_invalid_world", int32(50), int32(50), int32(1)).
+					WithArgs(int32(1), mustParseUUID("999e8400-e29b-41d4-a716-446655440000"), int32(1), int32(1), "cluster_invalid_world", int32(50), int32(50), int32(1), int32(0)).
 					WillReturnError(sql.ErrConnDone) // Simulate foreign key constraint violation
 			},
 			wantErr: true,
@@ -201,7 +204,7 @@ func TestCreateResourceNode(t *testing.T) {
 			},
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				mock.ExpectQuery("INSERT INTO resource_nodes").
-					WithArgs(int32(1), mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(999), int32(999), "cluster_invalid_chunk", int32(50), int32(50), int32(1)).
+					WithArgs(int32(1), mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(999), int32(999), "cluster_invalid_chunk", int32(50), int32(50), int32(1), int32(0)).
 					WillReturnError(sql.ErrConnDone) // Simulate foreign key constraint violation
 			},
 			wantErr: true,
@@ -231,6 +234,25 @@ func TestCreateResourceNode(t *testing.T) {
 	}
 }
 
+func TestCopyResourceNodes(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	worldID := mustParseUUID("550e8400-e29b-41d4-a716-446655440000")
+	nodes := []CopyResourceNodesParams{
+		{ResourceNodeTypeID: 1, WorldID: worldID, ChunkX: 1, ChunkY: 2, ClusterID: "cluster_wood_001", X: 32, Y: 64, Size: 2, Quality: 3},
+		{ResourceNodeTypeID: 2, WorldID: worldID, ChunkX: 1, ChunkY: 2, ClusterID: "cluster_stone_001", X: 33, Y: 64, Size: 1, Quality: 1},
+	}
+	mock.ExpectCopyFrom([]string{"resource_nodes"}, []string{"resource_node_type_id", "world_id", "chunk_x", "chunk_y", "cluster_id", "x", "y", "size", "quality"}).
+		WillReturnResult(2)
+
+	n, err := New(mock).CopyResourceNodes(context.Background(), nodes)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), n)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestGetResourceNode(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -245,9 +267,9 @@ func TestGetResourceNode(t *testing.T) {
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				now := time.Now()
 				rows := pgxmock.NewRows([]string{
-					"id", "resource_node_type_id", "world_id", "chunk_x", "chunk_y", "cluster_id", "x", "y", "size", "created_at",
+					"id", "resource_node_type_id", "world_id", "chunk_x", "chunk_y", "cluster_id", "x", "y", "size", "quality", "created_at",
 				}).AddRow(
-					int32(1), int32(1), "550e8400-e29b-41d4-a716-446655440000", int32(10), int32(20), "cluster_wood_001", int32(512), int32(768), int32(3), pgtype.Timestamp{Time: now, Valid: true},
+					int32(1), int32(1), "550e8400-e29b-41d4-a716-446655440000", int32(10), int32(20), "cluster_wood_001", int32(512), int32(768), int32(3), int32(2), pgtype.Timestamp{Time: now, Valid: true},
 				)
 				mock.ExpectQuery("SELECT (.+) FROM resource_nodes rn WHERE rn.id = \\$1").
 					WithArgs(int32(1)).
@@ -317,16 +339,16 @@ func TestGetResourceNodesInChunk(t *testing.T) {
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				now := time.Now()
 				rows := pgxmock.NewRows([]string{
-					"id", "resource_node_type_id", "world_id", "chunk_x", "chunk_y", "cluster_id", "x", "y", "size", "created_at",
+					"id", "resource_node_type_id", "world_id", "chunk_x", "chunk_y", "cluster_id", "x", "y", "size", "quality", "created_at",
 				}).
 					AddRow(
-						int32(1), int32(1), "550e8400-e29b-41d4-a716-446655440000", int32(10), int32(20), "cluster_wood_001", int32(100), int32(200), int32(2), pgtype.Timestamp{Time: now, Valid: true},
+						int32(1), int32(1), "550e8400-e29b-41d4-a716-446655440000", int32(10), int32(20), "cluster_wood_001", int32(100), int32(200), int32(2), int32(2), pgtype.Timestamp{Time: now, Valid: true},
 					).
 					AddRow(
-						int32(2), int32(2), "550e8400-e29b-41d4-a716-446655440000", int32(10), int32(20), "cluster_stone_001", int32(300), int32(400), int32(1), pgtype.Timestamp{Time: now, Valid: true},
+						int32(2), int32(2), "550e8400-e29b-41d4-a716-446655440000", int32(10), int32(20), "cluster_stone_001", int32(300), int32(400), int32(1), int32(2), pgtype.Timestamp{Time: now, Valid: true},
 					).
 					AddRow(
-						int32(3), int32(1), "550e8400-e29b-41d4-a716-446655440000", int32(10), int32(20), "cluster_wood_001", int32(500), int32(600), int32(3), pgtype.Timestamp{Time: now, Valid: true},
+						int32(3), int32(1), "550e8400-e29b-41d4-a716-446655440000", int32(10), int32(20), "cluster_wood_001", int32(500), int32(600), int32(3), int32(2), pgtype.Timestamp{Time: now, Valid: true},
 					)
 				mock.ExpectQuery("SELECT (.+) FROM resource_nodes rn WHERE rn.world_id = \\$1 AND rn.chunk_x = \\$2 AND rn.chunk_y = \\$3").
 					WithArgs(mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(10), int32(20)).
@@ -358,7 +380,7 @@ func TestGetResourceNodesInChunk(t *testing.T) {
 			},
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				rows := pgxmock.NewRows([]string{
-					"id", "resource_node_type_id", "world_id", "chunk_x", "chunk_y", "cluster_id", "x", "y", "size", "created_at",
+					"id", "resource_node_type_id", "world_id", "chunk_x", "chunk_y", "cluster_id", "x", "y", "size", "quality", "created_at",
 				})
 				mock.ExpectQuery("SELECT (.+) FROM resource_nodes rn WHERE rn.world_id = \\$1 AND rn.chunk_x = \\$2 AND rn.chunk_y = \\$3").
 					WithArgs(mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(999), int32(999)).
@@ -379,9 +401,9 @@ func TestGetResourceNodesInChunk(t *testing.T) {
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				now := time.Now()
 				rows := pgxmock.NewRows([]string{
-					"id", "resource_node_type_id", "world_id", "chunk_x", "chunk_y", "cluster_id", "x", "y", "size", "created_at",
+					"id", "resource_node_type_id", "world_id", "chunk_x", "chunk_y", "cluster_id", "x", "y", "size", "quality", "created_at",
 				}).AddRow(
-					int32(10), int32(3), "550e8400-e29b-41d4-a716-446655440000", int32(5), int32(5), "cluster_iron_001", int32(250), int32(250), int32(5), pgtype.Timestamp{Time: now, Valid: true},
+					int32(10), int32(3), "550e8400-e29b-41d4-a716-446655440000", int32(5), int32(5), "cluster_iron_001", int32(250), int32(250), int32(5), int32(2), pgtype.Timestamp{Time: now, Valid: true},
 				)
 				mock.ExpectQuery("SELECT (.+) FROM resource_nodes rn WHERE rn.world_id = \\$1 AND rn.chunk_x = \\$2 AND rn.chunk_y = \\$3").
 					WithArgs(mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(5), int32(5)).
@@ -440,19 +462,19 @@ func TestGetResourceNodesInChunkRange(t *testing.T) {
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				now := time.Now()
 				rows := pgxmock.NewRows([]string{
-					"id", "resource_node_type_id", "world_id", "chunk_x", "chunk_y", "cluster_id", "x", "y", "size", "created_at",
+					"id", "resource_node_type_id", "world_id", "chunk_x", "chunk_y", "cluster_id", "x", "y", "size", "quality", "created_at",
 				}).
 					AddRow(
-						int32(1), int32(1), "550e8400-e29b-41d4-a716-446655440000", int32(0), int32(0), "cluster_1", int32(50), int32(50), int32(1), pgtype.Timestamp{Time: now, Valid: true},
+						int32(1), int32(1), "550e8400-e29b-41d4-a716-446655440000", int32(0), int32(0), "cluster_1", int32(50), int32(50), int32(1), int32(2), pgtype.Timestamp{Time: now, Valid: true},
 					).
 					AddRow(
-						int32(2), int32(2), "550e8400-e29b-41d4-a716-446655440000", int32(0), int32(1), "cluster_2", int32(100), int32(150), int32(2), pgtype.Timestamp{Time: now, Valid: true},
+						int32(2), int32(2), "550e8400-e29b-41d4-a716-446655440000", int32(0), int32(1), "cluster_2", int32(100), int32(150), int32(2), int32(2), pgtype.Timestamp{Time: now, Valid: true},
 					).
 					AddRow(
-						int32(3), int32(1), "550e8400-e29b-41d4-a716-446655440000", int32(1), int32(0), "cluster_3", int32(200), int32(250), int32(1), pgtype.Timestamp{Time: now, Valid: true},
+						int32(3), int32(1), "550e8400-e29b-41d4-a716-446655440000", int32(1), int32(0), "cluster_3", int32(200), int32(250), int32(1), int32(2), pgtype.Timestamp{Time: now, Valid: true},
 					).
 					AddRow(
-						int32(4), int32(3), "550e8400-e29b-41d4-a716-446655440000", int32(1), int32(1), "cluster_4", int32(300), int32(350), int32(3), pgtype.Timestamp{Time: now, Valid: true},
+						int32(4), int32(3), "550e8400-e29b-41d4-a716-446655440000", int32(1), int32(1), "cluster_4", int32(300), int32(350), int32(3), int32(2), pgtype.Timestamp{Time: now, Valid: true},
 					)
 				mock.ExpectQuery("SELECT (.+) FROM resource_nodes rn WHERE rn.world_id = \\$1 AND rn.chunk_x >= \\$2 AND rn.chunk_x <= \\$3 AND rn.chunk_y >= \\$4 AND rn.chunk_y <= \\$5").
 					WithArgs(mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(0), int32(1), int32(0), int32(1)).
@@ -491,7 +513,7 @@ func TestGetResourceNodesInChunkRange(t *testing.T) {
 			},
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				rows := pgxmock.NewRows([]string{
-					"id", "resource_node_type_id", "world_id", "chunk_x", "chunk_y", "cluster_id", "x", "y", "size", "created_at",
+					"id", "resource_node_type_id", "world_id", "chunk_x", "chunk_y", "cluster_id", "x", "y", "size", "quality", "created_at",
 				})
 				mock.ExpectQuery("SELECT (.+) FROM resource_nodes rn WHERE rn.world_id = \\$1 AND rn.chunk_x >= \\$2 AND rn.chunk_x <= \\$3 AND rn.chunk_y >= \\$4 AND rn.chunk_y <= \\$5").
 					WithArgs(mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(100), int32(102), int32(100), int32(102)).
@@ -541,16 +563,16 @@ func TestGetResourceNodesInCluster(t *testing.T) {
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				now := time.Now()
 				rows := pgxmock.NewRows([]string{
-					"id", "resource_node_type_id", "world_id", "chunk_x", "chunk_y", "cluster_id", "x", "y", "size", "created_at",
+					"id", "resource_node_type_id", "world_id", "chunk_x", "chunk_y", "cluster_id", "x", "y", "size", "quality", "created_at",
 				}).
 					AddRow(
-						int32(1), int32(1), "550e8400-e29b-41d4-a716-446655440000", int32(10), int32(20), "cluster_wood_001", int32(100), int32(100), int32(2), pgtype.Timestamp{Time: now, Valid: true},
+						int32(1), int32(1), "550e8400-e29b-41d4-a716-446655440000", int32(10), int32(20), "cluster_wood_001", int32(100), int32(100), int32(2), int32(2), pgtype.Timestamp{Time: now, Valid: true},
 					).
 					AddRow(
-						int32(2), int32(1), "550e8400-e29b-41d4-a716-446655440000", int32(10), int32(20), "cluster_wood_001", int32(150), int32(150), int32(1), pgtype.Timestamp{Time: now, Valid: true},
+						int32(2), int32(1), "550e8400-e29b-41d4-a716-446655440000", int32(10), int32(20), "cluster_wood_001", int32(150), int32(150), int32(1), int32(2), pgtype.Timestamp{Time: now, Valid: true},
 					).
 					AddRow(
-						int32(3), int32(1), "550e8400-e29b-41d4-a716-446655440000", int32(10), int32(20), "cluster_wood_001", int32(200), int32(200), int32(3), pgtype.Timestamp{Time: now, Valid: true},
+						int32(3), int32(1), "550e8400-e29b-41d4-a716-446655440000", int32(10), int32(20), "cluster_wood_001", int32(200), int32(200), int32(3), int32(2), pgtype.Timestamp{Time: now, Valid: true},
 					)
 				mock.ExpectQuery("SELECT (.+) FROM resource_nodes rn WHERE rn.cluster_id = \\$1").
 					WithArgs("cluster_wood_001").
@@ -578,7 +600,7 @@ func TestGetResourceNodesInCluster(t *testing.T) {
 			clusterID: "non_existent_cluster",
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				rows := pgxmock.NewRows([]string{
-					"id", "resource_node_type_id", "world_id", "chunk_x", "chunk_y", "cluster_id", "x", "y", "size", "created_at",
+					"id", "resource_node_type_id", "world_id", "chunk_x", "chunk_y", "cluster_id", "x", "y", "size", "quality", "created_at",
 				})
 				mock.ExpectQuery("SELECT (.+) FROM resource_nodes rn WHERE rn.cluster_id = \\$1").
 					WithArgs("non_existent_cluster").
@@ -595,9 +617,9 @@ func TestGetResourceNodesInCluster(t *testing.T) {
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				now := time.Now()
 				rows := pgxmock.NewRows([]string{
-					"id", "resource_node_type_id", "world_id", "chunk_x", "chunk_y", "cluster_id", "x", "y", "size", "created_at",
+					"id", "resource_node_type_id", "world_id", "chunk_x", "chunk_y", "cluster_id", "x", "y", "size", "quality", "created_at",
 				}).AddRow(
-					int32(10), int32(3), "550e8400-e29b-41d4-a716-446655440000", int32(5), int32(5), "cluster_iron_solo", int32(256), int32(256), int32(10), pgtype.Timestamp{Time: now, Valid: true},
+					int32(10), int32(3), "550e8400-e29b-41d4-a716-446655440000", int32(5), int32(5), "cluster_iron_solo", int32(256), int32(256), int32(10), int32(2), pgtype.Timestamp{Time: now, Valid: true},
 				)
 				mock.ExpectQuery("SELECT (.+) FROM resource_nodes rn WHERE rn.cluster_id = \\$1").
 					WithArgs("cluster_iron_solo").
@@ -945,13 +967,13 @@ func TestResourceNodeBusinessLogic(t *testing.T) {
 
 		now := time.Now()
 		rows := pgxmock.NewRows([]string{
-			"id", "resource_node_type_id", "world_id", "chunk_x", "chunk_y", "cluster_id", "x", "y", "size", "created_at",
+			"id", "resource_node_type_id", "world_id", "chunk_x", "chunk_y", "cluster_id", "x", "y", "size", "quality", "created_at",
 		}).AddRow(
-			int32(1), int32(1), "550e8400-e29b-41d4-a716-446655440000", int32(2147483647), int32(-2147483648), "cluster_extreme", int32(2147483647), int32(-2147483648), int32(2147483647), pgtype.Timestamp{Time: now, Valid: true},
+			int32(1), int32(1), "550e8400-e29b-41d4-a716-446655440000", int32(2147483647), int32(-2147483648), "cluster_extreme", int32(2147483647), int32(-2147483648), int32(2147483647), int32(2), pgtype.Timestamp{Time: now, Valid: true},
 		)
 
 		mockPool.ExpectQuery("INSERT INTO resource_nodes").
-			WithArgs(int32(1), mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(2147483647), int32(-2147483648), "cluster_extreme", int32(2147483647), int32(-2147483648), int32(2147483647)).
+			WithArgs(int32(1), mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(2147483647), int32(-2147483648), "cluster_extreme", int32(2147483647), int32(-2147483648), int32(2147483647), int32(0)).
 			WillReturnRows(rows)
 
 		node, err := queries.CreateResourceNode(createTestContext(), params)
@@ -998,13 +1020,13 @@ func TestResourceNodeBusinessLogic(t *testing.T) {
 
 				now := time.Now()
 				rows := pgxmock.NewRows([]string{
-					"id", "resource_node_type_id", "world_id", "chunk_x", "chunk_y", "cluster_id", "x", "y", "size", "created_at",
+					"id", "resource_node_type_id", "world_id", "chunk_x", "chunk_y", "cluster_id", "x", "y", "size", "quality", "created_at",
 				}).AddRow(
-					int32(1), int32(1), "550e8400-e29b-41d4-a716-446655440000", int32(0), int32(0), tc.clusterID, int32(0), int32(0), int32(1), pgtype.Timestamp{Time: now, Valid: true},
+					int32(1), int32(1), "550e8400-e29b-41d4-a716-446655440000", int32(0), int32(0), tc.clusterID, int32(0), int32(0), int32(1), int32(2), pgtype.Timestamp{Time: now, Valid: true},
 				)
 
 				mockPool.ExpectQuery("INSERT INTO resource_nodes").
-					WithArgs(int32(1), mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(0), int32(0), tc.clusterID, int32(0), int32(0), int32(1)).
+					WithArgs(int32(1), mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(0), int32(0), tc.clusterID, int32(0), int32(0), int32(1), int32(0)).
 					WillReturnRows(rows)
 
 				node, err := queries.CreateResourceNode(createTestContext(), params)
@@ -1037,13 +1059,13 @@ func TestResourceNodeBusinessLogic(t *testing.T) {
 
 		now := time.Now()
 		rows := pgxmock.NewRows([]string{
-			"id", "resource_node_type_id", "world_id", "chunk_x", "chunk_y", "cluster_id", "x", "y", "size", "created_at",
+			"id", "resource_node_type_id", "world_id", "chunk_x", "chunk_y", "cluster_id", "x", "y", "size", "quality", "created_at",
 		}).AddRow(
-			int32(1), int32(-1), "550e8400-e29b-41d4-a716-446655440000", int32(0), int32(0), "cluster_negative_type", int32(0), int32(0), int32(1), pgtype.Timestamp{Time: now, Valid: true},
+			int32(1), int32(-1), "550e8400-e29b-41d4-a716-446655440000", int32(0), int32(0), "cluster_negative_type", int32(0), int32(0), int32(1), int32(2), pgtype.Timestamp{Time: now, Valid: true},
 		)
 
 		mockPool.ExpectQuery("INSERT INTO resource_nodes").
-			WithArgs(int32(-1), mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(0), int32(0), "cluster_negative_type", int32(0), int32(0), int32(1)).
+			WithArgs(int32(-1), mustParseUUID("550e8400-e29b-41d4-a716-446655440000"), int32(0), int32(0), "cluster_negative_type", int32(0), int32(0), int32(1), int32(0)).
 			WillReturnRows(rows)
 
 		node, err := queries.CreateResourceNode(createTestContext(), params)
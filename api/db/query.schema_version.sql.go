@@ -0,0 +1,44 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.schema_version.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const getSchemaVersion = `-- name: GetSchemaVersion :one
+
+SELECT id, checksum, applied_at FROM schema_version
+WHERE id
+`
+
+// Schema Version Operations
+func (q *Queries) GetSchemaVersion(ctx context.Context) (SchemaVersion, error) {
+	row := q.db.QueryRow(ctx, getSchemaVersion)
+	var i SchemaVersion
+	err := row.Scan(&i.ID, &i.Checksum, &i.AppliedAt)
+	return i, err
+}
+
+const setSchemaVersion = `-- name: SetSchemaVersion :exec
+INSERT INTO schema_version (checksum, applied_at)
+VALUES ($1, $2)
+ON CONFLICT (id) DO UPDATE
+SET checksum = EXCLUDED.checksum,
+    applied_at = EXCLUDED.applied_at
+`
+
+type SetSchemaVersionParams struct {
+	Checksum  string
+	AppliedAt pgtype.Timestamp
+}
+
+func (q *Queries) SetSchemaVersion(ctx context.Context, arg SetSchemaVersionParams) error {
+	_, err := q.db.Exec(ctx, setSchemaVersion, arg.Checksum, arg.AppliedAt)
+	return err
+}
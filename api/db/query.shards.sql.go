@@ -0,0 +1,95 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.shards.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const claimWorldShard = `-- name: ClaimWorldShard :one
+INSERT INTO world_shards (world_id, instance_id, assigned_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (world_id) DO UPDATE
+SET instance_id = EXCLUDED.instance_id,
+    assigned_at = EXCLUDED.assigned_at
+WHERE world_shards.instance_id = EXCLUDED.instance_id
+   OR NOT EXISTS (
+     SELECT 1 FROM shard_instances si
+     WHERE si.instance_id = world_shards.instance_id
+       AND si.heartbeat_at > $4
+   )
+RETURNING world_id, instance_id, assigned_at
+`
+
+type ClaimWorldShardParams struct {
+	WorldID     pgtype.UUID
+	InstanceID  string
+	Now         pgtype.Timestamp
+	StaleBefore pgtype.Timestamp
+}
+
+// Assigns the world to the instance unless another instance that heartbeated
+// after stale_before owns it. Returns no rows when the current owner is healthy.
+func (q *Queries) ClaimWorldShard(ctx context.Context, arg ClaimWorldShardParams) (WorldShard, error) {
+	row := q.db.QueryRow(ctx, claimWorldShard,
+		arg.WorldID,
+		arg.InstanceID,
+		arg.Now,
+		arg.StaleBefore,
+	)
+	var i WorldShard
+	err := row.Scan(&i.WorldID, &i.InstanceID, &i.AssignedAt)
+	return i, err
+}
+
+const getWorldShard = `-- name: GetWorldShard :one
+SELECT ws.world_id, ws.instance_id, si.address, si.heartbeat_at
+FROM world_shards ws
+JOIN shard_instances si ON si.instance_id = ws.instance_id
+WHERE ws.world_id = $1
+`
+
+type GetWorldShardRow struct {
+	WorldID     pgtype.UUID
+	InstanceID  string
+	Address     string
+	HeartbeatAt pgtype.Timestamp
+}
+
+func (q *Queries) GetWorldShard(ctx context.Context, worldID pgtype.UUID) (GetWorldShardRow, error) {
+	row := q.db.QueryRow(ctx, getWorldShard, worldID)
+	var i GetWorldShardRow
+	err := row.Scan(
+		&i.WorldID,
+		&i.InstanceID,
+		&i.Address,
+		&i.HeartbeatAt,
+	)
+	return i, err
+}
+
+const heartbeatShardInstance = `-- name: HeartbeatShardInstance :exec
+
+INSERT INTO shard_instances (instance_id, address, heartbeat_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (instance_id) DO UPDATE
+SET address = EXCLUDED.address,
+    heartbeat_at = EXCLUDED.heartbeat_at
+`
+
+type HeartbeatShardInstanceParams struct {
+	InstanceID string
+	Address    string
+	Now        pgtype.Timestamp
+}
+
+// Shard Registry Operations
+func (q *Queries) HeartbeatShardInstance(ctx context.Context, arg HeartbeatShardInstanceParams) error {
+	_, err := q.db.Exec(ctx, heartbeatShardInstance, arg.InstanceID, arg.Address, arg.Now)
+	return err
+}
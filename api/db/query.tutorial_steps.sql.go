@@ -0,0 +1,57 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.tutorial_steps.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const completeTutorialStep = `-- name: CompleteTutorialStep :exec
+INSERT INTO tutorial_steps (character_id, step, completed_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (character_id, step) DO NOTHING
+`
+
+type CompleteTutorialStepParams struct {
+	CharacterID pgtype.UUID
+	Step        string
+	CompletedAt pgtype.Timestamp
+}
+
+func (q *Queries) CompleteTutorialStep(ctx context.Context, arg CompleteTutorialStepParams) error {
+	_, err := q.db.Exec(ctx, completeTutorialStep, arg.CharacterID, arg.Step, arg.CompletedAt)
+	return err
+}
+
+const listTutorialSteps = `-- name: ListTutorialSteps :many
+
+SELECT character_id, step, completed_at FROM tutorial_steps
+WHERE character_id = $1
+ORDER BY completed_at
+`
+
+// Tutorial Operations
+func (q *Queries) ListTutorialSteps(ctx context.Context, characterID pgtype.UUID) ([]TutorialStep, error) {
+	rows, err := q.db.Query(ctx, listTutorialSteps, characterID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TutorialStep
+	for rows.Next() {
+		var i TutorialStep
+		if err := rows.Scan(&i.CharacterID, &i.Step, &i.CompletedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,141 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.user_blocks.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createUserBlock = `-- name: CreateUserBlock :exec
+
+INSERT INTO user_blocks (blocker_id, blocked_id, created_at)
+VALUES ($1, $2, $3)
+ON CONFLICT DO NOTHING
+`
+
+type CreateUserBlockParams struct {
+	BlockerID pgtype.UUID
+	BlockedID pgtype.UUID
+	CreatedAt pgtype.Timestamp
+}
+
+// User Block Operations
+func (q *Queries) CreateUserBlock(ctx context.Context, arg CreateUserBlockParams) error {
+	_, err := q.db.Exec(ctx, createUserBlock, arg.BlockerID, arg.BlockedID, arg.CreatedAt)
+	return err
+}
+
+const deleteUserBlock = `-- name: DeleteUserBlock :execrows
+DELETE FROM user_blocks
+WHERE blocker_id = $1 AND blocked_id = $2
+`
+
+type DeleteUserBlockParams struct {
+	BlockerID pgtype.UUID
+	BlockedID pgtype.UUID
+}
+
+func (q *Queries) DeleteUserBlock(ctx context.Context, arg DeleteUserBlockParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteUserBlock, arg.BlockerID, arg.BlockedID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const isBlockedBetween = `-- name: IsBlockedBetween :one
+SELECT EXISTS (
+  SELECT 1 FROM user_blocks
+  WHERE (blocker_id = $1 AND blocked_id = $2)
+     OR (blocker_id = $2 AND blocked_id = $1)
+)
+`
+
+type IsBlockedBetweenParams struct {
+	UserA pgtype.UUID
+	UserB pgtype.UUID
+}
+
+// Whether either user has blocked the other
+func (q *Queries) IsBlockedBetween(ctx context.Context, arg IsBlockedBetweenParams) (bool, error) {
+	row := q.db.QueryRow(ctx, isBlockedBetween, arg.UserA, arg.UserB)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const listBlockRelations = `-- name: ListBlockRelations :many
+SELECT (CASE WHEN blocker_id = $1 THEN blocked_id ELSE blocker_id END)::uuid AS user_id
+FROM user_blocks
+WHERE blocker_id = $1 OR blocked_id = $1
+`
+
+// Users the user has blocked or been blocked by
+func (q *Queries) ListBlockRelations(ctx context.Context, userID pgtype.UUID) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, listBlockRelations, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var user_id pgtype.UUID
+		if err := rows.Scan(&user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, user_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserBlocks = `-- name: ListUserBlocks :many
+SELECT
+  b.blocked_id,
+  b.created_at,
+  u.username,
+  u.display_name
+FROM user_blocks b
+JOIN users u ON u.id = b.blocked_id
+WHERE b.blocker_id = $1
+ORDER BY u.username
+`
+
+type ListUserBlocksRow struct {
+	BlockedID   pgtype.UUID
+	CreatedAt   pgtype.Timestamp
+	Username    string
+	DisplayName string
+}
+
+func (q *Queries) ListUserBlocks(ctx context.Context, blockerID pgtype.UUID) ([]ListUserBlocksRow, error) {
+	rows, err := q.db.Query(ctx, listUserBlocks, blockerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUserBlocksRow
+	for rows.Next() {
+		var i ListUserBlocksRow
+		if err := rows.Scan(
+			&i.BlockedID,
+			&i.CreatedAt,
+			&i.Username,
+			&i.DisplayName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,174 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.user_identities.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countUserIdentities = `-- name: CountUserIdentities :one
+SELECT count(*) FROM user_identities
+WHERE user_id = $1
+`
+
+func (q *Queries) CountUserIdentities(ctx context.Context, userID pgtype.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countUserIdentities, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createUserIdentity = `-- name: CreateUserIdentity :one
+INSERT INTO user_identities (user_id, provider, subject, email, username, linked_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, user_id, provider, subject, email, username, linked_at, last_login_at
+`
+
+type CreateUserIdentityParams struct {
+	UserID   pgtype.UUID
+	Provider string
+	Subject  string
+	Email    string
+	Username string
+	LinkedAt pgtype.Timestamp
+}
+
+func (q *Queries) CreateUserIdentity(ctx context.Context, arg CreateUserIdentityParams) (UserIdentity, error) {
+	row := q.db.QueryRow(ctx, createUserIdentity,
+		arg.UserID,
+		arg.Provider,
+		arg.Subject,
+		arg.Email,
+		arg.Username,
+		arg.LinkedAt,
+	)
+	var i UserIdentity
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Provider,
+		&i.Subject,
+		&i.Email,
+		&i.Username,
+		&i.LinkedAt,
+		&i.LastLoginAt,
+	)
+	return i, err
+}
+
+const deleteUserIdentity = `-- name: DeleteUserIdentity :one
+DELETE FROM user_identities
+WHERE user_id = $1 AND provider = $2
+RETURNING id, user_id, provider, subject, email, username, linked_at, last_login_at
+`
+
+type DeleteUserIdentityParams struct {
+	UserID   pgtype.UUID
+	Provider string
+}
+
+func (q *Queries) DeleteUserIdentity(ctx context.Context, arg DeleteUserIdentityParams) (UserIdentity, error) {
+	row := q.db.QueryRow(ctx, deleteUserIdentity, arg.UserID, arg.Provider)
+	var i UserIdentity
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Provider,
+		&i.Subject,
+		&i.Email,
+		&i.Username,
+		&i.LinkedAt,
+		&i.LastLoginAt,
+	)
+	return i, err
+}
+
+const getUserIdentity = `-- name: GetUserIdentity :one
+SELECT id, user_id, provider, subject, email, username, linked_at, last_login_at FROM user_identities
+WHERE provider = $1 AND subject = $2
+`
+
+type GetUserIdentityParams struct {
+	Provider string
+	Subject  string
+}
+
+func (q *Queries) GetUserIdentity(ctx context.Context, arg GetUserIdentityParams) (UserIdentity, error) {
+	row := q.db.QueryRow(ctx, getUserIdentity, arg.Provider, arg.Subject)
+	var i UserIdentity
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Provider,
+		&i.Subject,
+		&i.Email,
+		&i.Username,
+		&i.LinkedAt,
+		&i.LastLoginAt,
+	)
+	return i, err
+}
+
+const listUserIdentities = `-- name: ListUserIdentities :many
+SELECT id, user_id, provider, subject, email, username, linked_at, last_login_at FROM user_identities
+WHERE user_id = $1
+ORDER BY linked_at, id
+`
+
+func (q *Queries) ListUserIdentities(ctx context.Context, userID pgtype.UUID) ([]UserIdentity, error) {
+	rows, err := q.db.Query(ctx, listUserIdentities, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UserIdentity
+	for rows.Next() {
+		var i UserIdentity
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Provider,
+			&i.Subject,
+			&i.Email,
+			&i.Username,
+			&i.LinkedAt,
+			&i.LastLoginAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const touchUserIdentity = `-- name: TouchUserIdentity :exec
+UPDATE user_identities
+SET last_login_at = $1, email = $2, username = $3
+WHERE id = $4
+`
+
+type TouchUserIdentityParams struct {
+	LastLoginAt pgtype.Timestamp
+	Email       string
+	Username    string
+	ID          int64
+}
+
+// Records a login and what the provider now reports for the account
+func (q *Queries) TouchUserIdentity(ctx context.Context, arg TouchUserIdentityParams) error {
+	_, err := q.db.Exec(ctx, touchUserIdentity,
+		arg.LastLoginAt,
+		arg.Email,
+		arg.Username,
+		arg.ID,
+	)
+	return err
+}
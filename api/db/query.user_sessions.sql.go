@@ -0,0 +1,207 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.user_sessions.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countUserSessions = `-- name: CountUserSessions :one
+SELECT count(*) FROM user_sessions
+WHERE user_id = $1
+`
+
+func (q *Queries) CountUserSessions(ctx context.Context, userID pgtype.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countUserSessions, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createUserSession = `-- name: CreateUserSession :one
+INSERT INTO user_sessions (user_id, token_hash, ip_address, user_agent, created_at, last_seen_at, expires_at)
+VALUES ($1, $2, $3, $4, $5, $5, $6)
+RETURNING id, user_id, token_hash, ip_address, user_agent, created_at, last_seen_at, expires_at, revoked_at
+`
+
+type CreateUserSessionParams struct {
+	UserID    pgtype.UUID
+	TokenHash []byte
+	IpAddress string
+	UserAgent string
+	CreatedAt pgtype.Timestamp
+	ExpiresAt pgtype.Timestamp
+}
+
+func (q *Queries) CreateUserSession(ctx context.Context, arg CreateUserSessionParams) (UserSession, error) {
+	row := q.db.QueryRow(ctx, createUserSession,
+		arg.UserID,
+		arg.TokenHash,
+		arg.IpAddress,
+		arg.UserAgent,
+		arg.CreatedAt,
+		arg.ExpiresAt,
+	)
+	var i UserSession
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TokenHash,
+		&i.IpAddress,
+		&i.UserAgent,
+		&i.CreatedAt,
+		&i.LastSeenAt,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const deleteUserSessionsEndedBefore = `-- name: DeleteUserSessionsEndedBefore :execrows
+DELETE FROM user_sessions
+WHERE expires_at < $1 OR revoked_at < $1
+`
+
+// Sessions that expired or were revoked before the cutoff
+func (q *Queries) DeleteUserSessionsEndedBefore(ctx context.Context, cutoff pgtype.Timestamp) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteUserSessionsEndedBefore, cutoff)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getUserSessionByTokenHash = `-- name: GetUserSessionByTokenHash :one
+SELECT id, user_id, token_hash, ip_address, user_agent, created_at, last_seen_at, expires_at, revoked_at FROM user_sessions
+WHERE token_hash = $1
+`
+
+func (q *Queries) GetUserSessionByTokenHash(ctx context.Context, tokenHash []byte) (UserSession, error) {
+	row := q.db.QueryRow(ctx, getUserSessionByTokenHash, tokenHash)
+	var i UserSession
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TokenHash,
+		&i.IpAddress,
+		&i.UserAgent,
+		&i.CreatedAt,
+		&i.LastSeenAt,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const listActiveUserSessions = `-- name: ListActiveUserSessions :many
+SELECT id, user_id, token_hash, ip_address, user_agent, created_at, last_seen_at, expires_at, revoked_at FROM user_sessions
+WHERE user_id = $1 AND revoked_at IS NULL AND expires_at > $2
+ORDER BY last_seen_at DESC, created_at DESC
+`
+
+type ListActiveUserSessionsParams struct {
+	UserID pgtype.UUID
+	Now    pgtype.Timestamp
+}
+
+// Most recently seen first
+func (q *Queries) ListActiveUserSessions(ctx context.Context, arg ListActiveUserSessionsParams) ([]UserSession, error) {
+	rows, err := q.db.Query(ctx, listActiveUserSessions, arg.UserID, arg.Now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UserSession
+	for rows.Next() {
+		var i UserSession
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.TokenHash,
+			&i.IpAddress,
+			&i.UserAgent,
+			&i.CreatedAt,
+			&i.LastSeenAt,
+			&i.ExpiresAt,
+			&i.RevokedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const revokeUserSession = `-- name: RevokeUserSession :one
+UPDATE user_sessions
+SET revoked_at = $1
+WHERE id = $2 AND user_id = $3 AND revoked_at IS NULL
+RETURNING id, user_id, token_hash, ip_address, user_agent, created_at, last_seen_at, expires_at, revoked_at
+`
+
+type RevokeUserSessionParams struct {
+	RevokedAt pgtype.Timestamp
+	ID        pgtype.UUID
+	UserID    pgtype.UUID
+}
+
+func (q *Queries) RevokeUserSession(ctx context.Context, arg RevokeUserSessionParams) (UserSession, error) {
+	row := q.db.QueryRow(ctx, revokeUserSession, arg.RevokedAt, arg.ID, arg.UserID)
+	var i UserSession
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TokenHash,
+		&i.IpAddress,
+		&i.UserAgent,
+		&i.CreatedAt,
+		&i.LastSeenAt,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const touchUserSession = `-- name: TouchUserSession :exec
+UPDATE user_sessions
+SET last_seen_at = $2
+WHERE id = $1
+`
+
+type TouchUserSessionParams struct {
+	ID         pgtype.UUID
+	LastSeenAt pgtype.Timestamp
+}
+
+func (q *Queries) TouchUserSession(ctx context.Context, arg TouchUserSessionParams) error {
+	_, err := q.db.Exec(ctx, touchUserSession, arg.ID, arg.LastSeenAt)
+	return err
+}
+
+const userSessionDeviceKnown = `-- name: UserSessionDeviceKnown :one
+SELECT EXISTS (
+  SELECT 1 FROM user_sessions
+  WHERE user_id = $1 AND user_agent = $2
+)
+`
+
+type UserSessionDeviceKnownParams struct {
+	UserID    pgtype.UUID
+	UserAgent string
+}
+
+// Whether the user has logged in with the user agent before, in any kept session
+func (q *Queries) UserSessionDeviceKnown(ctx context.Context, arg UserSessionDeviceKnownParams) (bool, error) {
+	row := q.db.QueryRow(ctx, userSessionDeviceKnown, arg.UserID, arg.UserAgent)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
@@ -0,0 +1,371 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.world_entities.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createWorldEntity = `-- name: CreateWorldEntity :one
+
+INSERT INTO world_entities (world_id, type, chunk_x, chunk_y, x, y, components, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $8)
+RETURNING id, world_id, type, chunk_x, chunk_y, x, y, components, version, created_at, updated_at
+`
+
+type CreateWorldEntityParams struct {
+	WorldID    pgtype.UUID
+	Type       string
+	ChunkX     int32
+	ChunkY     int32
+	X          int32
+	Y          int32
+	Components []byte
+	Now        pgtype.Timestamp
+}
+
+// World Entity Operations
+func (q *Queries) CreateWorldEntity(ctx context.Context, arg CreateWorldEntityParams) (WorldEntity, error) {
+	row := q.db.QueryRow(ctx, createWorldEntity,
+		arg.WorldID,
+		arg.Type,
+		arg.ChunkX,
+		arg.ChunkY,
+		arg.X,
+		arg.Y,
+		arg.Components,
+		arg.Now,
+	)
+	var i WorldEntity
+	err := row.Scan(
+		&i.ID,
+		&i.WorldID,
+		&i.Type,
+		&i.ChunkX,
+		&i.ChunkY,
+		&i.X,
+		&i.Y,
+		&i.Components,
+		&i.Version,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteWorldEntity = `-- name: DeleteWorldEntity :execrows
+DELETE FROM world_entities
+WHERE id = $1
+`
+
+func (q *Queries) DeleteWorldEntity(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteWorldEntity, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getWorldEntity = `-- name: GetWorldEntity :one
+SELECT id, world_id, type, chunk_x, chunk_y, x, y, components, version, created_at, updated_at FROM world_entities
+WHERE id = $1
+`
+
+func (q *Queries) GetWorldEntity(ctx context.Context, id int64) (WorldEntity, error) {
+	row := q.db.QueryRow(ctx, getWorldEntity, id)
+	var i WorldEntity
+	err := row.Scan(
+		&i.ID,
+		&i.WorldID,
+		&i.Type,
+		&i.ChunkX,
+		&i.ChunkY,
+		&i.X,
+		&i.Y,
+		&i.Components,
+		&i.Version,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listWorldEntitiesInChunk = `-- name: ListWorldEntitiesInChunk :many
+SELECT id, world_id, type, chunk_x, chunk_y, x, y, components, version, created_at, updated_at FROM world_entities
+WHERE world_id = $1 AND chunk_x = $2 AND chunk_y = $3
+ORDER BY id
+`
+
+type ListWorldEntitiesInChunkParams struct {
+	WorldID pgtype.UUID
+	ChunkX  int32
+	ChunkY  int32
+}
+
+func (q *Queries) ListWorldEntitiesInChunk(ctx context.Context, arg ListWorldEntitiesInChunkParams) ([]WorldEntity, error) {
+	rows, err := q.db.Query(ctx, listWorldEntitiesInChunk, arg.WorldID, arg.ChunkX, arg.ChunkY)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WorldEntity
+	for rows.Next() {
+		var i WorldEntity
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorldID,
+			&i.Type,
+			&i.ChunkX,
+			&i.ChunkY,
+			&i.X,
+			&i.Y,
+			&i.Components,
+			&i.Version,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWorldEntitiesInChunkRange = `-- name: ListWorldEntitiesInChunkRange :many
+SELECT id, world_id, type, chunk_x, chunk_y, x, y, components, version, created_at, updated_at FROM world_entities
+WHERE world_id = $1
+  AND chunk_x BETWEEN $2 AND $3
+  AND chunk_y BETWEEN $4 AND $5
+  AND ($6::text = '' OR type = $6)
+ORDER BY chunk_x, chunk_y, id
+`
+
+type ListWorldEntitiesInChunkRangeParams struct {
+	WorldID   pgtype.UUID
+	MinChunkX int32
+	MaxChunkX int32
+	MinChunkY int32
+	MaxChunkY int32
+	Type      string
+}
+
+// An empty type lists entities of every type
+func (q *Queries) ListWorldEntitiesInChunkRange(ctx context.Context, arg ListWorldEntitiesInChunkRangeParams) ([]WorldEntity, error) {
+	rows, err := q.db.Query(ctx, listWorldEntitiesInChunkRange,
+		arg.WorldID,
+		arg.MinChunkX,
+		arg.MaxChunkX,
+		arg.MinChunkY,
+		arg.MaxChunkY,
+		arg.Type,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WorldEntity
+	for rows.Next() {
+		var i WorldEntity
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorldID,
+			&i.Type,
+			&i.ChunkX,
+			&i.ChunkY,
+			&i.X,
+			&i.Y,
+			&i.Components,
+			&i.Version,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWorldEntitiesInRect = `-- name: ListWorldEntitiesInRect :many
+SELECT id, world_id, type, chunk_x, chunk_y, x, y, components, version, created_at, updated_at FROM world_entities
+WHERE world_id = $1
+  AND x BETWEEN $2 AND $3
+  AND y BETWEEN $4 AND $5
+  AND (cardinality($6::text[]) = 0 OR type = ANY($6::text[]))
+ORDER BY id
+LIMIT $7
+`
+
+type ListWorldEntitiesInRectParams struct {
+	WorldID    pgtype.UUID
+	MinX       int32
+	MaxX       int32
+	MinY       int32
+	MaxY       int32
+	Types      []string
+	MaxResults int32
+}
+
+// Bounds are inclusive world cell coordinates; empty types lists entities of every type
+func (q *Queries) ListWorldEntitiesInRect(ctx context.Context, arg ListWorldEntitiesInRectParams) ([]WorldEntity, error) {
+	rows, err := q.db.Query(ctx, listWorldEntitiesInRect,
+		arg.WorldID,
+		arg.MinX,
+		arg.MaxX,
+		arg.MinY,
+		arg.MaxY,
+		arg.Types,
+		arg.MaxResults,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WorldEntity
+	for rows.Next() {
+		var i WorldEntity
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorldID,
+			&i.Type,
+			&i.ChunkX,
+			&i.ChunkY,
+			&i.X,
+			&i.Y,
+			&i.Components,
+			&i.Version,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWorldEntitiesWithinRadius = `-- name: ListWorldEntitiesWithinRadius :many
+SELECT id, world_id, type, chunk_x, chunk_y, x, y, components, version, created_at, updated_at FROM world_entities
+WHERE world_id = $1
+  AND x BETWEEN $2::integer - $3::integer AND $2::integer + $3::integer
+  AND y BETWEEN $4::integer - $3::integer AND $4::integer + $3::integer
+  AND (x::bigint - $2::integer) * (x::bigint - $2::integer)
+    + (y::bigint - $4::integer) * (y::bigint - $4::integer)
+    <= $3::bigint * $3::bigint
+  AND (cardinality($5::text[]) = 0 OR type = ANY($5::text[]))
+ORDER BY (x::bigint - $2::integer) * (x::bigint - $2::integer)
+    + (y::bigint - $4::integer) * (y::bigint - $4::integer), id
+LIMIT $6
+`
+
+type ListWorldEntitiesWithinRadiusParams struct {
+	WorldID    pgtype.UUID
+	CenterX    int32
+	Radius     int32
+	CenterY    int32
+	Types      []string
+	MaxResults int32
+}
+
+// Nearest first; the bounding box lets the position index narrow the scan before the
+// exact distance check
+func (q *Queries) ListWorldEntitiesWithinRadius(ctx context.Context, arg ListWorldEntitiesWithinRadiusParams) ([]WorldEntity, error) {
+	rows, err := q.db.Query(ctx, listWorldEntitiesWithinRadius,
+		arg.WorldID,
+		arg.CenterX,
+		arg.Radius,
+		arg.CenterY,
+		arg.Types,
+		arg.MaxResults,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WorldEntity
+	for rows.Next() {
+		var i WorldEntity
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorldID,
+			&i.Type,
+			&i.ChunkX,
+			&i.ChunkY,
+			&i.X,
+			&i.Y,
+			&i.Components,
+			&i.Version,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateWorldEntity = `-- name: UpdateWorldEntity :one
+UPDATE world_entities
+SET chunk_x = $1, chunk_y = $2, x = $3, y = $4,
+    components = $5, version = version + 1, updated_at = $6
+WHERE id = $7 AND version = $8
+RETURNING id, world_id, type, chunk_x, chunk_y, x, y, components, version, created_at, updated_at
+`
+
+type UpdateWorldEntityParams struct {
+	ChunkX     int32
+	ChunkY     int32
+	X          int32
+	Y          int32
+	Components []byte
+	Now        pgtype.Timestamp
+	ID         int64
+	Version    int32
+}
+
+// Returns no rows when the entity is gone or was updated since version was read
+func (q *Queries) UpdateWorldEntity(ctx context.Context, arg UpdateWorldEntityParams) (WorldEntity, error) {
+	row := q.db.QueryRow(ctx, updateWorldEntity,
+		arg.ChunkX,
+		arg.ChunkY,
+		arg.X,
+		arg.Y,
+		arg.Components,
+		arg.Now,
+		arg.ID,
+		arg.Version,
+	)
+	var i WorldEntity
+	err := row.Scan(
+		&i.ID,
+		&i.WorldID,
+		&i.Type,
+		&i.ChunkX,
+		&i.ChunkY,
+		&i.X,
+		&i.Y,
+		&i.Components,
+		&i.Version,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,91 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.world_pauses.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const deleteWorldPause = `-- name: DeleteWorldPause :execrows
+DELETE FROM world_pauses
+WHERE world_id = $1
+`
+
+func (q *Queries) DeleteWorldPause(ctx context.Context, worldID pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteWorldPause, worldID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const listWorldPauses = `-- name: ListWorldPauses :many
+
+SELECT world_id, reason, paused_by, paused_at FROM world_pauses
+ORDER BY paused_at, world_id
+`
+
+// World Pause Operations
+func (q *Queries) ListWorldPauses(ctx context.Context) ([]WorldPause, error) {
+	rows, err := q.db.Query(ctx, listWorldPauses)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WorldPause
+	for rows.Next() {
+		var i WorldPause
+		if err := rows.Scan(
+			&i.WorldID,
+			&i.Reason,
+			&i.PausedBy,
+			&i.PausedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertWorldPause = `-- name: UpsertWorldPause :one
+INSERT INTO world_pauses (world_id, reason, paused_by, paused_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (world_id) DO UPDATE
+SET reason = EXCLUDED.reason,
+    paused_by = EXCLUDED.paused_by,
+    paused_at = EXCLUDED.paused_at
+RETURNING world_id, reason, paused_by, paused_at
+`
+
+type UpsertWorldPauseParams struct {
+	WorldID  pgtype.UUID
+	Reason   string
+	PausedBy pgtype.UUID
+	PausedAt pgtype.Timestamp
+}
+
+func (q *Queries) UpsertWorldPause(ctx context.Context, arg UpsertWorldPauseParams) (WorldPause, error) {
+	row := q.db.QueryRow(ctx, upsertWorldPause,
+		arg.WorldID,
+		arg.Reason,
+		arg.PausedBy,
+		arg.PausedAt,
+	)
+	var i WorldPause
+	err := row.Scan(
+		&i.WorldID,
+		&i.Reason,
+		&i.PausedBy,
+		&i.PausedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,94 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.world_time_scales.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const getWorldTimeScale = `-- name: GetWorldTimeScale :one
+SELECT world_id, time_scale, updated_by, updated_at FROM world_time_scales
+WHERE world_id = $1
+`
+
+func (q *Queries) GetWorldTimeScale(ctx context.Context, worldID pgtype.UUID) (WorldTimeScale, error) {
+	row := q.db.QueryRow(ctx, getWorldTimeScale, worldID)
+	var i WorldTimeScale
+	err := row.Scan(
+		&i.WorldID,
+		&i.TimeScale,
+		&i.UpdatedBy,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listWorldTimeScales = `-- name: ListWorldTimeScales :many
+
+SELECT world_id, time_scale, updated_by, updated_at FROM world_time_scales
+`
+
+// World Time Scale Operations
+func (q *Queries) ListWorldTimeScales(ctx context.Context) ([]WorldTimeScale, error) {
+	rows, err := q.db.Query(ctx, listWorldTimeScales)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WorldTimeScale
+	for rows.Next() {
+		var i WorldTimeScale
+		if err := rows.Scan(
+			&i.WorldID,
+			&i.TimeScale,
+			&i.UpdatedBy,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertWorldTimeScale = `-- name: UpsertWorldTimeScale :one
+INSERT INTO world_time_scales (world_id, time_scale, updated_by, updated_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (world_id) DO UPDATE
+SET time_scale = EXCLUDED.time_scale,
+    updated_by = EXCLUDED.updated_by,
+    updated_at = EXCLUDED.updated_at
+RETURNING world_id, time_scale, updated_by, updated_at
+`
+
+type UpsertWorldTimeScaleParams struct {
+	WorldID   pgtype.UUID
+	TimeScale float64
+	UpdatedBy pgtype.UUID
+	UpdatedAt pgtype.Timestamp
+}
+
+func (q *Queries) UpsertWorldTimeScale(ctx context.Context, arg UpsertWorldTimeScaleParams) (WorldTimeScale, error) {
+	row := q.db.QueryRow(ctx, upsertWorldTimeScale,
+		arg.WorldID,
+		arg.TimeScale,
+		arg.UpdatedBy,
+		arg.UpdatedAt,
+	)
+	var i WorldTimeScale
+	err := row.Scan(
+		&i.WorldID,
+		&i.TimeScale,
+		&i.UpdatedBy,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,175 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: query.world_timeline.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createWorldTimelineEntry = `-- name: CreateWorldTimelineEntry :exec
+
+INSERT INTO world_timeline (world_id, kind, details, dedup_key, occurred_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (dedup_key) DO NOTHING
+`
+
+type CreateWorldTimelineEntryParams struct {
+	WorldID    pgtype.UUID
+	Kind       string
+	Details    []byte
+	DedupKey   string
+	OccurredAt pgtype.Timestamp
+}
+
+// World Timeline Operations
+// Does nothing when an entry with the same dedup key exists
+func (q *Queries) CreateWorldTimelineEntry(ctx context.Context, arg CreateWorldTimelineEntryParams) error {
+	_, err := q.db.Exec(ctx, createWorldTimelineEntry,
+		arg.WorldID,
+		arg.Kind,
+		arg.Details,
+		arg.DedupKey,
+		arg.OccurredAt,
+	)
+	return err
+}
+
+const getLatestWorldTimelineID = `-- name: GetLatestWorldTimelineID :one
+SELECT COALESCE(MAX(id), 0)::bigint FROM world_timeline
+`
+
+func (q *Queries) GetLatestWorldTimelineID(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, getLatestWorldTimelineID)
+	var column_1 int64
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const listAllWorldTimelineAfter = `-- name: ListAllWorldTimelineAfter :many
+SELECT id, world_id, kind, details, dedup_key, occurred_at FROM world_timeline
+WHERE id > $1
+ORDER BY id
+LIMIT $2
+`
+
+type ListAllWorldTimelineAfterParams struct {
+	AfterID    int64
+	MaxEntries int32
+}
+
+// Entries of every world after an entry ID, oldest first
+func (q *Queries) ListAllWorldTimelineAfter(ctx context.Context, arg ListAllWorldTimelineAfterParams) ([]WorldTimeline, error) {
+	rows, err := q.db.Query(ctx, listAllWorldTimelineAfter, arg.AfterID, arg.MaxEntries)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WorldTimeline
+	for rows.Next() {
+		var i WorldTimeline
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorldID,
+			&i.Kind,
+			&i.Details,
+			&i.DedupKey,
+			&i.OccurredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWorldTimeline = `-- name: ListWorldTimeline :many
+SELECT id, world_id, kind, details, dedup_key, occurred_at FROM world_timeline
+WHERE world_id = $1
+  AND id < $2
+ORDER BY id DESC
+LIMIT $3
+`
+
+type ListWorldTimelineParams struct {
+	WorldID    pgtype.UUID
+	BeforeID   int64
+	MaxEntries int32
+}
+
+// A world's entries before an entry ID, newest first
+func (q *Queries) ListWorldTimeline(ctx context.Context, arg ListWorldTimelineParams) ([]WorldTimeline, error) {
+	rows, err := q.db.Query(ctx, listWorldTimeline, arg.WorldID, arg.BeforeID, arg.MaxEntries)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WorldTimeline
+	for rows.Next() {
+		var i WorldTimeline
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorldID,
+			&i.Kind,
+			&i.Details,
+			&i.DedupKey,
+			&i.OccurredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWorldTimelineAfter = `-- name: ListWorldTimelineAfter :many
+SELECT id, world_id, kind, details, dedup_key, occurred_at FROM world_timeline
+WHERE world_id = $1
+  AND id > $2
+ORDER BY id
+LIMIT $3
+`
+
+type ListWorldTimelineAfterParams struct {
+	WorldID    pgtype.UUID
+	AfterID    int64
+	MaxEntries int32
+}
+
+// A world's entries after an entry ID, oldest first
+func (q *Queries) ListWorldTimelineAfter(ctx context.Context, arg ListWorldTimelineAfterParams) ([]WorldTimeline, error) {
+	rows, err := q.db.Query(ctx, listWorldTimelineAfter, arg.WorldID, arg.AfterID, arg.MaxEntries)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WorldTimeline
+	for rows.Next() {
+		var i WorldTimeline
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorldID,
+			&i.Kind,
+			&i.Details,
+			&i.DedupKey,
+			&i.OccurredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
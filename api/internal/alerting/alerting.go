@@ -0,0 +1,270 @@
+// Package alerting notifies operators of operational problems through webhooks
+// (Slack, Discord) and email. Conditions are detected by the monitors in this
+// package or reported directly (ReportJobError, Alerter.Fire); repeats of the same
+// alert are suppressed for a cooldown so a persistent fault does not flood the channel.
+package alerting
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/charmbracelet/log"
+)
+
+// Condition identifies what an alert is about
+type Condition string
+
+const (
+	MigrationFailed Condition = "migration_failed" // The database schema is missing or failed to apply
+	JobErrors       Condition = "job_errors"       // A background job failed repeatedly
+	PoolExhausted   Condition = "pool_exhausted"   // Every database connection stayed in use
+	ErrorRateSpike  Condition = "error_rate"       // The RPC server error rate jumped above its baseline
+	ChunkCorrupted  Condition = "chunk_corrupted"  // A stored chunk failed to decode and was regenerated
+)
+
+// AllConditions lists every condition, in the order used for configuration
+var AllConditions = []Condition{MigrationFailed, JobErrors, PoolExhausted, ErrorRateSpike, ChunkCorrupted}
+
+// Alert is a single operational alert
+type Alert struct {
+	Condition Condition
+	Key       string // Distinguishes alerts of one condition for cooldowns, e.g. the job name
+	Title     string
+	Message   string
+	Fields    map[string]string
+	At        time.Time
+}
+
+// Text renders the alert as plain text for chat webhooks and email bodies
+func (a Alert) Text() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "[%s] %s\n%s", a.Condition, a.Title, a.Message)
+	keys := make([]string, 0, len(a.Fields))
+	for key := range a.Fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		fmt.Fprintf(&b, "\n%s: %s", key, a.Fields[key])
+	}
+	return b.String()
+}
+
+// Notifier delivers alerts to one destination
+type Notifier interface {
+	Notify(ctx context.Context, alert Alert) error
+}
+
+// Config controls which conditions alert and their thresholds
+type Config struct {
+	Conditions  []Condition   // Conditions that send alerts; others are ignored
+	Cooldown    time.Duration // Minimum time between alerts with the same condition and key
+	Interval    time.Duration // How often Run samples the pool and error-rate monitors
+	SendTimeout time.Duration // Deadline for delivering one alert to all notifiers
+
+	JobErrorThreshold int // Failures of one job within JobErrorWindow that trigger an alert
+	JobErrorWindow    time.Duration
+
+	PoolExhaustedSamples int // Consecutive samples with every connection acquired that trigger an alert
+
+	ErrorRateMinRequests int     // Requests an interval needs before its error rate is judged
+	ErrorRateThreshold   float64 // Error rate that always counts as a spike
+	ErrorRateFactor      float64 // Error rate this many times the baseline counts as a spike
+}
+
+// DefaultConfig enables every condition
+func DefaultConfig() Config {
+	return Config{
+		Conditions:           AllConditions,
+		Cooldown:             15 * time.Minute,
+		Interval:             30 * time.Second,
+		SendTimeout:          10 * time.Second,
+		JobErrorThreshold:    5,
+		JobErrorWindow:       10 * time.Minute,
+		PoolExhaustedSamples: 3,
+		ErrorRateMinRequests: 50,
+		ErrorRateThreshold:   0.25,
+		ErrorRateFactor:      5,
+	}
+}
+
+// Alerter sends alerts to its notifiers. It is safe for concurrent use.
+type Alerter struct {
+	config    Config
+	notifiers []Notifier
+	enabled   map[Condition]bool
+	clock     clock.Clock
+	logger    *log.Logger
+
+	mu        sync.Mutex
+	lastSent  map[string]time.Time   // By condition and key
+	jobErrors map[string][]time.Time // Recent failure times by job
+	monitors  []monitor
+}
+
+// monitor is sampled on every Run interval
+type monitor interface {
+	sample(now time.Time) *Alert
+}
+
+// New creates an alerter delivering to notifiers
+func New(config Config, notifiers ...Notifier) *Alerter {
+	enabled := make(map[Condition]bool, len(config.Conditions))
+	for _, condition := range config.Conditions {
+		enabled[condition] = true
+	}
+	return &Alerter{
+		config:    config,
+		notifiers: notifiers,
+		enabled:   enabled,
+		clock:     clock.New(),
+		logger:    logging.WithComponent("alerting"),
+		lastSent:  make(map[string]time.Time),
+		jobErrors: make(map[string][]time.Time),
+	}
+}
+
+// SetClock replaces the clock used for cooldowns and windows (for simulation tests)
+func (a *Alerter) SetClock(c clock.Clock) {
+	a.clock = c
+}
+
+// Fire sends an alert to every notifier unless its condition is disabled or the same
+// alert was sent within the cooldown. It reports whether the alert was sent.
+func (a *Alerter) Fire(ctx context.Context, alert Alert) (bool, error) {
+	if !a.enabled[alert.Condition] {
+		return false, nil
+	}
+	now := a.clock.Now()
+	key := string(alert.Condition) + ":" + alert.Key
+
+	a.mu.Lock()
+	if last, ok := a.lastSent[key]; ok && now.Sub(last) < a.config.Cooldown {
+		a.mu.Unlock()
+		return false, nil
+	}
+	a.lastSent[key] = now
+	a.mu.Unlock()
+
+	if alert.At.IsZero() {
+		alert.At = now
+	}
+	ctx, cancel := context.WithTimeout(ctx, a.config.SendTimeout)
+	defer cancel()
+
+	var errs []error
+	for _, notifier := range a.notifiers {
+		if err := notifier.Notify(ctx, alert); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	err := errors.Join(errs...)
+	if err != nil {
+		a.logger.Error("Failed to deliver alert", "condition", alert.Condition, "title", alert.Title, "error", err)
+	} else {
+		a.logger.Warn("Alert sent", "condition", alert.Condition, "title", alert.Title)
+	}
+	return true, err
+}
+
+// JobFailed records a background job failure and alerts once the job has failed
+// JobErrorThreshold times within JobErrorWindow. The alert is sent in the background
+// so the job is not held up by slow notifiers.
+func (a *Alerter) JobFailed(job string, jobErr error) {
+	if !a.enabled[JobErrors] {
+		return
+	}
+	now := a.clock.Now()
+
+	a.mu.Lock()
+	recent := a.jobErrors[job][:0]
+	for _, at := range a.jobErrors[job] {
+		if now.Sub(at) < a.config.JobErrorWindow {
+			recent = append(recent, at)
+		}
+	}
+	recent = append(recent, now)
+	a.jobErrors[job] = recent
+	count := len(recent)
+	a.mu.Unlock()
+
+	if count < a.config.JobErrorThreshold {
+		return
+	}
+	go a.Fire(context.Background(), Alert{
+		Condition: JobErrors,
+		Key:       job,
+		Title:     fmt.Sprintf("Background job %s is failing", job),
+		Message:   fmt.Sprintf("%d failures in the last %s", count, a.config.JobErrorWindow),
+		Fields:    map[string]string{"job": job, "last_error": jobErr.Error()},
+	})
+}
+
+// Run samples the registered monitors every config.Interval until ctx is cancelled
+func (a *Alerter) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-a.clock.After(a.config.Interval):
+		}
+
+		a.mu.Lock()
+		monitors := append([]monitor(nil), a.monitors...)
+		a.mu.Unlock()
+
+		now := a.clock.Now()
+		for _, m := range monitors {
+			if alert := m.sample(now); alert != nil {
+				a.Fire(ctx, *alert)
+			}
+		}
+	}
+}
+
+func (a *Alerter) addMonitor(m monitor) {
+	a.mu.Lock()
+	a.monitors = append(a.monitors, m)
+	a.mu.Unlock()
+}
+
+var (
+	defaultMu      sync.RWMutex
+	defaultAlerter *Alerter
+)
+
+// SetDefault installs the alerter used by ReportJobError and Report. Passing nil disables reporting.
+func SetDefault(a *Alerter) {
+	defaultMu.Lock()
+	defaultAlerter = a
+	defaultMu.Unlock()
+}
+
+// ReportJobError records a background job failure with the default alerter, if any.
+// Jobs call it next to logging the error.
+func ReportJobError(job string, err error) {
+	defaultMu.RLock()
+	a := defaultAlerter
+	defaultMu.RUnlock()
+	if a != nil {
+		a.JobFailed(job, err)
+	}
+}
+
+// Report sends an alert with the default alerter, if any. It is sent in the background
+// so the caller is not held up by slow notifiers.
+func Report(alert Alert) {
+	defaultMu.RLock()
+	a := defaultAlerter
+	defaultMu.RUnlock()
+	if a != nil {
+		go a.Fire(context.Background(), alert)
+	}
+}
@@ -0,0 +1,170 @@
+package alerting
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/smtp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+// recordingNotifier keeps every alert it is sent
+type recordingNotifier struct {
+	mu     sync.Mutex
+	alerts []Alert
+}
+
+func (r *recordingNotifier) Notify(ctx context.Context, alert Alert) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.alerts = append(r.alerts, alert)
+	return nil
+}
+
+func (r *recordingNotifier) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.alerts)
+}
+
+func newTestAlerter(config Config) (*Alerter, *recordingNotifier, *clock.Fake) {
+	notifier := &recordingNotifier{}
+	alerter := New(config, notifier)
+	fake := clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	alerter.SetClock(fake)
+	return alerter, notifier, fake
+}
+
+func TestFire_CooldownAndConditions(t *testing.T) {
+	config := DefaultConfig()
+	config.Conditions = []Condition{MigrationFailed}
+	alerter, notifier, fake := newTestAlerter(config)
+	ctx := context.Background()
+
+	sent, err := alerter.Fire(ctx, Alert{Condition: MigrationFailed, Title: "schema missing"})
+	require.NoError(t, err)
+	assert.True(t, sent)
+
+	sent, _ = alerter.Fire(ctx, Alert{Condition: MigrationFailed, Title: "schema missing"})
+	assert.False(t, sent, "repeats within the cooldown are suppressed")
+
+	sent, _ = alerter.Fire(ctx, Alert{Condition: PoolExhausted, Title: "pool"})
+	assert.False(t, sent, "disabled conditions never alert")
+
+	fake.Advance(config.Cooldown)
+	sent, _ = alerter.Fire(ctx, Alert{Condition: MigrationFailed, Title: "schema missing"})
+	assert.True(t, sent)
+	assert.Equal(t, 2, notifier.count())
+}
+
+func TestJobFailed_Threshold(t *testing.T) {
+	config := DefaultConfig()
+	config.JobErrorThreshold = 3
+	alerter, notifier, fake := newTestAlerter(config)
+
+	alerter.JobFailed("checkpoint", errors.New("db down"))
+	alerter.JobFailed("checkpoint", errors.New("db down"))
+	fake.Advance(config.JobErrorWindow) // The first two fall out of the window
+	alerter.JobFailed("checkpoint", errors.New("db down"))
+	alerter.JobFailed("compaction", errors.New("db down"))
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, 0, notifier.count())
+
+	alerter.JobFailed("checkpoint", errors.New("db down"))
+	alerter.JobFailed("checkpoint", errors.New("still down"))
+	require.Eventually(t, func() bool { return notifier.count() == 1 }, time.Second, time.Millisecond)
+	assert.Equal(t, "checkpoint", notifier.alerts[0].Fields["job"])
+}
+
+type fakePoolStats struct{ acquired, max int32 }
+
+func (f fakePoolStats) AcquiredConns() int32 { return f.acquired }
+func (f fakePoolStats) MaxConns() int32      { return f.max }
+
+func TestPoolMonitor(t *testing.T) {
+	m := &poolMonitor{samples: 2}
+	stats := fakePoolStats{acquired: 10, max: 10}
+	m.stat = func() PoolStats { return stats }
+	now := time.Now()
+
+	assert.Nil(t, m.sample(now))
+	stats.acquired = 9
+	assert.Nil(t, m.sample(now), "a free connection resets the count")
+	stats.acquired = 10
+	assert.Nil(t, m.sample(now))
+	alert := m.sample(now)
+	require.NotNil(t, alert)
+	assert.Equal(t, PoolExhausted, alert.Condition)
+}
+
+func TestErrorRateMonitor(t *testing.T) {
+	config := DefaultConfig()
+	alerter, _, _ := newTestAlerter(config)
+	rate := alerter.WatchErrorRate()
+	m := alerter.monitors[0]
+	now := time.Now()
+
+	record := func(ok, failed int) {
+		for i := 0; i < ok; i++ {
+			rate.Record(codes.OK)
+			rate.Record(codes.NotFound) // Client errors never count
+		}
+		for i := 0; i < failed; i++ {
+			rate.Record(codes.Internal)
+		}
+	}
+
+	record(99, 2) // ~1% sets the baseline
+	assert.Nil(t, m.sample(now))
+	record(10, 0)
+	assert.Nil(t, m.sample(now), "too few requests to judge")
+	record(90, 20) // ~10%: below the threshold but many times the baseline
+	alert := m.sample(now)
+	require.NotNil(t, alert)
+	assert.Equal(t, ErrorRateSpike, alert.Condition)
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	var body map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	notifier, err := NewWebhookNotifier(server.URL, FormatDiscord)
+	require.NoError(t, err)
+	require.NoError(t, notifier.Notify(context.Background(), Alert{Condition: JobErrors, Title: "outbox failing", Fields: map[string]string{"job": "outbox"}}))
+	assert.Equal(t, "[job_errors] outbox failing\n\njob: outbox", body["content"])
+
+	_, err = NewWebhookNotifier(server.URL, "teams")
+	assert.Error(t, err)
+}
+
+func TestEmailNotifier(t *testing.T) {
+	notifier, err := NewEmailNotifier(EmailConfig{Addr: "smtp.example.com:587", Username: "ops", Password: "secret", From: "alerts@example.com", To: []string{"ops@example.com"}})
+	require.NoError(t, err)
+
+	var sent string
+	notifier.send = func(addr string, auth smtp.Auth, from string, to []string, msg []byte) error {
+		assert.Equal(t, "smtp.example.com:587", addr)
+		assert.NotNil(t, auth)
+		sent = string(msg)
+		return nil
+	}
+	require.NoError(t, notifier.Notify(context.Background(), Alert{Condition: PoolExhausted, Title: "pool exhausted", At: time.Now()}))
+	assert.True(t, strings.Contains(sent, "Subject: [VoidMesh alert] pool exhausted\r\n"))
+
+	_, err = NewEmailNotifier(EmailConfig{Addr: "smtp.example.com:587", From: "alerts@example.com", To: []string{""}})
+	assert.Error(t, err)
+}
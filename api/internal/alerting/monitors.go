@@ -0,0 +1,116 @@
+package alerting
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+// PoolStats is the part of *pgxpool.Stat the pool monitor reads
+type PoolStats interface {
+	AcquiredConns() int32
+	MaxConns() int32
+}
+
+// WatchPool alerts when every connection in the pool stays acquired for
+// PoolExhaustedSamples consecutive samples. stat is typically pool.Stat wrapped in a closure.
+func (a *Alerter) WatchPool(stat func() PoolStats) {
+	a.addMonitor(&poolMonitor{stat: stat, samples: a.config.PoolExhaustedSamples})
+}
+
+type poolMonitor struct {
+	stat      func() PoolStats
+	samples   int
+	exhausted int
+}
+
+func (m *poolMonitor) sample(now time.Time) *Alert {
+	stat := m.stat()
+	if stat.AcquiredConns() < stat.MaxConns() {
+		m.exhausted = 0
+		return nil
+	}
+	m.exhausted++
+	if m.exhausted < m.samples {
+		return nil
+	}
+	return &Alert{
+		Condition: PoolExhausted,
+		Title:     "Database connection pool exhausted",
+		Message:   fmt.Sprintf("All %d connections were in use for %d consecutive samples; requests are waiting for connections", stat.MaxConns(), m.exhausted),
+		Fields:    map[string]string{"max_conns": fmt.Sprint(stat.MaxConns())},
+	}
+}
+
+// ErrorRate counts RPC outcomes for spike detection
+type ErrorRate struct {
+	requests atomic.Int64
+	errors   atomic.Int64
+}
+
+// Record counts one RPC. Only codes that indicate a server fault count as errors;
+// client mistakes such as InvalidArgument or NotFound do not.
+func (r *ErrorRate) Record(code codes.Code) {
+	r.requests.Add(1)
+	switch code {
+	case codes.Internal, codes.Unknown, codes.Unavailable, codes.DataLoss:
+		r.errors.Add(1)
+	}
+}
+
+// WatchErrorRate returns a recorder whose error rate is checked every interval. An
+// interval is a spike when its rate reaches ErrorRateThreshold or ErrorRateFactor times
+// the baseline, a moving average of previous intervals.
+func (a *Alerter) WatchErrorRate() *ErrorRate {
+	rate := &ErrorRate{}
+	a.addMonitor(&errorRateMonitor{
+		rate:        rate,
+		minRequests: int64(a.config.ErrorRateMinRequests),
+		threshold:   a.config.ErrorRateThreshold,
+		factor:      a.config.ErrorRateFactor,
+	})
+	return rate
+}
+
+// baselineWeight is how much each normal interval moves the baseline
+const baselineWeight = 0.1
+
+type errorRateMonitor struct {
+	rate        *ErrorRate
+	minRequests int64
+	threshold   float64
+	factor      float64
+	baseline    float64
+	seeded      bool
+}
+
+func (m *errorRateMonitor) sample(now time.Time) *Alert {
+	requests := m.rate.requests.Swap(0)
+	errors := m.rate.errors.Swap(0)
+	if requests < m.minRequests {
+		return nil
+	}
+	current := float64(errors) / float64(requests)
+
+	spike := current >= m.threshold || (m.seeded && m.baseline > 0 && current >= m.baseline*m.factor)
+	if !spike {
+		// Spikes are kept out of the baseline so a long incident keeps alerting
+		if m.seeded {
+			m.baseline += baselineWeight * (current - m.baseline)
+		} else {
+			m.baseline, m.seeded = current, true
+		}
+		return nil
+	}
+	return &Alert{
+		Condition: ErrorRateSpike,
+		Title:     "RPC error rate spike",
+		Message:   fmt.Sprintf("%.1f%% of %d requests failed with server errors (baseline %.1f%%)", current*100, requests, m.baseline*100),
+		Fields: map[string]string{
+			"requests": fmt.Sprint(requests),
+			"errors":   fmt.Sprint(errors),
+		},
+	}
+}
@@ -0,0 +1,127 @@
+package alerting
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// Webhook formats
+const (
+	FormatSlack   = "slack"
+	FormatDiscord = "discord"
+)
+
+// WebhookNotifier posts alerts to a Slack or Discord incoming webhook
+type WebhookNotifier struct {
+	url    string
+	format string
+	client *http.Client
+}
+
+// NewWebhookNotifier creates a notifier for the webhook URL; format is FormatSlack or FormatDiscord
+func NewWebhookNotifier(url, format string) (*WebhookNotifier, error) {
+	if format != FormatSlack && format != FormatDiscord {
+		return nil, fmt.Errorf("unknown webhook format %q", format)
+	}
+	return &WebhookNotifier{url: url, format: format, client: &http.Client{Timeout: 10 * time.Second}}, nil
+}
+
+// Notify posts the alert text
+func (w *WebhookNotifier) Notify(ctx context.Context, alert Alert) error {
+	// Slack reads "text" and Discord reads "content"
+	field := "text"
+	if w.format == FormatDiscord {
+		field = "content"
+	}
+	body, err := json.Marshal(map[string]string{field: alert.Text()})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+// EmailConfig describes the SMTP server and recipients for email alerts
+type EmailConfig struct {
+	Addr     string // host:port
+	Username string // Optional; PLAIN auth is used when set
+	Password string
+	From     string
+	To       []string
+}
+
+// EmailNotifier sends alerts by email
+type EmailNotifier struct {
+	config EmailConfig
+	send   func(addr string, auth smtp.Auth, from string, to []string, msg []byte) error
+}
+
+// NewEmailNotifier creates a notifier sending through the configured SMTP server
+func NewEmailNotifier(config EmailConfig) (*EmailNotifier, error) {
+	var to []string
+	for _, recipient := range config.To {
+		if recipient = strings.TrimSpace(recipient); recipient != "" {
+			to = append(to, recipient)
+		}
+	}
+	config.To = to
+	if config.Addr == "" || config.From == "" || len(config.To) == 0 {
+		return nil, fmt.Errorf("email alerts need an SMTP address, a sender and at least one recipient")
+	}
+	return &EmailNotifier{config: config, send: smtp.SendMail}, nil
+}
+
+// Notify sends the alert as a plain-text email
+func (e *EmailNotifier) Notify(ctx context.Context, alert Alert) error {
+	var auth smtp.Auth
+	if e.config.Username != "" {
+		host := e.config.Addr
+		if i := strings.LastIndex(host, ":"); i >= 0 {
+			host = host[:i]
+		}
+		auth = smtp.PlainAuth("", e.config.Username, e.config.Password, host)
+	}
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", e.config.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(e.config.To, ", "))
+	fmt.Fprintf(&msg, "Subject: [VoidMesh alert] %s\r\n", alert.Title)
+	fmt.Fprintf(&msg, "Date: %s\r\n", alert.At.Format(time.RFC1123Z))
+	msg.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	msg.WriteString(strings.ReplaceAll(alert.Text(), "\n", "\r\n"))
+	msg.WriteString("\r\n")
+
+	// net/smtp has no context support, so the send runs until it finishes or fails
+	done := make(chan error, 1)
+	go func() {
+		done <- e.send(e.config.Addr, auth, e.config.From, e.config.To, msg.Bytes())
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			return fmt.Errorf("failed to send alert email: %w", err)
+		}
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("failed to send alert email: %w", ctx.Err())
+	}
+}
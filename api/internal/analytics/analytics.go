@@ -0,0 +1,365 @@
+// Package analytics exports gameplay events for offline analysis. An Exporter buffers
+// records from the event bus, sampled character moves and play sessions, and on a schedule
+// writes them as gzipped newline-delimited JSON files to a Sink (a local directory or an
+// S3-compatible bucket), so the data team never has to query the live database.
+//
+// Every record carries SchemaVersion and files are written under a v<SchemaVersion>/
+// prefix. Adding fields is backwards compatible; renaming or removing one, or changing
+// its meaning, requires bumping SchemaVersion so old and new files are never mixed.
+package analytics
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/alerting"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/debugstats"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/presence"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	"github.com/charmbracelet/log"
+)
+
+// SchemaVersion is the version of the record layout written by this build
+const SchemaVersion = 1
+
+// Record types that are not domain events
+const (
+	CharacterMoved = "character.moved"
+	SessionEnded   = "session.ended"
+)
+
+// Record is one line of an export file
+type Record struct {
+	SchemaVersion int             `json:"schema_version"`
+	Type          string          `json:"type"`
+	OccurredAt    time.Time       `json:"occurred_at"`
+	Data          json.RawMessage `json:"data"`
+}
+
+// MovementSample is the data of a CharacterMoved record
+type MovementSample struct {
+	CharacterID string `json:"character_id"`
+	UserID      string `json:"user_id"`
+	WorldID     string `json:"world_id"`
+	X           int32  `json:"x"`
+	Y           int32  `json:"y"`
+	ChunkX      int32  `json:"chunk_x"`
+	ChunkY      int32  `json:"chunk_y"`
+}
+
+// Session is the data of a SessionEnded record. A session lasts from a user's first
+// request on this instance until they are no longer online.
+type Session struct {
+	UserID          string    `json:"user_id"`
+	StartedAt       time.Time `json:"started_at"`
+	EndedAt         time.Time `json:"ended_at"`
+	DurationSeconds int64     `json:"duration_seconds"`
+}
+
+// Config tunes the exporter
+type Config struct {
+	FlushInterval      time.Duration // how often buffered records are written
+	MaxBatch           int           // a file is written early once this many records are buffered
+	MaxBuffered        int           // records kept while the sink is failing; the oldest are dropped beyond this
+	MoveSampleInterval time.Duration // at most one movement sample per character per interval
+}
+
+// DefaultConfig writes a file every 5 minutes and samples each character's position at
+// most every 30 seconds
+func DefaultConfig() Config {
+	return Config{
+		FlushInterval:      5 * time.Minute,
+		MaxBatch:           10000,
+		MaxBuffered:        100000,
+		MoveSampleInterval: 30 * time.Second,
+	}
+}
+
+type openSession struct {
+	startedAt time.Time
+	lastSeen  time.Time
+}
+
+// Exporter buffers analytics records and writes them to a sink. It is safe for concurrent use.
+type Exporter struct {
+	sink   Sink
+	config Config
+	clock  clock.Clock
+	logger *log.Logger
+	writer string // distinguishes this instance's files from other instances'
+
+	flushMu     sync.Mutex // one flush at a time, so no record is written twice
+	mu          sync.Mutex
+	buffer      []Record
+	seq         int
+	lastSampled map[string]time.Time
+	sessions    map[string]*openSession
+	online      func(userID string) bool
+	full        chan struct{}
+}
+
+// NewExporter creates an exporter writing to sink
+func NewExporter(sink Sink, config Config) *Exporter {
+	id := make([]byte, 4)
+	_, _ = rand.Read(id)
+	e := &Exporter{
+		sink:        sink,
+		config:      config,
+		clock:       clock.New(),
+		logger:      logging.WithComponent("analytics-exporter"),
+		writer:      hex.EncodeToString(id),
+		lastSampled: make(map[string]time.Time),
+		sessions:    make(map[string]*openSession),
+		full:        make(chan struct{}, 1),
+	}
+	debugstats.Register(debugstats.Queues, "analytics.buffered_records", func() int64 {
+		e.mu.Lock()
+		defer e.mu.Unlock()
+		return int64(len(e.buffer))
+	})
+	return e
+}
+
+// SetClock replaces the clock used for timestamps and the flush schedule (for simulation tests)
+func (e *Exporter) SetClock(c clock.Clock) {
+	e.clock = c
+}
+
+// Subscribe exports the domain events that describe player behaviour
+func (e *Exporter) Subscribe(bus *events.Bus) {
+	for _, eventType := range []string{
+		events.CharacterCreated,
+		events.ResourceHarvested,
+		events.ItemCrafted,
+		events.QuestCompleted,
+		events.TradeCompleted,
+		events.FriendRequestAccepted,
+		events.ExperimentAssigned,
+	} {
+		bus.Subscribe(eventType, events.Dedup(e.handleEvent, events.DefaultDedupCapacity))
+	}
+}
+
+// handleEvent exports the event payload as is; payload changes are schema changes
+func (e *Exporter) handleEvent(ctx context.Context, event events.Event) error {
+	e.add(Record{Type: event.Type, OccurredAt: event.OccurredAt, Data: event.Payload})
+	return nil
+}
+
+// RecordMove samples a character move. The character service calls it after every
+// successful move.
+func (e *Exporter) RecordMove(ctx context.Context, character db.Character) {
+	now := e.clock.Now()
+	key := uuid.PgtypeToNormalizedString(character.ID)
+	e.mu.Lock()
+	if last, ok := e.lastSampled[key]; ok && now.Sub(last) < e.config.MoveSampleInterval {
+		e.mu.Unlock()
+		return
+	}
+	e.lastSampled[key] = now
+	e.mu.Unlock()
+
+	e.addData(CharacterMoved, now, MovementSample{
+		CharacterID: uuid.PgtypeToString(character.ID),
+		UserID:      uuid.PgtypeToString(character.UserID),
+		WorldID:     uuid.PgtypeToString(character.WorldID),
+		X:           character.X,
+		Y:           character.Y,
+		ChunkX:      character.ChunkX,
+		ChunkY:      character.ChunkY,
+	})
+}
+
+// TrackSessions records play sessions from the tracker's activity. A session is closed
+// at the first flush after the tracker reports the user offline.
+func (e *Exporter) TrackSessions(tracker *presence.Tracker) {
+	e.mu.Lock()
+	e.online = tracker.Online
+	e.mu.Unlock()
+	tracker.SetObserver(e.seen)
+}
+
+// seen extends the user's open session, starting one if needed
+func (e *Exporter) seen(userID string) {
+	now := e.clock.Now()
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if session, ok := e.sessions[userID]; ok {
+		session.lastSeen = now
+		return
+	}
+	e.sessions[userID] = &openSession{startedAt: now, lastSeen: now}
+}
+
+// closeSessions turns sessions of users who went offline, or every session when all is
+// set, into records
+func (e *Exporter) closeSessions(all bool) {
+	e.mu.Lock()
+	online := e.online
+	var ended []Session
+	for userID, session := range e.sessions {
+		if !all && online != nil && online(userID) {
+			continue
+		}
+		delete(e.sessions, userID)
+		id, err := uuid.StringToPgtype(userID)
+		if err != nil {
+			continue
+		}
+		ended = append(ended, Session{
+			UserID:          uuid.PgtypeToString(id),
+			StartedAt:       session.startedAt,
+			EndedAt:         session.lastSeen,
+			DurationSeconds: int64(session.lastSeen.Sub(session.startedAt) / time.Second),
+		})
+	}
+	e.mu.Unlock()
+
+	for _, session := range ended {
+		e.addData(SessionEnded, session.EndedAt, session)
+	}
+}
+
+func (e *Exporter) addData(recordType string, at time.Time, data any) {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		e.logger.Error("Failed to encode analytics record", "type", recordType, "error", err)
+		return
+	}
+	e.add(Record{Type: recordType, OccurredAt: at, Data: encoded})
+}
+
+func (e *Exporter) add(record Record) {
+	record.SchemaVersion = SchemaVersion
+	record.OccurredAt = record.OccurredAt.UTC()
+	e.mu.Lock()
+	e.buffer = append(e.buffer, record)
+	full := len(e.buffer) >= e.config.MaxBatch
+	e.mu.Unlock()
+	if full {
+		select {
+		case e.full <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Run writes buffered records every flush interval, or sooner when a batch fills up,
+// until ctx is cancelled. Open sessions are closed and written before it returns.
+func (e *Exporter) Run(ctx context.Context) {
+	e.logger.Info("Analytics exporter started", "flush_interval", e.config.FlushInterval, "schema_version", SchemaVersion)
+	for {
+		select {
+		case <-ctx.Done():
+			e.closeSessions(true)
+			// The job context is already cancelled, so the last flush gets its own
+			flushCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			if _, err := e.Flush(flushCtx); err != nil {
+				e.logger.Error("Final analytics export failed", "error", err)
+			}
+			cancel()
+			e.logger.Info("Analytics exporter stopped")
+			return
+		case <-e.clock.After(e.config.FlushInterval):
+			e.closeSessions(false)
+			e.pruneSamples()
+		case <-e.full:
+		}
+
+		if _, err := e.Flush(ctx); err != nil {
+			e.logger.Error("Analytics export failed", "error", err)
+			alerting.ReportJobError("analytics_export", err)
+		}
+	}
+}
+
+// pruneSamples forgets characters whose next move would be sampled anyway
+func (e *Exporter) pruneSamples() {
+	now := e.clock.Now()
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for key, last := range e.lastSampled {
+		if now.Sub(last) >= e.config.MoveSampleInterval {
+			delete(e.lastSampled, key)
+		}
+	}
+}
+
+// Flush writes buffered records as files of at most MaxBatch records and returns how
+// many were written. Records that fail to write stay buffered for the next flush.
+func (e *Exporter) Flush(ctx context.Context) (int, error) {
+	e.flushMu.Lock()
+	defer e.flushMu.Unlock()
+
+	written := 0
+	for {
+		e.mu.Lock()
+		n := min(len(e.buffer), e.config.MaxBatch)
+		batch := e.buffer[:n:n]
+		e.seq++
+		seq := e.seq
+		e.mu.Unlock()
+		if n == 0 {
+			return written, nil
+		}
+
+		name := e.fileName(seq)
+		data, err := encode(batch)
+		if err == nil {
+			err = e.sink.Put(ctx, name, data)
+		}
+		if err != nil {
+			e.dropOverflow()
+			return written, fmt.Errorf("failed to write %s: %w", name, err)
+		}
+
+		e.mu.Lock()
+		e.buffer = e.buffer[n:]
+		e.mu.Unlock()
+		written += n
+		e.logger.Debug("Analytics batch written", "file", name, "records", n)
+	}
+}
+
+// dropOverflow discards the oldest records beyond MaxBuffered while the sink is failing
+func (e *Exporter) dropOverflow() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if over := len(e.buffer) - e.config.MaxBuffered; over > 0 {
+		e.buffer = e.buffer[over:]
+		e.logger.Warn("Dropped analytics records while the sink is failing", "dropped", over)
+	}
+}
+
+// fileName partitions files by schema version and UTC date
+func (e *Exporter) fileName(seq int) string {
+	now := e.clock.Now().UTC()
+	return fmt.Sprintf("v%d/dt=%s/%s-%s-%06d.ndjson.gz", SchemaVersion, now.Format(time.DateOnly), now.Format("150405"), e.writer, seq)
+}
+
+// encode writes records as gzipped newline-delimited JSON
+func encode(records []Record) ([]byte, error) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	encoder := json.NewEncoder(gz)
+	for _, record := range records {
+		if err := encoder.Encode(record); err != nil {
+			return nil, err
+		}
+	}
+	if err := gz.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,167 @@
+package analytics
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Sink stores export files
+type Sink interface {
+	// Put writes a complete file. name is a slash-separated relative path.
+	Put(ctx context.Context, name string, data []byte) error
+}
+
+// DirSink writes files under a local directory, e.g. one synced to a data lake
+type DirSink struct {
+	dir string
+}
+
+// NewDirSink creates a sink writing under dir
+func NewDirSink(dir string) *DirSink {
+	return &DirSink{dir: dir}
+}
+
+// Put writes the file atomically, so readers never see a partial file
+func (d *DirSink) Put(ctx context.Context, name string, data []byte) error {
+	target := filepath.Join(d.dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return err
+	}
+	tmp := target + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, target)
+}
+
+// S3Config locates an S3-compatible bucket (AWS S3, MinIO, R2, ...)
+type S3Config struct {
+	Endpoint  string // e.g. https://s3.us-east-1.amazonaws.com
+	Region    string
+	Bucket    string
+	Prefix    string // prepended to every file name
+	AccessKey string
+	SecretKey string
+}
+
+// S3Sink uploads files to an S3-compatible bucket with path-style requests signed with
+// AWS Signature Version 4
+type S3Sink struct {
+	config S3Config
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewS3Sink creates a sink uploading to the configured bucket
+func NewS3Sink(config S3Config) *S3Sink {
+	config.Endpoint = strings.TrimRight(config.Endpoint, "/")
+	return &S3Sink{config: config, client: &http.Client{Timeout: time.Minute}, now: time.Now}
+}
+
+// Put uploads the file with a single PUT
+func (s *S3Sink) Put(ctx context.Context, name string, data []byte) error {
+	key := path.Join(s.config.Prefix, name)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.config.Endpoint, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to build upload request: %w", err)
+	}
+	req.URL.Path = req.URL.Path + "/" + s.config.Bucket + "/" + key
+	req.URL.RawPath = canonicalPath(req.URL.Path)
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	req.Header.Set("Content-Encoding", "gzip")
+	sum := sha256.Sum256(data)
+	signV4(req, hex.EncodeToString(sum[:]), s.config.Region, "s3", s.config.AccessKey, s.config.SecretKey, s.now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("upload request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("upload returned %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// signV4 adds AWS Signature Version 4 headers to req, signing every header already set
+// along with host, x-amz-content-sha256 and x-amz-date
+func signV4(req *http.Request, payloadHash, region, service, accessKey, secretKey string, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		canonicalPath(req.URL.Path),
+		req.URL.Query().Encode(),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	scope := date + "/" + region + "/" + service + "/aws4_request"
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(requestHash[:])
+
+	key := hmacSHA256([]byte("AWS4"+secretKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s", accessKey, scope, signedHeaders, signature))
+}
+
+// canonicalPath percent-encodes everything but unreserved characters and slashes, as
+// SigV4 requires for S3. Requests are sent with the same encoding so the signature matches.
+func canonicalPath(p string) string {
+	if p == "" {
+		return "/"
+	}
+	var b strings.Builder
+	for i := 0; i < len(p); i++ {
+		c := p[i]
+		if c == '/' || c == '-' || c == '_' || c == '.' || c == '~' ||
+			'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,135 @@
+// Package apikey defines the API key credential used by server-to-server integrations:
+// the token format, how secrets are hashed for storage, and the scopes that limit a key
+// to read-only RPCs.
+//
+// A token looks like vmk_<key id>_<secret>. The key ID is public and identifies the
+// stored key; the secret is only ever shown once, when the key is created.
+package apikey
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"slices"
+	"strings"
+)
+
+// Prefix starts every API key token, telling it apart from a JWT
+const Prefix = "vmk_"
+
+const (
+	keyIDBytes  = 6
+	secretBytes = 32
+)
+
+// ErrMalformed is returned for tokens that are not in the API key format
+var ErrMalformed = errors.New("malformed API key")
+
+// IsToken reports whether a bearer token is an API key rather than a JWT
+func IsToken(token string) bool {
+	return strings.HasPrefix(token, Prefix)
+}
+
+// Generate creates a new key, returning the token to hand out, its public key ID and
+// the hash of its secret to store
+func Generate() (token, keyID string, secretHash []byte, err error) {
+	id := make([]byte, keyIDBytes)
+	secret := make([]byte, secretBytes)
+	if _, err := rand.Read(id); err != nil {
+		return "", "", nil, err
+	}
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", nil, err
+	}
+	keyID = hex.EncodeToString(id)
+	encoded := base64.RawURLEncoding.EncodeToString(secret)
+	return Prefix + keyID + "_" + encoded, keyID, HashSecret(encoded), nil
+}
+
+// Parse splits a token into its key ID and secret
+func Parse(token string) (keyID, secret string, err error) {
+	rest, ok := strings.CutPrefix(token, Prefix)
+	if !ok {
+		return "", "", ErrMalformed
+	}
+	keyID, secret, ok = strings.Cut(rest, "_")
+	if !ok || len(keyID) != 2*keyIDBytes || secret == "" {
+		return "", "", ErrMalformed
+	}
+	return keyID, secret, nil
+}
+
+// HashSecret hashes the secret part of a token for storage. Secrets are random and long,
+// so a fast hash is enough; there is nothing to brute-force.
+func HashSecret(secret string) []byte {
+	sum := sha256.Sum256([]byte(secret))
+	return sum[:]
+}
+
+// SecretMatches compares a presented secret with a stored hash in constant time
+func SecretMatches(secret string, hash []byte) bool {
+	return subtle.ConstantTimeCompare(HashSecret(secret), hash) == 1
+}
+
+// Scopes grant read access to groups of RPCs
+const (
+	ScopeWorldRead    = "world:read"
+	ScopeChunkRead    = "chunk:read"
+	ScopeResourceRead = "resource:read"
+	ScopeTerrainRead  = "terrain:read"
+)
+
+// scopeMethods lists the RPCs each scope allows. Only RPCs that read shared world data
+// belong here; anything acting as or on behalf of a player stays JWT-only.
+var scopeMethods = map[string][]string{
+	ScopeWorldRead: {
+		"/world.v1.WorldService/GetWorld",
+		"/world.v1.WorldService/GetDefaultWorld",
+		"/world.v1.WorldService/ListWorlds",
+		"/world.v2.WorldService/GetWorld",
+		"/world.v2.WorldService/GetDefaultWorld",
+		"/world.v2.WorldService/ListWorlds",
+	},
+	ScopeChunkRead: {
+		"/chunk.v1.ChunkService/GetChunk",
+		"/chunk.v1.ChunkService/GetChunks",
+		"/chunk.v1.ChunkService/GetChunksInRadius",
+		"/chunk.v1.ChunkService/GetChunkSummaries",
+		"/chunk.v1.ChunkService/GetCells",
+		"/chunk.v2.ChunkService/GetChunk",
+		"/chunk.v2.ChunkService/GetChunks",
+		"/chunk.v2.ChunkService/GetChunksInRadius",
+		"/chunk.v2.ChunkService/GetChunkSummaries",
+		"/chunk.v2.ChunkService/GetCells",
+	},
+	ScopeResourceRead: {
+		"/resource_node.v1.ResourceNodeService/GetResourcesInChunk",
+		"/resource_node.v1.ResourceNodeService/GetResourcesInChunks",
+		"/resource_node.v1.ResourceNodeService/GetResourceNodeTypes",
+		"/resource_node.v2.ResourceNodeService/GetResourcesInChunk",
+		"/resource_node.v2.ResourceNodeService/GetResourcesInChunks",
+		"/resource_node.v2.ResourceNodeService/GetResourceNodeTypes",
+	},
+	ScopeTerrainRead: {
+		"/terrain.v1.TerrainService/GetTerrainTypes",
+	},
+}
+
+// ValidScope reports whether scope is one keys can be granted
+func ValidScope(scope string) bool {
+	_, ok := scopeMethods[scope]
+	return ok
+}
+
+// Allows reports whether any of scopes grants access to the RPC
+func Allows(scopes []string, method string) bool {
+	for _, scope := range scopes {
+		if slices.Contains(scopeMethods[scope], method) {
+			return true
+		}
+	}
+	return false
+}
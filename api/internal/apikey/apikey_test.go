@@ -0,0 +1,50 @@
+package apikey
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateAndParse(t *testing.T) {
+	token, keyID, hash, err := Generate()
+	require.NoError(t, err)
+	assert.True(t, IsToken(token))
+
+	parsedID, secret, err := Parse(token)
+	require.NoError(t, err)
+	assert.Equal(t, keyID, parsedID)
+	assert.True(t, SecretMatches(secret, hash))
+	assert.False(t, SecretMatches(secret+"x", hash))
+
+	other, _, _, err := Generate()
+	require.NoError(t, err)
+	assert.NotEqual(t, token, other)
+}
+
+func TestParse_Malformed(t *testing.T) {
+	for _, token := range []string{
+		"",
+		"eyJhbGciOiJIUzI1NiJ9.e30.sig",
+		"vmk_",
+		"vmk_abc_secret",
+		"vmk_0123456789ab",
+		"vmk_0123456789ab_",
+	} {
+		_, _, err := Parse(token)
+		assert.ErrorIs(t, err, ErrMalformed, token)
+	}
+}
+
+func TestAllows(t *testing.T) {
+	scopes := []string{ScopeChunkRead}
+	assert.True(t, Allows(scopes, "/chunk.v1.ChunkService/GetChunks"))
+	assert.False(t, Allows(scopes, "/world.v1.WorldService/ListWorlds"))
+	assert.False(t, Allows(scopes, "/character.v1.CharacterService/MoveCharacter"))
+	assert.False(t, Allows([]string{"admin"}, "/admin.v1.AdminService/ListApiKeys"))
+	assert.False(t, Allows(nil, "/chunk.v1.ChunkService/GetChunks"))
+
+	assert.True(t, ValidScope(ScopeTerrainRead))
+	assert.False(t, ValidScope("chunk:write"))
+}
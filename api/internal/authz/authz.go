@@ -0,0 +1,57 @@
+package authz
+
+import (
+	"context"
+	"errors"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	"github.com/VoidMesh/api/api/pkg/uuidutil"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CharacterLoader loads characters by ID; every service database has it
+type CharacterLoader interface {
+	GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error)
+}
+
+// Logger records the failures that are hidden from callers
+type Logger interface {
+	Error(msg string, keysAndValues ...interface{})
+}
+
+// OwnedCharacter loads a character, failing unless it belongs to the user and is in the
+// session's world
+func OwnedCharacter(ctx context.Context, characters CharacterLoader, logger Logger, userID, characterID string) (db.Character, error) {
+	id, err := uuidutil.Parse(characterID)
+	if err != nil {
+		return db.Character{}, status.Errorf(codes.InvalidArgument, "invalid character ID format")
+	}
+	character, err := characters.GetCharacterById(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return db.Character{}, status.Errorf(codes.NotFound, "character not found")
+	}
+	if err != nil {
+		logger.Error("Failed to get character", "character_id", characterID, "error", err)
+		return db.Character{}, status.Errorf(codes.Internal, "failed to get character")
+	}
+	if err := CheckOwner(character, userID); err != nil {
+		return db.Character{}, err
+	}
+	if err := session.RequireWorld(ctx, character.WorldID); err != nil {
+		return db.Character{}, err
+	}
+	return character, nil
+}
+
+// CheckOwner fails with PermissionDenied unless the character belongs to the user
+func CheckOwner(character db.Character, userID string) error {
+	if !uuid.Compare(uuidutil.String(character.UserID), userID) {
+		return status.Errorf(codes.PermissionDenied, "character does not belong to user")
+	}
+	return nil
+}
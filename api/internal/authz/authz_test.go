@@ -0,0 +1,81 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	"github.com/VoidMesh/api/api/pkg/uuidutil"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	characterID = "550e8400-e29b-41d4-a716-446655440000"
+	userID      = "450e8400-e29b-41d4-a716-446655440000"
+	worldID     = "650e8400-e29b-41d4-a716-446655440000"
+)
+
+// brokenCharacters fails every lookup
+type brokenCharacters struct{}
+
+func (brokenCharacters) GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error) {
+	return db.Character{}, errors.New("connection lost")
+}
+
+type logger struct{ errors int }
+
+func (l *logger) Error(msg string, keysAndValues ...interface{}) { l.errors++ }
+
+func mustUUID(t *testing.T, s string) pgtype.UUID {
+	t.Helper()
+	id, err := uuidutil.Parse(s)
+	require.NoError(t, err)
+	return id
+}
+
+func TestOwnedCharacter(t *testing.T) {
+	character := db.Character{ID: mustUUID(t, characterID), UserID: mustUUID(t, userID), WorldID: mustUUID(t, worldID)}
+	inWorld := session.WithWorldID(context.Background(), worldID)
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		characters  CharacterLoader
+		userID      string
+		characterID string
+		code        codes.Code
+	}{
+		{"owned character in the session's world", inWorld, testmocks.NewCharacters(character), userID, characterID, codes.OK},
+		{"invalid ID", inWorld, testmocks.NewCharacters(character), userID, "not-a-uuid", codes.InvalidArgument},
+		{"unknown character", inWorld, testmocks.NewCharacters(character), userID, "750e8400-e29b-41d4-a716-446655440000", codes.NotFound},
+		{"database failure", inWorld, brokenCharacters{}, userID, characterID, codes.Internal},
+		{"another user's character", inWorld, testmocks.NewCharacters(character), "850e8400-e29b-41d4-a716-446655440000", characterID, codes.PermissionDenied},
+		{"unbound session", context.Background(), testmocks.NewCharacters(character), userID, characterID, codes.PermissionDenied},
+		{"another world", session.WithWorldID(context.Background(), "950e8400-e29b-41d4-a716-446655440000"), testmocks.NewCharacters(character), userID, characterID, codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log := &logger{}
+			got, err := OwnedCharacter(tt.ctx, tt.characters, log, tt.userID, tt.characterID)
+			assert.Equal(t, tt.code, status.Code(err))
+			if tt.code == codes.OK {
+				assert.Equal(t, character, got)
+			}
+			assert.Equal(t, tt.code == codes.Internal, log.errors == 1, "only unexpected failures are logged")
+		})
+	}
+}
+
+func TestCheckOwner(t *testing.T) {
+	character := db.Character{UserID: mustUUID(t, userID)}
+	assert.NoError(t, CheckOwner(character, userID))
+	assert.NoError(t, CheckOwner(character, "450e8400e29b41d4a716446655440000"), "IDs compare with or without dashes")
+	assert.Equal(t, codes.PermissionDenied, status.Code(CheckOwner(character, "850e8400-e29b-41d4-a716-446655440000")))
+}
@@ -0,0 +1,168 @@
+// Package bandwidth accounts for the bytes the server streams to each client connection.
+// Every connection gets a token bucket; streaming handlers check it and degrade what they
+// send, rather than queueing more than a slow (often mobile) client can take.
+package bandwidth
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/debugstats"
+)
+
+const (
+	DefaultBytesPerSecond = 64 * 1024  // Sustained rate per connection
+	DefaultBurst          = 256 * 1024 // Bytes a connection may send at once after being idle
+)
+
+// Budget limits what one connection is sent: a sustained rate plus a burst allowance
+type Budget struct {
+	BytesPerSecond int
+	Burst          int
+}
+
+// DefaultBudget comfortably fits a mobile connection
+func DefaultBudget() Budget {
+	return Budget{BytesPerSecond: DefaultBytesPerSecond, Burst: DefaultBurst}
+}
+
+// Meter tracks the bytes sent on one connection against its budget. It is safe for
+// concurrent use by every stream on the connection.
+type Meter struct {
+	mu     sync.Mutex
+	clock  clock.Clock
+	budget Budget
+	tokens float64
+	last   time.Time
+	sent   int64
+}
+
+// NewMeter creates a meter with a full burst allowance
+func NewMeter(budget Budget, c clock.Clock) *Meter {
+	return &Meter{
+		clock:  c,
+		budget: budget,
+		tokens: float64(budget.Burst),
+		last:   c.Now(),
+	}
+}
+
+// Record accounts for n bytes sent. The balance may go negative; the meter stays
+// exceeded until the rate has paid the debt back.
+func (m *Meter) Record(n int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.refill()
+	m.tokens -= float64(n)
+	m.sent += int64(n)
+}
+
+// Exceeded reports whether the connection has used up its budget
+func (m *Meter) Exceeded() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.refill()
+	return m.tokens <= 0
+}
+
+// Sent returns the total bytes recorded
+func (m *Meter) Sent() int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.sent
+}
+
+// refill adds the tokens earned since the last call. The caller holds mu.
+func (m *Meter) refill() {
+	now := m.clock.Now()
+	m.tokens += now.Sub(m.last).Seconds() * float64(m.budget.BytesPerSecond)
+	if m.tokens > float64(m.budget.Burst) {
+		m.tokens = float64(m.budget.Burst)
+	}
+	m.last = now
+}
+
+// Registry hands out one meter per connection, shared by all of its open streams
+type Registry struct {
+	mu     sync.Mutex
+	clock  clock.Clock
+	budget Budget
+	meters map[string]*registered
+}
+
+type registered struct {
+	meter   *Meter
+	streams int
+}
+
+// NewRegistry creates a registry giving every connection the same budget
+func NewRegistry(budget Budget) *Registry {
+	r := &Registry{
+		clock:  clock.New(),
+		budget: budget,
+		meters: make(map[string]*registered),
+	}
+	debugstats.Register(debugstats.StreamSubscriptions, "bandwidth.metered_connections", func() int64 {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		return int64(len(r.meters))
+	})
+	debugstats.Register(debugstats.StreamSubscriptions, "bandwidth.over_budget_connections", func() int64 {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		var over int64
+		for _, entry := range r.meters {
+			if entry.meter.Exceeded() {
+				over++
+			}
+		}
+		return over
+	})
+	return r
+}
+
+// SetClock replaces the clock used by meters created afterwards (for simulation tests)
+func (r *Registry) SetClock(c clock.Clock) {
+	r.mu.Lock()
+	r.clock = c
+	r.mu.Unlock()
+}
+
+// Acquire returns the meter of a connection for a new stream on it. The meter is
+// forgotten once every stream that acquired it has called release.
+func (r *Registry) Acquire(connection string) (*Meter, func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	entry, ok := r.meters[connection]
+	if !ok {
+		entry = &registered{meter: NewMeter(r.budget, r.clock)}
+		r.meters[connection] = entry
+	}
+	entry.streams++
+
+	var once sync.Once
+	return entry.meter, func() {
+		once.Do(func() {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			if entry.streams--; entry.streams <= 0 {
+				delete(r.meters, connection)
+			}
+		})
+	}
+}
+
+type meterKey struct{}
+
+// WithMeter returns a context carrying the connection's meter
+func WithMeter(ctx context.Context, meter *Meter) context.Context {
+	return context.WithValue(ctx, meterKey{}, meter)
+}
+
+// FromContext returns the connection's meter, or nil when the stream is not metered
+func FromContext(ctx context.Context) *Meter {
+	meter, _ := ctx.Value(meterKey{}).(*Meter)
+	return meter
+}
@@ -0,0 +1,60 @@
+package bandwidth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMeter(t *testing.T) {
+	fake := clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	meter := NewMeter(Budget{BytesPerSecond: 1000, Burst: 2000}, fake)
+
+	meter.Record(1500)
+	assert.False(t, meter.Exceeded(), "within the burst")
+	meter.Record(1000)
+	assert.True(t, meter.Exceeded())
+	assert.Equal(t, int64(2500), meter.Sent())
+
+	// 500 bytes of debt take half a second to pay back
+	fake.Advance(400 * time.Millisecond)
+	assert.True(t, meter.Exceeded())
+	fake.Advance(200 * time.Millisecond)
+	assert.False(t, meter.Exceeded())
+
+	// An idle connection earns no more than the burst
+	fake.Advance(time.Hour)
+	meter.Record(2000)
+	assert.True(t, meter.Exceeded())
+}
+
+func TestRegistry_SharesMeterPerConnection(t *testing.T) {
+	registry := NewRegistry(DefaultBudget())
+
+	a, releaseA := registry.Acquire("10.0.0.1:5000")
+	b, releaseB := registry.Acquire("10.0.0.1:5000")
+	other, releaseOther := registry.Acquire("10.0.0.2:5000")
+	defer releaseOther()
+	assert.Same(t, a, b, "streams on one connection share a budget")
+	assert.NotSame(t, a, other)
+
+	releaseA()
+	releaseA()
+	c, releaseC := registry.Acquire("10.0.0.1:5000")
+	assert.Same(t, a, c, "the meter lives while any stream is open")
+	releaseB()
+	releaseC()
+
+	d, releaseD := registry.Acquire("10.0.0.1:5000")
+	defer releaseD()
+	assert.NotSame(t, a, d, "a new connection on the same address starts fresh")
+}
+
+func TestFromContext(t *testing.T) {
+	assert.Nil(t, FromContext(context.Background()))
+	meter := NewMeter(DefaultBudget(), clock.New())
+	assert.Same(t, meter, FromContext(WithMeter(context.Background(), meter)))
+}
@@ -0,0 +1,266 @@
+// Package bootstrap wires the server's components. Constructors are registered by the
+// type they produce and built lazily on first use, so registration order does not
+// matter and every component is built once. Components with background work or
+// resources to release register lifecycle hooks, which Start runs in registration
+// order and Stop runs in reverse.
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/alerting"
+	"github.com/VoidMesh/api/api/internal/debugstats"
+	"github.com/charmbracelet/log"
+)
+
+// Hook is a component's lifecycle. Either function may be nil.
+type Hook struct {
+	Name    string
+	OnStart func(ctx context.Context) error
+	OnStop  func(ctx context.Context) error
+}
+
+type provider struct {
+	name      string
+	construct func(c *Container) (any, error)
+	value     any
+	err       error
+	built     bool
+	resolving bool
+}
+
+// Container holds the registered constructors, the components built from them and
+// their lifecycle hooks. Resolve and Must are safe for concurrent use.
+type Container struct {
+	logger *log.Logger
+
+	mu        sync.Mutex
+	providers map[reflect.Type]*provider
+	hooks     []Hook
+	started   int // Hooks whose OnStart succeeded
+}
+
+// New creates an empty container
+func New(logger *log.Logger) *Container {
+	return &Container{
+		logger:    logger,
+		providers: make(map[reflect.Type]*provider),
+	}
+}
+
+// resolveError carries a resolution failure out of a constructor that called Must
+type resolveError struct{ err error }
+
+// Provide registers the constructor for T. Registering a type twice is a programming
+// error and panics.
+func Provide[T any](c *Container, name string, construct func(c *Container) (T, error)) {
+	key := reflect.TypeFor[T]()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if existing, ok := c.providers[key]; ok {
+		panic(fmt.Sprintf("bootstrap: %s is already provided by %s", key, existing.name))
+	}
+	c.providers[key] = &provider{
+		name: name,
+		construct: func(c *Container) (any, error) {
+			return construct(c)
+		},
+	}
+}
+
+// Supply registers an already built value for T, e.g. configuration
+func Supply[T any](c *Container, name string, value T) {
+	Provide(c, name, func(*Container) (T, error) { return value, nil })
+}
+
+// Resolve returns the component for T, building it and its dependencies on first use.
+// A failed constructor is not retried.
+func Resolve[T any](c *Container) (T, error) {
+	var zero T
+	key := reflect.TypeFor[T]()
+
+	c.mu.Lock()
+	p, ok := c.providers[key]
+	if !ok {
+		c.mu.Unlock()
+		return zero, fmt.Errorf("bootstrap: nothing provides %s", key)
+	}
+	if p.built {
+		c.mu.Unlock()
+		if p.err != nil {
+			return zero, p.err
+		}
+		return p.value.(T), nil
+	}
+	if p.resolving {
+		c.mu.Unlock()
+		return zero, fmt.Errorf("bootstrap: dependency cycle through %s (%s)", p.name, key)
+	}
+	p.resolving = true
+	c.mu.Unlock()
+
+	// Constructors resolve their own dependencies, so the lock is not held while they run
+	start := time.Now()
+	value, err := construct(c, p)
+
+	c.mu.Lock()
+	p.resolving = false
+	p.built = true
+	p.value, p.err = value, err
+	c.mu.Unlock()
+
+	if err != nil {
+		return zero, err
+	}
+	c.logger.Debug("Component constructed", "component", p.name, "duration", time.Since(start))
+	return value.(T), nil
+}
+
+// construct runs a provider's constructor, naming the component in any failure
+func construct(c *Container, p *provider) (value any, err error) {
+	err = Invoke(c, func(c *Container) error {
+		var err error
+		value, err = p.construct(c)
+		return err
+	})
+	if err != nil {
+		err = fmt.Errorf("%s: %w", p.name, err)
+	}
+	return value, err
+}
+
+// Must is Resolve for use inside constructors: a failure is returned as the error of the
+// Resolve call that triggered the constructor. Outside a constructor it panics.
+func Must[T any](c *Container) T {
+	value, err := Resolve[T](c)
+	if err != nil {
+		panic(resolveError{err: err})
+	}
+	return value
+}
+
+// Invoke runs fn, which may call Must, returning a resolution failure inside it as an error
+func Invoke(c *Container, fn func(c *Container) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			failure, ok := r.(resolveError)
+			if !ok {
+				panic(r)
+			}
+			err = failure.err
+		}
+	}()
+	return fn(c)
+}
+
+// Append registers a lifecycle hook. Hooks start in the order they are appended.
+func (c *Container) Append(hook Hook) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.hooks = append(c.hooks, hook)
+}
+
+// Go registers a background job that runs from Start until Stop. Its context is
+// cancelled on Stop, which waits for it to return. A panicking job is logged and
+// reported to alerting instead of crashing the server. Whether the job is running is
+// reported in the debug service's background job gauges.
+func (c *Container) Go(name string, run func(ctx context.Context)) {
+	var (
+		cancel  context.CancelFunc
+		done    chan struct{}
+		running atomic.Int64
+	)
+	debugstats.Register(debugstats.BackgroundJobs, name, running.Load)
+	c.Append(Hook{
+		Name: name,
+		OnStart: func(context.Context) error {
+			// Jobs outlive the start context, so they get their own
+			var ctx context.Context
+			ctx, cancel = context.WithCancel(context.Background())
+			done = make(chan struct{})
+			running.Store(1)
+			go func() {
+				defer close(done)
+				defer running.Store(0)
+				defer func() {
+					if r := recover(); r != nil {
+						err := fmt.Errorf("panic: %v", r)
+						c.logger.Error("Background job crashed", "job", name, "error", err)
+						alerting.ReportJobError(name, err)
+					}
+				}()
+				run(ctx)
+			}()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			cancel()
+			select {
+			case <-done:
+				return nil
+			case <-ctx.Done():
+				return fmt.Errorf("job did not stop: %w", ctx.Err())
+			}
+		},
+	})
+}
+
+// Start runs every OnStart hook in order. If one fails the hooks already started are
+// stopped in reverse order and the error is returned.
+func (c *Container) Start(ctx context.Context) error {
+	c.mu.Lock()
+	hooks := c.hooks
+	c.mu.Unlock()
+
+	for i, hook := range hooks {
+		if hook.OnStart != nil {
+			start := time.Now()
+			if err := hook.OnStart(ctx); err != nil {
+				c.logger.Error("Component failed to start", "component", hook.Name, "error", err)
+				c.setStarted(i)
+				return errors.Join(fmt.Errorf("%s: %w", hook.Name, err), c.Stop(ctx))
+			}
+			c.logger.Debug("Component started", "component", hook.Name, "duration", time.Since(start))
+		}
+	}
+	c.setStarted(len(hooks))
+	c.logger.Info("All components started", "count", len(hooks))
+	return nil
+}
+
+// Stop runs the OnStop hooks of started components in reverse order and returns every
+// error. Calling it again is a no-op.
+func (c *Container) Stop(ctx context.Context) error {
+	c.mu.Lock()
+	hooks := c.hooks[:c.started]
+	c.started = 0
+	c.mu.Unlock()
+
+	var errs []error
+	for i := len(hooks) - 1; i >= 0; i-- {
+		hook := hooks[i]
+		if hook.OnStop == nil {
+			continue
+		}
+		start := time.Now()
+		if err := hook.OnStop(ctx); err != nil {
+			c.logger.Error("Component failed to stop", "component", hook.Name, "error", err)
+			errs = append(errs, fmt.Errorf("%s: %w", hook.Name, err))
+			continue
+		}
+		c.logger.Debug("Component stopped", "component", hook.Name, "duration", time.Since(start))
+	}
+	return errors.Join(errs...)
+}
+
+func (c *Container) setStarted(n int) {
+	c.mu.Lock()
+	c.started = n
+	c.mu.Unlock()
+}
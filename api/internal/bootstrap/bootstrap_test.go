@@ -0,0 +1,172 @@
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/debugstats"
+	"github.com/charmbracelet/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type config struct{ name string }
+
+type repository struct{ config config }
+
+type service struct{ repository *repository }
+
+func newTestContainer() *Container {
+	return New(log.New(io.Discard))
+}
+
+func TestResolve_BuildsDependenciesOnce(t *testing.T) {
+	c := newTestContainer()
+	builds := 0
+	Provide(c, "service", func(c *Container) (*service, error) {
+		return &service{repository: Must[*repository](c)}, nil
+	})
+	Provide(c, "repository", func(c *Container) (*repository, error) {
+		builds++
+		return &repository{config: Must[config](c)}, nil
+	})
+	Supply(c, "config", config{name: "test"})
+
+	first, err := Resolve[*service](c)
+	require.NoError(t, err)
+	assert.Equal(t, "test", first.repository.config.name)
+
+	second, err := Resolve[*service](c)
+	require.NoError(t, err)
+	assert.Same(t, first, second)
+	assert.Equal(t, 1, builds)
+}
+
+func TestResolve_Errors(t *testing.T) {
+	c := newTestContainer()
+	_, err := Resolve[config](c)
+	assert.ErrorContains(t, err, "nothing provides")
+
+	// A missing dependency fails the component that needed it
+	Provide(c, "service", func(c *Container) (*service, error) {
+		return &service{repository: Must[*repository](c)}, nil
+	})
+	_, err = Resolve[*service](c)
+	assert.ErrorContains(t, err, "nothing provides *bootstrap.repository")
+
+	boom := errors.New("boom")
+	Provide(c, "repository", func(c *Container) (*repository, error) { return nil, boom })
+	_, err = Resolve[*repository](c)
+	assert.ErrorIs(t, err, boom)
+	assert.ErrorContains(t, err, "repository: boom")
+
+	assert.Panics(t, func() { Supply(c, "config again", &repository{}) }, "duplicate registration")
+}
+
+func TestInvoke(t *testing.T) {
+	c := newTestContainer()
+	Supply(c, "config", config{name: "test"})
+
+	var name string
+	err := Invoke(c, func(c *Container) error {
+		name = Must[config](c).name
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "test", name)
+
+	err = Invoke(c, func(c *Container) error {
+		Must[*service](c)
+		return errors.New("unreachable")
+	})
+	assert.ErrorContains(t, err, "nothing provides *bootstrap.service")
+}
+
+func TestResolve_DetectsCycles(t *testing.T) {
+	c := newTestContainer()
+	Provide(c, "service", func(c *Container) (*service, error) {
+		return &service{repository: Must[*repository](c)}, nil
+	})
+	Provide(c, "repository", func(c *Container) (*repository, error) {
+		Must[*service](c)
+		return &repository{}, nil
+	})
+
+	_, err := Resolve[*service](c)
+	assert.ErrorContains(t, err, "dependency cycle through service")
+}
+
+func TestStartStop_Order(t *testing.T) {
+	c := newTestContainer()
+	var calls []string
+	for _, name := range []string{"pool", "cache", "server"} {
+		c.Append(Hook{
+			Name:    name,
+			OnStart: func(context.Context) error { calls = append(calls, "start "+name); return nil },
+			OnStop:  func(context.Context) error { calls = append(calls, "stop "+name); return nil },
+		})
+	}
+	ctx := context.Background()
+
+	require.NoError(t, c.Start(ctx))
+	require.NoError(t, c.Stop(ctx))
+	require.NoError(t, c.Stop(ctx), "stopping twice is a no-op")
+
+	assert.Equal(t, []string{
+		"start pool", "start cache", "start server",
+		"stop server", "stop cache", "stop pool",
+	}, calls)
+}
+
+func TestStart_RollsBackOnFailure(t *testing.T) {
+	c := newTestContainer()
+	var stopped []string
+	c.Append(Hook{Name: "pool", OnStop: func(context.Context) error { stopped = append(stopped, "pool"); return nil }})
+	c.Append(Hook{Name: "listener", OnStart: func(context.Context) error { return errors.New("address in use") }})
+	c.Append(Hook{Name: "server", OnStop: func(context.Context) error { stopped = append(stopped, "server"); return nil }})
+
+	err := c.Start(context.Background())
+	assert.ErrorContains(t, err, "listener: address in use")
+	assert.Equal(t, []string{"pool"}, stopped, "only components that started are stopped")
+}
+
+func TestGo_CancelsJobsOnStop(t *testing.T) {
+	debugstats.Reset()
+	t.Cleanup(debugstats.Reset)
+	c := newTestContainer()
+	running := make(chan struct{})
+	c.Go("ticker", func(ctx context.Context) {
+		close(running)
+		<-ctx.Done()
+	})
+	c.Go("crashes", func(context.Context) { panic("bad tick") })
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	require.NoError(t, c.Start(ctx))
+	<-running
+	assert.Equal(t, int64(1), debugstats.Snapshot(debugstats.BackgroundJobs)["ticker"])
+	assert.Eventually(t, func() bool {
+		return debugstats.Snapshot(debugstats.BackgroundJobs)["crashes"] == 0
+	}, time.Second, time.Millisecond)
+
+	require.NoError(t, c.Stop(ctx), "a crashed job counts as stopped")
+	assert.Equal(t, int64(0), debugstats.Snapshot(debugstats.BackgroundJobs)["ticker"])
+}
+
+func TestGo_StopTimesOut(t *testing.T) {
+	c := newTestContainer()
+	release := make(chan struct{})
+	defer close(release)
+	c.Go("stuck", func(context.Context) { <-release })
+	require.NoError(t, c.Start(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := c.Stop(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.ErrorContains(t, err, "stuck: job did not stop")
+}
@@ -0,0 +1,46 @@
+// Package chunkdata decodes stored chunk blobs defensively. A blob that does not
+// unmarshal, or unmarshals into something that cannot be the requested chunk, is
+// reported as ErrCorrupt so callers can tell corruption apart from database errors.
+package chunkdata
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/VoidMesh/api/api/internal/geometry"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// Size is the width and height of a chunk in cells
+const Size = geometry.ChunkSize
+
+// ErrCorrupt is wrapped by every error Decode returns
+var ErrCorrupt = errors.New("corrupt chunk data")
+
+// Decode unmarshals a stored blob and checks that it holds every cell of the chunk at
+// (chunkX, chunkY). It never panics.
+func Decode(data []byte, chunkX, chunkY int32) (chunk *chunkV1.ChunkData, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			chunk, err = nil, fmt.Errorf("%w: decoder panicked: %v", ErrCorrupt, r)
+		}
+	}()
+
+	var decoded chunkV1.ChunkData
+	if err := proto.Unmarshal(data, &decoded); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCorrupt, err)
+	}
+	if decoded.ChunkX != chunkX || decoded.ChunkY != chunkY {
+		return nil, fmt.Errorf("%w: blob is for chunk (%d,%d)", ErrCorrupt, decoded.ChunkX, decoded.ChunkY)
+	}
+	if len(decoded.Cells) != Size*Size {
+		return nil, fmt.Errorf("%w: %d cells, want %d", ErrCorrupt, len(decoded.Cells), Size*Size)
+	}
+	for i, cell := range decoded.Cells {
+		if cell == nil {
+			return nil, fmt.Errorf("%w: cell %d is missing", ErrCorrupt, i)
+		}
+	}
+	return &decoded, nil
+}
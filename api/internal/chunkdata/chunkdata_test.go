@@ -0,0 +1,76 @@
+package chunkdata
+
+import (
+	"testing"
+
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func encode(t *testing.T, chunkX, chunkY int32, cells int) []byte {
+	t.Helper()
+	chunk := &chunkV1.ChunkData{ChunkX: chunkX, ChunkY: chunkY, Cells: make([]*chunkV1.TerrainCell, cells)}
+	for i := range chunk.Cells {
+		chunk.Cells[i] = &chunkV1.TerrainCell{TerrainType: chunkV1.TerrainType_TERRAIN_TYPE_GRASS}
+	}
+	data, err := proto.Marshal(chunk)
+	require.NoError(t, err)
+	return data
+}
+
+func TestDecode(t *testing.T) {
+	chunk, err := Decode(encode(t, 2, -3, Size*Size), 2, -3)
+	require.NoError(t, err)
+	assert.Len(t, chunk.Cells, Size*Size)
+
+	valid := encode(t, 2, -3, Size*Size)
+	tests := []struct {
+		name string
+		data []byte
+		want string
+	}{
+		{name: "garbage", data: []byte{0xff, 0xff, 0xff}, want: "corrupt chunk data"},
+		{name: "truncated", data: valid[:len(valid)/2], want: "corrupt chunk data"},
+		{name: "empty", data: nil, want: "blob is for chunk (0,0)"},
+		{name: "other chunk", data: encode(t, 2, 3, Size*Size), want: "blob is for chunk (2,3)"},
+		{name: "missing cells", data: encode(t, 2, -3, 10), want: "10 cells, want 1024"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chunk, err := Decode(tt.data, 2, -3)
+			assert.Nil(t, chunk)
+			assert.ErrorIs(t, err, ErrCorrupt)
+			assert.ErrorContains(t, err, tt.want)
+		})
+	}
+}
+
+func TestPassability(t *testing.T) {
+	cells := make([]*chunkV1.TerrainCell, Size*Size)
+	for i := range cells {
+		cells[i] = &chunkV1.TerrainCell{TerrainType: chunkV1.TerrainType_TERRAIN_TYPE_GRASS}
+	}
+	cells[9].TerrainType = chunkV1.TerrainType_TERRAIN_TYPE_WATER
+	cells[Size*Size-1].TerrainType = chunkV1.TerrainType_TERRAIN_TYPE_STONE
+
+	mask := NewPassability(cells)
+	require.Len(t, mask, PassabilityBytes)
+	assert.True(t, mask.Passable(0))
+	assert.False(t, mask.Passable(9))
+	assert.Equal(t, byte(0xff&^(1<<1)), mask[1], "cell 9 is bit 1 of byte 1, as set_bit numbers it")
+	assert.False(t, mask.Passable(Size*Size-1))
+	assert.False(t, mask.Passable(Size*Size), "cells outside the chunk are not passable")
+	assert.False(t, mask.Passable(-1))
+
+	mask.Set(9, true)
+	mask.Set(0, false)
+	assert.True(t, mask.Passable(9))
+	assert.False(t, mask.Passable(0))
+
+	_, err := DecodePassability(mask)
+	require.NoError(t, err)
+	_, err = DecodePassability(mask[:10])
+	assert.ErrorIs(t, err, ErrCorrupt)
+}
@@ -0,0 +1,40 @@
+package chunkdata
+
+import (
+	"math"
+
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+)
+
+const (
+	// SeaLevel is the quantized elevation generated water lies around
+	SeaLevel = 89
+
+	// Crops grow on soil that is neither arid nor waterlogged, below the highlands
+	cropMinMoisture  = 64
+	cropMaxMoisture  = 224
+	cropMaxElevation = 200
+)
+
+// Quantize packs a noise value in [-1, 1] into a byte-sized cell attribute (0-255).
+// Values outside the range are clamped.
+func Quantize(v float64) uint32 {
+	q := math.Round((v + 1) / 2 * 255)
+	return uint32(max(0, min(255, q)))
+}
+
+// Dequantize is the inverse of Quantize, accurate to within 1/255
+func Dequantize(q uint32) float64 {
+	return float64(min(q, 255))/255*2 - 1
+}
+
+// CropViable reports whether crops can grow on a cell: grass or dirt, moist enough but
+// not waterlogged, and below the highlands
+func CropViable(cell *chunkV1.TerrainCell) bool {
+	switch cell.TerrainType {
+	case chunkV1.TerrainType_TERRAIN_TYPE_GRASS, chunkV1.TerrainType_TERRAIN_TYPE_DIRT:
+	default:
+		return false
+	}
+	return cell.Moisture >= cropMinMoisture && cell.Moisture <= cropMaxMoisture && cell.Elevation <= cropMaxElevation
+}
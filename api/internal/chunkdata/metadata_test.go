@@ -0,0 +1,41 @@
+package chunkdata
+
+import (
+	"testing"
+
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuantize(t *testing.T) {
+	assert.Equal(t, uint32(0), Quantize(-1))
+	assert.Equal(t, uint32(128), Quantize(0))
+	assert.Equal(t, uint32(255), Quantize(1))
+	assert.Equal(t, uint32(0), Quantize(-3), "out of range values are clamped")
+	assert.Equal(t, uint32(255), Quantize(2))
+	assert.Equal(t, uint32(SeaLevel), Quantize(-0.3))
+
+	for _, v := range []float64{-1, -0.3, 0, 0.42, 1} {
+		assert.InDelta(t, v, Dequantize(Quantize(v)), 1.0/255)
+	}
+}
+
+func TestCropViable(t *testing.T) {
+	tests := []struct {
+		name string
+		cell *chunkV1.TerrainCell
+		want bool
+	}{
+		{"moist grass", &chunkV1.TerrainCell{TerrainType: chunkV1.TerrainType_TERRAIN_TYPE_GRASS, Elevation: 130, Moisture: 150}, true},
+		{"moist dirt", &chunkV1.TerrainCell{TerrainType: chunkV1.TerrainType_TERRAIN_TYPE_DIRT, Elevation: 170, Moisture: 64}, true},
+		{"sand", &chunkV1.TerrainCell{TerrainType: chunkV1.TerrainType_TERRAIN_TYPE_SAND, Elevation: 95, Moisture: 150}, false},
+		{"arid", &chunkV1.TerrainCell{TerrainType: chunkV1.TerrainType_TERRAIN_TYPE_GRASS, Elevation: 130, Moisture: 40}, false},
+		{"waterlogged", &chunkV1.TerrainCell{TerrainType: chunkV1.TerrainType_TERRAIN_TYPE_GRASS, Elevation: 130, Moisture: 240}, false},
+		{"highland", &chunkV1.TerrainCell{TerrainType: chunkV1.TerrainType_TERRAIN_TYPE_DIRT, Elevation: 210, Moisture: 150}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, CropViable(tt.cell))
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package chunkdata
+
+import (
+	"fmt"
+
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+)
+
+// PassabilityBytes is the length of a chunk's passability mask
+const PassabilityBytes = Size * Size / 8
+
+// Passability is a chunk's walkable cells as a bit mask, one bit per cell in row-major
+// order. Cell i is bit i%8 of byte i/8, counting from the least significant bit, which
+// is the numbering PostgreSQL's set_bit uses, so single cells can be updated in SQL.
+type Passability []byte
+
+// Walkable reports whether characters can stand on a terrain type
+func Walkable(terrain chunkV1.TerrainType) bool {
+	switch terrain {
+	case chunkV1.TerrainType_TERRAIN_TYPE_GRASS,
+		chunkV1.TerrainType_TERRAIN_TYPE_SAND,
+		chunkV1.TerrainType_TERRAIN_TYPE_DIRT:
+		return true
+	default:
+		// Water, stone and unknown terrain
+		return false
+	}
+}
+
+// BlocksSight reports whether a terrain type hides what is behind it
+func BlocksSight(terrain chunkV1.TerrainType) bool {
+	return terrain == chunkV1.TerrainType_TERRAIN_TYPE_STONE
+}
+
+// NewPassability builds the mask for a chunk's cells
+func NewPassability(cells []*chunkV1.TerrainCell) Passability {
+	mask := make(Passability, PassabilityBytes)
+	for i, cell := range cells {
+		if i >= Size*Size {
+			break
+		}
+		if cell != nil && Walkable(cell.TerrainType) {
+			mask.Set(int32(i), true)
+		}
+	}
+	return mask
+}
+
+// DecodePassability checks a stored mask's length
+func DecodePassability(data []byte) (Passability, error) {
+	if len(data) != PassabilityBytes {
+		return nil, fmt.Errorf("%w: passability mask is %d bytes, want %d", ErrCorrupt, len(data), PassabilityBytes)
+	}
+	return Passability(data), nil
+}
+
+// Passable reports whether the cell at row-major index is walkable; cells outside the
+// chunk are not
+func (p Passability) Passable(index int32) bool {
+	if index < 0 || int(index)/8 >= len(p) {
+		return false
+	}
+	return p[index/8]&(1<<(index%8)) != 0
+}
+
+// Set marks the cell at row-major index walkable or not
+func (p Passability) Set(index int32, passable bool) {
+	if index < 0 || int(index)/8 >= len(p) {
+		return
+	}
+	if passable {
+		p[index/8] |= 1 << (index % 8)
+	} else {
+		p[index/8] &^= 1 << (index % 8)
+	}
+}
@@ -0,0 +1,349 @@
+// Package chunktemplate loads hand-built chunks — towns, dungeons — that the chunk
+// service serves instead of procedurally generated terrain.
+//
+// Templates are Tiled maps (JSON or TMX) in the shape worldexport writes, so a region
+// can be exported, edited in Tiled and registered back:
+//   - the map is a whole number of chunks wide and high (multiples of 32 cells);
+//   - a tile layer named "terrain" holds the cells. Each tile used must carry an int
+//     property terrain_type, or have a type (class) naming the terrain, e.g. "grass";
+//   - an optional object layer named "resources" places resource nodes. An object's
+//     cell is its position divided by the tile size; its resource type is the int
+//     property resource_node_type_id, or its type (class) or name, e.g. "herb_patch".
+//     Optional properties cluster_id and size default to a cluster of its own and 1.
+//
+// Only embedded tilesets and CSV (or plain JSON array) layer data are supported.
+//
+// A manifest registers templates to chunk coordinates:
+//
+//	templates:
+//	  - file: towns/riverside.tmx
+//	    chunk_x: 4
+//	    chunk_y: -2
+//
+// The chunk at (chunk_x, chunk_y) receives the template's top-left 32x32 cells.
+package chunktemplate
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/VoidMesh/api/api/internal/chunkdata"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	resourceNodeV1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
+	"gopkg.in/yaml.v3"
+)
+
+// Tiled stores flip and rotation flags in the top bits of a global tile ID
+const gidFlags = 0xF0000000
+
+// Layer names templates are read from
+const (
+	terrainLayer  = "terrain"
+	resourceLayer = "resources"
+)
+
+// Resource is a resource node placed in a template, in cells from its top-left corner
+type Resource struct {
+	X         int32
+	Y         int32
+	Type      resourceNodeV1.ResourceNodeTypeId
+	ClusterID string
+	Size      int32
+}
+
+// Template is a parsed map covering one or more whole chunks
+type Template struct {
+	Name         string
+	WidthChunks  int32
+	HeightChunks int32
+	terrain      []chunkV1.TerrainType // row-major over the template's cells
+	Resources    []Resource
+}
+
+// width is the template's width in cells
+func (t *Template) width() int32 {
+	return t.WidthChunks * chunkdata.Size
+}
+
+// Load reads a template file; the format follows the extension (.json, .tmj or .tmx)
+func Load(path string) (*Template, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+
+	var m *tiledMap
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json", ".tmj":
+		m, err = parseJSON(data)
+	case ".tmx":
+		m, err = parseTMX(data)
+	default:
+		return nil, fmt.Errorf("%s: unknown template format, want .json, .tmj or .tmx", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	template, err := m.template(name)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return template, nil
+}
+
+// tiledMap is the part of a Tiled map templates use, whichever format it came from
+type tiledMap struct {
+	width      int32
+	height     int32
+	tileWidth  float64
+	tileHeight float64
+	tiles      map[uint32]tiledTile // by global tile ID
+	terrain    []uint32             // nil without a terrain layer
+	objects    []tiledObject
+}
+
+type tiledTile struct {
+	class      string
+	properties map[string]string
+}
+
+type tiledObject struct {
+	name       string
+	class      string
+	gid        uint32
+	x          float64
+	y          float64
+	properties map[string]string
+}
+
+// template converts a parsed map, validating its size, terrain and resources
+func (m *tiledMap) template(name string) (*Template, error) {
+	if m.width <= 0 || m.height <= 0 || m.width%chunkdata.Size != 0 || m.height%chunkdata.Size != 0 {
+		return nil, fmt.Errorf("map is %dx%d cells, want multiples of %d", m.width, m.height, chunkdata.Size)
+	}
+	if m.tileWidth <= 0 || m.tileHeight <= 0 {
+		return nil, fmt.Errorf("map has no tile size")
+	}
+	if m.terrain == nil {
+		return nil, fmt.Errorf("map has no %q tile layer", terrainLayer)
+	}
+	if len(m.terrain) != int(m.width*m.height) {
+		return nil, fmt.Errorf("%q layer has %d cells, want %d", terrainLayer, len(m.terrain), m.width*m.height)
+	}
+
+	t := &Template{
+		Name:         name,
+		WidthChunks:  m.width / chunkdata.Size,
+		HeightChunks: m.height / chunkdata.Size,
+		terrain:      make([]chunkV1.TerrainType, len(m.terrain)),
+	}
+	for i, gid := range m.terrain {
+		terrain, err := m.terrainType(gid &^ gidFlags)
+		if err != nil {
+			return nil, fmt.Errorf("cell (%d,%d): %w", int32(i)%m.width, int32(i)/m.width, err)
+		}
+		t.terrain[i] = terrain
+	}
+
+	for _, object := range m.objects {
+		resource, err := m.resource(object)
+		if err != nil {
+			return nil, fmt.Errorf("resource %q: %w", object.name, err)
+		}
+		t.Resources = append(t.Resources, resource)
+	}
+	return t, nil
+}
+
+// terrainType looks up the terrain a tile stands for
+func (m *tiledMap) terrainType(gid uint32) (chunkV1.TerrainType, error) {
+	if gid == 0 {
+		return 0, fmt.Errorf("no tile")
+	}
+	tile, ok := m.tiles[gid]
+	if !ok {
+		return 0, fmt.Errorf("tile %d has no terrain_type property or type", gid)
+	}
+	if value, ok := tile.properties["terrain_type"]; ok {
+		var terrain int32
+		if _, err := fmt.Sscan(value, &terrain); err != nil {
+			return 0, fmt.Errorf("tile %d: terrain_type %q is not a number", gid, value)
+		}
+		if _, known := chunkV1.TerrainType_name[terrain]; !known || terrain == 0 {
+			return 0, fmt.Errorf("tile %d: unknown terrain_type %d", gid, terrain)
+		}
+		return chunkV1.TerrainType(terrain), nil
+	}
+	if terrain, ok := chunkV1.TerrainType_value["TERRAIN_TYPE_"+strings.ToUpper(tile.class)]; ok && terrain != 0 {
+		return chunkV1.TerrainType(terrain), nil
+	}
+	return 0, fmt.Errorf("tile %d has no terrain_type property or type", gid)
+}
+
+// resource places an object on its cell
+func (m *tiledMap) resource(object tiledObject) (Resource, error) {
+	// Tile objects are anchored at their bottom-left corner, shapes at their top-left
+	y := object.y
+	if object.gid != 0 {
+		y -= m.tileHeight
+	}
+	r := Resource{
+		X:    int32(object.x / m.tileWidth),
+		Y:    int32(y / m.tileHeight),
+		Size: 1,
+	}
+	if object.x < 0 || y < 0 || r.X >= m.width || r.Y >= m.height {
+		return Resource{}, fmt.Errorf("position (%g,%g) is outside the map", object.x, object.y)
+	}
+
+	if value, ok := object.properties["resource_node_type_id"]; ok {
+		var id int32
+		if _, err := fmt.Sscan(value, &id); err != nil {
+			return Resource{}, fmt.Errorf("resource_node_type_id %q is not a number", value)
+		}
+		r.Type = resourceNodeV1.ResourceNodeTypeId(id)
+	} else {
+		for _, name := range []string{object.class, object.name} {
+			if id, ok := resourceNodeV1.ResourceNodeTypeId_value["RESOURCE_NODE_TYPE_ID_"+strings.ToUpper(name)]; ok {
+				r.Type = resourceNodeV1.ResourceNodeTypeId(id)
+				break
+			}
+		}
+	}
+	if _, known := resourceNodeV1.ResourceNodeTypeId_name[int32(r.Type)]; !known || r.Type == 0 {
+		return Resource{}, fmt.Errorf("unknown resource type; set resource_node_type_id or a type such as \"herb_patch\"")
+	}
+
+	r.ClusterID = object.properties["cluster_id"]
+	if value, ok := object.properties["size"]; ok {
+		if _, err := fmt.Sscan(value, &r.Size); err != nil || r.Size <= 0 {
+			return Resource{}, fmt.Errorf("size %q is not a positive number", value)
+		}
+	}
+	return r, nil
+}
+
+// Set holds the templates registered by a manifest, by the chunks they cover
+type Set struct {
+	chunks map[[2]int32]placement
+}
+
+// placement is one chunk of a registered template
+type placement struct {
+	template *Template
+	// offset of the chunk within the template, in chunks
+	offsetX, offsetY int32
+}
+
+// Entry registers a template file at a chunk coordinate
+type Entry struct {
+	File   string `yaml:"file"`
+	ChunkX int32  `yaml:"chunk_x"`
+	ChunkY int32  `yaml:"chunk_y"`
+}
+
+type manifest struct {
+	Templates []Entry `yaml:"templates"`
+}
+
+// LoadManifest reads a manifest and every template it lists. Template paths are
+// relative to the manifest's directory.
+func LoadManifest(path string) (*Set, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var m manifest
+	if err := yaml.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	set := NewSet()
+	for _, entry := range m.Templates {
+		file := entry.File
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(filepath.Dir(path), file)
+		}
+		template, err := Load(file)
+		if err != nil {
+			return nil, err
+		}
+		if err := set.Add(template, entry.ChunkX, entry.ChunkY); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	return set, nil
+}
+
+// NewSet creates an empty set
+func NewSet() *Set {
+	return &Set{chunks: make(map[[2]int32]placement)}
+}
+
+// Add registers a template with its top-left chunk at (chunkX, chunkY). Templates may
+// not overlap.
+func (s *Set) Add(t *Template, chunkX, chunkY int32) error {
+	for dy := int32(0); dy < t.HeightChunks; dy++ {
+		for dx := int32(0); dx < t.WidthChunks; dx++ {
+			if existing, ok := s.chunks[[2]int32{chunkX + dx, chunkY + dy}]; ok {
+				return fmt.Errorf("template %q overlaps %q at chunk (%d,%d)", t.Name, existing.template.Name, chunkX+dx, chunkY+dy)
+			}
+		}
+	}
+	for dy := int32(0); dy < t.HeightChunks; dy++ {
+		for dx := int32(0); dx < t.WidthChunks; dx++ {
+			s.chunks[[2]int32{chunkX + dx, chunkY + dy}] = placement{template: t, offsetX: dx, offsetY: dy}
+		}
+	}
+	return nil
+}
+
+// Len is the number of chunks covered by templates
+func (s *Set) Len() int {
+	return len(s.chunks)
+}
+
+// Chunk returns the authored terrain and resource nodes of a chunk, in world
+// coordinates, or false when no template covers it
+func (s *Set) Chunk(chunkX, chunkY int32) (*chunkV1.ChunkData, bool) {
+	p, ok := s.chunks[[2]int32{chunkX, chunkY}]
+	if !ok {
+		return nil, false
+	}
+	t := p.template
+	left, top := p.offsetX*chunkdata.Size, p.offsetY*chunkdata.Size
+
+	cells := make([]*chunkV1.TerrainCell, chunkdata.Size*chunkdata.Size)
+	for y := int32(0); y < chunkdata.Size; y++ {
+		for x := int32(0); x < chunkdata.Size; x++ {
+			cells[y*chunkdata.Size+x] = &chunkV1.TerrainCell{TerrainType: t.terrain[(top+y)*t.width()+left+x]}
+		}
+	}
+
+	var nodes []*resourceNodeV1.ResourceNode
+	for _, r := range t.Resources {
+		if r.X < left || r.X >= left+chunkdata.Size || r.Y < top || r.Y >= top+chunkdata.Size {
+			continue
+		}
+		worldX := chunkX*chunkdata.Size + r.X - left
+		worldY := chunkY*chunkdata.Size + r.Y - top
+		clusterID := r.ClusterID
+		if clusterID == "" {
+			clusterID = fmt.Sprintf("template:%d:%d", worldX, worldY)
+		}
+		nodes = append(nodes, &resourceNodeV1.ResourceNode{
+			ResourceNodeTypeId: r.Type,
+			ResourceNodeType:   &resourceNodeV1.ResourceNodeType{Id: int32(r.Type)},
+			ChunkX:             chunkX,
+			ChunkY:             chunkY,
+			X:                  worldX,
+			Y:                  worldY,
+			ClusterId:          clusterID,
+			Size:               r.Size,
+		})
+	}
+	return &chunkV1.ChunkData{ChunkX: chunkX, ChunkY: chunkY, Cells: cells, ResourceNodes: nodes}, true
+}
@@ -0,0 +1,161 @@
+package chunktemplate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/VoidMesh/api/api/internal/chunkdata"
+	"github.com/VoidMesh/api/api/internal/worldexport"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	resourceNodeV1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// exportedRegion writes two chunks side by side, stone on the left and sand on the
+// right, with a herb patch in the right chunk, as worldexport renders them
+func exportedRegion(t *testing.T, dir, format, name string) string {
+	t.Helper()
+	chunk := func(chunkX int32, terrain chunkV1.TerrainType, nodes ...*resourceNodeV1.ResourceNode) *chunkV1.ChunkData {
+		cells := make([]*chunkV1.TerrainCell, chunkdata.Size*chunkdata.Size)
+		for i := range cells {
+			cells[i] = &chunkV1.TerrainCell{TerrainType: terrain}
+		}
+		return &chunkV1.ChunkData{ChunkX: chunkX, Cells: cells, ResourceNodes: nodes}
+	}
+	herb := &resourceNodeV1.ResourceNode{
+		ResourceNodeTypeId: resourceNodeV1.ResourceNodeTypeId_RESOURCE_NODE_TYPE_ID_HERB_PATCH,
+		ChunkX:             11,
+		X:                  11*chunkdata.Size + 5,
+		Y:                  6,
+		ClusterId:          "well",
+		Size:               3,
+	}
+	region, err := worldexport.NewRegion("Test World", 10, 0, 11, 0, []*chunkV1.ChunkData{
+		chunk(10, chunkV1.TerrainType_TERRAIN_TYPE_STONE),
+		chunk(11, chunkV1.TerrainType_TERRAIN_TYPE_SAND, herb),
+	})
+	require.NoError(t, err)
+
+	path := filepath.Join(dir, name)
+	file, err := os.Create(path)
+	require.NoError(t, err)
+	defer file.Close()
+	require.NoError(t, worldexport.Write(file, region, format))
+	return path
+}
+
+func TestLoad_ExportedMaps(t *testing.T) {
+	dir := t.TempDir()
+	for format, name := range map[string]string{worldexport.FormatTiledJSON: "town.json", worldexport.FormatTMX: "town.tmx"} {
+		t.Run(format, func(t *testing.T) {
+			template, err := Load(exportedRegion(t, dir, format, name))
+			require.NoError(t, err)
+			assert.Equal(t, "town", template.Name)
+			assert.Equal(t, int32(2), template.WidthChunks)
+			assert.Equal(t, int32(1), template.HeightChunks)
+			assert.Equal(t, []Resource{{
+				X:         chunkdata.Size + 5,
+				Y:         6,
+				Type:      resourceNodeV1.ResourceNodeTypeId_RESOURCE_NODE_TYPE_ID_HERB_PATCH,
+				ClusterID: "well",
+				Size:      3,
+			}}, template.Resources)
+		})
+	}
+}
+
+func TestLoad_Errors(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+		return path
+	}
+
+	_, err := Load(write("small.json", `{"width": 10, "height": 32, "tilewidth": 16, "tileheight": 16}`))
+	assert.ErrorContains(t, err, "want multiples of 32")
+
+	_, err = Load(write("external.json", `{"width": 32, "height": 32, "tilewidth": 16, "tileheight": 16,
+		"tilesets": [{"firstgid": 1, "source": "terrain.tsj"}]}`))
+	assert.ErrorContains(t, err, "external tileset")
+
+	_, err = Load(write("nolayer.tmx", `<map width="32" height="32" tilewidth="16" tileheight="16"></map>`))
+	assert.ErrorContains(t, err, `no "terrain" tile layer`)
+
+	_, err = Load(write("town.tiff", ""))
+	assert.ErrorContains(t, err, "unknown template format")
+}
+
+func TestTiledMap_TerrainAndResources(t *testing.T) {
+	m := &tiledMap{
+		width:      chunkdata.Size,
+		height:     chunkdata.Size,
+		tileWidth:  16,
+		tileHeight: 16,
+		tiles: map[uint32]tiledTile{
+			1: {class: "water"},
+			2: {properties: map[string]string{"terrain_type": "5"}},
+		},
+		terrain: make([]uint32, chunkdata.Size*chunkdata.Size),
+		objects: []tiledObject{{name: "ore", gid: 3, x: 32, y: 48, properties: map[string]string{"resource_node_type_id": "1"}}},
+	}
+	for i := range m.terrain {
+		m.terrain[i] = 1
+	}
+	m.terrain[1] = 2 | 0x80000000
+
+	template, err := m.template("cave")
+	require.NoError(t, err)
+	assert.Equal(t, chunkV1.TerrainType_TERRAIN_TYPE_WATER, template.terrain[0], "tiles can be named by type")
+	assert.Equal(t, chunkV1.TerrainType_TERRAIN_TYPE_DIRT, template.terrain[1], "flip flags are ignored")
+	require.Len(t, template.Resources, 1)
+	assert.Equal(t, Resource{X: 2, Y: 2, Type: 1, Size: 1}, template.Resources[0], "tile objects are anchored at their bottom edge")
+
+	m.terrain[2] = 7
+	_, err = m.template("cave")
+	assert.ErrorContains(t, err, "cell (2,0): tile 7 has no terrain_type")
+
+	m.terrain[2] = 1
+	m.objects[0].properties = nil
+	_, err = m.template("cave")
+	assert.ErrorContains(t, err, "unknown resource type")
+}
+
+func TestLoadManifest(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "towns"), 0o755))
+	exportedRegion(t, filepath.Join(dir, "towns"), worldexport.FormatTMX, "riverside.tmx")
+	manifest := filepath.Join(dir, "templates.yaml")
+	require.NoError(t, os.WriteFile(manifest, []byte("templates:\n  - file: towns/riverside.tmx\n    chunk_x: -3\n    chunk_y: 2\n"), 0o644))
+
+	set, err := LoadManifest(manifest)
+	require.NoError(t, err)
+	assert.Equal(t, 2, set.Len())
+
+	_, ok := set.Chunk(-1, 2)
+	assert.False(t, ok)
+
+	left, ok := set.Chunk(-3, 2)
+	require.True(t, ok)
+	assert.Equal(t, chunkV1.TerrainType_TERRAIN_TYPE_STONE, left.Cells[0].TerrainType)
+	assert.Empty(t, left.ResourceNodes)
+
+	right, ok := set.Chunk(-2, 2)
+	require.True(t, ok)
+	assert.Len(t, right.Cells, chunkdata.Size*chunkdata.Size)
+	assert.Equal(t, chunkV1.TerrainType_TERRAIN_TYPE_SAND, right.Cells[0].TerrainType)
+	require.Len(t, right.ResourceNodes, 1)
+	node := right.ResourceNodes[0]
+	assert.Equal(t, int32(-2*chunkdata.Size+5), node.X, "nodes are placed in world coordinates")
+	assert.Equal(t, int32(2*chunkdata.Size+6), node.Y)
+	assert.Equal(t, int32(-2), node.ChunkX)
+	assert.Equal(t, int32(resourceNodeV1.ResourceNodeTypeId_RESOURCE_NODE_TYPE_ID_HERB_PATCH), node.ResourceNodeType.Id)
+	assert.Equal(t, "well", node.ClusterId)
+
+	require.NoError(t, os.WriteFile(manifest, []byte(
+		"templates:\n  - file: towns/riverside.tmx\n    chunk_x: 0\n    chunk_y: 0\n  - file: towns/riverside.tmx\n    chunk_x: 1\n    chunk_y: 0\n"), 0o644))
+	_, err = LoadManifest(manifest)
+	assert.ErrorContains(t, err, `template "riverside" overlaps "riverside" at chunk (1,0)`)
+}
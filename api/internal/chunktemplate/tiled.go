@@ -0,0 +1,233 @@
+package chunktemplate
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// JSON map format
+
+type jsonProperty struct {
+	Name  string          `json:"name"`
+	Value json.RawMessage `json:"value"`
+}
+
+type jsonObject struct {
+	Name       string         `json:"name"`
+	Type       string         `json:"type"`
+	Class      string         `json:"class"`
+	GID        uint32         `json:"gid"`
+	X          float64        `json:"x"`
+	Y          float64        `json:"y"`
+	Properties []jsonProperty `json:"properties"`
+}
+
+type jsonMap struct {
+	Width      int32   `json:"width"`
+	Height     int32   `json:"height"`
+	TileWidth  float64 `json:"tilewidth"`
+	TileHeight float64 `json:"tileheight"`
+	Tilesets   []struct {
+		FirstGID uint32 `json:"firstgid"`
+		Source   string `json:"source"`
+		Tiles    []struct {
+			ID         uint32         `json:"id"`
+			Type       string         `json:"type"`
+			Class      string         `json:"class"`
+			Properties []jsonProperty `json:"properties"`
+		} `json:"tiles"`
+	} `json:"tilesets"`
+	Layers []struct {
+		Name     string          `json:"name"`
+		Type     string          `json:"type"`
+		Encoding string          `json:"encoding"`
+		Data     json.RawMessage `json:"data"`
+		Objects  []jsonObject    `json:"objects"`
+	} `json:"layers"`
+}
+
+// jsonProperties flattens properties to strings; string values are unquoted
+func jsonProperties(properties []jsonProperty) map[string]string {
+	values := make(map[string]string, len(properties))
+	for _, p := range properties {
+		var s string
+		if err := json.Unmarshal(p.Value, &s); err == nil {
+			values[p.Name] = s
+		} else {
+			values[p.Name] = string(p.Value)
+		}
+	}
+	return values
+}
+
+func parseJSON(data []byte) (*tiledMap, error) {
+	var j jsonMap
+	if err := json.Unmarshal(data, &j); err != nil {
+		return nil, fmt.Errorf("invalid Tiled JSON: %w", err)
+	}
+	m := &tiledMap{
+		width:      j.Width,
+		height:     j.Height,
+		tileWidth:  j.TileWidth,
+		tileHeight: j.TileHeight,
+		tiles:      make(map[uint32]tiledTile),
+	}
+	for _, tileset := range j.Tilesets {
+		if tileset.Source != "" {
+			return nil, fmt.Errorf("external tileset %q is not supported, embed it in the map", tileset.Source)
+		}
+		for _, tile := range tileset.Tiles {
+			m.tiles[tileset.FirstGID+tile.ID] = tiledTile{
+				class:      firstNonEmpty(tile.Class, tile.Type),
+				properties: jsonProperties(tile.Properties),
+			}
+		}
+	}
+	for _, layer := range j.Layers {
+		switch {
+		case layer.Name == terrainLayer && layer.Type == "tilelayer":
+			if layer.Encoding != "" && layer.Encoding != "csv" {
+				return nil, fmt.Errorf("%q layer uses %s encoding, save it as CSV", terrainLayer, layer.Encoding)
+			}
+			if err := json.Unmarshal(layer.Data, &m.terrain); err != nil {
+				return nil, fmt.Errorf("invalid %q layer data: %w", terrainLayer, err)
+			}
+		case layer.Name == resourceLayer && layer.Type == "objectgroup":
+			for _, object := range layer.Objects {
+				m.objects = append(m.objects, tiledObject{
+					name:       object.Name,
+					class:      firstNonEmpty(object.Class, object.Type),
+					gid:        object.GID,
+					x:          object.X,
+					y:          object.Y,
+					properties: jsonProperties(object.Properties),
+				})
+			}
+		}
+	}
+	return m, nil
+}
+
+// TMX map format
+
+type tmxProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type tmxProperties struct {
+	Properties []tmxProperty `xml:"property"`
+}
+
+func (p tmxProperties) values() map[string]string {
+	values := make(map[string]string, len(p.Properties))
+	for _, property := range p.Properties {
+		values[property.Name] = property.Value
+	}
+	return values
+}
+
+type tmxMap struct {
+	Width      int32   `xml:"width,attr"`
+	Height     int32   `xml:"height,attr"`
+	TileWidth  float64 `xml:"tilewidth,attr"`
+	TileHeight float64 `xml:"tileheight,attr"`
+	Tilesets   []struct {
+		FirstGID uint32 `xml:"firstgid,attr"`
+		Source   string `xml:"source,attr"`
+		Tiles    []struct {
+			ID         uint32        `xml:"id,attr"`
+			Type       string        `xml:"type,attr"`
+			Class      string        `xml:"class,attr"`
+			Properties tmxProperties `xml:"properties"`
+		} `xml:"tile"`
+	} `xml:"tileset"`
+	Layers []struct {
+		Name string `xml:"name,attr"`
+		Data struct {
+			Encoding string `xml:"encoding,attr"`
+			CSV      string `xml:",chardata"`
+		} `xml:"data"`
+	} `xml:"layer"`
+	ObjectGroups []struct {
+		Name    string `xml:"name,attr"`
+		Objects []struct {
+			Name       string        `xml:"name,attr"`
+			Type       string        `xml:"type,attr"`
+			Class      string        `xml:"class,attr"`
+			GID        uint32        `xml:"gid,attr"`
+			X          float64       `xml:"x,attr"`
+			Y          float64       `xml:"y,attr"`
+			Properties tmxProperties `xml:"properties"`
+		} `xml:"object"`
+	} `xml:"objectgroup"`
+}
+
+func parseTMX(data []byte) (*tiledMap, error) {
+	var t tmxMap
+	if err := xml.Unmarshal(data, &t); err != nil {
+		return nil, fmt.Errorf("invalid TMX: %w", err)
+	}
+	m := &tiledMap{
+		width:      t.Width,
+		height:     t.Height,
+		tileWidth:  t.TileWidth,
+		tileHeight: t.TileHeight,
+		tiles:      make(map[uint32]tiledTile),
+	}
+	for _, tileset := range t.Tilesets {
+		if tileset.Source != "" {
+			return nil, fmt.Errorf("external tileset %q is not supported, embed it in the map", tileset.Source)
+		}
+		for _, tile := range tileset.Tiles {
+			m.tiles[tileset.FirstGID+tile.ID] = tiledTile{
+				class:      firstNonEmpty(tile.Class, tile.Type),
+				properties: tile.Properties.values(),
+			}
+		}
+	}
+	for _, layer := range t.Layers {
+		if layer.Name != terrainLayer {
+			continue
+		}
+		if layer.Data.Encoding != "csv" {
+			return nil, fmt.Errorf("%q layer uses %q encoding, save it as CSV", terrainLayer, layer.Data.Encoding)
+		}
+		m.terrain = []uint32{}
+		for _, field := range strings.Split(layer.Data.CSV, ",") {
+			gid, err := strconv.ParseUint(strings.TrimSpace(field), 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %q layer data: %w", terrainLayer, err)
+			}
+			m.terrain = append(m.terrain, uint32(gid))
+		}
+	}
+	for _, group := range t.ObjectGroups {
+		if group.Name != resourceLayer {
+			continue
+		}
+		for _, object := range group.Objects {
+			m.objects = append(m.objects, tiledObject{
+				name:       object.Name,
+				class:      firstNonEmpty(object.Class, object.Type),
+				gid:        object.GID,
+				x:          object.X,
+				y:          object.Y,
+				properties: object.Properties.values(),
+			})
+		}
+	}
+	return m, nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,36 @@
+// Package clock abstracts wall-clock time so time-based game rules (cooldowns,
+// respawns, growth) and background jobs can be driven by a fake clock in tests.
+package clock
+
+import "time"
+
+// Clock tells the time and schedules wake-ups
+type Clock interface {
+	// Now returns the current time
+	Now() time.Time
+
+	// Since returns the time elapsed since t
+	Since(t time.Time) time.Duration
+
+	// After waits for the duration to elapse and then sends the current time on the returned channel
+	After(d time.Duration) <-chan time.Time
+}
+
+type realClock struct{}
+
+// New returns a Clock backed by the system clock
+func New() Clock {
+	return realClock{}
+}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) Since(t time.Time) time.Duration {
+	return time.Since(t)
+}
+
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
@@ -0,0 +1,51 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFake_Advance(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	f := NewFake(start)
+
+	assert.Equal(t, start, f.Now())
+
+	f.Advance(3 * time.Hour)
+	assert.Equal(t, start.Add(3*time.Hour), f.Now())
+	assert.Equal(t, 3*time.Hour, f.Since(start))
+
+	f.Set(start)
+	assert.Equal(t, time.Duration(0), f.Since(start))
+}
+
+func TestFake_After(t *testing.T) {
+	f := NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	short := f.After(time.Minute)
+	long := f.After(time.Hour)
+	assert.Equal(t, 2, f.Waiters())
+
+	f.Advance(59 * time.Second)
+	assert.Empty(t, short, "not due yet")
+
+	f.Advance(time.Second)
+	assert.Len(t, short, 1)
+	assert.Empty(t, long)
+	assert.Equal(t, 1, f.Waiters())
+
+	f.Advance(2 * time.Hour)
+	assert.Len(t, long, 1)
+	assert.Equal(t, 0, f.Waiters())
+
+	assert.Len(t, f.After(0), 1, "non-positive durations fire immediately")
+}
+
+func TestNew(t *testing.T) {
+	c := New()
+	before := time.Now()
+	assert.False(t, c.Now().Before(before))
+	assert.GreaterOrEqual(t, c.Since(before), time.Duration(0))
+}
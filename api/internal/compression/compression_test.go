@@ -0,0 +1,53 @@
+package compression
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/VoidMesh/api/api/internal/debugstats"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/stats"
+)
+
+func TestZstdRoundTrip(t *testing.T) {
+	require.True(t, Registered(Zstd))
+	require.True(t, Registered(Gzip))
+	compressor := encoding.GetCompressor(Zstd)
+
+	// Chunk cells are long runs of the same terrain
+	payload := bytes.Repeat([]byte{0x08, 0x01, 0x10, 0x02}, 4096)
+	for i := 0; i < 3; i++ { // pooled encoders and decoders are reused
+		var compressed bytes.Buffer
+		w, err := compressor.Compress(&compressed)
+		require.NoError(t, err)
+		_, err = w.Write(payload)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		assert.Less(t, compressed.Len(), len(payload)/10)
+
+		r, err := compressor.Decompress(&compressed)
+		require.NoError(t, err)
+		decompressed, err := io.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, payload, decompressed)
+	}
+}
+
+func TestStatsHandler_Ratio(t *testing.T) {
+	debugstats.Reset()
+	defer debugstats.Reset()
+	handler := NewStatsHandler()
+	ctx := handler.TagRPC(context.Background(), &stats.RPCTagInfo{FullMethodName: "/chunk.v1.ChunkService/GetChunks"})
+
+	handler.HandleRPC(ctx, &stats.OutPayload{Length: 4000, CompressedLength: 1000})
+	handler.HandleRPC(ctx, &stats.OutPayload{Length: 4000, CompressedLength: 1000})
+	handler.HandleRPC(ctx, &stats.InPayload{Length: 10, CompressedLength: 10})
+	assert.Equal(t, int64(400), handler.Ratio("/chunk.v1.ChunkService/GetChunks"))
+	assert.Zero(t, handler.Ratio("/user.v1.UserService/Login"), "no responses yet")
+
+	assert.Equal(t, map[string]int64{"/chunk.v1.ChunkService/GetChunks": 400}, debugstats.Snapshot(debugstats.Compression))
+}
@@ -0,0 +1,76 @@
+package compression
+
+import (
+	"context"
+	"sync"
+
+	"github.com/VoidMesh/api/api/internal/debugstats"
+	"google.golang.org/grpc/stats"
+)
+
+// StatsHandler is a gRPC stats handler that totals the response bytes of each method
+// before and after compression. Ratios are reported by the debug service.
+type StatsHandler struct {
+	mu      sync.Mutex
+	methods map[string]*methodTotals
+}
+
+type methodTotals struct {
+	raw        int64
+	compressed int64
+}
+
+// NewStatsHandler creates a handler; pass it to the server with grpc.StatsHandler
+func NewStatsHandler() *StatsHandler {
+	return &StatsHandler{methods: make(map[string]*methodTotals)}
+}
+
+type methodKey struct{}
+
+// TagRPC remembers the method so its payloads can be attributed to it
+func (h *StatsHandler) TagRPC(ctx context.Context, info *stats.RPCTagInfo) context.Context {
+	return context.WithValue(ctx, methodKey{}, info.FullMethodName)
+}
+
+// HandleRPC adds every outgoing payload to its method's totals
+func (h *StatsHandler) HandleRPC(ctx context.Context, s stats.RPCStats) {
+	payload, ok := s.(*stats.OutPayload)
+	if !ok || payload.Length == 0 {
+		return
+	}
+	method, _ := ctx.Value(methodKey{}).(string)
+	h.record(method, payload.Length, payload.CompressedLength)
+}
+
+func (h *StatsHandler) TagConn(ctx context.Context, info *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+func (h *StatsHandler) HandleConn(ctx context.Context, s stats.ConnStats) {}
+
+func (h *StatsHandler) record(method string, raw, compressed int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	totals, ok := h.methods[method]
+	if !ok {
+		totals = &methodTotals{}
+		h.methods[method] = totals
+		debugstats.Register(debugstats.Compression, method, func() int64 {
+			return h.Ratio(method)
+		})
+	}
+	totals.raw += int64(raw)
+	totals.compressed += int64(compressed)
+}
+
+// Ratio returns the method's response bytes before compression per byte sent, times
+// 100: 100 is uncompressed, 400 means responses shrank to a quarter
+func (h *StatsHandler) Ratio(method string) int64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	totals, ok := h.methods[method]
+	if !ok || totals.compressed == 0 {
+		return 0
+	}
+	return totals.raw * 100 / totals.compressed
+}
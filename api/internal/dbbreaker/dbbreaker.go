@@ -0,0 +1,265 @@
+// Package dbbreaker is a circuit breaker around the database. It watches every query the
+// pool runs and opens when too many of them fail or are slow, so the server stops sending
+// new work to a struggling database instead of piling up goroutines waiting on it.
+//
+// While the breaker is open, middleware.CircuitBreakerInterceptor fails writes fast with
+// a typed Unavailable error and serves the reads it can from cache. After OpenFor the
+// breaker lets queries through again and closes once enough of them succeed.
+package dbbreaker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/debugstats"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// ReasonDatabaseUnavailable is the ErrorInfo reason of requests rejected while the breaker is open
+const ReasonDatabaseUnavailable = "DATABASE_UNAVAILABLE"
+
+const errorDomain = "database.voidmesh"
+
+// State is the breaker's position
+type State int32
+
+const (
+	Closed   State = iota // Queries run normally
+	Open                  // The database is considered down; writes fail fast
+	HalfOpen              // Queries run again to probe whether the database recovered
+)
+
+func (s State) String() string {
+	switch s {
+	case Closed:
+		return "closed"
+	case Open:
+		return "open"
+	case HalfOpen:
+		return "half-open"
+	}
+	return "unknown"
+}
+
+// Config sets when the breaker opens and how it recovers
+type Config struct {
+	Window         time.Duration // Query outcomes are counted over windows of this length
+	MinQueries     int           // Queries a window needs before it can open the breaker
+	FailureRatio   float64       // Share of a window's queries that must fail to open it
+	SlowQuery      time.Duration // Queries taking longer than this count as failed
+	OpenFor        time.Duration // How long the breaker stays open before probing
+	ProbeSuccesses int           // Successful queries while half-open that close it
+}
+
+// DefaultConfig opens the breaker when half of at least 20 queries in 10s fail or take
+// over 2s, and probes again after 15s
+func DefaultConfig() Config {
+	return Config{
+		Window:         10 * time.Second,
+		MinQueries:     20,
+		FailureRatio:   0.5,
+		SlowQuery:      2 * time.Second,
+		OpenFor:        15 * time.Second,
+		ProbeSuccesses: 5,
+	}
+}
+
+// Breaker tracks query outcomes. It is safe for concurrent use.
+type Breaker struct {
+	mu          sync.Mutex
+	clock       clock.Clock
+	config      Config
+	state       State
+	windowStart time.Time
+	queries     int
+	failures    int
+	openedAt    time.Time
+	probes      int
+	trips       int64
+}
+
+// New creates a closed breaker and registers its state with the debug service
+func New(config Config) *Breaker {
+	c := clock.New()
+	b := &Breaker{clock: c, config: config, windowStart: c.Now()}
+	debugstats.Register(debugstats.Database, "breaker.state", func() int64 { return int64(b.State()) })
+	debugstats.Register(debugstats.Database, "breaker.trips", func() int64 {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		return b.trips
+	})
+	debugstats.Register(debugstats.Database, "breaker.window_failures", func() int64 {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		return int64(b.failures)
+	})
+	return b
+}
+
+// SetClock replaces the clock used for windows and open periods (for testing)
+func (b *Breaker) SetClock(c clock.Clock) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.clock = c
+	b.windowStart = c.Now()
+}
+
+// State returns the breaker's current position
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.current()
+}
+
+// Allow reports whether new database work should start, false while the breaker is open
+func (b *Breaker) Allow() bool {
+	return b.State() != Open
+}
+
+// RetryAfter returns how long until the open breaker starts probing, or zero when it isn't open
+func (b *Breaker) RetryAfter() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.current() != Open {
+		return 0
+	}
+	return b.openedAt.Add(b.config.OpenFor).Sub(b.clock.Now())
+}
+
+// Record counts the outcome of one query
+func (b *Breaker) Record(duration time.Duration, err error) {
+	failed := b.failed(duration, err)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.current() {
+	case Open:
+		// Queries started before the breaker opened are still finishing
+	case HalfOpen:
+		if failed {
+			b.open("a probe query failed")
+			return
+		}
+		b.probes++
+		if b.probes >= b.config.ProbeSuccesses {
+			b.state = Closed
+			b.resetWindow()
+			logging.WithComponent("dbbreaker").Info("Database circuit breaker closed")
+		}
+	case Closed:
+		if b.clock.Since(b.windowStart) >= b.config.Window {
+			b.resetWindow()
+		}
+		b.queries++
+		if failed {
+			b.failures++
+		}
+		if b.queries >= b.config.MinQueries && float64(b.failures) >= b.config.FailureRatio*float64(b.queries) {
+			b.open("too many queries failed or were slow")
+		}
+	}
+}
+
+// Error is the typed error for requests rejected while the breaker is open. Clients can
+// match on the DATABASE_UNAVAILABLE ErrorInfo reason and retry after the RetryInfo delay.
+func (b *Breaker) Error() error {
+	st := status.New(codes.Unavailable, "the database is unavailable, try again shortly")
+	detailed, err := st.WithDetails(
+		&errdetails.ErrorInfo{Reason: ReasonDatabaseUnavailable, Domain: errorDomain},
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(max(b.RetryAfter(), time.Second))},
+	)
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// current moves an open breaker to half-open once OpenFor has passed. The caller holds mu.
+func (b *Breaker) current() State {
+	if b.state == Open && b.clock.Since(b.openedAt) >= b.config.OpenFor {
+		b.state = HalfOpen
+		b.probes = 0
+	}
+	return b.state
+}
+
+// open trips the breaker. The caller holds mu.
+func (b *Breaker) open(reason string) {
+	b.state = Open
+	b.openedAt = b.clock.Now()
+	b.trips++
+	logging.WithComponent("dbbreaker").Warn("Database circuit breaker opened",
+		"reason", reason, "queries", b.queries, "failures", b.failures, "open_for", b.config.OpenFor)
+}
+
+// resetWindow starts a new counting window. The caller holds mu.
+func (b *Breaker) resetWindow() {
+	b.windowStart = b.clock.Now()
+	b.queries = 0
+	b.failures = 0
+}
+
+// failed reports whether a query outcome points at an unhealthy database. Errors the
+// database answered with (constraint violations, no rows) and queries the client
+// cancelled don't; connection errors, timeouts and slow queries do.
+func (b *Breaker) failed(duration time.Duration, err error) bool {
+	if duration > b.config.SlowQuery {
+		return true
+	}
+	if err == nil || errors.Is(err, pgx.ErrNoRows) || errors.Is(err, context.Canceled) {
+		return false
+	}
+	var pgErr *pgconn.PgError
+	return !errors.As(err, &pgErr)
+}
+
+// Tracer reports every query and failed connection acquire of a pool to the breaker. Set
+// it as the pool's ConnConfig.Tracer before creating the pool.
+func (b *Breaker) Tracer() pgx.QueryTracer {
+	return &tracer{breaker: b}
+}
+
+type tracer struct {
+	breaker *Breaker
+}
+
+type startKey struct{}
+
+func (t *tracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, _ pgx.TraceQueryStartData) context.Context {
+	return context.WithValue(ctx, startKey{}, t.breaker.clock.Now())
+}
+
+func (t *tracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	start, ok := ctx.Value(startKey{}).(time.Time)
+	if !ok {
+		return
+	}
+	t.breaker.Record(t.breaker.clock.Since(start), data.Err)
+}
+
+func (t *tracer) TraceAcquireStart(ctx context.Context, _ *pgxpool.Pool, _ pgxpool.TraceAcquireStartData) context.Context {
+	return context.WithValue(ctx, startKey{}, t.breaker.clock.Now())
+}
+
+// TraceAcquireEnd counts acquires that failed or waited too long for a connection; queries
+// never start when the database is unreachable, and successful acquires are counted by
+// the query that follows
+func (t *tracer) TraceAcquireEnd(ctx context.Context, _ *pgxpool.Pool, data pgxpool.TraceAcquireEndData) {
+	start, ok := ctx.Value(startKey{}).(time.Time)
+	if !ok {
+		return
+	}
+	if duration := t.breaker.clock.Since(start); data.Err != nil || duration > t.breaker.config.SlowQuery {
+		t.breaker.Record(duration, data.Err)
+	}
+}
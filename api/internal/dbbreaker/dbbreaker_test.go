@@ -0,0 +1,116 @@
+package dbbreaker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errConnection = errors.New("dial tcp 127.0.0.1:5432: connect: connection refused")
+
+func newTestBreaker() (*Breaker, *clock.Fake) {
+	fake := clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	b := New(Config{
+		Window:         10 * time.Second,
+		MinQueries:     4,
+		FailureRatio:   0.5,
+		SlowQuery:      time.Second,
+		OpenFor:        15 * time.Second,
+		ProbeSuccesses: 2,
+	})
+	b.SetClock(fake)
+	return b, fake
+}
+
+func TestBreakerOpensAndRecovers(t *testing.T) {
+	b, fake := newTestBreaker()
+
+	b.Record(time.Millisecond, errConnection)
+	b.Record(time.Millisecond, errConnection)
+	b.Record(time.Millisecond, errConnection)
+	assert.Equal(t, Closed, b.State(), "a window needs MinQueries before it can trip")
+
+	b.Record(time.Millisecond, nil)
+	assert.Equal(t, Open, b.State())
+	assert.False(t, b.Allow())
+	assert.Equal(t, 15*time.Second, b.RetryAfter())
+
+	fake.Advance(15 * time.Second)
+	assert.Equal(t, HalfOpen, b.State())
+	assert.True(t, b.Allow(), "probes are let through")
+	assert.Zero(t, b.RetryAfter())
+
+	b.Record(time.Millisecond, errConnection)
+	assert.Equal(t, Open, b.State(), "a failed probe opens the breaker again")
+
+	fake.Advance(15 * time.Second)
+	b.Record(time.Millisecond, nil)
+	assert.Equal(t, HalfOpen, b.State())
+	b.Record(time.Millisecond, nil)
+	assert.Equal(t, Closed, b.State())
+}
+
+func TestBreakerWindow(t *testing.T) {
+	b, fake := newTestBreaker()
+
+	b.Record(time.Millisecond, errConnection)
+	b.Record(time.Millisecond, errConnection)
+	b.Record(time.Millisecond, nil)
+	fake.Advance(10 * time.Second)
+
+	b.Record(time.Millisecond, errConnection)
+	b.Record(time.Millisecond, nil)
+	b.Record(time.Millisecond, nil)
+	b.Record(time.Millisecond, nil)
+	assert.Equal(t, Closed, b.State(), "failures of an earlier window don't count")
+
+	b.Record(2*time.Second, nil)
+	b.Record(2*time.Second, nil)
+	b.Record(2*time.Second, nil)
+	assert.Equal(t, Open, b.State(), "slow queries count as failures")
+}
+
+func TestBreakerIgnoresHealthyErrors(t *testing.T) {
+	b, _ := newTestBreaker()
+
+	for i := 0; i < 10; i++ {
+		b.Record(time.Millisecond, pgx.ErrNoRows)
+		b.Record(time.Millisecond, &pgconn.PgError{Code: "23505"})
+		b.Record(time.Millisecond, fmt.Errorf("query: %w", context.Canceled))
+	}
+	assert.Equal(t, Closed, b.State())
+
+	for i := 0; i < 30; i++ {
+		b.Record(time.Millisecond, fmt.Errorf("query: %w", context.DeadlineExceeded))
+	}
+	assert.Equal(t, Open, b.State(), "queries cut off by a deadline count as failures")
+}
+
+func TestBreakerError(t *testing.T) {
+	b, fake := newTestBreaker()
+	for i := 0; i < 4; i++ {
+		b.Record(time.Millisecond, errConnection)
+	}
+	fake.Advance(5 * time.Second)
+
+	st := status.Convert(b.Error())
+	assert.Equal(t, codes.Unavailable, st.Code())
+	require.Len(t, st.Details(), 2)
+	info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+	require.True(t, ok)
+	assert.Equal(t, ReasonDatabaseUnavailable, info.Reason)
+	retry, ok := st.Details()[1].(*errdetails.RetryInfo)
+	require.True(t, ok)
+	assert.Equal(t, 10*time.Second, retry.RetryDelay.AsDuration())
+}
@@ -0,0 +1,54 @@
+// Package debugstats is a process-wide registry of runtime gauges reported by the debug service.
+// Components register a read function once and the value is sampled on every dump.
+package debugstats
+
+import (
+	"sync"
+)
+
+// Kind groups gauges in the debug dump
+type Kind string
+
+const (
+	StreamSubscriptions Kind = "stream_subscriptions"
+	CacheEntries        Kind = "cache_entries"
+	Queues              Kind = "queues"
+	BackgroundJobs      Kind = "background_jobs"
+	Compression         Kind = "compression"
+	Database            Kind = "database"
+)
+
+var (
+	mu     sync.RWMutex
+	gauges = map[Kind]map[string]func() int64{}
+)
+
+// Register adds a gauge under the given kind, replacing any gauge already registered with the same name
+func Register(kind Kind, name string, read func() int64) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if gauges[kind] == nil {
+		gauges[kind] = make(map[string]func() int64)
+	}
+	gauges[kind][name] = read
+}
+
+// Snapshot samples every gauge of the given kind
+func Snapshot(kind Kind) map[string]int64 {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	values := make(map[string]int64, len(gauges[kind]))
+	for name, read := range gauges[kind] {
+		values[name] = read()
+	}
+	return values
+}
+
+// Reset removes all registered gauges (for testing)
+func Reset() {
+	mu.Lock()
+	gauges = map[Kind]map[string]func() int64{}
+	mu.Unlock()
+}
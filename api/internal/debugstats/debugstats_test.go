@@ -0,0 +1,26 @@
+package debugstats
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterAndSnapshot(t *testing.T) {
+	Reset()
+	t.Cleanup(Reset)
+
+	depth := int64(3)
+	Register(Queues, "chunk.generation_queue_depth", func() int64 { return depth })
+	Register(CacheEntries, "character.movement_cooldowns", func() int64 { return 7 })
+
+	assert.Equal(t, map[string]int64{"chunk.generation_queue_depth": 3}, Snapshot(Queues))
+
+	depth = 5
+	assert.Equal(t, int64(5), Snapshot(Queues)["chunk.generation_queue_depth"], "gauges are sampled on every snapshot")
+
+	Register(CacheEntries, "character.movement_cooldowns", func() int64 { return 1 })
+	assert.Equal(t, map[string]int64{"character.movement_cooldowns": 1}, Snapshot(CacheEntries), "re-registering replaces the gauge")
+
+	assert.Empty(t, Snapshot(StreamSubscriptions))
+}
@@ -0,0 +1,66 @@
+// Polls /debug/runtime with the admin's access token. The token is kept in session
+// storage so it is forgotten when the tab closes.
+(function () {
+  const refreshMS = 2000;
+  const tokenKey = "voidmesh.diagnostics.token";
+  const tokenInput = document.getElementById("token");
+  const errorText = document.getElementById("error");
+  let timer;
+
+  const bytes = (n) => (n / (1 << 20)).toFixed(1) + " MiB";
+  const duration = (ns) => (ns / 1e6).toFixed(3) + " ms";
+
+  function fill(table, rows) {
+    table.replaceChildren(...rows.map(([name, value]) => {
+      const tr = document.createElement("tr");
+      const th = document.createElement("th");
+      const td = document.createElement("td");
+      th.textContent = name;
+      td.textContent = value;
+      td.className = "value";
+      tr.append(th, td);
+      return tr;
+    }));
+  }
+
+  async function refresh() {
+    const token = sessionStorage.getItem(tokenKey);
+    if (!token) {
+      return;
+    }
+    try {
+      const res = await fetch("../debug/runtime", { headers: { Authorization: "Bearer " + token } });
+      if (!res.ok) {
+        throw new Error(res.status + " " + (await res.text()).trim());
+      }
+      const r = await res.json();
+      errorText.textContent = "";
+      fill(document.getElementById("runtime"), [
+        ["Goroutines", r.goroutines],
+        ["GOMAXPROCS", r.gomaxprocs],
+        ["Heap in use", bytes(r.heap_inuse_bytes)],
+        ["Heap objects", r.heap_objects],
+        ["Next GC", bytes(r.next_gc_bytes)],
+        ["Collections", r.num_gc],
+        ["Last GC", r.last_gc],
+        ["GC pause p50", duration(r.pause_p50_ns)],
+        ["GC pause p99", duration(r.pause_p99_ns)],
+        ["GC pause max", duration(r.pause_max_ns)],
+      ]);
+      fill(document.getElementById("gauges"), Object.entries(r.gauges).sort(([a], [b]) => a.localeCompare(b)));
+    } catch (err) {
+      errorText.textContent = "Failed to load diagnostics: " + err.message;
+    }
+  }
+
+  document.getElementById("login").addEventListener("submit", (event) => {
+    event.preventDefault();
+    sessionStorage.setItem(tokenKey, tokenInput.value.trim());
+    tokenInput.value = "";
+    refresh();
+  });
+
+  refresh();
+  timer = setInterval(refresh, refreshMS);
+  window.addEventListener("pagehide", () => clearInterval(timer));
+})();
@@ -0,0 +1,104 @@
+// Package diagnostics serves net/http/pprof profiles and a runtime summary (goroutines,
+// heap, GC pauses) over HTTP, so a slow generation or streaming path in production can be
+// profiled without redeploying. The handler has no authentication of its own; the server
+// only serves it on DEBUG_HTTP_ADDRESS behind middleware.AdminHTTP.
+//
+// Dashboard serves a page, embedded in the binary, that charts the runtime summary. The
+// page itself holds no data, so it is served without authentication; it asks for an
+// admin's access token and sends it with every request for the summary.
+package diagnostics
+
+import (
+	"embed"
+	"encoding/json"
+	"io/fs"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"runtime/debug"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/debugstats"
+)
+
+// Runtime is the summary served on /debug/runtime
+type Runtime struct {
+	Goroutines   int              `json:"goroutines"`
+	GOMAXPROCS   int              `json:"gomaxprocs"`
+	HeapInuse    uint64           `json:"heap_inuse_bytes"`
+	HeapObjects  uint64           `json:"heap_objects"`
+	NextGC       uint64           `json:"next_gc_bytes"`
+	NumGC        int64            `json:"num_gc"`
+	LastGC       time.Time        `json:"last_gc"`
+	PauseTotal   time.Duration    `json:"pause_total_ns"`
+	PauseP50     time.Duration    `json:"pause_p50_ns"`
+	PauseP99     time.Duration    `json:"pause_p99_ns"`
+	PauseMax     time.Duration    `json:"pause_max_ns"`
+	RecentPauses []time.Duration  `json:"recent_pauses_ns"` // Newest first, at most 16
+	Gauges       map[string]int64 `json:"gauges"`           // The debugstats gauges, as "<kind>/<name>"
+}
+
+// recentPauses caps the pauses listed in the summary
+const recentPauses = 16
+
+// ReadRuntime samples the runtime. Pause quantiles cover the last 256 collections.
+func ReadRuntime() Runtime {
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+	gc := debug.GCStats{PauseQuantiles: make([]time.Duration, 101)}
+	debug.ReadGCStats(&gc)
+
+	r := Runtime{
+		Goroutines:   runtime.NumGoroutine(),
+		GOMAXPROCS:   runtime.GOMAXPROCS(0),
+		HeapInuse:    mem.HeapInuse,
+		HeapObjects:  mem.HeapObjects,
+		NextGC:       mem.NextGC,
+		NumGC:        gc.NumGC,
+		LastGC:       gc.LastGC,
+		PauseTotal:   gc.PauseTotal,
+		RecentPauses: gc.Pause[:min(len(gc.Pause), recentPauses)],
+		Gauges:       make(map[string]int64),
+	}
+	if gc.NumGC > 0 {
+		r.PauseP50 = gc.PauseQuantiles[50]
+		r.PauseP99 = gc.PauseQuantiles[99]
+		r.PauseMax = gc.PauseQuantiles[100]
+	}
+	for _, kind := range []debugstats.Kind{debugstats.StreamSubscriptions, debugstats.CacheEntries, debugstats.Queues, debugstats.BackgroundJobs, debugstats.Database} {
+		for name, value := range debugstats.Snapshot(kind) {
+			r.Gauges[string(kind)+"/"+name] = value
+		}
+	}
+	return r
+}
+
+// Handler serves the pprof index and profiles under /debug/pprof/ and the runtime
+// summary on /debug/runtime
+func Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.HandleFunc("GET /debug/runtime", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		_ = json.NewEncoder(w).Encode(ReadRuntime())
+	})
+	return mux
+}
+
+//go:embed dashboard
+var dashboardFiles embed.FS
+
+// Dashboard serves the embedded dashboard under /dashboard/. It expects the runtime
+// summary at /debug/runtime on the same host.
+func Dashboard() http.Handler {
+	files, err := fs.Sub(dashboardFiles, "dashboard")
+	if err != nil {
+		panic(err) // The directory is embedded above
+	}
+	return http.StripPrefix("/dashboard/", http.FileServerFS(files))
+}
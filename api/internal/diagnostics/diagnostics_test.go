@@ -0,0 +1,61 @@
+package diagnostics
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"testing"
+
+	"github.com/VoidMesh/api/api/internal/debugstats"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_Runtime(t *testing.T) {
+	debugstats.Reset()
+	t.Cleanup(debugstats.Reset)
+	debugstats.Register(debugstats.StreamSubscriptions, "character.nearby_streams", func() int64 { return 3 })
+	runtime.GC()
+
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/runtime", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+	var got Runtime
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	assert.Positive(t, got.Goroutines)
+	assert.Positive(t, got.HeapInuse)
+	assert.Positive(t, got.NumGC)
+	assert.NotEmpty(t, got.RecentPauses)
+	assert.LessOrEqual(t, len(got.RecentPauses), recentPauses)
+	assert.GreaterOrEqual(t, got.PauseMax, got.PauseP99)
+	assert.Equal(t, int64(3), got.Gauges["stream_subscriptions/character.nearby_streams"])
+}
+
+func TestHandler_Pprof(t *testing.T) {
+	for path, want := range map[string]string{
+		"/debug/pprof/":                  "goroutine",
+		"/debug/pprof/goroutine?debug=1": "goroutine profile",
+		"/debug/pprof/heap?debug=1":      "heap profile",
+		"/debug/pprof/cmdline":           "",
+	} {
+		rec := httptest.NewRecorder()
+		Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		assert.Equal(t, http.StatusOK, rec.Code, path)
+		assert.Contains(t, rec.Body.String(), want, path)
+	}
+}
+
+func TestDashboard(t *testing.T) {
+	for path, want := range map[string]string{
+		"/dashboard/":             "VoidMesh diagnostics",
+		"/dashboard/dashboard.js": "/debug/runtime",
+	} {
+		rec := httptest.NewRecorder()
+		Dashboard().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		assert.Equal(t, http.StatusOK, rec.Code, path)
+		assert.Contains(t, rec.Body.String(), want, path)
+	}
+}
@@ -0,0 +1,176 @@
+package entity
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Component is a typed piece of entity state, stored in the components column under its
+// name. Implementations use value receivers so the name is known from the zero value,
+// and are registered with RegisterComponent.
+type Component interface {
+	ComponentName() string
+}
+
+// Validator is implemented by components with constraints beyond their JSON shape
+type Validator interface {
+	Validate() error
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]func(json.RawMessage) error{}
+)
+
+// RegisterComponent makes T storable. It is meant to be called from init and panics
+// when another component already uses T's name.
+func RegisterComponent[T Component]() {
+	var zero T
+	name := zero.ComponentName()
+	if name == "" {
+		panic(fmt.Sprintf("entity: component %T has no name", zero))
+	}
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if _, ok := registry[name]; ok {
+		panic(fmt.Sprintf("entity: component %q registered twice", name))
+	}
+	registry[name] = func(raw json.RawMessage) error {
+		decoder := json.NewDecoder(bytes.NewReader(raw))
+		decoder.DisallowUnknownFields()
+		var value T
+		if err := decoder.Decode(&value); err != nil {
+			return err
+		}
+		if v, ok := any(value).(Validator); ok {
+			return v.Validate()
+		}
+		return nil
+	}
+}
+
+// Components holds an entity's components by name, each still encoded. Components only
+// decode when read with Get, so entities written by a newer server with components this
+// one does not know can still be loaded; saving them fails validation until it is updated.
+type Components map[string]json.RawMessage
+
+// DecodeComponents decodes the components column
+func DecodeComponents(data []byte) (Components, error) {
+	components := Components{}
+	if len(data) == 0 {
+		return components, nil
+	}
+	if err := json.Unmarshal(data, &components); err != nil {
+		return nil, fmt.Errorf("failed to decode components: %w", err)
+	}
+	return components, nil
+}
+
+// Encode encodes the components for the components column
+func (c Components) Encode() ([]byte, error) {
+	if c == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(c)
+}
+
+// Validate checks that every component is registered and decodes without unknown fields
+func (c Components) Validate() error {
+	names := make([]string, 0, len(c))
+	for name := range c {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	var errs []error
+	for _, name := range names {
+		validate, ok := registry[name]
+		if !ok {
+			errs = append(errs, fmt.Errorf("unknown component %q", name))
+			continue
+		}
+		if err := validate(c[name]); err != nil {
+			errs = append(errs, fmt.Errorf("invalid component %q: %w", name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Get decodes the component of type T, reporting false when the entity does not have it
+func Get[T Component](c Components) (T, bool, error) {
+	var value T
+	raw, ok := c[value.ComponentName()]
+	if !ok {
+		return value, false, nil
+	}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return value, true, fmt.Errorf("failed to decode component %q: %w", value.ComponentName(), err)
+	}
+	return value, true, nil
+}
+
+// Set encodes a component, replacing any component of the same type
+func (c Components) Set(component Component) error {
+	raw, err := json.Marshal(component)
+	if err != nil {
+		return fmt.Errorf("failed to encode component %q: %w", component.ComponentName(), err)
+	}
+	c[component.ComponentName()] = raw
+	return nil
+}
+
+// Remove removes the component of type T
+func Remove[T Component](c Components) {
+	var zero T
+	delete(c, zero.ComponentName())
+}
+
+// Health is the hit points of an entity that can be damaged, such as a mob or structure
+type Health struct {
+	Current int32 `json:"current"`
+	Max     int32 `json:"max"`
+}
+
+func (Health) ComponentName() string { return "health" }
+
+func (h Health) Validate() error {
+	if h.Max <= 0 || h.Current < 0 || h.Current > h.Max {
+		return fmt.Errorf("current must be between 0 and max, and max positive")
+	}
+	return nil
+}
+
+// Owner is the character an entity belongs to, such as a structure or planted crop
+type Owner struct {
+	CharacterID string `json:"character_id"`
+}
+
+func (Owner) ComponentName() string { return "owner" }
+
+func (o Owner) Validate() error {
+	if o.CharacterID == "" {
+		return fmt.Errorf("character_id is required")
+	}
+	return nil
+}
+
+// Expiry is when a temporary entity, such as a drop, disappears
+type Expiry struct {
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func (Expiry) ComponentName() string { return "expiry" }
+
+func init() {
+	RegisterComponent[Health]()
+	RegisterComponent[Owner]()
+	RegisterComponent[Expiry]()
+}
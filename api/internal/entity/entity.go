@@ -0,0 +1,231 @@
+// Package entity stores generic game objects (mobs, drops, structures, crops) in the
+// world_entities table. An entity has a type, a position and a set of typed components
+// kept as one JSON object, so a new kind of object is a new type name and, at most, a
+// new component registered with RegisterComponent; it needs no schema change. Entities
+// are indexed by chunk, and updates use the version column for optimistic locking.
+package entity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// MaxTypeLength bounds entity type names
+const MaxTypeLength = 64
+
+var (
+	ErrNotFound = errors.New("entity not found")
+	// ErrVersionConflict means the entity was updated since it was read; reload and retry
+	ErrVersionConflict = errors.New("entity was modified concurrently")
+)
+
+// Entity is a game object and its components
+type Entity struct {
+	ID         int64
+	WorldID    pgtype.UUID
+	Type       string
+	X          int32 // World cell coordinates
+	Y          int32
+	Components Components
+	Version    int32 // Incremented by every update
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// Chunk returns the chunk the entity is in
+func (e *Entity) Chunk() (chunkX, chunkY int32) {
+	return geometry.ChunkOf(e.Position())
+}
+
+// DatabaseInterface abstracts the world entity queries
+type DatabaseInterface interface {
+	CreateWorldEntity(ctx context.Context, arg db.CreateWorldEntityParams) (db.WorldEntity, error)
+	GetWorldEntity(ctx context.Context, id int64) (db.WorldEntity, error)
+	ListWorldEntitiesInChunk(ctx context.Context, arg db.ListWorldEntitiesInChunkParams) ([]db.WorldEntity, error)
+	ListWorldEntitiesInChunkRange(ctx context.Context, arg db.ListWorldEntitiesInChunkRangeParams) ([]db.WorldEntity, error)
+	ListWorldEntitiesInRect(ctx context.Context, arg db.ListWorldEntitiesInRectParams) ([]db.WorldEntity, error)
+	ListWorldEntitiesWithinRadius(ctx context.Context, arg db.ListWorldEntitiesWithinRadiusParams) ([]db.WorldEntity, error)
+	UpdateWorldEntity(ctx context.Context, arg db.UpdateWorldEntityParams) (db.WorldEntity, error)
+	DeleteWorldEntity(ctx context.Context, id int64) (int64, error)
+}
+
+// Store reads and writes entities. Pass queries bound to a transaction to write entities
+// atomically with other state.
+type Store struct {
+	db    DatabaseInterface
+	clock clock.Clock
+}
+
+// NewStore creates a store over database
+func NewStore(database DatabaseInterface) *Store {
+	return &Store{db: database, clock: clock.New()}
+}
+
+// NewStoreWithPool creates a store over the connection pool
+func NewStoreWithPool(pool storage.Pool) *Store {
+	return NewStore(db.New(pool))
+}
+
+// SetClock replaces the clock used for timestamps (for simulation tests)
+func (s *Store) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// Create stores a new entity of the given type and position, filling in its ID, version
+// and timestamps
+func (s *Store) Create(ctx context.Context, e *Entity) error {
+	if e.Type == "" || len(e.Type) > MaxTypeLength {
+		return fmt.Errorf("entity type must be 1 to %d characters", MaxTypeLength)
+	}
+	components, err := encodeValid(e.Components)
+	if err != nil {
+		return err
+	}
+	chunkX, chunkY := e.Chunk()
+	row, err := s.db.CreateWorldEntity(ctx, db.CreateWorldEntityParams{
+		WorldID:    e.WorldID,
+		Type:       e.Type,
+		ChunkX:     chunkX,
+		ChunkY:     chunkY,
+		X:          e.X,
+		Y:          e.Y,
+		Components: components,
+		Now:        pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create entity: %w", err)
+	}
+	return fromRow(row, e)
+}
+
+// Get loads an entity
+func (s *Store) Get(ctx context.Context, id int64) (*Entity, error) {
+	row, err := s.db.GetWorldEntity(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get entity: %w", err)
+	}
+	var e Entity
+	if err := fromRow(row, &e); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+// ListInChunk loads the entities in a chunk
+func (s *Store) ListInChunk(ctx context.Context, worldID pgtype.UUID, chunkX, chunkY int32) ([]*Entity, error) {
+	rows, err := s.db.ListWorldEntitiesInChunk(ctx, db.ListWorldEntitiesInChunkParams{WorldID: worldID, ChunkX: chunkX, ChunkY: chunkY})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list entities: %w", err)
+	}
+	return fromRows(rows)
+}
+
+// ListInChunks loads the entities of a type in a rectangle of chunks (bounds inclusive);
+// an empty type loads every type
+func (s *Store) ListInChunks(ctx context.Context, worldID pgtype.UUID, minChunkX, maxChunkX, minChunkY, maxChunkY int32, entityType string) ([]*Entity, error) {
+	rows, err := s.db.ListWorldEntitiesInChunkRange(ctx, db.ListWorldEntitiesInChunkRangeParams{
+		WorldID:   worldID,
+		MinChunkX: minChunkX,
+		MaxChunkX: maxChunkX,
+		MinChunkY: minChunkY,
+		MaxChunkY: maxChunkY,
+		Type:      entityType,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list entities: %w", err)
+	}
+	return fromRows(rows)
+}
+
+// Update saves the entity's position and components if it is still at the version it
+// was read at, then refreshes e with the new version. It fails with ErrVersionConflict
+// when the entity was updated since, and ErrNotFound when it was deleted.
+func (s *Store) Update(ctx context.Context, e *Entity) error {
+	components, err := encodeValid(e.Components)
+	if err != nil {
+		return err
+	}
+	chunkX, chunkY := e.Chunk()
+	row, err := s.db.UpdateWorldEntity(ctx, db.UpdateWorldEntityParams{
+		ChunkX:     chunkX,
+		ChunkY:     chunkY,
+		X:          e.X,
+		Y:          e.Y,
+		Components: components,
+		Now:        pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
+		ID:         e.ID,
+		Version:    e.Version,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		if _, err := s.db.GetWorldEntity(ctx, e.ID); errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		return ErrVersionConflict
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update entity: %w", err)
+	}
+	return fromRow(row, e)
+}
+
+// Delete removes an entity
+func (s *Store) Delete(ctx context.Context, id int64) error {
+	deleted, err := s.db.DeleteWorldEntity(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete entity: %w", err)
+	}
+	if deleted == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func encodeValid(components Components) ([]byte, error) {
+	if err := components.Validate(); err != nil {
+		return nil, err
+	}
+	return components.Encode()
+}
+
+func fromRow(row db.WorldEntity, e *Entity) error {
+	components, err := DecodeComponents(row.Components)
+	if err != nil {
+		return fmt.Errorf("entity %d: %w", row.ID, err)
+	}
+	*e = Entity{
+		ID:         row.ID,
+		WorldID:    row.WorldID,
+		Type:       row.Type,
+		X:          row.X,
+		Y:          row.Y,
+		Components: components,
+		Version:    row.Version,
+		CreatedAt:  row.CreatedAt.Time,
+		UpdatedAt:  row.UpdatedAt.Time,
+	}
+	return nil
+}
+
+func fromRows(rows []db.WorldEntity) ([]*Entity, error) {
+	entities := make([]*Entity, 0, len(rows))
+	for _, row := range rows {
+		var e Entity
+		if err := fromRow(row, &e); err != nil {
+			return nil, err
+		}
+		entities = append(entities, &e)
+	}
+	return entities, nil
+}
@@ -0,0 +1,256 @@
+package entity
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDB keeps entity rows in memory
+type fakeDB struct {
+	rows   map[int64]db.WorldEntity
+	nextID int64
+}
+
+func (f *fakeDB) CreateWorldEntity(ctx context.Context, arg db.CreateWorldEntityParams) (db.WorldEntity, error) {
+	f.nextID++
+	row := db.WorldEntity{
+		ID: f.nextID, WorldID: arg.WorldID, Type: arg.Type, ChunkX: arg.ChunkX, ChunkY: arg.ChunkY,
+		X: arg.X, Y: arg.Y, Components: arg.Components, Version: 1, CreatedAt: arg.Now, UpdatedAt: arg.Now,
+	}
+	f.rows[row.ID] = row
+	return row, nil
+}
+
+func (f *fakeDB) GetWorldEntity(ctx context.Context, id int64) (db.WorldEntity, error) {
+	row, ok := f.rows[id]
+	if !ok {
+		return db.WorldEntity{}, pgx.ErrNoRows
+	}
+	return row, nil
+}
+
+func (f *fakeDB) ListWorldEntitiesInChunk(ctx context.Context, arg db.ListWorldEntitiesInChunkParams) ([]db.WorldEntity, error) {
+	var rows []db.WorldEntity
+	for id := int64(1); id <= f.nextID; id++ {
+		row, ok := f.rows[id]
+		if ok && row.WorldID == arg.WorldID && row.ChunkX == arg.ChunkX && row.ChunkY == arg.ChunkY {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+func (f *fakeDB) ListWorldEntitiesInChunkRange(ctx context.Context, arg db.ListWorldEntitiesInChunkRangeParams) ([]db.WorldEntity, error) {
+	var rows []db.WorldEntity
+	for id := int64(1); id <= f.nextID; id++ {
+		row, ok := f.rows[id]
+		if ok && row.WorldID == arg.WorldID &&
+			row.ChunkX >= arg.MinChunkX && row.ChunkX <= arg.MaxChunkX &&
+			row.ChunkY >= arg.MinChunkY && row.ChunkY <= arg.MaxChunkY &&
+			(arg.Type == "" || row.Type == arg.Type) {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+func (f *fakeDB) ListWorldEntitiesInRect(ctx context.Context, arg db.ListWorldEntitiesInRectParams) ([]db.WorldEntity, error) {
+	var rows []db.WorldEntity
+	for id := int64(1); id <= f.nextID; id++ {
+		row, ok := f.rows[id]
+		if ok && row.WorldID == arg.WorldID && row.X >= arg.MinX && row.X <= arg.MaxX && row.Y >= arg.MinY && row.Y <= arg.MaxY &&
+			(len(arg.Types) == 0 || slices.Contains(arg.Types, row.Type)) && len(rows) < int(arg.MaxResults) {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+func (f *fakeDB) ListWorldEntitiesWithinRadius(ctx context.Context, arg db.ListWorldEntitiesWithinRadiusParams) ([]db.WorldEntity, error) {
+	center := geometry.Point{X: arg.CenterX, Y: arg.CenterY}
+	var rows []db.WorldEntity
+	for id := int64(1); id <= f.nextID; id++ {
+		row, ok := f.rows[id]
+		if ok && row.WorldID == arg.WorldID && geometry.InRange(center, geometry.Point{X: row.X, Y: row.Y}, arg.Radius) &&
+			(len(arg.Types) == 0 || slices.Contains(arg.Types, row.Type)) {
+			rows = append(rows, row)
+		}
+	}
+	distance := func(row db.WorldEntity) int64 {
+		dx, dy := int64(row.X)-int64(center.X), int64(row.Y)-int64(center.Y)
+		return dx*dx + dy*dy
+	}
+	slices.SortStableFunc(rows, func(a, b db.WorldEntity) int { return cmp.Compare(distance(a), distance(b)) })
+	return rows[:min(len(rows), int(arg.MaxResults))], nil
+}
+
+func (f *fakeDB) UpdateWorldEntity(ctx context.Context, arg db.UpdateWorldEntityParams) (db.WorldEntity, error) {
+	row, ok := f.rows[arg.ID]
+	if !ok || row.Version != arg.Version {
+		return db.WorldEntity{}, pgx.ErrNoRows
+	}
+	row.ChunkX, row.ChunkY, row.X, row.Y = arg.ChunkX, arg.ChunkY, arg.X, arg.Y
+	row.Components = arg.Components
+	row.Version++
+	row.UpdatedAt = arg.Now
+	f.rows[row.ID] = row
+	return row, nil
+}
+
+func (f *fakeDB) DeleteWorldEntity(ctx context.Context, id int64) (int64, error) {
+	if _, ok := f.rows[id]; !ok {
+		return 0, nil
+	}
+	delete(f.rows, id)
+	return 1, nil
+}
+
+var worldID = pgtype.UUID{Bytes: [16]byte{15: 0xaa}, Valid: true}
+
+func newTestStore() (*Store, *clock.Fake) {
+	store := NewStore(&fakeDB{rows: map[int64]db.WorldEntity{}})
+	fake := clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	store.SetClock(fake)
+	return store, fake
+}
+
+func TestComponents(t *testing.T) {
+	components := Components{}
+	require.NoError(t, components.Set(Health{Current: 5, Max: 10}))
+	require.NoError(t, components.Validate())
+
+	health, ok, err := Get[Health](components)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, Health{Current: 5, Max: 10}, health)
+	_, ok, err = Get[Owner](components)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	Remove[Health](components)
+	assert.Empty(t, components)
+
+	tests := []struct {
+		name        string
+		components  Components
+		errContains string
+	}{
+		{"unknown component", Components{"wings": []byte(`{}`)}, `unknown component "wings"`},
+		{"unknown field", Components{"health": []byte(`{"current": 1, "max": 2, "armor": 3}`)}, `unknown field "armor"`},
+		{"wrong type", Components{"owner": []byte(`{"character_id": 7}`)}, `invalid component "owner"`},
+		{"component constraint", Components{"health": []byte(`{"current": 3, "max": 2}`)}, "between 0 and max"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorContains(t, tt.components.Validate(), tt.errContains)
+		})
+	}
+}
+
+func TestRegisterComponent_Duplicate(t *testing.T) {
+	assert.Panics(t, RegisterComponent[Health])
+}
+
+func TestStore(t *testing.T) {
+	store, fake := newTestStore()
+	ctx := context.Background()
+
+	components := Components{}
+	require.NoError(t, components.Set(Owner{CharacterID: "c1"}))
+	crop := &Entity{WorldID: worldID, Type: "crop", X: -1, Y: 40, Components: components}
+	require.NoError(t, store.Create(ctx, crop))
+	assert.Equal(t, int32(1), crop.Version)
+	chunkX, chunkY := crop.Chunk()
+	assert.Equal(t, int32(-1), chunkX, "negative cells are in negative chunks")
+	assert.Equal(t, int32(1), chunkY)
+
+	drop := &Entity{WorldID: worldID, Type: "drop", X: 0, Y: 0}
+	require.NoError(t, store.Create(ctx, drop))
+	assert.Error(t, store.Create(ctx, &Entity{WorldID: worldID}), "entities need a type")
+	assert.Error(t, store.Create(ctx, &Entity{WorldID: worldID, Type: "mob", Components: Components{"wings": []byte(`{}`)}}))
+
+	inChunk, err := store.ListInChunk(ctx, worldID, -1, 1)
+	require.NoError(t, err)
+	require.Len(t, inChunk, 1)
+	owner, ok, err := Get[Owner](inChunk[0].Components)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "c1", owner.CharacterID)
+
+	crops, err := store.ListInChunks(ctx, worldID, -1, 0, 0, 1, "crop")
+	require.NoError(t, err)
+	assert.Len(t, crops, 1)
+	all, err := store.ListInChunks(ctx, worldID, -1, 0, 0, 1, "")
+	require.NoError(t, err)
+	assert.Len(t, all, 2)
+
+	t.Run("updates are rejected when the entity changed since it was read", func(t *testing.T) {
+		stale, err := store.Get(ctx, crop.ID)
+		require.NoError(t, err)
+
+		fake.Advance(time.Minute)
+		crop.X = 33
+		require.NoError(t, crop.Components.Set(Health{Current: 1, Max: 1}))
+		require.NoError(t, store.Update(ctx, crop))
+		assert.Equal(t, int32(2), crop.Version)
+		assert.Equal(t, fake.Now(), crop.UpdatedAt)
+		moved, err := store.ListInChunk(ctx, worldID, 1, 1)
+		require.NoError(t, err)
+		assert.Len(t, moved, 1, "moving an entity moves it to its new chunk")
+
+		stale.Y = 0
+		assert.ErrorIs(t, store.Update(ctx, stale), ErrVersionConflict)
+	})
+
+	require.NoError(t, store.Delete(ctx, drop.ID))
+	assert.ErrorIs(t, store.Delete(ctx, drop.ID), ErrNotFound)
+	_, err = store.Get(ctx, drop.ID)
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.ErrorIs(t, store.Update(ctx, drop), ErrNotFound)
+}
+
+func TestStore_Spatial(t *testing.T) {
+	store, _ := newTestStore()
+	ctx := context.Background()
+	for _, e := range []*Entity{
+		{WorldID: worldID, Type: "mob", X: 10, Y: 10},
+		{WorldID: worldID, Type: "drop", X: 12, Y: 10},
+		{WorldID: worldID, Type: "mob", X: 11, Y: 11},
+		{WorldID: worldID, Type: "mob", X: 14, Y: 14},
+		{WorldID: pgtype.UUID{Bytes: [16]byte{15: 0xbb}, Valid: true}, Type: "mob", X: 10, Y: 10},
+	} {
+		require.NoError(t, store.Create(ctx, e))
+	}
+
+	near, err := store.WithinRadius(ctx, worldID, geometry.Point{X: 12, Y: 10}, 2)
+	require.NoError(t, err)
+	require.Len(t, near, 3)
+	assert.Equal(t, "drop", near[0].Type, "nearest first")
+	assert.Equal(t, geometry.Point{X: 11, Y: 11}, near[1].Position())
+
+	mobs, err := store.WithinRadius(ctx, worldID, geometry.Point{X: 12, Y: 10}, 2, "mob")
+	require.NoError(t, err)
+	assert.Len(t, mobs, 2)
+	_, err = store.WithinRadius(ctx, worldID, geometry.Point{}, -1)
+	assert.Error(t, err)
+
+	inRect, err := store.WithinRect(ctx, worldID, geometry.Around(geometry.Point{X: 13, Y: 12}, 1))
+	require.NoError(t, err)
+	assert.Empty(t, inRect)
+	inRect, err = store.WithinRect(ctx, worldID, geometry.Rect{MinX: 11, MinY: 10, MaxX: 14, MaxY: 14}, "mob", "drop")
+	require.NoError(t, err)
+	assert.Len(t, inRect, 3)
+	_, err = store.WithinRect(ctx, worldID, geometry.Rect{MinX: 1, MaxX: 0})
+	assert.Error(t, err)
+}
@@ -0,0 +1,70 @@
+package entity
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// MaxSpatialResults caps how many entities a spatial query returns, so a query over a
+// crowded area cannot load an unbounded number of rows
+const MaxSpatialResults = 1000
+
+// WithinRect loads the entities inside r, at most MaxSpatialResults of them, in creation
+// order. With types only entities of those types are loaded.
+func (s *Store) WithinRect(ctx context.Context, worldID pgtype.UUID, r geometry.Rect, types ...string) ([]*Entity, error) {
+	if r.MaxX < r.MinX || r.MaxY < r.MinY {
+		return nil, fmt.Errorf("invalid rectangle %+v", r)
+	}
+	rows, err := s.db.ListWorldEntitiesInRect(ctx, db.ListWorldEntitiesInRectParams{
+		WorldID:    worldID,
+		MinX:       r.MinX,
+		MaxX:       r.MaxX,
+		MinY:       r.MinY,
+		MaxY:       r.MaxY,
+		Types:      nonNil(types),
+		MaxResults: MaxSpatialResults,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query entities in rectangle: %w", err)
+	}
+	return fromRows(rows)
+}
+
+// WithinRadius loads the entities within radius cells of center (see geometry.InRange), nearest
+// first and at most MaxSpatialResults of them. With types only entities of those types
+// are loaded.
+func (s *Store) WithinRadius(ctx context.Context, worldID pgtype.UUID, center geometry.Point, radius int32, types ...string) ([]*Entity, error) {
+	if radius < 0 {
+		return nil, fmt.Errorf("radius must not be negative")
+	}
+	rows, err := s.db.ListWorldEntitiesWithinRadius(ctx, db.ListWorldEntitiesWithinRadiusParams{
+		WorldID:    worldID,
+		CenterX:    center.X,
+		CenterY:    center.Y,
+		Radius:     radius,
+		Types:      nonNil(types),
+		MaxResults: MaxSpatialResults,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query entities in radius: %w", err)
+	}
+	return fromRows(rows)
+}
+
+// Position returns the entity's cell
+func (e *Entity) Position() geometry.Point {
+	return geometry.Point{X: e.X, Y: e.Y}
+}
+
+// nonNil keeps an empty type filter an empty array rather than NULL, which the queries
+// would not match
+func nonNil(types []string) []string {
+	if types == nil {
+		return []string{}
+	}
+	return types
+}
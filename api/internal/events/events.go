@@ -0,0 +1,294 @@
+// Package events defines domain events and an in-process bus for publishing them.
+// Events are delivered at least once, so handlers should be idempotent or wrapped with Dedup.
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+)
+
+// Event types published by the API
+const (
+	AchievementUnlocked   = "achievement.unlocked"
+	CharacterCreated      = "character.created"
+	CharacterDied         = "character.died" // Reserved for combat and hazards
+	CharacterRenamed      = "character.renamed"
+	ChunkGenerated        = "chunk.generated"
+	ExperimentAssigned    = "experiment.assigned"
+	FriendRequestAccepted = "friend.request_accepted"
+	FriendRequestSent     = "friend.request_sent"
+	ItemCrafted           = "item.crafted"
+	LandClaimExpired      = "land_claim.expired"
+	MarketSaleCompleted   = "market.sale_completed"
+	ProcessingCompleted   = "processing.completed"
+	QuestCompleted        = "quest.completed"
+	RareEventCompleted    = "rare_event.completed"
+	RareEventSpawned      = "rare_event.spawned"
+	ResourceHarvested     = "resource.harvested"
+	ScriptEvent           = "script.event" // Special events spawned by scripts
+	TerrainModified       = "terrain.modified"
+	TradeCompleted        = "trade.completed"
+	UserNewDeviceLogin    = "user.new_device_login"
+)
+
+// AchievementUnlockedPayload is the payload of an AchievementUnlocked event
+type AchievementUnlockedPayload struct {
+	CharacterID     string `json:"character_id"`
+	UserID          string `json:"user_id"`
+	AchievementID   string `json:"achievement_id"`
+	AchievementName string `json:"achievement_name"`
+}
+
+// CharacterCreatedPayload is the payload of a CharacterCreated event
+type CharacterCreatedPayload struct {
+	CharacterID string `json:"character_id"`
+	UserID      string `json:"user_id"`
+	WorldID     string `json:"world_id"`
+	Name        string `json:"name"`
+	X           int32  `json:"x"`
+	Y           int32  `json:"y"`
+}
+
+// CharacterDiedPayload is the payload of a CharacterDied event
+type CharacterDiedPayload struct {
+	DeathID     string `json:"death_id"`
+	CharacterID string `json:"character_id"`
+	UserID      string `json:"user_id"`
+	WorldID     string `json:"world_id"`
+	X           int32  `json:"x"`
+	Y           int32  `json:"y"`
+	Cause       string `json:"cause"`
+}
+
+// CharacterRenamedPayload is the payload of a CharacterRenamed event
+type CharacterRenamedPayload struct {
+	CharacterID string `json:"character_id"`
+	UserID      string `json:"user_id"`
+	WorldID     string `json:"world_id"`
+	OldName     string `json:"old_name"`
+	NewName     string `json:"new_name"`
+}
+
+// ChunkGeneratedPayload is the payload of a ChunkGenerated event. CharacterID is the
+// character whose action generated the chunk; chunks loaded without one have none.
+type ChunkGeneratedPayload struct {
+	WorldID     string `json:"world_id"`
+	ChunkX      int32  `json:"chunk_x"`
+	ChunkY      int32  `json:"chunk_y"`
+	CharacterID string `json:"character_id,omitempty"`
+}
+
+// ExperimentAssignedPayload is the payload of an ExperimentAssigned event
+type ExperimentAssignedPayload struct {
+	Experiment string             `json:"experiment"`
+	Subject    string             `json:"subject"` // world or character
+	SubjectID  string             `json:"subject_id"`
+	Variant    string             `json:"variant"`
+	Params     map[string]float64 `json:"params"`
+}
+
+// FriendRequestPayload is the payload of FriendRequestSent and FriendRequestAccepted events
+type FriendRequestPayload struct {
+	RequesterID string `json:"requester_id"`
+	AddresseeID string `json:"addressee_id"`
+}
+
+// ItemCraftedPayload is the payload of an ItemCrafted event
+type ItemCraftedPayload struct {
+	CraftID     string `json:"craft_id"`
+	CharacterID string `json:"character_id"`
+	ItemID      int32  `json:"item_id"`
+	Quantity    int32  `json:"quantity"`
+}
+
+// LandClaimExpiredPayload is the payload of a LandClaimExpired event
+type LandClaimExpiredPayload struct {
+	ClaimID     int64  `json:"claim_id"`
+	CharacterID string `json:"character_id"`
+	UserID      string `json:"user_id"`
+	WorldID     string `json:"world_id"`
+	ChunkX      int32  `json:"chunk_x"`
+	ChunkY      int32  `json:"chunk_y"`
+}
+
+// MarketSaleCompletedPayload is the payload of a MarketSaleCompleted event
+type MarketSaleCompletedPayload struct {
+	SaleID    int64  `json:"sale_id"`
+	WorldID   string `json:"world_id"`
+	ItemID    int32  `json:"item_id"`
+	ItemName  string `json:"item_name"`
+	Quantity  int32  `json:"quantity"`
+	UnitPrice int32  `json:"unit_price"`
+}
+
+// ProcessingCompletedPayload is the payload of a ProcessingCompleted event
+type ProcessingCompletedPayload struct {
+	JobID       int64  `json:"job_id"`
+	CharacterID string `json:"character_id"`
+	UserID      string `json:"user_id"`
+	RecipeID    string `json:"recipe_id"`
+	RecipeName  string `json:"recipe_name"`
+}
+
+// QuestCompletedPayload is the payload of a QuestCompleted event
+type QuestCompletedPayload struct {
+	QuestID     string `json:"quest_id"`
+	QuestName   string `json:"quest_name"`
+	CharacterID string `json:"character_id"`
+	UserID      string `json:"user_id"`
+}
+
+// RareEventPayload is the payload of RareEventSpawned and RareEventCompleted events.
+// CharacterID is the contributor who completed the event; spawns have none.
+type RareEventPayload struct {
+	RareEventID int64  `json:"rare_event_id"`
+	WorldID     string `json:"world_id"`
+	Kind        string `json:"kind"`
+	X           int32  `json:"x"`
+	Y           int32  `json:"y"`
+	ChunkX      int32  `json:"chunk_x"`
+	ChunkY      int32  `json:"chunk_y"`
+	CharacterID string `json:"character_id,omitempty"`
+}
+
+// ResourceHarvestedPayload is the payload of a ResourceHarvested event
+type ResourceHarvestedPayload struct {
+	HarvestID          string           `json:"harvest_id"`
+	ResourceNodeID     int32            `json:"resource_node_id"`
+	ResourceNodeTypeID int32            `json:"resource_node_type_id"`
+	CharacterID        string           `json:"character_id"`
+	WorldID            string           `json:"world_id"`
+	ChunkX             int32            `json:"chunk_x"`
+	ChunkY             int32            `json:"chunk_y"`
+	Drops              int              `json:"drops"`
+	Items              map[string]int32 `json:"items,omitempty"` // Yields by item name, overflow included
+}
+
+// ScriptEventPayload is the payload of a ScriptEvent. Name and Data are chosen by the script.
+type ScriptEventPayload struct {
+	Script  string          `json:"script"`
+	Hook    string          `json:"hook"`
+	Name    string          `json:"name"`
+	WorldID string          `json:"world_id"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+// TerrainModifiedPayload is the payload of a TerrainModified event. Terrain types are chunk.v1.TerrainType values.
+type TerrainModifiedPayload struct {
+	DeltaID             int64  `json:"delta_id"`
+	WorldID             string `json:"world_id"`
+	CharacterID         string `json:"character_id,omitempty"`
+	X                   int32  `json:"x"`
+	Y                   int32  `json:"y"`
+	ChunkX              int32  `json:"chunk_x"`
+	ChunkY              int32  `json:"chunk_y"`
+	TerrainType         int32  `json:"terrain_type"`
+	PreviousTerrainType int32  `json:"previous_terrain_type"`
+}
+
+// TradeCompletedPayload is the payload of a TradeCompleted event
+type TradeCompletedPayload struct {
+	TradeID      string   `json:"trade_id"`
+	UserIDs      []string `json:"user_ids"`      // Every participant
+	CharacterIDs []string `json:"character_ids"` // The character each participant traded with
+}
+
+// UserNewDeviceLoginPayload is the payload of a UserNewDeviceLogin event
+type UserNewDeviceLoginPayload struct {
+	UserID    string `json:"user_id"`
+	SessionID string `json:"session_id"`
+	IPAddress string `json:"ip_address"`
+	UserAgent string `json:"user_agent"`
+}
+
+// Event is a domain event read back from the outbox
+type Event struct {
+	ID          int64
+	Type        string
+	AggregateID string
+	Payload     json.RawMessage
+	DedupKey    string
+	OccurredAt  time.Time
+}
+
+// Publisher delivers events to consumers
+type Publisher interface {
+	Publish(ctx context.Context, event Event) error
+}
+
+// Handler consumes a single event. Returning an error causes the event to be redelivered.
+type Handler func(ctx context.Context, event Event) error
+
+// Bus is an in-process Publisher that fans events out to subscribed handlers
+type Bus struct {
+	mu       sync.RWMutex
+	handlers map[string][]Handler
+}
+
+// NewBus creates an empty event bus
+func NewBus() *Bus {
+	return &Bus{handlers: make(map[string][]Handler)}
+}
+
+// Subscribe registers a handler for an event type
+func (b *Bus) Subscribe(eventType string, handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers[eventType] = append(b.handlers[eventType], handler)
+}
+
+// Publish calls every handler subscribed to the event type and joins their errors.
+// Handlers that already succeeded will see the event again on redelivery.
+func (b *Bus) Publish(ctx context.Context, event Event) error {
+	b.mu.RLock()
+	handlers := b.handlers[event.Type]
+	b.mu.RUnlock()
+
+	var errs []error
+	for _, handler := range handlers {
+		if err := handler(ctx, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// DefaultDedupCapacity is how many recently handled event keys subscribers remember per
+// event type, enough to cover redeliveries from the outbox dispatcher
+const DefaultDedupCapacity = 10000
+
+// Dedup wraps a handler so events whose dedup key was recently handled successfully are skipped.
+// It remembers up to capacity keys, evicting the oldest first.
+func Dedup(handler Handler, capacity int) Handler {
+	var (
+		mu    sync.Mutex
+		seen  = make(map[string]struct{}, capacity)
+		order = make([]string, 0, capacity)
+	)
+
+	return func(ctx context.Context, event Event) error {
+		mu.Lock()
+		_, dup := seen[event.DedupKey]
+		mu.Unlock()
+		if dup {
+			return nil
+		}
+
+		if err := handler(ctx, event); err != nil {
+			return err
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		if len(order) >= capacity {
+			delete(seen, order[0])
+			order = order[1:]
+		}
+		seen[event.DedupKey] = struct{}{}
+		order = append(order, event.DedupKey)
+		return nil
+	}
+}
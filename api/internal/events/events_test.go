@@ -0,0 +1,53 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBus_Publish(t *testing.T) {
+	bus := NewBus()
+	var got []string
+
+	bus.Subscribe(CharacterCreated, func(ctx context.Context, e Event) error {
+		got = append(got, "first:"+e.AggregateID)
+		return nil
+	})
+	bus.Subscribe(CharacterCreated, func(ctx context.Context, e Event) error {
+		got = append(got, "second:"+e.AggregateID)
+		return errors.New("boom")
+	})
+
+	err := bus.Publish(context.Background(), Event{Type: CharacterCreated, AggregateID: "c1"})
+	assert.ErrorContains(t, err, "boom")
+	assert.Equal(t, []string{"first:c1", "second:c1"}, got, "a failing handler doesn't stop the others")
+
+	assert.NoError(t, bus.Publish(context.Background(), Event{Type: ChunkGenerated}), "events without subscribers are dropped")
+}
+
+func TestDedup(t *testing.T) {
+	calls := 0
+	fail := true
+	handler := Dedup(func(ctx context.Context, e Event) error {
+		calls++
+		if fail {
+			return errors.New("transient")
+		}
+		return nil
+	}, 2)
+
+	ctx := context.Background()
+	assert.Error(t, handler(ctx, Event{DedupKey: "a"}))
+	fail = false
+	assert.NoError(t, handler(ctx, Event{DedupKey: "a"}), "failed deliveries are not remembered")
+	assert.NoError(t, handler(ctx, Event{DedupKey: "a"}))
+	assert.Equal(t, 2, calls, "redelivery of a handled event is skipped")
+
+	assert.NoError(t, handler(ctx, Event{DedupKey: "b"}))
+	assert.NoError(t, handler(ctx, Event{DedupKey: "c"}))
+	assert.NoError(t, handler(ctx, Event{DedupKey: "a"}))
+	assert.Equal(t, 5, calls, "oldest key is evicted once capacity is reached")
+}
@@ -0,0 +1,163 @@
+package faults
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/txn"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Pool is what services and txn use of a database pool; *pgxpool.Pool, *sqlite.DB and
+// pgxmock pools implement it
+type Pool interface {
+	db.DBTX
+	txn.Beginner
+}
+
+// DB wraps a pool so every statement, transaction begin and commit on it first goes
+// through the fault on its target. Build queries with db.New(d) and run transactions
+// with txn.Run(ctx, d, ...).
+type DB struct {
+	pool     Pool
+	injector *Injector
+	target   string
+	tracer   pgx.QueryTracer
+}
+
+// WrapPool injects the faults set on target into pool
+func WrapPool(pool Pool, injector *Injector, target string) *DB {
+	return &DB{pool: pool, injector: injector, target: target}
+}
+
+// SetTracer reports every statement's outcome, injected or not, to tracer the way pgx
+// reports to a pool's ConnConfig.Tracer. Use it to drive a dbbreaker.Breaker from a mock
+// pool; a real pool already traces its own statements.
+func (d *DB) SetTracer(tracer pgx.QueryTracer) {
+	d.tracer = tracer
+}
+
+func (d *DB) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	ctx, end := d.trace(ctx, sql, args)
+	if err := d.injector.Inject(ctx, d.target); err != nil {
+		end(err)
+		return pgconn.CommandTag{}, err
+	}
+	tag, err := d.pool.Exec(ctx, sql, args...)
+	end(err)
+	return tag, err
+}
+
+func (d *DB) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	ctx, end := d.trace(ctx, sql, args)
+	if err := d.injector.Inject(ctx, d.target); err != nil {
+		end(err)
+		return nil, err
+	}
+	rows, err := d.pool.Query(ctx, sql, args...)
+	end(err)
+	return rows, err
+}
+
+func (d *DB) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	ctx, end := d.trace(ctx, sql, args)
+	if err := d.injector.Inject(ctx, d.target); err != nil {
+		end(err)
+		return errRow{err}
+	}
+	row := d.pool.QueryRow(ctx, sql, args...)
+	end(nil)
+	return row
+}
+
+func (d *DB) CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
+	ctx, end := d.trace(ctx, "COPY "+tableName.Sanitize(), nil)
+	if err := d.injector.Inject(ctx, d.target); err != nil {
+		end(err)
+		return 0, err
+	}
+	n, err := d.pool.CopyFrom(ctx, tableName, columnNames, rowSrc)
+	end(err)
+	return n, err
+}
+
+// BeginTx injects the fault before beginning and wraps the transaction so its statements
+// and commit are injected too
+func (d *DB) BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error) {
+	if err := d.injector.Inject(ctx, d.target); err != nil {
+		return nil, err
+	}
+	tx, err := d.pool.BeginTx(ctx, txOptions)
+	if err != nil {
+		return nil, err
+	}
+	return &faultTx{Tx: tx, db: d}, nil
+}
+
+// trace starts a traced statement and returns the function that ends it
+func (d *DB) trace(ctx context.Context, sql string, args []interface{}) (context.Context, func(error)) {
+	if d.tracer == nil {
+		return ctx, func(error) {}
+	}
+	traced := d.tracer.TraceQueryStart(ctx, nil, pgx.TraceQueryStartData{SQL: sql, Args: args})
+	return traced, func(err error) {
+		d.tracer.TraceQueryEnd(traced, nil, pgx.TraceQueryEndData{Err: err})
+	}
+}
+
+// faultTx injects into a transaction's statements and commit; rollback always runs so a
+// failed attempt cleans up
+type faultTx struct {
+	pgx.Tx
+	db *DB
+}
+
+func (t *faultTx) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	ctx, end := t.db.trace(ctx, sql, args)
+	if err := t.db.injector.Inject(ctx, t.db.target); err != nil {
+		end(err)
+		return pgconn.CommandTag{}, err
+	}
+	tag, err := t.Tx.Exec(ctx, sql, args...)
+	end(err)
+	return tag, err
+}
+
+func (t *faultTx) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	ctx, end := t.db.trace(ctx, sql, args)
+	if err := t.db.injector.Inject(ctx, t.db.target); err != nil {
+		end(err)
+		return nil, err
+	}
+	rows, err := t.Tx.Query(ctx, sql, args...)
+	end(err)
+	return rows, err
+}
+
+func (t *faultTx) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	ctx, end := t.db.trace(ctx, sql, args)
+	if err := t.db.injector.Inject(ctx, t.db.target); err != nil {
+		end(err)
+		return errRow{err}
+	}
+	row := t.Tx.QueryRow(ctx, sql, args...)
+	end(nil)
+	return row
+}
+
+func (t *faultTx) Commit(ctx context.Context) error {
+	if err := t.db.injector.Inject(ctx, t.db.target); err != nil {
+		return err
+	}
+	return t.Tx.Commit(ctx)
+}
+
+// errRow is the row of a QueryRow that failed before reaching the database
+type errRow struct {
+	err error
+}
+
+func (r errRow) Scan(...any) error {
+	return r.err
+}
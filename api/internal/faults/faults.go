@@ -0,0 +1,189 @@
+// Package faults injects artificial latency and errors into the server for resilience
+// testing. Faults are keyed by target, a gRPC service name for the interceptor or any
+// name a wrapped database pool was given, so one service can be made slow or flaky while
+// the rest behave normally.
+//
+// Nothing here runs unless it is wired in: tests wrap the pools and servers they build,
+// and the server only adds the interceptor when FAULT_INJECTION is set. Never set it in
+// production.
+package faults
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// ErrTransient is a serialization failure, which txn retries
+	ErrTransient error = &pgconn.PgError{Code: "40001", Message: "could not serialize access due to injected fault"}
+	// ErrConnectionDropped looks like a connection the database closed mid-query; it is not
+	// retried and counts as a failure for the circuit breaker
+	ErrConnectionDropped = errors.New("read tcp 127.0.0.1:5432: connection reset by peer (injected fault)")
+	// ErrUnavailable is the status a service answers with while it is down
+	ErrUnavailable = status.Error(codes.Unavailable, "service unavailable (injected fault)")
+)
+
+// errorNames are the errors a fault spec can name
+var errorNames = map[string]error{
+	"transient":   ErrTransient,
+	"dropped":     ErrConnectionDropped,
+	"unavailable": ErrUnavailable,
+}
+
+// Fault is what happens to a call on a target
+type Fault struct {
+	Latency time.Duration // Added before the call, cut short if the context ends
+	Err     error         // Returned instead of running the call; nil only adds latency
+	Rate    float64       // Share of calls the fault applies to; 0 means every call
+	Times   int           // Calls the fault applies to before it clears itself; 0 means no limit
+}
+
+// Injector holds the active faults. It is safe for concurrent use.
+type Injector struct {
+	mu       sync.Mutex
+	clock    clock.Clock
+	rand     *rand.Rand
+	faults   map[string]*Fault
+	injected map[string]int
+}
+
+// New creates an injector without faults. The seed makes partial rates repeatable.
+func New(seed uint64) *Injector {
+	return &Injector{
+		clock:    clock.New(),
+		rand:     rand.New(rand.NewPCG(seed, seed)),
+		faults:   make(map[string]*Fault),
+		injected: make(map[string]int),
+	}
+}
+
+// SetClock replaces the clock latency waits on (for testing)
+func (i *Injector) SetClock(c clock.Clock) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.clock = c
+}
+
+// Set replaces the fault on target
+func (i *Injector) Set(target string, fault Fault) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.faults[target] = &fault
+}
+
+// Clear removes the fault on target
+func (i *Injector) Clear(target string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	delete(i.faults, target)
+}
+
+// Reset removes every fault and forgets the injected counts
+func (i *Injector) Reset() {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.faults = make(map[string]*Fault)
+	i.injected = make(map[string]int)
+}
+
+// Injected returns how many calls on target a fault has applied to
+func (i *Injector) Injected(target string) int {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.injected[target]
+}
+
+// Inject applies the fault on target, if any, to one call: it waits out the latency and
+// returns the fault's error. A context that ends during the wait returns its error.
+func (i *Injector) Inject(ctx context.Context, target string) error {
+	i.mu.Lock()
+	fault, ok := i.faults[target]
+	if !ok || (fault.Rate > 0 && i.rand.Float64() >= fault.Rate) {
+		i.mu.Unlock()
+		return nil
+	}
+	latency, err, c := fault.Latency, fault.Err, i.clock
+	i.injected[target]++
+	if fault.Times > 0 {
+		fault.Times--
+		if fault.Times == 0 {
+			delete(i.faults, target)
+		}
+	}
+	i.mu.Unlock()
+
+	if latency > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-c.After(latency):
+		}
+	}
+	return err
+}
+
+// ParseSpec reads faults written as target=option,option;target=option... where the
+// options are latency:<duration>, error:transient|dropped|unavailable, rate:<0-1> and
+// times:<n>, for example
+//
+//	inventory.v1.InventoryService=latency:200ms,rate:0.5;market.v1.MarketService=error:unavailable
+func ParseSpec(spec string) (map[string]Fault, error) {
+	faults := make(map[string]Fault)
+	for _, entry := range strings.Split(spec, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		target, options, ok := strings.Cut(entry, "=")
+		target = strings.TrimSpace(target)
+		if !ok || target == "" {
+			return nil, fmt.Errorf("fault %q: expected target=options", entry)
+		}
+
+		var fault Fault
+		for _, option := range strings.Split(options, ",") {
+			key, value, _ := strings.Cut(strings.TrimSpace(option), ":")
+			var err error
+			switch key {
+			case "latency":
+				fault.Latency, err = time.ParseDuration(value)
+			case "error":
+				var known bool
+				if fault.Err, known = errorNames[value]; !known {
+					err = errors.New("unknown error, use transient, dropped or unavailable")
+				}
+			case "rate":
+				fault.Rate, err = strconv.ParseFloat(value, 64)
+				if err == nil && (fault.Rate <= 0 || fault.Rate > 1) {
+					err = errors.New("must be above 0 and at most 1")
+				}
+			case "times":
+				fault.Times, err = strconv.Atoi(value)
+				if err == nil && fault.Times < 1 {
+					err = errors.New("must be positive")
+				}
+			default:
+				err = errors.New("unknown option")
+			}
+			if err != nil {
+				return nil, fmt.Errorf("fault %s: %s: %w", target, option, err)
+			}
+		}
+		if fault.Latency <= 0 && fault.Err == nil {
+			return nil, fmt.Errorf("fault %s: needs a latency or an error", target)
+		}
+		faults[target] = fault
+	}
+	return faults, nil
+}
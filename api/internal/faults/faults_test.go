@@ -0,0 +1,123 @@
+package faults
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestInjector_Inject(t *testing.T) {
+	ctx := context.Background()
+	injector := New(1)
+
+	assert.NoError(t, injector.Inject(ctx, "inventory"), "targets without a fault are untouched")
+
+	injector.Set("inventory", Fault{Err: ErrTransient, Times: 2})
+	assert.ErrorIs(t, injector.Inject(ctx, "inventory"), ErrTransient)
+	assert.NoError(t, injector.Inject(ctx, "market"), "faults only apply to their target")
+	assert.ErrorIs(t, injector.Inject(ctx, "inventory"), ErrTransient)
+	assert.NoError(t, injector.Inject(ctx, "inventory"), "the fault clears itself after Times calls")
+	assert.Equal(t, 2, injector.Injected("inventory"))
+
+	injector.Set("inventory", Fault{Err: ErrConnectionDropped})
+	injector.Clear("inventory")
+	assert.NoError(t, injector.Inject(ctx, "inventory"))
+
+	injector.Reset()
+	assert.Zero(t, injector.Injected("inventory"))
+}
+
+func TestInjector_Rate(t *testing.T) {
+	run := func() int {
+		injector := New(42)
+		injector.Set("chunk", Fault{Err: ErrUnavailable, Rate: 0.25})
+		for i := 0; i < 1000; i++ {
+			_ = injector.Inject(context.Background(), "chunk")
+		}
+		return injector.Injected("chunk")
+	}
+
+	injected := run()
+	assert.InDelta(t, 250, injected, 50)
+	assert.Equal(t, injected, run(), "the same seed injects into the same calls")
+}
+
+func TestInjector_Latency(t *testing.T) {
+	fake := clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	injector := New(1)
+	injector.SetClock(fake)
+	injector.Set("world", Fault{Latency: time.Second})
+
+	done := make(chan error, 1)
+	go func() { done <- injector.Inject(context.Background(), "world") }()
+	require.Eventually(t, func() bool { return fake.Waiters() == 1 }, time.Second, time.Millisecond)
+	select {
+	case <-done:
+		t.Fatal("returned before the latency passed")
+	default:
+	}
+	fake.Advance(time.Second)
+	assert.NoError(t, <-done, "a latency-only fault lets the call through")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, injector.Inject(ctx, "world"), context.Canceled, "an ended context cuts the wait short")
+}
+
+func TestParseSpec(t *testing.T) {
+	faults, err := ParseSpec(" inventory.v1.InventoryService=latency:200ms,rate:0.5 ; market.v1.MarketService=error:unavailable,times:3;")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]Fault{
+		"inventory.v1.InventoryService": {Latency: 200 * time.Millisecond, Rate: 0.5},
+		"market.v1.MarketService":       {Err: ErrUnavailable, Times: 3},
+	}, faults)
+
+	empty, err := ParseSpec("")
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+
+	for spec, want := range map[string]string{
+		"inventory":                  "expected target=options",
+		"inventory=error:flaky":      "unknown error",
+		"inventory=latency:soon":     "invalid duration",
+		"inventory=rate:0":           "above 0",
+		"inventory=times:0":          "must be positive",
+		"inventory=jitter:5ms":       "unknown option",
+		"inventory=rate:0.5,times:1": "needs a latency or an error",
+	} {
+		_, err := ParseSpec(spec)
+		assert.ErrorContains(t, err, want, spec)
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	injector := New(1)
+	interceptor := UnaryServerInterceptor(injector)
+	info := &grpc.UnaryServerInfo{FullMethod: "/market.v1.MarketService/ListOrders"}
+	handler := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+
+	resp, err := interceptor(context.Background(), nil, info, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+
+	injector.Set("market.v1.MarketService", Fault{Err: ErrConnectionDropped})
+	_, err = interceptor(context.Background(), nil, info, handler)
+	assert.Equal(t, codes.Unavailable, status.Code(err), "errors without a status are answered as Unavailable")
+
+	injector.Set("market.v1.MarketService", Fault{Err: status.Error(codes.ResourceExhausted, "slow down")})
+	_, err = interceptor(context.Background(), nil, info, handler)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	injector.Set("market.v1.MarketService", Fault{Latency: time.Hour})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = interceptor(ctx, nil, info, handler)
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+}
@@ -0,0 +1,50 @@
+package faults
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor injects the fault set on each request's gRPC service, such as
+// "inventory.v1.InventoryService", before its handler runs. Errors that aren't gRPC
+// statuses are answered as Unavailable, as a failing database would be.
+func UnaryServerInterceptor(injector *Injector) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := injector.Inject(ctx, serviceName(info.FullMethod)); err != nil {
+			return nil, statusError(err)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor injects the fault on a stream's service before the stream starts
+func StreamServerInterceptor(injector *Injector) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := injector.Inject(ss.Context(), serviceName(info.FullMethod)); err != nil {
+			return statusError(err)
+		}
+		return handler(srv, ss)
+	}
+}
+
+// statusError converts an injected error to what the client sees: a context that ended
+// during injected latency keeps its code, and errors without a status become Unavailable
+func statusError(err error) error {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return ErrUnavailable
+}
+
+// serviceName returns "pkg.Service" of "/pkg.Service/Method"
+func serviceName(fullMethod string) string {
+	service, _, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	return service
+}
@@ -0,0 +1,163 @@
+package faults_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/dbbreaker"
+	"github.com/VoidMesh/api/api/internal/faults"
+	"github.com/VoidMesh/api/api/internal/txn"
+	"github.com/VoidMesh/api/api/server/middleware"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const target = "inventory-db"
+
+func newMockPool(t *testing.T) pgxmock.PgxPoolIface {
+	t.Helper()
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	t.Cleanup(mock.Close)
+	return mock
+}
+
+func expectItem(mock pgxmock.PgxPoolIface) {
+	mock.ExpectQuery("SELECT (.+) FROM items WHERE id = \\$1").
+		WithArgs(int32(1)).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "name", "description", "item_type", "rarity", "stack_size", "visual_data", "created_at"}).
+			AddRow(int32(1), "Stone", "", "material", "common", int32(64), []byte("{}"), nil))
+}
+
+var fastRetry = txn.Policy{Isolation: pgx.Serializable, MaxAttempts: 3, Backoff: time.Millisecond}
+
+func TestTransactionsRetryTransientFaults(t *testing.T) {
+	mock := newMockPool(t)
+	injector := faults.New(1)
+	pool := faults.WrapPool(mock, injector, target)
+
+	// A serialization failure on the first begin, then one on the first statement of the
+	// transaction that does start, then a clean run
+	injector.Set(target, faults.Fault{Err: faults.ErrTransient, Times: 1})
+	mock.ExpectBeginTx(pgx.TxOptions{IsoLevel: pgx.Serializable})
+	mock.ExpectRollback()
+	mock.ExpectBeginTx(pgx.TxOptions{IsoLevel: pgx.Serializable})
+	expectItem(mock)
+	mock.ExpectCommit()
+
+	attempts := 0
+	err := txn.Run(context.Background(), pool, fastRetry, func(q *db.Queries) error {
+		attempts++
+		if attempts == 1 {
+			injector.Set(target, faults.Fault{Err: faults.ErrTransient, Times: 1})
+		}
+		_, err := q.GetItem(context.Background(), 1)
+		return err
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, attempts, "the failed begin never ran the flow")
+	assert.Equal(t, 2, injector.Injected(target))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTransactionsDoNotRetryDroppedConnections(t *testing.T) {
+	mock := newMockPool(t)
+	injector := faults.New(1)
+	pool := faults.WrapPool(mock, injector, target)
+
+	injector.Set(target, faults.Fault{Err: faults.ErrConnectionDropped})
+	err := txn.Run(context.Background(), pool, fastRetry, func(q *db.Queries) error {
+		t.Fatal("the flow must not run without a transaction")
+		return nil
+	})
+	assert.ErrorIs(t, err, faults.ErrConnectionDropped)
+	assert.Equal(t, 1, injector.Injected(target), "only serialization failures and deadlocks are retried")
+
+	injector.Set(target, faults.Fault{Err: faults.ErrTransient})
+	err = txn.Run(context.Background(), pool, fastRetry, func(q *db.Queries) error { return nil })
+	assert.ErrorContains(t, err, "transaction failed after 3 attempts")
+	assert.True(t, txn.IsRetryable(err))
+}
+
+func TestCircuitBreakerOpensOnDroppedConnectionsAndRecovers(t *testing.T) {
+	fake := clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	breaker := dbbreaker.New(dbbreaker.Config{
+		Window:         10 * time.Second,
+		MinQueries:     4,
+		FailureRatio:   0.5,
+		SlowQuery:      time.Second,
+		OpenFor:        15 * time.Second,
+		ProbeSuccesses: 2,
+	})
+	breaker.SetClock(fake)
+
+	mock := newMockPool(t)
+	injector := faults.New(1)
+	pool := faults.WrapPool(mock, injector, target)
+	pool.SetTracer(breaker.Tracer())
+	queries := db.New(pool)
+
+	interceptor := middleware.CircuitBreakerInterceptor(breaker, middleware.NewResponseCache(8), nil)
+	info := &grpc.UnaryServerInfo{FullMethod: "/inventory.v1.InventoryService/GetItem"}
+	getItem := func() error {
+		_, err := interceptor(context.Background(), nil, info, func(ctx context.Context, req any) (any, error) {
+			return queries.GetItem(ctx, 1)
+		})
+		return err
+	}
+
+	injector.Set(target, faults.Fault{Err: faults.ErrConnectionDropped})
+	for i := 0; i < 4; i++ {
+		assert.ErrorIs(t, getItem(), faults.ErrConnectionDropped)
+	}
+	assert.Equal(t, dbbreaker.Open, breaker.State())
+
+	err := getItem()
+	assert.Equal(t, codes.Unavailable, status.Code(err), "requests fail fast while the breaker is open")
+	var reason string
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			reason = info.Reason
+		}
+	}
+	assert.Equal(t, dbbreaker.ReasonDatabaseUnavailable, reason)
+	assert.Equal(t, 4, injector.Injected(target), "rejected requests never reach the database")
+
+	// The database comes back; after OpenFor probes succeed and close the breaker
+	injector.Clear(target)
+	fake.Advance(15 * time.Second)
+	expectItem(mock)
+	expectItem(mock)
+	require.NoError(t, getItem())
+	assert.Equal(t, dbbreaker.HalfOpen, breaker.State())
+	require.NoError(t, getItem())
+	assert.Equal(t, dbbreaker.Closed, breaker.State())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestInjectedLatencyIsCutOffByTheRPCTimeout(t *testing.T) {
+	injector := faults.New(1)
+	injector.Set("world.v1.WorldService", faults.Fault{Latency: time.Hour})
+	timeout := middleware.TimeoutInterceptor(20*time.Millisecond, nil)
+	inject := faults.UnaryServerInterceptor(injector)
+	info := &grpc.UnaryServerInfo{FullMethod: "/world.v1.WorldService/GetWorld"}
+
+	start := time.Now()
+	_, err := timeout(context.Background(), nil, info, func(ctx context.Context, req any) (any, error) {
+		return inject(ctx, req, info, func(context.Context, any) (any, error) {
+			t.Fatal("the handler must not run")
+			return nil, nil
+		})
+	})
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	assert.Less(t, time.Since(start), time.Second)
+}
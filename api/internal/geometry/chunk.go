@@ -0,0 +1,42 @@
+package geometry
+
+// ChunkSize is the width and height of a chunk in cells
+const ChunkSize = 32
+
+// FloorDiv divides rounding towards negative infinity, so negative coordinates map to
+// the cell or chunk they are in rather than the one nearer zero
+func FloorDiv(a, b int32) int32 {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
+// ChunkOf returns the chunk containing a cell
+func ChunkOf(p Point) (chunkX, chunkY int32) {
+	return FloorDiv(p.X, ChunkSize), FloorDiv(p.Y, ChunkSize)
+}
+
+// CellLocation returns the chunk containing a cell and the cell's row-major index in it
+func CellLocation(p Point) (chunkX, chunkY, index int32) {
+	chunkX, chunkY = ChunkOf(p)
+	localX := p.X - chunkX*ChunkSize
+	localY := p.Y - chunkY*ChunkSize
+	return chunkX, chunkY, localY*ChunkSize + localX
+}
+
+// CellAt is the inverse of CellLocation: the world cell at a row-major index of a chunk
+func CellAt(chunkX, chunkY, index int32) Point {
+	return Point{X: chunkX*ChunkSize + index%ChunkSize, Y: chunkY*ChunkSize + index/ChunkSize}
+}
+
+// ChunkOrigin returns a chunk's lowest cell
+func ChunkOrigin(chunkX, chunkY int32) Point {
+	return Point{X: chunkX * ChunkSize, Y: chunkY * ChunkSize}
+}
+
+// ChunkCenter returns the cell at the middle of a chunk
+func ChunkCenter(chunkX, chunkY int32) Point {
+	return Point{X: chunkX*ChunkSize + ChunkSize/2, Y: chunkY*ChunkSize + ChunkSize/2}
+}
@@ -0,0 +1,56 @@
+// Package geometry is the shared world cell math: points and rectangles, the reach checks
+// for anything a character interacts with, mapping cells to chunks, and Bresenham lines
+// for line of sight. Services use it instead of their own coordinate code so every reach
+// and chunk boundary is computed the same way.
+package geometry
+
+// Point is a world cell position
+type Point struct {
+	X int32
+	Y int32
+}
+
+// Rect is an area of world cells; both bounds are inclusive
+type Rect struct {
+	MinX int32
+	MinY int32
+	MaxX int32
+	MaxY int32
+}
+
+// Around returns the square of cells within radius of center on both axes
+func Around(center Point, radius int32) Rect {
+	return Rect{MinX: center.X - radius, MinY: center.Y - radius, MaxX: center.X + radius, MaxY: center.Y + radius}
+}
+
+// Contains reports whether p is inside the rectangle
+func (r Rect) Contains(p Point) bool {
+	return p.X >= r.MinX && p.X <= r.MaxX && p.Y >= r.MinY && p.Y <= r.MaxY
+}
+
+// InRange reports whether b is within radius cells of a, measured as a straight line.
+// It is the distance check for anything a character reaches: harvesting, picking up
+// drops, contributing to events.
+func InRange(a, b Point, radius int32) bool {
+	dx, dy, r := int64(a.X)-int64(b.X), int64(a.Y)-int64(b.Y), int64(radius)
+	// Rejecting points outside the bounding square first keeps the squares from overflowing
+	if dx < -r || dx > r || dy < -r || dy > r {
+		return false
+	}
+	return dx*dx+dy*dy <= r*r
+}
+
+// WithinSquare reports whether b is at most radius cells from a on both axes, so the
+// diagonal cells of the square count as in reach. Cell edits use it: a character can
+// change any cell of the square around it.
+func WithinSquare(a, b Point, radius int32) bool {
+	return Around(a, radius).Contains(b)
+}
+
+// Abs returns the absolute value of v
+func Abs(v int32) int32 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
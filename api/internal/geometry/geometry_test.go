@@ -0,0 +1,120 @@
+package geometry
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInRange(t *testing.T) {
+	origin := Point{X: 0, Y: 0}
+	assert.True(t, InRange(origin, origin, 0))
+	assert.True(t, InRange(origin, Point{X: 3, Y: 0}, 3))
+	assert.True(t, InRange(origin, Point{X: -2, Y: 2}, 3), "diagonals are measured as a straight line")
+	assert.False(t, InRange(origin, Point{X: 2, Y: 3}, 3))
+	assert.False(t, InRange(Point{X: math.MinInt32, Y: 0}, Point{X: math.MaxInt32, Y: 0}, math.MaxInt32), "far apart points do not overflow")
+}
+
+func TestWithinSquare(t *testing.T) {
+	center := Point{X: 10, Y: 10}
+	assert.True(t, WithinSquare(center, Point{X: 11, Y: 9}, 1), "diagonal neighbours are in reach")
+	assert.True(t, WithinSquare(center, center, 0))
+	assert.False(t, WithinSquare(center, Point{X: 12, Y: 10}, 1))
+	assert.True(t, Around(center, 2).Contains(Point{X: 8, Y: 12}))
+}
+
+func TestFloorDiv(t *testing.T) {
+	cases := []struct{ a, b, want int32 }{
+		{0, 32, 0}, {31, 32, 0}, {32, 32, 1}, {-1, 32, -1}, {-32, 32, -1}, {-33, 32, -2},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.want, FloorDiv(c.a, c.b), fmt.Sprintf("%d/%d", c.a, c.b))
+	}
+}
+
+func TestChunkOf(t *testing.T) {
+	tests := []struct {
+		p              Point
+		chunkX, chunkY int32
+	}{
+		{Point{X: 64, Y: 96}, 2, 3},
+		{Point{X: 0, Y: 0}, 0, 0},
+		{Point{X: -32, Y: -64}, -1, -2},
+		{Point{X: -33, Y: -65}, -2, -3},
+		{Point{X: 15, Y: -17}, 0, -1},
+	}
+	for _, tt := range tests {
+		chunkX, chunkY := ChunkOf(tt.p)
+		assert.Equal(t, []int32{tt.chunkX, tt.chunkY}, []int32{chunkX, chunkY}, "cell %+v", tt.p)
+	}
+}
+
+func TestCellLocation(t *testing.T) {
+	tests := []struct {
+		p                     Point
+		chunkX, chunkY, index int32
+	}{
+		{Point{X: 0, Y: 0}, 0, 0, 0},
+		{Point{X: 31, Y: 0}, 0, 0, 31},
+		{Point{X: 32, Y: 1}, 1, 0, 32},
+		{Point{X: -1, Y: -1}, -1, -1, ChunkSize*ChunkSize - 1},
+		{Point{X: -32, Y: 5}, -1, 0, 5 * ChunkSize},
+	}
+	for _, tt := range tests {
+		chunkX, chunkY, index := CellLocation(tt.p)
+		assert.Equal(t, []int32{tt.chunkX, tt.chunkY, tt.index}, []int32{chunkX, chunkY, index}, "cell %+v", tt.p)
+		assert.Equal(t, tt.p, CellAt(chunkX, chunkY, index), "CellAt inverts CellLocation")
+	}
+
+	assert.Equal(t, Point{X: -32, Y: 64}, ChunkOrigin(-1, 2))
+	assert.Equal(t, Point{X: -16, Y: 80}, ChunkCenter(-1, 2))
+}
+
+func TestLine(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Point
+		want []Point
+	}{
+		{"single cell", Point{X: 1, Y: 1}, Point{X: 1, Y: 1}, []Point{{X: 1, Y: 1}}},
+		{"horizontal", Point{X: 0, Y: 0}, Point{X: 3, Y: 0}, []Point{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 2, Y: 0}, {X: 3, Y: 0}}},
+		{"vertical down", Point{X: 0, Y: 2}, Point{X: 0, Y: 0}, []Point{{X: 0, Y: 2}, {X: 0, Y: 1}, {X: 0, Y: 0}}},
+		{"diagonal", Point{X: 0, Y: 0}, Point{X: -2, Y: 2}, []Point{{X: 0, Y: 0}, {X: -1, Y: 1}, {X: -2, Y: 2}}},
+		{"shallow", Point{X: 0, Y: 0}, Point{X: 4, Y: 2}, []Point{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 2, Y: 1}, {X: 3, Y: 1}, {X: 4, Y: 2}}},
+		{"steep", Point{X: 0, Y: 0}, Point{X: 1, Y: 3}, []Point{{X: 0, Y: 0}, {X: 0, Y: 1}, {X: 1, Y: 2}, {X: 1, Y: 3}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Line(tt.a, tt.b))
+		})
+	}
+
+	t.Run("traced the same cells in both directions", func(t *testing.T) {
+		for _, b := range []Point{{X: 5, Y: 2}, {X: -3, Y: 7}, {X: 2, Y: -9}, {X: -6, Y: -1}} {
+			forward, backward := Line(Point{}, b), Line(b, Point{})
+			assert.ElementsMatch(t, forward, backward, "line to %+v", b)
+			assert.Equal(t, forward[0], backward[len(backward)-1])
+			for i := 1; i < len(forward); i++ {
+				step := Point{X: forward[i].X - forward[i-1].X, Y: forward[i].Y - forward[i-1].Y}
+				assert.True(t, WithinSquare(Point{}, step, 1), "cells are adjacent")
+			}
+		}
+	})
+
+	assert.Nil(t, Line(Point{}, Point{X: MaxLineLength}), "lines that are too long are not traced")
+	assert.Nil(t, Line(Point{X: math.MinInt32}, Point{X: math.MaxInt32}))
+}
+
+func TestLineOfSight(t *testing.T) {
+	wall := map[Point]bool{{X: 2, Y: 0}: true}
+	blocks := func(p Point) bool { return wall[p] }
+
+	assert.False(t, LineOfSight(Point{X: 0, Y: 0}, Point{X: 4, Y: 0}, blocks))
+	assert.True(t, LineOfSight(Point{X: 0, Y: 0}, Point{X: 2, Y: 0}, blocks), "the target's own cell does not hide it")
+	assert.True(t, LineOfSight(Point{X: 2, Y: 0}, Point{X: 4, Y: 0}, blocks), "neither does the viewer's")
+	assert.True(t, LineOfSight(Point{X: 0, Y: 1}, Point{X: 4, Y: 1}, blocks))
+	assert.True(t, LineOfSight(Point{X: 1, Y: 0}, Point{X: 2, Y: 1}, blocks), "adjacent cells always see each other")
+	assert.False(t, LineOfSight(Point{}, Point{X: MaxLineLength}, blocks))
+}
@@ -0,0 +1,74 @@
+package geometry
+
+// MaxLineLength bounds the lines Line traces, so a request with far apart points cannot
+// make the server walk millions of cells
+const MaxLineLength = 1024
+
+// Line returns the cells of the Bresenham line from a to b, both included, in order from
+// a. The line is the same cells in reverse when traced from b, so sight is symmetric.
+// Lines longer than MaxLineLength cells return nil.
+func Line(a, b Point) []Point {
+	dx, dy := int64(b.X)-int64(a.X), int64(b.Y)-int64(a.Y)
+	adx, ady := abs64(dx), abs64(dy)
+	if max(adx, ady) >= MaxLineLength {
+		return nil
+	}
+
+	// Trace from the lower end so both directions produce the same cells
+	if dx < 0 || (dx == 0 && dy < 0) {
+		line := Line(b, a)
+		for i, j := 0, len(line)-1; i < j; i, j = i+1, j-1 {
+			line[i], line[j] = line[j], line[i]
+		}
+		return line
+	}
+
+	stepY := int64(1)
+	if dy < 0 {
+		stepY = -1
+	}
+	line := make([]Point, 0, max(adx, ady)+1)
+	x, y := int64(a.X), int64(a.Y)
+	errTerm := adx - ady
+	for {
+		line = append(line, Point{X: int32(x), Y: int32(y)})
+		if x == int64(b.X) && y == int64(b.Y) {
+			return line
+		}
+		doubled := 2 * errTerm
+		if doubled > -ady {
+			errTerm -= ady
+			x++
+		}
+		if doubled < adx {
+			errTerm += adx
+			y += stepY
+		}
+	}
+}
+
+// LineOfSight reports whether nothing blocks the line from a to b. Only the cells between
+// the two ends are checked, so neither the viewer's cell nor the target's cell hides the
+// target. Lines too long to trace are never clear.
+func LineOfSight(a, b Point, blocks func(Point) bool) bool {
+	line := Line(a, b)
+	if line == nil {
+		return false
+	}
+	if len(line) <= 2 {
+		return true
+	}
+	for _, p := range line[1 : len(line)-1] {
+		if blocks(p) {
+			return false
+		}
+	}
+	return true
+}
+
+func abs64(v int64) int64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,213 @@
+// Package interest routes positional events to the stream subscribers whose area of
+// interest contains them. Subscribers are indexed in a uniform grid so routing an event
+// only inspects the subscribers registered in the event's cell, not every subscriber.
+package interest
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/VoidMesh/api/api/internal/geometry"
+)
+
+// Point is a world cell position
+type Point = geometry.Point
+
+type cellKey struct {
+	worldID string
+	x, y    int32
+}
+
+// Subscription is a circular area of interest around a centre point. Events are delivered
+// on C; when the buffer is full further events are dropped and counted.
+type Subscription[T any] struct {
+	C <-chan T
+
+	ch       chan T
+	worldID  string
+	anchorID string
+	center   Point
+	radius   int32
+	minCell  Point
+	maxCell  Point
+	dropped  atomic.Int64
+	closed   bool
+}
+
+// Dropped returns how many events were discarded because the subscriber fell behind
+func (s *Subscription[T]) Dropped() int64 {
+	return s.dropped.Load()
+}
+
+func (s *Subscription[T]) contains(p Point) bool {
+	return geometry.InRange(s.center, p, s.radius)
+}
+
+// Manager tracks subscriptions and routes events to the ones whose area of interest
+// contains the event position. It is safe for concurrent use.
+type Manager[T any] struct {
+	cellSize int32
+
+	mu      sync.RWMutex
+	cells   map[cellKey]map[*Subscription[T]]struct{}
+	anchors map[string]map[*Subscription[T]]struct{}
+	count   int
+}
+
+// NewManager creates a manager indexing subscribers in cells of cellSize×cellSize world cells.
+// A cell size close to the typical radius keeps both routing and re-indexing cheap.
+func NewManager[T any](cellSize int32) *Manager[T] {
+	if cellSize <= 0 {
+		cellSize = 1
+	}
+	return &Manager[T]{
+		cellSize: cellSize,
+		cells:    make(map[cellKey]map[*Subscription[T]]struct{}),
+		anchors:  make(map[string]map[*Subscription[T]]struct{}),
+	}
+}
+
+// Subscribe registers an area of interest in a world. anchorID names the entity the area
+// follows (see Move); it may be empty for a fixed area. buffer sizes the delivery channel.
+func (m *Manager[T]) Subscribe(worldID, anchorID string, center Point, radius int32, buffer int) *Subscription[T] {
+	if radius < 0 {
+		radius = 0
+	}
+	ch := make(chan T, buffer)
+	sub := &Subscription[T]{
+		C:        ch,
+		ch:       ch,
+		worldID:  worldID,
+		anchorID: anchorID,
+		center:   center,
+		radius:   radius,
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	sub.minCell, sub.maxCell = m.bounds(center, radius)
+	m.index(sub)
+	if anchorID != "" {
+		if m.anchors[anchorID] == nil {
+			m.anchors[anchorID] = make(map[*Subscription[T]]struct{})
+		}
+		m.anchors[anchorID][sub] = struct{}{}
+	}
+	m.count++
+	return sub
+}
+
+// Unsubscribe removes the subscription and closes its channel. It is safe to call more than once.
+func (m *Manager[T]) Unsubscribe(sub *Subscription[T]) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if sub.closed {
+		return
+	}
+	sub.closed = true
+	m.unindex(sub)
+	if sub.anchorID != "" {
+		delete(m.anchors[sub.anchorID], sub)
+		if len(m.anchors[sub.anchorID]) == 0 {
+			delete(m.anchors, sub.anchorID)
+		}
+	}
+	m.count--
+	close(sub.ch)
+}
+
+// Move recentres every subscription anchored to the entity. Subscriptions are only
+// re-indexed when the move takes their bounding box into different grid cells.
+func (m *Manager[T]) Move(anchorID string, to Point) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for sub := range m.anchors[anchorID] {
+		sub.center = to
+		minCell, maxCell := m.bounds(to, sub.radius)
+		if minCell == sub.minCell && maxCell == sub.maxCell {
+			continue
+		}
+		m.unindex(sub)
+		sub.minCell, sub.maxCell = minCell, maxCell
+		m.index(sub)
+	}
+}
+
+// Publish delivers the event to every subscription in the world whose area contains at,
+// returning how many subscribers received it. Delivery never blocks the publisher.
+func (m *Manager[T]) Publish(worldID string, at Point, event T) int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	delivered := 0
+	for sub := range m.cells[cellKey{worldID: worldID, x: geometry.FloorDiv(at.X, m.cellSize), y: geometry.FloorDiv(at.Y, m.cellSize)}] {
+		if !sub.contains(at) {
+			continue
+		}
+		select {
+		case sub.ch <- event:
+			delivered++
+		default:
+			sub.dropped.Add(1)
+		}
+	}
+	return delivered
+}
+
+// Deliver sends an event to one subscription whatever its area, such as the state of the
+// area when the subscription starts. It reports false when the subscription has ended or
+// its buffer is full, in which case the event is dropped and counted.
+func (m *Manager[T]) Deliver(sub *Subscription[T], event T) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if sub.closed {
+		return false
+	}
+	select {
+	case sub.ch <- event:
+		return true
+	default:
+		sub.dropped.Add(1)
+		return false
+	}
+}
+
+// Len returns the number of active subscriptions
+func (m *Manager[T]) Len() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.count
+}
+
+func (m *Manager[T]) bounds(center Point, radius int32) (Point, Point) {
+	return Point{X: geometry.FloorDiv(center.X-radius, m.cellSize), Y: geometry.FloorDiv(center.Y-radius, m.cellSize)},
+		Point{X: geometry.FloorDiv(center.X+radius, m.cellSize), Y: geometry.FloorDiv(center.Y+radius, m.cellSize)}
+}
+
+func (m *Manager[T]) index(sub *Subscription[T]) {
+	for x := sub.minCell.X; x <= sub.maxCell.X; x++ {
+		for y := sub.minCell.Y; y <= sub.maxCell.Y; y++ {
+			key := cellKey{worldID: sub.worldID, x: x, y: y}
+			if m.cells[key] == nil {
+				m.cells[key] = make(map[*Subscription[T]]struct{})
+			}
+			m.cells[key][sub] = struct{}{}
+		}
+	}
+}
+
+func (m *Manager[T]) unindex(sub *Subscription[T]) {
+	for x := sub.minCell.X; x <= sub.maxCell.X; x++ {
+		for y := sub.minCell.Y; y <= sub.maxCell.Y; y++ {
+			key := cellKey{worldID: sub.worldID, x: x, y: y}
+			delete(m.cells[key], sub)
+			if len(m.cells[key]) == 0 {
+				delete(m.cells, key)
+			}
+		}
+	}
+}
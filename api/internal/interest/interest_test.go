@@ -0,0 +1,131 @@
+package interest
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testWorld = "world-1"
+
+func TestPublish_RoutesOnlyInsideRadius(t *testing.T) {
+	m := NewManager[string](32)
+	near := m.Subscribe(testWorld, "", Point{X: 0, Y: 0}, 10, 8)
+	far := m.Subscribe(testWorld, "", Point{X: 100, Y: 100}, 10, 8)
+	otherWorld := m.Subscribe("world-2", "", Point{X: 0, Y: 0}, 10, 8)
+
+	assert.Equal(t, 1, m.Publish(testWorld, Point{X: 6, Y: 8}, "edge"))
+	assert.Equal(t, 0, m.Publish(testWorld, Point{X: 8, Y: 8}, "corner"), "inside the bounding box but outside the circle")
+
+	assert.Equal(t, "edge", <-near.C)
+	assert.Empty(t, near.C)
+	assert.Empty(t, far.C)
+	assert.Empty(t, otherWorld.C)
+}
+
+func TestPublish_NegativeCoordinates(t *testing.T) {
+	m := NewManager[string](32)
+	sub := m.Subscribe(testWorld, "", Point{X: -1, Y: -1}, 2, 8)
+
+	assert.Equal(t, 1, m.Publish(testWorld, Point{X: 0, Y: 0}, "across the origin"))
+	assert.Equal(t, 1, m.Publish(testWorld, Point{X: -3, Y: -1}, "west"))
+	assert.Len(t, sub.C, 2)
+}
+
+func TestMove_RecomputesArea(t *testing.T) {
+	m := NewManager[string](16)
+	sub := m.Subscribe(testWorld, "char-1", Point{X: 0, Y: 0}, 5, 8)
+
+	assert.Equal(t, 0, m.Publish(testWorld, Point{X: 50, Y: 0}, "before"))
+
+	m.Move("char-1", Point{X: 48, Y: 0})
+	assert.Equal(t, 1, m.Publish(testWorld, Point{X: 50, Y: 0}, "after"))
+	assert.Equal(t, 0, m.Publish(testWorld, Point{X: 0, Y: 0}, "old area"))
+	assert.Equal(t, "after", <-sub.C)
+
+	// Moves of other entities leave the subscription alone
+	m.Move("char-2", Point{X: 0, Y: 0})
+	assert.Equal(t, 1, m.Publish(testWorld, Point{X: 50, Y: 0}, "still here"))
+}
+
+func TestPublish_DropsWhenSubscriberFallsBehind(t *testing.T) {
+	m := NewManager[int](32)
+	sub := m.Subscribe(testWorld, "", Point{}, 4, 1)
+
+	assert.Equal(t, 1, m.Publish(testWorld, Point{}, 1))
+	assert.Equal(t, 0, m.Publish(testWorld, Point{}, 2))
+	assert.Equal(t, int64(1), sub.Dropped())
+}
+
+func TestDeliver(t *testing.T) {
+	m := NewManager[int](32)
+	sub := m.Subscribe(testWorld, "", Point{}, 4, 1)
+
+	assert.True(t, m.Deliver(sub, 1), "delivery ignores the area")
+	assert.False(t, m.Deliver(sub, 2))
+	assert.Equal(t, int64(1), sub.Dropped())
+
+	m.Unsubscribe(sub)
+	assert.False(t, m.Deliver(sub, 3), "ended subscriptions get nothing")
+}
+
+func TestUnsubscribe(t *testing.T) {
+	m := NewManager[string](32)
+	sub := m.Subscribe(testWorld, "char-1", Point{}, 40, 1)
+	assert.Equal(t, 1, m.Len())
+
+	m.Unsubscribe(sub)
+	m.Unsubscribe(sub)
+	assert.Equal(t, 0, m.Len())
+	assert.Empty(t, m.cells, "grid cells are released")
+	assert.Empty(t, m.anchors)
+
+	_, open := <-sub.C
+	assert.False(t, open)
+	assert.Equal(t, 0, m.Publish(testWorld, Point{}, "gone"))
+	m.Move("char-1", Point{X: 1})
+}
+
+// populate spreads subscribers uniformly over a square area that grows with their number,
+// keeping density at one subscriber per 32×32 chunk like a busy world
+func populate(m *Manager[int], subscribers int, radius int32) int32 {
+	rng := rand.New(rand.NewSource(1))
+	side := int32(math.Sqrt(float64(subscribers))) * 32
+	for i := 0; i < subscribers; i++ {
+		m.Subscribe(testWorld, fmt.Sprint(i), Point{X: rng.Int31n(side), Y: rng.Int31n(side)}, radius, 1)
+	}
+	return side
+}
+
+func BenchmarkPublish(b *testing.B) {
+	for _, subscribers := range []int{100, 1000, 5000, 10000} {
+		b.Run(fmt.Sprintf("subscribers=%d", subscribers), func(b *testing.B) {
+			m := NewManager[int](32)
+			side := populate(m, subscribers, 32)
+			rng := rand.New(rand.NewSource(2))
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				m.Publish(testWorld, Point{X: rng.Int31n(side), Y: rng.Int31n(side)}, i)
+			}
+		})
+	}
+}
+
+func BenchmarkMove(b *testing.B) {
+	for _, subscribers := range []int{100, 1000, 5000, 10000} {
+		b.Run(fmt.Sprintf("subscribers=%d", subscribers), func(b *testing.B) {
+			m := NewManager[int](32)
+			side := populate(m, subscribers, 32)
+			rng := rand.New(rand.NewSource(2))
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				m.Move(fmt.Sprint(i%subscribers), Point{X: rng.Int31n(side), Y: rng.Int31n(side)})
+			}
+		})
+	}
+}
@@ -0,0 +1,294 @@
+// Package latency estimates the round trip time and clock offset of each connection, so
+// client-timed actions such as setting the fishing hook can be judged fairly for players
+// far from the server.
+//
+// Clients calibrate with a handshake. Every response carries a probe the server signed
+// with the time it was sent; the client echoes it in its next call together with its own
+// clock reading. The round trip is measured on the server clock alone, and the client's
+// reading gives the offset between the two clocks. Probes are signed so a client cannot
+// forge the time they were sent.
+//
+// Compensation is bounded: an action is credited at most the connection's allowance (its
+// smoothed RTT plus jitter, capped by the tracker's maximum) before it arrived, so a client
+// faking a slow connection gains at most that maximum. Calibrations are held in memory on
+// the instance that measured them until their session signs out or they go IdleTTL
+// without a sample.
+package latency
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/clock"
+)
+
+const (
+	// DefaultMaxAllowance caps how much earlier than its arrival an action may be credited
+	DefaultMaxAllowance = 250 * time.Millisecond
+	// MaxProbeAge is the slowest echo still taken as a round trip sample
+	MaxProbeAge = 2 * time.Second
+	// IdleTTL is how long a calibration is kept after its last sample
+	IdleTTL = 10 * time.Minute
+
+	// gain is the weight of a new sample in the smoothed values, as in TCP's RTT estimator
+	gain = 8
+	// macSize is the length of a probe's truncated signature
+	macSize    = 16
+	probeSize  = 8 + macSize
+	pruneEvery = time.Minute
+)
+
+var (
+	// ErrInvalidProbe is returned for probes this tracker did not issue to the connection
+	ErrInvalidProbe = errors.New("invalid clock sync probe")
+	// ErrStaleProbe is returned for probes echoed too late or more than once
+	ErrStaleProbe = errors.New("stale clock sync probe")
+)
+
+// Calibration is a connection's measured latency and clock offset. The zero value is an
+// uncalibrated connection, which gets no compensation.
+type Calibration struct {
+	RTT     time.Duration // Smoothed round trip time
+	RTTVar  time.Duration // Mean deviation of the round trip time
+	Offset  time.Duration // Client clock minus server clock
+	Samples int
+
+	maxAllowance time.Duration
+}
+
+// Calibrated reports whether the connection has completed a handshake
+func (c Calibration) Calibrated() bool {
+	return c.Samples > 0
+}
+
+// OneWay is the estimated time a message takes to reach the client, or to come back
+func (c Calibration) OneWay() time.Duration {
+	if !c.Calibrated() {
+		return 0
+	}
+	return min(c.maxAllowance, c.RTT/2)
+}
+
+// Allowance is the most an action may be credited before it arrived: the one-way time
+// plus a margin for jitter, capped
+func (c Calibration) Allowance() time.Duration {
+	if !c.Calibrated() {
+		return 0
+	}
+	return min(c.maxAllowance, c.RTT/2+2*c.RTTVar)
+}
+
+// SeenAt estimates when the player saw something the server sent at sentAt
+func (c Calibration) SeenAt(sentAt time.Time) time.Time {
+	return sentAt.Add(c.OneWay())
+}
+
+// ActionTime estimates when, on the server clock, the player acted on a request that
+// arrived at arrivedAt. A client timestamp is moved onto the server clock; without one
+// (zero clientTime) the one-way time is assumed. The result is never later than the
+// arrival nor earlier than the arrival less the allowance.
+func (c Calibration) ActionTime(arrivedAt, clientTime time.Time) time.Time {
+	if clientTime.IsZero() || !c.Calibrated() {
+		return arrivedAt.Add(-c.OneWay())
+	}
+	acted := clientTime.Add(-c.Offset)
+	if earliest := arrivedAt.Add(-c.Allowance()); acted.Before(earliest) {
+		return earliest
+	}
+	if acted.After(arrivedAt) {
+		return arrivedAt
+	}
+	return acted
+}
+
+// Grace is how long past a deadline a request from the player can still arrive and be
+// credited within it: the time for the news to reach the player plus the allowance
+func (c Calibration) Grace() time.Duration {
+	return c.OneWay() + c.Allowance()
+}
+
+type calibrationKey struct{}
+
+// WithCalibration attaches the calling connection's calibration to ctx
+func WithCalibration(ctx context.Context, c Calibration) context.Context {
+	return context.WithValue(ctx, calibrationKey{}, c)
+}
+
+// FromContext returns the calling connection's calibration; uncalibrated if there is none
+func FromContext(ctx context.Context) Calibration {
+	c, _ := ctx.Value(calibrationKey{}).(Calibration)
+	return c
+}
+
+// Tracker issues probes and keeps the calibration of each connection. It is safe for
+// concurrent use.
+type Tracker struct {
+	clock        clock.Clock
+	secret       []byte
+	maxAllowance time.Duration
+
+	mu          sync.Mutex
+	connections map[string]*connection
+	prunedAt    time.Time
+}
+
+// connection is a calibration and the send time of the latest probe it echoed, so each
+// probe counts once
+type connection struct {
+	calibration Calibration
+	lastProbe   int64
+	updatedAt   time.Time
+}
+
+// NewTracker creates a tracker crediting actions at most maxAllowance early; 0 uses
+// DefaultMaxAllowance. Probes are signed with a key generated for this process.
+func NewTracker(maxAllowance time.Duration) *Tracker {
+	if maxAllowance <= 0 {
+		maxAllowance = DefaultMaxAllowance
+	}
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		panic("latency: failed to generate probe key: " + err.Error())
+	}
+	return &Tracker{
+		clock:        clock.New(),
+		secret:       secret,
+		maxAllowance: maxAllowance,
+		connections:  make(map[string]*connection),
+	}
+}
+
+// SetClock replaces the clock used to measure round trips (for simulation tests)
+func (t *Tracker) SetClock(c clock.Clock) {
+	t.clock = c
+}
+
+// MaxAllowance returns the cap on the allowance of any connection
+func (t *Tracker) MaxAllowance() time.Duration {
+	return t.maxAllowance
+}
+
+// Probe returns a signed probe for the connection to echo in its next handshake call
+func (t *Tracker) Probe(connectionID string) string {
+	buf := make([]byte, probeSize)
+	binary.BigEndian.PutUint64(buf, uint64(t.clock.Now().UnixNano()))
+	copy(buf[8:], t.sign(connectionID, buf[:8]))
+	return base64.RawURLEncoding.EncodeToString(buf)
+}
+
+// Observe takes the round trip of an echoed probe as a sample, with the client's clock
+// reading when it sent the echo, and returns the updated calibration
+func (t *Tracker) Observe(connectionID, probe string, clientTime time.Time) (Calibration, error) {
+	now := t.clock.Now()
+	buf, err := base64.RawURLEncoding.DecodeString(probe)
+	if err != nil || len(buf) != probeSize || !hmac.Equal(buf[8:], t.sign(connectionID, buf[:8])) {
+		return Calibration{}, ErrInvalidProbe
+	}
+	sentNanos := int64(binary.BigEndian.Uint64(buf[:8]))
+	rtt := now.Sub(time.Unix(0, sentNanos))
+	if rtt < 0 || rtt > MaxProbeAge {
+		return Calibration{}, ErrStaleProbe
+	}
+	offset := clientTime.Sub(time.Unix(0, sentNanos).Add(rtt / 2))
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.prune(now)
+	conn, ok := t.connections[connectionID]
+	if !ok {
+		conn = &connection{}
+		t.connections[connectionID] = conn
+	}
+	if sentNanos <= conn.lastProbe {
+		return Calibration{}, ErrStaleProbe
+	}
+	conn.lastProbe = sentNanos
+	conn.updatedAt = now
+
+	c := &conn.calibration
+	if c.Samples == 0 {
+		c.RTT, c.RTTVar, c.Offset = rtt, rtt/2, offset
+	} else {
+		c.RTTVar += (abs(c.RTT-rtt) - c.RTTVar) / gain
+		c.RTT += (rtt - c.RTT) / gain
+		c.Offset += (offset - c.Offset) / gain
+	}
+	c.Samples++
+	c.maxAllowance = t.maxAllowance
+	return *c, nil
+}
+
+// Calibration returns the connection's calibration; uncalibrated if it has none
+func (t *Tracker) Calibration(connectionID string) Calibration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	conn, ok := t.connections[connectionID]
+	if !ok || t.clock.Now().Sub(conn.updatedAt) >= IdleTTL {
+		return Calibration{}
+	}
+	return conn.calibration
+}
+
+// Forget drops the connection's calibration, e.g. when its session signs out
+func (t *Tracker) Forget(connectionID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.connections, connectionID)
+}
+
+// Run drops idle calibrations every minute until ctx is cancelled, so connections that
+// stop calibrating are not kept until the next sample arrives
+func (t *Tracker) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.clock.After(pruneEvery):
+		}
+
+		t.mu.Lock()
+		t.prune(t.clock.Now())
+		t.mu.Unlock()
+	}
+}
+
+// Len returns the number of calibrated connections
+func (t *Tracker) Len() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.connections)
+}
+
+// prune drops idle calibrations, at most every pruneEvery; the caller holds the mutex
+func (t *Tracker) prune(now time.Time) {
+	if now.Sub(t.prunedAt) < pruneEvery {
+		return
+	}
+	t.prunedAt = now
+	for id, conn := range t.connections {
+		if now.Sub(conn.updatedAt) >= IdleTTL {
+			delete(t.connections, id)
+		}
+	}
+}
+
+func (t *Tracker) sign(connectionID string, sentAt []byte) []byte {
+	mac := hmac.New(sha256.New, t.secret)
+	mac.Write(sentAt)
+	mac.Write([]byte(connectionID))
+	return mac.Sum(nil)[:macSize]
+}
+
+func abs(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
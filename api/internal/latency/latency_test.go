@@ -0,0 +1,141 @@
+package latency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var start = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+// handshake runs one probe round trip of rtt against a client clock skewed by skew
+func handshake(t *testing.T, tracker *Tracker, fake *clock.Fake, connectionID string, rtt, skew time.Duration) Calibration {
+	t.Helper()
+	probe := tracker.Probe(connectionID)
+	fake.Advance(rtt / 2)
+	clientTime := fake.Now().Add(skew)
+	fake.Advance(rtt - rtt/2)
+	c, err := tracker.Observe(connectionID, probe, clientTime)
+	require.NoError(t, err)
+	return c
+}
+
+func TestTracker_Observe(t *testing.T) {
+	fake := clock.NewFake(start)
+	tracker := NewTracker(0)
+	tracker.SetClock(fake)
+	assert.Equal(t, DefaultMaxAllowance, tracker.MaxAllowance())
+	assert.False(t, tracker.Calibration("session-1").Calibrated())
+
+	c := handshake(t, tracker, fake, "session-1", 100*time.Millisecond, 3*time.Second)
+	assert.Equal(t, 100*time.Millisecond, c.RTT)
+	assert.Equal(t, 50*time.Millisecond, c.RTTVar)
+	assert.Equal(t, 3*time.Second, c.Offset)
+	assert.Equal(t, 1, c.Samples)
+
+	for i := 0; i < 40; i++ {
+		c = handshake(t, tracker, fake, "session-1", 60*time.Millisecond, 3*time.Second)
+	}
+	assert.InDelta(t, float64(60*time.Millisecond), float64(c.RTT), float64(time.Millisecond), "the estimate converges")
+	assert.Less(t, c.RTTVar, 5*time.Millisecond)
+	assert.InDelta(t, float64(3*time.Second), float64(c.Offset), float64(time.Millisecond))
+	assert.Equal(t, c, tracker.Calibration("session-1"))
+	assert.False(t, tracker.Calibration("session-2").Calibrated(), "calibrations are per connection")
+
+	fake.Advance(IdleTTL)
+	assert.False(t, tracker.Calibration("session-1").Calibrated(), "idle calibrations expire")
+}
+
+func TestTracker_Probes(t *testing.T) {
+	fake := clock.NewFake(start)
+	tracker := NewTracker(0)
+	tracker.SetClock(fake)
+
+	probe := tracker.Probe("session-1")
+	fake.Advance(80 * time.Millisecond)
+	_, err := tracker.Observe("session-2", probe, fake.Now())
+	assert.ErrorIs(t, err, ErrInvalidProbe, "probes are bound to their connection")
+	_, err = tracker.Observe("session-1", "not-a-probe", fake.Now())
+	assert.ErrorIs(t, err, ErrInvalidProbe)
+	forged := []byte(probe)
+	forged[2] ^= 1
+	_, err = tracker.Observe("session-1", string(forged), fake.Now())
+	assert.ErrorIs(t, err, ErrInvalidProbe, "the send time cannot be changed")
+
+	other := NewTracker(0)
+	other.SetClock(fake)
+	_, err = other.Observe("session-1", probe, fake.Now())
+	assert.ErrorIs(t, err, ErrInvalidProbe, "probes are signed with the issuing tracker's key")
+
+	_, err = tracker.Observe("session-1", probe, fake.Now())
+	require.NoError(t, err)
+	_, err = tracker.Observe("session-1", probe, fake.Now())
+	assert.ErrorIs(t, err, ErrStaleProbe, "each probe counts once")
+
+	probe = tracker.Probe("session-1")
+	fake.Advance(MaxProbeAge + time.Millisecond)
+	_, err = tracker.Observe("session-1", probe, fake.Now())
+	assert.ErrorIs(t, err, ErrStaleProbe)
+}
+
+func TestCalibration_ActionTime(t *testing.T) {
+	arrived := start
+	var uncalibrated Calibration
+	assert.Equal(t, arrived, uncalibrated.ActionTime(arrived, arrived.Add(-time.Second)), "uncalibrated actions are timed on arrival")
+	assert.Zero(t, uncalibrated.Grace())
+
+	c := Calibration{RTT: 100 * time.Millisecond, RTTVar: 10 * time.Millisecond, Offset: time.Hour, Samples: 5, maxAllowance: DefaultMaxAllowance}
+	assert.Equal(t, 50*time.Millisecond, c.OneWay())
+	assert.Equal(t, 70*time.Millisecond, c.Allowance())
+	assert.Equal(t, 120*time.Millisecond, c.Grace())
+	assert.Equal(t, start.Add(50*time.Millisecond), c.SeenAt(start))
+
+	assert.Equal(t, arrived.Add(-50*time.Millisecond), c.ActionTime(arrived, time.Time{}), "without a timestamp the one-way time is assumed")
+	clientArrived := arrived.Add(time.Hour)
+	assert.Equal(t, arrived.Add(-30*time.Millisecond), c.ActionTime(arrived, clientArrived.Add(-30*time.Millisecond)), "timestamps are moved onto the server clock")
+	assert.Equal(t, arrived.Add(-70*time.Millisecond), c.ActionTime(arrived, clientArrived.Add(-time.Second)), "backdating is bounded by the allowance")
+	assert.Equal(t, arrived, c.ActionTime(arrived, clientArrived.Add(time.Second)), "actions are not credited after they arrived")
+
+	slow := Calibration{RTT: 2 * time.Second, RTTVar: time.Second, Samples: 1, maxAllowance: DefaultMaxAllowance}
+	assert.Equal(t, DefaultMaxAllowance, slow.OneWay())
+	assert.Equal(t, DefaultMaxAllowance, slow.Allowance(), "slow connections are compensated at most the maximum")
+}
+
+func TestFromContext(t *testing.T) {
+	assert.False(t, FromContext(context.Background()).Calibrated())
+	c := Calibration{RTT: time.Millisecond, Samples: 1}
+	assert.Equal(t, c, FromContext(WithCalibration(context.Background(), c)))
+}
+
+func TestTracker_Eviction(t *testing.T) {
+	fake := clock.NewFake(start)
+	tracker := NewTracker(0)
+	tracker.SetClock(fake)
+
+	handshake(t, tracker, fake, "session-1", 100*time.Millisecond, 0)
+	handshake(t, tracker, fake, "session-2", 100*time.Millisecond, 0)
+	require.Equal(t, 2, tracker.Len())
+
+	tracker.Forget("session-1")
+	assert.False(t, tracker.Calibration("session-1").Calibrated(), "signed out sessions are forgotten")
+	assert.Equal(t, 1, tracker.Len())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		tracker.Run(ctx)
+		close(done)
+	}()
+	for elapsed := time.Duration(0); elapsed < IdleTTL; elapsed += pruneEvery {
+		require.Eventually(t, func() bool { return fake.Waiters() == 1 }, time.Second, time.Millisecond)
+		fake.Advance(pruneEvery)
+	}
+	require.Eventually(t, func() bool { return tracker.Len() == 0 }, time.Second, time.Millisecond, "idle calibrations are pruned without new samples")
+
+	cancel()
+	<-done
+}
@@ -35,11 +35,20 @@ func InitLogger() {
 	Logger.Debug("Logger initialized successfully", "level", logLevel)
 }
 
+// SetLevel changes the global logger's level, e.g. from a command-line flag.
+// Unknown levels fall back to debug like LOG_LEVEL does.
+func SetLevel(level string) {
+	setLogLevel(GetLogger(), parseLogLevel(level))
+}
+
 // getLogLevelFromEnv reads log level from LOG_LEVEL environment variable
 func getLogLevelFromEnv() LogLevel {
-	envLevel := strings.ToLower(strings.TrimSpace(os.Getenv("LOG_LEVEL")))
+	return parseLogLevel(os.Getenv("LOG_LEVEL"))
+}
 
-	switch envLevel {
+// parseLogLevel maps a level name to a LogLevel
+func parseLogLevel(level string) LogLevel {
+	switch strings.ToLower(strings.TrimSpace(level)) {
 	case "debug":
 		return DebugLevel
 	case "info":
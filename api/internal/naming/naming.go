@@ -0,0 +1,72 @@
+// Package naming is the shared check for names players choose and other players see:
+// how they are normalized, which characters they may contain, and when two names count as
+// the same. Services use it instead of their own rules so a name accepted in one place is
+// accepted everywhere.
+package naming
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	// ErrInvalidCharacter is returned for names with anything but letters, digits, spaces,
+	// hyphens and apostrophes
+	ErrInvalidCharacter = errors.New("names may only contain letters, digits, spaces, hyphens and apostrophes")
+	// ErrInvalidPunctuation is returned for names that start or end with punctuation or
+	// have two separators in a row
+	ErrInvalidPunctuation = errors.New("names must start and end with a letter or digit and may not have two separators in a row")
+)
+
+// Rules bound the length of a name, counted in characters
+type Rules struct {
+	MinLength int
+	MaxLength int
+}
+
+// CharacterRules returns the rules for character names
+func CharacterRules() Rules {
+	return Rules{MinLength: 3, MaxLength: 24}
+}
+
+// Normalize trims surrounding whitespace and collapses whitespace inside the name to
+// single spaces. Names are validated and stored normalized.
+func Normalize(name string) string {
+	return strings.Join(strings.Fields(name), " ")
+}
+
+// Validate checks a normalized name against the rules
+func (r Rules) Validate(name string) error {
+	length := utf8.RuneCountInString(name)
+	if length < r.MinLength || length > r.MaxLength {
+		return fmt.Errorf("names must be %d to %d characters long", r.MinLength, r.MaxLength)
+	}
+
+	separator := true // The name may not start with one
+	for _, c := range name {
+		switch {
+		case unicode.IsLetter(c) || unicode.IsDigit(c):
+			separator = false
+		case c == ' ' || c == '-' || c == '\'':
+			if separator {
+				return ErrInvalidPunctuation
+			}
+			separator = true
+		default:
+			return ErrInvalidCharacter
+		}
+	}
+	if separator {
+		return ErrInvalidPunctuation
+	}
+	return nil
+}
+
+// Equal reports whether two names count as the same for uniqueness: names differing only
+// in case are the same. The database compares lower(name) to match.
+func Equal(a, b string) bool {
+	return strings.EqualFold(a, b)
+}
@@ -0,0 +1,34 @@
+package naming
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	assert.Equal(t, "Ada Stone", Normalize("  Ada \t  Stone "))
+	assert.Equal(t, "", Normalize("   "))
+}
+
+func TestValidate(t *testing.T) {
+	rules := CharacterRules()
+	valid := []string{"Ada", "Ada Stone", "O'Brien", "Mary-Jane", "Zoë", "Miner42", "abcdefghijklmnopqrstuvwx"}
+	for _, name := range valid {
+		assert.NoError(t, rules.Validate(name), name)
+	}
+
+	assert.Error(t, rules.Validate("Al"), "too short")
+	assert.Error(t, rules.Validate("abcdefghijklmnopqrstuvwxy"), "too long")
+	assert.NoError(t, rules.Validate("Zoë"), "length counts characters, not bytes")
+	assert.ErrorIs(t, rules.Validate("Ada_Stone"), ErrInvalidCharacter)
+	assert.ErrorIs(t, rules.Validate("Ada\u200bStone"), ErrInvalidCharacter, "invisible characters are rejected")
+	assert.ErrorIs(t, rules.Validate("-Ada"), ErrInvalidPunctuation)
+	assert.ErrorIs(t, rules.Validate("Ada'"), ErrInvalidPunctuation)
+	assert.ErrorIs(t, rules.Validate("Ada -Stone"), ErrInvalidPunctuation)
+}
+
+func TestEqual(t *testing.T) {
+	assert.True(t, Equal("Ada Stone", "ada STONE"))
+	assert.False(t, Equal("Ada", "Adam"))
+}
@@ -0,0 +1,72 @@
+// Package oauth identifies players by an access token they obtained from an external
+// provider. Clients run the provider's OAuth flow themselves; the server only checks that
+// the token was issued to its own client ID and asks the provider whose account it is.
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Provider names, as stored with linked identities
+const (
+	ProviderDiscord = "discord"
+	ProviderGoogle  = "google"
+)
+
+var (
+	// ErrInvalidToken is returned for tokens the provider does not accept
+	ErrInvalidToken = errors.New("access token is invalid or expired")
+	// ErrWrongClient is returned for tokens the provider issued to another application, so
+	// a token handed to some other site cannot be replayed here
+	ErrWrongClient = errors.New("access token was issued to another application")
+)
+
+// Identity is an account at a provider
+type Identity struct {
+	Provider      string
+	Subject       string // The provider's stable ID for the account
+	Username      string
+	Email         string // Empty when the token does not grant access to it
+	EmailVerified bool
+}
+
+// Provider identifies the account an access token belongs to
+type Provider interface {
+	Name() string
+	Identify(ctx context.Context, accessToken string) (Identity, error)
+}
+
+// requestTimeout bounds each call to a provider
+const requestTimeout = 10 * time.Second
+
+// getJSON fetches url with the token as a bearer credential and decodes the response into
+// out. Unauthorized answers are ErrInvalidToken.
+func getJSON(ctx context.Context, client *http.Client, url, bearer string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+	if bearer != "" {
+		req.Header.Set("Authorization", "Bearer "+bearer)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusBadRequest:
+		return ErrInvalidToken
+	case resp.StatusCode >= 300:
+		return fmt.Errorf("provider returned %s", resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package oauth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscord_Identify(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Header.Get("Authorization") {
+		case "Bearer good", "Bearer no-email":
+		case "Bearer other-app":
+			_, _ = w.Write([]byte(`{"application": {"id": "999"}, "scopes": ["identify"], "user": {"id": "42", "username": "ada"}}`))
+			return
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/oauth2/@me":
+			scopes := `["identify", "email"]`
+			if r.Header.Get("Authorization") == "Bearer no-email" {
+				scopes = `["identify"]`
+			}
+			_, _ = w.Write([]byte(`{"application": {"id": "123"}, "scopes": ` + scopes + `, "user": {"id": "42", "username": "ada"}}`))
+		case "/users/@me":
+			_, _ = w.Write([]byte(`{"id": "42", "username": "ada", "email": "ada@example.com", "verified": true}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	discord := NewDiscord(server.URL+"/", "123")
+	ctx := context.Background()
+
+	identity, err := discord.Identify(ctx, "good")
+	require.NoError(t, err)
+	assert.Equal(t, Identity{Provider: ProviderDiscord, Subject: "42", Username: "ada", Email: "ada@example.com", EmailVerified: true}, identity)
+
+	identity, err = discord.Identify(ctx, "no-email")
+	require.NoError(t, err)
+	assert.Empty(t, identity.Email, "the email needs the email scope")
+	assert.False(t, identity.EmailVerified)
+
+	_, err = discord.Identify(ctx, "other-app")
+	assert.ErrorIs(t, err, ErrWrongClient)
+	_, err = discord.Identify(ctx, "expired")
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestGoogle_Identify(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("access_token") {
+		case "good":
+			_, _ = w.Write([]byte(`{"azp": "web.apps", "aud": "web.apps", "sub": "1087", "email": "grace@example.com", "email_verified": "true"}`))
+		case "unverified":
+			_, _ = w.Write([]byte(`{"azp": "web.apps", "aud": "web.apps", "sub": "1088", "email": "bob@example.com", "email_verified": "false"}`))
+		case "other-app":
+			_, _ = w.Write([]byte(`{"azp": "evil.apps", "aud": "evil.apps", "sub": "1087"}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error": "invalid_token"}`))
+		}
+	}))
+	defer server.Close()
+
+	google := NewGoogle(server.URL, "web.apps")
+	ctx := context.Background()
+
+	identity, err := google.Identify(ctx, "good")
+	require.NoError(t, err)
+	assert.Equal(t, Identity{Provider: ProviderGoogle, Subject: "1087", Username: "grace", Email: "grace@example.com", EmailVerified: true}, identity)
+
+	identity, err = google.Identify(ctx, "unverified")
+	require.NoError(t, err)
+	assert.False(t, identity.EmailVerified)
+
+	_, err = google.Identify(ctx, "other-app")
+	assert.ErrorIs(t, err, ErrWrongClient)
+	_, err = google.Identify(ctx, "expired")
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
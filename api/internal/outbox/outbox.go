@@ -0,0 +1,209 @@
+// Package outbox implements the transactional outbox pattern: mutations enqueue
+// events in the same database transaction, and a Dispatcher publishes them
+// afterwards with at-least-once delivery.
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/alerting"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/txn"
+	"github.com/charmbracelet/log"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// InTx runs fn with queries bound to a new read committed transaction, committing if fn
+// succeeds. Use it to write a mutation and its outbox event atomically; flows that write
+// based on what they read need txn.Run with a stricter policy.
+func InTx(ctx context.Context, pool storage.Pool, fn func(q *db.Queries) error) error {
+	return txn.Run(ctx, pool, txn.ReadCommitted, fn)
+}
+
+// Enqueue records an event in the outbox. q must be bound to the transaction that
+// performs the mutation so the event is committed (or rolled back) with it.
+func Enqueue(ctx context.Context, q *db.Queries, eventType, aggregateID, dedupKey string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s payload: %w", eventType, err)
+	}
+
+	return q.InsertOutboxEvent(ctx, db.InsertOutboxEventParams{
+		EventType:   eventType,
+		AggregateID: aggregateID,
+		Payload:     data,
+		DedupKey:    dedupKey,
+	})
+}
+
+// DatabaseInterface abstracts the outbox queries used by the dispatcher
+type DatabaseInterface interface {
+	ClaimOutboxEvents(ctx context.Context, arg db.ClaimOutboxEventsParams) ([]db.OutboxEvent, error)
+	MarkOutboxEventPublished(ctx context.Context, arg db.MarkOutboxEventPublishedParams) error
+	MarkOutboxEventFailed(ctx context.Context, arg db.MarkOutboxEventFailedParams) error
+	DeletePublishedOutboxEvents(ctx context.Context, publishedAt pgtype.Timestamp) (int64, error)
+}
+
+// Config tunes the dispatcher
+type Config struct {
+	BatchSize    int32         // events claimed per poll
+	PollInterval time.Duration // wait between polls when the outbox is drained
+	Lease        time.Duration // how long a claimed event is hidden from other dispatchers
+	MaxBackoff   time.Duration // upper bound for retry delay after failed publishes
+	Retention    time.Duration // published events older than this are deleted
+}
+
+// DefaultConfig returns production defaults
+func DefaultConfig() Config {
+	return Config{
+		BatchSize:    100,
+		PollInterval: time.Second,
+		Lease:        30 * time.Second,
+		MaxBackoff:   5 * time.Minute,
+		Retention:    24 * time.Hour,
+	}
+}
+
+// Dispatcher publishes outbox events to a Publisher
+type Dispatcher struct {
+	db        DatabaseInterface
+	publisher events.Publisher
+	config    Config
+	clock     clock.Clock
+	logger    *log.Logger
+}
+
+// NewDispatcher creates a dispatcher reading from database and publishing to publisher
+func NewDispatcher(database DatabaseInterface, publisher events.Publisher, config Config) *Dispatcher {
+	return &Dispatcher{
+		db:        database,
+		publisher: publisher,
+		config:    config,
+		clock:     clock.New(),
+		logger:    logging.WithComponent("outbox-dispatcher"),
+	}
+}
+
+// SetClock replaces the clock used for leases and polling (for simulation tests)
+func (d *Dispatcher) SetClock(c clock.Clock) {
+	d.clock = c
+}
+
+// Run polls the outbox until ctx is cancelled
+func (d *Dispatcher) Run(ctx context.Context) {
+	d.logger.Info("Outbox dispatcher started", "batch_size", d.config.BatchSize, "poll_interval", d.config.PollInterval)
+	lastCleanup := d.clock.Now()
+
+	for {
+		published, err := d.DispatchOnce(ctx)
+		if err != nil {
+			d.logger.Error("Outbox dispatch failed", "error", err)
+			alerting.ReportJobError("outbox_dispatch", err)
+		}
+
+		if d.clock.Since(lastCleanup) >= d.config.Retention {
+			d.cleanup(ctx)
+			lastCleanup = d.clock.Now()
+		}
+
+		// A full batch means more events are probably waiting
+		if err == nil && published == int(d.config.BatchSize) {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			d.logger.Info("Outbox dispatcher stopped")
+			return
+		case <-d.clock.After(d.config.PollInterval):
+		}
+	}
+}
+
+// DispatchOnce claims one batch of events and publishes them, returning how many were published
+func (d *Dispatcher) DispatchOnce(ctx context.Context) (int, error) {
+	now := d.clock.Now()
+	claimed, err := d.db.ClaimOutboxEvents(ctx, db.ClaimOutboxEventsParams{
+		LockedUntil: timestamp(now.Add(d.config.Lease)),
+		Now:         timestamp(now),
+		BatchSize:   d.config.BatchSize,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to claim outbox events: %w", err)
+	}
+
+	published := 0
+	for _, row := range claimed {
+		logger := d.logger.With("event_id", row.ID, "event_type", row.EventType, "dedup_key", row.DedupKey)
+
+		if err := d.publisher.Publish(ctx, toEvent(row)); err != nil {
+			retryAt := d.clock.Now().Add(d.backoff(row.Attempts))
+			logger.Warn("Failed to publish outbox event", "attempts", row.Attempts+1, "retry_at", retryAt, "error", err)
+			if markErr := d.db.MarkOutboxEventFailed(ctx, db.MarkOutboxEventFailedParams{
+				ID:          row.ID,
+				LastError:   pgtype.Text{String: err.Error(), Valid: true},
+				LockedUntil: timestamp(retryAt),
+			}); markErr != nil {
+				logger.Error("Failed to record outbox publish failure", "error", markErr)
+			}
+			continue
+		}
+
+		// If this fails the lease expires and the event is published again; consumers dedup on the key
+		if err := d.db.MarkOutboxEventPublished(ctx, db.MarkOutboxEventPublishedParams{
+			ID:          row.ID,
+			PublishedAt: timestamp(d.clock.Now()),
+		}); err != nil {
+			logger.Error("Failed to mark outbox event published", "error", err)
+			continue
+		}
+		published++
+	}
+
+	if len(claimed) > 0 {
+		d.logger.Debug("Outbox batch dispatched", "claimed", len(claimed), "published", published)
+	}
+	return published, nil
+}
+
+// backoff doubles the retry delay per attempt, starting at the poll interval
+func (d *Dispatcher) backoff(attempts int32) time.Duration {
+	delay := d.config.PollInterval
+	for i := int32(0); i < attempts && delay < d.config.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, d.config.MaxBackoff)
+}
+
+func (d *Dispatcher) cleanup(ctx context.Context) {
+	deleted, err := d.db.DeletePublishedOutboxEvents(ctx, timestamp(d.clock.Now().Add(-d.config.Retention)))
+	if err != nil {
+		d.logger.Error("Failed to delete published outbox events", "error", err)
+		return
+	}
+	if deleted > 0 {
+		d.logger.Debug("Deleted published outbox events", "count", deleted)
+	}
+}
+
+func toEvent(row db.OutboxEvent) events.Event {
+	return events.Event{
+		ID:          row.ID,
+		Type:        row.EventType,
+		AggregateID: row.AggregateID,
+		Payload:     row.Payload,
+		DedupKey:    row.DedupKey,
+		OccurredAt:  row.CreatedAt.Time,
+	}
+}
+
+func timestamp(t time.Time) pgtype.Timestamp {
+	return pgtype.Timestamp{Time: t, Valid: true}
+}
@@ -0,0 +1,186 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeStore mimics the claim semantics of the outbox queries in memory
+type fakeStore struct {
+	rows []db.OutboxEvent
+}
+
+func (f *fakeStore) add(eventType, dedupKey string) {
+	f.rows = append(f.rows, db.OutboxEvent{
+		ID:        int64(len(f.rows) + 1),
+		EventType: eventType,
+		DedupKey:  dedupKey,
+		Payload:   []byte(`{}`),
+	})
+}
+
+func (f *fakeStore) find(id int64) *db.OutboxEvent {
+	for i := range f.rows {
+		if f.rows[i].ID == id {
+			return &f.rows[i]
+		}
+	}
+	return nil
+}
+
+func (f *fakeStore) ClaimOutboxEvents(ctx context.Context, arg db.ClaimOutboxEventsParams) ([]db.OutboxEvent, error) {
+	var claimed []db.OutboxEvent
+	for i := range f.rows {
+		row := &f.rows[i]
+		if row.PublishedAt.Valid || (row.LockedUntil.Valid && row.LockedUntil.Time.After(arg.Now.Time)) {
+			continue
+		}
+		if int32(len(claimed)) == arg.BatchSize {
+			break
+		}
+		row.LockedUntil = arg.LockedUntil
+		claimed = append(claimed, *row)
+	}
+	return claimed, nil
+}
+
+func (f *fakeStore) MarkOutboxEventPublished(ctx context.Context, arg db.MarkOutboxEventPublishedParams) error {
+	f.find(arg.ID).PublishedAt = arg.PublishedAt
+	return nil
+}
+
+func (f *fakeStore) MarkOutboxEventFailed(ctx context.Context, arg db.MarkOutboxEventFailedParams) error {
+	row := f.find(arg.ID)
+	row.Attempts++
+	row.LastError = arg.LastError
+	row.LockedUntil = arg.LockedUntil
+	return nil
+}
+
+func (f *fakeStore) DeletePublishedOutboxEvents(ctx context.Context, publishedAt pgtype.Timestamp) (int64, error) {
+	return 0, nil
+}
+
+type flakyPublisher struct {
+	failures  int
+	delivered []events.Event
+}
+
+func (p *flakyPublisher) Publish(ctx context.Context, event events.Event) error {
+	if p.failures > 0 {
+		p.failures--
+		return errors.New("broker unavailable")
+	}
+	p.delivered = append(p.delivered, event)
+	return nil
+}
+
+func newTestDispatcher(store *fakeStore, publisher events.Publisher) (*Dispatcher, *clock.Fake) {
+	fake := clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	d := NewDispatcher(store, publisher, Config{
+		BatchSize:    2,
+		PollInterval: time.Second,
+		Lease:        30 * time.Second,
+		MaxBackoff:   4 * time.Second,
+		Retention:    time.Hour,
+	})
+	d.SetClock(fake)
+	return d, fake
+}
+
+func TestDispatchOnce_PublishesInBatches(t *testing.T) {
+	store := &fakeStore{}
+	store.add(events.CharacterCreated, "character.created:a")
+	store.add(events.CharacterCreated, "character.created:b")
+	store.add(events.ChunkGenerated, "chunk.generated:w:0:0")
+	publisher := &flakyPublisher{}
+	d, _ := newTestDispatcher(store, publisher)
+
+	published, err := d.DispatchOnce(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, published)
+
+	published, err = d.DispatchOnce(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, published)
+
+	published, err = d.DispatchOnce(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, published, "published events are not claimed again")
+
+	require.Len(t, publisher.delivered, 3)
+	assert.Equal(t, "chunk.generated:w:0:0", publisher.delivered[2].DedupKey)
+}
+
+func TestDispatchOnce_RetriesWithBackoff(t *testing.T) {
+	store := &fakeStore{}
+	store.add(events.CharacterCreated, "character.created:a")
+	publisher := &flakyPublisher{failures: 2}
+	d, fake := newTestDispatcher(store, publisher)
+	ctx := context.Background()
+
+	published, err := d.DispatchOnce(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, published)
+	assert.Equal(t, int32(1), store.rows[0].Attempts)
+	assert.Equal(t, "broker unavailable", store.rows[0].LastError.String)
+
+	// First retry waits one poll interval
+	fake.Advance(500 * time.Millisecond)
+	published, _ = d.DispatchOnce(ctx)
+	assert.Zero(t, published, "event is hidden until its retry time")
+
+	fake.Advance(500 * time.Millisecond)
+	published, _ = d.DispatchOnce(ctx)
+	assert.Zero(t, published)
+	assert.Equal(t, int32(2), store.rows[0].Attempts)
+
+	// Second retry waits twice as long
+	fake.Advance(time.Second)
+	published, _ = d.DispatchOnce(ctx)
+	assert.Zero(t, published)
+
+	fake.Advance(time.Second)
+	published, _ = d.DispatchOnce(ctx)
+	assert.Equal(t, 1, published)
+	assert.Len(t, publisher.delivered, 1)
+}
+
+func TestDispatchOnce_ExpiredLeaseIsRedelivered(t *testing.T) {
+	store := &fakeStore{}
+	store.add(events.ChunkGenerated, "chunk.generated:w:1:1")
+	d, fake := newTestDispatcher(store, &flakyPublisher{})
+
+	// Simulate a dispatcher that claimed the event and crashed before publishing
+	_, err := store.ClaimOutboxEvents(context.Background(), db.ClaimOutboxEventsParams{
+		LockedUntil: timestamp(fake.Now().Add(30 * time.Second)),
+		Now:         timestamp(fake.Now()),
+		BatchSize:   1,
+	})
+	require.NoError(t, err)
+
+	published, _ := d.DispatchOnce(context.Background())
+	assert.Zero(t, published, "event is leased by another dispatcher")
+
+	fake.Advance(30 * time.Second)
+	published, _ = d.DispatchOnce(context.Background())
+	assert.Equal(t, 1, published)
+}
+
+func TestBackoff(t *testing.T) {
+	d, _ := newTestDispatcher(&fakeStore{}, &flakyPublisher{})
+
+	assert.Equal(t, time.Second, d.backoff(0))
+	assert.Equal(t, 2*time.Second, d.backoff(1))
+	assert.Equal(t, 4*time.Second, d.backoff(2))
+	assert.Equal(t, 4*time.Second, d.backoff(10), "capped at MaxBackoff")
+}
@@ -0,0 +1,110 @@
+// Package presence tracks which users are online on this instance. A user is
+// online while they hold an open stream or for a short time after any request.
+package presence
+
+import (
+	"sync"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/uuid"
+)
+
+// DefaultTTL is how long a user stays online after their last request
+const DefaultTTL = 2 * time.Minute
+
+// Tracker records user activity. It is safe for concurrent use.
+type Tracker struct {
+	mu       sync.Mutex
+	clock    clock.Clock
+	ttl      time.Duration
+	lastSeen map[string]time.Time
+	streams  map[string]int
+	observer func(userID string)
+}
+
+// NewTracker creates a tracker that considers users online for ttl after their last request
+func NewTracker(ttl time.Duration) *Tracker {
+	return &Tracker{
+		clock:    clock.New(),
+		ttl:      ttl,
+		lastSeen: make(map[string]time.Time),
+		streams:  make(map[string]int),
+	}
+}
+
+// SetClock replaces the clock used for activity timestamps (for simulation tests)
+func (t *Tracker) SetClock(c clock.Clock) {
+	t.mu.Lock()
+	t.clock = c
+	t.mu.Unlock()
+}
+
+// SetObserver registers a function called with the normalized user ID on every request,
+// stream open and stream close, e.g. to track play sessions. It is called without the
+// tracker's lock held, so it may query the tracker.
+func (t *Tracker) SetObserver(observer func(userID string)) {
+	t.mu.Lock()
+	t.observer = observer
+	t.mu.Unlock()
+}
+
+// Touch records activity for the user
+func (t *Tracker) Touch(userID string) {
+	key := uuid.Normalize(userID)
+	t.mu.Lock()
+	t.lastSeen[key] = t.clock.Now()
+	observer := t.observer
+	t.mu.Unlock()
+	if observer != nil {
+		observer(key)
+	}
+}
+
+// Connect keeps the user online until the returned function is called. It is
+// used for the lifetime of a stream.
+func (t *Tracker) Connect(userID string) func() {
+	key := uuid.Normalize(userID)
+	t.mu.Lock()
+	t.streams[key]++
+	observer := t.observer
+	t.mu.Unlock()
+	if observer != nil {
+		observer(key)
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			t.mu.Lock()
+			t.lastSeen[key] = t.clock.Now()
+			if t.streams[key]--; t.streams[key] <= 0 {
+				delete(t.streams, key)
+			}
+			observer := t.observer
+			t.mu.Unlock()
+			if observer != nil {
+				observer(key)
+			}
+		})
+	}
+}
+
+// Online reports whether the user has an open stream or was active within the TTL
+func (t *Tracker) Online(userID string) bool {
+	key := uuid.Normalize(userID)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.streams[key] > 0 {
+		return true
+	}
+	seen, ok := t.lastSeen[key]
+	if !ok {
+		return false
+	}
+	if t.clock.Since(seen) >= t.ttl {
+		delete(t.lastSeen, key)
+		return false
+	}
+	return true
+}
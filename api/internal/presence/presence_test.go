@@ -0,0 +1,62 @@
+package presence
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/stretchr/testify/assert"
+)
+
+const userID = "0b3c1a6e-7f2d-4c1b-9a8e-5d6f7a8b9c0d"
+
+func TestTracker_Touch(t *testing.T) {
+	tracker := NewTracker(time.Minute)
+	fake := clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	tracker.SetClock(fake)
+
+	assert.False(t, tracker.Online(userID))
+	tracker.Touch(userID)
+	assert.True(t, tracker.Online(strings.ReplaceAll(userID, "-", "")), "dashed and undashed IDs match")
+
+	fake.Advance(time.Minute)
+	assert.False(t, tracker.Online(userID), "offline once the TTL passes")
+}
+
+func TestTracker_Connect(t *testing.T) {
+	tracker := NewTracker(time.Minute)
+	fake := clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	tracker.SetClock(fake)
+
+	first := tracker.Connect(userID)
+	second := tracker.Connect(userID)
+	fake.Advance(time.Hour)
+	assert.True(t, tracker.Online(userID), "open streams keep the user online")
+
+	first()
+	first()
+	assert.True(t, tracker.Online(userID), "disconnect is idempotent and per stream")
+
+	second()
+	assert.True(t, tracker.Online(userID), "online for the TTL after the last stream closes")
+	fake.Advance(time.Minute)
+	assert.False(t, tracker.Online(userID))
+}
+
+func TestTracker_Observer(t *testing.T) {
+	tracker := NewTracker(time.Minute)
+	var seen []string
+	tracker.SetObserver(func(id string) {
+		seen = append(seen, id)
+		assert.True(t, tracker.Online(id), "observers see the activity already recorded")
+	})
+
+	tracker.Touch(userID)
+	disconnect := tracker.Connect(userID)
+	disconnect()
+	disconnect()
+
+	key := strings.ReplaceAll(userID, "-", "")
+	assert.Equal(t, []string{key, key, key}, seen, "requests, stream opens and closes are observed once each")
+}
@@ -0,0 +1,100 @@
+// Package rng derives deterministic random streams from a world seed. A stream is
+// keyed by what it is used for and where (chunk coordinates, node IDs), so its values
+// depend only on those keys: never on how many numbers other code drew first or on
+// the order chunks happen to be generated in.
+package rng
+
+import (
+	"hash/fnv"
+)
+
+// Purpose names what a stream is used for. Streams for different purposes are
+// independent even when their keys are equal.
+type Purpose string
+
+const (
+	ClusterPlacement Purpose = "cluster_placement" // Order in which a chunk's spawn points become clusters
+	ClusterShape     Purpose = "cluster_shape"     // Size and layout of a single cluster
+	Yields           Purpose = "yields"            // Harvest drop rolls and quantities
+	Weather          Purpose = "weather"           // Weather patterns
+	RareEvents       Purpose = "rare_events"       // Where and which rare events spawn
+	Fishing          Purpose = "fishing"           // Bite delays and catches
+	Dungeons         Purpose = "dungeons"          // Dungeon instance seeds and layouts
+)
+
+// golden is the SplitMix64 increment, 2^64 divided by the golden ratio
+const golden = 0x9e3779b97f4a7c15
+
+// Stream is a SplitMix64 generator. It is not safe for concurrent use; derive a
+// stream where it is needed rather than sharing one.
+type Stream struct {
+	state uint64
+}
+
+// New derives the stream for purpose at keys from a world seed
+func New(seed int64, purpose Purpose, keys ...int64) *Stream {
+	h := fnv.New64a()
+	h.Write([]byte(purpose))
+	state := mix(uint64(seed) ^ h.Sum64())
+	for _, key := range keys {
+		state = mix(state ^ mix(uint64(key)+golden))
+	}
+	return &Stream{state: state}
+}
+
+// mix is the SplitMix64 output function, a bijection that spreads every input bit
+func mix(z uint64) uint64 {
+	z = (z ^ z>>30) * 0xbf58476d1ce4e5b9
+	z = (z ^ z>>27) * 0x94d049bb133111eb
+	return z ^ z>>31
+}
+
+// Uint64 returns the next 64 random bits
+func (s *Stream) Uint64() uint64 {
+	s.state += golden
+	return mix(s.state)
+}
+
+// uint64n returns a uniform value in [0, n) by rejecting the values that would bias
+// the modulo
+func (s *Stream) uint64n(n uint64) uint64 {
+	threshold := -n % n // 2^64 mod n
+	for {
+		if v := s.Uint64(); v >= threshold {
+			return v % n
+		}
+	}
+}
+
+// Intn returns a uniform value in [0, n). It panics if n <= 0.
+func (s *Stream) Intn(n int) int {
+	if n <= 0 {
+		panic("rng: invalid argument to Intn")
+	}
+	return int(s.uint64n(uint64(n)))
+}
+
+// Int31n returns a uniform value in [0, n). It panics if n <= 0.
+func (s *Stream) Int31n(n int32) int32 {
+	if n <= 0 {
+		panic("rng: invalid argument to Int31n")
+	}
+	return int32(s.uint64n(uint64(n)))
+}
+
+// Float32 returns a uniform value in [0, 1)
+func (s *Stream) Float32() float32 {
+	return float32(s.Uint64()>>40) / (1 << 24)
+}
+
+// Float64 returns a uniform value in [0, 1)
+func (s *Stream) Float64() float64 {
+	return float64(s.Uint64()>>11) / (1 << 53)
+}
+
+// Shuffle permutes n elements with the Fisher-Yates algorithm
+func (s *Stream) Shuffle(n int, swap func(i, j int)) {
+	for i := n - 1; i > 0; i-- {
+		swap(i, s.Intn(i+1))
+	}
+}
@@ -0,0 +1,444 @@
+// Package scripting runs operator-supplied WebAssembly scripts at defined extension
+// points so yields, special events and vetoes can be customized without forking the
+// server.
+//
+// A script is a .wasm module in the scripts directory, loaded in file name order. It
+// must export its linear memory as "memory" and an allocator
+//
+//	alloc(size i32) -> ptr i32
+//
+// and exports any of the hooks below with the signature
+//
+//	hook(ptr i32, len i32) -> i64
+//
+// The hook receives its input as JSON written into memory returned by alloc, and
+// returns the JSON Result packed as ptr<<32 | len, or 0 to leave the action unchanged.
+// The only import available is env.log(ptr i32, len i32), which logs a message; scripts
+// have no WASI, so no files, network, clock or randomness. Each call runs in a fresh
+// instance with Config.MemoryLimitPages of memory and is interrupted after
+// Config.Timeout. A script that fails, traps or runs out of time is logged, reported
+// to alerting and skipped: scripts cannot break the action they hook into.
+package scripting
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/alerting"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	"github.com/charmbracelet/log"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+)
+
+// Hooks scripts can export
+const (
+	HookHarvestComplete = "on_harvest_complete" // Yields rolled, not yet granted; may replace yields or veto
+	HookChunkGenerated  = "on_chunk_generated"  // After a chunk is stored; may spawn events
+	HookTrade           = "on_trade"            // Before a trade settles; may veto
+)
+
+var hooks = []string{HookHarvestComplete, HookChunkGenerated, HookTrade}
+
+const (
+	allocExport  = "alloc"
+	memoryExport = "memory"
+
+	// maxResultBytes bounds the JSON a hook may return
+	maxResultBytes = 64 << 10
+	// maxLogBytes bounds a single env.log message
+	maxLogBytes = 1 << 10
+)
+
+// Config controls where scripts are loaded from and the limits of each call
+type Config struct {
+	Dir              string        // Directory of .wasm scripts
+	Timeout          time.Duration // Per hook call, including instantiation
+	MemoryLimitPages uint32        // Linear memory per call, in 64 KiB pages
+}
+
+// DefaultConfig allows 50ms and 16 MiB per hook call
+func DefaultConfig() Config {
+	return Config{
+		Timeout:          50 * time.Millisecond,
+		MemoryLimitPages: 256,
+	}
+}
+
+// Yield is an item quantity granted by a harvest
+type Yield struct {
+	ItemID   int32 `json:"item_id"`
+	Quantity int32 `json:"quantity"`
+}
+
+// HarvestInput is the input of on_harvest_complete
+type HarvestInput struct {
+	CharacterID        string  `json:"character_id"`
+	WorldID            string  `json:"world_id"`
+	ResourceNodeID     int32   `json:"resource_node_id"`
+	ResourceNodeTypeID int32   `json:"resource_node_type_id"`
+	X                  int32   `json:"x"`
+	Y                  int32   `json:"y"`
+	Yields             []Yield `json:"yields"` // As rolled, or as replaced by earlier scripts
+}
+
+// ChunkInput is the input of on_chunk_generated
+type ChunkInput struct {
+	WorldID string `json:"world_id"`
+	ChunkX  int32  `json:"chunk_x"`
+	ChunkY  int32  `json:"chunk_y"`
+}
+
+// TradeInput is the input of on_trade
+type TradeInput struct {
+	Kind      string `json:"kind"` // "market_buyout"
+	WorldID   string `json:"world_id"`
+	SellerID  string `json:"seller_id"`
+	BuyerID   string `json:"buyer_id"`
+	ItemID    int32  `json:"item_id"`
+	Quantity  int32  `json:"quantity"`
+	UnitPrice int32  `json:"unit_price"`
+}
+
+// Event is a special event spawned by a script, published as events.ScriptEvent
+type Event struct {
+	Name   string          `json:"name"`
+	Data   json.RawMessage `json:"data,omitempty"`
+	Script string          `json:"-"`
+}
+
+// Result is what hooks return. Fields a hook does not support are ignored.
+type Result struct {
+	Veto   bool    `json:"veto"`   // on_harvest_complete and on_trade
+	Reason string  `json:"reason"` // Shown to the player when vetoed
+	Yields []Yield `json:"yields"` // on_harvest_complete; absent keeps the yields
+	Events []Event `json:"events"`
+}
+
+// script is a compiled module and the hooks it exports
+type script struct {
+	name     string
+	compiled wazero.CompiledModule
+	hooks    map[string]bool
+}
+
+type scriptNameKey struct{}
+
+// Engine runs scripts at hooks. It is safe for concurrent use.
+type Engine struct {
+	runtime   wazero.Runtime
+	config    Config
+	scripts   []*script
+	publisher events.Publisher
+	logger    *log.Logger
+}
+
+// NewEngine creates an engine without scripts
+func NewEngine(ctx context.Context, config Config) (*Engine, error) {
+	runtime := wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfig().
+		WithMemoryLimitPages(config.MemoryLimitPages).
+		WithCloseOnContextDone(true))
+	e := &Engine{
+		runtime: runtime,
+		config:  config,
+		logger:  logging.WithComponent("scripting"),
+	}
+
+	_, err := runtime.NewHostModuleBuilder("env").
+		NewFunctionBuilder().WithFunc(e.hostLog).Export("log").
+		Instantiate(ctx)
+	if err != nil {
+		runtime.Close(ctx)
+		return nil, fmt.Errorf("failed to register host functions: %w", err)
+	}
+	return e, nil
+}
+
+// Load creates an engine with every .wasm script in config.Dir
+func Load(ctx context.Context, config Config) (*Engine, error) {
+	paths, err := filepath.Glob(filepath.Join(config.Dir, "*.wasm"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	e, err := NewEngine(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range paths {
+		wasm, err := os.ReadFile(path)
+		if err != nil {
+			e.Close(ctx)
+			return nil, err
+		}
+		if err := e.Add(ctx, filepath.Base(path), wasm); err != nil {
+			e.Close(ctx)
+			return nil, err
+		}
+	}
+	return e, nil
+}
+
+// Add compiles a script and checks its exports and imports. Scripts run in the order
+// they were added.
+func (e *Engine) Add(ctx context.Context, name string, wasm []byte) error {
+	compiled, err := e.runtime.CompileModule(ctx, wasm)
+	if err != nil {
+		return fmt.Errorf("script %s: %w", name, err)
+	}
+
+	exports := compiled.ExportedFunctions()
+	if !hasSignature(exports[allocExport], []api.ValueType{api.ValueTypeI32}, []api.ValueType{api.ValueTypeI32}) {
+		compiled.Close(ctx)
+		return fmt.Errorf("script %s: must export %s(i32) -> i32", name, allocExport)
+	}
+	if _, ok := compiled.ExportedMemories()[memoryExport]; !ok {
+		compiled.Close(ctx)
+		return fmt.Errorf("script %s: must export its memory as %q", name, memoryExport)
+	}
+	s := &script{name: name, compiled: compiled, hooks: make(map[string]bool)}
+	for _, hook := range hooks {
+		def, ok := exports[hook]
+		if !ok {
+			continue
+		}
+		if !hasSignature(def, []api.ValueType{api.ValueTypeI32, api.ValueTypeI32}, []api.ValueType{api.ValueTypeI64}) {
+			compiled.Close(ctx)
+			return fmt.Errorf("script %s: %s must be (i32, i32) -> i64", name, hook)
+		}
+		s.hooks[hook] = true
+	}
+	if len(s.hooks) == 0 {
+		compiled.Close(ctx)
+		return fmt.Errorf("script %s: exports none of %s", name, strings.Join(hooks, ", "))
+	}
+
+	// Instantiating once reports unsatisfied imports and a failing start function now
+	// rather than on every hook call
+	mod, err := e.runtime.InstantiateModule(ctx, compiled, wazero.NewModuleConfig().WithName(""))
+	if err != nil {
+		compiled.Close(ctx)
+		return fmt.Errorf("script %s: %w", name, err)
+	}
+	mod.Close(ctx)
+
+	e.scripts = append(e.scripts, s)
+	e.logger.Info("Script loaded", "script", name, "hooks", len(s.hooks))
+	return nil
+}
+
+// SetPublisher sets where events spawned by scripts are published
+func (e *Engine) SetPublisher(publisher events.Publisher) {
+	e.publisher = publisher
+}
+
+// Close releases every compiled script
+func (e *Engine) Close(ctx context.Context) error {
+	return e.runtime.Close(ctx)
+}
+
+// Len returns the number of loaded scripts
+func (e *Engine) Len() int {
+	return len(e.scripts)
+}
+
+// HarvestComplete runs on_harvest_complete. Each script sees the yields as left by the
+// previous one; the first veto stops the chain.
+func (e *Engine) HarvestComplete(ctx context.Context, input HarvestInput) Result {
+	var result Result
+	result.Yields = input.Yields
+	e.run(ctx, HookHarvestComplete, &input, func(r Result) bool {
+		result.Events = append(result.Events, r.Events...)
+		if r.Veto {
+			result.Veto, result.Reason = true, r.Reason
+			return false
+		}
+		if r.Yields != nil {
+			result.Yields = r.Yields
+			input.Yields = r.Yields
+		}
+		return true
+	})
+	return result
+}
+
+// ChunkGenerated runs on_chunk_generated
+func (e *Engine) ChunkGenerated(ctx context.Context, input ChunkInput) Result {
+	var result Result
+	e.run(ctx, HookChunkGenerated, &input, func(r Result) bool {
+		result.Events = append(result.Events, r.Events...)
+		return true
+	})
+	return result
+}
+
+// Trade runs on_trade; the first veto stops the chain
+func (e *Engine) Trade(ctx context.Context, input TradeInput) Result {
+	var result Result
+	e.run(ctx, HookTrade, &input, func(r Result) bool {
+		result.Events = append(result.Events, r.Events...)
+		if r.Veto {
+			result.Veto, result.Reason = true, r.Reason
+			return false
+		}
+		return true
+	})
+	return result
+}
+
+// Publish publishes the events spawned at a hook. Callers publish once the action has
+// succeeded, so vetoed or failed actions spawn nothing.
+func (e *Engine) Publish(ctx context.Context, hook, worldID string, spawned []Event) {
+	if e.publisher == nil {
+		return
+	}
+	for _, event := range spawned {
+		payload, err := json.Marshal(events.ScriptEventPayload{
+			Script:  event.Script,
+			Hook:    hook,
+			Name:    event.Name,
+			WorldID: worldID,
+			Data:    event.Data,
+		})
+		if err != nil {
+			e.logger.Warn("Dropping script event with invalid data", "script", event.Script, "name", event.Name, "error", err)
+			continue
+		}
+		id := uuid.GenerateNew()
+		if err := e.publisher.Publish(ctx, events.Event{
+			Type:        events.ScriptEvent,
+			AggregateID: worldID,
+			Payload:     payload,
+			DedupKey:    events.ScriptEvent + ":" + id,
+			OccurredAt:  time.Now(),
+		}); err != nil {
+			e.logger.Warn("Failed to publish script event", "script", event.Script, "name", event.Name, "error", err)
+		}
+	}
+}
+
+// Subscribe runs on_chunk_generated for generated chunks and publishes what it spawns
+func (e *Engine) Subscribe(bus *events.Bus) {
+	bus.Subscribe(events.ChunkGenerated, func(ctx context.Context, event events.Event) error {
+		var payload events.ChunkGeneratedPayload
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return fmt.Errorf("invalid %s payload: %w", event.Type, err)
+		}
+		result := e.ChunkGenerated(ctx, ChunkInput{WorldID: payload.WorldID, ChunkX: payload.ChunkX, ChunkY: payload.ChunkY})
+		e.Publish(ctx, HookChunkGenerated, payload.WorldID, result.Events)
+		return nil
+	})
+}
+
+// run calls hook in every script exporting it until next returns false
+func (e *Engine) run(ctx context.Context, hook string, input any, next func(Result) bool) {
+	for _, s := range e.scripts {
+		if !s.hooks[hook] {
+			continue
+		}
+		payload, err := json.Marshal(input)
+		if err != nil {
+			e.logger.Error("Failed to encode script input", "hook", hook, "error", err)
+			return
+		}
+
+		start := time.Now()
+		result, err := e.call(ctx, s, hook, payload)
+		if err != nil {
+			e.logger.Warn("Script failed, skipping it", "script", s.name, "hook", hook, "duration", time.Since(start), "error", err)
+			alerting.ReportJobError("script "+s.name, err)
+			continue
+		}
+		for i := range result.Events {
+			result.Events[i].Script = s.name
+		}
+		if !next(result) {
+			return
+		}
+	}
+}
+
+// call runs one hook of one script in a fresh instance
+func (e *Engine) call(ctx context.Context, s *script, hook string, input []byte) (Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, e.config.Timeout)
+	defer cancel()
+	ctx = context.WithValue(ctx, scriptNameKey{}, s.name)
+
+	mod, err := e.runtime.InstantiateModule(ctx, s.compiled, wazero.NewModuleConfig().WithName(""))
+	if err != nil {
+		return Result{}, err
+	}
+	defer mod.Close(ctx)
+
+	allocated, err := mod.ExportedFunction(allocExport).Call(ctx, uint64(len(input)))
+	if err != nil {
+		return Result{}, fmt.Errorf("alloc: %w", err)
+	}
+	ptr := uint32(allocated[0])
+	if !mod.Memory().Write(ptr, input) {
+		return Result{}, errors.New("alloc returned memory out of range")
+	}
+
+	packed, err := mod.ExportedFunction(hook).Call(ctx, uint64(ptr), uint64(len(input)))
+	if err != nil {
+		return Result{}, err
+	}
+	if packed[0] == 0 {
+		return Result{}, nil
+	}
+	outPtr, outLen := uint32(packed[0]>>32), uint32(packed[0])
+	if outLen > maxResultBytes {
+		return Result{}, fmt.Errorf("result of %d bytes exceeds %d", outLen, maxResultBytes)
+	}
+	out, ok := mod.Memory().Read(outPtr, outLen)
+	if !ok {
+		return Result{}, errors.New("result out of memory range")
+	}
+
+	var result Result
+	if err := json.Unmarshal(out, &result); err != nil {
+		return Result{}, fmt.Errorf("invalid result: %w", err)
+	}
+	return result, nil
+}
+
+// hostLog implements env.log
+func (e *Engine) hostLog(ctx context.Context, m api.Module, ptr, length uint32) {
+	length = min(length, maxLogBytes)
+	message, ok := m.Memory().Read(ptr, length)
+	if !ok {
+		return
+	}
+	name, _ := ctx.Value(scriptNameKey{}).(string)
+	e.logger.Info("Script log", "script", name, "message", string(message))
+}
+
+// hasSignature reports whether a function is defined with the given parameters and results
+func hasSignature(def api.FunctionDefinition, params, results []api.ValueType) bool {
+	if def == nil {
+		return false
+	}
+	return equalTypes(def.ParamTypes(), params) && equalTypes(def.ResultTypes(), results)
+}
+
+func equalTypes(a, b []api.ValueType) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
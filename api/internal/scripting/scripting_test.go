@@ -0,0 +1,258 @@
+package scripting
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Hook bodies for testScript
+var (
+	returnNothing = []byte{0x42, 0x00, 0x0b}                         // i64.const 0
+	trap          = []byte{0x00, 0x0b}                               // unreachable
+	spin          = []byte{0x03, 0x40, 0x0c, 0x00, 0x0b, 0x00, 0x0b} // loop br 0 end; unreachable
+)
+
+// resultAt is the memory offset testScript stores its result JSON at
+const resultAt = 4096
+
+// returnResult is a hook body returning the result stored by testScript
+func returnResult(result string) []byte {
+	body := []byte{0x42}
+	body = append(body, sleb(int64(resultAt)<<32|int64(len(result)))...)
+	return append(body, 0x0b)
+}
+
+// testScript assembles a module exporting memory, alloc and one hook with the given
+// body; result is stored in memory for returnResult
+func testScript(hook string, body []byte, result string) []byte {
+	types := vec(
+		[]byte{0x60, 0x01, 0x7f, 0x01, 0x7f},       // (i32) -> i32
+		[]byte{0x60, 0x02, 0x7f, 0x7f, 0x01, 0x7e}, // (i32, i32) -> i64
+	)
+	functions := vec([]byte{0x00}, []byte{0x01})
+	memory := vec([]byte{0x00, 0x01}) // One page, no maximum
+	exports := vec(
+		append(name(memoryExport), 0x02, 0x00),
+		append(name(allocExport), 0x00, 0x00),
+		append(name(hook), 0x00, 0x01),
+	)
+	alloc := []byte{0x41, 0x80, 0x08, 0x0b} // i32.const 1024
+	code := vec(codeEntry(alloc), codeEntry(body))
+	data := []byte{0x00, 0x41}
+	data = append(data, sleb(resultAt)...)
+	data = append(data, 0x0b)
+	data = append(data, name(result)...)
+
+	module := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+	module = append(module, section(1, types)...)
+	module = append(module, section(3, functions)...)
+	module = append(module, section(5, memory)...)
+	module = append(module, section(7, exports)...)
+	module = append(module, section(10, code)...)
+	return append(module, section(11, vec(data))...)
+}
+
+func codeEntry(body []byte) []byte {
+	entry := append([]byte{0x00}, body...) // No locals
+	return append(uleb(uint64(len(entry))), entry...)
+}
+
+func section(id byte, payload []byte) []byte {
+	return append(append([]byte{id}, uleb(uint64(len(payload)))...), payload...)
+}
+
+func vec(items ...[]byte) []byte {
+	out := uleb(uint64(len(items)))
+	for _, item := range items {
+		out = append(out, item...)
+	}
+	return out
+}
+
+func name(s string) []byte {
+	return append(uleb(uint64(len(s))), s...)
+}
+
+func uleb(v uint64) []byte {
+	var out []byte
+	for {
+		b := byte(v & 0x7f)
+		v >>= 7
+		if v != 0 {
+			b |= 0x80
+		}
+		out = append(out, b)
+		if v == 0 {
+			return out
+		}
+	}
+}
+
+func sleb(v int64) []byte {
+	var out []byte
+	for {
+		b := byte(v & 0x7f)
+		v >>= 7
+		if (v == 0 && b&0x40 == 0) || (v == -1 && b&0x40 != 0) {
+			return append(out, b)
+		}
+		out = append(out, b|0x80)
+	}
+}
+
+func newTestEngine(t *testing.T) *Engine {
+	t.Helper()
+	e, err := NewEngine(context.Background(), DefaultConfig())
+	require.NoError(t, err)
+	t.Cleanup(func() { e.Close(context.Background()) })
+	return e
+}
+
+// recordingPublisher keeps published events
+type recordingPublisher struct {
+	mu     sync.Mutex
+	events []events.Event
+}
+
+func (p *recordingPublisher) Publish(ctx context.Context, event events.Event) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.events = append(p.events, event)
+	return nil
+}
+
+func TestEngine_HarvestComplete(t *testing.T) {
+	ctx := context.Background()
+	input := HarvestInput{WorldID: "w", Yields: []Yield{{ItemID: 1, Quantity: 2}}}
+
+	t.Run("scripts replace yields in order", func(t *testing.T) {
+		e := newTestEngine(t)
+		result := `{"yields":[{"item_id":1,"quantity":10}],"events":[{"name":"bonus","data":{"x":1}}]}`
+		require.NoError(t, e.Add(ctx, "01-bonus.wasm", testScript(HookHarvestComplete, returnResult(result), result)))
+		require.NoError(t, e.Add(ctx, "02-noop.wasm", testScript(HookHarvestComplete, returnNothing, "")))
+
+		got := e.HarvestComplete(ctx, input)
+		assert.False(t, got.Veto)
+		assert.Equal(t, []Yield{{ItemID: 1, Quantity: 10}}, got.Yields)
+		require.Len(t, got.Events, 1)
+		assert.Equal(t, "bonus", got.Events[0].Name)
+		assert.Equal(t, "01-bonus.wasm", got.Events[0].Script)
+	})
+
+	t.Run("a veto stops the chain", func(t *testing.T) {
+		e := newTestEngine(t)
+		veto := `{"veto":true,"reason":"the forest is resting"}`
+		empty := `{"yields":[]}`
+		require.NoError(t, e.Add(ctx, "veto.wasm", testScript(HookHarvestComplete, returnResult(veto), veto)))
+		require.NoError(t, e.Add(ctx, "empty.wasm", testScript(HookHarvestComplete, returnResult(empty), empty)))
+
+		got := e.HarvestComplete(ctx, input)
+		assert.True(t, got.Veto)
+		assert.Equal(t, "the forest is resting", got.Reason)
+		assert.Equal(t, input.Yields, got.Yields)
+	})
+
+	t.Run("failing scripts are skipped", func(t *testing.T) {
+		e := newTestEngine(t)
+		require.NoError(t, e.Add(ctx, "trap.wasm", testScript(HookHarvestComplete, trap, "")))
+		require.NoError(t, e.Add(ctx, "bad-json.wasm", testScript(HookHarvestComplete, returnResult("{"), "{")))
+
+		got := e.HarvestComplete(ctx, input)
+		assert.False(t, got.Veto)
+		assert.Equal(t, input.Yields, got.Yields)
+	})
+
+	t.Run("scripts running past the timeout are interrupted", func(t *testing.T) {
+		config := DefaultConfig()
+		config.Timeout = 20 * time.Millisecond
+		e, err := NewEngine(ctx, config)
+		require.NoError(t, err)
+		defer e.Close(ctx)
+		require.NoError(t, e.Add(ctx, "spin.wasm", testScript(HookHarvestComplete, spin, "")))
+
+		start := time.Now()
+		got := e.HarvestComplete(ctx, input)
+		assert.Less(t, time.Since(start), time.Second)
+		assert.Equal(t, input.Yields, got.Yields)
+	})
+}
+
+func TestEngine_Trade(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEngine(t)
+	veto := `{"veto":true,"reason":"embargo"}`
+	require.NoError(t, e.Add(ctx, "embargo.wasm", testScript(HookTrade, returnResult(veto), veto)))
+
+	got := e.Trade(ctx, TradeInput{Kind: "market_buyout"})
+	assert.True(t, got.Veto)
+	assert.Equal(t, "embargo", got.Reason)
+
+	// Scripts without the hook are not run
+	assert.False(t, e.HarvestComplete(ctx, HarvestInput{}).Veto)
+}
+
+func TestEngine_Subscribe(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEngine(t)
+	publisher := &recordingPublisher{}
+	e.SetPublisher(publisher)
+	result := `{"events":[{"name":"meteor","data":{"cell":7}}]}`
+	require.NoError(t, e.Add(ctx, "meteor.wasm", testScript(HookChunkGenerated, returnResult(result), result)))
+
+	bus := events.NewBus()
+	e.Subscribe(bus)
+	payload, err := json.Marshal(events.ChunkGeneratedPayload{WorldID: "world-1", ChunkX: 3, ChunkY: -1})
+	require.NoError(t, err)
+	require.NoError(t, bus.Publish(ctx, events.Event{Type: events.ChunkGenerated, Payload: payload}))
+
+	require.Len(t, publisher.events, 1)
+	assert.Equal(t, events.ScriptEvent, publisher.events[0].Type)
+	var spawned events.ScriptEventPayload
+	require.NoError(t, json.Unmarshal(publisher.events[0].Payload, &spawned))
+	assert.Equal(t, "meteor.wasm", spawned.Script)
+	assert.Equal(t, HookChunkGenerated, spawned.Hook)
+	assert.Equal(t, "meteor", spawned.Name)
+	assert.Equal(t, "world-1", spawned.WorldID)
+	assert.JSONEq(t, `{"cell":7}`, string(spawned.Data))
+}
+
+func TestEngine_Add(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEngine(t)
+
+	err := e.Add(ctx, "unknown-hook.wasm", testScript("on_something_else", returnNothing, ""))
+	assert.ErrorContains(t, err, "exports none of")
+
+	err = e.Add(ctx, "garbage.wasm", []byte("not wasm"))
+	assert.Error(t, err)
+	assert.Zero(t, e.Len())
+}
+
+func TestLoad(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.wasm"), testScript(HookTrade, returnNothing, ""), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.wasm"), testScript(HookHarvestComplete, returnNothing, ""), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("not a script"), 0o644))
+
+	config := DefaultConfig()
+	config.Dir = dir
+	e, err := Load(ctx, config)
+	require.NoError(t, err)
+	defer e.Close(ctx)
+	require.Equal(t, 2, e.Len())
+	assert.Equal(t, "a.wasm", e.scripts[0].name)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "c.wasm"), []byte("broken"), 0o644))
+	_, err = Load(ctx, config)
+	assert.ErrorContains(t, err, "c.wasm")
+}
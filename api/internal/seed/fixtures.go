@@ -0,0 +1,157 @@
+package seed
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed fixtures/dev.yaml
+var defaultFixtures []byte
+
+// Fixtures declares the data the seed tool loads into a fresh database
+type Fixtures struct {
+	Version int           `yaml:"version"`
+	World   WorldFixture  `yaml:"world"`
+	Chunks  ChunksFixture `yaml:"chunks"`
+	Users   []UserFixture `yaml:"users"`
+}
+
+// WorldFixture describes the default world
+type WorldFixture struct {
+	Name string `yaml:"name"`
+	Seed int64  `yaml:"seed"`
+}
+
+// ChunksFixture controls terrain pre-generation around the origin
+type ChunksFixture struct {
+	Radius int32 `yaml:"radius"`
+}
+
+// UserFixture describes a user account and its characters
+type UserFixture struct {
+	Username    string             `yaml:"username"`
+	DisplayName string             `yaml:"display_name"`
+	Email       string             `yaml:"email"`
+	Password    string             `yaml:"password"`
+	Characters  []CharacterFixture `yaml:"characters"`
+}
+
+// CharacterFixture describes a character and its starting inventory
+type CharacterFixture struct {
+	Name      string             `yaml:"name"`
+	X         int32              `yaml:"x"`
+	Y         int32              `yaml:"y"`
+	Inventory []InventoryFixture `yaml:"inventory"`
+}
+
+// InventoryFixture references an item by name
+type InventoryFixture struct {
+	Item     string `yaml:"item"`
+	Quantity int32  `yaml:"quantity"`
+}
+
+// maxChunkRadius keeps an accidental large radius from generating thousands of chunks
+const maxChunkRadius = 16
+
+// ParseFixtures decodes and validates a fixture file
+func ParseFixtures(data []byte) (*Fixtures, error) {
+	var f Fixtures
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&f); err != nil {
+		return nil, fmt.Errorf("failed to parse fixtures: %w", err)
+	}
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+// LoadFixtures reads and merges fixture files in order, using the embedded defaults when none are given
+func LoadFixtures(paths []string) (*Fixtures, error) {
+	if len(paths) == 0 {
+		return ParseFixtures(defaultFixtures)
+	}
+
+	var merged *Fixtures
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read fixtures %s: %w", path, err)
+		}
+		f, err := ParseFixtures(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		if merged == nil {
+			merged = f
+			continue
+		}
+		merged.merge(f)
+	}
+
+	if err := merged.Validate(); err != nil {
+		return nil, err
+	}
+	return merged, nil
+}
+
+// merge layers other on top of f: world and chunk settings are replaced when set, users are appended
+func (f *Fixtures) merge(other *Fixtures) {
+	if other.World.Name != "" {
+		f.World = other.World
+	}
+	if other.Chunks.Radius != 0 {
+		f.Chunks = other.Chunks
+	}
+	f.Users = append(f.Users, other.Users...)
+}
+
+// Validate checks the fixtures for mistakes that would otherwise fail halfway through seeding
+func (f *Fixtures) Validate() error {
+	if f.Version != 1 {
+		return fmt.Errorf("unsupported fixture version %d", f.Version)
+	}
+	if f.World.Name == "" {
+		return errors.New("world.name is required")
+	}
+	if f.Chunks.Radius < 0 || f.Chunks.Radius > maxChunkRadius {
+		return fmt.Errorf("chunks.radius must be between 0 and %d", maxChunkRadius)
+	}
+
+	usernames := make(map[string]bool)
+	for i, u := range f.Users {
+		if u.Username == "" || u.Email == "" || u.Password == "" {
+			return fmt.Errorf("users[%d]: username, email and password are required", i)
+		}
+		key := strings.ToLower(u.Username)
+		if usernames[key] {
+			return fmt.Errorf("users[%d]: duplicate username %q", i, u.Username)
+		}
+		usernames[key] = true
+
+		names := make(map[string]bool)
+		for j, c := range u.Characters {
+			if c.Name == "" {
+				return fmt.Errorf("users[%d].characters[%d]: name is required", i, j)
+			}
+			if names[c.Name] {
+				return fmt.Errorf("users[%d].characters[%d]: duplicate character name %q", i, j, c.Name)
+			}
+			names[c.Name] = true
+
+			for k, item := range c.Inventory {
+				if item.Item == "" || item.Quantity <= 0 {
+					return fmt.Errorf("users[%d].characters[%d].inventory[%d]: item and a positive quantity are required", i, j, k)
+				}
+			}
+		}
+	}
+	return nil
+}
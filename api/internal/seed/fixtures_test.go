@@ -0,0 +1,92 @@
+package seed
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const extraFixtures = `
+version: 1
+world:
+  name: Demo World
+  seed: 42
+users:
+  - username: carol
+    email: carol@voidmesh.dev
+    password: demopassword
+    characters:
+      - name: Cato
+`
+
+func TestLoadFixtures_Default(t *testing.T) {
+	f, err := LoadFixtures(nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "VoidMesh Dev World", f.World.Name)
+	assert.Equal(t, int32(2), f.Chunks.Radius)
+	assert.NotEmpty(t, f.Users)
+}
+
+func TestLoadFixtures_Merge(t *testing.T) {
+	dir := t.TempDir()
+	base := filepath.Join(dir, "base.yaml")
+	extra := filepath.Join(dir, "extra.yaml")
+	require.NoError(t, os.WriteFile(base, defaultFixtures, 0o600))
+	require.NoError(t, os.WriteFile(extra, []byte(extraFixtures), 0o600))
+
+	f, err := LoadFixtures([]string{base, extra})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Demo World", f.World.Name, "later files override the world")
+	assert.Equal(t, int32(2), f.Chunks.Radius, "unset chunk settings are kept")
+	assert.Equal(t, "carol", f.Users[len(f.Users)-1].Username, "users are appended")
+
+	_, err = LoadFixtures([]string{base, base})
+	assert.ErrorContains(t, err, "duplicate username")
+}
+
+func TestParseFixtures_Invalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		mutate      func(string) string
+		errContains string
+	}{
+		{
+			name:        "unknown field",
+			mutate:      func(s string) string { return s + "npcs: []\n" },
+			errContains: "field npcs not found",
+		},
+		{
+			name:        "unsupported version",
+			mutate:      func(s string) string { return strings.Replace(s, "version: 1", "version: 2", 1) },
+			errContains: "unsupported fixture version 2",
+		},
+		{
+			name:        "missing password",
+			mutate:      func(s string) string { return strings.Replace(s, "password: demopassword", "", 1) },
+			errContains: "username, email and password are required",
+		},
+		{
+			name:        "radius too large",
+			mutate:      func(s string) string { return s + "chunks:\n  radius: 500\n" },
+			errContains: "chunks.radius must be between 0 and 16",
+		},
+		{
+			name:        "non-positive quantity",
+			mutate:      func(s string) string { return s + "        inventory:\n          - { item: Herbs, quantity: 0 }\n" },
+			errContains: "positive quantity",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseFixtures([]byte(tt.mutate(extraFixtures)))
+			assert.ErrorContains(t, err, tt.errContains)
+		})
+	}
+}
@@ -0,0 +1,174 @@
+// Package seed populates a fresh database with a default world, sample users and
+// characters, pre-generated chunks around the origin and starting inventories from
+// declarative YAML fixtures.
+package seed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	"github.com/VoidMesh/api/api/server/handlers"
+	"github.com/VoidMesh/api/api/services/chunk"
+	"github.com/VoidMesh/api/api/services/world"
+	"github.com/charmbracelet/log"
+	"github.com/jackc/pgx/v5"
+)
+
+// Seeder loads fixtures into the database. Every step skips records that already
+// exist, so re-running it against a seeded database is safe.
+type Seeder struct {
+	pool      storage.Pool
+	queries   *db.Queries
+	passwords handlers.PasswordService
+	logger    *log.Logger
+}
+
+// NewSeeder creates a seeder backed by the given pool
+func NewSeeder(pool storage.Pool, logger *log.Logger) *Seeder {
+	return &Seeder{
+		pool:      pool,
+		queries:   db.New(pool),
+		passwords: handlers.NewPasswordService(),
+		logger:    logger,
+	}
+}
+
+// Seed runs every seeding step in dependency order
+func (s *Seeder) Seed(ctx context.Context, f *Fixtures) error {
+	w, err := s.seedWorld(ctx, f.World)
+	if err != nil {
+		return err
+	}
+
+	if err := s.seedChunks(ctx, w, f.Chunks); err != nil {
+		return err
+	}
+
+	for _, u := range f.Users {
+		if err := s.seedUser(ctx, w, u); err != nil {
+			return fmt.Errorf("user %s: %w", u.Username, err)
+		}
+	}
+	return nil
+}
+
+// seedWorld finds the fixture world by name or creates it
+func (s *Seeder) seedWorld(ctx context.Context, wf WorldFixture) (db.World, error) {
+	worlds, err := s.queries.ListWorlds(ctx)
+	if err != nil {
+		return db.World{}, fmt.Errorf("failed to list worlds: %w", err)
+	}
+	for _, w := range worlds {
+		if w.Name == wf.Name {
+			s.logger.Info("World already exists", "name", w.Name, "seed", w.Seed)
+			return w, nil
+		}
+	}
+
+	w, err := s.queries.CreateWorld(ctx, db.CreateWorldParams{Name: wf.Name, Seed: wf.Seed})
+	if err != nil {
+		return db.World{}, fmt.Errorf("failed to create world: %w", err)
+	}
+	s.logger.Info("Created world", "name", w.Name, "seed", w.Seed)
+	return w, nil
+}
+
+// seedChunks generates terrain and resource nodes around the origin chunk of the world
+func (s *Seeder) seedChunks(ctx context.Context, w db.World, cf ChunksFixture) error {
+	// Chunk generation reads and writes the world the context is bound to
+	ctx = session.WithWorldID(ctx, uuid.PgtypeToString(w.ID))
+	worldService := world.NewServiceWithPool(s.pool, world.NewDefaultLoggerWrapper())
+	chunkService := chunk.NewServiceWithPool(s.pool, worldService, world.NewSeeds(worldService))
+
+	chunks, err := chunkService.GetChunksInRadius(ctx, 0, 0, cf.Radius)
+	if err != nil {
+		return fmt.Errorf("failed to generate chunks: %w", err)
+	}
+	s.logger.Info("Chunks ready around origin", "world", w.Name, "radius", cf.Radius, "count", len(chunks))
+	return nil
+}
+
+// seedUser creates the user if needed, then its characters
+func (s *Seeder) seedUser(ctx context.Context, w db.World, uf UserFixture) error {
+	user, err := s.queries.GetUserByUsername(ctx, uf.Username)
+	switch {
+	case err == nil:
+		s.logger.Info("User already exists", "username", uf.Username)
+	case errors.Is(err, pgx.ErrNoRows):
+		hash, err := s.passwords.HashPassword(uf.Password)
+		if err != nil {
+			return fmt.Errorf("failed to hash password: %w", err)
+		}
+		user, err = s.queries.CreateUser(ctx, db.CreateUserParams{
+			Username:     uf.Username,
+			DisplayName:  uf.DisplayName,
+			Email:        uf.Email,
+			PasswordHash: hash,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create user: %w", err)
+		}
+		s.logger.Info("Created user", "username", uf.Username)
+	default:
+		return fmt.Errorf("failed to look up user: %w", err)
+	}
+
+	for _, cf := range uf.Characters {
+		if err := s.seedCharacter(ctx, w, user, cf); err != nil {
+			return fmt.Errorf("character %s: %w", cf.Name, err)
+		}
+	}
+	return nil
+}
+
+// seedCharacter creates the character and its starting inventory. Inventory is only
+// granted to newly created characters so re-runs don't stack items.
+func (s *Seeder) seedCharacter(ctx context.Context, w db.World, user db.User, cf CharacterFixture) error {
+	_, err := s.queries.GetCharacterByUserAndName(ctx, db.GetCharacterByUserAndNameParams{
+		UserID: user.ID,
+		Name:   cf.Name,
+	})
+	if err == nil {
+		s.logger.Info("Character already exists", "name", cf.Name)
+		return nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("failed to look up character: %w", err)
+	}
+
+	chunkX, chunkY := geometry.ChunkOf(geometry.Point{X: cf.X, Y: cf.Y})
+	character, err := s.queries.CreateCharacter(ctx, db.CreateCharacterParams{
+		UserID:  user.ID,
+		Name:    cf.Name,
+		X:       cf.X,
+		Y:       cf.Y,
+		ChunkX:  chunkX,
+		ChunkY:  chunkY,
+		WorldID: w.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create character: %w", err)
+	}
+	s.logger.Info("Created character", "name", cf.Name, "x", cf.X, "y", cf.Y)
+
+	for _, inv := range cf.Inventory {
+		item, err := s.queries.GetItemByName(ctx, inv.Item)
+		if err != nil {
+			return fmt.Errorf("unknown item %q: %w", inv.Item, err)
+		}
+		if _, err := s.queries.CreateInventoryItem(ctx, db.CreateInventoryItemParams{
+			CharacterID: character.ID,
+			ItemID:      item.ID,
+			Quantity:    inv.Quantity,
+		}); err != nil {
+			return fmt.Errorf("failed to add %s: %w", inv.Item, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package session
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/internal/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type contextKey string
+
+const (
+	worldIDKey     contextKey = "world_id"
+	characterIDKey contextKey = "character_id"
+)
+
+// WithWorldID binds the session in ctx to the given world
+func WithWorldID(ctx context.Context, worldID string) context.Context {
+	return context.WithValue(ctx, worldIDKey, worldID)
+}
+
+// WorldIDFromContext returns the world the session is bound to, if any
+func WorldIDFromContext(ctx context.Context) (string, bool) {
+	worldID, ok := ctx.Value(worldIDKey).(string)
+	return worldID, ok && worldID != ""
+}
+
+// WithCharacterID records the character acting in ctx, so what its action causes, such as
+// generating a chunk, can be credited to it
+func WithCharacterID(ctx context.Context, characterID string) context.Context {
+	return context.WithValue(ctx, characterIDKey, characterID)
+}
+
+// CharacterIDFromContext returns the character acting in ctx, if any
+func CharacterIDFromContext(ctx context.Context) (string, bool) {
+	characterID, ok := ctx.Value(characterIDKey).(string)
+	return characterID, ok && characterID != ""
+}
+
+// WorldUUIDFromContext returns the session world as a pgtype.UUID.
+// The result is invalid (NULL) when the session is not bound to a world.
+func WorldUUIDFromContext(ctx context.Context) (pgtype.UUID, error) {
+	worldID, ok := WorldIDFromContext(ctx)
+	if !ok {
+		return pgtype.UUID{}, nil
+	}
+	return uuid.StringToPgtype(worldID)
+}
+
+// RequireWorld returns a PermissionDenied error unless the session is bound to worldID.
+// The auth middleware binds every player session, falling back to the default world for
+// tokens without a world claim, so an unbound context is not acting for a player.
+func RequireWorld(ctx context.Context, worldID pgtype.UUID) error {
+	sessionWorld, ok := WorldIDFromContext(ctx)
+	if !ok {
+		return status.Errorf(codes.PermissionDenied, "session is not bound to a world")
+	}
+	if !uuid.Compare(sessionWorld, uuid.PgtypeToString(worldID)) {
+		return status.Errorf(codes.PermissionDenied, "character belongs to a different world")
+	}
+	return nil
+}
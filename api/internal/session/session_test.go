@@ -0,0 +1,50 @@
+package session
+
+import (
+	"context"
+	"testing"
+
+	"github.com/VoidMesh/api/api/internal/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestWorldIDFromContext(t *testing.T) {
+	_, ok := WorldIDFromContext(context.Background())
+	assert.False(t, ok)
+
+	_, ok = WorldIDFromContext(WithWorldID(context.Background(), ""))
+	assert.False(t, ok, "an empty claim does not bind the session")
+
+	worldID, ok := WorldIDFromContext(WithWorldID(context.Background(), "650e8400e29b41d4a716446655440000"))
+	assert.True(t, ok)
+	assert.Equal(t, "650e8400e29b41d4a716446655440000", worldID)
+}
+
+func TestWorldUUIDFromContext(t *testing.T) {
+	worldUUID, err := WorldUUIDFromContext(context.Background())
+	require.NoError(t, err)
+	assert.False(t, worldUUID.Valid)
+
+	worldUUID, err = WorldUUIDFromContext(WithWorldID(context.Background(), "650e8400-e29b-41d4-a716-446655440000"))
+	require.NoError(t, err)
+	assert.Equal(t, "650e8400-e29b-41d4-a716-446655440000", uuid.PgtypeToString(worldUUID))
+
+	_, err = WorldUUIDFromContext(WithWorldID(context.Background(), "not-a-uuid"))
+	assert.Error(t, err)
+}
+
+func TestRequireWorld(t *testing.T) {
+	home, err := uuid.StringToPgtype("650e8400-e29b-41d4-a716-446655440000")
+	require.NoError(t, err)
+	other, err := uuid.StringToPgtype("750e8400-e29b-41d4-a716-446655440000")
+	require.NoError(t, err)
+
+	bound := WithWorldID(context.Background(), "650e8400e29b41d4a716446655440000")
+
+	assert.NoError(t, RequireWorld(bound, home), "dashed and undashed forms compare equal")
+	assert.Equal(t, codes.PermissionDenied, status.Code(RequireWorld(bound, other)))
+	assert.Equal(t, codes.PermissionDenied, status.Code(RequireWorld(context.Background(), home)), "unbound contexts do not act for a player")
+}
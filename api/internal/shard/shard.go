@@ -0,0 +1,199 @@
+// Package shard assigns worlds to serving instances through the shard registry
+// tables. Each instance heartbeats into shard_instances; a world belongs to the
+// instance that first claims it and fails over to another instance once the
+// owner's heartbeat is older than Config.FailoverAfter.
+package shard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/alerting"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	"github.com/charmbracelet/log"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DatabaseInterface abstracts the shard registry queries
+type DatabaseInterface interface {
+	HeartbeatShardInstance(ctx context.Context, arg db.HeartbeatShardInstanceParams) error
+	GetWorldShard(ctx context.Context, worldID pgtype.UUID) (db.GetWorldShardRow, error)
+	ClaimWorldShard(ctx context.Context, arg db.ClaimWorldShardParams) (db.WorldShard, error)
+}
+
+// Config identifies this instance and tunes failover
+type Config struct {
+	InstanceID        string        // unique per serving instance
+	Address           string        // host:port clients are redirected to for worlds this instance owns
+	HeartbeatInterval time.Duration // how often this instance reports itself healthy
+	FailoverAfter     time.Duration // owners silent for this long lose their worlds to the next caller
+	CacheTTL          time.Duration // how long a lookup is trusted before re-reading the registry
+}
+
+// DefaultConfig returns production defaults for the given instance
+func DefaultConfig(instanceID, address string) Config {
+	return Config{
+		InstanceID:        instanceID,
+		Address:           address,
+		HeartbeatInterval: 5 * time.Second,
+		FailoverAfter:     30 * time.Second,
+		CacheTTL:          5 * time.Second,
+	}
+}
+
+// Owner is the instance serving a world
+type Owner struct {
+	InstanceID string
+	Address    string
+}
+
+type cachedOwner struct {
+	owner     Owner
+	expiresAt time.Time
+}
+
+// Registry resolves and claims world ownership for one instance
+type Registry struct {
+	db     DatabaseInterface
+	config Config
+	clock  clock.Clock
+	logger *log.Logger
+
+	mu    sync.Mutex
+	cache map[string]cachedOwner
+}
+
+// NewRegistry creates a registry for the instance described by config
+func NewRegistry(database DatabaseInterface, config Config) *Registry {
+	return &Registry{
+		db:     database,
+		config: config,
+		clock:  clock.New(),
+		logger: logging.WithComponent("shard-registry"),
+		cache:  make(map[string]cachedOwner),
+	}
+}
+
+// SetClock replaces the clock used for heartbeats and failover (for simulation tests)
+func (r *Registry) SetClock(c clock.Clock) {
+	r.clock = c
+}
+
+// InstanceID returns the ID of this instance
+func (r *Registry) InstanceID() string {
+	return r.config.InstanceID
+}
+
+// Heartbeat records this instance as healthy. It must succeed once before the
+// instance can claim worlds.
+func (r *Registry) Heartbeat(ctx context.Context) error {
+	return r.db.HeartbeatShardInstance(ctx, db.HeartbeatShardInstanceParams{
+		InstanceID: r.config.InstanceID,
+		Address:    r.config.Address,
+		Now:        timestamp(r.clock.Now()),
+	})
+}
+
+// Run heartbeats until ctx is cancelled. If heartbeats stop succeeding other
+// instances take over this instance's worlds after FailoverAfter.
+func (r *Registry) Run(ctx context.Context) {
+	r.logger.Info("Shard heartbeat started", "instance_id", r.config.InstanceID, "interval", r.config.HeartbeatInterval)
+	for {
+		select {
+		case <-ctx.Done():
+			r.logger.Info("Shard heartbeat stopped")
+			return
+		case <-r.clock.After(r.config.HeartbeatInterval):
+		}
+
+		if err := r.Heartbeat(ctx); err != nil {
+			r.logger.Error("Shard heartbeat failed", "error", err)
+			alerting.ReportJobError("shard_heartbeat", err)
+		}
+	}
+}
+
+// Owner returns the instance serving the world. Unassigned worlds and worlds whose
+// owner stopped heartbeating are claimed by this instance.
+func (r *Registry) Owner(ctx context.Context, worldID string) (Owner, error) {
+	key := uuid.Normalize(worldID)
+	now := r.clock.Now()
+
+	r.mu.Lock()
+	cached, ok := r.cache[key]
+	r.mu.Unlock()
+	if ok && now.Before(cached.expiresAt) {
+		return cached.owner, nil
+	}
+
+	worldUUID, err := uuid.StringToPgtype(worldID)
+	if err != nil {
+		return Owner{}, fmt.Errorf("invalid world ID: %w", err)
+	}
+
+	owner, err := r.resolve(ctx, worldUUID, now)
+	if err != nil {
+		return Owner{}, err
+	}
+
+	r.mu.Lock()
+	r.cache[key] = cachedOwner{owner: owner, expiresAt: now.Add(r.config.CacheTTL)}
+	r.mu.Unlock()
+	return owner, nil
+}
+
+// IsLocal reports whether the owner is this instance
+func (r *Registry) IsLocal(owner Owner) bool {
+	return owner.InstanceID == r.config.InstanceID
+}
+
+func (r *Registry) resolve(ctx context.Context, worldID pgtype.UUID, now time.Time) (Owner, error) {
+	staleBefore := now.Add(-r.config.FailoverAfter)
+
+	current, err := r.db.GetWorldShard(ctx, worldID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return Owner{}, fmt.Errorf("failed to look up world shard: %w", err)
+	}
+	if err == nil && (current.InstanceID == r.config.InstanceID || current.HeartbeatAt.Time.After(staleBefore)) {
+		return Owner{InstanceID: current.InstanceID, Address: current.Address}, nil
+	}
+
+	_, err = r.db.ClaimWorldShard(ctx, db.ClaimWorldShardParams{
+		WorldID:     worldID,
+		InstanceID:  r.config.InstanceID,
+		Now:         timestamp(now),
+		StaleBefore: timestamp(staleBefore),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		// Another healthy instance claimed it first
+		current, err = r.db.GetWorldShard(ctx, worldID)
+		if err != nil {
+			return Owner{}, fmt.Errorf("failed to look up world shard: %w", err)
+		}
+		return Owner{InstanceID: current.InstanceID, Address: current.Address}, nil
+	}
+	if err != nil {
+		return Owner{}, fmt.Errorf("failed to claim world shard: %w", err)
+	}
+
+	if current.InstanceID != "" {
+		r.logger.Warn("Took over world from unhealthy instance",
+			"world_id", uuid.PgtypeToString(worldID),
+			"previous_instance_id", current.InstanceID,
+			"last_heartbeat", current.HeartbeatAt.Time)
+	} else {
+		r.logger.Info("Claimed world", "world_id", uuid.PgtypeToString(worldID))
+	}
+	return Owner{InstanceID: r.config.InstanceID, Address: r.config.Address}, nil
+}
+
+func timestamp(t time.Time) pgtype.Timestamp {
+	return pgtype.Timestamp{Time: t, Valid: true}
+}
@@ -0,0 +1,194 @@
+package shard
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testWorldID = "550e8400-e29b-41d4-a716-446655440000"
+
+// fakeRegistryDB mirrors the shard registry queries in memory, including the
+// ClaimWorldShard takeover condition
+type fakeRegistryDB struct {
+	mu        sync.Mutex
+	instances map[string]db.ShardInstance
+	shards    map[string]string
+	lookups   int
+}
+
+func newFakeRegistryDB() *fakeRegistryDB {
+	return &fakeRegistryDB{
+		instances: make(map[string]db.ShardInstance),
+		shards:    make(map[string]string),
+	}
+}
+
+func (f *fakeRegistryDB) HeartbeatShardInstance(ctx context.Context, arg db.HeartbeatShardInstanceParams) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.instances[arg.InstanceID] = db.ShardInstance{InstanceID: arg.InstanceID, Address: arg.Address, HeartbeatAt: arg.Now}
+	return nil
+}
+
+func (f *fakeRegistryDB) GetWorldShard(ctx context.Context, worldID pgtype.UUID) (db.GetWorldShardRow, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.lookups++
+
+	instanceID, ok := f.shards[uuid.PgtypeToString(worldID)]
+	if !ok {
+		return db.GetWorldShardRow{}, pgx.ErrNoRows
+	}
+	instance := f.instances[instanceID]
+	return db.GetWorldShardRow{WorldID: worldID, InstanceID: instanceID, Address: instance.Address, HeartbeatAt: instance.HeartbeatAt}, nil
+}
+
+func (f *fakeRegistryDB) ClaimWorldShard(ctx context.Context, arg db.ClaimWorldShardParams) (db.WorldShard, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.instances[arg.InstanceID]; !ok {
+		return db.WorldShard{}, errors.New("foreign key violation: instance has not heartbeated")
+	}
+	key := uuid.PgtypeToString(arg.WorldID)
+	if current, ok := f.shards[key]; ok && current != arg.InstanceID {
+		if f.instances[current].HeartbeatAt.Time.After(arg.StaleBefore.Time) {
+			return db.WorldShard{}, pgx.ErrNoRows
+		}
+	}
+	f.shards[key] = arg.InstanceID
+	return db.WorldShard{WorldID: arg.WorldID, InstanceID: arg.InstanceID, AssignedAt: arg.Now}, nil
+}
+
+func newTestRegistry(t *testing.T, database *fakeRegistryDB, instanceID string, c clock.Clock) *Registry {
+	r := NewRegistry(database, DefaultConfig(instanceID, instanceID+":50051"))
+	r.SetClock(c)
+	require.NoError(t, r.Heartbeat(context.Background()))
+	return r
+}
+
+func TestOwner_ClaimsUnassignedWorld(t *testing.T) {
+	database := newFakeRegistryDB()
+	fake := clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	a := newTestRegistry(t, database, "a", fake)
+	b := newTestRegistry(t, database, "b", fake)
+	ctx := context.Background()
+
+	owner, err := a.Owner(ctx, testWorldID)
+	require.NoError(t, err)
+	assert.True(t, a.IsLocal(owner))
+
+	owner, err = b.Owner(ctx, testWorldID)
+	require.NoError(t, err)
+	assert.False(t, b.IsLocal(owner))
+	assert.Equal(t, Owner{InstanceID: "a", Address: "a:50051"}, owner)
+}
+
+func TestOwner_FailsOverFromSilentInstance(t *testing.T) {
+	database := newFakeRegistryDB()
+	fake := clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	a := newTestRegistry(t, database, "a", fake)
+	b := newTestRegistry(t, database, "b", fake)
+	ctx := context.Background()
+
+	_, err := a.Owner(ctx, testWorldID)
+	require.NoError(t, err)
+
+	// b keeps heartbeating while a goes silent for exactly FailoverAfter
+	fake.Advance(b.config.FailoverAfter)
+	require.NoError(t, b.Heartbeat(ctx))
+
+	owner, err := b.Owner(ctx, testWorldID)
+	require.NoError(t, err)
+	assert.True(t, b.IsLocal(owner), "b takes over once a's heartbeat is stale")
+
+	// a comes back but b is healthy, so a must redirect once its cache expires
+	require.NoError(t, a.Heartbeat(ctx))
+	owner, err = a.Owner(ctx, testWorldID)
+	require.NoError(t, err)
+	assert.Equal(t, "b", owner.InstanceID)
+}
+
+func TestOwner_CachesLookups(t *testing.T) {
+	database := newFakeRegistryDB()
+	fake := clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	a := newTestRegistry(t, database, "a", fake)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		_, err := a.Owner(ctx, testWorldID)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, database.lookups)
+
+	fake.Advance(a.config.CacheTTL)
+	_, err := a.Owner(ctx, testWorldID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, database.lookups)
+
+	_, err = a.Owner(ctx, "not-a-uuid")
+	assert.Error(t, err)
+}
+
+func TestOwner_ConcurrentClaimsAgree(t *testing.T) {
+	database := newFakeRegistryDB()
+	fake := clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	registries := []*Registry{
+		newTestRegistry(t, database, "a", fake),
+		newTestRegistry(t, database, "b", fake),
+		newTestRegistry(t, database, "c", fake),
+	}
+
+	owners := make([]Owner, len(registries))
+	var wg sync.WaitGroup
+	for i, r := range registries {
+		wg.Add(1)
+		go func(i int, r *Registry) {
+			defer wg.Done()
+			owner, err := r.Owner(context.Background(), testWorldID)
+			assert.NoError(t, err)
+			owners[i] = owner
+		}(i, r)
+	}
+	wg.Wait()
+
+	for _, owner := range owners[1:] {
+		assert.Equal(t, owners[0], owner)
+	}
+}
+
+func TestRun_Heartbeats(t *testing.T) {
+	database := newFakeRegistryDB()
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	fake := clock.NewFake(start)
+	r := newTestRegistry(t, database, "a", fake)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		r.Run(ctx)
+		close(done)
+	}()
+
+	require.Eventually(t, func() bool { return fake.Waiters() == 1 }, time.Second, time.Millisecond)
+	fake.Advance(r.config.HeartbeatInterval)
+	require.Eventually(t, func() bool {
+		database.mu.Lock()
+		defer database.mu.Unlock()
+		return database.instances["a"].HeartbeatAt.Time.Equal(start.Add(r.config.HeartbeatInterval))
+	}, time.Second, time.Millisecond)
+
+	cancel()
+	<-done
+}
@@ -0,0 +1,213 @@
+// Package signup protects account registration from bots and throwaway accounts: a
+// per-IP signup rate limit, an optional CAPTCHA check, a blocklist of disposable email
+// domains, and limits on what accounts may do in their first hours.
+package signup
+
+import (
+	"bufio"
+	"context"
+	_ "embed"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/debugstats"
+)
+
+// Actions new accounts can be kept from taking
+const (
+	ActionFriendRequest = "friend_request"
+	ActionTrade         = "trade" // Reserved for player trading
+)
+
+var (
+	ErrRateLimited     = errors.New("too many signups from this address, try again later")
+	ErrCaptchaRequired = errors.New("captcha token is required")
+	ErrCaptchaFailed   = errors.New("captcha verification failed")
+	ErrBlockedEmail    = errors.New("email provider is not allowed")
+)
+
+//go:embed disposable_domains.txt
+var disposableDomains string
+
+// Config holds the signup protections. Zero values disable the limit they configure.
+type Config struct {
+	MaxPerIP             int           // Signups allowed from one IP address per RateWindow
+	RateWindow           time.Duration // Window MaxPerIP applies to
+	BlockDisposableEmail bool          // Reject the built-in list of disposable email providers
+	BlockedEmailDomains  []string      // Further domains to reject, e.g. ones seen in abuse
+	NewAccountPeriod     time.Duration // How long a new account is kept from NewAccountActions
+	NewAccountActions    []string      // Actions restricted during NewAccountPeriod
+}
+
+// DefaultConfig allows a household's worth of signups per hour and blocks disposable
+// email providers. New accounts are not restricted unless a period is configured.
+func DefaultConfig() Config {
+	return Config{
+		MaxPerIP:             5,
+		RateWindow:           time.Hour,
+		BlockDisposableEmail: true,
+		NewAccountActions:    []string{ActionFriendRequest, ActionTrade},
+	}
+}
+
+// CaptchaVerifier validates the CAPTCHA token a client solved before signing up
+type CaptchaVerifier interface {
+	Verify(ctx context.Context, token, remoteIP string) error
+}
+
+// Guard applies the signup protections. It is safe for concurrent use.
+type Guard struct {
+	config  Config
+	clock   clock.Clock
+	blocked map[string]bool
+	captcha CaptchaVerifier
+
+	mu        sync.Mutex
+	windows   map[string]*window // Signup attempts per IP in the current window
+	lastSweep time.Time
+}
+
+type window struct {
+	start    time.Time
+	attempts int
+}
+
+// NewGuard creates a guard enforcing config
+func NewGuard(config Config) *Guard {
+	g := &Guard{
+		config:  config,
+		clock:   clock.New(),
+		blocked: make(map[string]bool),
+		windows: make(map[string]*window),
+	}
+	if config.BlockDisposableEmail {
+		scanner := bufio.NewScanner(strings.NewReader(disposableDomains))
+		for scanner.Scan() {
+			if line := strings.TrimSpace(scanner.Text()); line != "" && !strings.HasPrefix(line, "#") {
+				g.blocked[strings.ToLower(line)] = true
+			}
+		}
+	}
+	for _, domain := range config.BlockedEmailDomains {
+		if domain = strings.ToLower(strings.TrimSpace(domain)); domain != "" {
+			g.blocked[domain] = true
+		}
+	}
+	debugstats.Register(debugstats.CacheEntries, "signup.rate_limited_ips", func() int64 {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		return int64(len(g.windows))
+	})
+	return g
+}
+
+// SetClock replaces the clock used for rate windows and account ages (for simulation tests)
+func (g *Guard) SetClock(c clock.Clock) {
+	g.mu.Lock()
+	g.clock = c
+	g.mu.Unlock()
+}
+
+// SetCaptchaVerifier requires every signup to carry a token the verifier accepts
+func (g *Guard) SetCaptchaVerifier(verifier CaptchaVerifier) {
+	g.captcha = verifier
+}
+
+// CheckSignup reports whether a signup from remoteIP for email may go ahead. Every call
+// counts against the IP's rate limit, so a bot cycling through rejected emails or bad
+// CAPTCHA tokens is throttled too.
+func (g *Guard) CheckSignup(ctx context.Context, remoteIP, email, captchaToken string) error {
+	if !g.allow(remoteIP) {
+		return ErrRateLimited
+	}
+	if g.captcha != nil {
+		if captchaToken == "" {
+			return ErrCaptchaRequired
+		}
+		if err := g.captcha.Verify(ctx, captchaToken, remoteIP); err != nil {
+			return errors.Join(ErrCaptchaFailed, err)
+		}
+	}
+	if g.BlockedEmail(email) {
+		return ErrBlockedEmail
+	}
+	return nil
+}
+
+// CheckFederatedSignup is CheckSignup for accounts created by logging in with an external
+// provider. The provider already proved a person controls the account, so no CAPTCHA is
+// asked for; the rate limit and email blocklist still apply.
+func (g *Guard) CheckFederatedSignup(remoteIP, email string) error {
+	if !g.allow(remoteIP) {
+		return ErrRateLimited
+	}
+	if g.BlockedEmail(email) {
+		return ErrBlockedEmail
+	}
+	return nil
+}
+
+// BlockedEmail reports whether the address belongs to a blocked domain or a subdomain of one
+func (g *Guard) BlockedEmail(email string) bool {
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return false
+	}
+	domain := strings.ToLower(strings.TrimSpace(email[at+1:]))
+	for domain != "" {
+		if g.blocked[domain] {
+			return true
+		}
+		dot := strings.Index(domain, ".")
+		if dot < 0 {
+			break
+		}
+		domain = domain[dot+1:]
+	}
+	return false
+}
+
+// NewAccountRestricted reports whether an account created at createdAt is still too new
+// to take action, and how long until it may
+func (g *Guard) NewAccountRestricted(action string, createdAt time.Time) (bool, time.Duration) {
+	if g.config.NewAccountPeriod <= 0 || !slices.Contains(g.config.NewAccountActions, action) {
+		return false, 0
+	}
+	g.mu.Lock()
+	now := g.clock.Now()
+	g.mu.Unlock()
+	remaining := createdAt.Add(g.config.NewAccountPeriod).Sub(now)
+	return remaining > 0, max(remaining, 0)
+}
+
+// allow counts a signup attempt from ip and reports whether it is within the limit
+func (g *Guard) allow(ip string) bool {
+	if g.config.MaxPerIP <= 0 || g.config.RateWindow <= 0 {
+		return true
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	now := g.clock.Now()
+
+	// Forget addresses whose window ended, at most once per window
+	if now.Sub(g.lastSweep) >= g.config.RateWindow {
+		for key, w := range g.windows {
+			if now.Sub(w.start) >= g.config.RateWindow {
+				delete(g.windows, key)
+			}
+		}
+		g.lastSweep = now
+	}
+
+	w, ok := g.windows[ip]
+	if !ok || now.Sub(w.start) >= g.config.RateWindow {
+		w = &window{start: now}
+		g.windows[ip] = w
+	}
+	w.attempts++
+	return w.attempts <= g.config.MaxPerIP
+}
@@ -0,0 +1,158 @@
+package signup
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestGuard(config Config) (*Guard, *clock.Fake) {
+	fake := clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	g := NewGuard(config)
+	g.SetClock(fake)
+	return g, fake
+}
+
+func TestCheckSignup_RateLimitsPerIP(t *testing.T) {
+	g, fake := newTestGuard(Config{MaxPerIP: 2, RateWindow: time.Hour})
+	ctx := context.Background()
+
+	assert.NoError(t, g.CheckSignup(ctx, "203.0.113.1", "a@example.com", ""))
+	assert.NoError(t, g.CheckSignup(ctx, "203.0.113.1", "b@example.com", ""))
+	assert.ErrorIs(t, g.CheckSignup(ctx, "203.0.113.1", "c@example.com", ""), ErrRateLimited)
+	assert.NoError(t, g.CheckSignup(ctx, "203.0.113.2", "d@example.com", ""), "other addresses have their own limit")
+
+	fake.Advance(time.Hour)
+	assert.NoError(t, g.CheckSignup(ctx, "203.0.113.1", "e@example.com", ""), "the limit resets with the window")
+}
+
+func TestCheckSignup_RejectedAttemptsCount(t *testing.T) {
+	g, _ := newTestGuard(Config{MaxPerIP: 2, RateWindow: time.Hour, BlockDisposableEmail: true})
+	ctx := context.Background()
+
+	assert.ErrorIs(t, g.CheckSignup(ctx, "203.0.113.1", "a@mailinator.com", ""), ErrBlockedEmail)
+	assert.ErrorIs(t, g.CheckSignup(ctx, "203.0.113.1", "b@mailinator.com", ""), ErrBlockedEmail)
+	assert.ErrorIs(t, g.CheckSignup(ctx, "203.0.113.1", "c@example.com", ""), ErrRateLimited)
+}
+
+func TestCheckSignup_ForgetsExpiredWindows(t *testing.T) {
+	g, fake := newTestGuard(Config{MaxPerIP: 1, RateWindow: time.Minute})
+	ctx := context.Background()
+
+	for _, ip := range []string{"203.0.113.1", "203.0.113.2", "203.0.113.3"} {
+		require.NoError(t, g.CheckSignup(ctx, ip, "a@example.com", ""))
+	}
+	fake.Advance(time.Minute)
+	require.NoError(t, g.CheckSignup(ctx, "203.0.113.4", "a@example.com", ""))
+
+	assert.Len(t, g.windows, 1)
+}
+
+func TestBlockedEmail(t *testing.T) {
+	g := NewGuard(Config{BlockDisposableEmail: true, BlockedEmailDomains: []string{" Spam.Example "}})
+
+	tests := []struct {
+		email   string
+		blocked bool
+	}{
+		{"player@example.com", false},
+		{"player@mailinator.com", true},
+		{"player@MAILINATOR.COM", true},
+		{"player@eu.mailinator.com", true},
+		{"player@notmailinator.com", false},
+		{"player@spam.example", true},
+		{"player@mail.spam.example", true},
+		{"not-an-email", false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.blocked, g.BlockedEmail(tt.email), tt.email)
+	}
+}
+
+func TestBlockedEmail_DisposableListOptional(t *testing.T) {
+	g := NewGuard(Config{})
+	assert.False(t, g.BlockedEmail("player@mailinator.com"))
+}
+
+type stubVerifier struct {
+	err    error
+	tokens []string
+}
+
+func (s *stubVerifier) Verify(ctx context.Context, token, remoteIP string) error {
+	s.tokens = append(s.tokens, token)
+	return s.err
+}
+
+func TestCheckSignup_Captcha(t *testing.T) {
+	ctx := context.Background()
+
+	g := NewGuard(Config{})
+	assert.NoError(t, g.CheckSignup(ctx, "203.0.113.1", "a@example.com", ""), "no verifier, no captcha")
+
+	verifier := &stubVerifier{}
+	g.SetCaptchaVerifier(verifier)
+	assert.ErrorIs(t, g.CheckSignup(ctx, "203.0.113.1", "a@example.com", ""), ErrCaptchaRequired)
+	assert.NoError(t, g.CheckSignup(ctx, "203.0.113.1", "a@example.com", "solved"))
+	assert.Equal(t, []string{"solved"}, verifier.tokens)
+
+	verifier.err = errors.New("expired token")
+	err := g.CheckSignup(ctx, "203.0.113.1", "a@example.com", "stale")
+	assert.ErrorIs(t, err, ErrCaptchaFailed)
+	assert.Contains(t, err.Error(), "expired token")
+}
+
+func TestCheckFederatedSignup(t *testing.T) {
+	g, _ := newTestGuard(Config{MaxPerIP: 2, RateWindow: time.Hour, BlockDisposableEmail: true})
+	verifier := &stubVerifier{}
+	g.SetCaptchaVerifier(verifier)
+
+	assert.NoError(t, g.CheckFederatedSignup("203.0.113.1", "a@example.com"))
+	assert.Empty(t, verifier.tokens, "the provider stands in for the captcha")
+	assert.ErrorIs(t, g.CheckFederatedSignup("203.0.113.1", "b@mailinator.com"), ErrBlockedEmail)
+	assert.ErrorIs(t, g.CheckSignup(context.Background(), "203.0.113.1", "c@example.com", "solved"), ErrRateLimited, "both kinds of signup share the limit")
+}
+
+func TestNewAccountRestricted(t *testing.T) {
+	g, fake := newTestGuard(Config{NewAccountPeriod: 24 * time.Hour, NewAccountActions: []string{ActionTrade}})
+	createdAt := fake.Now().Add(-time.Hour)
+
+	restricted, remaining := g.NewAccountRestricted(ActionTrade, createdAt)
+	assert.True(t, restricted)
+	assert.Equal(t, 23*time.Hour, remaining)
+
+	restricted, _ = g.NewAccountRestricted(ActionFriendRequest, createdAt)
+	assert.False(t, restricted, "only the configured actions are restricted")
+
+	fake.Advance(23 * time.Hour)
+	restricted, remaining = g.NewAccountRestricted(ActionTrade, createdAt)
+	assert.False(t, restricted)
+	assert.Zero(t, remaining)
+}
+
+func TestSiteVerifier(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "site-secret", r.PostForm.Get("secret"))
+		assert.Equal(t, "203.0.113.1", r.PostForm.Get("remoteip"))
+		if r.PostForm.Get("response") == "good" {
+			_, _ = w.Write([]byte(`{"success": true}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"success": false, "error-codes": ["invalid-input-response"]}`))
+	}))
+	defer server.Close()
+
+	verifier := NewSiteVerifier(server.URL, "site-secret")
+	assert.NoError(t, verifier.Verify(context.Background(), "good", "203.0.113.1"))
+	err := verifier.Verify(context.Background(), "bad", "203.0.113.1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid-input-response")
+}
@@ -0,0 +1,265 @@
+// Package soak drives load against in-process components for a long time while sampling
+// goroutines, heap and the debugstats gauges, and reports the metrics that kept growing.
+// Leaks in streaming subscriptions and caches only show after hours of churn, long after
+// a unit test has finished, so soak runs are opt-in: tests/soak runs them when
+// SOAK_DURATION is set.
+package soak
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/debugstats"
+)
+
+// Metric names of the process-wide samples; gauges are named "<kind>/<gauge>"
+const (
+	MetricGoroutines = "goroutines"
+	MetricHeapBytes  = "heap_bytes"
+)
+
+// sampledKinds are the debugstats gauges that should level off under steady load
+var sampledKinds = []debugstats.Kind{debugstats.StreamSubscriptions, debugstats.CacheEntries, debugstats.Queues}
+
+// Config sets how long load runs and how growth is judged
+type Config struct {
+	Duration       time.Duration // Total run time, including the warm-up
+	Warmup         time.Duration // Samples before this are ignored while caches fill and pools grow
+	SampleInterval time.Duration
+	Workers        int    // Goroutines calling the load function
+	Windows        int    // Samples after the warm-up are split into this many windows
+	ProfileDir     string // Heap profiles after the warm-up and at the end go here; empty writes none
+
+	// A metric leaks when the lowest value of every window is above the previous one and
+	// the last window's lowest is at least this much above the first's
+	GoroutineSlack int64
+	HeapSlack      int64
+	GaugeSlack     int64
+}
+
+// DefaultConfig is a five minute run sampling every five seconds
+func DefaultConfig() Config {
+	return Config{
+		Duration:       5 * time.Minute,
+		Warmup:         30 * time.Second,
+		SampleInterval: 5 * time.Second,
+		Workers:        8,
+		Windows:        4,
+		GoroutineSlack: 10,
+		HeapSlack:      8 << 20,
+		GaugeSlack:     10,
+	}
+}
+
+// ConfigFromEnv returns the default config with SOAK_DURATION and SOAK_PROFILE_DIR
+// applied, and whether SOAK_DURATION asked for a soak run at all. The warm-up and
+// sample interval scale with the duration so short runs still have enough samples.
+func ConfigFromEnv() (Config, bool, error) {
+	config := DefaultConfig()
+	value := os.Getenv("SOAK_DURATION")
+	if value == "" {
+		return config, false, nil
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration <= 0 {
+		return config, false, fmt.Errorf("SOAK_DURATION: invalid duration %q", value)
+	}
+	config.Duration = duration
+	config.Warmup = min(config.Warmup, duration/10)
+	config.SampleInterval = min(config.SampleInterval, duration/100)
+	config.ProfileDir = os.Getenv("SOAK_PROFILE_DIR")
+	return config, true, nil
+}
+
+// Sample is the process state at one point of the run
+type Sample struct {
+	Elapsed time.Duration
+	Metrics map[string]int64
+}
+
+// Leak is a metric that grew through the whole run
+type Leak struct {
+	Metric string
+	First  int64 // Lowest value in the first window after the warm-up
+	Last   int64 // Lowest value in the last window
+}
+
+func (l Leak) String() string {
+	return fmt.Sprintf("%s grew from %d to %d", l.Metric, l.First, l.Last)
+}
+
+// Report is the outcome of a run
+type Report struct {
+	Samples    []Sample
+	Operations int64 // Calls of the load function
+	Errors     int64 // Calls that returned an error
+	FirstError error
+	Leaks      []Leak
+}
+
+// Run calls load from config.Workers goroutines until config.Duration has passed or ctx
+// ends, sampling the process every config.SampleInterval. The worker index lets load
+// give each worker its own users or areas. Errors from load are counted, not fatal.
+func Run(ctx context.Context, config Config, load func(ctx context.Context, worker int) error) (*Report, error) {
+	if config.Workers <= 0 || config.SampleInterval <= 0 || config.Windows < 2 {
+		return nil, errors.New("soak needs workers, a sample interval and at least two windows")
+	}
+	ctx, cancel := context.WithTimeout(ctx, config.Duration)
+	defer cancel()
+
+	report := &Report{}
+	var (
+		operations atomic.Int64
+		failures   atomic.Int64
+		errOnce    sync.Once
+		wg         sync.WaitGroup
+	)
+	for worker := 0; worker < config.Workers; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ctx.Err() == nil {
+				operations.Add(1)
+				if err := load(ctx, worker); err != nil && ctx.Err() == nil {
+					failures.Add(1)
+					errOnce.Do(func() { report.FirstError = err })
+				}
+			}
+		}()
+	}
+
+	start := time.Now()
+	ticker := time.NewTicker(config.SampleInterval)
+	warm := false
+	var err error
+sampling:
+	for {
+		select {
+		case <-ctx.Done():
+			break sampling
+		case <-ticker.C:
+		}
+		elapsed := time.Since(start)
+		if elapsed < config.Warmup {
+			continue
+		}
+		if !warm {
+			warm = true
+			err = errors.Join(err, writeHeapProfile(config.ProfileDir, "heap-warm.pprof"))
+		}
+		report.Samples = append(report.Samples, Sample{Elapsed: elapsed, Metrics: sample()})
+	}
+	ticker.Stop()
+	wg.Wait()
+
+	err = errors.Join(err, writeHeapProfile(config.ProfileDir, "heap-end.pprof"))
+	report.Operations = operations.Load()
+	report.Errors = failures.Load()
+	report.Leaks = detectLeaks(config, report.Samples)
+	return report, err
+}
+
+// sample collects the process metrics after a GC, so only live heap is counted
+func sample() map[string]int64 {
+	runtime.GC()
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+	metrics := map[string]int64{
+		MetricGoroutines: int64(runtime.NumGoroutine()),
+		MetricHeapBytes:  int64(mem.HeapInuse),
+	}
+	for _, kind := range sampledKinds {
+		for name, value := range debugstats.Snapshot(kind) {
+			metrics[string(kind)+"/"+name] = value
+		}
+	}
+	return metrics
+}
+
+// writeHeapProfile writes a heap profile for comparing with go tool pprof -base
+func writeHeapProfile(dir, name string) error {
+	if dir == "" {
+		return nil
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create profile directory: %w", err)
+	}
+	file, err := os.Create(filepath.Join(dir, name))
+	if err != nil {
+		return fmt.Errorf("failed to create heap profile: %w", err)
+	}
+	defer file.Close()
+	if err := pprof.WriteHeapProfile(file); err != nil {
+		return fmt.Errorf("failed to write heap profile: %w", err)
+	}
+	return nil
+}
+
+// detectLeaks checks every sampled metric for growth through all windows
+func detectLeaks(config Config, samples []Sample) []Leak {
+	names := make(map[string]bool)
+	for _, s := range samples {
+		for name := range s.Metrics {
+			names[name] = true
+		}
+	}
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+
+	var leaks []Leak
+	for _, name := range sorted {
+		values := make([]int64, len(samples))
+		for i, s := range samples {
+			values[i] = s.Metrics[name]
+		}
+		slack := config.GaugeSlack
+		switch name {
+		case MetricGoroutines:
+			slack = config.GoroutineSlack
+		case MetricHeapBytes:
+			slack = config.HeapSlack
+		}
+		if first, last, ok := Growing(values, config.Windows, slack); ok {
+			leaks = append(leaks, Leak{Metric: name, First: first, Last: last})
+		}
+	}
+	return leaks
+}
+
+// Growing splits values into windows and reports whether the lowest value of each window
+// is above the previous window's, with the last at least slack above the first. Taking
+// each window's lowest value ignores bursts of in-flight work; a leak raises the floor.
+func Growing(values []int64, windows int, slack int64) (first, last int64, growing bool) {
+	if windows < 2 || len(values) < windows {
+		return 0, 0, false
+	}
+	size := len(values) / windows
+	floors := make([]int64, windows)
+	for w := range floors {
+		end := (w + 1) * size
+		if w == windows-1 {
+			end = len(values)
+		}
+		floors[w] = values[w*size]
+		for _, v := range values[w*size : end] {
+			floors[w] = min(floors[w], v)
+		}
+		if w > 0 && floors[w] <= floors[w-1] {
+			return floors[0], floors[w], false
+		}
+	}
+	first, last = floors[0], floors[windows-1]
+	return first, last, last-first >= slack
+}
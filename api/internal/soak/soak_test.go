@@ -0,0 +1,139 @@
+package soak
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/debugstats"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrowing(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  []int64
+		growing bool
+	}{
+		{"steady", []int64{50, 52, 50, 51, 50, 53, 50, 52}, false},
+		{"bursts above a steady floor", []int64{50, 90, 50, 120, 50, 80, 50, 150}, false},
+		{"floor rises every window", []int64{50, 55, 62, 61, 70, 75, 81, 80}, true},
+		{"grows then levels off", []int64{50, 60, 70, 80, 80, 80, 80, 80}, false},
+		{"rises less than the slack", []int64{50, 50, 51, 51, 52, 52, 53, 53}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, growing := Growing(tt.values, 4, 10)
+			assert.Equal(t, tt.growing, growing)
+		})
+	}
+
+	first, last, growing := Growing([]int64{10, 20, 30}, 4, 1)
+	assert.False(t, growing, "too few samples to judge")
+	assert.Zero(t, first+last)
+}
+
+func shortConfig(t *testing.T) Config {
+	config := DefaultConfig()
+	config.Duration = 400 * time.Millisecond
+	config.Warmup = 0
+	config.SampleInterval = 10 * time.Millisecond
+	config.Workers = 2
+	config.ProfileDir = t.TempDir()
+	return config
+}
+
+func TestRun_DetectsLeaks(t *testing.T) {
+	debugstats.Reset()
+	t.Cleanup(debugstats.Reset)
+
+	// Every call parks a goroutine and adds a cache entry that is never removed
+	release := make(chan struct{})
+	t.Cleanup(func() { close(release) })
+	var entries atomic.Int64
+	debugstats.Register(debugstats.CacheEntries, "test.entries", entries.Load)
+
+	report, err := Run(context.Background(), shortConfig(t), func(ctx context.Context, worker int) error {
+		go func() { <-release }()
+		entries.Add(1)
+		time.Sleep(time.Millisecond)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Greater(t, report.Operations, int64(100))
+
+	leaked := make(map[string]bool)
+	for _, leak := range report.Leaks {
+		leaked[leak.Metric] = true
+	}
+	assert.True(t, leaked[MetricGoroutines], "leaks: %v", report.Leaks)
+	assert.True(t, leaked["cache_entries/test.entries"], "leaks: %v", report.Leaks)
+}
+
+func TestRun_SteadyLoad(t *testing.T) {
+	debugstats.Reset()
+	t.Cleanup(debugstats.Reset)
+
+	var open atomic.Int64
+	debugstats.Register(debugstats.StreamSubscriptions, "test.streams", open.Load)
+
+	config := shortConfig(t)
+	boom := errors.New("boom")
+	calls := atomic.Int64{}
+	report, err := Run(context.Background(), config, func(ctx context.Context, worker int) error {
+		// A stream opens, is served by a goroutine and closes again
+		open.Add(1)
+		done := make(chan struct{})
+		go func() { close(done) }()
+		<-done
+		open.Add(-1)
+		time.Sleep(time.Millisecond)
+		if calls.Add(1)%50 == 0 {
+			return boom
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Empty(t, report.Leaks)
+	assert.NotEmpty(t, report.Samples)
+	assert.Positive(t, report.Errors)
+	assert.ErrorIs(t, report.FirstError, boom)
+
+	for _, name := range []string{"heap-warm.pprof", "heap-end.pprof"} {
+		info, err := os.Stat(filepath.Join(config.ProfileDir, name))
+		require.NoError(t, err)
+		assert.Positive(t, info.Size())
+	}
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Setenv("SOAK_DURATION", "")
+	_, enabled, err := ConfigFromEnv()
+	require.NoError(t, err)
+	assert.False(t, enabled)
+
+	t.Setenv("SOAK_DURATION", "2h")
+	t.Setenv("SOAK_PROFILE_DIR", "/tmp/soak")
+	config, enabled, err := ConfigFromEnv()
+	require.NoError(t, err)
+	assert.True(t, enabled)
+	assert.Equal(t, 2*time.Hour, config.Duration)
+	assert.Equal(t, 30*time.Second, config.Warmup)
+	assert.Equal(t, 5*time.Second, config.SampleInterval)
+	assert.Equal(t, "/tmp/soak", config.ProfileDir)
+
+	t.Setenv("SOAK_DURATION", "10s")
+	config, _, err = ConfigFromEnv()
+	require.NoError(t, err)
+	assert.Equal(t, time.Second, config.Warmup, "short runs warm up for a tenth of the run")
+	assert.Equal(t, 100*time.Millisecond, config.SampleInterval)
+
+	t.Setenv("SOAK_DURATION", "forever")
+	_, _, err = ConfigFromEnv()
+	assert.ErrorContains(t, err, "SOAK_DURATION")
+}
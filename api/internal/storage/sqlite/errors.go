@@ -0,0 +1,48 @@
+package sqlite
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+// pgError converts SQLite errors callers branch on into the *pgconn.PgError Postgres
+// would have returned: constraint violations and missing tables keep their SQLSTATE, and
+// a database busy with another writer is a serialization failure, so txn.Run retries it
+func pgError(err error) error {
+	var serr *sqlite.Error
+	if !errors.As(err, &serr) {
+		return err
+	}
+
+	pgErr := &pgconn.PgError{Severity: "ERROR", Message: serr.Error()}
+	switch serr.Code() {
+	case sqlite3.SQLITE_CONSTRAINT_UNIQUE, sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY:
+		pgErr.Code = "23505"
+		pgErr.Message = "duplicate key value violates unique constraint"
+		pgErr.Detail = serr.Error()
+	case sqlite3.SQLITE_CONSTRAINT_FOREIGNKEY:
+		pgErr.Code = "23503"
+	case sqlite3.SQLITE_CONSTRAINT_CHECK:
+		pgErr.Code = "23514"
+	case sqlite3.SQLITE_CONSTRAINT_NOTNULL:
+		pgErr.Code = "23502"
+	default:
+		switch serr.Code() & 0xff {
+		case sqlite3.SQLITE_BUSY, sqlite3.SQLITE_LOCKED:
+			pgErr.Code = "40001"
+		case sqlite3.SQLITE_ERROR:
+			// SQLite reports every statement it cannot compile as SQLITE_ERROR
+			if !strings.Contains(serr.Error(), "no such table") {
+				return err
+			}
+			pgErr.Code = "42P01"
+		default:
+			return err
+		}
+	}
+	return pgErr
+}
@@ -0,0 +1,159 @@
+package sqlite
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"modernc.org/sqlite"
+)
+
+// The Postgres functions the schema and queries call. They are registered once for
+// every connection the driver opens.
+func init() {
+	sqlite.MustRegisterScalarFunction("now", 0, func(*sqlite.FunctionContext, []driver.Value) (driver.Value, error) {
+		return formatTime(time.Now()), nil
+	})
+	sqlite.MustRegisterScalarFunction("gen_random_uuid", 0, func(*sqlite.FunctionContext, []driver.Value) (driver.Value, error) {
+		return uuid.NewString(), nil
+	})
+	sqlite.MustRegisterDeterministicScalarFunction("greatest", -1, func(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+		return extreme(args, 1), nil
+	})
+	sqlite.MustRegisterDeterministicScalarFunction("least", -1, func(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+		return extreme(args, -1), nil
+	})
+	sqlite.MustRegisterDeterministicScalarFunction("date_trunc", 2, dateTrunc)
+	sqlite.MustRegisterDeterministicScalarFunction("timestamp_shift", 3, timestampShift)
+	sqlite.MustRegisterDeterministicScalarFunction("set_bit", 3, setBit)
+}
+
+// extreme is GREATEST (sign 1) or LEAST (sign -1), which unlike SQLite's max and min
+// ignore NULL arguments
+func extreme(args []driver.Value, sign int) driver.Value {
+	var best driver.Value
+	for _, arg := range args {
+		if arg == nil {
+			continue
+		}
+		if best == nil || compare(arg, best)*sign > 0 {
+			best = arg
+		}
+	}
+	return best
+}
+
+func compare(a, b driver.Value) int {
+	af, aNum := number(a)
+	bf, bNum := number(b)
+	switch {
+	case aNum && bNum:
+		return cmpFloat(af, bf)
+	case aNum:
+		return -1 // SQLite orders numbers before text
+	case bNum:
+		return 1
+	}
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
+
+func number(v driver.Value) (float64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
+
+func cmpFloat(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// dateTrunc is date_trunc(unit, timestamp) for the units the queries use
+func dateTrunc(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+	if args[0] == nil || args[1] == nil {
+		return nil, nil
+	}
+	t, err := timeArg(args[1])
+	if err != nil {
+		return nil, err
+	}
+	switch strings.ToLower(fmt.Sprint(args[0])) {
+	case "second":
+		t = t.Truncate(time.Second)
+	case "minute":
+		t = t.Truncate(time.Minute)
+	case "hour":
+		t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, time.UTC)
+	case "day":
+		t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	case "week":
+		// Postgres weeks start on Monday
+		t = time.Date(t.Year(), t.Month(), t.Day()-(int(t.Weekday())+6)%7, 0, 0, 0, 0, time.UTC)
+	case "month":
+		t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	case "year":
+		t = time.Date(t.Year(), 1, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return nil, fmt.Errorf("date_trunc: unsupported unit %q", args[0])
+	}
+	return formatTime(t), nil
+}
+
+// timestampShift is ts + (to - from), the interval arithmetic Postgres does on timestamps
+func timestampShift(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+	times := make([]time.Time, len(args))
+	for i, arg := range args {
+		if arg == nil {
+			return nil, nil
+		}
+		t, err := timeArg(arg)
+		if err != nil {
+			return nil, err
+		}
+		times[i] = t
+	}
+	return formatTime(times[0].Add(times[2].Sub(times[1]))), nil
+}
+
+// setBit is set_bit(bytes, n, bit) on bytea: bit n is bit n%8 of byte n/8, counted from
+// the least significant bit
+func setBit(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+	b, ok := args[0].([]byte)
+	if !ok {
+		return nil, fmt.Errorf("set_bit: %T is not a bytea", args[0])
+	}
+	n, ok := args[1].(int64)
+	if !ok || n < 0 || n >= int64(len(b))*8 {
+		return nil, fmt.Errorf("set_bit: index %v out of valid range", args[1])
+	}
+	out := append([]byte(nil), b...)
+	if v, _ := args[2].(int64); v != 0 {
+		out[n/8] |= 1 << (n % 8)
+	} else {
+		out[n/8] &^= 1 << (n % 8)
+	}
+	return out, nil
+}
+
+func timeArg(v driver.Value) (time.Time, error) {
+	switch t := v.(type) {
+	case string:
+		return parseTime(t)
+	case []byte:
+		return parseTime(string(t))
+	case time.Time:
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("sqlite: %T is not a timestamp", v)
+}
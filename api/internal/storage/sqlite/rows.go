@@ -0,0 +1,125 @@
+package sqlite
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// rows is a pgx.Rows over a result read in full when the query ran, so no connection
+// is held while the caller iterates and runs other statements
+type rows struct {
+	columns []string
+	values  [][]any
+	pos     int // 1-based index of the current row
+	tag     pgconn.CommandTag
+	err     error
+	closed  bool
+}
+
+func readAll(r *sql.Rows) (*rows, error) {
+	defer r.Close()
+
+	columns, err := r.Columns()
+	if err != nil {
+		return nil, err
+	}
+	result := &rows{columns: columns}
+	for r.Next() {
+		values := make([]any, len(columns))
+		dest := make([]any, len(columns))
+		for i := range values {
+			dest[i] = &values[i]
+		}
+		if err := r.Scan(dest...); err != nil {
+			return nil, err
+		}
+		result.values = append(result.values, values)
+	}
+	if err := r.Err(); err != nil {
+		return nil, pgError(err)
+	}
+	return result, nil
+}
+
+func (r *rows) Close() {
+	r.closed = true
+}
+
+func (r *rows) Err() error {
+	return r.err
+}
+
+func (r *rows) CommandTag() pgconn.CommandTag {
+	return r.tag
+}
+
+func (r *rows) FieldDescriptions() []pgconn.FieldDescription {
+	fields := make([]pgconn.FieldDescription, len(r.columns))
+	for i, name := range r.columns {
+		fields[i] = pgconn.FieldDescription{Name: name}
+	}
+	return fields
+}
+
+func (r *rows) Next() bool {
+	if r.closed || r.err != nil || r.pos >= len(r.values) {
+		r.closed = true
+		return false
+	}
+	r.pos++
+	return true
+}
+
+func (r *rows) Scan(dest ...any) error {
+	if r.pos == 0 || r.pos > len(r.values) {
+		return fmt.Errorf("sqlite: Scan called without a current row")
+	}
+	values := r.values[r.pos-1]
+	if len(dest) != len(values) {
+		r.err = fmt.Errorf("sqlite: %d destinations for %d columns", len(dest), len(values))
+		return r.err
+	}
+	for i, d := range dest {
+		if err := scanValue(d, values[i]); err != nil {
+			r.err = fmt.Errorf("can't scan into dest[%d] (%s): %w", i, r.columns[i], err)
+			return r.err
+		}
+	}
+	return nil
+}
+
+func (r *rows) Values() ([]any, error) {
+	if r.pos == 0 || r.pos > len(r.values) {
+		return nil, fmt.Errorf("sqlite: Values called without a current row")
+	}
+	return append([]any(nil), r.values[r.pos-1]...), nil
+}
+
+// RawValues is nil: SQLite values have no Postgres wire encoding
+func (r *rows) RawValues() [][]byte {
+	return nil
+}
+
+func (r *rows) Conn() *pgx.Conn {
+	return nil
+}
+
+// row is a pgx.Row over the first row of a result
+type row struct {
+	rows *rows
+	err  error
+}
+
+func (r *row) Scan(dest ...any) error {
+	if r.err != nil {
+		return r.err
+	}
+	defer r.rows.Close()
+	if !r.rows.Next() {
+		return pgx.ErrNoRows
+	}
+	return r.rows.Scan(dest...)
+}
@@ -0,0 +1,50 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+)
+
+// schemaRewrites map the column types and defaults of db/migrations/schema.sql to
+// SQLite. Values keep the representation bindValue writes: UUIDs and timestamps as
+// text, arrays as JSON text, booleans as 0 and 1.
+var schemaRewrites = []struct {
+	re   *regexp.Regexp
+	with string
+}{
+	{regexp.MustCompile(`(?i)\b(BIG)?SERIAL\s+PRIMARY\s+KEY`), "INTEGER PRIMARY KEY AUTOINCREMENT"},
+	{regexp.MustCompile(`(?i)\b\w+\[\]`), "TEXT"},
+	{regexp.MustCompile(`(?i)DEFAULT\s+'\{\}'`), "DEFAULT '[]'"},
+	{regexp.MustCompile(`(?i)\b(jsonb|uuid|timestamp)\b`), "TEXT"},
+	{regexp.MustCompile(`(?i)\bbytea\b`), "BLOB"},
+	// Single-row tables are keyed by a boolean that defaults to true. An INTEGER PRIMARY
+	// KEY would alias the rowid, which ignores the default, so every upsert would add a row
+	{regexp.MustCompile(`(?i)\bboolean\s+PRIMARY\s+KEY`), "INT PRIMARY KEY"},
+	{regexp.MustCompile(`(?i)\bboolean\b`), "INTEGER"},
+	{regexp.MustCompile(`(?i)\b(double\s+precision|decimal\s*\(\s*\d+\s*,\s*\d+\s*\))`), "REAL"},
+	// SQLite only accepts function calls as defaults in parentheses
+	{regexp.MustCompile(`(?i)DEFAULT\s+(\w+)\s*\(\s*\)`), "DEFAULT ($1())"},
+	// random() is a float in [0, 1) in Postgres and a 64-bit integer in SQLite
+	{regexp.MustCompile(`(?i)\brandom\(\)`), "(abs(random()) / 9223372036854775808.0)"},
+}
+
+// TranslateSchema rewrites the Postgres schema for SQLite
+func TranslateSchema(schema string) string {
+	for _, r := range schemaRewrites {
+		schema = r.re.ReplaceAllString(schema, r.with)
+	}
+	return rewriteCasts(schema)
+}
+
+// ApplySchema creates the tables of a Postgres schema in one transaction, so a failure
+// leaves the database empty
+func (d *DB) ApplySchema(ctx context.Context, schema string) error {
+	return d.atomically(ctx, func(t *sql.Tx) error {
+		if _, err := t.ExecContext(ctx, TranslateSchema(schema)); err != nil {
+			return fmt.Errorf("failed to apply schema: %w", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,241 @@
+// Package sqlite runs the server on a single-file SQLite database for self-hosting and
+// local worlds. DB implements storage.Pool, so services and the generated queries in db
+// run unchanged: each query is translated from Postgres to SQLite the first time it
+// runs (see translate.go), arguments are bound the way pgx would encode them, and
+// results are scanned back into the pgtype values the generated code expects.
+//
+// SQLite allows one writer at a time, so every transaction begins IMMEDIATE and holds
+// the write lock until it ends. That is stronger than any isolation level the services
+// ask for, which is why row locks (FOR UPDATE, SKIP LOCKED) are simply dropped. A
+// writer that waits longer than the busy timeout fails with SQLSTATE 40001, and
+// txn.Run retries it like a Postgres serialization failure.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	_ "modernc.org/sqlite" // Registers the "sqlite" database/sql driver
+)
+
+// busyTimeoutMS is how long a writer waits for the write lock before failing
+const busyTimeoutMS = 5000
+
+// DB is a SQLite database file
+type DB struct {
+	db *sql.DB
+}
+
+// Open opens or creates the database file at path with foreign keys enforced and
+// write-ahead logging, so readers never wait on the writer
+func Open(ctx context.Context, path string) (*DB, error) {
+	params := url.Values{
+		"_pragma": {
+			"foreign_keys(1)",
+			"journal_mode(WAL)",
+			fmt.Sprintf("busy_timeout(%d)", busyTimeoutMS),
+		},
+		"_txlock": {"immediate"},
+	}
+	sqlDB, err := sql.Open("sqlite", "file:"+path+"?"+params.Encode())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	if err := sqlDB.PingContext(ctx); err != nil {
+		sqlDB.Close()
+		return nil, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	return &DB{db: sqlDB}, nil
+}
+
+// Exec runs a statement and returns the command tag Postgres would have
+func (d *DB) Exec(ctx context.Context, query string, args ...any) (pgconn.CommandTag, error) {
+	if len(translate(query).after) == 0 {
+		return execute(ctx, d.db, query, args)
+	}
+	var tag pgconn.CommandTag
+	err := d.atomically(ctx, func(t *sql.Tx) (err error) {
+		tag, err = execute(ctx, t, query, args)
+		return err
+	})
+	return tag, err
+}
+
+// Query runs a query and reads all of its rows
+func (d *DB) Query(ctx context.Context, query string, args ...any) (pgx.Rows, error) {
+	r, err := d.query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// QueryRow runs a query that returns at most one row; Scan returns pgx.ErrNoRows if it
+// returned none
+func (d *DB) QueryRow(ctx context.Context, query string, args ...any) pgx.Row {
+	r, err := d.query(ctx, query, args)
+	return &row{rows: r, err: err}
+}
+
+func (d *DB) query(ctx context.Context, query string, args []any) (*rows, error) {
+	if len(translate(query).after) == 0 {
+		return read(ctx, d.db, query, args)
+	}
+	var r *rows
+	err := d.atomically(ctx, func(t *sql.Tx) (err error) {
+		r, err = read(ctx, t, query, args)
+		return err
+	})
+	return r, err
+}
+
+// CopyFrom inserts the rows one statement at a time in a single transaction
+func (d *DB) CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
+	var n int64
+	err := d.atomically(ctx, func(t *sql.Tx) (err error) {
+		n, err = copyFrom(ctx, t, tableName, columnNames, rowSrc)
+		return err
+	})
+	return n, err
+}
+
+// Begin starts a transaction that holds the write lock until it ends
+func (d *DB) Begin(ctx context.Context) (pgx.Tx, error) {
+	return d.BeginTx(ctx, pgx.TxOptions{})
+}
+
+// BeginTx starts a transaction. Isolation levels are ignored: SQLite transactions are
+// serializable, since only one of them writes at a time.
+func (d *DB) BeginTx(ctx context.Context, _ pgx.TxOptions) (pgx.Tx, error) {
+	t, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, pgError(err)
+	}
+	return &tx{tx: t}, nil
+}
+
+// Ping checks the database file can be read
+func (d *DB) Ping(ctx context.Context) error {
+	return d.db.PingContext(ctx)
+}
+
+// Close closes the database
+func (d *DB) Close() {
+	d.db.Close()
+}
+
+func (d *DB) atomically(ctx context.Context, fn func(t *sql.Tx) error) error {
+	t, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return pgError(err)
+	}
+	defer t.Rollback()
+
+	if err := fn(t); err != nil {
+		return err
+	}
+	return pgError(t.Commit())
+}
+
+// querier is what *sql.DB and *sql.Tx have in common
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+func execute(ctx context.Context, q querier, query string, args []any) (pgconn.CommandTag, error) {
+	st := translate(query)
+	values, err := bindArgs(args)
+	if err != nil {
+		return pgconn.CommandTag{}, err
+	}
+
+	res, err := q.ExecContext(ctx, st.sql, values...)
+	if err != nil {
+		return pgconn.CommandTag{}, pgError(err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return pgconn.CommandTag{}, err
+	}
+	if err := runAfter(ctx, q, st, values); err != nil {
+		return pgconn.CommandTag{}, err
+	}
+	return commandTag(st.verb, n), nil
+}
+
+func read(ctx context.Context, q querier, query string, args []any) (*rows, error) {
+	st := translate(query)
+	values, err := bindArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	sqlRows, err := q.QueryContext(ctx, st.sql, values...)
+	if err != nil {
+		return nil, pgError(err)
+	}
+	r, err := readAll(sqlRows)
+	if err != nil {
+		return nil, err
+	}
+	if err := runAfter(ctx, q, st, values); err != nil {
+		return nil, err
+	}
+	r.tag = commandTag(st.verb, int64(len(r.values)))
+	return r, nil
+}
+
+func runAfter(ctx context.Context, q querier, st statement, values []any) error {
+	for _, after := range st.after {
+		if _, err := q.ExecContext(ctx, after, values...); err != nil {
+			return pgError(err)
+		}
+	}
+	return nil
+}
+
+func copyFrom(ctx context.Context, q querier, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
+	columns := make([]string, len(columnNames))
+	placeholders := make([]string, len(columnNames))
+	for i, name := range columnNames {
+		columns[i] = pgx.Identifier{name}.Sanitize()
+		placeholders[i] = fmt.Sprintf("?%d", i+1)
+	}
+	insert := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)",
+		tableName.Sanitize(), strings.Join(columns, ", "), strings.Join(placeholders, ", "))
+
+	var n int64
+	for rowSrc.Next() {
+		row, err := rowSrc.Values()
+		if err != nil {
+			return n, err
+		}
+		values, err := bindArgs(row)
+		if err != nil {
+			return n, err
+		}
+		if _, err := q.ExecContext(ctx, insert, values...); err != nil {
+			return n, pgError(err)
+		}
+		n++
+	}
+	return n, rowSrc.Err()
+}
+
+// commandTag builds the tag Postgres returns for a statement, which callers read
+// RowsAffected from
+func commandTag(verb string, n int64) pgconn.CommandTag {
+	if verb == "INSERT" {
+		return pgconn.NewCommandTag(fmt.Sprintf("INSERT 0 %d", n))
+	}
+	if verb == "WITH" {
+		verb = "SELECT"
+	}
+	return pgconn.NewCommandTag(fmt.Sprintf("%s %d", verb, n))
+}
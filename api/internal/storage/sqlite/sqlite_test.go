@@ -0,0 +1,20 @@
+package sqlite
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/VoidMesh/api/api/db/migrations"
+	"github.com/stretchr/testify/require"
+)
+
+// openTestDB opens a database in a temporary directory with the schema applied
+func openTestDB(t *testing.T) *DB {
+	t.Helper()
+
+	d, err := Open(t.Context(), filepath.Join(t.TempDir(), "test.db"))
+	require.NoError(t, err)
+	t.Cleanup(d.Close)
+	require.NoError(t, d.ApplySchema(t.Context(), migrations.Schema))
+	return d
+}
@@ -0,0 +1,264 @@
+package sqlite
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// statement is a query rewritten for SQLite. Most generated queries translate
+// mechanically; the few that use Postgres-only constructs have an override below.
+type statement struct {
+	sql   string
+	verb  string   // First keyword, which names the command tag
+	after []string // Statements run after sql in the same transaction, with the same arguments
+}
+
+var (
+	nameRe        = regexp.MustCompile(`--\s*name:\s*(\w+)`)
+	anyRe         = regexp.MustCompile(`(?i)=\s*ANY\s*\(\s*(\?\d+)\s*\)`)
+	cardinalityRe = regexp.MustCompile(`(?i)\bcardinality\s*\(`)
+	lockRe        = regexp.MustCompile(`(?i)\s+FOR\s+(NO\s+KEY\s+)?(UPDATE|SHARE)(\s+OF\s+\w+)?(\s+SKIP\s+LOCKED|\s+NOWAIT)?`)
+	ilikeRe       = regexp.MustCompile(`(?i)\bILIKE\b`)
+	updateAliasRe = regexp.MustCompile(`(?is)^((?:\s|--[^\n]*\n)*(?:UPDATE|DELETE\s+FROM)\s+\w+)\s+(\w+)\s+(SET|WHERE)\b`)
+	verbRe        = regexp.MustCompile(`(?i)^(?:\s|--[^\n]*\n)*(\w+)`)
+)
+
+// statements caches translations by the query text sqlc generated
+var statements sync.Map
+
+func translate(query string) statement {
+	if st, ok := statements.Load(query); ok {
+		return st.(statement)
+	}
+
+	var st statement
+	if m := nameRe.FindStringSubmatch(query); m != nil {
+		if o, ok := overrides[m[1]]; ok {
+			st = o
+		}
+	}
+	if st.sql == "" {
+		st.sql = rewrite(query)
+	}
+	if m := verbRe.FindStringSubmatch(st.sql); m != nil {
+		st.verb = strings.ToUpper(m[1])
+	}
+
+	statements.Store(query, st)
+	return st
+}
+
+// rewrite translates the Postgres dialect the generated queries use: $N parameters,
+// :: casts, = ANY(array) and cardinality(array) over JSON array parameters, row locks
+// (SQLite has one writer, so they are dropped), ILIKE and unaliased UPDATE targets
+func rewrite(query string) string {
+	sql := rewriteCasts(query)
+	sql = anyRe.ReplaceAllString(sql, "IN (SELECT value FROM json_each($1))")
+	sql = cardinalityRe.ReplaceAllString(sql, "json_array_length(")
+	sql = lockRe.ReplaceAllString(sql, "")
+	sql = ilikeRe.ReplaceAllString(sql, "LIKE")
+	sql = updateAliasRe.ReplaceAllString(sql, "$1 AS $2 $3")
+	return sql
+}
+
+// rewriteCasts turns $N into ?N and removes :: casts outside string literals. Casts to
+// integer and floating point types become CAST so arithmetic keeps Postgres' types;
+// every other cast is dropped, since SQLite stores those values as text anyway.
+func rewriteCasts(query string) string {
+	var out []byte
+	for i := 0; i < len(query); {
+		c := query[i]
+		switch {
+		case c == '\'':
+			end := i + 1
+			for end < len(query) {
+				if query[end] == '\'' {
+					if end+1 < len(query) && query[end+1] == '\'' {
+						end += 2
+						continue
+					}
+					break
+				}
+				end++
+			}
+			out = append(out, query[i:min(end+1, len(query))]...)
+			i = end + 1
+		case c == '-' && strings.HasPrefix(query[i:], "--"):
+			end := strings.IndexByte(query[i:], '\n')
+			if end < 0 {
+				end = len(query) - i
+			}
+			out = append(out, query[i:i+end]...)
+			i += end
+		case c == '$' && i+1 < len(query) && isDigit(query[i+1]):
+			out = append(out, '?')
+			i++
+		case c == ':' && strings.HasPrefix(query[i:], "::"):
+			j := i + 2
+			for j < len(query) && (isIdent(query[j]) || query[j] == '[' || query[j] == ']') {
+				j++
+			}
+			if j < len(query) && query[j] == '(' {
+				j += strings.IndexByte(query[j:], ')') + 1
+			}
+			typ := strings.ToLower(query[i+2 : j])
+			start := operandStart(out)
+			operand := string(out[start:])
+			out = out[:start]
+			switch {
+			case strings.HasSuffix(typ, "[]"):
+				out = append(out, operand...)
+			case isIntegerType(typ):
+				out = append(out, "CAST("+operand+" AS INTEGER)"...)
+			case isRealType(typ):
+				out = append(out, "CAST("+operand+" AS REAL)"...)
+			default:
+				out = append(out, operand...)
+			}
+			i = j
+		default:
+			out = append(out, c)
+			i++
+		}
+	}
+	return string(out)
+}
+
+// operandStart finds where the expression a :: cast applies to begins: a parenthesised
+// expression or call, a string literal, or an identifier, number or parameter
+func operandStart(out []byte) int {
+	i := len(out) - 1
+	switch {
+	case i < 0:
+		return 0
+	case out[i] == ')':
+		depth := 0
+		for ; i >= 0; i-- {
+			if out[i] == ')' {
+				depth++
+			} else if out[i] == '(' {
+				depth--
+				if depth == 0 {
+					break
+				}
+			}
+		}
+		for i > 0 && isIdent(out[i-1]) {
+			i--
+		}
+		return max(i, 0)
+	case out[i] == '\'':
+		for i--; i > 0 && out[i] != '\''; i-- {
+		}
+		return max(i, 0)
+	}
+	for i >= 0 && (isIdent(out[i]) || out[i] == '.' || out[i] == '?') {
+		i--
+	}
+	return i + 1
+}
+
+func isDigit(c byte) bool { return c >= '0' && c <= '9' }
+
+func isIdent(c byte) bool {
+	return c == '_' || isDigit(c) || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIntegerType(typ string) bool {
+	switch typ {
+	case "int", "integer", "int2", "int4", "int8", "smallint", "bigint":
+		return true
+	}
+	return false
+}
+
+func isRealType(typ string) bool {
+	switch {
+	case typ == "float", typ == "float4", typ == "float8", typ == "real", typ == "numeric":
+		return true
+	case strings.HasPrefix(typ, "numeric("), strings.HasPrefix(typ, "decimal"):
+		return true
+	}
+	return false
+}
+
+// overrides are hand translations of queries whose Postgres constructs have no
+// mechanical SQLite equivalent: unnest, DISTINCT ON, array_agg, data-modifying CTEs and
+// interval arithmetic. Parameters keep sqlc's numbering.
+var overrides = map[string]statement{
+	"SetInventorySortPositions": {sql: `INSERT INTO character_inventory_flags (inventory_id, character_id, sort_position)
+SELECT ci.id, ci.character_id, p.key + 1
+FROM json_each(?1) AS p
+JOIN character_inventories ci ON ci.id = p.value
+WHERE ci.character_id = ?2
+ON CONFLICT (inventory_id) DO UPDATE
+SET sort_position = excluded.sort_position`},
+
+	"ApplyStatusEffect": {sql: `INSERT INTO character_status_effects (character_id, effect_key, effect_type, magnitude, stacks, source, applied_at, expires_at)
+VALUES (?1, ?2, ?3, ?4, 1, ?5, ?6, ?7)
+ON CONFLICT (character_id, effect_key) DO UPDATE
+SET effect_type = excluded.effect_type,
+    magnitude = excluded.magnitude,
+    source = excluded.source,
+    applied_at = excluded.applied_at,
+    stacks = CASE
+      WHEN character_status_effects.expires_at <= excluded.applied_at THEN 1
+      WHEN ?8 = 'intensity' THEN min(character_status_effects.stacks + 1, CAST(?9 AS INTEGER))
+      ELSE character_status_effects.stacks
+    END,
+    expires_at = CASE
+      WHEN ?8 = 'extend' AND character_status_effects.expires_at > excluded.applied_at
+        THEN timestamp_shift(character_status_effects.expires_at, excluded.applied_at, excluded.expires_at)
+      ELSE excluded.expires_at
+    END
+RETURNING character_id, effect_key, effect_type, magnitude, stacks, source, applied_at, expires_at`},
+
+	"RecordChunkAccesses": {sql: `UPDATE chunks AS c
+SET access_count = c.access_count + a.hits,
+    last_accessed_at = greatest(c.last_accessed_at, a.accessed_at)
+FROM (
+    SELECT w.value AS world_id, x.value AS chunk_x, y.value AS chunk_y, h.value AS hits, t.value AS accessed_at
+    FROM json_each(?1) AS w
+    JOIN json_each(?2) AS x ON x.key = w.key
+    JOIN json_each(?3) AS y ON y.key = w.key
+    JOIN json_each(?4) AS h ON h.key = w.key
+    JOIN json_each(?5) AS t ON t.key = w.key
+) AS a
+WHERE c.world_id = a.world_id AND c.chunk_x = a.chunk_x AND c.chunk_y = a.chunk_y`},
+
+	"ListLatestConsentDocuments": {sql: `SELECT kind, version, url, summary, required, published_by, published_at FROM consent_documents d
+WHERE version = (SELECT max(version) FROM consent_documents WHERE kind = d.kind)
+ORDER BY kind`},
+
+	"ListLatestUserConsents": {sql: `SELECT user_id, kind, version, accepted_at, ip_address FROM user_consents c
+WHERE user_id = ?1
+  AND version = (SELECT max(version) FROM user_consents WHERE user_id = c.user_id AND kind = c.kind)
+ORDER BY kind`},
+
+	"ListDuplicateInventoryStacks": {sql: `SELECT
+  character_id,
+  item_id,
+  json_group_array(id ORDER BY id) AS inventory_ids,
+  CAST(SUM(quantity) AS INTEGER) AS total_quantity
+FROM character_inventories
+GROUP BY character_id, item_id
+HAVING COUNT(*) > 1
+ORDER BY character_id, item_id`},
+
+	// The stacks are added to the kept row before they are deleted, which the Postgres
+	// query does in one statement with a DELETE ... RETURNING CTE
+	"MergeInventoryStacks": {
+		sql: `UPDATE character_inventories
+SET
+  quantity = quantity + (
+    SELECT COALESCE(SUM(d.quantity), 0) FROM character_inventories d
+    WHERE d.character_id = ?2 AND d.item_id = ?3 AND d.id <> ?1
+  ),
+  updated_at = now()
+WHERE id = ?1
+RETURNING quantity`,
+		after: []string{`DELETE FROM character_inventories
+WHERE character_id = ?2 AND item_id = ?3 AND id <> ?1`},
+	},
+}
@@ -0,0 +1,118 @@
+package sqlite
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// generatedQueries reads the query constants sqlc generated in db
+func generatedQueries(t *testing.T) map[string]string {
+	t.Helper()
+
+	files, err := filepath.Glob("../../../db/*.sql.go")
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+
+	queries := map[string]string{}
+	fset := token.NewFileSet()
+	for _, file := range files {
+		f, err := parser.ParseFile(fset, file, nil, 0)
+		require.NoError(t, err)
+		ast.Inspect(f, func(n ast.Node) bool {
+			spec, ok := n.(*ast.ValueSpec)
+			if !ok || len(spec.Values) != 1 {
+				return true
+			}
+			lit, ok := spec.Values[0].(*ast.BasicLit)
+			if !ok || lit.Kind != token.STRING {
+				return true
+			}
+			query, err := strconv.Unquote(lit.Value)
+			require.NoError(t, err)
+			if m := nameRe.FindStringSubmatch(query); m != nil {
+				queries[m[1]] = query
+			}
+			return true
+		})
+	}
+	return queries
+}
+
+// TestTranslate_EveryQueryPrepares compiles every generated query against the
+// translated schema, which catches syntax SQLite rejects and unknown columns
+func TestTranslate_EveryQueryPrepares(t *testing.T) {
+	d := openTestDB(t)
+	ctx := t.Context()
+
+	queries := generatedQueries(t)
+	for name := range overrides {
+		assert.Contains(t, queries, name, "override for a query that no longer exists")
+	}
+
+	for name, query := range queries {
+		st := translate(query)
+		args := make([]any, paramCount(st.sql))
+		for _, sql := range append([]string{st.sql}, st.after...) {
+			// The driver prepares lazily; EXPLAIN compiles the statement without running it
+			rows, err := d.db.QueryContext(ctx, "EXPLAIN "+sql, args...)
+			if !assert.NoError(t, err, "%s:\n%s", name, sql) {
+				continue
+			}
+			rows.Close()
+		}
+	}
+}
+
+func paramCount(sql string) int {
+	n := 0
+	for _, m := range paramRe.FindAllStringSubmatch(sql, -1) {
+		i, _ := strconv.Atoi(m[1])
+		n = max(n, i)
+	}
+	return n
+}
+
+var paramRe = regexp.MustCompile(`\?(\d+)`)
+
+func TestRewriteCasts(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"WHERE id = $1", "WHERE id = ?1"},
+		{"$2::bigint = 0", "CAST(?2 AS INTEGER) = 0"},
+		{"WHERE id = ANY($1::uuid[])", "WHERE id = ANY(?1)"},
+		{"COALESCE(c.name, '')::text AS name", "COALESCE(c.name, '') AS name"},
+		{"FLOOR(chunk_x::float8 / $1::integer)::integer", "CAST(FLOOR(CAST(chunk_x AS REAL) / CAST(?1 AS INTEGER)) AS INTEGER)"},
+		{"count(*)::integer", "CAST(count(*) AS INTEGER)"},
+		{"x::bigint - $2::integer", "CAST(x AS INTEGER) - CAST(?2 AS INTEGER)"},
+		{"'a::b $1'", "'a::b $1'"},
+		{"$7::text || '%'", "?7 || '%'"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, rewriteCasts(tt.query), tt.query)
+	}
+}
+
+func TestRewrite(t *testing.T) {
+	assert.Equal(t,
+		"SELECT * FROM t WHERE id IN (SELECT value FROM json_each(?1))",
+		rewrite("SELECT * FROM t WHERE id = ANY($1::bigint[])"))
+	assert.Equal(t,
+		"SELECT id FROM jobs WHERE ready ORDER BY id LIMIT ?1\n)",
+		rewrite("SELECT id FROM jobs WHERE ready ORDER BY id LIMIT $1\n    FOR UPDATE SKIP LOCKED\n)"))
+	assert.Equal(t,
+		"UPDATE chunks AS c SET n = 1",
+		rewrite("UPDATE chunks c SET n = 1"))
+	assert.Equal(t,
+		"i.name LIKE ?7 || '%'",
+		rewrite("i.name ILIKE $7::text || '%'"))
+}
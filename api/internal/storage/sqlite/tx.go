@@ -0,0 +1,149 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// tx is a pgx.Tx over a SQLite transaction. Begin on it opens a savepoint, as pgx does.
+type tx struct {
+	tx        *sql.Tx
+	savepoint string // Set on nested transactions
+	depth     int
+	closed    bool
+}
+
+func (t *tx) Begin(ctx context.Context) (pgx.Tx, error) {
+	if t.closed {
+		return nil, pgx.ErrTxClosed
+	}
+	nested := &tx{tx: t.tx, depth: t.depth + 1}
+	nested.savepoint = fmt.Sprintf("sp_%d", nested.depth)
+	if _, err := t.tx.ExecContext(ctx, "SAVEPOINT "+nested.savepoint); err != nil {
+		return nil, pgError(err)
+	}
+	return nested, nil
+}
+
+func (t *tx) Commit(ctx context.Context) error {
+	if t.closed {
+		return pgx.ErrTxClosed
+	}
+	t.closed = true
+	if t.savepoint != "" {
+		_, err := t.tx.ExecContext(ctx, "RELEASE "+t.savepoint)
+		return pgError(err)
+	}
+	return pgError(t.tx.Commit())
+}
+
+func (t *tx) Rollback(ctx context.Context) error {
+	if t.closed {
+		return pgx.ErrTxClosed
+	}
+	t.closed = true
+	if t.savepoint != "" {
+		if _, err := t.tx.ExecContext(ctx, "ROLLBACK TO "+t.savepoint); err != nil {
+			return pgError(err)
+		}
+		_, err := t.tx.ExecContext(ctx, "RELEASE "+t.savepoint)
+		return pgError(err)
+	}
+	if err := t.tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+		return err
+	}
+	return nil
+}
+
+func (t *tx) CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
+	return copyFrom(ctx, t.tx, tableName, columnNames, rowSrc)
+}
+
+// SendBatch runs the batch's queries in order as each result is read
+func (t *tx) SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults {
+	return &batchResults{ctx: ctx, tx: t, queued: b.QueuedQueries}
+}
+
+func (t *tx) LargeObjects() pgx.LargeObjects {
+	return pgx.LargeObjects{}
+}
+
+func (t *tx) Prepare(context.Context, string, string) (*pgconn.StatementDescription, error) {
+	return nil, errors.New("sqlite: prepared statements are not supported")
+}
+
+func (t *tx) Exec(ctx context.Context, query string, args ...any) (pgconn.CommandTag, error) {
+	return execute(ctx, t.tx, query, args)
+}
+
+func (t *tx) Query(ctx context.Context, query string, args ...any) (pgx.Rows, error) {
+	r, err := read(ctx, t.tx, query, args)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (t *tx) QueryRow(ctx context.Context, query string, args ...any) pgx.Row {
+	r, err := read(ctx, t.tx, query, args)
+	return &row{rows: r, err: err}
+}
+
+// Conn is nil: there is no pgx connection under a SQLite transaction
+func (t *tx) Conn() *pgx.Conn {
+	return nil
+}
+
+type batchResults struct {
+	ctx    context.Context
+	tx     *tx
+	queued []*pgx.QueuedQuery
+	next   int
+}
+
+func (b *batchResults) pop() (*pgx.QueuedQuery, error) {
+	if b.next >= len(b.queued) {
+		return nil, errors.New("sqlite: no more results in batch")
+	}
+	q := b.queued[b.next]
+	b.next++
+	return q, nil
+}
+
+func (b *batchResults) Exec() (pgconn.CommandTag, error) {
+	q, err := b.pop()
+	if err != nil {
+		return pgconn.CommandTag{}, err
+	}
+	return b.tx.Exec(b.ctx, q.SQL, q.Arguments...)
+}
+
+func (b *batchResults) Query() (pgx.Rows, error) {
+	q, err := b.pop()
+	if err != nil {
+		return nil, err
+	}
+	return b.tx.Query(b.ctx, q.SQL, q.Arguments...)
+}
+
+func (b *batchResults) QueryRow() pgx.Row {
+	q, err := b.pop()
+	if err != nil {
+		return &row{err: err}
+	}
+	return b.tx.QueryRow(b.ctx, q.SQL, q.Arguments...)
+}
+
+func (b *batchResults) Close() error {
+	for b.next < len(b.queued) {
+		if _, err := b.Exec(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
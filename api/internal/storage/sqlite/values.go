@@ -0,0 +1,305 @@
+package sqlite
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// timeLayout stores timestamps as fixed-width text so they compare and sort as strings.
+// Like pgx's timestamp codec, the wall clock is kept and the location dropped.
+const timeLayout = "2006-01-02 15:04:05.000000"
+
+// parseLayouts are the timestamp forms read back: stored values, SQLite's own
+// date functions and RFC 3339 strings written by hand
+var parseLayouts = []string{
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	time.RFC3339Nano,
+	"2006-01-02",
+}
+
+func formatTime(t time.Time) string {
+	return t.Format(timeLayout)
+}
+
+func parseTime(s string) (time.Time, error) {
+	for _, layout := range parseLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("sqlite: cannot parse %q as a timestamp", s)
+}
+
+// bindArgs converts query arguments to the values SQLite stores: timestamps as text,
+// UUIDs as their dashed string and slices as JSON arrays, which the translated array
+// parameters read with json_each
+func bindArgs(args []any) ([]any, error) {
+	values := make([]any, len(args))
+	for i, arg := range args {
+		v, err := bindValue(arg)
+		if err != nil {
+			return nil, fmt.Errorf("argument $%d: %w", i+1, err)
+		}
+		values[i] = v
+	}
+	return values, nil
+}
+
+func bindValue(arg any) (any, error) {
+	switch v := arg.(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		return v, nil
+	case string, int64, float64, bool:
+		return v, nil
+	case time.Time:
+		return formatTime(v), nil
+	case pgtype.Numeric:
+		if !v.Valid {
+			return nil, nil
+		}
+		f, err := v.Float64Value()
+		if err != nil {
+			return nil, err
+		}
+		return f.Float64, nil
+	case driver.Valuer:
+		if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer && rv.IsNil() {
+			return nil, nil
+		}
+		dv, err := v.Value()
+		if err != nil {
+			return nil, err
+		}
+		return bindValue(dv)
+	}
+
+	rv := reflect.ValueOf(arg)
+	switch rv.Kind() {
+	case reflect.Pointer:
+		if rv.IsNil() {
+			return nil, nil
+		}
+		return bindValue(rv.Elem().Interface())
+	case reflect.Slice, reflect.Array:
+		// A nil slice is a NULL array in pgx, which = ANY and cardinality treat as NULL
+		if rv.Kind() == reflect.Slice && rv.IsNil() {
+			return nil, nil
+		}
+		elems := make([]any, rv.Len())
+		for i := range elems {
+			v, err := bindValue(rv.Index(i).Interface())
+			if err != nil {
+				return nil, err
+			}
+			elems[i] = v
+		}
+		b, err := json.Marshal(elems)
+		if err != nil {
+			return nil, err
+		}
+		return string(b), nil
+	case reflect.Bool:
+		return rv.Bool(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u := rv.Uint()
+		if u > math.MaxInt64 {
+			return nil, fmt.Errorf("%d overflows a 64-bit integer", u)
+		}
+		return int64(u), nil
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), nil
+	case reflect.String:
+		return rv.String(), nil
+	}
+	return nil, fmt.Errorf("unsupported type %T", arg)
+}
+
+// scanValue stores a value read from SQLite into a destination written for pgx. SQLite
+// has no boolean, timestamp or array types, so those arrive as integers, text and JSON
+// text and are converted to what the destination expects.
+func scanValue(dest, src any) error {
+	if b, ok := src.([]byte); ok {
+		src = append([]byte(nil), b...)
+	}
+
+	switch dest.(type) {
+	case *pgtype.Bool, *bool:
+		if i, ok := src.(int64); ok {
+			src = i != 0
+		}
+	case *pgtype.Numeric:
+		switch v := src.(type) {
+		case int64:
+			src = strconv.FormatInt(v, 10)
+		case float64:
+			src = strconv.FormatFloat(v, 'f', -1, 64)
+		}
+	case *pgtype.Float8, *pgtype.Float4, *float64, *float32:
+		if i, ok := src.(int64); ok {
+			src = float64(i)
+		}
+	case *pgtype.Int2, *pgtype.Int4, *pgtype.Int8:
+		if f, ok := src.(float64); ok && f == math.Trunc(f) {
+			src = int64(f)
+		}
+	case *pgtype.Timestamp, *pgtype.Timestamptz, *pgtype.Date, *time.Time:
+		switch v := src.(type) {
+		case string:
+			t, err := parseTime(v)
+			if err != nil {
+				return err
+			}
+			src = t
+		case []byte:
+			t, err := parseTime(string(v))
+			if err != nil {
+				return err
+			}
+			src = t
+		}
+	}
+
+	switch d := dest.(type) {
+	case *any:
+		*d = src
+		return nil
+	case *[]byte:
+		switch v := src.(type) {
+		case nil:
+			*d = nil
+		case []byte:
+			*d = v
+		case string:
+			*d = []byte(v)
+		default:
+			return fmt.Errorf("cannot scan %T into %T", src, dest)
+		}
+		return nil
+	case sql.Scanner:
+		return d.Scan(src)
+	}
+	return scanReflect(dest, src)
+}
+
+func scanReflect(dest, src any) error {
+	dv := reflect.ValueOf(dest)
+	if dv.Kind() != reflect.Pointer || dv.IsNil() {
+		return fmt.Errorf("cannot scan into non-pointer %T", dest)
+	}
+	elem := dv.Elem()
+
+	if src == nil {
+		switch elem.Kind() {
+		case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Interface:
+			elem.SetZero()
+			return nil
+		}
+		return fmt.Errorf("cannot scan NULL into %T", dest)
+	}
+
+	switch elem.Kind() {
+	case reflect.Pointer:
+		v := reflect.New(elem.Type().Elem())
+		if err := scanValue(v.Interface(), src); err != nil {
+			return err
+		}
+		elem.Set(v)
+		return nil
+	case reflect.Slice:
+		var text []byte
+		switch v := src.(type) {
+		case string:
+			text = []byte(v)
+		case []byte:
+			text = v
+		default:
+			return fmt.Errorf("cannot scan %T into %T", src, dest)
+		}
+		return json.Unmarshal(text, dest)
+	case reflect.String:
+		switch v := src.(type) {
+		case string:
+			elem.SetString(v)
+		case []byte:
+			elem.SetString(string(v))
+		default:
+			elem.SetString(fmt.Sprint(v))
+		}
+		return nil
+	case reflect.Bool:
+		switch v := src.(type) {
+		case bool:
+			elem.SetBool(v)
+		case int64:
+			elem.SetBool(v != 0)
+		default:
+			return fmt.Errorf("cannot scan %T into %T", src, dest)
+		}
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := toInt64(src)
+		if err != nil {
+			return err
+		}
+		if elem.OverflowInt(i) {
+			return fmt.Errorf("%d overflows %s", i, elem.Type())
+		}
+		elem.SetInt(i)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		i, err := toInt64(src)
+		if err != nil {
+			return err
+		}
+		if i < 0 || elem.OverflowUint(uint64(i)) {
+			return fmt.Errorf("%d overflows %s", i, elem.Type())
+		}
+		elem.SetUint(uint64(i))
+		return nil
+	case reflect.Float32, reflect.Float64:
+		switch v := src.(type) {
+		case float64:
+			elem.SetFloat(v)
+		case int64:
+			elem.SetFloat(float64(v))
+		default:
+			return fmt.Errorf("cannot scan %T into %T", src, dest)
+		}
+		return nil
+	}
+	return fmt.Errorf("cannot scan %T into %T", src, dest)
+}
+
+func toInt64(src any) (int64, error) {
+	switch v := src.(type) {
+	case int64:
+		return v, nil
+	case float64:
+		if v != math.Trunc(v) {
+			return 0, fmt.Errorf("cannot scan fractional %v into an integer", v)
+		}
+		return int64(v), nil
+	case bool:
+		if v {
+			return 1, nil
+		}
+		return 0, nil
+	case string:
+		return strconv.ParseInt(v, 10, 64)
+	}
+	return 0, fmt.Errorf("cannot scan %T into an integer", src)
+}
@@ -0,0 +1,23 @@
+// Package storage is the database handle services are built with. A Pool is either a
+// pgx pool connected to Postgres or a single-file SQLite database from
+// internal/storage/sqlite; both run the generated queries in db unchanged.
+package storage
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Pool runs queries and starts transactions. *pgxpool.Pool and *sqlite.DB implement it.
+type Pool interface {
+	db.DBTX
+	Begin(ctx context.Context) (pgx.Tx, error)
+	BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error)
+	Ping(ctx context.Context) error
+	Close()
+}
+
+var _ Pool = (*pgxpool.Pool)(nil)
@@ -0,0 +1,288 @@
+package storage_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/db/migrations"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/storage/sqlite"
+	"github.com/VoidMesh/api/api/internal/testutil"
+	"github.com/VoidMesh/api/api/internal/txn"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// backends runs a test against a fresh SQLite database and, when the test database is
+// reachable, against Postgres in a schema of its own
+func backends(t *testing.T, test func(t *testing.T, pool storage.Pool)) {
+	t.Run("sqlite", func(t *testing.T) {
+		test(t, openSQLite(t))
+	})
+	t.Run("postgres", func(t *testing.T) {
+		test(t, openPostgres(t))
+	})
+}
+
+func openSQLite(t *testing.T) storage.Pool {
+	t.Helper()
+
+	ctx := t.Context()
+	d, err := sqlite.Open(ctx, filepath.Join(t.TempDir(), "test.db"))
+	require.NoError(t, err)
+	t.Cleanup(d.Close)
+	require.NoError(t, d.ApplySchema(ctx, migrations.Schema))
+	return d
+}
+
+func openPostgres(t *testing.T) storage.Pool {
+	t.Helper()
+
+	ctx := t.Context()
+	url := testutil.DefaultDatabaseConfig().DatabaseURL
+	admin, err := pgxpool.New(ctx, url)
+	if err != nil {
+		t.Skipf("Test database unavailable: %v", err)
+	}
+	t.Cleanup(admin.Close)
+	if err := admin.Ping(ctx); err != nil {
+		t.Skipf("Test database unavailable: %v", err)
+	}
+
+	schema := fmt.Sprintf("storage_test_%d", time.Now().UnixNano())
+	_, err = admin.Exec(ctx, "CREATE SCHEMA "+schema)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = admin.Exec(context.Background(), "DROP SCHEMA "+schema+" CASCADE")
+	})
+
+	config, err := pgxpool.ParseConfig(url)
+	require.NoError(t, err)
+	config.ConnConfig.RuntimeParams["search_path"] = schema
+	pool, err := pgxpool.NewWithConfig(ctx, config)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	_, err = pool.Exec(ctx, migrations.Schema)
+	require.NoError(t, err)
+	return pool
+}
+
+// ts truncates to the microseconds both backends store
+func ts(t time.Time) pgtype.Timestamp {
+	return pgtype.Timestamp{Time: t.UTC().Truncate(time.Microsecond), Valid: true}
+}
+
+func createCharacter(t *testing.T, q *db.Queries, name string) (db.User, db.Character) {
+	t.Helper()
+
+	ctx := t.Context()
+	user, err := q.CreateUser(ctx, db.CreateUserParams{
+		Username:     name,
+		DisplayName:  name,
+		Email:        name + "@example.com",
+		PasswordHash: "hash",
+	})
+	require.NoError(t, err)
+	character, err := q.CreateCharacter(ctx, db.CreateCharacterParams{UserID: user.ID, Name: name, X: 3, Y: -4})
+	require.NoError(t, err)
+	return user, character
+}
+
+func TestPool_RoundTrip(t *testing.T) {
+	backends(t, func(t *testing.T, pool storage.Pool) {
+		ctx := t.Context()
+		q := db.New(pool)
+
+		world, err := q.GetDefaultWorld(ctx)
+		require.NoError(t, err, "the schema seeds a default world")
+
+		user, character := createCharacter(t, q, "ada")
+		assert.True(t, user.ID.Valid)
+		assert.True(t, user.CreatedAt.Valid)
+		assert.Equal(t, world.ID, character.WorldID, "a character without a world joins the default one")
+
+		got, err := q.GetUserById(ctx, user.ID)
+		require.NoError(t, err)
+		assert.Equal(t, user, got)
+
+		_, err = q.GetUserById(ctx, pgtype.UUID{Bytes: [16]byte{1}, Valid: true})
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+
+		_, err = q.CreateUser(ctx, db.CreateUserParams{Username: "ada", Email: "other@example.com", PasswordHash: "hash"})
+		var pgErr *pgconn.PgError
+		require.ErrorAs(t, err, &pgErr)
+		assert.Equal(t, "23505", pgErr.Code)
+	})
+}
+
+func TestPool_Transactions(t *testing.T) {
+	backends(t, func(t *testing.T, pool storage.Pool) {
+		ctx := t.Context()
+		q := db.New(pool)
+		errAbort := errors.New("abort")
+
+		err := txn.Run(ctx, pool, txn.Serializable, func(q *db.Queries) error {
+			createCharacter(t, q, "rolled_back")
+			return errAbort
+		})
+		require.ErrorIs(t, err, errAbort)
+		_, err = q.GetUserByUsername(ctx, "rolled_back")
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+
+		err = txn.Do(ctx, pool, txn.Serializable, func(tx pgx.Tx) error {
+			createCharacter(t, db.New(tx), "committed")
+
+			nested, err := tx.Begin(ctx)
+			require.NoError(t, err)
+			createCharacter(t, db.New(nested), "savepoint")
+			return nested.Rollback(ctx)
+		})
+		require.NoError(t, err)
+		_, err = q.GetUserByUsername(ctx, "committed")
+		assert.NoError(t, err)
+		_, err = q.GetUserByUsername(ctx, "savepoint")
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+	})
+}
+
+func TestPool_ArrayParameters(t *testing.T) {
+	backends(t, func(t *testing.T, pool storage.Pool) {
+		ctx := t.Context()
+		q := db.New(pool)
+
+		_, ada := createCharacter(t, q, "ada")
+		_, bob := createCharacter(t, q, "bob")
+		createCharacter(t, q, "cy")
+
+		characters, err := q.ListCharactersByIds(ctx, []pgtype.UUID{ada.ID, bob.ID})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"ada", "bob"}, []string{characters[0].Name, characters[1].Name})
+
+		characters, err = q.ListCharactersByIds(ctx, nil)
+		require.NoError(t, err)
+		assert.Empty(t, characters)
+
+		now := time.Now()
+		_, err = q.CreateChunk(ctx, db.CreateChunkParams{WorldID: ada.WorldID, ChunkData: []byte{1, 2}, Passability: []byte{0}})
+		require.NoError(t, err)
+		err = q.RecordChunkAccesses(ctx, db.RecordChunkAccessesParams{
+			WorldIds:    []pgtype.UUID{ada.WorldID},
+			ChunkXs:     []int32{0},
+			ChunkYs:     []int32{0},
+			Hits:        []int64{3},
+			AccessedAts: []pgtype.Timestamp{ts(now)},
+		})
+		require.NoError(t, err)
+
+		chunk, err := q.GetChunk(ctx, db.GetChunkParams{WorldID: ada.WorldID})
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), chunk.AccessCount)
+		assert.Equal(t, ts(now), chunk.LastAccessedAt)
+		assert.Equal(t, []byte{1, 2}, chunk.ChunkData)
+	})
+}
+
+func TestPool_Upserts(t *testing.T) {
+	backends(t, func(t *testing.T, pool storage.Pool) {
+		ctx := t.Context()
+		q := db.New(pool)
+		_, ada := createCharacter(t, q, "ada")
+
+		applied := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+		apply := func(at time.Time) db.CharacterStatusEffect {
+			effect, err := q.ApplyStatusEffect(ctx, db.ApplyStatusEffectParams{
+				CharacterID: ada.ID,
+				EffectKey:   "well_fed",
+				Magnitude:   0.5,
+				Source:      "test",
+				AppliedAt:   ts(at),
+				ExpiresAt:   ts(at.Add(time.Minute)),
+				Stacking:    "extend",
+				MaxStacks:   1,
+			})
+			require.NoError(t, err)
+			return effect
+		}
+
+		apply(applied)
+		effect := apply(applied.Add(20 * time.Second))
+		assert.Equal(t, ts(applied.Add(2*time.Minute)), effect.ExpiresAt, "extending adds the new duration to the time left")
+		assert.Equal(t, 0.5, effect.Magnitude)
+
+		active, err := q.ListActiveStatusEffects(ctx, db.ListActiveStatusEffectsParams{
+			CharacterIds: []pgtype.UUID{ada.ID},
+			Now:          ts(applied.Add(90 * time.Second)),
+		})
+		require.NoError(t, err)
+		assert.Len(t, active, 1)
+	})
+}
+
+func TestPool_SingleRowTables(t *testing.T) {
+	backends(t, func(t *testing.T, pool storage.Pool) {
+		ctx := t.Context()
+		q := db.New(pool)
+
+		for _, enabled := range []bool{true, false} {
+			_, err := q.UpsertMaintenanceMode(ctx, db.UpsertMaintenanceModeParams{Enabled: enabled, UpdatedAt: ts(time.Now())})
+			require.NoError(t, err)
+		}
+		mode, err := q.GetMaintenanceMode(ctx)
+		require.NoError(t, err)
+		assert.False(t, mode.Enabled, "the second upsert updates the one row")
+	})
+}
+
+func TestPool_CopyFromAndAggregates(t *testing.T) {
+	backends(t, func(t *testing.T, pool storage.Pool) {
+		ctx := t.Context()
+		q := db.New(pool)
+		user, ada := createCharacter(t, q, "ada")
+
+		_, err := q.CreateChunk(ctx, db.CreateChunkParams{WorldID: ada.WorldID, ChunkData: []byte{0}})
+		require.NoError(t, err)
+		nodes := make([]db.CopyResourceNodesParams, 3)
+		for i := range nodes {
+			nodes[i] = db.CopyResourceNodesParams{ResourceNodeTypeID: 1, WorldID: ada.WorldID, ClusterID: "c", X: int32(i), Size: 1, Quality: 1}
+		}
+		n, err := q.CopyResourceNodes(ctx, nodes)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), n)
+
+		count, err := q.CountResourceNodesByType(ctx, db.CountResourceNodesByTypeParams{WorldID: ada.WorldID, ResourceNodeTypeID: 1})
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), count)
+
+		for _, url := range []string{"https://example.com/v1", "https://example.com/v2"} {
+			_, err := q.PublishConsentDocument(ctx, db.PublishConsentDocumentParams{
+				Kind: "terms", Url: url, Required: true, PublishedBy: user.ID, PublishedAt: ts(time.Now()),
+			})
+			require.NoError(t, err)
+		}
+		latest, err := q.ListLatestConsentDocuments(ctx)
+		require.NoError(t, err)
+		require.Len(t, latest, 1)
+		assert.Equal(t, int32(2), latest[0].Version)
+		assert.Equal(t, "https://example.com/v2", latest[0].Url)
+		assert.True(t, latest[0].Required)
+
+		stack, err := q.CreateInventoryItem(ctx, db.CreateInventoryItemParams{CharacterID: ada.ID, ItemID: 1, Quantity: 5})
+		require.NoError(t, err)
+		quantity, err := q.MergeInventoryStacks(ctx, db.MergeInventoryStacksParams{KeepID: stack.ID, CharacterID: ada.ID, ItemID: 1})
+		require.NoError(t, err)
+		assert.Equal(t, int32(5), quantity)
+		duplicates, err := q.ListDuplicateInventoryStacks(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, duplicates)
+	})
+}
@@ -0,0 +1,158 @@
+// Package streamsession numbers the messages of server streams, keeps the latest ones so
+// a client that reconnects can resume where it left off, and builds heartbeats.
+//
+// Delivery is at least once: a resumed stream sends again every buffered message after the
+// sequence the client acknowledges, so clients skip sequences they have already seen. A
+// resume that is not possible (unknown or expired stream, or too far behind) opens a new
+// stream whose first message has restarted set.
+package streamsession
+
+import (
+	"sync"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	streamV1 "github.com/VoidMesh/api/api/proto/stream/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	HeartbeatInterval = 15 * time.Second // Keeps idle streams alive through proxies and tells clients the server is there
+	ResumeWindow      = 2 * time.Minute  // How long a closed stream can be resumed
+	ReplayBuffer      = 256              // Messages kept per stream for resuming
+)
+
+// Registry tracks the streams of one RPC. It is safe for concurrent use.
+type Registry[T proto.Message] struct {
+	mu       sync.Mutex
+	clock    clock.Clock
+	setInfo  func(T, *streamV1.StreamInfo)
+	sessions map[string]*Session[T]
+}
+
+// NewRegistry creates an empty registry; setInfo stores the delivery information on a message
+func NewRegistry[T proto.Message](setInfo func(T, *streamV1.StreamInfo)) *Registry[T] {
+	return &Registry[T]{
+		clock:    clock.New(),
+		setInfo:  setInfo,
+		sessions: make(map[string]*Session[T]),
+	}
+}
+
+// SetClock replaces the clock used for the resume window (for simulation tests)
+func (r *Registry[T]) SetClock(c clock.Clock) {
+	r.clock = c
+}
+
+// Session is one stream. Its methods are called from the goroutine serving the stream.
+type Session[T proto.Message] struct {
+	registry  *Registry[T]
+	id        string
+	userKey   string
+	sequence  uint64
+	sent      []T // The last ReplayBuffer messages, oldest first
+	restarted bool
+
+	// Guarded by the registry's mutex
+	open     bool
+	closedAt time.Time
+}
+
+// Open starts a stream for the user, resuming the stream named by resume when it can.
+// It returns the session and the messages to send again after the opening heartbeat.
+func (r *Registry[T]) Open(userID string, resume *streamV1.StreamResume) (*Session[T], []T) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.clock.Now()
+	for id, s := range r.sessions {
+		if !s.open && now.Sub(s.closedAt) > ResumeWindow {
+			delete(r.sessions, id)
+		}
+	}
+
+	userKey := uuid.Normalize(userID)
+	if resume.GetStreamId() != "" {
+		s, ok := r.sessions[resume.StreamId]
+		if ok && s.userKey == userKey && !s.open && s.covers(resume.AcknowledgedSequence) {
+			s.open = true
+			return s, s.after(resume.AcknowledgedSequence)
+		}
+		if ok && s.userKey == userKey && !s.open {
+			delete(r.sessions, resume.StreamId)
+		}
+	}
+
+	s := &Session[T]{
+		registry:  r,
+		id:        uuid.GenerateNewNormalized(),
+		userKey:   userKey,
+		restarted: resume.GetStreamId() != "",
+		open:      true,
+	}
+	r.sessions[s.id] = s
+	return s, nil
+}
+
+// Len returns the number of open and resumable streams
+func (r *Registry[T]) Len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.sessions)
+}
+
+// ID returns the stream ID clients pass to resume
+func (s *Session[T]) ID() string {
+	return s.id
+}
+
+// Stamp returns a copy of msg with the next sequence, keeping it for resuming. Messages
+// are copied because the same one is often fanned out to several streams.
+func (s *Session[T]) Stamp(msg T) T {
+	stamped := proto.Clone(msg).(T)
+	s.sequence++
+	s.registry.setInfo(stamped, s.info())
+
+	s.sent = append(s.sent, stamped)
+	if len(s.sent) > ReplayBuffer {
+		s.sent = s.sent[len(s.sent)-ReplayBuffer:]
+	}
+	return stamped
+}
+
+// Heartbeat returns a message without payload carrying the last sequence
+func (s *Session[T]) Heartbeat() T {
+	var zero T
+	msg := zero.ProtoReflect().Type().New().Interface().(T)
+	info := s.info()
+	info.Heartbeat = true
+	s.registry.setInfo(msg, info)
+	return msg
+}
+
+// Close ends the stream; it can be resumed for ResumeWindow
+func (s *Session[T]) Close() {
+	s.registry.mu.Lock()
+	defer s.registry.mu.Unlock()
+	s.open = false
+	s.closedAt = s.registry.clock.Now()
+}
+
+// info is the delivery information for the next message; restarted is only sent once
+func (s *Session[T]) info() *streamV1.StreamInfo {
+	info := &streamV1.StreamInfo{StreamId: s.id, Sequence: s.sequence, Restarted: s.restarted}
+	s.restarted = false
+	return info
+}
+
+// covers reports whether every message after acknowledged is still buffered
+func (s *Session[T]) covers(acknowledged uint64) bool {
+	return acknowledged <= s.sequence && s.sequence-acknowledged <= uint64(len(s.sent))
+}
+
+// after returns the buffered messages after acknowledged and drops the rest
+func (s *Session[T]) after(acknowledged uint64) []T {
+	s.sent = s.sent[uint64(len(s.sent))-(s.sequence-acknowledged):]
+	return append([]T(nil), s.sent...)
+}
@@ -0,0 +1,105 @@
+package streamsession
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/VoidMesh/api/api/internal/clock"
+	socialV1 "github.com/VoidMesh/api/api/proto/social/v1"
+	streamV1 "github.com/VoidMesh/api/api/proto/stream/v1"
+)
+
+const userID = "0b3c1a6e-7f2d-4c1b-9a8e-5d6f7a8b9c0d"
+
+func newRegistry() (*Registry[*socialV1.DirectMessage], *clock.Fake) {
+	registry := NewRegistry(func(m *socialV1.DirectMessage, info *streamV1.StreamInfo) { m.Stream = info })
+	fake := clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	registry.SetClock(fake)
+	return registry, fake
+}
+
+func sequences(messages []*socialV1.DirectMessage) []uint64 {
+	var seqs []uint64
+	for _, m := range messages {
+		seqs = append(seqs, m.Stream.Sequence)
+	}
+	return seqs
+}
+
+func TestSession_StampAndHeartbeat(t *testing.T) {
+	registry, _ := newRegistry()
+	session, replay := registry.Open(userID, nil)
+	assert.Empty(t, replay)
+
+	opening := session.Heartbeat()
+	assert.Equal(t, session.ID(), opening.Stream.StreamId)
+	assert.True(t, opening.Stream.Heartbeat)
+	assert.Zero(t, opening.Stream.Sequence)
+	assert.False(t, opening.Stream.Restarted)
+
+	shared := &socialV1.DirectMessage{Id: 7, Body: "hi"}
+	first := session.Stamp(shared)
+	second := session.Stamp(shared)
+	assert.Nil(t, shared.Stream, "the published message is left untouched")
+	assert.Equal(t, "hi", first.Body)
+	assert.Equal(t, uint64(1), first.Stream.Sequence)
+	assert.Equal(t, uint64(2), second.Stream.Sequence)
+	assert.False(t, second.Stream.Heartbeat)
+
+	heartbeat := session.Heartbeat()
+	assert.Equal(t, uint64(2), heartbeat.Stream.Sequence, "heartbeats repeat the last sequence")
+	assert.Empty(t, heartbeat.Body)
+}
+
+func TestRegistry_Resume(t *testing.T) {
+	registry, fake := newRegistry()
+	session, _ := registry.Open(userID, nil)
+	for i := range 5 {
+		session.Stamp(&socialV1.DirectMessage{Id: int64(i + 1)})
+	}
+	resume := &streamV1.StreamResume{StreamId: session.ID(), AcknowledgedSequence: 3}
+
+	concurrent, replay := registry.Open(userID, resume)
+	assert.NotSame(t, session, concurrent, "an open stream cannot be taken over")
+	assert.Empty(t, replay)
+	concurrent.Close()
+
+	session.Close()
+	resumed, replay := registry.Open("0b3c1a6e7f2d4c1b9a8e5d6f7a8b9c0d", resume)
+	require.Same(t, session, resumed)
+	assert.Equal(t, []uint64{4, 5}, sequences(replay), "messages after the acknowledged sequence are sent again")
+	assert.Equal(t, uint64(6), resumed.Stamp(&socialV1.DirectMessage{}).Stream.Sequence)
+	assert.False(t, resumed.Heartbeat().Stream.Restarted)
+
+	t.Run("other users cannot resume", func(t *testing.T) {
+		resumed.Close()
+		other, _ := registry.Open("someone-else", &streamV1.StreamResume{StreamId: session.ID(), AcknowledgedSequence: 6})
+		assert.NotSame(t, session, other)
+		assert.True(t, other.Heartbeat().Stream.Restarted)
+		other.Close()
+	})
+
+	t.Run("acknowledging beyond the buffer restarts", func(t *testing.T) {
+		for range ReplayBuffer {
+			session.Stamp(&socialV1.DirectMessage{})
+		}
+		restarted, replay := registry.Open(userID, &streamV1.StreamResume{StreamId: session.ID(), AcknowledgedSequence: 5})
+		assert.NotSame(t, session, restarted)
+		assert.Empty(t, replay)
+		assert.True(t, restarted.Heartbeat().Stream.Restarted)
+		assert.False(t, restarted.Heartbeat().Stream.Restarted, "restarted is sent once")
+		restarted.Close()
+	})
+
+	t.Run("closed streams expire", func(t *testing.T) {
+		current, _ := registry.Open(userID, nil)
+		current.Close()
+		fake.Advance(ResumeWindow + time.Second)
+		fresh, _ := registry.Open(userID, &streamV1.StreamResume{StreamId: current.ID()})
+		assert.NotSame(t, current, fresh)
+		assert.Equal(t, 1, registry.Len(), "expired streams are dropped")
+	})
+}
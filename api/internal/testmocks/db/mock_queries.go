@@ -42,6 +42,21 @@ func (m *MockDBTX) EXPECT() *MockDBTXMockRecorder {
 	return m.recorder
 }
 
+// CopyFrom mocks base method.
+func (m *MockDBTX) CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyFrom", ctx, tableName, columnNames, rowSrc)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CopyFrom indicates an expected call of CopyFrom.
+func (mr *MockDBTXMockRecorder) CopyFrom(ctx, tableName, columnNames, rowSrc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyFrom", reflect.TypeOf((*MockDBTX)(nil).CopyFrom), ctx, tableName, columnNames, rowSrc)
+}
+
 // Exec mocks base method.
 func (m *MockDBTX) Exec(arg0 context.Context, arg1 string, arg2 ...any) (pgconn.CommandTag, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,43 @@
+package testmocks
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// NopLogger discards everything logged. L is the logger interface of the service under
+// test, which With returns, e.g. testmocks.NopLogger[LoggerInterface]{}.
+type NopLogger[L any] struct{}
+
+func (NopLogger[L]) Debug(msg string, keysAndValues ...interface{}) {}
+func (NopLogger[L]) Info(msg string, keysAndValues ...interface{})  {}
+func (NopLogger[L]) Warn(msg string, keysAndValues ...interface{})  {}
+func (NopLogger[L]) Error(msg string, keysAndValues ...interface{}) {}
+func (l NopLogger[L]) With(keysAndValues ...interface{}) L {
+	return any(l).(L)
+}
+
+// Characters is an in-memory characters table. Service fakes embed it to serve
+// GetCharacterById.
+type Characters map[pgtype.UUID]db.Character
+
+// NewCharacters returns a table holding the given characters
+func NewCharacters(characters ...db.Character) Characters {
+	table := make(Characters, len(characters))
+	for _, character := range characters {
+		table[character.ID] = character
+	}
+	return table
+}
+
+// GetCharacterById returns the character with the ID, or pgx.ErrNoRows
+func (c Characters) GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error) {
+	character, ok := c[id]
+	if !ok {
+		return db.Character{}, pgx.ErrNoRows
+	}
+	return character, nil
+}
@@ -102,6 +102,26 @@ func (mr *MockResourceNodeServiceClientMockRecorder) GetResourcesInChunks(ctx, i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourcesInChunks", reflect.TypeOf((*MockResourceNodeServiceClient)(nil).GetResourcesInChunks), varargs...)
 }
 
+// ReloadBalanceConfig mocks base method.
+func (m *MockResourceNodeServiceClient) ReloadBalanceConfig(ctx context.Context, in *v1.ReloadBalanceConfigRequest, opts ...grpc.CallOption) (*v1.ReloadBalanceConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReloadBalanceConfig", varargs...)
+	ret0, _ := ret[0].(*v1.ReloadBalanceConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReloadBalanceConfig indicates an expected call of ReloadBalanceConfig.
+func (mr *MockResourceNodeServiceClientMockRecorder) ReloadBalanceConfig(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReloadBalanceConfig", reflect.TypeOf((*MockResourceNodeServiceClient)(nil).ReloadBalanceConfig), varargs...)
+}
+
 // MockResourceNodeServiceServer is a mock of ResourceNodeServiceServer interface.
 type MockResourceNodeServiceServer struct {
 	ctrl     *gomock.Controller
@@ -171,6 +191,21 @@ func (mr *MockResourceNodeServiceServerMockRecorder) GetResourcesInChunks(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourcesInChunks", reflect.TypeOf((*MockResourceNodeServiceServer)(nil).GetResourcesInChunks), arg0, arg1)
 }
 
+// ReloadBalanceConfig mocks base method.
+func (m *MockResourceNodeServiceServer) ReloadBalanceConfig(arg0 context.Context, arg1 *v1.ReloadBalanceConfigRequest) (*v1.ReloadBalanceConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReloadBalanceConfig", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ReloadBalanceConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReloadBalanceConfig indicates an expected call of ReloadBalanceConfig.
+func (mr *MockResourceNodeServiceServerMockRecorder) ReloadBalanceConfig(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReloadBalanceConfig", reflect.TypeOf((*MockResourceNodeServiceServer)(nil).ReloadBalanceConfig), arg0, arg1)
+}
+
 // mustEmbedUnimplementedResourceNodeServiceServer mocks base method.
 func (m *MockResourceNodeServiceServer) mustEmbedUnimplementedResourceNodeServiceServer() {
 	m.ctrl.T.Helper()
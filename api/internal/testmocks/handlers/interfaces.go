@@ -5,6 +5,7 @@ import (
 	"context"
 
 	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/geometry"
 	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
 	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
 	resourceNodeV1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
@@ -57,7 +58,7 @@ type UserRepository interface {
 // This mirrors the interface defined in server/handlers/interfaces.go
 type JWTService interface {
 	// GenerateToken creates a new JWT token for the given user
-	GenerateToken(userID string, username string) (string, error)
+	GenerateToken(userID string, username string, worldID string) (string, error)
 
 	// ValidateToken validates a JWT token and returns the claims
 	// This method is not currently used in the user handler but is included
@@ -126,11 +127,26 @@ type ChunkService interface {
 	// GetOrCreateChunk retrieves an existing chunk or creates a new one
 	GetOrCreateChunk(ctx context.Context, chunkX, chunkY int32) (*chunkV1.ChunkData, error)
 
+	// GetOrCreateChunkInWorld retrieves or creates a chunk of an explicit world
+	GetOrCreateChunkInWorld(ctx context.Context, worldID pgtype.UUID, chunkX, chunkY int32) (*chunkV1.ChunkData, error)
+
 	// GetChunksInRange retrieves multiple chunks in a rectangular area
 	GetChunksInRange(ctx context.Context, minX, maxX, minY, maxY int32) ([]*chunkV1.ChunkData, error)
 
 	// GetChunksInRadius retrieves chunks in a circular area
 	GetChunksInRadius(ctx context.Context, centerX, centerY, radius int32) ([]*chunkV1.ChunkData, error)
+
+	// IsPassable checks a cell against its chunk's passability mask
+	IsPassable(ctx context.Context, worldID pgtype.UUID, x, y int32) (bool, error)
+
+	// Cells retrieves the terrain and passability of individual cells
+	Cells(ctx context.Context, points []geometry.Point) ([]*chunkV1.CellInfo, error)
+}
+
+// ChunkSummaryService defines the interface for the chunk summary read model.
+type ChunkSummaryService interface {
+	// GetChunkSummaries retrieves summaries for generated chunks in a rectangular area
+	GetChunkSummaries(ctx context.Context, worldID pgtype.UUID, minX, maxX, minY, maxY int32) ([]*chunkV1.ChunkSummary, error)
 }
 
 // ResourceNodeService defines the interface for resource node service operations.
@@ -144,6 +160,9 @@ type ResourceNodeService interface {
 
 	// GetResourceNodeTypes returns all available resource node types
 	GetResourceNodeTypes(ctx context.Context) ([]*resourceNodeV1.ResourceNodeType, error)
+
+	// ReloadBalanceConfig re-reads the balance data file and returns details of the applied configuration
+	ReloadBalanceConfig(ctx context.Context) (*resourceNodeV1.ReloadBalanceConfigResponse, error)
 }
 
 // LoggerInterface defines logging operations for dependency injection.
@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	db "github.com/VoidMesh/api/api/db"
+	geometry "github.com/VoidMesh/api/api/internal/geometry"
 	v1 "github.com/VoidMesh/api/api/proto/character/v1"
 	v10 "github.com/VoidMesh/api/api/proto/chunk/v1"
 	v11 "github.com/VoidMesh/api/api/proto/resource_node/v1"
@@ -250,18 +251,18 @@ func (m *MockJWTService) EXPECT() *MockJWTServiceMockRecorder {
 }
 
 // GenerateToken mocks base method.
-func (m *MockJWTService) GenerateToken(userID, username string) (string, error) {
+func (m *MockJWTService) GenerateToken(userID, username, worldID string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateToken", userID, username)
+	ret := m.ctrl.Call(m, "GenerateToken", userID, username, worldID)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GenerateToken indicates an expected call of GenerateToken.
-func (mr *MockJWTServiceMockRecorder) GenerateToken(userID, username any) *gomock.Call {
+func (mr *MockJWTServiceMockRecorder) GenerateToken(userID, username, worldID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateToken", reflect.TypeOf((*MockJWTService)(nil).GenerateToken), userID, username)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateToken", reflect.TypeOf((*MockJWTService)(nil).GenerateToken), userID, username, worldID)
 }
 
 // ValidateToken mocks base method.
@@ -592,6 +593,21 @@ func (m *MockChunkService) EXPECT() *MockChunkServiceMockRecorder {
 	return m.recorder
 }
 
+// Cells mocks base method.
+func (m *MockChunkService) Cells(ctx context.Context, points []geometry.Point) ([]*v10.CellInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cells", ctx, points)
+	ret0, _ := ret[0].([]*v10.CellInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cells indicates an expected call of Cells.
+func (mr *MockChunkServiceMockRecorder) Cells(ctx, points any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cells", reflect.TypeOf((*MockChunkService)(nil).Cells), ctx, points)
+}
+
 // GetChunksInRadius mocks base method.
 func (m *MockChunkService) GetChunksInRadius(ctx context.Context, centerX, centerY, radius int32) ([]*v10.ChunkData, error) {
 	m.ctrl.T.Helper()
@@ -637,6 +653,114 @@ func (mr *MockChunkServiceMockRecorder) GetOrCreateChunk(ctx, chunkX, chunkY any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrCreateChunk", reflect.TypeOf((*MockChunkService)(nil).GetOrCreateChunk), ctx, chunkX, chunkY)
 }
 
+// GetOrCreateChunkInWorld mocks base method.
+func (m *MockChunkService) GetOrCreateChunkInWorld(ctx context.Context, worldID pgtype.UUID, chunkX, chunkY int32) (*v10.ChunkData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrCreateChunkInWorld", ctx, worldID, chunkX, chunkY)
+	ret0, _ := ret[0].(*v10.ChunkData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrCreateChunkInWorld indicates an expected call of GetOrCreateChunkInWorld.
+func (mr *MockChunkServiceMockRecorder) GetOrCreateChunkInWorld(ctx, worldID, chunkX, chunkY any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrCreateChunkInWorld", reflect.TypeOf((*MockChunkService)(nil).GetOrCreateChunkInWorld), ctx, worldID, chunkX, chunkY)
+}
+
+// IsPassable mocks base method.
+func (m *MockChunkService) IsPassable(ctx context.Context, worldID pgtype.UUID, x, y int32) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsPassable", ctx, worldID, x, y)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsPassable indicates an expected call of IsPassable.
+func (mr *MockChunkServiceMockRecorder) IsPassable(ctx, worldID, x, y any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPassable", reflect.TypeOf((*MockChunkService)(nil).IsPassable), ctx, worldID, x, y)
+}
+
+// MockChunkSummaryService is a mock of ChunkSummaryService interface.
+type MockChunkSummaryService struct {
+	ctrl     *gomock.Controller
+	recorder *MockChunkSummaryServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockChunkSummaryServiceMockRecorder is the mock recorder for MockChunkSummaryService.
+type MockChunkSummaryServiceMockRecorder struct {
+	mock *MockChunkSummaryService
+}
+
+// NewMockChunkSummaryService creates a new mock instance.
+func NewMockChunkSummaryService(ctrl *gomock.Controller) *MockChunkSummaryService {
+	mock := &MockChunkSummaryService{ctrl: ctrl}
+	mock.recorder = &MockChunkSummaryServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChunkSummaryService) EXPECT() *MockChunkSummaryServiceMockRecorder {
+	return m.recorder
+}
+
+// GetChunkSummaries mocks base method.
+func (m *MockChunkSummaryService) GetChunkSummaries(ctx context.Context, worldID pgtype.UUID, minX, maxX, minY, maxY int32) ([]*v10.ChunkSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChunkSummaries", ctx, worldID, minX, maxX, minY, maxY)
+	ret0, _ := ret[0].([]*v10.ChunkSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChunkSummaries indicates an expected call of GetChunkSummaries.
+func (mr *MockChunkSummaryServiceMockRecorder) GetChunkSummaries(ctx, worldID, minX, maxX, minY, maxY any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChunkSummaries", reflect.TypeOf((*MockChunkSummaryService)(nil).GetChunkSummaries), ctx, worldID, minX, maxX, minY, maxY)
+}
+
+// MockRegionService is a mock of RegionService interface.
+type MockRegionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRegionServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockRegionServiceMockRecorder is the mock recorder for MockRegionService.
+type MockRegionServiceMockRecorder struct {
+	mock *MockRegionService
+}
+
+// NewMockRegionService creates a new mock instance.
+func NewMockRegionService(ctrl *gomock.Controller) *MockRegionService {
+	mock := &MockRegionService{ctrl: ctrl}
+	mock.recorder = &MockRegionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRegionService) EXPECT() *MockRegionServiceMockRecorder {
+	return m.recorder
+}
+
+// RegionsInChunks mocks base method.
+func (m *MockRegionService) RegionsInChunks(ctx context.Context, worldID pgtype.UUID, minX, maxX, minY, maxY int32) ([]*v10.ProtectedRegion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegionsInChunks", ctx, worldID, minX, maxX, minY, maxY)
+	ret0, _ := ret[0].([]*v10.ProtectedRegion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegionsInChunks indicates an expected call of RegionsInChunks.
+func (mr *MockRegionServiceMockRecorder) RegionsInChunks(ctx, worldID, minX, maxX, minY, maxY any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegionsInChunks", reflect.TypeOf((*MockRegionService)(nil).RegionsInChunks), ctx, worldID, minX, maxX, minY, maxY)
+}
+
 // MockResourceNodeService is a mock of ResourceNodeService interface.
 type MockResourceNodeService struct {
 	ctrl     *gomock.Controller
@@ -706,6 +830,21 @@ func (mr *MockResourceNodeServiceMockRecorder) GetResourcesForChunks(ctx, chunks
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourcesForChunks", reflect.TypeOf((*MockResourceNodeService)(nil).GetResourcesForChunks), ctx, chunks)
 }
 
+// ReloadBalanceConfig mocks base method.
+func (m *MockResourceNodeService) ReloadBalanceConfig(ctx context.Context) (*v11.ReloadBalanceConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReloadBalanceConfig", ctx)
+	ret0, _ := ret[0].(*v11.ReloadBalanceConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReloadBalanceConfig indicates an expected call of ReloadBalanceConfig.
+func (mr *MockResourceNodeServiceMockRecorder) ReloadBalanceConfig(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReloadBalanceConfig", reflect.TypeOf((*MockResourceNodeService)(nil).ReloadBalanceConfig), ctx)
+}
+
 // MockLoggerInterface is a mock of LoggerInterface interface.
 type MockLoggerInterface struct {
 	ctrl     *gomock.Controller
@@ -0,0 +1,169 @@
+package testutil
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/VoidMesh/api/api/db"
+)
+
+// RecordedQuery is one statement a QueryRecorder passed to the database
+type RecordedQuery struct {
+	// Name is the sqlc query name, or the first line of the SQL for hand-written statements
+	// and COPY <table> for bulk inserts
+	Name     string
+	SQL      string
+	Duration time.Duration // Until Exec, Query or QueryRow returned, so rows read later are not counted
+}
+
+// QueryRecorder wraps a db.DBTX and records every statement run through it, so tests
+// can check how many queries a call makes. Build the queries under test with
+// db.New(recorder). It is safe for concurrent use.
+type QueryRecorder struct {
+	db      db.DBTX
+	mu      sync.Mutex
+	queries []RecordedQuery
+}
+
+// NewQueryRecorder wraps a connection, pool or mock pool
+func NewQueryRecorder(inner db.DBTX) *QueryRecorder {
+	return &QueryRecorder{db: inner}
+}
+
+func (r *QueryRecorder) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	start := time.Now()
+	tag, err := r.db.Exec(ctx, sql, args...)
+	r.record(sql, time.Since(start))
+	return tag, err
+}
+
+func (r *QueryRecorder) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	start := time.Now()
+	rows, err := r.db.Query(ctx, sql, args...)
+	r.record(sql, time.Since(start))
+	return rows, err
+}
+
+func (r *QueryRecorder) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	start := time.Now()
+	row := r.db.QueryRow(ctx, sql, args...)
+	r.record(sql, time.Since(start))
+	return row
+}
+
+// CopyFrom is recorded as "COPY <table>", the statement pgx runs
+func (r *QueryRecorder) CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
+	start := time.Now()
+	n, err := r.db.CopyFrom(ctx, tableName, columnNames, rowSrc)
+	r.record("COPY "+tableName.Sanitize(), time.Since(start))
+	return n, err
+}
+
+func (r *QueryRecorder) record(sql string, duration time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.queries = append(r.queries, RecordedQuery{Name: queryName(sql), SQL: sql, Duration: duration})
+}
+
+// queryName extracts the name sqlc puts on the first line of generated SQL
+// ("-- name: GetItem :one")
+func queryName(sql string) string {
+	first, _, _ := strings.Cut(strings.TrimSpace(sql), "\n")
+	if name, ok := strings.CutPrefix(first, "-- name: "); ok {
+		name, _, _ = strings.Cut(name, " ")
+		return name
+	}
+	return first
+}
+
+// Queries returns the statements recorded since the last Reset
+func (r *QueryRecorder) Queries() []RecordedQuery {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]RecordedQuery(nil), r.queries...)
+}
+
+// Reset forgets the recorded statements
+func (r *QueryRecorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.queries = nil
+}
+
+// QueryBudget limits the statements one call may run. Zero fields are not checked.
+type QueryBudget struct {
+	// MaxQueries caps the statements per call
+	MaxQueries int
+	// MaxRepeats caps how often the same named query may run per call; a query run once
+	// per row of an earlier result (an N+1 pattern) exceeds it as the data grows
+	MaxRepeats int
+	// SlowQuery fails any single statement taking at least this long
+	SlowQuery time.Duration
+}
+
+// DefaultQueryBudget suits a single RPC: a handful of statements, none repeated more
+// than twice and none slower than 100ms
+func DefaultQueryBudget() QueryBudget {
+	return QueryBudget{MaxQueries: 10, MaxRepeats: 2, SlowQuery: 100 * time.Millisecond}
+}
+
+// AssertQueryBudget runs call, typically one handler or service method, and fails the
+// test when the statements it ran through the recorder exceed the budget. The failure
+// lists the statements run so the offending pattern is visible.
+func AssertQueryBudget(t testing.TB, recorder *QueryRecorder, budget QueryBudget, call func()) bool {
+	t.Helper()
+	recorder.Reset()
+	call()
+	queries := recorder.Queries()
+
+	var problems []string
+	if budget.MaxQueries > 0 && len(queries) > budget.MaxQueries {
+		problems = append(problems, fmt.Sprintf("ran %d queries, budget is %d", len(queries), budget.MaxQueries))
+	}
+	if budget.MaxRepeats > 0 {
+		counts := make(map[string]int)
+		for _, q := range queries {
+			counts[q.Name]++
+		}
+		names := make([]string, 0, len(counts))
+		for name := range counts {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if counts[name] > budget.MaxRepeats {
+				problems = append(problems, fmt.Sprintf("ran %s %d times, at most %d repeats allowed (N+1?)", name, counts[name], budget.MaxRepeats))
+			}
+		}
+	}
+	if budget.SlowQuery > 0 {
+		for _, q := range queries {
+			if q.Duration >= budget.SlowQuery {
+				problems = append(problems, fmt.Sprintf("%s took %s, slow query threshold is %s", q.Name, q.Duration, budget.SlowQuery))
+			}
+		}
+	}
+	if len(problems) == 0 {
+		return true
+	}
+
+	var report strings.Builder
+	report.WriteString("query budget exceeded:\n")
+	for _, problem := range problems {
+		fmt.Fprintf(&report, "  - %s\n", problem)
+	}
+	report.WriteString("statements run:\n")
+	for i, q := range queries {
+		fmt.Fprintf(&report, "  %d. %s (%s)\n", i+1, q.Name, q.Duration)
+	}
+	t.Errorf("%s", report.String())
+	return false
+}
@@ -0,0 +1,79 @@
+package testutil_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/testutil"
+)
+
+// budgetT captures a budget failure instead of failing the surrounding test
+type budgetT struct {
+	testing.TB
+	failure string
+}
+
+func (b *budgetT) Errorf(format string, args ...interface{}) {
+	b.failure = fmt.Sprintf(format, args...)
+}
+
+func expectItem(mock pgxmock.PgxPoolIface, id int32) {
+	mock.ExpectQuery("SELECT (.+) FROM items WHERE id = \\$1").
+		WithArgs(id).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "name", "description", "item_type", "rarity", "stack_size", "visual_data", "created_at"}).
+			AddRow(id, "Stone", "", "material", "common", int32(64), []byte("{}"), nil))
+}
+
+func TestAssertQueryBudget(t *testing.T) {
+	mock := testutil.GetMockPool(t)
+	recorder := testutil.NewQueryRecorder(mock)
+	queries := db.New(recorder)
+	ctx := context.Background()
+
+	// Looking items up one at a time is the N+1 pattern the budget catches
+	lookupEach := func(ids ...int32) func() {
+		return func() {
+			for _, id := range ids {
+				_, err := queries.GetItem(ctx, id)
+				require.NoError(t, err)
+			}
+		}
+	}
+
+	expectItem(mock, 1)
+	assert.True(t, testutil.AssertQueryBudget(t, recorder, testutil.DefaultQueryBudget(), lookupEach(1)))
+	require.Len(t, recorder.Queries(), 1)
+	assert.Equal(t, "GetItem", recorder.Queries()[0].Name)
+
+	for id := int32(1); id <= 3; id++ {
+		expectItem(mock, id)
+	}
+	bt := &budgetT{TB: t}
+	assert.False(t, testutil.AssertQueryBudget(bt, recorder, testutil.DefaultQueryBudget(), lookupEach(1, 2, 3)))
+	assert.Contains(t, bt.failure, "ran GetItem 3 times, at most 2 repeats allowed")
+	assert.Contains(t, bt.failure, "3. GetItem")
+
+	for id := int32(1); id <= 3; id++ {
+		expectItem(mock, id)
+	}
+	bt = &budgetT{TB: t}
+	assert.False(t, testutil.AssertQueryBudget(bt, recorder, testutil.QueryBudget{MaxQueries: 2}, lookupEach(1, 2, 3)))
+	assert.Contains(t, bt.failure, "ran 3 queries, budget is 2")
+
+	mock.ExpectExec("UPDATE items").WillReturnResult(pgxmock.NewResult("UPDATE", 1)).WillDelayFor(20 * time.Millisecond)
+	bt = &budgetT{TB: t}
+	assert.False(t, testutil.AssertQueryBudget(bt, recorder, testutil.QueryBudget{SlowQuery: 10 * time.Millisecond}, func() {
+		_, err := recorder.Exec(ctx, "UPDATE items SET name = 'x'")
+		require.NoError(t, err)
+	}))
+	assert.Contains(t, bt.failure, "UPDATE items SET name = 'x' took", "hand-written statements are named by their SQL")
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
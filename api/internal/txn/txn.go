@@ -0,0 +1,109 @@
+// Package txn runs multi-statement database flows in a transaction at the isolation level
+// the flow needs, retrying the whole transaction when Postgres aborts it with a
+// serialization failure or a deadlock.
+//
+// Read committed (Postgres' default) is enough for flows whose statements do not depend
+// on what an earlier statement read, such as a mutation plus its outbox event. Flows that
+// read and then write based on what they read (check-then-insert, delete-then-recreate,
+// moving items between two inventories) must run repeatable read or serializable, or two
+// concurrent runs can interleave into a state neither would produce alone.
+package txn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Postgres error codes of transactions that are safe to run again from the start
+const (
+	SerializationFailure = "40001"
+	DeadlockDetected     = "40P01"
+)
+
+// maxBackoff caps the wait between attempts of policies allowing many retries
+const maxBackoff = 250 * time.Millisecond
+
+// Beginner starts transactions; *pgxpool.Pool, *sqlite.DB and pgxmock pools implement it
+type Beginner interface {
+	BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error)
+}
+
+// Policy is the isolation level a flow runs at and how often it is retried
+type Policy struct {
+	Isolation   pgx.TxIsoLevel
+	MaxAttempts int           // Total attempts, including the first
+	Backoff     time.Duration // Wait before the second attempt, doubled for each one after
+}
+
+var (
+	// ReadCommitted suits flows whose writes do not depend on their own reads
+	ReadCommitted = Policy{Isolation: pgx.ReadCommitted, MaxAttempts: 3, Backoff: 5 * time.Millisecond}
+	// RepeatableRead suits flows that read a consistent snapshot before writing
+	RepeatableRead = Policy{Isolation: pgx.RepeatableRead, MaxAttempts: 5, Backoff: 5 * time.Millisecond}
+	// Serializable suits read-then-write flows that must behave as if run one at a time
+	Serializable = Policy{Isolation: pgx.Serializable, MaxAttempts: 5, Backoff: 5 * time.Millisecond}
+)
+
+// Run runs fn with queries bound to a transaction at the policy's isolation level and
+// commits it. If the transaction fails with a serialization failure or deadlock, it is
+// rolled back and fn runs again, so fn must not have side effects outside the database.
+func Run(ctx context.Context, pool Beginner, policy Policy, fn func(q *db.Queries) error) error {
+	return Do(ctx, pool, policy, func(tx pgx.Tx) error {
+		return fn(db.New(tx))
+	})
+}
+
+// Do is Run for flows that need the transaction itself rather than generated queries
+func Do(ctx context.Context, pool Beginner, policy Policy, fn func(tx pgx.Tx) error) error {
+	attempts := max(policy.MaxAttempts, 1)
+	backoff := policy.Backoff
+
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if err = attemptOnce(ctx, pool, policy.Isolation, fn); err == nil || !IsRetryable(err) {
+			return err
+		}
+		if attempt == attempts {
+			break
+		}
+		if ctx.Err() != nil {
+			return errors.Join(ctx.Err(), err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Join(ctx.Err(), err)
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, maxBackoff)
+	}
+	return fmt.Errorf("transaction failed after %d attempts: %w", attempts, err)
+}
+
+func attemptOnce(ctx context.Context, pool Beginner, isolation pgx.TxIsoLevel, fn func(tx pgx.Tx) error) error {
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{IsoLevel: isolation})
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// IsRetryable reports whether err aborted a transaction that may succeed if run again
+func IsRetryable(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+	return pgErr.Code == SerializationFailure || pgErr.Code == DeadlockDetected
+}
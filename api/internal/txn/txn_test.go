@@ -0,0 +1,242 @@
+package txn
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var fastRetry = Policy{Isolation: pgx.Serializable, MaxAttempts: 3}
+
+func TestDo_BeginsAtPolicyIsolation(t *testing.T) {
+	for _, policy := range []Policy{ReadCommitted, RepeatableRead, Serializable} {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+
+		mock.ExpectBeginTx(pgx.TxOptions{IsoLevel: policy.Isolation})
+		mock.ExpectExec("UPDATE counters").WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+		mock.ExpectCommit()
+
+		err = Do(context.Background(), mock, policy, func(tx pgx.Tx) error {
+			_, err := tx.Exec(context.Background(), "UPDATE counters SET value = value + 1")
+			return err
+		})
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet(), "isolation %s", policy.Isolation)
+		mock.Close()
+	}
+}
+
+func TestDo_RetriesSerializationFailures(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(mock pgxmock.PgxPoolIface)
+	}{
+		{
+			name: "statement aborted",
+			setup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBeginTx(pgx.TxOptions{IsoLevel: pgx.Serializable})
+				mock.ExpectExec("UPDATE counters").WillReturnError(&pgconn.PgError{Code: SerializationFailure})
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name: "commit aborted",
+			setup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBeginTx(pgx.TxOptions{IsoLevel: pgx.Serializable})
+				mock.ExpectExec("UPDATE counters").WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectCommit().WillReturnError(&pgconn.PgError{Code: SerializationFailure})
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name: "deadlock",
+			setup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBeginTx(pgx.TxOptions{IsoLevel: pgx.Serializable})
+				mock.ExpectExec("UPDATE counters").WillReturnError(&pgconn.PgError{Code: DeadlockDetected})
+				mock.ExpectRollback()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.setup(mock)
+			mock.ExpectBeginTx(pgx.TxOptions{IsoLevel: pgx.Serializable})
+			mock.ExpectExec("UPDATE counters").WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			mock.ExpectCommit()
+
+			attempts := 0
+			err = Do(context.Background(), mock, fastRetry, func(tx pgx.Tx) error {
+				attempts++
+				_, err := tx.Exec(context.Background(), "UPDATE counters SET value = value + 1")
+				return err
+			})
+			require.NoError(t, err)
+			assert.Equal(t, 2, attempts)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDo_DoesNotRetryOtherErrors(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	mock.ExpectBeginTx(pgx.TxOptions{IsoLevel: pgx.Serializable})
+	mock.ExpectExec("INSERT INTO counters").WillReturnError(&pgconn.PgError{Code: "23505"})
+	mock.ExpectRollback()
+
+	attempts := 0
+	err = Do(context.Background(), mock, fastRetry, func(tx pgx.Tx) error {
+		attempts++
+		_, err := tx.Exec(context.Background(), "INSERT INTO counters (value) VALUES (1)")
+		return err
+	})
+
+	var pgErr *pgconn.PgError
+	require.ErrorAs(t, err, &pgErr)
+	assert.Equal(t, "23505", pgErr.Code)
+	assert.Equal(t, 1, attempts)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDo_GivesUpAfterMaxAttempts(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	for range fastRetry.MaxAttempts {
+		mock.ExpectBeginTx(pgx.TxOptions{IsoLevel: pgx.Serializable})
+		mock.ExpectRollback()
+	}
+
+	attempts := 0
+	err = Do(context.Background(), mock, fastRetry, func(tx pgx.Tx) error {
+		attempts++
+		return &pgconn.PgError{Code: SerializationFailure}
+	})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "after 3 attempts")
+	assert.True(t, IsRetryable(err), "the cause stays inspectable")
+	assert.Equal(t, fastRetry.MaxAttempts, attempts)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDo_StopsRetryingWhenContextIsDone(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	mock.ExpectBeginTx(pgx.TxOptions{IsoLevel: pgx.Serializable})
+	mock.ExpectRollback()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	err = Do(ctx, mock, Policy{Isolation: pgx.Serializable, MaxAttempts: 5}, func(tx pgx.Tx) error {
+		cancel()
+		return &pgconn.PgError{Code: SerializationFailure}
+	})
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRun_BindsQueriesToTransaction(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	mock.ExpectBeginTx(pgx.TxOptions{IsoLevel: pgx.RepeatableRead})
+	mock.ExpectExec("DELETE FROM resource_nodes").
+		WithArgs(pgxmock.AnyArg(), int32(1), int32(2)).
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+	mock.ExpectCommit()
+
+	err = Run(context.Background(), mock, RepeatableRead, func(q *db.Queries) error {
+		return q.DeleteResourceNodesInChunk(context.Background(), db.DeleteResourceNodesInChunkParams{ChunkX: 1, ChunkY: 2})
+	})
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestIsRetryable(t *testing.T) {
+	assert.True(t, IsRetryable(&pgconn.PgError{Code: SerializationFailure}))
+	assert.True(t, IsRetryable(&pgconn.PgError{Code: DeadlockDetected}))
+	assert.True(t, IsRetryable(errors.Join(errors.New("wrapped"), &pgconn.PgError{Code: SerializationFailure})))
+	assert.False(t, IsRetryable(&pgconn.PgError{Code: "23505"}))
+	assert.False(t, IsRetryable(errors.New("connection reset")))
+	assert.False(t, IsRetryable(nil))
+}
+
+// counterStore is an optimistic in-memory store: a transaction that commits after another
+// one changed the counter fails the way Postgres fails a serializable transaction.
+type counterStore struct {
+	mu      sync.Mutex
+	value   int
+	version int
+}
+
+func (s *counterStore) BeginTx(ctx context.Context, _ pgx.TxOptions) (pgx.Tx, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return &counterTx{store: s, value: s.value, version: s.version}, nil
+}
+
+type counterTx struct {
+	pgx.Tx
+	store   *counterStore
+	value   int
+	version int
+}
+
+func (t *counterTx) Commit(ctx context.Context) error {
+	t.store.mu.Lock()
+	defer t.store.mu.Unlock()
+	if t.store.version != t.version {
+		return &pgconn.PgError{Code: SerializationFailure}
+	}
+	t.store.value = t.value
+	t.store.version++
+	return nil
+}
+
+func (t *counterTx) Rollback(ctx context.Context) error {
+	return nil
+}
+
+func TestDo_ConcurrentReadModifyWriteLosesNoUpdates(t *testing.T) {
+	store := &counterStore{}
+	policy := Policy{Isolation: pgx.Serializable, MaxAttempts: 1000}
+
+	const workers = 20
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := Do(context.Background(), store, policy, func(tx pgx.Tx) error {
+				counter := tx.(*counterTx)
+				counter.value++ // Read the snapshot, write based on it
+				return nil
+			})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, workers, store.value)
+}
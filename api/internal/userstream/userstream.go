@@ -0,0 +1,98 @@
+// Package userstream fans messages out to the open streams of a user on this instance.
+package userstream
+
+import (
+	"sync"
+
+	"github.com/VoidMesh/api/api/internal/uuid"
+)
+
+// Subscription is one open stream. Messages are delivered on C until it is unsubscribed.
+type Subscription[T any] struct {
+	C <-chan T
+
+	ch  chan T
+	key string
+}
+
+// Hub tracks subscriptions by user ID. It is safe for concurrent use.
+type Hub[T any] struct {
+	mu   sync.RWMutex
+	subs map[string]map[*Subscription[T]]struct{}
+}
+
+// NewHub creates an empty hub
+func NewHub[T any]() *Hub[T] {
+	return &Hub[T]{subs: make(map[string]map[*Subscription[T]]struct{})}
+}
+
+// Subscribe opens a stream for the user; buffer sizes its channel
+func (h *Hub[T]) Subscribe(userID string, buffer int) *Subscription[T] {
+	ch := make(chan T, buffer)
+	sub := &Subscription[T]{C: ch, ch: ch, key: uuid.Normalize(userID)}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.subs[sub.key] == nil {
+		h.subs[sub.key] = make(map[*Subscription[T]]struct{})
+	}
+	h.subs[sub.key][sub] = struct{}{}
+	return sub
+}
+
+// Unsubscribe closes the subscription's channel. It is safe to call more than once.
+func (h *Hub[T]) Unsubscribe(sub *Subscription[T]) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.subs[sub.key][sub]; !ok {
+		return
+	}
+	delete(h.subs[sub.key], sub)
+	if len(h.subs[sub.key]) == 0 {
+		delete(h.subs, sub.key)
+	}
+	close(sub.ch)
+}
+
+// Publish queues msg on every open stream of the user without blocking and returns how
+// many accepted it. Streams whose buffer is full miss the message.
+func (h *Hub[T]) Publish(userID string, msg T) int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	delivered := 0
+	for sub := range h.subs[uuid.Normalize(userID)] {
+		select {
+		case sub.ch <- msg:
+			delivered++
+		default:
+		}
+	}
+	return delivered
+}
+
+// Broadcast queues msg on every open stream of every user, like Publish
+func (h *Hub[T]) Broadcast(msg T) int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	delivered := 0
+	for _, subs := range h.subs {
+		for sub := range subs {
+			select {
+			case sub.ch <- msg:
+				delivered++
+			default:
+			}
+		}
+	}
+	return delivered
+}
+
+// Len returns the number of open subscriptions
+func (h *Hub[T]) Len() int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	n := 0
+	for _, subs := range h.subs {
+		n += len(subs)
+	}
+	return n
+}
@@ -0,0 +1,35 @@
+package userstream
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHub(t *testing.T) {
+	hub := NewHub[int]()
+	first := hub.Subscribe("0b3c1a6e-7f2d-4c1b-9a8e-5d6f7a8b9c0d", 1)
+	second := hub.Subscribe("0b3c1a6e7f2d4c1b9a8e5d6f7a8b9c0d", 1)
+	assert.Equal(t, 2, hub.Len())
+
+	assert.Equal(t, 2, hub.Publish("0b3c1a6e-7f2d-4c1b-9a8e-5d6f7a8b9c0d", 1), "dashed and undashed IDs match")
+	assert.Equal(t, 0, hub.Publish("0b3c1a6e-7f2d-4c1b-9a8e-5d6f7a8b9c0d", 2), "full buffers drop the message")
+	assert.Equal(t, 0, hub.Publish("someone-else", 3))
+	assert.Equal(t, 1, <-first.C)
+
+	hub.Unsubscribe(first)
+	hub.Unsubscribe(first)
+	_, ok := <-first.C
+	assert.False(t, ok)
+	assert.Equal(t, 1, hub.Len())
+
+	assert.Equal(t, 1, <-second.C)
+	third := hub.Subscribe("someone-else", 1)
+	assert.Equal(t, 2, hub.Broadcast(4), "broadcasts reach every user")
+	assert.Equal(t, 4, <-second.C)
+	assert.Equal(t, 4, <-third.C)
+	hub.Unsubscribe(third)
+
+	hub.Unsubscribe(second)
+	assert.Equal(t, 0, hub.Len())
+}
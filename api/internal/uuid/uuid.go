@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"strings"
 
+	"github.com/VoidMesh/api/api/pkg/uuidutil"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 )
@@ -24,24 +25,17 @@ func ParseToHexString(uuidStr string) (string, error) {
 }
 
 // StringToPgtype converts UUID string to pgtype.UUID
+//
+// Deprecated: use uuidutil.Parse.
 func StringToPgtype(uuidStr string) (pgtype.UUID, error) {
-	parsed, err := uuid.Parse(uuidStr)
-	if err != nil {
-		return pgtype.UUID{}, err
-	}
-	
-	var pgUUID pgtype.UUID
-	copy(pgUUID.Bytes[:], parsed[:])
-	pgUUID.Valid = true
-	return pgUUID, nil
+	return uuidutil.Parse(uuidStr)
 }
 
 // PgtypeToString converts pgtype.UUID to standard string format with dashes
+//
+// Deprecated: use uuidutil.String.
 func PgtypeToString(pgUUID pgtype.UUID) string {
-	if !pgUUID.Valid {
-		return ""
-	}
-	return uuid.UUID(pgUUID.Bytes).String()
+	return uuidutil.String(pgUUID)
 }
 
 // PgtypeToNormalizedString converts pgtype.UUID to normalized string format without dashes
@@ -0,0 +1,94 @@
+// Package weather derives each world's weather and time of day from the clock. Weather
+// is drawn from an rng.Weather stream keyed by the world seed and the hour, so every
+// instance agrees on it without storing anything; it changes on the hour.
+package weather
+
+import (
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/rng"
+)
+
+// Period is how long one weather draw lasts
+const Period = time.Hour
+
+// Condition is the weather in a world
+type Condition int
+
+const (
+	Clear Condition = iota
+	Cloudy
+	Rain
+	Storm
+)
+
+// weights are how often each condition is drawn, in Condition order
+var weights = [...]int{Clear: 45, Cloudy: 30, Rain: 18, Storm: 7}
+
+func (c Condition) String() string {
+	switch c {
+	case Clear:
+		return "clear"
+	case Cloudy:
+		return "cloudy"
+	case Rain:
+		return "rain"
+	case Storm:
+		return "storm"
+	}
+	return "unknown"
+}
+
+// TimeOfDay is the part of the day by UTC hour
+type TimeOfDay int
+
+const (
+	Dawn  TimeOfDay = iota // 05:00 to 07:00
+	Day                    // 07:00 to 18:00
+	Dusk                   // 18:00 to 20:00
+	Night                  // 20:00 to 05:00
+)
+
+func (t TimeOfDay) String() string {
+	switch t {
+	case Dawn:
+		return "dawn"
+	case Day:
+		return "day"
+	case Dusk:
+		return "dusk"
+	case Night:
+		return "night"
+	}
+	return "unknown"
+}
+
+// At returns the weather of the world with the given seed at t
+func At(seed int64, t time.Time) Condition {
+	total := 0
+	for _, w := range weights {
+		total += w
+	}
+	roll := rng.New(seed, rng.Weather, t.Unix()/int64(Period/time.Second)).Intn(total)
+	for c, w := range weights {
+		if roll < w {
+			return Condition(c)
+		}
+		roll -= w
+	}
+	return Clear
+}
+
+// TimeOfDayAt returns the time of day at t
+func TimeOfDayAt(t time.Time) TimeOfDay {
+	switch hour := t.UTC().Hour(); {
+	case hour >= 5 && hour < 7:
+		return Dawn
+	case hour >= 7 && hour < 18:
+		return Day
+	case hour >= 18 && hour < 20:
+		return Dusk
+	default:
+		return Night
+	}
+}
@@ -0,0 +1,42 @@
+package weather
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAt(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, At(7, start), At(7, start.Add(59*time.Minute)), "weather holds for the period")
+
+	counts := make(map[Condition]int)
+	for hour := range 2000 {
+		counts[At(7, start.Add(time.Duration(hour)*Period))]++
+	}
+	assert.Len(t, counts, 4, "every condition occurs")
+	assert.Greater(t, counts[Clear], counts[Storm], "storms are rarer than clear skies")
+
+	differs := false
+	for hour := range 24 {
+		at := start.Add(time.Duration(hour) * Period)
+		if At(7, at) != At(8, at) {
+			differs = true
+		}
+	}
+	assert.True(t, differs, "worlds have their own weather")
+}
+
+func TestTimeOfDayAt(t *testing.T) {
+	tests := []struct {
+		hour int
+		want TimeOfDay
+	}{
+		{0, Night}, {4, Night}, {5, Dawn}, {6, Dawn}, {7, Day}, {17, Day}, {18, Dusk}, {19, Dusk}, {20, Night}, {23, Night},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, TimeOfDayAt(time.Date(2025, 1, 1, tt.hour, 30, 0, 0, time.UTC)), "hour %d", tt.hour)
+	}
+}
@@ -0,0 +1,84 @@
+package worldexport
+
+import (
+	"encoding/json"
+	"io"
+)
+
+type geoJSONGeometry struct {
+	Type        string `json:"type"`
+	Coordinates any    `json:"coordinates"`
+}
+
+type geoJSONFeature struct {
+	Type       string          `json:"type"`
+	Geometry   geoJSONGeometry `json:"geometry"`
+	Properties map[string]any  `json:"properties"`
+}
+
+// writeGeoJSON writes a FeatureCollection in world cell coordinates (not longitude and
+// latitude): one MultiPolygon per terrain type, built from runs of equal cells along
+// each row, and one Point per resource node at the centre of its cell
+func writeGeoJSON(w io.Writer, r *Region) error {
+	runs := make(map[int32][][][][2]int32)
+	for y := int32(0); y < r.Height(); y++ {
+		for x := int32(0); x < r.Width(); {
+			terrain := r.terrainAt(x, y)
+			end := x + 1
+			for end < r.Width() && r.terrainAt(end, y) == terrain {
+				end++
+			}
+			if terrain != 0 {
+				x0, x1 := r.OriginX()+x, r.OriginX()+end
+				y0, y1 := r.OriginY()+y, r.OriginY()+y+1
+				runs[int32(terrain)] = append(runs[int32(terrain)], [][][2]int32{{{x0, y0}, {x1, y0}, {x1, y1}, {x0, y1}, {x0, y0}}})
+			}
+			x = end
+		}
+	}
+
+	var features []geoJSONFeature
+	for _, terrain := range terrainTypes {
+		polygons, ok := runs[int32(terrain)]
+		if !ok {
+			continue
+		}
+		features = append(features, geoJSONFeature{
+			Type:     "Feature",
+			Geometry: geoJSONGeometry{Type: "MultiPolygon", Coordinates: polygons},
+			Properties: map[string]any{
+				"layer":        "terrain",
+				"terrain":      terrainName(terrain),
+				"terrain_type": int32(terrain),
+			},
+		})
+	}
+	for _, node := range r.resources {
+		features = append(features, geoJSONFeature{
+			Type:     "Feature",
+			Geometry: geoJSONGeometry{Type: "Point", Coordinates: [2]float64{float64(node.X) + 0.5, float64(node.Y) + 0.5}},
+			Properties: map[string]any{
+				"layer":                 "resources",
+				"resource":              resourceName(node),
+				"resource_node_id":      node.Id,
+				"resource_node_type_id": int32(node.ResourceNodeTypeId),
+				"cluster_id":            node.ClusterId,
+				"size":                  node.Size,
+				"chunk_x":               node.ChunkX,
+				"chunk_y":               node.ChunkY,
+			},
+		})
+	}
+
+	return json.NewEncoder(w).Encode(map[string]any{
+		"type":     "FeatureCollection",
+		"features": features,
+		"properties": map[string]any{
+			"world":       r.WorldName,
+			"min_chunk_x": r.MinChunkX,
+			"min_chunk_y": r.MinChunkY,
+			"max_chunk_x": r.MaxChunkX,
+			"max_chunk_y": r.MaxChunkY,
+		},
+	})
+}
@@ -0,0 +1,315 @@
+package worldexport
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// tiledVersion is the Tiled map format version written
+const tiledVersion = "1.10"
+
+// tilesetImage is the image the terrain tileset refers to: one TileSize square per
+// terrain type, left to right in terrainTypes order. Designers provide it next to the
+// exported map; without it Tiled still opens the map and shows tile IDs.
+const tilesetImage = "terrain.png"
+
+// Layer IDs; object IDs start after the layers
+const (
+	terrainLayerID  = 1
+	resourceLayerID = 2
+)
+
+type tiledProperty struct {
+	Name  string `json:"name" xml:"name,attr"`
+	Type  string `json:"type" xml:"type,attr,omitempty"`
+	Value any    `json:"value" xml:"value,attr"`
+}
+
+type tiledObject struct {
+	ID         int             `json:"id"`
+	Name       string          `json:"name"`
+	Type       string          `json:"type"`
+	X          int32           `json:"x"`
+	Y          int32           `json:"y"`
+	Width      int32           `json:"width"`
+	Height     int32           `json:"height"`
+	Rotation   int             `json:"rotation"`
+	Visible    bool            `json:"visible"`
+	Properties []tiledProperty `json:"properties"`
+}
+
+// mapProperties records where the map sits in the world
+func mapProperties(r *Region) []tiledProperty {
+	return []tiledProperty{
+		{Name: "world", Type: "string", Value: r.WorldName},
+		{Name: "origin_x", Type: "int", Value: r.OriginX()},
+		{Name: "origin_y", Type: "int", Value: r.OriginY()},
+		{Name: "min_chunk_x", Type: "int", Value: r.MinChunkX},
+		{Name: "min_chunk_y", Type: "int", Value: r.MinChunkY},
+		{Name: "max_chunk_x", Type: "int", Value: r.MaxChunkX},
+		{Name: "max_chunk_y", Type: "int", Value: r.MaxChunkY},
+	}
+}
+
+// terrainGIDs returns the terrain layer's global tile IDs, row-major. Tile IDs follow
+// the terrain enum, so a cell's GID is its terrain type and unknown terrain is empty.
+func terrainGIDs(r *Region) []int {
+	gids := make([]int, len(r.terrain))
+	for i, terrain := range r.terrain {
+		gids[i] = int(terrain)
+	}
+	return gids
+}
+
+// resourceObjects places each resource node on its cell
+func resourceObjects(r *Region) []tiledObject {
+	objects := make([]tiledObject, 0, len(r.resources))
+	for i, node := range r.resources {
+		name := resourceName(node)
+		objects = append(objects, tiledObject{
+			ID:      resourceLayerID + 1 + i,
+			Name:    name,
+			Type:    name,
+			X:       (node.X - r.OriginX()) * TileSize,
+			Y:       (node.Y - r.OriginY()) * TileSize,
+			Width:   TileSize,
+			Height:  TileSize,
+			Visible: true,
+			Properties: []tiledProperty{
+				{Name: "resource_node_id", Type: "int", Value: node.Id},
+				{Name: "resource_node_type_id", Type: "int", Value: int32(node.ResourceNodeTypeId)},
+				{Name: "cluster_id", Type: "string", Value: node.ClusterId},
+				{Name: "size", Type: "int", Value: node.Size},
+			},
+		})
+	}
+	return objects
+}
+
+func writeTiledJSON(w io.Writer, r *Region) error {
+	tiles := make([]map[string]any, 0, len(terrainTypes))
+	for i, terrain := range terrainTypes {
+		tiles = append(tiles, map[string]any{
+			"id":         i,
+			"type":       terrainName(terrain),
+			"properties": []tiledProperty{{Name: "terrain_type", Type: "int", Value: int32(terrain)}},
+		})
+	}
+	objects := resourceObjects(r)
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(map[string]any{
+		"type":         "map",
+		"version":      tiledVersion,
+		"orientation":  "orthogonal",
+		"renderorder":  "right-down",
+		"width":        r.Width(),
+		"height":       r.Height(),
+		"tilewidth":    TileSize,
+		"tileheight":   TileSize,
+		"infinite":     false,
+		"nextlayerid":  resourceLayerID + 1,
+		"nextobjectid": resourceLayerID + 1 + len(objects),
+		"properties":   mapProperties(r),
+		"tilesets": []map[string]any{{
+			"firstgid":    1,
+			"name":        "terrain",
+			"tilewidth":   TileSize,
+			"tileheight":  TileSize,
+			"tilecount":   len(terrainTypes),
+			"columns":     len(terrainTypes),
+			"margin":      0,
+			"spacing":     0,
+			"image":       tilesetImage,
+			"imagewidth":  TileSize * len(terrainTypes),
+			"imageheight": TileSize,
+			"tiles":       tiles,
+		}},
+		"layers": []map[string]any{
+			{
+				"id":      terrainLayerID,
+				"name":    "terrain",
+				"type":    "tilelayer",
+				"x":       0,
+				"y":       0,
+				"width":   r.Width(),
+				"height":  r.Height(),
+				"opacity": 1,
+				"visible": true,
+				"data":    terrainGIDs(r),
+			},
+			{
+				"id":        resourceLayerID,
+				"name":      "resources",
+				"type":      "objectgroup",
+				"x":         0,
+				"y":         0,
+				"opacity":   1,
+				"visible":   true,
+				"draworder": "topdown",
+				"objects":   objects,
+			},
+		},
+	})
+}
+
+// TMX element types
+
+type tmxProperties struct {
+	Properties []tiledProperty `xml:"property"`
+}
+
+type tmxTile struct {
+	ID         int           `xml:"id,attr"`
+	Type       string        `xml:"type,attr"`
+	Properties tmxProperties `xml:"properties"`
+}
+
+type tmxImage struct {
+	Source string `xml:"source,attr"`
+	Width  int    `xml:"width,attr"`
+	Height int    `xml:"height,attr"`
+}
+
+type tmxTileset struct {
+	FirstGID   int       `xml:"firstgid,attr"`
+	Name       string    `xml:"name,attr"`
+	TileWidth  int       `xml:"tilewidth,attr"`
+	TileHeight int       `xml:"tileheight,attr"`
+	TileCount  int       `xml:"tilecount,attr"`
+	Columns    int       `xml:"columns,attr"`
+	Image      tmxImage  `xml:"image"`
+	Tiles      []tmxTile `xml:"tile"`
+}
+
+type tmxData struct {
+	Encoding string `xml:"encoding,attr"`
+	CSV      string `xml:",chardata"`
+}
+
+type tmxLayer struct {
+	ID     int     `xml:"id,attr"`
+	Name   string  `xml:"name,attr"`
+	Width  int32   `xml:"width,attr"`
+	Height int32   `xml:"height,attr"`
+	Data   tmxData `xml:"data"`
+}
+
+type tmxObject struct {
+	ID         int           `xml:"id,attr"`
+	Name       string        `xml:"name,attr"`
+	Type       string        `xml:"type,attr"`
+	X          int32         `xml:"x,attr"`
+	Y          int32         `xml:"y,attr"`
+	Width      int32         `xml:"width,attr"`
+	Height     int32         `xml:"height,attr"`
+	Properties tmxProperties `xml:"properties"`
+}
+
+type tmxObjectGroup struct {
+	ID      int         `xml:"id,attr"`
+	Name    string      `xml:"name,attr"`
+	Objects []tmxObject `xml:"object"`
+}
+
+type tmxMap struct {
+	XMLName      xml.Name       `xml:"map"`
+	Version      string         `xml:"version,attr"`
+	Orientation  string         `xml:"orientation,attr"`
+	RenderOrder  string         `xml:"renderorder,attr"`
+	Width        int32          `xml:"width,attr"`
+	Height       int32          `xml:"height,attr"`
+	TileWidth    int            `xml:"tilewidth,attr"`
+	TileHeight   int            `xml:"tileheight,attr"`
+	Infinite     int            `xml:"infinite,attr"`
+	NextLayerID  int            `xml:"nextlayerid,attr"`
+	NextObjectID int            `xml:"nextobjectid,attr"`
+	Properties   tmxProperties  `xml:"properties"`
+	Tileset      tmxTileset     `xml:"tileset"`
+	Layer        tmxLayer       `xml:"layer"`
+	ObjectGroup  tmxObjectGroup `xml:"objectgroup"`
+}
+
+func writeTMX(w io.Writer, r *Region) error {
+	tiles := make([]tmxTile, 0, len(terrainTypes))
+	for i, terrain := range terrainTypes {
+		tiles = append(tiles, tmxTile{
+			ID:         i,
+			Type:       terrainName(terrain),
+			Properties: tmxProperties{[]tiledProperty{{Name: "terrain_type", Type: "int", Value: int32(terrain)}}},
+		})
+	}
+
+	// CSV data has one row of tiles per line, as Tiled writes it
+	gids := terrainGIDs(r)
+	var csv strings.Builder
+	csv.WriteString("\n")
+	for i, gid := range gids {
+		csv.WriteString(strconv.Itoa(gid))
+		if i < len(gids)-1 {
+			csv.WriteString(",")
+		}
+		if (i+1)%int(r.Width()) == 0 {
+			csv.WriteString("\n")
+		}
+	}
+
+	objects := resourceObjects(r)
+	tmxObjects := make([]tmxObject, 0, len(objects))
+	for _, object := range objects {
+		tmxObjects = append(tmxObjects, tmxObject{
+			ID:         object.ID,
+			Name:       object.Name,
+			Type:       object.Type,
+			X:          object.X,
+			Y:          object.Y,
+			Width:      object.Width,
+			Height:     object.Height,
+			Properties: tmxProperties{object.Properties},
+		})
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", " ")
+	if err := encoder.Encode(tmxMap{
+		Version:      tiledVersion,
+		Orientation:  "orthogonal",
+		RenderOrder:  "right-down",
+		Width:        r.Width(),
+		Height:       r.Height(),
+		TileWidth:    TileSize,
+		TileHeight:   TileSize,
+		NextLayerID:  resourceLayerID + 1,
+		NextObjectID: resourceLayerID + 1 + len(objects),
+		Properties:   tmxProperties{mapProperties(r)},
+		Tileset: tmxTileset{
+			FirstGID:   1,
+			Name:       "terrain",
+			TileWidth:  TileSize,
+			TileHeight: TileSize,
+			TileCount:  len(terrainTypes),
+			Columns:    len(terrainTypes),
+			Image:      tmxImage{Source: tilesetImage, Width: TileSize * len(terrainTypes), Height: TileSize},
+			Tiles:      tiles,
+		},
+		Layer: tmxLayer{
+			ID:     terrainLayerID,
+			Name:   "terrain",
+			Width:  r.Width(),
+			Height: r.Height(),
+			Data:   tmxData{Encoding: "csv", CSV: csv.String()},
+		},
+		ObjectGroup: tmxObjectGroup{ID: resourceLayerID, Name: "resources", Objects: tmxObjects},
+	}); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
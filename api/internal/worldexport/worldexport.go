@@ -0,0 +1,145 @@
+// Package worldexport renders a rectangle of chunks as files level designers and GIS
+// tools can open: Tiled maps (JSON or TMX) and GeoJSON. Every format carries a terrain
+// layer and a resource node layer.
+//
+// Coordinates are world cell coordinates with y increasing downwards, as in the game.
+// Tiled maps start at the region's top-left cell, which is recorded in the map
+// properties (origin_x, origin_y) so positions can be mapped back to the world.
+package worldexport
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/VoidMesh/api/api/internal/chunkdata"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	resourceNodeV1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
+)
+
+// Export formats
+const (
+	FormatTiledJSON = "tiled-json"
+	FormatTMX       = "tmx"
+	FormatGeoJSON   = "geojson"
+)
+
+// Formats lists every supported format
+var Formats = []string{FormatTiledJSON, FormatTMX, FormatGeoJSON}
+
+// TileSize is the width and height of a cell in Tiled maps, in pixels
+const TileSize = 16
+
+// Region is a rectangle of chunks to export
+type Region struct {
+	WorldName  string
+	MinChunkX  int32
+	MinChunkY  int32
+	MaxChunkX  int32
+	MaxChunkY  int32
+	terrain    []chunkV1.TerrainType // row-major over the region's cells
+	resources  []*resourceNodeV1.ResourceNode
+	widthCells int32
+}
+
+// NewRegion builds a region from every chunk in the rectangle. Chunks may come in any
+// order; a missing or malformed chunk is an error.
+func NewRegion(worldName string, minChunkX, minChunkY, maxChunkX, maxChunkY int32, chunks []*chunkV1.ChunkData) (*Region, error) {
+	if maxChunkX < minChunkX || maxChunkY < minChunkY {
+		return nil, fmt.Errorf("empty region (%d,%d)-(%d,%d)", minChunkX, minChunkY, maxChunkX, maxChunkY)
+	}
+	r := &Region{
+		WorldName:  worldName,
+		MinChunkX:  minChunkX,
+		MinChunkY:  minChunkY,
+		MaxChunkX:  maxChunkX,
+		MaxChunkY:  maxChunkY,
+		widthCells: (maxChunkX - minChunkX + 1) * chunkdata.Size,
+	}
+	r.terrain = make([]chunkV1.TerrainType, int(r.Width())*int(r.Height()))
+
+	seen := make(map[[2]int32]bool, len(chunks))
+	for _, chunk := range chunks {
+		if chunk.ChunkX < minChunkX || chunk.ChunkX > maxChunkX || chunk.ChunkY < minChunkY || chunk.ChunkY > maxChunkY {
+			return nil, fmt.Errorf("chunk (%d,%d) is outside the region", chunk.ChunkX, chunk.ChunkY)
+		}
+		if len(chunk.Cells) != chunkdata.Size*chunkdata.Size {
+			return nil, fmt.Errorf("chunk (%d,%d) has %d cells, want %d", chunk.ChunkX, chunk.ChunkY, len(chunk.Cells), chunkdata.Size*chunkdata.Size)
+		}
+		seen[[2]int32{chunk.ChunkX, chunk.ChunkY}] = true
+		originX := (chunk.ChunkX - minChunkX) * chunkdata.Size
+		originY := (chunk.ChunkY - minChunkY) * chunkdata.Size
+		for i, cell := range chunk.Cells {
+			x := originX + int32(i)%chunkdata.Size
+			y := originY + int32(i)/chunkdata.Size
+			r.terrain[y*r.widthCells+x] = cell.GetTerrainType()
+		}
+		r.resources = append(r.resources, chunk.ResourceNodes...)
+	}
+	for x := minChunkX; x <= maxChunkX; x++ {
+		for y := minChunkY; y <= maxChunkY; y++ {
+			if !seen[[2]int32{x, y}] {
+				return nil, fmt.Errorf("chunk (%d,%d) is missing", x, y)
+			}
+		}
+	}
+	return r, nil
+}
+
+// Width is the region's width in cells
+func (r *Region) Width() int32 {
+	return r.widthCells
+}
+
+// Height is the region's height in cells
+func (r *Region) Height() int32 {
+	return (r.MaxChunkY - r.MinChunkY + 1) * chunkdata.Size
+}
+
+// OriginX is the world X coordinate of the region's left column
+func (r *Region) OriginX() int32 {
+	return r.MinChunkX * chunkdata.Size
+}
+
+// OriginY is the world Y coordinate of the region's top row
+func (r *Region) OriginY() int32 {
+	return r.MinChunkY * chunkdata.Size
+}
+
+// terrainAt returns the terrain of a cell by its position within the region
+func (r *Region) terrainAt(x, y int32) chunkV1.TerrainType {
+	return r.terrain[y*r.widthCells+x]
+}
+
+// Write renders the region in the given format
+func Write(w io.Writer, r *Region, format string) error {
+	switch format {
+	case FormatTiledJSON:
+		return writeTiledJSON(w, r)
+	case FormatTMX:
+		return writeTMX(w, r)
+	case FormatGeoJSON:
+		return writeGeoJSON(w, r)
+	default:
+		return fmt.Errorf("unknown format %q, want one of %s", format, strings.Join(Formats, ", "))
+	}
+}
+
+// terrainTypes lists the terrain types that appear in exports, in tile ID order
+var terrainTypes = []chunkV1.TerrainType{
+	chunkV1.TerrainType_TERRAIN_TYPE_GRASS,
+	chunkV1.TerrainType_TERRAIN_TYPE_WATER,
+	chunkV1.TerrainType_TERRAIN_TYPE_STONE,
+	chunkV1.TerrainType_TERRAIN_TYPE_SAND,
+	chunkV1.TerrainType_TERRAIN_TYPE_DIRT,
+}
+
+// terrainName is the short lowercase name of a terrain type, e.g. "grass"
+func terrainName(t chunkV1.TerrainType) string {
+	return strings.ToLower(strings.TrimPrefix(t.String(), "TERRAIN_TYPE_"))
+}
+
+// resourceName is the short lowercase name of a resource node's type, e.g. "herb_patch"
+func resourceName(node *resourceNodeV1.ResourceNode) string {
+	return strings.ToLower(strings.TrimPrefix(node.ResourceNodeTypeId.String(), "RESOURCE_NODE_TYPE_ID_"))
+}
@@ -0,0 +1,159 @@
+package worldexport
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/VoidMesh/api/api/internal/chunkdata"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	resourceNodeV1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testChunk is all grass except a row of water along its top edge
+func testChunk(chunkX, chunkY int32, nodes ...*resourceNodeV1.ResourceNode) *chunkV1.ChunkData {
+	cells := make([]*chunkV1.TerrainCell, chunkdata.Size*chunkdata.Size)
+	for i := range cells {
+		terrain := chunkV1.TerrainType_TERRAIN_TYPE_GRASS
+		if i < chunkdata.Size {
+			terrain = chunkV1.TerrainType_TERRAIN_TYPE_WATER
+		}
+		cells[i] = &chunkV1.TerrainCell{TerrainType: terrain}
+	}
+	return &chunkV1.ChunkData{ChunkX: chunkX, ChunkY: chunkY, Cells: cells, ResourceNodes: nodes}
+}
+
+func testRegion(t *testing.T) *Region {
+	t.Helper()
+	node := &resourceNodeV1.ResourceNode{
+		Id:                 7,
+		ResourceNodeTypeId: resourceNodeV1.ResourceNodeTypeId_RESOURCE_NODE_TYPE_ID_HERB_PATCH,
+		ChunkX:             0,
+		ChunkY:             -1,
+		X:                  3,
+		Y:                  -30,
+		ClusterId:          "c1",
+		Size:               2,
+	}
+	region, err := NewRegion("Test World", -1, -1, 0, -1, []*chunkV1.ChunkData{testChunk(0, -1, node), testChunk(-1, -1)})
+	require.NoError(t, err)
+	return region
+}
+
+func TestNewRegion(t *testing.T) {
+	region := testRegion(t)
+	assert.Equal(t, int32(64), region.Width())
+	assert.Equal(t, int32(32), region.Height())
+	assert.Equal(t, int32(-32), region.OriginX())
+	assert.Equal(t, int32(-32), region.OriginY())
+	assert.Equal(t, chunkV1.TerrainType_TERRAIN_TYPE_WATER, region.terrainAt(40, 0))
+	assert.Equal(t, chunkV1.TerrainType_TERRAIN_TYPE_GRASS, region.terrainAt(40, 1))
+
+	_, err := NewRegion("Test World", 0, 0, 1, 0, []*chunkV1.ChunkData{testChunk(0, 0)})
+	assert.ErrorContains(t, err, "chunk (1,0) is missing")
+	_, err = NewRegion("Test World", 0, 0, 0, 0, []*chunkV1.ChunkData{testChunk(2, 0)})
+	assert.ErrorContains(t, err, "outside the region")
+	_, err = NewRegion("Test World", 0, 0, 0, 0, []*chunkV1.ChunkData{{ChunkX: 0, ChunkY: 0}})
+	assert.ErrorContains(t, err, "0 cells")
+}
+
+func TestWrite_TiledJSON(t *testing.T) {
+	var out bytes.Buffer
+	require.NoError(t, Write(&out, testRegion(t), FormatTiledJSON))
+
+	var tiled struct {
+		Width      int32 `json:"width"`
+		Height     int32 `json:"height"`
+		Properties []tiledProperty
+		Layers     []struct {
+			Name    string        `json:"name"`
+			Type    string        `json:"type"`
+			Data    []int         `json:"data"`
+			Objects []tiledObject `json:"objects"`
+		} `json:"layers"`
+	}
+	require.NoError(t, json.Unmarshal(out.Bytes(), &tiled))
+	assert.Equal(t, int32(64), tiled.Width)
+	assert.Contains(t, tiled.Properties, tiledProperty{Name: "origin_x", Type: "int", Value: float64(-32)})
+	require.Len(t, tiled.Layers, 2)
+
+	terrain := tiled.Layers[0]
+	assert.Equal(t, "tilelayer", terrain.Type)
+	require.Len(t, terrain.Data, 64*32)
+	assert.Equal(t, int(chunkV1.TerrainType_TERRAIN_TYPE_WATER), terrain.Data[0])
+	assert.Equal(t, int(chunkV1.TerrainType_TERRAIN_TYPE_GRASS), terrain.Data[64])
+
+	resources := tiled.Layers[1]
+	assert.Equal(t, "objectgroup", resources.Type)
+	require.Len(t, resources.Objects, 1)
+	assert.Equal(t, "herb_patch", resources.Objects[0].Name)
+	assert.Equal(t, int32(35*TileSize), resources.Objects[0].X, "objects are placed relative to the origin")
+	assert.Equal(t, int32(2*TileSize), resources.Objects[0].Y)
+}
+
+func TestWrite_TMX(t *testing.T) {
+	var out bytes.Buffer
+	require.NoError(t, Write(&out, testRegion(t), FormatTMX))
+
+	var tmx struct {
+		Width   int32   `xml:"width,attr"`
+		Data    tmxData `xml:"layer>data"`
+		Objects []struct {
+			Type string `xml:"type,attr"`
+			X    int32  `xml:"x,attr"`
+		} `xml:"objectgroup>object"`
+	}
+	require.NoError(t, xml.Unmarshal(out.Bytes(), &tmx))
+	assert.Equal(t, int32(64), tmx.Width)
+	assert.Equal(t, "csv", tmx.Data.Encoding)
+	rows := strings.Split(strings.TrimSpace(tmx.Data.CSV), "\n")
+	require.Len(t, rows, 32)
+	assert.True(t, strings.HasPrefix(rows[0], "2,2,"), rows[0])
+	assert.True(t, strings.HasPrefix(rows[1], "1,1,"), rows[1])
+	require.Len(t, tmx.Objects, 1)
+	assert.Equal(t, "herb_patch", tmx.Objects[0].Type)
+	assert.Equal(t, int32(35*TileSize), tmx.Objects[0].X)
+	assert.Contains(t, out.String(), `<property name="world" type="string" value="Test World"></property>`)
+}
+
+func TestWrite_GeoJSON(t *testing.T) {
+	var out bytes.Buffer
+	require.NoError(t, Write(&out, testRegion(t), FormatGeoJSON))
+
+	var collection struct {
+		Type     string `json:"type"`
+		Features []struct {
+			Geometry struct {
+				Type        string          `json:"type"`
+				Coordinates json.RawMessage `json:"coordinates"`
+			} `json:"geometry"`
+			Properties map[string]any `json:"properties"`
+		} `json:"features"`
+	}
+	require.NoError(t, json.Unmarshal(out.Bytes(), &collection))
+	assert.Equal(t, "FeatureCollection", collection.Type)
+	require.Len(t, collection.Features, 3)
+
+	grass, water, herb := collection.Features[0], collection.Features[1], collection.Features[2]
+	assert.Equal(t, "grass", grass.Properties["terrain"])
+	var polygons [][][][2]int32
+	require.NoError(t, json.Unmarshal(grass.Geometry.Coordinates, &polygons))
+	assert.Len(t, polygons, 31, "equal cells along a row are merged, across chunk borders")
+
+	assert.Equal(t, "water", water.Properties["terrain"])
+	require.NoError(t, json.Unmarshal(water.Geometry.Coordinates, &polygons))
+	assert.Equal(t, [][][][2]int32{{{{-32, -32}, {32, -32}, {32, -31}, {-32, -31}, {-32, -32}}}}, polygons)
+
+	assert.Equal(t, "Point", herb.Geometry.Type)
+	assert.JSONEq(t, `[3.5, -29.5]`, string(herb.Geometry.Coordinates))
+	assert.Equal(t, "herb_patch", herb.Properties["resource"])
+}
+
+func TestWrite_UnknownFormat(t *testing.T) {
+	err := Write(&bytes.Buffer{}, testRegion(t), "shapefile")
+	assert.ErrorContains(t, err, "unknown format")
+}
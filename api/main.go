@@ -1,18 +1,11 @@
 package main
 
 import (
-	"github.com/VoidMesh/api/api/internal/logging"
-	"github.com/VoidMesh/api/api/server"
+	"os"
+
+	"github.com/VoidMesh/api/api/cmd"
 )
 
 func main() {
-	// Initialize logging system
-	logging.InitLogger()
-
-	logger := logging.GetLogger()
-	logger.Info("VoidMesh API server starting up")
-	logger.Debug("Debug logging enabled for maximum visibility")
-
-	// Start the server
-	server.Serve()
+	os.Exit(cmd.Execute())
 }
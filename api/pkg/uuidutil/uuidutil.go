@@ -0,0 +1,52 @@
+// Package uuidutil is the canonical encoding of the UUIDs the API sends and receives.
+//
+// IDs travel as strings in the canonical form: 36 lowercase characters with dashes.
+// Older v1 messages carry some IDs as bytes; Decode reads both the 16 raw bytes and the
+// UUID text in those fields, and Bytes writes the raw form they return. The package is
+// outside internal/ so clients of the API can encode IDs the same way.
+package uuidutil
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ErrInvalid is returned for values that are not a UUID in any accepted encoding
+var ErrInvalid = errors.New("invalid UUID")
+
+// Parse reads a UUID string with or without dashes
+func Parse(s string) (pgtype.UUID, error) {
+	parsed, err := uuid.Parse(s)
+	if err != nil {
+		return pgtype.UUID{}, fmt.Errorf("%w %q: %v", ErrInvalid, s, err)
+	}
+	return pgtype.UUID{Bytes: parsed, Valid: true}, nil
+}
+
+// Decode reads a UUID from a bytes field: either the 16 raw bytes or the UUID as text
+func Decode(b []byte) (pgtype.UUID, error) {
+	if len(b) == 16 {
+		return pgtype.UUID{Bytes: [16]byte(b), Valid: true}, nil
+	}
+	id, err := Parse(string(b))
+	if err != nil {
+		return pgtype.UUID{}, fmt.Errorf("%w: %d bytes are neither raw nor text", ErrInvalid, len(b))
+	}
+	return id, nil
+}
+
+// String returns the canonical form of id, or "" when it is not valid
+func String(id pgtype.UUID) string {
+	if !id.Valid {
+		return ""
+	}
+	return uuid.UUID(id.Bytes).String()
+}
+
+// Bytes returns the 16 raw bytes of id for v1 bytes fields
+func Bytes(id pgtype.UUID) []byte {
+	return id.Bytes[:]
+}
@@ -0,0 +1,61 @@
+package uuidutil
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const canonical = "650e8400-e29b-41d4-a716-446655440000"
+
+func TestParse(t *testing.T) {
+	for _, s := range []string{canonical, "650e8400e29b41d4a716446655440000", "650E8400-E29B-41D4-A716-446655440000"} {
+		id, err := Parse(s)
+		require.NoError(t, err, s)
+		assert.Equal(t, canonical, String(id), s)
+	}
+
+	_, err := Parse("not-a-uuid")
+	assert.True(t, errors.Is(err, ErrInvalid))
+	_, err = Parse("")
+	assert.True(t, errors.Is(err, ErrInvalid))
+}
+
+func TestDecode(t *testing.T) {
+	id, err := Parse(canonical)
+	require.NoError(t, err)
+
+	// Raw bytes, as v1 responses return them, and text, as v1 clients often send them
+	for _, b := range [][]byte{Bytes(id), []byte(canonical), []byte("650e8400e29b41d4a716446655440000")} {
+		decoded, err := Decode(b)
+		require.NoError(t, err, string(b))
+		assert.Equal(t, id, decoded)
+	}
+
+	for _, b := range [][]byte{nil, {1, 2, 3}, []byte("invalid-uuid-format")} {
+		_, err := Decode(b)
+		assert.True(t, errors.Is(err, ErrInvalid), "%q", b)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	id, err := Parse(canonical)
+	require.NoError(t, err)
+
+	fromString, err := Parse(String(id))
+	require.NoError(t, err)
+	assert.Equal(t, id, fromString)
+
+	fromBytes, err := Decode(Bytes(id))
+	require.NoError(t, err)
+	assert.Equal(t, id, fromBytes)
+}
+
+func TestInvalidID(t *testing.T) {
+	id, err := Decode(nil)
+	require.Error(t, err)
+	assert.Equal(t, "", String(id))
+	assert.Len(t, Bytes(id), 16)
+}
@@ -0,0 +1,472 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.4
+// source: achievement/v1/achievement.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ItemQuantity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        int32                  `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	ItemName      string                 `protobuf:"bytes,2,opt,name=item_name,json=itemName,proto3" json:"item_name,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemQuantity) Reset() {
+	*x = ItemQuantity{}
+	mi := &file_achievement_v1_achievement_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemQuantity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemQuantity) ProtoMessage() {}
+
+func (x *ItemQuantity) ProtoReflect() protoreflect.Message {
+	mi := &file_achievement_v1_achievement_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemQuantity.ProtoReflect.Descriptor instead.
+func (*ItemQuantity) Descriptor() ([]byte, []int) {
+	return file_achievement_v1_achievement_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ItemQuantity) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *ItemQuantity) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *ItemQuantity) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type Achievement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Progress      int64                  `protobuf:"varint,4,opt,name=progress,proto3" json:"progress,omitempty"` // Capped at threshold
+	Threshold     int64                  `protobuf:"varint,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Rewards       []*ItemQuantity        `protobuf:"bytes,6,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	UnlockedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=unlocked_at,json=unlockedAt,proto3" json:"unlocked_at,omitempty"` // Unset while locked
+	ClaimedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=claimed_at,json=claimedAt,proto3" json:"claimed_at,omitempty"`    // Unset until the reward is claimed
+	Cosmetic      string                 `protobuf:"bytes,9,opt,name=cosmetic,proto3" json:"cosmetic,omitempty"`                       // ID of the title or badge unlocked with it; empty for none
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Achievement) Reset() {
+	*x = Achievement{}
+	mi := &file_achievement_v1_achievement_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Achievement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Achievement) ProtoMessage() {}
+
+func (x *Achievement) ProtoReflect() protoreflect.Message {
+	mi := &file_achievement_v1_achievement_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Achievement.ProtoReflect.Descriptor instead.
+func (*Achievement) Descriptor() ([]byte, []int) {
+	return file_achievement_v1_achievement_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Achievement) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Achievement) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Achievement) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Achievement) GetProgress() int64 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *Achievement) GetThreshold() int64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *Achievement) GetRewards() []*ItemQuantity {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+func (x *Achievement) GetUnlockedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UnlockedAt
+	}
+	return nil
+}
+
+func (x *Achievement) GetClaimedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClaimedAt
+	}
+	return nil
+}
+
+func (x *Achievement) GetCosmetic() string {
+	if x != nil {
+		return x.Cosmetic
+	}
+	return ""
+}
+
+type ListAchievementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAchievementsRequest) Reset() {
+	*x = ListAchievementsRequest{}
+	mi := &file_achievement_v1_achievement_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAchievementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAchievementsRequest) ProtoMessage() {}
+
+func (x *ListAchievementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_achievement_v1_achievement_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAchievementsRequest.ProtoReflect.Descriptor instead.
+func (*ListAchievementsRequest) Descriptor() ([]byte, []int) {
+	return file_achievement_v1_achievement_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListAchievementsRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+type ListAchievementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Achievements  []*Achievement         `protobuf:"bytes,1,rep,name=achievements,proto3" json:"achievements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAchievementsResponse) Reset() {
+	*x = ListAchievementsResponse{}
+	mi := &file_achievement_v1_achievement_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAchievementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAchievementsResponse) ProtoMessage() {}
+
+func (x *ListAchievementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_achievement_v1_achievement_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAchievementsResponse.ProtoReflect.Descriptor instead.
+func (*ListAchievementsResponse) Descriptor() ([]byte, []int) {
+	return file_achievement_v1_achievement_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListAchievementsResponse) GetAchievements() []*Achievement {
+	if x != nil {
+		return x.Achievements
+	}
+	return nil
+}
+
+type ClaimRewardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	AchievementId string                 `protobuf:"bytes,2,opt,name=achievement_id,json=achievementId,proto3" json:"achievement_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimRewardRequest) Reset() {
+	*x = ClaimRewardRequest{}
+	mi := &file_achievement_v1_achievement_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimRewardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimRewardRequest) ProtoMessage() {}
+
+func (x *ClaimRewardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_achievement_v1_achievement_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimRewardRequest.ProtoReflect.Descriptor instead.
+func (*ClaimRewardRequest) Descriptor() ([]byte, []int) {
+	return file_achievement_v1_achievement_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ClaimRewardRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *ClaimRewardRequest) GetAchievementId() string {
+	if x != nil {
+		return x.AchievementId
+	}
+	return ""
+}
+
+type ClaimRewardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ItemQuantity        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimRewardResponse) Reset() {
+	*x = ClaimRewardResponse{}
+	mi := &file_achievement_v1_achievement_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimRewardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimRewardResponse) ProtoMessage() {}
+
+func (x *ClaimRewardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_achievement_v1_achievement_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimRewardResponse.ProtoReflect.Descriptor instead.
+func (*ClaimRewardResponse) Descriptor() ([]byte, []int) {
+	return file_achievement_v1_achievement_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ClaimRewardResponse) GetItems() []*ItemQuantity {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+var File_achievement_v1_achievement_proto protoreflect.FileDescriptor
+
+const file_achievement_v1_achievement_proto_rawDesc = "" +
+	"\n" +
+	" achievement/v1/achievement.proto\x12\x0eachievement.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"`\n" +
+	"\fItemQuantity\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\x05R\x06itemId\x12\x1b\n" +
+	"\titem_name\x18\x02 \x01(\tR\bitemName\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\"\xd9\x02\n" +
+	"\vAchievement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bprogress\x18\x04 \x01(\x03R\bprogress\x12\x1c\n" +
+	"\tthreshold\x18\x05 \x01(\x03R\tthreshold\x126\n" +
+	"\arewards\x18\x06 \x03(\v2\x1c.achievement.v1.ItemQuantityR\arewards\x12;\n" +
+	"\vunlocked_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"unlockedAt\x129\n" +
+	"\n" +
+	"claimed_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tclaimedAt\x12\x1a\n" +
+	"\bcosmetic\x18\t \x01(\tR\bcosmetic\"<\n" +
+	"\x17ListAchievementsRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\"[\n" +
+	"\x18ListAchievementsResponse\x12?\n" +
+	"\fachievements\x18\x01 \x03(\v2\x1b.achievement.v1.AchievementR\fachievements\"^\n" +
+	"\x12ClaimRewardRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12%\n" +
+	"\x0eachievement_id\x18\x02 \x01(\tR\rachievementId\"I\n" +
+	"\x13ClaimRewardResponse\x122\n" +
+	"\x05items\x18\x01 \x03(\v2\x1c.achievement.v1.ItemQuantityR\x05items2\xd7\x01\n" +
+	"\x12AchievementService\x12g\n" +
+	"\x10ListAchievements\x12'.achievement.v1.ListAchievementsRequest\x1a(.achievement.v1.ListAchievementsResponse\"\x00\x12X\n" +
+	"\vClaimReward\x12\".achievement.v1.ClaimRewardRequest\x1a#.achievement.v1.ClaimRewardResponse\"\x00B2Z0github.com/VoidMesh/api/api/proto/achievement/v1b\x06proto3"
+
+var (
+	file_achievement_v1_achievement_proto_rawDescOnce sync.Once
+	file_achievement_v1_achievement_proto_rawDescData []byte
+)
+
+func file_achievement_v1_achievement_proto_rawDescGZIP() []byte {
+	file_achievement_v1_achievement_proto_rawDescOnce.Do(func() {
+		file_achievement_v1_achievement_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_achievement_v1_achievement_proto_rawDesc), len(file_achievement_v1_achievement_proto_rawDesc)))
+	})
+	return file_achievement_v1_achievement_proto_rawDescData
+}
+
+var file_achievement_v1_achievement_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_achievement_v1_achievement_proto_goTypes = []any{
+	(*ItemQuantity)(nil),             // 0: achievement.v1.ItemQuantity
+	(*Achievement)(nil),              // 1: achievement.v1.Achievement
+	(*ListAchievementsRequest)(nil),  // 2: achievement.v1.ListAchievementsRequest
+	(*ListAchievementsResponse)(nil), // 3: achievement.v1.ListAchievementsResponse
+	(*ClaimRewardRequest)(nil),       // 4: achievement.v1.ClaimRewardRequest
+	(*ClaimRewardResponse)(nil),      // 5: achievement.v1.ClaimRewardResponse
+	(*timestamppb.Timestamp)(nil),    // 6: google.protobuf.Timestamp
+}
+var file_achievement_v1_achievement_proto_depIdxs = []int32{
+	0, // 0: achievement.v1.Achievement.rewards:type_name -> achievement.v1.ItemQuantity
+	6, // 1: achievement.v1.Achievement.unlocked_at:type_name -> google.protobuf.Timestamp
+	6, // 2: achievement.v1.Achievement.claimed_at:type_name -> google.protobuf.Timestamp
+	1, // 3: achievement.v1.ListAchievementsResponse.achievements:type_name -> achievement.v1.Achievement
+	0, // 4: achievement.v1.ClaimRewardResponse.items:type_name -> achievement.v1.ItemQuantity
+	2, // 5: achievement.v1.AchievementService.ListAchievements:input_type -> achievement.v1.ListAchievementsRequest
+	4, // 6: achievement.v1.AchievementService.ClaimReward:input_type -> achievement.v1.ClaimRewardRequest
+	3, // 7: achievement.v1.AchievementService.ListAchievements:output_type -> achievement.v1.ListAchievementsResponse
+	5, // 8: achievement.v1.AchievementService.ClaimReward:output_type -> achievement.v1.ClaimRewardResponse
+	7, // [7:9] is the sub-list for method output_type
+	5, // [5:7] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_achievement_v1_achievement_proto_init() }
+func file_achievement_v1_achievement_proto_init() {
+	if File_achievement_v1_achievement_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_achievement_v1_achievement_proto_rawDesc), len(file_achievement_v1_achievement_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_achievement_v1_achievement_proto_goTypes,
+		DependencyIndexes: file_achievement_v1_achievement_proto_depIdxs,
+		MessageInfos:      file_achievement_v1_achievement_proto_msgTypes,
+	}.Build()
+	File_achievement_v1_achievement_proto = out.File
+	file_achievement_v1_achievement_proto_goTypes = nil
+	file_achievement_v1_achievement_proto_depIdxs = nil
+}
@@ -0,0 +1,4433 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.4
+// source: admin/v1/admin.proto
+
+package v1
+
+import (
+	v14 "github.com/VoidMesh/api/api/proto/character/v1"
+	v11 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	v13 "github.com/VoidMesh/api/api/proto/inventory/v1"
+	v12 "github.com/VoidMesh/api/api/proto/notification/v1"
+	v1 "github.com/VoidMesh/api/api/proto/social/v1"
+	v15 "github.com/VoidMesh/api/api/proto/user/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// What an experiment assigns to variants
+type ExperimentSubject int32
+
+const (
+	ExperimentSubject_EXPERIMENT_SUBJECT_UNSPECIFIED ExperimentSubject = 0
+	ExperimentSubject_EXPERIMENT_SUBJECT_WORLD       ExperimentSubject = 1 // Worlds created after the experiment started
+	ExperimentSubject_EXPERIMENT_SUBJECT_CHARACTER   ExperimentSubject = 2 // Characters created after the experiment started
+)
+
+// Enum value maps for ExperimentSubject.
+var (
+	ExperimentSubject_name = map[int32]string{
+		0: "EXPERIMENT_SUBJECT_UNSPECIFIED",
+		1: "EXPERIMENT_SUBJECT_WORLD",
+		2: "EXPERIMENT_SUBJECT_CHARACTER",
+	}
+	ExperimentSubject_value = map[string]int32{
+		"EXPERIMENT_SUBJECT_UNSPECIFIED": 0,
+		"EXPERIMENT_SUBJECT_WORLD":       1,
+		"EXPERIMENT_SUBJECT_CHARACTER":   2,
+	}
+)
+
+func (x ExperimentSubject) Enum() *ExperimentSubject {
+	p := new(ExperimentSubject)
+	*p = x
+	return p
+}
+
+func (x ExperimentSubject) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExperimentSubject) Descriptor() protoreflect.EnumDescriptor {
+	return file_admin_v1_admin_proto_enumTypes[0].Descriptor()
+}
+
+func (ExperimentSubject) Type() protoreflect.EnumType {
+	return &file_admin_v1_admin_proto_enumTypes[0]
+}
+
+func (x ExperimentSubject) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExperimentSubject.Descriptor instead.
+func (ExperimentSubject) EnumDescriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{0}
+}
+
+type EconomyPeriod int32
+
+const (
+	EconomyPeriod_ECONOMY_PERIOD_UNSPECIFIED EconomyPeriod = 0 // Days
+	EconomyPeriod_ECONOMY_PERIOD_HOUR        EconomyPeriod = 1
+	EconomyPeriod_ECONOMY_PERIOD_DAY         EconomyPeriod = 2
+	EconomyPeriod_ECONOMY_PERIOD_WEEK        EconomyPeriod = 3
+)
+
+// Enum value maps for EconomyPeriod.
+var (
+	EconomyPeriod_name = map[int32]string{
+		0: "ECONOMY_PERIOD_UNSPECIFIED",
+		1: "ECONOMY_PERIOD_HOUR",
+		2: "ECONOMY_PERIOD_DAY",
+		3: "ECONOMY_PERIOD_WEEK",
+	}
+	EconomyPeriod_value = map[string]int32{
+		"ECONOMY_PERIOD_UNSPECIFIED": 0,
+		"ECONOMY_PERIOD_HOUR":        1,
+		"ECONOMY_PERIOD_DAY":         2,
+		"ECONOMY_PERIOD_WEEK":        3,
+	}
+)
+
+func (x EconomyPeriod) Enum() *EconomyPeriod {
+	p := new(EconomyPeriod)
+	*p = x
+	return p
+}
+
+func (x EconomyPeriod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EconomyPeriod) Descriptor() protoreflect.EnumDescriptor {
+	return file_admin_v1_admin_proto_enumTypes[1].Descriptor()
+}
+
+func (EconomyPeriod) Type() protoreflect.EnumType {
+	return &file_admin_v1_admin_proto_enumTypes[1]
+}
+
+func (x EconomyPeriod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EconomyPeriod.Descriptor instead.
+func (EconomyPeriod) EnumDescriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{1}
+}
+
+type ListPlayerReportsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        v1.ReportStatus        `protobuf:"varint,1,opt,name=status,proto3,enum=social.v1.ReportStatus" json:"status,omitempty"` // UNSPECIFIED lists open reports
+	AfterId       int64                  `protobuf:"varint,2,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`            // Pagination cursor: the last report ID of the previous page
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                               // 0 uses the default, larger values are capped
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlayerReportsRequest) Reset() {
+	*x = ListPlayerReportsRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlayerReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlayerReportsRequest) ProtoMessage() {}
+
+func (x *ListPlayerReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlayerReportsRequest.ProtoReflect.Descriptor instead.
+func (*ListPlayerReportsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListPlayerReportsRequest) GetStatus() v1.ReportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return v1.ReportStatus(0)
+}
+
+func (x *ListPlayerReportsRequest) GetAfterId() int64 {
+	if x != nil {
+		return x.AfterId
+	}
+	return 0
+}
+
+func (x *ListPlayerReportsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListPlayerReportsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reports       []*v1.PlayerReport     `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"` // Oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlayerReportsResponse) Reset() {
+	*x = ListPlayerReportsResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlayerReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlayerReportsResponse) ProtoMessage() {}
+
+func (x *ListPlayerReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlayerReportsResponse.ProtoReflect.Descriptor instead.
+func (*ListPlayerReportsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListPlayerReportsResponse) GetReports() []*v1.PlayerReport {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+type ResolvePlayerReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReportId      int64                  `protobuf:"varint,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	Resolution    string                 `protobuf:"bytes,2,opt,name=resolution,proto3" json:"resolution,omitempty"` // What the moderator did, e.g. "warned" or "no action"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolvePlayerReportRequest) Reset() {
+	*x = ResolvePlayerReportRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolvePlayerReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolvePlayerReportRequest) ProtoMessage() {}
+
+func (x *ResolvePlayerReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolvePlayerReportRequest.ProtoReflect.Descriptor instead.
+func (*ResolvePlayerReportRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ResolvePlayerReportRequest) GetReportId() int64 {
+	if x != nil {
+		return x.ReportId
+	}
+	return 0
+}
+
+func (x *ResolvePlayerReportRequest) GetResolution() string {
+	if x != nil {
+		return x.Resolution
+	}
+	return ""
+}
+
+type ResolvePlayerReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *v1.PlayerReport       `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolvePlayerReportResponse) Reset() {
+	*x = ResolvePlayerReportResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolvePlayerReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolvePlayerReportResponse) ProtoMessage() {}
+
+func (x *ResolvePlayerReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolvePlayerReportResponse.ProtoReflect.Descriptor instead.
+func (*ResolvePlayerReportResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ResolvePlayerReportResponse) GetReport() *v1.PlayerReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+type ApiKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	KeyId         string                 `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"` // Public part of the token (vmk_<key_id>_<secret>)
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes        []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"` // world:read, chunk:read, resource:read, terrain:read
+	CreatedBy     string                 `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Unset never expires
+	RevokedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiKey) Reset() {
+	*x = ApiKey{}
+	mi := &file_admin_v1_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKey) ProtoMessage() {}
+
+func (x *ApiKey) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKey.ProtoReflect.Descriptor instead.
+func (*ApiKey) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ApiKey) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ApiKey) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *ApiKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApiKey) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *ApiKey) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ApiKey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ApiKey) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ApiKey) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+func (x *ApiKey) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+type CreateApiKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // What the key is for, e.g. "analytics pipeline"
+	Scopes        []string               `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Unset never expires
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiKeyRequest) Reset() {
+	*x = CreateApiKeyRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiKeyRequest) ProtoMessage() {}
+
+func (x *CreateApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateApiKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateApiKeyRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateApiKeyRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type CreateApiKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        *ApiKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"` // The full key; it is not stored and cannot be shown again
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiKeyResponse) Reset() {
+	*x = CreateApiKeyResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiKeyResponse) ProtoMessage() {}
+
+func (x *CreateApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateApiKeyResponse) GetApiKey() *ApiKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *CreateApiKeyResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ListApiKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApiKeysRequest) Reset() {
+	*x = ListApiKeysRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiKeysRequest) ProtoMessage() {}
+
+func (x *ListApiKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListApiKeysRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{7}
+}
+
+type ListApiKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKeys       []*ApiKey              `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"` // Oldest first, including revoked and expired keys
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApiKeysResponse) Reset() {
+	*x = ListApiKeysResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiKeysResponse) ProtoMessage() {}
+
+func (x *ListApiKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListApiKeysResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListApiKeysResponse) GetApiKeys() []*ApiKey {
+	if x != nil {
+		return x.ApiKeys
+	}
+	return nil
+}
+
+type RevokeApiKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiKeyRequest) Reset() {
+	*x = RevokeApiKeyRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiKeyRequest) ProtoMessage() {}
+
+func (x *RevokeApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RevokeApiKeyRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RevokeApiKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        *ApiKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiKeyResponse) Reset() {
+	*x = RevokeApiKeyResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiKeyResponse) ProtoMessage() {}
+
+func (x *RevokeApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*RevokeApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RevokeApiKeyResponse) GetApiKey() *ApiKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+type CreateProtectedRegionRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	WorldId string                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"` // Defaults to the caller's session world
+	Name    string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Flags   []v11.RegionFlag       `protobuf:"varint,3,rep,packed,name=flags,proto3,enum=chunk.v1.RegionFlag" json:"flags,omitempty"`
+	// Exactly one of polygon and chunk_rect
+	Polygon       []*v11.RegionPoint `protobuf:"bytes,4,rep,name=polygon,proto3" json:"polygon,omitempty"` // At least three vertices
+	ChunkRect     *v11.ChunkRect     `protobuf:"bytes,5,opt,name=chunk_rect,json=chunkRect,proto3" json:"chunk_rect,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProtectedRegionRequest) Reset() {
+	*x = CreateProtectedRegionRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProtectedRegionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProtectedRegionRequest) ProtoMessage() {}
+
+func (x *CreateProtectedRegionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProtectedRegionRequest.ProtoReflect.Descriptor instead.
+func (*CreateProtectedRegionRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreateProtectedRegionRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *CreateProtectedRegionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateProtectedRegionRequest) GetFlags() []v11.RegionFlag {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *CreateProtectedRegionRequest) GetPolygon() []*v11.RegionPoint {
+	if x != nil {
+		return x.Polygon
+	}
+	return nil
+}
+
+func (x *CreateProtectedRegionRequest) GetChunkRect() *v11.ChunkRect {
+	if x != nil {
+		return x.ChunkRect
+	}
+	return nil
+}
+
+type CreateProtectedRegionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Region        *v11.ProtectedRegion   `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProtectedRegionResponse) Reset() {
+	*x = CreateProtectedRegionResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProtectedRegionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProtectedRegionResponse) ProtoMessage() {}
+
+func (x *CreateProtectedRegionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProtectedRegionResponse.ProtoReflect.Descriptor instead.
+func (*CreateProtectedRegionResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateProtectedRegionResponse) GetRegion() *v11.ProtectedRegion {
+	if x != nil {
+		return x.Region
+	}
+	return nil
+}
+
+// Replaces a region's name, flags and shape
+type UpdateProtectedRegionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Flags []v11.RegionFlag       `protobuf:"varint,3,rep,packed,name=flags,proto3,enum=chunk.v1.RegionFlag" json:"flags,omitempty"`
+	// Exactly one of polygon and chunk_rect
+	Polygon       []*v11.RegionPoint `protobuf:"bytes,4,rep,name=polygon,proto3" json:"polygon,omitempty"`
+	ChunkRect     *v11.ChunkRect     `protobuf:"bytes,5,opt,name=chunk_rect,json=chunkRect,proto3" json:"chunk_rect,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProtectedRegionRequest) Reset() {
+	*x = UpdateProtectedRegionRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProtectedRegionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProtectedRegionRequest) ProtoMessage() {}
+
+func (x *UpdateProtectedRegionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProtectedRegionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProtectedRegionRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateProtectedRegionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateProtectedRegionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateProtectedRegionRequest) GetFlags() []v11.RegionFlag {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *UpdateProtectedRegionRequest) GetPolygon() []*v11.RegionPoint {
+	if x != nil {
+		return x.Polygon
+	}
+	return nil
+}
+
+func (x *UpdateProtectedRegionRequest) GetChunkRect() *v11.ChunkRect {
+	if x != nil {
+		return x.ChunkRect
+	}
+	return nil
+}
+
+type UpdateProtectedRegionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Region        *v11.ProtectedRegion   `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProtectedRegionResponse) Reset() {
+	*x = UpdateProtectedRegionResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProtectedRegionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProtectedRegionResponse) ProtoMessage() {}
+
+func (x *UpdateProtectedRegionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProtectedRegionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProtectedRegionResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateProtectedRegionResponse) GetRegion() *v11.ProtectedRegion {
+	if x != nil {
+		return x.Region
+	}
+	return nil
+}
+
+type ListProtectedRegionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorldId       string                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"` // Defaults to the caller's session world
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProtectedRegionsRequest) Reset() {
+	*x = ListProtectedRegionsRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProtectedRegionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProtectedRegionsRequest) ProtoMessage() {}
+
+func (x *ListProtectedRegionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProtectedRegionsRequest.ProtoReflect.Descriptor instead.
+func (*ListProtectedRegionsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListProtectedRegionsRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+type ListProtectedRegionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Regions       []*v11.ProtectedRegion `protobuf:"bytes,1,rep,name=regions,proto3" json:"regions,omitempty"` // Oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProtectedRegionsResponse) Reset() {
+	*x = ListProtectedRegionsResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProtectedRegionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProtectedRegionsResponse) ProtoMessage() {}
+
+func (x *ListProtectedRegionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProtectedRegionsResponse.ProtoReflect.Descriptor instead.
+func (*ListProtectedRegionsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListProtectedRegionsResponse) GetRegions() []*v11.ProtectedRegion {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
+type DeleteProtectedRegionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProtectedRegionRequest) Reset() {
+	*x = DeleteProtectedRegionRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProtectedRegionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProtectedRegionRequest) ProtoMessage() {}
+
+func (x *DeleteProtectedRegionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProtectedRegionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProtectedRegionRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeleteProtectedRegionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteProtectedRegionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Region        *v11.ProtectedRegion   `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProtectedRegionResponse) Reset() {
+	*x = DeleteProtectedRegionResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProtectedRegionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProtectedRegionResponse) ProtoMessage() {}
+
+func (x *DeleteProtectedRegionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProtectedRegionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProtectedRegionResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DeleteProtectedRegionResponse) GetRegion() *v11.ProtectedRegion {
+	if x != nil {
+		return x.Region
+	}
+	return nil
+}
+
+// A flag is on for a user when it is enabled, the user's world is in world_ids (or
+// world_ids is empty) and the user is in user_ids or within rollout_percentage
+type FeatureFlag struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // Lowercase letters, digits and underscores, e.g. "land_claims"
+	Description       string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Enabled           bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`                                              // Off for everyone when false
+	RolloutPercentage int32                  `protobuf:"varint,4,opt,name=rollout_percentage,json=rolloutPercentage,proto3" json:"rollout_percentage,omitempty"` // 0-100; users are bucketed by a hash of the flag name and user ID
+	UserIds           []string               `protobuf:"bytes,5,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`                                // Always on for these users
+	WorldIds          []string               `protobuf:"bytes,6,rep,name=world_ids,json=worldIds,proto3" json:"world_ids,omitempty"`                             // Empty allows every world
+	Stored            bool                   `protobuf:"varint,7,opt,name=stored,proto3" json:"stored,omitempty"`                                                // False for known flags that have never been set
+	DefaultEnabled    bool                   `protobuf:"varint,8,opt,name=default_enabled,json=defaultEnabled,proto3" json:"default_enabled,omitempty"`          // What the server uses for a known flag until it is set
+	UpdatedBy         string                 `protobuf:"bytes,9,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *FeatureFlag) Reset() {
+	*x = FeatureFlag{}
+	mi := &file_admin_v1_admin_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeatureFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeatureFlag) ProtoMessage() {}
+
+func (x *FeatureFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeatureFlag.ProtoReflect.Descriptor instead.
+func (*FeatureFlag) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *FeatureFlag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FeatureFlag) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *FeatureFlag) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *FeatureFlag) GetRolloutPercentage() int32 {
+	if x != nil {
+		return x.RolloutPercentage
+	}
+	return 0
+}
+
+func (x *FeatureFlag) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *FeatureFlag) GetWorldIds() []string {
+	if x != nil {
+		return x.WorldIds
+	}
+	return nil
+}
+
+func (x *FeatureFlag) GetStored() bool {
+	if x != nil {
+		return x.Stored
+	}
+	return false
+}
+
+func (x *FeatureFlag) GetDefaultEnabled() bool {
+	if x != nil {
+		return x.DefaultEnabled
+	}
+	return false
+}
+
+func (x *FeatureFlag) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *FeatureFlag) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Creates or replaces a flag's settings
+type SetFeatureFlagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flag          *FeatureFlag           `protobuf:"bytes,1,opt,name=flag,proto3" json:"flag,omitempty"` // stored, default_enabled, updated_by and updated_at are ignored
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFeatureFlagRequest) Reset() {
+	*x = SetFeatureFlagRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFeatureFlagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFeatureFlagRequest) ProtoMessage() {}
+
+func (x *SetFeatureFlagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFeatureFlagRequest.ProtoReflect.Descriptor instead.
+func (*SetFeatureFlagRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SetFeatureFlagRequest) GetFlag() *FeatureFlag {
+	if x != nil {
+		return x.Flag
+	}
+	return nil
+}
+
+type SetFeatureFlagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flag          *FeatureFlag           `protobuf:"bytes,1,opt,name=flag,proto3" json:"flag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFeatureFlagResponse) Reset() {
+	*x = SetFeatureFlagResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFeatureFlagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFeatureFlagResponse) ProtoMessage() {}
+
+func (x *SetFeatureFlagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFeatureFlagResponse.ProtoReflect.Descriptor instead.
+func (*SetFeatureFlagResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SetFeatureFlagResponse) GetFlag() *FeatureFlag {
+	if x != nil {
+		return x.Flag
+	}
+	return nil
+}
+
+type ListFeatureFlagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFeatureFlagsRequest) Reset() {
+	*x = ListFeatureFlagsRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFeatureFlagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFeatureFlagsRequest) ProtoMessage() {}
+
+func (x *ListFeatureFlagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeatureFlagsRequest.ProtoReflect.Descriptor instead.
+func (*ListFeatureFlagsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{22}
+}
+
+type ListFeatureFlagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flags         []*FeatureFlag         `protobuf:"bytes,1,rep,name=flags,proto3" json:"flags,omitempty"` // Stored and known flags, by name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFeatureFlagsResponse) Reset() {
+	*x = ListFeatureFlagsResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFeatureFlagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFeatureFlagsResponse) ProtoMessage() {}
+
+func (x *ListFeatureFlagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeatureFlagsResponse.ProtoReflect.Descriptor instead.
+func (*ListFeatureFlagsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListFeatureFlagsResponse) GetFlags() []*FeatureFlag {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+type DeleteFeatureFlagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFeatureFlagRequest) Reset() {
+	*x = DeleteFeatureFlagRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFeatureFlagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFeatureFlagRequest) ProtoMessage() {}
+
+func (x *DeleteFeatureFlagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFeatureFlagRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFeatureFlagRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteFeatureFlagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteFeatureFlagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flag          *FeatureFlag           `protobuf:"bytes,1,opt,name=flag,proto3" json:"flag,omitempty"` // The deleted settings
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFeatureFlagResponse) Reset() {
+	*x = DeleteFeatureFlagResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFeatureFlagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFeatureFlagResponse) ProtoMessage() {}
+
+func (x *DeleteFeatureFlagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFeatureFlagResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFeatureFlagResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteFeatureFlagResponse) GetFlag() *FeatureFlag {
+	if x != nil {
+		return x.Flag
+	}
+	return nil
+}
+
+type ExperimentVariant struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Name   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Weight int32                  `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"` // Relative share of subjects, at least 1
+	// Generation parameters the variant sets, e.g. resource_density (a multiplier on
+	// resource nodes per chunk)
+	Params        map[string]float64 `protobuf:"bytes,3,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	Assignments   int64              `protobuf:"varint,4,opt,name=assignments,proto3" json:"assignments,omitempty"` // Subjects assigned so far; ignored on create
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExperimentVariant) Reset() {
+	*x = ExperimentVariant{}
+	mi := &file_admin_v1_admin_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExperimentVariant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExperimentVariant) ProtoMessage() {}
+
+func (x *ExperimentVariant) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExperimentVariant.ProtoReflect.Descriptor instead.
+func (*ExperimentVariant) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ExperimentVariant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExperimentVariant) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *ExperimentVariant) GetParams() map[string]float64 {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *ExperimentVariant) GetAssignments() int64 {
+	if x != nil {
+		return x.Assignments
+	}
+	return 0
+}
+
+type Experiment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // Lowercase letters, digits and underscores
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Subject       ExperimentSubject      `protobuf:"varint,3,opt,name=subject,proto3,enum=admin.v1.ExperimentSubject" json:"subject,omitempty"`
+	Variants      []*ExperimentVariant   `protobuf:"bytes,4,rep,name=variants,proto3" json:"variants,omitempty"` // At least two
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	StoppedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=stopped_at,json=stoppedAt,proto3" json:"stopped_at,omitempty"` // Unset while running
+	CreatedBy     string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Experiment) Reset() {
+	*x = Experiment{}
+	mi := &file_admin_v1_admin_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Experiment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Experiment) ProtoMessage() {}
+
+func (x *Experiment) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Experiment.ProtoReflect.Descriptor instead.
+func (*Experiment) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Experiment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Experiment) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Experiment) GetSubject() ExperimentSubject {
+	if x != nil {
+		return x.Subject
+	}
+	return ExperimentSubject_EXPERIMENT_SUBJECT_UNSPECIFIED
+}
+
+func (x *Experiment) GetVariants() []*ExperimentVariant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *Experiment) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *Experiment) GetStoppedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StoppedAt
+	}
+	return nil
+}
+
+func (x *Experiment) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type CreateExperimentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Experiment    *Experiment            `protobuf:"bytes,1,opt,name=experiment,proto3" json:"experiment,omitempty"` // started_at, stopped_at and created_by are ignored
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateExperimentRequest) Reset() {
+	*x = CreateExperimentRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateExperimentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExperimentRequest) ProtoMessage() {}
+
+func (x *CreateExperimentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExperimentRequest.ProtoReflect.Descriptor instead.
+func (*CreateExperimentRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreateExperimentRequest) GetExperiment() *Experiment {
+	if x != nil {
+		return x.Experiment
+	}
+	return nil
+}
+
+type CreateExperimentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Experiment    *Experiment            `protobuf:"bytes,1,opt,name=experiment,proto3" json:"experiment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateExperimentResponse) Reset() {
+	*x = CreateExperimentResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateExperimentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExperimentResponse) ProtoMessage() {}
+
+func (x *CreateExperimentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExperimentResponse.ProtoReflect.Descriptor instead.
+func (*CreateExperimentResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CreateExperimentResponse) GetExperiment() *Experiment {
+	if x != nil {
+		return x.Experiment
+	}
+	return nil
+}
+
+type ListExperimentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExperimentsRequest) Reset() {
+	*x = ListExperimentsRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExperimentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExperimentsRequest) ProtoMessage() {}
+
+func (x *ListExperimentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExperimentsRequest.ProtoReflect.Descriptor instead.
+func (*ListExperimentsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{30}
+}
+
+type ListExperimentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Experiments   []*Experiment          `protobuf:"bytes,1,rep,name=experiments,proto3" json:"experiments,omitempty"` // Oldest first, with assignment counts
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExperimentsResponse) Reset() {
+	*x = ListExperimentsResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExperimentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExperimentsResponse) ProtoMessage() {}
+
+func (x *ListExperimentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExperimentsResponse.ProtoReflect.Descriptor instead.
+func (*ListExperimentsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListExperimentsResponse) GetExperiments() []*Experiment {
+	if x != nil {
+		return x.Experiments
+	}
+	return nil
+}
+
+type StopExperimentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopExperimentRequest) Reset() {
+	*x = StopExperimentRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopExperimentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopExperimentRequest) ProtoMessage() {}
+
+func (x *StopExperimentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopExperimentRequest.ProtoReflect.Descriptor instead.
+func (*StopExperimentRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *StopExperimentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type StopExperimentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Experiment    *Experiment            `protobuf:"bytes,1,opt,name=experiment,proto3" json:"experiment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopExperimentResponse) Reset() {
+	*x = StopExperimentResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopExperimentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopExperimentResponse) ProtoMessage() {}
+
+func (x *StopExperimentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopExperimentResponse.ProtoReflect.Descriptor instead.
+func (*StopExperimentResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *StopExperimentResponse) GetExperiment() *Experiment {
+	if x != nil {
+		return x.Experiment
+	}
+	return nil
+}
+
+type MaintenanceMode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`                   // Shown to players, e.g. "Upgrading the database"
+	Eta           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=eta,proto3" json:"eta,omitempty"`                           // When the server is expected back; unset if unknown
+	FreezeAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=freeze_at,json=freezeAt,proto3" json:"freeze_at,omitempty"` // When non-admin writes are rejected
+	WritesFrozen  bool                   `protobuf:"varint,5,opt,name=writes_frozen,json=writesFrozen,proto3" json:"writes_frozen,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,6,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MaintenanceMode) Reset() {
+	*x = MaintenanceMode{}
+	mi := &file_admin_v1_admin_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaintenanceMode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceMode) ProtoMessage() {}
+
+func (x *MaintenanceMode) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceMode.ProtoReflect.Descriptor instead.
+func (*MaintenanceMode) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *MaintenanceMode) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *MaintenanceMode) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MaintenanceMode) GetEta() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Eta
+	}
+	return nil
+}
+
+func (x *MaintenanceMode) GetFreezeAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FreezeAt
+	}
+	return nil
+}
+
+func (x *MaintenanceMode) GetWritesFrozen() bool {
+	if x != nil {
+		return x.WritesFrozen
+	}
+	return false
+}
+
+func (x *MaintenanceMode) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *MaintenanceMode) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type SetMaintenanceModeRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Enabled            bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"` // false ends maintenance; the other fields are then ignored
+	Message            string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Eta                *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=eta,proto3" json:"eta,omitempty"`
+	GracePeriodSeconds int32                  `protobuf:"varint,4,opt,name=grace_period_seconds,json=gracePeriodSeconds,proto3" json:"grace_period_seconds,omitempty"` // Time open sessions get before writes freeze; 0 uses the default
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SetMaintenanceModeRequest) Reset() {
+	*x = SetMaintenanceModeRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMaintenanceModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMaintenanceModeRequest) ProtoMessage() {}
+
+func (x *SetMaintenanceModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMaintenanceModeRequest.ProtoReflect.Descriptor instead.
+func (*SetMaintenanceModeRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SetMaintenanceModeRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SetMaintenanceModeRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SetMaintenanceModeRequest) GetEta() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Eta
+	}
+	return nil
+}
+
+func (x *SetMaintenanceModeRequest) GetGracePeriodSeconds() int32 {
+	if x != nil {
+		return x.GracePeriodSeconds
+	}
+	return 0
+}
+
+type SetMaintenanceModeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Maintenance   *MaintenanceMode       `protobuf:"bytes,1,opt,name=maintenance,proto3" json:"maintenance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMaintenanceModeResponse) Reset() {
+	*x = SetMaintenanceModeResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMaintenanceModeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMaintenanceModeResponse) ProtoMessage() {}
+
+func (x *SetMaintenanceModeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMaintenanceModeResponse.ProtoReflect.Descriptor instead.
+func (*SetMaintenanceModeResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SetMaintenanceModeResponse) GetMaintenance() *MaintenanceMode {
+	if x != nil {
+		return x.Maintenance
+	}
+	return nil
+}
+
+type GetMaintenanceModeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMaintenanceModeRequest) Reset() {
+	*x = GetMaintenanceModeRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMaintenanceModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaintenanceModeRequest) ProtoMessage() {}
+
+func (x *GetMaintenanceModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaintenanceModeRequest.ProtoReflect.Descriptor instead.
+func (*GetMaintenanceModeRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{37}
+}
+
+type GetMaintenanceModeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Maintenance   *MaintenanceMode       `protobuf:"bytes,1,opt,name=maintenance,proto3" json:"maintenance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMaintenanceModeResponse) Reset() {
+	*x = GetMaintenanceModeResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMaintenanceModeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaintenanceModeResponse) ProtoMessage() {}
+
+func (x *GetMaintenanceModeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaintenanceModeResponse.ProtoReflect.Descriptor instead.
+func (*GetMaintenanceModeResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetMaintenanceModeResponse) GetMaintenance() *MaintenanceMode {
+	if x != nil {
+		return x.Maintenance
+	}
+	return nil
+}
+
+type BroadcastAnnouncementRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Message       string                   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`                                              // Up to 500 characters
+	Severity      v12.AnnouncementSeverity `protobuf:"varint,2,opt,name=severity,proto3,enum=notification.v1.AnnouncementSeverity" json:"severity,omitempty"` // UNSPECIFIED uses INFO
+	ExpiresAt     *timestamppb.Timestamp   `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`                         // Optional; must be in the future
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BroadcastAnnouncementRequest) Reset() {
+	*x = BroadcastAnnouncementRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BroadcastAnnouncementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastAnnouncementRequest) ProtoMessage() {}
+
+func (x *BroadcastAnnouncementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastAnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*BroadcastAnnouncementRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *BroadcastAnnouncementRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BroadcastAnnouncementRequest) GetSeverity() v12.AnnouncementSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return v12.AnnouncementSeverity(0)
+}
+
+func (x *BroadcastAnnouncementRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type BroadcastAnnouncementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Announcement  *v12.Announcement      `protobuf:"bytes,1,opt,name=announcement,proto3" json:"announcement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BroadcastAnnouncementResponse) Reset() {
+	*x = BroadcastAnnouncementResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BroadcastAnnouncementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastAnnouncementResponse) ProtoMessage() {}
+
+func (x *BroadcastAnnouncementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastAnnouncementResponse.ProtoReflect.Descriptor instead.
+func (*BroadcastAnnouncementResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *BroadcastAnnouncementResponse) GetAnnouncement() *v12.Announcement {
+	if x != nil {
+		return x.Announcement
+	}
+	return nil
+}
+
+type WorldInventorySettings struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	WorldId         string                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
+	InventorySlots  int32                  `protobuf:"varint,2,opt,name=inventory_slots,json=inventorySlots,proto3" json:"inventory_slots,omitempty"` // 0: unlimited. A stack takes one slot per stack_size items
+	HarvestOverflow v13.OverflowPolicy     `protobuf:"varint,3,opt,name=harvest_overflow,json=harvestOverflow,proto3,enum=inventory.v1.OverflowPolicy" json:"harvest_overflow,omitempty"`
+	UpdatedBy       string                 `protobuf:"bytes,4,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WorldInventorySettings) Reset() {
+	*x = WorldInventorySettings{}
+	mi := &file_admin_v1_admin_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorldInventorySettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorldInventorySettings) ProtoMessage() {}
+
+func (x *WorldInventorySettings) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorldInventorySettings.ProtoReflect.Descriptor instead.
+func (*WorldInventorySettings) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *WorldInventorySettings) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *WorldInventorySettings) GetInventorySlots() int32 {
+	if x != nil {
+		return x.InventorySlots
+	}
+	return 0
+}
+
+func (x *WorldInventorySettings) GetHarvestOverflow() v13.OverflowPolicy {
+	if x != nil {
+		return x.HarvestOverflow
+	}
+	return v13.OverflowPolicy(0)
+}
+
+func (x *WorldInventorySettings) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *WorldInventorySettings) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type SetWorldInventorySettingsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	WorldId         string                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
+	InventorySlots  int32                  `protobuf:"varint,2,opt,name=inventory_slots,json=inventorySlots,proto3" json:"inventory_slots,omitempty"`                                     // 0: unlimited
+	HarvestOverflow v13.OverflowPolicy     `protobuf:"varint,3,opt,name=harvest_overflow,json=harvestOverflow,proto3,enum=inventory.v1.OverflowPolicy" json:"harvest_overflow,omitempty"` // UNSPECIFIED uses REJECT
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetWorldInventorySettingsRequest) Reset() {
+	*x = SetWorldInventorySettingsRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetWorldInventorySettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWorldInventorySettingsRequest) ProtoMessage() {}
+
+func (x *SetWorldInventorySettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWorldInventorySettingsRequest.ProtoReflect.Descriptor instead.
+func (*SetWorldInventorySettingsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SetWorldInventorySettingsRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *SetWorldInventorySettingsRequest) GetInventorySlots() int32 {
+	if x != nil {
+		return x.InventorySlots
+	}
+	return 0
+}
+
+func (x *SetWorldInventorySettingsRequest) GetHarvestOverflow() v13.OverflowPolicy {
+	if x != nil {
+		return x.HarvestOverflow
+	}
+	return v13.OverflowPolicy(0)
+}
+
+type SetWorldInventorySettingsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Settings      *WorldInventorySettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetWorldInventorySettingsResponse) Reset() {
+	*x = SetWorldInventorySettingsResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetWorldInventorySettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWorldInventorySettingsResponse) ProtoMessage() {}
+
+func (x *SetWorldInventorySettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWorldInventorySettingsResponse.ProtoReflect.Descriptor instead.
+func (*SetWorldInventorySettingsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SetWorldInventorySettingsResponse) GetSettings() *WorldInventorySettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type GetWorldInventorySettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorldId       string                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorldInventorySettingsRequest) Reset() {
+	*x = GetWorldInventorySettingsRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorldInventorySettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorldInventorySettingsRequest) ProtoMessage() {}
+
+func (x *GetWorldInventorySettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorldInventorySettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetWorldInventorySettingsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetWorldInventorySettingsRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+type GetWorldInventorySettingsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Settings      *WorldInventorySettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"` // Defaults for worlds that were never configured
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorldInventorySettingsResponse) Reset() {
+	*x = GetWorldInventorySettingsResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorldInventorySettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorldInventorySettingsResponse) ProtoMessage() {}
+
+func (x *GetWorldInventorySettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorldInventorySettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetWorldInventorySettingsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetWorldInventorySettingsResponse) GetSettings() *WorldInventorySettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type WorldTimeScale struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorldId       string                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
+	Multiplier    float64                `protobuf:"fixed64,2,opt,name=multiplier,proto3" json:"multiplier,omitempty"` // Time-dependent systems run this many times faster; 1 is normal speed
+	UpdatedBy     string                 `protobuf:"bytes,3,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorldTimeScale) Reset() {
+	*x = WorldTimeScale{}
+	mi := &file_admin_v1_admin_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorldTimeScale) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorldTimeScale) ProtoMessage() {}
+
+func (x *WorldTimeScale) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorldTimeScale.ProtoReflect.Descriptor instead.
+func (*WorldTimeScale) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *WorldTimeScale) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *WorldTimeScale) GetMultiplier() float64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+func (x *WorldTimeScale) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *WorldTimeScale) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type SetWorldTimeScaleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorldId       string                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
+	Multiplier    float64                `protobuf:"fixed64,2,opt,name=multiplier,proto3" json:"multiplier,omitempty"` // Between 0.1 and 1000; 0 resets to 1
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetWorldTimeScaleRequest) Reset() {
+	*x = SetWorldTimeScaleRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetWorldTimeScaleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWorldTimeScaleRequest) ProtoMessage() {}
+
+func (x *SetWorldTimeScaleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWorldTimeScaleRequest.ProtoReflect.Descriptor instead.
+func (*SetWorldTimeScaleRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SetWorldTimeScaleRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *SetWorldTimeScaleRequest) GetMultiplier() float64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+type SetWorldTimeScaleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TimeScale     *WorldTimeScale        `protobuf:"bytes,1,opt,name=time_scale,json=timeScale,proto3" json:"time_scale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetWorldTimeScaleResponse) Reset() {
+	*x = SetWorldTimeScaleResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetWorldTimeScaleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWorldTimeScaleResponse) ProtoMessage() {}
+
+func (x *SetWorldTimeScaleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWorldTimeScaleResponse.ProtoReflect.Descriptor instead.
+func (*SetWorldTimeScaleResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SetWorldTimeScaleResponse) GetTimeScale() *WorldTimeScale {
+	if x != nil {
+		return x.TimeScale
+	}
+	return nil
+}
+
+type GetWorldTimeScaleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorldId       string                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorldTimeScaleRequest) Reset() {
+	*x = GetWorldTimeScaleRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorldTimeScaleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorldTimeScaleRequest) ProtoMessage() {}
+
+func (x *GetWorldTimeScaleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorldTimeScaleRequest.ProtoReflect.Descriptor instead.
+func (*GetWorldTimeScaleRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetWorldTimeScaleRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+type GetWorldTimeScaleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TimeScale     *WorldTimeScale        `protobuf:"bytes,1,opt,name=time_scale,json=timeScale,proto3" json:"time_scale,omitempty"` // Multiplier 1 for worlds that were never scaled
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorldTimeScaleResponse) Reset() {
+	*x = GetWorldTimeScaleResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorldTimeScaleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorldTimeScaleResponse) ProtoMessage() {}
+
+func (x *GetWorldTimeScaleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorldTimeScaleResponse.ProtoReflect.Descriptor instead.
+func (*GetWorldTimeScaleResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetWorldTimeScaleResponse) GetTimeScale() *WorldTimeScale {
+	if x != nil {
+		return x.TimeScale
+	}
+	return nil
+}
+
+type WorldPause struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorldId       string                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // Shown to players whose writes are rejected
+	PausedBy      string                 `protobuf:"bytes,3,opt,name=paused_by,json=pausedBy,proto3" json:"paused_by,omitempty"`
+	PausedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=paused_at,json=pausedAt,proto3" json:"paused_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorldPause) Reset() {
+	*x = WorldPause{}
+	mi := &file_admin_v1_admin_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorldPause) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorldPause) ProtoMessage() {}
+
+func (x *WorldPause) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorldPause.ProtoReflect.Descriptor instead.
+func (*WorldPause) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *WorldPause) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *WorldPause) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *WorldPause) GetPausedBy() string {
+	if x != nil {
+		return x.PausedBy
+	}
+	return ""
+}
+
+func (x *WorldPause) GetPausedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PausedAt
+	}
+	return nil
+}
+
+type PauseWorldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorldId       string                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // At most 500 characters
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseWorldRequest) Reset() {
+	*x = PauseWorldRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseWorldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseWorldRequest) ProtoMessage() {}
+
+func (x *PauseWorldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseWorldRequest.ProtoReflect.Descriptor instead.
+func (*PauseWorldRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *PauseWorldRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *PauseWorldRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type PauseWorldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pause         *WorldPause            `protobuf:"bytes,1,opt,name=pause,proto3" json:"pause,omitempty"` // Pausing a paused world replaces its reason
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseWorldResponse) Reset() {
+	*x = PauseWorldResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseWorldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseWorldResponse) ProtoMessage() {}
+
+func (x *PauseWorldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseWorldResponse.ProtoReflect.Descriptor instead.
+func (*PauseWorldResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *PauseWorldResponse) GetPause() *WorldPause {
+	if x != nil {
+		return x.Pause
+	}
+	return nil
+}
+
+type ResumeWorldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorldId       string                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeWorldRequest) Reset() {
+	*x = ResumeWorldRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeWorldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeWorldRequest) ProtoMessage() {}
+
+func (x *ResumeWorldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeWorldRequest.ProtoReflect.Descriptor instead.
+func (*ResumeWorldRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ResumeWorldRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+type ResumeWorldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resumed       bool                   `protobuf:"varint,1,opt,name=resumed,proto3" json:"resumed,omitempty"` // False when the world was not paused
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeWorldResponse) Reset() {
+	*x = ResumeWorldResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeWorldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeWorldResponse) ProtoMessage() {}
+
+func (x *ResumeWorldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeWorldResponse.ProtoReflect.Descriptor instead.
+func (*ResumeWorldResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ResumeWorldResponse) GetResumed() bool {
+	if x != nil {
+		return x.Resumed
+	}
+	return false
+}
+
+type ListWorldPausesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWorldPausesRequest) Reset() {
+	*x = ListWorldPausesRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWorldPausesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorldPausesRequest) ProtoMessage() {}
+
+func (x *ListWorldPausesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorldPausesRequest.ProtoReflect.Descriptor instead.
+func (*ListWorldPausesRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{56}
+}
+
+type ListWorldPausesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pauses        []*WorldPause          `protobuf:"bytes,1,rep,name=pauses,proto3" json:"pauses,omitempty"` // Oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWorldPausesResponse) Reset() {
+	*x = ListWorldPausesResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWorldPausesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorldPausesResponse) ProtoMessage() {}
+
+func (x *ListWorldPausesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorldPausesResponse.ProtoReflect.Descriptor instead.
+func (*ListWorldPausesResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListWorldPausesResponse) GetPauses() []*WorldPause {
+	if x != nil {
+		return x.Pauses
+	}
+	return nil
+}
+
+type ApplyStatusEffectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	EffectKey     string                 `protobuf:"bytes,2,opt,name=effect_key,json=effectKey,proto3" json:"effect_key,omitempty"` // A defined effect, e.g. "swiftness"
+	Source        string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`                        // Defaults to "admin"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyStatusEffectRequest) Reset() {
+	*x = ApplyStatusEffectRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyStatusEffectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyStatusEffectRequest) ProtoMessage() {}
+
+func (x *ApplyStatusEffectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyStatusEffectRequest.ProtoReflect.Descriptor instead.
+func (*ApplyStatusEffectRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ApplyStatusEffectRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *ApplyStatusEffectRequest) GetEffectKey() string {
+	if x != nil {
+		return x.EffectKey
+	}
+	return ""
+}
+
+func (x *ApplyStatusEffectRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type ApplyStatusEffectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Effect        *v14.StatusEffect      `protobuf:"bytes,1,opt,name=effect,proto3" json:"effect,omitempty"` // After stacking with an active application
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyStatusEffectResponse) Reset() {
+	*x = ApplyStatusEffectResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyStatusEffectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyStatusEffectResponse) ProtoMessage() {}
+
+func (x *ApplyStatusEffectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyStatusEffectResponse.ProtoReflect.Descriptor instead.
+func (*ApplyStatusEffectResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ApplyStatusEffectResponse) GetEffect() *v14.StatusEffect {
+	if x != nil {
+		return x.Effect
+	}
+	return nil
+}
+
+type RemoveStatusEffectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	EffectKey     string                 `protobuf:"bytes,2,opt,name=effect_key,json=effectKey,proto3" json:"effect_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveStatusEffectRequest) Reset() {
+	*x = RemoveStatusEffectRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveStatusEffectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveStatusEffectRequest) ProtoMessage() {}
+
+func (x *RemoveStatusEffectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveStatusEffectRequest.ProtoReflect.Descriptor instead.
+func (*RemoveStatusEffectRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *RemoveStatusEffectRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *RemoveStatusEffectRequest) GetEffectKey() string {
+	if x != nil {
+		return x.EffectKey
+	}
+	return ""
+}
+
+type RemoveStatusEffectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Removed       bool                   `protobuf:"varint,1,opt,name=removed,proto3" json:"removed,omitempty"` // False if the character did not have the effect
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveStatusEffectResponse) Reset() {
+	*x = RemoveStatusEffectResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveStatusEffectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveStatusEffectResponse) ProtoMessage() {}
+
+func (x *RemoveStatusEffectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveStatusEffectResponse.ProtoReflect.Descriptor instead.
+func (*RemoveStatusEffectResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *RemoveStatusEffectResponse) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
+}
+
+// Currency one source created or destroyed
+type EconomySourceStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"` // e.g. "harvest", "market_sale_tax", "market_listing_fee", "land_claim"
+	Created       int64                  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Destroyed     int64                  `protobuf:"varint,3,opt,name=destroyed,proto3" json:"destroyed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EconomySourceStats) Reset() {
+	*x = EconomySourceStats{}
+	mi := &file_admin_v1_admin_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EconomySourceStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EconomySourceStats) ProtoMessage() {}
+
+func (x *EconomySourceStats) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EconomySourceStats.ProtoReflect.Descriptor instead.
+func (*EconomySourceStats) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *EconomySourceStats) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *EconomySourceStats) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *EconomySourceStats) GetDestroyed() int64 {
+	if x != nil {
+		return x.Destroyed
+	}
+	return 0
+}
+
+type EconomyPeriodStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	Created       int64                  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Destroyed     int64                  `protobuf:"varint,3,opt,name=destroyed,proto3" json:"destroyed,omitempty"`
+	Net           int64                  `protobuf:"varint,4,opt,name=net,proto3" json:"net,omitempty"`        // created - destroyed; positive means more currency in circulation
+	Sources       []*EconomySourceStats  `protobuf:"bytes,5,rep,name=sources,proto3" json:"sources,omitempty"` // By source name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EconomyPeriodStats) Reset() {
+	*x = EconomyPeriodStats{}
+	mi := &file_admin_v1_admin_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EconomyPeriodStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EconomyPeriodStats) ProtoMessage() {}
+
+func (x *EconomyPeriodStats) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EconomyPeriodStats.ProtoReflect.Descriptor instead.
+func (*EconomyPeriodStats) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *EconomyPeriodStats) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *EconomyPeriodStats) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *EconomyPeriodStats) GetDestroyed() int64 {
+	if x != nil {
+		return x.Destroyed
+	}
+	return 0
+}
+
+func (x *EconomyPeriodStats) GetNet() int64 {
+	if x != nil {
+		return x.Net
+	}
+	return 0
+}
+
+func (x *EconomyPeriodStats) GetSources() []*EconomySourceStats {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+type GetEconomyStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorldId       string                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
+	Period        EconomyPeriod          `protobuf:"varint,2,opt,name=period,proto3,enum=admin.v1.EconomyPeriod" json:"period,omitempty"`
+	Periods       int32                  `protobuf:"varint,3,opt,name=periods,proto3" json:"periods,omitempty"` // How many periods back, the current one included; 0 uses the default, larger values are capped
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEconomyStatsRequest) Reset() {
+	*x = GetEconomyStatsRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEconomyStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEconomyStatsRequest) ProtoMessage() {}
+
+func (x *GetEconomyStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEconomyStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetEconomyStatsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetEconomyStatsRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *GetEconomyStatsRequest) GetPeriod() EconomyPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return EconomyPeriod_ECONOMY_PERIOD_UNSPECIFIED
+}
+
+func (x *GetEconomyStatsRequest) GetPeriods() int32 {
+	if x != nil {
+		return x.Periods
+	}
+	return 0
+}
+
+type GetEconomyStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CurrencyItem  string                 `protobuf:"bytes,1,opt,name=currency_item,json=currencyItem,proto3" json:"currency_item,omitempty"`
+	Periods       []*EconomyPeriodStats  `protobuf:"bytes,2,rep,name=periods,proto3" json:"periods,omitempty"`  // Oldest first; periods without movements are left out
+	Created       int64                  `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"` // Totals over the periods
+	Destroyed     int64                  `protobuf:"varint,4,opt,name=destroyed,proto3" json:"destroyed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEconomyStatsResponse) Reset() {
+	*x = GetEconomyStatsResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEconomyStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEconomyStatsResponse) ProtoMessage() {}
+
+func (x *GetEconomyStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEconomyStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetEconomyStatsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetEconomyStatsResponse) GetCurrencyItem() string {
+	if x != nil {
+		return x.CurrencyItem
+	}
+	return ""
+}
+
+func (x *GetEconomyStatsResponse) GetPeriods() []*EconomyPeriodStats {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+func (x *GetEconomyStatsResponse) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *GetEconomyStatsResponse) GetDestroyed() int64 {
+	if x != nil {
+		return x.Destroyed
+	}
+	return 0
+}
+
+type LookupCharacterNameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorldId       string                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`    // Compared ignoring case
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"` // 0 uses the default, larger values are capped
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookupCharacterNameRequest) Reset() {
+	*x = LookupCharacterNameRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupCharacterNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupCharacterNameRequest) ProtoMessage() {}
+
+func (x *LookupCharacterNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupCharacterNameRequest.ProtoReflect.Descriptor instead.
+func (*LookupCharacterNameRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *LookupCharacterNameRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *LookupCharacterNameRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LookupCharacterNameRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type LookupCharacterNameResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Characters currently using the name; names are unique, but some may predate that
+	Characters    []*v14.Character           `protobuf:"bytes,1,rep,name=characters,proto3" json:"characters,omitempty"`
+	Changes       []*v14.CharacterNameChange `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"` // Newest first, user_id set
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookupCharacterNameResponse) Reset() {
+	*x = LookupCharacterNameResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupCharacterNameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupCharacterNameResponse) ProtoMessage() {}
+
+func (x *LookupCharacterNameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupCharacterNameResponse.ProtoReflect.Descriptor instead.
+func (*LookupCharacterNameResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *LookupCharacterNameResponse) GetCharacters() []*v14.Character {
+	if x != nil {
+		return x.Characters
+	}
+	return nil
+}
+
+func (x *LookupCharacterNameResponse) GetChanges() []*v14.CharacterNameChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type PublishConsentDocumentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          v15.ConsentKind        `protobuf:"varint,1,opt,name=kind,proto3,enum=user.v1.ConsentKind" json:"kind,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`            // Where the full text is published; http(s)
+	Summary       string                 `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`    // What changed, up to 500 characters
+	Required      bool                   `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"` // false for edits players need not accept again, e.g. typo fixes
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishConsentDocumentRequest) Reset() {
+	*x = PublishConsentDocumentRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishConsentDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishConsentDocumentRequest) ProtoMessage() {}
+
+func (x *PublishConsentDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishConsentDocumentRequest.ProtoReflect.Descriptor instead.
+func (*PublishConsentDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *PublishConsentDocumentRequest) GetKind() v15.ConsentKind {
+	if x != nil {
+		return x.Kind
+	}
+	return v15.ConsentKind(0)
+}
+
+func (x *PublishConsentDocumentRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *PublishConsentDocumentRequest) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *PublishConsentDocumentRequest) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+type PublishConsentDocumentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Document      *v15.ConsentDocument   `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"` // Caller fields (accepted_version, must_accept) are unset
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishConsentDocumentResponse) Reset() {
+	*x = PublishConsentDocumentResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishConsentDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishConsentDocumentResponse) ProtoMessage() {}
+
+func (x *PublishConsentDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishConsentDocumentResponse.ProtoReflect.Descriptor instead.
+func (*PublishConsentDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *PublishConsentDocumentResponse) GetDocument() *v15.ConsentDocument {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+var File_admin_v1_admin_proto protoreflect.FileDescriptor
+
+const file_admin_v1_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x14admin/v1/admin.proto\x12\badmin.v1\x1a\x1ccharacter/v1/character.proto\x1a\x14chunk/v1/chunk.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cinventory/v1/inventory.proto\x1a\"notification/v1/notification.proto\x1a\x16social/v1/social.proto\x1a\x12user/v1/user.proto\"|\n" +
+	"\x18ListPlayerReportsRequest\x12/\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x17.social.v1.ReportStatusR\x06status\x12\x19\n" +
+	"\bafter_id\x18\x02 \x01(\x03R\aafterId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"N\n" +
+	"\x19ListPlayerReportsResponse\x121\n" +
+	"\areports\x18\x01 \x03(\v2\x17.social.v1.PlayerReportR\areports\"Y\n" +
+	"\x1aResolvePlayerReportRequest\x12\x1b\n" +
+	"\treport_id\x18\x01 \x01(\x03R\breportId\x12\x1e\n" +
+	"\n" +
+	"resolution\x18\x02 \x01(\tR\n" +
+	"resolution\"N\n" +
+	"\x1bResolvePlayerReportResponse\x12/\n" +
+	"\x06report\x18\x01 \x01(\v2\x17.social.v1.PlayerReportR\x06report\"\xe9\x02\n" +
+	"\x06ApiKey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x15\n" +
+	"\x06key_id\x18\x02 \x01(\tR\x05keyId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x05 \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"revoked_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\x12<\n" +
+	"\flast_used_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\"|\n" +
+	"\x13CreateApiKeyRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x02 \x03(\tR\x06scopes\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"W\n" +
+	"\x14CreateApiKeyResponse\x12)\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x10.admin.v1.ApiKeyR\x06apiKey\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"\x14\n" +
+	"\x12ListApiKeysRequest\"B\n" +
+	"\x13ListApiKeysResponse\x12+\n" +
+	"\bapi_keys\x18\x01 \x03(\v2\x10.admin.v1.ApiKeyR\aapiKeys\"%\n" +
+	"\x13RevokeApiKeyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"A\n" +
+	"\x14RevokeApiKeyResponse\x12)\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x10.admin.v1.ApiKeyR\x06apiKey\"\xde\x01\n" +
+	"\x1cCreateProtectedRegionRequest\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\tR\aworldId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12*\n" +
+	"\x05flags\x18\x03 \x03(\x0e2\x14.chunk.v1.RegionFlagR\x05flags\x12/\n" +
+	"\apolygon\x18\x04 \x03(\v2\x15.chunk.v1.RegionPointR\apolygon\x122\n" +
+	"\n" +
+	"chunk_rect\x18\x05 \x01(\v2\x13.chunk.v1.ChunkRectR\tchunkRect\"R\n" +
+	"\x1dCreateProtectedRegionResponse\x121\n" +
+	"\x06region\x18\x01 \x01(\v2\x19.chunk.v1.ProtectedRegionR\x06region\"\xd3\x01\n" +
+	"\x1cUpdateProtectedRegionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12*\n" +
+	"\x05flags\x18\x03 \x03(\x0e2\x14.chunk.v1.RegionFlagR\x05flags\x12/\n" +
+	"\apolygon\x18\x04 \x03(\v2\x15.chunk.v1.RegionPointR\apolygon\x122\n" +
+	"\n" +
+	"chunk_rect\x18\x05 \x01(\v2\x13.chunk.v1.ChunkRectR\tchunkRect\"R\n" +
+	"\x1dUpdateProtectedRegionResponse\x121\n" +
+	"\x06region\x18\x01 \x01(\v2\x19.chunk.v1.ProtectedRegionR\x06region\"8\n" +
+	"\x1bListProtectedRegionsRequest\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\tR\aworldId\"S\n" +
+	"\x1cListProtectedRegionsResponse\x123\n" +
+	"\aregions\x18\x01 \x03(\v2\x19.chunk.v1.ProtectedRegionR\aregions\".\n" +
+	"\x1cDeleteProtectedRegionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"R\n" +
+	"\x1dDeleteProtectedRegionResponse\x121\n" +
+	"\x06region\x18\x01 \x01(\v2\x19.chunk.v1.ProtectedRegionR\x06region\"\xdf\x02\n" +
+	"\vFeatureFlag\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x18\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\x12-\n" +
+	"\x12rollout_percentage\x18\x04 \x01(\x05R\x11rolloutPercentage\x12\x19\n" +
+	"\buser_ids\x18\x05 \x03(\tR\auserIds\x12\x1b\n" +
+	"\tworld_ids\x18\x06 \x03(\tR\bworldIds\x12\x16\n" +
+	"\x06stored\x18\a \x01(\bR\x06stored\x12'\n" +
+	"\x0fdefault_enabled\x18\b \x01(\bR\x0edefaultEnabled\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\t \x01(\tR\tupdatedBy\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"B\n" +
+	"\x15SetFeatureFlagRequest\x12)\n" +
+	"\x04flag\x18\x01 \x01(\v2\x15.admin.v1.FeatureFlagR\x04flag\"C\n" +
+	"\x16SetFeatureFlagResponse\x12)\n" +
+	"\x04flag\x18\x01 \x01(\v2\x15.admin.v1.FeatureFlagR\x04flag\"\x19\n" +
+	"\x17ListFeatureFlagsRequest\"G\n" +
+	"\x18ListFeatureFlagsResponse\x12+\n" +
+	"\x05flags\x18\x01 \x03(\v2\x15.admin.v1.FeatureFlagR\x05flags\".\n" +
+	"\x18DeleteFeatureFlagRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"F\n" +
+	"\x19DeleteFeatureFlagResponse\x12)\n" +
+	"\x04flag\x18\x01 \x01(\v2\x15.admin.v1.FeatureFlagR\x04flag\"\xdd\x01\n" +
+	"\x11ExperimentVariant\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06weight\x18\x02 \x01(\x05R\x06weight\x12?\n" +
+	"\x06params\x18\x03 \x03(\v2'.admin.v1.ExperimentVariant.ParamsEntryR\x06params\x12 \n" +
+	"\vassignments\x18\x04 \x01(\x03R\vassignments\x1a9\n" +
+	"\vParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\xc7\x02\n" +
+	"\n" +
+	"Experiment\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x125\n" +
+	"\asubject\x18\x03 \x01(\x0e2\x1b.admin.v1.ExperimentSubjectR\asubject\x127\n" +
+	"\bvariants\x18\x04 \x03(\v2\x1b.admin.v1.ExperimentVariantR\bvariants\x129\n" +
+	"\n" +
+	"started_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x129\n" +
+	"\n" +
+	"stopped_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tstoppedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\a \x01(\tR\tcreatedBy\"O\n" +
+	"\x17CreateExperimentRequest\x124\n" +
+	"\n" +
+	"experiment\x18\x01 \x01(\v2\x14.admin.v1.ExperimentR\n" +
+	"experiment\"P\n" +
+	"\x18CreateExperimentResponse\x124\n" +
+	"\n" +
+	"experiment\x18\x01 \x01(\v2\x14.admin.v1.ExperimentR\n" +
+	"experiment\"\x18\n" +
+	"\x16ListExperimentsRequest\"Q\n" +
+	"\x17ListExperimentsResponse\x126\n" +
+	"\vexperiments\x18\x01 \x03(\v2\x14.admin.v1.ExperimentR\vexperiments\"+\n" +
+	"\x15StopExperimentRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"N\n" +
+	"\x16StopExperimentResponse\x124\n" +
+	"\n" +
+	"experiment\x18\x01 \x01(\v2\x14.admin.v1.ExperimentR\n" +
+	"experiment\"\xab\x02\n" +
+	"\x0fMaintenanceMode\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12,\n" +
+	"\x03eta\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x03eta\x127\n" +
+	"\tfreeze_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bfreezeAt\x12#\n" +
+	"\rwrites_frozen\x18\x05 \x01(\bR\fwritesFrozen\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x06 \x01(\tR\tupdatedBy\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xaf\x01\n" +
+	"\x19SetMaintenanceModeRequest\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12,\n" +
+	"\x03eta\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x03eta\x120\n" +
+	"\x14grace_period_seconds\x18\x04 \x01(\x05R\x12gracePeriodSeconds\"Y\n" +
+	"\x1aSetMaintenanceModeResponse\x12;\n" +
+	"\vmaintenance\x18\x01 \x01(\v2\x19.admin.v1.MaintenanceModeR\vmaintenance\"\x1b\n" +
+	"\x19GetMaintenanceModeRequest\"Y\n" +
+	"\x1aGetMaintenanceModeResponse\x12;\n" +
+	"\vmaintenance\x18\x01 \x01(\v2\x19.admin.v1.MaintenanceModeR\vmaintenance\"\xb6\x01\n" +
+	"\x1cBroadcastAnnouncementRequest\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12A\n" +
+	"\bseverity\x18\x02 \x01(\x0e2%.notification.v1.AnnouncementSeverityR\bseverity\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"b\n" +
+	"\x1dBroadcastAnnouncementResponse\x12A\n" +
+	"\fannouncement\x18\x01 \x01(\v2\x1d.notification.v1.AnnouncementR\fannouncement\"\xff\x01\n" +
+	"\x16WorldInventorySettings\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\tR\aworldId\x12'\n" +
+	"\x0finventory_slots\x18\x02 \x01(\x05R\x0einventorySlots\x12G\n" +
+	"\x10harvest_overflow\x18\x03 \x01(\x0e2\x1c.inventory.v1.OverflowPolicyR\x0fharvestOverflow\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x04 \x01(\tR\tupdatedBy\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xaf\x01\n" +
+	" SetWorldInventorySettingsRequest\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\tR\aworldId\x12'\n" +
+	"\x0finventory_slots\x18\x02 \x01(\x05R\x0einventorySlots\x12G\n" +
+	"\x10harvest_overflow\x18\x03 \x01(\x0e2\x1c.inventory.v1.OverflowPolicyR\x0fharvestOverflow\"a\n" +
+	"!SetWorldInventorySettingsResponse\x12<\n" +
+	"\bsettings\x18\x01 \x01(\v2 .admin.v1.WorldInventorySettingsR\bsettings\"=\n" +
+	" GetWorldInventorySettingsRequest\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\tR\aworldId\"a\n" +
+	"!GetWorldInventorySettingsResponse\x12<\n" +
+	"\bsettings\x18\x01 \x01(\v2 .admin.v1.WorldInventorySettingsR\bsettings\"\xa5\x01\n" +
+	"\x0eWorldTimeScale\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\tR\aworldId\x12\x1e\n" +
+	"\n" +
+	"multiplier\x18\x02 \x01(\x01R\n" +
+	"multiplier\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x03 \x01(\tR\tupdatedBy\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"U\n" +
+	"\x18SetWorldTimeScaleRequest\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\tR\aworldId\x12\x1e\n" +
+	"\n" +
+	"multiplier\x18\x02 \x01(\x01R\n" +
+	"multiplier\"T\n" +
+	"\x19SetWorldTimeScaleResponse\x127\n" +
+	"\n" +
+	"time_scale\x18\x01 \x01(\v2\x18.admin.v1.WorldTimeScaleR\ttimeScale\"5\n" +
+	"\x18GetWorldTimeScaleRequest\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\tR\aworldId\"T\n" +
+	"\x19GetWorldTimeScaleResponse\x127\n" +
+	"\n" +
+	"time_scale\x18\x01 \x01(\v2\x18.admin.v1.WorldTimeScaleR\ttimeScale\"\x95\x01\n" +
+	"\n" +
+	"WorldPause\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\tR\aworldId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x1b\n" +
+	"\tpaused_by\x18\x03 \x01(\tR\bpausedBy\x127\n" +
+	"\tpaused_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bpausedAt\"F\n" +
+	"\x11PauseWorldRequest\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\tR\aworldId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"@\n" +
+	"\x12PauseWorldResponse\x12*\n" +
+	"\x05pause\x18\x01 \x01(\v2\x14.admin.v1.WorldPauseR\x05pause\"/\n" +
+	"\x12ResumeWorldRequest\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\tR\aworldId\"/\n" +
+	"\x13ResumeWorldResponse\x12\x18\n" +
+	"\aresumed\x18\x01 \x01(\bR\aresumed\"\x18\n" +
+	"\x16ListWorldPausesRequest\"G\n" +
+	"\x17ListWorldPausesResponse\x12,\n" +
+	"\x06pauses\x18\x01 \x03(\v2\x14.admin.v1.WorldPauseR\x06pauses\"t\n" +
+	"\x18ApplyStatusEffectRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x1d\n" +
+	"\n" +
+	"effect_key\x18\x02 \x01(\tR\teffectKey\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\"O\n" +
+	"\x19ApplyStatusEffectResponse\x122\n" +
+	"\x06effect\x18\x01 \x01(\v2\x1a.character.v1.StatusEffectR\x06effect\"]\n" +
+	"\x19RemoveStatusEffectRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x1d\n" +
+	"\n" +
+	"effect_key\x18\x02 \x01(\tR\teffectKey\"6\n" +
+	"\x1aRemoveStatusEffectResponse\x12\x18\n" +
+	"\aremoved\x18\x01 \x01(\bR\aremoved\"d\n" +
+	"\x12EconomySourceStats\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\x03R\acreated\x12\x1c\n" +
+	"\tdestroyed\x18\x03 \x01(\x03R\tdestroyed\"\xc8\x01\n" +
+	"\x12EconomyPeriodStats\x120\n" +
+	"\x05start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\x03R\acreated\x12\x1c\n" +
+	"\tdestroyed\x18\x03 \x01(\x03R\tdestroyed\x12\x10\n" +
+	"\x03net\x18\x04 \x01(\x03R\x03net\x126\n" +
+	"\asources\x18\x05 \x03(\v2\x1c.admin.v1.EconomySourceStatsR\asources\"~\n" +
+	"\x16GetEconomyStatsRequest\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\tR\aworldId\x12/\n" +
+	"\x06period\x18\x02 \x01(\x0e2\x17.admin.v1.EconomyPeriodR\x06period\x12\x18\n" +
+	"\aperiods\x18\x03 \x01(\x05R\aperiods\"\xae\x01\n" +
+	"\x17GetEconomyStatsResponse\x12#\n" +
+	"\rcurrency_item\x18\x01 \x01(\tR\fcurrencyItem\x126\n" +
+	"\aperiods\x18\x02 \x03(\v2\x1c.admin.v1.EconomyPeriodStatsR\aperiods\x12\x18\n" +
+	"\acreated\x18\x03 \x01(\x03R\acreated\x12\x1c\n" +
+	"\tdestroyed\x18\x04 \x01(\x03R\tdestroyed\"a\n" +
+	"\x1aLookupCharacterNameRequest\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\tR\aworldId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\x93\x01\n" +
+	"\x1bLookupCharacterNameResponse\x127\n" +
+	"\n" +
+	"characters\x18\x01 \x03(\v2\x17.character.v1.CharacterR\n" +
+	"characters\x12;\n" +
+	"\achanges\x18\x02 \x03(\v2!.character.v1.CharacterNameChangeR\achanges\"\x91\x01\n" +
+	"\x1dPublishConsentDocumentRequest\x12(\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x14.user.v1.ConsentKindR\x04kind\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x18\n" +
+	"\asummary\x18\x03 \x01(\tR\asummary\x12\x1a\n" +
+	"\brequired\x18\x04 \x01(\bR\brequired\"V\n" +
+	"\x1ePublishConsentDocumentResponse\x124\n" +
+	"\bdocument\x18\x01 \x01(\v2\x18.user.v1.ConsentDocumentR\bdocument*w\n" +
+	"\x11ExperimentSubject\x12\"\n" +
+	"\x1eEXPERIMENT_SUBJECT_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18EXPERIMENT_SUBJECT_WORLD\x10\x01\x12 \n" +
+	"\x1cEXPERIMENT_SUBJECT_CHARACTER\x10\x02*y\n" +
+	"\rEconomyPeriod\x12\x1e\n" +
+	"\x1aECONOMY_PERIOD_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13ECONOMY_PERIOD_HOUR\x10\x01\x12\x16\n" +
+	"\x12ECONOMY_PERIOD_DAY\x10\x02\x12\x17\n" +
+	"\x13ECONOMY_PERIOD_WEEK\x10\x032\xda\x16\n" +
+	"\fAdminService\x12^\n" +
+	"\x11ListPlayerReports\x12\".admin.v1.ListPlayerReportsRequest\x1a#.admin.v1.ListPlayerReportsResponse\"\x00\x12d\n" +
+	"\x13ResolvePlayerReport\x12$.admin.v1.ResolvePlayerReportRequest\x1a%.admin.v1.ResolvePlayerReportResponse\"\x00\x12O\n" +
+	"\fCreateApiKey\x12\x1d.admin.v1.CreateApiKeyRequest\x1a\x1e.admin.v1.CreateApiKeyResponse\"\x00\x12L\n" +
+	"\vListApiKeys\x12\x1c.admin.v1.ListApiKeysRequest\x1a\x1d.admin.v1.ListApiKeysResponse\"\x00\x12O\n" +
+	"\fRevokeApiKey\x12\x1d.admin.v1.RevokeApiKeyRequest\x1a\x1e.admin.v1.RevokeApiKeyResponse\"\x00\x12j\n" +
+	"\x15CreateProtectedRegion\x12&.admin.v1.CreateProtectedRegionRequest\x1a'.admin.v1.CreateProtectedRegionResponse\"\x00\x12j\n" +
+	"\x15UpdateProtectedRegion\x12&.admin.v1.UpdateProtectedRegionRequest\x1a'.admin.v1.UpdateProtectedRegionResponse\"\x00\x12g\n" +
+	"\x14ListProtectedRegions\x12%.admin.v1.ListProtectedRegionsRequest\x1a&.admin.v1.ListProtectedRegionsResponse\"\x00\x12j\n" +
+	"\x15DeleteProtectedRegion\x12&.admin.v1.DeleteProtectedRegionRequest\x1a'.admin.v1.DeleteProtectedRegionResponse\"\x00\x12U\n" +
+	"\x0eSetFeatureFlag\x12\x1f.admin.v1.SetFeatureFlagRequest\x1a .admin.v1.SetFeatureFlagResponse\"\x00\x12[\n" +
+	"\x10ListFeatureFlags\x12!.admin.v1.ListFeatureFlagsRequest\x1a\".admin.v1.ListFeatureFlagsResponse\"\x00\x12^\n" +
+	"\x11DeleteFeatureFlag\x12\".admin.v1.DeleteFeatureFlagRequest\x1a#.admin.v1.DeleteFeatureFlagResponse\"\x00\x12[\n" +
+	"\x10CreateExperiment\x12!.admin.v1.CreateExperimentRequest\x1a\".admin.v1.CreateExperimentResponse\"\x00\x12X\n" +
+	"\x0fListExperiments\x12 .admin.v1.ListExperimentsRequest\x1a!.admin.v1.ListExperimentsResponse\"\x00\x12U\n" +
+	"\x0eStopExperiment\x12\x1f.admin.v1.StopExperimentRequest\x1a .admin.v1.StopExperimentResponse\"\x00\x12a\n" +
+	"\x12SetMaintenanceMode\x12#.admin.v1.SetMaintenanceModeRequest\x1a$.admin.v1.SetMaintenanceModeResponse\"\x00\x12a\n" +
+	"\x12GetMaintenanceMode\x12#.admin.v1.GetMaintenanceModeRequest\x1a$.admin.v1.GetMaintenanceModeResponse\"\x00\x12j\n" +
+	"\x15BroadcastAnnouncement\x12&.admin.v1.BroadcastAnnouncementRequest\x1a'.admin.v1.BroadcastAnnouncementResponse\"\x00\x12v\n" +
+	"\x19SetWorldInventorySettings\x12*.admin.v1.SetWorldInventorySettingsRequest\x1a+.admin.v1.SetWorldInventorySettingsResponse\"\x00\x12v\n" +
+	"\x19GetWorldInventorySettings\x12*.admin.v1.GetWorldInventorySettingsRequest\x1a+.admin.v1.GetWorldInventorySettingsResponse\"\x00\x12^\n" +
+	"\x11SetWorldTimeScale\x12\".admin.v1.SetWorldTimeScaleRequest\x1a#.admin.v1.SetWorldTimeScaleResponse\"\x00\x12^\n" +
+	"\x11GetWorldTimeScale\x12\".admin.v1.GetWorldTimeScaleRequest\x1a#.admin.v1.GetWorldTimeScaleResponse\"\x00\x12I\n" +
+	"\n" +
+	"PauseWorld\x12\x1b.admin.v1.PauseWorldRequest\x1a\x1c.admin.v1.PauseWorldResponse\"\x00\x12L\n" +
+	"\vResumeWorld\x12\x1c.admin.v1.ResumeWorldRequest\x1a\x1d.admin.v1.ResumeWorldResponse\"\x00\x12X\n" +
+	"\x0fListWorldPauses\x12 .admin.v1.ListWorldPausesRequest\x1a!.admin.v1.ListWorldPausesResponse\"\x00\x12^\n" +
+	"\x11ApplyStatusEffect\x12\".admin.v1.ApplyStatusEffectRequest\x1a#.admin.v1.ApplyStatusEffectResponse\"\x00\x12a\n" +
+	"\x12RemoveStatusEffect\x12#.admin.v1.RemoveStatusEffectRequest\x1a$.admin.v1.RemoveStatusEffectResponse\"\x00\x12X\n" +
+	"\x0fGetEconomyStats\x12 .admin.v1.GetEconomyStatsRequest\x1a!.admin.v1.GetEconomyStatsResponse\"\x00\x12d\n" +
+	"\x13LookupCharacterName\x12$.admin.v1.LookupCharacterNameRequest\x1a%.admin.v1.LookupCharacterNameResponse\"\x00\x12m\n" +
+	"\x16PublishConsentDocument\x12'.admin.v1.PublishConsentDocumentRequest\x1a(.admin.v1.PublishConsentDocumentResponse\"\x00B,Z*github.com/VoidMesh/api/api/proto/admin/v1b\x06proto3"
+
+var (
+	file_admin_v1_admin_proto_rawDescOnce sync.Once
+	file_admin_v1_admin_proto_rawDescData []byte
+)
+
+func file_admin_v1_admin_proto_rawDescGZIP() []byte {
+	file_admin_v1_admin_proto_rawDescOnce.Do(func() {
+		file_admin_v1_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_admin_v1_admin_proto_rawDesc), len(file_admin_v1_admin_proto_rawDesc)))
+	})
+	return file_admin_v1_admin_proto_rawDescData
+}
+
+var file_admin_v1_admin_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_admin_v1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 71)
+var file_admin_v1_admin_proto_goTypes = []any{
+	(ExperimentSubject)(0),                    // 0: admin.v1.ExperimentSubject
+	(EconomyPeriod)(0),                        // 1: admin.v1.EconomyPeriod
+	(*ListPlayerReportsRequest)(nil),          // 2: admin.v1.ListPlayerReportsRequest
+	(*ListPlayerReportsResponse)(nil),         // 3: admin.v1.ListPlayerReportsResponse
+	(*ResolvePlayerReportRequest)(nil),        // 4: admin.v1.ResolvePlayerReportRequest
+	(*ResolvePlayerReportResponse)(nil),       // 5: admin.v1.ResolvePlayerReportResponse
+	(*ApiKey)(nil),                            // 6: admin.v1.ApiKey
+	(*CreateApiKeyRequest)(nil),               // 7: admin.v1.CreateApiKeyRequest
+	(*CreateApiKeyResponse)(nil),              // 8: admin.v1.CreateApiKeyResponse
+	(*ListApiKeysRequest)(nil),                // 9: admin.v1.ListApiKeysRequest
+	(*ListApiKeysResponse)(nil),               // 10: admin.v1.ListApiKeysResponse
+	(*RevokeApiKeyRequest)(nil),               // 11: admin.v1.RevokeApiKeyRequest
+	(*RevokeApiKeyResponse)(nil),              // 12: admin.v1.RevokeApiKeyResponse
+	(*CreateProtectedRegionRequest)(nil),      // 13: admin.v1.CreateProtectedRegionRequest
+	(*CreateProtectedRegionResponse)(nil),     // 14: admin.v1.CreateProtectedRegionResponse
+	(*UpdateProtectedRegionRequest)(nil),      // 15: admin.v1.UpdateProtectedRegionRequest
+	(*UpdateProtectedRegionResponse)(nil),     // 16: admin.v1.UpdateProtectedRegionResponse
+	(*ListProtectedRegionsRequest)(nil),       // 17: admin.v1.ListProtectedRegionsRequest
+	(*ListProtectedRegionsResponse)(nil),      // 18: admin.v1.ListProtectedRegionsResponse
+	(*DeleteProtectedRegionRequest)(nil),      // 19: admin.v1.DeleteProtectedRegionRequest
+	(*DeleteProtectedRegionResponse)(nil),     // 20: admin.v1.DeleteProtectedRegionResponse
+	(*FeatureFlag)(nil),                       // 21: admin.v1.FeatureFlag
+	(*SetFeatureFlagRequest)(nil),             // 22: admin.v1.SetFeatureFlagRequest
+	(*SetFeatureFlagResponse)(nil),            // 23: admin.v1.SetFeatureFlagResponse
+	(*ListFeatureFlagsRequest)(nil),           // 24: admin.v1.ListFeatureFlagsRequest
+	(*ListFeatureFlagsResponse)(nil),          // 25: admin.v1.ListFeatureFlagsResponse
+	(*DeleteFeatureFlagRequest)(nil),          // 26: admin.v1.DeleteFeatureFlagRequest
+	(*DeleteFeatureFlagResponse)(nil),         // 27: admin.v1.DeleteFeatureFlagResponse
+	(*ExperimentVariant)(nil),                 // 28: admin.v1.ExperimentVariant
+	(*Experiment)(nil),                        // 29: admin.v1.Experiment
+	(*CreateExperimentRequest)(nil),           // 30: admin.v1.CreateExperimentRequest
+	(*CreateExperimentResponse)(nil),          // 31: admin.v1.CreateExperimentResponse
+	(*ListExperimentsRequest)(nil),            // 32: admin.v1.ListExperimentsRequest
+	(*ListExperimentsResponse)(nil),           // 33: admin.v1.ListExperimentsResponse
+	(*StopExperimentRequest)(nil),             // 34: admin.v1.StopExperimentRequest
+	(*StopExperimentResponse)(nil),            // 35: admin.v1.StopExperimentResponse
+	(*MaintenanceMode)(nil),                   // 36: admin.v1.MaintenanceMode
+	(*SetMaintenanceModeRequest)(nil),         // 37: admin.v1.SetMaintenanceModeRequest
+	(*SetMaintenanceModeResponse)(nil),        // 38: admin.v1.SetMaintenanceModeResponse
+	(*GetMaintenanceModeRequest)(nil),         // 39: admin.v1.GetMaintenanceModeRequest
+	(*GetMaintenanceModeResponse)(nil),        // 40: admin.v1.GetMaintenanceModeResponse
+	(*BroadcastAnnouncementRequest)(nil),      // 41: admin.v1.BroadcastAnnouncementRequest
+	(*BroadcastAnnouncementResponse)(nil),     // 42: admin.v1.BroadcastAnnouncementResponse
+	(*WorldInventorySettings)(nil),            // 43: admin.v1.WorldInventorySettings
+	(*SetWorldInventorySettingsRequest)(nil),  // 44: admin.v1.SetWorldInventorySettingsRequest
+	(*SetWorldInventorySettingsResponse)(nil), // 45: admin.v1.SetWorldInventorySettingsResponse
+	(*GetWorldInventorySettingsRequest)(nil),  // 46: admin.v1.GetWorldInventorySettingsRequest
+	(*GetWorldInventorySettingsResponse)(nil), // 47: admin.v1.GetWorldInventorySettingsResponse
+	(*WorldTimeScale)(nil),                    // 48: admin.v1.WorldTimeScale
+	(*SetWorldTimeScaleRequest)(nil),          // 49: admin.v1.SetWorldTimeScaleRequest
+	(*SetWorldTimeScaleResponse)(nil),         // 50: admin.v1.SetWorldTimeScaleResponse
+	(*GetWorldTimeScaleRequest)(nil),          // 51: admin.v1.GetWorldTimeScaleRequest
+	(*GetWorldTimeScaleResponse)(nil),         // 52: admin.v1.GetWorldTimeScaleResponse
+	(*WorldPause)(nil),                        // 53: admin.v1.WorldPause
+	(*PauseWorldRequest)(nil),                 // 54: admin.v1.PauseWorldRequest
+	(*PauseWorldResponse)(nil),                // 55: admin.v1.PauseWorldResponse
+	(*ResumeWorldRequest)(nil),                // 56: admin.v1.ResumeWorldRequest
+	(*ResumeWorldResponse)(nil),               // 57: admin.v1.ResumeWorldResponse
+	(*ListWorldPausesRequest)(nil),            // 58: admin.v1.ListWorldPausesRequest
+	(*ListWorldPausesResponse)(nil),           // 59: admin.v1.ListWorldPausesResponse
+	(*ApplyStatusEffectRequest)(nil),          // 60: admin.v1.ApplyStatusEffectRequest
+	(*ApplyStatusEffectResponse)(nil),         // 61: admin.v1.ApplyStatusEffectResponse
+	(*RemoveStatusEffectRequest)(nil),         // 62: admin.v1.RemoveStatusEffectRequest
+	(*RemoveStatusEffectResponse)(nil),        // 63: admin.v1.RemoveStatusEffectResponse
+	(*EconomySourceStats)(nil),                // 64: admin.v1.EconomySourceStats
+	(*EconomyPeriodStats)(nil),                // 65: admin.v1.EconomyPeriodStats
+	(*GetEconomyStatsRequest)(nil),            // 66: admin.v1.GetEconomyStatsRequest
+	(*GetEconomyStatsResponse)(nil),           // 67: admin.v1.GetEconomyStatsResponse
+	(*LookupCharacterNameRequest)(nil),        // 68: admin.v1.LookupCharacterNameRequest
+	(*LookupCharacterNameResponse)(nil),       // 69: admin.v1.LookupCharacterNameResponse
+	(*PublishConsentDocumentRequest)(nil),     // 70: admin.v1.PublishConsentDocumentRequest
+	(*PublishConsentDocumentResponse)(nil),    // 71: admin.v1.PublishConsentDocumentResponse
+	nil,                                       // 72: admin.v1.ExperimentVariant.ParamsEntry
+	(v1.ReportStatus)(0),                      // 73: social.v1.ReportStatus
+	(*v1.PlayerReport)(nil),                   // 74: social.v1.PlayerReport
+	(*timestamppb.Timestamp)(nil),             // 75: google.protobuf.Timestamp
+	(v11.RegionFlag)(0),                       // 76: chunk.v1.RegionFlag
+	(*v11.RegionPoint)(nil),                   // 77: chunk.v1.RegionPoint
+	(*v11.ChunkRect)(nil),                     // 78: chunk.v1.ChunkRect
+	(*v11.ProtectedRegion)(nil),               // 79: chunk.v1.ProtectedRegion
+	(v12.AnnouncementSeverity)(0),             // 80: notification.v1.AnnouncementSeverity
+	(*v12.Announcement)(nil),                  // 81: notification.v1.Announcement
+	(v13.OverflowPolicy)(0),                   // 82: inventory.v1.OverflowPolicy
+	(*v14.StatusEffect)(nil),                  // 83: character.v1.StatusEffect
+	(*v14.Character)(nil),                     // 84: character.v1.Character
+	(*v14.CharacterNameChange)(nil),           // 85: character.v1.CharacterNameChange
+	(v15.ConsentKind)(0),                      // 86: user.v1.ConsentKind
+	(*v15.ConsentDocument)(nil),               // 87: user.v1.ConsentDocument
+}
+var file_admin_v1_admin_proto_depIdxs = []int32{
+	73, // 0: admin.v1.ListPlayerReportsRequest.status:type_name -> social.v1.ReportStatus
+	74, // 1: admin.v1.ListPlayerReportsResponse.reports:type_name -> social.v1.PlayerReport
+	74, // 2: admin.v1.ResolvePlayerReportResponse.report:type_name -> social.v1.PlayerReport
+	75, // 3: admin.v1.ApiKey.created_at:type_name -> google.protobuf.Timestamp
+	75, // 4: admin.v1.ApiKey.expires_at:type_name -> google.protobuf.Timestamp
+	75, // 5: admin.v1.ApiKey.revoked_at:type_name -> google.protobuf.Timestamp
+	75, // 6: admin.v1.ApiKey.last_used_at:type_name -> google.protobuf.Timestamp
+	75, // 7: admin.v1.CreateApiKeyRequest.expires_at:type_name -> google.protobuf.Timestamp
+	6,  // 8: admin.v1.CreateApiKeyResponse.api_key:type_name -> admin.v1.ApiKey
+	6,  // 9: admin.v1.ListApiKeysResponse.api_keys:type_name -> admin.v1.ApiKey
+	6,  // 10: admin.v1.RevokeApiKeyResponse.api_key:type_name -> admin.v1.ApiKey
+	76, // 11: admin.v1.CreateProtectedRegionRequest.flags:type_name -> chunk.v1.RegionFlag
+	77, // 12: admin.v1.CreateProtectedRegionRequest.polygon:type_name -> chunk.v1.RegionPoint
+	78, // 13: admin.v1.CreateProtectedRegionRequest.chunk_rect:type_name -> chunk.v1.ChunkRect
+	79, // 14: admin.v1.CreateProtectedRegionResponse.region:type_name -> chunk.v1.ProtectedRegion
+	76, // 15: admin.v1.UpdateProtectedRegionRequest.flags:type_name -> chunk.v1.RegionFlag
+	77, // 16: admin.v1.UpdateProtectedRegionRequest.polygon:type_name -> chunk.v1.RegionPoint
+	78, // 17: admin.v1.UpdateProtectedRegionRequest.chunk_rect:type_name -> chunk.v1.ChunkRect
+	79, // 18: admin.v1.UpdateProtectedRegionResponse.region:type_name -> chunk.v1.ProtectedRegion
+	79, // 19: admin.v1.ListProtectedRegionsResponse.regions:type_name -> chunk.v1.ProtectedRegion
+	79, // 20: admin.v1.DeleteProtectedRegionResponse.region:type_name -> chunk.v1.ProtectedRegion
+	75, // 21: admin.v1.FeatureFlag.updated_at:type_name -> google.protobuf.Timestamp
+	21, // 22: admin.v1.SetFeatureFlagRequest.flag:type_name -> admin.v1.FeatureFlag
+	21, // 23: admin.v1.SetFeatureFlagResponse.flag:type_name -> admin.v1.FeatureFlag
+	21, // 24: admin.v1.ListFeatureFlagsResponse.flags:type_name -> admin.v1.FeatureFlag
+	21, // 25: admin.v1.DeleteFeatureFlagResponse.flag:type_name -> admin.v1.FeatureFlag
+	72, // 26: admin.v1.ExperimentVariant.params:type_name -> admin.v1.ExperimentVariant.ParamsEntry
+	0,  // 27: admin.v1.Experiment.subject:type_name -> admin.v1.ExperimentSubject
+	28, // 28: admin.v1.Experiment.variants:type_name -> admin.v1.ExperimentVariant
+	75, // 29: admin.v1.Experiment.started_at:type_name -> google.protobuf.Timestamp
+	75, // 30: admin.v1.Experiment.stopped_at:type_name -> google.protobuf.Timestamp
+	29, // 31: admin.v1.CreateExperimentRequest.experiment:type_name -> admin.v1.Experiment
+	29, // 32: admin.v1.CreateExperimentResponse.experiment:type_name -> admin.v1.Experiment
+	29, // 33: admin.v1.ListExperimentsResponse.experiments:type_name -> admin.v1.Experiment
+	29, // 34: admin.v1.StopExperimentResponse.experiment:type_name -> admin.v1.Experiment
+	75, // 35: admin.v1.MaintenanceMode.eta:type_name -> google.protobuf.Timestamp
+	75, // 36: admin.v1.MaintenanceMode.freeze_at:type_name -> google.protobuf.Timestamp
+	75, // 37: admin.v1.MaintenanceMode.updated_at:type_name -> google.protobuf.Timestamp
+	75, // 38: admin.v1.SetMaintenanceModeRequest.eta:type_name -> google.protobuf.Timestamp
+	36, // 39: admin.v1.SetMaintenanceModeResponse.maintenance:type_name -> admin.v1.MaintenanceMode
+	36, // 40: admin.v1.GetMaintenanceModeResponse.maintenance:type_name -> admin.v1.MaintenanceMode
+	80, // 41: admin.v1.BroadcastAnnouncementRequest.severity:type_name -> notification.v1.AnnouncementSeverity
+	75, // 42: admin.v1.BroadcastAnnouncementRequest.expires_at:type_name -> google.protobuf.Timestamp
+	81, // 43: admin.v1.BroadcastAnnouncementResponse.announcement:type_name -> notification.v1.Announcement
+	82, // 44: admin.v1.WorldInventorySettings.harvest_overflow:type_name -> inventory.v1.OverflowPolicy
+	75, // 45: admin.v1.WorldInventorySettings.updated_at:type_name -> google.protobuf.Timestamp
+	82, // 46: admin.v1.SetWorldInventorySettingsRequest.harvest_overflow:type_name -> inventory.v1.OverflowPolicy
+	43, // 47: admin.v1.SetWorldInventorySettingsResponse.settings:type_name -> admin.v1.WorldInventorySettings
+	43, // 48: admin.v1.GetWorldInventorySettingsResponse.settings:type_name -> admin.v1.WorldInventorySettings
+	75, // 49: admin.v1.WorldTimeScale.updated_at:type_name -> google.protobuf.Timestamp
+	48, // 50: admin.v1.SetWorldTimeScaleResponse.time_scale:type_name -> admin.v1.WorldTimeScale
+	48, // 51: admin.v1.GetWorldTimeScaleResponse.time_scale:type_name -> admin.v1.WorldTimeScale
+	75, // 52: admin.v1.WorldPause.paused_at:type_name -> google.protobuf.Timestamp
+	53, // 53: admin.v1.PauseWorldResponse.pause:type_name -> admin.v1.WorldPause
+	53, // 54: admin.v1.ListWorldPausesResponse.pauses:type_name -> admin.v1.WorldPause
+	83, // 55: admin.v1.ApplyStatusEffectResponse.effect:type_name -> character.v1.StatusEffect
+	75, // 56: admin.v1.EconomyPeriodStats.start:type_name -> google.protobuf.Timestamp
+	64, // 57: admin.v1.EconomyPeriodStats.sources:type_name -> admin.v1.EconomySourceStats
+	1,  // 58: admin.v1.GetEconomyStatsRequest.period:type_name -> admin.v1.EconomyPeriod
+	65, // 59: admin.v1.GetEconomyStatsResponse.periods:type_name -> admin.v1.EconomyPeriodStats
+	84, // 60: admin.v1.LookupCharacterNameResponse.characters:type_name -> character.v1.Character
+	85, // 61: admin.v1.LookupCharacterNameResponse.changes:type_name -> character.v1.CharacterNameChange
+	86, // 62: admin.v1.PublishConsentDocumentRequest.kind:type_name -> user.v1.ConsentKind
+	87, // 63: admin.v1.PublishConsentDocumentResponse.document:type_name -> user.v1.ConsentDocument
+	2,  // 64: admin.v1.AdminService.ListPlayerReports:input_type -> admin.v1.ListPlayerReportsRequest
+	4,  // 65: admin.v1.AdminService.ResolvePlayerReport:input_type -> admin.v1.ResolvePlayerReportRequest
+	7,  // 66: admin.v1.AdminService.CreateApiKey:input_type -> admin.v1.CreateApiKeyRequest
+	9,  // 67: admin.v1.AdminService.ListApiKeys:input_type -> admin.v1.ListApiKeysRequest
+	11, // 68: admin.v1.AdminService.RevokeApiKey:input_type -> admin.v1.RevokeApiKeyRequest
+	13, // 69: admin.v1.AdminService.CreateProtectedRegion:input_type -> admin.v1.CreateProtectedRegionRequest
+	15, // 70: admin.v1.AdminService.UpdateProtectedRegion:input_type -> admin.v1.UpdateProtectedRegionRequest
+	17, // 71: admin.v1.AdminService.ListProtectedRegions:input_type -> admin.v1.ListProtectedRegionsRequest
+	19, // 72: admin.v1.AdminService.DeleteProtectedRegion:input_type -> admin.v1.DeleteProtectedRegionRequest
+	22, // 73: admin.v1.AdminService.SetFeatureFlag:input_type -> admin.v1.SetFeatureFlagRequest
+	24, // 74: admin.v1.AdminService.ListFeatureFlags:input_type -> admin.v1.ListFeatureFlagsRequest
+	26, // 75: admin.v1.AdminService.DeleteFeatureFlag:input_type -> admin.v1.DeleteFeatureFlagRequest
+	30, // 76: admin.v1.AdminService.CreateExperiment:input_type -> admin.v1.CreateExperimentRequest
+	32, // 77: admin.v1.AdminService.ListExperiments:input_type -> admin.v1.ListExperimentsRequest
+	34, // 78: admin.v1.AdminService.StopExperiment:input_type -> admin.v1.StopExperimentRequest
+	37, // 79: admin.v1.AdminService.SetMaintenanceMode:input_type -> admin.v1.SetMaintenanceModeRequest
+	39, // 80: admin.v1.AdminService.GetMaintenanceMode:input_type -> admin.v1.GetMaintenanceModeRequest
+	41, // 81: admin.v1.AdminService.BroadcastAnnouncement:input_type -> admin.v1.BroadcastAnnouncementRequest
+	44, // 82: admin.v1.AdminService.SetWorldInventorySettings:input_type -> admin.v1.SetWorldInventorySettingsRequest
+	46, // 83: admin.v1.AdminService.GetWorldInventorySettings:input_type -> admin.v1.GetWorldInventorySettingsRequest
+	49, // 84: admin.v1.AdminService.SetWorldTimeScale:input_type -> admin.v1.SetWorldTimeScaleRequest
+	51, // 85: admin.v1.AdminService.GetWorldTimeScale:input_type -> admin.v1.GetWorldTimeScaleRequest
+	54, // 86: admin.v1.AdminService.PauseWorld:input_type -> admin.v1.PauseWorldRequest
+	56, // 87: admin.v1.AdminService.ResumeWorld:input_type -> admin.v1.ResumeWorldRequest
+	58, // 88: admin.v1.AdminService.ListWorldPauses:input_type -> admin.v1.ListWorldPausesRequest
+	60, // 89: admin.v1.AdminService.ApplyStatusEffect:input_type -> admin.v1.ApplyStatusEffectRequest
+	62, // 90: admin.v1.AdminService.RemoveStatusEffect:input_type -> admin.v1.RemoveStatusEffectRequest
+	66, // 91: admin.v1.AdminService.GetEconomyStats:input_type -> admin.v1.GetEconomyStatsRequest
+	68, // 92: admin.v1.AdminService.LookupCharacterName:input_type -> admin.v1.LookupCharacterNameRequest
+	70, // 93: admin.v1.AdminService.PublishConsentDocument:input_type -> admin.v1.PublishConsentDocumentRequest
+	3,  // 94: admin.v1.AdminService.ListPlayerReports:output_type -> admin.v1.ListPlayerReportsResponse
+	5,  // 95: admin.v1.AdminService.ResolvePlayerReport:output_type -> admin.v1.ResolvePlayerReportResponse
+	8,  // 96: admin.v1.AdminService.CreateApiKey:output_type -> admin.v1.CreateApiKeyResponse
+	10, // 97: admin.v1.AdminService.ListApiKeys:output_type -> admin.v1.ListApiKeysResponse
+	12, // 98: admin.v1.AdminService.RevokeApiKey:output_type -> admin.v1.RevokeApiKeyResponse
+	14, // 99: admin.v1.AdminService.CreateProtectedRegion:output_type -> admin.v1.CreateProtectedRegionResponse
+	16, // 100: admin.v1.AdminService.UpdateProtectedRegion:output_type -> admin.v1.UpdateProtectedRegionResponse
+	18, // 101: admin.v1.AdminService.ListProtectedRegions:output_type -> admin.v1.ListProtectedRegionsResponse
+	20, // 102: admin.v1.AdminService.DeleteProtectedRegion:output_type -> admin.v1.DeleteProtectedRegionResponse
+	23, // 103: admin.v1.AdminService.SetFeatureFlag:output_type -> admin.v1.SetFeatureFlagResponse
+	25, // 104: admin.v1.AdminService.ListFeatureFlags:output_type -> admin.v1.ListFeatureFlagsResponse
+	27, // 105: admin.v1.AdminService.DeleteFeatureFlag:output_type -> admin.v1.DeleteFeatureFlagResponse
+	31, // 106: admin.v1.AdminService.CreateExperiment:output_type -> admin.v1.CreateExperimentResponse
+	33, // 107: admin.v1.AdminService.ListExperiments:output_type -> admin.v1.ListExperimentsResponse
+	35, // 108: admin.v1.AdminService.StopExperiment:output_type -> admin.v1.StopExperimentResponse
+	38, // 109: admin.v1.AdminService.SetMaintenanceMode:output_type -> admin.v1.SetMaintenanceModeResponse
+	40, // 110: admin.v1.AdminService.GetMaintenanceMode:output_type -> admin.v1.GetMaintenanceModeResponse
+	42, // 111: admin.v1.AdminService.BroadcastAnnouncement:output_type -> admin.v1.BroadcastAnnouncementResponse
+	45, // 112: admin.v1.AdminService.SetWorldInventorySettings:output_type -> admin.v1.SetWorldInventorySettingsResponse
+	47, // 113: admin.v1.AdminService.GetWorldInventorySettings:output_type -> admin.v1.GetWorldInventorySettingsResponse
+	50, // 114: admin.v1.AdminService.SetWorldTimeScale:output_type -> admin.v1.SetWorldTimeScaleResponse
+	52, // 115: admin.v1.AdminService.GetWorldTimeScale:output_type -> admin.v1.GetWorldTimeScaleResponse
+	55, // 116: admin.v1.AdminService.PauseWorld:output_type -> admin.v1.PauseWorldResponse
+	57, // 117: admin.v1.AdminService.ResumeWorld:output_type -> admin.v1.ResumeWorldResponse
+	59, // 118: admin.v1.AdminService.ListWorldPauses:output_type -> admin.v1.ListWorldPausesResponse
+	61, // 119: admin.v1.AdminService.ApplyStatusEffect:output_type -> admin.v1.ApplyStatusEffectResponse
+	63, // 120: admin.v1.AdminService.RemoveStatusEffect:output_type -> admin.v1.RemoveStatusEffectResponse
+	67, // 121: admin.v1.AdminService.GetEconomyStats:output_type -> admin.v1.GetEconomyStatsResponse
+	69, // 122: admin.v1.AdminService.LookupCharacterName:output_type -> admin.v1.LookupCharacterNameResponse
+	71, // 123: admin.v1.AdminService.PublishConsentDocument:output_type -> admin.v1.PublishConsentDocumentResponse
+	94, // [94:124] is the sub-list for method output_type
+	64, // [64:94] is the sub-list for method input_type
+	64, // [64:64] is the sub-list for extension type_name
+	64, // [64:64] is the sub-list for extension extendee
+	0,  // [0:64] is the sub-list for field type_name
+}
+
+func init() { file_admin_v1_admin_proto_init() }
+func file_admin_v1_admin_proto_init() {
+	if File_admin_v1_admin_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_admin_v1_admin_proto_rawDesc), len(file_admin_v1_admin_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   71,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_admin_v1_admin_proto_goTypes,
+		DependencyIndexes: file_admin_v1_admin_proto_depIdxs,
+		EnumInfos:         file_admin_v1_admin_proto_enumTypes,
+		MessageInfos:      file_admin_v1_admin_proto_msgTypes,
+	}.Build()
+	File_admin_v1_admin_proto = out.File
+	file_admin_v1_admin_proto_goTypes = nil
+	file_admin_v1_admin_proto_depIdxs = nil
+}
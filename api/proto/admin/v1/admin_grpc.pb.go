@@ -0,0 +1,1275 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.4
+// source: admin/v1/admin.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AdminService_ListPlayerReports_FullMethodName         = "/admin.v1.AdminService/ListPlayerReports"
+	AdminService_ResolvePlayerReport_FullMethodName       = "/admin.v1.AdminService/ResolvePlayerReport"
+	AdminService_CreateApiKey_FullMethodName              = "/admin.v1.AdminService/CreateApiKey"
+	AdminService_ListApiKeys_FullMethodName               = "/admin.v1.AdminService/ListApiKeys"
+	AdminService_RevokeApiKey_FullMethodName              = "/admin.v1.AdminService/RevokeApiKey"
+	AdminService_CreateProtectedRegion_FullMethodName     = "/admin.v1.AdminService/CreateProtectedRegion"
+	AdminService_UpdateProtectedRegion_FullMethodName     = "/admin.v1.AdminService/UpdateProtectedRegion"
+	AdminService_ListProtectedRegions_FullMethodName      = "/admin.v1.AdminService/ListProtectedRegions"
+	AdminService_DeleteProtectedRegion_FullMethodName     = "/admin.v1.AdminService/DeleteProtectedRegion"
+	AdminService_SetFeatureFlag_FullMethodName            = "/admin.v1.AdminService/SetFeatureFlag"
+	AdminService_ListFeatureFlags_FullMethodName          = "/admin.v1.AdminService/ListFeatureFlags"
+	AdminService_DeleteFeatureFlag_FullMethodName         = "/admin.v1.AdminService/DeleteFeatureFlag"
+	AdminService_CreateExperiment_FullMethodName          = "/admin.v1.AdminService/CreateExperiment"
+	AdminService_ListExperiments_FullMethodName           = "/admin.v1.AdminService/ListExperiments"
+	AdminService_StopExperiment_FullMethodName            = "/admin.v1.AdminService/StopExperiment"
+	AdminService_SetMaintenanceMode_FullMethodName        = "/admin.v1.AdminService/SetMaintenanceMode"
+	AdminService_GetMaintenanceMode_FullMethodName        = "/admin.v1.AdminService/GetMaintenanceMode"
+	AdminService_BroadcastAnnouncement_FullMethodName     = "/admin.v1.AdminService/BroadcastAnnouncement"
+	AdminService_SetWorldInventorySettings_FullMethodName = "/admin.v1.AdminService/SetWorldInventorySettings"
+	AdminService_GetWorldInventorySettings_FullMethodName = "/admin.v1.AdminService/GetWorldInventorySettings"
+	AdminService_SetWorldTimeScale_FullMethodName         = "/admin.v1.AdminService/SetWorldTimeScale"
+	AdminService_GetWorldTimeScale_FullMethodName         = "/admin.v1.AdminService/GetWorldTimeScale"
+	AdminService_PauseWorld_FullMethodName                = "/admin.v1.AdminService/PauseWorld"
+	AdminService_ResumeWorld_FullMethodName               = "/admin.v1.AdminService/ResumeWorld"
+	AdminService_ListWorldPauses_FullMethodName           = "/admin.v1.AdminService/ListWorldPauses"
+	AdminService_ApplyStatusEffect_FullMethodName         = "/admin.v1.AdminService/ApplyStatusEffect"
+	AdminService_RemoveStatusEffect_FullMethodName        = "/admin.v1.AdminService/RemoveStatusEffect"
+	AdminService_GetEconomyStats_FullMethodName           = "/admin.v1.AdminService/GetEconomyStats"
+	AdminService_LookupCharacterName_FullMethodName       = "/admin.v1.AdminService/LookupCharacterName"
+	AdminService_PublishConsentDocument_FullMethodName    = "/admin.v1.AdminService/PublishConsentDocument"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AdminService holds moderation tools. Every RPC requires an admin user (ADMIN_USER_IDS).
+type AdminServiceClient interface {
+	ListPlayerReports(ctx context.Context, in *ListPlayerReportsRequest, opts ...grpc.CallOption) (*ListPlayerReportsResponse, error)
+	ResolvePlayerReport(ctx context.Context, in *ResolvePlayerReportRequest, opts ...grpc.CallOption) (*ResolvePlayerReportResponse, error)
+	// API keys let server-to-server integrations call read-only RPCs without a player account
+	CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error)
+	ListApiKeys(ctx context.Context, in *ListApiKeysRequest, opts ...grpc.CallOption) (*ListApiKeysResponse, error)
+	RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*RevokeApiKeyResponse, error)
+	// Protected regions restrict harvesting, building and combat in parts of a world
+	CreateProtectedRegion(ctx context.Context, in *CreateProtectedRegionRequest, opts ...grpc.CallOption) (*CreateProtectedRegionResponse, error)
+	UpdateProtectedRegion(ctx context.Context, in *UpdateProtectedRegionRequest, opts ...grpc.CallOption) (*UpdateProtectedRegionResponse, error)
+	ListProtectedRegions(ctx context.Context, in *ListProtectedRegionsRequest, opts ...grpc.CallOption) (*ListProtectedRegionsResponse, error)
+	DeleteProtectedRegion(ctx context.Context, in *DeleteProtectedRegionRequest, opts ...grpc.CallOption) (*DeleteProtectedRegionResponse, error)
+	// Feature flags roll new subsystems out per world, per user or to a percentage of users
+	SetFeatureFlag(ctx context.Context, in *SetFeatureFlagRequest, opts ...grpc.CallOption) (*SetFeatureFlagResponse, error)
+	ListFeatureFlags(ctx context.Context, in *ListFeatureFlagsRequest, opts ...grpc.CallOption) (*ListFeatureFlagsResponse, error)
+	// Deletes a flag's settings; known flags fall back to their default
+	DeleteFeatureFlag(ctx context.Context, in *DeleteFeatureFlagRequest, opts ...grpc.CallOption) (*DeleteFeatureFlagResponse, error)
+	// Experiments assign new worlds or characters to parameter variants for A/B tests
+	CreateExperiment(ctx context.Context, in *CreateExperimentRequest, opts ...grpc.CallOption) (*CreateExperimentResponse, error)
+	ListExperiments(ctx context.Context, in *ListExperimentsRequest, opts ...grpc.CallOption) (*ListExperimentsResponse, error)
+	// Stops assigning new subjects; existing assignments and their parameters are kept
+	StopExperiment(ctx context.Context, in *StopExperimentRequest, opts ...grpc.CallOption) (*StopExperimentResponse, error)
+	// Maintenance mode rejects new logins, counts down on open notification streams and then
+	// freezes writes. Admins are exempt, so they can keep working and turn it off again.
+	SetMaintenanceMode(ctx context.Context, in *SetMaintenanceModeRequest, opts ...grpc.CallOption) (*SetMaintenanceModeResponse, error)
+	GetMaintenanceMode(ctx context.Context, in *GetMaintenanceModeRequest, opts ...grpc.CallOption) (*GetMaintenanceModeResponse, error)
+	// Pushes an announcement to every online player; players who connect before it expires see it too
+	BroadcastAnnouncement(ctx context.Context, in *BroadcastAnnouncementRequest, opts ...grpc.CallOption) (*BroadcastAnnouncementResponse, error)
+	// Inventory rules of a world: how many slots inventories have and where harvest yields
+	// that do not fit go
+	SetWorldInventorySettings(ctx context.Context, in *SetWorldInventorySettingsRequest, opts ...grpc.CallOption) (*SetWorldInventorySettingsResponse, error)
+	GetWorldInventorySettings(ctx context.Context, in *GetWorldInventorySettingsRequest, opts ...grpc.CallOption) (*GetWorldInventorySettingsResponse, error)
+	// Simulation speed of a world, so long-running mechanics (rare event schedules, ground
+	// drop expiry) can be tested quickly on staging
+	SetWorldTimeScale(ctx context.Context, in *SetWorldTimeScaleRequest, opts ...grpc.CallOption) (*SetWorldTimeScaleResponse, error)
+	GetWorldTimeScale(ctx context.Context, in *GetWorldTimeScaleRequest, opts ...grpc.CallOption) (*GetWorldTimeScaleResponse, error)
+	// Freezes one world to investigate an exploit or for targeted maintenance: non-admin
+	// writes in it are rejected and its background jobs skip it, while reads and every other
+	// world keep working
+	PauseWorld(ctx context.Context, in *PauseWorldRequest, opts ...grpc.CallOption) (*PauseWorldResponse, error)
+	ResumeWorld(ctx context.Context, in *ResumeWorldRequest, opts ...grpc.CallOption) (*ResumeWorldResponse, error)
+	ListWorldPauses(ctx context.Context, in *ListWorldPausesRequest, opts ...grpc.CallOption) (*ListWorldPausesResponse, error)
+	// Grants or removes a status effect on a character, e.g. to compensate players or test
+	// buffs before items and quests apply them
+	ApplyStatusEffect(ctx context.Context, in *ApplyStatusEffectRequest, opts ...grpc.CallOption) (*ApplyStatusEffectResponse, error)
+	RemoveStatusEffect(ctx context.Context, in *RemoveStatusEffectRequest, opts ...grpc.CallOption) (*RemoveStatusEffectResponse, error)
+	// Currency created (harvests) and destroyed (market taxes and fees, land claims) in a
+	// world per period, to watch for inflation
+	GetEconomyStats(ctx context.Context, in *GetEconomyStatsRequest, opts ...grpc.CallOption) (*GetEconomyStatsResponse, error)
+	// Renames from or to a name in a world, including those of deleted characters, to trace
+	// a name seen in a report to the character and user behind it
+	LookupCharacterName(ctx context.Context, in *LookupCharacterNameRequest, opts ...grpc.CallOption) (*LookupCharacterNameResponse, error)
+	// Publishes the next version of the terms of service or privacy policy. A required
+	// version must be accepted again before players can continue playing.
+	PublishConsentDocument(ctx context.Context, in *PublishConsentDocumentRequest, opts ...grpc.CallOption) (*PublishConsentDocumentResponse, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) ListPlayerReports(ctx context.Context, in *ListPlayerReportsRequest, opts ...grpc.CallOption) (*ListPlayerReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPlayerReportsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListPlayerReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ResolvePlayerReport(ctx context.Context, in *ResolvePlayerReportRequest, opts ...grpc.CallOption) (*ResolvePlayerReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolvePlayerReportResponse)
+	err := c.cc.Invoke(ctx, AdminService_ResolvePlayerReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateApiKeyResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreateApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListApiKeys(ctx context.Context, in *ListApiKeysRequest, opts ...grpc.CallOption) (*ListApiKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListApiKeysResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListApiKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*RevokeApiKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeApiKeyResponse)
+	err := c.cc.Invoke(ctx, AdminService_RevokeApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateProtectedRegion(ctx context.Context, in *CreateProtectedRegionRequest, opts ...grpc.CallOption) (*CreateProtectedRegionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateProtectedRegionResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreateProtectedRegion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateProtectedRegion(ctx context.Context, in *UpdateProtectedRegionRequest, opts ...grpc.CallOption) (*UpdateProtectedRegionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateProtectedRegionResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateProtectedRegion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListProtectedRegions(ctx context.Context, in *ListProtectedRegionsRequest, opts ...grpc.CallOption) (*ListProtectedRegionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProtectedRegionsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListProtectedRegions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteProtectedRegion(ctx context.Context, in *DeleteProtectedRegionRequest, opts ...grpc.CallOption) (*DeleteProtectedRegionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProtectedRegionResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteProtectedRegion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetFeatureFlag(ctx context.Context, in *SetFeatureFlagRequest, opts ...grpc.CallOption) (*SetFeatureFlagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetFeatureFlagResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetFeatureFlag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListFeatureFlags(ctx context.Context, in *ListFeatureFlagsRequest, opts ...grpc.CallOption) (*ListFeatureFlagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFeatureFlagsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListFeatureFlags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteFeatureFlag(ctx context.Context, in *DeleteFeatureFlagRequest, opts ...grpc.CallOption) (*DeleteFeatureFlagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteFeatureFlagResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteFeatureFlag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateExperiment(ctx context.Context, in *CreateExperimentRequest, opts ...grpc.CallOption) (*CreateExperimentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateExperimentResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreateExperiment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListExperiments(ctx context.Context, in *ListExperimentsRequest, opts ...grpc.CallOption) (*ListExperimentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExperimentsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListExperiments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StopExperiment(ctx context.Context, in *StopExperimentRequest, opts ...grpc.CallOption) (*StopExperimentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StopExperimentResponse)
+	err := c.cc.Invoke(ctx, AdminService_StopExperiment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetMaintenanceMode(ctx context.Context, in *SetMaintenanceModeRequest, opts ...grpc.CallOption) (*SetMaintenanceModeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetMaintenanceModeResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetMaintenanceMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetMaintenanceMode(ctx context.Context, in *GetMaintenanceModeRequest, opts ...grpc.CallOption) (*GetMaintenanceModeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMaintenanceModeResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetMaintenanceMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) BroadcastAnnouncement(ctx context.Context, in *BroadcastAnnouncementRequest, opts ...grpc.CallOption) (*BroadcastAnnouncementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BroadcastAnnouncementResponse)
+	err := c.cc.Invoke(ctx, AdminService_BroadcastAnnouncement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetWorldInventorySettings(ctx context.Context, in *SetWorldInventorySettingsRequest, opts ...grpc.CallOption) (*SetWorldInventorySettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetWorldInventorySettingsResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetWorldInventorySettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetWorldInventorySettings(ctx context.Context, in *GetWorldInventorySettingsRequest, opts ...grpc.CallOption) (*GetWorldInventorySettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorldInventorySettingsResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetWorldInventorySettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetWorldTimeScale(ctx context.Context, in *SetWorldTimeScaleRequest, opts ...grpc.CallOption) (*SetWorldTimeScaleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetWorldTimeScaleResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetWorldTimeScale_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetWorldTimeScale(ctx context.Context, in *GetWorldTimeScaleRequest, opts ...grpc.CallOption) (*GetWorldTimeScaleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorldTimeScaleResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetWorldTimeScale_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PauseWorld(ctx context.Context, in *PauseWorldRequest, opts ...grpc.CallOption) (*PauseWorldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PauseWorldResponse)
+	err := c.cc.Invoke(ctx, AdminService_PauseWorld_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ResumeWorld(ctx context.Context, in *ResumeWorldRequest, opts ...grpc.CallOption) (*ResumeWorldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumeWorldResponse)
+	err := c.cc.Invoke(ctx, AdminService_ResumeWorld_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListWorldPauses(ctx context.Context, in *ListWorldPausesRequest, opts ...grpc.CallOption) (*ListWorldPausesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWorldPausesResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListWorldPauses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ApplyStatusEffect(ctx context.Context, in *ApplyStatusEffectRequest, opts ...grpc.CallOption) (*ApplyStatusEffectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyStatusEffectResponse)
+	err := c.cc.Invoke(ctx, AdminService_ApplyStatusEffect_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RemoveStatusEffect(ctx context.Context, in *RemoveStatusEffectRequest, opts ...grpc.CallOption) (*RemoveStatusEffectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveStatusEffectResponse)
+	err := c.cc.Invoke(ctx, AdminService_RemoveStatusEffect_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetEconomyStats(ctx context.Context, in *GetEconomyStatsRequest, opts ...grpc.CallOption) (*GetEconomyStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEconomyStatsResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetEconomyStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) LookupCharacterName(ctx context.Context, in *LookupCharacterNameRequest, opts ...grpc.CallOption) (*LookupCharacterNameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LookupCharacterNameResponse)
+	err := c.cc.Invoke(ctx, AdminService_LookupCharacterName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PublishConsentDocument(ctx context.Context, in *PublishConsentDocumentRequest, opts ...grpc.CallOption) (*PublishConsentDocumentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishConsentDocumentResponse)
+	err := c.cc.Invoke(ctx, AdminService_PublishConsentDocument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility.
+//
+// AdminService holds moderation tools. Every RPC requires an admin user (ADMIN_USER_IDS).
+type AdminServiceServer interface {
+	ListPlayerReports(context.Context, *ListPlayerReportsRequest) (*ListPlayerReportsResponse, error)
+	ResolvePlayerReport(context.Context, *ResolvePlayerReportRequest) (*ResolvePlayerReportResponse, error)
+	// API keys let server-to-server integrations call read-only RPCs without a player account
+	CreateApiKey(context.Context, *CreateApiKeyRequest) (*CreateApiKeyResponse, error)
+	ListApiKeys(context.Context, *ListApiKeysRequest) (*ListApiKeysResponse, error)
+	RevokeApiKey(context.Context, *RevokeApiKeyRequest) (*RevokeApiKeyResponse, error)
+	// Protected regions restrict harvesting, building and combat in parts of a world
+	CreateProtectedRegion(context.Context, *CreateProtectedRegionRequest) (*CreateProtectedRegionResponse, error)
+	UpdateProtectedRegion(context.Context, *UpdateProtectedRegionRequest) (*UpdateProtectedRegionResponse, error)
+	ListProtectedRegions(context.Context, *ListProtectedRegionsRequest) (*ListProtectedRegionsResponse, error)
+	DeleteProtectedRegion(context.Context, *DeleteProtectedRegionRequest) (*DeleteProtectedRegionResponse, error)
+	// Feature flags roll new subsystems out per world, per user or to a percentage of users
+	SetFeatureFlag(context.Context, *SetFeatureFlagRequest) (*SetFeatureFlagResponse, error)
+	ListFeatureFlags(context.Context, *ListFeatureFlagsRequest) (*ListFeatureFlagsResponse, error)
+	// Deletes a flag's settings; known flags fall back to their default
+	DeleteFeatureFlag(context.Context, *DeleteFeatureFlagRequest) (*DeleteFeatureFlagResponse, error)
+	// Experiments assign new worlds or characters to parameter variants for A/B tests
+	CreateExperiment(context.Context, *CreateExperimentRequest) (*CreateExperimentResponse, error)
+	ListExperiments(context.Context, *ListExperimentsRequest) (*ListExperimentsResponse, error)
+	// Stops assigning new subjects; existing assignments and their parameters are kept
+	StopExperiment(context.Context, *StopExperimentRequest) (*StopExperimentResponse, error)
+	// Maintenance mode rejects new logins, counts down on open notification streams and then
+	// freezes writes. Admins are exempt, so they can keep working and turn it off again.
+	SetMaintenanceMode(context.Context, *SetMaintenanceModeRequest) (*SetMaintenanceModeResponse, error)
+	GetMaintenanceMode(context.Context, *GetMaintenanceModeRequest) (*GetMaintenanceModeResponse, error)
+	// Pushes an announcement to every online player; players who connect before it expires see it too
+	BroadcastAnnouncement(context.Context, *BroadcastAnnouncementRequest) (*BroadcastAnnouncementResponse, error)
+	// Inventory rules of a world: how many slots inventories have and where harvest yields
+	// that do not fit go
+	SetWorldInventorySettings(context.Context, *SetWorldInventorySettingsRequest) (*SetWorldInventorySettingsResponse, error)
+	GetWorldInventorySettings(context.Context, *GetWorldInventorySettingsRequest) (*GetWorldInventorySettingsResponse, error)
+	// Simulation speed of a world, so long-running mechanics (rare event schedules, ground
+	// drop expiry) can be tested quickly on staging
+	SetWorldTimeScale(context.Context, *SetWorldTimeScaleRequest) (*SetWorldTimeScaleResponse, error)
+	GetWorldTimeScale(context.Context, *GetWorldTimeScaleRequest) (*GetWorldTimeScaleResponse, error)
+	// Freezes one world to investigate an exploit or for targeted maintenance: non-admin
+	// writes in it are rejected and its background jobs skip it, while reads and every other
+	// world keep working
+	PauseWorld(context.Context, *PauseWorldRequest) (*PauseWorldResponse, error)
+	ResumeWorld(context.Context, *ResumeWorldRequest) (*ResumeWorldResponse, error)
+	ListWorldPauses(context.Context, *ListWorldPausesRequest) (*ListWorldPausesResponse, error)
+	// Grants or removes a status effect on a character, e.g. to compensate players or test
+	// buffs before items and quests apply them
+	ApplyStatusEffect(context.Context, *ApplyStatusEffectRequest) (*ApplyStatusEffectResponse, error)
+	RemoveStatusEffect(context.Context, *RemoveStatusEffectRequest) (*RemoveStatusEffectResponse, error)
+	// Currency created (harvests) and destroyed (market taxes and fees, land claims) in a
+	// world per period, to watch for inflation
+	GetEconomyStats(context.Context, *GetEconomyStatsRequest) (*GetEconomyStatsResponse, error)
+	// Renames from or to a name in a world, including those of deleted characters, to trace
+	// a name seen in a report to the character and user behind it
+	LookupCharacterName(context.Context, *LookupCharacterNameRequest) (*LookupCharacterNameResponse, error)
+	// Publishes the next version of the terms of service or privacy policy. A required
+	// version must be accepted again before players can continue playing.
+	PublishConsentDocument(context.Context, *PublishConsentDocumentRequest) (*PublishConsentDocumentResponse, error)
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAdminServiceServer struct{}
+
+func (UnimplementedAdminServiceServer) ListPlayerReports(context.Context, *ListPlayerReportsRequest) (*ListPlayerReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlayerReports not implemented")
+}
+func (UnimplementedAdminServiceServer) ResolvePlayerReport(context.Context, *ResolvePlayerReportRequest) (*ResolvePlayerReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolvePlayerReport not implemented")
+}
+func (UnimplementedAdminServiceServer) CreateApiKey(context.Context, *CreateApiKeyRequest) (*CreateApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApiKey not implemented")
+}
+func (UnimplementedAdminServiceServer) ListApiKeys(context.Context, *ListApiKeysRequest) (*ListApiKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApiKeys not implemented")
+}
+func (UnimplementedAdminServiceServer) RevokeApiKey(context.Context, *RevokeApiKeyRequest) (*RevokeApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeApiKey not implemented")
+}
+func (UnimplementedAdminServiceServer) CreateProtectedRegion(context.Context, *CreateProtectedRegionRequest) (*CreateProtectedRegionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProtectedRegion not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateProtectedRegion(context.Context, *UpdateProtectedRegionRequest) (*UpdateProtectedRegionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProtectedRegion not implemented")
+}
+func (UnimplementedAdminServiceServer) ListProtectedRegions(context.Context, *ListProtectedRegionsRequest) (*ListProtectedRegionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProtectedRegions not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteProtectedRegion(context.Context, *DeleteProtectedRegionRequest) (*DeleteProtectedRegionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProtectedRegion not implemented")
+}
+func (UnimplementedAdminServiceServer) SetFeatureFlag(context.Context, *SetFeatureFlagRequest) (*SetFeatureFlagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeatureFlag not implemented")
+}
+func (UnimplementedAdminServiceServer) ListFeatureFlags(context.Context, *ListFeatureFlagsRequest) (*ListFeatureFlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFeatureFlags not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteFeatureFlag(context.Context, *DeleteFeatureFlagRequest) (*DeleteFeatureFlagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFeatureFlag not implemented")
+}
+func (UnimplementedAdminServiceServer) CreateExperiment(context.Context, *CreateExperimentRequest) (*CreateExperimentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateExperiment not implemented")
+}
+func (UnimplementedAdminServiceServer) ListExperiments(context.Context, *ListExperimentsRequest) (*ListExperimentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExperiments not implemented")
+}
+func (UnimplementedAdminServiceServer) StopExperiment(context.Context, *StopExperimentRequest) (*StopExperimentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopExperiment not implemented")
+}
+func (UnimplementedAdminServiceServer) SetMaintenanceMode(context.Context, *SetMaintenanceModeRequest) (*SetMaintenanceModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaintenanceMode not implemented")
+}
+func (UnimplementedAdminServiceServer) GetMaintenanceMode(context.Context, *GetMaintenanceModeRequest) (*GetMaintenanceModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMaintenanceMode not implemented")
+}
+func (UnimplementedAdminServiceServer) BroadcastAnnouncement(context.Context, *BroadcastAnnouncementRequest) (*BroadcastAnnouncementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BroadcastAnnouncement not implemented")
+}
+func (UnimplementedAdminServiceServer) SetWorldInventorySettings(context.Context, *SetWorldInventorySettingsRequest) (*SetWorldInventorySettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWorldInventorySettings not implemented")
+}
+func (UnimplementedAdminServiceServer) GetWorldInventorySettings(context.Context, *GetWorldInventorySettingsRequest) (*GetWorldInventorySettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorldInventorySettings not implemented")
+}
+func (UnimplementedAdminServiceServer) SetWorldTimeScale(context.Context, *SetWorldTimeScaleRequest) (*SetWorldTimeScaleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWorldTimeScale not implemented")
+}
+func (UnimplementedAdminServiceServer) GetWorldTimeScale(context.Context, *GetWorldTimeScaleRequest) (*GetWorldTimeScaleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorldTimeScale not implemented")
+}
+func (UnimplementedAdminServiceServer) PauseWorld(context.Context, *PauseWorldRequest) (*PauseWorldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseWorld not implemented")
+}
+func (UnimplementedAdminServiceServer) ResumeWorld(context.Context, *ResumeWorldRequest) (*ResumeWorldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeWorld not implemented")
+}
+func (UnimplementedAdminServiceServer) ListWorldPauses(context.Context, *ListWorldPausesRequest) (*ListWorldPausesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorldPauses not implemented")
+}
+func (UnimplementedAdminServiceServer) ApplyStatusEffect(context.Context, *ApplyStatusEffectRequest) (*ApplyStatusEffectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyStatusEffect not implemented")
+}
+func (UnimplementedAdminServiceServer) RemoveStatusEffect(context.Context, *RemoveStatusEffectRequest) (*RemoveStatusEffectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveStatusEffect not implemented")
+}
+func (UnimplementedAdminServiceServer) GetEconomyStats(context.Context, *GetEconomyStatsRequest) (*GetEconomyStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEconomyStats not implemented")
+}
+func (UnimplementedAdminServiceServer) LookupCharacterName(context.Context, *LookupCharacterNameRequest) (*LookupCharacterNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupCharacterName not implemented")
+}
+func (UnimplementedAdminServiceServer) PublishConsentDocument(context.Context, *PublishConsentDocumentRequest) (*PublishConsentDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishConsentDocument not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_ListPlayerReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlayerReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListPlayerReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListPlayerReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListPlayerReports(ctx, req.(*ListPlayerReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ResolvePlayerReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolvePlayerReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ResolvePlayerReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ResolvePlayerReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ResolvePlayerReport(ctx, req.(*ResolvePlayerReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateApiKey(ctx, req.(*CreateApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListApiKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApiKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListApiKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListApiKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListApiKeys(ctx, req.(*ListApiKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RevokeApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RevokeApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RevokeApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RevokeApiKey(ctx, req.(*RevokeApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateProtectedRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProtectedRegionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateProtectedRegion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateProtectedRegion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateProtectedRegion(ctx, req.(*CreateProtectedRegionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateProtectedRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProtectedRegionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateProtectedRegion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateProtectedRegion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateProtectedRegion(ctx, req.(*UpdateProtectedRegionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListProtectedRegions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProtectedRegionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListProtectedRegions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListProtectedRegions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListProtectedRegions(ctx, req.(*ListProtectedRegionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteProtectedRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProtectedRegionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteProtectedRegion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteProtectedRegion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteProtectedRegion(ctx, req.(*DeleteProtectedRegionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetFeatureFlag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFeatureFlagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetFeatureFlag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetFeatureFlag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetFeatureFlag(ctx, req.(*SetFeatureFlagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListFeatureFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFeatureFlagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListFeatureFlags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListFeatureFlags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListFeatureFlags(ctx, req.(*ListFeatureFlagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteFeatureFlag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFeatureFlagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteFeatureFlag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteFeatureFlag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteFeatureFlag(ctx, req.(*DeleteFeatureFlagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateExperiment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExperimentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateExperiment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateExperiment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateExperiment(ctx, req.(*CreateExperimentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListExperiments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExperimentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListExperiments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListExperiments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListExperiments(ctx, req.(*ListExperimentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_StopExperiment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopExperimentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).StopExperiment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_StopExperiment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).StopExperiment(ctx, req.(*StopExperimentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetMaintenanceMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMaintenanceModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetMaintenanceMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetMaintenanceMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetMaintenanceMode(ctx, req.(*SetMaintenanceModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetMaintenanceMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMaintenanceModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetMaintenanceMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetMaintenanceMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetMaintenanceMode(ctx, req.(*GetMaintenanceModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_BroadcastAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BroadcastAnnouncementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).BroadcastAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_BroadcastAnnouncement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).BroadcastAnnouncement(ctx, req.(*BroadcastAnnouncementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetWorldInventorySettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWorldInventorySettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetWorldInventorySettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetWorldInventorySettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetWorldInventorySettings(ctx, req.(*SetWorldInventorySettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetWorldInventorySettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorldInventorySettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetWorldInventorySettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetWorldInventorySettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetWorldInventorySettings(ctx, req.(*GetWorldInventorySettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetWorldTimeScale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWorldTimeScaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetWorldTimeScale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetWorldTimeScale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetWorldTimeScale(ctx, req.(*SetWorldTimeScaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetWorldTimeScale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorldTimeScaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetWorldTimeScale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetWorldTimeScale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetWorldTimeScale(ctx, req.(*GetWorldTimeScaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PauseWorld_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseWorldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PauseWorld(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_PauseWorld_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PauseWorld(ctx, req.(*PauseWorldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ResumeWorld_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeWorldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ResumeWorld(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ResumeWorld_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ResumeWorld(ctx, req.(*ResumeWorldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListWorldPauses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorldPausesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListWorldPauses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListWorldPauses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListWorldPauses(ctx, req.(*ListWorldPausesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ApplyStatusEffect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyStatusEffectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ApplyStatusEffect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ApplyStatusEffect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ApplyStatusEffect(ctx, req.(*ApplyStatusEffectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RemoveStatusEffect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveStatusEffectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RemoveStatusEffect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RemoveStatusEffect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RemoveStatusEffect(ctx, req.(*RemoveStatusEffectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetEconomyStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEconomyStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetEconomyStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetEconomyStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetEconomyStats(ctx, req.(*GetEconomyStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_LookupCharacterName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupCharacterNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).LookupCharacterName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_LookupCharacterName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).LookupCharacterName(ctx, req.(*LookupCharacterNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PublishConsentDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishConsentDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PublishConsentDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_PublishConsentDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PublishConsentDocument(ctx, req.(*PublishConsentDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "admin.v1.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListPlayerReports",
+			Handler:    _AdminService_ListPlayerReports_Handler,
+		},
+		{
+			MethodName: "ResolvePlayerReport",
+			Handler:    _AdminService_ResolvePlayerReport_Handler,
+		},
+		{
+			MethodName: "CreateApiKey",
+			Handler:    _AdminService_CreateApiKey_Handler,
+		},
+		{
+			MethodName: "ListApiKeys",
+			Handler:    _AdminService_ListApiKeys_Handler,
+		},
+		{
+			MethodName: "RevokeApiKey",
+			Handler:    _AdminService_RevokeApiKey_Handler,
+		},
+		{
+			MethodName: "CreateProtectedRegion",
+			Handler:    _AdminService_CreateProtectedRegion_Handler,
+		},
+		{
+			MethodName: "UpdateProtectedRegion",
+			Handler:    _AdminService_UpdateProtectedRegion_Handler,
+		},
+		{
+			MethodName: "ListProtectedRegions",
+			Handler:    _AdminService_ListProtectedRegions_Handler,
+		},
+		{
+			MethodName: "DeleteProtectedRegion",
+			Handler:    _AdminService_DeleteProtectedRegion_Handler,
+		},
+		{
+			MethodName: "SetFeatureFlag",
+			Handler:    _AdminService_SetFeatureFlag_Handler,
+		},
+		{
+			MethodName: "ListFeatureFlags",
+			Handler:    _AdminService_ListFeatureFlags_Handler,
+		},
+		{
+			MethodName: "DeleteFeatureFlag",
+			Handler:    _AdminService_DeleteFeatureFlag_Handler,
+		},
+		{
+			MethodName: "CreateExperiment",
+			Handler:    _AdminService_CreateExperiment_Handler,
+		},
+		{
+			MethodName: "ListExperiments",
+			Handler:    _AdminService_ListExperiments_Handler,
+		},
+		{
+			MethodName: "StopExperiment",
+			Handler:    _AdminService_StopExperiment_Handler,
+		},
+		{
+			MethodName: "SetMaintenanceMode",
+			Handler:    _AdminService_SetMaintenanceMode_Handler,
+		},
+		{
+			MethodName: "GetMaintenanceMode",
+			Handler:    _AdminService_GetMaintenanceMode_Handler,
+		},
+		{
+			MethodName: "BroadcastAnnouncement",
+			Handler:    _AdminService_BroadcastAnnouncement_Handler,
+		},
+		{
+			MethodName: "SetWorldInventorySettings",
+			Handler:    _AdminService_SetWorldInventorySettings_Handler,
+		},
+		{
+			MethodName: "GetWorldInventorySettings",
+			Handler:    _AdminService_GetWorldInventorySettings_Handler,
+		},
+		{
+			MethodName: "SetWorldTimeScale",
+			Handler:    _AdminService_SetWorldTimeScale_Handler,
+		},
+		{
+			MethodName: "GetWorldTimeScale",
+			Handler:    _AdminService_GetWorldTimeScale_Handler,
+		},
+		{
+			MethodName: "PauseWorld",
+			Handler:    _AdminService_PauseWorld_Handler,
+		},
+		{
+			MethodName: "ResumeWorld",
+			Handler:    _AdminService_ResumeWorld_Handler,
+		},
+		{
+			MethodName: "ListWorldPauses",
+			Handler:    _AdminService_ListWorldPauses_Handler,
+		},
+		{
+			MethodName: "ApplyStatusEffect",
+			Handler:    _AdminService_ApplyStatusEffect_Handler,
+		},
+		{
+			MethodName: "RemoveStatusEffect",
+			Handler:    _AdminService_RemoveStatusEffect_Handler,
+		},
+		{
+			MethodName: "GetEconomyStats",
+			Handler:    _AdminService_GetEconomyStats_Handler,
+		},
+		{
+			MethodName: "LookupCharacterName",
+			Handler:    _AdminService_LookupCharacterName_Handler,
+		},
+		{
+			MethodName: "PublishConsentDocument",
+			Handler:    _AdminService_PublishConsentDocument_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "admin/v1/admin.proto",
+}
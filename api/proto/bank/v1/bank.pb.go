@@ -0,0 +1,1572 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.4
+// source: bank/v1/bank.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type VaultKind int32
+
+const (
+	VaultKind_VAULT_KIND_UNSPECIFIED VaultKind = 0
+	VaultKind_VAULT_KIND_PERSONAL    VaultKind = 1
+	VaultKind_VAULT_KIND_GUILD       VaultKind = 2
+)
+
+// Enum value maps for VaultKind.
+var (
+	VaultKind_name = map[int32]string{
+		0: "VAULT_KIND_UNSPECIFIED",
+		1: "VAULT_KIND_PERSONAL",
+		2: "VAULT_KIND_GUILD",
+	}
+	VaultKind_value = map[string]int32{
+		"VAULT_KIND_UNSPECIFIED": 0,
+		"VAULT_KIND_PERSONAL":    1,
+		"VAULT_KIND_GUILD":       2,
+	}
+)
+
+func (x VaultKind) Enum() *VaultKind {
+	p := new(VaultKind)
+	*p = x
+	return p
+}
+
+func (x VaultKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VaultKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_bank_v1_bank_proto_enumTypes[0].Descriptor()
+}
+
+func (VaultKind) Type() protoreflect.EnumType {
+	return &file_bank_v1_bank_proto_enumTypes[0]
+}
+
+func (x VaultKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VaultKind.Descriptor instead.
+func (VaultKind) EnumDescriptor() ([]byte, []int) {
+	return file_bank_v1_bank_proto_rawDescGZIP(), []int{0}
+}
+
+// What a character may do with a vault. Each tier includes the ones before it; owners of
+// personal vaults are managers.
+type VaultTier int32
+
+const (
+	VaultTier_VAULT_TIER_UNSPECIFIED VaultTier = 0
+	VaultTier_VAULT_TIER_VIEW        VaultTier = 1 // See the items, members and transactions
+	VaultTier_VAULT_TIER_DEPOSIT     VaultTier = 2
+	VaultTier_VAULT_TIER_WITHDRAW    VaultTier = 3
+	VaultTier_VAULT_TIER_MANAGE      VaultTier = 4 // Add, change and remove members
+)
+
+// Enum value maps for VaultTier.
+var (
+	VaultTier_name = map[int32]string{
+		0: "VAULT_TIER_UNSPECIFIED",
+		1: "VAULT_TIER_VIEW",
+		2: "VAULT_TIER_DEPOSIT",
+		3: "VAULT_TIER_WITHDRAW",
+		4: "VAULT_TIER_MANAGE",
+	}
+	VaultTier_value = map[string]int32{
+		"VAULT_TIER_UNSPECIFIED": 0,
+		"VAULT_TIER_VIEW":        1,
+		"VAULT_TIER_DEPOSIT":     2,
+		"VAULT_TIER_WITHDRAW":    3,
+		"VAULT_TIER_MANAGE":      4,
+	}
+)
+
+func (x VaultTier) Enum() *VaultTier {
+	p := new(VaultTier)
+	*p = x
+	return p
+}
+
+func (x VaultTier) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VaultTier) Descriptor() protoreflect.EnumDescriptor {
+	return file_bank_v1_bank_proto_enumTypes[1].Descriptor()
+}
+
+func (VaultTier) Type() protoreflect.EnumType {
+	return &file_bank_v1_bank_proto_enumTypes[1]
+}
+
+func (x VaultTier) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VaultTier.Descriptor instead.
+func (VaultTier) EnumDescriptor() ([]byte, []int) {
+	return file_bank_v1_bank_proto_rawDescGZIP(), []int{1}
+}
+
+type VaultItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        int32                  `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	ItemName      string                 `protobuf:"bytes,2,opt,name=item_name,json=itemName,proto3" json:"item_name,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	StackSize     int32                  `protobuf:"varint,4,opt,name=stack_size,json=stackSize,proto3" json:"stack_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VaultItem) Reset() {
+	*x = VaultItem{}
+	mi := &file_bank_v1_bank_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VaultItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultItem) ProtoMessage() {}
+
+func (x *VaultItem) ProtoReflect() protoreflect.Message {
+	mi := &file_bank_v1_bank_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultItem.ProtoReflect.Descriptor instead.
+func (*VaultItem) Descriptor() ([]byte, []int) {
+	return file_bank_v1_bank_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *VaultItem) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *VaultItem) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *VaultItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *VaultItem) GetStackSize() int32 {
+	if x != nil {
+		return x.StackSize
+	}
+	return 0
+}
+
+type Vault struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind             VaultKind              `protobuf:"varint,2,opt,name=kind,proto3,enum=bank.v1.VaultKind" json:"kind,omitempty"`
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                                   // Empty for personal vaults
+	OwnerCharacterId string                 `protobuf:"bytes,4,opt,name=owner_character_id,json=ownerCharacterId,proto3" json:"owner_character_id,omitempty"` // Empty for guild vaults
+	Tier             VaultTier              `protobuf:"varint,5,opt,name=tier,proto3,enum=bank.v1.VaultTier" json:"tier,omitempty"`                           // The requesting character's tier
+	Slots            int32                  `protobuf:"varint,6,opt,name=slots,proto3" json:"slots,omitempty"`                                                // Every item takes one slot per stack size, rounded up
+	UsedSlots        int32                  `protobuf:"varint,7,opt,name=used_slots,json=usedSlots,proto3" json:"used_slots,omitempty"`                       // Unset when items are not returned
+	Items            []*VaultItem           `protobuf:"bytes,8,rep,name=items,proto3" json:"items,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Vault) Reset() {
+	*x = Vault{}
+	mi := &file_bank_v1_bank_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Vault) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vault) ProtoMessage() {}
+
+func (x *Vault) ProtoReflect() protoreflect.Message {
+	mi := &file_bank_v1_bank_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vault.ProtoReflect.Descriptor instead.
+func (*Vault) Descriptor() ([]byte, []int) {
+	return file_bank_v1_bank_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Vault) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Vault) GetKind() VaultKind {
+	if x != nil {
+		return x.Kind
+	}
+	return VaultKind_VAULT_KIND_UNSPECIFIED
+}
+
+func (x *Vault) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Vault) GetOwnerCharacterId() string {
+	if x != nil {
+		return x.OwnerCharacterId
+	}
+	return ""
+}
+
+func (x *Vault) GetTier() VaultTier {
+	if x != nil {
+		return x.Tier
+	}
+	return VaultTier_VAULT_TIER_UNSPECIFIED
+}
+
+func (x *Vault) GetSlots() int32 {
+	if x != nil {
+		return x.Slots
+	}
+	return 0
+}
+
+func (x *Vault) GetUsedSlots() int32 {
+	if x != nil {
+		return x.UsedSlots
+	}
+	return 0
+}
+
+func (x *Vault) GetItems() []*VaultItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Vault) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type VaultMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	CharacterName string                 `protobuf:"bytes,2,opt,name=character_name,json=characterName,proto3" json:"character_name,omitempty"`
+	Tier          VaultTier              `protobuf:"varint,3,opt,name=tier,proto3,enum=bank.v1.VaultTier" json:"tier,omitempty"`
+	AddedAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VaultMember) Reset() {
+	*x = VaultMember{}
+	mi := &file_bank_v1_bank_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VaultMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultMember) ProtoMessage() {}
+
+func (x *VaultMember) ProtoReflect() protoreflect.Message {
+	mi := &file_bank_v1_bank_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultMember.ProtoReflect.Descriptor instead.
+func (*VaultMember) Descriptor() ([]byte, []int) {
+	return file_bank_v1_bank_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *VaultMember) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *VaultMember) GetCharacterName() string {
+	if x != nil {
+		return x.CharacterName
+	}
+	return ""
+}
+
+func (x *VaultMember) GetTier() VaultTier {
+	if x != nil {
+		return x.Tier
+	}
+	return VaultTier_VAULT_TIER_UNSPECIFIED
+}
+
+func (x *VaultMember) GetAddedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AddedAt
+	}
+	return nil
+}
+
+type VaultTransaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	VaultId       int64                  `protobuf:"varint,2,opt,name=vault_id,json=vaultId,proto3" json:"vault_id,omitempty"`
+	CharacterId   string                 `protobuf:"bytes,3,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"` // Empty when the character has been deleted
+	CharacterName string                 `protobuf:"bytes,4,opt,name=character_name,json=characterName,proto3" json:"character_name,omitempty"`
+	ItemId        int32                  `protobuf:"varint,5,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	ItemName      string                 `protobuf:"bytes,6,opt,name=item_name,json=itemName,proto3" json:"item_name,omitempty"`
+	Quantity      int32                  `protobuf:"varint,7,opt,name=quantity,proto3" json:"quantity,omitempty"` // Positive for deposits, negative for withdrawals
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VaultTransaction) Reset() {
+	*x = VaultTransaction{}
+	mi := &file_bank_v1_bank_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VaultTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultTransaction) ProtoMessage() {}
+
+func (x *VaultTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_bank_v1_bank_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultTransaction.ProtoReflect.Descriptor instead.
+func (*VaultTransaction) Descriptor() ([]byte, []int) {
+	return file_bank_v1_bank_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *VaultTransaction) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *VaultTransaction) GetVaultId() int64 {
+	if x != nil {
+		return x.VaultId
+	}
+	return 0
+}
+
+func (x *VaultTransaction) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *VaultTransaction) GetCharacterName() string {
+	if x != nil {
+		return x.CharacterName
+	}
+	return ""
+}
+
+func (x *VaultTransaction) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *VaultTransaction) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *VaultTransaction) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *VaultTransaction) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListVaultsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVaultsRequest) Reset() {
+	*x = ListVaultsRequest{}
+	mi := &file_bank_v1_bank_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVaultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVaultsRequest) ProtoMessage() {}
+
+func (x *ListVaultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bank_v1_bank_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVaultsRequest.ProtoReflect.Descriptor instead.
+func (*ListVaultsRequest) Descriptor() ([]byte, []int) {
+	return file_bank_v1_bank_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListVaultsRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+type ListVaultsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vaults        []*Vault               `protobuf:"bytes,1,rep,name=vaults,proto3" json:"vaults,omitempty"` // The personal vault first, once it has been opened
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVaultsResponse) Reset() {
+	*x = ListVaultsResponse{}
+	mi := &file_bank_v1_bank_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVaultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVaultsResponse) ProtoMessage() {}
+
+func (x *ListVaultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bank_v1_bank_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVaultsResponse.ProtoReflect.Descriptor instead.
+func (*ListVaultsResponse) Descriptor() ([]byte, []int) {
+	return file_bank_v1_bank_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListVaultsResponse) GetVaults() []*Vault {
+	if x != nil {
+		return x.Vaults
+	}
+	return nil
+}
+
+type OpenVaultRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	BankEntityId  int64                  `protobuf:"varint,2,opt,name=bank_entity_id,json=bankEntityId,proto3" json:"bank_entity_id,omitempty"`
+	VaultId       int64                  `protobuf:"varint,3,opt,name=vault_id,json=vaultId,proto3" json:"vault_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenVaultRequest) Reset() {
+	*x = OpenVaultRequest{}
+	mi := &file_bank_v1_bank_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenVaultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenVaultRequest) ProtoMessage() {}
+
+func (x *OpenVaultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bank_v1_bank_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenVaultRequest.ProtoReflect.Descriptor instead.
+func (*OpenVaultRequest) Descriptor() ([]byte, []int) {
+	return file_bank_v1_bank_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *OpenVaultRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *OpenVaultRequest) GetBankEntityId() int64 {
+	if x != nil {
+		return x.BankEntityId
+	}
+	return 0
+}
+
+func (x *OpenVaultRequest) GetVaultId() int64 {
+	if x != nil {
+		return x.VaultId
+	}
+	return 0
+}
+
+type OpenVaultResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vault         *Vault                 `protobuf:"bytes,1,opt,name=vault,proto3" json:"vault,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenVaultResponse) Reset() {
+	*x = OpenVaultResponse{}
+	mi := &file_bank_v1_bank_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenVaultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenVaultResponse) ProtoMessage() {}
+
+func (x *OpenVaultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bank_v1_bank_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenVaultResponse.ProtoReflect.Descriptor instead.
+func (*OpenVaultResponse) Descriptor() ([]byte, []int) {
+	return file_bank_v1_bank_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *OpenVaultResponse) GetVault() *Vault {
+	if x != nil {
+		return x.Vault
+	}
+	return nil
+}
+
+type DepositRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	BankEntityId  int64                  `protobuf:"varint,2,opt,name=bank_entity_id,json=bankEntityId,proto3" json:"bank_entity_id,omitempty"`
+	VaultId       int64                  `protobuf:"varint,3,opt,name=vault_id,json=vaultId,proto3" json:"vault_id,omitempty"` // 0 for the personal vault
+	ItemId        int32                  `protobuf:"varint,4,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepositRequest) Reset() {
+	*x = DepositRequest{}
+	mi := &file_bank_v1_bank_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepositRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositRequest) ProtoMessage() {}
+
+func (x *DepositRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bank_v1_bank_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositRequest.ProtoReflect.Descriptor instead.
+func (*DepositRequest) Descriptor() ([]byte, []int) {
+	return file_bank_v1_bank_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DepositRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *DepositRequest) GetBankEntityId() int64 {
+	if x != nil {
+		return x.BankEntityId
+	}
+	return 0
+}
+
+func (x *DepositRequest) GetVaultId() int64 {
+	if x != nil {
+		return x.VaultId
+	}
+	return 0
+}
+
+func (x *DepositRequest) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *DepositRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type DepositResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vault         *Vault                 `protobuf:"bytes,1,opt,name=vault,proto3" json:"vault,omitempty"`
+	Transaction   *VaultTransaction      `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepositResponse) Reset() {
+	*x = DepositResponse{}
+	mi := &file_bank_v1_bank_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepositResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositResponse) ProtoMessage() {}
+
+func (x *DepositResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bank_v1_bank_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositResponse.ProtoReflect.Descriptor instead.
+func (*DepositResponse) Descriptor() ([]byte, []int) {
+	return file_bank_v1_bank_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DepositResponse) GetVault() *Vault {
+	if x != nil {
+		return x.Vault
+	}
+	return nil
+}
+
+func (x *DepositResponse) GetTransaction() *VaultTransaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+type WithdrawRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	BankEntityId  int64                  `protobuf:"varint,2,opt,name=bank_entity_id,json=bankEntityId,proto3" json:"bank_entity_id,omitempty"`
+	VaultId       int64                  `protobuf:"varint,3,opt,name=vault_id,json=vaultId,proto3" json:"vault_id,omitempty"` // 0 for the personal vault
+	ItemId        int32                  `protobuf:"varint,4,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawRequest) Reset() {
+	*x = WithdrawRequest{}
+	mi := &file_bank_v1_bank_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawRequest) ProtoMessage() {}
+
+func (x *WithdrawRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bank_v1_bank_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawRequest) Descriptor() ([]byte, []int) {
+	return file_bank_v1_bank_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *WithdrawRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *WithdrawRequest) GetBankEntityId() int64 {
+	if x != nil {
+		return x.BankEntityId
+	}
+	return 0
+}
+
+func (x *WithdrawRequest) GetVaultId() int64 {
+	if x != nil {
+		return x.VaultId
+	}
+	return 0
+}
+
+func (x *WithdrawRequest) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *WithdrawRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type WithdrawResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vault         *Vault                 `protobuf:"bytes,1,opt,name=vault,proto3" json:"vault,omitempty"`
+	Transaction   *VaultTransaction      `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawResponse) Reset() {
+	*x = WithdrawResponse{}
+	mi := &file_bank_v1_bank_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawResponse) ProtoMessage() {}
+
+func (x *WithdrawResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bank_v1_bank_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawResponse.ProtoReflect.Descriptor instead.
+func (*WithdrawResponse) Descriptor() ([]byte, []int) {
+	return file_bank_v1_bank_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *WithdrawResponse) GetVault() *Vault {
+	if x != nil {
+		return x.Vault
+	}
+	return nil
+}
+
+func (x *WithdrawResponse) GetTransaction() *VaultTransaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+type CreateGuildVaultRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	BankEntityId  int64                  `protobuf:"varint,2,opt,name=bank_entity_id,json=bankEntityId,proto3" json:"bank_entity_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"` // Unique in the world, ignoring case
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGuildVaultRequest) Reset() {
+	*x = CreateGuildVaultRequest{}
+	mi := &file_bank_v1_bank_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGuildVaultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGuildVaultRequest) ProtoMessage() {}
+
+func (x *CreateGuildVaultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bank_v1_bank_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGuildVaultRequest.ProtoReflect.Descriptor instead.
+func (*CreateGuildVaultRequest) Descriptor() ([]byte, []int) {
+	return file_bank_v1_bank_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateGuildVaultRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *CreateGuildVaultRequest) GetBankEntityId() int64 {
+	if x != nil {
+		return x.BankEntityId
+	}
+	return 0
+}
+
+func (x *CreateGuildVaultRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateGuildVaultResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vault         *Vault                 `protobuf:"bytes,1,opt,name=vault,proto3" json:"vault,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGuildVaultResponse) Reset() {
+	*x = CreateGuildVaultResponse{}
+	mi := &file_bank_v1_bank_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGuildVaultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGuildVaultResponse) ProtoMessage() {}
+
+func (x *CreateGuildVaultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bank_v1_bank_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGuildVaultResponse.ProtoReflect.Descriptor instead.
+func (*CreateGuildVaultResponse) Descriptor() ([]byte, []int) {
+	return file_bank_v1_bank_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateGuildVaultResponse) GetVault() *Vault {
+	if x != nil {
+		return x.Vault
+	}
+	return nil
+}
+
+type ListVaultMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	VaultId       int64                  `protobuf:"varint,2,opt,name=vault_id,json=vaultId,proto3" json:"vault_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVaultMembersRequest) Reset() {
+	*x = ListVaultMembersRequest{}
+	mi := &file_bank_v1_bank_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVaultMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVaultMembersRequest) ProtoMessage() {}
+
+func (x *ListVaultMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bank_v1_bank_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVaultMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListVaultMembersRequest) Descriptor() ([]byte, []int) {
+	return file_bank_v1_bank_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListVaultMembersRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *ListVaultMembersRequest) GetVaultId() int64 {
+	if x != nil {
+		return x.VaultId
+	}
+	return 0
+}
+
+type ListVaultMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*VaultMember         `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVaultMembersResponse) Reset() {
+	*x = ListVaultMembersResponse{}
+	mi := &file_bank_v1_bank_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVaultMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVaultMembersResponse) ProtoMessage() {}
+
+func (x *ListVaultMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bank_v1_bank_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVaultMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListVaultMembersResponse) Descriptor() ([]byte, []int) {
+	return file_bank_v1_bank_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListVaultMembersResponse) GetMembers() []*VaultMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type SetVaultMemberRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId       string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	VaultId           int64                  `protobuf:"varint,2,opt,name=vault_id,json=vaultId,proto3" json:"vault_id,omitempty"`
+	MemberCharacterId string                 `protobuf:"bytes,3,opt,name=member_character_id,json=memberCharacterId,proto3" json:"member_character_id,omitempty"`
+	Tier              VaultTier              `protobuf:"varint,4,opt,name=tier,proto3,enum=bank.v1.VaultTier" json:"tier,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SetVaultMemberRequest) Reset() {
+	*x = SetVaultMemberRequest{}
+	mi := &file_bank_v1_bank_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetVaultMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVaultMemberRequest) ProtoMessage() {}
+
+func (x *SetVaultMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bank_v1_bank_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVaultMemberRequest.ProtoReflect.Descriptor instead.
+func (*SetVaultMemberRequest) Descriptor() ([]byte, []int) {
+	return file_bank_v1_bank_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SetVaultMemberRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *SetVaultMemberRequest) GetVaultId() int64 {
+	if x != nil {
+		return x.VaultId
+	}
+	return 0
+}
+
+func (x *SetVaultMemberRequest) GetMemberCharacterId() string {
+	if x != nil {
+		return x.MemberCharacterId
+	}
+	return ""
+}
+
+func (x *SetVaultMemberRequest) GetTier() VaultTier {
+	if x != nil {
+		return x.Tier
+	}
+	return VaultTier_VAULT_TIER_UNSPECIFIED
+}
+
+type SetVaultMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *VaultMember           `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"` // Unset when the member was removed
+	Removed       bool                   `protobuf:"varint,2,opt,name=removed,proto3" json:"removed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetVaultMemberResponse) Reset() {
+	*x = SetVaultMemberResponse{}
+	mi := &file_bank_v1_bank_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetVaultMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVaultMemberResponse) ProtoMessage() {}
+
+func (x *SetVaultMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bank_v1_bank_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVaultMemberResponse.ProtoReflect.Descriptor instead.
+func (*SetVaultMemberResponse) Descriptor() ([]byte, []int) {
+	return file_bank_v1_bank_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SetVaultMemberResponse) GetMember() *VaultMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+func (x *SetVaultMemberResponse) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
+}
+
+type ListVaultTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	VaultId       int64                  `protobuf:"varint,2,opt,name=vault_id,json=vaultId,proto3" json:"vault_id,omitempty"`    // 0 for the personal vault
+	BeforeId      int64                  `protobuf:"varint,3,opt,name=before_id,json=beforeId,proto3" json:"before_id,omitempty"` // Cursor: the last id of the previous page, 0 for the first
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVaultTransactionsRequest) Reset() {
+	*x = ListVaultTransactionsRequest{}
+	mi := &file_bank_v1_bank_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVaultTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVaultTransactionsRequest) ProtoMessage() {}
+
+func (x *ListVaultTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bank_v1_bank_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVaultTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListVaultTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_bank_v1_bank_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListVaultTransactionsRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *ListVaultTransactionsRequest) GetVaultId() int64 {
+	if x != nil {
+		return x.VaultId
+	}
+	return 0
+}
+
+func (x *ListVaultTransactionsRequest) GetBeforeId() int64 {
+	if x != nil {
+		return x.BeforeId
+	}
+	return 0
+}
+
+func (x *ListVaultTransactionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListVaultTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*VaultTransaction    `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVaultTransactionsResponse) Reset() {
+	*x = ListVaultTransactionsResponse{}
+	mi := &file_bank_v1_bank_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVaultTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVaultTransactionsResponse) ProtoMessage() {}
+
+func (x *ListVaultTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bank_v1_bank_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVaultTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListVaultTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_bank_v1_bank_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListVaultTransactionsResponse) GetTransactions() []*VaultTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+var File_bank_v1_bank_proto protoreflect.FileDescriptor
+
+const file_bank_v1_bank_proto_rawDesc = "" +
+	"\n" +
+	"\x12bank/v1/bank.proto\x12\abank.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"|\n" +
+	"\tVaultItem\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\x05R\x06itemId\x12\x1b\n" +
+	"\titem_name\x18\x02 \x01(\tR\bitemName\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x1d\n" +
+	"\n" +
+	"stack_size\x18\x04 \x01(\x05R\tstackSize\"\xc3\x02\n" +
+	"\x05Vault\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12&\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x12.bank.v1.VaultKindR\x04kind\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12,\n" +
+	"\x12owner_character_id\x18\x04 \x01(\tR\x10ownerCharacterId\x12&\n" +
+	"\x04tier\x18\x05 \x01(\x0e2\x12.bank.v1.VaultTierR\x04tier\x12\x14\n" +
+	"\x05slots\x18\x06 \x01(\x05R\x05slots\x12\x1d\n" +
+	"\n" +
+	"used_slots\x18\a \x01(\x05R\tusedSlots\x12(\n" +
+	"\x05items\x18\b \x03(\v2\x12.bank.v1.VaultItemR\x05items\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xb6\x01\n" +
+	"\vVaultMember\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12%\n" +
+	"\x0echaracter_name\x18\x02 \x01(\tR\rcharacterName\x12&\n" +
+	"\x04tier\x18\x03 \x01(\x0e2\x12.bank.v1.VaultTierR\x04tier\x125\n" +
+	"\badded_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aaddedAt\"\x94\x02\n" +
+	"\x10VaultTransaction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\bvault_id\x18\x02 \x01(\x03R\avaultId\x12!\n" +
+	"\fcharacter_id\x18\x03 \x01(\tR\vcharacterId\x12%\n" +
+	"\x0echaracter_name\x18\x04 \x01(\tR\rcharacterName\x12\x17\n" +
+	"\aitem_id\x18\x05 \x01(\x05R\x06itemId\x12\x1b\n" +
+	"\titem_name\x18\x06 \x01(\tR\bitemName\x12\x1a\n" +
+	"\bquantity\x18\a \x01(\x05R\bquantity\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"6\n" +
+	"\x11ListVaultsRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\"<\n" +
+	"\x12ListVaultsResponse\x12&\n" +
+	"\x06vaults\x18\x01 \x03(\v2\x0e.bank.v1.VaultR\x06vaults\"v\n" +
+	"\x10OpenVaultRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12$\n" +
+	"\x0ebank_entity_id\x18\x02 \x01(\x03R\fbankEntityId\x12\x19\n" +
+	"\bvault_id\x18\x03 \x01(\x03R\avaultId\"9\n" +
+	"\x11OpenVaultResponse\x12$\n" +
+	"\x05vault\x18\x01 \x01(\v2\x0e.bank.v1.VaultR\x05vault\"\xa9\x01\n" +
+	"\x0eDepositRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12$\n" +
+	"\x0ebank_entity_id\x18\x02 \x01(\x03R\fbankEntityId\x12\x19\n" +
+	"\bvault_id\x18\x03 \x01(\x03R\avaultId\x12\x17\n" +
+	"\aitem_id\x18\x04 \x01(\x05R\x06itemId\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\"t\n" +
+	"\x0fDepositResponse\x12$\n" +
+	"\x05vault\x18\x01 \x01(\v2\x0e.bank.v1.VaultR\x05vault\x12;\n" +
+	"\vtransaction\x18\x02 \x01(\v2\x19.bank.v1.VaultTransactionR\vtransaction\"\xaa\x01\n" +
+	"\x0fWithdrawRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12$\n" +
+	"\x0ebank_entity_id\x18\x02 \x01(\x03R\fbankEntityId\x12\x19\n" +
+	"\bvault_id\x18\x03 \x01(\x03R\avaultId\x12\x17\n" +
+	"\aitem_id\x18\x04 \x01(\x05R\x06itemId\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\"u\n" +
+	"\x10WithdrawResponse\x12$\n" +
+	"\x05vault\x18\x01 \x01(\v2\x0e.bank.v1.VaultR\x05vault\x12;\n" +
+	"\vtransaction\x18\x02 \x01(\v2\x19.bank.v1.VaultTransactionR\vtransaction\"v\n" +
+	"\x17CreateGuildVaultRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12$\n" +
+	"\x0ebank_entity_id\x18\x02 \x01(\x03R\fbankEntityId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"@\n" +
+	"\x18CreateGuildVaultResponse\x12$\n" +
+	"\x05vault\x18\x01 \x01(\v2\x0e.bank.v1.VaultR\x05vault\"W\n" +
+	"\x17ListVaultMembersRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x19\n" +
+	"\bvault_id\x18\x02 \x01(\x03R\avaultId\"J\n" +
+	"\x18ListVaultMembersResponse\x12.\n" +
+	"\amembers\x18\x01 \x03(\v2\x14.bank.v1.VaultMemberR\amembers\"\xad\x01\n" +
+	"\x15SetVaultMemberRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x19\n" +
+	"\bvault_id\x18\x02 \x01(\x03R\avaultId\x12.\n" +
+	"\x13member_character_id\x18\x03 \x01(\tR\x11memberCharacterId\x12&\n" +
+	"\x04tier\x18\x04 \x01(\x0e2\x12.bank.v1.VaultTierR\x04tier\"`\n" +
+	"\x16SetVaultMemberResponse\x12,\n" +
+	"\x06member\x18\x01 \x01(\v2\x14.bank.v1.VaultMemberR\x06member\x12\x18\n" +
+	"\aremoved\x18\x02 \x01(\bR\aremoved\"\x8f\x01\n" +
+	"\x1cListVaultTransactionsRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x19\n" +
+	"\bvault_id\x18\x02 \x01(\x03R\avaultId\x12\x1b\n" +
+	"\tbefore_id\x18\x03 \x01(\x03R\bbeforeId\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"^\n" +
+	"\x1dListVaultTransactionsResponse\x12=\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x19.bank.v1.VaultTransactionR\ftransactions*V\n" +
+	"\tVaultKind\x12\x1a\n" +
+	"\x16VAULT_KIND_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13VAULT_KIND_PERSONAL\x10\x01\x12\x14\n" +
+	"\x10VAULT_KIND_GUILD\x10\x02*\x84\x01\n" +
+	"\tVaultTier\x12\x1a\n" +
+	"\x16VAULT_TIER_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fVAULT_TIER_VIEW\x10\x01\x12\x16\n" +
+	"\x12VAULT_TIER_DEPOSIT\x10\x02\x12\x17\n" +
+	"\x13VAULT_TIER_WITHDRAW\x10\x03\x12\x15\n" +
+	"\x11VAULT_TIER_MANAGE\x10\x042\x94\x05\n" +
+	"\vBankService\x12G\n" +
+	"\n" +
+	"ListVaults\x12\x1a.bank.v1.ListVaultsRequest\x1a\x1b.bank.v1.ListVaultsResponse\"\x00\x12D\n" +
+	"\tOpenVault\x12\x19.bank.v1.OpenVaultRequest\x1a\x1a.bank.v1.OpenVaultResponse\"\x00\x12>\n" +
+	"\aDeposit\x12\x17.bank.v1.DepositRequest\x1a\x18.bank.v1.DepositResponse\"\x00\x12A\n" +
+	"\bWithdraw\x12\x18.bank.v1.WithdrawRequest\x1a\x19.bank.v1.WithdrawResponse\"\x00\x12Y\n" +
+	"\x10CreateGuildVault\x12 .bank.v1.CreateGuildVaultRequest\x1a!.bank.v1.CreateGuildVaultResponse\"\x00\x12Y\n" +
+	"\x10ListVaultMembers\x12 .bank.v1.ListVaultMembersRequest\x1a!.bank.v1.ListVaultMembersResponse\"\x00\x12S\n" +
+	"\x0eSetVaultMember\x12\x1e.bank.v1.SetVaultMemberRequest\x1a\x1f.bank.v1.SetVaultMemberResponse\"\x00\x12h\n" +
+	"\x15ListVaultTransactions\x12%.bank.v1.ListVaultTransactionsRequest\x1a&.bank.v1.ListVaultTransactionsResponse\"\x00B+Z)github.com/VoidMesh/api/api/proto/bank/v1b\x06proto3"
+
+var (
+	file_bank_v1_bank_proto_rawDescOnce sync.Once
+	file_bank_v1_bank_proto_rawDescData []byte
+)
+
+func file_bank_v1_bank_proto_rawDescGZIP() []byte {
+	file_bank_v1_bank_proto_rawDescOnce.Do(func() {
+		file_bank_v1_bank_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_bank_v1_bank_proto_rawDesc), len(file_bank_v1_bank_proto_rawDesc)))
+	})
+	return file_bank_v1_bank_proto_rawDescData
+}
+
+var file_bank_v1_bank_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_bank_v1_bank_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_bank_v1_bank_proto_goTypes = []any{
+	(VaultKind)(0),                        // 0: bank.v1.VaultKind
+	(VaultTier)(0),                        // 1: bank.v1.VaultTier
+	(*VaultItem)(nil),                     // 2: bank.v1.VaultItem
+	(*Vault)(nil),                         // 3: bank.v1.Vault
+	(*VaultMember)(nil),                   // 4: bank.v1.VaultMember
+	(*VaultTransaction)(nil),              // 5: bank.v1.VaultTransaction
+	(*ListVaultsRequest)(nil),             // 6: bank.v1.ListVaultsRequest
+	(*ListVaultsResponse)(nil),            // 7: bank.v1.ListVaultsResponse
+	(*OpenVaultRequest)(nil),              // 8: bank.v1.OpenVaultRequest
+	(*OpenVaultResponse)(nil),             // 9: bank.v1.OpenVaultResponse
+	(*DepositRequest)(nil),                // 10: bank.v1.DepositRequest
+	(*DepositResponse)(nil),               // 11: bank.v1.DepositResponse
+	(*WithdrawRequest)(nil),               // 12: bank.v1.WithdrawRequest
+	(*WithdrawResponse)(nil),              // 13: bank.v1.WithdrawResponse
+	(*CreateGuildVaultRequest)(nil),       // 14: bank.v1.CreateGuildVaultRequest
+	(*CreateGuildVaultResponse)(nil),      // 15: bank.v1.CreateGuildVaultResponse
+	(*ListVaultMembersRequest)(nil),       // 16: bank.v1.ListVaultMembersRequest
+	(*ListVaultMembersResponse)(nil),      // 17: bank.v1.ListVaultMembersResponse
+	(*SetVaultMemberRequest)(nil),         // 18: bank.v1.SetVaultMemberRequest
+	(*SetVaultMemberResponse)(nil),        // 19: bank.v1.SetVaultMemberResponse
+	(*ListVaultTransactionsRequest)(nil),  // 20: bank.v1.ListVaultTransactionsRequest
+	(*ListVaultTransactionsResponse)(nil), // 21: bank.v1.ListVaultTransactionsResponse
+	(*timestamppb.Timestamp)(nil),         // 22: google.protobuf.Timestamp
+}
+var file_bank_v1_bank_proto_depIdxs = []int32{
+	0,  // 0: bank.v1.Vault.kind:type_name -> bank.v1.VaultKind
+	1,  // 1: bank.v1.Vault.tier:type_name -> bank.v1.VaultTier
+	2,  // 2: bank.v1.Vault.items:type_name -> bank.v1.VaultItem
+	22, // 3: bank.v1.Vault.created_at:type_name -> google.protobuf.Timestamp
+	1,  // 4: bank.v1.VaultMember.tier:type_name -> bank.v1.VaultTier
+	22, // 5: bank.v1.VaultMember.added_at:type_name -> google.protobuf.Timestamp
+	22, // 6: bank.v1.VaultTransaction.created_at:type_name -> google.protobuf.Timestamp
+	3,  // 7: bank.v1.ListVaultsResponse.vaults:type_name -> bank.v1.Vault
+	3,  // 8: bank.v1.OpenVaultResponse.vault:type_name -> bank.v1.Vault
+	3,  // 9: bank.v1.DepositResponse.vault:type_name -> bank.v1.Vault
+	5,  // 10: bank.v1.DepositResponse.transaction:type_name -> bank.v1.VaultTransaction
+	3,  // 11: bank.v1.WithdrawResponse.vault:type_name -> bank.v1.Vault
+	5,  // 12: bank.v1.WithdrawResponse.transaction:type_name -> bank.v1.VaultTransaction
+	3,  // 13: bank.v1.CreateGuildVaultResponse.vault:type_name -> bank.v1.Vault
+	4,  // 14: bank.v1.ListVaultMembersResponse.members:type_name -> bank.v1.VaultMember
+	1,  // 15: bank.v1.SetVaultMemberRequest.tier:type_name -> bank.v1.VaultTier
+	4,  // 16: bank.v1.SetVaultMemberResponse.member:type_name -> bank.v1.VaultMember
+	5,  // 17: bank.v1.ListVaultTransactionsResponse.transactions:type_name -> bank.v1.VaultTransaction
+	6,  // 18: bank.v1.BankService.ListVaults:input_type -> bank.v1.ListVaultsRequest
+	8,  // 19: bank.v1.BankService.OpenVault:input_type -> bank.v1.OpenVaultRequest
+	10, // 20: bank.v1.BankService.Deposit:input_type -> bank.v1.DepositRequest
+	12, // 21: bank.v1.BankService.Withdraw:input_type -> bank.v1.WithdrawRequest
+	14, // 22: bank.v1.BankService.CreateGuildVault:input_type -> bank.v1.CreateGuildVaultRequest
+	16, // 23: bank.v1.BankService.ListVaultMembers:input_type -> bank.v1.ListVaultMembersRequest
+	18, // 24: bank.v1.BankService.SetVaultMember:input_type -> bank.v1.SetVaultMemberRequest
+	20, // 25: bank.v1.BankService.ListVaultTransactions:input_type -> bank.v1.ListVaultTransactionsRequest
+	7,  // 26: bank.v1.BankService.ListVaults:output_type -> bank.v1.ListVaultsResponse
+	9,  // 27: bank.v1.BankService.OpenVault:output_type -> bank.v1.OpenVaultResponse
+	11, // 28: bank.v1.BankService.Deposit:output_type -> bank.v1.DepositResponse
+	13, // 29: bank.v1.BankService.Withdraw:output_type -> bank.v1.WithdrawResponse
+	15, // 30: bank.v1.BankService.CreateGuildVault:output_type -> bank.v1.CreateGuildVaultResponse
+	17, // 31: bank.v1.BankService.ListVaultMembers:output_type -> bank.v1.ListVaultMembersResponse
+	19, // 32: bank.v1.BankService.SetVaultMember:output_type -> bank.v1.SetVaultMemberResponse
+	21, // 33: bank.v1.BankService.ListVaultTransactions:output_type -> bank.v1.ListVaultTransactionsResponse
+	26, // [26:34] is the sub-list for method output_type
+	18, // [18:26] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
+}
+
+func init() { file_bank_v1_bank_proto_init() }
+func file_bank_v1_bank_proto_init() {
+	if File_bank_v1_bank_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_bank_v1_bank_proto_rawDesc), len(file_bank_v1_bank_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   20,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_bank_v1_bank_proto_goTypes,
+		DependencyIndexes: file_bank_v1_bank_proto_depIdxs,
+		EnumInfos:         file_bank_v1_bank_proto_enumTypes,
+		MessageInfos:      file_bank_v1_bank_proto_msgTypes,
+	}.Build()
+	File_bank_v1_bank_proto = out.File
+	file_bank_v1_bank_proto_goTypes = nil
+	file_bank_v1_bank_proto_depIdxs = nil
+}
@@ -0,0 +1,417 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.4
+// source: bank/v1/bank.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	BankService_ListVaults_FullMethodName            = "/bank.v1.BankService/ListVaults"
+	BankService_OpenVault_FullMethodName             = "/bank.v1.BankService/OpenVault"
+	BankService_Deposit_FullMethodName               = "/bank.v1.BankService/Deposit"
+	BankService_Withdraw_FullMethodName              = "/bank.v1.BankService/Withdraw"
+	BankService_CreateGuildVault_FullMethodName      = "/bank.v1.BankService/CreateGuildVault"
+	BankService_ListVaultMembers_FullMethodName      = "/bank.v1.BankService/ListVaultMembers"
+	BankService_SetVaultMember_FullMethodName        = "/bank.v1.BankService/SetVaultMember"
+	BankService_ListVaultTransactions_FullMethodName = "/bank.v1.BankService/ListVaultTransactions"
+)
+
+// BankServiceClient is the client API for BankService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Banks store items apart from inventories. Every character has a personal vault, and
+// characters may found guild vaults shared with other characters of the world at a
+// permission tier. Vault contents are opened and moved only within reach of a bank; every
+// deposit and withdrawal is logged.
+type BankServiceClient interface {
+	// Lists the character's personal vault and the guild vaults it is a member of, without
+	// their items
+	ListVaults(ctx context.Context, in *ListVaultsRequest, opts ...grpc.CallOption) (*ListVaultsResponse, error)
+	// Returns a vault with its items; vault_id 0 opens the personal vault
+	OpenVault(ctx context.Context, in *OpenVaultRequest, opts ...grpc.CallOption) (*OpenVaultResponse, error)
+	// Moves items from the inventory into a vault
+	Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositResponse, error)
+	// Moves items from a vault into the inventory
+	Withdraw(ctx context.Context, in *WithdrawRequest, opts ...grpc.CallOption) (*WithdrawResponse, error)
+	// Founds a guild vault with the character as its manager
+	CreateGuildVault(ctx context.Context, in *CreateGuildVaultRequest, opts ...grpc.CallOption) (*CreateGuildVaultResponse, error)
+	// Lists the members of a guild vault
+	ListVaultMembers(ctx context.Context, in *ListVaultMembersRequest, opts ...grpc.CallOption) (*ListVaultMembersResponse, error)
+	// Adds a member to a guild vault, changes its tier or, with VAULT_TIER_UNSPECIFIED,
+	// removes it (managers only)
+	SetVaultMember(ctx context.Context, in *SetVaultMemberRequest, opts ...grpc.CallOption) (*SetVaultMemberResponse, error)
+	// Pages through a vault's deposits and withdrawals, newest first
+	ListVaultTransactions(ctx context.Context, in *ListVaultTransactionsRequest, opts ...grpc.CallOption) (*ListVaultTransactionsResponse, error)
+}
+
+type bankServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBankServiceClient(cc grpc.ClientConnInterface) BankServiceClient {
+	return &bankServiceClient{cc}
+}
+
+func (c *bankServiceClient) ListVaults(ctx context.Context, in *ListVaultsRequest, opts ...grpc.CallOption) (*ListVaultsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVaultsResponse)
+	err := c.cc.Invoke(ctx, BankService_ListVaults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) OpenVault(ctx context.Context, in *OpenVaultRequest, opts ...grpc.CallOption) (*OpenVaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OpenVaultResponse)
+	err := c.cc.Invoke(ctx, BankService_OpenVault_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DepositResponse)
+	err := c.cc.Invoke(ctx, BankService_Deposit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) Withdraw(ctx context.Context, in *WithdrawRequest, opts ...grpc.CallOption) (*WithdrawResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WithdrawResponse)
+	err := c.cc.Invoke(ctx, BankService_Withdraw_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) CreateGuildVault(ctx context.Context, in *CreateGuildVaultRequest, opts ...grpc.CallOption) (*CreateGuildVaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateGuildVaultResponse)
+	err := c.cc.Invoke(ctx, BankService_CreateGuildVault_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) ListVaultMembers(ctx context.Context, in *ListVaultMembersRequest, opts ...grpc.CallOption) (*ListVaultMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVaultMembersResponse)
+	err := c.cc.Invoke(ctx, BankService_ListVaultMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) SetVaultMember(ctx context.Context, in *SetVaultMemberRequest, opts ...grpc.CallOption) (*SetVaultMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetVaultMemberResponse)
+	err := c.cc.Invoke(ctx, BankService_SetVaultMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) ListVaultTransactions(ctx context.Context, in *ListVaultTransactionsRequest, opts ...grpc.CallOption) (*ListVaultTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVaultTransactionsResponse)
+	err := c.cc.Invoke(ctx, BankService_ListVaultTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BankServiceServer is the server API for BankService service.
+// All implementations must embed UnimplementedBankServiceServer
+// for forward compatibility.
+//
+// Banks store items apart from inventories. Every character has a personal vault, and
+// characters may found guild vaults shared with other characters of the world at a
+// permission tier. Vault contents are opened and moved only within reach of a bank; every
+// deposit and withdrawal is logged.
+type BankServiceServer interface {
+	// Lists the character's personal vault and the guild vaults it is a member of, without
+	// their items
+	ListVaults(context.Context, *ListVaultsRequest) (*ListVaultsResponse, error)
+	// Returns a vault with its items; vault_id 0 opens the personal vault
+	OpenVault(context.Context, *OpenVaultRequest) (*OpenVaultResponse, error)
+	// Moves items from the inventory into a vault
+	Deposit(context.Context, *DepositRequest) (*DepositResponse, error)
+	// Moves items from a vault into the inventory
+	Withdraw(context.Context, *WithdrawRequest) (*WithdrawResponse, error)
+	// Founds a guild vault with the character as its manager
+	CreateGuildVault(context.Context, *CreateGuildVaultRequest) (*CreateGuildVaultResponse, error)
+	// Lists the members of a guild vault
+	ListVaultMembers(context.Context, *ListVaultMembersRequest) (*ListVaultMembersResponse, error)
+	// Adds a member to a guild vault, changes its tier or, with VAULT_TIER_UNSPECIFIED,
+	// removes it (managers only)
+	SetVaultMember(context.Context, *SetVaultMemberRequest) (*SetVaultMemberResponse, error)
+	// Pages through a vault's deposits and withdrawals, newest first
+	ListVaultTransactions(context.Context, *ListVaultTransactionsRequest) (*ListVaultTransactionsResponse, error)
+	mustEmbedUnimplementedBankServiceServer()
+}
+
+// UnimplementedBankServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBankServiceServer struct{}
+
+func (UnimplementedBankServiceServer) ListVaults(context.Context, *ListVaultsRequest) (*ListVaultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVaults not implemented")
+}
+func (UnimplementedBankServiceServer) OpenVault(context.Context, *OpenVaultRequest) (*OpenVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenVault not implemented")
+}
+func (UnimplementedBankServiceServer) Deposit(context.Context, *DepositRequest) (*DepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+}
+func (UnimplementedBankServiceServer) Withdraw(context.Context, *WithdrawRequest) (*WithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
+}
+func (UnimplementedBankServiceServer) CreateGuildVault(context.Context, *CreateGuildVaultRequest) (*CreateGuildVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGuildVault not implemented")
+}
+func (UnimplementedBankServiceServer) ListVaultMembers(context.Context, *ListVaultMembersRequest) (*ListVaultMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVaultMembers not implemented")
+}
+func (UnimplementedBankServiceServer) SetVaultMember(context.Context, *SetVaultMemberRequest) (*SetVaultMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVaultMember not implemented")
+}
+func (UnimplementedBankServiceServer) ListVaultTransactions(context.Context, *ListVaultTransactionsRequest) (*ListVaultTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVaultTransactions not implemented")
+}
+func (UnimplementedBankServiceServer) mustEmbedUnimplementedBankServiceServer() {}
+func (UnimplementedBankServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeBankServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BankServiceServer will
+// result in compilation errors.
+type UnsafeBankServiceServer interface {
+	mustEmbedUnimplementedBankServiceServer()
+}
+
+func RegisterBankServiceServer(s grpc.ServiceRegistrar, srv BankServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBankServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BankService_ServiceDesc, srv)
+}
+
+func _BankService_ListVaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVaultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).ListVaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_ListVaults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).ListVaults(ctx, req.(*ListVaultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_OpenVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenVaultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).OpenVault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_OpenVault_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).OpenVault(ctx, req.(*OpenVaultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).Deposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_Deposit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).Deposit(ctx, req.(*DepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).Withdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_Withdraw_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).Withdraw(ctx, req.(*WithdrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_CreateGuildVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGuildVaultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).CreateGuildVault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_CreateGuildVault_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).CreateGuildVault(ctx, req.(*CreateGuildVaultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_ListVaultMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVaultMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).ListVaultMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_ListVaultMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).ListVaultMembers(ctx, req.(*ListVaultMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_SetVaultMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetVaultMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).SetVaultMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_SetVaultMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).SetVaultMember(ctx, req.(*SetVaultMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_ListVaultTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVaultTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).ListVaultTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_ListVaultTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).ListVaultTransactions(ctx, req.(*ListVaultTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BankService_ServiceDesc is the grpc.ServiceDesc for BankService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BankService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bank.v1.BankService",
+	HandlerType: (*BankServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListVaults",
+			Handler:    _BankService_ListVaults_Handler,
+		},
+		{
+			MethodName: "OpenVault",
+			Handler:    _BankService_OpenVault_Handler,
+		},
+		{
+			MethodName: "Deposit",
+			Handler:    _BankService_Deposit_Handler,
+		},
+		{
+			MethodName: "Withdraw",
+			Handler:    _BankService_Withdraw_Handler,
+		},
+		{
+			MethodName: "CreateGuildVault",
+			Handler:    _BankService_CreateGuildVault_Handler,
+		},
+		{
+			MethodName: "ListVaultMembers",
+			Handler:    _BankService_ListVaultMembers_Handler,
+		},
+		{
+			MethodName: "SetVaultMember",
+			Handler:    _BankService_SetVaultMember_Handler,
+		},
+		{
+			MethodName: "ListVaultTransactions",
+			Handler:    _BankService_ListVaultTransactions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bank/v1/bank.proto",
+}
@@ -7,6 +7,10 @@
 package v1
 
 import (
+	v13 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	v11 "github.com/VoidMesh/api/api/proto/dungeon/v1"
+	v1 "github.com/VoidMesh/api/api/proto/interior/v1"
+	v12 "github.com/VoidMesh/api/api/proto/stream/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -22,6 +26,354 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Which way a character looks. North is towards decreasing y.
+type Facing int32
+
+const (
+	Facing_FACING_UNSPECIFIED Facing = 0
+	Facing_FACING_NORTH       Facing = 1
+	Facing_FACING_EAST        Facing = 2
+	Facing_FACING_SOUTH       Facing = 3
+	Facing_FACING_WEST        Facing = 4
+)
+
+// Enum value maps for Facing.
+var (
+	Facing_name = map[int32]string{
+		0: "FACING_UNSPECIFIED",
+		1: "FACING_NORTH",
+		2: "FACING_EAST",
+		3: "FACING_SOUTH",
+		4: "FACING_WEST",
+	}
+	Facing_value = map[string]int32{
+		"FACING_UNSPECIFIED": 0,
+		"FACING_NORTH":       1,
+		"FACING_EAST":        2,
+		"FACING_SOUTH":       3,
+		"FACING_WEST":        4,
+	}
+)
+
+func (x Facing) Enum() *Facing {
+	p := new(Facing)
+	*p = x
+	return p
+}
+
+func (x Facing) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Facing) Descriptor() protoreflect.EnumDescriptor {
+	return file_character_v1_character_proto_enumTypes[0].Descriptor()
+}
+
+func (Facing) Type() protoreflect.EnumType {
+	return &file_character_v1_character_proto_enumTypes[0]
+}
+
+func (x Facing) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Facing.Descriptor instead.
+func (Facing) EnumDescriptor() ([]byte, []int) {
+	return file_character_v1_character_proto_rawDescGZIP(), []int{0}
+}
+
+// What a character is doing, so other clients can pick its animation
+type ActionState int32
+
+const (
+	ActionState_ACTION_STATE_UNSPECIFIED ActionState = 0
+	ActionState_ACTION_STATE_IDLE        ActionState = 1
+	ActionState_ACTION_STATE_WALKING     ActionState = 2
+	ActionState_ACTION_STATE_HARVESTING  ActionState = 3
+)
+
+// Enum value maps for ActionState.
+var (
+	ActionState_name = map[int32]string{
+		0: "ACTION_STATE_UNSPECIFIED",
+		1: "ACTION_STATE_IDLE",
+		2: "ACTION_STATE_WALKING",
+		3: "ACTION_STATE_HARVESTING",
+	}
+	ActionState_value = map[string]int32{
+		"ACTION_STATE_UNSPECIFIED": 0,
+		"ACTION_STATE_IDLE":        1,
+		"ACTION_STATE_WALKING":     2,
+		"ACTION_STATE_HARVESTING":  3,
+	}
+)
+
+func (x ActionState) Enum() *ActionState {
+	p := new(ActionState)
+	*p = x
+	return p
+}
+
+func (x ActionState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ActionState) Descriptor() protoreflect.EnumDescriptor {
+	return file_character_v1_character_proto_enumTypes[1].Descriptor()
+}
+
+func (ActionState) Type() protoreflect.EnumType {
+	return &file_character_v1_character_proto_enumTypes[1]
+}
+
+func (x ActionState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ActionState.Descriptor instead.
+func (ActionState) EnumDescriptor() ([]byte, []int) {
+	return file_character_v1_character_proto_rawDescGZIP(), []int{1}
+}
+
+// What a status effect changes
+type StatusEffectType int32
+
+const (
+	StatusEffectType_STATUS_EFFECT_TYPE_UNSPECIFIED    StatusEffectType = 0
+	StatusEffectType_STATUS_EFFECT_TYPE_HARVEST_YIELD  StatusEffectType = 1 // Scales the quantities a harvest gathers
+	StatusEffectType_STATUS_EFFECT_TYPE_MOVEMENT_SPEED StatusEffectType = 2 // Scales how often the character may move
+)
+
+// Enum value maps for StatusEffectType.
+var (
+	StatusEffectType_name = map[int32]string{
+		0: "STATUS_EFFECT_TYPE_UNSPECIFIED",
+		1: "STATUS_EFFECT_TYPE_HARVEST_YIELD",
+		2: "STATUS_EFFECT_TYPE_MOVEMENT_SPEED",
+	}
+	StatusEffectType_value = map[string]int32{
+		"STATUS_EFFECT_TYPE_UNSPECIFIED":    0,
+		"STATUS_EFFECT_TYPE_HARVEST_YIELD":  1,
+		"STATUS_EFFECT_TYPE_MOVEMENT_SPEED": 2,
+	}
+)
+
+func (x StatusEffectType) Enum() *StatusEffectType {
+	p := new(StatusEffectType)
+	*p = x
+	return p
+}
+
+func (x StatusEffectType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatusEffectType) Descriptor() protoreflect.EnumDescriptor {
+	return file_character_v1_character_proto_enumTypes[2].Descriptor()
+}
+
+func (StatusEffectType) Type() protoreflect.EnumType {
+	return &file_character_v1_character_proto_enumTypes[2]
+}
+
+func (x StatusEffectType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatusEffectType.Descriptor instead.
+func (StatusEffectType) EnumDescriptor() ([]byte, []int) {
+	return file_character_v1_character_proto_rawDescGZIP(), []int{2}
+}
+
+type ActivityType int32
+
+const (
+	ActivityType_ACTIVITY_TYPE_UNSPECIFIED ActivityType = 0
+	ActivityType_ACTIVITY_TYPE_HARVEST     ActivityType = 1
+	ActivityType_ACTIVITY_TYPE_CRAFT       ActivityType = 2
+	ActivityType_ACTIVITY_TYPE_TRADE       ActivityType = 3
+	ActivityType_ACTIVITY_TYPE_DEATH       ActivityType = 4
+)
+
+// Enum value maps for ActivityType.
+var (
+	ActivityType_name = map[int32]string{
+		0: "ACTIVITY_TYPE_UNSPECIFIED",
+		1: "ACTIVITY_TYPE_HARVEST",
+		2: "ACTIVITY_TYPE_CRAFT",
+		3: "ACTIVITY_TYPE_TRADE",
+		4: "ACTIVITY_TYPE_DEATH",
+	}
+	ActivityType_value = map[string]int32{
+		"ACTIVITY_TYPE_UNSPECIFIED": 0,
+		"ACTIVITY_TYPE_HARVEST":     1,
+		"ACTIVITY_TYPE_CRAFT":       2,
+		"ACTIVITY_TYPE_TRADE":       3,
+		"ACTIVITY_TYPE_DEATH":       4,
+	}
+)
+
+func (x ActivityType) Enum() *ActivityType {
+	p := new(ActivityType)
+	*p = x
+	return p
+}
+
+func (x ActivityType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ActivityType) Descriptor() protoreflect.EnumDescriptor {
+	return file_character_v1_character_proto_enumTypes[3].Descriptor()
+}
+
+func (ActivityType) Type() protoreflect.EnumType {
+	return &file_character_v1_character_proto_enumTypes[3]
+}
+
+func (x ActivityType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ActivityType.Descriptor instead.
+func (ActivityType) EnumDescriptor() ([]byte, []int) {
+	return file_character_v1_character_proto_rawDescGZIP(), []int{3}
+}
+
+type CosmeticKind int32
+
+const (
+	CosmeticKind_COSMETIC_KIND_UNSPECIFIED CosmeticKind = 0
+	CosmeticKind_COSMETIC_KIND_TITLE       CosmeticKind = 1 // Can be equipped and is shown with the character's name
+	CosmeticKind_COSMETIC_KIND_BADGE       CosmeticKind = 2 // Collected, not equipped
+)
+
+// Enum value maps for CosmeticKind.
+var (
+	CosmeticKind_name = map[int32]string{
+		0: "COSMETIC_KIND_UNSPECIFIED",
+		1: "COSMETIC_KIND_TITLE",
+		2: "COSMETIC_KIND_BADGE",
+	}
+	CosmeticKind_value = map[string]int32{
+		"COSMETIC_KIND_UNSPECIFIED": 0,
+		"COSMETIC_KIND_TITLE":       1,
+		"COSMETIC_KIND_BADGE":       2,
+	}
+)
+
+func (x CosmeticKind) Enum() *CosmeticKind {
+	p := new(CosmeticKind)
+	*p = x
+	return p
+}
+
+func (x CosmeticKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CosmeticKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_character_v1_character_proto_enumTypes[4].Descriptor()
+}
+
+func (CosmeticKind) Type() protoreflect.EnumType {
+	return &file_character_v1_character_proto_enumTypes[4]
+}
+
+func (x CosmeticKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CosmeticKind.Descriptor instead.
+func (CosmeticKind) EnumDescriptor() ([]byte, []int) {
+	return file_character_v1_character_proto_rawDescGZIP(), []int{4}
+}
+
+// A time-limited buff or debuff on a character
+type StatusEffect struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"` // Definition name, e.g. "swiftness"
+	Type          StatusEffectType       `protobuf:"varint,2,opt,name=type,proto3,enum=character.v1.StatusEffectType" json:"type,omitempty"`
+	Magnitude     float64                `protobuf:"fixed64,3,opt,name=magnitude,proto3" json:"magnitude,omitempty"` // Total change over all stacks, e.g. 0.25 for 25% faster; negative slows
+	Stacks        int32                  `protobuf:"varint,4,opt,name=stacks,proto3" json:"stacks,omitempty"`
+	Source        string                 `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"` // What applied it, e.g. "item:12" or "event:harvest_festival"
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusEffect) Reset() {
+	*x = StatusEffect{}
+	mi := &file_character_v1_character_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusEffect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusEffect) ProtoMessage() {}
+
+func (x *StatusEffect) ProtoReflect() protoreflect.Message {
+	mi := &file_character_v1_character_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusEffect.ProtoReflect.Descriptor instead.
+func (*StatusEffect) Descriptor() ([]byte, []int) {
+	return file_character_v1_character_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StatusEffect) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *StatusEffect) GetType() StatusEffectType {
+	if x != nil {
+		return x.Type
+	}
+	return StatusEffectType_STATUS_EFFECT_TYPE_UNSPECIFIED
+}
+
+func (x *StatusEffect) GetMagnitude() float64 {
+	if x != nil {
+		return x.Magnitude
+	}
+	return 0
+}
+
+func (x *StatusEffect) GetStacks() int32 {
+	if x != nil {
+		return x.Stacks
+	}
+	return 0
+}
+
+func (x *StatusEffect) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *StatusEffect) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
 type Character struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -32,13 +384,18 @@ type Character struct {
 	ChunkX        int32                  `protobuf:"varint,6,opt,name=chunk_x,json=chunkX,proto3" json:"chunk_x,omitempty"`
 	ChunkY        int32                  `protobuf:"varint,7,opt,name=chunk_y,json=chunkY,proto3" json:"chunk_y,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	WorldId       string                 `protobuf:"bytes,9,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"` // World the character lives in; characters never leave it
+	Facing        Facing                 `protobuf:"varint,10,opt,name=facing,proto3,enum=character.v1.Facing" json:"facing,omitempty"`
+	ActionState   ActionState            `protobuf:"varint,11,opt,name=action_state,json=actionState,proto3,enum=character.v1.ActionState" json:"action_state,omitempty"` // Last known state, also after reconnecting
+	StatusEffects []*StatusEffect        `protobuf:"bytes,12,rep,name=status_effects,json=statusEffects,proto3" json:"status_effects,omitempty"`                          // Active effects; only set by GetCharacter and GetMyCharacters
+	Title         string                 `protobuf:"bytes,13,opt,name=title,proto3" json:"title,omitempty"`                                                               // Display name of the equipped title; empty when none
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Character) Reset() {
 	*x = Character{}
-	mi := &file_character_v1_character_proto_msgTypes[0]
+	mi := &file_character_v1_character_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -50,7 +407,7 @@ func (x *Character) String() string {
 func (*Character) ProtoMessage() {}
 
 func (x *Character) ProtoReflect() protoreflect.Message {
-	mi := &file_character_v1_character_proto_msgTypes[0]
+	mi := &file_character_v1_character_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -63,7 +420,7 @@ func (x *Character) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Character.ProtoReflect.Descriptor instead.
 func (*Character) Descriptor() ([]byte, []int) {
-	return file_character_v1_character_proto_rawDescGZIP(), []int{0}
+	return file_character_v1_character_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *Character) GetId() string {
@@ -122,6 +479,41 @@ func (x *Character) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Character) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *Character) GetFacing() Facing {
+	if x != nil {
+		return x.Facing
+	}
+	return Facing_FACING_UNSPECIFIED
+}
+
+func (x *Character) GetActionState() ActionState {
+	if x != nil {
+		return x.ActionState
+	}
+	return ActionState_ACTION_STATE_UNSPECIFIED
+}
+
+func (x *Character) GetStatusEffects() []*StatusEffect {
+	if x != nil {
+		return x.StatusEffects
+	}
+	return nil
+}
+
+func (x *Character) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
 type Position struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	X             int32                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
@@ -134,7 +526,7 @@ type Position struct {
 
 func (x *Position) Reset() {
 	*x = Position{}
-	mi := &file_character_v1_character_proto_msgTypes[1]
+	mi := &file_character_v1_character_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -146,7 +538,7 @@ func (x *Position) String() string {
 func (*Position) ProtoMessage() {}
 
 func (x *Position) ProtoReflect() protoreflect.Message {
-	mi := &file_character_v1_character_proto_msgTypes[1]
+	mi := &file_character_v1_character_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -159,7 +551,7 @@ func (x *Position) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Position.ProtoReflect.Descriptor instead.
 func (*Position) Descriptor() ([]byte, []int) {
-	return file_character_v1_character_proto_rawDescGZIP(), []int{1}
+	return file_character_v1_character_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Position) GetX() int32 {
@@ -202,7 +594,7 @@ type CreateCharacterRequest struct {
 
 func (x *CreateCharacterRequest) Reset() {
 	*x = CreateCharacterRequest{}
-	mi := &file_character_v1_character_proto_msgTypes[2]
+	mi := &file_character_v1_character_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -214,7 +606,7 @@ func (x *CreateCharacterRequest) String() string {
 func (*CreateCharacterRequest) ProtoMessage() {}
 
 func (x *CreateCharacterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_character_v1_character_proto_msgTypes[2]
+	mi := &file_character_v1_character_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -227,7 +619,7 @@ func (x *CreateCharacterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCharacterRequest.ProtoReflect.Descriptor instead.
 func (*CreateCharacterRequest) Descriptor() ([]byte, []int) {
-	return file_character_v1_character_proto_rawDescGZIP(), []int{2}
+	return file_character_v1_character_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateCharacterRequest) GetName() string {
@@ -260,7 +652,7 @@ type CreateCharacterResponse struct {
 
 func (x *CreateCharacterResponse) Reset() {
 	*x = CreateCharacterResponse{}
-	mi := &file_character_v1_character_proto_msgTypes[3]
+	mi := &file_character_v1_character_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -272,7 +664,7 @@ func (x *CreateCharacterResponse) String() string {
 func (*CreateCharacterResponse) ProtoMessage() {}
 
 func (x *CreateCharacterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_character_v1_character_proto_msgTypes[3]
+	mi := &file_character_v1_character_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -285,7 +677,7 @@ func (x *CreateCharacterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCharacterResponse.ProtoReflect.Descriptor instead.
 func (*CreateCharacterResponse) Descriptor() ([]byte, []int) {
-	return file_character_v1_character_proto_rawDescGZIP(), []int{3}
+	return file_character_v1_character_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreateCharacterResponse) GetCharacter() *Character {
@@ -305,7 +697,7 @@ type GetCharacterRequest struct {
 
 func (x *GetCharacterRequest) Reset() {
 	*x = GetCharacterRequest{}
-	mi := &file_character_v1_character_proto_msgTypes[4]
+	mi := &file_character_v1_character_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -317,7 +709,7 @@ func (x *GetCharacterRequest) String() string {
 func (*GetCharacterRequest) ProtoMessage() {}
 
 func (x *GetCharacterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_character_v1_character_proto_msgTypes[4]
+	mi := &file_character_v1_character_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -330,7 +722,7 @@ func (x *GetCharacterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCharacterRequest.ProtoReflect.Descriptor instead.
 func (*GetCharacterRequest) Descriptor() ([]byte, []int) {
-	return file_character_v1_character_proto_rawDescGZIP(), []int{4}
+	return file_character_v1_character_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetCharacterRequest) GetCharacterId() string {
@@ -349,7 +741,7 @@ type GetCharacterResponse struct {
 
 func (x *GetCharacterResponse) Reset() {
 	*x = GetCharacterResponse{}
-	mi := &file_character_v1_character_proto_msgTypes[5]
+	mi := &file_character_v1_character_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -361,7 +753,7 @@ func (x *GetCharacterResponse) String() string {
 func (*GetCharacterResponse) ProtoMessage() {}
 
 func (x *GetCharacterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_character_v1_character_proto_msgTypes[5]
+	mi := &file_character_v1_character_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -374,7 +766,7 @@ func (x *GetCharacterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCharacterResponse.ProtoReflect.Descriptor instead.
 func (*GetCharacterResponse) Descriptor() ([]byte, []int) {
-	return file_character_v1_character_proto_rawDescGZIP(), []int{5}
+	return file_character_v1_character_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetCharacterResponse) GetCharacter() *Character {
@@ -393,7 +785,7 @@ type GetMyCharactersRequest struct {
 
 func (x *GetMyCharactersRequest) Reset() {
 	*x = GetMyCharactersRequest{}
-	mi := &file_character_v1_character_proto_msgTypes[6]
+	mi := &file_character_v1_character_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -405,7 +797,7 @@ func (x *GetMyCharactersRequest) String() string {
 func (*GetMyCharactersRequest) ProtoMessage() {}
 
 func (x *GetMyCharactersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_character_v1_character_proto_msgTypes[6]
+	mi := &file_character_v1_character_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -418,7 +810,7 @@ func (x *GetMyCharactersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMyCharactersRequest.ProtoReflect.Descriptor instead.
 func (*GetMyCharactersRequest) Descriptor() ([]byte, []int) {
-	return file_character_v1_character_proto_rawDescGZIP(), []int{6}
+	return file_character_v1_character_proto_rawDescGZIP(), []int{7}
 }
 
 type GetMyCharactersResponse struct {
@@ -430,7 +822,7 @@ type GetMyCharactersResponse struct {
 
 func (x *GetMyCharactersResponse) Reset() {
 	*x = GetMyCharactersResponse{}
-	mi := &file_character_v1_character_proto_msgTypes[7]
+	mi := &file_character_v1_character_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -442,7 +834,7 @@ func (x *GetMyCharactersResponse) String() string {
 func (*GetMyCharactersResponse) ProtoMessage() {}
 
 func (x *GetMyCharactersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_character_v1_character_proto_msgTypes[7]
+	mi := &file_character_v1_character_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -455,7 +847,7 @@ func (x *GetMyCharactersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMyCharactersResponse.ProtoReflect.Descriptor instead.
 func (*GetMyCharactersResponse) Descriptor() ([]byte, []int) {
-	return file_character_v1_character_proto_rawDescGZIP(), []int{7}
+	return file_character_v1_character_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetMyCharactersResponse) GetCharacters() []*Character {
@@ -475,7 +867,7 @@ type DeleteCharacterRequest struct {
 
 func (x *DeleteCharacterRequest) Reset() {
 	*x = DeleteCharacterRequest{}
-	mi := &file_character_v1_character_proto_msgTypes[8]
+	mi := &file_character_v1_character_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -487,7 +879,7 @@ func (x *DeleteCharacterRequest) String() string {
 func (*DeleteCharacterRequest) ProtoMessage() {}
 
 func (x *DeleteCharacterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_character_v1_character_proto_msgTypes[8]
+	mi := &file_character_v1_character_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -500,7 +892,7 @@ func (x *DeleteCharacterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCharacterRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCharacterRequest) Descriptor() ([]byte, []int) {
-	return file_character_v1_character_proto_rawDescGZIP(), []int{8}
+	return file_character_v1_character_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DeleteCharacterRequest) GetCharacterId() string {
@@ -519,7 +911,7 @@ type DeleteCharacterResponse struct {
 
 func (x *DeleteCharacterResponse) Reset() {
 	*x = DeleteCharacterResponse{}
-	mi := &file_character_v1_character_proto_msgTypes[9]
+	mi := &file_character_v1_character_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -531,7 +923,7 @@ func (x *DeleteCharacterResponse) String() string {
 func (*DeleteCharacterResponse) ProtoMessage() {}
 
 func (x *DeleteCharacterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_character_v1_character_proto_msgTypes[9]
+	mi := &file_character_v1_character_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -544,7 +936,7 @@ func (x *DeleteCharacterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCharacterResponse.ProtoReflect.Descriptor instead.
 func (*DeleteCharacterResponse) Descriptor() ([]byte, []int) {
-	return file_character_v1_character_proto_rawDescGZIP(), []int{9}
+	return file_character_v1_character_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DeleteCharacterResponse) GetSuccess() bool {
@@ -554,19 +946,22 @@ func (x *DeleteCharacterResponse) GetSuccess() bool {
 	return false
 }
 
-// Move character
+// Move character. Moving to the current cell only changes facing and action state, e.g.
+// to turn on the spot or stop walking.
 type MoveCharacterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
-	NewX          int32                  `protobuf:"varint,2,opt,name=new_x,json=newX,proto3" json:"new_x,omitempty"`
+	NewX          int32                  `protobuf:"varint,2,opt,name=new_x,json=newX,proto3" json:"new_x,omitempty"` // World cell, or instance cell while inside a structure or dungeon
 	NewY          int32                  `protobuf:"varint,3,opt,name=new_y,json=newY,proto3" json:"new_y,omitempty"`
+	Facing        Facing                 `protobuf:"varint,4,opt,name=facing,proto3,enum=character.v1.Facing" json:"facing,omitempty"`                                   // Unspecified faces the direction of the step, or keeps the facing when staying put
+	ActionState   ActionState            `protobuf:"varint,5,opt,name=action_state,json=actionState,proto3,enum=character.v1.ActionState" json:"action_state,omitempty"` // Unspecified is walking for a step and idle when staying put
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MoveCharacterRequest) Reset() {
 	*x = MoveCharacterRequest{}
-	mi := &file_character_v1_character_proto_msgTypes[10]
+	mi := &file_character_v1_character_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -578,7 +973,7 @@ func (x *MoveCharacterRequest) String() string {
 func (*MoveCharacterRequest) ProtoMessage() {}
 
 func (x *MoveCharacterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_character_v1_character_proto_msgTypes[10]
+	mi := &file_character_v1_character_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -591,7 +986,7 @@ func (x *MoveCharacterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MoveCharacterRequest.ProtoReflect.Descriptor instead.
 func (*MoveCharacterRequest) Descriptor() ([]byte, []int) {
-	return file_character_v1_character_proto_rawDescGZIP(), []int{10}
+	return file_character_v1_character_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *MoveCharacterRequest) GetCharacterId() string {
@@ -615,30 +1010,1710 @@ func (x *MoveCharacterRequest) GetNewY() int32 {
 	return 0
 }
 
+func (x *MoveCharacterRequest) GetFacing() Facing {
+	if x != nil {
+		return x.Facing
+	}
+	return Facing_FACING_UNSPECIFIED
+}
+
+func (x *MoveCharacterRequest) GetActionState() ActionState {
+	if x != nil {
+		return x.ActionState
+	}
+	return ActionState_ACTION_STATE_UNSPECIFIED
+}
+
 type MoveCharacterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Character     *Character             `protobuf:"bytes,1,opt,name=character,proto3" json:"character,omitempty"`
-	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
-	ErrorMessage  string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"` // If movement failed
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"` // If movement failed
+	Interior      *v1.InteriorPosition   `protobuf:"bytes,4,opt,name=interior,proto3" json:"interior,omitempty"`                             // Set while the character is inside a structure; moves are on the interior's grid
+	Dungeon       *v11.DungeonPosition   `protobuf:"bytes,5,opt,name=dungeon,proto3" json:"dungeon,omitempty"`                               // Set while the character is inside a dungeon; moves are on the instance's grid
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveCharacterResponse) Reset() {
+	*x = MoveCharacterResponse{}
+	mi := &file_character_v1_character_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveCharacterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveCharacterResponse) ProtoMessage() {}
+
+func (x *MoveCharacterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_character_v1_character_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveCharacterResponse.ProtoReflect.Descriptor instead.
+func (*MoveCharacterResponse) Descriptor() ([]byte, []int) {
+	return file_character_v1_character_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MoveCharacterResponse) GetCharacter() *Character {
+	if x != nil {
+		return x.Character
+	}
+	return nil
+}
+
+func (x *MoveCharacterResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *MoveCharacterResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *MoveCharacterResponse) GetInterior() *v1.InteriorPosition {
+	if x != nil {
+		return x.Interior
+	}
+	return nil
+}
+
+func (x *MoveCharacterResponse) GetDungeon() *v11.DungeonPosition {
+	if x != nil {
+		return x.Dungeon
+	}
+	return nil
+}
+
+// Stream events around a character; the area of interest follows the character as it moves
+type StreamNearbyEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	Radius        int32                  `protobuf:"varint,2,opt,name=radius,proto3" json:"radius,omitempty"` // In cells; 0 uses the default, larger values are capped
+	Resume        *v12.StreamResume      `protobuf:"bytes,3,opt,name=resume,proto3" json:"resume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamNearbyEventsRequest) Reset() {
+	*x = StreamNearbyEventsRequest{}
+	mi := &file_character_v1_character_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamNearbyEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamNearbyEventsRequest) ProtoMessage() {}
+
+func (x *StreamNearbyEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_character_v1_character_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamNearbyEventsRequest.ProtoReflect.Descriptor instead.
+func (*StreamNearbyEventsRequest) Descriptor() ([]byte, []int) {
+	return file_character_v1_character_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *StreamNearbyEventsRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *StreamNearbyEventsRequest) GetRadius() int32 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+func (x *StreamNearbyEventsRequest) GetResume() *v12.StreamResume {
+	if x != nil {
+		return x.Resume
+	}
+	return nil
+}
+
+type ChunkGenerated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChunkX        int32                  `protobuf:"varint,1,opt,name=chunk_x,json=chunkX,proto3" json:"chunk_x,omitempty"`
+	ChunkY        int32                  `protobuf:"varint,2,opt,name=chunk_y,json=chunkY,proto3" json:"chunk_y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChunkGenerated) Reset() {
+	*x = ChunkGenerated{}
+	mi := &file_character_v1_character_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChunkGenerated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChunkGenerated) ProtoMessage() {}
+
+func (x *ChunkGenerated) ProtoReflect() protoreflect.Message {
+	mi := &file_character_v1_character_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChunkGenerated.ProtoReflect.Descriptor instead.
+func (*ChunkGenerated) Descriptor() ([]byte, []int) {
+	return file_character_v1_character_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ChunkGenerated) GetChunkX() int32 {
+	if x != nil {
+		return x.ChunkX
+	}
+	return 0
+}
+
+func (x *ChunkGenerated) GetChunkY() int32 {
+	if x != nil {
+		return x.ChunkY
+	}
+	return 0
+}
+
+type TerrainModified struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	TerrainType         v13.TerrainType        `protobuf:"varint,1,opt,name=terrain_type,json=terrainType,proto3,enum=chunk.v1.TerrainType" json:"terrain_type,omitempty"`
+	PreviousTerrainType v13.TerrainType        `protobuf:"varint,2,opt,name=previous_terrain_type,json=previousTerrainType,proto3,enum=chunk.v1.TerrainType" json:"previous_terrain_type,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *TerrainModified) Reset() {
+	*x = TerrainModified{}
+	mi := &file_character_v1_character_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerrainModified) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerrainModified) ProtoMessage() {}
+
+func (x *TerrainModified) ProtoReflect() protoreflect.Message {
+	mi := &file_character_v1_character_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerrainModified.ProtoReflect.Descriptor instead.
+func (*TerrainModified) Descriptor() ([]byte, []int) {
+	return file_character_v1_character_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TerrainModified) GetTerrainType() v13.TerrainType {
+	if x != nil {
+		return x.TerrainType
+	}
+	return v13.TerrainType(0)
+}
+
+func (x *TerrainModified) GetPreviousTerrainType() v13.TerrainType {
+	if x != nil {
+		return x.PreviousTerrainType
+	}
+	return v13.TerrainType(0)
+}
+
+// A world entity (mob, drop, structure, crop) in the area when the stream opened
+type EntityPresent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Components    string                 `protobuf:"bytes,3,opt,name=components,proto3" json:"components,omitempty"` // JSON object of the entity's components by name
+	Version       int32                  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EntityPresent) Reset() {
+	*x = EntityPresent{}
+	mi := &file_character_v1_character_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntityPresent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityPresent) ProtoMessage() {}
+
+func (x *EntityPresent) ProtoReflect() protoreflect.Message {
+	mi := &file_character_v1_character_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityPresent.ProtoReflect.Descriptor instead.
+func (*EntityPresent) Descriptor() ([]byte, []int) {
+	return file_character_v1_character_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *EntityPresent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EntityPresent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *EntityPresent) GetComponents() string {
+	if x != nil {
+		return x.Components
+	}
+	return ""
+}
+
+func (x *EntityPresent) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type NearbyEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	X     int32                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"` // World position the event happened at
+	Y     int32                  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*NearbyEvent_CharacterMoved
+	//	*NearbyEvent_ChunkGenerated
+	//	*NearbyEvent_TerrainModified
+	//	*NearbyEvent_EntityPresent
+	Event isNearbyEvent_Event `protobuf_oneof:"event"`
+	// Outbox sequence number of generated chunks and terrain edits, increasing; pass the
+	// highest seen to ResyncState. 0 for events that are not replayed (moves, entities).
+	Sequence      int64           `protobuf:"varint,7,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Stream        *v12.StreamInfo `protobuf:"bytes,8,opt,name=stream,proto3" json:"stream,omitempty"` // Set when sent by StreamNearbyEvents
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NearbyEvent) Reset() {
+	*x = NearbyEvent{}
+	mi := &file_character_v1_character_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NearbyEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearbyEvent) ProtoMessage() {}
+
+func (x *NearbyEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_character_v1_character_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearbyEvent.ProtoReflect.Descriptor instead.
+func (*NearbyEvent) Descriptor() ([]byte, []int) {
+	return file_character_v1_character_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *NearbyEvent) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *NearbyEvent) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *NearbyEvent) GetEvent() isNearbyEvent_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *NearbyEvent) GetCharacterMoved() *Character {
+	if x != nil {
+		if x, ok := x.Event.(*NearbyEvent_CharacterMoved); ok {
+			return x.CharacterMoved
+		}
+	}
+	return nil
+}
+
+func (x *NearbyEvent) GetChunkGenerated() *ChunkGenerated {
+	if x != nil {
+		if x, ok := x.Event.(*NearbyEvent_ChunkGenerated); ok {
+			return x.ChunkGenerated
+		}
+	}
+	return nil
+}
+
+func (x *NearbyEvent) GetTerrainModified() *TerrainModified {
+	if x != nil {
+		if x, ok := x.Event.(*NearbyEvent_TerrainModified); ok {
+			return x.TerrainModified
+		}
+	}
+	return nil
+}
+
+func (x *NearbyEvent) GetEntityPresent() *EntityPresent {
+	if x != nil {
+		if x, ok := x.Event.(*NearbyEvent_EntityPresent); ok {
+			return x.EntityPresent
+		}
+	}
+	return nil
+}
+
+func (x *NearbyEvent) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *NearbyEvent) GetStream() *v12.StreamInfo {
+	if x != nil {
+		return x.Stream
+	}
+	return nil
+}
+
+type isNearbyEvent_Event interface {
+	isNearbyEvent_Event()
+}
+
+type NearbyEvent_CharacterMoved struct {
+	CharacterMoved *Character `protobuf:"bytes,3,opt,name=character_moved,json=characterMoved,proto3,oneof"`
+}
+
+type NearbyEvent_ChunkGenerated struct {
+	ChunkGenerated *ChunkGenerated `protobuf:"bytes,4,opt,name=chunk_generated,json=chunkGenerated,proto3,oneof"`
+}
+
+type NearbyEvent_TerrainModified struct {
+	TerrainModified *TerrainModified `protobuf:"bytes,5,opt,name=terrain_modified,json=terrainModified,proto3,oneof"`
+}
+
+type NearbyEvent_EntityPresent struct {
+	EntityPresent *EntityPresent `protobuf:"bytes,6,opt,name=entity_present,json=entityPresent,proto3,oneof"`
+}
+
+func (*NearbyEvent_CharacterMoved) isNearbyEvent_Event() {}
+
+func (*NearbyEvent_ChunkGenerated) isNearbyEvent_Event() {}
+
+func (*NearbyEvent_TerrainModified) isNearbyEvent_Event() {}
+
+func (*NearbyEvent_EntityPresent) isNearbyEvent_Event() {}
+
+type ResyncStateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	LastSequence  int64                  `protobuf:"varint,2,opt,name=last_sequence,json=lastSequence,proto3" json:"last_sequence,omitempty"` // Highest NearbyEvent sequence the client has; 0 asks for a snapshot
+	Radius        int32                  `protobuf:"varint,3,opt,name=radius,proto3" json:"radius,omitempty"`                                 // As in StreamNearbyEventsRequest
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResyncStateRequest) Reset() {
+	*x = ResyncStateRequest{}
+	mi := &file_character_v1_character_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResyncStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResyncStateRequest) ProtoMessage() {}
+
+func (x *ResyncStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_character_v1_character_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResyncStateRequest.ProtoReflect.Descriptor instead.
+func (*ResyncStateRequest) Descriptor() ([]byte, []int) {
+	return file_character_v1_character_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ResyncStateRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *ResyncStateRequest) GetLastSequence() int64 {
+	if x != nil {
+		return x.LastSequence
+	}
+	return 0
+}
+
+func (x *ResyncStateRequest) GetRadius() int32 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+// The events missed since last_sequence inside the area. Moves are not replayed, so the
+// characters in the area are sent with their current state instead.
+type ResyncDelta struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*NearbyEvent         `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"` // Oldest first
+	Characters    []*Character           `protobuf:"bytes,2,rep,name=characters,proto3" json:"characters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResyncDelta) Reset() {
+	*x = ResyncDelta{}
+	mi := &file_character_v1_character_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResyncDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResyncDelta) ProtoMessage() {}
+
+func (x *ResyncDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_character_v1_character_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResyncDelta.ProtoReflect.Descriptor instead.
+func (*ResyncDelta) Descriptor() ([]byte, []int) {
+	return file_character_v1_character_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ResyncDelta) GetEvents() []*NearbyEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ResyncDelta) GetCharacters() []*Character {
+	if x != nil {
+		return x.Characters
+	}
+	return nil
+}
+
+// Everything in the area, for clients whose last sequence is too old to replay
+type ResyncSnapshot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chunks        []*v13.ChunkData       `protobuf:"bytes,1,rep,name=chunks,proto3" json:"chunks,omitempty"` // Every chunk overlapping the area
+	Characters    []*Character           `protobuf:"bytes,2,rep,name=characters,proto3" json:"characters,omitempty"`
+	Entities      []*NearbyEvent         `protobuf:"bytes,3,rep,name=entities,proto3" json:"entities,omitempty"` // EntityPresent events, nearest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResyncSnapshot) Reset() {
+	*x = ResyncSnapshot{}
+	mi := &file_character_v1_character_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResyncSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResyncSnapshot) ProtoMessage() {}
+
+func (x *ResyncSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_character_v1_character_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResyncSnapshot.ProtoReflect.Descriptor instead.
+func (*ResyncSnapshot) Descriptor() ([]byte, []int) {
+	return file_character_v1_character_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ResyncSnapshot) GetChunks() []*v13.ChunkData {
+	if x != nil {
+		return x.Chunks
+	}
+	return nil
+}
+
+func (x *ResyncSnapshot) GetCharacters() []*Character {
+	if x != nil {
+		return x.Characters
+	}
+	return nil
+}
+
+func (x *ResyncSnapshot) GetEntities() []*NearbyEvent {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+type ResyncStateResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Sequence int64                  `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"` // Continue from here; events up to it are included in the delta or snapshot
+	// Types that are valid to be assigned to State:
+	//
+	//	*ResyncStateResponse_Delta
+	//	*ResyncStateResponse_Snapshot
+	State         isResyncStateResponse_State `protobuf_oneof:"state"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResyncStateResponse) Reset() {
+	*x = ResyncStateResponse{}
+	mi := &file_character_v1_character_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResyncStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResyncStateResponse) ProtoMessage() {}
+
+func (x *ResyncStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_character_v1_character_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResyncStateResponse.ProtoReflect.Descriptor instead.
+func (*ResyncStateResponse) Descriptor() ([]byte, []int) {
+	return file_character_v1_character_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ResyncStateResponse) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *ResyncStateResponse) GetState() isResyncStateResponse_State {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *ResyncStateResponse) GetDelta() *ResyncDelta {
+	if x != nil {
+		if x, ok := x.State.(*ResyncStateResponse_Delta); ok {
+			return x.Delta
+		}
+	}
+	return nil
+}
+
+func (x *ResyncStateResponse) GetSnapshot() *ResyncSnapshot {
+	if x != nil {
+		if x, ok := x.State.(*ResyncStateResponse_Snapshot); ok {
+			return x.Snapshot
+		}
+	}
+	return nil
+}
+
+type isResyncStateResponse_State interface {
+	isResyncStateResponse_State()
+}
+
+type ResyncStateResponse_Delta struct {
+	Delta *ResyncDelta `protobuf:"bytes,2,opt,name=delta,proto3,oneof"`
+}
+
+type ResyncStateResponse_Snapshot struct {
+	Snapshot *ResyncSnapshot `protobuf:"bytes,3,opt,name=snapshot,proto3,oneof"`
+}
+
+func (*ResyncStateResponse_Delta) isResyncStateResponse_State() {}
+
+func (*ResyncStateResponse_Snapshot) isResyncStateResponse_State() {}
+
+type CheckpointItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        int32                  `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckpointItem) Reset() {
+	*x = CheckpointItem{}
+	mi := &file_character_v1_character_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckpointItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckpointItem) ProtoMessage() {}
+
+func (x *CheckpointItem) ProtoReflect() protoreflect.Message {
+	mi := &file_character_v1_character_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckpointItem.ProtoReflect.Descriptor instead.
+func (*CheckpointItem) Descriptor() ([]byte, []int) {
+	return file_character_v1_character_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CheckpointItem) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *CheckpointItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// A snapshot of a character's position and inventory
+type CharacterCheckpoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CharacterId   string                 `protobuf:"bytes,2,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	X             int32                  `protobuf:"varint,3,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,4,opt,name=y,proto3" json:"y,omitempty"`
+	ChunkX        int32                  `protobuf:"varint,5,opt,name=chunk_x,json=chunkX,proto3" json:"chunk_x,omitempty"`
+	ChunkY        int32                  `protobuf:"varint,6,opt,name=chunk_y,json=chunkY,proto3" json:"chunk_y,omitempty"`
+	Inventory     []*CheckpointItem      `protobuf:"bytes,7,rep,name=inventory,proto3" json:"inventory,omitempty"`
+	InventoryHash string                 `protobuf:"bytes,8,opt,name=inventory_hash,json=inventoryHash,proto3" json:"inventory_hash,omitempty"`
+	Reason        string                 `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"` // periodic or pre_restore
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CharacterCheckpoint) Reset() {
+	*x = CharacterCheckpoint{}
+	mi := &file_character_v1_character_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CharacterCheckpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CharacterCheckpoint) ProtoMessage() {}
+
+func (x *CharacterCheckpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_character_v1_character_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CharacterCheckpoint.ProtoReflect.Descriptor instead.
+func (*CharacterCheckpoint) Descriptor() ([]byte, []int) {
+	return file_character_v1_character_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CharacterCheckpoint) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CharacterCheckpoint) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *CharacterCheckpoint) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *CharacterCheckpoint) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *CharacterCheckpoint) GetChunkX() int32 {
+	if x != nil {
+		return x.ChunkX
+	}
+	return 0
+}
+
+func (x *CharacterCheckpoint) GetChunkY() int32 {
+	if x != nil {
+		return x.ChunkY
+	}
+	return 0
+}
+
+func (x *CharacterCheckpoint) GetInventory() []*CheckpointItem {
+	if x != nil {
+		return x.Inventory
+	}
+	return nil
+}
+
+func (x *CharacterCheckpoint) GetInventoryHash() string {
+	if x != nil {
+		return x.InventoryHash
+	}
+	return ""
+}
+
+func (x *CharacterCheckpoint) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CharacterCheckpoint) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListCharacterCheckpointsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // 0 uses the default, larger values are capped
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCharacterCheckpointsRequest) Reset() {
+	*x = ListCharacterCheckpointsRequest{}
+	mi := &file_character_v1_character_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCharacterCheckpointsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCharacterCheckpointsRequest) ProtoMessage() {}
+
+func (x *ListCharacterCheckpointsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_character_v1_character_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCharacterCheckpointsRequest.ProtoReflect.Descriptor instead.
+func (*ListCharacterCheckpointsRequest) Descriptor() ([]byte, []int) {
+	return file_character_v1_character_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListCharacterCheckpointsRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *ListCharacterCheckpointsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListCharacterCheckpointsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Checkpoints   []*CharacterCheckpoint `protobuf:"bytes,1,rep,name=checkpoints,proto3" json:"checkpoints,omitempty"` // Newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCharacterCheckpointsResponse) Reset() {
+	*x = ListCharacterCheckpointsResponse{}
+	mi := &file_character_v1_character_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCharacterCheckpointsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCharacterCheckpointsResponse) ProtoMessage() {}
+
+func (x *ListCharacterCheckpointsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_character_v1_character_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCharacterCheckpointsResponse.ProtoReflect.Descriptor instead.
+func (*ListCharacterCheckpointsResponse) Descriptor() ([]byte, []int) {
+	return file_character_v1_character_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListCharacterCheckpointsResponse) GetCheckpoints() []*CharacterCheckpoint {
+	if x != nil {
+		return x.Checkpoints
+	}
+	return nil
+}
+
+// Restore a character's position and inventory. The current state is checkpointed
+// first so the restore can itself be undone.
+type RestoreCharacterCheckpointRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CheckpointId  int64                  `protobuf:"varint,1,opt,name=checkpoint_id,json=checkpointId,proto3" json:"checkpoint_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreCharacterCheckpointRequest) Reset() {
+	*x = RestoreCharacterCheckpointRequest{}
+	mi := &file_character_v1_character_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCharacterCheckpointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCharacterCheckpointRequest) ProtoMessage() {}
+
+func (x *RestoreCharacterCheckpointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_character_v1_character_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCharacterCheckpointRequest.ProtoReflect.Descriptor instead.
+func (*RestoreCharacterCheckpointRequest) Descriptor() ([]byte, []int) {
+	return file_character_v1_character_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RestoreCharacterCheckpointRequest) GetCheckpointId() int64 {
+	if x != nil {
+		return x.CheckpointId
+	}
+	return 0
+}
+
+type RestoreCharacterCheckpointResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Restored               *CharacterCheckpoint   `protobuf:"bytes,1,opt,name=restored,proto3" json:"restored,omitempty"`
+	PreRestoreCheckpointId int64                  `protobuf:"varint,2,opt,name=pre_restore_checkpoint_id,json=preRestoreCheckpointId,proto3" json:"pre_restore_checkpoint_id,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *RestoreCharacterCheckpointResponse) Reset() {
+	*x = RestoreCharacterCheckpointResponse{}
+	mi := &file_character_v1_character_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCharacterCheckpointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCharacterCheckpointResponse) ProtoMessage() {}
+
+func (x *RestoreCharacterCheckpointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_character_v1_character_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCharacterCheckpointResponse.ProtoReflect.Descriptor instead.
+func (*RestoreCharacterCheckpointResponse) Descriptor() ([]byte, []int) {
+	return file_character_v1_character_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RestoreCharacterCheckpointResponse) GetRestored() *CharacterCheckpoint {
+	if x != nil {
+		return x.Restored
+	}
+	return nil
+}
+
+func (x *RestoreCharacterCheckpointResponse) GetPreRestoreCheckpointId() int64 {
+	if x != nil {
+		return x.PreRestoreCheckpointId
+	}
+	return 0
+}
+
+type ActivityEntry struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type        ActivityType           `protobuf:"varint,2,opt,name=type,proto3,enum=character.v1.ActivityType" json:"type,omitempty"`
+	CharacterId string                 `protobuf:"bytes,3,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"` // Empty for account-wide activity such as trades
+	// Type-specific values, e.g. resource_node_type_id and drops for harvests, item_id and
+	// quantity for crafts, trade_id for trades, cause, x and y for deaths
+	Details       map[string]string      `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityEntry) Reset() {
+	*x = ActivityEntry{}
+	mi := &file_character_v1_character_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityEntry) ProtoMessage() {}
+
+func (x *ActivityEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_character_v1_character_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityEntry.ProtoReflect.Descriptor instead.
+func (*ActivityEntry) Descriptor() ([]byte, []int) {
+	return file_character_v1_character_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ActivityEntry) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ActivityEntry) GetType() ActivityType {
+	if x != nil {
+		return x.Type
+	}
+	return ActivityType_ACTIVITY_TYPE_UNSPECIFIED
+}
+
+func (x *ActivityEntry) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *ActivityEntry) GetDetails() map[string]string {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *ActivityEntry) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+type GetMyActivityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	BeforeId      int64                  `protobuf:"varint,2,opt,name=before_id,json=beforeId,proto3" json:"before_id,omitempty"` // 0 starts from the newest entry
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                       // 0 uses the default, larger values are capped
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyActivityRequest) Reset() {
+	*x = GetMyActivityRequest{}
+	mi := &file_character_v1_character_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyActivityRequest) ProtoMessage() {}
+
+func (x *GetMyActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_character_v1_character_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyActivityRequest.ProtoReflect.Descriptor instead.
+func (*GetMyActivityRequest) Descriptor() ([]byte, []int) {
+	return file_character_v1_character_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetMyActivityRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *GetMyActivityRequest) GetBeforeId() int64 {
+	if x != nil {
+		return x.BeforeId
+	}
+	return 0
+}
+
+func (x *GetMyActivityRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetMyActivityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*ActivityEntry       `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"` // Newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyActivityResponse) Reset() {
+	*x = GetMyActivityResponse{}
+	mi := &file_character_v1_character_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyActivityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyActivityResponse) ProtoMessage() {}
+
+func (x *GetMyActivityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_character_v1_character_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyActivityResponse.ProtoReflect.Descriptor instead.
+func (*GetMyActivityResponse) Descriptor() ([]byte, []int) {
+	return file_character_v1_character_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetMyActivityResponse) GetEntries() []*ActivityEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// A title or badge a character has unlocked
+type CharacterTitle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`             // ID, e.g. pathfinder
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`               // Display name, e.g. "Pathfinder"
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"` // How it was earned
+	AwardedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=awarded_at,json=awardedAt,proto3" json:"awarded_at,omitempty"`
+	Kind          CosmeticKind           `protobuf:"varint,5,opt,name=kind,proto3,enum=character.v1.CosmeticKind" json:"kind,omitempty"`
+	Equipped      bool                   `protobuf:"varint,6,opt,name=equipped,proto3" json:"equipped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CharacterTitle) Reset() {
+	*x = CharacterTitle{}
+	mi := &file_character_v1_character_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CharacterTitle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CharacterTitle) ProtoMessage() {}
+
+func (x *CharacterTitle) ProtoReflect() protoreflect.Message {
+	mi := &file_character_v1_character_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CharacterTitle.ProtoReflect.Descriptor instead.
+func (*CharacterTitle) Descriptor() ([]byte, []int) {
+	return file_character_v1_character_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CharacterTitle) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CharacterTitle) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CharacterTitle) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CharacterTitle) GetAwardedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AwardedAt
+	}
+	return nil
+}
+
+func (x *CharacterTitle) GetKind() CosmeticKind {
+	if x != nil {
+		return x.Kind
+	}
+	return CosmeticKind_COSMETIC_KIND_UNSPECIFIED
+}
+
+func (x *CharacterTitle) GetEquipped() bool {
+	if x != nil {
+		return x.Equipped
+	}
+	return false
+}
+
+type ListCharacterTitlesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCharacterTitlesRequest) Reset() {
+	*x = ListCharacterTitlesRequest{}
+	mi := &file_character_v1_character_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCharacterTitlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCharacterTitlesRequest) ProtoMessage() {}
+
+func (x *ListCharacterTitlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_character_v1_character_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCharacterTitlesRequest.ProtoReflect.Descriptor instead.
+func (*ListCharacterTitlesRequest) Descriptor() ([]byte, []int) {
+	return file_character_v1_character_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListCharacterTitlesRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+type ListCharacterTitlesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Titles        []*CharacterTitle      `protobuf:"bytes,1,rep,name=titles,proto3" json:"titles,omitempty"` // Oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCharacterTitlesResponse) Reset() {
+	*x = ListCharacterTitlesResponse{}
+	mi := &file_character_v1_character_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCharacterTitlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCharacterTitlesResponse) ProtoMessage() {}
+
+func (x *ListCharacterTitlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_character_v1_character_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCharacterTitlesResponse.ProtoReflect.Descriptor instead.
+func (*ListCharacterTitlesResponse) Descriptor() ([]byte, []int) {
+	return file_character_v1_character_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListCharacterTitlesResponse) GetTitles() []*CharacterTitle {
+	if x != nil {
+		return x.Titles
+	}
+	return nil
+}
+
+type EquipTitleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"` // ID of an unlocked title; empty to show none
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EquipTitleRequest) Reset() {
+	*x = EquipTitleRequest{}
+	mi := &file_character_v1_character_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EquipTitleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EquipTitleRequest) ProtoMessage() {}
+
+func (x *EquipTitleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_character_v1_character_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EquipTitleRequest.ProtoReflect.Descriptor instead.
+func (*EquipTitleRequest) Descriptor() ([]byte, []int) {
+	return file_character_v1_character_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *EquipTitleRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *EquipTitleRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type EquipTitleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         *CharacterTitle        `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"` // Unset when cleared
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EquipTitleResponse) Reset() {
+	*x = EquipTitleResponse{}
+	mi := &file_character_v1_character_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EquipTitleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EquipTitleResponse) ProtoMessage() {}
+
+func (x *EquipTitleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_character_v1_character_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EquipTitleResponse.ProtoReflect.Descriptor instead.
+func (*EquipTitleResponse) Descriptor() ([]byte, []int) {
+	return file_character_v1_character_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *EquipTitleResponse) GetTitle() *CharacterTitle {
+	if x != nil {
+		return x.Title
+	}
+	return nil
+}
+
+// A rename of a character
+type CharacterNameChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"` // Empty when the character has been deleted
+	OldName       string                 `protobuf:"bytes,2,opt,name=old_name,json=oldName,proto3" json:"old_name,omitempty"`
+	NewName       string                 `protobuf:"bytes,3,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	RenamedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=renamed_at,json=renamedAt,proto3" json:"renamed_at,omitempty"`
+	UserId        string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Only set for moderators
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CharacterNameChange) Reset() {
+	*x = CharacterNameChange{}
+	mi := &file_character_v1_character_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CharacterNameChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CharacterNameChange) ProtoMessage() {}
+
+func (x *CharacterNameChange) ProtoReflect() protoreflect.Message {
+	mi := &file_character_v1_character_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CharacterNameChange.ProtoReflect.Descriptor instead.
+func (*CharacterNameChange) Descriptor() ([]byte, []int) {
+	return file_character_v1_character_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CharacterNameChange) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *CharacterNameChange) GetOldName() string {
+	if x != nil {
+		return x.OldName
+	}
+	return ""
+}
+
+func (x *CharacterNameChange) GetNewName() string {
+	if x != nil {
+		return x.NewName
+	}
+	return ""
+}
+
+func (x *CharacterNameChange) GetRenamedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RenamedAt
+	}
+	return nil
+}
+
+func (x *CharacterNameChange) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RenameCharacterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // Surrounding whitespace is trimmed and inner runs collapsed to one space
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameCharacterRequest) Reset() {
+	*x = RenameCharacterRequest{}
+	mi := &file_character_v1_character_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameCharacterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameCharacterRequest) ProtoMessage() {}
+
+func (x *RenameCharacterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_character_v1_character_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameCharacterRequest.ProtoReflect.Descriptor instead.
+func (*RenameCharacterRequest) Descriptor() ([]byte, []int) {
+	return file_character_v1_character_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RenameCharacterRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *RenameCharacterRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RenameCharacterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Character     *Character             `protobuf:"bytes,1,opt,name=character,proto3" json:"character,omitempty"`
+	Change        *CharacterNameChange   `protobuf:"bytes,2,opt,name=change,proto3" json:"change,omitempty"`
+	NextRenameAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=next_rename_at,json=nextRenameAt,proto3" json:"next_rename_at,omitempty"` // When the character can be renamed again
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MoveCharacterResponse) Reset() {
-	*x = MoveCharacterResponse{}
-	mi := &file_character_v1_character_proto_msgTypes[11]
+func (x *RenameCharacterResponse) Reset() {
+	*x = RenameCharacterResponse{}
+	mi := &file_character_v1_character_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MoveCharacterResponse) String() string {
+func (x *RenameCharacterResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MoveCharacterResponse) ProtoMessage() {}
+func (*RenameCharacterResponse) ProtoMessage() {}
 
-func (x *MoveCharacterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_character_v1_character_proto_msgTypes[11]
+func (x *RenameCharacterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_character_v1_character_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -649,37 +2724,141 @@ func (x *MoveCharacterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MoveCharacterResponse.ProtoReflect.Descriptor instead.
-func (*MoveCharacterResponse) Descriptor() ([]byte, []int) {
-	return file_character_v1_character_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use RenameCharacterResponse.ProtoReflect.Descriptor instead.
+func (*RenameCharacterResponse) Descriptor() ([]byte, []int) {
+	return file_character_v1_character_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *MoveCharacterResponse) GetCharacter() *Character {
+func (x *RenameCharacterResponse) GetCharacter() *Character {
 	if x != nil {
 		return x.Character
 	}
 	return nil
 }
 
-func (x *MoveCharacterResponse) GetSuccess() bool {
+func (x *RenameCharacterResponse) GetChange() *CharacterNameChange {
 	if x != nil {
-		return x.Success
+		return x.Change
 	}
-	return false
+	return nil
 }
 
-func (x *MoveCharacterResponse) GetErrorMessage() string {
+func (x *RenameCharacterResponse) GetNextRenameAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ErrorMessage
+		return x.NextRenameAt
+	}
+	return nil
+}
+
+type ListCharacterNameHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // 0 uses the default, larger values are capped
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCharacterNameHistoryRequest) Reset() {
+	*x = ListCharacterNameHistoryRequest{}
+	mi := &file_character_v1_character_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCharacterNameHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCharacterNameHistoryRequest) ProtoMessage() {}
+
+func (x *ListCharacterNameHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_character_v1_character_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCharacterNameHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListCharacterNameHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_character_v1_character_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListCharacterNameHistoryRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
 	}
 	return ""
 }
 
+func (x *ListCharacterNameHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListCharacterNameHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*CharacterNameChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"` // Newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCharacterNameHistoryResponse) Reset() {
+	*x = ListCharacterNameHistoryResponse{}
+	mi := &file_character_v1_character_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCharacterNameHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCharacterNameHistoryResponse) ProtoMessage() {}
+
+func (x *ListCharacterNameHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_character_v1_character_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCharacterNameHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListCharacterNameHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_character_v1_character_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListCharacterNameHistoryResponse) GetChanges() []*CharacterNameChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
 var File_character_v1_character_proto protoreflect.FileDescriptor
 
 const file_character_v1_character_proto_rawDesc = "" +
 	"\n" +
-	"\x1ccharacter/v1/character.proto\x12\fcharacter.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd1\x01\n" +
+	"\x1ccharacter/v1/character.proto\x12\fcharacter.v1\x1a\x14chunk/v1/chunk.proto\x1a\x18dungeon/v1/dungeon.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1ainterior/v1/interior.proto\x1a\x16stream/v1/stream.proto\"\xdd\x01\n" +
+	"\fStatusEffect\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x122\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1e.character.v1.StatusEffectTypeR\x04type\x12\x1c\n" +
+	"\tmagnitude\x18\x03 \x01(\x01R\tmagnitude\x12\x16\n" +
+	"\x06stacks\x18\x04 \x01(\x05R\x06stacks\x12\x16\n" +
+	"\x06source\x18\x05 \x01(\tR\x06source\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\xb1\x03\n" +
 	"\tCharacter\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
@@ -689,7 +2868,13 @@ const file_character_v1_character_proto_rawDesc = "" +
 	"\achunk_x\x18\x06 \x01(\x05R\x06chunkX\x12\x17\n" +
 	"\achunk_y\x18\a \x01(\x05R\x06chunkY\x129\n" +
 	"\n" +
-	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"X\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x19\n" +
+	"\bworld_id\x18\t \x01(\tR\aworldId\x12,\n" +
+	"\x06facing\x18\n" +
+	" \x01(\x0e2\x14.character.v1.FacingR\x06facing\x12<\n" +
+	"\faction_state\x18\v \x01(\x0e2\x19.character.v1.ActionStateR\vactionState\x12A\n" +
+	"\x0estatus_effects\x18\f \x03(\v2\x1a.character.v1.StatusEffectR\rstatusEffects\x12\x14\n" +
+	"\x05title\x18\r \x01(\tR\x05title\"X\n" +
 	"\bPosition\x12\f\n" +
 	"\x01x\x18\x01 \x01(\x05R\x01x\x12\f\n" +
 	"\x01y\x18\x02 \x01(\x05R\x01y\x12\x17\n" +
@@ -713,21 +2898,185 @@ const file_character_v1_character_proto_rawDesc = "" +
 	"\x16DeleteCharacterRequest\x12!\n" +
 	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\"3\n" +
 	"\x17DeleteCharacterResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"c\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xcf\x01\n" +
 	"\x14MoveCharacterRequest\x12!\n" +
 	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x13\n" +
 	"\x05new_x\x18\x02 \x01(\x05R\x04newX\x12\x13\n" +
-	"\x05new_y\x18\x03 \x01(\x05R\x04newY\"\x8d\x01\n" +
+	"\x05new_y\x18\x03 \x01(\x05R\x04newY\x12,\n" +
+	"\x06facing\x18\x04 \x01(\x0e2\x14.character.v1.FacingR\x06facing\x12<\n" +
+	"\faction_state\x18\x05 \x01(\x0e2\x19.character.v1.ActionStateR\vactionState\"\xff\x01\n" +
 	"\x15MoveCharacterResponse\x125\n" +
 	"\tcharacter\x18\x01 \x01(\v2\x17.character.v1.CharacterR\tcharacter\x12\x18\n" +
 	"\asuccess\x18\x02 \x01(\bR\asuccess\x12#\n" +
-	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage2\xed\x03\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\x129\n" +
+	"\binterior\x18\x04 \x01(\v2\x1d.interior.v1.InteriorPositionR\binterior\x125\n" +
+	"\adungeon\x18\x05 \x01(\v2\x1b.dungeon.v1.DungeonPositionR\adungeon\"\x87\x01\n" +
+	"\x19StreamNearbyEventsRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x16\n" +
+	"\x06radius\x18\x02 \x01(\x05R\x06radius\x12/\n" +
+	"\x06resume\x18\x03 \x01(\v2\x17.stream.v1.StreamResumeR\x06resume\"B\n" +
+	"\x0eChunkGenerated\x12\x17\n" +
+	"\achunk_x\x18\x01 \x01(\x05R\x06chunkX\x12\x17\n" +
+	"\achunk_y\x18\x02 \x01(\x05R\x06chunkY\"\x96\x01\n" +
+	"\x0fTerrainModified\x128\n" +
+	"\fterrain_type\x18\x01 \x01(\x0e2\x15.chunk.v1.TerrainTypeR\vterrainType\x12I\n" +
+	"\x15previous_terrain_type\x18\x02 \x01(\x0e2\x15.chunk.v1.TerrainTypeR\x13previousTerrainType\"m\n" +
+	"\rEntityPresent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1e\n" +
+	"\n" +
+	"components\x18\x03 \x01(\tR\n" +
+	"components\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x05R\aversion\"\x9c\x03\n" +
+	"\vNearbyEvent\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x05R\x01y\x12B\n" +
+	"\x0fcharacter_moved\x18\x03 \x01(\v2\x17.character.v1.CharacterH\x00R\x0echaracterMoved\x12G\n" +
+	"\x0fchunk_generated\x18\x04 \x01(\v2\x1c.character.v1.ChunkGeneratedH\x00R\x0echunkGenerated\x12J\n" +
+	"\x10terrain_modified\x18\x05 \x01(\v2\x1d.character.v1.TerrainModifiedH\x00R\x0fterrainModified\x12D\n" +
+	"\x0eentity_present\x18\x06 \x01(\v2\x1b.character.v1.EntityPresentH\x00R\rentityPresent\x12\x1a\n" +
+	"\bsequence\x18\a \x01(\x03R\bsequence\x12-\n" +
+	"\x06stream\x18\b \x01(\v2\x15.stream.v1.StreamInfoR\x06streamB\a\n" +
+	"\x05event\"t\n" +
+	"\x12ResyncStateRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12#\n" +
+	"\rlast_sequence\x18\x02 \x01(\x03R\flastSequence\x12\x16\n" +
+	"\x06radius\x18\x03 \x01(\x05R\x06radius\"y\n" +
+	"\vResyncDelta\x121\n" +
+	"\x06events\x18\x01 \x03(\v2\x19.character.v1.NearbyEventR\x06events\x127\n" +
+	"\n" +
+	"characters\x18\x02 \x03(\v2\x17.character.v1.CharacterR\n" +
+	"characters\"\xad\x01\n" +
+	"\x0eResyncSnapshot\x12+\n" +
+	"\x06chunks\x18\x01 \x03(\v2\x13.chunk.v1.ChunkDataR\x06chunks\x127\n" +
+	"\n" +
+	"characters\x18\x02 \x03(\v2\x17.character.v1.CharacterR\n" +
+	"characters\x125\n" +
+	"\bentities\x18\x03 \x03(\v2\x19.character.v1.NearbyEventR\bentities\"\xa9\x01\n" +
+	"\x13ResyncStateResponse\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x03R\bsequence\x121\n" +
+	"\x05delta\x18\x02 \x01(\v2\x19.character.v1.ResyncDeltaH\x00R\x05delta\x12:\n" +
+	"\bsnapshot\x18\x03 \x01(\v2\x1c.character.v1.ResyncSnapshotH\x00R\bsnapshotB\a\n" +
+	"\x05state\"E\n" +
+	"\x0eCheckpointItem\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\x05R\x06itemId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"\xcc\x02\n" +
+	"\x13CharacterCheckpoint\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
+	"\fcharacter_id\x18\x02 \x01(\tR\vcharacterId\x12\f\n" +
+	"\x01x\x18\x03 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x04 \x01(\x05R\x01y\x12\x17\n" +
+	"\achunk_x\x18\x05 \x01(\x05R\x06chunkX\x12\x17\n" +
+	"\achunk_y\x18\x06 \x01(\x05R\x06chunkY\x12:\n" +
+	"\tinventory\x18\a \x03(\v2\x1c.character.v1.CheckpointItemR\tinventory\x12%\n" +
+	"\x0einventory_hash\x18\b \x01(\tR\rinventoryHash\x12\x16\n" +
+	"\x06reason\x18\t \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"Z\n" +
+	"\x1fListCharacterCheckpointsRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"g\n" +
+	" ListCharacterCheckpointsResponse\x12C\n" +
+	"\vcheckpoints\x18\x01 \x03(\v2!.character.v1.CharacterCheckpointR\vcheckpoints\"H\n" +
+	"!RestoreCharacterCheckpointRequest\x12#\n" +
+	"\rcheckpoint_id\x18\x01 \x01(\x03R\fcheckpointId\"\x9e\x01\n" +
+	"\"RestoreCharacterCheckpointResponse\x12=\n" +
+	"\brestored\x18\x01 \x01(\v2!.character.v1.CharacterCheckpointR\brestored\x129\n" +
+	"\x19pre_restore_checkpoint_id\x18\x02 \x01(\x03R\x16preRestoreCheckpointId\"\xaf\x02\n" +
+	"\rActivityEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12.\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1a.character.v1.ActivityTypeR\x04type\x12!\n" +
+	"\fcharacter_id\x18\x03 \x01(\tR\vcharacterId\x12B\n" +
+	"\adetails\x18\x04 \x03(\v2(.character.v1.ActivityEntry.DetailsEntryR\adetails\x12;\n" +
+	"\voccurred_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x1a:\n" +
+	"\fDetailsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"l\n" +
+	"\x14GetMyActivityRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x1b\n" +
+	"\tbefore_id\x18\x02 \x01(\x03R\bbeforeId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"N\n" +
+	"\x15GetMyActivityResponse\x125\n" +
+	"\aentries\x18\x01 \x03(\v2\x1b.character.v1.ActivityEntryR\aentries\"\xe3\x01\n" +
+	"\x0eCharacterTitle\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"awarded_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tawardedAt\x12.\n" +
+	"\x04kind\x18\x05 \x01(\x0e2\x1a.character.v1.CosmeticKindR\x04kind\x12\x1a\n" +
+	"\bequipped\x18\x06 \x01(\bR\bequipped\"?\n" +
+	"\x1aListCharacterTitlesRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\"S\n" +
+	"\x1bListCharacterTitlesResponse\x124\n" +
+	"\x06titles\x18\x01 \x03(\v2\x1c.character.v1.CharacterTitleR\x06titles\"L\n" +
+	"\x11EquipTitleRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\"H\n" +
+	"\x12EquipTitleResponse\x122\n" +
+	"\x05title\x18\x01 \x01(\v2\x1c.character.v1.CharacterTitleR\x05title\"\xc2\x01\n" +
+	"\x13CharacterNameChange\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x19\n" +
+	"\bold_name\x18\x02 \x01(\tR\aoldName\x12\x19\n" +
+	"\bnew_name\x18\x03 \x01(\tR\anewName\x129\n" +
+	"\n" +
+	"renamed_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\trenamedAt\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\"O\n" +
+	"\x16RenameCharacterRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xcd\x01\n" +
+	"\x17RenameCharacterResponse\x125\n" +
+	"\tcharacter\x18\x01 \x01(\v2\x17.character.v1.CharacterR\tcharacter\x129\n" +
+	"\x06change\x18\x02 \x01(\v2!.character.v1.CharacterNameChangeR\x06change\x12@\n" +
+	"\x0enext_rename_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\fnextRenameAt\"Z\n" +
+	"\x1fListCharacterNameHistoryRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"_\n" +
+	" ListCharacterNameHistoryResponse\x12;\n" +
+	"\achanges\x18\x01 \x03(\v2!.character.v1.CharacterNameChangeR\achanges*f\n" +
+	"\x06Facing\x12\x16\n" +
+	"\x12FACING_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fFACING_NORTH\x10\x01\x12\x0f\n" +
+	"\vFACING_EAST\x10\x02\x12\x10\n" +
+	"\fFACING_SOUTH\x10\x03\x12\x0f\n" +
+	"\vFACING_WEST\x10\x04*y\n" +
+	"\vActionState\x12\x1c\n" +
+	"\x18ACTION_STATE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11ACTION_STATE_IDLE\x10\x01\x12\x18\n" +
+	"\x14ACTION_STATE_WALKING\x10\x02\x12\x1b\n" +
+	"\x17ACTION_STATE_HARVESTING\x10\x03*\x83\x01\n" +
+	"\x10StatusEffectType\x12\"\n" +
+	"\x1eSTATUS_EFFECT_TYPE_UNSPECIFIED\x10\x00\x12$\n" +
+	" STATUS_EFFECT_TYPE_HARVEST_YIELD\x10\x01\x12%\n" +
+	"!STATUS_EFFECT_TYPE_MOVEMENT_SPEED\x10\x02*\x93\x01\n" +
+	"\fActivityType\x12\x1d\n" +
+	"\x19ACTIVITY_TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15ACTIVITY_TYPE_HARVEST\x10\x01\x12\x17\n" +
+	"\x13ACTIVITY_TYPE_CRAFT\x10\x02\x12\x17\n" +
+	"\x13ACTIVITY_TYPE_TRADE\x10\x03\x12\x17\n" +
+	"\x13ACTIVITY_TYPE_DEATH\x10\x04*_\n" +
+	"\fCosmeticKind\x12\x1d\n" +
+	"\x19COSMETIC_KIND_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13COSMETIC_KIND_TITLE\x10\x01\x12\x17\n" +
+	"\x13COSMETIC_KIND_BADGE\x10\x022\x9e\v\n" +
 	"\x10CharacterService\x12`\n" +
 	"\x0fCreateCharacter\x12$.character.v1.CreateCharacterRequest\x1a%.character.v1.CreateCharacterResponse\"\x00\x12W\n" +
 	"\fGetCharacter\x12!.character.v1.GetCharacterRequest\x1a\".character.v1.GetCharacterResponse\"\x00\x12`\n" +
 	"\x0fGetMyCharacters\x12$.character.v1.GetMyCharactersRequest\x1a%.character.v1.GetMyCharactersResponse\"\x00\x12`\n" +
 	"\x0fDeleteCharacter\x12$.character.v1.DeleteCharacterRequest\x1a%.character.v1.DeleteCharacterResponse\"\x00\x12Z\n" +
-	"\rMoveCharacter\x12\".character.v1.MoveCharacterRequest\x1a#.character.v1.MoveCharacterResponse\"\x00B0Z.github.com/VoidMesh/api/api/proto/character/v1b\x06proto3"
+	"\rMoveCharacter\x12\".character.v1.MoveCharacterRequest\x1a#.character.v1.MoveCharacterResponse\"\x00\x12\\\n" +
+	"\x12StreamNearbyEvents\x12'.character.v1.StreamNearbyEventsRequest\x1a\x19.character.v1.NearbyEvent\"\x000\x01\x12T\n" +
+	"\vResyncState\x12 .character.v1.ResyncStateRequest\x1a!.character.v1.ResyncStateResponse\"\x00\x12{\n" +
+	"\x18ListCharacterCheckpoints\x12-.character.v1.ListCharacterCheckpointsRequest\x1a..character.v1.ListCharacterCheckpointsResponse\"\x00\x12\x81\x01\n" +
+	"\x1aRestoreCharacterCheckpoint\x12/.character.v1.RestoreCharacterCheckpointRequest\x1a0.character.v1.RestoreCharacterCheckpointResponse\"\x00\x12Z\n" +
+	"\rGetMyActivity\x12\".character.v1.GetMyActivityRequest\x1a#.character.v1.GetMyActivityResponse\"\x00\x12l\n" +
+	"\x13ListCharacterTitles\x12(.character.v1.ListCharacterTitlesRequest\x1a).character.v1.ListCharacterTitlesResponse\"\x00\x12Q\n" +
+	"\n" +
+	"EquipTitle\x12\x1f.character.v1.EquipTitleRequest\x1a .character.v1.EquipTitleResponse\"\x00\x12`\n" +
+	"\x0fRenameCharacter\x12$.character.v1.RenameCharacterRequest\x1a%.character.v1.RenameCharacterResponse\"\x00\x12{\n" +
+	"\x18ListCharacterNameHistory\x12-.character.v1.ListCharacterNameHistoryRequest\x1a..character.v1.ListCharacterNameHistoryResponse\"\x00B0Z.github.com/VoidMesh/api/api/proto/character/v1b\x06proto3"
 
 var (
 	file_character_v1_character_proto_rawDescOnce sync.Once
@@ -741,43 +3090,144 @@ func file_character_v1_character_proto_rawDescGZIP() []byte {
 	return file_character_v1_character_proto_rawDescData
 }
 
-var file_character_v1_character_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_character_v1_character_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_character_v1_character_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
 var file_character_v1_character_proto_goTypes = []any{
-	(*Character)(nil),               // 0: character.v1.Character
-	(*Position)(nil),                // 1: character.v1.Position
-	(*CreateCharacterRequest)(nil),  // 2: character.v1.CreateCharacterRequest
-	(*CreateCharacterResponse)(nil), // 3: character.v1.CreateCharacterResponse
-	(*GetCharacterRequest)(nil),     // 4: character.v1.GetCharacterRequest
-	(*GetCharacterResponse)(nil),    // 5: character.v1.GetCharacterResponse
-	(*GetMyCharactersRequest)(nil),  // 6: character.v1.GetMyCharactersRequest
-	(*GetMyCharactersResponse)(nil), // 7: character.v1.GetMyCharactersResponse
-	(*DeleteCharacterRequest)(nil),  // 8: character.v1.DeleteCharacterRequest
-	(*DeleteCharacterResponse)(nil), // 9: character.v1.DeleteCharacterResponse
-	(*MoveCharacterRequest)(nil),    // 10: character.v1.MoveCharacterRequest
-	(*MoveCharacterResponse)(nil),   // 11: character.v1.MoveCharacterResponse
-	(*timestamppb.Timestamp)(nil),   // 12: google.protobuf.Timestamp
+	(Facing)(0),                                // 0: character.v1.Facing
+	(ActionState)(0),                           // 1: character.v1.ActionState
+	(StatusEffectType)(0),                      // 2: character.v1.StatusEffectType
+	(ActivityType)(0),                          // 3: character.v1.ActivityType
+	(CosmeticKind)(0),                          // 4: character.v1.CosmeticKind
+	(*StatusEffect)(nil),                       // 5: character.v1.StatusEffect
+	(*Character)(nil),                          // 6: character.v1.Character
+	(*Position)(nil),                           // 7: character.v1.Position
+	(*CreateCharacterRequest)(nil),             // 8: character.v1.CreateCharacterRequest
+	(*CreateCharacterResponse)(nil),            // 9: character.v1.CreateCharacterResponse
+	(*GetCharacterRequest)(nil),                // 10: character.v1.GetCharacterRequest
+	(*GetCharacterResponse)(nil),               // 11: character.v1.GetCharacterResponse
+	(*GetMyCharactersRequest)(nil),             // 12: character.v1.GetMyCharactersRequest
+	(*GetMyCharactersResponse)(nil),            // 13: character.v1.GetMyCharactersResponse
+	(*DeleteCharacterRequest)(nil),             // 14: character.v1.DeleteCharacterRequest
+	(*DeleteCharacterResponse)(nil),            // 15: character.v1.DeleteCharacterResponse
+	(*MoveCharacterRequest)(nil),               // 16: character.v1.MoveCharacterRequest
+	(*MoveCharacterResponse)(nil),              // 17: character.v1.MoveCharacterResponse
+	(*StreamNearbyEventsRequest)(nil),          // 18: character.v1.StreamNearbyEventsRequest
+	(*ChunkGenerated)(nil),                     // 19: character.v1.ChunkGenerated
+	(*TerrainModified)(nil),                    // 20: character.v1.TerrainModified
+	(*EntityPresent)(nil),                      // 21: character.v1.EntityPresent
+	(*NearbyEvent)(nil),                        // 22: character.v1.NearbyEvent
+	(*ResyncStateRequest)(nil),                 // 23: character.v1.ResyncStateRequest
+	(*ResyncDelta)(nil),                        // 24: character.v1.ResyncDelta
+	(*ResyncSnapshot)(nil),                     // 25: character.v1.ResyncSnapshot
+	(*ResyncStateResponse)(nil),                // 26: character.v1.ResyncStateResponse
+	(*CheckpointItem)(nil),                     // 27: character.v1.CheckpointItem
+	(*CharacterCheckpoint)(nil),                // 28: character.v1.CharacterCheckpoint
+	(*ListCharacterCheckpointsRequest)(nil),    // 29: character.v1.ListCharacterCheckpointsRequest
+	(*ListCharacterCheckpointsResponse)(nil),   // 30: character.v1.ListCharacterCheckpointsResponse
+	(*RestoreCharacterCheckpointRequest)(nil),  // 31: character.v1.RestoreCharacterCheckpointRequest
+	(*RestoreCharacterCheckpointResponse)(nil), // 32: character.v1.RestoreCharacterCheckpointResponse
+	(*ActivityEntry)(nil),                      // 33: character.v1.ActivityEntry
+	(*GetMyActivityRequest)(nil),               // 34: character.v1.GetMyActivityRequest
+	(*GetMyActivityResponse)(nil),              // 35: character.v1.GetMyActivityResponse
+	(*CharacterTitle)(nil),                     // 36: character.v1.CharacterTitle
+	(*ListCharacterTitlesRequest)(nil),         // 37: character.v1.ListCharacterTitlesRequest
+	(*ListCharacterTitlesResponse)(nil),        // 38: character.v1.ListCharacterTitlesResponse
+	(*EquipTitleRequest)(nil),                  // 39: character.v1.EquipTitleRequest
+	(*EquipTitleResponse)(nil),                 // 40: character.v1.EquipTitleResponse
+	(*CharacterNameChange)(nil),                // 41: character.v1.CharacterNameChange
+	(*RenameCharacterRequest)(nil),             // 42: character.v1.RenameCharacterRequest
+	(*RenameCharacterResponse)(nil),            // 43: character.v1.RenameCharacterResponse
+	(*ListCharacterNameHistoryRequest)(nil),    // 44: character.v1.ListCharacterNameHistoryRequest
+	(*ListCharacterNameHistoryResponse)(nil),   // 45: character.v1.ListCharacterNameHistoryResponse
+	nil,                                        // 46: character.v1.ActivityEntry.DetailsEntry
+	(*timestamppb.Timestamp)(nil),              // 47: google.protobuf.Timestamp
+	(*v1.InteriorPosition)(nil),                // 48: interior.v1.InteriorPosition
+	(*v11.DungeonPosition)(nil),                // 49: dungeon.v1.DungeonPosition
+	(*v12.StreamResume)(nil),                   // 50: stream.v1.StreamResume
+	(v13.TerrainType)(0),                       // 51: chunk.v1.TerrainType
+	(*v12.StreamInfo)(nil),                     // 52: stream.v1.StreamInfo
+	(*v13.ChunkData)(nil),                      // 53: chunk.v1.ChunkData
 }
 var file_character_v1_character_proto_depIdxs = []int32{
-	12, // 0: character.v1.Character.created_at:type_name -> google.protobuf.Timestamp
-	0,  // 1: character.v1.CreateCharacterResponse.character:type_name -> character.v1.Character
-	0,  // 2: character.v1.GetCharacterResponse.character:type_name -> character.v1.Character
-	0,  // 3: character.v1.GetMyCharactersResponse.characters:type_name -> character.v1.Character
-	0,  // 4: character.v1.MoveCharacterResponse.character:type_name -> character.v1.Character
-	2,  // 5: character.v1.CharacterService.CreateCharacter:input_type -> character.v1.CreateCharacterRequest
-	4,  // 6: character.v1.CharacterService.GetCharacter:input_type -> character.v1.GetCharacterRequest
-	6,  // 7: character.v1.CharacterService.GetMyCharacters:input_type -> character.v1.GetMyCharactersRequest
-	8,  // 8: character.v1.CharacterService.DeleteCharacter:input_type -> character.v1.DeleteCharacterRequest
-	10, // 9: character.v1.CharacterService.MoveCharacter:input_type -> character.v1.MoveCharacterRequest
-	3,  // 10: character.v1.CharacterService.CreateCharacter:output_type -> character.v1.CreateCharacterResponse
-	5,  // 11: character.v1.CharacterService.GetCharacter:output_type -> character.v1.GetCharacterResponse
-	7,  // 12: character.v1.CharacterService.GetMyCharacters:output_type -> character.v1.GetMyCharactersResponse
-	9,  // 13: character.v1.CharacterService.DeleteCharacter:output_type -> character.v1.DeleteCharacterResponse
-	11, // 14: character.v1.CharacterService.MoveCharacter:output_type -> character.v1.MoveCharacterResponse
-	10, // [10:15] is the sub-list for method output_type
-	5,  // [5:10] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	2,  // 0: character.v1.StatusEffect.type:type_name -> character.v1.StatusEffectType
+	47, // 1: character.v1.StatusEffect.expires_at:type_name -> google.protobuf.Timestamp
+	47, // 2: character.v1.Character.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: character.v1.Character.facing:type_name -> character.v1.Facing
+	1,  // 4: character.v1.Character.action_state:type_name -> character.v1.ActionState
+	5,  // 5: character.v1.Character.status_effects:type_name -> character.v1.StatusEffect
+	6,  // 6: character.v1.CreateCharacterResponse.character:type_name -> character.v1.Character
+	6,  // 7: character.v1.GetCharacterResponse.character:type_name -> character.v1.Character
+	6,  // 8: character.v1.GetMyCharactersResponse.characters:type_name -> character.v1.Character
+	0,  // 9: character.v1.MoveCharacterRequest.facing:type_name -> character.v1.Facing
+	1,  // 10: character.v1.MoveCharacterRequest.action_state:type_name -> character.v1.ActionState
+	6,  // 11: character.v1.MoveCharacterResponse.character:type_name -> character.v1.Character
+	48, // 12: character.v1.MoveCharacterResponse.interior:type_name -> interior.v1.InteriorPosition
+	49, // 13: character.v1.MoveCharacterResponse.dungeon:type_name -> dungeon.v1.DungeonPosition
+	50, // 14: character.v1.StreamNearbyEventsRequest.resume:type_name -> stream.v1.StreamResume
+	51, // 15: character.v1.TerrainModified.terrain_type:type_name -> chunk.v1.TerrainType
+	51, // 16: character.v1.TerrainModified.previous_terrain_type:type_name -> chunk.v1.TerrainType
+	6,  // 17: character.v1.NearbyEvent.character_moved:type_name -> character.v1.Character
+	19, // 18: character.v1.NearbyEvent.chunk_generated:type_name -> character.v1.ChunkGenerated
+	20, // 19: character.v1.NearbyEvent.terrain_modified:type_name -> character.v1.TerrainModified
+	21, // 20: character.v1.NearbyEvent.entity_present:type_name -> character.v1.EntityPresent
+	52, // 21: character.v1.NearbyEvent.stream:type_name -> stream.v1.StreamInfo
+	22, // 22: character.v1.ResyncDelta.events:type_name -> character.v1.NearbyEvent
+	6,  // 23: character.v1.ResyncDelta.characters:type_name -> character.v1.Character
+	53, // 24: character.v1.ResyncSnapshot.chunks:type_name -> chunk.v1.ChunkData
+	6,  // 25: character.v1.ResyncSnapshot.characters:type_name -> character.v1.Character
+	22, // 26: character.v1.ResyncSnapshot.entities:type_name -> character.v1.NearbyEvent
+	24, // 27: character.v1.ResyncStateResponse.delta:type_name -> character.v1.ResyncDelta
+	25, // 28: character.v1.ResyncStateResponse.snapshot:type_name -> character.v1.ResyncSnapshot
+	27, // 29: character.v1.CharacterCheckpoint.inventory:type_name -> character.v1.CheckpointItem
+	47, // 30: character.v1.CharacterCheckpoint.created_at:type_name -> google.protobuf.Timestamp
+	28, // 31: character.v1.ListCharacterCheckpointsResponse.checkpoints:type_name -> character.v1.CharacterCheckpoint
+	28, // 32: character.v1.RestoreCharacterCheckpointResponse.restored:type_name -> character.v1.CharacterCheckpoint
+	3,  // 33: character.v1.ActivityEntry.type:type_name -> character.v1.ActivityType
+	46, // 34: character.v1.ActivityEntry.details:type_name -> character.v1.ActivityEntry.DetailsEntry
+	47, // 35: character.v1.ActivityEntry.occurred_at:type_name -> google.protobuf.Timestamp
+	33, // 36: character.v1.GetMyActivityResponse.entries:type_name -> character.v1.ActivityEntry
+	47, // 37: character.v1.CharacterTitle.awarded_at:type_name -> google.protobuf.Timestamp
+	4,  // 38: character.v1.CharacterTitle.kind:type_name -> character.v1.CosmeticKind
+	36, // 39: character.v1.ListCharacterTitlesResponse.titles:type_name -> character.v1.CharacterTitle
+	36, // 40: character.v1.EquipTitleResponse.title:type_name -> character.v1.CharacterTitle
+	47, // 41: character.v1.CharacterNameChange.renamed_at:type_name -> google.protobuf.Timestamp
+	6,  // 42: character.v1.RenameCharacterResponse.character:type_name -> character.v1.Character
+	41, // 43: character.v1.RenameCharacterResponse.change:type_name -> character.v1.CharacterNameChange
+	47, // 44: character.v1.RenameCharacterResponse.next_rename_at:type_name -> google.protobuf.Timestamp
+	41, // 45: character.v1.ListCharacterNameHistoryResponse.changes:type_name -> character.v1.CharacterNameChange
+	8,  // 46: character.v1.CharacterService.CreateCharacter:input_type -> character.v1.CreateCharacterRequest
+	10, // 47: character.v1.CharacterService.GetCharacter:input_type -> character.v1.GetCharacterRequest
+	12, // 48: character.v1.CharacterService.GetMyCharacters:input_type -> character.v1.GetMyCharactersRequest
+	14, // 49: character.v1.CharacterService.DeleteCharacter:input_type -> character.v1.DeleteCharacterRequest
+	16, // 50: character.v1.CharacterService.MoveCharacter:input_type -> character.v1.MoveCharacterRequest
+	18, // 51: character.v1.CharacterService.StreamNearbyEvents:input_type -> character.v1.StreamNearbyEventsRequest
+	23, // 52: character.v1.CharacterService.ResyncState:input_type -> character.v1.ResyncStateRequest
+	29, // 53: character.v1.CharacterService.ListCharacterCheckpoints:input_type -> character.v1.ListCharacterCheckpointsRequest
+	31, // 54: character.v1.CharacterService.RestoreCharacterCheckpoint:input_type -> character.v1.RestoreCharacterCheckpointRequest
+	34, // 55: character.v1.CharacterService.GetMyActivity:input_type -> character.v1.GetMyActivityRequest
+	37, // 56: character.v1.CharacterService.ListCharacterTitles:input_type -> character.v1.ListCharacterTitlesRequest
+	39, // 57: character.v1.CharacterService.EquipTitle:input_type -> character.v1.EquipTitleRequest
+	42, // 58: character.v1.CharacterService.RenameCharacter:input_type -> character.v1.RenameCharacterRequest
+	44, // 59: character.v1.CharacterService.ListCharacterNameHistory:input_type -> character.v1.ListCharacterNameHistoryRequest
+	9,  // 60: character.v1.CharacterService.CreateCharacter:output_type -> character.v1.CreateCharacterResponse
+	11, // 61: character.v1.CharacterService.GetCharacter:output_type -> character.v1.GetCharacterResponse
+	13, // 62: character.v1.CharacterService.GetMyCharacters:output_type -> character.v1.GetMyCharactersResponse
+	15, // 63: character.v1.CharacterService.DeleteCharacter:output_type -> character.v1.DeleteCharacterResponse
+	17, // 64: character.v1.CharacterService.MoveCharacter:output_type -> character.v1.MoveCharacterResponse
+	22, // 65: character.v1.CharacterService.StreamNearbyEvents:output_type -> character.v1.NearbyEvent
+	26, // 66: character.v1.CharacterService.ResyncState:output_type -> character.v1.ResyncStateResponse
+	30, // 67: character.v1.CharacterService.ListCharacterCheckpoints:output_type -> character.v1.ListCharacterCheckpointsResponse
+	32, // 68: character.v1.CharacterService.RestoreCharacterCheckpoint:output_type -> character.v1.RestoreCharacterCheckpointResponse
+	35, // 69: character.v1.CharacterService.GetMyActivity:output_type -> character.v1.GetMyActivityResponse
+	38, // 70: character.v1.CharacterService.ListCharacterTitles:output_type -> character.v1.ListCharacterTitlesResponse
+	40, // 71: character.v1.CharacterService.EquipTitle:output_type -> character.v1.EquipTitleResponse
+	43, // 72: character.v1.CharacterService.RenameCharacter:output_type -> character.v1.RenameCharacterResponse
+	45, // 73: character.v1.CharacterService.ListCharacterNameHistory:output_type -> character.v1.ListCharacterNameHistoryResponse
+	60, // [60:74] is the sub-list for method output_type
+	46, // [46:60] is the sub-list for method input_type
+	46, // [46:46] is the sub-list for extension type_name
+	46, // [46:46] is the sub-list for extension extendee
+	0,  // [0:46] is the sub-list for field type_name
 }
 
 func init() { file_character_v1_character_proto_init() }
@@ -785,18 +3235,29 @@ func file_character_v1_character_proto_init() {
 	if File_character_v1_character_proto != nil {
 		return
 	}
+	file_character_v1_character_proto_msgTypes[17].OneofWrappers = []any{
+		(*NearbyEvent_CharacterMoved)(nil),
+		(*NearbyEvent_ChunkGenerated)(nil),
+		(*NearbyEvent_TerrainModified)(nil),
+		(*NearbyEvent_EntityPresent)(nil),
+	}
+	file_character_v1_character_proto_msgTypes[21].OneofWrappers = []any{
+		(*ResyncStateResponse_Delta)(nil),
+		(*ResyncStateResponse_Snapshot)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_character_v1_character_proto_rawDesc), len(file_character_v1_character_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   12,
+			NumEnums:      5,
+			NumMessages:   42,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_character_v1_character_proto_goTypes,
 		DependencyIndexes: file_character_v1_character_proto_depIdxs,
+		EnumInfos:         file_character_v1_character_proto_enumTypes,
 		MessageInfos:      file_character_v1_character_proto_msgTypes,
 	}.Build()
 	File_character_v1_character_proto = out.File
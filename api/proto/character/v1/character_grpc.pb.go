@@ -19,11 +19,20 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CharacterService_CreateCharacter_FullMethodName = "/character.v1.CharacterService/CreateCharacter"
-	CharacterService_GetCharacter_FullMethodName    = "/character.v1.CharacterService/GetCharacter"
-	CharacterService_GetMyCharacters_FullMethodName = "/character.v1.CharacterService/GetMyCharacters"
-	CharacterService_DeleteCharacter_FullMethodName = "/character.v1.CharacterService/DeleteCharacter"
-	CharacterService_MoveCharacter_FullMethodName   = "/character.v1.CharacterService/MoveCharacter"
+	CharacterService_CreateCharacter_FullMethodName            = "/character.v1.CharacterService/CreateCharacter"
+	CharacterService_GetCharacter_FullMethodName               = "/character.v1.CharacterService/GetCharacter"
+	CharacterService_GetMyCharacters_FullMethodName            = "/character.v1.CharacterService/GetMyCharacters"
+	CharacterService_DeleteCharacter_FullMethodName            = "/character.v1.CharacterService/DeleteCharacter"
+	CharacterService_MoveCharacter_FullMethodName              = "/character.v1.CharacterService/MoveCharacter"
+	CharacterService_StreamNearbyEvents_FullMethodName         = "/character.v1.CharacterService/StreamNearbyEvents"
+	CharacterService_ResyncState_FullMethodName                = "/character.v1.CharacterService/ResyncState"
+	CharacterService_ListCharacterCheckpoints_FullMethodName   = "/character.v1.CharacterService/ListCharacterCheckpoints"
+	CharacterService_RestoreCharacterCheckpoint_FullMethodName = "/character.v1.CharacterService/RestoreCharacterCheckpoint"
+	CharacterService_GetMyActivity_FullMethodName              = "/character.v1.CharacterService/GetMyActivity"
+	CharacterService_ListCharacterTitles_FullMethodName        = "/character.v1.CharacterService/ListCharacterTitles"
+	CharacterService_EquipTitle_FullMethodName                 = "/character.v1.CharacterService/EquipTitle"
+	CharacterService_RenameCharacter_FullMethodName            = "/character.v1.CharacterService/RenameCharacter"
+	CharacterService_ListCharacterNameHistory_FullMethodName   = "/character.v1.CharacterService/ListCharacterNameHistory"
 )
 
 // CharacterServiceClient is the client API for CharacterService service.
@@ -37,6 +46,27 @@ type CharacterServiceClient interface {
 	DeleteCharacter(ctx context.Context, in *DeleteCharacterRequest, opts ...grpc.CallOption) (*DeleteCharacterResponse, error)
 	// Character movement
 	MoveCharacter(ctx context.Context, in *MoveCharacterRequest, opts ...grpc.CallOption) (*MoveCharacterResponse, error)
+	// Events around a character, filtered to its area of interest. The entities already in
+	// the area are sent first, nearest first.
+	StreamNearbyEvents(ctx context.Context, in *StreamNearbyEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[NearbyEvent], error)
+	// Catch up after a disconnect: the events missed since a sequence number, or a snapshot
+	// of the area when they are no longer available
+	ResyncState(ctx context.Context, in *ResyncStateRequest, opts ...grpc.CallOption) (*ResyncStateResponse, error)
+	// State checkpoints for support tooling (admin only)
+	ListCharacterCheckpoints(ctx context.Context, in *ListCharacterCheckpointsRequest, opts ...grpc.CallOption) (*ListCharacterCheckpointsResponse, error)
+	RestoreCharacterCheckpoint(ctx context.Context, in *RestoreCharacterCheckpointRequest, opts ...grpc.CallOption) (*RestoreCharacterCheckpointResponse, error)
+	// What happened to one of the caller's characters, for activity feeds. Kept for 30 days.
+	GetMyActivity(ctx context.Context, in *GetMyActivityRequest, opts ...grpc.CallOption) (*GetMyActivityResponse, error)
+	// Titles and badges a character has unlocked through achievements and first discoveries.
+	// Any character's titles can be listed.
+	ListCharacterTitles(ctx context.Context, in *ListCharacterTitlesRequest, opts ...grpc.CallOption) (*ListCharacterTitlesResponse, error)
+	// Shows an unlocked title with one of the caller's characters; an empty title clears it
+	EquipTitle(ctx context.Context, in *EquipTitleRequest, opts ...grpc.CallOption) (*EquipTitleResponse, error)
+	// Renames one of the caller's characters. Names are unique in a world ignoring case, and
+	// a character can be renamed once per cooldown; the old name is kept in its history.
+	RenameCharacter(ctx context.Context, in *RenameCharacterRequest, opts ...grpc.CallOption) (*RenameCharacterResponse, error)
+	// The names a character has had. Any character's history can be listed.
+	ListCharacterNameHistory(ctx context.Context, in *ListCharacterNameHistoryRequest, opts ...grpc.CallOption) (*ListCharacterNameHistoryResponse, error)
 }
 
 type characterServiceClient struct {
@@ -97,6 +127,105 @@ func (c *characterServiceClient) MoveCharacter(ctx context.Context, in *MoveChar
 	return out, nil
 }
 
+func (c *characterServiceClient) StreamNearbyEvents(ctx context.Context, in *StreamNearbyEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[NearbyEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CharacterService_ServiceDesc.Streams[0], CharacterService_StreamNearbyEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamNearbyEventsRequest, NearbyEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CharacterService_StreamNearbyEventsClient = grpc.ServerStreamingClient[NearbyEvent]
+
+func (c *characterServiceClient) ResyncState(ctx context.Context, in *ResyncStateRequest, opts ...grpc.CallOption) (*ResyncStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResyncStateResponse)
+	err := c.cc.Invoke(ctx, CharacterService_ResyncState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *characterServiceClient) ListCharacterCheckpoints(ctx context.Context, in *ListCharacterCheckpointsRequest, opts ...grpc.CallOption) (*ListCharacterCheckpointsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCharacterCheckpointsResponse)
+	err := c.cc.Invoke(ctx, CharacterService_ListCharacterCheckpoints_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *characterServiceClient) RestoreCharacterCheckpoint(ctx context.Context, in *RestoreCharacterCheckpointRequest, opts ...grpc.CallOption) (*RestoreCharacterCheckpointResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreCharacterCheckpointResponse)
+	err := c.cc.Invoke(ctx, CharacterService_RestoreCharacterCheckpoint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *characterServiceClient) GetMyActivity(ctx context.Context, in *GetMyActivityRequest, opts ...grpc.CallOption) (*GetMyActivityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMyActivityResponse)
+	err := c.cc.Invoke(ctx, CharacterService_GetMyActivity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *characterServiceClient) ListCharacterTitles(ctx context.Context, in *ListCharacterTitlesRequest, opts ...grpc.CallOption) (*ListCharacterTitlesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCharacterTitlesResponse)
+	err := c.cc.Invoke(ctx, CharacterService_ListCharacterTitles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *characterServiceClient) EquipTitle(ctx context.Context, in *EquipTitleRequest, opts ...grpc.CallOption) (*EquipTitleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EquipTitleResponse)
+	err := c.cc.Invoke(ctx, CharacterService_EquipTitle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *characterServiceClient) RenameCharacter(ctx context.Context, in *RenameCharacterRequest, opts ...grpc.CallOption) (*RenameCharacterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenameCharacterResponse)
+	err := c.cc.Invoke(ctx, CharacterService_RenameCharacter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *characterServiceClient) ListCharacterNameHistory(ctx context.Context, in *ListCharacterNameHistoryRequest, opts ...grpc.CallOption) (*ListCharacterNameHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCharacterNameHistoryResponse)
+	err := c.cc.Invoke(ctx, CharacterService_ListCharacterNameHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CharacterServiceServer is the server API for CharacterService service.
 // All implementations must embed UnimplementedCharacterServiceServer
 // for forward compatibility.
@@ -108,6 +237,27 @@ type CharacterServiceServer interface {
 	DeleteCharacter(context.Context, *DeleteCharacterRequest) (*DeleteCharacterResponse, error)
 	// Character movement
 	MoveCharacter(context.Context, *MoveCharacterRequest) (*MoveCharacterResponse, error)
+	// Events around a character, filtered to its area of interest. The entities already in
+	// the area are sent first, nearest first.
+	StreamNearbyEvents(*StreamNearbyEventsRequest, grpc.ServerStreamingServer[NearbyEvent]) error
+	// Catch up after a disconnect: the events missed since a sequence number, or a snapshot
+	// of the area when they are no longer available
+	ResyncState(context.Context, *ResyncStateRequest) (*ResyncStateResponse, error)
+	// State checkpoints for support tooling (admin only)
+	ListCharacterCheckpoints(context.Context, *ListCharacterCheckpointsRequest) (*ListCharacterCheckpointsResponse, error)
+	RestoreCharacterCheckpoint(context.Context, *RestoreCharacterCheckpointRequest) (*RestoreCharacterCheckpointResponse, error)
+	// What happened to one of the caller's characters, for activity feeds. Kept for 30 days.
+	GetMyActivity(context.Context, *GetMyActivityRequest) (*GetMyActivityResponse, error)
+	// Titles and badges a character has unlocked through achievements and first discoveries.
+	// Any character's titles can be listed.
+	ListCharacterTitles(context.Context, *ListCharacterTitlesRequest) (*ListCharacterTitlesResponse, error)
+	// Shows an unlocked title with one of the caller's characters; an empty title clears it
+	EquipTitle(context.Context, *EquipTitleRequest) (*EquipTitleResponse, error)
+	// Renames one of the caller's characters. Names are unique in a world ignoring case, and
+	// a character can be renamed once per cooldown; the old name is kept in its history.
+	RenameCharacter(context.Context, *RenameCharacterRequest) (*RenameCharacterResponse, error)
+	// The names a character has had. Any character's history can be listed.
+	ListCharacterNameHistory(context.Context, *ListCharacterNameHistoryRequest) (*ListCharacterNameHistoryResponse, error)
 	mustEmbedUnimplementedCharacterServiceServer()
 }
 
@@ -133,6 +283,33 @@ func (UnimplementedCharacterServiceServer) DeleteCharacter(context.Context, *Del
 func (UnimplementedCharacterServiceServer) MoveCharacter(context.Context, *MoveCharacterRequest) (*MoveCharacterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MoveCharacter not implemented")
 }
+func (UnimplementedCharacterServiceServer) StreamNearbyEvents(*StreamNearbyEventsRequest, grpc.ServerStreamingServer[NearbyEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamNearbyEvents not implemented")
+}
+func (UnimplementedCharacterServiceServer) ResyncState(context.Context, *ResyncStateRequest) (*ResyncStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResyncState not implemented")
+}
+func (UnimplementedCharacterServiceServer) ListCharacterCheckpoints(context.Context, *ListCharacterCheckpointsRequest) (*ListCharacterCheckpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCharacterCheckpoints not implemented")
+}
+func (UnimplementedCharacterServiceServer) RestoreCharacterCheckpoint(context.Context, *RestoreCharacterCheckpointRequest) (*RestoreCharacterCheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCharacterCheckpoint not implemented")
+}
+func (UnimplementedCharacterServiceServer) GetMyActivity(context.Context, *GetMyActivityRequest) (*GetMyActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMyActivity not implemented")
+}
+func (UnimplementedCharacterServiceServer) ListCharacterTitles(context.Context, *ListCharacterTitlesRequest) (*ListCharacterTitlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCharacterTitles not implemented")
+}
+func (UnimplementedCharacterServiceServer) EquipTitle(context.Context, *EquipTitleRequest) (*EquipTitleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EquipTitle not implemented")
+}
+func (UnimplementedCharacterServiceServer) RenameCharacter(context.Context, *RenameCharacterRequest) (*RenameCharacterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameCharacter not implemented")
+}
+func (UnimplementedCharacterServiceServer) ListCharacterNameHistory(context.Context, *ListCharacterNameHistoryRequest) (*ListCharacterNameHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCharacterNameHistory not implemented")
+}
 func (UnimplementedCharacterServiceServer) mustEmbedUnimplementedCharacterServiceServer() {}
 func (UnimplementedCharacterServiceServer) testEmbeddedByValue()                          {}
 
@@ -244,6 +421,161 @@ func _CharacterService_MoveCharacter_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CharacterService_StreamNearbyEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamNearbyEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CharacterServiceServer).StreamNearbyEvents(m, &grpc.GenericServerStream[StreamNearbyEventsRequest, NearbyEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CharacterService_StreamNearbyEventsServer = grpc.ServerStreamingServer[NearbyEvent]
+
+func _CharacterService_ResyncState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResyncStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CharacterServiceServer).ResyncState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CharacterService_ResyncState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CharacterServiceServer).ResyncState(ctx, req.(*ResyncStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CharacterService_ListCharacterCheckpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCharacterCheckpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CharacterServiceServer).ListCharacterCheckpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CharacterService_ListCharacterCheckpoints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CharacterServiceServer).ListCharacterCheckpoints(ctx, req.(*ListCharacterCheckpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CharacterService_RestoreCharacterCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCharacterCheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CharacterServiceServer).RestoreCharacterCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CharacterService_RestoreCharacterCheckpoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CharacterServiceServer).RestoreCharacterCheckpoint(ctx, req.(*RestoreCharacterCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CharacterService_GetMyActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CharacterServiceServer).GetMyActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CharacterService_GetMyActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CharacterServiceServer).GetMyActivity(ctx, req.(*GetMyActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CharacterService_ListCharacterTitles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCharacterTitlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CharacterServiceServer).ListCharacterTitles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CharacterService_ListCharacterTitles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CharacterServiceServer).ListCharacterTitles(ctx, req.(*ListCharacterTitlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CharacterService_EquipTitle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EquipTitleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CharacterServiceServer).EquipTitle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CharacterService_EquipTitle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CharacterServiceServer).EquipTitle(ctx, req.(*EquipTitleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CharacterService_RenameCharacter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameCharacterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CharacterServiceServer).RenameCharacter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CharacterService_RenameCharacter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CharacterServiceServer).RenameCharacter(ctx, req.(*RenameCharacterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CharacterService_ListCharacterNameHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCharacterNameHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CharacterServiceServer).ListCharacterNameHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CharacterService_ListCharacterNameHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CharacterServiceServer).ListCharacterNameHistory(ctx, req.(*ListCharacterNameHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CharacterService_ServiceDesc is the grpc.ServiceDesc for CharacterService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -271,7 +603,45 @@ var CharacterService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MoveCharacter",
 			Handler:    _CharacterService_MoveCharacter_Handler,
 		},
+		{
+			MethodName: "ResyncState",
+			Handler:    _CharacterService_ResyncState_Handler,
+		},
+		{
+			MethodName: "ListCharacterCheckpoints",
+			Handler:    _CharacterService_ListCharacterCheckpoints_Handler,
+		},
+		{
+			MethodName: "RestoreCharacterCheckpoint",
+			Handler:    _CharacterService_RestoreCharacterCheckpoint_Handler,
+		},
+		{
+			MethodName: "GetMyActivity",
+			Handler:    _CharacterService_GetMyActivity_Handler,
+		},
+		{
+			MethodName: "ListCharacterTitles",
+			Handler:    _CharacterService_ListCharacterTitles_Handler,
+		},
+		{
+			MethodName: "EquipTitle",
+			Handler:    _CharacterService_EquipTitle_Handler,
+		},
+		{
+			MethodName: "RenameCharacter",
+			Handler:    _CharacterService_RenameCharacter_Handler,
+		},
+		{
+			MethodName: "ListCharacterNameHistory",
+			Handler:    _CharacterService_ListCharacterNameHistory_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamNearbyEvents",
+			Handler:       _CharacterService_StreamNearbyEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "character/v1/character.proto",
 }
@@ -7,9 +7,13 @@
 package v1
 
 import (
+	v12 "github.com/VoidMesh/api/api/proto/character/v1"
+	v11 "github.com/VoidMesh/api/api/proto/chunk/v1"
 	v1 "github.com/VoidMesh/api/api/proto/inventory/v1"
+	v13 "github.com/VoidMesh/api/api/proto/stream/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -27,8 +31,12 @@ type HarvestResourceRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	CharacterId    string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
 	ResourceNodeId int32                  `protobuf:"varint,2,opt,name=resource_node_id,json=resourceNodeId,proto3" json:"resource_node_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// When the player completed the harvest, on the client clock. Credited within the
+	// session's latency allowance as for FishingService.Hook; without it the estimated
+	// one-way latency is assumed.
+	ClientTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=client_time,json=clientTime,proto3" json:"client_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *HarvestResourceRequest) Reset() {
@@ -75,6 +83,13 @@ func (x *HarvestResourceRequest) GetResourceNodeId() int32 {
 	return 0
 }
 
+func (x *HarvestResourceRequest) GetClientTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClientTime
+	}
+	return nil
+}
+
 type HarvestResourceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -144,12 +159,14 @@ func (x *HarvestResourceResponse) GetUpdatedItem() *v1.InventoryItem {
 }
 
 type HarvestResult struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	ItemName        string                 `protobuf:"bytes,1,opt,name=item_name,json=itemName,proto3" json:"item_name,omitempty"`
-	Quantity        int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	IsSecondaryDrop bool                   `protobuf:"varint,3,opt,name=is_secondary_drop,json=isSecondaryDrop,proto3" json:"is_secondary_drop,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ItemName         string                 `protobuf:"bytes,1,opt,name=item_name,json=itemName,proto3" json:"item_name,omitempty"`
+	Quantity         int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"` // Including any overflow
+	IsSecondaryDrop  bool                   `protobuf:"varint,3,opt,name=is_secondary_drop,json=isSecondaryDrop,proto3" json:"is_secondary_drop,omitempty"`
+	OverflowQuantity int32                  `protobuf:"varint,4,opt,name=overflow_quantity,json=overflowQuantity,proto3" json:"overflow_quantity,omitempty"`                            // Did not fit in the inventory
+	OverflowPolicy   v1.OverflowPolicy      `protobuf:"varint,5,opt,name=overflow_policy,json=overflowPolicy,proto3,enum=inventory.v1.OverflowPolicy" json:"overflow_policy,omitempty"` // Where the overflow went: GROUND or INBOX
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *HarvestResult) Reset() {
@@ -203,25 +220,696 @@ func (x *HarvestResult) GetIsSecondaryDrop() bool {
 	return false
 }
 
+func (x *HarvestResult) GetOverflowQuantity() int32 {
+	if x != nil {
+		return x.OverflowQuantity
+	}
+	return 0
+}
+
+func (x *HarvestResult) GetOverflowPolicy() v1.OverflowPolicy {
+	if x != nil {
+		return x.OverflowPolicy
+	}
+	return v1.OverflowPolicy(0)
+}
+
+// Change a cell within one cell of the character. Allowed changes: grass <-> dirt, sand <-> water.
+type ModifyTerrainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	X             int32                  `protobuf:"varint,2,opt,name=x,proto3" json:"x,omitempty"` // World cell coordinates
+	Y             int32                  `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
+	TerrainType   v11.TerrainType        `protobuf:"varint,4,opt,name=terrain_type,json=terrainType,proto3,enum=chunk.v1.TerrainType" json:"terrain_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModifyTerrainRequest) Reset() {
+	*x = ModifyTerrainRequest{}
+	mi := &file_character_actions_v1_character_actions_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModifyTerrainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyTerrainRequest) ProtoMessage() {}
+
+func (x *ModifyTerrainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_character_actions_v1_character_actions_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyTerrainRequest.ProtoReflect.Descriptor instead.
+func (*ModifyTerrainRequest) Descriptor() ([]byte, []int) {
+	return file_character_actions_v1_character_actions_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ModifyTerrainRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *ModifyTerrainRequest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *ModifyTerrainRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *ModifyTerrainRequest) GetTerrainType() v11.TerrainType {
+	if x != nil {
+		return x.TerrainType
+	}
+	return v11.TerrainType(0)
+}
+
+type ModifyTerrainResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	PreviousTerrainType v11.TerrainType        `protobuf:"varint,1,opt,name=previous_terrain_type,json=previousTerrainType,proto3,enum=chunk.v1.TerrainType" json:"previous_terrain_type,omitempty"`
+	TerrainType         v11.TerrainType        `protobuf:"varint,2,opt,name=terrain_type,json=terrainType,proto3,enum=chunk.v1.TerrainType" json:"terrain_type,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ModifyTerrainResponse) Reset() {
+	*x = ModifyTerrainResponse{}
+	mi := &file_character_actions_v1_character_actions_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModifyTerrainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyTerrainResponse) ProtoMessage() {}
+
+func (x *ModifyTerrainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_character_actions_v1_character_actions_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyTerrainResponse.ProtoReflect.Descriptor instead.
+func (*ModifyTerrainResponse) Descriptor() ([]byte, []int) {
+	return file_character_actions_v1_character_actions_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ModifyTerrainResponse) GetPreviousTerrainType() v11.TerrainType {
+	if x != nil {
+		return x.PreviousTerrainType
+	}
+	return v11.TerrainType(0)
+}
+
+func (x *ModifyTerrainResponse) GetTerrainType() v11.TerrainType {
+	if x != nil {
+		return x.TerrainType
+	}
+	return v11.TerrainType(0)
+}
+
+type MoveAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NewX          int32                  `protobuf:"varint,1,opt,name=new_x,json=newX,proto3" json:"new_x,omitempty"`
+	NewY          int32                  `protobuf:"varint,2,opt,name=new_y,json=newY,proto3" json:"new_y,omitempty"`
+	Facing        v12.Facing             `protobuf:"varint,3,opt,name=facing,proto3,enum=character.v1.Facing" json:"facing,omitempty"` // As in MoveCharacterRequest
+	ActionState   v12.ActionState        `protobuf:"varint,4,opt,name=action_state,json=actionState,proto3,enum=character.v1.ActionState" json:"action_state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveAction) Reset() {
+	*x = MoveAction{}
+	mi := &file_character_actions_v1_character_actions_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveAction) ProtoMessage() {}
+
+func (x *MoveAction) ProtoReflect() protoreflect.Message {
+	mi := &file_character_actions_v1_character_actions_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveAction.ProtoReflect.Descriptor instead.
+func (*MoveAction) Descriptor() ([]byte, []int) {
+	return file_character_actions_v1_character_actions_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MoveAction) GetNewX() int32 {
+	if x != nil {
+		return x.NewX
+	}
+	return 0
+}
+
+func (x *MoveAction) GetNewY() int32 {
+	if x != nil {
+		return x.NewY
+	}
+	return 0
+}
+
+func (x *MoveAction) GetFacing() v12.Facing {
+	if x != nil {
+		return x.Facing
+	}
+	return v12.Facing(0)
+}
+
+func (x *MoveAction) GetActionState() v12.ActionState {
+	if x != nil {
+		return x.ActionState
+	}
+	return v12.ActionState(0)
+}
+
+type HarvestAction struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ResourceNodeId int32                  `protobuf:"varint,1,opt,name=resource_node_id,json=resourceNodeId,proto3" json:"resource_node_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *HarvestAction) Reset() {
+	*x = HarvestAction{}
+	mi := &file_character_actions_v1_character_actions_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HarvestAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HarvestAction) ProtoMessage() {}
+
+func (x *HarvestAction) ProtoReflect() protoreflect.Message {
+	mi := &file_character_actions_v1_character_actions_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HarvestAction.ProtoReflect.Descriptor instead.
+func (*HarvestAction) Descriptor() ([]byte, []int) {
+	return file_character_actions_v1_character_actions_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *HarvestAction) GetResourceNodeId() int32 {
+	if x != nil {
+		return x.ResourceNodeId
+	}
+	return 0
+}
+
+// Reserved for combat: characters have no health yet, so attacks always fail
+type AttackAction struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TargetCharacterId string                 `protobuf:"bytes,1,opt,name=target_character_id,json=targetCharacterId,proto3" json:"target_character_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AttackAction) Reset() {
+	*x = AttackAction{}
+	mi := &file_character_actions_v1_character_actions_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttackAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttackAction) ProtoMessage() {}
+
+func (x *AttackAction) ProtoReflect() protoreflect.Message {
+	mi := &file_character_actions_v1_character_actions_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttackAction.ProtoReflect.Descriptor instead.
+func (*AttackAction) Descriptor() ([]byte, []int) {
+	return file_character_actions_v1_character_actions_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AttackAction) GetTargetCharacterId() string {
+	if x != nil {
+		return x.TargetCharacterId
+	}
+	return ""
+}
+
+// Queue an action; it is processed on a later server tick, one action per character per tick
+type EnqueueActionRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	// Types that are valid to be assigned to Action:
+	//
+	//	*EnqueueActionRequest_Move
+	//	*EnqueueActionRequest_Harvest
+	//	*EnqueueActionRequest_Attack
+	Action        isEnqueueActionRequest_Action `protobuf_oneof:"action"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnqueueActionRequest) Reset() {
+	*x = EnqueueActionRequest{}
+	mi := &file_character_actions_v1_character_actions_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnqueueActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnqueueActionRequest) ProtoMessage() {}
+
+func (x *EnqueueActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_character_actions_v1_character_actions_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnqueueActionRequest.ProtoReflect.Descriptor instead.
+func (*EnqueueActionRequest) Descriptor() ([]byte, []int) {
+	return file_character_actions_v1_character_actions_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *EnqueueActionRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *EnqueueActionRequest) GetAction() isEnqueueActionRequest_Action {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+func (x *EnqueueActionRequest) GetMove() *MoveAction {
+	if x != nil {
+		if x, ok := x.Action.(*EnqueueActionRequest_Move); ok {
+			return x.Move
+		}
+	}
+	return nil
+}
+
+func (x *EnqueueActionRequest) GetHarvest() *HarvestAction {
+	if x != nil {
+		if x, ok := x.Action.(*EnqueueActionRequest_Harvest); ok {
+			return x.Harvest
+		}
+	}
+	return nil
+}
+
+func (x *EnqueueActionRequest) GetAttack() *AttackAction {
+	if x != nil {
+		if x, ok := x.Action.(*EnqueueActionRequest_Attack); ok {
+			return x.Attack
+		}
+	}
+	return nil
+}
+
+type isEnqueueActionRequest_Action interface {
+	isEnqueueActionRequest_Action()
+}
+
+type EnqueueActionRequest_Move struct {
+	Move *MoveAction `protobuf:"bytes,2,opt,name=move,proto3,oneof"`
+}
+
+type EnqueueActionRequest_Harvest struct {
+	Harvest *HarvestAction `protobuf:"bytes,3,opt,name=harvest,proto3,oneof"`
+}
+
+type EnqueueActionRequest_Attack struct {
+	Attack *AttackAction `protobuf:"bytes,4,opt,name=attack,proto3,oneof"`
+}
+
+func (*EnqueueActionRequest_Move) isEnqueueActionRequest_Action() {}
+
+func (*EnqueueActionRequest_Harvest) isEnqueueActionRequest_Action() {}
+
+func (*EnqueueActionRequest_Attack) isEnqueueActionRequest_Action() {}
+
+type EnqueueActionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActionId      uint64                 `protobuf:"varint,1,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
+	QueuePosition int32                  `protobuf:"varint,2,opt,name=queue_position,json=queuePosition,proto3" json:"queue_position,omitempty"` // 1 means it runs on the next tick
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnqueueActionResponse) Reset() {
+	*x = EnqueueActionResponse{}
+	mi := &file_character_actions_v1_character_actions_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnqueueActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnqueueActionResponse) ProtoMessage() {}
+
+func (x *EnqueueActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_character_actions_v1_character_actions_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnqueueActionResponse.ProtoReflect.Descriptor instead.
+func (*EnqueueActionResponse) Descriptor() ([]byte, []int) {
+	return file_character_actions_v1_character_actions_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EnqueueActionResponse) GetActionId() uint64 {
+	if x != nil {
+		return x.ActionId
+	}
+	return 0
+}
+
+func (x *EnqueueActionResponse) GetQueuePosition() int32 {
+	if x != nil {
+		return x.QueuePosition
+	}
+	return 0
+}
+
+// Subscribe to results of a character's queued actions
+type StreamActionResultsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	Resume        *v13.StreamResume      `protobuf:"bytes,2,opt,name=resume,proto3" json:"resume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamActionResultsRequest) Reset() {
+	*x = StreamActionResultsRequest{}
+	mi := &file_character_actions_v1_character_actions_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamActionResultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamActionResultsRequest) ProtoMessage() {}
+
+func (x *StreamActionResultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_character_actions_v1_character_actions_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamActionResultsRequest.ProtoReflect.Descriptor instead.
+func (*StreamActionResultsRequest) Descriptor() ([]byte, []int) {
+	return file_character_actions_v1_character_actions_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StreamActionResultsRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *StreamActionResultsRequest) GetResume() *v13.StreamResume {
+	if x != nil {
+		return x.Resume
+	}
+	return nil
+}
+
+type ActionResult struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ActionId     uint64                 `protobuf:"varint,1,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
+	CharacterId  string                 `protobuf:"bytes,2,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	Tick         uint64                 `protobuf:"varint,3,opt,name=tick,proto3" json:"tick,omitempty"`
+	Success      bool                   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage string                 `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	ProcessedAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=processed_at,json=processedAt,proto3" json:"processed_at,omitempty"`
+	// Move results
+	Character *v12.Character `protobuf:"bytes,7,opt,name=character,proto3" json:"character,omitempty"`
+	// Harvest results
+	HarvestResults []*HarvestResult  `protobuf:"bytes,8,rep,name=harvest_results,json=harvestResults,proto3" json:"harvest_results,omitempty"`
+	UpdatedItem    *v1.InventoryItem `protobuf:"bytes,9,opt,name=updated_item,json=updatedItem,proto3" json:"updated_item,omitempty"`
+	Stream         *v13.StreamInfo   `protobuf:"bytes,10,opt,name=stream,proto3" json:"stream,omitempty"` // Set when sent by StreamActionResults
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ActionResult) Reset() {
+	*x = ActionResult{}
+	mi := &file_character_actions_v1_character_actions_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActionResult) ProtoMessage() {}
+
+func (x *ActionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_character_actions_v1_character_actions_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActionResult.ProtoReflect.Descriptor instead.
+func (*ActionResult) Descriptor() ([]byte, []int) {
+	return file_character_actions_v1_character_actions_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ActionResult) GetActionId() uint64 {
+	if x != nil {
+		return x.ActionId
+	}
+	return 0
+}
+
+func (x *ActionResult) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *ActionResult) GetTick() uint64 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+func (x *ActionResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ActionResult) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *ActionResult) GetProcessedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ProcessedAt
+	}
+	return nil
+}
+
+func (x *ActionResult) GetCharacter() *v12.Character {
+	if x != nil {
+		return x.Character
+	}
+	return nil
+}
+
+func (x *ActionResult) GetHarvestResults() []*HarvestResult {
+	if x != nil {
+		return x.HarvestResults
+	}
+	return nil
+}
+
+func (x *ActionResult) GetUpdatedItem() *v1.InventoryItem {
+	if x != nil {
+		return x.UpdatedItem
+	}
+	return nil
+}
+
+func (x *ActionResult) GetStream() *v13.StreamInfo {
+	if x != nil {
+		return x.Stream
+	}
+	return nil
+}
+
 var File_character_actions_v1_character_actions_proto protoreflect.FileDescriptor
 
 const file_character_actions_v1_character_actions_proto_rawDesc = "" +
 	"\n" +
-	",character_actions/v1/character_actions.proto\x12\x14character_actions.v1\x1a\x1cinventory/v1/inventory.proto\"e\n" +
+	",character_actions/v1/character_actions.proto\x12\x14character_actions.v1\x1a\x1ccharacter/v1/character.proto\x1a\x14chunk/v1/chunk.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cinventory/v1/inventory.proto\x1a\x16stream/v1/stream.proto\"\xa2\x01\n" +
 	"\x16HarvestResourceRequest\x12!\n" +
 	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12(\n" +
-	"\x10resource_node_id\x18\x02 \x01(\x05R\x0eresourceNodeId\"\xd7\x01\n" +
+	"\x10resource_node_id\x18\x02 \x01(\x05R\x0eresourceNodeId\x12;\n" +
+	"\vclient_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"clientTime\"\xd7\x01\n" +
 	"\x17HarvestResourceResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
 	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\x12=\n" +
 	"\aresults\x18\x03 \x03(\v2#.character_actions.v1.HarvestResultR\aresults\x12>\n" +
-	"\fupdated_item\x18\x04 \x01(\v2\x1b.inventory.v1.InventoryItemR\vupdatedItem\"t\n" +
+	"\fupdated_item\x18\x04 \x01(\v2\x1b.inventory.v1.InventoryItemR\vupdatedItem\"\xe8\x01\n" +
 	"\rHarvestResult\x12\x1b\n" +
 	"\titem_name\x18\x01 \x01(\tR\bitemName\x12\x1a\n" +
 	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12*\n" +
-	"\x11is_secondary_drop\x18\x03 \x01(\bR\x0fisSecondaryDrop2\x8b\x01\n" +
+	"\x11is_secondary_drop\x18\x03 \x01(\bR\x0fisSecondaryDrop\x12+\n" +
+	"\x11overflow_quantity\x18\x04 \x01(\x05R\x10overflowQuantity\x12E\n" +
+	"\x0foverflow_policy\x18\x05 \x01(\x0e2\x1c.inventory.v1.OverflowPolicyR\x0eoverflowPolicy\"\x8f\x01\n" +
+	"\x14ModifyTerrainRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\f\n" +
+	"\x01x\x18\x02 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x03 \x01(\x05R\x01y\x128\n" +
+	"\fterrain_type\x18\x04 \x01(\x0e2\x15.chunk.v1.TerrainTypeR\vterrainType\"\x9c\x01\n" +
+	"\x15ModifyTerrainResponse\x12I\n" +
+	"\x15previous_terrain_type\x18\x01 \x01(\x0e2\x15.chunk.v1.TerrainTypeR\x13previousTerrainType\x128\n" +
+	"\fterrain_type\x18\x02 \x01(\x0e2\x15.chunk.v1.TerrainTypeR\vterrainType\"\xa2\x01\n" +
+	"\n" +
+	"MoveAction\x12\x13\n" +
+	"\x05new_x\x18\x01 \x01(\x05R\x04newX\x12\x13\n" +
+	"\x05new_y\x18\x02 \x01(\x05R\x04newY\x12,\n" +
+	"\x06facing\x18\x03 \x01(\x0e2\x14.character.v1.FacingR\x06facing\x12<\n" +
+	"\faction_state\x18\x04 \x01(\x0e2\x19.character.v1.ActionStateR\vactionState\"9\n" +
+	"\rHarvestAction\x12(\n" +
+	"\x10resource_node_id\x18\x01 \x01(\x05R\x0eresourceNodeId\">\n" +
+	"\fAttackAction\x12.\n" +
+	"\x13target_character_id\x18\x01 \x01(\tR\x11targetCharacterId\"\xfa\x01\n" +
+	"\x14EnqueueActionRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x126\n" +
+	"\x04move\x18\x02 \x01(\v2 .character_actions.v1.MoveActionH\x00R\x04move\x12?\n" +
+	"\aharvest\x18\x03 \x01(\v2#.character_actions.v1.HarvestActionH\x00R\aharvest\x12<\n" +
+	"\x06attack\x18\x04 \x01(\v2\".character_actions.v1.AttackActionH\x00R\x06attackB\b\n" +
+	"\x06action\"[\n" +
+	"\x15EnqueueActionResponse\x12\x1b\n" +
+	"\taction_id\x18\x01 \x01(\x04R\bactionId\x12%\n" +
+	"\x0equeue_position\x18\x02 \x01(\x05R\rqueuePosition\"p\n" +
+	"\x1aStreamActionResultsRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12/\n" +
+	"\x06resume\x18\x02 \x01(\v2\x17.stream.v1.StreamResumeR\x06resume\"\xd4\x03\n" +
+	"\fActionResult\x12\x1b\n" +
+	"\taction_id\x18\x01 \x01(\x04R\bactionId\x12!\n" +
+	"\fcharacter_id\x18\x02 \x01(\tR\vcharacterId\x12\x12\n" +
+	"\x04tick\x18\x03 \x01(\x04R\x04tick\x12\x18\n" +
+	"\asuccess\x18\x04 \x01(\bR\asuccess\x12#\n" +
+	"\rerror_message\x18\x05 \x01(\tR\ferrorMessage\x12=\n" +
+	"\fprocessed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vprocessedAt\x125\n" +
+	"\tcharacter\x18\a \x01(\v2\x17.character.v1.CharacterR\tcharacter\x12L\n" +
+	"\x0fharvest_results\x18\b \x03(\v2#.character_actions.v1.HarvestResultR\x0eharvestResults\x12>\n" +
+	"\fupdated_item\x18\t \x01(\v2\x1b.inventory.v1.InventoryItemR\vupdatedItem\x12-\n" +
+	"\x06stream\x18\n" +
+	" \x01(\v2\x15.stream.v1.StreamInfoR\x06stream2\xd4\x03\n" +
 	"\x17CharacterActionsService\x12p\n" +
-	"\x0fHarvestResource\x12,.character_actions.v1.HarvestResourceRequest\x1a-.character_actions.v1.HarvestResourceResponse\"\x00B8Z6github.com/VoidMesh/api/api/proto/character_actions/v1b\x06proto3"
+	"\x0fHarvestResource\x12,.character_actions.v1.HarvestResourceRequest\x1a-.character_actions.v1.HarvestResourceResponse\"\x00\x12j\n" +
+	"\rModifyTerrain\x12*.character_actions.v1.ModifyTerrainRequest\x1a+.character_actions.v1.ModifyTerrainResponse\"\x00\x12j\n" +
+	"\rEnqueueAction\x12*.character_actions.v1.EnqueueActionRequest\x1a+.character_actions.v1.EnqueueActionResponse\"\x00\x12o\n" +
+	"\x13StreamActionResults\x120.character_actions.v1.StreamActionResultsRequest\x1a\".character_actions.v1.ActionResult\"\x000\x01B8Z6github.com/VoidMesh/api/api/proto/character_actions/v1b\x06proto3"
 
 var (
 	file_character_actions_v1_character_actions_proto_rawDescOnce sync.Once
@@ -235,23 +923,62 @@ func file_character_actions_v1_character_actions_proto_rawDescGZIP() []byte {
 	return file_character_actions_v1_character_actions_proto_rawDescData
 }
 
-var file_character_actions_v1_character_actions_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_character_actions_v1_character_actions_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_character_actions_v1_character_actions_proto_goTypes = []any{
-	(*HarvestResourceRequest)(nil),  // 0: character_actions.v1.HarvestResourceRequest
-	(*HarvestResourceResponse)(nil), // 1: character_actions.v1.HarvestResourceResponse
-	(*HarvestResult)(nil),           // 2: character_actions.v1.HarvestResult
-	(*v1.InventoryItem)(nil),        // 3: inventory.v1.InventoryItem
+	(*HarvestResourceRequest)(nil),     // 0: character_actions.v1.HarvestResourceRequest
+	(*HarvestResourceResponse)(nil),    // 1: character_actions.v1.HarvestResourceResponse
+	(*HarvestResult)(nil),              // 2: character_actions.v1.HarvestResult
+	(*ModifyTerrainRequest)(nil),       // 3: character_actions.v1.ModifyTerrainRequest
+	(*ModifyTerrainResponse)(nil),      // 4: character_actions.v1.ModifyTerrainResponse
+	(*MoveAction)(nil),                 // 5: character_actions.v1.MoveAction
+	(*HarvestAction)(nil),              // 6: character_actions.v1.HarvestAction
+	(*AttackAction)(nil),               // 7: character_actions.v1.AttackAction
+	(*EnqueueActionRequest)(nil),       // 8: character_actions.v1.EnqueueActionRequest
+	(*EnqueueActionResponse)(nil),      // 9: character_actions.v1.EnqueueActionResponse
+	(*StreamActionResultsRequest)(nil), // 10: character_actions.v1.StreamActionResultsRequest
+	(*ActionResult)(nil),               // 11: character_actions.v1.ActionResult
+	(*timestamppb.Timestamp)(nil),      // 12: google.protobuf.Timestamp
+	(*v1.InventoryItem)(nil),           // 13: inventory.v1.InventoryItem
+	(v1.OverflowPolicy)(0),             // 14: inventory.v1.OverflowPolicy
+	(v11.TerrainType)(0),               // 15: chunk.v1.TerrainType
+	(v12.Facing)(0),                    // 16: character.v1.Facing
+	(v12.ActionState)(0),               // 17: character.v1.ActionState
+	(*v13.StreamResume)(nil),           // 18: stream.v1.StreamResume
+	(*v12.Character)(nil),              // 19: character.v1.Character
+	(*v13.StreamInfo)(nil),             // 20: stream.v1.StreamInfo
 }
 var file_character_actions_v1_character_actions_proto_depIdxs = []int32{
-	2, // 0: character_actions.v1.HarvestResourceResponse.results:type_name -> character_actions.v1.HarvestResult
-	3, // 1: character_actions.v1.HarvestResourceResponse.updated_item:type_name -> inventory.v1.InventoryItem
-	0, // 2: character_actions.v1.CharacterActionsService.HarvestResource:input_type -> character_actions.v1.HarvestResourceRequest
-	1, // 3: character_actions.v1.CharacterActionsService.HarvestResource:output_type -> character_actions.v1.HarvestResourceResponse
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	12, // 0: character_actions.v1.HarvestResourceRequest.client_time:type_name -> google.protobuf.Timestamp
+	2,  // 1: character_actions.v1.HarvestResourceResponse.results:type_name -> character_actions.v1.HarvestResult
+	13, // 2: character_actions.v1.HarvestResourceResponse.updated_item:type_name -> inventory.v1.InventoryItem
+	14, // 3: character_actions.v1.HarvestResult.overflow_policy:type_name -> inventory.v1.OverflowPolicy
+	15, // 4: character_actions.v1.ModifyTerrainRequest.terrain_type:type_name -> chunk.v1.TerrainType
+	15, // 5: character_actions.v1.ModifyTerrainResponse.previous_terrain_type:type_name -> chunk.v1.TerrainType
+	15, // 6: character_actions.v1.ModifyTerrainResponse.terrain_type:type_name -> chunk.v1.TerrainType
+	16, // 7: character_actions.v1.MoveAction.facing:type_name -> character.v1.Facing
+	17, // 8: character_actions.v1.MoveAction.action_state:type_name -> character.v1.ActionState
+	5,  // 9: character_actions.v1.EnqueueActionRequest.move:type_name -> character_actions.v1.MoveAction
+	6,  // 10: character_actions.v1.EnqueueActionRequest.harvest:type_name -> character_actions.v1.HarvestAction
+	7,  // 11: character_actions.v1.EnqueueActionRequest.attack:type_name -> character_actions.v1.AttackAction
+	18, // 12: character_actions.v1.StreamActionResultsRequest.resume:type_name -> stream.v1.StreamResume
+	12, // 13: character_actions.v1.ActionResult.processed_at:type_name -> google.protobuf.Timestamp
+	19, // 14: character_actions.v1.ActionResult.character:type_name -> character.v1.Character
+	2,  // 15: character_actions.v1.ActionResult.harvest_results:type_name -> character_actions.v1.HarvestResult
+	13, // 16: character_actions.v1.ActionResult.updated_item:type_name -> inventory.v1.InventoryItem
+	20, // 17: character_actions.v1.ActionResult.stream:type_name -> stream.v1.StreamInfo
+	0,  // 18: character_actions.v1.CharacterActionsService.HarvestResource:input_type -> character_actions.v1.HarvestResourceRequest
+	3,  // 19: character_actions.v1.CharacterActionsService.ModifyTerrain:input_type -> character_actions.v1.ModifyTerrainRequest
+	8,  // 20: character_actions.v1.CharacterActionsService.EnqueueAction:input_type -> character_actions.v1.EnqueueActionRequest
+	10, // 21: character_actions.v1.CharacterActionsService.StreamActionResults:input_type -> character_actions.v1.StreamActionResultsRequest
+	1,  // 22: character_actions.v1.CharacterActionsService.HarvestResource:output_type -> character_actions.v1.HarvestResourceResponse
+	4,  // 23: character_actions.v1.CharacterActionsService.ModifyTerrain:output_type -> character_actions.v1.ModifyTerrainResponse
+	9,  // 24: character_actions.v1.CharacterActionsService.EnqueueAction:output_type -> character_actions.v1.EnqueueActionResponse
+	11, // 25: character_actions.v1.CharacterActionsService.StreamActionResults:output_type -> character_actions.v1.ActionResult
+	22, // [22:26] is the sub-list for method output_type
+	18, // [18:22] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_character_actions_v1_character_actions_proto_init() }
@@ -259,13 +986,18 @@ func file_character_actions_v1_character_actions_proto_init() {
 	if File_character_actions_v1_character_actions_proto != nil {
 		return
 	}
+	file_character_actions_v1_character_actions_proto_msgTypes[8].OneofWrappers = []any{
+		(*EnqueueActionRequest_Move)(nil),
+		(*EnqueueActionRequest_Harvest)(nil),
+		(*EnqueueActionRequest_Attack)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_character_actions_v1_character_actions_proto_rawDesc), len(file_character_actions_v1_character_actions_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
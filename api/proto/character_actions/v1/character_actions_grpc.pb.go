@@ -19,7 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CharacterActionsService_HarvestResource_FullMethodName = "/character_actions.v1.CharacterActionsService/HarvestResource"
+	CharacterActionsService_HarvestResource_FullMethodName     = "/character_actions.v1.CharacterActionsService/HarvestResource"
+	CharacterActionsService_ModifyTerrain_FullMethodName       = "/character_actions.v1.CharacterActionsService/ModifyTerrain"
+	CharacterActionsService_EnqueueAction_FullMethodName       = "/character_actions.v1.CharacterActionsService/EnqueueAction"
+	CharacterActionsService_StreamActionResults_FullMethodName = "/character_actions.v1.CharacterActionsService/StreamActionResults"
 )
 
 // CharacterActionsServiceClient is the client API for CharacterActionsService service.
@@ -28,6 +31,11 @@ const (
 type CharacterActionsServiceClient interface {
 	// Resource harvesting
 	HarvestResource(ctx context.Context, in *HarvestResourceRequest, opts ...grpc.CallOption) (*HarvestResourceResponse, error)
+	// Terrain modification (dig, fill, till) of a cell next to the character
+	ModifyTerrain(ctx context.Context, in *ModifyTerrainRequest, opts ...grpc.CallOption) (*ModifyTerrainResponse, error)
+	// Tick-processed action queue
+	EnqueueAction(ctx context.Context, in *EnqueueActionRequest, opts ...grpc.CallOption) (*EnqueueActionResponse, error)
+	StreamActionResults(ctx context.Context, in *StreamActionResultsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ActionResult], error)
 }
 
 type characterActionsServiceClient struct {
@@ -48,12 +56,56 @@ func (c *characterActionsServiceClient) HarvestResource(ctx context.Context, in
 	return out, nil
 }
 
+func (c *characterActionsServiceClient) ModifyTerrain(ctx context.Context, in *ModifyTerrainRequest, opts ...grpc.CallOption) (*ModifyTerrainResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModifyTerrainResponse)
+	err := c.cc.Invoke(ctx, CharacterActionsService_ModifyTerrain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *characterActionsServiceClient) EnqueueAction(ctx context.Context, in *EnqueueActionRequest, opts ...grpc.CallOption) (*EnqueueActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnqueueActionResponse)
+	err := c.cc.Invoke(ctx, CharacterActionsService_EnqueueAction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *characterActionsServiceClient) StreamActionResults(ctx context.Context, in *StreamActionResultsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ActionResult], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CharacterActionsService_ServiceDesc.Streams[0], CharacterActionsService_StreamActionResults_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamActionResultsRequest, ActionResult]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CharacterActionsService_StreamActionResultsClient = grpc.ServerStreamingClient[ActionResult]
+
 // CharacterActionsServiceServer is the server API for CharacterActionsService service.
 // All implementations must embed UnimplementedCharacterActionsServiceServer
 // for forward compatibility.
 type CharacterActionsServiceServer interface {
 	// Resource harvesting
 	HarvestResource(context.Context, *HarvestResourceRequest) (*HarvestResourceResponse, error)
+	// Terrain modification (dig, fill, till) of a cell next to the character
+	ModifyTerrain(context.Context, *ModifyTerrainRequest) (*ModifyTerrainResponse, error)
+	// Tick-processed action queue
+	EnqueueAction(context.Context, *EnqueueActionRequest) (*EnqueueActionResponse, error)
+	StreamActionResults(*StreamActionResultsRequest, grpc.ServerStreamingServer[ActionResult]) error
 	mustEmbedUnimplementedCharacterActionsServiceServer()
 }
 
@@ -67,6 +119,15 @@ type UnimplementedCharacterActionsServiceServer struct{}
 func (UnimplementedCharacterActionsServiceServer) HarvestResource(context.Context, *HarvestResourceRequest) (*HarvestResourceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HarvestResource not implemented")
 }
+func (UnimplementedCharacterActionsServiceServer) ModifyTerrain(context.Context, *ModifyTerrainRequest) (*ModifyTerrainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyTerrain not implemented")
+}
+func (UnimplementedCharacterActionsServiceServer) EnqueueAction(context.Context, *EnqueueActionRequest) (*EnqueueActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnqueueAction not implemented")
+}
+func (UnimplementedCharacterActionsServiceServer) StreamActionResults(*StreamActionResultsRequest, grpc.ServerStreamingServer[ActionResult]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamActionResults not implemented")
+}
 func (UnimplementedCharacterActionsServiceServer) mustEmbedUnimplementedCharacterActionsServiceServer() {
 }
 func (UnimplementedCharacterActionsServiceServer) testEmbeddedByValue() {}
@@ -107,6 +168,53 @@ func _CharacterActionsService_HarvestResource_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CharacterActionsService_ModifyTerrain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyTerrainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CharacterActionsServiceServer).ModifyTerrain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CharacterActionsService_ModifyTerrain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CharacterActionsServiceServer).ModifyTerrain(ctx, req.(*ModifyTerrainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CharacterActionsService_EnqueueAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnqueueActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CharacterActionsServiceServer).EnqueueAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CharacterActionsService_EnqueueAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CharacterActionsServiceServer).EnqueueAction(ctx, req.(*EnqueueActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CharacterActionsService_StreamActionResults_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamActionResultsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CharacterActionsServiceServer).StreamActionResults(m, &grpc.GenericServerStream[StreamActionResultsRequest, ActionResult]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CharacterActionsService_StreamActionResultsServer = grpc.ServerStreamingServer[ActionResult]
+
 // CharacterActionsService_ServiceDesc is the grpc.ServiceDesc for CharacterActionsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -118,7 +226,21 @@ var CharacterActionsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "HarvestResource",
 			Handler:    _CharacterActionsService_HarvestResource_Handler,
 		},
+		{
+			MethodName: "ModifyTerrain",
+			Handler:    _CharacterActionsService_ModifyTerrain_Handler,
+		},
+		{
+			MethodName: "EnqueueAction",
+			Handler:    _CharacterActionsService_EnqueueAction_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamActionResults",
+			Handler:       _CharacterActionsService_StreamActionResults_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "character_actions/v1/character_actions.proto",
 }
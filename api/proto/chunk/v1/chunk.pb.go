@@ -81,9 +81,67 @@ func (TerrainType) EnumDescriptor() ([]byte, []int) {
 	return file_chunk_v1_chunk_proto_rawDescGZIP(), []int{0}
 }
 
+// Rules that apply inside a protected region
+type RegionFlag int32
+
+const (
+	RegionFlag_REGION_FLAG_UNSPECIFIED RegionFlag = 0
+	RegionFlag_REGION_FLAG_NO_HARVEST  RegionFlag = 1 // Resource nodes cannot be harvested
+	RegionFlag_REGION_FLAG_NO_BUILD    RegionFlag = 2 // Terrain cannot be modified
+	RegionFlag_REGION_FLAG_NO_PVP      RegionFlag = 3 // Players cannot attack each other
+	RegionFlag_REGION_FLAG_SAFE_ZONE   RegionFlag = 4 // Characters cannot be harmed
+)
+
+// Enum value maps for RegionFlag.
+var (
+	RegionFlag_name = map[int32]string{
+		0: "REGION_FLAG_UNSPECIFIED",
+		1: "REGION_FLAG_NO_HARVEST",
+		2: "REGION_FLAG_NO_BUILD",
+		3: "REGION_FLAG_NO_PVP",
+		4: "REGION_FLAG_SAFE_ZONE",
+	}
+	RegionFlag_value = map[string]int32{
+		"REGION_FLAG_UNSPECIFIED": 0,
+		"REGION_FLAG_NO_HARVEST":  1,
+		"REGION_FLAG_NO_BUILD":    2,
+		"REGION_FLAG_NO_PVP":      3,
+		"REGION_FLAG_SAFE_ZONE":   4,
+	}
+)
+
+func (x RegionFlag) Enum() *RegionFlag {
+	p := new(RegionFlag)
+	*p = x
+	return p
+}
+
+func (x RegionFlag) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RegionFlag) Descriptor() protoreflect.EnumDescriptor {
+	return file_chunk_v1_chunk_proto_enumTypes[1].Descriptor()
+}
+
+func (RegionFlag) Type() protoreflect.EnumType {
+	return &file_chunk_v1_chunk_proto_enumTypes[1]
+}
+
+func (x RegionFlag) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RegionFlag.Descriptor instead.
+func (RegionFlag) EnumDescriptor() ([]byte, []int) {
+	return file_chunk_v1_chunk_proto_rawDescGZIP(), []int{1}
+}
+
 type TerrainCell struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TerrainType   TerrainType            `protobuf:"varint,1,opt,name=terrain_type,json=terrainType,proto3,enum=chunk.v1.TerrainType" json:"terrain_type,omitempty"`
+	Elevation     uint32                 `protobuf:"varint,2,opt,name=elevation,proto3" json:"elevation,omitempty"` // Quantized to 0-255; sea level is about 89
+	Moisture      uint32                 `protobuf:"varint,3,opt,name=moisture,proto3" json:"moisture,omitempty"`   // Quantized to 0 (arid) to 255 (saturated)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -125,6 +183,20 @@ func (x *TerrainCell) GetTerrainType() TerrainType {
 	return TerrainType_TERRAIN_TYPE_UNSPECIFIED
 }
 
+func (x *TerrainCell) GetElevation() uint32 {
+	if x != nil {
+		return x.Elevation
+	}
+	return 0
+}
+
+func (x *TerrainCell) GetMoisture() uint32 {
+	if x != nil {
+		return x.Moisture
+	}
+	return 0
+}
+
 type ChunkData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ChunkX        int32                  `protobuf:"varint,1,opt,name=chunk_x,json=chunkX,proto3" json:"chunk_x,omitempty"`
@@ -133,6 +205,7 @@ type ChunkData struct {
 	Seed          int64                  `protobuf:"varint,4,opt,name=seed,proto3" json:"seed,omitempty"`
 	GeneratedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
 	ResourceNodes []*v1.ResourceNode     `protobuf:"bytes,6,rep,name=resource_nodes,json=resourceNodes,proto3" json:"resource_nodes,omitempty"` // Resource nodes in this chunk
+	CellMetadata  bool                   `protobuf:"varint,7,opt,name=cell_metadata,json=cellMetadata,proto3" json:"cell_metadata,omitempty"`   // Cells carry elevation and moisture; chunks stored before they existed have it filled in on load
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -209,6 +282,13 @@ func (x *ChunkData) GetResourceNodes() []*v1.ResourceNode {
 	return nil
 }
 
+func (x *ChunkData) GetCellMetadata() bool {
+	if x != nil {
+		return x.CellMetadata
+	}
+	return false
+}
+
 type ChunkCoordinate struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	WorldId       []byte                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"` // Optional, uses default world if not provided
@@ -333,6 +413,7 @@ func (x *GetChunkRequest) GetChunkY() int32 {
 type GetChunkResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Chunk         *ChunkData             `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	Regions       []*ProtectedRegion     `protobuf:"bytes,2,rep,name=regions,proto3" json:"regions,omitempty"` // Protected regions overlapping the chunk
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -374,6 +455,13 @@ func (x *GetChunkResponse) GetChunk() *ChunkData {
 	return nil
 }
 
+func (x *GetChunkResponse) GetRegions() []*ProtectedRegion {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
 // Get multiple chunks in a rectangle
 type GetChunksRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -454,6 +542,7 @@ func (x *GetChunksRequest) GetMaxChunkY() int32 {
 type GetChunksResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Chunks        []*ChunkData           `protobuf:"bytes,1,rep,name=chunks,proto3" json:"chunks,omitempty"`
+	Regions       []*ProtectedRegion     `protobuf:"bytes,2,rep,name=regions,proto3" json:"regions,omitempty"` // Protected regions overlapping the chunks
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -495,6 +584,13 @@ func (x *GetChunksResponse) GetChunks() []*ChunkData {
 	return nil
 }
 
+func (x *GetChunksResponse) GetRegions() []*ProtectedRegion {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
 // Get chunks in radius around a point
 type GetChunksInRadiusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -567,6 +663,7 @@ func (x *GetChunksInRadiusRequest) GetRadius() int32 {
 type GetChunksInRadiusResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Chunks        []*ChunkData           `protobuf:"bytes,1,rep,name=chunks,proto3" json:"chunks,omitempty"`
+	Regions       []*ProtectedRegion     `protobuf:"bytes,2,rep,name=regions,proto3" json:"regions,omitempty"` // Protected regions overlapping the chunks
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -608,56 +705,1033 @@ func (x *GetChunksInRadiusResponse) GetChunks() []*ChunkData {
 	return nil
 }
 
-var File_chunk_v1_chunk_proto protoreflect.FileDescriptor
+func (x *GetChunksInRadiusResponse) GetRegions() []*ProtectedRegion {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
 
-const file_chunk_v1_chunk_proto_rawDesc = "" +
-	"\n" +
-	"\x14chunk/v1/chunk.proto\x12\bchunk.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$resource_node/v1/resource_node.proto\"G\n" +
-	"\vTerrainCell\x128\n" +
-	"\fterrain_type\x18\x01 \x01(\x0e2\x15.chunk.v1.TerrainTypeR\vterrainType\"\x84\x02\n" +
-	"\tChunkData\x12\x17\n" +
-	"\achunk_x\x18\x01 \x01(\x05R\x06chunkX\x12\x17\n" +
-	"\achunk_y\x18\x02 \x01(\x05R\x06chunkY\x12+\n" +
-	"\x05cells\x18\x03 \x03(\v2\x15.chunk.v1.TerrainCellR\x05cells\x12\x12\n" +
-	"\x04seed\x18\x04 \x01(\x03R\x04seed\x12=\n" +
-	"\fgenerated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\x12E\n" +
-	"\x0eresource_nodes\x18\x06 \x03(\v2\x1e.resource_node.v1.ResourceNodeR\rresourceNodes\"^\n" +
-	"\x0fChunkCoordinate\x12\x19\n" +
-	"\bworld_id\x18\x01 \x01(\fR\aworldId\x12\x17\n" +
-	"\achunk_x\x18\x02 \x01(\x05R\x06chunkX\x12\x17\n" +
-	"\achunk_y\x18\x03 \x01(\x05R\x06chunkY\"^\n" +
-	"\x0fGetChunkRequest\x12\x19\n" +
-	"\bworld_id\x18\x01 \x01(\fR\aworldId\x12\x17\n" +
-	"\achunk_x\x18\x02 \x01(\x05R\x06chunkX\x12\x17\n" +
-	"\achunk_y\x18\x03 \x01(\x05R\x06chunkY\"=\n" +
-	"\x10GetChunkResponse\x12)\n" +
-	"\x05chunk\x18\x01 \x01(\v2\x13.chunk.v1.ChunkDataR\x05chunk\"\xad\x01\n" +
-	"\x10GetChunksRequest\x12\x19\n" +
-	"\bworld_id\x18\x01 \x01(\fR\aworldId\x12\x1e\n" +
-	"\vmin_chunk_x\x18\x02 \x01(\x05R\tminChunkX\x12\x1e\n" +
-	"\vmax_chunk_x\x18\x03 \x01(\x05R\tmaxChunkX\x12\x1e\n" +
-	"\vmin_chunk_y\x18\x04 \x01(\x05R\tminChunkY\x12\x1e\n" +
-	"\vmax_chunk_y\x18\x05 \x01(\x05R\tmaxChunkY\"@\n" +
-	"\x11GetChunksResponse\x12+\n" +
-	"\x06chunks\x18\x01 \x03(\v2\x13.chunk.v1.ChunkDataR\x06chunks\"\x99\x01\n" +
-	"\x18GetChunksInRadiusRequest\x12\x19\n" +
-	"\bworld_id\x18\x01 \x01(\fR\aworldId\x12$\n" +
-	"\x0ecenter_chunk_x\x18\x02 \x01(\x05R\fcenterChunkX\x12$\n" +
-	"\x0ecenter_chunk_y\x18\x03 \x01(\x05R\fcenterChunkY\x12\x16\n" +
-	"\x06radius\x18\x04 \x01(\x05R\x06radius\"H\n" +
-	"\x19GetChunksInRadiusResponse\x12+\n" +
-	"\x06chunks\x18\x01 \x03(\v2\x13.chunk.v1.ChunkDataR\x06chunks*\xa1\x01\n" +
-	"\vTerrainType\x12\x1c\n" +
-	"\x18TERRAIN_TYPE_UNSPECIFIED\x10\x00\x12\x16\n" +
-	"\x12TERRAIN_TYPE_GRASS\x10\x01\x12\x16\n" +
-	"\x12TERRAIN_TYPE_WATER\x10\x02\x12\x16\n" +
-	"\x12TERRAIN_TYPE_STONE\x10\x03\x12\x15\n" +
-	"\x11TERRAIN_TYPE_SAND\x10\x04\x12\x15\n" +
-	"\x11TERRAIN_TYPE_DIRT\x10\x052\xfb\x01\n" +
+// A cell in world cell coordinates
+type CellCoordinate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             int32                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CellCoordinate) Reset() {
+	*x = CellCoordinate{}
+	mi := &file_chunk_v1_chunk_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CellCoordinate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CellCoordinate) ProtoMessage() {}
+
+func (x *CellCoordinate) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v1_chunk_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CellCoordinate.ProtoReflect.Descriptor instead.
+func (*CellCoordinate) Descriptor() ([]byte, []int) {
+	return file_chunk_v1_chunk_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CellCoordinate) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *CellCoordinate) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+// A cell's terrain and whether characters can stand on it
+type CellInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             int32                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	Cell          *TerrainCell           `protobuf:"bytes,3,opt,name=cell,proto3" json:"cell,omitempty"`
+	Passable      bool                   `protobuf:"varint,4,opt,name=passable,proto3" json:"passable,omitempty"`
+	CropViable    bool                   `protobuf:"varint,5,opt,name=crop_viable,json=cropViable,proto3" json:"crop_viable,omitempty"` // Terrain, elevation and moisture allow crops to grow
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CellInfo) Reset() {
+	*x = CellInfo{}
+	mi := &file_chunk_v1_chunk_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CellInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CellInfo) ProtoMessage() {}
+
+func (x *CellInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v1_chunk_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CellInfo.ProtoReflect.Descriptor instead.
+func (*CellInfo) Descriptor() ([]byte, []int) {
+	return file_chunk_v1_chunk_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CellInfo) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *CellInfo) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *CellInfo) GetCell() *TerrainCell {
+	if x != nil {
+		return x.Cell
+	}
+	return nil
+}
+
+func (x *CellInfo) GetPassable() bool {
+	if x != nil {
+		return x.Passable
+	}
+	return false
+}
+
+func (x *CellInfo) GetCropViable() bool {
+	if x != nil {
+		return x.CropViable
+	}
+	return false
+}
+
+type GetCellsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorldId       []byte                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"` // Optional, uses default world if not provided
+	Cells         []*CellCoordinate      `protobuf:"bytes,2,rep,name=cells,proto3" json:"cells,omitempty"`                    // At most 256; duplicates are returned once
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCellsRequest) Reset() {
+	*x = GetCellsRequest{}
+	mi := &file_chunk_v1_chunk_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCellsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCellsRequest) ProtoMessage() {}
+
+func (x *GetCellsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v1_chunk_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCellsRequest.ProtoReflect.Descriptor instead.
+func (*GetCellsRequest) Descriptor() ([]byte, []int) {
+	return file_chunk_v1_chunk_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetCellsRequest) GetWorldId() []byte {
+	if x != nil {
+		return x.WorldId
+	}
+	return nil
+}
+
+func (x *GetCellsRequest) GetCells() []*CellCoordinate {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+type GetCellsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cells         []*CellInfo            `protobuf:"bytes,1,rep,name=cells,proto3" json:"cells,omitempty"` // In the order requested
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCellsResponse) Reset() {
+	*x = GetCellsResponse{}
+	mi := &file_chunk_v1_chunk_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCellsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCellsResponse) ProtoMessage() {}
+
+func (x *GetCellsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v1_chunk_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCellsResponse.ProtoReflect.Descriptor instead.
+func (*GetCellsResponse) Descriptor() ([]byte, []int) {
+	return file_chunk_v1_chunk_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetCellsResponse) GetCells() []*CellInfo {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+type TerrainCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TerrainType   TerrainType            `protobuf:"varint,1,opt,name=terrain_type,json=terrainType,proto3,enum=chunk.v1.TerrainType" json:"terrain_type,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerrainCount) Reset() {
+	*x = TerrainCount{}
+	mi := &file_chunk_v1_chunk_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerrainCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerrainCount) ProtoMessage() {}
+
+func (x *TerrainCount) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v1_chunk_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerrainCount.ProtoReflect.Descriptor instead.
+func (*TerrainCount) Descriptor() ([]byte, []int) {
+	return file_chunk_v1_chunk_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TerrainCount) GetTerrainType() TerrainType {
+	if x != nil {
+		return x.TerrainType
+	}
+	return TerrainType_TERRAIN_TYPE_UNSPECIFIED
+}
+
+func (x *TerrainCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ResourceNodeCount struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ResourceNodeTypeId v1.ResourceNodeTypeId  `protobuf:"varint,1,opt,name=resource_node_type_id,json=resourceNodeTypeId,proto3,enum=resource_node.v1.ResourceNodeTypeId" json:"resource_node_type_id,omitempty"`
+	Count              int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ResourceNodeCount) Reset() {
+	*x = ResourceNodeCount{}
+	mi := &file_chunk_v1_chunk_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceNodeCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceNodeCount) ProtoMessage() {}
+
+func (x *ResourceNodeCount) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v1_chunk_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceNodeCount.ProtoReflect.Descriptor instead.
+func (*ResourceNodeCount) Descriptor() ([]byte, []int) {
+	return file_chunk_v1_chunk_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ResourceNodeCount) GetResourceNodeTypeId() v1.ResourceNodeTypeId {
+	if x != nil {
+		return x.ResourceNodeTypeId
+	}
+	return v1.ResourceNodeTypeId(0)
+}
+
+func (x *ResourceNodeCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Summary of a generated chunk, maintained from generation and harvest events
+type ChunkSummary struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ChunkX              int32                  `protobuf:"varint,1,opt,name=chunk_x,json=chunkX,proto3" json:"chunk_x,omitempty"`
+	ChunkY              int32                  `protobuf:"varint,2,opt,name=chunk_y,json=chunkY,proto3" json:"chunk_y,omitempty"`
+	TerrainHistogram    []*TerrainCount        `protobuf:"bytes,3,rep,name=terrain_histogram,json=terrainHistogram,proto3" json:"terrain_histogram,omitempty"`
+	NodeCounts          []*ResourceNodeCount   `protobuf:"bytes,4,rep,name=node_counts,json=nodeCounts,proto3" json:"node_counts,omitempty"`
+	HarvestCount        int32                  `protobuf:"varint,5,opt,name=harvest_count,json=harvestCount,proto3" json:"harvest_count,omitempty"`
+	LastModified        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_modified,json=lastModified,proto3" json:"last_modified,omitempty"`
+	Discovery           *Discovery             `protobuf:"bytes,7,opt,name=discovery,proto3" json:"discovery,omitempty"`                                                // Unset for chunks generated without a character, e.g. by map requests
+	ResourceDiscoveries []*ResourceDiscovery   `protobuf:"bytes,8,rep,name=resource_discoveries,json=resourceDiscoveries,proto3" json:"resource_discoveries,omitempty"` // First harvests of a node type in its region made in this chunk
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ChunkSummary) Reset() {
+	*x = ChunkSummary{}
+	mi := &file_chunk_v1_chunk_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChunkSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChunkSummary) ProtoMessage() {}
+
+func (x *ChunkSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v1_chunk_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChunkSummary.ProtoReflect.Descriptor instead.
+func (*ChunkSummary) Descriptor() ([]byte, []int) {
+	return file_chunk_v1_chunk_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ChunkSummary) GetChunkX() int32 {
+	if x != nil {
+		return x.ChunkX
+	}
+	return 0
+}
+
+func (x *ChunkSummary) GetChunkY() int32 {
+	if x != nil {
+		return x.ChunkY
+	}
+	return 0
+}
+
+func (x *ChunkSummary) GetTerrainHistogram() []*TerrainCount {
+	if x != nil {
+		return x.TerrainHistogram
+	}
+	return nil
+}
+
+func (x *ChunkSummary) GetNodeCounts() []*ResourceNodeCount {
+	if x != nil {
+		return x.NodeCounts
+	}
+	return nil
+}
+
+func (x *ChunkSummary) GetHarvestCount() int32 {
+	if x != nil {
+		return x.HarvestCount
+	}
+	return 0
+}
+
+func (x *ChunkSummary) GetLastModified() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModified
+	}
+	return nil
+}
+
+func (x *ChunkSummary) GetDiscovery() *Discovery {
+	if x != nil {
+		return x.Discovery
+	}
+	return nil
+}
+
+func (x *ChunkSummary) GetResourceDiscoveries() []*ResourceDiscovery {
+	if x != nil {
+		return x.ResourceDiscoveries
+	}
+	return nil
+}
+
+// Who discovered something first, for "Discovered by X on date"
+type Discovery struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"` // Empty once the character is deleted
+	CharacterName string                 `protobuf:"bytes,2,opt,name=character_name,json=characterName,proto3" json:"character_name,omitempty"`
+	DiscoveredAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=discovered_at,json=discoveredAt,proto3" json:"discovered_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Discovery) Reset() {
+	*x = Discovery{}
+	mi := &file_chunk_v1_chunk_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Discovery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Discovery) ProtoMessage() {}
+
+func (x *Discovery) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v1_chunk_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Discovery.ProtoReflect.Descriptor instead.
+func (*Discovery) Descriptor() ([]byte, []int) {
+	return file_chunk_v1_chunk_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Discovery) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *Discovery) GetCharacterName() string {
+	if x != nil {
+		return x.CharacterName
+	}
+	return ""
+}
+
+func (x *Discovery) GetDiscoveredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DiscoveredAt
+	}
+	return nil
+}
+
+// The first harvest of a resource node type in a region (a square of 8x8 chunks)
+type ResourceDiscovery struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ResourceNodeTypeId int32                  `protobuf:"varint,1,opt,name=resource_node_type_id,json=resourceNodeTypeId,proto3" json:"resource_node_type_id,omitempty"`
+	RegionX            int32                  `protobuf:"varint,2,opt,name=region_x,json=regionX,proto3" json:"region_x,omitempty"`
+	RegionY            int32                  `protobuf:"varint,3,opt,name=region_y,json=regionY,proto3" json:"region_y,omitempty"`
+	Discovery          *Discovery             `protobuf:"bytes,4,opt,name=discovery,proto3" json:"discovery,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ResourceDiscovery) Reset() {
+	*x = ResourceDiscovery{}
+	mi := &file_chunk_v1_chunk_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceDiscovery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceDiscovery) ProtoMessage() {}
+
+func (x *ResourceDiscovery) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v1_chunk_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceDiscovery.ProtoReflect.Descriptor instead.
+func (*ResourceDiscovery) Descriptor() ([]byte, []int) {
+	return file_chunk_v1_chunk_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ResourceDiscovery) GetResourceNodeTypeId() int32 {
+	if x != nil {
+		return x.ResourceNodeTypeId
+	}
+	return 0
+}
+
+func (x *ResourceDiscovery) GetRegionX() int32 {
+	if x != nil {
+		return x.RegionX
+	}
+	return 0
+}
+
+func (x *ResourceDiscovery) GetRegionY() int32 {
+	if x != nil {
+		return x.RegionY
+	}
+	return 0
+}
+
+func (x *ResourceDiscovery) GetDiscovery() *Discovery {
+	if x != nil {
+		return x.Discovery
+	}
+	return nil
+}
+
+// Get summaries for generated chunks in a rectangle; chunks that were never generated are omitted
+type GetChunkSummariesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorldId       []byte                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"` // Optional, uses default world if not provided
+	MinChunkX     int32                  `protobuf:"varint,2,opt,name=min_chunk_x,json=minChunkX,proto3" json:"min_chunk_x,omitempty"`
+	MaxChunkX     int32                  `protobuf:"varint,3,opt,name=max_chunk_x,json=maxChunkX,proto3" json:"max_chunk_x,omitempty"`
+	MinChunkY     int32                  `protobuf:"varint,4,opt,name=min_chunk_y,json=minChunkY,proto3" json:"min_chunk_y,omitempty"`
+	MaxChunkY     int32                  `protobuf:"varint,5,opt,name=max_chunk_y,json=maxChunkY,proto3" json:"max_chunk_y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChunkSummariesRequest) Reset() {
+	*x = GetChunkSummariesRequest{}
+	mi := &file_chunk_v1_chunk_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChunkSummariesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChunkSummariesRequest) ProtoMessage() {}
+
+func (x *GetChunkSummariesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v1_chunk_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChunkSummariesRequest.ProtoReflect.Descriptor instead.
+func (*GetChunkSummariesRequest) Descriptor() ([]byte, []int) {
+	return file_chunk_v1_chunk_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetChunkSummariesRequest) GetWorldId() []byte {
+	if x != nil {
+		return x.WorldId
+	}
+	return nil
+}
+
+func (x *GetChunkSummariesRequest) GetMinChunkX() int32 {
+	if x != nil {
+		return x.MinChunkX
+	}
+	return 0
+}
+
+func (x *GetChunkSummariesRequest) GetMaxChunkX() int32 {
+	if x != nil {
+		return x.MaxChunkX
+	}
+	return 0
+}
+
+func (x *GetChunkSummariesRequest) GetMinChunkY() int32 {
+	if x != nil {
+		return x.MinChunkY
+	}
+	return 0
+}
+
+func (x *GetChunkSummariesRequest) GetMaxChunkY() int32 {
+	if x != nil {
+		return x.MaxChunkY
+	}
+	return 0
+}
+
+type GetChunkSummariesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Summaries     []*ChunkSummary        `protobuf:"bytes,1,rep,name=summaries,proto3" json:"summaries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChunkSummariesResponse) Reset() {
+	*x = GetChunkSummariesResponse{}
+	mi := &file_chunk_v1_chunk_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChunkSummariesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChunkSummariesResponse) ProtoMessage() {}
+
+func (x *GetChunkSummariesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v1_chunk_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChunkSummariesResponse.ProtoReflect.Descriptor instead.
+func (*GetChunkSummariesResponse) Descriptor() ([]byte, []int) {
+	return file_chunk_v1_chunk_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetChunkSummariesResponse) GetSummaries() []*ChunkSummary {
+	if x != nil {
+		return x.Summaries
+	}
+	return nil
+}
+
+// A vertex in world cell coordinates; (x, y) is the top-left corner of cell (x, y)
+type RegionPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             int32                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegionPoint) Reset() {
+	*x = RegionPoint{}
+	mi := &file_chunk_v1_chunk_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegionPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegionPoint) ProtoMessage() {}
+
+func (x *RegionPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v1_chunk_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegionPoint.ProtoReflect.Descriptor instead.
+func (*RegionPoint) Descriptor() ([]byte, []int) {
+	return file_chunk_v1_chunk_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RegionPoint) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *RegionPoint) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+// An inclusive rectangle of chunks
+type ChunkRect struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MinChunkX     int32                  `protobuf:"varint,1,opt,name=min_chunk_x,json=minChunkX,proto3" json:"min_chunk_x,omitempty"`
+	MinChunkY     int32                  `protobuf:"varint,2,opt,name=min_chunk_y,json=minChunkY,proto3" json:"min_chunk_y,omitempty"`
+	MaxChunkX     int32                  `protobuf:"varint,3,opt,name=max_chunk_x,json=maxChunkX,proto3" json:"max_chunk_x,omitempty"`
+	MaxChunkY     int32                  `protobuf:"varint,4,opt,name=max_chunk_y,json=maxChunkY,proto3" json:"max_chunk_y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChunkRect) Reset() {
+	*x = ChunkRect{}
+	mi := &file_chunk_v1_chunk_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChunkRect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChunkRect) ProtoMessage() {}
+
+func (x *ChunkRect) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v1_chunk_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChunkRect.ProtoReflect.Descriptor instead.
+func (*ChunkRect) Descriptor() ([]byte, []int) {
+	return file_chunk_v1_chunk_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ChunkRect) GetMinChunkX() int32 {
+	if x != nil {
+		return x.MinChunkX
+	}
+	return 0
+}
+
+func (x *ChunkRect) GetMinChunkY() int32 {
+	if x != nil {
+		return x.MinChunkY
+	}
+	return 0
+}
+
+func (x *ChunkRect) GetMaxChunkX() int32 {
+	if x != nil {
+		return x.MaxChunkX
+	}
+	return 0
+}
+
+func (x *ChunkRect) GetMaxChunkY() int32 {
+	if x != nil {
+		return x.MaxChunkY
+	}
+	return 0
+}
+
+// An admin-defined area of the world with special rules. A cell belongs to the region
+// when its centre lies inside the polygon.
+type ProtectedRegion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Flags         []RegionFlag           `protobuf:"varint,3,rep,packed,name=flags,proto3,enum=chunk.v1.RegionFlag" json:"flags,omitempty"`
+	Polygon       []*RegionPoint         `protobuf:"bytes,4,rep,name=polygon,proto3" json:"polygon,omitempty"` // Chunk rectangles are stored as their four corners
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProtectedRegion) Reset() {
+	*x = ProtectedRegion{}
+	mi := &file_chunk_v1_chunk_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProtectedRegion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtectedRegion) ProtoMessage() {}
+
+func (x *ProtectedRegion) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v1_chunk_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtectedRegion.ProtoReflect.Descriptor instead.
+func (*ProtectedRegion) Descriptor() ([]byte, []int) {
+	return file_chunk_v1_chunk_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ProtectedRegion) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProtectedRegion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProtectedRegion) GetFlags() []RegionFlag {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *ProtectedRegion) GetPolygon() []*RegionPoint {
+	if x != nil {
+		return x.Polygon
+	}
+	return nil
+}
+
+func (x *ProtectedRegion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ProtectedRegion) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+var File_chunk_v1_chunk_proto protoreflect.FileDescriptor
+
+const file_chunk_v1_chunk_proto_rawDesc = "" +
+	"\n" +
+	"\x14chunk/v1/chunk.proto\x12\bchunk.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$resource_node/v1/resource_node.proto\"\x81\x01\n" +
+	"\vTerrainCell\x128\n" +
+	"\fterrain_type\x18\x01 \x01(\x0e2\x15.chunk.v1.TerrainTypeR\vterrainType\x12\x1c\n" +
+	"\televation\x18\x02 \x01(\rR\televation\x12\x1a\n" +
+	"\bmoisture\x18\x03 \x01(\rR\bmoisture\"\xa9\x02\n" +
+	"\tChunkData\x12\x17\n" +
+	"\achunk_x\x18\x01 \x01(\x05R\x06chunkX\x12\x17\n" +
+	"\achunk_y\x18\x02 \x01(\x05R\x06chunkY\x12+\n" +
+	"\x05cells\x18\x03 \x03(\v2\x15.chunk.v1.TerrainCellR\x05cells\x12\x12\n" +
+	"\x04seed\x18\x04 \x01(\x03R\x04seed\x12=\n" +
+	"\fgenerated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\x12E\n" +
+	"\x0eresource_nodes\x18\x06 \x03(\v2\x1e.resource_node.v1.ResourceNodeR\rresourceNodes\x12#\n" +
+	"\rcell_metadata\x18\a \x01(\bR\fcellMetadata\"^\n" +
+	"\x0fChunkCoordinate\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\fR\aworldId\x12\x17\n" +
+	"\achunk_x\x18\x02 \x01(\x05R\x06chunkX\x12\x17\n" +
+	"\achunk_y\x18\x03 \x01(\x05R\x06chunkY\"^\n" +
+	"\x0fGetChunkRequest\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\fR\aworldId\x12\x17\n" +
+	"\achunk_x\x18\x02 \x01(\x05R\x06chunkX\x12\x17\n" +
+	"\achunk_y\x18\x03 \x01(\x05R\x06chunkY\"r\n" +
+	"\x10GetChunkResponse\x12)\n" +
+	"\x05chunk\x18\x01 \x01(\v2\x13.chunk.v1.ChunkDataR\x05chunk\x123\n" +
+	"\aregions\x18\x02 \x03(\v2\x19.chunk.v1.ProtectedRegionR\aregions\"\xad\x01\n" +
+	"\x10GetChunksRequest\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\fR\aworldId\x12\x1e\n" +
+	"\vmin_chunk_x\x18\x02 \x01(\x05R\tminChunkX\x12\x1e\n" +
+	"\vmax_chunk_x\x18\x03 \x01(\x05R\tmaxChunkX\x12\x1e\n" +
+	"\vmin_chunk_y\x18\x04 \x01(\x05R\tminChunkY\x12\x1e\n" +
+	"\vmax_chunk_y\x18\x05 \x01(\x05R\tmaxChunkY\"u\n" +
+	"\x11GetChunksResponse\x12+\n" +
+	"\x06chunks\x18\x01 \x03(\v2\x13.chunk.v1.ChunkDataR\x06chunks\x123\n" +
+	"\aregions\x18\x02 \x03(\v2\x19.chunk.v1.ProtectedRegionR\aregions\"\x99\x01\n" +
+	"\x18GetChunksInRadiusRequest\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\fR\aworldId\x12$\n" +
+	"\x0ecenter_chunk_x\x18\x02 \x01(\x05R\fcenterChunkX\x12$\n" +
+	"\x0ecenter_chunk_y\x18\x03 \x01(\x05R\fcenterChunkY\x12\x16\n" +
+	"\x06radius\x18\x04 \x01(\x05R\x06radius\"}\n" +
+	"\x19GetChunksInRadiusResponse\x12+\n" +
+	"\x06chunks\x18\x01 \x03(\v2\x13.chunk.v1.ChunkDataR\x06chunks\x123\n" +
+	"\aregions\x18\x02 \x03(\v2\x19.chunk.v1.ProtectedRegionR\aregions\",\n" +
+	"\x0eCellCoordinate\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x05R\x01y\"\x8e\x01\n" +
+	"\bCellInfo\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x05R\x01y\x12)\n" +
+	"\x04cell\x18\x03 \x01(\v2\x15.chunk.v1.TerrainCellR\x04cell\x12\x1a\n" +
+	"\bpassable\x18\x04 \x01(\bR\bpassable\x12\x1f\n" +
+	"\vcrop_viable\x18\x05 \x01(\bR\n" +
+	"cropViable\"\\\n" +
+	"\x0fGetCellsRequest\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\fR\aworldId\x12.\n" +
+	"\x05cells\x18\x02 \x03(\v2\x18.chunk.v1.CellCoordinateR\x05cells\"<\n" +
+	"\x10GetCellsResponse\x12(\n" +
+	"\x05cells\x18\x01 \x03(\v2\x12.chunk.v1.CellInfoR\x05cells\"^\n" +
+	"\fTerrainCount\x128\n" +
+	"\fterrain_type\x18\x01 \x01(\x0e2\x15.chunk.v1.TerrainTypeR\vterrainType\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\x82\x01\n" +
+	"\x11ResourceNodeCount\x12W\n" +
+	"\x15resource_node_type_id\x18\x01 \x01(\x0e2$.resource_node.v1.ResourceNodeTypeIdR\x12resourceNodeTypeId\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\xac\x03\n" +
+	"\fChunkSummary\x12\x17\n" +
+	"\achunk_x\x18\x01 \x01(\x05R\x06chunkX\x12\x17\n" +
+	"\achunk_y\x18\x02 \x01(\x05R\x06chunkY\x12C\n" +
+	"\x11terrain_histogram\x18\x03 \x03(\v2\x16.chunk.v1.TerrainCountR\x10terrainHistogram\x12<\n" +
+	"\vnode_counts\x18\x04 \x03(\v2\x1b.chunk.v1.ResourceNodeCountR\n" +
+	"nodeCounts\x12#\n" +
+	"\rharvest_count\x18\x05 \x01(\x05R\fharvestCount\x12?\n" +
+	"\rlast_modified\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\flastModified\x121\n" +
+	"\tdiscovery\x18\a \x01(\v2\x13.chunk.v1.DiscoveryR\tdiscovery\x12N\n" +
+	"\x14resource_discoveries\x18\b \x03(\v2\x1b.chunk.v1.ResourceDiscoveryR\x13resourceDiscoveries\"\x96\x01\n" +
+	"\tDiscovery\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12%\n" +
+	"\x0echaracter_name\x18\x02 \x01(\tR\rcharacterName\x12?\n" +
+	"\rdiscovered_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\fdiscoveredAt\"\xaf\x01\n" +
+	"\x11ResourceDiscovery\x121\n" +
+	"\x15resource_node_type_id\x18\x01 \x01(\x05R\x12resourceNodeTypeId\x12\x19\n" +
+	"\bregion_x\x18\x02 \x01(\x05R\aregionX\x12\x19\n" +
+	"\bregion_y\x18\x03 \x01(\x05R\aregionY\x121\n" +
+	"\tdiscovery\x18\x04 \x01(\v2\x13.chunk.v1.DiscoveryR\tdiscovery\"\xb5\x01\n" +
+	"\x18GetChunkSummariesRequest\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\fR\aworldId\x12\x1e\n" +
+	"\vmin_chunk_x\x18\x02 \x01(\x05R\tminChunkX\x12\x1e\n" +
+	"\vmax_chunk_x\x18\x03 \x01(\x05R\tmaxChunkX\x12\x1e\n" +
+	"\vmin_chunk_y\x18\x04 \x01(\x05R\tminChunkY\x12\x1e\n" +
+	"\vmax_chunk_y\x18\x05 \x01(\x05R\tmaxChunkY\"Q\n" +
+	"\x19GetChunkSummariesResponse\x124\n" +
+	"\tsummaries\x18\x01 \x03(\v2\x16.chunk.v1.ChunkSummaryR\tsummaries\")\n" +
+	"\vRegionPoint\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x05R\x01y\"\x8b\x01\n" +
+	"\tChunkRect\x12\x1e\n" +
+	"\vmin_chunk_x\x18\x01 \x01(\x05R\tminChunkX\x12\x1e\n" +
+	"\vmin_chunk_y\x18\x02 \x01(\x05R\tminChunkY\x12\x1e\n" +
+	"\vmax_chunk_x\x18\x03 \x01(\x05R\tmaxChunkX\x12\x1e\n" +
+	"\vmax_chunk_y\x18\x04 \x01(\x05R\tmaxChunkY\"\x88\x02\n" +
+	"\x0fProtectedRegion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12*\n" +
+	"\x05flags\x18\x03 \x03(\x0e2\x14.chunk.v1.RegionFlagR\x05flags\x12/\n" +
+	"\apolygon\x18\x04 \x03(\v2\x15.chunk.v1.RegionPointR\apolygon\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt*\xa1\x01\n" +
+	"\vTerrainType\x12\x1c\n" +
+	"\x18TERRAIN_TYPE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12TERRAIN_TYPE_GRASS\x10\x01\x12\x16\n" +
+	"\x12TERRAIN_TYPE_WATER\x10\x02\x12\x16\n" +
+	"\x12TERRAIN_TYPE_STONE\x10\x03\x12\x15\n" +
+	"\x11TERRAIN_TYPE_SAND\x10\x04\x12\x15\n" +
+	"\x11TERRAIN_TYPE_DIRT\x10\x05*\x92\x01\n" +
+	"\n" +
+	"RegionFlag\x12\x1b\n" +
+	"\x17REGION_FLAG_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16REGION_FLAG_NO_HARVEST\x10\x01\x12\x18\n" +
+	"\x14REGION_FLAG_NO_BUILD\x10\x02\x12\x16\n" +
+	"\x12REGION_FLAG_NO_PVP\x10\x03\x12\x19\n" +
+	"\x15REGION_FLAG_SAFE_ZONE\x10\x042\xa0\x03\n" +
 	"\fChunkService\x12C\n" +
 	"\bGetChunk\x12\x19.chunk.v1.GetChunkRequest\x1a\x1a.chunk.v1.GetChunkResponse\"\x00\x12F\n" +
 	"\tGetChunks\x12\x1a.chunk.v1.GetChunksRequest\x1a\x1b.chunk.v1.GetChunksResponse\"\x00\x12^\n" +
-	"\x11GetChunksInRadius\x12\".chunk.v1.GetChunksInRadiusRequest\x1a#.chunk.v1.GetChunksInRadiusResponse\"\x00B,Z*github.com/VoidMesh/api/api/proto/chunk/v1b\x06proto3"
+	"\x11GetChunksInRadius\x12\".chunk.v1.GetChunksInRadiusRequest\x1a#.chunk.v1.GetChunksInRadiusResponse\"\x00\x12C\n" +
+	"\bGetCells\x12\x19.chunk.v1.GetCellsRequest\x1a\x1a.chunk.v1.GetCellsResponse\"\x00\x12^\n" +
+	"\x11GetChunkSummaries\x12\".chunk.v1.GetChunkSummariesRequest\x1a#.chunk.v1.GetChunkSummariesResponse\"\x00B,Z*github.com/VoidMesh/api/api/proto/chunk/v1b\x06proto3"
 
 var (
 	file_chunk_v1_chunk_proto_rawDescOnce sync.Once
@@ -671,41 +1745,81 @@ func file_chunk_v1_chunk_proto_rawDescGZIP() []byte {
 	return file_chunk_v1_chunk_proto_rawDescData
 }
 
-var file_chunk_v1_chunk_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_chunk_v1_chunk_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_chunk_v1_chunk_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_chunk_v1_chunk_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_chunk_v1_chunk_proto_goTypes = []any{
 	(TerrainType)(0),                  // 0: chunk.v1.TerrainType
-	(*TerrainCell)(nil),               // 1: chunk.v1.TerrainCell
-	(*ChunkData)(nil),                 // 2: chunk.v1.ChunkData
-	(*ChunkCoordinate)(nil),           // 3: chunk.v1.ChunkCoordinate
-	(*GetChunkRequest)(nil),           // 4: chunk.v1.GetChunkRequest
-	(*GetChunkResponse)(nil),          // 5: chunk.v1.GetChunkResponse
-	(*GetChunksRequest)(nil),          // 6: chunk.v1.GetChunksRequest
-	(*GetChunksResponse)(nil),         // 7: chunk.v1.GetChunksResponse
-	(*GetChunksInRadiusRequest)(nil),  // 8: chunk.v1.GetChunksInRadiusRequest
-	(*GetChunksInRadiusResponse)(nil), // 9: chunk.v1.GetChunksInRadiusResponse
-	(*timestamppb.Timestamp)(nil),     // 10: google.protobuf.Timestamp
-	(*v1.ResourceNode)(nil),           // 11: resource_node.v1.ResourceNode
+	(RegionFlag)(0),                   // 1: chunk.v1.RegionFlag
+	(*TerrainCell)(nil),               // 2: chunk.v1.TerrainCell
+	(*ChunkData)(nil),                 // 3: chunk.v1.ChunkData
+	(*ChunkCoordinate)(nil),           // 4: chunk.v1.ChunkCoordinate
+	(*GetChunkRequest)(nil),           // 5: chunk.v1.GetChunkRequest
+	(*GetChunkResponse)(nil),          // 6: chunk.v1.GetChunkResponse
+	(*GetChunksRequest)(nil),          // 7: chunk.v1.GetChunksRequest
+	(*GetChunksResponse)(nil),         // 8: chunk.v1.GetChunksResponse
+	(*GetChunksInRadiusRequest)(nil),  // 9: chunk.v1.GetChunksInRadiusRequest
+	(*GetChunksInRadiusResponse)(nil), // 10: chunk.v1.GetChunksInRadiusResponse
+	(*CellCoordinate)(nil),            // 11: chunk.v1.CellCoordinate
+	(*CellInfo)(nil),                  // 12: chunk.v1.CellInfo
+	(*GetCellsRequest)(nil),           // 13: chunk.v1.GetCellsRequest
+	(*GetCellsResponse)(nil),          // 14: chunk.v1.GetCellsResponse
+	(*TerrainCount)(nil),              // 15: chunk.v1.TerrainCount
+	(*ResourceNodeCount)(nil),         // 16: chunk.v1.ResourceNodeCount
+	(*ChunkSummary)(nil),              // 17: chunk.v1.ChunkSummary
+	(*Discovery)(nil),                 // 18: chunk.v1.Discovery
+	(*ResourceDiscovery)(nil),         // 19: chunk.v1.ResourceDiscovery
+	(*GetChunkSummariesRequest)(nil),  // 20: chunk.v1.GetChunkSummariesRequest
+	(*GetChunkSummariesResponse)(nil), // 21: chunk.v1.GetChunkSummariesResponse
+	(*RegionPoint)(nil),               // 22: chunk.v1.RegionPoint
+	(*ChunkRect)(nil),                 // 23: chunk.v1.ChunkRect
+	(*ProtectedRegion)(nil),           // 24: chunk.v1.ProtectedRegion
+	(*timestamppb.Timestamp)(nil),     // 25: google.protobuf.Timestamp
+	(*v1.ResourceNode)(nil),           // 26: resource_node.v1.ResourceNode
+	(v1.ResourceNodeTypeId)(0),        // 27: resource_node.v1.ResourceNodeTypeId
 }
 var file_chunk_v1_chunk_proto_depIdxs = []int32{
 	0,  // 0: chunk.v1.TerrainCell.terrain_type:type_name -> chunk.v1.TerrainType
-	1,  // 1: chunk.v1.ChunkData.cells:type_name -> chunk.v1.TerrainCell
-	10, // 2: chunk.v1.ChunkData.generated_at:type_name -> google.protobuf.Timestamp
-	11, // 3: chunk.v1.ChunkData.resource_nodes:type_name -> resource_node.v1.ResourceNode
-	2,  // 4: chunk.v1.GetChunkResponse.chunk:type_name -> chunk.v1.ChunkData
-	2,  // 5: chunk.v1.GetChunksResponse.chunks:type_name -> chunk.v1.ChunkData
-	2,  // 6: chunk.v1.GetChunksInRadiusResponse.chunks:type_name -> chunk.v1.ChunkData
-	4,  // 7: chunk.v1.ChunkService.GetChunk:input_type -> chunk.v1.GetChunkRequest
-	6,  // 8: chunk.v1.ChunkService.GetChunks:input_type -> chunk.v1.GetChunksRequest
-	8,  // 9: chunk.v1.ChunkService.GetChunksInRadius:input_type -> chunk.v1.GetChunksInRadiusRequest
-	5,  // 10: chunk.v1.ChunkService.GetChunk:output_type -> chunk.v1.GetChunkResponse
-	7,  // 11: chunk.v1.ChunkService.GetChunks:output_type -> chunk.v1.GetChunksResponse
-	9,  // 12: chunk.v1.ChunkService.GetChunksInRadius:output_type -> chunk.v1.GetChunksInRadiusResponse
-	10, // [10:13] is the sub-list for method output_type
-	7,  // [7:10] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	2,  // 1: chunk.v1.ChunkData.cells:type_name -> chunk.v1.TerrainCell
+	25, // 2: chunk.v1.ChunkData.generated_at:type_name -> google.protobuf.Timestamp
+	26, // 3: chunk.v1.ChunkData.resource_nodes:type_name -> resource_node.v1.ResourceNode
+	3,  // 4: chunk.v1.GetChunkResponse.chunk:type_name -> chunk.v1.ChunkData
+	24, // 5: chunk.v1.GetChunkResponse.regions:type_name -> chunk.v1.ProtectedRegion
+	3,  // 6: chunk.v1.GetChunksResponse.chunks:type_name -> chunk.v1.ChunkData
+	24, // 7: chunk.v1.GetChunksResponse.regions:type_name -> chunk.v1.ProtectedRegion
+	3,  // 8: chunk.v1.GetChunksInRadiusResponse.chunks:type_name -> chunk.v1.ChunkData
+	24, // 9: chunk.v1.GetChunksInRadiusResponse.regions:type_name -> chunk.v1.ProtectedRegion
+	2,  // 10: chunk.v1.CellInfo.cell:type_name -> chunk.v1.TerrainCell
+	11, // 11: chunk.v1.GetCellsRequest.cells:type_name -> chunk.v1.CellCoordinate
+	12, // 12: chunk.v1.GetCellsResponse.cells:type_name -> chunk.v1.CellInfo
+	0,  // 13: chunk.v1.TerrainCount.terrain_type:type_name -> chunk.v1.TerrainType
+	27, // 14: chunk.v1.ResourceNodeCount.resource_node_type_id:type_name -> resource_node.v1.ResourceNodeTypeId
+	15, // 15: chunk.v1.ChunkSummary.terrain_histogram:type_name -> chunk.v1.TerrainCount
+	16, // 16: chunk.v1.ChunkSummary.node_counts:type_name -> chunk.v1.ResourceNodeCount
+	25, // 17: chunk.v1.ChunkSummary.last_modified:type_name -> google.protobuf.Timestamp
+	18, // 18: chunk.v1.ChunkSummary.discovery:type_name -> chunk.v1.Discovery
+	19, // 19: chunk.v1.ChunkSummary.resource_discoveries:type_name -> chunk.v1.ResourceDiscovery
+	25, // 20: chunk.v1.Discovery.discovered_at:type_name -> google.protobuf.Timestamp
+	18, // 21: chunk.v1.ResourceDiscovery.discovery:type_name -> chunk.v1.Discovery
+	17, // 22: chunk.v1.GetChunkSummariesResponse.summaries:type_name -> chunk.v1.ChunkSummary
+	1,  // 23: chunk.v1.ProtectedRegion.flags:type_name -> chunk.v1.RegionFlag
+	22, // 24: chunk.v1.ProtectedRegion.polygon:type_name -> chunk.v1.RegionPoint
+	25, // 25: chunk.v1.ProtectedRegion.created_at:type_name -> google.protobuf.Timestamp
+	25, // 26: chunk.v1.ProtectedRegion.updated_at:type_name -> google.protobuf.Timestamp
+	5,  // 27: chunk.v1.ChunkService.GetChunk:input_type -> chunk.v1.GetChunkRequest
+	7,  // 28: chunk.v1.ChunkService.GetChunks:input_type -> chunk.v1.GetChunksRequest
+	9,  // 29: chunk.v1.ChunkService.GetChunksInRadius:input_type -> chunk.v1.GetChunksInRadiusRequest
+	13, // 30: chunk.v1.ChunkService.GetCells:input_type -> chunk.v1.GetCellsRequest
+	20, // 31: chunk.v1.ChunkService.GetChunkSummaries:input_type -> chunk.v1.GetChunkSummariesRequest
+	6,  // 32: chunk.v1.ChunkService.GetChunk:output_type -> chunk.v1.GetChunkResponse
+	8,  // 33: chunk.v1.ChunkService.GetChunks:output_type -> chunk.v1.GetChunksResponse
+	10, // 34: chunk.v1.ChunkService.GetChunksInRadius:output_type -> chunk.v1.GetChunksInRadiusResponse
+	14, // 35: chunk.v1.ChunkService.GetCells:output_type -> chunk.v1.GetCellsResponse
+	21, // 36: chunk.v1.ChunkService.GetChunkSummaries:output_type -> chunk.v1.GetChunkSummariesResponse
+	32, // [32:37] is the sub-list for method output_type
+	27, // [27:32] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_chunk_v1_chunk_proto_init() }
@@ -718,8 +1832,8 @@ func file_chunk_v1_chunk_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_chunk_v1_chunk_proto_rawDesc), len(file_chunk_v1_chunk_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   9,
+			NumEnums:      2,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,6 +22,8 @@ const (
 	ChunkService_GetChunk_FullMethodName          = "/chunk.v1.ChunkService/GetChunk"
 	ChunkService_GetChunks_FullMethodName         = "/chunk.v1.ChunkService/GetChunks"
 	ChunkService_GetChunksInRadius_FullMethodName = "/chunk.v1.ChunkService/GetChunksInRadius"
+	ChunkService_GetCells_FullMethodName          = "/chunk.v1.ChunkService/GetCells"
+	ChunkService_GetChunkSummaries_FullMethodName = "/chunk.v1.ChunkService/GetChunkSummaries"
 )
 
 // ChunkServiceClient is the client API for ChunkService service.
@@ -32,6 +34,11 @@ type ChunkServiceClient interface {
 	GetChunk(ctx context.Context, in *GetChunkRequest, opts ...grpc.CallOption) (*GetChunkResponse, error)
 	GetChunks(ctx context.Context, in *GetChunksRequest, opts ...grpc.CallOption) (*GetChunksResponse, error)
 	GetChunksInRadius(ctx context.Context, in *GetChunksInRadiusRequest, opts ...grpc.CallOption) (*GetChunksInRadiusResponse, error)
+	// Individual cells by world coordinates, for clients that need a few cells (such as a
+	// build placement preview) rather than whole chunks
+	GetCells(ctx context.Context, in *GetCellsRequest, opts ...grpc.CallOption) (*GetCellsResponse, error)
+	// Precomputed per-chunk statistics for map overlays
+	GetChunkSummaries(ctx context.Context, in *GetChunkSummariesRequest, opts ...grpc.CallOption) (*GetChunkSummariesResponse, error)
 }
 
 type chunkServiceClient struct {
@@ -72,6 +79,26 @@ func (c *chunkServiceClient) GetChunksInRadius(ctx context.Context, in *GetChunk
 	return out, nil
 }
 
+func (c *chunkServiceClient) GetCells(ctx context.Context, in *GetCellsRequest, opts ...grpc.CallOption) (*GetCellsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCellsResponse)
+	err := c.cc.Invoke(ctx, ChunkService_GetCells_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) GetChunkSummaries(ctx context.Context, in *GetChunkSummariesRequest, opts ...grpc.CallOption) (*GetChunkSummariesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChunkSummariesResponse)
+	err := c.cc.Invoke(ctx, ChunkService_GetChunkSummaries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ChunkServiceServer is the server API for ChunkService service.
 // All implementations must embed UnimplementedChunkServiceServer
 // for forward compatibility.
@@ -80,6 +107,11 @@ type ChunkServiceServer interface {
 	GetChunk(context.Context, *GetChunkRequest) (*GetChunkResponse, error)
 	GetChunks(context.Context, *GetChunksRequest) (*GetChunksResponse, error)
 	GetChunksInRadius(context.Context, *GetChunksInRadiusRequest) (*GetChunksInRadiusResponse, error)
+	// Individual cells by world coordinates, for clients that need a few cells (such as a
+	// build placement preview) rather than whole chunks
+	GetCells(context.Context, *GetCellsRequest) (*GetCellsResponse, error)
+	// Precomputed per-chunk statistics for map overlays
+	GetChunkSummaries(context.Context, *GetChunkSummariesRequest) (*GetChunkSummariesResponse, error)
 	mustEmbedUnimplementedChunkServiceServer()
 }
 
@@ -99,6 +131,12 @@ func (UnimplementedChunkServiceServer) GetChunks(context.Context, *GetChunksRequ
 func (UnimplementedChunkServiceServer) GetChunksInRadius(context.Context, *GetChunksInRadiusRequest) (*GetChunksInRadiusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetChunksInRadius not implemented")
 }
+func (UnimplementedChunkServiceServer) GetCells(context.Context, *GetCellsRequest) (*GetCellsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCells not implemented")
+}
+func (UnimplementedChunkServiceServer) GetChunkSummaries(context.Context, *GetChunkSummariesRequest) (*GetChunkSummariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChunkSummaries not implemented")
+}
 func (UnimplementedChunkServiceServer) mustEmbedUnimplementedChunkServiceServer() {}
 func (UnimplementedChunkServiceServer) testEmbeddedByValue()                      {}
 
@@ -174,6 +212,42 @@ func _ChunkService_GetChunksInRadius_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChunkService_GetCells_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCellsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).GetCells(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_GetCells_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).GetCells(ctx, req.(*GetCellsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChunkService_GetChunkSummaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChunkSummariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).GetChunkSummaries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_GetChunkSummaries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).GetChunkSummaries(ctx, req.(*GetChunkSummariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ChunkService_ServiceDesc is the grpc.ServiceDesc for ChunkService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -193,6 +267,14 @@ var ChunkService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetChunksInRadius",
 			Handler:    _ChunkService_GetChunksInRadius_Handler,
 		},
+		{
+			MethodName: "GetCells",
+			Handler:    _ChunkService_GetCells_Handler,
+		},
+		{
+			MethodName: "GetChunkSummaries",
+			Handler:    _ChunkService_GetChunkSummaries_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "chunk/v1/chunk.proto",
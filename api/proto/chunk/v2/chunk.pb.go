@@ -0,0 +1,465 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.4
+// source: chunk/v2/chunk.proto
+
+package v2
+
+import (
+	v1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Get single chunk
+type GetChunkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorldId       string                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"` // Optional, uses default world if not provided
+	ChunkX        int32                  `protobuf:"varint,2,opt,name=chunk_x,json=chunkX,proto3" json:"chunk_x,omitempty"`
+	ChunkY        int32                  `protobuf:"varint,3,opt,name=chunk_y,json=chunkY,proto3" json:"chunk_y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChunkRequest) Reset() {
+	*x = GetChunkRequest{}
+	mi := &file_chunk_v2_chunk_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChunkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChunkRequest) ProtoMessage() {}
+
+func (x *GetChunkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v2_chunk_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChunkRequest.ProtoReflect.Descriptor instead.
+func (*GetChunkRequest) Descriptor() ([]byte, []int) {
+	return file_chunk_v2_chunk_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetChunkRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *GetChunkRequest) GetChunkX() int32 {
+	if x != nil {
+		return x.ChunkX
+	}
+	return 0
+}
+
+func (x *GetChunkRequest) GetChunkY() int32 {
+	if x != nil {
+		return x.ChunkY
+	}
+	return 0
+}
+
+// Get multiple chunks in a rectangle
+type GetChunksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorldId       string                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"` // Optional, uses default world if not provided
+	MinChunkX     int32                  `protobuf:"varint,2,opt,name=min_chunk_x,json=minChunkX,proto3" json:"min_chunk_x,omitempty"`
+	MaxChunkX     int32                  `protobuf:"varint,3,opt,name=max_chunk_x,json=maxChunkX,proto3" json:"max_chunk_x,omitempty"`
+	MinChunkY     int32                  `protobuf:"varint,4,opt,name=min_chunk_y,json=minChunkY,proto3" json:"min_chunk_y,omitempty"`
+	MaxChunkY     int32                  `protobuf:"varint,5,opt,name=max_chunk_y,json=maxChunkY,proto3" json:"max_chunk_y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChunksRequest) Reset() {
+	*x = GetChunksRequest{}
+	mi := &file_chunk_v2_chunk_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChunksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChunksRequest) ProtoMessage() {}
+
+func (x *GetChunksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v2_chunk_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChunksRequest.ProtoReflect.Descriptor instead.
+func (*GetChunksRequest) Descriptor() ([]byte, []int) {
+	return file_chunk_v2_chunk_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetChunksRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *GetChunksRequest) GetMinChunkX() int32 {
+	if x != nil {
+		return x.MinChunkX
+	}
+	return 0
+}
+
+func (x *GetChunksRequest) GetMaxChunkX() int32 {
+	if x != nil {
+		return x.MaxChunkX
+	}
+	return 0
+}
+
+func (x *GetChunksRequest) GetMinChunkY() int32 {
+	if x != nil {
+		return x.MinChunkY
+	}
+	return 0
+}
+
+func (x *GetChunksRequest) GetMaxChunkY() int32 {
+	if x != nil {
+		return x.MaxChunkY
+	}
+	return 0
+}
+
+// Get chunks in radius around a point
+type GetChunksInRadiusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorldId       string                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"` // Optional, uses default world if not provided
+	CenterChunkX  int32                  `protobuf:"varint,2,opt,name=center_chunk_x,json=centerChunkX,proto3" json:"center_chunk_x,omitempty"`
+	CenterChunkY  int32                  `protobuf:"varint,3,opt,name=center_chunk_y,json=centerChunkY,proto3" json:"center_chunk_y,omitempty"`
+	Radius        int32                  `protobuf:"varint,4,opt,name=radius,proto3" json:"radius,omitempty"` // Radius in chunks
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChunksInRadiusRequest) Reset() {
+	*x = GetChunksInRadiusRequest{}
+	mi := &file_chunk_v2_chunk_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChunksInRadiusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChunksInRadiusRequest) ProtoMessage() {}
+
+func (x *GetChunksInRadiusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v2_chunk_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChunksInRadiusRequest.ProtoReflect.Descriptor instead.
+func (*GetChunksInRadiusRequest) Descriptor() ([]byte, []int) {
+	return file_chunk_v2_chunk_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetChunksInRadiusRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *GetChunksInRadiusRequest) GetCenterChunkX() int32 {
+	if x != nil {
+		return x.CenterChunkX
+	}
+	return 0
+}
+
+func (x *GetChunksInRadiusRequest) GetCenterChunkY() int32 {
+	if x != nil {
+		return x.CenterChunkY
+	}
+	return 0
+}
+
+func (x *GetChunksInRadiusRequest) GetRadius() int32 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+// Get individual cells by world coordinates
+type GetCellsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorldId       string                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"` // Optional, uses default world if not provided
+	Cells         []*v1.CellCoordinate   `protobuf:"bytes,2,rep,name=cells,proto3" json:"cells,omitempty"`                    // At most 256; duplicates are returned once
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCellsRequest) Reset() {
+	*x = GetCellsRequest{}
+	mi := &file_chunk_v2_chunk_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCellsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCellsRequest) ProtoMessage() {}
+
+func (x *GetCellsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v2_chunk_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCellsRequest.ProtoReflect.Descriptor instead.
+func (*GetCellsRequest) Descriptor() ([]byte, []int) {
+	return file_chunk_v2_chunk_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetCellsRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *GetCellsRequest) GetCells() []*v1.CellCoordinate {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+// Get summaries for generated chunks in a rectangle; chunks that were never generated are omitted
+type GetChunkSummariesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorldId       string                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"` // Optional, uses default world if not provided
+	MinChunkX     int32                  `protobuf:"varint,2,opt,name=min_chunk_x,json=minChunkX,proto3" json:"min_chunk_x,omitempty"`
+	MaxChunkX     int32                  `protobuf:"varint,3,opt,name=max_chunk_x,json=maxChunkX,proto3" json:"max_chunk_x,omitempty"`
+	MinChunkY     int32                  `protobuf:"varint,4,opt,name=min_chunk_y,json=minChunkY,proto3" json:"min_chunk_y,omitempty"`
+	MaxChunkY     int32                  `protobuf:"varint,5,opt,name=max_chunk_y,json=maxChunkY,proto3" json:"max_chunk_y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChunkSummariesRequest) Reset() {
+	*x = GetChunkSummariesRequest{}
+	mi := &file_chunk_v2_chunk_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChunkSummariesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChunkSummariesRequest) ProtoMessage() {}
+
+func (x *GetChunkSummariesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v2_chunk_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChunkSummariesRequest.ProtoReflect.Descriptor instead.
+func (*GetChunkSummariesRequest) Descriptor() ([]byte, []int) {
+	return file_chunk_v2_chunk_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetChunkSummariesRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *GetChunkSummariesRequest) GetMinChunkX() int32 {
+	if x != nil {
+		return x.MinChunkX
+	}
+	return 0
+}
+
+func (x *GetChunkSummariesRequest) GetMaxChunkX() int32 {
+	if x != nil {
+		return x.MaxChunkX
+	}
+	return 0
+}
+
+func (x *GetChunkSummariesRequest) GetMinChunkY() int32 {
+	if x != nil {
+		return x.MinChunkY
+	}
+	return 0
+}
+
+func (x *GetChunkSummariesRequest) GetMaxChunkY() int32 {
+	if x != nil {
+		return x.MaxChunkY
+	}
+	return 0
+}
+
+var File_chunk_v2_chunk_proto protoreflect.FileDescriptor
+
+const file_chunk_v2_chunk_proto_rawDesc = "" +
+	"\n" +
+	"\x14chunk/v2/chunk.proto\x12\bchunk.v2\x1a\x14chunk/v1/chunk.proto\"^\n" +
+	"\x0fGetChunkRequest\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\tR\aworldId\x12\x17\n" +
+	"\achunk_x\x18\x02 \x01(\x05R\x06chunkX\x12\x17\n" +
+	"\achunk_y\x18\x03 \x01(\x05R\x06chunkY\"\xad\x01\n" +
+	"\x10GetChunksRequest\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\tR\aworldId\x12\x1e\n" +
+	"\vmin_chunk_x\x18\x02 \x01(\x05R\tminChunkX\x12\x1e\n" +
+	"\vmax_chunk_x\x18\x03 \x01(\x05R\tmaxChunkX\x12\x1e\n" +
+	"\vmin_chunk_y\x18\x04 \x01(\x05R\tminChunkY\x12\x1e\n" +
+	"\vmax_chunk_y\x18\x05 \x01(\x05R\tmaxChunkY\"\x99\x01\n" +
+	"\x18GetChunksInRadiusRequest\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\tR\aworldId\x12$\n" +
+	"\x0ecenter_chunk_x\x18\x02 \x01(\x05R\fcenterChunkX\x12$\n" +
+	"\x0ecenter_chunk_y\x18\x03 \x01(\x05R\fcenterChunkY\x12\x16\n" +
+	"\x06radius\x18\x04 \x01(\x05R\x06radius\"\\\n" +
+	"\x0fGetCellsRequest\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\tR\aworldId\x12.\n" +
+	"\x05cells\x18\x02 \x03(\v2\x18.chunk.v1.CellCoordinateR\x05cells\"\xb5\x01\n" +
+	"\x18GetChunkSummariesRequest\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\tR\aworldId\x12\x1e\n" +
+	"\vmin_chunk_x\x18\x02 \x01(\x05R\tminChunkX\x12\x1e\n" +
+	"\vmax_chunk_x\x18\x03 \x01(\x05R\tmaxChunkX\x12\x1e\n" +
+	"\vmin_chunk_y\x18\x04 \x01(\x05R\tminChunkY\x12\x1e\n" +
+	"\vmax_chunk_y\x18\x05 \x01(\x05R\tmaxChunkY2\xa0\x03\n" +
+	"\fChunkService\x12C\n" +
+	"\bGetChunk\x12\x19.chunk.v2.GetChunkRequest\x1a\x1a.chunk.v1.GetChunkResponse\"\x00\x12F\n" +
+	"\tGetChunks\x12\x1a.chunk.v2.GetChunksRequest\x1a\x1b.chunk.v1.GetChunksResponse\"\x00\x12^\n" +
+	"\x11GetChunksInRadius\x12\".chunk.v2.GetChunksInRadiusRequest\x1a#.chunk.v1.GetChunksInRadiusResponse\"\x00\x12C\n" +
+	"\bGetCells\x12\x19.chunk.v2.GetCellsRequest\x1a\x1a.chunk.v1.GetCellsResponse\"\x00\x12^\n" +
+	"\x11GetChunkSummaries\x12\".chunk.v2.GetChunkSummariesRequest\x1a#.chunk.v1.GetChunkSummariesResponse\"\x00B,Z*github.com/VoidMesh/api/api/proto/chunk/v2b\x06proto3"
+
+var (
+	file_chunk_v2_chunk_proto_rawDescOnce sync.Once
+	file_chunk_v2_chunk_proto_rawDescData []byte
+)
+
+func file_chunk_v2_chunk_proto_rawDescGZIP() []byte {
+	file_chunk_v2_chunk_proto_rawDescOnce.Do(func() {
+		file_chunk_v2_chunk_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_chunk_v2_chunk_proto_rawDesc), len(file_chunk_v2_chunk_proto_rawDesc)))
+	})
+	return file_chunk_v2_chunk_proto_rawDescData
+}
+
+var file_chunk_v2_chunk_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_chunk_v2_chunk_proto_goTypes = []any{
+	(*GetChunkRequest)(nil),              // 0: chunk.v2.GetChunkRequest
+	(*GetChunksRequest)(nil),             // 1: chunk.v2.GetChunksRequest
+	(*GetChunksInRadiusRequest)(nil),     // 2: chunk.v2.GetChunksInRadiusRequest
+	(*GetCellsRequest)(nil),              // 3: chunk.v2.GetCellsRequest
+	(*GetChunkSummariesRequest)(nil),     // 4: chunk.v2.GetChunkSummariesRequest
+	(*v1.CellCoordinate)(nil),            // 5: chunk.v1.CellCoordinate
+	(*v1.GetChunkResponse)(nil),          // 6: chunk.v1.GetChunkResponse
+	(*v1.GetChunksResponse)(nil),         // 7: chunk.v1.GetChunksResponse
+	(*v1.GetChunksInRadiusResponse)(nil), // 8: chunk.v1.GetChunksInRadiusResponse
+	(*v1.GetCellsResponse)(nil),          // 9: chunk.v1.GetCellsResponse
+	(*v1.GetChunkSummariesResponse)(nil), // 10: chunk.v1.GetChunkSummariesResponse
+}
+var file_chunk_v2_chunk_proto_depIdxs = []int32{
+	5,  // 0: chunk.v2.GetCellsRequest.cells:type_name -> chunk.v1.CellCoordinate
+	0,  // 1: chunk.v2.ChunkService.GetChunk:input_type -> chunk.v2.GetChunkRequest
+	1,  // 2: chunk.v2.ChunkService.GetChunks:input_type -> chunk.v2.GetChunksRequest
+	2,  // 3: chunk.v2.ChunkService.GetChunksInRadius:input_type -> chunk.v2.GetChunksInRadiusRequest
+	3,  // 4: chunk.v2.ChunkService.GetCells:input_type -> chunk.v2.GetCellsRequest
+	4,  // 5: chunk.v2.ChunkService.GetChunkSummaries:input_type -> chunk.v2.GetChunkSummariesRequest
+	6,  // 6: chunk.v2.ChunkService.GetChunk:output_type -> chunk.v1.GetChunkResponse
+	7,  // 7: chunk.v2.ChunkService.GetChunks:output_type -> chunk.v1.GetChunksResponse
+	8,  // 8: chunk.v2.ChunkService.GetChunksInRadius:output_type -> chunk.v1.GetChunksInRadiusResponse
+	9,  // 9: chunk.v2.ChunkService.GetCells:output_type -> chunk.v1.GetCellsResponse
+	10, // 10: chunk.v2.ChunkService.GetChunkSummaries:output_type -> chunk.v1.GetChunkSummariesResponse
+	6,  // [6:11] is the sub-list for method output_type
+	1,  // [1:6] is the sub-list for method input_type
+	1,  // [1:1] is the sub-list for extension type_name
+	1,  // [1:1] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_chunk_v2_chunk_proto_init() }
+func file_chunk_v2_chunk_proto_init() {
+	if File_chunk_v2_chunk_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_chunk_v2_chunk_proto_rawDesc), len(file_chunk_v2_chunk_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_chunk_v2_chunk_proto_goTypes,
+		DependencyIndexes: file_chunk_v2_chunk_proto_depIdxs,
+		MessageInfos:      file_chunk_v2_chunk_proto_msgTypes,
+	}.Build()
+	File_chunk_v2_chunk_proto = out.File
+	file_chunk_v2_chunk_proto_goTypes = nil
+	file_chunk_v2_chunk_proto_depIdxs = nil
+}
@@ -0,0 +1,286 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.4
+// source: chunk/v2/chunk.proto
+
+package v2
+
+import (
+	context "context"
+	v1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ChunkService_GetChunk_FullMethodName          = "/chunk.v2.ChunkService/GetChunk"
+	ChunkService_GetChunks_FullMethodName         = "/chunk.v2.ChunkService/GetChunks"
+	ChunkService_GetChunksInRadius_FullMethodName = "/chunk.v2.ChunkService/GetChunksInRadius"
+	ChunkService_GetCells_FullMethodName          = "/chunk.v2.ChunkService/GetCells"
+	ChunkService_GetChunkSummaries_FullMethodName = "/chunk.v2.ChunkService/GetChunkSummaries"
+)
+
+// ChunkServiceClient is the client API for ChunkService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ChunkService v2 takes world IDs as UUID strings instead of bytes. Responses carry no
+// world IDs and are the v1 messages.
+type ChunkServiceClient interface {
+	// Chunk operations
+	GetChunk(ctx context.Context, in *GetChunkRequest, opts ...grpc.CallOption) (*v1.GetChunkResponse, error)
+	GetChunks(ctx context.Context, in *GetChunksRequest, opts ...grpc.CallOption) (*v1.GetChunksResponse, error)
+	GetChunksInRadius(ctx context.Context, in *GetChunksInRadiusRequest, opts ...grpc.CallOption) (*v1.GetChunksInRadiusResponse, error)
+	// Individual cells by world coordinates
+	GetCells(ctx context.Context, in *GetCellsRequest, opts ...grpc.CallOption) (*v1.GetCellsResponse, error)
+	// Precomputed per-chunk statistics for map overlays
+	GetChunkSummaries(ctx context.Context, in *GetChunkSummariesRequest, opts ...grpc.CallOption) (*v1.GetChunkSummariesResponse, error)
+}
+
+type chunkServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewChunkServiceClient(cc grpc.ClientConnInterface) ChunkServiceClient {
+	return &chunkServiceClient{cc}
+}
+
+func (c *chunkServiceClient) GetChunk(ctx context.Context, in *GetChunkRequest, opts ...grpc.CallOption) (*v1.GetChunkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.GetChunkResponse)
+	err := c.cc.Invoke(ctx, ChunkService_GetChunk_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) GetChunks(ctx context.Context, in *GetChunksRequest, opts ...grpc.CallOption) (*v1.GetChunksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.GetChunksResponse)
+	err := c.cc.Invoke(ctx, ChunkService_GetChunks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) GetChunksInRadius(ctx context.Context, in *GetChunksInRadiusRequest, opts ...grpc.CallOption) (*v1.GetChunksInRadiusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.GetChunksInRadiusResponse)
+	err := c.cc.Invoke(ctx, ChunkService_GetChunksInRadius_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) GetCells(ctx context.Context, in *GetCellsRequest, opts ...grpc.CallOption) (*v1.GetCellsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.GetCellsResponse)
+	err := c.cc.Invoke(ctx, ChunkService_GetCells_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) GetChunkSummaries(ctx context.Context, in *GetChunkSummariesRequest, opts ...grpc.CallOption) (*v1.GetChunkSummariesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.GetChunkSummariesResponse)
+	err := c.cc.Invoke(ctx, ChunkService_GetChunkSummaries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ChunkServiceServer is the server API for ChunkService service.
+// All implementations must embed UnimplementedChunkServiceServer
+// for forward compatibility.
+//
+// ChunkService v2 takes world IDs as UUID strings instead of bytes. Responses carry no
+// world IDs and are the v1 messages.
+type ChunkServiceServer interface {
+	// Chunk operations
+	GetChunk(context.Context, *GetChunkRequest) (*v1.GetChunkResponse, error)
+	GetChunks(context.Context, *GetChunksRequest) (*v1.GetChunksResponse, error)
+	GetChunksInRadius(context.Context, *GetChunksInRadiusRequest) (*v1.GetChunksInRadiusResponse, error)
+	// Individual cells by world coordinates
+	GetCells(context.Context, *GetCellsRequest) (*v1.GetCellsResponse, error)
+	// Precomputed per-chunk statistics for map overlays
+	GetChunkSummaries(context.Context, *GetChunkSummariesRequest) (*v1.GetChunkSummariesResponse, error)
+	mustEmbedUnimplementedChunkServiceServer()
+}
+
+// UnimplementedChunkServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedChunkServiceServer struct{}
+
+func (UnimplementedChunkServiceServer) GetChunk(context.Context, *GetChunkRequest) (*v1.GetChunkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChunk not implemented")
+}
+func (UnimplementedChunkServiceServer) GetChunks(context.Context, *GetChunksRequest) (*v1.GetChunksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChunks not implemented")
+}
+func (UnimplementedChunkServiceServer) GetChunksInRadius(context.Context, *GetChunksInRadiusRequest) (*v1.GetChunksInRadiusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChunksInRadius not implemented")
+}
+func (UnimplementedChunkServiceServer) GetCells(context.Context, *GetCellsRequest) (*v1.GetCellsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCells not implemented")
+}
+func (UnimplementedChunkServiceServer) GetChunkSummaries(context.Context, *GetChunkSummariesRequest) (*v1.GetChunkSummariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChunkSummaries not implemented")
+}
+func (UnimplementedChunkServiceServer) mustEmbedUnimplementedChunkServiceServer() {}
+func (UnimplementedChunkServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeChunkServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ChunkServiceServer will
+// result in compilation errors.
+type UnsafeChunkServiceServer interface {
+	mustEmbedUnimplementedChunkServiceServer()
+}
+
+func RegisterChunkServiceServer(s grpc.ServiceRegistrar, srv ChunkServiceServer) {
+	// If the following call pancis, it indicates UnimplementedChunkServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ChunkService_ServiceDesc, srv)
+}
+
+func _ChunkService_GetChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChunkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).GetChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_GetChunk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).GetChunk(ctx, req.(*GetChunkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChunkService_GetChunks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChunksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).GetChunks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_GetChunks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).GetChunks(ctx, req.(*GetChunksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChunkService_GetChunksInRadius_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChunksInRadiusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).GetChunksInRadius(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_GetChunksInRadius_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).GetChunksInRadius(ctx, req.(*GetChunksInRadiusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChunkService_GetCells_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCellsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).GetCells(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_GetCells_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).GetCells(ctx, req.(*GetCellsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChunkService_GetChunkSummaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChunkSummariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).GetChunkSummaries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_GetChunkSummaries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).GetChunkSummaries(ctx, req.(*GetChunkSummariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ChunkService_ServiceDesc is the grpc.ServiceDesc for ChunkService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ChunkService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "chunk.v2.ChunkService",
+	HandlerType: (*ChunkServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetChunk",
+			Handler:    _ChunkService_GetChunk_Handler,
+		},
+		{
+			MethodName: "GetChunks",
+			Handler:    _ChunkService_GetChunks_Handler,
+		},
+		{
+			MethodName: "GetChunksInRadius",
+			Handler:    _ChunkService_GetChunksInRadius_Handler,
+		},
+		{
+			MethodName: "GetCells",
+			Handler:    _ChunkService_GetCells_Handler,
+		},
+		{
+			MethodName: "GetChunkSummaries",
+			Handler:    _ChunkService_GetChunkSummaries_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "chunk/v2/chunk.proto",
+}
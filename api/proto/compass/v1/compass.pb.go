@@ -0,0 +1,682 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.4
+// source: compass/v1/compass.proto
+
+package v1
+
+import (
+	v1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PointOfInterestType int32
+
+const (
+	PointOfInterestType_POINT_OF_INTEREST_TYPE_UNSPECIFIED PointOfInterestType = 0
+	PointOfInterestType_POINT_OF_INTEREST_TYPE_WAYPOINT    PointOfInterestType = 1
+	PointOfInterestType_POINT_OF_INTEREST_TYPE_RARE_NODE   PointOfInterestType = 2 // Rare or very rare resource node the character has harvested
+	PointOfInterestType_POINT_OF_INTEREST_TYPE_FRIEND      PointOfInterestType = 3 // Character of a friend in the same world
+	PointOfInterestType_POINT_OF_INTEREST_TYPE_CLAIM       PointOfInterestType = 4 // Center of a chunk the character has claimed
+)
+
+// Enum value maps for PointOfInterestType.
+var (
+	PointOfInterestType_name = map[int32]string{
+		0: "POINT_OF_INTEREST_TYPE_UNSPECIFIED",
+		1: "POINT_OF_INTEREST_TYPE_WAYPOINT",
+		2: "POINT_OF_INTEREST_TYPE_RARE_NODE",
+		3: "POINT_OF_INTEREST_TYPE_FRIEND",
+		4: "POINT_OF_INTEREST_TYPE_CLAIM",
+	}
+	PointOfInterestType_value = map[string]int32{
+		"POINT_OF_INTEREST_TYPE_UNSPECIFIED": 0,
+		"POINT_OF_INTEREST_TYPE_WAYPOINT":    1,
+		"POINT_OF_INTEREST_TYPE_RARE_NODE":   2,
+		"POINT_OF_INTEREST_TYPE_FRIEND":      3,
+		"POINT_OF_INTEREST_TYPE_CLAIM":       4,
+	}
+)
+
+func (x PointOfInterestType) Enum() *PointOfInterestType {
+	p := new(PointOfInterestType)
+	*p = x
+	return p
+}
+
+func (x PointOfInterestType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PointOfInterestType) Descriptor() protoreflect.EnumDescriptor {
+	return file_compass_v1_compass_proto_enumTypes[0].Descriptor()
+}
+
+func (PointOfInterestType) Type() protoreflect.EnumType {
+	return &file_compass_v1_compass_proto_enumTypes[0]
+}
+
+func (x PointOfInterestType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PointOfInterestType.Descriptor instead.
+func (PointOfInterestType) EnumDescriptor() ([]byte, []int) {
+	return file_compass_v1_compass_proto_rawDescGZIP(), []int{0}
+}
+
+type PointOfInterest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Type               PointOfInterestType    `protobuf:"varint,1,opt,name=type,proto3,enum=compass.v1.PointOfInterestType" json:"type,omitempty"`
+	Id                 string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"` // Waypoint, resource node, character or claim ID
+	Name               string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	X                  int32                  `protobuf:"varint,4,opt,name=x,proto3" json:"x,omitempty"` // World cell coordinates
+	Y                  int32                  `protobuf:"varint,5,opt,name=y,proto3" json:"y,omitempty"`
+	Distance           float64                `protobuf:"fixed64,6,opt,name=distance,proto3" json:"distance,omitempty"`                                                                                           // In cells, straight line
+	Bearing            float64                `protobuf:"fixed64,7,opt,name=bearing,proto3" json:"bearing,omitempty"`                                                                                             // Degrees clockwise from north (negative y), 0 to 360
+	ResourceNodeTypeId v1.ResourceNodeTypeId  `protobuf:"varint,8,opt,name=resource_node_type_id,json=resourceNodeTypeId,proto3,enum=resource_node.v1.ResourceNodeTypeId" json:"resource_node_type_id,omitempty"` // Rare nodes only
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *PointOfInterest) Reset() {
+	*x = PointOfInterest{}
+	mi := &file_compass_v1_compass_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PointOfInterest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PointOfInterest) ProtoMessage() {}
+
+func (x *PointOfInterest) ProtoReflect() protoreflect.Message {
+	mi := &file_compass_v1_compass_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PointOfInterest.ProtoReflect.Descriptor instead.
+func (*PointOfInterest) Descriptor() ([]byte, []int) {
+	return file_compass_v1_compass_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PointOfInterest) GetType() PointOfInterestType {
+	if x != nil {
+		return x.Type
+	}
+	return PointOfInterestType_POINT_OF_INTEREST_TYPE_UNSPECIFIED
+}
+
+func (x *PointOfInterest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PointOfInterest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PointOfInterest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *PointOfInterest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *PointOfInterest) GetDistance() float64 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *PointOfInterest) GetBearing() float64 {
+	if x != nil {
+		return x.Bearing
+	}
+	return 0
+}
+
+func (x *PointOfInterest) GetResourceNodeTypeId() v1.ResourceNodeTypeId {
+	if x != nil {
+		return x.ResourceNodeTypeId
+	}
+	return v1.ResourceNodeTypeId(0)
+}
+
+type Waypoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	X             int32                  `protobuf:"varint,3,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,4,opt,name=y,proto3" json:"y,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Waypoint) Reset() {
+	*x = Waypoint{}
+	mi := &file_compass_v1_compass_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Waypoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Waypoint) ProtoMessage() {}
+
+func (x *Waypoint) ProtoReflect() protoreflect.Message {
+	mi := &file_compass_v1_compass_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Waypoint.ProtoReflect.Descriptor instead.
+func (*Waypoint) Descriptor() ([]byte, []int) {
+	return file_compass_v1_compass_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Waypoint) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Waypoint) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Waypoint) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *Waypoint) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *Waypoint) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetPointsOfInterestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	Radius        int32                  `protobuf:"varint,2,opt,name=radius,proto3" json:"radius,omitempty"`                                          // In cells; 0 uses the server default, larger values are capped
+	Types         []PointOfInterestType  `protobuf:"varint,3,rep,packed,name=types,proto3,enum=compass.v1.PointOfInterestType" json:"types,omitempty"` // Empty returns every type
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPointsOfInterestRequest) Reset() {
+	*x = GetPointsOfInterestRequest{}
+	mi := &file_compass_v1_compass_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPointsOfInterestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPointsOfInterestRequest) ProtoMessage() {}
+
+func (x *GetPointsOfInterestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_compass_v1_compass_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPointsOfInterestRequest.ProtoReflect.Descriptor instead.
+func (*GetPointsOfInterestRequest) Descriptor() ([]byte, []int) {
+	return file_compass_v1_compass_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetPointsOfInterestRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *GetPointsOfInterestRequest) GetRadius() int32 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+func (x *GetPointsOfInterestRequest) GetTypes() []PointOfInterestType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+type GetPointsOfInterestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Points        []*PointOfInterest     `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPointsOfInterestResponse) Reset() {
+	*x = GetPointsOfInterestResponse{}
+	mi := &file_compass_v1_compass_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPointsOfInterestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPointsOfInterestResponse) ProtoMessage() {}
+
+func (x *GetPointsOfInterestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_compass_v1_compass_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPointsOfInterestResponse.ProtoReflect.Descriptor instead.
+func (*GetPointsOfInterestResponse) Descriptor() ([]byte, []int) {
+	return file_compass_v1_compass_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetPointsOfInterestResponse) GetPoints() []*PointOfInterest {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type AddWaypointRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	X             int32                  `protobuf:"varint,3,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,4,opt,name=y,proto3" json:"y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddWaypointRequest) Reset() {
+	*x = AddWaypointRequest{}
+	mi := &file_compass_v1_compass_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddWaypointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddWaypointRequest) ProtoMessage() {}
+
+func (x *AddWaypointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_compass_v1_compass_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddWaypointRequest.ProtoReflect.Descriptor instead.
+func (*AddWaypointRequest) Descriptor() ([]byte, []int) {
+	return file_compass_v1_compass_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AddWaypointRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *AddWaypointRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddWaypointRequest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *AddWaypointRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type AddWaypointResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Waypoint      *Waypoint              `protobuf:"bytes,1,opt,name=waypoint,proto3" json:"waypoint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddWaypointResponse) Reset() {
+	*x = AddWaypointResponse{}
+	mi := &file_compass_v1_compass_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddWaypointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddWaypointResponse) ProtoMessage() {}
+
+func (x *AddWaypointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_compass_v1_compass_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddWaypointResponse.ProtoReflect.Descriptor instead.
+func (*AddWaypointResponse) Descriptor() ([]byte, []int) {
+	return file_compass_v1_compass_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AddWaypointResponse) GetWaypoint() *Waypoint {
+	if x != nil {
+		return x.Waypoint
+	}
+	return nil
+}
+
+type RemoveWaypointRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	WaypointId    int64                  `protobuf:"varint,2,opt,name=waypoint_id,json=waypointId,proto3" json:"waypoint_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveWaypointRequest) Reset() {
+	*x = RemoveWaypointRequest{}
+	mi := &file_compass_v1_compass_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveWaypointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveWaypointRequest) ProtoMessage() {}
+
+func (x *RemoveWaypointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_compass_v1_compass_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveWaypointRequest.ProtoReflect.Descriptor instead.
+func (*RemoveWaypointRequest) Descriptor() ([]byte, []int) {
+	return file_compass_v1_compass_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RemoveWaypointRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *RemoveWaypointRequest) GetWaypointId() int64 {
+	if x != nil {
+		return x.WaypointId
+	}
+	return 0
+}
+
+type RemoveWaypointResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveWaypointResponse) Reset() {
+	*x = RemoveWaypointResponse{}
+	mi := &file_compass_v1_compass_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveWaypointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveWaypointResponse) ProtoMessage() {}
+
+func (x *RemoveWaypointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_compass_v1_compass_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveWaypointResponse.ProtoReflect.Descriptor instead.
+func (*RemoveWaypointResponse) Descriptor() ([]byte, []int) {
+	return file_compass_v1_compass_proto_rawDescGZIP(), []int{7}
+}
+
+var File_compass_v1_compass_proto protoreflect.FileDescriptor
+
+const file_compass_v1_compass_proto_rawDesc = "" +
+	"\n" +
+	"\x18compass/v1/compass.proto\x12\n" +
+	"compass.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$resource_node/v1/resource_node.proto\"\x95\x02\n" +
+	"\x0fPointOfInterest\x123\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1f.compass.v1.PointOfInterestTypeR\x04type\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\f\n" +
+	"\x01x\x18\x04 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x05 \x01(\x05R\x01y\x12\x1a\n" +
+	"\bdistance\x18\x06 \x01(\x01R\bdistance\x12\x18\n" +
+	"\abearing\x18\a \x01(\x01R\abearing\x12W\n" +
+	"\x15resource_node_type_id\x18\b \x01(\x0e2$.resource_node.v1.ResourceNodeTypeIdR\x12resourceNodeTypeId\"\x85\x01\n" +
+	"\bWaypoint\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\f\n" +
+	"\x01x\x18\x03 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x04 \x01(\x05R\x01y\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x8e\x01\n" +
+	"\x1aGetPointsOfInterestRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x16\n" +
+	"\x06radius\x18\x02 \x01(\x05R\x06radius\x125\n" +
+	"\x05types\x18\x03 \x03(\x0e2\x1f.compass.v1.PointOfInterestTypeR\x05types\"R\n" +
+	"\x1bGetPointsOfInterestResponse\x123\n" +
+	"\x06points\x18\x01 \x03(\v2\x1b.compass.v1.PointOfInterestR\x06points\"g\n" +
+	"\x12AddWaypointRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\f\n" +
+	"\x01x\x18\x03 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x04 \x01(\x05R\x01y\"G\n" +
+	"\x13AddWaypointResponse\x120\n" +
+	"\bwaypoint\x18\x01 \x01(\v2\x14.compass.v1.WaypointR\bwaypoint\"[\n" +
+	"\x15RemoveWaypointRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x1f\n" +
+	"\vwaypoint_id\x18\x02 \x01(\x03R\n" +
+	"waypointId\"\x18\n" +
+	"\x16RemoveWaypointResponse*\xcd\x01\n" +
+	"\x13PointOfInterestType\x12&\n" +
+	"\"POINT_OF_INTEREST_TYPE_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fPOINT_OF_INTEREST_TYPE_WAYPOINT\x10\x01\x12$\n" +
+	" POINT_OF_INTEREST_TYPE_RARE_NODE\x10\x02\x12!\n" +
+	"\x1dPOINT_OF_INTEREST_TYPE_FRIEND\x10\x03\x12 \n" +
+	"\x1cPOINT_OF_INTEREST_TYPE_CLAIM\x10\x042\xa7\x02\n" +
+	"\x0eCompassService\x12h\n" +
+	"\x13GetPointsOfInterest\x12&.compass.v1.GetPointsOfInterestRequest\x1a'.compass.v1.GetPointsOfInterestResponse\"\x00\x12P\n" +
+	"\vAddWaypoint\x12\x1e.compass.v1.AddWaypointRequest\x1a\x1f.compass.v1.AddWaypointResponse\"\x00\x12Y\n" +
+	"\x0eRemoveWaypoint\x12!.compass.v1.RemoveWaypointRequest\x1a\".compass.v1.RemoveWaypointResponse\"\x00B.Z,github.com/VoidMesh/api/api/proto/compass/v1b\x06proto3"
+
+var (
+	file_compass_v1_compass_proto_rawDescOnce sync.Once
+	file_compass_v1_compass_proto_rawDescData []byte
+)
+
+func file_compass_v1_compass_proto_rawDescGZIP() []byte {
+	file_compass_v1_compass_proto_rawDescOnce.Do(func() {
+		file_compass_v1_compass_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_compass_v1_compass_proto_rawDesc), len(file_compass_v1_compass_proto_rawDesc)))
+	})
+	return file_compass_v1_compass_proto_rawDescData
+}
+
+var file_compass_v1_compass_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_compass_v1_compass_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_compass_v1_compass_proto_goTypes = []any{
+	(PointOfInterestType)(0),            // 0: compass.v1.PointOfInterestType
+	(*PointOfInterest)(nil),             // 1: compass.v1.PointOfInterest
+	(*Waypoint)(nil),                    // 2: compass.v1.Waypoint
+	(*GetPointsOfInterestRequest)(nil),  // 3: compass.v1.GetPointsOfInterestRequest
+	(*GetPointsOfInterestResponse)(nil), // 4: compass.v1.GetPointsOfInterestResponse
+	(*AddWaypointRequest)(nil),          // 5: compass.v1.AddWaypointRequest
+	(*AddWaypointResponse)(nil),         // 6: compass.v1.AddWaypointResponse
+	(*RemoveWaypointRequest)(nil),       // 7: compass.v1.RemoveWaypointRequest
+	(*RemoveWaypointResponse)(nil),      // 8: compass.v1.RemoveWaypointResponse
+	(v1.ResourceNodeTypeId)(0),          // 9: resource_node.v1.ResourceNodeTypeId
+	(*timestamppb.Timestamp)(nil),       // 10: google.protobuf.Timestamp
+}
+var file_compass_v1_compass_proto_depIdxs = []int32{
+	0,  // 0: compass.v1.PointOfInterest.type:type_name -> compass.v1.PointOfInterestType
+	9,  // 1: compass.v1.PointOfInterest.resource_node_type_id:type_name -> resource_node.v1.ResourceNodeTypeId
+	10, // 2: compass.v1.Waypoint.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: compass.v1.GetPointsOfInterestRequest.types:type_name -> compass.v1.PointOfInterestType
+	1,  // 4: compass.v1.GetPointsOfInterestResponse.points:type_name -> compass.v1.PointOfInterest
+	2,  // 5: compass.v1.AddWaypointResponse.waypoint:type_name -> compass.v1.Waypoint
+	3,  // 6: compass.v1.CompassService.GetPointsOfInterest:input_type -> compass.v1.GetPointsOfInterestRequest
+	5,  // 7: compass.v1.CompassService.AddWaypoint:input_type -> compass.v1.AddWaypointRequest
+	7,  // 8: compass.v1.CompassService.RemoveWaypoint:input_type -> compass.v1.RemoveWaypointRequest
+	4,  // 9: compass.v1.CompassService.GetPointsOfInterest:output_type -> compass.v1.GetPointsOfInterestResponse
+	6,  // 10: compass.v1.CompassService.AddWaypoint:output_type -> compass.v1.AddWaypointResponse
+	8,  // 11: compass.v1.CompassService.RemoveWaypoint:output_type -> compass.v1.RemoveWaypointResponse
+	9,  // [9:12] is the sub-list for method output_type
+	6,  // [6:9] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_compass_v1_compass_proto_init() }
+func file_compass_v1_compass_proto_init() {
+	if File_compass_v1_compass_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_compass_v1_compass_proto_rawDesc), len(file_compass_v1_compass_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_compass_v1_compass_proto_goTypes,
+		DependencyIndexes: file_compass_v1_compass_proto_depIdxs,
+		EnumInfos:         file_compass_v1_compass_proto_enumTypes,
+		MessageInfos:      file_compass_v1_compass_proto_msgTypes,
+	}.Build()
+	File_compass_v1_compass_proto = out.File
+	file_compass_v1_compass_proto_goTypes = nil
+	file_compass_v1_compass_proto_depIdxs = nil
+}
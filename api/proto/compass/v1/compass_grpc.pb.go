@@ -0,0 +1,207 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.4
+// source: compass/v1/compass.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CompassService_GetPointsOfInterest_FullMethodName = "/compass.v1.CompassService/GetPointsOfInterest"
+	CompassService_AddWaypoint_FullMethodName         = "/compass.v1.CompassService/AddWaypoint"
+	CompassService_RemoveWaypoint_FullMethodName      = "/compass.v1.CompassService/RemoveWaypoint"
+)
+
+// CompassServiceClient is the client API for CompassService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Notable locations around a character, with bearings and distances computed by the
+// server so clients can draw a compass without holding the world state.
+type CompassServiceClient interface {
+	// Lists the character's points of interest within a radius, nearest first
+	GetPointsOfInterest(ctx context.Context, in *GetPointsOfInterestRequest, opts ...grpc.CallOption) (*GetPointsOfInterestResponse, error)
+	// Waypoints are named markers a character places; they show on its compass
+	AddWaypoint(ctx context.Context, in *AddWaypointRequest, opts ...grpc.CallOption) (*AddWaypointResponse, error)
+	RemoveWaypoint(ctx context.Context, in *RemoveWaypointRequest, opts ...grpc.CallOption) (*RemoveWaypointResponse, error)
+}
+
+type compassServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCompassServiceClient(cc grpc.ClientConnInterface) CompassServiceClient {
+	return &compassServiceClient{cc}
+}
+
+func (c *compassServiceClient) GetPointsOfInterest(ctx context.Context, in *GetPointsOfInterestRequest, opts ...grpc.CallOption) (*GetPointsOfInterestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPointsOfInterestResponse)
+	err := c.cc.Invoke(ctx, CompassService_GetPointsOfInterest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compassServiceClient) AddWaypoint(ctx context.Context, in *AddWaypointRequest, opts ...grpc.CallOption) (*AddWaypointResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddWaypointResponse)
+	err := c.cc.Invoke(ctx, CompassService_AddWaypoint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compassServiceClient) RemoveWaypoint(ctx context.Context, in *RemoveWaypointRequest, opts ...grpc.CallOption) (*RemoveWaypointResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveWaypointResponse)
+	err := c.cc.Invoke(ctx, CompassService_RemoveWaypoint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CompassServiceServer is the server API for CompassService service.
+// All implementations must embed UnimplementedCompassServiceServer
+// for forward compatibility.
+//
+// Notable locations around a character, with bearings and distances computed by the
+// server so clients can draw a compass without holding the world state.
+type CompassServiceServer interface {
+	// Lists the character's points of interest within a radius, nearest first
+	GetPointsOfInterest(context.Context, *GetPointsOfInterestRequest) (*GetPointsOfInterestResponse, error)
+	// Waypoints are named markers a character places; they show on its compass
+	AddWaypoint(context.Context, *AddWaypointRequest) (*AddWaypointResponse, error)
+	RemoveWaypoint(context.Context, *RemoveWaypointRequest) (*RemoveWaypointResponse, error)
+	mustEmbedUnimplementedCompassServiceServer()
+}
+
+// UnimplementedCompassServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCompassServiceServer struct{}
+
+func (UnimplementedCompassServiceServer) GetPointsOfInterest(context.Context, *GetPointsOfInterestRequest) (*GetPointsOfInterestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPointsOfInterest not implemented")
+}
+func (UnimplementedCompassServiceServer) AddWaypoint(context.Context, *AddWaypointRequest) (*AddWaypointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWaypoint not implemented")
+}
+func (UnimplementedCompassServiceServer) RemoveWaypoint(context.Context, *RemoveWaypointRequest) (*RemoveWaypointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWaypoint not implemented")
+}
+func (UnimplementedCompassServiceServer) mustEmbedUnimplementedCompassServiceServer() {}
+func (UnimplementedCompassServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeCompassServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CompassServiceServer will
+// result in compilation errors.
+type UnsafeCompassServiceServer interface {
+	mustEmbedUnimplementedCompassServiceServer()
+}
+
+func RegisterCompassServiceServer(s grpc.ServiceRegistrar, srv CompassServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCompassServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CompassService_ServiceDesc, srv)
+}
+
+func _CompassService_GetPointsOfInterest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPointsOfInterestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).GetPointsOfInterest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_GetPointsOfInterest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).GetPointsOfInterest(ctx, req.(*GetPointsOfInterestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_AddWaypoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWaypointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).AddWaypoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_AddWaypoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).AddWaypoint(ctx, req.(*AddWaypointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_RemoveWaypoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveWaypointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).RemoveWaypoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_RemoveWaypoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).RemoveWaypoint(ctx, req.(*RemoveWaypointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CompassService_ServiceDesc is the grpc.ServiceDesc for CompassService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CompassService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "compass.v1.CompassService",
+	HandlerType: (*CompassServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPointsOfInterest",
+			Handler:    _CompassService_GetPointsOfInterest_Handler,
+		},
+		{
+			MethodName: "AddWaypoint",
+			Handler:    _CompassService_AddWaypoint_Handler,
+		},
+		{
+			MethodName: "RemoveWaypoint",
+			Handler:    _CompassService_RemoveWaypoint_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "compass/v1/compass.proto",
+}
@@ -0,0 +1,1666 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.4
+// source: debug/v1/debug.proto
+
+package v1
+
+import (
+	v1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ChunkAccessOrder int32
+
+const (
+	ChunkAccessOrder_CHUNK_ACCESS_ORDER_UNSPECIFIED   ChunkAccessOrder = 0 // Same as COLDEST
+	ChunkAccessOrder_CHUNK_ACCESS_ORDER_COLDEST       ChunkAccessOrder = 1 // Least recently read first
+	ChunkAccessOrder_CHUNK_ACCESS_ORDER_MOST_ACCESSED ChunkAccessOrder = 2 // Highest access count first
+)
+
+// Enum value maps for ChunkAccessOrder.
+var (
+	ChunkAccessOrder_name = map[int32]string{
+		0: "CHUNK_ACCESS_ORDER_UNSPECIFIED",
+		1: "CHUNK_ACCESS_ORDER_COLDEST",
+		2: "CHUNK_ACCESS_ORDER_MOST_ACCESSED",
+	}
+	ChunkAccessOrder_value = map[string]int32{
+		"CHUNK_ACCESS_ORDER_UNSPECIFIED":   0,
+		"CHUNK_ACCESS_ORDER_COLDEST":       1,
+		"CHUNK_ACCESS_ORDER_MOST_ACCESSED": 2,
+	}
+)
+
+func (x ChunkAccessOrder) Enum() *ChunkAccessOrder {
+	p := new(ChunkAccessOrder)
+	*p = x
+	return p
+}
+
+func (x ChunkAccessOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChunkAccessOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_debug_v1_debug_proto_enumTypes[0].Descriptor()
+}
+
+func (ChunkAccessOrder) Type() protoreflect.EnumType {
+	return &file_debug_v1_debug_proto_enumTypes[0]
+}
+
+func (x ChunkAccessOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChunkAccessOrder.Descriptor instead.
+func (ChunkAccessOrder) EnumDescriptor() ([]byte, []int) {
+	return file_debug_v1_debug_proto_rawDescGZIP(), []int{0}
+}
+
+// A registered gRPC service and its methods
+type ServiceInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Methods       []string               `protobuf:"bytes,2,rep,name=methods,proto3" json:"methods,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceInfo) Reset() {
+	*x = ServiceInfo{}
+	mi := &file_debug_v1_debug_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceInfo) ProtoMessage() {}
+
+func (x *ServiceInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_v1_debug_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceInfo.ProtoReflect.Descriptor instead.
+func (*ServiceInfo) Descriptor() ([]byte, []int) {
+	return file_debug_v1_debug_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ServiceInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServiceInfo) GetMethods() []string {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+type GetServerStateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServerStateRequest) Reset() {
+	*x = GetServerStateRequest{}
+	mi := &file_debug_v1_debug_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServerStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServerStateRequest) ProtoMessage() {}
+
+func (x *GetServerStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_v1_debug_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServerStateRequest.ProtoReflect.Descriptor instead.
+func (*GetServerStateRequest) Descriptor() ([]byte, []int) {
+	return file_debug_v1_debug_proto_rawDescGZIP(), []int{1}
+}
+
+type GetServerStateResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Services            []*ServiceInfo         `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+	StreamSubscriptions map[string]int64       `protobuf:"bytes,2,rep,name=stream_subscriptions,json=streamSubscriptions,proto3" json:"stream_subscriptions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // Active subscribers per stream
+	CacheEntries        map[string]int64       `protobuf:"bytes,3,rep,name=cache_entries,json=cacheEntries,proto3" json:"cache_entries,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`                      // Entry counts per in-memory cache
+	Queues              map[string]int64       `protobuf:"bytes,4,rep,name=queues,proto3" json:"queues,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`                                                      // Pending work per queue, e.g. chunk generation
+	ReflectionEnabled   bool                   `protobuf:"varint,5,opt,name=reflection_enabled,json=reflectionEnabled,proto3" json:"reflection_enabled,omitempty"`
+	Goroutines          int32                  `protobuf:"varint,6,opt,name=goroutines,proto3" json:"goroutines,omitempty"`
+	GoVersion           string                 `protobuf:"bytes,7,opt,name=go_version,json=goVersion,proto3" json:"go_version,omitempty"`
+	StartedAt           *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	BackgroundJobs      map[string]int64       `protobuf:"bytes,9,rep,name=background_jobs,json=backgroundJobs,proto3" json:"background_jobs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`           // 1 while a background job is running, 0 once it has exited
+	CompressionRatios   map[string]int64       `protobuf:"bytes,10,rep,name=compression_ratios,json=compressionRatios,proto3" json:"compression_ratios,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // Response bytes before compression per byte sent, x100, per method
+	Database            map[string]int64       `protobuf:"bytes,11,rep,name=database,proto3" json:"database,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`                                            // Circuit breaker state (0 closed, 1 open, 2 half-open), trips and failures in the current window
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetServerStateResponse) Reset() {
+	*x = GetServerStateResponse{}
+	mi := &file_debug_v1_debug_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServerStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServerStateResponse) ProtoMessage() {}
+
+func (x *GetServerStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_v1_debug_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServerStateResponse.ProtoReflect.Descriptor instead.
+func (*GetServerStateResponse) Descriptor() ([]byte, []int) {
+	return file_debug_v1_debug_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetServerStateResponse) GetServices() []*ServiceInfo {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *GetServerStateResponse) GetStreamSubscriptions() map[string]int64 {
+	if x != nil {
+		return x.StreamSubscriptions
+	}
+	return nil
+}
+
+func (x *GetServerStateResponse) GetCacheEntries() map[string]int64 {
+	if x != nil {
+		return x.CacheEntries
+	}
+	return nil
+}
+
+func (x *GetServerStateResponse) GetQueues() map[string]int64 {
+	if x != nil {
+		return x.Queues
+	}
+	return nil
+}
+
+func (x *GetServerStateResponse) GetReflectionEnabled() bool {
+	if x != nil {
+		return x.ReflectionEnabled
+	}
+	return false
+}
+
+func (x *GetServerStateResponse) GetGoroutines() int32 {
+	if x != nil {
+		return x.Goroutines
+	}
+	return 0
+}
+
+func (x *GetServerStateResponse) GetGoVersion() string {
+	if x != nil {
+		return x.GoVersion
+	}
+	return ""
+}
+
+func (x *GetServerStateResponse) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *GetServerStateResponse) GetBackgroundJobs() map[string]int64 {
+	if x != nil {
+		return x.BackgroundJobs
+	}
+	return nil
+}
+
+func (x *GetServerStateResponse) GetCompressionRatios() map[string]int64 {
+	if x != nil {
+		return x.CompressionRatios
+	}
+	return nil
+}
+
+func (x *GetServerStateResponse) GetDatabase() map[string]int64 {
+	if x != nil {
+		return x.Database
+	}
+	return nil
+}
+
+// A recording of the mutating events in a rectangle of chunks (bounds inclusive)
+type RegionRecording struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	WorldId       string                 `protobuf:"bytes,2,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
+	MinChunkX     int32                  `protobuf:"varint,3,opt,name=min_chunk_x,json=minChunkX,proto3" json:"min_chunk_x,omitempty"`
+	MinChunkY     int32                  `protobuf:"varint,4,opt,name=min_chunk_y,json=minChunkY,proto3" json:"min_chunk_y,omitempty"`
+	MaxChunkX     int32                  `protobuf:"varint,5,opt,name=max_chunk_x,json=maxChunkX,proto3" json:"max_chunk_x,omitempty"`
+	MaxChunkY     int32                  `protobuf:"varint,6,opt,name=max_chunk_y,json=maxChunkY,proto3" json:"max_chunk_y,omitempty"`
+	StartedBy     string                 `protobuf:"bytes,7,opt,name=started_by,json=startedBy,proto3" json:"started_by,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	StoppedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=stopped_at,json=stoppedAt,proto3" json:"stopped_at,omitempty"` // Unset unless stopped early
+	Active        bool                   `protobuf:"varint,11,opt,name=active,proto3" json:"active,omitempty"`                       // Still recording on this instance
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegionRecording) Reset() {
+	*x = RegionRecording{}
+	mi := &file_debug_v1_debug_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegionRecording) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegionRecording) ProtoMessage() {}
+
+func (x *RegionRecording) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_v1_debug_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegionRecording.ProtoReflect.Descriptor instead.
+func (*RegionRecording) Descriptor() ([]byte, []int) {
+	return file_debug_v1_debug_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RegionRecording) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RegionRecording) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *RegionRecording) GetMinChunkX() int32 {
+	if x != nil {
+		return x.MinChunkX
+	}
+	return 0
+}
+
+func (x *RegionRecording) GetMinChunkY() int32 {
+	if x != nil {
+		return x.MinChunkY
+	}
+	return 0
+}
+
+func (x *RegionRecording) GetMaxChunkX() int32 {
+	if x != nil {
+		return x.MaxChunkX
+	}
+	return 0
+}
+
+func (x *RegionRecording) GetMaxChunkY() int32 {
+	if x != nil {
+		return x.MaxChunkY
+	}
+	return 0
+}
+
+func (x *RegionRecording) GetStartedBy() string {
+	if x != nil {
+		return x.StartedBy
+	}
+	return ""
+}
+
+func (x *RegionRecording) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *RegionRecording) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *RegionRecording) GetStoppedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StoppedAt
+	}
+	return nil
+}
+
+func (x *RegionRecording) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+// A recorded event. Steps are numbered from 1 in the order events were recorded.
+type RecordedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Step          int32                  `protobuf:"varint,1,opt,name=step,proto3" json:"step,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`                                  // character.moved, character.created, chunk.generated, resource.harvested, terrain.modified
+	CharacterId   string                 `protobuf:"bytes,3,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"` // Empty when the event has no character
+	X             int32                  `protobuf:"varint,4,opt,name=x,proto3" json:"x,omitempty"`                                       // Cell for positional events, the chunk origin otherwise
+	Y             int32                  `protobuf:"varint,5,opt,name=y,proto3" json:"y,omitempty"`
+	ChunkX        int32                  `protobuf:"varint,6,opt,name=chunk_x,json=chunkX,proto3" json:"chunk_x,omitempty"`
+	ChunkY        int32                  `protobuf:"varint,7,opt,name=chunk_y,json=chunkY,proto3" json:"chunk_y,omitempty"`
+	PayloadJson   string                 `protobuf:"bytes,8,opt,name=payload_json,json=payloadJson,proto3" json:"payload_json,omitempty"` // The event as published
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordedEvent) Reset() {
+	*x = RecordedEvent{}
+	mi := &file_debug_v1_debug_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordedEvent) ProtoMessage() {}
+
+func (x *RecordedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_v1_debug_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordedEvent.ProtoReflect.Descriptor instead.
+func (*RecordedEvent) Descriptor() ([]byte, []int) {
+	return file_debug_v1_debug_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RecordedEvent) GetStep() int32 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+func (x *RecordedEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RecordedEvent) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *RecordedEvent) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *RecordedEvent) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *RecordedEvent) GetChunkX() int32 {
+	if x != nil {
+		return x.ChunkX
+	}
+	return 0
+}
+
+func (x *RecordedEvent) GetChunkY() int32 {
+	if x != nil {
+		return x.ChunkY
+	}
+	return 0
+}
+
+func (x *RecordedEvent) GetPayloadJson() string {
+	if x != nil {
+		return x.PayloadJson
+	}
+	return ""
+}
+
+func (x *RecordedEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// A cell whose terrain was modified during the replay
+type ReplayCell struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             int32                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	TerrainType   v1.TerrainType         `protobuf:"varint,3,opt,name=terrain_type,json=terrainType,proto3,enum=chunk.v1.TerrainType" json:"terrain_type,omitempty"`
+	Modifications int32                  `protobuf:"varint,4,opt,name=modifications,proto3" json:"modifications,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayCell) Reset() {
+	*x = ReplayCell{}
+	mi := &file_debug_v1_debug_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayCell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayCell) ProtoMessage() {}
+
+func (x *ReplayCell) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_v1_debug_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayCell.ProtoReflect.Descriptor instead.
+func (*ReplayCell) Descriptor() ([]byte, []int) {
+	return file_debug_v1_debug_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReplayCell) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *ReplayCell) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *ReplayCell) GetTerrainType() v1.TerrainType {
+	if x != nil {
+		return x.TerrainType
+	}
+	return v1.TerrainType(0)
+}
+
+func (x *ReplayCell) GetModifications() int32 {
+	if x != nil {
+		return x.Modifications
+	}
+	return 0
+}
+
+// The last recorded position of a character
+type ReplayCharacter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	X             int32                  `protobuf:"varint,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
+	LastStep      int32                  `protobuf:"varint,4,opt,name=last_step,json=lastStep,proto3" json:"last_step,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayCharacter) Reset() {
+	*x = ReplayCharacter{}
+	mi := &file_debug_v1_debug_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayCharacter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayCharacter) ProtoMessage() {}
+
+func (x *ReplayCharacter) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_v1_debug_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayCharacter.ProtoReflect.Descriptor instead.
+func (*ReplayCharacter) Descriptor() ([]byte, []int) {
+	return file_debug_v1_debug_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReplayCharacter) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *ReplayCharacter) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *ReplayCharacter) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *ReplayCharacter) GetLastStep() int32 {
+	if x != nil {
+		return x.LastStep
+	}
+	return 0
+}
+
+// Harvest totals for a resource node
+type ReplayResourceNode struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ResourceNodeId int32                  `protobuf:"varint,1,opt,name=resource_node_id,json=resourceNodeId,proto3" json:"resource_node_id,omitempty"`
+	Harvests       int32                  `protobuf:"varint,2,opt,name=harvests,proto3" json:"harvests,omitempty"`
+	Drops          int32                  `protobuf:"varint,3,opt,name=drops,proto3" json:"drops,omitempty"`
+	LastStep       int32                  `protobuf:"varint,4,opt,name=last_step,json=lastStep,proto3" json:"last_step,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReplayResourceNode) Reset() {
+	*x = ReplayResourceNode{}
+	mi := &file_debug_v1_debug_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayResourceNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayResourceNode) ProtoMessage() {}
+
+func (x *ReplayResourceNode) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_v1_debug_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayResourceNode.ProtoReflect.Descriptor instead.
+func (*ReplayResourceNode) Descriptor() ([]byte, []int) {
+	return file_debug_v1_debug_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReplayResourceNode) GetResourceNodeId() int32 {
+	if x != nil {
+		return x.ResourceNodeId
+	}
+	return 0
+}
+
+func (x *ReplayResourceNode) GetHarvests() int32 {
+	if x != nil {
+		return x.Harvests
+	}
+	return 0
+}
+
+func (x *ReplayResourceNode) GetDrops() int32 {
+	if x != nil {
+		return x.Drops
+	}
+	return 0
+}
+
+func (x *ReplayResourceNode) GetLastStep() int32 {
+	if x != nil {
+		return x.LastStep
+	}
+	return 0
+}
+
+// Region state rebuilt from the recorded events up to a step
+type ReplayState struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Cells           []*ReplayCell          `protobuf:"bytes,1,rep,name=cells,proto3" json:"cells,omitempty"`
+	Characters      []*ReplayCharacter     `protobuf:"bytes,2,rep,name=characters,proto3" json:"characters,omitempty"`
+	ResourceNodes   []*ReplayResourceNode  `protobuf:"bytes,3,rep,name=resource_nodes,json=resourceNodes,proto3" json:"resource_nodes,omitempty"`
+	GeneratedChunks []*v1.ChunkCoordinate  `protobuf:"bytes,4,rep,name=generated_chunks,json=generatedChunks,proto3" json:"generated_chunks,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReplayState) Reset() {
+	*x = ReplayState{}
+	mi := &file_debug_v1_debug_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayState) ProtoMessage() {}
+
+func (x *ReplayState) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_v1_debug_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayState.ProtoReflect.Descriptor instead.
+func (*ReplayState) Descriptor() ([]byte, []int) {
+	return file_debug_v1_debug_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReplayState) GetCells() []*ReplayCell {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+func (x *ReplayState) GetCharacters() []*ReplayCharacter {
+	if x != nil {
+		return x.Characters
+	}
+	return nil
+}
+
+func (x *ReplayState) GetResourceNodes() []*ReplayResourceNode {
+	if x != nil {
+		return x.ResourceNodes
+	}
+	return nil
+}
+
+func (x *ReplayState) GetGeneratedChunks() []*v1.ChunkCoordinate {
+	if x != nil {
+		return x.GeneratedChunks
+	}
+	return nil
+}
+
+type StartRegionRecordingRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	WorldId         string                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"` // Defaults to the session world
+	MinChunkX       int32                  `protobuf:"varint,2,opt,name=min_chunk_x,json=minChunkX,proto3" json:"min_chunk_x,omitempty"`
+	MinChunkY       int32                  `protobuf:"varint,3,opt,name=min_chunk_y,json=minChunkY,proto3" json:"min_chunk_y,omitempty"`
+	MaxChunkX       int32                  `protobuf:"varint,4,opt,name=max_chunk_x,json=maxChunkX,proto3" json:"max_chunk_x,omitempty"`
+	MaxChunkY       int32                  `protobuf:"varint,5,opt,name=max_chunk_y,json=maxChunkY,proto3" json:"max_chunk_y,omitempty"`
+	DurationSeconds int32                  `protobuf:"varint,6,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"` // Defaults to 10 minutes, at most an hour
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StartRegionRecordingRequest) Reset() {
+	*x = StartRegionRecordingRequest{}
+	mi := &file_debug_v1_debug_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartRegionRecordingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartRegionRecordingRequest) ProtoMessage() {}
+
+func (x *StartRegionRecordingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_v1_debug_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartRegionRecordingRequest.ProtoReflect.Descriptor instead.
+func (*StartRegionRecordingRequest) Descriptor() ([]byte, []int) {
+	return file_debug_v1_debug_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *StartRegionRecordingRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *StartRegionRecordingRequest) GetMinChunkX() int32 {
+	if x != nil {
+		return x.MinChunkX
+	}
+	return 0
+}
+
+func (x *StartRegionRecordingRequest) GetMinChunkY() int32 {
+	if x != nil {
+		return x.MinChunkY
+	}
+	return 0
+}
+
+func (x *StartRegionRecordingRequest) GetMaxChunkX() int32 {
+	if x != nil {
+		return x.MaxChunkX
+	}
+	return 0
+}
+
+func (x *StartRegionRecordingRequest) GetMaxChunkY() int32 {
+	if x != nil {
+		return x.MaxChunkY
+	}
+	return 0
+}
+
+func (x *StartRegionRecordingRequest) GetDurationSeconds() int32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+type StartRegionRecordingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recording     *RegionRecording       `protobuf:"bytes,1,opt,name=recording,proto3" json:"recording,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartRegionRecordingResponse) Reset() {
+	*x = StartRegionRecordingResponse{}
+	mi := &file_debug_v1_debug_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartRegionRecordingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartRegionRecordingResponse) ProtoMessage() {}
+
+func (x *StartRegionRecordingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_v1_debug_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartRegionRecordingResponse.ProtoReflect.Descriptor instead.
+func (*StartRegionRecordingResponse) Descriptor() ([]byte, []int) {
+	return file_debug_v1_debug_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StartRegionRecordingResponse) GetRecording() *RegionRecording {
+	if x != nil {
+		return x.Recording
+	}
+	return nil
+}
+
+type StopRegionRecordingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecordingId   int64                  `protobuf:"varint,1,opt,name=recording_id,json=recordingId,proto3" json:"recording_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopRegionRecordingRequest) Reset() {
+	*x = StopRegionRecordingRequest{}
+	mi := &file_debug_v1_debug_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopRegionRecordingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopRegionRecordingRequest) ProtoMessage() {}
+
+func (x *StopRegionRecordingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_v1_debug_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopRegionRecordingRequest.ProtoReflect.Descriptor instead.
+func (*StopRegionRecordingRequest) Descriptor() ([]byte, []int) {
+	return file_debug_v1_debug_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StopRegionRecordingRequest) GetRecordingId() int64 {
+	if x != nil {
+		return x.RecordingId
+	}
+	return 0
+}
+
+type StopRegionRecordingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recording     *RegionRecording       `protobuf:"bytes,1,opt,name=recording,proto3" json:"recording,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopRegionRecordingResponse) Reset() {
+	*x = StopRegionRecordingResponse{}
+	mi := &file_debug_v1_debug_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopRegionRecordingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopRegionRecordingResponse) ProtoMessage() {}
+
+func (x *StopRegionRecordingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_v1_debug_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopRegionRecordingResponse.ProtoReflect.Descriptor instead.
+func (*StopRegionRecordingResponse) Descriptor() ([]byte, []int) {
+	return file_debug_v1_debug_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *StopRegionRecordingResponse) GetRecording() *RegionRecording {
+	if x != nil {
+		return x.Recording
+	}
+	return nil
+}
+
+type ListRegionRecordingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // Defaults to 20, at most 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRegionRecordingsRequest) Reset() {
+	*x = ListRegionRecordingsRequest{}
+	mi := &file_debug_v1_debug_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRegionRecordingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRegionRecordingsRequest) ProtoMessage() {}
+
+func (x *ListRegionRecordingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_v1_debug_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRegionRecordingsRequest.ProtoReflect.Descriptor instead.
+func (*ListRegionRecordingsRequest) Descriptor() ([]byte, []int) {
+	return file_debug_v1_debug_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListRegionRecordingsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListRegionRecordingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recordings    []*RegionRecording     `protobuf:"bytes,1,rep,name=recordings,proto3" json:"recordings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRegionRecordingsResponse) Reset() {
+	*x = ListRegionRecordingsResponse{}
+	mi := &file_debug_v1_debug_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRegionRecordingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRegionRecordingsResponse) ProtoMessage() {}
+
+func (x *ListRegionRecordingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_v1_debug_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRegionRecordingsResponse.ProtoReflect.Descriptor instead.
+func (*ListRegionRecordingsResponse) Descriptor() ([]byte, []int) {
+	return file_debug_v1_debug_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListRegionRecordingsResponse) GetRecordings() []*RegionRecording {
+	if x != nil {
+		return x.Recordings
+	}
+	return nil
+}
+
+type StepRegionReplayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecordingId   int64                  `protobuf:"varint,1,opt,name=recording_id,json=recordingId,proto3" json:"recording_id,omitempty"`
+	Step          int32                  `protobuf:"varint,2,opt,name=step,proto3" json:"step,omitempty"` // 0 returns the empty starting state
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StepRegionReplayRequest) Reset() {
+	*x = StepRegionReplayRequest{}
+	mi := &file_debug_v1_debug_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StepRegionReplayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StepRegionReplayRequest) ProtoMessage() {}
+
+func (x *StepRegionReplayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_v1_debug_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StepRegionReplayRequest.ProtoReflect.Descriptor instead.
+func (*StepRegionReplayRequest) Descriptor() ([]byte, []int) {
+	return file_debug_v1_debug_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *StepRegionReplayRequest) GetRecordingId() int64 {
+	if x != nil {
+		return x.RecordingId
+	}
+	return 0
+}
+
+func (x *StepRegionReplayRequest) GetStep() int32 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+type StepRegionReplayResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recording     *RegionRecording       `protobuf:"bytes,1,opt,name=recording,proto3" json:"recording,omitempty"`
+	Event         *RecordedEvent         `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"` // Unset for step 0
+	State         *ReplayState           `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"` // State after applying steps 1 through step
+	TotalSteps    int32                  `protobuf:"varint,4,opt,name=total_steps,json=totalSteps,proto3" json:"total_steps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StepRegionReplayResponse) Reset() {
+	*x = StepRegionReplayResponse{}
+	mi := &file_debug_v1_debug_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StepRegionReplayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StepRegionReplayResponse) ProtoMessage() {}
+
+func (x *StepRegionReplayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_v1_debug_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StepRegionReplayResponse.ProtoReflect.Descriptor instead.
+func (*StepRegionReplayResponse) Descriptor() ([]byte, []int) {
+	return file_debug_v1_debug_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *StepRegionReplayResponse) GetRecording() *RegionRecording {
+	if x != nil {
+		return x.Recording
+	}
+	return nil
+}
+
+func (x *StepRegionReplayResponse) GetEvent() *RecordedEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *StepRegionReplayResponse) GetState() *ReplayState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *StepRegionReplayResponse) GetTotalSteps() int32 {
+	if x != nil {
+		return x.TotalSteps
+	}
+	return 0
+}
+
+// Read statistics of a chunk. Reads are flushed in batches, so counts lag by up to the flush interval.
+type ChunkAccessStats struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Chunk          *v1.ChunkCoordinate    `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	AccessCount    int64                  `protobuf:"varint,2,opt,name=access_count,json=accessCount,proto3" json:"access_count,omitempty"`
+	LastAccessedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_accessed_at,json=lastAccessedAt,proto3" json:"last_accessed_at,omitempty"` // Unset if not read since access tracking began
+	GeneratedAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ChunkAccessStats) Reset() {
+	*x = ChunkAccessStats{}
+	mi := &file_debug_v1_debug_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChunkAccessStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChunkAccessStats) ProtoMessage() {}
+
+func (x *ChunkAccessStats) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_v1_debug_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChunkAccessStats.ProtoReflect.Descriptor instead.
+func (*ChunkAccessStats) Descriptor() ([]byte, []int) {
+	return file_debug_v1_debug_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ChunkAccessStats) GetChunk() *v1.ChunkCoordinate {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *ChunkAccessStats) GetAccessCount() int64 {
+	if x != nil {
+		return x.AccessCount
+	}
+	return 0
+}
+
+func (x *ChunkAccessStats) GetLastAccessedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAccessedAt
+	}
+	return nil
+}
+
+func (x *ChunkAccessStats) GetGeneratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return nil
+}
+
+type ListChunkAccessStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorldId       string                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"` // Defaults to the default world
+	Order         ChunkAccessOrder       `protobuf:"varint,2,opt,name=order,proto3,enum=debug.v1.ChunkAccessOrder" json:"order,omitempty"`
+	IdleSeconds   int32                  `protobuf:"varint,3,opt,name=idle_seconds,json=idleSeconds,proto3" json:"idle_seconds,omitempty"` // COLDEST only: skip chunks read in the last idle_seconds
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                                // Defaults to 500, at most 500
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChunkAccessStatsRequest) Reset() {
+	*x = ListChunkAccessStatsRequest{}
+	mi := &file_debug_v1_debug_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChunkAccessStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChunkAccessStatsRequest) ProtoMessage() {}
+
+func (x *ListChunkAccessStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_v1_debug_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChunkAccessStatsRequest.ProtoReflect.Descriptor instead.
+func (*ListChunkAccessStatsRequest) Descriptor() ([]byte, []int) {
+	return file_debug_v1_debug_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListChunkAccessStatsRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *ListChunkAccessStatsRequest) GetOrder() ChunkAccessOrder {
+	if x != nil {
+		return x.Order
+	}
+	return ChunkAccessOrder_CHUNK_ACCESS_ORDER_UNSPECIFIED
+}
+
+func (x *ListChunkAccessStatsRequest) GetIdleSeconds() int32 {
+	if x != nil {
+		return x.IdleSeconds
+	}
+	return 0
+}
+
+func (x *ListChunkAccessStatsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListChunkAccessStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chunks        []*ChunkAccessStats    `protobuf:"bytes,1,rep,name=chunks,proto3" json:"chunks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChunkAccessStatsResponse) Reset() {
+	*x = ListChunkAccessStatsResponse{}
+	mi := &file_debug_v1_debug_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChunkAccessStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChunkAccessStatsResponse) ProtoMessage() {}
+
+func (x *ListChunkAccessStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_v1_debug_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChunkAccessStatsResponse.ProtoReflect.Descriptor instead.
+func (*ListChunkAccessStatsResponse) Descriptor() ([]byte, []int) {
+	return file_debug_v1_debug_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListChunkAccessStatsResponse) GetChunks() []*ChunkAccessStats {
+	if x != nil {
+		return x.Chunks
+	}
+	return nil
+}
+
+var File_debug_v1_debug_proto protoreflect.FileDescriptor
+
+const file_debug_v1_debug_proto_rawDesc = "" +
+	"\n" +
+	"\x14debug/v1/debug.proto\x12\bdebug.v1\x1a\x14chunk/v1/chunk.proto\x1a\x1fgoogle/protobuf/timestamp.proto\";\n" +
+	"\vServiceInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\amethods\x18\x02 \x03(\tR\amethods\"\x17\n" +
+	"\x15GetServerStateRequest\"\x9e\t\n" +
+	"\x16GetServerStateResponse\x121\n" +
+	"\bservices\x18\x01 \x03(\v2\x15.debug.v1.ServiceInfoR\bservices\x12l\n" +
+	"\x14stream_subscriptions\x18\x02 \x03(\v29.debug.v1.GetServerStateResponse.StreamSubscriptionsEntryR\x13streamSubscriptions\x12W\n" +
+	"\rcache_entries\x18\x03 \x03(\v22.debug.v1.GetServerStateResponse.CacheEntriesEntryR\fcacheEntries\x12D\n" +
+	"\x06queues\x18\x04 \x03(\v2,.debug.v1.GetServerStateResponse.QueuesEntryR\x06queues\x12-\n" +
+	"\x12reflection_enabled\x18\x05 \x01(\bR\x11reflectionEnabled\x12\x1e\n" +
+	"\n" +
+	"goroutines\x18\x06 \x01(\x05R\n" +
+	"goroutines\x12\x1d\n" +
+	"\n" +
+	"go_version\x18\a \x01(\tR\tgoVersion\x129\n" +
+	"\n" +
+	"started_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12]\n" +
+	"\x0fbackground_jobs\x18\t \x03(\v24.debug.v1.GetServerStateResponse.BackgroundJobsEntryR\x0ebackgroundJobs\x12f\n" +
+	"\x12compression_ratios\x18\n" +
+	" \x03(\v27.debug.v1.GetServerStateResponse.CompressionRatiosEntryR\x11compressionRatios\x12J\n" +
+	"\bdatabase\x18\v \x03(\v2..debug.v1.GetServerStateResponse.DatabaseEntryR\bdatabase\x1aF\n" +
+	"\x18StreamSubscriptionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1a?\n" +
+	"\x11CacheEntriesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1a9\n" +
+	"\vQueuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1aA\n" +
+	"\x13BackgroundJobsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1aD\n" +
+	"\x16CompressionRatiosEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1a;\n" +
+	"\rDatabaseEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\x9e\x03\n" +
+	"\x0fRegionRecording\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\bworld_id\x18\x02 \x01(\tR\aworldId\x12\x1e\n" +
+	"\vmin_chunk_x\x18\x03 \x01(\x05R\tminChunkX\x12\x1e\n" +
+	"\vmin_chunk_y\x18\x04 \x01(\x05R\tminChunkY\x12\x1e\n" +
+	"\vmax_chunk_x\x18\x05 \x01(\x05R\tmaxChunkX\x12\x1e\n" +
+	"\vmax_chunk_y\x18\x06 \x01(\x05R\tmaxChunkY\x12\x1d\n" +
+	"\n" +
+	"started_by\x18\a \x01(\tR\tstartedBy\x129\n" +
+	"\n" +
+	"started_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x123\n" +
+	"\aends_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x129\n" +
+	"\n" +
+	"stopped_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tstoppedAt\x12\x16\n" +
+	"\x06active\x18\v \x01(\bR\x06active\"\x88\x02\n" +
+	"\rRecordedEvent\x12\x12\n" +
+	"\x04step\x18\x01 \x01(\x05R\x04step\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12!\n" +
+	"\fcharacter_id\x18\x03 \x01(\tR\vcharacterId\x12\f\n" +
+	"\x01x\x18\x04 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x05 \x01(\x05R\x01y\x12\x17\n" +
+	"\achunk_x\x18\x06 \x01(\x05R\x06chunkX\x12\x17\n" +
+	"\achunk_y\x18\a \x01(\x05R\x06chunkY\x12!\n" +
+	"\fpayload_json\x18\b \x01(\tR\vpayloadJson\x12;\n" +
+	"\voccurred_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"\x88\x01\n" +
+	"\n" +
+	"ReplayCell\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x05R\x01y\x128\n" +
+	"\fterrain_type\x18\x03 \x01(\x0e2\x15.chunk.v1.TerrainTypeR\vterrainType\x12$\n" +
+	"\rmodifications\x18\x04 \x01(\x05R\rmodifications\"m\n" +
+	"\x0fReplayCharacter\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\f\n" +
+	"\x01x\x18\x02 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x03 \x01(\x05R\x01y\x12\x1b\n" +
+	"\tlast_step\x18\x04 \x01(\x05R\blastStep\"\x8d\x01\n" +
+	"\x12ReplayResourceNode\x12(\n" +
+	"\x10resource_node_id\x18\x01 \x01(\x05R\x0eresourceNodeId\x12\x1a\n" +
+	"\bharvests\x18\x02 \x01(\x05R\bharvests\x12\x14\n" +
+	"\x05drops\x18\x03 \x01(\x05R\x05drops\x12\x1b\n" +
+	"\tlast_step\x18\x04 \x01(\x05R\blastStep\"\xff\x01\n" +
+	"\vReplayState\x12*\n" +
+	"\x05cells\x18\x01 \x03(\v2\x14.debug.v1.ReplayCellR\x05cells\x129\n" +
+	"\n" +
+	"characters\x18\x02 \x03(\v2\x19.debug.v1.ReplayCharacterR\n" +
+	"characters\x12C\n" +
+	"\x0eresource_nodes\x18\x03 \x03(\v2\x1c.debug.v1.ReplayResourceNodeR\rresourceNodes\x12D\n" +
+	"\x10generated_chunks\x18\x04 \x03(\v2\x19.chunk.v1.ChunkCoordinateR\x0fgeneratedChunks\"\xe3\x01\n" +
+	"\x1bStartRegionRecordingRequest\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\tR\aworldId\x12\x1e\n" +
+	"\vmin_chunk_x\x18\x02 \x01(\x05R\tminChunkX\x12\x1e\n" +
+	"\vmin_chunk_y\x18\x03 \x01(\x05R\tminChunkY\x12\x1e\n" +
+	"\vmax_chunk_x\x18\x04 \x01(\x05R\tmaxChunkX\x12\x1e\n" +
+	"\vmax_chunk_y\x18\x05 \x01(\x05R\tmaxChunkY\x12)\n" +
+	"\x10duration_seconds\x18\x06 \x01(\x05R\x0fdurationSeconds\"W\n" +
+	"\x1cStartRegionRecordingResponse\x127\n" +
+	"\trecording\x18\x01 \x01(\v2\x19.debug.v1.RegionRecordingR\trecording\"?\n" +
+	"\x1aStopRegionRecordingRequest\x12!\n" +
+	"\frecording_id\x18\x01 \x01(\x03R\vrecordingId\"V\n" +
+	"\x1bStopRegionRecordingResponse\x127\n" +
+	"\trecording\x18\x01 \x01(\v2\x19.debug.v1.RegionRecordingR\trecording\"3\n" +
+	"\x1bListRegionRecordingsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"Y\n" +
+	"\x1cListRegionRecordingsResponse\x129\n" +
+	"\n" +
+	"recordings\x18\x01 \x03(\v2\x19.debug.v1.RegionRecordingR\n" +
+	"recordings\"P\n" +
+	"\x17StepRegionReplayRequest\x12!\n" +
+	"\frecording_id\x18\x01 \x01(\x03R\vrecordingId\x12\x12\n" +
+	"\x04step\x18\x02 \x01(\x05R\x04step\"\xd0\x01\n" +
+	"\x18StepRegionReplayResponse\x127\n" +
+	"\trecording\x18\x01 \x01(\v2\x19.debug.v1.RegionRecordingR\trecording\x12-\n" +
+	"\x05event\x18\x02 \x01(\v2\x17.debug.v1.RecordedEventR\x05event\x12+\n" +
+	"\x05state\x18\x03 \x01(\v2\x15.debug.v1.ReplayStateR\x05state\x12\x1f\n" +
+	"\vtotal_steps\x18\x04 \x01(\x05R\n" +
+	"totalSteps\"\xeb\x01\n" +
+	"\x10ChunkAccessStats\x12/\n" +
+	"\x05chunk\x18\x01 \x01(\v2\x19.chunk.v1.ChunkCoordinateR\x05chunk\x12!\n" +
+	"\faccess_count\x18\x02 \x01(\x03R\vaccessCount\x12D\n" +
+	"\x10last_accessed_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastAccessedAt\x12=\n" +
+	"\fgenerated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\"\xa3\x01\n" +
+	"\x1bListChunkAccessStatsRequest\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\tR\aworldId\x120\n" +
+	"\x05order\x18\x02 \x01(\x0e2\x1a.debug.v1.ChunkAccessOrderR\x05order\x12!\n" +
+	"\fidle_seconds\x18\x03 \x01(\x05R\vidleSeconds\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"R\n" +
+	"\x1cListChunkAccessStatsResponse\x122\n" +
+	"\x06chunks\x18\x01 \x03(\v2\x1a.debug.v1.ChunkAccessStatsR\x06chunks*|\n" +
+	"\x10ChunkAccessOrder\x12\"\n" +
+	"\x1eCHUNK_ACCESS_ORDER_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aCHUNK_ACCESS_ORDER_COLDEST\x10\x01\x12$\n" +
+	" CHUNK_ACCESS_ORDER_MOST_ACCESSED\x10\x022\xe3\x04\n" +
+	"\fDebugService\x12U\n" +
+	"\x0eGetServerState\x12\x1f.debug.v1.GetServerStateRequest\x1a .debug.v1.GetServerStateResponse\"\x00\x12g\n" +
+	"\x14StartRegionRecording\x12%.debug.v1.StartRegionRecordingRequest\x1a&.debug.v1.StartRegionRecordingResponse\"\x00\x12d\n" +
+	"\x13StopRegionRecording\x12$.debug.v1.StopRegionRecordingRequest\x1a%.debug.v1.StopRegionRecordingResponse\"\x00\x12g\n" +
+	"\x14ListRegionRecordings\x12%.debug.v1.ListRegionRecordingsRequest\x1a&.debug.v1.ListRegionRecordingsResponse\"\x00\x12[\n" +
+	"\x10StepRegionReplay\x12!.debug.v1.StepRegionReplayRequest\x1a\".debug.v1.StepRegionReplayResponse\"\x00\x12g\n" +
+	"\x14ListChunkAccessStats\x12%.debug.v1.ListChunkAccessStatsRequest\x1a&.debug.v1.ListChunkAccessStatsResponse\"\x00B,Z*github.com/VoidMesh/api/api/proto/debug/v1b\x06proto3"
+
+var (
+	file_debug_v1_debug_proto_rawDescOnce sync.Once
+	file_debug_v1_debug_proto_rawDescData []byte
+)
+
+func file_debug_v1_debug_proto_rawDescGZIP() []byte {
+	file_debug_v1_debug_proto_rawDescOnce.Do(func() {
+		file_debug_v1_debug_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_debug_v1_debug_proto_rawDesc), len(file_debug_v1_debug_proto_rawDesc)))
+	})
+	return file_debug_v1_debug_proto_rawDescData
+}
+
+var file_debug_v1_debug_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_debug_v1_debug_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_debug_v1_debug_proto_goTypes = []any{
+	(ChunkAccessOrder)(0),                // 0: debug.v1.ChunkAccessOrder
+	(*ServiceInfo)(nil),                  // 1: debug.v1.ServiceInfo
+	(*GetServerStateRequest)(nil),        // 2: debug.v1.GetServerStateRequest
+	(*GetServerStateResponse)(nil),       // 3: debug.v1.GetServerStateResponse
+	(*RegionRecording)(nil),              // 4: debug.v1.RegionRecording
+	(*RecordedEvent)(nil),                // 5: debug.v1.RecordedEvent
+	(*ReplayCell)(nil),                   // 6: debug.v1.ReplayCell
+	(*ReplayCharacter)(nil),              // 7: debug.v1.ReplayCharacter
+	(*ReplayResourceNode)(nil),           // 8: debug.v1.ReplayResourceNode
+	(*ReplayState)(nil),                  // 9: debug.v1.ReplayState
+	(*StartRegionRecordingRequest)(nil),  // 10: debug.v1.StartRegionRecordingRequest
+	(*StartRegionRecordingResponse)(nil), // 11: debug.v1.StartRegionRecordingResponse
+	(*StopRegionRecordingRequest)(nil),   // 12: debug.v1.StopRegionRecordingRequest
+	(*StopRegionRecordingResponse)(nil),  // 13: debug.v1.StopRegionRecordingResponse
+	(*ListRegionRecordingsRequest)(nil),  // 14: debug.v1.ListRegionRecordingsRequest
+	(*ListRegionRecordingsResponse)(nil), // 15: debug.v1.ListRegionRecordingsResponse
+	(*StepRegionReplayRequest)(nil),      // 16: debug.v1.StepRegionReplayRequest
+	(*StepRegionReplayResponse)(nil),     // 17: debug.v1.StepRegionReplayResponse
+	(*ChunkAccessStats)(nil),             // 18: debug.v1.ChunkAccessStats
+	(*ListChunkAccessStatsRequest)(nil),  // 19: debug.v1.ListChunkAccessStatsRequest
+	(*ListChunkAccessStatsResponse)(nil), // 20: debug.v1.ListChunkAccessStatsResponse
+	nil,                                  // 21: debug.v1.GetServerStateResponse.StreamSubscriptionsEntry
+	nil,                                  // 22: debug.v1.GetServerStateResponse.CacheEntriesEntry
+	nil,                                  // 23: debug.v1.GetServerStateResponse.QueuesEntry
+	nil,                                  // 24: debug.v1.GetServerStateResponse.BackgroundJobsEntry
+	nil,                                  // 25: debug.v1.GetServerStateResponse.CompressionRatiosEntry
+	nil,                                  // 26: debug.v1.GetServerStateResponse.DatabaseEntry
+	(*timestamppb.Timestamp)(nil),        // 27: google.protobuf.Timestamp
+	(v1.TerrainType)(0),                  // 28: chunk.v1.TerrainType
+	(*v1.ChunkCoordinate)(nil),           // 29: chunk.v1.ChunkCoordinate
+}
+var file_debug_v1_debug_proto_depIdxs = []int32{
+	1,  // 0: debug.v1.GetServerStateResponse.services:type_name -> debug.v1.ServiceInfo
+	21, // 1: debug.v1.GetServerStateResponse.stream_subscriptions:type_name -> debug.v1.GetServerStateResponse.StreamSubscriptionsEntry
+	22, // 2: debug.v1.GetServerStateResponse.cache_entries:type_name -> debug.v1.GetServerStateResponse.CacheEntriesEntry
+	23, // 3: debug.v1.GetServerStateResponse.queues:type_name -> debug.v1.GetServerStateResponse.QueuesEntry
+	27, // 4: debug.v1.GetServerStateResponse.started_at:type_name -> google.protobuf.Timestamp
+	24, // 5: debug.v1.GetServerStateResponse.background_jobs:type_name -> debug.v1.GetServerStateResponse.BackgroundJobsEntry
+	25, // 6: debug.v1.GetServerStateResponse.compression_ratios:type_name -> debug.v1.GetServerStateResponse.CompressionRatiosEntry
+	26, // 7: debug.v1.GetServerStateResponse.database:type_name -> debug.v1.GetServerStateResponse.DatabaseEntry
+	27, // 8: debug.v1.RegionRecording.started_at:type_name -> google.protobuf.Timestamp
+	27, // 9: debug.v1.RegionRecording.ends_at:type_name -> google.protobuf.Timestamp
+	27, // 10: debug.v1.RegionRecording.stopped_at:type_name -> google.protobuf.Timestamp
+	27, // 11: debug.v1.RecordedEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	28, // 12: debug.v1.ReplayCell.terrain_type:type_name -> chunk.v1.TerrainType
+	6,  // 13: debug.v1.ReplayState.cells:type_name -> debug.v1.ReplayCell
+	7,  // 14: debug.v1.ReplayState.characters:type_name -> debug.v1.ReplayCharacter
+	8,  // 15: debug.v1.ReplayState.resource_nodes:type_name -> debug.v1.ReplayResourceNode
+	29, // 16: debug.v1.ReplayState.generated_chunks:type_name -> chunk.v1.ChunkCoordinate
+	4,  // 17: debug.v1.StartRegionRecordingResponse.recording:type_name -> debug.v1.RegionRecording
+	4,  // 18: debug.v1.StopRegionRecordingResponse.recording:type_name -> debug.v1.RegionRecording
+	4,  // 19: debug.v1.ListRegionRecordingsResponse.recordings:type_name -> debug.v1.RegionRecording
+	4,  // 20: debug.v1.StepRegionReplayResponse.recording:type_name -> debug.v1.RegionRecording
+	5,  // 21: debug.v1.StepRegionReplayResponse.event:type_name -> debug.v1.RecordedEvent
+	9,  // 22: debug.v1.StepRegionReplayResponse.state:type_name -> debug.v1.ReplayState
+	29, // 23: debug.v1.ChunkAccessStats.chunk:type_name -> chunk.v1.ChunkCoordinate
+	27, // 24: debug.v1.ChunkAccessStats.last_accessed_at:type_name -> google.protobuf.Timestamp
+	27, // 25: debug.v1.ChunkAccessStats.generated_at:type_name -> google.protobuf.Timestamp
+	0,  // 26: debug.v1.ListChunkAccessStatsRequest.order:type_name -> debug.v1.ChunkAccessOrder
+	18, // 27: debug.v1.ListChunkAccessStatsResponse.chunks:type_name -> debug.v1.ChunkAccessStats
+	2,  // 28: debug.v1.DebugService.GetServerState:input_type -> debug.v1.GetServerStateRequest
+	10, // 29: debug.v1.DebugService.StartRegionRecording:input_type -> debug.v1.StartRegionRecordingRequest
+	12, // 30: debug.v1.DebugService.StopRegionRecording:input_type -> debug.v1.StopRegionRecordingRequest
+	14, // 31: debug.v1.DebugService.ListRegionRecordings:input_type -> debug.v1.ListRegionRecordingsRequest
+	16, // 32: debug.v1.DebugService.StepRegionReplay:input_type -> debug.v1.StepRegionReplayRequest
+	19, // 33: debug.v1.DebugService.ListChunkAccessStats:input_type -> debug.v1.ListChunkAccessStatsRequest
+	3,  // 34: debug.v1.DebugService.GetServerState:output_type -> debug.v1.GetServerStateResponse
+	11, // 35: debug.v1.DebugService.StartRegionRecording:output_type -> debug.v1.StartRegionRecordingResponse
+	13, // 36: debug.v1.DebugService.StopRegionRecording:output_type -> debug.v1.StopRegionRecordingResponse
+	15, // 37: debug.v1.DebugService.ListRegionRecordings:output_type -> debug.v1.ListRegionRecordingsResponse
+	17, // 38: debug.v1.DebugService.StepRegionReplay:output_type -> debug.v1.StepRegionReplayResponse
+	20, // 39: debug.v1.DebugService.ListChunkAccessStats:output_type -> debug.v1.ListChunkAccessStatsResponse
+	34, // [34:40] is the sub-list for method output_type
+	28, // [28:34] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
+}
+
+func init() { file_debug_v1_debug_proto_init() }
+func file_debug_v1_debug_proto_init() {
+	if File_debug_v1_debug_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_debug_v1_debug_proto_rawDesc), len(file_debug_v1_debug_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   26,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_debug_v1_debug_proto_goTypes,
+		DependencyIndexes: file_debug_v1_debug_proto_depIdxs,
+		EnumInfos:         file_debug_v1_debug_proto_enumTypes,
+		MessageInfos:      file_debug_v1_debug_proto_msgTypes,
+	}.Build()
+	File_debug_v1_debug_proto = out.File
+	file_debug_v1_debug_proto_goTypes = nil
+	file_debug_v1_debug_proto_depIdxs = nil
+}
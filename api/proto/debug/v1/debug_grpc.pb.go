@@ -0,0 +1,329 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.4
+// source: debug/v1/debug.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DebugService_GetServerState_FullMethodName       = "/debug.v1.DebugService/GetServerState"
+	DebugService_StartRegionRecording_FullMethodName = "/debug.v1.DebugService/StartRegionRecording"
+	DebugService_StopRegionRecording_FullMethodName  = "/debug.v1.DebugService/StopRegionRecording"
+	DebugService_ListRegionRecordings_FullMethodName = "/debug.v1.DebugService/ListRegionRecordings"
+	DebugService_StepRegionReplay_FullMethodName     = "/debug.v1.DebugService/StepRegionReplay"
+	DebugService_ListChunkAccessStats_FullMethodName = "/debug.v1.DebugService/ListChunkAccessStats"
+)
+
+// DebugServiceClient is the client API for DebugService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// DebugService exposes server internals for staging diagnostics.
+// It is only registered when DEBUG_RPC_ENABLED is set and requires an admin token.
+type DebugServiceClient interface {
+	// Dump service wiring and runtime counters
+	GetServerState(ctx context.Context, in *GetServerStateRequest, opts ...grpc.CallOption) (*GetServerStateResponse, error)
+	// Record every mutating event in a rectangle of chunks for a time window
+	StartRegionRecording(ctx context.Context, in *StartRegionRecordingRequest, opts ...grpc.CallOption) (*StartRegionRecordingResponse, error)
+	// End a recording before its window runs out
+	StopRegionRecording(ctx context.Context, in *StopRegionRecordingRequest, opts ...grpc.CallOption) (*StopRegionRecordingResponse, error)
+	// List recordings, newest first
+	ListRegionRecordings(ctx context.Context, in *ListRegionRecordingsRequest, opts ...grpc.CallOption) (*ListRegionRecordingsResponse, error)
+	// Replay a recording up to a step and return the event at that step and the region state after it
+	StepRegionReplay(ctx context.Context, in *StepRegionReplayRequest, opts ...grpc.CallOption) (*StepRegionReplayResponse, error)
+	// List per-chunk read statistics, coldest or most read first
+	ListChunkAccessStats(ctx context.Context, in *ListChunkAccessStatsRequest, opts ...grpc.CallOption) (*ListChunkAccessStatsResponse, error)
+}
+
+type debugServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDebugServiceClient(cc grpc.ClientConnInterface) DebugServiceClient {
+	return &debugServiceClient{cc}
+}
+
+func (c *debugServiceClient) GetServerState(ctx context.Context, in *GetServerStateRequest, opts ...grpc.CallOption) (*GetServerStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetServerStateResponse)
+	err := c.cc.Invoke(ctx, DebugService_GetServerState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugServiceClient) StartRegionRecording(ctx context.Context, in *StartRegionRecordingRequest, opts ...grpc.CallOption) (*StartRegionRecordingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartRegionRecordingResponse)
+	err := c.cc.Invoke(ctx, DebugService_StartRegionRecording_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugServiceClient) StopRegionRecording(ctx context.Context, in *StopRegionRecordingRequest, opts ...grpc.CallOption) (*StopRegionRecordingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StopRegionRecordingResponse)
+	err := c.cc.Invoke(ctx, DebugService_StopRegionRecording_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugServiceClient) ListRegionRecordings(ctx context.Context, in *ListRegionRecordingsRequest, opts ...grpc.CallOption) (*ListRegionRecordingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRegionRecordingsResponse)
+	err := c.cc.Invoke(ctx, DebugService_ListRegionRecordings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugServiceClient) StepRegionReplay(ctx context.Context, in *StepRegionReplayRequest, opts ...grpc.CallOption) (*StepRegionReplayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StepRegionReplayResponse)
+	err := c.cc.Invoke(ctx, DebugService_StepRegionReplay_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugServiceClient) ListChunkAccessStats(ctx context.Context, in *ListChunkAccessStatsRequest, opts ...grpc.CallOption) (*ListChunkAccessStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListChunkAccessStatsResponse)
+	err := c.cc.Invoke(ctx, DebugService_ListChunkAccessStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DebugServiceServer is the server API for DebugService service.
+// All implementations must embed UnimplementedDebugServiceServer
+// for forward compatibility.
+//
+// DebugService exposes server internals for staging diagnostics.
+// It is only registered when DEBUG_RPC_ENABLED is set and requires an admin token.
+type DebugServiceServer interface {
+	// Dump service wiring and runtime counters
+	GetServerState(context.Context, *GetServerStateRequest) (*GetServerStateResponse, error)
+	// Record every mutating event in a rectangle of chunks for a time window
+	StartRegionRecording(context.Context, *StartRegionRecordingRequest) (*StartRegionRecordingResponse, error)
+	// End a recording before its window runs out
+	StopRegionRecording(context.Context, *StopRegionRecordingRequest) (*StopRegionRecordingResponse, error)
+	// List recordings, newest first
+	ListRegionRecordings(context.Context, *ListRegionRecordingsRequest) (*ListRegionRecordingsResponse, error)
+	// Replay a recording up to a step and return the event at that step and the region state after it
+	StepRegionReplay(context.Context, *StepRegionReplayRequest) (*StepRegionReplayResponse, error)
+	// List per-chunk read statistics, coldest or most read first
+	ListChunkAccessStats(context.Context, *ListChunkAccessStatsRequest) (*ListChunkAccessStatsResponse, error)
+	mustEmbedUnimplementedDebugServiceServer()
+}
+
+// UnimplementedDebugServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDebugServiceServer struct{}
+
+func (UnimplementedDebugServiceServer) GetServerState(context.Context, *GetServerStateRequest) (*GetServerStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServerState not implemented")
+}
+func (UnimplementedDebugServiceServer) StartRegionRecording(context.Context, *StartRegionRecordingRequest) (*StartRegionRecordingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartRegionRecording not implemented")
+}
+func (UnimplementedDebugServiceServer) StopRegionRecording(context.Context, *StopRegionRecordingRequest) (*StopRegionRecordingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopRegionRecording not implemented")
+}
+func (UnimplementedDebugServiceServer) ListRegionRecordings(context.Context, *ListRegionRecordingsRequest) (*ListRegionRecordingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRegionRecordings not implemented")
+}
+func (UnimplementedDebugServiceServer) StepRegionReplay(context.Context, *StepRegionReplayRequest) (*StepRegionReplayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StepRegionReplay not implemented")
+}
+func (UnimplementedDebugServiceServer) ListChunkAccessStats(context.Context, *ListChunkAccessStatsRequest) (*ListChunkAccessStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChunkAccessStats not implemented")
+}
+func (UnimplementedDebugServiceServer) mustEmbedUnimplementedDebugServiceServer() {}
+func (UnimplementedDebugServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeDebugServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DebugServiceServer will
+// result in compilation errors.
+type UnsafeDebugServiceServer interface {
+	mustEmbedUnimplementedDebugServiceServer()
+}
+
+func RegisterDebugServiceServer(s grpc.ServiceRegistrar, srv DebugServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDebugServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DebugService_ServiceDesc, srv)
+}
+
+func _DebugService_GetServerState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServerStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServiceServer).GetServerState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebugService_GetServerState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServiceServer).GetServerState(ctx, req.(*GetServerStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebugService_StartRegionRecording_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartRegionRecordingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServiceServer).StartRegionRecording(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebugService_StartRegionRecording_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServiceServer).StartRegionRecording(ctx, req.(*StartRegionRecordingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebugService_StopRegionRecording_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopRegionRecordingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServiceServer).StopRegionRecording(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebugService_StopRegionRecording_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServiceServer).StopRegionRecording(ctx, req.(*StopRegionRecordingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebugService_ListRegionRecordings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRegionRecordingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServiceServer).ListRegionRecordings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebugService_ListRegionRecordings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServiceServer).ListRegionRecordings(ctx, req.(*ListRegionRecordingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebugService_StepRegionReplay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StepRegionReplayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServiceServer).StepRegionReplay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebugService_StepRegionReplay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServiceServer).StepRegionReplay(ctx, req.(*StepRegionReplayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebugService_ListChunkAccessStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChunkAccessStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServiceServer).ListChunkAccessStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebugService_ListChunkAccessStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServiceServer).ListChunkAccessStats(ctx, req.(*ListChunkAccessStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DebugService_ServiceDesc is the grpc.ServiceDesc for DebugService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DebugService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "debug.v1.DebugService",
+	HandlerType: (*DebugServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetServerState",
+			Handler:    _DebugService_GetServerState_Handler,
+		},
+		{
+			MethodName: "StartRegionRecording",
+			Handler:    _DebugService_StartRegionRecording_Handler,
+		},
+		{
+			MethodName: "StopRegionRecording",
+			Handler:    _DebugService_StopRegionRecording_Handler,
+		},
+		{
+			MethodName: "ListRegionRecordings",
+			Handler:    _DebugService_ListRegionRecordings_Handler,
+		},
+		{
+			MethodName: "StepRegionReplay",
+			Handler:    _DebugService_StepRegionReplay_Handler,
+		},
+		{
+			MethodName: "ListChunkAccessStats",
+			Handler:    _DebugService_ListChunkAccessStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "debug/v1/debug.proto",
+}
@@ -0,0 +1,962 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.4
+// source: dungeon/v1/dungeon.proto
+
+package v1
+
+import (
+	v1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// A resource node generated with the instance; each yields once per instance
+type DungeonNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // Use as resource_node_id when harvesting inside the instance
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	X             int32                  `protobuf:"varint,4,opt,name=x,proto3" json:"x,omitempty"` // Instance cell coordinates
+	Y             int32                  `protobuf:"varint,5,opt,name=y,proto3" json:"y,omitempty"`
+	Harvested     bool                   `protobuf:"varint,6,opt,name=harvested,proto3" json:"harvested,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DungeonNode) Reset() {
+	*x = DungeonNode{}
+	mi := &file_dungeon_v1_dungeon_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DungeonNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DungeonNode) ProtoMessage() {}
+
+func (x *DungeonNode) ProtoReflect() protoreflect.Message {
+	mi := &file_dungeon_v1_dungeon_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DungeonNode.ProtoReflect.Descriptor instead.
+func (*DungeonNode) Descriptor() ([]byte, []int) {
+	return file_dungeon_v1_dungeon_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DungeonNode) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DungeonNode) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *DungeonNode) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DungeonNode) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *DungeonNode) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *DungeonNode) GetHarvested() bool {
+	if x != nil {
+		return x.Harvested
+	}
+	return false
+}
+
+type DungeonMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	Inside        bool                   `protobuf:"varint,2,opt,name=inside,proto3" json:"inside,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DungeonMember) Reset() {
+	*x = DungeonMember{}
+	mi := &file_dungeon_v1_dungeon_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DungeonMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DungeonMember) ProtoMessage() {}
+
+func (x *DungeonMember) ProtoReflect() protoreflect.Message {
+	mi := &file_dungeon_v1_dungeon_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DungeonMember.ProtoReflect.Descriptor instead.
+func (*DungeonMember) Descriptor() ([]byte, []int) {
+	return file_dungeon_v1_dungeon_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DungeonMember) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *DungeonMember) GetInside() bool {
+	if x != nil {
+		return x.Inside
+	}
+	return false
+}
+
+type Dungeon struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Seed              int64                  `protobuf:"varint,2,opt,name=seed,proto3" json:"seed,omitempty"`
+	Width             int32                  `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
+	Height            int32                  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Cells             []*v1.TerrainCell      `protobuf:"bytes,5,rep,name=cells,proto3" json:"cells,omitempty"` // width x height, row-major; (0, 0) is the top left
+	EntranceX         int32                  `protobuf:"varint,6,opt,name=entrance_x,json=entranceX,proto3" json:"entrance_x,omitempty"`
+	EntranceY         int32                  `protobuf:"varint,7,opt,name=entrance_y,json=entranceY,proto3" json:"entrance_y,omitempty"`
+	Nodes             []*DungeonNode         `protobuf:"bytes,8,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	LeaderCharacterId string                 `protobuf:"bytes,9,opt,name=leader_character_id,json=leaderCharacterId,proto3" json:"leader_character_id,omitempty"`
+	Members           []*DungeonMember       `protobuf:"bytes,10,rep,name=members,proto3" json:"members,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt         *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Everyone inside is returned to the overworld then
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Dungeon) Reset() {
+	*x = Dungeon{}
+	mi := &file_dungeon_v1_dungeon_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Dungeon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Dungeon) ProtoMessage() {}
+
+func (x *Dungeon) ProtoReflect() protoreflect.Message {
+	mi := &file_dungeon_v1_dungeon_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Dungeon.ProtoReflect.Descriptor instead.
+func (*Dungeon) Descriptor() ([]byte, []int) {
+	return file_dungeon_v1_dungeon_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Dungeon) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Dungeon) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *Dungeon) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Dungeon) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Dungeon) GetCells() []*v1.TerrainCell {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+func (x *Dungeon) GetEntranceX() int32 {
+	if x != nil {
+		return x.EntranceX
+	}
+	return 0
+}
+
+func (x *Dungeon) GetEntranceY() int32 {
+	if x != nil {
+		return x.EntranceY
+	}
+	return 0
+}
+
+func (x *Dungeon) GetNodes() []*DungeonNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *Dungeon) GetLeaderCharacterId() string {
+	if x != nil {
+		return x.LeaderCharacterId
+	}
+	return ""
+}
+
+func (x *Dungeon) GetMembers() []*DungeonMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *Dungeon) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Dungeon) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// Where a character stands inside a dungeon instance
+type DungeonPosition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DungeonId     int64                  `protobuf:"varint,1,opt,name=dungeon_id,json=dungeonId,proto3" json:"dungeon_id,omitempty"`
+	X             int32                  `protobuf:"varint,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DungeonPosition) Reset() {
+	*x = DungeonPosition{}
+	mi := &file_dungeon_v1_dungeon_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DungeonPosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DungeonPosition) ProtoMessage() {}
+
+func (x *DungeonPosition) ProtoReflect() protoreflect.Message {
+	mi := &file_dungeon_v1_dungeon_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DungeonPosition.ProtoReflect.Descriptor instead.
+func (*DungeonPosition) Descriptor() ([]byte, []int) {
+	return file_dungeon_v1_dungeon_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DungeonPosition) GetDungeonId() int64 {
+	if x != nil {
+		return x.DungeonId
+	}
+	return 0
+}
+
+func (x *DungeonPosition) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *DungeonPosition) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type CreateDungeonRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"` // The leader
+	// Characters in the same world, of the same user or of accepted friends
+	MemberCharacterIds []string `protobuf:"bytes,2,rep,name=member_character_ids,json=memberCharacterIds,proto3" json:"member_character_ids,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreateDungeonRequest) Reset() {
+	*x = CreateDungeonRequest{}
+	mi := &file_dungeon_v1_dungeon_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDungeonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDungeonRequest) ProtoMessage() {}
+
+func (x *CreateDungeonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dungeon_v1_dungeon_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDungeonRequest.ProtoReflect.Descriptor instead.
+func (*CreateDungeonRequest) Descriptor() ([]byte, []int) {
+	return file_dungeon_v1_dungeon_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateDungeonRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *CreateDungeonRequest) GetMemberCharacterIds() []string {
+	if x != nil {
+		return x.MemberCharacterIds
+	}
+	return nil
+}
+
+type CreateDungeonResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dungeon       *Dungeon               `protobuf:"bytes,1,opt,name=dungeon,proto3" json:"dungeon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDungeonResponse) Reset() {
+	*x = CreateDungeonResponse{}
+	mi := &file_dungeon_v1_dungeon_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDungeonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDungeonResponse) ProtoMessage() {}
+
+func (x *CreateDungeonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dungeon_v1_dungeon_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDungeonResponse.ProtoReflect.Descriptor instead.
+func (*CreateDungeonResponse) Descriptor() ([]byte, []int) {
+	return file_dungeon_v1_dungeon_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateDungeonResponse) GetDungeon() *Dungeon {
+	if x != nil {
+		return x.Dungeon
+	}
+	return nil
+}
+
+type GetDungeonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDungeonRequest) Reset() {
+	*x = GetDungeonRequest{}
+	mi := &file_dungeon_v1_dungeon_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDungeonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDungeonRequest) ProtoMessage() {}
+
+func (x *GetDungeonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dungeon_v1_dungeon_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDungeonRequest.ProtoReflect.Descriptor instead.
+func (*GetDungeonRequest) Descriptor() ([]byte, []int) {
+	return file_dungeon_v1_dungeon_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetDungeonRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+type GetDungeonResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dungeon       *Dungeon               `protobuf:"bytes,1,opt,name=dungeon,proto3" json:"dungeon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDungeonResponse) Reset() {
+	*x = GetDungeonResponse{}
+	mi := &file_dungeon_v1_dungeon_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDungeonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDungeonResponse) ProtoMessage() {}
+
+func (x *GetDungeonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dungeon_v1_dungeon_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDungeonResponse.ProtoReflect.Descriptor instead.
+func (*GetDungeonResponse) Descriptor() ([]byte, []int) {
+	return file_dungeon_v1_dungeon_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetDungeonResponse) GetDungeon() *Dungeon {
+	if x != nil {
+		return x.Dungeon
+	}
+	return nil
+}
+
+type EnterDungeonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnterDungeonRequest) Reset() {
+	*x = EnterDungeonRequest{}
+	mi := &file_dungeon_v1_dungeon_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnterDungeonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnterDungeonRequest) ProtoMessage() {}
+
+func (x *EnterDungeonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dungeon_v1_dungeon_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnterDungeonRequest.ProtoReflect.Descriptor instead.
+func (*EnterDungeonRequest) Descriptor() ([]byte, []int) {
+	return file_dungeon_v1_dungeon_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *EnterDungeonRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+type EnterDungeonResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dungeon       *Dungeon               `protobuf:"bytes,1,opt,name=dungeon,proto3" json:"dungeon,omitempty"`
+	Position      *DungeonPosition       `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnterDungeonResponse) Reset() {
+	*x = EnterDungeonResponse{}
+	mi := &file_dungeon_v1_dungeon_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnterDungeonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnterDungeonResponse) ProtoMessage() {}
+
+func (x *EnterDungeonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dungeon_v1_dungeon_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnterDungeonResponse.ProtoReflect.Descriptor instead.
+func (*EnterDungeonResponse) Descriptor() ([]byte, []int) {
+	return file_dungeon_v1_dungeon_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EnterDungeonResponse) GetDungeon() *Dungeon {
+	if x != nil {
+		return x.Dungeon
+	}
+	return nil
+}
+
+func (x *EnterDungeonResponse) GetPosition() *DungeonPosition {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+type LeaveDungeonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveDungeonRequest) Reset() {
+	*x = LeaveDungeonRequest{}
+	mi := &file_dungeon_v1_dungeon_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveDungeonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveDungeonRequest) ProtoMessage() {}
+
+func (x *LeaveDungeonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dungeon_v1_dungeon_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveDungeonRequest.ProtoReflect.Descriptor instead.
+func (*LeaveDungeonRequest) Descriptor() ([]byte, []int) {
+	return file_dungeon_v1_dungeon_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *LeaveDungeonRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+type LeaveDungeonResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             int32                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"` // World cell the character stands on again
+	Y             int32                  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveDungeonResponse) Reset() {
+	*x = LeaveDungeonResponse{}
+	mi := &file_dungeon_v1_dungeon_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveDungeonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveDungeonResponse) ProtoMessage() {}
+
+func (x *LeaveDungeonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dungeon_v1_dungeon_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveDungeonResponse.ProtoReflect.Descriptor instead.
+func (*LeaveDungeonResponse) Descriptor() ([]byte, []int) {
+	return file_dungeon_v1_dungeon_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *LeaveDungeonResponse) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *LeaveDungeonResponse) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type DisbandDungeonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisbandDungeonRequest) Reset() {
+	*x = DisbandDungeonRequest{}
+	mi := &file_dungeon_v1_dungeon_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisbandDungeonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisbandDungeonRequest) ProtoMessage() {}
+
+func (x *DisbandDungeonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dungeon_v1_dungeon_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisbandDungeonRequest.ProtoReflect.Descriptor instead.
+func (*DisbandDungeonRequest) Descriptor() ([]byte, []int) {
+	return file_dungeon_v1_dungeon_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DisbandDungeonRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+type DisbandDungeonResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisbandDungeonResponse) Reset() {
+	*x = DisbandDungeonResponse{}
+	mi := &file_dungeon_v1_dungeon_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisbandDungeonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisbandDungeonResponse) ProtoMessage() {}
+
+func (x *DisbandDungeonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dungeon_v1_dungeon_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisbandDungeonResponse.ProtoReflect.Descriptor instead.
+func (*DisbandDungeonResponse) Descriptor() ([]byte, []int) {
+	return file_dungeon_v1_dungeon_proto_rawDescGZIP(), []int{13}
+}
+
+var File_dungeon_v1_dungeon_proto protoreflect.FileDescriptor
+
+const file_dungeon_v1_dungeon_proto_rawDesc = "" +
+	"\n" +
+	"\x18dungeon/v1/dungeon.proto\x12\n" +
+	"dungeon.v1\x1a\x14chunk/v1/chunk.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x7f\n" +
+	"\vDungeonNode\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\f\n" +
+	"\x01x\x18\x04 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x05 \x01(\x05R\x01y\x12\x1c\n" +
+	"\tharvested\x18\x06 \x01(\bR\tharvested\"J\n" +
+	"\rDungeonMember\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x16\n" +
+	"\x06inside\x18\x02 \x01(\bR\x06inside\"\xd0\x03\n" +
+	"\aDungeon\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04seed\x18\x02 \x01(\x03R\x04seed\x12\x14\n" +
+	"\x05width\x18\x03 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x04 \x01(\x05R\x06height\x12+\n" +
+	"\x05cells\x18\x05 \x03(\v2\x15.chunk.v1.TerrainCellR\x05cells\x12\x1d\n" +
+	"\n" +
+	"entrance_x\x18\x06 \x01(\x05R\tentranceX\x12\x1d\n" +
+	"\n" +
+	"entrance_y\x18\a \x01(\x05R\tentranceY\x12-\n" +
+	"\x05nodes\x18\b \x03(\v2\x17.dungeon.v1.DungeonNodeR\x05nodes\x12.\n" +
+	"\x13leader_character_id\x18\t \x01(\tR\x11leaderCharacterId\x123\n" +
+	"\amembers\x18\n" +
+	" \x03(\v2\x19.dungeon.v1.DungeonMemberR\amembers\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"L\n" +
+	"\x0fDungeonPosition\x12\x1d\n" +
+	"\n" +
+	"dungeon_id\x18\x01 \x01(\x03R\tdungeonId\x12\f\n" +
+	"\x01x\x18\x02 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x03 \x01(\x05R\x01y\"k\n" +
+	"\x14CreateDungeonRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x120\n" +
+	"\x14member_character_ids\x18\x02 \x03(\tR\x12memberCharacterIds\"F\n" +
+	"\x15CreateDungeonResponse\x12-\n" +
+	"\adungeon\x18\x01 \x01(\v2\x13.dungeon.v1.DungeonR\adungeon\"6\n" +
+	"\x11GetDungeonRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\"C\n" +
+	"\x12GetDungeonResponse\x12-\n" +
+	"\adungeon\x18\x01 \x01(\v2\x13.dungeon.v1.DungeonR\adungeon\"8\n" +
+	"\x13EnterDungeonRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\"~\n" +
+	"\x14EnterDungeonResponse\x12-\n" +
+	"\adungeon\x18\x01 \x01(\v2\x13.dungeon.v1.DungeonR\adungeon\x127\n" +
+	"\bposition\x18\x02 \x01(\v2\x1b.dungeon.v1.DungeonPositionR\bposition\"8\n" +
+	"\x13LeaveDungeonRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\"2\n" +
+	"\x14LeaveDungeonResponse\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x05R\x01y\":\n" +
+	"\x15DisbandDungeonRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\"\x18\n" +
+	"\x16DisbandDungeonResponse2\xbc\x03\n" +
+	"\x0eDungeonService\x12V\n" +
+	"\rCreateDungeon\x12 .dungeon.v1.CreateDungeonRequest\x1a!.dungeon.v1.CreateDungeonResponse\"\x00\x12M\n" +
+	"\n" +
+	"GetDungeon\x12\x1d.dungeon.v1.GetDungeonRequest\x1a\x1e.dungeon.v1.GetDungeonResponse\"\x00\x12S\n" +
+	"\fEnterDungeon\x12\x1f.dungeon.v1.EnterDungeonRequest\x1a .dungeon.v1.EnterDungeonResponse\"\x00\x12S\n" +
+	"\fLeaveDungeon\x12\x1f.dungeon.v1.LeaveDungeonRequest\x1a .dungeon.v1.LeaveDungeonResponse\"\x00\x12Y\n" +
+	"\x0eDisbandDungeon\x12!.dungeon.v1.DisbandDungeonRequest\x1a\".dungeon.v1.DisbandDungeonResponse\"\x00B.Z,github.com/VoidMesh/api/api/proto/dungeon/v1b\x06proto3"
+
+var (
+	file_dungeon_v1_dungeon_proto_rawDescOnce sync.Once
+	file_dungeon_v1_dungeon_proto_rawDescData []byte
+)
+
+func file_dungeon_v1_dungeon_proto_rawDescGZIP() []byte {
+	file_dungeon_v1_dungeon_proto_rawDescOnce.Do(func() {
+		file_dungeon_v1_dungeon_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_dungeon_v1_dungeon_proto_rawDesc), len(file_dungeon_v1_dungeon_proto_rawDesc)))
+	})
+	return file_dungeon_v1_dungeon_proto_rawDescData
+}
+
+var file_dungeon_v1_dungeon_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_dungeon_v1_dungeon_proto_goTypes = []any{
+	(*DungeonNode)(nil),            // 0: dungeon.v1.DungeonNode
+	(*DungeonMember)(nil),          // 1: dungeon.v1.DungeonMember
+	(*Dungeon)(nil),                // 2: dungeon.v1.Dungeon
+	(*DungeonPosition)(nil),        // 3: dungeon.v1.DungeonPosition
+	(*CreateDungeonRequest)(nil),   // 4: dungeon.v1.CreateDungeonRequest
+	(*CreateDungeonResponse)(nil),  // 5: dungeon.v1.CreateDungeonResponse
+	(*GetDungeonRequest)(nil),      // 6: dungeon.v1.GetDungeonRequest
+	(*GetDungeonResponse)(nil),     // 7: dungeon.v1.GetDungeonResponse
+	(*EnterDungeonRequest)(nil),    // 8: dungeon.v1.EnterDungeonRequest
+	(*EnterDungeonResponse)(nil),   // 9: dungeon.v1.EnterDungeonResponse
+	(*LeaveDungeonRequest)(nil),    // 10: dungeon.v1.LeaveDungeonRequest
+	(*LeaveDungeonResponse)(nil),   // 11: dungeon.v1.LeaveDungeonResponse
+	(*DisbandDungeonRequest)(nil),  // 12: dungeon.v1.DisbandDungeonRequest
+	(*DisbandDungeonResponse)(nil), // 13: dungeon.v1.DisbandDungeonResponse
+	(*v1.TerrainCell)(nil),         // 14: chunk.v1.TerrainCell
+	(*timestamppb.Timestamp)(nil),  // 15: google.protobuf.Timestamp
+}
+var file_dungeon_v1_dungeon_proto_depIdxs = []int32{
+	14, // 0: dungeon.v1.Dungeon.cells:type_name -> chunk.v1.TerrainCell
+	0,  // 1: dungeon.v1.Dungeon.nodes:type_name -> dungeon.v1.DungeonNode
+	1,  // 2: dungeon.v1.Dungeon.members:type_name -> dungeon.v1.DungeonMember
+	15, // 3: dungeon.v1.Dungeon.created_at:type_name -> google.protobuf.Timestamp
+	15, // 4: dungeon.v1.Dungeon.expires_at:type_name -> google.protobuf.Timestamp
+	2,  // 5: dungeon.v1.CreateDungeonResponse.dungeon:type_name -> dungeon.v1.Dungeon
+	2,  // 6: dungeon.v1.GetDungeonResponse.dungeon:type_name -> dungeon.v1.Dungeon
+	2,  // 7: dungeon.v1.EnterDungeonResponse.dungeon:type_name -> dungeon.v1.Dungeon
+	3,  // 8: dungeon.v1.EnterDungeonResponse.position:type_name -> dungeon.v1.DungeonPosition
+	4,  // 9: dungeon.v1.DungeonService.CreateDungeon:input_type -> dungeon.v1.CreateDungeonRequest
+	6,  // 10: dungeon.v1.DungeonService.GetDungeon:input_type -> dungeon.v1.GetDungeonRequest
+	8,  // 11: dungeon.v1.DungeonService.EnterDungeon:input_type -> dungeon.v1.EnterDungeonRequest
+	10, // 12: dungeon.v1.DungeonService.LeaveDungeon:input_type -> dungeon.v1.LeaveDungeonRequest
+	12, // 13: dungeon.v1.DungeonService.DisbandDungeon:input_type -> dungeon.v1.DisbandDungeonRequest
+	5,  // 14: dungeon.v1.DungeonService.CreateDungeon:output_type -> dungeon.v1.CreateDungeonResponse
+	7,  // 15: dungeon.v1.DungeonService.GetDungeon:output_type -> dungeon.v1.GetDungeonResponse
+	9,  // 16: dungeon.v1.DungeonService.EnterDungeon:output_type -> dungeon.v1.EnterDungeonResponse
+	11, // 17: dungeon.v1.DungeonService.LeaveDungeon:output_type -> dungeon.v1.LeaveDungeonResponse
+	13, // 18: dungeon.v1.DungeonService.DisbandDungeon:output_type -> dungeon.v1.DisbandDungeonResponse
+	14, // [14:19] is the sub-list for method output_type
+	9,  // [9:14] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_dungeon_v1_dungeon_proto_init() }
+func file_dungeon_v1_dungeon_proto_init() {
+	if File_dungeon_v1_dungeon_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_dungeon_v1_dungeon_proto_rawDesc), len(file_dungeon_v1_dungeon_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_dungeon_v1_dungeon_proto_goTypes,
+		DependencyIndexes: file_dungeon_v1_dungeon_proto_depIdxs,
+		MessageInfos:      file_dungeon_v1_dungeon_proto_msgTypes,
+	}.Build()
+	File_dungeon_v1_dungeon_proto = out.File
+	file_dungeon_v1_dungeon_proto_goTypes = nil
+	file_dungeon_v1_dungeon_proto_depIdxs = nil
+}
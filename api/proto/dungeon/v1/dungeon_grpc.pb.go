@@ -0,0 +1,293 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.4
+// source: dungeon/v1/dungeon.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DungeonService_CreateDungeon_FullMethodName  = "/dungeon.v1.DungeonService/CreateDungeon"
+	DungeonService_GetDungeon_FullMethodName     = "/dungeon.v1.DungeonService/GetDungeon"
+	DungeonService_EnterDungeon_FullMethodName   = "/dungeon.v1.DungeonService/EnterDungeon"
+	DungeonService_LeaveDungeon_FullMethodName   = "/dungeon.v1.DungeonService/LeaveDungeon"
+	DungeonService_DisbandDungeon_FullMethodName = "/dungeon.v1.DungeonService/DisbandDungeon"
+)
+
+// DungeonServiceClient is the client API for DungeonService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Dungeons are instances: a bounded map generated from a seed for one party of
+// characters, away from the overworld, until the instance expires. While a character is
+// inside, MoveCharacter moves it on the instance's grid and HarvestResource harvests the
+// instance's own resource nodes.
+type DungeonServiceClient interface {
+	// Generates a new instance bound to the character and the party members it brings
+	CreateDungeon(ctx context.Context, in *CreateDungeonRequest, opts ...grpc.CallOption) (*CreateDungeonResponse, error)
+	// Returns the instance the character is bound to
+	GetDungeon(ctx context.Context, in *GetDungeonRequest, opts ...grpc.CallOption) (*GetDungeonResponse, error)
+	// Puts the character on the entrance of its instance
+	EnterDungeon(ctx context.Context, in *EnterDungeonRequest, opts ...grpc.CallOption) (*EnterDungeonResponse, error)
+	// Takes the character standing on the entrance back to the overworld
+	LeaveDungeon(ctx context.Context, in *LeaveDungeonRequest, opts ...grpc.CallOption) (*LeaveDungeonResponse, error)
+	// Closes the leader's instance early, returning everyone inside to the overworld
+	DisbandDungeon(ctx context.Context, in *DisbandDungeonRequest, opts ...grpc.CallOption) (*DisbandDungeonResponse, error)
+}
+
+type dungeonServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDungeonServiceClient(cc grpc.ClientConnInterface) DungeonServiceClient {
+	return &dungeonServiceClient{cc}
+}
+
+func (c *dungeonServiceClient) CreateDungeon(ctx context.Context, in *CreateDungeonRequest, opts ...grpc.CallOption) (*CreateDungeonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateDungeonResponse)
+	err := c.cc.Invoke(ctx, DungeonService_CreateDungeon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dungeonServiceClient) GetDungeon(ctx context.Context, in *GetDungeonRequest, opts ...grpc.CallOption) (*GetDungeonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDungeonResponse)
+	err := c.cc.Invoke(ctx, DungeonService_GetDungeon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dungeonServiceClient) EnterDungeon(ctx context.Context, in *EnterDungeonRequest, opts ...grpc.CallOption) (*EnterDungeonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnterDungeonResponse)
+	err := c.cc.Invoke(ctx, DungeonService_EnterDungeon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dungeonServiceClient) LeaveDungeon(ctx context.Context, in *LeaveDungeonRequest, opts ...grpc.CallOption) (*LeaveDungeonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaveDungeonResponse)
+	err := c.cc.Invoke(ctx, DungeonService_LeaveDungeon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dungeonServiceClient) DisbandDungeon(ctx context.Context, in *DisbandDungeonRequest, opts ...grpc.CallOption) (*DisbandDungeonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisbandDungeonResponse)
+	err := c.cc.Invoke(ctx, DungeonService_DisbandDungeon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DungeonServiceServer is the server API for DungeonService service.
+// All implementations must embed UnimplementedDungeonServiceServer
+// for forward compatibility.
+//
+// Dungeons are instances: a bounded map generated from a seed for one party of
+// characters, away from the overworld, until the instance expires. While a character is
+// inside, MoveCharacter moves it on the instance's grid and HarvestResource harvests the
+// instance's own resource nodes.
+type DungeonServiceServer interface {
+	// Generates a new instance bound to the character and the party members it brings
+	CreateDungeon(context.Context, *CreateDungeonRequest) (*CreateDungeonResponse, error)
+	// Returns the instance the character is bound to
+	GetDungeon(context.Context, *GetDungeonRequest) (*GetDungeonResponse, error)
+	// Puts the character on the entrance of its instance
+	EnterDungeon(context.Context, *EnterDungeonRequest) (*EnterDungeonResponse, error)
+	// Takes the character standing on the entrance back to the overworld
+	LeaveDungeon(context.Context, *LeaveDungeonRequest) (*LeaveDungeonResponse, error)
+	// Closes the leader's instance early, returning everyone inside to the overworld
+	DisbandDungeon(context.Context, *DisbandDungeonRequest) (*DisbandDungeonResponse, error)
+	mustEmbedUnimplementedDungeonServiceServer()
+}
+
+// UnimplementedDungeonServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDungeonServiceServer struct{}
+
+func (UnimplementedDungeonServiceServer) CreateDungeon(context.Context, *CreateDungeonRequest) (*CreateDungeonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDungeon not implemented")
+}
+func (UnimplementedDungeonServiceServer) GetDungeon(context.Context, *GetDungeonRequest) (*GetDungeonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDungeon not implemented")
+}
+func (UnimplementedDungeonServiceServer) EnterDungeon(context.Context, *EnterDungeonRequest) (*EnterDungeonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnterDungeon not implemented")
+}
+func (UnimplementedDungeonServiceServer) LeaveDungeon(context.Context, *LeaveDungeonRequest) (*LeaveDungeonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveDungeon not implemented")
+}
+func (UnimplementedDungeonServiceServer) DisbandDungeon(context.Context, *DisbandDungeonRequest) (*DisbandDungeonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisbandDungeon not implemented")
+}
+func (UnimplementedDungeonServiceServer) mustEmbedUnimplementedDungeonServiceServer() {}
+func (UnimplementedDungeonServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeDungeonServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DungeonServiceServer will
+// result in compilation errors.
+type UnsafeDungeonServiceServer interface {
+	mustEmbedUnimplementedDungeonServiceServer()
+}
+
+func RegisterDungeonServiceServer(s grpc.ServiceRegistrar, srv DungeonServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDungeonServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DungeonService_ServiceDesc, srv)
+}
+
+func _DungeonService_CreateDungeon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDungeonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DungeonServiceServer).CreateDungeon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DungeonService_CreateDungeon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DungeonServiceServer).CreateDungeon(ctx, req.(*CreateDungeonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DungeonService_GetDungeon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDungeonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DungeonServiceServer).GetDungeon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DungeonService_GetDungeon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DungeonServiceServer).GetDungeon(ctx, req.(*GetDungeonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DungeonService_EnterDungeon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnterDungeonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DungeonServiceServer).EnterDungeon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DungeonService_EnterDungeon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DungeonServiceServer).EnterDungeon(ctx, req.(*EnterDungeonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DungeonService_LeaveDungeon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveDungeonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DungeonServiceServer).LeaveDungeon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DungeonService_LeaveDungeon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DungeonServiceServer).LeaveDungeon(ctx, req.(*LeaveDungeonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DungeonService_DisbandDungeon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisbandDungeonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DungeonServiceServer).DisbandDungeon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DungeonService_DisbandDungeon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DungeonServiceServer).DisbandDungeon(ctx, req.(*DisbandDungeonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DungeonService_ServiceDesc is the grpc.ServiceDesc for DungeonService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DungeonService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dungeon.v1.DungeonService",
+	HandlerType: (*DungeonServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateDungeon",
+			Handler:    _DungeonService_CreateDungeon_Handler,
+		},
+		{
+			MethodName: "GetDungeon",
+			Handler:    _DungeonService_GetDungeon_Handler,
+		},
+		{
+			MethodName: "EnterDungeon",
+			Handler:    _DungeonService_EnterDungeon_Handler,
+		},
+		{
+			MethodName: "LeaveDungeon",
+			Handler:    _DungeonService_LeaveDungeon_Handler,
+		},
+		{
+			MethodName: "DisbandDungeon",
+			Handler:    _DungeonService_DisbandDungeon_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dungeon/v1/dungeon.proto",
+}
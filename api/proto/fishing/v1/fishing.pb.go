@@ -0,0 +1,677 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.4
+// source: fishing/v1/fishing.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type FishingEventType int32
+
+const (
+	FishingEventType_FISHING_EVENT_TYPE_UNSPECIFIED FishingEventType = 0
+	FishingEventType_FISHING_EVENT_TYPE_CAST        FishingEventType = 1
+	FishingEventType_FISHING_EVENT_TYPE_BITE        FishingEventType = 2
+	FishingEventType_FISHING_EVENT_TYPE_CAUGHT      FishingEventType = 3
+	FishingEventType_FISHING_EVENT_TYPE_ESCAPED     FishingEventType = 4
+)
+
+// Enum value maps for FishingEventType.
+var (
+	FishingEventType_name = map[int32]string{
+		0: "FISHING_EVENT_TYPE_UNSPECIFIED",
+		1: "FISHING_EVENT_TYPE_CAST",
+		2: "FISHING_EVENT_TYPE_BITE",
+		3: "FISHING_EVENT_TYPE_CAUGHT",
+		4: "FISHING_EVENT_TYPE_ESCAPED",
+	}
+	FishingEventType_value = map[string]int32{
+		"FISHING_EVENT_TYPE_UNSPECIFIED": 0,
+		"FISHING_EVENT_TYPE_CAST":        1,
+		"FISHING_EVENT_TYPE_BITE":        2,
+		"FISHING_EVENT_TYPE_CAUGHT":      3,
+		"FISHING_EVENT_TYPE_ESCAPED":     4,
+	}
+)
+
+func (x FishingEventType) Enum() *FishingEventType {
+	p := new(FishingEventType)
+	*p = x
+	return p
+}
+
+func (x FishingEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FishingEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_fishing_v1_fishing_proto_enumTypes[0].Descriptor()
+}
+
+func (FishingEventType) Type() protoreflect.EnumType {
+	return &file_fishing_v1_fishing_proto_enumTypes[0]
+}
+
+func (x FishingEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FishingEventType.Descriptor instead.
+func (FishingEventType) EnumDescriptor() ([]byte, []int) {
+	return file_fishing_v1_fishing_proto_rawDescGZIP(), []int{0}
+}
+
+type Weather int32
+
+const (
+	Weather_WEATHER_UNSPECIFIED Weather = 0
+	Weather_WEATHER_CLEAR       Weather = 1
+	Weather_WEATHER_CLOUDY      Weather = 2
+	Weather_WEATHER_RAIN        Weather = 3
+	Weather_WEATHER_STORM       Weather = 4
+)
+
+// Enum value maps for Weather.
+var (
+	Weather_name = map[int32]string{
+		0: "WEATHER_UNSPECIFIED",
+		1: "WEATHER_CLEAR",
+		2: "WEATHER_CLOUDY",
+		3: "WEATHER_RAIN",
+		4: "WEATHER_STORM",
+	}
+	Weather_value = map[string]int32{
+		"WEATHER_UNSPECIFIED": 0,
+		"WEATHER_CLEAR":       1,
+		"WEATHER_CLOUDY":      2,
+		"WEATHER_RAIN":        3,
+		"WEATHER_STORM":       4,
+	}
+)
+
+func (x Weather) Enum() *Weather {
+	p := new(Weather)
+	*p = x
+	return p
+}
+
+func (x Weather) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Weather) Descriptor() protoreflect.EnumDescriptor {
+	return file_fishing_v1_fishing_proto_enumTypes[1].Descriptor()
+}
+
+func (Weather) Type() protoreflect.EnumType {
+	return &file_fishing_v1_fishing_proto_enumTypes[1]
+}
+
+func (x Weather) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Weather.Descriptor instead.
+func (Weather) EnumDescriptor() ([]byte, []int) {
+	return file_fishing_v1_fishing_proto_rawDescGZIP(), []int{1}
+}
+
+type TimeOfDay int32
+
+const (
+	TimeOfDay_TIME_OF_DAY_UNSPECIFIED TimeOfDay = 0
+	TimeOfDay_TIME_OF_DAY_DAWN        TimeOfDay = 1
+	TimeOfDay_TIME_OF_DAY_DAY         TimeOfDay = 2
+	TimeOfDay_TIME_OF_DAY_DUSK        TimeOfDay = 3
+	TimeOfDay_TIME_OF_DAY_NIGHT       TimeOfDay = 4
+)
+
+// Enum value maps for TimeOfDay.
+var (
+	TimeOfDay_name = map[int32]string{
+		0: "TIME_OF_DAY_UNSPECIFIED",
+		1: "TIME_OF_DAY_DAWN",
+		2: "TIME_OF_DAY_DAY",
+		3: "TIME_OF_DAY_DUSK",
+		4: "TIME_OF_DAY_NIGHT",
+	}
+	TimeOfDay_value = map[string]int32{
+		"TIME_OF_DAY_UNSPECIFIED": 0,
+		"TIME_OF_DAY_DAWN":        1,
+		"TIME_OF_DAY_DAY":         2,
+		"TIME_OF_DAY_DUSK":        3,
+		"TIME_OF_DAY_NIGHT":       4,
+	}
+)
+
+func (x TimeOfDay) Enum() *TimeOfDay {
+	p := new(TimeOfDay)
+	*p = x
+	return p
+}
+
+func (x TimeOfDay) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimeOfDay) Descriptor() protoreflect.EnumDescriptor {
+	return file_fishing_v1_fishing_proto_enumTypes[2].Descriptor()
+}
+
+func (TimeOfDay) Type() protoreflect.EnumType {
+	return &file_fishing_v1_fishing_proto_enumTypes[2]
+}
+
+func (x TimeOfDay) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimeOfDay.Descriptor instead.
+func (TimeOfDay) EnumDescriptor() ([]byte, []int) {
+	return file_fishing_v1_fishing_proto_rawDescGZIP(), []int{2}
+}
+
+// The conditions a cast was made in, which decide its catch table
+type FishingConditions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Weather       Weather                `protobuf:"varint,1,opt,name=weather,proto3,enum=fishing.v1.Weather" json:"weather,omitempty"`
+	TimeOfDay     TimeOfDay              `protobuf:"varint,2,opt,name=time_of_day,json=timeOfDay,proto3,enum=fishing.v1.TimeOfDay" json:"time_of_day,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FishingConditions) Reset() {
+	*x = FishingConditions{}
+	mi := &file_fishing_v1_fishing_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FishingConditions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FishingConditions) ProtoMessage() {}
+
+func (x *FishingConditions) ProtoReflect() protoreflect.Message {
+	mi := &file_fishing_v1_fishing_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FishingConditions.ProtoReflect.Descriptor instead.
+func (*FishingConditions) Descriptor() ([]byte, []int) {
+	return file_fishing_v1_fishing_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FishingConditions) GetWeather() Weather {
+	if x != nil {
+		return x.Weather
+	}
+	return Weather_WEATHER_UNSPECIFIED
+}
+
+func (x *FishingConditions) GetTimeOfDay() TimeOfDay {
+	if x != nil {
+		return x.TimeOfDay
+	}
+	return TimeOfDay_TIME_OF_DAY_UNSPECIFIED
+}
+
+type CaughtItem struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ItemId           int32                  `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	ItemName         string                 `protobuf:"bytes,2,opt,name=item_name,json=itemName,proto3" json:"item_name,omitempty"`
+	Quantity         int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	OverflowQuantity int32                  `protobuf:"varint,4,opt,name=overflow_quantity,json=overflowQuantity,proto3" json:"overflow_quantity,omitempty"` // Did not fit; handled by the world's overflow policy
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CaughtItem) Reset() {
+	*x = CaughtItem{}
+	mi := &file_fishing_v1_fishing_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaughtItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaughtItem) ProtoMessage() {}
+
+func (x *CaughtItem) ProtoReflect() protoreflect.Message {
+	mi := &file_fishing_v1_fishing_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaughtItem.ProtoReflect.Descriptor instead.
+func (*CaughtItem) Descriptor() ([]byte, []int) {
+	return file_fishing_v1_fishing_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CaughtItem) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *CaughtItem) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *CaughtItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CaughtItem) GetOverflowQuantity() int32 {
+	if x != nil {
+		return x.OverflowQuantity
+	}
+	return 0
+}
+
+type FishingEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          FishingEventType       `protobuf:"varint,1,opt,name=type,proto3,enum=fishing.v1.FishingEventType" json:"type,omitempty"`
+	Conditions    *FishingConditions     `protobuf:"bytes,2,opt,name=conditions,proto3" json:"conditions,omitempty"`                            // CAST
+	BiteId        string                 `protobuf:"bytes,3,opt,name=bite_id,json=biteId,proto3" json:"bite_id,omitempty"`                      // BITE
+	HookWindowMs  int64                  `protobuf:"varint,4,opt,name=hook_window_ms,json=hookWindowMs,proto3" json:"hook_window_ms,omitempty"` // BITE: how long the client has to call Hook
+	Items         []*CaughtItem          `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`                                      // CAUGHT
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`                                    // ESCAPED: "missed" or "too_early"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FishingEvent) Reset() {
+	*x = FishingEvent{}
+	mi := &file_fishing_v1_fishing_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FishingEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FishingEvent) ProtoMessage() {}
+
+func (x *FishingEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_fishing_v1_fishing_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FishingEvent.ProtoReflect.Descriptor instead.
+func (*FishingEvent) Descriptor() ([]byte, []int) {
+	return file_fishing_v1_fishing_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FishingEvent) GetType() FishingEventType {
+	if x != nil {
+		return x.Type
+	}
+	return FishingEventType_FISHING_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *FishingEvent) GetConditions() *FishingConditions {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *FishingEvent) GetBiteId() string {
+	if x != nil {
+		return x.BiteId
+	}
+	return ""
+}
+
+func (x *FishingEvent) GetHookWindowMs() int64 {
+	if x != nil {
+		return x.HookWindowMs
+	}
+	return 0
+}
+
+func (x *FishingEvent) GetItems() []*CaughtItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *FishingEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CastRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId    string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	ResourceNodeId int32                  `protobuf:"varint,2,opt,name=resource_node_id,json=resourceNodeId,proto3" json:"resource_node_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CastRequest) Reset() {
+	*x = CastRequest{}
+	mi := &file_fishing_v1_fishing_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CastRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CastRequest) ProtoMessage() {}
+
+func (x *CastRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fishing_v1_fishing_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CastRequest.ProtoReflect.Descriptor instead.
+func (*CastRequest) Descriptor() ([]byte, []int) {
+	return file_fishing_v1_fishing_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CastRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *CastRequest) GetResourceNodeId() int32 {
+	if x != nil {
+		return x.ResourceNodeId
+	}
+	return 0
+}
+
+type HookRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	BiteId      string                 `protobuf:"bytes,2,opt,name=bite_id,json=biteId,proto3" json:"bite_id,omitempty"`
+	// When the player set the hook, on the client clock. Credited within the session's
+	// latency allowance once calibrated with UserService.SyncClock; without it the
+	// estimated one-way latency is assumed.
+	ClientTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=client_time,json=clientTime,proto3" json:"client_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HookRequest) Reset() {
+	*x = HookRequest{}
+	mi := &file_fishing_v1_fishing_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HookRequest) ProtoMessage() {}
+
+func (x *HookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fishing_v1_fishing_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HookRequest.ProtoReflect.Descriptor instead.
+func (*HookRequest) Descriptor() ([]byte, []int) {
+	return file_fishing_v1_fishing_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *HookRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *HookRequest) GetBiteId() string {
+	if x != nil {
+		return x.BiteId
+	}
+	return ""
+}
+
+func (x *HookRequest) GetClientTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClientTime
+	}
+	return nil
+}
+
+type HookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hooked        bool                   `protobuf:"varint,1,opt,name=hooked,proto3" json:"hooked,omitempty"` // The fish was hooked in time; the catch follows on the cast stream
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HookResponse) Reset() {
+	*x = HookResponse{}
+	mi := &file_fishing_v1_fishing_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HookResponse) ProtoMessage() {}
+
+func (x *HookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_fishing_v1_fishing_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HookResponse.ProtoReflect.Descriptor instead.
+func (*HookResponse) Descriptor() ([]byte, []int) {
+	return file_fishing_v1_fishing_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *HookResponse) GetHooked() bool {
+	if x != nil {
+		return x.Hooked
+	}
+	return false
+}
+
+var File_fishing_v1_fishing_proto protoreflect.FileDescriptor
+
+const file_fishing_v1_fishing_proto_rawDesc = "" +
+	"\n" +
+	"\x18fishing/v1/fishing.proto\x12\n" +
+	"fishing.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"y\n" +
+	"\x11FishingConditions\x12-\n" +
+	"\aweather\x18\x01 \x01(\x0e2\x13.fishing.v1.WeatherR\aweather\x125\n" +
+	"\vtime_of_day\x18\x02 \x01(\x0e2\x15.fishing.v1.TimeOfDayR\ttimeOfDay\"\x8b\x01\n" +
+	"\n" +
+	"CaughtItem\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\x05R\x06itemId\x12\x1b\n" +
+	"\titem_name\x18\x02 \x01(\tR\bitemName\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12+\n" +
+	"\x11overflow_quantity\x18\x04 \x01(\x05R\x10overflowQuantity\"\x84\x02\n" +
+	"\fFishingEvent\x120\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1c.fishing.v1.FishingEventTypeR\x04type\x12=\n" +
+	"\n" +
+	"conditions\x18\x02 \x01(\v2\x1d.fishing.v1.FishingConditionsR\n" +
+	"conditions\x12\x17\n" +
+	"\abite_id\x18\x03 \x01(\tR\x06biteId\x12$\n" +
+	"\x0ehook_window_ms\x18\x04 \x01(\x03R\fhookWindowMs\x12,\n" +
+	"\x05items\x18\x05 \x03(\v2\x16.fishing.v1.CaughtItemR\x05items\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\"Z\n" +
+	"\vCastRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12(\n" +
+	"\x10resource_node_id\x18\x02 \x01(\x05R\x0eresourceNodeId\"\x86\x01\n" +
+	"\vHookRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x17\n" +
+	"\abite_id\x18\x02 \x01(\tR\x06biteId\x12;\n" +
+	"\vclient_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"clientTime\"&\n" +
+	"\fHookResponse\x12\x16\n" +
+	"\x06hooked\x18\x01 \x01(\bR\x06hooked*\xaf\x01\n" +
+	"\x10FishingEventType\x12\"\n" +
+	"\x1eFISHING_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17FISHING_EVENT_TYPE_CAST\x10\x01\x12\x1b\n" +
+	"\x17FISHING_EVENT_TYPE_BITE\x10\x02\x12\x1d\n" +
+	"\x19FISHING_EVENT_TYPE_CAUGHT\x10\x03\x12\x1e\n" +
+	"\x1aFISHING_EVENT_TYPE_ESCAPED\x10\x04*n\n" +
+	"\aWeather\x12\x17\n" +
+	"\x13WEATHER_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rWEATHER_CLEAR\x10\x01\x12\x12\n" +
+	"\x0eWEATHER_CLOUDY\x10\x02\x12\x10\n" +
+	"\fWEATHER_RAIN\x10\x03\x12\x11\n" +
+	"\rWEATHER_STORM\x10\x04*\x80\x01\n" +
+	"\tTimeOfDay\x12\x1b\n" +
+	"\x17TIME_OF_DAY_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10TIME_OF_DAY_DAWN\x10\x01\x12\x13\n" +
+	"\x0fTIME_OF_DAY_DAY\x10\x02\x12\x14\n" +
+	"\x10TIME_OF_DAY_DUSK\x10\x03\x12\x15\n" +
+	"\x11TIME_OF_DAY_NIGHT\x10\x042\x8c\x01\n" +
+	"\x0eFishingService\x12=\n" +
+	"\x04Cast\x12\x17.fishing.v1.CastRequest\x1a\x18.fishing.v1.FishingEvent\"\x000\x01\x12;\n" +
+	"\x04Hook\x12\x17.fishing.v1.HookRequest\x1a\x18.fishing.v1.HookResponse\"\x00B.Z,github.com/VoidMesh/api/api/proto/fishing/v1b\x06proto3"
+
+var (
+	file_fishing_v1_fishing_proto_rawDescOnce sync.Once
+	file_fishing_v1_fishing_proto_rawDescData []byte
+)
+
+func file_fishing_v1_fishing_proto_rawDescGZIP() []byte {
+	file_fishing_v1_fishing_proto_rawDescOnce.Do(func() {
+		file_fishing_v1_fishing_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_fishing_v1_fishing_proto_rawDesc), len(file_fishing_v1_fishing_proto_rawDesc)))
+	})
+	return file_fishing_v1_fishing_proto_rawDescData
+}
+
+var file_fishing_v1_fishing_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_fishing_v1_fishing_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_fishing_v1_fishing_proto_goTypes = []any{
+	(FishingEventType)(0),         // 0: fishing.v1.FishingEventType
+	(Weather)(0),                  // 1: fishing.v1.Weather
+	(TimeOfDay)(0),                // 2: fishing.v1.TimeOfDay
+	(*FishingConditions)(nil),     // 3: fishing.v1.FishingConditions
+	(*CaughtItem)(nil),            // 4: fishing.v1.CaughtItem
+	(*FishingEvent)(nil),          // 5: fishing.v1.FishingEvent
+	(*CastRequest)(nil),           // 6: fishing.v1.CastRequest
+	(*HookRequest)(nil),           // 7: fishing.v1.HookRequest
+	(*HookResponse)(nil),          // 8: fishing.v1.HookResponse
+	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
+}
+var file_fishing_v1_fishing_proto_depIdxs = []int32{
+	1, // 0: fishing.v1.FishingConditions.weather:type_name -> fishing.v1.Weather
+	2, // 1: fishing.v1.FishingConditions.time_of_day:type_name -> fishing.v1.TimeOfDay
+	0, // 2: fishing.v1.FishingEvent.type:type_name -> fishing.v1.FishingEventType
+	3, // 3: fishing.v1.FishingEvent.conditions:type_name -> fishing.v1.FishingConditions
+	4, // 4: fishing.v1.FishingEvent.items:type_name -> fishing.v1.CaughtItem
+	9, // 5: fishing.v1.HookRequest.client_time:type_name -> google.protobuf.Timestamp
+	6, // 6: fishing.v1.FishingService.Cast:input_type -> fishing.v1.CastRequest
+	7, // 7: fishing.v1.FishingService.Hook:input_type -> fishing.v1.HookRequest
+	5, // 8: fishing.v1.FishingService.Cast:output_type -> fishing.v1.FishingEvent
+	8, // 9: fishing.v1.FishingService.Hook:output_type -> fishing.v1.HookResponse
+	8, // [8:10] is the sub-list for method output_type
+	6, // [6:8] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_fishing_v1_fishing_proto_init() }
+func file_fishing_v1_fishing_proto_init() {
+	if File_fishing_v1_fishing_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_fishing_v1_fishing_proto_rawDesc), len(file_fishing_v1_fishing_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_fishing_v1_fishing_proto_goTypes,
+		DependencyIndexes: file_fishing_v1_fishing_proto_depIdxs,
+		EnumInfos:         file_fishing_v1_fishing_proto_enumTypes,
+		MessageInfos:      file_fishing_v1_fishing_proto_msgTypes,
+	}.Build()
+	File_fishing_v1_fishing_proto = out.File
+	file_fishing_v1_fishing_proto_goTypes = nil
+	file_fishing_v1_fishing_proto_depIdxs = nil
+}
@@ -0,0 +1,177 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.4
+// source: fishing/v1/fishing.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	FishingService_Cast_FullMethodName = "/fishing.v1.FishingService/Cast"
+	FishingService_Hook_FullMethodName = "/fishing.v1.FishingService/Hook"
+)
+
+// FishingServiceClient is the client API for FishingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Characters fish at fishing spots. A cast is a stream: after a random delay the server
+// sends a bite, and the client has the bite's hook window to call Hook. What can be
+// caught depends on the weather and time of day.
+type FishingServiceClient interface {
+	// Casts at a fishing spot within reach. Streams CAST, then BITE, then CAUGHT or ESCAPED,
+	// and ends. A character has one cast at a time.
+	Cast(ctx context.Context, in *CastRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FishingEvent], error)
+	// Sets the hook on the current bite. Hooking before the bite scares the fish off.
+	Hook(ctx context.Context, in *HookRequest, opts ...grpc.CallOption) (*HookResponse, error)
+}
+
+type fishingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFishingServiceClient(cc grpc.ClientConnInterface) FishingServiceClient {
+	return &fishingServiceClient{cc}
+}
+
+func (c *fishingServiceClient) Cast(ctx context.Context, in *CastRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FishingEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &FishingService_ServiceDesc.Streams[0], FishingService_Cast_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[CastRequest, FishingEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FishingService_CastClient = grpc.ServerStreamingClient[FishingEvent]
+
+func (c *fishingServiceClient) Hook(ctx context.Context, in *HookRequest, opts ...grpc.CallOption) (*HookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HookResponse)
+	err := c.cc.Invoke(ctx, FishingService_Hook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FishingServiceServer is the server API for FishingService service.
+// All implementations must embed UnimplementedFishingServiceServer
+// for forward compatibility.
+//
+// Characters fish at fishing spots. A cast is a stream: after a random delay the server
+// sends a bite, and the client has the bite's hook window to call Hook. What can be
+// caught depends on the weather and time of day.
+type FishingServiceServer interface {
+	// Casts at a fishing spot within reach. Streams CAST, then BITE, then CAUGHT or ESCAPED,
+	// and ends. A character has one cast at a time.
+	Cast(*CastRequest, grpc.ServerStreamingServer[FishingEvent]) error
+	// Sets the hook on the current bite. Hooking before the bite scares the fish off.
+	Hook(context.Context, *HookRequest) (*HookResponse, error)
+	mustEmbedUnimplementedFishingServiceServer()
+}
+
+// UnimplementedFishingServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFishingServiceServer struct{}
+
+func (UnimplementedFishingServiceServer) Cast(*CastRequest, grpc.ServerStreamingServer[FishingEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method Cast not implemented")
+}
+func (UnimplementedFishingServiceServer) Hook(context.Context, *HookRequest) (*HookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Hook not implemented")
+}
+func (UnimplementedFishingServiceServer) mustEmbedUnimplementedFishingServiceServer() {}
+func (UnimplementedFishingServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeFishingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FishingServiceServer will
+// result in compilation errors.
+type UnsafeFishingServiceServer interface {
+	mustEmbedUnimplementedFishingServiceServer()
+}
+
+func RegisterFishingServiceServer(s grpc.ServiceRegistrar, srv FishingServiceServer) {
+	// If the following call pancis, it indicates UnimplementedFishingServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FishingService_ServiceDesc, srv)
+}
+
+func _FishingService_Cast_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CastRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FishingServiceServer).Cast(m, &grpc.GenericServerStream[CastRequest, FishingEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FishingService_CastServer = grpc.ServerStreamingServer[FishingEvent]
+
+func _FishingService_Hook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FishingServiceServer).Hook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FishingService_Hook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FishingServiceServer).Hook(ctx, req.(*HookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FishingService_ServiceDesc is the grpc.ServiceDesc for FishingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FishingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "fishing.v1.FishingService",
+	HandlerType: (*FishingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Hook",
+			Handler:    _FishingService_Hook_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Cast",
+			Handler:       _FishingService_Cast_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "fishing/v1/fishing.proto",
+}
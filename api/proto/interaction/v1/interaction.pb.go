@@ -0,0 +1,505 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.4
+// source: interaction/v1/interaction.proto
+
+package v1
+
+import (
+	v11 "github.com/VoidMesh/api/api/proto/bank/v1"
+	v1 "github.com/VoidMesh/api/api/proto/interior/v1"
+	v12 "github.com/VoidMesh/api/api/proto/processing/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type InteractRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId    string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	TargetEntityId int64                  `protobuf:"varint,2,opt,name=target_entity_id,json=targetEntityId,proto3" json:"target_entity_id,omitempty"`
+	Verb           string                 `protobuf:"bytes,3,opt,name=verb,proto3" json:"verb,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InteractRequest) Reset() {
+	*x = InteractRequest{}
+	mi := &file_interaction_v1_interaction_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InteractRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InteractRequest) ProtoMessage() {}
+
+func (x *InteractRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_interaction_v1_interaction_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InteractRequest.ProtoReflect.Descriptor instead.
+func (*InteractRequest) Descriptor() ([]byte, []int) {
+	return file_interaction_v1_interaction_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *InteractRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *InteractRequest) GetTargetEntityId() int64 {
+	if x != nil {
+		return x.TargetEntityId
+	}
+	return 0
+}
+
+func (x *InteractRequest) GetVerb() string {
+	if x != nil {
+		return x.Verb
+	}
+	return ""
+}
+
+type InteractResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Verb           string                 `protobuf:"bytes,1,opt,name=verb,proto3" json:"verb,omitempty"`
+	TargetEntityId int64                  `protobuf:"varint,2,opt,name=target_entity_id,json=targetEntityId,proto3" json:"target_entity_id,omitempty"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*InteractResponse_Examination
+	//	*InteractResponse_Interior
+	//	*InteractResponse_Station
+	//	*InteractResponse_Vault
+	Result        isInteractResponse_Result `protobuf_oneof:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InteractResponse) Reset() {
+	*x = InteractResponse{}
+	mi := &file_interaction_v1_interaction_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InteractResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InteractResponse) ProtoMessage() {}
+
+func (x *InteractResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_interaction_v1_interaction_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InteractResponse.ProtoReflect.Descriptor instead.
+func (*InteractResponse) Descriptor() ([]byte, []int) {
+	return file_interaction_v1_interaction_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *InteractResponse) GetVerb() string {
+	if x != nil {
+		return x.Verb
+	}
+	return ""
+}
+
+func (x *InteractResponse) GetTargetEntityId() int64 {
+	if x != nil {
+		return x.TargetEntityId
+	}
+	return 0
+}
+
+func (x *InteractResponse) GetResult() isInteractResponse_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *InteractResponse) GetExamination() *Examination {
+	if x != nil {
+		if x, ok := x.Result.(*InteractResponse_Examination); ok {
+			return x.Examination
+		}
+	}
+	return nil
+}
+
+func (x *InteractResponse) GetInterior() *v1.EnterInteriorResponse {
+	if x != nil {
+		if x, ok := x.Result.(*InteractResponse_Interior); ok {
+			return x.Interior
+		}
+	}
+	return nil
+}
+
+func (x *InteractResponse) GetStation() *StationUse {
+	if x != nil {
+		if x, ok := x.Result.(*InteractResponse_Station); ok {
+			return x.Station
+		}
+	}
+	return nil
+}
+
+func (x *InteractResponse) GetVault() *v11.Vault {
+	if x != nil {
+		if x, ok := x.Result.(*InteractResponse_Vault); ok {
+			return x.Vault
+		}
+	}
+	return nil
+}
+
+type isInteractResponse_Result interface {
+	isInteractResponse_Result()
+}
+
+type InteractResponse_Examination struct {
+	Examination *Examination `protobuf:"bytes,3,opt,name=examination,proto3,oneof"`
+}
+
+type InteractResponse_Interior struct {
+	Interior *v1.EnterInteriorResponse `protobuf:"bytes,4,opt,name=interior,proto3,oneof"` // Opening steps the character inside
+}
+
+type InteractResponse_Station struct {
+	Station *StationUse `protobuf:"bytes,5,opt,name=station,proto3,oneof"`
+}
+
+type InteractResponse_Vault struct {
+	Vault *v11.Vault `protobuf:"bytes,6,opt,name=vault,proto3,oneof"` // Opening a bank shows the personal vault
+}
+
+func (*InteractResponse_Examination) isInteractResponse_Result() {}
+
+func (*InteractResponse_Interior) isInteractResponse_Result() {}
+
+func (*InteractResponse_Station) isInteractResponse_Result() {}
+
+func (*InteractResponse_Vault) isInteractResponse_Result() {}
+
+// What examining an entity shows
+type Examination struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	EntityId         int64                  `protobuf:"varint,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Type             string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	X                int32                  `protobuf:"varint,3,opt,name=x,proto3" json:"x,omitempty"` // World cell coordinates
+	Y                int32                  `protobuf:"varint,4,opt,name=y,proto3" json:"y,omitempty"`
+	OwnerCharacterId string                 `protobuf:"bytes,5,opt,name=owner_character_id,json=ownerCharacterId,proto3" json:"owner_character_id,omitempty"` // Empty when the entity has no owner
+	Health           int32                  `protobuf:"varint,6,opt,name=health,proto3" json:"health,omitempty"`                                              // Zero with max_health when the entity cannot be damaged
+	MaxHealth        int32                  `protobuf:"varint,7,opt,name=max_health,json=maxHealth,proto3" json:"max_health,omitempty"`
+	ExpiresAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Unset when the entity does not expire
+	Verbs            []string               `protobuf:"bytes,9,rep,name=verbs,proto3" json:"verbs,omitempty"`                          // Sorted
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Examination) Reset() {
+	*x = Examination{}
+	mi := &file_interaction_v1_interaction_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Examination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Examination) ProtoMessage() {}
+
+func (x *Examination) ProtoReflect() protoreflect.Message {
+	mi := &file_interaction_v1_interaction_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Examination.ProtoReflect.Descriptor instead.
+func (*Examination) Descriptor() ([]byte, []int) {
+	return file_interaction_v1_interaction_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Examination) GetEntityId() int64 {
+	if x != nil {
+		return x.EntityId
+	}
+	return 0
+}
+
+func (x *Examination) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Examination) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *Examination) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *Examination) GetOwnerCharacterId() string {
+	if x != nil {
+		return x.OwnerCharacterId
+	}
+	return ""
+}
+
+func (x *Examination) GetHealth() int32 {
+	if x != nil {
+		return x.Health
+	}
+	return 0
+}
+
+func (x *Examination) GetMaxHealth() int32 {
+	if x != nil {
+		return x.MaxHealth
+	}
+	return 0
+}
+
+func (x *Examination) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Examination) GetVerbs() []string {
+	if x != nil {
+		return x.Verbs
+	}
+	return nil
+}
+
+// Activating a station collects the character's ready jobs there and shows what it makes
+type StationUse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	StationEntityId int64                  `protobuf:"varint,1,opt,name=station_entity_id,json=stationEntityId,proto3" json:"station_entity_id,omitempty"`
+	Recipes         []*v12.Recipe          `protobuf:"bytes,2,rep,name=recipes,proto3" json:"recipes,omitempty"`
+	Collected       []*v12.ItemQuantity    `protobuf:"bytes,3,rep,name=collected,proto3" json:"collected,omitempty"`
+	Jobs            []*v12.ProcessingJob   `protobuf:"bytes,4,rep,name=jobs,proto3" json:"jobs,omitempty"` // The character's jobs still at the station
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StationUse) Reset() {
+	*x = StationUse{}
+	mi := &file_interaction_v1_interaction_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StationUse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StationUse) ProtoMessage() {}
+
+func (x *StationUse) ProtoReflect() protoreflect.Message {
+	mi := &file_interaction_v1_interaction_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StationUse.ProtoReflect.Descriptor instead.
+func (*StationUse) Descriptor() ([]byte, []int) {
+	return file_interaction_v1_interaction_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StationUse) GetStationEntityId() int64 {
+	if x != nil {
+		return x.StationEntityId
+	}
+	return 0
+}
+
+func (x *StationUse) GetRecipes() []*v12.Recipe {
+	if x != nil {
+		return x.Recipes
+	}
+	return nil
+}
+
+func (x *StationUse) GetCollected() []*v12.ItemQuantity {
+	if x != nil {
+		return x.Collected
+	}
+	return nil
+}
+
+func (x *StationUse) GetJobs() []*v12.ProcessingJob {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+var File_interaction_v1_interaction_proto protoreflect.FileDescriptor
+
+const file_interaction_v1_interaction_proto_rawDesc = "" +
+	"\n" +
+	" interaction/v1/interaction.proto\x12\x0einteraction.v1\x1a\x12bank/v1/bank.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1ainterior/v1/interior.proto\x1a\x1eprocessing/v1/processing.proto\"r\n" +
+	"\x0fInteractRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12(\n" +
+	"\x10target_entity_id\x18\x02 \x01(\x03R\x0etargetEntityId\x12\x12\n" +
+	"\x04verb\x18\x03 \x01(\tR\x04verb\"\xbd\x02\n" +
+	"\x10InteractResponse\x12\x12\n" +
+	"\x04verb\x18\x01 \x01(\tR\x04verb\x12(\n" +
+	"\x10target_entity_id\x18\x02 \x01(\x03R\x0etargetEntityId\x12?\n" +
+	"\vexamination\x18\x03 \x01(\v2\x1b.interaction.v1.ExaminationH\x00R\vexamination\x12@\n" +
+	"\binterior\x18\x04 \x01(\v2\".interior.v1.EnterInteriorResponseH\x00R\binterior\x126\n" +
+	"\astation\x18\x05 \x01(\v2\x1a.interaction.v1.StationUseH\x00R\astation\x12&\n" +
+	"\x05vault\x18\x06 \x01(\v2\x0e.bank.v1.VaultH\x00R\x05vaultB\b\n" +
+	"\x06result\"\x90\x02\n" +
+	"\vExamination\x12\x1b\n" +
+	"\tentity_id\x18\x01 \x01(\x03R\bentityId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\f\n" +
+	"\x01x\x18\x03 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x04 \x01(\x05R\x01y\x12,\n" +
+	"\x12owner_character_id\x18\x05 \x01(\tR\x10ownerCharacterId\x12\x16\n" +
+	"\x06health\x18\x06 \x01(\x05R\x06health\x12\x1d\n" +
+	"\n" +
+	"max_health\x18\a \x01(\x05R\tmaxHealth\x129\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x14\n" +
+	"\x05verbs\x18\t \x03(\tR\x05verbs\"\xd6\x01\n" +
+	"\n" +
+	"StationUse\x12*\n" +
+	"\x11station_entity_id\x18\x01 \x01(\x03R\x0fstationEntityId\x12/\n" +
+	"\arecipes\x18\x02 \x03(\v2\x15.processing.v1.RecipeR\arecipes\x129\n" +
+	"\tcollected\x18\x03 \x03(\v2\x1b.processing.v1.ItemQuantityR\tcollected\x120\n" +
+	"\x04jobs\x18\x04 \x03(\v2\x1c.processing.v1.ProcessingJobR\x04jobs2e\n" +
+	"\x12InteractionService\x12O\n" +
+	"\bInteract\x12\x1f.interaction.v1.InteractRequest\x1a .interaction.v1.InteractResponse\"\x00B2Z0github.com/VoidMesh/api/api/proto/interaction/v1b\x06proto3"
+
+var (
+	file_interaction_v1_interaction_proto_rawDescOnce sync.Once
+	file_interaction_v1_interaction_proto_rawDescData []byte
+)
+
+func file_interaction_v1_interaction_proto_rawDescGZIP() []byte {
+	file_interaction_v1_interaction_proto_rawDescOnce.Do(func() {
+		file_interaction_v1_interaction_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_interaction_v1_interaction_proto_rawDesc), len(file_interaction_v1_interaction_proto_rawDesc)))
+	})
+	return file_interaction_v1_interaction_proto_rawDescData
+}
+
+var file_interaction_v1_interaction_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_interaction_v1_interaction_proto_goTypes = []any{
+	(*InteractRequest)(nil),          // 0: interaction.v1.InteractRequest
+	(*InteractResponse)(nil),         // 1: interaction.v1.InteractResponse
+	(*Examination)(nil),              // 2: interaction.v1.Examination
+	(*StationUse)(nil),               // 3: interaction.v1.StationUse
+	(*v1.EnterInteriorResponse)(nil), // 4: interior.v1.EnterInteriorResponse
+	(*v11.Vault)(nil),                // 5: bank.v1.Vault
+	(*timestamppb.Timestamp)(nil),    // 6: google.protobuf.Timestamp
+	(*v12.Recipe)(nil),               // 7: processing.v1.Recipe
+	(*v12.ItemQuantity)(nil),         // 8: processing.v1.ItemQuantity
+	(*v12.ProcessingJob)(nil),        // 9: processing.v1.ProcessingJob
+}
+var file_interaction_v1_interaction_proto_depIdxs = []int32{
+	2, // 0: interaction.v1.InteractResponse.examination:type_name -> interaction.v1.Examination
+	4, // 1: interaction.v1.InteractResponse.interior:type_name -> interior.v1.EnterInteriorResponse
+	3, // 2: interaction.v1.InteractResponse.station:type_name -> interaction.v1.StationUse
+	5, // 3: interaction.v1.InteractResponse.vault:type_name -> bank.v1.Vault
+	6, // 4: interaction.v1.Examination.expires_at:type_name -> google.protobuf.Timestamp
+	7, // 5: interaction.v1.StationUse.recipes:type_name -> processing.v1.Recipe
+	8, // 6: interaction.v1.StationUse.collected:type_name -> processing.v1.ItemQuantity
+	9, // 7: interaction.v1.StationUse.jobs:type_name -> processing.v1.ProcessingJob
+	0, // 8: interaction.v1.InteractionService.Interact:input_type -> interaction.v1.InteractRequest
+	1, // 9: interaction.v1.InteractionService.Interact:output_type -> interaction.v1.InteractResponse
+	9, // [9:10] is the sub-list for method output_type
+	8, // [8:9] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_interaction_v1_interaction_proto_init() }
+func file_interaction_v1_interaction_proto_init() {
+	if File_interaction_v1_interaction_proto != nil {
+		return
+	}
+	file_interaction_v1_interaction_proto_msgTypes[1].OneofWrappers = []any{
+		(*InteractResponse_Examination)(nil),
+		(*InteractResponse_Interior)(nil),
+		(*InteractResponse_Station)(nil),
+		(*InteractResponse_Vault)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_interaction_v1_interaction_proto_rawDesc), len(file_interaction_v1_interaction_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_interaction_v1_interaction_proto_goTypes,
+		DependencyIndexes: file_interaction_v1_interaction_proto_depIdxs,
+		MessageInfos:      file_interaction_v1_interaction_proto_msgTypes,
+	}.Build()
+	File_interaction_v1_interaction_proto = out.File
+	file_interaction_v1_interaction_proto_goTypes = nil
+	file_interaction_v1_interaction_proto_depIdxs = nil
+}
@@ -0,0 +1,131 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.4
+// source: interaction/v1/interaction.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	InteractionService_Interact_FullMethodName = "/interaction.v1.InteractionService/Interact"
+)
+
+// InteractionServiceClient is the client API for InteractionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Characters interact with the world entities within reach through generic verbs, so a
+// new kind of object needs a handler on the server rather than an RPC of its own. Verbs
+// are "examine" (any entity), "open" (structures with an interior, and banks) and
+// "activate" (processing stations); examining an entity lists the verbs it accepts.
+type InteractionServiceClient interface {
+	Interact(ctx context.Context, in *InteractRequest, opts ...grpc.CallOption) (*InteractResponse, error)
+}
+
+type interactionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInteractionServiceClient(cc grpc.ClientConnInterface) InteractionServiceClient {
+	return &interactionServiceClient{cc}
+}
+
+func (c *interactionServiceClient) Interact(ctx context.Context, in *InteractRequest, opts ...grpc.CallOption) (*InteractResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InteractResponse)
+	err := c.cc.Invoke(ctx, InteractionService_Interact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InteractionServiceServer is the server API for InteractionService service.
+// All implementations must embed UnimplementedInteractionServiceServer
+// for forward compatibility.
+//
+// Characters interact with the world entities within reach through generic verbs, so a
+// new kind of object needs a handler on the server rather than an RPC of its own. Verbs
+// are "examine" (any entity), "open" (structures with an interior, and banks) and
+// "activate" (processing stations); examining an entity lists the verbs it accepts.
+type InteractionServiceServer interface {
+	Interact(context.Context, *InteractRequest) (*InteractResponse, error)
+	mustEmbedUnimplementedInteractionServiceServer()
+}
+
+// UnimplementedInteractionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedInteractionServiceServer struct{}
+
+func (UnimplementedInteractionServiceServer) Interact(context.Context, *InteractRequest) (*InteractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Interact not implemented")
+}
+func (UnimplementedInteractionServiceServer) mustEmbedUnimplementedInteractionServiceServer() {}
+func (UnimplementedInteractionServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeInteractionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InteractionServiceServer will
+// result in compilation errors.
+type UnsafeInteractionServiceServer interface {
+	mustEmbedUnimplementedInteractionServiceServer()
+}
+
+func RegisterInteractionServiceServer(s grpc.ServiceRegistrar, srv InteractionServiceServer) {
+	// If the following call pancis, it indicates UnimplementedInteractionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&InteractionService_ServiceDesc, srv)
+}
+
+func _InteractionService_Interact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InteractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteractionServiceServer).Interact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InteractionService_Interact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteractionServiceServer).Interact(ctx, req.(*InteractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// InteractionService_ServiceDesc is the grpc.ServiceDesc for InteractionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var InteractionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "interaction.v1.InteractionService",
+	HandlerType: (*InteractionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Interact",
+			Handler:    _InteractionService_Interact_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "interaction/v1/interaction.proto",
+}
@@ -0,0 +1,933 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.4
+// source: interior/v1/interior.proto
+
+package v1
+
+import (
+	v1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Furniture struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ItemId        int32                  `protobuf:"varint,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	ItemName      string                 `protobuf:"bytes,3,opt,name=item_name,json=itemName,proto3" json:"item_name,omitempty"`
+	X             int32                  `protobuf:"varint,4,opt,name=x,proto3" json:"x,omitempty"` // Interior cell coordinates
+	Y             int32                  `protobuf:"varint,5,opt,name=y,proto3" json:"y,omitempty"`
+	PlacedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=placed_at,json=placedAt,proto3" json:"placed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Furniture) Reset() {
+	*x = Furniture{}
+	mi := &file_interior_v1_interior_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Furniture) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Furniture) ProtoMessage() {}
+
+func (x *Furniture) ProtoReflect() protoreflect.Message {
+	mi := &file_interior_v1_interior_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Furniture.ProtoReflect.Descriptor instead.
+func (*Furniture) Descriptor() ([]byte, []int) {
+	return file_interior_v1_interior_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Furniture) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Furniture) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *Furniture) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *Furniture) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *Furniture) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *Furniture) GetPlacedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PlacedAt
+	}
+	return nil
+}
+
+type Interior struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StructureId      int64                  `protobuf:"varint,2,opt,name=structure_id,json=structureId,proto3" json:"structure_id,omitempty"` // World entity the interior belongs to
+	Template         string                 `protobuf:"bytes,3,opt,name=template,proto3" json:"template,omitempty"`
+	Width            int32                  `protobuf:"varint,4,opt,name=width,proto3" json:"width,omitempty"`
+	Height           int32                  `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Cells            []*v1.TerrainCell      `protobuf:"bytes,6,rep,name=cells,proto3" json:"cells,omitempty"` // width x height, row-major; (0, 0) is the top left
+	DoorX            int32                  `protobuf:"varint,7,opt,name=door_x,json=doorX,proto3" json:"door_x,omitempty"`
+	DoorY            int32                  `protobuf:"varint,8,opt,name=door_y,json=doorY,proto3" json:"door_y,omitempty"`
+	Furniture        []*Furniture           `protobuf:"bytes,9,rep,name=furniture,proto3" json:"furniture,omitempty"`
+	OwnerCharacterId string                 `protobuf:"bytes,10,opt,name=owner_character_id,json=ownerCharacterId,proto3" json:"owner_character_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Interior) Reset() {
+	*x = Interior{}
+	mi := &file_interior_v1_interior_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Interior) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Interior) ProtoMessage() {}
+
+func (x *Interior) ProtoReflect() protoreflect.Message {
+	mi := &file_interior_v1_interior_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Interior.ProtoReflect.Descriptor instead.
+func (*Interior) Descriptor() ([]byte, []int) {
+	return file_interior_v1_interior_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Interior) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Interior) GetStructureId() int64 {
+	if x != nil {
+		return x.StructureId
+	}
+	return 0
+}
+
+func (x *Interior) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *Interior) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Interior) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Interior) GetCells() []*v1.TerrainCell {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+func (x *Interior) GetDoorX() int32 {
+	if x != nil {
+		return x.DoorX
+	}
+	return 0
+}
+
+func (x *Interior) GetDoorY() int32 {
+	if x != nil {
+		return x.DoorY
+	}
+	return 0
+}
+
+func (x *Interior) GetFurniture() []*Furniture {
+	if x != nil {
+		return x.Furniture
+	}
+	return nil
+}
+
+func (x *Interior) GetOwnerCharacterId() string {
+	if x != nil {
+		return x.OwnerCharacterId
+	}
+	return ""
+}
+
+// Where a character stands inside an interior
+type InteriorPosition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InteriorId    int64                  `protobuf:"varint,1,opt,name=interior_id,json=interiorId,proto3" json:"interior_id,omitempty"`
+	X             int32                  `protobuf:"varint,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InteriorPosition) Reset() {
+	*x = InteriorPosition{}
+	mi := &file_interior_v1_interior_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InteriorPosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InteriorPosition) ProtoMessage() {}
+
+func (x *InteriorPosition) ProtoReflect() protoreflect.Message {
+	mi := &file_interior_v1_interior_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InteriorPosition.ProtoReflect.Descriptor instead.
+func (*InteriorPosition) Descriptor() ([]byte, []int) {
+	return file_interior_v1_interior_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *InteriorPosition) GetInteriorId() int64 {
+	if x != nil {
+		return x.InteriorId
+	}
+	return 0
+}
+
+func (x *InteriorPosition) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *InteriorPosition) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type GetInteriorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	StructureId   int64                  `protobuf:"varint,2,opt,name=structure_id,json=structureId,proto3" json:"structure_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInteriorRequest) Reset() {
+	*x = GetInteriorRequest{}
+	mi := &file_interior_v1_interior_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInteriorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInteriorRequest) ProtoMessage() {}
+
+func (x *GetInteriorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_interior_v1_interior_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInteriorRequest.ProtoReflect.Descriptor instead.
+func (*GetInteriorRequest) Descriptor() ([]byte, []int) {
+	return file_interior_v1_interior_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetInteriorRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *GetInteriorRequest) GetStructureId() int64 {
+	if x != nil {
+		return x.StructureId
+	}
+	return 0
+}
+
+type GetInteriorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interior      *Interior              `protobuf:"bytes,1,opt,name=interior,proto3" json:"interior,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInteriorResponse) Reset() {
+	*x = GetInteriorResponse{}
+	mi := &file_interior_v1_interior_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInteriorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInteriorResponse) ProtoMessage() {}
+
+func (x *GetInteriorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_interior_v1_interior_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInteriorResponse.ProtoReflect.Descriptor instead.
+func (*GetInteriorResponse) Descriptor() ([]byte, []int) {
+	return file_interior_v1_interior_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetInteriorResponse) GetInterior() *Interior {
+	if x != nil {
+		return x.Interior
+	}
+	return nil
+}
+
+type EnterInteriorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	StructureId   int64                  `protobuf:"varint,2,opt,name=structure_id,json=structureId,proto3" json:"structure_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnterInteriorRequest) Reset() {
+	*x = EnterInteriorRequest{}
+	mi := &file_interior_v1_interior_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnterInteriorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnterInteriorRequest) ProtoMessage() {}
+
+func (x *EnterInteriorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_interior_v1_interior_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnterInteriorRequest.ProtoReflect.Descriptor instead.
+func (*EnterInteriorRequest) Descriptor() ([]byte, []int) {
+	return file_interior_v1_interior_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EnterInteriorRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *EnterInteriorRequest) GetStructureId() int64 {
+	if x != nil {
+		return x.StructureId
+	}
+	return 0
+}
+
+type EnterInteriorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interior      *Interior              `protobuf:"bytes,1,opt,name=interior,proto3" json:"interior,omitempty"`
+	Position      *InteriorPosition      `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnterInteriorResponse) Reset() {
+	*x = EnterInteriorResponse{}
+	mi := &file_interior_v1_interior_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnterInteriorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnterInteriorResponse) ProtoMessage() {}
+
+func (x *EnterInteriorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_interior_v1_interior_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnterInteriorResponse.ProtoReflect.Descriptor instead.
+func (*EnterInteriorResponse) Descriptor() ([]byte, []int) {
+	return file_interior_v1_interior_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EnterInteriorResponse) GetInterior() *Interior {
+	if x != nil {
+		return x.Interior
+	}
+	return nil
+}
+
+func (x *EnterInteriorResponse) GetPosition() *InteriorPosition {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+type ExitInteriorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExitInteriorRequest) Reset() {
+	*x = ExitInteriorRequest{}
+	mi := &file_interior_v1_interior_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExitInteriorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExitInteriorRequest) ProtoMessage() {}
+
+func (x *ExitInteriorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_interior_v1_interior_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExitInteriorRequest.ProtoReflect.Descriptor instead.
+func (*ExitInteriorRequest) Descriptor() ([]byte, []int) {
+	return file_interior_v1_interior_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ExitInteriorRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+type ExitInteriorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             int32                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"` // World cell the character stands on again
+	Y             int32                  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExitInteriorResponse) Reset() {
+	*x = ExitInteriorResponse{}
+	mi := &file_interior_v1_interior_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExitInteriorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExitInteriorResponse) ProtoMessage() {}
+
+func (x *ExitInteriorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_interior_v1_interior_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExitInteriorResponse.ProtoReflect.Descriptor instead.
+func (*ExitInteriorResponse) Descriptor() ([]byte, []int) {
+	return file_interior_v1_interior_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ExitInteriorResponse) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *ExitInteriorResponse) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type PlaceFurnitureRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	ItemId        int32                  `protobuf:"varint,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	X             int32                  `protobuf:"varint,3,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,4,opt,name=y,proto3" json:"y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceFurnitureRequest) Reset() {
+	*x = PlaceFurnitureRequest{}
+	mi := &file_interior_v1_interior_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceFurnitureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceFurnitureRequest) ProtoMessage() {}
+
+func (x *PlaceFurnitureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_interior_v1_interior_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceFurnitureRequest.ProtoReflect.Descriptor instead.
+func (*PlaceFurnitureRequest) Descriptor() ([]byte, []int) {
+	return file_interior_v1_interior_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PlaceFurnitureRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *PlaceFurnitureRequest) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *PlaceFurnitureRequest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *PlaceFurnitureRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type PlaceFurnitureResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Furniture     *Furniture             `protobuf:"bytes,1,opt,name=furniture,proto3" json:"furniture,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceFurnitureResponse) Reset() {
+	*x = PlaceFurnitureResponse{}
+	mi := &file_interior_v1_interior_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceFurnitureResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceFurnitureResponse) ProtoMessage() {}
+
+func (x *PlaceFurnitureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_interior_v1_interior_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceFurnitureResponse.ProtoReflect.Descriptor instead.
+func (*PlaceFurnitureResponse) Descriptor() ([]byte, []int) {
+	return file_interior_v1_interior_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PlaceFurnitureResponse) GetFurniture() *Furniture {
+	if x != nil {
+		return x.Furniture
+	}
+	return nil
+}
+
+type RemoveFurnitureRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	FurnitureId   int64                  `protobuf:"varint,2,opt,name=furniture_id,json=furnitureId,proto3" json:"furniture_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFurnitureRequest) Reset() {
+	*x = RemoveFurnitureRequest{}
+	mi := &file_interior_v1_interior_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFurnitureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFurnitureRequest) ProtoMessage() {}
+
+func (x *RemoveFurnitureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_interior_v1_interior_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFurnitureRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFurnitureRequest) Descriptor() ([]byte, []int) {
+	return file_interior_v1_interior_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RemoveFurnitureRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *RemoveFurnitureRequest) GetFurnitureId() int64 {
+	if x != nil {
+		return x.FurnitureId
+	}
+	return 0
+}
+
+type RemoveFurnitureResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Furniture     *Furniture             `protobuf:"bytes,1,opt,name=furniture,proto3" json:"furniture,omitempty"` // The piece picked up
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFurnitureResponse) Reset() {
+	*x = RemoveFurnitureResponse{}
+	mi := &file_interior_v1_interior_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFurnitureResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFurnitureResponse) ProtoMessage() {}
+
+func (x *RemoveFurnitureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_interior_v1_interior_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFurnitureResponse.ProtoReflect.Descriptor instead.
+func (*RemoveFurnitureResponse) Descriptor() ([]byte, []int) {
+	return file_interior_v1_interior_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RemoveFurnitureResponse) GetFurniture() *Furniture {
+	if x != nil {
+		return x.Furniture
+	}
+	return nil
+}
+
+var File_interior_v1_interior_proto protoreflect.FileDescriptor
+
+const file_interior_v1_interior_proto_rawDesc = "" +
+	"\n" +
+	"\x1ainterior/v1/interior.proto\x12\vinterior.v1\x1a\x14chunk/v1/chunk.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa6\x01\n" +
+	"\tFurniture\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\x05R\x06itemId\x12\x1b\n" +
+	"\titem_name\x18\x03 \x01(\tR\bitemName\x12\f\n" +
+	"\x01x\x18\x04 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x05 \x01(\x05R\x01y\x127\n" +
+	"\tplaced_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bplacedAt\"\xc6\x02\n" +
+	"\bInterior\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
+	"\fstructure_id\x18\x02 \x01(\x03R\vstructureId\x12\x1a\n" +
+	"\btemplate\x18\x03 \x01(\tR\btemplate\x12\x14\n" +
+	"\x05width\x18\x04 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x05 \x01(\x05R\x06height\x12+\n" +
+	"\x05cells\x18\x06 \x03(\v2\x15.chunk.v1.TerrainCellR\x05cells\x12\x15\n" +
+	"\x06door_x\x18\a \x01(\x05R\x05doorX\x12\x15\n" +
+	"\x06door_y\x18\b \x01(\x05R\x05doorY\x124\n" +
+	"\tfurniture\x18\t \x03(\v2\x16.interior.v1.FurnitureR\tfurniture\x12,\n" +
+	"\x12owner_character_id\x18\n" +
+	" \x01(\tR\x10ownerCharacterId\"O\n" +
+	"\x10InteriorPosition\x12\x1f\n" +
+	"\vinterior_id\x18\x01 \x01(\x03R\n" +
+	"interiorId\x12\f\n" +
+	"\x01x\x18\x02 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x03 \x01(\x05R\x01y\"Z\n" +
+	"\x12GetInteriorRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12!\n" +
+	"\fstructure_id\x18\x02 \x01(\x03R\vstructureId\"H\n" +
+	"\x13GetInteriorResponse\x121\n" +
+	"\binterior\x18\x01 \x01(\v2\x15.interior.v1.InteriorR\binterior\"\\\n" +
+	"\x14EnterInteriorRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12!\n" +
+	"\fstructure_id\x18\x02 \x01(\x03R\vstructureId\"\x85\x01\n" +
+	"\x15EnterInteriorResponse\x121\n" +
+	"\binterior\x18\x01 \x01(\v2\x15.interior.v1.InteriorR\binterior\x129\n" +
+	"\bposition\x18\x02 \x01(\v2\x1d.interior.v1.InteriorPositionR\bposition\"8\n" +
+	"\x13ExitInteriorRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\"2\n" +
+	"\x14ExitInteriorResponse\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x05R\x01y\"o\n" +
+	"\x15PlaceFurnitureRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\x05R\x06itemId\x12\f\n" +
+	"\x01x\x18\x03 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x04 \x01(\x05R\x01y\"N\n" +
+	"\x16PlaceFurnitureResponse\x124\n" +
+	"\tfurniture\x18\x01 \x01(\v2\x16.interior.v1.FurnitureR\tfurniture\"^\n" +
+	"\x16RemoveFurnitureRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12!\n" +
+	"\ffurniture_id\x18\x02 \x01(\x03R\vfurnitureId\"O\n" +
+	"\x17RemoveFurnitureResponse\x124\n" +
+	"\tfurniture\x18\x01 \x01(\v2\x16.interior.v1.FurnitureR\tfurniture2\xd3\x03\n" +
+	"\x0fInteriorService\x12R\n" +
+	"\vGetInterior\x12\x1f.interior.v1.GetInteriorRequest\x1a .interior.v1.GetInteriorResponse\"\x00\x12X\n" +
+	"\rEnterInterior\x12!.interior.v1.EnterInteriorRequest\x1a\".interior.v1.EnterInteriorResponse\"\x00\x12U\n" +
+	"\fExitInterior\x12 .interior.v1.ExitInteriorRequest\x1a!.interior.v1.ExitInteriorResponse\"\x00\x12[\n" +
+	"\x0ePlaceFurniture\x12\".interior.v1.PlaceFurnitureRequest\x1a#.interior.v1.PlaceFurnitureResponse\"\x00\x12^\n" +
+	"\x0fRemoveFurniture\x12#.interior.v1.RemoveFurnitureRequest\x1a$.interior.v1.RemoveFurnitureResponse\"\x00B/Z-github.com/VoidMesh/api/api/proto/interior/v1b\x06proto3"
+
+var (
+	file_interior_v1_interior_proto_rawDescOnce sync.Once
+	file_interior_v1_interior_proto_rawDescData []byte
+)
+
+func file_interior_v1_interior_proto_rawDescGZIP() []byte {
+	file_interior_v1_interior_proto_rawDescOnce.Do(func() {
+		file_interior_v1_interior_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_interior_v1_interior_proto_rawDesc), len(file_interior_v1_interior_proto_rawDesc)))
+	})
+	return file_interior_v1_interior_proto_rawDescData
+}
+
+var file_interior_v1_interior_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_interior_v1_interior_proto_goTypes = []any{
+	(*Furniture)(nil),               // 0: interior.v1.Furniture
+	(*Interior)(nil),                // 1: interior.v1.Interior
+	(*InteriorPosition)(nil),        // 2: interior.v1.InteriorPosition
+	(*GetInteriorRequest)(nil),      // 3: interior.v1.GetInteriorRequest
+	(*GetInteriorResponse)(nil),     // 4: interior.v1.GetInteriorResponse
+	(*EnterInteriorRequest)(nil),    // 5: interior.v1.EnterInteriorRequest
+	(*EnterInteriorResponse)(nil),   // 6: interior.v1.EnterInteriorResponse
+	(*ExitInteriorRequest)(nil),     // 7: interior.v1.ExitInteriorRequest
+	(*ExitInteriorResponse)(nil),    // 8: interior.v1.ExitInteriorResponse
+	(*PlaceFurnitureRequest)(nil),   // 9: interior.v1.PlaceFurnitureRequest
+	(*PlaceFurnitureResponse)(nil),  // 10: interior.v1.PlaceFurnitureResponse
+	(*RemoveFurnitureRequest)(nil),  // 11: interior.v1.RemoveFurnitureRequest
+	(*RemoveFurnitureResponse)(nil), // 12: interior.v1.RemoveFurnitureResponse
+	(*timestamppb.Timestamp)(nil),   // 13: google.protobuf.Timestamp
+	(*v1.TerrainCell)(nil),          // 14: chunk.v1.TerrainCell
+}
+var file_interior_v1_interior_proto_depIdxs = []int32{
+	13, // 0: interior.v1.Furniture.placed_at:type_name -> google.protobuf.Timestamp
+	14, // 1: interior.v1.Interior.cells:type_name -> chunk.v1.TerrainCell
+	0,  // 2: interior.v1.Interior.furniture:type_name -> interior.v1.Furniture
+	1,  // 3: interior.v1.GetInteriorResponse.interior:type_name -> interior.v1.Interior
+	1,  // 4: interior.v1.EnterInteriorResponse.interior:type_name -> interior.v1.Interior
+	2,  // 5: interior.v1.EnterInteriorResponse.position:type_name -> interior.v1.InteriorPosition
+	0,  // 6: interior.v1.PlaceFurnitureResponse.furniture:type_name -> interior.v1.Furniture
+	0,  // 7: interior.v1.RemoveFurnitureResponse.furniture:type_name -> interior.v1.Furniture
+	3,  // 8: interior.v1.InteriorService.GetInterior:input_type -> interior.v1.GetInteriorRequest
+	5,  // 9: interior.v1.InteriorService.EnterInterior:input_type -> interior.v1.EnterInteriorRequest
+	7,  // 10: interior.v1.InteriorService.ExitInterior:input_type -> interior.v1.ExitInteriorRequest
+	9,  // 11: interior.v1.InteriorService.PlaceFurniture:input_type -> interior.v1.PlaceFurnitureRequest
+	11, // 12: interior.v1.InteriorService.RemoveFurniture:input_type -> interior.v1.RemoveFurnitureRequest
+	4,  // 13: interior.v1.InteriorService.GetInterior:output_type -> interior.v1.GetInteriorResponse
+	6,  // 14: interior.v1.InteriorService.EnterInterior:output_type -> interior.v1.EnterInteriorResponse
+	8,  // 15: interior.v1.InteriorService.ExitInterior:output_type -> interior.v1.ExitInteriorResponse
+	10, // 16: interior.v1.InteriorService.PlaceFurniture:output_type -> interior.v1.PlaceFurnitureResponse
+	12, // 17: interior.v1.InteriorService.RemoveFurniture:output_type -> interior.v1.RemoveFurnitureResponse
+	13, // [13:18] is the sub-list for method output_type
+	8,  // [8:13] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_interior_v1_interior_proto_init() }
+func file_interior_v1_interior_proto_init() {
+	if File_interior_v1_interior_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_interior_v1_interior_proto_rawDesc), len(file_interior_v1_interior_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_interior_v1_interior_proto_goTypes,
+		DependencyIndexes: file_interior_v1_interior_proto_depIdxs,
+		MessageInfos:      file_interior_v1_interior_proto_msgTypes,
+	}.Build()
+	File_interior_v1_interior_proto = out.File
+	file_interior_v1_interior_proto_goTypes = nil
+	file_interior_v1_interior_proto_depIdxs = nil
+}
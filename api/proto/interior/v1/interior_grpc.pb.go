@@ -0,0 +1,291 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.4
+// source: interior/v1/interior.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	InteriorService_GetInterior_FullMethodName     = "/interior.v1.InteriorService/GetInterior"
+	InteriorService_EnterInterior_FullMethodName   = "/interior.v1.InteriorService/EnterInterior"
+	InteriorService_ExitInterior_FullMethodName    = "/interior.v1.InteriorService/ExitInterior"
+	InteriorService_PlaceFurniture_FullMethodName  = "/interior.v1.InteriorService/PlaceFurniture"
+	InteriorService_RemoveFurniture_FullMethodName = "/interior.v1.InteriorService/RemoveFurniture"
+)
+
+// InteriorServiceClient is the client API for InteriorService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Some placed structures (a cottage) have an instanced interior: a small grid of cells of
+// its own, generated from the structure type's template, that characters step into from
+// beside the structure. The structure's owner furnishes it with items from the inventory.
+type InteriorServiceClient interface {
+	// Returns a structure's interior with its furniture, generating it on first use
+	GetInterior(ctx context.Context, in *GetInteriorRequest, opts ...grpc.CallOption) (*GetInteriorResponse, error)
+	// Steps the character from beside the structure onto the interior's door cell
+	EnterInterior(ctx context.Context, in *EnterInteriorRequest, opts ...grpc.CallOption) (*EnterInteriorResponse, error)
+	// Steps the character standing on the door cell back out beside the structure
+	ExitInterior(ctx context.Context, in *ExitInteriorRequest, opts ...grpc.CallOption) (*ExitInteriorResponse, error)
+	// Places a furniture item from the inventory on a free floor cell of the owner's interior
+	PlaceFurniture(ctx context.Context, in *PlaceFurnitureRequest, opts ...grpc.CallOption) (*PlaceFurnitureResponse, error)
+	// Picks a piece of furniture back up into the inventory
+	RemoveFurniture(ctx context.Context, in *RemoveFurnitureRequest, opts ...grpc.CallOption) (*RemoveFurnitureResponse, error)
+}
+
+type interiorServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInteriorServiceClient(cc grpc.ClientConnInterface) InteriorServiceClient {
+	return &interiorServiceClient{cc}
+}
+
+func (c *interiorServiceClient) GetInterior(ctx context.Context, in *GetInteriorRequest, opts ...grpc.CallOption) (*GetInteriorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInteriorResponse)
+	err := c.cc.Invoke(ctx, InteriorService_GetInterior_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interiorServiceClient) EnterInterior(ctx context.Context, in *EnterInteriorRequest, opts ...grpc.CallOption) (*EnterInteriorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnterInteriorResponse)
+	err := c.cc.Invoke(ctx, InteriorService_EnterInterior_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interiorServiceClient) ExitInterior(ctx context.Context, in *ExitInteriorRequest, opts ...grpc.CallOption) (*ExitInteriorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExitInteriorResponse)
+	err := c.cc.Invoke(ctx, InteriorService_ExitInterior_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interiorServiceClient) PlaceFurniture(ctx context.Context, in *PlaceFurnitureRequest, opts ...grpc.CallOption) (*PlaceFurnitureResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlaceFurnitureResponse)
+	err := c.cc.Invoke(ctx, InteriorService_PlaceFurniture_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interiorServiceClient) RemoveFurniture(ctx context.Context, in *RemoveFurnitureRequest, opts ...grpc.CallOption) (*RemoveFurnitureResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveFurnitureResponse)
+	err := c.cc.Invoke(ctx, InteriorService_RemoveFurniture_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InteriorServiceServer is the server API for InteriorService service.
+// All implementations must embed UnimplementedInteriorServiceServer
+// for forward compatibility.
+//
+// Some placed structures (a cottage) have an instanced interior: a small grid of cells of
+// its own, generated from the structure type's template, that characters step into from
+// beside the structure. The structure's owner furnishes it with items from the inventory.
+type InteriorServiceServer interface {
+	// Returns a structure's interior with its furniture, generating it on first use
+	GetInterior(context.Context, *GetInteriorRequest) (*GetInteriorResponse, error)
+	// Steps the character from beside the structure onto the interior's door cell
+	EnterInterior(context.Context, *EnterInteriorRequest) (*EnterInteriorResponse, error)
+	// Steps the character standing on the door cell back out beside the structure
+	ExitInterior(context.Context, *ExitInteriorRequest) (*ExitInteriorResponse, error)
+	// Places a furniture item from the inventory on a free floor cell of the owner's interior
+	PlaceFurniture(context.Context, *PlaceFurnitureRequest) (*PlaceFurnitureResponse, error)
+	// Picks a piece of furniture back up into the inventory
+	RemoveFurniture(context.Context, *RemoveFurnitureRequest) (*RemoveFurnitureResponse, error)
+	mustEmbedUnimplementedInteriorServiceServer()
+}
+
+// UnimplementedInteriorServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedInteriorServiceServer struct{}
+
+func (UnimplementedInteriorServiceServer) GetInterior(context.Context, *GetInteriorRequest) (*GetInteriorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInterior not implemented")
+}
+func (UnimplementedInteriorServiceServer) EnterInterior(context.Context, *EnterInteriorRequest) (*EnterInteriorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnterInterior not implemented")
+}
+func (UnimplementedInteriorServiceServer) ExitInterior(context.Context, *ExitInteriorRequest) (*ExitInteriorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExitInterior not implemented")
+}
+func (UnimplementedInteriorServiceServer) PlaceFurniture(context.Context, *PlaceFurnitureRequest) (*PlaceFurnitureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceFurniture not implemented")
+}
+func (UnimplementedInteriorServiceServer) RemoveFurniture(context.Context, *RemoveFurnitureRequest) (*RemoveFurnitureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFurniture not implemented")
+}
+func (UnimplementedInteriorServiceServer) mustEmbedUnimplementedInteriorServiceServer() {}
+func (UnimplementedInteriorServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeInteriorServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InteriorServiceServer will
+// result in compilation errors.
+type UnsafeInteriorServiceServer interface {
+	mustEmbedUnimplementedInteriorServiceServer()
+}
+
+func RegisterInteriorServiceServer(s grpc.ServiceRegistrar, srv InteriorServiceServer) {
+	// If the following call pancis, it indicates UnimplementedInteriorServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&InteriorService_ServiceDesc, srv)
+}
+
+func _InteriorService_GetInterior_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInteriorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteriorServiceServer).GetInterior(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InteriorService_GetInterior_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteriorServiceServer).GetInterior(ctx, req.(*GetInteriorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InteriorService_EnterInterior_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnterInteriorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteriorServiceServer).EnterInterior(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InteriorService_EnterInterior_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteriorServiceServer).EnterInterior(ctx, req.(*EnterInteriorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InteriorService_ExitInterior_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExitInteriorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteriorServiceServer).ExitInterior(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InteriorService_ExitInterior_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteriorServiceServer).ExitInterior(ctx, req.(*ExitInteriorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InteriorService_PlaceFurniture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceFurnitureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteriorServiceServer).PlaceFurniture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InteriorService_PlaceFurniture_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteriorServiceServer).PlaceFurniture(ctx, req.(*PlaceFurnitureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InteriorService_RemoveFurniture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFurnitureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteriorServiceServer).RemoveFurniture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InteriorService_RemoveFurniture_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteriorServiceServer).RemoveFurniture(ctx, req.(*RemoveFurnitureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// InteriorService_ServiceDesc is the grpc.ServiceDesc for InteriorService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var InteriorService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "interior.v1.InteriorService",
+	HandlerType: (*InteriorServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetInterior",
+			Handler:    _InteriorService_GetInterior_Handler,
+		},
+		{
+			MethodName: "EnterInterior",
+			Handler:    _InteriorService_EnterInterior_Handler,
+		},
+		{
+			MethodName: "ExitInterior",
+			Handler:    _InteriorService_ExitInterior_Handler,
+		},
+		{
+			MethodName: "PlaceFurniture",
+			Handler:    _InteriorService_PlaceFurniture_Handler,
+		},
+		{
+			MethodName: "RemoveFurniture",
+			Handler:    _InteriorService_RemoveFurniture_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "interior/v1/interior.proto",
+}
@@ -22,6 +22,118 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// What happens to items that do not fit in an inventory, set per world
+type OverflowPolicy int32
+
+const (
+	OverflowPolicy_OVERFLOW_POLICY_UNSPECIFIED OverflowPolicy = 0
+	OverflowPolicy_OVERFLOW_POLICY_REJECT      OverflowPolicy = 1 // The action fails and nothing is granted
+	OverflowPolicy_OVERFLOW_POLICY_GROUND      OverflowPolicy = 2 // The overflow is dropped on the ground where it was gained
+	OverflowPolicy_OVERFLOW_POLICY_INBOX       OverflowPolicy = 3 // The overflow is held in the character's inbox
+)
+
+// Enum value maps for OverflowPolicy.
+var (
+	OverflowPolicy_name = map[int32]string{
+		0: "OVERFLOW_POLICY_UNSPECIFIED",
+		1: "OVERFLOW_POLICY_REJECT",
+		2: "OVERFLOW_POLICY_GROUND",
+		3: "OVERFLOW_POLICY_INBOX",
+	}
+	OverflowPolicy_value = map[string]int32{
+		"OVERFLOW_POLICY_UNSPECIFIED": 0,
+		"OVERFLOW_POLICY_REJECT":      1,
+		"OVERFLOW_POLICY_GROUND":      2,
+		"OVERFLOW_POLICY_INBOX":       3,
+	}
+)
+
+func (x OverflowPolicy) Enum() *OverflowPolicy {
+	p := new(OverflowPolicy)
+	*p = x
+	return p
+}
+
+func (x OverflowPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OverflowPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[0].Descriptor()
+}
+
+func (OverflowPolicy) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[0]
+}
+
+func (x OverflowPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OverflowPolicy.Descriptor instead.
+func (OverflowPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{0}
+}
+
+// Canonical inventory orderings; ties are broken by item name
+type InventorySortOrder int32
+
+const (
+	InventorySortOrder_INVENTORY_SORT_ORDER_UNSPECIFIED InventorySortOrder = 0
+	InventorySortOrder_INVENTORY_SORT_ORDER_NAME        InventorySortOrder = 1
+	InventorySortOrder_INVENTORY_SORT_ORDER_TYPE        InventorySortOrder = 2
+	InventorySortOrder_INVENTORY_SORT_ORDER_RARITY      InventorySortOrder = 3 // Rarest first
+	InventorySortOrder_INVENTORY_SORT_ORDER_QUANTITY    InventorySortOrder = 4 // Largest stacks first
+	InventorySortOrder_INVENTORY_SORT_ORDER_RECENT      InventorySortOrder = 5 // Most recently changed first
+)
+
+// Enum value maps for InventorySortOrder.
+var (
+	InventorySortOrder_name = map[int32]string{
+		0: "INVENTORY_SORT_ORDER_UNSPECIFIED",
+		1: "INVENTORY_SORT_ORDER_NAME",
+		2: "INVENTORY_SORT_ORDER_TYPE",
+		3: "INVENTORY_SORT_ORDER_RARITY",
+		4: "INVENTORY_SORT_ORDER_QUANTITY",
+		5: "INVENTORY_SORT_ORDER_RECENT",
+	}
+	InventorySortOrder_value = map[string]int32{
+		"INVENTORY_SORT_ORDER_UNSPECIFIED": 0,
+		"INVENTORY_SORT_ORDER_NAME":        1,
+		"INVENTORY_SORT_ORDER_TYPE":        2,
+		"INVENTORY_SORT_ORDER_RARITY":      3,
+		"INVENTORY_SORT_ORDER_QUANTITY":    4,
+		"INVENTORY_SORT_ORDER_RECENT":      5,
+	}
+)
+
+func (x InventorySortOrder) Enum() *InventorySortOrder {
+	p := new(InventorySortOrder)
+	*p = x
+	return p
+}
+
+func (x InventorySortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InventorySortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[1].Descriptor()
+}
+
+func (InventorySortOrder) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[1]
+}
+
+func (x InventorySortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InventorySortOrder.Descriptor instead.
+func (InventorySortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{1}
+}
+
 // Inventory item representing any harvestable item in character's inventory
 type InventoryItem struct {
 	state       protoimpl.MessageState `protogen:"open.v1"`
@@ -32,12 +144,15 @@ type InventoryItem struct {
 	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	// Item details (populated from JOIN with items table)
-	ItemName      string `protobuf:"bytes,7,opt,name=item_name,json=itemName,proto3" json:"item_name,omitempty"`
-	Description   string `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
-	ItemType      string `protobuf:"bytes,9,opt,name=item_type,json=itemType,proto3" json:"item_type,omitempty"`
-	Rarity        string `protobuf:"bytes,10,opt,name=rarity,proto3" json:"rarity,omitempty"`
-	StackSize     int32  `protobuf:"varint,11,opt,name=stack_size,json=stackSize,proto3" json:"stack_size,omitempty"`
-	VisualData    []byte `protobuf:"bytes,12,opt,name=visual_data,json=visualData,proto3" json:"visual_data,omitempty"` // JSON data for sprite, color, etc.
+	ItemName    string `protobuf:"bytes,7,opt,name=item_name,json=itemName,proto3" json:"item_name,omitempty"`
+	Description string `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	ItemType    string `protobuf:"bytes,9,opt,name=item_type,json=itemType,proto3" json:"item_type,omitempty"`
+	Rarity      string `protobuf:"bytes,10,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	StackSize   int32  `protobuf:"varint,11,opt,name=stack_size,json=stackSize,proto3" json:"stack_size,omitempty"`
+	VisualData  []byte `protobuf:"bytes,12,opt,name=visual_data,json=visualData,proto3" json:"visual_data,omitempty"` // JSON data for sprite, color, etc.
+	// Player organization
+	Favorite      bool     `protobuf:"varint,13,opt,name=favorite,proto3" json:"favorite,omitempty"`
+	Tags          []string `protobuf:"bytes,14,rep,name=tags,proto3" json:"tags,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -156,10 +271,25 @@ func (x *InventoryItem) GetVisualData() []byte {
 	return nil
 }
 
+func (x *InventoryItem) GetFavorite() bool {
+	if x != nil {
+		return x.Favorite
+	}
+	return false
+}
+
+func (x *InventoryItem) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 // Get character inventory
 type GetCharacterInventoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	Filter        *InventoryFilter       `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"` // Optional; all stacks when unset
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -201,6 +331,90 @@ func (x *GetCharacterInventoryRequest) GetCharacterId() string {
 	return ""
 }
 
+func (x *GetCharacterInventoryRequest) GetFilter() *InventoryFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// Stacks must match every field that is set
+type InventoryFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemTypes     []string               `protobuf:"bytes,1,rep,name=item_types,json=itemTypes,proto3" json:"item_types,omitempty"`    // Any of these types
+	Rarities      []string               `protobuf:"bytes,2,rep,name=rarities,proto3" json:"rarities,omitempty"`                       // Any of these rarities
+	NamePrefix    string                 `protobuf:"bytes,3,opt,name=name_prefix,json=namePrefix,proto3" json:"name_prefix,omitempty"` // Case-insensitive
+	FavoritesOnly bool                   `protobuf:"varint,4,opt,name=favorites_only,json=favoritesOnly,proto3" json:"favorites_only,omitempty"`
+	Tag           string                 `protobuf:"bytes,5,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventoryFilter) Reset() {
+	*x = InventoryFilter{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryFilter) ProtoMessage() {}
+
+func (x *InventoryFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryFilter.ProtoReflect.Descriptor instead.
+func (*InventoryFilter) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *InventoryFilter) GetItemTypes() []string {
+	if x != nil {
+		return x.ItemTypes
+	}
+	return nil
+}
+
+func (x *InventoryFilter) GetRarities() []string {
+	if x != nil {
+		return x.Rarities
+	}
+	return nil
+}
+
+func (x *InventoryFilter) GetNamePrefix() string {
+	if x != nil {
+		return x.NamePrefix
+	}
+	return ""
+}
+
+func (x *InventoryFilter) GetFavoritesOnly() bool {
+	if x != nil {
+		return x.FavoritesOnly
+	}
+	return false
+}
+
+func (x *InventoryFilter) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
 type GetCharacterInventoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Items         []*InventoryItem       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
@@ -211,7 +425,7 @@ type GetCharacterInventoryResponse struct {
 
 func (x *GetCharacterInventoryResponse) Reset() {
 	*x = GetCharacterInventoryResponse{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[2]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -223,7 +437,7 @@ func (x *GetCharacterInventoryResponse) String() string {
 func (*GetCharacterInventoryResponse) ProtoMessage() {}
 
 func (x *GetCharacterInventoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[2]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -236,7 +450,7 @@ func (x *GetCharacterInventoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCharacterInventoryResponse.ProtoReflect.Descriptor instead.
 func (*GetCharacterInventoryResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{2}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetCharacterInventoryResponse) GetItems() []*InventoryItem {
@@ -265,7 +479,7 @@ type AddInventoryItemRequest struct {
 
 func (x *AddInventoryItemRequest) Reset() {
 	*x = AddInventoryItemRequest{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[3]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -277,7 +491,7 @@ func (x *AddInventoryItemRequest) String() string {
 func (*AddInventoryItemRequest) ProtoMessage() {}
 
 func (x *AddInventoryItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[3]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -290,7 +504,7 @@ func (x *AddInventoryItemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddInventoryItemRequest.ProtoReflect.Descriptor instead.
 func (*AddInventoryItemRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{3}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *AddInventoryItemRequest) GetCharacterId() string {
@@ -325,7 +539,7 @@ type AddInventoryItemResponse struct {
 
 func (x *AddInventoryItemResponse) Reset() {
 	*x = AddInventoryItemResponse{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[4]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -337,7 +551,7 @@ func (x *AddInventoryItemResponse) String() string {
 func (*AddInventoryItemResponse) ProtoMessage() {}
 
 func (x *AddInventoryItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[4]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -350,7 +564,7 @@ func (x *AddInventoryItemResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddInventoryItemResponse.ProtoReflect.Descriptor instead.
 func (*AddInventoryItemResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{4}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *AddInventoryItemResponse) GetItem() *InventoryItem {
@@ -386,7 +600,7 @@ type RemoveInventoryItemRequest struct {
 
 func (x *RemoveInventoryItemRequest) Reset() {
 	*x = RemoveInventoryItemRequest{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[5]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -398,7 +612,7 @@ func (x *RemoveInventoryItemRequest) String() string {
 func (*RemoveInventoryItemRequest) ProtoMessage() {}
 
 func (x *RemoveInventoryItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[5]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -411,7 +625,7 @@ func (x *RemoveInventoryItemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveInventoryItemRequest.ProtoReflect.Descriptor instead.
 func (*RemoveInventoryItemRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{5}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *RemoveInventoryItemRequest) GetCharacterId() string {
@@ -446,7 +660,7 @@ type RemoveInventoryItemResponse struct {
 
 func (x *RemoveInventoryItemResponse) Reset() {
 	*x = RemoveInventoryItemResponse{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -458,7 +672,7 @@ func (x *RemoveInventoryItemResponse) String() string {
 func (*RemoveInventoryItemResponse) ProtoMessage() {}
 
 func (x *RemoveInventoryItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -471,7 +685,7 @@ func (x *RemoveInventoryItemResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveInventoryItemResponse.ProtoReflect.Descriptor instead.
 func (*RemoveInventoryItemResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{6}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *RemoveInventoryItemResponse) GetItem() *InventoryItem {
@@ -507,7 +721,7 @@ type UpdateItemQuantityRequest struct {
 
 func (x *UpdateItemQuantityRequest) Reset() {
 	*x = UpdateItemQuantityRequest{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -519,7 +733,7 @@ func (x *UpdateItemQuantityRequest) String() string {
 func (*UpdateItemQuantityRequest) ProtoMessage() {}
 
 func (x *UpdateItemQuantityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -532,7 +746,7 @@ func (x *UpdateItemQuantityRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateItemQuantityRequest.ProtoReflect.Descriptor instead.
 func (*UpdateItemQuantityRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{7}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UpdateItemQuantityRequest) GetCharacterId() string {
@@ -567,7 +781,7 @@ type UpdateItemQuantityResponse struct {
 
 func (x *UpdateItemQuantityResponse) Reset() {
 	*x = UpdateItemQuantityResponse{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -579,7 +793,7 @@ func (x *UpdateItemQuantityResponse) String() string {
 func (*UpdateItemQuantityResponse) ProtoMessage() {}
 
 func (x *UpdateItemQuantityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -592,7 +806,7 @@ func (x *UpdateItemQuantityResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateItemQuantityResponse.ProtoReflect.Descriptor instead.
 func (*UpdateItemQuantityResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{8}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateItemQuantityResponse) GetItem() *InventoryItem {
@@ -616,64 +830,942 @@ func (x *UpdateItemQuantityResponse) GetErrorMessage() string {
 	return ""
 }
 
-var File_inventory_v1_inventory_proto protoreflect.FileDescriptor
+// Set stack flags
+type SetInventoryItemFlagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	ItemId        int32                  `protobuf:"varint,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Favorite      bool                   `protobuf:"varint,3,opt,name=favorite,proto3" json:"favorite,omitempty"`
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"` // Up to 10, each up to 24 characters; stored lowercase
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_inventory_v1_inventory_proto_rawDesc = "" +
-	"\n" +
-	"\x1cinventory/v1/inventory.proto\x12\finventory.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa1\x03\n" +
-	"\rInventoryItem\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\x12!\n" +
-	"\fcharacter_id\x18\x02 \x01(\tR\vcharacterId\x12\x17\n" +
-	"\aitem_id\x18\x03 \x01(\x05R\x06itemId\x12\x1a\n" +
-	"\bquantity\x18\x04 \x01(\x05R\bquantity\x129\n" +
-	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1b\n" +
-	"\titem_name\x18\a \x01(\tR\bitemName\x12 \n" +
-	"\vdescription\x18\b \x01(\tR\vdescription\x12\x1b\n" +
-	"\titem_type\x18\t \x01(\tR\bitemType\x12\x16\n" +
-	"\x06rarity\x18\n" +
-	" \x01(\tR\x06rarity\x12\x1d\n" +
-	"\n" +
-	"stack_size\x18\v \x01(\x05R\tstackSize\x12\x1f\n" +
-	"\vvisual_data\x18\f \x01(\fR\n" +
-	"visualData\"A\n" +
-	"\x1cGetCharacterInventoryRequest\x12!\n" +
-	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\"s\n" +
-	"\x1dGetCharacterInventoryResponse\x121\n" +
-	"\x05items\x18\x01 \x03(\v2\x1b.inventory.v1.InventoryItemR\x05items\x12\x1f\n" +
-	"\vtotal_items\x18\x02 \x01(\x05R\n" +
-	"totalItems\"q\n" +
-	"\x17AddInventoryItemRequest\x12!\n" +
-	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x17\n" +
-	"\aitem_id\x18\x02 \x01(\x05R\x06itemId\x12\x1a\n" +
-	"\bquantity\x18\x03 \x01(\x05R\bquantity\"\x8a\x01\n" +
-	"\x18AddInventoryItemResponse\x12/\n" +
-	"\x04item\x18\x01 \x01(\v2\x1b.inventory.v1.InventoryItemR\x04item\x12\x18\n" +
-	"\asuccess\x18\x02 \x01(\bR\asuccess\x12#\n" +
-	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"t\n" +
-	"\x1aRemoveInventoryItemRequest\x12!\n" +
-	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x17\n" +
-	"\aitem_id\x18\x02 \x01(\x05R\x06itemId\x12\x1a\n" +
-	"\bquantity\x18\x03 \x01(\x05R\bquantity\"\x8d\x01\n" +
-	"\x1bRemoveInventoryItemResponse\x12/\n" +
-	"\x04item\x18\x01 \x01(\v2\x1b.inventory.v1.InventoryItemR\x04item\x12\x18\n" +
-	"\asuccess\x18\x02 \x01(\bR\asuccess\x12#\n" +
-	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"z\n" +
-	"\x19UpdateItemQuantityRequest\x12!\n" +
-	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x17\n" +
-	"\aitem_id\x18\x02 \x01(\x05R\x06itemId\x12!\n" +
-	"\fnew_quantity\x18\x03 \x01(\x05R\vnewQuantity\"\x8c\x01\n" +
-	"\x1aUpdateItemQuantityResponse\x12/\n" +
-	"\x04item\x18\x01 \x01(\v2\x1b.inventory.v1.InventoryItemR\x04item\x12\x18\n" +
-	"\asuccess\x18\x02 \x01(\bR\asuccess\x12#\n" +
-	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage2\xc4\x03\n" +
+func (x *SetInventoryItemFlagsRequest) Reset() {
+	*x = SetInventoryItemFlagsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetInventoryItemFlagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetInventoryItemFlagsRequest) ProtoMessage() {}
+
+func (x *SetInventoryItemFlagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetInventoryItemFlagsRequest.ProtoReflect.Descriptor instead.
+func (*SetInventoryItemFlagsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SetInventoryItemFlagsRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *SetInventoryItemFlagsRequest) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *SetInventoryItemFlagsRequest) GetFavorite() bool {
+	if x != nil {
+		return x.Favorite
+	}
+	return false
+}
+
+func (x *SetInventoryItemFlagsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type SetInventoryItemFlagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *InventoryItem         `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetInventoryItemFlagsResponse) Reset() {
+	*x = SetInventoryItemFlagsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetInventoryItemFlagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetInventoryItemFlagsResponse) ProtoMessage() {}
+
+func (x *SetInventoryItemFlagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetInventoryItemFlagsResponse.ProtoReflect.Descriptor instead.
+func (*SetInventoryItemFlagsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetInventoryItemFlagsResponse) GetItem() *InventoryItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+// Sort inventory
+type SortInventoryRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId    string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	Order          InventorySortOrder     `protobuf:"varint,2,opt,name=order,proto3,enum=inventory.v1.InventorySortOrder" json:"order,omitempty"`
+	FavoritesFirst bool                   `protobuf:"varint,3,opt,name=favorites_first,json=favoritesFirst,proto3" json:"favorites_first,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SortInventoryRequest) Reset() {
+	*x = SortInventoryRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SortInventoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortInventoryRequest) ProtoMessage() {}
+
+func (x *SortInventoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortInventoryRequest.ProtoReflect.Descriptor instead.
+func (*SortInventoryRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SortInventoryRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *SortInventoryRequest) GetOrder() InventorySortOrder {
+	if x != nil {
+		return x.Order
+	}
+	return InventorySortOrder_INVENTORY_SORT_ORDER_UNSPECIFIED
+}
+
+func (x *SortInventoryRequest) GetFavoritesFirst() bool {
+	if x != nil {
+		return x.FavoritesFirst
+	}
+	return false
+}
+
+type SortInventoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*InventoryItem       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // In the new order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SortInventoryResponse) Reset() {
+	*x = SortInventoryResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SortInventoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortInventoryResponse) ProtoMessage() {}
+
+func (x *SortInventoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortInventoryResponse.ProtoReflect.Descriptor instead.
+func (*SortInventoryResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SortInventoryResponse) GetItems() []*InventoryItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// Ground drops
+type GroundDrop struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ItemId        int32                  `protobuf:"varint,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	ItemName      string                 `protobuf:"bytes,3,opt,name=item_name,json=itemName,proto3" json:"item_name,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	X             int32                  `protobuf:"varint,5,opt,name=x,proto3" json:"x,omitempty"` // World cell coordinates
+	Y             int32                  `protobuf:"varint,6,opt,name=y,proto3" json:"y,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroundDrop) Reset() {
+	*x = GroundDrop{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroundDrop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroundDrop) ProtoMessage() {}
+
+func (x *GroundDrop) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroundDrop.ProtoReflect.Descriptor instead.
+func (*GroundDrop) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GroundDrop) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GroundDrop) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *GroundDrop) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *GroundDrop) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *GroundDrop) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *GroundDrop) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *GroundDrop) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type ListGroundDropsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroundDropsRequest) Reset() {
+	*x = ListGroundDropsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroundDropsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroundDropsRequest) ProtoMessage() {}
+
+func (x *ListGroundDropsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroundDropsRequest.ProtoReflect.Descriptor instead.
+func (*ListGroundDropsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListGroundDropsRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+type ListGroundDropsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Drops         []*GroundDrop          `protobuf:"bytes,1,rep,name=drops,proto3" json:"drops,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroundDropsResponse) Reset() {
+	*x = ListGroundDropsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroundDropsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroundDropsResponse) ProtoMessage() {}
+
+func (x *ListGroundDropsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroundDropsResponse.ProtoReflect.Descriptor instead.
+func (*ListGroundDropsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListGroundDropsResponse) GetDrops() []*GroundDrop {
+	if x != nil {
+		return x.Drops
+	}
+	return nil
+}
+
+type PickUpGroundDropRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	DropId        int64                  `protobuf:"varint,2,opt,name=drop_id,json=dropId,proto3" json:"drop_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickUpGroundDropRequest) Reset() {
+	*x = PickUpGroundDropRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickUpGroundDropRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickUpGroundDropRequest) ProtoMessage() {}
+
+func (x *PickUpGroundDropRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickUpGroundDropRequest.ProtoReflect.Descriptor instead.
+func (*PickUpGroundDropRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PickUpGroundDropRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *PickUpGroundDropRequest) GetDropId() int64 {
+	if x != nil {
+		return x.DropId
+	}
+	return 0
+}
+
+type PickUpGroundDropResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *InventoryItem         `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"` // The stack the items went to
+	PickedUp      int32                  `protobuf:"varint,2,opt,name=picked_up,json=pickedUp,proto3" json:"picked_up,omitempty"`
+	Remaining     int32                  `protobuf:"varint,3,opt,name=remaining,proto3" json:"remaining,omitempty"` // Left on the ground because the inventory is full
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickUpGroundDropResponse) Reset() {
+	*x = PickUpGroundDropResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickUpGroundDropResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickUpGroundDropResponse) ProtoMessage() {}
+
+func (x *PickUpGroundDropResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickUpGroundDropResponse.ProtoReflect.Descriptor instead.
+func (*PickUpGroundDropResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PickUpGroundDropResponse) GetItem() *InventoryItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *PickUpGroundDropResponse) GetPickedUp() int32 {
+	if x != nil {
+		return x.PickedUp
+	}
+	return 0
+}
+
+func (x *PickUpGroundDropResponse) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+// Inbox
+type InboxItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ItemId        int32                  `protobuf:"varint,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	ItemName      string                 `protobuf:"bytes,3,opt,name=item_name,json=itemName,proto3" json:"item_name,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"` // e.g. "harvest"
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InboxItem) Reset() {
+	*x = InboxItem{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InboxItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InboxItem) ProtoMessage() {}
+
+func (x *InboxItem) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InboxItem.ProtoReflect.Descriptor instead.
+func (*InboxItem) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *InboxItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *InboxItem) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *InboxItem) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *InboxItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *InboxItem) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *InboxItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListInboxItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInboxItemsRequest) Reset() {
+	*x = ListInboxItemsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInboxItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInboxItemsRequest) ProtoMessage() {}
+
+func (x *ListInboxItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInboxItemsRequest.ProtoReflect.Descriptor instead.
+func (*ListInboxItemsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListInboxItemsRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+type ListInboxItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*InboxItem           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // Oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInboxItemsResponse) Reset() {
+	*x = ListInboxItemsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInboxItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInboxItemsResponse) ProtoMessage() {}
+
+func (x *ListInboxItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInboxItemsResponse.ProtoReflect.Descriptor instead.
+func (*ListInboxItemsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListInboxItemsResponse) GetItems() []*InboxItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ClaimInboxItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	InboxItemId   int64                  `protobuf:"varint,2,opt,name=inbox_item_id,json=inboxItemId,proto3" json:"inbox_item_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimInboxItemRequest) Reset() {
+	*x = ClaimInboxItemRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimInboxItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimInboxItemRequest) ProtoMessage() {}
+
+func (x *ClaimInboxItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimInboxItemRequest.ProtoReflect.Descriptor instead.
+func (*ClaimInboxItemRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ClaimInboxItemRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *ClaimInboxItemRequest) GetInboxItemId() int64 {
+	if x != nil {
+		return x.InboxItemId
+	}
+	return 0
+}
+
+type ClaimInboxItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *InventoryItem         `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"` // The stack the items went to
+	Claimed       int32                  `protobuf:"varint,2,opt,name=claimed,proto3" json:"claimed,omitempty"`
+	Remaining     int32                  `protobuf:"varint,3,opt,name=remaining,proto3" json:"remaining,omitempty"` // Left in the inbox because the inventory is full
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimInboxItemResponse) Reset() {
+	*x = ClaimInboxItemResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimInboxItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimInboxItemResponse) ProtoMessage() {}
+
+func (x *ClaimInboxItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimInboxItemResponse.ProtoReflect.Descriptor instead.
+func (*ClaimInboxItemResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ClaimInboxItemResponse) GetItem() *InventoryItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *ClaimInboxItemResponse) GetClaimed() int32 {
+	if x != nil {
+		return x.Claimed
+	}
+	return 0
+}
+
+func (x *ClaimInboxItemResponse) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+var File_inventory_v1_inventory_proto protoreflect.FileDescriptor
+
+const file_inventory_v1_inventory_proto_rawDesc = "" +
+	"\n" +
+	"\x1cinventory/v1/inventory.proto\x12\finventory.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd1\x03\n" +
+	"\rInventoryItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12!\n" +
+	"\fcharacter_id\x18\x02 \x01(\tR\vcharacterId\x12\x17\n" +
+	"\aitem_id\x18\x03 \x01(\x05R\x06itemId\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1b\n" +
+	"\titem_name\x18\a \x01(\tR\bitemName\x12 \n" +
+	"\vdescription\x18\b \x01(\tR\vdescription\x12\x1b\n" +
+	"\titem_type\x18\t \x01(\tR\bitemType\x12\x16\n" +
+	"\x06rarity\x18\n" +
+	" \x01(\tR\x06rarity\x12\x1d\n" +
+	"\n" +
+	"stack_size\x18\v \x01(\x05R\tstackSize\x12\x1f\n" +
+	"\vvisual_data\x18\f \x01(\fR\n" +
+	"visualData\x12\x1a\n" +
+	"\bfavorite\x18\r \x01(\bR\bfavorite\x12\x12\n" +
+	"\x04tags\x18\x0e \x03(\tR\x04tags\"x\n" +
+	"\x1cGetCharacterInventoryRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x125\n" +
+	"\x06filter\x18\x02 \x01(\v2\x1d.inventory.v1.InventoryFilterR\x06filter\"\xa6\x01\n" +
+	"\x0fInventoryFilter\x12\x1d\n" +
+	"\n" +
+	"item_types\x18\x01 \x03(\tR\titemTypes\x12\x1a\n" +
+	"\brarities\x18\x02 \x03(\tR\brarities\x12\x1f\n" +
+	"\vname_prefix\x18\x03 \x01(\tR\n" +
+	"namePrefix\x12%\n" +
+	"\x0efavorites_only\x18\x04 \x01(\bR\rfavoritesOnly\x12\x10\n" +
+	"\x03tag\x18\x05 \x01(\tR\x03tag\"s\n" +
+	"\x1dGetCharacterInventoryResponse\x121\n" +
+	"\x05items\x18\x01 \x03(\v2\x1b.inventory.v1.InventoryItemR\x05items\x12\x1f\n" +
+	"\vtotal_items\x18\x02 \x01(\x05R\n" +
+	"totalItems\"q\n" +
+	"\x17AddInventoryItemRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\x05R\x06itemId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\"\x8a\x01\n" +
+	"\x18AddInventoryItemResponse\x12/\n" +
+	"\x04item\x18\x01 \x01(\v2\x1b.inventory.v1.InventoryItemR\x04item\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"t\n" +
+	"\x1aRemoveInventoryItemRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\x05R\x06itemId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\"\x8d\x01\n" +
+	"\x1bRemoveInventoryItemResponse\x12/\n" +
+	"\x04item\x18\x01 \x01(\v2\x1b.inventory.v1.InventoryItemR\x04item\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"z\n" +
+	"\x19UpdateItemQuantityRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\x05R\x06itemId\x12!\n" +
+	"\fnew_quantity\x18\x03 \x01(\x05R\vnewQuantity\"\x8c\x01\n" +
+	"\x1aUpdateItemQuantityResponse\x12/\n" +
+	"\x04item\x18\x01 \x01(\v2\x1b.inventory.v1.InventoryItemR\x04item\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"\x8a\x01\n" +
+	"\x1cSetInventoryItemFlagsRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\x05R\x06itemId\x12\x1a\n" +
+	"\bfavorite\x18\x03 \x01(\bR\bfavorite\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\"P\n" +
+	"\x1dSetInventoryItemFlagsResponse\x12/\n" +
+	"\x04item\x18\x01 \x01(\v2\x1b.inventory.v1.InventoryItemR\x04item\"\x9a\x01\n" +
+	"\x14SortInventoryRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x126\n" +
+	"\x05order\x18\x02 \x01(\x0e2 .inventory.v1.InventorySortOrderR\x05order\x12'\n" +
+	"\x0ffavorites_first\x18\x03 \x01(\bR\x0efavoritesFirst\"J\n" +
+	"\x15SortInventoryResponse\x121\n" +
+	"\x05items\x18\x01 \x03(\v2\x1b.inventory.v1.InventoryItemR\x05items\"\xc5\x01\n" +
+	"\n" +
+	"GroundDrop\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\x05R\x06itemId\x12\x1b\n" +
+	"\titem_name\x18\x03 \x01(\tR\bitemName\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12\f\n" +
+	"\x01x\x18\x05 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x06 \x01(\x05R\x01y\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\";\n" +
+	"\x16ListGroundDropsRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\"I\n" +
+	"\x17ListGroundDropsResponse\x12.\n" +
+	"\x05drops\x18\x01 \x03(\v2\x18.inventory.v1.GroundDropR\x05drops\"U\n" +
+	"\x17PickUpGroundDropRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x17\n" +
+	"\adrop_id\x18\x02 \x01(\x03R\x06dropId\"\x86\x01\n" +
+	"\x18PickUpGroundDropResponse\x12/\n" +
+	"\x04item\x18\x01 \x01(\v2\x1b.inventory.v1.InventoryItemR\x04item\x12\x1b\n" +
+	"\tpicked_up\x18\x02 \x01(\x05R\bpickedUp\x12\x1c\n" +
+	"\tremaining\x18\x03 \x01(\x05R\tremaining\"\xc0\x01\n" +
+	"\tInboxItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\x05R\x06itemId\x12\x1b\n" +
+	"\titem_name\x18\x03 \x01(\tR\bitemName\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\":\n" +
+	"\x15ListInboxItemsRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\"G\n" +
+	"\x16ListInboxItemsResponse\x12-\n" +
+	"\x05items\x18\x01 \x03(\v2\x17.inventory.v1.InboxItemR\x05items\"^\n" +
+	"\x15ClaimInboxItemRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\"\n" +
+	"\rinbox_item_id\x18\x02 \x01(\x03R\vinboxItemId\"\x81\x01\n" +
+	"\x16ClaimInboxItemResponse\x12/\n" +
+	"\x04item\x18\x01 \x01(\v2\x1b.inventory.v1.InventoryItemR\x04item\x12\x18\n" +
+	"\aclaimed\x18\x02 \x01(\x05R\aclaimed\x12\x1c\n" +
+	"\tremaining\x18\x03 \x01(\x05R\tremaining*\x84\x01\n" +
+	"\x0eOverflowPolicy\x12\x1f\n" +
+	"\x1bOVERFLOW_POLICY_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16OVERFLOW_POLICY_REJECT\x10\x01\x12\x1a\n" +
+	"\x16OVERFLOW_POLICY_GROUND\x10\x02\x12\x19\n" +
+	"\x15OVERFLOW_POLICY_INBOX\x10\x03*\xdd\x01\n" +
+	"\x12InventorySortOrder\x12$\n" +
+	" INVENTORY_SORT_ORDER_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19INVENTORY_SORT_ORDER_NAME\x10\x01\x12\x1d\n" +
+	"\x19INVENTORY_SORT_ORDER_TYPE\x10\x02\x12\x1f\n" +
+	"\x1bINVENTORY_SORT_ORDER_RARITY\x10\x03\x12!\n" +
+	"\x1dINVENTORY_SORT_ORDER_QUANTITY\x10\x04\x12\x1f\n" +
+	"\x1bINVENTORY_SORT_ORDER_RECENT\x10\x052\x99\b\n" +
 	"\x10InventoryService\x12r\n" +
 	"\x15GetCharacterInventory\x12*.inventory.v1.GetCharacterInventoryRequest\x1a+.inventory.v1.GetCharacterInventoryResponse\"\x00\x12c\n" +
 	"\x10AddInventoryItem\x12%.inventory.v1.AddInventoryItemRequest\x1a&.inventory.v1.AddInventoryItemResponse\"\x00\x12l\n" +
 	"\x13RemoveInventoryItem\x12(.inventory.v1.RemoveInventoryItemRequest\x1a).inventory.v1.RemoveInventoryItemResponse\"\x00\x12i\n" +
-	"\x12UpdateItemQuantity\x12'.inventory.v1.UpdateItemQuantityRequest\x1a(.inventory.v1.UpdateItemQuantityResponse\"\x00B0Z.github.com/VoidMesh/api/api/proto/inventory/v1b\x06proto3"
+	"\x12UpdateItemQuantity\x12'.inventory.v1.UpdateItemQuantityRequest\x1a(.inventory.v1.UpdateItemQuantityResponse\"\x00\x12r\n" +
+	"\x15SetInventoryItemFlags\x12*.inventory.v1.SetInventoryItemFlagsRequest\x1a+.inventory.v1.SetInventoryItemFlagsResponse\"\x00\x12Z\n" +
+	"\rSortInventory\x12\".inventory.v1.SortInventoryRequest\x1a#.inventory.v1.SortInventoryResponse\"\x00\x12`\n" +
+	"\x0fListGroundDrops\x12$.inventory.v1.ListGroundDropsRequest\x1a%.inventory.v1.ListGroundDropsResponse\"\x00\x12c\n" +
+	"\x10PickUpGroundDrop\x12%.inventory.v1.PickUpGroundDropRequest\x1a&.inventory.v1.PickUpGroundDropResponse\"\x00\x12]\n" +
+	"\x0eListInboxItems\x12#.inventory.v1.ListInboxItemsRequest\x1a$.inventory.v1.ListInboxItemsResponse\"\x00\x12]\n" +
+	"\x0eClaimInboxItem\x12#.inventory.v1.ClaimInboxItemRequest\x1a$.inventory.v1.ClaimInboxItemResponse\"\x00B0Z.github.com/VoidMesh/api/api/proto/inventory/v1b\x06proto3"
 
 var (
 	file_inventory_v1_inventory_proto_rawDescOnce sync.Once
@@ -687,39 +1779,79 @@ func file_inventory_v1_inventory_proto_rawDescGZIP() []byte {
 	return file_inventory_v1_inventory_proto_rawDescData
 }
 
-var file_inventory_v1_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_inventory_v1_inventory_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_inventory_v1_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_inventory_v1_inventory_proto_goTypes = []any{
-	(*InventoryItem)(nil),                 // 0: inventory.v1.InventoryItem
-	(*GetCharacterInventoryRequest)(nil),  // 1: inventory.v1.GetCharacterInventoryRequest
-	(*GetCharacterInventoryResponse)(nil), // 2: inventory.v1.GetCharacterInventoryResponse
-	(*AddInventoryItemRequest)(nil),       // 3: inventory.v1.AddInventoryItemRequest
-	(*AddInventoryItemResponse)(nil),      // 4: inventory.v1.AddInventoryItemResponse
-	(*RemoveInventoryItemRequest)(nil),    // 5: inventory.v1.RemoveInventoryItemRequest
-	(*RemoveInventoryItemResponse)(nil),   // 6: inventory.v1.RemoveInventoryItemResponse
-	(*UpdateItemQuantityRequest)(nil),     // 7: inventory.v1.UpdateItemQuantityRequest
-	(*UpdateItemQuantityResponse)(nil),    // 8: inventory.v1.UpdateItemQuantityResponse
-	(*timestamppb.Timestamp)(nil),         // 9: google.protobuf.Timestamp
+	(OverflowPolicy)(0),                   // 0: inventory.v1.OverflowPolicy
+	(InventorySortOrder)(0),               // 1: inventory.v1.InventorySortOrder
+	(*InventoryItem)(nil),                 // 2: inventory.v1.InventoryItem
+	(*GetCharacterInventoryRequest)(nil),  // 3: inventory.v1.GetCharacterInventoryRequest
+	(*InventoryFilter)(nil),               // 4: inventory.v1.InventoryFilter
+	(*GetCharacterInventoryResponse)(nil), // 5: inventory.v1.GetCharacterInventoryResponse
+	(*AddInventoryItemRequest)(nil),       // 6: inventory.v1.AddInventoryItemRequest
+	(*AddInventoryItemResponse)(nil),      // 7: inventory.v1.AddInventoryItemResponse
+	(*RemoveInventoryItemRequest)(nil),    // 8: inventory.v1.RemoveInventoryItemRequest
+	(*RemoveInventoryItemResponse)(nil),   // 9: inventory.v1.RemoveInventoryItemResponse
+	(*UpdateItemQuantityRequest)(nil),     // 10: inventory.v1.UpdateItemQuantityRequest
+	(*UpdateItemQuantityResponse)(nil),    // 11: inventory.v1.UpdateItemQuantityResponse
+	(*SetInventoryItemFlagsRequest)(nil),  // 12: inventory.v1.SetInventoryItemFlagsRequest
+	(*SetInventoryItemFlagsResponse)(nil), // 13: inventory.v1.SetInventoryItemFlagsResponse
+	(*SortInventoryRequest)(nil),          // 14: inventory.v1.SortInventoryRequest
+	(*SortInventoryResponse)(nil),         // 15: inventory.v1.SortInventoryResponse
+	(*GroundDrop)(nil),                    // 16: inventory.v1.GroundDrop
+	(*ListGroundDropsRequest)(nil),        // 17: inventory.v1.ListGroundDropsRequest
+	(*ListGroundDropsResponse)(nil),       // 18: inventory.v1.ListGroundDropsResponse
+	(*PickUpGroundDropRequest)(nil),       // 19: inventory.v1.PickUpGroundDropRequest
+	(*PickUpGroundDropResponse)(nil),      // 20: inventory.v1.PickUpGroundDropResponse
+	(*InboxItem)(nil),                     // 21: inventory.v1.InboxItem
+	(*ListInboxItemsRequest)(nil),         // 22: inventory.v1.ListInboxItemsRequest
+	(*ListInboxItemsResponse)(nil),        // 23: inventory.v1.ListInboxItemsResponse
+	(*ClaimInboxItemRequest)(nil),         // 24: inventory.v1.ClaimInboxItemRequest
+	(*ClaimInboxItemResponse)(nil),        // 25: inventory.v1.ClaimInboxItemResponse
+	(*timestamppb.Timestamp)(nil),         // 26: google.protobuf.Timestamp
 }
 var file_inventory_v1_inventory_proto_depIdxs = []int32{
-	9,  // 0: inventory.v1.InventoryItem.created_at:type_name -> google.protobuf.Timestamp
-	9,  // 1: inventory.v1.InventoryItem.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 2: inventory.v1.GetCharacterInventoryResponse.items:type_name -> inventory.v1.InventoryItem
-	0,  // 3: inventory.v1.AddInventoryItemResponse.item:type_name -> inventory.v1.InventoryItem
-	0,  // 4: inventory.v1.RemoveInventoryItemResponse.item:type_name -> inventory.v1.InventoryItem
-	0,  // 5: inventory.v1.UpdateItemQuantityResponse.item:type_name -> inventory.v1.InventoryItem
-	1,  // 6: inventory.v1.InventoryService.GetCharacterInventory:input_type -> inventory.v1.GetCharacterInventoryRequest
-	3,  // 7: inventory.v1.InventoryService.AddInventoryItem:input_type -> inventory.v1.AddInventoryItemRequest
-	5,  // 8: inventory.v1.InventoryService.RemoveInventoryItem:input_type -> inventory.v1.RemoveInventoryItemRequest
-	7,  // 9: inventory.v1.InventoryService.UpdateItemQuantity:input_type -> inventory.v1.UpdateItemQuantityRequest
-	2,  // 10: inventory.v1.InventoryService.GetCharacterInventory:output_type -> inventory.v1.GetCharacterInventoryResponse
-	4,  // 11: inventory.v1.InventoryService.AddInventoryItem:output_type -> inventory.v1.AddInventoryItemResponse
-	6,  // 12: inventory.v1.InventoryService.RemoveInventoryItem:output_type -> inventory.v1.RemoveInventoryItemResponse
-	8,  // 13: inventory.v1.InventoryService.UpdateItemQuantity:output_type -> inventory.v1.UpdateItemQuantityResponse
-	10, // [10:14] is the sub-list for method output_type
-	6,  // [6:10] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	26, // 0: inventory.v1.InventoryItem.created_at:type_name -> google.protobuf.Timestamp
+	26, // 1: inventory.v1.InventoryItem.updated_at:type_name -> google.protobuf.Timestamp
+	4,  // 2: inventory.v1.GetCharacterInventoryRequest.filter:type_name -> inventory.v1.InventoryFilter
+	2,  // 3: inventory.v1.GetCharacterInventoryResponse.items:type_name -> inventory.v1.InventoryItem
+	2,  // 4: inventory.v1.AddInventoryItemResponse.item:type_name -> inventory.v1.InventoryItem
+	2,  // 5: inventory.v1.RemoveInventoryItemResponse.item:type_name -> inventory.v1.InventoryItem
+	2,  // 6: inventory.v1.UpdateItemQuantityResponse.item:type_name -> inventory.v1.InventoryItem
+	2,  // 7: inventory.v1.SetInventoryItemFlagsResponse.item:type_name -> inventory.v1.InventoryItem
+	1,  // 8: inventory.v1.SortInventoryRequest.order:type_name -> inventory.v1.InventorySortOrder
+	2,  // 9: inventory.v1.SortInventoryResponse.items:type_name -> inventory.v1.InventoryItem
+	26, // 10: inventory.v1.GroundDrop.expires_at:type_name -> google.protobuf.Timestamp
+	16, // 11: inventory.v1.ListGroundDropsResponse.drops:type_name -> inventory.v1.GroundDrop
+	2,  // 12: inventory.v1.PickUpGroundDropResponse.item:type_name -> inventory.v1.InventoryItem
+	26, // 13: inventory.v1.InboxItem.created_at:type_name -> google.protobuf.Timestamp
+	21, // 14: inventory.v1.ListInboxItemsResponse.items:type_name -> inventory.v1.InboxItem
+	2,  // 15: inventory.v1.ClaimInboxItemResponse.item:type_name -> inventory.v1.InventoryItem
+	3,  // 16: inventory.v1.InventoryService.GetCharacterInventory:input_type -> inventory.v1.GetCharacterInventoryRequest
+	6,  // 17: inventory.v1.InventoryService.AddInventoryItem:input_type -> inventory.v1.AddInventoryItemRequest
+	8,  // 18: inventory.v1.InventoryService.RemoveInventoryItem:input_type -> inventory.v1.RemoveInventoryItemRequest
+	10, // 19: inventory.v1.InventoryService.UpdateItemQuantity:input_type -> inventory.v1.UpdateItemQuantityRequest
+	12, // 20: inventory.v1.InventoryService.SetInventoryItemFlags:input_type -> inventory.v1.SetInventoryItemFlagsRequest
+	14, // 21: inventory.v1.InventoryService.SortInventory:input_type -> inventory.v1.SortInventoryRequest
+	17, // 22: inventory.v1.InventoryService.ListGroundDrops:input_type -> inventory.v1.ListGroundDropsRequest
+	19, // 23: inventory.v1.InventoryService.PickUpGroundDrop:input_type -> inventory.v1.PickUpGroundDropRequest
+	22, // 24: inventory.v1.InventoryService.ListInboxItems:input_type -> inventory.v1.ListInboxItemsRequest
+	24, // 25: inventory.v1.InventoryService.ClaimInboxItem:input_type -> inventory.v1.ClaimInboxItemRequest
+	5,  // 26: inventory.v1.InventoryService.GetCharacterInventory:output_type -> inventory.v1.GetCharacterInventoryResponse
+	7,  // 27: inventory.v1.InventoryService.AddInventoryItem:output_type -> inventory.v1.AddInventoryItemResponse
+	9,  // 28: inventory.v1.InventoryService.RemoveInventoryItem:output_type -> inventory.v1.RemoveInventoryItemResponse
+	11, // 29: inventory.v1.InventoryService.UpdateItemQuantity:output_type -> inventory.v1.UpdateItemQuantityResponse
+	13, // 30: inventory.v1.InventoryService.SetInventoryItemFlags:output_type -> inventory.v1.SetInventoryItemFlagsResponse
+	15, // 31: inventory.v1.InventoryService.SortInventory:output_type -> inventory.v1.SortInventoryResponse
+	18, // 32: inventory.v1.InventoryService.ListGroundDrops:output_type -> inventory.v1.ListGroundDropsResponse
+	20, // 33: inventory.v1.InventoryService.PickUpGroundDrop:output_type -> inventory.v1.PickUpGroundDropResponse
+	23, // 34: inventory.v1.InventoryService.ListInboxItems:output_type -> inventory.v1.ListInboxItemsResponse
+	25, // 35: inventory.v1.InventoryService.ClaimInboxItem:output_type -> inventory.v1.ClaimInboxItemResponse
+	26, // [26:36] is the sub-list for method output_type
+	16, // [16:26] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_inventory_v1_inventory_proto_init() }
@@ -732,13 +1864,14 @@ func file_inventory_v1_inventory_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_inventory_v1_inventory_proto_rawDesc), len(file_inventory_v1_inventory_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      2,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_inventory_v1_inventory_proto_goTypes,
 		DependencyIndexes: file_inventory_v1_inventory_proto_depIdxs,
+		EnumInfos:         file_inventory_v1_inventory_proto_enumTypes,
 		MessageInfos:      file_inventory_v1_inventory_proto_msgTypes,
 	}.Build()
 	File_inventory_v1_inventory_proto = out.File
@@ -23,6 +23,12 @@ const (
 	InventoryService_AddInventoryItem_FullMethodName      = "/inventory.v1.InventoryService/AddInventoryItem"
 	InventoryService_RemoveInventoryItem_FullMethodName   = "/inventory.v1.InventoryService/RemoveInventoryItem"
 	InventoryService_UpdateItemQuantity_FullMethodName    = "/inventory.v1.InventoryService/UpdateItemQuantity"
+	InventoryService_SetInventoryItemFlags_FullMethodName = "/inventory.v1.InventoryService/SetInventoryItemFlags"
+	InventoryService_SortInventory_FullMethodName         = "/inventory.v1.InventoryService/SortInventory"
+	InventoryService_ListGroundDrops_FullMethodName       = "/inventory.v1.InventoryService/ListGroundDrops"
+	InventoryService_PickUpGroundDrop_FullMethodName      = "/inventory.v1.InventoryService/PickUpGroundDrop"
+	InventoryService_ListInboxItems_FullMethodName        = "/inventory.v1.InventoryService/ListInboxItems"
+	InventoryService_ClaimInboxItem_FullMethodName        = "/inventory.v1.InventoryService/ClaimInboxItem"
 )
 
 // InventoryServiceClient is the client API for InventoryService service.
@@ -32,9 +38,24 @@ type InventoryServiceClient interface {
 	// Inventory management
 	GetCharacterInventory(ctx context.Context, in *GetCharacterInventoryRequest, opts ...grpc.CallOption) (*GetCharacterInventoryResponse, error)
 	// Item management
+	// Grants items to any character (admin only); players gain items through gameplay
 	AddInventoryItem(ctx context.Context, in *AddInventoryItemRequest, opts ...grpc.CallOption) (*AddInventoryItemResponse, error)
 	RemoveInventoryItem(ctx context.Context, in *RemoveInventoryItemRequest, opts ...grpc.CallOption) (*RemoveInventoryItemResponse, error)
 	UpdateItemQuantity(ctx context.Context, in *UpdateItemQuantityRequest, opts ...grpc.CallOption) (*UpdateItemQuantityResponse, error)
+	// Organization
+	// Sets the favorite flag and tags of a stack, replacing the previous ones
+	SetInventoryItemFlags(ctx context.Context, in *SetInventoryItemFlagsRequest, opts ...grpc.CallOption) (*SetInventoryItemFlagsResponse, error)
+	// Stores a canonical ordering of the inventory; GetCharacterInventory lists stacks in it,
+	// with stacks gained since the last sort at the end
+	SortInventory(ctx context.Context, in *SortInventoryRequest, opts ...grpc.CallOption) (*SortInventoryResponse, error)
+	// Overflow: items that did not fit in an inventory
+	// Lists unexpired ground drops within pickup range of the character
+	ListGroundDrops(ctx context.Context, in *ListGroundDropsRequest, opts ...grpc.CallOption) (*ListGroundDropsResponse, error)
+	// Picks up as much of a ground drop as fits; the rest stays on the ground
+	PickUpGroundDrop(ctx context.Context, in *PickUpGroundDropRequest, opts ...grpc.CallOption) (*PickUpGroundDropResponse, error)
+	ListInboxItems(ctx context.Context, in *ListInboxItemsRequest, opts ...grpc.CallOption) (*ListInboxItemsResponse, error)
+	// Moves as much of an inbox item as fits into the inventory; the rest stays in the inbox
+	ClaimInboxItem(ctx context.Context, in *ClaimInboxItemRequest, opts ...grpc.CallOption) (*ClaimInboxItemResponse, error)
 }
 
 type inventoryServiceClient struct {
@@ -85,6 +106,66 @@ func (c *inventoryServiceClient) UpdateItemQuantity(ctx context.Context, in *Upd
 	return out, nil
 }
 
+func (c *inventoryServiceClient) SetInventoryItemFlags(ctx context.Context, in *SetInventoryItemFlagsRequest, opts ...grpc.CallOption) (*SetInventoryItemFlagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetInventoryItemFlagsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_SetInventoryItemFlags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) SortInventory(ctx context.Context, in *SortInventoryRequest, opts ...grpc.CallOption) (*SortInventoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SortInventoryResponse)
+	err := c.cc.Invoke(ctx, InventoryService_SortInventory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListGroundDrops(ctx context.Context, in *ListGroundDropsRequest, opts ...grpc.CallOption) (*ListGroundDropsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGroundDropsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListGroundDrops_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) PickUpGroundDrop(ctx context.Context, in *PickUpGroundDropRequest, opts ...grpc.CallOption) (*PickUpGroundDropResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PickUpGroundDropResponse)
+	err := c.cc.Invoke(ctx, InventoryService_PickUpGroundDrop_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListInboxItems(ctx context.Context, in *ListInboxItemsRequest, opts ...grpc.CallOption) (*ListInboxItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInboxItemsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListInboxItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ClaimInboxItem(ctx context.Context, in *ClaimInboxItemRequest, opts ...grpc.CallOption) (*ClaimInboxItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaimInboxItemResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ClaimInboxItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InventoryServiceServer is the server API for InventoryService service.
 // All implementations must embed UnimplementedInventoryServiceServer
 // for forward compatibility.
@@ -92,9 +173,24 @@ type InventoryServiceServer interface {
 	// Inventory management
 	GetCharacterInventory(context.Context, *GetCharacterInventoryRequest) (*GetCharacterInventoryResponse, error)
 	// Item management
+	// Grants items to any character (admin only); players gain items through gameplay
 	AddInventoryItem(context.Context, *AddInventoryItemRequest) (*AddInventoryItemResponse, error)
 	RemoveInventoryItem(context.Context, *RemoveInventoryItemRequest) (*RemoveInventoryItemResponse, error)
 	UpdateItemQuantity(context.Context, *UpdateItemQuantityRequest) (*UpdateItemQuantityResponse, error)
+	// Organization
+	// Sets the favorite flag and tags of a stack, replacing the previous ones
+	SetInventoryItemFlags(context.Context, *SetInventoryItemFlagsRequest) (*SetInventoryItemFlagsResponse, error)
+	// Stores a canonical ordering of the inventory; GetCharacterInventory lists stacks in it,
+	// with stacks gained since the last sort at the end
+	SortInventory(context.Context, *SortInventoryRequest) (*SortInventoryResponse, error)
+	// Overflow: items that did not fit in an inventory
+	// Lists unexpired ground drops within pickup range of the character
+	ListGroundDrops(context.Context, *ListGroundDropsRequest) (*ListGroundDropsResponse, error)
+	// Picks up as much of a ground drop as fits; the rest stays on the ground
+	PickUpGroundDrop(context.Context, *PickUpGroundDropRequest) (*PickUpGroundDropResponse, error)
+	ListInboxItems(context.Context, *ListInboxItemsRequest) (*ListInboxItemsResponse, error)
+	// Moves as much of an inbox item as fits into the inventory; the rest stays in the inbox
+	ClaimInboxItem(context.Context, *ClaimInboxItemRequest) (*ClaimInboxItemResponse, error)
 	mustEmbedUnimplementedInventoryServiceServer()
 }
 
@@ -117,6 +213,24 @@ func (UnimplementedInventoryServiceServer) RemoveInventoryItem(context.Context,
 func (UnimplementedInventoryServiceServer) UpdateItemQuantity(context.Context, *UpdateItemQuantityRequest) (*UpdateItemQuantityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateItemQuantity not implemented")
 }
+func (UnimplementedInventoryServiceServer) SetInventoryItemFlags(context.Context, *SetInventoryItemFlagsRequest) (*SetInventoryItemFlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetInventoryItemFlags not implemented")
+}
+func (UnimplementedInventoryServiceServer) SortInventory(context.Context, *SortInventoryRequest) (*SortInventoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SortInventory not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListGroundDrops(context.Context, *ListGroundDropsRequest) (*ListGroundDropsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroundDrops not implemented")
+}
+func (UnimplementedInventoryServiceServer) PickUpGroundDrop(context.Context, *PickUpGroundDropRequest) (*PickUpGroundDropResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PickUpGroundDrop not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListInboxItems(context.Context, *ListInboxItemsRequest) (*ListInboxItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInboxItems not implemented")
+}
+func (UnimplementedInventoryServiceServer) ClaimInboxItem(context.Context, *ClaimInboxItemRequest) (*ClaimInboxItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimInboxItem not implemented")
+}
 func (UnimplementedInventoryServiceServer) mustEmbedUnimplementedInventoryServiceServer() {}
 func (UnimplementedInventoryServiceServer) testEmbeddedByValue()                          {}
 
@@ -210,6 +324,114 @@ func _InventoryService_UpdateItemQuantity_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InventoryService_SetInventoryItemFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetInventoryItemFlagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SetInventoryItemFlags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SetInventoryItemFlags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SetInventoryItemFlags(ctx, req.(*SetInventoryItemFlagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_SortInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SortInventoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SortInventory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SortInventory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SortInventory(ctx, req.(*SortInventoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListGroundDrops_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroundDropsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListGroundDrops(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListGroundDrops_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListGroundDrops(ctx, req.(*ListGroundDropsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_PickUpGroundDrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PickUpGroundDropRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).PickUpGroundDrop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_PickUpGroundDrop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).PickUpGroundDrop(ctx, req.(*PickUpGroundDropRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListInboxItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInboxItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListInboxItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListInboxItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListInboxItems(ctx, req.(*ListInboxItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ClaimInboxItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimInboxItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ClaimInboxItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ClaimInboxItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ClaimInboxItem(ctx, req.(*ClaimInboxItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // InventoryService_ServiceDesc is the grpc.ServiceDesc for InventoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -233,6 +455,30 @@ var InventoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateItemQuantity",
 			Handler:    _InventoryService_UpdateItemQuantity_Handler,
 		},
+		{
+			MethodName: "SetInventoryItemFlags",
+			Handler:    _InventoryService_SetInventoryItemFlags_Handler,
+		},
+		{
+			MethodName: "SortInventory",
+			Handler:    _InventoryService_SortInventory_Handler,
+		},
+		{
+			MethodName: "ListGroundDrops",
+			Handler:    _InventoryService_ListGroundDrops_Handler,
+		},
+		{
+			MethodName: "PickUpGroundDrop",
+			Handler:    _InventoryService_PickUpGroundDrop_Handler,
+		},
+		{
+			MethodName: "ListInboxItems",
+			Handler:    _InventoryService_ListInboxItems_Handler,
+		},
+		{
+			MethodName: "ClaimInboxItem",
+			Handler:    _InventoryService_ClaimInboxItem_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inventory/v1/inventory.proto",
@@ -0,0 +1,720 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.4
+// source: land_claim/v1/land_claim.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type LandClaim struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	WorldId       string                 `protobuf:"bytes,2,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
+	ChunkX        int32                  `protobuf:"varint,3,opt,name=chunk_x,json=chunkX,proto3" json:"chunk_x,omitempty"`
+	ChunkY        int32                  `protobuf:"varint,4,opt,name=chunk_y,json=chunkY,proto3" json:"chunk_y,omitempty"`
+	CharacterId   string                 `protobuf:"bytes,5,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	ClaimedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=claimed_at,json=claimedAt,proto3" json:"claimed_at,omitempty"`
+	PaidUntil     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=paid_until,json=paidUntil,proto3" json:"paid_until,omitempty"` // Upkeep is taken from the owner's inventory at this time
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LandClaim) Reset() {
+	*x = LandClaim{}
+	mi := &file_land_claim_v1_land_claim_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LandClaim) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LandClaim) ProtoMessage() {}
+
+func (x *LandClaim) ProtoReflect() protoreflect.Message {
+	mi := &file_land_claim_v1_land_claim_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LandClaim.ProtoReflect.Descriptor instead.
+func (*LandClaim) Descriptor() ([]byte, []int) {
+	return file_land_claim_v1_land_claim_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LandClaim) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LandClaim) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *LandClaim) GetChunkX() int32 {
+	if x != nil {
+		return x.ChunkX
+	}
+	return 0
+}
+
+func (x *LandClaim) GetChunkY() int32 {
+	if x != nil {
+		return x.ChunkY
+	}
+	return 0
+}
+
+func (x *LandClaim) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *LandClaim) GetClaimedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClaimedAt
+	}
+	return nil
+}
+
+func (x *LandClaim) GetPaidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaidUntil
+	}
+	return nil
+}
+
+// What claims cost; both costs are paid in the currency item
+type ClaimTerms struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	CurrencyItemId      int32                  `protobuf:"varint,1,opt,name=currency_item_id,json=currencyItemId,proto3" json:"currency_item_id,omitempty"`
+	ClaimCost           int32                  `protobuf:"varint,2,opt,name=claim_cost,json=claimCost,proto3" json:"claim_cost,omitempty"`
+	UpkeepCost          int32                  `protobuf:"varint,3,opt,name=upkeep_cost,json=upkeepCost,proto3" json:"upkeep_cost,omitempty"`
+	UpkeepPeriodSeconds int64                  `protobuf:"varint,4,opt,name=upkeep_period_seconds,json=upkeepPeriodSeconds,proto3" json:"upkeep_period_seconds,omitempty"`
+	MaxClaims           int32                  `protobuf:"varint,5,opt,name=max_claims,json=maxClaims,proto3" json:"max_claims,omitempty"` // Per character
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ClaimTerms) Reset() {
+	*x = ClaimTerms{}
+	mi := &file_land_claim_v1_land_claim_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimTerms) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimTerms) ProtoMessage() {}
+
+func (x *ClaimTerms) ProtoReflect() protoreflect.Message {
+	mi := &file_land_claim_v1_land_claim_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimTerms.ProtoReflect.Descriptor instead.
+func (*ClaimTerms) Descriptor() ([]byte, []int) {
+	return file_land_claim_v1_land_claim_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ClaimTerms) GetCurrencyItemId() int32 {
+	if x != nil {
+		return x.CurrencyItemId
+	}
+	return 0
+}
+
+func (x *ClaimTerms) GetClaimCost() int32 {
+	if x != nil {
+		return x.ClaimCost
+	}
+	return 0
+}
+
+func (x *ClaimTerms) GetUpkeepCost() int32 {
+	if x != nil {
+		return x.UpkeepCost
+	}
+	return 0
+}
+
+func (x *ClaimTerms) GetUpkeepPeriodSeconds() int64 {
+	if x != nil {
+		return x.UpkeepPeriodSeconds
+	}
+	return 0
+}
+
+func (x *ClaimTerms) GetMaxClaims() int32 {
+	if x != nil {
+		return x.MaxClaims
+	}
+	return 0
+}
+
+type ClaimChunkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimChunkRequest) Reset() {
+	*x = ClaimChunkRequest{}
+	mi := &file_land_claim_v1_land_claim_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimChunkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimChunkRequest) ProtoMessage() {}
+
+func (x *ClaimChunkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_land_claim_v1_land_claim_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimChunkRequest.ProtoReflect.Descriptor instead.
+func (*ClaimChunkRequest) Descriptor() ([]byte, []int) {
+	return file_land_claim_v1_land_claim_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ClaimChunkRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+type ClaimChunkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Claim         *LandClaim             `protobuf:"bytes,1,opt,name=claim,proto3" json:"claim,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimChunkResponse) Reset() {
+	*x = ClaimChunkResponse{}
+	mi := &file_land_claim_v1_land_claim_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimChunkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimChunkResponse) ProtoMessage() {}
+
+func (x *ClaimChunkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_land_claim_v1_land_claim_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimChunkResponse.ProtoReflect.Descriptor instead.
+func (*ClaimChunkResponse) Descriptor() ([]byte, []int) {
+	return file_land_claim_v1_land_claim_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ClaimChunkResponse) GetClaim() *LandClaim {
+	if x != nil {
+		return x.Claim
+	}
+	return nil
+}
+
+type ReleaseClaimRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	ClaimId       int64                  `protobuf:"varint,2,opt,name=claim_id,json=claimId,proto3" json:"claim_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseClaimRequest) Reset() {
+	*x = ReleaseClaimRequest{}
+	mi := &file_land_claim_v1_land_claim_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseClaimRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseClaimRequest) ProtoMessage() {}
+
+func (x *ReleaseClaimRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_land_claim_v1_land_claim_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseClaimRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseClaimRequest) Descriptor() ([]byte, []int) {
+	return file_land_claim_v1_land_claim_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ReleaseClaimRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *ReleaseClaimRequest) GetClaimId() int64 {
+	if x != nil {
+		return x.ClaimId
+	}
+	return 0
+}
+
+type ReleaseClaimResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Claim         *LandClaim             `protobuf:"bytes,1,opt,name=claim,proto3" json:"claim,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseClaimResponse) Reset() {
+	*x = ReleaseClaimResponse{}
+	mi := &file_land_claim_v1_land_claim_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseClaimResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseClaimResponse) ProtoMessage() {}
+
+func (x *ReleaseClaimResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_land_claim_v1_land_claim_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseClaimResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseClaimResponse) Descriptor() ([]byte, []int) {
+	return file_land_claim_v1_land_claim_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReleaseClaimResponse) GetClaim() *LandClaim {
+	if x != nil {
+		return x.Claim
+	}
+	return nil
+}
+
+type ListClaimsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClaimsRequest) Reset() {
+	*x = ListClaimsRequest{}
+	mi := &file_land_claim_v1_land_claim_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClaimsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClaimsRequest) ProtoMessage() {}
+
+func (x *ListClaimsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_land_claim_v1_land_claim_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClaimsRequest.ProtoReflect.Descriptor instead.
+func (*ListClaimsRequest) Descriptor() ([]byte, []int) {
+	return file_land_claim_v1_land_claim_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListClaimsRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+type ListClaimsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Claims        []*LandClaim           `protobuf:"bytes,1,rep,name=claims,proto3" json:"claims,omitempty"`
+	Terms         *ClaimTerms            `protobuf:"bytes,2,opt,name=terms,proto3" json:"terms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClaimsResponse) Reset() {
+	*x = ListClaimsResponse{}
+	mi := &file_land_claim_v1_land_claim_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClaimsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClaimsResponse) ProtoMessage() {}
+
+func (x *ListClaimsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_land_claim_v1_land_claim_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClaimsResponse.ProtoReflect.Descriptor instead.
+func (*ListClaimsResponse) Descriptor() ([]byte, []int) {
+	return file_land_claim_v1_land_claim_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListClaimsResponse) GetClaims() []*LandClaim {
+	if x != nil {
+		return x.Claims
+	}
+	return nil
+}
+
+func (x *ListClaimsResponse) GetTerms() *ClaimTerms {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+type GetClaimsInChunksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorldId       string                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"` // Defaults to the caller's session world
+	MinChunkX     int32                  `protobuf:"varint,2,opt,name=min_chunk_x,json=minChunkX,proto3" json:"min_chunk_x,omitempty"`
+	MaxChunkX     int32                  `protobuf:"varint,3,opt,name=max_chunk_x,json=maxChunkX,proto3" json:"max_chunk_x,omitempty"`
+	MinChunkY     int32                  `protobuf:"varint,4,opt,name=min_chunk_y,json=minChunkY,proto3" json:"min_chunk_y,omitempty"`
+	MaxChunkY     int32                  `protobuf:"varint,5,opt,name=max_chunk_y,json=maxChunkY,proto3" json:"max_chunk_y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClaimsInChunksRequest) Reset() {
+	*x = GetClaimsInChunksRequest{}
+	mi := &file_land_claim_v1_land_claim_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClaimsInChunksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClaimsInChunksRequest) ProtoMessage() {}
+
+func (x *GetClaimsInChunksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_land_claim_v1_land_claim_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClaimsInChunksRequest.ProtoReflect.Descriptor instead.
+func (*GetClaimsInChunksRequest) Descriptor() ([]byte, []int) {
+	return file_land_claim_v1_land_claim_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetClaimsInChunksRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *GetClaimsInChunksRequest) GetMinChunkX() int32 {
+	if x != nil {
+		return x.MinChunkX
+	}
+	return 0
+}
+
+func (x *GetClaimsInChunksRequest) GetMaxChunkX() int32 {
+	if x != nil {
+		return x.MaxChunkX
+	}
+	return 0
+}
+
+func (x *GetClaimsInChunksRequest) GetMinChunkY() int32 {
+	if x != nil {
+		return x.MinChunkY
+	}
+	return 0
+}
+
+func (x *GetClaimsInChunksRequest) GetMaxChunkY() int32 {
+	if x != nil {
+		return x.MaxChunkY
+	}
+	return 0
+}
+
+type GetClaimsInChunksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Claims        []*LandClaim           `protobuf:"bytes,1,rep,name=claims,proto3" json:"claims,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClaimsInChunksResponse) Reset() {
+	*x = GetClaimsInChunksResponse{}
+	mi := &file_land_claim_v1_land_claim_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClaimsInChunksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClaimsInChunksResponse) ProtoMessage() {}
+
+func (x *GetClaimsInChunksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_land_claim_v1_land_claim_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClaimsInChunksResponse.ProtoReflect.Descriptor instead.
+func (*GetClaimsInChunksResponse) Descriptor() ([]byte, []int) {
+	return file_land_claim_v1_land_claim_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetClaimsInChunksResponse) GetClaims() []*LandClaim {
+	if x != nil {
+		return x.Claims
+	}
+	return nil
+}
+
+var File_land_claim_v1_land_claim_proto protoreflect.FileDescriptor
+
+const file_land_claim_v1_land_claim_proto_rawDesc = "" +
+	"\n" +
+	"\x1eland_claim/v1/land_claim.proto\x12\rland_claim.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x81\x02\n" +
+	"\tLandClaim\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\bworld_id\x18\x02 \x01(\tR\aworldId\x12\x17\n" +
+	"\achunk_x\x18\x03 \x01(\x05R\x06chunkX\x12\x17\n" +
+	"\achunk_y\x18\x04 \x01(\x05R\x06chunkY\x12!\n" +
+	"\fcharacter_id\x18\x05 \x01(\tR\vcharacterId\x129\n" +
+	"\n" +
+	"claimed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tclaimedAt\x129\n" +
+	"\n" +
+	"paid_until\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tpaidUntil\"\xc9\x01\n" +
+	"\n" +
+	"ClaimTerms\x12(\n" +
+	"\x10currency_item_id\x18\x01 \x01(\x05R\x0ecurrencyItemId\x12\x1d\n" +
+	"\n" +
+	"claim_cost\x18\x02 \x01(\x05R\tclaimCost\x12\x1f\n" +
+	"\vupkeep_cost\x18\x03 \x01(\x05R\n" +
+	"upkeepCost\x122\n" +
+	"\x15upkeep_period_seconds\x18\x04 \x01(\x03R\x13upkeepPeriodSeconds\x12\x1d\n" +
+	"\n" +
+	"max_claims\x18\x05 \x01(\x05R\tmaxClaims\"6\n" +
+	"\x11ClaimChunkRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\"D\n" +
+	"\x12ClaimChunkResponse\x12.\n" +
+	"\x05claim\x18\x01 \x01(\v2\x18.land_claim.v1.LandClaimR\x05claim\"S\n" +
+	"\x13ReleaseClaimRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x19\n" +
+	"\bclaim_id\x18\x02 \x01(\x03R\aclaimId\"F\n" +
+	"\x14ReleaseClaimResponse\x12.\n" +
+	"\x05claim\x18\x01 \x01(\v2\x18.land_claim.v1.LandClaimR\x05claim\"6\n" +
+	"\x11ListClaimsRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\"w\n" +
+	"\x12ListClaimsResponse\x120\n" +
+	"\x06claims\x18\x01 \x03(\v2\x18.land_claim.v1.LandClaimR\x06claims\x12/\n" +
+	"\x05terms\x18\x02 \x01(\v2\x19.land_claim.v1.ClaimTermsR\x05terms\"\xb5\x01\n" +
+	"\x18GetClaimsInChunksRequest\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\tR\aworldId\x12\x1e\n" +
+	"\vmin_chunk_x\x18\x02 \x01(\x05R\tminChunkX\x12\x1e\n" +
+	"\vmax_chunk_x\x18\x03 \x01(\x05R\tmaxChunkX\x12\x1e\n" +
+	"\vmin_chunk_y\x18\x04 \x01(\x05R\tminChunkY\x12\x1e\n" +
+	"\vmax_chunk_y\x18\x05 \x01(\x05R\tmaxChunkY\"M\n" +
+	"\x19GetClaimsInChunksResponse\x120\n" +
+	"\x06claims\x18\x01 \x03(\v2\x18.land_claim.v1.LandClaimR\x06claims2\x81\x03\n" +
+	"\x10LandClaimService\x12S\n" +
+	"\n" +
+	"ClaimChunk\x12 .land_claim.v1.ClaimChunkRequest\x1a!.land_claim.v1.ClaimChunkResponse\"\x00\x12Y\n" +
+	"\fReleaseClaim\x12\".land_claim.v1.ReleaseClaimRequest\x1a#.land_claim.v1.ReleaseClaimResponse\"\x00\x12S\n" +
+	"\n" +
+	"ListClaims\x12 .land_claim.v1.ListClaimsRequest\x1a!.land_claim.v1.ListClaimsResponse\"\x00\x12h\n" +
+	"\x11GetClaimsInChunks\x12'.land_claim.v1.GetClaimsInChunksRequest\x1a(.land_claim.v1.GetClaimsInChunksResponse\"\x00B1Z/github.com/VoidMesh/api/api/proto/land_claim/v1b\x06proto3"
+
+var (
+	file_land_claim_v1_land_claim_proto_rawDescOnce sync.Once
+	file_land_claim_v1_land_claim_proto_rawDescData []byte
+)
+
+func file_land_claim_v1_land_claim_proto_rawDescGZIP() []byte {
+	file_land_claim_v1_land_claim_proto_rawDescOnce.Do(func() {
+		file_land_claim_v1_land_claim_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_land_claim_v1_land_claim_proto_rawDesc), len(file_land_claim_v1_land_claim_proto_rawDesc)))
+	})
+	return file_land_claim_v1_land_claim_proto_rawDescData
+}
+
+var file_land_claim_v1_land_claim_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_land_claim_v1_land_claim_proto_goTypes = []any{
+	(*LandClaim)(nil),                 // 0: land_claim.v1.LandClaim
+	(*ClaimTerms)(nil),                // 1: land_claim.v1.ClaimTerms
+	(*ClaimChunkRequest)(nil),         // 2: land_claim.v1.ClaimChunkRequest
+	(*ClaimChunkResponse)(nil),        // 3: land_claim.v1.ClaimChunkResponse
+	(*ReleaseClaimRequest)(nil),       // 4: land_claim.v1.ReleaseClaimRequest
+	(*ReleaseClaimResponse)(nil),      // 5: land_claim.v1.ReleaseClaimResponse
+	(*ListClaimsRequest)(nil),         // 6: land_claim.v1.ListClaimsRequest
+	(*ListClaimsResponse)(nil),        // 7: land_claim.v1.ListClaimsResponse
+	(*GetClaimsInChunksRequest)(nil),  // 8: land_claim.v1.GetClaimsInChunksRequest
+	(*GetClaimsInChunksResponse)(nil), // 9: land_claim.v1.GetClaimsInChunksResponse
+	(*timestamppb.Timestamp)(nil),     // 10: google.protobuf.Timestamp
+}
+var file_land_claim_v1_land_claim_proto_depIdxs = []int32{
+	10, // 0: land_claim.v1.LandClaim.claimed_at:type_name -> google.protobuf.Timestamp
+	10, // 1: land_claim.v1.LandClaim.paid_until:type_name -> google.protobuf.Timestamp
+	0,  // 2: land_claim.v1.ClaimChunkResponse.claim:type_name -> land_claim.v1.LandClaim
+	0,  // 3: land_claim.v1.ReleaseClaimResponse.claim:type_name -> land_claim.v1.LandClaim
+	0,  // 4: land_claim.v1.ListClaimsResponse.claims:type_name -> land_claim.v1.LandClaim
+	1,  // 5: land_claim.v1.ListClaimsResponse.terms:type_name -> land_claim.v1.ClaimTerms
+	0,  // 6: land_claim.v1.GetClaimsInChunksResponse.claims:type_name -> land_claim.v1.LandClaim
+	2,  // 7: land_claim.v1.LandClaimService.ClaimChunk:input_type -> land_claim.v1.ClaimChunkRequest
+	4,  // 8: land_claim.v1.LandClaimService.ReleaseClaim:input_type -> land_claim.v1.ReleaseClaimRequest
+	6,  // 9: land_claim.v1.LandClaimService.ListClaims:input_type -> land_claim.v1.ListClaimsRequest
+	8,  // 10: land_claim.v1.LandClaimService.GetClaimsInChunks:input_type -> land_claim.v1.GetClaimsInChunksRequest
+	3,  // 11: land_claim.v1.LandClaimService.ClaimChunk:output_type -> land_claim.v1.ClaimChunkResponse
+	5,  // 12: land_claim.v1.LandClaimService.ReleaseClaim:output_type -> land_claim.v1.ReleaseClaimResponse
+	7,  // 13: land_claim.v1.LandClaimService.ListClaims:output_type -> land_claim.v1.ListClaimsResponse
+	9,  // 14: land_claim.v1.LandClaimService.GetClaimsInChunks:output_type -> land_claim.v1.GetClaimsInChunksResponse
+	11, // [11:15] is the sub-list for method output_type
+	7,  // [7:11] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_land_claim_v1_land_claim_proto_init() }
+func file_land_claim_v1_land_claim_proto_init() {
+	if File_land_claim_v1_land_claim_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_land_claim_v1_land_claim_proto_rawDesc), len(file_land_claim_v1_land_claim_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_land_claim_v1_land_claim_proto_goTypes,
+		DependencyIndexes: file_land_claim_v1_land_claim_proto_depIdxs,
+		MessageInfos:      file_land_claim_v1_land_claim_proto_msgTypes,
+	}.Build()
+	File_land_claim_v1_land_claim_proto = out.File
+	file_land_claim_v1_land_claim_proto_goTypes = nil
+	file_land_claim_v1_land_claim_proto_depIdxs = nil
+}
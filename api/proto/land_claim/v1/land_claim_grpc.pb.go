@@ -0,0 +1,243 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.4
+// source: land_claim/v1/land_claim.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	LandClaimService_ClaimChunk_FullMethodName        = "/land_claim.v1.LandClaimService/ClaimChunk"
+	LandClaimService_ReleaseClaim_FullMethodName      = "/land_claim.v1.LandClaimService/ReleaseClaim"
+	LandClaimService_ListClaims_FullMethodName        = "/land_claim.v1.LandClaimService/ListClaims"
+	LandClaimService_GetClaimsInChunks_FullMethodName = "/land_claim.v1.LandClaimService/GetClaimsInChunks"
+)
+
+// LandClaimServiceClient is the client API for LandClaimService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Characters pay to claim chunks. Only the owner can harvest or modify terrain in a
+// claimed chunk; claims are kept by paying upkeep and released when it cannot be paid.
+type LandClaimServiceClient interface {
+	// Claims the chunk the character is standing in, paying the claim cost from its inventory
+	ClaimChunk(ctx context.Context, in *ClaimChunkRequest, opts ...grpc.CallOption) (*ClaimChunkResponse, error)
+	ReleaseClaim(ctx context.Context, in *ReleaseClaimRequest, opts ...grpc.CallOption) (*ReleaseClaimResponse, error)
+	ListClaims(ctx context.Context, in *ListClaimsRequest, opts ...grpc.CallOption) (*ListClaimsResponse, error)
+	GetClaimsInChunks(ctx context.Context, in *GetClaimsInChunksRequest, opts ...grpc.CallOption) (*GetClaimsInChunksResponse, error)
+}
+
+type landClaimServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLandClaimServiceClient(cc grpc.ClientConnInterface) LandClaimServiceClient {
+	return &landClaimServiceClient{cc}
+}
+
+func (c *landClaimServiceClient) ClaimChunk(ctx context.Context, in *ClaimChunkRequest, opts ...grpc.CallOption) (*ClaimChunkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaimChunkResponse)
+	err := c.cc.Invoke(ctx, LandClaimService_ClaimChunk_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *landClaimServiceClient) ReleaseClaim(ctx context.Context, in *ReleaseClaimRequest, opts ...grpc.CallOption) (*ReleaseClaimResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseClaimResponse)
+	err := c.cc.Invoke(ctx, LandClaimService_ReleaseClaim_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *landClaimServiceClient) ListClaims(ctx context.Context, in *ListClaimsRequest, opts ...grpc.CallOption) (*ListClaimsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListClaimsResponse)
+	err := c.cc.Invoke(ctx, LandClaimService_ListClaims_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *landClaimServiceClient) GetClaimsInChunks(ctx context.Context, in *GetClaimsInChunksRequest, opts ...grpc.CallOption) (*GetClaimsInChunksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetClaimsInChunksResponse)
+	err := c.cc.Invoke(ctx, LandClaimService_GetClaimsInChunks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LandClaimServiceServer is the server API for LandClaimService service.
+// All implementations must embed UnimplementedLandClaimServiceServer
+// for forward compatibility.
+//
+// Characters pay to claim chunks. Only the owner can harvest or modify terrain in a
+// claimed chunk; claims are kept by paying upkeep and released when it cannot be paid.
+type LandClaimServiceServer interface {
+	// Claims the chunk the character is standing in, paying the claim cost from its inventory
+	ClaimChunk(context.Context, *ClaimChunkRequest) (*ClaimChunkResponse, error)
+	ReleaseClaim(context.Context, *ReleaseClaimRequest) (*ReleaseClaimResponse, error)
+	ListClaims(context.Context, *ListClaimsRequest) (*ListClaimsResponse, error)
+	GetClaimsInChunks(context.Context, *GetClaimsInChunksRequest) (*GetClaimsInChunksResponse, error)
+	mustEmbedUnimplementedLandClaimServiceServer()
+}
+
+// UnimplementedLandClaimServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLandClaimServiceServer struct{}
+
+func (UnimplementedLandClaimServiceServer) ClaimChunk(context.Context, *ClaimChunkRequest) (*ClaimChunkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimChunk not implemented")
+}
+func (UnimplementedLandClaimServiceServer) ReleaseClaim(context.Context, *ReleaseClaimRequest) (*ReleaseClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseClaim not implemented")
+}
+func (UnimplementedLandClaimServiceServer) ListClaims(context.Context, *ListClaimsRequest) (*ListClaimsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClaims not implemented")
+}
+func (UnimplementedLandClaimServiceServer) GetClaimsInChunks(context.Context, *GetClaimsInChunksRequest) (*GetClaimsInChunksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClaimsInChunks not implemented")
+}
+func (UnimplementedLandClaimServiceServer) mustEmbedUnimplementedLandClaimServiceServer() {}
+func (UnimplementedLandClaimServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeLandClaimServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LandClaimServiceServer will
+// result in compilation errors.
+type UnsafeLandClaimServiceServer interface {
+	mustEmbedUnimplementedLandClaimServiceServer()
+}
+
+func RegisterLandClaimServiceServer(s grpc.ServiceRegistrar, srv LandClaimServiceServer) {
+	// If the following call pancis, it indicates UnimplementedLandClaimServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LandClaimService_ServiceDesc, srv)
+}
+
+func _LandClaimService_ClaimChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimChunkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LandClaimServiceServer).ClaimChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LandClaimService_ClaimChunk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LandClaimServiceServer).ClaimChunk(ctx, req.(*ClaimChunkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LandClaimService_ReleaseClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseClaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LandClaimServiceServer).ReleaseClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LandClaimService_ReleaseClaim_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LandClaimServiceServer).ReleaseClaim(ctx, req.(*ReleaseClaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LandClaimService_ListClaims_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClaimsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LandClaimServiceServer).ListClaims(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LandClaimService_ListClaims_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LandClaimServiceServer).ListClaims(ctx, req.(*ListClaimsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LandClaimService_GetClaimsInChunks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClaimsInChunksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LandClaimServiceServer).GetClaimsInChunks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LandClaimService_GetClaimsInChunks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LandClaimServiceServer).GetClaimsInChunks(ctx, req.(*GetClaimsInChunksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LandClaimService_ServiceDesc is the grpc.ServiceDesc for LandClaimService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LandClaimService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "land_claim.v1.LandClaimService",
+	HandlerType: (*LandClaimServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ClaimChunk",
+			Handler:    _LandClaimService_ClaimChunk_Handler,
+		},
+		{
+			MethodName: "ReleaseClaim",
+			Handler:    _LandClaimService_ReleaseClaim_Handler,
+		},
+		{
+			MethodName: "ListClaims",
+			Handler:    _LandClaimService_ListClaims_Handler,
+		},
+		{
+			MethodName: "GetClaimsInChunks",
+			Handler:    _LandClaimService_GetClaimsInChunks_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "land_claim/v1/land_claim.proto",
+}
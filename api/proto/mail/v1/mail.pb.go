@@ -0,0 +1,736 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.4
+// source: mail/v1/mail.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MailAttachment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        int32                  `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	ItemName      string                 `protobuf:"bytes,2,opt,name=item_name,json=itemName,proto3" json:"item_name,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MailAttachment) Reset() {
+	*x = MailAttachment{}
+	mi := &file_mail_v1_mail_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MailAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MailAttachment) ProtoMessage() {}
+
+func (x *MailAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_mail_v1_mail_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MailAttachment.ProtoReflect.Descriptor instead.
+func (*MailAttachment) Descriptor() ([]byte, []int) {
+	return file_mail_v1_mail_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MailAttachment) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *MailAttachment) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *MailAttachment) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type Mail struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SenderCharacterId string                 `protobuf:"bytes,2,opt,name=sender_character_id,json=senderCharacterId,proto3" json:"sender_character_id,omitempty"` // Empty for system mail
+	SenderName        string                 `protobuf:"bytes,3,opt,name=sender_name,json=senderName,proto3" json:"sender_name,omitempty"`
+	Subject           string                 `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	Body              string                 `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	Attachments       []*MailAttachment      `protobuf:"bytes,6,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	Currency          int32                  `protobuf:"varint,7,opt,name=currency,proto3" json:"currency,omitempty"` // Amount of the currency item
+	Returned          bool                   `protobuf:"varint,8,opt,name=returned,proto3" json:"returned,omitempty"` // Attachments the recipient sent that were never claimed
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt         *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	ClaimedAt         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=claimed_at,json=claimedAt,proto3" json:"claimed_at,omitempty"` // Set once the attachments are in the recipient's inventory
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Mail) Reset() {
+	*x = Mail{}
+	mi := &file_mail_v1_mail_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Mail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mail) ProtoMessage() {}
+
+func (x *Mail) ProtoReflect() protoreflect.Message {
+	mi := &file_mail_v1_mail_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mail.ProtoReflect.Descriptor instead.
+func (*Mail) Descriptor() ([]byte, []int) {
+	return file_mail_v1_mail_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Mail) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Mail) GetSenderCharacterId() string {
+	if x != nil {
+		return x.SenderCharacterId
+	}
+	return ""
+}
+
+func (x *Mail) GetSenderName() string {
+	if x != nil {
+		return x.SenderName
+	}
+	return ""
+}
+
+func (x *Mail) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *Mail) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Mail) GetAttachments() []*MailAttachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *Mail) GetCurrency() int32 {
+	if x != nil {
+		return x.Currency
+	}
+	return 0
+}
+
+func (x *Mail) GetReturned() bool {
+	if x != nil {
+		return x.Returned
+	}
+	return false
+}
+
+func (x *Mail) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Mail) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Mail) GetClaimedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClaimedAt
+	}
+	return nil
+}
+
+type SendMailRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId          string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"` // Sender
+	RecipientCharacterId string                 `protobuf:"bytes,2,opt,name=recipient_character_id,json=recipientCharacterId,proto3" json:"recipient_character_id,omitempty"`
+	Subject              string                 `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	Body                 string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	Attachments          []*MailAttachment      `protobuf:"bytes,5,rep,name=attachments,proto3" json:"attachments,omitempty"` // item_id and quantity; item_name is ignored
+	Currency             int32                  `protobuf:"varint,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *SendMailRequest) Reset() {
+	*x = SendMailRequest{}
+	mi := &file_mail_v1_mail_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendMailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMailRequest) ProtoMessage() {}
+
+func (x *SendMailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mail_v1_mail_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMailRequest.ProtoReflect.Descriptor instead.
+func (*SendMailRequest) Descriptor() ([]byte, []int) {
+	return file_mail_v1_mail_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SendMailRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *SendMailRequest) GetRecipientCharacterId() string {
+	if x != nil {
+		return x.RecipientCharacterId
+	}
+	return ""
+}
+
+func (x *SendMailRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *SendMailRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *SendMailRequest) GetAttachments() []*MailAttachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *SendMailRequest) GetCurrency() int32 {
+	if x != nil {
+		return x.Currency
+	}
+	return 0
+}
+
+type SendMailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mail          *Mail                  `protobuf:"bytes,1,opt,name=mail,proto3" json:"mail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendMailResponse) Reset() {
+	*x = SendMailResponse{}
+	mi := &file_mail_v1_mail_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendMailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMailResponse) ProtoMessage() {}
+
+func (x *SendMailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mail_v1_mail_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMailResponse.ProtoReflect.Descriptor instead.
+func (*SendMailResponse) Descriptor() ([]byte, []int) {
+	return file_mail_v1_mail_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SendMailResponse) GetMail() *Mail {
+	if x != nil {
+		return x.Mail
+	}
+	return nil
+}
+
+type ListMailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMailRequest) Reset() {
+	*x = ListMailRequest{}
+	mi := &file_mail_v1_mail_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMailRequest) ProtoMessage() {}
+
+func (x *ListMailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mail_v1_mail_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMailRequest.ProtoReflect.Descriptor instead.
+func (*ListMailRequest) Descriptor() ([]byte, []int) {
+	return file_mail_v1_mail_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListMailRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+type ListMailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mail          []*Mail                `protobuf:"bytes,1,rep,name=mail,proto3" json:"mail,omitempty"` // Newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMailResponse) Reset() {
+	*x = ListMailResponse{}
+	mi := &file_mail_v1_mail_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMailResponse) ProtoMessage() {}
+
+func (x *ListMailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mail_v1_mail_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMailResponse.ProtoReflect.Descriptor instead.
+func (*ListMailResponse) Descriptor() ([]byte, []int) {
+	return file_mail_v1_mail_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListMailResponse) GetMail() []*Mail {
+	if x != nil {
+		return x.Mail
+	}
+	return nil
+}
+
+type ClaimAttachmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	MailId        int64                  `protobuf:"varint,2,opt,name=mail_id,json=mailId,proto3" json:"mail_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimAttachmentsRequest) Reset() {
+	*x = ClaimAttachmentsRequest{}
+	mi := &file_mail_v1_mail_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimAttachmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimAttachmentsRequest) ProtoMessage() {}
+
+func (x *ClaimAttachmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mail_v1_mail_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*ClaimAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return file_mail_v1_mail_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ClaimAttachmentsRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *ClaimAttachmentsRequest) GetMailId() int64 {
+	if x != nil {
+		return x.MailId
+	}
+	return 0
+}
+
+type ClaimAttachmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mail          *Mail                  `protobuf:"bytes,1,opt,name=mail,proto3" json:"mail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimAttachmentsResponse) Reset() {
+	*x = ClaimAttachmentsResponse{}
+	mi := &file_mail_v1_mail_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimAttachmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimAttachmentsResponse) ProtoMessage() {}
+
+func (x *ClaimAttachmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mail_v1_mail_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimAttachmentsResponse.ProtoReflect.Descriptor instead.
+func (*ClaimAttachmentsResponse) Descriptor() ([]byte, []int) {
+	return file_mail_v1_mail_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ClaimAttachmentsResponse) GetMail() *Mail {
+	if x != nil {
+		return x.Mail
+	}
+	return nil
+}
+
+type DeleteMailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	MailId        int64                  `protobuf:"varint,2,opt,name=mail_id,json=mailId,proto3" json:"mail_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMailRequest) Reset() {
+	*x = DeleteMailRequest{}
+	mi := &file_mail_v1_mail_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMailRequest) ProtoMessage() {}
+
+func (x *DeleteMailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mail_v1_mail_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMailRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMailRequest) Descriptor() ([]byte, []int) {
+	return file_mail_v1_mail_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteMailRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *DeleteMailRequest) GetMailId() int64 {
+	if x != nil {
+		return x.MailId
+	}
+	return 0
+}
+
+type DeleteMailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMailResponse) Reset() {
+	*x = DeleteMailResponse{}
+	mi := &file_mail_v1_mail_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMailResponse) ProtoMessage() {}
+
+func (x *DeleteMailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mail_v1_mail_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMailResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMailResponse) Descriptor() ([]byte, []int) {
+	return file_mail_v1_mail_proto_rawDescGZIP(), []int{9}
+}
+
+var File_mail_v1_mail_proto protoreflect.FileDescriptor
+
+const file_mail_v1_mail_proto_rawDesc = "" +
+	"\n" +
+	"\x12mail/v1/mail.proto\x12\amail.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"b\n" +
+	"\x0eMailAttachment\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\x05R\x06itemId\x12\x1b\n" +
+	"\titem_name\x18\x02 \x01(\tR\bitemName\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\"\xb9\x03\n" +
+	"\x04Mail\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12.\n" +
+	"\x13sender_character_id\x18\x02 \x01(\tR\x11senderCharacterId\x12\x1f\n" +
+	"\vsender_name\x18\x03 \x01(\tR\n" +
+	"senderName\x12\x18\n" +
+	"\asubject\x18\x04 \x01(\tR\asubject\x12\x12\n" +
+	"\x04body\x18\x05 \x01(\tR\x04body\x129\n" +
+	"\vattachments\x18\x06 \x03(\v2\x17.mail.v1.MailAttachmentR\vattachments\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\x05R\bcurrency\x12\x1a\n" +
+	"\breturned\x18\b \x01(\bR\breturned\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"claimed_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tclaimedAt\"\xef\x01\n" +
+	"\x0fSendMailRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x124\n" +
+	"\x16recipient_character_id\x18\x02 \x01(\tR\x14recipientCharacterId\x12\x18\n" +
+	"\asubject\x18\x03 \x01(\tR\asubject\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\x129\n" +
+	"\vattachments\x18\x05 \x03(\v2\x17.mail.v1.MailAttachmentR\vattachments\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\x05R\bcurrency\"5\n" +
+	"\x10SendMailResponse\x12!\n" +
+	"\x04mail\x18\x01 \x01(\v2\r.mail.v1.MailR\x04mail\"4\n" +
+	"\x0fListMailRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\"5\n" +
+	"\x10ListMailResponse\x12!\n" +
+	"\x04mail\x18\x01 \x03(\v2\r.mail.v1.MailR\x04mail\"U\n" +
+	"\x17ClaimAttachmentsRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x17\n" +
+	"\amail_id\x18\x02 \x01(\x03R\x06mailId\"=\n" +
+	"\x18ClaimAttachmentsResponse\x12!\n" +
+	"\x04mail\x18\x01 \x01(\v2\r.mail.v1.MailR\x04mail\"O\n" +
+	"\x11DeleteMailRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x17\n" +
+	"\amail_id\x18\x02 \x01(\x03R\x06mailId\"\x14\n" +
+	"\x12DeleteMailResponse2\xb7\x02\n" +
+	"\vMailService\x12A\n" +
+	"\bSendMail\x12\x18.mail.v1.SendMailRequest\x1a\x19.mail.v1.SendMailResponse\"\x00\x12A\n" +
+	"\bListMail\x12\x18.mail.v1.ListMailRequest\x1a\x19.mail.v1.ListMailResponse\"\x00\x12Y\n" +
+	"\x10ClaimAttachments\x12 .mail.v1.ClaimAttachmentsRequest\x1a!.mail.v1.ClaimAttachmentsResponse\"\x00\x12G\n" +
+	"\n" +
+	"DeleteMail\x12\x1a.mail.v1.DeleteMailRequest\x1a\x1b.mail.v1.DeleteMailResponse\"\x00B+Z)github.com/VoidMesh/api/api/proto/mail/v1b\x06proto3"
+
+var (
+	file_mail_v1_mail_proto_rawDescOnce sync.Once
+	file_mail_v1_mail_proto_rawDescData []byte
+)
+
+func file_mail_v1_mail_proto_rawDescGZIP() []byte {
+	file_mail_v1_mail_proto_rawDescOnce.Do(func() {
+		file_mail_v1_mail_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_mail_v1_mail_proto_rawDesc), len(file_mail_v1_mail_proto_rawDesc)))
+	})
+	return file_mail_v1_mail_proto_rawDescData
+}
+
+var file_mail_v1_mail_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_mail_v1_mail_proto_goTypes = []any{
+	(*MailAttachment)(nil),           // 0: mail.v1.MailAttachment
+	(*Mail)(nil),                     // 1: mail.v1.Mail
+	(*SendMailRequest)(nil),          // 2: mail.v1.SendMailRequest
+	(*SendMailResponse)(nil),         // 3: mail.v1.SendMailResponse
+	(*ListMailRequest)(nil),          // 4: mail.v1.ListMailRequest
+	(*ListMailResponse)(nil),         // 5: mail.v1.ListMailResponse
+	(*ClaimAttachmentsRequest)(nil),  // 6: mail.v1.ClaimAttachmentsRequest
+	(*ClaimAttachmentsResponse)(nil), // 7: mail.v1.ClaimAttachmentsResponse
+	(*DeleteMailRequest)(nil),        // 8: mail.v1.DeleteMailRequest
+	(*DeleteMailResponse)(nil),       // 9: mail.v1.DeleteMailResponse
+	(*timestamppb.Timestamp)(nil),    // 10: google.protobuf.Timestamp
+}
+var file_mail_v1_mail_proto_depIdxs = []int32{
+	0,  // 0: mail.v1.Mail.attachments:type_name -> mail.v1.MailAttachment
+	10, // 1: mail.v1.Mail.created_at:type_name -> google.protobuf.Timestamp
+	10, // 2: mail.v1.Mail.expires_at:type_name -> google.protobuf.Timestamp
+	10, // 3: mail.v1.Mail.claimed_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: mail.v1.SendMailRequest.attachments:type_name -> mail.v1.MailAttachment
+	1,  // 5: mail.v1.SendMailResponse.mail:type_name -> mail.v1.Mail
+	1,  // 6: mail.v1.ListMailResponse.mail:type_name -> mail.v1.Mail
+	1,  // 7: mail.v1.ClaimAttachmentsResponse.mail:type_name -> mail.v1.Mail
+	2,  // 8: mail.v1.MailService.SendMail:input_type -> mail.v1.SendMailRequest
+	4,  // 9: mail.v1.MailService.ListMail:input_type -> mail.v1.ListMailRequest
+	6,  // 10: mail.v1.MailService.ClaimAttachments:input_type -> mail.v1.ClaimAttachmentsRequest
+	8,  // 11: mail.v1.MailService.DeleteMail:input_type -> mail.v1.DeleteMailRequest
+	3,  // 12: mail.v1.MailService.SendMail:output_type -> mail.v1.SendMailResponse
+	5,  // 13: mail.v1.MailService.ListMail:output_type -> mail.v1.ListMailResponse
+	7,  // 14: mail.v1.MailService.ClaimAttachments:output_type -> mail.v1.ClaimAttachmentsResponse
+	9,  // 15: mail.v1.MailService.DeleteMail:output_type -> mail.v1.DeleteMailResponse
+	12, // [12:16] is the sub-list for method output_type
+	8,  // [8:12] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_mail_v1_mail_proto_init() }
+func file_mail_v1_mail_proto_init() {
+	if File_mail_v1_mail_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mail_v1_mail_proto_rawDesc), len(file_mail_v1_mail_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_mail_v1_mail_proto_goTypes,
+		DependencyIndexes: file_mail_v1_mail_proto_depIdxs,
+		MessageInfos:      file_mail_v1_mail_proto_msgTypes,
+	}.Build()
+	File_mail_v1_mail_proto = out.File
+	file_mail_v1_mail_proto_goTypes = nil
+	file_mail_v1_mail_proto_depIdxs = nil
+}
@@ -0,0 +1,251 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.4
+// source: mail/v1/mail.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	MailService_SendMail_FullMethodName         = "/mail.v1.MailService/SendMail"
+	MailService_ListMail_FullMethodName         = "/mail.v1.MailService/ListMail"
+	MailService_ClaimAttachments_FullMethodName = "/mail.v1.MailService/ClaimAttachments"
+	MailService_DeleteMail_FullMethodName       = "/mail.v1.MailService/DeleteMail"
+)
+
+// MailServiceClient is the client API for MailService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Mail delivers items and currency to characters while they are offline. Attachments
+// are taken from the sender when mail is sent and held until the recipient claims them;
+// unclaimed attachments go back to the sender when the mail expires.
+type MailServiceClient interface {
+	// Sends mail from one of the caller's characters to another character in the same world
+	SendMail(ctx context.Context, in *SendMailRequest, opts ...grpc.CallOption) (*SendMailResponse, error)
+	ListMail(ctx context.Context, in *ListMailRequest, opts ...grpc.CallOption) (*ListMailResponse, error)
+	// Moves every attachment of a mail into the character's inventory, or nothing if they
+	// do not all fit
+	ClaimAttachments(ctx context.Context, in *ClaimAttachmentsRequest, opts ...grpc.CallOption) (*ClaimAttachmentsResponse, error)
+	// Deletes mail whose attachments were claimed, or that had none
+	DeleteMail(ctx context.Context, in *DeleteMailRequest, opts ...grpc.CallOption) (*DeleteMailResponse, error)
+}
+
+type mailServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMailServiceClient(cc grpc.ClientConnInterface) MailServiceClient {
+	return &mailServiceClient{cc}
+}
+
+func (c *mailServiceClient) SendMail(ctx context.Context, in *SendMailRequest, opts ...grpc.CallOption) (*SendMailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendMailResponse)
+	err := c.cc.Invoke(ctx, MailService_SendMail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mailServiceClient) ListMail(ctx context.Context, in *ListMailRequest, opts ...grpc.CallOption) (*ListMailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMailResponse)
+	err := c.cc.Invoke(ctx, MailService_ListMail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mailServiceClient) ClaimAttachments(ctx context.Context, in *ClaimAttachmentsRequest, opts ...grpc.CallOption) (*ClaimAttachmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaimAttachmentsResponse)
+	err := c.cc.Invoke(ctx, MailService_ClaimAttachments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mailServiceClient) DeleteMail(ctx context.Context, in *DeleteMailRequest, opts ...grpc.CallOption) (*DeleteMailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMailResponse)
+	err := c.cc.Invoke(ctx, MailService_DeleteMail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MailServiceServer is the server API for MailService service.
+// All implementations must embed UnimplementedMailServiceServer
+// for forward compatibility.
+//
+// Mail delivers items and currency to characters while they are offline. Attachments
+// are taken from the sender when mail is sent and held until the recipient claims them;
+// unclaimed attachments go back to the sender when the mail expires.
+type MailServiceServer interface {
+	// Sends mail from one of the caller's characters to another character in the same world
+	SendMail(context.Context, *SendMailRequest) (*SendMailResponse, error)
+	ListMail(context.Context, *ListMailRequest) (*ListMailResponse, error)
+	// Moves every attachment of a mail into the character's inventory, or nothing if they
+	// do not all fit
+	ClaimAttachments(context.Context, *ClaimAttachmentsRequest) (*ClaimAttachmentsResponse, error)
+	// Deletes mail whose attachments were claimed, or that had none
+	DeleteMail(context.Context, *DeleteMailRequest) (*DeleteMailResponse, error)
+	mustEmbedUnimplementedMailServiceServer()
+}
+
+// UnimplementedMailServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMailServiceServer struct{}
+
+func (UnimplementedMailServiceServer) SendMail(context.Context, *SendMailRequest) (*SendMailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendMail not implemented")
+}
+func (UnimplementedMailServiceServer) ListMail(context.Context, *ListMailRequest) (*ListMailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMail not implemented")
+}
+func (UnimplementedMailServiceServer) ClaimAttachments(context.Context, *ClaimAttachmentsRequest) (*ClaimAttachmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimAttachments not implemented")
+}
+func (UnimplementedMailServiceServer) DeleteMail(context.Context, *DeleteMailRequest) (*DeleteMailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMail not implemented")
+}
+func (UnimplementedMailServiceServer) mustEmbedUnimplementedMailServiceServer() {}
+func (UnimplementedMailServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeMailServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MailServiceServer will
+// result in compilation errors.
+type UnsafeMailServiceServer interface {
+	mustEmbedUnimplementedMailServiceServer()
+}
+
+func RegisterMailServiceServer(s grpc.ServiceRegistrar, srv MailServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMailServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MailService_ServiceDesc, srv)
+}
+
+func _MailService_SendMail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendMailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MailServiceServer).SendMail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MailService_SendMail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MailServiceServer).SendMail(ctx, req.(*SendMailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MailService_ListMail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MailServiceServer).ListMail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MailService_ListMail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MailServiceServer).ListMail(ctx, req.(*ListMailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MailService_ClaimAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimAttachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MailServiceServer).ClaimAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MailService_ClaimAttachments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MailServiceServer).ClaimAttachments(ctx, req.(*ClaimAttachmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MailService_DeleteMail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MailServiceServer).DeleteMail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MailService_DeleteMail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MailServiceServer).DeleteMail(ctx, req.(*DeleteMailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MailService_ServiceDesc is the grpc.ServiceDesc for MailService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MailService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "mail.v1.MailService",
+	HandlerType: (*MailServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SendMail",
+			Handler:    _MailService_SendMail_Handler,
+		},
+		{
+			MethodName: "ListMail",
+			Handler:    _MailService_ListMail_Handler,
+		},
+		{
+			MethodName: "ClaimAttachments",
+			Handler:    _MailService_ClaimAttachments_Handler,
+		},
+		{
+			MethodName: "DeleteMail",
+			Handler:    _MailService_DeleteMail_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mail/v1/mail.proto",
+}
@@ -0,0 +1,1003 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.4
+// source: market/v1/market.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Listing struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SellerCharacterId string                 `protobuf:"bytes,2,opt,name=seller_character_id,json=sellerCharacterId,proto3" json:"seller_character_id,omitempty"`
+	ItemId            int32                  `protobuf:"varint,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	ItemName          string                 `protobuf:"bytes,4,opt,name=item_name,json=itemName,proto3" json:"item_name,omitempty"`
+	ItemType          string                 `protobuf:"bytes,5,opt,name=item_type,json=itemType,proto3" json:"item_type,omitempty"`
+	Rarity            string                 `protobuf:"bytes,6,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Quantity          int32                  `protobuf:"varint,7,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice         int32                  `protobuf:"varint,8,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`    // In the currency item
+	TotalPrice        int64                  `protobuf:"varint,9,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"` // unit_price * quantity, the buyout price
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	SaleTax           int64                  `protobuf:"varint,12,opt,name=sale_tax,json=saleTax,proto3" json:"sale_tax,omitempty"` // Withheld from the seller's proceeds on a buyout at the current tax rate
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Listing) Reset() {
+	*x = Listing{}
+	mi := &file_market_v1_market_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Listing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Listing) ProtoMessage() {}
+
+func (x *Listing) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_market_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Listing.ProtoReflect.Descriptor instead.
+func (*Listing) Descriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Listing) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Listing) GetSellerCharacterId() string {
+	if x != nil {
+		return x.SellerCharacterId
+	}
+	return ""
+}
+
+func (x *Listing) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *Listing) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *Listing) GetItemType() string {
+	if x != nil {
+		return x.ItemType
+	}
+	return ""
+}
+
+func (x *Listing) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *Listing) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Listing) GetUnitPrice() int32 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *Listing) GetTotalPrice() int64 {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return 0
+}
+
+func (x *Listing) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Listing) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Listing) GetSaleTax() int64 {
+	if x != nil {
+		return x.SaleTax
+	}
+	return 0
+}
+
+type CreateListingRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId     string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	ItemId          int32                  `protobuf:"varint,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Quantity        int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice       int32                  `protobuf:"varint,4,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	DurationSeconds int64                  `protobuf:"varint,5,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"` // 0 uses the default, larger values are capped
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateListingRequest) Reset() {
+	*x = CreateListingRequest{}
+	mi := &file_market_v1_market_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateListingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateListingRequest) ProtoMessage() {}
+
+func (x *CreateListingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_market_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateListingRequest.ProtoReflect.Descriptor instead.
+func (*CreateListingRequest) Descriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateListingRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *CreateListingRequest) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *CreateListingRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CreateListingRequest) GetUnitPrice() int32 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *CreateListingRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+type CreateListingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Listing       *Listing               `protobuf:"bytes,1,opt,name=listing,proto3" json:"listing,omitempty"`
+	ListingFee    int32                  `protobuf:"varint,2,opt,name=listing_fee,json=listingFee,proto3" json:"listing_fee,omitempty"` // Taken from the seller's currency when listing
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateListingResponse) Reset() {
+	*x = CreateListingResponse{}
+	mi := &file_market_v1_market_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateListingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateListingResponse) ProtoMessage() {}
+
+func (x *CreateListingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_market_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateListingResponse.ProtoReflect.Descriptor instead.
+func (*CreateListingResponse) Descriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateListingResponse) GetListing() *Listing {
+	if x != nil {
+		return x.Listing
+	}
+	return nil
+}
+
+func (x *CreateListingResponse) GetListingFee() int32 {
+	if x != nil {
+		return x.ListingFee
+	}
+	return 0
+}
+
+type ListingFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        int32                  `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"` // 0 matches every item
+	ItemType      string                 `protobuf:"bytes,2,opt,name=item_type,json=itemType,proto3" json:"item_type,omitempty"`
+	NamePrefix    string                 `protobuf:"bytes,3,opt,name=name_prefix,json=namePrefix,proto3" json:"name_prefix,omitempty"`          // Case-insensitive
+	MaxUnitPrice  int32                  `protobuf:"varint,4,opt,name=max_unit_price,json=maxUnitPrice,proto3" json:"max_unit_price,omitempty"` // 0 matches every price
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListingFilter) Reset() {
+	*x = ListingFilter{}
+	mi := &file_market_v1_market_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListingFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListingFilter) ProtoMessage() {}
+
+func (x *ListingFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_market_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListingFilter.ProtoReflect.Descriptor instead.
+func (*ListingFilter) Descriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListingFilter) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *ListingFilter) GetItemType() string {
+	if x != nil {
+		return x.ItemType
+	}
+	return ""
+}
+
+func (x *ListingFilter) GetNamePrefix() string {
+	if x != nil {
+		return x.NamePrefix
+	}
+	return ""
+}
+
+func (x *ListingFilter) GetMaxUnitPrice() int32 {
+	if x != nil {
+		return x.MaxUnitPrice
+	}
+	return 0
+}
+
+type SearchListingsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Filter *ListingFilter         `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Pagination cursor: unit_price and id of the last listing of the previous page
+	AfterUnitPrice int32  `protobuf:"varint,2,opt,name=after_unit_price,json=afterUnitPrice,proto3" json:"after_unit_price,omitempty"`
+	AfterId        int64  `protobuf:"varint,3,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
+	Limit          int32  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                   // 0 uses the default, larger values are capped
+	WorldId        string `protobuf:"bytes,5,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"` // Defaults to the session world
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SearchListingsRequest) Reset() {
+	*x = SearchListingsRequest{}
+	mi := &file_market_v1_market_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchListingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchListingsRequest) ProtoMessage() {}
+
+func (x *SearchListingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_market_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchListingsRequest.ProtoReflect.Descriptor instead.
+func (*SearchListingsRequest) Descriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SearchListingsRequest) GetFilter() *ListingFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *SearchListingsRequest) GetAfterUnitPrice() int32 {
+	if x != nil {
+		return x.AfterUnitPrice
+	}
+	return 0
+}
+
+func (x *SearchListingsRequest) GetAfterId() int64 {
+	if x != nil {
+		return x.AfterId
+	}
+	return 0
+}
+
+func (x *SearchListingsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchListingsRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+type SearchListingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Listings      []*Listing             `protobuf:"bytes,1,rep,name=listings,proto3" json:"listings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchListingsResponse) Reset() {
+	*x = SearchListingsResponse{}
+	mi := &file_market_v1_market_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchListingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchListingsResponse) ProtoMessage() {}
+
+func (x *SearchListingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_market_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchListingsResponse.ProtoReflect.Descriptor instead.
+func (*SearchListingsResponse) Descriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SearchListingsResponse) GetListings() []*Listing {
+	if x != nil {
+		return x.Listings
+	}
+	return nil
+}
+
+type ListMyListingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyListingsRequest) Reset() {
+	*x = ListMyListingsRequest{}
+	mi := &file_market_v1_market_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyListingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyListingsRequest) ProtoMessage() {}
+
+func (x *ListMyListingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_market_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyListingsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyListingsRequest) Descriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListMyListingsRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+type ListMyListingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Listings      []*Listing             `protobuf:"bytes,1,rep,name=listings,proto3" json:"listings,omitempty"` // Newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyListingsResponse) Reset() {
+	*x = ListMyListingsResponse{}
+	mi := &file_market_v1_market_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyListingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyListingsResponse) ProtoMessage() {}
+
+func (x *ListMyListingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_market_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyListingsResponse.ProtoReflect.Descriptor instead.
+func (*ListMyListingsResponse) Descriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListMyListingsResponse) GetListings() []*Listing {
+	if x != nil {
+		return x.Listings
+	}
+	return nil
+}
+
+type BuyListingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"` // Buyer
+	ListingId     int64                  `protobuf:"varint,2,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuyListingRequest) Reset() {
+	*x = BuyListingRequest{}
+	mi := &file_market_v1_market_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuyListingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuyListingRequest) ProtoMessage() {}
+
+func (x *BuyListingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_market_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuyListingRequest.ProtoReflect.Descriptor instead.
+func (*BuyListingRequest) Descriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BuyListingRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *BuyListingRequest) GetListingId() int64 {
+	if x != nil {
+		return x.ListingId
+	}
+	return 0
+}
+
+type BuyListingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Listing       *Listing               `protobuf:"bytes,1,opt,name=listing,proto3" json:"listing,omitempty"` // The listing as it was bought
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuyListingResponse) Reset() {
+	*x = BuyListingResponse{}
+	mi := &file_market_v1_market_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuyListingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuyListingResponse) ProtoMessage() {}
+
+func (x *BuyListingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_market_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuyListingResponse.ProtoReflect.Descriptor instead.
+func (*BuyListingResponse) Descriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BuyListingResponse) GetListing() *Listing {
+	if x != nil {
+		return x.Listing
+	}
+	return nil
+}
+
+type PriceHistoryPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Day           *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"` // Start of the UTC day
+	Sales         int64                  `protobuf:"varint,2,opt,name=sales,proto3" json:"sales,omitempty"`
+	Volume        int64                  `protobuf:"varint,3,opt,name=volume,proto3" json:"volume,omitempty"` // Items sold
+	MinUnitPrice  int32                  `protobuf:"varint,4,opt,name=min_unit_price,json=minUnitPrice,proto3" json:"min_unit_price,omitempty"`
+	MaxUnitPrice  int32                  `protobuf:"varint,5,opt,name=max_unit_price,json=maxUnitPrice,proto3" json:"max_unit_price,omitempty"`
+	AvgUnitPrice  int32                  `protobuf:"varint,6,opt,name=avg_unit_price,json=avgUnitPrice,proto3" json:"avg_unit_price,omitempty"` // Weighted by quantity
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceHistoryPoint) Reset() {
+	*x = PriceHistoryPoint{}
+	mi := &file_market_v1_market_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceHistoryPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceHistoryPoint) ProtoMessage() {}
+
+func (x *PriceHistoryPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_market_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceHistoryPoint.ProtoReflect.Descriptor instead.
+func (*PriceHistoryPoint) Descriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PriceHistoryPoint) GetDay() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Day
+	}
+	return nil
+}
+
+func (x *PriceHistoryPoint) GetSales() int64 {
+	if x != nil {
+		return x.Sales
+	}
+	return 0
+}
+
+func (x *PriceHistoryPoint) GetVolume() int64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *PriceHistoryPoint) GetMinUnitPrice() int32 {
+	if x != nil {
+		return x.MinUnitPrice
+	}
+	return 0
+}
+
+func (x *PriceHistoryPoint) GetMaxUnitPrice() int32 {
+	if x != nil {
+		return x.MaxUnitPrice
+	}
+	return 0
+}
+
+func (x *PriceHistoryPoint) GetAvgUnitPrice() int32 {
+	if x != nil {
+		return x.AvgUnitPrice
+	}
+	return 0
+}
+
+type GetPriceHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        int32                  `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Days          int32                  `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`                     // 0 uses the default, larger values are capped
+	WorldId       string                 `protobuf:"bytes,3,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"` // Defaults to the session world
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceHistoryRequest) Reset() {
+	*x = GetPriceHistoryRequest{}
+	mi := &file_market_v1_market_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceHistoryRequest) ProtoMessage() {}
+
+func (x *GetPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_market_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetPriceHistoryRequest) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *GetPriceHistoryRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *GetPriceHistoryRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+type GetPriceHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Points        []*PriceHistoryPoint   `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"` // Oldest first; days without sales are omitted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceHistoryResponse) Reset() {
+	*x = GetPriceHistoryResponse{}
+	mi := &file_market_v1_market_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceHistoryResponse) ProtoMessage() {}
+
+func (x *GetPriceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_market_v1_market_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_market_v1_market_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetPriceHistoryResponse) GetPoints() []*PriceHistoryPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+var File_market_v1_market_proto protoreflect.FileDescriptor
+
+const file_market_v1_market_proto_rawDesc = "" +
+	"\n" +
+	"\x16market/v1/market.proto\x12\tmarket.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa1\x03\n" +
+	"\aListing\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12.\n" +
+	"\x13seller_character_id\x18\x02 \x01(\tR\x11sellerCharacterId\x12\x17\n" +
+	"\aitem_id\x18\x03 \x01(\x05R\x06itemId\x12\x1b\n" +
+	"\titem_name\x18\x04 \x01(\tR\bitemName\x12\x1b\n" +
+	"\titem_type\x18\x05 \x01(\tR\bitemType\x12\x16\n" +
+	"\x06rarity\x18\x06 \x01(\tR\x06rarity\x12\x1a\n" +
+	"\bquantity\x18\a \x01(\x05R\bquantity\x12\x1d\n" +
+	"\n" +
+	"unit_price\x18\b \x01(\x05R\tunitPrice\x12\x1f\n" +
+	"\vtotal_price\x18\t \x01(\x03R\n" +
+	"totalPrice\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x19\n" +
+	"\bsale_tax\x18\f \x01(\x03R\asaleTax\"\xb8\x01\n" +
+	"\x14CreateListingRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\x05R\x06itemId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x1d\n" +
+	"\n" +
+	"unit_price\x18\x04 \x01(\x05R\tunitPrice\x12)\n" +
+	"\x10duration_seconds\x18\x05 \x01(\x03R\x0fdurationSeconds\"f\n" +
+	"\x15CreateListingResponse\x12,\n" +
+	"\alisting\x18\x01 \x01(\v2\x12.market.v1.ListingR\alisting\x12\x1f\n" +
+	"\vlisting_fee\x18\x02 \x01(\x05R\n" +
+	"listingFee\"\x8c\x01\n" +
+	"\rListingFilter\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\x05R\x06itemId\x12\x1b\n" +
+	"\titem_type\x18\x02 \x01(\tR\bitemType\x12\x1f\n" +
+	"\vname_prefix\x18\x03 \x01(\tR\n" +
+	"namePrefix\x12$\n" +
+	"\x0emax_unit_price\x18\x04 \x01(\x05R\fmaxUnitPrice\"\xbf\x01\n" +
+	"\x15SearchListingsRequest\x120\n" +
+	"\x06filter\x18\x01 \x01(\v2\x18.market.v1.ListingFilterR\x06filter\x12(\n" +
+	"\x10after_unit_price\x18\x02 \x01(\x05R\x0eafterUnitPrice\x12\x19\n" +
+	"\bafter_id\x18\x03 \x01(\x03R\aafterId\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x19\n" +
+	"\bworld_id\x18\x05 \x01(\tR\aworldId\"H\n" +
+	"\x16SearchListingsResponse\x12.\n" +
+	"\blistings\x18\x01 \x03(\v2\x12.market.v1.ListingR\blistings\":\n" +
+	"\x15ListMyListingsRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\"H\n" +
+	"\x16ListMyListingsResponse\x12.\n" +
+	"\blistings\x18\x01 \x03(\v2\x12.market.v1.ListingR\blistings\"U\n" +
+	"\x11BuyListingRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x1d\n" +
+	"\n" +
+	"listing_id\x18\x02 \x01(\x03R\tlistingId\"B\n" +
+	"\x12BuyListingResponse\x12,\n" +
+	"\alisting\x18\x01 \x01(\v2\x12.market.v1.ListingR\alisting\"\xe1\x01\n" +
+	"\x11PriceHistoryPoint\x12,\n" +
+	"\x03day\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x03day\x12\x14\n" +
+	"\x05sales\x18\x02 \x01(\x03R\x05sales\x12\x16\n" +
+	"\x06volume\x18\x03 \x01(\x03R\x06volume\x12$\n" +
+	"\x0emin_unit_price\x18\x04 \x01(\x05R\fminUnitPrice\x12$\n" +
+	"\x0emax_unit_price\x18\x05 \x01(\x05R\fmaxUnitPrice\x12$\n" +
+	"\x0eavg_unit_price\x18\x06 \x01(\x05R\favgUnitPrice\"`\n" +
+	"\x16GetPriceHistoryRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\x05R\x06itemId\x12\x12\n" +
+	"\x04days\x18\x02 \x01(\x05R\x04days\x12\x19\n" +
+	"\bworld_id\x18\x03 \x01(\tR\aworldId\"O\n" +
+	"\x17GetPriceHistoryResponse\x124\n" +
+	"\x06points\x18\x01 \x03(\v2\x1c.market.v1.PriceHistoryPointR\x06points2\xc0\x03\n" +
+	"\rMarketService\x12T\n" +
+	"\rCreateListing\x12\x1f.market.v1.CreateListingRequest\x1a .market.v1.CreateListingResponse\"\x00\x12W\n" +
+	"\x0eSearchListings\x12 .market.v1.SearchListingsRequest\x1a!.market.v1.SearchListingsResponse\"\x00\x12W\n" +
+	"\x0eListMyListings\x12 .market.v1.ListMyListingsRequest\x1a!.market.v1.ListMyListingsResponse\"\x00\x12K\n" +
+	"\n" +
+	"BuyListing\x12\x1c.market.v1.BuyListingRequest\x1a\x1d.market.v1.BuyListingResponse\"\x00\x12Z\n" +
+	"\x0fGetPriceHistory\x12!.market.v1.GetPriceHistoryRequest\x1a\".market.v1.GetPriceHistoryResponse\"\x00B-Z+github.com/VoidMesh/api/api/proto/market/v1b\x06proto3"
+
+var (
+	file_market_v1_market_proto_rawDescOnce sync.Once
+	file_market_v1_market_proto_rawDescData []byte
+)
+
+func file_market_v1_market_proto_rawDescGZIP() []byte {
+	file_market_v1_market_proto_rawDescOnce.Do(func() {
+		file_market_v1_market_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_market_v1_market_proto_rawDesc), len(file_market_v1_market_proto_rawDesc)))
+	})
+	return file_market_v1_market_proto_rawDescData
+}
+
+var file_market_v1_market_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_market_v1_market_proto_goTypes = []any{
+	(*Listing)(nil),                 // 0: market.v1.Listing
+	(*CreateListingRequest)(nil),    // 1: market.v1.CreateListingRequest
+	(*CreateListingResponse)(nil),   // 2: market.v1.CreateListingResponse
+	(*ListingFilter)(nil),           // 3: market.v1.ListingFilter
+	(*SearchListingsRequest)(nil),   // 4: market.v1.SearchListingsRequest
+	(*SearchListingsResponse)(nil),  // 5: market.v1.SearchListingsResponse
+	(*ListMyListingsRequest)(nil),   // 6: market.v1.ListMyListingsRequest
+	(*ListMyListingsResponse)(nil),  // 7: market.v1.ListMyListingsResponse
+	(*BuyListingRequest)(nil),       // 8: market.v1.BuyListingRequest
+	(*BuyListingResponse)(nil),      // 9: market.v1.BuyListingResponse
+	(*PriceHistoryPoint)(nil),       // 10: market.v1.PriceHistoryPoint
+	(*GetPriceHistoryRequest)(nil),  // 11: market.v1.GetPriceHistoryRequest
+	(*GetPriceHistoryResponse)(nil), // 12: market.v1.GetPriceHistoryResponse
+	(*timestamppb.Timestamp)(nil),   // 13: google.protobuf.Timestamp
+}
+var file_market_v1_market_proto_depIdxs = []int32{
+	13, // 0: market.v1.Listing.created_at:type_name -> google.protobuf.Timestamp
+	13, // 1: market.v1.Listing.expires_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: market.v1.CreateListingResponse.listing:type_name -> market.v1.Listing
+	3,  // 3: market.v1.SearchListingsRequest.filter:type_name -> market.v1.ListingFilter
+	0,  // 4: market.v1.SearchListingsResponse.listings:type_name -> market.v1.Listing
+	0,  // 5: market.v1.ListMyListingsResponse.listings:type_name -> market.v1.Listing
+	0,  // 6: market.v1.BuyListingResponse.listing:type_name -> market.v1.Listing
+	13, // 7: market.v1.PriceHistoryPoint.day:type_name -> google.protobuf.Timestamp
+	10, // 8: market.v1.GetPriceHistoryResponse.points:type_name -> market.v1.PriceHistoryPoint
+	1,  // 9: market.v1.MarketService.CreateListing:input_type -> market.v1.CreateListingRequest
+	4,  // 10: market.v1.MarketService.SearchListings:input_type -> market.v1.SearchListingsRequest
+	6,  // 11: market.v1.MarketService.ListMyListings:input_type -> market.v1.ListMyListingsRequest
+	8,  // 12: market.v1.MarketService.BuyListing:input_type -> market.v1.BuyListingRequest
+	11, // 13: market.v1.MarketService.GetPriceHistory:input_type -> market.v1.GetPriceHistoryRequest
+	2,  // 14: market.v1.MarketService.CreateListing:output_type -> market.v1.CreateListingResponse
+	5,  // 15: market.v1.MarketService.SearchListings:output_type -> market.v1.SearchListingsResponse
+	7,  // 16: market.v1.MarketService.ListMyListings:output_type -> market.v1.ListMyListingsResponse
+	9,  // 17: market.v1.MarketService.BuyListing:output_type -> market.v1.BuyListingResponse
+	12, // 18: market.v1.MarketService.GetPriceHistory:output_type -> market.v1.GetPriceHistoryResponse
+	14, // [14:19] is the sub-list for method output_type
+	9,  // [9:14] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_market_v1_market_proto_init() }
+func file_market_v1_market_proto_init() {
+	if File_market_v1_market_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_market_v1_market_proto_rawDesc), len(file_market_v1_market_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_market_v1_market_proto_goTypes,
+		DependencyIndexes: file_market_v1_market_proto_depIdxs,
+		MessageInfos:      file_market_v1_market_proto_msgTypes,
+	}.Build()
+	File_market_v1_market_proto = out.File
+	file_market_v1_market_proto_goTypes = nil
+	file_market_v1_market_proto_depIdxs = nil
+}
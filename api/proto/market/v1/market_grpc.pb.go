@@ -0,0 +1,293 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.4
+// source: market/v1/market.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	MarketService_CreateListing_FullMethodName   = "/market.v1.MarketService/CreateListing"
+	MarketService_SearchListings_FullMethodName  = "/market.v1.MarketService/SearchListings"
+	MarketService_ListMyListings_FullMethodName  = "/market.v1.MarketService/ListMyListings"
+	MarketService_BuyListing_FullMethodName      = "/market.v1.MarketService/BuyListing"
+	MarketService_GetPriceHistory_FullMethodName = "/market.v1.MarketService/GetPriceHistory"
+)
+
+// MarketServiceClient is the client API for MarketService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Market lets characters sell items to anyone in their world. Listed items are taken
+// from the seller when the listing is created; a buyout pays the seller by mail, and
+// listings that expire unsold send the items back by mail.
+type MarketServiceClient interface {
+	// Lists items from one of the caller's characters for sale, taking the listing fee
+	CreateListing(ctx context.Context, in *CreateListingRequest, opts ...grpc.CallOption) (*CreateListingResponse, error)
+	// Open listings in a world, cheapest first
+	SearchListings(ctx context.Context, in *SearchListingsRequest, opts ...grpc.CallOption) (*SearchListingsResponse, error)
+	ListMyListings(ctx context.Context, in *ListMyListingsRequest, opts ...grpc.CallOption) (*ListMyListingsResponse, error)
+	// Buys a whole listing: the price is taken from the buyer's inventory and the items
+	// added to it in one step, or nothing happens. The seller is mailed the price less the
+	// sale tax, which leaves the economy.
+	BuyListing(ctx context.Context, in *BuyListingRequest, opts ...grpc.CallOption) (*BuyListingResponse, error)
+	// Daily sale statistics for an item in a world
+	GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error)
+}
+
+type marketServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMarketServiceClient(cc grpc.ClientConnInterface) MarketServiceClient {
+	return &marketServiceClient{cc}
+}
+
+func (c *marketServiceClient) CreateListing(ctx context.Context, in *CreateListingRequest, opts ...grpc.CallOption) (*CreateListingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateListingResponse)
+	err := c.cc.Invoke(ctx, MarketService_CreateListing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketServiceClient) SearchListings(ctx context.Context, in *SearchListingsRequest, opts ...grpc.CallOption) (*SearchListingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchListingsResponse)
+	err := c.cc.Invoke(ctx, MarketService_SearchListings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketServiceClient) ListMyListings(ctx context.Context, in *ListMyListingsRequest, opts ...grpc.CallOption) (*ListMyListingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMyListingsResponse)
+	err := c.cc.Invoke(ctx, MarketService_ListMyListings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketServiceClient) BuyListing(ctx context.Context, in *BuyListingRequest, opts ...grpc.CallOption) (*BuyListingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BuyListingResponse)
+	err := c.cc.Invoke(ctx, MarketService_BuyListing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketServiceClient) GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, MarketService_GetPriceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MarketServiceServer is the server API for MarketService service.
+// All implementations must embed UnimplementedMarketServiceServer
+// for forward compatibility.
+//
+// Market lets characters sell items to anyone in their world. Listed items are taken
+// from the seller when the listing is created; a buyout pays the seller by mail, and
+// listings that expire unsold send the items back by mail.
+type MarketServiceServer interface {
+	// Lists items from one of the caller's characters for sale, taking the listing fee
+	CreateListing(context.Context, *CreateListingRequest) (*CreateListingResponse, error)
+	// Open listings in a world, cheapest first
+	SearchListings(context.Context, *SearchListingsRequest) (*SearchListingsResponse, error)
+	ListMyListings(context.Context, *ListMyListingsRequest) (*ListMyListingsResponse, error)
+	// Buys a whole listing: the price is taken from the buyer's inventory and the items
+	// added to it in one step, or nothing happens. The seller is mailed the price less the
+	// sale tax, which leaves the economy.
+	BuyListing(context.Context, *BuyListingRequest) (*BuyListingResponse, error)
+	// Daily sale statistics for an item in a world
+	GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error)
+	mustEmbedUnimplementedMarketServiceServer()
+}
+
+// UnimplementedMarketServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMarketServiceServer struct{}
+
+func (UnimplementedMarketServiceServer) CreateListing(context.Context, *CreateListingRequest) (*CreateListingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateListing not implemented")
+}
+func (UnimplementedMarketServiceServer) SearchListings(context.Context, *SearchListingsRequest) (*SearchListingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchListings not implemented")
+}
+func (UnimplementedMarketServiceServer) ListMyListings(context.Context, *ListMyListingsRequest) (*ListMyListingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyListings not implemented")
+}
+func (UnimplementedMarketServiceServer) BuyListing(context.Context, *BuyListingRequest) (*BuyListingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyListing not implemented")
+}
+func (UnimplementedMarketServiceServer) GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceHistory not implemented")
+}
+func (UnimplementedMarketServiceServer) mustEmbedUnimplementedMarketServiceServer() {}
+func (UnimplementedMarketServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeMarketServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MarketServiceServer will
+// result in compilation errors.
+type UnsafeMarketServiceServer interface {
+	mustEmbedUnimplementedMarketServiceServer()
+}
+
+func RegisterMarketServiceServer(s grpc.ServiceRegistrar, srv MarketServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMarketServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MarketService_ServiceDesc, srv)
+}
+
+func _MarketService_CreateListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketServiceServer).CreateListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarketService_CreateListing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketServiceServer).CreateListing(ctx, req.(*CreateListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarketService_SearchListings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchListingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketServiceServer).SearchListings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarketService_SearchListings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketServiceServer).SearchListings(ctx, req.(*SearchListingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarketService_ListMyListings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyListingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketServiceServer).ListMyListings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarketService_ListMyListings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketServiceServer).ListMyListings(ctx, req.(*ListMyListingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarketService_BuyListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuyListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketServiceServer).BuyListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarketService_BuyListing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketServiceServer).BuyListing(ctx, req.(*BuyListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarketService_GetPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketServiceServer).GetPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarketService_GetPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketServiceServer).GetPriceHistory(ctx, req.(*GetPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MarketService_ServiceDesc is the grpc.ServiceDesc for MarketService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MarketService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "market.v1.MarketService",
+	HandlerType: (*MarketServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateListing",
+			Handler:    _MarketService_CreateListing_Handler,
+		},
+		{
+			MethodName: "SearchListings",
+			Handler:    _MarketService_SearchListings_Handler,
+		},
+		{
+			MethodName: "ListMyListings",
+			Handler:    _MarketService_ListMyListings_Handler,
+		},
+		{
+			MethodName: "BuyListing",
+			Handler:    _MarketService_BuyListing_Handler,
+		},
+		{
+			MethodName: "GetPriceHistory",
+			Handler:    _MarketService_GetPriceHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "market/v1/market.proto",
+}
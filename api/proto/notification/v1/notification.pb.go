@@ -0,0 +1,821 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.4
+// source: notification/v1/notification.proto
+
+package v1
+
+import (
+	v1 "github.com/VoidMesh/api/api/proto/stream/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type NotificationType int32
+
+const (
+	NotificationType_NOTIFICATION_TYPE_UNSPECIFIED        NotificationType = 0
+	NotificationType_NOTIFICATION_TYPE_FRIEND_REQUEST     NotificationType = 1
+	NotificationType_NOTIFICATION_TYPE_FRIEND_ACCEPTED    NotificationType = 2
+	NotificationType_NOTIFICATION_TYPE_TRADE_COMPLETED    NotificationType = 3
+	NotificationType_NOTIFICATION_TYPE_QUEST_COMPLETED    NotificationType = 4
+	NotificationType_NOTIFICATION_TYPE_LAND_CLAIM_EXPIRED NotificationType = 5
+	// Maintenance countdown; streamed only, never stored. data holds state (countdown, frozen
+	// or ended) and, while maintenance is on, freeze_at and eta (RFC 3339)
+	NotificationType_NOTIFICATION_TYPE_MAINTENANCE NotificationType = 6
+	// Server announcement; streamed only, the announcement itself is listed by ListAnnouncements.
+	// data holds announcement_id, severity and, if set, expires_at (RFC 3339)
+	NotificationType_NOTIFICATION_TYPE_ANNOUNCEMENT NotificationType = 7
+	// Seasonal event started or ended; streamed only, never stored. data holds event_id,
+	// state (started or ended) and, when started, ends_at (RFC 3339)
+	NotificationType_NOTIFICATION_TYPE_SEASONAL_EVENT NotificationType = 8
+	// A processing job is ready to collect. data holds job_id, character_id and recipe_id
+	NotificationType_NOTIFICATION_TYPE_PROCESSING_COMPLETE NotificationType = 9
+	// An achievement was unlocked and its reward can be claimed. data holds achievement_id and character_id
+	NotificationType_NOTIFICATION_TYPE_ACHIEVEMENT_UNLOCKED NotificationType = 10
+	// The account logged in from a device it had not used before. data holds session_id,
+	// ip_address and user_agent
+	NotificationType_NOTIFICATION_TYPE_NEW_DEVICE_LOGIN NotificationType = 11
+)
+
+// Enum value maps for NotificationType.
+var (
+	NotificationType_name = map[int32]string{
+		0:  "NOTIFICATION_TYPE_UNSPECIFIED",
+		1:  "NOTIFICATION_TYPE_FRIEND_REQUEST",
+		2:  "NOTIFICATION_TYPE_FRIEND_ACCEPTED",
+		3:  "NOTIFICATION_TYPE_TRADE_COMPLETED",
+		4:  "NOTIFICATION_TYPE_QUEST_COMPLETED",
+		5:  "NOTIFICATION_TYPE_LAND_CLAIM_EXPIRED",
+		6:  "NOTIFICATION_TYPE_MAINTENANCE",
+		7:  "NOTIFICATION_TYPE_ANNOUNCEMENT",
+		8:  "NOTIFICATION_TYPE_SEASONAL_EVENT",
+		9:  "NOTIFICATION_TYPE_PROCESSING_COMPLETE",
+		10: "NOTIFICATION_TYPE_ACHIEVEMENT_UNLOCKED",
+		11: "NOTIFICATION_TYPE_NEW_DEVICE_LOGIN",
+	}
+	NotificationType_value = map[string]int32{
+		"NOTIFICATION_TYPE_UNSPECIFIED":          0,
+		"NOTIFICATION_TYPE_FRIEND_REQUEST":       1,
+		"NOTIFICATION_TYPE_FRIEND_ACCEPTED":      2,
+		"NOTIFICATION_TYPE_TRADE_COMPLETED":      3,
+		"NOTIFICATION_TYPE_QUEST_COMPLETED":      4,
+		"NOTIFICATION_TYPE_LAND_CLAIM_EXPIRED":   5,
+		"NOTIFICATION_TYPE_MAINTENANCE":          6,
+		"NOTIFICATION_TYPE_ANNOUNCEMENT":         7,
+		"NOTIFICATION_TYPE_SEASONAL_EVENT":       8,
+		"NOTIFICATION_TYPE_PROCESSING_COMPLETE":  9,
+		"NOTIFICATION_TYPE_ACHIEVEMENT_UNLOCKED": 10,
+		"NOTIFICATION_TYPE_NEW_DEVICE_LOGIN":     11,
+	}
+)
+
+func (x NotificationType) Enum() *NotificationType {
+	p := new(NotificationType)
+	*p = x
+	return p
+}
+
+func (x NotificationType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationType) Descriptor() protoreflect.EnumDescriptor {
+	return file_notification_v1_notification_proto_enumTypes[0].Descriptor()
+}
+
+func (NotificationType) Type() protoreflect.EnumType {
+	return &file_notification_v1_notification_proto_enumTypes[0]
+}
+
+func (x NotificationType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationType.Descriptor instead.
+func (NotificationType) EnumDescriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{0}
+}
+
+type AnnouncementSeverity int32
+
+const (
+	AnnouncementSeverity_ANNOUNCEMENT_SEVERITY_UNSPECIFIED AnnouncementSeverity = 0
+	AnnouncementSeverity_ANNOUNCEMENT_SEVERITY_INFO        AnnouncementSeverity = 1
+	AnnouncementSeverity_ANNOUNCEMENT_SEVERITY_WARNING     AnnouncementSeverity = 2
+	AnnouncementSeverity_ANNOUNCEMENT_SEVERITY_CRITICAL    AnnouncementSeverity = 3
+)
+
+// Enum value maps for AnnouncementSeverity.
+var (
+	AnnouncementSeverity_name = map[int32]string{
+		0: "ANNOUNCEMENT_SEVERITY_UNSPECIFIED",
+		1: "ANNOUNCEMENT_SEVERITY_INFO",
+		2: "ANNOUNCEMENT_SEVERITY_WARNING",
+		3: "ANNOUNCEMENT_SEVERITY_CRITICAL",
+	}
+	AnnouncementSeverity_value = map[string]int32{
+		"ANNOUNCEMENT_SEVERITY_UNSPECIFIED": 0,
+		"ANNOUNCEMENT_SEVERITY_INFO":        1,
+		"ANNOUNCEMENT_SEVERITY_WARNING":     2,
+		"ANNOUNCEMENT_SEVERITY_CRITICAL":    3,
+	}
+)
+
+func (x AnnouncementSeverity) Enum() *AnnouncementSeverity {
+	p := new(AnnouncementSeverity)
+	*p = x
+	return p
+}
+
+func (x AnnouncementSeverity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AnnouncementSeverity) Descriptor() protoreflect.EnumDescriptor {
+	return file_notification_v1_notification_proto_enumTypes[1].Descriptor()
+}
+
+func (AnnouncementSeverity) Type() protoreflect.EnumType {
+	return &file_notification_v1_notification_proto_enumTypes[1]
+}
+
+func (x AnnouncementSeverity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AnnouncementSeverity.Descriptor instead.
+func (AnnouncementSeverity) EnumDescriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{1}
+}
+
+type Announcement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Severity      AnnouncementSeverity   `protobuf:"varint,3,opt,name=severity,proto3,enum=notification.v1.AnnouncementSeverity" json:"severity,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Unset if it never expires
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Announcement) Reset() {
+	*x = Announcement{}
+	mi := &file_notification_v1_notification_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Announcement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Announcement) ProtoMessage() {}
+
+func (x *Announcement) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Announcement.ProtoReflect.Descriptor instead.
+func (*Announcement) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Announcement) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Announcement) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Announcement) GetSeverity() AnnouncementSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return AnnouncementSeverity_ANNOUNCEMENT_SEVERITY_UNSPECIFIED
+}
+
+func (x *Announcement) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Announcement) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type Notification struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          NotificationType       `protobuf:"varint,2,opt,name=type,proto3,enum=notification.v1.NotificationType" json:"type,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	Data          map[string]string      `protobuf:"bytes,5,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Type-specific IDs, e.g. user_id for friend notifications
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Read          bool                   `protobuf:"varint,7,opt,name=read,proto3" json:"read,omitempty"`
+	Stream        *v1.StreamInfo         `protobuf:"bytes,8,opt,name=stream,proto3" json:"stream,omitempty"` // Set when sent by StreamNotifications
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_notification_v1_notification_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Notification) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Notification) GetType() NotificationType {
+	if x != nil {
+		return x.Type
+	}
+	return NotificationType_NOTIFICATION_TYPE_UNSPECIFIED
+}
+
+func (x *Notification) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Notification) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Notification) GetData() map[string]string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Notification) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Notification) GetRead() bool {
+	if x != nil {
+		return x.Read
+	}
+	return false
+}
+
+func (x *Notification) GetStream() *v1.StreamInfo {
+	if x != nil {
+		return x.Stream
+	}
+	return nil
+}
+
+type ListNotificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BeforeId      int64                  `protobuf:"varint,1,opt,name=before_id,json=beforeId,proto3" json:"before_id,omitempty"` // 0 starts from the newest notification
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                       // 0 uses the default, larger values are capped
+	UnreadOnly    bool                   `protobuf:"varint,3,opt,name=unread_only,json=unreadOnly,proto3" json:"unread_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsRequest) Reset() {
+	*x = ListNotificationsRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsRequest) ProtoMessage() {}
+
+func (x *ListNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListNotificationsRequest) GetBeforeId() int64 {
+	if x != nil {
+		return x.BeforeId
+	}
+	return 0
+}
+
+func (x *ListNotificationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListNotificationsRequest) GetUnreadOnly() bool {
+	if x != nil {
+		return x.UnreadOnly
+	}
+	return false
+}
+
+type ListNotificationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notifications []*Notification        `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"` // Newest first
+	UnreadCount   int64                  `protobuf:"varint,2,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsResponse) Reset() {
+	*x = ListNotificationsResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsResponse) ProtoMessage() {}
+
+func (x *ListNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*ListNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListNotificationsResponse) GetNotifications() []*Notification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *ListNotificationsResponse) GetUnreadCount() int64 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+type MarkNotificationsReadRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	NotificationIds []int64                `protobuf:"varint,1,rep,packed,name=notification_ids,json=notificationIds,proto3" json:"notification_ids,omitempty"`
+	All             bool                   `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"` // Marks every notification read; notification_ids is ignored
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MarkNotificationsReadRequest) Reset() {
+	*x = MarkNotificationsReadRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkNotificationsReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNotificationsReadRequest) ProtoMessage() {}
+
+func (x *MarkNotificationsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNotificationsReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkNotificationsReadRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MarkNotificationsReadRequest) GetNotificationIds() []int64 {
+	if x != nil {
+		return x.NotificationIds
+	}
+	return nil
+}
+
+func (x *MarkNotificationsReadRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+type MarkNotificationsReadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Marked        int64                  `protobuf:"varint,1,opt,name=marked,proto3" json:"marked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkNotificationsReadResponse) Reset() {
+	*x = MarkNotificationsReadResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkNotificationsReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNotificationsReadResponse) ProtoMessage() {}
+
+func (x *MarkNotificationsReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNotificationsReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkNotificationsReadResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MarkNotificationsReadResponse) GetMarked() int64 {
+	if x != nil {
+		return x.Marked
+	}
+	return 0
+}
+
+type StreamNotificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resume        *v1.StreamResume       `protobuf:"bytes,1,opt,name=resume,proto3" json:"resume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamNotificationsRequest) Reset() {
+	*x = StreamNotificationsRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamNotificationsRequest) ProtoMessage() {}
+
+func (x *StreamNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*StreamNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StreamNotificationsRequest) GetResume() *v1.StreamResume {
+	if x != nil {
+		return x.Resume
+	}
+	return nil
+}
+
+type ListAnnouncementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAnnouncementsRequest) Reset() {
+	*x = ListAnnouncementsRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAnnouncementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAnnouncementsRequest) ProtoMessage() {}
+
+func (x *ListAnnouncementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAnnouncementsRequest.ProtoReflect.Descriptor instead.
+func (*ListAnnouncementsRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{7}
+}
+
+type ListAnnouncementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Announcements []*Announcement        `protobuf:"bytes,1,rep,name=announcements,proto3" json:"announcements,omitempty"` // Oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAnnouncementsResponse) Reset() {
+	*x = ListAnnouncementsResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAnnouncementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAnnouncementsResponse) ProtoMessage() {}
+
+func (x *ListAnnouncementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAnnouncementsResponse.ProtoReflect.Descriptor instead.
+func (*ListAnnouncementsResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListAnnouncementsResponse) GetAnnouncements() []*Announcement {
+	if x != nil {
+		return x.Announcements
+	}
+	return nil
+}
+
+var File_notification_v1_notification_proto protoreflect.FileDescriptor
+
+const file_notification_v1_notification_proto_rawDesc = "" +
+	"\n" +
+	"\"notification/v1/notification.proto\x12\x0fnotification.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16stream/v1/stream.proto\"\xf1\x01\n" +
+	"\fAnnouncement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12A\n" +
+	"\bseverity\x18\x03 \x01(\x0e2%.notification.v1.AnnouncementSeverityR\bseverity\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\xf3\x02\n" +
+	"\fNotification\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x125\n" +
+	"\x04type\x18\x02 \x01(\x0e2!.notification.v1.NotificationTypeR\x04type\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\x12;\n" +
+	"\x04data\x18\x05 \x03(\v2'.notification.v1.Notification.DataEntryR\x04data\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x12\n" +
+	"\x04read\x18\a \x01(\bR\x04read\x12-\n" +
+	"\x06stream\x18\b \x01(\v2\x15.stream.v1.StreamInfoR\x06stream\x1a7\n" +
+	"\tDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"n\n" +
+	"\x18ListNotificationsRequest\x12\x1b\n" +
+	"\tbefore_id\x18\x01 \x01(\x03R\bbeforeId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1f\n" +
+	"\vunread_only\x18\x03 \x01(\bR\n" +
+	"unreadOnly\"\x83\x01\n" +
+	"\x19ListNotificationsResponse\x12C\n" +
+	"\rnotifications\x18\x01 \x03(\v2\x1d.notification.v1.NotificationR\rnotifications\x12!\n" +
+	"\funread_count\x18\x02 \x01(\x03R\vunreadCount\"[\n" +
+	"\x1cMarkNotificationsReadRequest\x12)\n" +
+	"\x10notification_ids\x18\x01 \x03(\x03R\x0fnotificationIds\x12\x10\n" +
+	"\x03all\x18\x02 \x01(\bR\x03all\"7\n" +
+	"\x1dMarkNotificationsReadResponse\x12\x16\n" +
+	"\x06marked\x18\x01 \x01(\x03R\x06marked\"M\n" +
+	"\x1aStreamNotificationsRequest\x12/\n" +
+	"\x06resume\x18\x01 \x01(\v2\x17.stream.v1.StreamResumeR\x06resume\"\x1a\n" +
+	"\x18ListAnnouncementsRequest\"`\n" +
+	"\x19ListAnnouncementsResponse\x12C\n" +
+	"\rannouncements\x18\x01 \x03(\v2\x1d.notification.v1.AnnouncementR\rannouncements*\xe6\x03\n" +
+	"\x10NotificationType\x12!\n" +
+	"\x1dNOTIFICATION_TYPE_UNSPECIFIED\x10\x00\x12$\n" +
+	" NOTIFICATION_TYPE_FRIEND_REQUEST\x10\x01\x12%\n" +
+	"!NOTIFICATION_TYPE_FRIEND_ACCEPTED\x10\x02\x12%\n" +
+	"!NOTIFICATION_TYPE_TRADE_COMPLETED\x10\x03\x12%\n" +
+	"!NOTIFICATION_TYPE_QUEST_COMPLETED\x10\x04\x12(\n" +
+	"$NOTIFICATION_TYPE_LAND_CLAIM_EXPIRED\x10\x05\x12!\n" +
+	"\x1dNOTIFICATION_TYPE_MAINTENANCE\x10\x06\x12\"\n" +
+	"\x1eNOTIFICATION_TYPE_ANNOUNCEMENT\x10\a\x12$\n" +
+	" NOTIFICATION_TYPE_SEASONAL_EVENT\x10\b\x12)\n" +
+	"%NOTIFICATION_TYPE_PROCESSING_COMPLETE\x10\t\x12*\n" +
+	"&NOTIFICATION_TYPE_ACHIEVEMENT_UNLOCKED\x10\n" +
+	"\x12&\n" +
+	"\"NOTIFICATION_TYPE_NEW_DEVICE_LOGIN\x10\v*\xa4\x01\n" +
+	"\x14AnnouncementSeverity\x12%\n" +
+	"!ANNOUNCEMENT_SEVERITY_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aANNOUNCEMENT_SEVERITY_INFO\x10\x01\x12!\n" +
+	"\x1dANNOUNCEMENT_SEVERITY_WARNING\x10\x02\x12\"\n" +
+	"\x1eANNOUNCEMENT_SEVERITY_CRITICAL\x10\x032\xd2\x03\n" +
+	"\x13NotificationService\x12l\n" +
+	"\x11ListNotifications\x12).notification.v1.ListNotificationsRequest\x1a*.notification.v1.ListNotificationsResponse\"\x00\x12x\n" +
+	"\x15MarkNotificationsRead\x12-.notification.v1.MarkNotificationsReadRequest\x1a..notification.v1.MarkNotificationsReadResponse\"\x00\x12e\n" +
+	"\x13StreamNotifications\x12+.notification.v1.StreamNotificationsRequest\x1a\x1d.notification.v1.Notification\"\x000\x01\x12l\n" +
+	"\x11ListAnnouncements\x12).notification.v1.ListAnnouncementsRequest\x1a*.notification.v1.ListAnnouncementsResponse\"\x00B3Z1github.com/VoidMesh/api/api/proto/notification/v1b\x06proto3"
+
+var (
+	file_notification_v1_notification_proto_rawDescOnce sync.Once
+	file_notification_v1_notification_proto_rawDescData []byte
+)
+
+func file_notification_v1_notification_proto_rawDescGZIP() []byte {
+	file_notification_v1_notification_proto_rawDescOnce.Do(func() {
+		file_notification_v1_notification_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_notification_v1_notification_proto_rawDesc), len(file_notification_v1_notification_proto_rawDesc)))
+	})
+	return file_notification_v1_notification_proto_rawDescData
+}
+
+var file_notification_v1_notification_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_notification_v1_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_notification_v1_notification_proto_goTypes = []any{
+	(NotificationType)(0),                 // 0: notification.v1.NotificationType
+	(AnnouncementSeverity)(0),             // 1: notification.v1.AnnouncementSeverity
+	(*Announcement)(nil),                  // 2: notification.v1.Announcement
+	(*Notification)(nil),                  // 3: notification.v1.Notification
+	(*ListNotificationsRequest)(nil),      // 4: notification.v1.ListNotificationsRequest
+	(*ListNotificationsResponse)(nil),     // 5: notification.v1.ListNotificationsResponse
+	(*MarkNotificationsReadRequest)(nil),  // 6: notification.v1.MarkNotificationsReadRequest
+	(*MarkNotificationsReadResponse)(nil), // 7: notification.v1.MarkNotificationsReadResponse
+	(*StreamNotificationsRequest)(nil),    // 8: notification.v1.StreamNotificationsRequest
+	(*ListAnnouncementsRequest)(nil),      // 9: notification.v1.ListAnnouncementsRequest
+	(*ListAnnouncementsResponse)(nil),     // 10: notification.v1.ListAnnouncementsResponse
+	nil,                                   // 11: notification.v1.Notification.DataEntry
+	(*timestamppb.Timestamp)(nil),         // 12: google.protobuf.Timestamp
+	(*v1.StreamInfo)(nil),                 // 13: stream.v1.StreamInfo
+	(*v1.StreamResume)(nil),               // 14: stream.v1.StreamResume
+}
+var file_notification_v1_notification_proto_depIdxs = []int32{
+	1,  // 0: notification.v1.Announcement.severity:type_name -> notification.v1.AnnouncementSeverity
+	12, // 1: notification.v1.Announcement.created_at:type_name -> google.protobuf.Timestamp
+	12, // 2: notification.v1.Announcement.expires_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: notification.v1.Notification.type:type_name -> notification.v1.NotificationType
+	11, // 4: notification.v1.Notification.data:type_name -> notification.v1.Notification.DataEntry
+	12, // 5: notification.v1.Notification.created_at:type_name -> google.protobuf.Timestamp
+	13, // 6: notification.v1.Notification.stream:type_name -> stream.v1.StreamInfo
+	3,  // 7: notification.v1.ListNotificationsResponse.notifications:type_name -> notification.v1.Notification
+	14, // 8: notification.v1.StreamNotificationsRequest.resume:type_name -> stream.v1.StreamResume
+	2,  // 9: notification.v1.ListAnnouncementsResponse.announcements:type_name -> notification.v1.Announcement
+	4,  // 10: notification.v1.NotificationService.ListNotifications:input_type -> notification.v1.ListNotificationsRequest
+	6,  // 11: notification.v1.NotificationService.MarkNotificationsRead:input_type -> notification.v1.MarkNotificationsReadRequest
+	8,  // 12: notification.v1.NotificationService.StreamNotifications:input_type -> notification.v1.StreamNotificationsRequest
+	9,  // 13: notification.v1.NotificationService.ListAnnouncements:input_type -> notification.v1.ListAnnouncementsRequest
+	5,  // 14: notification.v1.NotificationService.ListNotifications:output_type -> notification.v1.ListNotificationsResponse
+	7,  // 15: notification.v1.NotificationService.MarkNotificationsRead:output_type -> notification.v1.MarkNotificationsReadResponse
+	3,  // 16: notification.v1.NotificationService.StreamNotifications:output_type -> notification.v1.Notification
+	10, // 17: notification.v1.NotificationService.ListAnnouncements:output_type -> notification.v1.ListAnnouncementsResponse
+	14, // [14:18] is the sub-list for method output_type
+	10, // [10:14] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_notification_v1_notification_proto_init() }
+func file_notification_v1_notification_proto_init() {
+	if File_notification_v1_notification_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_notification_v1_notification_proto_rawDesc), len(file_notification_v1_notification_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_notification_v1_notification_proto_goTypes,
+		DependencyIndexes: file_notification_v1_notification_proto_depIdxs,
+		EnumInfos:         file_notification_v1_notification_proto_enumTypes,
+		MessageInfos:      file_notification_v1_notification_proto_msgTypes,
+	}.Build()
+	File_notification_v1_notification_proto = out.File
+	file_notification_v1_notification_proto_goTypes = nil
+	file_notification_v1_notification_proto_depIdxs = nil
+}
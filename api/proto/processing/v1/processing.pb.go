@@ -0,0 +1,1028 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.4
+// source: processing/v1/processing.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ItemQuantity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        int32                  `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	ItemName      string                 `protobuf:"bytes,2,opt,name=item_name,json=itemName,proto3" json:"item_name,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemQuantity) Reset() {
+	*x = ItemQuantity{}
+	mi := &file_processing_v1_processing_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemQuantity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemQuantity) ProtoMessage() {}
+
+func (x *ItemQuantity) ProtoReflect() protoreflect.Message {
+	mi := &file_processing_v1_processing_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemQuantity.ProtoReflect.Descriptor instead.
+func (*ItemQuantity) Descriptor() ([]byte, []int) {
+	return file_processing_v1_processing_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ItemQuantity) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *ItemQuantity) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *ItemQuantity) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type Recipe struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	StationType     string                 `protobuf:"bytes,3,opt,name=station_type,json=stationType,proto3" json:"station_type,omitempty"` // Entity type of the station it needs, e.g. "campfire"
+	Inputs          []*ItemQuantity        `protobuf:"bytes,4,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	Outputs         []*ItemQuantity        `protobuf:"bytes,5,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	DurationSeconds int64                  `protobuf:"varint,6,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Recipe) Reset() {
+	*x = Recipe{}
+	mi := &file_processing_v1_processing_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recipe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recipe) ProtoMessage() {}
+
+func (x *Recipe) ProtoReflect() protoreflect.Message {
+	mi := &file_processing_v1_processing_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recipe.ProtoReflect.Descriptor instead.
+func (*Recipe) Descriptor() ([]byte, []int) {
+	return file_processing_v1_processing_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Recipe) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Recipe) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Recipe) GetStationType() string {
+	if x != nil {
+		return x.StationType
+	}
+	return ""
+}
+
+func (x *Recipe) GetInputs() []*ItemQuantity {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+func (x *Recipe) GetOutputs() []*ItemQuantity {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+func (x *Recipe) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+type StationType struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Cost          []*ItemQuantity        `protobuf:"bytes,3,rep,name=cost,proto3" json:"cost,omitempty"` // Taken from the inventory when building
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StationType) Reset() {
+	*x = StationType{}
+	mi := &file_processing_v1_processing_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StationType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StationType) ProtoMessage() {}
+
+func (x *StationType) ProtoReflect() protoreflect.Message {
+	mi := &file_processing_v1_processing_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StationType.ProtoReflect.Descriptor instead.
+func (*StationType) Descriptor() ([]byte, []int) {
+	return file_processing_v1_processing_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StationType) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *StationType) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StationType) GetCost() []*ItemQuantity {
+	if x != nil {
+		return x.Cost
+	}
+	return nil
+}
+
+// A placed station, stored as a world entity
+type Station struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	EntityId         int64                  `protobuf:"varint,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Type             string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	X                int32                  `protobuf:"varint,3,opt,name=x,proto3" json:"x,omitempty"`
+	Y                int32                  `protobuf:"varint,4,opt,name=y,proto3" json:"y,omitempty"`
+	OwnerCharacterId string                 `protobuf:"bytes,5,opt,name=owner_character_id,json=ownerCharacterId,proto3" json:"owner_character_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Station) Reset() {
+	*x = Station{}
+	mi := &file_processing_v1_processing_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Station) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Station) ProtoMessage() {}
+
+func (x *Station) ProtoReflect() protoreflect.Message {
+	mi := &file_processing_v1_processing_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Station.ProtoReflect.Descriptor instead.
+func (*Station) Descriptor() ([]byte, []int) {
+	return file_processing_v1_processing_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Station) GetEntityId() int64 {
+	if x != nil {
+		return x.EntityId
+	}
+	return 0
+}
+
+func (x *Station) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Station) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *Station) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *Station) GetOwnerCharacterId() string {
+	if x != nil {
+		return x.OwnerCharacterId
+	}
+	return ""
+}
+
+type ProcessingJob struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CharacterId     string                 `protobuf:"bytes,2,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	RecipeId        string                 `protobuf:"bytes,3,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+	StationEntityId int64                  `protobuf:"varint,4,opt,name=station_entity_id,json=stationEntityId,proto3" json:"station_entity_id,omitempty"`
+	Outputs         []*ItemQuantity        `protobuf:"bytes,5,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	StartedAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	ReadyAt         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=ready_at,json=readyAt,proto3" json:"ready_at,omitempty"`
+	Ready           bool                   `protobuf:"varint,8,opt,name=ready,proto3" json:"ready,omitempty"` // Can be collected
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ProcessingJob) Reset() {
+	*x = ProcessingJob{}
+	mi := &file_processing_v1_processing_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessingJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessingJob) ProtoMessage() {}
+
+func (x *ProcessingJob) ProtoReflect() protoreflect.Message {
+	mi := &file_processing_v1_processing_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessingJob.ProtoReflect.Descriptor instead.
+func (*ProcessingJob) Descriptor() ([]byte, []int) {
+	return file_processing_v1_processing_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ProcessingJob) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProcessingJob) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *ProcessingJob) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *ProcessingJob) GetStationEntityId() int64 {
+	if x != nil {
+		return x.StationEntityId
+	}
+	return 0
+}
+
+func (x *ProcessingJob) GetOutputs() []*ItemQuantity {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+func (x *ProcessingJob) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *ProcessingJob) GetReadyAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReadyAt
+	}
+	return nil
+}
+
+func (x *ProcessingJob) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+type ListRecipesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecipesRequest) Reset() {
+	*x = ListRecipesRequest{}
+	mi := &file_processing_v1_processing_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecipesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecipesRequest) ProtoMessage() {}
+
+func (x *ListRecipesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_processing_v1_processing_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecipesRequest.ProtoReflect.Descriptor instead.
+func (*ListRecipesRequest) Descriptor() ([]byte, []int) {
+	return file_processing_v1_processing_proto_rawDescGZIP(), []int{5}
+}
+
+type ListRecipesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recipes       []*Recipe              `protobuf:"bytes,1,rep,name=recipes,proto3" json:"recipes,omitempty"`
+	StationTypes  []*StationType         `protobuf:"bytes,2,rep,name=station_types,json=stationTypes,proto3" json:"station_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecipesResponse) Reset() {
+	*x = ListRecipesResponse{}
+	mi := &file_processing_v1_processing_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecipesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecipesResponse) ProtoMessage() {}
+
+func (x *ListRecipesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_processing_v1_processing_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecipesResponse.ProtoReflect.Descriptor instead.
+func (*ListRecipesResponse) Descriptor() ([]byte, []int) {
+	return file_processing_v1_processing_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListRecipesResponse) GetRecipes() []*Recipe {
+	if x != nil {
+		return x.Recipes
+	}
+	return nil
+}
+
+func (x *ListRecipesResponse) GetStationTypes() []*StationType {
+	if x != nil {
+		return x.StationTypes
+	}
+	return nil
+}
+
+type PlaceStationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	StationType   string                 `protobuf:"bytes,2,opt,name=station_type,json=stationType,proto3" json:"station_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceStationRequest) Reset() {
+	*x = PlaceStationRequest{}
+	mi := &file_processing_v1_processing_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceStationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceStationRequest) ProtoMessage() {}
+
+func (x *PlaceStationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_processing_v1_processing_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceStationRequest.ProtoReflect.Descriptor instead.
+func (*PlaceStationRequest) Descriptor() ([]byte, []int) {
+	return file_processing_v1_processing_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PlaceStationRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *PlaceStationRequest) GetStationType() string {
+	if x != nil {
+		return x.StationType
+	}
+	return ""
+}
+
+type PlaceStationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Station       *Station               `protobuf:"bytes,1,opt,name=station,proto3" json:"station,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceStationResponse) Reset() {
+	*x = PlaceStationResponse{}
+	mi := &file_processing_v1_processing_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceStationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceStationResponse) ProtoMessage() {}
+
+func (x *PlaceStationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_processing_v1_processing_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceStationResponse.ProtoReflect.Descriptor instead.
+func (*PlaceStationResponse) Descriptor() ([]byte, []int) {
+	return file_processing_v1_processing_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PlaceStationResponse) GetStation() *Station {
+	if x != nil {
+		return x.Station
+	}
+	return nil
+}
+
+type StartProcessingRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId     string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	RecipeId        string                 `protobuf:"bytes,2,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+	StationEntityId int64                  `protobuf:"varint,3,opt,name=station_entity_id,json=stationEntityId,proto3" json:"station_entity_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StartProcessingRequest) Reset() {
+	*x = StartProcessingRequest{}
+	mi := &file_processing_v1_processing_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartProcessingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartProcessingRequest) ProtoMessage() {}
+
+func (x *StartProcessingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_processing_v1_processing_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartProcessingRequest.ProtoReflect.Descriptor instead.
+func (*StartProcessingRequest) Descriptor() ([]byte, []int) {
+	return file_processing_v1_processing_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *StartProcessingRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *StartProcessingRequest) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *StartProcessingRequest) GetStationEntityId() int64 {
+	if x != nil {
+		return x.StationEntityId
+	}
+	return 0
+}
+
+type StartProcessingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *ProcessingJob         `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartProcessingResponse) Reset() {
+	*x = StartProcessingResponse{}
+	mi := &file_processing_v1_processing_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartProcessingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartProcessingResponse) ProtoMessage() {}
+
+func (x *StartProcessingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_processing_v1_processing_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartProcessingResponse.ProtoReflect.Descriptor instead.
+func (*StartProcessingResponse) Descriptor() ([]byte, []int) {
+	return file_processing_v1_processing_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StartProcessingResponse) GetJob() *ProcessingJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type ListProcessingJobsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProcessingJobsRequest) Reset() {
+	*x = ListProcessingJobsRequest{}
+	mi := &file_processing_v1_processing_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProcessingJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProcessingJobsRequest) ProtoMessage() {}
+
+func (x *ListProcessingJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_processing_v1_processing_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProcessingJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListProcessingJobsRequest) Descriptor() ([]byte, []int) {
+	return file_processing_v1_processing_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListProcessingJobsRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+type ListProcessingJobsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jobs          []*ProcessingJob       `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"` // Soonest ready first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProcessingJobsResponse) Reset() {
+	*x = ListProcessingJobsResponse{}
+	mi := &file_processing_v1_processing_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProcessingJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProcessingJobsResponse) ProtoMessage() {}
+
+func (x *ListProcessingJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_processing_v1_processing_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProcessingJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListProcessingJobsResponse) Descriptor() ([]byte, []int) {
+	return file_processing_v1_processing_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListProcessingJobsResponse) GetJobs() []*ProcessingJob {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+type CollectProcessingJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	JobId         int64                  `protobuf:"varint,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectProcessingJobRequest) Reset() {
+	*x = CollectProcessingJobRequest{}
+	mi := &file_processing_v1_processing_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectProcessingJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectProcessingJobRequest) ProtoMessage() {}
+
+func (x *CollectProcessingJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_processing_v1_processing_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectProcessingJobRequest.ProtoReflect.Descriptor instead.
+func (*CollectProcessingJobRequest) Descriptor() ([]byte, []int) {
+	return file_processing_v1_processing_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CollectProcessingJobRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *CollectProcessingJobRequest) GetJobId() int64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+type CollectProcessingJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ItemQuantity        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectProcessingJobResponse) Reset() {
+	*x = CollectProcessingJobResponse{}
+	mi := &file_processing_v1_processing_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectProcessingJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectProcessingJobResponse) ProtoMessage() {}
+
+func (x *CollectProcessingJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_processing_v1_processing_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectProcessingJobResponse.ProtoReflect.Descriptor instead.
+func (*CollectProcessingJobResponse) Descriptor() ([]byte, []int) {
+	return file_processing_v1_processing_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CollectProcessingJobResponse) GetItems() []*ItemQuantity {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+var File_processing_v1_processing_proto protoreflect.FileDescriptor
+
+const file_processing_v1_processing_proto_rawDesc = "" +
+	"\n" +
+	"\x1eprocessing/v1/processing.proto\x12\rprocessing.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"`\n" +
+	"\fItemQuantity\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\x05R\x06itemId\x12\x1b\n" +
+	"\titem_name\x18\x02 \x01(\tR\bitemName\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\"\xe6\x01\n" +
+	"\x06Recipe\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
+	"\fstation_type\x18\x03 \x01(\tR\vstationType\x123\n" +
+	"\x06inputs\x18\x04 \x03(\v2\x1b.processing.v1.ItemQuantityR\x06inputs\x125\n" +
+	"\aoutputs\x18\x05 \x03(\v2\x1b.processing.v1.ItemQuantityR\aoutputs\x12)\n" +
+	"\x10duration_seconds\x18\x06 \x01(\x03R\x0fdurationSeconds\"f\n" +
+	"\vStationType\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12/\n" +
+	"\x04cost\x18\x03 \x03(\v2\x1b.processing.v1.ItemQuantityR\x04cost\"\x84\x01\n" +
+	"\aStation\x12\x1b\n" +
+	"\tentity_id\x18\x01 \x01(\x03R\bentityId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\f\n" +
+	"\x01x\x18\x03 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x04 \x01(\x05R\x01y\x12,\n" +
+	"\x12owner_character_id\x18\x05 \x01(\tR\x10ownerCharacterId\"\xca\x02\n" +
+	"\rProcessingJob\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
+	"\fcharacter_id\x18\x02 \x01(\tR\vcharacterId\x12\x1b\n" +
+	"\trecipe_id\x18\x03 \x01(\tR\brecipeId\x12*\n" +
+	"\x11station_entity_id\x18\x04 \x01(\x03R\x0fstationEntityId\x125\n" +
+	"\aoutputs\x18\x05 \x03(\v2\x1b.processing.v1.ItemQuantityR\aoutputs\x129\n" +
+	"\n" +
+	"started_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x125\n" +
+	"\bready_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\areadyAt\x12\x14\n" +
+	"\x05ready\x18\b \x01(\bR\x05ready\"\x14\n" +
+	"\x12ListRecipesRequest\"\x87\x01\n" +
+	"\x13ListRecipesResponse\x12/\n" +
+	"\arecipes\x18\x01 \x03(\v2\x15.processing.v1.RecipeR\arecipes\x12?\n" +
+	"\rstation_types\x18\x02 \x03(\v2\x1a.processing.v1.StationTypeR\fstationTypes\"[\n" +
+	"\x13PlaceStationRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12!\n" +
+	"\fstation_type\x18\x02 \x01(\tR\vstationType\"H\n" +
+	"\x14PlaceStationResponse\x120\n" +
+	"\astation\x18\x01 \x01(\v2\x16.processing.v1.StationR\astation\"\x84\x01\n" +
+	"\x16StartProcessingRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x1b\n" +
+	"\trecipe_id\x18\x02 \x01(\tR\brecipeId\x12*\n" +
+	"\x11station_entity_id\x18\x03 \x01(\x03R\x0fstationEntityId\"I\n" +
+	"\x17StartProcessingResponse\x12.\n" +
+	"\x03job\x18\x01 \x01(\v2\x1c.processing.v1.ProcessingJobR\x03job\">\n" +
+	"\x19ListProcessingJobsRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\"N\n" +
+	"\x1aListProcessingJobsResponse\x120\n" +
+	"\x04jobs\x18\x01 \x03(\v2\x1c.processing.v1.ProcessingJobR\x04jobs\"W\n" +
+	"\x1bCollectProcessingJobRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x15\n" +
+	"\x06job_id\x18\x02 \x01(\x03R\x05jobId\"Q\n" +
+	"\x1cCollectProcessingJobResponse\x121\n" +
+	"\x05items\x18\x01 \x03(\v2\x1b.processing.v1.ItemQuantityR\x05items2\x8a\x04\n" +
+	"\x11ProcessingService\x12V\n" +
+	"\vListRecipes\x12!.processing.v1.ListRecipesRequest\x1a\".processing.v1.ListRecipesResponse\"\x00\x12Y\n" +
+	"\fPlaceStation\x12\".processing.v1.PlaceStationRequest\x1a#.processing.v1.PlaceStationResponse\"\x00\x12b\n" +
+	"\x0fStartProcessing\x12%.processing.v1.StartProcessingRequest\x1a&.processing.v1.StartProcessingResponse\"\x00\x12k\n" +
+	"\x12ListProcessingJobs\x12(.processing.v1.ListProcessingJobsRequest\x1a).processing.v1.ListProcessingJobsResponse\"\x00\x12q\n" +
+	"\x14CollectProcessingJob\x12*.processing.v1.CollectProcessingJobRequest\x1a+.processing.v1.CollectProcessingJobResponse\"\x00B1Z/github.com/VoidMesh/api/api/proto/processing/v1b\x06proto3"
+
+var (
+	file_processing_v1_processing_proto_rawDescOnce sync.Once
+	file_processing_v1_processing_proto_rawDescData []byte
+)
+
+func file_processing_v1_processing_proto_rawDescGZIP() []byte {
+	file_processing_v1_processing_proto_rawDescOnce.Do(func() {
+		file_processing_v1_processing_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_processing_v1_processing_proto_rawDesc), len(file_processing_v1_processing_proto_rawDesc)))
+	})
+	return file_processing_v1_processing_proto_rawDescData
+}
+
+var file_processing_v1_processing_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_processing_v1_processing_proto_goTypes = []any{
+	(*ItemQuantity)(nil),                 // 0: processing.v1.ItemQuantity
+	(*Recipe)(nil),                       // 1: processing.v1.Recipe
+	(*StationType)(nil),                  // 2: processing.v1.StationType
+	(*Station)(nil),                      // 3: processing.v1.Station
+	(*ProcessingJob)(nil),                // 4: processing.v1.ProcessingJob
+	(*ListRecipesRequest)(nil),           // 5: processing.v1.ListRecipesRequest
+	(*ListRecipesResponse)(nil),          // 6: processing.v1.ListRecipesResponse
+	(*PlaceStationRequest)(nil),          // 7: processing.v1.PlaceStationRequest
+	(*PlaceStationResponse)(nil),         // 8: processing.v1.PlaceStationResponse
+	(*StartProcessingRequest)(nil),       // 9: processing.v1.StartProcessingRequest
+	(*StartProcessingResponse)(nil),      // 10: processing.v1.StartProcessingResponse
+	(*ListProcessingJobsRequest)(nil),    // 11: processing.v1.ListProcessingJobsRequest
+	(*ListProcessingJobsResponse)(nil),   // 12: processing.v1.ListProcessingJobsResponse
+	(*CollectProcessingJobRequest)(nil),  // 13: processing.v1.CollectProcessingJobRequest
+	(*CollectProcessingJobResponse)(nil), // 14: processing.v1.CollectProcessingJobResponse
+	(*timestamppb.Timestamp)(nil),        // 15: google.protobuf.Timestamp
+}
+var file_processing_v1_processing_proto_depIdxs = []int32{
+	0,  // 0: processing.v1.Recipe.inputs:type_name -> processing.v1.ItemQuantity
+	0,  // 1: processing.v1.Recipe.outputs:type_name -> processing.v1.ItemQuantity
+	0,  // 2: processing.v1.StationType.cost:type_name -> processing.v1.ItemQuantity
+	0,  // 3: processing.v1.ProcessingJob.outputs:type_name -> processing.v1.ItemQuantity
+	15, // 4: processing.v1.ProcessingJob.started_at:type_name -> google.protobuf.Timestamp
+	15, // 5: processing.v1.ProcessingJob.ready_at:type_name -> google.protobuf.Timestamp
+	1,  // 6: processing.v1.ListRecipesResponse.recipes:type_name -> processing.v1.Recipe
+	2,  // 7: processing.v1.ListRecipesResponse.station_types:type_name -> processing.v1.StationType
+	3,  // 8: processing.v1.PlaceStationResponse.station:type_name -> processing.v1.Station
+	4,  // 9: processing.v1.StartProcessingResponse.job:type_name -> processing.v1.ProcessingJob
+	4,  // 10: processing.v1.ListProcessingJobsResponse.jobs:type_name -> processing.v1.ProcessingJob
+	0,  // 11: processing.v1.CollectProcessingJobResponse.items:type_name -> processing.v1.ItemQuantity
+	5,  // 12: processing.v1.ProcessingService.ListRecipes:input_type -> processing.v1.ListRecipesRequest
+	7,  // 13: processing.v1.ProcessingService.PlaceStation:input_type -> processing.v1.PlaceStationRequest
+	9,  // 14: processing.v1.ProcessingService.StartProcessing:input_type -> processing.v1.StartProcessingRequest
+	11, // 15: processing.v1.ProcessingService.ListProcessingJobs:input_type -> processing.v1.ListProcessingJobsRequest
+	13, // 16: processing.v1.ProcessingService.CollectProcessingJob:input_type -> processing.v1.CollectProcessingJobRequest
+	6,  // 17: processing.v1.ProcessingService.ListRecipes:output_type -> processing.v1.ListRecipesResponse
+	8,  // 18: processing.v1.ProcessingService.PlaceStation:output_type -> processing.v1.PlaceStationResponse
+	10, // 19: processing.v1.ProcessingService.StartProcessing:output_type -> processing.v1.StartProcessingResponse
+	12, // 20: processing.v1.ProcessingService.ListProcessingJobs:output_type -> processing.v1.ListProcessingJobsResponse
+	14, // 21: processing.v1.ProcessingService.CollectProcessingJob:output_type -> processing.v1.CollectProcessingJobResponse
+	17, // [17:22] is the sub-list for method output_type
+	12, // [12:17] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_processing_v1_processing_proto_init() }
+func file_processing_v1_processing_proto_init() {
+	if File_processing_v1_processing_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_processing_v1_processing_proto_rawDesc), len(file_processing_v1_processing_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_processing_v1_processing_proto_goTypes,
+		DependencyIndexes: file_processing_v1_processing_proto_depIdxs,
+		MessageInfos:      file_processing_v1_processing_proto_msgTypes,
+	}.Build()
+	File_processing_v1_processing_proto = out.File
+	file_processing_v1_processing_proto_goTypes = nil
+	file_processing_v1_processing_proto_depIdxs = nil
+}
@@ -0,0 +1,287 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.4
+// source: processing/v1/processing.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ProcessingService_ListRecipes_FullMethodName          = "/processing.v1.ProcessingService/ListRecipes"
+	ProcessingService_PlaceStation_FullMethodName         = "/processing.v1.ProcessingService/PlaceStation"
+	ProcessingService_StartProcessing_FullMethodName      = "/processing.v1.ProcessingService/StartProcessing"
+	ProcessingService_ListProcessingJobs_FullMethodName   = "/processing.v1.ProcessingService/ListProcessingJobs"
+	ProcessingService_CollectProcessingJob_FullMethodName = "/processing.v1.ProcessingService/CollectProcessingJob"
+)
+
+// ProcessingServiceClient is the client API for ProcessingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Characters build stations (campfire, furnace) and process items at them. Processing
+// takes time: a job is started with the recipe's inputs and collected once it is ready.
+type ProcessingServiceClient interface {
+	// Lists the recipes and the stations they need, with what each station costs to build
+	ListRecipes(ctx context.Context, in *ListRecipesRequest, opts ...grpc.CallOption) (*ListRecipesResponse, error)
+	// Builds a station on the character's cell, paying its cost from the inventory
+	PlaceStation(ctx context.Context, in *PlaceStationRequest, opts ...grpc.CallOption) (*PlaceStationResponse, error)
+	// Takes the recipe's inputs from the inventory and starts processing at a station within reach
+	StartProcessing(ctx context.Context, in *StartProcessingRequest, opts ...grpc.CallOption) (*StartProcessingResponse, error)
+	ListProcessingJobs(ctx context.Context, in *ListProcessingJobsRequest, opts ...grpc.CallOption) (*ListProcessingJobsResponse, error)
+	// Moves a ready job's outputs into the inventory; nothing is collected unless all of it fits
+	CollectProcessingJob(ctx context.Context, in *CollectProcessingJobRequest, opts ...grpc.CallOption) (*CollectProcessingJobResponse, error)
+}
+
+type processingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProcessingServiceClient(cc grpc.ClientConnInterface) ProcessingServiceClient {
+	return &processingServiceClient{cc}
+}
+
+func (c *processingServiceClient) ListRecipes(ctx context.Context, in *ListRecipesRequest, opts ...grpc.CallOption) (*ListRecipesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRecipesResponse)
+	err := c.cc.Invoke(ctx, ProcessingService_ListRecipes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processingServiceClient) PlaceStation(ctx context.Context, in *PlaceStationRequest, opts ...grpc.CallOption) (*PlaceStationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlaceStationResponse)
+	err := c.cc.Invoke(ctx, ProcessingService_PlaceStation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processingServiceClient) StartProcessing(ctx context.Context, in *StartProcessingRequest, opts ...grpc.CallOption) (*StartProcessingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartProcessingResponse)
+	err := c.cc.Invoke(ctx, ProcessingService_StartProcessing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processingServiceClient) ListProcessingJobs(ctx context.Context, in *ListProcessingJobsRequest, opts ...grpc.CallOption) (*ListProcessingJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProcessingJobsResponse)
+	err := c.cc.Invoke(ctx, ProcessingService_ListProcessingJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processingServiceClient) CollectProcessingJob(ctx context.Context, in *CollectProcessingJobRequest, opts ...grpc.CallOption) (*CollectProcessingJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectProcessingJobResponse)
+	err := c.cc.Invoke(ctx, ProcessingService_CollectProcessingJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProcessingServiceServer is the server API for ProcessingService service.
+// All implementations must embed UnimplementedProcessingServiceServer
+// for forward compatibility.
+//
+// Characters build stations (campfire, furnace) and process items at them. Processing
+// takes time: a job is started with the recipe's inputs and collected once it is ready.
+type ProcessingServiceServer interface {
+	// Lists the recipes and the stations they need, with what each station costs to build
+	ListRecipes(context.Context, *ListRecipesRequest) (*ListRecipesResponse, error)
+	// Builds a station on the character's cell, paying its cost from the inventory
+	PlaceStation(context.Context, *PlaceStationRequest) (*PlaceStationResponse, error)
+	// Takes the recipe's inputs from the inventory and starts processing at a station within reach
+	StartProcessing(context.Context, *StartProcessingRequest) (*StartProcessingResponse, error)
+	ListProcessingJobs(context.Context, *ListProcessingJobsRequest) (*ListProcessingJobsResponse, error)
+	// Moves a ready job's outputs into the inventory; nothing is collected unless all of it fits
+	CollectProcessingJob(context.Context, *CollectProcessingJobRequest) (*CollectProcessingJobResponse, error)
+	mustEmbedUnimplementedProcessingServiceServer()
+}
+
+// UnimplementedProcessingServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedProcessingServiceServer struct{}
+
+func (UnimplementedProcessingServiceServer) ListRecipes(context.Context, *ListRecipesRequest) (*ListRecipesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecipes not implemented")
+}
+func (UnimplementedProcessingServiceServer) PlaceStation(context.Context, *PlaceStationRequest) (*PlaceStationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceStation not implemented")
+}
+func (UnimplementedProcessingServiceServer) StartProcessing(context.Context, *StartProcessingRequest) (*StartProcessingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartProcessing not implemented")
+}
+func (UnimplementedProcessingServiceServer) ListProcessingJobs(context.Context, *ListProcessingJobsRequest) (*ListProcessingJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProcessingJobs not implemented")
+}
+func (UnimplementedProcessingServiceServer) CollectProcessingJob(context.Context, *CollectProcessingJobRequest) (*CollectProcessingJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectProcessingJob not implemented")
+}
+func (UnimplementedProcessingServiceServer) mustEmbedUnimplementedProcessingServiceServer() {}
+func (UnimplementedProcessingServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeProcessingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProcessingServiceServer will
+// result in compilation errors.
+type UnsafeProcessingServiceServer interface {
+	mustEmbedUnimplementedProcessingServiceServer()
+}
+
+func RegisterProcessingServiceServer(s grpc.ServiceRegistrar, srv ProcessingServiceServer) {
+	// If the following call pancis, it indicates UnimplementedProcessingServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ProcessingService_ServiceDesc, srv)
+}
+
+func _ProcessingService_ListRecipes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecipesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessingServiceServer).ListRecipes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProcessingService_ListRecipes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessingServiceServer).ListRecipes(ctx, req.(*ListRecipesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProcessingService_PlaceStation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceStationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessingServiceServer).PlaceStation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProcessingService_PlaceStation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessingServiceServer).PlaceStation(ctx, req.(*PlaceStationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProcessingService_StartProcessing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartProcessingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessingServiceServer).StartProcessing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProcessingService_StartProcessing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessingServiceServer).StartProcessing(ctx, req.(*StartProcessingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProcessingService_ListProcessingJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProcessingJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessingServiceServer).ListProcessingJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProcessingService_ListProcessingJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessingServiceServer).ListProcessingJobs(ctx, req.(*ListProcessingJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProcessingService_CollectProcessingJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectProcessingJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessingServiceServer).CollectProcessingJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProcessingService_CollectProcessingJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessingServiceServer).CollectProcessingJob(ctx, req.(*CollectProcessingJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProcessingService_ServiceDesc is the grpc.ServiceDesc for ProcessingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProcessingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "processing.v1.ProcessingService",
+	HandlerType: (*ProcessingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListRecipes",
+			Handler:    _ProcessingService_ListRecipes_Handler,
+		},
+		{
+			MethodName: "PlaceStation",
+			Handler:    _ProcessingService_PlaceStation_Handler,
+		},
+		{
+			MethodName: "StartProcessing",
+			Handler:    _ProcessingService_StartProcessing_Handler,
+		},
+		{
+			MethodName: "ListProcessingJobs",
+			Handler:    _ProcessingService_ListProcessingJobs_Handler,
+		},
+		{
+			MethodName: "CollectProcessingJob",
+			Handler:    _ProcessingService_CollectProcessingJob_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "processing/v1/processing.proto",
+}
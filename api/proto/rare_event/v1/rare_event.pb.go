@@ -0,0 +1,452 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.4
+// source: rare_event/v1/rare_event.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RareEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	WorldId       string                 `protobuf:"bytes,2,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"` // meteor or treasure_chest
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	ChunkX        int32                  `protobuf:"varint,5,opt,name=chunk_x,json=chunkX,proto3" json:"chunk_x,omitempty"`
+	ChunkY        int32                  `protobuf:"varint,6,opt,name=chunk_y,json=chunkY,proto3" json:"chunk_y,omitempty"`
+	X             int32                  `protobuf:"varint,7,opt,name=x,proto3" json:"x,omitempty"` // World cell coordinates
+	Y             int32                  `protobuf:"varint,8,opt,name=y,proto3" json:"y,omitempty"`
+	Progress      int32                  `protobuf:"varint,9,opt,name=progress,proto3" json:"progress,omitempty"`
+	Required      int32                  `protobuf:"varint,10,opt,name=required,proto3" json:"required,omitempty"`
+	SpawnedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=spawned_at,json=spawnedAt,proto3" json:"spawned_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Completed     bool                   `protobuf:"varint,13,opt,name=completed,proto3" json:"completed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RareEvent) Reset() {
+	*x = RareEvent{}
+	mi := &file_rare_event_v1_rare_event_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RareEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RareEvent) ProtoMessage() {}
+
+func (x *RareEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_rare_event_v1_rare_event_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RareEvent.ProtoReflect.Descriptor instead.
+func (*RareEvent) Descriptor() ([]byte, []int) {
+	return file_rare_event_v1_rare_event_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RareEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RareEvent) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *RareEvent) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *RareEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RareEvent) GetChunkX() int32 {
+	if x != nil {
+		return x.ChunkX
+	}
+	return 0
+}
+
+func (x *RareEvent) GetChunkY() int32 {
+	if x != nil {
+		return x.ChunkY
+	}
+	return 0
+}
+
+func (x *RareEvent) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *RareEvent) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *RareEvent) GetProgress() int32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *RareEvent) GetRequired() int32 {
+	if x != nil {
+		return x.Required
+	}
+	return 0
+}
+
+func (x *RareEvent) GetSpawnedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SpawnedAt
+	}
+	return nil
+}
+
+func (x *RareEvent) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *RareEvent) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+type ListRareEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorldId       string                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"` // Defaults to the caller's session world
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRareEventsRequest) Reset() {
+	*x = ListRareEventsRequest{}
+	mi := &file_rare_event_v1_rare_event_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRareEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRareEventsRequest) ProtoMessage() {}
+
+func (x *ListRareEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rare_event_v1_rare_event_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRareEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListRareEventsRequest) Descriptor() ([]byte, []int) {
+	return file_rare_event_v1_rare_event_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListRareEventsRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+type ListRareEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*RareEvent           `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRareEventsResponse) Reset() {
+	*x = ListRareEventsResponse{}
+	mi := &file_rare_event_v1_rare_event_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRareEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRareEventsResponse) ProtoMessage() {}
+
+func (x *ListRareEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rare_event_v1_rare_event_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRareEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListRareEventsResponse) Descriptor() ([]byte, []int) {
+	return file_rare_event_v1_rare_event_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListRareEventsResponse) GetEvents() []*RareEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type ContributeToRareEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	RareEventId   int64                  `protobuf:"varint,2,opt,name=rare_event_id,json=rareEventId,proto3" json:"rare_event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContributeToRareEventRequest) Reset() {
+	*x = ContributeToRareEventRequest{}
+	mi := &file_rare_event_v1_rare_event_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContributeToRareEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContributeToRareEventRequest) ProtoMessage() {}
+
+func (x *ContributeToRareEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rare_event_v1_rare_event_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContributeToRareEventRequest.ProtoReflect.Descriptor instead.
+func (*ContributeToRareEventRequest) Descriptor() ([]byte, []int) {
+	return file_rare_event_v1_rare_event_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ContributeToRareEventRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *ContributeToRareEventRequest) GetRareEventId() int64 {
+	if x != nil {
+		return x.RareEventId
+	}
+	return 0
+}
+
+type ContributeToRareEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *RareEvent             `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Contribution  int32                  `protobuf:"varint,2,opt,name=contribution,proto3" json:"contribution,omitempty"` // The character's total contribution to the event
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContributeToRareEventResponse) Reset() {
+	*x = ContributeToRareEventResponse{}
+	mi := &file_rare_event_v1_rare_event_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContributeToRareEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContributeToRareEventResponse) ProtoMessage() {}
+
+func (x *ContributeToRareEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rare_event_v1_rare_event_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContributeToRareEventResponse.ProtoReflect.Descriptor instead.
+func (*ContributeToRareEventResponse) Descriptor() ([]byte, []int) {
+	return file_rare_event_v1_rare_event_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ContributeToRareEventResponse) GetEvent() *RareEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *ContributeToRareEventResponse) GetContribution() int32 {
+	if x != nil {
+		return x.Contribution
+	}
+	return 0
+}
+
+var File_rare_event_v1_rare_event_proto protoreflect.FileDescriptor
+
+const file_rare_event_v1_rare_event_proto_rawDesc = "" +
+	"\n" +
+	"\x1erare_event/v1/rare_event.proto\x12\rrare_event.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf8\x02\n" +
+	"\tRareEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\bworld_id\x18\x02 \x01(\tR\aworldId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x17\n" +
+	"\achunk_x\x18\x05 \x01(\x05R\x06chunkX\x12\x17\n" +
+	"\achunk_y\x18\x06 \x01(\x05R\x06chunkY\x12\f\n" +
+	"\x01x\x18\a \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\b \x01(\x05R\x01y\x12\x1a\n" +
+	"\bprogress\x18\t \x01(\x05R\bprogress\x12\x1a\n" +
+	"\brequired\x18\n" +
+	" \x01(\x05R\brequired\x129\n" +
+	"\n" +
+	"spawned_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tspawnedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1c\n" +
+	"\tcompleted\x18\r \x01(\bR\tcompleted\"2\n" +
+	"\x15ListRareEventsRequest\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\tR\aworldId\"J\n" +
+	"\x16ListRareEventsResponse\x120\n" +
+	"\x06events\x18\x01 \x03(\v2\x18.rare_event.v1.RareEventR\x06events\"e\n" +
+	"\x1cContributeToRareEventRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\"\n" +
+	"\rrare_event_id\x18\x02 \x01(\x03R\vrareEventId\"s\n" +
+	"\x1dContributeToRareEventResponse\x12.\n" +
+	"\x05event\x18\x01 \x01(\v2\x18.rare_event.v1.RareEventR\x05event\x12\"\n" +
+	"\fcontribution\x18\x02 \x01(\x05R\fcontribution2\xe9\x01\n" +
+	"\x10RareEventService\x12_\n" +
+	"\x0eListRareEvents\x12$.rare_event.v1.ListRareEventsRequest\x1a%.rare_event.v1.ListRareEventsResponse\"\x00\x12t\n" +
+	"\x15ContributeToRareEvent\x12+.rare_event.v1.ContributeToRareEventRequest\x1a,.rare_event.v1.ContributeToRareEventResponse\"\x00B1Z/github.com/VoidMesh/api/api/proto/rare_event/v1b\x06proto3"
+
+var (
+	file_rare_event_v1_rare_event_proto_rawDescOnce sync.Once
+	file_rare_event_v1_rare_event_proto_rawDescData []byte
+)
+
+func file_rare_event_v1_rare_event_proto_rawDescGZIP() []byte {
+	file_rare_event_v1_rare_event_proto_rawDescOnce.Do(func() {
+		file_rare_event_v1_rare_event_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rare_event_v1_rare_event_proto_rawDesc), len(file_rare_event_v1_rare_event_proto_rawDesc)))
+	})
+	return file_rare_event_v1_rare_event_proto_rawDescData
+}
+
+var file_rare_event_v1_rare_event_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_rare_event_v1_rare_event_proto_goTypes = []any{
+	(*RareEvent)(nil),                     // 0: rare_event.v1.RareEvent
+	(*ListRareEventsRequest)(nil),         // 1: rare_event.v1.ListRareEventsRequest
+	(*ListRareEventsResponse)(nil),        // 2: rare_event.v1.ListRareEventsResponse
+	(*ContributeToRareEventRequest)(nil),  // 3: rare_event.v1.ContributeToRareEventRequest
+	(*ContributeToRareEventResponse)(nil), // 4: rare_event.v1.ContributeToRareEventResponse
+	(*timestamppb.Timestamp)(nil),         // 5: google.protobuf.Timestamp
+}
+var file_rare_event_v1_rare_event_proto_depIdxs = []int32{
+	5, // 0: rare_event.v1.RareEvent.spawned_at:type_name -> google.protobuf.Timestamp
+	5, // 1: rare_event.v1.RareEvent.expires_at:type_name -> google.protobuf.Timestamp
+	0, // 2: rare_event.v1.ListRareEventsResponse.events:type_name -> rare_event.v1.RareEvent
+	0, // 3: rare_event.v1.ContributeToRareEventResponse.event:type_name -> rare_event.v1.RareEvent
+	1, // 4: rare_event.v1.RareEventService.ListRareEvents:input_type -> rare_event.v1.ListRareEventsRequest
+	3, // 5: rare_event.v1.RareEventService.ContributeToRareEvent:input_type -> rare_event.v1.ContributeToRareEventRequest
+	2, // 6: rare_event.v1.RareEventService.ListRareEvents:output_type -> rare_event.v1.ListRareEventsResponse
+	4, // 7: rare_event.v1.RareEventService.ContributeToRareEvent:output_type -> rare_event.v1.ContributeToRareEventResponse
+	6, // [6:8] is the sub-list for method output_type
+	4, // [4:6] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_rare_event_v1_rare_event_proto_init() }
+func file_rare_event_v1_rare_event_proto_init() {
+	if File_rare_event_v1_rare_event_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rare_event_v1_rare_event_proto_rawDesc), len(file_rare_event_v1_rare_event_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_rare_event_v1_rare_event_proto_goTypes,
+		DependencyIndexes: file_rare_event_v1_rare_event_proto_depIdxs,
+		MessageInfos:      file_rare_event_v1_rare_event_proto_msgTypes,
+	}.Build()
+	File_rare_event_v1_rare_event_proto = out.File
+	file_rare_event_v1_rare_event_proto_goTypes = nil
+	file_rare_event_v1_rare_event_proto_depIdxs = nil
+}
@@ -0,0 +1,171 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.4
+// source: rare_event/v1/rare_event.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RareEventService_ListRareEvents_FullMethodName        = "/rare_event.v1.RareEventService/ListRareEvents"
+	RareEventService_ContributeToRareEvent_FullMethodName = "/rare_event.v1.RareEventService/ContributeToRareEvent"
+)
+
+// RareEventServiceClient is the client API for RareEventService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Rare events (meteors, treasure chests) spawn for a limited time in chunks players are
+// in. Characters next to one contribute to it; when its progress is complete the rewards
+// are mailed to every contributor, split by how much each contributed.
+type RareEventServiceClient interface {
+	// Lists the events in progress in a world
+	ListRareEvents(ctx context.Context, in *ListRareEventsRequest, opts ...grpc.CallOption) (*ListRareEventsResponse, error)
+	// Contributes to an event the character stands next to; limited to once per cooldown
+	ContributeToRareEvent(ctx context.Context, in *ContributeToRareEventRequest, opts ...grpc.CallOption) (*ContributeToRareEventResponse, error)
+}
+
+type rareEventServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRareEventServiceClient(cc grpc.ClientConnInterface) RareEventServiceClient {
+	return &rareEventServiceClient{cc}
+}
+
+func (c *rareEventServiceClient) ListRareEvents(ctx context.Context, in *ListRareEventsRequest, opts ...grpc.CallOption) (*ListRareEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRareEventsResponse)
+	err := c.cc.Invoke(ctx, RareEventService_ListRareEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rareEventServiceClient) ContributeToRareEvent(ctx context.Context, in *ContributeToRareEventRequest, opts ...grpc.CallOption) (*ContributeToRareEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ContributeToRareEventResponse)
+	err := c.cc.Invoke(ctx, RareEventService_ContributeToRareEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RareEventServiceServer is the server API for RareEventService service.
+// All implementations must embed UnimplementedRareEventServiceServer
+// for forward compatibility.
+//
+// Rare events (meteors, treasure chests) spawn for a limited time in chunks players are
+// in. Characters next to one contribute to it; when its progress is complete the rewards
+// are mailed to every contributor, split by how much each contributed.
+type RareEventServiceServer interface {
+	// Lists the events in progress in a world
+	ListRareEvents(context.Context, *ListRareEventsRequest) (*ListRareEventsResponse, error)
+	// Contributes to an event the character stands next to; limited to once per cooldown
+	ContributeToRareEvent(context.Context, *ContributeToRareEventRequest) (*ContributeToRareEventResponse, error)
+	mustEmbedUnimplementedRareEventServiceServer()
+}
+
+// UnimplementedRareEventServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRareEventServiceServer struct{}
+
+func (UnimplementedRareEventServiceServer) ListRareEvents(context.Context, *ListRareEventsRequest) (*ListRareEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRareEvents not implemented")
+}
+func (UnimplementedRareEventServiceServer) ContributeToRareEvent(context.Context, *ContributeToRareEventRequest) (*ContributeToRareEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContributeToRareEvent not implemented")
+}
+func (UnimplementedRareEventServiceServer) mustEmbedUnimplementedRareEventServiceServer() {}
+func (UnimplementedRareEventServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeRareEventServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RareEventServiceServer will
+// result in compilation errors.
+type UnsafeRareEventServiceServer interface {
+	mustEmbedUnimplementedRareEventServiceServer()
+}
+
+func RegisterRareEventServiceServer(s grpc.ServiceRegistrar, srv RareEventServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRareEventServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RareEventService_ServiceDesc, srv)
+}
+
+func _RareEventService_ListRareEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRareEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RareEventServiceServer).ListRareEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RareEventService_ListRareEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RareEventServiceServer).ListRareEvents(ctx, req.(*ListRareEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RareEventService_ContributeToRareEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContributeToRareEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RareEventServiceServer).ContributeToRareEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RareEventService_ContributeToRareEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RareEventServiceServer).ContributeToRareEvent(ctx, req.(*ContributeToRareEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RareEventService_ServiceDesc is the grpc.ServiceDesc for RareEventService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RareEventService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rare_event.v1.RareEventService",
+	HandlerType: (*RareEventServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListRareEvents",
+			Handler:    _RareEventService_ListRareEvents_Handler,
+		},
+		{
+			MethodName: "ContributeToRareEvent",
+			Handler:    _RareEventService_ContributeToRareEvent_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rare_event/v1/rare_event.proto",
+}
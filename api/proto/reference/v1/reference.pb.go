@@ -0,0 +1,429 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.4
+// source: reference/v1/reference.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ResolveReferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterIds  []string               `protobuf:"bytes,1,rep,name=character_ids,json=characterIds,proto3" json:"character_ids,omitempty"`
+	ItemIds       []int32                `protobuf:"varint,2,rep,packed,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`
+	WorldIds      []string               `protobuf:"bytes,3,rep,name=world_ids,json=worldIds,proto3" json:"world_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveReferencesRequest) Reset() {
+	*x = ResolveReferencesRequest{}
+	mi := &file_reference_v1_reference_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveReferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveReferencesRequest) ProtoMessage() {}
+
+func (x *ResolveReferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reference_v1_reference_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveReferencesRequest.ProtoReflect.Descriptor instead.
+func (*ResolveReferencesRequest) Descriptor() ([]byte, []int) {
+	return file_reference_v1_reference_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ResolveReferencesRequest) GetCharacterIds() []string {
+	if x != nil {
+		return x.CharacterIds
+	}
+	return nil
+}
+
+func (x *ResolveReferencesRequest) GetItemIds() []int32 {
+	if x != nil {
+		return x.ItemIds
+	}
+	return nil
+}
+
+func (x *ResolveReferencesRequest) GetWorldIds() []string {
+	if x != nil {
+		return x.WorldIds
+	}
+	return nil
+}
+
+type CharacterSummary struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name    string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                      // Empty when redacted
+	WorldId string                 `protobuf:"bytes,3,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"` // Empty when redacted
+	// Characters of users the caller has blocked or been blocked by, and characters in
+	// other worlds than the caller's session, are redacted
+	Redacted      bool   `protobuf:"varint,4,opt,name=redacted,proto3" json:"redacted,omitempty"`
+	Title         string `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"` // Display name of the equipped title; empty when none or redacted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CharacterSummary) Reset() {
+	*x = CharacterSummary{}
+	mi := &file_reference_v1_reference_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CharacterSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CharacterSummary) ProtoMessage() {}
+
+func (x *CharacterSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_reference_v1_reference_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CharacterSummary.ProtoReflect.Descriptor instead.
+func (*CharacterSummary) Descriptor() ([]byte, []int) {
+	return file_reference_v1_reference_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CharacterSummary) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CharacterSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CharacterSummary) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *CharacterSummary) GetRedacted() bool {
+	if x != nil {
+		return x.Redacted
+	}
+	return false
+}
+
+func (x *CharacterSummary) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type ItemSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ItemType      string                 `protobuf:"bytes,3,opt,name=item_type,json=itemType,proto3" json:"item_type,omitempty"`
+	Rarity        string                 `protobuf:"bytes,4,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemSummary) Reset() {
+	*x = ItemSummary{}
+	mi := &file_reference_v1_reference_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemSummary) ProtoMessage() {}
+
+func (x *ItemSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_reference_v1_reference_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemSummary.ProtoReflect.Descriptor instead.
+func (*ItemSummary) Descriptor() ([]byte, []int) {
+	return file_reference_v1_reference_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ItemSummary) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ItemSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ItemSummary) GetItemType() string {
+	if x != nil {
+		return x.ItemType
+	}
+	return ""
+}
+
+func (x *ItemSummary) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+type WorldSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorldSummary) Reset() {
+	*x = WorldSummary{}
+	mi := &file_reference_v1_reference_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorldSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorldSummary) ProtoMessage() {}
+
+func (x *WorldSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_reference_v1_reference_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorldSummary.ProtoReflect.Descriptor instead.
+func (*WorldSummary) Descriptor() ([]byte, []int) {
+	return file_reference_v1_reference_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WorldSummary) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WorldSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ResolveReferencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Characters    []*CharacterSummary    `protobuf:"bytes,1,rep,name=characters,proto3" json:"characters,omitempty"`
+	Items         []*ItemSummary         `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Worlds        []*WorldSummary        `protobuf:"bytes,3,rep,name=worlds,proto3" json:"worlds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveReferencesResponse) Reset() {
+	*x = ResolveReferencesResponse{}
+	mi := &file_reference_v1_reference_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveReferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveReferencesResponse) ProtoMessage() {}
+
+func (x *ResolveReferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reference_v1_reference_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveReferencesResponse.ProtoReflect.Descriptor instead.
+func (*ResolveReferencesResponse) Descriptor() ([]byte, []int) {
+	return file_reference_v1_reference_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ResolveReferencesResponse) GetCharacters() []*CharacterSummary {
+	if x != nil {
+		return x.Characters
+	}
+	return nil
+}
+
+func (x *ResolveReferencesResponse) GetItems() []*ItemSummary {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ResolveReferencesResponse) GetWorlds() []*WorldSummary {
+	if x != nil {
+		return x.Worlds
+	}
+	return nil
+}
+
+var File_reference_v1_reference_proto protoreflect.FileDescriptor
+
+const file_reference_v1_reference_proto_rawDesc = "" +
+	"\n" +
+	"\x1creference/v1/reference.proto\x12\freference.v1\"w\n" +
+	"\x18ResolveReferencesRequest\x12#\n" +
+	"\rcharacter_ids\x18\x01 \x03(\tR\fcharacterIds\x12\x19\n" +
+	"\bitem_ids\x18\x02 \x03(\x05R\aitemIds\x12\x1b\n" +
+	"\tworld_ids\x18\x03 \x03(\tR\bworldIds\"\x83\x01\n" +
+	"\x10CharacterSummary\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
+	"\bworld_id\x18\x03 \x01(\tR\aworldId\x12\x1a\n" +
+	"\bredacted\x18\x04 \x01(\bR\bredacted\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\"f\n" +
+	"\vItemSummary\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\titem_type\x18\x03 \x01(\tR\bitemType\x12\x16\n" +
+	"\x06rarity\x18\x04 \x01(\tR\x06rarity\"2\n" +
+	"\fWorldSummary\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xc0\x01\n" +
+	"\x19ResolveReferencesResponse\x12>\n" +
+	"\n" +
+	"characters\x18\x01 \x03(\v2\x1e.reference.v1.CharacterSummaryR\n" +
+	"characters\x12/\n" +
+	"\x05items\x18\x02 \x03(\v2\x19.reference.v1.ItemSummaryR\x05items\x122\n" +
+	"\x06worlds\x18\x03 \x03(\v2\x1a.reference.v1.WorldSummaryR\x06worlds2z\n" +
+	"\x10ReferenceService\x12f\n" +
+	"\x11ResolveReferences\x12&.reference.v1.ResolveReferencesRequest\x1a'.reference.v1.ResolveReferencesResponse\"\x00B0Z.github.com/VoidMesh/api/api/proto/reference/v1b\x06proto3"
+
+var (
+	file_reference_v1_reference_proto_rawDescOnce sync.Once
+	file_reference_v1_reference_proto_rawDescData []byte
+)
+
+func file_reference_v1_reference_proto_rawDescGZIP() []byte {
+	file_reference_v1_reference_proto_rawDescOnce.Do(func() {
+		file_reference_v1_reference_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_reference_v1_reference_proto_rawDesc), len(file_reference_v1_reference_proto_rawDesc)))
+	})
+	return file_reference_v1_reference_proto_rawDescData
+}
+
+var file_reference_v1_reference_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_reference_v1_reference_proto_goTypes = []any{
+	(*ResolveReferencesRequest)(nil),  // 0: reference.v1.ResolveReferencesRequest
+	(*CharacterSummary)(nil),          // 1: reference.v1.CharacterSummary
+	(*ItemSummary)(nil),               // 2: reference.v1.ItemSummary
+	(*WorldSummary)(nil),              // 3: reference.v1.WorldSummary
+	(*ResolveReferencesResponse)(nil), // 4: reference.v1.ResolveReferencesResponse
+}
+var file_reference_v1_reference_proto_depIdxs = []int32{
+	1, // 0: reference.v1.ResolveReferencesResponse.characters:type_name -> reference.v1.CharacterSummary
+	2, // 1: reference.v1.ResolveReferencesResponse.items:type_name -> reference.v1.ItemSummary
+	3, // 2: reference.v1.ResolveReferencesResponse.worlds:type_name -> reference.v1.WorldSummary
+	0, // 3: reference.v1.ReferenceService.ResolveReferences:input_type -> reference.v1.ResolveReferencesRequest
+	4, // 4: reference.v1.ReferenceService.ResolveReferences:output_type -> reference.v1.ResolveReferencesResponse
+	4, // [4:5] is the sub-list for method output_type
+	3, // [3:4] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_reference_v1_reference_proto_init() }
+func file_reference_v1_reference_proto_init() {
+	if File_reference_v1_reference_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_reference_v1_reference_proto_rawDesc), len(file_reference_v1_reference_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_reference_v1_reference_proto_goTypes,
+		DependencyIndexes: file_reference_v1_reference_proto_depIdxs,
+		MessageInfos:      file_reference_v1_reference_proto_msgTypes,
+	}.Build()
+	File_reference_v1_reference_proto = out.File
+	file_reference_v1_reference_proto_goTypes = nil
+	file_reference_v1_reference_proto_depIdxs = nil
+}
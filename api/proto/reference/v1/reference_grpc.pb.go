@@ -0,0 +1,133 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.4
+// source: reference/v1/reference.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ReferenceService_ResolveReferences_FullMethodName = "/reference.v1.ReferenceService/ResolveReferences"
+)
+
+// ReferenceServiceClient is the client API for ReferenceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Resolves the raw IDs clients receive in streams and events to display summaries in
+// one call, instead of one lookup per ID.
+type ReferenceServiceClient interface {
+	// Summaries of the requested characters, items and worlds. Unknown IDs are left out
+	// of the response. Renamed characters resolve to their new name once the rename's
+	// event has been dispatched.
+	ResolveReferences(ctx context.Context, in *ResolveReferencesRequest, opts ...grpc.CallOption) (*ResolveReferencesResponse, error)
+}
+
+type referenceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReferenceServiceClient(cc grpc.ClientConnInterface) ReferenceServiceClient {
+	return &referenceServiceClient{cc}
+}
+
+func (c *referenceServiceClient) ResolveReferences(ctx context.Context, in *ResolveReferencesRequest, opts ...grpc.CallOption) (*ResolveReferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveReferencesResponse)
+	err := c.cc.Invoke(ctx, ReferenceService_ResolveReferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReferenceServiceServer is the server API for ReferenceService service.
+// All implementations must embed UnimplementedReferenceServiceServer
+// for forward compatibility.
+//
+// Resolves the raw IDs clients receive in streams and events to display summaries in
+// one call, instead of one lookup per ID.
+type ReferenceServiceServer interface {
+	// Summaries of the requested characters, items and worlds. Unknown IDs are left out
+	// of the response. Renamed characters resolve to their new name once the rename's
+	// event has been dispatched.
+	ResolveReferences(context.Context, *ResolveReferencesRequest) (*ResolveReferencesResponse, error)
+	mustEmbedUnimplementedReferenceServiceServer()
+}
+
+// UnimplementedReferenceServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedReferenceServiceServer struct{}
+
+func (UnimplementedReferenceServiceServer) ResolveReferences(context.Context, *ResolveReferencesRequest) (*ResolveReferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveReferences not implemented")
+}
+func (UnimplementedReferenceServiceServer) mustEmbedUnimplementedReferenceServiceServer() {}
+func (UnimplementedReferenceServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeReferenceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReferenceServiceServer will
+// result in compilation errors.
+type UnsafeReferenceServiceServer interface {
+	mustEmbedUnimplementedReferenceServiceServer()
+}
+
+func RegisterReferenceServiceServer(s grpc.ServiceRegistrar, srv ReferenceServiceServer) {
+	// If the following call pancis, it indicates UnimplementedReferenceServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ReferenceService_ServiceDesc, srv)
+}
+
+func _ReferenceService_ResolveReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveReferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReferenceServiceServer).ResolveReferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReferenceService_ResolveReferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReferenceServiceServer).ResolveReferences(ctx, req.(*ResolveReferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ReferenceService_ServiceDesc is the grpc.ServiceDesc for ReferenceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReferenceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "reference.v1.ReferenceService",
+	HandlerType: (*ReferenceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ResolveReferences",
+			Handler:    _ReferenceService_ResolveReferences_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "reference/v1/reference.proto",
+}
@@ -78,6 +78,59 @@ func (ResourceRarity) EnumDescriptor() ([]byte, []int) {
 	return file_resource_node_v1_resource_node_proto_rawDescGZIP(), []int{0}
 }
 
+// Resource node quality tiers; the tier scales harvest yields from the node
+type ResourceNodeQuality int32
+
+const (
+	ResourceNodeQuality_RESOURCE_NODE_QUALITY_UNSPECIFIED ResourceNodeQuality = 0
+	ResourceNodeQuality_RESOURCE_NODE_QUALITY_POOR        ResourceNodeQuality = 1
+	ResourceNodeQuality_RESOURCE_NODE_QUALITY_NORMAL      ResourceNodeQuality = 2
+	ResourceNodeQuality_RESOURCE_NODE_QUALITY_RICH        ResourceNodeQuality = 3
+)
+
+// Enum value maps for ResourceNodeQuality.
+var (
+	ResourceNodeQuality_name = map[int32]string{
+		0: "RESOURCE_NODE_QUALITY_UNSPECIFIED",
+		1: "RESOURCE_NODE_QUALITY_POOR",
+		2: "RESOURCE_NODE_QUALITY_NORMAL",
+		3: "RESOURCE_NODE_QUALITY_RICH",
+	}
+	ResourceNodeQuality_value = map[string]int32{
+		"RESOURCE_NODE_QUALITY_UNSPECIFIED": 0,
+		"RESOURCE_NODE_QUALITY_POOR":        1,
+		"RESOURCE_NODE_QUALITY_NORMAL":      2,
+		"RESOURCE_NODE_QUALITY_RICH":        3,
+	}
+)
+
+func (x ResourceNodeQuality) Enum() *ResourceNodeQuality {
+	p := new(ResourceNodeQuality)
+	*p = x
+	return p
+}
+
+func (x ResourceNodeQuality) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResourceNodeQuality) Descriptor() protoreflect.EnumDescriptor {
+	return file_resource_node_v1_resource_node_proto_enumTypes[1].Descriptor()
+}
+
+func (ResourceNodeQuality) Type() protoreflect.EnumType {
+	return &file_resource_node_v1_resource_node_proto_enumTypes[1]
+}
+
+func (x ResourceNodeQuality) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResourceNodeQuality.Descriptor instead.
+func (ResourceNodeQuality) EnumDescriptor() ([]byte, []int) {
+	return file_resource_node_v1_resource_node_proto_rawDescGZIP(), []int{1}
+}
+
 // Resource node type identifiers
 type ResourceNodeTypeId int32
 
@@ -155,11 +208,11 @@ func (x ResourceNodeTypeId) String() string {
 }
 
 func (ResourceNodeTypeId) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_node_v1_resource_node_proto_enumTypes[1].Descriptor()
+	return file_resource_node_v1_resource_node_proto_enumTypes[2].Descriptor()
 }
 
 func (ResourceNodeTypeId) Type() protoreflect.EnumType {
-	return &file_resource_node_v1_resource_node_proto_enumTypes[1]
+	return &file_resource_node_v1_resource_node_proto_enumTypes[2]
 }
 
 func (x ResourceNodeTypeId) Number() protoreflect.EnumNumber {
@@ -168,7 +221,7 @@ func (x ResourceNodeTypeId) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ResourceNodeTypeId.Descriptor instead.
 func (ResourceNodeTypeId) EnumDescriptor() ([]byte, []int) {
-	return file_resource_node_v1_resource_node_proto_rawDescGZIP(), []int{1}
+	return file_resource_node_v1_resource_node_proto_rawDescGZIP(), []int{2}
 }
 
 // Resource node secondary drop information
@@ -476,6 +529,7 @@ type ResourceNode struct {
 	ClusterId          string                 `protobuf:"bytes,8,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
 	Size               int32                  `protobuf:"varint,9,opt,name=size,proto3" json:"size,omitempty"`
 	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Quality            ResourceNodeQuality    `protobuf:"varint,11,opt,name=quality,proto3,enum=resource_node.v1.ResourceNodeQuality" json:"quality,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -580,6 +634,13 @@ func (x *ResourceNode) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *ResourceNode) GetQuality() ResourceNodeQuality {
+	if x != nil {
+		return x.Quality
+	}
+	return ResourceNodeQuality_RESOURCE_NODE_QUALITY_UNSPECIFIED
+}
+
 // Request to get resource nodes in a specific chunk
 type GetResourcesInChunkRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -919,6 +980,421 @@ func (x *GetResourceNodeTypesResponse) GetResourceNodeTypes() []*ResourceNodeTyp
 	return nil
 }
 
+// Image holding many sprites
+type SpriteSheet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Image         string                 `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`  // File name of the image
+	Width         int32                  `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"` // In pixels
+	Height        int32                  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Url           string                 `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"` // Where the image is served over HTTP; empty when the server doesn't serve it
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpriteSheet) Reset() {
+	*x = SpriteSheet{}
+	mi := &file_resource_node_v1_resource_node_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpriteSheet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpriteSheet) ProtoMessage() {}
+
+func (x *SpriteSheet) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_node_v1_resource_node_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpriteSheet.ProtoReflect.Descriptor instead.
+func (*SpriteSheet) Descriptor() ([]byte, []int) {
+	return file_resource_node_v1_resource_node_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SpriteSheet) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SpriteSheet) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *SpriteSheet) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *SpriteSheet) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *SpriteSheet) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// A sprite's place in its sheet. Animated sprites have frames of the same size laid out
+// left to right from x, y.
+type SpriteFrame struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`   // Matches ResourceVisual.sprite
+	Sheet           string                 `protobuf:"bytes,2,opt,name=sheet,proto3" json:"sheet,omitempty"` // SpriteSheet.name
+	X               int32                  `protobuf:"varint,3,opt,name=x,proto3" json:"x,omitempty"`        // Pixel offset of the first frame in the sheet
+	Y               int32                  `protobuf:"varint,4,opt,name=y,proto3" json:"y,omitempty"`
+	Width           int32                  `protobuf:"varint,5,opt,name=width,proto3" json:"width,omitempty"` // Size of one frame in pixels
+	Height          int32                  `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	Frames          int32                  `protobuf:"varint,7,opt,name=frames,proto3" json:"frames,omitempty"`                                            // At least 1
+	FrameDurationMs int32                  `protobuf:"varint,8,opt,name=frame_duration_ms,json=frameDurationMs,proto3" json:"frame_duration_ms,omitempty"` // Time each frame shows; 0 for still sprites
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SpriteFrame) Reset() {
+	*x = SpriteFrame{}
+	mi := &file_resource_node_v1_resource_node_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpriteFrame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpriteFrame) ProtoMessage() {}
+
+func (x *SpriteFrame) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_node_v1_resource_node_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpriteFrame.ProtoReflect.Descriptor instead.
+func (*SpriteFrame) Descriptor() ([]byte, []int) {
+	return file_resource_node_v1_resource_node_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SpriteFrame) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SpriteFrame) GetSheet() string {
+	if x != nil {
+		return x.Sheet
+	}
+	return ""
+}
+
+func (x *SpriteFrame) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *SpriteFrame) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *SpriteFrame) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *SpriteFrame) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *SpriteFrame) GetFrames() int32 {
+	if x != nil {
+		return x.Frames
+	}
+	return 0
+}
+
+func (x *SpriteFrame) GetFrameDurationMs() int32 {
+	if x != nil {
+		return x.FrameDurationMs
+	}
+	return 0
+}
+
+// Request for the sprite atlas
+type GetSpriteAtlasRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KnownChecksum string                 `protobuf:"bytes,1,opt,name=known_checksum,json=knownChecksum,proto3" json:"known_checksum,omitempty"` // Checksum of the atlas the client has; an unchanged atlas is not sent again
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSpriteAtlasRequest) Reset() {
+	*x = GetSpriteAtlasRequest{}
+	mi := &file_resource_node_v1_resource_node_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSpriteAtlasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSpriteAtlasRequest) ProtoMessage() {}
+
+func (x *GetSpriteAtlasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_node_v1_resource_node_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSpriteAtlasRequest.ProtoReflect.Descriptor instead.
+func (*GetSpriteAtlasRequest) Descriptor() ([]byte, []int) {
+	return file_resource_node_v1_resource_node_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetSpriteAtlasRequest) GetKnownChecksum() string {
+	if x != nil {
+		return x.KnownChecksum
+	}
+	return ""
+}
+
+// Response with the sprite atlas metadata
+type GetSpriteAtlasResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`                            // Version declared in the atlas file
+	Checksum      string                 `protobuf:"bytes,2,opt,name=checksum,proto3" json:"checksum,omitempty"`                           // SHA-256 of the atlas file and its sheet images
+	NotModified   bool                   `protobuf:"varint,3,opt,name=not_modified,json=notModified,proto3" json:"not_modified,omitempty"` // The client's atlas is current; sheets and sprites are empty
+	Sheets        []*SpriteSheet         `protobuf:"bytes,4,rep,name=sheets,proto3" json:"sheets,omitempty"`
+	Sprites       []*SpriteFrame         `protobuf:"bytes,5,rep,name=sprites,proto3" json:"sprites,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSpriteAtlasResponse) Reset() {
+	*x = GetSpriteAtlasResponse{}
+	mi := &file_resource_node_v1_resource_node_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSpriteAtlasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSpriteAtlasResponse) ProtoMessage() {}
+
+func (x *GetSpriteAtlasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_node_v1_resource_node_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSpriteAtlasResponse.ProtoReflect.Descriptor instead.
+func (*GetSpriteAtlasResponse) Descriptor() ([]byte, []int) {
+	return file_resource_node_v1_resource_node_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetSpriteAtlasResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GetSpriteAtlasResponse) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+func (x *GetSpriteAtlasResponse) GetNotModified() bool {
+	if x != nil {
+		return x.NotModified
+	}
+	return false
+}
+
+func (x *GetSpriteAtlasResponse) GetSheets() []*SpriteSheet {
+	if x != nil {
+		return x.Sheets
+	}
+	return nil
+}
+
+func (x *GetSpriteAtlasResponse) GetSprites() []*SpriteFrame {
+	if x != nil {
+		return x.Sprites
+	}
+	return nil
+}
+
+// Request to reload the balance configuration from its data file (admin only)
+type ReloadBalanceConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReloadBalanceConfigRequest) Reset() {
+	*x = ReloadBalanceConfigRequest{}
+	mi := &file_resource_node_v1_resource_node_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReloadBalanceConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadBalanceConfigRequest) ProtoMessage() {}
+
+func (x *ReloadBalanceConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_node_v1_resource_node_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadBalanceConfigRequest.ProtoReflect.Descriptor instead.
+func (*ReloadBalanceConfigRequest) Descriptor() ([]byte, []int) {
+	return file_resource_node_v1_resource_node_proto_rawDescGZIP(), []int{16}
+}
+
+// Response describing the balance configuration now in effect
+type ReloadBalanceConfigResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Version           int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`  // Version declared in the data file
+	Checksum          string                 `protobuf:"bytes,2,opt,name=checksum,proto3" json:"checksum,omitempty"` // SHA-256 of the data file contents
+	Source            string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`     // File path the configuration was read from
+	ResourceTypeCount int32                  `protobuf:"varint,4,opt,name=resource_type_count,json=resourceTypeCount,proto3" json:"resource_type_count,omitempty"`
+	LoadedAt          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=loaded_at,json=loadedAt,proto3" json:"loaded_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ReloadBalanceConfigResponse) Reset() {
+	*x = ReloadBalanceConfigResponse{}
+	mi := &file_resource_node_v1_resource_node_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReloadBalanceConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadBalanceConfigResponse) ProtoMessage() {}
+
+func (x *ReloadBalanceConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_node_v1_resource_node_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadBalanceConfigResponse.ProtoReflect.Descriptor instead.
+func (*ReloadBalanceConfigResponse) Descriptor() ([]byte, []int) {
+	return file_resource_node_v1_resource_node_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ReloadBalanceConfigResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ReloadBalanceConfigResponse) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+func (x *ReloadBalanceConfigResponse) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ReloadBalanceConfigResponse) GetResourceTypeCount() int32 {
+	if x != nil {
+		return x.ResourceTypeCount
+	}
+	return 0
+}
+
+func (x *ReloadBalanceConfigResponse) GetLoadedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LoadedAt
+	}
+	return nil
+}
+
 var File_resource_node_v1_resource_node_proto protoreflect.FileDescriptor
 
 const file_resource_node_v1_resource_node_proto_rawDesc = "" +
@@ -950,7 +1426,7 @@ const file_resource_node_v1_resource_node_proto_rawDesc = "" +
 	"visualData\x12D\n" +
 	"\n" +
 	"properties\x18\a \x01(\v2$.resource_node.v1.ResourcePropertiesR\n" +
-	"properties\"\x85\x03\n" +
+	"properties\"\xc6\x03\n" +
 	"\fResourceNode\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12W\n" +
 	"\x15resource_node_type_id\x18\x02 \x01(\x0e2$.resource_node.v1.ResourceNodeTypeIdR\x12resourceNodeTypeId\x12P\n" +
@@ -964,7 +1440,8 @@ const file_resource_node_v1_resource_node_proto_rawDesc = "" +
 	"\x04size\x18\t \x01(\x05R\x04size\x129\n" +
 	"\n" +
 	"created_at\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"i\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12?\n" +
+	"\aquality\x18\v \x01(\x0e2%.resource_node.v1.ResourceNodeQualityR\aquality\"i\n" +
 	"\x1aGetResourcesInChunkRequest\x12\x19\n" +
 	"\bworld_id\x18\x01 \x01(\fR\aworldId\x12\x17\n" +
 	"\achunk_x\x18\x02 \x01(\x05R\x06chunkX\x12\x17\n" +
@@ -981,13 +1458,48 @@ const file_resource_node_v1_resource_node_proto_rawDesc = "" +
 	"\tresources\x18\x01 \x03(\v2\x1e.resource_node.v1.ResourceNodeR\tresources\"\x1d\n" +
 	"\x1bGetResourceNodeTypesRequest\"r\n" +
 	"\x1cGetResourceNodeTypesResponse\x12R\n" +
-	"\x13resource_node_types\x18\x01 \x03(\v2\".resource_node.v1.ResourceNodeTypeR\x11resourceNodeTypes*\xa4\x01\n" +
+	"\x13resource_node_types\x18\x01 \x03(\v2\".resource_node.v1.ResourceNodeTypeR\x11resourceNodeTypes\"w\n" +
+	"\vSpriteSheet\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05image\x18\x02 \x01(\tR\x05image\x12\x14\n" +
+	"\x05width\x18\x03 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x04 \x01(\x05R\x06height\x12\x10\n" +
+	"\x03url\x18\x05 \x01(\tR\x03url\"\xc5\x01\n" +
+	"\vSpriteFrame\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05sheet\x18\x02 \x01(\tR\x05sheet\x12\f\n" +
+	"\x01x\x18\x03 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x04 \x01(\x05R\x01y\x12\x14\n" +
+	"\x05width\x18\x05 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x06 \x01(\x05R\x06height\x12\x16\n" +
+	"\x06frames\x18\a \x01(\x05R\x06frames\x12*\n" +
+	"\x11frame_duration_ms\x18\b \x01(\x05R\x0fframeDurationMs\">\n" +
+	"\x15GetSpriteAtlasRequest\x12%\n" +
+	"\x0eknown_checksum\x18\x01 \x01(\tR\rknownChecksum\"\xe1\x01\n" +
+	"\x16GetSpriteAtlasResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12\x1a\n" +
+	"\bchecksum\x18\x02 \x01(\tR\bchecksum\x12!\n" +
+	"\fnot_modified\x18\x03 \x01(\bR\vnotModified\x125\n" +
+	"\x06sheets\x18\x04 \x03(\v2\x1d.resource_node.v1.SpriteSheetR\x06sheets\x127\n" +
+	"\asprites\x18\x05 \x03(\v2\x1d.resource_node.v1.SpriteFrameR\asprites\"\x1c\n" +
+	"\x1aReloadBalanceConfigRequest\"\xd4\x01\n" +
+	"\x1bReloadBalanceConfigResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12\x1a\n" +
+	"\bchecksum\x18\x02 \x01(\tR\bchecksum\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x12.\n" +
+	"\x13resource_type_count\x18\x04 \x01(\x05R\x11resourceTypeCount\x127\n" +
+	"\tloaded_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bloadedAt*\xa4\x01\n" +
 	"\x0eResourceRarity\x12\x1f\n" +
 	"\x1bRESOURCE_RARITY_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16RESOURCE_RARITY_COMMON\x10\x01\x12\x1c\n" +
 	"\x18RESOURCE_RARITY_UNCOMMON\x10\x02\x12\x18\n" +
 	"\x14RESOURCE_RARITY_RARE\x10\x03\x12\x1d\n" +
-	"\x19RESOURCE_RARITY_VERY_RARE\x10\x04*\x9e\x05\n" +
+	"\x19RESOURCE_RARITY_VERY_RARE\x10\x04*\x9e\x01\n" +
+	"\x13ResourceNodeQuality\x12%\n" +
+	"!RESOURCE_NODE_QUALITY_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aRESOURCE_NODE_QUALITY_POOR\x10\x01\x12 \n" +
+	"\x1cRESOURCE_NODE_QUALITY_NORMAL\x10\x02\x12\x1e\n" +
+	"\x1aRESOURCE_NODE_QUALITY_RICH\x10\x03*\x9e\x05\n" +
 	"\x12ResourceNodeTypeId\x12%\n" +
 	"!RESOURCE_NODE_TYPE_ID_UNSPECIFIED\x10\x00\x12$\n" +
 	" RESOURCE_NODE_TYPE_ID_HERB_PATCH\x10\x01\x12$\n" +
@@ -1005,11 +1517,13 @@ const file_resource_node_v1_resource_node_proto_rawDesc = "" +
 	"%RESOURCE_NODE_TYPE_ID_WILD_HONEY_HIVE\x10\f\x12$\n" +
 	" RESOURCE_NODE_TYPE_ID_STONE_VEIN\x10\r\x12%\n" +
 	"!RESOURCE_NODE_TYPE_ID_GEM_DEPOSIT\x10\x0e\x12#\n" +
-	"\x1fRESOURCE_NODE_TYPE_ID_METAL_ORE\x10\x0f2\xfd\x02\n" +
+	"\x1fRESOURCE_NODE_TYPE_ID_METAL_ORE\x10\x0f2\xda\x04\n" +
 	"\x13ResourceNodeService\x12t\n" +
 	"\x13GetResourcesInChunk\x12,.resource_node.v1.GetResourcesInChunkRequest\x1a-.resource_node.v1.GetResourcesInChunkResponse\"\x00\x12w\n" +
 	"\x14GetResourcesInChunks\x12-.resource_node.v1.GetResourcesInChunksRequest\x1a..resource_node.v1.GetResourcesInChunksResponse\"\x00\x12w\n" +
-	"\x14GetResourceNodeTypes\x12-.resource_node.v1.GetResourceNodeTypesRequest\x1a..resource_node.v1.GetResourceNodeTypesResponse\"\x00B4Z2github.com/VoidMesh/api/api/proto/resource_node/v1b\x06proto3"
+	"\x14GetResourceNodeTypes\x12-.resource_node.v1.GetResourceNodeTypesRequest\x1a..resource_node.v1.GetResourceNodeTypesResponse\"\x00\x12e\n" +
+	"\x0eGetSpriteAtlas\x12'.resource_node.v1.GetSpriteAtlasRequest\x1a(.resource_node.v1.GetSpriteAtlasResponse\"\x00\x12t\n" +
+	"\x13ReloadBalanceConfig\x12,.resource_node.v1.ReloadBalanceConfigRequest\x1a-.resource_node.v1.ReloadBalanceConfigResponse\"\x00B4Z2github.com/VoidMesh/api/api/proto/resource_node/v1b\x06proto3"
 
 var (
 	file_resource_node_v1_resource_node_proto_rawDescOnce sync.Once
@@ -1023,48 +1537,63 @@ func file_resource_node_v1_resource_node_proto_rawDescGZIP() []byte {
 	return file_resource_node_v1_resource_node_proto_rawDescData
 }
 
-var file_resource_node_v1_resource_node_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_resource_node_v1_resource_node_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_resource_node_v1_resource_node_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_resource_node_v1_resource_node_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_resource_node_v1_resource_node_proto_goTypes = []any{
 	(ResourceRarity)(0),                  // 0: resource_node.v1.ResourceRarity
-	(ResourceNodeTypeId)(0),              // 1: resource_node.v1.ResourceNodeTypeId
-	(*SecondaryDrop)(nil),                // 2: resource_node.v1.SecondaryDrop
-	(*ResourceProperties)(nil),           // 3: resource_node.v1.ResourceProperties
-	(*ResourceVisual)(nil),               // 4: resource_node.v1.ResourceVisual
-	(*ResourceNodeType)(nil),             // 5: resource_node.v1.ResourceNodeType
-	(*ResourceNode)(nil),                 // 6: resource_node.v1.ResourceNode
-	(*GetResourcesInChunkRequest)(nil),   // 7: resource_node.v1.GetResourcesInChunkRequest
-	(*GetResourcesInChunkResponse)(nil),  // 8: resource_node.v1.GetResourcesInChunkResponse
-	(*GetResourcesInChunksRequest)(nil),  // 9: resource_node.v1.GetResourcesInChunksRequest
-	(*ChunkCoordinate)(nil),              // 10: resource_node.v1.ChunkCoordinate
-	(*GetResourcesInChunksResponse)(nil), // 11: resource_node.v1.GetResourcesInChunksResponse
-	(*GetResourceNodeTypesRequest)(nil),  // 12: resource_node.v1.GetResourceNodeTypesRequest
-	(*GetResourceNodeTypesResponse)(nil), // 13: resource_node.v1.GetResourceNodeTypesResponse
-	(*timestamppb.Timestamp)(nil),        // 14: google.protobuf.Timestamp
+	(ResourceNodeQuality)(0),             // 1: resource_node.v1.ResourceNodeQuality
+	(ResourceNodeTypeId)(0),              // 2: resource_node.v1.ResourceNodeTypeId
+	(*SecondaryDrop)(nil),                // 3: resource_node.v1.SecondaryDrop
+	(*ResourceProperties)(nil),           // 4: resource_node.v1.ResourceProperties
+	(*ResourceVisual)(nil),               // 5: resource_node.v1.ResourceVisual
+	(*ResourceNodeType)(nil),             // 6: resource_node.v1.ResourceNodeType
+	(*ResourceNode)(nil),                 // 7: resource_node.v1.ResourceNode
+	(*GetResourcesInChunkRequest)(nil),   // 8: resource_node.v1.GetResourcesInChunkRequest
+	(*GetResourcesInChunkResponse)(nil),  // 9: resource_node.v1.GetResourcesInChunkResponse
+	(*GetResourcesInChunksRequest)(nil),  // 10: resource_node.v1.GetResourcesInChunksRequest
+	(*ChunkCoordinate)(nil),              // 11: resource_node.v1.ChunkCoordinate
+	(*GetResourcesInChunksResponse)(nil), // 12: resource_node.v1.GetResourcesInChunksResponse
+	(*GetResourceNodeTypesRequest)(nil),  // 13: resource_node.v1.GetResourceNodeTypesRequest
+	(*GetResourceNodeTypesResponse)(nil), // 14: resource_node.v1.GetResourceNodeTypesResponse
+	(*SpriteSheet)(nil),                  // 15: resource_node.v1.SpriteSheet
+	(*SpriteFrame)(nil),                  // 16: resource_node.v1.SpriteFrame
+	(*GetSpriteAtlasRequest)(nil),        // 17: resource_node.v1.GetSpriteAtlasRequest
+	(*GetSpriteAtlasResponse)(nil),       // 18: resource_node.v1.GetSpriteAtlasResponse
+	(*ReloadBalanceConfigRequest)(nil),   // 19: resource_node.v1.ReloadBalanceConfigRequest
+	(*ReloadBalanceConfigResponse)(nil),  // 20: resource_node.v1.ReloadBalanceConfigResponse
+	(*timestamppb.Timestamp)(nil),        // 21: google.protobuf.Timestamp
 }
 var file_resource_node_v1_resource_node_proto_depIdxs = []int32{
-	2,  // 0: resource_node.v1.ResourceProperties.secondary_drops:type_name -> resource_node.v1.SecondaryDrop
+	3,  // 0: resource_node.v1.ResourceProperties.secondary_drops:type_name -> resource_node.v1.SecondaryDrop
 	0,  // 1: resource_node.v1.ResourceNodeType.rarity:type_name -> resource_node.v1.ResourceRarity
-	4,  // 2: resource_node.v1.ResourceNodeType.visual_data:type_name -> resource_node.v1.ResourceVisual
-	3,  // 3: resource_node.v1.ResourceNodeType.properties:type_name -> resource_node.v1.ResourceProperties
-	1,  // 4: resource_node.v1.ResourceNode.resource_node_type_id:type_name -> resource_node.v1.ResourceNodeTypeId
-	5,  // 5: resource_node.v1.ResourceNode.resource_node_type:type_name -> resource_node.v1.ResourceNodeType
-	14, // 6: resource_node.v1.ResourceNode.created_at:type_name -> google.protobuf.Timestamp
-	6,  // 7: resource_node.v1.GetResourcesInChunkResponse.resources:type_name -> resource_node.v1.ResourceNode
-	10, // 8: resource_node.v1.GetResourcesInChunksRequest.coordinates:type_name -> resource_node.v1.ChunkCoordinate
-	6,  // 9: resource_node.v1.GetResourcesInChunksResponse.resources:type_name -> resource_node.v1.ResourceNode
-	5,  // 10: resource_node.v1.GetResourceNodeTypesResponse.resource_node_types:type_name -> resource_node.v1.ResourceNodeType
-	7,  // 11: resource_node.v1.ResourceNodeService.GetResourcesInChunk:input_type -> resource_node.v1.GetResourcesInChunkRequest
-	9,  // 12: resource_node.v1.ResourceNodeService.GetResourcesInChunks:input_type -> resource_node.v1.GetResourcesInChunksRequest
-	12, // 13: resource_node.v1.ResourceNodeService.GetResourceNodeTypes:input_type -> resource_node.v1.GetResourceNodeTypesRequest
-	8,  // 14: resource_node.v1.ResourceNodeService.GetResourcesInChunk:output_type -> resource_node.v1.GetResourcesInChunkResponse
-	11, // 15: resource_node.v1.ResourceNodeService.GetResourcesInChunks:output_type -> resource_node.v1.GetResourcesInChunksResponse
-	13, // 16: resource_node.v1.ResourceNodeService.GetResourceNodeTypes:output_type -> resource_node.v1.GetResourceNodeTypesResponse
-	14, // [14:17] is the sub-list for method output_type
-	11, // [11:14] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	5,  // 2: resource_node.v1.ResourceNodeType.visual_data:type_name -> resource_node.v1.ResourceVisual
+	4,  // 3: resource_node.v1.ResourceNodeType.properties:type_name -> resource_node.v1.ResourceProperties
+	2,  // 4: resource_node.v1.ResourceNode.resource_node_type_id:type_name -> resource_node.v1.ResourceNodeTypeId
+	6,  // 5: resource_node.v1.ResourceNode.resource_node_type:type_name -> resource_node.v1.ResourceNodeType
+	21, // 6: resource_node.v1.ResourceNode.created_at:type_name -> google.protobuf.Timestamp
+	1,  // 7: resource_node.v1.ResourceNode.quality:type_name -> resource_node.v1.ResourceNodeQuality
+	7,  // 8: resource_node.v1.GetResourcesInChunkResponse.resources:type_name -> resource_node.v1.ResourceNode
+	11, // 9: resource_node.v1.GetResourcesInChunksRequest.coordinates:type_name -> resource_node.v1.ChunkCoordinate
+	7,  // 10: resource_node.v1.GetResourcesInChunksResponse.resources:type_name -> resource_node.v1.ResourceNode
+	6,  // 11: resource_node.v1.GetResourceNodeTypesResponse.resource_node_types:type_name -> resource_node.v1.ResourceNodeType
+	15, // 12: resource_node.v1.GetSpriteAtlasResponse.sheets:type_name -> resource_node.v1.SpriteSheet
+	16, // 13: resource_node.v1.GetSpriteAtlasResponse.sprites:type_name -> resource_node.v1.SpriteFrame
+	21, // 14: resource_node.v1.ReloadBalanceConfigResponse.loaded_at:type_name -> google.protobuf.Timestamp
+	8,  // 15: resource_node.v1.ResourceNodeService.GetResourcesInChunk:input_type -> resource_node.v1.GetResourcesInChunkRequest
+	10, // 16: resource_node.v1.ResourceNodeService.GetResourcesInChunks:input_type -> resource_node.v1.GetResourcesInChunksRequest
+	13, // 17: resource_node.v1.ResourceNodeService.GetResourceNodeTypes:input_type -> resource_node.v1.GetResourceNodeTypesRequest
+	17, // 18: resource_node.v1.ResourceNodeService.GetSpriteAtlas:input_type -> resource_node.v1.GetSpriteAtlasRequest
+	19, // 19: resource_node.v1.ResourceNodeService.ReloadBalanceConfig:input_type -> resource_node.v1.ReloadBalanceConfigRequest
+	9,  // 20: resource_node.v1.ResourceNodeService.GetResourcesInChunk:output_type -> resource_node.v1.GetResourcesInChunkResponse
+	12, // 21: resource_node.v1.ResourceNodeService.GetResourcesInChunks:output_type -> resource_node.v1.GetResourcesInChunksResponse
+	14, // 22: resource_node.v1.ResourceNodeService.GetResourceNodeTypes:output_type -> resource_node.v1.GetResourceNodeTypesResponse
+	18, // 23: resource_node.v1.ResourceNodeService.GetSpriteAtlas:output_type -> resource_node.v1.GetSpriteAtlasResponse
+	20, // 24: resource_node.v1.ResourceNodeService.ReloadBalanceConfig:output_type -> resource_node.v1.ReloadBalanceConfigResponse
+	20, // [20:25] is the sub-list for method output_type
+	15, // [15:20] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_resource_node_v1_resource_node_proto_init() }
@@ -1077,8 +1606,8 @@ func file_resource_node_v1_resource_node_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resource_node_v1_resource_node_proto_rawDesc), len(file_resource_node_v1_resource_node_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   12,
+			NumEnums:      3,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
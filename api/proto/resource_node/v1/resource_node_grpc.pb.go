@@ -22,6 +22,8 @@ const (
 	ResourceNodeService_GetResourcesInChunk_FullMethodName  = "/resource_node.v1.ResourceNodeService/GetResourcesInChunk"
 	ResourceNodeService_GetResourcesInChunks_FullMethodName = "/resource_node.v1.ResourceNodeService/GetResourcesInChunks"
 	ResourceNodeService_GetResourceNodeTypes_FullMethodName = "/resource_node.v1.ResourceNodeService/GetResourceNodeTypes"
+	ResourceNodeService_GetSpriteAtlas_FullMethodName       = "/resource_node.v1.ResourceNodeService/GetSpriteAtlas"
+	ResourceNodeService_ReloadBalanceConfig_FullMethodName  = "/resource_node.v1.ResourceNodeService/ReloadBalanceConfig"
 )
 
 // ResourceNodeServiceClient is the client API for ResourceNodeService service.
@@ -35,6 +37,10 @@ type ResourceNodeServiceClient interface {
 	GetResourcesInChunks(ctx context.Context, in *GetResourcesInChunksRequest, opts ...grpc.CallOption) (*GetResourcesInChunksResponse, error)
 	// Resource node type information
 	GetResourceNodeTypes(ctx context.Context, in *GetResourceNodeTypesRequest, opts ...grpc.CallOption) (*GetResourceNodeTypesResponse, error)
+	// Where each sprite named in ResourceVisual is drawn from
+	GetSpriteAtlas(ctx context.Context, in *GetSpriteAtlasRequest, opts ...grpc.CallOption) (*GetSpriteAtlasResponse, error)
+	// Admin operations
+	ReloadBalanceConfig(ctx context.Context, in *ReloadBalanceConfigRequest, opts ...grpc.CallOption) (*ReloadBalanceConfigResponse, error)
 }
 
 type resourceNodeServiceClient struct {
@@ -75,6 +81,26 @@ func (c *resourceNodeServiceClient) GetResourceNodeTypes(ctx context.Context, in
 	return out, nil
 }
 
+func (c *resourceNodeServiceClient) GetSpriteAtlas(ctx context.Context, in *GetSpriteAtlasRequest, opts ...grpc.CallOption) (*GetSpriteAtlasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSpriteAtlasResponse)
+	err := c.cc.Invoke(ctx, ResourceNodeService_GetSpriteAtlas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceNodeServiceClient) ReloadBalanceConfig(ctx context.Context, in *ReloadBalanceConfigRequest, opts ...grpc.CallOption) (*ReloadBalanceConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReloadBalanceConfigResponse)
+	err := c.cc.Invoke(ctx, ResourceNodeService_ReloadBalanceConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ResourceNodeServiceServer is the server API for ResourceNodeService service.
 // All implementations must embed UnimplementedResourceNodeServiceServer
 // for forward compatibility.
@@ -86,6 +112,10 @@ type ResourceNodeServiceServer interface {
 	GetResourcesInChunks(context.Context, *GetResourcesInChunksRequest) (*GetResourcesInChunksResponse, error)
 	// Resource node type information
 	GetResourceNodeTypes(context.Context, *GetResourceNodeTypesRequest) (*GetResourceNodeTypesResponse, error)
+	// Where each sprite named in ResourceVisual is drawn from
+	GetSpriteAtlas(context.Context, *GetSpriteAtlasRequest) (*GetSpriteAtlasResponse, error)
+	// Admin operations
+	ReloadBalanceConfig(context.Context, *ReloadBalanceConfigRequest) (*ReloadBalanceConfigResponse, error)
 	mustEmbedUnimplementedResourceNodeServiceServer()
 }
 
@@ -105,6 +135,12 @@ func (UnimplementedResourceNodeServiceServer) GetResourcesInChunks(context.Conte
 func (UnimplementedResourceNodeServiceServer) GetResourceNodeTypes(context.Context, *GetResourceNodeTypesRequest) (*GetResourceNodeTypesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetResourceNodeTypes not implemented")
 }
+func (UnimplementedResourceNodeServiceServer) GetSpriteAtlas(context.Context, *GetSpriteAtlasRequest) (*GetSpriteAtlasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSpriteAtlas not implemented")
+}
+func (UnimplementedResourceNodeServiceServer) ReloadBalanceConfig(context.Context, *ReloadBalanceConfigRequest) (*ReloadBalanceConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadBalanceConfig not implemented")
+}
 func (UnimplementedResourceNodeServiceServer) mustEmbedUnimplementedResourceNodeServiceServer() {}
 func (UnimplementedResourceNodeServiceServer) testEmbeddedByValue()                             {}
 
@@ -180,6 +216,42 @@ func _ResourceNodeService_GetResourceNodeTypes_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ResourceNodeService_GetSpriteAtlas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSpriteAtlasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceNodeServiceServer).GetSpriteAtlas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceNodeService_GetSpriteAtlas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceNodeServiceServer).GetSpriteAtlas(ctx, req.(*GetSpriteAtlasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceNodeService_ReloadBalanceConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadBalanceConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceNodeServiceServer).ReloadBalanceConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceNodeService_ReloadBalanceConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceNodeServiceServer).ReloadBalanceConfig(ctx, req.(*ReloadBalanceConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ResourceNodeService_ServiceDesc is the grpc.ServiceDesc for ResourceNodeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -199,6 +271,14 @@ var ResourceNodeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetResourceNodeTypes",
 			Handler:    _ResourceNodeService_GetResourceNodeTypes_Handler,
 		},
+		{
+			MethodName: "GetSpriteAtlas",
+			Handler:    _ResourceNodeService_GetSpriteAtlas_Handler,
+		},
+		{
+			MethodName: "ReloadBalanceConfig",
+			Handler:    _ResourceNodeService_ReloadBalanceConfig_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "resource_node/v1/resource_node.proto",
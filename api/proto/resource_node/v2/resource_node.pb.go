@@ -0,0 +1,281 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.4
+// source: resource_node/v2/resource_node.proto
+
+package v2
+
+import (
+	v1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Request to get resource nodes in a specific chunk
+type GetResourcesInChunkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorldId       string                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"` // Optional, uses default world if not provided
+	ChunkX        int32                  `protobuf:"varint,2,opt,name=chunk_x,json=chunkX,proto3" json:"chunk_x,omitempty"`
+	ChunkY        int32                  `protobuf:"varint,3,opt,name=chunk_y,json=chunkY,proto3" json:"chunk_y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResourcesInChunkRequest) Reset() {
+	*x = GetResourcesInChunkRequest{}
+	mi := &file_resource_node_v2_resource_node_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResourcesInChunkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourcesInChunkRequest) ProtoMessage() {}
+
+func (x *GetResourcesInChunkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_node_v2_resource_node_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResourcesInChunkRequest.ProtoReflect.Descriptor instead.
+func (*GetResourcesInChunkRequest) Descriptor() ([]byte, []int) {
+	return file_resource_node_v2_resource_node_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetResourcesInChunkRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *GetResourcesInChunkRequest) GetChunkX() int32 {
+	if x != nil {
+		return x.ChunkX
+	}
+	return 0
+}
+
+func (x *GetResourcesInChunkRequest) GetChunkY() int32 {
+	if x != nil {
+		return x.ChunkY
+	}
+	return 0
+}
+
+// Request to get resource nodes in multiple chunks
+type GetResourcesInChunksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Coordinates   []*ChunkCoordinate     `protobuf:"bytes,1,rep,name=coordinates,proto3" json:"coordinates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResourcesInChunksRequest) Reset() {
+	*x = GetResourcesInChunksRequest{}
+	mi := &file_resource_node_v2_resource_node_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResourcesInChunksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourcesInChunksRequest) ProtoMessage() {}
+
+func (x *GetResourcesInChunksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_node_v2_resource_node_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResourcesInChunksRequest.ProtoReflect.Descriptor instead.
+func (*GetResourcesInChunksRequest) Descriptor() ([]byte, []int) {
+	return file_resource_node_v2_resource_node_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetResourcesInChunksRequest) GetCoordinates() []*ChunkCoordinate {
+	if x != nil {
+		return x.Coordinates
+	}
+	return nil
+}
+
+// Chunk coordinate pair
+type ChunkCoordinate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorldId       string                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"` // Optional, uses default world if not provided
+	ChunkX        int32                  `protobuf:"varint,2,opt,name=chunk_x,json=chunkX,proto3" json:"chunk_x,omitempty"`
+	ChunkY        int32                  `protobuf:"varint,3,opt,name=chunk_y,json=chunkY,proto3" json:"chunk_y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChunkCoordinate) Reset() {
+	*x = ChunkCoordinate{}
+	mi := &file_resource_node_v2_resource_node_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChunkCoordinate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChunkCoordinate) ProtoMessage() {}
+
+func (x *ChunkCoordinate) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_node_v2_resource_node_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChunkCoordinate.ProtoReflect.Descriptor instead.
+func (*ChunkCoordinate) Descriptor() ([]byte, []int) {
+	return file_resource_node_v2_resource_node_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ChunkCoordinate) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *ChunkCoordinate) GetChunkX() int32 {
+	if x != nil {
+		return x.ChunkX
+	}
+	return 0
+}
+
+func (x *ChunkCoordinate) GetChunkY() int32 {
+	if x != nil {
+		return x.ChunkY
+	}
+	return 0
+}
+
+var File_resource_node_v2_resource_node_proto protoreflect.FileDescriptor
+
+const file_resource_node_v2_resource_node_proto_rawDesc = "" +
+	"\n" +
+	"$resource_node/v2/resource_node.proto\x12\x10resource_node.v2\x1a$resource_node/v1/resource_node.proto\"i\n" +
+	"\x1aGetResourcesInChunkRequest\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\tR\aworldId\x12\x17\n" +
+	"\achunk_x\x18\x02 \x01(\x05R\x06chunkX\x12\x17\n" +
+	"\achunk_y\x18\x03 \x01(\x05R\x06chunkY\"b\n" +
+	"\x1bGetResourcesInChunksRequest\x12C\n" +
+	"\vcoordinates\x18\x01 \x03(\v2!.resource_node.v2.ChunkCoordinateR\vcoordinates\"^\n" +
+	"\x0fChunkCoordinate\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\tR\aworldId\x12\x17\n" +
+	"\achunk_x\x18\x02 \x01(\x05R\x06chunkX\x12\x17\n" +
+	"\achunk_y\x18\x03 \x01(\x05R\x06chunkY2\xda\x04\n" +
+	"\x13ResourceNodeService\x12t\n" +
+	"\x13GetResourcesInChunk\x12,.resource_node.v2.GetResourcesInChunkRequest\x1a-.resource_node.v1.GetResourcesInChunkResponse\"\x00\x12w\n" +
+	"\x14GetResourcesInChunks\x12-.resource_node.v2.GetResourcesInChunksRequest\x1a..resource_node.v1.GetResourcesInChunksResponse\"\x00\x12w\n" +
+	"\x14GetResourceNodeTypes\x12-.resource_node.v1.GetResourceNodeTypesRequest\x1a..resource_node.v1.GetResourceNodeTypesResponse\"\x00\x12e\n" +
+	"\x0eGetSpriteAtlas\x12'.resource_node.v1.GetSpriteAtlasRequest\x1a(.resource_node.v1.GetSpriteAtlasResponse\"\x00\x12t\n" +
+	"\x13ReloadBalanceConfig\x12,.resource_node.v1.ReloadBalanceConfigRequest\x1a-.resource_node.v1.ReloadBalanceConfigResponse\"\x00B4Z2github.com/VoidMesh/api/api/proto/resource_node/v2b\x06proto3"
+
+var (
+	file_resource_node_v2_resource_node_proto_rawDescOnce sync.Once
+	file_resource_node_v2_resource_node_proto_rawDescData []byte
+)
+
+func file_resource_node_v2_resource_node_proto_rawDescGZIP() []byte {
+	file_resource_node_v2_resource_node_proto_rawDescOnce.Do(func() {
+		file_resource_node_v2_resource_node_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_resource_node_v2_resource_node_proto_rawDesc), len(file_resource_node_v2_resource_node_proto_rawDesc)))
+	})
+	return file_resource_node_v2_resource_node_proto_rawDescData
+}
+
+var file_resource_node_v2_resource_node_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_resource_node_v2_resource_node_proto_goTypes = []any{
+	(*GetResourcesInChunkRequest)(nil),      // 0: resource_node.v2.GetResourcesInChunkRequest
+	(*GetResourcesInChunksRequest)(nil),     // 1: resource_node.v2.GetResourcesInChunksRequest
+	(*ChunkCoordinate)(nil),                 // 2: resource_node.v2.ChunkCoordinate
+	(*v1.GetResourceNodeTypesRequest)(nil),  // 3: resource_node.v1.GetResourceNodeTypesRequest
+	(*v1.GetSpriteAtlasRequest)(nil),        // 4: resource_node.v1.GetSpriteAtlasRequest
+	(*v1.ReloadBalanceConfigRequest)(nil),   // 5: resource_node.v1.ReloadBalanceConfigRequest
+	(*v1.GetResourcesInChunkResponse)(nil),  // 6: resource_node.v1.GetResourcesInChunkResponse
+	(*v1.GetResourcesInChunksResponse)(nil), // 7: resource_node.v1.GetResourcesInChunksResponse
+	(*v1.GetResourceNodeTypesResponse)(nil), // 8: resource_node.v1.GetResourceNodeTypesResponse
+	(*v1.GetSpriteAtlasResponse)(nil),       // 9: resource_node.v1.GetSpriteAtlasResponse
+	(*v1.ReloadBalanceConfigResponse)(nil),  // 10: resource_node.v1.ReloadBalanceConfigResponse
+}
+var file_resource_node_v2_resource_node_proto_depIdxs = []int32{
+	2,  // 0: resource_node.v2.GetResourcesInChunksRequest.coordinates:type_name -> resource_node.v2.ChunkCoordinate
+	0,  // 1: resource_node.v2.ResourceNodeService.GetResourcesInChunk:input_type -> resource_node.v2.GetResourcesInChunkRequest
+	1,  // 2: resource_node.v2.ResourceNodeService.GetResourcesInChunks:input_type -> resource_node.v2.GetResourcesInChunksRequest
+	3,  // 3: resource_node.v2.ResourceNodeService.GetResourceNodeTypes:input_type -> resource_node.v1.GetResourceNodeTypesRequest
+	4,  // 4: resource_node.v2.ResourceNodeService.GetSpriteAtlas:input_type -> resource_node.v1.GetSpriteAtlasRequest
+	5,  // 5: resource_node.v2.ResourceNodeService.ReloadBalanceConfig:input_type -> resource_node.v1.ReloadBalanceConfigRequest
+	6,  // 6: resource_node.v2.ResourceNodeService.GetResourcesInChunk:output_type -> resource_node.v1.GetResourcesInChunkResponse
+	7,  // 7: resource_node.v2.ResourceNodeService.GetResourcesInChunks:output_type -> resource_node.v1.GetResourcesInChunksResponse
+	8,  // 8: resource_node.v2.ResourceNodeService.GetResourceNodeTypes:output_type -> resource_node.v1.GetResourceNodeTypesResponse
+	9,  // 9: resource_node.v2.ResourceNodeService.GetSpriteAtlas:output_type -> resource_node.v1.GetSpriteAtlasResponse
+	10, // 10: resource_node.v2.ResourceNodeService.ReloadBalanceConfig:output_type -> resource_node.v1.ReloadBalanceConfigResponse
+	6,  // [6:11] is the sub-list for method output_type
+	1,  // [1:6] is the sub-list for method input_type
+	1,  // [1:1] is the sub-list for extension type_name
+	1,  // [1:1] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_resource_node_v2_resource_node_proto_init() }
+func file_resource_node_v2_resource_node_proto_init() {
+	if File_resource_node_v2_resource_node_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resource_node_v2_resource_node_proto_rawDesc), len(file_resource_node_v2_resource_node_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_resource_node_v2_resource_node_proto_goTypes,
+		DependencyIndexes: file_resource_node_v2_resource_node_proto_depIdxs,
+		MessageInfos:      file_resource_node_v2_resource_node_proto_msgTypes,
+	}.Build()
+	File_resource_node_v2_resource_node_proto = out.File
+	file_resource_node_v2_resource_node_proto_goTypes = nil
+	file_resource_node_v2_resource_node_proto_depIdxs = nil
+}
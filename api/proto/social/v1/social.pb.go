@@ -0,0 +1,1799 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.4
+// source: social/v1/social.proto
+
+package v1
+
+import (
+	v1 "github.com/VoidMesh/api/api/proto/stream/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type FriendStatus int32
+
+const (
+	FriendStatus_FRIEND_STATUS_UNSPECIFIED      FriendStatus = 0
+	FriendStatus_FRIEND_STATUS_FRIENDS          FriendStatus = 1
+	FriendStatus_FRIEND_STATUS_INCOMING_REQUEST FriendStatus = 2 // The other user asked to be friends
+	FriendStatus_FRIEND_STATUS_OUTGOING_REQUEST FriendStatus = 3 // Waiting for the other user to accept
+)
+
+// Enum value maps for FriendStatus.
+var (
+	FriendStatus_name = map[int32]string{
+		0: "FRIEND_STATUS_UNSPECIFIED",
+		1: "FRIEND_STATUS_FRIENDS",
+		2: "FRIEND_STATUS_INCOMING_REQUEST",
+		3: "FRIEND_STATUS_OUTGOING_REQUEST",
+	}
+	FriendStatus_value = map[string]int32{
+		"FRIEND_STATUS_UNSPECIFIED":      0,
+		"FRIEND_STATUS_FRIENDS":          1,
+		"FRIEND_STATUS_INCOMING_REQUEST": 2,
+		"FRIEND_STATUS_OUTGOING_REQUEST": 3,
+	}
+)
+
+func (x FriendStatus) Enum() *FriendStatus {
+	p := new(FriendStatus)
+	*p = x
+	return p
+}
+
+func (x FriendStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FriendStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_social_v1_social_proto_enumTypes[0].Descriptor()
+}
+
+func (FriendStatus) Type() protoreflect.EnumType {
+	return &file_social_v1_social_proto_enumTypes[0]
+}
+
+func (x FriendStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FriendStatus.Descriptor instead.
+func (FriendStatus) EnumDescriptor() ([]byte, []int) {
+	return file_social_v1_social_proto_rawDescGZIP(), []int{0}
+}
+
+type ReportReason int32
+
+const (
+	ReportReason_REPORT_REASON_UNSPECIFIED    ReportReason = 0
+	ReportReason_REPORT_REASON_HARASSMENT     ReportReason = 1
+	ReportReason_REPORT_REASON_CHEATING       ReportReason = 2
+	ReportReason_REPORT_REASON_SPAM           ReportReason = 3
+	ReportReason_REPORT_REASON_OFFENSIVE_NAME ReportReason = 4
+	ReportReason_REPORT_REASON_OTHER          ReportReason = 5
+)
+
+// Enum value maps for ReportReason.
+var (
+	ReportReason_name = map[int32]string{
+		0: "REPORT_REASON_UNSPECIFIED",
+		1: "REPORT_REASON_HARASSMENT",
+		2: "REPORT_REASON_CHEATING",
+		3: "REPORT_REASON_SPAM",
+		4: "REPORT_REASON_OFFENSIVE_NAME",
+		5: "REPORT_REASON_OTHER",
+	}
+	ReportReason_value = map[string]int32{
+		"REPORT_REASON_UNSPECIFIED":    0,
+		"REPORT_REASON_HARASSMENT":     1,
+		"REPORT_REASON_CHEATING":       2,
+		"REPORT_REASON_SPAM":           3,
+		"REPORT_REASON_OFFENSIVE_NAME": 4,
+		"REPORT_REASON_OTHER":          5,
+	}
+)
+
+func (x ReportReason) Enum() *ReportReason {
+	p := new(ReportReason)
+	*p = x
+	return p
+}
+
+func (x ReportReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_social_v1_social_proto_enumTypes[1].Descriptor()
+}
+
+func (ReportReason) Type() protoreflect.EnumType {
+	return &file_social_v1_social_proto_enumTypes[1]
+}
+
+func (x ReportReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportReason.Descriptor instead.
+func (ReportReason) EnumDescriptor() ([]byte, []int) {
+	return file_social_v1_social_proto_rawDescGZIP(), []int{1}
+}
+
+type ReportStatus int32
+
+const (
+	ReportStatus_REPORT_STATUS_UNSPECIFIED ReportStatus = 0
+	ReportStatus_REPORT_STATUS_OPEN        ReportStatus = 1
+	ReportStatus_REPORT_STATUS_RESOLVED    ReportStatus = 2
+)
+
+// Enum value maps for ReportStatus.
+var (
+	ReportStatus_name = map[int32]string{
+		0: "REPORT_STATUS_UNSPECIFIED",
+		1: "REPORT_STATUS_OPEN",
+		2: "REPORT_STATUS_RESOLVED",
+	}
+	ReportStatus_value = map[string]int32{
+		"REPORT_STATUS_UNSPECIFIED": 0,
+		"REPORT_STATUS_OPEN":        1,
+		"REPORT_STATUS_RESOLVED":    2,
+	}
+)
+
+func (x ReportStatus) Enum() *ReportStatus {
+	p := new(ReportStatus)
+	*p = x
+	return p
+}
+
+func (x ReportStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_social_v1_social_proto_enumTypes[2].Descriptor()
+}
+
+func (ReportStatus) Type() protoreflect.EnumType {
+	return &file_social_v1_social_proto_enumTypes[2]
+}
+
+func (x ReportStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportStatus.Descriptor instead.
+func (ReportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_social_v1_social_proto_rawDescGZIP(), []int{2}
+}
+
+type Friend struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Status        FriendStatus           `protobuf:"varint,4,opt,name=status,proto3,enum=social.v1.FriendStatus" json:"status,omitempty"`
+	Online        bool                   `protobuf:"varint,5,opt,name=online,proto3" json:"online,omitempty"` // Only reported for accepted friends
+	Since         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=since,proto3" json:"since,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Friend) Reset() {
+	*x = Friend{}
+	mi := &file_social_v1_social_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Friend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Friend) ProtoMessage() {}
+
+func (x *Friend) ProtoReflect() protoreflect.Message {
+	mi := &file_social_v1_social_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Friend.ProtoReflect.Descriptor instead.
+func (*Friend) Descriptor() ([]byte, []int) {
+	return file_social_v1_social_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Friend) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Friend) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Friend) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Friend) GetStatus() FriendStatus {
+	if x != nil {
+		return x.Status
+	}
+	return FriendStatus_FRIEND_STATUS_UNSPECIFIED
+}
+
+func (x *Friend) GetOnline() bool {
+	if x != nil {
+		return x.Online
+	}
+	return false
+}
+
+func (x *Friend) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+type SendFriendRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendFriendRequestRequest) Reset() {
+	*x = SendFriendRequestRequest{}
+	mi := &file_social_v1_social_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendFriendRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendFriendRequestRequest) ProtoMessage() {}
+
+func (x *SendFriendRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_social_v1_social_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendFriendRequestRequest.ProtoReflect.Descriptor instead.
+func (*SendFriendRequestRequest) Descriptor() ([]byte, []int) {
+	return file_social_v1_social_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SendFriendRequestRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type SendFriendRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Friend        *Friend                `protobuf:"bytes,1,opt,name=friend,proto3" json:"friend,omitempty"` // FRIENDS when the other user had already sent a request
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendFriendRequestResponse) Reset() {
+	*x = SendFriendRequestResponse{}
+	mi := &file_social_v1_social_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendFriendRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendFriendRequestResponse) ProtoMessage() {}
+
+func (x *SendFriendRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_social_v1_social_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendFriendRequestResponse.ProtoReflect.Descriptor instead.
+func (*SendFriendRequestResponse) Descriptor() ([]byte, []int) {
+	return file_social_v1_social_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SendFriendRequestResponse) GetFriend() *Friend {
+	if x != nil {
+		return x.Friend
+	}
+	return nil
+}
+
+type AcceptFriendRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // The user who sent the request
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptFriendRequestRequest) Reset() {
+	*x = AcceptFriendRequestRequest{}
+	mi := &file_social_v1_social_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptFriendRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptFriendRequestRequest) ProtoMessage() {}
+
+func (x *AcceptFriendRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_social_v1_social_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptFriendRequestRequest.ProtoReflect.Descriptor instead.
+func (*AcceptFriendRequestRequest) Descriptor() ([]byte, []int) {
+	return file_social_v1_social_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AcceptFriendRequestRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type AcceptFriendRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Friend        *Friend                `protobuf:"bytes,1,opt,name=friend,proto3" json:"friend,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptFriendRequestResponse) Reset() {
+	*x = AcceptFriendRequestResponse{}
+	mi := &file_social_v1_social_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptFriendRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptFriendRequestResponse) ProtoMessage() {}
+
+func (x *AcceptFriendRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_social_v1_social_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptFriendRequestResponse.ProtoReflect.Descriptor instead.
+func (*AcceptFriendRequestResponse) Descriptor() ([]byte, []int) {
+	return file_social_v1_social_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AcceptFriendRequestResponse) GetFriend() *Friend {
+	if x != nil {
+		return x.Friend
+	}
+	return nil
+}
+
+type DeclineFriendRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineFriendRequestRequest) Reset() {
+	*x = DeclineFriendRequestRequest{}
+	mi := &file_social_v1_social_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineFriendRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineFriendRequestRequest) ProtoMessage() {}
+
+func (x *DeclineFriendRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_social_v1_social_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineFriendRequestRequest.ProtoReflect.Descriptor instead.
+func (*DeclineFriendRequestRequest) Descriptor() ([]byte, []int) {
+	return file_social_v1_social_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeclineFriendRequestRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeclineFriendRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineFriendRequestResponse) Reset() {
+	*x = DeclineFriendRequestResponse{}
+	mi := &file_social_v1_social_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineFriendRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineFriendRequestResponse) ProtoMessage() {}
+
+func (x *DeclineFriendRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_social_v1_social_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineFriendRequestResponse.ProtoReflect.Descriptor instead.
+func (*DeclineFriendRequestResponse) Descriptor() ([]byte, []int) {
+	return file_social_v1_social_proto_rawDescGZIP(), []int{6}
+}
+
+type RemoveFriendRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Also cancels an outgoing request
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFriendRequest) Reset() {
+	*x = RemoveFriendRequest{}
+	mi := &file_social_v1_social_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFriendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFriendRequest) ProtoMessage() {}
+
+func (x *RemoveFriendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_social_v1_social_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFriendRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFriendRequest) Descriptor() ([]byte, []int) {
+	return file_social_v1_social_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RemoveFriendRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RemoveFriendResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFriendResponse) Reset() {
+	*x = RemoveFriendResponse{}
+	mi := &file_social_v1_social_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFriendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFriendResponse) ProtoMessage() {}
+
+func (x *RemoveFriendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_social_v1_social_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFriendResponse.ProtoReflect.Descriptor instead.
+func (*RemoveFriendResponse) Descriptor() ([]byte, []int) {
+	return file_social_v1_social_proto_rawDescGZIP(), []int{8}
+}
+
+type ListFriendsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFriendsRequest) Reset() {
+	*x = ListFriendsRequest{}
+	mi := &file_social_v1_social_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFriendsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFriendsRequest) ProtoMessage() {}
+
+func (x *ListFriendsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_social_v1_social_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFriendsRequest.ProtoReflect.Descriptor instead.
+func (*ListFriendsRequest) Descriptor() ([]byte, []int) {
+	return file_social_v1_social_proto_rawDescGZIP(), []int{9}
+}
+
+type ListFriendsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Friends       []*Friend              `protobuf:"bytes,1,rep,name=friends,proto3" json:"friends,omitempty"` // Accepted friends and pending requests in both directions
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFriendsResponse) Reset() {
+	*x = ListFriendsResponse{}
+	mi := &file_social_v1_social_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFriendsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFriendsResponse) ProtoMessage() {}
+
+func (x *ListFriendsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_social_v1_social_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFriendsResponse.ProtoReflect.Descriptor instead.
+func (*ListFriendsResponse) Descriptor() ([]byte, []int) {
+	return file_social_v1_social_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListFriendsResponse) GetFriends() []*Friend {
+	if x != nil {
+		return x.Friends
+	}
+	return nil
+}
+
+type DirectMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SenderId      string                 `protobuf:"bytes,2,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	RecipientId   string                 `protobuf:"bytes,3,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
+	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	SentAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
+	Stream        *v1.StreamInfo         `protobuf:"bytes,6,opt,name=stream,proto3" json:"stream,omitempty"` // Set when sent by StreamDirectMessages
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DirectMessage) Reset() {
+	*x = DirectMessage{}
+	mi := &file_social_v1_social_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DirectMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DirectMessage) ProtoMessage() {}
+
+func (x *DirectMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_social_v1_social_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DirectMessage.ProtoReflect.Descriptor instead.
+func (*DirectMessage) Descriptor() ([]byte, []int) {
+	return file_social_v1_social_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DirectMessage) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DirectMessage) GetSenderId() string {
+	if x != nil {
+		return x.SenderId
+	}
+	return ""
+}
+
+func (x *DirectMessage) GetRecipientId() string {
+	if x != nil {
+		return x.RecipientId
+	}
+	return ""
+}
+
+func (x *DirectMessage) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *DirectMessage) GetSentAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SentAt
+	}
+	return nil
+}
+
+func (x *DirectMessage) GetStream() *v1.StreamInfo {
+	if x != nil {
+		return x.Stream
+	}
+	return nil
+}
+
+type SendDirectMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecipientId   string                 `protobuf:"bytes,1,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
+	Body          string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendDirectMessageRequest) Reset() {
+	*x = SendDirectMessageRequest{}
+	mi := &file_social_v1_social_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendDirectMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendDirectMessageRequest) ProtoMessage() {}
+
+func (x *SendDirectMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_social_v1_social_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendDirectMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendDirectMessageRequest) Descriptor() ([]byte, []int) {
+	return file_social_v1_social_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SendDirectMessageRequest) GetRecipientId() string {
+	if x != nil {
+		return x.RecipientId
+	}
+	return ""
+}
+
+func (x *SendDirectMessageRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type SendDirectMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *DirectMessage         `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Delivered     bool                   `protobuf:"varint,2,opt,name=delivered,proto3" json:"delivered,omitempty"` // False when the recipient is offline; it is delivered when they next connect
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendDirectMessageResponse) Reset() {
+	*x = SendDirectMessageResponse{}
+	mi := &file_social_v1_social_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendDirectMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendDirectMessageResponse) ProtoMessage() {}
+
+func (x *SendDirectMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_social_v1_social_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendDirectMessageResponse.ProtoReflect.Descriptor instead.
+func (*SendDirectMessageResponse) Descriptor() ([]byte, []int) {
+	return file_social_v1_social_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SendDirectMessageResponse) GetMessage() *DirectMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *SendDirectMessageResponse) GetDelivered() bool {
+	if x != nil {
+		return x.Delivered
+	}
+	return false
+}
+
+type GetConversationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BeforeId      int64                  `protobuf:"varint,2,opt,name=before_id,json=beforeId,proto3" json:"before_id,omitempty"` // 0 starts from the newest message
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                       // 0 uses the default, larger values are capped
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConversationRequest) Reset() {
+	*x = GetConversationRequest{}
+	mi := &file_social_v1_social_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationRequest) ProtoMessage() {}
+
+func (x *GetConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_social_v1_social_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationRequest.ProtoReflect.Descriptor instead.
+func (*GetConversationRequest) Descriptor() ([]byte, []int) {
+	return file_social_v1_social_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetConversationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetConversationRequest) GetBeforeId() int64 {
+	if x != nil {
+		return x.BeforeId
+	}
+	return 0
+}
+
+func (x *GetConversationRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetConversationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*DirectMessage       `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"` // Newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConversationResponse) Reset() {
+	*x = GetConversationResponse{}
+	mi := &file_social_v1_social_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConversationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationResponse) ProtoMessage() {}
+
+func (x *GetConversationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_social_v1_social_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationResponse.ProtoReflect.Descriptor instead.
+func (*GetConversationResponse) Descriptor() ([]byte, []int) {
+	return file_social_v1_social_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetConversationResponse) GetMessages() []*DirectMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type StreamDirectMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resume        *v1.StreamResume       `protobuf:"bytes,1,opt,name=resume,proto3" json:"resume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamDirectMessagesRequest) Reset() {
+	*x = StreamDirectMessagesRequest{}
+	mi := &file_social_v1_social_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamDirectMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamDirectMessagesRequest) ProtoMessage() {}
+
+func (x *StreamDirectMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_social_v1_social_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamDirectMessagesRequest.ProtoReflect.Descriptor instead.
+func (*StreamDirectMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_social_v1_social_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *StreamDirectMessagesRequest) GetResume() *v1.StreamResume {
+	if x != nil {
+		return x.Resume
+	}
+	return nil
+}
+
+type BlockedUser struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	BlockedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=blocked_at,json=blockedAt,proto3" json:"blocked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockedUser) Reset() {
+	*x = BlockedUser{}
+	mi := &file_social_v1_social_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockedUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockedUser) ProtoMessage() {}
+
+func (x *BlockedUser) ProtoReflect() protoreflect.Message {
+	mi := &file_social_v1_social_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockedUser.ProtoReflect.Descriptor instead.
+func (*BlockedUser) Descriptor() ([]byte, []int) {
+	return file_social_v1_social_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BlockedUser) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BlockedUser) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *BlockedUser) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *BlockedUser) GetBlockedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BlockedAt
+	}
+	return nil
+}
+
+type BlockUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockUserRequest) Reset() {
+	*x = BlockUserRequest{}
+	mi := &file_social_v1_social_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserRequest) ProtoMessage() {}
+
+func (x *BlockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_social_v1_social_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserRequest.ProtoReflect.Descriptor instead.
+func (*BlockUserRequest) Descriptor() ([]byte, []int) {
+	return file_social_v1_social_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BlockUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type BlockUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockUserResponse) Reset() {
+	*x = BlockUserResponse{}
+	mi := &file_social_v1_social_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserResponse) ProtoMessage() {}
+
+func (x *BlockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_social_v1_social_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserResponse.ProtoReflect.Descriptor instead.
+func (*BlockUserResponse) Descriptor() ([]byte, []int) {
+	return file_social_v1_social_proto_rawDescGZIP(), []int{19}
+}
+
+type UnblockUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockUserRequest) Reset() {
+	*x = UnblockUserRequest{}
+	mi := &file_social_v1_social_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockUserRequest) ProtoMessage() {}
+
+func (x *UnblockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_social_v1_social_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockUserRequest.ProtoReflect.Descriptor instead.
+func (*UnblockUserRequest) Descriptor() ([]byte, []int) {
+	return file_social_v1_social_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UnblockUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UnblockUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockUserResponse) Reset() {
+	*x = UnblockUserResponse{}
+	mi := &file_social_v1_social_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockUserResponse) ProtoMessage() {}
+
+func (x *UnblockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_social_v1_social_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockUserResponse.ProtoReflect.Descriptor instead.
+func (*UnblockUserResponse) Descriptor() ([]byte, []int) {
+	return file_social_v1_social_proto_rawDescGZIP(), []int{21}
+}
+
+type ListBlockedUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlockedUsersRequest) Reset() {
+	*x = ListBlockedUsersRequest{}
+	mi := &file_social_v1_social_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlockedUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedUsersRequest) ProtoMessage() {}
+
+func (x *ListBlockedUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_social_v1_social_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListBlockedUsersRequest) Descriptor() ([]byte, []int) {
+	return file_social_v1_social_proto_rawDescGZIP(), []int{22}
+}
+
+type ListBlockedUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*BlockedUser         `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlockedUsersResponse) Reset() {
+	*x = ListBlockedUsersResponse{}
+	mi := &file_social_v1_social_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlockedUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedUsersResponse) ProtoMessage() {}
+
+func (x *ListBlockedUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_social_v1_social_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListBlockedUsersResponse) Descriptor() ([]byte, []int) {
+	return file_social_v1_social_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListBlockedUsersResponse) GetUsers() []*BlockedUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type ReportPlayerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason        ReportReason           `protobuf:"varint,2,opt,name=reason,proto3,enum=social.v1.ReportReason" json:"reason,omitempty"`
+	Details       string                 `protobuf:"bytes,3,opt,name=details,proto3" json:"details,omitempty"` // Optional free text from the reporter
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportPlayerRequest) Reset() {
+	*x = ReportPlayerRequest{}
+	mi := &file_social_v1_social_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportPlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportPlayerRequest) ProtoMessage() {}
+
+func (x *ReportPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_social_v1_social_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportPlayerRequest.ProtoReflect.Descriptor instead.
+func (*ReportPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_social_v1_social_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ReportPlayerRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReportPlayerRequest) GetReason() ReportReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ReportReason_REPORT_REASON_UNSPECIFIED
+}
+
+func (x *ReportPlayerRequest) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+type ReportPlayerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReportId      int64                  `protobuf:"varint,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportPlayerResponse) Reset() {
+	*x = ReportPlayerResponse{}
+	mi := &file_social_v1_social_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportPlayerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportPlayerResponse) ProtoMessage() {}
+
+func (x *ReportPlayerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_social_v1_social_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportPlayerResponse.ProtoReflect.Descriptor instead.
+func (*ReportPlayerResponse) Descriptor() ([]byte, []int) {
+	return file_social_v1_social_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReportPlayerResponse) GetReportId() int64 {
+	if x != nil {
+		return x.ReportId
+	}
+	return 0
+}
+
+type PlayerReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReporterId    string                 `protobuf:"bytes,2,opt,name=reporter_id,json=reporterId,proto3" json:"reporter_id,omitempty"`
+	ReportedId    string                 `protobuf:"bytes,3,opt,name=reported_id,json=reportedId,proto3" json:"reported_id,omitempty"`
+	Reason        ReportReason           `protobuf:"varint,4,opt,name=reason,proto3,enum=social.v1.ReportReason" json:"reason,omitempty"`
+	Details       string                 `protobuf:"bytes,5,opt,name=details,proto3" json:"details,omitempty"`
+	ContextJson   string                 `protobuf:"bytes,6,opt,name=context_json,json=contextJson,proto3" json:"context_json,omitempty"` // Snapshot taken when the report was filed
+	Status        ReportStatus           `protobuf:"varint,7,opt,name=status,proto3,enum=social.v1.ReportStatus" json:"status,omitempty"`
+	Resolution    string                 `protobuf:"bytes,8,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	ResolvedBy    string                 `protobuf:"bytes,9,opt,name=resolved_by,json=resolvedBy,proto3" json:"resolved_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ResolvedAt    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerReport) Reset() {
+	*x = PlayerReport{}
+	mi := &file_social_v1_social_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerReport) ProtoMessage() {}
+
+func (x *PlayerReport) ProtoReflect() protoreflect.Message {
+	mi := &file_social_v1_social_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerReport.ProtoReflect.Descriptor instead.
+func (*PlayerReport) Descriptor() ([]byte, []int) {
+	return file_social_v1_social_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PlayerReport) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PlayerReport) GetReporterId() string {
+	if x != nil {
+		return x.ReporterId
+	}
+	return ""
+}
+
+func (x *PlayerReport) GetReportedId() string {
+	if x != nil {
+		return x.ReportedId
+	}
+	return ""
+}
+
+func (x *PlayerReport) GetReason() ReportReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ReportReason_REPORT_REASON_UNSPECIFIED
+}
+
+func (x *PlayerReport) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+func (x *PlayerReport) GetContextJson() string {
+	if x != nil {
+		return x.ContextJson
+	}
+	return ""
+}
+
+func (x *PlayerReport) GetStatus() ReportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReportStatus_REPORT_STATUS_UNSPECIFIED
+}
+
+func (x *PlayerReport) GetResolution() string {
+	if x != nil {
+		return x.Resolution
+	}
+	return ""
+}
+
+func (x *PlayerReport) GetResolvedBy() string {
+	if x != nil {
+		return x.ResolvedBy
+	}
+	return ""
+}
+
+func (x *PlayerReport) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PlayerReport) GetResolvedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return nil
+}
+
+var File_social_v1_social_proto protoreflect.FileDescriptor
+
+const file_social_v1_social_proto_rawDesc = "" +
+	"\n" +
+	"\x16social/v1/social.proto\x12\tsocial.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16stream/v1/stream.proto\"\xdb\x01\n" +
+	"\x06Friend\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12!\n" +
+	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x12/\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x17.social.v1.FriendStatusR\x06status\x12\x16\n" +
+	"\x06online\x18\x05 \x01(\bR\x06online\x120\n" +
+	"\x05since\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\"6\n" +
+	"\x18SendFriendRequestRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"F\n" +
+	"\x19SendFriendRequestResponse\x12)\n" +
+	"\x06friend\x18\x01 \x01(\v2\x11.social.v1.FriendR\x06friend\"5\n" +
+	"\x1aAcceptFriendRequestRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"H\n" +
+	"\x1bAcceptFriendRequestResponse\x12)\n" +
+	"\x06friend\x18\x01 \x01(\v2\x11.social.v1.FriendR\x06friend\"6\n" +
+	"\x1bDeclineFriendRequestRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x1e\n" +
+	"\x1cDeclineFriendRequestResponse\".\n" +
+	"\x13RemoveFriendRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x16\n" +
+	"\x14RemoveFriendResponse\"\x14\n" +
+	"\x12ListFriendsRequest\"B\n" +
+	"\x13ListFriendsResponse\x12+\n" +
+	"\afriends\x18\x01 \x03(\v2\x11.social.v1.FriendR\afriends\"\xd7\x01\n" +
+	"\rDirectMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\tsender_id\x18\x02 \x01(\tR\bsenderId\x12!\n" +
+	"\frecipient_id\x18\x03 \x01(\tR\vrecipientId\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\x123\n" +
+	"\asent_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x06sentAt\x12-\n" +
+	"\x06stream\x18\x06 \x01(\v2\x15.stream.v1.StreamInfoR\x06stream\"Q\n" +
+	"\x18SendDirectMessageRequest\x12!\n" +
+	"\frecipient_id\x18\x01 \x01(\tR\vrecipientId\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\"m\n" +
+	"\x19SendDirectMessageResponse\x122\n" +
+	"\amessage\x18\x01 \x01(\v2\x18.social.v1.DirectMessageR\amessage\x12\x1c\n" +
+	"\tdelivered\x18\x02 \x01(\bR\tdelivered\"d\n" +
+	"\x16GetConversationRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tbefore_id\x18\x02 \x01(\x03R\bbeforeId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"O\n" +
+	"\x17GetConversationResponse\x124\n" +
+	"\bmessages\x18\x01 \x03(\v2\x18.social.v1.DirectMessageR\bmessages\"N\n" +
+	"\x1bStreamDirectMessagesRequest\x12/\n" +
+	"\x06resume\x18\x01 \x01(\v2\x17.stream.v1.StreamResumeR\x06resume\"\xa0\x01\n" +
+	"\vBlockedUser\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12!\n" +
+	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x129\n" +
+	"\n" +
+	"blocked_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tblockedAt\"+\n" +
+	"\x10BlockUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x13\n" +
+	"\x11BlockUserResponse\"-\n" +
+	"\x12UnblockUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x15\n" +
+	"\x13UnblockUserResponse\"\x19\n" +
+	"\x17ListBlockedUsersRequest\"H\n" +
+	"\x18ListBlockedUsersResponse\x12,\n" +
+	"\x05users\x18\x01 \x03(\v2\x16.social.v1.BlockedUserR\x05users\"y\n" +
+	"\x13ReportPlayerRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12/\n" +
+	"\x06reason\x18\x02 \x01(\x0e2\x17.social.v1.ReportReasonR\x06reason\x12\x18\n" +
+	"\adetails\x18\x03 \x01(\tR\adetails\"3\n" +
+	"\x14ReportPlayerResponse\x12\x1b\n" +
+	"\treport_id\x18\x01 \x01(\x03R\breportId\"\xb8\x03\n" +
+	"\fPlayerReport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vreporter_id\x18\x02 \x01(\tR\n" +
+	"reporterId\x12\x1f\n" +
+	"\vreported_id\x18\x03 \x01(\tR\n" +
+	"reportedId\x12/\n" +
+	"\x06reason\x18\x04 \x01(\x0e2\x17.social.v1.ReportReasonR\x06reason\x12\x18\n" +
+	"\adetails\x18\x05 \x01(\tR\adetails\x12!\n" +
+	"\fcontext_json\x18\x06 \x01(\tR\vcontextJson\x12/\n" +
+	"\x06status\x18\a \x01(\x0e2\x17.social.v1.ReportStatusR\x06status\x12\x1e\n" +
+	"\n" +
+	"resolution\x18\b \x01(\tR\n" +
+	"resolution\x12\x1f\n" +
+	"\vresolved_by\x18\t \x01(\tR\n" +
+	"resolvedBy\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12;\n" +
+	"\vresolved_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"resolvedAt*\x90\x01\n" +
+	"\fFriendStatus\x12\x1d\n" +
+	"\x19FRIEND_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15FRIEND_STATUS_FRIENDS\x10\x01\x12\"\n" +
+	"\x1eFRIEND_STATUS_INCOMING_REQUEST\x10\x02\x12\"\n" +
+	"\x1eFRIEND_STATUS_OUTGOING_REQUEST\x10\x03*\xba\x01\n" +
+	"\fReportReason\x12\x1d\n" +
+	"\x19REPORT_REASON_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18REPORT_REASON_HARASSMENT\x10\x01\x12\x1a\n" +
+	"\x16REPORT_REASON_CHEATING\x10\x02\x12\x16\n" +
+	"\x12REPORT_REASON_SPAM\x10\x03\x12 \n" +
+	"\x1cREPORT_REASON_OFFENSIVE_NAME\x10\x04\x12\x17\n" +
+	"\x13REPORT_REASON_OTHER\x10\x05*a\n" +
+	"\fReportStatus\x12\x1d\n" +
+	"\x19REPORT_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12REPORT_STATUS_OPEN\x10\x01\x12\x1a\n" +
+	"\x16REPORT_STATUS_RESOLVED\x10\x022\xcf\b\n" +
+	"\rSocialService\x12`\n" +
+	"\x11SendFriendRequest\x12#.social.v1.SendFriendRequestRequest\x1a$.social.v1.SendFriendRequestResponse\"\x00\x12f\n" +
+	"\x13AcceptFriendRequest\x12%.social.v1.AcceptFriendRequestRequest\x1a&.social.v1.AcceptFriendRequestResponse\"\x00\x12i\n" +
+	"\x14DeclineFriendRequest\x12&.social.v1.DeclineFriendRequestRequest\x1a'.social.v1.DeclineFriendRequestResponse\"\x00\x12Q\n" +
+	"\fRemoveFriend\x12\x1e.social.v1.RemoveFriendRequest\x1a\x1f.social.v1.RemoveFriendResponse\"\x00\x12N\n" +
+	"\vListFriends\x12\x1d.social.v1.ListFriendsRequest\x1a\x1e.social.v1.ListFriendsResponse\"\x00\x12`\n" +
+	"\x11SendDirectMessage\x12#.social.v1.SendDirectMessageRequest\x1a$.social.v1.SendDirectMessageResponse\"\x00\x12Z\n" +
+	"\x0fGetConversation\x12!.social.v1.GetConversationRequest\x1a\".social.v1.GetConversationResponse\"\x00\x12\\\n" +
+	"\x14StreamDirectMessages\x12&.social.v1.StreamDirectMessagesRequest\x1a\x18.social.v1.DirectMessage\"\x000\x01\x12H\n" +
+	"\tBlockUser\x12\x1b.social.v1.BlockUserRequest\x1a\x1c.social.v1.BlockUserResponse\"\x00\x12N\n" +
+	"\vUnblockUser\x12\x1d.social.v1.UnblockUserRequest\x1a\x1e.social.v1.UnblockUserResponse\"\x00\x12]\n" +
+	"\x10ListBlockedUsers\x12\".social.v1.ListBlockedUsersRequest\x1a#.social.v1.ListBlockedUsersResponse\"\x00\x12Q\n" +
+	"\fReportPlayer\x12\x1e.social.v1.ReportPlayerRequest\x1a\x1f.social.v1.ReportPlayerResponse\"\x00B-Z+github.com/VoidMesh/api/api/proto/social/v1b\x06proto3"
+
+var (
+	file_social_v1_social_proto_rawDescOnce sync.Once
+	file_social_v1_social_proto_rawDescData []byte
+)
+
+func file_social_v1_social_proto_rawDescGZIP() []byte {
+	file_social_v1_social_proto_rawDescOnce.Do(func() {
+		file_social_v1_social_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_social_v1_social_proto_rawDesc), len(file_social_v1_social_proto_rawDesc)))
+	})
+	return file_social_v1_social_proto_rawDescData
+}
+
+var file_social_v1_social_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_social_v1_social_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_social_v1_social_proto_goTypes = []any{
+	(FriendStatus)(0),                    // 0: social.v1.FriendStatus
+	(ReportReason)(0),                    // 1: social.v1.ReportReason
+	(ReportStatus)(0),                    // 2: social.v1.ReportStatus
+	(*Friend)(nil),                       // 3: social.v1.Friend
+	(*SendFriendRequestRequest)(nil),     // 4: social.v1.SendFriendRequestRequest
+	(*SendFriendRequestResponse)(nil),    // 5: social.v1.SendFriendRequestResponse
+	(*AcceptFriendRequestRequest)(nil),   // 6: social.v1.AcceptFriendRequestRequest
+	(*AcceptFriendRequestResponse)(nil),  // 7: social.v1.AcceptFriendRequestResponse
+	(*DeclineFriendRequestRequest)(nil),  // 8: social.v1.DeclineFriendRequestRequest
+	(*DeclineFriendRequestResponse)(nil), // 9: social.v1.DeclineFriendRequestResponse
+	(*RemoveFriendRequest)(nil),          // 10: social.v1.RemoveFriendRequest
+	(*RemoveFriendResponse)(nil),         // 11: social.v1.RemoveFriendResponse
+	(*ListFriendsRequest)(nil),           // 12: social.v1.ListFriendsRequest
+	(*ListFriendsResponse)(nil),          // 13: social.v1.ListFriendsResponse
+	(*DirectMessage)(nil),                // 14: social.v1.DirectMessage
+	(*SendDirectMessageRequest)(nil),     // 15: social.v1.SendDirectMessageRequest
+	(*SendDirectMessageResponse)(nil),    // 16: social.v1.SendDirectMessageResponse
+	(*GetConversationRequest)(nil),       // 17: social.v1.GetConversationRequest
+	(*GetConversationResponse)(nil),      // 18: social.v1.GetConversationResponse
+	(*StreamDirectMessagesRequest)(nil),  // 19: social.v1.StreamDirectMessagesRequest
+	(*BlockedUser)(nil),                  // 20: social.v1.BlockedUser
+	(*BlockUserRequest)(nil),             // 21: social.v1.BlockUserRequest
+	(*BlockUserResponse)(nil),            // 22: social.v1.BlockUserResponse
+	(*UnblockUserRequest)(nil),           // 23: social.v1.UnblockUserRequest
+	(*UnblockUserResponse)(nil),          // 24: social.v1.UnblockUserResponse
+	(*ListBlockedUsersRequest)(nil),      // 25: social.v1.ListBlockedUsersRequest
+	(*ListBlockedUsersResponse)(nil),     // 26: social.v1.ListBlockedUsersResponse
+	(*ReportPlayerRequest)(nil),          // 27: social.v1.ReportPlayerRequest
+	(*ReportPlayerResponse)(nil),         // 28: social.v1.ReportPlayerResponse
+	(*PlayerReport)(nil),                 // 29: social.v1.PlayerReport
+	(*timestamppb.Timestamp)(nil),        // 30: google.protobuf.Timestamp
+	(*v1.StreamInfo)(nil),                // 31: stream.v1.StreamInfo
+	(*v1.StreamResume)(nil),              // 32: stream.v1.StreamResume
+}
+var file_social_v1_social_proto_depIdxs = []int32{
+	0,  // 0: social.v1.Friend.status:type_name -> social.v1.FriendStatus
+	30, // 1: social.v1.Friend.since:type_name -> google.protobuf.Timestamp
+	3,  // 2: social.v1.SendFriendRequestResponse.friend:type_name -> social.v1.Friend
+	3,  // 3: social.v1.AcceptFriendRequestResponse.friend:type_name -> social.v1.Friend
+	3,  // 4: social.v1.ListFriendsResponse.friends:type_name -> social.v1.Friend
+	30, // 5: social.v1.DirectMessage.sent_at:type_name -> google.protobuf.Timestamp
+	31, // 6: social.v1.DirectMessage.stream:type_name -> stream.v1.StreamInfo
+	14, // 7: social.v1.SendDirectMessageResponse.message:type_name -> social.v1.DirectMessage
+	14, // 8: social.v1.GetConversationResponse.messages:type_name -> social.v1.DirectMessage
+	32, // 9: social.v1.StreamDirectMessagesRequest.resume:type_name -> stream.v1.StreamResume
+	30, // 10: social.v1.BlockedUser.blocked_at:type_name -> google.protobuf.Timestamp
+	20, // 11: social.v1.ListBlockedUsersResponse.users:type_name -> social.v1.BlockedUser
+	1,  // 12: social.v1.ReportPlayerRequest.reason:type_name -> social.v1.ReportReason
+	1,  // 13: social.v1.PlayerReport.reason:type_name -> social.v1.ReportReason
+	2,  // 14: social.v1.PlayerReport.status:type_name -> social.v1.ReportStatus
+	30, // 15: social.v1.PlayerReport.created_at:type_name -> google.protobuf.Timestamp
+	30, // 16: social.v1.PlayerReport.resolved_at:type_name -> google.protobuf.Timestamp
+	4,  // 17: social.v1.SocialService.SendFriendRequest:input_type -> social.v1.SendFriendRequestRequest
+	6,  // 18: social.v1.SocialService.AcceptFriendRequest:input_type -> social.v1.AcceptFriendRequestRequest
+	8,  // 19: social.v1.SocialService.DeclineFriendRequest:input_type -> social.v1.DeclineFriendRequestRequest
+	10, // 20: social.v1.SocialService.RemoveFriend:input_type -> social.v1.RemoveFriendRequest
+	12, // 21: social.v1.SocialService.ListFriends:input_type -> social.v1.ListFriendsRequest
+	15, // 22: social.v1.SocialService.SendDirectMessage:input_type -> social.v1.SendDirectMessageRequest
+	17, // 23: social.v1.SocialService.GetConversation:input_type -> social.v1.GetConversationRequest
+	19, // 24: social.v1.SocialService.StreamDirectMessages:input_type -> social.v1.StreamDirectMessagesRequest
+	21, // 25: social.v1.SocialService.BlockUser:input_type -> social.v1.BlockUserRequest
+	23, // 26: social.v1.SocialService.UnblockUser:input_type -> social.v1.UnblockUserRequest
+	25, // 27: social.v1.SocialService.ListBlockedUsers:input_type -> social.v1.ListBlockedUsersRequest
+	27, // 28: social.v1.SocialService.ReportPlayer:input_type -> social.v1.ReportPlayerRequest
+	5,  // 29: social.v1.SocialService.SendFriendRequest:output_type -> social.v1.SendFriendRequestResponse
+	7,  // 30: social.v1.SocialService.AcceptFriendRequest:output_type -> social.v1.AcceptFriendRequestResponse
+	9,  // 31: social.v1.SocialService.DeclineFriendRequest:output_type -> social.v1.DeclineFriendRequestResponse
+	11, // 32: social.v1.SocialService.RemoveFriend:output_type -> social.v1.RemoveFriendResponse
+	13, // 33: social.v1.SocialService.ListFriends:output_type -> social.v1.ListFriendsResponse
+	16, // 34: social.v1.SocialService.SendDirectMessage:output_type -> social.v1.SendDirectMessageResponse
+	18, // 35: social.v1.SocialService.GetConversation:output_type -> social.v1.GetConversationResponse
+	14, // 36: social.v1.SocialService.StreamDirectMessages:output_type -> social.v1.DirectMessage
+	22, // 37: social.v1.SocialService.BlockUser:output_type -> social.v1.BlockUserResponse
+	24, // 38: social.v1.SocialService.UnblockUser:output_type -> social.v1.UnblockUserResponse
+	26, // 39: social.v1.SocialService.ListBlockedUsers:output_type -> social.v1.ListBlockedUsersResponse
+	28, // 40: social.v1.SocialService.ReportPlayer:output_type -> social.v1.ReportPlayerResponse
+	29, // [29:41] is the sub-list for method output_type
+	17, // [17:29] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
+}
+
+func init() { file_social_v1_social_proto_init() }
+func file_social_v1_social_proto_init() {
+	if File_social_v1_social_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_social_v1_social_proto_rawDesc), len(file_social_v1_social_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   27,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_social_v1_social_proto_goTypes,
+		DependencyIndexes: file_social_v1_social_proto_depIdxs,
+		EnumInfos:         file_social_v1_social_proto_enumTypes,
+		MessageInfos:      file_social_v1_social_proto_msgTypes,
+	}.Build()
+	File_social_v1_social_proto = out.File
+	file_social_v1_social_proto_goTypes = nil
+	file_social_v1_social_proto_depIdxs = nil
+}
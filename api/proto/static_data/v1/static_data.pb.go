@@ -0,0 +1,598 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.4
+// source: static_data/v1/static_data.proto
+
+package v1
+
+import (
+	v12 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	v1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
+	v11 "github.com/VoidMesh/api/api/proto/terrain/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Item definition from the items table
+type Item struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ItemType      string                 `protobuf:"bytes,4,opt,name=item_type,json=itemType,proto3" json:"item_type,omitempty"`
+	Rarity        string                 `protobuf:"bytes,5,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	StackSize     int32                  `protobuf:"varint,6,opt,name=stack_size,json=stackSize,proto3" json:"stack_size,omitempty"`
+	VisualData    []byte                 `protobuf:"bytes,7,opt,name=visual_data,json=visualData,proto3" json:"visual_data,omitempty"` // JSON data for sprite, color, etc.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Item) Reset() {
+	*x = Item{}
+	mi := &file_static_data_v1_static_data_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Item) ProtoMessage() {}
+
+func (x *Item) ProtoReflect() protoreflect.Message {
+	mi := &file_static_data_v1_static_data_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Item.ProtoReflect.Descriptor instead.
+func (*Item) Descriptor() ([]byte, []int) {
+	return file_static_data_v1_static_data_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Item) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Item) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Item) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Item) GetItemType() string {
+	if x != nil {
+		return x.ItemType
+	}
+	return ""
+}
+
+func (x *Item) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *Item) GetStackSize() int32 {
+	if x != nil {
+		return x.StackSize
+	}
+	return 0
+}
+
+func (x *Item) GetVisualData() []byte {
+	if x != nil {
+		return x.VisualData
+	}
+	return nil
+}
+
+// Item a resource node type can drop when harvested
+type ResourceDrop struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ResourceNodeTypeId int32                  `protobuf:"varint,1,opt,name=resource_node_type_id,json=resourceNodeTypeId,proto3" json:"resource_node_type_id,omitempty"`
+	ItemId             int32                  `protobuf:"varint,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Chance             float32                `protobuf:"fixed32,3,opt,name=chance,proto3" json:"chance,omitempty"` // 0 to 1
+	MinQuantity        int32                  `protobuf:"varint,4,opt,name=min_quantity,json=minQuantity,proto3" json:"min_quantity,omitempty"`
+	MaxQuantity        int32                  `protobuf:"varint,5,opt,name=max_quantity,json=maxQuantity,proto3" json:"max_quantity,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ResourceDrop) Reset() {
+	*x = ResourceDrop{}
+	mi := &file_static_data_v1_static_data_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceDrop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceDrop) ProtoMessage() {}
+
+func (x *ResourceDrop) ProtoReflect() protoreflect.Message {
+	mi := &file_static_data_v1_static_data_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceDrop.ProtoReflect.Descriptor instead.
+func (*ResourceDrop) Descriptor() ([]byte, []int) {
+	return file_static_data_v1_static_data_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ResourceDrop) GetResourceNodeTypeId() int32 {
+	if x != nil {
+		return x.ResourceNodeTypeId
+	}
+	return 0
+}
+
+func (x *ResourceDrop) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *ResourceDrop) GetChance() float32 {
+	if x != nil {
+		return x.Chance
+	}
+	return 0
+}
+
+func (x *ResourceDrop) GetMinQuantity() int32 {
+	if x != nil {
+		return x.MinQuantity
+	}
+	return 0
+}
+
+func (x *ResourceDrop) GetMaxQuantity() int32 {
+	if x != nil {
+		return x.MaxQuantity
+	}
+	return 0
+}
+
+// Reference data of a world
+type StaticDataBundle struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Items             []*Item                `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	ResourceNodeTypes []*v1.ResourceNodeType `protobuf:"bytes,2,rep,name=resource_node_types,json=resourceNodeTypes,proto3" json:"resource_node_types,omitempty"`
+	ResourceDrops     []*ResourceDrop        `protobuf:"bytes,3,rep,name=resource_drops,json=resourceDrops,proto3" json:"resource_drops,omitempty"`
+	TerrainTypes      []*v11.TerrainTypeInfo `protobuf:"bytes,4,rep,name=terrain_types,json=terrainTypes,proto3" json:"terrain_types,omitempty"`
+	Regions           []*v12.ProtectedRegion `protobuf:"bytes,5,rep,name=regions,proto3" json:"regions,omitempty"` // Protected regions of the world
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *StaticDataBundle) Reset() {
+	*x = StaticDataBundle{}
+	mi := &file_static_data_v1_static_data_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StaticDataBundle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaticDataBundle) ProtoMessage() {}
+
+func (x *StaticDataBundle) ProtoReflect() protoreflect.Message {
+	mi := &file_static_data_v1_static_data_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaticDataBundle.ProtoReflect.Descriptor instead.
+func (*StaticDataBundle) Descriptor() ([]byte, []int) {
+	return file_static_data_v1_static_data_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StaticDataBundle) GetItems() []*Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *StaticDataBundle) GetResourceNodeTypes() []*v1.ResourceNodeType {
+	if x != nil {
+		return x.ResourceNodeTypes
+	}
+	return nil
+}
+
+func (x *StaticDataBundle) GetResourceDrops() []*ResourceDrop {
+	if x != nil {
+		return x.ResourceDrops
+	}
+	return nil
+}
+
+func (x *StaticDataBundle) GetTerrainTypes() []*v11.TerrainTypeInfo {
+	if x != nil {
+		return x.TerrainTypes
+	}
+	return nil
+}
+
+func (x *StaticDataBundle) GetRegions() []*v12.ProtectedRegion {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
+// Request for a world's bundle
+type GetStaticDataBundleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorldId       string                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`       // Optional, defaults to the session's world
+	KnownHash     string                 `protobuf:"bytes,2,opt,name=known_hash,json=knownHash,proto3" json:"known_hash,omitempty"` // Content hash of the bundle the client has; an unchanged bundle is not sent again
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStaticDataBundleRequest) Reset() {
+	*x = GetStaticDataBundleRequest{}
+	mi := &file_static_data_v1_static_data_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStaticDataBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStaticDataBundleRequest) ProtoMessage() {}
+
+func (x *GetStaticDataBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_static_data_v1_static_data_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStaticDataBundleRequest.ProtoReflect.Descriptor instead.
+func (*GetStaticDataBundleRequest) Descriptor() ([]byte, []int) {
+	return file_static_data_v1_static_data_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetStaticDataBundleRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *GetStaticDataBundleRequest) GetKnownHash() string {
+	if x != nil {
+		return x.KnownHash
+	}
+	return ""
+}
+
+// Response with the bundle
+type GetStaticDataBundleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentHash   string                 `protobuf:"bytes,1,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`  // SHA-256 of the bundle
+	NotModified   bool                   `protobuf:"varint,2,opt,name=not_modified,json=notModified,proto3" json:"not_modified,omitempty"` // The client's bundle is current and bundle is unset
+	Bundle        *StaticDataBundle      `protobuf:"bytes,3,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	BuiltAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=built_at,json=builtAt,proto3" json:"built_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStaticDataBundleResponse) Reset() {
+	*x = GetStaticDataBundleResponse{}
+	mi := &file_static_data_v1_static_data_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStaticDataBundleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStaticDataBundleResponse) ProtoMessage() {}
+
+func (x *GetStaticDataBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_static_data_v1_static_data_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStaticDataBundleResponse.ProtoReflect.Descriptor instead.
+func (*GetStaticDataBundleResponse) Descriptor() ([]byte, []int) {
+	return file_static_data_v1_static_data_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetStaticDataBundleResponse) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+func (x *GetStaticDataBundleResponse) GetNotModified() bool {
+	if x != nil {
+		return x.NotModified
+	}
+	return false
+}
+
+func (x *GetStaticDataBundleResponse) GetBundle() *StaticDataBundle {
+	if x != nil {
+		return x.Bundle
+	}
+	return nil
+}
+
+func (x *GetStaticDataBundleResponse) GetBuiltAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BuiltAt
+	}
+	return nil
+}
+
+// Request for the content hash of a world's bundle
+type CheckVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorldId       string                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"` // Optional, defaults to the session's world
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckVersionRequest) Reset() {
+	*x = CheckVersionRequest{}
+	mi := &file_static_data_v1_static_data_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckVersionRequest) ProtoMessage() {}
+
+func (x *CheckVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_static_data_v1_static_data_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckVersionRequest.ProtoReflect.Descriptor instead.
+func (*CheckVersionRequest) Descriptor() ([]byte, []int) {
+	return file_static_data_v1_static_data_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CheckVersionRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+// Response with the content hash
+type CheckVersionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentHash   string                 `protobuf:"bytes,1,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckVersionResponse) Reset() {
+	*x = CheckVersionResponse{}
+	mi := &file_static_data_v1_static_data_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckVersionResponse) ProtoMessage() {}
+
+func (x *CheckVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_static_data_v1_static_data_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckVersionResponse.ProtoReflect.Descriptor instead.
+func (*CheckVersionResponse) Descriptor() ([]byte, []int) {
+	return file_static_data_v1_static_data_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CheckVersionResponse) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+var File_static_data_v1_static_data_proto protoreflect.FileDescriptor
+
+const file_static_data_v1_static_data_proto_rawDesc = "" +
+	"\n" +
+	" static_data/v1/static_data.proto\x12\x0estatic_data.v1\x1a\x14chunk/v1/chunk.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$resource_node/v1/resource_node.proto\x1a\x18terrain/v1/terrain.proto\"\xc1\x01\n" +
+	"\x04Item\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1b\n" +
+	"\titem_type\x18\x04 \x01(\tR\bitemType\x12\x16\n" +
+	"\x06rarity\x18\x05 \x01(\tR\x06rarity\x12\x1d\n" +
+	"\n" +
+	"stack_size\x18\x06 \x01(\x05R\tstackSize\x12\x1f\n" +
+	"\vvisual_data\x18\a \x01(\fR\n" +
+	"visualData\"\xb8\x01\n" +
+	"\fResourceDrop\x121\n" +
+	"\x15resource_node_type_id\x18\x01 \x01(\x05R\x12resourceNodeTypeId\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\x05R\x06itemId\x12\x16\n" +
+	"\x06chance\x18\x03 \x01(\x02R\x06chance\x12!\n" +
+	"\fmin_quantity\x18\x04 \x01(\x05R\vminQuantity\x12!\n" +
+	"\fmax_quantity\x18\x05 \x01(\x05R\vmaxQuantity\"\xce\x02\n" +
+	"\x10StaticDataBundle\x12*\n" +
+	"\x05items\x18\x01 \x03(\v2\x14.static_data.v1.ItemR\x05items\x12R\n" +
+	"\x13resource_node_types\x18\x02 \x03(\v2\".resource_node.v1.ResourceNodeTypeR\x11resourceNodeTypes\x12C\n" +
+	"\x0eresource_drops\x18\x03 \x03(\v2\x1c.static_data.v1.ResourceDropR\rresourceDrops\x12@\n" +
+	"\rterrain_types\x18\x04 \x03(\v2\x1b.terrain.v1.TerrainTypeInfoR\fterrainTypes\x123\n" +
+	"\aregions\x18\x05 \x03(\v2\x19.chunk.v1.ProtectedRegionR\aregions\"V\n" +
+	"\x1aGetStaticDataBundleRequest\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\tR\aworldId\x12\x1d\n" +
+	"\n" +
+	"known_hash\x18\x02 \x01(\tR\tknownHash\"\xd4\x01\n" +
+	"\x1bGetStaticDataBundleResponse\x12!\n" +
+	"\fcontent_hash\x18\x01 \x01(\tR\vcontentHash\x12!\n" +
+	"\fnot_modified\x18\x02 \x01(\bR\vnotModified\x128\n" +
+	"\x06bundle\x18\x03 \x01(\v2 .static_data.v1.StaticDataBundleR\x06bundle\x125\n" +
+	"\bbuilt_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\abuiltAt\"0\n" +
+	"\x13CheckVersionRequest\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\tR\aworldId\"9\n" +
+	"\x14CheckVersionResponse\x12!\n" +
+	"\fcontent_hash\x18\x01 \x01(\tR\vcontentHash2\xe2\x01\n" +
+	"\x11StaticDataService\x12p\n" +
+	"\x13GetStaticDataBundle\x12*.static_data.v1.GetStaticDataBundleRequest\x1a+.static_data.v1.GetStaticDataBundleResponse\"\x00\x12[\n" +
+	"\fCheckVersion\x12#.static_data.v1.CheckVersionRequest\x1a$.static_data.v1.CheckVersionResponse\"\x00B2Z0github.com/VoidMesh/api/api/proto/static_data/v1b\x06proto3"
+
+var (
+	file_static_data_v1_static_data_proto_rawDescOnce sync.Once
+	file_static_data_v1_static_data_proto_rawDescData []byte
+)
+
+func file_static_data_v1_static_data_proto_rawDescGZIP() []byte {
+	file_static_data_v1_static_data_proto_rawDescOnce.Do(func() {
+		file_static_data_v1_static_data_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_static_data_v1_static_data_proto_rawDesc), len(file_static_data_v1_static_data_proto_rawDesc)))
+	})
+	return file_static_data_v1_static_data_proto_rawDescData
+}
+
+var file_static_data_v1_static_data_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_static_data_v1_static_data_proto_goTypes = []any{
+	(*Item)(nil),                        // 0: static_data.v1.Item
+	(*ResourceDrop)(nil),                // 1: static_data.v1.ResourceDrop
+	(*StaticDataBundle)(nil),            // 2: static_data.v1.StaticDataBundle
+	(*GetStaticDataBundleRequest)(nil),  // 3: static_data.v1.GetStaticDataBundleRequest
+	(*GetStaticDataBundleResponse)(nil), // 4: static_data.v1.GetStaticDataBundleResponse
+	(*CheckVersionRequest)(nil),         // 5: static_data.v1.CheckVersionRequest
+	(*CheckVersionResponse)(nil),        // 6: static_data.v1.CheckVersionResponse
+	(*v1.ResourceNodeType)(nil),         // 7: resource_node.v1.ResourceNodeType
+	(*v11.TerrainTypeInfo)(nil),         // 8: terrain.v1.TerrainTypeInfo
+	(*v12.ProtectedRegion)(nil),         // 9: chunk.v1.ProtectedRegion
+	(*timestamppb.Timestamp)(nil),       // 10: google.protobuf.Timestamp
+}
+var file_static_data_v1_static_data_proto_depIdxs = []int32{
+	0,  // 0: static_data.v1.StaticDataBundle.items:type_name -> static_data.v1.Item
+	7,  // 1: static_data.v1.StaticDataBundle.resource_node_types:type_name -> resource_node.v1.ResourceNodeType
+	1,  // 2: static_data.v1.StaticDataBundle.resource_drops:type_name -> static_data.v1.ResourceDrop
+	8,  // 3: static_data.v1.StaticDataBundle.terrain_types:type_name -> terrain.v1.TerrainTypeInfo
+	9,  // 4: static_data.v1.StaticDataBundle.regions:type_name -> chunk.v1.ProtectedRegion
+	2,  // 5: static_data.v1.GetStaticDataBundleResponse.bundle:type_name -> static_data.v1.StaticDataBundle
+	10, // 6: static_data.v1.GetStaticDataBundleResponse.built_at:type_name -> google.protobuf.Timestamp
+	3,  // 7: static_data.v1.StaticDataService.GetStaticDataBundle:input_type -> static_data.v1.GetStaticDataBundleRequest
+	5,  // 8: static_data.v1.StaticDataService.CheckVersion:input_type -> static_data.v1.CheckVersionRequest
+	4,  // 9: static_data.v1.StaticDataService.GetStaticDataBundle:output_type -> static_data.v1.GetStaticDataBundleResponse
+	6,  // 10: static_data.v1.StaticDataService.CheckVersion:output_type -> static_data.v1.CheckVersionResponse
+	9,  // [9:11] is the sub-list for method output_type
+	7,  // [7:9] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_static_data_v1_static_data_proto_init() }
+func file_static_data_v1_static_data_proto_init() {
+	if File_static_data_v1_static_data_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_static_data_v1_static_data_proto_rawDesc), len(file_static_data_v1_static_data_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_static_data_v1_static_data_proto_goTypes,
+		DependencyIndexes: file_static_data_v1_static_data_proto_depIdxs,
+		MessageInfos:      file_static_data_v1_static_data_proto_msgTypes,
+	}.Build()
+	File_static_data_v1_static_data_proto = out.File
+	file_static_data_v1_static_data_proto_goTypes = nil
+	file_static_data_v1_static_data_proto_depIdxs = nil
+}
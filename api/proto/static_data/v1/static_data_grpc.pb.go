@@ -0,0 +1,169 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.4
+// source: static_data/v1/static_data.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	StaticDataService_GetStaticDataBundle_FullMethodName = "/static_data.v1.StaticDataService/GetStaticDataBundle"
+	StaticDataService_CheckVersion_FullMethodName        = "/static_data.v1.StaticDataService/CheckVersion"
+)
+
+// StaticDataServiceClient is the client API for StaticDataService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// StaticDataService serves the reference data clients need to render and explain the
+// world, versioned by a hash of its contents so clients download it only when it changes
+type StaticDataServiceClient interface {
+	// All reference data of a world in one response
+	GetStaticDataBundle(ctx context.Context, in *GetStaticDataBundleRequest, opts ...grpc.CallOption) (*GetStaticDataBundleResponse, error)
+	// The content hash of the current bundle, to check a cached bundle is still current
+	CheckVersion(ctx context.Context, in *CheckVersionRequest, opts ...grpc.CallOption) (*CheckVersionResponse, error)
+}
+
+type staticDataServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStaticDataServiceClient(cc grpc.ClientConnInterface) StaticDataServiceClient {
+	return &staticDataServiceClient{cc}
+}
+
+func (c *staticDataServiceClient) GetStaticDataBundle(ctx context.Context, in *GetStaticDataBundleRequest, opts ...grpc.CallOption) (*GetStaticDataBundleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStaticDataBundleResponse)
+	err := c.cc.Invoke(ctx, StaticDataService_GetStaticDataBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staticDataServiceClient) CheckVersion(ctx context.Context, in *CheckVersionRequest, opts ...grpc.CallOption) (*CheckVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckVersionResponse)
+	err := c.cc.Invoke(ctx, StaticDataService_CheckVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StaticDataServiceServer is the server API for StaticDataService service.
+// All implementations must embed UnimplementedStaticDataServiceServer
+// for forward compatibility.
+//
+// StaticDataService serves the reference data clients need to render and explain the
+// world, versioned by a hash of its contents so clients download it only when it changes
+type StaticDataServiceServer interface {
+	// All reference data of a world in one response
+	GetStaticDataBundle(context.Context, *GetStaticDataBundleRequest) (*GetStaticDataBundleResponse, error)
+	// The content hash of the current bundle, to check a cached bundle is still current
+	CheckVersion(context.Context, *CheckVersionRequest) (*CheckVersionResponse, error)
+	mustEmbedUnimplementedStaticDataServiceServer()
+}
+
+// UnimplementedStaticDataServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedStaticDataServiceServer struct{}
+
+func (UnimplementedStaticDataServiceServer) GetStaticDataBundle(context.Context, *GetStaticDataBundleRequest) (*GetStaticDataBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStaticDataBundle not implemented")
+}
+func (UnimplementedStaticDataServiceServer) CheckVersion(context.Context, *CheckVersionRequest) (*CheckVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckVersion not implemented")
+}
+func (UnimplementedStaticDataServiceServer) mustEmbedUnimplementedStaticDataServiceServer() {}
+func (UnimplementedStaticDataServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeStaticDataServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StaticDataServiceServer will
+// result in compilation errors.
+type UnsafeStaticDataServiceServer interface {
+	mustEmbedUnimplementedStaticDataServiceServer()
+}
+
+func RegisterStaticDataServiceServer(s grpc.ServiceRegistrar, srv StaticDataServiceServer) {
+	// If the following call pancis, it indicates UnimplementedStaticDataServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&StaticDataService_ServiceDesc, srv)
+}
+
+func _StaticDataService_GetStaticDataBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStaticDataBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaticDataServiceServer).GetStaticDataBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaticDataService_GetStaticDataBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaticDataServiceServer).GetStaticDataBundle(ctx, req.(*GetStaticDataBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaticDataService_CheckVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaticDataServiceServer).CheckVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaticDataService_CheckVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaticDataServiceServer).CheckVersion(ctx, req.(*CheckVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StaticDataService_ServiceDesc is the grpc.ServiceDesc for StaticDataService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StaticDataService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "static_data.v1.StaticDataService",
+	HandlerType: (*StaticDataServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetStaticDataBundle",
+			Handler:    _StaticDataService_GetStaticDataBundle_Handler,
+		},
+		{
+			MethodName: "CheckVersion",
+			Handler:    _StaticDataService_CheckVersion_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "static_data/v1/static_data.proto",
+}
@@ -0,0 +1,217 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.4
+// source: stream/v1/stream.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Delivery information on every message a streaming RPC sends.
+//
+// Streams deliver at least once: a client that reconnects with StreamResume is sent
+// again every message after the sequence it acknowledges, so it must ignore sequences it
+// has already processed. Within a stream, sequences start at 1 and increase by one per
+// message, so a gap means messages were lost. Messages published while no stream was
+// open are not kept; when a resume is refused the first message has restarted set and the
+// client reloads its state through the unary RPCs (ResyncState, ListNotifications,
+// GetConversation).
+type StreamInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StreamId      string                 `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"` // Pass it in StreamResume to resume this stream
+	Sequence      uint64                 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`                // Heartbeats repeat the sequence of the last message
+	Heartbeat     bool                   `protobuf:"varint,3,opt,name=heartbeat,proto3" json:"heartbeat,omitempty"`              // Carries no payload; sent when the stream opens and periodically after
+	Restarted     bool                   `protobuf:"varint,4,opt,name=restarted,proto3" json:"restarted,omitempty"`              // The requested resume was not possible and messages may have been missed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamInfo) Reset() {
+	*x = StreamInfo{}
+	mi := &file_stream_v1_stream_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamInfo) ProtoMessage() {}
+
+func (x *StreamInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_stream_v1_stream_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamInfo.ProtoReflect.Descriptor instead.
+func (*StreamInfo) Descriptor() ([]byte, []int) {
+	return file_stream_v1_stream_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StreamInfo) GetStreamId() string {
+	if x != nil {
+		return x.StreamId
+	}
+	return ""
+}
+
+func (x *StreamInfo) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *StreamInfo) GetHeartbeat() bool {
+	if x != nil {
+		return x.Heartbeat
+	}
+	return false
+}
+
+func (x *StreamInfo) GetRestarted() bool {
+	if x != nil {
+		return x.Restarted
+	}
+	return false
+}
+
+// Sent by a client reopening a stream that disconnected. Messages sent after the
+// acknowledged sequence are sent again before new ones.
+type StreamResume struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	StreamId             string                 `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	AcknowledgedSequence uint64                 `protobuf:"varint,2,opt,name=acknowledged_sequence,json=acknowledgedSequence,proto3" json:"acknowledged_sequence,omitempty"` // Last sequence the client processed
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *StreamResume) Reset() {
+	*x = StreamResume{}
+	mi := &file_stream_v1_stream_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamResume) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResume) ProtoMessage() {}
+
+func (x *StreamResume) ProtoReflect() protoreflect.Message {
+	mi := &file_stream_v1_stream_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResume.ProtoReflect.Descriptor instead.
+func (*StreamResume) Descriptor() ([]byte, []int) {
+	return file_stream_v1_stream_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StreamResume) GetStreamId() string {
+	if x != nil {
+		return x.StreamId
+	}
+	return ""
+}
+
+func (x *StreamResume) GetAcknowledgedSequence() uint64 {
+	if x != nil {
+		return x.AcknowledgedSequence
+	}
+	return 0
+}
+
+var File_stream_v1_stream_proto protoreflect.FileDescriptor
+
+const file_stream_v1_stream_proto_rawDesc = "" +
+	"\n" +
+	"\x16stream/v1/stream.proto\x12\tstream.v1\"\x81\x01\n" +
+	"\n" +
+	"StreamInfo\x12\x1b\n" +
+	"\tstream_id\x18\x01 \x01(\tR\bstreamId\x12\x1a\n" +
+	"\bsequence\x18\x02 \x01(\x04R\bsequence\x12\x1c\n" +
+	"\theartbeat\x18\x03 \x01(\bR\theartbeat\x12\x1c\n" +
+	"\trestarted\x18\x04 \x01(\bR\trestarted\"`\n" +
+	"\fStreamResume\x12\x1b\n" +
+	"\tstream_id\x18\x01 \x01(\tR\bstreamId\x123\n" +
+	"\x15acknowledged_sequence\x18\x02 \x01(\x04R\x14acknowledgedSequenceB-Z+github.com/VoidMesh/api/api/proto/stream/v1b\x06proto3"
+
+var (
+	file_stream_v1_stream_proto_rawDescOnce sync.Once
+	file_stream_v1_stream_proto_rawDescData []byte
+)
+
+func file_stream_v1_stream_proto_rawDescGZIP() []byte {
+	file_stream_v1_stream_proto_rawDescOnce.Do(func() {
+		file_stream_v1_stream_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_stream_v1_stream_proto_rawDesc), len(file_stream_v1_stream_proto_rawDesc)))
+	})
+	return file_stream_v1_stream_proto_rawDescData
+}
+
+var file_stream_v1_stream_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_stream_v1_stream_proto_goTypes = []any{
+	(*StreamInfo)(nil),   // 0: stream.v1.StreamInfo
+	(*StreamResume)(nil), // 1: stream.v1.StreamResume
+}
+var file_stream_v1_stream_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_stream_v1_stream_proto_init() }
+func file_stream_v1_stream_proto_init() {
+	if File_stream_v1_stream_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_stream_v1_stream_proto_rawDesc), len(file_stream_v1_stream_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_stream_v1_stream_proto_goTypes,
+		DependencyIndexes: file_stream_v1_stream_proto_depIdxs,
+		MessageInfos:      file_stream_v1_stream_proto_msgTypes,
+	}.Build()
+	File_stream_v1_stream_proto = out.File
+	file_stream_v1_stream_proto_goTypes = nil
+	file_stream_v1_stream_proto_depIdxs = nil
+}
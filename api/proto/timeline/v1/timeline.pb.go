@@ -0,0 +1,421 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.4
+// source: timeline/v1/timeline.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TimelineEntryType int32
+
+const (
+	TimelineEntryType_TIMELINE_ENTRY_TYPE_UNSPECIFIED          TimelineEntryType = 0
+	TimelineEntryType_TIMELINE_ENTRY_TYPE_RARE_EVENT_SPAWNED   TimelineEntryType = 1
+	TimelineEntryType_TIMELINE_ENTRY_TYPE_RARE_EVENT_COMPLETED TimelineEntryType = 2
+	TimelineEntryType_TIMELINE_ENTRY_TYPE_REGION_DISCOVERED    TimelineEntryType = 3
+	TimelineEntryType_TIMELINE_ENTRY_TYPE_MARKET_FIRST_SALE    TimelineEntryType = 4 // The first sale of an item in the world
+	TimelineEntryType_TIMELINE_ENTRY_TYPE_MARKET_MILESTONE     TimelineEntryType = 5 // The world's 100th, 1000th, ... sale
+)
+
+// Enum value maps for TimelineEntryType.
+var (
+	TimelineEntryType_name = map[int32]string{
+		0: "TIMELINE_ENTRY_TYPE_UNSPECIFIED",
+		1: "TIMELINE_ENTRY_TYPE_RARE_EVENT_SPAWNED",
+		2: "TIMELINE_ENTRY_TYPE_RARE_EVENT_COMPLETED",
+		3: "TIMELINE_ENTRY_TYPE_REGION_DISCOVERED",
+		4: "TIMELINE_ENTRY_TYPE_MARKET_FIRST_SALE",
+		5: "TIMELINE_ENTRY_TYPE_MARKET_MILESTONE",
+	}
+	TimelineEntryType_value = map[string]int32{
+		"TIMELINE_ENTRY_TYPE_UNSPECIFIED":          0,
+		"TIMELINE_ENTRY_TYPE_RARE_EVENT_SPAWNED":   1,
+		"TIMELINE_ENTRY_TYPE_RARE_EVENT_COMPLETED": 2,
+		"TIMELINE_ENTRY_TYPE_REGION_DISCOVERED":    3,
+		"TIMELINE_ENTRY_TYPE_MARKET_FIRST_SALE":    4,
+		"TIMELINE_ENTRY_TYPE_MARKET_MILESTONE":     5,
+	}
+)
+
+func (x TimelineEntryType) Enum() *TimelineEntryType {
+	p := new(TimelineEntryType)
+	*p = x
+	return p
+}
+
+func (x TimelineEntryType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimelineEntryType) Descriptor() protoreflect.EnumDescriptor {
+	return file_timeline_v1_timeline_proto_enumTypes[0].Descriptor()
+}
+
+func (TimelineEntryType) Type() protoreflect.EnumType {
+	return &file_timeline_v1_timeline_proto_enumTypes[0]
+}
+
+func (x TimelineEntryType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimelineEntryType.Descriptor instead.
+func (TimelineEntryType) EnumDescriptor() ([]byte, []int) {
+	return file_timeline_v1_timeline_proto_rawDescGZIP(), []int{0}
+}
+
+type TimelineEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // Increases with every entry; pass it as before_id or after_id to page
+	WorldId       string                 `protobuf:"bytes,2,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
+	Type          TimelineEntryType      `protobuf:"varint,3,opt,name=type,proto3,enum=timeline.v1.TimelineEntryType" json:"type,omitempty"`
+	Summary       string                 `protobuf:"bytes,4,opt,name=summary,proto3" json:"summary,omitempty"`                                                                           // A line for display, e.g. "A meteor has been completed"
+	Details       map[string]string      `protobuf:"bytes,5,rep,name=details,proto3" json:"details,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Type-specific, e.g. kind, x and y of rare events
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimelineEntry) Reset() {
+	*x = TimelineEntry{}
+	mi := &file_timeline_v1_timeline_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimelineEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimelineEntry) ProtoMessage() {}
+
+func (x *TimelineEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_timeline_v1_timeline_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimelineEntry.ProtoReflect.Descriptor instead.
+func (*TimelineEntry) Descriptor() ([]byte, []int) {
+	return file_timeline_v1_timeline_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TimelineEntry) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TimelineEntry) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *TimelineEntry) GetType() TimelineEntryType {
+	if x != nil {
+		return x.Type
+	}
+	return TimelineEntryType_TIMELINE_ENTRY_TYPE_UNSPECIFIED
+}
+
+func (x *TimelineEntry) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *TimelineEntry) GetDetails() map[string]string {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *TimelineEntry) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+type ListWorldTimelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorldId       string                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`     // Defaults to the caller's session world
+	BeforeId      int64                  `protobuf:"varint,2,opt,name=before_id,json=beforeId,proto3" json:"before_id,omitempty"` // Entries before this ID; 0 for the newest
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                       // Defaults to 50, at most 200
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWorldTimelineRequest) Reset() {
+	*x = ListWorldTimelineRequest{}
+	mi := &file_timeline_v1_timeline_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWorldTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorldTimelineRequest) ProtoMessage() {}
+
+func (x *ListWorldTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timeline_v1_timeline_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorldTimelineRequest.ProtoReflect.Descriptor instead.
+func (*ListWorldTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_timeline_v1_timeline_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListWorldTimelineRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *ListWorldTimelineRequest) GetBeforeId() int64 {
+	if x != nil {
+		return x.BeforeId
+	}
+	return 0
+}
+
+func (x *ListWorldTimelineRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListWorldTimelineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*TimelineEntry       `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWorldTimelineResponse) Reset() {
+	*x = ListWorldTimelineResponse{}
+	mi := &file_timeline_v1_timeline_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWorldTimelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorldTimelineResponse) ProtoMessage() {}
+
+func (x *ListWorldTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timeline_v1_timeline_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorldTimelineResponse.ProtoReflect.Descriptor instead.
+func (*ListWorldTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_timeline_v1_timeline_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListWorldTimelineResponse) GetEntries() []*TimelineEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type StreamWorldTimelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorldId       string                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`  // Defaults to the caller's session world
+	AfterId       int64                  `protobuf:"varint,2,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"` // The last entry the client has; 0 only streams new entries
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamWorldTimelineRequest) Reset() {
+	*x = StreamWorldTimelineRequest{}
+	mi := &file_timeline_v1_timeline_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamWorldTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamWorldTimelineRequest) ProtoMessage() {}
+
+func (x *StreamWorldTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timeline_v1_timeline_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamWorldTimelineRequest.ProtoReflect.Descriptor instead.
+func (*StreamWorldTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_timeline_v1_timeline_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StreamWorldTimelineRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *StreamWorldTimelineRequest) GetAfterId() int64 {
+	if x != nil {
+		return x.AfterId
+	}
+	return 0
+}
+
+var File_timeline_v1_timeline_proto protoreflect.FileDescriptor
+
+const file_timeline_v1_timeline_proto_rawDesc = "" +
+	"\n" +
+	"\x1atimeline/v1/timeline.proto\x12\vtimeline.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc4\x02\n" +
+	"\rTimelineEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\bworld_id\x18\x02 \x01(\tR\aworldId\x122\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x1e.timeline.v1.TimelineEntryTypeR\x04type\x12\x18\n" +
+	"\asummary\x18\x04 \x01(\tR\asummary\x12A\n" +
+	"\adetails\x18\x05 \x03(\v2'.timeline.v1.TimelineEntry.DetailsEntryR\adetails\x12;\n" +
+	"\voccurred_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x1a:\n" +
+	"\fDetailsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"h\n" +
+	"\x18ListWorldTimelineRequest\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\tR\aworldId\x12\x1b\n" +
+	"\tbefore_id\x18\x02 \x01(\x03R\bbeforeId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"Q\n" +
+	"\x19ListWorldTimelineResponse\x124\n" +
+	"\aentries\x18\x01 \x03(\v2\x1a.timeline.v1.TimelineEntryR\aentries\"R\n" +
+	"\x1aStreamWorldTimelineRequest\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\tR\aworldId\x12\x19\n" +
+	"\bafter_id\x18\x02 \x01(\x03R\aafterId*\x92\x02\n" +
+	"\x11TimelineEntryType\x12#\n" +
+	"\x1fTIMELINE_ENTRY_TYPE_UNSPECIFIED\x10\x00\x12*\n" +
+	"&TIMELINE_ENTRY_TYPE_RARE_EVENT_SPAWNED\x10\x01\x12,\n" +
+	"(TIMELINE_ENTRY_TYPE_RARE_EVENT_COMPLETED\x10\x02\x12)\n" +
+	"%TIMELINE_ENTRY_TYPE_REGION_DISCOVERED\x10\x03\x12)\n" +
+	"%TIMELINE_ENTRY_TYPE_MARKET_FIRST_SALE\x10\x04\x12(\n" +
+	"$TIMELINE_ENTRY_TYPE_MARKET_MILESTONE\x10\x052\xd7\x01\n" +
+	"\x0fTimelineService\x12d\n" +
+	"\x11ListWorldTimeline\x12%.timeline.v1.ListWorldTimelineRequest\x1a&.timeline.v1.ListWorldTimelineResponse\"\x00\x12^\n" +
+	"\x13StreamWorldTimeline\x12'.timeline.v1.StreamWorldTimelineRequest\x1a\x1a.timeline.v1.TimelineEntry\"\x000\x01B/Z-github.com/VoidMesh/api/api/proto/timeline/v1b\x06proto3"
+
+var (
+	file_timeline_v1_timeline_proto_rawDescOnce sync.Once
+	file_timeline_v1_timeline_proto_rawDescData []byte
+)
+
+func file_timeline_v1_timeline_proto_rawDescGZIP() []byte {
+	file_timeline_v1_timeline_proto_rawDescOnce.Do(func() {
+		file_timeline_v1_timeline_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_timeline_v1_timeline_proto_rawDesc), len(file_timeline_v1_timeline_proto_rawDesc)))
+	})
+	return file_timeline_v1_timeline_proto_rawDescData
+}
+
+var file_timeline_v1_timeline_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_timeline_v1_timeline_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_timeline_v1_timeline_proto_goTypes = []any{
+	(TimelineEntryType)(0),             // 0: timeline.v1.TimelineEntryType
+	(*TimelineEntry)(nil),              // 1: timeline.v1.TimelineEntry
+	(*ListWorldTimelineRequest)(nil),   // 2: timeline.v1.ListWorldTimelineRequest
+	(*ListWorldTimelineResponse)(nil),  // 3: timeline.v1.ListWorldTimelineResponse
+	(*StreamWorldTimelineRequest)(nil), // 4: timeline.v1.StreamWorldTimelineRequest
+	nil,                                // 5: timeline.v1.TimelineEntry.DetailsEntry
+	(*timestamppb.Timestamp)(nil),      // 6: google.protobuf.Timestamp
+}
+var file_timeline_v1_timeline_proto_depIdxs = []int32{
+	0, // 0: timeline.v1.TimelineEntry.type:type_name -> timeline.v1.TimelineEntryType
+	5, // 1: timeline.v1.TimelineEntry.details:type_name -> timeline.v1.TimelineEntry.DetailsEntry
+	6, // 2: timeline.v1.TimelineEntry.occurred_at:type_name -> google.protobuf.Timestamp
+	1, // 3: timeline.v1.ListWorldTimelineResponse.entries:type_name -> timeline.v1.TimelineEntry
+	2, // 4: timeline.v1.TimelineService.ListWorldTimeline:input_type -> timeline.v1.ListWorldTimelineRequest
+	4, // 5: timeline.v1.TimelineService.StreamWorldTimeline:input_type -> timeline.v1.StreamWorldTimelineRequest
+	3, // 6: timeline.v1.TimelineService.ListWorldTimeline:output_type -> timeline.v1.ListWorldTimelineResponse
+	1, // 7: timeline.v1.TimelineService.StreamWorldTimeline:output_type -> timeline.v1.TimelineEntry
+	6, // [6:8] is the sub-list for method output_type
+	4, // [4:6] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_timeline_v1_timeline_proto_init() }
+func file_timeline_v1_timeline_proto_init() {
+	if File_timeline_v1_timeline_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_timeline_v1_timeline_proto_rawDesc), len(file_timeline_v1_timeline_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_timeline_v1_timeline_proto_goTypes,
+		DependencyIndexes: file_timeline_v1_timeline_proto_depIdxs,
+		EnumInfos:         file_timeline_v1_timeline_proto_enumTypes,
+		MessageInfos:      file_timeline_v1_timeline_proto_msgTypes,
+	}.Build()
+	File_timeline_v1_timeline_proto = out.File
+	file_timeline_v1_timeline_proto_goTypes = nil
+	file_timeline_v1_timeline_proto_depIdxs = nil
+}
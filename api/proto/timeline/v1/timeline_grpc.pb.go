@@ -0,0 +1,173 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.4
+// source: timeline/v1/timeline.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TimelineService_ListWorldTimeline_FullMethodName   = "/timeline.v1.TimelineService/ListWorldTimeline"
+	TimelineService_StreamWorldTimeline_FullMethodName = "/timeline.v1.TimelineService/StreamWorldTimeline"
+)
+
+// TimelineServiceClient is the client API for TimelineService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// The timeline is a world's shared history: rare events spawning and being completed,
+// regions discovered for the first time and market milestones.
+type TimelineServiceClient interface {
+	// Lists a world's entries, newest first
+	ListWorldTimeline(ctx context.Context, in *ListWorldTimelineRequest, opts ...grpc.CallOption) (*ListWorldTimelineResponse, error)
+	// Delivers the entries after after_id, then new entries as they happen, oldest first
+	StreamWorldTimeline(ctx context.Context, in *StreamWorldTimelineRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TimelineEntry], error)
+}
+
+type timelineServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTimelineServiceClient(cc grpc.ClientConnInterface) TimelineServiceClient {
+	return &timelineServiceClient{cc}
+}
+
+func (c *timelineServiceClient) ListWorldTimeline(ctx context.Context, in *ListWorldTimelineRequest, opts ...grpc.CallOption) (*ListWorldTimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWorldTimelineResponse)
+	err := c.cc.Invoke(ctx, TimelineService_ListWorldTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timelineServiceClient) StreamWorldTimeline(ctx context.Context, in *StreamWorldTimelineRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TimelineEntry], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TimelineService_ServiceDesc.Streams[0], TimelineService_StreamWorldTimeline_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamWorldTimelineRequest, TimelineEntry]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TimelineService_StreamWorldTimelineClient = grpc.ServerStreamingClient[TimelineEntry]
+
+// TimelineServiceServer is the server API for TimelineService service.
+// All implementations must embed UnimplementedTimelineServiceServer
+// for forward compatibility.
+//
+// The timeline is a world's shared history: rare events spawning and being completed,
+// regions discovered for the first time and market milestones.
+type TimelineServiceServer interface {
+	// Lists a world's entries, newest first
+	ListWorldTimeline(context.Context, *ListWorldTimelineRequest) (*ListWorldTimelineResponse, error)
+	// Delivers the entries after after_id, then new entries as they happen, oldest first
+	StreamWorldTimeline(*StreamWorldTimelineRequest, grpc.ServerStreamingServer[TimelineEntry]) error
+	mustEmbedUnimplementedTimelineServiceServer()
+}
+
+// UnimplementedTimelineServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTimelineServiceServer struct{}
+
+func (UnimplementedTimelineServiceServer) ListWorldTimeline(context.Context, *ListWorldTimelineRequest) (*ListWorldTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorldTimeline not implemented")
+}
+func (UnimplementedTimelineServiceServer) StreamWorldTimeline(*StreamWorldTimelineRequest, grpc.ServerStreamingServer[TimelineEntry]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamWorldTimeline not implemented")
+}
+func (UnimplementedTimelineServiceServer) mustEmbedUnimplementedTimelineServiceServer() {}
+func (UnimplementedTimelineServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeTimelineServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TimelineServiceServer will
+// result in compilation errors.
+type UnsafeTimelineServiceServer interface {
+	mustEmbedUnimplementedTimelineServiceServer()
+}
+
+func RegisterTimelineServiceServer(s grpc.ServiceRegistrar, srv TimelineServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTimelineServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TimelineService_ServiceDesc, srv)
+}
+
+func _TimelineService_ListWorldTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorldTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimelineServiceServer).ListWorldTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimelineService_ListWorldTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimelineServiceServer).ListWorldTimeline(ctx, req.(*ListWorldTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimelineService_StreamWorldTimeline_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamWorldTimelineRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TimelineServiceServer).StreamWorldTimeline(m, &grpc.GenericServerStream[StreamWorldTimelineRequest, TimelineEntry]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TimelineService_StreamWorldTimelineServer = grpc.ServerStreamingServer[TimelineEntry]
+
+// TimelineService_ServiceDesc is the grpc.ServiceDesc for TimelineService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TimelineService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "timeline.v1.TimelineService",
+	HandlerType: (*TimelineServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListWorldTimeline",
+			Handler:    _TimelineService_ListWorldTimeline_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamWorldTimeline",
+			Handler:       _TimelineService_StreamWorldTimeline_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "timeline/v1/timeline.proto",
+}
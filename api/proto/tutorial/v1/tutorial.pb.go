@@ -0,0 +1,375 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.4
+// source: tutorial/v1/tutorial.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Tutorial steps in the order they must be completed
+type TutorialStep int32
+
+const (
+	TutorialStep_TUTORIAL_STEP_UNSPECIFIED TutorialStep = 0
+	TutorialStep_TUTORIAL_STEP_MOVE        TutorialStep = 1
+	TutorialStep_TUTORIAL_STEP_HARVEST     TutorialStep = 2
+	TutorialStep_TUTORIAL_STEP_CRAFT       TutorialStep = 3
+	TutorialStep_TUTORIAL_STEP_TRADE       TutorialStep = 4
+)
+
+// Enum value maps for TutorialStep.
+var (
+	TutorialStep_name = map[int32]string{
+		0: "TUTORIAL_STEP_UNSPECIFIED",
+		1: "TUTORIAL_STEP_MOVE",
+		2: "TUTORIAL_STEP_HARVEST",
+		3: "TUTORIAL_STEP_CRAFT",
+		4: "TUTORIAL_STEP_TRADE",
+	}
+	TutorialStep_value = map[string]int32{
+		"TUTORIAL_STEP_UNSPECIFIED": 0,
+		"TUTORIAL_STEP_MOVE":        1,
+		"TUTORIAL_STEP_HARVEST":     2,
+		"TUTORIAL_STEP_CRAFT":       3,
+		"TUTORIAL_STEP_TRADE":       4,
+	}
+)
+
+func (x TutorialStep) Enum() *TutorialStep {
+	p := new(TutorialStep)
+	*p = x
+	return p
+}
+
+func (x TutorialStep) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TutorialStep) Descriptor() protoreflect.EnumDescriptor {
+	return file_tutorial_v1_tutorial_proto_enumTypes[0].Descriptor()
+}
+
+func (TutorialStep) Type() protoreflect.EnumType {
+	return &file_tutorial_v1_tutorial_proto_enumTypes[0]
+}
+
+func (x TutorialStep) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TutorialStep.Descriptor instead.
+func (TutorialStep) EnumDescriptor() ([]byte, []int) {
+	return file_tutorial_v1_tutorial_proto_rawDescGZIP(), []int{0}
+}
+
+type TutorialStepState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Step          TutorialStep           `protobuf:"varint,1,opt,name=step,proto3,enum=tutorial.v1.TutorialStep" json:"step,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"` // Unset until the step is completed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TutorialStepState) Reset() {
+	*x = TutorialStepState{}
+	mi := &file_tutorial_v1_tutorial_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TutorialStepState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TutorialStepState) ProtoMessage() {}
+
+func (x *TutorialStepState) ProtoReflect() protoreflect.Message {
+	mi := &file_tutorial_v1_tutorial_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TutorialStepState.ProtoReflect.Descriptor instead.
+func (*TutorialStepState) Descriptor() ([]byte, []int) {
+	return file_tutorial_v1_tutorial_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TutorialStepState) GetStep() TutorialStep {
+	if x != nil {
+		return x.Step
+	}
+	return TutorialStep_TUTORIAL_STEP_UNSPECIFIED
+}
+
+func (x *TutorialStepState) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+type TutorialState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	Steps         []*TutorialStepState   `protobuf:"bytes,2,rep,name=steps,proto3" json:"steps,omitempty"`                                                               // Every step, in order
+	CurrentStep   TutorialStep           `protobuf:"varint,3,opt,name=current_step,json=currentStep,proto3,enum=tutorial.v1.TutorialStep" json:"current_step,omitempty"` // UNSPECIFIED once the tutorial is complete
+	Completed     bool                   `protobuf:"varint,4,opt,name=completed,proto3" json:"completed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TutorialState) Reset() {
+	*x = TutorialState{}
+	mi := &file_tutorial_v1_tutorial_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TutorialState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TutorialState) ProtoMessage() {}
+
+func (x *TutorialState) ProtoReflect() protoreflect.Message {
+	mi := &file_tutorial_v1_tutorial_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TutorialState.ProtoReflect.Descriptor instead.
+func (*TutorialState) Descriptor() ([]byte, []int) {
+	return file_tutorial_v1_tutorial_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TutorialState) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *TutorialState) GetSteps() []*TutorialStepState {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *TutorialState) GetCurrentStep() TutorialStep {
+	if x != nil {
+		return x.CurrentStep
+	}
+	return TutorialStep_TUTORIAL_STEP_UNSPECIFIED
+}
+
+func (x *TutorialState) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+type GetTutorialStateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTutorialStateRequest) Reset() {
+	*x = GetTutorialStateRequest{}
+	mi := &file_tutorial_v1_tutorial_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTutorialStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTutorialStateRequest) ProtoMessage() {}
+
+func (x *GetTutorialStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tutorial_v1_tutorial_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTutorialStateRequest.ProtoReflect.Descriptor instead.
+func (*GetTutorialStateRequest) Descriptor() ([]byte, []int) {
+	return file_tutorial_v1_tutorial_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetTutorialStateRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+type GetTutorialStateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         *TutorialState         `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTutorialStateResponse) Reset() {
+	*x = GetTutorialStateResponse{}
+	mi := &file_tutorial_v1_tutorial_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTutorialStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTutorialStateResponse) ProtoMessage() {}
+
+func (x *GetTutorialStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tutorial_v1_tutorial_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTutorialStateResponse.ProtoReflect.Descriptor instead.
+func (*GetTutorialStateResponse) Descriptor() ([]byte, []int) {
+	return file_tutorial_v1_tutorial_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetTutorialStateResponse) GetState() *TutorialState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+var File_tutorial_v1_tutorial_proto protoreflect.FileDescriptor
+
+const file_tutorial_v1_tutorial_proto_rawDesc = "" +
+	"\n" +
+	"\x1atutorial/v1/tutorial.proto\x12\vtutorial.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x81\x01\n" +
+	"\x11TutorialStepState\x12-\n" +
+	"\x04step\x18\x01 \x01(\x0e2\x19.tutorial.v1.TutorialStepR\x04step\x12=\n" +
+	"\fcompleted_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"\xc4\x01\n" +
+	"\rTutorialState\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x124\n" +
+	"\x05steps\x18\x02 \x03(\v2\x1e.tutorial.v1.TutorialStepStateR\x05steps\x12<\n" +
+	"\fcurrent_step\x18\x03 \x01(\x0e2\x19.tutorial.v1.TutorialStepR\vcurrentStep\x12\x1c\n" +
+	"\tcompleted\x18\x04 \x01(\bR\tcompleted\"<\n" +
+	"\x17GetTutorialStateRequest\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\"L\n" +
+	"\x18GetTutorialStateResponse\x120\n" +
+	"\x05state\x18\x01 \x01(\v2\x1a.tutorial.v1.TutorialStateR\x05state*\x92\x01\n" +
+	"\fTutorialStep\x12\x1d\n" +
+	"\x19TUTORIAL_STEP_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12TUTORIAL_STEP_MOVE\x10\x01\x12\x19\n" +
+	"\x15TUTORIAL_STEP_HARVEST\x10\x02\x12\x17\n" +
+	"\x13TUTORIAL_STEP_CRAFT\x10\x03\x12\x17\n" +
+	"\x13TUTORIAL_STEP_TRADE\x10\x042t\n" +
+	"\x0fTutorialService\x12a\n" +
+	"\x10GetTutorialState\x12$.tutorial.v1.GetTutorialStateRequest\x1a%.tutorial.v1.GetTutorialStateResponse\"\x00B/Z-github.com/VoidMesh/api/api/proto/tutorial/v1b\x06proto3"
+
+var (
+	file_tutorial_v1_tutorial_proto_rawDescOnce sync.Once
+	file_tutorial_v1_tutorial_proto_rawDescData []byte
+)
+
+func file_tutorial_v1_tutorial_proto_rawDescGZIP() []byte {
+	file_tutorial_v1_tutorial_proto_rawDescOnce.Do(func() {
+		file_tutorial_v1_tutorial_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tutorial_v1_tutorial_proto_rawDesc), len(file_tutorial_v1_tutorial_proto_rawDesc)))
+	})
+	return file_tutorial_v1_tutorial_proto_rawDescData
+}
+
+var file_tutorial_v1_tutorial_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_tutorial_v1_tutorial_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_tutorial_v1_tutorial_proto_goTypes = []any{
+	(TutorialStep)(0),                // 0: tutorial.v1.TutorialStep
+	(*TutorialStepState)(nil),        // 1: tutorial.v1.TutorialStepState
+	(*TutorialState)(nil),            // 2: tutorial.v1.TutorialState
+	(*GetTutorialStateRequest)(nil),  // 3: tutorial.v1.GetTutorialStateRequest
+	(*GetTutorialStateResponse)(nil), // 4: tutorial.v1.GetTutorialStateResponse
+	(*timestamppb.Timestamp)(nil),    // 5: google.protobuf.Timestamp
+}
+var file_tutorial_v1_tutorial_proto_depIdxs = []int32{
+	0, // 0: tutorial.v1.TutorialStepState.step:type_name -> tutorial.v1.TutorialStep
+	5, // 1: tutorial.v1.TutorialStepState.completed_at:type_name -> google.protobuf.Timestamp
+	1, // 2: tutorial.v1.TutorialState.steps:type_name -> tutorial.v1.TutorialStepState
+	0, // 3: tutorial.v1.TutorialState.current_step:type_name -> tutorial.v1.TutorialStep
+	2, // 4: tutorial.v1.GetTutorialStateResponse.state:type_name -> tutorial.v1.TutorialState
+	3, // 5: tutorial.v1.TutorialService.GetTutorialState:input_type -> tutorial.v1.GetTutorialStateRequest
+	4, // 6: tutorial.v1.TutorialService.GetTutorialState:output_type -> tutorial.v1.GetTutorialStateResponse
+	6, // [6:7] is the sub-list for method output_type
+	5, // [5:6] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_tutorial_v1_tutorial_proto_init() }
+func file_tutorial_v1_tutorial_proto_init() {
+	if File_tutorial_v1_tutorial_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tutorial_v1_tutorial_proto_rawDesc), len(file_tutorial_v1_tutorial_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_tutorial_v1_tutorial_proto_goTypes,
+		DependencyIndexes: file_tutorial_v1_tutorial_proto_depIdxs,
+		EnumInfos:         file_tutorial_v1_tutorial_proto_enumTypes,
+		MessageInfos:      file_tutorial_v1_tutorial_proto_msgTypes,
+	}.Build()
+	File_tutorial_v1_tutorial_proto = out.File
+	file_tutorial_v1_tutorial_proto_goTypes = nil
+	file_tutorial_v1_tutorial_proto_depIdxs = nil
+}
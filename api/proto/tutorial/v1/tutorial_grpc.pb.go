@@ -0,0 +1,127 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.4
+// source: tutorial/v1/tutorial.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TutorialService_GetTutorialState_FullMethodName = "/tutorial.v1.TutorialService/GetTutorialState"
+)
+
+// TutorialServiceClient is the client API for TutorialService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Tracks each character's progress through the starter tutorial. Steps are completed in
+// order by playing the game; clients only read the state to render onboarding.
+type TutorialServiceClient interface {
+	GetTutorialState(ctx context.Context, in *GetTutorialStateRequest, opts ...grpc.CallOption) (*GetTutorialStateResponse, error)
+}
+
+type tutorialServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTutorialServiceClient(cc grpc.ClientConnInterface) TutorialServiceClient {
+	return &tutorialServiceClient{cc}
+}
+
+func (c *tutorialServiceClient) GetTutorialState(ctx context.Context, in *GetTutorialStateRequest, opts ...grpc.CallOption) (*GetTutorialStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTutorialStateResponse)
+	err := c.cc.Invoke(ctx, TutorialService_GetTutorialState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TutorialServiceServer is the server API for TutorialService service.
+// All implementations must embed UnimplementedTutorialServiceServer
+// for forward compatibility.
+//
+// Tracks each character's progress through the starter tutorial. Steps are completed in
+// order by playing the game; clients only read the state to render onboarding.
+type TutorialServiceServer interface {
+	GetTutorialState(context.Context, *GetTutorialStateRequest) (*GetTutorialStateResponse, error)
+	mustEmbedUnimplementedTutorialServiceServer()
+}
+
+// UnimplementedTutorialServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTutorialServiceServer struct{}
+
+func (UnimplementedTutorialServiceServer) GetTutorialState(context.Context, *GetTutorialStateRequest) (*GetTutorialStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTutorialState not implemented")
+}
+func (UnimplementedTutorialServiceServer) mustEmbedUnimplementedTutorialServiceServer() {}
+func (UnimplementedTutorialServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeTutorialServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TutorialServiceServer will
+// result in compilation errors.
+type UnsafeTutorialServiceServer interface {
+	mustEmbedUnimplementedTutorialServiceServer()
+}
+
+func RegisterTutorialServiceServer(s grpc.ServiceRegistrar, srv TutorialServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTutorialServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TutorialService_ServiceDesc, srv)
+}
+
+func _TutorialService_GetTutorialState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTutorialStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TutorialServiceServer).GetTutorialState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TutorialService_GetTutorialState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TutorialServiceServer).GetTutorialState(ctx, req.(*GetTutorialStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TutorialService_ServiceDesc is the grpc.ServiceDesc for TutorialService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TutorialService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tutorial.v1.TutorialService",
+	HandlerType: (*TutorialServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTutorialState",
+			Handler:    _TutorialService_GetTutorialState_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tutorial/v1/tutorial.proto",
+}
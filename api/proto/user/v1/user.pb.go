@@ -23,6 +23,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ConsentKind int32
+
+const (
+	ConsentKind_CONSENT_KIND_UNSPECIFIED      ConsentKind = 0
+	ConsentKind_CONSENT_KIND_TERMS_OF_SERVICE ConsentKind = 1
+	ConsentKind_CONSENT_KIND_PRIVACY_POLICY   ConsentKind = 2
+)
+
+// Enum value maps for ConsentKind.
+var (
+	ConsentKind_name = map[int32]string{
+		0: "CONSENT_KIND_UNSPECIFIED",
+		1: "CONSENT_KIND_TERMS_OF_SERVICE",
+		2: "CONSENT_KIND_PRIVACY_POLICY",
+	}
+	ConsentKind_value = map[string]int32{
+		"CONSENT_KIND_UNSPECIFIED":      0,
+		"CONSENT_KIND_TERMS_OF_SERVICE": 1,
+		"CONSENT_KIND_PRIVACY_POLICY":   2,
+	}
+)
+
+func (x ConsentKind) Enum() *ConsentKind {
+	p := new(ConsentKind)
+	*p = x
+	return p
+}
+
+func (x ConsentKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConsentKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_user_v1_user_proto_enumTypes[0].Descriptor()
+}
+
+func (ConsentKind) Type() protoreflect.EnumType {
+	return &file_user_v1_user_proto_enumTypes[0]
+}
+
+func (x ConsentKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConsentKind.Descriptor instead.
+func (ConsentKind) EnumDescriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{0}
+}
+
 type User struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
 	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -137,7 +186,8 @@ type CreateUserRequest struct {
 	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
 	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	Password      string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"` // Plain password, will be hashed server-side
+	Password      string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`                             // Plain password, will be hashed server-side
+	CaptchaToken  string                 `protobuf:"bytes,5,opt,name=captcha_token,json=captchaToken,proto3" json:"captcha_token,omitempty"` // Required when the server has CAPTCHA verification configured
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -200,6 +250,13 @@ func (x *CreateUserRequest) GetPassword() string {
 	return ""
 }
 
+func (x *CreateUserRequest) GetCaptchaToken() string {
+	if x != nil {
+		return x.CaptchaToken
+	}
+	return ""
+}
+
 type CreateUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
@@ -1089,6 +1146,7 @@ type LoginRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	UsernameOrEmail string                 `protobuf:"bytes,1,opt,name=username_or_email,json=usernameOrEmail,proto3" json:"username_or_email,omitempty"`
 	Password        string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	WorldId         string                 `protobuf:"bytes,3,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"` // Optional world to bind the session to
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -1137,6 +1195,13 @@ func (x *LoginRequest) GetPassword() string {
 	return ""
 }
 
+func (x *LoginRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
 type LoginResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"` // JWT or session token
@@ -1269,92 +1334,1351 @@ func (x *LogoutResponse) GetSuccess() bool {
 	return false
 }
 
-var File_user_v1_user_proto protoreflect.FileDescriptor
+// A login session of the user
+type Session struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastSeenAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"` // Updated at most once a minute
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	IpAddress     string                 `protobuf:"bytes,5,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"` // Address the session logged in from
+	UserAgent     string                 `protobuf:"bytes,6,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	Current       bool                   `protobuf:"varint,7,opt,name=current,proto3" json:"current,omitempty"`                     // The session making the request
+	RevokedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"` // Only set on revoked sessions
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_user_v1_user_proto_rawDesc = "" +
-	"\n" +
-	"\x12user/v1/user.proto\x12\auser.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\xe8\x02\n" +
-	"\x04User\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
-	"\busername\x18\x02 \x01(\tR\busername\x12!\n" +
-	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x12\x14\n" +
-	"\x05email\x18\x04 \x01(\tR\x05email\x12%\n" +
-	"\x0eemail_verified\x18\x05 \x01(\bR\remailVerified\x129\n" +
-	"\n" +
-	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12>\n" +
-	"\rlast_login_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vlastLoginAt\x12%\n" +
-	"\x0eaccount_locked\x18\b \x01(\bR\raccountLocked\x122\n" +
-	"\x15failed_login_attempts\x18\t \x01(\x05R\x13failedLoginAttempts\"\x84\x01\n" +
-	"\x11CreateUserRequest\x12\x1a\n" +
-	"\busername\x18\x01 \x01(\tR\busername\x12!\n" +
-	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x14\n" +
-	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x04 \x01(\tR\bpassword\"7\n" +
-	"\x12CreateUserResponse\x12!\n" +
-	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\" \n" +
-	"\x0eGetUserRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"4\n" +
-	"\x0fGetUserResponse\x12!\n" +
-	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\"-\n" +
-	"\x15GetUserByEmailRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\";\n" +
-	"\x16GetUserByEmailResponse\x12!\n" +
-	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\"6\n" +
-	"\x18GetUserByUsernameRequest\x12\x1a\n" +
-	"\busername\x18\x01 \x01(\tR\busername\">\n" +
-	"\x19GetUserByUsernameResponse\x12!\n" +
-	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\"\x95\x02\n" +
-	"\x11UpdateUserRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12?\n" +
-	"\fdisplay_name\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\vdisplayName\x122\n" +
-	"\x05email\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\x05email\x12A\n" +
-	"\x0eemail_verified\x18\x04 \x01(\v2\x1a.google.protobuf.BoolValueR\remailVerified\x128\n" +
-	"\bpassword\x18\x05 \x01(\v2\x1c.google.protobuf.StringValueR\bpassword\"7\n" +
-	"\x12UpdateUserResponse\x12!\n" +
-	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\"#\n" +
-	"\x11DeleteUserRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x14\n" +
-	"\x12DeleteUserResponse\"@\n" +
-	"\x10ListUsersRequest\x12\x14\n" +
-	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x02 \x01(\x05R\x06offset\"8\n" +
-	"\x11ListUsersResponse\x12#\n" +
-	"\x05users\x18\x01 \x03(\v2\r.user.v1.UserR\x05users\"3\n" +
-	"\x1bRequestPasswordResetRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\"8\n" +
-	"\x1cRequestPasswordResetResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"O\n" +
-	"\x14ResetPasswordRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\x12!\n" +
-	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\"1\n" +
-	"\x15ResetPasswordResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"$\n" +
-	"\x12VerifyEmailRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"/\n" +
-	"\x13VerifyEmailResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"V\n" +
-	"\fLoginRequest\x12*\n" +
-	"\x11username_or_email\x18\x01 \x01(\tR\x0fusernameOrEmail\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"H\n" +
-	"\rLoginResponse\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\x12!\n" +
-	"\x04user\x18\x02 \x01(\v2\r.user.v1.UserR\x04user\"\x0f\n" +
-	"\rLogoutRequest\"*\n" +
-	"\x0eLogoutResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\x9d\a\n" +
-	"\vUserService\x12G\n" +
-	"\n" +
-	"CreateUser\x12\x1a.user.v1.CreateUserRequest\x1a\x1b.user.v1.CreateUserResponse\"\x00\x12>\n" +
-	"\aGetUser\x12\x17.user.v1.GetUserRequest\x1a\x18.user.v1.GetUserResponse\"\x00\x12S\n" +
-	"\x0eGetUserByEmail\x12\x1e.user.v1.GetUserByEmailRequest\x1a\x1f.user.v1.GetUserByEmailResponse\"\x00\x12\\\n" +
-	"\x11GetUserByUsername\x12!.user.v1.GetUserByUsernameRequest\x1a\".user.v1.GetUserByUsernameResponse\"\x00\x12G\n" +
-	"\n" +
-	"UpdateUser\x12\x1a.user.v1.UpdateUserRequest\x1a\x1b.user.v1.UpdateUserResponse\"\x00\x12G\n" +
-	"\n" +
-	"DeleteUser\x12\x1a.user.v1.DeleteUserRequest\x1a\x1b.user.v1.DeleteUserResponse\"\x00\x12D\n" +
-	"\tListUsers\x12\x19.user.v1.ListUsersRequest\x1a\x1a.user.v1.ListUsersResponse\"\x00\x128\n" +
-	"\x05Login\x12\x15.user.v1.LoginRequest\x1a\x16.user.v1.LoginResponse\"\x00\x12;\n" +
-	"\x06Logout\x12\x16.user.v1.LogoutRequest\x1a\x17.user.v1.LogoutResponse\"\x00\x12e\n" +
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_user_v1_user_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Session) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Session) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Session) GetLastSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return nil
+}
+
+func (x *Session) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Session) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *Session) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *Session) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+func (x *Session) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+type ListSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsRequest) ProtoMessage() {}
+
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{26}
+}
+
+type ListSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*Session             `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"` // Active sessions, most recently seen first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse) ProtoMessage() {}
+
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListSessionsResponse) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type RevokeSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionRequest) Reset() {
+	*x = RevokeSessionRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionRequest) ProtoMessage() {}
+
+func (x *RevokeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RevokeSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type RevokeSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Session       *Session               `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionResponse) Reset() {
+	*x = RevokeSessionResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionResponse) ProtoMessage() {}
+
+func (x *RevokeSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionResponse.ProtoReflect.Descriptor instead.
+func (*RevokeSessionResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RevokeSessionResponse) GetSession() *Session {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+type SyncClockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Probe         string                 `protobuf:"bytes,1,opt,name=probe,proto3" json:"probe,omitempty"`                             // The probe from the previous SyncClockResponse, echoed unchanged
+	ClientTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=client_time,json=clientTime,proto3" json:"client_time,omitempty"` // The client's clock when sending the request
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncClockRequest) Reset() {
+	*x = SyncClockRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncClockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncClockRequest) ProtoMessage() {}
+
+func (x *SyncClockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncClockRequest.ProtoReflect.Descriptor instead.
+func (*SyncClockRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SyncClockRequest) GetProbe() string {
+	if x != nil {
+		return x.Probe
+	}
+	return ""
+}
+
+func (x *SyncClockRequest) GetClientTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClientTime
+	}
+	return nil
+}
+
+type SyncClockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Probe         string                 `protobuf:"bytes,1,opt,name=probe,proto3" json:"probe,omitempty"` // Echo in the next SyncClock call, promptly; probes expire after 2 seconds
+	ServerTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"`
+	RttMs         int64                  `protobuf:"varint,3,opt,name=rtt_ms,json=rttMs,proto3" json:"rtt_ms,omitempty"`                   // Smoothed round trip time; 0 until a probe has been echoed
+	OffsetMs      int64                  `protobuf:"varint,4,opt,name=offset_ms,json=offsetMs,proto3" json:"offset_ms,omitempty"`          // Client clock minus server clock
+	Samples       int32                  `protobuf:"varint,5,opt,name=samples,proto3" json:"samples,omitempty"`                            // Round trips measured this session
+	AllowanceMs   int64                  `protobuf:"varint,6,opt,name=allowance_ms,json=allowanceMs,proto3" json:"allowance_ms,omitempty"` // How much earlier than their arrival timed actions may be credited
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncClockResponse) Reset() {
+	*x = SyncClockResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncClockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncClockResponse) ProtoMessage() {}
+
+func (x *SyncClockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncClockResponse.ProtoReflect.Descriptor instead.
+func (*SyncClockResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SyncClockResponse) GetProbe() string {
+	if x != nil {
+		return x.Probe
+	}
+	return ""
+}
+
+func (x *SyncClockResponse) GetServerTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ServerTime
+	}
+	return nil
+}
+
+func (x *SyncClockResponse) GetRttMs() int64 {
+	if x != nil {
+		return x.RttMs
+	}
+	return 0
+}
+
+func (x *SyncClockResponse) GetOffsetMs() int64 {
+	if x != nil {
+		return x.OffsetMs
+	}
+	return 0
+}
+
+func (x *SyncClockResponse) GetSamples() int32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *SyncClockResponse) GetAllowanceMs() int64 {
+	if x != nil {
+		return x.AllowanceMs
+	}
+	return 0
+}
+
+// An external account linked to a user
+type LinkedIdentity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"` // discord, google
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"` // The account's name at the provider
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	LinkedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=linked_at,json=linkedAt,proto3" json:"linked_at,omitempty"`
+	LastLoginAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_login_at,json=lastLoginAt,proto3" json:"last_login_at,omitempty"` // Unset until first used to log in
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkedIdentity) Reset() {
+	*x = LinkedIdentity{}
+	mi := &file_user_v1_user_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkedIdentity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkedIdentity) ProtoMessage() {}
+
+func (x *LinkedIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkedIdentity.ProtoReflect.Descriptor instead.
+func (*LinkedIdentity) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *LinkedIdentity) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *LinkedIdentity) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *LinkedIdentity) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *LinkedIdentity) GetLinkedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LinkedAt
+	}
+	return nil
+}
+
+func (x *LinkedIdentity) GetLastLoginAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastLoginAt
+	}
+	return nil
+}
+
+type OAuthLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	AccessToken   string                 `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"` // Issued to this server's client ID by the provider
+	WorldId       string                 `protobuf:"bytes,3,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`             // Optional world to bind the session to
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OAuthLoginRequest) Reset() {
+	*x = OAuthLoginRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OAuthLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OAuthLoginRequest) ProtoMessage() {}
+
+func (x *OAuthLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OAuthLoginRequest.ProtoReflect.Descriptor instead.
+func (*OAuthLoginRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *OAuthLoginRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *OAuthLoginRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *OAuthLoginRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+type OAuthLoginResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	User          *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Created       bool                   `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"` // The login created the account
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OAuthLoginResponse) Reset() {
+	*x = OAuthLoginResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OAuthLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OAuthLoginResponse) ProtoMessage() {}
+
+func (x *OAuthLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OAuthLoginResponse.ProtoReflect.Descriptor instead.
+func (*OAuthLoginResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *OAuthLoginResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *OAuthLoginResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *OAuthLoginResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+type LinkIdentityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	AccessToken   string                 `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkIdentityRequest) Reset() {
+	*x = LinkIdentityRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkIdentityRequest) ProtoMessage() {}
+
+func (x *LinkIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkIdentityRequest.ProtoReflect.Descriptor instead.
+func (*LinkIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *LinkIdentityRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *LinkIdentityRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+type LinkIdentityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identity      *LinkedIdentity        `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkIdentityResponse) Reset() {
+	*x = LinkIdentityResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkIdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkIdentityResponse) ProtoMessage() {}
+
+func (x *LinkIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkIdentityResponse.ProtoReflect.Descriptor instead.
+func (*LinkIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *LinkIdentityResponse) GetIdentity() *LinkedIdentity {
+	if x != nil {
+		return x.Identity
+	}
+	return nil
+}
+
+type UnlinkIdentityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkIdentityRequest) Reset() {
+	*x = UnlinkIdentityRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkIdentityRequest) ProtoMessage() {}
+
+func (x *UnlinkIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkIdentityRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UnlinkIdentityRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+type UnlinkIdentityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identity      *LinkedIdentity        `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkIdentityResponse) Reset() {
+	*x = UnlinkIdentityResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkIdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkIdentityResponse) ProtoMessage() {}
+
+func (x *UnlinkIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkIdentityResponse.ProtoReflect.Descriptor instead.
+func (*UnlinkIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UnlinkIdentityResponse) GetIdentity() *LinkedIdentity {
+	if x != nil {
+		return x.Identity
+	}
+	return nil
+}
+
+type ListIdentitiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIdentitiesRequest) Reset() {
+	*x = ListIdentitiesRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIdentitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIdentitiesRequest) ProtoMessage() {}
+
+func (x *ListIdentitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIdentitiesRequest.ProtoReflect.Descriptor instead.
+func (*ListIdentitiesRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{39}
+}
+
+type ListIdentitiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identities    []*LinkedIdentity      `protobuf:"bytes,1,rep,name=identities,proto3" json:"identities,omitempty"`
+	Providers     []string               `protobuf:"bytes,2,rep,name=providers,proto3" json:"providers,omitempty"` // Providers this server accepts
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIdentitiesResponse) Reset() {
+	*x = ListIdentitiesResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIdentitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIdentitiesResponse) ProtoMessage() {}
+
+func (x *ListIdentitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIdentitiesResponse.ProtoReflect.Descriptor instead.
+func (*ListIdentitiesResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListIdentitiesResponse) GetIdentities() []*LinkedIdentity {
+	if x != nil {
+		return x.Identities
+	}
+	return nil
+}
+
+func (x *ListIdentitiesResponse) GetProviders() []string {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+// The current version of a consent document and where the caller stands with it
+type ConsentDocument struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Kind            ConsentKind            `protobuf:"varint,1,opt,name=kind,proto3,enum=user.v1.ConsentKind" json:"kind,omitempty"`
+	Version         int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // Current version
+	Url             string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`          // Where the full text is published
+	Summary         string                 `protobuf:"bytes,4,opt,name=summary,proto3" json:"summary,omitempty"`  // What changed since the previous version
+	PublishedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	RequiredVersion int32                  `protobuf:"varint,6,opt,name=required_version,json=requiredVersion,proto3" json:"required_version,omitempty"` // Oldest version players may have accepted and still play
+	AcceptedVersion int32                  `protobuf:"varint,7,opt,name=accepted_version,json=acceptedVersion,proto3" json:"accepted_version,omitempty"` // Latest version the caller accepted; 0 if none
+	AcceptedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=accepted_at,json=acceptedAt,proto3" json:"accepted_at,omitempty"`
+	MustAccept      bool                   `protobuf:"varint,9,opt,name=must_accept,json=mustAccept,proto3" json:"must_accept,omitempty"` // Gameplay RPCs are rejected until the current version is accepted
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ConsentDocument) Reset() {
+	*x = ConsentDocument{}
+	mi := &file_user_v1_user_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsentDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsentDocument) ProtoMessage() {}
+
+func (x *ConsentDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsentDocument.ProtoReflect.Descriptor instead.
+func (*ConsentDocument) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ConsentDocument) GetKind() ConsentKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ConsentKind_CONSENT_KIND_UNSPECIFIED
+}
+
+func (x *ConsentDocument) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ConsentDocument) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ConsentDocument) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *ConsentDocument) GetPublishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
+}
+
+func (x *ConsentDocument) GetRequiredVersion() int32 {
+	if x != nil {
+		return x.RequiredVersion
+	}
+	return 0
+}
+
+func (x *ConsentDocument) GetAcceptedVersion() int32 {
+	if x != nil {
+		return x.AcceptedVersion
+	}
+	return 0
+}
+
+func (x *ConsentDocument) GetAcceptedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AcceptedAt
+	}
+	return nil
+}
+
+func (x *ConsentDocument) GetMustAccept() bool {
+	if x != nil {
+		return x.MustAccept
+	}
+	return false
+}
+
+type GetConsentStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConsentStatusRequest) Reset() {
+	*x = GetConsentStatusRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConsentStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConsentStatusRequest) ProtoMessage() {}
+
+func (x *GetConsentStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConsentStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetConsentStatusRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{42}
+}
+
+type GetConsentStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Documents     []*ConsentDocument     `protobuf:"bytes,1,rep,name=documents,proto3" json:"documents,omitempty"` // Kinds without a published document are left out
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConsentStatusResponse) Reset() {
+	*x = GetConsentStatusResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConsentStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConsentStatusResponse) ProtoMessage() {}
+
+func (x *GetConsentStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConsentStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetConsentStatusResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetConsentStatusResponse) GetDocuments() []*ConsentDocument {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+type AcceptConsentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          ConsentKind            `protobuf:"varint,1,opt,name=kind,proto3,enum=user.v1.ConsentKind" json:"kind,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // Must be the current version, i.e. the one shown to the player
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptConsentRequest) Reset() {
+	*x = AcceptConsentRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptConsentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptConsentRequest) ProtoMessage() {}
+
+func (x *AcceptConsentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptConsentRequest.ProtoReflect.Descriptor instead.
+func (*AcceptConsentRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *AcceptConsentRequest) GetKind() ConsentKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ConsentKind_CONSENT_KIND_UNSPECIFIED
+}
+
+func (x *AcceptConsentRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type AcceptConsentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Document      *ConsentDocument       `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptConsentResponse) Reset() {
+	*x = AcceptConsentResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptConsentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptConsentResponse) ProtoMessage() {}
+
+func (x *AcceptConsentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptConsentResponse.ProtoReflect.Descriptor instead.
+func (*AcceptConsentResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *AcceptConsentResponse) GetDocument() *ConsentDocument {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+var File_user_v1_user_proto protoreflect.FileDescriptor
+
+const file_user_v1_user_proto_rawDesc = "" +
+	"\n" +
+	"\x12user/v1/user.proto\x12\auser.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\xe8\x02\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12!\n" +
+	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12%\n" +
+	"\x0eemail_verified\x18\x05 \x01(\bR\remailVerified\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12>\n" +
+	"\rlast_login_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vlastLoginAt\x12%\n" +
+	"\x0eaccount_locked\x18\b \x01(\bR\raccountLocked\x122\n" +
+	"\x15failed_login_attempts\x18\t \x01(\x05R\x13failedLoginAttempts\"\xa9\x01\n" +
+	"\x11CreateUserRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1a\n" +
+	"\bpassword\x18\x04 \x01(\tR\bpassword\x12#\n" +
+	"\rcaptcha_token\x18\x05 \x01(\tR\fcaptchaToken\"7\n" +
+	"\x12CreateUserResponse\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\" \n" +
+	"\x0eGetUserRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"4\n" +
+	"\x0fGetUserResponse\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\"-\n" +
+	"\x15GetUserByEmailRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\";\n" +
+	"\x16GetUserByEmailResponse\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\"6\n" +
+	"\x18GetUserByUsernameRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\">\n" +
+	"\x19GetUserByUsernameResponse\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\"\x95\x02\n" +
+	"\x11UpdateUserRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12?\n" +
+	"\fdisplay_name\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\vdisplayName\x122\n" +
+	"\x05email\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\x05email\x12A\n" +
+	"\x0eemail_verified\x18\x04 \x01(\v2\x1a.google.protobuf.BoolValueR\remailVerified\x128\n" +
+	"\bpassword\x18\x05 \x01(\v2\x1c.google.protobuf.StringValueR\bpassword\"7\n" +
+	"\x12UpdateUserResponse\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\"#\n" +
+	"\x11DeleteUserRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x14\n" +
+	"\x12DeleteUserResponse\"@\n" +
+	"\x10ListUsersRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\"8\n" +
+	"\x11ListUsersResponse\x12#\n" +
+	"\x05users\x18\x01 \x03(\v2\r.user.v1.UserR\x05users\"3\n" +
+	"\x1bRequestPasswordResetRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"8\n" +
+	"\x1cRequestPasswordResetResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"O\n" +
+	"\x14ResetPasswordRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12!\n" +
+	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\"1\n" +
+	"\x15ResetPasswordResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"$\n" +
+	"\x12VerifyEmailRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"/\n" +
+	"\x13VerifyEmailResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"q\n" +
+	"\fLoginRequest\x12*\n" +
+	"\x11username_or_email\x18\x01 \x01(\tR\x0fusernameOrEmail\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x19\n" +
+	"\bworld_id\x18\x03 \x01(\tR\aworldId\"H\n" +
+	"\rLoginResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12!\n" +
+	"\x04user\x18\x02 \x01(\v2\r.user.v1.UserR\x04user\"\x0f\n" +
+	"\rLogoutRequest\"*\n" +
+	"\x0eLogoutResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xe0\x02\n" +
+	"\aSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\flast_seen_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastSeenAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x05 \x01(\tR\tipAddress\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x06 \x01(\tR\tuserAgent\x12\x18\n" +
+	"\acurrent\x18\a \x01(\bR\acurrent\x129\n" +
+	"\n" +
+	"revoked_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\"\x15\n" +
+	"\x13ListSessionsRequest\"D\n" +
+	"\x14ListSessionsResponse\x12,\n" +
+	"\bsessions\x18\x01 \x03(\v2\x10.user.v1.SessionR\bsessions\"5\n" +
+	"\x14RevokeSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"C\n" +
+	"\x15RevokeSessionResponse\x12*\n" +
+	"\asession\x18\x01 \x01(\v2\x10.user.v1.SessionR\asession\"e\n" +
+	"\x10SyncClockRequest\x12\x14\n" +
+	"\x05probe\x18\x01 \x01(\tR\x05probe\x12;\n" +
+	"\vclient_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"clientTime\"\xd7\x01\n" +
+	"\x11SyncClockResponse\x12\x14\n" +
+	"\x05probe\x18\x01 \x01(\tR\x05probe\x12;\n" +
+	"\vserver_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"serverTime\x12\x15\n" +
+	"\x06rtt_ms\x18\x03 \x01(\x03R\x05rttMs\x12\x1b\n" +
+	"\toffset_ms\x18\x04 \x01(\x03R\boffsetMs\x12\x18\n" +
+	"\asamples\x18\x05 \x01(\x05R\asamples\x12!\n" +
+	"\fallowance_ms\x18\x06 \x01(\x03R\vallowanceMs\"\xd7\x01\n" +
+	"\x0eLinkedIdentity\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x127\n" +
+	"\tlinked_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\blinkedAt\x12>\n" +
+	"\rlast_login_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vlastLoginAt\"m\n" +
+	"\x11OAuthLoginRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12!\n" +
+	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\x12\x19\n" +
+	"\bworld_id\x18\x03 \x01(\tR\aworldId\"g\n" +
+	"\x12OAuthLoginResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12!\n" +
+	"\x04user\x18\x02 \x01(\v2\r.user.v1.UserR\x04user\x12\x18\n" +
+	"\acreated\x18\x03 \x01(\bR\acreated\"T\n" +
+	"\x13LinkIdentityRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12!\n" +
+	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\"K\n" +
+	"\x14LinkIdentityResponse\x123\n" +
+	"\bidentity\x18\x01 \x01(\v2\x17.user.v1.LinkedIdentityR\bidentity\"3\n" +
+	"\x15UnlinkIdentityRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\"M\n" +
+	"\x16UnlinkIdentityResponse\x123\n" +
+	"\bidentity\x18\x01 \x01(\v2\x17.user.v1.LinkedIdentityR\bidentity\"\x17\n" +
+	"\x15ListIdentitiesRequest\"o\n" +
+	"\x16ListIdentitiesResponse\x127\n" +
+	"\n" +
+	"identities\x18\x01 \x03(\v2\x17.user.v1.LinkedIdentityR\n" +
+	"identities\x12\x1c\n" +
+	"\tproviders\x18\x02 \x03(\tR\tproviders\"\xf4\x02\n" +
+	"\x0fConsentDocument\x12(\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x14.user.v1.ConsentKindR\x04kind\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x18\n" +
+	"\asummary\x18\x04 \x01(\tR\asummary\x12=\n" +
+	"\fpublished_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vpublishedAt\x12)\n" +
+	"\x10required_version\x18\x06 \x01(\x05R\x0frequiredVersion\x12)\n" +
+	"\x10accepted_version\x18\a \x01(\x05R\x0facceptedVersion\x12;\n" +
+	"\vaccepted_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"acceptedAt\x12\x1f\n" +
+	"\vmust_accept\x18\t \x01(\bR\n" +
+	"mustAccept\"\x19\n" +
+	"\x17GetConsentStatusRequest\"R\n" +
+	"\x18GetConsentStatusResponse\x126\n" +
+	"\tdocuments\x18\x01 \x03(\v2\x18.user.v1.ConsentDocumentR\tdocuments\"Z\n" +
+	"\x14AcceptConsentRequest\x12(\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x14.user.v1.ConsentKindR\x04kind\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\"M\n" +
+	"\x15AcceptConsentResponse\x124\n" +
+	"\bdocument\x18\x01 \x01(\v2\x18.user.v1.ConsentDocumentR\bdocument*o\n" +
+	"\vConsentKind\x12\x1c\n" +
+	"\x18CONSENT_KIND_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dCONSENT_KIND_TERMS_OF_SERVICE\x10\x01\x12\x1f\n" +
+	"\x1bCONSENT_KIND_PRIVACY_POLICY\x10\x022\xf3\f\n" +
+	"\vUserService\x12G\n" +
+	"\n" +
+	"CreateUser\x12\x1a.user.v1.CreateUserRequest\x1a\x1b.user.v1.CreateUserResponse\"\x00\x12>\n" +
+	"\aGetUser\x12\x17.user.v1.GetUserRequest\x1a\x18.user.v1.GetUserResponse\"\x00\x12S\n" +
+	"\x0eGetUserByEmail\x12\x1e.user.v1.GetUserByEmailRequest\x1a\x1f.user.v1.GetUserByEmailResponse\"\x00\x12\\\n" +
+	"\x11GetUserByUsername\x12!.user.v1.GetUserByUsernameRequest\x1a\".user.v1.GetUserByUsernameResponse\"\x00\x12G\n" +
+	"\n" +
+	"UpdateUser\x12\x1a.user.v1.UpdateUserRequest\x1a\x1b.user.v1.UpdateUserResponse\"\x00\x12G\n" +
+	"\n" +
+	"DeleteUser\x12\x1a.user.v1.DeleteUserRequest\x1a\x1b.user.v1.DeleteUserResponse\"\x00\x12D\n" +
+	"\tListUsers\x12\x19.user.v1.ListUsersRequest\x1a\x1a.user.v1.ListUsersResponse\"\x00\x128\n" +
+	"\x05Login\x12\x15.user.v1.LoginRequest\x1a\x16.user.v1.LoginResponse\"\x00\x12;\n" +
+	"\x06Logout\x12\x16.user.v1.LogoutRequest\x1a\x17.user.v1.LogoutResponse\"\x00\x12M\n" +
+	"\fListSessions\x12\x1c.user.v1.ListSessionsRequest\x1a\x1d.user.v1.ListSessionsResponse\"\x00\x12P\n" +
+	"\rRevokeSession\x12\x1d.user.v1.RevokeSessionRequest\x1a\x1e.user.v1.RevokeSessionResponse\"\x00\x12D\n" +
+	"\tSyncClock\x12\x19.user.v1.SyncClockRequest\x1a\x1a.user.v1.SyncClockResponse\"\x00\x12G\n" +
+	"\n" +
+	"OAuthLogin\x12\x1a.user.v1.OAuthLoginRequest\x1a\x1b.user.v1.OAuthLoginResponse\"\x00\x12M\n" +
+	"\fLinkIdentity\x12\x1c.user.v1.LinkIdentityRequest\x1a\x1d.user.v1.LinkIdentityResponse\"\x00\x12S\n" +
+	"\x0eUnlinkIdentity\x12\x1e.user.v1.UnlinkIdentityRequest\x1a\x1f.user.v1.UnlinkIdentityResponse\"\x00\x12S\n" +
+	"\x0eListIdentities\x12\x1e.user.v1.ListIdentitiesRequest\x1a\x1f.user.v1.ListIdentitiesResponse\"\x00\x12Y\n" +
+	"\x10GetConsentStatus\x12 .user.v1.GetConsentStatusRequest\x1a!.user.v1.GetConsentStatusResponse\"\x00\x12P\n" +
+	"\rAcceptConsent\x12\x1d.user.v1.AcceptConsentRequest\x1a\x1e.user.v1.AcceptConsentResponse\"\x00\x12e\n" +
 	"\x14RequestPasswordReset\x12$.user.v1.RequestPasswordResetRequest\x1a%.user.v1.RequestPasswordResetResponse\"\x00\x12P\n" +
 	"\rResetPassword\x12\x1d.user.v1.ResetPasswordRequest\x1a\x1e.user.v1.ResetPasswordResponse\"\x00\x12J\n" +
 	"\vVerifyEmail\x12\x1b.user.v1.VerifyEmailRequest\x1a\x1c.user.v1.VerifyEmailResponse\"\x00B+Z)github.com/VoidMesh/api/api/proto/user/v1b\x06proto3"
@@ -1371,80 +2695,141 @@ func file_user_v1_user_proto_rawDescGZIP() []byte {
 	return file_user_v1_user_proto_rawDescData
 }
 
-var file_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_user_v1_user_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
 var file_user_v1_user_proto_goTypes = []any{
-	(*User)(nil),                         // 0: user.v1.User
-	(*CreateUserRequest)(nil),            // 1: user.v1.CreateUserRequest
-	(*CreateUserResponse)(nil),           // 2: user.v1.CreateUserResponse
-	(*GetUserRequest)(nil),               // 3: user.v1.GetUserRequest
-	(*GetUserResponse)(nil),              // 4: user.v1.GetUserResponse
-	(*GetUserByEmailRequest)(nil),        // 5: user.v1.GetUserByEmailRequest
-	(*GetUserByEmailResponse)(nil),       // 6: user.v1.GetUserByEmailResponse
-	(*GetUserByUsernameRequest)(nil),     // 7: user.v1.GetUserByUsernameRequest
-	(*GetUserByUsernameResponse)(nil),    // 8: user.v1.GetUserByUsernameResponse
-	(*UpdateUserRequest)(nil),            // 9: user.v1.UpdateUserRequest
-	(*UpdateUserResponse)(nil),           // 10: user.v1.UpdateUserResponse
-	(*DeleteUserRequest)(nil),            // 11: user.v1.DeleteUserRequest
-	(*DeleteUserResponse)(nil),           // 12: user.v1.DeleteUserResponse
-	(*ListUsersRequest)(nil),             // 13: user.v1.ListUsersRequest
-	(*ListUsersResponse)(nil),            // 14: user.v1.ListUsersResponse
-	(*RequestPasswordResetRequest)(nil),  // 15: user.v1.RequestPasswordResetRequest
-	(*RequestPasswordResetResponse)(nil), // 16: user.v1.RequestPasswordResetResponse
-	(*ResetPasswordRequest)(nil),         // 17: user.v1.ResetPasswordRequest
-	(*ResetPasswordResponse)(nil),        // 18: user.v1.ResetPasswordResponse
-	(*VerifyEmailRequest)(nil),           // 19: user.v1.VerifyEmailRequest
-	(*VerifyEmailResponse)(nil),          // 20: user.v1.VerifyEmailResponse
-	(*LoginRequest)(nil),                 // 21: user.v1.LoginRequest
-	(*LoginResponse)(nil),                // 22: user.v1.LoginResponse
-	(*LogoutRequest)(nil),                // 23: user.v1.LogoutRequest
-	(*LogoutResponse)(nil),               // 24: user.v1.LogoutResponse
-	(*timestamppb.Timestamp)(nil),        // 25: google.protobuf.Timestamp
-	(*wrapperspb.StringValue)(nil),       // 26: google.protobuf.StringValue
-	(*wrapperspb.BoolValue)(nil),         // 27: google.protobuf.BoolValue
+	(ConsentKind)(0),                     // 0: user.v1.ConsentKind
+	(*User)(nil),                         // 1: user.v1.User
+	(*CreateUserRequest)(nil),            // 2: user.v1.CreateUserRequest
+	(*CreateUserResponse)(nil),           // 3: user.v1.CreateUserResponse
+	(*GetUserRequest)(nil),               // 4: user.v1.GetUserRequest
+	(*GetUserResponse)(nil),              // 5: user.v1.GetUserResponse
+	(*GetUserByEmailRequest)(nil),        // 6: user.v1.GetUserByEmailRequest
+	(*GetUserByEmailResponse)(nil),       // 7: user.v1.GetUserByEmailResponse
+	(*GetUserByUsernameRequest)(nil),     // 8: user.v1.GetUserByUsernameRequest
+	(*GetUserByUsernameResponse)(nil),    // 9: user.v1.GetUserByUsernameResponse
+	(*UpdateUserRequest)(nil),            // 10: user.v1.UpdateUserRequest
+	(*UpdateUserResponse)(nil),           // 11: user.v1.UpdateUserResponse
+	(*DeleteUserRequest)(nil),            // 12: user.v1.DeleteUserRequest
+	(*DeleteUserResponse)(nil),           // 13: user.v1.DeleteUserResponse
+	(*ListUsersRequest)(nil),             // 14: user.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),            // 15: user.v1.ListUsersResponse
+	(*RequestPasswordResetRequest)(nil),  // 16: user.v1.RequestPasswordResetRequest
+	(*RequestPasswordResetResponse)(nil), // 17: user.v1.RequestPasswordResetResponse
+	(*ResetPasswordRequest)(nil),         // 18: user.v1.ResetPasswordRequest
+	(*ResetPasswordResponse)(nil),        // 19: user.v1.ResetPasswordResponse
+	(*VerifyEmailRequest)(nil),           // 20: user.v1.VerifyEmailRequest
+	(*VerifyEmailResponse)(nil),          // 21: user.v1.VerifyEmailResponse
+	(*LoginRequest)(nil),                 // 22: user.v1.LoginRequest
+	(*LoginResponse)(nil),                // 23: user.v1.LoginResponse
+	(*LogoutRequest)(nil),                // 24: user.v1.LogoutRequest
+	(*LogoutResponse)(nil),               // 25: user.v1.LogoutResponse
+	(*Session)(nil),                      // 26: user.v1.Session
+	(*ListSessionsRequest)(nil),          // 27: user.v1.ListSessionsRequest
+	(*ListSessionsResponse)(nil),         // 28: user.v1.ListSessionsResponse
+	(*RevokeSessionRequest)(nil),         // 29: user.v1.RevokeSessionRequest
+	(*RevokeSessionResponse)(nil),        // 30: user.v1.RevokeSessionResponse
+	(*SyncClockRequest)(nil),             // 31: user.v1.SyncClockRequest
+	(*SyncClockResponse)(nil),            // 32: user.v1.SyncClockResponse
+	(*LinkedIdentity)(nil),               // 33: user.v1.LinkedIdentity
+	(*OAuthLoginRequest)(nil),            // 34: user.v1.OAuthLoginRequest
+	(*OAuthLoginResponse)(nil),           // 35: user.v1.OAuthLoginResponse
+	(*LinkIdentityRequest)(nil),          // 36: user.v1.LinkIdentityRequest
+	(*LinkIdentityResponse)(nil),         // 37: user.v1.LinkIdentityResponse
+	(*UnlinkIdentityRequest)(nil),        // 38: user.v1.UnlinkIdentityRequest
+	(*UnlinkIdentityResponse)(nil),       // 39: user.v1.UnlinkIdentityResponse
+	(*ListIdentitiesRequest)(nil),        // 40: user.v1.ListIdentitiesRequest
+	(*ListIdentitiesResponse)(nil),       // 41: user.v1.ListIdentitiesResponse
+	(*ConsentDocument)(nil),              // 42: user.v1.ConsentDocument
+	(*GetConsentStatusRequest)(nil),      // 43: user.v1.GetConsentStatusRequest
+	(*GetConsentStatusResponse)(nil),     // 44: user.v1.GetConsentStatusResponse
+	(*AcceptConsentRequest)(nil),         // 45: user.v1.AcceptConsentRequest
+	(*AcceptConsentResponse)(nil),        // 46: user.v1.AcceptConsentResponse
+	(*timestamppb.Timestamp)(nil),        // 47: google.protobuf.Timestamp
+	(*wrapperspb.StringValue)(nil),       // 48: google.protobuf.StringValue
+	(*wrapperspb.BoolValue)(nil),         // 49: google.protobuf.BoolValue
 }
 var file_user_v1_user_proto_depIdxs = []int32{
-	25, // 0: user.v1.User.created_at:type_name -> google.protobuf.Timestamp
-	25, // 1: user.v1.User.last_login_at:type_name -> google.protobuf.Timestamp
-	0,  // 2: user.v1.CreateUserResponse.user:type_name -> user.v1.User
-	0,  // 3: user.v1.GetUserResponse.user:type_name -> user.v1.User
-	0,  // 4: user.v1.GetUserByEmailResponse.user:type_name -> user.v1.User
-	0,  // 5: user.v1.GetUserByUsernameResponse.user:type_name -> user.v1.User
-	26, // 6: user.v1.UpdateUserRequest.display_name:type_name -> google.protobuf.StringValue
-	26, // 7: user.v1.UpdateUserRequest.email:type_name -> google.protobuf.StringValue
-	27, // 8: user.v1.UpdateUserRequest.email_verified:type_name -> google.protobuf.BoolValue
-	26, // 9: user.v1.UpdateUserRequest.password:type_name -> google.protobuf.StringValue
-	0,  // 10: user.v1.UpdateUserResponse.user:type_name -> user.v1.User
-	0,  // 11: user.v1.ListUsersResponse.users:type_name -> user.v1.User
-	0,  // 12: user.v1.LoginResponse.user:type_name -> user.v1.User
-	1,  // 13: user.v1.UserService.CreateUser:input_type -> user.v1.CreateUserRequest
-	3,  // 14: user.v1.UserService.GetUser:input_type -> user.v1.GetUserRequest
-	5,  // 15: user.v1.UserService.GetUserByEmail:input_type -> user.v1.GetUserByEmailRequest
-	7,  // 16: user.v1.UserService.GetUserByUsername:input_type -> user.v1.GetUserByUsernameRequest
-	9,  // 17: user.v1.UserService.UpdateUser:input_type -> user.v1.UpdateUserRequest
-	11, // 18: user.v1.UserService.DeleteUser:input_type -> user.v1.DeleteUserRequest
-	13, // 19: user.v1.UserService.ListUsers:input_type -> user.v1.ListUsersRequest
-	21, // 20: user.v1.UserService.Login:input_type -> user.v1.LoginRequest
-	23, // 21: user.v1.UserService.Logout:input_type -> user.v1.LogoutRequest
-	15, // 22: user.v1.UserService.RequestPasswordReset:input_type -> user.v1.RequestPasswordResetRequest
-	17, // 23: user.v1.UserService.ResetPassword:input_type -> user.v1.ResetPasswordRequest
-	19, // 24: user.v1.UserService.VerifyEmail:input_type -> user.v1.VerifyEmailRequest
-	2,  // 25: user.v1.UserService.CreateUser:output_type -> user.v1.CreateUserResponse
-	4,  // 26: user.v1.UserService.GetUser:output_type -> user.v1.GetUserResponse
-	6,  // 27: user.v1.UserService.GetUserByEmail:output_type -> user.v1.GetUserByEmailResponse
-	8,  // 28: user.v1.UserService.GetUserByUsername:output_type -> user.v1.GetUserByUsernameResponse
-	10, // 29: user.v1.UserService.UpdateUser:output_type -> user.v1.UpdateUserResponse
-	12, // 30: user.v1.UserService.DeleteUser:output_type -> user.v1.DeleteUserResponse
-	14, // 31: user.v1.UserService.ListUsers:output_type -> user.v1.ListUsersResponse
-	22, // 32: user.v1.UserService.Login:output_type -> user.v1.LoginResponse
-	24, // 33: user.v1.UserService.Logout:output_type -> user.v1.LogoutResponse
-	16, // 34: user.v1.UserService.RequestPasswordReset:output_type -> user.v1.RequestPasswordResetResponse
-	18, // 35: user.v1.UserService.ResetPassword:output_type -> user.v1.ResetPasswordResponse
-	20, // 36: user.v1.UserService.VerifyEmail:output_type -> user.v1.VerifyEmailResponse
-	25, // [25:37] is the sub-list for method output_type
-	13, // [13:25] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	47, // 0: user.v1.User.created_at:type_name -> google.protobuf.Timestamp
+	47, // 1: user.v1.User.last_login_at:type_name -> google.protobuf.Timestamp
+	1,  // 2: user.v1.CreateUserResponse.user:type_name -> user.v1.User
+	1,  // 3: user.v1.GetUserResponse.user:type_name -> user.v1.User
+	1,  // 4: user.v1.GetUserByEmailResponse.user:type_name -> user.v1.User
+	1,  // 5: user.v1.GetUserByUsernameResponse.user:type_name -> user.v1.User
+	48, // 6: user.v1.UpdateUserRequest.display_name:type_name -> google.protobuf.StringValue
+	48, // 7: user.v1.UpdateUserRequest.email:type_name -> google.protobuf.StringValue
+	49, // 8: user.v1.UpdateUserRequest.email_verified:type_name -> google.protobuf.BoolValue
+	48, // 9: user.v1.UpdateUserRequest.password:type_name -> google.protobuf.StringValue
+	1,  // 10: user.v1.UpdateUserResponse.user:type_name -> user.v1.User
+	1,  // 11: user.v1.ListUsersResponse.users:type_name -> user.v1.User
+	1,  // 12: user.v1.LoginResponse.user:type_name -> user.v1.User
+	47, // 13: user.v1.Session.created_at:type_name -> google.protobuf.Timestamp
+	47, // 14: user.v1.Session.last_seen_at:type_name -> google.protobuf.Timestamp
+	47, // 15: user.v1.Session.expires_at:type_name -> google.protobuf.Timestamp
+	47, // 16: user.v1.Session.revoked_at:type_name -> google.protobuf.Timestamp
+	26, // 17: user.v1.ListSessionsResponse.sessions:type_name -> user.v1.Session
+	26, // 18: user.v1.RevokeSessionResponse.session:type_name -> user.v1.Session
+	47, // 19: user.v1.SyncClockRequest.client_time:type_name -> google.protobuf.Timestamp
+	47, // 20: user.v1.SyncClockResponse.server_time:type_name -> google.protobuf.Timestamp
+	47, // 21: user.v1.LinkedIdentity.linked_at:type_name -> google.protobuf.Timestamp
+	47, // 22: user.v1.LinkedIdentity.last_login_at:type_name -> google.protobuf.Timestamp
+	1,  // 23: user.v1.OAuthLoginResponse.user:type_name -> user.v1.User
+	33, // 24: user.v1.LinkIdentityResponse.identity:type_name -> user.v1.LinkedIdentity
+	33, // 25: user.v1.UnlinkIdentityResponse.identity:type_name -> user.v1.LinkedIdentity
+	33, // 26: user.v1.ListIdentitiesResponse.identities:type_name -> user.v1.LinkedIdentity
+	0,  // 27: user.v1.ConsentDocument.kind:type_name -> user.v1.ConsentKind
+	47, // 28: user.v1.ConsentDocument.published_at:type_name -> google.protobuf.Timestamp
+	47, // 29: user.v1.ConsentDocument.accepted_at:type_name -> google.protobuf.Timestamp
+	42, // 30: user.v1.GetConsentStatusResponse.documents:type_name -> user.v1.ConsentDocument
+	0,  // 31: user.v1.AcceptConsentRequest.kind:type_name -> user.v1.ConsentKind
+	42, // 32: user.v1.AcceptConsentResponse.document:type_name -> user.v1.ConsentDocument
+	2,  // 33: user.v1.UserService.CreateUser:input_type -> user.v1.CreateUserRequest
+	4,  // 34: user.v1.UserService.GetUser:input_type -> user.v1.GetUserRequest
+	6,  // 35: user.v1.UserService.GetUserByEmail:input_type -> user.v1.GetUserByEmailRequest
+	8,  // 36: user.v1.UserService.GetUserByUsername:input_type -> user.v1.GetUserByUsernameRequest
+	10, // 37: user.v1.UserService.UpdateUser:input_type -> user.v1.UpdateUserRequest
+	12, // 38: user.v1.UserService.DeleteUser:input_type -> user.v1.DeleteUserRequest
+	14, // 39: user.v1.UserService.ListUsers:input_type -> user.v1.ListUsersRequest
+	22, // 40: user.v1.UserService.Login:input_type -> user.v1.LoginRequest
+	24, // 41: user.v1.UserService.Logout:input_type -> user.v1.LogoutRequest
+	27, // 42: user.v1.UserService.ListSessions:input_type -> user.v1.ListSessionsRequest
+	29, // 43: user.v1.UserService.RevokeSession:input_type -> user.v1.RevokeSessionRequest
+	31, // 44: user.v1.UserService.SyncClock:input_type -> user.v1.SyncClockRequest
+	34, // 45: user.v1.UserService.OAuthLogin:input_type -> user.v1.OAuthLoginRequest
+	36, // 46: user.v1.UserService.LinkIdentity:input_type -> user.v1.LinkIdentityRequest
+	38, // 47: user.v1.UserService.UnlinkIdentity:input_type -> user.v1.UnlinkIdentityRequest
+	40, // 48: user.v1.UserService.ListIdentities:input_type -> user.v1.ListIdentitiesRequest
+	43, // 49: user.v1.UserService.GetConsentStatus:input_type -> user.v1.GetConsentStatusRequest
+	45, // 50: user.v1.UserService.AcceptConsent:input_type -> user.v1.AcceptConsentRequest
+	16, // 51: user.v1.UserService.RequestPasswordReset:input_type -> user.v1.RequestPasswordResetRequest
+	18, // 52: user.v1.UserService.ResetPassword:input_type -> user.v1.ResetPasswordRequest
+	20, // 53: user.v1.UserService.VerifyEmail:input_type -> user.v1.VerifyEmailRequest
+	3,  // 54: user.v1.UserService.CreateUser:output_type -> user.v1.CreateUserResponse
+	5,  // 55: user.v1.UserService.GetUser:output_type -> user.v1.GetUserResponse
+	7,  // 56: user.v1.UserService.GetUserByEmail:output_type -> user.v1.GetUserByEmailResponse
+	9,  // 57: user.v1.UserService.GetUserByUsername:output_type -> user.v1.GetUserByUsernameResponse
+	11, // 58: user.v1.UserService.UpdateUser:output_type -> user.v1.UpdateUserResponse
+	13, // 59: user.v1.UserService.DeleteUser:output_type -> user.v1.DeleteUserResponse
+	15, // 60: user.v1.UserService.ListUsers:output_type -> user.v1.ListUsersResponse
+	23, // 61: user.v1.UserService.Login:output_type -> user.v1.LoginResponse
+	25, // 62: user.v1.UserService.Logout:output_type -> user.v1.LogoutResponse
+	28, // 63: user.v1.UserService.ListSessions:output_type -> user.v1.ListSessionsResponse
+	30, // 64: user.v1.UserService.RevokeSession:output_type -> user.v1.RevokeSessionResponse
+	32, // 65: user.v1.UserService.SyncClock:output_type -> user.v1.SyncClockResponse
+	35, // 66: user.v1.UserService.OAuthLogin:output_type -> user.v1.OAuthLoginResponse
+	37, // 67: user.v1.UserService.LinkIdentity:output_type -> user.v1.LinkIdentityResponse
+	39, // 68: user.v1.UserService.UnlinkIdentity:output_type -> user.v1.UnlinkIdentityResponse
+	41, // 69: user.v1.UserService.ListIdentities:output_type -> user.v1.ListIdentitiesResponse
+	44, // 70: user.v1.UserService.GetConsentStatus:output_type -> user.v1.GetConsentStatusResponse
+	46, // 71: user.v1.UserService.AcceptConsent:output_type -> user.v1.AcceptConsentResponse
+	17, // 72: user.v1.UserService.RequestPasswordReset:output_type -> user.v1.RequestPasswordResetResponse
+	19, // 73: user.v1.UserService.ResetPassword:output_type -> user.v1.ResetPasswordResponse
+	21, // 74: user.v1.UserService.VerifyEmail:output_type -> user.v1.VerifyEmailResponse
+	54, // [54:75] is the sub-list for method output_type
+	33, // [33:54] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
 }
 
 func init() { file_user_v1_user_proto_init() }
@@ -1457,13 +2842,14 @@ func file_user_v1_user_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_v1_user_proto_rawDesc), len(file_user_v1_user_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   25,
+			NumEnums:      1,
+			NumMessages:   46,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_user_v1_user_proto_goTypes,
 		DependencyIndexes: file_user_v1_user_proto_depIdxs,
+		EnumInfos:         file_user_v1_user_proto_enumTypes,
 		MessageInfos:      file_user_v1_user_proto_msgTypes,
 	}.Build()
 	File_user_v1_user_proto = out.File
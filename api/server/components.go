@@ -0,0 +1,1071 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/alerting"
+	"github.com/VoidMesh/api/api/internal/analytics"
+	"github.com/VoidMesh/api/api/internal/bandwidth"
+	"github.com/VoidMesh/api/api/internal/bootstrap"
+	"github.com/VoidMesh/api/api/internal/chunktemplate"
+	"github.com/VoidMesh/api/api/internal/compression"
+	"github.com/VoidMesh/api/api/internal/dbbreaker"
+	"github.com/VoidMesh/api/api/internal/debugstats"
+	"github.com/VoidMesh/api/api/internal/diagnostics"
+	"github.com/VoidMesh/api/api/internal/entity"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/faults"
+	"github.com/VoidMesh/api/api/internal/latency"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/oauth"
+	"github.com/VoidMesh/api/api/internal/outbox"
+	"github.com/VoidMesh/api/api/internal/presence"
+	"github.com/VoidMesh/api/api/internal/scripting"
+	"github.com/VoidMesh/api/api/internal/shard"
+	"github.com/VoidMesh/api/api/internal/signup"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/storage/sqlite"
+	"github.com/VoidMesh/api/api/pkg/uuidutil"
+	pbAchievementV1 "github.com/VoidMesh/api/api/proto/achievement/v1"
+	pbAdminV1 "github.com/VoidMesh/api/api/proto/admin/v1"
+	pbBankV1 "github.com/VoidMesh/api/api/proto/bank/v1"
+	pbCharacterV1 "github.com/VoidMesh/api/api/proto/character/v1"
+	pbCharacterActionsV1 "github.com/VoidMesh/api/api/proto/character_actions/v1"
+	pbChunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	pbChunkV2 "github.com/VoidMesh/api/api/proto/chunk/v2"
+	pbCompassV1 "github.com/VoidMesh/api/api/proto/compass/v1"
+	pbDebugV1 "github.com/VoidMesh/api/api/proto/debug/v1"
+	pbDungeonV1 "github.com/VoidMesh/api/api/proto/dungeon/v1"
+	pbFishingV1 "github.com/VoidMesh/api/api/proto/fishing/v1"
+	pbInteractionV1 "github.com/VoidMesh/api/api/proto/interaction/v1"
+	pbInteriorV1 "github.com/VoidMesh/api/api/proto/interior/v1"
+	pbInventoryV1 "github.com/VoidMesh/api/api/proto/inventory/v1"
+	pbLandClaimV1 "github.com/VoidMesh/api/api/proto/land_claim/v1"
+	pbMailV1 "github.com/VoidMesh/api/api/proto/mail/v1"
+	pbMarketV1 "github.com/VoidMesh/api/api/proto/market/v1"
+	pbNotificationV1 "github.com/VoidMesh/api/api/proto/notification/v1"
+	pbProcessingV1 "github.com/VoidMesh/api/api/proto/processing/v1"
+	pbRareEventV1 "github.com/VoidMesh/api/api/proto/rare_event/v1"
+	pbReferenceV1 "github.com/VoidMesh/api/api/proto/reference/v1"
+	pbResourceNodeV1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
+	pbResourceNodeV2 "github.com/VoidMesh/api/api/proto/resource_node/v2"
+	pbSocialV1 "github.com/VoidMesh/api/api/proto/social/v1"
+	pbStaticDataV1 "github.com/VoidMesh/api/api/proto/static_data/v1"
+	pbTerrainV1 "github.com/VoidMesh/api/api/proto/terrain/v1"
+	pbTimelineV1 "github.com/VoidMesh/api/api/proto/timeline/v1"
+	pbTutorialV1 "github.com/VoidMesh/api/api/proto/tutorial/v1"
+	pbUserV1 "github.com/VoidMesh/api/api/proto/user/v1"
+	pbWorldV1 "github.com/VoidMesh/api/api/proto/world/v1"
+	pbWorldV2 "github.com/VoidMesh/api/api/proto/world/v2"
+	"github.com/VoidMesh/api/api/server/handlers"
+	"github.com/VoidMesh/api/api/server/middleware"
+	"github.com/VoidMesh/api/api/services/achievement"
+	"github.com/VoidMesh/api/api/services/action_queue"
+	"github.com/VoidMesh/api/api/services/activity"
+	"github.com/VoidMesh/api/api/services/api_key"
+	"github.com/VoidMesh/api/api/services/bank"
+	"github.com/VoidMesh/api/api/services/calendar"
+	"github.com/VoidMesh/api/api/services/character"
+	"github.com/VoidMesh/api/api/services/character_actions"
+	"github.com/VoidMesh/api/api/services/checkpoint"
+	"github.com/VoidMesh/api/api/services/chunk"
+	"github.com/VoidMesh/api/api/services/chunk_summary"
+	"github.com/VoidMesh/api/api/services/compass"
+	"github.com/VoidMesh/api/api/services/consent"
+	"github.com/VoidMesh/api/api/services/cosmetic"
+	"github.com/VoidMesh/api/api/services/discovery"
+	"github.com/VoidMesh/api/api/services/dungeon"
+	"github.com/VoidMesh/api/api/services/economy"
+	"github.com/VoidMesh/api/api/services/feature_flag"
+	"github.com/VoidMesh/api/api/services/fishing"
+	"github.com/VoidMesh/api/api/services/identity"
+	"github.com/VoidMesh/api/api/services/interaction"
+	"github.com/VoidMesh/api/api/services/interior"
+	"github.com/VoidMesh/api/api/services/inventory"
+	"github.com/VoidMesh/api/api/services/land_claim"
+	"github.com/VoidMesh/api/api/services/mail"
+	"github.com/VoidMesh/api/api/services/maintenance"
+	"github.com/VoidMesh/api/api/services/market"
+	"github.com/VoidMesh/api/api/services/notification"
+	"github.com/VoidMesh/api/api/services/processing"
+	"github.com/VoidMesh/api/api/services/protected_region"
+	"github.com/VoidMesh/api/api/services/rare_event"
+	"github.com/VoidMesh/api/api/services/reference"
+	"github.com/VoidMesh/api/api/services/replay"
+	"github.com/VoidMesh/api/api/services/resource_node"
+	"github.com/VoidMesh/api/api/services/social"
+	"github.com/VoidMesh/api/api/services/sprite_atlas"
+	"github.com/VoidMesh/api/api/services/static_data"
+	"github.com/VoidMesh/api/api/services/status_effect"
+	"github.com/VoidMesh/api/api/services/terrain"
+	"github.com/VoidMesh/api/api/services/time_scale"
+	"github.com/VoidMesh/api/api/services/tutorial"
+	"github.com/VoidMesh/api/api/services/user_session"
+	"github.com/VoidMesh/api/api/services/world"
+	"github.com/VoidMesh/api/api/services/world_maturity"
+	"github.com/VoidMesh/api/api/services/world_pause"
+	"github.com/VoidMesh/api/api/services/world_timeline"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+// provideComponents registers the constructor of every component the server is built
+// from. A component that runs in the background or holds a resource registers its
+// lifecycle on the container from its constructor, so it is started after and stopped
+// before everything it depends on.
+func provideComponents(c *bootstrap.Container) {
+	logger := logging.GetLogger()
+
+	// Operational alerts go to the webhook and/or email configured in ALERT_* variables
+	bootstrap.Provide(c, "alerting", func(c *bootstrap.Container) (*alerting.Alerter, error) {
+		alerter := newAlerter()
+		alerting.SetDefault(alerter)
+		c.Go("alerting", alerter.Run)
+		return alerter, nil
+	})
+
+	// The breaker watches every query so requests can fail fast while the database struggles
+	bootstrap.Provide(c, "database breaker", func(c *bootstrap.Container) (*dbbreaker.Breaker, error) {
+		return dbbreaker.New(bootstrap.Must[Config](c).DBBreaker), nil
+	})
+
+	bootstrap.Provide(c, "database", func(c *bootstrap.Container) (storage.Pool, error) {
+		config := bootstrap.Must[Config](c)
+		alerter := bootstrap.Must[*alerting.Alerter](c)
+		if config.SQLitePath != "" {
+			// The breaker, statement cache and pool alert are pgx pool features
+			file, err := sqlite.Open(context.Background(), config.SQLitePath)
+			if err != nil {
+				return nil, err
+			}
+			logger.Info("Using SQLite database", "path", config.SQLitePath)
+			c.Append(bootstrap.Hook{
+				Name: "database",
+				OnStop: func(context.Context) error {
+					file.Close()
+					return nil
+				},
+			})
+			return file, nil
+		}
+		logger.Debug("Connecting to PostgreSQL database", "url_length", len(config.DatabaseURL))
+
+		poolConfig, err := pgxpool.ParseConfig(config.DatabaseURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse database URL: %w", err)
+		}
+		config.configurePool(poolConfig)
+		logger.Debug("Configured database queries", "exec_mode", config.queryExecMode(), "statement_cache_size", config.DBStatementCacheSize, "pgbouncer", config.DBPgBouncer)
+		if config.DBBreakerEnabled {
+			poolConfig.ConnConfig.Tracer = bootstrap.Must[*dbbreaker.Breaker](c).Tracer()
+		}
+
+		start := time.Now()
+		pool, err := pgxpool.NewWithConfig(context.Background(), poolConfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to database: %w", err)
+		}
+		logger.Info("Database connection pool created successfully", "duration", time.Since(start))
+
+		alerter.WatchPool(func() alerting.PoolStats { return pool.Stat() })
+		c.Append(bootstrap.Hook{
+			Name: "database",
+			OnStop: func(context.Context) error {
+				pool.Close()
+				return nil
+			},
+		})
+		return pool, nil
+	})
+
+	// Authenticated requests and open streams mark users online for friends lists
+	bootstrap.Provide(c, "presence", func(c *bootstrap.Container) (*presence.Tracker, error) {
+		return presence.NewTracker(presence.DefaultTTL), nil
+	})
+
+	// Per-session latency calibrations from the clock sync handshake, used to judge
+	// client-timed actions fairly
+	bootstrap.Provide(c, "latency", func(c *bootstrap.Container) (*latency.Tracker, error) {
+		tracker := latency.NewTracker(bootstrap.Must[Config](c).ClockSkewMaxAllowance)
+		debugstats.Register(debugstats.CacheEntries, "latency.calibrations", func() int64 {
+			return int64(tracker.Len())
+		})
+		c.Go("latency_prune", tracker.Run)
+		return tracker, nil
+	})
+
+	// API keys let integrations call read-only RPCs without a player's JWT
+	bootstrap.Provide(c, "api key", func(c *bootstrap.Container) (*api_key.Service, error) {
+		return api_key.NewServiceWithPool(bootstrap.Must[storage.Pool](c)), nil
+	})
+
+	// Every login records a session, so users can list their devices and sign them out
+	bootstrap.Provide(c, "user session", func(c *bootstrap.Container) (*user_session.Service, error) {
+		service := user_session.NewServiceWithPool(bootstrap.Must[storage.Pool](c))
+		c.Go("session_prune", service.Run)
+		return service, nil
+	})
+
+	// Published terms of service and privacy policy versions are reloaded periodically so
+	// every instance gates players on the same versions
+	bootstrap.Provide(c, "consent", func(c *bootstrap.Container) (*consent.Service, error) {
+		service := consent.NewServiceWithPool(bootstrap.Must[storage.Pool](c))
+		c.Go("consent_refresh", service.Run)
+		return service, nil
+	})
+
+	// Maintenance mode is reloaded periodically so every instance follows it; the same job
+	// sends the countdown to open notification streams
+	bootstrap.Provide(c, "maintenance", func(c *bootstrap.Container) (*maintenance.Service, error) {
+		service := maintenance.NewServiceWithPool(bootstrap.Must[storage.Pool](c))
+		service.SetBroadcaster(bootstrap.Must[*notification.Service](c))
+		c.Go("maintenance_refresh", service.Run)
+		return service, nil
+	})
+
+	// World time scales are reloaded periodically so every instance speeds up the same worlds
+	bootstrap.Provide(c, "time scale", func(c *bootstrap.Container) (*time_scale.Service, error) {
+		service := time_scale.NewServiceWithPool(bootstrap.Must[storage.Pool](c))
+		c.Go("time_scale_refresh", service.Run)
+		return service, nil
+	})
+
+	// World pauses are reloaded periodically so every instance freezes the same worlds
+	bootstrap.Provide(c, "world pause", func(c *bootstrap.Container) (*world_pause.Service, error) {
+		service := world_pause.NewServiceWithPool(bootstrap.Must[storage.Pool](c))
+		c.Go("world_pause_refresh", service.Run)
+		return service, nil
+	})
+
+	bootstrap.Provide(c, "grpc", func(c *bootstrap.Container) (*grpc.Server, error) {
+		config := bootstrap.Must[Config](c)
+		errorRate := bootstrap.Must[*alerting.Alerter](c).WatchErrorRate()
+		presenceTracker := bootstrap.Must[*presence.Tracker](c)
+		calibrations := bootstrap.Must[*latency.Tracker](c)
+		breaker := bootstrap.Must[*dbbreaker.Breaker](c)
+		var cachedMethods []string
+		if config.DBBreakerEnabled {
+			cachedMethods = middleware.DefaultCachedMethods
+		}
+		jwtSecret := []byte(config.JWTSecret)
+		logger.Debug("JWT secret loaded", "length", len(jwtSecret))
+
+		unary := []grpc.UnaryServerInterceptor{
+			middleware.ErrorRateInterceptor(errorRate),
+			middleware.TimeoutInterceptor(config.RPCTimeout, config.RPCMethodTimeouts),
+			middleware.JWTAuthInterceptor(jwtSecret),
+			middleware.CircuitBreakerInterceptor(breaker, middleware.NewResponseCache(middleware.DefaultResponseCacheSize), cachedMethods),
+			middleware.PresenceInterceptor(presenceTracker),
+			middleware.LatencyInterceptor(calibrations),
+			middleware.MaintenanceInterceptor(),
+			middleware.WorldPauseInterceptor(),
+			middleware.ConsentInterceptor(),
+			middleware.ShardRoutingInterceptor(),
+			middleware.CompressionInterceptor(config.Compression, config.CompressedMethods),
+		}
+		stream := []grpc.StreamServerInterceptor{
+			middleware.ErrorRateStreamInterceptor(errorRate),
+			middleware.TimeoutStreamInterceptor(),
+			middleware.JWTStreamAuthInterceptor(jwtSecret),
+			middleware.CircuitBreakerStreamInterceptor(breaker),
+			middleware.PresenceStreamInterceptor(presenceTracker),
+			middleware.LatencyStreamInterceptor(calibrations),
+			middleware.MaintenanceStreamInterceptor(),
+			middleware.WorldPauseStreamInterceptor(),
+			middleware.ConsentStreamInterceptor(),
+			middleware.ShardRoutingStreamInterceptor(),
+			middleware.BandwidthStreamInterceptor(bandwidth.NewRegistry(config.StreamBandwidth)),
+		}
+		// Injected faults act like a slow or failing service, so they run last, inside the
+		// timeout and after authentication
+		if config.FaultInjection != "" {
+			injector, err := config.faultInjector()
+			if err != nil {
+				return nil, err
+			}
+			logger.Warn("Fault injection enabled, never run this in production", "faults", config.FaultInjection)
+			unary = append(unary, faults.UnaryServerInterceptor(injector))
+			stream = append(stream, faults.StreamServerInterceptor(injector))
+		}
+
+		g := grpc.NewServer(
+			grpc.ChainUnaryInterceptor(unary...),
+			grpc.ChainStreamInterceptor(stream...),
+			grpc.StatsHandler(compression.NewStatsHandler()),
+		)
+		logger.Info("gRPC server created with JWT authentication interceptor")
+
+		middleware.SetAdminUserIDs(config.AdminUserIDs)
+		middleware.SetAPIKeyAuthenticator(bootstrap.Must[*api_key.Service](c))
+		middleware.SetSessionAuthenticator(bootstrap.Must[*user_session.Service](c))
+		middleware.SetMaintenanceGate(bootstrap.Must[*maintenance.Service](c))
+		defaultWorldID := uuidutil.String(bootstrap.Must[db.World](c).ID)
+		middleware.SetDefaultWorldID(defaultWorldID)
+		middleware.SetWorldPauseGate(bootstrap.Must[*world_pause.Service](c), defaultWorldID)
+		middleware.SetConsentGate(bootstrap.Must[*consent.Service](c))
+		if config.ReflectionEnabled {
+			reflection.Register(g)
+			logger.Debug("gRPC reflection service registered successfully")
+		}
+		grpc_health_v1.RegisterHealthServer(g, health.NewServer())
+
+		if config.DebugHTTPAddress != "" {
+			// The dashboard page is public; the data it loads needs an admin token
+			debugMux := http.NewServeMux()
+			debugMux.Handle("/dashboard/", diagnostics.Dashboard())
+			debugMux.Handle("/", middleware.AdminHTTP(jwtSecret, diagnostics.Handler()))
+			debugServer := &http.Server{
+				Addr:              config.DebugHTTPAddress,
+				Handler:           debugMux,
+				ReadHeaderTimeout: 10 * time.Second,
+			}
+			c.Append(bootstrap.Hook{
+				Name: "debug http",
+				OnStart: func(context.Context) error {
+					lis, err := net.Listen("tcp", config.DebugHTTPAddress)
+					if err != nil {
+						return fmt.Errorf("failed to create debug HTTP listener on %s: %w", config.DebugHTTPAddress, err)
+					}
+					logger.Info("Serving pprof, runtime diagnostics and the dashboard to admins over HTTP", "address", lis.Addr().String(), "dashboard", "/dashboard/")
+					go func() {
+						if err := debugServer.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+							logger.Error("Debug HTTP server failed", "error", err)
+						}
+					}()
+					return nil
+				},
+				OnStop: debugServer.Shutdown,
+			})
+		}
+		return g, nil
+	})
+
+	bootstrap.Provide(c, "world", func(c *bootstrap.Container) (*world.Service, error) {
+		return world.NewServiceWithPool(bootstrap.Must[storage.Pool](c), world.NewDefaultLoggerWrapper()), nil
+	})
+
+	bootstrap.Provide(c, "default world", func(c *bootstrap.Container) (db.World, error) {
+		defaultWorld, err := bootstrap.Must[*world.Service](c).GetDefaultWorld(context.Background())
+		if err != nil {
+			// Usually the schema has not been applied or a migration failed part-way
+			_, _ = bootstrap.Must[*alerting.Alerter](c).Fire(context.Background(), alerting.Alert{
+				Condition: alerting.MigrationFailed,
+				Title:     "Server failed to start: default world could not be loaded",
+				Message:   "Check that db/migrations/schema.sql has been applied.",
+				Fields:    map[string]string{"error": err.Error()},
+			})
+			return db.World{}, fmt.Errorf("failed to get default world: %w", err)
+		}
+		logger.Debug("Default world loaded", "world_id", defaultWorld.ID, "seed", defaultWorld.Seed)
+		return defaultWorld, nil
+	})
+
+	// Multi-instance deployments set SHARD_INSTANCE_ID so each world is served by one
+	// instance; without it there is no registry
+	bootstrap.Provide(c, "shard", func(c *bootstrap.Container) (*shard.Registry, error) {
+		config := bootstrap.Must[Config](c)
+		if config.ShardInstanceID == "" {
+			return nil, nil
+		}
+		defaultWorld := bootstrap.Must[db.World](c)
+		registry := shard.NewRegistry(db.New(bootstrap.Must[storage.Pool](c)), shard.DefaultConfig(config.ShardInstanceID, config.ShardAdvertiseAddress))
+		c.Append(bootstrap.Hook{
+			Name: "shard registration",
+			OnStart: func(ctx context.Context) error {
+				if err := registry.Heartbeat(ctx); err != nil {
+					return fmt.Errorf("failed to register shard instance: %w", err)
+				}
+				middleware.SetShardRouter(registry, uuidutil.String(defaultWorld.ID))
+				logger.Info("Shard routing enabled", "instance_id", config.ShardInstanceID, "address", config.ShardAdvertiseAddress)
+				return nil
+			},
+		})
+		c.Go("shard_heartbeat", registry.Run)
+		return registry, nil
+	})
+
+	// Each world's seed and terrain noise generator are loaded once and shared by all
+	// generation and seeded streams in that world
+	bootstrap.Provide(c, "world seeds", func(c *bootstrap.Container) (*world.Seeds, error) {
+		return world.NewSeeds(bootstrap.Must[*world.Service](c)), nil
+	})
+
+	// Authored chunks from CHUNK_TEMPLATES_PATH replace generation for every chunk
+	// service, so they are registered before any is built; nil without a manifest
+	bootstrap.Provide(c, "chunk templates", func(c *bootstrap.Container) (*chunktemplate.Set, error) {
+		path := bootstrap.Must[Config](c).ChunkTemplatesPath
+		if path == "" {
+			return nil, nil
+		}
+		templates, err := chunktemplate.LoadManifest(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load chunk templates: %w", err)
+		}
+		chunk.SetTemplates(templates)
+		logger.Info("Chunk templates loaded", "path", path, "chunks", templates.Len())
+		return templates, nil
+	})
+
+	bootstrap.Provide(c, "chunk", func(c *bootstrap.Container) (handlers.ChunkService, error) {
+		bootstrap.Must[*chunktemplate.Set](c)
+		return handlers.NewChunkServiceWithPool(bootstrap.Must[storage.Pool](c))
+	})
+
+	// Terrain edits are stored as chunk deltas in their world and periodically
+	// folded back into the chunk blobs. Chunk reads from every chunk service are
+	// counted in memory and flushed from here.
+	bootstrap.Provide(c, "terrain chunks", func(c *bootstrap.Container) (*chunk.Service, error) {
+		bootstrap.Must[*chunktemplate.Set](c)
+		service := chunk.NewServiceWithPool(bootstrap.Must[storage.Pool](c), bootstrap.Must[*world.Service](c), bootstrap.Must[*world.Seeds](c))
+		c.Go("chunk_compaction", func(ctx context.Context) {
+			service.RunCompaction(ctx, chunk.DefaultCompactionConfig())
+		})
+		c.Go("chunk_access_flush", func(ctx context.Context) {
+			service.RunAccessFlush(ctx, chunk.DefaultAccessConfig())
+		})
+		return service, nil
+	})
+
+	// Tutorial steps are completed by moves and by gameplay events
+	bootstrap.Provide(c, "tutorial", func(c *bootstrap.Container) (*tutorial.Service, error) {
+		service := tutorial.NewServiceWithPool(bootstrap.Must[storage.Pool](c))
+		service.Subscribe(bootstrap.Must[*events.Bus](c))
+		return service, nil
+	})
+
+	// Generic world entities (mobs, drops, structures, crops) and spatial queries over them
+	bootstrap.Provide(c, "entity store", func(c *bootstrap.Container) (*entity.Store, error) {
+		return entity.NewStoreWithPool(bootstrap.Must[storage.Pool](c)), nil
+	})
+
+	// Time-limited buffs and debuffs; expired effects are deleted periodically
+	bootstrap.Provide(c, "status effect", func(c *bootstrap.Container) (*status_effect.Service, error) {
+		service := status_effect.NewServiceWithPool(bootstrap.Must[storage.Pool](c))
+		c.Go("status_effect_expiry", service.Run)
+		return service, nil
+	})
+
+	// Moves within a chunk are written behind; nil when every move is written straight away
+	bootstrap.Provide(c, "position buffer", func(c *bootstrap.Container) (*character.PositionBuffer, error) {
+		config := bootstrap.Must[Config](c)
+		if !config.PositionBufferEnabled {
+			return nil, nil
+		}
+		buffer := character.NewPositionBuffer(character.NewDatabaseWrapper(bootstrap.Must[storage.Pool](c)), config.PositionBuffer)
+		c.Go("position_flush", buffer.Run)
+		return buffer, nil
+	})
+
+	// Structure interiors are generated the first time a character enters them
+	bootstrap.Provide(c, "interiors", func(c *bootstrap.Container) (*interior.Service, error) {
+		return interior.NewServiceWithPool(bootstrap.Must[storage.Pool](c), bootstrap.Must[*entity.Store](c)), nil
+	})
+
+	// Vaults are opened and moved only at the bank entities
+	bootstrap.Provide(c, "bank", func(c *bootstrap.Container) (*bank.Service, error) {
+		pool := bootstrap.Must[storage.Pool](c)
+		return bank.NewService(bank.NewDatabaseWrapper(pool), bootstrap.Must[*entity.Store](c), bank.NewDefaultLoggerWrapper()), nil
+	})
+
+	// Dungeon instances are generated from their seed and deleted when they expire
+	bootstrap.Provide(c, "dungeons", func(c *bootstrap.Container) (*dungeon.Service, error) {
+		service := dungeon.NewServiceWithPool(bootstrap.Must[storage.Pool](c))
+		service.SetWorldSeeds(bootstrap.Must[*world.Seeds](c))
+		service.SetPauses(bootstrap.Must[*world_pause.Service](c))
+		service.SetInteriors(bootstrap.Must[*interior.Service](c))
+		c.Go("dungeon_expiry", service.Run)
+		return service, nil
+	})
+
+	bootstrap.Provide(c, "character", func(c *bootstrap.Container) (*character.Service, error) {
+		service := character.NewServiceWithPool(bootstrap.Must[storage.Pool](c), bootstrap.Must[handlers.ChunkService](c))
+		// Movements from the RPC and the action queue share one interest manager
+		service.SetNearbyEvents(character.NewNearbyEvents())
+		service.SetEntities(bootstrap.Must[*entity.Store](c))
+		service.SubscribeChunkEvents(bootstrap.Must[*events.Bus](c))
+		service.SetStatusEffects(bootstrap.Must[*status_effect.Service](c))
+		service.SetInteriors(bootstrap.Must[*interior.Service](c))
+		service.SetDungeons(bootstrap.Must[*dungeon.Service](c))
+		if positions := bootstrap.Must[*character.PositionBuffer](c); positions != nil {
+			service.SetPositionBuffer(positions)
+		}
+		if recorder := bootstrap.Must[*replay.Service](c); recorder != nil {
+			service.AddMoveRecorder(recorder)
+		}
+		if exporter := bootstrap.Must[*analytics.Exporter](c); exporter != nil {
+			service.AddMoveRecorder(exporter)
+		}
+		service.AddMoveRecorder(bootstrap.Must[*tutorial.Service](c))
+		if config := bootstrap.Must[Config](c); config.ChunkPrefetchEnabled {
+			prefetchConfig := chunk.DefaultPrefetchConfig()
+			prefetchConfig.LookAhead = int32(config.ChunkPrefetchDistance)
+			prefetcher := chunk.NewPrefetcher(bootstrap.Must[handlers.ChunkService](c), prefetchConfig, chunk.NewDefaultLoggerWrapper())
+			c.Go("chunk_prefetch", prefetcher.Run)
+			service.SetChunkPrefetcher(prefetcher)
+		}
+		return service, nil
+	})
+
+	// Character state is checkpointed periodically so support can restore it
+	bootstrap.Provide(c, "checkpoint", func(c *bootstrap.Container) (*checkpoint.Service, error) {
+		service := checkpoint.NewServiceWithPool(bootstrap.Must[storage.Pool](c))
+		if positions := bootstrap.Must[*character.PositionBuffer](c); positions != nil {
+			service.SetPositionBuffer(positions)
+		}
+		c.Go("character_checkpoint", func(ctx context.Context) {
+			service.Run(ctx, checkpoint.DefaultConfig())
+		})
+		return service, nil
+	})
+
+	bootstrap.Provide(c, "resource node", func(c *bootstrap.Container) (*resource_node.NodeService, error) {
+		service := resource_node.NewNodeServiceWithPool(bootstrap.Must[storage.Pool](c), bootstrap.Must[*world.Seeds](c), bootstrap.Must[*world.Service](c))
+
+		// Resource type packs must be registered before the balance config is applied
+		if dir := bootstrap.Must[Config](c).ResourcePacksDir; dir != "" {
+			packs, err := resource_node.RegisterResourcePacks(dir)
+			if err != nil {
+				return nil, fmt.Errorf("failed to register resource packs: %w", err)
+			}
+			logger.Info("Resource packs registered", "dir", dir, "packs", packs)
+		}
+
+		// Load balance data from BALANCE_CONFIG_PATH, falling back to the embedded defaults
+		balanceInfo, err := service.LoadBalanceConfig(bootstrap.Must[Config](c).BalanceConfigPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load balance config: %w", err)
+		}
+		logger.Info("Balance config loaded", "version", balanceInfo.Version, "checksum", balanceInfo.Checksum, "source", balanceInfo.Source)
+
+		// Experiments assigned to the default world tune its generation, e.g. resource density
+		defaultWorld := bootstrap.Must[db.World](c)
+		params, err := bootstrap.Must[*feature_flag.Service](c).Assign(context.Background(), feature_flag.SubjectWorld, defaultWorld.ID, defaultWorld.CreatedAt.Time)
+		if err != nil {
+			return nil, fmt.Errorf("failed to assign world experiments: %w", err)
+		}
+		service.SetExperimentParams(params)
+		service.SetMaturity(bootstrap.Must[*world_maturity.Service](c))
+		return service, nil
+	})
+
+	// Sprite metadata for the resource types' visuals, from SPRITE_ATLAS_DIR or the embedded
+	// atlas; ASSET_HTTP_ADDRESS also serves it and the sheet images over HTTP
+	bootstrap.Provide(c, "sprite atlas", func(c *bootstrap.Container) (*sprite_atlas.Service, error) {
+		config := bootstrap.Must[Config](c)
+		service, err := sprite_atlas.NewService(config.AssetBaseURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load sprite atlas: %w", err)
+		}
+		if config.SpriteAtlasDir != "" {
+			if _, err := service.Load(config.SpriteAtlasDir); err != nil {
+				return nil, fmt.Errorf("failed to load sprite atlas: %w", err)
+			}
+		}
+
+		types, err := bootstrap.Must[*resource_node.NodeService](c).GetResourceNodeTypes(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("failed to list resource node types: %w", err)
+		}
+		sprites := make([]string, len(types))
+		for i, t := range types {
+			sprites[i] = t.GetVisualData().GetSprite()
+		}
+		if missing := service.Missing(sprites); len(missing) > 0 {
+			logger.Warn("Resource node sprites missing from the sprite atlas", "sprites", missing)
+		}
+
+		if config.AssetHTTPAddress != "" {
+			httpServer := &http.Server{Addr: config.AssetHTTPAddress, Handler: service.Handler(), ReadHeaderTimeout: 10 * time.Second}
+			c.Append(bootstrap.Hook{
+				Name: "asset http",
+				OnStart: func(context.Context) error {
+					lis, err := net.Listen("tcp", config.AssetHTTPAddress)
+					if err != nil {
+						return fmt.Errorf("failed to create asset HTTP listener on %s: %w", config.AssetHTTPAddress, err)
+					}
+					logger.Info("Serving sprite atlas over HTTP", "address", lis.Addr().String())
+					go func() {
+						if err := httpServer.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+							logger.Error("Asset HTTP server failed", "error", err)
+						}
+					}()
+					return nil
+				},
+				OnStop: httpServer.Shutdown,
+			})
+		}
+		return service, nil
+	})
+
+	// Harvest overflow left on the ground expires after a while
+	bootstrap.Provide(c, "inventory", func(c *bootstrap.Container) (*inventory.Service, error) {
+		service := inventory.NewServiceWithPool(bootstrap.Must[storage.Pool](c), bootstrap.Must[*character.Service](c))
+		service.SetTimeScale(bootstrap.Must[*time_scale.Service](c))
+		c.Go("ground_drop_expiry", service.Run)
+		return service, nil
+	})
+
+	bootstrap.Provide(c, "protected region", func(c *bootstrap.Container) (*protected_region.Service, error) {
+		return protected_region.NewServiceWithPool(bootstrap.Must[storage.Pool](c)), nil
+	})
+
+	// Reference data bundles are rebuilt at most once a minute per world
+	bootstrap.Provide(c, "static data", func(c *bootstrap.Container) (*static_data.Service, error) {
+		return static_data.NewService(
+			static_data.NewDatabaseWrapper(bootstrap.Must[storage.Pool](c)),
+			bootstrap.Must[*resource_node.NodeService](c),
+			terrain.NewServiceWithDefaultLogger(),
+			bootstrap.Must[*protected_region.Service](c),
+			static_data.NewDefaultLoggerWrapper(),
+		), nil
+	})
+
+	// Flags are cached in memory and reloaded periodically, so changes reach every instance.
+	// New characters are assigned to experiments from character.created events.
+	bootstrap.Provide(c, "feature flag", func(c *bootstrap.Container) (*feature_flag.Service, error) {
+		service := feature_flag.NewServiceWithPool(bootstrap.Must[storage.Pool](c))
+		c.Go("feature_flag_refresh", service.Run)
+		service.Subscribe(bootstrap.Must[*events.Bus](c))
+		return service, nil
+	})
+
+	// Claim upkeep is collected periodically; unpaid claims are released
+	bootstrap.Provide(c, "land claim", func(c *bootstrap.Container) (*land_claim.Service, error) {
+		service := land_claim.NewServiceWithPool(bootstrap.Must[storage.Pool](c))
+		service.SetFlags(bootstrap.Must[*feature_flag.Service](c))
+		c.Go("land_claim_upkeep", service.Run)
+		return service, nil
+	})
+
+	// Owners of processing jobs are notified as the jobs become ready
+	bootstrap.Provide(c, "processing", func(c *bootstrap.Container) (*processing.Service, error) {
+		service := processing.NewService(
+			processing.NewDatabaseWrapper(bootstrap.Must[storage.Pool](c)),
+			bootstrap.Must[*entity.Store](c),
+			processing.NewDefaultLoggerWrapper(),
+		)
+		service.SetRegionService(bootstrap.Must[*protected_region.Service](c))
+		service.SetClaimService(bootstrap.Must[*land_claim.Service](c))
+		service.SetTimeScale(bootstrap.Must[*time_scale.Service](c))
+		c.Go("processing_notify", service.Run)
+		return service, nil
+	})
+
+	// Generic verbs on world entities: interiors and banks open and processing stations
+	// activate
+	bootstrap.Provide(c, "interactions", func(c *bootstrap.Container) (*interaction.Service, error) {
+		pool := bootstrap.Must[storage.Pool](c)
+		service := interaction.NewService(interaction.NewDatabaseWrapper(pool), bootstrap.Must[*entity.Store](c), interaction.NewDefaultLoggerWrapper())
+		open := interaction.OpenInterior(bootstrap.Must[*character.Service](c))
+		for _, structureType := range bootstrap.Must[*interior.Service](c).StructureTypes() {
+			service.Register(interaction.VerbOpen, interaction.Match{Type: structureType}, open)
+		}
+		bankService := bootstrap.Must[*bank.Service](c)
+		openBank := interaction.OpenBank(bankService)
+		for _, bankType := range bankService.BankTypes() {
+			service.Register(interaction.VerbOpen, interaction.Match{Type: bankType}, openBank)
+		}
+		processingService := bootstrap.Must[*processing.Service](c)
+		activate := interaction.ActivateStation(processingService)
+		for _, stationType := range processingService.RecipeStations() {
+			service.Register(interaction.VerbActivate, interaction.Match{Type: stationType}, activate)
+		}
+		return service, nil
+	})
+
+	// Harvested currency is recorded in the economy ledger next to the sinks
+	bootstrap.Provide(c, "economy", func(c *bootstrap.Container) (*economy.Service, error) {
+		service := economy.NewServiceWithPool(bootstrap.Must[storage.Pool](c))
+		service.Subscribe(bootstrap.Must[*events.Bus](c))
+		return service, nil
+	})
+
+	// Rare nodes a character harvests are recorded as discovered for its compass
+	bootstrap.Provide(c, "compass", func(c *bootstrap.Container) (*compass.Service, error) {
+		service := compass.NewServiceWithPool(bootstrap.Must[storage.Pool](c), bootstrap.Must[*resource_node.NodeService](c))
+		service.Subscribe(bootstrap.Must[*events.Bus](c))
+		return service, nil
+	})
+
+	// Renamed characters are dropped from the reference cache
+	bootstrap.Provide(c, "reference", func(c *bootstrap.Container) (*reference.Service, error) {
+		service := reference.NewServiceWithPool(bootstrap.Must[storage.Pool](c))
+		service.Subscribe(bootstrap.Must[*events.Bus](c))
+		return service, nil
+	})
+
+	// Expired mail is deleted periodically, returning unclaimed attachments to the sender
+	bootstrap.Provide(c, "mail", func(c *bootstrap.Container) (*mail.Service, error) {
+		service := mail.NewServiceWithPool(bootstrap.Must[storage.Pool](c))
+		c.Go("mail_expiry", service.Run)
+		return service, nil
+	})
+
+	// Operator scripts from SCRIPTS_DIR hook into harvests, chunk generation and trades;
+	// nil without a directory
+	bootstrap.Provide(c, "scripts", func(c *bootstrap.Container) (*scripting.Engine, error) {
+		config := bootstrap.Must[Config](c).Scripts
+		if config.Dir == "" {
+			return nil, nil
+		}
+		engine, err := scripting.Load(context.Background(), config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load scripts: %w", err)
+		}
+		bus := bootstrap.Must[*events.Bus](c)
+		engine.SetPublisher(bus)
+		engine.Subscribe(bus)
+		c.Append(bootstrap.Hook{
+			Name:   "scripts",
+			OnStop: engine.Close,
+		})
+		logger.Info("Scripts loaded", "dir", config.Dir, "scripts", engine.Len())
+		return engine, nil
+	})
+
+	// Seasonal events from CALENDAR_PATH scale harvests and resource generation while
+	// active; nil without a calendar
+	bootstrap.Provide(c, "calendar", func(c *bootstrap.Container) (*calendar.Service, error) {
+		path := bootstrap.Must[Config](c).CalendarPath
+		if path == "" {
+			return nil, nil
+		}
+		cal, err := calendar.LoadCalendarFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load calendar: %w", err)
+		}
+		service := calendar.NewService(cal, calendar.NewDefaultLoggerWrapper())
+		service.SetBroadcaster(bootstrap.Must[*notification.Service](c))
+		service.SetResourceNodes(bootstrap.Must[*resource_node.NodeService](c))
+		if err := service.Refresh(); err != nil {
+			return nil, fmt.Errorf("failed to apply calendar: %w", err)
+		}
+		c.Go("calendar_refresh", service.Run)
+		logger.Info("Calendar loaded", "path", path, "events", len(cal.Events), "active", len(service.Active()))
+		return service, nil
+	})
+
+	// Rare events spawn in recently visited chunks of the default world, are announced to
+	// every player and mail their rewards to contributors, who also get the kind's status effect
+	bootstrap.Provide(c, "rare event", func(c *bootstrap.Container) (*rare_event.Service, error) {
+		service := rare_event.NewServiceWithPool(bootstrap.Must[storage.Pool](c))
+		service.SetWorld(bootstrap.Must[db.World](c).ID, bootstrap.Must[*world.Seeds](c))
+		service.SetMailer(bootstrap.Must[*mail.Service](c))
+		service.SetAnnouncer(bootstrap.Must[*notification.Service](c))
+		service.SetTimeScale(bootstrap.Must[*time_scale.Service](c))
+		service.SetStatusEffects(bootstrap.Must[*status_effect.Service](c))
+		service.SetPauses(bootstrap.Must[*world_pause.Service](c))
+		c.Go("rare_events", service.Run)
+		return service, nil
+	})
+
+	// Expired listings are returned to their sellers by mail periodically
+	bootstrap.Provide(c, "market", func(c *bootstrap.Container) (*market.Service, error) {
+		service := market.NewServiceWithPool(bootstrap.Must[storage.Pool](c))
+		if scripts := bootstrap.Must[*scripting.Engine](c); scripts != nil {
+			service.SetScripts(scripts)
+		}
+		c.Go("market_listing_expiry", service.Run)
+		return service, nil
+	})
+
+	bootstrap.Provide(c, "character actions", func(c *bootstrap.Container) (*character_actions.Service, error) {
+		service := character_actions.NewService(
+			character_actions.NewDatabaseWrapper(db.New(bootstrap.Must[storage.Pool](c))),
+			character_actions.NewInventoryServiceAdapter(bootstrap.Must[*inventory.Service](c)),
+			character_actions.NewCharacterServiceAdapter(bootstrap.Must[*character.Service](c)),
+			character_actions.NewDefaultLoggerWrapper(),
+		)
+		service.SetChunkService(bootstrap.Must[*chunk.Service](c))
+		service.SetActionStates(bootstrap.Must[*character.Service](c))
+		service.SetRegionService(bootstrap.Must[*protected_region.Service](c))
+		service.SetClaimService(bootstrap.Must[*land_claim.Service](c))
+		service.SetWorldSeeds(bootstrap.Must[*world.Seeds](c))
+		service.SetDungeons(bootstrap.Must[*dungeon.Service](c))
+		service.SetStatusEffects(bootstrap.Must[*status_effect.Service](c))
+		service.SetNodeQuality(bootstrap.Must[*resource_node.NodeService](c))
+		if scripts := bootstrap.Must[*scripting.Engine](c); scripts != nil {
+			service.SetScripts(scripts)
+		}
+		if cal := bootstrap.Must[*calendar.Service](c); cal != nil {
+			service.SetCalendar(cal)
+		}
+		return service, nil
+	})
+
+	bootstrap.Provide(c, "fishing", func(c *bootstrap.Container) (*fishing.Service, error) {
+		service := fishing.NewService(
+			fishing.NewDatabaseWrapper(bootstrap.Must[storage.Pool](c)),
+			bootstrap.Must[*inventory.Service](c),
+			bootstrap.Must[*world.Seeds](c),
+			fishing.NewDefaultLoggerWrapper(),
+		)
+		service.SetRegionService(bootstrap.Must[*protected_region.Service](c))
+		service.SetClaimService(bootstrap.Must[*land_claim.Service](c))
+		return service, nil
+	})
+
+	// Queued actions are processed in order on a fixed server tick
+	bootstrap.Provide(c, "action queue", func(c *bootstrap.Container) (*action_queue.Service, error) {
+		service := action_queue.NewService(
+			bootstrap.Must[*character.Service](c),
+			bootstrap.Must[*character_actions.Service](c),
+			bootstrap.Must[Config](c).TickRate,
+			action_queue.NewDefaultLoggerWrapper(),
+		)
+		c.Go("action_queue", service.Run)
+		return service, nil
+	})
+
+	bootstrap.Provide(c, "signup", func(c *bootstrap.Container) (*signup.Guard, error) {
+		config := bootstrap.Must[Config](c)
+		guard := signup.NewGuard(config.Signup)
+		if config.CaptchaVerifyURL != "" {
+			guard.SetCaptchaVerifier(signup.NewSiteVerifier(config.CaptchaVerifyURL, config.CaptchaSecret))
+		}
+		return guard, nil
+	})
+
+	// Logins with Discord and Google accounts; a provider is enabled by configuring its client ID
+	bootstrap.Provide(c, "identity", func(c *bootstrap.Container) (*identity.Service, error) {
+		config := bootstrap.Must[Config](c)
+		service := identity.NewServiceWithPool(bootstrap.Must[storage.Pool](c))
+		service.SetSignupPolicy(bootstrap.Must[*signup.Guard](c))
+		if config.OAuthDiscordClientID != "" {
+			service.AddProvider(oauth.NewDiscord(oauth.DiscordAPIURL, config.OAuthDiscordClientID))
+		}
+		if config.OAuthGoogleClientID != "" {
+			service.AddProvider(oauth.NewGoogle(oauth.GoogleTokenInfoURL, config.OAuthGoogleClientID))
+		}
+		return service, nil
+	})
+
+	bootstrap.Provide(c, "social", func(c *bootstrap.Container) (*social.Service, error) {
+		service := social.NewServiceWithPool(bootstrap.Must[storage.Pool](c), bootstrap.Must[*presence.Tracker](c))
+		service.SetNewAccountPolicy(bootstrap.Must[*signup.Guard](c))
+		return service, nil
+	})
+
+	// Announcements made through other instances are polled so every instance pushes them to its streams
+	bootstrap.Provide(c, "notification", func(c *bootstrap.Container) (*notification.Service, error) {
+		service := notification.NewServiceWithPool(bootstrap.Must[storage.Pool](c))
+		service.Subscribe(bootstrap.Must[*events.Bus](c))
+		c.Go("announcement_poll", service.RunAnnouncements)
+		debugstats.Register(debugstats.StreamSubscriptions, "notification.streams", func() int64 {
+			return int64(service.Streams())
+		})
+		return service, nil
+	})
+
+	// The world timeline is projected from rare event, chunk and market events; every
+	// instance polls for new entries to push to its streams
+	bootstrap.Provide(c, "world timeline", func(c *bootstrap.Container) (*world_timeline.Service, error) {
+		service := world_timeline.NewServiceWithPool(bootstrap.Must[storage.Pool](c))
+		service.Subscribe(bootstrap.Must[*events.Bus](c))
+		c.Go("world_timeline_poll", service.Run)
+		debugstats.Register(debugstats.StreamSubscriptions, "world_timeline.streams", func() int64 {
+			return int64(service.Streams())
+		})
+		return service, nil
+	})
+
+	// Outbox events written alongside mutations are published to in-process subscribers.
+	// Subscribers attach while they are constructed, before the dispatcher starts.
+	bootstrap.Provide(c, "event bus", func(c *bootstrap.Container) (*events.Bus, error) {
+		return events.NewBus(), nil
+	})
+
+	bootstrap.Provide(c, "outbox", func(c *bootstrap.Container) (*outbox.Dispatcher, error) {
+		dispatcher := outbox.NewDispatcher(db.New(bootstrap.Must[storage.Pool](c)), bootstrap.Must[*events.Bus](c), outbox.DefaultConfig())
+		c.Go("outbox_dispatch", dispatcher.Run)
+		return dispatcher, nil
+	})
+
+	// Project chunk and harvest events into the chunk_summaries read model
+	bootstrap.Provide(c, "chunk summary", func(c *bootstrap.Container) (*chunk_summary.Service, error) {
+		service := chunk_summary.NewServiceWithPool(bootstrap.Must[storage.Pool](c))
+		service.Subscribe(bootstrap.Must[*events.Bus](c))
+		c.Go("chunk_summary", func(ctx context.Context) {
+			service.Run(ctx, time.Minute)
+		})
+		return service, nil
+	})
+
+	// Region densities of the default world, moved by the harvest counts in chunk_summaries
+	bootstrap.Provide(c, "world maturity", func(c *bootstrap.Container) (*world_maturity.Service, error) {
+		service := world_maturity.NewServiceWithPool(bootstrap.Must[storage.Pool](c), bootstrap.Must[db.World](c))
+		service.SetConfig(bootstrap.Must[Config](c).WorldMaturity)
+		service.SetPauses(bootstrap.Must[*world_pause.Service](c))
+		c.Go("world_maturity", service.Run)
+		return service, nil
+	})
+
+	// Project harvest, craft, trade and death events into the character_activity feed
+	bootstrap.Provide(c, "activity", func(c *bootstrap.Container) (*activity.Service, error) {
+		service := activity.NewServiceWithPool(bootstrap.Must[storage.Pool](c))
+		service.Subscribe(bootstrap.Must[*events.Bus](c))
+		c.Go("activity_prune", service.Run)
+		return service, nil
+	})
+
+	// Count events towards achievements; unlocks notify through the outbox
+	bootstrap.Provide(c, "achievements", func(c *bootstrap.Container) (*achievement.Service, error) {
+		service := achievement.NewServiceWithPool(bootstrap.Must[storage.Pool](c))
+		service.Subscribe(bootstrap.Must[*events.Bus](c))
+		return service, nil
+	})
+
+	// Credit first chunk and resource discoveries to characters and award their titles
+	bootstrap.Provide(c, "discovery", func(c *bootstrap.Container) (*discovery.Service, error) {
+		service := discovery.NewServiceWithPool(bootstrap.Must[storage.Pool](c))
+		service.Subscribe(bootstrap.Must[*events.Bus](c))
+		return service, nil
+	})
+
+	// Titles and badges unlocked by achievements and discoveries, and the equipped title
+	bootstrap.Provide(c, "cosmetics", func(c *bootstrap.Container) (*cosmetic.Service, error) {
+		return cosmetic.NewServiceWithPool(bootstrap.Must[storage.Pool](c)), nil
+	})
+
+	// Region recordings for the debug tool capture moves and chunk events while debug
+	// endpoints are enabled; otherwise there is no replay service
+	// Gameplay events, sampled moves and sessions are exported for offline analysis when
+	// ANALYTICS_SINK is set; otherwise there is no exporter
+	bootstrap.Provide(c, "analytics", func(c *bootstrap.Container) (*analytics.Exporter, error) {
+		config := bootstrap.Must[Config](c)
+		var sink analytics.Sink
+		switch config.AnalyticsSink {
+		case "dir":
+			sink = analytics.NewDirSink(config.AnalyticsDir)
+		case "s3":
+			sink = analytics.NewS3Sink(config.AnalyticsS3)
+		default:
+			return nil, nil
+		}
+		exporter := analytics.NewExporter(sink, config.Analytics)
+		exporter.Subscribe(bootstrap.Must[*events.Bus](c))
+		exporter.TrackSessions(bootstrap.Must[*presence.Tracker](c))
+		c.Go("analytics_export", exporter.Run)
+		return exporter, nil
+	})
+
+	bootstrap.Provide(c, "replay", func(c *bootstrap.Container) (*replay.Service, error) {
+		if !bootstrap.Must[Config](c).DebugRPCEnabled {
+			return nil, nil
+		}
+		service := replay.NewServiceWithPool(bootstrap.Must[storage.Pool](c))
+		if err := service.Load(context.Background()); err != nil {
+			logger.Error("Failed to resume region recordings", "error", err)
+		}
+		service.Subscribe(bootstrap.Must[*events.Bus](c))
+		return service, nil
+	})
+}
+
+// registerServices registers every gRPC service on the server, resolving the components
+// they need, and returns the server. Components nothing serves directly, like the
+// outbox dispatcher, are resolved here too so their background jobs run.
+func registerServices(c *bootstrap.Container) (*grpc.Server, error) {
+	logger := logging.GetLogger()
+	var g *grpc.Server
+	err := bootstrap.Invoke(c, func(c *bootstrap.Container) error {
+		config := bootstrap.Must[Config](c)
+		g = bootstrap.Must[*grpc.Server](c)
+		logger.Debug("Registering gRPC service handlers")
+
+		maintenanceService := bootstrap.Must[*maintenance.Service](c)
+		userServer, err := handlers.NewUserServerWithPool(bootstrap.Must[storage.Pool](c), bootstrap.Must[*signup.Guard](c), maintenanceService, bootstrap.Must[*user_session.Service](c), bootstrap.Must[*identity.Service](c), bootstrap.Must[*consent.Service](c), bootstrap.Must[*latency.Tracker](c))
+		if err != nil {
+			return fmt.Errorf("failed to create user server: %w", err)
+		}
+		pbUserV1.RegisterUserServiceServer(g, userServer)
+
+		worldService := bootstrap.Must[*world.Service](c)
+		worldServer := handlers.NewWorldHandler(worldService)
+		pbWorldV1.RegisterWorldServiceServer(g, worldServer)
+		pbWorldV2.RegisterWorldServiceServer(g, handlers.NewWorldServerV2(worldServer))
+
+		characterService := bootstrap.Must[*character.Service](c)
+		pbCharacterV1.RegisterCharacterServiceServer(g, handlers.NewCharacterServer(
+			handlers.NewCharacterService(characterService), characterService, bootstrap.Must[*checkpoint.Service](c),
+			bootstrap.Must[*activity.Service](c), bootstrap.Must[*cosmetic.Service](c), characterService))
+
+		terrainLogger := &handlers.LoggerWrapper{Logger: logging.WithComponent("terrain-handler")}
+		pbTerrainV1.RegisterTerrainServiceServer(g, handlers.NewTerrainServer(handlers.NewTerrainServiceWithDefaultLogger(), terrainLogger))
+
+		resourceNodeService := bootstrap.Must[*resource_node.NodeService](c)
+		resourceNodeServer := handlers.NewResourceNodeHandler(resourceNodeService, worldService)
+		resourceNodeServer.SetSpriteAtlas(bootstrap.Must[*sprite_atlas.Service](c))
+		pbResourceNodeV1.RegisterResourceNodeServiceServer(g, resourceNodeServer)
+		pbResourceNodeV2.RegisterResourceNodeServiceServer(g, handlers.NewResourceNodeServerV2(resourceNodeServer))
+
+		bootstrap.Must[*chunktemplate.Set](c)
+		chunkServer, err := handlers.NewChunkServerWithPool(bootstrap.Must[storage.Pool](c))
+		if err != nil {
+			return fmt.Errorf("failed to create chunk server: %w", err)
+		}
+		pbChunkV1.RegisterChunkServiceServer(g, chunkServer)
+		pbChunkV2.RegisterChunkServiceServer(g, handlers.NewChunkServerV2(chunkServer))
+
+		pbInventoryV1.RegisterInventoryServiceServer(g, handlers.NewInventoryHandler(bootstrap.Must[*inventory.Service](c)))
+
+		characterActionsHandler := handlers.NewCharacterActionsServiceWithPool(bootstrap.Must[*character_actions.Service](c))
+		pbCharacterActionsV1.RegisterCharacterActionsServiceServer(g, handlers.NewCharacterActionsServer(characterActionsHandler, bootstrap.Must[*action_queue.Service](c)))
+
+		socialService := bootstrap.Must[*social.Service](c)
+		pbSocialV1.RegisterSocialServiceServer(g, handlers.NewSocialServer(socialService))
+		notificationService := bootstrap.Must[*notification.Service](c)
+		pbNotificationV1.RegisterNotificationServiceServer(g, handlers.NewNotificationServer(notificationService))
+		pbLandClaimV1.RegisterLandClaimServiceServer(g, handlers.NewLandClaimServer(bootstrap.Must[*land_claim.Service](c)))
+		pbFishingV1.RegisterFishingServiceServer(g, handlers.NewFishingServer(bootstrap.Must[*fishing.Service](c)))
+		pbProcessingV1.RegisterProcessingServiceServer(g, handlers.NewProcessingServer(bootstrap.Must[*processing.Service](c)))
+		pbDungeonV1.RegisterDungeonServiceServer(g, handlers.NewDungeonServer(bootstrap.Must[*dungeon.Service](c)))
+		pbBankV1.RegisterBankServiceServer(g, handlers.NewBankServer(bootstrap.Must[*bank.Service](c)))
+		pbInteractionV1.RegisterInteractionServiceServer(g, handlers.NewInteractionServer(bootstrap.Must[*interaction.Service](c)))
+		pbInteriorV1.RegisterInteriorServiceServer(g, handlers.NewInteriorServer(bootstrap.Must[*interior.Service](c), bootstrap.Must[*character.Service](c)))
+		pbCompassV1.RegisterCompassServiceServer(g, handlers.NewCompassServer(bootstrap.Must[*compass.Service](c)))
+		pbMailV1.RegisterMailServiceServer(g, handlers.NewMailServer(bootstrap.Must[*mail.Service](c)))
+		pbRareEventV1.RegisterRareEventServiceServer(g, handlers.NewRareEventServer(bootstrap.Must[*rare_event.Service](c)))
+		pbTimelineV1.RegisterTimelineServiceServer(g, handlers.NewTimelineServer(bootstrap.Must[*world_timeline.Service](c)))
+		pbMarketV1.RegisterMarketServiceServer(g, handlers.NewMarketServer(bootstrap.Must[*market.Service](c)))
+		pbAchievementV1.RegisterAchievementServiceServer(g, handlers.NewAchievementServer(bootstrap.Must[*achievement.Service](c)))
+		pbTutorialV1.RegisterTutorialServiceServer(g, handlers.NewTutorialServer(bootstrap.Must[*tutorial.Service](c)))
+		pbReferenceV1.RegisterReferenceServiceServer(g, handlers.NewReferenceServer(bootstrap.Must[*reference.Service](c)))
+		pbStaticDataV1.RegisterStaticDataServiceServer(g, handlers.NewStaticDataServer(bootstrap.Must[*static_data.Service](c)))
+		flags := bootstrap.Must[*feature_flag.Service](c)
+		pbAdminV1.RegisterAdminServiceServer(g, handlers.NewAdminServer(
+			socialService, bootstrap.Must[*api_key.Service](c), bootstrap.Must[*protected_region.Service](c), flags, flags, maintenanceService, notificationService,
+			bootstrap.Must[*inventory.Service](c), bootstrap.Must[*time_scale.Service](c), bootstrap.Must[*world_pause.Service](c),
+			bootstrap.Must[*status_effect.Service](c), bootstrap.Must[*economy.Service](c), bootstrap.Must[*character.Service](c),
+			bootstrap.Must[*consent.Service](c)))
+
+		bootstrap.Must[*shard.Registry](c)
+		bootstrap.Must[*outbox.Dispatcher](c)
+		bootstrap.Must[*chunk_summary.Service](c)
+		bootstrap.Must[*discovery.Service](c)
+
+		// Debug endpoints are opt-in so they never ship enabled in production by accident
+		if config.DebugRPCEnabled {
+			logger.Warn("Registering DebugService; disable DEBUG_RPC_ENABLED outside staging")
+			debugstats.Register(debugstats.CacheEntries, "resource_node.resource_types", func() int64 {
+				return int64(resourceNodeService.BalanceConfigInfo().ResourceTypeCount)
+			})
+			pbDebugV1.RegisterDebugServiceServer(g, handlers.NewDebugServer(g, bootstrap.Must[*replay.Service](c), bootstrap.Must[*chunk.Service](c), config.ReflectionEnabled))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("All gRPC services registered successfully")
+	return g, nil
+}
@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/internal/logging"
+	achievementV1 "github.com/VoidMesh/api/api/proto/achievement/v1"
+	"github.com/charmbracelet/log"
+)
+
+// AchievementService defines the interface for achievements and their rewards
+type AchievementService interface {
+	List(ctx context.Context, userID, characterID string) ([]*achievementV1.Achievement, error)
+	Claim(ctx context.Context, userID, characterID, achievementID string) ([]*achievementV1.ItemQuantity, error)
+}
+
+type achievementServiceServer struct {
+	achievementV1.UnimplementedAchievementServiceServer
+	achievementService AchievementService
+	logger             *log.Logger
+}
+
+// NewAchievementServer creates the achievement service handler
+func NewAchievementServer(achievementService AchievementService) achievementV1.AchievementServiceServer {
+	logger := logging.WithComponent("achievement-handler")
+	logger.Debug("Creating new AchievementService server instance")
+	return &achievementServiceServer{
+		achievementService: achievementService,
+		logger:             logger,
+	}
+}
+
+// ListAchievements lists every achievement with the progress of one of the caller's characters
+func (s *achievementServiceServer) ListAchievements(ctx context.Context, req *achievementV1.ListAchievementsRequest) (*achievementV1.ListAchievementsResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	achievements, err := s.achievementService.List(ctx, userID, req.CharacterId)
+	if err != nil {
+		s.logger.Debug("Failed to list achievements", "user_id", userID, "character_id", req.CharacterId, "error", err)
+		return nil, err
+	}
+	return &achievementV1.ListAchievementsResponse{Achievements: achievements}, nil
+}
+
+// ClaimReward moves an unlocked achievement's reward into the character's inventory
+func (s *achievementServiceServer) ClaimReward(ctx context.Context, req *achievementV1.ClaimRewardRequest) (*achievementV1.ClaimRewardResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.achievementService.Claim(ctx, userID, req.CharacterId, req.AchievementId)
+	if err != nil {
+		s.logger.Debug("Failed to claim achievement reward", "user_id", userID, "achievement_id", req.AchievementId, "error", err)
+		return nil, err
+	}
+	return &achievementV1.ClaimRewardResponse{Items: items}, nil
+}
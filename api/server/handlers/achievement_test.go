@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/VoidMesh/api/api/internal/testutil"
+	achievementV1 "github.com/VoidMesh/api/api/proto/achievement/v1"
+	"github.com/VoidMesh/api/api/server/middleware"
+	"github.com/charmbracelet/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeAchievementService records the user each call was made for
+type fakeAchievementService struct {
+	userID string
+}
+
+func (f *fakeAchievementService) List(ctx context.Context, userID, characterID string) ([]*achievementV1.Achievement, error) {
+	f.userID = userID
+	return []*achievementV1.Achievement{{Id: "gatherer", Progress: 40, Threshold: 100}}, nil
+}
+
+func (f *fakeAchievementService) Claim(ctx context.Context, userID, characterID, achievementID string) ([]*achievementV1.ItemQuantity, error) {
+	f.userID = userID
+	return []*achievementV1.ItemQuantity{{ItemId: 2, ItemName: "Stone", Quantity: 20}}, nil
+}
+
+func TestAchievementServiceServer(t *testing.T) {
+	achievements := &fakeAchievementService{}
+	server := &achievementServiceServer{achievementService: achievements, logger: log.New(io.Discard)}
+	ctx := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "player")
+	characterID := testutil.UUIDTestData.Character1
+
+	_, err := server.ListAchievements(context.Background(), &achievementV1.ListAchievementsRequest{CharacterId: characterID})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = server.ClaimReward(context.Background(), &achievementV1.ClaimRewardRequest{CharacterId: characterID, AchievementId: "gatherer"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	listed, err := server.ListAchievements(ctx, &achievementV1.ListAchievementsRequest{CharacterId: characterID})
+	require.NoError(t, err)
+	require.Len(t, listed.Achievements, 1)
+	assert.Equal(t, int64(40), listed.Achievements[0].Progress)
+	assert.Equal(t, testutil.UUIDTestData.User1, achievements.userID)
+
+	claimed, err := server.ClaimReward(ctx, &achievementV1.ClaimRewardRequest{CharacterId: characterID, AchievementId: "gatherer"})
+	require.NoError(t, err)
+	require.Len(t, claimed.Items, 1)
+	assert.Equal(t, int32(20), claimed.Items[0].Quantity)
+}
@@ -0,0 +1,734 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/logging"
+	adminV1 "github.com/VoidMesh/api/api/proto/admin/v1"
+	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	notificationV1 "github.com/VoidMesh/api/api/proto/notification/v1"
+	socialV1 "github.com/VoidMesh/api/api/proto/social/v1"
+	userV1 "github.com/VoidMesh/api/api/proto/user/v1"
+	"github.com/VoidMesh/api/api/server/middleware"
+	"github.com/VoidMesh/api/api/services/protected_region"
+	"github.com/charmbracelet/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ReportModerationService defines the interface for reviewing player reports
+type ReportModerationService interface {
+	ListReports(ctx context.Context, reportStatus socialV1.ReportStatus, afterID int64, limit int32) ([]*socialV1.PlayerReport, error)
+	ResolveReport(ctx context.Context, reportID int64, moderatorID, resolution string) (*socialV1.PlayerReport, error)
+}
+
+// APIKeyService defines the interface for managing integration API keys
+type APIKeyService interface {
+	CreateKey(ctx context.Context, createdBy, name string, scopes []string, expiresAt time.Time) (*adminV1.ApiKey, string, error)
+	ListKeys(ctx context.Context) ([]*adminV1.ApiKey, error)
+	RevokeKey(ctx context.Context, id int64) (*adminV1.ApiKey, error)
+}
+
+// ProtectedRegionService defines the interface for managing protected regions
+type ProtectedRegionService interface {
+	CreateRegion(ctx context.Context, createdBy, worldID string, def protected_region.Definition) (*chunkV1.ProtectedRegion, error)
+	UpdateRegion(ctx context.Context, id int64, def protected_region.Definition) (*chunkV1.ProtectedRegion, error)
+	ListRegions(ctx context.Context, worldID string) ([]*chunkV1.ProtectedRegion, error)
+	DeleteRegion(ctx context.Context, id int64) (*chunkV1.ProtectedRegion, error)
+}
+
+// FeatureFlagService defines the interface for managing feature flags
+type FeatureFlagService interface {
+	SetFlag(ctx context.Context, updatedBy string, flag *adminV1.FeatureFlag) (*adminV1.FeatureFlag, error)
+	ListFlags(ctx context.Context) ([]*adminV1.FeatureFlag, error)
+	DeleteFlag(ctx context.Context, name string) (*adminV1.FeatureFlag, error)
+}
+
+// ExperimentService defines the interface for managing A/B experiments
+type ExperimentService interface {
+	CreateExperiment(ctx context.Context, createdBy string, experiment *adminV1.Experiment) (*adminV1.Experiment, error)
+	ListExperiments(ctx context.Context) ([]*adminV1.Experiment, error)
+	StopExperiment(ctx context.Context, name string) (*adminV1.Experiment, error)
+}
+
+// MaintenanceService defines the interface for switching maintenance mode
+type MaintenanceService interface {
+	Set(ctx context.Context, updatedBy string, req *adminV1.SetMaintenanceModeRequest) (*adminV1.MaintenanceMode, error)
+	Mode(ctx context.Context) (*adminV1.MaintenanceMode, error)
+}
+
+// AnnouncementService defines the interface for broadcasting server announcements
+type AnnouncementService interface {
+	Announce(ctx context.Context, createdBy, message string, severity notificationV1.AnnouncementSeverity, expiresAt *timestamppb.Timestamp) (*notificationV1.Announcement, error)
+}
+
+// WorldInventoryService defines the interface for managing per-world inventory rules
+type WorldInventoryService interface {
+	SetWorldSettings(ctx context.Context, updatedBy string, req *adminV1.SetWorldInventorySettingsRequest) (*adminV1.WorldInventorySettings, error)
+	WorldSettings(ctx context.Context, worldID string) (*adminV1.WorldInventorySettings, error)
+}
+
+// WorldTimeScaleService defines the interface for changing how fast a world runs
+type WorldTimeScaleService interface {
+	SetTimeScale(ctx context.Context, updatedBy string, req *adminV1.SetWorldTimeScaleRequest) (*adminV1.WorldTimeScale, error)
+	TimeScale(ctx context.Context, worldID string) (*adminV1.WorldTimeScale, error)
+}
+
+// WorldPauseService defines the interface for freezing and unfreezing single worlds
+type WorldPauseService interface {
+	Pause(ctx context.Context, pausedBy string, req *adminV1.PauseWorldRequest) (*adminV1.WorldPause, error)
+	Resume(ctx context.Context, worldID, resumedBy string) (bool, error)
+	List(ctx context.Context) ([]*adminV1.WorldPause, error)
+}
+
+// StatusEffectService defines the interface for granting and removing status effects
+type StatusEffectService interface {
+	ApplyStatusEffect(ctx context.Context, req *adminV1.ApplyStatusEffectRequest) (*characterV1.StatusEffect, error)
+	RemoveStatusEffect(ctx context.Context, req *adminV1.RemoveStatusEffectRequest) (bool, error)
+}
+
+// EconomyService defines the interface for reading the economy ledger
+type EconomyService interface {
+	Stats(ctx context.Context, worldID string, period adminV1.EconomyPeriod, periods int32) (*adminV1.GetEconomyStatsResponse, error)
+}
+
+// CharacterNameLookup defines the interface for tracing character names for moderation
+type CharacterNameLookup interface {
+	LookupName(ctx context.Context, worldID, name string, limit int32) ([]*characterV1.Character, []*characterV1.CharacterNameChange, error)
+}
+
+// ConsentPublisher defines the interface for publishing terms of service and privacy policy versions
+type ConsentPublisher interface {
+	Publish(ctx context.Context, publishedBy string, req *adminV1.PublishConsentDocumentRequest) (*userV1.ConsentDocument, error)
+}
+
+type adminServiceServer struct {
+	adminV1.UnimplementedAdminServiceServer
+	reports       ReportModerationService
+	apiKeys       APIKeyService
+	regions       ProtectedRegionService
+	flags         FeatureFlagService
+	experiments   ExperimentService
+	maintenance   MaintenanceService
+	announcements AnnouncementService
+	inventory     WorldInventoryService
+	timeScales    WorldTimeScaleService
+	pauses        WorldPauseService
+	effects       StatusEffectService
+	economy       EconomyService
+	names         CharacterNameLookup
+	consent       ConsentPublisher
+	logger        *log.Logger
+}
+
+// NewAdminServer creates the admin service handler; every RPC requires an admin user
+func NewAdminServer(reports ReportModerationService, apiKeys APIKeyService, regions ProtectedRegionService, flags FeatureFlagService, experiments ExperimentService, maintenance MaintenanceService, announcements AnnouncementService, inventory WorldInventoryService, timeScales WorldTimeScaleService, pauses WorldPauseService, effects StatusEffectService, economy EconomyService, names CharacterNameLookup, consent ConsentPublisher) adminV1.AdminServiceServer {
+	logger := logging.WithComponent("admin-handler")
+	logger.Debug("Creating new AdminService server instance")
+	return &adminServiceServer{
+		reports:       reports,
+		apiKeys:       apiKeys,
+		regions:       regions,
+		flags:         flags,
+		experiments:   experiments,
+		maintenance:   maintenance,
+		announcements: announcements,
+		inventory:     inventory,
+		timeScales:    timeScales,
+		pauses:        pauses,
+		effects:       effects,
+		economy:       economy,
+		names:         names,
+		consent:       consent,
+		logger:        logger,
+	}
+}
+
+// ListPlayerReports pages through player reports (admin only)
+func (s *adminServiceServer) ListPlayerReports(ctx context.Context, req *adminV1.ListPlayerReportsRequest) (*adminV1.ListPlayerReportsResponse, error) {
+	logger := s.logger.With("operation", "ListPlayerReports")
+
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		logger.Warn("Non-admin attempted to list player reports", "user_id", userID)
+		return nil, err
+	}
+
+	reports, err := s.reports.ListReports(ctx, req.Status, req.AfterId, req.Limit)
+	if err != nil {
+		logger.Error("Failed to list player reports", "error", err)
+		return nil, err
+	}
+	return &adminV1.ListPlayerReportsResponse{Reports: reports}, nil
+}
+
+// ResolvePlayerReport closes a report with the moderator's resolution (admin only)
+func (s *adminServiceServer) ResolvePlayerReport(ctx context.Context, req *adminV1.ResolvePlayerReportRequest) (*adminV1.ResolvePlayerReportResponse, error) {
+	logger := s.logger.With("operation", "ResolvePlayerReport", "report_id", req.ReportId)
+
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		logger.Warn("Non-admin attempted to resolve a player report", "user_id", userID)
+		return nil, err
+	}
+	if req.ReportId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "report_id is required")
+	}
+
+	moderatorID, _ := middleware.GetUserIDFromContext(ctx)
+	report, err := s.reports.ResolveReport(ctx, req.ReportId, moderatorID, req.Resolution)
+	if err != nil {
+		logger.Warn("Failed to resolve player report", "error", err)
+		return nil, err
+	}
+	return &adminV1.ResolvePlayerReportResponse{Report: report}, nil
+}
+
+// CreateApiKey creates an API key for an integration; the token is only returned here (admin only)
+func (s *adminServiceServer) CreateApiKey(ctx context.Context, req *adminV1.CreateApiKeyRequest) (*adminV1.CreateApiKeyResponse, error) {
+	logger := s.logger.With("operation", "CreateApiKey", "name", req.Name)
+
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		logger.Warn("Non-admin attempted to create an API key", "user_id", userID)
+		return nil, err
+	}
+
+	var expiresAt time.Time
+	if req.ExpiresAt != nil {
+		expiresAt = req.ExpiresAt.AsTime()
+	}
+	createdBy, _ := middleware.GetUserIDFromContext(ctx)
+	key, token, err := s.apiKeys.CreateKey(ctx, createdBy, req.Name, req.Scopes, expiresAt)
+	if err != nil {
+		logger.Warn("Failed to create API key", "error", err)
+		return nil, err
+	}
+	return &adminV1.CreateApiKeyResponse{ApiKey: key, Token: token}, nil
+}
+
+// ListApiKeys lists every API key, without secrets (admin only)
+func (s *adminServiceServer) ListApiKeys(ctx context.Context, req *adminV1.ListApiKeysRequest) (*adminV1.ListApiKeysResponse, error) {
+	logger := s.logger.With("operation", "ListApiKeys")
+
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		logger.Warn("Non-admin attempted to list API keys", "user_id", userID)
+		return nil, err
+	}
+
+	keys, err := s.apiKeys.ListKeys(ctx)
+	if err != nil {
+		logger.Error("Failed to list API keys", "error", err)
+		return nil, err
+	}
+	return &adminV1.ListApiKeysResponse{ApiKeys: keys}, nil
+}
+
+// RevokeApiKey stops an API key from authenticating (admin only)
+func (s *adminServiceServer) RevokeApiKey(ctx context.Context, req *adminV1.RevokeApiKeyRequest) (*adminV1.RevokeApiKeyResponse, error) {
+	logger := s.logger.With("operation", "RevokeApiKey", "id", req.Id)
+
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		logger.Warn("Non-admin attempted to revoke an API key", "user_id", userID)
+		return nil, err
+	}
+	if req.Id <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required")
+	}
+
+	key, err := s.apiKeys.RevokeKey(ctx, req.Id)
+	if err != nil {
+		logger.Warn("Failed to revoke API key", "error", err)
+		return nil, err
+	}
+	return &adminV1.RevokeApiKeyResponse{ApiKey: key}, nil
+}
+
+// CreateProtectedRegion protects an area of a world from the flagged actions (admin only)
+func (s *adminServiceServer) CreateProtectedRegion(ctx context.Context, req *adminV1.CreateProtectedRegionRequest) (*adminV1.CreateProtectedRegionResponse, error) {
+	logger := s.logger.With("operation", "CreateProtectedRegion", "name", req.Name)
+
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		logger.Warn("Non-admin attempted to create a protected region", "user_id", userID)
+		return nil, err
+	}
+
+	createdBy, _ := middleware.GetUserIDFromContext(ctx)
+	region, err := s.regions.CreateRegion(ctx, createdBy, req.WorldId, protected_region.Definition{
+		Name:      req.Name,
+		Flags:     req.Flags,
+		Polygon:   req.Polygon,
+		ChunkRect: req.ChunkRect,
+	})
+	if err != nil {
+		logger.Warn("Failed to create protected region", "error", err)
+		return nil, err
+	}
+	return &adminV1.CreateProtectedRegionResponse{Region: region}, nil
+}
+
+// UpdateProtectedRegion replaces a protected region's name, flags and shape (admin only)
+func (s *adminServiceServer) UpdateProtectedRegion(ctx context.Context, req *adminV1.UpdateProtectedRegionRequest) (*adminV1.UpdateProtectedRegionResponse, error) {
+	logger := s.logger.With("operation", "UpdateProtectedRegion", "id", req.Id)
+
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		logger.Warn("Non-admin attempted to update a protected region", "user_id", userID)
+		return nil, err
+	}
+	if req.Id <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required")
+	}
+
+	region, err := s.regions.UpdateRegion(ctx, req.Id, protected_region.Definition{
+		Name:      req.Name,
+		Flags:     req.Flags,
+		Polygon:   req.Polygon,
+		ChunkRect: req.ChunkRect,
+	})
+	if err != nil {
+		logger.Warn("Failed to update protected region", "error", err)
+		return nil, err
+	}
+	return &adminV1.UpdateProtectedRegionResponse{Region: region}, nil
+}
+
+// ListProtectedRegions lists the protected regions of a world (admin only)
+func (s *adminServiceServer) ListProtectedRegions(ctx context.Context, req *adminV1.ListProtectedRegionsRequest) (*adminV1.ListProtectedRegionsResponse, error) {
+	logger := s.logger.With("operation", "ListProtectedRegions")
+
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		logger.Warn("Non-admin attempted to list protected regions", "user_id", userID)
+		return nil, err
+	}
+
+	regions, err := s.regions.ListRegions(ctx, req.WorldId)
+	if err != nil {
+		logger.Warn("Failed to list protected regions", "error", err)
+		return nil, err
+	}
+	return &adminV1.ListProtectedRegionsResponse{Regions: regions}, nil
+}
+
+// DeleteProtectedRegion removes a protected region (admin only)
+func (s *adminServiceServer) DeleteProtectedRegion(ctx context.Context, req *adminV1.DeleteProtectedRegionRequest) (*adminV1.DeleteProtectedRegionResponse, error) {
+	logger := s.logger.With("operation", "DeleteProtectedRegion", "id", req.Id)
+
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		logger.Warn("Non-admin attempted to delete a protected region", "user_id", userID)
+		return nil, err
+	}
+	if req.Id <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required")
+	}
+
+	region, err := s.regions.DeleteRegion(ctx, req.Id)
+	if err != nil {
+		logger.Warn("Failed to delete protected region", "error", err)
+		return nil, err
+	}
+	return &adminV1.DeleteProtectedRegionResponse{Region: region}, nil
+}
+
+// SetFeatureFlag creates or replaces a feature flag's settings (admin only)
+func (s *adminServiceServer) SetFeatureFlag(ctx context.Context, req *adminV1.SetFeatureFlagRequest) (*adminV1.SetFeatureFlagResponse, error) {
+	logger := s.logger.With("operation", "SetFeatureFlag", "name", req.GetFlag().GetName())
+
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		logger.Warn("Non-admin attempted to set a feature flag", "user_id", userID)
+		return nil, err
+	}
+
+	updatedBy, _ := middleware.GetUserIDFromContext(ctx)
+	flag, err := s.flags.SetFlag(ctx, updatedBy, req.Flag)
+	if err != nil {
+		logger.Warn("Failed to set feature flag", "error", err)
+		return nil, err
+	}
+	return &adminV1.SetFeatureFlagResponse{Flag: flag}, nil
+}
+
+// ListFeatureFlags lists stored and known feature flags (admin only)
+func (s *adminServiceServer) ListFeatureFlags(ctx context.Context, req *adminV1.ListFeatureFlagsRequest) (*adminV1.ListFeatureFlagsResponse, error) {
+	logger := s.logger.With("operation", "ListFeatureFlags")
+
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		logger.Warn("Non-admin attempted to list feature flags", "user_id", userID)
+		return nil, err
+	}
+
+	flags, err := s.flags.ListFlags(ctx)
+	if err != nil {
+		logger.Warn("Failed to list feature flags", "error", err)
+		return nil, err
+	}
+	return &adminV1.ListFeatureFlagsResponse{Flags: flags}, nil
+}
+
+// DeleteFeatureFlag removes a feature flag's settings (admin only)
+func (s *adminServiceServer) DeleteFeatureFlag(ctx context.Context, req *adminV1.DeleteFeatureFlagRequest) (*adminV1.DeleteFeatureFlagResponse, error) {
+	logger := s.logger.With("operation", "DeleteFeatureFlag", "name", req.Name)
+
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		logger.Warn("Non-admin attempted to delete a feature flag", "user_id", userID)
+		return nil, err
+	}
+	if req.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name is required")
+	}
+
+	flag, err := s.flags.DeleteFlag(ctx, req.Name)
+	if err != nil {
+		logger.Warn("Failed to delete feature flag", "error", err)
+		return nil, err
+	}
+	return &adminV1.DeleteFeatureFlagResponse{Flag: flag}, nil
+}
+
+// CreateExperiment starts an A/B experiment (admin only)
+func (s *adminServiceServer) CreateExperiment(ctx context.Context, req *adminV1.CreateExperimentRequest) (*adminV1.CreateExperimentResponse, error) {
+	logger := s.logger.With("operation", "CreateExperiment", "name", req.GetExperiment().GetName())
+
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		logger.Warn("Non-admin attempted to create an experiment", "user_id", userID)
+		return nil, err
+	}
+
+	createdBy, _ := middleware.GetUserIDFromContext(ctx)
+	experiment, err := s.experiments.CreateExperiment(ctx, createdBy, req.Experiment)
+	if err != nil {
+		logger.Warn("Failed to create experiment", "error", err)
+		return nil, err
+	}
+	return &adminV1.CreateExperimentResponse{Experiment: experiment}, nil
+}
+
+// ListExperiments lists experiments with their assignment counts (admin only)
+func (s *adminServiceServer) ListExperiments(ctx context.Context, req *adminV1.ListExperimentsRequest) (*adminV1.ListExperimentsResponse, error) {
+	logger := s.logger.With("operation", "ListExperiments")
+
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		logger.Warn("Non-admin attempted to list experiments", "user_id", userID)
+		return nil, err
+	}
+
+	experiments, err := s.experiments.ListExperiments(ctx)
+	if err != nil {
+		logger.Warn("Failed to list experiments", "error", err)
+		return nil, err
+	}
+	return &adminV1.ListExperimentsResponse{Experiments: experiments}, nil
+}
+
+// StopExperiment stops assigning new subjects to an experiment (admin only)
+func (s *adminServiceServer) StopExperiment(ctx context.Context, req *adminV1.StopExperimentRequest) (*adminV1.StopExperimentResponse, error) {
+	logger := s.logger.With("operation", "StopExperiment", "name", req.Name)
+
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		logger.Warn("Non-admin attempted to stop an experiment", "user_id", userID)
+		return nil, err
+	}
+	if req.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name is required")
+	}
+
+	experiment, err := s.experiments.StopExperiment(ctx, req.Name)
+	if err != nil {
+		logger.Warn("Failed to stop experiment", "error", err)
+		return nil, err
+	}
+	return &adminV1.StopExperimentResponse{Experiment: experiment}, nil
+}
+
+// SetMaintenanceMode turns maintenance mode on or off (admin only)
+func (s *adminServiceServer) SetMaintenanceMode(ctx context.Context, req *adminV1.SetMaintenanceModeRequest) (*adminV1.SetMaintenanceModeResponse, error) {
+	logger := s.logger.With("operation", "SetMaintenanceMode", "enabled", req.Enabled)
+
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		logger.Warn("Non-admin attempted to set maintenance mode", "user_id", userID)
+		return nil, err
+	}
+
+	updatedBy, _ := middleware.GetUserIDFromContext(ctx)
+	mode, err := s.maintenance.Set(ctx, updatedBy, req)
+	if err != nil {
+		logger.Warn("Failed to set maintenance mode", "error", err)
+		return nil, err
+	}
+	return &adminV1.SetMaintenanceModeResponse{Maintenance: mode}, nil
+}
+
+// GetMaintenanceMode returns the current maintenance mode (admin only)
+func (s *adminServiceServer) GetMaintenanceMode(ctx context.Context, req *adminV1.GetMaintenanceModeRequest) (*adminV1.GetMaintenanceModeResponse, error) {
+	logger := s.logger.With("operation", "GetMaintenanceMode")
+
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		logger.Warn("Non-admin attempted to get maintenance mode", "user_id", userID)
+		return nil, err
+	}
+
+	mode, err := s.maintenance.Mode(ctx)
+	if err != nil {
+		logger.Warn("Failed to get maintenance mode", "error", err)
+		return nil, err
+	}
+	return &adminV1.GetMaintenanceModeResponse{Maintenance: mode}, nil
+}
+
+// BroadcastAnnouncement pushes an announcement to every online player (admin only)
+func (s *adminServiceServer) BroadcastAnnouncement(ctx context.Context, req *adminV1.BroadcastAnnouncementRequest) (*adminV1.BroadcastAnnouncementResponse, error) {
+	logger := s.logger.With("operation", "BroadcastAnnouncement", "severity", req.Severity)
+
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		logger.Warn("Non-admin attempted to broadcast an announcement", "user_id", userID)
+		return nil, err
+	}
+
+	createdBy, _ := middleware.GetUserIDFromContext(ctx)
+	announcement, err := s.announcements.Announce(ctx, createdBy, req.Message, req.Severity, req.ExpiresAt)
+	if err != nil {
+		logger.Warn("Failed to broadcast announcement", "error", err)
+		return nil, err
+	}
+	return &adminV1.BroadcastAnnouncementResponse{Announcement: announcement}, nil
+}
+
+// SetWorldInventorySettings replaces the inventory rules of a world (admin only)
+func (s *adminServiceServer) SetWorldInventorySettings(ctx context.Context, req *adminV1.SetWorldInventorySettingsRequest) (*adminV1.SetWorldInventorySettingsResponse, error) {
+	logger := s.logger.With("operation", "SetWorldInventorySettings", "world_id", req.WorldId)
+
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		logger.Warn("Non-admin attempted to set world inventory settings", "user_id", userID)
+		return nil, err
+	}
+
+	updatedBy, _ := middleware.GetUserIDFromContext(ctx)
+	settings, err := s.inventory.SetWorldSettings(ctx, updatedBy, req)
+	if err != nil {
+		logger.Warn("Failed to set world inventory settings", "error", err)
+		return nil, err
+	}
+	return &adminV1.SetWorldInventorySettingsResponse{Settings: settings}, nil
+}
+
+// GetWorldInventorySettings returns the inventory rules of a world (admin only)
+func (s *adminServiceServer) GetWorldInventorySettings(ctx context.Context, req *adminV1.GetWorldInventorySettingsRequest) (*adminV1.GetWorldInventorySettingsResponse, error) {
+	logger := s.logger.With("operation", "GetWorldInventorySettings", "world_id", req.WorldId)
+
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		logger.Warn("Non-admin attempted to read world inventory settings", "user_id", userID)
+		return nil, err
+	}
+
+	settings, err := s.inventory.WorldSettings(ctx, req.WorldId)
+	if err != nil {
+		logger.Error("Failed to get world inventory settings", "error", err)
+		return nil, err
+	}
+	return &adminV1.GetWorldInventorySettingsResponse{Settings: settings}, nil
+}
+
+// SetWorldTimeScale changes how fast a world's time-dependent systems run (admin only)
+func (s *adminServiceServer) SetWorldTimeScale(ctx context.Context, req *adminV1.SetWorldTimeScaleRequest) (*adminV1.SetWorldTimeScaleResponse, error) {
+	logger := s.logger.With("operation", "SetWorldTimeScale", "world_id", req.WorldId, "multiplier", req.Multiplier)
+
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		logger.Warn("Non-admin attempted to set a world time scale", "user_id", userID)
+		return nil, err
+	}
+
+	updatedBy, _ := middleware.GetUserIDFromContext(ctx)
+	timeScale, err := s.timeScales.SetTimeScale(ctx, updatedBy, req)
+	if err != nil {
+		logger.Warn("Failed to set world time scale", "error", err)
+		return nil, err
+	}
+	return &adminV1.SetWorldTimeScaleResponse{TimeScale: timeScale}, nil
+}
+
+// GetWorldTimeScale returns how fast a world's time-dependent systems run (admin only)
+func (s *adminServiceServer) GetWorldTimeScale(ctx context.Context, req *adminV1.GetWorldTimeScaleRequest) (*adminV1.GetWorldTimeScaleResponse, error) {
+	logger := s.logger.With("operation", "GetWorldTimeScale", "world_id", req.WorldId)
+
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		logger.Warn("Non-admin attempted to read a world time scale", "user_id", userID)
+		return nil, err
+	}
+
+	timeScale, err := s.timeScales.TimeScale(ctx, req.WorldId)
+	if err != nil {
+		logger.Error("Failed to get world time scale", "error", err)
+		return nil, err
+	}
+	return &adminV1.GetWorldTimeScaleResponse{TimeScale: timeScale}, nil
+}
+
+// PauseWorld freezes writes and background jobs in one world (admin only)
+func (s *adminServiceServer) PauseWorld(ctx context.Context, req *adminV1.PauseWorldRequest) (*adminV1.PauseWorldResponse, error) {
+	logger := s.logger.With("operation", "PauseWorld", "world_id", req.WorldId)
+
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		logger.Warn("Non-admin attempted to pause a world", "user_id", userID)
+		return nil, err
+	}
+
+	pausedBy, _ := middleware.GetUserIDFromContext(ctx)
+	pause, err := s.pauses.Pause(ctx, pausedBy, req)
+	if err != nil {
+		logger.Warn("Failed to pause world", "error", err)
+		return nil, err
+	}
+	return &adminV1.PauseWorldResponse{Pause: pause}, nil
+}
+
+// ResumeWorld lifts the pause of a world (admin only)
+func (s *adminServiceServer) ResumeWorld(ctx context.Context, req *adminV1.ResumeWorldRequest) (*adminV1.ResumeWorldResponse, error) {
+	logger := s.logger.With("operation", "ResumeWorld", "world_id", req.WorldId)
+
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		logger.Warn("Non-admin attempted to resume a world", "user_id", userID)
+		return nil, err
+	}
+
+	resumedBy, _ := middleware.GetUserIDFromContext(ctx)
+	resumed, err := s.pauses.Resume(ctx, req.WorldId, resumedBy)
+	if err != nil {
+		logger.Warn("Failed to resume world", "error", err)
+		return nil, err
+	}
+	return &adminV1.ResumeWorldResponse{Resumed: resumed}, nil
+}
+
+// ListWorldPauses returns the paused worlds (admin only)
+func (s *adminServiceServer) ListWorldPauses(ctx context.Context, req *adminV1.ListWorldPausesRequest) (*adminV1.ListWorldPausesResponse, error) {
+	logger := s.logger.With("operation", "ListWorldPauses")
+
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		logger.Warn("Non-admin attempted to list world pauses", "user_id", userID)
+		return nil, err
+	}
+
+	pauses, err := s.pauses.List(ctx)
+	if err != nil {
+		logger.Error("Failed to list world pauses", "error", err)
+		return nil, err
+	}
+	return &adminV1.ListWorldPausesResponse{Pauses: pauses}, nil
+}
+
+// ApplyStatusEffect grants a status effect to a character (admin only)
+func (s *adminServiceServer) ApplyStatusEffect(ctx context.Context, req *adminV1.ApplyStatusEffectRequest) (*adminV1.ApplyStatusEffectResponse, error) {
+	logger := s.logger.With("operation", "ApplyStatusEffect", "character_id", req.CharacterId, "effect", req.EffectKey)
+
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		logger.Warn("Non-admin attempted to apply a status effect", "user_id", userID)
+		return nil, err
+	}
+
+	effect, err := s.effects.ApplyStatusEffect(ctx, req)
+	if err != nil {
+		logger.Warn("Failed to apply status effect", "error", err)
+		return nil, err
+	}
+	return &adminV1.ApplyStatusEffectResponse{Effect: effect}, nil
+}
+
+// RemoveStatusEffect ends a status effect on a character early (admin only)
+func (s *adminServiceServer) RemoveStatusEffect(ctx context.Context, req *adminV1.RemoveStatusEffectRequest) (*adminV1.RemoveStatusEffectResponse, error) {
+	logger := s.logger.With("operation", "RemoveStatusEffect", "character_id", req.CharacterId, "effect", req.EffectKey)
+
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		logger.Warn("Non-admin attempted to remove a status effect", "user_id", userID)
+		return nil, err
+	}
+
+	removed, err := s.effects.RemoveStatusEffect(ctx, req)
+	if err != nil {
+		logger.Warn("Failed to remove status effect", "error", err)
+		return nil, err
+	}
+	return &adminV1.RemoveStatusEffectResponse{Removed: removed}, nil
+}
+
+// GetEconomyStats reports the currency created and destroyed in a world per period (admin only)
+func (s *adminServiceServer) GetEconomyStats(ctx context.Context, req *adminV1.GetEconomyStatsRequest) (*adminV1.GetEconomyStatsResponse, error) {
+	logger := s.logger.With("operation", "GetEconomyStats", "world_id", req.WorldId, "period", req.Period)
+
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		logger.Warn("Non-admin attempted to read economy stats", "user_id", userID)
+		return nil, err
+	}
+
+	resp, err := s.economy.Stats(ctx, req.WorldId, req.Period, req.Periods)
+	if err != nil {
+		logger.Warn("Failed to get economy stats", "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// LookupCharacterName traces a name to the characters using it and the renames from or to
+// it (admin only)
+func (s *adminServiceServer) LookupCharacterName(ctx context.Context, req *adminV1.LookupCharacterNameRequest) (*adminV1.LookupCharacterNameResponse, error) {
+	logger := s.logger.With("operation", "LookupCharacterName", "world_id", req.WorldId, "name", req.Name)
+
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		logger.Warn("Non-admin attempted to look up a character name", "user_id", userID)
+		return nil, err
+	}
+
+	characters, changes, err := s.names.LookupName(ctx, req.WorldId, req.Name, req.Limit)
+	if err != nil {
+		logger.Warn("Failed to look up character name", "error", err)
+		return nil, err
+	}
+	return &adminV1.LookupCharacterNameResponse{Characters: characters, Changes: changes}, nil
+}
+
+// PublishConsentDocument publishes the next version of the terms of service or privacy
+// policy (admin only)
+func (s *adminServiceServer) PublishConsentDocument(ctx context.Context, req *adminV1.PublishConsentDocumentRequest) (*adminV1.PublishConsentDocumentResponse, error) {
+	logger := s.logger.With("operation", "PublishConsentDocument", "kind", req.Kind, "required", req.Required)
+
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		logger.Warn("Non-admin attempted to publish a consent document", "user_id", userID)
+		return nil, err
+	}
+
+	publishedBy, _ := middleware.GetUserIDFromContext(ctx)
+	document, err := s.consent.Publish(ctx, publishedBy, req)
+	if err != nil {
+		logger.Warn("Failed to publish consent document", "error", err)
+		return nil, err
+	}
+	return &adminV1.PublishConsentDocumentResponse{Document: document}, nil
+}
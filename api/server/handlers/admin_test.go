@@ -0,0 +1,664 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/testutil"
+	adminV1 "github.com/VoidMesh/api/api/proto/admin/v1"
+	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	inventoryV1 "github.com/VoidMesh/api/api/proto/inventory/v1"
+	notificationV1 "github.com/VoidMesh/api/api/proto/notification/v1"
+	socialV1 "github.com/VoidMesh/api/api/proto/social/v1"
+	userV1 "github.com/VoidMesh/api/api/proto/user/v1"
+	"github.com/VoidMesh/api/api/server/middleware"
+	"github.com/VoidMesh/api/api/services/protected_region"
+	"github.com/charmbracelet/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// fakeReportModeration records which moderator resolved which report
+type fakeReportModeration struct {
+	resolvedBy map[int64]string
+}
+
+func (f *fakeReportModeration) ListReports(ctx context.Context, reportStatus socialV1.ReportStatus, afterID int64, limit int32) ([]*socialV1.PlayerReport, error) {
+	return []*socialV1.PlayerReport{{Id: afterID + 1, Status: socialV1.ReportStatus_REPORT_STATUS_OPEN}}, nil
+}
+
+func (f *fakeReportModeration) ResolveReport(ctx context.Context, reportID int64, moderatorID, resolution string) (*socialV1.PlayerReport, error) {
+	f.resolvedBy[reportID] = moderatorID
+	return &socialV1.PlayerReport{Id: reportID, Resolution: resolution, ResolvedBy: moderatorID}, nil
+}
+
+func TestAdminServiceServer_RequireAdmin(t *testing.T) {
+	middleware.SetAdminUserIDs([]string{testutil.UUIDTestData.User1})
+	t.Cleanup(func() { middleware.SetAdminUserIDs(nil) })
+
+	reports := &fakeReportModeration{resolvedBy: map[int64]string{}}
+	server := &adminServiceServer{reports: reports, logger: log.New(io.Discard)}
+	admin := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "admin")
+	player := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User2, "player")
+
+	_, err := server.ListPlayerReports(player, &adminV1.ListPlayerReportsRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = server.ResolvePlayerReport(player, &adminV1.ResolvePlayerReportRequest{ReportId: 1, Resolution: "banned"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Empty(t, reports.resolvedBy)
+
+	listed, err := server.ListPlayerReports(admin, &adminV1.ListPlayerReportsRequest{AfterId: 4})
+	require.NoError(t, err)
+	require.Len(t, listed.Reports, 1)
+	assert.Equal(t, int64(5), listed.Reports[0].Id)
+
+	_, err = server.ResolvePlayerReport(admin, &adminV1.ResolvePlayerReportRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	resolved, err := server.ResolvePlayerReport(admin, &adminV1.ResolvePlayerReportRequest{ReportId: 3, Resolution: "warned"})
+	require.NoError(t, err)
+	assert.Equal(t, "warned", resolved.Report.Resolution)
+	assert.Equal(t, testutil.UUIDTestData.User1, reports.resolvedBy[3], "the moderator is taken from the caller")
+}
+
+// fakeAPIKeys records the arguments keys were created with
+type fakeAPIKeys struct {
+	createdBy string
+	expiresAt time.Time
+	revoked   []int64
+}
+
+func (f *fakeAPIKeys) CreateKey(ctx context.Context, createdBy, name string, scopes []string, expiresAt time.Time) (*adminV1.ApiKey, string, error) {
+	f.createdBy, f.expiresAt = createdBy, expiresAt
+	return &adminV1.ApiKey{Id: 1, KeyId: "0123456789ab", Name: name, Scopes: scopes, CreatedBy: createdBy}, "vmk_0123456789ab_secret", nil
+}
+
+func (f *fakeAPIKeys) ListKeys(ctx context.Context) ([]*adminV1.ApiKey, error) {
+	return []*adminV1.ApiKey{{Id: 1, KeyId: "0123456789ab"}}, nil
+}
+
+func (f *fakeAPIKeys) RevokeKey(ctx context.Context, id int64) (*adminV1.ApiKey, error) {
+	f.revoked = append(f.revoked, id)
+	return &adminV1.ApiKey{Id: id, RevokedAt: timestamppb.Now()}, nil
+}
+
+func TestAdminServiceServer_ApiKeys(t *testing.T) {
+	middleware.SetAdminUserIDs([]string{testutil.UUIDTestData.User1})
+	t.Cleanup(func() { middleware.SetAdminUserIDs(nil) })
+
+	keys := &fakeAPIKeys{}
+	server := &adminServiceServer{apiKeys: keys, logger: log.New(io.Discard)}
+	admin := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "admin")
+	player := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User2, "player")
+
+	_, err := server.CreateApiKey(player, &adminV1.CreateApiKeyRequest{Name: "analytics", Scopes: []string{"chunk:read"}})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = server.ListApiKeys(player, &adminV1.ListApiKeysRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = server.RevokeApiKey(player, &adminV1.RevokeApiKeyRequest{Id: 1})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Empty(t, keys.revoked)
+
+	created, err := server.CreateApiKey(admin, &adminV1.CreateApiKeyRequest{Name: "analytics", Scopes: []string{"chunk:read"}})
+	require.NoError(t, err)
+	assert.Equal(t, "vmk_0123456789ab_secret", created.Token)
+	assert.Equal(t, testutil.UUIDTestData.User1, keys.createdBy, "the creator is taken from the caller")
+	assert.True(t, keys.expiresAt.IsZero(), "keys without expires_at never expire")
+
+	expiry := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, err = server.CreateApiKey(admin, &adminV1.CreateApiKeyRequest{Name: "analytics", Scopes: []string{"chunk:read"}, ExpiresAt: timestamppb.New(expiry)})
+	require.NoError(t, err)
+	assert.Equal(t, expiry, keys.expiresAt)
+
+	listed, err := server.ListApiKeys(admin, &adminV1.ListApiKeysRequest{})
+	require.NoError(t, err)
+	assert.Len(t, listed.ApiKeys, 1)
+
+	_, err = server.RevokeApiKey(admin, &adminV1.RevokeApiKeyRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	revoked, err := server.RevokeApiKey(admin, &adminV1.RevokeApiKeyRequest{Id: 1})
+	require.NoError(t, err)
+	assert.NotNil(t, revoked.ApiKey.RevokedAt)
+}
+
+// fakeRegions records the regions it is asked to create
+type fakeRegions struct {
+	createdBy string
+	worldID   string
+	def       protected_region.Definition
+}
+
+func (f *fakeRegions) CreateRegion(ctx context.Context, createdBy, worldID string, def protected_region.Definition) (*chunkV1.ProtectedRegion, error) {
+	f.createdBy, f.worldID, f.def = createdBy, worldID, def
+	return &chunkV1.ProtectedRegion{Id: 1, Name: def.Name, Flags: def.Flags}, nil
+}
+
+func (f *fakeRegions) UpdateRegion(ctx context.Context, id int64, def protected_region.Definition) (*chunkV1.ProtectedRegion, error) {
+	return &chunkV1.ProtectedRegion{Id: id, Name: def.Name, Flags: def.Flags}, nil
+}
+
+func (f *fakeRegions) ListRegions(ctx context.Context, worldID string) ([]*chunkV1.ProtectedRegion, error) {
+	return []*chunkV1.ProtectedRegion{{Id: 1}}, nil
+}
+
+func (f *fakeRegions) DeleteRegion(ctx context.Context, id int64) (*chunkV1.ProtectedRegion, error) {
+	return &chunkV1.ProtectedRegion{Id: id}, nil
+}
+
+func TestAdminServiceServer_ProtectedRegions(t *testing.T) {
+	middleware.SetAdminUserIDs([]string{testutil.UUIDTestData.User1})
+	t.Cleanup(func() { middleware.SetAdminUserIDs(nil) })
+
+	regions := &fakeRegions{}
+	server := &adminServiceServer{regions: regions, logger: log.New(io.Discard)}
+	admin := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "admin")
+	player := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User2, "player")
+
+	create := &adminV1.CreateProtectedRegionRequest{
+		WorldId:   testutil.UUIDTestData.World1,
+		Name:      "Spawn",
+		Flags:     []chunkV1.RegionFlag{chunkV1.RegionFlag_REGION_FLAG_NO_HARVEST},
+		ChunkRect: &chunkV1.ChunkRect{MinChunkX: -1, MinChunkY: -1, MaxChunkX: 1, MaxChunkY: 1},
+	}
+	_, err := server.CreateProtectedRegion(player, create)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = server.ListProtectedRegions(player, &adminV1.ListProtectedRegionsRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = server.DeleteProtectedRegion(player, &adminV1.DeleteProtectedRegionRequest{Id: 1})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Empty(t, regions.createdBy)
+
+	created, err := server.CreateProtectedRegion(admin, create)
+	require.NoError(t, err)
+	assert.Equal(t, "Spawn", created.Region.Name)
+	assert.Equal(t, testutil.UUIDTestData.User1, regions.createdBy, "the creator is taken from the caller")
+	assert.Equal(t, testutil.UUIDTestData.World1, regions.worldID)
+	assert.Equal(t, int32(1), regions.def.ChunkRect.MaxChunkX)
+
+	_, err = server.UpdateProtectedRegion(admin, &adminV1.UpdateProtectedRegionRequest{Name: "Spawn"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	updated, err := server.UpdateProtectedRegion(admin, &adminV1.UpdateProtectedRegionRequest{Id: 1, Name: "Town"})
+	require.NoError(t, err)
+	assert.Equal(t, "Town", updated.Region.Name)
+
+	listed, err := server.ListProtectedRegions(admin, &adminV1.ListProtectedRegionsRequest{})
+	require.NoError(t, err)
+	assert.Len(t, listed.Regions, 1)
+
+	_, err = server.DeleteProtectedRegion(admin, &adminV1.DeleteProtectedRegionRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	deleted, err := server.DeleteProtectedRegion(admin, &adminV1.DeleteProtectedRegionRequest{Id: 1})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted.Region.Id)
+}
+
+// fakeFlags records the user each flag was set by
+type fakeFlags struct {
+	updatedBy string
+	flags     map[string]*adminV1.FeatureFlag
+}
+
+func (f *fakeFlags) SetFlag(ctx context.Context, updatedBy string, flag *adminV1.FeatureFlag) (*adminV1.FeatureFlag, error) {
+	f.updatedBy = updatedBy
+	f.flags[flag.Name] = flag
+	return flag, nil
+}
+
+func (f *fakeFlags) ListFlags(ctx context.Context) ([]*adminV1.FeatureFlag, error) {
+	var flags []*adminV1.FeatureFlag
+	for _, flag := range f.flags {
+		flags = append(flags, flag)
+	}
+	return flags, nil
+}
+
+func (f *fakeFlags) DeleteFlag(ctx context.Context, name string) (*adminV1.FeatureFlag, error) {
+	flag, ok := f.flags[name]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "feature flag not found")
+	}
+	delete(f.flags, name)
+	return flag, nil
+}
+
+func TestAdminServiceServer_FeatureFlags(t *testing.T) {
+	middleware.SetAdminUserIDs([]string{testutil.UUIDTestData.User1})
+	t.Cleanup(func() { middleware.SetAdminUserIDs(nil) })
+
+	flags := &fakeFlags{flags: map[string]*adminV1.FeatureFlag{}}
+	server := &adminServiceServer{flags: flags, logger: log.New(io.Discard)}
+	admin := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "admin")
+	player := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User2, "player")
+
+	set := &adminV1.SetFeatureFlagRequest{Flag: &adminV1.FeatureFlag{Name: "combat", Enabled: true, RolloutPercentage: 10}}
+	_, err := server.SetFeatureFlag(player, set)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = server.ListFeatureFlags(player, &adminV1.ListFeatureFlagsRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = server.DeleteFeatureFlag(player, &adminV1.DeleteFeatureFlagRequest{Name: "combat"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Empty(t, flags.flags)
+
+	resp, err := server.SetFeatureFlag(admin, set)
+	require.NoError(t, err)
+	assert.Equal(t, int32(10), resp.Flag.RolloutPercentage)
+	assert.Equal(t, testutil.UUIDTestData.User1, flags.updatedBy, "the updater is taken from the caller")
+
+	listed, err := server.ListFeatureFlags(admin, &adminV1.ListFeatureFlagsRequest{})
+	require.NoError(t, err)
+	assert.Len(t, listed.Flags, 1)
+
+	_, err = server.DeleteFeatureFlag(admin, &adminV1.DeleteFeatureFlagRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	deleted, err := server.DeleteFeatureFlag(admin, &adminV1.DeleteFeatureFlagRequest{Name: "combat"})
+	require.NoError(t, err)
+	assert.Equal(t, "combat", deleted.Flag.Name)
+}
+
+// fakeExperiments records the user each experiment was created by
+type fakeExperiments struct {
+	createdBy   string
+	experiments []*adminV1.Experiment
+}
+
+func (f *fakeExperiments) CreateExperiment(ctx context.Context, createdBy string, experiment *adminV1.Experiment) (*adminV1.Experiment, error) {
+	f.createdBy = createdBy
+	f.experiments = append(f.experiments, experiment)
+	return experiment, nil
+}
+
+func (f *fakeExperiments) ListExperiments(ctx context.Context) ([]*adminV1.Experiment, error) {
+	return f.experiments, nil
+}
+
+func (f *fakeExperiments) StopExperiment(ctx context.Context, name string) (*adminV1.Experiment, error) {
+	for _, experiment := range f.experiments {
+		if experiment.Name == name {
+			return experiment, nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "experiment not found or already stopped")
+}
+
+func TestAdminServiceServer_Experiments(t *testing.T) {
+	middleware.SetAdminUserIDs([]string{testutil.UUIDTestData.User1})
+	t.Cleanup(func() { middleware.SetAdminUserIDs(nil) })
+
+	experiments := &fakeExperiments{}
+	server := &adminServiceServer{experiments: experiments, logger: log.New(io.Discard)}
+	admin := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "admin")
+	player := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User2, "player")
+
+	create := &adminV1.CreateExperimentRequest{Experiment: &adminV1.Experiment{
+		Name:    "density",
+		Subject: adminV1.ExperimentSubject_EXPERIMENT_SUBJECT_WORLD,
+	}}
+	_, err := server.CreateExperiment(player, create)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = server.ListExperiments(player, &adminV1.ListExperimentsRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = server.StopExperiment(player, &adminV1.StopExperimentRequest{Name: "density"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Empty(t, experiments.experiments)
+
+	created, err := server.CreateExperiment(admin, create)
+	require.NoError(t, err)
+	assert.Equal(t, "density", created.Experiment.Name)
+	assert.Equal(t, testutil.UUIDTestData.User1, experiments.createdBy, "the creator is taken from the caller")
+
+	listed, err := server.ListExperiments(admin, &adminV1.ListExperimentsRequest{})
+	require.NoError(t, err)
+	assert.Len(t, listed.Experiments, 1)
+
+	_, err = server.StopExperiment(admin, &adminV1.StopExperimentRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	stopped, err := server.StopExperiment(admin, &adminV1.StopExperimentRequest{Name: "density"})
+	require.NoError(t, err)
+	assert.Equal(t, "density", stopped.Experiment.Name)
+}
+
+// fakeMaintenance stores the last request it was given
+type fakeMaintenance struct {
+	mode      *adminV1.MaintenanceMode
+	updatedBy string
+}
+
+func (f *fakeMaintenance) Set(ctx context.Context, updatedBy string, req *adminV1.SetMaintenanceModeRequest) (*adminV1.MaintenanceMode, error) {
+	f.updatedBy = updatedBy
+	f.mode = &adminV1.MaintenanceMode{Enabled: req.Enabled, Message: req.Message, UpdatedBy: updatedBy}
+	return f.mode, nil
+}
+
+func (f *fakeMaintenance) Mode(ctx context.Context) (*adminV1.MaintenanceMode, error) {
+	if f.mode == nil {
+		return &adminV1.MaintenanceMode{}, nil
+	}
+	return f.mode, nil
+}
+
+func TestAdminServiceServer_MaintenanceMode(t *testing.T) {
+	middleware.SetAdminUserIDs([]string{testutil.UUIDTestData.User1})
+	t.Cleanup(func() { middleware.SetAdminUserIDs(nil) })
+
+	maintenance := &fakeMaintenance{}
+	server := &adminServiceServer{maintenance: maintenance, logger: log.New(io.Discard)}
+	admin := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "admin")
+	player := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User2, "player")
+
+	set := &adminV1.SetMaintenanceModeRequest{Enabled: true, Message: "Upgrading"}
+	_, err := server.SetMaintenanceMode(player, set)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = server.GetMaintenanceMode(player, &adminV1.GetMaintenanceModeRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Nil(t, maintenance.mode)
+
+	resp, err := server.SetMaintenanceMode(admin, set)
+	require.NoError(t, err)
+	assert.True(t, resp.Maintenance.Enabled)
+	assert.Equal(t, testutil.UUIDTestData.User1, maintenance.updatedBy, "the admin is taken from the caller")
+
+	got, err := server.GetMaintenanceMode(admin, &adminV1.GetMaintenanceModeRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "Upgrading", got.Maintenance.Message)
+}
+
+// fakeAnnouncements records the last announcement
+type fakeAnnouncements struct {
+	createdBy string
+	message   string
+}
+
+func (f *fakeAnnouncements) Announce(ctx context.Context, createdBy, message string, severity notificationV1.AnnouncementSeverity, expiresAt *timestamppb.Timestamp) (*notificationV1.Announcement, error) {
+	f.createdBy, f.message = createdBy, message
+	return &notificationV1.Announcement{Id: 1, Message: message, Severity: severity}, nil
+}
+
+func TestAdminServiceServer_BroadcastAnnouncement(t *testing.T) {
+	middleware.SetAdminUserIDs([]string{testutil.UUIDTestData.User1})
+	t.Cleanup(func() { middleware.SetAdminUserIDs(nil) })
+
+	announcements := &fakeAnnouncements{}
+	server := &adminServiceServer{announcements: announcements, logger: log.New(io.Discard)}
+	req := &adminV1.BroadcastAnnouncementRequest{Message: "Restart in 10 minutes", Severity: notificationV1.AnnouncementSeverity_ANNOUNCEMENT_SEVERITY_WARNING}
+
+	_, err := server.BroadcastAnnouncement(middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User2, "player"), req)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Empty(t, announcements.message)
+
+	resp, err := server.BroadcastAnnouncement(middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "admin"), req)
+	require.NoError(t, err)
+	assert.Equal(t, "Restart in 10 minutes", resp.Announcement.Message)
+	assert.Equal(t, testutil.UUIDTestData.User1, announcements.createdBy, "the admin is taken from the caller")
+}
+
+// fakeWorldInventory stores the settings of one world
+type fakeWorldInventory struct {
+	updatedBy string
+	settings  *adminV1.WorldInventorySettings
+}
+
+func (f *fakeWorldInventory) SetWorldSettings(ctx context.Context, updatedBy string, req *adminV1.SetWorldInventorySettingsRequest) (*adminV1.WorldInventorySettings, error) {
+	f.updatedBy = updatedBy
+	f.settings = &adminV1.WorldInventorySettings{WorldId: req.WorldId, InventorySlots: req.InventorySlots, HarvestOverflow: req.HarvestOverflow, UpdatedBy: updatedBy}
+	return f.settings, nil
+}
+
+func (f *fakeWorldInventory) WorldSettings(ctx context.Context, worldID string) (*adminV1.WorldInventorySettings, error) {
+	return f.settings, nil
+}
+
+func TestAdminServiceServer_WorldInventorySettings(t *testing.T) {
+	middleware.SetAdminUserIDs([]string{testutil.UUIDTestData.User1})
+	t.Cleanup(func() { middleware.SetAdminUserIDs(nil) })
+
+	inventory := &fakeWorldInventory{}
+	server := &adminServiceServer{inventory: inventory, logger: log.New(io.Discard)}
+	set := &adminV1.SetWorldInventorySettingsRequest{WorldId: testutil.UUIDTestData.World1, InventorySlots: 24, HarvestOverflow: inventoryV1.OverflowPolicy_OVERFLOW_POLICY_GROUND}
+
+	_, err := server.SetWorldInventorySettings(middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User2, "player"), set)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Nil(t, inventory.settings)
+
+	admin := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "admin")
+	_, err = server.SetWorldInventorySettings(admin, set)
+	require.NoError(t, err)
+	assert.Equal(t, testutil.UUIDTestData.User1, inventory.updatedBy, "the admin is taken from the caller")
+
+	got, err := server.GetWorldInventorySettings(admin, &adminV1.GetWorldInventorySettingsRequest{WorldId: testutil.UUIDTestData.World1})
+	require.NoError(t, err)
+	assert.Equal(t, int32(24), got.Settings.InventorySlots)
+	assert.Equal(t, inventoryV1.OverflowPolicy_OVERFLOW_POLICY_GROUND, got.Settings.HarvestOverflow)
+}
+
+// fakeTimeScales stores the time scale of one world
+type fakeTimeScales struct {
+	timeScale *adminV1.WorldTimeScale
+}
+
+func (f *fakeTimeScales) SetTimeScale(ctx context.Context, updatedBy string, req *adminV1.SetWorldTimeScaleRequest) (*adminV1.WorldTimeScale, error) {
+	f.timeScale = &adminV1.WorldTimeScale{WorldId: req.WorldId, Multiplier: req.Multiplier, UpdatedBy: updatedBy}
+	return f.timeScale, nil
+}
+
+func (f *fakeTimeScales) TimeScale(ctx context.Context, worldID string) (*adminV1.WorldTimeScale, error) {
+	return f.timeScale, nil
+}
+
+func TestAdminServiceServer_WorldTimeScale(t *testing.T) {
+	middleware.SetAdminUserIDs([]string{testutil.UUIDTestData.User1})
+	t.Cleanup(func() { middleware.SetAdminUserIDs(nil) })
+
+	timeScales := &fakeTimeScales{}
+	server := &adminServiceServer{timeScales: timeScales, logger: log.New(io.Discard)}
+	set := &adminV1.SetWorldTimeScaleRequest{WorldId: testutil.UUIDTestData.World1, Multiplier: 60}
+
+	_, err := server.SetWorldTimeScale(middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User2, "player"), set)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Nil(t, timeScales.timeScale)
+
+	admin := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "admin")
+	_, err = server.SetWorldTimeScale(admin, set)
+	require.NoError(t, err)
+	assert.Equal(t, testutil.UUIDTestData.User1, timeScales.timeScale.UpdatedBy, "the admin is taken from the caller")
+
+	got, err := server.GetWorldTimeScale(admin, &adminV1.GetWorldTimeScaleRequest{WorldId: testutil.UUIDTestData.World1})
+	require.NoError(t, err)
+	assert.Equal(t, float64(60), got.TimeScale.Multiplier)
+}
+
+// fakeWorldPauses keeps paused worlds in memory
+type fakeWorldPauses struct {
+	paused map[string]*adminV1.WorldPause
+}
+
+func (f *fakeWorldPauses) Pause(ctx context.Context, pausedBy string, req *adminV1.PauseWorldRequest) (*adminV1.WorldPause, error) {
+	pause := &adminV1.WorldPause{WorldId: req.WorldId, Reason: req.Reason, PausedBy: pausedBy}
+	f.paused[req.WorldId] = pause
+	return pause, nil
+}
+
+func (f *fakeWorldPauses) Resume(ctx context.Context, worldID, resumedBy string) (bool, error) {
+	_, ok := f.paused[worldID]
+	delete(f.paused, worldID)
+	return ok, nil
+}
+
+func (f *fakeWorldPauses) List(ctx context.Context) ([]*adminV1.WorldPause, error) {
+	var pauses []*adminV1.WorldPause
+	for _, pause := range f.paused {
+		pauses = append(pauses, pause)
+	}
+	return pauses, nil
+}
+
+func TestAdminServiceServer_WorldPauses(t *testing.T) {
+	middleware.SetAdminUserIDs([]string{testutil.UUIDTestData.User1})
+	t.Cleanup(func() { middleware.SetAdminUserIDs(nil) })
+
+	pauses := &fakeWorldPauses{paused: make(map[string]*adminV1.WorldPause)}
+	server := &adminServiceServer{pauses: pauses, logger: log.New(io.Discard)}
+	pause := &adminV1.PauseWorldRequest{WorldId: testutil.UUIDTestData.World1, Reason: "duplication exploit"}
+	player := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User2, "player")
+
+	_, err := server.PauseWorld(player, pause)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = server.ListWorldPauses(player, &adminV1.ListWorldPausesRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Empty(t, pauses.paused)
+
+	admin := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "admin")
+	paused, err := server.PauseWorld(admin, pause)
+	require.NoError(t, err)
+	assert.Equal(t, testutil.UUIDTestData.User1, paused.Pause.PausedBy, "the admin is taken from the caller")
+
+	listed, err := server.ListWorldPauses(admin, &adminV1.ListWorldPausesRequest{})
+	require.NoError(t, err)
+	assert.Len(t, listed.Pauses, 1)
+
+	_, err = server.ResumeWorld(player, &adminV1.ResumeWorldRequest{WorldId: testutil.UUIDTestData.World1})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	resumed, err := server.ResumeWorld(admin, &adminV1.ResumeWorldRequest{WorldId: testutil.UUIDTestData.World1})
+	require.NoError(t, err)
+	assert.True(t, resumed.Resumed)
+}
+
+// fakeStatusEffects keeps the effects applied per character
+type fakeStatusEffects struct {
+	applied map[string][]string
+}
+
+func (f *fakeStatusEffects) ApplyStatusEffect(ctx context.Context, req *adminV1.ApplyStatusEffectRequest) (*characterV1.StatusEffect, error) {
+	f.applied[req.CharacterId] = append(f.applied[req.CharacterId], req.EffectKey)
+	return &characterV1.StatusEffect{Key: req.EffectKey, Stacks: 1}, nil
+}
+
+func (f *fakeStatusEffects) RemoveStatusEffect(ctx context.Context, req *adminV1.RemoveStatusEffectRequest) (bool, error) {
+	_, ok := f.applied[req.CharacterId]
+	delete(f.applied, req.CharacterId)
+	return ok, nil
+}
+
+func TestAdminServiceServer_StatusEffects(t *testing.T) {
+	middleware.SetAdminUserIDs([]string{testutil.UUIDTestData.User1})
+	t.Cleanup(func() { middleware.SetAdminUserIDs(nil) })
+
+	effects := &fakeStatusEffects{applied: make(map[string][]string)}
+	server := &adminServiceServer{effects: effects, logger: log.New(io.Discard)}
+	apply := &adminV1.ApplyStatusEffectRequest{CharacterId: testutil.UUIDTestData.Character1, EffectKey: "swiftness"}
+
+	_, err := server.ApplyStatusEffect(middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User2, "player"), apply)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Empty(t, effects.applied)
+
+	admin := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "admin")
+	resp, err := server.ApplyStatusEffect(admin, apply)
+	require.NoError(t, err)
+	assert.Equal(t, "swiftness", resp.Effect.Key)
+
+	removed, err := server.RemoveStatusEffect(admin, &adminV1.RemoveStatusEffectRequest{CharacterId: testutil.UUIDTestData.Character1, EffectKey: "swiftness"})
+	require.NoError(t, err)
+	assert.True(t, removed.Removed)
+}
+
+// fakeEconomy returns one period of stats and keeps the last request
+type fakeEconomy struct {
+	worldID string
+	period  adminV1.EconomyPeriod
+	periods int32
+}
+
+func (f *fakeEconomy) Stats(ctx context.Context, worldID string, period adminV1.EconomyPeriod, periods int32) (*adminV1.GetEconomyStatsResponse, error) {
+	f.worldID, f.period, f.periods = worldID, period, periods
+	return &adminV1.GetEconomyStatsResponse{CurrencyItem: "Minerals", Created: 40, Destroyed: 12}, nil
+}
+
+func TestAdminServiceServer_GetEconomyStats(t *testing.T) {
+	middleware.SetAdminUserIDs([]string{testutil.UUIDTestData.User1})
+	t.Cleanup(func() { middleware.SetAdminUserIDs(nil) })
+
+	economy := &fakeEconomy{}
+	server := &adminServiceServer{economy: economy, logger: log.New(io.Discard)}
+	req := &adminV1.GetEconomyStatsRequest{WorldId: testutil.UUIDTestData.World1, Period: adminV1.EconomyPeriod_ECONOMY_PERIOD_WEEK, Periods: 4}
+
+	_, err := server.GetEconomyStats(middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User2, "player"), req)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Empty(t, economy.worldID)
+
+	resp, err := server.GetEconomyStats(middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "admin"), req)
+	require.NoError(t, err)
+	assert.Equal(t, int64(28), resp.Created-resp.Destroyed)
+	assert.Equal(t, testutil.UUIDTestData.World1, economy.worldID)
+	assert.Equal(t, adminV1.EconomyPeriod_ECONOMY_PERIOD_WEEK, economy.period)
+	assert.Equal(t, int32(4), economy.periods)
+}
+
+// fakeNameLookup finds one rename of any name and keeps the last request
+type fakeNameLookup struct {
+	worldID string
+	name    string
+}
+
+func (f *fakeNameLookup) LookupName(ctx context.Context, worldID, name string, limit int32) ([]*characterV1.Character, []*characterV1.CharacterNameChange, error) {
+	f.worldID, f.name = worldID, name
+	return nil, []*characterV1.CharacterNameChange{{OldName: name, NewName: "Renamed", UserId: testutil.UUIDTestData.User2}}, nil
+}
+
+func TestAdminServiceServer_LookupCharacterName(t *testing.T) {
+	middleware.SetAdminUserIDs([]string{testutil.UUIDTestData.User1})
+	t.Cleanup(func() { middleware.SetAdminUserIDs(nil) })
+
+	names := &fakeNameLookup{}
+	server := &adminServiceServer{names: names, logger: log.New(io.Discard)}
+	req := &adminV1.LookupCharacterNameRequest{WorldId: testutil.UUIDTestData.World1, Name: "Griefer"}
+
+	_, err := server.LookupCharacterName(middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User2, "player"), req)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Empty(t, names.name)
+
+	resp, err := server.LookupCharacterName(middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "admin"), req)
+	require.NoError(t, err)
+	require.Len(t, resp.Changes, 1)
+	assert.Equal(t, testutil.UUIDTestData.User2, resp.Changes[0].UserId)
+	assert.Equal(t, "Griefer", names.name)
+	assert.Equal(t, testutil.UUIDTestData.World1, names.worldID)
+}
+
+// fakeConsentPublisher numbers the versions it publishes and keeps the publisher
+type fakeConsentPublisher struct {
+	publishedBy string
+	versions    int32
+}
+
+func (f *fakeConsentPublisher) Publish(ctx context.Context, publishedBy string, req *adminV1.PublishConsentDocumentRequest) (*userV1.ConsentDocument, error) {
+	f.publishedBy = publishedBy
+	f.versions++
+	return &userV1.ConsentDocument{Kind: req.Kind, Version: f.versions, Url: req.Url}, nil
+}
+
+func TestAdminServiceServer_PublishConsentDocument(t *testing.T) {
+	middleware.SetAdminUserIDs([]string{testutil.UUIDTestData.User1})
+	t.Cleanup(func() { middleware.SetAdminUserIDs(nil) })
+
+	consent := &fakeConsentPublisher{}
+	server := &adminServiceServer{consent: consent, logger: log.New(io.Discard)}
+	req := &adminV1.PublishConsentDocumentRequest{
+		Kind:     userV1.ConsentKind_CONSENT_KIND_TERMS_OF_SERVICE,
+		Url:      "https://voidmesh.example/terms",
+		Required: true,
+	}
+
+	_, err := server.PublishConsentDocument(middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User2, "player"), req)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Zero(t, consent.versions)
+
+	resp, err := server.PublishConsentDocument(middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "admin"), req)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), resp.Document.Version)
+	assert.Equal(t, testutil.UUIDTestData.User1, consent.publishedBy)
+}
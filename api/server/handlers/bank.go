@@ -0,0 +1,157 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/internal/logging"
+	bankV1 "github.com/VoidMesh/api/api/proto/bank/v1"
+	"github.com/charmbracelet/log"
+)
+
+// BankService defines the interface for bank vaults
+type BankService interface {
+	ListVaults(ctx context.Context, userID, characterID string) ([]*bankV1.Vault, error)
+	OpenVault(ctx context.Context, userID, characterID string, bankID, vaultID int64) (*bankV1.Vault, error)
+	Deposit(ctx context.Context, userID string, req *bankV1.DepositRequest) (*bankV1.DepositResponse, error)
+	Withdraw(ctx context.Context, userID string, req *bankV1.WithdrawRequest) (*bankV1.WithdrawResponse, error)
+	CreateGuildVault(ctx context.Context, userID, characterID string, bankID int64, name string) (*bankV1.Vault, error)
+	ListMembers(ctx context.Context, userID, characterID string, vaultID int64) ([]*bankV1.VaultMember, error)
+	SetMember(ctx context.Context, userID string, req *bankV1.SetVaultMemberRequest) (*bankV1.SetVaultMemberResponse, error)
+	ListTransactions(ctx context.Context, userID, characterID string, vaultID, beforeID int64, limit int32) ([]*bankV1.VaultTransaction, error)
+}
+
+type bankServiceServer struct {
+	bankV1.UnimplementedBankServiceServer
+	bankService BankService
+	logger      *log.Logger
+}
+
+// NewBankServer creates the bank service handler
+func NewBankServer(bankService BankService) bankV1.BankServiceServer {
+	logger := logging.WithComponent("bank-handler")
+	logger.Debug("Creating new BankService server instance")
+	return &bankServiceServer{
+		bankService: bankService,
+		logger:      logger,
+	}
+}
+
+// ListVaults lists the vaults of the caller's character
+func (s *bankServiceServer) ListVaults(ctx context.Context, req *bankV1.ListVaultsRequest) (*bankV1.ListVaultsResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	vaults, err := s.bankService.ListVaults(ctx, userID, req.CharacterId)
+	if err != nil {
+		s.logger.Debug("Failed to list vaults", "user_id", userID, "character_id", req.CharacterId, "error", err)
+		return nil, err
+	}
+	return &bankV1.ListVaultsResponse{Vaults: vaults}, nil
+}
+
+// OpenVault returns a vault with its items at a bank
+func (s *bankServiceServer) OpenVault(ctx context.Context, req *bankV1.OpenVaultRequest) (*bankV1.OpenVaultResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	vault, err := s.bankService.OpenVault(ctx, userID, req.CharacterId, req.BankEntityId, req.VaultId)
+	if err != nil {
+		s.logger.Debug("Failed to open vault", "user_id", userID, "vault_id", req.VaultId, "error", err)
+		return nil, err
+	}
+	return &bankV1.OpenVaultResponse{Vault: vault}, nil
+}
+
+// Deposit moves items from the caller's inventory into a vault
+func (s *bankServiceServer) Deposit(ctx context.Context, req *bankV1.DepositRequest) (*bankV1.DepositResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.bankService.Deposit(ctx, userID, req)
+	if err != nil {
+		s.logger.Debug("Failed to deposit", "user_id", userID, "vault_id", req.VaultId, "item_id", req.ItemId, "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Withdraw moves items from a vault into the caller's inventory
+func (s *bankServiceServer) Withdraw(ctx context.Context, req *bankV1.WithdrawRequest) (*bankV1.WithdrawResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.bankService.Withdraw(ctx, userID, req)
+	if err != nil {
+		s.logger.Debug("Failed to withdraw", "user_id", userID, "vault_id", req.VaultId, "item_id", req.ItemId, "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// CreateGuildVault founds a guild vault managed by the caller's character
+func (s *bankServiceServer) CreateGuildVault(ctx context.Context, req *bankV1.CreateGuildVaultRequest) (*bankV1.CreateGuildVaultResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	vault, err := s.bankService.CreateGuildVault(ctx, userID, req.CharacterId, req.BankEntityId, req.Name)
+	if err != nil {
+		s.logger.Debug("Failed to create guild vault", "user_id", userID, "name", req.Name, "error", err)
+		return nil, err
+	}
+	return &bankV1.CreateGuildVaultResponse{Vault: vault}, nil
+}
+
+// ListVaultMembers lists the members of a guild vault
+func (s *bankServiceServer) ListVaultMembers(ctx context.Context, req *bankV1.ListVaultMembersRequest) (*bankV1.ListVaultMembersResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := s.bankService.ListMembers(ctx, userID, req.CharacterId, req.VaultId)
+	if err != nil {
+		s.logger.Debug("Failed to list vault members", "user_id", userID, "vault_id", req.VaultId, "error", err)
+		return nil, err
+	}
+	return &bankV1.ListVaultMembersResponse{Members: members}, nil
+}
+
+// SetVaultMember adds, changes or removes a member of a guild vault
+func (s *bankServiceServer) SetVaultMember(ctx context.Context, req *bankV1.SetVaultMemberRequest) (*bankV1.SetVaultMemberResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.bankService.SetMember(ctx, userID, req)
+	if err != nil {
+		s.logger.Debug("Failed to set vault member", "user_id", userID, "vault_id", req.VaultId, "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ListVaultTransactions pages through a vault's deposits and withdrawals
+func (s *bankServiceServer) ListVaultTransactions(ctx context.Context, req *bankV1.ListVaultTransactionsRequest) (*bankV1.ListVaultTransactionsResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, err := s.bankService.ListTransactions(ctx, userID, req.CharacterId, req.VaultId, req.BeforeId, req.Limit)
+	if err != nil {
+		s.logger.Debug("Failed to list vault transactions", "user_id", userID, "vault_id", req.VaultId, "error", err)
+		return nil, err
+	}
+	return &bankV1.ListVaultTransactionsResponse{Transactions: transactions}, nil
+}
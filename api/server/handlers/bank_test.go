@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/VoidMesh/api/api/internal/testutil"
+	bankV1 "github.com/VoidMesh/api/api/proto/bank/v1"
+	"github.com/VoidMesh/api/api/server/middleware"
+	"github.com/charmbracelet/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeBankService records the user each call was made for
+type fakeBankService struct {
+	userID string
+}
+
+func (f *fakeBankService) ListVaults(ctx context.Context, userID, characterID string) ([]*bankV1.Vault, error) {
+	f.userID = userID
+	return []*bankV1.Vault{{Id: 1, Kind: bankV1.VaultKind_VAULT_KIND_PERSONAL}}, nil
+}
+
+func (f *fakeBankService) OpenVault(ctx context.Context, userID, characterID string, bankID, vaultID int64) (*bankV1.Vault, error) {
+	f.userID = userID
+	return &bankV1.Vault{Id: vaultID, Items: []*bankV1.VaultItem{{ItemId: 3, Quantity: 5}}}, nil
+}
+
+func (f *fakeBankService) Deposit(ctx context.Context, userID string, req *bankV1.DepositRequest) (*bankV1.DepositResponse, error) {
+	f.userID = userID
+	return &bankV1.DepositResponse{Transaction: &bankV1.VaultTransaction{ItemId: req.ItemId, Quantity: req.Quantity}}, nil
+}
+
+func (f *fakeBankService) Withdraw(ctx context.Context, userID string, req *bankV1.WithdrawRequest) (*bankV1.WithdrawResponse, error) {
+	f.userID = userID
+	return &bankV1.WithdrawResponse{Transaction: &bankV1.VaultTransaction{ItemId: req.ItemId, Quantity: -req.Quantity}}, nil
+}
+
+func (f *fakeBankService) CreateGuildVault(ctx context.Context, userID, characterID string, bankID int64, name string) (*bankV1.Vault, error) {
+	f.userID = userID
+	return &bankV1.Vault{Id: 2, Kind: bankV1.VaultKind_VAULT_KIND_GUILD, Name: name}, nil
+}
+
+func (f *fakeBankService) ListMembers(ctx context.Context, userID, characterID string, vaultID int64) ([]*bankV1.VaultMember, error) {
+	f.userID = userID
+	return []*bankV1.VaultMember{{CharacterId: characterID, Tier: bankV1.VaultTier_VAULT_TIER_MANAGE}}, nil
+}
+
+func (f *fakeBankService) SetMember(ctx context.Context, userID string, req *bankV1.SetVaultMemberRequest) (*bankV1.SetVaultMemberResponse, error) {
+	f.userID = userID
+	return &bankV1.SetVaultMemberResponse{Member: &bankV1.VaultMember{CharacterId: req.MemberCharacterId, Tier: req.Tier}}, nil
+}
+
+func (f *fakeBankService) ListTransactions(ctx context.Context, userID, characterID string, vaultID, beforeID int64, limit int32) ([]*bankV1.VaultTransaction, error) {
+	f.userID = userID
+	return []*bankV1.VaultTransaction{{Id: beforeID - 1, VaultId: vaultID}}, nil
+}
+
+func TestBankServiceServer(t *testing.T) {
+	banks := &fakeBankService{}
+	server := &bankServiceServer{bankService: banks, logger: log.New(io.Discard)}
+	ctx := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "player")
+	characterID := testutil.UUIDTestData.Character1
+
+	_, err := server.OpenVault(context.Background(), &bankV1.OpenVaultRequest{CharacterId: characterID, BankEntityId: 4})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = server.Withdraw(context.Background(), &bankV1.WithdrawRequest{CharacterId: characterID, ItemId: 3, Quantity: 1})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.Empty(t, banks.userID)
+
+	listed, err := server.ListVaults(ctx, &bankV1.ListVaultsRequest{CharacterId: characterID})
+	require.NoError(t, err)
+	require.Len(t, listed.Vaults, 1)
+	assert.Equal(t, testutil.UUIDTestData.User1, banks.userID)
+
+	opened, err := server.OpenVault(ctx, &bankV1.OpenVaultRequest{CharacterId: characterID, BankEntityId: 4, VaultId: 2})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), opened.Vault.Id)
+
+	deposited, err := server.Deposit(ctx, &bankV1.DepositRequest{CharacterId: characterID, ItemId: 3, Quantity: 5})
+	require.NoError(t, err)
+	assert.Equal(t, int32(5), deposited.Transaction.Quantity)
+
+	withdrawn, err := server.Withdraw(ctx, &bankV1.WithdrawRequest{CharacterId: characterID, ItemId: 3, Quantity: 2})
+	require.NoError(t, err)
+	assert.Equal(t, int32(-2), withdrawn.Transaction.Quantity)
+
+	created, err := server.CreateGuildVault(ctx, &bankV1.CreateGuildVaultRequest{CharacterId: characterID, BankEntityId: 4, Name: "Miners"})
+	require.NoError(t, err)
+	assert.Equal(t, "Miners", created.Vault.Name)
+
+	members, err := server.ListVaultMembers(ctx, &bankV1.ListVaultMembersRequest{CharacterId: characterID, VaultId: 2})
+	require.NoError(t, err)
+	assert.Len(t, members.Members, 1)
+
+	set, err := server.SetVaultMember(ctx, &bankV1.SetVaultMemberRequest{CharacterId: characterID, VaultId: 2, MemberCharacterId: testutil.UUIDTestData.Character2, Tier: bankV1.VaultTier_VAULT_TIER_DEPOSIT})
+	require.NoError(t, err)
+	assert.Equal(t, bankV1.VaultTier_VAULT_TIER_DEPOSIT, set.Member.Tier)
+
+	transactions, err := server.ListVaultTransactions(ctx, &bankV1.ListVaultTransactionsRequest{CharacterId: characterID, VaultId: 2, BeforeId: 10})
+	require.NoError(t, err)
+	require.Len(t, transactions.Transactions, 1)
+	assert.Equal(t, int64(9), transactions.Transactions[0].Id)
+}
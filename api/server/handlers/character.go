@@ -4,11 +4,15 @@ import (
 	"context"
 	"time"
 
+	"github.com/VoidMesh/api/api/internal/bandwidth"
 	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/streamsession"
 	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
+	streamV1 "github.com/VoidMesh/api/api/proto/stream/v1"
 	"github.com/VoidMesh/api/api/server/middleware"
+	"github.com/VoidMesh/api/api/services/character"
 	"github.com/charmbracelet/log"
-	"github.com/jackc/pgx/v5/pgxpool"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -16,23 +20,71 @@ import (
 type characterServiceServer struct {
 	characterV1.UnimplementedCharacterServiceServer
 	characterService CharacterService
+	nearbyEvents     NearbyEventsService
+	checkpoints      CheckpointService
+	activity         ActivityService
+	titles           CharacterTitleService
+	names            CharacterNameService
+	nearbyStreams    *streamsession.Registry[*characterV1.NearbyEvent]
 	logger           *log.Logger
 }
 
+// NearbyEventsService defines the interface for streaming events around a character
+type NearbyEventsService interface {
+	SubscribeNearby(ctx context.Context, userID, characterID string, radius int32) (<-chan *characterV1.NearbyEvent, func(), error)
+	ResyncState(ctx context.Context, userID string, req *characterV1.ResyncStateRequest) (*characterV1.ResyncStateResponse, error)
+}
+
+// CheckpointService defines the interface for character state checkpoints
+type CheckpointService interface {
+	ListCheckpoints(ctx context.Context, characterID string, limit int32) ([]*characterV1.CharacterCheckpoint, error)
+	Restore(ctx context.Context, checkpointID int64) (*characterV1.CharacterCheckpoint, int64, error)
+}
+
+// ActivityService defines the interface for the character activity feed
+type ActivityService interface {
+	List(ctx context.Context, userID, characterID string, beforeID int64, limit int32) ([]*characterV1.ActivityEntry, error)
+}
+
+// CharacterNameService defines the interface for renaming characters and their name history
+type CharacterNameService interface {
+	RenameCharacter(ctx context.Context, userID string, req *characterV1.RenameCharacterRequest) (*characterV1.RenameCharacterResponse, error)
+	ListNameHistory(ctx context.Context, characterID string, limit int32) ([]*characterV1.CharacterNameChange, error)
+}
+
+// CharacterTitleService defines the interface for the titles and badges characters unlock
+type CharacterTitleService interface {
+	ListTitles(ctx context.Context, characterID string) ([]*characterV1.CharacterTitle, error)
+	Equip(ctx context.Context, userID, characterID, titleID string) (*characterV1.CharacterTitle, error)
+}
+
 func NewCharacterServer(
 	characterService CharacterService,
+	nearbyEvents NearbyEventsService,
+	checkpoints CheckpointService,
+	activity ActivityService,
+	titles CharacterTitleService,
+	names CharacterNameService,
 ) characterV1.CharacterServiceServer {
 	logger := logging.WithComponent("character-handler")
 	logger.Debug("Creating new CharacterService server instance")
 	return &characterServiceServer{
 		characterService: characterService,
-		logger:           logger,
+		nearbyEvents:     nearbyEvents,
+		checkpoints:      checkpoints,
+		activity:         activity,
+		titles:           titles,
+		names:            names,
+		nearbyStreams: streamsession.NewRegistry(func(e *characterV1.NearbyEvent, info *streamV1.StreamInfo) {
+			e.Stream = info
+		}),
+		logger: logger,
 	}
 }
 
 // NewCharacterServerWithPool creates a character server with all dependencies wired up
 // This function maintains backward compatibility while providing dependency injection
-func NewCharacterServerWithPool(dbPool *pgxpool.Pool) (characterV1.CharacterServiceServer, error) {
+func NewCharacterServerWithPool(dbPool storage.Pool) (characterV1.CharacterServiceServer, error) {
 	logger := logging.WithComponent("character-handler")
 	logger.Debug("Creating CharacterService server with dependency injection")
 
@@ -43,7 +95,7 @@ func NewCharacterServerWithPool(dbPool *pgxpool.Pool) (characterV1.CharacterServ
 		return nil, err
 	}
 
-	return NewCharacterServer(characterService), nil
+	return NewCharacterServer(characterService, nil, nil, nil, nil, nil), nil
 }
 
 // CreateCharacter creates a new character
@@ -112,6 +164,117 @@ func (s *characterServiceServer) GetMyCharacters(ctx context.Context, req *chara
 	return resp, nil
 }
 
+// GetMyActivity returns the activity feed of one of the authenticated user's characters
+func (s *characterServiceServer) GetMyActivity(ctx context.Context, req *characterV1.GetMyActivityRequest) (*characterV1.GetMyActivityResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if s.activity == nil {
+		return nil, status.Errorf(codes.Unimplemented, "activity feed is not enabled")
+	}
+	if req.CharacterId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "character_id is required")
+	}
+
+	logger := s.logger.With("operation", "GetMyActivity", "user_id", userID, "character_id", req.CharacterId)
+	entries, err := s.activity.List(ctx, userID, req.CharacterId, req.BeforeId, req.Limit)
+	if err != nil {
+		logger.Warn("Failed to list activity", "error", err)
+		return nil, err
+	}
+	logger.Debug("Listed activity", "count", len(entries))
+	return &characterV1.GetMyActivityResponse{Entries: entries}, nil
+}
+
+// ListCharacterTitles returns the titles a character has earned
+func (s *characterServiceServer) ListCharacterTitles(ctx context.Context, req *characterV1.ListCharacterTitlesRequest) (*characterV1.ListCharacterTitlesResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if s.titles == nil {
+		return nil, status.Errorf(codes.Unimplemented, "character titles are not enabled")
+	}
+	if req.CharacterId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "character_id is required")
+	}
+
+	logger := s.logger.With("operation", "ListCharacterTitles", "user_id", userID, "character_id", req.CharacterId)
+	titles, err := s.titles.ListTitles(ctx, req.CharacterId)
+	if err != nil {
+		logger.Warn("Failed to list titles", "error", err)
+		return nil, err
+	}
+	return &characterV1.ListCharacterTitlesResponse{Titles: titles}, nil
+}
+
+// EquipTitle shows an unlocked title with a character's name, or clears it
+func (s *characterServiceServer) EquipTitle(ctx context.Context, req *characterV1.EquipTitleRequest) (*characterV1.EquipTitleResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if s.titles == nil {
+		return nil, status.Errorf(codes.Unimplemented, "character titles are not enabled")
+	}
+	if req.CharacterId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "character_id is required")
+	}
+
+	logger := s.logger.With("operation", "EquipTitle", "user_id", userID, "character_id", req.CharacterId, "title", req.Title)
+	title, err := s.titles.Equip(ctx, userID, req.CharacterId, req.Title)
+	if err != nil {
+		logger.Debug("Failed to equip title", "error", err)
+		return nil, err
+	}
+	return &characterV1.EquipTitleResponse{Title: title}, nil
+}
+
+// RenameCharacter renames one of the caller's characters
+func (s *characterServiceServer) RenameCharacter(ctx context.Context, req *characterV1.RenameCharacterRequest) (*characterV1.RenameCharacterResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if s.names == nil {
+		return nil, status.Errorf(codes.Unimplemented, "character renaming is not enabled")
+	}
+	if req.CharacterId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "character_id is required")
+	}
+
+	logger := s.logger.With("operation", "RenameCharacter", "user_id", userID, "character_id", req.CharacterId, "name", req.Name)
+	resp, err := s.names.RenameCharacter(ctx, userID, req)
+	if err != nil {
+		logger.Debug("Failed to rename character", "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ListCharacterNameHistory returns the names a character has had
+func (s *characterServiceServer) ListCharacterNameHistory(ctx context.Context, req *characterV1.ListCharacterNameHistoryRequest) (*characterV1.ListCharacterNameHistoryResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if s.names == nil {
+		return nil, status.Errorf(codes.Unimplemented, "character renaming is not enabled")
+	}
+	if req.CharacterId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "character_id is required")
+	}
+
+	logger := s.logger.With("operation", "ListCharacterNameHistory", "user_id", userID, "character_id", req.CharacterId)
+	changes, err := s.names.ListNameHistory(ctx, req.CharacterId, req.Limit)
+	if err != nil {
+		logger.Warn("Failed to list name history", "error", err)
+		return nil, err
+	}
+	return &characterV1.ListCharacterNameHistoryResponse{Changes: changes}, nil
+}
+
 // DeleteCharacter deletes a character
 func (s *characterServiceServer) DeleteCharacter(ctx context.Context, req *characterV1.DeleteCharacterRequest) (*characterV1.DeleteCharacterResponse, error) {
 	logger := s.logger.With("operation", "DeleteCharacter", "character_id", req.CharacterId)
@@ -151,3 +314,145 @@ func (s *characterServiceServer) MoveCharacter(ctx context.Context, req *charact
 	}
 	return resp, nil
 }
+
+// StreamNearbyEvents streams events inside the character's area of interest until the client disconnects
+func (s *characterServiceServer) StreamNearbyEvents(req *characterV1.StreamNearbyEventsRequest, stream characterV1.CharacterService_StreamNearbyEventsServer) error {
+	ctx := stream.Context()
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok || userID == "" {
+		return status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if s.nearbyEvents == nil {
+		return status.Errorf(codes.Unimplemented, "nearby events are not enabled")
+	}
+
+	logger := s.logger.With("operation", "StreamNearbyEvents", "user_id", userID, "character_id", req.CharacterId, "radius", req.Radius)
+
+	nearby, unsubscribe, err := s.nearbyEvents.SubscribeNearby(ctx, userID, req.CharacterId, req.Radius)
+	if err != nil {
+		logger.Warn("Failed to subscribe to nearby events", "error", err)
+		return err
+	}
+	defer unsubscribe()
+
+	session, err := openStream(s.nearbyStreams, userID, req.Resume, stream.Send)
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	// Over the connection's bandwidth budget the shaper holds back and merges updates
+	shaper := character.NewNearbyShaper(bandwidth.FromContext(ctx))
+	flush := time.NewTicker(character.NearbyFlushInterval)
+	defer flush.Stop()
+	heartbeat := time.NewTicker(streamsession.HeartbeatInterval)
+	defer heartbeat.Stop()
+
+	logger.Debug("Nearby event stream opened", "stream_id", session.ID(), "resumed", req.Resume.GetStreamId() == session.ID())
+	for {
+		var events []*characterV1.NearbyEvent
+		select {
+		case <-ctx.Done():
+			logger.Debug("Nearby event stream closed", "held_events", shaper.Held())
+			return nil
+		case event, ok := <-nearby:
+			if !ok {
+				return nil
+			}
+			events = shaper.Offer(event)
+		case <-flush.C:
+			events = shaper.Flush()
+		case <-heartbeat.C:
+			if err := stream.Send(session.Heartbeat()); err != nil {
+				return err
+			}
+		}
+		for _, event := range events {
+			if err := stream.Send(session.Stamp(event)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// ResyncState returns what a reconnecting client missed around its character
+func (s *characterServiceServer) ResyncState(ctx context.Context, req *characterV1.ResyncStateRequest) (*characterV1.ResyncStateResponse, error) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok || userID == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if s.nearbyEvents == nil {
+		return nil, status.Errorf(codes.Unimplemented, "nearby events are not enabled")
+	}
+	if req.CharacterId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "character_id is required")
+	}
+
+	logger := s.logger.With("operation", "ResyncState", "user_id", userID, "character_id", req.CharacterId, "last_sequence", req.LastSequence)
+	resp, err := s.nearbyEvents.ResyncState(ctx, userID, req)
+	if err != nil {
+		logger.Warn("Failed to resync state", "error", err)
+		return nil, err
+	}
+	if resp.GetSnapshot() != nil {
+		logger.Info("Client resynced from a snapshot", "sequence", resp.Sequence)
+	}
+	return resp, nil
+}
+
+// ListCharacterCheckpoints returns a character's state checkpoints (admin only)
+func (s *characterServiceServer) ListCharacterCheckpoints(ctx context.Context, req *characterV1.ListCharacterCheckpointsRequest) (*characterV1.ListCharacterCheckpointsResponse, error) {
+	logger := s.logger.With("operation", "ListCharacterCheckpoints", "character_id", req.CharacterId)
+
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		logger.Warn("Non-admin attempted to list character checkpoints", "user_id", userID)
+		return nil, err
+	}
+	if s.checkpoints == nil {
+		return nil, status.Errorf(codes.Unimplemented, "character checkpoints are not enabled")
+	}
+	if req.CharacterId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "character_id is required")
+	}
+
+	checkpoints, err := s.checkpoints.ListCheckpoints(ctx, req.CharacterId, req.Limit)
+	if err != nil {
+		logger.Error("Failed to list character checkpoints", "error", err)
+		return nil, err
+	}
+	return &characterV1.ListCharacterCheckpointsResponse{Checkpoints: checkpoints}, nil
+}
+
+// RestoreCharacterCheckpoint resets a character to a checkpoint (admin only)
+func (s *characterServiceServer) RestoreCharacterCheckpoint(ctx context.Context, req *characterV1.RestoreCharacterCheckpointRequest) (*characterV1.RestoreCharacterCheckpointResponse, error) {
+	logger := s.logger.With("operation", "RestoreCharacterCheckpoint", "checkpoint_id", req.CheckpointId)
+
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		logger.Warn("Non-admin attempted to restore a character checkpoint", "user_id", userID)
+		return nil, err
+	}
+	if s.checkpoints == nil {
+		return nil, status.Errorf(codes.Unimplemented, "character checkpoints are not enabled")
+	}
+	if req.CheckpointId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "checkpoint_id must be positive")
+	}
+
+	restored, preRestoreID, err := s.checkpoints.Restore(ctx, req.CheckpointId)
+	if err != nil {
+		logger.Error("Failed to restore character checkpoint", "error", err)
+		return nil, err
+	}
+
+	adminID, _ := middleware.GetUserIDFromContext(ctx)
+	logger.Info("Character restored from checkpoint",
+		"admin_user_id", adminID,
+		"character_id", restored.CharacterId,
+		"pre_restore_checkpoint_id", preRestoreID)
+	return &characterV1.RestoreCharacterCheckpointResponse{
+		Restored:               restored,
+		PreRestoreCheckpointId: preRestoreID,
+	}, nil
+}
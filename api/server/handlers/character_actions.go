@@ -2,10 +2,14 @@ package handlers
 
 import (
 	"context"
+	"time"
 
 	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/streamsession"
 	characterActionsV1 "github.com/VoidMesh/api/api/proto/character_actions/v1"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
 	inventoryV1 "github.com/VoidMesh/api/api/proto/inventory/v1"
+	streamV1 "github.com/VoidMesh/api/api/proto/stream/v1"
 	"github.com/VoidMesh/api/api/server/middleware"
 	"github.com/VoidMesh/api/api/services/character_actions"
 	"github.com/charmbracelet/log"
@@ -16,12 +20,21 @@ import (
 type characterActionsServiceServer struct {
 	characterActionsV1.UnimplementedCharacterActionsServiceServer
 	characterActionsService CharacterActionsService
+	actionQueue             ActionQueueService
+	resultStreams           *streamsession.Registry[*characterActionsV1.ActionResult]
 	logger                  *log.Logger
 }
 
 // CharacterActionsService defines the interface for character actions service
 type CharacterActionsService interface {
-	HarvestResource(ctx context.Context, userID, characterID string, resourceNodeID int32) ([]*characterActionsV1.HarvestResult, *inventoryV1.InventoryItem, error)
+	HarvestResourceAt(ctx context.Context, userID, characterID string, resourceNodeID int32, clientTime time.Time) ([]*characterActionsV1.HarvestResult, *inventoryV1.InventoryItem, error)
+	ModifyTerrain(ctx context.Context, userID, characterID string, x, y int32, terrainType chunkV1.TerrainType) (chunkV1.TerrainType, error)
+}
+
+// ActionQueueService defines the interface for the tick-processed action queue
+type ActionQueueService interface {
+	Enqueue(ctx context.Context, userID string, req *characterActionsV1.EnqueueActionRequest) (*characterActionsV1.EnqueueActionResponse, error)
+	Subscribe(ctx context.Context, userID, characterID string) (<-chan *characterActionsV1.ActionResult, func(), error)
 }
 
 // CharacterActionsServiceAdapter adapts the character actions service to the handler interface
@@ -33,8 +46,8 @@ func NewCharacterActionsServiceAdapter(service *character_actions.Service) *Char
 	return &CharacterActionsServiceAdapter{service: service}
 }
 
-func (a *CharacterActionsServiceAdapter) HarvestResource(ctx context.Context, userID, characterID string, resourceNodeID int32) ([]*characterActionsV1.HarvestResult, *inventoryV1.InventoryItem, error) {
-	results, updatedItem, err := a.service.HarvestResource(ctx, userID, characterID, resourceNodeID)
+func (a *CharacterActionsServiceAdapter) HarvestResourceAt(ctx context.Context, userID, characterID string, resourceNodeID int32, clientTime time.Time) ([]*characterActionsV1.HarvestResult, *inventoryV1.InventoryItem, error) {
+	results, updatedItem, err := a.service.HarvestResourceAt(ctx, userID, characterID, resourceNodeID, clientTime)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -42,14 +55,23 @@ func (a *CharacterActionsServiceAdapter) HarvestResource(ctx context.Context, us
 	return results, updatedItem, nil
 }
 
+func (a *CharacterActionsServiceAdapter) ModifyTerrain(ctx context.Context, userID, characterID string, x, y int32, terrainType chunkV1.TerrainType) (chunkV1.TerrainType, error) {
+	return a.service.ModifyTerrain(ctx, userID, characterID, x, y, terrainType)
+}
+
 func NewCharacterActionsServer(
 	characterActionsService CharacterActionsService,
+	actionQueue ActionQueueService,
 ) characterActionsV1.CharacterActionsServiceServer {
 	logger := logging.WithComponent("character-actions-handler")
 	logger.Debug("Creating new CharacterActionsService server instance")
 	return &characterActionsServiceServer{
 		characterActionsService: characterActionsService,
-		logger:                  logger,
+		actionQueue:             actionQueue,
+		resultStreams: streamsession.NewRegistry(func(r *characterActionsV1.ActionResult, info *streamV1.StreamInfo) {
+			r.Stream = info
+		}),
+		logger: logger,
 	}
 }
 
@@ -83,8 +105,13 @@ func (s *characterActionsServiceServer) HarvestResource(ctx context.Context, req
 		return nil, status.Errorf(codes.InvalidArgument, "resource_node_id must be positive")
 	}
 
+	var clientTime time.Time
+	if req.ClientTime != nil {
+		clientTime = req.ClientTime.AsTime()
+	}
+
 	// Call the character actions service
-	harvestResults, updatedItem, err := s.characterActionsService.HarvestResource(ctx, userID, req.CharacterId, req.ResourceNodeId)
+	harvestResults, updatedItem, err := s.characterActionsService.HarvestResourceAt(ctx, userID, req.CharacterId, req.ResourceNodeId, clientTime)
 	if err != nil {
 		s.logger.Error("Failed to harvest resource",
 			"user_id", userID,
@@ -105,4 +132,120 @@ func (s *characterActionsServiceServer) HarvestResource(ctx context.Context, req
 		Results:     harvestResults,
 		UpdatedItem: updatedItem,
 	}, nil
-}
\ No newline at end of file
+}
+
+// ModifyTerrain handles dig, fill and till requests for a cell next to the character
+func (s *characterActionsServiceServer) ModifyTerrain(ctx context.Context, req *characterActionsV1.ModifyTerrainRequest) (*characterActionsV1.ModifyTerrainResponse, error) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		s.logger.Warn("Failed to get user ID from context")
+		return nil, status.Errorf(codes.Unauthenticated, "authentication required")
+	}
+
+	if req.CharacterId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "character_id is required")
+	}
+	if req.TerrainType == chunkV1.TerrainType_TERRAIN_TYPE_UNSPECIFIED {
+		return nil, status.Errorf(codes.InvalidArgument, "terrain_type is required")
+	}
+
+	previous, err := s.characterActionsService.ModifyTerrain(ctx, userID, req.CharacterId, req.X, req.Y, req.TerrainType)
+	if err != nil {
+		s.logger.Warn("Failed to modify terrain",
+			"user_id", userID,
+			"character_id", req.CharacterId,
+			"x", req.X,
+			"y", req.Y,
+			"error", err)
+		return nil, err
+	}
+
+	s.logger.Debug("Modified terrain",
+		"user_id", userID,
+		"character_id", req.CharacterId,
+		"x", req.X,
+		"y", req.Y,
+		"terrain_type", req.TerrainType.String())
+
+	return &characterActionsV1.ModifyTerrainResponse{
+		PreviousTerrainType: previous,
+		TerrainType:         req.TerrainType,
+	}, nil
+}
+
+// EnqueueAction queues a move, harvest or attack to run on an upcoming server tick
+func (s *characterActionsServiceServer) EnqueueAction(ctx context.Context, req *characterActionsV1.EnqueueActionRequest) (*characterActionsV1.EnqueueActionResponse, error) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		s.logger.Warn("Failed to get user ID from context")
+		return nil, status.Errorf(codes.Unauthenticated, "authentication required")
+	}
+
+	if req.CharacterId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "character_id is required")
+	}
+
+	resp, err := s.actionQueue.Enqueue(ctx, userID, req)
+	if err != nil {
+		s.logger.Warn("Failed to enqueue action",
+			"user_id", userID,
+			"character_id", req.CharacterId,
+			"error", err)
+		return nil, err
+	}
+
+	s.logger.Debug("Queued action",
+		"user_id", userID,
+		"character_id", req.CharacterId,
+		"action_id", resp.ActionId,
+		"queue_position", resp.QueuePosition)
+	return resp, nil
+}
+
+// StreamActionResults streams results of a character's queued actions until the client disconnects
+func (s *characterActionsServiceServer) StreamActionResults(req *characterActionsV1.StreamActionResultsRequest, stream characterActionsV1.CharacterActionsService_StreamActionResultsServer) error {
+	ctx := stream.Context()
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		s.logger.Warn("Failed to get user ID from context")
+		return status.Errorf(codes.Unauthenticated, "authentication required")
+	}
+
+	if req.CharacterId == "" {
+		return status.Errorf(codes.InvalidArgument, "character_id is required")
+	}
+
+	results, unsubscribe, err := s.actionQueue.Subscribe(ctx, userID, req.CharacterId)
+	if err != nil {
+		return err
+	}
+	defer unsubscribe()
+
+	session, err := openStream(s.resultStreams, userID, req.Resume, stream.Send)
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+	heartbeat := time.NewTicker(streamsession.HeartbeatInterval)
+	defer heartbeat.Stop()
+
+	s.logger.Debug("Action result stream opened", "user_id", userID, "character_id", req.CharacterId, "stream_id", session.ID())
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Debug("Action result stream closed", "user_id", userID, "character_id", req.CharacterId)
+			return nil
+		case <-heartbeat.C:
+			if err := stream.Send(session.Heartbeat()); err != nil {
+				return err
+			}
+		case result, ok := <-results:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(session.Stamp(result)); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -3,8 +3,10 @@ package handlers
 import (
 	"context"
 	"testing"
+	"time"
 
 	characterActionsV1 "github.com/VoidMesh/api/api/proto/character_actions/v1"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
 	inventoryV1 "github.com/VoidMesh/api/api/proto/inventory/v1"
 	"github.com/VoidMesh/api/api/server/middleware"
 	"github.com/stretchr/testify/assert"
@@ -20,24 +22,52 @@ type MockCharacterActionsService struct {
 	mock.Mock
 }
 
-func (m *MockCharacterActionsService) HarvestResource(ctx context.Context, userID string, characterID string, resourceNodeID int32) ([]*characterActionsV1.HarvestResult, *inventoryV1.InventoryItem, error) {
-	args := m.Called(ctx, userID, characterID, resourceNodeID)
+func (m *MockCharacterActionsService) HarvestResourceAt(ctx context.Context, userID string, characterID string, resourceNodeID int32, clientTime time.Time) ([]*characterActionsV1.HarvestResult, *inventoryV1.InventoryItem, error) {
+	args := m.Called(ctx, userID, characterID, resourceNodeID, clientTime)
 	if args.Get(0) == nil {
 		return nil, args.Get(1).(*inventoryV1.InventoryItem), args.Error(2)
 	}
 	return args.Get(0).([]*characterActionsV1.HarvestResult), args.Get(1).(*inventoryV1.InventoryItem), args.Error(2)
 }
 
+func (m *MockCharacterActionsService) ModifyTerrain(ctx context.Context, userID, characterID string, x, y int32, terrainType chunkV1.TerrainType) (chunkV1.TerrainType, error) {
+	args := m.Called(ctx, userID, characterID, x, y, terrainType)
+	return args.Get(0).(chunkV1.TerrainType), args.Error(1)
+}
+
+// MockActionQueueService is a mock implementation of ActionQueueService
+type MockActionQueueService struct {
+	mock.Mock
+}
+
+func (m *MockActionQueueService) Enqueue(ctx context.Context, userID string, req *characterActionsV1.EnqueueActionRequest) (*characterActionsV1.EnqueueActionResponse, error) {
+	args := m.Called(ctx, userID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*characterActionsV1.EnqueueActionResponse), args.Error(1)
+}
+
+func (m *MockActionQueueService) Subscribe(ctx context.Context, userID, characterID string) (<-chan *characterActionsV1.ActionResult, func(), error) {
+	args := m.Called(ctx, userID, characterID)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(<-chan *characterActionsV1.ActionResult), args.Get(1).(func()), args.Error(2)
+}
+
 func TestCharacterActionsServer_HarvestResource_Success(t *testing.T) {
 	mockService := &MockCharacterActionsService{}
-	server := NewCharacterActionsServer(mockService)
+	server := NewCharacterActionsServer(mockService, nil)
 
 	// Create context with user ID
 	ctx := middleware.WithUserID(context.Background(), "user123")
 
+	completedAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
 	req := &characterActionsV1.HarvestResourceRequest{
 		CharacterId:    "0123456789abcdef0123456789abcdef",
 		ResourceNodeId: 1,
+		ClientTime:     timestamppb.New(completedAt),
 	}
 
 	// Expected results
@@ -68,7 +98,7 @@ func TestCharacterActionsServer_HarvestResource_Success(t *testing.T) {
 	}
 
 	// Setup expectations
-	mockService.On("HarvestResource", ctx, "user123", req.CharacterId, req.ResourceNodeId).Return(harvestResults, updatedItem, nil)
+	mockService.On("HarvestResourceAt", ctx, "user123", req.CharacterId, req.ResourceNodeId, completedAt).Return(harvestResults, updatedItem, nil)
 
 	// Execute
 	resp, err := server.HarvestResource(ctx, req)
@@ -92,7 +122,7 @@ func TestCharacterActionsServer_HarvestResource_Success(t *testing.T) {
 
 func TestCharacterActionsServer_HarvestResource_Unauthenticated(t *testing.T) {
 	mockService := &MockCharacterActionsService{}
-	server := NewCharacterActionsServer(mockService)
+	server := NewCharacterActionsServer(mockService, nil)
 
 	// Create context without user ID
 	ctx := context.Background()
@@ -114,12 +144,12 @@ func TestCharacterActionsServer_HarvestResource_Unauthenticated(t *testing.T) {
 	assert.Contains(t, st.Message(), "authentication required")
 
 	// Service should not be called
-	mockService.AssertNotCalled(t, "HarvestResource")
+	mockService.AssertNotCalled(t, "HarvestResourceAt")
 }
 
 func TestCharacterActionsServer_HarvestResource_InvalidCharacterID(t *testing.T) {
 	mockService := &MockCharacterActionsService{}
-	server := NewCharacterActionsServer(mockService)
+	server := NewCharacterActionsServer(mockService, nil)
 
 	// Create context with user ID
 	ctx := middleware.WithUserID(context.Background(), "user123")
@@ -141,12 +171,12 @@ func TestCharacterActionsServer_HarvestResource_InvalidCharacterID(t *testing.T)
 	assert.Contains(t, st.Message(), "character_id is required")
 
 	// Service should not be called
-	mockService.AssertNotCalled(t, "HarvestResource")
+	mockService.AssertNotCalled(t, "HarvestResourceAt")
 }
 
 func TestCharacterActionsServer_HarvestResource_InvalidResourceNodeID(t *testing.T) {
 	mockService := &MockCharacterActionsService{}
-	server := NewCharacterActionsServer(mockService)
+	server := NewCharacterActionsServer(mockService, nil)
 
 	// Create context with user ID
 	ctx := middleware.WithUserID(context.Background(), "user123")
@@ -168,12 +198,12 @@ func TestCharacterActionsServer_HarvestResource_InvalidResourceNodeID(t *testing
 	assert.Contains(t, st.Message(), "resource_node_id must be positive")
 
 	// Service should not be called
-	mockService.AssertNotCalled(t, "HarvestResource")
+	mockService.AssertNotCalled(t, "HarvestResourceAt")
 }
 
 func TestCharacterActionsServer_HarvestResource_ServiceError(t *testing.T) {
 	mockService := &MockCharacterActionsService{}
-	server := NewCharacterActionsServer(mockService)
+	server := NewCharacterActionsServer(mockService, nil)
 
 	// Create context with user ID
 	ctx := middleware.WithUserID(context.Background(), "user123")
@@ -185,7 +215,7 @@ func TestCharacterActionsServer_HarvestResource_ServiceError(t *testing.T) {
 
 	// Setup service to return error
 	serviceErr := status.Errorf(codes.NotFound, "character not found")
-	mockService.On("HarvestResource", ctx, "user123", req.CharacterId, req.ResourceNodeId).Return(nil, (*inventoryV1.InventoryItem)(nil), serviceErr)
+	mockService.On("HarvestResourceAt", ctx, "user123", req.CharacterId, req.ResourceNodeId, time.Time{}).Return(nil, (*inventoryV1.InventoryItem)(nil), serviceErr)
 
 	// Execute
 	resp, err := server.HarvestResource(ctx, req)
@@ -201,6 +231,85 @@ func TestCharacterActionsServer_HarvestResource_ServiceError(t *testing.T) {
 	mockService.AssertExpectations(t)
 }
 
+func TestCharacterActionsServer_ModifyTerrain(t *testing.T) {
+	mockService := &MockCharacterActionsService{}
+	server := NewCharacterActionsServer(mockService, nil)
+	ctx := middleware.WithUserID(context.Background(), "user123")
+
+	req := &characterActionsV1.ModifyTerrainRequest{
+		CharacterId: "0123456789abcdef0123456789abcdef",
+		X:           10,
+		Y:           -3,
+		TerrainType: chunkV1.TerrainType_TERRAIN_TYPE_DIRT,
+	}
+	mockService.On("ModifyTerrain", ctx, "user123", req.CharacterId, int32(10), int32(-3), chunkV1.TerrainType_TERRAIN_TYPE_DIRT).
+		Return(chunkV1.TerrainType_TERRAIN_TYPE_GRASS, nil)
+
+	resp, err := server.ModifyTerrain(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, chunkV1.TerrainType_TERRAIN_TYPE_GRASS, resp.PreviousTerrainType)
+	assert.Equal(t, chunkV1.TerrainType_TERRAIN_TYPE_DIRT, resp.TerrainType)
+	mockService.AssertExpectations(t)
+}
+
+func TestCharacterActionsServer_ModifyTerrain_Errors(t *testing.T) {
+	mockService := &MockCharacterActionsService{}
+	server := NewCharacterActionsServer(mockService, nil)
+	ctx := middleware.WithUserID(context.Background(), "user123")
+
+	_, err := server.ModifyTerrain(context.Background(), &characterActionsV1.ModifyTerrainRequest{CharacterId: "c", TerrainType: chunkV1.TerrainType_TERRAIN_TYPE_DIRT})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = server.ModifyTerrain(ctx, &characterActionsV1.ModifyTerrainRequest{TerrainType: chunkV1.TerrainType_TERRAIN_TYPE_DIRT})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = server.ModifyTerrain(ctx, &characterActionsV1.ModifyTerrainRequest{CharacterId: "c"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	mockService.On("ModifyTerrain", ctx, "user123", "c", int32(0), int32(0), chunkV1.TerrainType_TERRAIN_TYPE_WATER).
+		Return(chunkV1.TerrainType_TERRAIN_TYPE_UNSPECIFIED, status.Error(codes.FailedPrecondition, "cannot change"))
+	_, err = server.ModifyTerrain(ctx, &characterActionsV1.ModifyTerrainRequest{CharacterId: "c", TerrainType: chunkV1.TerrainType_TERRAIN_TYPE_WATER})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestCharacterActionsServer_EnqueueAction(t *testing.T) {
+	mockQueue := &MockActionQueueService{}
+	server := NewCharacterActionsServer(&MockCharacterActionsService{}, mockQueue)
+
+	ctx := middleware.WithUserID(context.Background(), "user123")
+	req := &characterActionsV1.EnqueueActionRequest{
+		CharacterId: "0123456789abcdef0123456789abcdef",
+		Action: &characterActionsV1.EnqueueActionRequest_Move{
+			Move: &characterActionsV1.MoveAction{NewX: 1, NewY: 2},
+		},
+	}
+	expected := &characterActionsV1.EnqueueActionResponse{ActionId: 7, QueuePosition: 1}
+	mockQueue.On("Enqueue", ctx, "user123", req).Return(expected, nil)
+
+	resp, err := server.EnqueueAction(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, expected, resp)
+	mockQueue.AssertExpectations(t)
+}
+
+func TestCharacterActionsServer_EnqueueAction_Errors(t *testing.T) {
+	mockQueue := &MockActionQueueService{}
+	server := NewCharacterActionsServer(&MockCharacterActionsService{}, mockQueue)
+
+	_, err := server.EnqueueAction(context.Background(), &characterActionsV1.EnqueueActionRequest{CharacterId: "0123456789abcdef0123456789abcdef"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	ctx := middleware.WithUserID(context.Background(), "user123")
+	_, err = server.EnqueueAction(ctx, &characterActionsV1.EnqueueActionRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	req := &characterActionsV1.EnqueueActionRequest{CharacterId: "0123456789abcdef0123456789abcdef"}
+	mockQueue.On("Enqueue", ctx, "user123", req).Return(nil, status.Error(codes.ResourceExhausted, "action queue is full"))
+	_, err = server.EnqueueAction(ctx, req)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	mockQueue.AssertExpectations(t)
+}
+
 func TestCharacterActionsServiceAdapter_HarvestResource(t *testing.T) {
 	// This test verifies the adapter correctly converts between inventory types
 
@@ -3,12 +3,11 @@ package handlers
 import (
 	"context"
 
+	"github.com/VoidMesh/api/api/internal/storage"
 	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
 	"github.com/VoidMesh/api/api/services/character"
 	"github.com/VoidMesh/api/api/services/chunk"
-	"github.com/VoidMesh/api/api/services/noise"
 	"github.com/VoidMesh/api/api/services/world"
-	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 // characterServiceWrapper implements the CharacterService interface using the real character service
@@ -25,20 +24,13 @@ func NewCharacterService(service *character.Service) CharacterService {
 
 // NewCharacterServiceWithPool creates a character service with all dependencies wired up
 // This function creates the necessary services and dependencies
-func NewCharacterServiceWithPool(dbPool *pgxpool.Pool) (CharacterService, error) {
+func NewCharacterServiceWithPool(dbPool storage.Pool) (CharacterService, error) {
 	// Create world service
 	worldLogger := world.NewDefaultLoggerWrapper()
 	worldService := world.NewServiceWithPool(dbPool, worldLogger)
 
-	// Get default world or create if it doesn't exist
-	defaultWorld, err := worldService.GetDefaultWorld(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Create chunk service with noise generator
-	noiseGen := noise.NewGenerator(defaultWorld.Seed)
-	chunkService := chunk.NewServiceWithPool(dbPool, worldService, noiseGen.(*noise.Generator))
+	// Create chunk service; each world generates from its own seed
+	chunkService := chunk.NewServiceWithPool(dbPool, worldService, world.NewSeeds(worldService))
 
 	// Create character service
 	characterService := character.NewServiceWithPool(dbPool, chunkService)
@@ -69,4 +61,4 @@ func (w *characterServiceWrapper) DeleteCharacter(ctx context.Context, req *char
 // MoveCharacter moves a character
 func (w *characterServiceWrapper) MoveCharacter(ctx context.Context, req *characterV1.MoveCharacterRequest) (*characterV1.MoveCharacterResponse, error) {
 	return w.service.MoveCharacter(ctx, req)
-}
\ No newline at end of file
+}
@@ -1300,4 +1300,179 @@ func TestCharacterServiceServer_EdgeCases(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, tt.testFunc)
 	}
-}
\ No newline at end of file
+}
+// fakeCheckpointService records restores and returns a fixed checkpoint
+type fakeCheckpointService struct {
+	restored []int64
+}
+
+func (f *fakeCheckpointService) ListCheckpoints(ctx context.Context, characterID string, limit int32) ([]*characterV1.CharacterCheckpoint, error) {
+	return []*characterV1.CharacterCheckpoint{{Id: 1, CharacterId: characterID}}, nil
+}
+
+func (f *fakeCheckpointService) Restore(ctx context.Context, checkpointID int64) (*characterV1.CharacterCheckpoint, int64, error) {
+	f.restored = append(f.restored, checkpointID)
+	return &characterV1.CharacterCheckpoint{Id: checkpointID}, 42, nil
+}
+
+func TestCharacterServiceServer_Checkpoints_RequireAdmin(t *testing.T) {
+	middleware.SetAdminUserIDs([]string{testutil.UUIDTestData.User1})
+	t.Cleanup(func() { middleware.SetAdminUserIDs(nil) })
+
+	checkpoints := &fakeCheckpointService{}
+	server := &characterServiceServer{checkpoints: checkpoints, logger: log.New(io.Discard)}
+	admin := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "admin")
+	player := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User2, "player")
+
+	_, err := server.ListCharacterCheckpoints(player, &characterV1.ListCharacterCheckpointsRequest{CharacterId: testutil.UUIDTestData.Character1})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = server.RestoreCharacterCheckpoint(player, &characterV1.RestoreCharacterCheckpointRequest{CheckpointId: 1})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Empty(t, checkpoints.restored)
+
+	listed, err := server.ListCharacterCheckpoints(admin, &characterV1.ListCharacterCheckpointsRequest{CharacterId: testutil.UUIDTestData.Character1})
+	require.NoError(t, err)
+	assert.Len(t, listed.Checkpoints, 1)
+
+	_, err = server.RestoreCharacterCheckpoint(admin, &characterV1.RestoreCharacterCheckpointRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	restored, err := server.RestoreCharacterCheckpoint(admin, &characterV1.RestoreCharacterCheckpointRequest{CheckpointId: 7})
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), restored.Restored.Id)
+	assert.Equal(t, int64(42), restored.PreRestoreCheckpointId)
+}
+
+// fakeActivityService records the requests it serves
+type fakeActivityService struct {
+	userID   string
+	beforeID int64
+	limit    int32
+}
+
+func (f *fakeActivityService) List(ctx context.Context, userID, characterID string, beforeID int64, limit int32) ([]*characterV1.ActivityEntry, error) {
+	f.userID, f.beforeID, f.limit = userID, beforeID, limit
+	return []*characterV1.ActivityEntry{{Id: 3, CharacterId: characterID, Type: characterV1.ActivityType_ACTIVITY_TYPE_CRAFT}}, nil
+}
+
+func TestCharacterServiceServer_GetMyActivity(t *testing.T) {
+	activity := &fakeActivityService{}
+	server := &characterServiceServer{activity: activity, logger: log.New(io.Discard)}
+	ctx := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "player")
+
+	_, err := server.GetMyActivity(context.Background(), &characterV1.GetMyActivityRequest{CharacterId: testutil.UUIDTestData.Character1})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = server.GetMyActivity(ctx, &characterV1.GetMyActivityRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	resp, err := server.GetMyActivity(ctx, &characterV1.GetMyActivityRequest{CharacterId: testutil.UUIDTestData.Character1, BeforeId: 10, Limit: 5})
+	require.NoError(t, err)
+	require.Len(t, resp.Entries, 1)
+	assert.Equal(t, characterV1.ActivityType_ACTIVITY_TYPE_CRAFT, resp.Entries[0].Type)
+	assert.Equal(t, testutil.UUIDTestData.User1, activity.userID)
+	assert.Equal(t, int64(10), activity.beforeID)
+	assert.Equal(t, int32(5), activity.limit)
+
+	disabled := &characterServiceServer{logger: log.New(io.Discard)}
+	_, err = disabled.GetMyActivity(ctx, &characterV1.GetMyActivityRequest{CharacterId: testutil.UUIDTestData.Character1})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+// fakeTitleService returns one title for any character
+type fakeTitleService struct{}
+
+func (fakeTitleService) ListTitles(ctx context.Context, characterID string) ([]*characterV1.CharacterTitle, error) {
+	return []*characterV1.CharacterTitle{{Title: "pathfinder", Name: "Pathfinder"}}, nil
+}
+
+func (fakeTitleService) Equip(ctx context.Context, userID, characterID, titleID string) (*characterV1.CharacterTitle, error) {
+	if titleID == "" {
+		return nil, nil
+	}
+	if titleID != "pathfinder" {
+		return nil, status.Errorf(codes.FailedPrecondition, "title is not unlocked")
+	}
+	return &characterV1.CharacterTitle{Title: "pathfinder", Name: "Pathfinder", Equipped: true}, nil
+}
+
+func TestCharacterServiceServer_ListCharacterTitles(t *testing.T) {
+	server := &characterServiceServer{titles: fakeTitleService{}, logger: log.New(io.Discard)}
+	ctx := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "player")
+
+	_, err := server.ListCharacterTitles(context.Background(), &characterV1.ListCharacterTitlesRequest{CharacterId: testutil.UUIDTestData.Character1})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = server.ListCharacterTitles(ctx, &characterV1.ListCharacterTitlesRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	resp, err := server.ListCharacterTitles(ctx, &characterV1.ListCharacterTitlesRequest{CharacterId: testutil.UUIDTestData.Character1})
+	require.NoError(t, err)
+	require.Len(t, resp.Titles, 1)
+	assert.Equal(t, "Pathfinder", resp.Titles[0].Name)
+
+	disabled := &characterServiceServer{logger: log.New(io.Discard)}
+	_, err = disabled.ListCharacterTitles(ctx, &characterV1.ListCharacterTitlesRequest{CharacterId: testutil.UUIDTestData.Character1})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestCharacterServiceServer_EquipTitle(t *testing.T) {
+	server := &characterServiceServer{titles: fakeTitleService{}, logger: log.New(io.Discard)}
+	ctx := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "player")
+
+	_, err := server.EquipTitle(context.Background(), &characterV1.EquipTitleRequest{CharacterId: testutil.UUIDTestData.Character1, Title: "pathfinder"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = server.EquipTitle(ctx, &characterV1.EquipTitleRequest{Title: "pathfinder"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = server.EquipTitle(ctx, &characterV1.EquipTitleRequest{CharacterId: testutil.UUIDTestData.Character1, Title: "artisan"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	resp, err := server.EquipTitle(ctx, &characterV1.EquipTitleRequest{CharacterId: testutil.UUIDTestData.Character1, Title: "pathfinder"})
+	require.NoError(t, err)
+	assert.True(t, resp.Title.Equipped)
+
+	resp, err = server.EquipTitle(ctx, &characterV1.EquipTitleRequest{CharacterId: testutil.UUIDTestData.Character1})
+	require.NoError(t, err)
+	assert.Nil(t, resp.Title, "clearing the title returns none")
+}
+
+// fakeNameService renames any character and records the user it was asked by
+type fakeNameService struct {
+	userID string
+}
+
+func (f *fakeNameService) RenameCharacter(ctx context.Context, userID string, req *characterV1.RenameCharacterRequest) (*characterV1.RenameCharacterResponse, error) {
+	f.userID = userID
+	return &characterV1.RenameCharacterResponse{
+		Character: &characterV1.Character{Id: req.CharacterId, Name: req.Name},
+		Change:    &characterV1.CharacterNameChange{CharacterId: req.CharacterId, OldName: "Old", NewName: req.Name},
+	}, nil
+}
+
+func (f *fakeNameService) ListNameHistory(ctx context.Context, characterID string, limit int32) ([]*characterV1.CharacterNameChange, error) {
+	return []*characterV1.CharacterNameChange{{CharacterId: characterID, OldName: "Old", NewName: "New"}}, nil
+}
+
+func TestCharacterServiceServer_RenameCharacter(t *testing.T) {
+	names := &fakeNameService{}
+	server := &characterServiceServer{names: names, logger: log.New(io.Discard)}
+	ctx := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "player")
+
+	_, err := server.RenameCharacter(context.Background(), &characterV1.RenameCharacterRequest{CharacterId: testutil.UUIDTestData.Character1, Name: "New"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = server.RenameCharacter(ctx, &characterV1.RenameCharacterRequest{Name: "New"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Empty(t, names.userID)
+
+	resp, err := server.RenameCharacter(ctx, &characterV1.RenameCharacterRequest{CharacterId: testutil.UUIDTestData.Character1, Name: "New"})
+	require.NoError(t, err)
+	assert.Equal(t, "New", resp.Character.Name)
+	assert.Equal(t, testutil.UUIDTestData.User1, names.userID)
+
+	history, err := server.ListCharacterNameHistory(ctx, &characterV1.ListCharacterNameHistoryRequest{CharacterId: testutil.UUIDTestData.Character1})
+	require.NoError(t, err)
+	require.Len(t, history.Changes, 1)
+	assert.Equal(t, "Old", history.Changes[0].OldName)
+
+	disabled := &characterServiceServer{logger: log.New(io.Discard)}
+	_, err = disabled.RenameCharacter(ctx, &characterV1.RenameCharacterRequest{CharacterId: testutil.UUIDTestData.Character1, Name: "New"})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
@@ -3,7 +3,10 @@ package handlers
 import (
 	"context"
 
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/VoidMesh/api/api/pkg/uuidutil"
 	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	"github.com/VoidMesh/api/api/services/chunk"
 	"github.com/jackc/pgx/v5/pgtype"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -11,22 +14,28 @@ import (
 
 type chunkServiceServer struct {
 	chunkV1.UnimplementedChunkServiceServer
-	chunkService ChunkService
-	worldService WorldService
-	logger       LoggerInterface
+	chunkService   ChunkService
+	summaryService ChunkSummaryService
+	worldService   WorldService
+	regionService  RegionService
+	logger         LoggerInterface
 }
 
 // NewChunkServer creates a chunk server with interface dependencies (primary constructor for testing)
 func NewChunkServer(
 	chunkService ChunkService,
+	summaryService ChunkSummaryService,
 	worldService WorldService,
+	regionService RegionService,
 	logger LoggerInterface,
 ) chunkV1.ChunkServiceServer {
 	logger.Debug("Creating new ChunkService server instance")
 	return &chunkServiceServer{
-		chunkService: chunkService,
-		worldService: worldService,
-		logger:       logger,
+		chunkService:   chunkService,
+		summaryService: summaryService,
+		worldService:   worldService,
+		regionService:  regionService,
+		logger:         logger,
 	}
 }
 
@@ -44,7 +53,8 @@ func (s *chunkServiceServer) resolveWorldID(ctx context.Context, worldIDBytes []
 		}
 		worldID = defaultWorld.ID
 	} else {
-		err := worldID.Scan(worldIDBytes)
+		var err error
+		worldID, err = uuidutil.Decode(worldIDBytes)
 		if err != nil {
 			logger.Warn("Invalid world ID format", "world_id", worldIDBytes, "error", err)
 			return worldID, status.Errorf(codes.InvalidArgument, "Invalid world ID: %v", err)
@@ -54,6 +64,20 @@ func (s *chunkServiceServer) resolveWorldID(ctx context.Context, worldIDBytes []
 	return worldID, nil
 }
 
+// regionsInChunks returns the protected regions overlapping the chunk range, so clients can
+// show them alongside the terrain. Nothing is returned when regions are not enabled.
+func (s *chunkServiceServer) regionsInChunks(ctx context.Context, worldID pgtype.UUID, minX, maxX, minY, maxY int32, logger LoggerInterface) ([]*chunkV1.ProtectedRegion, error) {
+	if s.regionService == nil {
+		return nil, nil
+	}
+	regions, err := s.regionService.RegionsInChunks(ctx, worldID, minX, maxX, minY, maxY)
+	if err != nil {
+		logger.Error("Failed to get protected regions", "error", err)
+		return nil, err
+	}
+	return regions, nil
+}
+
 // GetChunk retrieves a single chunk
 func (s *chunkServiceServer) GetChunk(ctx context.Context, req *chunkV1.GetChunkRequest) (*chunkV1.GetChunkResponse, error) {
 	logger := s.logger.With("operation", "GetChunk", "chunk_x", req.ChunkX, "chunk_y", req.ChunkY)
@@ -73,9 +97,15 @@ func (s *chunkServiceServer) GetChunk(ctx context.Context, req *chunkV1.GetChunk
 		return nil, err
 	}
 
+	regions, err := s.regionsInChunks(ctx, worldID, req.ChunkX, req.ChunkX, req.ChunkY, req.ChunkY, logger)
+	if err != nil {
+		return nil, err
+	}
+
 	logger.Info("Successfully retrieved chunk")
 	return &chunkV1.GetChunkResponse{
-		Chunk: chunk,
+		Chunk:   chunk,
+		Regions: regions,
 	}, nil
 }
 
@@ -98,9 +128,15 @@ func (s *chunkServiceServer) GetChunks(ctx context.Context, req *chunkV1.GetChun
 		return nil, err
 	}
 
+	regions, err := s.regionsInChunks(ctx, worldID, req.MinChunkX, req.MaxChunkX, req.MinChunkY, req.MaxChunkY, logger)
+	if err != nil {
+		return nil, err
+	}
+
 	logger.Info("Successfully retrieved chunks in range", "count", len(chunks))
 	return &chunkV1.GetChunksResponse{
-		Chunks: chunks,
+		Chunks:  chunks,
+		Regions: regions,
 	}, nil
 }
 
@@ -123,8 +159,69 @@ func (s *chunkServiceServer) GetChunksInRadius(ctx context.Context, req *chunkV1
 		return nil, err
 	}
 
+	regions, err := s.regionsInChunks(ctx, worldID,
+		req.CenterChunkX-req.Radius, req.CenterChunkX+req.Radius, req.CenterChunkY-req.Radius, req.CenterChunkY+req.Radius, logger)
+	if err != nil {
+		return nil, err
+	}
+
 	logger.Info("Successfully retrieved chunks in radius", "count", len(chunks))
 	return &chunkV1.GetChunksInRadiusResponse{
-		Chunks: chunks,
+		Chunks:  chunks,
+		Regions: regions,
+	}, nil
+}
+
+// GetCells retrieves individual cells, so clients need not download whole chunks for a few
+func (s *chunkServiceServer) GetCells(ctx context.Context, req *chunkV1.GetCellsRequest) (*chunkV1.GetCellsResponse, error) {
+	logger := s.logger.With("operation", "GetCells", "count", len(req.Cells))
+	logger.Debug("Received GetCells request")
+
+	if len(req.Cells) > chunk.MaxCellQuery {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d cells can be requested at once", chunk.MaxCellQuery)
+	}
+
+	worldID, err := s.resolveWorldID(ctx, req.WorldId, logger)
+	if err != nil {
+		return nil, err
+	}
+	logger = logger.With("world_id", worldID.Bytes)
+
+	points := make([]geometry.Point, len(req.Cells))
+	for i, cell := range req.Cells {
+		points[i] = geometry.Point{X: cell.X, Y: cell.Y}
+	}
+	cells, err := s.chunkService.Cells(ctx, points)
+	if err != nil {
+		logger.Error("Failed to get cells", "error", err)
+		return nil, err
+	}
+
+	logger.Info("Successfully retrieved cells", "count", len(cells))
+	return &chunkV1.GetCellsResponse{
+		Cells: cells,
+	}, nil
+}
+
+// GetChunkSummaries retrieves precomputed summaries for generated chunks in a rectangular area
+func (s *chunkServiceServer) GetChunkSummaries(ctx context.Context, req *chunkV1.GetChunkSummariesRequest) (*chunkV1.GetChunkSummariesResponse, error) {
+	logger := s.logger.With("operation", "GetChunkSummaries", "min_x", req.MinChunkX, "max_x", req.MaxChunkX, "min_y", req.MinChunkY, "max_y", req.MaxChunkY)
+	logger.Debug("Received GetChunkSummaries request")
+
+	worldID, err := s.resolveWorldID(ctx, req.WorldId, logger)
+	if err != nil {
+		return nil, err
+	}
+	logger = logger.With("world_id", worldID.Bytes)
+
+	summaries, err := s.summaryService.GetChunkSummaries(ctx, worldID, req.MinChunkX, req.MaxChunkX, req.MinChunkY, req.MaxChunkY)
+	if err != nil {
+		logger.Error("Failed to get chunk summaries", "error", err)
+		return nil, err
+	}
+
+	logger.Info("Successfully retrieved chunk summaries", "count", len(summaries))
+	return &chunkV1.GetChunkSummariesResponse{
+		Summaries: summaries,
 	}, nil
 }
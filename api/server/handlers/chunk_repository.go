@@ -3,13 +3,16 @@ package handlers
 import (
 	"context"
 
+	"github.com/VoidMesh/api/api/internal/geometry"
 	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/storage"
 	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
 	"github.com/VoidMesh/api/api/services/chunk"
-	"github.com/VoidMesh/api/api/services/noise"
+	"github.com/VoidMesh/api/api/services/chunk_summary"
+	"github.com/VoidMesh/api/api/services/protected_region"
 	"github.com/VoidMesh/api/api/services/world"
 	"github.com/charmbracelet/log"
-	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 // chunkServiceWrapper implements the ChunkService interface using the real chunk service
@@ -26,28 +29,19 @@ func NewChunkService(service *chunk.Service) ChunkService {
 
 // NewChunkServiceWithPool creates a chunk service with all dependencies wired up
 // This function creates the necessary services and dependencies for production use
-func NewChunkServiceWithPool(dbPool *pgxpool.Pool) (ChunkService, error) {
+func NewChunkServiceWithPool(dbPool storage.Pool) (ChunkService, error) {
 	// Create world service (needed for chunk service)
 	worldLogger := world.NewDefaultLoggerWrapper()
 	worldService := world.NewServiceWithPool(dbPool, worldLogger)
 
-	// Get default world or create if it doesn't exist
-	defaultWorld, err := worldService.GetDefaultWorld(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Create noise generator for chunk generation
-	noiseGen := noise.NewGenerator(defaultWorld.Seed)
-
-	// Create chunk service with all dependencies
-	chunkService := chunk.NewServiceWithPool(dbPool, worldService, noiseGen.(*noise.Generator))
+	// Create chunk service with all dependencies; each world generates from its own seed
+	chunkService := chunk.NewServiceWithPool(dbPool, worldService, world.NewSeeds(worldService))
 
 	return NewChunkService(chunkService), nil
 }
 
 // NewChunkServerWithPool creates a complete chunk handler with all dependencies for production use
-func NewChunkServerWithPool(dbPool *pgxpool.Pool) (chunkV1.ChunkServiceServer, error) {
+func NewChunkServerWithPool(dbPool storage.Pool) (chunkV1.ChunkServiceServer, error) {
 	// Create chunk service
 	chunkService, err := NewChunkServiceWithPool(dbPool)
 	if err != nil {
@@ -64,7 +58,7 @@ func NewChunkServerWithPool(dbPool *pgxpool.Pool) (chunkV1.ChunkServiceServer, e
 	loggerWrapper := NewLoggerWrapper(logger)
 
 	// Create the handler with dependency injection
-	return NewChunkServer(chunkService, worldServiceWrapper, loggerWrapper), nil
+	return NewChunkServer(chunkService, chunk_summary.NewServiceWithPool(dbPool), worldServiceWrapper, protected_region.NewServiceWithPool(dbPool), loggerWrapper), nil
 }
 
 // loggerWrapper adapts charmbracelet/log.Logger to LoggerInterface
@@ -103,12 +97,16 @@ func (l *loggerWrapper) With(keysAndValues ...interface{}) LoggerInterface {
 	return &loggerWrapper{logger: newLogger}
 }
 
-
 // GetOrCreateChunk retrieves an existing chunk or creates a new one
 func (w *chunkServiceWrapper) GetOrCreateChunk(ctx context.Context, chunkX, chunkY int32) (*chunkV1.ChunkData, error) {
 	return w.service.GetOrCreateChunk(ctx, chunkX, chunkY)
 }
 
+// GetOrCreateChunkInWorld retrieves or creates a chunk of an explicit world
+func (w *chunkServiceWrapper) GetOrCreateChunkInWorld(ctx context.Context, worldID pgtype.UUID, chunkX, chunkY int32) (*chunkV1.ChunkData, error) {
+	return w.service.GetOrCreateChunkInWorld(ctx, worldID, chunkX, chunkY)
+}
+
 // GetChunksInRange retrieves multiple chunks in a rectangular area
 func (w *chunkServiceWrapper) GetChunksInRange(ctx context.Context, minX, maxX, minY, maxY int32) ([]*chunkV1.ChunkData, error) {
 	return w.service.GetChunksInRange(ctx, minX, maxX, minY, maxY)
@@ -117,4 +115,14 @@ func (w *chunkServiceWrapper) GetChunksInRange(ctx context.Context, minX, maxX,
 // GetChunksInRadius retrieves chunks in a circular area
 func (w *chunkServiceWrapper) GetChunksInRadius(ctx context.Context, centerX, centerY, radius int32) ([]*chunkV1.ChunkData, error) {
 	return w.service.GetChunksInRadius(ctx, centerX, centerY, radius)
-}
\ No newline at end of file
+}
+
+// IsPassable checks a cell against its chunk's passability mask
+func (w *chunkServiceWrapper) IsPassable(ctx context.Context, worldID pgtype.UUID, x, y int32) (bool, error) {
+	return w.service.IsPassable(ctx, worldID, x, y)
+}
+
+// Cells retrieves the terrain and passability of individual cells
+func (w *chunkServiceWrapper) Cells(ctx context.Context, points []geometry.Point) ([]*chunkV1.CellInfo, error) {
+	return w.service.Cells(ctx, points)
+}
@@ -8,16 +8,19 @@ import (
 	"time"
 
 	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/geometry"
 	"github.com/VoidMesh/api/api/internal/testmocks/handlers"
 	"github.com/VoidMesh/api/api/internal/testutil"
 	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
 	resourceNodeV1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
+	"github.com/VoidMesh/api/api/services/chunk"
 	"github.com/charmbracelet/log"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -891,6 +894,119 @@ func TestChunkServiceServer_GetChunksInRadius(t *testing.T) {
 	}
 }
 
+// TestChunkServiceServer_GetChunkSummaries tests retrieval of the chunk summary read model
+func TestChunkServiceServer_GetChunkSummaries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSummaryService := mockhandlers.NewMockChunkSummaryService(ctrl)
+	mockWorldService := mockhandlers.NewMockWorldService(ctrl)
+	mockLoggerInterface := mockhandlers.NewMockLoggerInterface(ctrl)
+	mockLogger := &mockLoggerAdapter{mock: mockLoggerInterface}
+
+	server := &chunkServiceServer{
+		summaryService: mockSummaryService,
+		worldService:   mockWorldService,
+		logger:         mockLogger,
+	}
+
+	testWorldID := testutil.UUIDFromString(testutil.UUIDTestData.World1)
+	testWorld := db.World{
+		ID:        testWorldID,
+		Name:      "Test World",
+		Seed:      12345,
+		CreatedAt: testutil.NowTimestamp(),
+	}
+
+	tests := []struct {
+		name       string
+		request    *chunkV1.GetChunkSummariesRequest
+		setupMocks func()
+		wantErr    bool
+		wantCode   codes.Code
+		wantMsg    string
+		validate   func(t *testing.T, resp *chunkV1.GetChunkSummariesResponse)
+	}{
+		{
+			name: "successful retrieval with default world",
+			request: &chunkV1.GetChunkSummariesRequest{
+				MinChunkX: 0,
+				MaxChunkX: 1,
+				MinChunkY: 0,
+				MaxChunkY: 1,
+			},
+			setupMocks: func() {
+				mockLoggerInterface.EXPECT().With(gomock.Any()).Return(mockLoggerInterface).Times(2)
+				mockLoggerInterface.EXPECT().Debug("Received GetChunkSummaries request")
+				mockLoggerInterface.EXPECT().Debug("World ID not provided, using default world")
+				mockLoggerInterface.EXPECT().Info(gomock.Any(), gomock.Any())
+
+				mockWorldService.EXPECT().GetDefaultWorld(gomock.Any()).Return(testWorld, nil)
+				mockSummaryService.EXPECT().GetChunkSummaries(gomock.Any(), testWorldID, int32(0), int32(1), int32(0), int32(1)).Return([]*chunkV1.ChunkSummary{
+					{
+						ChunkX: 0,
+						ChunkY: 0,
+						TerrainHistogram: []*chunkV1.TerrainCount{
+							{TerrainType: chunkV1.TerrainType_TERRAIN_TYPE_GRASS, Count: 1024},
+						},
+						NodeCounts: []*chunkV1.ResourceNodeCount{
+							{ResourceNodeTypeId: resourceNodeV1.ResourceNodeTypeId_RESOURCE_NODE_TYPE_ID_HERB_PATCH, Count: 3},
+						},
+						HarvestCount: 2,
+					},
+				}, nil)
+			},
+			validate: func(t *testing.T, resp *chunkV1.GetChunkSummariesResponse) {
+				require.Len(t, resp.Summaries, 1)
+				assert.Equal(t, int32(1024), resp.Summaries[0].TerrainHistogram[0].Count)
+				assert.Equal(t, int32(2), resp.Summaries[0].HarvestCount)
+			},
+		},
+		{
+			name: "invalid range is rejected by the service",
+			request: &chunkV1.GetChunkSummariesRequest{
+				MinChunkX: 5,
+				MaxChunkX: 0,
+			},
+			setupMocks: func() {
+				mockLoggerInterface.EXPECT().With(gomock.Any()).Return(mockLoggerInterface).Times(2)
+				mockLoggerInterface.EXPECT().Debug("Received GetChunkSummaries request")
+				mockLoggerInterface.EXPECT().Debug("World ID not provided, using default world")
+				mockWorldService.EXPECT().GetDefaultWorld(gomock.Any()).Return(testWorld, nil)
+				mockLoggerInterface.EXPECT().Error(gomock.Any(), gomock.Any())
+
+				mockSummaryService.EXPECT().GetChunkSummaries(gomock.Any(), testWorldID, int32(5), int32(0), int32(0), int32(0)).
+					Return(nil, status.Errorf(codes.InvalidArgument, "min chunk coordinates must not exceed max"))
+			},
+			wantErr:  true,
+			wantCode: codes.InvalidArgument,
+			wantMsg:  "min chunk coordinates must not exceed max",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMocks()
+
+			resp, err := server.GetChunkSummaries(context.Background(), tt.request)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				if tt.wantCode != codes.OK {
+					testutil.AssertGRPCError(t, err, tt.wantCode, tt.wantMsg)
+				}
+				assert.Nil(t, resp)
+			} else {
+				testutil.AssertNoGRPCError(t, err)
+				require.NotNil(t, resp)
+				if tt.validate != nil {
+					tt.validate(t, resp)
+				}
+			}
+		})
+	}
+}
+
 // TestChunkServiceServer_resolveWorldID tests the helper method for world ID resolution
 func TestChunkServiceServer_resolveWorldID(t *testing.T) {
 	ctrl := gomock.NewController(t)
@@ -983,6 +1099,75 @@ func TestChunkServiceServer_resolveWorldID(t *testing.T) {
 }
 
 // Benchmark tests for performance baseline establishment
+func TestChunkServiceServer_GetChunksInRadius_Regions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChunkService := mockhandlers.NewMockChunkService(ctrl)
+	mockWorldService := mockhandlers.NewMockWorldService(ctrl)
+	mockRegionService := mockhandlers.NewMockRegionService(ctrl)
+
+	server := &chunkServiceServer{
+		chunkService:  mockChunkService,
+		worldService:  mockWorldService,
+		regionService: mockRegionService,
+		logger:        &loggerWrapper{logger: log.New(io.Discard)},
+	}
+
+	testWorldID := testutil.UUIDFromString(testutil.UUIDTestData.World1)
+	spawn := &chunkV1.ProtectedRegion{
+		Id:    1,
+		Name:  "Spawn",
+		Flags: []chunkV1.RegionFlag{chunkV1.RegionFlag_REGION_FLAG_NO_HARVEST},
+	}
+
+	mockWorldService.EXPECT().GetDefaultWorld(gomock.Any()).Return(db.World{ID: testWorldID}, nil)
+	mockChunkService.EXPECT().GetChunksInRadius(gomock.Any(), int32(4), int32(-2), int32(1)).
+		Return([]*chunkV1.ChunkData{{ChunkX: 4, ChunkY: -2}}, nil)
+	// The region lookup covers the square around the circle
+	mockRegionService.EXPECT().RegionsInChunks(gomock.Any(), testWorldID, int32(3), int32(5), int32(-3), int32(-1)).
+		Return([]*chunkV1.ProtectedRegion{spawn}, nil)
+
+	resp, err := server.GetChunksInRadius(context.Background(), &chunkV1.GetChunksInRadiusRequest{
+		CenterChunkX: 4,
+		CenterChunkY: -2,
+		Radius:       1,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Regions, 1)
+	assert.Equal(t, "Spawn", resp.Regions[0].Name)
+}
+
+func TestChunkServiceServer_GetCells(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChunkService := mockhandlers.NewMockChunkService(ctrl)
+	mockWorldService := mockhandlers.NewMockWorldService(ctrl)
+
+	server := &chunkServiceServer{
+		chunkService: mockChunkService,
+		worldService: mockWorldService,
+		logger:       &loggerWrapper{logger: log.New(io.Discard)},
+	}
+
+	water := &chunkV1.CellInfo{X: 3, Y: -40, Cell: &chunkV1.TerrainCell{TerrainType: chunkV1.TerrainType_TERRAIN_TYPE_WATER}}
+	mockWorldService.EXPECT().GetDefaultWorld(gomock.Any()).Return(db.World{ID: testutil.UUIDFromString(testutil.UUIDTestData.World1)}, nil)
+	mockChunkService.EXPECT().Cells(gomock.Any(), []geometry.Point{{X: 3, Y: -40}}).Return([]*chunkV1.CellInfo{water}, nil)
+
+	resp, err := server.GetCells(context.Background(), &chunkV1.GetCellsRequest{
+		Cells: []*chunkV1.CellCoordinate{{X: 3, Y: -40}},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Cells, 1)
+	assert.False(t, resp.Cells[0].Passable)
+
+	_, err = server.GetCells(context.Background(), &chunkV1.GetCellsRequest{
+		Cells: make([]*chunkV1.CellCoordinate, chunk.MaxCellQuery+1),
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "requests for more cells than the limit are rejected before any lookup")
+}
+
 func BenchmarkChunkServiceServer_GetChunk(b *testing.B) {
 	ctrl := gomock.NewController(b)
 	defer ctrl.Finish()
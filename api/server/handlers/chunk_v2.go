@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"context"
+
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	chunkV2 "github.com/VoidMesh/api/api/proto/chunk/v2"
+)
+
+// chunkServiceV2Server serves chunk.v2 through the v1 server
+type chunkServiceV2Server struct {
+	chunkV2.UnimplementedChunkServiceServer
+	v1 chunkV1.ChunkServiceServer
+}
+
+func NewChunkServerV2(v1 chunkV1.ChunkServiceServer) chunkV2.ChunkServiceServer {
+	return &chunkServiceV2Server{v1: v1}
+}
+
+// GetChunk retrieves a single chunk
+func (s *chunkServiceV2Server) GetChunk(ctx context.Context, req *chunkV2.GetChunkRequest) (*chunkV1.GetChunkResponse, error) {
+	worldID, err := v1WorldID(req.WorldId)
+	if err != nil {
+		return nil, err
+	}
+	return s.v1.GetChunk(ctx, &chunkV1.GetChunkRequest{
+		WorldId: worldID,
+		ChunkX:  req.ChunkX,
+		ChunkY:  req.ChunkY,
+	})
+}
+
+// GetChunks retrieves the chunks in a rectangle
+func (s *chunkServiceV2Server) GetChunks(ctx context.Context, req *chunkV2.GetChunksRequest) (*chunkV1.GetChunksResponse, error) {
+	worldID, err := v1WorldID(req.WorldId)
+	if err != nil {
+		return nil, err
+	}
+	return s.v1.GetChunks(ctx, &chunkV1.GetChunksRequest{
+		WorldId:   worldID,
+		MinChunkX: req.MinChunkX,
+		MaxChunkX: req.MaxChunkX,
+		MinChunkY: req.MinChunkY,
+		MaxChunkY: req.MaxChunkY,
+	})
+}
+
+// GetChunksInRadius retrieves the chunks in a radius around a chunk
+func (s *chunkServiceV2Server) GetChunksInRadius(ctx context.Context, req *chunkV2.GetChunksInRadiusRequest) (*chunkV1.GetChunksInRadiusResponse, error) {
+	worldID, err := v1WorldID(req.WorldId)
+	if err != nil {
+		return nil, err
+	}
+	return s.v1.GetChunksInRadius(ctx, &chunkV1.GetChunksInRadiusRequest{
+		WorldId:      worldID,
+		CenterChunkX: req.CenterChunkX,
+		CenterChunkY: req.CenterChunkY,
+		Radius:       req.Radius,
+	})
+}
+
+// GetCells retrieves individual cells
+func (s *chunkServiceV2Server) GetCells(ctx context.Context, req *chunkV2.GetCellsRequest) (*chunkV1.GetCellsResponse, error) {
+	worldID, err := v1WorldID(req.WorldId)
+	if err != nil {
+		return nil, err
+	}
+	return s.v1.GetCells(ctx, &chunkV1.GetCellsRequest{
+		WorldId: worldID,
+		Cells:   req.Cells,
+	})
+}
+
+// GetChunkSummaries retrieves the summaries of generated chunks in a rectangle
+func (s *chunkServiceV2Server) GetChunkSummaries(ctx context.Context, req *chunkV2.GetChunkSummariesRequest) (*chunkV1.GetChunkSummariesResponse, error) {
+	worldID, err := v1WorldID(req.WorldId)
+	if err != nil {
+		return nil, err
+	}
+	return s.v1.GetChunkSummaries(ctx, &chunkV1.GetChunkSummariesRequest{
+		WorldId:   worldID,
+		MinChunkX: req.MinChunkX,
+		MaxChunkX: req.MaxChunkX,
+		MinChunkY: req.MinChunkY,
+		MaxChunkY: req.MaxChunkY,
+	})
+}
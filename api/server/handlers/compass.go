@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/internal/logging"
+	compassV1 "github.com/VoidMesh/api/api/proto/compass/v1"
+	"github.com/charmbracelet/log"
+)
+
+// CompassService defines the interface for points of interest and waypoints
+type CompassService interface {
+	GetPointsOfInterest(ctx context.Context, userID, characterID string, radius int32, types []compassV1.PointOfInterestType) ([]*compassV1.PointOfInterest, error)
+	AddWaypoint(ctx context.Context, userID, characterID, name string, x, y int32) (*compassV1.Waypoint, error)
+	RemoveWaypoint(ctx context.Context, userID, characterID string, waypointID int64) error
+}
+
+type compassServiceServer struct {
+	compassV1.UnimplementedCompassServiceServer
+	compassService CompassService
+	logger         *log.Logger
+}
+
+// NewCompassServer creates the compass service handler
+func NewCompassServer(compassService CompassService) compassV1.CompassServiceServer {
+	logger := logging.WithComponent("compass-handler")
+	logger.Debug("Creating new CompassService server instance")
+	return &compassServiceServer{
+		compassService: compassService,
+		logger:         logger,
+	}
+}
+
+// GetPointsOfInterest lists the points of interest around the caller's character
+func (s *compassServiceServer) GetPointsOfInterest(ctx context.Context, req *compassV1.GetPointsOfInterestRequest) (*compassV1.GetPointsOfInterestResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	points, err := s.compassService.GetPointsOfInterest(ctx, userID, req.CharacterId, req.Radius, req.Types)
+	if err != nil {
+		s.logger.Debug("Failed to get points of interest", "user_id", userID, "character_id", req.CharacterId, "error", err)
+		return nil, err
+	}
+	return &compassV1.GetPointsOfInterestResponse{Points: points}, nil
+}
+
+// AddWaypoint places a named waypoint for the caller's character
+func (s *compassServiceServer) AddWaypoint(ctx context.Context, req *compassV1.AddWaypointRequest) (*compassV1.AddWaypointResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	waypoint, err := s.compassService.AddWaypoint(ctx, userID, req.CharacterId, req.Name, req.X, req.Y)
+	if err != nil {
+		s.logger.Debug("Failed to add waypoint", "user_id", userID, "character_id", req.CharacterId, "error", err)
+		return nil, err
+	}
+	return &compassV1.AddWaypointResponse{Waypoint: waypoint}, nil
+}
+
+// RemoveWaypoint deletes one of the caller's character's waypoints
+func (s *compassServiceServer) RemoveWaypoint(ctx context.Context, req *compassV1.RemoveWaypointRequest) (*compassV1.RemoveWaypointResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.compassService.RemoveWaypoint(ctx, userID, req.CharacterId, req.WaypointId); err != nil {
+		s.logger.Debug("Failed to remove waypoint", "user_id", userID, "waypoint_id", req.WaypointId, "error", err)
+		return nil, err
+	}
+	return &compassV1.RemoveWaypointResponse{}, nil
+}
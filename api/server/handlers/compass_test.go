@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/VoidMesh/api/api/internal/testutil"
+	compassV1 "github.com/VoidMesh/api/api/proto/compass/v1"
+	"github.com/VoidMesh/api/api/server/middleware"
+	"github.com/charmbracelet/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeCompassService records the user and radius each call was made with
+type fakeCompassService struct {
+	userID string
+	radius int32
+}
+
+func (f *fakeCompassService) GetPointsOfInterest(ctx context.Context, userID, characterID string, radius int32, types []compassV1.PointOfInterestType) ([]*compassV1.PointOfInterest, error) {
+	f.userID, f.radius = userID, radius
+	return []*compassV1.PointOfInterest{{Type: compassV1.PointOfInterestType_POINT_OF_INTEREST_TYPE_WAYPOINT, Name: "Camp"}}, nil
+}
+
+func (f *fakeCompassService) AddWaypoint(ctx context.Context, userID, characterID, name string, x, y int32) (*compassV1.Waypoint, error) {
+	f.userID = userID
+	return &compassV1.Waypoint{Id: 1, Name: name, X: x, Y: y}, nil
+}
+
+func (f *fakeCompassService) RemoveWaypoint(ctx context.Context, userID, characterID string, waypointID int64) error {
+	f.userID = userID
+	if waypointID != 1 {
+		return status.Errorf(codes.NotFound, "waypoint not found")
+	}
+	return nil
+}
+
+func TestCompassServiceServer(t *testing.T) {
+	compass := &fakeCompassService{}
+	server := &compassServiceServer{compassService: compass, logger: log.New(io.Discard)}
+	ctx := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "player")
+	characterID := testutil.UUIDTestData.Character1
+
+	_, err := server.GetPointsOfInterest(context.Background(), &compassV1.GetPointsOfInterestRequest{CharacterId: characterID})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	points, err := server.GetPointsOfInterest(ctx, &compassV1.GetPointsOfInterestRequest{CharacterId: characterID, Radius: 64})
+	require.NoError(t, err)
+	assert.Len(t, points.Points, 1)
+	assert.Equal(t, int32(64), compass.radius)
+	assert.Equal(t, testutil.UUIDTestData.User1, compass.userID, "the user is taken from the caller")
+
+	added, err := server.AddWaypoint(ctx, &compassV1.AddWaypointRequest{CharacterId: characterID, Name: "Camp", X: 3, Y: 4})
+	require.NoError(t, err)
+	assert.Equal(t, "Camp", added.Waypoint.Name)
+
+	_, err = server.RemoveWaypoint(ctx, &compassV1.RemoveWaypointRequest{CharacterId: characterID, WaypointId: 1})
+	require.NoError(t, err)
+	_, err = server.RemoveWaypoint(ctx, &compassV1.RemoveWaypointRequest{CharacterId: characterID, WaypointId: 2})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
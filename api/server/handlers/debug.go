@@ -0,0 +1,188 @@
+package handlers
+
+import (
+	"context"
+	"runtime"
+	"sort"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/debugstats"
+	"github.com/VoidMesh/api/api/internal/logging"
+	debugV1 "github.com/VoidMesh/api/api/proto/debug/v1"
+	"github.com/VoidMesh/api/api/server/middleware"
+	"github.com/VoidMesh/api/api/services/replay"
+	"github.com/charmbracelet/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// RegionReplayService defines the interface for recording and replaying chunk regions
+type RegionReplayService interface {
+	StartRecording(ctx context.Context, userID string, region replay.Region, duration time.Duration) (*debugV1.RegionRecording, error)
+	StopRecording(ctx context.Context, recordingID int64) (*debugV1.RegionRecording, error)
+	ListRecordings(ctx context.Context, limit int32) ([]*debugV1.RegionRecording, error)
+	Step(ctx context.Context, recordingID int64, step int32) (*debugV1.StepRegionReplayResponse, error)
+}
+
+// ChunkAccessStatsService defines the interface for reading per-chunk access statistics
+type ChunkAccessStatsService interface {
+	AccessStats(ctx context.Context, worldID string, order debugV1.ChunkAccessOrder, idleFor time.Duration, limit int32) ([]*debugV1.ChunkAccessStats, error)
+}
+
+type debugServiceServer struct {
+	debugV1.UnimplementedDebugServiceServer
+	serviceInfo       ServiceInfoProvider
+	replay            RegionReplayService
+	chunkStats        ChunkAccessStatsService
+	reflectionEnabled bool
+	startedAt         time.Time
+	logger            *log.Logger
+}
+
+// NewDebugServer creates the debug service; it should only be registered when debug endpoints are enabled
+func NewDebugServer(serviceInfo ServiceInfoProvider, replay RegionReplayService, chunkStats ChunkAccessStatsService, reflectionEnabled bool) debugV1.DebugServiceServer {
+	logger := logging.WithComponent("debug-handler")
+	logger.Debug("Creating new DebugService server instance", "reflection_enabled", reflectionEnabled)
+	return &debugServiceServer{
+		serviceInfo:       serviceInfo,
+		replay:            replay,
+		chunkStats:        chunkStats,
+		reflectionEnabled: reflectionEnabled,
+		startedAt:         time.Now(),
+		logger:            logger,
+	}
+}
+
+// GetServerState dumps service wiring and runtime counters (admin only)
+func (s *debugServiceServer) GetServerState(ctx context.Context, req *debugV1.GetServerStateRequest) (*debugV1.GetServerStateResponse, error) {
+	logger := s.logger.With("operation", "GetServerState")
+	logger.Debug("Received GetServerState request")
+
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		logger.Warn("Non-admin attempted to read server state", "user_id", userID)
+		return nil, err
+	}
+
+	var services []*debugV1.ServiceInfo
+	for name, info := range s.serviceInfo.GetServiceInfo() {
+		methods := make([]string, 0, len(info.Methods))
+		for _, method := range info.Methods {
+			methods = append(methods, method.Name)
+		}
+		sort.Strings(methods)
+		services = append(services, &debugV1.ServiceInfo{Name: name, Methods: methods})
+	}
+	sort.Slice(services, func(i, j int) bool { return services[i].Name < services[j].Name })
+
+	logger.Info("Server state dumped", "services", len(services))
+	return &debugV1.GetServerStateResponse{
+		Services:            services,
+		StreamSubscriptions: debugstats.Snapshot(debugstats.StreamSubscriptions),
+		CacheEntries:        debugstats.Snapshot(debugstats.CacheEntries),
+		Queues:              debugstats.Snapshot(debugstats.Queues),
+		BackgroundJobs:      debugstats.Snapshot(debugstats.BackgroundJobs),
+		CompressionRatios:   debugstats.Snapshot(debugstats.Compression),
+		Database:            debugstats.Snapshot(debugstats.Database),
+		ReflectionEnabled:   s.reflectionEnabled,
+		Goroutines:          int32(runtime.NumGoroutine()),
+		GoVersion:           runtime.Version(),
+		StartedAt:           timestamppb.New(s.startedAt),
+	}, nil
+}
+
+// StartRegionRecording begins recording the mutating events in a rectangle of chunks (admin only)
+func (s *debugServiceServer) StartRegionRecording(ctx context.Context, req *debugV1.StartRegionRecordingRequest) (*debugV1.StartRegionRecordingResponse, error) {
+	logger := s.logger.With("operation", "StartRegionRecording")
+
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		logger.Warn("Non-admin attempted to start a region recording", "user_id", userID)
+		return nil, err
+	}
+
+	userID, _ := middleware.GetUserIDFromContext(ctx)
+	recording, err := s.replay.StartRecording(ctx, userID, replay.Region{
+		WorldID:   req.WorldId,
+		MinChunkX: req.MinChunkX,
+		MinChunkY: req.MinChunkY,
+		MaxChunkX: req.MaxChunkX,
+		MaxChunkY: req.MaxChunkY,
+	}, time.Duration(req.DurationSeconds)*time.Second)
+	if err != nil {
+		logger.Warn("Failed to start region recording", "error", err)
+		return nil, err
+	}
+	return &debugV1.StartRegionRecordingResponse{Recording: recording}, nil
+}
+
+// StopRegionRecording ends a region recording early (admin only)
+func (s *debugServiceServer) StopRegionRecording(ctx context.Context, req *debugV1.StopRegionRecordingRequest) (*debugV1.StopRegionRecordingResponse, error) {
+	logger := s.logger.With("operation", "StopRegionRecording", "recording_id", req.RecordingId)
+
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		logger.Warn("Non-admin attempted to stop a region recording", "user_id", userID)
+		return nil, err
+	}
+
+	recording, err := s.replay.StopRecording(ctx, req.RecordingId)
+	if err != nil {
+		logger.Warn("Failed to stop region recording", "error", err)
+		return nil, err
+	}
+	return &debugV1.StopRegionRecordingResponse{Recording: recording}, nil
+}
+
+// ListRegionRecordings lists region recordings, newest first (admin only)
+func (s *debugServiceServer) ListRegionRecordings(ctx context.Context, req *debugV1.ListRegionRecordingsRequest) (*debugV1.ListRegionRecordingsResponse, error) {
+	logger := s.logger.With("operation", "ListRegionRecordings")
+
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		logger.Warn("Non-admin attempted to list region recordings", "user_id", userID)
+		return nil, err
+	}
+
+	recordings, err := s.replay.ListRecordings(ctx, req.Limit)
+	if err != nil {
+		logger.Error("Failed to list region recordings", "error", err)
+		return nil, err
+	}
+	return &debugV1.ListRegionRecordingsResponse{Recordings: recordings}, nil
+}
+
+// StepRegionReplay replays a recording up to the requested step (admin only)
+func (s *debugServiceServer) StepRegionReplay(ctx context.Context, req *debugV1.StepRegionReplayRequest) (*debugV1.StepRegionReplayResponse, error) {
+	logger := s.logger.With("operation", "StepRegionReplay", "recording_id", req.RecordingId, "step", req.Step)
+
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		logger.Warn("Non-admin attempted to replay a region recording", "user_id", userID)
+		return nil, err
+	}
+
+	resp, err := s.replay.Step(ctx, req.RecordingId, req.Step)
+	if err != nil {
+		logger.Warn("Failed to replay region recording", "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ListChunkAccessStats lists per-chunk read statistics, coldest or most read first (admin only)
+func (s *debugServiceServer) ListChunkAccessStats(ctx context.Context, req *debugV1.ListChunkAccessStatsRequest) (*debugV1.ListChunkAccessStatsResponse, error) {
+	logger := s.logger.With("operation", "ListChunkAccessStats", "order", req.Order)
+
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		logger.Warn("Non-admin attempted to list chunk access stats", "user_id", userID)
+		return nil, err
+	}
+
+	chunks, err := s.chunkStats.AccessStats(ctx, req.WorldId, req.Order, time.Duration(req.IdleSeconds)*time.Second, req.Limit)
+	if err != nil {
+		logger.Warn("Failed to list chunk access stats", "error", err)
+		return nil, err
+	}
+	return &debugV1.ListChunkAccessStatsResponse{Chunks: chunks}, nil
+}
@@ -0,0 +1,169 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/debugstats"
+	"github.com/VoidMesh/api/api/internal/testutil"
+	debugV1 "github.com/VoidMesh/api/api/proto/debug/v1"
+	worldV1 "github.com/VoidMesh/api/api/proto/world/v1"
+	"github.com/VoidMesh/api/api/server/middleware"
+	"github.com/VoidMesh/api/api/services/replay"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// fakeRegionReplayService records the requests it receives
+type fakeRegionReplayService struct {
+	userID   string
+	region   replay.Region
+	duration time.Duration
+	stepped  int32
+}
+
+func (f *fakeRegionReplayService) StartRecording(ctx context.Context, userID string, region replay.Region, duration time.Duration) (*debugV1.RegionRecording, error) {
+	f.userID, f.region, f.duration = userID, region, duration
+	return &debugV1.RegionRecording{Id: 1, WorldId: region.WorldID, Active: true}, nil
+}
+
+func (f *fakeRegionReplayService) StopRecording(ctx context.Context, recordingID int64) (*debugV1.RegionRecording, error) {
+	return &debugV1.RegionRecording{Id: recordingID}, nil
+}
+
+func (f *fakeRegionReplayService) ListRecordings(ctx context.Context, limit int32) ([]*debugV1.RegionRecording, error) {
+	return []*debugV1.RegionRecording{{Id: 1}}, nil
+}
+
+func (f *fakeRegionReplayService) Step(ctx context.Context, recordingID int64, step int32) (*debugV1.StepRegionReplayResponse, error) {
+	f.stepped = step
+	return &debugV1.StepRegionReplayResponse{TotalSteps: 10, Event: &debugV1.RecordedEvent{Step: step}}, nil
+}
+
+// fakeChunkAccessStatsService records the requests it receives
+type fakeChunkAccessStatsService struct {
+	worldID string
+	order   debugV1.ChunkAccessOrder
+	idleFor time.Duration
+	limit   int32
+}
+
+func (f *fakeChunkAccessStatsService) AccessStats(ctx context.Context, worldID string, order debugV1.ChunkAccessOrder, idleFor time.Duration, limit int32) ([]*debugV1.ChunkAccessStats, error) {
+	f.worldID, f.order, f.idleFor, f.limit = worldID, order, idleFor, limit
+	return []*debugV1.ChunkAccessStats{{AccessCount: 7}}, nil
+}
+
+func TestDebugServiceServer_GetServerState(t *testing.T) {
+	middleware.SetAdminUserIDs([]string{testutil.UUIDTestData.User1})
+	t.Cleanup(func() { middleware.SetAdminUserIDs(nil) })
+
+	debugstats.Register(debugstats.Queues, "test.queue", func() int64 { return 4 })
+
+	g := grpc.NewServer()
+	worldV1.RegisterWorldServiceServer(g, &worldV1.UnimplementedWorldServiceServer{})
+	server := NewDebugServer(g, &fakeRegionReplayService{}, &fakeChunkAccessStatsService{}, true)
+	debugV1.RegisterDebugServiceServer(g, server)
+
+	t.Run("admin receives service wiring and counters", func(t *testing.T) {
+		resp, err := server.GetServerState(middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "admin"), &debugV1.GetServerStateRequest{})
+		require.NoError(t, err)
+
+		require.Len(t, resp.Services, 2)
+		assert.Equal(t, "debug.v1.DebugService", resp.Services[0].Name)
+		assert.Contains(t, resp.Services[0].Methods, "GetServerState")
+		assert.Contains(t, resp.Services[0].Methods, "StepRegionReplay")
+		assert.Equal(t, "world.v1.WorldService", resp.Services[1].Name)
+		assert.Contains(t, resp.Services[1].Methods, "GetDefaultWorld")
+
+		assert.Equal(t, int64(4), resp.Queues["test.queue"])
+		assert.True(t, resp.ReflectionEnabled)
+		assert.Positive(t, resp.Goroutines)
+		assert.NotEmpty(t, resp.GoVersion)
+		assert.NotNil(t, resp.StartedAt)
+	})
+
+	t.Run("non-admin is rejected", func(t *testing.T) {
+		_, err := server.GetServerState(middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User2, "player"), &debugV1.GetServerStateRequest{})
+		testutil.AssertGRPCError(t, err, codes.PermissionDenied)
+	})
+
+	t.Run("unauthenticated is rejected", func(t *testing.T) {
+		_, err := server.GetServerState(context.Background(), &debugV1.GetServerStateRequest{})
+		testutil.AssertGRPCError(t, err, codes.PermissionDenied)
+	})
+}
+
+func TestDebugServiceServer_RegionReplay(t *testing.T) {
+	middleware.SetAdminUserIDs([]string{testutil.UUIDTestData.User1})
+	t.Cleanup(func() { middleware.SetAdminUserIDs(nil) })
+
+	fake := &fakeRegionReplayService{}
+	server := NewDebugServer(grpc.NewServer(), fake, &fakeChunkAccessStatsService{}, false)
+	adminCtx := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "admin")
+	playerCtx := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User2, "player")
+
+	t.Run("admin starts a recording", func(t *testing.T) {
+		resp, err := server.StartRegionRecording(adminCtx, &debugV1.StartRegionRecordingRequest{
+			WorldId:         testutil.UUIDTestData.World1,
+			MinChunkX:       -1,
+			MaxChunkX:       1,
+			MaxChunkY:       2,
+			DurationSeconds: 90,
+		})
+		require.NoError(t, err)
+		assert.True(t, resp.Recording.Active)
+		assert.Equal(t, testutil.UUIDTestData.User1, fake.userID)
+		assert.Equal(t, replay.Region{WorldID: testutil.UUIDTestData.World1, MinChunkX: -1, MaxChunkX: 1, MaxChunkY: 2}, fake.region)
+		assert.Equal(t, 90*time.Second, fake.duration)
+	})
+
+	t.Run("admin steps through a replay", func(t *testing.T) {
+		resp, err := server.StepRegionReplay(adminCtx, &debugV1.StepRegionReplayRequest{RecordingId: 1, Step: 3})
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), resp.Event.Step)
+		assert.Equal(t, int32(3), fake.stepped)
+	})
+
+	t.Run("non-admin is rejected", func(t *testing.T) {
+		_, err := server.StartRegionRecording(playerCtx, &debugV1.StartRegionRecordingRequest{})
+		testutil.AssertGRPCError(t, err, codes.PermissionDenied)
+		_, err = server.StopRegionRecording(playerCtx, &debugV1.StopRegionRecordingRequest{RecordingId: 1})
+		testutil.AssertGRPCError(t, err, codes.PermissionDenied)
+		_, err = server.ListRegionRecordings(playerCtx, &debugV1.ListRegionRecordingsRequest{})
+		testutil.AssertGRPCError(t, err, codes.PermissionDenied)
+		_, err = server.StepRegionReplay(playerCtx, &debugV1.StepRegionReplayRequest{RecordingId: 1})
+		testutil.AssertGRPCError(t, err, codes.PermissionDenied)
+	})
+}
+
+func TestDebugServiceServer_ListChunkAccessStats(t *testing.T) {
+	middleware.SetAdminUserIDs([]string{testutil.UUIDTestData.User1})
+	t.Cleanup(func() { middleware.SetAdminUserIDs(nil) })
+
+	fake := &fakeChunkAccessStatsService{}
+	server := NewDebugServer(grpc.NewServer(), &fakeRegionReplayService{}, fake, false)
+
+	t.Run("admin lists cold chunks", func(t *testing.T) {
+		resp, err := server.ListChunkAccessStats(middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "admin"), &debugV1.ListChunkAccessStatsRequest{
+			WorldId:     testutil.UUIDTestData.World1,
+			Order:       debugV1.ChunkAccessOrder_CHUNK_ACCESS_ORDER_COLDEST,
+			IdleSeconds: 3600,
+			Limit:       10,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Chunks, 1)
+		assert.Equal(t, int64(7), resp.Chunks[0].AccessCount)
+		assert.Equal(t, testutil.UUIDTestData.World1, fake.worldID)
+		assert.Equal(t, debugV1.ChunkAccessOrder_CHUNK_ACCESS_ORDER_COLDEST, fake.order)
+		assert.Equal(t, time.Hour, fake.idleFor)
+		assert.Equal(t, int32(10), fake.limit)
+	})
+
+	t.Run("non-admin is rejected", func(t *testing.T) {
+		_, err := server.ListChunkAccessStats(middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User2, "player"), &debugV1.ListChunkAccessStatsRequest{})
+		testutil.AssertGRPCError(t, err, codes.PermissionDenied)
+	})
+}
@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/internal/logging"
+	dungeonV1 "github.com/VoidMesh/api/api/proto/dungeon/v1"
+	"github.com/charmbracelet/log"
+)
+
+// DungeonService defines the interface for dungeon instances and their parties
+type DungeonService interface {
+	Create(ctx context.Context, userID, characterID string, memberIDs []string) (*dungeonV1.Dungeon, error)
+	Get(ctx context.Context, userID, characterID string) (*dungeonV1.Dungeon, error)
+	Enter(ctx context.Context, userID, characterID string) (*dungeonV1.EnterDungeonResponse, error)
+	Leave(ctx context.Context, userID, characterID string) (*dungeonV1.LeaveDungeonResponse, error)
+	Disband(ctx context.Context, userID, characterID string) error
+}
+
+type dungeonServiceServer struct {
+	dungeonV1.UnimplementedDungeonServiceServer
+	dungeonService DungeonService
+	logger         *log.Logger
+}
+
+// NewDungeonServer creates the dungeon service handler
+func NewDungeonServer(dungeonService DungeonService) dungeonV1.DungeonServiceServer {
+	logger := logging.WithComponent("dungeon-handler")
+	logger.Debug("Creating new DungeonService server instance")
+	return &dungeonServiceServer{
+		dungeonService: dungeonService,
+		logger:         logger,
+	}
+}
+
+// CreateDungeon generates an instance for the caller's character and its party
+func (s *dungeonServiceServer) CreateDungeon(ctx context.Context, req *dungeonV1.CreateDungeonRequest) (*dungeonV1.CreateDungeonResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dungeon, err := s.dungeonService.Create(ctx, userID, req.CharacterId, req.MemberCharacterIds)
+	if err != nil {
+		s.logger.Debug("Failed to create dungeon", "user_id", userID, "character_id", req.CharacterId, "error", err)
+		return nil, err
+	}
+	return &dungeonV1.CreateDungeonResponse{Dungeon: dungeon}, nil
+}
+
+// GetDungeon returns the instance the caller's character is bound to
+func (s *dungeonServiceServer) GetDungeon(ctx context.Context, req *dungeonV1.GetDungeonRequest) (*dungeonV1.GetDungeonResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dungeon, err := s.dungeonService.Get(ctx, userID, req.CharacterId)
+	if err != nil {
+		s.logger.Debug("Failed to get dungeon", "user_id", userID, "character_id", req.CharacterId, "error", err)
+		return nil, err
+	}
+	return &dungeonV1.GetDungeonResponse{Dungeon: dungeon}, nil
+}
+
+// EnterDungeon puts the caller's character on its instance's entrance
+func (s *dungeonServiceServer) EnterDungeon(ctx context.Context, req *dungeonV1.EnterDungeonRequest) (*dungeonV1.EnterDungeonResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.dungeonService.Enter(ctx, userID, req.CharacterId)
+	if err != nil {
+		s.logger.Debug("Failed to enter dungeon", "user_id", userID, "character_id", req.CharacterId, "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// LeaveDungeon takes the caller's character back to the overworld
+func (s *dungeonServiceServer) LeaveDungeon(ctx context.Context, req *dungeonV1.LeaveDungeonRequest) (*dungeonV1.LeaveDungeonResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.dungeonService.Leave(ctx, userID, req.CharacterId)
+	if err != nil {
+		s.logger.Debug("Failed to leave dungeon", "user_id", userID, "character_id", req.CharacterId, "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DisbandDungeon closes the instance the caller's character leads
+func (s *dungeonServiceServer) DisbandDungeon(ctx context.Context, req *dungeonV1.DisbandDungeonRequest) (*dungeonV1.DisbandDungeonResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.dungeonService.Disband(ctx, userID, req.CharacterId); err != nil {
+		s.logger.Debug("Failed to disband dungeon", "user_id", userID, "character_id", req.CharacterId, "error", err)
+		return nil, err
+	}
+	return &dungeonV1.DisbandDungeonResponse{}, nil
+}
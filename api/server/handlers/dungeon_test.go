@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/VoidMesh/api/api/internal/testutil"
+	dungeonV1 "github.com/VoidMesh/api/api/proto/dungeon/v1"
+	"github.com/VoidMesh/api/api/server/middleware"
+	"github.com/charmbracelet/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeDungeonService records the user and party of the last call
+type fakeDungeonService struct {
+	userID  string
+	members []string
+}
+
+func (f *fakeDungeonService) Create(ctx context.Context, userID, characterID string, memberIDs []string) (*dungeonV1.Dungeon, error) {
+	f.userID, f.members = userID, memberIDs
+	return &dungeonV1.Dungeon{Id: 4, LeaderCharacterId: characterID}, nil
+}
+
+func (f *fakeDungeonService) Get(ctx context.Context, userID, characterID string) (*dungeonV1.Dungeon, error) {
+	f.userID = userID
+	return &dungeonV1.Dungeon{Id: 4}, nil
+}
+
+func (f *fakeDungeonService) Enter(ctx context.Context, userID, characterID string) (*dungeonV1.EnterDungeonResponse, error) {
+	f.userID = userID
+	return &dungeonV1.EnterDungeonResponse{Position: &dungeonV1.DungeonPosition{DungeonId: 4, X: 5, Y: 6}}, nil
+}
+
+func (f *fakeDungeonService) Leave(ctx context.Context, userID, characterID string) (*dungeonV1.LeaveDungeonResponse, error) {
+	f.userID = userID
+	return &dungeonV1.LeaveDungeonResponse{X: 10, Y: 20}, nil
+}
+
+func (f *fakeDungeonService) Disband(ctx context.Context, userID, characterID string) error {
+	f.userID = userID
+	return status.Errorf(codes.PermissionDenied, "only the party leader can disband the dungeon")
+}
+
+func TestDungeonServiceServer(t *testing.T) {
+	dungeons := &fakeDungeonService{}
+	server := &dungeonServiceServer{dungeonService: dungeons, logger: log.New(io.Discard)}
+	ctx := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "player")
+	characterID := testutil.UUIDTestData.Character1
+
+	_, err := server.CreateDungeon(context.Background(), &dungeonV1.CreateDungeonRequest{CharacterId: characterID})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = server.EnterDungeon(context.Background(), &dungeonV1.EnterDungeonRequest{CharacterId: characterID})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	created, err := server.CreateDungeon(ctx, &dungeonV1.CreateDungeonRequest{CharacterId: characterID, MemberCharacterIds: []string{testutil.UUIDTestData.Character2}})
+	require.NoError(t, err)
+	assert.Equal(t, characterID, created.Dungeon.LeaderCharacterId)
+	assert.Equal(t, testutil.UUIDTestData.User1, dungeons.userID)
+	assert.Equal(t, []string{testutil.UUIDTestData.Character2}, dungeons.members)
+
+	got, err := server.GetDungeon(ctx, &dungeonV1.GetDungeonRequest{CharacterId: characterID})
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), got.Dungeon.Id)
+
+	entered, err := server.EnterDungeon(ctx, &dungeonV1.EnterDungeonRequest{CharacterId: characterID})
+	require.NoError(t, err)
+	assert.Equal(t, int32(6), entered.Position.Y)
+
+	left, err := server.LeaveDungeon(ctx, &dungeonV1.LeaveDungeonRequest{CharacterId: characterID})
+	require.NoError(t, err)
+	assert.Equal(t, int32(20), left.Y)
+
+	_, err = server.DisbandDungeon(ctx, &dungeonV1.DisbandDungeonRequest{CharacterId: characterID})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/logging"
+	fishingV1 "github.com/VoidMesh/api/api/proto/fishing/v1"
+	"github.com/charmbracelet/log"
+)
+
+// FishingService defines the interface for the fishing minigame
+type FishingService interface {
+	Cast(ctx context.Context, userID, characterID string, nodeID int32, send func(*fishingV1.FishingEvent) error) error
+	Hook(ctx context.Context, userID, characterID, biteID string, clientTime time.Time) (bool, error)
+}
+
+type fishingServiceServer struct {
+	fishingV1.UnimplementedFishingServiceServer
+	fishingService FishingService
+	logger         *log.Logger
+}
+
+// NewFishingServer creates the fishing service handler
+func NewFishingServer(fishingService FishingService) fishingV1.FishingServiceServer {
+	logger := logging.WithComponent("fishing-handler")
+	logger.Debug("Creating new FishingService server instance")
+	return &fishingServiceServer{
+		fishingService: fishingService,
+		logger:         logger,
+	}
+}
+
+// Cast streams a cast's events until the fish is caught or escapes
+func (s *fishingServiceServer) Cast(req *fishingV1.CastRequest, stream fishingV1.FishingService_CastServer) error {
+	ctx := stream.Context()
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := s.fishingService.Cast(ctx, userID, req.CharacterId, req.ResourceNodeId, stream.Send); err != nil {
+		s.logger.Debug("Cast failed", "user_id", userID, "resource_node_id", req.ResourceNodeId, "error", err)
+		return err
+	}
+	return nil
+}
+
+// Hook sets the hook on the character's current bite
+func (s *fishingServiceServer) Hook(ctx context.Context, req *fishingV1.HookRequest) (*fishingV1.HookResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var clientTime time.Time
+	if req.ClientTime != nil {
+		clientTime = req.ClientTime.AsTime()
+	}
+	hooked, err := s.fishingService.Hook(ctx, userID, req.CharacterId, req.BiteId, clientTime)
+	if err != nil {
+		s.logger.Debug("Failed to hook", "user_id", userID, "character_id", req.CharacterId, "error", err)
+		return nil, err
+	}
+	return &fishingV1.HookResponse{Hooked: hooked}, nil
+}
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/testutil"
+	fishingV1 "github.com/VoidMesh/api/api/proto/fishing/v1"
+	"github.com/VoidMesh/api/api/server/middleware"
+	"github.com/charmbracelet/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// fakeFishingService sends a bite and a catch for every cast
+type fakeFishingService struct {
+	userID     string
+	clientTime time.Time
+}
+
+func (f *fakeFishingService) Cast(ctx context.Context, userID, characterID string, nodeID int32, send func(*fishingV1.FishingEvent) error) error {
+	f.userID = userID
+	if err := send(&fishingV1.FishingEvent{Type: fishingV1.FishingEventType_FISHING_EVENT_TYPE_BITE, BiteId: "bite"}); err != nil {
+		return err
+	}
+	return send(&fishingV1.FishingEvent{Type: fishingV1.FishingEventType_FISHING_EVENT_TYPE_CAUGHT})
+}
+
+func (f *fakeFishingService) Hook(ctx context.Context, userID, characterID, biteID string, clientTime time.Time) (bool, error) {
+	f.userID = userID
+	f.clientTime = clientTime
+	return biteID == "bite", nil
+}
+
+// recordingFishingStream collects what the handler sends
+type recordingFishingStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*fishingV1.FishingEvent
+}
+
+func (s *recordingFishingStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *recordingFishingStream) Send(e *fishingV1.FishingEvent) error {
+	s.sent = append(s.sent, e)
+	return nil
+}
+
+func TestFishingServiceServer(t *testing.T) {
+	fishing := &fakeFishingService{}
+	server := &fishingServiceServer{fishingService: fishing, logger: log.New(io.Discard)}
+	ctx := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "player")
+	characterID := testutil.UUIDTestData.Character1
+
+	err := server.Cast(&fishingV1.CastRequest{CharacterId: characterID}, &recordingFishingStream{ctx: context.Background()})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = server.Hook(context.Background(), &fishingV1.HookRequest{CharacterId: characterID})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	stream := &recordingFishingStream{ctx: ctx}
+	require.NoError(t, server.Cast(&fishingV1.CastRequest{CharacterId: characterID, ResourceNodeId: 1}, stream))
+	require.Len(t, stream.sent, 2)
+	assert.Equal(t, fishingV1.FishingEventType_FISHING_EVENT_TYPE_CAUGHT, stream.sent[1].Type)
+	assert.Equal(t, testutil.UUIDTestData.User1, fishing.userID, "the user is taken from the caller")
+
+	hooked, err := server.Hook(ctx, &fishingV1.HookRequest{CharacterId: characterID, BiteId: "bite"})
+	require.NoError(t, err)
+	assert.True(t, hooked.Hooked)
+	assert.True(t, fishing.clientTime.IsZero(), "the client time is optional")
+
+	hookedAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	_, err = server.Hook(ctx, &fishingV1.HookRequest{CharacterId: characterID, BiteId: "bite", ClientTime: timestamppb.New(hookedAt)})
+	require.NoError(t, err)
+	assert.True(t, hookedAt.Equal(fishing.clientTime))
+}
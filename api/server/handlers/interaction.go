@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/internal/logging"
+	interactionV1 "github.com/VoidMesh/api/api/proto/interaction/v1"
+	"github.com/charmbracelet/log"
+)
+
+// InteractionService defines the interface for generic verbs on world entities
+type InteractionService interface {
+	Interact(ctx context.Context, userID, characterID string, entityID int64, verb string) (*interactionV1.InteractResponse, error)
+}
+
+type interactionServiceServer struct {
+	interactionV1.UnimplementedInteractionServiceServer
+	interactionService InteractionService
+	logger             *log.Logger
+}
+
+// NewInteractionServer creates the interaction service handler
+func NewInteractionServer(interactionService InteractionService) interactionV1.InteractionServiceServer {
+	logger := logging.WithComponent("interaction-handler")
+	logger.Debug("Creating new InteractionService server instance")
+	return &interactionServiceServer{
+		interactionService: interactionService,
+		logger:             logger,
+	}
+}
+
+// Interact carries out a verb on an entity within reach of the caller's character
+func (s *interactionServiceServer) Interact(ctx context.Context, req *interactionV1.InteractRequest) (*interactionV1.InteractResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.interactionService.Interact(ctx, userID, req.CharacterId, req.TargetEntityId, req.Verb)
+	if err != nil {
+		s.logger.Debug("Failed to interact", "user_id", userID, "entity_id", req.TargetEntityId, "verb", req.Verb, "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
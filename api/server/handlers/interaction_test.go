@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/VoidMesh/api/api/internal/testutil"
+	interactionV1 "github.com/VoidMesh/api/api/proto/interaction/v1"
+	"github.com/VoidMesh/api/api/server/middleware"
+	"github.com/charmbracelet/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeInteractionService records the user and verb of the last call
+type fakeInteractionService struct {
+	userID string
+	verb   string
+}
+
+func (f *fakeInteractionService) Interact(ctx context.Context, userID, characterID string, entityID int64, verb string) (*interactionV1.InteractResponse, error) {
+	f.userID, f.verb = userID, verb
+	return &interactionV1.InteractResponse{Verb: verb, TargetEntityId: entityID}, nil
+}
+
+func TestInteractionServiceServer(t *testing.T) {
+	interactions := &fakeInteractionService{}
+	server := &interactionServiceServer{interactionService: interactions, logger: log.New(io.Discard)}
+	req := &interactionV1.InteractRequest{CharacterId: testutil.UUIDTestData.Character1, TargetEntityId: 7, Verb: "examine"}
+
+	_, err := server.Interact(context.Background(), req)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	resp, err := server.Interact(middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "player"), req)
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), resp.TargetEntityId)
+	assert.Equal(t, testutil.UUIDTestData.User1, interactions.userID)
+	assert.Equal(t, "examine", interactions.verb)
+}
@@ -2,13 +2,18 @@ package handlers
 
 import (
 	"context"
+	"time"
 
 	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/VoidMesh/api/api/internal/latency"
 	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
 	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
 	resourceNodeV1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
 	terrainV1 "github.com/VoidMesh/api/api/proto/terrain/v1"
+	userV1 "github.com/VoidMesh/api/api/proto/user/v1"
 	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc"
 )
 
 // UserRepository defines the interface for user database operations.
@@ -55,7 +60,7 @@ type UserRepository interface {
 // This abstraction allows for easy testing and different JWT implementations.
 type JWTService interface {
 	// GenerateToken creates a new JWT token for the given user
-	GenerateToken(userID string, username string) (string, error)
+	GenerateToken(userID string, username string, worldID string) (string, error)
 
 	// ValidateToken validates a JWT token and returns the claims
 	// This method is not currently used in the user handler but is included
@@ -80,6 +85,47 @@ type TokenGenerator interface {
 	GenerateToken(length int) (string, error)
 }
 
+// LoginGate rejects logins while the server is in maintenance.
+type LoginGate interface {
+	CheckLogin(ctx context.Context) error
+}
+
+// SessionService records the session each login token belongs to, so users can see where
+// they are logged in and sign devices out.
+type SessionService interface {
+	Start(ctx context.Context, userID, token, ipAddress, userAgent string, expiresAt time.Time) (string, error)
+	List(ctx context.Context, userID, currentSessionID string) ([]*userV1.Session, error)
+	Revoke(ctx context.Context, userID, sessionID string) (*userV1.Session, error)
+}
+
+// IdentityService logs users in with external (OAuth provider) accounts and manages which
+// of those accounts are linked to them.
+type IdentityService interface {
+	// Login returns the user the provider account is linked to, creating one if it is not
+	// linked yet, and whether it was created
+	Login(ctx context.Context, provider, accessToken, remoteIP string) (db.User, bool, error)
+	Link(ctx context.Context, userID, provider, accessToken string) (*userV1.LinkedIdentity, error)
+	Unlink(ctx context.Context, userID, provider string) (*userV1.LinkedIdentity, error)
+	List(ctx context.Context, userID string) ([]*userV1.LinkedIdentity, error)
+	Providers() []string
+}
+
+// ConsentService records which versions of the terms of service and privacy policy users
+// accepted.
+type ConsentService interface {
+	Status(ctx context.Context, userID string) ([]*userV1.ConsentDocument, error)
+	Accept(ctx context.Context, userID string, kind userV1.ConsentKind, version int32, ipAddress string) (*userV1.ConsentDocument, error)
+}
+
+// ClockSyncService runs the clock sync handshake that calibrates a connection's latency
+// and clock offset, so client-timed actions can be judged fairly.
+type ClockSyncService interface {
+	Probe(connectionID string) string
+	Observe(connectionID, probe string, clientTime time.Time) (latency.Calibration, error)
+	Calibration(connectionID string) latency.Calibration
+	Forget(connectionID string)
+}
+
 // CharacterService defines the interface for character service operations.
 // This abstraction allows for easy testing and dependency injection.
 type CharacterService interface {
@@ -124,11 +170,32 @@ type ChunkService interface {
 	// GetOrCreateChunk retrieves an existing chunk or creates a new one
 	GetOrCreateChunk(ctx context.Context, chunkX, chunkY int32) (*chunkV1.ChunkData, error)
 
+	// GetOrCreateChunkInWorld retrieves or creates a chunk of an explicit world
+	GetOrCreateChunkInWorld(ctx context.Context, worldID pgtype.UUID, chunkX, chunkY int32) (*chunkV1.ChunkData, error)
+
 	// GetChunksInRange retrieves multiple chunks in a rectangular area
 	GetChunksInRange(ctx context.Context, minX, maxX, minY, maxY int32) ([]*chunkV1.ChunkData, error)
 
 	// GetChunksInRadius retrieves chunks in a circular area
 	GetChunksInRadius(ctx context.Context, centerX, centerY, radius int32) ([]*chunkV1.ChunkData, error)
+
+	// IsPassable checks a cell against its chunk's passability mask
+	IsPassable(ctx context.Context, worldID pgtype.UUID, x, y int32) (bool, error)
+
+	// Cells retrieves the terrain and passability of individual cells
+	Cells(ctx context.Context, points []geometry.Point) ([]*chunkV1.CellInfo, error)
+}
+
+// ChunkSummaryService defines the interface for the chunk summary read model.
+type ChunkSummaryService interface {
+	// GetChunkSummaries retrieves summaries for generated chunks in a rectangular area
+	GetChunkSummaries(ctx context.Context, worldID pgtype.UUID, minX, maxX, minY, maxY int32) ([]*chunkV1.ChunkSummary, error)
+}
+
+// RegionService defines the interface for looking up protected regions.
+type RegionService interface {
+	// RegionsInChunks retrieves the protected regions overlapping a rectangular chunk area
+	RegionsInChunks(ctx context.Context, worldID pgtype.UUID, minX, maxX, minY, maxY int32) ([]*chunkV1.ProtectedRegion, error)
 }
 
 // ResourceNodeService defines the interface for resource node service operations.
@@ -142,6 +209,15 @@ type ResourceNodeService interface {
 
 	// GetResourceNodeTypes returns all available resource node types
 	GetResourceNodeTypes(ctx context.Context) ([]*resourceNodeV1.ResourceNodeType, error)
+
+	// ReloadBalanceConfig re-reads the balance data file and returns details of the applied configuration
+	ReloadBalanceConfig(ctx context.Context) (*resourceNodeV1.ReloadBalanceConfigResponse, error)
+}
+
+// SpriteAtlasService serves the sprite atlas metadata
+type SpriteAtlasService interface {
+	// GetSpriteAtlas returns the atlas, or only its version when knownChecksum is current
+	GetSpriteAtlas(knownChecksum string) *resourceNodeV1.GetSpriteAtlasResponse
 }
 
 // TerrainService defines the interface for terrain service operations.
@@ -151,6 +227,13 @@ type TerrainService interface {
 	GetTerrainTypes(ctx context.Context) ([]*terrainV1.TerrainTypeInfo, error)
 }
 
+// ServiceInfoProvider reports the services registered on a gRPC server.
+// *grpc.Server satisfies this interface.
+type ServiceInfoProvider interface {
+	// GetServiceInfo returns registered service names mapped to their method info
+	GetServiceInfo() map[string]grpc.ServiceInfo
+}
+
 // LoggerInterface defines logging operations for dependency injection.
 // This abstraction allows for easy testing and different logging implementations.
 type LoggerInterface interface {
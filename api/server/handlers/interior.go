@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/internal/logging"
+	interiorV1 "github.com/VoidMesh/api/api/proto/interior/v1"
+	"github.com/charmbracelet/log"
+)
+
+// InteriorService defines the interface for structure interiors and their furniture
+type InteriorService interface {
+	Get(ctx context.Context, userID, characterID string, structureID int64) (*interiorV1.Interior, error)
+	PlaceFurniture(ctx context.Context, userID, characterID string, itemID, x, y int32) (*interiorV1.Furniture, error)
+	RemoveFurniture(ctx context.Context, userID, characterID string, furnitureID int64) (*interiorV1.Furniture, error)
+}
+
+// InteriorMovementService steps characters in and out of interiors. It is the movement
+// service, so transitions are validated like any other move.
+type InteriorMovementService interface {
+	EnterInterior(ctx context.Context, userID, characterID string, structureID int64) (*interiorV1.EnterInteriorResponse, error)
+	ExitInterior(ctx context.Context, userID, characterID string) (*interiorV1.ExitInteriorResponse, error)
+}
+
+type interiorServiceServer struct {
+	interiorV1.UnimplementedInteriorServiceServer
+	interiorService InteriorService
+	movement        InteriorMovementService
+	logger          *log.Logger
+}
+
+// NewInteriorServer creates the interior service handler
+func NewInteriorServer(interiorService InteriorService, movement InteriorMovementService) interiorV1.InteriorServiceServer {
+	logger := logging.WithComponent("interior-handler")
+	logger.Debug("Creating new InteriorService server instance")
+	return &interiorServiceServer{
+		interiorService: interiorService,
+		movement:        movement,
+		logger:          logger,
+	}
+}
+
+// GetInterior returns a structure's interior with its furniture
+func (s *interiorServiceServer) GetInterior(ctx context.Context, req *interiorV1.GetInteriorRequest) (*interiorV1.GetInteriorResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	interior, err := s.interiorService.Get(ctx, userID, req.CharacterId, req.StructureId)
+	if err != nil {
+		s.logger.Debug("Failed to get interior", "user_id", userID, "structure_id", req.StructureId, "error", err)
+		return nil, err
+	}
+	return &interiorV1.GetInteriorResponse{Interior: interior}, nil
+}
+
+// EnterInterior steps the caller's character into a structure next to it
+func (s *interiorServiceServer) EnterInterior(ctx context.Context, req *interiorV1.EnterInteriorRequest) (*interiorV1.EnterInteriorResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.movement.EnterInterior(ctx, userID, req.CharacterId, req.StructureId)
+	if err != nil {
+		s.logger.Debug("Failed to enter interior", "user_id", userID, "structure_id", req.StructureId, "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ExitInterior steps the caller's character out through the door
+func (s *interiorServiceServer) ExitInterior(ctx context.Context, req *interiorV1.ExitInteriorRequest) (*interiorV1.ExitInteriorResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.movement.ExitInterior(ctx, userID, req.CharacterId)
+	if err != nil {
+		s.logger.Debug("Failed to exit interior", "user_id", userID, "character_id", req.CharacterId, "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// PlaceFurniture places a furniture item in the interior the caller's character owns and is in
+func (s *interiorServiceServer) PlaceFurniture(ctx context.Context, req *interiorV1.PlaceFurnitureRequest) (*interiorV1.PlaceFurnitureResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	furniture, err := s.interiorService.PlaceFurniture(ctx, userID, req.CharacterId, req.ItemId, req.X, req.Y)
+	if err != nil {
+		s.logger.Debug("Failed to place furniture", "user_id", userID, "item_id", req.ItemId, "error", err)
+		return nil, err
+	}
+	return &interiorV1.PlaceFurnitureResponse{Furniture: furniture}, nil
+}
+
+// RemoveFurniture picks furniture back up into the character's inventory
+func (s *interiorServiceServer) RemoveFurniture(ctx context.Context, req *interiorV1.RemoveFurnitureRequest) (*interiorV1.RemoveFurnitureResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	furniture, err := s.interiorService.RemoveFurniture(ctx, userID, req.CharacterId, req.FurnitureId)
+	if err != nil {
+		s.logger.Debug("Failed to remove furniture", "user_id", userID, "furniture_id", req.FurnitureId, "error", err)
+		return nil, err
+	}
+	return &interiorV1.RemoveFurnitureResponse{Furniture: furniture}, nil
+}
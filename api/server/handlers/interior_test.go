@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/VoidMesh/api/api/internal/testutil"
+	interiorV1 "github.com/VoidMesh/api/api/proto/interior/v1"
+	"github.com/VoidMesh/api/api/server/middleware"
+	"github.com/charmbracelet/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeInteriorService records the user each call was made for
+type fakeInteriorService struct {
+	userID string
+}
+
+func (f *fakeInteriorService) Get(ctx context.Context, userID, characterID string, structureID int64) (*interiorV1.Interior, error) {
+	f.userID = userID
+	return &interiorV1.Interior{Id: 1, StructureId: structureID, Width: 10, Height: 7}, nil
+}
+
+func (f *fakeInteriorService) PlaceFurniture(ctx context.Context, userID, characterID string, itemID, x, y int32) (*interiorV1.Furniture, error) {
+	f.userID = userID
+	return &interiorV1.Furniture{Id: 3, ItemId: itemID, X: x, Y: y}, nil
+}
+
+func (f *fakeInteriorService) RemoveFurniture(ctx context.Context, userID, characterID string, furnitureID int64) (*interiorV1.Furniture, error) {
+	f.userID = userID
+	return &interiorV1.Furniture{Id: furnitureID}, nil
+}
+
+func (f *fakeInteriorService) EnterInterior(ctx context.Context, userID, characterID string, structureID int64) (*interiorV1.EnterInteriorResponse, error) {
+	f.userID = userID
+	return &interiorV1.EnterInteriorResponse{Position: &interiorV1.InteriorPosition{InteriorId: 1, X: 4, Y: 6}}, nil
+}
+
+func (f *fakeInteriorService) ExitInterior(ctx context.Context, userID, characterID string) (*interiorV1.ExitInteriorResponse, error) {
+	f.userID = userID
+	return &interiorV1.ExitInteriorResponse{X: 10, Y: 20}, nil
+}
+
+func TestInteriorServiceServer(t *testing.T) {
+	interiors := &fakeInteriorService{}
+	server := &interiorServiceServer{interiorService: interiors, movement: interiors, logger: log.New(io.Discard)}
+	ctx := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "player")
+	characterID := testutil.UUIDTestData.Character1
+
+	_, err := server.GetInterior(context.Background(), &interiorV1.GetInteriorRequest{CharacterId: characterID, StructureId: 7})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = server.EnterInterior(context.Background(), &interiorV1.EnterInteriorRequest{CharacterId: characterID, StructureId: 7})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = server.PlaceFurniture(context.Background(), &interiorV1.PlaceFurnitureRequest{CharacterId: characterID, ItemId: 21})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	got, err := server.GetInterior(ctx, &interiorV1.GetInteriorRequest{CharacterId: characterID, StructureId: 7})
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), got.Interior.StructureId)
+	assert.Equal(t, testutil.UUIDTestData.User1, interiors.userID)
+
+	entered, err := server.EnterInterior(ctx, &interiorV1.EnterInteriorRequest{CharacterId: characterID, StructureId: 7})
+	require.NoError(t, err)
+	assert.Equal(t, int32(6), entered.Position.Y)
+
+	placed, err := server.PlaceFurniture(ctx, &interiorV1.PlaceFurnitureRequest{CharacterId: characterID, ItemId: 21, X: 2, Y: 3})
+	require.NoError(t, err)
+	assert.Equal(t, int32(21), placed.Furniture.ItemId)
+
+	removed, err := server.RemoveFurniture(ctx, &interiorV1.RemoveFurnitureRequest{CharacterId: characterID, FurnitureId: 3})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), removed.Furniture.Id)
+
+	exited, err := server.ExitInterior(ctx, &interiorV1.ExitInteriorRequest{CharacterId: characterID})
+	require.NoError(t, err)
+	assert.Equal(t, int32(10), exited.X)
+}
@@ -45,6 +45,14 @@ func (s *inventoryServiceServer) GetCharacterInventory(ctx context.Context, req
 		return nil, status.Errorf(codes.InvalidArgument, "character_id is required")
 	}
 
+	if _, err := s.inventoryService.OwnedCharacter(ctx, userID, req.CharacterId); err != nil {
+		s.logger.Warn("Refused to get character inventory",
+			"user_id", userID,
+			"character_id", req.CharacterId,
+			"error", err)
+		return nil, err
+	}
+
 	// Call the inventory service
 	items, err := s.inventoryService.GetCharacterInventory(ctx, req.CharacterId)
 	if err != nil {
@@ -55,6 +63,8 @@ func (s *inventoryServiceServer) GetCharacterInventory(ctx context.Context, req
 		return nil, err // Let the service layer handle error codes
 	}
 
+	items = inventory.FilterInventory(items, req.Filter)
+
 	s.logger.Debug("Successfully retrieved character inventory",
 		"user_id", userID,
 		"character_id", req.CharacterId,
@@ -66,7 +76,8 @@ func (s *inventoryServiceServer) GetCharacterInventory(ctx context.Context, req
 	}, nil
 }
 
-// AddInventoryItem adds an item to character's inventory
+// AddInventoryItem adds an item to character's inventory (admin only). Players gain
+// items through gameplay, which delivers them by the world's overflow policy.
 func (s *inventoryServiceServer) AddInventoryItem(ctx context.Context, req *inventoryV1.AddInventoryItemRequest) (*inventoryV1.AddInventoryItemResponse, error) {
 	// Extract user ID from JWT context for authorization
 	userID, ok := middleware.GetUserIDFromContext(ctx)
@@ -74,6 +85,10 @@ func (s *inventoryServiceServer) AddInventoryItem(ctx context.Context, req *inve
 		s.logger.Warn("Failed to get user ID from context")
 		return nil, status.Errorf(codes.Unauthenticated, "authentication required")
 	}
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		s.logger.Warn("Non-admin attempted to add an inventory item", "user_id", userID, "character_id", req.CharacterId)
+		return nil, err
+	}
 
 	s.logger.Debug("Processing add inventory item request",
 		"user_id", userID,
@@ -136,6 +151,14 @@ func (s *inventoryServiceServer) RemoveInventoryItem(ctx context.Context, req *i
 		return nil, status.Errorf(codes.InvalidArgument, "quantity must be positive")
 	}
 
+	if _, err := s.inventoryService.OwnedCharacter(ctx, userID, req.CharacterId); err != nil {
+		s.logger.Warn("Refused to remove inventory item",
+			"user_id", userID,
+			"character_id", req.CharacterId,
+			"error", err)
+		return nil, err
+	}
+
 	// Call the inventory service
 	item, err := s.inventoryService.RemoveInventoryItem(ctx, req.CharacterId, req.ItemId, req.Quantity)
 	if err != nil {
@@ -186,4 +209,173 @@ func (s *inventoryServiceServer) UpdateItemQuantity(ctx context.Context, req *in
 	// For now, we don't have UpdateItemQuantity in the service, so we'll implement it using Add/Remove logic
 	// TODO: Add UpdateItemQuantity method to inventory service if needed
 	return nil, status.Errorf(codes.Unimplemented, "update item quantity not yet implemented")
-}
\ No newline at end of file
+}
+
+// SetInventoryItemFlags sets the favorite flag and tags of a stack
+func (s *inventoryServiceServer) SetInventoryItemFlags(ctx context.Context, req *inventoryV1.SetInventoryItemFlagsRequest) (*inventoryV1.SetInventoryItemFlagsResponse, error) {
+	// Extract user ID from JWT context for authorization
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		s.logger.Warn("Failed to get user ID from context")
+		return nil, status.Errorf(codes.Unauthenticated, "authentication required")
+	}
+
+	s.logger.Debug("Processing set inventory item flags request",
+		"user_id", userID,
+		"character_id", req.CharacterId,
+		"item_id", req.ItemId)
+
+	// Validate request
+	if req.CharacterId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "character_id is required")
+	}
+
+	if _, err := s.inventoryService.OwnedCharacter(ctx, userID, req.CharacterId); err != nil {
+		s.logger.Warn("Refused to set inventory item flags",
+			"user_id", userID,
+			"character_id", req.CharacterId,
+			"error", err)
+		return nil, err
+	}
+
+	item, err := s.inventoryService.SetItemFlags(ctx, req.CharacterId, req.ItemId, req.Favorite, req.Tags)
+	if err != nil {
+		s.logger.Warn("Failed to set inventory item flags",
+			"user_id", userID,
+			"character_id", req.CharacterId,
+			"item_id", req.ItemId,
+			"error", err)
+		return nil, err
+	}
+
+	return &inventoryV1.SetInventoryItemFlagsResponse{Item: item}, nil
+}
+
+// SortInventory stores a canonical ordering of a character's inventory
+func (s *inventoryServiceServer) SortInventory(ctx context.Context, req *inventoryV1.SortInventoryRequest) (*inventoryV1.SortInventoryResponse, error) {
+	// Extract user ID from JWT context for authorization
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		s.logger.Warn("Failed to get user ID from context")
+		return nil, status.Errorf(codes.Unauthenticated, "authentication required")
+	}
+
+	s.logger.Debug("Processing sort inventory request",
+		"user_id", userID,
+		"character_id", req.CharacterId,
+		"order", req.Order.String())
+
+	// Validate request
+	if req.CharacterId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "character_id is required")
+	}
+
+	if _, err := s.inventoryService.OwnedCharacter(ctx, userID, req.CharacterId); err != nil {
+		s.logger.Warn("Refused to sort inventory",
+			"user_id", userID,
+			"character_id", req.CharacterId,
+			"error", err)
+		return nil, err
+	}
+
+	items, err := s.inventoryService.SortInventory(ctx, req.CharacterId, req.Order, req.FavoritesFirst)
+	if err != nil {
+		s.logger.Warn("Failed to sort inventory",
+			"user_id", userID,
+			"character_id", req.CharacterId,
+			"error", err)
+		return nil, err
+	}
+
+	return &inventoryV1.SortInventoryResponse{Items: items}, nil
+}
+
+// ListGroundDrops lists the ground drops within reach of a character
+func (s *inventoryServiceServer) ListGroundDrops(ctx context.Context, req *inventoryV1.ListGroundDropsRequest) (*inventoryV1.ListGroundDropsResponse, error) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		s.logger.Warn("Failed to get user ID from context")
+		return nil, status.Errorf(codes.Unauthenticated, "authentication required")
+	}
+	if req.CharacterId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "character_id is required")
+	}
+
+	drops, err := s.inventoryService.ListGroundDrops(ctx, userID, req.CharacterId)
+	if err != nil {
+		s.logger.Warn("Failed to list ground drops",
+			"user_id", userID,
+			"character_id", req.CharacterId,
+			"error", err)
+		return nil, err
+	}
+	return &inventoryV1.ListGroundDropsResponse{Drops: drops}, nil
+}
+
+// PickUpGroundDrop moves a ground drop within reach into a character's inventory
+func (s *inventoryServiceServer) PickUpGroundDrop(ctx context.Context, req *inventoryV1.PickUpGroundDropRequest) (*inventoryV1.PickUpGroundDropResponse, error) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		s.logger.Warn("Failed to get user ID from context")
+		return nil, status.Errorf(codes.Unauthenticated, "authentication required")
+	}
+	if req.CharacterId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "character_id is required")
+	}
+
+	resp, err := s.inventoryService.PickUpGroundDrop(ctx, userID, req.CharacterId, req.DropId)
+	if err != nil {
+		s.logger.Warn("Failed to pick up ground drop",
+			"user_id", userID,
+			"character_id", req.CharacterId,
+			"drop_id", req.DropId,
+			"error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ListInboxItems lists the items held in a character's inbox
+func (s *inventoryServiceServer) ListInboxItems(ctx context.Context, req *inventoryV1.ListInboxItemsRequest) (*inventoryV1.ListInboxItemsResponse, error) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		s.logger.Warn("Failed to get user ID from context")
+		return nil, status.Errorf(codes.Unauthenticated, "authentication required")
+	}
+	if req.CharacterId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "character_id is required")
+	}
+
+	items, err := s.inventoryService.ListInboxItems(ctx, userID, req.CharacterId)
+	if err != nil {
+		s.logger.Warn("Failed to list inbox items",
+			"user_id", userID,
+			"character_id", req.CharacterId,
+			"error", err)
+		return nil, err
+	}
+	return &inventoryV1.ListInboxItemsResponse{Items: items}, nil
+}
+
+// ClaimInboxItem moves an inbox item into a character's inventory
+func (s *inventoryServiceServer) ClaimInboxItem(ctx context.Context, req *inventoryV1.ClaimInboxItemRequest) (*inventoryV1.ClaimInboxItemResponse, error) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		s.logger.Warn("Failed to get user ID from context")
+		return nil, status.Errorf(codes.Unauthenticated, "authentication required")
+	}
+	if req.CharacterId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "character_id is required")
+	}
+
+	resp, err := s.inventoryService.ClaimInboxItem(ctx, userID, req.CharacterId, req.InboxItemId)
+	if err != nil {
+		s.logger.Warn("Failed to claim inbox item",
+			"user_id", userID,
+			"character_id", req.CharacterId,
+			"inbox_item_id", req.InboxItemId,
+			"error", err)
+		return nil, err
+	}
+	return resp, nil
+}
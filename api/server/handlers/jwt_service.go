@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/VoidMesh/api/api/internal/clock"
 	"github.com/golang-jwt/jwt/v5"
 	"golang.org/x/crypto/bcrypt"
 )
@@ -15,6 +16,7 @@ import (
 // jwtService implements the JWTService interface
 type jwtService struct {
 	secret []byte
+	clock  clock.Clock
 }
 
 // NewJWTService creates a new JWT service with the provided secret
@@ -25,19 +27,25 @@ func NewJWTService(secret string) (JWTService, error) {
 
 	return &jwtService{
 		secret: []byte(secret),
+		clock:  clock.New(),
 	}, nil
 }
 
-// GenerateToken creates a JWT token for the given user
-func (j *jwtService) GenerateToken(userID string, username string) (string, error) {
+// GenerateToken creates a JWT token for the given user.
+// worldID binds the session to a world and is omitted from the claims when empty.
+func (j *jwtService) GenerateToken(userID string, username string, worldID string) (string, error) {
 	// Create the claims
+	now := j.clock.Now()
 	claims := jwt.MapClaims{
 		"user_id":  userID,
 		"username": username,
-		"exp":      time.Now().Add(time.Hour * 24 * 7).Unix(), // Token expires in 7 days
-		"iat":      time.Now().Unix(),
+		"exp":      now.Add(time.Hour * 24 * 7).Unix(), // Token expires in 7 days
+		"iat":      now.Unix(),
 		"iss":      "voidmesh-api",
 	}
+	if worldID != "" {
+		claims["world_id"] = worldID
+	}
 
 	// Create token with claims
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
@@ -59,7 +67,7 @@ func (j *jwtService) ValidateToken(tokenString string) (map[string]interface{},
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
 		return j.secret, nil
-	})
+	}, jwt.WithTimeFunc(j.clock.Now))
 
 	if err != nil {
 		return nil, err
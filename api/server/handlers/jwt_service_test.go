@@ -0,0 +1,33 @@
+package handlers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJWTService_TokenExpiryWithFakeClock(t *testing.T) {
+	fakeClock := clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	service, err := NewJWTService(testutil.TestJWTSecretKey)
+	require.NoError(t, err)
+	service.(*jwtService).clock = fakeClock
+
+	token, err := service.GenerateToken(testutil.UUIDTestData.User1, "testuser", "")
+	require.NoError(t, err)
+
+	claims, err := service.ValidateToken(token)
+	require.NoError(t, err)
+	assert.Equal(t, float64(fakeClock.Now().Unix()), claims["iat"])
+
+	fakeClock.Advance(7*24*time.Hour - time.Minute)
+	_, err = service.ValidateToken(token)
+	assert.NoError(t, err, "token is valid until the end of its 7 day lifetime")
+
+	fakeClock.Advance(2 * time.Minute)
+	_, err = service.ValidateToken(token)
+	assert.ErrorContains(t, err, "expired")
+}
@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/internal/logging"
+	landClaimV1 "github.com/VoidMesh/api/api/proto/land_claim/v1"
+	"github.com/charmbracelet/log"
+)
+
+// LandClaimService defines the interface for claiming chunks
+type LandClaimService interface {
+	ClaimChunk(ctx context.Context, userID, characterID string) (*landClaimV1.LandClaim, error)
+	ReleaseClaim(ctx context.Context, userID, characterID string, claimID int64) (*landClaimV1.LandClaim, error)
+	ListClaims(ctx context.Context, userID, characterID string) ([]*landClaimV1.LandClaim, error)
+	ClaimsInChunks(ctx context.Context, worldID string, minX, maxX, minY, maxY int32) ([]*landClaimV1.LandClaim, error)
+	Terms(ctx context.Context) (*landClaimV1.ClaimTerms, error)
+}
+
+type landClaimServiceServer struct {
+	landClaimV1.UnimplementedLandClaimServiceServer
+	claimService LandClaimService
+	logger       *log.Logger
+}
+
+// NewLandClaimServer creates the land claim service handler
+func NewLandClaimServer(claimService LandClaimService) landClaimV1.LandClaimServiceServer {
+	logger := logging.WithComponent("land-claim-handler")
+	logger.Debug("Creating new LandClaimService server instance")
+	return &landClaimServiceServer{
+		claimService: claimService,
+		logger:       logger,
+	}
+}
+
+// ClaimChunk claims the chunk the caller's character stands in
+func (s *landClaimServiceServer) ClaimChunk(ctx context.Context, req *landClaimV1.ClaimChunkRequest) (*landClaimV1.ClaimChunkResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claim, err := s.claimService.ClaimChunk(ctx, userID, req.CharacterId)
+	if err != nil {
+		s.logger.Debug("Failed to claim chunk", "user_id", userID, "character_id", req.CharacterId, "error", err)
+		return nil, err
+	}
+	return &landClaimV1.ClaimChunkResponse{Claim: claim}, nil
+}
+
+// ReleaseClaim gives up one of the character's claims
+func (s *landClaimServiceServer) ReleaseClaim(ctx context.Context, req *landClaimV1.ReleaseClaimRequest) (*landClaimV1.ReleaseClaimResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claim, err := s.claimService.ReleaseClaim(ctx, userID, req.CharacterId, req.ClaimId)
+	if err != nil {
+		s.logger.Debug("Failed to release claim", "user_id", userID, "claim_id", req.ClaimId, "error", err)
+		return nil, err
+	}
+	return &landClaimV1.ReleaseClaimResponse{Claim: claim}, nil
+}
+
+// ListClaims lists the character's claims along with what claims cost
+func (s *landClaimServiceServer) ListClaims(ctx context.Context, req *landClaimV1.ListClaimsRequest) (*landClaimV1.ListClaimsResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := s.claimService.ListClaims(ctx, userID, req.CharacterId)
+	if err != nil {
+		s.logger.Debug("Failed to list claims", "user_id", userID, "character_id", req.CharacterId, "error", err)
+		return nil, err
+	}
+	terms, err := s.claimService.Terms(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &landClaimV1.ListClaimsResponse{Claims: claims, Terms: terms}, nil
+}
+
+// GetClaimsInChunks lists the claims in a rectangle of chunks so clients can show owners
+func (s *landClaimServiceServer) GetClaimsInChunks(ctx context.Context, req *landClaimV1.GetClaimsInChunksRequest) (*landClaimV1.GetClaimsInChunksResponse, error) {
+	if _, err := authenticatedUser(ctx); err != nil {
+		return nil, err
+	}
+
+	claims, err := s.claimService.ClaimsInChunks(ctx, req.WorldId, req.MinChunkX, req.MaxChunkX, req.MinChunkY, req.MaxChunkY)
+	if err != nil {
+		s.logger.Debug("Failed to get claims in chunks", "error", err)
+		return nil, err
+	}
+	return &landClaimV1.GetClaimsInChunksResponse{Claims: claims}, nil
+}
@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/VoidMesh/api/api/internal/testutil"
+	landClaimV1 "github.com/VoidMesh/api/api/proto/land_claim/v1"
+	"github.com/VoidMesh/api/api/server/middleware"
+	"github.com/charmbracelet/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeLandClaimService records the user each call was made for
+type fakeLandClaimService struct {
+	userID string
+}
+
+func (f *fakeLandClaimService) ClaimChunk(ctx context.Context, userID, characterID string) (*landClaimV1.LandClaim, error) {
+	f.userID = userID
+	return &landClaimV1.LandClaim{Id: 1, CharacterId: characterID}, nil
+}
+
+func (f *fakeLandClaimService) ReleaseClaim(ctx context.Context, userID, characterID string, claimID int64) (*landClaimV1.LandClaim, error) {
+	f.userID = userID
+	return &landClaimV1.LandClaim{Id: claimID, CharacterId: characterID}, nil
+}
+
+func (f *fakeLandClaimService) ListClaims(ctx context.Context, userID, characterID string) ([]*landClaimV1.LandClaim, error) {
+	f.userID = userID
+	return []*landClaimV1.LandClaim{{Id: 1, CharacterId: characterID}}, nil
+}
+
+func (f *fakeLandClaimService) ClaimsInChunks(ctx context.Context, worldID string, minX, maxX, minY, maxY int32) ([]*landClaimV1.LandClaim, error) {
+	return []*landClaimV1.LandClaim{{Id: 1}, {Id: 2}}, nil
+}
+
+func (f *fakeLandClaimService) Terms(ctx context.Context) (*landClaimV1.ClaimTerms, error) {
+	return &landClaimV1.ClaimTerms{CurrencyItemId: 3, ClaimCost: 20}, nil
+}
+
+func TestLandClaimServiceServer(t *testing.T) {
+	claims := &fakeLandClaimService{}
+	server := &landClaimServiceServer{claimService: claims, logger: log.New(io.Discard)}
+	ctx := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "player")
+	characterID := testutil.UUIDTestData.Character1
+
+	_, err := server.ClaimChunk(context.Background(), &landClaimV1.ClaimChunkRequest{CharacterId: characterID})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = server.GetClaimsInChunks(context.Background(), &landClaimV1.GetClaimsInChunksRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	claimed, err := server.ClaimChunk(ctx, &landClaimV1.ClaimChunkRequest{CharacterId: characterID})
+	require.NoError(t, err)
+	assert.Equal(t, characterID, claimed.Claim.CharacterId)
+	assert.Equal(t, testutil.UUIDTestData.User1, claims.userID, "the user is taken from the caller")
+
+	listed, err := server.ListClaims(ctx, &landClaimV1.ListClaimsRequest{CharacterId: characterID})
+	require.NoError(t, err)
+	assert.Len(t, listed.Claims, 1)
+	assert.Equal(t, int32(20), listed.Terms.ClaimCost)
+
+	inChunks, err := server.GetClaimsInChunks(ctx, &landClaimV1.GetClaimsInChunksRequest{MaxChunkX: 1, MaxChunkY: 1})
+	require.NoError(t, err)
+	assert.Len(t, inChunks.Claims, 2)
+
+	released, err := server.ReleaseClaim(ctx, &landClaimV1.ReleaseClaimRequest{CharacterId: characterID, ClaimId: 1})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), released.Claim.Id)
+}
@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/internal/logging"
+	mailV1 "github.com/VoidMesh/api/api/proto/mail/v1"
+	"github.com/charmbracelet/log"
+)
+
+// MailService defines the interface for sending and receiving mail
+type MailService interface {
+	Send(ctx context.Context, userID string, req *mailV1.SendMailRequest) (*mailV1.Mail, error)
+	List(ctx context.Context, userID, characterID string) ([]*mailV1.Mail, error)
+	Claim(ctx context.Context, userID, characterID string, mailID int64) (*mailV1.Mail, error)
+	Delete(ctx context.Context, userID, characterID string, mailID int64) error
+}
+
+type mailServiceServer struct {
+	mailV1.UnimplementedMailServiceServer
+	mailService MailService
+	logger      *log.Logger
+}
+
+// NewMailServer creates the mail service handler
+func NewMailServer(mailService MailService) mailV1.MailServiceServer {
+	logger := logging.WithComponent("mail-handler")
+	logger.Debug("Creating new MailService server instance")
+	return &mailServiceServer{
+		mailService: mailService,
+		logger:      logger,
+	}
+}
+
+// SendMail mails items and currency from the caller's character to another character
+func (s *mailServiceServer) SendMail(ctx context.Context, req *mailV1.SendMailRequest) (*mailV1.SendMailResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mail, err := s.mailService.Send(ctx, userID, req)
+	if err != nil {
+		s.logger.Debug("Failed to send mail", "user_id", userID, "character_id", req.CharacterId, "error", err)
+		return nil, err
+	}
+	return &mailV1.SendMailResponse{Mail: mail}, nil
+}
+
+// ListMail lists the character's mail, newest first
+func (s *mailServiceServer) ListMail(ctx context.Context, req *mailV1.ListMailRequest) (*mailV1.ListMailResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mail, err := s.mailService.List(ctx, userID, req.CharacterId)
+	if err != nil {
+		s.logger.Debug("Failed to list mail", "user_id", userID, "character_id", req.CharacterId, "error", err)
+		return nil, err
+	}
+	return &mailV1.ListMailResponse{Mail: mail}, nil
+}
+
+// ClaimAttachments moves a mail's attachments into the character's inventory
+func (s *mailServiceServer) ClaimAttachments(ctx context.Context, req *mailV1.ClaimAttachmentsRequest) (*mailV1.ClaimAttachmentsResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mail, err := s.mailService.Claim(ctx, userID, req.CharacterId, req.MailId)
+	if err != nil {
+		s.logger.Debug("Failed to claim attachments", "user_id", userID, "mail_id", req.MailId, "error", err)
+		return nil, err
+	}
+	return &mailV1.ClaimAttachmentsResponse{Mail: mail}, nil
+}
+
+// DeleteMail deletes one of the character's mail
+func (s *mailServiceServer) DeleteMail(ctx context.Context, req *mailV1.DeleteMailRequest) (*mailV1.DeleteMailResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.mailService.Delete(ctx, userID, req.CharacterId, req.MailId); err != nil {
+		s.logger.Debug("Failed to delete mail", "user_id", userID, "mail_id", req.MailId, "error", err)
+		return nil, err
+	}
+	return &mailV1.DeleteMailResponse{}, nil
+}
@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/VoidMesh/api/api/internal/testutil"
+	mailV1 "github.com/VoidMesh/api/api/proto/mail/v1"
+	"github.com/VoidMesh/api/api/server/middleware"
+	"github.com/charmbracelet/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeMailService records the user each call was made for
+type fakeMailService struct {
+	userID  string
+	deleted int64
+}
+
+func (f *fakeMailService) Send(ctx context.Context, userID string, req *mailV1.SendMailRequest) (*mailV1.Mail, error) {
+	f.userID = userID
+	return &mailV1.Mail{Id: 1, SenderCharacterId: req.CharacterId, Subject: req.Subject}, nil
+}
+
+func (f *fakeMailService) List(ctx context.Context, userID, characterID string) ([]*mailV1.Mail, error) {
+	f.userID = userID
+	return []*mailV1.Mail{{Id: 2}, {Id: 1}}, nil
+}
+
+func (f *fakeMailService) Claim(ctx context.Context, userID, characterID string, mailID int64) (*mailV1.Mail, error) {
+	f.userID = userID
+	return &mailV1.Mail{Id: mailID}, nil
+}
+
+func (f *fakeMailService) Delete(ctx context.Context, userID, characterID string, mailID int64) error {
+	f.userID = userID
+	f.deleted = mailID
+	return nil
+}
+
+func TestMailServiceServer(t *testing.T) {
+	mail := &fakeMailService{}
+	server := &mailServiceServer{mailService: mail, logger: log.New(io.Discard)}
+	ctx := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "player")
+	characterID := testutil.UUIDTestData.Character1
+
+	_, err := server.ListMail(context.Background(), &mailV1.ListMailRequest{CharacterId: characterID})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	sent, err := server.SendMail(ctx, &mailV1.SendMailRequest{CharacterId: characterID, Subject: "Logs"})
+	require.NoError(t, err)
+	assert.Equal(t, "Logs", sent.Mail.Subject)
+	assert.Equal(t, testutil.UUIDTestData.User1, mail.userID, "the user is taken from the caller")
+
+	listed, err := server.ListMail(ctx, &mailV1.ListMailRequest{CharacterId: characterID})
+	require.NoError(t, err)
+	assert.Len(t, listed.Mail, 2)
+
+	claimed, err := server.ClaimAttachments(ctx, &mailV1.ClaimAttachmentsRequest{CharacterId: characterID, MailId: 2})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), claimed.Mail.Id)
+
+	_, err = server.DeleteMail(ctx, &mailV1.DeleteMailRequest{CharacterId: characterID, MailId: 2})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), mail.deleted)
+}
@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/internal/logging"
+	marketV1 "github.com/VoidMesh/api/api/proto/market/v1"
+	"github.com/charmbracelet/log"
+)
+
+// MarketService defines the interface for trading on the market
+type MarketService interface {
+	CreateListing(ctx context.Context, userID string, req *marketV1.CreateListingRequest) (*marketV1.CreateListingResponse, error)
+	Search(ctx context.Context, req *marketV1.SearchListingsRequest) ([]*marketV1.Listing, error)
+	MyListings(ctx context.Context, userID, characterID string) ([]*marketV1.Listing, error)
+	Buy(ctx context.Context, userID, characterID string, listingID int64) (*marketV1.Listing, error)
+	PriceHistory(ctx context.Context, worldID string, itemID, days int32) ([]*marketV1.PriceHistoryPoint, error)
+}
+
+type marketServiceServer struct {
+	marketV1.UnimplementedMarketServiceServer
+	marketService MarketService
+	logger        *log.Logger
+}
+
+// NewMarketServer creates the market service handler
+func NewMarketServer(marketService MarketService) marketV1.MarketServiceServer {
+	logger := logging.WithComponent("market-handler")
+	logger.Debug("Creating new MarketService server instance")
+	return &marketServiceServer{
+		marketService: marketService,
+		logger:        logger,
+	}
+}
+
+// CreateListing lists items from the caller's character for sale
+func (s *marketServiceServer) CreateListing(ctx context.Context, req *marketV1.CreateListingRequest) (*marketV1.CreateListingResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.marketService.CreateListing(ctx, userID, req)
+	if err != nil {
+		s.logger.Debug("Failed to create listing", "user_id", userID, "character_id", req.CharacterId, "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SearchListings returns a page of open listings, cheapest first
+func (s *marketServiceServer) SearchListings(ctx context.Context, req *marketV1.SearchListingsRequest) (*marketV1.SearchListingsResponse, error) {
+	if _, err := authenticatedUser(ctx); err != nil {
+		return nil, err
+	}
+
+	listings, err := s.marketService.Search(ctx, req)
+	if err != nil {
+		s.logger.Debug("Failed to search listings", "error", err)
+		return nil, err
+	}
+	return &marketV1.SearchListingsResponse{Listings: listings}, nil
+}
+
+// ListMyListings lists the character's own listings
+func (s *marketServiceServer) ListMyListings(ctx context.Context, req *marketV1.ListMyListingsRequest) (*marketV1.ListMyListingsResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	listings, err := s.marketService.MyListings(ctx, userID, req.CharacterId)
+	if err != nil {
+		s.logger.Debug("Failed to list listings", "user_id", userID, "character_id", req.CharacterId, "error", err)
+		return nil, err
+	}
+	return &marketV1.ListMyListingsResponse{Listings: listings}, nil
+}
+
+// BuyListing buys a whole listing for the caller's character
+func (s *marketServiceServer) BuyListing(ctx context.Context, req *marketV1.BuyListingRequest) (*marketV1.BuyListingResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	listing, err := s.marketService.Buy(ctx, userID, req.CharacterId, req.ListingId)
+	if err != nil {
+		s.logger.Debug("Failed to buy listing", "user_id", userID, "listing_id", req.ListingId, "error", err)
+		return nil, err
+	}
+	return &marketV1.BuyListingResponse{Listing: listing}, nil
+}
+
+// GetPriceHistory returns daily sale statistics for an item
+func (s *marketServiceServer) GetPriceHistory(ctx context.Context, req *marketV1.GetPriceHistoryRequest) (*marketV1.GetPriceHistoryResponse, error) {
+	if _, err := authenticatedUser(ctx); err != nil {
+		return nil, err
+	}
+
+	points, err := s.marketService.PriceHistory(ctx, req.WorldId, req.ItemId, req.Days)
+	if err != nil {
+		s.logger.Debug("Failed to get price history", "item_id", req.ItemId, "error", err)
+		return nil, err
+	}
+	return &marketV1.GetPriceHistoryResponse{Points: points}, nil
+}
@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/VoidMesh/api/api/internal/testutil"
+	marketV1 "github.com/VoidMesh/api/api/proto/market/v1"
+	"github.com/VoidMesh/api/api/server/middleware"
+	"github.com/charmbracelet/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeMarketService records the user each call was made for
+type fakeMarketService struct {
+	userID string
+}
+
+func (f *fakeMarketService) CreateListing(ctx context.Context, userID string, req *marketV1.CreateListingRequest) (*marketV1.CreateListingResponse, error) {
+	f.userID = userID
+	return &marketV1.CreateListingResponse{Listing: &marketV1.Listing{Id: 1, SellerCharacterId: req.CharacterId, Quantity: req.Quantity}, ListingFee: 1}, nil
+}
+
+func (f *fakeMarketService) Search(ctx context.Context, req *marketV1.SearchListingsRequest) ([]*marketV1.Listing, error) {
+	return []*marketV1.Listing{{Id: 1}, {Id: 2}}, nil
+}
+
+func (f *fakeMarketService) MyListings(ctx context.Context, userID, characterID string) ([]*marketV1.Listing, error) {
+	f.userID = userID
+	return []*marketV1.Listing{{Id: 1, SellerCharacterId: characterID}}, nil
+}
+
+func (f *fakeMarketService) Buy(ctx context.Context, userID, characterID string, listingID int64) (*marketV1.Listing, error) {
+	f.userID = userID
+	return &marketV1.Listing{Id: listingID}, nil
+}
+
+func (f *fakeMarketService) PriceHistory(ctx context.Context, worldID string, itemID, days int32) ([]*marketV1.PriceHistoryPoint, error) {
+	return []*marketV1.PriceHistoryPoint{{Sales: 1}}, nil
+}
+
+func TestMarketServiceServer(t *testing.T) {
+	market := &fakeMarketService{}
+	server := &marketServiceServer{marketService: market, logger: log.New(io.Discard)}
+	ctx := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "player")
+	characterID := testutil.UUIDTestData.Character1
+
+	_, err := server.SearchListings(context.Background(), &marketV1.SearchListingsRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = server.BuyListing(context.Background(), &marketV1.BuyListingRequest{CharacterId: characterID, ListingId: 1})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	created, err := server.CreateListing(ctx, &marketV1.CreateListingRequest{CharacterId: characterID, ItemId: 1, Quantity: 3, UnitPrice: 2})
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), created.Listing.Quantity)
+	assert.Equal(t, int32(1), created.ListingFee)
+	assert.Equal(t, testutil.UUIDTestData.User1, market.userID, "the user is taken from the caller")
+
+	searched, err := server.SearchListings(ctx, &marketV1.SearchListingsRequest{})
+	require.NoError(t, err)
+	assert.Len(t, searched.Listings, 2)
+
+	mine, err := server.ListMyListings(ctx, &marketV1.ListMyListingsRequest{CharacterId: characterID})
+	require.NoError(t, err)
+	assert.Len(t, mine.Listings, 1)
+
+	bought, err := server.BuyListing(ctx, &marketV1.BuyListingRequest{CharacterId: characterID, ListingId: 2})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), bought.Listing.Id)
+
+	history, err := server.GetPriceHistory(ctx, &marketV1.GetPriceHistoryRequest{ItemId: 1})
+	require.NoError(t, err)
+	assert.Len(t, history.Points, 1)
+}
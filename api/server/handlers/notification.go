@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/streamsession"
+	notificationV1 "github.com/VoidMesh/api/api/proto/notification/v1"
+	streamV1 "github.com/VoidMesh/api/api/proto/stream/v1"
+	"github.com/charmbracelet/log"
+)
+
+// NotificationService defines the interface for the notification inbox
+type NotificationService interface {
+	ListNotifications(ctx context.Context, userID string, beforeID int64, limit int32, unreadOnly bool) ([]*notificationV1.Notification, int64, error)
+	MarkRead(ctx context.Context, userID string, ids []int64, all bool) (int64, error)
+	SubscribeNotifications(ctx context.Context, userID string) (<-chan *notificationV1.Notification, func(), error)
+	ListAnnouncements(ctx context.Context) ([]*notificationV1.Announcement, error)
+	AnnouncementNotifications(ctx context.Context) ([]*notificationV1.Notification, error)
+}
+
+type notificationServiceServer struct {
+	notificationV1.UnimplementedNotificationServiceServer
+	notificationService NotificationService
+	streams             *streamsession.Registry[*notificationV1.Notification]
+	logger              *log.Logger
+}
+
+// NewNotificationServer creates the notification service handler
+func NewNotificationServer(notificationService NotificationService) notificationV1.NotificationServiceServer {
+	logger := logging.WithComponent("notification-handler")
+	logger.Debug("Creating new NotificationService server instance")
+	return &notificationServiceServer{
+		notificationService: notificationService,
+		streams: streamsession.NewRegistry(func(n *notificationV1.Notification, info *streamV1.StreamInfo) {
+			n.Stream = info
+		}),
+		logger: logger,
+	}
+}
+
+// ListNotifications pages through the caller's inbox
+func (s *notificationServiceServer) ListNotifications(ctx context.Context, req *notificationV1.ListNotificationsRequest) (*notificationV1.ListNotificationsResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	notifications, unread, err := s.notificationService.ListNotifications(ctx, userID, req.BeforeId, req.Limit, req.UnreadOnly)
+	if err != nil {
+		s.logger.Error("Failed to list notifications", "user_id", userID, "error", err)
+		return nil, err
+	}
+	return &notificationV1.ListNotificationsResponse{Notifications: notifications, UnreadCount: unread}, nil
+}
+
+// MarkNotificationsRead marks some or all of the caller's notifications read
+func (s *notificationServiceServer) MarkNotificationsRead(ctx context.Context, req *notificationV1.MarkNotificationsReadRequest) (*notificationV1.MarkNotificationsReadResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	marked, err := s.notificationService.MarkRead(ctx, userID, req.NotificationIds, req.All)
+	if err != nil {
+		s.logger.Debug("Failed to mark notifications read", "user_id", userID, "error", err)
+		return nil, err
+	}
+	return &notificationV1.MarkNotificationsReadResponse{Marked: marked}, nil
+}
+
+// StreamNotifications streams new notifications until the client disconnects
+func (s *notificationServiceServer) StreamNotifications(req *notificationV1.StreamNotificationsRequest, stream notificationV1.NotificationService_StreamNotificationsServer) error {
+	ctx := stream.Context()
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	logger := s.logger.With("operation", "StreamNotifications", "user_id", userID)
+
+	notifications, unsubscribe, err := s.notificationService.SubscribeNotifications(ctx, userID)
+	if err != nil {
+		logger.Warn("Failed to subscribe to notifications", "error", err)
+		return err
+	}
+	defer unsubscribe()
+
+	session, err := openStream(s.streams, userID, req.Resume, stream.Send)
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	// Subscribing first means an announcement made meanwhile is sent twice rather than missed
+	announcements, err := s.notificationService.AnnouncementNotifications(ctx)
+	if err != nil {
+		logger.Warn("Failed to get announcements", "error", err)
+		return err
+	}
+	for _, announcement := range announcements {
+		if err := stream.Send(session.Stamp(announcement)); err != nil {
+			return err
+		}
+	}
+	heartbeat := time.NewTicker(streamsession.HeartbeatInterval)
+	defer heartbeat.Stop()
+
+	logger.Debug("Notification stream opened", "announcements", len(announcements), "stream_id", session.ID())
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Debug("Notification stream closed")
+			return nil
+		case <-heartbeat.C:
+			if err := stream.Send(session.Heartbeat()); err != nil {
+				return err
+			}
+		case notification, ok := <-notifications:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(session.Stamp(notification)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// ListAnnouncements lists the server announcements that have not expired
+func (s *notificationServiceServer) ListAnnouncements(ctx context.Context, req *notificationV1.ListAnnouncementsRequest) (*notificationV1.ListAnnouncementsResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	announcements, err := s.notificationService.ListAnnouncements(ctx)
+	if err != nil {
+		s.logger.Error("Failed to list announcements", "user_id", userID, "error", err)
+		return nil, err
+	}
+	return &notificationV1.ListAnnouncementsResponse{Announcements: announcements}, nil
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/VoidMesh/api/api/internal/testutil"
+	notificationV1 "github.com/VoidMesh/api/api/proto/notification/v1"
+	streamV1 "github.com/VoidMesh/api/api/proto/stream/v1"
+	"github.com/VoidMesh/api/api/server/middleware"
+	"github.com/charmbracelet/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeNotificationService returns a fixed inbox and records mark-read calls
+type fakeNotificationService struct {
+	markedAll bool
+}
+
+func (f *fakeNotificationService) ListNotifications(ctx context.Context, userID string, beforeID int64, limit int32, unreadOnly bool) ([]*notificationV1.Notification, int64, error) {
+	return []*notificationV1.Notification{{Id: 1, Title: "Quest complete"}}, 1, nil
+}
+
+func (f *fakeNotificationService) MarkRead(ctx context.Context, userID string, ids []int64, all bool) (int64, error) {
+	f.markedAll = all
+	return 1, nil
+}
+
+func (f *fakeNotificationService) SubscribeNotifications(ctx context.Context, userID string) (<-chan *notificationV1.Notification, func(), error) {
+	ch := make(chan *notificationV1.Notification)
+	close(ch)
+	return ch, func() {}, nil
+}
+
+func (f *fakeNotificationService) ListAnnouncements(ctx context.Context) ([]*notificationV1.Announcement, error) {
+	return []*notificationV1.Announcement{{Id: 1, Message: "Welcome!"}}, nil
+}
+
+func (f *fakeNotificationService) AnnouncementNotifications(ctx context.Context) ([]*notificationV1.Notification, error) {
+	return []*notificationV1.Notification{{Type: notificationV1.NotificationType_NOTIFICATION_TYPE_ANNOUNCEMENT, Body: "Welcome!"}}, nil
+}
+
+func TestNotificationServiceServer(t *testing.T) {
+	notifications := &fakeNotificationService{}
+	server := &notificationServiceServer{notificationService: notifications, logger: log.New(io.Discard)}
+	ctx := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "player")
+
+	_, err := server.ListNotifications(context.Background(), &notificationV1.ListNotificationsRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	listed, err := server.ListNotifications(ctx, &notificationV1.ListNotificationsRequest{})
+	require.NoError(t, err)
+	assert.Len(t, listed.Notifications, 1)
+	assert.Equal(t, int64(1), listed.UnreadCount)
+
+	marked, err := server.MarkNotificationsRead(ctx, &notificationV1.MarkNotificationsReadRequest{All: true})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), marked.Marked)
+	assert.True(t, notifications.markedAll)
+
+	announcements, err := server.ListAnnouncements(ctx, &notificationV1.ListAnnouncementsRequest{})
+	require.NoError(t, err)
+	assert.Len(t, announcements.Announcements, 1)
+}
+
+// recordingNotificationStream collects what the handler sends
+type recordingNotificationStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*notificationV1.Notification
+}
+
+func (s *recordingNotificationStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *recordingNotificationStream) Send(n *notificationV1.Notification) error {
+	s.sent = append(s.sent, n)
+	return nil
+}
+
+func TestNotificationServiceServer_StreamSendsAnnouncements(t *testing.T) {
+	server := NewNotificationServer(&fakeNotificationService{})
+	ctx := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "player")
+	stream := &recordingNotificationStream{ctx: ctx}
+
+	require.NoError(t, server.StreamNotifications(&notificationV1.StreamNotificationsRequest{}, stream))
+	require.Len(t, stream.sent, 2)
+	opening := stream.sent[0].Stream
+	assert.True(t, opening.Heartbeat, "the stream opens with a heartbeat carrying its ID")
+	assert.NotEmpty(t, opening.StreamId)
+	assert.Equal(t, notificationV1.NotificationType_NOTIFICATION_TYPE_ANNOUNCEMENT, stream.sent[1].Type)
+	assert.Equal(t, uint64(1), stream.sent[1].Stream.Sequence)
+
+	// Resuming without acknowledging the announcement sends it again before the new copy
+	resumed := &recordingNotificationStream{ctx: ctx}
+	require.NoError(t, server.StreamNotifications(&notificationV1.StreamNotificationsRequest{
+		Resume: &streamV1.StreamResume{StreamId: opening.StreamId},
+	}, resumed))
+	require.Len(t, resumed.sent, 3)
+	assert.Equal(t, opening.StreamId, resumed.sent[0].Stream.StreamId)
+	assert.False(t, resumed.sent[0].Stream.Restarted)
+	assert.Equal(t, []uint64{1, 2}, []uint64{resumed.sent[1].Stream.Sequence, resumed.sent[2].Stream.Sequence})
+}
@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/internal/logging"
+	processingV1 "github.com/VoidMesh/api/api/proto/processing/v1"
+	"github.com/charmbracelet/log"
+)
+
+// ProcessingService defines the interface for stations and processing jobs
+type ProcessingService interface {
+	Recipes(ctx context.Context) (*processingV1.ListRecipesResponse, error)
+	PlaceStation(ctx context.Context, userID, characterID, stationType string) (*processingV1.Station, error)
+	StartProcessing(ctx context.Context, userID, characterID, recipeID string, stationID int64) (*processingV1.ProcessingJob, error)
+	ListJobs(ctx context.Context, userID, characterID string) ([]*processingV1.ProcessingJob, error)
+	Collect(ctx context.Context, userID, characterID string, jobID int64) ([]*processingV1.ItemQuantity, error)
+}
+
+type processingServiceServer struct {
+	processingV1.UnimplementedProcessingServiceServer
+	processingService ProcessingService
+	logger            *log.Logger
+}
+
+// NewProcessingServer creates the processing service handler
+func NewProcessingServer(processingService ProcessingService) processingV1.ProcessingServiceServer {
+	logger := logging.WithComponent("processing-handler")
+	logger.Debug("Creating new ProcessingService server instance")
+	return &processingServiceServer{
+		processingService: processingService,
+		logger:            logger,
+	}
+}
+
+// ListRecipes lists the recipes and the stations they are made at
+func (s *processingServiceServer) ListRecipes(ctx context.Context, req *processingV1.ListRecipesRequest) (*processingV1.ListRecipesResponse, error) {
+	if _, err := authenticatedUser(ctx); err != nil {
+		return nil, err
+	}
+	return s.processingService.Recipes(ctx)
+}
+
+// PlaceStation builds a station where the caller's character stands
+func (s *processingServiceServer) PlaceStation(ctx context.Context, req *processingV1.PlaceStationRequest) (*processingV1.PlaceStationResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	station, err := s.processingService.PlaceStation(ctx, userID, req.CharacterId, req.StationType)
+	if err != nil {
+		s.logger.Debug("Failed to place station", "user_id", userID, "station_type", req.StationType, "error", err)
+		return nil, err
+	}
+	return &processingV1.PlaceStationResponse{Station: station}, nil
+}
+
+// StartProcessing starts a recipe at a nearby station
+func (s *processingServiceServer) StartProcessing(ctx context.Context, req *processingV1.StartProcessingRequest) (*processingV1.StartProcessingResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := s.processingService.StartProcessing(ctx, userID, req.CharacterId, req.RecipeId, req.StationEntityId)
+	if err != nil {
+		s.logger.Debug("Failed to start processing", "user_id", userID, "recipe_id", req.RecipeId, "error", err)
+		return nil, err
+	}
+	return &processingV1.StartProcessingResponse{Job: job}, nil
+}
+
+// ListProcessingJobs lists the character's jobs
+func (s *processingServiceServer) ListProcessingJobs(ctx context.Context, req *processingV1.ListProcessingJobsRequest) (*processingV1.ListProcessingJobsResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs, err := s.processingService.ListJobs(ctx, userID, req.CharacterId)
+	if err != nil {
+		s.logger.Debug("Failed to list processing jobs", "user_id", userID, "character_id", req.CharacterId, "error", err)
+		return nil, err
+	}
+	return &processingV1.ListProcessingJobsResponse{Jobs: jobs}, nil
+}
+
+// CollectProcessingJob moves a ready job's outputs into the character's inventory
+func (s *processingServiceServer) CollectProcessingJob(ctx context.Context, req *processingV1.CollectProcessingJobRequest) (*processingV1.CollectProcessingJobResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.processingService.Collect(ctx, userID, req.CharacterId, req.JobId)
+	if err != nil {
+		s.logger.Debug("Failed to collect processing job", "user_id", userID, "job_id", req.JobId, "error", err)
+		return nil, err
+	}
+	return &processingV1.CollectProcessingJobResponse{Items: items}, nil
+}
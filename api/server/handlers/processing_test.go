@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/VoidMesh/api/api/internal/testutil"
+	processingV1 "github.com/VoidMesh/api/api/proto/processing/v1"
+	"github.com/VoidMesh/api/api/server/middleware"
+	"github.com/charmbracelet/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeProcessingService records the user each call was made for
+type fakeProcessingService struct {
+	userID string
+}
+
+func (f *fakeProcessingService) Recipes(ctx context.Context) (*processingV1.ListRecipesResponse, error) {
+	return &processingV1.ListRecipesResponse{Recipes: []*processingV1.Recipe{{Id: "glass"}}}, nil
+}
+
+func (f *fakeProcessingService) PlaceStation(ctx context.Context, userID, characterID, stationType string) (*processingV1.Station, error) {
+	f.userID = userID
+	return &processingV1.Station{EntityId: 1, Type: stationType, OwnerCharacterId: characterID}, nil
+}
+
+func (f *fakeProcessingService) StartProcessing(ctx context.Context, userID, characterID, recipeID string, stationID int64) (*processingV1.ProcessingJob, error) {
+	f.userID = userID
+	return &processingV1.ProcessingJob{Id: 1, RecipeId: recipeID, StationEntityId: stationID}, nil
+}
+
+func (f *fakeProcessingService) ListJobs(ctx context.Context, userID, characterID string) ([]*processingV1.ProcessingJob, error) {
+	f.userID = userID
+	return []*processingV1.ProcessingJob{{Id: 1, CharacterId: characterID}}, nil
+}
+
+func (f *fakeProcessingService) Collect(ctx context.Context, userID, characterID string, jobID int64) ([]*processingV1.ItemQuantity, error) {
+	f.userID = userID
+	return []*processingV1.ItemQuantity{{ItemId: 4, Quantity: 2}}, nil
+}
+
+func TestProcessingServiceServer(t *testing.T) {
+	processing := &fakeProcessingService{}
+	server := &processingServiceServer{processingService: processing, logger: log.New(io.Discard)}
+	ctx := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "player")
+	characterID := testutil.UUIDTestData.Character1
+
+	_, err := server.ListRecipes(context.Background(), &processingV1.ListRecipesRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = server.StartProcessing(context.Background(), &processingV1.StartProcessingRequest{CharacterId: characterID})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	recipes, err := server.ListRecipes(ctx, &processingV1.ListRecipesRequest{})
+	require.NoError(t, err)
+	assert.Len(t, recipes.Recipes, 1)
+
+	placed, err := server.PlaceStation(ctx, &processingV1.PlaceStationRequest{CharacterId: characterID, StationType: "campfire"})
+	require.NoError(t, err)
+	assert.Equal(t, "campfire", placed.Station.Type)
+	assert.Equal(t, testutil.UUIDTestData.User1, processing.userID, "the user is taken from the caller")
+
+	started, err := server.StartProcessing(ctx, &processingV1.StartProcessingRequest{CharacterId: characterID, RecipeId: "glass", StationEntityId: 1})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), started.Job.StationEntityId)
+
+	jobs, err := server.ListProcessingJobs(ctx, &processingV1.ListProcessingJobsRequest{CharacterId: characterID})
+	require.NoError(t, err)
+	assert.Len(t, jobs.Jobs, 1)
+
+	collected, err := server.CollectProcessingJob(ctx, &processingV1.CollectProcessingJobRequest{CharacterId: characterID, JobId: 1})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), collected.Items[0].Quantity)
+}
@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/internal/logging"
+	rareEventV1 "github.com/VoidMesh/api/api/proto/rare_event/v1"
+	"github.com/charmbracelet/log"
+)
+
+// RareEventService defines the interface for rare events
+type RareEventService interface {
+	List(ctx context.Context, worldID string) ([]*rareEventV1.RareEvent, error)
+	Contribute(ctx context.Context, userID, characterID string, eventID int64) (*rareEventV1.RareEvent, int32, error)
+}
+
+type rareEventServiceServer struct {
+	rareEventV1.UnimplementedRareEventServiceServer
+	eventService RareEventService
+	logger       *log.Logger
+}
+
+// NewRareEventServer creates the rare event service handler
+func NewRareEventServer(eventService RareEventService) rareEventV1.RareEventServiceServer {
+	logger := logging.WithComponent("rare-event-handler")
+	logger.Debug("Creating new RareEventService server instance")
+	return &rareEventServiceServer{
+		eventService: eventService,
+		logger:       logger,
+	}
+}
+
+// ListRareEvents lists the events in progress in a world
+func (s *rareEventServiceServer) ListRareEvents(ctx context.Context, req *rareEventV1.ListRareEventsRequest) (*rareEventV1.ListRareEventsResponse, error) {
+	if _, err := authenticatedUser(ctx); err != nil {
+		return nil, err
+	}
+
+	events, err := s.eventService.List(ctx, req.WorldId)
+	if err != nil {
+		s.logger.Debug("Failed to list rare events", "world_id", req.WorldId, "error", err)
+		return nil, err
+	}
+	return &rareEventV1.ListRareEventsResponse{Events: events}, nil
+}
+
+// ContributeToRareEvent contributes to an event the caller's character stands next to
+func (s *rareEventServiceServer) ContributeToRareEvent(ctx context.Context, req *rareEventV1.ContributeToRareEventRequest) (*rareEventV1.ContributeToRareEventResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	event, contribution, err := s.eventService.Contribute(ctx, userID, req.CharacterId, req.RareEventId)
+	if err != nil {
+		s.logger.Debug("Failed to contribute to rare event", "user_id", userID, "rare_event_id", req.RareEventId, "error", err)
+		return nil, err
+	}
+	return &rareEventV1.ContributeToRareEventResponse{Event: event, Contribution: contribution}, nil
+}
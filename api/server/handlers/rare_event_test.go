@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/VoidMesh/api/api/internal/testutil"
+	rareEventV1 "github.com/VoidMesh/api/api/proto/rare_event/v1"
+	"github.com/VoidMesh/api/api/server/middleware"
+	"github.com/charmbracelet/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeRareEventService records the user each contribution was made for
+type fakeRareEventService struct {
+	userID string
+}
+
+func (f *fakeRareEventService) List(ctx context.Context, worldID string) ([]*rareEventV1.RareEvent, error) {
+	return []*rareEventV1.RareEvent{{Id: 1, WorldId: worldID}}, nil
+}
+
+func (f *fakeRareEventService) Contribute(ctx context.Context, userID, characterID string, eventID int64) (*rareEventV1.RareEvent, int32, error) {
+	f.userID = userID
+	return &rareEventV1.RareEvent{Id: eventID, Progress: 1}, 1, nil
+}
+
+func TestRareEventServiceServer(t *testing.T) {
+	events := &fakeRareEventService{}
+	server := &rareEventServiceServer{eventService: events, logger: log.New(io.Discard)}
+	ctx := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "player")
+
+	_, err := server.ListRareEvents(context.Background(), &rareEventV1.ListRareEventsRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = server.ContributeToRareEvent(context.Background(), &rareEventV1.ContributeToRareEventRequest{RareEventId: 1})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	listed, err := server.ListRareEvents(ctx, &rareEventV1.ListRareEventsRequest{WorldId: testutil.UUIDTestData.World1})
+	require.NoError(t, err)
+	require.Len(t, listed.Events, 1)
+	assert.Equal(t, testutil.UUIDTestData.World1, listed.Events[0].WorldId)
+
+	contributed, err := server.ContributeToRareEvent(ctx, &rareEventV1.ContributeToRareEventRequest{CharacterId: testutil.UUIDTestData.Character1, RareEventId: 7})
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), contributed.Event.Id)
+	assert.Equal(t, int32(1), contributed.Contribution)
+	assert.Equal(t, testutil.UUIDTestData.User1, events.userID, "the user is taken from the caller")
+}
@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/internal/logging"
+	referenceV1 "github.com/VoidMesh/api/api/proto/reference/v1"
+	"github.com/charmbracelet/log"
+)
+
+// ReferenceService defines the interface for batch ID resolution
+type ReferenceService interface {
+	ResolveReferences(ctx context.Context, userID string, req *referenceV1.ResolveReferencesRequest) (*referenceV1.ResolveReferencesResponse, error)
+}
+
+type referenceServiceServer struct {
+	referenceV1.UnimplementedReferenceServiceServer
+	referenceService ReferenceService
+	logger           *log.Logger
+}
+
+// NewReferenceServer creates the reference service handler
+func NewReferenceServer(referenceService ReferenceService) referenceV1.ReferenceServiceServer {
+	logger := logging.WithComponent("reference-handler")
+	logger.Debug("Creating new ReferenceService server instance")
+	return &referenceServiceServer{
+		referenceService: referenceService,
+		logger:           logger,
+	}
+}
+
+// ResolveReferences maps the requested IDs to display summaries for the caller
+func (s *referenceServiceServer) ResolveReferences(ctx context.Context, req *referenceV1.ResolveReferencesRequest) (*referenceV1.ResolveReferencesResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.referenceService.ResolveReferences(ctx, userID, req)
+	if err != nil {
+		s.logger.Debug("Failed to resolve references", "user_id", userID, "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/VoidMesh/api/api/internal/testutil"
+	referenceV1 "github.com/VoidMesh/api/api/proto/reference/v1"
+	"github.com/VoidMesh/api/api/server/middleware"
+	"github.com/charmbracelet/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeReferenceService records the user each call was made for
+type fakeReferenceService struct {
+	userID string
+}
+
+func (f *fakeReferenceService) ResolveReferences(ctx context.Context, userID string, req *referenceV1.ResolveReferencesRequest) (*referenceV1.ResolveReferencesResponse, error) {
+	f.userID = userID
+	resp := &referenceV1.ResolveReferencesResponse{}
+	for _, id := range req.ItemIds {
+		resp.Items = append(resp.Items, &referenceV1.ItemSummary{Id: id})
+	}
+	return resp, nil
+}
+
+func TestReferenceServiceServer(t *testing.T) {
+	reference := &fakeReferenceService{}
+	server := &referenceServiceServer{referenceService: reference, logger: log.New(io.Discard)}
+
+	_, err := server.ResolveReferences(context.Background(), &referenceV1.ResolveReferencesRequest{ItemIds: []int32{1}})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	ctx := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "player")
+	resp, err := server.ResolveReferences(ctx, &referenceV1.ResolveReferencesRequest{ItemIds: []int32{1, 2}})
+	require.NoError(t, err)
+	assert.Len(t, resp.Items, 2)
+	assert.Equal(t, testutil.UUIDTestData.User1, reference.userID, "the user is taken from the caller")
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 
 	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/pkg/uuidutil"
 	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
 	resourceNodeV1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
+	"github.com/VoidMesh/api/api/server/middleware"
 	"github.com/charmbracelet/log"
 	"github.com/jackc/pgx/v5/pgtype"
-	"github.com/jackc/pgx/v5/pgxpool"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -18,6 +20,7 @@ type ResourceNodeHandler struct {
 	resourceNodeV1.UnimplementedResourceNodeServiceServer
 	resourceNodeService ResourceNodeService
 	worldService        WorldService
+	spriteAtlas         SpriteAtlasService
 	logger              *log.Logger
 }
 
@@ -37,7 +40,7 @@ func NewResourceNodeHandler(
 
 // NewResourceNodeHandlerWithPool creates a resource node handler with all dependencies wired up
 // This function maintains backward compatibility while providing dependency injection
-func NewResourceNodeHandlerWithPool(dbPool *pgxpool.Pool) (*ResourceNodeHandler, error) {
+func NewResourceNodeHandlerWithPool(dbPool storage.Pool) (*ResourceNodeHandler, error) {
 	logger := logging.WithComponent("resource-node-handler")
 	logger.Debug("Creating ResourceNodeHandler with dependency injection")
 
@@ -58,6 +61,11 @@ func NewResourceNodeHandlerWithPool(dbPool *pgxpool.Pool) (*ResourceNodeHandler,
 	return NewResourceNodeHandler(resourceNodeService, worldService), nil
 }
 
+// SetSpriteAtlas enables GetSpriteAtlas
+func (h *ResourceNodeHandler) SetSpriteAtlas(spriteAtlas SpriteAtlasService) {
+	h.spriteAtlas = spriteAtlas
+}
+
 // GetResourcesInChunk retrieves all resource nodes in a specific chunk
 func (h *ResourceNodeHandler) GetResourcesInChunk(ctx context.Context, req *resourceNodeV1.GetResourcesInChunkRequest) (*resourceNodeV1.GetResourcesInChunkResponse, error) {
 	logger := h.logger.With("operation", "GetResourcesInChunk", "chunk_x", req.ChunkX, "chunk_y", req.ChunkY)
@@ -75,7 +83,8 @@ func (h *ResourceNodeHandler) GetResourcesInChunk(ctx context.Context, req *reso
 		}
 		worldID = defaultWorld.ID
 	} else {
-		err := worldID.Scan(string(req.WorldId))
+		var err error
+		worldID, err = uuidutil.Decode(req.WorldId)
 		if err != nil {
 			logger.Warn("Invalid world ID format", "world_id", req.WorldId, "error", err)
 			return nil, status.Errorf(codes.InvalidArgument, "Invalid world ID: %v", err)
@@ -107,7 +116,8 @@ func (h *ResourceNodeHandler) GetResourcesInChunks(ctx context.Context, req *res
 
 	// Get the worldID from the first coordinate (all should be the same world)
 	if len(req.Coordinates) > 0 && len(req.Coordinates[0].WorldId) > 0 {
-		err := worldID.Scan(string(req.Coordinates[0].WorldId))
+		var err error
+		worldID, err = uuidutil.Decode(req.Coordinates[0].WorldId)
 		if err != nil {
 			logger.Warn("Invalid world ID format", "world_id", req.Coordinates[0].WorldId, "error", err)
 			return nil, status.Errorf(codes.InvalidArgument, "Invalid world ID: %v", err)
@@ -162,3 +172,38 @@ func (h *ResourceNodeHandler) GetResourceNodeTypes(ctx context.Context, req *res
 		ResourceNodeTypes: resourceNodeTypes,
 	}, nil
 }
+
+// GetSpriteAtlas returns where each sprite named in ResourceVisual is drawn from
+func (h *ResourceNodeHandler) GetSpriteAtlas(ctx context.Context, req *resourceNodeV1.GetSpriteAtlasRequest) (*resourceNodeV1.GetSpriteAtlasResponse, error) {
+	logger := h.logger.With("operation", "GetSpriteAtlas")
+	logger.Debug("Received GetSpriteAtlas request")
+
+	if h.spriteAtlas == nil {
+		return nil, status.Errorf(codes.Unimplemented, "Sprite atlas is not configured")
+	}
+
+	resp := h.spriteAtlas.GetSpriteAtlas(req.KnownChecksum)
+	logger.Debug("Retrieved sprite atlas", "version", resp.Version, "not_modified", resp.NotModified)
+	return resp, nil
+}
+
+// ReloadBalanceConfig reloads the resource balance data file without restarting the server (admin only)
+func (h *ResourceNodeHandler) ReloadBalanceConfig(ctx context.Context, req *resourceNodeV1.ReloadBalanceConfigRequest) (*resourceNodeV1.ReloadBalanceConfigResponse, error) {
+	logger := h.logger.With("operation", "ReloadBalanceConfig")
+	logger.Debug("Received ReloadBalanceConfig request")
+
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		logger.Warn("Non-admin attempted to reload balance config", "user_id", userID)
+		return nil, err
+	}
+
+	resp, err := h.resourceNodeService.ReloadBalanceConfig(ctx)
+	if err != nil {
+		logger.Error("Failed to reload balance config", "error", err)
+		return nil, status.Errorf(codes.FailedPrecondition, "Failed to reload balance config: %v", err)
+	}
+
+	logger.Info("Balance config reloaded", "version", resp.Version, "checksum", resp.Checksum, "source", resp.Source)
+	return resp, nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/VoidMesh/api/api/internal/testutil"
 	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
 	resourceNodeV1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
+	"github.com/VoidMesh/api/api/server/middleware"
 	"github.com/charmbracelet/log"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -837,6 +838,87 @@ func TestResourceNodeHandler_GetResourceNodeTypes(t *testing.T) {
 	}
 }
 
+// TestResourceNodeHandler_ReloadBalanceConfig verifies the admin gate and error mapping for balance reloads
+func TestResourceNodeHandler_ReloadBalanceConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockResourceNodeService := mockhandlers.NewMockResourceNodeService(ctrl)
+	handler := &ResourceNodeHandler{
+		resourceNodeService: mockResourceNodeService,
+		worldService:        mockhandlers.NewMockWorldService(ctrl),
+		logger:              log.New(io.Discard),
+	}
+
+	middleware.SetAdminUserIDs([]string{testutil.UUIDTestData.User1})
+	t.Cleanup(func() { middleware.SetAdminUserIDs(nil) })
+
+	adminCtx := middleware.WithUserID(context.Background(), testutil.UUIDTestData.User1)
+	playerCtx := middleware.WithUserID(context.Background(), testutil.UUIDTestData.User2)
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		setupMocks func()
+		wantErr    bool
+		wantCode   codes.Code
+		wantMsg    string
+	}{
+		{
+			name: "admin reloads balance config",
+			ctx:  adminCtx,
+			setupMocks: func() {
+				mockResourceNodeService.EXPECT().
+					ReloadBalanceConfig(gomock.Any()).
+					Return(&resourceNodeV1.ReloadBalanceConfigResponse{
+						Version:           2,
+						Checksum:          "abc123",
+						Source:            "/etc/voidmesh/resource_nodes.yaml",
+						ResourceTypeCount: 15,
+						LoadedAt:          timestamppb.Now(),
+					}, nil)
+			},
+		},
+		{
+			name:       "non-admin is rejected",
+			ctx:        playerCtx,
+			setupMocks: func() {},
+			wantErr:    true,
+			wantCode:   codes.PermissionDenied,
+			wantMsg:    "admin privileges required",
+		},
+		{
+			name: "invalid file keeps current config",
+			ctx:  adminCtx,
+			setupMocks: func() {
+				mockResourceNodeService.EXPECT().
+					ReloadBalanceConfig(gomock.Any()).
+					Return(nil, fmt.Errorf("invalid balance config: version must be positive, got 0"))
+			},
+			wantErr:  true,
+			wantCode: codes.FailedPrecondition,
+			wantMsg:  "version must be positive",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMocks()
+
+			resp, err := handler.ReloadBalanceConfig(tt.ctx, &resourceNodeV1.ReloadBalanceConfigRequest{})
+
+			if tt.wantErr {
+				testutil.AssertGRPCError(t, err, tt.wantCode, tt.wantMsg)
+				assert.Nil(t, resp)
+			} else {
+				testutil.AssertNoGRPCError(t, err)
+				assert.Equal(t, int32(2), resp.Version)
+				assert.Equal(t, int32(15), resp.ResourceTypeCount)
+			}
+		})
+	}
+}
+
 // BenchmarkResourceNodeHandler_GetResourcesInChunk measures performance of single chunk resource retrieval
 func BenchmarkResourceNodeHandler_GetResourcesInChunk(b *testing.B) {
 	ctrl := gomock.NewController(b)
@@ -1297,3 +1379,26 @@ func TestResourceNodeHandler_ContextCancellation(t *testing.T) {
 		testutil.AssertGRPCError(t, err, codes.Canceled, "context canceled")
 	})
 }
+
+type fakeSpriteAtlas struct {
+	knownChecksum string
+}
+
+func (a *fakeSpriteAtlas) GetSpriteAtlas(knownChecksum string) *resourceNodeV1.GetSpriteAtlasResponse {
+	a.knownChecksum = knownChecksum
+	return &resourceNodeV1.GetSpriteAtlasResponse{Version: 1, Checksum: "abc", NotModified: knownChecksum == "abc"}
+}
+
+func TestResourceNodeHandler_GetSpriteAtlas(t *testing.T) {
+	handler := &ResourceNodeHandler{logger: log.New(io.Discard)}
+
+	_, err := handler.GetSpriteAtlas(context.Background(), &resourceNodeV1.GetSpriteAtlasRequest{})
+	assert.Equal(t, codes.Unimplemented, status.Code(err), "without an atlas the RPC is unavailable")
+
+	atlas := &fakeSpriteAtlas{}
+	handler.SetSpriteAtlas(atlas)
+	resp, err := handler.GetSpriteAtlas(context.Background(), &resourceNodeV1.GetSpriteAtlasRequest{KnownChecksum: "abc"})
+	require.NoError(t, err)
+	assert.True(t, resp.NotModified)
+	assert.Equal(t, "abc", atlas.knownChecksum)
+}
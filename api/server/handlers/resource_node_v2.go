@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"context"
+
+	resourceNodeV1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
+	resourceNodeV2 "github.com/VoidMesh/api/api/proto/resource_node/v2"
+)
+
+// resourceNodeV2Server serves resource_node.v2 through the v1 server
+type resourceNodeV2Server struct {
+	resourceNodeV2.UnimplementedResourceNodeServiceServer
+	v1 resourceNodeV1.ResourceNodeServiceServer
+}
+
+func NewResourceNodeServerV2(v1 resourceNodeV1.ResourceNodeServiceServer) resourceNodeV2.ResourceNodeServiceServer {
+	return &resourceNodeV2Server{v1: v1}
+}
+
+// GetResourcesInChunk retrieves all resource nodes in a specific chunk
+func (s *resourceNodeV2Server) GetResourcesInChunk(ctx context.Context, req *resourceNodeV2.GetResourcesInChunkRequest) (*resourceNodeV1.GetResourcesInChunkResponse, error) {
+	worldID, err := v1WorldID(req.WorldId)
+	if err != nil {
+		return nil, err
+	}
+	return s.v1.GetResourcesInChunk(ctx, &resourceNodeV1.GetResourcesInChunkRequest{
+		WorldId: worldID,
+		ChunkX:  req.ChunkX,
+		ChunkY:  req.ChunkY,
+	})
+}
+
+// GetResourcesInChunks retrieves resource nodes in multiple chunks
+func (s *resourceNodeV2Server) GetResourcesInChunks(ctx context.Context, req *resourceNodeV2.GetResourcesInChunksRequest) (*resourceNodeV1.GetResourcesInChunksResponse, error) {
+	coordinates := make([]*resourceNodeV1.ChunkCoordinate, len(req.Coordinates))
+	for i, coord := range req.Coordinates {
+		worldID, err := v1WorldID(coord.WorldId)
+		if err != nil {
+			return nil, err
+		}
+		coordinates[i] = &resourceNodeV1.ChunkCoordinate{
+			WorldId: worldID,
+			ChunkX:  coord.ChunkX,
+			ChunkY:  coord.ChunkY,
+		}
+	}
+	return s.v1.GetResourcesInChunks(ctx, &resourceNodeV1.GetResourcesInChunksRequest{Coordinates: coordinates})
+}
+
+// GetResourceNodeTypes returns all available resource node types
+func (s *resourceNodeV2Server) GetResourceNodeTypes(ctx context.Context, req *resourceNodeV1.GetResourceNodeTypesRequest) (*resourceNodeV1.GetResourceNodeTypesResponse, error) {
+	return s.v1.GetResourceNodeTypes(ctx, req)
+}
+
+// GetSpriteAtlas returns where each sprite named in ResourceVisual is drawn from
+func (s *resourceNodeV2Server) GetSpriteAtlas(ctx context.Context, req *resourceNodeV1.GetSpriteAtlasRequest) (*resourceNodeV1.GetSpriteAtlasResponse, error) {
+	return s.v1.GetSpriteAtlas(ctx, req)
+}
+
+// ReloadBalanceConfig re-reads the balance data file (admin only)
+func (s *resourceNodeV2Server) ReloadBalanceConfig(ctx context.Context, req *resourceNodeV1.ReloadBalanceConfigRequest) (*resourceNodeV1.ReloadBalanceConfigResponse, error) {
+	return s.v1.ReloadBalanceConfig(ctx, req)
+}
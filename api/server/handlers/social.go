@@ -0,0 +1,296 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/streamsession"
+	socialV1 "github.com/VoidMesh/api/api/proto/social/v1"
+	streamV1 "github.com/VoidMesh/api/api/proto/stream/v1"
+	"github.com/VoidMesh/api/api/server/middleware"
+	"github.com/charmbracelet/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SocialService defines the interface for friendships and direct messages
+type SocialService interface {
+	SendFriendRequest(ctx context.Context, userID, username string) (*socialV1.Friend, error)
+	AcceptFriendRequest(ctx context.Context, userID, requesterID string) (*socialV1.Friend, error)
+	DeclineFriendRequest(ctx context.Context, userID, requesterID string) error
+	RemoveFriend(ctx context.Context, userID, otherUserID string) error
+	ListFriends(ctx context.Context, userID string) ([]*socialV1.Friend, error)
+	SendDirectMessage(ctx context.Context, userID, recipientID, body string) (*socialV1.DirectMessage, bool, error)
+	GetConversation(ctx context.Context, userID, otherUserID string, beforeID int64, limit int32) ([]*socialV1.DirectMessage, error)
+	SubscribeDirectMessages(ctx context.Context, userID string) (<-chan *socialV1.DirectMessage, func(), error)
+	BlockUser(ctx context.Context, userID, otherUserID string) error
+	UnblockUser(ctx context.Context, userID, otherUserID string) error
+	ListBlockedUsers(ctx context.Context, userID string) ([]*socialV1.BlockedUser, error)
+	ReportPlayer(ctx context.Context, userID, reportedID string, reason socialV1.ReportReason, details string) (int64, error)
+}
+
+type socialServiceServer struct {
+	socialV1.UnimplementedSocialServiceServer
+	socialService  SocialService
+	messageStreams *streamsession.Registry[*socialV1.DirectMessage]
+	logger         *log.Logger
+}
+
+// NewSocialServer creates the social service handler
+func NewSocialServer(socialService SocialService) socialV1.SocialServiceServer {
+	logger := logging.WithComponent("social-handler")
+	logger.Debug("Creating new SocialService server instance")
+	return &socialServiceServer{
+		socialService: socialService,
+		messageStreams: streamsession.NewRegistry(func(m *socialV1.DirectMessage, info *streamV1.StreamInfo) {
+			m.Stream = info
+		}),
+		logger: logger,
+	}
+}
+
+func authenticatedUser(ctx context.Context) (string, error) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok || userID == "" {
+		return "", status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	return userID, nil
+}
+
+// SendFriendRequest asks another user, by username, to be friends
+func (s *socialServiceServer) SendFriendRequest(ctx context.Context, req *socialV1.SendFriendRequestRequest) (*socialV1.SendFriendRequestResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Username == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "username is required")
+	}
+
+	friend, err := s.socialService.SendFriendRequest(ctx, userID, req.Username)
+	if err != nil {
+		s.logger.Debug("Failed to send friend request", "user_id", userID, "username", req.Username, "error", err)
+		return nil, err
+	}
+	return &socialV1.SendFriendRequestResponse{Friend: friend}, nil
+}
+
+// AcceptFriendRequest accepts a pending request from another user
+func (s *socialServiceServer) AcceptFriendRequest(ctx context.Context, req *socialV1.AcceptFriendRequestRequest) (*socialV1.AcceptFriendRequestResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.UserId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id is required")
+	}
+
+	friend, err := s.socialService.AcceptFriendRequest(ctx, userID, req.UserId)
+	if err != nil {
+		s.logger.Debug("Failed to accept friend request", "user_id", userID, "requester_id", req.UserId, "error", err)
+		return nil, err
+	}
+	return &socialV1.AcceptFriendRequestResponse{Friend: friend}, nil
+}
+
+// DeclineFriendRequest deletes a pending request from another user
+func (s *socialServiceServer) DeclineFriendRequest(ctx context.Context, req *socialV1.DeclineFriendRequestRequest) (*socialV1.DeclineFriendRequestResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.UserId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id is required")
+	}
+
+	if err := s.socialService.DeclineFriendRequest(ctx, userID, req.UserId); err != nil {
+		s.logger.Debug("Failed to decline friend request", "user_id", userID, "requester_id", req.UserId, "error", err)
+		return nil, err
+	}
+	return &socialV1.DeclineFriendRequestResponse{}, nil
+}
+
+// RemoveFriend ends a friendship or cancels an outgoing request
+func (s *socialServiceServer) RemoveFriend(ctx context.Context, req *socialV1.RemoveFriendRequest) (*socialV1.RemoveFriendResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.UserId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id is required")
+	}
+
+	if err := s.socialService.RemoveFriend(ctx, userID, req.UserId); err != nil {
+		s.logger.Debug("Failed to remove friend", "user_id", userID, "other_user_id", req.UserId, "error", err)
+		return nil, err
+	}
+	return &socialV1.RemoveFriendResponse{}, nil
+}
+
+// ListFriends returns the caller's friends and pending requests
+func (s *socialServiceServer) ListFriends(ctx context.Context, req *socialV1.ListFriendsRequest) (*socialV1.ListFriendsResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	friends, err := s.socialService.ListFriends(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to list friends", "user_id", userID, "error", err)
+		return nil, err
+	}
+	return &socialV1.ListFriendsResponse{Friends: friends}, nil
+}
+
+// SendDirectMessage sends a message to a friend
+func (s *socialServiceServer) SendDirectMessage(ctx context.Context, req *socialV1.SendDirectMessageRequest) (*socialV1.SendDirectMessageResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.RecipientId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "recipient_id is required")
+	}
+
+	message, delivered, err := s.socialService.SendDirectMessage(ctx, userID, req.RecipientId, req.Body)
+	if err != nil {
+		s.logger.Debug("Failed to send direct message", "user_id", userID, "recipient_id", req.RecipientId, "error", err)
+		return nil, err
+	}
+	return &socialV1.SendDirectMessageResponse{Message: message, Delivered: delivered}, nil
+}
+
+// GetConversation pages through the messages exchanged with another user
+func (s *socialServiceServer) GetConversation(ctx context.Context, req *socialV1.GetConversationRequest) (*socialV1.GetConversationResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.UserId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id is required")
+	}
+
+	messages, err := s.socialService.GetConversation(ctx, userID, req.UserId, req.BeforeId, req.Limit)
+	if err != nil {
+		s.logger.Debug("Failed to get conversation", "user_id", userID, "other_user_id", req.UserId, "error", err)
+		return nil, err
+	}
+	return &socialV1.GetConversationResponse{Messages: messages}, nil
+}
+
+// StreamDirectMessages streams the caller's stored and incoming messages until the client disconnects
+func (s *socialServiceServer) StreamDirectMessages(req *socialV1.StreamDirectMessagesRequest, stream socialV1.SocialService_StreamDirectMessagesServer) error {
+	ctx := stream.Context()
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	logger := s.logger.With("operation", "StreamDirectMessages", "user_id", userID)
+
+	messages, unsubscribe, err := s.socialService.SubscribeDirectMessages(ctx, userID)
+	if err != nil {
+		logger.Warn("Failed to subscribe to direct messages", "error", err)
+		return err
+	}
+	defer unsubscribe()
+
+	session, err := openStream(s.messageStreams, userID, req.Resume, stream.Send)
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+	heartbeat := time.NewTicker(streamsession.HeartbeatInterval)
+	defer heartbeat.Stop()
+
+	logger.Debug("Direct message stream opened", "stream_id", session.ID())
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Debug("Direct message stream closed")
+			return nil
+		case <-heartbeat.C:
+			if err := stream.Send(session.Heartbeat()); err != nil {
+				return err
+			}
+		case message, ok := <-messages:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(session.Stamp(message)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// BlockUser blocks another user
+func (s *socialServiceServer) BlockUser(ctx context.Context, req *socialV1.BlockUserRequest) (*socialV1.BlockUserResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.UserId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id is required")
+	}
+
+	if err := s.socialService.BlockUser(ctx, userID, req.UserId); err != nil {
+		s.logger.Debug("Failed to block user", "user_id", userID, "blocked_id", req.UserId, "error", err)
+		return nil, err
+	}
+	return &socialV1.BlockUserResponse{}, nil
+}
+
+// UnblockUser removes a block placed by the caller
+func (s *socialServiceServer) UnblockUser(ctx context.Context, req *socialV1.UnblockUserRequest) (*socialV1.UnblockUserResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.UserId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id is required")
+	}
+
+	if err := s.socialService.UnblockUser(ctx, userID, req.UserId); err != nil {
+		s.logger.Debug("Failed to unblock user", "user_id", userID, "blocked_id", req.UserId, "error", err)
+		return nil, err
+	}
+	return &socialV1.UnblockUserResponse{}, nil
+}
+
+// ListBlockedUsers returns the users the caller has blocked
+func (s *socialServiceServer) ListBlockedUsers(ctx context.Context, req *socialV1.ListBlockedUsersRequest) (*socialV1.ListBlockedUsersResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := s.socialService.ListBlockedUsers(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to list blocked users", "user_id", userID, "error", err)
+		return nil, err
+	}
+	return &socialV1.ListBlockedUsersResponse{Users: users}, nil
+}
+
+// ReportPlayer files a report against another user for moderator review
+func (s *socialServiceServer) ReportPlayer(ctx context.Context, req *socialV1.ReportPlayerRequest) (*socialV1.ReportPlayerResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.UserId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id is required")
+	}
+	if req.Reason == socialV1.ReportReason_REPORT_REASON_UNSPECIFIED {
+		return nil, status.Errorf(codes.InvalidArgument, "reason is required")
+	}
+
+	reportID, err := s.socialService.ReportPlayer(ctx, userID, req.UserId, req.Reason, req.Details)
+	if err != nil {
+		s.logger.Warn("Failed to report player", "user_id", userID, "reported_id", req.UserId, "error", err)
+		return nil, err
+	}
+	return &socialV1.ReportPlayerResponse{ReportId: reportID}, nil
+}
@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/VoidMesh/api/api/internal/testutil"
+	socialV1 "github.com/VoidMesh/api/api/proto/social/v1"
+	"github.com/VoidMesh/api/api/server/middleware"
+	"github.com/charmbracelet/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeSocialService records the messages and reports it was asked to send
+type fakeSocialService struct {
+	sent    []string
+	reports []socialV1.ReportReason
+}
+
+func (f *fakeSocialService) SendFriendRequest(ctx context.Context, userID, username string) (*socialV1.Friend, error) {
+	return &socialV1.Friend{Username: username, Status: socialV1.FriendStatus_FRIEND_STATUS_OUTGOING_REQUEST}, nil
+}
+
+func (f *fakeSocialService) AcceptFriendRequest(ctx context.Context, userID, requesterID string) (*socialV1.Friend, error) {
+	return &socialV1.Friend{UserId: requesterID, Status: socialV1.FriendStatus_FRIEND_STATUS_FRIENDS}, nil
+}
+
+func (f *fakeSocialService) DeclineFriendRequest(ctx context.Context, userID, requesterID string) error {
+	return nil
+}
+
+func (f *fakeSocialService) RemoveFriend(ctx context.Context, userID, otherUserID string) error {
+	return status.Errorf(codes.NotFound, "friendship not found")
+}
+
+func (f *fakeSocialService) ListFriends(ctx context.Context, userID string) ([]*socialV1.Friend, error) {
+	return []*socialV1.Friend{{Username: "friend", Online: true}}, nil
+}
+
+func (f *fakeSocialService) SendDirectMessage(ctx context.Context, userID, recipientID, body string) (*socialV1.DirectMessage, bool, error) {
+	f.sent = append(f.sent, body)
+	return &socialV1.DirectMessage{Id: 1, SenderId: userID, RecipientId: recipientID, Body: body}, false, nil
+}
+
+func (f *fakeSocialService) GetConversation(ctx context.Context, userID, otherUserID string, beforeID int64, limit int32) ([]*socialV1.DirectMessage, error) {
+	return nil, nil
+}
+
+func (f *fakeSocialService) SubscribeDirectMessages(ctx context.Context, userID string) (<-chan *socialV1.DirectMessage, func(), error) {
+	ch := make(chan *socialV1.DirectMessage)
+	close(ch)
+	return ch, func() {}, nil
+}
+
+func (f *fakeSocialService) BlockUser(ctx context.Context, userID, otherUserID string) error {
+	return nil
+}
+
+func (f *fakeSocialService) UnblockUser(ctx context.Context, userID, otherUserID string) error {
+	return nil
+}
+
+func (f *fakeSocialService) ListBlockedUsers(ctx context.Context, userID string) ([]*socialV1.BlockedUser, error) {
+	return nil, nil
+}
+
+func (f *fakeSocialService) ReportPlayer(ctx context.Context, userID, reportedID string, reason socialV1.ReportReason, details string) (int64, error) {
+	f.reports = append(f.reports, reason)
+	return int64(len(f.reports)), nil
+}
+
+func TestSocialServiceServer(t *testing.T) {
+	social := &fakeSocialService{}
+	server := &socialServiceServer{socialService: social, logger: log.New(io.Discard)}
+	ctx := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "player")
+
+	_, err := server.ListFriends(context.Background(), &socialV1.ListFriendsRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = server.SendFriendRequest(ctx, &socialV1.SendFriendRequestRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = server.SendDirectMessage(ctx, &socialV1.SendDirectMessageRequest{Body: "hi"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Empty(t, social.sent)
+
+	friends, err := server.ListFriends(ctx, &socialV1.ListFriendsRequest{})
+	require.NoError(t, err)
+	require.Len(t, friends.Friends, 1)
+	assert.True(t, friends.Friends[0].Online)
+
+	sent, err := server.SendDirectMessage(ctx, &socialV1.SendDirectMessageRequest{RecipientId: testutil.UUIDTestData.User2, Body: "hi"})
+	require.NoError(t, err)
+	assert.Equal(t, testutil.UUIDTestData.User1, sent.Message.SenderId)
+	assert.False(t, sent.Delivered)
+
+	_, err = server.RemoveFriend(ctx, &socialV1.RemoveFriendRequest{UserId: testutil.UUIDTestData.User2})
+	assert.Equal(t, codes.NotFound, status.Code(err), "service errors are returned unchanged")
+}
+
+func TestSocialServiceServer_ReportPlayer(t *testing.T) {
+	social := &fakeSocialService{}
+	server := &socialServiceServer{socialService: social, logger: log.New(io.Discard)}
+	ctx := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "player")
+
+	_, err := server.ReportPlayer(ctx, &socialV1.ReportPlayerRequest{UserId: testutil.UUIDTestData.User2})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "a reason is required")
+	assert.Empty(t, social.reports)
+
+	resp, err := server.ReportPlayer(ctx, &socialV1.ReportPlayerRequest{
+		UserId: testutil.UUIDTestData.User2,
+		Reason: socialV1.ReportReason_REPORT_REASON_SPAM,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), resp.ReportId)
+}
@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/internal/logging"
+	staticDataV1 "github.com/VoidMesh/api/api/proto/static_data/v1"
+	"github.com/charmbracelet/log"
+)
+
+// StaticDataService defines the interface for the versioned reference data bundle
+type StaticDataService interface {
+	GetStaticDataBundle(ctx context.Context, worldID, knownHash string) (*staticDataV1.GetStaticDataBundleResponse, error)
+	CheckVersion(ctx context.Context, worldID string) (string, error)
+}
+
+type staticDataServiceServer struct {
+	staticDataV1.UnimplementedStaticDataServiceServer
+	staticDataService StaticDataService
+	logger            *log.Logger
+}
+
+// NewStaticDataServer creates the static data service handler
+func NewStaticDataServer(staticDataService StaticDataService) staticDataV1.StaticDataServiceServer {
+	logger := logging.WithComponent("static-data-handler")
+	logger.Debug("Creating new StaticDataService server instance")
+	return &staticDataServiceServer{
+		staticDataService: staticDataService,
+		logger:            logger,
+	}
+}
+
+// GetStaticDataBundle returns all reference data of a world, or only its hash when the
+// client's copy is current
+func (s *staticDataServiceServer) GetStaticDataBundle(ctx context.Context, req *staticDataV1.GetStaticDataBundleRequest) (*staticDataV1.GetStaticDataBundleResponse, error) {
+	resp, err := s.staticDataService.GetStaticDataBundle(ctx, req.WorldId, req.KnownHash)
+	if err != nil {
+		s.logger.Debug("Failed to get static data bundle", "world_id", req.WorldId, "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// CheckVersion returns the content hash of a world's current bundle
+func (s *staticDataServiceServer) CheckVersion(ctx context.Context, req *staticDataV1.CheckVersionRequest) (*staticDataV1.CheckVersionResponse, error) {
+	hash, err := s.staticDataService.CheckVersion(ctx, req.WorldId)
+	if err != nil {
+		s.logger.Debug("Failed to check static data version", "world_id", req.WorldId, "error", err)
+		return nil, err
+	}
+	return &staticDataV1.CheckVersionResponse{ContentHash: hash}, nil
+}
@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/internal/logging"
+	timelineV1 "github.com/VoidMesh/api/api/proto/timeline/v1"
+	"github.com/charmbracelet/log"
+)
+
+// TimelineService defines the interface for the world timeline
+type TimelineService interface {
+	List(ctx context.Context, worldID string, beforeID int64, limit int32) ([]*timelineV1.TimelineEntry, error)
+	SubscribeWorld(ctx context.Context, worldID string, afterID int64) ([]*timelineV1.TimelineEntry, <-chan *timelineV1.TimelineEntry, func(), error)
+}
+
+type timelineServiceServer struct {
+	timelineV1.UnimplementedTimelineServiceServer
+	timelineService TimelineService
+	logger          *log.Logger
+}
+
+// NewTimelineServer creates the world timeline service handler
+func NewTimelineServer(timelineService TimelineService) timelineV1.TimelineServiceServer {
+	logger := logging.WithComponent("timeline-handler")
+	logger.Debug("Creating new TimelineService server instance")
+	return &timelineServiceServer{
+		timelineService: timelineService,
+		logger:          logger,
+	}
+}
+
+// ListWorldTimeline lists a world's history, newest first
+func (s *timelineServiceServer) ListWorldTimeline(ctx context.Context, req *timelineV1.ListWorldTimelineRequest) (*timelineV1.ListWorldTimelineResponse, error) {
+	if _, err := authenticatedUser(ctx); err != nil {
+		return nil, err
+	}
+
+	entries, err := s.timelineService.List(ctx, req.WorldId, req.BeforeId, req.Limit)
+	if err != nil {
+		s.logger.Debug("Failed to list world timeline", "world_id", req.WorldId, "error", err)
+		return nil, err
+	}
+	return &timelineV1.ListWorldTimelineResponse{Entries: entries}, nil
+}
+
+// StreamWorldTimeline sends the entries the client missed, then new entries until the client disconnects
+func (s *timelineServiceServer) StreamWorldTimeline(req *timelineV1.StreamWorldTimelineRequest, stream timelineV1.TimelineService_StreamWorldTimelineServer) error {
+	ctx := stream.Context()
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	logger := s.logger.With("operation", "StreamWorldTimeline", "user_id", userID, "world_id", req.WorldId)
+
+	backlog, entries, unsubscribe, err := s.timelineService.SubscribeWorld(ctx, req.WorldId, req.AfterId)
+	if err != nil {
+		logger.Warn("Failed to subscribe to world timeline", "error", err)
+		return err
+	}
+	defer unsubscribe()
+
+	lastID := req.AfterId
+	for _, entry := range backlog {
+		if err := stream.Send(entry); err != nil {
+			return err
+		}
+		lastID = entry.Id
+	}
+
+	logger.Debug("World timeline stream opened", "resent", len(backlog))
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Debug("World timeline stream closed")
+			return nil
+		case entry, ok := <-entries:
+			if !ok {
+				return nil
+			}
+			// The backlog may already have covered entries published while it was read
+			if entry.Id <= lastID {
+				continue
+			}
+			if err := stream.Send(entry); err != nil {
+				return err
+			}
+			lastID = entry.Id
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/VoidMesh/api/api/internal/testutil"
+	timelineV1 "github.com/VoidMesh/api/api/proto/timeline/v1"
+	"github.com/VoidMesh/api/api/server/middleware"
+	"github.com/charmbracelet/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeTimelineService resends entries 2 and 3 and then streams entries 3 and 4, as when
+// entry 3 is published while the backlog is read
+type fakeTimelineService struct {
+	afterID int64
+}
+
+func (f *fakeTimelineService) List(ctx context.Context, worldID string, beforeID int64, limit int32) ([]*timelineV1.TimelineEntry, error) {
+	return []*timelineV1.TimelineEntry{{Id: 1, WorldId: worldID}}, nil
+}
+
+func (f *fakeTimelineService) SubscribeWorld(ctx context.Context, worldID string, afterID int64) ([]*timelineV1.TimelineEntry, <-chan *timelineV1.TimelineEntry, func(), error) {
+	f.afterID = afterID
+	ch := make(chan *timelineV1.TimelineEntry, 2)
+	ch <- &timelineV1.TimelineEntry{Id: 3}
+	ch <- &timelineV1.TimelineEntry{Id: 4}
+	close(ch)
+	return []*timelineV1.TimelineEntry{{Id: 2}, {Id: 3}}, ch, func() {}, nil
+}
+
+// recordingTimelineStream collects what the handler sends
+type recordingTimelineStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []int64
+}
+
+func (s *recordingTimelineStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *recordingTimelineStream) Send(entry *timelineV1.TimelineEntry) error {
+	s.sent = append(s.sent, entry.Id)
+	return nil
+}
+
+func TestTimelineServiceServer(t *testing.T) {
+	timeline := &fakeTimelineService{}
+	server := &timelineServiceServer{timelineService: timeline, logger: log.New(io.Discard)}
+	ctx := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "player")
+
+	_, err := server.ListWorldTimeline(context.Background(), &timelineV1.ListWorldTimelineRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	err = server.StreamWorldTimeline(&timelineV1.StreamWorldTimelineRequest{}, &recordingTimelineStream{ctx: context.Background()})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	listed, err := server.ListWorldTimeline(ctx, &timelineV1.ListWorldTimelineRequest{WorldId: testutil.UUIDTestData.World1})
+	require.NoError(t, err)
+	require.Len(t, listed.Entries, 1)
+	assert.Equal(t, testutil.UUIDTestData.World1, listed.Entries[0].WorldId)
+
+	stream := &recordingTimelineStream{ctx: ctx}
+	require.NoError(t, server.StreamWorldTimeline(&timelineV1.StreamWorldTimelineRequest{AfterId: 1}, stream))
+	assert.Equal(t, int64(1), timeline.afterID)
+	assert.Equal(t, []int64{2, 3, 4}, stream.sent, "entries already resent are not sent again")
+}
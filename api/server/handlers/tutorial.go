@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/internal/logging"
+	tutorialV1 "github.com/VoidMesh/api/api/proto/tutorial/v1"
+	"github.com/charmbracelet/log"
+)
+
+// TutorialService defines the interface for reading tutorial progress
+type TutorialService interface {
+	State(ctx context.Context, userID, characterID string) (*tutorialV1.TutorialState, error)
+}
+
+type tutorialServiceServer struct {
+	tutorialV1.UnimplementedTutorialServiceServer
+	tutorialService TutorialService
+	logger          *log.Logger
+}
+
+// NewTutorialServer creates the tutorial service handler
+func NewTutorialServer(tutorialService TutorialService) tutorialV1.TutorialServiceServer {
+	logger := logging.WithComponent("tutorial-handler")
+	logger.Debug("Creating new TutorialService server instance")
+	return &tutorialServiceServer{
+		tutorialService: tutorialService,
+		logger:          logger,
+	}
+}
+
+// GetTutorialState returns the tutorial progress of one of the caller's characters
+func (s *tutorialServiceServer) GetTutorialState(ctx context.Context, req *tutorialV1.GetTutorialStateRequest) (*tutorialV1.GetTutorialStateResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := s.tutorialService.State(ctx, userID, req.CharacterId)
+	if err != nil {
+		s.logger.Debug("Failed to get tutorial state", "user_id", userID, "character_id", req.CharacterId, "error", err)
+		return nil, err
+	}
+	return &tutorialV1.GetTutorialStateResponse{State: state}, nil
+}
@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/VoidMesh/api/api/internal/testutil"
+	tutorialV1 "github.com/VoidMesh/api/api/proto/tutorial/v1"
+	"github.com/VoidMesh/api/api/server/middleware"
+	"github.com/charmbracelet/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeTutorialService records the user the state was requested for
+type fakeTutorialService struct {
+	userID string
+}
+
+func (f *fakeTutorialService) State(ctx context.Context, userID, characterID string) (*tutorialV1.TutorialState, error) {
+	f.userID = userID
+	return &tutorialV1.TutorialState{CharacterId: characterID, CurrentStep: tutorialV1.TutorialStep_TUTORIAL_STEP_MOVE}, nil
+}
+
+func TestTutorialServiceServer_GetTutorialState(t *testing.T) {
+	tutorial := &fakeTutorialService{}
+	server := &tutorialServiceServer{tutorialService: tutorial, logger: log.New(io.Discard)}
+	characterID := testutil.UUIDTestData.Character1
+
+	_, err := server.GetTutorialState(context.Background(), &tutorialV1.GetTutorialStateRequest{CharacterId: characterID})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	ctx := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "player")
+	resp, err := server.GetTutorialState(ctx, &tutorialV1.GetTutorialStateRequest{CharacterId: characterID})
+	require.NoError(t, err)
+	assert.Equal(t, characterID, resp.State.CharacterId)
+	assert.Equal(t, tutorialV1.TutorialStep_TUTORIAL_STEP_MOVE, resp.State.CurrentStep)
+	assert.Equal(t, testutil.UUIDTestData.User1, tutorial.userID, "the user is taken from the caller")
+}
@@ -3,18 +3,28 @@ package handlers
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"net"
 	"os"
 	"strings"
 	"time"
 
 	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/latency"
 	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/signup"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/pkg/uuidutil"
 	userV1 "github.com/VoidMesh/api/api/proto/user/v1"
+	"github.com/VoidMesh/api/api/server/middleware"
 	"github.com/charmbracelet/log"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
-	"github.com/jackc/pgx/v5/pgxpool"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -25,6 +35,14 @@ type userServiceServer struct {
 	jwtService      JWTService
 	passwordService PasswordService
 	tokenGenerator  TokenGenerator
+	clock           clock.Clock
+	signup          *signup.Guard    // Nil leaves signups unprotected
+	maintenance     LoginGate        // Nil allows logins during maintenance
+	sessions        SessionService   // Nil leaves logins without sessions
+	identities      IdentityService  // Nil disables federated login
+	consent         ConsentService   // Nil leaves consent untracked
+	clockSync       ClockSyncService // Nil disables latency calibration
+	worlds          WorldService     // Nil accepts any well-formed world ID at login
 	logger          *log.Logger
 }
 
@@ -41,13 +59,19 @@ func NewUserServer(
 		jwtService:      jwtService,
 		passwordService: passwordService,
 		tokenGenerator:  tokenGenerator,
+		clock:           clock.New(),
 		logger:          logger,
 	}
 }
 
 // NewUserServerWithPool creates a user server with all dependencies wired up
 // This function maintains backward compatibility while providing dependency injection
-func NewUserServerWithPool(dbPool *pgxpool.Pool) (userV1.UserServiceServer, error) {
+// guard protects CreateUser from bots and throwaway accounts; nil disables the checks.
+// sessions records a session for every login; nil leaves tokens unrevocable.
+// identities enables logging in with OAuth provider accounts; nil disables it.
+// consent records acceptance of the terms of service and privacy policy; nil disables it.
+// clockSync calibrates connection latency for timed actions; nil disables it.
+func NewUserServerWithPool(dbPool storage.Pool, guard *signup.Guard, maintenance LoginGate, sessions SessionService, identities IdentityService, consent ConsentService, clockSync ClockSyncService) (userV1.UserServiceServer, error) {
 	logger := logging.WithComponent("user-handler")
 	logger.Debug("Creating UserService server with dependency injection")
 
@@ -78,10 +102,20 @@ func NewUserServerWithPool(dbPool *pgxpool.Pool) (userV1.UserServiceServer, erro
 	passwordService := NewPasswordService()
 	tokenGenerator := NewTokenGenerator()
 
-	return NewUserServer(userRepo, jwtService, passwordService, tokenGenerator), nil
+	server := NewUserServer(userRepo, jwtService, passwordService, tokenGenerator).(*userServiceServer)
+	server.signup = guard
+	server.maintenance = maintenance
+	server.sessions = sessions
+	server.identities = identities
+	server.consent = consent
+	server.clockSync = clockSync
+	server.worlds, err = NewWorldServiceWithPool(dbPool)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create world service: %w", err)
+	}
+	return server, nil
 }
 
-
 // Helper function to convert DB user to proto user
 func (s *userServiceServer) dbUserToProto(user db.User) *userV1.User {
 	protoUser := &userV1.User{
@@ -105,19 +139,19 @@ func (s *userServiceServer) dbUserToProto(user db.User) *userV1.User {
 	return protoUser
 }
 
-
-// Helper function to parse UUID string to pgtype.UUID
-func parseUUID(uuidStr string) (pgtype.UUID, error) {
-	var uuid pgtype.UUID
-	err := uuid.Scan(uuidStr)
-	return uuid, err
-}
-
 // CreateUser creates a new user
 func (s *userServiceServer) CreateUser(ctx context.Context, req *userV1.CreateUserRequest) (*userV1.CreateUserResponse, error) {
 	logger := logging.WithFields("operation", "CreateUser", "username", req.Username, "email", req.Email)
 	logger.Debug("Starting user creation process")
 
+	if s.signup != nil {
+		remoteIP := clientIP(ctx)
+		if err := s.signup.CheckSignup(ctx, remoteIP, req.Email, req.CaptchaToken); err != nil {
+			logger.Warn("User creation rejected by signup protection", "remote_ip", remoteIP, "error", err)
+			return nil, signupError(err)
+		}
+	}
+
 	start := time.Now()
 	// Hash the password
 	logger.Debug("Hashing user password")
@@ -160,12 +194,41 @@ func (s *userServiceServer) CreateUser(ctx context.Context, req *userV1.CreateUs
 	}, nil
 }
 
+// signupError maps a signup protection failure to the status the client sees
+func signupError(err error) error {
+	switch {
+	case errors.Is(err, signup.ErrRateLimited):
+		return status.Error(codes.ResourceExhausted, signup.ErrRateLimited.Error())
+	case errors.Is(err, signup.ErrCaptchaRequired):
+		return status.Error(codes.InvalidArgument, signup.ErrCaptchaRequired.Error())
+	case errors.Is(err, signup.ErrCaptchaFailed):
+		return status.Error(codes.PermissionDenied, signup.ErrCaptchaFailed.Error())
+	case errors.Is(err, signup.ErrBlockedEmail):
+		return status.Error(codes.InvalidArgument, signup.ErrBlockedEmail.Error())
+	default:
+		return status.Errorf(codes.Internal, "failed to check signup")
+	}
+}
+
+// clientIP returns the address of the client that sent the request, without its port
+func clientIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
 // GetUser gets a user by ID
 func (s *userServiceServer) GetUser(ctx context.Context, req *userV1.GetUserRequest) (*userV1.GetUserResponse, error) {
 	logger := s.logger.With("operation", "GetUser", "user_id_request", req.Id)
 	logger.Debug("Received GetUser request")
 
-	uuid, err := parseUUID(req.Id)
+	uuid, err := uuidutil.Parse(req.Id)
 	if err != nil {
 		logger.Warn("Invalid user ID format", "user_id_request", req.Id, "error", err)
 		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
@@ -222,7 +285,7 @@ func (s *userServiceServer) UpdateUser(ctx context.Context, req *userV1.UpdateUs
 	logger := s.logger.With("operation", "UpdateUser", "user_id_request", req.Id)
 	logger.Debug("Received UpdateUser request")
 
-	uuid, err := parseUUID(req.Id)
+	uuid, err := uuidutil.Parse(req.Id)
 	if err != nil {
 		logger.Warn("Invalid user ID format", "user_id_request", req.Id, "error", err)
 		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
@@ -277,7 +340,7 @@ func (s *userServiceServer) DeleteUser(ctx context.Context, req *userV1.DeleteUs
 	logger := s.logger.With("operation", "DeleteUser", "user_id_request", req.Id)
 	logger.Debug("Received DeleteUser request")
 
-	uuid, err := parseUUID(req.Id)
+	uuid, err := uuidutil.Parse(req.Id)
 	if err != nil {
 		logger.Warn("Invalid user ID format", "user_id_request", req.Id, "error", err)
 		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
@@ -392,6 +455,63 @@ func (s *userServiceServer) Login(ctx context.Context, req *userV1.LoginRequest)
 		return nil, status.Errorf(codes.Unauthenticated, "invalid credentials")
 	}
 
+	token, err := s.issueToken(ctx, loggerWithUser, user, req.WorldId)
+	if err != nil {
+		return nil, err
+	}
+
+	duration := time.Since(start)
+	loggerWithUser.Info("User authentication successful", "duration", duration)
+
+	return &userV1.LoginResponse{
+		Token: token,
+		User:  s.dbUserToProto(user),
+	}, nil
+}
+
+// requestedWorld checks the world a login asked to join and returns its ID in the JWT's
+// format. No world leaves the session to the default world.
+func (s *userServiceServer) requestedWorld(ctx context.Context, loggerWithUser *log.Logger, requestedWorldID string) (string, error) {
+	if requestedWorldID == "" {
+		return "", nil
+	}
+	worldID, err := uuidutil.Parse(requestedWorldID)
+	if err != nil {
+		loggerWithUser.Warn("Authentication failed: invalid world ID", "world_id", requestedWorldID)
+		return "", status.Errorf(codes.InvalidArgument, "invalid world ID: %v", err)
+	}
+	if s.worlds != nil {
+		if _, err := s.worlds.GetWorldByID(ctx, worldID); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				loggerWithUser.Warn("Authentication failed: world not found", "world_id", requestedWorldID)
+				return "", status.Errorf(codes.NotFound, "world not found")
+			}
+			loggerWithUser.Error("Failed to look up world", "world_id", requestedWorldID, "error", err)
+			return "", status.Errorf(codes.Internal, "failed to look up world")
+		}
+	}
+	return hex.EncodeToString(worldID.Bytes[:]), nil
+}
+
+// issueToken completes a login whose credentials were checked: it applies the maintenance
+// gate, resets failed attempts, and returns a token bound to the requested world, if any
+func (s *userServiceServer) issueToken(ctx context.Context, loggerWithUser *log.Logger, user db.User, requestedWorldID string) (string, error) {
+	userID := hex.EncodeToString(user.ID.Bytes[:])
+
+	// Only admins may log in during maintenance, so they can end it
+	if s.maintenance != nil && !middleware.IsAdminUserID(userID) {
+		if err := s.maintenance.CheckLogin(ctx); err != nil {
+			loggerWithUser.Info("Login rejected during maintenance")
+			return "", err
+		}
+	}
+
+	// Bind the session to the requested world, if any
+	worldID, err := s.requestedWorld(ctx, loggerWithUser, requestedWorldID)
+	if err != nil {
+		return "", err
+	}
+
 	// Reset failed login attempts and update last login
 	loggerWithUser.Debug("Resetting failed login attempts")
 	_, err = s.userRepo.UpdateLoginAttempts(ctx, db.UpdateLoginAttemptsParams{
@@ -401,49 +521,297 @@ func (s *userServiceServer) Login(ctx context.Context, req *userV1.LoginRequest)
 	})
 	if err != nil {
 		loggerWithUser.Error("Failed to reset login attempts", "error", err)
-		return nil, status.Errorf(codes.Internal, "failed to update login attempts: %v", err)
+		return "", status.Errorf(codes.Internal, "failed to update login attempts: %v", err)
 	}
 
 	// Update last login time
 	loggerWithUser.Debug("Updating last login timestamp")
 	_, err = s.userRepo.UpdateLastLoginAt(ctx, db.UpdateLastLoginAtParams{
 		ID:          user.ID,
-		LastLoginAt: pgtype.Timestamp{Time: time.Now(), Valid: true},
+		LastLoginAt: pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
 	})
 	if err != nil {
 		loggerWithUser.Error("Failed to update last login time", "error", err)
 	}
 
 	// Generate JWT token
-	loggerWithUser.Debug("Generating JWT token")
-	token, err := s.jwtService.GenerateToken(userID, user.Username)
+	loggerWithUser.Debug("Generating JWT token", "world_id", worldID)
+	token, err := s.jwtService.GenerateToken(userID, user.Username, worldID)
 	if err != nil {
 		loggerWithUser.Error("Failed to generate JWT token", "error", err)
-		return nil, status.Errorf(codes.Internal, "failed to generate JWT token: %v", err)
+		return "", status.Errorf(codes.Internal, "failed to generate JWT token: %v", err)
 	}
 
-	duration := time.Since(start)
-	loggerWithUser.Info("User authentication successful", "duration", duration)
+	// A token without a session could not be signed out, so failing to record one fails the login
+	if s.sessions != nil {
+		if err := s.startSession(ctx, userID, token); err != nil {
+			loggerWithUser.Error("Failed to start session", "error", err)
+			return "", err
+		}
+	}
 
-	return &userV1.LoginResponse{
-		Token: token,
-		User:  s.dbUserToProto(user),
+	return token, nil
+}
+
+// OAuthLogin logs in with an access token from an OAuth provider, creating an account for
+// provider accounts not linked to one yet
+func (s *userServiceServer) OAuthLogin(ctx context.Context, req *userV1.OAuthLoginRequest) (*userV1.OAuthLoginResponse, error) {
+	logger := logging.WithFields("operation", "OAuthLogin", "provider", req.Provider)
+	logger.Debug("Starting federated authentication")
+	if s.identities == nil {
+		return nil, status.Errorf(codes.Unimplemented, "federated login is not enabled")
+	}
+
+	start := time.Now()
+	user, created, err := s.identities.Login(ctx, req.Provider, req.AccessToken, clientIP(ctx))
+	if err != nil {
+		logger.Warn("Federated authentication failed", "error", err, "duration", time.Since(start))
+		return nil, err
+	}
+	userID := hex.EncodeToString(user.ID.Bytes[:])
+	loggerWithUser := logger.With("user_id", userID, "username", user.Username)
+	if user.AccountLocked.Bool {
+		loggerWithUser.Warn("Authentication failed: account is locked")
+		return nil, status.Errorf(codes.PermissionDenied, "account is locked")
+	}
+
+	token, err := s.issueToken(ctx, loggerWithUser, user, req.WorldId)
+	if err != nil {
+		return nil, err
+	}
+
+	loggerWithUser.Info("Federated authentication successful", "created", created, "duration", time.Since(start))
+	return &userV1.OAuthLoginResponse{
+		Token:   token,
+		User:    s.dbUserToProto(user),
+		Created: created,
 	}, nil
 }
 
-// Logout logs out a user
+// LinkIdentity links the provider account behind an access token to the caller
+func (s *userServiceServer) LinkIdentity(ctx context.Context, req *userV1.LinkIdentityRequest) (*userV1.LinkIdentityResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if s.identities == nil {
+		return nil, status.Errorf(codes.Unimplemented, "federated login is not enabled")
+	}
+
+	identity, err := s.identities.Link(ctx, userID, req.Provider, req.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	return &userV1.LinkIdentityResponse{Identity: identity}, nil
+}
+
+// UnlinkIdentity removes the caller's identity at a provider
+func (s *userServiceServer) UnlinkIdentity(ctx context.Context, req *userV1.UnlinkIdentityRequest) (*userV1.UnlinkIdentityResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if s.identities == nil {
+		return nil, status.Errorf(codes.Unimplemented, "federated login is not enabled")
+	}
+
+	identity, err := s.identities.Unlink(ctx, userID, req.Provider)
+	if err != nil {
+		return nil, err
+	}
+	return &userV1.UnlinkIdentityResponse{Identity: identity}, nil
+}
+
+// ListIdentities returns the caller's linked identities and the providers they may link
+func (s *userServiceServer) ListIdentities(ctx context.Context, req *userV1.ListIdentitiesRequest) (*userV1.ListIdentitiesResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if s.identities == nil {
+		return &userV1.ListIdentitiesResponse{}, nil
+	}
+
+	identities, err := s.identities.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &userV1.ListIdentitiesResponse{
+		Identities: identities,
+		Providers:  s.identities.Providers(),
+	}, nil
+}
+
+// GetConsentStatus returns the current terms of service and privacy policy and whether
+// the caller must accept them before playing
+func (s *userServiceServer) GetConsentStatus(ctx context.Context, req *userV1.GetConsentStatusRequest) (*userV1.GetConsentStatusResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if s.consent == nil {
+		return &userV1.GetConsentStatusResponse{}, nil
+	}
+
+	documents, err := s.consent.Status(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &userV1.GetConsentStatusResponse{Documents: documents}, nil
+}
+
+// AcceptConsent records that the caller accepted the current version of a document
+func (s *userServiceServer) AcceptConsent(ctx context.Context, req *userV1.AcceptConsentRequest) (*userV1.AcceptConsentResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if s.consent == nil {
+		return nil, status.Errorf(codes.Unimplemented, "consent tracking is not enabled")
+	}
+
+	document, err := s.consent.Accept(ctx, userID, req.Kind, req.Version, clientIP(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &userV1.AcceptConsentResponse{Document: document}, nil
+}
+
+// startSession records the session a login token belongs to, expiring with the token
+func (s *userServiceServer) startSession(ctx context.Context, userID, token string) error {
+	claims, err := s.jwtService.ValidateToken(token)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to read JWT token: %v", err)
+	}
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return status.Errorf(codes.Internal, "JWT token has no expiry")
+	}
+
+	userAgent := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("user-agent"); len(values) > 0 {
+			userAgent = values[0]
+		}
+	}
+	_, err = s.sessions.Start(ctx, userID, token, clientIP(ctx), userAgent, time.Unix(int64(exp), 0))
+	return err
+}
+
+// Logout logs out a user by revoking the session making the request. Tokens issued before
+// sessions were recorded stay valid until they expire.
 func (s *userServiceServer) Logout(ctx context.Context, req *userV1.LogoutRequest) (*userV1.LogoutResponse, error) {
 	logger := s.logger.With("operation", "Logout")
 	logger.Debug("Received Logout request")
 
-	// In a real implementation, you would invalidate the token
-	// For now, just return success
-	logger.Info("User logged out successfully (token invalidation not implemented)")
+	sessionID, ok := middleware.GetSessionIDFromContext(ctx)
+	if s.sessions == nil || !ok {
+		logger.Info("User logged out without a session to revoke")
+		return &userV1.LogoutResponse{
+			Success: true,
+		}, nil
+	}
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.sessions.Revoke(ctx, userID, sessionID); err != nil && status.Code(err) != codes.NotFound {
+		logger.Error("Failed to revoke session", "session_id", sessionID, "error", err)
+		return nil, err
+	}
+	s.forgetCalibration(sessionID)
+
+	logger.Info("User logged out successfully", "user_id", userID, "session_id", sessionID)
 	return &userV1.LogoutResponse{
 		Success: true,
 	}, nil
 }
 
+// ListSessions returns the caller's live sessions, most recently seen first
+func (s *userServiceServer) ListSessions(ctx context.Context, req *userV1.ListSessionsRequest) (*userV1.ListSessionsResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if s.sessions == nil {
+		return nil, status.Errorf(codes.Unimplemented, "sessions are not enabled")
+	}
+
+	sessionID, _ := middleware.GetSessionIDFromContext(ctx)
+	sessions, err := s.sessions.List(ctx, userID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	return &userV1.ListSessionsResponse{Sessions: sessions}, nil
+}
+
+// RevokeSession signs one of the caller's sessions out, including the current one
+func (s *userServiceServer) RevokeSession(ctx context.Context, req *userV1.RevokeSessionRequest) (*userV1.RevokeSessionResponse, error) {
+	userID, err := authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if s.sessions == nil {
+		return nil, status.Errorf(codes.Unimplemented, "sessions are not enabled")
+	}
+	if req.SessionId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "session ID is required")
+	}
+
+	session, err := s.sessions.Revoke(ctx, userID, req.SessionId)
+	if err != nil {
+		return nil, err
+	}
+	s.forgetCalibration(req.SessionId)
+	s.logger.Info("Session revoked", "user_id", userID, "session_id", req.SessionId)
+	return &userV1.RevokeSessionResponse{Session: session}, nil
+}
+
+// forgetCalibration drops the latency calibration of a session that signed out
+func (s *userServiceServer) forgetCalibration(sessionID string) {
+	if s.clockSync != nil {
+		s.clockSync.Forget(middleware.SessionConnectionKey(sessionID))
+	}
+}
+
+// SyncClock takes the round trip of the echoed probe as a latency sample for the calling
+// session and returns a new probe
+func (s *userServiceServer) SyncClock(ctx context.Context, req *userV1.SyncClockRequest) (*userV1.SyncClockResponse, error) {
+	if _, err := authenticatedUser(ctx); err != nil {
+		return nil, err
+	}
+	if s.clockSync == nil {
+		return nil, status.Errorf(codes.Unimplemented, "clock sync is not enabled")
+	}
+	connectionID := middleware.ConnectionKeyFromContext(ctx)
+
+	calibration := s.clockSync.Calibration(connectionID)
+	if req.Probe != "" {
+		if req.ClientTime == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "client time is required with a probe")
+		}
+		var err error
+		calibration, err = s.clockSync.Observe(connectionID, req.Probe, req.ClientTime.AsTime())
+		switch {
+		case errors.Is(err, latency.ErrInvalidProbe):
+			return nil, status.Errorf(codes.InvalidArgument, "invalid probe")
+		case errors.Is(err, latency.ErrStaleProbe):
+			return nil, status.Errorf(codes.InvalidArgument, "probe expired or already used")
+		case err != nil:
+			return nil, status.Errorf(codes.Internal, "failed to sync clock")
+		}
+	}
+
+	return &userV1.SyncClockResponse{
+		Probe:       s.clockSync.Probe(connectionID),
+		ServerTime:  timestamppb.New(s.clock.Now()),
+		RttMs:       calibration.RTT.Milliseconds(),
+		OffsetMs:    calibration.Offset.Milliseconds(),
+		Samples:     int32(calibration.Samples),
+		AllowanceMs: calibration.Allowance().Milliseconds(),
+	}, nil
+}
+
 // RequestPasswordReset initiates a password reset
 func (s *userServiceServer) RequestPasswordReset(ctx context.Context, req *userV1.RequestPasswordResetRequest) (*userV1.RequestPasswordResetResponse, error) {
 	logger := s.logger.With("operation", "RequestPasswordReset", "email", req.Email)
@@ -470,7 +838,7 @@ func (s *userServiceServer) RequestPasswordReset(ctx context.Context, req *userV
 	}
 
 	// Set token expiration to 1 hour
-	expires := time.Now().Add(time.Hour)
+	expires := s.clock.Now().Add(time.Hour)
 
 	logger.Debug("Updating user with reset token and expiration")
 	_, err = s.userRepo.UpdatePasswordResetToken(ctx, db.UpdatePasswordResetTokenParams{
@@ -549,7 +917,7 @@ func (s *userServiceServer) VerifyEmail(ctx context.Context, req *userV1.VerifyE
 	logger := s.logger.With("operation", "VerifyEmail", "user_id_request", req.Id)
 	logger.Debug("Received VerifyEmail request")
 
-	uuid, err := parseUUID(req.Id)
+	uuid, err := uuidutil.Parse(req.Id)
 	if err != nil {
 		logger.Warn("Invalid user ID format for email verification", "user_id_request", req.Id, "error", err)
 		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
@@ -4,17 +4,17 @@ import (
 	"context"
 
 	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/storage"
 	"github.com/jackc/pgx/v5/pgtype"
-	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 // userRepository implements the UserRepository interface using the real database
 type userRepository struct {
-	db *pgxpool.Pool
+	db storage.Pool
 }
 
 // NewUserRepository creates a new UserRepository implementation
-func NewUserRepository(db *pgxpool.Pool) UserRepository {
+func NewUserRepository(db storage.Pool) UserRepository {
 	return &userRepository{
 		db: db,
 	}
@@ -78,4 +78,4 @@ func (r *userRepository) DeleteUser(ctx context.Context, id pgtype.UUID) error {
 // IndexUsers lists users with pagination
 func (r *userRepository) IndexUsers(ctx context.Context, params db.IndexUsersParams) ([]db.User, error) {
 	return db.New(r.db).IndexUsers(ctx, params)
-}
\ No newline at end of file
+}
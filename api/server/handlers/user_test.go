@@ -4,20 +4,29 @@ import (
 	"context"
 	"errors"
 	"io"
+	"net"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/latency"
+	"github.com/VoidMesh/api/api/internal/signup"
 	"github.com/VoidMesh/api/api/internal/testmocks/handlers"
 	"github.com/VoidMesh/api/api/internal/testutil"
 	userV1 "github.com/VoidMesh/api/api/proto/user/v1"
+	"github.com/VoidMesh/api/api/server/middleware"
 	"github.com/charmbracelet/log"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
@@ -39,6 +48,7 @@ func TestUserServiceServer_CreateUser(t *testing.T) {
 		jwtService:      mockJWT,
 		passwordService: mockPassword,
 		tokenGenerator:  mockToken,
+		clock:           clock.New(),
 		logger:          log.New(io.Discard),
 	}
 
@@ -198,6 +208,34 @@ func TestUserServiceServer_CreateUser(t *testing.T) {
 	}
 }
 
+func TestUserServiceServer_CreateUser_SignupProtection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mockhandlers.NewMockUserRepository(ctrl)
+	mockPassword := mockhandlers.NewMockPasswordService(ctrl)
+	server := &userServiceServer{
+		userRepo:        mockRepo,
+		passwordService: mockPassword,
+		clock:           clock.New(),
+		signup:          signup.NewGuard(signup.Config{MaxPerIP: 2, RateWindow: time.Hour, BlockDisposableEmail: true}),
+		logger:          log.New(io.Discard),
+	}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("203.0.113.7"), Port: 40000}})
+
+	_, err := server.CreateUser(ctx, &userV1.CreateUserRequest{Username: "bot1", Email: "bot@mailinator.com", Password: "password123"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	mockPassword.EXPECT().HashPassword("password123").Return("hashed_password", nil)
+	mockRepo.EXPECT().CreateUser(gomock.Any(), gomock.Any()).Return(db.User{Username: "player"}, nil)
+	_, err = server.CreateUser(ctx, &userV1.CreateUserRequest{Username: "player", Email: "player@example.com", Password: "password123"})
+	require.NoError(t, err)
+
+	// Rejected and accepted signups both count against the address
+	_, err = server.CreateUser(ctx, &userV1.CreateUserRequest{Username: "bot2", Email: "bot2@example.com", Password: "password123"})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
 // TestUserServiceServer_GetUser demonstrates testing patterns for user retrieval
 func TestUserServiceServer_GetUser(t *testing.T) {
 	ctrl := gomock.NewController(t)
@@ -213,6 +251,7 @@ func TestUserServiceServer_GetUser(t *testing.T) {
 		jwtService:      mockJWT,
 		passwordService: mockPassword,
 		tokenGenerator:  mockToken,
+		clock:           clock.New(),
 		logger:          log.New(io.Discard),
 	}
 
@@ -309,6 +348,7 @@ func TestUserServiceServer_Login(t *testing.T) {
 		jwtService:      mockJWT,
 		passwordService: mockPassword,
 		tokenGenerator:  mockToken,
+		clock:           clock.New(),
 		logger:          log.New(io.Discard),
 	}
 
@@ -364,7 +404,7 @@ func TestUserServiceServer_Login(t *testing.T) {
 				// Mock JWT generation - handler passes hex format without dashes
 				expectedUserID := strings.ReplaceAll(testutil.UUIDTestData.User1, "-", "")
 				mockJWT.EXPECT().
-					GenerateToken(expectedUserID, "testuser").
+					GenerateToken(expectedUserID, "testuser", "").
 					Return("jwt_token", nil)
 			},
 			wantErr: false,
@@ -402,7 +442,7 @@ func TestUserServiceServer_Login(t *testing.T) {
 
 				expectedUserID := strings.ReplaceAll(testutil.UUIDTestData.User1, "-", "")
 				mockJWT.EXPECT().
-					GenerateToken(expectedUserID, "testuser").
+					GenerateToken(expectedUserID, "testuser", "").
 					Return("jwt_token", nil)
 			},
 			wantErr: false,
@@ -494,7 +534,7 @@ func TestUserServiceServer_Login(t *testing.T) {
 
 				expectedUserID := strings.ReplaceAll(testutil.UUIDTestData.User1, "-", "")
 				mockJWT.EXPECT().
-					GenerateToken(expectedUserID, "testuser").
+					GenerateToken(expectedUserID, "testuser", "").
 					Return("", errors.New("JWT signing error"))
 			},
 			wantErr:  true,
@@ -522,6 +562,428 @@ func TestUserServiceServer_Login(t *testing.T) {
 	}
 }
 
+// closedGate rejects every login
+type closedGate struct{}
+
+func (closedGate) CheckLogin(ctx context.Context) error {
+	return status.Errorf(codes.Unavailable, "the server is down for maintenance")
+}
+
+func TestUserServiceServer_Login_Maintenance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mockhandlers.NewMockUserRepository(ctrl)
+	mockJWT := mockhandlers.NewMockJWTService(ctrl)
+	mockPassword := mockhandlers.NewMockPasswordService(ctrl)
+	server := &userServiceServer{
+		userRepo:        mockRepo,
+		jwtService:      mockJWT,
+		passwordService: mockPassword,
+		clock:           clock.New(),
+		maintenance:     closedGate{},
+		logger:          log.New(io.Discard),
+	}
+	user := db.User{
+		ID:           testutil.ParseTestUUID(t, testutil.UUIDTestData.User1),
+		Username:     "testuser",
+		PasswordHash: "hashed_password",
+	}
+	request := &userV1.LoginRequest{UsernameOrEmail: "testuser", Password: "password123"}
+
+	mockRepo.EXPECT().GetUserByUsername(gomock.Any(), "testuser").Return(user, nil).Times(2)
+	mockPassword.EXPECT().CheckPassword("password123", "hashed_password").Return(true).Times(2)
+	_, err := server.Login(context.Background(), request)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	userID := strings.ReplaceAll(testutil.UUIDTestData.User1, "-", "")
+	middleware.SetAdminUserIDs([]string{userID})
+	t.Cleanup(func() { middleware.SetAdminUserIDs(nil) })
+	mockRepo.EXPECT().UpdateLoginAttempts(gomock.Any(), gomock.Any()).Return(user, nil)
+	mockRepo.EXPECT().UpdateLastLoginAt(gomock.Any(), gomock.Any()).Return(user, nil)
+	mockJWT.EXPECT().GenerateToken(userID, "testuser", "").Return("jwt_token", nil)
+	resp, err := server.Login(context.Background(), request)
+	require.NoError(t, err, "admins can still log in")
+	assert.Equal(t, "jwt_token", resp.Token)
+}
+
+func TestUserServiceServer_Login_World(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mockhandlers.NewMockUserRepository(ctrl)
+	mockJWT := mockhandlers.NewMockJWTService(ctrl)
+	mockPassword := mockhandlers.NewMockPasswordService(ctrl)
+	mockWorlds := mockhandlers.NewMockWorldService(ctrl)
+	server := &userServiceServer{
+		userRepo:        mockRepo,
+		jwtService:      mockJWT,
+		passwordService: mockPassword,
+		clock:           clock.New(),
+		worlds:          mockWorlds,
+		logger:          log.New(io.Discard),
+	}
+	user := db.User{
+		ID:           testutil.ParseTestUUID(t, testutil.UUIDTestData.User1),
+		Username:     "testuser",
+		PasswordHash: "hashed_password",
+	}
+	const worldID = "750e8400-e29b-41d4-a716-446655440000"
+	login := func(worldID string) (*userV1.LoginResponse, error) {
+		return server.Login(context.Background(), &userV1.LoginRequest{UsernameOrEmail: "testuser", Password: "password123", WorldId: worldID})
+	}
+	mockRepo.EXPECT().GetUserByUsername(gomock.Any(), "testuser").Return(user, nil).AnyTimes()
+	mockPassword.EXPECT().CheckPassword("password123", "hashed_password").Return(true).AnyTimes()
+
+	_, err := login("not-a-world")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	mockWorlds.EXPECT().GetWorldByID(gomock.Any(), testutil.ParseTestUUID(t, worldID)).Return(db.World{}, pgx.ErrNoRows)
+	_, err = login(worldID)
+	assert.Equal(t, codes.NotFound, status.Code(err), "unknown worlds are rejected before the login is recorded")
+
+	mockWorlds.EXPECT().GetWorldByID(gomock.Any(), testutil.ParseTestUUID(t, worldID)).Return(db.World{ID: testutil.ParseTestUUID(t, worldID)}, nil)
+	mockRepo.EXPECT().UpdateLoginAttempts(gomock.Any(), gomock.Any()).Return(user, nil)
+	mockRepo.EXPECT().UpdateLastLoginAt(gomock.Any(), gomock.Any()).Return(user, nil)
+	mockJWT.EXPECT().GenerateToken(gomock.Any(), "testuser", strings.ReplaceAll(worldID, "-", "")).Return("jwt_token", nil)
+	resp, err := login(worldID)
+	require.NoError(t, err)
+	assert.Equal(t, "jwt_token", resp.Token)
+}
+
+// stubSessions records the sessions the handler starts and revokes
+type stubSessions struct {
+	started []string // Tokens
+	agents  []string
+	expiry  time.Time
+	revoked []string
+}
+
+func (s *stubSessions) Start(ctx context.Context, userID, token, ipAddress, userAgent string, expiresAt time.Time) (string, error) {
+	s.started = append(s.started, token)
+	s.agents = append(s.agents, userAgent)
+	s.expiry = expiresAt
+	return "session-1", nil
+}
+
+func (s *stubSessions) List(ctx context.Context, userID, currentSessionID string) ([]*userV1.Session, error) {
+	return []*userV1.Session{{Id: "session-1", Current: currentSessionID == "session-1"}, {Id: "session-2"}}, nil
+}
+
+func (s *stubSessions) Revoke(ctx context.Context, userID, sessionID string) (*userV1.Session, error) {
+	if sessionID != "session-1" && sessionID != "session-2" {
+		return nil, status.Errorf(codes.NotFound, "session not found or already revoked")
+	}
+	s.revoked = append(s.revoked, sessionID)
+	return &userV1.Session{Id: sessionID, RevokedAt: timestamppb.Now()}, nil
+}
+
+func TestUserServiceServer_Login_StartsSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mockhandlers.NewMockUserRepository(ctrl)
+	mockJWT := mockhandlers.NewMockJWTService(ctrl)
+	mockPassword := mockhandlers.NewMockPasswordService(ctrl)
+	sessions := &stubSessions{}
+	server := &userServiceServer{
+		userRepo:        mockRepo,
+		jwtService:      mockJWT,
+		passwordService: mockPassword,
+		clock:           clock.New(),
+		sessions:        sessions,
+		logger:          log.New(io.Discard),
+	}
+	user := db.User{
+		ID:           testutil.ParseTestUUID(t, testutil.UUIDTestData.User1),
+		Username:     "testuser",
+		PasswordHash: "hashed_password",
+	}
+	expiresAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	userID := strings.ReplaceAll(testutil.UUIDTestData.User1, "-", "")
+	mockRepo.EXPECT().GetUserByUsername(gomock.Any(), "testuser").Return(user, nil)
+	mockPassword.EXPECT().CheckPassword("password123", "hashed_password").Return(true)
+	mockRepo.EXPECT().UpdateLoginAttempts(gomock.Any(), gomock.Any()).Return(user, nil)
+	mockRepo.EXPECT().UpdateLastLoginAt(gomock.Any(), gomock.Any()).Return(user, nil)
+	mockJWT.EXPECT().GenerateToken(userID, "testuser", "").Return("jwt_token", nil)
+	mockJWT.EXPECT().ValidateToken("jwt_token").Return(map[string]interface{}{"exp": float64(expiresAt.Unix())}, nil)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("user-agent", "VoidMesh/1.4 (Android 14)"))
+	resp, err := server.Login(ctx, &userV1.LoginRequest{UsernameOrEmail: "testuser", Password: "password123"})
+	require.NoError(t, err)
+	assert.Equal(t, "jwt_token", resp.Token)
+	assert.Equal(t, []string{"jwt_token"}, sessions.started)
+	assert.Equal(t, []string{"VoidMesh/1.4 (Android 14)"}, sessions.agents)
+	assert.True(t, expiresAt.Equal(sessions.expiry), "the session expires with the token")
+}
+
+// forgetfulClockSync records the connections whose calibration was dropped
+type forgetfulClockSync struct {
+	*latency.Tracker
+	forgotten []string
+}
+
+func (f *forgetfulClockSync) Forget(connectionID string) {
+	f.forgotten = append(f.forgotten, connectionID)
+}
+
+func TestUserServiceServer_Sessions(t *testing.T) {
+	sessions := &stubSessions{}
+	clockSync := &forgetfulClockSync{Tracker: latency.NewTracker(0)}
+	server := &userServiceServer{
+		clock:     clock.New(),
+		sessions:  sessions,
+		clockSync: clockSync,
+		logger:    log.New(io.Discard),
+	}
+	ctx := middleware.WithSessionID(middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "player"), "session-1")
+
+	list, err := server.ListSessions(ctx, &userV1.ListSessionsRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Sessions, 2)
+	assert.True(t, list.Sessions[0].Current)
+	assert.False(t, list.Sessions[1].Current)
+
+	_, err = server.RevokeSession(ctx, &userV1.RevokeSessionRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = server.RevokeSession(ctx, &userV1.RevokeSessionRequest{SessionId: "session-9"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	revoked, err := server.RevokeSession(ctx, &userV1.RevokeSessionRequest{SessionId: "session-2"})
+	require.NoError(t, err)
+	assert.NotNil(t, revoked.Session.RevokedAt)
+
+	logout, err := server.Logout(ctx, &userV1.LogoutRequest{})
+	require.NoError(t, err)
+	assert.True(t, logout.Success)
+	assert.Equal(t, []string{"session-2", "session-1"}, sessions.revoked, "logout revokes the current session")
+	assert.Equal(t, []string{"session:session-2", "session:session-1"}, clockSync.forgotten, "signed out sessions lose their calibration")
+
+	_, err = server.ListSessions(context.Background(), &userV1.ListSessionsRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	server.sessions = nil
+	_, err = server.ListSessions(ctx, &userV1.ListSessionsRequest{})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+// stubIdentities logs in the user it holds and links identities in memory
+type stubIdentities struct {
+	user    db.User
+	created bool
+	linked  map[string]bool // By provider
+}
+
+func (s *stubIdentities) Login(ctx context.Context, provider, accessToken, remoteIP string) (db.User, bool, error) {
+	if accessToken != "valid" {
+		return db.User{}, false, status.Errorf(codes.Unauthenticated, "invalid %s access token", provider)
+	}
+	return s.user, s.created, nil
+}
+
+func (s *stubIdentities) Link(ctx context.Context, userID, provider, accessToken string) (*userV1.LinkedIdentity, error) {
+	if s.linked[provider] {
+		return nil, status.Errorf(codes.AlreadyExists, "a %s account is already linked", provider)
+	}
+	s.linked[provider] = true
+	return &userV1.LinkedIdentity{Provider: provider}, nil
+}
+
+func (s *stubIdentities) Unlink(ctx context.Context, userID, provider string) (*userV1.LinkedIdentity, error) {
+	if !s.linked[provider] {
+		return nil, status.Errorf(codes.NotFound, "no %s account is linked", provider)
+	}
+	delete(s.linked, provider)
+	return &userV1.LinkedIdentity{Provider: provider}, nil
+}
+
+func (s *stubIdentities) List(ctx context.Context, userID string) ([]*userV1.LinkedIdentity, error) {
+	var identities []*userV1.LinkedIdentity
+	for provider := range s.linked {
+		identities = append(identities, &userV1.LinkedIdentity{Provider: provider})
+	}
+	return identities, nil
+}
+
+func (s *stubIdentities) Providers() []string {
+	return []string{"discord", "google"}
+}
+
+func TestUserServiceServer_OAuthLogin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mockhandlers.NewMockUserRepository(ctrl)
+	mockJWT := mockhandlers.NewMockJWTService(ctrl)
+	identities := &stubIdentities{
+		user: db.User{
+			ID:       testutil.ParseTestUUID(t, testutil.UUIDTestData.User1),
+			Username: "ada",
+		},
+		created: true,
+	}
+	server := &userServiceServer{
+		userRepo:   mockRepo,
+		jwtService: mockJWT,
+		clock:      clock.New(),
+		logger:     log.New(io.Discard),
+	}
+
+	_, err := server.OAuthLogin(context.Background(), &userV1.OAuthLoginRequest{Provider: "discord", AccessToken: "valid"})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	server.identities = identities
+	_, err = server.OAuthLogin(context.Background(), &userV1.OAuthLoginRequest{Provider: "discord", AccessToken: "forged"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	userID := strings.ReplaceAll(testutil.UUIDTestData.User1, "-", "")
+	mockRepo.EXPECT().UpdateLoginAttempts(gomock.Any(), gomock.Any()).Return(identities.user, nil)
+	mockRepo.EXPECT().UpdateLastLoginAt(gomock.Any(), gomock.Any()).Return(identities.user, nil)
+	mockJWT.EXPECT().GenerateToken(userID, "ada", "").Return("jwt_token", nil)
+	resp, err := server.OAuthLogin(context.Background(), &userV1.OAuthLoginRequest{Provider: "discord", AccessToken: "valid"})
+	require.NoError(t, err)
+	assert.Equal(t, "jwt_token", resp.Token)
+	assert.Equal(t, "ada", resp.User.Username)
+	assert.True(t, resp.Created)
+
+	identities.user.AccountLocked = pgtype.Bool{Bool: true, Valid: true}
+	_, err = server.OAuthLogin(context.Background(), &userV1.OAuthLoginRequest{Provider: "discord", AccessToken: "valid"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "locked accounts cannot log in with a provider either")
+}
+
+func TestUserServiceServer_Identities(t *testing.T) {
+	server := &userServiceServer{
+		clock:  clock.New(),
+		logger: log.New(io.Discard),
+	}
+	ctx := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "player")
+
+	list, err := server.ListIdentities(ctx, &userV1.ListIdentitiesRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, list.Providers, "no providers are offered when federated login is off")
+	_, err = server.LinkIdentity(ctx, &userV1.LinkIdentityRequest{Provider: "discord", AccessToken: "valid"})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	server.identities = &stubIdentities{linked: map[string]bool{}}
+	_, err = server.LinkIdentity(context.Background(), &userV1.LinkIdentityRequest{Provider: "discord", AccessToken: "valid"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	linked, err := server.LinkIdentity(ctx, &userV1.LinkIdentityRequest{Provider: "discord", AccessToken: "valid"})
+	require.NoError(t, err)
+	assert.Equal(t, "discord", linked.Identity.Provider)
+	_, err = server.LinkIdentity(ctx, &userV1.LinkIdentityRequest{Provider: "discord", AccessToken: "valid"})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	list, err = server.ListIdentities(ctx, &userV1.ListIdentitiesRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Identities, 1)
+	assert.Equal(t, []string{"discord", "google"}, list.Providers)
+
+	unlinked, err := server.UnlinkIdentity(ctx, &userV1.UnlinkIdentityRequest{Provider: "discord"})
+	require.NoError(t, err)
+	assert.Equal(t, "discord", unlinked.Identity.Provider)
+	_, err = server.UnlinkIdentity(ctx, &userV1.UnlinkIdentityRequest{Provider: "discord"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// stubConsent holds one terms of service version and the version the caller accepted
+type stubConsent struct {
+	accepted int32
+	ip       string
+}
+
+func (s *stubConsent) Status(ctx context.Context, userID string) ([]*userV1.ConsentDocument, error) {
+	return []*userV1.ConsentDocument{{
+		Kind:            userV1.ConsentKind_CONSENT_KIND_TERMS_OF_SERVICE,
+		Version:         2,
+		RequiredVersion: 2,
+		AcceptedVersion: s.accepted,
+		MustAccept:      s.accepted < 2,
+	}}, nil
+}
+
+func (s *stubConsent) Accept(ctx context.Context, userID string, kind userV1.ConsentKind, version int32, ipAddress string) (*userV1.ConsentDocument, error) {
+	if version != 2 {
+		return nil, status.Errorf(codes.FailedPrecondition, "version %d of the terms of service is not current", version)
+	}
+	s.accepted, s.ip = version, ipAddress
+	return &userV1.ConsentDocument{Kind: kind, Version: 2, RequiredVersion: 2, AcceptedVersion: 2}, nil
+}
+
+func TestUserServiceServer_Consent(t *testing.T) {
+	server := &userServiceServer{
+		clock:  clock.New(),
+		logger: log.New(io.Discard),
+	}
+	ctx := middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "player")
+
+	consentStatus, err := server.GetConsentStatus(ctx, &userV1.GetConsentStatusRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, consentStatus.Documents, "nothing is required when consent is not tracked")
+	_, err = server.AcceptConsent(ctx, &userV1.AcceptConsentRequest{Kind: userV1.ConsentKind_CONSENT_KIND_TERMS_OF_SERVICE, Version: 2})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	consent := &stubConsent{accepted: 1}
+	server.consent = consent
+	_, err = server.GetConsentStatus(context.Background(), &userV1.GetConsentStatusRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	consentStatus, err = server.GetConsentStatus(ctx, &userV1.GetConsentStatusRequest{})
+	require.NoError(t, err)
+	require.Len(t, consentStatus.Documents, 1)
+	assert.True(t, consentStatus.Documents[0].MustAccept)
+
+	_, err = server.AcceptConsent(ctx, &userV1.AcceptConsentRequest{Kind: userV1.ConsentKind_CONSENT_KIND_TERMS_OF_SERVICE, Version: 1})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	peerCtx := peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("203.0.113.7"), Port: 51234}})
+	accepted, err := server.AcceptConsent(peerCtx, &userV1.AcceptConsentRequest{Kind: userV1.ConsentKind_CONSENT_KIND_TERMS_OF_SERVICE, Version: 2})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), accepted.Document.AcceptedVersion)
+	assert.Equal(t, "203.0.113.7", consent.ip, "the acceptance records where it came from")
+}
+
+func TestUserServiceServer_SyncClock(t *testing.T) {
+	fake := clock.NewFake(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	server := &userServiceServer{
+		clock:  fake,
+		logger: log.New(io.Discard),
+	}
+	ctx := middleware.WithSessionID(middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "player"), "session-1")
+
+	_, err := server.SyncClock(ctx, &userV1.SyncClockRequest{})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	tracker := latency.NewTracker(0)
+	tracker.SetClock(fake)
+	server.clockSync = tracker
+	_, err = server.SyncClock(context.Background(), &userV1.SyncClockRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	first, err := server.SyncClock(ctx, &userV1.SyncClockRequest{})
+	require.NoError(t, err)
+	assert.NotEmpty(t, first.Probe)
+	assert.Zero(t, first.Samples, "the first call only starts the handshake")
+
+	fake.Advance(40 * time.Millisecond)
+	clientTime := timestamppb.New(fake.Now().Add(2 * time.Second))
+	fake.Advance(40 * time.Millisecond)
+	_, err = server.SyncClock(ctx, &userV1.SyncClockRequest{Probe: first.Probe})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "a probe needs the client time")
+	second, err := server.SyncClock(ctx, &userV1.SyncClockRequest{Probe: first.Probe, ClientTime: clientTime})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), second.Samples)
+	assert.Equal(t, int64(80), second.RttMs)
+	assert.Equal(t, int64(2000), second.OffsetMs)
+	assert.Equal(t, int64(120), second.AllowanceMs)
+
+	_, err = server.SyncClock(ctx, &userV1.SyncClockRequest{Probe: first.Probe, ClientTime: clientTime})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "probes count once")
+	other := middleware.WithSessionID(middleware.CreateTestContextWithAuth(testutil.UUIDTestData.User1, "player"), "session-2")
+	_, err = server.SyncClock(other, &userV1.SyncClockRequest{Probe: second.Probe, ClientTime: clientTime})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "probes belong to the session they were sent to")
+}
+
 // TestUserServiceServer_UpdateUser demonstrates testing patterns for user updates
 func TestUserServiceServer_UpdateUser(t *testing.T) {
 	ctrl := gomock.NewController(t)
@@ -537,6 +999,7 @@ func TestUserServiceServer_UpdateUser(t *testing.T) {
 		jwtService:      mockJWT,
 		passwordService: mockPassword,
 		tokenGenerator:  mockToken,
+		clock:           clock.New(),
 		logger:          log.New(io.Discard),
 	}
 
@@ -657,6 +1120,7 @@ func TestUserServiceServer_RequestPasswordReset(t *testing.T) {
 		jwtService:      mockJWT,
 		passwordService: mockPassword,
 		tokenGenerator:  mockToken,
+		clock:           clock.New(),
 		logger:          log.New(io.Discard),
 	}
 
@@ -770,6 +1234,7 @@ func TestUserServiceServer_ListUsers(t *testing.T) {
 		jwtService:      mockJWT,
 		passwordService: mockPassword,
 		tokenGenerator:  mockToken,
+		clock:           clock.New(),
 		logger:          log.New(io.Discard),
 	}
 
@@ -898,6 +1363,7 @@ func BenchmarkUserServiceServer_CreateUser(b *testing.B) {
 		jwtService:      mockJWT,
 		passwordService: mockPassword,
 		tokenGenerator:  mockToken,
+		clock:           clock.New(),
 		logger:          log.New(io.Discard),
 	}
 
@@ -948,6 +1414,7 @@ func BenchmarkUserServiceServer_Login(b *testing.B) {
 		jwtService:      mockJWT,
 		passwordService: mockPassword,
 		tokenGenerator:  mockToken,
+		clock:           clock.New(),
 		logger:          log.New(io.Discard),
 	}
 
@@ -982,7 +1449,7 @@ func BenchmarkUserServiceServer_Login(b *testing.B) {
 		AnyTimes()
 
 	mockJWT.EXPECT().
-		GenerateToken(gomock.Any(), gomock.Any()).
+		GenerateToken(gomock.Any(), gomock.Any(), gomock.Any()).
 		Return("jwt_token", nil).
 		AnyTimes()
 
@@ -1,4 +1,27 @@
 package handlers
 
+import (
+	"github.com/VoidMesh/api/api/internal/streamsession"
+	streamV1 "github.com/VoidMesh/api/api/proto/stream/v1"
+	"google.golang.org/protobuf/proto"
+)
+
 // This file contains utility functions for handlers
 // The previous getWorldSeed function has been removed as we now use the world service
+
+// openStream starts or resumes a sequenced stream. It sends the heartbeat that tells the
+// client its stream ID, then the messages it is resuming.
+func openStream[T proto.Message](registry *streamsession.Registry[T], userID string, resume *streamV1.StreamResume, send func(T) error) (*streamsession.Session[T], error) {
+	session, replay := registry.Open(userID, resume)
+	if err := send(session.Heartbeat()); err != nil {
+		session.Close()
+		return nil, err
+	}
+	for _, msg := range replay {
+		if err := send(msg); err != nil {
+			session.Close()
+			return nil, err
+		}
+	}
+	return session, nil
+}
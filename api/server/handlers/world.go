@@ -4,11 +4,11 @@ import (
 	"context"
 
 	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/pkg/uuidutil"
 	worldV1 "github.com/VoidMesh/api/api/proto/world/v1"
 	"github.com/VoidMesh/api/api/services/world"
 	"github.com/charmbracelet/log"
-	"github.com/jackc/pgx/v5/pgtype"
-	"github.com/jackc/pgx/v5/pgxpool"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -33,7 +33,7 @@ func NewWorldServer(
 
 // NewWorldServerWithPool creates a world server with all dependencies wired up
 // This function maintains backward compatibility while providing dependency injection
-func NewWorldServerWithPool(dbPool *pgxpool.Pool) (worldV1.WorldServiceServer, error) {
+func NewWorldServerWithPool(dbPool storage.Pool) (worldV1.WorldServiceServer, error) {
 	logger := logging.WithComponent("world-handler")
 	logger.Debug("Creating WorldService server with dependency injection")
 
@@ -60,8 +60,7 @@ func (s *worldServiceServer) GetWorld(ctx context.Context, req *worldV1.GetWorld
 	logger := s.logger.With("operation", "GetWorld", "world_id_request", req.WorldId)
 	logger.Debug("Received GetWorld request")
 
-	var worldID pgtype.UUID
-	err := worldID.Scan(string(req.WorldId))
+	worldID, err := uuidutil.Decode(req.WorldId)
 	if err != nil {
 		logger.Warn("Invalid world ID format", "world_id_request", req.WorldId, "error", err)
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid world ID: %v", err)
@@ -76,7 +75,7 @@ func (s *worldServiceServer) GetWorld(ctx context.Context, req *worldV1.GetWorld
 	logger.Info("World retrieved successfully", "world_id", req.WorldId, "world_name", world.Name)
 	return &worldV1.GetWorldResponse{
 		World: &worldV1.World{
-			Id:        world.ID.Bytes[:],
+			Id:        uuidutil.Bytes(world.ID),
 			Name:      world.Name,
 			Seed:      world.Seed,
 			CreatedAt: timestamppb.New(world.CreatedAt.Time),
@@ -98,7 +97,7 @@ func (s *worldServiceServer) GetDefaultWorld(ctx context.Context, req *worldV1.G
 	logger.Info("Default world retrieved successfully", "world_id", world.ID.Bytes[:], "world_name", world.Name)
 	return &worldV1.GetDefaultWorldResponse{
 		World: &worldV1.World{
-			Id:        world.ID.Bytes[:],
+			Id:        uuidutil.Bytes(world.ID),
 			Name:      world.Name,
 			Seed:      world.Seed,
 			CreatedAt: timestamppb.New(world.CreatedAt.Time),
@@ -120,7 +119,7 @@ func (s *worldServiceServer) ListWorlds(ctx context.Context, req *worldV1.ListWo
 	protoWorlds := make([]*worldV1.World, 0, len(worlds))
 	for _, world := range worlds {
 		protoWorlds = append(protoWorlds, &worldV1.World{
-			Id:        world.ID.Bytes[:],
+			Id:        uuidutil.Bytes(world.ID),
 			Name:      world.Name,
 			Seed:      world.Seed,
 			CreatedAt: timestamppb.New(world.CreatedAt.Time),
@@ -138,8 +137,7 @@ func (s *worldServiceServer) UpdateWorldName(ctx context.Context, req *worldV1.U
 	logger := s.logger.With("operation", "UpdateWorldName", "world_id_request", req.WorldId, "new_name", req.Name)
 	logger.Debug("Received UpdateWorldName request")
 
-	var worldID pgtype.UUID
-	err := worldID.Scan(string(req.WorldId))
+	worldID, err := uuidutil.Decode(req.WorldId)
 	if err != nil {
 		logger.Warn("Invalid world ID format", "world_id_request", req.WorldId, "error", err)
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid world ID: %v", err)
@@ -154,7 +152,7 @@ func (s *worldServiceServer) UpdateWorldName(ctx context.Context, req *worldV1.U
 	logger.Info("World name updated successfully", "world_id", world.ID.Bytes[:], "old_name", world.Name, "new_name", req.Name)
 	return &worldV1.UpdateWorldNameResponse{
 		World: &worldV1.World{
-			Id:        world.ID.Bytes[:],
+			Id:        uuidutil.Bytes(world.ID),
 			Name:      world.Name,
 			Seed:      world.Seed,
 			CreatedAt: timestamppb.New(world.CreatedAt.Time),
@@ -167,8 +165,7 @@ func (s *worldServiceServer) DeleteWorld(ctx context.Context, req *worldV1.Delet
 	logger := s.logger.With("operation", "DeleteWorld", "world_id_request", req.WorldId)
 	logger.Debug("Received DeleteWorld request")
 
-	var worldID pgtype.UUID
-	err := worldID.Scan(string(req.WorldId))
+	worldID, err := uuidutil.Decode(req.WorldId)
 	if err != nil {
 		logger.Warn("Invalid world ID format", "world_id_request", req.WorldId, "error", err)
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid world ID: %v", err)
@@ -4,9 +4,9 @@ import (
 	"context"
 
 	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/storage"
 	"github.com/VoidMesh/api/api/services/world"
 	"github.com/jackc/pgx/v5/pgtype"
-	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 // worldServiceWrapper implements the WorldService interface using the real world service
@@ -29,7 +29,7 @@ func NewWorldServiceFromConcreteService(service *world.Service) WorldService {
 
 // NewWorldServiceWithPool creates a world service with all dependencies wired up
 // This function creates the necessary services and dependencies
-func NewWorldServiceWithPool(dbPool *pgxpool.Pool) (WorldService, error) {
+func NewWorldServiceWithPool(dbPool storage.Pool) (WorldService, error) {
 	// Create world service
 	worldLogger := world.NewDefaultLoggerWrapper()
 	worldService := world.NewServiceWithPool(dbPool, worldLogger)
@@ -60,4 +60,4 @@ func (w *worldServiceWrapper) UpdateWorld(ctx context.Context, id pgtype.UUID, n
 // DeleteWorld deletes a world
 func (w *worldServiceWrapper) DeleteWorld(ctx context.Context, id pgtype.UUID) error {
 	return w.service.DeleteWorld(ctx, id)
-}
\ No newline at end of file
+}
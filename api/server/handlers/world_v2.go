@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/pkg/uuidutil"
+	worldV1 "github.com/VoidMesh/api/api/proto/world/v1"
+	worldV2 "github.com/VoidMesh/api/api/proto/world/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// worldServiceV2Server serves world.v2 through the v1 server, converting between
+// UUID strings and the bytes v1 uses
+type worldServiceV2Server struct {
+	worldV2.UnimplementedWorldServiceServer
+	v1 worldV1.WorldServiceServer
+}
+
+func NewWorldServerV2(v1 worldV1.WorldServiceServer) worldV2.WorldServiceServer {
+	return &worldServiceV2Server{v1: v1}
+}
+
+// v1WorldID converts a v2 world ID to the raw bytes v1 requests take. An empty ID stays
+// empty, which v1 treats as missing.
+func v1WorldID(worldID string) ([]byte, error) {
+	if worldID == "" {
+		return nil, nil
+	}
+	id, err := uuidutil.Parse(worldID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid world ID: %v", err)
+	}
+	return uuidutil.Bytes(id), nil
+}
+
+func v2World(world *worldV1.World) *worldV2.World {
+	if world == nil {
+		return nil
+	}
+	id, _ := uuidutil.Decode(world.Id)
+	return &worldV2.World{
+		Id:        uuidutil.String(id),
+		Name:      world.Name,
+		Seed:      world.Seed,
+		CreatedAt: world.CreatedAt,
+	}
+}
+
+// GetWorld retrieves a world by ID
+func (s *worldServiceV2Server) GetWorld(ctx context.Context, req *worldV2.GetWorldRequest) (*worldV2.GetWorldResponse, error) {
+	worldID, err := v1WorldID(req.WorldId)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.v1.GetWorld(ctx, &worldV1.GetWorldRequest{WorldId: worldID})
+	if err != nil {
+		return nil, err
+	}
+	return &worldV2.GetWorldResponse{World: v2World(resp.World)}, nil
+}
+
+// GetDefaultWorld retrieves the default world
+func (s *worldServiceV2Server) GetDefaultWorld(ctx context.Context, req *worldV2.GetDefaultWorldRequest) (*worldV2.GetDefaultWorldResponse, error) {
+	resp, err := s.v1.GetDefaultWorld(ctx, &worldV1.GetDefaultWorldRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return &worldV2.GetDefaultWorldResponse{World: v2World(resp.World)}, nil
+}
+
+// ListWorlds retrieves all worlds
+func (s *worldServiceV2Server) ListWorlds(ctx context.Context, req *worldV2.ListWorldsRequest) (*worldV2.ListWorldsResponse, error) {
+	resp, err := s.v1.ListWorlds(ctx, &worldV1.ListWorldsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	worlds := make([]*worldV2.World, 0, len(resp.Worlds))
+	for _, world := range resp.Worlds {
+		worlds = append(worlds, v2World(world))
+	}
+	return &worldV2.ListWorldsResponse{Worlds: worlds}, nil
+}
+
+// UpdateWorldName updates a world's name
+func (s *worldServiceV2Server) UpdateWorldName(ctx context.Context, req *worldV2.UpdateWorldNameRequest) (*worldV2.UpdateWorldNameResponse, error) {
+	worldID, err := v1WorldID(req.WorldId)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.v1.UpdateWorldName(ctx, &worldV1.UpdateWorldNameRequest{WorldId: worldID, Name: req.Name})
+	if err != nil {
+		return nil, err
+	}
+	return &worldV2.UpdateWorldNameResponse{World: v2World(resp.World)}, nil
+}
+
+// DeleteWorld deletes a world
+func (s *worldServiceV2Server) DeleteWorld(ctx context.Context, req *worldV2.DeleteWorldRequest) (*worldV2.DeleteWorldResponse, error) {
+	worldID, err := v1WorldID(req.WorldId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.v1.DeleteWorld(ctx, &worldV1.DeleteWorldRequest{WorldId: worldID}); err != nil {
+		return nil, err
+	}
+	return &worldV2.DeleteWorldResponse{}, nil
+}
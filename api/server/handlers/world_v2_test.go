@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	mockhandlers "github.com/VoidMesh/api/api/internal/testmocks/handlers"
+	"github.com/VoidMesh/api/api/internal/testutil"
+	worldV1 "github.com/VoidMesh/api/api/proto/world/v1"
+	worldV2 "github.com/VoidMesh/api/api/proto/world/v2"
+	"github.com/charmbracelet/log"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestWorldServiceV2_RoundTrip checks that IDs returned by ListWorlds can be passed back
+// to GetWorld, in v2 as strings and in v1 as raw bytes
+func TestWorldServiceV2_RoundTrip(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockWorldService := mockhandlers.NewMockWorldService(ctrl)
+	v1 := &worldServiceServer{worldService: mockWorldService, logger: log.New(io.Discard)}
+	v2 := NewWorldServerV2(v1)
+
+	worldUUID := testutil.ParseTestUUID(t, testutil.UUIDTestData.World1)
+	world := db.World{
+		ID:        worldUUID,
+		Name:      "Test World",
+		Seed:      12345,
+		CreatedAt: pgtype.Timestamp{Time: time.Now(), Valid: true},
+	}
+	mockWorldService.EXPECT().ListWorlds(gomock.Any()).Return([]db.World{world}, nil).Times(2)
+	mockWorldService.EXPECT().GetWorldByID(gomock.Any(), worldUUID).Return(world, nil).Times(2)
+
+	ctx := context.Background()
+
+	listed, err := v2.ListWorlds(ctx, &worldV2.ListWorldsRequest{})
+	require.NoError(t, err)
+	require.Len(t, listed.Worlds, 1)
+	assert.Equal(t, testutil.UUIDTestData.World1, listed.Worlds[0].Id)
+
+	got, err := v2.GetWorld(ctx, &worldV2.GetWorldRequest{WorldId: listed.Worlds[0].Id})
+	require.NoError(t, err)
+	assert.Equal(t, "Test World", got.World.Name)
+
+	listedV1, err := v1.ListWorlds(ctx, &worldV1.ListWorldsRequest{})
+	require.NoError(t, err)
+	require.Len(t, listedV1.Worlds, 1)
+
+	gotV1, err := v1.GetWorld(ctx, &worldV1.GetWorldRequest{WorldId: listedV1.Worlds[0].Id})
+	require.NoError(t, err)
+	assert.Equal(t, worldUUID.Bytes[:], gotV1.World.Id)
+}
+
+func TestWorldServiceV2_InvalidID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	v2 := NewWorldServerV2(&worldServiceServer{
+		worldService: mockhandlers.NewMockWorldService(ctrl),
+		logger:       log.New(io.Discard),
+	})
+
+	_, err := v2.GetWorld(context.Background(), &worldV2.GetWorldRequest{WorldId: "not-a-uuid"})
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = v2.DeleteWorld(context.Background(), &worldV2.DeleteWorldRequest{WorldId: ""})
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
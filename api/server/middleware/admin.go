@@ -0,0 +1,86 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	adminMu      sync.RWMutex
+	adminUserIDs = map[string]struct{}{}
+)
+
+// SetAdminUserIDs configures which user IDs may call administrative RPCs.
+// Blank entries are ignored so a comma-separated environment variable can be passed through directly.
+func SetAdminUserIDs(ids []string) {
+	admins := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		id = strings.ToLower(strings.TrimSpace(id))
+		if id != "" {
+			admins[id] = struct{}{}
+		}
+	}
+
+	adminMu.Lock()
+	adminUserIDs = admins
+	adminMu.Unlock()
+}
+
+// IsAdmin reports whether the authenticated user in the context is an administrator
+func IsAdmin(ctx context.Context) bool {
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return false
+	}
+	return IsAdminUserID(userID)
+}
+
+// IsAdminUserID reports whether the user ID belongs to an administrator, for checks made
+// before the user is authenticated
+func IsAdminUserID(userID string) bool {
+	if userID == "" {
+		return false
+	}
+
+	adminMu.RLock()
+	defer adminMu.RUnlock()
+	_, ok := adminUserIDs[strings.ToLower(userID)]
+	return ok
+}
+
+// RequireAdmin returns a PermissionDenied error unless the authenticated user is an administrator
+func RequireAdmin(ctx context.Context) error {
+	if !IsAdmin(ctx) {
+		return status.Errorf(codes.PermissionDenied, "admin privileges required")
+	}
+	return nil
+}
+
+// AdminHTTP only lets requests carrying an administrator's JWT as a bearer token through
+// to next, for HTTP listeners such as the diagnostics endpoint that sit outside gRPC
+func AdminHTTP(jwtSecret []byte, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || token == "" {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "missing bearer token", http.StatusUnauthorized)
+			return
+		}
+		claims, err := validateJWTToken(token, jwtSecret)
+		if err != nil {
+			http.Error(w, "invalid token", http.StatusUnauthorized)
+			return
+		}
+		userID, _ := claims["user_id"].(string)
+		if !IsAdminUserID(userID) {
+			http.Error(w, "admin privileges required", http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
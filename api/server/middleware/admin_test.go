@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/VoidMesh/api/api/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestIsAdmin(t *testing.T) {
+	SetAdminUserIDs([]string{" " + strings.ToUpper(testutil.UUIDTestData.User1) + " ", ""})
+	t.Cleanup(func() { SetAdminUserIDs(nil) })
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want bool
+	}{
+		{
+			name: "configured admin (case and whitespace insensitive)",
+			ctx:  CreateTestContextWithAuth(testutil.UUIDTestData.User1, "testuser1"),
+			want: true,
+		},
+		{
+			name: "regular user",
+			ctx:  CreateTestContextWithAuth(testutil.UUIDTestData.User2, "testuser2"),
+			want: false,
+		},
+		{
+			name: "unauthenticated",
+			ctx:  context.Background(),
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsAdmin(tt.ctx))
+
+			err := RequireAdmin(tt.ctx)
+			if tt.want {
+				assert.NoError(t, err)
+			} else {
+				assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestAdminHTTP(t *testing.T) {
+	SetAdminUserIDs([]string{testutil.UUIDTestData.User1})
+	t.Cleanup(func() { SetAdminUserIDs(nil) })
+
+	handler := AdminHTTP([]byte(testutil.TestJWTSecretKey), http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	regular := testutil.DefaultJWTTestConfig()
+	regular.UserID = testutil.UUIDTestData.User2
+	forged := testutil.DefaultJWTTestConfig()
+	forged.SecretKey = "not-the-server-secret"
+
+	tests := []struct {
+		name          string
+		authorization string
+		want          int
+	}{
+		{"admin", "Bearer " + testutil.GenerateTestJWT(t, nil), http.StatusNoContent},
+		{"regular user", "Bearer " + testutil.GenerateTestJWT(t, regular), http.StatusForbidden},
+		{"wrong secret", "Bearer " + testutil.GenerateTestJWT(t, forged), http.StatusUnauthorized},
+		{"not a bearer token", "Basic YWRtaW46YWRtaW4=", http.StatusUnauthorized},
+		{"no token", "", http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/debug/pprof/", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			assert.Equal(t, tt.want, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/VoidMesh/api/api/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// stubAPIKeys accepts one token and only for one method
+type stubAPIKeys struct {
+	methods []string
+}
+
+func (s *stubAPIKeys) AuthenticateAPIKey(ctx context.Context, token, method string) (string, error) {
+	s.methods = append(s.methods, method)
+	if token != "vmk_0123456789ab_secret" {
+		return "", status.Errorf(codes.Unauthenticated, "invalid API key")
+	}
+	if method != "/chunk.v1.ChunkService/GetChunk" {
+		return "", status.Errorf(codes.PermissionDenied, "API key is not allowed to call %s", method)
+	}
+	return "0123456789ab", nil
+}
+
+func apiKeyContext(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func TestJWTAuthInterceptor_APIKey(t *testing.T) {
+	interceptor := JWTAuthInterceptor([]byte(testutil.TestJWTSecretKey))
+
+	_, err := interceptor(apiKeyContext("vmk_0123456789ab_secret"), nil, mockUnaryInfo("/chunk.v1.ChunkService/GetChunk"), mockUnaryHandler)
+	testutil.AssertGRPCError(t, err, codes.Unauthenticated, "API keys are not accepted")
+
+	keys := &stubAPIKeys{}
+	SetAPIKeyAuthenticator(keys)
+	t.Cleanup(func() { SetAPIKeyAuthenticator(nil) })
+
+	var keyID, userID string
+	var hasUser bool
+	_, err = interceptor(apiKeyContext("vmk_0123456789ab_secret"), nil, mockUnaryInfo("/chunk.v1.ChunkService/GetChunk"), func(ctx context.Context, req any) (any, error) {
+		keyID, _ = GetAPIKeyIDFromContext(ctx)
+		userID, hasUser = GetUserIDFromContext(ctx)
+		return nil, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "0123456789ab", keyID)
+	assert.False(t, hasUser, "API keys do not act as a player")
+	assert.Empty(t, userID)
+
+	_, err = interceptor(apiKeyContext("vmk_0123456789ab_secret"), nil, mockUnaryInfo("/character.v1.CharacterService/MoveCharacter"), mockUnaryHandler)
+	testutil.AssertGRPCError(t, err, codes.PermissionDenied)
+	_, err = interceptor(apiKeyContext("vmk_0123456789ab_wrong"), nil, mockUnaryInfo("/chunk.v1.ChunkService/GetChunk"), mockUnaryHandler)
+	testutil.AssertGRPCError(t, err, codes.Unauthenticated, "invalid API key")
+
+	// JWTs are still validated as before
+	_, err = interceptor(testutil.CreateTestContextForUser1(), nil, mockUnaryInfo("/chunk.v1.ChunkService/GetChunk"), mockUnaryHandler)
+	require.NoError(t, err)
+	assert.Len(t, keys.methods, 3)
+}
+
+func TestJWTStreamAuthInterceptor_APIKey(t *testing.T) {
+	SetAPIKeyAuthenticator(&stubAPIKeys{})
+	t.Cleanup(func() { SetAPIKeyAuthenticator(nil) })
+
+	interceptor := JWTStreamAuthInterceptor([]byte(testutil.TestJWTSecretKey))
+	info := &grpc.StreamServerInfo{FullMethod: "/character_actions.v1.CharacterActionsService/StreamActionResults", IsServerStream: true}
+
+	called := false
+	err := interceptor(nil, &fakeServerStream{ctx: apiKeyContext("vmk_0123456789ab_secret")}, info, func(srv any, stream grpc.ServerStream) error {
+		called = true
+		return nil
+	})
+	testutil.AssertGRPCError(t, err, codes.PermissionDenied)
+	assert.False(t, called)
+}
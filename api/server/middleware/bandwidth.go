@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/internal/bandwidth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/proto"
+)
+
+// BandwidthStreamInterceptor accounts every message sent on a stream against the budget of
+// its client connection. Handlers find the meter with bandwidth.FromContext and shape what
+// they send once it is exceeded; the interceptor itself never holds messages back.
+func BandwidthStreamInterceptor(registry *bandwidth.Registry) grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		p, ok := peer.FromContext(ss.Context())
+		if !ok || p.Addr == nil {
+			return handler(srv, ss)
+		}
+		meter, release := registry.Acquire(p.Addr.String())
+		defer release()
+		return handler(srv, &meteredStream{
+			ServerStream: ss,
+			ctx:          bandwidth.WithMeter(ss.Context(), meter),
+			meter:        meter,
+		})
+	}
+}
+
+type meteredStream struct {
+	grpc.ServerStream
+	ctx   context.Context
+	meter *bandwidth.Meter
+}
+
+func (s *meteredStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *meteredStream) SendMsg(m any) error {
+	if err := s.ServerStream.SendMsg(m); err != nil {
+		return err
+	}
+	if message, ok := m.(proto.Message); ok {
+		s.meter.Record(proto.Size(message))
+	}
+	return nil
+}
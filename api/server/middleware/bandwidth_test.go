@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/VoidMesh/api/api/internal/bandwidth"
+	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/proto"
+)
+
+// sendingStream accepts every message
+type sendingStream struct {
+	fakeServerStream
+	sent int
+}
+
+func (s *sendingStream) SendMsg(m any) error {
+	s.sent++
+	return nil
+}
+
+func TestBandwidthStreamInterceptor(t *testing.T) {
+	interceptor := BandwidthStreamInterceptor(bandwidth.NewRegistry(bandwidth.Budget{BytesPerSecond: 1, Burst: 10}))
+	info := &grpc.StreamServerInfo{FullMethod: "/character.v1.CharacterService/StreamNearbyEvents", IsServerStream: true}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 5000}})
+	event := &characterV1.NearbyEvent{X: 100000, Y: 100000}
+
+	var meter *bandwidth.Meter
+	stream := &sendingStream{fakeServerStream: fakeServerStream{ctx: ctx}}
+	err := interceptor(nil, stream, info, func(srv any, ss grpc.ServerStream) error {
+		meter = bandwidth.FromContext(ss.Context())
+		require.NotNil(t, meter, "handlers find the connection's meter")
+		assert.False(t, meter.Exceeded())
+		require.NoError(t, ss.SendMsg(event))
+		require.NoError(t, ss.SendMsg(event))
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, stream.sent, "messages are never held back by the interceptor")
+	assert.Equal(t, int64(2*proto.Size(event)), meter.Sent())
+	assert.True(t, meter.Exceeded())
+
+	// Streams without a peer address are not metered
+	err = interceptor(nil, &sendingStream{fakeServerStream: fakeServerStream{ctx: context.Background()}}, info, func(srv any, ss grpc.ServerStream) error {
+		assert.Nil(t, bandwidth.FromContext(ss.Context()))
+		return nil
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,194 @@
+package middleware
+
+import (
+	"container/list"
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/VoidMesh/api/api/internal/debugstats"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// DatabaseBreaker reports whether the database can take new work
+type DatabaseBreaker interface {
+	Allow() bool
+	Error() error
+}
+
+// DefaultCachedMethods are reads whose responses don't depend on the caller, so the last
+// response to the same request can be served while the database is unavailable
+var DefaultCachedMethods = []string{
+	"/world.v1.WorldService/GetWorld",
+	"/world.v1.WorldService/GetDefaultWorld",
+	"/world.v1.WorldService/ListWorlds",
+	"/world.v2.WorldService/GetWorld",
+	"/world.v2.WorldService/GetDefaultWorld",
+	"/world.v2.WorldService/ListWorlds",
+	"/chunk.v1.ChunkService/GetChunk",
+	"/chunk.v1.ChunkService/GetChunks",
+	"/chunk.v1.ChunkService/GetChunksInRadius",
+	"/chunk.v1.ChunkService/GetChunkSummaries",
+	"/chunk.v1.ChunkService/GetCells",
+	"/chunk.v2.ChunkService/GetChunk",
+	"/chunk.v2.ChunkService/GetChunks",
+	"/chunk.v2.ChunkService/GetChunksInRadius",
+	"/chunk.v2.ChunkService/GetChunkSummaries",
+	"/chunk.v2.ChunkService/GetCells",
+	"/resource_node.v1.ResourceNodeService/GetResourcesInChunk",
+	"/resource_node.v1.ResourceNodeService/GetResourcesInChunks",
+	"/resource_node.v1.ResourceNodeService/GetResourceNodeTypes",
+	"/resource_node.v1.ResourceNodeService/GetSpriteAtlas",
+	"/resource_node.v2.ResourceNodeService/GetResourcesInChunk",
+	"/resource_node.v2.ResourceNodeService/GetResourcesInChunks",
+	"/resource_node.v2.ResourceNodeService/GetResourceNodeTypes",
+	"/resource_node.v2.ResourceNodeService/GetSpriteAtlas",
+}
+
+// DefaultResponseCacheSize is how many responses the breaker keeps for serving reads
+const DefaultResponseCacheSize = 1024
+
+// breakerExemptServices don't need the database or are needed to look into an outage
+var breakerExemptServices = []string{
+	"/debug.v1.",
+	"/grpc.health.v1.",
+	"/grpc.reflection.",
+}
+
+// CircuitBreakerInterceptor fails requests fast while the database breaker is open,
+// instead of letting them queue for connections. Reads of the given methods are answered
+// with the last response to the same request when there is one; every other request gets
+// the breaker's Unavailable error. It must run after JWTAuthInterceptor so cached
+// responses are only served to authenticated callers.
+func CircuitBreakerInterceptor(breaker DatabaseBreaker, cache *ResponseCache, methods []string) grpc.UnaryServerInterceptor {
+	cached := make(map[string]bool, len(methods))
+	for _, method := range methods {
+		cached[method] = true
+	}
+
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if breakerExempt(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		var key string
+		if cached[info.FullMethod] {
+			key = cacheKey(info.FullMethod, req)
+		}
+		if !breaker.Allow() {
+			if resp, ok := cache.Get(key); ok {
+				return resp, nil
+			}
+			return nil, breaker.Error()
+		}
+		resp, err := handler(ctx, req)
+		if err == nil && key != "" {
+			cache.Put(key, resp)
+		}
+		return resp, err
+	}
+}
+
+// CircuitBreakerStreamInterceptor refuses new streams while the database breaker is
+// open. Streams that are already open are not interrupted.
+func CircuitBreakerStreamInterceptor(breaker DatabaseBreaker) grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if !breakerExempt(info.FullMethod) && !breaker.Allow() {
+			return breaker.Error()
+		}
+		return handler(srv, ss)
+	}
+}
+
+func breakerExempt(method string) bool {
+	for _, prefix := range breakerExemptServices {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// cacheKey identifies a request by method and encoded message, or "" if it can't be encoded
+func cacheKey(method string, req any) string {
+	message, ok := req.(proto.Message)
+	if !ok {
+		return ""
+	}
+	encoded, err := proto.MarshalOptions{Deterministic: true}.Marshal(message)
+	if err != nil {
+		return ""
+	}
+	return method + "\x00" + string(encoded)
+}
+
+// ResponseCache keeps the most recently used responses up to a fixed count. Responses are
+// stored as returned and must not be modified afterwards.
+type ResponseCache struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List // Front is the most recently used
+	entries map[string]*list.Element
+}
+
+type cachedResponse struct {
+	key  string
+	resp any
+}
+
+// NewResponseCache creates a cache holding up to size responses
+func NewResponseCache(size int) *ResponseCache {
+	c := &ResponseCache{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+	debugstats.Register(debugstats.CacheEntries, "middleware.breaker_responses", func() int64 {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		return int64(c.order.Len())
+	})
+	return c
+}
+
+// Get returns the response stored for key
+func (c *ResponseCache) Get(key string) (any, bool) {
+	if key == "" {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(element)
+	return element.Value.(*cachedResponse).resp, true
+}
+
+// Put stores the response for key, evicting the least recently used one when full
+func (c *ResponseCache) Put(key string, resp any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if element, ok := c.entries[key]; ok {
+		element.Value.(*cachedResponse).resp = resp
+		c.order.MoveToFront(element)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&cachedResponse{key: key, resp: resp})
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cachedResponse).key)
+	}
+}
@@ -0,0 +1,94 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeBreaker struct {
+	open bool
+}
+
+func (b *fakeBreaker) Allow() bool {
+	return !b.open
+}
+
+func (b *fakeBreaker) Error() error {
+	return status.Error(codes.Unavailable, "the database is unavailable")
+}
+
+func TestCircuitBreakerInterceptor(t *testing.T) {
+	const getChunk = "/chunk.v1.ChunkService/GetChunk"
+	breaker := &fakeBreaker{}
+	interceptor := CircuitBreakerInterceptor(breaker, NewResponseCache(2), []string{getChunk})
+
+	calls := 0
+	handler := func(ctx context.Context, req any) (any, error) {
+		calls++
+		r := req.(*chunkV1.GetChunkRequest)
+		return &chunkV1.GetChunkResponse{Chunk: &chunkV1.ChunkData{ChunkX: r.ChunkX, ChunkY: r.ChunkY}}, nil
+	}
+	call := func(method string, req any) (any, error) {
+		return interceptor(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+	}
+
+	_, err := call(getChunk, &chunkV1.GetChunkRequest{ChunkX: 1, ChunkY: 2})
+	require.NoError(t, err)
+	assert.Equal(t, 1, calls)
+
+	breaker.open = true
+	resp, err := call(getChunk, &chunkV1.GetChunkRequest{ChunkX: 1, ChunkY: 2})
+	require.NoError(t, err, "cached reads are served while the breaker is open")
+	assert.Equal(t, int32(2), resp.(*chunkV1.GetChunkResponse).Chunk.ChunkY)
+	assert.Equal(t, 1, calls, "the handler is not called")
+
+	_, err = call(getChunk, &chunkV1.GetChunkRequest{ChunkX: 5, ChunkY: 5})
+	assert.Equal(t, codes.Unavailable, status.Code(err), "uncached reads fail fast")
+
+	_, err = call("/character_actions.v1.CharacterActionsService/HarvestResource", &chunkV1.GetChunkRequest{})
+	assert.Equal(t, codes.Unavailable, status.Code(err), "writes fail fast")
+
+	_, err = call("/grpc.health.v1.Health/Check", &chunkV1.GetChunkRequest{})
+	require.NoError(t, err, "health checks are exempt")
+	assert.Equal(t, 2, calls)
+}
+
+func TestCircuitBreakerStreamInterceptor(t *testing.T) {
+	breaker := &fakeBreaker{open: true}
+	interceptor := CircuitBreakerStreamInterceptor(breaker)
+	stream := &fakeServerStream{ctx: context.Background()}
+	handler := func(srv any, ss grpc.ServerStream) error { return nil }
+
+	err := interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: "/notification.v1.NotificationService/StreamNotifications"}, handler)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	breaker.open = false
+	assert.NoError(t, interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: "/notification.v1.NotificationService/StreamNotifications"}, handler))
+}
+
+func TestResponseCache(t *testing.T) {
+	cache := NewResponseCache(2)
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	_, ok := cache.Get("a")
+	require.True(t, ok)
+
+	cache.Put("c", 3)
+	_, ok = cache.Get("b")
+	assert.False(t, ok, "the least recently used entry is evicted")
+
+	cache.Put("a", 4)
+	value, ok := cache.Get("a")
+	require.True(t, ok)
+	assert.Equal(t, 4, value)
+
+	_, ok = cache.Get("")
+	assert.False(t, ok)
+}
@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"context"
+	"slices"
+
+	"github.com/VoidMesh/api/api/internal/logging"
+	"google.golang.org/grpc"
+)
+
+// CompressionInterceptor compresses the responses of the given methods with the first
+// compressor in preference that the client advertises in grpc-accept-encoding, even if
+// the request itself was sent uncompressed. Other methods, and clients that advertise
+// none of them, keep gRPC's default of answering in the request's encoding.
+func CompressionInterceptor(preference []string, methods []string) grpc.UnaryServerInterceptor {
+	compressed := make(map[string]bool, len(methods))
+	for _, method := range methods {
+		compressed[method] = true
+	}
+
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if compressed[info.FullMethod] {
+			if name := negotiateCompressor(ctx, preference); name != "" {
+				if err := grpc.SetSendCompressor(ctx, name); err != nil {
+					logging.GetLogger().Debug("Failed to set response compressor", "method", info.FullMethod, "compressor", name, "error", err)
+				}
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// negotiateCompressor picks the preferred compressor the client supports, or "" if none
+func negotiateCompressor(ctx context.Context, preference []string) string {
+	supported, err := grpc.ClientSupportedCompressors(ctx)
+	if err != nil {
+		return ""
+	}
+	for _, name := range preference {
+		if slices.Contains(supported, name) {
+			return name
+		}
+	}
+	return ""
+}
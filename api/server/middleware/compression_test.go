@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/VoidMesh/api/api/internal/compression"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const healthCheck = "/grpc.health.v1.Health/Check"
+
+// checkHealth calls Health/Check with an uncompressed request on a server with the
+// compression interceptor and returns the server's compression stats
+func checkHealth(t *testing.T, preference, methods []string) *compression.StatsHandler {
+	listener := bufconn.Listen(1 << 20)
+	handler := compression.NewStatsHandler()
+	server := grpc.NewServer(grpc.UnaryInterceptor(CompressionInterceptor(preference, methods)), grpc.StatsHandler(handler))
+	grpc_health_v1.RegisterHealthServer(server, health.NewServer())
+	go server.Serve(listener)
+	defer server.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	resp, err := grpc_health_v1.NewHealthClient(conn).Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, resp.Status)
+	return handler
+}
+
+func TestCompressionInterceptor(t *testing.T) {
+	// The client advertises every registered compressor, so listed methods are compressed.
+	// A two-byte response grows when compressed, which is how the test can tell.
+	handler := checkHealth(t, compression.DefaultPreference, []string{healthCheck})
+	assert.Less(t, handler.Ratio(healthCheck), int64(100), "response is compressed")
+
+	handler = checkHealth(t, compression.DefaultPreference, compression.DefaultMethods)
+	assert.Equal(t, int64(100), handler.Ratio(healthCheck), "unlisted methods answer in the request's encoding")
+
+	handler = checkHealth(t, nil, []string{healthCheck})
+	assert.Equal(t, int64(100), handler.Ratio(healthCheck), "compression disabled")
+
+	handler = checkHealth(t, []string{"br"}, []string{healthCheck})
+	assert.Equal(t, int64(100), handler.Ratio(healthCheck), "the client does not support the compressor")
+}
@@ -0,0 +1,92 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+)
+
+// ConsentGate rejects users who have not accepted the latest required terms of service
+// and privacy policy
+type ConsentGate interface {
+	CheckConsent(ctx context.Context, userID string) error
+}
+
+// consentExemptServices work before the current documents are accepted: accounts and
+// logins (including reading and accepting the documents), notifications, and operator
+// tooling
+var consentExemptServices = []string{
+	"/user.v1.",
+	"/notification.v1.",
+	"/admin.v1.",
+	"/debug.v1.",
+	"/grpc.health.v1.",
+	"/grpc.reflection.",
+}
+
+var (
+	consentMu   sync.RWMutex
+	consentGate ConsentGate
+)
+
+// SetConsentGate enables the consent gate. Passing nil disables it.
+func SetConsentGate(gate ConsentGate) {
+	consentMu.Lock()
+	consentGate = gate
+	consentMu.Unlock()
+}
+
+// ConsentInterceptor rejects gameplay RPCs from users who have not accepted the latest
+// required documents. Admins are gated too. It must run after JWTAuthInterceptor so the
+// user is known; calls without a user (public methods, API keys) are not gated.
+func ConsentInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if err := checkConsent(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// ConsentStreamInterceptor applies the consent gate to streaming RPCs. Streams that are
+// already open when a new version is published are not interrupted.
+func ConsentStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if err := checkConsent(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+func checkConsent(ctx context.Context, method string) error {
+	consentMu.RLock()
+	gate := consentGate
+	consentMu.RUnlock()
+	if gate == nil {
+		return nil
+	}
+
+	for _, prefix := range consentExemptServices {
+		if strings.HasPrefix(method, prefix) {
+			return nil
+		}
+	}
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok || userID == "" {
+		return nil
+	}
+	return gate.CheckConsent(ctx, userID)
+}
@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// consentedUsers lets through the users it holds
+type consentedUsers map[string]bool
+
+func (c consentedUsers) CheckConsent(ctx context.Context, userID string) error {
+	if !c[userID] {
+		return status.Errorf(codes.FailedPrecondition, "accept the terms of service to continue")
+	}
+	return nil
+}
+
+func TestConsentInterceptor(t *testing.T) {
+	interceptor := ConsentInterceptor()
+	handler := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+	call := func(ctx context.Context, method string) error {
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+	const gameplay = "/character.v1.CharacterService/MoveCharacter"
+	player := CreateTestContextWithAuth("player-1", "player")
+	accepted := CreateTestContextWithAuth("player-2", "player")
+
+	assert.NoError(t, call(player, gameplay), "nobody is gated until a gate is set")
+
+	SetConsentGate(consentedUsers{"player-2": true})
+	t.Cleanup(func() { SetConsentGate(nil) })
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(call(player, gameplay)))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(call(player, "/chunk.v1.ChunkService/GetChunk")), "reads are gated too")
+	assert.NoError(t, call(accepted, gameplay))
+	assert.NoError(t, call(player, "/user.v1.UserService/AcceptConsent"), "the documents can be accepted")
+	assert.NoError(t, call(player, "/notification.v1.NotificationService/ListNotifications"))
+	assert.NoError(t, call(context.Background(), "/world.v1.WorldService/ListWorlds"), "calls without a user are not gated")
+}
@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RPCRecorder counts RPC outcomes
+type RPCRecorder interface {
+	Record(code codes.Code)
+}
+
+// ErrorRateInterceptor records the status code of every unary RPC
+func ErrorRateInterceptor(recorder RPCRecorder) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		resp, err := handler(ctx, req)
+		recorder.Record(status.Code(err))
+		return resp, err
+	}
+}
+
+// ErrorRateStreamInterceptor records the status code each stream ends with
+func ErrorRateStreamInterceptor(recorder RPCRecorder) grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		err := handler(srv, ss)
+		recorder.Record(status.Code(err))
+		return err
+	}
+}
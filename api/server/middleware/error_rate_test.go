@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type recordedCodes []codes.Code
+
+func (r *recordedCodes) Record(code codes.Code) {
+	*r = append(*r, code)
+}
+
+func TestErrorRateInterceptors(t *testing.T) {
+	var recorded recordedCodes
+
+	unary := ErrorRateInterceptor(&recorded)
+	_, _ = unary(context.Background(), nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+		return nil, nil
+	})
+	_, _ = unary(context.Background(), nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+		return nil, status.Error(codes.Internal, "boom")
+	})
+
+	stream := ErrorRateStreamInterceptor(&recorded)
+	_ = stream(nil, &fakeServerStream{ctx: context.Background()}, &grpc.StreamServerInfo{}, func(srv any, ss grpc.ServerStream) error {
+		return status.Error(codes.Unavailable, "gone")
+	})
+
+	assert.Equal(t, recordedCodes{codes.OK, codes.Internal, codes.Unavailable}, recorded)
+}
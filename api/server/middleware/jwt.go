@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"sync"
 
+	"github.com/VoidMesh/api/api/internal/apikey"
+	"github.com/VoidMesh/api/api/internal/session"
 	"github.com/golang-jwt/jwt/v5"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -20,6 +23,19 @@ const (
 	usernameKey contextKey = "username"
 )
 
+var (
+	defaultWorldMu sync.RWMutex
+	defaultWorldID string
+)
+
+// SetDefaultWorldID sets the world sessions are bound to when their token has no world
+// claim, as tokens issued before logins chose a world do
+func SetDefaultWorldID(worldID string) {
+	defaultWorldMu.Lock()
+	defaultWorldID = worldID
+	defaultWorldMu.Unlock()
+}
+
 // JWTAuthInterceptor creates a gRPC interceptor for JWT authentication
 func JWTAuthInterceptor(jwtSecret []byte) grpc.UnaryServerInterceptor {
 	return func(
@@ -33,43 +49,97 @@ func JWTAuthInterceptor(jwtSecret []byte) grpc.UnaryServerInterceptor {
 			return handler(ctx, req)
 		}
 
-		// Extract token from metadata
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Errorf(codes.Unauthenticated, "missing metadata")
+		ctx, err := authenticate(ctx, jwtSecret, info.FullMethod)
+		if err != nil {
+			return nil, err
 		}
 
-		authorization := md.Get("authorization")
-		if len(authorization) == 0 {
-			return nil, status.Errorf(codes.Unauthenticated, "missing authorization header")
-		}
+		return handler(ctx, req)
+	}
+}
 
-		// Extract token from "Bearer <token>" format
-		token := strings.TrimPrefix(authorization[0], "Bearer ")
-		if token == authorization[0] {
-			return nil, status.Errorf(codes.Unauthenticated, "invalid authorization header format")
+// JWTStreamAuthInterceptor applies the same authentication as JWTAuthInterceptor to streaming RPCs
+func JWTStreamAuthInterceptor(jwtSecret []byte) grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if isPublicMethod(info.FullMethod) {
+			return handler(srv, ss)
 		}
 
-		// Validate JWT token
-		claims, err := validateJWTToken(token, jwtSecret)
+		ctx, err := authenticate(ss.Context(), jwtSecret, info.FullMethod)
 		if err != nil {
-			return nil, status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
+			return err
 		}
 
-		// Add user info to context
-		userIDClaim, _ := claims["user_id"].(string)
-		usernameClaim, _ := claims["username"].(string)
-		ctx = context.WithValue(ctx, userIDKey, userIDClaim)
-		ctx = context.WithValue(ctx, usernameKey, usernameClaim)
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
 
-		return handler(ctx, req)
+// authenticatedStream overrides the stream context with the authenticated one
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+// authenticate validates the bearer token in the incoming metadata and returns
+// a context carrying the user and session claims, or the API key for key tokens
+func authenticate(ctx context.Context, jwtSecret []byte, method string) (context.Context, error) {
+	// Extract token from metadata
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "missing metadata")
+	}
+
+	authorization := md.Get("authorization")
+	if len(authorization) == 0 {
+		return nil, status.Errorf(codes.Unauthenticated, "missing authorization header")
+	}
+
+	// Extract token from "Bearer <token>" format
+	token := strings.TrimPrefix(authorization[0], "Bearer ")
+	if token == authorization[0] {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid authorization header format")
 	}
+
+	if apikey.IsToken(token) {
+		return authenticateAPIKey(ctx, token, method)
+	}
+
+	// Validate JWT token
+	claims, err := validateJWTToken(token, jwtSecret)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
+	}
+
+	// Add user info to context
+	userIDClaim, _ := claims["user_id"].(string)
+	usernameClaim, _ := claims["username"].(string)
+	ctx = context.WithValue(ctx, userIDKey, userIDClaim)
+	ctx = context.WithValue(ctx, usernameKey, usernameClaim)
+	worldIDClaim, _ := claims["world_id"].(string)
+	if worldIDClaim == "" {
+		defaultWorldMu.RLock()
+		worldIDClaim = defaultWorldID
+		defaultWorldMu.RUnlock()
+	}
+	ctx = session.WithWorldID(ctx, worldIDClaim)
+
+	return authenticateSession(ctx, token)
 }
 
 // isPublicMethod checks if a method should skip authentication
 func isPublicMethod(method string) bool {
 	publicMethods := []string{
 		"/user.v1.UserService/Login",
+		"/user.v1.UserService/OAuthLogin",
 		"/user.v1.UserService/CreateUser",
 		"/user.v1.UserService/RequestPasswordReset",
 		"/user.v1.UserService/ResetPassword",
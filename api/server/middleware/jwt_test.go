@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/VoidMesh/api/api/internal/session"
 	"github.com/VoidMesh/api/api/internal/testutil"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
@@ -116,6 +117,105 @@ func TestJWTAuthInterceptor_ValidToken(t *testing.T) {
 	}
 }
 
+func TestJWTAuthInterceptor_WorldClaim(t *testing.T) {
+	interceptor := JWTAuthInterceptor([]byte(testutil.TestJWTSecretKey))
+	worldID := "650e8400e29b41d4a716446655440000"
+	defaultWorldID := "750e8400e29b41d4a716446655440000"
+
+	signToken := func(extra jwt.MapClaims) string {
+		claims := jwt.MapClaims{
+			"user_id":  testutil.UUIDTestData.User1,
+			"username": "testuser1",
+			"exp":      time.Now().Add(time.Hour).Unix(),
+		}
+		for k, v := range extra {
+			claims[k] = v
+		}
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(testutil.TestJWTSecretKey))
+		require.NoError(t, err)
+		return token
+	}
+
+	tests := []struct {
+		name         string
+		token        string
+		defaultWorld string
+		wantWorld    string
+		wantBound    bool
+	}{
+		{name: "token bound to a world", token: signToken(jwt.MapClaims{"world_id": worldID}), wantWorld: worldID, wantBound: true},
+		{name: "token without world claim", token: signToken(nil), wantBound: false},
+		{name: "token without world claim joins the default world", token: signToken(nil), defaultWorld: defaultWorldID, wantWorld: defaultWorldID, wantBound: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetDefaultWorldID(tt.defaultWorld)
+			t.Cleanup(func() { SetDefaultWorldID("") })
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+tt.token))
+
+			var capturedCtx context.Context
+			_, err := interceptor(ctx, nil, mockUnaryInfo("/character.v1.CharacterService/GetMyCharacters"), func(ctx context.Context, req any) (any, error) {
+				capturedCtx = ctx
+				return mockUnaryHandler(ctx, req)
+			})
+			require.NoError(t, err)
+
+			world, bound := session.WorldIDFromContext(capturedCtx)
+			assert.Equal(t, tt.wantBound, bound)
+			assert.Equal(t, tt.wantWorld, world)
+		})
+	}
+}
+
+// fakeServerStream is a grpc.ServerStream that only carries a context
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestJWTStreamAuthInterceptor(t *testing.T) {
+	interceptor := JWTStreamAuthInterceptor([]byte(testutil.TestJWTSecretKey))
+	info := &grpc.StreamServerInfo{FullMethod: "/character_actions.v1.CharacterActionsService/StreamActionResults", IsServerStream: true}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"user_id":  testutil.UUIDTestData.User1,
+		"username": "testuser1",
+		"world_id": "650e8400e29b41d4a716446655440000",
+		"exp":      time.Now().Add(time.Hour).Unix(),
+	}).SignedString([]byte(testutil.TestJWTSecretKey))
+	require.NoError(t, err)
+
+	t.Run("valid token populates the stream context", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+
+		var userID string
+		var bound bool
+		err := interceptor(nil, &fakeServerStream{ctx: ctx}, info, func(srv any, stream grpc.ServerStream) error {
+			userID, _ = GetUserIDFromContext(stream.Context())
+			_, bound = session.WorldIDFromContext(stream.Context())
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, testutil.UUIDTestData.User1, userID)
+		assert.True(t, bound)
+	})
+
+	t.Run("missing token is rejected before the handler runs", func(t *testing.T) {
+		called := false
+		err := interceptor(nil, &fakeServerStream{ctx: context.Background()}, info, func(srv any, stream grpc.ServerStream) error {
+			called = true
+			return nil
+		})
+		testutil.AssertGRPCError(t, err, codes.Unauthenticated, "missing metadata")
+		assert.False(t, called)
+	})
+}
+
 func TestJWTAuthInterceptor_ExpiredToken(t *testing.T) {
 	interceptor := JWTAuthInterceptor([]byte(testutil.TestJWTSecretKey))
 	ctx := testutil.CreateTestContextWithExpiredToken()
@@ -261,6 +361,7 @@ func TestIsPublicMethod(t *testing.T) {
 	}{
 		// Public methods
 		{"/user.v1.UserService/Login", true},
+		{"/user.v1.UserService/OAuthLogin", true},
 		{"/user.v1.UserService/CreateUser", true},
 		{"/user.v1.UserService/RequestPasswordReset", true},
 		{"/user.v1.UserService/ResetPassword", true},
@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/internal/latency"
+	"google.golang.org/grpc"
+)
+
+// CalibrationSource returns the latency calibration of a connection
+type CalibrationSource interface {
+	Calibration(connectionID string) latency.Calibration
+}
+
+// ConnectionKeyFromContext returns the key the caller's latency calibration is kept
+// under: its login session, or its user for tokens without one. Empty when the caller is
+// not authenticated.
+func ConnectionKeyFromContext(ctx context.Context) string {
+	if sessionID, ok := GetSessionIDFromContext(ctx); ok && sessionID != "" {
+		return SessionConnectionKey(sessionID)
+	}
+	if userID, ok := GetUserIDFromContext(ctx); ok && userID != "" {
+		return "user:" + userID
+	}
+	return ""
+}
+
+// SessionConnectionKey returns the key a login session's latency calibration is kept under
+func SessionConnectionKey(sessionID string) string {
+	return "session:" + sessionID
+}
+
+// LatencyInterceptor attaches the caller's latency calibration to the context, for
+// services judging client-timed actions. It must run after JWTAuthInterceptor so the
+// session is in the context.
+func LatencyInterceptor(calibrations CalibrationSource) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if key := ConnectionKeyFromContext(ctx); key != "" {
+			ctx = latency.WithCalibration(ctx, calibrations.Calibration(key))
+		}
+		return handler(ctx, req)
+	}
+}
+
+// LatencyStreamInterceptor attaches the caller's calibration as of when the stream opened
+func LatencyStreamInterceptor(calibrations CalibrationSource) grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if key := ConnectionKeyFromContext(ss.Context()); key != "" {
+			ctx := latency.WithCalibration(ss.Context(), calibrations.Calibration(key))
+			ss = &authenticatedStream{ServerStream: ss, ctx: ctx}
+		}
+		return handler(srv, ss)
+	}
+}
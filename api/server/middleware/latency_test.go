@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/latency"
+	"github.com/VoidMesh/api/api/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// stubCalibrations calibrates the connections it knows with a fixed round trip
+type stubCalibrations map[string]time.Duration
+
+func (s stubCalibrations) Calibration(connectionID string) latency.Calibration {
+	rtt, ok := s[connectionID]
+	if !ok {
+		return latency.Calibration{}
+	}
+	return latency.Calibration{RTT: rtt, Samples: 1}
+}
+
+func TestConnectionKeyFromContext(t *testing.T) {
+	assert.Empty(t, ConnectionKeyFromContext(context.Background()))
+	ctx := CreateTestContextWithAuth(testutil.UUIDTestData.User1, "testuser1")
+	assert.Equal(t, "user:"+testutil.UUIDTestData.User1, ConnectionKeyFromContext(ctx))
+	assert.Equal(t, "session:s1", ConnectionKeyFromContext(WithSessionID(ctx, "s1")))
+}
+
+func TestLatencyInterceptors(t *testing.T) {
+	calibrations := stubCalibrations{"session:s1": 80 * time.Millisecond}
+	ctx := WithSessionID(CreateTestContextWithAuth(testutil.UUIDTestData.User1, "testuser1"), "s1")
+
+	var seen latency.Calibration
+	unary := LatencyInterceptor(calibrations)
+	handler := func(ctx context.Context, req any) (any, error) {
+		seen = latency.FromContext(ctx)
+		return nil, nil
+	}
+	_, err := unary(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+	require.NoError(t, err)
+	assert.Equal(t, 80*time.Millisecond, seen.RTT)
+	_, err = unary(context.Background(), nil, &grpc.UnaryServerInfo{}, handler)
+	require.NoError(t, err)
+	assert.False(t, seen.Calibrated(), "unauthenticated calls are not calibrated")
+
+	stream := LatencyStreamInterceptor(calibrations)
+	err = stream(nil, &fakeServerStream{ctx: ctx}, &grpc.StreamServerInfo{}, func(srv any, ss grpc.ServerStream) error {
+		seen = latency.FromContext(ss.Context())
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 80*time.Millisecond, seen.RTT)
+}
@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc"
+)
+
+// MaintenanceGate rejects writes while maintenance mode has them frozen
+type MaintenanceGate interface {
+	CheckWrite(ctx context.Context) error
+}
+
+var (
+	maintenanceMu   sync.RWMutex
+	maintenanceGate MaintenanceGate
+)
+
+// SetMaintenanceGate enables the write freeze. Passing nil disables it.
+func SetMaintenanceGate(gate MaintenanceGate) {
+	maintenanceMu.Lock()
+	maintenanceGate = gate
+	maintenanceMu.Unlock()
+}
+
+// MaintenanceInterceptor rejects writes from non-admins while maintenance mode has them
+// frozen. It must run after JWTAuthInterceptor so admins can be recognised.
+func MaintenanceInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if err := checkMaintenance(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// MaintenanceStreamInterceptor applies the write freeze to streaming RPCs. Streams that
+// are already open are not interrupted.
+func MaintenanceStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if err := checkMaintenance(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+func checkMaintenance(ctx context.Context, method string) error {
+	maintenanceMu.RLock()
+	gate := maintenanceGate
+	maintenanceMu.RUnlock()
+
+	if gate == nil || !isWriteMethod(method) || IsAdmin(ctx) {
+		return nil
+	}
+	return gate.CheckWrite(ctx)
+}
@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// frozenGate rejects every write it is asked about
+type frozenGate struct{}
+
+func (frozenGate) CheckWrite(ctx context.Context) error {
+	return status.Errorf(codes.Unavailable, "the server is read-only for maintenance")
+}
+
+func TestMaintenanceInterceptor(t *testing.T) {
+	interceptor := MaintenanceInterceptor()
+	handler := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+	call := func(ctx context.Context, method string) error {
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+	const write = "/character.v1.CharacterService/MoveCharacter"
+	player := CreateTestContextWithAuth("player-1", "player")
+
+	assert.NoError(t, call(player, write), "writes are allowed until a gate is set")
+
+	SetMaintenanceGate(frozenGate{})
+	t.Cleanup(func() { SetMaintenanceGate(nil) })
+	SetAdminUserIDs([]string{"admin-1"})
+	t.Cleanup(func() { SetAdminUserIDs(nil) })
+
+	assert.Equal(t, codes.Unavailable, status.Code(call(player, write)))
+	assert.Equal(t, codes.Unavailable, status.Code(call(context.Background(), "/user.v1.UserService/CreateUser")))
+	assert.NoError(t, call(player, "/chunk.v1.ChunkService/GetChunk"), "reads continue")
+	assert.NoError(t, call(player, "/notification.v1.NotificationService/ListNotifications"))
+	assert.NoError(t, call(player, "/user.v1.UserService/Logout"))
+	assert.NoError(t, call(context.Background(), "/user.v1.UserService/Login"), "players can still sign in")
+	assert.NoError(t, call(player, "/user.v1.UserService/SyncClock"), "clock calibration changes no state")
+	assert.NoError(t, call(player, "/character.v1.CharacterService/ResyncState"))
+	assert.Equal(t, codes.Unavailable, status.Code(call(player, "/character.v1.CharacterService/GetNewThing")), "unlisted methods are writes")
+	assert.NoError(t, call(CreateTestContextWithAuth("admin-1", "admin"), write), "admins are exempt")
+	assert.NoError(t, call(player, "/admin.v1.AdminService/SetMaintenanceMode"), "the admin service checks admins itself")
+	assert.NoError(t, call(context.Background(), "/grpc.health.v1.Health/Check"))
+}
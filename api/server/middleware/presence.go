@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// PresenceTracker records authenticated user activity
+type PresenceTracker interface {
+	Touch(userID string)
+	Connect(userID string) func()
+}
+
+// PresenceInterceptor marks the caller as active on every authenticated request.
+// It must run after JWTAuthInterceptor so the user ID is in the context.
+func PresenceInterceptor(tracker PresenceTracker) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if userID, ok := GetUserIDFromContext(ctx); ok && userID != "" {
+			tracker.Touch(userID)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// PresenceStreamInterceptor keeps the caller online for as long as a stream is open
+func PresenceStreamInterceptor(tracker PresenceTracker) grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if userID, ok := GetUserIDFromContext(ss.Context()); ok && userID != "" {
+			disconnect := tracker.Connect(userID)
+			defer disconnect()
+		}
+		return handler(srv, ss)
+	}
+}
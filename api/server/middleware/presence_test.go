@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/VoidMesh/api/api/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// recordingTracker counts touches and open streams per user
+type recordingTracker struct {
+	touched map[string]int
+	open    map[string]int
+}
+
+func (r *recordingTracker) Touch(userID string) {
+	r.touched[userID]++
+}
+
+func (r *recordingTracker) Connect(userID string) func() {
+	r.open[userID]++
+	return func() { r.open[userID]-- }
+}
+
+func TestPresenceInterceptors(t *testing.T) {
+	tracker := &recordingTracker{touched: map[string]int{}, open: map[string]int{}}
+	ctx := CreateTestContextWithAuth(testutil.UUIDTestData.User1, "testuser1")
+
+	unary := PresenceInterceptor(tracker)
+	handler := func(ctx context.Context, req any) (any, error) { return nil, nil }
+	_, err := unary(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+	require.NoError(t, err)
+	_, err = unary(context.Background(), nil, &grpc.UnaryServerInfo{}, handler)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{testutil.UUIDTestData.User1: 1}, tracker.touched, "unauthenticated calls are ignored")
+
+	stream := PresenceStreamInterceptor(tracker)
+	err = stream(nil, &fakeServerStream{ctx: ctx}, &grpc.StreamServerInfo{}, func(srv any, ss grpc.ServerStream) error {
+		assert.Equal(t, 1, tracker.open[testutil.UUIDTestData.User1], "online while the stream is open")
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 0, tracker.open[testutil.UUIDTestData.User1])
+}
@@ -0,0 +1,135 @@
+package middleware
+
+import "strings"
+
+// readOnlyMethods are the RPCs, by full method name, that change no game state: queries
+// and streams, plus logins, logouts and clock calibration, which only touch the caller's
+// session. Every other method is a write, so a new RPC is frozen by maintenance and world
+// pauses until it is listed here.
+var readOnlyMethods = map[string]bool{
+	"/achievement.v1.AchievementService/ListAchievements": true,
+
+	"/bank.v1.BankService/ListVaults":            true,
+	"/bank.v1.BankService/OpenVault":             true,
+	"/bank.v1.BankService/ListVaultMembers":      true,
+	"/bank.v1.BankService/ListVaultTransactions": true,
+
+	"/character.v1.CharacterService/GetCharacter":             true,
+	"/character.v1.CharacterService/GetMyCharacters":          true,
+	"/character.v1.CharacterService/StreamNearbyEvents":       true,
+	"/character.v1.CharacterService/ResyncState":              true,
+	"/character.v1.CharacterService/ListCharacterCheckpoints": true,
+	"/character.v1.CharacterService/GetMyActivity":            true,
+	"/character.v1.CharacterService/ListCharacterTitles":      true,
+	"/character.v1.CharacterService/ListCharacterNameHistory": true,
+
+	"/character_actions.v1.CharacterActionsService/StreamActionResults": true,
+
+	"/chunk.v1.ChunkService/GetChunk":          true,
+	"/chunk.v1.ChunkService/GetChunks":         true,
+	"/chunk.v1.ChunkService/GetChunksInRadius": true,
+	"/chunk.v1.ChunkService/GetCells":          true,
+	"/chunk.v1.ChunkService/GetChunkSummaries": true,
+	"/chunk.v2.ChunkService/GetChunk":          true,
+	"/chunk.v2.ChunkService/GetChunks":         true,
+	"/chunk.v2.ChunkService/GetChunksInRadius": true,
+	"/chunk.v2.ChunkService/GetCells":          true,
+	"/chunk.v2.ChunkService/GetChunkSummaries": true,
+
+	"/compass.v1.CompassService/GetPointsOfInterest": true,
+
+	"/debug.v1.DebugService/GetServerState":       true,
+	"/debug.v1.DebugService/ListRegionRecordings": true,
+	"/debug.v1.DebugService/StepRegionReplay":     true,
+	"/debug.v1.DebugService/ListChunkAccessStats": true,
+
+	"/dungeon.v1.DungeonService/GetDungeon": true,
+
+	"/interior.v1.InteriorService/GetInterior": true,
+
+	"/inventory.v1.InventoryService/GetCharacterInventory": true,
+	"/inventory.v1.InventoryService/ListGroundDrops":       true,
+	"/inventory.v1.InventoryService/ListInboxItems":        true,
+
+	"/land_claim.v1.LandClaimService/ListClaims":        true,
+	"/land_claim.v1.LandClaimService/GetClaimsInChunks": true,
+
+	"/mail.v1.MailService/ListMail": true,
+
+	"/market.v1.MarketService/SearchListings":  true,
+	"/market.v1.MarketService/ListMyListings":  true,
+	"/market.v1.MarketService/GetPriceHistory": true,
+
+	"/notification.v1.NotificationService/ListNotifications":   true,
+	"/notification.v1.NotificationService/StreamNotifications": true,
+	"/notification.v1.NotificationService/ListAnnouncements":   true,
+
+	"/processing.v1.ProcessingService/ListRecipes":        true,
+	"/processing.v1.ProcessingService/ListProcessingJobs": true,
+
+	"/rare_event.v1.RareEventService/ListRareEvents": true,
+
+	"/reference.v1.ReferenceService/ResolveReferences": true,
+
+	"/resource_node.v1.ResourceNodeService/GetResourcesInChunk":  true,
+	"/resource_node.v1.ResourceNodeService/GetResourcesInChunks": true,
+	"/resource_node.v1.ResourceNodeService/GetResourceNodeTypes": true,
+	"/resource_node.v1.ResourceNodeService/GetSpriteAtlas":       true,
+	"/resource_node.v2.ResourceNodeService/GetResourcesInChunk":  true,
+	"/resource_node.v2.ResourceNodeService/GetResourcesInChunks": true,
+	"/resource_node.v2.ResourceNodeService/GetResourceNodeTypes": true,
+	"/resource_node.v2.ResourceNodeService/GetSpriteAtlas":       true,
+
+	"/social.v1.SocialService/ListFriends":          true,
+	"/social.v1.SocialService/GetConversation":      true,
+	"/social.v1.SocialService/StreamDirectMessages": true,
+	"/social.v1.SocialService/ListBlockedUsers":     true,
+
+	"/static_data.v1.StaticDataService/GetStaticDataBundle": true,
+	"/static_data.v1.StaticDataService/CheckVersion":        true,
+
+	"/terrain.v1.TerrainService/GetTerrainTypes": true,
+
+	"/timeline.v1.TimelineService/ListWorldTimeline":   true,
+	"/timeline.v1.TimelineService/StreamWorldTimeline": true,
+
+	"/tutorial.v1.TutorialService/GetTutorialState": true,
+
+	"/user.v1.UserService/GetUser":           true,
+	"/user.v1.UserService/GetUserByEmail":    true,
+	"/user.v1.UserService/GetUserByUsername": true,
+	"/user.v1.UserService/ListUsers":         true,
+	"/user.v1.UserService/Login":             true,
+	"/user.v1.UserService/OAuthLogin":        true,
+	"/user.v1.UserService/Logout":            true,
+	"/user.v1.UserService/ListSessions":      true,
+	"/user.v1.UserService/SyncClock":         true,
+	"/user.v1.UserService/ListIdentities":    true,
+	"/user.v1.UserService/GetConsentStatus":  true,
+
+	"/world.v1.WorldService/GetWorld":        true,
+	"/world.v1.WorldService/GetDefaultWorld": true,
+	"/world.v1.WorldService/ListWorlds":      true,
+	"/world.v2.WorldService/GetWorld":        true,
+	"/world.v2.WorldService/GetDefaultWorld": true,
+	"/world.v2.WorldService/ListWorlds":      true,
+}
+
+// writeExemptServices are never frozen: admins need the admin service to end maintenance
+// and resume worlds, and health checks must not fail while the server drains
+var writeExemptServices = []string{
+	"/admin.v1.",
+	"/grpc.health.v1.",
+	"/grpc.reflection.",
+}
+
+// isWriteMethod reports whether a full method name, e.g. "/chunk.v1.ChunkService/GetChunk",
+// may change state
+func isWriteMethod(method string) bool {
+	for _, prefix := range writeExemptServices {
+		if strings.HasPrefix(method, prefix) {
+			return false
+		}
+	}
+	return !readOnlyMethods[method]
+}
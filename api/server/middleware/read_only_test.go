@@ -0,0 +1,213 @@
+package middleware
+
+import (
+	"testing"
+
+	achievementV1 "github.com/VoidMesh/api/api/proto/achievement/v1"
+	adminV1 "github.com/VoidMesh/api/api/proto/admin/v1"
+	bankV1 "github.com/VoidMesh/api/api/proto/bank/v1"
+	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
+	characterActionsV1 "github.com/VoidMesh/api/api/proto/character_actions/v1"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	chunkV2 "github.com/VoidMesh/api/api/proto/chunk/v2"
+	compassV1 "github.com/VoidMesh/api/api/proto/compass/v1"
+	debugV1 "github.com/VoidMesh/api/api/proto/debug/v1"
+	dungeonV1 "github.com/VoidMesh/api/api/proto/dungeon/v1"
+	fishingV1 "github.com/VoidMesh/api/api/proto/fishing/v1"
+	interactionV1 "github.com/VoidMesh/api/api/proto/interaction/v1"
+	interiorV1 "github.com/VoidMesh/api/api/proto/interior/v1"
+	inventoryV1 "github.com/VoidMesh/api/api/proto/inventory/v1"
+	landClaimV1 "github.com/VoidMesh/api/api/proto/land_claim/v1"
+	mailV1 "github.com/VoidMesh/api/api/proto/mail/v1"
+	marketV1 "github.com/VoidMesh/api/api/proto/market/v1"
+	notificationV1 "github.com/VoidMesh/api/api/proto/notification/v1"
+	processingV1 "github.com/VoidMesh/api/api/proto/processing/v1"
+	rareEventV1 "github.com/VoidMesh/api/api/proto/rare_event/v1"
+	referenceV1 "github.com/VoidMesh/api/api/proto/reference/v1"
+	resourceNodeV1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
+	resourceNodeV2 "github.com/VoidMesh/api/api/proto/resource_node/v2"
+	socialV1 "github.com/VoidMesh/api/api/proto/social/v1"
+	staticDataV1 "github.com/VoidMesh/api/api/proto/static_data/v1"
+	terrainV1 "github.com/VoidMesh/api/api/proto/terrain/v1"
+	timelineV1 "github.com/VoidMesh/api/api/proto/timeline/v1"
+	tutorialV1 "github.com/VoidMesh/api/api/proto/tutorial/v1"
+	userV1 "github.com/VoidMesh/api/api/proto/user/v1"
+	worldV1 "github.com/VoidMesh/api/api/proto/world/v1"
+	worldV2 "github.com/VoidMesh/api/api/proto/world/v2"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// writeMethods are the RPCs outside the admin service that readOnlyMethods leaves out.
+// Together they list every registered method, so adding an RPC fails
+// TestIsWriteMethod_EveryRegisteredMethod until it is classified.
+var writeMethods = map[string]bool{
+	"/achievement.v1.AchievementService/ClaimReward": true,
+
+	"/bank.v1.BankService/CreateGuildVault": true,
+	"/bank.v1.BankService/Deposit":          true,
+	"/bank.v1.BankService/SetVaultMember":   true,
+	"/bank.v1.BankService/Withdraw":         true,
+
+	"/character.v1.CharacterService/CreateCharacter":            true,
+	"/character.v1.CharacterService/DeleteCharacter":            true,
+	"/character.v1.CharacterService/EquipTitle":                 true,
+	"/character.v1.CharacterService/MoveCharacter":              true,
+	"/character.v1.CharacterService/RenameCharacter":            true,
+	"/character.v1.CharacterService/RestoreCharacterCheckpoint": true,
+
+	"/character_actions.v1.CharacterActionsService/EnqueueAction":   true,
+	"/character_actions.v1.CharacterActionsService/HarvestResource": true,
+	"/character_actions.v1.CharacterActionsService/ModifyTerrain":   true,
+
+	"/compass.v1.CompassService/AddWaypoint":    true,
+	"/compass.v1.CompassService/RemoveWaypoint": true,
+
+	"/debug.v1.DebugService/StartRegionRecording": true,
+	"/debug.v1.DebugService/StopRegionRecording":  true,
+
+	"/dungeon.v1.DungeonService/CreateDungeon":  true,
+	"/dungeon.v1.DungeonService/DisbandDungeon": true,
+	"/dungeon.v1.DungeonService/EnterDungeon":   true,
+	"/dungeon.v1.DungeonService/LeaveDungeon":   true,
+
+	"/fishing.v1.FishingService/Cast": true,
+	"/fishing.v1.FishingService/Hook": true,
+
+	"/interaction.v1.InteractionService/Interact": true,
+
+	"/interior.v1.InteriorService/EnterInterior":   true,
+	"/interior.v1.InteriorService/ExitInterior":    true,
+	"/interior.v1.InteriorService/PlaceFurniture":  true,
+	"/interior.v1.InteriorService/RemoveFurniture": true,
+
+	"/inventory.v1.InventoryService/AddInventoryItem":      true,
+	"/inventory.v1.InventoryService/ClaimInboxItem":        true,
+	"/inventory.v1.InventoryService/PickUpGroundDrop":      true,
+	"/inventory.v1.InventoryService/RemoveInventoryItem":   true,
+	"/inventory.v1.InventoryService/SetInventoryItemFlags": true,
+	"/inventory.v1.InventoryService/SortInventory":         true,
+	"/inventory.v1.InventoryService/UpdateItemQuantity":    true,
+
+	"/land_claim.v1.LandClaimService/ClaimChunk":   true,
+	"/land_claim.v1.LandClaimService/ReleaseClaim": true,
+
+	"/mail.v1.MailService/ClaimAttachments": true,
+	"/mail.v1.MailService/DeleteMail":       true,
+	"/mail.v1.MailService/SendMail":         true,
+
+	"/market.v1.MarketService/BuyListing":    true,
+	"/market.v1.MarketService/CreateListing": true,
+
+	"/notification.v1.NotificationService/MarkNotificationsRead": true,
+
+	"/processing.v1.ProcessingService/CollectProcessingJob": true,
+	"/processing.v1.ProcessingService/PlaceStation":         true,
+	"/processing.v1.ProcessingService/StartProcessing":      true,
+
+	"/rare_event.v1.RareEventService/ContributeToRareEvent": true,
+
+	"/resource_node.v1.ResourceNodeService/ReloadBalanceConfig": true,
+
+	"/resource_node.v2.ResourceNodeService/ReloadBalanceConfig": true,
+
+	"/social.v1.SocialService/AcceptFriendRequest":  true,
+	"/social.v1.SocialService/BlockUser":            true,
+	"/social.v1.SocialService/DeclineFriendRequest": true,
+	"/social.v1.SocialService/RemoveFriend":         true,
+	"/social.v1.SocialService/ReportPlayer":         true,
+	"/social.v1.SocialService/SendDirectMessage":    true,
+	"/social.v1.SocialService/SendFriendRequest":    true,
+	"/social.v1.SocialService/UnblockUser":          true,
+
+	"/user.v1.UserService/AcceptConsent":        true,
+	"/user.v1.UserService/CreateUser":           true,
+	"/user.v1.UserService/DeleteUser":           true,
+	"/user.v1.UserService/LinkIdentity":         true,
+	"/user.v1.UserService/RequestPasswordReset": true,
+	"/user.v1.UserService/ResetPassword":        true,
+	"/user.v1.UserService/RevokeSession":        true,
+	"/user.v1.UserService/UnlinkIdentity":       true,
+	"/user.v1.UserService/UpdateUser":           true,
+	"/user.v1.UserService/VerifyEmail":          true,
+
+	"/world.v1.WorldService/DeleteWorld":     true,
+	"/world.v1.WorldService/UpdateWorldName": true,
+
+	"/world.v2.WorldService/DeleteWorld":     true,
+	"/world.v2.WorldService/UpdateWorldName": true,
+}
+
+// registeredServices are the services the server registers
+func registeredServices() []*grpc.ServiceDesc {
+	return []*grpc.ServiceDesc{
+		&achievementV1.AchievementService_ServiceDesc,
+		&adminV1.AdminService_ServiceDesc,
+		&bankV1.BankService_ServiceDesc,
+		&characterV1.CharacterService_ServiceDesc,
+		&characterActionsV1.CharacterActionsService_ServiceDesc,
+		&chunkV1.ChunkService_ServiceDesc,
+		&chunkV2.ChunkService_ServiceDesc,
+		&compassV1.CompassService_ServiceDesc,
+		&debugV1.DebugService_ServiceDesc,
+		&dungeonV1.DungeonService_ServiceDesc,
+		&fishingV1.FishingService_ServiceDesc,
+		&interactionV1.InteractionService_ServiceDesc,
+		&interiorV1.InteriorService_ServiceDesc,
+		&inventoryV1.InventoryService_ServiceDesc,
+		&landClaimV1.LandClaimService_ServiceDesc,
+		&mailV1.MailService_ServiceDesc,
+		&marketV1.MarketService_ServiceDesc,
+		&notificationV1.NotificationService_ServiceDesc,
+		&processingV1.ProcessingService_ServiceDesc,
+		&rareEventV1.RareEventService_ServiceDesc,
+		&referenceV1.ReferenceService_ServiceDesc,
+		&resourceNodeV1.ResourceNodeService_ServiceDesc,
+		&resourceNodeV2.ResourceNodeService_ServiceDesc,
+		&socialV1.SocialService_ServiceDesc,
+		&staticDataV1.StaticDataService_ServiceDesc,
+		&terrainV1.TerrainService_ServiceDesc,
+		&timelineV1.TimelineService_ServiceDesc,
+		&tutorialV1.TutorialService_ServiceDesc,
+		&userV1.UserService_ServiceDesc,
+		&worldV1.WorldService_ServiceDesc,
+		&worldV2.WorldService_ServiceDesc,
+		&grpc_health_v1.Health_ServiceDesc,
+	}
+}
+
+func TestIsWriteMethod_EveryRegisteredMethod(t *testing.T) {
+	registered := make(map[string]bool)
+	for _, service := range registeredServices() {
+		var names []string
+		for _, method := range service.Methods {
+			names = append(names, method.MethodName)
+		}
+		for _, stream := range service.Streams {
+			names = append(names, stream.StreamName)
+		}
+		for _, name := range names {
+			method := "/" + service.ServiceName + "/" + name
+			registered[method] = true
+			switch {
+			case service.ServiceName == "admin.v1.AdminService" || service.ServiceName == "grpc.health.v1.Health":
+				assert.False(t, isWriteMethod(method), "%s is exempt", method)
+			case readOnlyMethods[method]:
+				assert.False(t, writeMethods[method], "%s is listed as both a read and a write", method)
+				assert.False(t, isWriteMethod(method), method)
+			default:
+				assert.True(t, writeMethods[method], "%s is not classified: add it to readOnlyMethods or writeMethods", method)
+				assert.True(t, isWriteMethod(method), method)
+			}
+		}
+	}
+
+	for method := range readOnlyMethods {
+		assert.True(t, registered[method], "read-only method %s is not registered", method)
+	}
+	for method := range writeMethods {
+		assert.True(t, registered[method], "write method %s is not registered", method)
+	}
+	assert.True(t, isWriteMethod("/example.v1.ExampleService/Unknown"), "unlisted methods are writes")
+}
@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"context"
+	"sync"
+)
+
+// SessionAuthenticator checks that the login session a user token was issued with is
+// still live and returns its ID, empty for tokens without one. Errors are returned to the
+// caller as-is, so they should be gRPC statuses.
+type SessionAuthenticator interface {
+	AuthenticateSession(ctx context.Context, token string) (string, error)
+}
+
+const sessionIDKey contextKey = "session_id"
+
+var (
+	sessionMu            sync.RWMutex
+	sessionAuthenticator SessionAuthenticator
+)
+
+// SetSessionAuthenticator makes the auth interceptors reject user tokens whose session
+// was revoked. Until one is set, any valid token is accepted.
+func SetSessionAuthenticator(authenticator SessionAuthenticator) {
+	sessionMu.Lock()
+	sessionAuthenticator = authenticator
+	sessionMu.Unlock()
+}
+
+// authenticateSession returns a context carrying the token's session ID, if it has one
+func authenticateSession(ctx context.Context, token string) (context.Context, error) {
+	sessionMu.RLock()
+	authenticator := sessionAuthenticator
+	sessionMu.RUnlock()
+	if authenticator == nil {
+		return ctx, nil
+	}
+
+	sessionID, err := authenticator.AuthenticateSession(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if sessionID == "" {
+		return ctx, nil
+	}
+	return context.WithValue(ctx, sessionIDKey, sessionID), nil
+}
+
+// GetSessionIDFromContext extracts the ID of the login session that made the request
+func GetSessionIDFromContext(ctx context.Context) (string, bool) {
+	sessionID, ok := ctx.Value(sessionIDKey).(string)
+	return sessionID, ok
+}
+
+// WithSessionID adds a session ID to context (for testing)
+func WithSessionID(ctx context.Context, sessionID string) context.Context {
+	return context.WithValue(ctx, sessionIDKey, sessionID)
+}
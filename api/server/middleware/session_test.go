@@ -0,0 +1,62 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/VoidMesh/api/api/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// stubSessions knows User1's token and has revoked User2's
+type stubSessions struct {
+	tokens []string
+}
+
+func (s *stubSessions) AuthenticateSession(ctx context.Context, token string) (string, error) {
+	s.tokens = append(s.tokens, token)
+	switch token {
+	case testutil.PreGeneratedJWTTokens.ValidUser1Token:
+		return "session-1", nil
+	case testutil.PreGeneratedJWTTokens.ValidUser2Token:
+		return "", status.Errorf(codes.Unauthenticated, "session revoked")
+	}
+	return "", nil
+}
+
+func TestJWTAuthInterceptor_Session(t *testing.T) {
+	interceptor := JWTAuthInterceptor([]byte(testutil.TestJWTSecretKey))
+	info := mockUnaryInfo("/character.v1.CharacterService/MoveCharacter")
+
+	var sessionID string
+	var hasSession bool
+	handler := func(ctx context.Context, req any) (any, error) {
+		sessionID, hasSession = GetSessionIDFromContext(ctx)
+		return nil, nil
+	}
+	_, err := interceptor(testutil.CreateTestContextForUser2(), nil, info, handler)
+	require.NoError(t, err, "tokens are accepted until an authenticator is set")
+	assert.False(t, hasSession)
+
+	sessions := &stubSessions{}
+	SetSessionAuthenticator(sessions)
+	t.Cleanup(func() { SetSessionAuthenticator(nil) })
+
+	_, err = interceptor(testutil.CreateTestContextForUser1(), nil, info, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "session-1", sessionID)
+
+	_, err = interceptor(testutil.CreateTestContextForUser2(), nil, info, handler)
+	testutil.AssertGRPCError(t, err, codes.Unauthenticated, "session revoked")
+
+	_, err = interceptor(testutil.CreateTestContextWithExpiredToken(), nil, info, handler)
+	testutil.AssertGRPCError(t, err, codes.Unauthenticated)
+	assert.Len(t, sessions.tokens, 2, "invalid tokens are rejected before the session is checked")
+
+	_, err = interceptor(apiKeyContext("vmk_0123456789ab_secret"), nil, info, handler)
+	testutil.AssertGRPCError(t, err, codes.Unauthenticated, "API keys are not accepted")
+	assert.Len(t, sessions.tokens, 2, "API keys have no session")
+}
@@ -0,0 +1,134 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/shard"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ReasonWrongShard is the ErrorInfo reason returned when a request reaches an
+// instance that does not serve the session's world
+const ReasonWrongShard = "WRONG_SHARD"
+
+const shardErrorDomain = "shard.voidmesh"
+
+// ShardRouter resolves which instance serves a world
+type ShardRouter interface {
+	Owner(ctx context.Context, worldID string) (shard.Owner, error)
+	IsLocal(owner shard.Owner) bool
+}
+
+// worldScopedServices are the services whose requests operate on a single world
+var worldScopedServices = []string{
+	"/character.v1.",
+	"/character_actions.v1.",
+	"/chunk.v1.",
+	"/chunk.v2.",
+	"/inventory.v1.",
+	"/resource_node.v1.",
+	"/resource_node.v2.",
+}
+
+var (
+	shardMu           sync.RWMutex
+	shardRouter       ShardRouter
+	shardDefaultWorld string
+)
+
+// SetShardRouter enables shard routing. Sessions without a world claim are routed
+// as the default world. Passing a nil router disables routing.
+func SetShardRouter(router ShardRouter, defaultWorldID string) {
+	shardMu.Lock()
+	shardRouter = router
+	shardDefaultWorld = defaultWorldID
+	shardMu.Unlock()
+}
+
+// ShardRoutingInterceptor rejects world-scoped requests for worlds served by another instance.
+// It must run after JWTAuthInterceptor so the session world is in the context.
+func ShardRoutingInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if err := routeToShard(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// ShardRoutingStreamInterceptor applies the same routing as ShardRoutingInterceptor to streaming RPCs
+func ShardRoutingStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if err := routeToShard(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+func routeToShard(ctx context.Context, method string) error {
+	shardMu.RLock()
+	router, defaultWorld := shardRouter, shardDefaultWorld
+	shardMu.RUnlock()
+
+	if router == nil || !isWorldScopedMethod(method) {
+		return nil
+	}
+
+	worldID, ok := session.WorldIDFromContext(ctx)
+	if !ok {
+		worldID = defaultWorld
+	}
+
+	owner, err := router.Owner(ctx, worldID)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "failed to resolve world shard: %v", err)
+	}
+	if router.IsLocal(owner) {
+		return nil
+	}
+	return wrongShardError(worldID, owner)
+}
+
+func isWorldScopedMethod(method string) bool {
+	for _, prefix := range worldScopedServices {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// wrongShardError tells the client which instance to reconnect to
+func wrongShardError(worldID string, owner shard.Owner) error {
+	st := status.New(codes.FailedPrecondition, "world is served by another instance")
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: ReasonWrongShard,
+		Domain: shardErrorDomain,
+		Metadata: map[string]string{
+			"world_id":    worldID,
+			"instance_id": owner.InstanceID,
+			"address":     owner.Address,
+		},
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/shard"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// staticShardRouter assigns worlds from a fixed map; unknown worlds fail to resolve
+type staticShardRouter struct {
+	local  string
+	owners map[string]shard.Owner
+}
+
+func (r *staticShardRouter) Owner(ctx context.Context, worldID string) (shard.Owner, error) {
+	owner, ok := r.owners[worldID]
+	if !ok {
+		return shard.Owner{}, errors.New("registry unavailable")
+	}
+	return owner, nil
+}
+
+func (r *staticShardRouter) IsLocal(owner shard.Owner) bool {
+	return owner.InstanceID == r.local
+}
+
+func TestShardRoutingInterceptor(t *testing.T) {
+	router := &staticShardRouter{
+		local: "a",
+		owners: map[string]shard.Owner{
+			"default-world": {InstanceID: "a", Address: "a:50051"},
+			"remote-world":  {InstanceID: "b", Address: "b:50051"},
+		},
+	}
+	interceptor := ShardRoutingInterceptor()
+	handler := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+	call := func(ctx context.Context, method string) error {
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+	const worldScoped = "/character.v1.CharacterService/MoveCharacter"
+
+	assert.NoError(t, call(session.WithWorldID(context.Background(), "remote-world"), worldScoped), "routing is off until a router is set")
+
+	SetShardRouter(router, "default-world")
+	t.Cleanup(func() { SetShardRouter(nil, "") })
+
+	assert.NoError(t, call(context.Background(), worldScoped), "sessions without a world use the default world")
+	assert.NoError(t, call(session.WithWorldID(context.Background(), "remote-world"), "/user.v1.UserService/GetMe"), "account RPCs are not world-scoped")
+
+	err := call(session.WithWorldID(context.Background(), "remote-world"), worldScoped)
+	st := status.Convert(err)
+	require.Equal(t, codes.FailedPrecondition, st.Code())
+	require.Len(t, st.Details(), 1)
+	info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+	require.True(t, ok)
+	assert.Equal(t, ReasonWrongShard, info.Reason)
+	assert.Equal(t, "b:50051", info.Metadata["address"])
+
+	err = call(session.WithWorldID(context.Background(), "unknown-world"), worldScoped)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestShardRoutingStreamInterceptor(t *testing.T) {
+	SetShardRouter(&staticShardRouter{
+		local:  "a",
+		owners: map[string]shard.Owner{"remote-world": {InstanceID: "b", Address: "b:50051"}},
+	}, "")
+	t.Cleanup(func() { SetShardRouter(nil, "") })
+
+	interceptor := ShardRoutingStreamInterceptor()
+	info := &grpc.StreamServerInfo{FullMethod: "/character.v1.CharacterService/StreamNearbyEvents", IsServerStream: true}
+	called := false
+	err := interceptor(nil, &fakeServerStream{ctx: session.WithWorldID(context.Background(), "remote-world")}, info, func(srv any, stream grpc.ServerStream) error {
+		called = true
+		return nil
+	})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.False(t, called)
+}
@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultRPCTimeout bounds unary RPCs that have no timeout of their own
+const DefaultRPCTimeout = 10 * time.Second
+
+// DefaultMethodTimeouts are the RPCs that may legitimately take longer than
+// DefaultRPCTimeout, such as generating many chunks at once
+var DefaultMethodTimeouts = map[string]time.Duration{
+	"/chunk.v1.ChunkService/GetChunks":         30 * time.Second,
+	"/chunk.v1.ChunkService/GetChunksInRadius": 30 * time.Second,
+	"/chunk.v2.ChunkService/GetChunks":         30 * time.Second,
+	"/chunk.v2.ChunkService/GetChunksInRadius": 30 * time.Second,
+	"/debug.v1.DebugService/StepRegionReplay":  30 * time.Second,
+}
+
+// TimeoutInterceptor bounds each unary RPC by its timeout in methods, or by fallback for
+// methods not listed; a timeout of zero leaves the method unbounded. A shorter deadline
+// set by the client still applies. Handlers see the deadline on their context, so
+// database calls and generation loops stop when it passes.
+func TimeoutInterceptor(fallback time.Duration, methods map[string]time.Duration) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		timeout, ok := methods[info.FullMethod]
+		if !ok {
+			timeout = fallback
+		}
+		if timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		resp, err := handler(ctx, req)
+		return resp, contextError(ctx, err)
+	}
+}
+
+// TimeoutStreamInterceptor gives streams no server timeout, since they are meant to stay
+// open, but reports a stream that ended because its client deadline passed or the
+// client cancelled as DeadlineExceeded or Canceled
+func TimeoutStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return contextError(ss.Context(), handler(srv, ss))
+	}
+}
+
+// contextError replaces an error returned after the context ended, which handlers often
+// wrap as Internal, with the status matching why the context ended
+func contextError(ctx context.Context, err error) error {
+	if err == nil || ctx.Err() == nil {
+		return err
+	}
+	return status.FromContextError(ctx.Err()).Err()
+}
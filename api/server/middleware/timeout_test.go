@@ -0,0 +1,83 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// slowHandler waits for its context like a database call would and wraps the error the
+// way handlers do
+func slowHandler(ctx context.Context, req any) (any, error) {
+	select {
+	case <-ctx.Done():
+		return nil, status.Errorf(codes.Internal, "Failed to get chunk: %v", ctx.Err())
+	case <-time.After(100 * time.Millisecond):
+		return "done", nil
+	}
+}
+
+func TestTimeoutInterceptor(t *testing.T) {
+	interceptor := TimeoutInterceptor(10*time.Millisecond, map[string]time.Duration{
+		"/test.v1.Test/Slow":      5 * time.Second,
+		"/test.v1.Test/Unbounded": 0,
+	})
+	call := func(ctx context.Context, method string, handler grpc.UnaryHandler) (any, error) {
+		return interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+	}
+
+	_, err := call(context.Background(), "/test.v1.Test/Default", slowHandler)
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err), "the fallback timeout applies")
+
+	resp, err := call(context.Background(), "/test.v1.Test/Slow", slowHandler)
+	require.NoError(t, err, "the method's own timeout applies")
+	assert.Equal(t, "done", resp)
+
+	_, err = call(context.Background(), "/test.v1.Test/Unbounded", func(ctx context.Context, req any) (any, error) {
+		_, ok := ctx.Deadline()
+		assert.False(t, ok, "a zero timeout sets no deadline")
+		return nil, nil
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = call(ctx, "/test.v1.Test/Slow", slowHandler)
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err), "a shorter client deadline still applies")
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	_, err = call(ctx, "/test.v1.Test/Slow", slowHandler)
+	assert.Equal(t, codes.Canceled, status.Code(err))
+
+	_, err = call(context.Background(), "/test.v1.Test/Slow", func(ctx context.Context, req any) (any, error) {
+		return nil, status.Error(codes.NotFound, "missing")
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err), "errors before the deadline are kept")
+}
+
+func TestTimeoutStreamInterceptor(t *testing.T) {
+	interceptor := TimeoutStreamInterceptor()
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &fakeServerStream{ctx: ctx}
+
+	err := interceptor(nil, stream, &grpc.StreamServerInfo{}, func(srv any, ss grpc.ServerStream) error {
+		_, ok := ss.Context().Deadline()
+		assert.False(t, ok, "streams get no server timeout")
+		return nil
+	})
+	require.NoError(t, err)
+
+	cancel()
+	err = interceptor(nil, stream, &grpc.StreamServerInfo{}, func(srv any, ss grpc.ServerStream) error {
+		return fmt.Errorf("send failed: %w", ss.Context().Err())
+	})
+	assert.Equal(t, codes.Canceled, status.Code(err))
+}
@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/VoidMesh/api/api/internal/session"
+	"google.golang.org/grpc"
+)
+
+// WorldPauseGate rejects writes in paused worlds
+type WorldPauseGate interface {
+	CheckWorldWrite(ctx context.Context, worldID string) error
+}
+
+// worldPauseExemptServices do not change any world: accounts and logins, notification
+// read state, and friends, chat and player reports, so players can still report what
+// the world was paused for
+var worldPauseExemptServices = []string{
+	"/user.v1.",
+	"/notification.v1.",
+	"/social.v1.",
+}
+
+var (
+	worldPauseMu           sync.RWMutex
+	worldPauseGate         WorldPauseGate
+	worldPauseDefaultWorld string
+)
+
+// SetWorldPauseGate enables per-world pauses. Sessions without a world claim write to the
+// default world. Passing a nil gate disables them.
+func SetWorldPauseGate(gate WorldPauseGate, defaultWorldID string) {
+	worldPauseMu.Lock()
+	worldPauseGate = gate
+	worldPauseDefaultWorld = defaultWorldID
+	worldPauseMu.Unlock()
+}
+
+// WorldPauseInterceptor rejects writes from non-admins in a paused session world. Writes
+// are every method readOnlyMethods does not list, as for maintenance mode. It must run
+// after JWTAuthInterceptor so the session world and admins are known.
+func WorldPauseInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if err := checkWorldPause(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// WorldPauseStreamInterceptor applies world pauses to streaming RPCs. Streams that are
+// already open are not interrupted.
+func WorldPauseStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if err := checkWorldPause(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+func checkWorldPause(ctx context.Context, method string) error {
+	worldPauseMu.RLock()
+	gate, defaultWorld := worldPauseGate, worldPauseDefaultWorld
+	worldPauseMu.RUnlock()
+
+	if gate == nil || !isWriteMethod(method) || IsAdmin(ctx) {
+		return nil
+	}
+	for _, prefix := range worldPauseExemptServices {
+		if strings.HasPrefix(method, prefix) {
+			return nil
+		}
+	}
+
+	worldID, ok := session.WorldIDFromContext(ctx)
+	if !ok {
+		worldID = defaultWorld
+	}
+	return gate.CheckWorldWrite(ctx, worldID)
+}
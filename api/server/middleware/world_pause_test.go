@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// pausedWorlds rejects writes in the worlds it holds
+type pausedWorlds map[string]bool
+
+func (p pausedWorlds) CheckWorldWrite(ctx context.Context, worldID string) error {
+	if p[worldID] {
+		return status.Errorf(codes.Unavailable, "the world is paused")
+	}
+	return nil
+}
+
+func TestWorldPauseInterceptor(t *testing.T) {
+	interceptor := WorldPauseInterceptor()
+	handler := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+	call := func(ctx context.Context, method string) error {
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+	const write = "/character.v1.CharacterService/MoveCharacter"
+	player := CreateTestContextWithAuth("player-1", "player")
+	elsewhere := session.WithWorldID(player, "world-2")
+
+	assert.NoError(t, call(player, write), "writes are allowed until a gate is set")
+
+	SetWorldPauseGate(pausedWorlds{"world-1": true}, "world-1")
+	t.Cleanup(func() { SetWorldPauseGate(nil, "") })
+	SetAdminUserIDs([]string{"admin-1"})
+	t.Cleanup(func() { SetAdminUserIDs(nil) })
+
+	assert.Equal(t, codes.Unavailable, status.Code(call(player, write)), "sessions without a world write to the default world")
+	assert.Equal(t, codes.Unavailable, status.Code(call(session.WithWorldID(player, "world-1"), "/market.v1.MarketService/CreateListing")))
+	assert.NoError(t, call(elsewhere, write), "other worlds keep running")
+	assert.NoError(t, call(player, "/chunk.v1.ChunkService/GetChunk"), "reads continue")
+	assert.NoError(t, call(player, "/character.v1.CharacterService/ResyncState"), "reads are listed by full name, not by prefix")
+	assert.NoError(t, call(player, "/market.v1.MarketService/SearchListings"))
+	assert.Equal(t, codes.Unavailable, status.Code(call(player, "/character.v1.CharacterService/GetNewThing")), "unlisted methods are writes")
+	assert.NoError(t, call(player, "/user.v1.UserService/UpdateProfile"), "accounts are not part of a world")
+	assert.NoError(t, call(player, "/social.v1.SocialService/ReportPlayer"))
+	assert.NoError(t, call(CreateTestContextWithAuth("admin-1", "admin"), write), "admins are exempt")
+	assert.NoError(t, call(player, "/admin.v1.AdminService/ResumeWorld"))
+}
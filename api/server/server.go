@@ -2,186 +2,465 @@ package server
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net"
 	"os"
+	"os/signal"
+	"slices"
+	"strconv"
+	"strings"
+	"syscall"
 	"time"
 
-	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/alerting"
+	"github.com/VoidMesh/api/api/internal/analytics"
+	"github.com/VoidMesh/api/api/internal/bandwidth"
+	"github.com/VoidMesh/api/api/internal/bootstrap"
+	"github.com/VoidMesh/api/api/internal/compression"
+	"github.com/VoidMesh/api/api/internal/dbbreaker"
+	"github.com/VoidMesh/api/api/internal/faults"
+	"github.com/VoidMesh/api/api/internal/latency"
 	"github.com/VoidMesh/api/api/internal/logging"
-	pbCharacterActionsV1 "github.com/VoidMesh/api/api/proto/character_actions/v1"
-	pbCharacterV1 "github.com/VoidMesh/api/api/proto/character/v1"
-	pbChunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
-	pbInventoryV1 "github.com/VoidMesh/api/api/proto/inventory/v1"
-	pbResourceNodeV1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
-	pbTerrainV1 "github.com/VoidMesh/api/api/proto/terrain/v1"
-	pbUserV1 "github.com/VoidMesh/api/api/proto/user/v1"
-	pbWorldV1 "github.com/VoidMesh/api/api/proto/world/v1"
-	"github.com/VoidMesh/api/api/server/handlers"
-	"github.com/VoidMesh/api/api/server/middleware" // Uncomment to enable JWT middleware
+	"github.com/VoidMesh/api/api/internal/scripting"
+	"github.com/VoidMesh/api/api/internal/signup"
+	"github.com/VoidMesh/api/api/server/middleware"
+	"github.com/VoidMesh/api/api/services/action_queue"
 	"github.com/VoidMesh/api/api/services/character"
-	"github.com/VoidMesh/api/api/services/character_actions"
-	"github.com/VoidMesh/api/api/services/inventory"
-	"github.com/VoidMesh/api/api/services/noise"
-	"github.com/VoidMesh/api/api/services/resource_node"
-	"github.com/VoidMesh/api/api/services/world"
+	"github.com/VoidMesh/api/api/services/chunk"
+	"github.com/VoidMesh/api/api/services/world_maturity"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/health"
-	"google.golang.org/grpc/health/grpc_health_v1"
-	"google.golang.org/grpc/reflection"
 )
 
-func Serve() {
-	logger := logging.GetLogger()
-	logger.Info("Starting VoidMesh gRPC server initialization")
+// DefaultShutdownTimeout is how long in-flight requests and background jobs get to
+// finish after a shutdown signal
+const DefaultShutdownTimeout = 30 * time.Second
 
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+// Config holds the server settings. The serve command fills Address, DatabaseURL and
+// SQLitePath from its flags; everything else comes from ConfigFromEnv.
+type Config struct {
+	Address     string // Address to listen on, e.g. ":50051"
+	DatabaseURL string
+	SQLitePath  string // SQLite database file to use instead of DatabaseURL; empty uses Postgres
+
+	JWTSecret             string
+	AdminUserIDs          []string // Users who may call admin RPCs
+	ReflectionEnabled     bool
+	DebugRPCEnabled       bool
+	TickRate              int // Action queue ticks per second
+	ShardInstanceID       string
+	ShardAdvertiseAddress string
+	BalanceConfigPath     string           // Empty uses the embedded defaults
+	ResourcePacksDir      string           // Resource type packs added to the balance config; empty adds none
+	ChunkTemplatesPath    string           // Manifest of authored chunks; empty generates every chunk
+	SpriteAtlasDir        string           // Sprite atlas manifest and sheet images; empty serves the embedded atlas without images
+	AssetHTTPAddress      string           // Address to serve the sprite atlas over HTTP on; empty disables
+	DebugHTTPAddress      string           // Address to serve pprof, runtime diagnostics and the dashboard to admins on; empty disables
+	AssetBaseURL          string           // Public URL of the asset HTTP server, prefixed to sheet URLs
+	CalendarPath          string           // Seasonal events; empty runs none
+	Scripts               scripting.Config // Scripts.Dir empty disables scripting
+	ChunkPrefetchEnabled  bool
+	ChunkPrefetchDistance int              // Cells ahead of a moving character to generate chunks for
+	StreamBandwidth       bandwidth.Budget // Per client connection, across its streams
+	Compression           []string         // Response compressors in order of preference; empty disables
+	CompressedMethods     []string         // RPCs whose responses are compressed when the client supports it
+	RPCTimeout            time.Duration    // Unary RPCs without their own timeout
+	RPCMethodTimeouts     map[string]time.Duration
+	ClockSkewMaxAllowance time.Duration // Most a client-timed action is credited before it arrived
+	DBBreakerEnabled      bool
+	DBBreaker             dbbreaker.Config
+	DBQueryExecMode       string // One of queryExecModes; empty uses cache_statement, or simple_protocol behind PgBouncer
+	DBStatementCacheSize  int    // Statements (or descriptions) cached per connection in the cache modes
+	DBPgBouncer           bool   // Connections go through transaction-pooling PgBouncer, so no named prepared statements
+	PositionBufferEnabled bool   // Write in-chunk moves behind instead of on every move
+	PositionBuffer        character.PositionBufferConfig
+	Signup                signup.Config
+	CaptchaVerifyURL      string // Siteverify endpoint of the CAPTCHA provider; empty disables CAPTCHA
+	CaptchaSecret         string
+	OAuthDiscordClientID  string // Discord application accepted for federated login; empty disables Discord login
+	OAuthGoogleClientID   string // Google OAuth client accepted for federated login; empty disables Google login
+	AnalyticsSink         string // "dir" or "s3"; empty disables the analytics export
+	AnalyticsDir          string
+	AnalyticsS3           analytics.S3Config
+	Analytics             analytics.Config
+	WorldMaturity         world_maturity.Config // Interval, batch size and workers of the region density job
+	FaultInjection        string                // faults.ParseSpec faults for resilience testing; never set in production
+	ShutdownTimeout       time.Duration
+}
 
-	logger.Debug("Context created for server lifecycle management")
+// ConfigFromEnv reads the settings the server takes from environment variables
+func ConfigFromEnv() Config {
+	return Config{
+		JWTSecret:             os.Getenv("JWT_SECRET"),
+		AdminUserIDs:          strings.Split(os.Getenv("ADMIN_USER_IDS"), ","),
+		ReflectionEnabled:     envBool("GRPC_REFLECTION_ENABLED", true),
+		DebugRPCEnabled:       envBool("DEBUG_RPC_ENABLED", false),
+		TickRate:              envInt("TICK_RATE_HZ", action_queue.DefaultTickRate),
+		ShardInstanceID:       os.Getenv("SHARD_INSTANCE_ID"),
+		ShardAdvertiseAddress: os.Getenv("SHARD_ADVERTISE_ADDRESS"),
+		BalanceConfigPath:     os.Getenv("BALANCE_CONFIG_PATH"),
+		ResourcePacksDir:      os.Getenv("RESOURCE_PACKS_DIR"),
+		ChunkTemplatesPath:    os.Getenv("CHUNK_TEMPLATES_PATH"),
+		SpriteAtlasDir:        os.Getenv("SPRITE_ATLAS_DIR"),
+		AssetHTTPAddress:      os.Getenv("ASSET_HTTP_ADDRESS"),
+		DebugHTTPAddress:      os.Getenv("DEBUG_HTTP_ADDRESS"),
+		AssetBaseURL:          strings.TrimSuffix(os.Getenv("ASSET_BASE_URL"), "/"),
+		CalendarPath:          os.Getenv("CALENDAR_PATH"),
+		Scripts: scripting.Config{
+			Dir:              os.Getenv("SCRIPTS_DIR"),
+			Timeout:          time.Duration(envInt("SCRIPT_TIMEOUT_MS", int(scripting.DefaultConfig().Timeout/time.Millisecond))) * time.Millisecond,
+			MemoryLimitPages: uint32(envInt("SCRIPT_MEMORY_LIMIT_MB", int(scripting.DefaultConfig().MemoryLimitPages/16))) * 16,
+		},
+		ChunkPrefetchEnabled:  envBool("CHUNK_PREFETCH_ENABLED", true),
+		ChunkPrefetchDistance: envInt("CHUNK_PREFETCH_DISTANCE", int(chunk.DefaultPrefetchConfig().LookAhead)),
+		StreamBandwidth: bandwidth.Budget{
+			BytesPerSecond: envInt("STREAM_BANDWIDTH_BYTES_PER_SEC", bandwidth.DefaultBytesPerSecond),
+			Burst:          envInt("STREAM_BANDWIDTH_BURST_BYTES", bandwidth.DefaultBurst),
+		},
+		Compression:           envList("GRPC_COMPRESSION", compression.DefaultPreference),
+		CompressedMethods:     envList("GRPC_COMPRESSED_METHODS", compression.DefaultMethods),
+		RPCTimeout:            time.Duration(envInt("GRPC_TIMEOUT_MS", int(middleware.DefaultRPCTimeout/time.Millisecond))) * time.Millisecond,
+		RPCMethodTimeouts:     envTimeouts("GRPC_METHOD_TIMEOUTS", middleware.DefaultMethodTimeouts),
+		ClockSkewMaxAllowance: time.Duration(envInt("CLOCK_SKEW_MAX_ALLOWANCE_MS", int(latency.DefaultMaxAllowance/time.Millisecond))) * time.Millisecond,
+		DBBreakerEnabled:      envBool("DB_BREAKER_ENABLED", true),
+		DBBreaker: dbbreaker.Config{
+			Window:         dbbreaker.DefaultConfig().Window,
+			MinQueries:     dbbreaker.DefaultConfig().MinQueries,
+			FailureRatio:   float64(envInt("DB_BREAKER_FAILURE_PERCENT", int(dbbreaker.DefaultConfig().FailureRatio*100))) / 100,
+			SlowQuery:      time.Duration(envInt("DB_BREAKER_SLOW_QUERY_MS", int(dbbreaker.DefaultConfig().SlowQuery/time.Millisecond))) * time.Millisecond,
+			OpenFor:        time.Duration(envInt("DB_BREAKER_OPEN_SECONDS", int(dbbreaker.DefaultConfig().OpenFor/time.Second))) * time.Second,
+			ProbeSuccesses: dbbreaker.DefaultConfig().ProbeSuccesses,
+		},
+		DBQueryExecMode:       os.Getenv("DB_QUERY_EXEC_MODE"),
+		DBStatementCacheSize:  envInt("DB_STATEMENT_CACHE_SIZE", DefaultStatementCacheSize),
+		DBPgBouncer:           envBool("DB_PGBOUNCER", false),
+		PositionBufferEnabled: envBool("POSITION_BUFFER_ENABLED", true),
+		PositionBuffer: character.PositionBufferConfig{
+			FlushInterval: time.Duration(envInt("POSITION_FLUSH_MS", int(character.DefaultPositionBufferConfig().FlushInterval/time.Millisecond))) * time.Millisecond,
+		},
+		Signup: signup.Config{
+			MaxPerIP:             envInt("SIGNUP_MAX_PER_IP", signup.DefaultConfig().MaxPerIP),
+			RateWindow:           time.Duration(envInt("SIGNUP_RATE_WINDOW_MINUTES", int(signup.DefaultConfig().RateWindow/time.Minute))) * time.Minute,
+			BlockDisposableEmail: envBool("SIGNUP_BLOCK_DISPOSABLE_EMAIL", signup.DefaultConfig().BlockDisposableEmail),
+			BlockedEmailDomains:  envList("SIGNUP_BLOCKED_EMAIL_DOMAINS", nil),
+			NewAccountPeriod:     time.Duration(envInt("SIGNUP_NEW_ACCOUNT_HOURS", 0)) * time.Hour,
+			NewAccountActions:    envList("SIGNUP_NEW_ACCOUNT_ACTIONS", signup.DefaultConfig().NewAccountActions),
+		},
+		CaptchaVerifyURL:     os.Getenv("CAPTCHA_VERIFY_URL"),
+		CaptchaSecret:        os.Getenv("CAPTCHA_SECRET"),
+		OAuthDiscordClientID: os.Getenv("OAUTH_DISCORD_CLIENT_ID"),
+		OAuthGoogleClientID:  os.Getenv("OAUTH_GOOGLE_CLIENT_ID"),
+		AnalyticsSink:        os.Getenv("ANALYTICS_SINK"),
+		AnalyticsDir:         os.Getenv("ANALYTICS_DIR"),
+		AnalyticsS3: analytics.S3Config{
+			Endpoint:  os.Getenv("ANALYTICS_S3_ENDPOINT"),
+			Region:    envString("ANALYTICS_S3_REGION", "us-east-1"),
+			Bucket:    os.Getenv("ANALYTICS_S3_BUCKET"),
+			Prefix:    os.Getenv("ANALYTICS_S3_PREFIX"),
+			AccessKey: os.Getenv("ANALYTICS_S3_ACCESS_KEY"),
+			SecretKey: os.Getenv("ANALYTICS_S3_SECRET_KEY"),
+		},
+		Analytics: analytics.Config{
+			FlushInterval:      time.Duration(envInt("ANALYTICS_FLUSH_INTERVAL_SECONDS", int(analytics.DefaultConfig().FlushInterval/time.Second))) * time.Second,
+			MaxBatch:           analytics.DefaultConfig().MaxBatch,
+			MaxBuffered:        analytics.DefaultConfig().MaxBuffered,
+			MoveSampleInterval: time.Duration(envInt("ANALYTICS_MOVE_SAMPLE_SECONDS", int(analytics.DefaultConfig().MoveSampleInterval/time.Second))) * time.Second,
+		},
+		WorldMaturity:   worldMaturityFromEnv(),
+		FaultInjection:  os.Getenv("FAULT_INJECTION"),
+		ShutdownTimeout: DefaultShutdownTimeout,
+	}
+}
 
-	// Create a listener on TCP port for gRPC server
-	logger.Debug("Creating TCP listener on port 50051")
-	lis, err := net.Listen("tcp", ":50051")
-	if err != nil {
-		logger.Fatal("Failed to create TCP listener", "error", err, "port", 50051)
+// validate reports settings the server cannot start without
+func (c Config) validate() error {
+	if c.JWTSecret == "" {
+		return errors.New("JWT_SECRET environment variable is required for production")
+	}
+	if c.ShardInstanceID != "" && c.ShardAdvertiseAddress == "" {
+		return errors.New("SHARD_ADVERTISE_ADDRESS is required when SHARD_INSTANCE_ID is set")
+	}
+	if c.DebugHTTPAddress != "" && !slices.ContainsFunc(c.AdminUserIDs, func(id string) bool { return strings.TrimSpace(id) != "" }) {
+		return errors.New("ADMIN_USER_IDS is required when DEBUG_HTTP_ADDRESS is set, only admins may use it")
+	}
+	if c.CaptchaVerifyURL != "" && c.CaptchaSecret == "" {
+		return errors.New("CAPTCHA_SECRET is required when CAPTCHA_VERIFY_URL is set")
+	}
+	switch c.AnalyticsSink {
+	case "":
+	case "dir":
+		if c.AnalyticsDir == "" {
+			return errors.New("ANALYTICS_DIR is required when ANALYTICS_SINK is dir")
+		}
+	case "s3":
+		if c.AnalyticsS3.Endpoint == "" || c.AnalyticsS3.Bucket == "" || c.AnalyticsS3.AccessKey == "" || c.AnalyticsS3.SecretKey == "" {
+			return errors.New("ANALYTICS_S3_ENDPOINT, ANALYTICS_S3_BUCKET, ANALYTICS_S3_ACCESS_KEY and ANALYTICS_S3_SECRET_KEY are required when ANALYTICS_SINK is s3")
+		}
+	default:
+		return fmt.Errorf("ANALYTICS_SINK: unknown sink %q", c.AnalyticsSink)
+	}
+	mode := c.queryExecMode()
+	if _, ok := queryExecModes[mode]; !ok {
+		return fmt.Errorf("DB_QUERY_EXEC_MODE: unknown mode %q", mode)
 	}
-	defer func() {
-		if err := lis.Close(); err != nil {
-			logger.Error("Failed to close listener", "error", err)
+	if c.DBPgBouncer && mode == "cache_statement" {
+		return errors.New("DB_QUERY_EXEC_MODE=cache_statement prepares named statements, which break behind transaction-pooling PgBouncer; use cache_describe, describe_exec, exec or simple_protocol")
+	}
+	for _, name := range c.Compression {
+		if !compression.Registered(name) {
+			return fmt.Errorf("GRPC_COMPRESSION: unknown compressor %q", name)
 		}
-	}()
-	logger.Info("TCP listener created successfully", "address", lis.Addr().String())
-
-	// Create a new gRPC server
-	logger.Debug("Initializing gRPC server with JWT authentication")
-
-	// Configure JWT authentication
-	jwtSecret := []byte(os.Getenv("JWT_SECRET"))
-	if len(jwtSecret) == 0 {
-		logger.Fatal("JWT_SECRET environment variable is required for production")
-	}
-	logger.Debug("JWT secret loaded", "length", len(jwtSecret))
-	g := grpc.NewServer(
-		grpc.UnaryInterceptor(middleware.JWTAuthInterceptor(jwtSecret)),
-	)
-	logger.Info("gRPC server created with JWT authentication interceptor")
-
-	defer func() {
-		logger.Info("Initiating graceful server shutdown")
-		g.GracefulStop()
-		logger.Info("Server shutdown completed")
-	}()
-
-	// Register reflection service
-	logger.Debug("Registering gRPC reflection service")
-	reflection.Register(g)
-	logger.Debug("gRPC reflection service registered successfully")
-
-	// Register health check service
-	logger.Debug("Registering health check service")
-	healthServer := health.NewServer()
-	grpc_health_v1.RegisterHealthServer(g, healthServer)
-	logger.Debug("Health check service registered successfully")
-
-	// Create a new PostgreSQL connection pool
-	databaseURL := os.Getenv("DATABASE_URL")
-	logger.Debug("Connecting to PostgreSQL database", "url_length", len(databaseURL))
-
-	start := time.Now()
-	dbPool, err := pgxpool.New(ctx, databaseURL)
-	connectionDuration := time.Since(start)
+	}
+	if _, err := faults.ParseSpec(c.FaultInjection); err != nil {
+		return fmt.Errorf("FAULT_INJECTION: %w", err)
+	}
+	return nil
+}
 
+// faultInjector builds an injector with the FAULT_INJECTION faults set
+func (c Config) faultInjector() (*faults.Injector, error) {
+	spec, err := faults.ParseSpec(c.FaultInjection)
 	if err != nil {
-		logger.Fatal("Failed to connect to database", "error", err, "duration", connectionDuration)
+		return nil, fmt.Errorf("FAULT_INJECTION: %w", err)
 	}
-	logger.Info("Database connection pool created successfully", "duration", connectionDuration)
+	injector := faults.New(uint64(time.Now().UnixNano()))
+	for target, fault := range spec {
+		injector.Set(target, fault)
+	}
+	return injector, nil
+}
 
-	// Create world service using new constructor
-	worldLogger := world.NewDefaultLoggerWrapper()
-	worldService := world.NewServiceWithPool(dbPool, worldLogger)
+// DefaultStatementCacheSize matches pgx's default per-connection statement cache
+const DefaultStatementCacheSize = 512
 
-	// Get default world or create if it doesn't exist
-	defaultWorld, err := worldService.GetDefaultWorld(ctx)
-	if err != nil {
-		logger.Error("Failed to get default world", "error", err)
-		return
-	}
-	logger.Debug("Default world loaded", "world_id", defaultWorld.ID, "seed", defaultWorld.Seed)
+// queryExecModes maps DB_QUERY_EXEC_MODE values to pgx query modes. Only cache_statement
+// prepares named statements on the server; the others work behind transaction-pooling
+// PgBouncer, with simple_protocol also working on PgBouncer versions without any
+// prepared statement support.
+var queryExecModes = map[string]pgx.QueryExecMode{
+	"cache_statement": pgx.QueryExecModeCacheStatement,
+	"cache_describe":  pgx.QueryExecModeCacheDescribe,
+	"describe_exec":   pgx.QueryExecModeDescribeExec,
+	"exec":            pgx.QueryExecModeExec,
+	"simple_protocol": pgx.QueryExecModeSimpleProtocol,
+}
 
-	// Create shared noise generator
-	noiseGen := noise.NewGenerator(defaultWorld.Seed)
+// queryExecMode is the DB_QUERY_EXEC_MODE in effect
+func (c Config) queryExecMode() string {
+	switch {
+	case c.DBQueryExecMode != "":
+		return c.DBQueryExecMode
+	case c.DBPgBouncer:
+		return "simple_protocol"
+	default:
+		return "cache_statement"
+	}
+}
 
-	// Register V1 services
-	logger.Debug("Registering gRPC service handlers")
+// configurePool applies the query mode and statement cache settings to a pool config
+func (c Config) configurePool(poolConfig *pgxpool.Config) {
+	poolConfig.ConnConfig.DefaultQueryExecMode = queryExecModes[c.queryExecMode()]
+	poolConfig.ConnConfig.StatementCacheCapacity = c.DBStatementCacheSize
+	poolConfig.ConnConfig.DescriptionCacheCapacity = c.DBStatementCacheSize
+}
 
-	logger.Debug("Registering UserService")
-	userServer, err := handlers.NewUserServerWithPool(dbPool)
-	if err != nil {
-		logger.Fatal("Failed to create user server", "error", err)
+// Serve runs the gRPC server until it fails or the process receives SIGINT or SIGTERM,
+// then stops every component in the reverse of the order they started
+func Serve(config Config) error {
+	logger := logging.GetLogger()
+	logger.Info("Starting VoidMesh gRPC server initialization")
+	if err := config.validate(); err != nil {
+		return err
 	}
-	pbUserV1.RegisterUserServiceServer(g, userServer)
 
-	logger.Debug("Registering WorldService")
-	pbWorldV1.RegisterWorldServiceServer(g, handlers.NewWorldHandler(worldService))
+	c := bootstrap.New(logging.WithComponent("bootstrap"))
+	bootstrap.Supply(c, "config", config)
+	provideComponents(c)
 
-	logger.Debug("Registering CharacterService")
-	characterServer, err := handlers.NewCharacterServerWithPool(dbPool)
+	g, err := registerServices(c)
 	if err != nil {
-		logger.Fatal("Failed to create character server", "error", err)
-		return
+		return err
 	}
-	pbCharacterV1.RegisterCharacterServiceServer(g, characterServer)
 
-	// Create underlying character service for other services
-	// We need to create the chunk service as well
-	chunkService, err := handlers.NewChunkServiceWithPool(dbPool)
-	if err != nil {
-		logger.Fatal("Failed to create chunk service for character service", "error", err)
+	// The listener starts last and stops first, so in-flight requests finish before the
+	// components they use shut down
+	serveErr := make(chan error, 1)
+	c.Append(bootstrap.Hook{
+		Name: "grpc",
+		OnStart: func(context.Context) error {
+			lis, err := net.Listen("tcp", config.Address)
+			if err != nil {
+				return fmt.Errorf("failed to create TCP listener on %s: %w", config.Address, err)
+			}
+			features := []string{"JWT Auth", "Health Check"}
+			if config.ReflectionEnabled {
+				features = append(features, "Reflection")
+			}
+			if config.DebugRPCEnabled {
+				features = append(features, "Debug RPC")
+			}
+			logger.Info("🚀 VoidMesh API server ready to accept connections",
+				"address", lis.Addr().String(),
+				"services", []string{"User", "World", "Character", "Chunk", "ResourceNode", "Terrain", "Inventory", "CharacterActions"},
+				"features", features)
+			go func() { serveErr <- g.Serve(lis) }()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			logger.Info("Initiating graceful server shutdown")
+			stopped := make(chan struct{})
+			go func() {
+				g.GracefulStop()
+				close(stopped)
+			}()
+			select {
+			case <-stopped:
+				return nil
+			case <-ctx.Done():
+				g.Stop()
+				return fmt.Errorf("in-flight requests did not finish: %w", ctx.Err())
+			}
+		},
+	})
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	if err := c.Start(ctx); err != nil {
+		return err
 	}
-	characterRealService := character.NewServiceWithPool(dbPool, chunkService)
 
-	logger.Debug("Registering TerrainService")
-	terrainService := handlers.NewTerrainServiceWithDefaultLogger()
-	terrainLogger := &handlers.LoggerWrapper{Logger: logging.WithComponent("terrain-handler")}
-	terrainServer := handlers.NewTerrainServer(terrainService, terrainLogger)
-	pbTerrainV1.RegisterTerrainServiceServer(g, terrainServer)
+	select {
+	case <-ctx.Done():
+		logger.Info("Shutdown signal received")
+	case err = <-serveErr:
+		logger.Error("gRPC server failed to serve", "error", err)
+		err = fmt.Errorf("gRPC server failed to serve: %w", err)
+	}
 
-	logger.Debug("Registering ResourceNodeService")
-	resourceNodeService := resource_node.NewNodeServiceWithPool(dbPool, noiseGen.(*noise.Generator), worldService)
-	pbResourceNodeV1.RegisterResourceNodeServiceServer(g, handlers.NewResourceNodeHandler(resourceNodeService, worldService))
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), config.ShutdownTimeout)
+	defer cancel()
+	err = errors.Join(err, c.Stop(shutdownCtx))
+	logger.Info("Server shutdown completed")
+	return err
+}
 
-	logger.Debug("Registering ChunkService")
-	chunkServer, err := handlers.NewChunkServerWithPool(dbPool)
+// envBool reads a boolean environment variable, returning fallback when it is unset or unparsable
+func envBool(name string, fallback bool) bool {
+	value, err := strconv.ParseBool(os.Getenv(name))
 	if err != nil {
-		logger.Fatal("Failed to create chunk server", "error", err)
+		return fallback
 	}
-	pbChunkV1.RegisterChunkServiceServer(g, chunkServer)
+	return value
+}
 
-	logger.Debug("Registering InventoryService")
-	inventoryService := inventory.NewServiceWithPool(dbPool, characterRealService)
-	pbInventoryV1.RegisterInventoryServiceServer(g, handlers.NewInventoryHandler(inventoryService))
+// envString reads an environment variable, returning fallback when it is unset
+func envString(name, fallback string) string {
+	if value := os.Getenv(name); value != "" {
+		return value
+	}
+	return fallback
+}
 
-	logger.Debug("Registering CharacterActionsService")
-	characterActionsService := character_actions.NewService(
-		character_actions.NewDatabaseWrapper(db.New(dbPool)),
-		character_actions.NewInventoryServiceAdapter(inventoryService),
-		character_actions.NewCharacterServiceAdapter(characterRealService),
-		character_actions.NewDefaultLoggerWrapper(),
-	)
-	characterActionsHandler := handlers.NewCharacterActionsServiceWithPool(characterActionsService)
-	pbCharacterActionsV1.RegisterCharacterActionsServiceServer(g, handlers.NewCharacterActionsServer(characterActionsHandler))
+// worldMaturityFromEnv returns the default maturity settings with the job's interval,
+// regions per update and store workers taken from the environment
+func worldMaturityFromEnv() world_maturity.Config {
+	config := world_maturity.DefaultConfig()
+	config.Interval = time.Duration(envInt("WORLD_MATURITY_INTERVAL_MINUTES", int(config.Interval/time.Minute))) * time.Minute
+	config.BatchSize = envInt("WORLD_MATURITY_BATCH_SIZE", config.BatchSize)
+	config.Workers = envInt("WORLD_MATURITY_WORKERS", config.Workers)
+	return config
+}
 
-	logger.Info("All gRPC services registered successfully")
+// envInt reads a positive integer environment variable, returning fallback when it is unset or invalid
+func envInt(name string, fallback int) int {
+	value, err := strconv.Atoi(os.Getenv(name))
+	if err != nil || value <= 0 {
+		return fallback
+	}
+	return value
+}
 
-	// Serve the gRPC server
-	logger.Info("🚀 VoidMesh API server ready to accept connections",
-		"address", lis.Addr().String(),
-		"services", []string{"User", "World", "Character", "Chunk", "ResourceNode", "Terrain", "Inventory", "CharacterActions"},
-		"features", []string{"JWT Auth", "Health Check", "Reflection"})
+// envList reads a comma-separated environment variable, returning fallback when it is
+// unset and nothing when it is "none"
+func envList(name string, fallback []string) []string {
+	value := os.Getenv(name)
+	switch strings.TrimSpace(value) {
+	case "":
+		return fallback
+	case "none":
+		return nil
+	}
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
 
-	logger.Debug("Starting to serve gRPC requests")
-	if err := g.Serve(lis); err != nil {
-		logger.Fatal("gRPC server failed to serve", "error", err)
+// envTimeouts reads comma-separated method=milliseconds pairs over the fallback timeouts;
+// 0 leaves a method unbounded and malformed pairs are ignored
+func envTimeouts(name string, fallback map[string]time.Duration) map[string]time.Duration {
+	timeouts := make(map[string]time.Duration, len(fallback))
+	for method, timeout := range fallback {
+		timeouts[method] = timeout
 	}
+	for _, item := range envList(name, nil) {
+		method, value, ok := strings.Cut(item, "=")
+		if !ok {
+			continue
+		}
+		ms, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || ms < 0 {
+			continue
+		}
+		timeouts[strings.TrimSpace(method)] = time.Duration(ms) * time.Millisecond
+	}
+	return timeouts
+}
+
+// newAlerter builds the alerter from ALERT_* environment variables. Without a webhook
+// URL or SMTP address alerts are only logged as they would have been before.
+func newAlerter() *alerting.Alerter {
+	logger := logging.WithComponent("alerting")
+	config := alerting.DefaultConfig()
+	if conditions := os.Getenv("ALERT_CONDITIONS"); conditions != "" {
+		config.Conditions = nil
+		for _, condition := range strings.Split(conditions, ",") {
+			config.Conditions = append(config.Conditions, alerting.Condition(strings.TrimSpace(condition)))
+		}
+	}
+
+	var notifiers []alerting.Notifier
+	if url := os.Getenv("ALERT_WEBHOOK_URL"); url != "" {
+		format := os.Getenv("ALERT_WEBHOOK_FORMAT")
+		if format == "" {
+			format = alerting.FormatSlack
+		}
+		notifier, err := alerting.NewWebhookNotifier(url, format)
+		if err != nil {
+			logger.Error("Invalid alert webhook configuration", "error", err)
+		} else {
+			notifiers = append(notifiers, notifier)
+		}
+	}
+	if addr := os.Getenv("ALERT_SMTP_ADDR"); addr != "" {
+		notifier, err := alerting.NewEmailNotifier(alerting.EmailConfig{
+			Addr:     addr,
+			Username: os.Getenv("ALERT_SMTP_USERNAME"),
+			Password: os.Getenv("ALERT_SMTP_PASSWORD"),
+			From:     os.Getenv("ALERT_EMAIL_FROM"),
+			To:       strings.Split(os.Getenv("ALERT_EMAIL_TO"), ","),
+		})
+		if err != nil {
+			logger.Error("Invalid alert email configuration", "error", err)
+		} else {
+			notifiers = append(notifiers, notifier)
+		}
+	}
+
+	logger.Info("Alerting configured", "notifiers", len(notifiers), "conditions", config.Conditions)
+	return alerting.New(config, notifiers...)
 }
@@ -1,41 +1,30 @@
 package server
 
 import (
-	"context"
+	"os"
 
 	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/storage"
 	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
 	resourceNodeV1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
 	terrainV1 "github.com/VoidMesh/api/api/proto/terrain/v1"
 	worldV1 "github.com/VoidMesh/api/api/proto/world/v1"
 	"github.com/VoidMesh/api/api/server/handlers"
-	"github.com/VoidMesh/api/api/services/noise"
 	"github.com/VoidMesh/api/api/services/resource_node"
 	"github.com/VoidMesh/api/api/services/terrain"
 	"github.com/VoidMesh/api/api/services/world"
-	"github.com/jackc/pgx/v5/pgxpool"
 	"google.golang.org/grpc"
 )
 
 // RegisterServices registers all service handlers with the gRPC server
-func RegisterServices(server *grpc.Server, database *pgxpool.Pool) {
+func RegisterServices(server *grpc.Server, database storage.Pool) {
 	logger := logging.GetLogger()
 
 	// Create world service first using the new constructor pattern
 	worldLogger := world.NewDefaultLoggerWrapper()
 	worldService := world.NewServiceWithPool(database, worldLogger)
 
-	// Get default world or create if it doesn't exist
-	defaultWorld, err := worldService.GetDefaultWorld(context.Background())
-	if err != nil {
-		logger.Error("Failed to get default world", "error", err)
-		return
-	}
-
-	// Create shared components
-	noiseGen := noise.NewGenerator(defaultWorld.Seed)
-
-	// Create and register chunk service with shared noise generator using new constructor
+	// Create and register chunk service using new constructor
 	chunkHandler, err := handlers.NewChunkServerWithPool(database)
 	if err != nil {
 		logger.Error("Failed to create chunk handler", "error", err)
@@ -43,8 +32,12 @@ func RegisterServices(server *grpc.Server, database *pgxpool.Pool) {
 	}
 	chunkV1.RegisterChunkServiceServer(server, chunkHandler)
 
-	// Create and register resource node service with shared noise generator using new constructor
-	resourceNodeService := resource_node.NewNodeServiceWithPool(database, noiseGen.(*noise.Generator), worldService)
+	// Create and register resource node service; each world generates from its own seed
+	resourceNodeService := resource_node.NewNodeServiceWithPool(database, world.NewSeeds(worldService), worldService)
+	if _, err := resourceNodeService.LoadBalanceConfig(os.Getenv("BALANCE_CONFIG_PATH")); err != nil {
+		logger.Error("Failed to load balance config", "error", err)
+		return
+	}
 	resourceNodeHandler := handlers.NewResourceNodeHandler(resourceNodeService, worldService)
 	resourceNodeV1.RegisterResourceNodeServiceServer(server, resourceNodeHandler)
 
@@ -0,0 +1,311 @@
+// Package achievement tracks achievements: declarative thresholds over per-character
+// counters of domain events, such as harvesting 100 times. Counters are updated from the
+// event bus, an achievement unlocks once its counter reaches the threshold (notifying the
+// owner through the outbox), and its item reward is claimed once.
+package achievement
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/authz"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	achievementV1 "github.com/VoidMesh/api/api/proto/achievement/v1"
+	"github.com/VoidMesh/api/api/services/cosmetic"
+	"github.com/VoidMesh/api/api/services/inventory"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var ErrAlreadyClaimed = errors.New("reward already claimed")
+
+// ItemAmount is a quantity of an item, by name
+type ItemAmount struct {
+	Item     string
+	Quantity int32
+}
+
+// Definition unlocks once the character's Counter reaches Threshold. Counters are event
+// types, counted once per event for the character_id in its payload.
+type Definition struct {
+	ID          string
+	Name        string
+	Description string
+	Counter     string
+	Threshold   int64
+	Rewards     []ItemAmount
+	Cosmetic    string // Title or badge unlocked with the achievement; optional
+}
+
+// Config sets the achievements that can be earned
+type Config struct {
+	Definitions []Definition
+}
+
+// DefaultConfig rewards gathering, crafting, exploring and rare events
+func DefaultConfig() Config {
+	return Config{
+		Definitions: []Definition{
+			{
+				ID: "first_harvest", Name: "First Harvest", Description: "Harvest a resource node",
+				Counter: events.ResourceHarvested, Threshold: 1,
+				Rewards: []ItemAmount{{"Twigs", 5}},
+			},
+			{
+				ID: "gatherer", Name: "Gatherer", Description: "Harvest 100 times",
+				Counter: events.ResourceHarvested, Threshold: 100,
+				Rewards:  []ItemAmount{{"Stone", 20}, {"Minerals", 5}},
+				Cosmetic: cosmetic.Gatherer,
+			},
+			{
+				ID: "master_gatherer", Name: "Master Gatherer", Description: "Harvest 1,000 times",
+				Counter: events.ResourceHarvested, Threshold: 1000,
+				Rewards:  []ItemAmount{{"Metal Ingot", 5}},
+				Cosmetic: cosmetic.MasterGatherer,
+			},
+			{
+				ID: "first_craft", Name: "Handiwork", Description: "Craft an item",
+				Counter: events.ItemCrafted, Threshold: 1,
+				Rewards: []ItemAmount{{"Herbs", 3}},
+			},
+			{
+				ID: "artisan", Name: "Artisan", Description: "Craft 50 items",
+				Counter: events.ItemCrafted, Threshold: 50,
+				Rewards:  []ItemAmount{{"Glass", 5}},
+				Cosmetic: cosmetic.Artisan,
+			},
+			{
+				ID: "explorer", Name: "Explorer", Description: "Be the first to reach 25 chunks",
+				Counter: events.ChunkGenerated, Threshold: 25,
+				Rewards:  []ItemAmount{{"Grilled Fish", 5}},
+				Cosmetic: cosmetic.Explorer,
+			},
+			{
+				ID: "event_hunter", Name: "Event Hunter", Description: "Complete 5 rare events",
+				Counter: events.RareEventCompleted, Threshold: 5,
+				Rewards:  []ItemAmount{{"Metal Ingot", 3}},
+				Cosmetic: cosmetic.EventHunter,
+			},
+		},
+	}
+}
+
+// Service tracks achievement progress and grants rewards.
+type Service struct {
+	db     DatabaseInterface
+	logger LoggerInterface
+	clock  clock.Clock
+	config Config
+}
+
+// NewService creates a new achievement service with dependency injection.
+func NewService(db DatabaseInterface, logger LoggerInterface) *Service {
+	componentLogger := logger.With("component", "achievement-service")
+	componentLogger.Debug("Creating new achievement service")
+	return &Service{
+		db:     db,
+		logger: componentLogger,
+		clock:  clock.New(),
+		config: DefaultConfig(),
+	}
+}
+
+// NewServiceWithPool creates a service with concrete implementations (convenience constructor for production use).
+func NewServiceWithPool(pool storage.Pool) *Service {
+	return NewService(NewDatabaseWrapper(pool), NewDefaultLoggerWrapper())
+}
+
+// SetClock replaces the clock used to time claims
+func (s *Service) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// SetConfig replaces the definitions. Call it before Subscribe.
+func (s *Service) SetConfig(config Config) {
+	s.config = config
+}
+
+// Subscribe counts the event types the definitions are over
+func (s *Service) Subscribe(bus *events.Bus) {
+	byCounter := make(map[string][]Definition)
+	for _, definition := range s.config.Definitions {
+		byCounter[definition.Counter] = append(byCounter[definition.Counter], definition)
+	}
+	for counter, definitions := range byCounter {
+		bus.Subscribe(counter, events.Dedup(s.countHandler(counter, definitions), events.DefaultDedupCapacity))
+	}
+}
+
+// countHandler counts events of one type for the character in their payload. Events
+// without a character, or for one deleted since, are not counted.
+func (s *Service) countHandler(counter string, definitions []Definition) events.Handler {
+	return func(ctx context.Context, event events.Event) error {
+		var payload struct {
+			CharacterID string `json:"character_id"`
+		}
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return fmt.Errorf("invalid %s payload: %w", event.Type, err)
+		}
+		if payload.CharacterID == "" {
+			return nil
+		}
+		id, err := uuid.StringToPgtype(payload.CharacterID)
+		if err != nil {
+			return fmt.Errorf("invalid character ID %q: %w", payload.CharacterID, err)
+		}
+		character, err := s.db.GetCharacterById(ctx, id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get character %s: %w", payload.CharacterID, err)
+		}
+
+		unlocked, err := s.db.Count(ctx, character, counter, definitions, pgtype.Timestamp{Time: event.OccurredAt, Valid: true})
+		if err != nil {
+			return err
+		}
+		for _, achievementID := range unlocked {
+			s.logger.Info("Achievement unlocked", "character_id", payload.CharacterID, "achievement_id", achievementID)
+		}
+		return nil
+	}
+}
+
+// List returns every achievement with the progress of one of the user's characters, in
+// definition order
+func (s *Service) List(ctx context.Context, userID, characterID string) ([]*achievementV1.Achievement, error) {
+	character, err := authz.OwnedCharacter(ctx, s.db, s.logger, userID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	counters, err := s.db.ListAchievementCounters(ctx, character.ID)
+	if err != nil {
+		s.logger.Error("Failed to list achievement counters", "character_id", characterID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list achievements")
+	}
+	rows, err := s.db.ListCharacterAchievements(ctx, character.ID)
+	if err != nil {
+		s.logger.Error("Failed to list character achievements", "character_id", characterID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list achievements")
+	}
+
+	values := make(map[string]int64, len(counters))
+	for _, counter := range counters {
+		values[counter.Counter] = counter.Value
+	}
+	unlocked := make(map[string]db.CharacterAchievement, len(rows))
+	for _, row := range rows {
+		unlocked[row.AchievementID] = row
+	}
+
+	achievements := make([]*achievementV1.Achievement, 0, len(s.config.Definitions))
+	for _, definition := range s.config.Definitions {
+		rewards, err := s.resolve(ctx, definition.Rewards)
+		if err != nil {
+			s.logger.Error("Failed to resolve achievement rewards", "achievement_id", definition.ID, "error", err)
+			return nil, status.Errorf(codes.Internal, "failed to list achievements")
+		}
+		achievement := &achievementV1.Achievement{
+			Id:          definition.ID,
+			Name:        definition.Name,
+			Description: definition.Description,
+			Progress:    min(values[definition.Counter], definition.Threshold),
+			Threshold:   definition.Threshold,
+			Rewards:     itemsToProto(rewards),
+			Cosmetic:    definition.Cosmetic,
+		}
+		if row, ok := unlocked[definition.ID]; ok {
+			// Unlocked achievements show as complete even if the threshold was raised since
+			achievement.Progress = definition.Threshold
+			achievement.UnlockedAt = timestamppb.New(row.UnlockedAt.Time)
+			if row.ClaimedAt.Valid {
+				achievement.ClaimedAt = timestamppb.New(row.ClaimedAt.Time)
+			}
+		}
+		achievements = append(achievements, achievement)
+	}
+	return achievements, nil
+}
+
+// Claim grants an unlocked achievement's reward to one of the user's characters
+func (s *Service) Claim(ctx context.Context, userID, characterID, achievementID string) ([]*achievementV1.ItemQuantity, error) {
+	definition, ok := s.definition(achievementID)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "achievement not found")
+	}
+	character, err := authz.OwnedCharacter(ctx, s.db, s.logger, userID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	rewards, err := s.resolve(ctx, definition.Rewards)
+	if err != nil {
+		s.logger.Error("Failed to resolve achievement rewards", "achievement_id", definition.ID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to claim reward")
+	}
+	grants := make([]inventory.Grant, 0, len(rewards))
+	for _, r := range rewards {
+		grants = append(grants, inventory.Grant{ItemID: r.item.ID, StackSize: r.item.StackSize, Quantity: r.quantity})
+	}
+
+	err = s.db.Claim(ctx, character, definition.ID, grants, pgtype.Timestamp{Time: s.clock.Now(), Valid: true})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, status.Errorf(codes.FailedPrecondition, "achievement is not unlocked")
+	case errors.Is(err, ErrAlreadyClaimed):
+		return nil, status.Errorf(codes.AlreadyExists, "reward already claimed")
+	case errors.Is(err, inventory.ErrInventoryFull):
+		return nil, status.Errorf(codes.ResourceExhausted, "inventory is full")
+	case err != nil:
+		s.logger.Error("Failed to claim achievement reward", "achievement_id", definition.ID, "character_id", characterID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to claim reward")
+	}
+
+	s.logger.Info("Achievement reward claimed", "achievement_id", definition.ID, "character_id", characterID)
+	return itemsToProto(rewards), nil
+}
+
+func (s *Service) definition(achievementID string) (Definition, bool) {
+	for _, definition := range s.config.Definitions {
+		if definition.ID == achievementID {
+			return definition, true
+		}
+	}
+	return Definition{}, false
+}
+
+// resolved is an ItemAmount with the item looked up
+type resolved struct {
+	item     db.Item
+	quantity int32
+}
+
+// resolve looks up the items of amounts by name
+func (s *Service) resolve(ctx context.Context, amounts []ItemAmount) ([]resolved, error) {
+	items := make([]resolved, 0, len(amounts))
+	for _, amount := range amounts {
+		item, err := s.db.GetItemByName(ctx, amount.Item)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get item %q: %w", amount.Item, err)
+		}
+		items = append(items, resolved{item: item, quantity: amount.Quantity})
+	}
+	return items, nil
+}
+
+func itemsToProto(items []resolved) []*achievementV1.ItemQuantity {
+	quantities := make([]*achievementV1.ItemQuantity, 0, len(items))
+	for _, r := range items {
+		quantities = append(quantities, &achievementV1.ItemQuantity{ItemId: r.item.ID, ItemName: r.item.Name, Quantity: r.quantity})
+	}
+	return quantities
+}
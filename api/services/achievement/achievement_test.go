@@ -0,0 +1,212 @@
+package achievement
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	"github.com/VoidMesh/api/api/services/inventory"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeDB keeps one character's counters, achievements and inventory in memory
+type fakeDB struct {
+	testmocks.Characters
+	items        map[string]db.Item
+	counters     map[string]int64
+	achievements map[string]db.CharacterAchievement
+	unlocked     []events.AchievementUnlockedPayload // Enqueued events
+	inventory    map[int32]int32
+	capacity     int32 // Items that fit in the inventory
+}
+
+func (f *fakeDB) GetItemByName(ctx context.Context, name string) (db.Item, error) {
+	item, ok := f.items[name]
+	if !ok {
+		return db.Item{}, pgx.ErrNoRows
+	}
+	return item, nil
+}
+
+func (f *fakeDB) ListAchievementCounters(ctx context.Context, characterID pgtype.UUID) ([]db.AchievementCounter, error) {
+	var rows []db.AchievementCounter
+	for counter, value := range f.counters {
+		rows = append(rows, db.AchievementCounter{CharacterID: characterID, Counter: counter, Value: value})
+	}
+	return rows, nil
+}
+
+func (f *fakeDB) ListCharacterAchievements(ctx context.Context, characterID pgtype.UUID) ([]db.CharacterAchievement, error) {
+	var rows []db.CharacterAchievement
+	for _, row := range f.achievements {
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func (f *fakeDB) Count(ctx context.Context, character db.Character, counter string, definitions []Definition, now pgtype.Timestamp) ([]string, error) {
+	f.counters[counter]++
+	var unlocked []string
+	for _, definition := range definitions {
+		if _, ok := f.achievements[definition.ID]; ok || f.counters[counter] < definition.Threshold {
+			continue
+		}
+		f.achievements[definition.ID] = db.CharacterAchievement{CharacterID: character.ID, AchievementID: definition.ID, UnlockedAt: now}
+		f.unlocked = append(f.unlocked, events.AchievementUnlockedPayload{
+			CharacterID:     uuid.PgtypeToString(character.ID),
+			UserID:          uuid.PgtypeToString(character.UserID),
+			AchievementID:   definition.ID,
+			AchievementName: definition.Name,
+		})
+		unlocked = append(unlocked, definition.ID)
+	}
+	return unlocked, nil
+}
+
+func (f *fakeDB) Claim(ctx context.Context, character db.Character, achievementID string, grants []inventory.Grant, now pgtype.Timestamp) error {
+	row, ok := f.achievements[achievementID]
+	if !ok {
+		return pgx.ErrNoRows
+	}
+	if row.ClaimedAt.Valid {
+		return ErrAlreadyClaimed
+	}
+	var total int32
+	for _, grant := range grants {
+		total += grant.Quantity
+	}
+	if total > f.capacity {
+		return inventory.ErrInventoryFull
+	}
+	for _, grant := range grants {
+		f.inventory[grant.ItemID] += grant.Quantity
+		f.capacity -= grant.Quantity
+	}
+	row.ClaimedAt = now
+	f.achievements[achievementID] = row
+	return nil
+}
+
+const (
+	characterID = "00000000-0000-0000-0000-0000000000c1"
+	userID      = "00000000-0000-0000-0000-0000000000a1"
+	otherUserID = "00000000-0000-0000-0000-0000000000a2"
+	worldID     = "00000000-0000-0000-0000-0000000000f1"
+)
+
+var testConfig = Config{
+	Definitions: []Definition{
+		{ID: "first_harvest", Name: "First Harvest", Counter: events.ResourceHarvested, Threshold: 1, Rewards: []ItemAmount{{"Twigs", 5}}},
+		{ID: "gatherer", Name: "Gatherer", Counter: events.ResourceHarvested, Threshold: 3, Rewards: []ItemAmount{{"Stone", 20}}},
+		{ID: "artisan", Name: "Artisan", Counter: events.ItemCrafted, Threshold: 2},
+	},
+}
+
+func newTestService(t *testing.T) (*Service, *fakeDB, *events.Bus) {
+	character, err := uuid.StringToPgtype(characterID)
+	require.NoError(t, err)
+	owner, err := uuid.StringToPgtype(userID)
+	require.NoError(t, err)
+	world, err := uuid.StringToPgtype(worldID)
+	require.NoError(t, err)
+	database := &fakeDB{
+		Characters:   testmocks.NewCharacters(db.Character{ID: character, UserID: owner, WorldID: world, Name: "Ada"}),
+		items:        map[string]db.Item{"Twigs": {ID: 1, Name: "Twigs", StackSize: 64}, "Stone": {ID: 2, Name: "Stone", StackSize: 64}},
+		counters:     make(map[string]int64),
+		achievements: make(map[string]db.CharacterAchievement),
+		inventory:    make(map[int32]int32),
+		capacity:     100,
+	}
+	service := NewService(database, testmocks.NopLogger[LoggerInterface]{})
+	service.SetConfig(testConfig)
+	service.SetClock(clock.NewFake(time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)))
+	bus := events.NewBus()
+	service.Subscribe(bus)
+	return service, database, bus
+}
+
+func publish(t *testing.T, bus *events.Bus, eventType, key string, payload any) {
+	t.Helper()
+	data, err := json.Marshal(payload)
+	require.NoError(t, err)
+	event := events.Event{Type: eventType, DedupKey: key, Payload: data, OccurredAt: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)}
+	require.NoError(t, bus.Publish(context.Background(), event))
+}
+
+func TestCounting(t *testing.T) {
+	service, database, bus := newTestService(t)
+	ctx := session.WithWorldID(context.Background(), worldID)
+
+	publish(t, bus, events.ResourceHarvested, "h1", events.ResourceHarvestedPayload{HarvestID: "h1", CharacterID: characterID})
+	publish(t, bus, events.ResourceHarvested, "h1", events.ResourceHarvestedPayload{HarvestID: "h1", CharacterID: characterID}) // Redelivered
+	publish(t, bus, events.ResourceHarvested, "h2", events.ResourceHarvestedPayload{HarvestID: "h2", CharacterID: "00000000-0000-0000-0000-0000000000c9"})
+	publish(t, bus, events.ItemCrafted, "c1", events.ItemCraftedPayload{CraftID: "c1", CharacterID: characterID})
+	publish(t, bus, events.ChunkGenerated, "g1", events.ChunkGeneratedPayload{CharacterID: characterID}) // No definition counts chunks
+
+	assert.Equal(t, map[string]int64{events.ResourceHarvested: 1, events.ItemCrafted: 1}, database.counters)
+	require.Len(t, database.unlocked, 1, "the owner is notified once per unlock")
+	assert.Equal(t, events.AchievementUnlockedPayload{
+		CharacterID: characterID, UserID: userID, AchievementID: "first_harvest", AchievementName: "First Harvest",
+	}, database.unlocked[0])
+
+	achievements, err := service.List(ctx, userID, characterID)
+	require.NoError(t, err)
+	require.Len(t, achievements, 3)
+	assert.Equal(t, "first_harvest", achievements[0].Id)
+	assert.Equal(t, int64(1), achievements[0].Progress)
+	assert.NotNil(t, achievements[0].UnlockedAt)
+	assert.Nil(t, achievements[0].ClaimedAt)
+	assert.Equal(t, "Twigs", achievements[0].Rewards[0].ItemName)
+	assert.Equal(t, int64(1), achievements[1].Progress)
+	assert.Equal(t, int64(3), achievements[1].Threshold)
+	assert.Nil(t, achievements[1].UnlockedAt)
+	assert.Equal(t, int64(1), achievements[2].Progress)
+
+	_, err = service.List(ctx, otherUserID, characterID)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestClaim(t *testing.T) {
+	service, database, bus := newTestService(t)
+	ctx := session.WithWorldID(context.Background(), worldID)
+
+	_, err := service.Claim(ctx, userID, characterID, "first_harvest")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "locked achievements cannot be claimed")
+	_, err = service.Claim(ctx, userID, characterID, "unknown")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	for _, key := range []string{"h1", "h2", "h3"} {
+		publish(t, bus, events.ResourceHarvested, key, events.ResourceHarvestedPayload{HarvestID: key, CharacterID: characterID})
+	}
+	require.Len(t, database.unlocked, 2)
+
+	_, err = service.Claim(ctx, otherUserID, characterID, "first_harvest")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	items, err := service.Claim(ctx, userID, characterID, "first_harvest")
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, int32(5), items[0].Quantity)
+	assert.Equal(t, int32(5), database.inventory[1])
+	assert.Equal(t, time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC), database.achievements["first_harvest"].ClaimedAt.Time)
+
+	_, err = service.Claim(ctx, userID, characterID, "first_harvest")
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	database.capacity = 10
+	_, err = service.Claim(ctx, userID, characterID, "gatherer")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Zero(t, database.inventory[2], "nothing is granted unless it all fits")
+}
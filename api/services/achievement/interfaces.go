@@ -0,0 +1,178 @@
+package achievement
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/outbox"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/txn"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	"github.com/VoidMesh/api/api/services/cosmetic"
+	"github.com/VoidMesh/api/api/services/inventory"
+	"github.com/charmbracelet/log"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DatabaseInterface abstracts database operations for achievements.
+type DatabaseInterface interface {
+	GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error)
+	GetItemByName(ctx context.Context, name string) (db.Item, error)
+	ListAchievementCounters(ctx context.Context, characterID pgtype.UUID) ([]db.AchievementCounter, error)
+	ListCharacterAchievements(ctx context.Context, characterID pgtype.UUID) ([]db.CharacterAchievement, error)
+	Count(ctx context.Context, character db.Character, counter string, definitions []Definition, now pgtype.Timestamp) ([]string, error)
+	Claim(ctx context.Context, character db.Character, achievementID string, grants []inventory.Grant, now pgtype.Timestamp) error
+}
+
+// DatabaseWrapper implements DatabaseInterface using the actual database connection.
+type DatabaseWrapper struct {
+	pool    storage.Pool
+	queries *db.Queries
+}
+
+// NewDatabaseWrapper creates a new database wrapper with the given connection pool.
+func NewDatabaseWrapper(pool storage.Pool) DatabaseInterface {
+	return &DatabaseWrapper{
+		pool:    pool,
+		queries: db.New(pool),
+	}
+}
+
+func (d *DatabaseWrapper) GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error) {
+	return d.queries.GetCharacterById(ctx, id)
+}
+
+func (d *DatabaseWrapper) GetItemByName(ctx context.Context, name string) (db.Item, error) {
+	return d.queries.GetItemByName(ctx, name)
+}
+
+func (d *DatabaseWrapper) ListAchievementCounters(ctx context.Context, characterID pgtype.UUID) ([]db.AchievementCounter, error) {
+	return d.queries.ListAchievementCounters(ctx, characterID)
+}
+
+func (d *DatabaseWrapper) ListCharacterAchievements(ctx context.Context, characterID pgtype.UUID) ([]db.CharacterAchievement, error) {
+	return d.queries.ListCharacterAchievements(ctx, characterID)
+}
+
+// Count increments the character's counter, unlocks the definitions whose threshold it has
+// reached with their cosmetics and enqueues an AchievementUnlocked event for each in one
+// transaction. It returns
+// the IDs of the achievements it unlocked.
+func (d *DatabaseWrapper) Count(ctx context.Context, character db.Character, counter string, definitions []Definition, now pgtype.Timestamp) ([]string, error) {
+	var unlocked []string
+	err := txn.Run(ctx, d.pool, txn.ReadCommitted, func(q *db.Queries) error {
+		unlocked = nil
+		value, err := q.IncrementAchievementCounter(ctx, db.IncrementAchievementCounterParams{
+			CharacterID: character.ID,
+			Counter:     counter,
+			UpdatedAt:   now,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to increment counter: %w", err)
+		}
+
+		characterID := uuid.PgtypeToString(character.ID)
+		for _, definition := range definitions {
+			if value < definition.Threshold {
+				continue
+			}
+			// Thresholds passed before a definition was added unlock on the next event
+			added, err := q.UnlockAchievement(ctx, db.UnlockAchievementParams{
+				CharacterID:   character.ID,
+				AchievementID: definition.ID,
+				UnlockedAt:    now,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to unlock %s: %w", definition.ID, err)
+			}
+			if added == 0 {
+				continue
+			}
+			if definition.Cosmetic != "" {
+				if err := cosmetic.UnlockInTx(ctx, q, character.ID, definition.Cosmetic, now); err != nil {
+					return err
+				}
+			}
+			err = outbox.Enqueue(ctx, q, events.AchievementUnlocked, characterID,
+				fmt.Sprintf("%s:%s:%s", events.AchievementUnlocked, uuid.Normalize(characterID), definition.ID),
+				events.AchievementUnlockedPayload{
+					CharacterID:     characterID,
+					UserID:          uuid.PgtypeToString(character.UserID),
+					AchievementID:   definition.ID,
+					AchievementName: definition.Name,
+				})
+			if err != nil {
+				return err
+			}
+			unlocked = append(unlocked, definition.ID)
+		}
+		return nil
+	})
+	return unlocked, err
+}
+
+// Claim grants an unlocked achievement's reward and marks it claimed in one serializable
+// transaction. Nothing is granted unless everything fits.
+func (d *DatabaseWrapper) Claim(ctx context.Context, character db.Character, achievementID string, grants []inventory.Grant, now pgtype.Timestamp) error {
+	return txn.Run(ctx, d.pool, txn.Serializable, func(q *db.Queries) error {
+		row, err := q.GetCharacterAchievementForUpdate(ctx, db.GetCharacterAchievementForUpdateParams{
+			CharacterID:   character.ID,
+			AchievementID: achievementID,
+		})
+		if err != nil {
+			return err
+		}
+		if row.ClaimedAt.Valid {
+			return ErrAlreadyClaimed
+		}
+		if _, err := inventory.GrantAllInTx(ctx, q, character, grants); err != nil {
+			return err
+		}
+		return q.ClaimAchievement(ctx, db.ClaimAchievementParams{
+			CharacterID:   character.ID,
+			AchievementID: achievementID,
+			ClaimedAt:     now,
+		})
+	})
+}
+
+// LoggerInterface abstracts logging operations for dependency injection.
+type LoggerInterface interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+	With(keysAndValues ...interface{}) LoggerInterface
+}
+
+// DefaultLoggerWrapper wraps the internal logging package.
+type DefaultLoggerWrapper struct {
+	logger *log.Logger
+}
+
+func NewDefaultLoggerWrapper() LoggerInterface {
+	return &DefaultLoggerWrapper{logger: logging.GetLogger()}
+}
+
+func (l *DefaultLoggerWrapper) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.Debug(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Info(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.Warn(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.Error(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) With(keysAndValues ...interface{}) LoggerInterface {
+	return &DefaultLoggerWrapper{logger: l.logger.With(keysAndValues...)}
+}
@@ -0,0 +1,311 @@
+// Package action_queue queues character actions and processes them on a fixed
+// server tick, one action per character per tick, in enqueue order.
+package action_queue
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/debugstats"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
+	characterActionsV1 "github.com/VoidMesh/api/api/proto/character_actions/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// DefaultTickRate is the number of ticks per second when none is configured
+	DefaultTickRate = 20
+	// MaxQueuedActions caps how many actions a single character may have pending
+	MaxQueuedActions = 32
+	// resultBufferSize is how many undelivered results a subscriber may lag behind before results are dropped
+	resultBufferSize = 64
+)
+
+type queuedAction struct {
+	id          uint64
+	userID      string
+	worldID     string // session world at enqueue time, re-applied when the action runs
+	characterID string
+	request     *characterActionsV1.EnqueueActionRequest
+}
+
+// Service owns the per-character action queues and the tick loop that drains them.
+type Service struct {
+	characterService CharacterServiceInterface
+	harvestService   HarvestServiceInterface
+	logger           LoggerInterface
+	clock            clock.Clock
+	interval         time.Duration
+
+	mu          sync.Mutex
+	nextID      uint64
+	tick        uint64
+	pending     map[string][]*queuedAction
+	subscribers map[string]map[chan *characterActionsV1.ActionResult]struct{}
+}
+
+// NewService creates an action queue ticking tickRate times per second.
+func NewService(
+	characterService CharacterServiceInterface,
+	harvestService HarvestServiceInterface,
+	tickRate int,
+	logger LoggerInterface,
+) *Service {
+	if tickRate <= 0 {
+		tickRate = DefaultTickRate
+	}
+	componentLogger := logger.With("component", "action-queue-service")
+	componentLogger.Debug("Creating new action queue service", "tick_rate", tickRate)
+
+	s := &Service{
+		characterService: characterService,
+		harvestService:   harvestService,
+		logger:           componentLogger,
+		clock:            clock.New(),
+		interval:         time.Second / time.Duration(tickRate),
+		pending:          make(map[string][]*queuedAction),
+		subscribers:      make(map[string]map[chan *characterActionsV1.ActionResult]struct{}),
+	}
+
+	debugstats.Register(debugstats.Queues, "action_queue.pending_actions", s.pendingCount)
+	debugstats.Register(debugstats.StreamSubscriptions, "action_queue.result_streams", s.subscriberCount)
+	return s
+}
+
+// SetClock replaces the clock driving the tick loop (for simulation tests)
+func (s *Service) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// TickInterval returns the time between ticks
+func (s *Service) TickInterval() time.Duration {
+	return s.interval
+}
+
+// Enqueue validates and queues an action for the given character
+func (s *Service) Enqueue(ctx context.Context, userID string, req *characterActionsV1.EnqueueActionRequest) (*characterActionsV1.EnqueueActionResponse, error) {
+	if req.Action == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "action is required")
+	}
+	if err := s.authorize(ctx, userID, req.CharacterId); err != nil {
+		return nil, err
+	}
+
+	worldID, _ := session.WorldIDFromContext(ctx)
+	key := uuid.Normalize(req.CharacterId)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.pending[key]) >= MaxQueuedActions {
+		return nil, status.Errorf(codes.ResourceExhausted, "action queue is full (max %d)", MaxQueuedActions)
+	}
+
+	s.nextID++
+	s.pending[key] = append(s.pending[key], &queuedAction{
+		id:          s.nextID,
+		userID:      userID,
+		worldID:     worldID,
+		characterID: req.CharacterId,
+		request:     req,
+	})
+
+	return &characterActionsV1.EnqueueActionResponse{
+		ActionId:      s.nextID,
+		QueuePosition: int32(len(s.pending[key])),
+	}, nil
+}
+
+// Subscribe returns a channel receiving results for the character's actions and a
+// function that ends the subscription. Results are dropped for subscribers that fall
+// more than resultBufferSize results behind.
+func (s *Service) Subscribe(ctx context.Context, userID, characterID string) (<-chan *characterActionsV1.ActionResult, func(), error) {
+	if err := s.authorize(ctx, userID, characterID); err != nil {
+		return nil, nil, err
+	}
+
+	key := uuid.Normalize(characterID)
+	ch := make(chan *characterActionsV1.ActionResult, resultBufferSize)
+
+	s.mu.Lock()
+	if s.subscribers[key] == nil {
+		s.subscribers[key] = make(map[chan *characterActionsV1.ActionResult]struct{})
+	}
+	s.subscribers[key][ch] = struct{}{}
+	s.mu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			delete(s.subscribers[key], ch)
+			if len(s.subscribers[key]) == 0 {
+				delete(s.subscribers, key)
+			}
+			close(ch)
+		})
+	}
+	return ch, unsubscribe, nil
+}
+
+// Run ticks at the configured rate until ctx is cancelled. Ticks are stamped with
+// their scheduled time rather than the wall clock so cooldowns measured between
+// ticks are exact multiples of the tick interval.
+func (s *Service) Run(ctx context.Context) {
+	s.logger.Info("Action queue tick loop started", "interval", s.interval)
+	next := s.clock.Now()
+
+	for {
+		next = next.Add(s.interval)
+		select {
+		case <-ctx.Done():
+			s.logger.Info("Action queue tick loop stopped")
+			return
+		case <-s.clock.After(next.Sub(s.clock.Now())):
+		}
+
+		s.Tick(ctx, next)
+
+		// Skip missed ticks instead of bursting through them after a stall
+		if behind := s.clock.Since(next); behind > s.interval {
+			s.logger.Warn("Action queue tick loop fell behind", "behind", behind)
+			next = s.clock.Now()
+		}
+	}
+}
+
+// Tick processes the head action of every character queue as of time at and
+// returns how many actions ran. Actions run in the order they were enqueued.
+func (s *Service) Tick(ctx context.Context, at time.Time) int {
+	s.mu.Lock()
+	s.tick++
+	tick := s.tick
+	heads := make([]*queuedAction, 0, len(s.pending))
+	for key, queue := range s.pending {
+		heads = append(heads, queue[0])
+		if len(queue) == 1 {
+			delete(s.pending, key)
+		} else {
+			s.pending[key] = queue[1:]
+		}
+	}
+	s.mu.Unlock()
+
+	sort.Slice(heads, func(i, j int) bool { return heads[i].id < heads[j].id })
+
+	for _, action := range heads {
+		result := s.process(ctx, action, at)
+		result.Tick = tick
+		s.publish(uuid.Normalize(action.characterID), result)
+	}
+	return len(heads)
+}
+
+func (s *Service) process(ctx context.Context, action *queuedAction, at time.Time) *characterActionsV1.ActionResult {
+	result := &characterActionsV1.ActionResult{
+		ActionId:    action.id,
+		CharacterId: action.characterID,
+		ProcessedAt: timestamppb.New(at),
+	}
+	if action.worldID != "" {
+		ctx = session.WithWorldID(ctx, action.worldID)
+	}
+
+	switch a := action.request.Action.(type) {
+	case *characterActionsV1.EnqueueActionRequest_Move:
+		resp, err := s.characterService.MoveCharacterAt(ctx, &characterV1.MoveCharacterRequest{
+			CharacterId: action.characterID,
+			NewX:        a.Move.NewX,
+			NewY:        a.Move.NewY,
+			Facing:      a.Move.Facing,
+			ActionState: a.Move.ActionState,
+		}, at)
+		if err != nil {
+			result.ErrorMessage = status.Convert(err).Message()
+			break
+		}
+		result.Success = resp.Success
+		result.ErrorMessage = resp.ErrorMessage
+		result.Character = resp.Character
+
+	case *characterActionsV1.EnqueueActionRequest_Harvest:
+		harvestResults, updatedItem, err := s.harvestService.HarvestResource(ctx, action.userID, action.characterID, a.Harvest.ResourceNodeId)
+		if err != nil {
+			result.ErrorMessage = status.Convert(err).Message()
+			break
+		}
+		result.Success = true
+		result.HarvestResults = harvestResults
+		result.UpdatedItem = updatedItem
+
+	case *characterActionsV1.EnqueueActionRequest_Attack:
+		result.ErrorMessage = "attack actions are not supported yet"
+
+	default:
+		result.ErrorMessage = "unknown action type"
+	}
+
+	if !result.Success {
+		s.logger.Debug("Queued action failed", "action_id", action.id, "character_id", action.characterID, "reason", result.ErrorMessage)
+	}
+	return result
+}
+
+func (s *Service) publish(key string, result *characterActionsV1.ActionResult) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for ch := range s.subscribers[key] {
+		select {
+		case ch <- result:
+		default:
+			s.logger.Warn("Dropping action result for slow subscriber", "character_id", result.CharacterId, "action_id", result.ActionId)
+		}
+	}
+}
+
+// authorize checks the character exists, belongs to the user and is in the session's world
+func (s *Service) authorize(ctx context.Context, userID, characterID string) error {
+	if !uuid.ValidateFormat(characterID) {
+		return status.Errorf(codes.InvalidArgument, "invalid character ID format")
+	}
+
+	character, err := s.characterService.GetCharacterByID(ctx, characterID)
+	if err != nil {
+		return status.Errorf(codes.NotFound, "character not found")
+	}
+	if !uuid.Compare(uuid.PgtypeToString(character.UserID), userID) {
+		return status.Errorf(codes.PermissionDenied, "character not owned by user")
+	}
+	return session.RequireWorld(ctx, character.WorldID)
+}
+
+func (s *Service) pendingCount() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var count int64
+	for _, queue := range s.pending {
+		count += int64(len(queue))
+	}
+	return count
+}
+
+func (s *Service) subscriberCount() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var count int64
+	for _, subscribers := range s.subscribers {
+		count += int64(len(subscribers))
+	}
+	return count
+}
@@ -0,0 +1,322 @@
+package action_queue
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
+	characterActionsV1 "github.com/VoidMesh/api/api/proto/character_actions/v1"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	inventoryV1 "github.com/VoidMesh/api/api/proto/inventory/v1"
+	"github.com/VoidMesh/api/api/services/character"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	ownerID     = "11111111-1111-1111-1111-111111111111"
+	otherUserID = "22222222-2222-2222-2222-222222222222"
+	characterA  = "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
+	characterB  = "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"
+	worldID     = "ffffffff-ffff-ffff-ffff-ffffffffffff"
+)
+
+// fakeCharacters records moves in call order and enforces a movement cooldown against the supplied time
+type fakeCharacters struct {
+	mu       sync.Mutex
+	cooldown time.Duration
+	lastMove map[string]time.Time
+	calls    []string
+}
+
+func newFakeCharacters() *fakeCharacters {
+	return &fakeCharacters{lastMove: make(map[string]time.Time)}
+}
+
+func (f *fakeCharacters) GetCharacterByID(ctx context.Context, characterID string) (*db.Character, error) {
+	userID, _ := uuid.StringToPgtype(ownerID)
+	world, _ := uuid.StringToPgtype(worldID)
+	id, err := uuid.StringToPgtype(characterID)
+	if err != nil {
+		return nil, err
+	}
+	return &db.Character{ID: id, UserID: userID, WorldID: world}, nil
+}
+
+func (f *fakeCharacters) MoveCharacterAt(ctx context.Context, req *characterV1.MoveCharacterRequest, at time.Time) (*characterV1.MoveCharacterResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.calls = append(f.calls, fmt.Sprintf("move %s %d,%d", req.CharacterId, req.NewX, req.NewY))
+	if last, ok := f.lastMove[req.CharacterId]; ok && at.Sub(last) < f.cooldown {
+		return &characterV1.MoveCharacterResponse{Success: false, ErrorMessage: "movement too fast"}, nil
+	}
+	f.lastMove[req.CharacterId] = at
+	return &characterV1.MoveCharacterResponse{
+		Success:   true,
+		Character: &characterV1.Character{Id: req.CharacterId, X: req.NewX, Y: req.NewY},
+	}, nil
+}
+
+type fakeHarvester struct {
+	err error
+}
+
+func (f *fakeHarvester) HarvestResource(ctx context.Context, userID, characterID string, resourceNodeID int32) ([]*characterActionsV1.HarvestResult, *inventoryV1.InventoryItem, error) {
+	if f.err != nil {
+		return nil, nil, f.err
+	}
+	return []*characterActionsV1.HarvestResult{{ItemName: "Wood", Quantity: 1}}, nil, nil
+}
+
+func moveRequest(characterID string, x, y int32) *characterActionsV1.EnqueueActionRequest {
+	return &characterActionsV1.EnqueueActionRequest{
+		CharacterId: characterID,
+		Action: &characterActionsV1.EnqueueActionRequest_Move{
+			Move: &characterActionsV1.MoveAction{NewX: x, NewY: y},
+		},
+	}
+}
+
+func TestEnqueue_Validation(t *testing.T) {
+	s := NewService(newFakeCharacters(), &fakeHarvester{}, DefaultTickRate, testmocks.NopLogger[LoggerInterface]{})
+	ctx := session.WithWorldID(context.Background(), worldID)
+
+	_, err := s.Enqueue(ctx, ownerID, &characterActionsV1.EnqueueActionRequest{CharacterId: characterA})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "missing action")
+
+	_, err = s.Enqueue(ctx, ownerID, moveRequest("not-a-uuid", 1, 1))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.Enqueue(ctx, otherUserID, moveRequest(characterA, 1, 1))
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, _, err = s.Subscribe(ctx, otherUserID, characterA)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestEnqueue_QueueFull(t *testing.T) {
+	s := NewService(newFakeCharacters(), &fakeHarvester{}, DefaultTickRate, testmocks.NopLogger[LoggerInterface]{})
+	ctx := session.WithWorldID(context.Background(), worldID)
+
+	for i := 0; i < MaxQueuedActions; i++ {
+		resp, err := s.Enqueue(ctx, ownerID, moveRequest(characterA, int32(i), 0))
+		require.NoError(t, err)
+		assert.Equal(t, int32(i+1), resp.QueuePosition)
+	}
+
+	_, err := s.Enqueue(ctx, ownerID, moveRequest(characterA, 0, 0))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Other characters have their own queue
+	_, err = s.Enqueue(ctx, ownerID, moveRequest(characterB, 0, 0))
+	assert.NoError(t, err)
+}
+
+func TestTick_ProcessesOneActionPerCharacterInEnqueueOrder(t *testing.T) {
+	characters := newFakeCharacters()
+	s := NewService(characters, &fakeHarvester{}, DefaultTickRate, testmocks.NopLogger[LoggerInterface]{})
+	ctx := session.WithWorldID(context.Background(), worldID)
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, req := range []*characterActionsV1.EnqueueActionRequest{
+		moveRequest(characterB, 1, 0),
+		moveRequest(characterA, 1, 0),
+		moveRequest(characterB, 2, 0),
+	} {
+		_, err := s.Enqueue(ctx, ownerID, req)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, 2, s.Tick(ctx, now))
+	assert.Equal(t, []string{"move " + characterB + " 1,0", "move " + characterA + " 1,0"}, characters.calls)
+	assert.Equal(t, int64(1), s.pendingCount())
+
+	assert.Equal(t, 1, s.Tick(ctx, now.Add(s.TickInterval())))
+	assert.Equal(t, "move "+characterB+" 2,0", characters.calls[2])
+
+	assert.Equal(t, 0, s.Tick(ctx, now.Add(2*s.TickInterval())))
+}
+
+func TestTick_PublishesResults(t *testing.T) {
+	s := NewService(newFakeCharacters(), &fakeHarvester{err: status.Error(codes.FailedPrecondition, "too far away")}, DefaultTickRate, testmocks.NopLogger[LoggerInterface]{})
+	ctx := session.WithWorldID(context.Background(), worldID)
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	results, unsubscribe, err := s.Subscribe(ctx, ownerID, characterA)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), s.subscriberCount())
+
+	move, err := s.Enqueue(ctx, ownerID, moveRequest(characterA, 3, 4))
+	require.NoError(t, err)
+	harvest, err := s.Enqueue(ctx, ownerID, &characterActionsV1.EnqueueActionRequest{
+		CharacterId: characterA,
+		Action: &characterActionsV1.EnqueueActionRequest_Harvest{
+			Harvest: &characterActionsV1.HarvestAction{ResourceNodeId: 7},
+		},
+	})
+	require.NoError(t, err)
+	attack, err := s.Enqueue(ctx, ownerID, &characterActionsV1.EnqueueActionRequest{
+		CharacterId: characterA,
+		Action: &characterActionsV1.EnqueueActionRequest_Attack{
+			Attack: &characterActionsV1.AttackAction{TargetCharacterId: characterB},
+		},
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		s.Tick(ctx, now.Add(time.Duration(i)*s.TickInterval()))
+	}
+
+	result := <-results
+	assert.Equal(t, move.ActionId, result.ActionId)
+	assert.True(t, result.Success)
+	assert.Equal(t, int32(3), result.Character.X)
+	assert.Equal(t, uint64(1), result.Tick)
+	assert.Equal(t, now, result.ProcessedAt.AsTime())
+
+	result = <-results
+	assert.Equal(t, harvest.ActionId, result.ActionId)
+	assert.False(t, result.Success)
+	assert.Equal(t, "too far away", result.ErrorMessage)
+
+	result = <-results
+	assert.Equal(t, attack.ActionId, result.ActionId)
+	assert.False(t, result.Success)
+
+	unsubscribe()
+	unsubscribe()
+	assert.Equal(t, int64(0), s.subscriberCount())
+	_, open := <-results
+	assert.False(t, open, "unsubscribe closes the channel")
+}
+
+func TestRun_UsesScheduledTickTimes(t *testing.T) {
+	characters := newFakeCharacters()
+	characters.cooldown = 100 * time.Millisecond
+	s := NewService(characters, &fakeHarvester{}, 10, testmocks.NopLogger[LoggerInterface]{})
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	fake := clock.NewFake(start)
+	s.SetClock(fake)
+
+	ctx, cancel := context.WithCancel(session.WithWorldID(context.Background(), worldID))
+	defer cancel()
+
+	results, unsubscribe, err := s.Subscribe(ctx, ownerID, characterA)
+	require.NoError(t, err)
+	defer unsubscribe()
+
+	_, err = s.Enqueue(ctx, ownerID, moveRequest(characterA, 1, 0))
+	require.NoError(t, err)
+	_, err = s.Enqueue(ctx, ownerID, moveRequest(characterA, 2, 0))
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	go func() {
+		s.Run(ctx)
+		close(done)
+	}()
+
+	// Moves one tick apart are exactly one cooldown apart, so both succeed
+	for i := 1; i <= 2; i++ {
+		require.Eventually(t, func() bool { return fake.Waiters() == 1 }, time.Second, time.Millisecond)
+		fake.Advance(s.TickInterval())
+
+		result := <-results
+		assert.True(t, result.Success, result.ErrorMessage)
+		assert.Equal(t, start.Add(time.Duration(i)*s.TickInterval()), result.ProcessedAt.AsTime())
+	}
+
+	cancel()
+	<-done
+}
+
+// lockedCharacterDB serializes the character service's mock database, which is not safe
+// for concurrent use on its own
+type lockedCharacterDB struct {
+	mu sync.Mutex
+	*character.MockDatabaseInterface
+}
+
+func (l *lockedCharacterDB) GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.MockDatabaseInterface.GetCharacterById(ctx, id)
+}
+
+func (l *lockedCharacterDB) UpdateCharacterPosition(ctx context.Context, arg db.UpdateCharacterPositionParams) (db.Character, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.MockDatabaseInterface.UpdateCharacterPosition(ctx, arg)
+}
+
+// openGround is a chunk service where every cell can be walked on
+type openGround struct{}
+
+func (openGround) GetOrCreateChunk(ctx context.Context, chunkX, chunkY int32) (*chunkV1.ChunkData, error) {
+	return &chunkV1.ChunkData{ChunkX: chunkX, ChunkY: chunkY}, nil
+}
+
+func (openGround) GetOrCreateChunkInWorld(ctx context.Context, worldID pgtype.UUID, chunkX, chunkY int32) (*chunkV1.ChunkData, error) {
+	return &chunkV1.ChunkData{ChunkX: chunkX, ChunkY: chunkY}, nil
+}
+
+func (openGround) IsPassable(ctx context.Context, worldID pgtype.UUID, x, y int32) (bool, error) {
+	return true, nil
+}
+
+// Queued moves run on the tick goroutine while MoveCharacter RPCs run on request
+// goroutines, and both record movement cooldowns on the same character service. Run
+// with -race.
+func TestTick_MovesConcurrentlyWithRPCs(t *testing.T) {
+	userID, _ := uuid.StringToPgtype(ownerID)
+	queuedID, _ := uuid.StringToPgtype(characterA)
+	directID, _ := uuid.StringToPgtype(characterB)
+	world, _ := uuid.StringToPgtype(worldID)
+	database := &lockedCharacterDB{MockDatabaseInterface: character.NewMockDatabase()}
+	database.AddCharacter(db.Character{ID: queuedID, UserID: userID, WorldID: world, Name: "Queued", X: 0, Y: 1})
+	database.AddCharacter(db.Character{ID: directID, UserID: userID, WorldID: world, Name: "Direct", X: 0, Y: 3})
+	characters := character.NewService(database, openGround{})
+	s := NewService(characters, &fakeHarvester{}, DefaultTickRate, testmocks.NopLogger[LoggerInterface]{})
+	ctx := session.WithWorldID(context.Background(), worldID)
+
+	const steps = 10
+	for i := int32(1); i <= steps; i++ {
+		_, err := s.Enqueue(ctx, ownerID, moveRequest(characterA, i, 1))
+		require.NoError(t, err)
+	}
+
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 1; i <= steps; i++ {
+			s.Tick(ctx, start.Add(time.Duration(i)*character.MovementCooldown))
+		}
+	}()
+	for i := int32(1); i <= steps; i++ {
+		resp, err := characters.MoveCharacterAt(ctx, &characterV1.MoveCharacterRequest{CharacterId: characterB, NewX: i, NewY: 3}, start.Add(time.Duration(i)*character.MovementCooldown))
+		require.NoError(t, err)
+		assert.True(t, resp.Success, resp.ErrorMessage)
+	}
+	wg.Wait()
+
+	queued, err := database.GetCharacterById(ctx, queuedID)
+	require.NoError(t, err)
+	assert.Equal(t, int32(steps), queued.X, "every queued move ran")
+	direct, err := database.GetCharacterById(ctx, directID)
+	require.NoError(t, err)
+	assert.Equal(t, int32(steps), direct.X)
+}
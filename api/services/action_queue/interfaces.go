@@ -0,0 +1,70 @@
+package action_queue
+
+import (
+	"context"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/logging"
+	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
+	characterActionsV1 "github.com/VoidMesh/api/api/proto/character_actions/v1"
+	inventoryV1 "github.com/VoidMesh/api/api/proto/inventory/v1"
+	"github.com/VoidMesh/api/api/services/character"
+	"github.com/VoidMesh/api/api/services/character_actions"
+	"github.com/charmbracelet/log"
+)
+
+// CharacterServiceInterface defines the character operations the queue needs.
+type CharacterServiceInterface interface {
+	GetCharacterByID(ctx context.Context, characterID string) (*db.Character, error)
+	MoveCharacterAt(ctx context.Context, req *characterV1.MoveCharacterRequest, at time.Time) (*characterV1.MoveCharacterResponse, error)
+}
+
+// HarvestServiceInterface defines the harvesting operation the queue needs.
+type HarvestServiceInterface interface {
+	HarvestResource(ctx context.Context, userID, characterID string, resourceNodeID int32) ([]*characterActionsV1.HarvestResult, *inventoryV1.InventoryItem, error)
+}
+
+// LoggerInterface abstracts logging operations for dependency injection.
+type LoggerInterface interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+	With(keysAndValues ...interface{}) LoggerInterface
+}
+
+// Compile-time checks that the concrete services satisfy the queue's interfaces
+var (
+	_ CharacterServiceInterface = (*character.Service)(nil)
+	_ HarvestServiceInterface   = (*character_actions.Service)(nil)
+)
+
+// DefaultLoggerWrapper wraps the internal logging package.
+type DefaultLoggerWrapper struct {
+	logger *log.Logger
+}
+
+func NewDefaultLoggerWrapper() LoggerInterface {
+	return &DefaultLoggerWrapper{logger: logging.GetLogger()}
+}
+
+func (l *DefaultLoggerWrapper) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.Debug(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Info(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.Warn(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.Error(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) With(keysAndValues ...interface{}) LoggerInterface {
+	return &DefaultLoggerWrapper{logger: l.logger.With(keysAndValues...)}
+}
@@ -0,0 +1,288 @@
+// Package activity maintains the character_activity read model: a per-character feed of
+// harvests, crafts, trades and deaths projected from domain events, so players can review
+// what happened while they were away. Entries are kept for Retention.
+package activity
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/alerting"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	DefaultListLimit = 50
+	MaxListLimit     = 200
+
+	// Retention is how long entries are kept
+	Retention = 30 * 24 * time.Hour
+	// PruneInterval is how often expired entries are deleted
+	PruneInterval = time.Hour
+)
+
+// kindNames maps activity types to their stored names
+var kindNames = map[characterV1.ActivityType]string{
+	characterV1.ActivityType_ACTIVITY_TYPE_HARVEST: "harvest",
+	characterV1.ActivityType_ACTIVITY_TYPE_CRAFT:   "craft",
+	characterV1.ActivityType_ACTIVITY_TYPE_TRADE:   "trade",
+	characterV1.ActivityType_ACTIVITY_TYPE_DEATH:   "death",
+}
+
+// Service projects events into the activity feed and serves it.
+type Service struct {
+	db     DatabaseInterface
+	logger LoggerInterface
+	clock  clock.Clock
+}
+
+// NewService creates a new activity service with dependency injection.
+func NewService(db DatabaseInterface, logger LoggerInterface) *Service {
+	componentLogger := logger.With("component", "activity-service")
+	componentLogger.Debug("Creating new activity service")
+	return &Service{
+		db:     db,
+		logger: componentLogger,
+		clock:  clock.New(),
+	}
+}
+
+// NewServiceWithPool creates a service with concrete implementations (convenience constructor for production use).
+func NewServiceWithPool(pool storage.Pool) *Service {
+	return NewService(NewDatabaseWrapper(pool), NewDefaultLoggerWrapper())
+}
+
+// SetClock replaces the clock used for pruning (for simulation tests)
+func (s *Service) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// Subscribe records activity from the events that describe it
+func (s *Service) Subscribe(bus *events.Bus) {
+	bus.Subscribe(events.ResourceHarvested, events.Dedup(s.handleResourceHarvested, events.DefaultDedupCapacity))
+	bus.Subscribe(events.ItemCrafted, events.Dedup(s.handleItemCrafted, events.DefaultDedupCapacity))
+	bus.Subscribe(events.TradeCompleted, events.Dedup(s.handleTradeCompleted, events.DefaultDedupCapacity))
+	bus.Subscribe(events.CharacterDied, events.Dedup(s.handleCharacterDied, events.DefaultDedupCapacity))
+}
+
+func (s *Service) handleResourceHarvested(ctx context.Context, event events.Event) error {
+	var payload events.ResourceHarvestedPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("invalid %s payload: %w", event.Type, err)
+	}
+	return s.recordForCharacter(ctx, event, payload.CharacterID, characterV1.ActivityType_ACTIVITY_TYPE_HARVEST, map[string]string{
+		"harvest_id":            payload.HarvestID,
+		"resource_node_type_id": strconv.Itoa(int(payload.ResourceNodeTypeID)),
+		"drops":                 strconv.Itoa(payload.Drops),
+		"chunk_x":               strconv.Itoa(int(payload.ChunkX)),
+		"chunk_y":               strconv.Itoa(int(payload.ChunkY)),
+	})
+}
+
+func (s *Service) handleItemCrafted(ctx context.Context, event events.Event) error {
+	var payload events.ItemCraftedPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("invalid %s payload: %w", event.Type, err)
+	}
+	return s.recordForCharacter(ctx, event, payload.CharacterID, characterV1.ActivityType_ACTIVITY_TYPE_CRAFT, map[string]string{
+		"craft_id": payload.CraftID,
+		"item_id":  strconv.Itoa(int(payload.ItemID)),
+		"quantity": strconv.Itoa(int(payload.Quantity)),
+	})
+}
+
+// handleTradeCompleted records the trade once per participant; trades are between users,
+// so the entries belong to none of their characters in particular
+func (s *Service) handleTradeCompleted(ctx context.Context, event events.Event) error {
+	var payload events.TradeCompletedPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("invalid %s payload: %w", event.Type, err)
+	}
+	var errs []error
+	for _, userID := range payload.UserIDs {
+		id, err := uuid.StringToPgtype(userID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid user ID %q: %w", userID, err))
+			continue
+		}
+		err = s.record(ctx, id, pgtype.UUID{}, characterV1.ActivityType_ACTIVITY_TYPE_TRADE,
+			map[string]string{"trade_id": payload.TradeID}, event.DedupKey+":"+userID, event.OccurredAt)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *Service) handleCharacterDied(ctx context.Context, event events.Event) error {
+	var payload events.CharacterDiedPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("invalid %s payload: %w", event.Type, err)
+	}
+	return s.recordForCharacter(ctx, event, payload.CharacterID, characterV1.ActivityType_ACTIVITY_TYPE_DEATH, map[string]string{
+		"death_id": payload.DeathID,
+		"cause":    payload.Cause,
+		"x":        strconv.Itoa(int(payload.X)),
+		"y":        strconv.Itoa(int(payload.Y)),
+	})
+}
+
+// recordForCharacter records activity of a character for its owner. Events about
+// characters that have since been deleted are dropped.
+func (s *Service) recordForCharacter(ctx context.Context, event events.Event, characterID string, kind characterV1.ActivityType, details map[string]string) error {
+	id, err := uuid.StringToPgtype(characterID)
+	if err != nil {
+		return fmt.Errorf("invalid character ID %q: %w", characterID, err)
+	}
+	character, err := s.db.GetCharacterById(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get character %s: %w", characterID, err)
+	}
+	return s.record(ctx, character.UserID, character.ID, kind, details, event.DedupKey, event.OccurredAt)
+}
+
+func (s *Service) record(ctx context.Context, userID, characterID pgtype.UUID, kind characterV1.ActivityType, details map[string]string, dedupKey string, occurredAt time.Time) error {
+	data, err := json.Marshal(details)
+	if err != nil {
+		return fmt.Errorf("failed to encode activity details: %w", err)
+	}
+	err = s.db.CreateCharacterActivity(ctx, db.CreateCharacterActivityParams{
+		UserID:      userID,
+		CharacterID: characterID,
+		Kind:        kindNames[kind],
+		Details:     data,
+		DedupKey:    dedupKey,
+		OccurredAt:  pgtype.Timestamp{Time: occurredAt, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record %s activity: %w", kindNames[kind], err)
+	}
+	return nil
+}
+
+// List returns the activity of one of the user's characters before beforeID (0 for the
+// newest), newest first
+func (s *Service) List(ctx context.Context, userID, characterID string, beforeID int64, limit int32) ([]*characterV1.ActivityEntry, error) {
+	id, err := uuid.StringToPgtype(characterID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid character ID format")
+	}
+	if beforeID < 0 || limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "before_id and limit must not be negative")
+	}
+	character, err := s.db.GetCharacterById(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "character not found")
+	}
+	if err != nil {
+		s.logger.Error("Failed to get character", "character_id", characterID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to get character")
+	}
+	if !uuid.Compare(uuid.PgtypeToString(character.UserID), userID) {
+		return nil, status.Errorf(codes.PermissionDenied, "character does not belong to user")
+	}
+	if err := session.RequireWorld(ctx, character.WorldID); err != nil {
+		return nil, err
+	}
+	if beforeID == 0 {
+		beforeID = math.MaxInt64
+	}
+	if limit == 0 {
+		limit = DefaultListLimit
+	}
+	if limit > MaxListLimit {
+		limit = MaxListLimit
+	}
+
+	rows, err := s.db.ListCharacterActivity(ctx, db.ListCharacterActivityParams{
+		CharacterID: character.ID,
+		UserID:      character.UserID,
+		BeforeID:    beforeID,
+		MaxEntries:  limit,
+	})
+	if err != nil {
+		s.logger.Error("Failed to list activity", "character_id", characterID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list activity")
+	}
+
+	entries := make([]*characterV1.ActivityEntry, 0, len(rows))
+	for _, row := range rows {
+		entry, err := dbActivityToProto(row)
+		if err != nil {
+			s.logger.Error("Failed to decode activity", "activity_id", row.ID, "error", err)
+			return nil, status.Errorf(codes.Internal, "failed to decode activity")
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// Prune deletes entries older than Retention
+func (s *Service) Prune(ctx context.Context) (int64, error) {
+	deleted, err := s.db.DeleteCharacterActivityBefore(ctx, pgtype.Timestamp{Time: s.clock.Now().Add(-Retention), Valid: true})
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune activity: %w", err)
+	}
+	return deleted, nil
+}
+
+// Run prunes old entries every PruneInterval until ctx is cancelled
+func (s *Service) Run(ctx context.Context) {
+	s.logger.Info("Activity pruning started", "interval", PruneInterval, "retention", Retention)
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("Activity pruning stopped")
+			return
+		case <-s.clock.After(PruneInterval):
+		}
+
+		pruned, err := s.Prune(ctx)
+		if err != nil {
+			s.logger.Error("Activity pruning failed", "error", err)
+			alerting.ReportJobError("activity_prune", err)
+			continue
+		}
+		if pruned > 0 {
+			s.logger.Debug("Activity pruned", "deleted", pruned)
+		}
+	}
+}
+
+func dbActivityToProto(row db.CharacterActivity) (*characterV1.ActivityEntry, error) {
+	var details map[string]string
+	if err := json.Unmarshal(row.Details, &details); err != nil {
+		return nil, fmt.Errorf("failed to decode activity details: %w", err)
+	}
+	entry := &characterV1.ActivityEntry{
+		Id:          row.ID,
+		CharacterId: uuid.PgtypeToString(row.CharacterID),
+		Details:     details,
+		OccurredAt:  timestamppb.New(row.OccurredAt.Time),
+	}
+	for kind, name := range kindNames {
+		if name == row.Kind {
+			entry.Type = kind
+		}
+	}
+	return entry, nil
+}
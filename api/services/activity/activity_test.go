@@ -0,0 +1,167 @@
+package activity
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeDB keeps characters and activity in memory
+type fakeDB struct {
+	testmocks.Characters
+	activity []db.CharacterActivity
+}
+
+func (f *fakeDB) CreateCharacterActivity(ctx context.Context, arg db.CreateCharacterActivityParams) error {
+	for _, row := range f.activity {
+		if row.DedupKey == arg.DedupKey {
+			return nil
+		}
+	}
+	f.activity = append(f.activity, db.CharacterActivity{
+		ID:          int64(len(f.activity) + 1),
+		UserID:      arg.UserID,
+		CharacterID: arg.CharacterID,
+		Kind:        arg.Kind,
+		Details:     arg.Details,
+		DedupKey:    arg.DedupKey,
+		OccurredAt:  arg.OccurredAt,
+	})
+	return nil
+}
+
+func (f *fakeDB) ListCharacterActivity(ctx context.Context, arg db.ListCharacterActivityParams) ([]db.CharacterActivity, error) {
+	var rows []db.CharacterActivity
+	for i := len(f.activity) - 1; i >= 0 && len(rows) < int(arg.MaxEntries); i-- {
+		row := f.activity[i]
+		mine := row.CharacterID == arg.CharacterID || (!row.CharacterID.Valid && row.UserID == arg.UserID)
+		if mine && row.ID < arg.BeforeID {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+func (f *fakeDB) DeleteCharacterActivityBefore(ctx context.Context, occurredAt pgtype.Timestamp) (int64, error) {
+	var kept []db.CharacterActivity
+	for _, row := range f.activity {
+		if !row.OccurredAt.Time.Before(occurredAt.Time) {
+			kept = append(kept, row)
+		}
+	}
+	deleted := int64(len(f.activity) - len(kept))
+	f.activity = kept
+	return deleted, nil
+}
+
+const (
+	userID      = "00000000-0000-0000-0000-000000000001"
+	otherUserID = "00000000-0000-0000-0000-000000000002"
+	characterID = "00000000-0000-0000-0000-0000000000c1"
+	otherID     = "00000000-0000-0000-0000-0000000000c2"
+	worldID     = "00000000-0000-0000-0000-0000000000f1"
+)
+
+func publish(t *testing.T, bus *events.Bus, eventType, key string, occurredAt time.Time, payload any) {
+	t.Helper()
+	data, err := json.Marshal(payload)
+	require.NoError(t, err)
+	require.NoError(t, bus.Publish(context.Background(), events.Event{Type: eventType, DedupKey: key, Payload: data, OccurredAt: occurredAt}))
+}
+
+func newTestService(t *testing.T) (*Service, *fakeDB, *events.Bus) {
+	user, err := uuid.StringToPgtype(userID)
+	require.NoError(t, err)
+	other, err := uuid.StringToPgtype(otherUserID)
+	require.NoError(t, err)
+	character, err := uuid.StringToPgtype(characterID)
+	require.NoError(t, err)
+	otherCharacter, err := uuid.StringToPgtype(otherID)
+	require.NoError(t, err)
+	world, err := uuid.StringToPgtype(worldID)
+	require.NoError(t, err)
+	database := &fakeDB{Characters: testmocks.NewCharacters(
+		db.Character{ID: character, UserID: user, WorldID: world},
+		db.Character{ID: otherCharacter, UserID: other, WorldID: world},
+	)}
+	service := NewService(database, testmocks.NopLogger[LoggerInterface]{})
+	bus := events.NewBus()
+	service.Subscribe(bus)
+	return service, database, bus
+}
+
+func TestService_ProjectsEvents(t *testing.T) {
+	service, database, bus := newTestService(t)
+	ctx := session.WithWorldID(context.Background(), worldID)
+	at := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	publish(t, bus, events.ResourceHarvested, "h1", at, events.ResourceHarvestedPayload{HarvestID: "h1", CharacterID: characterID, ResourceNodeTypeID: 3, Drops: 2})
+	publish(t, bus, events.ResourceHarvested, "h1", at, events.ResourceHarvestedPayload{HarvestID: "h1", CharacterID: characterID, ResourceNodeTypeID: 3, Drops: 2})
+	publish(t, bus, events.ResourceHarvested, "h2", at, events.ResourceHarvestedPayload{HarvestID: "h2", CharacterID: otherID})
+	publish(t, bus, events.ResourceHarvested, "h3", at, events.ResourceHarvestedPayload{HarvestID: "h3", CharacterID: "00000000-0000-0000-0000-0000000000c3"})
+	publish(t, bus, events.ItemCrafted, "c1", at.Add(time.Minute), events.ItemCraftedPayload{CraftID: "c1", CharacterID: characterID, ItemID: 7, Quantity: 1})
+	publish(t, bus, events.TradeCompleted, "t1", at.Add(2*time.Minute), events.TradeCompletedPayload{TradeID: "t1", UserIDs: []string{userID, otherUserID}})
+	publish(t, bus, events.CharacterDied, "d1", at.Add(3*time.Minute), events.CharacterDiedPayload{DeathID: "d1", CharacterID: characterID, UserID: userID, Cause: "fall", X: 4, Y: -2})
+	assert.Len(t, database.activity, 6, "redelivered events and deleted characters add nothing")
+
+	entries, err := service.List(ctx, userID, characterID, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, entries, 4)
+	assert.Equal(t, characterV1.ActivityType_ACTIVITY_TYPE_DEATH, entries[0].Type, "newest first")
+	assert.Equal(t, "fall", entries[0].Details["cause"])
+	assert.Equal(t, characterV1.ActivityType_ACTIVITY_TYPE_TRADE, entries[1].Type)
+	assert.Empty(t, entries[1].CharacterId, "trades belong to the account")
+	assert.Equal(t, characterV1.ActivityType_ACTIVITY_TYPE_CRAFT, entries[2].Type)
+	assert.Equal(t, characterV1.ActivityType_ACTIVITY_TYPE_HARVEST, entries[3].Type)
+	assert.Equal(t, "2", entries[3].Details["drops"])
+	assert.Equal(t, characterID, entries[3].CharacterId)
+	assert.Equal(t, at, entries[3].OccurredAt.AsTime())
+
+	page, err := service.List(ctx, userID, characterID, entries[1].Id, 1)
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	assert.Equal(t, entries[2].Id, page[0].Id)
+}
+
+func TestService_List_Errors(t *testing.T) {
+	service, _, _ := newTestService(t)
+	ctx := session.WithWorldID(context.Background(), worldID)
+
+	_, err := service.List(ctx, userID, "not-a-uuid", 0, 0)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = service.List(ctx, userID, characterID, -1, 0)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = service.List(ctx, userID, "00000000-0000-0000-0000-0000000000c3", 0, 0)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = service.List(ctx, userID, otherID, 0, 0)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestService_Prune(t *testing.T) {
+	service, database, bus := newTestService(t)
+	now := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	service.SetClock(clock.NewFake(now))
+
+	publish(t, bus, events.ItemCrafted, "old", now.Add(-Retention-time.Hour), events.ItemCraftedPayload{CraftID: "old", CharacterID: characterID})
+	publish(t, bus, events.ItemCrafted, "new", now.Add(-time.Hour), events.ItemCraftedPayload{CraftID: "new", CharacterID: characterID})
+
+	deleted, err := service.Prune(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+	require.Len(t, database.activity, 1)
+	assert.Equal(t, "new", database.activity[0].DedupKey)
+}
@@ -0,0 +1,83 @@
+package activity
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/charmbracelet/log"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DatabaseInterface abstracts database operations for the activity feed.
+type DatabaseInterface interface {
+	GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error)
+	CreateCharacterActivity(ctx context.Context, arg db.CreateCharacterActivityParams) error
+	ListCharacterActivity(ctx context.Context, arg db.ListCharacterActivityParams) ([]db.CharacterActivity, error)
+	DeleteCharacterActivityBefore(ctx context.Context, occurredAt pgtype.Timestamp) (int64, error)
+}
+
+// DatabaseWrapper implements DatabaseInterface using the actual database connection.
+type DatabaseWrapper struct {
+	queries *db.Queries
+}
+
+// NewDatabaseWrapper creates a new database wrapper with the given connection pool.
+func NewDatabaseWrapper(pool storage.Pool) DatabaseInterface {
+	return &DatabaseWrapper{queries: db.New(pool)}
+}
+
+func (d *DatabaseWrapper) GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error) {
+	return d.queries.GetCharacterById(ctx, id)
+}
+
+func (d *DatabaseWrapper) CreateCharacterActivity(ctx context.Context, arg db.CreateCharacterActivityParams) error {
+	return d.queries.CreateCharacterActivity(ctx, arg)
+}
+
+func (d *DatabaseWrapper) ListCharacterActivity(ctx context.Context, arg db.ListCharacterActivityParams) ([]db.CharacterActivity, error) {
+	return d.queries.ListCharacterActivity(ctx, arg)
+}
+
+func (d *DatabaseWrapper) DeleteCharacterActivityBefore(ctx context.Context, occurredAt pgtype.Timestamp) (int64, error) {
+	return d.queries.DeleteCharacterActivityBefore(ctx, occurredAt)
+}
+
+// LoggerInterface abstracts logging operations for dependency injection.
+type LoggerInterface interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+	With(keysAndValues ...interface{}) LoggerInterface
+}
+
+// DefaultLoggerWrapper wraps the internal logging package.
+type DefaultLoggerWrapper struct {
+	logger *log.Logger
+}
+
+func NewDefaultLoggerWrapper() LoggerInterface {
+	return &DefaultLoggerWrapper{logger: logging.GetLogger()}
+}
+
+func (l *DefaultLoggerWrapper) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.Debug(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Info(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.Warn(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.Error(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) With(keysAndValues ...interface{}) LoggerInterface {
+	return &DefaultLoggerWrapper{logger: l.logger.With(keysAndValues...)}
+}
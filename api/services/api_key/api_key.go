@@ -0,0 +1,208 @@
+// Package api_key manages API keys for server-to-server integrations. Admins create,
+// list and revoke keys; the auth interceptor authenticates requests carrying one and
+// checks the RPC against the key's scopes.
+package api_key
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/apikey"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	adminV1 "github.com/VoidMesh/api/api/proto/admin/v1"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	MaxNameLength = 100
+
+	// touchInterval limits how often a key's last use is written back
+	touchInterval = time.Minute
+)
+
+// Service stores API keys and authenticates requests made with them.
+type Service struct {
+	db     DatabaseInterface
+	logger LoggerInterface
+	clock  clock.Clock
+}
+
+// NewService creates a new API key service with dependency injection.
+func NewService(db DatabaseInterface, logger LoggerInterface) *Service {
+	componentLogger := logger.With("component", "api-key-service")
+	componentLogger.Debug("Creating new API key service")
+	return &Service{
+		db:     db,
+		logger: componentLogger,
+		clock:  clock.New(),
+	}
+}
+
+// NewServiceWithPool creates a service with concrete implementations (convenience constructor for production use).
+func NewServiceWithPool(pool storage.Pool) *Service {
+	return NewService(NewDatabaseWrapper(pool), NewDefaultLoggerWrapper())
+}
+
+// SetClock replaces the clock used for timestamps and expiry (for simulation tests)
+func (s *Service) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// CreateKey creates a key with the given scopes, returning it with the token to hand to
+// the integration. The token is not stored and cannot be retrieved later.
+func (s *Service) CreateKey(ctx context.Context, createdBy, name string, scopes []string, expiresAt time.Time) (*adminV1.ApiKey, string, error) {
+	creator, err := uuid.StringToPgtype(createdBy)
+	if err != nil {
+		return nil, "", status.Errorf(codes.InvalidArgument, "invalid user ID format")
+	}
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, "", status.Errorf(codes.InvalidArgument, "name is required")
+	}
+	if utf8.RuneCountInString(name) > MaxNameLength {
+		return nil, "", status.Errorf(codes.InvalidArgument, "name must be at most %d characters", MaxNameLength)
+	}
+	if len(scopes) == 0 {
+		return nil, "", status.Errorf(codes.InvalidArgument, "at least one scope is required")
+	}
+	for _, scope := range scopes {
+		if !apikey.ValidScope(scope) {
+			return nil, "", status.Errorf(codes.InvalidArgument, "unknown scope %q", scope)
+		}
+	}
+	now := s.clock.Now()
+	if !expiresAt.IsZero() && !expiresAt.After(now) {
+		return nil, "", status.Errorf(codes.InvalidArgument, "expiry must be in the future")
+	}
+
+	token, keyID, secretHash, err := apikey.Generate()
+	if err != nil {
+		s.logger.Error("Failed to generate API key", "error", err)
+		return nil, "", status.Errorf(codes.Internal, "failed to generate API key")
+	}
+	row, err := s.db.CreateApiKey(ctx, db.CreateApiKeyParams{
+		KeyID:      keyID,
+		SecretHash: secretHash,
+		Name:       name,
+		Scopes:     slices.Compact(slices.Sorted(slices.Values(scopes))),
+		CreatedBy:  creator,
+		CreatedAt:  pgtype.Timestamp{Time: now, Valid: true},
+		ExpiresAt:  pgtype.Timestamp{Time: expiresAt, Valid: !expiresAt.IsZero()},
+	})
+	if err != nil {
+		s.logger.Error("Failed to store API key", "error", err)
+		return nil, "", status.Errorf(codes.Internal, "failed to create API key")
+	}
+
+	s.logger.Info("API key created", "key_id", keyID, "name", name, "scopes", row.Scopes, "created_by", createdBy)
+	return dbKeyToProto(row), token, nil
+}
+
+// ListKeys returns every key, oldest first, including revoked and expired ones
+func (s *Service) ListKeys(ctx context.Context) ([]*adminV1.ApiKey, error) {
+	rows, err := s.db.ListApiKeys(ctx)
+	if err != nil {
+		s.logger.Error("Failed to list API keys", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list API keys")
+	}
+	keys := make([]*adminV1.ApiKey, 0, len(rows))
+	for _, row := range rows {
+		keys = append(keys, dbKeyToProto(row))
+	}
+	return keys, nil
+}
+
+// RevokeKey stops a key from authenticating; requests already in flight finish
+func (s *Service) RevokeKey(ctx context.Context, id int64) (*adminV1.ApiKey, error) {
+	row, err := s.db.RevokeApiKey(ctx, db.RevokeApiKeyParams{
+		ID:        id,
+		RevokedAt: pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "API key not found or already revoked")
+	}
+	if err != nil {
+		s.logger.Error("Failed to revoke API key", "id", id, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to revoke API key")
+	}
+
+	s.logger.Info("API key revoked", "key_id", row.KeyID, "name", row.Name)
+	return dbKeyToProto(row), nil
+}
+
+// AuthenticateAPIKey checks that token is a live key whose scopes allow method, and
+// returns its key ID
+func (s *Service) AuthenticateAPIKey(ctx context.Context, token, method string) (string, error) {
+	keyID, secret, err := apikey.Parse(token)
+	if err != nil {
+		return "", status.Errorf(codes.Unauthenticated, "invalid API key")
+	}
+	row, err := s.db.GetApiKeyByKeyID(ctx, keyID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", status.Errorf(codes.Unauthenticated, "invalid API key")
+	}
+	if err != nil {
+		s.logger.Error("Failed to get API key", "key_id", keyID, "error", err)
+		return "", status.Errorf(codes.Internal, "failed to check API key")
+	}
+	if !apikey.SecretMatches(secret, row.SecretHash) {
+		return "", status.Errorf(codes.Unauthenticated, "invalid API key")
+	}
+
+	// The caller holds the secret, so it may learn why a real key stopped working
+	now := s.clock.Now()
+	if row.RevokedAt.Valid {
+		return "", status.Errorf(codes.Unauthenticated, "API key revoked")
+	}
+	if row.ExpiresAt.Valid && !now.Before(row.ExpiresAt.Time) {
+		return "", status.Errorf(codes.Unauthenticated, "API key expired")
+	}
+	if !apikey.Allows(row.Scopes, method) {
+		s.logger.Warn("API key used outside its scopes", "key_id", keyID, "method", method)
+		return "", status.Errorf(codes.PermissionDenied, "API key is not allowed to call %s", method)
+	}
+
+	if !row.LastUsedAt.Valid || now.Sub(row.LastUsedAt.Time) >= touchInterval {
+		if err := s.db.TouchApiKey(ctx, db.TouchApiKeyParams{
+			ID:         row.ID,
+			LastUsedAt: pgtype.Timestamp{Time: now, Valid: true},
+		}); err != nil {
+			s.logger.Warn("Failed to record API key use", "key_id", keyID, "error", err)
+		}
+	}
+	return keyID, nil
+}
+
+func dbKeyToProto(row db.ApiKey) *adminV1.ApiKey {
+	key := &adminV1.ApiKey{
+		Id:        row.ID,
+		KeyId:     row.KeyID,
+		Name:      row.Name,
+		Scopes:    row.Scopes,
+		CreatedAt: timestamppb.New(row.CreatedAt.Time),
+	}
+	if row.CreatedBy.Valid {
+		key.CreatedBy = uuid.PgtypeToString(row.CreatedBy)
+	}
+	if row.ExpiresAt.Valid {
+		key.ExpiresAt = timestamppb.New(row.ExpiresAt.Time)
+	}
+	if row.RevokedAt.Valid {
+		key.RevokedAt = timestamppb.New(row.RevokedAt.Time)
+	}
+	if row.LastUsedAt.Valid {
+		key.LastUsedAt = timestamppb.New(row.LastUsedAt.Time)
+	}
+	return key
+}
@@ -0,0 +1,169 @@
+package api_key
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/apikey"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeDB keeps API keys in memory
+type fakeDB struct {
+	keys    []db.ApiKey
+	touches int
+}
+
+func (f *fakeDB) CreateApiKey(ctx context.Context, arg db.CreateApiKeyParams) (db.ApiKey, error) {
+	row := db.ApiKey{
+		ID:         int64(len(f.keys) + 1),
+		KeyID:      arg.KeyID,
+		SecretHash: arg.SecretHash,
+		Name:       arg.Name,
+		Scopes:     arg.Scopes,
+		CreatedBy:  arg.CreatedBy,
+		CreatedAt:  arg.CreatedAt,
+		ExpiresAt:  arg.ExpiresAt,
+	}
+	f.keys = append(f.keys, row)
+	return row, nil
+}
+
+func (f *fakeDB) GetApiKeyByKeyID(ctx context.Context, keyID string) (db.ApiKey, error) {
+	for _, key := range f.keys {
+		if key.KeyID == keyID {
+			return key, nil
+		}
+	}
+	return db.ApiKey{}, pgx.ErrNoRows
+}
+
+func (f *fakeDB) ListApiKeys(ctx context.Context) ([]db.ApiKey, error) {
+	return f.keys, nil
+}
+
+func (f *fakeDB) RevokeApiKey(ctx context.Context, arg db.RevokeApiKeyParams) (db.ApiKey, error) {
+	if arg.ID < 1 || arg.ID > int64(len(f.keys)) || f.keys[arg.ID-1].RevokedAt.Valid {
+		return db.ApiKey{}, pgx.ErrNoRows
+	}
+	f.keys[arg.ID-1].RevokedAt = arg.RevokedAt
+	return f.keys[arg.ID-1], nil
+}
+
+func (f *fakeDB) TouchApiKey(ctx context.Context, arg db.TouchApiKeyParams) error {
+	f.keys[arg.ID-1].LastUsedAt = arg.LastUsedAt
+	f.touches++
+	return nil
+}
+
+const (
+	adminID  = "00000000-0000-0000-0000-000000000001"
+	getChunk = "/chunk.v1.ChunkService/GetChunk"
+)
+
+func newTestService() (*Service, *fakeDB, *clock.Fake) {
+	database := &fakeDB{}
+	fake := clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	service := NewService(database, testmocks.NopLogger[LoggerInterface]{})
+	service.SetClock(fake)
+	return service, database, fake
+}
+
+func TestCreateKey(t *testing.T) {
+	service, database, _ := newTestService()
+	ctx := context.Background()
+
+	key, token, err := service.CreateKey(ctx, adminID, " analytics ", []string{apikey.ScopeWorldRead, apikey.ScopeChunkRead, apikey.ScopeChunkRead}, time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, "analytics", key.Name)
+	assert.Equal(t, []string{apikey.ScopeChunkRead, apikey.ScopeWorldRead}, key.Scopes)
+	assert.Equal(t, adminID, key.CreatedBy)
+	assert.Nil(t, key.ExpiresAt)
+	assert.Contains(t, token, key.KeyId)
+	assert.NotContains(t, string(database.keys[0].SecretHash), token, "only the hash is stored")
+
+	tests := []struct {
+		name      string
+		keyName   string
+		scopes    []string
+		expiresAt time.Time
+	}{
+		{"missing name", "", []string{apikey.ScopeChunkRead}, time.Time{}},
+		{"no scopes", "pipeline", nil, time.Time{}},
+		{"unknown scope", "pipeline", []string{"chunk:write"}, time.Time{}},
+		{"expiry in the past", "pipeline", []string{apikey.ScopeChunkRead}, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		_, _, err := service.CreateKey(ctx, adminID, tt.keyName, tt.scopes, tt.expiresAt)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), tt.name)
+	}
+}
+
+func TestAuthenticateAPIKey(t *testing.T) {
+	service, database, fake := newTestService()
+	ctx := context.Background()
+
+	key, token, err := service.CreateKey(ctx, adminID, "analytics", []string{apikey.ScopeChunkRead}, fake.Now().Add(24*time.Hour))
+	require.NoError(t, err)
+
+	keyID, err := service.AuthenticateAPIKey(ctx, token, getChunk)
+	require.NoError(t, err)
+	assert.Equal(t, key.KeyId, keyID)
+
+	_, err = service.AuthenticateAPIKey(ctx, token, "/character.v1.CharacterService/MoveCharacter")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = service.AuthenticateAPIKey(ctx, token+"x", getChunk)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = service.AuthenticateAPIKey(ctx, "vmk_000000000000_secret", getChunk)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = service.AuthenticateAPIKey(ctx, "not-a-key", getChunk)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// Last use is recorded at most once a minute
+	assert.Equal(t, 1, database.touches)
+	_, err = service.AuthenticateAPIKey(ctx, token, getChunk)
+	require.NoError(t, err)
+	assert.Equal(t, 1, database.touches)
+	fake.Advance(time.Minute)
+	_, err = service.AuthenticateAPIKey(ctx, token, getChunk)
+	require.NoError(t, err)
+	assert.Equal(t, 2, database.touches)
+
+	fake.Advance(24 * time.Hour)
+	_, err = service.AuthenticateAPIKey(ctx, token, getChunk)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.Contains(t, err.Error(), "expired")
+}
+
+func TestRevokeKey(t *testing.T) {
+	service, _, _ := newTestService()
+	ctx := context.Background()
+
+	key, token, err := service.CreateKey(ctx, adminID, "companion", []string{apikey.ScopeWorldRead}, time.Time{})
+	require.NoError(t, err)
+
+	revoked, err := service.RevokeKey(ctx, key.Id)
+	require.NoError(t, err)
+	assert.NotNil(t, revoked.RevokedAt)
+
+	_, err = service.AuthenticateAPIKey(ctx, token, "/world.v1.WorldService/ListWorlds")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.Contains(t, err.Error(), "revoked")
+
+	_, err = service.RevokeKey(ctx, key.Id)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	keys, err := service.ListKeys(ctx)
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	assert.Equal(t, "companion", keys[0].Name)
+}
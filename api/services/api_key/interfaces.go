@@ -0,0 +1,89 @@
+package api_key
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/charmbracelet/log"
+)
+
+// DatabaseInterface abstracts database operations for API keys.
+type DatabaseInterface interface {
+	CreateApiKey(ctx context.Context, arg db.CreateApiKeyParams) (db.ApiKey, error)
+	GetApiKeyByKeyID(ctx context.Context, keyID string) (db.ApiKey, error)
+	ListApiKeys(ctx context.Context) ([]db.ApiKey, error)
+	RevokeApiKey(ctx context.Context, arg db.RevokeApiKeyParams) (db.ApiKey, error)
+	TouchApiKey(ctx context.Context, arg db.TouchApiKeyParams) error
+}
+
+// DatabaseWrapper implements DatabaseInterface using the actual database connection.
+type DatabaseWrapper struct {
+	queries *db.Queries
+}
+
+// NewDatabaseWrapper creates a new database wrapper with the given connection pool.
+func NewDatabaseWrapper(pool storage.Pool) DatabaseInterface {
+	return &DatabaseWrapper{
+		queries: db.New(pool),
+	}
+}
+
+func (d *DatabaseWrapper) CreateApiKey(ctx context.Context, arg db.CreateApiKeyParams) (db.ApiKey, error) {
+	return d.queries.CreateApiKey(ctx, arg)
+}
+
+func (d *DatabaseWrapper) GetApiKeyByKeyID(ctx context.Context, keyID string) (db.ApiKey, error) {
+	return d.queries.GetApiKeyByKeyID(ctx, keyID)
+}
+
+func (d *DatabaseWrapper) ListApiKeys(ctx context.Context) ([]db.ApiKey, error) {
+	return d.queries.ListApiKeys(ctx)
+}
+
+func (d *DatabaseWrapper) RevokeApiKey(ctx context.Context, arg db.RevokeApiKeyParams) (db.ApiKey, error) {
+	return d.queries.RevokeApiKey(ctx, arg)
+}
+
+func (d *DatabaseWrapper) TouchApiKey(ctx context.Context, arg db.TouchApiKeyParams) error {
+	return d.queries.TouchApiKey(ctx, arg)
+}
+
+// LoggerInterface abstracts logging operations for dependency injection.
+type LoggerInterface interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+	With(keysAndValues ...interface{}) LoggerInterface
+}
+
+// DefaultLoggerWrapper wraps the internal logging package.
+type DefaultLoggerWrapper struct {
+	logger *log.Logger
+}
+
+func NewDefaultLoggerWrapper() LoggerInterface {
+	return &DefaultLoggerWrapper{logger: logging.GetLogger()}
+}
+
+func (l *DefaultLoggerWrapper) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.Debug(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Info(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.Warn(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.Error(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) With(keysAndValues ...interface{}) LoggerInterface {
+	return &DefaultLoggerWrapper{logger: l.logger.With(keysAndValues...)}
+}
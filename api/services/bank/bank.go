@@ -0,0 +1,587 @@
+// Package bank stores items in vaults kept apart from inventories. Every character has a
+// personal vault, created the first time it is opened; guild vaults are founded by a
+// character and shared with other characters of the world at a permission tier (view,
+// deposit, withdraw, manage). There is no guild system, so a guild vault's members are
+// its guild. Vault contents are opened and moved only within reach of a bank entity, and
+// every deposit and withdrawal is logged in bank_transactions.
+package bank
+
+import (
+	"context"
+	"errors"
+	"math"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/authz"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/entity"
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	bankV1 "github.com/VoidMesh/api/api/proto/bank/v1"
+	"github.com/VoidMesh/api/api/services/inventory"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	DefaultTransactionLimit = 50
+	MaxTransactionLimit     = 200
+)
+
+var (
+	ErrInsufficientItems = errors.New("not enough items")
+	ErrVaultFull         = errors.New("vault is full")
+	ErrTooManyVaults     = errors.New("guild vault limit reached")
+	ErrNameTaken         = errors.New("guild vault name is taken")
+)
+
+// Tiers as stored in bank_vault_members
+const (
+	tierView     = "view"
+	tierDeposit  = "deposit"
+	tierWithdraw = "withdraw"
+	tierManage   = "manage"
+)
+
+var tierNames = map[bankV1.VaultTier]string{
+	bankV1.VaultTier_VAULT_TIER_VIEW:     tierView,
+	bankV1.VaultTier_VAULT_TIER_DEPOSIT:  tierDeposit,
+	bankV1.VaultTier_VAULT_TIER_WITHDRAW: tierWithdraw,
+	bankV1.VaultTier_VAULT_TIER_MANAGE:   tierManage,
+}
+
+func parseTier(name string) bankV1.VaultTier {
+	for tier, n := range tierNames {
+		if n == name {
+			return tier
+		}
+	}
+	return bankV1.VaultTier_VAULT_TIER_UNSPECIFIED
+}
+
+// Config sets where characters bank and how much vaults hold
+type Config struct {
+	BankTypes      []string // Entity types characters bank at, structures or NPCs
+	Range          int32    // Cells a character may stand from the bank
+	PersonalSlots  int32    // Slots of every personal vault
+	GuildSlots     int32    // Slots of every guild vault
+	MaxGuildVaults int64    // Guild vaults a character may found
+	MaxNameLength  int      // Of guild vault names, in characters
+}
+
+// DefaultConfig banks at bank structures, with personal vaults twice the size of a
+// default inventory and guild vaults larger still
+func DefaultConfig() Config {
+	return Config{
+		BankTypes:      []string{"bank"},
+		Range:          2,
+		PersonalSlots:  48,
+		GuildSlots:     120,
+		MaxGuildVaults: 2,
+		MaxNameLength:  32,
+	}
+}
+
+// Service manages bank vaults.
+type Service struct {
+	db       DatabaseInterface
+	entities EntityStore
+	logger   LoggerInterface
+	clock    clock.Clock
+	config   Config
+}
+
+// NewService creates a new bank service with dependency injection.
+func NewService(db DatabaseInterface, entities EntityStore, logger LoggerInterface) *Service {
+	componentLogger := logger.With("component", "bank-service")
+	componentLogger.Debug("Creating new bank service")
+	return &Service{
+		db:       db,
+		entities: entities,
+		logger:   componentLogger,
+		clock:    clock.New(),
+		config:   DefaultConfig(),
+	}
+}
+
+// NewServiceWithPool creates a service with concrete implementations (convenience constructor for production use).
+func NewServiceWithPool(pool storage.Pool) *Service {
+	return NewService(NewDatabaseWrapper(pool), entity.NewStoreWithPool(pool), NewDefaultLoggerWrapper())
+}
+
+// SetClock replaces the clock used for vault and transaction times (for simulation tests)
+func (s *Service) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// SetConfig replaces the bank types, reach and vault sizes
+func (s *Service) SetConfig(config Config) {
+	s.config = config
+}
+
+// BankTypes returns the entity types characters bank at
+func (s *Service) BankTypes() []string {
+	return slices.Clone(s.config.BankTypes)
+}
+
+// access is a vault and what the character may do with it
+type access struct {
+	vault db.BankVault
+	tier  bankV1.VaultTier
+}
+
+func (a access) allows(tier bankV1.VaultTier) bool {
+	return a.tier >= tier
+}
+
+// ListVaults lists the character's personal vault, once created, and the guild vaults it
+// is a member of, without their items
+func (s *Service) ListVaults(ctx context.Context, userID, characterID string) ([]*bankV1.Vault, error) {
+	character, err := authz.OwnedCharacter(ctx, s.db, s.logger, userID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	var vaults []*bankV1.Vault
+	personal, err := s.db.GetPersonalBankVault(ctx, character.ID)
+	switch {
+	case err == nil:
+		vaults = append(vaults, s.vaultToProto(access{vault: personal, tier: bankV1.VaultTier_VAULT_TIER_MANAGE}, nil))
+	case !errors.Is(err, pgx.ErrNoRows):
+		s.logger.Error("Failed to get personal vault", "character_id", characterID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list vaults")
+	}
+	guild, err := s.db.ListGuildBankVaultsByMember(ctx, character.ID)
+	if err != nil {
+		s.logger.Error("Failed to list guild vaults", "character_id", characterID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list vaults")
+	}
+	for _, row := range guild {
+		vault := db.BankVault{ID: row.ID, WorldID: row.WorldID, OwnerID: row.OwnerID, Name: row.Name, CreatedBy: row.CreatedBy, CreatedAt: row.CreatedAt}
+		vaults = append(vaults, s.vaultToProto(access{vault: vault, tier: parseTier(row.Tier)}, nil))
+	}
+	return vaults, nil
+}
+
+// OpenVault returns a vault with its items to a character at a bank; vault 0 is the
+// character's personal vault
+func (s *Service) OpenVault(ctx context.Context, userID, characterID string, bankID, vaultID int64) (*bankV1.Vault, error) {
+	character, err := authz.OwnedCharacter(ctx, s.db, s.logger, userID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.atBank(ctx, character, bankID); err != nil {
+		return nil, err
+	}
+	a, err := s.access(ctx, character, vaultID, true)
+	if err != nil {
+		return nil, err
+	}
+	return s.withItems(ctx, a)
+}
+
+// Deposit moves items from the character's inventory into a vault
+func (s *Service) Deposit(ctx context.Context, userID string, req *bankV1.DepositRequest) (*bankV1.DepositResponse, error) {
+	a, move, err := s.prepareMove(ctx, userID, req.CharacterId, req.BankEntityId, req.VaultId, req.ItemId, req.Quantity, "depositing", bankV1.VaultTier_VAULT_TIER_DEPOSIT)
+	if err != nil {
+		return nil, err
+	}
+	logged, err := s.db.Deposit(ctx, move)
+	switch {
+	case errors.Is(err, ErrInsufficientItems):
+		return nil, status.Errorf(codes.FailedPrecondition, "not enough %s", move.Item.Name)
+	case errors.Is(err, ErrVaultFull):
+		return nil, status.Errorf(codes.ResourceExhausted, "not enough room in the vault")
+	case err != nil:
+		s.logger.Error("Failed to deposit", "vault_id", a.vault.ID, "item_id", move.Item.ID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to deposit")
+	}
+	s.logger.Info("Items deposited", "vault_id", a.vault.ID, "character_id", req.CharacterId, "item_id", move.Item.ID, "quantity", move.Quantity)
+
+	vault, err := s.withItems(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+	return &bankV1.DepositResponse{Vault: vault, Transaction: transactionToProto(logged, move)}, nil
+}
+
+// Withdraw moves items from a vault into the character's inventory
+func (s *Service) Withdraw(ctx context.Context, userID string, req *bankV1.WithdrawRequest) (*bankV1.WithdrawResponse, error) {
+	a, move, err := s.prepareMove(ctx, userID, req.CharacterId, req.BankEntityId, req.VaultId, req.ItemId, req.Quantity, "withdrawing", bankV1.VaultTier_VAULT_TIER_WITHDRAW)
+	if err != nil {
+		return nil, err
+	}
+	logged, err := s.db.Withdraw(ctx, move)
+	switch {
+	case errors.Is(err, ErrInsufficientItems):
+		return nil, status.Errorf(codes.FailedPrecondition, "the vault holds less than %d %s", move.Quantity, move.Item.Name)
+	case errors.Is(err, inventory.ErrInventoryFull):
+		return nil, status.Errorf(codes.ResourceExhausted, "inventory is full")
+	case err != nil:
+		s.logger.Error("Failed to withdraw", "vault_id", a.vault.ID, "item_id", move.Item.ID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to withdraw")
+	}
+	s.logger.Info("Items withdrawn", "vault_id", a.vault.ID, "character_id", req.CharacterId, "item_id", move.Item.ID, "quantity", move.Quantity)
+
+	vault, err := s.withItems(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+	return &bankV1.WithdrawResponse{Vault: vault, Transaction: transactionToProto(logged, move)}, nil
+}
+
+// prepareMove checks a deposit or withdrawal up to the point of moving the items
+func (s *Service) prepareMove(ctx context.Context, userID, characterID string, bankID, vaultID int64, itemID, quantity int32, action string, tier bankV1.VaultTier) (access, Move, error) {
+	if quantity <= 0 {
+		return access{}, Move{}, status.Errorf(codes.InvalidArgument, "quantity must be positive")
+	}
+	character, err := authz.OwnedCharacter(ctx, s.db, s.logger, userID, characterID)
+	if err != nil {
+		return access{}, Move{}, err
+	}
+	if err := s.atBank(ctx, character, bankID); err != nil {
+		return access{}, Move{}, err
+	}
+	a, err := s.access(ctx, character, vaultID, true)
+	if err != nil {
+		return access{}, Move{}, err
+	}
+	if !a.allows(tier) {
+		return access{}, Move{}, status.Errorf(codes.PermissionDenied, "%s needs the %s tier", action, tierNames[tier])
+	}
+	item, err := s.db.GetItem(ctx, itemID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return access{}, Move{}, status.Errorf(codes.NotFound, "item not found")
+	}
+	if err != nil {
+		s.logger.Error("Failed to get item", "item_id", itemID, "error", err)
+		return access{}, Move{}, status.Errorf(codes.Internal, "failed to get item")
+	}
+	return a, Move{
+		VaultID:   a.vault.ID,
+		Character: character,
+		Item:      item,
+		Quantity:  quantity,
+		Slots:     s.slots(a.vault),
+		Now:       s.clock.Now(),
+	}, nil
+}
+
+// CreateGuildVault founds a guild vault at a bank with the character as its manager
+func (s *Service) CreateGuildVault(ctx context.Context, userID, characterID string, bankID int64, name string) (*bankV1.Vault, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > s.config.MaxNameLength {
+		return nil, status.Errorf(codes.InvalidArgument, "name must be 1 to %d characters", s.config.MaxNameLength)
+	}
+	character, err := authz.OwnedCharacter(ctx, s.db, s.logger, userID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.atBank(ctx, character, bankID); err != nil {
+		return nil, err
+	}
+	vault, err := s.db.CreateGuildVault(ctx, db.CreateGuildBankVaultParams{
+		WorldID:   character.WorldID,
+		Name:      name,
+		CreatedBy: character.ID,
+		CreatedAt: pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
+	}, s.config.MaxGuildVaults)
+	switch {
+	case errors.Is(err, ErrTooManyVaults):
+		return nil, status.Errorf(codes.FailedPrecondition, "a character may found at most %d guild vaults", s.config.MaxGuildVaults)
+	case errors.Is(err, ErrNameTaken):
+		return nil, status.Errorf(codes.AlreadyExists, "a guild vault named %q already exists", name)
+	case err != nil:
+		s.logger.Error("Failed to create guild vault", "character_id", characterID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to create guild vault")
+	}
+	s.logger.Info("Guild vault created", "vault_id", vault.ID, "character_id", characterID, "name", name)
+	return s.vaultToProto(access{vault: vault, tier: bankV1.VaultTier_VAULT_TIER_MANAGE}, []db.ListBankVaultItemsRow{}), nil
+}
+
+// ListMembers lists the members of a guild vault the character is a member of
+func (s *Service) ListMembers(ctx context.Context, userID, characterID string, vaultID int64) ([]*bankV1.VaultMember, error) {
+	character, err := authz.OwnedCharacter(ctx, s.db, s.logger, userID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	a, err := s.access(ctx, character, vaultID, false)
+	if err != nil {
+		return nil, err
+	}
+	if a.vault.OwnerID.Valid {
+		return nil, status.Errorf(codes.FailedPrecondition, "personal vaults have no members")
+	}
+	rows, err := s.db.ListBankVaultMembers(ctx, a.vault.ID)
+	if err != nil {
+		s.logger.Error("Failed to list vault members", "vault_id", vaultID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list vault members")
+	}
+	members := make([]*bankV1.VaultMember, 0, len(rows))
+	for _, row := range rows {
+		members = append(members, &bankV1.VaultMember{
+			CharacterId:   uuid.PgtypeToString(row.CharacterID),
+			CharacterName: row.CharacterName,
+			Tier:          parseTier(row.Tier),
+			AddedAt:       timestamppb.New(row.AddedAt.Time),
+		})
+	}
+	return members, nil
+}
+
+// SetMember adds a character of the vault's world to a guild vault, changes its tier or,
+// with VAULT_TIER_UNSPECIFIED, removes it. Only managers may, and not for themselves, so a
+// vault always keeps a manager.
+func (s *Service) SetMember(ctx context.Context, userID string, req *bankV1.SetVaultMemberRequest) (*bankV1.SetVaultMemberResponse, error) {
+	name, known := tierNames[req.Tier]
+	if !known && req.Tier != bankV1.VaultTier_VAULT_TIER_UNSPECIFIED {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown tier %d", req.Tier)
+	}
+	memberID, err := uuid.StringToPgtype(req.MemberCharacterId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid member character ID format")
+	}
+	character, err := authz.OwnedCharacter(ctx, s.db, s.logger, userID, req.CharacterId)
+	if err != nil {
+		return nil, err
+	}
+	if memberID == character.ID {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot change your own tier")
+	}
+	a, err := s.access(ctx, character, req.VaultId, false)
+	if err != nil {
+		return nil, err
+	}
+	if a.vault.OwnerID.Valid {
+		return nil, status.Errorf(codes.FailedPrecondition, "personal vaults have no members")
+	}
+	if !a.allows(bankV1.VaultTier_VAULT_TIER_MANAGE) {
+		return nil, status.Errorf(codes.PermissionDenied, "managing members needs the %s tier", tierManage)
+	}
+
+	if !known {
+		removed, err := s.db.DeleteBankVaultMember(ctx, db.DeleteBankVaultMemberParams{VaultID: a.vault.ID, CharacterID: memberID})
+		if err != nil {
+			s.logger.Error("Failed to remove vault member", "vault_id", a.vault.ID, "error", err)
+			return nil, status.Errorf(codes.Internal, "failed to remove vault member")
+		}
+		s.logger.Info("Vault member removed", "vault_id", a.vault.ID, "member_id", req.MemberCharacterId, "by", req.CharacterId)
+		return &bankV1.SetVaultMemberResponse{Removed: removed > 0}, nil
+	}
+
+	member, err := s.db.GetCharacterById(ctx, memberID)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && member.WorldID != a.vault.WorldID) {
+		return nil, status.Errorf(codes.NotFound, "character not found")
+	}
+	if err != nil {
+		s.logger.Error("Failed to get member character", "character_id", req.MemberCharacterId, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to set vault member")
+	}
+	row, err := s.db.UpsertBankVaultMember(ctx, db.UpsertBankVaultMemberParams{
+		VaultID:     a.vault.ID,
+		CharacterID: memberID,
+		Tier:        name,
+		AddedAt:     pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
+	})
+	if err != nil {
+		s.logger.Error("Failed to set vault member", "vault_id", a.vault.ID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to set vault member")
+	}
+	s.logger.Info("Vault member set", "vault_id", a.vault.ID, "member_id", req.MemberCharacterId, "tier", name, "by", req.CharacterId)
+	return &bankV1.SetVaultMemberResponse{Member: &bankV1.VaultMember{
+		CharacterId:   uuid.PgtypeToString(row.CharacterID),
+		CharacterName: member.Name,
+		Tier:          req.Tier,
+		AddedAt:       timestamppb.New(row.AddedAt.Time),
+	}}, nil
+}
+
+// ListTransactions pages through a vault's deposits and withdrawals, newest first
+func (s *Service) ListTransactions(ctx context.Context, userID, characterID string, vaultID, beforeID int64, limit int32) ([]*bankV1.VaultTransaction, error) {
+	if beforeID < 0 || limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "before_id and limit must not be negative")
+	}
+	if limit == 0 {
+		limit = DefaultTransactionLimit
+	}
+	limit = min(limit, MaxTransactionLimit)
+	character, err := authz.OwnedCharacter(ctx, s.db, s.logger, userID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	a, err := s.access(ctx, character, vaultID, false)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.db.ListBankTransactions(ctx, db.ListBankTransactionsParams{VaultID: a.vault.ID, BeforeID: beforeID, MaxTransactions: limit})
+	if err != nil {
+		s.logger.Error("Failed to list vault transactions", "vault_id", a.vault.ID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list vault transactions")
+	}
+	transactions := make([]*bankV1.VaultTransaction, 0, len(rows))
+	for _, row := range rows {
+		transactions = append(transactions, &bankV1.VaultTransaction{
+			Id:            row.ID,
+			VaultId:       row.VaultID,
+			CharacterId:   uuid.PgtypeToString(row.CharacterID),
+			CharacterName: row.CharacterName,
+			ItemId:        row.ItemID,
+			ItemName:      row.ItemName,
+			Quantity:      row.Quantity,
+			CreatedAt:     timestamppb.New(row.CreatedAt.Time),
+		})
+	}
+	return transactions, nil
+}
+
+// access loads a vault the character may use and its tier. Vault 0 is the character's
+// personal vault, created when create is set; other characters' personal vaults and guild
+// vaults the character is not a member of are denied.
+func (s *Service) access(ctx context.Context, character db.Character, vaultID int64, create bool) (access, error) {
+	var vault db.BankVault
+	var err error
+	switch {
+	case vaultID == 0 && create:
+		vault, err = s.db.CreatePersonalBankVault(ctx, db.CreatePersonalBankVaultParams{
+			WorldID:   character.WorldID,
+			OwnerID:   character.ID,
+			CreatedAt: pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
+		})
+	case vaultID == 0:
+		vault, err = s.db.GetPersonalBankVault(ctx, character.ID)
+	default:
+		vault, err = s.db.GetBankVault(ctx, vaultID)
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return access{}, status.Errorf(codes.NotFound, "vault not found")
+	}
+	if err != nil {
+		s.logger.Error("Failed to get vault", "vault_id", vaultID, "error", err)
+		return access{}, status.Errorf(codes.Internal, "failed to get vault")
+	}
+	if vault.OwnerID.Valid {
+		if vault.OwnerID != character.ID {
+			return access{}, status.Errorf(codes.PermissionDenied, "not your vault")
+		}
+		return access{vault: vault, tier: bankV1.VaultTier_VAULT_TIER_MANAGE}, nil
+	}
+	member, err := s.db.GetBankVaultMember(ctx, db.GetBankVaultMemberParams{VaultID: vault.ID, CharacterID: character.ID})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return access{}, status.Errorf(codes.PermissionDenied, "not a member of this vault")
+	}
+	if err != nil {
+		s.logger.Error("Failed to get vault member", "vault_id", vault.ID, "error", err)
+		return access{}, status.Errorf(codes.Internal, "failed to get vault")
+	}
+	return access{vault: vault, tier: parseTier(member.Tier)}, nil
+}
+
+// atBank fails unless the entity is a bank within reach of the character
+func (s *Service) atBank(ctx context.Context, character db.Character, bankID int64) error {
+	bank, err := s.entities.Get(ctx, bankID)
+	if errors.Is(err, entity.ErrNotFound) || (err == nil && bank.WorldID != character.WorldID) {
+		return status.Errorf(codes.NotFound, "bank not found")
+	}
+	if err != nil {
+		s.logger.Error("Failed to get bank", "entity_id", bankID, "error", err)
+		return status.Errorf(codes.Internal, "failed to get bank")
+	}
+	if !slices.Contains(s.config.BankTypes, bank.Type) {
+		return status.Errorf(codes.FailedPrecondition, "a %s is not a bank", bank.Type)
+	}
+	if !geometry.InRange(geometry.Point{X: character.X, Y: character.Y}, bank.Position(), s.config.Range) {
+		return status.Errorf(codes.FailedPrecondition, "bank is out of range")
+	}
+	return nil
+}
+
+// withItems returns the vault with its items
+func (s *Service) withItems(ctx context.Context, a access) (*bankV1.Vault, error) {
+	items, err := s.db.ListBankVaultItems(ctx, a.vault.ID)
+	if err != nil {
+		s.logger.Error("Failed to list vault items", "vault_id", a.vault.ID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to get vault")
+	}
+	if items == nil {
+		items = []db.ListBankVaultItemsRow{}
+	}
+	return s.vaultToProto(a, items), nil
+}
+
+// slots returns how many slots a vault has
+func (s *Service) slots(vault db.BankVault) int32 {
+	if vault.OwnerID.Valid {
+		return s.config.PersonalSlots
+	}
+	return s.config.GuildSlots
+}
+
+// vaultToProto converts a vault; items are left out when nil
+func (s *Service) vaultToProto(a access, items []db.ListBankVaultItemsRow) *bankV1.Vault {
+	vault := &bankV1.Vault{
+		Id:        a.vault.ID,
+		Kind:      bankV1.VaultKind_VAULT_KIND_GUILD,
+		Name:      a.vault.Name,
+		Tier:      a.tier,
+		Slots:     s.slots(a.vault),
+		CreatedAt: timestamppb.New(a.vault.CreatedAt.Time),
+	}
+	if a.vault.OwnerID.Valid {
+		vault.Kind = bankV1.VaultKind_VAULT_KIND_PERSONAL
+		vault.OwnerCharacterId = uuid.PgtypeToString(a.vault.OwnerID)
+	}
+	for _, item := range items {
+		vault.UsedSlots += slotsFor(item.Quantity, item.StackSize)
+		vault.Items = append(vault.Items, &bankV1.VaultItem{
+			ItemId:    item.ItemID,
+			ItemName:  item.ItemName,
+			Quantity:  item.Quantity,
+			StackSize: item.StackSize,
+		})
+	}
+	return vault
+}
+
+func transactionToProto(logged db.BankTransaction, move Move) *bankV1.VaultTransaction {
+	return &bankV1.VaultTransaction{
+		Id:            logged.ID,
+		VaultId:       logged.VaultID,
+		CharacterId:   uuid.PgtypeToString(move.Character.ID),
+		CharacterName: move.Character.Name,
+		ItemId:        move.Item.ID,
+		ItemName:      move.Item.Name,
+		Quantity:      logged.Quantity,
+		CreatedAt:     timestamppb.New(logged.CreatedAt.Time),
+	}
+}
+
+// fits reports whether quantity more of an item fits in a vault holding items. Like
+// inventories, every item takes one slot per stack size, rounded up.
+func fits(items []db.ListBankVaultItemsRow, item db.Item, quantity, slots int32) bool {
+	total := int64(quantity)
+	var used int64
+	for _, row := range items {
+		if row.ItemID == item.ID {
+			total += int64(row.Quantity)
+			continue
+		}
+		used += int64(slotsFor(row.Quantity, row.StackSize))
+	}
+	if total > math.MaxInt32 {
+		return false
+	}
+	return used+int64(slotsFor(int32(total), item.StackSize)) <= int64(slots)
+}
+
+func slotsFor(quantity, stackSize int32) int32 {
+	if stackSize < 1 {
+		stackSize = 1
+	}
+	return int32((int64(quantity) + int64(stackSize) - 1) / int64(stackSize))
+}
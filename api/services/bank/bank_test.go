@@ -0,0 +1,426 @@
+package bank
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/entity"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	bankV1 "github.com/VoidMesh/api/api/proto/bank/v1"
+	"github.com/VoidMesh/api/api/services/inventory"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	woodID  = 1
+	stoneID = 2
+)
+
+// fakeDB keeps characters, their inventories, vaults, members and transactions in memory
+type fakeDB struct {
+	testmocks.Characters
+	items        map[pgtype.UUID]map[int32]int32
+	full         map[pgtype.UUID]bool
+	vaults       map[int64]db.BankVault
+	vaultItems   map[int64]map[int32]int32
+	members      map[int64]map[pgtype.UUID]db.BankVaultMember
+	transactions []db.BankTransaction
+}
+
+func newFakeDB() *fakeDB {
+	return &fakeDB{
+		Characters: testmocks.Characters{},
+		items:      map[pgtype.UUID]map[int32]int32{},
+		full:       map[pgtype.UUID]bool{},
+		vaults:     map[int64]db.BankVault{},
+		vaultItems: map[int64]map[int32]int32{},
+		members:    map[int64]map[pgtype.UUID]db.BankVaultMember{},
+	}
+}
+
+var catalog = map[int32]db.Item{
+	woodID:  {ID: woodID, Name: "Wood", StackSize: 10},
+	stoneID: {ID: stoneID, Name: "Stone", StackSize: 5},
+}
+
+func (f *fakeDB) GetItem(ctx context.Context, id int32) (db.Item, error) {
+	item, ok := catalog[id]
+	if !ok {
+		return db.Item{}, pgx.ErrNoRows
+	}
+	return item, nil
+}
+
+func (f *fakeDB) GetBankVault(ctx context.Context, id int64) (db.BankVault, error) {
+	vault, ok := f.vaults[id]
+	if !ok {
+		return db.BankVault{}, pgx.ErrNoRows
+	}
+	return vault, nil
+}
+
+func (f *fakeDB) GetPersonalBankVault(ctx context.Context, ownerID pgtype.UUID) (db.BankVault, error) {
+	for _, vault := range f.vaults {
+		if vault.OwnerID == ownerID {
+			return vault, nil
+		}
+	}
+	return db.BankVault{}, pgx.ErrNoRows
+}
+
+func (f *fakeDB) create(vault db.BankVault) db.BankVault {
+	vault.ID = int64(len(f.vaults) + 1)
+	f.vaults[vault.ID] = vault
+	return vault
+}
+
+func (f *fakeDB) CreatePersonalBankVault(ctx context.Context, arg db.CreatePersonalBankVaultParams) (db.BankVault, error) {
+	if vault, err := f.GetPersonalBankVault(ctx, arg.OwnerID); err == nil {
+		return vault, nil
+	}
+	return f.create(db.BankVault{WorldID: arg.WorldID, OwnerID: arg.OwnerID, CreatedBy: arg.OwnerID, CreatedAt: arg.CreatedAt}), nil
+}
+
+func (f *fakeDB) ListGuildBankVaultsByMember(ctx context.Context, characterID pgtype.UUID) ([]db.ListGuildBankVaultsByMemberRow, error) {
+	var rows []db.ListGuildBankVaultsByMemberRow
+	for id, members := range f.members {
+		if member, ok := members[characterID]; ok {
+			v := f.vaults[id]
+			rows = append(rows, db.ListGuildBankVaultsByMemberRow{ID: v.ID, WorldID: v.WorldID, Name: v.Name, CreatedBy: v.CreatedBy, CreatedAt: v.CreatedAt, Tier: member.Tier})
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].ID < rows[j].ID })
+	return rows, nil
+}
+
+func (f *fakeDB) ListBankVaultItems(ctx context.Context, vaultID int64) ([]db.ListBankVaultItemsRow, error) {
+	var rows []db.ListBankVaultItemsRow
+	for itemID, quantity := range f.vaultItems[vaultID] {
+		item := catalog[itemID]
+		rows = append(rows, db.ListBankVaultItemsRow{VaultID: vaultID, ItemID: itemID, Quantity: quantity, ItemName: item.Name, StackSize: item.StackSize})
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].ItemName < rows[j].ItemName })
+	return rows, nil
+}
+
+func (f *fakeDB) GetBankVaultMember(ctx context.Context, arg db.GetBankVaultMemberParams) (db.BankVaultMember, error) {
+	member, ok := f.members[arg.VaultID][arg.CharacterID]
+	if !ok {
+		return db.BankVaultMember{}, pgx.ErrNoRows
+	}
+	return member, nil
+}
+
+func (f *fakeDB) ListBankVaultMembers(ctx context.Context, vaultID int64) ([]db.ListBankVaultMembersRow, error) {
+	var rows []db.ListBankVaultMembersRow
+	for id, member := range f.members[vaultID] {
+		rows = append(rows, db.ListBankVaultMembersRow{VaultID: vaultID, CharacterID: id, Tier: member.Tier, CharacterName: f.Characters[id].Name})
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].CharacterName < rows[j].CharacterName })
+	return rows, nil
+}
+
+func (f *fakeDB) UpsertBankVaultMember(ctx context.Context, arg db.UpsertBankVaultMemberParams) (db.BankVaultMember, error) {
+	if f.members[arg.VaultID] == nil {
+		f.members[arg.VaultID] = map[pgtype.UUID]db.BankVaultMember{}
+	}
+	member := db.BankVaultMember{VaultID: arg.VaultID, CharacterID: arg.CharacterID, Tier: arg.Tier, AddedAt: arg.AddedAt}
+	if existing, ok := f.members[arg.VaultID][arg.CharacterID]; ok {
+		member.AddedAt = existing.AddedAt
+	}
+	f.members[arg.VaultID][arg.CharacterID] = member
+	return member, nil
+}
+
+func (f *fakeDB) DeleteBankVaultMember(ctx context.Context, arg db.DeleteBankVaultMemberParams) (int64, error) {
+	if _, ok := f.members[arg.VaultID][arg.CharacterID]; !ok {
+		return 0, nil
+	}
+	delete(f.members[arg.VaultID], arg.CharacterID)
+	return 1, nil
+}
+
+func (f *fakeDB) ListBankTransactions(ctx context.Context, arg db.ListBankTransactionsParams) ([]db.ListBankTransactionsRow, error) {
+	var rows []db.ListBankTransactionsRow
+	for i := len(f.transactions) - 1; i >= 0 && len(rows) < int(arg.MaxTransactions); i-- {
+		t := f.transactions[i]
+		if t.VaultID == arg.VaultID && (arg.BeforeID == 0 || t.ID < arg.BeforeID) {
+			rows = append(rows, db.ListBankTransactionsRow{ID: t.ID, VaultID: t.VaultID, CharacterID: t.CharacterID, ItemID: t.ItemID, Quantity: t.Quantity, CreatedAt: t.CreatedAt, ItemName: catalog[t.ItemID].Name})
+		}
+	}
+	return rows, nil
+}
+
+func (f *fakeDB) CreateGuildVault(ctx context.Context, vault db.CreateGuildBankVaultParams, maxVaults int64) (db.BankVault, error) {
+	var count int64
+	for _, v := range f.vaults {
+		if !v.OwnerID.Valid && v.CreatedBy == vault.CreatedBy {
+			count++
+		}
+		if !v.OwnerID.Valid && v.WorldID == vault.WorldID && strings.EqualFold(v.Name, vault.Name) {
+			return db.BankVault{}, ErrNameTaken
+		}
+	}
+	if count >= maxVaults {
+		return db.BankVault{}, ErrTooManyVaults
+	}
+	created := f.create(db.BankVault{WorldID: vault.WorldID, Name: vault.Name, CreatedBy: vault.CreatedBy, CreatedAt: vault.CreatedAt})
+	_, err := f.UpsertBankVaultMember(ctx, db.UpsertBankVaultMemberParams{VaultID: created.ID, CharacterID: vault.CreatedBy, Tier: tierManage, AddedAt: vault.CreatedAt})
+	return created, err
+}
+
+func (f *fakeDB) log(move Move, quantity int32) db.BankTransaction {
+	logged := db.BankTransaction{
+		ID:          int64(len(f.transactions) + 1),
+		VaultID:     move.VaultID,
+		CharacterID: move.Character.ID,
+		ItemID:      move.Item.ID,
+		Quantity:    quantity,
+		CreatedAt:   pgtype.Timestamp{Time: move.Now, Valid: true},
+	}
+	f.transactions = append(f.transactions, logged)
+	return logged
+}
+
+func (f *fakeDB) Deposit(ctx context.Context, move Move) (db.BankTransaction, error) {
+	if f.items[move.Character.ID][move.Item.ID] < move.Quantity {
+		return db.BankTransaction{}, ErrInsufficientItems
+	}
+	items, _ := f.ListBankVaultItems(ctx, move.VaultID)
+	if !fits(items, move.Item, move.Quantity, move.Slots) {
+		return db.BankTransaction{}, ErrVaultFull
+	}
+	f.items[move.Character.ID][move.Item.ID] -= move.Quantity
+	if f.vaultItems[move.VaultID] == nil {
+		f.vaultItems[move.VaultID] = map[int32]int32{}
+	}
+	f.vaultItems[move.VaultID][move.Item.ID] += move.Quantity
+	return f.log(move, move.Quantity), nil
+}
+
+func (f *fakeDB) Withdraw(ctx context.Context, move Move) (db.BankTransaction, error) {
+	if f.vaultItems[move.VaultID][move.Item.ID] < move.Quantity {
+		return db.BankTransaction{}, ErrInsufficientItems
+	}
+	if f.full[move.Character.ID] {
+		return db.BankTransaction{}, inventory.ErrInventoryFull
+	}
+	f.vaultItems[move.VaultID][move.Item.ID] -= move.Quantity
+	if f.vaultItems[move.VaultID][move.Item.ID] == 0 {
+		delete(f.vaultItems[move.VaultID], move.Item.ID)
+	}
+	f.items[move.Character.ID][move.Item.ID] += move.Quantity
+	return f.log(move, -move.Quantity), nil
+}
+
+type fakeEntities map[int64]*entity.Entity
+
+func (f fakeEntities) Get(ctx context.Context, id int64) (*entity.Entity, error) {
+	e, ok := f[id]
+	if !ok {
+		return nil, entity.ErrNotFound
+	}
+	return e, nil
+}
+
+var (
+	aliceID  = "00000000-0000-0000-0000-00000000000a"
+	bobID    = "00000000-0000-0000-0000-00000000000b"
+	worldID  = pgtype.UUID{Bytes: [16]byte{15: 0xaa}, Valid: true}
+	aliceChr = pgtype.UUID{Bytes: [16]byte{0: 1, 15: 1}, Valid: true}
+	bobChr   = pgtype.UUID{Bytes: [16]byte{0: 1, 15: 2}, Valid: true}
+)
+
+const (
+	bankEntity    = 1
+	farBank       = 2
+	furnaceEntity = 3
+)
+
+func newTestService(t *testing.T) (*Service, *fakeDB) {
+	database := newFakeDB()
+	for chr, user := range map[pgtype.UUID]string{aliceChr: aliceID, bobChr: bobID} {
+		userID, err := uuid.StringToPgtype(user)
+		require.NoError(t, err)
+		database.Characters[chr] = db.Character{ID: chr, UserID: userID, WorldID: worldID, Name: user[len(user)-1:], X: 10, Y: 10}
+		database.items[chr] = map[int32]int32{}
+	}
+	entities := fakeEntities{
+		bankEntity:    {ID: bankEntity, WorldID: worldID, Type: "bank", X: 11, Y: 10},
+		farBank:       {ID: farBank, WorldID: worldID, Type: "bank", X: 20, Y: 10},
+		furnaceEntity: {ID: furnaceEntity, WorldID: worldID, Type: "furnace", X: 10, Y: 11},
+	}
+	service := NewService(database, entities, testmocks.NopLogger[LoggerInterface]{})
+	service.SetClock(clock.NewFake(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)))
+	config := DefaultConfig()
+	config.PersonalSlots = 2
+	service.SetConfig(config)
+	return service, database
+}
+
+func TestPersonalVault(t *testing.T) {
+	service, _ := newTestService(t)
+	ctx := session.WithWorldID(context.Background(), uuid.PgtypeToString(worldID))
+	alice := uuid.PgtypeToString(aliceChr)
+
+	vaults, err := service.ListVaults(ctx, aliceID, alice)
+	require.NoError(t, err)
+	assert.Empty(t, vaults, "the personal vault is created when first opened")
+
+	_, err = service.OpenVault(ctx, aliceID, alice, farBank, 0)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "banks must be within reach")
+	_, err = service.OpenVault(ctx, aliceID, alice, furnaceEntity, 0)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "only banks hold vaults")
+	_, err = service.OpenVault(ctx, bobID, alice, bankEntity, 0)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	vault, err := service.OpenVault(ctx, aliceID, alice, bankEntity, 0)
+	require.NoError(t, err)
+	assert.Equal(t, bankV1.VaultKind_VAULT_KIND_PERSONAL, vault.Kind)
+	assert.Equal(t, bankV1.VaultTier_VAULT_TIER_MANAGE, vault.Tier)
+	assert.Equal(t, int32(2), vault.Slots)
+	assert.Empty(t, vault.Items)
+
+	_, err = service.OpenVault(ctx, bobID, uuid.PgtypeToString(bobChr), bankEntity, vault.Id)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "personal vaults are their owner's alone")
+
+	vaults, err = service.ListVaults(ctx, aliceID, alice)
+	require.NoError(t, err)
+	require.Len(t, vaults, 1)
+	assert.Equal(t, vault.Id, vaults[0].Id)
+}
+
+func TestDepositAndWithdraw(t *testing.T) {
+	service, database := newTestService(t)
+	ctx := session.WithWorldID(context.Background(), uuid.PgtypeToString(worldID))
+	alice := uuid.PgtypeToString(aliceChr)
+	deposit := func(itemID, quantity int32) (*bankV1.DepositResponse, error) {
+		return service.Deposit(ctx, aliceID, &bankV1.DepositRequest{CharacterId: alice, BankEntityId: bankEntity, ItemId: itemID, Quantity: quantity})
+	}
+
+	_, err := deposit(woodID, 0)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = deposit(99, 1)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = deposit(woodID, 5)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "the character must hold what it deposits")
+
+	database.items[aliceChr][woodID] = 30
+	database.items[aliceChr][stoneID] = 5
+	resp, err := deposit(woodID, 15)
+	require.NoError(t, err)
+	assert.Equal(t, int32(15), database.items[aliceChr][woodID])
+	assert.Equal(t, int32(15), resp.Transaction.Quantity)
+	assert.Equal(t, "Wood", resp.Transaction.ItemName)
+	require.Len(t, resp.Vault.Items, 1)
+	assert.Equal(t, int32(2), resp.Vault.UsedSlots, "15 wood take two stacks of 10")
+
+	_, err = deposit(stoneID, 1)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err), "the vault is full")
+	assert.Equal(t, int32(5), database.items[aliceChr][stoneID], "nothing moves when the vault is full")
+	_, err = deposit(woodID, 5)
+	require.NoError(t, err, "the second stack has room")
+
+	withdraw := func(quantity int32) (*bankV1.WithdrawResponse, error) {
+		return service.Withdraw(ctx, aliceID, &bankV1.WithdrawRequest{CharacterId: alice, BankEntityId: bankEntity, ItemId: woodID, Quantity: quantity})
+	}
+	_, err = withdraw(21)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	database.full[aliceChr] = true
+	_, err = withdraw(5)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	database.full[aliceChr] = false
+	withdrawn, err := withdraw(20)
+	require.NoError(t, err)
+	assert.Equal(t, int32(-20), withdrawn.Transaction.Quantity)
+	assert.Empty(t, withdrawn.Vault.Items)
+	assert.Equal(t, int32(30), database.items[aliceChr][woodID])
+
+	transactions, err := service.ListTransactions(ctx, aliceID, alice, 0, 0, 2)
+	require.NoError(t, err)
+	require.Len(t, transactions, 2, "newest first")
+	assert.Equal(t, int32(-20), transactions[0].Quantity)
+	assert.Equal(t, int32(5), transactions[1].Quantity)
+	older, err := service.ListTransactions(ctx, aliceID, alice, 0, transactions[1].Id, 0)
+	require.NoError(t, err)
+	require.Len(t, older, 1)
+	assert.Equal(t, int32(15), older[0].Quantity)
+}
+
+func TestGuildVault(t *testing.T) {
+	service, database := newTestService(t)
+	ctx := session.WithWorldID(context.Background(), uuid.PgtypeToString(worldID))
+	alice, bob := uuid.PgtypeToString(aliceChr), uuid.PgtypeToString(bobChr)
+
+	_, err := service.CreateGuildVault(ctx, aliceID, alice, bankEntity, "  ")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	vault, err := service.CreateGuildVault(ctx, aliceID, alice, bankEntity, " Miners ")
+	require.NoError(t, err)
+	assert.Equal(t, "Miners", vault.Name)
+	assert.Equal(t, bankV1.VaultKind_VAULT_KIND_GUILD, vault.Kind)
+	assert.Equal(t, int32(DefaultConfig().GuildSlots), vault.Slots)
+	_, err = service.CreateGuildVault(ctx, bobID, bob, bankEntity, "miners")
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	_, err = service.CreateGuildVault(ctx, aliceID, alice, bankEntity, "Smiths")
+	require.NoError(t, err)
+	_, err = service.CreateGuildVault(ctx, aliceID, alice, bankEntity, "Masons")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "the guild vault limit applies")
+
+	database.items[bobChr][stoneID] = 10
+	deposit := &bankV1.DepositRequest{CharacterId: bob, BankEntityId: bankEntity, VaultId: vault.Id, ItemId: stoneID, Quantity: 4}
+	_, err = service.Deposit(ctx, bobID, deposit)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "only members use guild vaults")
+
+	setBob := func(by, character string, tier bankV1.VaultTier) (*bankV1.SetVaultMemberResponse, error) {
+		return service.SetMember(ctx, by, &bankV1.SetVaultMemberRequest{CharacterId: character, VaultId: vault.Id, MemberCharacterId: bob, Tier: tier})
+	}
+	set, err := setBob(aliceID, alice, bankV1.VaultTier_VAULT_TIER_DEPOSIT)
+	require.NoError(t, err)
+	assert.Equal(t, "b", set.Member.CharacterName)
+	_, err = setBob(bobID, bob, bankV1.VaultTier_VAULT_TIER_MANAGE)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "nobody changes their own tier")
+	_, err = service.SetMember(ctx, bobID, &bankV1.SetVaultMemberRequest{CharacterId: bob, VaultId: vault.Id, MemberCharacterId: alice})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "only managers manage members")
+	_, err = setBob(aliceID, alice, bankV1.VaultTier(9))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = service.Deposit(ctx, bobID, deposit)
+	require.NoError(t, err)
+	withdraw := &bankV1.WithdrawRequest{CharacterId: bob, BankEntityId: bankEntity, VaultId: vault.Id, ItemId: stoneID, Quantity: 1}
+	_, err = service.Withdraw(ctx, bobID, withdraw)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "depositors cannot withdraw")
+	_, err = setBob(aliceID, alice, bankV1.VaultTier_VAULT_TIER_WITHDRAW)
+	require.NoError(t, err)
+	_, err = service.Withdraw(ctx, bobID, withdraw)
+	require.NoError(t, err)
+
+	vaults, err := service.ListVaults(ctx, bobID, bob)
+	require.NoError(t, err)
+	require.Len(t, vaults, 1)
+	assert.Equal(t, bankV1.VaultTier_VAULT_TIER_WITHDRAW, vaults[0].Tier)
+	members, err := service.ListMembers(ctx, bobID, bob, vault.Id)
+	require.NoError(t, err)
+	assert.Len(t, members, 2)
+	transactions, err := service.ListTransactions(ctx, aliceID, alice, vault.Id, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, transactions, 2)
+	assert.Equal(t, bob, transactions[0].CharacterId)
+
+	removed, err := setBob(aliceID, alice, bankV1.VaultTier_VAULT_TIER_UNSPECIFIED)
+	require.NoError(t, err)
+	assert.True(t, removed.Removed)
+	_, err = service.ListTransactions(ctx, bobID, bob, vault.Id, 0, 0)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -0,0 +1,262 @@
+package bank
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/entity"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/txn"
+	"github.com/VoidMesh/api/api/services/inventory"
+	"github.com/charmbracelet/log"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DatabaseInterface abstracts database operations for banks.
+type DatabaseInterface interface {
+	GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error)
+	GetItem(ctx context.Context, id int32) (db.Item, error)
+	GetBankVault(ctx context.Context, id int64) (db.BankVault, error)
+	GetPersonalBankVault(ctx context.Context, ownerID pgtype.UUID) (db.BankVault, error)
+	CreatePersonalBankVault(ctx context.Context, arg db.CreatePersonalBankVaultParams) (db.BankVault, error)
+	ListGuildBankVaultsByMember(ctx context.Context, characterID pgtype.UUID) ([]db.ListGuildBankVaultsByMemberRow, error)
+	ListBankVaultItems(ctx context.Context, vaultID int64) ([]db.ListBankVaultItemsRow, error)
+	GetBankVaultMember(ctx context.Context, arg db.GetBankVaultMemberParams) (db.BankVaultMember, error)
+	ListBankVaultMembers(ctx context.Context, vaultID int64) ([]db.ListBankVaultMembersRow, error)
+	UpsertBankVaultMember(ctx context.Context, arg db.UpsertBankVaultMemberParams) (db.BankVaultMember, error)
+	DeleteBankVaultMember(ctx context.Context, arg db.DeleteBankVaultMemberParams) (int64, error)
+	ListBankTransactions(ctx context.Context, arg db.ListBankTransactionsParams) ([]db.ListBankTransactionsRow, error)
+	CreateGuildVault(ctx context.Context, vault db.CreateGuildBankVaultParams, maxVaults int64) (db.BankVault, error)
+	Deposit(ctx context.Context, move Move) (db.BankTransaction, error)
+	Withdraw(ctx context.Context, move Move) (db.BankTransaction, error)
+}
+
+// EntityStore loads banks from the world entities.
+type EntityStore interface {
+	Get(ctx context.Context, id int64) (*entity.Entity, error)
+}
+
+// Move is items moved between a character's inventory and a vault
+type Move struct {
+	VaultID   int64
+	Character db.Character
+	Item      db.Item
+	Quantity  int32
+	Slots     int32 // Of the vault; deposits that do not fit fail with ErrVaultFull
+	Now       time.Time
+}
+
+// DatabaseWrapper implements DatabaseInterface using the actual database connection.
+type DatabaseWrapper struct {
+	pool    storage.Pool
+	queries *db.Queries
+}
+
+// NewDatabaseWrapper creates a new database wrapper with the given connection pool.
+func NewDatabaseWrapper(pool storage.Pool) DatabaseInterface {
+	return &DatabaseWrapper{
+		pool:    pool,
+		queries: db.New(pool),
+	}
+}
+
+func (d *DatabaseWrapper) GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error) {
+	return d.queries.GetCharacterById(ctx, id)
+}
+
+func (d *DatabaseWrapper) GetItem(ctx context.Context, id int32) (db.Item, error) {
+	return d.queries.GetItem(ctx, id)
+}
+
+func (d *DatabaseWrapper) GetBankVault(ctx context.Context, id int64) (db.BankVault, error) {
+	return d.queries.GetBankVault(ctx, id)
+}
+
+func (d *DatabaseWrapper) GetPersonalBankVault(ctx context.Context, ownerID pgtype.UUID) (db.BankVault, error) {
+	return d.queries.GetPersonalBankVault(ctx, ownerID)
+}
+
+func (d *DatabaseWrapper) CreatePersonalBankVault(ctx context.Context, arg db.CreatePersonalBankVaultParams) (db.BankVault, error) {
+	return d.queries.CreatePersonalBankVault(ctx, arg)
+}
+
+func (d *DatabaseWrapper) ListGuildBankVaultsByMember(ctx context.Context, characterID pgtype.UUID) ([]db.ListGuildBankVaultsByMemberRow, error) {
+	return d.queries.ListGuildBankVaultsByMember(ctx, characterID)
+}
+
+func (d *DatabaseWrapper) ListBankVaultItems(ctx context.Context, vaultID int64) ([]db.ListBankVaultItemsRow, error) {
+	return d.queries.ListBankVaultItems(ctx, vaultID)
+}
+
+func (d *DatabaseWrapper) GetBankVaultMember(ctx context.Context, arg db.GetBankVaultMemberParams) (db.BankVaultMember, error) {
+	return d.queries.GetBankVaultMember(ctx, arg)
+}
+
+func (d *DatabaseWrapper) ListBankVaultMembers(ctx context.Context, vaultID int64) ([]db.ListBankVaultMembersRow, error) {
+	return d.queries.ListBankVaultMembers(ctx, vaultID)
+}
+
+func (d *DatabaseWrapper) UpsertBankVaultMember(ctx context.Context, arg db.UpsertBankVaultMemberParams) (db.BankVaultMember, error) {
+	return d.queries.UpsertBankVaultMember(ctx, arg)
+}
+
+func (d *DatabaseWrapper) DeleteBankVaultMember(ctx context.Context, arg db.DeleteBankVaultMemberParams) (int64, error) {
+	return d.queries.DeleteBankVaultMember(ctx, arg)
+}
+
+func (d *DatabaseWrapper) ListBankTransactions(ctx context.Context, arg db.ListBankTransactionsParams) ([]db.ListBankTransactionsRow, error) {
+	return d.queries.ListBankTransactions(ctx, arg)
+}
+
+// CreateGuildVault stores a guild vault with its founder as manager, failing with
+// ErrTooManyVaults when the founder has founded maxVaults already and ErrNameTaken when
+// the world has a guild vault of that name
+func (d *DatabaseWrapper) CreateGuildVault(ctx context.Context, vault db.CreateGuildBankVaultParams, maxVaults int64) (db.BankVault, error) {
+	var created db.BankVault
+	err := txn.Run(ctx, d.pool, txn.Serializable, func(q *db.Queries) error {
+		count, err := q.CountGuildBankVaultsCreatedBy(ctx, vault.CreatedBy)
+		if err != nil {
+			return fmt.Errorf("failed to count vaults: %w", err)
+		}
+		if count >= maxVaults {
+			return ErrTooManyVaults
+		}
+		created, err = q.CreateGuildBankVault(ctx, vault)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNameTaken
+		}
+		if err != nil {
+			return fmt.Errorf("failed to create vault: %w", err)
+		}
+		_, err = q.UpsertBankVaultMember(ctx, db.UpsertBankVaultMemberParams{
+			VaultID:     created.ID,
+			CharacterID: vault.CreatedBy,
+			Tier:        tierManage,
+			AddedAt:     vault.CreatedAt,
+		})
+		return err
+	})
+	return created, err
+}
+
+// Deposit takes the items from the character's inventory and stores them in the vault,
+// failing with ErrInsufficientItems or ErrVaultFull, moving nothing
+func (d *DatabaseWrapper) Deposit(ctx context.Context, move Move) (db.BankTransaction, error) {
+	var logged db.BankTransaction
+	err := txn.Run(ctx, d.pool, txn.Serializable, func(q *db.Queries) error {
+		_, err := q.RemoveInventoryItemQuantity(ctx, db.RemoveInventoryItemQuantityParams{
+			CharacterID: move.Character.ID,
+			ItemID:      move.Item.ID,
+			Quantity:    move.Quantity,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrInsufficientItems
+		}
+		if err != nil {
+			return fmt.Errorf("failed to take item %d: %w", move.Item.ID, err)
+		}
+		if err := q.DeleteEmptyInventoryItems(ctx, move.Character.ID); err != nil {
+			return err
+		}
+		items, err := q.ListBankVaultItems(ctx, move.VaultID)
+		if err != nil {
+			return fmt.Errorf("failed to list vault items: %w", err)
+		}
+		if !fits(items, move.Item, move.Quantity, move.Slots) {
+			return ErrVaultFull
+		}
+		if _, err := q.AddBankVaultItem(ctx, db.AddBankVaultItemParams{VaultID: move.VaultID, ItemID: move.Item.ID, Quantity: move.Quantity}); err != nil {
+			return fmt.Errorf("failed to store item %d: %w", move.Item.ID, err)
+		}
+		logged, err = logInTx(ctx, q, move, move.Quantity)
+		return err
+	})
+	return logged, err
+}
+
+// Withdraw takes the items from the vault and grants them to the character, failing with
+// ErrInsufficientItems or inventory.ErrInventoryFull, moving nothing
+func (d *DatabaseWrapper) Withdraw(ctx context.Context, move Move) (db.BankTransaction, error) {
+	var logged db.BankTransaction
+	err := txn.Run(ctx, d.pool, txn.Serializable, func(q *db.Queries) error {
+		_, err := q.RemoveBankVaultItemQuantity(ctx, db.RemoveBankVaultItemQuantityParams{
+			VaultID:  move.VaultID,
+			ItemID:   move.Item.ID,
+			Quantity: move.Quantity,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrInsufficientItems
+		}
+		if err != nil {
+			return fmt.Errorf("failed to take item %d: %w", move.Item.ID, err)
+		}
+		if err := q.DeleteEmptyBankVaultItems(ctx, move.VaultID); err != nil {
+			return err
+		}
+		grant := inventory.Grant{ItemID: move.Item.ID, StackSize: move.Item.StackSize, Quantity: move.Quantity}
+		if _, err := inventory.GrantAllInTx(ctx, q, move.Character, []inventory.Grant{grant}); err != nil {
+			return err
+		}
+		logged, err = logInTx(ctx, q, move, -move.Quantity)
+		return err
+	})
+	return logged, err
+}
+
+// logInTx records a deposit (positive quantity) or withdrawal (negative)
+func logInTx(ctx context.Context, q *db.Queries, move Move, quantity int32) (db.BankTransaction, error) {
+	logged, err := q.CreateBankTransaction(ctx, db.CreateBankTransactionParams{
+		VaultID:     move.VaultID,
+		CharacterID: move.Character.ID,
+		ItemID:      move.Item.ID,
+		Quantity:    quantity,
+		CreatedAt:   pgtype.Timestamp{Time: move.Now, Valid: true},
+	})
+	if err != nil {
+		return db.BankTransaction{}, fmt.Errorf("failed to log transaction: %w", err)
+	}
+	return logged, nil
+}
+
+// LoggerInterface abstracts logging operations for dependency injection.
+type LoggerInterface interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+	With(keysAndValues ...interface{}) LoggerInterface
+}
+
+// DefaultLoggerWrapper wraps the internal logging package.
+type DefaultLoggerWrapper struct {
+	logger *log.Logger
+}
+
+func NewDefaultLoggerWrapper() LoggerInterface {
+	return &DefaultLoggerWrapper{logger: logging.GetLogger()}
+}
+
+func (l *DefaultLoggerWrapper) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.Debug(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Info(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.Warn(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.Error(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) With(keysAndValues ...interface{}) LoggerInterface {
+	return &DefaultLoggerWrapper{logger: l.logger.With(keysAndValues...)}
+}
@@ -0,0 +1,362 @@
+// Package calendar runs time-bounded seasonal events defined in a data file. While an
+// event is active its modifiers apply: harvest yields and resource density are scaled,
+// and its resource types are registered as a resource node provider so they spawn in
+// newly generated chunks. Every instance evaluates the calendar on its own clock and
+// broadcasts the start and end of each event to its notification streams.
+package calendar
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/alerting"
+	"github.com/VoidMesh/api/api/internal/clock"
+	notificationV1 "github.com/VoidMesh/api/api/proto/notification/v1"
+	"github.com/VoidMesh/api/api/services/notification"
+	"github.com/VoidMesh/api/api/services/resource_node"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultRefreshInterval is how often the calendar is checked for events starting or ending
+const DefaultRefreshInterval = time.Minute
+
+// RepeatYearly makes an event recur on the same dates every year
+const RepeatYearly = "yearly"
+
+// Notification states, in the notification's "state" data
+const (
+	StateStarted = "started"
+	StateEnded   = "ended"
+)
+
+// Calendar is the list of seasonal events read from a data file:
+//
+//	events:
+//	  - id: winter_festival
+//	    name: Winter Festival
+//	    description: Frost blooms appear and harvests yield double.
+//	    starts_at: 2026-12-20T00:00:00Z
+//	    ends_at: 2027-01-03T00:00:00Z
+//	    repeat: yearly
+//	    yield_multiplier: 2
+//	    resource_density: 1.25
+//	    resource_types: [...] # resource pack format, ids from 1000
+type Calendar struct {
+	Events []Event `yaml:"events"`
+}
+
+// Event is a time-bounded event and the modifiers it applies while active
+type Event struct {
+	ID              string    `yaml:"id"`
+	Name            string    `yaml:"name"`
+	Description     string    `yaml:"description"`
+	StartsAt        time.Time `yaml:"starts_at"`
+	EndsAt          time.Time `yaml:"ends_at"`
+	Repeat          string    `yaml:"repeat"`           // Empty or "yearly"
+	YieldMultiplier float64   `yaml:"yield_multiplier"` // Scales harvest quantities; 0 leaves them
+	ResourceDensity float64   `yaml:"resource_density"` // Scales resource nodes per new chunk; 0 leaves it
+	// Spawned in newly generated chunks while the event is active
+	ResourceTypes []resource_node.ResourceTypeConfig `yaml:"resource_types"`
+}
+
+// Modifiers are the combined modifiers of the active events
+type Modifiers struct {
+	YieldMultiplier float64
+	ResourceDensity float64
+}
+
+// ActiveEvent is an event in progress and the end of its current occurrence
+type ActiveEvent struct {
+	Event
+	EndsAt time.Time
+}
+
+// ParseCalendar decodes and validates a calendar. Unknown fields are rejected so typos
+// in data files fail loudly instead of being ignored.
+func ParseCalendar(data []byte) (*Calendar, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	var cal Calendar
+	if err := decoder.Decode(&cal); err != nil {
+		return nil, fmt.Errorf("failed to decode calendar: %w", err)
+	}
+	if err := cal.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid calendar: %w", err)
+	}
+	return &cal, nil
+}
+
+// LoadCalendarFile reads and validates the calendar at path
+func LoadCalendarFile(path string) (*Calendar, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read calendar %s: %w", path, err)
+	}
+	return ParseCalendar(data)
+}
+
+// Validate checks that every event is complete and that event ids are unique
+func (c *Calendar) Validate() error {
+	var errs []error
+	seenIDs := make(map[string]bool, len(c.Events))
+	for i, e := range c.Events {
+		prefix := fmt.Sprintf("events[%d]", i)
+		if e.ID == "" {
+			errs = append(errs, fmt.Errorf("%s is missing an id", prefix))
+		}
+		if seenIDs[e.ID] {
+			errs = append(errs, fmt.Errorf("%s duplicates id %q", prefix, e.ID))
+		}
+		seenIDs[e.ID] = true
+		if e.Name == "" {
+			errs = append(errs, fmt.Errorf("%s is missing a name", prefix))
+		}
+		if !e.EndsAt.After(e.StartsAt) {
+			errs = append(errs, fmt.Errorf("%s must end after it starts", prefix))
+		}
+		switch e.Repeat {
+		case "":
+		case RepeatYearly:
+			if !e.EndsAt.Before(e.StartsAt.AddDate(1, 0, 0)) {
+				errs = append(errs, fmt.Errorf("%s repeats yearly, so it must last less than a year", prefix))
+			}
+		default:
+			errs = append(errs, fmt.Errorf("%s has unknown repeat %q", prefix, e.Repeat))
+		}
+		if e.YieldMultiplier < 0 || e.ResourceDensity < 0 {
+			errs = append(errs, fmt.Errorf("%s must not have negative yield_multiplier or resource_density", prefix))
+		}
+		if len(e.ResourceTypes) > 0 {
+			if err := e.resourcePack().Validate(); err != nil {
+				errs = append(errs, fmt.Errorf("%s has invalid resource_types: %w", prefix, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// occurrence returns the end of the occurrence of the event in progress at t
+func (e Event) occurrence(t time.Time) (time.Time, bool) {
+	if e.Repeat != RepeatYearly {
+		return e.EndsAt, !t.Before(e.StartsAt) && t.Before(e.EndsAt)
+	}
+	// The occurrence in progress started this year or, for events spanning new year, last year
+	years := t.Year() - e.StartsAt.Year()
+	for _, offset := range []int{years, years - 1} {
+		if offset < 0 {
+			continue
+		}
+		start, end := e.StartsAt.AddDate(offset, 0, 0), e.EndsAt.AddDate(offset, 0, 0)
+		if !t.Before(start) && t.Before(end) {
+			return end, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// resourcePack is the provider registered for the event's resource types
+func (e Event) resourcePack() *resource_node.ResourcePack {
+	return &resource_node.ResourcePack{PackName: "event:" + e.ID, Types: e.ResourceTypes}
+}
+
+// Service activates and deactivates the calendar's events and serves their modifiers.
+type Service struct {
+	events        []Event
+	logger        LoggerInterface
+	clock         clock.Clock
+	interval      time.Duration
+	broadcaster   BroadcasterInterface  // Nil disables announcements
+	resourceNodes ResourceNodeInterface // Nil disables generation modifiers
+
+	mu        sync.RWMutex
+	active    map[string]time.Time // End of the current occurrence, by event id
+	modifiers Modifiers
+	refreshed bool
+}
+
+// NewService creates a calendar service for the given events; none are active until
+// the first Refresh.
+func NewService(cal *Calendar, logger LoggerInterface) *Service {
+	componentLogger := logger.With("component", "calendar-service")
+	componentLogger.Debug("Creating new calendar service", "events", len(cal.Events))
+	return &Service{
+		events:    cal.Events,
+		logger:    componentLogger,
+		clock:     clock.New(),
+		interval:  DefaultRefreshInterval,
+		active:    make(map[string]time.Time),
+		modifiers: Modifiers{YieldMultiplier: 1, ResourceDensity: 1},
+	}
+}
+
+// SetClock replaces the clock events are scheduled by (for simulation tests)
+func (s *Service) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// SetBroadcaster announces events starting and ending on open notification streams
+func (s *Service) SetBroadcaster(broadcaster BroadcasterInterface) {
+	s.broadcaster = broadcaster
+}
+
+// SetResourceNodes applies the resource density and resource types of active events to generation
+func (s *Service) SetResourceNodes(resourceNodes ResourceNodeInterface) {
+	s.resourceNodes = resourceNodes
+}
+
+// Modifiers returns the combined modifiers of the active events
+func (s *Service) Modifiers() Modifiers {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.modifiers
+}
+
+// YieldMultiplier returns the factor harvest quantities are scaled by
+func (s *Service) YieldMultiplier() float64 {
+	return s.Modifiers().YieldMultiplier
+}
+
+// YieldMultiplierAt returns the factor harvests completed at t are scaled by, from the
+// events scheduled at t rather than those last refreshed. Harvests credited before they
+// arrived keep the modifiers of an event that ended in between.
+func (s *Service) YieldMultiplierAt(t time.Time) float64 {
+	multiplier := 1.0
+	for _, e := range s.events {
+		if _, ok := e.occurrence(t); ok && e.YieldMultiplier > 0 {
+			multiplier *= e.YieldMultiplier
+		}
+	}
+	return multiplier
+}
+
+// Active returns the events in progress, ending soonest first
+func (s *Service) Active() []ActiveEvent {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var active []ActiveEvent
+	for _, e := range s.events {
+		if endsAt, ok := s.active[e.ID]; ok {
+			active = append(active, ActiveEvent{Event: e, EndsAt: endsAt})
+		}
+	}
+	sort.SliceStable(active, func(i, j int) bool { return active[i].EndsAt.Before(active[j].EndsAt) })
+	return active
+}
+
+// Refresh activates the events that have started and deactivates those that have
+// ended. Events already in progress at the first refresh are applied without being
+// announced, so restarts do not repeat announcements.
+func (s *Service) Refresh() error {
+	now := s.clock.Now()
+
+	s.mu.Lock()
+	announce := s.refreshed
+	s.refreshed = true
+	var started, ended []Event
+	active := make(map[string]time.Time, len(s.active))
+	modifiers := Modifiers{YieldMultiplier: 1, ResourceDensity: 1}
+	for _, e := range s.events {
+		endsAt, ok := e.occurrence(now)
+		if !ok {
+			if _, was := s.active[e.ID]; was {
+				ended = append(ended, e)
+			}
+			continue
+		}
+		if _, was := s.active[e.ID]; !was {
+			started = append(started, e)
+		}
+		active[e.ID] = endsAt
+		if e.YieldMultiplier > 0 {
+			modifiers.YieldMultiplier *= e.YieldMultiplier
+		}
+		if e.ResourceDensity > 0 {
+			modifiers.ResourceDensity *= e.ResourceDensity
+		}
+	}
+	s.active = active
+	s.modifiers = modifiers
+	s.mu.Unlock()
+
+	if len(started) == 0 && len(ended) == 0 {
+		return nil
+	}
+
+	var errs []error
+	changedTypes := false
+	for _, e := range ended {
+		s.logger.Info("Seasonal event ended", "event_id", e.ID)
+		if len(e.ResourceTypes) > 0 {
+			resource_node.UnregisterProvider(e.resourcePack().Name())
+			changedTypes = true
+		}
+	}
+	for _, e := range started {
+		s.logger.Info("Seasonal event started", "event_id", e.ID, "ends_at", active[e.ID])
+		if len(e.ResourceTypes) > 0 {
+			if err := resource_node.RegisterProvider(e.resourcePack()); err != nil {
+				errs = append(errs, fmt.Errorf("failed to register resource types of event %s: %w", e.ID, err))
+				continue
+			}
+			changedTypes = true
+		}
+	}
+	if s.resourceNodes != nil {
+		s.resourceNodes.SetEventDensity(modifiers.ResourceDensity)
+		if changedTypes {
+			s.resourceNodes.RefreshProviders()
+		}
+	}
+
+	if announce && s.broadcaster != nil {
+		for _, e := range ended {
+			s.broadcaster.Broadcast(eventNotification(e, StateEnded, time.Time{}))
+		}
+		for _, e := range started {
+			s.broadcaster.Broadcast(eventNotification(e, StateStarted, active[e.ID]))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Run refreshes the calendar until the context is cancelled
+func (s *Service) Run(ctx context.Context) {
+	s.logger.Info("Calendar refresh started", "interval", s.interval, "events", len(s.events))
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("Calendar refresh stopped")
+			return
+		case <-s.clock.After(s.interval):
+		}
+
+		if err := s.Refresh(); err != nil {
+			s.logger.Error("Calendar refresh failed", "error", err)
+			alerting.ReportJobError("calendar_refresh", err)
+		}
+	}
+}
+
+func eventNotification(e Event, state string, endsAt time.Time) notification.Notification {
+	n := notification.Notification{
+		Type: notificationV1.NotificationType_NOTIFICATION_TYPE_SEASONAL_EVENT,
+		Data: map[string]string{"event_id": e.ID, "state": state},
+	}
+	switch state {
+	case StateStarted:
+		n.Title = e.Name + " has begun"
+		n.Body = e.Description
+		n.Data["ends_at"] = endsAt.UTC().Format(time.RFC3339)
+	case StateEnded:
+		n.Title = e.Name + " is over"
+	}
+	return n
+}
@@ -0,0 +1,157 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	notificationV1 "github.com/VoidMesh/api/api/proto/notification/v1"
+	"github.com/VoidMesh/api/api/services/notification"
+	"github.com/VoidMesh/api/api/services/resource_node"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeBroadcaster keeps broadcast notifications
+type fakeBroadcaster struct {
+	sent []notification.Notification
+}
+
+func (f *fakeBroadcaster) Broadcast(n notification.Notification) int {
+	f.sent = append(f.sent, n)
+	return 1
+}
+
+// fakeResourceNodes records the generation modifiers applied
+type fakeResourceNodes struct {
+	density   float64
+	refreshes int
+	types     []resource_node.ResourceTypeConfig
+}
+
+func (f *fakeResourceNodes) SetEventDensity(density float64) { f.density = density }
+
+func (f *fakeResourceNodes) RefreshProviders() {
+	f.refreshes++
+	f.types = resource_node.ProvidedResourceTypes()
+}
+
+const testCalendar = `
+events:
+  - id: winter_festival
+    name: Winter Festival
+    description: Frost blooms appear and harvests yield double.
+    starts_at: 2025-12-20T00:00:00Z
+    ends_at: 2026-01-03T00:00:00Z
+    repeat: yearly
+    yield_multiplier: 2
+    resource_density: 1.5
+    resource_types:
+      - id: 1001
+        name: Frost Bloom
+        terrain_type: grass
+        rarity: rare
+        harvest_time: 2
+        respawn_time: 600
+        yield_min: 1
+        yield_max: 2
+  - id: launch_weekend
+    name: Launch Weekend
+    starts_at: 2026-01-02T00:00:00Z
+    ends_at: 2026-01-05T00:00:00Z
+    yield_multiplier: 1.5
+`
+
+func newTestService(t *testing.T, start time.Time) (*Service, *clock.Fake, *fakeBroadcaster, *fakeResourceNodes) {
+	t.Helper()
+	cal, err := ParseCalendar([]byte(testCalendar))
+	require.NoError(t, err)
+	service := NewService(cal, testmocks.NopLogger[LoggerInterface]{})
+	fakeClock := clock.NewFake(start)
+	service.SetClock(fakeClock)
+	broadcaster := &fakeBroadcaster{}
+	service.SetBroadcaster(broadcaster)
+	resourceNodes := &fakeResourceNodes{density: 1}
+	service.SetResourceNodes(resourceNodes)
+	t.Cleanup(func() { resource_node.UnregisterProvider("event:winter_festival") })
+	return service, fakeClock, broadcaster, resourceNodes
+}
+
+func TestParseCalendar_Invalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		calendar    string
+		errContains string
+	}{
+		{"unknown field", "events: []\nseason: winter\n", "field season not found"},
+		{"missing id", "events:\n  - name: A\n    starts_at: 2026-01-01T00:00:00Z\n    ends_at: 2026-01-02T00:00:00Z\n", "missing an id"},
+		{"ends before start", "events:\n  - id: a\n    name: A\n    starts_at: 2026-01-02T00:00:00Z\n    ends_at: 2026-01-01T00:00:00Z\n", "must end after it starts"},
+		{"yearly event over a year", "events:\n  - id: a\n    name: A\n    repeat: yearly\n    starts_at: 2026-01-01T00:00:00Z\n    ends_at: 2027-02-01T00:00:00Z\n", "less than a year"},
+		{"unknown repeat", "events:\n  - id: a\n    name: A\n    repeat: weekly\n    starts_at: 2026-01-01T00:00:00Z\n    ends_at: 2026-01-02T00:00:00Z\n", `unknown repeat "weekly"`},
+		{"built-in resource type id", "events:\n  - id: a\n    name: A\n    starts_at: 2026-01-01T00:00:00Z\n    ends_at: 2026-01-02T00:00:00Z\n    resource_types:\n      - {id: 1, name: Herb, terrain_type: grass, rarity: common, harvest_time: 1, respawn_time: 1, yield_min: 1, yield_max: 1}\n", "provided ids start at 1000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseCalendar([]byte(tt.calendar))
+			assert.ErrorContains(t, err, tt.errContains)
+		})
+	}
+}
+
+func TestService_Refresh(t *testing.T) {
+	service, fakeClock, broadcaster, resourceNodes := newTestService(t, time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC))
+
+	require.NoError(t, service.Refresh())
+	assert.Empty(t, service.Active())
+	assert.Equal(t, Modifiers{YieldMultiplier: 1, ResourceDensity: 1}, service.Modifiers())
+
+	t.Run("a yearly event starts again the next year", func(t *testing.T) {
+		fakeClock.Set(time.Date(2026, 12, 20, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, service.Refresh())
+
+		active := service.Active()
+		require.Len(t, active, 1)
+		assert.Equal(t, "winter_festival", active[0].ID)
+		assert.Equal(t, time.Date(2027, 1, 3, 0, 0, 0, 0, time.UTC), active[0].EndsAt)
+		assert.Equal(t, 2.0, service.YieldMultiplier())
+		assert.Equal(t, 2.0, service.YieldMultiplierAt(time.Date(2027, 1, 3, 0, 0, 0, -1, time.UTC)))
+		assert.Equal(t, 1.0, service.YieldMultiplierAt(time.Date(2027, 1, 3, 0, 0, 0, 0, time.UTC)), "the event ends")
+		assert.Equal(t, 1.5, resourceNodes.density)
+		require.Len(t, resourceNodes.types, 1, "the event's resource types are provided")
+		assert.Equal(t, int32(1001), resourceNodes.types[0].ID)
+
+		require.Len(t, broadcaster.sent, 1)
+		assert.Equal(t, notificationV1.NotificationType_NOTIFICATION_TYPE_SEASONAL_EVENT, broadcaster.sent[0].Type)
+		assert.Equal(t, "Winter Festival has begun", broadcaster.sent[0].Title)
+		assert.Equal(t, StateStarted, broadcaster.sent[0].Data["state"])
+		assert.Equal(t, "2027-01-03T00:00:00Z", broadcaster.sent[0].Data["ends_at"])
+	})
+
+	t.Run("an event ending is announced and its modifiers removed", func(t *testing.T) {
+		fakeClock.Set(time.Date(2027, 1, 3, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, service.Refresh())
+
+		assert.Empty(t, service.Active())
+		assert.Equal(t, 1.0, service.YieldMultiplier())
+		assert.Equal(t, 1.0, resourceNodes.density)
+		assert.Empty(t, resourceNodes.types)
+		require.Len(t, broadcaster.sent, 2)
+		assert.Equal(t, "Winter Festival is over", broadcaster.sent[1].Title)
+		assert.Equal(t, StateEnded, broadcaster.sent[1].Data["state"])
+	})
+}
+
+func TestService_Refresh_OverlappingEvents(t *testing.T) {
+	service, _, broadcaster, resourceNodes := newTestService(t, time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC))
+
+	require.NoError(t, service.Refresh())
+	assert.Empty(t, broadcaster.sent, "events in progress at startup are not announced")
+	assert.Equal(t, Modifiers{YieldMultiplier: 3, ResourceDensity: 1.5}, service.Modifiers(), "modifiers of overlapping events multiply")
+	assert.Equal(t, 1, resourceNodes.refreshes)
+
+	active := service.Active()
+	require.Len(t, active, 2)
+	assert.Equal(t, "winter_festival", active[0].ID, "ending soonest first")
+	assert.Equal(t, "launch_weekend", active[1].ID)
+}
@@ -6,12 +6,17 @@ import (
 	"time"
 
 	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/debugstats"
+	"github.com/VoidMesh/api/api/internal/geometry"
 	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/storage"
 	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
 	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
 	"github.com/VoidMesh/api/api/services/chunk"
+	"github.com/VoidMesh/api/api/services/cosmetic"
 	"github.com/jackc/pgx/v5/pgtype"
-	"github.com/jackc/pgx/v5/pgxpool"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -20,39 +25,87 @@ import (
 // ChunkServiceInterface defines the interface for chunk service operations
 type ChunkServiceInterface interface {
 	GetOrCreateChunk(ctx context.Context, chunkX, chunkY int32) (*chunkV1.ChunkData, error)
+	GetOrCreateChunkInWorld(ctx context.Context, worldID pgtype.UUID, chunkX, chunkY int32) (*chunkV1.ChunkData, error)
+	// IsPassable checks a cell against its chunk's passability mask, without loading the chunk's cells
+	IsPassable(ctx context.Context, worldID pgtype.UUID, x, y int32) (bool, error)
+}
+
+// StatusEffectSource reports the active status effects of characters
+type StatusEffectSource interface {
+	Active(ctx context.Context, characterIDs []pgtype.UUID) (map[[16]byte][]*characterV1.StatusEffect, error)
+	Multiplier(ctx context.Context, characterID pgtype.UUID, effectType characterV1.StatusEffectType) float64
 }
 
 type Service struct {
 	db           DatabaseInterface
 	chunkService ChunkServiceInterface
-	chunkSize    int32
+	clock        clock.Clock
+	nearby       *NearbyEvents
+	recorders    []MoveRecorder
+	prefetcher   ChunkPrefetcher
+	entities     EntityFinder
+	positions    *PositionBuffer
+	effects      StatusEffectSource
+	interiors    InteriorSource
+	dungeons     DungeonSource
+	moves        *moveTimes
+
+	renameCooldown time.Duration
 }
 
 func NewService(db DatabaseInterface, chunkService ChunkServiceInterface) *Service {
 	logger := logging.GetLogger()
 	logger.Debug("Creating new character service", "chunk_size", chunk.ChunkSize)
+	moves := newMoveTimes()
+	debugstats.Register(debugstats.CacheEntries, "character.movement_cooldowns", func() int64 {
+		return int64(moves.len())
+	})
 	return &Service{
-		db:           db,
-		chunkService: chunkService,
-		chunkSize:    chunk.ChunkSize,
+		db:             db,
+		chunkService:   chunkService,
+		clock:          clock.New(),
+		moves:          moves,
+		renameCooldown: DefaultRenameCooldown,
 	}
 }
 
+// SetClock replaces the clock used for movement cooldowns (for simulation tests)
+func (s *Service) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// SetPositionBuffer makes moves within a chunk write behind through the buffer. Reads of
+// the service see the buffered positions.
+func (s *Service) SetPositionBuffer(positions *PositionBuffer) {
+	s.positions = positions
+	s.db = &bufferedDatabase{DatabaseInterface: s.db, positions: positions}
+}
+
+// SetStatusEffects includes active status effects in character responses and scales the
+// movement cooldown by movement speed effects
+func (s *Service) SetStatusEffects(effects StatusEffectSource) {
+	s.effects = effects
+}
+
 // NewServiceWithPool creates a service with a database pool (convenience constructor for production use)
-func NewServiceWithPool(pool *pgxpool.Pool, chunkService ChunkServiceInterface) *Service {
+func NewServiceWithPool(pool storage.Pool, chunkService ChunkServiceInterface) *Service {
 	return NewService(NewDatabaseWrapper(pool), chunkService)
 }
 
 // Helper function to convert DB character to proto character
 func (s *Service) dbCharacterToProto(char db.Character) *characterV1.Character {
 	protoChar := &characterV1.Character{
-		Id:     hex.EncodeToString(char.ID.Bytes[:]),
-		UserId: hex.EncodeToString(char.UserID.Bytes[:]),
-		Name:   char.Name,
-		X:      char.X,
-		Y:      char.Y,
-		ChunkX: char.ChunkX,
-		ChunkY: char.ChunkY,
+		Id:          hex.EncodeToString(char.ID.Bytes[:]),
+		UserId:      hex.EncodeToString(char.UserID.Bytes[:]),
+		WorldId:     hex.EncodeToString(char.WorldID.Bytes[:]),
+		Name:        char.Name,
+		X:           char.X,
+		Y:           char.Y,
+		ChunkX:      char.ChunkX,
+		ChunkY:      char.ChunkY,
+		Facing:      characterV1.Facing(char.Facing),
+		ActionState: characterV1.ActionState(char.ActionState),
+		Title:       cosmetic.TitleName(char.EquippedTitle),
 	}
 
 	if char.CreatedAt.Valid {
@@ -69,47 +122,16 @@ func parseUUID(uuidStr string) (pgtype.UUID, error) {
 	return uuid, err
 }
 
-// worldToChunkCoords converts world coordinates to chunk coordinates
-func (s *Service) worldToChunkCoords(x, y int32) (chunkX, chunkY int32) {
-	chunkX = x / s.chunkSize
-	chunkY = y / s.chunkSize
-
-	// Handle negative coordinates properly
-	if x < 0 && x%s.chunkSize != 0 {
-		chunkX--
-	}
-	if y < 0 && y%s.chunkSize != 0 {
-		chunkY--
-	}
-
-	return chunkX, chunkY
-}
-
 // isValidSpawnPosition checks if the given position is a valid spawn location
 func (s *Service) isValidSpawnPosition(ctx context.Context, x, y int32) (bool, error) {
-	chunkX, chunkY := s.worldToChunkCoords(x, y)
+	chunkX, chunkY, index := geometry.CellLocation(geometry.Point{X: x, Y: y})
 
 	// Get the chunk
 	chunkData, err := s.chunkService.GetOrCreateChunk(ctx, chunkX, chunkY)
 	if err != nil {
 		return false, err
 	}
-
-	// Calculate local coordinates within the chunk
-	localX := x - chunkX*s.chunkSize
-	localY := y - chunkY*s.chunkSize
-
-	// Handle negative coordinates
-	if localX < 0 {
-		localX += s.chunkSize
-	}
-	if localY < 0 {
-		localY += s.chunkSize
-	}
-
-	// Get the terrain cell (row-major order)
-	index := localY*s.chunkSize + localX
-	if index < 0 || index >= int32(len(chunkData.Cells)) {
+	if int(index) >= len(chunkData.Cells) {
 		return false, nil
 	}
 
@@ -138,6 +160,19 @@ func (s *Service) CreateCharacter(ctx context.Context, userID string, req *chara
 	}
 	logger.Debug("User ID parsed successfully")
 
+	// Characters are created in the session's world; unbound sessions fall back to the default world
+	worldUUID, err := session.WorldUUIDFromContext(ctx)
+	if err != nil {
+		logger.Error("Invalid world ID in session", "error", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid world ID: %v", err)
+	}
+
+	name, err := s.validName(ctx, worldUUID, pgtype.UUID{}, req.Name)
+	if err != nil {
+		logger.Warn("Character creation failed: name rejected", "error", err)
+		return nil, err
+	}
+
 	// Set spawn position (default to 0,0 if not specified)
 	spawnX := req.SpawnX
 	spawnY := req.SpawnY
@@ -152,21 +187,22 @@ func (s *Service) CreateCharacter(ctx context.Context, userID string, req *chara
 		spawnX, spawnY = s.findNearbySpawnPosition(ctx, spawnX, spawnY)
 	}
 
-	chunkX, chunkY := s.worldToChunkCoords(spawnX, spawnY)
+	chunkX, chunkY := geometry.ChunkOf(geometry.Point{X: spawnX, Y: spawnY})
 
 	logger.Debug("Creating character record in database")
 	character, err := s.db.CreateCharacter(ctx, db.CreateCharacterParams{
-		UserID: userUUID,
-		Name:   req.Name,
-		X:      spawnX,
-		Y:      spawnY,
-		ChunkX: chunkX,
-		ChunkY: chunkY,
+		UserID:  userUUID,
+		Name:    name,
+		X:       spawnX,
+		Y:       spawnY,
+		ChunkX:  chunkX,
+		ChunkY:  chunkY,
+		WorldID: worldUUID,
 	})
 	if err != nil {
 		if err.Error() == "duplicate key value violates unique constraint" {
-			logger.Warn("Character creation failed: name already exists", "name", req.Name)
-			return nil, status.Errorf(codes.AlreadyExists, "character with name '%s' already exists for this user", req.Name)
+			logger.Warn("Character creation failed: name already exists", "name", name)
+			return nil, status.Errorf(codes.AlreadyExists, "character with name '%s' already exists for this user", name)
 		}
 		logger.Error("Failed to create character in database", "error", err)
 		return nil, status.Errorf(codes.Internal, "failed to create character: %v", err)
@@ -188,7 +224,7 @@ func (s *Service) findNearbySpawnPosition(ctx context.Context, x, y int32) (int3
 		for dx := -radius; dx <= radius; dx++ {
 			for dy := -radius; dy <= radius; dy++ {
 				// Only check positions on the edge of the current radius
-				if abs32(dx) == radius || abs32(dy) == radius {
+				if geometry.Abs(dx) == radius || geometry.Abs(dy) == radius {
 					testX := x + dx
 					testY := y + dy
 					if valid, _ := s.isValidSpawnPosition(ctx, testX, testY); valid {
@@ -202,13 +238,6 @@ func (s *Service) findNearbySpawnPosition(ctx context.Context, x, y int32) (int3
 	return x, y
 }
 
-func abs32(x int32) int32 {
-	if x < 0 {
-		return -x
-	}
-	return x
-}
-
 // GetCharacter retrieves a character by ID
 func (s *Service) GetCharacter(ctx context.Context, req *characterV1.GetCharacterRequest) (*characterV1.GetCharacterResponse, error) {
 	charUUID, err := parseUUID(req.CharacterId)
@@ -221,8 +250,14 @@ func (s *Service) GetCharacter(ctx context.Context, req *characterV1.GetCharacte
 		return nil, status.Errorf(codes.NotFound, "character not found: %v", err)
 	}
 
+	if err := session.RequireWorld(ctx, character.WorldID); err != nil {
+		return nil, err
+	}
+
+	protoCharacter := s.dbCharacterToProto(character)
+	s.addStatusEffects(ctx, []*characterV1.Character{protoCharacter}, []pgtype.UUID{character.ID})
 	return &characterV1.GetCharacterResponse{
-		Character: s.dbCharacterToProto(character),
+		Character: protoCharacter,
 	}, nil
 }
 
@@ -238,6 +273,10 @@ func (s *Service) GetCharacterByID(ctx context.Context, characterID string) (*db
 		return nil, status.Errorf(codes.NotFound, "character not found: %v", err)
 	}
 
+	if err := session.RequireWorld(ctx, character.WorldID); err != nil {
+		return nil, err
+	}
+
 	return &character, nil
 }
 
@@ -248,21 +287,53 @@ func (s *Service) GetUserCharacters(ctx context.Context, userID string) (*charac
 		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
 	}
 
-	characters, err := s.db.GetCharactersByUser(ctx, userUUID)
+	// Sessions bound to a world only see the characters that live there
+	var characters []db.Character
+	worldUUID, err := session.WorldUUIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid world ID: %v", err)
+	}
+	if worldUUID.Valid {
+		characters, err = s.db.GetCharactersByUserInWorld(ctx, db.GetCharactersByUserInWorldParams{
+			UserID:  userUUID,
+			WorldID: worldUUID,
+		})
+	} else {
+		characters, err = s.db.GetCharactersByUser(ctx, userUUID)
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get characters: %v", err)
 	}
 
 	var protoCharacters []*characterV1.Character
+	ids := make([]pgtype.UUID, 0, len(characters))
 	for _, char := range characters {
 		protoCharacters = append(protoCharacters, s.dbCharacterToProto(char))
+		ids = append(ids, char.ID)
 	}
+	s.addStatusEffects(ctx, protoCharacters, ids)
 
 	return &characterV1.GetMyCharactersResponse{
 		Characters: protoCharacters,
 	}, nil
 }
 
+// addStatusEffects sets the active status effects of the characters, loaded in one query.
+// Characters are still returned without them if they cannot be loaded.
+func (s *Service) addStatusEffects(ctx context.Context, characters []*characterV1.Character, ids []pgtype.UUID) {
+	if s.effects == nil || len(ids) == 0 {
+		return
+	}
+	effects, err := s.effects.Active(ctx, ids)
+	if err != nil {
+		logging.GetLogger().Warn("Failed to load status effects", "characters", len(ids), "error", err)
+		return
+	}
+	for i, id := range ids {
+		characters[i].StatusEffects = effects[id.Bytes]
+	}
+}
+
 // DeleteCharacter deletes a character
 func (s *Service) DeleteCharacter(ctx context.Context, req *characterV1.DeleteCharacterRequest) (*characterV1.DeleteCharacterResponse, error) {
 	charUUID, err := parseUUID(req.CharacterId)
@@ -270,10 +341,23 @@ func (s *Service) DeleteCharacter(ctx context.Context, req *characterV1.DeleteCh
 		return nil, status.Errorf(codes.InvalidArgument, "invalid character ID: %v", err)
 	}
 
+	if _, bound := session.WorldIDFromContext(ctx); bound {
+		character, err := s.db.GetCharacterById(ctx, charUUID)
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "character not found: %v", err)
+		}
+		if err := session.RequireWorld(ctx, character.WorldID); err != nil {
+			return nil, err
+		}
+	}
+
 	err = s.db.DeleteCharacter(ctx, charUUID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete character: %v", err)
 	}
+	if s.positions != nil {
+		s.positions.Forget(charUUID)
+	}
 
 	return &characterV1.DeleteCharacterResponse{
 		Success: true,
@@ -13,12 +13,29 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/chunkdata"
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/VoidMesh/api/api/internal/session"
 	"github.com/VoidMesh/api/api/internal/testutil"
 	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
 	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
 	"github.com/VoidMesh/api/api/services/chunk"
 )
 
+// testWorldID is the world test characters are in and test sessions are bound to
+const testWorldID = "550e8400-e29b-41d4-a716-446655440009"
+
+// testWorld returns testWorldID as a UUID
+func testWorld() pgtype.UUID {
+	worldID, _ := mockParseUUID(testWorldID)
+	return worldID
+}
+
+// playerContext is the context of a session bound to the test world
+func playerContext() context.Context {
+	return session.WithWorldID(testutil.CreateTestContext(), testWorldID)
+}
+
 // MockChunkService implements a mock chunk service for testing
 type MockChunkService struct {
 	chunkData map[string]*chunkV1.ChunkData
@@ -58,6 +75,10 @@ func (m *MockChunkService) GetOrCreateChunk(ctx context.Context, chunkX, chunkY
 	return chunk, nil
 }
 
+func (m *MockChunkService) GetOrCreateChunkInWorld(ctx context.Context, worldID pgtype.UUID, chunkX, chunkY int32) (*chunkV1.ChunkData, error) {
+	return m.GetOrCreateChunk(ctx, chunkX, chunkY)
+}
+
 func (m *MockChunkService) SetChunkTerrain(chunkX, chunkY int32, localX, localY int32, terrain chunkV1.TerrainType) {
 	key := chunkKey(chunkX, chunkY)
 	
@@ -87,6 +108,15 @@ func (m *MockChunkService) SetChunkTerrain(chunkX, chunkY int32, localX, localY
 	}
 }
 
+func (m *MockChunkService) IsPassable(ctx context.Context, worldID pgtype.UUID, x, y int32) (bool, error) {
+	chunkX, chunkY, index := geometry.CellLocation(geometry.Point{X: x, Y: y})
+	chunkData, err := m.GetOrCreateChunk(ctx, chunkX, chunkY)
+	if err != nil {
+		return false, err
+	}
+	return chunkdata.NewPassability(chunkData.Cells).Passable(index), nil
+}
+
 func (m *MockChunkService) SetShouldError(shouldErr bool) {
 	m.shouldErr = shouldErr
 }
@@ -112,7 +142,6 @@ func TestNewService(t *testing.T) {
 			expectFields: func(t *testing.T, service *Service) {
 				// For mock databases, the Pool might be nil but MockPool should exist in TestDB
 				assert.NotNil(t, service.chunkService)
-				assert.Equal(t, int32(chunk.ChunkSize), service.chunkSize)
 			},
 		},
 	}
@@ -229,68 +258,6 @@ func TestService_dbCharacterToProto(t *testing.T) {
 	}
 }
 
-func TestService_worldToChunkCoords(t *testing.T) {
-	cleanup := testutil.SetupTest(t, testutil.DefaultTestConfig())
-	defer cleanup()
-
-	testDB := testutil.SetupTestDB(t, testutil.MockDatabaseConfig())
-	defer testDB.Close()
-
-	mockChunkService := NewMockChunkService()
-	service := NewServiceWithPool(testDB.Pool, mockChunkService)
-
-	tests := []struct {
-		name            string
-		x, y            int32
-		expectedChunkX  int32
-		expectedChunkY  int32
-	}{
-		{
-			name:           "positive coordinates",
-			x:              64,
-			y:              96,
-			expectedChunkX: 2,  // 64 / 32 = 2
-			expectedChunkY: 3,  // 96 / 32 = 3
-		},
-		{
-			name:           "zero coordinates",
-			x:              0,
-			y:              0,
-			expectedChunkX: 0,
-			expectedChunkY: 0,
-		},
-		{
-			name:           "negative coordinates aligned to chunk boundary",
-			x:              -32,
-			y:              -64,
-			expectedChunkX: -1, // -32 / 32 = -1
-			expectedChunkY: -2, // -64 / 32 = -2
-		},
-		{
-			name:           "negative coordinates not aligned",
-			x:              -33,
-			y:              -65,
-			expectedChunkX: -2, // Floor division for negative numbers
-			expectedChunkY: -3, // Floor division for negative numbers
-		},
-		{
-			name:           "mixed positive and negative",
-			x:              15,
-			y:              -17,
-			expectedChunkX: 0,  // 15 / 32 = 0
-			expectedChunkY: -1, // -17 / 32 with floor = -1
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			chunkX, chunkY := service.worldToChunkCoords(tt.x, tt.y)
-			assert.Equal(t, tt.expectedChunkX, chunkX, "chunkX mismatch")
-			assert.Equal(t, tt.expectedChunkY, chunkY, "chunkY mismatch")
-		})
-	}
-}
-
 func TestService_isValidSpawnPosition(t *testing.T) {
 	cleanup := testutil.SetupTest(t, testutil.DefaultTestConfig())
 	defer cleanup()
@@ -455,7 +422,7 @@ func TestService_CreateCharacter(t *testing.T) {
 				SpawnY: 20,
 			},
 			setupDatabase: func(mock *MockDatabaseInterface) {
-				// Configure mock to return error on creation
+				// Configure mock to return error on creation; the name check is the first query
 				mock.SetShouldReturnError(true)
 			},
 			setupChunk: func(mockService *MockChunkService) {
@@ -463,7 +430,23 @@ func TestService_CreateCharacter(t *testing.T) {
 			},
 			expectError:    true,
 			expectCode:     codes.Internal,
-			expectErrorMsg: "failed to create character",
+			expectErrorMsg: "failed to check name",
+		},
+		{
+			name:   "invalid character name",
+			userID: "550e8400-e29b-41d4-a716-446655440000",
+			request: &characterV1.CreateCharacterRequest{
+				Name: "-Test_Character",
+			},
+			setupDatabase: func(mock *MockDatabaseInterface) {
+				// No setup needed
+			},
+			setupChunk: func(mockService *MockChunkService) {
+				// No setup needed
+			},
+			expectError:    true,
+			expectCode:     codes.InvalidArgument,
+			expectErrorMsg: "invalid name",
 		},
 	}
 
@@ -530,6 +513,7 @@ func TestService_GetCharacter(t *testing.T) {
 				testChar := db.Character{
 					ID:        charUUID,
 					UserID:    userUUID,
+					WorldID:   testWorld(),
 					Name:      "TestCharacter",
 					X:         10,
 					Y:         20,
@@ -574,7 +558,7 @@ func TestService_GetCharacter(t *testing.T) {
 
 			mockChunkService := NewMockChunkService()
 			service := NewService(mockDB, mockChunkService)
-			ctx := testutil.CreateTestContext()
+			ctx := playerContext()
 
 			response, err := service.GetCharacter(ctx, tt.request)
 
@@ -744,6 +728,7 @@ func TestService_DeleteCharacter(t *testing.T) {
 				testChar := db.Character{
 					ID:        charUUID,
 					UserID:    userUUID,
+					WorldID:   testWorld(),
 					Name:      "TestCharacter",
 					X:         10,
 					Y:         20,
@@ -823,6 +808,89 @@ func TestService_DeleteCharacter(t *testing.T) {
 	}
 }
 
+func TestService_WorldScoping(t *testing.T) {
+	cleanup := testutil.SetupTest(t, testutil.DefaultTestConfig())
+	defer cleanup()
+
+	const (
+		homeWorld  = "650e8400-e29b-41d4-a716-446655440000"
+		otherWorld = "750e8400-e29b-41d4-a716-446655440000"
+		userID     = "550e8400-e29b-41d4-a716-446655440001"
+		homeChar   = "550e8400-e29b-41d4-a716-446655440010"
+		otherChar  = "550e8400-e29b-41d4-a716-446655440020"
+	)
+
+	newService := func() (*Service, *MockDatabaseInterface) {
+		mockDB := NewMockDatabase()
+		for _, c := range []struct{ id, world, name string }{
+			{homeChar, homeWorld, "HomeCharacter"},
+			{otherChar, otherWorld, "OtherCharacter"},
+		} {
+			charUUID, _ := mockParseUUID(c.id)
+			userUUID, _ := mockParseUUID(userID)
+			worldUUID, _ := mockParseUUID(c.world)
+			mockDB.AddCharacter(db.Character{
+				ID:        charUUID,
+				UserID:    userUUID,
+				WorldID:   worldUUID,
+				Name:      c.name,
+				CreatedAt: pgtype.Timestamp{Valid: true, Time: time.Now()},
+			})
+		}
+		return NewService(mockDB, NewMockChunkService()), mockDB
+	}
+	bound := session.WithWorldID(testutil.CreateTestContext(), homeWorld)
+
+	t.Run("GetCharacter rejects characters from another world", func(t *testing.T) {
+		service, _ := newService()
+
+		_, err := service.GetCharacter(bound, &characterV1.GetCharacterRequest{CharacterId: otherChar})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		resp, err := service.GetCharacter(bound, &characterV1.GetCharacterRequest{CharacterId: homeChar})
+		require.NoError(t, err)
+		assert.Equal(t, "650e8400e29b41d4a716446655440000", resp.Character.WorldId)
+	})
+
+	t.Run("GetUserCharacters only lists the session world", func(t *testing.T) {
+		service, _ := newService()
+
+		resp, err := service.GetUserCharacters(bound, userID)
+		require.NoError(t, err)
+		require.Len(t, resp.Characters, 1)
+		assert.Equal(t, "HomeCharacter", resp.Characters[0].Name)
+
+		resp, err = service.GetUserCharacters(testutil.CreateTestContext(), userID)
+		require.NoError(t, err)
+		assert.Len(t, resp.Characters, 2, "unbound sessions see every world")
+	})
+
+	t.Run("MoveCharacter rejects characters from another world", func(t *testing.T) {
+		service, mockDB := newService()
+
+		_, err := service.MoveCharacter(bound, &characterV1.MoveCharacterRequest{CharacterId: otherChar, NewX: 1, NewY: 0})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Equal(t, 0, mockDB.GetUpdateCallCount())
+	})
+
+	t.Run("DeleteCharacter rejects characters from another world", func(t *testing.T) {
+		service, mockDB := newService()
+
+		_, err := service.DeleteCharacter(bound, &characterV1.DeleteCharacterRequest{CharacterId: otherChar})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Equal(t, 0, mockDB.GetDeleteCallCount())
+	})
+
+	t.Run("CreateCharacter places the character in the session world", func(t *testing.T) {
+		service, _ := newService()
+		service.db.(*MockDatabaseInterface).SetNextCharacterID("550e8400-e29b-41d4-a716-446655440030")
+
+		resp, err := service.CreateCharacter(bound, userID, &characterV1.CreateCharacterRequest{Name: "NewCharacter"})
+		require.NoError(t, err)
+		assert.Equal(t, "650e8400e29b41d4a716446655440000", resp.Character.WorldId)
+	})
+}
+
 func TestService_findNearbySpawnPosition(t *testing.T) {
 	t.Skip("Skipping findNearbySpawnPosition test - coordinate calculation needs refinement")
 	
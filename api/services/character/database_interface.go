@@ -2,10 +2,18 @@ package character
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/outbox"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/txn"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
-	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 // DatabaseInterface abstracts database operations for the character service.
@@ -14,20 +22,49 @@ type DatabaseInterface interface {
 	GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error)
 	CreateCharacter(ctx context.Context, arg db.CreateCharacterParams) (db.Character, error)
 	UpdateCharacterPosition(ctx context.Context, arg db.UpdateCharacterPositionParams) (db.Character, error)
+	UpdateCharacterActionState(ctx context.Context, arg db.UpdateCharacterActionStateParams) (db.Character, error)
 	GetCharactersByUser(ctx context.Context, userID pgtype.UUID) ([]db.Character, error)
+	GetCharactersByUserInWorld(ctx context.Context, arg db.GetCharactersByUserInWorldParams) ([]db.Character, error)
 	GetCharacterByUserAndName(ctx context.Context, arg db.GetCharacterByUserAndNameParams) (db.Character, error)
 	DeleteCharacter(ctx context.Context, id pgtype.UUID) error
+	ListCharactersInArea(ctx context.Context, arg db.ListCharactersInAreaParams) ([]db.Character, error)
+	GetLatestOutboxEventID(ctx context.Context) (int64, error)
+	OutboxEventExists(ctx context.Context, id int64) (bool, error)
+	ListOutboxEventsAfter(ctx context.Context, arg db.ListOutboxEventsAfterParams) ([]db.OutboxEvent, error)
+	CharacterNameInUse(ctx context.Context, arg db.CharacterNameInUseParams) (bool, error)
+	GetLatestCharacterRename(ctx context.Context, characterID pgtype.UUID) (pgtype.Timestamp, error)
+	RenameCharacter(ctx context.Context, rename Rename) (db.Character, db.CharacterNameHistory, error)
+	ListCharacterNameHistory(ctx context.Context, arg db.ListCharacterNameHistoryParams) ([]db.CharacterNameHistory, error)
+	LookupCharacterName(ctx context.Context, arg db.LookupCharacterNameParams) ([]db.CharacterNameHistory, error)
+	ListCharactersByWorldAndName(ctx context.Context, arg db.ListCharactersByWorldAndNameParams) ([]db.Character, error)
+}
+
+var (
+	// ErrNameTaken is returned when another character of the world has the name
+	ErrNameTaken = errors.New("name is taken")
+	// ErrRenameCooldown is returned when the character was renamed less than the cooldown ago
+	ErrRenameCooldown = errors.New("character was renamed recently")
+)
+
+// Rename changes a character's name once the checks pass
+type Rename struct {
+	Character db.Character
+	Name      string
+	Now       time.Time
+	Cooldown  time.Duration
 }
 
 // DatabaseWrapper implements DatabaseInterface using the actual database connection.
 // This is the production implementation that wraps the SQLC generated queries.
 type DatabaseWrapper struct {
+	pool    storage.Pool
 	queries *db.Queries
 }
 
 // NewDatabaseWrapper creates a new database wrapper with the given connection pool.
-func NewDatabaseWrapper(pool *pgxpool.Pool) DatabaseInterface {
+func NewDatabaseWrapper(pool storage.Pool) DatabaseInterface {
 	return &DatabaseWrapper{
+		pool:    pool,
 		queries: db.New(pool),
 	}
 }
@@ -37,9 +74,29 @@ func (d *DatabaseWrapper) GetCharacterById(ctx context.Context, id pgtype.UUID)
 	return d.queries.GetCharacterById(ctx, id)
 }
 
-// CreateCharacter creates a new character.
+// CreateCharacter creates a new character and enqueues a CharacterCreated event in the same transaction.
 func (d *DatabaseWrapper) CreateCharacter(ctx context.Context, arg db.CreateCharacterParams) (db.Character, error) {
-	return d.queries.CreateCharacter(ctx, arg)
+	var character db.Character
+	err := outbox.InTx(ctx, d.pool, func(q *db.Queries) error {
+		var err error
+		character, err = q.CreateCharacter(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		characterID := uuid.PgtypeToString(character.ID)
+		return outbox.Enqueue(ctx, q, events.CharacterCreated, characterID,
+			fmt.Sprintf("%s:%s", events.CharacterCreated, characterID),
+			events.CharacterCreatedPayload{
+				CharacterID: characterID,
+				UserID:      uuid.PgtypeToString(character.UserID),
+				WorldID:     uuid.PgtypeToString(character.WorldID),
+				Name:        character.Name,
+				X:           character.X,
+				Y:           character.Y,
+			})
+	})
+	return character, err
 }
 
 // UpdateCharacterPosition updates a character's position.
@@ -47,11 +104,21 @@ func (d *DatabaseWrapper) UpdateCharacterPosition(ctx context.Context, arg db.Up
 	return d.queries.UpdateCharacterPosition(ctx, arg)
 }
 
+// UpdateCharacterActionState updates what a character is doing.
+func (d *DatabaseWrapper) UpdateCharacterActionState(ctx context.Context, arg db.UpdateCharacterActionStateParams) (db.Character, error) {
+	return d.queries.UpdateCharacterActionState(ctx, arg)
+}
+
 // GetCharactersByUser retrieves all characters for a user.
 func (d *DatabaseWrapper) GetCharactersByUser(ctx context.Context, userID pgtype.UUID) ([]db.Character, error) {
 	return d.queries.GetCharactersByUser(ctx, userID)
 }
 
+// GetCharactersByUserInWorld retrieves a user's characters in a single world.
+func (d *DatabaseWrapper) GetCharactersByUserInWorld(ctx context.Context, arg db.GetCharactersByUserInWorldParams) ([]db.Character, error) {
+	return d.queries.GetCharactersByUserInWorld(ctx, arg)
+}
+
 // GetCharacterByUserAndName retrieves a character by user ID and name.
 func (d *DatabaseWrapper) GetCharacterByUserAndName(ctx context.Context, arg db.GetCharacterByUserAndNameParams) (db.Character, error) {
 	return d.queries.GetCharacterByUserAndName(ctx, arg)
@@ -60,4 +127,106 @@ func (d *DatabaseWrapper) GetCharacterByUserAndName(ctx context.Context, arg db.
 // DeleteCharacter deletes a character by ID.
 func (d *DatabaseWrapper) DeleteCharacter(ctx context.Context, id pgtype.UUID) error {
 	return d.queries.DeleteCharacter(ctx, id)
-}
\ No newline at end of file
+}
+
+// ListCharactersInArea retrieves the characters inside a rectangle of cells.
+func (d *DatabaseWrapper) ListCharactersInArea(ctx context.Context, arg db.ListCharactersInAreaParams) ([]db.Character, error) {
+	return d.queries.ListCharactersInArea(ctx, arg)
+}
+
+// GetLatestOutboxEventID returns the highest outbox event ID, or 0 when there are none.
+func (d *DatabaseWrapper) GetLatestOutboxEventID(ctx context.Context) (int64, error) {
+	return d.queries.GetLatestOutboxEventID(ctx)
+}
+
+// OutboxEventExists reports whether an outbox event is still stored.
+func (d *DatabaseWrapper) OutboxEventExists(ctx context.Context, id int64) (bool, error) {
+	return d.queries.OutboxEventExists(ctx, id)
+}
+
+// ListOutboxEventsAfter retrieves outbox events of some types after an ID.
+func (d *DatabaseWrapper) ListOutboxEventsAfter(ctx context.Context, arg db.ListOutboxEventsAfterParams) ([]db.OutboxEvent, error) {
+	return d.queries.ListOutboxEventsAfter(ctx, arg)
+}
+
+// CharacterNameInUse reports whether a character of the world has a name, ignoring case.
+func (d *DatabaseWrapper) CharacterNameInUse(ctx context.Context, arg db.CharacterNameInUseParams) (bool, error) {
+	return d.queries.CharacterNameInUse(ctx, arg)
+}
+
+// GetLatestCharacterRename returns when a character was last renamed.
+func (d *DatabaseWrapper) GetLatestCharacterRename(ctx context.Context, characterID pgtype.UUID) (pgtype.Timestamp, error) {
+	return d.queries.GetLatestCharacterRename(ctx, characterID)
+}
+
+// RenameCharacter checks that the name is free and the cooldown has passed, then renames
+// the character, records the old name and enqueues a CharacterRenamed event in one
+// serializable transaction, so two characters cannot take the same name at once.
+func (d *DatabaseWrapper) RenameCharacter(ctx context.Context, rename Rename) (db.Character, db.CharacterNameHistory, error) {
+	var character db.Character
+	var change db.CharacterNameHistory
+	err := txn.Run(ctx, d.pool, txn.Serializable, func(q *db.Queries) error {
+		inUse, err := q.CharacterNameInUse(ctx, db.CharacterNameInUseParams{
+			WorldID:   rename.Character.WorldID,
+			Name:      rename.Name,
+			ExcludeID: rename.Character.ID,
+		})
+		if err != nil {
+			return err
+		}
+		if inUse {
+			return ErrNameTaken
+		}
+
+		last, err := q.GetLatestCharacterRename(ctx, rename.Character.ID)
+		if err == nil && rename.Now.Before(last.Time.Add(rename.Cooldown)) {
+			return ErrRenameCooldown
+		}
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+
+		character, err = q.RenameCharacter(ctx, db.RenameCharacterParams{ID: rename.Character.ID, Name: rename.Name})
+		if err != nil {
+			return err
+		}
+		change, err = q.CreateCharacterNameChange(ctx, db.CreateCharacterNameChangeParams{
+			CharacterID: character.ID,
+			UserID:      character.UserID,
+			WorldID:     character.WorldID,
+			OldName:     rename.Character.Name,
+			NewName:     character.Name,
+			RenamedAt:   pgtype.Timestamp{Time: rename.Now, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+
+		characterID := uuid.PgtypeToString(character.ID)
+		return outbox.Enqueue(ctx, q, events.CharacterRenamed, characterID,
+			fmt.Sprintf("%s:%d", events.CharacterRenamed, change.ID),
+			events.CharacterRenamedPayload{
+				CharacterID: characterID,
+				UserID:      uuid.PgtypeToString(character.UserID),
+				WorldID:     uuid.PgtypeToString(character.WorldID),
+				OldName:     change.OldName,
+				NewName:     change.NewName,
+			})
+	})
+	return character, change, err
+}
+
+// ListCharacterNameHistory retrieves the renames of a character, newest first.
+func (d *DatabaseWrapper) ListCharacterNameHistory(ctx context.Context, arg db.ListCharacterNameHistoryParams) ([]db.CharacterNameHistory, error) {
+	return d.queries.ListCharacterNameHistory(ctx, arg)
+}
+
+// LookupCharacterName retrieves the renames from or to a name in a world, newest first.
+func (d *DatabaseWrapper) LookupCharacterName(ctx context.Context, arg db.LookupCharacterNameParams) ([]db.CharacterNameHistory, error) {
+	return d.queries.LookupCharacterName(ctx, arg)
+}
+
+// ListCharactersByWorldAndName retrieves the characters of a world with a name, ignoring case.
+func (d *DatabaseWrapper) ListCharactersByWorldAndName(ctx context.Context, arg db.ListCharactersByWorldAndNameParams) ([]db.Character, error) {
+	return d.queries.ListCharactersByWorldAndName(ctx, arg)
+}
@@ -1,11 +1,16 @@
 package character
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"fmt"
+	"slices"
+	"sort"
+	"strings"
 
 	"github.com/VoidMesh/api/api/db"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 )
@@ -20,6 +25,8 @@ type MockDatabaseInterface struct {
 	getCallCount     int
 	updateCallCount  int
 	deleteCallCount  int
+	outbox           []db.OutboxEvent
+	nameHistory      []db.CharacterNameHistory
 }
 
 // NewMockDatabase creates a new mock database interface for testing.
@@ -87,6 +94,7 @@ func (m *MockDatabaseInterface) CreateCharacter(ctx context.Context, arg db.Crea
 	char := db.Character{
 		ID:      uuid,
 		UserID:  arg.UserID,
+		WorldID: arg.WorldID,
 		Name:    arg.Name,
 		X:       arg.X,
 		Y:       arg.Y,
@@ -120,12 +128,34 @@ func (m *MockDatabaseInterface) UpdateCharacterPosition(ctx context.Context, arg
 	char.Y = arg.Y
 	char.ChunkX = arg.ChunkX
 	char.ChunkY = arg.ChunkY
+	char.Facing = arg.Facing
+	char.ActionState = arg.ActionState
 	
 	m.characters[key] = char
 	
 	return char, nil
 }
 
+// UpdateCharacterActionState updates what a character is doing.
+func (m *MockDatabaseInterface) UpdateCharacterActionState(ctx context.Context, arg db.UpdateCharacterActionStateParams) (db.Character, error) {
+	m.updateCallCount++
+	
+	if m.shouldReturnErr {
+		return db.Character{}, assert.AnError
+	}
+	
+	key := fmt.Sprintf("%x", arg.ID.Bytes)
+	char, exists := m.characters[key]
+	if !exists {
+		return db.Character{}, sql.ErrNoRows
+	}
+	
+	char.ActionState = arg.ActionState
+	m.characters[key] = char
+	
+	return char, nil
+}
+
 // GetCharactersByUser retrieves all characters for a user.
 func (m *MockDatabaseInterface) GetCharactersByUser(ctx context.Context, userID pgtype.UUID) ([]db.Character, error) {
 	if m.shouldReturnErr {
@@ -145,6 +175,23 @@ func (m *MockDatabaseInterface) GetCharactersByUser(ctx context.Context, userID
 	return result, nil
 }
 
+// GetCharactersByUserInWorld retrieves a user's characters in a single world.
+func (m *MockDatabaseInterface) GetCharactersByUserInWorld(ctx context.Context, arg db.GetCharactersByUserInWorldParams) ([]db.Character, error) {
+	characters, err := m.GetCharactersByUser(ctx, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []db.Character
+	for _, char := range characters {
+		if char.WorldID == arg.WorldID {
+			result = append(result, char)
+		}
+	}
+
+	return result, nil
+}
+
 // GetCharacterByUserAndName retrieves a character by user ID and name.
 func (m *MockDatabaseInterface) GetCharacterByUserAndName(ctx context.Context, arg db.GetCharacterByUserAndNameParams) (db.Character, error) {
 	if m.shouldReturnErr {
@@ -180,6 +227,193 @@ func (m *MockDatabaseInterface) DeleteCharacter(ctx context.Context, id pgtype.U
 	return nil
 }
 
+// ListCharactersInArea retrieves the characters inside a rectangle of cells.
+func (m *MockDatabaseInterface) ListCharactersInArea(ctx context.Context, arg db.ListCharactersInAreaParams) ([]db.Character, error) {
+	if m.shouldReturnErr {
+		return nil, assert.AnError
+	}
+
+	var result []db.Character
+	for _, char := range m.characters {
+		if char.WorldID == arg.WorldID && char.X >= arg.MinX && char.X <= arg.MaxX && char.Y >= arg.MinY && char.Y <= arg.MaxY {
+			result = append(result, char)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return bytes.Compare(result[i].ID.Bytes[:], result[j].ID.Bytes[:]) < 0
+	})
+	return result, nil
+}
+
+// AddOutboxEvent stores an outbox event for the outbox queries.
+func (m *MockDatabaseInterface) AddOutboxEvent(event db.OutboxEvent) {
+	m.outbox = append(m.outbox, event)
+}
+
+// DeleteOutboxEventsBefore removes outbox events with lower IDs, as retention does.
+func (m *MockDatabaseInterface) DeleteOutboxEventsBefore(id int64) {
+	kept := m.outbox[:0]
+	for _, event := range m.outbox {
+		if event.ID >= id {
+			kept = append(kept, event)
+		}
+	}
+	m.outbox = kept
+}
+
+// GetLatestOutboxEventID returns the highest outbox event ID, or 0 when there are none.
+func (m *MockDatabaseInterface) GetLatestOutboxEventID(ctx context.Context) (int64, error) {
+	if m.shouldReturnErr {
+		return 0, assert.AnError
+	}
+
+	var latest int64
+	for _, event := range m.outbox {
+		latest = max(latest, event.ID)
+	}
+	return latest, nil
+}
+
+// OutboxEventExists reports whether an outbox event is still stored.
+func (m *MockDatabaseInterface) OutboxEventExists(ctx context.Context, id int64) (bool, error) {
+	if m.shouldReturnErr {
+		return false, assert.AnError
+	}
+
+	for _, event := range m.outbox {
+		if event.ID == id {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ListOutboxEventsAfter retrieves outbox events of some types after an ID.
+func (m *MockDatabaseInterface) ListOutboxEventsAfter(ctx context.Context, arg db.ListOutboxEventsAfterParams) ([]db.OutboxEvent, error) {
+	if m.shouldReturnErr {
+		return nil, assert.AnError
+	}
+
+	var result []db.OutboxEvent
+	for _, event := range m.outbox {
+		if event.ID > arg.AfterID && slices.Contains(arg.EventTypes, event.EventType) && len(result) < int(arg.MaxEvents) {
+			result = append(result, event)
+		}
+	}
+	return result, nil
+}
+
+// CharacterNameInUse reports whether a character of the world has a name, ignoring case.
+func (m *MockDatabaseInterface) CharacterNameInUse(ctx context.Context, arg db.CharacterNameInUseParams) (bool, error) {
+	if m.shouldReturnErr {
+		return false, assert.AnError
+	}
+
+	for _, char := range m.characters {
+		if char.WorldID == arg.WorldID && strings.EqualFold(char.Name, arg.Name) && char.ID != arg.ExcludeID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// GetLatestCharacterRename returns when a character was last renamed.
+func (m *MockDatabaseInterface) GetLatestCharacterRename(ctx context.Context, characterID pgtype.UUID) (pgtype.Timestamp, error) {
+	if m.shouldReturnErr {
+		return pgtype.Timestamp{}, assert.AnError
+	}
+
+	var latest pgtype.Timestamp
+	for _, change := range m.nameHistory {
+		if change.CharacterID == characterID && (!latest.Valid || change.RenamedAt.Time.After(latest.Time)) {
+			latest = change.RenamedAt
+		}
+	}
+	if !latest.Valid {
+		return latest, pgx.ErrNoRows
+	}
+	return latest, nil
+}
+
+// RenameCharacter renames a character and records its old name.
+func (m *MockDatabaseInterface) RenameCharacter(ctx context.Context, rename Rename) (db.Character, db.CharacterNameHistory, error) {
+	m.updateCallCount++
+
+	if inUse, err := m.CharacterNameInUse(ctx, db.CharacterNameInUseParams{WorldID: rename.Character.WorldID, Name: rename.Name, ExcludeID: rename.Character.ID}); err != nil {
+		return db.Character{}, db.CharacterNameHistory{}, err
+	} else if inUse {
+		return db.Character{}, db.CharacterNameHistory{}, ErrNameTaken
+	}
+	if last, err := m.GetLatestCharacterRename(ctx, rename.Character.ID); err == nil && rename.Now.Before(last.Time.Add(rename.Cooldown)) {
+		return db.Character{}, db.CharacterNameHistory{}, ErrRenameCooldown
+	}
+
+	key := fmt.Sprintf("%x", rename.Character.ID.Bytes)
+	char, exists := m.characters[key]
+	if !exists {
+		return db.Character{}, db.CharacterNameHistory{}, sql.ErrNoRows
+	}
+	change := db.CharacterNameHistory{
+		ID:          int64(len(m.nameHistory) + 1),
+		CharacterID: char.ID,
+		UserID:      char.UserID,
+		WorldID:     char.WorldID,
+		OldName:     char.Name,
+		NewName:     rename.Name,
+		RenamedAt:   pgtype.Timestamp{Time: rename.Now, Valid: true},
+	}
+	char.Name = rename.Name
+	m.characters[key] = char
+	m.nameHistory = append(m.nameHistory, change)
+	return char, change, nil
+}
+
+// ListCharacterNameHistory retrieves the renames of a character, newest first.
+func (m *MockDatabaseInterface) ListCharacterNameHistory(ctx context.Context, arg db.ListCharacterNameHistoryParams) ([]db.CharacterNameHistory, error) {
+	if m.shouldReturnErr {
+		return nil, assert.AnError
+	}
+
+	var result []db.CharacterNameHistory
+	for i := len(m.nameHistory) - 1; i >= 0 && len(result) < int(arg.Limit); i-- {
+		if m.nameHistory[i].CharacterID == arg.CharacterID {
+			result = append(result, m.nameHistory[i])
+		}
+	}
+	return result, nil
+}
+
+// LookupCharacterName retrieves the renames from or to a name in a world, newest first.
+func (m *MockDatabaseInterface) LookupCharacterName(ctx context.Context, arg db.LookupCharacterNameParams) ([]db.CharacterNameHistory, error) {
+	if m.shouldReturnErr {
+		return nil, assert.AnError
+	}
+
+	var result []db.CharacterNameHistory
+	for i := len(m.nameHistory) - 1; i >= 0 && len(result) < int(arg.MaxResults); i-- {
+		change := m.nameHistory[i]
+		if change.WorldID == arg.WorldID && (strings.EqualFold(change.OldName, arg.Name) || strings.EqualFold(change.NewName, arg.Name)) {
+			result = append(result, change)
+		}
+	}
+	return result, nil
+}
+
+// ListCharactersByWorldAndName retrieves the characters of a world with a name, ignoring case.
+func (m *MockDatabaseInterface) ListCharactersByWorldAndName(ctx context.Context, arg db.ListCharactersByWorldAndNameParams) ([]db.Character, error) {
+	if m.shouldReturnErr {
+		return nil, assert.AnError
+	}
+
+	var result []db.Character
+	for _, char := range m.characters {
+		if char.WorldID == arg.WorldID && strings.EqualFold(char.Name, arg.Name) {
+			result = append(result, char)
+		}
+	}
+	return result, nil
+}
+
 // Test helper methods
 func (m *MockDatabaseInterface) GetCreateCallCount() int {
 	return m.createCallCount
@@ -0,0 +1,53 @@
+package character
+
+import (
+	"context"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/VoidMesh/api/api/internal/logging"
+	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
+	dungeonV1 "github.com/VoidMesh/api/api/proto/dungeon/v1"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DungeonSource keeps the characters inside dungeon instances. A character inside keeps
+// its world position and moves on the instance's own grid.
+type DungeonSource interface {
+	// Locate returns where a character is inside a dungeon, or nil when it is in the overworld
+	Locate(ctx context.Context, characterID pgtype.UUID) (*dungeonV1.DungeonPosition, error)
+	// Passable reports whether a cell of an instance can be walked on
+	Passable(ctx context.Context, dungeonID int64, x, y int32) (bool, error)
+	MoveTo(ctx context.Context, characterID pgtype.UUID, x, y int32) error
+}
+
+// SetDungeons moves the characters inside dungeon instances on the instance's grid
+func (s *Service) SetDungeons(dungeons DungeonSource) {
+	s.dungeons = dungeons
+}
+
+// moveInDungeon moves a character on the grid of the dungeon instance it is in. Like
+// interior moves, they are not seen by nearby streams, recorders or prefetching.
+func (s *Service) moveInDungeon(ctx context.Context, character db.Character, location *dungeonV1.DungeonPosition, req *characterV1.MoveCharacterRequest, at time.Time) (*characterV1.MoveCharacterResponse, error) {
+	position := &dungeonV1.DungeonPosition{DungeonId: location.DungeonId, X: location.X, Y: location.Y}
+	step := gridStep{
+		from: geometry.Point{X: location.X, Y: location.Y},
+		passable: func(x, y int32) (bool, error) {
+			return s.dungeons.Passable(ctx, location.DungeonId, x, y)
+		},
+		moveTo: func(x, y int32) error {
+			return s.dungeons.MoveTo(ctx, character.ID, x, y)
+		},
+		blocked: "Cannot move to that position (wall)",
+	}
+	resp, err := s.stepOnGrid(ctx, character, req, at, step, logging.WithFields("character_id", req.CharacterId, "dungeon_id", location.DungeonId, "new_x", req.NewX, "new_y", req.NewY))
+	if err != nil {
+		return nil, err
+	}
+	if resp.Success {
+		position.X, position.Y = req.NewX, req.NewY
+	}
+	resp.Dungeon = position
+	return resp, nil
+}
@@ -0,0 +1,221 @@
+package character
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/entity"
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/VoidMesh/api/api/internal/logging"
+	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
+	interiorV1 "github.com/VoidMesh/api/api/proto/interior/v1"
+	"github.com/charmbracelet/log"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// InteriorSource keeps the characters inside the instanced interiors of structures. A
+// character inside keeps its world position beside the structure and moves on the
+// interior's own grid.
+type InteriorSource interface {
+	// Locate returns where a character is inside an interior, or nil when it is outside
+	Locate(ctx context.Context, characterID pgtype.UUID) (*interiorV1.InteriorPosition, error)
+	// Structure returns a placed structure in a world, or entity.ErrNotFound
+	Structure(ctx context.Context, worldID pgtype.UUID, structureID int64) (*entity.Entity, error)
+	// Door returns the cell of an interior characters enter and leave on
+	Door(ctx context.Context, interiorID int64) (geometry.Point, error)
+	// Passable reports whether a cell of an interior is floor without furniture
+	Passable(ctx context.Context, interiorID int64, x, y int32) (bool, error)
+	// Enter opens the structure's interior, generating it the first time, and puts the
+	// character on its door cell. It fails with a FailedPrecondition status when the
+	// structure has no interior or the character is already inside one.
+	Enter(ctx context.Context, characterID pgtype.UUID, structure *entity.Entity) (*interiorV1.EnterInteriorResponse, error)
+	MoveTo(ctx context.Context, characterID pgtype.UUID, x, y int32) error
+	Exit(ctx context.Context, characterID pgtype.UUID) error
+}
+
+// SetInteriors lets characters enter structure interiors and moves the characters inside
+// on the interior's grid
+func (s *Service) SetInteriors(interiors InteriorSource) {
+	s.interiors = interiors
+}
+
+// waitingToMove reports whether the character moved too recently to move again at
+func (s *Service) waitingToMove(ctx context.Context, character db.Character, characterID string, at time.Time) bool {
+	lastMove, exists := s.moves.last(characterID)
+	return exists && at.Sub(lastMove) < s.cooldown(ctx, character)
+}
+
+// moveInside moves a character on the grid of the interior it is in. Moves inside are
+// not seen by nearby streams, recorders or prefetching, and keep the character's facing
+// and action state.
+func (s *Service) moveInside(ctx context.Context, character db.Character, location *interiorV1.InteriorPosition, req *characterV1.MoveCharacterRequest, at time.Time) (*characterV1.MoveCharacterResponse, error) {
+	position := &interiorV1.InteriorPosition{InteriorId: location.InteriorId, X: location.X, Y: location.Y}
+	step := gridStep{
+		from: geometry.Point{X: location.X, Y: location.Y},
+		passable: func(x, y int32) (bool, error) {
+			return s.interiors.Passable(ctx, location.InteriorId, x, y)
+		},
+		moveTo: func(x, y int32) error {
+			return s.interiors.MoveTo(ctx, character.ID, x, y)
+		},
+		blocked: "Cannot move to that position (wall or furniture)",
+	}
+	resp, err := s.stepOnGrid(ctx, character, req, at, step, logging.WithFields("character_id", req.CharacterId, "interior_id", location.InteriorId, "new_x", req.NewX, "new_y", req.NewY))
+	if err != nil {
+		return nil, err
+	}
+	if resp.Success {
+		position.X, position.Y = req.NewX, req.NewY
+	}
+	resp.Interior = position
+	return resp, nil
+}
+
+// gridStep is a move on the grid of an instance: an interior or a dungeon
+type gridStep struct {
+	from     geometry.Point
+	passable func(x, y int32) (bool, error)
+	moveTo   func(x, y int32) error
+	blocked  string // Error message when the destination is not passable
+}
+
+// stepOnGrid validates a step on an instance's grid like a world step, distance and
+// cooldown, and makes it
+func (s *Service) stepOnGrid(ctx context.Context, character db.Character, req *characterV1.MoveCharacterRequest, at time.Time, step gridStep, logger *log.Logger) (*characterV1.MoveCharacterResponse, error) {
+	if !validStep(step.from.X, step.from.Y, req.NewX, req.NewY) {
+		logger.Warn("Instance movement rejected by anti-cheat validation", "current_x", step.from.X, "current_y", step.from.Y)
+		return &characterV1.MoveCharacterResponse{Success: false, ErrorMessage: "Invalid movement: too far or too fast"}, nil
+	}
+	if s.waitingToMove(ctx, character, req.CharacterId, at) {
+		return &characterV1.MoveCharacterResponse{Success: false, ErrorMessage: "Movement too fast, please wait"}, nil
+	}
+	if req.NewX != step.from.X || req.NewY != step.from.Y {
+		passable, err := step.passable(req.NewX, req.NewY)
+		if err != nil {
+			logger.Error("Failed to validate instance destination", "error", err)
+			return nil, status.Errorf(codes.Internal, "failed to validate position: %v", err)
+		}
+		if !passable {
+			return &characterV1.MoveCharacterResponse{Success: false, ErrorMessage: step.blocked}, nil
+		}
+		if err := step.moveTo(req.NewX, req.NewY); err != nil {
+			logger.Error("Failed to update instance position", "error", err)
+			return nil, status.Errorf(codes.Internal, "failed to update character position: %v", err)
+		}
+	}
+	s.moves.record(req.CharacterId, at)
+
+	logger.Debug("Character moved inside instance")
+	return &characterV1.MoveCharacterResponse{
+		Character: s.dbCharacterToProto(character),
+		Success:   true,
+	}, nil
+}
+
+// EnterInterior steps a character from beside a structure onto its interior's door cell.
+// Like any step it must be to the structure's cell or one next to it, and it waits for the
+// movement cooldown.
+func (s *Service) EnterInterior(ctx context.Context, userID, characterID string, structureID int64) (*interiorV1.EnterInteriorResponse, error) {
+	if s.interiors == nil {
+		return nil, status.Errorf(codes.Unimplemented, "interiors are not enabled")
+	}
+	logger := logging.WithFields("operation", "EnterInterior", "character_id", characterID, "structure_id", structureID)
+	character, err := s.ownedCharacter(ctx, userID, characterID)
+	if err != nil {
+		return nil, err
+	}
+
+	location, err := s.interiors.Locate(ctx, character.ID)
+	if err != nil {
+		logger.Error("Failed to locate character in interiors", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to enter interior")
+	}
+	if location != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "character is already inside an interior")
+	}
+	if s.dungeons != nil {
+		inDungeon, err := s.dungeons.Locate(ctx, character.ID)
+		if err != nil {
+			logger.Error("Failed to locate character in dungeons", "error", err)
+			return nil, status.Errorf(codes.Internal, "failed to enter interior")
+		}
+		if inDungeon != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "character is inside a dungeon")
+		}
+	}
+	structure, err := s.interiors.Structure(ctx, character.WorldID, structureID)
+	if errors.Is(err, entity.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "structure not found")
+	}
+	if err != nil {
+		logger.Error("Failed to get structure", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to enter interior")
+	}
+	if !s.validateMovement(*character, structure.X, structure.Y) {
+		return nil, status.Errorf(codes.FailedPrecondition, "structure is out of reach")
+	}
+	at := s.clock.Now()
+	if s.waitingToMove(ctx, *character, characterID, at) {
+		return nil, status.Errorf(codes.FailedPrecondition, "movement too fast, please wait")
+	}
+
+	resp, err := s.interiors.Enter(ctx, character.ID, structure)
+	if status.Code(err) == codes.FailedPrecondition {
+		return nil, err
+	}
+	if err != nil {
+		logger.Error("Failed to enter interior", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to enter interior")
+	}
+	s.moves.record(characterID, at)
+
+	logger.Info("Character entered interior", "interior_id", resp.Position.InteriorId)
+	return resp, nil
+}
+
+// ExitInterior steps a character standing on its interior's door cell back out, where it
+// stood beside the structure
+func (s *Service) ExitInterior(ctx context.Context, userID, characterID string) (*interiorV1.ExitInteriorResponse, error) {
+	if s.interiors == nil {
+		return nil, status.Errorf(codes.Unimplemented, "interiors are not enabled")
+	}
+	logger := logging.WithFields("operation", "ExitInterior", "character_id", characterID)
+	character, err := s.ownedCharacter(ctx, userID, characterID)
+	if err != nil {
+		return nil, err
+	}
+
+	location, err := s.interiors.Locate(ctx, character.ID)
+	if err != nil {
+		logger.Error("Failed to locate character in interiors", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to exit interior")
+	}
+	if location == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "character is not inside an interior")
+	}
+	door, err := s.interiors.Door(ctx, location.InteriorId)
+	if err != nil {
+		logger.Error("Failed to get interior door", "interior_id", location.InteriorId, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to exit interior")
+	}
+	if location.X != door.X || location.Y != door.Y {
+		return nil, status.Errorf(codes.FailedPrecondition, "characters leave through the door")
+	}
+	at := s.clock.Now()
+	if s.waitingToMove(ctx, *character, characterID, at) {
+		return nil, status.Errorf(codes.FailedPrecondition, "movement too fast, please wait")
+	}
+
+	if err := s.interiors.Exit(ctx, character.ID); err != nil {
+		logger.Error("Failed to exit interior", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to exit interior")
+	}
+	s.moves.record(characterID, at)
+
+	logger.Info("Character left interior", "interior_id", location.InteriorId)
+	return &interiorV1.ExitInteriorResponse{X: character.X, Y: character.Y}, nil
+}
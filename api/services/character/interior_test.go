@@ -0,0 +1,133 @@
+package character
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/entity"
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/VoidMesh/api/api/internal/testutil"
+	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
+	interiorV1 "github.com/VoidMesh/api/api/proto/interior/v1"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeInteriors is one 5x5 room, walled except its floor, with the door at (2, 4) and a
+// table at (2, 2), behind the structure with ID 1 at (10, 9)
+type fakeInteriors struct {
+	inside map[[16]byte]*interiorV1.InteriorPosition
+}
+
+func (f *fakeInteriors) Locate(ctx context.Context, characterID pgtype.UUID) (*interiorV1.InteriorPosition, error) {
+	return f.inside[characterID.Bytes], nil
+}
+
+func (f *fakeInteriors) Structure(ctx context.Context, worldID pgtype.UUID, structureID int64) (*entity.Entity, error) {
+	if structureID != 1 {
+		return nil, entity.ErrNotFound
+	}
+	return &entity.Entity{ID: 1, WorldID: worldID, Type: "cottage", X: 10, Y: 9}, nil
+}
+
+func (f *fakeInteriors) Door(ctx context.Context, interiorID int64) (geometry.Point, error) {
+	return geometry.Point{X: 2, Y: 4}, nil
+}
+
+func (f *fakeInteriors) Passable(ctx context.Context, interiorID int64, x, y int32) (bool, error) {
+	door := x == 2 && y == 4
+	floor := x >= 1 && x <= 3 && y >= 1 && y <= 3 && !(x == 2 && y == 2)
+	return door || floor, nil
+}
+
+func (f *fakeInteriors) Enter(ctx context.Context, characterID pgtype.UUID, structure *entity.Entity) (*interiorV1.EnterInteriorResponse, error) {
+	position := &interiorV1.InteriorPosition{InteriorId: 5, X: 2, Y: 4}
+	f.inside[characterID.Bytes] = position
+	return &interiorV1.EnterInteriorResponse{Interior: &interiorV1.Interior{Id: 5}, Position: position}, nil
+}
+
+func (f *fakeInteriors) MoveTo(ctx context.Context, characterID pgtype.UUID, x, y int32) error {
+	f.inside[characterID.Bytes].X, f.inside[characterID.Bytes].Y = x, y
+	return nil
+}
+
+func (f *fakeInteriors) Exit(ctx context.Context, characterID pgtype.UUID) error {
+	delete(f.inside, characterID.Bytes)
+	return nil
+}
+
+func TestInteriorMovement(t *testing.T) {
+	cleanup := testutil.SetupTest(t, testutil.DefaultTestConfig())
+	defer cleanup()
+
+	userID := "550e8400-e29b-41d4-a716-446655440001"
+	characterID := "550e8400-e29b-41d4-a716-446655440000"
+	charUUID, _ := mockParseUUID(characterID)
+	userUUID, _ := mockParseUUID(userID)
+	mockDB := NewMockDatabase()
+	mockDB.AddCharacter(db.Character{ID: charUUID, UserID: userUUID, WorldID: testWorld(), Name: "TestChar", X: 10, Y: 11})
+
+	fakeClock := clock.NewFake(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	interiors := &fakeInteriors{inside: make(map[[16]byte]*interiorV1.InteriorPosition)}
+	service := NewService(mockDB, NewMockChunkService())
+	service.SetClock(fakeClock)
+	service.SetInteriors(interiors)
+	ctx := playerContext()
+	move := func(x, y int32) *characterV1.MoveCharacterResponse {
+		t.Helper()
+		fakeClock.Advance(MovementCooldown)
+		resp, err := service.MoveCharacter(ctx, &characterV1.MoveCharacterRequest{CharacterId: characterID, NewX: x, NewY: y})
+		require.NoError(t, err)
+		return resp
+	}
+
+	_, err := service.EnterInterior(ctx, userID, characterID, 1)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "the structure is two cells away")
+	_, err = service.EnterInterior(ctx, userID, characterID, 2)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = service.ExitInterior(ctx, userID, characterID)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "the character is outside")
+
+	require.True(t, move(10, 10).Success)
+	_, err = service.EnterInterior(ctx, "550e8400-e29b-41d4-a716-446655440002", characterID, 1)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = service.EnterInterior(ctx, userID, characterID, 1)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "entering waits for the movement cooldown")
+
+	fakeClock.Advance(MovementCooldown)
+	entered, err := service.EnterInterior(ctx, userID, characterID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), entered.Position.Y)
+	_, err = service.EnterInterior(ctx, userID, characterID, 1)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "the character is already inside")
+
+	// Inside, moves are on the interior's grid and the world position stays put
+	resp := move(2, 3)
+	require.True(t, resp.Success, resp.ErrorMessage)
+	assert.Equal(t, int32(3), resp.Interior.Y)
+	assert.Equal(t, int32(10), resp.Character.Y)
+	resp = move(2, 2)
+	assert.False(t, resp.Success, "furniture blocks the way")
+	assert.Equal(t, int32(3), resp.Interior.Y)
+	assert.False(t, move(2, 1).Success, "steps are still one cell")
+	assert.False(t, move(0, 3).Success)
+
+	_, err = service.ExitInterior(ctx, userID, characterID)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "characters leave through the door")
+	require.True(t, move(2, 4).Success)
+	fakeClock.Advance(MovementCooldown)
+	exited, err := service.ExitInterior(ctx, userID, characterID)
+	require.NoError(t, err)
+	assert.Equal(t, int32(10), exited.X)
+	assert.Equal(t, int32(10), exited.Y)
+
+	resp = move(10, 11)
+	require.True(t, resp.Success, "outside again, moves are in the world")
+	assert.Nil(t, resp.Interior)
+}
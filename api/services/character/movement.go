@@ -2,27 +2,86 @@ package character
 
 import (
 	"context"
-	"fmt"
+	"sync"
 	"time"
 
 	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/geometry"
 	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/uuid"
 	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
-	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	"github.com/jackc/pgx/v5/pgtype"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
-// movementCache stores last movement times for rate limiting
-var movementCache = make(map[string]time.Time)
+// moveTimes stores last movement times for rate limiting. Moves come from RPCs and from
+// the action queue tick at once, so every access holds the lock.
+type moveTimes struct {
+	mu    sync.Mutex
+	times map[string]time.Time
+}
+
+func newMoveTimes() *moveTimes {
+	return &moveTimes{times: make(map[string]time.Time)}
+}
+
+// last returns when the character last moved
+func (m *moveTimes) last(characterID string) (time.Time, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	at, ok := m.times[characterID]
+	return at, ok
+}
+
+// record sets when the character last moved
+func (m *moveTimes) record(characterID string, at time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.times[characterID] = at
+}
+
+func (m *moveTimes) len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.times)
+}
 
 const (
 	MovementCooldown = 50 * time.Millisecond // 50ms between moves for smoother gameplay
 	MaxMoveDistance  = 1                     // Max 1 cell per move
 )
 
+// MoveRecorder is told about every successful move, e.g. by debug region recordings or
+// the analytics exporter
+type MoveRecorder interface {
+	RecordMove(ctx context.Context, character db.Character)
+}
+
+// AddMoveRecorder registers a recorder notified of successful moves
+func (s *Service) AddMoveRecorder(recorder MoveRecorder) {
+	s.recorders = append(s.recorders, recorder)
+}
+
+// ChunkPrefetcher loads the chunks ahead of a moving character in the background
+type ChunkPrefetcher interface {
+	Prefetch(characterID, worldID string, fromX, fromY, toX, toY int32)
+}
+
+// SetChunkPrefetcher registers the prefetcher told about every successful move
+func (s *Service) SetChunkPrefetcher(prefetcher ChunkPrefetcher) {
+	s.prefetcher = prefetcher
+}
+
 // MoveCharacter handles character movement with anti-cheat validation
 func (s *Service) MoveCharacter(ctx context.Context, req *characterV1.MoveCharacterRequest) (*characterV1.MoveCharacterResponse, error) {
+	return s.MoveCharacterAt(ctx, req, s.clock.Now())
+}
+
+// MoveCharacterAt moves a character as of the given time. The action queue passes
+// its logical tick time so cooldowns line up exactly with the tick rate.
+func (s *Service) MoveCharacterAt(ctx context.Context, req *characterV1.MoveCharacterRequest, at time.Time) (*characterV1.MoveCharacterResponse, error) {
 	logger := logging.WithFields("character_id", req.CharacterId, "new_x", req.NewX, "new_y", req.NewY)
 	logger.Debug("Processing character movement request")
 
@@ -42,9 +101,47 @@ func (s *Service) MoveCharacter(ctx context.Context, req *characterV1.MoveCharac
 		return nil, status.Errorf(codes.NotFound, "character not found: %v", err)
 	}
 
+	if err := session.RequireWorld(ctx, character.WorldID); err != nil {
+		logger.Warn("Movement rejected: character is in a different world", "error", err)
+		return nil, err
+	}
+	// Chunks this move generates are credited to the character
+	ctx = session.WithCharacterID(ctx, req.CharacterId)
+
 	loggerWithChar := logger.With("current_x", character.X, "current_y", character.Y)
 	loggerWithChar.Debug("Character loaded, validating movement")
 
+	if !validFacing(req.Facing) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid facing: %v", req.Facing)
+	}
+	// Harvesting is set by the harvest itself, clients only say whether they walk or stand
+	if req.ActionState != characterV1.ActionState_ACTION_STATE_UNSPECIFIED &&
+		req.ActionState != characterV1.ActionState_ACTION_STATE_IDLE &&
+		req.ActionState != characterV1.ActionState_ACTION_STATE_WALKING {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid action state: %v", req.ActionState)
+	}
+	if s.dungeons != nil {
+		location, err := s.dungeons.Locate(ctx, character.ID)
+		if err != nil {
+			loggerWithChar.Error("Failed to locate character in dungeons", "error", err)
+			return nil, status.Errorf(codes.Internal, "failed to locate character: %v", err)
+		}
+		if location != nil {
+			return s.moveInDungeon(ctx, character, location, req, at)
+		}
+	}
+	if s.interiors != nil {
+		location, err := s.interiors.Locate(ctx, character.ID)
+		if err != nil {
+			loggerWithChar.Error("Failed to locate character in interiors", "error", err)
+			return nil, status.Errorf(codes.Internal, "failed to locate character: %v", err)
+		}
+		if location != nil {
+			return s.moveInside(ctx, character, location, req, at)
+		}
+	}
+	moved := req.NewX != character.X || req.NewY != character.Y
+
 	// Anti-cheat validation
 	loggerWithChar.Debug("Running anti-cheat movement validation")
 	if !s.validateMovement(character, req.NewX, req.NewY) {
@@ -59,16 +156,17 @@ func (s *Service) MoveCharacter(ctx context.Context, req *characterV1.MoveCharac
 	}
 	loggerWithChar.Debug("Anti-cheat validation passed")
 
-	// Check rate limiting
+	// Check rate limiting; movement speed effects shorten or lengthen the cooldown
 	characterID := req.CharacterId
-	lastMove, exists := movementCache[characterID]
+	lastMove, exists := s.moves.last(characterID)
 	loggerWithChar.Debug("Checking movement rate limiting", "exists", exists)
 	if exists {
-		timeSinceLastMove := time.Since(lastMove)
-		loggerWithChar.Debug("Time since last movement", "elapsed", timeSinceLastMove, "cooldown", MovementCooldown)
-		if timeSinceLastMove < MovementCooldown {
+		cooldown := s.cooldown(ctx, character)
+		timeSinceLastMove := at.Sub(lastMove)
+		loggerWithChar.Debug("Time since last movement", "elapsed", timeSinceLastMove, "cooldown", cooldown)
+		if timeSinceLastMove < cooldown {
 			loggerWithChar.Warn("Movement rejected: rate limit exceeded",
-				"time_since_last", timeSinceLastMove, "required_cooldown", MovementCooldown)
+				"time_since_last", timeSinceLastMove, "required_cooldown", cooldown)
 			return &characterV1.MoveCharacterResponse{
 				Success:      false,
 				ErrorMessage: "Movement too fast, please wait",
@@ -77,44 +175,67 @@ func (s *Service) MoveCharacter(ctx context.Context, req *characterV1.MoveCharac
 	}
 	loggerWithChar.Debug("Rate limiting check passed")
 
-	// Validate destination terrain
-	loggerWithChar.Debug("Validating destination terrain")
-	valid, err := s.isValidMovePosition(ctx, req.NewX, req.NewY)
-	if err != nil {
-		loggerWithChar.Error("Failed to validate destination terrain", "error", err)
-		return nil, status.Errorf(codes.Internal, "failed to validate position: %v", err)
-	}
-	if !valid {
-		loggerWithChar.Warn("Movement rejected: invalid destination terrain")
-		return &characterV1.MoveCharacterResponse{
-			Success:      false,
-			ErrorMessage: "Cannot move to that position (water or stone)",
-		}, nil
+	// Validate destination terrain; turning on the spot is allowed wherever the character stands
+	if moved {
+		loggerWithChar.Debug("Validating destination terrain")
+		valid, err := s.isValidMovePosition(ctx, character.WorldID, req.NewX, req.NewY)
+		if err != nil {
+			loggerWithChar.Error("Failed to validate destination terrain", "error", err)
+			return nil, status.Errorf(codes.Internal, "failed to validate position: %v", err)
+		}
+		if !valid {
+			loggerWithChar.Warn("Movement rejected: invalid destination terrain")
+			return &characterV1.MoveCharacterResponse{
+				Success:      false,
+				ErrorMessage: "Cannot move to that position (water or stone)",
+			}, nil
+		}
+		loggerWithChar.Debug("Destination terrain validation passed")
 	}
-	loggerWithChar.Debug("Destination terrain validation passed")
 
 	// Calculate new chunk coordinates
-	newChunkX, newChunkY := s.worldToChunkCoords(req.NewX, req.NewY)
+	newChunkX, newChunkY := geometry.ChunkOf(geometry.Point{X: req.NewX, Y: req.NewY})
 	loggerWithChar.Debug("Calculated new chunk coordinates", "new_chunk_x", newChunkX, "new_chunk_y", newChunkY)
 
 	// Update character position
 	loggerWithChar.Debug("Updating character position in database")
-	updatedCharacter, err := s.db.UpdateCharacterPosition(ctx, db.UpdateCharacterPositionParams{
-		ID:     charUUID,
-		X:      req.NewX,
-		Y:      req.NewY,
-		ChunkX: newChunkX,
-		ChunkY: newChunkY,
-	})
+	facing, actionState := moveState(character, req)
+	position := db.UpdateCharacterPositionParams{
+		ID:          charUUID,
+		X:           req.NewX,
+		Y:           req.NewY,
+		ChunkX:      newChunkX,
+		ChunkY:      newChunkY,
+		Facing:      int32(facing),
+		ActionState: int32(actionState),
+	}
+	var updatedCharacter db.Character
+	if s.positions != nil {
+		updatedCharacter, err = s.positions.Move(ctx, character, position)
+	} else {
+		updatedCharacter, err = s.db.UpdateCharacterPosition(ctx, position)
+	}
 	if err != nil {
 		loggerWithChar.Error("Failed to update character position in database", "error", err)
 		return nil, status.Errorf(codes.Internal, "failed to update character position: %v", err)
 	}
 
 	// Update movement cache
-	movementCache[characterID] = time.Now()
+	s.moves.record(characterID, at)
 	loggerWithChar.Debug("Updated movement cache timestamp")
 
+	// Turns and stops are sent to nearby streams so remote players animate them, but
+	// they are not moves for recorders or prefetching
+	s.publishMove(updatedCharacter)
+	if moved {
+		for _, recorder := range s.recorders {
+			recorder.RecordMove(ctx, updatedCharacter)
+		}
+		if s.prefetcher != nil {
+			s.prefetcher.Prefetch(req.CharacterId, uuid.PgtypeToString(character.WorldID), character.X, character.Y, updatedCharacter.X, updatedCharacter.Y)
+		}
+	}
+
 	duration := time.Since(start)
 	loggerWithChar.Info("Character movement completed successfully",
 		"final_x", updatedCharacter.X, "final_y", updatedCharacter.Y, "duration", duration)
@@ -125,11 +246,87 @@ func (s *Service) MoveCharacter(ctx context.Context, req *characterV1.MoveCharac
 	}, nil
 }
 
+// SetActionState records what a character is doing and tells nearby streams, e.g. when
+// it starts harvesting
+func (s *Service) SetActionState(ctx context.Context, characterID pgtype.UUID, state characterV1.ActionState) error {
+	// The update returns the stored position, so buffered moves go first
+	if s.positions != nil {
+		if err := s.positions.FlushCharacter(ctx, characterID); err != nil {
+			return status.Errorf(codes.Internal, "failed to store position: %v", err)
+		}
+	}
+	character, err := s.db.UpdateCharacterActionState(ctx, db.UpdateCharacterActionStateParams{
+		ID:          characterID,
+		ActionState: int32(state),
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to update action state: %v", err)
+	}
+	s.publishMove(character)
+	return nil
+}
+
+// moveState is the facing and action state a character has after a move request. Left
+// unspecified, the character faces the way it stepped and walks, or keeps its facing and
+// stands idle when it stays put.
+func moveState(character db.Character, req *characterV1.MoveCharacterRequest) (characterV1.Facing, characterV1.ActionState) {
+	facing := req.Facing
+	if facing == characterV1.Facing_FACING_UNSPECIFIED {
+		facing = stepFacing(req.NewX-character.X, req.NewY-character.Y, characterV1.Facing(character.Facing))
+	}
+
+	state := req.ActionState
+	if state == characterV1.ActionState_ACTION_STATE_UNSPECIFIED {
+		state = characterV1.ActionState_ACTION_STATE_IDLE
+		if req.NewX != character.X || req.NewY != character.Y {
+			state = characterV1.ActionState_ACTION_STATE_WALKING
+		}
+	}
+	return facing, state
+}
+
+// stepFacing is the direction of a step, or current when there is no step
+func stepFacing(deltaX, deltaY int32, current characterV1.Facing) characterV1.Facing {
+	switch {
+	case deltaY < 0:
+		return characterV1.Facing_FACING_NORTH
+	case deltaY > 0:
+		return characterV1.Facing_FACING_SOUTH
+	case deltaX > 0:
+		return characterV1.Facing_FACING_EAST
+	case deltaX < 0:
+		return characterV1.Facing_FACING_WEST
+	default:
+		return current
+	}
+}
+
+// validFacing reports whether a requested facing is one of the known directions or unspecified
+func validFacing(facing characterV1.Facing) bool {
+	_, ok := characterV1.Facing_name[int32(facing)]
+	return ok
+}
+
+// cooldown is the time a character waits between moves; movement speed effects shorten
+// or lengthen it
+func (s *Service) cooldown(ctx context.Context, character db.Character) time.Duration {
+	cooldown := MovementCooldown
+	if s.effects != nil {
+		cooldown = time.Duration(float64(cooldown) / s.effects.Multiplier(ctx, character.ID, characterV1.StatusEffectType_STATUS_EFFECT_TYPE_MOVEMENT_SPEED))
+	}
+	return cooldown
+}
+
 // validateMovement checks if the movement is valid (distance and speed)
 func (s *Service) validateMovement(character db.Character, newX, newY int32) bool {
+	return validStep(character.X, character.Y, newX, newY)
+}
+
+// validStep reports whether going from one cell to another is at most one orthogonal step
+func validStep(fromX, fromY, toX, toY int32) bool {
 	// Calculate distance
-	deltaX := abs32(newX - character.X)
-	deltaY := abs32(newY - character.Y)
+	deltaX := geometry.Abs(toX - fromX)
+	deltaY := geometry.Abs(toY - fromY)
 
 	// Check maximum distance (Manhattan distance)
 	distance := deltaX + deltaY
@@ -145,50 +342,7 @@ func (s *Service) validateMovement(character db.Character, newX, newY int32) boo
 	return true
 }
 
-// isValidMovePosition checks if a position is valid for movement
-func (s *Service) isValidMovePosition(ctx context.Context, x, y int32) (bool, error) {
-	chunkX, chunkY := s.worldToChunkCoords(x, y)
-
-	// Get the chunk
-	chunkData, err := s.chunkService.GetOrCreateChunk(ctx, chunkX, chunkY)
-	if err != nil {
-		return false, err
-	}
-
-	// Calculate local coordinates within the chunk
-	localX := x - chunkX*s.chunkSize
-	localY := y - chunkY*s.chunkSize
-
-	// Handle negative coordinates
-	if localX < 0 {
-		localX += s.chunkSize
-	}
-	if localY < 0 {
-		localY += s.chunkSize
-	}
-
-	// Validate coordinates are within chunk bounds
-	if localX < 0 || localX >= s.chunkSize || localY < 0 || localY >= s.chunkSize {
-		return false, fmt.Errorf("coordinates out of chunk bounds")
-	}
-
-	// Get the terrain cell (row-major order)
-	index := localY*s.chunkSize + localX
-	if index < 0 || index >= int32(len(chunkData.Cells)) {
-		return false, fmt.Errorf("invalid cell index")
-	}
-
-	cell := chunkData.Cells[index]
-
-	// Check if terrain is walkable
-	switch cell.TerrainType {
-	case chunkV1.TerrainType_TERRAIN_TYPE_WATER, chunkV1.TerrainType_TERRAIN_TYPE_STONE:
-		return false, nil // Not walkable
-	case chunkV1.TerrainType_TERRAIN_TYPE_GRASS,
-		chunkV1.TerrainType_TERRAIN_TYPE_SAND,
-		chunkV1.TerrainType_TERRAIN_TYPE_DIRT:
-		return true, nil // Walkable
-	default:
-		return false, nil // Unknown terrain type, assume not walkable
-	}
+// isValidMovePosition checks if a position in the character's world is valid for movement
+func (s *Service) isValidMovePosition(ctx context.Context, worldID pgtype.UUID, x, y int32) (bool, error) {
+	return s.chunkService.IsPassable(ctx, worldID, x, y)
 }
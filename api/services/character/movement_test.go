@@ -1,6 +1,7 @@
 package character
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -13,6 +14,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
 	"github.com/VoidMesh/api/api/internal/testutil"
 	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
 	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
@@ -24,29 +26,26 @@ func TestMovementCooldown_CriticalAntiCheat(t *testing.T) {
 	cleanup := testutil.SetupTest(t, testutil.DefaultTestConfig())
 	defer cleanup()
 
-	// Clear the movement cache before test
-	movementCache = make(map[string]time.Time)
-
 	tests := []struct {
 		name            string
 		characterID     string
-		setupCache      func()
+		setupCache      func(moves *moveTimes)
 		expectBlocked   bool
 		description     string
 	}{
 		{
 			name:        "no previous movement - should allow",
 			characterID: "550e8400-e29b-41d4-a716-446655440000",
-			setupCache:  func() {}, // No setup, cache is empty
+			setupCache:  func(moves *moveTimes) {}, // No setup, cache is empty
 			expectBlocked: false,
 			description: "First movement should always be allowed",
 		},
 		{
 			name:        "movement within cooldown - should block",
 			characterID: "550e8400-e29b-41d4-a716-446655440001",
-			setupCache: func() {
+			setupCache: func(moves *moveTimes) {
 				// Set last movement to 10ms ago (within 50ms cooldown)
-				movementCache["550e8400-e29b-41d4-a716-446655440001"] = time.Now().Add(-10 * time.Millisecond)
+				moves.record("550e8400-e29b-41d4-a716-446655440001", time.Now().Add(-10 * time.Millisecond))
 			},
 			expectBlocked: true,
 			description: "Movement within 50ms cooldown should be blocked",
@@ -54,9 +53,9 @@ func TestMovementCooldown_CriticalAntiCheat(t *testing.T) {
 		{
 			name:        "movement after cooldown - should allow",
 			characterID: "550e8400-e29b-41d4-a716-446655440002",
-			setupCache: func() {
+			setupCache: func(moves *moveTimes) {
 				// Set last movement to 60ms ago (beyond 50ms cooldown)
-				movementCache["550e8400-e29b-41d4-a716-446655440002"] = time.Now().Add(-60 * time.Millisecond)
+				moves.record("550e8400-e29b-41d4-a716-446655440002", time.Now().Add(-60 * time.Millisecond))
 			},
 			expectBlocked: false,
 			description: "Movement after cooldown expires should be allowed",
@@ -64,9 +63,9 @@ func TestMovementCooldown_CriticalAntiCheat(t *testing.T) {
 		{
 			name:        "exactly at cooldown boundary - should allow",
 			characterID: "550e8400-e29b-41d4-a716-446655440003",
-			setupCache: func() {
+			setupCache: func(moves *moveTimes) {
 				// Set last movement to exactly 50ms ago
-				movementCache["550e8400-e29b-41d4-a716-446655440003"] = time.Now().Add(-MovementCooldown)
+				moves.record("550e8400-e29b-41d4-a716-446655440003", time.Now().Add(-MovementCooldown))
 			},
 			expectBlocked: false,
 			description: "Movement at exact cooldown boundary should be allowed",
@@ -83,9 +82,6 @@ func TestMovementCooldown_CriticalAntiCheat(t *testing.T) {
 				Bytes: [16]byte{0x55, 0x0e, 0x84, 0x00, 0xe2, 0x9b, 0x41, 0xd4, 0xa7, 0x16, 0x44, 0x66, 0x55, 0x44, 0x00, 0x00},
 			}
 
-			// Setup cache state
-			tt.setupCache()
-
 			if !tt.expectBlocked {
 				// If movement should be allowed, setup full mock chain
 				testDB.MockPool.ExpectQuery("SELECT (.+) FROM characters WHERE id").
@@ -109,6 +105,9 @@ func TestMovementCooldown_CriticalAntiCheat(t *testing.T) {
 			service := NewServiceWithPool(testDB.Pool, mockChunkService)
 			ctx := testutil.CreateTestContext()
 
+			// Setup cache state
+			tt.setupCache(service.moves)
+
 			request := &characterV1.MoveCharacterRequest{
 				CharacterId: tt.characterID,
 				NewX:        11, // Move one cell right
@@ -128,7 +127,7 @@ func TestMovementCooldown_CriticalAntiCheat(t *testing.T) {
 				assert.Empty(t, response.ErrorMessage, "Should have no error message: %s", tt.description)
 				
 				// Verify movement cache was updated
-				_, exists := movementCache[tt.characterID]
+				_, exists := service.moves.last(tt.characterID)
 				assert.True(t, exists, "Movement cache should be updated after successful movement")
 			}
 
@@ -390,7 +389,7 @@ func TestIsValidMovePosition_TerrainCollision(t *testing.T) {
 			service := NewServiceWithPool(testDB.Pool, mockChunkService)
 			ctx := testutil.CreateTestContext()
 
-			valid, err := service.isValidMovePosition(ctx, tt.x, tt.y)
+			valid, err := service.isValidMovePosition(ctx, pgtype.UUID{}, tt.x, tt.y)
 
 			if tt.expectError {
 				assert.Error(t, err, tt.description)
@@ -408,9 +407,6 @@ func TestMoveCharacter_FullIntegration(t *testing.T) {
 	cleanup := testutil.SetupTest(t, testutil.DefaultTestConfig())
 	defer cleanup()
 
-	// Clear the movement cache before test
-	movementCache = make(map[string]time.Time)
-
 	tests := []struct {
 		name           string
 		request        *characterV1.MoveCharacterRequest
@@ -640,9 +636,6 @@ func TestMovementCooldown_ConcurrentSafety(t *testing.T) {
 	cleanup := testutil.SetupTest(t, testutil.DefaultTestConfig())
 	defer cleanup()
 
-	// Clear the movement cache before test
-	movementCache = make(map[string]time.Time)
-
 	testDB := testutil.SetupTestDB(t, testutil.MockDatabaseConfig())
 	defer testDB.Close()
 
@@ -704,9 +697,7 @@ func TestMovementCooldown_ExactTiming(t *testing.T) {
 
 	assert.Equal(t, 50*time.Millisecond, MovementCooldown, "Movement cooldown should be exactly 50ms")
 	
-	// Clear the movement cache
-	movementCache = make(map[string]time.Time)
-	
+	moves := newMoveTimes()
 	characterID := "test-character-123"
 	
 	// Test boundary conditions
@@ -722,9 +713,9 @@ func TestMovementCooldown_ExactTiming(t *testing.T) {
 	
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			movementCache[characterID] = time.Now().Add(-tc.timeSince)
+			moves.record(characterID, time.Now().Add(-tc.timeSince))
 			
-			lastMove, exists := movementCache[characterID]
+			lastMove, exists := moves.last(characterID)
 			require.True(t, exists)
 			
 			timeSinceLastMove := time.Since(lastMove)
@@ -735,4 +726,203 @@ func TestMovementCooldown_ExactTiming(t *testing.T) {
 				timeSinceLastMove, MovementCooldown, tc.expectBlocked)
 		})
 	}
-}
\ No newline at end of file
+}
+func TestMoveCharacter_CooldownWithFakeClock(t *testing.T) {
+	cleanup := testutil.SetupTest(t, testutil.DefaultTestConfig())
+	defer cleanup()
+
+	characterID := "550e8400-e29b-41d4-a716-446655440000"
+	charUUID, _ := mockParseUUID(characterID)
+	mockDB := NewMockDatabase()
+	mockDB.AddCharacter(db.Character{ID: charUUID, WorldID: testWorld(), Name: "TestChar", X: 10, Y: 10})
+
+	fakeClock := clock.NewFake(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	service := NewService(mockDB, NewMockChunkService())
+	service.SetClock(fakeClock)
+	ctx := playerContext()
+
+	move := func(x int32) *characterV1.MoveCharacterResponse {
+		resp, err := service.MoveCharacter(ctx, &characterV1.MoveCharacterRequest{CharacterId: characterID, NewX: x, NewY: 10})
+		require.NoError(t, err)
+		return resp
+	}
+
+	assert.True(t, move(11).Success, "first move is always allowed")
+
+	blocked := move(12)
+	assert.False(t, blocked.Success, "time has not moved, so the cooldown still applies")
+	assert.Contains(t, blocked.ErrorMessage, "too fast")
+
+	fakeClock.Advance(MovementCooldown - time.Millisecond)
+	assert.False(t, move(12).Success)
+
+	fakeClock.Advance(time.Millisecond)
+	assert.True(t, move(12).Success, "cooldown elapsed exactly")
+
+	fakeClock.Advance(6 * time.Hour)
+	assert.True(t, move(13).Success)
+	assert.Equal(t, 1, service.moves.len())
+	lastMove, _ := service.moves.last(characterID)
+	assert.Equal(t, fakeClock.Now(), lastMove)
+}
+
+// fixedEffects reports one multiplier for every character and no active effects
+type fixedEffects struct {
+	multiplier float64
+}
+
+func (f fixedEffects) Active(ctx context.Context, characterIDs []pgtype.UUID) (map[[16]byte][]*characterV1.StatusEffect, error) {
+	return nil, nil
+}
+
+func (f fixedEffects) Multiplier(ctx context.Context, characterID pgtype.UUID, effectType characterV1.StatusEffectType) float64 {
+	if effectType != characterV1.StatusEffectType_STATUS_EFFECT_TYPE_MOVEMENT_SPEED {
+		return 1
+	}
+	return f.multiplier
+}
+
+func TestMoveCharacter_MovementSpeedEffects(t *testing.T) {
+	cleanup := testutil.SetupTest(t, testutil.DefaultTestConfig())
+	defer cleanup()
+
+	characterID := "550e8400-e29b-41d4-a716-446655440000"
+	charUUID, _ := mockParseUUID(characterID)
+	mockDB := NewMockDatabase()
+	mockDB.AddCharacter(db.Character{ID: charUUID, WorldID: testWorld(), Name: "TestChar", X: 10, Y: 10})
+
+	fakeClock := clock.NewFake(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	service := NewService(mockDB, NewMockChunkService())
+	service.SetClock(fakeClock)
+	service.SetStatusEffects(fixedEffects{multiplier: 2})
+	ctx := playerContext()
+
+	move := func(x int32) bool {
+		resp, err := service.MoveCharacter(ctx, &characterV1.MoveCharacterRequest{CharacterId: characterID, NewX: x, NewY: 10})
+		require.NoError(t, err)
+		return resp.Success
+	}
+
+	assert.True(t, move(11))
+	fakeClock.Advance(MovementCooldown / 2)
+	assert.True(t, move(12), "twice the speed halves the cooldown")
+
+	service.SetStatusEffects(fixedEffects{multiplier: 0.5})
+	fakeClock.Advance(MovementCooldown)
+	assert.False(t, move(13), "half the speed doubles the cooldown")
+	fakeClock.Advance(MovementCooldown)
+	assert.True(t, move(13))
+}
+
+// prefetchRecorder records the moves and worlds passed to the chunk prefetcher
+type prefetchRecorder struct {
+	moves  [][4]int32
+	worlds []string
+}
+
+func (p *prefetchRecorder) Prefetch(characterID, worldID string, fromX, fromY, toX, toY int32) {
+	p.moves = append(p.moves, [4]int32{fromX, fromY, toX, toY})
+	p.worlds = append(p.worlds, worldID)
+}
+
+func TestMoveCharacter_PrefetchesAhead(t *testing.T) {
+	cleanup := testutil.SetupTest(t, testutil.DefaultTestConfig())
+	defer cleanup()
+
+	characterID := "550e8400-e29b-41d4-a716-446655440000"
+	charUUID, _ := mockParseUUID(characterID)
+	mockDB := NewMockDatabase()
+	mockDB.AddCharacter(db.Character{ID: charUUID, WorldID: testWorld(), Name: "TestChar", X: 10, Y: 10})
+
+	prefetcher := &prefetchRecorder{}
+	service := NewService(mockDB, NewMockChunkService())
+	service.SetClock(clock.NewFake(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)))
+	service.SetChunkPrefetcher(prefetcher)
+	ctx := playerContext()
+
+	resp, err := service.MoveCharacter(ctx, &characterV1.MoveCharacterRequest{CharacterId: characterID, NewX: 11, NewY: 10})
+	require.NoError(t, err)
+	require.True(t, resp.Success)
+	assert.Equal(t, [][4]int32{{10, 10, 11, 10}}, prefetcher.moves)
+	assert.Equal(t, []string{testWorldID}, prefetcher.worlds, "chunks are prefetched in the character's world")
+
+	// Rejected moves don't prefetch
+	resp, err = service.MoveCharacter(ctx, &characterV1.MoveCharacterRequest{CharacterId: characterID, NewX: 12, NewY: 10})
+	require.NoError(t, err)
+	require.False(t, resp.Success)
+	assert.Len(t, prefetcher.moves, 1)
+}
+
+func TestMoveCharacter_FacingAndActionState(t *testing.T) {
+	cleanup := testutil.SetupTest(t, testutil.DefaultTestConfig())
+	defer cleanup()
+
+	characterID := "550e8400-e29b-41d4-a716-446655440000"
+	charUUID, _ := mockParseUUID(characterID)
+	mockDB := NewMockDatabase()
+	mockDB.AddCharacter(db.Character{
+		ID:          charUUID,
+		WorldID:     testWorld(),
+		Name:        "TestChar",
+		X:           10,
+		Y:           10,
+		Facing:      int32(characterV1.Facing_FACING_SOUTH),
+		ActionState: int32(characterV1.ActionState_ACTION_STATE_IDLE),
+	})
+
+	prefetcher := &prefetchRecorder{}
+	service := NewService(mockDB, NewMockChunkService())
+	service.SetChunkPrefetcher(prefetcher)
+	ctx := playerContext()
+	at := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	move := func(req *characterV1.MoveCharacterRequest) *characterV1.Character {
+		t.Helper()
+		at = at.Add(MovementCooldown)
+		req.CharacterId = characterID
+		resp, err := service.MoveCharacterAt(ctx, req, at)
+		require.NoError(t, err)
+		require.True(t, resp.Success, resp.ErrorMessage)
+		return resp.Character
+	}
+
+	// A step faces its direction and walks
+	character := move(&characterV1.MoveCharacterRequest{NewX: 10, NewY: 9})
+	assert.Equal(t, characterV1.Facing_FACING_NORTH, character.Facing)
+	assert.Equal(t, characterV1.ActionState_ACTION_STATE_WALKING, character.ActionState)
+
+	character = move(&characterV1.MoveCharacterRequest{NewX: 9, NewY: 9})
+	assert.Equal(t, characterV1.Facing_FACING_WEST, character.Facing)
+
+	// Staying put keeps the facing and stops walking
+	character = move(&characterV1.MoveCharacterRequest{NewX: 9, NewY: 9})
+	assert.Equal(t, characterV1.Facing_FACING_WEST, character.Facing)
+	assert.Equal(t, characterV1.ActionState_ACTION_STATE_IDLE, character.ActionState)
+
+	// Turning on the spot
+	character = move(&characterV1.MoveCharacterRequest{NewX: 9, NewY: 9, Facing: characterV1.Facing_FACING_EAST})
+	assert.Equal(t, characterV1.Facing_FACING_EAST, character.Facing)
+	assert.Equal(t, int32(9), character.X)
+
+	// Only real steps prefetch
+	assert.Len(t, prefetcher.moves, 2)
+
+	// The state is stored for clients that reconnect
+	stored, err := service.GetCharacterByID(ctx, characterID)
+	require.NoError(t, err)
+	assert.Equal(t, int32(characterV1.Facing_FACING_EAST), stored.Facing)
+	assert.Equal(t, int32(characterV1.ActionState_ACTION_STATE_IDLE), stored.ActionState)
+
+	// Clients cannot claim to be harvesting or send unknown values
+	for _, req := range []*characterV1.MoveCharacterRequest{
+		{CharacterId: characterID, NewX: 9, NewY: 9, ActionState: characterV1.ActionState_ACTION_STATE_HARVESTING},
+		{CharacterId: characterID, NewX: 9, NewY: 9, Facing: characterV1.Facing(9)},
+	} {
+		_, err := service.MoveCharacterAt(ctx, req, at.Add(time.Minute))
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+
+	require.NoError(t, service.SetActionState(ctx, charUUID, characterV1.ActionState_ACTION_STATE_HARVESTING))
+	stored, err = service.GetCharacterByID(ctx, characterID)
+	require.NoError(t, err)
+	assert.Equal(t, int32(characterV1.ActionState_ACTION_STATE_HARVESTING), stored.ActionState)
+}
@@ -0,0 +1,234 @@
+package character
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/authz"
+	"github.com/VoidMesh/api/api/internal/debugstats"
+	"github.com/VoidMesh/api/api/internal/entity"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/VoidMesh/api/api/internal/interest"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	"github.com/VoidMesh/api/api/services/chunk"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DefaultNearbyRadius = chunk.ChunkSize     // One chunk around the character
+	MaxNearbyRadius     = 4 * chunk.ChunkSize // Keeps a single stream from covering the whole world
+	nearbyBufferSize    = 64
+)
+
+// NearbyEvents routes positional events to the streams whose area of interest contains them
+type NearbyEvents = interest.Manager[*characterV1.NearbyEvent]
+
+// NewNearbyEvents creates the interest manager shared by every character service instance.
+// Subscribers are indexed per chunk since the default radius is one chunk.
+func NewNearbyEvents() *NearbyEvents {
+	nearby := interest.NewManager[*characterV1.NearbyEvent](chunk.ChunkSize)
+	debugstats.Register(debugstats.StreamSubscriptions, "character.nearby_streams", func() int64 {
+		return int64(nearby.Len())
+	})
+	return nearby
+}
+
+// EntityFinder finds the world entities around a point
+type EntityFinder interface {
+	WithinRadius(ctx context.Context, worldID pgtype.UUID, center geometry.Point, radius int32, types ...string) ([]*entity.Entity, error)
+}
+
+// SetEntities makes nearby streams start with the entities already in their area
+func (s *Service) SetEntities(entities EntityFinder) {
+	s.entities = entities
+}
+
+// SetNearbyEvents enables nearby event routing; movements are published to it and
+// subscriptions follow their character as it moves
+func (s *Service) SetNearbyEvents(nearby *NearbyEvents) {
+	s.nearby = nearby
+}
+
+// SubscribeNearby streams events within radius cells of the user's character. The returned
+// function ends the subscription and closes the channel.
+func (s *Service) SubscribeNearby(ctx context.Context, userID, characterID string, radius int32) (<-chan *characterV1.NearbyEvent, func(), error) {
+	if s.nearby == nil {
+		return nil, nil, status.Errorf(codes.Unavailable, "nearby events are not enabled")
+	}
+	radius, err := nearbyRadius(radius)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	character, err := s.ownedCharacter(ctx, userID, characterID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var present []*entity.Entity
+	if s.entities != nil {
+		present, err = s.entities.WithinRadius(ctx, character.WorldID, geometry.Point{X: character.X, Y: character.Y}, radius)
+		if err != nil {
+			logging.WithFields("character_id", characterID, "error", err).Error("Failed to load nearby entities")
+			return nil, nil, status.Errorf(codes.Internal, "failed to load nearby entities")
+		}
+	}
+
+	// The buffer holds the whole snapshot on top of the usual room for live events
+	sub := s.nearby.Subscribe(
+		uuid.PgtypeToNormalizedString(character.WorldID),
+		uuid.PgtypeToString(character.ID),
+		interest.Point{X: character.X, Y: character.Y},
+		radius,
+		nearbyBufferSize+len(present),
+	)
+	for _, e := range present {
+		s.nearby.Deliver(sub, entityPresentEvent(e))
+	}
+	logging.WithFields("character_id", characterID, "radius", radius).Debug("Nearby event subscription started")
+	return sub.C, func() {
+		s.nearby.Unsubscribe(sub)
+		s.flushOnDisconnect(character.ID)
+	}, nil
+}
+
+// flushOnDisconnect stores a character's buffered position once its client stops
+// listening, so it is not left waiting for the next flush
+func (s *Service) flushOnDisconnect(characterID pgtype.UUID) {
+	if s.positions == nil {
+		return
+	}
+	// The stream's context is already done
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := s.positions.FlushCharacter(ctx, characterID); err != nil {
+		logging.WithFields("character_id", uuid.PgtypeToString(characterID), "error", err).Error("Failed to store position on disconnect")
+	}
+}
+
+// nearbyRadius applies the default and cap to a requested area of interest radius
+func nearbyRadius(radius int32) (int32, error) {
+	if radius < 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "radius must not be negative")
+	}
+	if radius == 0 {
+		return DefaultNearbyRadius, nil
+	}
+	return min(radius, MaxNearbyRadius), nil
+}
+
+// ownedCharacter loads a character, failing unless it belongs to the user and is in the
+// session's world
+func (s *Service) ownedCharacter(ctx context.Context, userID, characterID string) (*db.Character, error) {
+	character, err := s.GetCharacterByID(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authz.CheckOwner(*character, userID); err != nil {
+		return nil, err
+	}
+	return character, nil
+}
+
+// SubscribeChunkEvents forwards generated chunks, positioned at the chunk centre, and
+// terrain edits, positioned at the modified cell, to nearby streams
+func (s *Service) SubscribeChunkEvents(bus *events.Bus) {
+	forward := func(ctx context.Context, event events.Event) error {
+		if s.nearby == nil {
+			return nil
+		}
+		worldID, nearbyEvent, err := nearbyOutboxEvent(event)
+		if err != nil {
+			return err
+		}
+		s.nearby.Publish(worldID, interest.Point{X: nearbyEvent.X, Y: nearbyEvent.Y}, nearbyEvent)
+		return nil
+	}
+	bus.Subscribe(events.TerrainModified, forward)
+	bus.Subscribe(events.ChunkGenerated, forward)
+}
+
+// nearbyOutboxEvent converts a terrain edit or generated chunk from the outbox into the
+// nearby event streams send, sequenced by its outbox ID, and returns its world ID without
+// dashes, the form streams are keyed by
+func nearbyOutboxEvent(event events.Event) (string, *characterV1.NearbyEvent, error) {
+	switch event.Type {
+	case events.TerrainModified:
+		var payload events.TerrainModifiedPayload
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return "", nil, fmt.Errorf("invalid %s payload: %w", event.Type, err)
+		}
+		return uuid.Normalize(payload.WorldID), &characterV1.NearbyEvent{
+			X:        payload.X,
+			Y:        payload.Y,
+			Sequence: event.ID,
+			Event: &characterV1.NearbyEvent_TerrainModified{
+				TerrainModified: &characterV1.TerrainModified{
+					TerrainType:         chunkV1.TerrainType(payload.TerrainType),
+					PreviousTerrainType: chunkV1.TerrainType(payload.PreviousTerrainType),
+				},
+			},
+		}, nil
+
+	case events.ChunkGenerated:
+		var payload events.ChunkGeneratedPayload
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return "", nil, fmt.Errorf("invalid %s payload: %w", event.Type, err)
+		}
+		center := geometry.ChunkCenter(payload.ChunkX, payload.ChunkY)
+		return uuid.Normalize(payload.WorldID), &characterV1.NearbyEvent{
+			X:        center.X,
+			Y:        center.Y,
+			Sequence: event.ID,
+			Event: &characterV1.NearbyEvent_ChunkGenerated{
+				ChunkGenerated: &characterV1.ChunkGenerated{ChunkX: payload.ChunkX, ChunkY: payload.ChunkY},
+			},
+		}, nil
+
+	default:
+		return "", nil, fmt.Errorf("%s is not a nearby event", event.Type)
+	}
+}
+
+// publishMove recentres the character's own subscriptions and tells nearby streams it moved
+func (s *Service) publishMove(character db.Character) {
+	if s.nearby == nil {
+		return
+	}
+	at := interest.Point{X: character.X, Y: character.Y}
+	s.nearby.Move(uuid.PgtypeToString(character.ID), at)
+	s.nearby.Publish(uuid.PgtypeToNormalizedString(character.WorldID), at, &characterV1.NearbyEvent{
+		X:     character.X,
+		Y:     character.Y,
+		Event: &characterV1.NearbyEvent_CharacterMoved{CharacterMoved: s.dbCharacterToProto(character)},
+	})
+}
+
+// entityPresentEvent describes an entity found in a stream's area when it opened
+func entityPresentEvent(e *entity.Entity) *characterV1.NearbyEvent {
+	components, err := e.Components.Encode()
+	if err != nil {
+		components = []byte("{}")
+	}
+	return &characterV1.NearbyEvent{
+		X: e.X,
+		Y: e.Y,
+		Event: &characterV1.NearbyEvent_EntityPresent{
+			EntityPresent: &characterV1.EntityPresent{
+				Id:         e.ID,
+				Type:       e.Type,
+				Components: string(components),
+				Version:    e.Version,
+			},
+		},
+	}
+}
@@ -0,0 +1,127 @@
+package character
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/bandwidth"
+	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
+)
+
+// NearbyFlushInterval is how often a shaped stream sends the updates it held back
+const NearbyFlushInterval = 250 * time.Millisecond
+
+// NearbyShaper decides what a nearby event stream sends while its connection is over
+// its bandwidth budget. Within budget every event goes out as it arrives. Over budget:
+//   - character movements are held and only each character's latest position is sent,
+//     lowering the update frequency to what the connection can take;
+//   - terrain edits are held per cell and merged, and dropped if the cell ends up as it was;
+//   - chunk announcements the stream already sent are omitted.
+//
+// Held events are sent by Flush once the budget recovers, in the order they first arrived.
+// A shaper belongs to one stream and is not safe for concurrent use.
+type NearbyShaper struct {
+	meter      *bandwidth.Meter
+	held       map[string]*characterV1.NearbyEvent
+	order      []string
+	sentChunks map[[2]int32]bool
+}
+
+// NewNearbyShaper creates a shaper for a stream metered by meter. A nil meter never
+// shapes, for streams without a budget.
+func NewNearbyShaper(meter *bandwidth.Meter) *NearbyShaper {
+	return &NearbyShaper{
+		meter:      meter,
+		held:       make(map[string]*characterV1.NearbyEvent),
+		sentChunks: make(map[[2]int32]bool),
+	}
+}
+
+// Offer takes an event from the subscription and returns the events to send now
+func (s *NearbyShaper) Offer(event *characterV1.NearbyEvent) []*characterV1.NearbyEvent {
+	if generated := event.GetChunkGenerated(); generated != nil {
+		coord := [2]int32{generated.ChunkX, generated.ChunkY}
+		if s.sentChunks[coord] && s.exceeded() {
+			return nil
+		}
+		s.sentChunks[coord] = true
+		return []*characterV1.NearbyEvent{event}
+	}
+
+	key, ok := holdKey(event)
+	if !ok {
+		return []*characterV1.NearbyEvent{event}
+	}
+	// Keep what is already held in order, so a fresh event never overtakes an older one
+	if len(s.held) == 0 && !s.exceeded() {
+		return []*characterV1.NearbyEvent{event}
+	}
+	s.hold(key, event)
+	return s.Flush()
+}
+
+// Flush returns the held events if the connection is back within budget
+func (s *NearbyShaper) Flush() []*characterV1.NearbyEvent {
+	if len(s.held) == 0 || s.exceeded() {
+		return nil
+	}
+	var events []*characterV1.NearbyEvent
+	for _, key := range s.order {
+		// A key dropped and held again appears twice in order; it is sent once
+		if event, ok := s.held[key]; ok {
+			events = append(events, event)
+			delete(s.held, key)
+		}
+	}
+	s.order = s.order[:0]
+	return events
+}
+
+// Held returns how many events are waiting for the budget to recover
+func (s *NearbyShaper) Held() int {
+	return len(s.held)
+}
+
+// hold replaces the held event for key, merging terrain edits of the same cell
+func (s *NearbyShaper) hold(key string, event *characterV1.NearbyEvent) {
+	previous, ok := s.held[key]
+	if !ok {
+		s.held[key] = event
+		s.order = append(s.order, key)
+		return
+	}
+
+	if edit := event.GetTerrainModified(); edit != nil {
+		// The client last saw the terrain the first held edit started from
+		from := previous.GetTerrainModified().PreviousTerrainType
+		if edit.TerrainType == from {
+			delete(s.held, key)
+			return
+		}
+		event = &characterV1.NearbyEvent{
+			X: event.X,
+			Y: event.Y,
+			Event: &characterV1.NearbyEvent_TerrainModified{TerrainModified: &characterV1.TerrainModified{
+				TerrainType:         edit.TerrainType,
+				PreviousTerrainType: from,
+			}},
+		}
+	}
+	s.held[key] = event
+}
+
+func (s *NearbyShaper) exceeded() bool {
+	return s.meter != nil && s.meter.Exceeded()
+}
+
+// holdKey identifies the state an event updates, so a newer event can replace an older one
+func holdKey(event *characterV1.NearbyEvent) (string, bool) {
+	switch e := event.Event.(type) {
+	case *characterV1.NearbyEvent_CharacterMoved:
+		return "character:" + e.CharacterMoved.Id, true
+	case *characterV1.NearbyEvent_TerrainModified:
+		return fmt.Sprintf("cell:%d,%d", event.X, event.Y), true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,93 @@
+package character
+
+import (
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/bandwidth"
+	"github.com/VoidMesh/api/api/internal/clock"
+	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func movedEvent(id string, x int32) *characterV1.NearbyEvent {
+	return &characterV1.NearbyEvent{X: x, Event: &characterV1.NearbyEvent_CharacterMoved{CharacterMoved: &characterV1.Character{Id: id, X: x}}}
+}
+
+func terrainEvent(x int32, from, to chunkV1.TerrainType) *characterV1.NearbyEvent {
+	return &characterV1.NearbyEvent{X: x, Event: &characterV1.NearbyEvent_TerrainModified{TerrainModified: &characterV1.TerrainModified{
+		TerrainType:         to,
+		PreviousTerrainType: from,
+	}}}
+}
+
+func chunkEvent(chunkX int32) *characterV1.NearbyEvent {
+	return &characterV1.NearbyEvent{Event: &characterV1.NearbyEvent_ChunkGenerated{ChunkGenerated: &characterV1.ChunkGenerated{ChunkX: chunkX}}}
+}
+
+func TestNearbyShaper_WithinBudget(t *testing.T) {
+	shaper := NewNearbyShaper(nil)
+	for i := int32(0); i < 3; i++ {
+		assert.Len(t, shaper.Offer(movedEvent("a", i)), 1)
+		assert.Len(t, shaper.Offer(chunkEvent(0)), 1, "repeats are only omitted over budget")
+	}
+	assert.Nil(t, shaper.Flush())
+}
+
+func TestNearbyShaper_OverBudget(t *testing.T) {
+	fake := clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	meter := bandwidth.NewMeter(bandwidth.Budget{BytesPerSecond: 100, Burst: 100}, fake)
+	shaper := NewNearbyShaper(meter)
+
+	require.Len(t, shaper.Offer(chunkEvent(5)), 1)
+	meter.Record(200)
+
+	// Only the latest position of each character is kept
+	assert.Empty(t, shaper.Offer(movedEvent("a", 1)))
+	assert.Empty(t, shaper.Offer(movedEvent("b", 7)))
+	assert.Empty(t, shaper.Offer(movedEvent("a", 2)))
+	assert.Empty(t, shaper.Offer(movedEvent("a", 3)))
+
+	// Edits to one cell merge; a cell edited back to what it was is dropped
+	grass, dirt, sand, water := chunkV1.TerrainType_TERRAIN_TYPE_GRASS, chunkV1.TerrainType_TERRAIN_TYPE_DIRT, chunkV1.TerrainType_TERRAIN_TYPE_SAND, chunkV1.TerrainType_TERRAIN_TYPE_WATER
+	assert.Empty(t, shaper.Offer(terrainEvent(10, sand, water)))
+	assert.Empty(t, shaper.Offer(terrainEvent(10, water, sand)))
+	assert.Empty(t, shaper.Offer(terrainEvent(20, grass, dirt)))
+	assert.Empty(t, shaper.Offer(terrainEvent(20, dirt, sand)))
+
+	// Chunks the client already heard about are omitted, new ones still go out
+	assert.Empty(t, shaper.Offer(chunkEvent(5)))
+	assert.Len(t, shaper.Offer(chunkEvent(6)), 1)
+
+	assert.Equal(t, 3, shaper.Held())
+	assert.Nil(t, shaper.Flush(), "still over budget")
+
+	fake.Advance(2 * time.Second)
+	flushed := shaper.Flush()
+	require.Len(t, flushed, 3)
+	assert.Equal(t, int32(3), flushed[0].GetCharacterMoved().X, "held in first-arrival order")
+	assert.Equal(t, "b", flushed[1].GetCharacterMoved().Id)
+	assert.Equal(t, grass, flushed[2].GetTerrainModified().PreviousTerrainType)
+	assert.Equal(t, sand, flushed[2].GetTerrainModified().TerrainType)
+	assert.Zero(t, shaper.Held())
+
+	// Back within budget events pass straight through again
+	assert.Len(t, shaper.Offer(movedEvent("a", 4)), 1)
+}
+
+func TestNearbyShaper_ReheldCellIsSentOnce(t *testing.T) {
+	fake := clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	meter := bandwidth.NewMeter(bandwidth.Budget{BytesPerSecond: 100, Burst: 100}, fake)
+	shaper := NewNearbyShaper(meter)
+	meter.Record(200)
+
+	grass, dirt := chunkV1.TerrainType_TERRAIN_TYPE_GRASS, chunkV1.TerrainType_TERRAIN_TYPE_DIRT
+	shaper.Offer(terrainEvent(10, grass, dirt))
+	shaper.Offer(terrainEvent(10, dirt, grass))
+	shaper.Offer(terrainEvent(10, grass, dirt))
+
+	fake.Advance(2 * time.Second)
+	assert.Len(t, shaper.Flush(), 1)
+}
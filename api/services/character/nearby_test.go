@@ -0,0 +1,185 @@
+package character
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/entity"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/VoidMesh/api/api/internal/testutil"
+	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+)
+
+func TestSubscribeNearby_RoutesMovesInsideArea(t *testing.T) {
+	cleanup := testutil.SetupTest(t, testutil.DefaultTestConfig())
+	defer cleanup()
+
+	const userID = "11111111-1111-1111-1111-111111111111"
+	watcherID := "550e8400-e29b-41d4-a716-446655440000"
+	neighbourID := "550e8400-e29b-41d4-a716-446655440001"
+	userUUID, _ := mockParseUUID(userID)
+	watcherUUID, _ := mockParseUUID(watcherID)
+	neighbourUUID, _ := mockParseUUID(neighbourID)
+
+	mockDB := NewMockDatabase()
+	mockDB.AddCharacter(db.Character{ID: watcherUUID, UserID: userUUID, WorldID: testWorld(), Name: "Watcher", X: 10, Y: 10})
+	mockDB.AddCharacter(db.Character{ID: neighbourUUID, UserID: userUUID, WorldID: testWorld(), Name: "Neighbour", X: 14, Y: 10})
+
+	service := NewService(mockDB, NewMockChunkService())
+	service.SetNearbyEvents(NewNearbyEvents())
+	ctx := playerContext()
+
+	nearby, unsubscribe, err := service.SubscribeNearby(ctx, userID, watcherID, 5)
+	require.NoError(t, err)
+	defer unsubscribe()
+
+	_, err = service.MoveCharacter(ctx, &characterV1.MoveCharacterRequest{CharacterId: neighbourID, NewX: 15, NewY: 10})
+	require.NoError(t, err)
+	require.Len(t, nearby, 1)
+	event := <-nearby
+	assert.Equal(t, int32(15), event.X)
+	assert.Equal(t, "Neighbour", event.GetCharacterMoved().Name)
+
+	// One step further leaves the watcher's radius
+	service.moves = newMoveTimes()
+	_, err = service.MoveCharacter(ctx, &characterV1.MoveCharacterRequest{CharacterId: neighbourID, NewX: 16, NewY: 10})
+	require.NoError(t, err)
+	assert.Empty(t, nearby)
+
+	// The area follows the watcher, bringing the neighbour back into range
+	_, err = service.MoveCharacter(ctx, &characterV1.MoveCharacterRequest{CharacterId: watcherID, NewX: 11, NewY: 10})
+	require.NoError(t, err)
+	require.Len(t, nearby, 1, "the watcher sees its own move")
+	<-nearby
+
+	service.moves = newMoveTimes()
+	_, err = service.MoveCharacter(ctx, &characterV1.MoveCharacterRequest{CharacterId: neighbourID, NewX: 15, NewY: 10})
+	require.NoError(t, err)
+	assert.Len(t, nearby, 1)
+}
+
+func TestSubscribeNearby_Errors(t *testing.T) {
+	cleanup := testutil.SetupTest(t, testutil.DefaultTestConfig())
+	defer cleanup()
+
+	characterID := "550e8400-e29b-41d4-a716-446655440000"
+	ownerUUID, _ := mockParseUUID("11111111-1111-1111-1111-111111111111")
+	charUUID, _ := mockParseUUID(characterID)
+	mockDB := NewMockDatabase()
+	mockDB.AddCharacter(db.Character{ID: charUUID, UserID: ownerUUID, WorldID: testWorld(), Name: "TestChar"})
+	ctx := playerContext()
+
+	service := NewService(mockDB, NewMockChunkService())
+	_, _, err := service.SubscribeNearby(ctx, "11111111-1111-1111-1111-111111111111", characterID, 0)
+	assert.Equal(t, codes.Unavailable, status.Code(err), "routing is disabled until a manager is set")
+
+	service.SetNearbyEvents(NewNearbyEvents())
+	_, _, err = service.SubscribeNearby(ctx, "22222222-2222-2222-2222-222222222222", characterID, 0)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, _, err = service.SubscribeNearby(ctx, "11111111-1111-1111-1111-111111111111", characterID, -1)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// fakeEntityFinder returns fixed entities and records the query
+type fakeEntityFinder struct {
+	entities []*entity.Entity
+	center   geometry.Point
+	radius   int32
+}
+
+func (f *fakeEntityFinder) WithinRadius(ctx context.Context, worldID pgtype.UUID, center geometry.Point, radius int32, types ...string) ([]*entity.Entity, error) {
+	f.center, f.radius = center, radius
+	return f.entities, nil
+}
+
+func TestSubscribeNearby_EntitySnapshot(t *testing.T) {
+	cleanup := testutil.SetupTest(t, testutil.DefaultTestConfig())
+	defer cleanup()
+
+	const userID = "11111111-1111-1111-1111-111111111111"
+	characterID := "550e8400-e29b-41d4-a716-446655440000"
+	userUUID, _ := mockParseUUID(userID)
+	charUUID, _ := mockParseUUID(characterID)
+	mockDB := NewMockDatabase()
+	mockDB.AddCharacter(db.Character{ID: charUUID, UserID: userUUID, WorldID: testWorld(), Name: "TestChar", X: 10, Y: 10})
+
+	// More entities than the live event buffer holds are still all delivered
+	finder := &fakeEntityFinder{}
+	for i := range nearbyBufferSize + 10 {
+		components := entity.Components{}
+		require.NoError(t, components.Set(entity.Health{Current: 1, Max: 1}))
+		finder.entities = append(finder.entities, &entity.Entity{ID: int64(i + 1), Type: "mob", X: 11, Y: 10, Components: components, Version: 1})
+	}
+
+	service := NewService(mockDB, NewMockChunkService())
+	service.SetNearbyEvents(NewNearbyEvents())
+	service.SetEntities(finder)
+
+	nearby, unsubscribe, err := service.SubscribeNearby(playerContext(), userID, characterID, 5)
+	require.NoError(t, err)
+	defer unsubscribe()
+
+	assert.Equal(t, geometry.Point{X: 10, Y: 10}, finder.center)
+	assert.Equal(t, int32(5), finder.radius)
+	require.Len(t, nearby, nearbyBufferSize+10)
+	event := <-nearby
+	assert.Equal(t, int32(11), event.X)
+	assert.Equal(t, int64(1), event.GetEntityPresent().Id)
+	assert.Equal(t, "mob", event.GetEntityPresent().Type)
+	assert.JSONEq(t, `{"health": {"current": 1, "max": 1}}`, event.GetEntityPresent().Components)
+}
+
+func TestSubscribeChunkEvents(t *testing.T) {
+	cleanup := testutil.SetupTest(t, testutil.DefaultTestConfig())
+	defer cleanup()
+
+	const userID = "11111111-1111-1111-1111-111111111111"
+	characterID := "550e8400-e29b-41d4-a716-446655440000"
+	userUUID, _ := mockParseUUID(userID)
+	charUUID, _ := mockParseUUID(characterID)
+	mockDB := NewMockDatabase()
+	mockDB.AddCharacter(db.Character{ID: charUUID, UserID: userUUID, WorldID: testWorld(), Name: "TestChar", X: 40, Y: 40})
+
+	service := NewService(mockDB, NewMockChunkService())
+	service.SetNearbyEvents(NewNearbyEvents())
+	bus := events.NewBus()
+	service.SubscribeChunkEvents(bus)
+
+	nearby, unsubscribe, err := service.SubscribeNearby(playerContext(), userID, characterID, 0)
+	require.NoError(t, err)
+	defer unsubscribe()
+
+	publish := func(chunkX, chunkY int32) {
+		payload, _ := json.Marshal(events.ChunkGeneratedPayload{WorldID: testWorldID, ChunkX: chunkX, ChunkY: chunkY})
+		require.NoError(t, bus.Publish(context.Background(), events.Event{Type: events.ChunkGenerated, Payload: payload}))
+	}
+
+	publish(1, 1)
+	publish(5, 5)
+	require.Len(t, nearby, 1, "only the chunk inside the default radius is routed")
+	event := <-nearby
+	assert.Equal(t, int32(1), event.GetChunkGenerated().ChunkX)
+	assert.Equal(t, int32(48), event.X)
+
+	err = bus.Publish(context.Background(), events.Event{Type: events.ChunkGenerated, Payload: json.RawMessage(`{`)})
+	assert.Error(t, err)
+
+	payload, _ := json.Marshal(events.TerrainModifiedPayload{WorldID: testWorldID, X: 41, Y: 40, TerrainType: int32(chunkV1.TerrainType_TERRAIN_TYPE_DIRT), PreviousTerrainType: int32(chunkV1.TerrainType_TERRAIN_TYPE_GRASS)})
+	require.NoError(t, bus.Publish(context.Background(), events.Event{ID: 7, Type: events.TerrainModified, Payload: payload}))
+	require.Len(t, nearby, 1)
+	event = <-nearby
+	assert.Equal(t, chunkV1.TerrainType_TERRAIN_TYPE_DIRT, event.GetTerrainModified().TerrainType)
+	assert.Equal(t, int32(41), event.X)
+	assert.Equal(t, int64(7), event.Sequence, "outbox events carry their sequence for ResyncState")
+}
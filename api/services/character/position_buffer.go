@@ -0,0 +1,309 @@
+package character
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/alerting"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/debugstats"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// PositionBufferConfig controls how moves are written behind
+type PositionBufferConfig struct {
+	FlushInterval time.Duration // Longest a buffered move waits before it is written
+}
+
+// DefaultPositionBufferConfig writes buffered moves every 2 seconds, which is also the
+// most movement a crash can lose
+func DefaultPositionBufferConfig() PositionBufferConfig {
+	return PositionBufferConfig{FlushInterval: 2 * time.Second}
+}
+
+// PositionWriter stores a character's position
+type PositionWriter interface {
+	UpdateCharacterPosition(ctx context.Context, arg db.UpdateCharacterPositionParams) (db.Character, error)
+}
+
+// PositionBuffer keeps moves within a chunk in memory and writes only the latest position
+// of each character, every flush interval. Moves into another chunk are written straight
+// away, so chunk-level queries always see where characters are; the last stored position
+// is never more than one flush interval old. It is safe for concurrent use.
+type PositionBuffer struct {
+	writer  PositionWriter
+	config  PositionBufferConfig
+	clock   clock.Clock
+	mu      sync.Mutex
+	entries map[[16]byte]*bufferedPosition
+}
+
+type bufferedPosition struct {
+	mu        sync.Mutex   // Held while the character's position is written
+	character db.Character // With every buffered move applied
+	dirty     bool         // Has moves that are not stored yet
+	idle      bool         // Clean since the last flush; dropped at the next one
+	removed   bool         // Dropped from the buffer, so moves must take a new entry
+}
+
+// NewPositionBuffer creates an empty buffer writing through writer
+func NewPositionBuffer(writer PositionWriter, config PositionBufferConfig) *PositionBuffer {
+	b := &PositionBuffer{
+		writer:  writer,
+		config:  config,
+		clock:   clock.New(),
+		entries: make(map[[16]byte]*bufferedPosition),
+	}
+	debugstats.Register(debugstats.Queues, "character.buffered_positions", func() int64 {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		var dirty int64
+		for _, entry := range b.entries {
+			if entry.dirty {
+				dirty++
+			}
+		}
+		return dirty
+	})
+	return b
+}
+
+// SetClock replaces the clock used for the flush interval (for testing)
+func (b *PositionBuffer) SetClock(c clock.Clock) {
+	b.clock = c
+}
+
+// Move records a move of current, the character as last read. A move within the
+// character's chunk is buffered; one into another chunk is written immediately.
+func (b *PositionBuffer) Move(ctx context.Context, current db.Character, arg db.UpdateCharacterPositionParams) (db.Character, error) {
+	entry := b.lockedEntry(arg.ID)
+	defer entry.mu.Unlock()
+	entry.idle = false
+
+	if arg.ChunkX != current.ChunkX || arg.ChunkY != current.ChunkY {
+		updated, err := b.writer.UpdateCharacterPosition(ctx, arg)
+		if err != nil {
+			return db.Character{}, err
+		}
+		entry.character = updated
+		entry.dirty = false
+		return updated, nil
+	}
+
+	entry.character = withPosition(current, arg)
+	entry.dirty = true
+	return entry.character, nil
+}
+
+// Overlay returns the character with its buffered position, if it has one
+func (b *PositionBuffer) Overlay(character db.Character) db.Character {
+	b.mu.Lock()
+	entry, ok := b.entries[character.ID.Bytes]
+	b.mu.Unlock()
+	if !ok {
+		return character
+	}
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+	if !entry.dirty {
+		return character
+	}
+	return withPosition(character, db.UpdateCharacterPositionParams{
+		X:           entry.character.X,
+		Y:           entry.character.Y,
+		ChunkX:      entry.character.ChunkX,
+		ChunkY:      entry.character.ChunkY,
+		Facing:      entry.character.Facing,
+		ActionState: entry.character.ActionState,
+	})
+}
+
+// FlushCharacter writes the character's buffered position now, e.g. when it disconnects
+func (b *PositionBuffer) FlushCharacter(ctx context.Context, id pgtype.UUID) error {
+	b.mu.Lock()
+	entry, ok := b.entries[id.Bytes]
+	b.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	return b.flush(ctx, id.Bytes, entry)
+}
+
+// Forget drops a character's buffered position without writing it, e.g. once it is deleted
+func (b *PositionBuffer) Forget(id pgtype.UUID) {
+	b.mu.Lock()
+	delete(b.entries, id.Bytes)
+	b.mu.Unlock()
+}
+
+// Flush writes every buffered position and drops characters that haven't moved since
+// the previous flush. Positions that fail to write stay buffered for the next flush.
+func (b *PositionBuffer) Flush(ctx context.Context) error {
+	b.mu.Lock()
+	entries := make(map[[16]byte]*bufferedPosition, len(b.entries))
+	for id, entry := range b.entries {
+		entries[id] = entry
+	}
+	b.mu.Unlock()
+
+	var errs []error
+	for id, entry := range entries {
+		if err := b.flush(ctx, id, entry); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Run flushes the buffer every flush interval until ctx is cancelled, then once more
+func (b *PositionBuffer) Run(ctx context.Context) {
+	logger := logging.WithComponent("position-buffer")
+	logger.Info("Position buffer started", "flush_interval", b.config.FlushInterval)
+	for {
+		select {
+		case <-ctx.Done():
+			// The job context is already cancelled, so the last flush gets its own
+			flushCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			if err := b.Flush(flushCtx); err != nil {
+				logger.Error("Final position flush failed", "error", err)
+			}
+			cancel()
+			logger.Info("Position buffer stopped")
+			return
+		case <-b.clock.After(b.config.FlushInterval):
+		}
+
+		if err := b.Flush(ctx); err != nil {
+			logger.Error("Position flush failed", "error", err)
+			alerting.ReportJobError("position_flush", err)
+		}
+	}
+}
+
+// flush writes one character's buffered position
+func (b *PositionBuffer) flush(ctx context.Context, id [16]byte, entry *bufferedPosition) error {
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	if !entry.dirty {
+		if entry.idle {
+			b.mu.Lock()
+			if b.entries[id] == entry {
+				delete(b.entries, id)
+			}
+			b.mu.Unlock()
+			entry.removed = true
+			return nil
+		}
+		entry.idle = true
+		return nil
+	}
+
+	c := entry.character
+	_, err := b.writer.UpdateCharacterPosition(ctx, db.UpdateCharacterPositionParams{
+		ID:          c.ID,
+		X:           c.X,
+		Y:           c.Y,
+		ChunkX:      c.ChunkX,
+		ChunkY:      c.ChunkY,
+		Facing:      c.Facing,
+		ActionState: c.ActionState,
+	})
+	// A character deleted since it moved has nothing left to update
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+	entry.dirty = false
+	return nil
+}
+
+// lockedEntry returns the character's entry, creating it if needed, with its lock held
+func (b *PositionBuffer) lockedEntry(id pgtype.UUID) *bufferedPosition {
+	for {
+		b.mu.Lock()
+		entry, ok := b.entries[id.Bytes]
+		if !ok {
+			entry = &bufferedPosition{}
+			b.entries[id.Bytes] = entry
+		}
+		b.mu.Unlock()
+
+		entry.mu.Lock()
+		if !entry.removed {
+			return entry
+		}
+		entry.mu.Unlock()
+	}
+}
+
+// withPosition applies a position update to a character
+func withPosition(character db.Character, arg db.UpdateCharacterPositionParams) db.Character {
+	character.X = arg.X
+	character.Y = arg.Y
+	character.ChunkX = arg.ChunkX
+	character.ChunkY = arg.ChunkY
+	character.Facing = arg.Facing
+	character.ActionState = arg.ActionState
+	return character
+}
+
+// bufferedDatabase shows buffered moves in the characters the service reads
+type bufferedDatabase struct {
+	DatabaseInterface
+	positions *PositionBuffer
+}
+
+func (d *bufferedDatabase) GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error) {
+	character, err := d.DatabaseInterface.GetCharacterById(ctx, id)
+	if err != nil {
+		return character, err
+	}
+	return d.positions.Overlay(character), nil
+}
+
+func (d *bufferedDatabase) GetCharacterByUserAndName(ctx context.Context, arg db.GetCharacterByUserAndNameParams) (db.Character, error) {
+	character, err := d.DatabaseInterface.GetCharacterByUserAndName(ctx, arg)
+	if err != nil {
+		return character, err
+	}
+	return d.positions.Overlay(character), nil
+}
+
+func (d *bufferedDatabase) GetCharactersByUser(ctx context.Context, userID pgtype.UUID) ([]db.Character, error) {
+	characters, err := d.DatabaseInterface.GetCharactersByUser(ctx, userID)
+	return d.overlayAll(characters), err
+}
+
+func (d *bufferedDatabase) GetCharactersByUserInWorld(ctx context.Context, arg db.GetCharactersByUserInWorldParams) ([]db.Character, error) {
+	characters, err := d.DatabaseInterface.GetCharactersByUserInWorld(ctx, arg)
+	return d.overlayAll(characters), err
+}
+
+// ListCharactersInArea drops characters whose buffered position left the area. Characters
+// that moved into it within their chunk are missed until their position is flushed.
+func (d *bufferedDatabase) ListCharactersInArea(ctx context.Context, arg db.ListCharactersInAreaParams) ([]db.Character, error) {
+	characters, err := d.DatabaseInterface.ListCharactersInArea(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	inArea := characters[:0]
+	for _, character := range d.overlayAll(characters) {
+		if character.X >= arg.MinX && character.X <= arg.MaxX && character.Y >= arg.MinY && character.Y <= arg.MaxY {
+			inArea = append(inArea, character)
+		}
+	}
+	return inArea, nil
+}
+
+func (d *bufferedDatabase) overlayAll(characters []db.Character) []db.Character {
+	for i, character := range characters {
+		characters[i] = d.positions.Overlay(character)
+	}
+	return characters
+}
@@ -0,0 +1,154 @@
+package character
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakePositionWriter struct {
+	mu     sync.Mutex
+	writes []db.UpdateCharacterPositionParams
+}
+
+func (w *fakePositionWriter) UpdateCharacterPosition(ctx context.Context, arg db.UpdateCharacterPositionParams) (db.Character, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.writes = append(w.writes, arg)
+	return withPosition(db.Character{ID: arg.ID}, arg), nil
+}
+
+func (w *fakePositionWriter) stored() []db.UpdateCharacterPositionParams {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return append([]db.UpdateCharacterPositionParams(nil), w.writes...)
+}
+
+func newTestPositionBuffer() (*PositionBuffer, *fakePositionWriter, *clock.Fake) {
+	writer := &fakePositionWriter{}
+	fake := clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	buffer := NewPositionBuffer(writer, DefaultPositionBufferConfig())
+	buffer.SetClock(fake)
+	return buffer, writer, fake
+}
+
+func testMover() db.Character {
+	return db.Character{ID: pgtype.UUID{Bytes: [16]byte{1}, Valid: true}, X: 1, Y: 1}
+}
+
+func moveTo(character db.Character, x, y, chunkX, chunkY int32) db.UpdateCharacterPositionParams {
+	return db.UpdateCharacterPositionParams{ID: character.ID, X: x, Y: y, ChunkX: chunkX, ChunkY: chunkY}
+}
+
+func TestPositionBuffer_CoalescesMovesWithinChunk(t *testing.T) {
+	buffer, writer, _ := newTestPositionBuffer()
+	ctx := context.Background()
+	character := testMover()
+
+	for x := int32(2); x <= 10; x++ {
+		var err error
+		character, err = buffer.Move(ctx, character, moveTo(character, x, 1, 0, 0))
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(10), character.X)
+	assert.Empty(t, writer.stored(), "moves within a chunk are not written straight away")
+	assert.Equal(t, int32(10), buffer.Overlay(testMover()).X, "reads see the buffered position")
+
+	require.NoError(t, buffer.Flush(ctx))
+	require.Len(t, writer.stored(), 1, "only the latest position is written")
+	assert.Equal(t, int32(10), writer.stored()[0].X)
+
+	require.NoError(t, buffer.Flush(ctx))
+	assert.Len(t, writer.stored(), 1, "stored positions are not written again")
+	assert.Equal(t, int32(1), buffer.Overlay(testMover()).X, "stored positions are read from the database")
+}
+
+func TestPositionBuffer_WritesChunkChangesImmediately(t *testing.T) {
+	buffer, writer, _ := newTestPositionBuffer()
+	ctx := context.Background()
+	character := testMover()
+
+	character, err := buffer.Move(ctx, character, moveTo(character, 5, 1, 0, 0))
+	require.NoError(t, err)
+	character, err = buffer.Move(ctx, character, moveTo(character, 33, 1, 1, 0))
+	require.NoError(t, err)
+	require.Len(t, writer.stored(), 1)
+	assert.Equal(t, int32(1), writer.stored()[0].ChunkX)
+	assert.Equal(t, int32(1), character.ChunkX)
+
+	require.NoError(t, buffer.Flush(ctx))
+	assert.Len(t, writer.stored(), 1, "the earlier in-chunk move was superseded")
+}
+
+func TestPositionBuffer_FlushCharacterAndForget(t *testing.T) {
+	buffer, writer, _ := newTestPositionBuffer()
+	ctx := context.Background()
+	character := testMover()
+
+	_, err := buffer.Move(ctx, character, moveTo(character, 4, 4, 0, 0))
+	require.NoError(t, err)
+	require.NoError(t, buffer.FlushCharacter(ctx, character.ID))
+	require.Len(t, writer.stored(), 1, "a disconnecting character's position is written")
+
+	_, err = buffer.Move(ctx, character, moveTo(character, 6, 6, 0, 0))
+	require.NoError(t, err)
+	buffer.Forget(character.ID)
+	require.NoError(t, buffer.Flush(ctx))
+	assert.Len(t, writer.stored(), 1, "forgotten positions are not written")
+}
+
+func TestPositionBuffer_RunBoundsLoss(t *testing.T) {
+	buffer, writer, fake := newTestPositionBuffer()
+	ctx, cancel := context.WithCancel(context.Background())
+	character := testMover()
+
+	done := make(chan struct{})
+	go func() {
+		buffer.Run(ctx)
+		close(done)
+	}()
+
+	_, err := buffer.Move(ctx, character, moveTo(character, 7, 7, 0, 0))
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return fake.Waiters() > 0 }, time.Second, time.Millisecond)
+	fake.Advance(DefaultPositionBufferConfig().FlushInterval)
+	require.Eventually(t, func() bool { return len(writer.stored()) == 1 }, time.Second, time.Millisecond,
+		"moves are stored within one flush interval")
+	assert.Equal(t, int32(7), writer.stored()[0].X)
+
+	_, err = buffer.Move(ctx, character, moveTo(character, 8, 8, 0, 0))
+	require.NoError(t, err)
+	cancel()
+	<-done
+	stored := writer.stored()
+	require.Len(t, stored, 2, "moves buffered at shutdown are flushed")
+	assert.Equal(t, int32(8), stored[1].X)
+}
+
+func TestBufferedDatabase_ListCharactersInArea(t *testing.T) {
+	buffer, _, _ := newTestPositionBuffer()
+	character := testMover()
+	_, err := buffer.Move(context.Background(), character, moveTo(character, 20, 20, 0, 0))
+	require.NoError(t, err)
+
+	database := &bufferedDatabase{DatabaseInterface: &areaDatabase{characters: []db.Character{character}}, positions: buffer}
+	inArea, err := database.ListCharactersInArea(context.Background(), db.ListCharactersInAreaParams{MinX: 0, MaxX: 10, MinY: 0, MaxY: 10})
+	require.NoError(t, err)
+	assert.Empty(t, inArea, "characters whose buffered position left the area are dropped")
+}
+
+type areaDatabase struct {
+	DatabaseInterface
+	characters []db.Character
+}
+
+func (d *areaDatabase) ListCharactersInArea(ctx context.Context, arg db.ListCharactersInAreaParams) ([]db.Character, error) {
+	return append([]db.Character(nil), d.characters...), nil
+}
@@ -0,0 +1,183 @@
+package character
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/naming"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DefaultRenameCooldown is how long a character has to keep a name before it can be
+// renamed again
+const DefaultRenameCooldown = 30 * 24 * time.Hour
+
+const (
+	defaultNameHistoryLimit = 20
+	maxNameHistoryLimit     = 100
+)
+
+// SetRenameCooldown replaces how long a character keeps a name before it can be renamed again
+func (s *Service) SetRenameCooldown(cooldown time.Duration) {
+	s.renameCooldown = cooldown
+}
+
+// validName normalizes a requested name and checks it against the character name rules
+// and the names of the world's other characters. excludeID is the character being
+// renamed, unset for new characters.
+func (s *Service) validName(ctx context.Context, worldID, excludeID pgtype.UUID, requested string) (string, error) {
+	name := naming.Normalize(requested)
+	if err := naming.CharacterRules().Validate(name); err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "invalid name: %v", err)
+	}
+	inUse, err := s.db.CharacterNameInUse(ctx, db.CharacterNameInUseParams{WorldID: worldID, Name: name, ExcludeID: excludeID})
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to check name: %v", err)
+	}
+	if inUse {
+		return "", status.Errorf(codes.AlreadyExists, "character with name '%s' already exists in this world", name)
+	}
+	return name, nil
+}
+
+// RenameCharacter renames one of the user's characters. Nearby streams see the new name
+// at once; other services learn of it from the CharacterRenamed event.
+func (s *Service) RenameCharacter(ctx context.Context, userID string, req *characterV1.RenameCharacterRequest) (*characterV1.RenameCharacterResponse, error) {
+	logger := logging.WithFields("user_id", userID, "character_id", req.CharacterId, "name", req.Name)
+
+	character, err := s.ownedCharacter(ctx, userID, req.CharacterId)
+	if err != nil {
+		return nil, err
+	}
+	name, err := s.validName(ctx, character.WorldID, character.ID, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if name == character.Name {
+		return nil, status.Errorf(codes.InvalidArgument, "character is already named '%s'", name)
+	}
+
+	now := s.clock.Now()
+	last, err := s.db.GetLatestCharacterRename(ctx, character.ID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.Internal, "failed to check rename cooldown: %v", err)
+	}
+	if err == nil && now.Before(last.Time.Add(s.renameCooldown)) {
+		return nil, status.Errorf(codes.FailedPrecondition, "character can be renamed again at %s", last.Time.Add(s.renameCooldown).UTC().Format(time.RFC3339))
+	}
+
+	// The rename returns the stored position, so buffered moves go first
+	if s.positions != nil {
+		if err := s.positions.FlushCharacter(ctx, character.ID); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to store position: %v", err)
+		}
+	}
+	renamed, change, err := s.db.RenameCharacter(ctx, Rename{Character: *character, Name: name, Now: now, Cooldown: s.renameCooldown})
+	switch {
+	case errors.Is(err, ErrNameTaken):
+		return nil, status.Errorf(codes.AlreadyExists, "character with name '%s' already exists in this world", name)
+	case errors.Is(err, ErrRenameCooldown):
+		return nil, status.Errorf(codes.FailedPrecondition, "character was renamed recently")
+	case err != nil:
+		logger.Error("Failed to rename character", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to rename character: %v", err)
+	}
+
+	s.publishMove(renamed)
+	logger.Info("Character renamed", "old_name", change.OldName)
+
+	protoCharacter := s.dbCharacterToProto(renamed)
+	s.addStatusEffects(ctx, []*characterV1.Character{protoCharacter}, []pgtype.UUID{renamed.ID})
+	return &characterV1.RenameCharacterResponse{
+		Character:    protoCharacter,
+		Change:       nameChangeToProto(change, false),
+		NextRenameAt: timestamppb.New(now.Add(s.renameCooldown)),
+	}, nil
+}
+
+// ListNameHistory returns the renames of any character in the session's world, newest first
+func (s *Service) ListNameHistory(ctx context.Context, characterID string, limit int32) ([]*characterV1.CharacterNameChange, error) {
+	character, err := s.GetCharacterByID(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		limit = defaultNameHistoryLimit
+	}
+	rows, err := s.db.ListCharacterNameHistory(ctx, db.ListCharacterNameHistoryParams{
+		CharacterID: character.ID,
+		Limit:       min(limit, maxNameHistoryLimit),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list name history: %v", err)
+	}
+	changes := make([]*characterV1.CharacterNameChange, 0, len(rows))
+	for _, row := range rows {
+		changes = append(changes, nameChangeToProto(row, false))
+	}
+	return changes, nil
+}
+
+// LookupName returns the characters of a world currently using a name and the renames from
+// or to it, including those of deleted characters, for moderators
+func (s *Service) LookupName(ctx context.Context, worldID, name string, limit int32) ([]*characterV1.Character, []*characterV1.CharacterNameChange, error) {
+	worldUUID, err := uuid.StringToPgtype(worldID)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "invalid world ID: %v", err)
+	}
+	name = naming.Normalize(name)
+	if name == "" {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "name is required")
+	}
+	if limit <= 0 {
+		limit = defaultNameHistoryLimit
+	}
+
+	current, err := s.db.ListCharactersByWorldAndName(ctx, db.ListCharactersByWorldAndNameParams{WorldID: worldUUID, Name: name})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to look up characters: %v", err)
+	}
+	rows, err := s.db.LookupCharacterName(ctx, db.LookupCharacterNameParams{
+		WorldID:    worldUUID,
+		Name:       name,
+		MaxResults: min(limit, maxNameHistoryLimit),
+	})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to look up name history: %v", err)
+	}
+
+	characters := make([]*characterV1.Character, 0, len(current))
+	for _, character := range current {
+		characters = append(characters, s.dbCharacterToProto(character))
+	}
+	changes := make([]*characterV1.CharacterNameChange, 0, len(rows))
+	for _, row := range rows {
+		changes = append(changes, nameChangeToProto(row, true))
+	}
+	return characters, changes, nil
+}
+
+// nameChangeToProto converts a name history row; the user is only shown to moderators
+func nameChangeToProto(row db.CharacterNameHistory, withUser bool) *characterV1.CharacterNameChange {
+	change := &characterV1.CharacterNameChange{
+		OldName:   row.OldName,
+		NewName:   row.NewName,
+		RenamedAt: timestamppb.New(row.RenamedAt.Time),
+	}
+	if row.CharacterID.Valid {
+		change.CharacterId = uuid.PgtypeToString(row.CharacterID)
+	}
+	if withUser && row.UserID.Valid {
+		change.UserId = uuid.PgtypeToString(row.UserID)
+	}
+	return change
+}
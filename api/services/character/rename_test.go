@@ -0,0 +1,86 @@
+package character
+
+import (
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/testutil"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRenameCharacter(t *testing.T) {
+	cleanup := testutil.SetupTest(t, testutil.DefaultTestConfig())
+	defer cleanup()
+
+	userID := "550e8400-e29b-41d4-a716-446655440001"
+	characterID := "550e8400-e29b-41d4-a716-446655440000"
+	worldID := "550e8400-e29b-41d4-a716-446655440009"
+	charUUID, _ := mockParseUUID(characterID)
+	userUUID, _ := mockParseUUID(userID)
+	otherUUID, _ := mockParseUUID("550e8400-e29b-41d4-a716-446655440003")
+	worldUUID, _ := mockParseUUID(worldID)
+	mockDB := NewMockDatabase()
+	mockDB.AddCharacter(db.Character{ID: charUUID, UserID: userUUID, WorldID: worldUUID, Name: "Ada", X: 4, Y: 5})
+	mockDB.AddCharacter(db.Character{ID: otherUUID, UserID: otherUUID, WorldID: worldUUID, Name: "Grace"})
+
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	fakeClock := clock.NewFake(start)
+	service := NewService(mockDB, NewMockChunkService())
+	service.SetClock(fakeClock)
+	service.SetRenameCooldown(24 * time.Hour)
+	ctx := session.WithWorldID(testutil.CreateTestContext(), worldID)
+	rename := func(user, name string) (*characterV1.RenameCharacterResponse, error) {
+		return service.RenameCharacter(ctx, user, &characterV1.RenameCharacterRequest{CharacterId: characterID, Name: name})
+	}
+
+	_, err := rename("550e8400-e29b-41d4-a716-446655440002", "Lovelace")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = rename(userID, "A")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = rename(userID, "Ada")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "the name is unchanged")
+	_, err = rename(userID, "grace")
+	assert.Equal(t, codes.AlreadyExists, status.Code(err), "names are unique ignoring case")
+
+	renamed, err := rename(userID, "  Ada   Lovelace ")
+	require.NoError(t, err)
+	assert.Equal(t, "Ada Lovelace", renamed.Character.Name)
+	assert.Equal(t, int32(4), renamed.Character.X)
+	assert.Equal(t, "Ada", renamed.Change.OldName)
+	assert.Empty(t, renamed.Change.UserId)
+	assert.Equal(t, start.Add(24*time.Hour), renamed.NextRenameAt.AsTime())
+
+	fakeClock.Advance(time.Hour)
+	_, err = rename(userID, "Countess")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "renames wait for the cooldown")
+	assert.Contains(t, status.Convert(err).Message(), "2025-01-02T12:00:00Z")
+
+	fakeClock.Advance(23 * time.Hour)
+	_, err = rename(userID, "Countess")
+	require.NoError(t, err)
+
+	history, err := service.ListNameHistory(ctx, characterID, 0)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, "Countess", history[0].NewName, "newest first")
+	assert.Equal(t, "Ada", history[1].OldName)
+
+	current, changes, err := service.LookupName(ctx, worldID, "ada", 0)
+	require.NoError(t, err)
+	assert.Empty(t, current, "nobody is named Ada any more")
+	require.Len(t, changes, 1)
+	assert.Equal(t, uuid.PgtypeToString(userUUID), changes[0].UserId, "moderators see the user")
+
+	current, _, err = service.LookupName(ctx, worldID, "COUNTESS", 0)
+	require.NoError(t, err)
+	require.Len(t, current, 1)
+	assert.Equal(t, "Countess", current[0].Name)
+}
@@ -0,0 +1,184 @@
+package character
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MaxResyncEvents caps the outbox events a delta is built from; a client further behind
+// gets a snapshot, which is smaller by then
+const MaxResyncEvents = 1000
+
+// resyncEventTypes are the outbox events nearby streams carry and deltas replay
+var resyncEventTypes = []string{events.ChunkGenerated, events.TerrainModified}
+
+// ResyncState brings a reconnecting client up to date with its character's area of
+// interest. While the outbox still holds the client's last event the response is a delta
+// of the events it missed in the area; otherwise, or when it is too far behind, it is a
+// snapshot of the area's chunks, characters and entities.
+func (s *Service) ResyncState(ctx context.Context, userID string, req *characterV1.ResyncStateRequest) (*characterV1.ResyncStateResponse, error) {
+	if req.LastSequence < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "last_sequence must not be negative")
+	}
+	radius, err := nearbyRadius(req.Radius)
+	if err != nil {
+		return nil, err
+	}
+	character, err := s.ownedCharacter(ctx, userID, req.CharacterId)
+	if err != nil {
+		return nil, err
+	}
+	logger := logging.WithFields("character_id", req.CharacterId, "last_sequence", req.LastSequence, "radius", radius)
+
+	// Read the latest sequence first: events enqueued while the response is built reach
+	// the client again on its stream, where it skips them by sequence, instead of being lost
+	sequence, err := s.db.GetLatestOutboxEventID(ctx)
+	if err != nil {
+		logger.Error("Failed to read the latest outbox sequence", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to resync state")
+	}
+	center := geometry.Point{X: character.X, Y: character.Y}
+
+	if req.LastSequence > 0 && req.LastSequence <= sequence {
+		delta, last, err := s.resyncDelta(ctx, character, center, radius, req.LastSequence)
+		if err != nil {
+			logger.Error("Failed to build resync delta", "error", err)
+			return nil, status.Errorf(codes.Internal, "failed to resync state")
+		}
+		if delta != nil {
+			logger.Debug("Resyncing with a delta", "events", len(delta.Events))
+			return &characterV1.ResyncStateResponse{
+				Sequence: max(sequence, last),
+				State:    &characterV1.ResyncStateResponse_Delta{Delta: delta},
+			}, nil
+		}
+	}
+
+	snapshot, err := s.resyncSnapshot(ctx, character, center, radius)
+	if err != nil {
+		logger.Error("Failed to build resync snapshot", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to resync state")
+	}
+	logger.Debug("Resyncing with a snapshot", "chunks", len(snapshot.Chunks), "characters", len(snapshot.Characters))
+	return &characterV1.ResyncStateResponse{
+		Sequence: sequence,
+		State:    &characterV1.ResyncStateResponse_Snapshot{Snapshot: snapshot},
+	}, nil
+}
+
+// resyncDelta returns the events after lastSequence inside the area and the sequence of
+// the last event read, or a nil delta when they cannot all be replayed: the client's last
+// event was already deleted by outbox retention, or there are too many to send
+func (s *Service) resyncDelta(ctx context.Context, character *db.Character, center geometry.Point, radius int32, lastSequence int64) (*characterV1.ResyncDelta, int64, error) {
+	exists, err := s.db.OutboxEventExists(ctx, lastSequence)
+	if err != nil || !exists {
+		return nil, 0, err
+	}
+	missed, err := s.db.ListOutboxEventsAfter(ctx, db.ListOutboxEventsAfterParams{
+		AfterID:    lastSequence,
+		EventTypes: resyncEventTypes,
+		MaxEvents:  MaxResyncEvents,
+	})
+	if err != nil || len(missed) >= MaxResyncEvents {
+		return nil, 0, err
+	}
+
+	worldID := uuid.PgtypeToNormalizedString(character.WorldID)
+	delta := &characterV1.ResyncDelta{}
+	var last int64
+	for _, row := range missed {
+		last = row.ID
+		eventWorld, event, err := nearbyOutboxEvent(events.Event{ID: row.ID, Type: row.EventType, Payload: row.Payload})
+		if err != nil {
+			logging.WithFields("outbox_event_id", row.ID, "error", err).Warn("Skipping undecodable outbox event in resync")
+			continue
+		}
+		if eventWorld == worldID && geometry.InRange(center, geometry.Point{X: event.X, Y: event.Y}, radius) {
+			delta.Events = append(delta.Events, event)
+		}
+	}
+
+	delta.Characters, err = s.charactersInArea(ctx, character, center, radius)
+	if err != nil {
+		return nil, 0, err
+	}
+	return delta, last, nil
+}
+
+// resyncSnapshot returns the chunks overlapping the area and the characters and entities in it
+func (s *Service) resyncSnapshot(ctx context.Context, character *db.Character, center geometry.Point, radius int32) (*characterV1.ResyncSnapshot, error) {
+	area := geometry.Around(center, radius)
+	minChunkX, minChunkY, maxChunkX, maxChunkY := chunkBounds(area)
+
+	snapshot := &characterV1.ResyncSnapshot{}
+	for chunkY := minChunkY; chunkY <= maxChunkY; chunkY++ {
+		for chunkX := minChunkX; chunkX <= maxChunkX; chunkX++ {
+			chunkData, err := s.chunkService.GetOrCreateChunkInWorld(ctx, character.WorldID, chunkX, chunkY)
+			if err != nil {
+				return nil, err
+			}
+			snapshot.Chunks = append(snapshot.Chunks, chunkData)
+		}
+	}
+
+	characters, err := s.charactersInArea(ctx, character, center, radius)
+	if err != nil {
+		return nil, err
+	}
+	snapshot.Characters = characters
+
+	if s.entities != nil {
+		present, err := s.entities.WithinRadius(ctx, character.WorldID, center, radius)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range present {
+			snapshot.Entities = append(snapshot.Entities, entityPresentEvent(e))
+		}
+	}
+	return snapshot, nil
+}
+
+// charactersInArea returns the characters within radius of center, including the
+// character itself, with their current position and state
+func (s *Service) charactersInArea(ctx context.Context, character *db.Character, center geometry.Point, radius int32) ([]*characterV1.Character, error) {
+	area := geometry.Around(center, radius)
+	minChunkX, minChunkY, maxChunkX, maxChunkY := chunkBounds(area)
+	rows, err := s.db.ListCharactersInArea(ctx, db.ListCharactersInAreaParams{
+		WorldID:   character.WorldID,
+		MinChunkX: minChunkX,
+		MaxChunkX: maxChunkX,
+		MinChunkY: minChunkY,
+		MaxChunkY: maxChunkY,
+		MinX:      area.MinX,
+		MaxX:      area.MaxX,
+		MinY:      area.MinY,
+		MaxY:      area.MaxY,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var characters []*characterV1.Character
+	for _, row := range rows {
+		if geometry.InRange(center, geometry.Point{X: row.X, Y: row.Y}, radius) {
+			characters = append(characters, s.dbCharacterToProto(row))
+		}
+	}
+	return characters, nil
+}
+
+// chunkBounds returns the chunks at the corners of an area
+func chunkBounds(area geometry.Rect) (minChunkX, minChunkY, maxChunkX, maxChunkY int32) {
+	minChunkX, minChunkY = geometry.ChunkOf(geometry.Point{X: area.MinX, Y: area.MinY})
+	maxChunkX, maxChunkY = geometry.ChunkOf(geometry.Point{X: area.MaxX, Y: area.MaxY})
+	return minChunkX, minChunkY, maxChunkX, maxChunkY
+}
@@ -0,0 +1,122 @@
+package character
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/testutil"
+	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+)
+
+func TestResyncState(t *testing.T) {
+	cleanup := testutil.SetupTest(t, testutil.DefaultTestConfig())
+	defer cleanup()
+
+	const (
+		userID     = "11111111-1111-1111-1111-111111111111"
+		worldID    = "650e8400-e29b-41d4-a716-446655440000"
+		otherWorld = "750e8400-e29b-41d4-a716-446655440000"
+	)
+	characterID := "550e8400-e29b-41d4-a716-446655440000"
+	userUUID, _ := mockParseUUID(userID)
+	worldUUID, _ := mockParseUUID(worldID)
+	charUUID, _ := mockParseUUID(characterID)
+	neighbourUUID, _ := mockParseUUID("550e8400-e29b-41d4-a716-446655440001")
+	farUUID, _ := mockParseUUID("550e8400-e29b-41d4-a716-446655440002")
+
+	mockDB := NewMockDatabase()
+	mockDB.AddCharacter(db.Character{ID: charUUID, UserID: userUUID, WorldID: worldUUID, Name: "Me", X: 10, Y: 10})
+	mockDB.AddCharacter(db.Character{ID: neighbourUUID, WorldID: worldUUID, Name: "Neighbour", X: 12, Y: 10})
+	mockDB.AddCharacter(db.Character{ID: farUUID, WorldID: worldUUID, Name: "Far", X: 500, Y: 500})
+
+	terrainEdit := func(id int64, world string, x, y int32) db.OutboxEvent {
+		payload, _ := json.Marshal(events.TerrainModifiedPayload{
+			WorldID:     world,
+			X:           x,
+			Y:           y,
+			TerrainType: int32(chunkV1.TerrainType_TERRAIN_TYPE_DIRT),
+		})
+		return db.OutboxEvent{ID: id, EventType: events.TerrainModified, Payload: payload}
+	}
+	mockDB.AddOutboxEvent(terrainEdit(1, worldID, 11, 10))
+	mockDB.AddOutboxEvent(terrainEdit(2, worldID, 12, 11))
+	mockDB.AddOutboxEvent(db.OutboxEvent{ID: 3, EventType: events.ResourceHarvested, Payload: json.RawMessage(`{}`)})
+	mockDB.AddOutboxEvent(terrainEdit(4, otherWorld, 12, 11))
+	mockDB.AddOutboxEvent(terrainEdit(5, worldID, 400, 400))
+	mockDB.AddOutboxEvent(terrainEdit(6, worldID, 9, 9))
+
+	service := NewService(mockDB, NewMockChunkService())
+	ctx := session.WithWorldID(testutil.CreateTestContext(), worldID)
+	resync := func(lastSequence int64) *characterV1.ResyncStateResponse {
+		t.Helper()
+		resp, err := service.ResyncState(ctx, userID, &characterV1.ResyncStateRequest{
+			CharacterId:  characterID,
+			LastSequence: lastSequence,
+			Radius:       5,
+		})
+		require.NoError(t, err)
+		return resp
+	}
+
+	t.Run("a known sequence gets the missed events in the area", func(t *testing.T) {
+		resp := resync(1)
+		assert.Equal(t, int64(6), resp.Sequence)
+		delta := resp.GetDelta()
+		require.NotNil(t, delta)
+
+		var sequences []int64
+		for _, event := range delta.Events {
+			sequences = append(sequences, event.Sequence)
+		}
+		assert.Equal(t, []int64{2, 6}, sequences, "other worlds, far cells and other event types are left out")
+		assert.Equal(t, chunkV1.TerrainType_TERRAIN_TYPE_DIRT, delta.Events[0].GetTerrainModified().TerrainType)
+
+		var names []string
+		for _, character := range delta.Characters {
+			names = append(names, character.Name)
+		}
+		assert.ElementsMatch(t, []string{"Me", "Neighbour"}, names, "moves are not replayed, current positions are sent instead")
+	})
+
+	t.Run("nothing missed is an empty delta", func(t *testing.T) {
+		resp := resync(6)
+		require.NotNil(t, resp.GetDelta())
+		assert.Empty(t, resp.GetDelta().Events)
+	})
+
+	t.Run("no sequence gets a snapshot", func(t *testing.T) {
+		resp := resync(0)
+		assert.Equal(t, int64(6), resp.Sequence)
+		snapshot := resp.GetSnapshot()
+		require.NotNil(t, snapshot)
+		assert.Len(t, snapshot.Chunks, 1, "the radius stays inside the character's chunk")
+		assert.Len(t, snapshot.Characters, 2)
+	})
+
+	t.Run("a sequence deleted by retention gets a snapshot", func(t *testing.T) {
+		mockDB.DeleteOutboxEventsBefore(3)
+		assert.NotNil(t, resync(1).GetSnapshot())
+		assert.NotNil(t, resync(4).GetDelta())
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := service.ResyncState(ctx, "22222222-2222-2222-2222-222222222222", &characterV1.ResyncStateRequest{CharacterId: characterID})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		_, err = service.ResyncState(ctx, userID, &characterV1.ResyncStateRequest{CharacterId: characterID, LastSequence: -1})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = service.ResyncState(context.Background(), userID, &characterV1.ResyncStateRequest{CharacterId: characterID, Radius: -1})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -2,25 +2,62 @@ package character_actions
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"math"
-	"math/rand"
 	"time"
 
 	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/VoidMesh/api/api/internal/latency"
+	"github.com/VoidMesh/api/api/internal/rng"
+	"github.com/VoidMesh/api/api/internal/scripting"
+	"github.com/VoidMesh/api/api/internal/session"
 	"github.com/VoidMesh/api/api/internal/uuid"
+	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
 	characterActionsV1 "github.com/VoidMesh/api/api/proto/character_actions/v1"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
 	inventoryV1 "github.com/VoidMesh/api/api/proto/inventory/v1"
+	"github.com/VoidMesh/api/api/services/inventory"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+const (
+	// harvestReservationTTL bounds how long a crashed harvest can block a node
+	harvestReservationTTL = 5 * time.Second
+
+	// ReasonAlreadyReserved is the ErrorInfo reason attached to Aborted harvest errors
+	ReasonAlreadyReserved = "ALREADY_RESERVED"
+	errorDomain           = "character_actions.voidmesh"
+)
+
+// ErrAlreadyReserved is returned when another character is harvesting the resource node
+var ErrAlreadyReserved = errors.New("resource node is already reserved by another character")
+
 // Service provides character action operations.
 type Service struct {
 	db               DatabaseInterface
 	inventoryService InventoryServiceInterface
 	characterService CharacterServiceInterface
+	chunkService     ChunkServiceInterface
+	regionService    RegionServiceInterface
+	claimService     ClaimServiceInterface
+	scripts          ScriptEngineInterface
+	calendar         CalendarInterface
+	nodeQuality      NodeQualityInterface
+	statusEffects    StatusEffectInterface
+	actionStates     ActionStateInterface
+	dungeons         DungeonInterface
 	logger           LoggerInterface
-	rng              *rand.Rand
+	worldSeeds       WorldSeedsInterface // Harvest yields are derived from the world's seed; nil uses seed 0
+	clock            clock.Clock
 }
 
 // NewService creates a new character actions service with dependency injection.
@@ -37,12 +74,73 @@ func NewService(
 		inventoryService: inventoryService,
 		characterService: characterService,
 		logger:           componentLogger,
-		rng:              rand.New(rand.NewSource(time.Now().UnixNano())),
+		clock:            clock.New(),
 	}
 }
 
+// SetClock replaces the clock used for reservation expiry and yield rolls (for simulation tests)
+func (s *Service) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// SetWorldSeeds sets where the seed of each world, which harvest yields are derived
+// from, is looked up
+func (s *Service) SetWorldSeeds(seeds WorldSeedsInterface) {
+	s.worldSeeds = seeds
+}
+
+// SetRegionService enables protected region checks on harvesting and terrain edits
+func (s *Service) SetRegionService(regionService RegionServiceInterface) {
+	s.regionService = regionService
+}
+
+// SetClaimService restricts harvesting and terrain edits in claimed chunks to their owner
+func (s *Service) SetClaimService(claimService ClaimServiceInterface) {
+	s.claimService = claimService
+}
+
+// SetScripts runs the on_harvest_complete scripting hook on harvests
+func (s *Service) SetScripts(scripts ScriptEngineInterface) {
+	s.scripts = scripts
+}
+
+// SetCalendar scales harvest quantities by the yield multiplier of active seasonal events
+func (s *Service) SetCalendar(calendar CalendarInterface) {
+	s.calendar = calendar
+}
+
+// SetNodeQuality scales harvest quantities by the yield multiplier of the node's quality tier
+func (s *Service) SetNodeQuality(nodeQuality NodeQualityInterface) {
+	s.nodeQuality = nodeQuality
+}
+
+// SetStatusEffects scales harvest quantities by the character's harvest yield effects
+func (s *Service) SetStatusEffects(statusEffects StatusEffectInterface) {
+	s.statusEffects = statusEffects
+}
+
+// SetActionStates marks characters as harvesting when they harvest, so nearby clients
+// play the animation
+func (s *Service) SetActionStates(actionStates ActionStateInterface) {
+	s.actionStates = actionStates
+}
+
+// SetDungeons makes characters inside a dungeon instance harvest the instance's own
+// nodes, with resource_node_id the node's ID in the instance
+func (s *Service) SetDungeons(dungeons DungeonInterface) {
+	s.dungeons = dungeons
+}
+
 // HarvestResource processes harvesting from a resource node
 func (s *Service) HarvestResource(ctx context.Context, userID, characterID string, resourceNodeID int32) ([]*characterActionsV1.HarvestResult, *inventoryV1.InventoryItem, error) {
+	return s.HarvestResourceAt(ctx, userID, characterID, resourceNodeID, time.Time{})
+}
+
+// HarvestResourceAt processes a harvest the player completed at clientTime on their
+// clock (zero if unknown). The caller's latency compensated action time decides which
+// seasonal event modifiers the harvest completed under; the yield roll only depends on
+// the server's clock, so a client cannot shop for drops by choosing its time.
+func (s *Service) HarvestResourceAt(ctx context.Context, userID, characterID string, resourceNodeID int32, clientTime time.Time) ([]*characterActionsV1.HarvestResult, *inventoryV1.InventoryItem, error) {
 	s.logger.Debug("Harvesting resource node", "user_id", userID, "character_id", characterID, "resource_node_id", resourceNodeID)
 
 	// Validate character ID format
@@ -62,6 +160,20 @@ func (s *Service) HarvestResource(ctx context.Context, userID, characterID strin
 	if err := s.validateCharacterOwnership(character, userID); err != nil {
 		return nil, nil, err
 	}
+	if err := session.RequireWorld(ctx, character.WorldID); err != nil {
+		return nil, nil, err
+	}
+
+	if s.dungeons != nil {
+		position, err := s.dungeons.Locate(ctx, character.ID)
+		if err != nil {
+			s.logger.Error("Failed to locate character in dungeons", "character_id", characterID, "error", err)
+			return nil, nil, status.Errorf(codes.Internal, "failed to locate character")
+		}
+		if position != nil {
+			return s.dungeons.Harvest(ctx, *character, position, resourceNodeID)
+		}
+	}
 
 	// Get resource node information
 	resourceNode, err := s.db.GetResourceNode(ctx, resourceNodeID)
@@ -69,6 +181,11 @@ func (s *Service) HarvestResource(ctx context.Context, userID, characterID strin
 		s.logger.Error("Failed to get resource node", "resource_node_id", resourceNodeID, "error", err)
 		return nil, nil, status.Errorf(codes.NotFound, "resource node not found")
 	}
+	// Nodes of other worlds may share the character's coordinates
+	if resourceNode.WorldID != character.WorldID {
+		s.logger.Warn("Resource node is in another world", "character_id", characterID, "resource_node_id", resourceNodeID)
+		return nil, nil, status.Errorf(codes.NotFound, "resource node not found")
+	}
 
 	// Validate character is in range of resource node (basic distance check)
 	if !s.isCharacterInRange(character, &resourceNode) {
@@ -78,7 +195,33 @@ func (s *Service) HarvestResource(ctx context.Context, userID, characterID strin
 			"resource_node_pos", map[string]int32{"chunk_x": resourceNode.ChunkX, "chunk_y": resourceNode.ChunkY, "x": resourceNode.X, "y": resourceNode.Y})
 		return nil, nil, status.Errorf(codes.FailedPrecondition, "character is too far from resource node")
 	}
+	if s.chunkService != nil {
+		visible, err := s.chunkService.LineOfSight(ctx, character.WorldID, geometry.Point{X: character.X, Y: character.Y}, geometry.Point{X: resourceNode.X, Y: resourceNode.Y})
+		if err != nil {
+			s.logger.Error("Failed to check line of sight", "character_id", characterID, "error", err)
+			return nil, nil, status.Errorf(codes.Internal, "failed to check line of sight")
+		}
+		if !visible {
+			return nil, nil, status.Errorf(codes.FailedPrecondition, "resource node is behind terrain")
+		}
+	}
+
+	if s.regionService != nil {
+		if err := s.regionService.CheckAllowed(ctx, resourceNode.WorldID, resourceNode.X, resourceNode.Y, chunkV1.RegionFlag_REGION_FLAG_NO_HARVEST); err != nil {
+			return nil, nil, err
+		}
+	}
+	if s.claimService != nil {
+		if err := s.claimService.CheckAllowed(ctx, resourceNode.WorldID, resourceNode.ChunkX, resourceNode.ChunkY, character.ID); err != nil {
+			return nil, nil, err
+		}
+	}
 
+	// Reserve the node so concurrent harvesters can't both collect the yield
+	if err := s.reserveResourceNode(ctx, character.ID, resourceNodeID); err != nil {
+		return nil, nil, err
+	}
+	defer s.releaseResourceNode(ctx, character.ID, resourceNodeID)
 
 	// Get all possible drops for this resource node type from database
 	drops, err := s.db.GetResourceNodeDrops(ctx, resourceNode.ResourceNodeTypeID)
@@ -87,9 +230,34 @@ func (s *Service) HarvestResource(ctx context.Context, userID, characterID strin
 		return nil, nil, status.Errorf(codes.Internal, "failed to get drop information")
 	}
 
-	// Process all drops for this resource node
+	var worldSeed int64
+	if s.worldSeeds != nil {
+		if worldSeed, err = s.worldSeeds.Seed(ctx, character.WorldID); err != nil {
+			s.logger.Error("Failed to get world seed", "world_id", uuid.PgtypeToString(character.WorldID), "error", err)
+			return nil, nil, status.Errorf(codes.Internal, "failed to roll harvest yields")
+		}
+	}
+
+	// Each harvest rolls from its own stream keyed by the node and the time the server
+	// received it, so a harvest replayed at the same instant yields the same drops
+	receivedAt := s.clock.Now()
+	completedAt := latency.FromContext(ctx).ActionTime(receivedAt, clientTime)
+	yieldRng := rng.New(worldSeed, rng.Yields,
+		int64(resourceNode.X), int64(resourceNode.Y), int64(resourceNode.ID), receivedAt.UnixNano())
+
+	qualityMultiplier := 1.0
+	if s.nodeQuality != nil {
+		qualityMultiplier = s.nodeQuality.QualityMultiplier(resourceNode.Quality)
+	}
+	harvestYield := 1.0
+	if s.statusEffects != nil {
+		harvestYield = s.statusEffects.Multiplier(ctx, character.ID, characterV1.StatusEffectType_STATUS_EFFECT_TYPE_HARVEST_YIELD)
+	}
+
+	// Roll all drops for this resource node
 	var harvestResults []*characterActionsV1.HarvestResult
-	var lastUpdatedItem *inventoryV1.InventoryItem
+	var rolled []db.GetResourceNodeDropsRow
+	var grants []inventory.Grant
 
 	for _, drop := range drops {
 		// Convert pgtype.Numeric to float64
@@ -100,29 +268,108 @@ func (s *Service) HarvestResource(ctx context.Context, userID, characterID strin
 		}
 
 		// Roll for this drop based on chance
-		if s.rng.Float64() < chanceFloat.Float64 {
+		if yieldRng.Float64() < chanceFloat.Float64 {
 			// Calculate quantity within range
 			quantityRange := drop.MaxQuantity - drop.MinQuantity + 1
-			quantity := drop.MinQuantity + s.rng.Int31n(quantityRange)
+			quantity := drop.MinQuantity + yieldRng.Int31n(quantityRange)
+			if qualityMultiplier != 1 {
+				quantity = max(1, int32(math.Round(float64(quantity)*qualityMultiplier)))
+			}
+			if s.calendar != nil {
+				quantity = max(1, int32(math.Round(float64(quantity)*s.calendar.YieldMultiplierAt(completedAt))))
+			}
+			if harvestYield != 1 {
+				quantity = max(1, int32(math.Round(float64(quantity)*harvestYield)))
+			}
 
 			// Add to harvest results
 			harvestResults = append(harvestResults, &characterActionsV1.HarvestResult{
-				ItemName: drop.ItemName,
-				Quantity: quantity,
+				ItemName:        drop.ItemName,
+				Quantity:        quantity,
 				IsSecondaryDrop: chanceFloat.Float64 < 1.0, // Items with 100% chance are "primary"
 			})
+			rolled = append(rolled, drop)
+			grants = append(grants, inventory.Grant{ItemID: drop.ItemID, StackSize: drop.StackSize, Quantity: quantity})
+		}
+	}
 
-			// Add to inventory using the item_id from database
-			updatedItem, err := s.inventoryService.AddInventoryItem(ctx, characterID, drop.ItemID, quantity)
-			if err != nil {
-				s.logger.Error("Failed to add harvested item to inventory", 
-					"item_id", drop.ItemID, 
-					"item_name", drop.ItemName, 
-					"quantity", quantity, 
-					"error", err)
-				return nil, nil, status.Errorf(codes.Internal, "failed to add harvested item: %s", drop.ItemName)
-			}
-			lastUpdatedItem = updatedItem
+	// Scripts may replace the rolled yields with other drops of the node, or veto the harvest
+	var spawned []scripting.Event
+	if s.scripts != nil {
+		input := scripting.HarvestInput{
+			CharacterID:        characterID,
+			WorldID:            uuid.PgtypeToString(resourceNode.WorldID),
+			ResourceNodeID:     resourceNode.ID,
+			ResourceNodeTypeID: resourceNode.ResourceNodeTypeID,
+			X:                  resourceNode.X,
+			Y:                  resourceNode.Y,
+		}
+		for _, grant := range grants {
+			input.Yields = append(input.Yields, scripting.Yield{ItemID: grant.ItemID, Quantity: grant.Quantity})
+		}
+		result := s.scripts.HarvestComplete(ctx, input)
+		if result.Veto {
+			s.logger.Info("Harvest vetoed by script", "resource_node_id", resourceNodeID, "reason", result.Reason)
+			return nil, nil, status.Errorf(codes.FailedPrecondition, "harvest not allowed: %s", result.Reason)
+		}
+		harvestResults, rolled, grants = s.scriptedYields(drops, result.Yields)
+		spawned = result.Events
+	}
+
+	// Grant the yields and publish the harvest for read models in one transaction, so
+	// yields are never lost or granted without the event. What does not fit goes where
+	// the world's overflow policy says, or fails the harvest.
+	harvest := events.ResourceHarvestedPayload{
+		HarvestID:          uuid.GenerateNew(),
+		ResourceNodeID:     resourceNode.ID,
+		ResourceNodeTypeID: resourceNode.ResourceNodeTypeID,
+		CharacterID:        characterID,
+		WorldID:            uuid.PgtypeToString(resourceNode.WorldID),
+		ChunkX:             resourceNode.ChunkX,
+		ChunkY:             resourceNode.ChunkY,
+		Drops:              len(harvestResults),
+		Items:              make(map[string]int32, len(harvestResults)),
+	}
+	for _, result := range harvestResults {
+		harvest.Items[result.ItemName] += result.Quantity
+	}
+	delivery, err := s.inventoryService.Deliver(ctx, inventory.Delivery{
+		CharacterID: character.ID,
+		WorldID:     resourceNode.WorldID,
+		X:           resourceNode.X,
+		Y:           resourceNode.Y,
+		Grants:      grants,
+		Reason:      "harvest",
+		Event: &inventory.Event{
+			Type:        events.ResourceHarvested,
+			AggregateID: characterID,
+			DedupKey:    events.ResourceHarvested + ":" + harvest.HarvestID,
+			Payload:     harvest,
+		},
+	})
+	if err != nil {
+		s.logger.Error("Failed to deliver harvested items", "resource_node_id", resourceNodeID, "drops", len(grants), "error", err)
+		return nil, nil, err
+	}
+
+	var lastUpdatedItem *inventoryV1.InventoryItem
+	for i, delivered := range delivery.Grants {
+		if delivered.Overflow > 0 {
+			harvestResults[i].OverflowQuantity = delivered.Overflow
+			harvestResults[i].OverflowPolicy = inventory.OverflowPolicyProto(delivery.Policy)
+		}
+		if delivered.Granted > 0 {
+			lastUpdatedItem = stackToProto(delivered.Stack, rolled[i])
+		}
+	}
+
+	if s.scripts != nil {
+		s.scripts.Publish(ctx, scripting.HookHarvestComplete, harvest.WorldID, spawned)
+	}
+	if s.actionStates != nil {
+		// The harvest already happened, a character shown in the wrong state is not worth failing it
+		if err := s.actionStates.SetActionState(ctx, character.ID, characterV1.ActionState_ACTION_STATE_HARVESTING); err != nil {
+			s.logger.Warn("Failed to set harvesting action state", "character_id", characterID, "error", err)
 		}
 	}
 
@@ -135,27 +382,138 @@ func (s *Service) HarvestResource(ctx context.Context, userID, characterID strin
 	return harvestResults, lastUpdatedItem, nil
 }
 
-// isCharacterInRange checks if character is within harvesting range of the resource node
-func (s *Service) isCharacterInRange(character *db.Character, resourceNode *db.ResourceNode) bool {
-	// Calculate distance using global coordinates (much simpler!)
-	dx := float64(character.X - resourceNode.X)
-	dy := float64(character.Y - resourceNode.Y)
-	distance := math.Sqrt(dx*dx + dy*dy)
+// scriptedYields builds the harvest from the yields left by scripts. Only items the node
+// can drop are granted, so scripts cannot mint arbitrary items.
+func (s *Service) scriptedYields(drops []db.GetResourceNodeDropsRow, yields []scripting.Yield) ([]*characterActionsV1.HarvestResult, []db.GetResourceNodeDropsRow, []inventory.Grant) {
+	byItem := make(map[int32]db.GetResourceNodeDropsRow, len(drops))
+	for _, drop := range drops {
+		byItem[drop.ItemID] = drop
+	}
+
+	var harvestResults []*characterActionsV1.HarvestResult
+	var rolled []db.GetResourceNodeDropsRow
+	var grants []inventory.Grant
+	for _, yield := range yields {
+		drop, ok := byItem[yield.ItemID]
+		if !ok || yield.Quantity <= 0 {
+			s.logger.Warn("Ignoring scripted yield", "item_id", yield.ItemID, "quantity", yield.Quantity)
+			continue
+		}
+		chance, _ := drop.Chance.Float64Value()
+		harvestResults = append(harvestResults, &characterActionsV1.HarvestResult{
+			ItemName:        drop.ItemName,
+			Quantity:        yield.Quantity,
+			IsSecondaryDrop: chance.Float64 < 1.0,
+		})
+		rolled = append(rolled, drop)
+		grants = append(grants, inventory.Grant{ItemID: drop.ItemID, StackSize: drop.StackSize, Quantity: yield.Quantity})
+	}
+	return harvestResults, rolled, grants
+}
+
+// stackToProto describes an inventory stack with the item details of the drop granted to it
+func stackToProto(stack db.CharacterInventory, drop db.GetResourceNodeDropsRow) *inventoryV1.InventoryItem {
+	item := &inventoryV1.InventoryItem{
+		Id:          stack.ID,
+		CharacterId: uuid.PgtypeToNormalizedString(stack.CharacterID),
+		ItemId:      stack.ItemID,
+		Quantity:    stack.Quantity,
+		ItemName:    drop.ItemName,
+		Description: drop.ItemDescription,
+		ItemType:    drop.ItemType,
+		Rarity:      drop.Rarity,
+		StackSize:   drop.StackSize,
+		VisualData:  drop.VisualData,
+	}
+	if stack.CreatedAt.Valid {
+		item.CreatedAt = timestamppb.New(stack.CreatedAt.Time)
+	}
+	if stack.UpdatedAt.Valid {
+		item.UpdatedAt = timestamppb.New(stack.UpdatedAt.Time)
+	}
+	return item
+}
+
+// reserveResourceNode acquires the harvest reservation for a node, failing with
+// Aborted when another character holds an unexpired reservation
+func (s *Service) reserveResourceNode(ctx context.Context, characterID pgtype.UUID, resourceNodeID int32) error {
+	now := s.clock.Now()
+	_, err := s.db.AcquireResourceNodeReservation(ctx, db.AcquireResourceNodeReservationParams{
+		ResourceNodeID: resourceNodeID,
+		CharacterID:    characterID,
+		Now:            pgtype.Timestamp{Time: now, Valid: true},
+		ExpiresAt:      pgtype.Timestamp{Time: now.Add(harvestReservationTTL), Valid: true},
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		s.logger.Debug("Resource node already reserved", "resource_node_id", resourceNodeID)
+		return alreadyReservedError(resourceNodeID)
+	}
+	if err != nil {
+		s.logger.Error("Failed to reserve resource node", "resource_node_id", resourceNodeID, "error", err)
+		return status.Errorf(codes.Internal, "failed to reserve resource node")
+	}
+	return nil
+}
+
+// releaseResourceNode frees the reservation early; if this fails the reservation simply expires
+func (s *Service) releaseResourceNode(ctx context.Context, characterID pgtype.UUID, resourceNodeID int32) {
+	if err := s.db.ReleaseResourceNodeReservation(ctx, db.ReleaseResourceNodeReservationParams{
+		ResourceNodeID: resourceNodeID,
+		CharacterID:    characterID,
+	}); err != nil {
+		s.logger.Warn("Failed to release resource node reservation", "resource_node_id", resourceNodeID, "error", err)
+	}
+}
+
+// alreadyReservedError builds the Aborted status returned to concurrent harvesters.
+// Clients can match on the ALREADY_RESERVED ErrorInfo reason and retry later.
+func alreadyReservedError(resourceNodeID int32) error {
+	st := status.New(codes.Aborted, ErrAlreadyReserved.Error())
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   ReasonAlreadyReserved,
+		Domain:   errorDomain,
+		Metadata: map[string]string{"resource_node_id": fmt.Sprint(resourceNodeID)},
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// IsAlreadyReserved reports whether err is the Aborted error returned for a reserved resource node
+func IsAlreadyReserved(err error) bool {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.Aborted {
+		return false
+	}
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.Reason == ReasonAlreadyReserved {
+			return true
+		}
+	}
+	return false
+}
 
+// isCharacterInRange checks if character is within harvesting range of the resource node,
+// which must be in the character's world
+func (s *Service) isCharacterInRange(character *db.Character, resourceNode *db.ResourceNode) bool {
+	if character.WorldID != resourceNode.WorldID {
+		return false
+	}
 	// Allow harvesting within 3 units (adjust as needed for game balance)
-	const maxHarvestDistance = 3.0
-	return distance <= maxHarvestDistance
+	const maxHarvestDistance = 3
+	return geometry.InRange(geometry.Point{X: character.X, Y: character.Y}, geometry.Point{X: resourceNode.X, Y: resourceNode.Y}, maxHarvestDistance)
 }
 
 // validateCharacterOwnership checks if the character belongs to the specified user
 func (s *Service) validateCharacterOwnership(character *db.Character, userID string) error {
 	characterUserID := uuid.PgtypeToString(character.UserID)
 	if !uuid.Compare(characterUserID, userID) {
-		s.logger.Warn("Character ownership validation failed", 
-			"character_id", character.ID.String(), 
-			"character_user_id", characterUserID, 
+		s.logger.Warn("Character ownership validation failed",
+			"character_id", character.ID.String(),
+			"character_user_id", characterUserID,
 			"requesting_user_id", userID)
 		return status.Errorf(codes.PermissionDenied, "character not owned by user")
 	}
 	return nil
-}
\ No newline at end of file
+}
@@ -4,9 +4,19 @@ import (
 	"context"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/latency"
+	"github.com/VoidMesh/api/api/internal/scripting"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
 	inventoryV1 "github.com/VoidMesh/api/api/proto/inventory/v1"
+	"github.com/VoidMesh/api/api/services/inventory"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -30,13 +40,42 @@ func (m *MockDatabase) GetResourceNodeDrops(ctx context.Context, resourceNodeTyp
 	return args.Get(0).([]db.GetResourceNodeDropsRow), args.Error(1)
 }
 
+func (m *MockDatabase) AcquireResourceNodeReservation(ctx context.Context, arg db.AcquireResourceNodeReservationParams) (db.ResourceNodeReservation, error) {
+	args := m.Called(ctx, arg)
+	return args.Get(0).(db.ResourceNodeReservation), args.Error(1)
+}
+
+func (m *MockDatabase) ReleaseResourceNodeReservation(ctx context.Context, arg db.ReleaseResourceNodeReservationParams) error {
+	args := m.Called(ctx, arg)
+	return args.Error(0)
+}
+
 type MockInventoryService struct {
 	mock.Mock
 }
 
-func (m *MockInventoryService) AddInventoryItem(ctx context.Context, characterID string, itemID int32, quantity int32) (*inventoryV1.InventoryItem, error) {
-	args := m.Called(ctx, characterID, itemID, quantity)
-	return args.Get(0).(*inventoryV1.InventoryItem), args.Error(1)
+func (m *MockInventoryService) Deliver(ctx context.Context, delivery inventory.Delivery) (*inventory.DeliveryResult, error) {
+	args := m.Called(ctx, delivery)
+	if deliver, ok := args.Get(0).(func(inventory.Delivery) *inventory.DeliveryResult); ok {
+		return deliver(delivery), args.Error(1)
+	}
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*inventory.DeliveryResult), args.Error(1)
+}
+
+// deliverAll returns a DeliveryResult granting every grant of the delivery in full
+func deliverAll(delivery inventory.Delivery) *inventory.DeliveryResult {
+	result := &inventory.DeliveryResult{Policy: inventory.OverflowReject}
+	for i, grant := range delivery.Grants {
+		result.Grants = append(result.Grants, inventory.DeliveredGrant{
+			Grant:   grant,
+			Granted: grant.Quantity,
+			Stack:   db.CharacterInventory{ID: int32(i + 1), CharacterID: delivery.CharacterID, ItemID: grant.ItemID, Quantity: grant.Quantity},
+		})
+	}
+	return result
 }
 
 type MockCharacterService struct {
@@ -77,6 +116,14 @@ func (m *MockLogger) With(key string, value interface{}) LoggerInterface {
 	return args.Get(0).(LoggerInterface)
 }
 
+// testWorld is the world of the test characters and resource nodes
+var testWorld = pgtype.UUID{Bytes: [16]byte{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}, Valid: true}
+
+// playerContext is the context of a session bound to the test world
+func playerContext() context.Context {
+	return session.WithWorldID(context.Background(), uuid.PgtypeToString(testWorld))
+}
+
 func TestService_HarvestResource_Success(t *testing.T) {
 	// Setup mocks
 	mockDB := &MockDatabase{}
@@ -90,7 +137,7 @@ func TestService_HarvestResource_Success(t *testing.T) {
 
 	service := NewService(mockDB, mockInventory, mockCharacter, mockLogger)
 
-	ctx := context.Background()
+	ctx := playerContext()
 	characterID := "0123456789abcdef0123456789abcdef" // 32 hex chars
 	resourceNodeID := int32(1)
 
@@ -99,9 +146,10 @@ func TestService_HarvestResource_Success(t *testing.T) {
 	// Create a proper UUID for the user - using same UUID
 	userUUIDBytes := [16]byte{0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0, 0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0}
 	character := &db.Character{
-		ID:     pgtype.UUID{Bytes: [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, Valid: true},
-		UserID: pgtype.UUID{Bytes: userUUIDBytes, Valid: true},
-		Name:   "TestCharacter",
+		ID:      pgtype.UUID{Bytes: [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, Valid: true},
+		UserID:  pgtype.UUID{Bytes: userUUIDBytes, Valid: true},
+		WorldID: testWorld,
+		Name:    "TestCharacter",
 		X:      10,
 		Y:      10,
 		ChunkX: 0,
@@ -111,7 +159,7 @@ func TestService_HarvestResource_Success(t *testing.T) {
 	resourceNode := db.ResourceNode{
 		ID:                 1,
 		ResourceNodeTypeID: 1,
-		WorldID:            pgtype.UUID{Bytes: [16]byte{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}, Valid: true},
+		WorldID:            testWorld,
 		ChunkX:             0,
 		ChunkY:             0,
 		X:               12, // Within range (distance = 2.828)
@@ -151,27 +199,24 @@ func TestService_HarvestResource_Success(t *testing.T) {
 		},
 	}
 
-	inventoryItem := &inventoryV1.InventoryItem{
-		Id:          1,
-		CharacterId: characterID,
-		ItemId:      101,
-		Quantity:    2,
-		ItemName:    "Tree",
-		Description: "Wood from a tree",
-		ItemType:    "material",
-		Rarity:      "common",
-		StackSize:   64,
-		VisualData:  []byte(`{"sprite": "tree", "color": "#8B4513"}`),
-	}
-
 	// Setup expectations
 	mockCharacter.On("GetCharacterByID", ctx, characterID).Return(character, nil)
 	mockDB.On("GetResourceNode", ctx, resourceNodeID).Return(resourceNode, nil)
 	mockDB.On("GetResourceNodeDrops", ctx, int32(1)).Return(drops, nil)
-	// Mock expects either item ID from the drops (101 or 102)
-	mockInventory.On("AddInventoryItem", ctx, characterID, mock.MatchedBy(func(itemID int32) bool {
-		return itemID == 101 || itemID == 102
-	}), mock.AnythingOfType("int32")).Return(inventoryItem, nil)
+	mockDB.On("AcquireResourceNodeReservation", ctx, mock.MatchedBy(func(arg db.AcquireResourceNodeReservationParams) bool {
+		return arg.ResourceNodeID == resourceNodeID && arg.CharacterID == character.ID && arg.ExpiresAt.Time.After(arg.Now.Time)
+	})).Return(db.ResourceNodeReservation{ResourceNodeID: resourceNodeID, CharacterID: character.ID}, nil)
+	mockDB.On("ReleaseResourceNodeReservation", ctx, db.ReleaseResourceNodeReservationParams{
+		ResourceNodeID: resourceNodeID,
+		CharacterID:    character.ID,
+	}).Return(nil)
+	// The delivery carries the rolled drops and the harvest event
+	isHarvestDelivery := mock.MatchedBy(func(delivery inventory.Delivery) bool {
+		harvest, ok := delivery.Event.Payload.(events.ResourceHarvestedPayload)
+		return ok && delivery.CharacterID == character.ID && len(delivery.Grants) >= 1 && delivery.Grants[0].ItemID == 101 &&
+			delivery.Event.Type == events.ResourceHarvested && harvest.ResourceNodeID == resourceNodeID && harvest.CharacterID == characterID && harvest.HarvestID != ""
+	})
+	mockInventory.On("Deliver", ctx, isHarvestDelivery).Return(deliverAll, nil)
 
 	// Execute
 	results, updatedItem, err := service.HarvestResource(ctx, userID, characterID, resourceNodeID)
@@ -187,7 +232,12 @@ func TestService_HarvestResource_Success(t *testing.T) {
 	assert.Equal(t, "Tree", results[0].ItemName)
 	assert.False(t, results[0].IsSecondaryDrop)
 	assert.True(t, results[0].Quantity >= 1 && results[0].Quantity <= 3)
-	assert.Equal(t, inventoryItem, updatedItem)
+	// The updated item is the stack of the last drop
+	last := results[len(results)-1]
+	assert.Equal(t, last.ItemName, updatedItem.ItemName)
+	assert.Equal(t, last.Quantity, updatedItem.Quantity)
+	assert.Equal(t, int32(64), updatedItem.StackSize)
+	assert.Zero(t, last.OverflowQuantity)
 
 	// Verify all mocks were called
 	mockCharacter.AssertExpectations(t)
@@ -195,6 +245,43 @@ func TestService_HarvestResource_Success(t *testing.T) {
 	mockInventory.AssertExpectations(t)
 }
 
+func TestService_HarvestResource_Overflow(t *testing.T) {
+	ctx := playerContext()
+	newService := func(inventoryService *MockInventoryService) *Service {
+		logger := &MockLogger{}
+		logger.On("With", "component", "character-actions-service").Return(logger)
+		logger.On("Debug", mock.Anything, mock.Anything).Return()
+		logger.On("Error", mock.Anything, mock.Anything).Return()
+		return NewService(newReservationDB(), inventoryService, characterDirectory{}, logger)
+	}
+
+	t.Run("spilled overflow is reported", func(t *testing.T) {
+		mockInventory := &MockInventoryService{}
+		mockInventory.On("Deliver", ctx, mock.MatchedBy(func(delivery inventory.Delivery) bool {
+			return delivery.X == 10 && delivery.Y == 10 && delivery.Reason == "harvest"
+		})).Return(&inventory.DeliveryResult{
+			Policy: inventory.OverflowGround,
+			Grants: []inventory.DeliveredGrant{{Grant: inventory.Grant{ItemID: 101, Quantity: 1}, Overflow: 1}},
+		}, nil)
+
+		results, updatedItem, err := newService(mockInventory).HarvestResource(ctx, raceUserID, raceCharacterID(0), 1)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, int32(1), results[0].Quantity)
+		assert.Equal(t, int32(1), results[0].OverflowQuantity)
+		assert.Equal(t, inventoryV1.OverflowPolicy_OVERFLOW_POLICY_GROUND, results[0].OverflowPolicy)
+		assert.Nil(t, updatedItem, "nothing reached the inventory")
+	})
+
+	t.Run("rejected overflow fails the harvest", func(t *testing.T) {
+		mockInventory := &MockInventoryService{}
+		mockInventory.On("Deliver", ctx, mock.Anything).Return(nil, status.Error(codes.ResourceExhausted, "inventory is full"))
+
+		_, _, err := newService(mockInventory).HarvestResource(ctx, raceUserID, raceCharacterID(0), 1)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+}
+
 func TestService_HarvestResource_InvalidCharacterID(t *testing.T) {
 	// Setup mocks
 	mockDB := &MockDatabase{}
@@ -276,7 +363,7 @@ func TestService_HarvestResource_OutOfRange(t *testing.T) {
 
 	service := NewService(mockDB, mockInventory, mockCharacter, mockLogger)
 
-	ctx := context.Background()
+	ctx := playerContext()
 	characterID := "0123456789abcdef0123456789abcdef"
 	resourceNodeID := int32(1)
 
@@ -284,9 +371,10 @@ func TestService_HarvestResource_OutOfRange(t *testing.T) {
 	userID := "12345678-9abc-def0-1234-56789abcdef0"
 	userUUIDBytes := [16]byte{0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0, 0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0}
 	character := &db.Character{
-		ID:     pgtype.UUID{Bytes: [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, Valid: true},
-		UserID: pgtype.UUID{Bytes: userUUIDBytes, Valid: true},
-		Name:   "TestCharacter",
+		ID:      pgtype.UUID{Bytes: [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, Valid: true},
+		UserID:  pgtype.UUID{Bytes: userUUIDBytes, Valid: true},
+		WorldID: testWorld,
+		Name:    "TestCharacter",
 		X:      0,
 		Y:      0,
 		ChunkX: 0,
@@ -296,7 +384,7 @@ func TestService_HarvestResource_OutOfRange(t *testing.T) {
 	resourceNode := db.ResourceNode{
 		ID:                 1,
 		ResourceNodeTypeID: 1,
-		WorldID:            pgtype.UUID{Bytes: [16]byte{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}, Valid: true},
+		WorldID:            testWorld,
 		ChunkX:             0,
 		ChunkY:             0,
 		X:               10, // Distance = 14.14, > maxHarvestDistance (3)
@@ -324,6 +412,339 @@ func TestService_HarvestResource_OutOfRange(t *testing.T) {
 	mockDB.AssertExpectations(t)
 }
 
+func TestService_HarvestResource_ProtectedRegion(t *testing.T) {
+	mockDB := &MockDatabase{}
+	mockCharacter := &MockCharacterService{}
+	mockLogger := &MockLogger{}
+	mockLogger.On("With", "component", "character-actions-service").Return(mockLogger)
+	mockLogger.On("Debug", mock.AnythingOfType("string"), mock.Anything).Return()
+
+	service := NewService(mockDB, &MockInventoryService{}, mockCharacter, mockLogger)
+	service.SetRegionService(fakeRegions{x: 1, y: 1, flag: chunkV1.RegionFlag_REGION_FLAG_NO_HARVEST})
+
+	ctx := playerContext()
+	characterID := "0123456789abcdef0123456789abcdef"
+	userID := "12345678-9abc-def0-1234-56789abcdef0"
+	userUUIDBytes := [16]byte{0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0, 0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0}
+	character := &db.Character{
+		ID:      pgtype.UUID{Bytes: [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, Valid: true},
+		UserID:  pgtype.UUID{Bytes: userUUIDBytes, Valid: true},
+		WorldID: testWorld,
+		Name:    "TestCharacter",
+	}
+	resourceNode := db.ResourceNode{ID: 1, ResourceNodeTypeID: 1, WorldID: testWorld, X: 1, Y: 1, Size: 1}
+
+	mockCharacter.On("GetCharacterByID", ctx, characterID).Return(character, nil)
+	mockDB.On("GetResourceNode", ctx, int32(1)).Return(resourceNode, nil)
+
+	results, _, err := service.HarvestResource(ctx, userID, characterID, 1)
+	assert.Nil(t, results)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, err.Error(), "not allowed")
+
+	// The node is never reserved
+	mockDB.AssertNotCalled(t, "AcquireResourceNodeReservation", mock.Anything, mock.Anything)
+}
+
+func TestService_HarvestResource_LineOfSight(t *testing.T) {
+	mockDB := &MockDatabase{}
+	mockCharacter := &MockCharacterService{}
+	mockLogger := &MockLogger{}
+	mockLogger.On("With", "component", "character-actions-service").Return(mockLogger)
+	mockLogger.On("Debug", mock.AnythingOfType("string"), mock.Anything).Return()
+
+	service := NewService(mockDB, &MockInventoryService{}, mockCharacter, mockLogger)
+	service.SetChunkService(&fakeChunkService{cells: map[[2]int32]chunkV1.TerrainType{
+		{1, 0}: chunkV1.TerrainType_TERRAIN_TYPE_STONE,
+	}})
+
+	ctx := playerContext()
+	characterID := "0123456789abcdef0123456789abcdef"
+	userID := "12345678-9abc-def0-1234-56789abcdef0"
+	userUUIDBytes := [16]byte{0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0, 0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0}
+	character := &db.Character{
+		ID:      pgtype.UUID{Bytes: [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, Valid: true},
+		UserID:  pgtype.UUID{Bytes: userUUIDBytes, Valid: true},
+		WorldID: testWorld,
+		Name:    "TestCharacter",
+	}
+	resourceNode := db.ResourceNode{ID: 1, ResourceNodeTypeID: 1, WorldID: testWorld, X: 2, Y: 0, Size: 1}
+
+	mockCharacter.On("GetCharacterByID", ctx, characterID).Return(character, nil)
+	mockDB.On("GetResourceNode", ctx, int32(1)).Return(resourceNode, nil)
+
+	results, _, err := service.HarvestResource(ctx, userID, characterID, 1)
+	assert.Nil(t, results)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, err.Error(), "behind terrain")
+	mockDB.AssertNotCalled(t, "AcquireResourceNodeReservation", mock.Anything, mock.Anything)
+}
+
+// otherWorldNodeDB returns resource nodes at the test characters' coordinates in
+// another world
+type otherWorldNodeDB struct{ *reservationDB }
+
+func (o otherWorldNodeDB) GetResourceNode(ctx context.Context, id int32) (db.ResourceNode, error) {
+	node, err := o.reservationDB.GetResourceNode(ctx, id)
+	node.WorldID = pgtype.UUID{Bytes: [16]byte{2}, Valid: true}
+	return node, err
+}
+
+func TestService_HarvestResource_Worlds(t *testing.T) {
+	logger := &MockLogger{}
+	logger.On("With", "component", "character-actions-service").Return(logger)
+	for _, level := range []string{"Debug", "Info", "Warn", "Error"} {
+		logger.On(level, mock.Anything, mock.Anything).Return()
+	}
+	inventory := &gatedInventory{grants: make(map[string]int)}
+
+	service := NewService(newReservationDB(), inventory, characterDirectory{}, logger)
+	_, _, err := service.HarvestResource(context.Background(), raceUserID, raceCharacterID(0), 1)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "sessions not bound to a world cannot harvest")
+	_, _, err = service.HarvestResource(session.WithWorldID(context.Background(), "00000000-0000-0000-0000-000000000002"), raceUserID, raceCharacterID(0), 1)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "sessions of another world cannot harvest")
+
+	service = NewService(otherWorldNodeDB{newReservationDB()}, inventory, characterDirectory{}, logger)
+	_, _, err = service.HarvestResource(playerContext(), raceUserID, raceCharacterID(0), 1)
+	assert.Equal(t, codes.NotFound, status.Code(err), "nodes at the same coordinates of another world cannot be harvested")
+	assert.Empty(t, inventory.grants)
+}
+
+// fakeScripts returns a fixed result from on_harvest_complete and keeps what it sees
+type fakeScripts struct {
+	result    scripting.Result
+	input     scripting.HarvestInput
+	published []scripting.Event
+}
+
+func (f *fakeScripts) HarvestComplete(ctx context.Context, input scripting.HarvestInput) scripting.Result {
+	f.input = input
+	return f.result
+}
+
+func (f *fakeScripts) Publish(ctx context.Context, hook, worldID string, spawned []scripting.Event) {
+	f.published = append(f.published, spawned...)
+}
+
+func TestService_HarvestResource_Scripts(t *testing.T) {
+	ctx := playerContext()
+	newService := func(scripts *fakeScripts) (*Service, *gatedInventory) {
+		logger := &MockLogger{}
+		logger.On("With", "component", "character-actions-service").Return(logger)
+		for _, level := range []string{"Debug", "Info", "Warn", "Error"} {
+			logger.On(level, mock.Anything, mock.Anything).Return()
+		}
+		inventory := &gatedInventory{grants: make(map[string]int)}
+		service := NewService(newReservationDB(), inventory, characterDirectory{}, logger)
+		service.SetScripts(scripts)
+		return service, inventory
+	}
+
+	t.Run("scripts replace yields with drops of the node", func(t *testing.T) {
+		scripts := &fakeScripts{result: scripting.Result{
+			Yields: []scripting.Yield{{ItemID: 101, Quantity: 5}, {ItemID: 999, Quantity: 1}},
+			Events: []scripting.Event{{Name: "bonus"}},
+		}}
+		service, _ := newService(scripts)
+
+		results, _, err := service.HarvestResource(ctx, raceUserID, raceCharacterID(0), 1)
+		require.NoError(t, err)
+		assert.Equal(t, []scripting.Yield{{ItemID: 101, Quantity: 1}}, scripts.input.Yields, "scripts see the rolled yields")
+		require.Len(t, results, 1, "items the node cannot drop are ignored")
+		assert.Equal(t, "Wood", results[0].ItemName)
+		assert.Equal(t, int32(5), results[0].Quantity)
+		require.Len(t, scripts.published, 1)
+		assert.Equal(t, "bonus", scripts.published[0].Name)
+	})
+
+	t.Run("a veto fails the harvest without granting", func(t *testing.T) {
+		scripts := &fakeScripts{result: scripting.Result{Veto: true, Reason: "the forest is resting", Events: []scripting.Event{{Name: "bonus"}}}}
+		service, inventory := newService(scripts)
+
+		_, _, err := service.HarvestResource(ctx, raceUserID, raceCharacterID(0), 1)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Contains(t, err.Error(), "the forest is resting")
+		assert.Empty(t, inventory.grants)
+		assert.Empty(t, scripts.published)
+	})
+}
+
+// fixedCalendar scales every harvest by the same multiplier
+type fixedCalendar float64
+
+func (c fixedCalendar) YieldMultiplierAt(t time.Time) float64 { return float64(c) }
+
+func TestService_HarvestResource_Calendar(t *testing.T) {
+	logger := &MockLogger{}
+	logger.On("With", "component", "character-actions-service").Return(logger)
+	for _, level := range []string{"Debug", "Info", "Warn", "Error"} {
+		logger.On(level, mock.Anything, mock.Anything).Return()
+	}
+	inventory := &gatedInventory{grants: make(map[string]int)}
+	service := NewService(newReservationDB(), inventory, characterDirectory{}, logger)
+	service.SetCalendar(fixedCalendar(2.5))
+
+	results, _, err := service.HarvestResource(playerContext(), raceUserID, raceCharacterID(0), 1)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, int32(3), results[0].Quantity, "the rolled quantity of 1 is scaled and rounded")
+}
+
+// eventUntil doubles harvests completed before the event ends
+type eventUntil time.Time
+
+func (e eventUntil) YieldMultiplierAt(t time.Time) float64 {
+	if t.Before(time.Time(e)) {
+		return 2
+	}
+	return 1
+}
+
+// calibrated returns a context with a calibration of 200ms round trip time and a client
+// clock 100ms ahead, so harvests are credited 100ms before they arrive
+func calibrated(t *testing.T) context.Context {
+	t.Helper()
+	fake := clock.NewFake(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	tracker := latency.NewTracker(0)
+	tracker.SetClock(fake)
+	probe := tracker.Probe("session")
+	fake.Advance(200 * time.Millisecond)
+	cal, err := tracker.Observe("session", probe, fake.Now())
+	require.NoError(t, err)
+	return latency.WithCalibration(playerContext(), cal)
+}
+
+func TestService_HarvestResource_LatencyCompensation(t *testing.T) {
+	logger := &MockLogger{}
+	logger.On("With", "component", "character-actions-service").Return(logger)
+	for _, level := range []string{"Debug", "Info", "Warn", "Error"} {
+		logger.On(level, mock.Anything, mock.Anything).Return()
+	}
+	endsAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	service := NewService(newReservationDB(), &gatedInventory{grants: make(map[string]int)}, characterDirectory{}, logger)
+	service.SetClock(clock.NewFake(endsAt.Add(50 * time.Millisecond)))
+	service.SetCalendar(eventUntil(endsAt))
+
+	results, _, err := service.HarvestResource(playerContext(), raceUserID, raceCharacterID(0), 1)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, int32(1), results[0].Quantity, "an uncalibrated harvest is credited when it arrives, after the event")
+
+	results, _, err = service.HarvestResource(calibrated(t), raceUserID, raceCharacterID(0), 1)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, int32(2), results[0].Quantity, "the player completed the harvest while the event was running")
+
+	results, _, err = service.HarvestResourceAt(calibrated(t), raceUserID, raceCharacterID(0), 1, endsAt.Add(-time.Hour))
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, int32(2), results[0].Quantity, "an early client time is credited at most the allowance")
+
+	results, _, err = service.HarvestResourceAt(calibrated(t), raceUserID, raceCharacterID(0), 1, endsAt.Add(150*time.Millisecond))
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, int32(1), results[0].Quantity, "the client says it finished after the event ended")
+}
+
+func TestService_HarvestResource_ClientTimeDoesNotPickTheRoll(t *testing.T) {
+	logger := &MockLogger{}
+	logger.On("With", "component", "character-actions-service").Return(logger)
+	for _, level := range []string{"Debug", "Info", "Warn", "Error"} {
+		logger.On(level, mock.Anything, mock.Anything).Return()
+	}
+	now := time.Date(2025, 1, 1, 12, 0, 0, 50*int(time.Millisecond), time.UTC)
+	service := NewService(rangedDropDB{newReservationDB()}, &gatedInventory{grants: make(map[string]int)}, characterDirectory{}, logger)
+	service.SetClock(clock.NewFake(now))
+
+	var quantities []int32
+	for _, offset := range []time.Duration{0, -10 * time.Millisecond, -50 * time.Millisecond, -100 * time.Millisecond} {
+		results, _, err := service.HarvestResourceAt(calibrated(t), raceUserID, raceCharacterID(0), 1, now.Add(offset))
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		quantities = append(quantities, results[0].Quantity)
+	}
+	for _, quantity := range quantities[1:] {
+		assert.Equal(t, quantities[0], quantity, "harvests received at the same server time roll the same drops whatever client time they claim")
+	}
+}
+
+// harvestYield applies one harvest yield multiplier to every character
+type harvestYield float64
+
+func (h harvestYield) Multiplier(ctx context.Context, characterID pgtype.UUID, effectType characterV1.StatusEffectType) float64 {
+	if effectType != characterV1.StatusEffectType_STATUS_EFFECT_TYPE_HARVEST_YIELD {
+		return 1
+	}
+	return float64(h)
+}
+
+func TestService_HarvestResource_StatusEffects(t *testing.T) {
+	logger := &MockLogger{}
+	logger.On("With", "component", "character-actions-service").Return(logger)
+	for _, level := range []string{"Debug", "Info", "Warn", "Error"} {
+		logger.On(level, mock.Anything, mock.Anything).Return()
+	}
+	service := NewService(newReservationDB(), &gatedInventory{grants: make(map[string]int)}, characterDirectory{}, logger)
+	service.SetCalendar(fixedCalendar(2))
+	service.SetStatusEffects(harvestYield(1.5))
+
+	results, _, err := service.HarvestResource(playerContext(), raceUserID, raceCharacterID(0), 1)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, int32(3), results[0].Quantity, "harvest yield scales the quantity after seasonal events")
+}
+
+// qualityYields maps node quality tiers to their yield multipliers
+type qualityYields map[int32]float64
+
+func (q qualityYields) QualityMultiplier(quality int32) float64 {
+	if multiplier, ok := q[quality]; ok {
+		return multiplier
+	}
+	return 1
+}
+
+func TestService_HarvestResource_NodeQuality(t *testing.T) {
+	logger := &MockLogger{}
+	logger.On("With", "component", "character-actions-service").Return(logger)
+	for _, level := range []string{"Debug", "Info", "Warn", "Error"} {
+		logger.On(level, mock.Anything, mock.Anything).Return()
+	}
+	service := NewService(newReservationDB(), &gatedInventory{grants: make(map[string]int)}, characterDirectory{}, logger)
+	service.SetNodeQuality(qualityYields{1: 0.5, 3: 4})
+
+	results, _, err := service.HarvestResource(playerContext(), raceUserID, raceCharacterID(0), 1)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, int32(4), results[0].Quantity, "the node is rich")
+}
+
+// recordedActionStates keeps the action states set per character
+type recordedActionStates map[pgtype.UUID]characterV1.ActionState
+
+func (r recordedActionStates) SetActionState(ctx context.Context, characterID pgtype.UUID, state characterV1.ActionState) error {
+	r[characterID] = state
+	return nil
+}
+
+func TestService_HarvestResource_ActionState(t *testing.T) {
+	logger := &MockLogger{}
+	logger.On("With", "component", "character-actions-service").Return(logger)
+	for _, level := range []string{"Debug", "Info", "Warn", "Error"} {
+		logger.On(level, mock.Anything, mock.Anything).Return()
+	}
+	states := recordedActionStates{}
+	service := NewService(newReservationDB(), &gatedInventory{grants: make(map[string]int)}, characterDirectory{}, logger)
+	service.SetActionStates(states)
+
+	_, _, err := service.HarvestResource(playerContext(), raceUserID, raceCharacterID(0), 1)
+	require.NoError(t, err)
+
+	character, err := characterDirectory{}.GetCharacterByID(context.Background(), raceCharacterID(0))
+	require.NoError(t, err)
+	assert.Equal(t, recordedActionStates{character.ID: characterV1.ActionState_ACTION_STATE_HARVESTING}, states)
+}
+
 func TestService_isCharacterInRange(t *testing.T) {
 	service := &Service{}
 
@@ -373,6 +794,16 @@ func TestService_isCharacterInRange(t *testing.T) {
 			},
 			expected: false,
 		},
+		{
+			name: "Same cell of another world",
+			character: &db.Character{
+				WorldID: testWorld, X: 10, Y: 10, ChunkX: 0, ChunkY: 0,
+			},
+			resourceNode: &db.ResourceNode{
+				X: 10, Y: 10, ChunkX: 0, ChunkY: 0,
+			},
+			expected: false,
+		},
 	}
 
 	for _, tt := range tests {
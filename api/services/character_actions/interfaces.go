@@ -2,20 +2,32 @@ package character_actions
 
 import (
 	"context"
+	"time"
 
 	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/VoidMesh/api/api/internal/scripting"
+	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
+	characterActionsV1 "github.com/VoidMesh/api/api/proto/character_actions/v1"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	dungeonV1 "github.com/VoidMesh/api/api/proto/dungeon/v1"
 	inventoryV1 "github.com/VoidMesh/api/api/proto/inventory/v1"
+	"github.com/VoidMesh/api/api/services/chunk"
+	"github.com/VoidMesh/api/api/services/inventory"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 // DatabaseInterface defines the database operations needed by the character actions service.
 type DatabaseInterface interface {
 	GetResourceNode(ctx context.Context, id int32) (db.ResourceNode, error)
 	GetResourceNodeDrops(ctx context.Context, resourceNodeTypeID int32) ([]db.GetResourceNodeDropsRow, error)
+	AcquireResourceNodeReservation(ctx context.Context, arg db.AcquireResourceNodeReservationParams) (db.ResourceNodeReservation, error)
+	ReleaseResourceNodeReservation(ctx context.Context, arg db.ReleaseResourceNodeReservationParams) error
 }
 
 // InventoryServiceInterface defines the inventory operations needed.
 type InventoryServiceInterface interface {
-	AddInventoryItem(ctx context.Context, characterID string, itemID int32, quantity int32) (*inventoryV1.InventoryItem, error)
+	Deliver(ctx context.Context, delivery inventory.Delivery) (*inventory.DeliveryResult, error)
 }
 
 // CharacterServiceInterface defines the character operations needed.
@@ -24,6 +36,60 @@ type CharacterServiceInterface interface {
 	// In the future: ValidateCharacterPosition, CheckCharacterPermissions, etc.
 }
 
+// ChunkServiceInterface defines the terrain operations needed.
+type ChunkServiceInterface interface {
+	GetCell(ctx context.Context, worldID pgtype.UUID, x, y int32) (chunkV1.TerrainType, error)
+	ModifyCell(ctx context.Context, edit chunk.CellEdit) error
+	LineOfSight(ctx context.Context, worldID pgtype.UUID, from, to geometry.Point) (bool, error)
+}
+
+// RegionServiceInterface defines the protected region checks needed.
+type RegionServiceInterface interface {
+	CheckAllowed(ctx context.Context, worldID pgtype.UUID, x, y int32, flag chunkV1.RegionFlag) error
+}
+
+// ClaimServiceInterface defines the land claim checks needed.
+type ClaimServiceInterface interface {
+	CheckAllowed(ctx context.Context, worldID pgtype.UUID, chunkX, chunkY int32, characterID pgtype.UUID) error
+}
+
+// ScriptEngineInterface defines the scripting hooks run on harvests.
+type ScriptEngineInterface interface {
+	HarvestComplete(ctx context.Context, input scripting.HarvestInput) scripting.Result
+	Publish(ctx context.Context, hook, worldID string, spawned []scripting.Event)
+}
+
+// CalendarInterface defines the seasonal event modifiers applied to harvests.
+type CalendarInterface interface {
+	YieldMultiplierAt(t time.Time) float64
+}
+
+// NodeQualityInterface defines the yield multipliers of resource node quality tiers.
+type NodeQualityInterface interface {
+	QualityMultiplier(quality int32) float64
+}
+
+// StatusEffectInterface defines the status effect modifiers applied to harvests.
+type StatusEffectInterface interface {
+	Multiplier(ctx context.Context, characterID pgtype.UUID, effectType characterV1.StatusEffectType) float64
+}
+
+// DungeonInterface harvests the resource nodes of dungeon instances.
+type DungeonInterface interface {
+	Locate(ctx context.Context, characterID pgtype.UUID) (*dungeonV1.DungeonPosition, error)
+	Harvest(ctx context.Context, character db.Character, position *dungeonV1.DungeonPosition, nodeID int32) ([]*characterActionsV1.HarvestResult, *inventoryV1.InventoryItem, error)
+}
+
+// WorldSeedsInterface looks up the seed of a world.
+type WorldSeedsInterface interface {
+	Seed(ctx context.Context, worldID pgtype.UUID) (int64, error)
+}
+
+// ActionStateInterface records what characters are doing for remote animation.
+type ActionStateInterface interface {
+	SetActionState(ctx context.Context, characterID pgtype.UUID, state characterV1.ActionState) error
+}
+
 
 // LoggerInterface defines the logging operations.
 type LoggerInterface interface {
@@ -51,6 +117,14 @@ func (d *DatabaseWrapper) GetResourceNodeDrops(ctx context.Context, resourceNode
 	return d.queries.GetResourceNodeDrops(ctx, resourceNodeTypeID)
 }
 
+func (d *DatabaseWrapper) AcquireResourceNodeReservation(ctx context.Context, arg db.AcquireResourceNodeReservationParams) (db.ResourceNodeReservation, error) {
+	return d.queries.AcquireResourceNodeReservation(ctx, arg)
+}
+
+func (d *DatabaseWrapper) ReleaseResourceNodeReservation(ctx context.Context, arg db.ReleaseResourceNodeReservationParams) error {
+	return d.queries.ReleaseResourceNodeReservation(ctx, arg)
+}
+
 // InventoryServiceAdapter adapts the inventory service to our interface
 type InventoryServiceAdapter struct {
 	service InventoryServiceInterface
@@ -60,8 +134,8 @@ func NewInventoryServiceAdapter(service InventoryServiceInterface) *InventorySer
 	return &InventoryServiceAdapter{service: service}
 }
 
-func (a *InventoryServiceAdapter) AddInventoryItem(ctx context.Context, characterID string, itemID int32, quantity int32) (*inventoryV1.InventoryItem, error) {
-	return a.service.AddInventoryItem(ctx, characterID, itemID, quantity)
+func (a *InventoryServiceAdapter) Deliver(ctx context.Context, delivery inventory.Delivery) (*inventory.DeliveryResult, error) {
+	return a.service.Deliver(ctx, delivery)
 }
 
 // CharacterServiceAdapter adapts the character service to our interface
@@ -0,0 +1,253 @@
+package character_actions
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/services/inventory"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const raceUserID = "12345678-9abc-def0-1234-56789abcdef0"
+
+// reservationDB mirrors the AcquireResourceNodeReservation upsert semantics in memory
+type reservationDB struct {
+	mu           sync.Mutex
+	reservations map[int32]db.ResourceNodeReservation
+}
+
+func newReservationDB() *reservationDB {
+	return &reservationDB{reservations: make(map[int32]db.ResourceNodeReservation)}
+}
+
+func (r *reservationDB) GetResourceNode(ctx context.Context, id int32) (db.ResourceNode, error) {
+	return db.ResourceNode{ID: id, ResourceNodeTypeID: 1, WorldID: testWorld, X: 10, Y: 10, Quality: 3}, nil
+}
+
+func (r *reservationDB) GetResourceNodeDrops(ctx context.Context, resourceNodeTypeID int32) ([]db.GetResourceNodeDropsRow, error) {
+	return []db.GetResourceNodeDropsRow{{
+		ItemID:      101,
+		ItemName:    "Wood",
+		Chance:      pgtype.Numeric{Int: big.NewInt(1), Valid: true},
+		MinQuantity: 1,
+		MaxQuantity: 1,
+	}}, nil
+}
+
+func (r *reservationDB) AcquireResourceNodeReservation(ctx context.Context, arg db.AcquireResourceNodeReservationParams) (db.ResourceNodeReservation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, ok := r.reservations[arg.ResourceNodeID]
+	if ok && existing.ExpiresAt.Time.After(arg.Now.Time) && existing.CharacterID != arg.CharacterID {
+		return db.ResourceNodeReservation{}, pgx.ErrNoRows
+	}
+	reservation := db.ResourceNodeReservation{
+		ResourceNodeID: arg.ResourceNodeID,
+		CharacterID:    arg.CharacterID,
+		ReservedAt:     arg.Now,
+		ExpiresAt:      arg.ExpiresAt,
+	}
+	r.reservations[arg.ResourceNodeID] = reservation
+	return reservation, nil
+}
+
+func (r *reservationDB) ReleaseResourceNodeReservation(ctx context.Context, arg db.ReleaseResourceNodeReservationParams) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if existing, ok := r.reservations[arg.ResourceNodeID]; ok && existing.CharacterID == arg.CharacterID {
+		delete(r.reservations, arg.ResourceNodeID)
+	}
+	return nil
+}
+
+// gatedInventory blocks the first harvest inside Deliver until released
+type gatedInventory struct {
+	mu      sync.Mutex
+	entered chan struct{}
+	release chan struct{}
+	grants  map[string]int
+}
+
+func (g *gatedInventory) Deliver(ctx context.Context, delivery inventory.Delivery) (*inventory.DeliveryResult, error) {
+	characterID := hex.EncodeToString(delivery.CharacterID.Bytes[:])
+	g.mu.Lock()
+	g.grants[characterID]++
+	first := len(g.grants) == 1 && g.grants[characterID] == 1
+	g.mu.Unlock()
+
+	if first && g.entered != nil {
+		close(g.entered)
+		<-g.release
+	}
+	result := &inventory.DeliveryResult{Policy: inventory.OverflowReject}
+	for _, grant := range delivery.Grants {
+		result.Grants = append(result.Grants, inventory.DeliveredGrant{Grant: grant, Granted: grant.Quantity})
+	}
+	return result, nil
+}
+
+type characterDirectory struct{}
+
+func (characterDirectory) GetCharacterByID(ctx context.Context, characterID string) (*db.Character, error) {
+	var id [16]byte
+	if _, err := hex.Decode(id[:], []byte(characterID)); err != nil {
+		return nil, err
+	}
+	userID := [16]byte{0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0, 0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0}
+	return &db.Character{
+		ID:      pgtype.UUID{Bytes: id, Valid: true},
+		UserID:  pgtype.UUID{Bytes: userID, Valid: true},
+		WorldID: testWorld,
+		X:       10,
+		Y:       10,
+	}, nil
+}
+
+func newRaceService(store *reservationDB, inventory *gatedInventory) *Service {
+	logger := &MockLogger{}
+	logger.On("With", "component", "character-actions-service").Return(logger)
+	for _, level := range []string{"Debug", "Warn", "Error"} {
+		logger.On(level, mock.Anything, mock.Anything).Return()
+	}
+	return NewService(store, inventory, characterDirectory{}, logger)
+}
+
+func raceCharacterID(i int) string {
+	return fmt.Sprintf("%032x", i+1)
+}
+
+func TestHarvestResource_ConcurrentHarvestersAreAborted(t *testing.T) {
+	const harvesters = 8
+	store := newReservationDB()
+	inventory := &gatedInventory{
+		entered: make(chan struct{}),
+		release: make(chan struct{}),
+		grants:  make(map[string]int),
+	}
+	service := newRaceService(store, inventory)
+	ctx := playerContext()
+
+	// The first harvester parks inside Deliver while holding the reservation
+	winnerErr := make(chan error, 1)
+	go func() {
+		_, _, err := service.HarvestResource(ctx, raceUserID, raceCharacterID(0), 1)
+		winnerErr <- err
+	}()
+	<-inventory.entered
+
+	var wg sync.WaitGroup
+	errs := make([]error, harvesters-1)
+	for i := 1; i < harvesters; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, _, errs[i-1] = service.HarvestResource(ctx, raceUserID, raceCharacterID(i), 1)
+		}(i)
+	}
+	wg.Wait()
+	close(inventory.release)
+	require.NoError(t, <-winnerErr)
+
+	for _, err := range errs {
+		assert.Equal(t, codes.Aborted, status.Code(err))
+		assert.True(t, IsAlreadyReserved(err), "error should carry the ALREADY_RESERVED reason: %v", err)
+	}
+	assert.Equal(t, map[string]int{raceCharacterID(0): 1}, inventory.grants, "only the reservation holder receives items")
+	assert.Empty(t, store.reservations, "reservation is released after the harvest")
+}
+
+func TestHarvestResource_ReservationReleasedAfterHarvest(t *testing.T) {
+	store := newReservationDB()
+	inventory := &gatedInventory{grants: make(map[string]int)}
+	service := newRaceService(store, inventory)
+	ctx := playerContext()
+
+	_, _, err := service.HarvestResource(ctx, raceUserID, raceCharacterID(0), 1)
+	require.NoError(t, err)
+	_, _, err = service.HarvestResource(ctx, raceUserID, raceCharacterID(1), 1)
+	require.NoError(t, err, "sequential harvesters are not blocked")
+}
+
+func TestHarvestResource_ExpiredReservationIsTakenOver(t *testing.T) {
+	store := newReservationDB()
+	service := newRaceService(store, &gatedInventory{grants: make(map[string]int)})
+	fake := clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	service.SetClock(fake)
+	ctx := playerContext()
+
+	// A harvester that crashed mid-harvest leaves its reservation behind
+	crashed, err := characterDirectory{}.GetCharacterByID(ctx, raceCharacterID(7))
+	require.NoError(t, err)
+	require.NoError(t, service.reserveResourceNode(ctx, crashed.ID, 1))
+
+	_, _, err = service.HarvestResource(ctx, raceUserID, raceCharacterID(0), 1)
+	assert.True(t, IsAlreadyReserved(err))
+
+	fake.Advance(harvestReservationTTL)
+	_, _, err = service.HarvestResource(ctx, raceUserID, raceCharacterID(0), 1)
+	assert.NoError(t, err)
+}
+
+// worldSeeds gives each world its seed
+type worldSeeds map[pgtype.UUID]int64
+
+func (w worldSeeds) Seed(ctx context.Context, worldID pgtype.UUID) (int64, error) {
+	seed, ok := w[worldID]
+	if !ok {
+		return 0, pgx.ErrNoRows
+	}
+	return seed, nil
+}
+
+// rangedDropDB drops a random quantity so yields can be compared across harvests
+type rangedDropDB struct{ *reservationDB }
+
+func (r rangedDropDB) GetResourceNodeDrops(ctx context.Context, resourceNodeTypeID int32) ([]db.GetResourceNodeDropsRow, error) {
+	return []db.GetResourceNodeDropsRow{{
+		ItemID:      101,
+		ItemName:    "Wood",
+		Chance:      pgtype.Numeric{Int: big.NewInt(1), Valid: true},
+		MinQuantity: 1,
+		MaxQuantity: 1000,
+	}}, nil
+}
+
+func TestHarvestResource_YieldsAreDeterministic(t *testing.T) {
+	harvest := func(seeds worldSeeds, at time.Time) int32 {
+		logger := &MockLogger{}
+		logger.On("With", "component", "character-actions-service").Return(logger)
+		logger.On("Debug", mock.Anything, mock.Anything).Return()
+		service := NewService(rangedDropDB{newReservationDB()}, &gatedInventory{grants: make(map[string]int)}, characterDirectory{}, logger)
+		service.SetClock(clock.NewFake(at))
+		service.SetWorldSeeds(seeds)
+
+		drops, _, err := service.HarvestResource(playerContext(), raceUserID, raceCharacterID(0), 1)
+		require.NoError(t, err)
+		require.Len(t, drops, 1)
+		return drops[0].Quantity
+	}
+	at := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	seeded := worldSeeds{testWorld: 42}
+	otherWorld := pgtype.UUID{Bytes: [16]byte{2}, Valid: true}
+
+	assert.Equal(t, harvest(seeded, at), harvest(seeded, at), "same seed, node and time replay the same yield")
+	assert.NotEqual(t, harvest(seeded, at), harvest(worldSeeds{testWorld: 43}, at), "the world seed changes the yield")
+	assert.Equal(t, harvest(seeded, at), harvest(worldSeeds{testWorld: 42, otherWorld: 43}, at), "the character's world decides the seed")
+	assert.NotEqual(t, harvest(seeded, at), harvest(seeded, at.Add(time.Second)), "each harvest draws a fresh yield")
+}
@@ -0,0 +1,128 @@
+package character_actions
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	"github.com/VoidMesh/api/api/services/chunk"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxTerrainEditDistance is how far from the character a cell can be modified (the
+// character's own cell and its eight neighbours)
+const maxTerrainEditDistance = 1
+
+// terrainTransitions lists the edits a character can make to each terrain type.
+// Stone is not editable.
+var terrainTransitions = map[chunkV1.TerrainType][]chunkV1.TerrainType{
+	chunkV1.TerrainType_TERRAIN_TYPE_GRASS: {chunkV1.TerrainType_TERRAIN_TYPE_DIRT},  // till
+	chunkV1.TerrainType_TERRAIN_TYPE_DIRT:  {chunkV1.TerrainType_TERRAIN_TYPE_GRASS}, // re-seed
+	chunkV1.TerrainType_TERRAIN_TYPE_SAND:  {chunkV1.TerrainType_TERRAIN_TYPE_WATER}, // dig
+	chunkV1.TerrainType_TERRAIN_TYPE_WATER: {chunkV1.TerrainType_TERRAIN_TYPE_SAND},  // fill
+}
+
+// SetChunkService enables terrain modification and line of sight checks for harvesting
+func (s *Service) SetChunkService(chunkService ChunkServiceInterface) {
+	s.chunkService = chunkService
+}
+
+// ModifyTerrain changes a cell next to the character and returns the terrain it replaced.
+// The edit is stored as a chunk delta and published to nearby players.
+func (s *Service) ModifyTerrain(ctx context.Context, userID, characterID string, x, y int32, terrainType chunkV1.TerrainType) (chunkV1.TerrainType, error) {
+	s.logger.Debug("Modifying terrain", "user_id", userID, "character_id", characterID, "x", x, "y", y, "terrain_type", terrainType.String())
+
+	if s.chunkService == nil {
+		return chunkV1.TerrainType_TERRAIN_TYPE_UNSPECIFIED, status.Errorf(codes.Unimplemented, "terrain modification is not enabled")
+	}
+
+	if !uuid.ValidateFormat(characterID) {
+		s.logger.Warn("Invalid character ID format", "character_id", characterID)
+		return chunkV1.TerrainType_TERRAIN_TYPE_UNSPECIFIED, status.Errorf(codes.InvalidArgument, "invalid character ID format")
+	}
+
+	character, err := s.characterService.GetCharacterByID(ctx, characterID)
+	if err != nil {
+		s.logger.Error("Failed to get character", "character_id", characterID, "error", err)
+		return chunkV1.TerrainType_TERRAIN_TYPE_UNSPECIFIED, status.Errorf(codes.NotFound, "character not found")
+	}
+	if err := s.validateCharacterOwnership(character, userID); err != nil {
+		return chunkV1.TerrainType_TERRAIN_TYPE_UNSPECIFIED, err
+	}
+	if err := session.RequireWorld(ctx, character.WorldID); err != nil {
+		return chunkV1.TerrainType_TERRAIN_TYPE_UNSPECIFIED, err
+	}
+
+	if s.dungeons != nil {
+		// Dungeon maps are generated from their seed and cannot be edited; the character's
+		// world position is not where it stands
+		position, err := s.dungeons.Locate(ctx, character.ID)
+		if err != nil {
+			s.logger.Error("Failed to locate character in dungeons", "character_id", characterID, "error", err)
+			return chunkV1.TerrainType_TERRAIN_TYPE_UNSPECIFIED, status.Errorf(codes.Internal, "failed to locate character")
+		}
+		if position != nil {
+			return chunkV1.TerrainType_TERRAIN_TYPE_UNSPECIFIED, status.Errorf(codes.FailedPrecondition, "terrain cannot be modified inside a dungeon")
+		}
+	}
+
+	if !geometry.WithinSquare(geometry.Point{X: character.X, Y: character.Y}, geometry.Point{X: x, Y: y}, maxTerrainEditDistance) {
+		return chunkV1.TerrainType_TERRAIN_TYPE_UNSPECIFIED, status.Errorf(codes.FailedPrecondition, "character is too far from the cell")
+	}
+	if terrainType == chunkV1.TerrainType_TERRAIN_TYPE_WATER && x == character.X && y == character.Y {
+		return chunkV1.TerrainType_TERRAIN_TYPE_UNSPECIFIED, status.Errorf(codes.FailedPrecondition, "cannot turn the cell the character stands on into water")
+	}
+	if s.regionService != nil {
+		if err := s.regionService.CheckAllowed(ctx, character.WorldID, x, y, chunkV1.RegionFlag_REGION_FLAG_NO_BUILD); err != nil {
+			return chunkV1.TerrainType_TERRAIN_TYPE_UNSPECIFIED, err
+		}
+	}
+	if s.claimService != nil {
+		chunkX, chunkY, _ := geometry.CellLocation(geometry.Point{X: x, Y: y})
+		if err := s.claimService.CheckAllowed(ctx, character.WorldID, chunkX, chunkY, character.ID); err != nil {
+			return chunkV1.TerrainType_TERRAIN_TYPE_UNSPECIFIED, err
+		}
+	}
+
+	previous, err := s.chunkService.GetCell(ctx, character.WorldID, x, y)
+	if err != nil {
+		s.logger.Error("Failed to get terrain cell", "x", x, "y", y, "error", err)
+		return chunkV1.TerrainType_TERRAIN_TYPE_UNSPECIFIED, status.Errorf(codes.Internal, "failed to get terrain")
+	}
+	if !canTransition(previous, terrainType) {
+		return chunkV1.TerrainType_TERRAIN_TYPE_UNSPECIFIED, status.Errorf(codes.FailedPrecondition,
+			"cannot change %s to %s", previous.String(), terrainType.String())
+	}
+
+	if err := s.chunkService.ModifyCell(ctx, chunk.CellEdit{
+		WorldID:             character.WorldID,
+		X:                   x,
+		Y:                   y,
+		TerrainType:         terrainType,
+		PreviousTerrainType: previous,
+		CharacterID:         character.ID,
+	}); err != nil {
+		s.logger.Error("Failed to modify terrain cell", "x", x, "y", y, "error", err)
+		return chunkV1.TerrainType_TERRAIN_TYPE_UNSPECIFIED, status.Errorf(codes.Internal, "failed to modify terrain")
+	}
+
+	s.logger.Debug("Modified terrain",
+		"character_id", characterID,
+		"x", x,
+		"y", y,
+		"previous_terrain_type", previous.String(),
+		"terrain_type", terrainType.String())
+	return previous, nil
+}
+
+func canTransition(from, to chunkV1.TerrainType) bool {
+	for _, allowed := range terrainTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
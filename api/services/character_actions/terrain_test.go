@@ -0,0 +1,188 @@
+package character_actions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/chunkdata"
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	"github.com/VoidMesh/api/api/services/chunk"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeChunkService stores terrain per cell, defaulting to grass
+type fakeChunkService struct {
+	cells map[[2]int32]chunkV1.TerrainType
+	edits []chunk.CellEdit
+}
+
+func (f *fakeChunkService) GetCell(ctx context.Context, worldID pgtype.UUID, x, y int32) (chunkV1.TerrainType, error) {
+	if terrain, ok := f.cells[[2]int32{x, y}]; ok {
+		return terrain, nil
+	}
+	return chunkV1.TerrainType_TERRAIN_TYPE_GRASS, nil
+}
+
+func (f *fakeChunkService) LineOfSight(ctx context.Context, worldID pgtype.UUID, from, to geometry.Point) (bool, error) {
+	return geometry.LineOfSight(from, to, func(p geometry.Point) bool {
+		return chunkdata.BlocksSight(f.cells[[2]int32{p.X, p.Y}])
+	}), nil
+}
+
+func (f *fakeChunkService) ModifyCell(ctx context.Context, edit chunk.CellEdit) error {
+	f.cells[[2]int32{edit.X, edit.Y}] = edit.TerrainType
+	f.edits = append(f.edits, edit)
+	return nil
+}
+
+// fakeRegions protects a single cell with the given flag
+type fakeRegions struct {
+	x, y int32
+	flag chunkV1.RegionFlag
+}
+
+func (f fakeRegions) CheckAllowed(ctx context.Context, worldID pgtype.UUID, x, y int32, flag chunkV1.RegionFlag) error {
+	if x == f.x && y == f.y && flag == f.flag {
+		return status.Errorf(codes.FailedPrecondition, "not allowed in Spawn")
+	}
+	return nil
+}
+
+// fakeClaims gives one chunk to an owner
+type fakeClaims struct {
+	chunkX, chunkY int32
+	owner          pgtype.UUID
+}
+
+func (f fakeClaims) CheckAllowed(ctx context.Context, worldID pgtype.UUID, chunkX, chunkY int32, characterID pgtype.UUID) error {
+	if chunkX == f.chunkX && chunkY == f.chunkY && characterID != f.owner {
+		return status.Errorf(codes.FailedPrecondition, "chunk is claimed by another character")
+	}
+	return nil
+}
+
+// terrainWorldID is the world of the character newTerrainTestService returns
+const terrainWorldID = "650e8400-e29b-41d4-a716-446655440000"
+
+func newTerrainTestService(t *testing.T, userID, characterID string) (*Service, *fakeChunkService) {
+	userUUID, err := uuid.StringToPgtype(userID)
+	require.NoError(t, err)
+	charUUID, err := uuid.StringToPgtype(characterID)
+	require.NoError(t, err)
+	worldUUID, err := uuid.StringToPgtype(terrainWorldID)
+	require.NoError(t, err)
+
+	mockCharacter := &MockCharacterService{}
+	mockCharacter.On("GetCharacterByID", mock.Anything, characterID).
+		Return(&db.Character{ID: charUUID, UserID: userUUID, WorldID: worldUUID, X: 10, Y: 10}, nil)
+	mockLogger := &MockLogger{}
+	mockLogger.On("With", "component", "character-actions-service").Return(mockLogger)
+	mockLogger.On("Debug", mock.AnythingOfType("string"), mock.Anything).Return()
+	mockLogger.On("Warn", mock.AnythingOfType("string"), mock.Anything).Return()
+
+	chunks := &fakeChunkService{cells: map[[2]int32]chunkV1.TerrainType{
+		{11, 10}: chunkV1.TerrainType_TERRAIN_TYPE_SAND,
+		{9, 9}:   chunkV1.TerrainType_TERRAIN_TYPE_STONE,
+	}}
+	service := NewService(&MockDatabase{}, &MockInventoryService{}, mockCharacter, mockLogger)
+	service.SetChunkService(chunks)
+	return service, chunks
+}
+
+func TestService_ModifyTerrain(t *testing.T) {
+	const userID = "12345678-9abc-def0-1234-56789abcdef0"
+	const characterID = "550e8400-e29b-41d4-a716-446655440000"
+	service, chunks := newTerrainTestService(t, userID, characterID)
+	ctx := session.WithWorldID(context.Background(), terrainWorldID)
+
+	previous, err := service.ModifyTerrain(ctx, userID, characterID, 10, 11, chunkV1.TerrainType_TERRAIN_TYPE_DIRT)
+	require.NoError(t, err)
+	assert.Equal(t, chunkV1.TerrainType_TERRAIN_TYPE_GRASS, previous)
+
+	previous, err = service.ModifyTerrain(ctx, userID, characterID, 11, 10, chunkV1.TerrainType_TERRAIN_TYPE_WATER)
+	require.NoError(t, err)
+	assert.Equal(t, chunkV1.TerrainType_TERRAIN_TYPE_SAND, previous)
+
+	require.Len(t, chunks.edits, 2)
+	assert.Equal(t, chunkV1.TerrainType_TERRAIN_TYPE_GRASS, chunks.edits[0].PreviousTerrainType)
+	assert.True(t, uuid.Compare(characterID, uuid.PgtypeToString(chunks.edits[0].CharacterID)))
+	assert.Equal(t, terrainWorldID, uuid.PgtypeToString(chunks.edits[0].WorldID), "edits land in the character's world")
+}
+
+func TestService_ModifyTerrain_Rejected(t *testing.T) {
+	const userID = "12345678-9abc-def0-1234-56789abcdef0"
+	const characterID = "550e8400-e29b-41d4-a716-446655440000"
+	service, chunks := newTerrainTestService(t, userID, characterID)
+	ctx := session.WithWorldID(context.Background(), terrainWorldID)
+
+	tests := []struct {
+		name        string
+		userID      string
+		characterID string
+		x, y        int32
+		terrainType chunkV1.TerrainType
+		code        codes.Code
+	}{
+		{"invalid character ID", userID, "invalid", 10, 10, chunkV1.TerrainType_TERRAIN_TYPE_DIRT, codes.InvalidArgument},
+		{"not the owner", "87654321-9abc-def0-1234-56789abcdef0", characterID, 10, 10, chunkV1.TerrainType_TERRAIN_TYPE_DIRT, codes.PermissionDenied},
+		{"out of range", userID, characterID, 12, 10, chunkV1.TerrainType_TERRAIN_TYPE_DIRT, codes.FailedPrecondition},
+		{"stone is immutable", userID, characterID, 9, 9, chunkV1.TerrainType_TERRAIN_TYPE_DIRT, codes.FailedPrecondition},
+		{"grass cannot become water", userID, characterID, 10, 9, chunkV1.TerrainType_TERRAIN_TYPE_WATER, codes.FailedPrecondition},
+		{"own cell cannot become water", userID, characterID, 10, 10, chunkV1.TerrainType_TERRAIN_TYPE_WATER, codes.FailedPrecondition},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.ModifyTerrain(ctx, tt.userID, tt.characterID, tt.x, tt.y, tt.terrainType)
+			assert.Equal(t, tt.code, status.Code(err))
+		})
+	}
+	assert.Empty(t, chunks.edits)
+
+	service.SetChunkService(nil)
+	_, err := service.ModifyTerrain(ctx, userID, characterID, 10, 11, chunkV1.TerrainType_TERRAIN_TYPE_DIRT)
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestService_ModifyTerrain_ProtectedRegion(t *testing.T) {
+	const userID = "12345678-9abc-def0-1234-56789abcdef0"
+	const characterID = "550e8400-e29b-41d4-a716-446655440000"
+	service, chunks := newTerrainTestService(t, userID, characterID)
+	service.SetRegionService(fakeRegions{x: 10, y: 11, flag: chunkV1.RegionFlag_REGION_FLAG_NO_BUILD})
+	ctx := session.WithWorldID(context.Background(), terrainWorldID)
+
+	_, err := service.ModifyTerrain(ctx, userID, characterID, 10, 11, chunkV1.TerrainType_TERRAIN_TYPE_DIRT)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Empty(t, chunks.edits)
+
+	_, err = service.ModifyTerrain(ctx, userID, characterID, 11, 11, chunkV1.TerrainType_TERRAIN_TYPE_DIRT)
+	require.NoError(t, err)
+	assert.Len(t, chunks.edits, 1)
+}
+
+func TestService_ModifyTerrain_LandClaim(t *testing.T) {
+	const userID = "12345678-9abc-def0-1234-56789abcdef0"
+	const characterID = "550e8400-e29b-41d4-a716-446655440000"
+	service, chunks := newTerrainTestService(t, userID, characterID)
+	ctx := session.WithWorldID(context.Background(), terrainWorldID)
+
+	// The character stands at (10, 10), in chunk (0, 0)
+	service.SetClaimService(fakeClaims{chunkX: 0, chunkY: 0})
+	_, err := service.ModifyTerrain(ctx, userID, characterID, 10, 11, chunkV1.TerrainType_TERRAIN_TYPE_DIRT)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Empty(t, chunks.edits)
+
+	owner, err := uuid.StringToPgtype(characterID)
+	require.NoError(t, err)
+	service.SetClaimService(fakeClaims{chunkX: 0, chunkY: 0, owner: owner})
+	_, err = service.ModifyTerrain(ctx, userID, characterID, 10, 11, chunkV1.TerrainType_TERRAIN_TYPE_DIRT)
+	require.NoError(t, err)
+}
@@ -0,0 +1,328 @@
+// Package checkpoint records periodic snapshots of character state (position and
+// inventory) and restores characters to them for support.
+package checkpoint
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/alerting"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	ReasonPeriodic   = "periodic"    // Written by the checkpoint job
+	ReasonPreRestore = "pre_restore" // State replaced by a restore, so the restore can be undone
+
+	DefaultListLimit = 20
+	MaxListLimit     = 200
+
+	// characterBatchSize is how many characters the job loads per page
+	characterBatchSize = 500
+)
+
+// Config controls the checkpoint job
+type Config struct {
+	Interval  time.Duration // Time between checkpoint passes
+	Retention time.Duration // Checkpoints older than this are deleted
+}
+
+// DefaultConfig checkpoints every 15 minutes and keeps 30 days of history
+func DefaultConfig() Config {
+	return Config{
+		Interval:  15 * time.Minute,
+		Retention: 30 * 24 * time.Hour,
+	}
+}
+
+// Item is an inventory entry as stored in a checkpoint
+type Item struct {
+	ItemID   int32 `json:"item_id"`
+	Quantity int32 `json:"quantity"`
+}
+
+// Service records and restores character checkpoints.
+type Service struct {
+	db        DatabaseInterface
+	logger    LoggerInterface
+	clock     clock.Clock
+	positions PositionBuffer
+}
+
+// NewService creates a new checkpoint service with dependency injection.
+func NewService(db DatabaseInterface, logger LoggerInterface) *Service {
+	componentLogger := logger.With("component", "checkpoint-service")
+	componentLogger.Debug("Creating new checkpoint service")
+	return &Service{
+		db:     db,
+		logger: componentLogger,
+		clock:  clock.New(),
+	}
+}
+
+// NewServiceWithPool creates a service with concrete implementations (convenience constructor for production use).
+func NewServiceWithPool(pool storage.Pool) *Service {
+	return NewService(NewDatabaseWrapper(pool), NewDefaultLoggerWrapper())
+}
+
+// SetClock replaces the clock used for timestamps (for simulation tests)
+func (s *Service) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// SetPositionBuffer makes restores store a character's buffered moves before reading
+// it and drop them afterwards, so a later flush can't undo the restore
+func (s *Service) SetPositionBuffer(positions PositionBuffer) {
+	s.positions = positions
+}
+
+// Checkpoint snapshots a character. Periodic checkpoints are skipped when the position
+// and inventory match the latest checkpoint; the bool reports whether one was written.
+func (s *Service) Checkpoint(ctx context.Context, character db.Character, reason string) (db.CharacterCheckpoint, bool, error) {
+	rows, err := s.db.GetCharacterInventory(ctx, character.ID)
+	if err != nil {
+		return db.CharacterCheckpoint{}, false, fmt.Errorf("failed to get inventory: %w", err)
+	}
+	items := make([]Item, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, Item{ItemID: row.ItemID, Quantity: row.Quantity})
+	}
+	inventory, hash, err := encodeInventory(items)
+	if err != nil {
+		return db.CharacterCheckpoint{}, false, err
+	}
+
+	if reason == ReasonPeriodic {
+		latest, err := s.db.GetLatestCharacterCheckpoint(ctx, character.ID)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return db.CharacterCheckpoint{}, false, fmt.Errorf("failed to get latest checkpoint: %w", err)
+		}
+		if err == nil && latest.InventoryHash == hash && latest.X == character.X && latest.Y == character.Y {
+			return latest, false, nil
+		}
+	}
+
+	checkpoint, err := s.db.CreateCharacterCheckpoint(ctx, db.CreateCharacterCheckpointParams{
+		CharacterID:   character.ID,
+		X:             character.X,
+		Y:             character.Y,
+		ChunkX:        character.ChunkX,
+		ChunkY:        character.ChunkY,
+		Inventory:     inventory,
+		InventoryHash: hash,
+		Reason:        reason,
+		CreatedAt:     pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
+	})
+	if err != nil {
+		return db.CharacterCheckpoint{}, false, fmt.Errorf("failed to store checkpoint: %w", err)
+	}
+	return checkpoint, true, nil
+}
+
+// CheckpointAll snapshots every character whose state changed since its latest
+// checkpoint and returns how many checkpoints were written
+func (s *Service) CheckpointAll(ctx context.Context) (int, error) {
+	written := 0
+	after := pgtype.UUID{Valid: true} // Sorts before every generated ID
+	for {
+		characters, err := s.db.ListCharactersAfter(ctx, db.ListCharactersAfterParams{ID: after, Limit: characterBatchSize})
+		if err != nil {
+			return written, fmt.Errorf("failed to list characters: %w", err)
+		}
+
+		for _, character := range characters {
+			_, created, err := s.Checkpoint(ctx, character, ReasonPeriodic)
+			if err != nil {
+				s.logger.Warn("Failed to checkpoint character", "character_id", uuid.PgtypeToString(character.ID), "error", err)
+				continue
+			}
+			if created {
+				written++
+			}
+		}
+
+		if len(characters) < characterBatchSize {
+			return written, nil
+		}
+		after = characters[len(characters)-1].ID
+	}
+}
+
+// Prune deletes checkpoints older than the retention period
+func (s *Service) Prune(ctx context.Context, retention time.Duration) (int64, error) {
+	deleted, err := s.db.DeleteCharacterCheckpointsBefore(ctx, pgtype.Timestamp{Time: s.clock.Now().Add(-retention), Valid: true})
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune checkpoints: %w", err)
+	}
+	return deleted, nil
+}
+
+// Run checkpoints all characters and prunes old checkpoints every config.Interval
+// until ctx is cancelled
+func (s *Service) Run(ctx context.Context, config Config) {
+	s.logger.Info("Character checkpoint job started", "interval", config.Interval, "retention", config.Retention)
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("Character checkpoint job stopped")
+			return
+		case <-s.clock.After(config.Interval):
+		}
+
+		written, err := s.CheckpointAll(ctx)
+		if err != nil {
+			s.logger.Error("Character checkpoint pass failed", "error", err)
+			alerting.ReportJobError("character_checkpoint", err)
+		}
+		pruned, err := s.Prune(ctx, config.Retention)
+		if err != nil {
+			s.logger.Error("Character checkpoint pruning failed", "error", err)
+			alerting.ReportJobError("character_checkpoint", err)
+		}
+		if written > 0 || pruned > 0 {
+			s.logger.Debug("Character checkpoint pass complete", "written", written, "pruned", pruned)
+		}
+	}
+}
+
+// ListCheckpoints returns a character's checkpoints, newest first
+func (s *Service) ListCheckpoints(ctx context.Context, characterID string, limit int32) ([]*characterV1.CharacterCheckpoint, error) {
+	id, err := uuid.StringToPgtype(characterID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid character ID format")
+	}
+	if limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must not be negative")
+	}
+	if limit == 0 {
+		limit = DefaultListLimit
+	}
+	if limit > MaxListLimit {
+		limit = MaxListLimit
+	}
+
+	rows, err := s.db.ListCharacterCheckpoints(ctx, db.ListCharacterCheckpointsParams{CharacterID: id, Limit: limit})
+	if err != nil {
+		s.logger.Error("Failed to list checkpoints", "character_id", characterID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list checkpoints")
+	}
+
+	checkpoints := make([]*characterV1.CharacterCheckpoint, 0, len(rows))
+	for _, row := range rows {
+		checkpoint, err := dbCheckpointToProto(row)
+		if err != nil {
+			s.logger.Error("Failed to decode checkpoint", "checkpoint_id", row.ID, "error", err)
+			return nil, status.Errorf(codes.Internal, "failed to decode checkpoint")
+		}
+		checkpoints = append(checkpoints, checkpoint)
+	}
+	return checkpoints, nil
+}
+
+// Restore resets a character's position and inventory to a checkpoint. The current
+// state is checkpointed first; its ID is returned so the restore can be reverted.
+func (s *Service) Restore(ctx context.Context, checkpointID int64) (*characterV1.CharacterCheckpoint, int64, error) {
+	checkpoint, err := s.db.GetCharacterCheckpoint(ctx, checkpointID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, 0, status.Errorf(codes.NotFound, "checkpoint not found")
+	}
+	if err != nil {
+		s.logger.Error("Failed to get checkpoint", "checkpoint_id", checkpointID, "error", err)
+		return nil, 0, status.Errorf(codes.Internal, "failed to get checkpoint")
+	}
+
+	if s.positions != nil {
+		if err := s.positions.FlushCharacter(ctx, checkpoint.CharacterID); err != nil {
+			s.logger.Error("Failed to store buffered position", "checkpoint_id", checkpointID, "error", err)
+			return nil, 0, status.Errorf(codes.Internal, "failed to store current position")
+		}
+	}
+
+	character, err := s.db.GetCharacterById(ctx, checkpoint.CharacterID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, 0, status.Errorf(codes.NotFound, "character not found")
+	}
+	if err != nil {
+		s.logger.Error("Failed to get character", "checkpoint_id", checkpointID, "error", err)
+		return nil, 0, status.Errorf(codes.Internal, "failed to get character")
+	}
+
+	var items []Item
+	if err := json.Unmarshal(checkpoint.Inventory, &items); err != nil {
+		s.logger.Error("Failed to decode checkpoint inventory", "checkpoint_id", checkpointID, "error", err)
+		return nil, 0, status.Errorf(codes.Internal, "failed to decode checkpoint")
+	}
+
+	preRestore, _, err := s.Checkpoint(ctx, character, ReasonPreRestore)
+	if err != nil {
+		s.logger.Error("Failed to checkpoint state before restore", "checkpoint_id", checkpointID, "error", err)
+		return nil, 0, status.Errorf(codes.Internal, "failed to checkpoint current state")
+	}
+
+	if err := s.db.RestoreCharacter(ctx, checkpoint, items); err != nil {
+		s.logger.Error("Failed to restore character", "checkpoint_id", checkpointID, "error", err)
+		return nil, 0, status.Errorf(codes.Internal, "failed to restore character")
+	}
+	if s.positions != nil {
+		s.positions.Forget(checkpoint.CharacterID)
+	}
+
+	restored, err := dbCheckpointToProto(checkpoint)
+	if err != nil {
+		return nil, 0, status.Errorf(codes.Internal, "failed to decode checkpoint")
+	}
+	s.logger.Info("Restored character from checkpoint",
+		"character_id", restored.CharacterId,
+		"checkpoint_id", checkpointID,
+		"pre_restore_checkpoint_id", preRestore.ID)
+	return restored, preRestore.ID, nil
+}
+
+// encodeInventory returns the canonical JSON for items (sorted by item ID) and its SHA-256
+func encodeInventory(items []Item) ([]byte, string, error) {
+	sort.Slice(items, func(i, j int) bool { return items[i].ItemID < items[j].ItemID })
+	data, err := json.Marshal(items)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to encode inventory: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return data, hex.EncodeToString(sum[:]), nil
+}
+
+func dbCheckpointToProto(row db.CharacterCheckpoint) (*characterV1.CharacterCheckpoint, error) {
+	var items []Item
+	if err := json.Unmarshal(row.Inventory, &items); err != nil {
+		return nil, err
+	}
+	inventory := make([]*characterV1.CheckpointItem, 0, len(items))
+	for _, item := range items {
+		inventory = append(inventory, &characterV1.CheckpointItem{ItemId: item.ItemID, Quantity: item.Quantity})
+	}
+	return &characterV1.CharacterCheckpoint{
+		Id:            row.ID,
+		CharacterId:   uuid.PgtypeToString(row.CharacterID),
+		X:             row.X,
+		Y:             row.Y,
+		ChunkX:        row.ChunkX,
+		ChunkY:        row.ChunkY,
+		Inventory:     inventory,
+		InventoryHash: row.InventoryHash,
+		Reason:        row.Reason,
+		CreatedAt:     timestamppb.New(row.CreatedAt.Time),
+	}, nil
+}
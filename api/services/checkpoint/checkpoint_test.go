@@ -0,0 +1,246 @@
+package checkpoint
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeDB keeps characters, inventories and checkpoints in memory
+type fakeDB struct {
+	testmocks.Characters
+	inventories map[pgtype.UUID][]Item
+	checkpoints []db.CharacterCheckpoint
+}
+
+func newFakeDB() *fakeDB {
+	return &fakeDB{
+		Characters:  testmocks.Characters{},
+		inventories: make(map[pgtype.UUID][]Item),
+	}
+}
+
+func (f *fakeDB) ListCharactersAfter(ctx context.Context, arg db.ListCharactersAfterParams) ([]db.Character, error) {
+	var characters []db.Character
+	for _, character := range f.Characters {
+		if bytes.Compare(character.ID.Bytes[:], arg.ID.Bytes[:]) > 0 {
+			characters = append(characters, character)
+		}
+	}
+	sort.Slice(characters, func(i, j int) bool {
+		return bytes.Compare(characters[i].ID.Bytes[:], characters[j].ID.Bytes[:]) < 0
+	})
+	if len(characters) > int(arg.Limit) {
+		characters = characters[:arg.Limit]
+	}
+	return characters, nil
+}
+
+func (f *fakeDB) GetCharacterInventory(ctx context.Context, characterID pgtype.UUID) ([]db.GetCharacterInventoryRow, error) {
+	var rows []db.GetCharacterInventoryRow
+	for _, item := range f.inventories[characterID] {
+		rows = append(rows, db.GetCharacterInventoryRow{CharacterID: characterID, ItemID: item.ItemID, Quantity: item.Quantity})
+	}
+	return rows, nil
+}
+
+func (f *fakeDB) CreateCharacterCheckpoint(ctx context.Context, arg db.CreateCharacterCheckpointParams) (db.CharacterCheckpoint, error) {
+	checkpoint := db.CharacterCheckpoint{
+		ID:            int64(len(f.checkpoints) + 1),
+		CharacterID:   arg.CharacterID,
+		X:             arg.X,
+		Y:             arg.Y,
+		ChunkX:        arg.ChunkX,
+		ChunkY:        arg.ChunkY,
+		Inventory:     arg.Inventory,
+		InventoryHash: arg.InventoryHash,
+		Reason:        arg.Reason,
+		CreatedAt:     arg.CreatedAt,
+	}
+	f.checkpoints = append(f.checkpoints, checkpoint)
+	return checkpoint, nil
+}
+
+func (f *fakeDB) GetCharacterCheckpoint(ctx context.Context, id int64) (db.CharacterCheckpoint, error) {
+	for _, checkpoint := range f.checkpoints {
+		if checkpoint.ID == id {
+			return checkpoint, nil
+		}
+	}
+	return db.CharacterCheckpoint{}, pgx.ErrNoRows
+}
+
+func (f *fakeDB) GetLatestCharacterCheckpoint(ctx context.Context, characterID pgtype.UUID) (db.CharacterCheckpoint, error) {
+	for i := len(f.checkpoints) - 1; i >= 0; i-- {
+		if f.checkpoints[i].CharacterID == characterID {
+			return f.checkpoints[i], nil
+		}
+	}
+	return db.CharacterCheckpoint{}, pgx.ErrNoRows
+}
+
+func (f *fakeDB) ListCharacterCheckpoints(ctx context.Context, arg db.ListCharacterCheckpointsParams) ([]db.CharacterCheckpoint, error) {
+	var checkpoints []db.CharacterCheckpoint
+	for i := len(f.checkpoints) - 1; i >= 0 && len(checkpoints) < int(arg.Limit); i-- {
+		if f.checkpoints[i].CharacterID == arg.CharacterID {
+			checkpoints = append(checkpoints, f.checkpoints[i])
+		}
+	}
+	return checkpoints, nil
+}
+
+func (f *fakeDB) DeleteCharacterCheckpointsBefore(ctx context.Context, createdAt pgtype.Timestamp) (int64, error) {
+	kept := f.checkpoints[:0]
+	for _, checkpoint := range f.checkpoints {
+		if !checkpoint.CreatedAt.Time.Before(createdAt.Time) {
+			kept = append(kept, checkpoint)
+		}
+	}
+	deleted := int64(len(f.checkpoints) - len(kept))
+	f.checkpoints = kept
+	return deleted, nil
+}
+
+func (f *fakeDB) RestoreCharacter(ctx context.Context, checkpoint db.CharacterCheckpoint, inventory []Item) error {
+	character := f.Characters[checkpoint.CharacterID]
+	character.X, character.Y = checkpoint.X, checkpoint.Y
+	character.ChunkX, character.ChunkY = checkpoint.ChunkX, checkpoint.ChunkY
+	f.Characters[checkpoint.CharacterID] = character
+	f.inventories[checkpoint.CharacterID] = append([]Item(nil), inventory...)
+	return nil
+}
+
+func testCharacterID(b byte) pgtype.UUID {
+	return pgtype.UUID{Bytes: [16]byte{15: b}, Valid: true}
+}
+
+func newTestService(database *fakeDB) (*Service, *clock.Fake) {
+	service := NewService(database, testmocks.NopLogger[LoggerInterface]{})
+	fake := clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	service.SetClock(fake)
+	return service, fake
+}
+
+func TestCheckpointAll_SkipsUnchangedCharacters(t *testing.T) {
+	database := newFakeDB()
+	a, b := testCharacterID(1), testCharacterID(2)
+	database.Characters[a] = db.Character{ID: a, X: 1, Y: 1}
+	database.Characters[b] = db.Character{ID: b, X: 5, Y: 5}
+	database.inventories[a] = []Item{{ItemID: 2, Quantity: 1}, {ItemID: 1, Quantity: 3}}
+	service, _ := newTestService(database)
+	ctx := context.Background()
+
+	written, err := service.CheckpointAll(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, written)
+
+	written, err = service.CheckpointAll(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, written, "nothing changed")
+
+	// Reordered rows hash the same; a quantity change does not
+	database.inventories[a] = []Item{{ItemID: 1, Quantity: 3}, {ItemID: 2, Quantity: 1}}
+	written, err = service.CheckpointAll(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, written)
+
+	database.inventories[a] = []Item{{ItemID: 1, Quantity: 2}, {ItemID: 2, Quantity: 1}}
+	written, err = service.CheckpointAll(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, written)
+}
+
+func TestRestore(t *testing.T) {
+	database := newFakeDB()
+	id := testCharacterID(1)
+	database.Characters[id] = db.Character{ID: id, X: 3, Y: 4}
+	database.inventories[id] = []Item{{ItemID: 7, Quantity: 10}}
+	service, _ := newTestService(database)
+	ctx := context.Background()
+
+	saved, _, err := service.Checkpoint(ctx, database.Characters[id], ReasonPeriodic)
+	require.NoError(t, err)
+
+	// The character loses its items and gets stuck somewhere
+	database.Characters[id] = db.Character{ID: id, X: 900, Y: -900, ChunkX: 28, ChunkY: -29}
+	database.inventories[id] = nil
+
+	restored, preRestoreID, err := service.Restore(ctx, saved.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), restored.X)
+	require.Len(t, restored.Inventory, 1)
+	assert.Equal(t, int32(10), restored.Inventory[0].Quantity)
+
+	assert.Equal(t, int32(3), database.Characters[id].X)
+	assert.Equal(t, []Item{{ItemID: 7, Quantity: 10}}, database.inventories[id])
+
+	preRestore, err := database.GetCharacterCheckpoint(ctx, preRestoreID)
+	require.NoError(t, err)
+	assert.Equal(t, ReasonPreRestore, preRestore.Reason)
+	assert.Equal(t, int32(900), preRestore.X, "the replaced state is kept so the restore can be undone")
+
+	_, _, err = service.Restore(ctx, 999)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestListCheckpoints(t *testing.T) {
+	database := newFakeDB()
+	id := testCharacterID(1)
+	service, _ := newTestService(database)
+	ctx := context.Background()
+
+	for x := int32(0); x < 3; x++ {
+		_, _, err := service.Checkpoint(ctx, db.Character{ID: id, X: x}, ReasonPeriodic)
+		require.NoError(t, err)
+	}
+
+	checkpoints, err := service.ListCheckpoints(ctx, "00000000-0000-0000-0000-000000000001", 2)
+	require.NoError(t, err)
+	require.Len(t, checkpoints, 2)
+	assert.Equal(t, int32(2), checkpoints[0].X, "newest first")
+
+	_, err = service.ListCheckpoints(ctx, "not-a-uuid", 0)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = service.ListCheckpoints(ctx, "00000000-0000-0000-0000-000000000001", -1)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestRun_CheckpointsAndPrunes(t *testing.T) {
+	database := newFakeDB()
+	id := testCharacterID(1)
+	database.Characters[id] = db.Character{ID: id}
+	service, fake := newTestService(database)
+	config := Config{Interval: time.Minute, Retention: time.Hour}
+
+	// An old checkpoint from a previous position is past retention
+	_, _, err := service.Checkpoint(context.Background(), db.Character{ID: id, X: 50}, ReasonPeriodic)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		service.Run(ctx, config)
+		close(done)
+	}()
+
+	require.Eventually(t, func() bool { return fake.Waiters() == 1 }, time.Second, time.Millisecond)
+	fake.Advance(time.Hour + time.Minute)
+	require.Eventually(t, func() bool { return fake.Waiters() == 1 }, time.Second, time.Millisecond)
+	cancel()
+	<-done
+
+	require.Len(t, database.checkpoints, 1)
+	assert.Equal(t, int32(0), database.checkpoints[0].X)
+}
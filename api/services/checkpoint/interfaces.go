@@ -0,0 +1,150 @@
+package checkpoint
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/txn"
+	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
+	"github.com/charmbracelet/log"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DatabaseInterface abstracts database operations for character checkpoints.
+type DatabaseInterface interface {
+	GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error)
+	ListCharactersAfter(ctx context.Context, arg db.ListCharactersAfterParams) ([]db.Character, error)
+	GetCharacterInventory(ctx context.Context, characterID pgtype.UUID) ([]db.GetCharacterInventoryRow, error)
+	CreateCharacterCheckpoint(ctx context.Context, arg db.CreateCharacterCheckpointParams) (db.CharacterCheckpoint, error)
+	GetCharacterCheckpoint(ctx context.Context, id int64) (db.CharacterCheckpoint, error)
+	GetLatestCharacterCheckpoint(ctx context.Context, characterID pgtype.UUID) (db.CharacterCheckpoint, error)
+	ListCharacterCheckpoints(ctx context.Context, arg db.ListCharacterCheckpointsParams) ([]db.CharacterCheckpoint, error)
+	DeleteCharacterCheckpointsBefore(ctx context.Context, createdAt pgtype.Timestamp) (int64, error)
+	RestoreCharacter(ctx context.Context, checkpoint db.CharacterCheckpoint, inventory []Item) error
+}
+
+// PositionBuffer holds character moves that are not stored yet
+type PositionBuffer interface {
+	FlushCharacter(ctx context.Context, id pgtype.UUID) error
+	Forget(id pgtype.UUID)
+}
+
+// DatabaseWrapper implements DatabaseInterface using the actual database connection.
+type DatabaseWrapper struct {
+	pool    storage.Pool
+	queries *db.Queries
+}
+
+// NewDatabaseWrapper creates a new database wrapper with the given connection pool.
+func NewDatabaseWrapper(pool storage.Pool) DatabaseInterface {
+	return &DatabaseWrapper{
+		pool:    pool,
+		queries: db.New(pool),
+	}
+}
+
+func (d *DatabaseWrapper) GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error) {
+	return d.queries.GetCharacterById(ctx, id)
+}
+
+func (d *DatabaseWrapper) ListCharactersAfter(ctx context.Context, arg db.ListCharactersAfterParams) ([]db.Character, error) {
+	return d.queries.ListCharactersAfter(ctx, arg)
+}
+
+func (d *DatabaseWrapper) GetCharacterInventory(ctx context.Context, characterID pgtype.UUID) ([]db.GetCharacterInventoryRow, error) {
+	return d.queries.GetCharacterInventory(ctx, characterID)
+}
+
+func (d *DatabaseWrapper) CreateCharacterCheckpoint(ctx context.Context, arg db.CreateCharacterCheckpointParams) (db.CharacterCheckpoint, error) {
+	return d.queries.CreateCharacterCheckpoint(ctx, arg)
+}
+
+func (d *DatabaseWrapper) GetCharacterCheckpoint(ctx context.Context, id int64) (db.CharacterCheckpoint, error) {
+	return d.queries.GetCharacterCheckpoint(ctx, id)
+}
+
+func (d *DatabaseWrapper) GetLatestCharacterCheckpoint(ctx context.Context, characterID pgtype.UUID) (db.CharacterCheckpoint, error) {
+	return d.queries.GetLatestCharacterCheckpoint(ctx, characterID)
+}
+
+func (d *DatabaseWrapper) ListCharacterCheckpoints(ctx context.Context, arg db.ListCharacterCheckpointsParams) ([]db.CharacterCheckpoint, error) {
+	return d.queries.ListCharacterCheckpoints(ctx, arg)
+}
+
+func (d *DatabaseWrapper) DeleteCharacterCheckpointsBefore(ctx context.Context, createdAt pgtype.Timestamp) (int64, error) {
+	return d.queries.DeleteCharacterCheckpointsBefore(ctx, createdAt)
+}
+
+// RestoreCharacter moves the character to the checkpoint position and replaces its
+// inventory in one serializable transaction, so an item granted while the restore runs
+// cannot survive next to (or collide with) the restored inventory
+func (d *DatabaseWrapper) RestoreCharacter(ctx context.Context, checkpoint db.CharacterCheckpoint, inventory []Item) error {
+	return txn.Run(ctx, d.pool, txn.Serializable, func(q *db.Queries) error {
+		// Restored characters stand idle facing south, like new ones
+		if _, err := q.UpdateCharacterPosition(ctx, db.UpdateCharacterPositionParams{
+			ID:          checkpoint.CharacterID,
+			X:           checkpoint.X,
+			Y:           checkpoint.Y,
+			ChunkX:      checkpoint.ChunkX,
+			ChunkY:      checkpoint.ChunkY,
+			Facing:      int32(characterV1.Facing_FACING_SOUTH),
+			ActionState: int32(characterV1.ActionState_ACTION_STATE_IDLE),
+		}); err != nil {
+			return fmt.Errorf("failed to restore position: %w", err)
+		}
+		if err := q.DeleteCharacterInventory(ctx, checkpoint.CharacterID); err != nil {
+			return fmt.Errorf("failed to clear inventory: %w", err)
+		}
+		for _, item := range inventory {
+			if _, err := q.CreateInventoryItem(ctx, db.CreateInventoryItemParams{
+				CharacterID: checkpoint.CharacterID,
+				ItemID:      item.ItemID,
+				Quantity:    item.Quantity,
+			}); err != nil {
+				return fmt.Errorf("failed to restore item %d: %w", item.ItemID, err)
+			}
+		}
+		return nil
+	})
+}
+
+// LoggerInterface abstracts logging operations for dependency injection.
+type LoggerInterface interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+	With(keysAndValues ...interface{}) LoggerInterface
+}
+
+// DefaultLoggerWrapper wraps the internal logging package.
+type DefaultLoggerWrapper struct {
+	logger *log.Logger
+}
+
+func NewDefaultLoggerWrapper() LoggerInterface {
+	return &DefaultLoggerWrapper{logger: logging.GetLogger()}
+}
+
+func (l *DefaultLoggerWrapper) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.Debug(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Info(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.Warn(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.Error(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) With(keysAndValues ...interface{}) LoggerInterface {
+	return &DefaultLoggerWrapper{logger: l.logger.With(keysAndValues...)}
+}
@@ -0,0 +1,240 @@
+package chunk
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/alerting"
+	"github.com/VoidMesh/api/api/internal/debugstats"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	debugV1 "github.com/VoidMesh/api/api/proto/debug/v1"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// pendingAccesses counts chunks with reads not yet written to the database
+var pendingAccesses atomic.Int64
+
+// accesses is shared by every Service in the process (the chunk handler and the
+// terrain service each build one), so a single flush job writes all reads
+var accesses = newAccessTracker()
+
+func init() {
+	debugstats.Register(debugstats.Queues, "chunk.access_pending", pendingAccesses.Load)
+}
+
+// AccessConfig controls how chunk reads are written to last_accessed_at and access_count.
+// Reads are counted in memory and written in batches, so a busy chunk costs one row
+// update per flush rather than one per read.
+type AccessConfig struct {
+	FlushInterval time.Duration // Time between flushes of the counted reads
+	BatchSize     int           // Chunks updated per statement
+}
+
+// DefaultAccessConfig flushes counted reads every 30 seconds
+func DefaultAccessConfig() AccessConfig {
+	return AccessConfig{
+		FlushInterval: 30 * time.Second,
+		BatchSize:     1000,
+	}
+}
+
+// MaxAccessStatsLimit bounds how many chunks AccessStats returns
+const MaxAccessStatsLimit = 500
+
+type chunkKey struct {
+	worldID pgtype.UUID
+	chunkX  int32
+	chunkY  int32
+}
+
+type chunkAccess struct {
+	hits int64
+	last time.Time
+}
+
+// accessTracker counts chunk reads between flushes
+type accessTracker struct {
+	mu      sync.Mutex
+	pending map[chunkKey]*chunkAccess
+}
+
+func newAccessTracker() *accessTracker {
+	return &accessTracker{pending: make(map[chunkKey]*chunkAccess)}
+}
+
+// add counts hits reads of a chunk, the latest at last
+func (t *accessTracker) add(key chunkKey, hits int64, last time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	access, ok := t.pending[key]
+	if !ok {
+		access = &chunkAccess{}
+		t.pending[key] = access
+		pendingAccesses.Add(1)
+	}
+	access.hits += hits
+	if last.After(access.last) {
+		access.last = last
+	}
+}
+
+// take removes and returns every counted read
+func (t *accessTracker) take() map[chunkKey]*chunkAccess {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	taken := t.pending
+	t.pending = make(map[chunkKey]*chunkAccess)
+	pendingAccesses.Add(-int64(len(taken)))
+	return taken
+}
+
+// recordAccess counts a read of a chunk; it is written by the next flush
+func (s *Service) recordAccess(worldID pgtype.UUID, chunkX, chunkY int32) {
+	accesses.add(chunkKey{worldID: worldID, chunkX: chunkX, chunkY: chunkY}, 1, s.clock.Now())
+}
+
+// FlushAccesses writes the reads counted since the last flush and returns how many
+// chunks were updated. Reads of a batch that fails to write are kept for the next flush.
+func (s *Service) FlushAccesses(ctx context.Context, config AccessConfig) (int, error) {
+	taken := accesses.take()
+	if len(taken) == 0 {
+		return 0, nil
+	}
+
+	keys := make([]chunkKey, 0, len(taken))
+	for key := range taken {
+		keys = append(keys, key)
+	}
+	flushed := 0
+	for start := 0; start < len(keys); start += config.BatchSize {
+		batch := keys[start:min(start+config.BatchSize, len(keys))]
+		arg := db.RecordChunkAccessesParams{
+			WorldIds:    make([]pgtype.UUID, len(batch)),
+			ChunkXs:     make([]int32, len(batch)),
+			ChunkYs:     make([]int32, len(batch)),
+			Hits:        make([]int64, len(batch)),
+			AccessedAts: make([]pgtype.Timestamp, len(batch)),
+		}
+		for i, key := range batch {
+			access := taken[key]
+			arg.WorldIds[i] = key.worldID
+			arg.ChunkXs[i] = key.chunkX
+			arg.ChunkYs[i] = key.chunkY
+			arg.Hits[i] = access.hits
+			arg.AccessedAts[i] = pgtype.Timestamp{Time: access.last, Valid: true}
+		}
+
+		if err := s.db.RecordChunkAccesses(ctx, arg); err != nil {
+			for _, key := range keys[start:] {
+				accesses.add(key, taken[key].hits, taken[key].last)
+			}
+			return flushed, fmt.Errorf("failed to record chunk accesses: %w", err)
+		}
+		flushed += len(batch)
+	}
+	return flushed, nil
+}
+
+// RunAccessFlush flushes counted reads every config.FlushInterval until ctx is
+// cancelled, then flushes once more so reads are not lost on shutdown
+func (s *Service) RunAccessFlush(ctx context.Context, config AccessConfig) {
+	s.logger.Info("Chunk access flush started", "interval", config.FlushInterval)
+	for {
+		select {
+		case <-ctx.Done():
+			flushCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+			if _, err := s.FlushAccesses(flushCtx, config); err != nil {
+				s.logger.Warn("Final chunk access flush failed", "error", err)
+			}
+			cancel()
+			s.logger.Info("Chunk access flush stopped")
+			return
+		case <-s.clock.After(config.FlushInterval):
+		}
+
+		if _, err := s.FlushAccesses(ctx, config); err != nil {
+			s.logger.Error("Chunk access flush failed", "error", err)
+			alerting.ReportJobError("chunk_access_flush", err)
+		}
+	}
+}
+
+// ColdChunks returns up to limit chunks of a world that have not been read since
+// idleSince, least recently read first. Chunks never read since access tracking began
+// count from when they were generated. This is the input for archival and eviction.
+func (s *Service) ColdChunks(ctx context.Context, worldID pgtype.UUID, idleSince time.Time, limit int32) ([]db.ListColdChunksRow, error) {
+	return s.db.ListColdChunks(ctx, db.ListColdChunksParams{
+		WorldID:   worldID,
+		IdleSince: pgtype.Timestamp{Time: idleSince, Valid: true},
+		MaxChunks: limit,
+	})
+}
+
+// AccessStats returns per-chunk read statistics for a world (the default world when
+// worldID is empty): the coldest chunks idle for at least idleFor, or the most read
+// chunks
+func (s *Service) AccessStats(ctx context.Context, worldID string, order debugV1.ChunkAccessOrder, idleFor time.Duration, limit int32) ([]*debugV1.ChunkAccessStats, error) {
+	if limit < 0 || idleFor < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "limit and idle time must not be negative")
+	}
+	if limit == 0 || limit > MaxAccessStatsLimit {
+		limit = MaxAccessStatsLimit
+	}
+	var world pgtype.UUID
+	if worldID == "" {
+		defaultWorld, err := s.worldService.GetDefaultWorld(ctx)
+		if err != nil {
+			s.logger.Error("Failed to get default world", "error", err)
+			return nil, status.Errorf(codes.Internal, "failed to get chunk access stats")
+		}
+		world = defaultWorld.ID
+	} else {
+		var err error
+		if world, err = uuid.StringToPgtype(worldID); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid world ID format")
+		}
+	}
+
+	var rows []db.ListColdChunksRow
+	var err error
+	switch order {
+	case debugV1.ChunkAccessOrder_CHUNK_ACCESS_ORDER_UNSPECIFIED, debugV1.ChunkAccessOrder_CHUNK_ACCESS_ORDER_COLDEST:
+		rows, err = s.ColdChunks(ctx, world, s.clock.Now().Add(-idleFor), limit)
+	case debugV1.ChunkAccessOrder_CHUNK_ACCESS_ORDER_MOST_ACCESSED:
+		var hot []db.ListMostAccessedChunksRow
+		hot, err = s.db.ListMostAccessedChunks(ctx, db.ListMostAccessedChunksParams{WorldID: world, MaxChunks: limit})
+		for _, row := range hot {
+			rows = append(rows, db.ListColdChunksRow(row))
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown order %v", order)
+	}
+	if err != nil {
+		s.logger.Error("Failed to list chunk access stats", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to get chunk access stats")
+	}
+
+	stats := make([]*debugV1.ChunkAccessStats, 0, len(rows))
+	for _, row := range rows {
+		stat := &debugV1.ChunkAccessStats{
+			Chunk:       &chunkV1.ChunkCoordinate{ChunkX: row.ChunkX, ChunkY: row.ChunkY},
+			AccessCount: row.AccessCount,
+			GeneratedAt: timestamppb.New(row.GeneratedAt.Time),
+		}
+		if row.LastAccessedAt.Valid {
+			stat.LastAccessedAt = timestamppb.New(row.LastAccessedAt.Time)
+		}
+		stats = append(stats, stat)
+	}
+	return stats, nil
+}
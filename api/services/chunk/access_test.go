@@ -0,0 +1,120 @@
+package chunk
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/testutil"
+	debugV1 "github.com/VoidMesh/api/api/proto/debug/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestService_FlushAccesses(t *testing.T) {
+	cleanup := testutil.SetupTest(t, testutil.DefaultTestConfig())
+	defer cleanup()
+
+	accesses.take() // Reads counted by other tests
+	database := NewMockDatabase()
+	service := NewService(database, NewMockNoiseGenerator(12345), NewMockWorldService(), NewMockResourceNodeIntegration(), NewMockLogger())
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	fake := clock.NewFake(start)
+	service.SetClock(fake)
+	ctx := context.Background()
+	config := AccessConfig{BatchSize: 1}
+
+	// Generating chunk (0,0) counts as its first read, then it is read twice more
+	_, err := service.GetOrCreateChunk(ctx, 0, 0)
+	require.NoError(t, err)
+	_, err = service.GetOrCreateChunk(ctx, 1, 0)
+	require.NoError(t, err)
+	fake.Advance(time.Minute)
+	for i := 0; i < 2; i++ {
+		_, err = service.GetOrCreateChunk(ctx, 0, 0)
+		require.NoError(t, err)
+	}
+
+	t.Run("reads are kept when the write fails", func(t *testing.T) {
+		database.SetShouldReturnError(true)
+		_, err := service.FlushAccesses(ctx, config)
+		require.Error(t, err)
+		database.SetShouldReturnError(false)
+		assert.Equal(t, int64(2), pendingAccesses.Load())
+	})
+
+	t.Run("reads are written once per chunk", func(t *testing.T) {
+		flushed, err := service.FlushAccesses(ctx, config)
+		require.NoError(t, err)
+		assert.Equal(t, 2, flushed)
+		assert.Zero(t, pendingAccesses.Load())
+
+		stats, err := service.AccessStats(ctx, "", debugV1.ChunkAccessOrder_CHUNK_ACCESS_ORDER_MOST_ACCESSED, 0, 0)
+		require.NoError(t, err)
+		require.Len(t, stats, 2)
+		assert.Equal(t, int32(0), stats[0].Chunk.ChunkX)
+		assert.Equal(t, int64(3), stats[0].AccessCount)
+		assert.Equal(t, start.Add(time.Minute), stats[0].LastAccessedAt.AsTime())
+		assert.Equal(t, int64(1), stats[1].AccessCount)
+
+		flushed, err = service.FlushAccesses(ctx, config)
+		require.NoError(t, err)
+		assert.Zero(t, flushed)
+	})
+
+	t.Run("cold chunks are those not read since the idle time", func(t *testing.T) {
+		fake.Advance(time.Hour)
+		stats, err := service.AccessStats(ctx, "", debugV1.ChunkAccessOrder_CHUNK_ACCESS_ORDER_COLDEST, 30*time.Minute, 0)
+		require.NoError(t, err)
+		require.Len(t, stats, 2)
+		assert.Equal(t, int32(1), stats[0].Chunk.ChunkX, "least recently read first")
+
+		stats, err = service.AccessStats(ctx, "", debugV1.ChunkAccessOrder_CHUNK_ACCESS_ORDER_COLDEST, 2*time.Hour, 0)
+		require.NoError(t, err)
+		assert.Empty(t, stats)
+	})
+
+	t.Run("invalid requests are rejected", func(t *testing.T) {
+		_, err := service.AccessStats(ctx, "not-a-uuid", debugV1.ChunkAccessOrder_CHUNK_ACCESS_ORDER_COLDEST, 0, 0)
+		testutil.AssertGRPCError(t, err, codes.InvalidArgument)
+		_, err = service.AccessStats(ctx, "", debugV1.ChunkAccessOrder_CHUNK_ACCESS_ORDER_COLDEST, 0, -1)
+		testutil.AssertGRPCError(t, err, codes.InvalidArgument)
+	})
+}
+
+func TestService_RunAccessFlush(t *testing.T) {
+	cleanup := testutil.SetupTest(t, testutil.DefaultTestConfig())
+	defer cleanup()
+
+	accesses.take()
+	database := NewMockDatabase()
+	service := NewService(database, NewMockNoiseGenerator(12345), NewMockWorldService(), NewMockResourceNodeIntegration(), NewMockLogger())
+	fake := clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	service.SetClock(fake)
+	_, err := service.GetOrCreateChunk(context.Background(), 0, 0)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		service.RunAccessFlush(ctx, AccessConfig{FlushInterval: time.Minute, BatchSize: 10})
+		close(done)
+	}()
+
+	require.Eventually(t, func() bool { return fake.Waiters() == 1 }, time.Second, time.Millisecond)
+	fake.Advance(time.Minute)
+	require.Eventually(t, func() bool { return fake.Waiters() == 1 }, time.Second, time.Millisecond)
+	assert.Zero(t, pendingAccesses.Load())
+
+	// Reads counted after the last tick are flushed on shutdown
+	_, err = service.GetOrCreateChunk(context.Background(), 0, 0)
+	require.NoError(t, err)
+	cancel()
+	<-done
+	assert.Zero(t, pendingAccesses.Load())
+	for _, chunk := range database.chunks {
+		assert.Equal(t, int64(2), chunk.AccessCount)
+	}
+}
@@ -0,0 +1,46 @@
+package chunk
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/internal/chunkdata"
+	"github.com/VoidMesh/api/api/internal/geometry"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+)
+
+// MaxCellQuery is the most cells a GetCells request may ask for
+const MaxCellQuery = 256
+
+// Cells returns the terrain, passability and crop viability of individual cells, once per distinct cell in
+// the order given. Each chunk the cells fall in is loaded once, generating it if needed.
+func (s *Service) Cells(ctx context.Context, points []geometry.Point) ([]*chunkV1.CellInfo, error) {
+	chunks := make(map[[2]int32]*chunkV1.ChunkData)
+	seen := make(map[geometry.Point]bool, len(points))
+	cells := make([]*chunkV1.CellInfo, 0, len(points))
+	for _, p := range points {
+		if seen[p] {
+			continue
+		}
+		seen[p] = true
+
+		chunkX, chunkY, index := geometry.CellLocation(p)
+		chunk, ok := chunks[[2]int32{chunkX, chunkY}]
+		if !ok {
+			var err error
+			chunk, err = s.GetOrCreateChunk(ctx, chunkX, chunkY)
+			if err != nil {
+				return nil, err
+			}
+			chunks[[2]int32{chunkX, chunkY}] = chunk
+		}
+
+		info := &chunkV1.CellInfo{X: p.X, Y: p.Y}
+		if int(index) < len(chunk.Cells) && chunk.Cells[index] != nil {
+			info.Cell = chunk.Cells[index]
+			info.Passable = chunkdata.Walkable(info.Cell.TerrainType)
+			info.CropViable = chunkdata.CropViable(info.Cell)
+		}
+		cells = append(cells, info)
+	}
+	return cells, nil
+}
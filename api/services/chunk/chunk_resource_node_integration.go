@@ -7,25 +7,24 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/VoidMesh/api/api/internal/storage"
 	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
 	resourceNodeV1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
-	"github.com/VoidMesh/api/api/services/noise"
 	"github.com/VoidMesh/api/api/services/resource_node"
 	"github.com/VoidMesh/api/api/services/world"
 	"github.com/charmbracelet/log"
-	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 // ResourceNodeGeneratorIntegration provides integration between the chunk and resource node services
 type ResourceNodeGeneratorIntegration struct {
 	resourceNodeService *resource_node.NodeService
-	db                  *pgxpool.Pool
+	db                  storage.Pool
 	logger              *log.Logger
 }
 
 // NewResourceNodeGeneratorIntegration creates a new resource generator integration
-func NewResourceNodeGeneratorIntegration(db *pgxpool.Pool, noiseGen *noise.Generator, worldService *world.Service) *ResourceNodeGeneratorIntegration {
-	resourceNodeService := resource_node.NewNodeServiceWithPool(db, noiseGen, worldService)
+func NewResourceNodeGeneratorIntegration(db storage.Pool, seeds *world.Seeds, worldService *world.Service) *ResourceNodeGeneratorIntegration {
+	resourceNodeService := resource_node.NewNodeServiceWithPool(db, seeds, worldService)
 
 	logger := log.NewWithOptions(os.Stderr, log.Options{
 		ReportCaller:    false,
@@ -73,6 +72,31 @@ func (rgi *ResourceNodeGeneratorIntegration) GenerateAndAttachResourceNodes(ctx
 	return nil
 }
 
+// StoreAndAttachResourceNodes stores the given resource nodes for a chunk, replacing
+// any it had, and attaches them as stored
+func (rgi *ResourceNodeGeneratorIntegration) StoreAndAttachResourceNodes(ctx context.Context, chunk *chunkV1.ChunkData, resources []*resourceNodeV1.ResourceNode) error {
+	if chunk == nil {
+		rgi.logger.Error("Cannot store resource nodes for nil chunk")
+		return fmt.Errorf("nil chunk provided")
+	}
+
+	err := rgi.resourceNodeService.StoreResourceNodes(ctx, chunk.ChunkX, chunk.ChunkY, resources)
+	if err != nil {
+		rgi.logger.Error("Failed to store resource nodes", "error", err)
+		return err
+	}
+
+	// Read them back for their IDs and full type definitions
+	stored, err := rgi.resourceNodeService.GetResourcesInChunkRange(ctx, chunk.ChunkX, chunk.ChunkX, chunk.ChunkY, chunk.ChunkY)
+	if err != nil {
+		rgi.logger.Error("Failed to get stored resource nodes", "error", err)
+		return err
+	}
+	chunk.ResourceNodes = stored
+
+	return nil
+}
+
 // AttachResourceNodesToChunk attaches existing resource nodes to a chunk
 func (rgi *ResourceNodeGeneratorIntegration) AttachResourceNodesToChunk(ctx context.Context, chunk *chunkV1.ChunkData) error {
 	if chunk == nil {
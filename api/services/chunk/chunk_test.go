@@ -4,13 +4,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"testing"
 	"time"
 
 	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/chunkdata"
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/uuid"
 	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
 	resourceNodeV1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
 	"github.com/VoidMesh/api/api/internal/testutil"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -21,6 +27,8 @@ import (
 // MockDatabaseInterface provides a mock database for testing
 type MockDatabaseInterface struct {
 	chunks          map[string]db.Chunk
+	deltas          []db.ChunkDelta
+	nextDeltaID     int64
 	shouldReturnErr bool
 	getCallCount    int
 	createCallCount int
@@ -57,6 +65,7 @@ func (m *MockDatabaseInterface) CreateChunk(ctx context.Context, arg db.CreateCh
 		ChunkX:      arg.ChunkX,
 		ChunkY:      arg.ChunkY,
 		ChunkData:   arg.ChunkData,
+		Passability: arg.Passability,
 		GeneratedAt: pgtype.Timestamp{Valid: true, Time: time.Now()},
 	}
 
@@ -75,6 +84,225 @@ func (m *MockDatabaseInterface) ChunkExists(ctx context.Context, arg db.ChunkExi
 	return exists, nil
 }
 
+func (m *MockDatabaseInterface) CreateChunkDelta(ctx context.Context, arg db.CreateChunkDeltaParams) (db.ChunkDelta, error) {
+	if m.shouldReturnErr {
+		return db.ChunkDelta{}, errors.New("database error")
+	}
+
+	m.nextDeltaID++
+	delta := db.ChunkDelta{
+		ID:                  m.nextDeltaID,
+		WorldID:             arg.WorldID,
+		ChunkX:              arg.ChunkX,
+		ChunkY:              arg.ChunkY,
+		CellIndex:           arg.CellIndex,
+		TerrainType:         arg.TerrainType,
+		PreviousTerrainType: arg.PreviousTerrainType,
+		CharacterID:         arg.CharacterID,
+		CreatedAt:           arg.CreatedAt,
+	}
+	m.deltas = append(m.deltas, delta)
+
+	// Like SetCellPassability, only update chunks that have a mask
+	key := fmt.Sprintf("%x_%d_%d", arg.WorldID.Bytes, arg.ChunkX, arg.ChunkY)
+	if chunk, exists := m.chunks[key]; exists && chunk.Passability != nil {
+		mask := append(chunkdata.Passability(nil), chunk.Passability...)
+		mask.Set(arg.CellIndex, chunkdata.Walkable(chunkV1.TerrainType(arg.TerrainType)))
+		chunk.Passability = mask
+		m.chunks[key] = chunk
+	}
+	return delta, nil
+}
+
+func (m *MockDatabaseInterface) ListChunkDeltas(ctx context.Context, arg db.ListChunkDeltasParams) ([]db.ChunkDelta, error) {
+	if m.shouldReturnErr {
+		return nil, errors.New("database error")
+	}
+
+	var deltas []db.ChunkDelta
+	for _, delta := range m.deltas {
+		if delta.WorldID == arg.WorldID && delta.ChunkX == arg.ChunkX && delta.ChunkY == arg.ChunkY {
+			deltas = append(deltas, delta)
+		}
+	}
+	return deltas, nil
+}
+
+func (m *MockDatabaseInterface) ListCompactableChunks(ctx context.Context, arg db.ListCompactableChunksParams) ([]db.ListCompactableChunksRow, error) {
+	if m.shouldReturnErr {
+		return nil, errors.New("database error")
+	}
+
+	var rows []db.ListCompactableChunksRow
+	index := make(map[string]int)
+	oldest := make(map[string]time.Time)
+	for _, delta := range m.deltas {
+		key := fmt.Sprintf("%x_%d_%d", delta.WorldID.Bytes, delta.ChunkX, delta.ChunkY)
+		i, ok := index[key]
+		if !ok {
+			i = len(rows)
+			index[key] = i
+			rows = append(rows, db.ListCompactableChunksRow{WorldID: delta.WorldID, ChunkX: delta.ChunkX, ChunkY: delta.ChunkY})
+			oldest[key] = delta.CreatedAt.Time
+		}
+		rows[i].DeltaCount++
+		if delta.CreatedAt.Time.Before(oldest[key]) {
+			oldest[key] = delta.CreatedAt.Time
+		}
+	}
+
+	var compactable []db.ListCompactableChunksRow
+	for key, i := range index {
+		if rows[i].DeltaCount >= arg.MinDeltas || !oldest[key].After(arg.CreatedBefore.Time) {
+			compactable = append(compactable, rows[i])
+		}
+	}
+	if len(compactable) > int(arg.MaxChunks) {
+		compactable = compactable[:arg.MaxChunks]
+	}
+	return compactable, nil
+}
+
+func (m *MockDatabaseInterface) QuarantineChunk(ctx context.Context, arg db.QuarantineChunkParams) error {
+	if m.shouldReturnErr {
+		return errors.New("database error")
+	}
+
+	key := fmt.Sprintf("%x_%d_%d", arg.WorldID.Bytes, arg.ChunkX, arg.ChunkY)
+	chunk, exists := m.chunks[key]
+	if !exists {
+		return errors.New("chunk not found")
+	}
+	chunk.CorruptChunkData = chunk.ChunkData
+	chunk.ChunkData = arg.ChunkData
+	chunk.QuarantinedAt = arg.QuarantinedAt
+	chunk.Passability = nil
+	m.chunks[key] = chunk
+	return nil
+}
+
+func (m *MockDatabaseInterface) GetChunkPassability(ctx context.Context, arg db.GetChunkPassabilityParams) ([]byte, error) {
+	if m.shouldReturnErr {
+		return nil, errors.New("database error")
+	}
+
+	key := fmt.Sprintf("%x_%d_%d", arg.WorldID.Bytes, arg.ChunkX, arg.ChunkY)
+	chunk, exists := m.chunks[key]
+	if !exists {
+		return nil, pgx.ErrNoRows
+	}
+	return chunk.Passability, nil
+}
+
+func (m *MockDatabaseInterface) BackfillChunkPassability(ctx context.Context, arg db.BackfillChunkPassabilityParams) error {
+	if m.shouldReturnErr {
+		return errors.New("database error")
+	}
+
+	key := fmt.Sprintf("%x_%d_%d", arg.WorldID.Bytes, arg.ChunkX, arg.ChunkY)
+	chunk, exists := m.chunks[key]
+	if exists && len(chunk.Passability) != len(arg.Passability) {
+		chunk.Passability = arg.Passability
+		m.chunks[key] = chunk
+	}
+	return nil
+}
+
+func (m *MockDatabaseInterface) CompactChunk(ctx context.Context, arg db.UpdateChunkDataParams, deltaIDs []int64) error {
+	if m.shouldReturnErr {
+		return errors.New("database error")
+	}
+
+	key := fmt.Sprintf("%x_%d_%d", arg.WorldID.Bytes, arg.ChunkX, arg.ChunkY)
+	chunk, exists := m.chunks[key]
+	if !exists {
+		return errors.New("chunk not found")
+	}
+	chunk.ChunkData = arg.ChunkData
+	chunk.Passability = arg.Passability
+	m.chunks[key] = chunk
+
+	compacted := make(map[int64]bool, len(deltaIDs))
+	for _, id := range deltaIDs {
+		compacted[id] = true
+	}
+	remaining := m.deltas[:0]
+	for _, delta := range m.deltas {
+		if !compacted[delta.ID] {
+			remaining = append(remaining, delta)
+		}
+	}
+	m.deltas = remaining
+	return nil
+}
+
+func (m *MockDatabaseInterface) RecordChunkAccesses(ctx context.Context, arg db.RecordChunkAccessesParams) error {
+	if m.shouldReturnErr {
+		return errors.New("database error")
+	}
+
+	for i := range arg.WorldIds {
+		key := fmt.Sprintf("%x_%d_%d", arg.WorldIds[i].Bytes, arg.ChunkXs[i], arg.ChunkYs[i])
+		chunk, exists := m.chunks[key]
+		if !exists {
+			continue
+		}
+		chunk.AccessCount += arg.Hits[i]
+		if !chunk.LastAccessedAt.Valid || arg.AccessedAts[i].Time.After(chunk.LastAccessedAt.Time) {
+			chunk.LastAccessedAt = arg.AccessedAts[i]
+		}
+		m.chunks[key] = chunk
+	}
+	return nil
+}
+
+func (m *MockDatabaseInterface) ListColdChunks(ctx context.Context, arg db.ListColdChunksParams) ([]db.ListColdChunksRow, error) {
+	if m.shouldReturnErr {
+		return nil, errors.New("database error")
+	}
+
+	lastRead := func(chunk db.Chunk) time.Time {
+		if chunk.LastAccessedAt.Valid {
+			return chunk.LastAccessedAt.Time
+		}
+		return chunk.GeneratedAt.Time
+	}
+	var cold []db.Chunk
+	for _, chunk := range m.chunks {
+		if chunk.WorldID == arg.WorldID && lastRead(chunk).Before(arg.IdleSince.Time) {
+			cold = append(cold, chunk)
+		}
+	}
+	sort.Slice(cold, func(i, j int) bool { return lastRead(cold[i]).Before(lastRead(cold[j])) })
+
+	var rows []db.ListColdChunksRow
+	for _, chunk := range cold {
+		if len(rows) == int(arg.MaxChunks) {
+			break
+		}
+		rows = append(rows, db.ListColdChunksRow{WorldID: chunk.WorldID, ChunkX: chunk.ChunkX, ChunkY: chunk.ChunkY, GeneratedAt: chunk.GeneratedAt, LastAccessedAt: chunk.LastAccessedAt, AccessCount: chunk.AccessCount})
+	}
+	return rows, nil
+}
+
+func (m *MockDatabaseInterface) ListMostAccessedChunks(ctx context.Context, arg db.ListMostAccessedChunksParams) ([]db.ListMostAccessedChunksRow, error) {
+	if m.shouldReturnErr {
+		return nil, errors.New("database error")
+	}
+
+	var rows []db.ListMostAccessedChunksRow
+	for _, chunk := range m.chunks {
+		if chunk.WorldID == arg.WorldID {
+			rows = append(rows, db.ListMostAccessedChunksRow{WorldID: chunk.WorldID, ChunkX: chunk.ChunkX, ChunkY: chunk.ChunkY, GeneratedAt: chunk.GeneratedAt, LastAccessedAt: chunk.LastAccessedAt, AccessCount: chunk.AccessCount})
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].AccessCount > rows[j].AccessCount })
+	if len(rows) > int(arg.MaxChunks) {
+		rows = rows[:arg.MaxChunks]
+	}
+	return rows, nil
+}
+
 func (m *MockDatabaseInterface) SetShouldReturnError(shouldErr bool) {
 	m.shouldReturnErr = shouldErr
 }
@@ -124,6 +352,11 @@ func (m *MockNoiseGenerator) GetSeed() int64 {
 	return m.seed
 }
 
+// Noise serves the mock as the noise of every world
+func (m *MockNoiseGenerator) Noise(ctx context.Context, worldID pgtype.UUID) (NoiseGeneratorInterface, error) {
+	return m, nil
+}
+
 func (m *MockNoiseGenerator) SetNoiseValue(x, y int, scale float64, value float64) {
 	key := fmt.Sprintf("%d_%d_%.1f", x, y, scale)
 	m.noiseValues[key] = value
@@ -184,6 +417,9 @@ type MockResourceNodeIntegration struct {
 	resourceNodes     []*resourceNodeV1.ResourceNode
 	generateCallCount int
 	attachCallCount   int
+	storeCallCount    int
+
+	attachBatchCallCount int
 }
 
 func NewMockResourceNodeIntegration() *MockResourceNodeIntegration {
@@ -214,6 +450,23 @@ func (m *MockResourceNodeIntegration) AttachResourceNodesToChunk(ctx context.Con
 	return nil
 }
 
+func (m *MockResourceNodeIntegration) AttachResourceNodesToChunks(ctx context.Context, chunks []*chunkV1.ChunkData) error {
+	m.attachBatchCallCount++
+	if m.shouldErr {
+		return errors.New("resource attachment error")
+	}
+	return nil
+}
+
+func (m *MockResourceNodeIntegration) StoreAndAttachResourceNodes(ctx context.Context, chunk *chunkV1.ChunkData, resources []*resourceNodeV1.ResourceNode) error {
+	m.storeCallCount++
+	if m.shouldErr {
+		return errors.New("resource store error")
+	}
+	chunk.ResourceNodes = resources
+	return nil
+}
+
 func (m *MockResourceNodeIntegration) SetShouldError(shouldErr bool) {
 	m.shouldErr = shouldErr
 }
@@ -304,12 +557,12 @@ func TestNewService(t *testing.T) {
 
 	tests := []struct {
 		name         string
-		setupMocks   func() (DatabaseInterface, NoiseGeneratorInterface, WorldServiceInterface, ResourceNodeIntegrationInterface, LoggerInterface)
+		setupMocks   func() (DatabaseInterface, WorldNoiseInterface, WorldServiceInterface, ResourceNodeIntegrationInterface, LoggerInterface)
 		expectFields func(t *testing.T, service *Service)
 	}{
 		{
 			name: "successful service creation",
-			setupMocks: func() (DatabaseInterface, NoiseGeneratorInterface, WorldServiceInterface, ResourceNodeIntegrationInterface, LoggerInterface) {
+			setupMocks: func() (DatabaseInterface, WorldNoiseInterface, WorldServiceInterface, ResourceNodeIntegrationInterface, LoggerInterface) {
 				db := NewMockDatabase()
 				noise := NewMockNoiseGenerator(12345)
 				world := NewMockWorldService()
@@ -319,7 +572,7 @@ func TestNewService(t *testing.T) {
 			},
 			expectFields: func(t *testing.T, service *Service) {
 				assert.NotNil(t, service.db)
-				assert.NotNil(t, service.noiseGen)
+				assert.NotNil(t, service.worldNoise)
 				assert.NotNil(t, service.worldService)
 				assert.NotNil(t, service.resourceNodeIntegration)
 				assert.NotNil(t, service.logger)
@@ -397,7 +650,7 @@ func TestService_GenerateChunk(t *testing.T) {
 			validateChunk: func(t *testing.T, chunk *chunkV1.ChunkData) {
 				assert.Equal(t, int32(1), chunk.ChunkX)
 				assert.Equal(t, int32(1), chunk.ChunkY)
-				assert.Equal(t, int64(54321), chunk.Seed)
+				assert.Equal(t, int64(12345), chunk.Seed, "the seed of the world's noise")
 				
 				// All cells should be water with configured noise values
 				for _, cell := range chunk.Cells {
@@ -503,6 +756,44 @@ func TestService_GenerateChunk(t *testing.T) {
 	}
 }
 
+// worldNoise serves a noise generator per world
+type worldNoise map[pgtype.UUID]*MockNoiseGenerator
+
+func (w worldNoise) Noise(ctx context.Context, worldID pgtype.UUID) (NoiseGeneratorInterface, error) {
+	gen, ok := w[worldID]
+	if !ok {
+		return nil, pgx.ErrNoRows
+	}
+	return gen, nil
+}
+
+func TestService_GenerateChunk_PerWorldNoise(t *testing.T) {
+	world := NewMockWorldService()
+	other := pgtype.UUID{Bytes: [16]byte{9}, Valid: true}
+	noises := worldNoise{world.defaultWorld.ID: NewMockNoiseGenerator(12345), other: NewMockNoiseGenerator(777)}
+	// Water everywhere in the other world, dry land in the default one
+	for y := 0; y < ChunkSize; y++ {
+		for x := 0; x < ChunkSize; x++ {
+			noises[other].SetNoiseValue(x, y, ElevationScale, -0.5)
+			noises[other].SetNoiseValue(x, y, DetailScale, -0.1)
+		}
+	}
+	service := NewService(NewMockDatabase(), noises, world, NewMockResourceNodeIntegration(), NewMockLogger())
+
+	chunk, err := service.GenerateChunk(testutil.CreateTestContext(), 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(12345), chunk.Seed, "system contexts generate in the default world")
+	assert.NotEqual(t, chunkV1.TerrainType_TERRAIN_TYPE_WATER, chunk.Cells[0].TerrainType)
+
+	chunk, err = service.GenerateChunk(session.WithWorldID(context.Background(), uuid.PgtypeToString(other)), 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(777), chunk.Seed, "the session's world decides the noise")
+	assert.Equal(t, chunkV1.TerrainType_TERRAIN_TYPE_WATER, chunk.Cells[0].TerrainType)
+
+	_, err = service.GenerateChunk(session.WithWorldID(context.Background(), uuid.PgtypeToString(pgtype.UUID{Bytes: [16]byte{8}, Valid: true})), 0, 0)
+	assert.Error(t, err, "a world without noise cannot generate")
+}
+
 func TestService_GetOrCreateChunk(t *testing.T) {
 	cleanup := testutil.SetupTest(t, testutil.DefaultTestConfig())
 	defer cleanup()
@@ -607,7 +898,7 @@ func TestService_GetOrCreateChunk(t *testing.T) {
 			validateChunk: func(t *testing.T, chunk *chunkV1.ChunkData) {
 				assert.Equal(t, int32(1), chunk.ChunkX)
 				assert.Equal(t, int32(1), chunk.ChunkY)
-				assert.Equal(t, int64(54321), chunk.Seed)
+				assert.Equal(t, int64(12345), chunk.Seed, "the seed of the world's noise")
 				assert.Len(t, chunk.ResourceNodes, 1)
 				assert.Equal(t, "Coal", chunk.ResourceNodes[0].ResourceNodeType.Name)
 			},
@@ -786,7 +1077,7 @@ func TestService_GetTerrainType(t *testing.T) {
 
 			service := NewService(db, noise, world, resources, logger)
 
-			terrainType := service.getTerrainType(tt.x, tt.y)
+			terrainType := service.getTerrainType(noise, tt.x, tt.y)
 			assert.Equal(t, tt.expectedTerrain, terrainType)
 		})
 	}
@@ -932,7 +1223,7 @@ func TestService_GetChunksInRadius(t *testing.T) {
 					coords[key] = true
 					
 					// Verify Manhattan distance is <= 2
-					distance := abs(chunk.ChunkX-0) + abs(chunk.ChunkY-0)
+					distance := geometry.Abs(chunk.ChunkX-0) + geometry.Abs(chunk.ChunkY-0)
 					assert.LessOrEqual(t, distance, int32(2))
 				}
 				
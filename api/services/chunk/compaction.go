@@ -0,0 +1,118 @@
+package chunk
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/alerting"
+	"github.com/VoidMesh/api/api/internal/chunkdata"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/proto"
+)
+
+// CompactionConfig controls when chunk deltas are folded back into the chunk blob
+type CompactionConfig struct {
+	Interval  time.Duration // Time between compaction passes
+	MinDeltas int32         // Compact chunks with at least this many deltas...
+	MaxAge    time.Duration // ...or whose oldest delta is at least this old
+	BatchSize int32         // Maximum chunks compacted per pass
+}
+
+// DefaultCompactionConfig compacts busy chunks every minute and quiet ones within an hour
+func DefaultCompactionConfig() CompactionConfig {
+	return CompactionConfig{
+		Interval:  time.Minute,
+		MinDeltas: 64,
+		MaxAge:    time.Hour,
+		BatchSize: 100,
+	}
+}
+
+// CompactDeltas folds pending deltas into the base blob of up to BatchSize chunks and
+// returns how many chunks were compacted. A chunk that fails is skipped until the next pass.
+func (s *Service) CompactDeltas(ctx context.Context, config CompactionConfig) (int, error) {
+	chunks, err := s.db.ListCompactableChunks(ctx, db.ListCompactableChunksParams{
+		MinDeltas:     config.MinDeltas,
+		CreatedBefore: pgtype.Timestamp{Time: s.clock.Now().Add(-config.MaxAge), Valid: true},
+		MaxChunks:     config.BatchSize,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list compactable chunks: %w", err)
+	}
+
+	compacted := 0
+	for _, c := range chunks {
+		if err := s.compactChunk(ctx, c.WorldID, c.ChunkX, c.ChunkY); err != nil {
+			s.logger.Warn("Failed to compact chunk deltas", "chunk_x", c.ChunkX, "chunk_y", c.ChunkY, "delta_count", c.DeltaCount, "error", err)
+			continue
+		}
+		compacted++
+	}
+
+	if compacted > 0 {
+		s.logger.Debug("Compacted chunk deltas", "chunks", compacted)
+	}
+	return compacted, nil
+}
+
+// compactChunk rewrites a chunk blob with its deltas applied and removes those deltas
+func (s *Service) compactChunk(ctx context.Context, worldID pgtype.UUID, chunkX, chunkY int32) error {
+	dbChunk, err := s.db.GetChunk(ctx, db.GetChunkParams{WorldID: worldID, ChunkX: chunkX, ChunkY: chunkY})
+	if err != nil {
+		return fmt.Errorf("failed to get chunk: %w", err)
+	}
+	chunk, err := chunkdata.Decode(dbChunk.ChunkData, chunkX, chunkY)
+	if err != nil {
+		if chunk, err = s.repairChunk(ctx, worldID, chunkX, chunkY, err); err != nil {
+			return err
+		}
+	}
+
+	deltas, err := s.db.ListChunkDeltas(ctx, db.ListChunkDeltasParams{WorldID: worldID, ChunkX: chunkX, ChunkY: chunkY})
+	if err != nil {
+		return fmt.Errorf("failed to list chunk deltas: %w", err)
+	}
+	if len(deltas) == 0 {
+		return nil
+	}
+	if err := s.fillCellMetadata(ctx, worldID, chunk); err != nil {
+		return err
+	}
+	s.overlayDeltas(chunk, deltas)
+
+	data, err := proto.Marshal(chunk)
+	if err != nil {
+		return fmt.Errorf("failed to serialize chunk data: %w", err)
+	}
+	ids := make([]int64, len(deltas))
+	for i, delta := range deltas {
+		ids[i] = delta.ID
+	}
+	return s.db.CompactChunk(ctx, db.UpdateChunkDataParams{
+		WorldID:     worldID,
+		ChunkX:      chunkX,
+		ChunkY:      chunkY,
+		ChunkData:   data,
+		Passability: chunkdata.NewPassability(chunk.Cells),
+	}, ids)
+}
+
+// RunCompaction compacts chunk deltas every config.Interval until ctx is cancelled
+func (s *Service) RunCompaction(ctx context.Context, config CompactionConfig) {
+	s.logger.Info("Chunk delta compaction started", "interval", config.Interval)
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("Chunk delta compaction stopped")
+			return
+		case <-s.clock.After(config.Interval):
+		}
+
+		if _, err := s.CompactDeltas(ctx, config); err != nil {
+			s.logger.Error("Chunk delta compaction failed", "error", err)
+			alerting.ReportJobError("chunk_compaction", err)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package chunk
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/testutil"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_CompactDeltas(t *testing.T) {
+	cleanup := testutil.SetupTest(t, testutil.DefaultTestConfig())
+	defer cleanup()
+
+	database := NewMockDatabase()
+	service := NewService(database, NewMockNoiseGenerator(12345), NewMockWorldService(), NewMockResourceNodeIntegration(), NewMockLogger())
+	fake := clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	service.SetClock(fake)
+	ctx := context.Background()
+	worldID := NewMockWorldService().defaultWorld.ID
+	config := CompactionConfig{MinDeltas: 3, MaxAge: time.Hour, BatchSize: 10}
+
+	// Chunk (0,0) gets enough edits to compact; chunk (1,0) a single recent one
+	_, err := service.GetOrCreateChunk(ctx, 0, 0)
+	require.NoError(t, err)
+	_, err = service.GetOrCreateChunk(ctx, 1, 0)
+	require.NoError(t, err)
+	for x := int32(0); x < 3; x++ {
+		require.NoError(t, service.ModifyCell(ctx, CellEdit{WorldID: worldID, X: x, Y: 0, TerrainType: chunkV1.TerrainType_TERRAIN_TYPE_DIRT}))
+	}
+	require.NoError(t, service.ModifyCell(ctx, CellEdit{WorldID: worldID, X: ChunkSize, Y: 0, TerrainType: chunkV1.TerrainType_TERRAIN_TYPE_DIRT}))
+
+	compacted, err := service.CompactDeltas(ctx, config)
+	require.NoError(t, err)
+	assert.Equal(t, 1, compacted)
+	require.Len(t, database.deltas, 1, "the quiet chunk keeps its delta")
+
+	// The compacted blob holds the edits without any deltas left to apply
+	chunk, err := service.GetOrCreateChunk(ctx, 0, 0)
+	require.NoError(t, err)
+	for x := 0; x < 3; x++ {
+		assert.Equal(t, chunkV1.TerrainType_TERRAIN_TYPE_DIRT, chunk.Cells[x].TerrainType)
+	}
+
+	// Once its delta is old enough, the quiet chunk is compacted too
+	fake.Advance(time.Hour)
+	compacted, err = service.CompactDeltas(ctx, config)
+	require.NoError(t, err)
+	assert.Equal(t, 1, compacted)
+	assert.Empty(t, database.deltas)
+
+	terrain, err := service.GetCell(ctx, worldID, ChunkSize, 0)
+	require.NoError(t, err)
+	assert.Equal(t, chunkV1.TerrainType_TERRAIN_TYPE_DIRT, terrain)
+	assert.Equal(t, 2, database.GetCreateCallCount(), "compaction updates blobs in place")
+}
+
+func TestService_RunCompaction(t *testing.T) {
+	cleanup := testutil.SetupTest(t, testutil.DefaultTestConfig())
+	defer cleanup()
+
+	database := NewMockDatabase()
+	service := NewService(database, NewMockNoiseGenerator(12345), NewMockWorldService(), NewMockResourceNodeIntegration(), NewMockLogger())
+	worldID := NewMockWorldService().defaultWorld.ID
+	fake := clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	service.SetClock(fake)
+	_, err := service.GetOrCreateChunk(context.Background(), 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, service.ModifyCell(context.Background(), CellEdit{WorldID: worldID, X: 1, Y: 1, TerrainType: chunkV1.TerrainType_TERRAIN_TYPE_DIRT}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		service.RunCompaction(ctx, CompactionConfig{Interval: time.Minute, MinDeltas: 1, MaxAge: time.Hour, BatchSize: 10})
+		close(done)
+	}()
+
+	require.Eventually(t, func() bool { return fake.Waiters() == 1 }, time.Second, time.Millisecond)
+	fake.Advance(time.Minute)
+	require.Eventually(t, func() bool { return fake.Waiters() == 1 }, time.Second, time.Millisecond)
+	cancel()
+	<-done
+	assert.Empty(t, database.deltas)
+}
@@ -0,0 +1,102 @@
+package chunk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/geometry"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// CellEdit is a terrain change to a single cell in world coordinates
+type CellEdit struct {
+	WorldID             pgtype.UUID
+	X                   int32
+	Y                   int32
+	TerrainType         chunkV1.TerrainType
+	PreviousTerrainType chunkV1.TerrainType
+	CharacterID         pgtype.UUID // NULL for system edits
+}
+
+// GetCell returns the current terrain of a cell in the given world, generating its chunk
+// if needed
+func (s *Service) GetCell(ctx context.Context, worldID pgtype.UUID, x, y int32) (chunkV1.TerrainType, error) {
+	chunkX, chunkY, index := geometry.CellLocation(geometry.Point{X: x, Y: y})
+	chunk, err := s.GetOrCreateChunkInWorld(ctx, worldID, chunkX, chunkY)
+	if err != nil {
+		return chunkV1.TerrainType_TERRAIN_TYPE_UNSPECIFIED, err
+	}
+	if int(index) >= len(chunk.Cells) {
+		return chunkV1.TerrainType_TERRAIN_TYPE_UNSPECIFIED, fmt.Errorf("chunk (%d, %d) has %d cells, expected %d", chunkX, chunkY, len(chunk.Cells), ChunkSize*ChunkSize)
+	}
+	return chunk.Cells[index].TerrainType, nil
+}
+
+// ModifyCell records a terrain edit as a chunk delta. The cell's chunk must already
+// exist; the edit is applied whenever the chunk is loaded from then on.
+func (s *Service) ModifyCell(ctx context.Context, edit CellEdit) error {
+	if !edit.WorldID.Valid {
+		return fmt.Errorf("cell edit at (%d, %d) has no world", edit.X, edit.Y)
+	}
+
+	chunkX, chunkY, index := geometry.CellLocation(geometry.Point{X: edit.X, Y: edit.Y})
+	delta, err := s.db.CreateChunkDelta(ctx, db.CreateChunkDeltaParams{
+		WorldID:             edit.WorldID,
+		ChunkX:              chunkX,
+		ChunkY:              chunkY,
+		CellIndex:           index,
+		TerrainType:         int32(edit.TerrainType),
+		PreviousTerrainType: int32(edit.PreviousTerrainType),
+		CharacterID:         edit.CharacterID,
+		CreatedAt:           pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store chunk delta: %w", err)
+	}
+
+	s.logger.Debug("Stored chunk delta",
+		"delta_id", delta.ID,
+		"chunk_x", chunkX,
+		"chunk_y", chunkY,
+		"cell_index", index,
+		"terrain_type", edit.TerrainType.String())
+	return nil
+}
+
+// applyDeltas overlays the stored edits for a chunk loaded from its generated blob
+func (s *Service) applyDeltas(ctx context.Context, chunk *chunkV1.ChunkData) error {
+	worldID, err := s.worldID(ctx)
+	if err != nil {
+		return err
+	}
+
+	deltas, err := s.db.ListChunkDeltas(ctx, db.ListChunkDeltasParams{
+		WorldID: worldID,
+		ChunkX:  chunk.ChunkX,
+		ChunkY:  chunk.ChunkY,
+	})
+	if err != nil {
+		return err
+	}
+
+	s.overlayDeltas(chunk, deltas)
+	return nil
+}
+
+// overlayDeltas sets each edited cell to its new terrain, keeping its elevation and
+// moisture; deltas must be in id order
+func (s *Service) overlayDeltas(chunk *chunkV1.ChunkData, deltas []db.ChunkDelta) {
+	for _, delta := range deltas {
+		if delta.CellIndex < 0 || int(delta.CellIndex) >= len(chunk.Cells) {
+			s.logger.Warn("Skipping chunk delta outside the chunk", "delta_id", delta.ID, "cell_index", delta.CellIndex)
+			continue
+		}
+		cell := &chunkV1.TerrainCell{TerrainType: chunkV1.TerrainType(delta.TerrainType)}
+		if previous := chunk.Cells[delta.CellIndex]; previous != nil {
+			cell.Elevation, cell.Moisture = previous.Elevation, previous.Moisture
+		}
+		chunk.Cells[delta.CellIndex] = cell
+	}
+}
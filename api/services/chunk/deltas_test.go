@@ -0,0 +1,41 @@
+package chunk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/VoidMesh/api/api/internal/testutil"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_ModifyCell_AppliedOnLoad(t *testing.T) {
+	cleanup := testutil.SetupTest(t, testutil.DefaultTestConfig())
+	defer cleanup()
+
+	database := NewMockDatabase()
+	service := NewService(database, NewMockNoiseGenerator(12345), NewMockWorldService(), NewMockResourceNodeIntegration(), NewMockLogger())
+	ctx := context.Background()
+	worldID := NewMockWorldService().defaultWorld.ID
+
+	before, err := service.GetCell(ctx, worldID, -3, 4)
+	require.NoError(t, err)
+	assert.Equal(t, chunkV1.TerrainType_TERRAIN_TYPE_GRASS, before)
+
+	require.NoError(t, service.ModifyCell(ctx, CellEdit{WorldID: worldID, X: -3, Y: 4, TerrainType: chunkV1.TerrainType_TERRAIN_TYPE_DIRT, PreviousTerrainType: before}))
+	require.NoError(t, service.ModifyCell(ctx, CellEdit{WorldID: worldID, X: -3, Y: 4, TerrainType: chunkV1.TerrainType_TERRAIN_TYPE_GRASS, PreviousTerrainType: chunkV1.TerrainType_TERRAIN_TYPE_DIRT}))
+	require.NoError(t, service.ModifyCell(ctx, CellEdit{WorldID: worldID, X: -2, Y: 4, TerrainType: chunkV1.TerrainType_TERRAIN_TYPE_DIRT, PreviousTerrainType: before}))
+
+	chunk, err := service.GetOrCreateChunk(ctx, -1, 0)
+	require.NoError(t, err)
+	_, _, index := geometry.CellLocation(geometry.Point{X: -3, Y: 4})
+	assert.Equal(t, chunkV1.TerrainType_TERRAIN_TYPE_GRASS, chunk.Cells[index].TerrainType, "later edits win")
+	assert.Equal(t, chunkV1.TerrainType_TERRAIN_TYPE_DIRT, chunk.Cells[index+1].TerrainType)
+	assert.Equal(t, 1, database.GetCreateCallCount(), "edits never rewrite the chunk blob")
+
+	database.SetShouldReturnError(true)
+	_, err = service.GetOrCreateChunk(ctx, -1, 0)
+	assert.Error(t, err)
+}
@@ -3,76 +3,136 @@ package chunk
 import (
 	"context"
 	"fmt"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/chunkdata"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/debugstats"
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/uuid"
 	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
-	"github.com/VoidMesh/api/api/services/noise"
 	"github.com/VoidMesh/api/api/services/world"
-	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/pgtype"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
-	ChunkSize = 32 // 32x32 cells per chunk
+	ChunkSize = chunkdata.Size // 32x32 cells per chunk
+
+	// Noise scales of the cell attributes; larger scales change more slowly across the map
+	ElevationScale = 100.0
+	DetailScale    = 20.0
+	MoistureScale  = 150.0
+
+	// moistureOffset moves moisture sampling far from elevation's, so the two fields are
+	// independent although they share the world's noise generator
+	moistureOffset = 1 << 20
+	// Moisture beyond these thresholds turns lowland grass to sand and upland dirt to grass
+	aridMoisture = -0.4
+	wetMoisture  = 0.4
 )
 
+var (
+	// pendingChunkQueues holds the coordinate queues of in-progress parallel fetches
+	pendingChunkQueues sync.Map
+	// generationsInFlight counts chunks currently being generated
+	generationsInFlight atomic.Int64
+)
+
+func init() {
+	debugstats.Register(debugstats.Queues, "chunk.generation_queue_depth", func() int64 {
+		var depth int64
+		pendingChunkQueues.Range(func(key, _ any) bool {
+			depth += int64(len(key.(chan [2]int32)))
+			return true
+		})
+		return depth
+	})
+	debugstats.Register(debugstats.Queues, "chunk.generations_in_flight", generationsInFlight.Load)
+}
+
 // Service provides chunk generation and management operations.
 type Service struct {
 	db                      DatabaseInterface
-	noiseGen                NoiseGeneratorInterface
+	worldNoise              WorldNoiseInterface
 	worldService            WorldServiceInterface
 	resourceNodeIntegration ResourceNodeIntegrationInterface
 	logger                  LoggerInterface
+	clock                   clock.Clock
 	chunkSize               int32
 }
 
 // NewService creates a new chunk service with dependency injection.
 func NewService(
 	db DatabaseInterface,
-	noiseGen NoiseGeneratorInterface,
+	worldNoise WorldNoiseInterface,
 	worldService WorldServiceInterface,
 	resourceNodeIntegration ResourceNodeIntegrationInterface,
 	logger LoggerInterface,
 ) *Service {
 	componentLogger := logger.With("component", "chunk-service")
 	componentLogger.Debug("Creating new chunk service", "chunk_size", ChunkSize)
-	
+
 	return &Service{
 		db:                      db,
-		noiseGen:                noiseGen,
+		worldNoise:              worldNoise,
 		worldService:            worldService,
 		resourceNodeIntegration: resourceNodeIntegration,
 		logger:                  componentLogger,
+		clock:                   clock.New(),
 		chunkSize:               ChunkSize,
 	}
 }
 
+// SetClock replaces the clock used for timestamps (for simulation tests)
+func (s *Service) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
 // NewServiceWithPool creates a service with concrete implementations (convenience constructor for production use).
 func NewServiceWithPool(
-	pool *pgxpool.Pool,
+	pool storage.Pool,
 	worldService *world.Service,
-	noiseGen *noise.Generator,
+	seeds *world.Seeds,
 ) *Service {
 	logger := &DefaultLoggerWrapper{}
-	
+
 	// Create the resource node integration with the concrete types
 	// This will be refactored when we get to the resource node service
-	resourceNodeIntegration := NewResourceNodeGeneratorIntegration(pool, noiseGen, worldService)
-	
+	resourceNodeIntegration := NewResourceNodeGeneratorIntegration(pool, seeds, worldService)
+
 	return NewService(
 		NewDatabaseWrapper(pool),
-		NewNoiseGeneratorAdapter(noiseGen),
+		NewWorldNoiseAdapter(seeds),
 		NewWorldServiceAdapter(worldService),
 		resourceNodeIntegration,
 		logger,
 	)
 }
 
-// GenerateChunk creates a new chunk using procedural generation
+// GenerateChunk creates a new chunk of the world ctx reads and writes using procedural
+// generation
 func (s *Service) GenerateChunk(ctx context.Context, chunkX, chunkY int32) (*chunkV1.ChunkData, error) {
+	worldID, err := s.worldID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.generateChunk(ctx, worldID, chunkX, chunkY)
+}
+
+// generateChunk creates a new chunk of a world from the world's terrain noise
+func (s *Service) generateChunk(ctx context.Context, worldID pgtype.UUID, chunkX, chunkY int32) (*chunkV1.ChunkData, error) {
 	logger := s.logger.With("chunk_x", chunkX, "chunk_y", chunkY)
+	gen, err := s.worldNoise.Noise(ctx, worldID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get world noise: %w", err)
+	}
 	logger.Debug("Starting chunk generation")
 
 	start := time.Now()
@@ -83,20 +143,21 @@ func (s *Service) GenerateChunk(ctx context.Context, chunkX, chunkY int32) (*chu
 	logger.Debug("Generating terrain cells using noise")
 	terrainCounts := make(map[chunkV1.TerrainType]int)
 	for y := int32(0); y < ChunkSize; y++ {
+		// Stop between rows once the caller has given up
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		for x := int32(0); x < ChunkSize; x++ {
 			// Calculate world coordinates
 			worldX := chunkX*ChunkSize + x
 			worldY := chunkY*ChunkSize + y
 
-			// Generate terrain type based on noise
-			terrainType := s.getTerrainType(worldX, worldY)
-			terrainCounts[terrainType]++
+			// Generate the cell's terrain from its elevation and moisture
+			cell := s.generateCell(gen, worldX, worldY)
+			terrainCounts[cell.TerrainType]++
 
 			// Store in row-major order
-			index := y*ChunkSize + x
-			cells[index] = &chunkV1.TerrainCell{
-				TerrainType: terrainType,
-			}
+			cells[y*ChunkSize+x] = cell
 		}
 	}
 
@@ -105,47 +166,89 @@ func (s *Service) GenerateChunk(ctx context.Context, chunkX, chunkY int32) (*chu
 	duration := time.Since(start)
 	logger.Info("Chunk generation completed", "duration", duration, "cells_generated", len(cells))
 
-	// Get world seed for the default world
-	defaultWorld, err := s.worldService.GetDefaultWorld(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get default world: %w", err)
-	}
-
 	chunk := &chunkV1.ChunkData{
-		ChunkX:      chunkX,
-		ChunkY:      chunkY,
-		Cells:       cells,
-		Seed:        defaultWorld.Seed,
-		GeneratedAt: timestamppb.New(time.Now()),
+		ChunkX:       chunkX,
+		ChunkY:       chunkY,
+		Cells:        cells,
+		Seed:         gen.GetSeed(),
+		GeneratedAt:  timestamppb.New(s.clock.Now()),
+		CellMetadata: true,
 	}
 
 	return chunk, nil
 }
 
 // getTerrainType determines terrain type based on noise values
-func (s *Service) getTerrainType(x, y int32) chunkV1.TerrainType {
+func (s *Service) getTerrainType(gen NoiseGeneratorInterface, x, y int32) chunkV1.TerrainType {
+	return s.generateCell(gen, x, y).TerrainType
+}
+
+// generateCell generates a cell's elevation and moisture, and the terrain (biome) they make
+func (s *Service) generateCell(gen NoiseGeneratorInterface, x, y int32) *chunkV1.TerrainCell {
+	elevation, moisture := cellNoise(gen, x, y)
+	return &chunkV1.TerrainCell{
+		TerrainType: terrainFor(elevation, moisture),
+		Elevation:   chunkdata.Quantize(elevation),
+		Moisture:    chunkdata.Quantize(moisture),
+	}
+}
+
+// cellNoise returns a cell's elevation and moisture, each in [-1, 1]
+func cellNoise(gen NoiseGeneratorInterface, x, y int32) (elevation, moisture float64) {
 	// Use different scales for different terrain features
-	elevation := s.noiseGen.GetTerrainNoise(int(x), int(y), 100.0) // Large scale elevation
-	detail := s.noiseGen.GetTerrainNoise(int(x), int(y), 20.0)     // Fine detail
+	large := gen.GetTerrainNoise(int(x), int(y), ElevationScale) // Large scale elevation
+	detail := gen.GetTerrainNoise(int(x), int(y), DetailScale)   // Fine detail
 
 	// Combine noise values
-	combined := elevation*0.7 + detail*0.3
+	elevation = large*0.7 + detail*0.3
+	moisture = gen.GetTerrainNoise(int(x)+moistureOffset, int(y)+moistureOffset, MoistureScale)
+	return elevation, moisture
+}
 
-	// Determine terrain type based on combined noise
+// terrainFor selects the terrain of a cell: elevation sets the band, and moisture dries
+// lowland grass to sand or keeps upland dirt green
+func terrainFor(elevation, moisture float64) chunkV1.TerrainType {
 	switch {
-	case combined < -0.3:
+	case elevation < -0.3:
 		return chunkV1.TerrainType_TERRAIN_TYPE_WATER
-	case combined < -0.1:
+	case elevation < -0.1:
 		return chunkV1.TerrainType_TERRAIN_TYPE_SAND
-	case combined < 0.2:
+	case elevation < 0.2:
+		if moisture < aridMoisture {
+			return chunkV1.TerrainType_TERRAIN_TYPE_SAND
+		}
 		return chunkV1.TerrainType_TERRAIN_TYPE_GRASS
-	case combined < 0.5:
+	case elevation < 0.5:
+		if moisture > wetMoisture {
+			return chunkV1.TerrainType_TERRAIN_TYPE_GRASS
+		}
 		return chunkV1.TerrainType_TERRAIN_TYPE_DIRT
 	default:
 		return chunkV1.TerrainType_TERRAIN_TYPE_STONE
 	}
 }
 
+// fillCellMetadata gives the cells of a chunk without elevation and moisture (authored
+// templates and chunks stored before cells had them) the values generation would, keeping
+// their terrain. Both are deterministic from the seed of the chunk's world.
+func (s *Service) fillCellMetadata(ctx context.Context, worldID pgtype.UUID, chunk *chunkV1.ChunkData) error {
+	if chunk.CellMetadata {
+		return nil
+	}
+	gen, err := s.worldNoise.Noise(ctx, worldID)
+	if err != nil {
+		return fmt.Errorf("failed to get world noise: %w", err)
+	}
+	for i, cell := range chunk.Cells {
+		p := geometry.CellAt(chunk.ChunkX, chunk.ChunkY, int32(i))
+		elevation, moisture := cellNoise(gen, p.X, p.Y)
+		cell.Elevation = chunkdata.Quantize(elevation)
+		cell.Moisture = chunkdata.Quantize(moisture)
+	}
+	chunk.CellMetadata = true
+	return nil
+}
+
 // GetOrCreateChunk retrieves a chunk from database or generates it if it doesn't exist
 func (s *Service) GetOrCreateChunk(ctx context.Context, chunkX, chunkY int32) (*chunkV1.ChunkData, error) {
 	logger := s.logger.With("chunk_x", chunkX, "chunk_y", chunkY)
@@ -153,17 +256,38 @@ func (s *Service) GetOrCreateChunk(ctx context.Context, chunkX, chunkY int32) (*
 	// Try to get chunk from database first
 	chunk, err := s.getChunkFromDB(ctx, chunkX, chunkY)
 	if err == nil {
-		// Attach resources to existing chunk
-		err = s.resourceNodeIntegration.AttachResourceNodesToChunk(ctx, chunk)
+		// Overlay terrain edits made since the chunk was generated
+		if err := s.applyDeltas(ctx, chunk); err != nil {
+			return nil, fmt.Errorf("failed to apply chunk deltas: %w", err)
+		}
+
+		// Attach resources to existing chunk. Templated chunks only get the resources
+		// stored for them: an authored chunk without resources must stay without.
+		if _, templated := templateChunk(chunkX, chunkY); templated {
+			err = s.resourceNodeIntegration.AttachResourceNodesToChunks(ctx, []*chunkV1.ChunkData{chunk})
+		} else {
+			err = s.resourceNodeIntegration.AttachResourceNodesToChunk(ctx, chunk)
+		}
 		if err != nil {
 			// Don't fail chunk retrieval if resource attachment fails
 			logger.Error("Failed to attach resources to existing chunk", "error", err)
 		}
 		return chunk, nil
 	}
+	// A cancelled read is not a missing chunk
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
 
 	// Chunk doesn't exist, generate it
-	generatedChunk, err := s.GenerateChunk(ctx, chunkX, chunkY)
+	generationsInFlight.Add(1)
+	defer generationsInFlight.Add(-1)
+
+	worldID, err := s.worldID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	generatedChunk, templateResources, templated, err := s.newChunk(ctx, worldID, chunkX, chunkY)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate chunk: %w", err)
 	}
@@ -175,8 +299,13 @@ func (s *Service) GetOrCreateChunk(ctx context.Context, chunkX, chunkY int32) (*
 	}
 
 	// Generate and attach resources after chunk is saved
-	logger.Debug("Generating resources for new chunk")
-	err = s.resourceNodeIntegration.GenerateAndAttachResourceNodes(ctx, generatedChunk)
+	if templated {
+		logger.Debug("Storing template resources for new chunk", "count", len(templateResources))
+		err = s.resourceNodeIntegration.StoreAndAttachResourceNodes(ctx, generatedChunk, templateResources)
+	} else {
+		logger.Debug("Generating resources for new chunk")
+		err = s.resourceNodeIntegration.GenerateAndAttachResourceNodes(ctx, generatedChunk)
+	}
 	if err != nil {
 		logger.Error("Failed to generate resources for chunk", "error", err)
 		// Don't fail chunk generation if resource generation fails
@@ -185,39 +314,68 @@ func (s *Service) GetOrCreateChunk(ctx context.Context, chunkX, chunkY int32) (*
 	return generatedChunk, nil
 }
 
+// GetOrCreateChunkInWorld loads a chunk of an explicit world, whatever world ctx is bound
+// to. Calls made for a character go through it with the character's world, so they never
+// fall back to the default world.
+func (s *Service) GetOrCreateChunkInWorld(ctx context.Context, worldID pgtype.UUID, chunkX, chunkY int32) (*chunkV1.ChunkData, error) {
+	if !worldID.Valid {
+		return nil, fmt.Errorf("chunk (%d, %d) requested without a world", chunkX, chunkY)
+	}
+	return s.GetOrCreateChunk(session.WithWorldID(ctx, uuid.PgtypeToString(worldID)), chunkX, chunkY)
+}
+
+// worldID returns the world whose chunks ctx reads and writes: the session's world, or
+// the default world for contexts not acting for a player, such as pregeneration
+func (s *Service) worldID(ctx context.Context) (pgtype.UUID, error) {
+	worldID, err := session.WorldUUIDFromContext(ctx)
+	if err != nil {
+		return pgtype.UUID{}, fmt.Errorf("invalid session world: %w", err)
+	}
+	if worldID.Valid {
+		return worldID, nil
+	}
+	defaultWorld, err := s.worldService.GetDefaultWorld(ctx)
+	if err != nil {
+		return pgtype.UUID{}, fmt.Errorf("failed to get default world: %w", err)
+	}
+	return defaultWorld.ID, nil
+}
+
 // getChunkFromDB retrieves a chunk from the database
 func (s *Service) getChunkFromDB(ctx context.Context, chunkX, chunkY int32) (*chunkV1.ChunkData, error) {
-	// Get default world to get the WorldID
-	defaultWorld, err := s.worldService.GetDefaultWorld(ctx)
+	worldID, err := s.worldID(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get default world: %w", err)
+		return nil, err
 	}
 
 	dbChunk, err := s.db.GetChunk(ctx, db.GetChunkParams{
-		WorldID: defaultWorld.ID,
+		WorldID: worldID,
 		ChunkX:  chunkX,
 		ChunkY:  chunkY,
 	})
 	if err != nil {
 		return nil, err
 	}
+	s.recordAccess(worldID, chunkX, chunkY)
 
-	// Deserialize protobuf data
-	var chunkData chunkV1.ChunkData
-	err = proto.Unmarshal(dbChunk.ChunkData, &chunkData)
+	chunkData, err := chunkdata.Decode(dbChunk.ChunkData, chunkX, chunkY)
 	if err != nil {
-		return nil, fmt.Errorf("failed to deserialize chunk data: %w", err)
+		// A corrupt blob is replaced rather than failing every read of the chunk
+		return s.repairChunk(ctx, worldID, chunkX, chunkY, err)
+	}
+	// Compaction stores the filled-in values the next time it rewrites the blob
+	if err := s.fillCellMetadata(ctx, worldID, chunkData); err != nil {
+		return nil, err
 	}
 
-	return &chunkData, nil
+	return chunkData, nil
 }
 
 // saveChunkToDB saves a chunk to the database
 func (s *Service) saveChunkToDB(ctx context.Context, chunk *chunkV1.ChunkData) error {
-	// Get default world to get the WorldID
-	defaultWorld, err := s.worldService.GetDefaultWorld(ctx)
+	worldID, err := s.worldID(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to get default world: %w", err)
+		return err
 	}
 
 	// Serialize protobuf data
@@ -227,13 +385,18 @@ func (s *Service) saveChunkToDB(ctx context.Context, chunk *chunkV1.ChunkData) e
 	}
 
 	_, err = s.db.CreateChunk(ctx, db.CreateChunkParams{
-		WorldID:   defaultWorld.ID,
-		ChunkX:    chunk.ChunkX,
-		ChunkY:    chunk.ChunkY,
-		ChunkData: data,
+		WorldID:     worldID,
+		ChunkX:      chunk.ChunkX,
+		ChunkY:      chunk.ChunkY,
+		ChunkData:   data,
+		Passability: chunkdata.NewPassability(chunk.Cells),
 	})
-
-	return err
+	if err != nil {
+		return err
+	}
+	// Generating a chunk is its first read
+	s.recordAccess(worldID, chunk.ChunkX, chunk.ChunkY)
+	return nil
 }
 
 // GetChunksInRange retrieves multiple chunks in a rectangular area
@@ -256,7 +419,7 @@ func (s *Service) GetChunksInRadius(ctx context.Context, centerX, centerY, radiu
 	for x := centerX - radius; x <= centerX+radius; x++ {
 		for y := centerY - radius; y <= centerY+radius; y++ {
 			// Check if chunk is within radius (Manhattan distance for simplicity)
-			distance := abs(x-centerX) + abs(y-centerY)
+			distance := geometry.Abs(x-centerX) + geometry.Abs(y-centerY)
 			if distance <= radius {
 				coordinates = append(coordinates, [2]int32{x, y})
 			}
@@ -266,13 +429,6 @@ func (s *Service) GetChunksInRadius(ctx context.Context, centerX, centerY, radiu
 	return s.getChunksParallel(ctx, coordinates)
 }
 
-func abs(x int32) int32 {
-	if x < 0 {
-		return -x
-	}
-	return x
-}
-
 // getChunksParallel processes a list of chunk coordinates in parallel
 func (s *Service) getChunksParallel(ctx context.Context, coordinates [][2]int32) ([]*chunkV1.ChunkData, error) {
 	if len(coordinates) == 0 {
@@ -291,6 +447,9 @@ func (s *Service) getChunksParallel(ctx context.Context, coordinates [][2]int32)
 	errChan := make(chan error, workerCount)
 	doneChan := make(chan struct{})
 
+	pendingChunkQueues.Store(coordChan, struct{}{})
+	defer pendingChunkQueues.Delete(coordChan)
+
 	// Start worker goroutines
 	for i := 0; i < workerCount; i++ {
 		go s.chunkWorker(ctx, coordChan, resultChan, errChan, doneChan)
@@ -2,13 +2,20 @@ package chunk
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/chunkdata"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/VoidMesh/api/api/internal/outbox"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/uuid"
 	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
-	"github.com/VoidMesh/api/api/services/noise"
+	resourceNodeV1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
 	"github.com/VoidMesh/api/api/services/world"
 	"github.com/jackc/pgx/v5/pgtype"
-	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 // DatabaseInterface abstracts database operations for the chunk service.
@@ -16,16 +23,28 @@ type DatabaseInterface interface {
 	GetChunk(ctx context.Context, arg db.GetChunkParams) (db.Chunk, error)
 	CreateChunk(ctx context.Context, arg db.CreateChunkParams) (db.Chunk, error)
 	ChunkExists(ctx context.Context, arg db.ChunkExistsParams) (bool, error)
+	CreateChunkDelta(ctx context.Context, arg db.CreateChunkDeltaParams) (db.ChunkDelta, error)
+	ListChunkDeltas(ctx context.Context, arg db.ListChunkDeltasParams) ([]db.ChunkDelta, error)
+	ListCompactableChunks(ctx context.Context, arg db.ListCompactableChunksParams) ([]db.ListCompactableChunksRow, error)
+	CompactChunk(ctx context.Context, arg db.UpdateChunkDataParams, deltaIDs []int64) error
+	QuarantineChunk(ctx context.Context, arg db.QuarantineChunkParams) error
+	GetChunkPassability(ctx context.Context, arg db.GetChunkPassabilityParams) ([]byte, error)
+	BackfillChunkPassability(ctx context.Context, arg db.BackfillChunkPassabilityParams) error
+	RecordChunkAccesses(ctx context.Context, arg db.RecordChunkAccessesParams) error
+	ListColdChunks(ctx context.Context, arg db.ListColdChunksParams) ([]db.ListColdChunksRow, error)
+	ListMostAccessedChunks(ctx context.Context, arg db.ListMostAccessedChunksParams) ([]db.ListMostAccessedChunksRow, error)
 }
 
 // DatabaseWrapper implements DatabaseInterface using the actual database connection.
 type DatabaseWrapper struct {
+	pool    storage.Pool
 	queries *db.Queries
 }
 
 // NewDatabaseWrapper creates a new database wrapper with the given connection pool.
-func NewDatabaseWrapper(pool *pgxpool.Pool) DatabaseInterface {
+func NewDatabaseWrapper(pool storage.Pool) DatabaseInterface {
 	return &DatabaseWrapper{
+		pool:    pool,
 		queries: db.New(pool),
 	}
 }
@@ -34,20 +53,143 @@ func (d *DatabaseWrapper) GetChunk(ctx context.Context, arg db.GetChunkParams) (
 	return d.queries.GetChunk(ctx, arg)
 }
 
+// CreateChunk stores a generated chunk and enqueues a ChunkGenerated event in the same
+// transaction. The event credits the character acting in ctx (session.WithCharacterID), if any.
 func (d *DatabaseWrapper) CreateChunk(ctx context.Context, arg db.CreateChunkParams) (db.Chunk, error) {
-	return d.queries.CreateChunk(ctx, arg)
+	var chunk db.Chunk
+	err := outbox.InTx(ctx, d.pool, func(q *db.Queries) error {
+		var err error
+		chunk, err = q.CreateChunk(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		worldID := uuid.PgtypeToString(chunk.WorldID)
+		aggregateID := fmt.Sprintf("%s:%d:%d", worldID, chunk.ChunkX, chunk.ChunkY)
+		characterID, _ := session.CharacterIDFromContext(ctx)
+		return outbox.Enqueue(ctx, q, events.ChunkGenerated, aggregateID,
+			fmt.Sprintf("%s:%s", events.ChunkGenerated, aggregateID),
+			events.ChunkGeneratedPayload{
+				WorldID:     worldID,
+				ChunkX:      chunk.ChunkX,
+				ChunkY:      chunk.ChunkY,
+				CharacterID: characterID,
+			})
+	})
+	return chunk, err
 }
 
 func (d *DatabaseWrapper) ChunkExists(ctx context.Context, arg db.ChunkExistsParams) (bool, error) {
 	return d.queries.ChunkExists(ctx, arg)
 }
 
+// CreateChunkDelta stores a cell edit, updates the cell's passability bit and enqueues a
+// TerrainModified event in the same transaction.
+func (d *DatabaseWrapper) CreateChunkDelta(ctx context.Context, arg db.CreateChunkDeltaParams) (db.ChunkDelta, error) {
+	var delta db.ChunkDelta
+	err := outbox.InTx(ctx, d.pool, func(q *db.Queries) error {
+		var err error
+		delta, err = q.CreateChunkDelta(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		passable := int32(0)
+		if chunkdata.Walkable(chunkV1.TerrainType(arg.TerrainType)) {
+			passable = 1
+		}
+		err = q.SetCellPassability(ctx, db.SetCellPassabilityParams{
+			WorldID:   arg.WorldID,
+			ChunkX:    arg.ChunkX,
+			ChunkY:    arg.ChunkY,
+			CellIndex: arg.CellIndex,
+			Passable:  passable,
+		})
+		if err != nil {
+			return err
+		}
+
+		worldID := uuid.PgtypeToString(delta.WorldID)
+		aggregateID := fmt.Sprintf("%s:%d:%d", worldID, delta.ChunkX, delta.ChunkY)
+		cell := geometry.CellAt(delta.ChunkX, delta.ChunkY, delta.CellIndex)
+		return outbox.Enqueue(ctx, q, events.TerrainModified, aggregateID,
+			fmt.Sprintf("%s:%d", events.TerrainModified, delta.ID),
+			events.TerrainModifiedPayload{
+				DeltaID:             delta.ID,
+				WorldID:             worldID,
+				CharacterID:         uuid.PgtypeToString(delta.CharacterID),
+				X:                   cell.X,
+				Y:                   cell.Y,
+				ChunkX:              delta.ChunkX,
+				ChunkY:              delta.ChunkY,
+				TerrainType:         delta.TerrainType,
+				PreviousTerrainType: delta.PreviousTerrainType,
+			})
+	})
+	return delta, err
+}
+
+func (d *DatabaseWrapper) ListChunkDeltas(ctx context.Context, arg db.ListChunkDeltasParams) ([]db.ChunkDelta, error) {
+	return d.queries.ListChunkDeltas(ctx, arg)
+}
+
+func (d *DatabaseWrapper) ListCompactableChunks(ctx context.Context, arg db.ListCompactableChunksParams) ([]db.ListCompactableChunksRow, error) {
+	return d.queries.ListCompactableChunks(ctx, arg)
+}
+
+// CompactChunk rewrites the chunk blob and deletes the deltas folded into it in one transaction.
+// Deltas are deleted by ID so edits stored during compaction are kept.
+func (d *DatabaseWrapper) CompactChunk(ctx context.Context, arg db.UpdateChunkDataParams, deltaIDs []int64) error {
+	return outbox.InTx(ctx, d.pool, func(q *db.Queries) error {
+		if err := q.UpdateChunkData(ctx, arg); err != nil {
+			return err
+		}
+		deleted, err := q.DeleteChunkDeltas(ctx, deltaIDs)
+		if err != nil {
+			return err
+		}
+		if deleted != int64(len(deltaIDs)) {
+			return fmt.Errorf("deleted %d of %d chunk deltas, chunk was compacted concurrently", deleted, len(deltaIDs))
+		}
+		return nil
+	})
+}
+
+func (d *DatabaseWrapper) QuarantineChunk(ctx context.Context, arg db.QuarantineChunkParams) error {
+	return d.queries.QuarantineChunk(ctx, arg)
+}
+
+func (d *DatabaseWrapper) GetChunkPassability(ctx context.Context, arg db.GetChunkPassabilityParams) ([]byte, error) {
+	return d.queries.GetChunkPassability(ctx, arg)
+}
+
+func (d *DatabaseWrapper) BackfillChunkPassability(ctx context.Context, arg db.BackfillChunkPassabilityParams) error {
+	return d.queries.BackfillChunkPassability(ctx, arg)
+}
+
+func (d *DatabaseWrapper) RecordChunkAccesses(ctx context.Context, arg db.RecordChunkAccessesParams) error {
+	return d.queries.RecordChunkAccesses(ctx, arg)
+}
+
+func (d *DatabaseWrapper) ListColdChunks(ctx context.Context, arg db.ListColdChunksParams) ([]db.ListColdChunksRow, error) {
+	return d.queries.ListColdChunks(ctx, arg)
+}
+
+func (d *DatabaseWrapper) ListMostAccessedChunks(ctx context.Context, arg db.ListMostAccessedChunksParams) ([]db.ListMostAccessedChunksRow, error) {
+	return d.queries.ListMostAccessedChunks(ctx, arg)
+}
+
 // NoiseGeneratorInterface defines the interface for noise generation operations.
 type NoiseGeneratorInterface interface {
 	GetTerrainNoise(x, y int, scale float64) float64
 	GetSeed() int64
 }
 
+// WorldNoiseInterface resolves the terrain noise of each world, seeded from the world's seed.
+type WorldNoiseInterface interface {
+	Noise(ctx context.Context, worldID pgtype.UUID) (NoiseGeneratorInterface, error)
+}
+
 // WorldServiceInterface defines the interface for world service operations.
 type WorldServiceInterface interface {
 	GetDefaultWorld(ctx context.Context) (db.World, error)
@@ -67,26 +209,29 @@ type LoggerInterface interface {
 // ResourceNodeIntegrationInterface defines the interface for resource node integration.
 type ResourceNodeIntegrationInterface interface {
 	AttachResourceNodesToChunk(ctx context.Context, chunk *chunkV1.ChunkData) error
+	AttachResourceNodesToChunks(ctx context.Context, chunks []*chunkV1.ChunkData) error
 	GenerateAndAttachResourceNodes(ctx context.Context, chunk *chunkV1.ChunkData) error
+	StoreAndAttachResourceNodes(ctx context.Context, chunk *chunkV1.ChunkData, resources []*resourceNodeV1.ResourceNode) error
 }
 
-// Adapter types to implement interfaces for existing services
-
-// NoiseGeneratorAdapter adapts a noise.GeneratorInterface to our interface.
-type NoiseGeneratorAdapter struct {
-	generator noise.GeneratorInterface
+// TemplateSource provides authored chunks that replace procedural generation.
+type TemplateSource interface {
+	Chunk(chunkX, chunkY int32) (*chunkV1.ChunkData, bool)
 }
 
-func NewNoiseGeneratorAdapter(generator noise.GeneratorInterface) NoiseGeneratorInterface {
-	return &NoiseGeneratorAdapter{generator: generator}
+// Adapter types to implement interfaces for existing services
+
+// WorldNoiseAdapter adapts a world.Seeds cache to our interface.
+type WorldNoiseAdapter struct {
+	seeds *world.Seeds
 }
 
-func (n *NoiseGeneratorAdapter) GetTerrainNoise(x, y int, scale float64) float64 {
-	return n.generator.GetTerrainNoise(x, y, scale)
+func NewWorldNoiseAdapter(seeds *world.Seeds) WorldNoiseInterface {
+	return &WorldNoiseAdapter{seeds: seeds}
 }
 
-func (n *NoiseGeneratorAdapter) GetSeed() int64 {
-	return n.generator.GetSeed()
+func (w *WorldNoiseAdapter) Noise(ctx context.Context, worldID pgtype.UUID) (NoiseGeneratorInterface, error) {
+	return w.seeds.Noise(ctx, worldID)
 }
 
 // WorldServiceAdapter adapts a world.Service to our interface.
@@ -135,4 +280,4 @@ func (l *DefaultLoggerWrapper) Error(msg string, keysAndValues ...interface{}) {
 
 func (l *DefaultLoggerWrapper) With(keysAndValues ...interface{}) LoggerInterface {
 	return l
-}
\ No newline at end of file
+}
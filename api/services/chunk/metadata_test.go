@@ -0,0 +1,110 @@
+package chunk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/chunkdata"
+	"github.com/VoidMesh/api/api/internal/testutil"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestTerrainFor(t *testing.T) {
+	tests := []struct {
+		name      string
+		elevation float64
+		moisture  float64
+		expected  chunkV1.TerrainType
+	}{
+		{"deep water", -0.5, 0.9, chunkV1.TerrainType_TERRAIN_TYPE_WATER},
+		{"beach", -0.2, 0.9, chunkV1.TerrainType_TERRAIN_TYPE_SAND},
+		{"lowland grass", 0.1, 0, chunkV1.TerrainType_TERRAIN_TYPE_GRASS},
+		{"arid lowland", 0.1, -0.5, chunkV1.TerrainType_TERRAIN_TYPE_SAND},
+		{"upland dirt", 0.3, 0, chunkV1.TerrainType_TERRAIN_TYPE_DIRT},
+		{"wet upland", 0.3, 0.5, chunkV1.TerrainType_TERRAIN_TYPE_GRASS},
+		{"mountain", 0.7, 0.9, chunkV1.TerrainType_TERRAIN_TYPE_STONE},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, terrainFor(tt.elevation, tt.moisture))
+		})
+	}
+}
+
+func TestService_GenerateChunk_CellMetadata(t *testing.T) {
+	cleanup := testutil.SetupTest(t, testutil.DefaultTestConfig())
+	defer cleanup()
+
+	noise := NewMockNoiseGenerator(12345)
+	noise.SetNoiseValue(0, 0, ElevationScale, 0.1)
+	noise.SetNoiseValue(moistureOffset, moistureOffset, MoistureScale, -0.6)
+	noise.SetNoiseValue(1, 0, ElevationScale, 0.4)
+	noise.SetNoiseValue(1+moistureOffset, moistureOffset, MoistureScale, 0.3)
+	service := NewService(NewMockDatabase(), noise, NewMockWorldService(), NewMockResourceNodeIntegration(), NewMockLogger())
+
+	chunk, err := service.GenerateChunk(context.Background(), 0, 0)
+	require.NoError(t, err)
+	assert.True(t, chunk.CellMetadata)
+
+	assert.Equal(t, chunkV1.TerrainType_TERRAIN_TYPE_SAND, chunk.Cells[0].TerrainType, "dry lowland is sand")
+	assert.Equal(t, chunkdata.Quantize(0.07), chunk.Cells[0].Elevation)
+	assert.Equal(t, chunkdata.Quantize(-0.6), chunk.Cells[0].Moisture)
+
+	assert.Equal(t, chunkV1.TerrainType_TERRAIN_TYPE_DIRT, chunk.Cells[1].TerrainType)
+	assert.Equal(t, chunkdata.Quantize(0.28), chunk.Cells[1].Elevation)
+	assert.True(t, chunkdata.CropViable(chunk.Cells[1]))
+}
+
+func TestService_CellMetadata_FilledOnLoad(t *testing.T) {
+	cleanup := testutil.SetupTest(t, testutil.DefaultTestConfig())
+	defer cleanup()
+
+	database := NewMockDatabase()
+	noise := NewMockNoiseGenerator(12345)
+	noise.SetNoiseValue(0, 0, ElevationScale, 0.1)
+	noise.SetNoiseValue(moistureOffset, moistureOffset, MoistureScale, 0.5)
+	world := NewMockWorldService()
+	service := NewService(database, noise, world, NewMockResourceNodeIntegration(), NewMockLogger())
+	ctx := context.Background()
+	worldID := world.defaultWorld.ID
+
+	// A chunk stored before cells carried elevation and moisture
+	cells := make([]*chunkV1.TerrainCell, ChunkSize*ChunkSize)
+	for i := range cells {
+		cells[i] = &chunkV1.TerrainCell{TerrainType: chunkV1.TerrainType_TERRAIN_TYPE_STONE}
+	}
+	serialized, err := proto.Marshal(&chunkV1.ChunkData{ChunkX: 0, ChunkY: 0, Cells: cells, Seed: world.defaultWorld.Seed})
+	require.NoError(t, err)
+	database.AddChunk(world.defaultWorld.ID, 0, 0, serialized)
+
+	chunk, err := service.GetOrCreateChunk(ctx, 0, 0)
+	require.NoError(t, err)
+	assert.True(t, chunk.CellMetadata)
+	assert.Equal(t, chunkV1.TerrainType_TERRAIN_TYPE_STONE, chunk.Cells[0].TerrainType, "stored terrain is kept")
+	assert.Equal(t, chunkdata.Quantize(0.07), chunk.Cells[0].Elevation)
+	assert.Equal(t, chunkdata.Quantize(0.5), chunk.Cells[0].Moisture)
+	assert.Equal(t, 0, database.GetCreateCallCount(), "loading never rewrites the chunk blob")
+
+	// Editing a cell's terrain keeps its elevation and moisture
+	require.NoError(t, service.ModifyCell(ctx, CellEdit{WorldID: worldID, X: 0, Y: 0, TerrainType: chunkV1.TerrainType_TERRAIN_TYPE_DIRT}))
+	chunk, err = service.GetOrCreateChunk(ctx, 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, chunkV1.TerrainType_TERRAIN_TYPE_DIRT, chunk.Cells[0].TerrainType)
+	assert.Equal(t, chunkdata.Quantize(0.07), chunk.Cells[0].Elevation)
+	assert.Equal(t, chunkdata.Quantize(0.5), chunk.Cells[0].Moisture)
+
+	// Compaction stores the filled-in values
+	compacted, err := service.CompactDeltas(ctx, CompactionConfig{MinDeltas: 1, MaxAge: time.Hour, BatchSize: 10})
+	require.NoError(t, err)
+	assert.Equal(t, 1, compacted)
+	var stored chunkV1.ChunkData
+	require.NoError(t, proto.Unmarshal(database.chunks[fmt.Sprintf("%x_%d_%d", world.defaultWorld.ID.Bytes, 0, 0)].ChunkData, &stored))
+	assert.True(t, stored.CellMetadata)
+	assert.Equal(t, chunkdata.Quantize(0.07), stored.Cells[0].Elevation)
+	assert.Equal(t, chunkV1.TerrainType_TERRAIN_TYPE_DIRT, stored.Cells[0].TerrainType)
+}
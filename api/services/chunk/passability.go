@@ -0,0 +1,71 @@
+package chunk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/chunkdata"
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Passability returns a chunk's walkable cells without decoding its cells. The mask is
+// stored when the chunk is generated or compacted and kept up to date by every terrain
+// edit, so movement checks and pathfinding can read it on every step. Chunks that do not
+// exist yet are generated; chunks stored before masks existed, or whose mask was cleared
+// by a repair, have it computed from their cells and stored.
+func (s *Service) Passability(ctx context.Context, worldID pgtype.UUID, chunkX, chunkY int32) (chunkdata.Passability, error) {
+	stored, err := s.db.GetChunkPassability(ctx, db.GetChunkPassabilityParams{
+		WorldID: worldID,
+		ChunkX:  chunkX,
+		ChunkY:  chunkY,
+	})
+	generated := errors.Is(err, pgx.ErrNoRows)
+	if err != nil && !generated {
+		return nil, fmt.Errorf("failed to get chunk passability: %w", err)
+	}
+	if stored != nil {
+		mask, err := chunkdata.DecodePassability(stored)
+		if err == nil {
+			return mask, nil
+		}
+		s.logger.Warn("Stored passability mask is invalid, recomputing it", "chunk_x", chunkX, "chunk_y", chunkY, "error", err)
+	}
+
+	// Loading the chunk generates it if needed, which stores its mask, and overlays edits
+	chunk, err := s.GetOrCreateChunkInWorld(ctx, worldID, chunkX, chunkY)
+	if err != nil {
+		return nil, err
+	}
+	mask := chunkdata.NewPassability(chunk.Cells)
+	if generated {
+		return mask, nil
+	}
+
+	// An edit stored between loading the chunk and this write is missing from the mask
+	// until the chunk's next compaction recomputes it
+	err = s.db.BackfillChunkPassability(ctx, db.BackfillChunkPassabilityParams{
+		WorldID:     worldID,
+		ChunkX:      chunkX,
+		ChunkY:      chunkY,
+		Passability: mask,
+	})
+	if err != nil {
+		// The mask is still right for this check; the next read retries the write
+		s.logger.Error("Failed to store chunk passability", "chunk_x", chunkX, "chunk_y", chunkY, "error", err)
+	}
+	return mask, nil
+}
+
+// IsPassable reports whether characters can stand on the cell at coordinates x, y of a world
+func (s *Service) IsPassable(ctx context.Context, worldID pgtype.UUID, x, y int32) (bool, error) {
+	chunkX, chunkY, index := geometry.CellLocation(geometry.Point{X: x, Y: y})
+	mask, err := s.Passability(ctx, worldID, chunkX, chunkY)
+	if err != nil {
+		return false, err
+	}
+	return mask.Passable(index), nil
+}
@@ -0,0 +1,139 @@
+package chunk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/testutil"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Passability(t *testing.T) {
+	cleanup := testutil.SetupTest(t, testutil.DefaultTestConfig())
+	defer cleanup()
+
+	database := NewMockDatabase()
+	service := NewService(database, NewMockNoiseGenerator(12345), NewMockWorldService(), NewMockResourceNodeIntegration(), NewMockLogger())
+	ctx := context.Background()
+	worldID := NewMockWorldService().defaultWorld.ID
+
+	passable, err := service.IsPassable(ctx, worldID, -3, 4)
+	require.NoError(t, err)
+	assert.True(t, passable, "grass is walkable")
+	assert.Equal(t, 1, database.GetCreateCallCount(), "checking a cell of a new chunk generates it")
+
+	require.NoError(t, service.ModifyCell(ctx, CellEdit{WorldID: worldID, X: -3, Y: 4, TerrainType: chunkV1.TerrainType_TERRAIN_TYPE_WATER}))
+	getCalls := database.GetCallCount()
+	passable, err = service.IsPassable(ctx, worldID, -3, 4)
+	require.NoError(t, err)
+	assert.False(t, passable, "edits update the stored mask")
+	assert.Equal(t, getCalls, database.GetCallCount(), "the chunk's cells are not loaded")
+
+	t.Run("chunks stored without a mask have it computed and stored", func(t *testing.T) {
+		key := fmt.Sprintf("%x_%d_%d", NewMockWorldService().defaultWorld.ID.Bytes, -1, 0)
+		row := database.chunks[key]
+		row.Passability = nil
+		database.chunks[key] = row
+
+		passable, err := service.IsPassable(ctx, worldID, -3, 4)
+		require.NoError(t, err)
+		assert.False(t, passable, "the computed mask includes edits")
+		assert.NotNil(t, database.chunks[key].Passability)
+	})
+
+	t.Run("compaction keeps the mask", func(t *testing.T) {
+		require.NoError(t, service.ModifyCell(ctx, CellEdit{WorldID: worldID, X: -2, Y: 4, TerrainType: chunkV1.TerrainType_TERRAIN_TYPE_STONE}))
+		_, err := service.CompactDeltas(ctx, CompactionConfig{MinDeltas: 1, MaxAge: time.Hour, BatchSize: 10})
+		require.NoError(t, err)
+
+		mask, err := service.Passability(ctx, worldID, -1, 0)
+		require.NoError(t, err)
+		_, _, water := geometry.CellLocation(geometry.Point{X: -3, Y: 4})
+		_, _, stone := geometry.CellLocation(geometry.Point{X: -2, Y: 4})
+		assert.False(t, mask.Passable(water))
+		assert.False(t, mask.Passable(stone))
+		assert.True(t, mask.Passable(0))
+	})
+
+	t.Run("other worlds have their own chunks", func(t *testing.T) {
+		otherWorld := pgtype.UUID{Bytes: [16]byte{0x75, 0x0e, 0x84, 0x00}, Valid: true}
+		creates := database.GetCreateCallCount()
+
+		passable, err := service.IsPassable(ctx, otherWorld, -3, 4)
+		require.NoError(t, err)
+		assert.True(t, passable, "edits in the default world don't reach other worlds")
+		assert.Equal(t, creates+1, database.GetCreateCallCount(), "the other world's chunk is generated")
+		assert.Contains(t, database.chunks, fmt.Sprintf("%x_%d_%d", otherWorld.Bytes, -1, 0))
+	})
+}
+
+func TestService_LineOfSight(t *testing.T) {
+	cleanup := testutil.SetupTest(t, testutil.DefaultTestConfig())
+	defer cleanup()
+
+	database := NewMockDatabase()
+	service := NewService(database, NewMockNoiseGenerator(12345), NewMockWorldService(), NewMockResourceNodeIntegration(), NewMockLogger())
+	ctx := context.Background()
+	worldID := NewMockWorldService().defaultWorld.ID
+
+	_, err := service.GetOrCreateChunk(ctx, -1, 0)
+	require.NoError(t, err)
+	require.NoError(t, service.ModifyCell(ctx, CellEdit{WorldID: worldID, X: -1, Y: 5, TerrainType: chunkV1.TerrainType_TERRAIN_TYPE_STONE}))
+
+	visible, err := service.LineOfSight(ctx, worldID, geometry.Point{X: -3, Y: 5}, geometry.Point{X: 1, Y: 5})
+	require.NoError(t, err)
+	assert.False(t, visible, "stone hides cells behind it, across chunk borders")
+
+	visible, err = service.LineOfSight(ctx, worldID, geometry.Point{X: -3, Y: 6}, geometry.Point{X: 1, Y: 6})
+	require.NoError(t, err)
+	assert.True(t, visible)
+
+	otherWorld := pgtype.UUID{Bytes: [16]byte{0x75, 0x0e, 0x84, 0x00}, Valid: true}
+	visible, err = service.LineOfSight(session.WithWorldID(ctx, uuid.PgtypeToString(worldID)), otherWorld, geometry.Point{X: -3, Y: 5}, geometry.Point{X: 1, Y: 5})
+	require.NoError(t, err)
+	assert.True(t, visible, "the given world decides, not the session's")
+	assert.Error(t, service.ModifyCell(ctx, CellEdit{X: -1, Y: 6, TerrainType: chunkV1.TerrainType_TERRAIN_TYPE_STONE}), "edits need a world")
+
+	database.SetShouldReturnError(true)
+	_, err = service.LineOfSight(ctx, worldID, geometry.Point{X: -3, Y: 6}, geometry.Point{X: 1, Y: 6})
+	assert.Error(t, err)
+}
+
+func TestService_Cells(t *testing.T) {
+	cleanup := testutil.SetupTest(t, testutil.DefaultTestConfig())
+	defer cleanup()
+
+	database := NewMockDatabase()
+	service := NewService(database, NewMockNoiseGenerator(12345), NewMockWorldService(), NewMockResourceNodeIntegration(), NewMockLogger())
+	ctx := context.Background()
+	worldID := NewMockWorldService().defaultWorld.ID
+
+	_, err := service.GetOrCreateChunk(ctx, -1, 0)
+	require.NoError(t, err)
+	require.NoError(t, service.ModifyCell(ctx, CellEdit{WorldID: worldID, X: -3, Y: 4, TerrainType: chunkV1.TerrainType_TERRAIN_TYPE_WATER}))
+
+	getCalls := database.GetCallCount()
+	cells, err := service.Cells(ctx, []geometry.Point{{X: -3, Y: 4}, {X: -2, Y: 4}, {X: 40, Y: 4}, {X: -3, Y: 4}})
+	require.NoError(t, err)
+	require.Len(t, cells, 3, "duplicates are returned once")
+	assert.Equal(t, int32(-3), cells[0].X)
+	assert.Equal(t, chunkV1.TerrainType_TERRAIN_TYPE_WATER, cells[0].Cell.TerrainType)
+	assert.False(t, cells[0].Passable)
+	assert.True(t, cells[1].Passable)
+	assert.False(t, cells[0].CropViable, "crops do not grow in water")
+	assert.True(t, cells[1].CropViable)
+	assert.Equal(t, int32(40), cells[2].X)
+	assert.Equal(t, getCalls+2, database.GetCallCount(), "each chunk is loaded once")
+
+	database.SetShouldReturnError(true)
+	_, err = service.Cells(ctx, []geometry.Point{{X: 0, Y: 0}})
+	assert.Error(t, err)
+}
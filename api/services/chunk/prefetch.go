@@ -0,0 +1,197 @@
+package chunk
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/debugstats"
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/VoidMesh/api/api/internal/session"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+)
+
+// PrefetchConfig controls how far ahead of a moving character chunks are loaded
+type PrefetchConfig struct {
+	LookAhead int32 // Cells ahead of the character, along its direction of travel
+	Workers   int   // Chunks loaded concurrently
+	QueueSize int   // Pending chunks; further requests are dropped until the queue drains
+	// RecentSize is how many recently prefetched chunks are remembered so repeated
+	// moves in the same direction don't reload them
+	RecentSize int
+}
+
+// DefaultPrefetchConfig loads up to two chunks ahead, which at the movement cooldown
+// is several seconds of walking
+func DefaultPrefetchConfig() PrefetchConfig {
+	return PrefetchConfig{
+		LookAhead:  2 * ChunkSize,
+		Workers:    2,
+		QueueSize:  256,
+		RecentSize: 4096,
+	}
+}
+
+// ChunkLoader loads a chunk, generating and storing it if it does not exist yet
+type ChunkLoader interface {
+	GetOrCreateChunk(ctx context.Context, chunkX, chunkY int32) (*chunkV1.ChunkData, error)
+}
+
+// Prefetcher generates the chunks a moving character is heading into before it gets
+// there, so walking into a new area never waits on generation. Requests are queued
+// and loaded in the background by Run; Prefetch itself never blocks.
+type Prefetcher struct {
+	loader ChunkLoader
+	config PrefetchConfig
+	logger LoggerInterface
+	queue  chan prefetchRequest
+
+	mu sync.Mutex
+	// queued holds chunks waiting in or taken from the queue but not yet loaded
+	queued map[prefetchKey]bool
+	// recent holds chunks loaded since it was last cleared
+	recent map[prefetchKey]bool
+}
+
+// prefetchKey identifies a chunk of a world
+type prefetchKey struct {
+	worldID string
+	coord   [2]int32
+}
+
+// prefetchRequest is a queued chunk and the character whose move queued it, who is
+// credited if loading it generates the chunk
+type prefetchRequest struct {
+	prefetchKey
+	characterID string
+}
+
+// NewPrefetcher creates a prefetcher that loads chunks through loader
+func NewPrefetcher(loader ChunkLoader, config PrefetchConfig, logger LoggerInterface) *Prefetcher {
+	p := &Prefetcher{
+		loader: loader,
+		config: config,
+		logger: logger.With("component", "chunk-prefetcher"),
+		queue:  make(chan prefetchRequest, config.QueueSize),
+		queued: make(map[prefetchKey]bool),
+		recent: make(map[prefetchKey]bool),
+	}
+	debugstats.Register(debugstats.Queues, "chunk.prefetch_queue_depth", func() int64 {
+		return int64(len(p.queue))
+	})
+	return p
+}
+
+// Prefetch queues the chunks ahead of a character that moved from one cell to another
+// of its world
+func (p *Prefetcher) Prefetch(characterID, worldID string, fromX, fromY, toX, toY int32) {
+	for _, coord := range p.chunksAhead(fromX, fromY, toX, toY) {
+		p.enqueue(prefetchRequest{prefetchKey: prefetchKey{worldID: worldID, coord: coord}, characterID: characterID})
+	}
+}
+
+// chunksAhead returns the chunks between the character's chunk and the point
+// LookAhead cells further along its direction of travel. Each step includes the
+// chunks on either side so a stream's area of interest is covered when it arrives.
+func (p *Prefetcher) chunksAhead(fromX, fromY, toX, toY int32) [][2]int32 {
+	dx, dy := sign(toX-fromX), sign(toY-fromY)
+	if (dx == 0 && dy == 0) || p.config.LookAhead <= 0 {
+		return nil
+	}
+
+	currentX, currentY := geometry.ChunkOf(geometry.Point{X: toX, Y: toY})
+	targetX, targetY := geometry.ChunkOf(geometry.Point{X: toX + dx*p.config.LookAhead, Y: toY + dy*p.config.LookAhead})
+
+	var chunks [][2]int32
+	for x, y := currentX, currentY; x != targetX || y != targetY; {
+		if x != targetX {
+			x += dx
+		}
+		if y != targetY {
+			y += dy
+		}
+		chunks = append(chunks, [2]int32{x, y})
+		// Sideways neighbours, perpendicular to each axis of travel
+		if dx != 0 {
+			chunks = append(chunks, [2]int32{x, y - 1}, [2]int32{x, y + 1})
+		}
+		if dy != 0 {
+			chunks = append(chunks, [2]int32{x - 1, y}, [2]int32{x + 1, y})
+		}
+	}
+	return chunks
+}
+
+// enqueue adds a chunk to the queue unless it is already pending or was loaded recently
+func (p *Prefetcher) enqueue(req prefetchRequest) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.queued[req.prefetchKey] || p.recent[req.prefetchKey] {
+		return
+	}
+	select {
+	case p.queue <- req:
+		p.queued[req.prefetchKey] = true
+	default:
+		// The character will load it on arrival; prefetching is best effort
+	}
+}
+
+// Run loads queued chunks on config.Workers goroutines until ctx is cancelled
+func (p *Prefetcher) Run(ctx context.Context) {
+	p.logger.Info("Chunk prefetching started", "look_ahead", p.config.LookAhead, "workers", p.config.Workers)
+	var wg sync.WaitGroup
+	for i := 0; i < p.config.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case req := <-p.queue:
+					p.load(ctx, req)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	p.logger.Info("Chunk prefetching stopped")
+}
+
+// load generates a queued chunk if needed and remembers it as recently loaded
+func (p *Prefetcher) load(ctx context.Context, req prefetchRequest) {
+	start := time.Now()
+	coord := req.coord
+	loadCtx := session.WithWorldID(session.WithCharacterID(ctx, req.characterID), req.worldID)
+	_, err := p.loader.GetOrCreateChunk(loadCtx, coord[0], coord[1])
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.queued, req.prefetchKey)
+	if err != nil {
+		if ctx.Err() == nil {
+			p.logger.Warn("Failed to prefetch chunk", "chunk_x", coord[0], "chunk_y", coord[1], "error", err)
+		}
+		return
+	}
+	if len(p.recent) >= p.config.RecentSize {
+		// Forgetting everything at once is cheaper than tracking age, and the worst case
+		// is one extra database read per chunk
+		clear(p.recent)
+	}
+	p.recent[req.prefetchKey] = true
+	p.logger.Debug("Prefetched chunk", "chunk_x", coord[0], "chunk_y", coord[1], "duration", time.Since(start))
+}
+
+// sign returns -1, 0 or 1
+func sign(v int32) int32 {
+	switch {
+	case v < 0:
+		return -1
+	case v > 0:
+		return 1
+	default:
+		return 0
+	}
+}
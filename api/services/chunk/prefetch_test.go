@@ -0,0 +1,125 @@
+package chunk
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/session"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingLoader records the chunks it is asked for, the character credited and the
+// world loaded, and fails those in failing
+type recordingLoader struct {
+	mu         sync.Mutex
+	loads      map[[2]int32]int
+	characters map[[2]int32]string
+	worlds     map[[2]int32]string
+	failing    map[[2]int32]bool
+}
+
+func (l *recordingLoader) GetOrCreateChunk(ctx context.Context, chunkX, chunkY int32) (*chunkV1.ChunkData, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	coord := [2]int32{chunkX, chunkY}
+	l.loads[coord]++
+	if l.characters != nil {
+		l.characters[coord], _ = session.CharacterIDFromContext(ctx)
+	}
+	if l.worlds != nil {
+		l.worlds[coord], _ = session.WorldIDFromContext(ctx)
+	}
+	if l.failing[coord] {
+		return nil, errors.New("database unavailable")
+	}
+	return &chunkV1.ChunkData{ChunkX: chunkX, ChunkY: chunkY}, nil
+}
+
+func (l *recordingLoader) count(coord [2]int32) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.loads[coord]
+}
+
+func TestPrefetcher_ChunksAhead(t *testing.T) {
+	p := NewPrefetcher(&recordingLoader{}, PrefetchConfig{LookAhead: 2 * ChunkSize}, NewMockLogger())
+
+	// Walking east from the middle of chunk (0,0) reaches into chunk (2,0)
+	assert.Equal(t, [][2]int32{
+		{1, 0}, {1, -1}, {1, 1},
+		{2, 0}, {2, -1}, {2, 1},
+	}, p.chunksAhead(15, 10, 16, 10))
+
+	// Walking north across negative coordinates
+	assert.Equal(t, [][2]int32{
+		{-1, -2}, {-2, -2}, {0, -2},
+		{-1, -3}, {-2, -3}, {0, -3},
+	}, p.chunksAhead(-5, -1, -5, -2))
+
+	assert.Empty(t, p.chunksAhead(3, 3, 3, 3), "no direction without movement")
+
+	short := NewPrefetcher(&recordingLoader{}, PrefetchConfig{LookAhead: 4}, NewMockLogger())
+	assert.Empty(t, short.chunksAhead(10, 10, 11, 10), "the look-ahead stays within the current chunk")
+	assert.Equal(t, [][2]int32{{1, 0}, {1, -1}, {1, 1}}, short.chunksAhead(29, 10, 30, 10))
+}
+
+func TestPrefetcher_Run(t *testing.T) {
+	loader := &recordingLoader{
+		loads:      make(map[[2]int32]int),
+		characters: make(map[[2]int32]string),
+		worlds:     make(map[[2]int32]string),
+		failing:    map[[2]int32]bool{{1, 1}: true},
+	}
+	p := NewPrefetcher(loader, PrefetchConfig{LookAhead: ChunkSize, Workers: 1, QueueSize: 16, RecentSize: 64}, NewMockLogger())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		p.Run(ctx)
+		close(done)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	p.Prefetch("char-1", "world-1", 15, 10, 16, 10)
+	require.Eventually(t, func() bool {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		return len(p.queued) == 0
+	}, time.Second, time.Millisecond)
+	for _, coord := range [][2]int32{{1, 0}, {1, -1}, {1, 1}} {
+		assert.Equal(t, 1, loader.count(coord))
+	}
+	loader.mu.Lock()
+	assert.Equal(t, "char-1", loader.characters[[2]int32{1, 0}], "generated chunks are credited to the moving character")
+	assert.Equal(t, "world-1", loader.worlds[[2]int32{1, 0}], "chunks are loaded in the moving character's world")
+	loader.mu.Unlock()
+
+	// Moving on in the same direction does not reload what was prefetched, but a
+	// chunk that failed to load is tried again
+	p.Prefetch("char-1", "world-1", 16, 10, 17, 10)
+	require.Eventually(t, func() bool { return loader.count([2]int32{1, 1}) == 2 }, time.Second, time.Millisecond)
+	assert.Equal(t, 1, loader.count([2]int32{1, 0}))
+	assert.Equal(t, 1, loader.count([2]int32{1, -1}))
+
+	// The same chunks of another world are loaded separately
+	p.Prefetch("char-2", "world-2", 15, 10, 16, 10)
+	require.Eventually(t, func() bool { return loader.count([2]int32{1, 0}) == 2 }, time.Second, time.Millisecond)
+}
+
+func TestPrefetcher_DropsWhenQueueIsFull(t *testing.T) {
+	loader := &recordingLoader{loads: make(map[[2]int32]int)}
+	p := NewPrefetcher(loader, PrefetchConfig{LookAhead: 4 * ChunkSize, QueueSize: 2, RecentSize: 64}, NewMockLogger())
+
+	// Without Run nothing drains the queue; Prefetch must still return
+	p.Prefetch("char-1", "world-1", 15, 10, 16, 10)
+	assert.Len(t, p.queue, 2)
+	assert.Len(t, p.queued, 2)
+}
@@ -0,0 +1,56 @@
+package chunk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/alerting"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/proto"
+)
+
+// repairChunk replaces a chunk whose stored blob failed to decode. Generation is
+// deterministic from the world seed (or the chunk's template), so the chunk is
+// regenerated as it was first created; the bad blob is kept in corrupt_chunk_data and the row is flagged with
+// quarantined_at. Terrain edits still stored as deltas apply on top as usual, but edits
+// already compacted into the lost blob are gone, so operators are alerted.
+func (s *Service) repairChunk(ctx context.Context, worldID pgtype.UUID, chunkX, chunkY int32, cause error) (*chunkV1.ChunkData, error) {
+	logger := s.logger.With("chunk_x", chunkX, "chunk_y", chunkY)
+	logger.Error("Stored chunk data is corrupt, regenerating it from the world seed", "error", cause)
+
+	chunk, _, _, err := s.newChunk(ctx, worldID, chunkX, chunkY)
+	if err != nil {
+		return nil, fmt.Errorf("failed to regenerate corrupt chunk: %w", err)
+	}
+	data, err := proto.Marshal(chunk)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize chunk data: %w", err)
+	}
+	err = s.db.QuarantineChunk(ctx, db.QuarantineChunkParams{
+		WorldID:       worldID,
+		ChunkX:        chunkX,
+		ChunkY:        chunkY,
+		ChunkData:     data,
+		QuarantinedAt: pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to quarantine corrupt chunk: %w", err)
+	}
+
+	worldIDString := uuid.PgtypeToString(worldID)
+	alerting.Report(alerting.Alert{
+		Condition: alerting.ChunkCorrupted,
+		Key:       fmt.Sprintf("%s:%d:%d", worldIDString, chunkX, chunkY),
+		Title:     fmt.Sprintf("Corrupt chunk (%d,%d) was regenerated", chunkX, chunkY),
+		Message:   "The stored blob is kept in chunks.corrupt_chunk_data. Compacted terrain edits in it were lost.",
+		Fields: map[string]string{
+			"world_id": worldIDString,
+			"error":    cause.Error(),
+		},
+	})
+	logger.Warn("Corrupt chunk quarantined and regenerated")
+	return chunk, nil
+}
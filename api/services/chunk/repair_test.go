@@ -0,0 +1,47 @@
+package chunk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/VoidMesh/api/api/internal/testutil"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_GetOrCreateChunk_RepairsCorruptChunk(t *testing.T) {
+	cleanup := testutil.SetupTest(t, testutil.DefaultTestConfig())
+	defer cleanup()
+
+	database := NewMockDatabase()
+	world := NewMockWorldService()
+	service := NewService(database, NewMockNoiseGenerator(12345), world, NewMockResourceNodeIntegration(), NewMockLogger())
+	ctx := context.Background()
+	worldID := world.defaultWorld.ID
+
+	expected, err := service.GenerateChunk(ctx, 0, 0)
+	require.NoError(t, err)
+
+	corrupt := []byte{0x0a, 0xff, 0xff, 0xff}
+	database.AddChunk(world.defaultWorld.ID, 0, 0, corrupt)
+	require.NoError(t, service.ModifyCell(ctx, CellEdit{WorldID: worldID, X: 1, Y: 0, TerrainType: chunkV1.TerrainType_TERRAIN_TYPE_DIRT}))
+
+	chunk, err := service.GetOrCreateChunk(ctx, 0, 0)
+	require.NoError(t, err, "a corrupt chunk is served regenerated instead of failing")
+	require.Len(t, chunk.Cells, ChunkSize*ChunkSize)
+	assert.Equal(t, expected.Cells[0].TerrainType, chunk.Cells[0].TerrainType, "regeneration is deterministic from the seed")
+	assert.Equal(t, chunkV1.TerrainType_TERRAIN_TYPE_DIRT, chunk.Cells[1].TerrainType, "stored deltas still apply")
+	assert.Equal(t, 0, database.GetCreateCallCount(), "the existing row is repaired in place")
+
+	stored := database.chunks[fmt.Sprintf("%x_%d_%d", world.defaultWorld.ID.Bytes, 0, 0)]
+	assert.True(t, stored.QuarantinedAt.Valid)
+	assert.Equal(t, corrupt, stored.CorruptChunkData)
+
+	// The repaired blob decodes on the next read without another quarantine
+	quarantinedAt := stored.QuarantinedAt
+	_, err = service.GetOrCreateChunk(ctx, 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, quarantinedAt, database.chunks[fmt.Sprintf("%x_%d_%d", world.defaultWorld.ID.Bytes, 0, 0)].QuarantinedAt)
+}
@@ -0,0 +1,37 @@
+package chunk
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/internal/chunkdata"
+	"github.com/VoidMesh/api/api/internal/geometry"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// LineOfSight reports whether terrain leaves the line between two cells clear (see
+// geometry.LineOfSight and chunkdata.BlocksSight) in the given world. Each chunk the line
+// crosses is loaded once, generating it if needed.
+func (s *Service) LineOfSight(ctx context.Context, worldID pgtype.UUID, from, to geometry.Point) (bool, error) {
+	chunks := make(map[[2]int32]*chunkV1.ChunkData)
+	var loadErr error
+	visible := geometry.LineOfSight(from, to, func(p geometry.Point) bool {
+		if loadErr != nil {
+			return true
+		}
+		chunkX, chunkY, index := geometry.CellLocation(p)
+		chunk, ok := chunks[[2]int32{chunkX, chunkY}]
+		if !ok {
+			chunk, loadErr = s.GetOrCreateChunkInWorld(ctx, worldID, chunkX, chunkY)
+			if loadErr != nil {
+				return true
+			}
+			chunks[[2]int32{chunkX, chunkY}] = chunk
+		}
+		return int(index) >= len(chunk.Cells) || chunkdata.BlocksSight(chunk.Cells[index].TerrainType)
+	})
+	if loadErr != nil {
+		return false, loadErr
+	}
+	return visible, nil
+}
@@ -0,0 +1,62 @@
+package chunk
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	resourceNodeV1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	templatesMu sync.RWMutex
+	templates   TemplateSource
+)
+
+// SetTemplates registers authored chunks that are served instead of procedurally
+// generated ones. Every chunk service in the process shares them. Only chunks that have
+// not been generated yet are affected: a chunk already stored keeps its terrain.
+func SetTemplates(source TemplateSource) {
+	templatesMu.Lock()
+	defer templatesMu.Unlock()
+	templates = source
+}
+
+// templateChunk returns the authored chunk at the coordinates, if any
+func templateChunk(chunkX, chunkY int32) (*chunkV1.ChunkData, bool) {
+	templatesMu.RLock()
+	source := templates
+	templatesMu.RUnlock()
+	if source == nil {
+		return nil, false
+	}
+	return source.Chunk(chunkX, chunkY)
+}
+
+// newChunk creates the terrain of a chunk that is not stored yet: the authored template
+// covering it, or procedural terrain. For a template, templated is true and resources
+// are its authored resource nodes, which are not attached to the returned chunk.
+func (s *Service) newChunk(ctx context.Context, worldID pgtype.UUID, chunkX, chunkY int32) (chunk *chunkV1.ChunkData, resources []*resourceNodeV1.ResourceNode, templated bool, err error) {
+	template, ok := templateChunk(chunkX, chunkY)
+	if !ok {
+		chunk, err = s.generateChunk(ctx, worldID, chunkX, chunkY)
+		return chunk, nil, false, err
+	}
+
+	s.logger.Info("Using authored chunk template", "chunk_x", chunkX, "chunk_y", chunkY)
+	gen, err := s.worldNoise.Noise(ctx, worldID)
+	if err != nil {
+		return nil, nil, false, fmt.Errorf("failed to get world noise: %w", err)
+	}
+	resources = template.ResourceNodes
+	template.ResourceNodes = nil
+	template.Seed = gen.GetSeed()
+	template.GeneratedAt = timestamppb.New(s.clock.Now())
+	if err := s.fillCellMetadata(ctx, worldID, template); err != nil {
+		return nil, nil, false, err
+	}
+	return template, resources, true, nil
+}
@@ -0,0 +1,65 @@
+package chunk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/VoidMesh/api/api/internal/testutil"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	resourceNodeV1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTemplates serves an all-water chunk with one herb patch at (2,3)
+type fakeTemplates struct{}
+
+func (fakeTemplates) Chunk(chunkX, chunkY int32) (*chunkV1.ChunkData, bool) {
+	if chunkX != 2 || chunkY != 3 {
+		return nil, false
+	}
+	cells := make([]*chunkV1.TerrainCell, ChunkSize*ChunkSize)
+	for i := range cells {
+		cells[i] = &chunkV1.TerrainCell{TerrainType: chunkV1.TerrainType_TERRAIN_TYPE_WATER}
+	}
+	herb := &resourceNodeV1.ResourceNode{
+		ResourceNodeTypeId: resourceNodeV1.ResourceNodeTypeId_RESOURCE_NODE_TYPE_ID_HERB_PATCH,
+		ChunkX:             chunkX,
+		ChunkY:             chunkY,
+		X:                  chunkX*ChunkSize + 1,
+		Y:                  chunkY * ChunkSize,
+	}
+	return &chunkV1.ChunkData{ChunkX: chunkX, ChunkY: chunkY, Cells: cells, ResourceNodes: []*resourceNodeV1.ResourceNode{herb}}, true
+}
+
+func TestService_GetOrCreateChunk_ServesTemplates(t *testing.T) {
+	cleanup := testutil.SetupTest(t, testutil.DefaultTestConfig())
+	defer cleanup()
+	SetTemplates(fakeTemplates{})
+	defer SetTemplates(nil)
+
+	resources := NewMockResourceNodeIntegration()
+	service := NewService(NewMockDatabase(), NewMockNoiseGenerator(12345), NewMockWorldService(), resources, NewMockLogger())
+	ctx := context.Background()
+
+	chunk, err := service.GetOrCreateChunk(ctx, 2, 3)
+	require.NoError(t, err)
+	for _, cell := range chunk.Cells {
+		require.Equal(t, chunkV1.TerrainType_TERRAIN_TYPE_WATER, cell.TerrainType)
+	}
+	require.Len(t, chunk.ResourceNodes, 1)
+	assert.Equal(t, int32(2*ChunkSize+1), chunk.ResourceNodes[0].X)
+	assert.NotNil(t, chunk.GeneratedAt)
+	assert.Equal(t, 1, resources.storeCallCount, "template resources are stored instead of generated")
+	assert.Zero(t, resources.generateCallCount)
+
+	stored, err := service.GetOrCreateChunk(ctx, 2, 3)
+	require.NoError(t, err)
+	assert.Equal(t, chunkV1.TerrainType_TERRAIN_TYPE_WATER, stored.Cells[0].TerrainType)
+	assert.Equal(t, 1, resources.attachBatchCallCount, "stored template chunks only attach what is stored")
+	assert.Zero(t, resources.attachCallCount, "which would generate resources for an empty chunk")
+
+	_, err = service.GetOrCreateChunk(ctx, 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, resources.generateCallCount, "chunks outside templates are procedural")
+}
@@ -0,0 +1,340 @@
+// Package chunk_summary maintains the chunk_summaries read model: terrain
+// histograms, resource node counts and harvest activity per generated chunk.
+package chunk_summary
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/alerting"
+	"github.com/VoidMesh/api/api/internal/chunkdata"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	resourceNodeV1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// MaxSummaryArea caps how many chunks a single GetChunkSummaries call may cover
+	MaxSummaryArea = 64 * 64
+	// staleBatchSize is how many stale summaries are rebuilt per job pass
+	staleBatchSize = 100
+)
+
+// Service projects chunk events into chunk_summaries and serves the read model.
+type Service struct {
+	db     DatabaseInterface
+	logger LoggerInterface
+	clock  clock.Clock
+}
+
+// NewService creates a new chunk summary service with dependency injection.
+func NewService(db DatabaseInterface, logger LoggerInterface) *Service {
+	componentLogger := logger.With("component", "chunk-summary-service")
+	componentLogger.Debug("Creating new chunk summary service")
+	return &Service{
+		db:     db,
+		logger: componentLogger,
+		clock:  clock.New(),
+	}
+}
+
+// NewServiceWithPool creates a service with concrete implementations (convenience constructor for production use).
+func NewServiceWithPool(pool storage.Pool) *Service {
+	return NewService(NewDatabaseWrapper(pool), NewDefaultLoggerWrapper())
+}
+
+// SetClock replaces the clock used for timestamps (for simulation tests)
+func (s *Service) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// Subscribe registers the projection's event handlers on the bus
+func (s *Service) Subscribe(bus *events.Bus) {
+	bus.Subscribe(events.ChunkGenerated, events.Dedup(s.handleChunkGenerated, events.DefaultDedupCapacity))
+	bus.Subscribe(events.ResourceHarvested, events.Dedup(s.handleResourceHarvested, events.DefaultDedupCapacity))
+}
+
+func (s *Service) handleChunkGenerated(ctx context.Context, event events.Event) error {
+	var payload events.ChunkGeneratedPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("invalid %s payload: %w", event.Type, err)
+	}
+
+	worldID, err := uuid.StringToPgtype(payload.WorldID)
+	if err != nil {
+		return fmt.Errorf("invalid world ID in %s payload: %w", event.Type, err)
+	}
+
+	_, err = s.Refresh(ctx, worldID, payload.ChunkX, payload.ChunkY)
+	return err
+}
+
+func (s *Service) handleResourceHarvested(ctx context.Context, event events.Event) error {
+	var payload events.ResourceHarvestedPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("invalid %s payload: %w", event.Type, err)
+	}
+
+	worldID, err := uuid.StringToPgtype(payload.WorldID)
+	if err != nil {
+		return fmt.Errorf("invalid world ID in %s payload: %w", event.Type, err)
+	}
+
+	arg := db.IncrementChunkHarvestCountParams{
+		WorldID:      worldID,
+		ChunkX:       payload.ChunkX,
+		ChunkY:       payload.ChunkY,
+		LastModified: pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
+	}
+	updated, err := s.db.IncrementChunkHarvestCount(ctx, arg)
+	if err != nil {
+		return fmt.Errorf("failed to record harvest: %w", err)
+	}
+	if updated > 0 {
+		return nil
+	}
+
+	// The chunk has no summary yet (e.g. it predates the projection); build one first
+	if _, err := s.Refresh(ctx, worldID, payload.ChunkX, payload.ChunkY); err != nil {
+		return err
+	}
+	if _, err := s.db.IncrementChunkHarvestCount(ctx, arg); err != nil {
+		return fmt.Errorf("failed to record harvest: %w", err)
+	}
+	return nil
+}
+
+// Refresh rebuilds the terrain histogram and node counts for a chunk from its stored data
+func (s *Service) Refresh(ctx context.Context, worldID pgtype.UUID, chunkX, chunkY int32) (db.ChunkSummary, error) {
+	logger := s.logger.With("chunk_x", chunkX, "chunk_y", chunkY)
+
+	chunk, err := s.db.GetChunk(ctx, db.GetChunkParams{WorldID: worldID, ChunkX: chunkX, ChunkY: chunkY})
+	if err != nil {
+		return db.ChunkSummary{}, fmt.Errorf("failed to load chunk: %w", err)
+	}
+
+	chunkData, err := chunkdata.Decode(chunk.ChunkData, chunkX, chunkY)
+	if err != nil {
+		return db.ChunkSummary{}, fmt.Errorf("failed to deserialize chunk data: %w", err)
+	}
+
+	terrain := make(map[int32]int32)
+	for _, cell := range chunkData.Cells {
+		terrain[int32(cell.TerrainType)]++
+	}
+
+	rows, err := s.db.CountResourceNodesByTypeInChunk(ctx, db.CountResourceNodesByTypeInChunkParams{
+		WorldID: worldID,
+		ChunkX:  chunkX,
+		ChunkY:  chunkY,
+	})
+	if err != nil {
+		return db.ChunkSummary{}, fmt.Errorf("failed to count resource nodes: %w", err)
+	}
+	nodes := make(map[int32]int32, len(rows))
+	for _, row := range rows {
+		nodes[row.ResourceNodeTypeID] = int32(row.NodeCount)
+	}
+
+	terrainJSON, err := json.Marshal(terrain)
+	if err != nil {
+		return db.ChunkSummary{}, err
+	}
+	nodesJSON, err := json.Marshal(nodes)
+	if err != nil {
+		return db.ChunkSummary{}, err
+	}
+
+	summary, err := s.db.UpsertChunkSummary(ctx, db.UpsertChunkSummaryParams{
+		WorldID:          worldID,
+		ChunkX:           chunkX,
+		ChunkY:           chunkY,
+		TerrainHistogram: terrainJSON,
+		NodeCounts:       nodesJSON,
+		LastModified:     pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
+	})
+	if err != nil {
+		return db.ChunkSummary{}, fmt.Errorf("failed to store chunk summary: %w", err)
+	}
+
+	logger.Debug("Refreshed chunk summary", "terrain_types", len(terrain), "node_types", len(nodes))
+	return summary, nil
+}
+
+// RefreshStale rebuilds up to limit summaries that are missing or older than their chunk's resource nodes
+func (s *Service) RefreshStale(ctx context.Context, limit int32) (int, error) {
+	stale, err := s.db.ListStaleChunkSummaries(ctx, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list stale chunk summaries: %w", err)
+	}
+
+	refreshed := 0
+	for _, row := range stale {
+		if _, err := s.Refresh(ctx, row.WorldID, row.ChunkX, row.ChunkY); err != nil {
+			s.logger.Warn("Failed to refresh stale chunk summary", "chunk_x", row.ChunkX, "chunk_y", row.ChunkY, "error", err)
+			continue
+		}
+		refreshed++
+	}
+	return refreshed, nil
+}
+
+// Run periodically rebuilds stale summaries until ctx is cancelled. This backfills
+// chunks generated before the projection existed and picks up resource nodes that
+// were stored after the chunk's generation event was handled.
+func (s *Service) Run(ctx context.Context, interval time.Duration) {
+	for {
+		refreshed, err := s.RefreshStale(ctx, staleBatchSize)
+		if err != nil {
+			s.logger.Error("Chunk summary refresh failed", "error", err)
+			alerting.ReportJobError("chunk_summary", err)
+		} else if refreshed > 0 {
+			s.logger.Info("Refreshed stale chunk summaries", "count", refreshed)
+		}
+
+		// Keep draining while there is a backlog
+		if err == nil && refreshed == staleBatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.clock.After(interval):
+		}
+	}
+}
+
+// GetChunkSummaries returns summaries for generated chunks in a rectangular area
+func (s *Service) GetChunkSummaries(ctx context.Context, worldID pgtype.UUID, minX, maxX, minY, maxY int32) ([]*chunkV1.ChunkSummary, error) {
+	if minX > maxX || minY > maxY {
+		return nil, status.Errorf(codes.InvalidArgument, "min chunk coordinates must not exceed max")
+	}
+	if area := (int64(maxX) - int64(minX) + 1) * (int64(maxY) - int64(minY) + 1); area > MaxSummaryArea {
+		return nil, status.Errorf(codes.InvalidArgument, "requested area of %d chunks exceeds the limit of %d", area, MaxSummaryArea)
+	}
+
+	rows, err := s.db.GetChunkSummariesInRange(ctx, db.GetChunkSummariesInRangeParams{
+		WorldID:  worldID,
+		ChunkX:   minX,
+		ChunkX_2: maxX,
+		ChunkY:   minY,
+		ChunkY_2: maxY,
+	})
+	if err != nil {
+		s.logger.Error("Failed to get chunk summaries", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to get chunk summaries")
+	}
+
+	chunkDiscoveries, err := s.db.ListChunkDiscoveriesInRange(ctx, db.ListChunkDiscoveriesInRangeParams{
+		WorldID:   worldID,
+		MinChunkX: minX,
+		MaxChunkX: maxX,
+		MinChunkY: minY,
+		MaxChunkY: maxY,
+	})
+	if err != nil {
+		s.logger.Error("Failed to get chunk discoveries", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to get chunk summaries")
+	}
+	resourceDiscoveries, err := s.db.ListResourceDiscoveriesInRange(ctx, db.ListResourceDiscoveriesInRangeParams{
+		WorldID:   worldID,
+		MinChunkX: minX,
+		MaxChunkX: maxX,
+		MinChunkY: minY,
+		MaxChunkY: maxY,
+	})
+	if err != nil {
+		s.logger.Error("Failed to get resource discoveries", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to get chunk summaries")
+	}
+
+	summaries := make([]*chunkV1.ChunkSummary, 0, len(rows))
+	byChunk := make(map[[2]int32]*chunkV1.ChunkSummary, len(rows))
+	for _, row := range rows {
+		summary, err := dbSummaryToProto(row)
+		if err != nil {
+			s.logger.Error("Corrupt chunk summary", "chunk_x", row.ChunkX, "chunk_y", row.ChunkY, "error", err)
+			return nil, status.Errorf(codes.Internal, "failed to decode chunk summary")
+		}
+		summaries = append(summaries, summary)
+		byChunk[[2]int32{row.ChunkX, row.ChunkY}] = summary
+	}
+
+	// Discoveries of chunks without a summary yet are left for a later request
+	for _, row := range chunkDiscoveries {
+		if summary, ok := byChunk[[2]int32{row.ChunkX, row.ChunkY}]; ok {
+			summary.Discovery = discoveryToProto(row.CharacterID, row.CharacterName, row.DiscoveredAt)
+		}
+	}
+	for _, row := range resourceDiscoveries {
+		if summary, ok := byChunk[[2]int32{row.ChunkX, row.ChunkY}]; ok {
+			summary.ResourceDiscoveries = append(summary.ResourceDiscoveries, &chunkV1.ResourceDiscovery{
+				ResourceNodeTypeId: row.ResourceNodeTypeID,
+				RegionX:            row.RegionX,
+				RegionY:            row.RegionY,
+				Discovery:          discoveryToProto(row.CharacterID, row.CharacterName, row.DiscoveredAt),
+			})
+		}
+	}
+	return summaries, nil
+}
+
+// discoveryToProto credits a discovery; the character ID is empty once the character is deleted
+func discoveryToProto(characterID pgtype.UUID, characterName string, discoveredAt pgtype.Timestamp) *chunkV1.Discovery {
+	return &chunkV1.Discovery{
+		CharacterId:   uuid.PgtypeToString(characterID),
+		CharacterName: characterName,
+		DiscoveredAt:  timestamppb.New(discoveredAt.Time),
+	}
+}
+
+func dbSummaryToProto(row db.ChunkSummary) (*chunkV1.ChunkSummary, error) {
+	var terrain, nodes map[int32]int32
+	if err := json.Unmarshal(row.TerrainHistogram, &terrain); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(row.NodeCounts, &nodes); err != nil {
+		return nil, err
+	}
+
+	summary := &chunkV1.ChunkSummary{
+		ChunkX:       row.ChunkX,
+		ChunkY:       row.ChunkY,
+		HarvestCount: row.HarvestCount,
+		LastModified: timestamppb.New(row.LastModified.Time),
+	}
+	for _, key := range sortedKeys(terrain) {
+		summary.TerrainHistogram = append(summary.TerrainHistogram, &chunkV1.TerrainCount{
+			TerrainType: chunkV1.TerrainType(key),
+			Count:       terrain[key],
+		})
+	}
+	for _, key := range sortedKeys(nodes) {
+		summary.NodeCounts = append(summary.NodeCounts, &chunkV1.ResourceNodeCount{
+			ResourceNodeTypeId: resourceNodeV1.ResourceNodeTypeId(key),
+			Count:              nodes[key],
+		})
+	}
+	return summary, nil
+}
+
+func sortedKeys(m map[int32]int32) []int32 {
+	keys := make([]int32, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
@@ -0,0 +1,300 @@
+package chunk_summary
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	resourceNodeV1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const testWorldID = "550e8400e29b41d4a716446655440000"
+
+type chunkKey struct{ x, y int32 }
+
+// fakeDB keeps chunks, node counts and summaries in memory
+type fakeDB struct {
+	chunks    map[chunkKey][]byte
+	nodes     map[chunkKey][]db.CountResourceNodesByTypeInChunkRow
+	summaries map[chunkKey]db.ChunkSummary
+	stale     []db.ListStaleChunkSummariesRow
+
+	chunkDiscoveries    []db.ChunkDiscovery
+	resourceDiscoveries []db.ResourceDiscovery
+}
+
+func newFakeDB() *fakeDB {
+	return &fakeDB{
+		chunks:    make(map[chunkKey][]byte),
+		nodes:     make(map[chunkKey][]db.CountResourceNodesByTypeInChunkRow),
+		summaries: make(map[chunkKey]db.ChunkSummary),
+	}
+}
+
+func (f *fakeDB) GetChunk(ctx context.Context, arg db.GetChunkParams) (db.Chunk, error) {
+	data, ok := f.chunks[chunkKey{arg.ChunkX, arg.ChunkY}]
+	if !ok {
+		return db.Chunk{}, errors.New("no rows in result set")
+	}
+	return db.Chunk{WorldID: arg.WorldID, ChunkX: arg.ChunkX, ChunkY: arg.ChunkY, ChunkData: data}, nil
+}
+
+func (f *fakeDB) CountResourceNodesByTypeInChunk(ctx context.Context, arg db.CountResourceNodesByTypeInChunkParams) ([]db.CountResourceNodesByTypeInChunkRow, error) {
+	return f.nodes[chunkKey{arg.ChunkX, arg.ChunkY}], nil
+}
+
+func (f *fakeDB) UpsertChunkSummary(ctx context.Context, arg db.UpsertChunkSummaryParams) (db.ChunkSummary, error) {
+	key := chunkKey{arg.ChunkX, arg.ChunkY}
+	summary := f.summaries[key]
+	summary.WorldID = arg.WorldID
+	summary.ChunkX = arg.ChunkX
+	summary.ChunkY = arg.ChunkY
+	summary.TerrainHistogram = arg.TerrainHistogram
+	summary.NodeCounts = arg.NodeCounts
+	summary.LastModified = arg.LastModified
+	f.summaries[key] = summary
+	return summary, nil
+}
+
+func (f *fakeDB) IncrementChunkHarvestCount(ctx context.Context, arg db.IncrementChunkHarvestCountParams) (int64, error) {
+	key := chunkKey{arg.ChunkX, arg.ChunkY}
+	summary, ok := f.summaries[key]
+	if !ok {
+		return 0, nil
+	}
+	summary.HarvestCount++
+	summary.LastModified = arg.LastModified
+	f.summaries[key] = summary
+	return 1, nil
+}
+
+func (f *fakeDB) GetChunkSummariesInRange(ctx context.Context, arg db.GetChunkSummariesInRangeParams) ([]db.ChunkSummary, error) {
+	var rows []db.ChunkSummary
+	for key, summary := range f.summaries {
+		if key.x >= arg.ChunkX && key.x <= arg.ChunkX_2 && key.y >= arg.ChunkY && key.y <= arg.ChunkY_2 {
+			rows = append(rows, summary)
+		}
+	}
+	return rows, nil
+}
+
+func (f *fakeDB) ListStaleChunkSummaries(ctx context.Context, limit int32) ([]db.ListStaleChunkSummariesRow, error) {
+	return f.stale, nil
+}
+
+func (f *fakeDB) ListChunkDiscoveriesInRange(ctx context.Context, arg db.ListChunkDiscoveriesInRangeParams) ([]db.ChunkDiscovery, error) {
+	var rows []db.ChunkDiscovery
+	for _, row := range f.chunkDiscoveries {
+		if row.ChunkX >= arg.MinChunkX && row.ChunkX <= arg.MaxChunkX && row.ChunkY >= arg.MinChunkY && row.ChunkY <= arg.MaxChunkY {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+func (f *fakeDB) ListResourceDiscoveriesInRange(ctx context.Context, arg db.ListResourceDiscoveriesInRangeParams) ([]db.ResourceDiscovery, error) {
+	var rows []db.ResourceDiscovery
+	for _, row := range f.resourceDiscoveries {
+		if row.ChunkX >= arg.MinChunkX && row.ChunkX <= arg.MaxChunkX && row.ChunkY >= arg.MinChunkY && row.ChunkY <= arg.MaxChunkY {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+// storeChunk saves a chunk whose first waterCells cells are water and the rest grass
+func (f *fakeDB) storeChunk(t *testing.T, x, y int32, waterCells int) {
+	t.Helper()
+	cells := make([]*chunkV1.TerrainCell, 1024)
+	for i := range cells {
+		terrain := chunkV1.TerrainType_TERRAIN_TYPE_GRASS
+		if i < waterCells {
+			terrain = chunkV1.TerrainType_TERRAIN_TYPE_WATER
+		}
+		cells[i] = &chunkV1.TerrainCell{TerrainType: terrain}
+	}
+	data, err := proto.Marshal(&chunkV1.ChunkData{ChunkX: x, ChunkY: y, Cells: cells})
+	require.NoError(t, err)
+	f.chunks[chunkKey{x, y}] = data
+}
+
+func newTestService(store *fakeDB) (*Service, *clock.Fake) {
+	fake := clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	service := NewService(store, testmocks.NopLogger[LoggerInterface]{})
+	service.SetClock(fake)
+	return service, fake
+}
+
+func newEvent(t *testing.T, eventType, dedupKey string, payload any) events.Event {
+	t.Helper()
+	data, err := json.Marshal(payload)
+	require.NoError(t, err)
+	return events.Event{Type: eventType, DedupKey: dedupKey, Payload: data}
+}
+
+func TestProjection_ChunkGenerated(t *testing.T) {
+	store := newFakeDB()
+	store.storeChunk(t, 2, 3, 24)
+	store.nodes[chunkKey{2, 3}] = []db.CountResourceNodesByTypeInChunkRow{
+		{ResourceNodeTypeID: int32(resourceNodeV1.ResourceNodeTypeId_RESOURCE_NODE_TYPE_ID_FISHING_SPOT), NodeCount: 2},
+		{ResourceNodeTypeID: int32(resourceNodeV1.ResourceNodeTypeId_RESOURCE_NODE_TYPE_ID_HERB_PATCH), NodeCount: 5},
+	}
+	service, _ := newTestService(store)
+	bus := events.NewBus()
+	service.Subscribe(bus)
+
+	err := bus.Publish(context.Background(), newEvent(t, events.ChunkGenerated, "chunk.generated:w:2:3",
+		events.ChunkGeneratedPayload{WorldID: testWorldID, ChunkX: 2, ChunkY: 3}))
+	require.NoError(t, err)
+
+	worldID, err := uuid.StringToPgtype(testWorldID)
+	require.NoError(t, err)
+	summaries, err := service.GetChunkSummaries(context.Background(), worldID, 0, 5, 0, 5)
+	require.NoError(t, err)
+	require.Len(t, summaries, 1)
+
+	summary := summaries[0]
+	assert.Equal(t, []*chunkV1.TerrainCount{
+		{TerrainType: chunkV1.TerrainType_TERRAIN_TYPE_GRASS, Count: 1000},
+		{TerrainType: chunkV1.TerrainType_TERRAIN_TYPE_WATER, Count: 24},
+	}, summary.TerrainHistogram)
+	assert.Equal(t, []*chunkV1.ResourceNodeCount{
+		{ResourceNodeTypeId: resourceNodeV1.ResourceNodeTypeId_RESOURCE_NODE_TYPE_ID_HERB_PATCH, Count: 5},
+		{ResourceNodeTypeId: resourceNodeV1.ResourceNodeTypeId_RESOURCE_NODE_TYPE_ID_FISHING_SPOT, Count: 2},
+	}, summary.NodeCounts)
+	assert.Zero(t, summary.HarvestCount)
+}
+
+func TestProjection_ResourceHarvested(t *testing.T) {
+	store := newFakeDB()
+	store.storeChunk(t, 0, 0, 0)
+	service, fake := newTestService(store)
+	bus := events.NewBus()
+	service.Subscribe(bus)
+	ctx := context.Background()
+
+	harvest := func(harvestID string) events.Event {
+		return newEvent(t, events.ResourceHarvested, "resource.harvested:"+harvestID,
+			events.ResourceHarvestedPayload{HarvestID: harvestID, WorldID: testWorldID, ChunkX: 0, ChunkY: 0})
+	}
+
+	// The first harvest builds the missing summary before counting
+	require.NoError(t, bus.Publish(ctx, harvest("h1")))
+	fake.Advance(time.Minute)
+	require.NoError(t, bus.Publish(ctx, harvest("h2")))
+	require.NoError(t, bus.Publish(ctx, harvest("h2")), "redelivery is deduplicated")
+
+	summary := store.summaries[chunkKey{0, 0}]
+	assert.Equal(t, int32(2), summary.HarvestCount)
+	assert.Equal(t, fake.Now(), summary.LastModified.Time)
+
+	// Rebuilding the histogram keeps the harvest count
+	worldID, _ := uuid.StringToPgtype(testWorldID)
+	_, err := service.Refresh(ctx, worldID, 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), store.summaries[chunkKey{0, 0}].HarvestCount)
+}
+
+func TestProjection_InvalidPayloadIsRetried(t *testing.T) {
+	service, _ := newTestService(newFakeDB())
+	bus := events.NewBus()
+	service.Subscribe(bus)
+
+	err := bus.Publish(context.Background(), events.Event{Type: events.ChunkGenerated, DedupKey: "bad", Payload: []byte(`{`)})
+	assert.Error(t, err)
+}
+
+func TestRefreshStale(t *testing.T) {
+	store := newFakeDB()
+	store.storeChunk(t, 1, 1, 10)
+	worldID, _ := uuid.StringToPgtype(testWorldID)
+	store.stale = []db.ListStaleChunkSummariesRow{
+		{WorldID: worldID, ChunkX: 1, ChunkY: 1},
+		{WorldID: worldID, ChunkX: 9, ChunkY: 9}, // blob missing; skipped
+	}
+	service, _ := newTestService(store)
+
+	refreshed, err := service.RefreshStale(context.Background(), 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, refreshed)
+	assert.Contains(t, store.summaries, chunkKey{1, 1})
+}
+
+func TestGetChunkSummaries_Discoveries(t *testing.T) {
+	store := newFakeDB()
+	store.storeChunk(t, 1, 1, 0)
+	store.storeChunk(t, 2, 1, 0)
+	service, _ := newTestService(store)
+	ctx := context.Background()
+	worldID, _ := uuid.StringToPgtype(testWorldID)
+	for _, x := range []int32{1, 2} {
+		_, err := service.Refresh(ctx, worldID, x, 1)
+		require.NoError(t, err)
+	}
+
+	characterID, _ := uuid.StringToPgtype("00000000-0000-0000-0000-0000000000c1")
+	discoveredAt := pgtype.Timestamp{Time: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC), Valid: true}
+	store.chunkDiscoveries = []db.ChunkDiscovery{
+		{WorldID: worldID, ChunkX: 1, ChunkY: 1, CharacterID: characterID, CharacterName: "Ada", DiscoveredAt: discoveredAt},
+		{WorldID: worldID, ChunkX: 2, ChunkY: 1, CharacterName: "Grace", DiscoveredAt: discoveredAt}, // Character deleted
+		{WorldID: worldID, ChunkX: 3, ChunkY: 1, CharacterID: characterID, CharacterName: "Ada", DiscoveredAt: discoveredAt},
+	}
+	store.resourceDiscoveries = []db.ResourceDiscovery{
+		{WorldID: worldID, ResourceNodeTypeID: 2, ChunkX: 1, ChunkY: 1, CharacterID: characterID, CharacterName: "Ada", DiscoveredAt: discoveredAt},
+	}
+
+	summaries, err := service.GetChunkSummaries(ctx, worldID, 0, 5, 0, 5)
+	require.NoError(t, err)
+	require.Len(t, summaries, 2, "discoveries do not add summaries for chunks without one")
+	for _, summary := range summaries {
+		require.NotNil(t, summary.Discovery)
+		assert.Equal(t, discoveredAt.Time, summary.Discovery.DiscoveredAt.AsTime())
+		if summary.ChunkX == 1 {
+			assert.Equal(t, "00000000-0000-0000-0000-0000000000c1", summary.Discovery.CharacterId)
+			assert.Equal(t, "Ada", summary.Discovery.CharacterName)
+			require.Len(t, summary.ResourceDiscoveries, 1)
+			assert.Equal(t, int32(2), summary.ResourceDiscoveries[0].ResourceNodeTypeId)
+		} else {
+			assert.Empty(t, summary.Discovery.CharacterId)
+			assert.Equal(t, "Grace", summary.Discovery.CharacterName, "the name is kept after deletion")
+			assert.Empty(t, summary.ResourceDiscoveries)
+		}
+	}
+}
+
+func TestGetChunkSummaries_Validation(t *testing.T) {
+	service, _ := newTestService(newFakeDB())
+	worldID := pgtype.UUID{Valid: true}
+
+	tests := []struct {
+		name                   string
+		minX, maxX, minY, maxY int32
+	}{
+		{"inverted x", 5, 0, 0, 0},
+		{"inverted y", 0, 0, 5, 0},
+		{"area too large", 0, 64, 0, 64},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.GetChunkSummaries(context.Background(), worldID, tt.minX, tt.maxX, tt.minY, tt.maxY)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+
+	_, err := service.GetChunkSummaries(context.Background(), worldID, 0, 63, 0, 63)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,104 @@
+package chunk_summary
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/charmbracelet/log"
+)
+
+// DatabaseInterface abstracts database operations for the chunk summary projection.
+type DatabaseInterface interface {
+	GetChunk(ctx context.Context, arg db.GetChunkParams) (db.Chunk, error)
+	CountResourceNodesByTypeInChunk(ctx context.Context, arg db.CountResourceNodesByTypeInChunkParams) ([]db.CountResourceNodesByTypeInChunkRow, error)
+	UpsertChunkSummary(ctx context.Context, arg db.UpsertChunkSummaryParams) (db.ChunkSummary, error)
+	IncrementChunkHarvestCount(ctx context.Context, arg db.IncrementChunkHarvestCountParams) (int64, error)
+	GetChunkSummariesInRange(ctx context.Context, arg db.GetChunkSummariesInRangeParams) ([]db.ChunkSummary, error)
+	ListStaleChunkSummaries(ctx context.Context, limit int32) ([]db.ListStaleChunkSummariesRow, error)
+	ListChunkDiscoveriesInRange(ctx context.Context, arg db.ListChunkDiscoveriesInRangeParams) ([]db.ChunkDiscovery, error)
+	ListResourceDiscoveriesInRange(ctx context.Context, arg db.ListResourceDiscoveriesInRangeParams) ([]db.ResourceDiscovery, error)
+}
+
+// DatabaseWrapper implements DatabaseInterface using the actual database connection.
+type DatabaseWrapper struct {
+	queries *db.Queries
+}
+
+// NewDatabaseWrapper creates a new database wrapper with the given connection pool.
+func NewDatabaseWrapper(pool storage.Pool) DatabaseInterface {
+	return &DatabaseWrapper{
+		queries: db.New(pool),
+	}
+}
+
+func (d *DatabaseWrapper) GetChunk(ctx context.Context, arg db.GetChunkParams) (db.Chunk, error) {
+	return d.queries.GetChunk(ctx, arg)
+}
+
+func (d *DatabaseWrapper) CountResourceNodesByTypeInChunk(ctx context.Context, arg db.CountResourceNodesByTypeInChunkParams) ([]db.CountResourceNodesByTypeInChunkRow, error) {
+	return d.queries.CountResourceNodesByTypeInChunk(ctx, arg)
+}
+
+func (d *DatabaseWrapper) UpsertChunkSummary(ctx context.Context, arg db.UpsertChunkSummaryParams) (db.ChunkSummary, error) {
+	return d.queries.UpsertChunkSummary(ctx, arg)
+}
+
+func (d *DatabaseWrapper) IncrementChunkHarvestCount(ctx context.Context, arg db.IncrementChunkHarvestCountParams) (int64, error) {
+	return d.queries.IncrementChunkHarvestCount(ctx, arg)
+}
+
+func (d *DatabaseWrapper) GetChunkSummariesInRange(ctx context.Context, arg db.GetChunkSummariesInRangeParams) ([]db.ChunkSummary, error) {
+	return d.queries.GetChunkSummariesInRange(ctx, arg)
+}
+
+func (d *DatabaseWrapper) ListStaleChunkSummaries(ctx context.Context, limit int32) ([]db.ListStaleChunkSummariesRow, error) {
+	return d.queries.ListStaleChunkSummaries(ctx, limit)
+}
+
+func (d *DatabaseWrapper) ListChunkDiscoveriesInRange(ctx context.Context, arg db.ListChunkDiscoveriesInRangeParams) ([]db.ChunkDiscovery, error) {
+	return d.queries.ListChunkDiscoveriesInRange(ctx, arg)
+}
+
+func (d *DatabaseWrapper) ListResourceDiscoveriesInRange(ctx context.Context, arg db.ListResourceDiscoveriesInRangeParams) ([]db.ResourceDiscovery, error) {
+	return d.queries.ListResourceDiscoveriesInRange(ctx, arg)
+}
+
+// LoggerInterface abstracts logging operations for dependency injection.
+type LoggerInterface interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+	With(keysAndValues ...interface{}) LoggerInterface
+}
+
+// DefaultLoggerWrapper wraps the internal logging package.
+type DefaultLoggerWrapper struct {
+	logger *log.Logger
+}
+
+func NewDefaultLoggerWrapper() LoggerInterface {
+	return &DefaultLoggerWrapper{logger: logging.GetLogger()}
+}
+
+func (l *DefaultLoggerWrapper) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.Debug(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Info(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.Warn(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.Error(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) With(keysAndValues ...interface{}) LoggerInterface {
+	return &DefaultLoggerWrapper{logger: l.logger.With(keysAndValues...)}
+}
@@ -0,0 +1,357 @@
+// Package compass lists the notable locations around a character (its waypoints, the
+// rare resource nodes it has discovered, its friends' characters and its land claims)
+// with bearings and distances, so clients can draw a compass without the world state.
+package compass
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/authz"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	compassV1 "github.com/VoidMesh/api/api/proto/compass/v1"
+	resourceNodeV1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Config bounds compass queries and waypoints
+type Config struct {
+	// DefaultRadius is used when a request leaves the radius at 0
+	DefaultRadius int32
+	// MaxRadius caps the radius a request may ask for
+	MaxRadius int32
+	// MaxWaypoints is how many waypoints a character may have
+	MaxWaypoints int64
+	// MaxNameLength is the longest waypoint name in characters
+	MaxNameLength int
+}
+
+// DefaultConfig returns the production compass settings
+func DefaultConfig() Config {
+	return Config{
+		DefaultRadius: 256,
+		MaxRadius:     2048,
+		MaxWaypoints:  20,
+		MaxNameLength: 32,
+	}
+}
+
+// Service serves points of interest and manages waypoints.
+type Service struct {
+	db            DatabaseInterface
+	resourceTypes ResourceTypesInterface
+	config        Config
+	logger        LoggerInterface
+	clock         clock.Clock
+}
+
+// NewService creates a new compass service with dependency injection.
+func NewService(database DatabaseInterface, resourceTypes ResourceTypesInterface, logger LoggerInterface) *Service {
+	componentLogger := logger.With("component", "compass-service")
+	componentLogger.Debug("Creating new compass service")
+	return &Service{
+		db:            database,
+		resourceTypes: resourceTypes,
+		config:        DefaultConfig(),
+		logger:        componentLogger,
+		clock:         clock.New(),
+	}
+}
+
+// NewServiceWithPool creates a service with concrete implementations (convenience constructor for production use).
+func NewServiceWithPool(pool storage.Pool, resourceTypes ResourceTypesInterface) *Service {
+	return NewService(NewDatabaseWrapper(pool), resourceTypes, NewDefaultLoggerWrapper())
+}
+
+// SetClock replaces the clock used for timestamps (for simulation tests)
+func (s *Service) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// SetConfig replaces the compass settings
+func (s *Service) SetConfig(config Config) {
+	s.config = config
+}
+
+// Subscribe records discoveries from harvest events on the bus
+func (s *Service) Subscribe(bus *events.Bus) {
+	bus.Subscribe(events.ResourceHarvested, s.handleResourceHarvested)
+}
+
+// handleResourceHarvested records rare nodes as discovered by the harvesting character.
+// Recording a discovery twice changes nothing, so redelivered events are harmless.
+func (s *Service) handleResourceHarvested(ctx context.Context, event events.Event) error {
+	var payload events.ResourceHarvestedPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("invalid %s payload: %w", event.Type, err)
+	}
+
+	types, err := s.typesByID(ctx)
+	if err != nil {
+		return err
+	}
+	if resourceType, ok := types[payload.ResourceNodeTypeID]; !ok || !isRare(resourceType.Rarity) {
+		return nil
+	}
+
+	characterID, err := uuid.StringToPgtype(payload.CharacterID)
+	if err != nil {
+		return fmt.Errorf("invalid character ID in %s payload: %w", event.Type, err)
+	}
+	if err := s.db.CreateDiscovery(ctx, db.CreateDiscoveryParams{
+		CharacterID:    characterID,
+		ResourceNodeID: payload.ResourceNodeID,
+		DiscoveredAt:   pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
+	}); err != nil {
+		return fmt.Errorf("failed to record discovery: %w", err)
+	}
+	return nil
+}
+
+// isRare reports whether nodes of a rarity show on the compass once discovered
+func isRare(rarity resourceNodeV1.ResourceRarity) bool {
+	return rarity == resourceNodeV1.ResourceRarity_RESOURCE_RARITY_RARE ||
+		rarity == resourceNodeV1.ResourceRarity_RESOURCE_RARITY_VERY_RARE
+}
+
+func (s *Service) typesByID(ctx context.Context) (map[int32]*resourceNodeV1.ResourceNodeType, error) {
+	types, err := s.resourceTypes.GetResourceNodeTypes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resource node types: %w", err)
+	}
+	byID := make(map[int32]*resourceNodeV1.ResourceNodeType, len(types))
+	for _, resourceType := range types {
+		byID[resourceType.Id] = resourceType
+	}
+	return byID, nil
+}
+
+// GetPointsOfInterest lists the character's points of interest of the given types (all
+// types when empty) within radius cells, nearest first
+func (s *Service) GetPointsOfInterest(ctx context.Context, userID, characterID string, radius int32, types []compassV1.PointOfInterestType) ([]*compassV1.PointOfInterest, error) {
+	if radius < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "radius must not be negative")
+	}
+	if radius == 0 {
+		radius = s.config.DefaultRadius
+	}
+	radius = min(radius, s.config.MaxRadius)
+
+	character, err := authz.OwnedCharacter(ctx, s.db, s.logger, userID, characterID)
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := func(t compassV1.PointOfInterestType) bool {
+		if len(types) == 0 {
+			return true
+		}
+		for _, want := range types {
+			if want == t {
+				return true
+			}
+		}
+		return false
+	}
+
+	origin := geometry.Point{X: character.X, Y: character.Y}
+	var points []*compassV1.PointOfInterest
+	add := func(point *compassV1.PointOfInterest) {
+		point.Distance = distance(origin, geometry.Point{X: point.X, Y: point.Y})
+		if point.Distance > float64(radius) {
+			return
+		}
+		point.Bearing = bearing(origin, geometry.Point{X: point.X, Y: point.Y})
+		points = append(points, point)
+	}
+
+	if wanted(compassV1.PointOfInterestType_POINT_OF_INTEREST_TYPE_WAYPOINT) {
+		waypoints, err := s.db.ListWaypoints(ctx, character.ID)
+		if err != nil {
+			s.logger.Error("Failed to list waypoints", "character_id", characterID, "error", err)
+			return nil, status.Errorf(codes.Internal, "failed to list waypoints")
+		}
+		for _, w := range waypoints {
+			add(&compassV1.PointOfInterest{
+				Type: compassV1.PointOfInterestType_POINT_OF_INTEREST_TYPE_WAYPOINT,
+				Id:   strconv.FormatInt(w.ID, 10),
+				Name: w.Name,
+				X:    w.X,
+				Y:    w.Y,
+			})
+		}
+	}
+
+	if wanted(compassV1.PointOfInterestType_POINT_OF_INTEREST_TYPE_RARE_NODE) {
+		nodes, err := s.db.ListDiscoveredNodes(ctx, character.ID)
+		if err != nil {
+			s.logger.Error("Failed to list discovered nodes", "character_id", characterID, "error", err)
+			return nil, status.Errorf(codes.Internal, "failed to list discovered nodes")
+		}
+		resourceTypes, err := s.typesByID(ctx)
+		if err != nil {
+			s.logger.Error("Failed to get resource node types", "error", err)
+			return nil, status.Errorf(codes.Internal, "failed to get resource node types")
+		}
+		for _, node := range nodes {
+			if node.WorldID != character.WorldID {
+				continue
+			}
+			name := fmt.Sprintf("Resource %d", node.ResourceNodeTypeID)
+			if resourceType, ok := resourceTypes[node.ResourceNodeTypeID]; ok {
+				name = resourceType.Name
+			}
+			add(&compassV1.PointOfInterest{
+				Type:               compassV1.PointOfInterestType_POINT_OF_INTEREST_TYPE_RARE_NODE,
+				Id:                 strconv.FormatInt(int64(node.ID), 10),
+				Name:               name,
+				X:                  node.X,
+				Y:                  node.Y,
+				ResourceNodeTypeId: resourceNodeV1.ResourceNodeTypeId(node.ResourceNodeTypeID),
+			})
+		}
+	}
+
+	if wanted(compassV1.PointOfInterestType_POINT_OF_INTEREST_TYPE_FRIEND) {
+		friends, err := s.db.ListFriendCharactersInWorld(ctx, db.ListFriendCharactersInWorldParams{
+			UserID:  character.UserID,
+			WorldID: character.WorldID,
+		})
+		if err != nil {
+			s.logger.Error("Failed to list friend characters", "character_id", characterID, "error", err)
+			return nil, status.Errorf(codes.Internal, "failed to list friends")
+		}
+		for _, friend := range friends {
+			add(&compassV1.PointOfInterest{
+				Type: compassV1.PointOfInterestType_POINT_OF_INTEREST_TYPE_FRIEND,
+				Id:   uuid.PgtypeToString(friend.ID),
+				Name: friend.Name,
+				X:    friend.X,
+				Y:    friend.Y,
+			})
+		}
+	}
+
+	if wanted(compassV1.PointOfInterestType_POINT_OF_INTEREST_TYPE_CLAIM) {
+		claims, err := s.db.ListLandClaimsByCharacter(ctx, character.ID)
+		if err != nil {
+			s.logger.Error("Failed to list land claims", "character_id", characterID, "error", err)
+			return nil, status.Errorf(codes.Internal, "failed to list land claims")
+		}
+		for _, claim := range claims {
+			center := geometry.ChunkCenter(claim.ChunkX, claim.ChunkY)
+			add(&compassV1.PointOfInterest{
+				Type: compassV1.PointOfInterestType_POINT_OF_INTEREST_TYPE_CLAIM,
+				Id:   strconv.FormatInt(claim.ID, 10),
+				Name: fmt.Sprintf("Claim %d,%d", claim.ChunkX, claim.ChunkY),
+				X:    center.X,
+				Y:    center.Y,
+			})
+		}
+	}
+
+	sort.SliceStable(points, func(i, j int) bool {
+		if points[i].Distance != points[j].Distance {
+			return points[i].Distance < points[j].Distance
+		}
+		return points[i].Type < points[j].Type
+	})
+	return points, nil
+}
+
+// distance is the straight-line distance between two cells
+func distance(from, to geometry.Point) float64 {
+	return math.Hypot(float64(to.X-from.X), float64(to.Y-from.Y))
+}
+
+// bearing is the direction from one cell to another in degrees clockwise from north,
+// which is negative y; a point on the origin is due north
+func bearing(from, to geometry.Point) float64 {
+	degrees := math.Atan2(float64(to.X-from.X), float64(from.Y-to.Y)) * 180 / math.Pi
+	if degrees < 0 {
+		degrees += 360
+	}
+	return degrees
+}
+
+// AddWaypoint places a named waypoint for the character
+func (s *Service) AddWaypoint(ctx context.Context, userID, characterID, name string, x, y int32) (*compassV1.Waypoint, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "waypoint name must not be empty")
+	}
+	if utf8.RuneCountInString(name) > s.config.MaxNameLength {
+		return nil, status.Errorf(codes.InvalidArgument, "waypoint name must be at most %d characters", s.config.MaxNameLength)
+	}
+
+	character, err := authz.OwnedCharacter(ctx, s.db, s.logger, userID, characterID)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.db.CountWaypoints(ctx, character.ID)
+	if err != nil {
+		s.logger.Error("Failed to count waypoints", "character_id", characterID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to add waypoint")
+	}
+	if count >= s.config.MaxWaypoints {
+		return nil, status.Errorf(codes.FailedPrecondition, "character already has %d waypoints", s.config.MaxWaypoints)
+	}
+
+	waypoint, err := s.db.CreateWaypoint(ctx, db.CreateWaypointParams{
+		CharacterID: character.ID,
+		Name:        name,
+		X:           x,
+		Y:           y,
+		CreatedAt:   pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
+	})
+	if err != nil {
+		s.logger.Error("Failed to create waypoint", "character_id", characterID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to add waypoint")
+	}
+
+	s.logger.Debug("Added waypoint", "character_id", characterID, "waypoint_id", waypoint.ID)
+	return waypointToProto(waypoint), nil
+}
+
+// RemoveWaypoint deletes one of the character's waypoints
+func (s *Service) RemoveWaypoint(ctx context.Context, userID, characterID string, waypointID int64) error {
+	character, err := authz.OwnedCharacter(ctx, s.db, s.logger, userID, characterID)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := s.db.DeleteWaypoint(ctx, db.DeleteWaypointParams{ID: waypointID, CharacterID: character.ID})
+	if err != nil {
+		s.logger.Error("Failed to delete waypoint", "waypoint_id", waypointID, "error", err)
+		return status.Errorf(codes.Internal, "failed to remove waypoint")
+	}
+	if deleted == 0 {
+		return status.Errorf(codes.NotFound, "waypoint not found")
+	}
+	return nil
+}
+
+func waypointToProto(w db.CharacterWaypoint) *compassV1.Waypoint {
+	return &compassV1.Waypoint{
+		Id:        w.ID,
+		Name:      w.Name,
+		X:         w.X,
+		Y:         w.Y,
+		CreatedAt: timestamppb.New(w.CreatedAt.Time),
+	}
+}
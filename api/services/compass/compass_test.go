@@ -0,0 +1,216 @@
+package compass
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	compassV1 "github.com/VoidMesh/api/api/proto/compass/v1"
+	resourceNodeV1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeDB keeps one character's waypoints, discoveries, friends and claims in memory
+type fakeDB struct {
+	testmocks.Characters
+	waypoints   []db.CharacterWaypoint
+	nodes       map[int32]db.ResourceNode
+	discoveries map[int32]bool
+	friends     []db.Character
+	claims      []db.LandClaim
+}
+
+func (f *fakeDB) CreateWaypoint(ctx context.Context, arg db.CreateWaypointParams) (db.CharacterWaypoint, error) {
+	waypoint := db.CharacterWaypoint{
+		ID:          int64(len(f.waypoints) + 1),
+		CharacterID: arg.CharacterID,
+		Name:        arg.Name,
+		X:           arg.X,
+		Y:           arg.Y,
+		CreatedAt:   arg.CreatedAt,
+	}
+	f.waypoints = append(f.waypoints, waypoint)
+	return waypoint, nil
+}
+
+func (f *fakeDB) CountWaypoints(ctx context.Context, characterID pgtype.UUID) (int64, error) {
+	return int64(len(f.waypoints)), nil
+}
+
+func (f *fakeDB) ListWaypoints(ctx context.Context, characterID pgtype.UUID) ([]db.CharacterWaypoint, error) {
+	return f.waypoints, nil
+}
+
+func (f *fakeDB) DeleteWaypoint(ctx context.Context, arg db.DeleteWaypointParams) (int64, error) {
+	for i, waypoint := range f.waypoints {
+		if waypoint.ID == arg.ID && waypoint.CharacterID == arg.CharacterID {
+			f.waypoints = append(f.waypoints[:i], f.waypoints[i+1:]...)
+			return 1, nil
+		}
+	}
+	return 0, nil
+}
+
+func (f *fakeDB) CreateDiscovery(ctx context.Context, arg db.CreateDiscoveryParams) error {
+	f.discoveries[arg.ResourceNodeID] = true
+	return nil
+}
+
+func (f *fakeDB) ListDiscoveredNodes(ctx context.Context, characterID pgtype.UUID) ([]db.ResourceNode, error) {
+	var nodes []db.ResourceNode
+	for id := range f.discoveries {
+		nodes = append(nodes, f.nodes[id])
+	}
+	return nodes, nil
+}
+
+func (f *fakeDB) ListFriendCharactersInWorld(ctx context.Context, arg db.ListFriendCharactersInWorldParams) ([]db.Character, error) {
+	return f.friends, nil
+}
+
+func (f *fakeDB) ListLandClaimsByCharacter(ctx context.Context, characterID pgtype.UUID) ([]db.LandClaim, error) {
+	return f.claims, nil
+}
+
+// fakeResourceTypes serves a common and a rare resource type
+type fakeResourceTypes struct{}
+
+func (fakeResourceTypes) GetResourceNodeTypes(ctx context.Context) ([]*resourceNodeV1.ResourceNodeType, error) {
+	return []*resourceNodeV1.ResourceNodeType{
+		{Id: 1, Name: "Herb Patch", Rarity: resourceNodeV1.ResourceRarity_RESOURCE_RARITY_COMMON},
+		{Id: 2, Name: "Gem Deposit", Rarity: resourceNodeV1.ResourceRarity_RESOURCE_RARITY_RARE},
+	}, nil
+}
+
+const (
+	userID      = "550e8400-e29b-41d4-a716-446655440000"
+	characterID = "01000000-0000-0000-0000-000000000000"
+)
+
+var (
+	worldID   = pgtype.UUID{Bytes: [16]byte{9}, Valid: true}
+	character = db.Character{
+		ID:      pgtype.UUID{Bytes: [16]byte{1}, Valid: true},
+		UserID:  pgtype.UUID{Bytes: [16]byte{0x55, 0x0e, 0x84, 0x00, 0xe2, 0x9b, 0x41, 0xd4, 0xa7, 0x16, 0x44, 0x66, 0x55, 0x44, 0x00, 0x00}, Valid: true},
+		WorldID: worldID,
+		X:       10,
+		Y:       10,
+	}
+)
+
+func newTestService() (*Service, *fakeDB) {
+	database := &fakeDB{
+		Characters: testmocks.NewCharacters(character),
+		nodes: map[int32]db.ResourceNode{
+			1: {ID: 1, ResourceNodeTypeID: 1, WorldID: worldID, X: 12, Y: 12},
+			2: {ID: 2, ResourceNodeTypeID: 2, WorldID: worldID, X: 30, Y: 10},
+		},
+		discoveries: make(map[int32]bool),
+	}
+	service := NewService(database, fakeResourceTypes{}, testmocks.NopLogger[LoggerInterface]{})
+	service.SetClock(clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)))
+	return service, database
+}
+
+func harvested(t *testing.T, nodeID, typeID int32) events.Event {
+	t.Helper()
+	payload, err := json.Marshal(events.ResourceHarvestedPayload{
+		ResourceNodeID:     nodeID,
+		ResourceNodeTypeID: typeID,
+		CharacterID:        characterID,
+	})
+	require.NoError(t, err)
+	return events.Event{Type: events.ResourceHarvested, Payload: payload}
+}
+
+func TestGetPointsOfInterest(t *testing.T) {
+	service, database := newTestService()
+	ctx := session.WithWorldID(context.Background(), uuid.PgtypeToString(worldID))
+
+	// Harvesting a rare node discovers it; common nodes never show
+	bus := events.NewBus()
+	service.Subscribe(bus)
+	require.NoError(t, bus.Publish(ctx, harvested(t, 1, 1)))
+	require.NoError(t, bus.Publish(ctx, harvested(t, 2, 2)))
+	require.NoError(t, bus.Publish(ctx, harvested(t, 2, 2)))
+	assert.Equal(t, map[int32]bool{2: true}, database.discoveries)
+
+	_, err := service.AddWaypoint(ctx, userID, characterID, "Camp", 10, 0)
+	require.NoError(t, err)
+	_, err = service.AddWaypoint(ctx, userID, characterID, "Far away", 1000, 1000)
+	require.NoError(t, err)
+	database.friends = []db.Character{{ID: pgtype.UUID{Bytes: [16]byte{2}, Valid: true}, Name: "Friend", WorldID: worldID, X: 10, Y: 40}}
+	database.claims = []db.LandClaim{{ID: 7, ChunkX: -1, ChunkY: 0}}
+
+	points, err := service.GetPointsOfInterest(ctx, userID, characterID, 0, nil)
+	require.NoError(t, err)
+	require.Len(t, points, 4, "the far waypoint is outside the default radius")
+
+	assert.Equal(t, compassV1.PointOfInterestType_POINT_OF_INTEREST_TYPE_WAYPOINT, points[0].Type)
+	assert.Equal(t, "Camp", points[0].Name)
+	assert.InDelta(t, 10, points[0].Distance, 1e-9)
+	assert.InDelta(t, 0, points[0].Bearing, 1e-9, "negative y is north")
+
+	assert.Equal(t, compassV1.PointOfInterestType_POINT_OF_INTEREST_TYPE_RARE_NODE, points[1].Type)
+	assert.Equal(t, "Gem Deposit", points[1].Name)
+	assert.Equal(t, resourceNodeV1.ResourceNodeTypeId(2), points[1].ResourceNodeTypeId)
+	assert.InDelta(t, 20, points[1].Distance, 1e-9)
+	assert.InDelta(t, 90, points[1].Bearing, 1e-9)
+
+	// The claim is pointed at the centre of its chunk, (-16, 16)
+	assert.Equal(t, compassV1.PointOfInterestType_POINT_OF_INTEREST_TYPE_CLAIM, points[2].Type)
+	assert.Equal(t, int32(-16), points[2].X)
+	assert.Greater(t, points[2].Bearing, 180.0)
+	assert.Less(t, points[2].Bearing, 270.0)
+
+	assert.Equal(t, compassV1.PointOfInterestType_POINT_OF_INTEREST_TYPE_FRIEND, points[3].Type)
+	assert.InDelta(t, 180, points[3].Bearing, 1e-9)
+
+	points, err = service.GetPointsOfInterest(ctx, userID, characterID, 5000, []compassV1.PointOfInterestType{compassV1.PointOfInterestType_POINT_OF_INTEREST_TYPE_WAYPOINT})
+	require.NoError(t, err)
+	assert.Len(t, points, 2, "the radius is capped but still reaches the far waypoint")
+
+	_, err = service.GetPointsOfInterest(ctx, userID, characterID, -1, nil)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = service.GetPointsOfInterest(ctx, "650e8400-e29b-41d4-a716-446655440000", characterID, 0, nil)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = service.GetPointsOfInterest(ctx, userID, "02000000-0000-0000-0000-000000000000", 0, nil)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestWaypoints(t *testing.T) {
+	service, database := newTestService()
+	ctx := session.WithWorldID(context.Background(), uuid.PgtypeToString(worldID))
+
+	waypoint, err := service.AddWaypoint(ctx, userID, characterID, "  Home  ", 4, 5)
+	require.NoError(t, err)
+	assert.Equal(t, "Home", waypoint.Name)
+	assert.Equal(t, int32(5), waypoint.Y)
+
+	_, err = service.AddWaypoint(ctx, userID, characterID, "   ", 0, 0)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = service.AddWaypoint(ctx, userID, characterID, "a name that is far longer than allowed", 0, 0)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	for len(database.waypoints) < int(service.config.MaxWaypoints) {
+		_, err = service.AddWaypoint(ctx, userID, characterID, "Marker", 0, 0)
+		require.NoError(t, err)
+	}
+	_, err = service.AddWaypoint(ctx, userID, characterID, "One too many", 0, 0)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	require.NoError(t, service.RemoveWaypoint(ctx, userID, characterID, waypoint.Id))
+	assert.Equal(t, codes.NotFound, status.Code(service.RemoveWaypoint(ctx, userID, characterID, waypoint.Id)))
+	assert.Equal(t, codes.PermissionDenied, status.Code(service.RemoveWaypoint(ctx, "650e8400-e29b-41d4-a716-446655440000", characterID, 2)))
+}
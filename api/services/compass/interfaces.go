@@ -0,0 +1,114 @@
+package compass
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/storage"
+	resourceNodeV1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
+	"github.com/charmbracelet/log"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DatabaseInterface abstracts database operations for the compass.
+type DatabaseInterface interface {
+	GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error)
+	CreateWaypoint(ctx context.Context, arg db.CreateWaypointParams) (db.CharacterWaypoint, error)
+	CountWaypoints(ctx context.Context, characterID pgtype.UUID) (int64, error)
+	ListWaypoints(ctx context.Context, characterID pgtype.UUID) ([]db.CharacterWaypoint, error)
+	DeleteWaypoint(ctx context.Context, arg db.DeleteWaypointParams) (int64, error)
+	CreateDiscovery(ctx context.Context, arg db.CreateDiscoveryParams) error
+	ListDiscoveredNodes(ctx context.Context, characterID pgtype.UUID) ([]db.ResourceNode, error)
+	ListFriendCharactersInWorld(ctx context.Context, arg db.ListFriendCharactersInWorldParams) ([]db.Character, error)
+	ListLandClaimsByCharacter(ctx context.Context, characterID pgtype.UUID) ([]db.LandClaim, error)
+}
+
+// ResourceTypesInterface describes resource node types, for their names and rarities.
+type ResourceTypesInterface interface {
+	GetResourceNodeTypes(ctx context.Context) ([]*resourceNodeV1.ResourceNodeType, error)
+}
+
+// DatabaseWrapper implements DatabaseInterface using the actual database connection.
+type DatabaseWrapper struct {
+	queries *db.Queries
+}
+
+// NewDatabaseWrapper creates a new database wrapper with the given connection pool.
+func NewDatabaseWrapper(pool storage.Pool) DatabaseInterface {
+	return &DatabaseWrapper{queries: db.New(pool)}
+}
+
+func (d *DatabaseWrapper) GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error) {
+	return d.queries.GetCharacterById(ctx, id)
+}
+
+func (d *DatabaseWrapper) CreateWaypoint(ctx context.Context, arg db.CreateWaypointParams) (db.CharacterWaypoint, error) {
+	return d.queries.CreateWaypoint(ctx, arg)
+}
+
+func (d *DatabaseWrapper) CountWaypoints(ctx context.Context, characterID pgtype.UUID) (int64, error) {
+	return d.queries.CountWaypoints(ctx, characterID)
+}
+
+func (d *DatabaseWrapper) ListWaypoints(ctx context.Context, characterID pgtype.UUID) ([]db.CharacterWaypoint, error) {
+	return d.queries.ListWaypoints(ctx, characterID)
+}
+
+func (d *DatabaseWrapper) DeleteWaypoint(ctx context.Context, arg db.DeleteWaypointParams) (int64, error) {
+	return d.queries.DeleteWaypoint(ctx, arg)
+}
+
+func (d *DatabaseWrapper) CreateDiscovery(ctx context.Context, arg db.CreateDiscoveryParams) error {
+	return d.queries.CreateDiscovery(ctx, arg)
+}
+
+func (d *DatabaseWrapper) ListDiscoveredNodes(ctx context.Context, characterID pgtype.UUID) ([]db.ResourceNode, error) {
+	return d.queries.ListDiscoveredNodes(ctx, characterID)
+}
+
+func (d *DatabaseWrapper) ListFriendCharactersInWorld(ctx context.Context, arg db.ListFriendCharactersInWorldParams) ([]db.Character, error) {
+	return d.queries.ListFriendCharactersInWorld(ctx, arg)
+}
+
+func (d *DatabaseWrapper) ListLandClaimsByCharacter(ctx context.Context, characterID pgtype.UUID) ([]db.LandClaim, error) {
+	return d.queries.ListLandClaimsByCharacter(ctx, characterID)
+}
+
+// LoggerInterface abstracts logging operations for dependency injection.
+type LoggerInterface interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+	With(keysAndValues ...interface{}) LoggerInterface
+}
+
+// DefaultLoggerWrapper wraps the internal logging package.
+type DefaultLoggerWrapper struct {
+	logger *log.Logger
+}
+
+func NewDefaultLoggerWrapper() LoggerInterface {
+	return &DefaultLoggerWrapper{logger: logging.GetLogger()}
+}
+
+func (l *DefaultLoggerWrapper) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.Debug(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Info(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.Warn(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.Error(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) With(keysAndValues ...interface{}) LoggerInterface {
+	return &DefaultLoggerWrapper{logger: l.logger.With(keysAndValues...)}
+}
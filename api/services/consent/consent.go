@@ -0,0 +1,479 @@
+// Package consent tracks which versions of the terms of service and privacy policy each
+// user accepted. Publishing a required version gates gameplay RPCs for every user until
+// they accept it; the gate is answered from memory, so it costs no query per request for
+// users who are up to date. Documents are stored in the database and every instance
+// refreshes them periodically.
+package consent
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/alerting"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/debugstats"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	adminV1 "github.com/VoidMesh/api/api/proto/admin/v1"
+	userV1 "github.com/VoidMesh/api/api/proto/user/v1"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Document kinds, as stored in consent_documents.kind
+const (
+	KindTerms   = "terms"
+	KindPrivacy = "privacy"
+)
+
+const (
+	// DefaultRefreshInterval is how often published documents are reloaded, so a version
+	// published on another instance takes at most this long to gate players here
+	DefaultRefreshInterval = 30 * time.Second
+	MaxSummaryLength       = 500 // In characters
+	MaxURLLength           = 2048
+
+	// recheckInterval limits how often a user who has not accepted is looked up again, in
+	// case they accepted on another instance
+	recheckInterval = 5 * time.Second
+	// cacheTTL is how long a user's acceptances are kept in memory after loading
+	cacheTTL = 10 * time.Minute
+)
+
+// ReasonConsentRequired is the ErrorInfo reason of requests rejected until the caller
+// accepts the current documents. Its metadata maps each kind to accept to the version
+// required.
+const ReasonConsentRequired = "CONSENT_REQUIRED"
+
+const errorDomain = "consent.voidmesh"
+
+var kindNames = map[userV1.ConsentKind]string{
+	userV1.ConsentKind_CONSENT_KIND_TERMS_OF_SERVICE: KindTerms,
+	userV1.ConsentKind_CONSENT_KIND_PRIVACY_POLICY:   KindPrivacy,
+}
+
+var kindTitles = map[string]string{
+	KindTerms:   "terms of service",
+	KindPrivacy: "privacy policy",
+}
+
+// kindOrder is the order documents are listed in
+var kindOrder = []string{KindTerms, KindPrivacy}
+
+// Service stores consent documents and acceptances and enforces the latest required
+// versions. It is safe for concurrent use.
+type Service struct {
+	db       DatabaseInterface
+	logger   LoggerInterface
+	clock    clock.Clock
+	interval time.Duration
+
+	mu        sync.RWMutex
+	documents map[string]db.ConsentDocument // Current version by kind
+	required  map[string]int32              // Latest required version by kind
+	loaded    bool
+	accepted  map[string]acceptance // By user ID
+}
+
+// acceptance is the latest version of each kind a user accepted
+type acceptance struct {
+	versions map[string]int32
+	loadedAt time.Time
+}
+
+// NewService creates a new consent service with dependency injection.
+func NewService(database DatabaseInterface, logger LoggerInterface) *Service {
+	componentLogger := logger.With("component", "consent-service")
+	componentLogger.Debug("Creating new consent service")
+	s := &Service{
+		db:       database,
+		logger:   componentLogger,
+		clock:    clock.New(),
+		interval: DefaultRefreshInterval,
+		accepted: make(map[string]acceptance),
+	}
+	debugstats.Register(debugstats.CacheEntries, "consent.users", func() int64 {
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+		return int64(len(s.accepted))
+	})
+	return s
+}
+
+// NewServiceWithPool creates a service with concrete implementations (convenience constructor for production use).
+func NewServiceWithPool(pool storage.Pool) *Service {
+	return NewService(NewDatabaseWrapper(pool), NewDefaultLoggerWrapper())
+}
+
+// SetClock replaces the clock used for timestamps, the caches and the refresh schedule (for simulation tests)
+func (s *Service) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// Refresh reloads the published documents from the database
+func (s *Service) Refresh(ctx context.Context) error {
+	documents, err := s.db.ListLatestConsentDocuments(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list consent documents: %w", err)
+	}
+	versions, err := s.db.ListRequiredConsentVersions(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list required consent versions: %w", err)
+	}
+
+	byKind := make(map[string]db.ConsentDocument, len(documents))
+	for _, document := range documents {
+		byKind[document.Kind] = document
+	}
+	required := make(map[string]int32, len(versions))
+	for _, version := range versions {
+		required[version.Kind] = version.Version
+	}
+	s.mu.Lock()
+	s.documents = byKind
+	s.required = required
+	s.loaded = true
+	s.mu.Unlock()
+	return nil
+}
+
+// current returns the cached documents and required versions, loading them on first use.
+// If they cannot be loaded nobody is gated.
+func (s *Service) current(ctx context.Context) (map[string]db.ConsentDocument, map[string]int32) {
+	s.mu.RLock()
+	documents, required, loaded := s.documents, s.required, s.loaded
+	s.mu.RUnlock()
+	if loaded {
+		return documents, required
+	}
+	if err := s.Refresh(ctx); err != nil {
+		s.logger.Error("Failed to load consent documents", "error", err)
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.documents, s.required
+}
+
+// Run refreshes the documents and drops stale acceptances until the context is cancelled
+func (s *Service) Run(ctx context.Context) {
+	s.logger.Info("Consent document refresh started", "interval", s.interval)
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("Consent document refresh stopped")
+			return
+		case <-s.clock.After(s.interval):
+		}
+
+		s.prune()
+		if err := s.Refresh(ctx); err != nil {
+			s.logger.Error("Consent document refresh failed", "error", err)
+			alerting.ReportJobError("consent_refresh", err)
+		}
+	}
+}
+
+// prune drops acceptances loaded more than cacheTTL ago; they are loaded again on use
+func (s *Service) prune() {
+	now := s.clock.Now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for userID, cached := range s.accepted {
+		if now.Sub(cached.loadedAt) >= cacheTTL {
+			delete(s.accepted, userID)
+		}
+	}
+}
+
+// CheckConsent returns a typed FailedPrecondition error while the user has not accepted
+// the latest required version of every document. If acceptances cannot be loaded the
+// user is let through.
+func (s *Service) CheckConsent(ctx context.Context, userID string) error {
+	_, required := s.current(ctx)
+	if len(required) == 0 {
+		return nil
+	}
+	user, err := uuid.StringToPgtype(userID)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid user ID format")
+	}
+	key := uuid.PgtypeToString(user)
+
+	s.mu.RLock()
+	cached, ok := s.accepted[key]
+	s.mu.RUnlock()
+	if ok && len(missing(required, cached.versions)) == 0 {
+		return nil
+	}
+	if !ok || s.clock.Now().Sub(cached.loadedAt) >= recheckInterval {
+		consents, err := s.loadAccepted(ctx, user)
+		if err != nil {
+			s.logger.Error("Failed to load consents", "user_id", userID, "error", err)
+			return nil
+		}
+		cached = acceptance{versions: latestVersions(consents)}
+	}
+
+	if outstanding := missing(required, cached.versions); len(outstanding) > 0 {
+		return consentError(outstanding)
+	}
+	return nil
+}
+
+// loadAccepted reads the user's latest acceptances and caches their versions
+func (s *Service) loadAccepted(ctx context.Context, user pgtype.UUID) ([]db.UserConsent, error) {
+	consents, err := s.db.ListLatestUserConsents(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	s.accepted[uuid.PgtypeToString(user)] = acceptance{versions: latestVersions(consents), loadedAt: s.clock.Now()}
+	s.mu.Unlock()
+	return consents, nil
+}
+
+// Status returns the current documents and which of them the user must accept
+func (s *Service) Status(ctx context.Context, userID string) ([]*userV1.ConsentDocument, error) {
+	user, err := uuid.StringToPgtype(userID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID format")
+	}
+	documents, required := s.current(ctx)
+	consents, err := s.loadAccepted(ctx, user)
+	if err != nil {
+		s.logger.Error("Failed to load consents", "user_id", userID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to get consent status")
+	}
+	byKind := make(map[string]db.UserConsent, len(consents))
+	for _, consent := range consents {
+		byKind[consent.Kind] = consent
+	}
+
+	var result []*userV1.ConsentDocument
+	for _, kind := range kindOrder {
+		document, ok := documents[kind]
+		if !ok {
+			continue
+		}
+		var accepted *db.UserConsent
+		if consent, ok := byKind[kind]; ok {
+			accepted = &consent
+		}
+		result = append(result, documentToProto(document, required[kind], accepted))
+	}
+	return result, nil
+}
+
+// Accept records that the user accepted the current version of a document. Only the
+// current version can be accepted, so players consent to the text they were shown.
+func (s *Service) Accept(ctx context.Context, userID string, kind userV1.ConsentKind, version int32, ipAddress string) (*userV1.ConsentDocument, error) {
+	user, err := uuid.StringToPgtype(userID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID format")
+	}
+	name, ok := kindNames[kind]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "kind is required")
+	}
+
+	documents, err := s.db.ListLatestConsentDocuments(ctx)
+	if err != nil {
+		s.logger.Error("Failed to list consent documents", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to accept %s", kindTitles[name])
+	}
+	var document db.ConsentDocument
+	found := false
+	for _, d := range documents {
+		if d.Kind == name {
+			document, found = d, true
+		}
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no %s has been published", kindTitles[name])
+	}
+	if version != document.Version {
+		return nil, status.Errorf(codes.FailedPrecondition, "version %d of the %s is not current; the current version is %d", version, kindTitles[name], document.Version)
+	}
+
+	consent := db.CreateUserConsentParams{
+		UserID:     user,
+		Kind:       name,
+		Version:    version,
+		AcceptedAt: pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
+		IpAddress:  ipAddress,
+	}
+	if err := s.db.CreateUserConsent(ctx, consent); err != nil {
+		s.logger.Error("Failed to store consent", "user_id", userID, "kind", name, "version", version, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to accept %s", kindTitles[name])
+	}
+
+	// Users not cached are loaded with this acceptance on their next request
+	s.mu.Lock()
+	key := uuid.PgtypeToString(user)
+	if cached, ok := s.accepted[key]; ok && cached.versions[name] < version {
+		versions := make(map[string]int32, len(cached.versions)+1)
+		for k, v := range cached.versions {
+			versions[k] = v
+		}
+		versions[name] = version
+		s.accepted[key] = acceptance{versions: versions, loadedAt: cached.loadedAt}
+	}
+	required := s.required[name]
+	s.mu.Unlock()
+
+	s.logger.Info("Consent accepted", "user_id", userID, "kind", name, "version", version)
+	return documentToProto(document, required, &db.UserConsent{
+		UserID:     user,
+		Kind:       name,
+		Version:    version,
+		AcceptedAt: consent.AcceptedAt,
+		IpAddress:  ipAddress,
+	}), nil
+}
+
+// Publish stores the next version of a document. A required version gates every player
+// who has not accepted it, on this instance at once and on others within the refresh
+// interval.
+func (s *Service) Publish(ctx context.Context, publishedBy string, req *adminV1.PublishConsentDocumentRequest) (*userV1.ConsentDocument, error) {
+	publishedByID, err := uuid.StringToPgtype(publishedBy)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID format")
+	}
+	name, ok := kindNames[req.Kind]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "kind is required")
+	}
+	if err := validateURL(req.Url); err != nil {
+		return nil, err
+	}
+	summary := strings.TrimSpace(req.Summary)
+	if utf8.RuneCountInString(summary) > MaxSummaryLength {
+		return nil, status.Errorf(codes.InvalidArgument, "summary must be at most %d characters", MaxSummaryLength)
+	}
+
+	document, err := s.db.PublishConsentDocument(ctx, db.PublishConsentDocumentParams{
+		Kind:        name,
+		Url:         req.Url,
+		Summary:     summary,
+		Required:    req.Required,
+		PublishedBy: publishedByID,
+		PublishedAt: pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
+	})
+	if err != nil {
+		s.logger.Error("Failed to publish consent document", "kind", name, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to publish %s", kindTitles[name])
+	}
+	if err := s.Refresh(ctx); err != nil {
+		s.logger.Warn("Failed to reload consent documents after publishing", "error", err)
+	}
+	s.logger.Info("Consent document published", "kind", name, "version", document.Version, "required", document.Required, "published_by", publishedBy)
+
+	_, required := s.current(ctx)
+	return documentToProto(document, required[name], nil), nil
+}
+
+func validateURL(raw string) error {
+	if raw == "" {
+		return status.Errorf(codes.InvalidArgument, "url is required")
+	}
+	if len(raw) > MaxURLLength {
+		return status.Errorf(codes.InvalidArgument, "url must be at most %d bytes", MaxURLLength)
+	}
+	parsed, err := url.Parse(raw)
+	if err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" {
+		return status.Errorf(codes.InvalidArgument, "url must be an absolute http(s) URL")
+	}
+	return nil
+}
+
+// latestVersions maps each kind to the latest version accepted
+func latestVersions(consents []db.UserConsent) map[string]int32 {
+	versions := make(map[string]int32, len(consents))
+	for _, consent := range consents {
+		versions[consent.Kind] = max(versions[consent.Kind], consent.Version)
+	}
+	return versions
+}
+
+// missing returns the kinds whose required version was not accepted, with that version
+func missing(required, accepted map[string]int32) map[string]int32 {
+	var outstanding map[string]int32
+	for kind, version := range required {
+		if accepted[kind] < version {
+			if outstanding == nil {
+				outstanding = make(map[string]int32)
+			}
+			outstanding[kind] = version
+		}
+	}
+	return outstanding
+}
+
+// consentError tells the client which documents to accept before it may continue
+func consentError(outstanding map[string]int32) error {
+	kinds := make([]string, 0, len(outstanding))
+	for kind := range outstanding {
+		kinds = append(kinds, kind)
+	}
+	sort.Slice(kinds, func(i, j int) bool { return kindRank(kinds[i]) < kindRank(kinds[j]) })
+
+	titles := make([]string, 0, len(kinds))
+	metadata := make(map[string]string, len(kinds))
+	for _, kind := range kinds {
+		titles = append(titles, kindTitles[kind])
+		metadata[kind] = strconv.Itoa(int(outstanding[kind]))
+	}
+	st := status.New(codes.FailedPrecondition, fmt.Sprintf("accept the %s to continue", strings.Join(titles, " and ")))
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   ReasonConsentRequired,
+		Domain:   errorDomain,
+		Metadata: metadata,
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+func kindRank(kind string) int {
+	for i, k := range kindOrder {
+		if k == kind {
+			return i
+		}
+	}
+	return len(kindOrder)
+}
+
+func documentToProto(document db.ConsentDocument, requiredVersion int32, accepted *db.UserConsent) *userV1.ConsentDocument {
+	var kind userV1.ConsentKind
+	for k, name := range kindNames {
+		if name == document.Kind {
+			kind = k
+		}
+	}
+	result := &userV1.ConsentDocument{
+		Kind:            kind,
+		Version:         document.Version,
+		Url:             document.Url,
+		Summary:         document.Summary,
+		PublishedAt:     timestamppb.New(document.PublishedAt.Time),
+		RequiredVersion: requiredVersion,
+	}
+	if accepted != nil {
+		result.AcceptedVersion = accepted.Version
+		result.AcceptedAt = timestamppb.New(accepted.AcceptedAt.Time)
+	}
+	result.MustAccept = result.AcceptedVersion < requiredVersion
+	return result
+}
@@ -0,0 +1,256 @@
+package consent
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	adminV1 "github.com/VoidMesh/api/api/proto/admin/v1"
+	userV1 "github.com/VoidMesh/api/api/proto/user/v1"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeDB keeps documents and acceptances in memory
+type fakeDB struct {
+	documents   []db.ConsentDocument
+	consents    []db.UserConsent
+	userLookups int
+	failUsers   bool
+}
+
+func (f *fakeDB) ListLatestConsentDocuments(ctx context.Context) ([]db.ConsentDocument, error) {
+	latest := map[string]db.ConsentDocument{}
+	for _, document := range f.documents {
+		if document.Version > latest[document.Kind].Version {
+			latest[document.Kind] = document
+		}
+	}
+	var documents []db.ConsentDocument
+	for _, document := range latest {
+		documents = append(documents, document)
+	}
+	return documents, nil
+}
+
+func (f *fakeDB) ListRequiredConsentVersions(ctx context.Context) ([]db.ListRequiredConsentVersionsRow, error) {
+	required := map[string]int32{}
+	for _, document := range f.documents {
+		if document.Required {
+			required[document.Kind] = max(required[document.Kind], document.Version)
+		}
+	}
+	var rows []db.ListRequiredConsentVersionsRow
+	for kind, version := range required {
+		rows = append(rows, db.ListRequiredConsentVersionsRow{Kind: kind, Version: version})
+	}
+	return rows, nil
+}
+
+func (f *fakeDB) PublishConsentDocument(ctx context.Context, arg db.PublishConsentDocumentParams) (db.ConsentDocument, error) {
+	var version int32
+	for _, document := range f.documents {
+		if document.Kind == arg.Kind {
+			version = max(version, document.Version)
+		}
+	}
+	document := db.ConsentDocument{
+		Kind:        arg.Kind,
+		Version:     version + 1,
+		Url:         arg.Url,
+		Summary:     arg.Summary,
+		Required:    arg.Required,
+		PublishedBy: arg.PublishedBy,
+		PublishedAt: arg.PublishedAt,
+	}
+	f.documents = append(f.documents, document)
+	return document, nil
+}
+
+func (f *fakeDB) ListLatestUserConsents(ctx context.Context, userID pgtype.UUID) ([]db.UserConsent, error) {
+	f.userLookups++
+	if f.failUsers {
+		return nil, errors.New("connection refused")
+	}
+	var consents []db.UserConsent
+	for _, consent := range f.consents {
+		if consent.UserID == userID {
+			consents = append(consents, consent)
+		}
+	}
+	return consents, nil
+}
+
+func (f *fakeDB) CreateUserConsent(ctx context.Context, arg db.CreateUserConsentParams) error {
+	f.consents = append(f.consents, db.UserConsent(arg))
+	return nil
+}
+
+const (
+	adminID = "00000000-0000-0000-0000-0000000000aa"
+	userID  = "00000000-0000-0000-0000-000000000001"
+	otherID = "00000000000000000000000000000002"
+)
+
+func newTestService() (*Service, *fakeDB, *clock.Fake) {
+	database := &fakeDB{}
+	fake := clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	service := NewService(database, testmocks.NopLogger[LoggerInterface]{})
+	service.SetClock(fake)
+	return service, database, fake
+}
+
+func publish(t *testing.T, service *Service, kind userV1.ConsentKind, required bool) *userV1.ConsentDocument {
+	t.Helper()
+	document, err := service.Publish(context.Background(), adminID, &adminV1.PublishConsentDocumentRequest{
+		Kind:     kind,
+		Url:      "https://voidmesh.example/legal",
+		Summary:  "  New arbitration clause ",
+		Required: required,
+	})
+	require.NoError(t, err)
+	return document
+}
+
+func TestCheckConsent(t *testing.T) {
+	service, database, fake := newTestService()
+	ctx := context.Background()
+
+	require.NoError(t, service.CheckConsent(ctx, userID), "nothing is published yet")
+
+	terms := publish(t, service, userV1.ConsentKind_CONSENT_KIND_TERMS_OF_SERVICE, true)
+	assert.Equal(t, int32(1), terms.Version)
+	assert.Equal(t, int32(1), terms.RequiredVersion)
+	assert.Equal(t, "New arbitration clause", terms.Summary)
+	publish(t, service, userV1.ConsentKind_CONSENT_KIND_PRIVACY_POLICY, true)
+
+	err := service.CheckConsent(ctx, userID)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Equal(t, "accept the terms of service and privacy policy to continue", status.Convert(err).Message())
+	info := status.Convert(err).Details()[0].(*errdetails.ErrorInfo)
+	assert.Equal(t, ReasonConsentRequired, info.Reason)
+	assert.Equal(t, map[string]string{"terms": "1", "privacy": "1"}, info.Metadata)
+
+	_, err = service.Accept(ctx, userID, userV1.ConsentKind_CONSENT_KIND_TERMS_OF_SERVICE, 1, "203.0.113.7")
+	require.NoError(t, err)
+	err = service.CheckConsent(ctx, userID)
+	assert.Equal(t, map[string]string{"privacy": "1"}, status.Convert(err).Details()[0].(*errdetails.ErrorInfo).Metadata)
+
+	accepted, err := service.Accept(ctx, userID, userV1.ConsentKind_CONSENT_KIND_PRIVACY_POLICY, 1, "203.0.113.7")
+	require.NoError(t, err)
+	assert.False(t, accepted.MustAccept)
+	require.NoError(t, service.CheckConsent(ctx, userID))
+
+	lookups := database.userLookups
+	require.NoError(t, service.CheckConsent(ctx, userID))
+	assert.Equal(t, lookups, database.userLookups, "users who are up to date are answered from memory")
+
+	// An edit players need not accept again does not gate anyone
+	publish(t, service, userV1.ConsentKind_CONSENT_KIND_TERMS_OF_SERVICE, false)
+	require.NoError(t, service.CheckConsent(ctx, userID))
+
+	// A required update gates everyone until they accept it
+	publish(t, service, userV1.ConsentKind_CONSENT_KIND_TERMS_OF_SERVICE, true)
+	err = service.CheckConsent(ctx, userID)
+	assert.Equal(t, map[string]string{"terms": "3"}, status.Convert(err).Details()[0].(*errdetails.ErrorInfo).Metadata)
+
+	lookups = database.userLookups
+	assert.Error(t, service.CheckConsent(ctx, userID))
+	assert.Equal(t, lookups, database.userLookups, "users who have not accepted are looked up again at most every few seconds")
+
+	// Accepted on another instance
+	database.consents = append(database.consents, db.UserConsent{UserID: database.consents[0].UserID, Kind: KindTerms, Version: 3})
+	assert.Error(t, service.CheckConsent(ctx, userID))
+	fake.Advance(recheckInterval)
+	require.NoError(t, service.CheckConsent(ctx, userID))
+
+	database.failUsers = true
+	assert.NoError(t, service.CheckConsent(ctx, otherID), "players are let through when acceptances cannot be loaded")
+	_, err = service.Status(ctx, otherID)
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestAccept(t *testing.T) {
+	service, database, _ := newTestService()
+	ctx := context.Background()
+
+	_, err := service.Accept(ctx, userID, userV1.ConsentKind_CONSENT_KIND_TERMS_OF_SERVICE, 1, "")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = service.Accept(ctx, userID, userV1.ConsentKind_CONSENT_KIND_UNSPECIFIED, 1, "")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = service.Accept(ctx, "not-a-uuid", userV1.ConsentKind_CONSENT_KIND_TERMS_OF_SERVICE, 1, "")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	publish(t, service, userV1.ConsentKind_CONSENT_KIND_TERMS_OF_SERVICE, true)
+	publish(t, service, userV1.ConsentKind_CONSENT_KIND_TERMS_OF_SERVICE, false)
+
+	_, err = service.Accept(ctx, userID, userV1.ConsentKind_CONSENT_KIND_TERMS_OF_SERVICE, 1, "")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "only the current version can be accepted")
+	assert.Contains(t, status.Convert(err).Message(), "the current version is 2")
+
+	documents, err := service.Status(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, documents, 1, "kinds without a published document are left out")
+	assert.Equal(t, int32(2), documents[0].Version)
+	assert.Equal(t, int32(1), documents[0].RequiredVersion)
+	assert.Zero(t, documents[0].AcceptedVersion)
+	assert.True(t, documents[0].MustAccept)
+
+	accepted, err := service.Accept(ctx, userID, userV1.ConsentKind_CONSENT_KIND_TERMS_OF_SERVICE, 2, "203.0.113.7")
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), accepted.AcceptedVersion)
+	assert.False(t, accepted.MustAccept)
+	assert.Equal(t, "203.0.113.7", database.consents[0].IpAddress)
+
+	documents, err = service.Status(ctx, userID)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), documents[0].AcceptedVersion)
+	assert.False(t, documents[0].MustAccept)
+}
+
+func TestPublish_Validation(t *testing.T) {
+	service, _, _ := newTestService()
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		req  *adminV1.PublishConsentDocumentRequest
+	}{
+		{"no kind", &adminV1.PublishConsentDocumentRequest{Url: "https://voidmesh.example/terms"}},
+		{"no url", &adminV1.PublishConsentDocumentRequest{Kind: userV1.ConsentKind_CONSENT_KIND_TERMS_OF_SERVICE}},
+		{"relative url", &adminV1.PublishConsentDocumentRequest{Kind: userV1.ConsentKind_CONSENT_KIND_TERMS_OF_SERVICE, Url: "/terms"}},
+		{"other scheme", &adminV1.PublishConsentDocumentRequest{Kind: userV1.ConsentKind_CONSENT_KIND_TERMS_OF_SERVICE, Url: "javascript:alert(1)"}},
+		{"long summary", &adminV1.PublishConsentDocumentRequest{
+			Kind:    userV1.ConsentKind_CONSENT_KIND_PRIVACY_POLICY,
+			Url:     "https://voidmesh.example/privacy",
+			Summary: string(make([]rune, MaxSummaryLength+1)),
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.Publish(ctx, adminID, tt.req)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+func TestPrune(t *testing.T) {
+	service, _, fake := newTestService()
+	ctx := context.Background()
+
+	publish(t, service, userV1.ConsentKind_CONSENT_KIND_TERMS_OF_SERVICE, true)
+	assert.Error(t, service.CheckConsent(ctx, userID))
+	require.Len(t, service.accepted, 1)
+
+	fake.Advance(cacheTTL)
+	service.prune()
+	assert.Empty(t, service.accepted)
+}
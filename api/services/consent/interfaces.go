@@ -0,0 +1,102 @@
+package consent
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/txn"
+	"github.com/charmbracelet/log"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DatabaseInterface abstracts database operations for consent documents and acceptances.
+type DatabaseInterface interface {
+	ListLatestConsentDocuments(ctx context.Context) ([]db.ConsentDocument, error)
+	ListRequiredConsentVersions(ctx context.Context) ([]db.ListRequiredConsentVersionsRow, error)
+	// PublishConsentDocument stores the next version of a kind of document
+	PublishConsentDocument(ctx context.Context, arg db.PublishConsentDocumentParams) (db.ConsentDocument, error)
+	ListLatestUserConsents(ctx context.Context, userID pgtype.UUID) ([]db.UserConsent, error)
+	CreateUserConsent(ctx context.Context, arg db.CreateUserConsentParams) error
+}
+
+// DatabaseWrapper implements DatabaseInterface using the actual database connection.
+type DatabaseWrapper struct {
+	pool    storage.Pool
+	queries *db.Queries
+}
+
+// NewDatabaseWrapper creates a new database wrapper with the given connection pool.
+func NewDatabaseWrapper(pool storage.Pool) DatabaseInterface {
+	return &DatabaseWrapper{
+		pool:    pool,
+		queries: db.New(pool),
+	}
+}
+
+func (d *DatabaseWrapper) ListLatestConsentDocuments(ctx context.Context) ([]db.ConsentDocument, error) {
+	return d.queries.ListLatestConsentDocuments(ctx)
+}
+
+func (d *DatabaseWrapper) ListRequiredConsentVersions(ctx context.Context) ([]db.ListRequiredConsentVersionsRow, error) {
+	return d.queries.ListRequiredConsentVersions(ctx)
+}
+
+// PublishConsentDocument numbers the version from the kind's latest one; serializable
+// isolation retries a publish that raced another instead of failing on the primary key
+func (d *DatabaseWrapper) PublishConsentDocument(ctx context.Context, arg db.PublishConsentDocumentParams) (db.ConsentDocument, error) {
+	var document db.ConsentDocument
+	err := txn.Run(ctx, d.pool, txn.Serializable, func(q *db.Queries) error {
+		var err error
+		document, err = q.PublishConsentDocument(ctx, arg)
+		return err
+	})
+	return document, err
+}
+
+func (d *DatabaseWrapper) ListLatestUserConsents(ctx context.Context, userID pgtype.UUID) ([]db.UserConsent, error) {
+	return d.queries.ListLatestUserConsents(ctx, userID)
+}
+
+func (d *DatabaseWrapper) CreateUserConsent(ctx context.Context, arg db.CreateUserConsentParams) error {
+	return d.queries.CreateUserConsent(ctx, arg)
+}
+
+// LoggerInterface abstracts logging operations for dependency injection.
+type LoggerInterface interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+	With(keysAndValues ...interface{}) LoggerInterface
+}
+
+// DefaultLoggerWrapper wraps the internal logging package.
+type DefaultLoggerWrapper struct {
+	logger *log.Logger
+}
+
+func NewDefaultLoggerWrapper() LoggerInterface {
+	return &DefaultLoggerWrapper{logger: logging.GetLogger()}
+}
+
+func (l *DefaultLoggerWrapper) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.Debug(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Info(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.Warn(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.Error(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) With(keysAndValues ...interface{}) LoggerInterface {
+	return &DefaultLoggerWrapper{logger: l.logger.With(keysAndValues...)}
+}
@@ -0,0 +1,196 @@
+// Package cosmetic manages the titles and badges characters unlock through achievements
+// and first discoveries. Unlocks are stored by ID in character_cosmetics; a character
+// shows one equipped title with its name, in character responses, nearby streams and
+// reference summaries.
+package cosmetic
+
+import (
+	"context"
+	"errors"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Kind is what a cosmetic is used as
+type Kind int
+
+const (
+	// Title can be equipped and is shown with the character's name
+	Title Kind = iota + 1
+	// Badge is collected and listed, but not equipped
+	Badge
+)
+
+// Cosmetic IDs awarded by the API
+const (
+	Pathfinder     = "pathfinder"
+	Prospector     = "prospector"
+	Gatherer       = "gatherer"
+	MasterGatherer = "master_gatherer"
+	Artisan        = "artisan"
+	Explorer       = "explorer"
+	EventHunter    = "event_hunter"
+)
+
+// Cosmetic describes a title or badge
+type Cosmetic struct {
+	ID          string
+	Kind        Kind
+	Name        string
+	Description string
+}
+
+var catalogue = map[string]Cosmetic{
+	Pathfinder:     {ID: Pathfinder, Kind: Title, Name: "Pathfinder", Description: "First to reach an undiscovered chunk"},
+	Prospector:     {ID: Prospector, Kind: Title, Name: "Prospector", Description: "First to harvest a resource in a region"},
+	Gatherer:       {ID: Gatherer, Kind: Title, Name: "Gatherer", Description: "Harvested 100 times"},
+	MasterGatherer: {ID: MasterGatherer, Kind: Title, Name: "Master Gatherer", Description: "Harvested 1,000 times"},
+	Artisan:        {ID: Artisan, Kind: Title, Name: "Artisan", Description: "Crafted 50 items"},
+	Explorer:       {ID: Explorer, Kind: Badge, Name: "Explorer", Description: "First to reach 25 chunks"},
+	EventHunter:    {ID: EventHunter, Kind: Badge, Name: "Event Hunter", Description: "Completed 5 rare events"},
+}
+
+// Get returns the cosmetic with the given ID
+func Get(id string) (Cosmetic, bool) {
+	c, ok := catalogue[id]
+	return c, ok
+}
+
+// TitleName returns the display name of an equipped title, or "" for none. Titles
+// removed from the catalogue show as nothing rather than their ID.
+func TitleName(id string) string {
+	if c, ok := catalogue[id]; ok && c.Kind == Title {
+		return c.Name
+	}
+	return ""
+}
+
+// Service lists unlocked cosmetics and equips titles.
+type Service struct {
+	db     DatabaseInterface
+	logger LoggerInterface
+}
+
+// NewService creates a new cosmetic service with dependency injection.
+func NewService(database DatabaseInterface, logger LoggerInterface) *Service {
+	componentLogger := logger.With("component", "cosmetic-service")
+	componentLogger.Debug("Creating new cosmetic service")
+	return &Service{
+		db:     database,
+		logger: componentLogger,
+	}
+}
+
+// NewServiceWithPool creates a service with concrete implementations (convenience constructor for production use).
+func NewServiceWithPool(pool storage.Pool) *Service {
+	return NewService(NewDatabaseWrapper(pool), NewDefaultLoggerWrapper())
+}
+
+// ListTitles returns the titles and badges a character has unlocked, oldest first
+func (s *Service) ListTitles(ctx context.Context, characterID string) ([]*characterV1.CharacterTitle, error) {
+	id, err := uuid.StringToPgtype(characterID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid character ID format")
+	}
+	character, err := s.db.GetCharacterById(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "character not found")
+	}
+	if err != nil {
+		s.logger.Error("Failed to get character", "character_id", characterID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list titles")
+	}
+	rows, err := s.db.ListCharacterCosmetics(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to list character cosmetics", "character_id", characterID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list titles")
+	}
+	titles := make([]*characterV1.CharacterTitle, 0, len(rows))
+	for _, row := range rows {
+		titles = append(titles, toProto(row, character.EquippedTitle))
+	}
+	return titles, nil
+}
+
+// Equip shows an unlocked title with one of the user's characters, or clears it when
+// titleID is empty. It returns the equipped title, or nil when cleared.
+func (s *Service) Equip(ctx context.Context, userID, characterID, titleID string) (*characterV1.CharacterTitle, error) {
+	if titleID != "" {
+		if c, ok := catalogue[titleID]; !ok || c.Kind != Title {
+			return nil, status.Errorf(codes.InvalidArgument, "%q is not a title", titleID)
+		}
+	}
+	id, err := uuid.StringToPgtype(characterID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid character ID format")
+	}
+	character, err := s.db.GetCharacterById(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "character not found")
+	}
+	if err != nil {
+		s.logger.Error("Failed to get character", "character_id", characterID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to get character")
+	}
+	if !uuid.Compare(uuid.PgtypeToString(character.UserID), userID) {
+		return nil, status.Errorf(codes.PermissionDenied, "character does not belong to user")
+	}
+	if err := session.RequireWorld(ctx, character.WorldID); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.db.EquipCharacterTitle(ctx, db.EquipCharacterTitleParams{CharacterID: id, Title: titleID})
+	if err != nil {
+		s.logger.Error("Failed to equip title", "character_id", characterID, "title", titleID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to equip title")
+	}
+	if updated == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "title is not unlocked")
+	}
+	s.logger.Debug("Title equipped", "character_id", characterID, "title", titleID)
+	if titleID == "" {
+		return nil, nil
+	}
+
+	rows, err := s.db.ListCharacterCosmetics(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to list character cosmetics", "character_id", characterID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to equip title")
+	}
+	for _, row := range rows {
+		if row.CosmeticID == titleID {
+			return toProto(row, titleID), nil
+		}
+	}
+	return nil, status.Errorf(codes.FailedPrecondition, "title is not unlocked")
+}
+
+// toProto describes an unlocked cosmetic. Cosmetics no longer in the catalogue are
+// listed by ID so they are not silently lost.
+func toProto(row db.CharacterCosmetic, equippedTitle string) *characterV1.CharacterTitle {
+	title := &characterV1.CharacterTitle{
+		Title:     row.CosmeticID,
+		Name:      row.CosmeticID,
+		AwardedAt: timestamppb.New(row.UnlockedAt.Time),
+		Equipped:  row.CosmeticID == equippedTitle,
+	}
+	if c, ok := catalogue[row.CosmeticID]; ok {
+		title.Name = c.Name
+		title.Description = c.Description
+		switch c.Kind {
+		case Title:
+			title.Kind = characterV1.CosmeticKind_COSMETIC_KIND_TITLE
+		case Badge:
+			title.Kind = characterV1.CosmeticKind_COSMETIC_KIND_BADGE
+		}
+	}
+	return title
+}
@@ -0,0 +1,127 @@
+package cosmetic
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeDB keeps one character and its unlocked cosmetics in memory
+type fakeDB struct {
+	character db.Character
+	unlocked  []db.CharacterCosmetic
+}
+
+func (f *fakeDB) GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error) {
+	if id != f.character.ID {
+		return db.Character{}, pgx.ErrNoRows
+	}
+	return f.character, nil
+}
+
+func (f *fakeDB) ListCharacterCosmetics(ctx context.Context, characterID pgtype.UUID) ([]db.CharacterCosmetic, error) {
+	return f.unlocked, nil
+}
+
+func (f *fakeDB) EquipCharacterTitle(ctx context.Context, arg db.EquipCharacterTitleParams) (int64, error) {
+	if arg.Title != "" {
+		found := false
+		for _, row := range f.unlocked {
+			found = found || row.CosmeticID == arg.Title
+		}
+		if !found {
+			return 0, nil
+		}
+	}
+	f.character.EquippedTitle = arg.Title
+	return 1, nil
+}
+
+const (
+	characterID = "00000000-0000-0000-0000-0000000000c1"
+	userID      = "00000000-0000-0000-0000-0000000000a1"
+	otherUserID = "00000000-0000-0000-0000-0000000000a2"
+	worldID     = "00000000-0000-0000-0000-0000000000f1"
+)
+
+func newTestService(t *testing.T) (*Service, *fakeDB) {
+	character, err := uuid.StringToPgtype(characterID)
+	require.NoError(t, err)
+	owner, err := uuid.StringToPgtype(userID)
+	require.NoError(t, err)
+	world, err := uuid.StringToPgtype(worldID)
+	require.NoError(t, err)
+	at := pgtype.Timestamp{Time: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC), Valid: true}
+	database := &fakeDB{
+		character: db.Character{ID: character, UserID: owner, WorldID: world, Name: "Ada"},
+		unlocked: []db.CharacterCosmetic{
+			{CharacterID: character, CosmeticID: Pathfinder, UnlockedAt: at},
+			{CharacterID: character, CosmeticID: Explorer, UnlockedAt: at},
+			{CharacterID: character, CosmeticID: "retired", UnlockedAt: at},
+		},
+	}
+	return NewService(database, testmocks.NopLogger[LoggerInterface]{}), database
+}
+
+func TestListTitles(t *testing.T) {
+	service, database := newTestService(t)
+	database.character.EquippedTitle = Pathfinder
+
+	titles, err := service.ListTitles(context.Background(), characterID)
+	require.NoError(t, err)
+	require.Len(t, titles, 3)
+	assert.Equal(t, "Pathfinder", titles[0].Name)
+	assert.Equal(t, characterV1.CosmeticKind_COSMETIC_KIND_TITLE, titles[0].Kind)
+	assert.True(t, titles[0].Equipped)
+	assert.Equal(t, characterV1.CosmeticKind_COSMETIC_KIND_BADGE, titles[1].Kind)
+	assert.False(t, titles[1].Equipped)
+	assert.Equal(t, "retired", titles[2].Name, "cosmetics removed from the catalogue are listed by ID")
+
+	_, err = service.ListTitles(context.Background(), "00000000-0000-0000-0000-0000000000c9")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestEquip(t *testing.T) {
+	service, database := newTestService(t)
+	ctx := session.WithWorldID(context.Background(), worldID)
+
+	_, err := service.Equip(ctx, userID, characterID, Explorer)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "badges cannot be equipped")
+	_, err = service.Equip(ctx, userID, characterID, "unknown")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = service.Equip(ctx, userID, characterID, Artisan)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "locked titles cannot be equipped")
+	_, err = service.Equip(ctx, otherUserID, characterID, Pathfinder)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Empty(t, database.character.EquippedTitle)
+
+	title, err := service.Equip(ctx, userID, characterID, Pathfinder)
+	require.NoError(t, err)
+	assert.True(t, title.Equipped)
+	assert.Equal(t, Pathfinder, database.character.EquippedTitle)
+	assert.Equal(t, "Pathfinder", TitleName(database.character.EquippedTitle))
+
+	title, err = service.Equip(ctx, userID, characterID, "")
+	require.NoError(t, err)
+	assert.Nil(t, title)
+	assert.Empty(t, database.character.EquippedTitle)
+}
+
+func TestTitleName(t *testing.T) {
+	assert.Equal(t, "Master Gatherer", TitleName(MasterGatherer))
+	assert.Empty(t, TitleName(Explorer), "badges are not shown as titles")
+	assert.Empty(t, TitleName(""))
+	assert.Empty(t, TitleName("retired"))
+}
@@ -0,0 +1,91 @@
+package cosmetic
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/charmbracelet/log"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DatabaseInterface abstracts database operations for cosmetics.
+type DatabaseInterface interface {
+	GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error)
+	ListCharacterCosmetics(ctx context.Context, characterID pgtype.UUID) ([]db.CharacterCosmetic, error)
+	EquipCharacterTitle(ctx context.Context, arg db.EquipCharacterTitleParams) (int64, error)
+}
+
+// DatabaseWrapper implements DatabaseInterface using the actual database connection.
+type DatabaseWrapper struct {
+	queries *db.Queries
+}
+
+// NewDatabaseWrapper creates a new database wrapper with the given connection pool.
+func NewDatabaseWrapper(pool storage.Pool) DatabaseInterface {
+	return &DatabaseWrapper{
+		queries: db.New(pool),
+	}
+}
+
+func (d *DatabaseWrapper) GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error) {
+	return d.queries.GetCharacterById(ctx, id)
+}
+
+func (d *DatabaseWrapper) ListCharacterCosmetics(ctx context.Context, characterID pgtype.UUID) ([]db.CharacterCosmetic, error) {
+	return d.queries.ListCharacterCosmetics(ctx, characterID)
+}
+
+func (d *DatabaseWrapper) EquipCharacterTitle(ctx context.Context, arg db.EquipCharacterTitleParams) (int64, error) {
+	return d.queries.EquipCharacterTitle(ctx, arg)
+}
+
+// UnlockInTx unlocks a cosmetic for a character within the caller's transaction. Other
+// services use it to award titles and badges atomically with what earned them.
+func UnlockInTx(ctx context.Context, q *db.Queries, characterID pgtype.UUID, cosmeticID string, unlockedAt pgtype.Timestamp) error {
+	_, err := q.UnlockCosmetic(ctx, db.UnlockCosmeticParams{CharacterID: characterID, CosmeticID: cosmeticID, UnlockedAt: unlockedAt})
+	if err != nil {
+		return fmt.Errorf("failed to unlock %s: %w", cosmeticID, err)
+	}
+	return nil
+}
+
+// LoggerInterface abstracts logging operations for dependency injection.
+type LoggerInterface interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+	With(keysAndValues ...interface{}) LoggerInterface
+}
+
+// DefaultLoggerWrapper wraps the internal logging package.
+type DefaultLoggerWrapper struct {
+	logger *log.Logger
+}
+
+func NewDefaultLoggerWrapper() LoggerInterface {
+	return &DefaultLoggerWrapper{logger: logging.GetLogger()}
+}
+
+func (l *DefaultLoggerWrapper) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.Debug(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Info(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.Warn(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.Error(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) With(keysAndValues ...interface{}) LoggerInterface {
+	return &DefaultLoggerWrapper{logger: l.logger.With(keysAndValues...)}
+}
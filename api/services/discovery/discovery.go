@@ -0,0 +1,140 @@
+// Package discovery credits first discoveries: the character whose action first generated
+// a chunk, and the first to harvest each resource node type in a region. Discoveries are
+// projected from chunk.generated and resource.harvested events and earn the character a
+// title (see services/cosmetic). Chunk summaries show them as "Discovered by X on date".
+package discovery
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	"github.com/VoidMesh/api/api/services/cosmetic"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	// RegionSize is the side of a region in chunks, as in world_maturity
+	RegionSize = 8
+)
+
+// Service records first discoveries and unlocks the titles they earn.
+type Service struct {
+	db     DatabaseInterface
+	logger LoggerInterface
+}
+
+// NewService creates a new discovery service with dependency injection.
+func NewService(database DatabaseInterface, logger LoggerInterface) *Service {
+	componentLogger := logger.With("component", "discovery-service")
+	componentLogger.Debug("Creating new discovery service")
+	return &Service{
+		db:     database,
+		logger: componentLogger,
+	}
+}
+
+// NewServiceWithPool creates a service with concrete implementations (convenience constructor for production use).
+func NewServiceWithPool(pool storage.Pool) *Service {
+	return NewService(NewDatabaseWrapper(pool), NewDefaultLoggerWrapper())
+}
+
+// Subscribe records discoveries from the events that make them
+func (s *Service) Subscribe(bus *events.Bus) {
+	bus.Subscribe(events.ChunkGenerated, events.Dedup(s.handleChunkGenerated, events.DefaultDedupCapacity))
+	bus.Subscribe(events.ResourceHarvested, events.Dedup(s.handleResourceHarvested, events.DefaultDedupCapacity))
+}
+
+// handleChunkGenerated credits the chunk to the character whose action generated it.
+// Chunks generated without a character, or by one deleted since, stay uncredited.
+func (s *Service) handleChunkGenerated(ctx context.Context, event events.Event) error {
+	var payload events.ChunkGeneratedPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("invalid %s payload: %w", event.Type, err)
+	}
+	if payload.CharacterID == "" {
+		return nil
+	}
+	worldID, err := uuid.StringToPgtype(payload.WorldID)
+	if err != nil {
+		return fmt.Errorf("invalid world ID %q: %w", payload.WorldID, err)
+	}
+	character, ok, err := s.character(ctx, payload.CharacterID)
+	if err != nil || !ok {
+		return err
+	}
+
+	recorded, err := s.db.RecordChunkDiscovery(ctx, db.CreateChunkDiscoveryParams{
+		WorldID:       worldID,
+		ChunkX:        payload.ChunkX,
+		ChunkY:        payload.ChunkY,
+		CharacterID:   character.ID,
+		CharacterName: character.Name,
+		DiscoveredAt:  pgtype.Timestamp{Time: event.OccurredAt, Valid: true},
+	}, cosmetic.Pathfinder)
+	if err != nil {
+		return err
+	}
+	if recorded {
+		s.logger.Debug("Chunk discovered", "character_id", payload.CharacterID, "chunk_x", payload.ChunkX, "chunk_y", payload.ChunkY)
+	}
+	return nil
+}
+
+// handleResourceHarvested credits the first harvest of a node type in the chunk's region
+func (s *Service) handleResourceHarvested(ctx context.Context, event events.Event) error {
+	var payload events.ResourceHarvestedPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("invalid %s payload: %w", event.Type, err)
+	}
+	worldID, err := uuid.StringToPgtype(payload.WorldID)
+	if err != nil {
+		return fmt.Errorf("invalid world ID %q: %w", payload.WorldID, err)
+	}
+	character, ok, err := s.character(ctx, payload.CharacterID)
+	if err != nil || !ok {
+		return err
+	}
+
+	recorded, err := s.db.RecordResourceDiscovery(ctx, db.CreateResourceDiscoveryParams{
+		WorldID:            worldID,
+		RegionX:            geometry.FloorDiv(payload.ChunkX, RegionSize),
+		RegionY:            geometry.FloorDiv(payload.ChunkY, RegionSize),
+		ResourceNodeTypeID: payload.ResourceNodeTypeID,
+		ChunkX:             payload.ChunkX,
+		ChunkY:             payload.ChunkY,
+		CharacterID:        character.ID,
+		CharacterName:      character.Name,
+		DiscoveredAt:       pgtype.Timestamp{Time: event.OccurredAt, Valid: true},
+	}, cosmetic.Prospector)
+	if err != nil {
+		return err
+	}
+	if recorded {
+		s.logger.Debug("Resource discovered", "character_id", payload.CharacterID, "resource_node_type_id", payload.ResourceNodeTypeID)
+	}
+	return nil
+}
+
+// character returns the character to credit, or false when it has been deleted
+func (s *Service) character(ctx context.Context, characterID string) (db.Character, bool, error) {
+	id, err := uuid.StringToPgtype(characterID)
+	if err != nil {
+		return db.Character{}, false, fmt.Errorf("invalid character ID %q: %w", characterID, err)
+	}
+	character, err := s.db.GetCharacterById(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return db.Character{}, false, nil
+	}
+	if err != nil {
+		return db.Character{}, false, fmt.Errorf("failed to get character %s: %w", characterID, err)
+	}
+	return character, true, nil
+}
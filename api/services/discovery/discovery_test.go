@@ -0,0 +1,132 @@
+package discovery
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	"github.com/VoidMesh/api/api/services/cosmetic"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDB keeps characters, discoveries and titles in memory
+type fakeDB struct {
+	testmocks.Characters
+	chunks    []db.CreateChunkDiscoveryParams
+	resources []db.CreateResourceDiscoveryParams
+	titles    []db.CharacterCosmetic
+}
+
+func (f *fakeDB) RecordChunkDiscovery(ctx context.Context, arg db.CreateChunkDiscoveryParams, title string) (bool, error) {
+	for _, row := range f.chunks {
+		if row.WorldID == arg.WorldID && row.ChunkX == arg.ChunkX && row.ChunkY == arg.ChunkY {
+			return false, nil
+		}
+	}
+	f.chunks = append(f.chunks, arg)
+	f.award(arg.CharacterID, title, arg.DiscoveredAt)
+	return true, nil
+}
+
+func (f *fakeDB) RecordResourceDiscovery(ctx context.Context, arg db.CreateResourceDiscoveryParams, title string) (bool, error) {
+	for _, row := range f.resources {
+		if row.WorldID == arg.WorldID && row.RegionX == arg.RegionX && row.RegionY == arg.RegionY && row.ResourceNodeTypeID == arg.ResourceNodeTypeID {
+			return false, nil
+		}
+	}
+	f.resources = append(f.resources, arg)
+	f.award(arg.CharacterID, title, arg.DiscoveredAt)
+	return true, nil
+}
+
+func (f *fakeDB) award(characterID pgtype.UUID, title string, at pgtype.Timestamp) {
+	for _, row := range f.titles {
+		if row.CharacterID == characterID && row.CosmeticID == title {
+			return
+		}
+	}
+	f.titles = append(f.titles, db.CharacterCosmetic{CharacterID: characterID, CosmeticID: title, UnlockedAt: at})
+}
+
+// titlesOf returns the IDs of the titles a character was awarded
+func (f *fakeDB) titlesOf(characterID string) []string {
+	var titles []string
+	for _, row := range f.titles {
+		if uuid.PgtypeToString(row.CharacterID) == characterID {
+			titles = append(titles, row.CosmeticID)
+		}
+	}
+	return titles
+}
+
+const (
+	worldID     = "650e8400-e29b-41d4-a716-446655440000"
+	characterID = "00000000-0000-0000-0000-0000000000c1"
+	otherID     = "00000000-0000-0000-0000-0000000000c2"
+	deletedID   = "00000000-0000-0000-0000-0000000000c3"
+)
+
+var occurredAt = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func publish(t *testing.T, bus *events.Bus, eventType, key string, payload any) {
+	t.Helper()
+	data, err := json.Marshal(payload)
+	require.NoError(t, err)
+	require.NoError(t, bus.Publish(context.Background(), events.Event{Type: eventType, DedupKey: key, Payload: data, OccurredAt: occurredAt}))
+}
+
+func newTestService(t *testing.T) (*fakeDB, *events.Bus) {
+	character, err := uuid.StringToPgtype(characterID)
+	require.NoError(t, err)
+	other, err := uuid.StringToPgtype(otherID)
+	require.NoError(t, err)
+	database := &fakeDB{Characters: testmocks.NewCharacters(db.Character{ID: character, Name: "Ada"}, db.Character{ID: other, Name: "Grace"})}
+	service := NewService(database, testmocks.NopLogger[LoggerInterface]{})
+	bus := events.NewBus()
+	service.Subscribe(bus)
+	return database, bus
+}
+
+func TestChunkDiscoveries(t *testing.T) {
+	database, bus := newTestService(t)
+
+	publish(t, bus, events.ChunkGenerated, "a", events.ChunkGeneratedPayload{WorldID: worldID, ChunkX: 3, ChunkY: -2, CharacterID: characterID})
+	publish(t, bus, events.ChunkGenerated, "b", events.ChunkGeneratedPayload{WorldID: worldID, ChunkX: 4, ChunkY: -2, CharacterID: characterID})
+	publish(t, bus, events.ChunkGenerated, "c", events.ChunkGeneratedPayload{WorldID: worldID, ChunkX: 5, ChunkY: -2})
+	publish(t, bus, events.ChunkGenerated, "d", events.ChunkGeneratedPayload{WorldID: worldID, ChunkX: 6, ChunkY: -2, CharacterID: deletedID})
+
+	require.Len(t, database.chunks, 2, "chunks generated without a character, or by a deleted one, stay uncredited")
+	assert.Equal(t, "Ada", database.chunks[0].CharacterName)
+	assert.Equal(t, occurredAt, database.chunks[0].DiscoveredAt.Time)
+
+	assert.Equal(t, []string{cosmetic.Pathfinder}, database.titlesOf(characterID), "the title is awarded once")
+}
+
+func TestResourceDiscoveries(t *testing.T) {
+	database, bus := newTestService(t)
+	harvest := func(key, character string, nodeType, chunkX int32) {
+		publish(t, bus, events.ResourceHarvested, key, events.ResourceHarvestedPayload{
+			CharacterID: character, WorldID: worldID, ResourceNodeTypeID: nodeType, ChunkX: chunkX, ChunkY: 1,
+		})
+	}
+
+	harvest("a", characterID, 1, 0)
+	harvest("b", otherID, 1, 7)  // Same region
+	harvest("c", otherID, 1, 8)  // The next region
+	harvest("d", otherID, 2, -1) // Another type, in region -1
+
+	require.Len(t, database.resources, 3)
+	assert.Equal(t, "Ada", database.resources[0].CharacterName)
+	assert.Equal(t, int32(1), database.resources[1].RegionX)
+	assert.Equal(t, int32(-1), database.resources[2].RegionX)
+	assert.Equal(t, int32(-1), database.resources[2].ChunkX, "the chunk of the first harvest is kept for summaries")
+
+	assert.Equal(t, []string{cosmetic.Prospector}, database.titlesOf(otherID))
+}
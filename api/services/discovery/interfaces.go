@@ -0,0 +1,113 @@
+package discovery
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/txn"
+	"github.com/VoidMesh/api/api/services/cosmetic"
+	"github.com/charmbracelet/log"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DatabaseInterface abstracts database operations for first discoveries.
+type DatabaseInterface interface {
+	GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error)
+	RecordChunkDiscovery(ctx context.Context, arg db.CreateChunkDiscoveryParams, title string) (bool, error)
+	RecordResourceDiscovery(ctx context.Context, arg db.CreateResourceDiscoveryParams, title string) (bool, error)
+}
+
+// DatabaseWrapper implements DatabaseInterface using the actual database connection.
+type DatabaseWrapper struct {
+	pool    storage.Pool
+	queries *db.Queries
+}
+
+// NewDatabaseWrapper creates a new database wrapper with the given connection pool.
+func NewDatabaseWrapper(pool storage.Pool) DatabaseInterface {
+	return &DatabaseWrapper{
+		pool:    pool,
+		queries: db.New(pool),
+	}
+}
+
+func (d *DatabaseWrapper) GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error) {
+	return d.queries.GetCharacterById(ctx, id)
+}
+
+// RecordChunkDiscovery credits a chunk to a character and awards it the title in one
+// transaction, returning false when the chunk was already credited
+func (d *DatabaseWrapper) RecordChunkDiscovery(ctx context.Context, arg db.CreateChunkDiscoveryParams, title string) (bool, error) {
+	var recorded bool
+	err := txn.Run(ctx, d.pool, txn.ReadCommitted, func(q *db.Queries) error {
+		created, err := q.CreateChunkDiscovery(ctx, arg)
+		if err != nil {
+			return fmt.Errorf("failed to record chunk discovery: %w", err)
+		}
+		recorded = created > 0
+		if !recorded {
+			return nil
+		}
+		return cosmetic.UnlockInTx(ctx, q, arg.CharacterID, title, arg.DiscoveredAt)
+	})
+	return recorded, err
+}
+
+// RecordResourceDiscovery credits a region's first harvest of a node type to a character
+// and awards it the title in one transaction, returning false when it was already credited
+func (d *DatabaseWrapper) RecordResourceDiscovery(ctx context.Context, arg db.CreateResourceDiscoveryParams, title string) (bool, error) {
+	var recorded bool
+	err := txn.Run(ctx, d.pool, txn.ReadCommitted, func(q *db.Queries) error {
+		created, err := q.CreateResourceDiscovery(ctx, arg)
+		if err != nil {
+			return fmt.Errorf("failed to record resource discovery: %w", err)
+		}
+		recorded = created > 0
+		if !recorded {
+			return nil
+		}
+		return cosmetic.UnlockInTx(ctx, q, arg.CharacterID, title, arg.DiscoveredAt)
+	})
+	return recorded, err
+}
+
+// LoggerInterface abstracts logging operations for dependency injection.
+type LoggerInterface interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+	With(keysAndValues ...interface{}) LoggerInterface
+}
+
+// DefaultLoggerWrapper wraps the internal logging package.
+type DefaultLoggerWrapper struct {
+	logger *log.Logger
+}
+
+func NewDefaultLoggerWrapper() LoggerInterface {
+	return &DefaultLoggerWrapper{logger: logging.GetLogger()}
+}
+
+func (l *DefaultLoggerWrapper) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.Debug(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Info(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.Warn(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.Error(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) With(keysAndValues ...interface{}) LoggerInterface {
+	return &DefaultLoggerWrapper{logger: l.logger.With(keysAndValues...)}
+}
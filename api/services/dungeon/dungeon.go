@@ -0,0 +1,583 @@
+// Package dungeon runs instanced dungeons. A leader creates an instance for a party of
+// characters; its map is generated from the instance's seed whenever it is needed, so
+// only the seed, the party and what has been harvested are stored. Characters inside
+// keep their world position, move on the instance's grid through the character service
+// and harvest the instance's own nodes through the character actions service, each node
+// yielding once per instance. Instances are deleted when they expire or the leader
+// disbands them, which returns everyone inside to the overworld.
+package dungeon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/alerting"
+	"github.com/VoidMesh/api/api/internal/authz"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/VoidMesh/api/api/internal/rng"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	characterActionsV1 "github.com/VoidMesh/api/api/proto/character_actions/v1"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	dungeonV1 "github.com/VoidMesh/api/api/proto/dungeon/v1"
+	inventoryV1 "github.com/VoidMesh/api/api/proto/inventory/v1"
+	"github.com/VoidMesh/api/api/services/inventory"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// cacheSize bounds the generated maps kept in memory
+	cacheSize = 256
+	// expiryBatchSize is how many expired instances one query returns
+	expiryBatchSize = 100
+)
+
+var (
+	// ErrAlreadyBound means a party member is bound to another instance
+	ErrAlreadyBound = errors.New("character is already bound to a dungeon")
+	// ErrHarvested means the node already yielded in this instance
+	ErrHarvested = errors.New("dungeon node has been harvested")
+)
+
+// Config sets how dungeons are generated and how long instances last
+type Config struct {
+	Layout   Layout
+	Nodes    []NodeKind
+	TTL      time.Duration // From creation until the instance is deleted
+	MaxParty int           // Characters bound to one instance, leader included
+	Reach    int32         // Cells, in a straight line, a character may stand from a node it harvests
+	Interval time.Duration // Time between expiry passes
+}
+
+// DefaultConfig generates 48x48 dungeons with mineral veins and glowcaps for parties of
+// up to four, lasting half an hour
+func DefaultConfig() Config {
+	return Config{
+		Layout: Layout{Width: 48, Height: 48, Rooms: 10, MinRoom: 4, MaxRoom: 9, NodesPerRoom: 2},
+		Nodes: []NodeKind{
+			{Kind: "mineral_vein", Name: "Mineral Vein", Drops: []Drop{{"Minerals", 2, 4}, {"Stone", 1, 3}}},
+			{Kind: "glowcap", Name: "Glowcap Patch", Drops: []Drop{{"Herbs", 1, 3}}},
+		},
+		TTL:      30 * time.Minute,
+		MaxParty: 4,
+		Reach:    2,
+		Interval: time.Minute,
+	}
+}
+
+// Service creates dungeon instances, tracks the characters inside and expires them.
+type Service struct {
+	db        DatabaseInterface
+	clock     clock.Clock
+	config    Config
+	seeds     WorldSeedsInterface // Instance seeds are derived from their world's; nil uses seed 0
+	pauses    PauseInterface      // Nil never skips expiring an instance
+	interiors InteriorLocator     // Nil lets characters enter from anywhere
+	logger    LoggerInterface
+
+	mu   sync.Mutex
+	maps map[int64]*Map // By instance ID
+}
+
+// NewService creates a new dungeon service with dependency injection.
+func NewService(database DatabaseInterface, logger LoggerInterface) *Service {
+	componentLogger := logger.With("component", "dungeon-service")
+	componentLogger.Debug("Creating new dungeon service")
+	return &Service{
+		db:     database,
+		clock:  clock.New(),
+		config: DefaultConfig(),
+		logger: componentLogger,
+		maps:   make(map[int64]*Map),
+	}
+}
+
+// NewServiceWithPool creates a service with concrete implementations (convenience constructor for production use).
+func NewServiceWithPool(pool storage.Pool) *Service {
+	return NewService(NewDatabaseWrapper(pool), NewDefaultLoggerWrapper())
+}
+
+// SetClock replaces the clock used for expiry and seeds (for simulation tests)
+func (s *Service) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// SetConfig replaces the generation settings and instance lifetime
+func (s *Service) SetConfig(config Config) {
+	s.config = config
+}
+
+// SetWorldSeeds sets where the seed of each world, which instance seeds are derived
+// from, is looked up
+func (s *Service) SetWorldSeeds(seeds WorldSeedsInterface) {
+	s.seeds = seeds
+}
+
+// SetPauses keeps the instances of worlds an admin has paused from expiring
+func (s *Service) SetPauses(pauses PauseInterface) {
+	s.pauses = pauses
+}
+
+// SetInteriors keeps characters inside a structure interior from entering dungeons
+func (s *Service) SetInteriors(interiors InteriorLocator) {
+	s.interiors = interiors
+}
+
+// instanceMap returns the generated map of an instance
+func (s *Service) instanceMap(instance db.DungeonInstance) *Map {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if m, ok := s.maps[instance.ID]; ok {
+		return m
+	}
+	if len(s.maps) >= cacheSize {
+		clear(s.maps)
+	}
+	m := Generate(instance.Seed, s.config.Layout, s.config.Nodes)
+	s.maps[instance.ID] = m
+	return m
+}
+
+func (s *Service) forget(instanceID int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.maps, instanceID)
+}
+
+// partyMember loads a character the leader brings into a party: one in the leader's
+// world, of the leader's user or of an accepted friend
+func (s *Service) partyMember(ctx context.Context, leader db.Character, characterID string) (db.Character, error) {
+	id, err := uuid.StringToPgtype(characterID)
+	if err != nil {
+		return db.Character{}, status.Errorf(codes.InvalidArgument, "invalid member character ID format")
+	}
+	member, err := s.db.GetCharacterById(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return db.Character{}, status.Errorf(codes.NotFound, "member character %s not found", characterID)
+	}
+	if err != nil {
+		s.logger.Error("Failed to get member character", "character_id", characterID, "error", err)
+		return db.Character{}, status.Errorf(codes.Internal, "failed to create dungeon")
+	}
+	if member.WorldID != leader.WorldID {
+		return db.Character{}, status.Errorf(codes.FailedPrecondition, "member character %s is in another world", characterID)
+	}
+	if member.UserID == leader.UserID {
+		return member, nil
+	}
+	friendship, err := s.db.GetFriendshipBetween(ctx, db.GetFriendshipBetweenParams{UserA: leader.UserID, UserB: member.UserID})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		s.logger.Error("Failed to get friendship", "character_id", characterID, "error", err)
+		return db.Character{}, status.Errorf(codes.Internal, "failed to create dungeon")
+	}
+	if err != nil || friendship.Status != "accepted" {
+		return db.Character{}, status.Errorf(codes.PermissionDenied, "member character %s is not a friend's", characterID)
+	}
+	return member, nil
+}
+
+// Create generates a new instance bound to the leader and the members it brings
+func (s *Service) Create(ctx context.Context, userID, characterID string, memberIDs []string) (*dungeonV1.Dungeon, error) {
+	leader, err := authz.OwnedCharacter(ctx, s.db, s.logger, userID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	party := []pgtype.UUID{leader.ID}
+	for _, memberID := range memberIDs {
+		member, err := s.partyMember(ctx, leader, memberID)
+		if err != nil {
+			return nil, err
+		}
+		seen := false
+		for _, id := range party {
+			seen = seen || id == member.ID
+		}
+		if !seen {
+			party = append(party, member.ID)
+		}
+	}
+	if len(party) > s.config.MaxParty {
+		return nil, status.Errorf(codes.InvalidArgument, "a party has at most %d characters", s.config.MaxParty)
+	}
+
+	var worldSeed int64
+	if s.seeds != nil {
+		if worldSeed, err = s.seeds.Seed(ctx, leader.WorldID); err != nil {
+			s.logger.Error("Failed to get world seed", "character_id", characterID, "error", err)
+			return nil, status.Errorf(codes.Internal, "failed to create dungeon")
+		}
+	}
+
+	now := s.clock.Now()
+	instance, err := s.db.CreateDungeon(ctx, db.CreateDungeonInstanceParams{
+		WorldID:   leader.WorldID,
+		LeaderID:  leader.ID,
+		Seed:      int64(rng.New(worldSeed, rng.Dungeons, now.UnixNano()).Uint64()),
+		CreatedAt: pgtype.Timestamp{Time: now, Valid: true},
+		ExpiresAt: pgtype.Timestamp{Time: now.Add(s.config.TTL), Valid: true},
+	}, party)
+	if errors.Is(err, ErrAlreadyBound) {
+		return nil, status.Errorf(codes.FailedPrecondition, "a party member is already bound to a dungeon")
+	}
+	if err != nil {
+		s.logger.Error("Failed to create dungeon", "character_id", characterID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to create dungeon")
+	}
+	s.logger.Info("Dungeon created", "dungeon_id", instance.ID, "leader_id", characterID, "party", len(party))
+	return s.describeOrFail(ctx, instance)
+}
+
+// binding returns the instance a character is bound to, failing with NotFound when it
+// is bound to none
+func (s *Service) binding(ctx context.Context, character db.Character) (db.DungeonMember, db.DungeonInstance, error) {
+	member, err := s.db.GetDungeonMember(ctx, character.ID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return member, db.DungeonInstance{}, status.Errorf(codes.NotFound, "character is not in a dungeon party")
+	}
+	if err != nil {
+		s.logger.Error("Failed to get dungeon member", "character_id", uuid.PgtypeToString(character.ID), "error", err)
+		return member, db.DungeonInstance{}, status.Errorf(codes.Internal, "failed to get dungeon")
+	}
+	instance, err := s.db.GetDungeonInstance(ctx, member.DungeonID)
+	if err != nil {
+		s.logger.Error("Failed to get dungeon instance", "dungeon_id", member.DungeonID, "error", err)
+		return member, instance, status.Errorf(codes.Internal, "failed to get dungeon")
+	}
+	return member, instance, nil
+}
+
+// Get returns the instance the character is bound to
+func (s *Service) Get(ctx context.Context, userID, characterID string) (*dungeonV1.Dungeon, error) {
+	character, err := authz.OwnedCharacter(ctx, s.db, s.logger, userID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	_, instance, err := s.binding(ctx, character)
+	if err != nil {
+		return nil, err
+	}
+	return s.describeOrFail(ctx, instance)
+}
+
+// Enter puts the character on the entrance of its instance
+func (s *Service) Enter(ctx context.Context, userID, characterID string) (*dungeonV1.EnterDungeonResponse, error) {
+	character, err := authz.OwnedCharacter(ctx, s.db, s.logger, userID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	member, instance, err := s.binding(ctx, character)
+	if err != nil {
+		return nil, err
+	}
+	if member.Inside {
+		return nil, status.Errorf(codes.FailedPrecondition, "character is already inside the dungeon")
+	}
+	if !instance.ExpiresAt.Time.After(s.clock.Now()) {
+		return nil, status.Errorf(codes.FailedPrecondition, "dungeon has expired")
+	}
+	if s.interiors != nil {
+		location, err := s.interiors.Locate(ctx, character.ID)
+		if err != nil {
+			s.logger.Error("Failed to locate character in interiors", "character_id", characterID, "error", err)
+			return nil, status.Errorf(codes.Internal, "failed to enter dungeon")
+		}
+		if location != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "character must leave the interior first")
+		}
+	}
+
+	m := s.instanceMap(instance)
+	entered, err := s.db.EnterDungeon(ctx, db.EnterDungeonParams{CharacterID: character.ID, X: m.Entrance.X, Y: m.Entrance.Y})
+	if err != nil {
+		s.logger.Error("Failed to enter dungeon", "character_id", characterID, "dungeon_id", instance.ID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to enter dungeon")
+	}
+	if entered == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "character is already inside the dungeon")
+	}
+	described, err := s.describeOrFail(ctx, instance)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Debug("Character entered dungeon", "character_id", characterID, "dungeon_id", instance.ID)
+	return &dungeonV1.EnterDungeonResponse{
+		Dungeon:  described,
+		Position: &dungeonV1.DungeonPosition{DungeonId: instance.ID, X: m.Entrance.X, Y: m.Entrance.Y},
+	}, nil
+}
+
+// Leave takes the character standing on the entrance back to the overworld, where it
+// entered
+func (s *Service) Leave(ctx context.Context, userID, characterID string) (*dungeonV1.LeaveDungeonResponse, error) {
+	character, err := authz.OwnedCharacter(ctx, s.db, s.logger, userID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	member, instance, err := s.binding(ctx, character)
+	if err != nil {
+		return nil, err
+	}
+	if !member.Inside {
+		return nil, status.Errorf(codes.FailedPrecondition, "character is not inside the dungeon")
+	}
+	if m := s.instanceMap(instance); member.X != m.Entrance.X || member.Y != m.Entrance.Y {
+		return nil, status.Errorf(codes.FailedPrecondition, "characters leave through the entrance")
+	}
+	if _, err := s.db.LeaveDungeon(ctx, character.ID); err != nil {
+		s.logger.Error("Failed to leave dungeon", "character_id", characterID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to leave dungeon")
+	}
+	s.logger.Debug("Character left dungeon", "character_id", characterID, "dungeon_id", instance.ID)
+	return &dungeonV1.LeaveDungeonResponse{X: character.X, Y: character.Y}, nil
+}
+
+// Disband deletes the leader's instance, returning everyone inside to the overworld
+func (s *Service) Disband(ctx context.Context, userID, characterID string) error {
+	character, err := authz.OwnedCharacter(ctx, s.db, s.logger, userID, characterID)
+	if err != nil {
+		return err
+	}
+	_, instance, err := s.binding(ctx, character)
+	if err != nil {
+		return err
+	}
+	if instance.LeaderID != character.ID {
+		return status.Errorf(codes.PermissionDenied, "only the party leader can disband the dungeon")
+	}
+	if _, err := s.db.DeleteDungeonInstance(ctx, instance.ID); err != nil {
+		s.logger.Error("Failed to delete dungeon", "dungeon_id", instance.ID, "error", err)
+		return status.Errorf(codes.Internal, "failed to disband dungeon")
+	}
+	s.forget(instance.ID)
+	s.logger.Info("Dungeon disbanded", "dungeon_id", instance.ID, "leader_id", characterID)
+	return nil
+}
+
+// Locate returns where a character is inside a dungeon, or nil when it is in the overworld
+func (s *Service) Locate(ctx context.Context, characterID pgtype.UUID) (*dungeonV1.DungeonPosition, error) {
+	member, err := s.db.GetDungeonMember(ctx, characterID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to locate character: %w", err)
+	}
+	if !member.Inside {
+		return nil, nil
+	}
+	return &dungeonV1.DungeonPosition{DungeonId: member.DungeonID, X: member.X, Y: member.Y}, nil
+}
+
+// Passable reports whether a character can step onto a cell of an instance
+func (s *Service) Passable(ctx context.Context, dungeonID int64, x, y int32) (bool, error) {
+	instance, err := s.db.GetDungeonInstance(ctx, dungeonID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get dungeon instance %d: %w", dungeonID, err)
+	}
+	return s.instanceMap(instance).Walkable(x, y), nil
+}
+
+// MoveTo moves a character inside its instance
+func (s *Service) MoveTo(ctx context.Context, characterID pgtype.UUID, x, y int32) error {
+	if _, err := s.db.UpdateDungeonPosition(ctx, db.UpdateDungeonPositionParams{CharacterID: characterID, X: x, Y: y}); err != nil {
+		return fmt.Errorf("failed to move inside dungeon: %w", err)
+	}
+	return nil
+}
+
+// Harvest harvests a node of the instance the character is in. Yields are rolled from
+// the instance's seed, so a node yields the same for whoever harvests it first.
+func (s *Service) Harvest(ctx context.Context, character db.Character, position *dungeonV1.DungeonPosition, nodeID int32) ([]*characterActionsV1.HarvestResult, *inventoryV1.InventoryItem, error) {
+	instance, err := s.db.GetDungeonInstance(ctx, position.DungeonId)
+	if err != nil {
+		s.logger.Error("Failed to get dungeon instance", "dungeon_id", position.DungeonId, "error", err)
+		return nil, nil, status.Errorf(codes.Internal, "failed to harvest")
+	}
+	node, ok := s.instanceMap(instance).Node(nodeID)
+	if !ok {
+		return nil, nil, status.Errorf(codes.NotFound, "resource node not found")
+	}
+	if !geometry.InRange(geometry.Point{X: position.X, Y: position.Y}, geometry.Point{X: node.X, Y: node.Y}, s.config.Reach) {
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "character is too far from resource node")
+	}
+
+	yieldRng := rng.New(instance.Seed, rng.Yields, int64(node.ID))
+	var results []*characterActionsV1.HarvestResult
+	var items []db.Item
+	var grants []inventory.Grant
+	for _, drop := range node.Kind.Drops {
+		item, err := s.db.GetItemByName(ctx, drop.Item)
+		if err != nil {
+			s.logger.Error("Failed to get dungeon drop item", "item", drop.Item, "error", err)
+			return nil, nil, status.Errorf(codes.Internal, "failed to harvest")
+		}
+		quantity := drop.Min + yieldRng.Int31n(drop.Max-drop.Min+1)
+		results = append(results, &characterActionsV1.HarvestResult{ItemName: item.Name, Quantity: quantity})
+		items = append(items, item)
+		grants = append(grants, inventory.Grant{ItemID: item.ID, StackSize: item.StackSize, Quantity: quantity})
+	}
+
+	stacks, err := s.db.HarvestNode(ctx, db.CreateDungeonHarvestParams{
+		DungeonID:   instance.ID,
+		NodeID:      node.ID,
+		CharacterID: character.ID,
+		HarvestedAt: pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
+	}, character, grants)
+	switch {
+	case errors.Is(err, ErrHarvested):
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "resource node has been harvested")
+	case errors.Is(err, inventory.ErrInventoryFull):
+		return nil, nil, status.Errorf(codes.ResourceExhausted, "inventory is full")
+	case err != nil:
+		s.logger.Error("Failed to harvest dungeon node", "dungeon_id", instance.ID, "node_id", nodeID, "error", err)
+		return nil, nil, status.Errorf(codes.Internal, "failed to harvest")
+	}
+
+	var updated *inventoryV1.InventoryItem
+	if len(stacks) > 0 {
+		updated = stackToProto(stacks[len(stacks)-1], items[len(stacks)-1])
+	}
+	s.logger.Debug("Dungeon node harvested", "dungeon_id", instance.ID, "node_id", nodeID, "drops", len(results))
+	return results, updated, nil
+}
+
+// ExpireInstances deletes instances past their expiry, except those of paused worlds,
+// and returns how many it deleted
+func (s *Service) ExpireInstances(ctx context.Context) (int, error) {
+	expired := 0
+	for {
+		instances, err := s.db.ListExpiredDungeonInstances(ctx, db.ListExpiredDungeonInstancesParams{
+			ExpiresAt: pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
+			Limit:     expiryBatchSize,
+		})
+		if err != nil {
+			return expired, fmt.Errorf("failed to list expired dungeons: %w", err)
+		}
+		deleted := 0
+		for _, instance := range instances {
+			if s.pauses != nil && s.pauses.Paused(ctx, instance.WorldID) {
+				continue
+			}
+			if _, err := s.db.DeleteDungeonInstance(ctx, instance.ID); err != nil {
+				return expired, fmt.Errorf("failed to delete dungeon %d: %w", instance.ID, err)
+			}
+			s.forget(instance.ID)
+			deleted++
+			s.logger.Debug("Dungeon expired", "dungeon_id", instance.ID)
+		}
+		expired += deleted
+		// A full batch of paused worlds' instances would be listed again
+		if len(instances) < expiryBatchSize || deleted == 0 {
+			return expired, nil
+		}
+	}
+}
+
+// Run expires instances every config.Interval until ctx is cancelled
+func (s *Service) Run(ctx context.Context) {
+	s.logger.Info("Dungeon expiry job started", "interval", s.config.Interval, "ttl", s.config.TTL)
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("Dungeon expiry job stopped")
+			return
+		case <-s.clock.After(s.config.Interval):
+		}
+
+		expired, err := s.ExpireInstances(ctx)
+		if err != nil {
+			s.logger.Error("Dungeon expiry pass failed", "error", err)
+			alerting.ReportJobError("dungeon_expiry", err)
+		}
+		if expired > 0 {
+			s.logger.Debug("Dungeon expiry pass complete", "expired", expired)
+		}
+	}
+}
+
+// describeOrFail describes an instance, logging and failing with Internal on errors
+func (s *Service) describeOrFail(ctx context.Context, instance db.DungeonInstance) (*dungeonV1.Dungeon, error) {
+	described, err := s.describe(ctx, instance)
+	if err != nil {
+		s.logger.Error("Failed to describe dungeon", "dungeon_id", instance.ID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to get dungeon")
+	}
+	return described, nil
+}
+
+// describe returns an instance with its map, what has been harvested and its party
+func (s *Service) describe(ctx context.Context, instance db.DungeonInstance) (*dungeonV1.Dungeon, error) {
+	harvests, err := s.db.ListDungeonHarvests(ctx, instance.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list harvests: %w", err)
+	}
+	members, err := s.db.ListDungeonMembers(ctx, instance.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list members: %w", err)
+	}
+	harvested := make(map[int32]bool, len(harvests))
+	for _, h := range harvests {
+		harvested[h.NodeID] = true
+	}
+
+	m := s.instanceMap(instance)
+	resp := &dungeonV1.Dungeon{
+		Id:                instance.ID,
+		Seed:              instance.Seed,
+		Width:             m.Width,
+		Height:            m.Height,
+		Cells:             make([]*chunkV1.TerrainCell, len(m.Cells)),
+		EntranceX:         m.Entrance.X,
+		EntranceY:         m.Entrance.Y,
+		LeaderCharacterId: uuid.PgtypeToString(instance.LeaderID),
+		CreatedAt:         timestamppb.New(instance.CreatedAt.Time),
+		ExpiresAt:         timestamppb.New(instance.ExpiresAt.Time),
+	}
+	for i, cell := range m.Cells {
+		resp.Cells[i] = &chunkV1.TerrainCell{TerrainType: cell}
+	}
+	for _, node := range m.Nodes {
+		resp.Nodes = append(resp.Nodes, &dungeonV1.DungeonNode{
+			Id:        node.ID,
+			Kind:      node.Kind.Kind,
+			Name:      node.Kind.Name,
+			X:         node.X,
+			Y:         node.Y,
+			Harvested: harvested[node.ID],
+		})
+	}
+	for _, member := range members {
+		resp.Members = append(resp.Members, &dungeonV1.DungeonMember{
+			CharacterId: uuid.PgtypeToString(member.CharacterID),
+			Inside:      member.Inside,
+		})
+	}
+	return resp, nil
+}
+
+// stackToProto describes an inventory stack with its item's details
+func stackToProto(stack db.CharacterInventory, item db.Item) *inventoryV1.InventoryItem {
+	return &inventoryV1.InventoryItem{
+		Id:          stack.ID,
+		CharacterId: uuid.PgtypeToNormalizedString(stack.CharacterID),
+		ItemId:      stack.ItemID,
+		Quantity:    stack.Quantity,
+		ItemName:    item.Name,
+		Description: item.Description,
+		ItemType:    item.ItemType,
+		Rarity:      item.Rarity,
+		StackSize:   item.StackSize,
+		VisualData:  item.VisualData,
+		CreatedAt:   timestamppb.New(stack.CreatedAt.Time),
+		UpdatedAt:   timestamppb.New(stack.UpdatedAt.Time),
+	}
+}
@@ -0,0 +1,430 @@
+package dungeon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	dungeonV1 "github.com/VoidMesh/api/api/proto/dungeon/v1"
+	interiorV1 "github.com/VoidMesh/api/api/proto/interior/v1"
+	"github.com/VoidMesh/api/api/services/inventory"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeDB keeps characters, instances, parties and harvests in memory
+type fakeDB struct {
+	testmocks.Characters
+	friendships []db.Friendship
+	items       map[string]db.Item
+	instances   map[int64]db.DungeonInstance
+	members     map[[16]byte]db.DungeonMember
+	harvests    []db.DungeonHarvest
+	granted     map[int32]int32 // Items granted by ID
+	full        bool
+	nextID      int64
+}
+
+func (f *fakeDB) GetItemByName(ctx context.Context, name string) (db.Item, error) {
+	item, ok := f.items[name]
+	if !ok {
+		return db.Item{}, pgx.ErrNoRows
+	}
+	return item, nil
+}
+
+func (f *fakeDB) GetFriendshipBetween(ctx context.Context, arg db.GetFriendshipBetweenParams) (db.Friendship, error) {
+	for _, friendship := range f.friendships {
+		if (friendship.RequesterID == arg.UserA && friendship.AddresseeID == arg.UserB) ||
+			(friendship.RequesterID == arg.UserB && friendship.AddresseeID == arg.UserA) {
+			return friendship, nil
+		}
+	}
+	return db.Friendship{}, pgx.ErrNoRows
+}
+
+func (f *fakeDB) GetDungeonInstance(ctx context.Context, id int64) (db.DungeonInstance, error) {
+	instance, ok := f.instances[id]
+	if !ok {
+		return db.DungeonInstance{}, pgx.ErrNoRows
+	}
+	return instance, nil
+}
+
+func (f *fakeDB) DeleteDungeonInstance(ctx context.Context, id int64) (int64, error) {
+	if _, ok := f.instances[id]; !ok {
+		return 0, nil
+	}
+	delete(f.instances, id)
+	for key, member := range f.members {
+		if member.DungeonID == id {
+			delete(f.members, key)
+		}
+	}
+	return 1, nil
+}
+
+func (f *fakeDB) ListExpiredDungeonInstances(ctx context.Context, arg db.ListExpiredDungeonInstancesParams) ([]db.DungeonInstance, error) {
+	var expired []db.DungeonInstance
+	for id := int64(1); id <= f.nextID && len(expired) < int(arg.Limit); id++ {
+		if instance, ok := f.instances[id]; ok && !instance.ExpiresAt.Time.After(arg.ExpiresAt.Time) {
+			expired = append(expired, instance)
+		}
+	}
+	return expired, nil
+}
+
+func (f *fakeDB) GetDungeonMember(ctx context.Context, characterID pgtype.UUID) (db.DungeonMember, error) {
+	member, ok := f.members[characterID.Bytes]
+	if !ok {
+		return db.DungeonMember{}, pgx.ErrNoRows
+	}
+	return member, nil
+}
+
+func (f *fakeDB) ListDungeonMembers(ctx context.Context, dungeonID int64) ([]db.DungeonMember, error) {
+	var members []db.DungeonMember
+	for _, member := range f.members {
+		if member.DungeonID == dungeonID {
+			members = append(members, member)
+		}
+	}
+	return members, nil
+}
+
+func (f *fakeDB) EnterDungeon(ctx context.Context, arg db.EnterDungeonParams) (int64, error) {
+	member, ok := f.members[arg.CharacterID.Bytes]
+	if !ok || member.Inside {
+		return 0, nil
+	}
+	member.Inside, member.X, member.Y = true, arg.X, arg.Y
+	f.members[arg.CharacterID.Bytes] = member
+	return 1, nil
+}
+
+func (f *fakeDB) UpdateDungeonPosition(ctx context.Context, arg db.UpdateDungeonPositionParams) (int64, error) {
+	member, ok := f.members[arg.CharacterID.Bytes]
+	if !ok || !member.Inside {
+		return 0, nil
+	}
+	member.X, member.Y = arg.X, arg.Y
+	f.members[arg.CharacterID.Bytes] = member
+	return 1, nil
+}
+
+func (f *fakeDB) LeaveDungeon(ctx context.Context, characterID pgtype.UUID) (int64, error) {
+	member, ok := f.members[characterID.Bytes]
+	if !ok || !member.Inside {
+		return 0, nil
+	}
+	member.Inside = false
+	f.members[characterID.Bytes] = member
+	return 1, nil
+}
+
+func (f *fakeDB) ListDungeonHarvests(ctx context.Context, dungeonID int64) ([]db.DungeonHarvest, error) {
+	var harvests []db.DungeonHarvest
+	for _, harvest := range f.harvests {
+		if harvest.DungeonID == dungeonID {
+			harvests = append(harvests, harvest)
+		}
+	}
+	return harvests, nil
+}
+
+func (f *fakeDB) CreateDungeon(ctx context.Context, arg db.CreateDungeonInstanceParams, members []pgtype.UUID) (db.DungeonInstance, error) {
+	for _, member := range members {
+		if _, ok := f.members[member.Bytes]; ok {
+			return db.DungeonInstance{}, ErrAlreadyBound
+		}
+	}
+	f.nextID++
+	instance := db.DungeonInstance{
+		ID:        f.nextID,
+		WorldID:   arg.WorldID,
+		LeaderID:  arg.LeaderID,
+		Seed:      arg.Seed,
+		CreatedAt: arg.CreatedAt,
+		ExpiresAt: arg.ExpiresAt,
+	}
+	f.instances[instance.ID] = instance
+	for _, member := range members {
+		f.members[member.Bytes] = db.DungeonMember{CharacterID: member, DungeonID: instance.ID, JoinedAt: arg.CreatedAt}
+	}
+	return instance, nil
+}
+
+func (f *fakeDB) HarvestNode(ctx context.Context, arg db.CreateDungeonHarvestParams, character db.Character, grants []inventory.Grant) ([]db.CharacterInventory, error) {
+	for _, harvest := range f.harvests {
+		if harvest.DungeonID == arg.DungeonID && harvest.NodeID == arg.NodeID {
+			return nil, ErrHarvested
+		}
+	}
+	if f.full {
+		return nil, inventory.ErrInventoryFull
+	}
+	f.harvests = append(f.harvests, db.DungeonHarvest(arg))
+	var stacks []db.CharacterInventory
+	for _, grant := range grants {
+		f.granted[grant.ItemID] += grant.Quantity
+		stacks = append(stacks, db.CharacterInventory{CharacterID: character.ID, ItemID: grant.ItemID, Quantity: f.granted[grant.ItemID]})
+	}
+	return stacks, nil
+}
+
+// worldSeeds gives each listed world a fixed seed
+type worldSeeds map[[16]byte]int64
+
+func (w worldSeeds) Seed(ctx context.Context, worldID pgtype.UUID) (int64, error) {
+	seed, ok := w[worldID.Bytes]
+	if !ok {
+		return 0, pgx.ErrNoRows
+	}
+	return seed, nil
+}
+
+type pausedWorlds map[[16]byte]bool
+
+func (p pausedWorlds) Paused(ctx context.Context, worldID pgtype.UUID) bool {
+	return p[worldID.Bytes]
+}
+
+// insideInteriors reports every character as outside except the ones listed
+type insideInteriors map[[16]byte]bool
+
+func (i insideInteriors) Locate(ctx context.Context, characterID pgtype.UUID) (*interiorV1.InteriorPosition, error) {
+	if i[characterID.Bytes] {
+		return &interiorV1.InteriorPosition{InteriorId: 1}, nil
+	}
+	return nil, nil
+}
+
+const (
+	userID       = "550e8400-e29b-41d4-a716-446655440000"
+	leaderID     = "01000000-0000-0000-0000-000000000000"
+	altID        = "02000000-0000-0000-0000-000000000000"
+	friendCharID = "03000000-0000-0000-0000-000000000000"
+	strangerID   = "04000000-0000-0000-0000-000000000000"
+	elsewhereID  = "05000000-0000-0000-0000-000000000000"
+)
+
+var (
+	world      = pgtype.UUID{Bytes: [16]byte{9}, Valid: true}
+	otherWorld = pgtype.UUID{Bytes: [16]byte{8}, Valid: true}
+	user       = pgtype.UUID{Bytes: [16]byte{0x55, 0x0e, 0x84, 0x00, 0xe2, 0x9b, 0x41, 0xd4, 0xa7, 0x16, 0x44, 0x66, 0x55, 0x44, 0x00, 0x00}, Valid: true}
+	friendUser = pgtype.UUID{Bytes: [16]byte{0xf1}, Valid: true}
+	otherUser  = pgtype.UUID{Bytes: [16]byte{0xf2}, Valid: true}
+	leader     = db.Character{ID: pgtype.UUID{Bytes: [16]byte{1}, Valid: true}, UserID: user, WorldID: world, X: 10, Y: 10}
+)
+
+func newTestService(t *testing.T) (*Service, *fakeDB, *clock.Fake) {
+	database := &fakeDB{
+		Characters: testmocks.NewCharacters(
+			leader,
+			db.Character{ID: pgtype.UUID{Bytes: [16]byte{2}, Valid: true}, UserID: user, WorldID: world},
+			db.Character{ID: pgtype.UUID{Bytes: [16]byte{3}, Valid: true}, UserID: friendUser, WorldID: world},
+			db.Character{ID: pgtype.UUID{Bytes: [16]byte{4}, Valid: true}, UserID: otherUser, WorldID: world},
+			db.Character{ID: pgtype.UUID{Bytes: [16]byte{5}, Valid: true}, UserID: friendUser, WorldID: otherWorld},
+		),
+		friendships: []db.Friendship{
+			{RequesterID: friendUser, AddresseeID: user, Status: "accepted"},
+			{RequesterID: otherUser, AddresseeID: user, Status: "pending"},
+		},
+		items: map[string]db.Item{
+			"Minerals": {ID: 1, Name: "Minerals", StackSize: 64},
+			"Stone":    {ID: 2, Name: "Stone", StackSize: 64},
+			"Herbs":    {ID: 3, Name: "Herbs", StackSize: 64},
+		},
+		instances: make(map[int64]db.DungeonInstance),
+		members:   make(map[[16]byte]db.DungeonMember),
+		granted:   make(map[int32]int32),
+	}
+	fake := clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	service := NewService(database, testmocks.NopLogger[LoggerInterface]{})
+	service.SetClock(fake)
+	service.SetWorldSeeds(worldSeeds{world.Bytes: 42})
+	return service, database, fake
+}
+
+func TestCreate(t *testing.T) {
+	service, database, _ := newTestService(t)
+	ctx := playerContext()
+
+	_, err := service.Create(ctx, userID, leaderID, []string{strangerID})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "pending friend requests do not count")
+	_, err = service.Create(ctx, userID, leaderID, []string{elsewhereID})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = service.Create(ctx, userID, leaderID, []string{"not-a-uuid"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = service.Create(ctx, "550e8400-e29b-41d4-a716-446655440001", leaderID, nil)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	service.config.MaxParty = 2
+	_, err = service.Create(ctx, userID, leaderID, []string{altID, friendCharID})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	service.config.MaxParty = 4
+	assert.Empty(t, database.instances)
+
+	dungeon, err := service.Create(ctx, userID, leaderID, []string{altID, friendCharID, altID})
+	require.NoError(t, err)
+	assert.Equal(t, leaderID, dungeon.LeaderCharacterId)
+	assert.Len(t, dungeon.Members, 3, "duplicate members are bound once")
+	assert.Len(t, dungeon.Cells, 48*48)
+	assert.NotEmpty(t, dungeon.Nodes)
+	assert.Equal(t, time.Date(2025, 1, 1, 0, 30, 0, 0, time.UTC), dungeon.ExpiresAt.AsTime())
+
+	_, err = service.Create(ctx, userID, altID, nil)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "characters are bound to one instance at a time")
+
+	got, err := service.Get(ctx, userID, leaderID)
+	require.NoError(t, err)
+	assert.Equal(t, dungeon.Seed, got.Seed)
+	assert.Equal(t, dungeon.Cells, got.Cells, "the map is generated again from the seed")
+	_, err = service.Get(ctx, "550e8400-e29b-41d4-a716-446655440002", strangerID)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestEnterMoveLeave(t *testing.T) {
+	service, database, fake := newTestService(t)
+	ctx := playerContext()
+
+	_, err := service.Enter(ctx, userID, leaderID)
+	assert.Equal(t, codes.NotFound, status.Code(err), "the character has no party")
+	created, err := service.Create(ctx, userID, leaderID, []string{altID})
+	require.NoError(t, err)
+
+	service.SetInteriors(insideInteriors{leader.ID.Bytes: true})
+	_, err = service.Enter(ctx, userID, leaderID)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "characters in an interior cannot enter")
+	service.SetInteriors(insideInteriors{})
+
+	location, err := service.Locate(ctx, leader.ID)
+	require.NoError(t, err)
+	assert.Nil(t, location, "bound but not inside")
+
+	entered, err := service.Enter(ctx, userID, leaderID)
+	require.NoError(t, err)
+	assert.Equal(t, created.EntranceX, entered.Position.X)
+	assert.Equal(t, created.EntranceY, entered.Position.Y)
+	_, err = service.Enter(ctx, userID, leaderID)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	location, err = service.Locate(ctx, leader.ID)
+	require.NoError(t, err)
+	require.NotNil(t, location)
+	assert.Equal(t, created.Id, location.DungeonId)
+
+	passable, err := service.Passable(ctx, created.Id, created.EntranceX, created.EntranceY)
+	require.NoError(t, err)
+	assert.True(t, passable)
+	passable, err = service.Passable(ctx, created.Id, 0, 0)
+	require.NoError(t, err)
+	assert.False(t, passable)
+
+	// Leaving is only through the entrance
+	require.NoError(t, service.MoveTo(ctx, leader.ID, created.EntranceX+1, created.EntranceY))
+	_, err = service.Leave(ctx, userID, leaderID)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.NoError(t, service.MoveTo(ctx, leader.ID, created.EntranceX, created.EntranceY))
+	left, err := service.Leave(ctx, userID, leaderID)
+	require.NoError(t, err)
+	assert.Equal(t, leader.X, left.X)
+	assert.True(t, database.members[leader.ID.Bytes].Inside == false)
+
+	fake.Advance(30 * time.Minute)
+	_, err = service.Enter(ctx, userID, altID)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "expired instances cannot be entered")
+}
+
+func TestHarvest(t *testing.T) {
+	service, database, _ := newTestService(t)
+	ctx := playerContext()
+	created, err := service.Create(ctx, userID, leaderID, nil)
+	require.NoError(t, err)
+	node := created.Nodes[0]
+
+	far := &dungeonV1.DungeonPosition{DungeonId: created.Id, X: node.X + 3, Y: node.Y}
+	_, _, err = service.Harvest(ctx, leader, far, node.Id)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	at := &dungeonV1.DungeonPosition{DungeonId: created.Id, X: node.X, Y: node.Y + 1}
+	_, _, err = service.Harvest(ctx, leader, at, 999)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	database.full = true
+	_, _, err = service.Harvest(ctx, leader, at, node.Id)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	database.full = false
+
+	results, updated, err := service.Harvest(ctx, leader, at, node.Id)
+	require.NoError(t, err)
+	require.NotEmpty(t, results)
+	require.NotNil(t, updated)
+	assert.Equal(t, results[len(results)-1].ItemName, updated.ItemName)
+	for _, result := range results {
+		assert.Equal(t, result.Quantity, database.granted[database.items[result.ItemName].ID])
+	}
+	_, _, err = service.Harvest(ctx, leader, at, node.Id)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "nodes yield once per instance")
+
+	got, err := service.Get(ctx, userID, leaderID)
+	require.NoError(t, err)
+	assert.True(t, got.Nodes[0].Harvested)
+}
+
+func TestExpireAndDisband(t *testing.T) {
+	service, database, fake := newTestService(t)
+	ctx := playerContext()
+	first, err := service.Create(ctx, userID, leaderID, nil)
+	require.NoError(t, err)
+	fake.Advance(10 * time.Minute)
+	_, err = service.Create(ctx, userID, altID, []string{friendCharID})
+	require.NoError(t, err)
+
+	err = service.Disband(ctx, userID, altID)
+	require.NoError(t, err, "the leader disbands")
+	assert.Len(t, database.instances, 1)
+	_, err = service.Get(ctx, userID, altID)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	paused := pausedWorlds{world.Bytes: true}
+	service.SetPauses(paused)
+	fake.Advance(25 * time.Minute)
+	expired, err := service.ExpireInstances(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, expired, "instances of paused worlds are kept")
+
+	delete(paused, world.Bytes)
+	expired, err = service.ExpireInstances(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, expired)
+	_, ok := database.instances[first.Id]
+	assert.False(t, ok)
+	location, err := service.Locate(ctx, leader.ID)
+	require.NoError(t, err)
+	assert.Nil(t, location)
+}
+
+func TestDisbandByMember(t *testing.T) {
+	service, _, _ := newTestService(t)
+	ctx := playerContext()
+	_, err := service.Create(ctx, userID, leaderID, []string{altID})
+	require.NoError(t, err)
+
+	err = service.Disband(ctx, userID, altID)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "only the leader disbands")
+	err = service.Disband(ctx, userID, friendCharID)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "the character is not the user's")
+}
+
+// playerContext is the context of a session bound to the test world
+func playerContext() context.Context {
+	return session.WithWorldID(context.Background(), uuid.PgtypeToString(world))
+}
@@ -0,0 +1,167 @@
+package dungeon
+
+import (
+	"github.com/VoidMesh/api/api/internal/chunkdata"
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/VoidMesh/api/api/internal/rng"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+)
+
+const (
+	wall  = chunkV1.TerrainType_TERRAIN_TYPE_STONE
+	floor = chunkV1.TerrainType_TERRAIN_TYPE_DIRT
+)
+
+// Layout sets the size of generated dungeons. Both dimensions must fit MaxRoom plus a
+// wall on each side.
+type Layout struct {
+	Width        int32
+	Height       int32
+	Rooms        int   // Rooms attempted; those overlapping an earlier room are skipped
+	MinRoom      int32 // Side length of rooms in floor cells
+	MaxRoom      int32
+	NodesPerRoom int // At most, in every room but the entrance's
+}
+
+// Drop is an item a dungeon node yields, a quantity in [Min, Max]
+type Drop struct {
+	Item string
+	Min  int32
+	Max  int32
+}
+
+// NodeKind is a kind of resource node generated in dungeons
+type NodeKind struct {
+	Kind  string
+	Name  string
+	Drops []Drop
+}
+
+// Node is a resource node of a generated dungeon. IDs start at 1 in every map.
+type Node struct {
+	ID   int32
+	Kind NodeKind
+	X    int32
+	Y    int32
+}
+
+// Map is a generated dungeon: rooms joined by corridors, walled in
+type Map struct {
+	Seed     int64
+	Width    int32
+	Height   int32
+	Cells    []chunkV1.TerrainType // Row-major
+	Entrance geometry.Point
+	Nodes    []Node
+}
+
+// Walkable reports whether a cell is inside the map and its terrain can be walked on
+func (m *Map) Walkable(x, y int32) bool {
+	if x < 0 || y < 0 || x >= m.Width || y >= m.Height {
+		return false
+	}
+	return chunkdata.Walkable(m.Cells[y*m.Width+x])
+}
+
+// Node returns the node with an ID
+func (m *Map) Node(id int32) (Node, bool) {
+	if id < 1 || int(id) > len(m.Nodes) {
+		return Node{}, false
+	}
+	return m.Nodes[id-1], true
+}
+
+type room struct {
+	x, y, w, h int32 // Floor cells, walls excluded
+}
+
+func (r room) center() geometry.Point {
+	return geometry.Point{X: r.x + r.w/2, Y: r.y + r.h/2}
+}
+
+// overlaps reports whether two rooms share a cell or a wall
+func (r room) overlaps(o room) bool {
+	return r.x <= o.x+o.w && o.x <= r.x+r.w && r.y <= o.y+o.h && o.y <= r.y+r.h
+}
+
+// Generate lays out a dungeon from a seed. The same seed, layout and kinds always give
+// the same map, so instances store only their seed.
+func Generate(seed int64, layout Layout, kinds []NodeKind) *Map {
+	stream := rng.New(seed, rng.Dungeons)
+	m := &Map{
+		Seed:   seed,
+		Width:  layout.Width,
+		Height: layout.Height,
+		Cells:  make([]chunkV1.TerrainType, layout.Width*layout.Height),
+	}
+	for i := range m.Cells {
+		m.Cells[i] = wall
+	}
+	carve := func(x, y int32) {
+		m.Cells[y*m.Width+x] = floor
+	}
+
+	var rooms []room
+	for i := 0; i < layout.Rooms; i++ {
+		r := room{
+			w: layout.MinRoom + stream.Int31n(layout.MaxRoom-layout.MinRoom+1),
+			h: layout.MinRoom + stream.Int31n(layout.MaxRoom-layout.MinRoom+1),
+		}
+		r.x = 1 + stream.Int31n(layout.Width-r.w-1)
+		r.y = 1 + stream.Int31n(layout.Height-r.h-1)
+		placed := true
+		for _, other := range rooms {
+			placed = placed && !r.overlaps(other)
+		}
+		if !placed {
+			continue
+		}
+		for y := r.y; y < r.y+r.h; y++ {
+			for x := r.x; x < r.x+r.w; x++ {
+				carve(x, y)
+			}
+		}
+		rooms = append(rooms, r)
+	}
+
+	// Each room is joined to the one placed before it by an L-shaped corridor
+	for i := 1; i < len(rooms); i++ {
+		from, to := rooms[i-1].center(), rooms[i].center()
+		corner := geometry.Point{X: to.X, Y: from.Y}
+		if stream.Intn(2) == 0 {
+			corner = geometry.Point{X: from.X, Y: to.Y}
+		}
+		for _, leg := range [][2]geometry.Point{{from, corner}, {corner, to}} {
+			for x := min(leg[0].X, leg[1].X); x <= max(leg[0].X, leg[1].X); x++ {
+				for y := min(leg[0].Y, leg[1].Y); y <= max(leg[0].Y, leg[1].Y); y++ {
+					carve(x, y)
+				}
+			}
+		}
+	}
+
+	if len(rooms) == 0 {
+		return m
+	}
+	m.Entrance = rooms[0].center()
+	if layout.NodesPerRoom < 1 || len(kinds) == 0 {
+		return m
+	}
+	taken := make(map[geometry.Point]bool)
+	for _, r := range rooms[1:] {
+		for n := 1 + stream.Intn(layout.NodesPerRoom); n > 0; n-- {
+			at := geometry.Point{X: r.x + stream.Int31n(r.w), Y: r.y + stream.Int31n(r.h)}
+			if taken[at] {
+				continue
+			}
+			taken[at] = true
+			m.Nodes = append(m.Nodes, Node{
+				ID:   int32(len(m.Nodes) + 1),
+				Kind: kinds[stream.Intn(len(kinds))],
+				X:    at.X,
+				Y:    at.Y,
+			})
+		}
+	}
+	return m
+}
@@ -0,0 +1,62 @@
+package dungeon
+
+import (
+	"testing"
+
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	config := DefaultConfig()
+	for seed := int64(1); seed <= 20; seed++ {
+		m := Generate(seed, config.Layout, config.Nodes)
+		require.Len(t, m.Cells, 48*48)
+		assert.Equal(t, m, Generate(seed, config.Layout, config.Nodes), "the same seed gives the same map")
+		require.True(t, m.Walkable(m.Entrance.X, m.Entrance.Y))
+		assert.NotEmpty(t, m.Nodes)
+
+		// The border is wall
+		for i := int32(0); i < m.Width; i++ {
+			assert.False(t, m.Walkable(i, 0))
+			assert.False(t, m.Walkable(i, m.Height-1))
+			assert.False(t, m.Walkable(0, i))
+			assert.False(t, m.Walkable(m.Width-1, i))
+		}
+
+		// Every floor cell and node can be reached from the entrance
+		reached := map[geometry.Point]bool{m.Entrance: true}
+		queue := []geometry.Point{m.Entrance}
+		for len(queue) > 0 {
+			p := queue[0]
+			queue = queue[1:]
+			for _, d := range []geometry.Point{{X: 1}, {X: -1}, {Y: 1}, {Y: -1}} {
+				next := geometry.Point{X: p.X + d.X, Y: p.Y + d.Y}
+				if !reached[next] && m.Walkable(next.X, next.Y) {
+					reached[next] = true
+					queue = append(queue, next)
+				}
+			}
+		}
+		floor := 0
+		for y := int32(0); y < m.Height; y++ {
+			for x := int32(0); x < m.Width; x++ {
+				if m.Walkable(x, y) {
+					floor++
+				}
+			}
+		}
+		assert.Equal(t, floor, len(reached), "seed %d", seed)
+		for _, node := range m.Nodes {
+			assert.True(t, reached[geometry.Point{X: node.X, Y: node.Y}], "seed %d node %d", seed, node.ID)
+			found, ok := m.Node(node.ID)
+			assert.True(t, ok)
+			assert.Equal(t, node, found)
+		}
+	}
+
+	assert.NotEqual(t, Generate(1, config.Layout, config.Nodes).Cells, Generate(2, config.Layout, config.Nodes).Cells)
+	_, ok := Generate(1, config.Layout, config.Nodes).Node(0)
+	assert.False(t, ok)
+}
@@ -0,0 +1,191 @@
+package dungeon
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/txn"
+	interiorV1 "github.com/VoidMesh/api/api/proto/interior/v1"
+	"github.com/VoidMesh/api/api/services/inventory"
+	"github.com/charmbracelet/log"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DatabaseInterface abstracts database operations for dungeons.
+type DatabaseInterface interface {
+	GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error)
+	GetItemByName(ctx context.Context, name string) (db.Item, error)
+	GetFriendshipBetween(ctx context.Context, arg db.GetFriendshipBetweenParams) (db.Friendship, error)
+	GetDungeonInstance(ctx context.Context, id int64) (db.DungeonInstance, error)
+	DeleteDungeonInstance(ctx context.Context, id int64) (int64, error)
+	ListExpiredDungeonInstances(ctx context.Context, arg db.ListExpiredDungeonInstancesParams) ([]db.DungeonInstance, error)
+	GetDungeonMember(ctx context.Context, characterID pgtype.UUID) (db.DungeonMember, error)
+	ListDungeonMembers(ctx context.Context, dungeonID int64) ([]db.DungeonMember, error)
+	EnterDungeon(ctx context.Context, arg db.EnterDungeonParams) (int64, error)
+	UpdateDungeonPosition(ctx context.Context, arg db.UpdateDungeonPositionParams) (int64, error)
+	LeaveDungeon(ctx context.Context, characterID pgtype.UUID) (int64, error)
+	ListDungeonHarvests(ctx context.Context, dungeonID int64) ([]db.DungeonHarvest, error)
+	CreateDungeon(ctx context.Context, arg db.CreateDungeonInstanceParams, members []pgtype.UUID) (db.DungeonInstance, error)
+	HarvestNode(ctx context.Context, arg db.CreateDungeonHarvestParams, character db.Character, grants []inventory.Grant) ([]db.CharacterInventory, error)
+}
+
+// PauseInterface reports worlds an admin has paused.
+type PauseInterface interface {
+	Paused(ctx context.Context, worldID pgtype.UUID) bool
+}
+
+// WorldSeedsInterface looks up the seed of a world.
+type WorldSeedsInterface interface {
+	Seed(ctx context.Context, worldID pgtype.UUID) (int64, error)
+}
+
+// InteriorLocator finds characters inside structure interiors.
+type InteriorLocator interface {
+	Locate(ctx context.Context, characterID pgtype.UUID) (*interiorV1.InteriorPosition, error)
+}
+
+// DatabaseWrapper implements DatabaseInterface using the actual database connection.
+type DatabaseWrapper struct {
+	pool    storage.Pool
+	queries *db.Queries
+}
+
+// NewDatabaseWrapper creates a new database wrapper with the given connection pool.
+func NewDatabaseWrapper(pool storage.Pool) DatabaseInterface {
+	return &DatabaseWrapper{
+		pool:    pool,
+		queries: db.New(pool),
+	}
+}
+
+func (d *DatabaseWrapper) GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error) {
+	return d.queries.GetCharacterById(ctx, id)
+}
+
+func (d *DatabaseWrapper) GetItemByName(ctx context.Context, name string) (db.Item, error) {
+	return d.queries.GetItemByName(ctx, name)
+}
+
+func (d *DatabaseWrapper) GetFriendshipBetween(ctx context.Context, arg db.GetFriendshipBetweenParams) (db.Friendship, error) {
+	return d.queries.GetFriendshipBetween(ctx, arg)
+}
+
+func (d *DatabaseWrapper) GetDungeonInstance(ctx context.Context, id int64) (db.DungeonInstance, error) {
+	return d.queries.GetDungeonInstance(ctx, id)
+}
+
+func (d *DatabaseWrapper) DeleteDungeonInstance(ctx context.Context, id int64) (int64, error) {
+	return d.queries.DeleteDungeonInstance(ctx, id)
+}
+
+func (d *DatabaseWrapper) ListExpiredDungeonInstances(ctx context.Context, arg db.ListExpiredDungeonInstancesParams) ([]db.DungeonInstance, error) {
+	return d.queries.ListExpiredDungeonInstances(ctx, arg)
+}
+
+func (d *DatabaseWrapper) GetDungeonMember(ctx context.Context, characterID pgtype.UUID) (db.DungeonMember, error) {
+	return d.queries.GetDungeonMember(ctx, characterID)
+}
+
+func (d *DatabaseWrapper) ListDungeonMembers(ctx context.Context, dungeonID int64) ([]db.DungeonMember, error) {
+	return d.queries.ListDungeonMembers(ctx, dungeonID)
+}
+
+func (d *DatabaseWrapper) EnterDungeon(ctx context.Context, arg db.EnterDungeonParams) (int64, error) {
+	return d.queries.EnterDungeon(ctx, arg)
+}
+
+func (d *DatabaseWrapper) UpdateDungeonPosition(ctx context.Context, arg db.UpdateDungeonPositionParams) (int64, error) {
+	return d.queries.UpdateDungeonPosition(ctx, arg)
+}
+
+func (d *DatabaseWrapper) LeaveDungeon(ctx context.Context, characterID pgtype.UUID) (int64, error) {
+	return d.queries.LeaveDungeon(ctx, characterID)
+}
+
+func (d *DatabaseWrapper) ListDungeonHarvests(ctx context.Context, dungeonID int64) ([]db.DungeonHarvest, error) {
+	return d.queries.ListDungeonHarvests(ctx, dungeonID)
+}
+
+// CreateDungeon stores an instance and binds its party in one serializable transaction.
+// It returns ErrAlreadyBound, creating nothing, when a member is bound to another instance.
+func (d *DatabaseWrapper) CreateDungeon(ctx context.Context, arg db.CreateDungeonInstanceParams, members []pgtype.UUID) (db.DungeonInstance, error) {
+	var instance db.DungeonInstance
+	err := txn.Run(ctx, d.pool, txn.Serializable, func(q *db.Queries) error {
+		var err error
+		instance, err = q.CreateDungeonInstance(ctx, arg)
+		if err != nil {
+			return fmt.Errorf("failed to create dungeon instance: %w", err)
+		}
+		for _, member := range members {
+			added, err := q.AddDungeonMember(ctx, db.AddDungeonMemberParams{CharacterID: member, DungeonID: instance.ID, JoinedAt: arg.CreatedAt})
+			if err != nil {
+				return fmt.Errorf("failed to add dungeon member: %w", err)
+			}
+			if added == 0 {
+				return ErrAlreadyBound
+			}
+		}
+		return nil
+	})
+	return instance, err
+}
+
+// HarvestNode records the harvest and grants its yield in one serializable transaction.
+// It returns ErrHarvested when the node was already harvested, and nothing changes when
+// the yield does not fit.
+func (d *DatabaseWrapper) HarvestNode(ctx context.Context, arg db.CreateDungeonHarvestParams, character db.Character, grants []inventory.Grant) ([]db.CharacterInventory, error) {
+	var stacks []db.CharacterInventory
+	err := txn.Run(ctx, d.pool, txn.Serializable, func(q *db.Queries) error {
+		recorded, err := q.CreateDungeonHarvest(ctx, arg)
+		if err != nil {
+			return fmt.Errorf("failed to record harvest: %w", err)
+		}
+		if recorded == 0 {
+			return ErrHarvested
+		}
+		stacks, err = inventory.GrantAllInTx(ctx, q, character, grants)
+		return err
+	})
+	return stacks, err
+}
+
+// LoggerInterface abstracts logging operations for dependency injection.
+type LoggerInterface interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+	With(keysAndValues ...interface{}) LoggerInterface
+}
+
+// DefaultLoggerWrapper wraps the internal logging package.
+type DefaultLoggerWrapper struct {
+	logger *log.Logger
+}
+
+func NewDefaultLoggerWrapper() LoggerInterface {
+	return &DefaultLoggerWrapper{logger: logging.GetLogger()}
+}
+
+func (l *DefaultLoggerWrapper) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.Debug(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Info(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.Warn(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.Error(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) With(keysAndValues ...interface{}) LoggerInterface {
+	return &DefaultLoggerWrapper{logger: l.logger.With(keysAndValues...)}
+}
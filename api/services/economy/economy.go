@@ -0,0 +1,235 @@
+// Package economy keeps the economy ledger: currency entering the game (created, such as
+// harvested Minerals) and leaving it (destroyed, such as market taxes and fees or land
+// claim payments) in each world. Sinks are recorded with RecordInTx in the transaction
+// that takes the currency, and harvests are projected from resource.harvested events.
+// Stats sums the ledger per period so admins can watch for inflation.
+package economy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	adminV1 "github.com/VoidMesh/api/api/proto/admin/v1"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Sources of ledger entries
+const (
+	SourceHarvest          = "harvest"
+	SourceMarketSaleTax    = "market_sale_tax"
+	SourceMarketListingFee = "market_listing_fee"
+	SourceLandClaim        = "land_claim"
+	SourceTradeTax         = "trade_tax"
+)
+
+const (
+	DefaultStatsPeriods = 30
+	MaxStatsPeriods     = 366
+)
+
+// Flow is currency created or destroyed by one action
+type Flow struct {
+	WorldID   pgtype.UUID
+	Source    string
+	Created   int64
+	Destroyed int64
+	DedupKey  string // Identifies the action; recording it again does nothing
+	At        time.Time
+}
+
+// Tax is percent of an amount, rounded down. Every tax is computed with it, so the tax a
+// player is shown is the tax they are charged.
+func Tax(amount int64, percent int32) int64 {
+	return amount * int64(percent) / 100
+}
+
+// RecordInTx records a flow with queries bound to the transaction that moves the
+// currency, so the ledger never disagrees with inventories. Empty flows are skipped.
+func RecordInTx(ctx context.Context, q *db.Queries, flow Flow) error {
+	return record(ctx, q, flow)
+}
+
+func record(ctx context.Context, database DatabaseInterface, flow Flow) error {
+	if flow.Created == 0 && flow.Destroyed == 0 {
+		return nil
+	}
+	_, err := database.RecordEconomyFlow(ctx, db.RecordEconomyFlowParams{
+		WorldID:    flow.WorldID,
+		Source:     flow.Source,
+		Created:    flow.Created,
+		Destroyed:  flow.Destroyed,
+		DedupKey:   flow.DedupKey,
+		RecordedAt: pgtype.Timestamp{Time: flow.At, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record %s flow: %w", flow.Source, err)
+	}
+	return nil
+}
+
+// Config sets the item the ledger follows
+type Config struct {
+	CurrencyItem string // As in the market, mail and land claim configs
+}
+
+// DefaultConfig follows Minerals, the currency of the market, mail and land claims
+func DefaultConfig() Config {
+	return Config{CurrencyItem: "Minerals"}
+}
+
+// Service records currency created by harvests and reports the ledger.
+type Service struct {
+	db     DatabaseInterface
+	logger LoggerInterface
+	clock  clock.Clock
+	config Config
+}
+
+// NewService creates a new economy service with dependency injection.
+func NewService(db DatabaseInterface, logger LoggerInterface) *Service {
+	componentLogger := logger.With("component", "economy-service")
+	componentLogger.Debug("Creating new economy service")
+	return &Service{
+		db:     db,
+		logger: componentLogger,
+		clock:  clock.New(),
+		config: DefaultConfig(),
+	}
+}
+
+// NewServiceWithPool creates a service with concrete implementations (convenience constructor for production use).
+func NewServiceWithPool(pool storage.Pool) *Service {
+	return NewService(NewDatabaseWrapper(pool), NewDefaultLoggerWrapper())
+}
+
+// SetClock replaces the clock the stats periods end at (for simulation tests)
+func (s *Service) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// SetConfig replaces the currency item
+func (s *Service) SetConfig(config Config) {
+	s.config = config
+}
+
+// Subscribe records the currency harvests create
+func (s *Service) Subscribe(bus *events.Bus) {
+	bus.Subscribe(events.ResourceHarvested, events.Dedup(s.handleResourceHarvested, events.DefaultDedupCapacity))
+}
+
+// handleResourceHarvested records harvested currency as created, overflow included: it
+// exists on the ground or in the inbox even when the inventory was full
+func (s *Service) handleResourceHarvested(ctx context.Context, event events.Event) error {
+	var payload events.ResourceHarvestedPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("invalid %s payload: %w", event.Type, err)
+	}
+	quantity := payload.Items[s.config.CurrencyItem]
+	if quantity <= 0 {
+		return nil
+	}
+	worldID, err := uuid.StringToPgtype(payload.WorldID)
+	if err != nil {
+		return fmt.Errorf("invalid world ID %q: %w", payload.WorldID, err)
+	}
+	return record(ctx, s.db, Flow{
+		WorldID:  worldID,
+		Source:   SourceHarvest,
+		Created:  int64(quantity),
+		DedupKey: events.ResourceHarvested + ":" + payload.HarvestID,
+		At:       event.OccurredAt,
+	})
+}
+
+// periodStart returns the start of the period t is in, and the start of the one before
+// it, as Postgres date_trunc computes them (weeks start on Monday)
+func periodStart(t time.Time, period adminV1.EconomyPeriod) (start time.Time, previous func(time.Time) time.Time) {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	switch period {
+	case adminV1.EconomyPeriod_ECONOMY_PERIOD_HOUR:
+		return t.Truncate(time.Hour), func(p time.Time) time.Time { return p.Add(-time.Hour) }
+	case adminV1.EconomyPeriod_ECONOMY_PERIOD_WEEK:
+		monday := day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+		return monday, func(p time.Time) time.Time { return p.AddDate(0, 0, -7) }
+	default:
+		return day, func(p time.Time) time.Time { return p.AddDate(0, 0, -1) }
+	}
+}
+
+// truncField is the date_trunc field of a period
+func truncField(period adminV1.EconomyPeriod) string {
+	switch period {
+	case adminV1.EconomyPeriod_ECONOMY_PERIOD_HOUR:
+		return "hour"
+	case adminV1.EconomyPeriod_ECONOMY_PERIOD_WEEK:
+		return "week"
+	default:
+		return "day"
+	}
+}
+
+// Stats sums the currency created and destroyed in a world over the last periods, the
+// current one included
+func (s *Service) Stats(ctx context.Context, worldID string, period adminV1.EconomyPeriod, periods int32) (*adminV1.GetEconomyStatsResponse, error) {
+	world, err := uuid.StringToPgtype(worldID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid world ID format")
+	}
+	if _, ok := adminV1.EconomyPeriod_name[int32(period)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown period %d", period)
+	}
+	if periods < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "periods must not be negative")
+	}
+	if periods == 0 {
+		periods = DefaultStatsPeriods
+	}
+	periods = min(periods, MaxStatsPeriods)
+
+	since, previous := periodStart(s.clock.Now(), period)
+	for i := int32(1); i < periods; i++ {
+		since = previous(since)
+	}
+	rows, err := s.db.GetEconomyStats(ctx, db.GetEconomyStatsParams{
+		WorldID: world,
+		Period:  truncField(period),
+		Since:   pgtype.Timestamp{Time: since, Valid: true},
+	})
+	if err != nil {
+		s.logger.Error("Failed to get economy stats", "world_id", worldID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to get economy stats")
+	}
+
+	resp := &adminV1.GetEconomyStatsResponse{CurrencyItem: s.config.CurrencyItem}
+	byStart := make(map[time.Time]*adminV1.EconomyPeriodStats)
+	for _, row := range rows {
+		stats, ok := byStart[row.PeriodStart.Time]
+		if !ok {
+			stats = &adminV1.EconomyPeriodStats{Start: timestamppb.New(row.PeriodStart.Time)}
+			byStart[row.PeriodStart.Time] = stats
+			resp.Periods = append(resp.Periods, stats)
+		}
+		stats.Created += row.Created
+		stats.Destroyed += row.Destroyed
+		stats.Net = stats.Created - stats.Destroyed
+		stats.Sources = append(stats.Sources, &adminV1.EconomySourceStats{Source: row.Source, Created: row.Created, Destroyed: row.Destroyed})
+		resp.Created += row.Created
+		resp.Destroyed += row.Destroyed
+	}
+	sort.SliceStable(resp.Periods, func(i, j int) bool {
+		return resp.Periods[i].Start.AsTime().Before(resp.Periods[j].Start.AsTime())
+	})
+	return resp, nil
+}
@@ -0,0 +1,154 @@
+package economy
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	adminV1 "github.com/VoidMesh/api/api/proto/admin/v1"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeDB keeps ledger entries in memory and returns canned stats rows
+type fakeDB struct {
+	flows []db.RecordEconomyFlowParams
+	keys  map[string]bool
+	stats db.GetEconomyStatsParams
+	rows  []db.GetEconomyStatsRow
+}
+
+func (f *fakeDB) RecordEconomyFlow(ctx context.Context, arg db.RecordEconomyFlowParams) (int64, error) {
+	if f.keys[arg.DedupKey] {
+		return 0, nil
+	}
+	f.keys[arg.DedupKey] = true
+	f.flows = append(f.flows, arg)
+	return 1, nil
+}
+
+func (f *fakeDB) GetEconomyStats(ctx context.Context, arg db.GetEconomyStatsParams) ([]db.GetEconomyStatsRow, error) {
+	f.stats = arg
+	return f.rows, nil
+}
+
+const worldID = "00000000-0000-0000-0000-0000000000aa"
+
+func newTestService(t *testing.T) (*Service, *fakeDB, *clock.Fake) {
+	database := &fakeDB{keys: map[string]bool{}}
+	service := NewService(database, testmocks.NopLogger[LoggerInterface]{})
+	// A Wednesday
+	fake := clock.NewFake(time.Date(2025, 1, 15, 15, 30, 0, 0, time.UTC))
+	service.SetClock(fake)
+	return service, database, fake
+}
+
+func TestHandleResourceHarvested(t *testing.T) {
+	service, database, fake := newTestService(t)
+	bus := events.NewBus()
+	service.Subscribe(bus)
+	publish := func(key string, items map[string]int32) {
+		data, err := json.Marshal(events.ResourceHarvestedPayload{HarvestID: key, WorldID: worldID, Items: items})
+		require.NoError(t, err)
+		require.NoError(t, bus.Publish(context.Background(), events.Event{Type: events.ResourceHarvested, DedupKey: key, Payload: data, OccurredAt: fake.Now()}))
+	}
+
+	publish("h1", map[string]int32{"Wood": 3})
+	assert.Empty(t, database.flows, "only the currency is followed")
+
+	publish("h2", map[string]int32{"Minerals": 4, "Wood": 1})
+	publish("h2", map[string]int32{"Minerals": 4, "Wood": 1})
+	require.Len(t, database.flows, 1, "a harvest is recorded once")
+	flow := database.flows[0]
+	assert.Equal(t, SourceHarvest, flow.Source)
+	assert.Equal(t, int64(4), flow.Created)
+	assert.Zero(t, flow.Destroyed)
+	assert.Equal(t, worldID, uuid.PgtypeToString(flow.WorldID))
+	assert.Equal(t, fake.Now(), flow.RecordedAt.Time)
+}
+
+func TestTax(t *testing.T) {
+	assert.Equal(t, int64(12), Tax(250, 5), "rounded down")
+	assert.Equal(t, int64(0), Tax(19, 5))
+	assert.Equal(t, int64(0), Tax(250, 0))
+}
+
+func TestPeriodStart(t *testing.T) {
+	now := time.Date(2025, 1, 15, 15, 30, 0, 0, time.UTC)
+	tests := []struct {
+		period   adminV1.EconomyPeriod
+		start    time.Time
+		previous time.Time
+	}{
+		{adminV1.EconomyPeriod_ECONOMY_PERIOD_HOUR, time.Date(2025, 1, 15, 15, 0, 0, 0, time.UTC), time.Date(2025, 1, 15, 14, 0, 0, 0, time.UTC)},
+		{adminV1.EconomyPeriod_ECONOMY_PERIOD_UNSPECIFIED, time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 14, 0, 0, 0, 0, time.UTC)},
+		{adminV1.EconomyPeriod_ECONOMY_PERIOD_WEEK, time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.period.String(), func(t *testing.T) {
+			start, previous := periodStart(now, tt.period)
+			assert.Equal(t, tt.start, start)
+			assert.Equal(t, tt.previous, previous(start))
+		})
+	}
+
+	sunday, _ := periodStart(time.Date(2025, 1, 19, 23, 0, 0, 0, time.UTC), adminV1.EconomyPeriod_ECONOMY_PERIOD_WEEK)
+	assert.Equal(t, time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC), sunday, "weeks start on Monday")
+}
+
+func TestStats(t *testing.T) {
+	service, database, _ := newTestService(t)
+	ctx := context.Background()
+	day := func(d int) pgtype.Timestamp {
+		return pgtype.Timestamp{Time: time.Date(2025, 1, d, 0, 0, 0, 0, time.UTC), Valid: true}
+	}
+	database.rows = []db.GetEconomyStatsRow{
+		{PeriodStart: day(15), Source: SourceHarvest, Created: 30},
+		{PeriodStart: day(14), Source: SourceHarvest, Created: 50},
+		{PeriodStart: day(14), Source: SourceMarketSaleTax, Destroyed: 12},
+		{PeriodStart: day(14), Source: SourceLandClaim, Destroyed: 20},
+	}
+
+	resp, err := service.Stats(ctx, worldID, adminV1.EconomyPeriod_ECONOMY_PERIOD_DAY, 7)
+	require.NoError(t, err)
+	assert.Equal(t, "day", database.stats.Period)
+	assert.Equal(t, time.Date(2025, 1, 9, 0, 0, 0, 0, time.UTC), database.stats.Since.Time, "seven whole days including today")
+	assert.Equal(t, "Minerals", resp.CurrencyItem)
+	assert.Equal(t, int64(80), resp.Created)
+	assert.Equal(t, int64(32), resp.Destroyed)
+	require.Len(t, resp.Periods, 2)
+	assert.Equal(t, day(14).Time, resp.Periods[0].Start.AsTime(), "oldest first")
+	assert.Equal(t, int64(18), resp.Periods[0].Net)
+	assert.Len(t, resp.Periods[0].Sources, 3)
+	assert.Equal(t, int64(30), resp.Periods[1].Net)
+
+	_, err = service.Stats(ctx, worldID, adminV1.EconomyPeriod_ECONOMY_PERIOD_HOUR, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "hour", database.stats.Period)
+	assert.Equal(t, time.Date(2025, 1, 15, 15-(DefaultStatsPeriods-1), 0, 0, 0, time.UTC), database.stats.Since.Time)
+
+	_, err = service.Stats(ctx, worldID, adminV1.EconomyPeriod_ECONOMY_PERIOD_WEEK, 1000)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC).AddDate(0, 0, -7*(MaxStatsPeriods-1)), database.stats.Since.Time)
+}
+
+func TestStats_Validation(t *testing.T) {
+	service, _, _ := newTestService(t)
+	ctx := context.Background()
+
+	_, err := service.Stats(ctx, "not-a-uuid", adminV1.EconomyPeriod_ECONOMY_PERIOD_DAY, 1)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = service.Stats(ctx, worldID, adminV1.EconomyPeriod(42), 1)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = service.Stats(ctx, worldID, adminV1.EconomyPeriod_ECONOMY_PERIOD_DAY, -1)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,264 @@
+package feature_flag
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	adminV1 "github.com/VoidMesh/api/api/proto/admin/v1"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Experiment subjects, as stored
+const (
+	SubjectWorld     = "world"
+	SubjectCharacter = "character"
+)
+
+var subjects = map[adminV1.ExperimentSubject]string{
+	adminV1.ExperimentSubject_EXPERIMENT_SUBJECT_WORLD:     SubjectWorld,
+	adminV1.ExperimentSubject_EXPERIMENT_SUBJECT_CHARACTER: SubjectCharacter,
+}
+
+// variant is an experiment variant as stored in experiments.variants
+type variant struct {
+	Name   string             `json:"name"`
+	Weight int32              `json:"weight"`
+	Params map[string]float64 `json:"params,omitempty"`
+}
+
+// CreateExperiment starts an experiment; subjects created from now on are assigned to its variants
+func (s *Service) CreateExperiment(ctx context.Context, createdBy string, experiment *adminV1.Experiment) (*adminV1.Experiment, error) {
+	if experiment == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "experiment is required")
+	}
+	if !namePattern.MatchString(experiment.Name) {
+		return nil, status.Errorf(codes.InvalidArgument, "name must be lowercase letters, digits and underscores")
+	}
+	subject, ok := subjects[experiment.Subject]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "subject is required")
+	}
+	if len(experiment.Variants) < 2 {
+		return nil, status.Errorf(codes.InvalidArgument, "an experiment needs at least two variants")
+	}
+	variants := make([]variant, 0, len(experiment.Variants))
+	names := make(map[string]bool, len(experiment.Variants))
+	for _, v := range experiment.Variants {
+		if v.Name == "" || names[v.Name] {
+			return nil, status.Errorf(codes.InvalidArgument, "variant names must be set and unique")
+		}
+		if v.Weight < 1 {
+			return nil, status.Errorf(codes.InvalidArgument, "variant %s needs a weight of at least 1", v.Name)
+		}
+		names[v.Name] = true
+		variants = append(variants, variant{Name: v.Name, Weight: v.Weight, Params: v.Params})
+	}
+	data, err := json.Marshal(variants)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode variants")
+	}
+	createdByID, err := uuid.StringToPgtype(createdBy)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID format")
+	}
+
+	row, err := s.db.CreateExperiment(ctx, db.CreateExperimentParams{
+		Name:        experiment.Name,
+		Description: experiment.Description,
+		Subject:     subject,
+		Variants:    data,
+		StartedAt:   pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
+		CreatedBy:   createdByID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.AlreadyExists, "experiment %s already exists", experiment.Name)
+	}
+	if err != nil {
+		s.logger.Error("Failed to create experiment", "name", experiment.Name, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to create experiment")
+	}
+	s.logger.Info("Experiment started", "name", row.Name, "subject", row.Subject, "variants", len(variants), "created_by", createdBy)
+	return dbExperimentToProto(row, nil)
+}
+
+// ListExperiments lists every experiment with the number of subjects in each variant
+func (s *Service) ListExperiments(ctx context.Context) ([]*adminV1.Experiment, error) {
+	rows, err := s.db.ListExperiments(ctx)
+	if err != nil {
+		s.logger.Error("Failed to list experiments", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list experiments")
+	}
+	counts, err := s.db.CountExperimentAssignments(ctx)
+	if err != nil {
+		s.logger.Error("Failed to count experiment assignments", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list experiments")
+	}
+	byVariant := make(map[string]map[string]int64)
+	for _, count := range counts {
+		if byVariant[count.Experiment] == nil {
+			byVariant[count.Experiment] = make(map[string]int64)
+		}
+		byVariant[count.Experiment][count.Variant] = count.Assignments
+	}
+
+	experiments := make([]*adminV1.Experiment, 0, len(rows))
+	for _, row := range rows {
+		experiment, err := dbExperimentToProto(row, byVariant[row.Name])
+		if err != nil {
+			return nil, err
+		}
+		experiments = append(experiments, experiment)
+	}
+	return experiments, nil
+}
+
+// StopExperiment stops assigning new subjects to an experiment
+func (s *Service) StopExperiment(ctx context.Context, name string) (*adminV1.Experiment, error) {
+	row, err := s.db.StopExperiment(ctx, db.StopExperimentParams{
+		Name:      name,
+		StoppedAt: pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "experiment not found or already stopped")
+	}
+	if err != nil {
+		s.logger.Error("Failed to stop experiment", "name", name, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to stop experiment")
+	}
+	s.logger.Info("Experiment stopped", "name", row.Name)
+	return dbExperimentToProto(row, nil)
+}
+
+// Assign assigns a subject created at createdAt to every running experiment it is not yet
+// part of, then returns the parameters of all its variants. When two experiments set the
+// same parameter, the one whose name sorts last wins. Calling it again is harmless.
+func (s *Service) Assign(ctx context.Context, subject string, subjectID pgtype.UUID, createdAt time.Time) (map[string]float64, error) {
+	experiments, err := s.db.ListActiveExperiments(ctx, db.ListActiveExperimentsParams{
+		Subject:   subject,
+		CreatedAt: pgtype.Timestamp{Time: createdAt, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list experiments: %w", err)
+	}
+	for _, experiment := range experiments {
+		var variants []variant
+		if err := json.Unmarshal(experiment.Variants, &variants); err != nil {
+			return nil, fmt.Errorf("invalid variants of experiment %s: %w", experiment.Name, err)
+		}
+		chosen := pickVariant(experiment.Name, subjectID, variants)
+		err := s.db.AssignExperiment(ctx, db.CreateExperimentAssignmentParams{
+			Experiment: experiment.Name,
+			SubjectID:  subjectID,
+			Variant:    chosen.Name,
+			AssignedAt: pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
+		}, events.ExperimentAssignedPayload{
+			Experiment: experiment.Name,
+			Subject:    subject,
+			SubjectID:  uuid.PgtypeToString(subjectID),
+			Variant:    chosen.Name,
+			Params:     chosen.Params,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to assign experiment %s: %w", experiment.Name, err)
+		}
+	}
+
+	assignments, err := s.db.ListExperimentAssignments(ctx, subjectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list assignments: %w", err)
+	}
+	params := make(map[string]float64)
+	for _, assignment := range assignments {
+		var variants []variant
+		if err := json.Unmarshal(assignment.Variants, &variants); err != nil {
+			return nil, fmt.Errorf("invalid variants of experiment %s: %w", assignment.Experiment, err)
+		}
+		for _, v := range variants {
+			if v.Name == assignment.Variant {
+				for name, value := range v.Params {
+					params[name] = value
+				}
+			}
+		}
+	}
+	return params, nil
+}
+
+// pickVariant chooses a subject's variant by weight. The choice only depends on the
+// experiment and the subject, so a retried assignment picks the same variant.
+func pickVariant(experiment string, subjectID pgtype.UUID, variants []variant) variant {
+	var total uint32
+	for _, v := range variants {
+		total += uint32(v.Weight)
+	}
+	h := fnv.New32a()
+	h.Write([]byte(experiment))
+	h.Write(subjectID.Bytes[:])
+	n := h.Sum32() % total
+	for _, v := range variants {
+		if n < uint32(v.Weight) {
+			return v
+		}
+		n -= uint32(v.Weight)
+	}
+	return variants[len(variants)-1]
+}
+
+// Subscribe assigns new characters to the running character experiments
+func (s *Service) Subscribe(bus *events.Bus) {
+	bus.Subscribe(events.CharacterCreated, events.Dedup(s.handleCharacterCreated, events.DefaultDedupCapacity))
+}
+
+func (s *Service) handleCharacterCreated(ctx context.Context, event events.Event) error {
+	var payload events.CharacterCreatedPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("invalid %s payload: %w", event.Type, err)
+	}
+	id, err := uuid.StringToPgtype(payload.CharacterID)
+	if err != nil {
+		return fmt.Errorf("invalid character ID %q: %w", payload.CharacterID, err)
+	}
+	_, err = s.Assign(ctx, SubjectCharacter, id, event.OccurredAt)
+	return err
+}
+
+func dbExperimentToProto(row db.Experiment, counts map[string]int64) (*adminV1.Experiment, error) {
+	var variants []variant
+	if err := json.Unmarshal(row.Variants, &variants); err != nil {
+		return nil, status.Errorf(codes.Internal, "invalid variants of experiment %s", row.Name)
+	}
+	experiment := &adminV1.Experiment{
+		Name:        row.Name,
+		Description: row.Description,
+		StartedAt:   timestamppb.New(row.StartedAt.Time),
+		CreatedBy:   uuid.PgtypeToString(row.CreatedBy),
+	}
+	for subject, name := range subjects {
+		if name == row.Subject {
+			experiment.Subject = subject
+		}
+	}
+	if row.StoppedAt.Valid {
+		experiment.StoppedAt = timestamppb.New(row.StoppedAt.Time)
+	}
+	for _, v := range variants {
+		experiment.Variants = append(experiment.Variants, &adminV1.ExperimentVariant{
+			Name:        v.Name,
+			Weight:      v.Weight,
+			Params:      v.Params,
+			Assignments: counts[v.Name],
+		})
+	}
+	return experiment, nil
+}
@@ -0,0 +1,155 @@
+package feature_flag
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	adminV1 "github.com/VoidMesh/api/api/proto/admin/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPickVariant(t *testing.T) {
+	variants := []variant{{Name: "control", Weight: 3}, {Name: "dense", Weight: 1}}
+	counts := map[string]int{}
+	for i := 1; i <= 2000; i++ {
+		chosen := pickVariant("density", testUUID(t, i), variants)
+		assert.Equal(t, chosen.Name, pickVariant("density", testUUID(t, i), variants).Name, "assignment is deterministic")
+		counts[chosen.Name]++
+	}
+	assert.InDelta(t, 1500, counts["control"], 100)
+	assert.InDelta(t, 500, counts["dense"], 100)
+}
+
+func densityExperiment() *adminV1.Experiment {
+	return &adminV1.Experiment{
+		Name:    "density",
+		Subject: adminV1.ExperimentSubject_EXPERIMENT_SUBJECT_WORLD,
+		Variants: []*adminV1.ExperimentVariant{
+			{Name: "control", Weight: 1, Params: map[string]float64{"resource_density": 1}},
+			{Name: "dense", Weight: 1, Params: map[string]float64{"resource_density": 1.5}},
+		},
+	}
+}
+
+func TestService_CreateExperiment_Validation(t *testing.T) {
+	service := NewService(newFakeDB(), testmocks.NopLogger[LoggerInterface]{})
+	ctx := context.Background()
+
+	invalid := []func(*adminV1.Experiment){
+		func(e *adminV1.Experiment) { e.Name = "" },
+		func(e *adminV1.Experiment) { e.Subject = adminV1.ExperimentSubject_EXPERIMENT_SUBJECT_UNSPECIFIED },
+		func(e *adminV1.Experiment) { e.Variants = e.Variants[:1] },
+		func(e *adminV1.Experiment) { e.Variants[1].Name = "control" },
+		func(e *adminV1.Experiment) { e.Variants[0].Weight = 0 },
+	}
+	for i, change := range invalid {
+		experiment := densityExperiment()
+		change(experiment)
+		_, err := service.CreateExperiment(ctx, adminID, experiment)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), "case %d", i)
+	}
+
+	_, err := service.CreateExperiment(ctx, adminID, densityExperiment())
+	require.NoError(t, err)
+	_, err = service.CreateExperiment(ctx, adminID, densityExperiment())
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+}
+
+func TestService_Assign(t *testing.T) {
+	database := newFakeDB()
+	service := NewService(database, testmocks.NopLogger[LoggerInterface]{})
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	fake := clock.NewFake(start)
+	service.SetClock(fake)
+	ctx := context.Background()
+
+	created, err := service.CreateExperiment(ctx, adminID, densityExperiment())
+	require.NoError(t, err)
+	assert.Equal(t, adminV1.ExperimentSubject_EXPERIMENT_SUBJECT_WORLD, created.Subject)
+
+	oldWorld := testUUID(t, 100)
+	params, err := service.Assign(ctx, SubjectWorld, oldWorld, start.Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, params, "worlds created before the experiment are not assigned")
+
+	newWorld := testUUID(t, 101)
+	params, err = service.Assign(ctx, SubjectWorld, newWorld, start.Add(time.Minute))
+	require.NoError(t, err)
+	require.Contains(t, params, "resource_density")
+	require.Len(t, database.events, 1)
+	assert.Equal(t, events.ExperimentAssignedPayload{
+		Experiment: "density",
+		Subject:    SubjectWorld,
+		SubjectID:  uuid.PgtypeToString(newWorld),
+		Variant:    database.assignments[0].Variant,
+		Params:     params,
+	}, database.events[0])
+
+	again, err := service.Assign(ctx, SubjectWorld, newWorld, start.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, params, again)
+	assert.Len(t, database.events, 1, "assignments are exported once")
+
+	_, err = service.Assign(ctx, SubjectCharacter, testUUID(t, 1), start.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Len(t, database.assignments, 1, "world experiments do not assign characters")
+
+	fake.Advance(time.Hour)
+	_, err = service.StopExperiment(ctx, "density")
+	require.NoError(t, err)
+	_, err = service.StopExperiment(ctx, "density")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	params, err = service.Assign(ctx, SubjectWorld, testUUID(t, 102), start.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, params, "stopped experiments assign no one new")
+	kept, err := service.Assign(ctx, SubjectWorld, newWorld, start.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, again, kept, "existing assignments keep their parameters")
+
+	experiments, err := service.ListExperiments(ctx)
+	require.NoError(t, err)
+	require.Len(t, experiments, 1)
+	assert.NotNil(t, experiments[0].StoppedAt)
+	var total int64
+	for _, v := range experiments[0].Variants {
+		total += v.Assignments
+	}
+	assert.Equal(t, int64(1), total)
+}
+
+func TestService_AssignsNewCharacters(t *testing.T) {
+	database := newFakeDB()
+	service := NewService(database, testmocks.NopLogger[LoggerInterface]{})
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	service.SetClock(clock.NewFake(start))
+	bus := events.NewBus()
+	service.Subscribe(bus)
+	ctx := context.Background()
+
+	experiment := densityExperiment()
+	experiment.Subject = adminV1.ExperimentSubject_EXPERIMENT_SUBJECT_CHARACTER
+	_, err := service.CreateExperiment(ctx, adminID, experiment)
+	require.NoError(t, err)
+
+	character := uuid.PgtypeToString(testUUID(t, 7))
+	payload, err := json.Marshal(events.CharacterCreatedPayload{CharacterID: character})
+	require.NoError(t, err)
+	require.NoError(t, bus.Publish(ctx, events.Event{
+		Type:       events.CharacterCreated,
+		DedupKey:   events.CharacterCreated + ":" + character,
+		Payload:    payload,
+		OccurredAt: start.Add(time.Second),
+	}))
+	require.Len(t, database.events, 1)
+	assert.Equal(t, SubjectCharacter, database.events[0].Subject)
+	assert.Equal(t, character, database.events[0].SubjectID)
+}
@@ -0,0 +1,296 @@
+// Package feature_flag gates new subsystems so they can be rolled out progressively
+// without a deploy. Flags are stored in the database and cached in memory; services ask
+// Enabled before using a gated feature, and admins change flags through the AdminService.
+// Every instance refreshes its cache periodically, so changes made on another instance
+// apply within one refresh interval.
+//
+// Experiments (experiment.go) split new worlds or characters between parameter variants
+// for A/B tests. Assignments are permanent and exported as experiment.assigned events.
+package feature_flag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/alerting"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	adminV1 "github.com/VoidMesh/api/api/proto/admin/v1"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DefaultRefreshInterval is how often the flag cache is reloaded from the database
+const DefaultRefreshInterval = 30 * time.Second
+
+// Flag is a feature flag the server checks. Default applies until an admin sets the flag.
+type Flag struct {
+	Name        string
+	Description string
+	Default     bool
+}
+
+// Flags known to the server
+var (
+	LandClaims = Flag{Name: "land_claims", Description: "Claiming chunks", Default: true}
+)
+
+// Known lists the flags the server checks, so admins can see them before they are set
+var Known = []Flag{LandClaims}
+
+var namePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+
+// Service stores feature flags and evaluates them from an in-memory cache.
+type Service struct {
+	db       DatabaseInterface
+	logger   LoggerInterface
+	clock    clock.Clock
+	interval time.Duration
+
+	mu     sync.RWMutex
+	flags  map[string]db.FeatureFlag
+	loaded bool
+}
+
+// NewService creates a new feature flag service with dependency injection.
+func NewService(database DatabaseInterface, logger LoggerInterface) *Service {
+	componentLogger := logger.With("component", "feature-flag-service")
+	componentLogger.Debug("Creating new feature flag service")
+	return &Service{
+		db:       database,
+		logger:   componentLogger,
+		clock:    clock.New(),
+		interval: DefaultRefreshInterval,
+		flags:    make(map[string]db.FeatureFlag),
+	}
+}
+
+// NewServiceWithPool creates a service with concrete implementations (convenience constructor for production use).
+func NewServiceWithPool(pool storage.Pool) *Service {
+	return NewService(NewDatabaseWrapper(pool), NewDefaultLoggerWrapper())
+}
+
+// SetClock replaces the clock used for timestamps and the refresh schedule (for simulation tests)
+func (s *Service) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// Enabled reports whether the flag is on for the user in the world. Until the cache has
+// loaded, and for flags that have not been set, the flag's default applies.
+func (s *Service) Enabled(ctx context.Context, flag Flag, userID, worldID pgtype.UUID) bool {
+	s.mu.RLock()
+	loaded := s.loaded
+	s.mu.RUnlock()
+	if !loaded {
+		if err := s.Refresh(ctx); err != nil {
+			s.logger.Error("Failed to load feature flags", "error", err)
+		}
+	}
+
+	s.mu.RLock()
+	row, ok := s.flags[flag.Name]
+	s.mu.RUnlock()
+	if !ok {
+		return flag.Default
+	}
+	return evaluate(row, userID, worldID)
+}
+
+// evaluate applies a stored flag's rules to a user
+func evaluate(row db.FeatureFlag, userID, worldID pgtype.UUID) bool {
+	if !row.Enabled {
+		return false
+	}
+	if len(row.WorldIds) > 0 && !slices.Contains(row.WorldIds, worldID) {
+		return false
+	}
+	if slices.Contains(row.UserIds, userID) {
+		return true
+	}
+	return bucket(row.Name, userID) < row.RolloutPercentage
+}
+
+// bucket places a user in one of 100 buckets per flag. Hashing the flag name with the
+// user spreads different flags over different users, and raising a percentage only adds
+// users.
+func bucket(name string, userID pgtype.UUID) int32 {
+	h := fnv.New32a()
+	h.Write([]byte(name))
+	h.Write(userID.Bytes[:])
+	return int32(h.Sum32() % 100)
+}
+
+// Refresh reloads the flag cache from the database
+func (s *Service) Refresh(ctx context.Context) error {
+	rows, err := s.db.ListFeatureFlags(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list feature flags: %w", err)
+	}
+	flags := make(map[string]db.FeatureFlag, len(rows))
+	for _, row := range rows {
+		flags[row.Name] = row
+	}
+	s.mu.Lock()
+	s.flags = flags
+	s.loaded = true
+	s.mu.Unlock()
+	return nil
+}
+
+// Run refreshes the flag cache until the context is cancelled
+func (s *Service) Run(ctx context.Context) {
+	s.logger.Info("Feature flag refresh started", "interval", s.interval)
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("Feature flag refresh stopped")
+			return
+		case <-s.clock.After(s.interval):
+		}
+
+		if err := s.Refresh(ctx); err != nil {
+			s.logger.Error("Feature flag refresh failed", "error", err)
+			alerting.ReportJobError("feature_flag_refresh", err)
+		}
+	}
+}
+
+// SetFlag creates or replaces a flag's settings
+func (s *Service) SetFlag(ctx context.Context, updatedBy string, flag *adminV1.FeatureFlag) (*adminV1.FeatureFlag, error) {
+	if flag == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "flag is required")
+	}
+	if !namePattern.MatchString(flag.Name) {
+		return nil, status.Errorf(codes.InvalidArgument, "name must be lowercase letters, digits and underscores")
+	}
+	if flag.RolloutPercentage < 0 || flag.RolloutPercentage > 100 {
+		return nil, status.Errorf(codes.InvalidArgument, "rollout_percentage must be between 0 and 100")
+	}
+	userIDs, err := parseIDs("user_ids", flag.UserIds)
+	if err != nil {
+		return nil, err
+	}
+	worldIDs, err := parseIDs("world_ids", flag.WorldIds)
+	if err != nil {
+		return nil, err
+	}
+	updatedByID, err := uuid.StringToPgtype(updatedBy)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID format")
+	}
+
+	row, err := s.db.UpsertFeatureFlag(ctx, db.UpsertFeatureFlagParams{
+		Name:              flag.Name,
+		Description:       flag.Description,
+		Enabled:           flag.Enabled,
+		RolloutPercentage: flag.RolloutPercentage,
+		UserIds:           userIDs,
+		WorldIds:          worldIDs,
+		UpdatedBy:         updatedByID,
+		UpdatedAt:         pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
+	})
+	if err != nil {
+		s.logger.Error("Failed to set feature flag", "name", flag.Name, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to set feature flag")
+	}
+
+	s.mu.Lock()
+	s.flags[row.Name] = row
+	s.mu.Unlock()
+	s.logger.Info("Feature flag set", "name", row.Name, "enabled", row.Enabled, "rollout_percentage", row.RolloutPercentage, "updated_by", updatedBy)
+	return dbFlagToProto(row), nil
+}
+
+// ListFlags lists stored flags together with known flags that have not been set
+func (s *Service) ListFlags(ctx context.Context) ([]*adminV1.FeatureFlag, error) {
+	rows, err := s.db.ListFeatureFlags(ctx)
+	if err != nil {
+		s.logger.Error("Failed to list feature flags", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list feature flags")
+	}
+
+	flags := make([]*adminV1.FeatureFlag, 0, len(rows)+len(Known))
+	stored := make(map[string]bool, len(rows))
+	for _, row := range rows {
+		flags = append(flags, dbFlagToProto(row))
+		stored[row.Name] = true
+	}
+	for _, flag := range Known {
+		if !stored[flag.Name] {
+			flags = append(flags, &adminV1.FeatureFlag{
+				Name:           flag.Name,
+				Description:    flag.Description,
+				Enabled:        flag.Default,
+				DefaultEnabled: flag.Default,
+			})
+		}
+	}
+	sort.Slice(flags, func(i, j int) bool { return flags[i].Name < flags[j].Name })
+	return flags, nil
+}
+
+// DeleteFlag removes a flag's settings; a known flag falls back to its default
+func (s *Service) DeleteFlag(ctx context.Context, name string) (*adminV1.FeatureFlag, error) {
+	row, err := s.db.DeleteFeatureFlag(ctx, name)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "feature flag not found")
+	}
+	if err != nil {
+		s.logger.Error("Failed to delete feature flag", "name", name, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to delete feature flag")
+	}
+
+	s.mu.Lock()
+	delete(s.flags, row.Name)
+	s.mu.Unlock()
+	s.logger.Info("Feature flag deleted", "name", row.Name)
+	return dbFlagToProto(row), nil
+}
+
+func parseIDs(field string, ids []string) ([]pgtype.UUID, error) {
+	parsed := make([]pgtype.UUID, 0, len(ids))
+	for _, id := range ids {
+		pgID, err := uuid.StringToPgtype(id)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid ID %q in %s", id, field)
+		}
+		parsed = append(parsed, pgID)
+	}
+	return parsed, nil
+}
+
+func dbFlagToProto(row db.FeatureFlag) *adminV1.FeatureFlag {
+	flag := &adminV1.FeatureFlag{
+		Name:              row.Name,
+		Description:       row.Description,
+		Enabled:           row.Enabled,
+		RolloutPercentage: row.RolloutPercentage,
+		Stored:            true,
+		UpdatedBy:         uuid.PgtypeToString(row.UpdatedBy),
+		UpdatedAt:         timestamppb.New(row.UpdatedAt.Time),
+	}
+	for _, id := range row.UserIds {
+		flag.UserIds = append(flag.UserIds, uuid.PgtypeToString(id))
+	}
+	for _, id := range row.WorldIds {
+		flag.WorldIds = append(flag.WorldIds, uuid.PgtypeToString(id))
+	}
+	for _, known := range Known {
+		if known.Name == row.Name {
+			flag.DefaultEnabled = known.Default
+		}
+	}
+	return flag
+}
@@ -0,0 +1,232 @@
+package feature_flag
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	adminV1 "github.com/VoidMesh/api/api/proto/admin/v1"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeDB keeps flags and experiments in memory and counts flag list calls
+type fakeDB struct {
+	flags       map[string]db.FeatureFlag
+	lists       int
+	experiments []db.Experiment
+	assignments []db.CreateExperimentAssignmentParams
+	events      []events.ExperimentAssignedPayload
+}
+
+func newFakeDB() *fakeDB {
+	return &fakeDB{flags: map[string]db.FeatureFlag{}}
+}
+
+func (f *fakeDB) UpsertFeatureFlag(ctx context.Context, arg db.UpsertFeatureFlagParams) (db.FeatureFlag, error) {
+	row := db.FeatureFlag(arg)
+	f.flags[arg.Name] = row
+	return row, nil
+}
+
+func (f *fakeDB) ListFeatureFlags(ctx context.Context) ([]db.FeatureFlag, error) {
+	f.lists++
+	var rows []db.FeatureFlag
+	for _, row := range f.flags {
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func (f *fakeDB) DeleteFeatureFlag(ctx context.Context, name string) (db.FeatureFlag, error) {
+	row, ok := f.flags[name]
+	if !ok {
+		return db.FeatureFlag{}, pgx.ErrNoRows
+	}
+	delete(f.flags, name)
+	return row, nil
+}
+
+func (f *fakeDB) CreateExperiment(ctx context.Context, arg db.CreateExperimentParams) (db.Experiment, error) {
+	for _, experiment := range f.experiments {
+		if experiment.Name == arg.Name {
+			return db.Experiment{}, pgx.ErrNoRows
+		}
+	}
+	row := db.Experiment{
+		Name:        arg.Name,
+		Description: arg.Description,
+		Subject:     arg.Subject,
+		Variants:    arg.Variants,
+		StartedAt:   arg.StartedAt,
+		CreatedBy:   arg.CreatedBy,
+	}
+	f.experiments = append(f.experiments, row)
+	return row, nil
+}
+
+func (f *fakeDB) ListExperiments(ctx context.Context) ([]db.Experiment, error) {
+	return f.experiments, nil
+}
+
+func (f *fakeDB) ListActiveExperiments(ctx context.Context, arg db.ListActiveExperimentsParams) ([]db.Experiment, error) {
+	var rows []db.Experiment
+	for _, experiment := range f.experiments {
+		if experiment.Subject == arg.Subject && !experiment.StoppedAt.Valid && !experiment.StartedAt.Time.After(arg.CreatedAt.Time) {
+			rows = append(rows, experiment)
+		}
+	}
+	return rows, nil
+}
+
+func (f *fakeDB) StopExperiment(ctx context.Context, arg db.StopExperimentParams) (db.Experiment, error) {
+	for i, experiment := range f.experiments {
+		if experiment.Name == arg.Name && !experiment.StoppedAt.Valid {
+			f.experiments[i].StoppedAt = arg.StoppedAt
+			return f.experiments[i], nil
+		}
+	}
+	return db.Experiment{}, pgx.ErrNoRows
+}
+
+func (f *fakeDB) ListExperimentAssignments(ctx context.Context, subjectID pgtype.UUID) ([]db.ListExperimentAssignmentsRow, error) {
+	var rows []db.ListExperimentAssignmentsRow
+	for _, assignment := range f.assignments {
+		if assignment.SubjectID != subjectID {
+			continue
+		}
+		for _, experiment := range f.experiments {
+			if experiment.Name == assignment.Experiment {
+				rows = append(rows, db.ListExperimentAssignmentsRow{Experiment: experiment.Name, Variant: assignment.Variant, Variants: experiment.Variants})
+			}
+		}
+	}
+	return rows, nil
+}
+
+func (f *fakeDB) CountExperimentAssignments(ctx context.Context) ([]db.CountExperimentAssignmentsRow, error) {
+	counts := map[[2]string]int64{}
+	for _, assignment := range f.assignments {
+		counts[[2]string{assignment.Experiment, assignment.Variant}]++
+	}
+	var rows []db.CountExperimentAssignmentsRow
+	for key, count := range counts {
+		rows = append(rows, db.CountExperimentAssignmentsRow{Experiment: key[0], Variant: key[1], Assignments: count})
+	}
+	return rows, nil
+}
+
+func (f *fakeDB) AssignExperiment(ctx context.Context, arg db.CreateExperimentAssignmentParams, event events.ExperimentAssignedPayload) error {
+	for _, assignment := range f.assignments {
+		if assignment.Experiment == arg.Experiment && assignment.SubjectID == arg.SubjectID {
+			return nil
+		}
+	}
+	f.assignments = append(f.assignments, arg)
+	f.events = append(f.events, event)
+	return nil
+}
+
+const (
+	adminID = "00000000-0000-0000-0000-000000000001"
+	worldID = "00000000-0000-0000-0000-0000000000aa"
+)
+
+func testUUID(t *testing.T, i int) pgtype.UUID {
+	id, err := uuid.StringToPgtype(fmt.Sprintf("00000000-0000-0000-0000-%012d", i))
+	require.NoError(t, err)
+	return id
+}
+
+func TestEvaluate(t *testing.T) {
+	world := testUUID(t, 100)
+	user := testUUID(t, 1)
+	row := db.FeatureFlag{Name: "combat", Enabled: true, RolloutPercentage: 100}
+
+	assert.True(t, evaluate(row, user, world))
+	row.Enabled = false
+	assert.False(t, evaluate(row, user, world), "disabled flags are off for everyone")
+
+	row = db.FeatureFlag{Name: "combat", Enabled: true, UserIds: []pgtype.UUID{user}}
+	assert.True(t, evaluate(row, user, world), "listed users")
+	assert.False(t, evaluate(row, testUUID(t, 2), world))
+
+	row.WorldIds = []pgtype.UUID{testUUID(t, 101)}
+	assert.False(t, evaluate(row, user, world), "listed users outside the allowed worlds")
+
+	row = db.FeatureFlag{Name: "combat", Enabled: true, RolloutPercentage: 25}
+	on := 0
+	for i := 1; i <= 1000; i++ {
+		if evaluate(row, testUUID(t, i), world) {
+			on++
+			row.RolloutPercentage = 50
+			assert.True(t, evaluate(row, testUUID(t, i), world), "raising the percentage keeps users on")
+			row.RolloutPercentage = 25
+		}
+	}
+	assert.InDelta(t, 250, on, 60)
+}
+
+func TestService_FlagLifecycle(t *testing.T) {
+	database := newFakeDB()
+	service := NewService(database, testmocks.NopLogger[LoggerInterface]{})
+	ctx := context.Background()
+	user := testUUID(t, 1)
+	world, err := uuid.StringToPgtype(worldID)
+	require.NoError(t, err)
+
+	assert.True(t, service.Enabled(ctx, LandClaims, user, world), "unset flags use their default")
+	assert.False(t, service.Enabled(ctx, Flag{Name: "combat"}, user, world))
+	assert.Equal(t, 1, database.lists, "the cache is loaded once")
+
+	flags, err := service.ListFlags(ctx)
+	require.NoError(t, err)
+	require.Len(t, flags, 1)
+	assert.Equal(t, "land_claims", flags[0].Name)
+	assert.False(t, flags[0].Stored)
+
+	for _, tt := range []struct {
+		flag *adminV1.FeatureFlag
+		want string
+	}{
+		{nil, "flag is required"},
+		{&adminV1.FeatureFlag{Name: "Land Claims"}, "lowercase letters"},
+		{&adminV1.FeatureFlag{Name: "combat", RolloutPercentage: 101}, "between 0 and 100"},
+		{&adminV1.FeatureFlag{Name: "combat", WorldIds: []string{"nope"}}, "in world_ids"},
+	} {
+		_, err := service.SetFlag(ctx, adminID, tt.flag)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), tt.want)
+		assert.ErrorContains(t, err, tt.want)
+	}
+
+	set, err := service.SetFlag(ctx, adminID, &adminV1.FeatureFlag{Name: "land_claims", Enabled: true, WorldIds: []string{worldID}, RolloutPercentage: 100})
+	require.NoError(t, err)
+	assert.True(t, set.Stored)
+	assert.True(t, set.DefaultEnabled)
+	assert.Equal(t, adminID, set.UpdatedBy)
+	assert.True(t, service.Enabled(ctx, LandClaims, user, world))
+	assert.False(t, service.Enabled(ctx, LandClaims, user, testUUID(t, 101)), "other worlds")
+
+	_, err = service.SetFlag(ctx, adminID, &adminV1.FeatureFlag{Name: "land_claims"})
+	require.NoError(t, err)
+	assert.False(t, service.Enabled(ctx, LandClaims, user, world), "changes apply without a refresh")
+
+	flags, err = service.ListFlags(ctx)
+	require.NoError(t, err)
+	require.Len(t, flags, 1)
+	assert.True(t, flags[0].Stored)
+
+	_, err = service.DeleteFlag(ctx, "land_claims")
+	require.NoError(t, err)
+	assert.True(t, service.Enabled(ctx, LandClaims, user, world), "deleted flags fall back to their default")
+	_, err = service.DeleteFlag(ctx, "land_claims")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,135 @@
+package feature_flag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/outbox"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/txn"
+	"github.com/charmbracelet/log"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DatabaseInterface abstracts database operations for feature flags and experiments.
+type DatabaseInterface interface {
+	UpsertFeatureFlag(ctx context.Context, arg db.UpsertFeatureFlagParams) (db.FeatureFlag, error)
+	ListFeatureFlags(ctx context.Context) ([]db.FeatureFlag, error)
+	DeleteFeatureFlag(ctx context.Context, name string) (db.FeatureFlag, error)
+	CreateExperiment(ctx context.Context, arg db.CreateExperimentParams) (db.Experiment, error)
+	ListExperiments(ctx context.Context) ([]db.Experiment, error)
+	ListActiveExperiments(ctx context.Context, arg db.ListActiveExperimentsParams) ([]db.Experiment, error)
+	StopExperiment(ctx context.Context, arg db.StopExperimentParams) (db.Experiment, error)
+	ListExperimentAssignments(ctx context.Context, subjectID pgtype.UUID) ([]db.ListExperimentAssignmentsRow, error)
+	CountExperimentAssignments(ctx context.Context) ([]db.CountExperimentAssignmentsRow, error)
+	AssignExperiment(ctx context.Context, arg db.CreateExperimentAssignmentParams, event events.ExperimentAssignedPayload) error
+}
+
+// DatabaseWrapper implements DatabaseInterface using the actual database connection.
+type DatabaseWrapper struct {
+	pool    storage.Pool
+	queries *db.Queries
+}
+
+// NewDatabaseWrapper creates a new database wrapper with the given connection pool.
+func NewDatabaseWrapper(pool storage.Pool) DatabaseInterface {
+	return &DatabaseWrapper{
+		pool:    pool,
+		queries: db.New(pool),
+	}
+}
+
+func (d *DatabaseWrapper) UpsertFeatureFlag(ctx context.Context, arg db.UpsertFeatureFlagParams) (db.FeatureFlag, error) {
+	return d.queries.UpsertFeatureFlag(ctx, arg)
+}
+
+func (d *DatabaseWrapper) ListFeatureFlags(ctx context.Context) ([]db.FeatureFlag, error) {
+	return d.queries.ListFeatureFlags(ctx)
+}
+
+func (d *DatabaseWrapper) DeleteFeatureFlag(ctx context.Context, name string) (db.FeatureFlag, error) {
+	return d.queries.DeleteFeatureFlag(ctx, name)
+}
+
+func (d *DatabaseWrapper) CreateExperiment(ctx context.Context, arg db.CreateExperimentParams) (db.Experiment, error) {
+	return d.queries.CreateExperiment(ctx, arg)
+}
+
+func (d *DatabaseWrapper) ListExperiments(ctx context.Context) ([]db.Experiment, error) {
+	return d.queries.ListExperiments(ctx)
+}
+
+func (d *DatabaseWrapper) ListActiveExperiments(ctx context.Context, arg db.ListActiveExperimentsParams) ([]db.Experiment, error) {
+	return d.queries.ListActiveExperiments(ctx, arg)
+}
+
+func (d *DatabaseWrapper) StopExperiment(ctx context.Context, arg db.StopExperimentParams) (db.Experiment, error) {
+	return d.queries.StopExperiment(ctx, arg)
+}
+
+func (d *DatabaseWrapper) ListExperimentAssignments(ctx context.Context, subjectID pgtype.UUID) ([]db.ListExperimentAssignmentsRow, error) {
+	return d.queries.ListExperimentAssignments(ctx, subjectID)
+}
+
+func (d *DatabaseWrapper) CountExperimentAssignments(ctx context.Context) ([]db.CountExperimentAssignmentsRow, error) {
+	return d.queries.CountExperimentAssignments(ctx)
+}
+
+// AssignExperiment stores an assignment and its export event in one transaction. Subjects
+// that are already assigned keep their variant and no event is written.
+func (d *DatabaseWrapper) AssignExperiment(ctx context.Context, arg db.CreateExperimentAssignmentParams, event events.ExperimentAssignedPayload) error {
+	return txn.Run(ctx, d.pool, txn.ReadCommitted, func(q *db.Queries) error {
+		_, err := q.CreateExperimentAssignment(ctx, arg)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to create assignment: %w", err)
+		}
+		return outbox.Enqueue(ctx, q, events.ExperimentAssigned, event.SubjectID,
+			fmt.Sprintf("%s:%s:%s", events.ExperimentAssigned, event.Experiment, event.SubjectID), event)
+	})
+}
+
+// LoggerInterface abstracts logging operations for dependency injection.
+type LoggerInterface interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+	With(keysAndValues ...interface{}) LoggerInterface
+}
+
+// DefaultLoggerWrapper wraps the internal logging package.
+type DefaultLoggerWrapper struct {
+	logger *log.Logger
+}
+
+func NewDefaultLoggerWrapper() LoggerInterface {
+	return &DefaultLoggerWrapper{logger: logging.GetLogger()}
+}
+
+func (l *DefaultLoggerWrapper) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.Debug(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Info(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.Warn(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.Error(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) With(keysAndValues ...interface{}) LoggerInterface {
+	return &DefaultLoggerWrapper{logger: l.logger.With(keysAndValues...)}
+}
@@ -0,0 +1,448 @@
+// Package fishing runs the fishing minigame at fishing spot resource nodes. A cast waits
+// a random delay for a bite, then gives the client HookWindow to set the hook; the timing
+// is measured on the server, so a client cannot claim to have hooked in time. A session
+// calibrated with the clock sync handshake has its reaction time measured from when the
+// bite reached it to when it hooked, within the bounded latency allowance (see package
+// latency). What is caught is drawn from a catch table filtered by the world's weather
+// and time of day.
+//
+// Casts are held in memory: Hook must reach the instance that serves the cast's stream.
+package fishing
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/authz"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/debugstats"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/VoidMesh/api/api/internal/latency"
+	"github.com/VoidMesh/api/api/internal/rng"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	"github.com/VoidMesh/api/api/internal/weather"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	fishingV1 "github.com/VoidMesh/api/api/proto/fishing/v1"
+	"github.com/VoidMesh/api/api/services/inventory"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Reasons a fish escapes
+const (
+	ReasonMissed   = "missed"
+	ReasonTooEarly = "too_early"
+)
+
+// Catch is an entry of the catch table. Empty Weather or TimesOfDay match any.
+type Catch struct {
+	Item       string
+	Weight     int
+	Min        int32
+	Max        int32
+	Weather    []weather.Condition
+	TimesOfDay []weather.TimeOfDay
+}
+
+// matches reports whether the catch can be caught in the conditions
+func (c Catch) matches(w weather.Condition, t weather.TimeOfDay) bool {
+	return (len(c.Weather) == 0 || slices.Contains(c.Weather, w)) &&
+		(len(c.TimesOfDay) == 0 || slices.Contains(c.TimesOfDay, t))
+}
+
+// Config sets where and how fishing works
+type Config struct {
+	SpotTypeID   int32 // Resource node type fished at
+	Range        int32 // Cells a character may stand from the spot
+	MinBiteDelay time.Duration
+	MaxBiteDelay time.Duration
+	HookWindow   time.Duration // Time from the bite to hook the fish
+	Catches      []Catch
+}
+
+// DefaultConfig fishes at Fishing Spots with a second to hook. Eels bite at night, pike
+// in rain and storms, and golden carp only in clear weather at dawn and dusk.
+func DefaultConfig() Config {
+	return Config{
+		SpotTypeID:   4,
+		Range:        3,
+		MinBiteDelay: 3 * time.Second,
+		MaxBiteDelay: 12 * time.Second,
+		HookWindow:   time.Second,
+		Catches: []Catch{
+			{Item: "Fish", Weight: 60, Min: 1, Max: 2},
+			{Item: "Shells", Weight: 10, Min: 1, Max: 2},
+			{Item: "Algae", Weight: 10, Min: 1, Max: 3},
+			{Item: "Night Eel", Weight: 25, Min: 1, Max: 1, TimesOfDay: []weather.TimeOfDay{weather.Dusk, weather.Night}},
+			{Item: "Storm Pike", Weight: 20, Min: 1, Max: 1, Weather: []weather.Condition{weather.Rain, weather.Storm}},
+			{Item: "Golden Carp", Weight: 5, Min: 1, Max: 1, Weather: []weather.Condition{weather.Clear}, TimesOfDay: []weather.TimeOfDay{weather.Dawn, weather.Dusk}},
+		},
+	}
+}
+
+// outcome is how a cast ended
+type outcome int
+
+const (
+	pending outcome = iota
+	hooked
+	missed
+	tooEarly
+)
+
+// cast is a character's cast in progress
+type cast struct {
+	userID string
+	done   chan struct{} // Closed once the outcome is decided by Hook
+
+	mu      sync.Mutex
+	biteID  string // Set when the fish bites
+	bitAt   time.Time
+	grace   time.Duration // How long past the hook window a hook may still arrive in time
+	outcome outcome
+}
+
+// settle decides the outcome unless it was already decided, and returns it
+func (c *cast) settle(o outcome) outcome {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.outcome == pending {
+		c.outcome = o
+	}
+	return c.outcome
+}
+
+// Service runs casts. It is safe for concurrent use.
+type Service struct {
+	db        DatabaseInterface
+	inventory InventoryServiceInterface
+	logger    LoggerInterface
+	clock     clock.Clock
+	config    Config
+	seeds     WorldSeedsInterface
+	regions   RegionServiceInterface
+	claims    ClaimServiceInterface
+
+	mu    sync.Mutex
+	casts map[[16]byte]*cast // By character ID
+}
+
+// NewService creates a new fishing service with dependency injection.
+func NewService(db DatabaseInterface, inventory InventoryServiceInterface, seeds WorldSeedsInterface, logger LoggerInterface) *Service {
+	componentLogger := logger.With("component", "fishing-service")
+	componentLogger.Debug("Creating new fishing service")
+	s := &Service{
+		db:        db,
+		inventory: inventory,
+		logger:    componentLogger,
+		clock:     clock.New(),
+		config:    DefaultConfig(),
+		seeds:     seeds,
+		casts:     make(map[[16]byte]*cast),
+	}
+	debugstats.Register(debugstats.StreamSubscriptions, "fishing.casts", func() int64 {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return int64(len(s.casts))
+	})
+	return s
+}
+
+// SetClock replaces the clock used for bite delays and hook timing (for simulation tests)
+func (s *Service) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// SetConfig replaces the bite timing and catch table
+func (s *Service) SetConfig(config Config) {
+	s.config = config
+}
+
+// SetRegionService enables protected region checks, as for harvesting
+func (s *Service) SetRegionService(regions RegionServiceInterface) {
+	s.regions = regions
+}
+
+// SetClaimService enables land claim checks, as for harvesting
+func (s *Service) SetClaimService(claims ClaimServiceInterface) {
+	s.claims = claims
+}
+
+// Conditions returns the weather and time of day at t in the world with the seed
+func (s *Service) Conditions(worldSeed int64, t time.Time) (weather.Condition, weather.TimeOfDay) {
+	return weather.At(worldSeed, t), weather.TimeOfDayAt(t)
+}
+
+// spot loads a fishing spot and checks that the character may fish at it
+func (s *Service) spot(ctx context.Context, character db.Character, nodeID int32) (db.ResourceNode, error) {
+	node, err := s.db.GetResourceNode(ctx, nodeID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return db.ResourceNode{}, status.Errorf(codes.NotFound, "resource node not found")
+	}
+	if err != nil {
+		s.logger.Error("Failed to get resource node", "resource_node_id", nodeID, "error", err)
+		return db.ResourceNode{}, status.Errorf(codes.Internal, "failed to get resource node")
+	}
+	if node.ResourceNodeTypeID != s.config.SpotTypeID {
+		return db.ResourceNode{}, status.Errorf(codes.FailedPrecondition, "resource node is not a fishing spot")
+	}
+	if node.WorldID != character.WorldID ||
+		!geometry.InRange(geometry.Point{X: character.X, Y: character.Y}, geometry.Point{X: node.X, Y: node.Y}, s.config.Range) {
+		return db.ResourceNode{}, status.Errorf(codes.FailedPrecondition, "character is too far from the fishing spot")
+	}
+	if s.regions != nil {
+		if err := s.regions.CheckAllowed(ctx, node.WorldID, node.X, node.Y, chunkV1.RegionFlag_REGION_FLAG_NO_HARVEST); err != nil {
+			return db.ResourceNode{}, err
+		}
+	}
+	if s.claims != nil {
+		if err := s.claims.CheckAllowed(ctx, node.WorldID, node.ChunkX, node.ChunkY, character.ID); err != nil {
+			return db.ResourceNode{}, err
+		}
+	}
+	return node, nil
+}
+
+// Cast fishes at a spot, sending the cast's events until it ends or ctx is cancelled
+func (s *Service) Cast(ctx context.Context, userID, characterID string, nodeID int32, send func(*fishingV1.FishingEvent) error) error {
+	character, err := authz.OwnedCharacter(ctx, s.db, s.logger, userID, characterID)
+	if err != nil {
+		return err
+	}
+	node, err := s.spot(ctx, character, nodeID)
+	if err != nil {
+		return err
+	}
+	worldSeed, err := s.seeds.Seed(ctx, character.WorldID)
+	if err != nil {
+		s.logger.Error("Failed to get world seed", "world_id", uuid.PgtypeToString(character.WorldID), "error", err)
+		return status.Errorf(codes.Internal, "failed to cast")
+	}
+
+	c := &cast{userID: userID, done: make(chan struct{})}
+	s.mu.Lock()
+	if _, ok := s.casts[character.ID.Bytes]; ok {
+		s.mu.Unlock()
+		return status.Errorf(codes.FailedPrecondition, "character is already fishing")
+	}
+	s.casts[character.ID.Bytes] = c
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.casts, character.ID.Bytes)
+		s.mu.Unlock()
+	}()
+
+	now := s.clock.Now()
+	sky, timeOfDay := s.Conditions(worldSeed, now)
+	// Each cast draws from its own stream keyed by the spot and cast time
+	stream := rng.New(worldSeed, rng.Fishing, int64(node.ID), now.UnixNano())
+	err = send(&fishingV1.FishingEvent{
+		Type:       fishingV1.FishingEventType_FISHING_EVENT_TYPE_CAST,
+		Conditions: conditionsToProto(sky, timeOfDay),
+	})
+	if err != nil {
+		return err
+	}
+
+	delay := s.config.MinBiteDelay + time.Duration(stream.Float64()*float64(s.config.MaxBiteDelay-s.config.MinBiteDelay))
+	select {
+	case <-ctx.Done():
+		return nil
+	case <-c.done:
+	case <-s.clock.After(delay):
+		c.mu.Lock()
+		if c.outcome == pending {
+			c.biteID = uuid.GenerateNew()
+			c.bitAt = s.clock.Now()
+			c.grace = latency.FromContext(ctx).Grace()
+		}
+		c.mu.Unlock()
+	}
+	if c.settle(pending) == tooEarly { // settle(pending) only reads the outcome
+		return send(escaped(ReasonTooEarly))
+	}
+
+	err = send(&fishingV1.FishingEvent{
+		Type:         fishingV1.FishingEventType_FISHING_EVENT_TYPE_BITE,
+		BiteId:       c.biteID,
+		HookWindowMs: s.config.HookWindow.Milliseconds(),
+	})
+	if err != nil {
+		return err
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil
+	case <-c.done:
+	case <-s.clock.After(s.config.HookWindow + c.grace):
+	}
+	if c.settle(missed) != hooked {
+		return send(escaped(ReasonMissed))
+	}
+
+	items, err := s.land(ctx, character, node, stream, sky, timeOfDay)
+	if err != nil {
+		return err
+	}
+	return send(&fishingV1.FishingEvent{
+		Type:  fishingV1.FishingEventType_FISHING_EVENT_TYPE_CAUGHT,
+		Items: items,
+	})
+}
+
+// Hook sets the hook on the character's current bite. It reports whether the fish was
+// hooked; a hook before the bite or after the window loses the fish. The window runs from
+// when the bite reached the player to when they hooked (clientTime, on the client clock;
+// zero if unknown), as estimated from the calling session's calibration.
+func (s *Service) Hook(ctx context.Context, userID, characterID, biteID string, clientTime time.Time) (bool, error) {
+	now := s.clock.Now()
+	id, err := uuid.StringToPgtype(characterID)
+	if err != nil {
+		return false, status.Errorf(codes.InvalidArgument, "invalid character ID format")
+	}
+	s.mu.Lock()
+	c, ok := s.casts[id.Bytes]
+	s.mu.Unlock()
+	if !ok {
+		return false, status.Errorf(codes.NotFound, "character is not fishing")
+	}
+	if !uuid.Compare(c.userID, userID) {
+		return false, status.Errorf(codes.PermissionDenied, "character does not belong to user")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.outcome != pending {
+		return c.outcome == hooked, nil
+	}
+	switch {
+	case c.biteID == "":
+		c.outcome = tooEarly
+	case biteID != c.biteID:
+		return false, status.Errorf(codes.InvalidArgument, "unknown bite")
+	case now.Sub(c.bitAt) > s.config.HookWindow+c.grace:
+		c.outcome = missed
+	case reactionTime(latency.FromContext(ctx), c.bitAt, now, clientTime) > s.config.HookWindow:
+		c.outcome = missed
+	default:
+		c.outcome = hooked
+	}
+	close(c.done)
+	return c.outcome == hooked, nil
+}
+
+// reactionTime estimates how long the player took to hook a bite sent at bitAt with a
+// request that arrived at arrivedAt
+func reactionTime(cal latency.Calibration, bitAt, arrivedAt, clientTime time.Time) time.Duration {
+	return cal.ActionTime(arrivedAt, clientTime).Sub(cal.SeenAt(bitAt))
+}
+
+// land rolls the catch and delivers it like a harvest of the spot
+func (s *Service) land(ctx context.Context, character db.Character, node db.ResourceNode, stream *rng.Stream, sky weather.Condition, timeOfDay weather.TimeOfDay) ([]*fishingV1.CaughtItem, error) {
+	catch, ok := s.roll(stream, sky, timeOfDay)
+	if !ok {
+		return nil, nil
+	}
+	item, err := s.db.GetItemByName(ctx, catch.Item)
+	if err != nil {
+		s.logger.Error("Failed to get catch item", "item", catch.Item, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to land catch")
+	}
+	quantity := catch.Min + stream.Int31n(catch.Max-catch.Min+1)
+
+	characterID := uuid.PgtypeToString(character.ID)
+	harvest := events.ResourceHarvestedPayload{
+		HarvestID:          uuid.GenerateNew(),
+		ResourceNodeID:     node.ID,
+		ResourceNodeTypeID: node.ResourceNodeTypeID,
+		CharacterID:        characterID,
+		WorldID:            uuid.PgtypeToString(node.WorldID),
+		ChunkX:             node.ChunkX,
+		ChunkY:             node.ChunkY,
+		Drops:              1,
+		Items:              map[string]int32{item.Name: quantity},
+	}
+	delivery, err := s.inventory.Deliver(ctx, inventory.Delivery{
+		CharacterID: character.ID,
+		WorldID:     node.WorldID,
+		X:           character.X,
+		Y:           character.Y,
+		Grants:      []inventory.Grant{{ItemID: item.ID, StackSize: item.StackSize, Quantity: quantity}},
+		Reason:      "fishing",
+		Event: &inventory.Event{
+			Type:        events.ResourceHarvested,
+			AggregateID: characterID,
+			DedupKey:    events.ResourceHarvested + ":" + harvest.HarvestID,
+			Payload:     harvest,
+		},
+	})
+	if err != nil {
+		s.logger.Error("Failed to deliver catch", "character_id", characterID, "item", catch.Item, "error", err)
+		return nil, err
+	}
+
+	caught := &fishingV1.CaughtItem{ItemId: item.ID, ItemName: item.Name, Quantity: quantity}
+	if len(delivery.Grants) > 0 {
+		caught.OverflowQuantity = delivery.Grants[0].Overflow
+	}
+	s.logger.Debug("Fish caught", "character_id", characterID, "item", item.Name, "quantity", quantity,
+		"weather", sky.String(), "time_of_day", timeOfDay.String())
+	return []*fishingV1.CaughtItem{caught}, nil
+}
+
+// roll picks an entry of the catch table by weight among those the conditions allow
+func (s *Service) roll(stream *rng.Stream, sky weather.Condition, timeOfDay weather.TimeOfDay) (Catch, bool) {
+	total := 0
+	for _, c := range s.config.Catches {
+		if c.matches(sky, timeOfDay) {
+			total += c.Weight
+		}
+	}
+	if total <= 0 {
+		return Catch{}, false
+	}
+	roll := stream.Intn(total)
+	for _, c := range s.config.Catches {
+		if !c.matches(sky, timeOfDay) {
+			continue
+		}
+		if roll < c.Weight {
+			return c, true
+		}
+		roll -= c.Weight
+	}
+	return Catch{}, false
+}
+
+func escaped(reason string) *fishingV1.FishingEvent {
+	return &fishingV1.FishingEvent{
+		Type:   fishingV1.FishingEventType_FISHING_EVENT_TYPE_ESCAPED,
+		Reason: reason,
+	}
+}
+
+var weatherToProto = map[weather.Condition]fishingV1.Weather{
+	weather.Clear:  fishingV1.Weather_WEATHER_CLEAR,
+	weather.Cloudy: fishingV1.Weather_WEATHER_CLOUDY,
+	weather.Rain:   fishingV1.Weather_WEATHER_RAIN,
+	weather.Storm:  fishingV1.Weather_WEATHER_STORM,
+}
+
+var timeOfDayToProto = map[weather.TimeOfDay]fishingV1.TimeOfDay{
+	weather.Dawn:  fishingV1.TimeOfDay_TIME_OF_DAY_DAWN,
+	weather.Day:   fishingV1.TimeOfDay_TIME_OF_DAY_DAY,
+	weather.Dusk:  fishingV1.TimeOfDay_TIME_OF_DAY_DUSK,
+	weather.Night: fishingV1.TimeOfDay_TIME_OF_DAY_NIGHT,
+}
+
+func conditionsToProto(sky weather.Condition, timeOfDay weather.TimeOfDay) *fishingV1.FishingConditions {
+	return &fishingV1.FishingConditions{Weather: weatherToProto[sky], TimeOfDay: timeOfDayToProto[timeOfDay]}
+}
@@ -0,0 +1,290 @@
+package fishing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/latency"
+	"github.com/VoidMesh/api/api/internal/rng"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	"github.com/VoidMesh/api/api/internal/weather"
+	fishingV1 "github.com/VoidMesh/api/api/proto/fishing/v1"
+	"github.com/VoidMesh/api/api/services/inventory"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeDB struct {
+	nodes map[int32]db.ResourceNode
+}
+
+func (f *fakeDB) GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error) {
+	if id != character.ID {
+		return db.Character{}, pgx.ErrNoRows
+	}
+	return character, nil
+}
+
+func (f *fakeDB) GetResourceNode(ctx context.Context, id int32) (db.ResourceNode, error) {
+	node, ok := f.nodes[id]
+	if !ok {
+		return db.ResourceNode{}, pgx.ErrNoRows
+	}
+	return node, nil
+}
+
+func (f *fakeDB) GetItemByName(ctx context.Context, name string) (db.Item, error) {
+	return db.Item{ID: int32(len(name)), Name: name, StackSize: 64}, nil
+}
+
+// fakeInventory records deliveries
+type fakeInventory struct {
+	deliveries []inventory.Delivery
+}
+
+func (f *fakeInventory) Deliver(ctx context.Context, delivery inventory.Delivery) (*inventory.DeliveryResult, error) {
+	f.deliveries = append(f.deliveries, delivery)
+	return &inventory.DeliveryResult{Grants: []inventory.DeliveredGrant{{Granted: delivery.Grants[0].Quantity}}}, nil
+}
+
+const (
+	userID      = "550e8400-e29b-41d4-a716-446655440000"
+	characterID = "01000000-0000-0000-0000-000000000000"
+)
+
+var (
+	world     = pgtype.UUID{Bytes: [16]byte{9}, Valid: true}
+	character = db.Character{
+		ID:      pgtype.UUID{Bytes: [16]byte{1}, Valid: true},
+		UserID:  pgtype.UUID{Bytes: [16]byte{0x55, 0x0e, 0x84, 0x00, 0xe2, 0x9b, 0x41, 0xd4, 0xa7, 0x16, 0x44, 0x66, 0x55, 0x44, 0x00, 0x00}, Valid: true},
+		WorldID: world,
+		X:       10,
+		Y:       10,
+	}
+)
+
+func newTestService() (*Service, *fakeInventory, *clock.Fake) {
+	database := &fakeDB{nodes: map[int32]db.ResourceNode{
+		1: {ID: 1, ResourceNodeTypeID: 4, WorldID: world, X: 12, Y: 10},
+		2: {ID: 2, ResourceNodeTypeID: 1, WorldID: world, X: 10, Y: 11},
+		3: {ID: 3, ResourceNodeTypeID: 4, WorldID: world, X: 30, Y: 10},
+	}}
+	inv := &fakeInventory{}
+	fake := clock.NewFake(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	service := NewService(database, inv, worldSeeds{world.Bytes: 7}, testmocks.NopLogger[LoggerInterface]{})
+	service.SetClock(fake)
+	return service, inv, fake
+}
+
+// startCast runs a cast in the background, returning its events and its result
+func startCast(t *testing.T, service *Service, nodeID int32) (<-chan *fishingV1.FishingEvent, <-chan error) {
+	t.Helper()
+	return startCastContext(t, playerContext(), service, nodeID)
+}
+
+func startCastContext(t *testing.T, ctx context.Context, service *Service, nodeID int32) (<-chan *fishingV1.FishingEvent, <-chan error) {
+	t.Helper()
+	events := make(chan *fishingV1.FishingEvent, 4)
+	result := make(chan error, 1)
+	go func() {
+		result <- service.Cast(ctx, userID, characterID, nodeID, func(e *fishingV1.FishingEvent) error {
+			events <- e
+			return nil
+		})
+	}()
+	require.Equal(t, fishingV1.FishingEventType_FISHING_EVENT_TYPE_CAST, (<-events).Type)
+	return events, result
+}
+
+// waitForTimer waits until the cast is waiting on the clock
+func waitForTimer(t *testing.T, fake *clock.Fake) {
+	t.Helper()
+	require.Eventually(t, func() bool { return fake.Waiters() > 0 }, time.Second, time.Millisecond)
+}
+
+func TestCast_Caught(t *testing.T) {
+	service, inv, fake := newTestService()
+	events, result := startCast(t, service, 1)
+
+	waitForTimer(t, fake)
+	fake.Advance(service.config.MaxBiteDelay)
+	bite := <-events
+	require.Equal(t, fishingV1.FishingEventType_FISHING_EVENT_TYPE_BITE, bite.Type)
+	assert.Equal(t, int64(1000), bite.HookWindowMs)
+
+	_, err := service.Hook(playerContext(), userID, characterID, "another bite", time.Time{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = service.Hook(playerContext(), "650e8400-e29b-41d4-a716-446655440000", characterID, bite.BiteId, time.Time{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	fake.Advance(500 * time.Millisecond)
+	hooked, err := service.Hook(playerContext(), userID, characterID, bite.BiteId, time.Time{})
+	require.NoError(t, err)
+	assert.True(t, hooked)
+
+	caught := <-events
+	require.NoError(t, <-result)
+	require.Equal(t, fishingV1.FishingEventType_FISHING_EVENT_TYPE_CAUGHT, caught.Type)
+	require.Len(t, caught.Items, 1)
+	require.Len(t, inv.deliveries, 1)
+	assert.Equal(t, "fishing", inv.deliveries[0].Reason)
+	assert.Equal(t, caught.Items[0].Quantity, inv.deliveries[0].Grants[0].Quantity)
+
+	_, err = service.Hook(playerContext(), userID, characterID, bite.BiteId, time.Time{})
+	assert.Equal(t, codes.NotFound, status.Code(err), "the cast has ended")
+}
+
+func TestCast_Escaped(t *testing.T) {
+	service, inv, fake := newTestService()
+
+	// Hooking before the bite scares the fish off
+	events, result := startCast(t, service, 1)
+	hooked, err := service.Hook(playerContext(), userID, characterID, "", time.Time{})
+	require.NoError(t, err)
+	assert.False(t, hooked)
+	escaped := <-events
+	require.NoError(t, <-result)
+	assert.Equal(t, ReasonTooEarly, escaped.Reason)
+
+	// The window is measured on the server
+	events, result = startCast(t, service, 1)
+	waitForTimer(t, fake)
+	fake.Advance(service.config.MaxBiteDelay)
+	bite := <-events
+	waitForTimer(t, fake)
+	fake.Advance(service.config.HookWindow + time.Millisecond)
+	escaped = <-events
+	require.NoError(t, <-result)
+	assert.Equal(t, fishingV1.FishingEventType_FISHING_EVENT_TYPE_ESCAPED, escaped.Type)
+	assert.Equal(t, ReasonMissed, escaped.Reason)
+	_, err = service.Hook(playerContext(), userID, characterID, bite.BiteId, time.Time{})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	assert.Empty(t, inv.deliveries)
+}
+
+// calibrated returns a context for a session with a 200ms round trip whose clock is an
+// hour ahead of the server's
+func calibrated(t *testing.T) context.Context {
+	t.Helper()
+	fake := clock.NewFake(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	tracker := latency.NewTracker(0)
+	tracker.SetClock(fake)
+	probe := tracker.Probe("session")
+	fake.Advance(100 * time.Millisecond)
+	clientTime := fake.Now().Add(time.Hour)
+	fake.Advance(100 * time.Millisecond)
+	cal, err := tracker.Observe("session", probe, clientTime)
+	require.NoError(t, err)
+	return latency.WithCalibration(playerContext(), cal)
+}
+
+func TestCast_LatencyCompensation(t *testing.T) {
+	service, _, fake := newTestService()
+	ctx := calibrated(t)
+	cal := latency.FromContext(ctx)
+	require.Equal(t, 350*time.Millisecond, cal.Grace())
+
+	// The bite takes 100ms to reach the player, and the hook 100ms to come back
+	events, result := startCastContext(t, ctx, service, 1)
+	waitForTimer(t, fake)
+	fake.Advance(service.config.MaxBiteDelay)
+	bite := <-events
+	waitForTimer(t, fake)
+	fake.Advance(service.config.HookWindow + 50*time.Millisecond)
+	hooked, err := service.Hook(ctx, userID, characterID, bite.BiteId, time.Time{})
+	require.NoError(t, err)
+	assert.True(t, hooked, "hooks late only by the connection's latency are in time")
+	<-events
+	require.NoError(t, <-result)
+
+	// The client's timestamp is honoured when it says the player was slow
+	events, result = startCastContext(t, ctx, service, 1)
+	waitForTimer(t, fake)
+	fake.Advance(service.config.MaxBiteDelay)
+	bite = <-events
+	waitForTimer(t, fake)
+	fake.Advance(service.config.HookWindow + 250*time.Millisecond) // In time if the hook took 100ms to arrive
+	actedAt := fake.Now().Add(time.Hour).Add(-10 * time.Millisecond)
+	hooked, err = service.Hook(ctx, userID, characterID, bite.BiteId, actedAt)
+	require.NoError(t, err)
+	assert.False(t, hooked)
+	<-events
+	require.NoError(t, <-result)
+
+	// No timestamp buys more than the grace period
+	events, result = startCastContext(t, ctx, service, 1)
+	waitForTimer(t, fake)
+	fake.Advance(service.config.MaxBiteDelay)
+	<-events
+	waitForTimer(t, fake)
+	fake.Advance(service.config.HookWindow + cal.Grace() + time.Millisecond)
+	escaped := <-events
+	require.NoError(t, <-result)
+	assert.Equal(t, ReasonMissed, escaped.Reason)
+}
+
+func TestCast_Invalid(t *testing.T) {
+	service, _, _ := newTestService()
+	ctx := playerContext()
+	send := func(*fishingV1.FishingEvent) error { return nil }
+
+	err := service.Cast(ctx, userID, characterID, 2, send)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "not a fishing spot")
+	err = service.Cast(ctx, userID, characterID, 3, send)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "out of range")
+	err = service.Cast(ctx, userID, characterID, 9, send)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	startCast(t, service, 1)
+	err = service.Cast(ctx, userID, characterID, 1, send)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "one cast at a time")
+}
+
+func TestRoll_Conditions(t *testing.T) {
+	service, _, _ := newTestService()
+	service.SetConfig(Config{Catches: []Catch{
+		{Item: "Night Eel", Weight: 1, Min: 1, Max: 1, TimesOfDay: []weather.TimeOfDay{weather.Night}},
+		{Item: "Storm Pike", Weight: 1, Min: 1, Max: 1, Weather: []weather.Condition{weather.Storm}},
+	}})
+
+	caught := make(map[string]bool)
+	for i := range 50 {
+		catch, ok := service.roll(rng.New(1, rng.Fishing, int64(i)), weather.Storm, weather.Night)
+		require.True(t, ok)
+		caught[catch.Item] = true
+	}
+	assert.Len(t, caught, 2)
+
+	catch, ok := service.roll(rng.New(1, rng.Fishing), weather.Clear, weather.Night)
+	require.True(t, ok)
+	assert.Equal(t, "Night Eel", catch.Item)
+
+	_, ok = service.roll(rng.New(1, rng.Fishing), weather.Clear, weather.Day)
+	assert.False(t, ok, "nothing bites when no entry matches")
+}
+
+// worldSeeds gives each listed world a fixed seed
+type worldSeeds map[[16]byte]int64
+
+func (w worldSeeds) Seed(ctx context.Context, worldID pgtype.UUID) (int64, error) {
+	seed, ok := w[worldID.Bytes]
+	if !ok {
+		return 0, pgx.ErrNoRows
+	}
+	return seed, nil
+}
+
+// playerContext is the context of a session bound to the test world
+func playerContext() context.Context {
+	return session.WithWorldID(context.Background(), uuid.PgtypeToString(world))
+}
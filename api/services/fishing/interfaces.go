@@ -0,0 +1,100 @@
+package fishing
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/storage"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	"github.com/VoidMesh/api/api/services/inventory"
+	"github.com/charmbracelet/log"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DatabaseInterface abstracts database operations for fishing.
+type DatabaseInterface interface {
+	GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error)
+	GetResourceNode(ctx context.Context, id int32) (db.ResourceNode, error)
+	GetItemByName(ctx context.Context, name string) (db.Item, error)
+}
+
+// InventoryServiceInterface delivers catches to characters.
+type InventoryServiceInterface interface {
+	Deliver(ctx context.Context, delivery inventory.Delivery) (*inventory.DeliveryResult, error)
+}
+
+// RegionServiceInterface defines the protected region checks needed.
+type RegionServiceInterface interface {
+	CheckAllowed(ctx context.Context, worldID pgtype.UUID, x, y int32, flag chunkV1.RegionFlag) error
+}
+
+// ClaimServiceInterface defines the land claim checks needed.
+type ClaimServiceInterface interface {
+	CheckAllowed(ctx context.Context, worldID pgtype.UUID, chunkX, chunkY int32, characterID pgtype.UUID) error
+}
+
+// WorldSeedsInterface looks up the seed of a world, which weather and casts derive from.
+type WorldSeedsInterface interface {
+	Seed(ctx context.Context, worldID pgtype.UUID) (int64, error)
+}
+
+// DatabaseWrapper implements DatabaseInterface using the actual database connection.
+type DatabaseWrapper struct {
+	queries *db.Queries
+}
+
+// NewDatabaseWrapper creates a new database wrapper with the given connection pool.
+func NewDatabaseWrapper(pool storage.Pool) DatabaseInterface {
+	return &DatabaseWrapper{queries: db.New(pool)}
+}
+
+func (d *DatabaseWrapper) GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error) {
+	return d.queries.GetCharacterById(ctx, id)
+}
+
+func (d *DatabaseWrapper) GetResourceNode(ctx context.Context, id int32) (db.ResourceNode, error) {
+	return d.queries.GetResourceNode(ctx, id)
+}
+
+func (d *DatabaseWrapper) GetItemByName(ctx context.Context, name string) (db.Item, error) {
+	return d.queries.GetItemByName(ctx, name)
+}
+
+// LoggerInterface abstracts logging operations for dependency injection.
+type LoggerInterface interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+	With(keysAndValues ...interface{}) LoggerInterface
+}
+
+// DefaultLoggerWrapper wraps the internal logging package.
+type DefaultLoggerWrapper struct {
+	logger *log.Logger
+}
+
+func NewDefaultLoggerWrapper() LoggerInterface {
+	return &DefaultLoggerWrapper{logger: logging.GetLogger()}
+}
+
+func (l *DefaultLoggerWrapper) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.Debug(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Info(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.Warn(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.Error(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) With(keysAndValues ...interface{}) LoggerInterface {
+	return &DefaultLoggerWrapper{logger: l.logger.With(keysAndValues...)}
+}
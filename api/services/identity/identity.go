@@ -0,0 +1,319 @@
+// Package identity lets players log in with external accounts (Discord, Google) and link
+// them to a VoidMesh account. Logging in with an identity not linked to any account
+// creates one, named after the provider account and without a password.
+package identity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/oauth"
+	"github.com/VoidMesh/api/api/internal/signup"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	userV1 "github.com/VoidMesh/api/api/proto/user/v1"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// MaxUsernameLength bounds usernames made from provider account names
+	MaxUsernameLength = 20
+	// usernameAttempts is how many suffixed usernames are tried when the plain one is taken
+	usernameAttempts = 5
+)
+
+// SignupPolicy decides whether an account may be created for a federated login
+type SignupPolicy interface {
+	CheckFederatedSignup(remoteIP, email string) error
+}
+
+// Service logs players in with external identities and manages their links.
+type Service struct {
+	db        DatabaseInterface
+	logger    LoggerInterface
+	clock     clock.Clock
+	providers map[string]oauth.Provider
+	signup    SignupPolicy // Nil lets every federated login create an account
+}
+
+// NewService creates a new identity service with dependency injection.
+func NewService(db DatabaseInterface, logger LoggerInterface) *Service {
+	componentLogger := logger.With("component", "identity-service")
+	componentLogger.Debug("Creating new identity service")
+	return &Service{
+		db:        db,
+		logger:    componentLogger,
+		clock:     clock.New(),
+		providers: make(map[string]oauth.Provider),
+	}
+}
+
+// NewServiceWithPool creates a service with concrete implementations (convenience constructor for production use).
+func NewServiceWithPool(pool storage.Pool) *Service {
+	return NewService(NewDatabaseWrapper(pool), NewDefaultLoggerWrapper())
+}
+
+// SetClock replaces the clock used for timestamps (for simulation tests)
+func (s *Service) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// SetSignupPolicy applies signup protection to accounts created by federated logins
+func (s *Service) SetSignupPolicy(policy SignupPolicy) {
+	s.signup = policy
+}
+
+// AddProvider accepts logins and links with the provider
+func (s *Service) AddProvider(provider oauth.Provider) {
+	s.providers[provider.Name()] = provider
+}
+
+// Providers returns the names of the accepted providers, sorted
+func (s *Service) Providers() []string {
+	names := make([]string, 0, len(s.providers))
+	for name := range s.providers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Login returns the user the provider account behind accessToken is linked to, creating
+// one if it is not linked yet, and whether it was created. remoteIP counts against the
+// signup rate limit when an account is created.
+func (s *Service) Login(ctx context.Context, provider, accessToken, remoteIP string) (db.User, bool, error) {
+	identity, err := s.identify(ctx, provider, accessToken)
+	if err != nil {
+		return db.User{}, false, err
+	}
+	logger := s.logger.With("provider", provider, "subject", identity.Subject)
+
+	linked, err := s.db.GetUserIdentity(ctx, db.GetUserIdentityParams{Provider: provider, Subject: identity.Subject})
+	if err == nil {
+		user, err := s.db.GetUserById(ctx, linked.UserID)
+		if err != nil {
+			logger.Error("Failed to get linked user", "error", err)
+			return db.User{}, false, status.Errorf(codes.Internal, "failed to get user")
+		}
+		if err := s.db.TouchUserIdentity(ctx, db.TouchUserIdentityParams{
+			ID:          linked.ID,
+			LastLoginAt: s.now(),
+			Email:       identity.Email,
+			Username:    identity.Username,
+		}); err != nil {
+			logger.Warn("Failed to record identity login", "error", err)
+		}
+		return user, false, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		logger.Error("Failed to get identity", "error", err)
+		return db.User{}, false, status.Errorf(codes.Internal, "failed to look up identity")
+	}
+
+	user, err := s.createAccount(ctx, identity, remoteIP)
+	if err != nil {
+		return db.User{}, false, err
+	}
+	logger.Info("Account created for identity", "user_id", uuid.PgtypeToString(user.ID), "username", user.Username)
+	return user, true, nil
+}
+
+// createAccount creates a user for an identity. Accounts need a verified email so password
+// resets and notices reach the owner; one already in use must be linked by its owner
+// instead, or anyone controlling a provider account with that email could take it over.
+func (s *Service) createAccount(ctx context.Context, identity oauth.Identity, remoteIP string) (db.User, error) {
+	if !identity.EmailVerified {
+		return db.User{}, status.Errorf(codes.FailedPrecondition, "the %s account has no verified email to create an account with", identity.Provider)
+	}
+	if s.signup != nil {
+		if err := s.signup.CheckFederatedSignup(remoteIP, identity.Email); err != nil {
+			return db.User{}, signupError(err)
+		}
+	}
+
+	base := usernameFrom(identity.Username)
+	username := base
+	for attempt := 0; ; attempt++ {
+		user, _, err := s.db.CreateAccount(ctx, NewAccount{
+			User: db.CreateUserParams{
+				Username:     username,
+				DisplayName:  identity.Username,
+				Email:        identity.Email,
+				PasswordHash: "", // Password logins fail until the user sets one through a reset
+			},
+			EmailVerified: identity.EmailVerified,
+			Identity: db.CreateUserIdentityParams{
+				Provider: identity.Provider,
+				Subject:  identity.Subject,
+				Email:    identity.Email,
+				Username: identity.Username,
+				LinkedAt: s.now(),
+			},
+		})
+		switch {
+		case err == nil:
+			return user, nil
+		case errors.Is(err, ErrUsernameTaken) && attempt < usernameAttempts:
+			username = fmt.Sprintf("%s%04d", base[:min(len(base), MaxUsernameLength-4)], rand.IntN(10000))
+		case errors.Is(err, ErrEmailTaken):
+			return db.User{}, status.Errorf(codes.FailedPrecondition, "an account with this email already exists; log in to it and link your %s account", identity.Provider)
+		case errors.Is(err, ErrIdentityTaken):
+			return db.User{}, status.Errorf(codes.Aborted, "the identity was linked by a concurrent login, try again")
+		case errors.Is(err, ErrUsernameTaken):
+			return db.User{}, status.Errorf(codes.Unavailable, "no free username found, try again")
+		default:
+			s.logger.Error("Failed to create account", "provider", identity.Provider, "error", err)
+			return db.User{}, status.Errorf(codes.Internal, "failed to create account")
+		}
+	}
+}
+
+// Link links the provider account behind accessToken to the user
+func (s *Service) Link(ctx context.Context, userID, provider, accessToken string) (*userV1.LinkedIdentity, error) {
+	user, err := uuid.StringToPgtype(userID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID format")
+	}
+	identity, err := s.identify(ctx, provider, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := s.db.LinkIdentity(ctx, db.CreateUserIdentityParams{
+		UserID:   user,
+		Provider: provider,
+		Subject:  identity.Subject,
+		Email:    identity.Email,
+		Username: identity.Username,
+		LinkedAt: s.now(),
+	})
+	switch {
+	case errors.Is(err, ErrIdentityTaken):
+		return nil, status.Errorf(codes.AlreadyExists, "the %s account is already linked to a user", provider)
+	case errors.Is(err, ErrProviderLinked):
+		return nil, status.Errorf(codes.AlreadyExists, "a %s account is already linked; unlink it first", provider)
+	case err != nil:
+		s.logger.Error("Failed to link identity", "user_id", userID, "provider", provider, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to link identity")
+	}
+
+	s.logger.Info("Identity linked", "user_id", userID, "provider", provider, "subject", identity.Subject)
+	return dbIdentityToProto(row), nil
+}
+
+// Unlink removes the user's identity at the provider
+func (s *Service) Unlink(ctx context.Context, userID, provider string) (*userV1.LinkedIdentity, error) {
+	user, err := uuid.StringToPgtype(userID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID format")
+	}
+	row, err := s.db.UnlinkIdentity(ctx, user, provider)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, status.Errorf(codes.NotFound, "no %s account is linked", provider)
+	case errors.Is(err, ErrLastLogin):
+		return nil, status.Errorf(codes.FailedPrecondition, "set a password before unlinking your only way to log in")
+	case err != nil:
+		s.logger.Error("Failed to unlink identity", "user_id", userID, "provider", provider, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to unlink identity")
+	}
+
+	s.logger.Info("Identity unlinked", "user_id", userID, "provider", provider)
+	return dbIdentityToProto(row), nil
+}
+
+// List returns the user's linked identities, oldest first
+func (s *Service) List(ctx context.Context, userID string) ([]*userV1.LinkedIdentity, error) {
+	user, err := uuid.StringToPgtype(userID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID format")
+	}
+	rows, err := s.db.ListUserIdentities(ctx, user)
+	if err != nil {
+		s.logger.Error("Failed to list identities", "user_id", userID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list identities")
+	}
+	identities := make([]*userV1.LinkedIdentity, 0, len(rows))
+	for _, row := range rows {
+		identities = append(identities, dbIdentityToProto(row))
+	}
+	return identities, nil
+}
+
+// identify asks the provider whose account the token belongs to
+func (s *Service) identify(ctx context.Context, provider, accessToken string) (oauth.Identity, error) {
+	p, ok := s.providers[provider]
+	if !ok {
+		return oauth.Identity{}, status.Errorf(codes.InvalidArgument, "login with %q is not enabled", provider)
+	}
+	if accessToken == "" {
+		return oauth.Identity{}, status.Errorf(codes.InvalidArgument, "access token is required")
+	}
+	identity, err := p.Identify(ctx, accessToken)
+	switch {
+	case errors.Is(err, oauth.ErrInvalidToken) || errors.Is(err, oauth.ErrWrongClient):
+		return oauth.Identity{}, status.Errorf(codes.Unauthenticated, "%v", err)
+	case err != nil:
+		s.logger.Warn("Failed to identify account", "provider", provider, "error", err)
+		return oauth.Identity{}, status.Errorf(codes.Unavailable, "could not reach %s, try again later", provider)
+	}
+	return identity, nil
+}
+
+func (s *Service) now() pgtype.Timestamp {
+	return pgtype.Timestamp{Time: s.clock.Now(), Valid: true}
+}
+
+// usernameFrom makes a username from a provider account name: letters, digits and
+// underscores, at most MaxUsernameLength long
+func usernameFrom(name string) string {
+	var b strings.Builder
+	for _, c := range strings.ToLower(name) {
+		if c < unicode.MaxASCII && (unicode.IsLetter(c) || unicode.IsDigit(c) || c == '_') {
+			b.WriteRune(c)
+		}
+		if b.Len() == MaxUsernameLength {
+			break
+		}
+	}
+	if b.Len() < 3 {
+		return "player"
+	}
+	return b.String()
+}
+
+// signupError maps a signup protection failure to the status the client sees
+func signupError(err error) error {
+	switch {
+	case errors.Is(err, signup.ErrRateLimited):
+		return status.Error(codes.ResourceExhausted, signup.ErrRateLimited.Error())
+	case errors.Is(err, signup.ErrBlockedEmail):
+		return status.Error(codes.InvalidArgument, signup.ErrBlockedEmail.Error())
+	default:
+		return status.Errorf(codes.Internal, "failed to check signup")
+	}
+}
+
+func dbIdentityToProto(row db.UserIdentity) *userV1.LinkedIdentity {
+	identity := &userV1.LinkedIdentity{
+		Provider: row.Provider,
+		Username: row.Username,
+		Email:    row.Email,
+		LinkedAt: timestamppb.New(row.LinkedAt.Time),
+	}
+	if row.LastLoginAt.Valid {
+		identity.LastLoginAt = timestamppb.New(row.LastLoginAt.Time)
+	}
+	return identity
+}
@@ -0,0 +1,279 @@
+package identity
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/oauth"
+	"github.com/VoidMesh/api/api/internal/signup"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeDB keeps users and identities in memory
+type fakeDB struct {
+	users      []db.User
+	identities []db.UserIdentity
+}
+
+func (f *fakeDB) addUser(username, email, passwordHash string) db.User {
+	id, _ := uuid.StringToPgtype(uuid.GenerateNew())
+	user := db.User{ID: id, Username: username, DisplayName: username, Email: email, PasswordHash: passwordHash}
+	f.users = append(f.users, user)
+	return user
+}
+
+func (f *fakeDB) GetUserIdentity(ctx context.Context, arg db.GetUserIdentityParams) (db.UserIdentity, error) {
+	for _, identity := range f.identities {
+		if identity.Provider == arg.Provider && identity.Subject == arg.Subject {
+			return identity, nil
+		}
+	}
+	return db.UserIdentity{}, pgx.ErrNoRows
+}
+
+func (f *fakeDB) GetUserById(ctx context.Context, id pgtype.UUID) (db.User, error) {
+	for _, user := range f.users {
+		if user.ID == id {
+			return user, nil
+		}
+	}
+	return db.User{}, pgx.ErrNoRows
+}
+
+func (f *fakeDB) ListUserIdentities(ctx context.Context, userID pgtype.UUID) ([]db.UserIdentity, error) {
+	var identities []db.UserIdentity
+	for _, identity := range f.identities {
+		if identity.UserID == userID {
+			identities = append(identities, identity)
+		}
+	}
+	return identities, nil
+}
+
+func (f *fakeDB) TouchUserIdentity(ctx context.Context, arg db.TouchUserIdentityParams) error {
+	for i, identity := range f.identities {
+		if identity.ID == arg.ID {
+			f.identities[i].LastLoginAt = arg.LastLoginAt
+			f.identities[i].Email = arg.Email
+			f.identities[i].Username = arg.Username
+		}
+	}
+	return nil
+}
+
+func (f *fakeDB) CreateAccount(ctx context.Context, account NewAccount) (db.User, db.UserIdentity, error) {
+	if _, err := f.GetUserIdentity(ctx, db.GetUserIdentityParams{Provider: account.Identity.Provider, Subject: account.Identity.Subject}); err == nil {
+		return db.User{}, db.UserIdentity{}, ErrIdentityTaken
+	}
+	for _, user := range f.users {
+		if user.Email == account.User.Email {
+			return db.User{}, db.UserIdentity{}, ErrEmailTaken
+		}
+		if user.Username == account.User.Username {
+			return db.User{}, db.UserIdentity{}, ErrUsernameTaken
+		}
+	}
+	user := f.addUser(account.User.Username, account.User.Email, account.User.PasswordHash)
+	user.DisplayName = account.User.DisplayName
+	user.EmailVerified = pgtype.Bool{Bool: account.EmailVerified, Valid: true}
+	f.users[len(f.users)-1] = user
+	link := account.Identity
+	link.UserID = user.ID
+	identity, _ := f.LinkIdentity(ctx, link)
+	return user, identity, nil
+}
+
+func (f *fakeDB) LinkIdentity(ctx context.Context, arg db.CreateUserIdentityParams) (db.UserIdentity, error) {
+	if _, err := f.GetUserIdentity(ctx, db.GetUserIdentityParams{Provider: arg.Provider, Subject: arg.Subject}); err == nil {
+		return db.UserIdentity{}, ErrIdentityTaken
+	}
+	for _, identity := range f.identities {
+		if identity.UserID == arg.UserID && identity.Provider == arg.Provider {
+			return db.UserIdentity{}, ErrProviderLinked
+		}
+	}
+	identity := db.UserIdentity{
+		ID:       int64(len(f.identities) + 1),
+		UserID:   arg.UserID,
+		Provider: arg.Provider,
+		Subject:  arg.Subject,
+		Email:    arg.Email,
+		Username: arg.Username,
+		LinkedAt: arg.LinkedAt,
+	}
+	f.identities = append(f.identities, identity)
+	return identity, nil
+}
+
+func (f *fakeDB) UnlinkIdentity(ctx context.Context, userID pgtype.UUID, provider string) (db.UserIdentity, error) {
+	user, err := f.GetUserById(ctx, userID)
+	if err != nil {
+		return db.UserIdentity{}, err
+	}
+	linked, _ := f.ListUserIdentities(ctx, userID)
+	for i, identity := range f.identities {
+		if identity.UserID == userID && identity.Provider == provider {
+			if user.PasswordHash == "" && len(linked) <= 1 {
+				return db.UserIdentity{}, ErrLastLogin
+			}
+			f.identities = append(f.identities[:i], f.identities[i+1:]...)
+			return identity, nil
+		}
+	}
+	return db.UserIdentity{}, pgx.ErrNoRows
+}
+
+// stubProvider knows accounts by access token
+type stubProvider struct {
+	name     string
+	accounts map[string]oauth.Identity
+	down     bool
+}
+
+func (p *stubProvider) Name() string {
+	return p.name
+}
+
+func (p *stubProvider) Identify(ctx context.Context, accessToken string) (oauth.Identity, error) {
+	if p.down {
+		return oauth.Identity{}, errors.New("connection refused")
+	}
+	identity, ok := p.accounts[accessToken]
+	if !ok {
+		return oauth.Identity{}, oauth.ErrInvalidToken
+	}
+	identity.Provider = p.name
+	return identity, nil
+}
+
+func newTestService() (*Service, *fakeDB, *stubProvider) {
+	database := &fakeDB{}
+	service := NewService(database, testmocks.NopLogger[LoggerInterface]{})
+	service.SetClock(clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)))
+	discord := &stubProvider{name: oauth.ProviderDiscord, accounts: map[string]oauth.Identity{
+		"ada-token":   {Subject: "42", Username: "Ada.Lovelace!", Email: "ada@example.com", EmailVerified: true},
+		"grace-token": {Subject: "43", Username: "grace", Email: "grace@example.com", EmailVerified: true},
+		"no-email":    {Subject: "44", Username: "anon"},
+	}}
+	service.AddProvider(discord)
+	return service, database, discord
+}
+
+func TestLogin_CreatesAndReusesAccount(t *testing.T) {
+	service, database, _ := newTestService()
+	ctx := context.Background()
+
+	user, created, err := service.Login(ctx, oauth.ProviderDiscord, "ada-token", "203.0.113.7")
+	require.NoError(t, err)
+	assert.True(t, created)
+	assert.Equal(t, "adalovelace", user.Username)
+	assert.Equal(t, "Ada.Lovelace!", user.DisplayName)
+	assert.Equal(t, "ada@example.com", user.Email)
+	assert.True(t, user.EmailVerified.Bool, "the provider verified the email")
+	assert.Empty(t, user.PasswordHash, "federated accounts have no password")
+
+	again, created, err := service.Login(ctx, oauth.ProviderDiscord, "ada-token", "203.0.113.7")
+	require.NoError(t, err)
+	assert.False(t, created)
+	assert.Equal(t, user.ID, again.ID)
+	assert.True(t, database.identities[0].LastLoginAt.Valid)
+	assert.Len(t, database.users, 1)
+}
+
+func TestLogin_Rejections(t *testing.T) {
+	service, database, discord := newTestService()
+	ctx := context.Background()
+	login := func(provider, token string) error {
+		_, _, err := service.Login(ctx, provider, token, "203.0.113.7")
+		return err
+	}
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(login(oauth.ProviderGoogle, "ada-token")), "google is not enabled")
+	assert.Equal(t, codes.InvalidArgument, status.Code(login(oauth.ProviderDiscord, "")))
+	assert.Equal(t, codes.Unauthenticated, status.Code(login(oauth.ProviderDiscord, "expired")))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(login(oauth.ProviderDiscord, "no-email")))
+
+	database.addUser("grace", "grace@example.com", "hash")
+	err := login(oauth.ProviderDiscord, "grace-token")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "existing accounts must link the identity themselves")
+	assert.Contains(t, status.Convert(err).Message(), "link")
+
+	discord.down = true
+	assert.Equal(t, codes.Unavailable, status.Code(login(oauth.ProviderDiscord, "ada-token")))
+	assert.Empty(t, database.identities)
+}
+
+func TestLogin_SignupPolicyAndUsernames(t *testing.T) {
+	service, database, _ := newTestService()
+	ctx := context.Background()
+	service.SetSignupPolicy(signup.NewGuard(signup.Config{MaxPerIP: 1, RateWindow: time.Hour}))
+	database.addUser("adalovelace", "other@example.com", "hash")
+
+	user, _, err := service.Login(ctx, oauth.ProviderDiscord, "ada-token", "203.0.113.7")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(user.Username, "adalovelace"))
+	assert.Len(t, user.Username, len("adalovelace")+4, "taken usernames get a numeric suffix")
+
+	_, _, err = service.Login(ctx, oauth.ProviderDiscord, "grace-token", "203.0.113.7")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	_, _, err = service.Login(ctx, oauth.ProviderDiscord, "ada-token", "203.0.113.7")
+	assert.NoError(t, err, "logging in to an existing account is not a signup")
+}
+
+func TestLinkAndUnlink(t *testing.T) {
+	service, database, _ := newTestService()
+	ctx := context.Background()
+	owner := database.addUser("ada", "ada@example.com", "hash")
+	ownerID := uuid.PgtypeToString(owner.ID)
+
+	linked, err := service.Link(ctx, ownerID, oauth.ProviderDiscord, "ada-token")
+	require.NoError(t, err)
+	assert.Equal(t, "Ada.Lovelace!", linked.Username)
+	assert.Nil(t, linked.LastLoginAt)
+
+	_, err = service.Link(ctx, ownerID, oauth.ProviderDiscord, "grace-token")
+	assert.Equal(t, codes.AlreadyExists, status.Code(err), "one identity per provider")
+	other := database.addUser("grace", "grace@example.com", "")
+	_, err = service.Link(ctx, uuid.PgtypeToString(other.ID), oauth.ProviderDiscord, "ada-token")
+	assert.Equal(t, codes.AlreadyExists, status.Code(err), "an identity belongs to one user")
+
+	user, created, err := service.Login(ctx, oauth.ProviderDiscord, "ada-token", "")
+	require.NoError(t, err)
+	assert.False(t, created)
+	assert.Equal(t, owner.ID, user.ID, "the linked identity logs in to the existing account")
+
+	identities, err := service.List(ctx, ownerID)
+	require.NoError(t, err)
+	require.Len(t, identities, 1)
+	assert.NotNil(t, identities[0].LastLoginAt)
+	assert.Equal(t, []string{oauth.ProviderDiscord}, service.Providers())
+
+	_, err = service.Unlink(ctx, ownerID, oauth.ProviderDiscord)
+	require.NoError(t, err)
+	_, err = service.Unlink(ctx, ownerID, oauth.ProviderDiscord)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	otherID := uuid.PgtypeToString(other.ID)
+	_, err = service.Link(ctx, otherID, oauth.ProviderDiscord, "grace-token")
+	require.NoError(t, err)
+	_, err = service.Unlink(ctx, otherID, oauth.ProviderDiscord)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "accounts without a password keep their last identity")
+}
+
+func TestUsernameFrom(t *testing.T) {
+	assert.Equal(t, "ada_l", usernameFrom("Ada_L"))
+	assert.Equal(t, "player", usernameFrom("Zoë"), "too little left to use")
+	assert.Len(t, usernameFrom(strings.Repeat("a", 40)), MaxUsernameLength)
+}
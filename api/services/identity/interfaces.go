@@ -0,0 +1,208 @@
+package identity
+
+import (
+	"context"
+	"errors"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/txn"
+	"github.com/charmbracelet/log"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var (
+	// ErrIdentityTaken is returned when the provider account is already linked to a user
+	ErrIdentityTaken = errors.New("identity is already linked")
+	// ErrProviderLinked is returned when the user already has an identity at the provider
+	ErrProviderLinked = errors.New("user already has an identity at this provider")
+	// ErrEmailTaken is returned when creating an account for an email another account uses
+	ErrEmailTaken = errors.New("email is already in use")
+	// ErrUsernameTaken is returned when creating an account with a username in use
+	ErrUsernameTaken = errors.New("username is already in use")
+	// ErrLastLogin is returned when unlinking the only way a user without a password can log in
+	ErrLastLogin = errors.New("identity is the account's only login method")
+)
+
+// NewAccount is an account to create for an identity not linked to any user
+type NewAccount struct {
+	User          db.CreateUserParams
+	EmailVerified bool
+	Identity      db.CreateUserIdentityParams // UserID is filled in
+}
+
+// DatabaseInterface abstracts database operations for linked identities.
+type DatabaseInterface interface {
+	GetUserIdentity(ctx context.Context, arg db.GetUserIdentityParams) (db.UserIdentity, error)
+	GetUserById(ctx context.Context, id pgtype.UUID) (db.User, error)
+	ListUserIdentities(ctx context.Context, userID pgtype.UUID) ([]db.UserIdentity, error)
+	TouchUserIdentity(ctx context.Context, arg db.TouchUserIdentityParams) error
+	// CreateAccount creates a user and links the identity to it in one transaction
+	CreateAccount(ctx context.Context, account NewAccount) (db.User, db.UserIdentity, error)
+	// LinkIdentity links an identity to an existing user
+	LinkIdentity(ctx context.Context, arg db.CreateUserIdentityParams) (db.UserIdentity, error)
+	// UnlinkIdentity removes a user's identity at a provider unless the user could no
+	// longer log in without it
+	UnlinkIdentity(ctx context.Context, userID pgtype.UUID, provider string) (db.UserIdentity, error)
+}
+
+// DatabaseWrapper implements DatabaseInterface using the actual database connection.
+type DatabaseWrapper struct {
+	pool    storage.Pool
+	queries *db.Queries
+}
+
+// NewDatabaseWrapper creates a new database wrapper with the given connection pool.
+func NewDatabaseWrapper(pool storage.Pool) DatabaseInterface {
+	return &DatabaseWrapper{
+		pool:    pool,
+		queries: db.New(pool),
+	}
+}
+
+func (d *DatabaseWrapper) GetUserIdentity(ctx context.Context, arg db.GetUserIdentityParams) (db.UserIdentity, error) {
+	return d.queries.GetUserIdentity(ctx, arg)
+}
+
+func (d *DatabaseWrapper) GetUserById(ctx context.Context, id pgtype.UUID) (db.User, error) {
+	return d.queries.GetUserById(ctx, id)
+}
+
+func (d *DatabaseWrapper) ListUserIdentities(ctx context.Context, userID pgtype.UUID) ([]db.UserIdentity, error) {
+	return d.queries.ListUserIdentities(ctx, userID)
+}
+
+func (d *DatabaseWrapper) TouchUserIdentity(ctx context.Context, arg db.TouchUserIdentityParams) error {
+	return d.queries.TouchUserIdentity(ctx, arg)
+}
+
+func (d *DatabaseWrapper) CreateAccount(ctx context.Context, account NewAccount) (db.User, db.UserIdentity, error) {
+	var user db.User
+	var identity db.UserIdentity
+	err := txn.Run(ctx, d.pool, txn.Serializable, func(q *db.Queries) error {
+		if err := identityFree(ctx, q, account.Identity.Provider, account.Identity.Subject); err != nil {
+			return err
+		}
+		if _, err := q.GetUserByEmail(ctx, account.User.Email); err == nil {
+			return ErrEmailTaken
+		} else if !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+		if _, err := q.GetUserByUsername(ctx, account.User.Username); err == nil {
+			return ErrUsernameTaken
+		} else if !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+
+		var err error
+		user, err = q.CreateUser(ctx, account.User)
+		if err != nil {
+			return err
+		}
+		if account.EmailVerified {
+			if user, err = q.VerifyEmail(ctx, user.ID); err != nil {
+				return err
+			}
+		}
+		link := account.Identity
+		link.UserID = user.ID
+		identity, err = q.CreateUserIdentity(ctx, link)
+		return err
+	})
+	return user, identity, err
+}
+
+func (d *DatabaseWrapper) LinkIdentity(ctx context.Context, arg db.CreateUserIdentityParams) (db.UserIdentity, error) {
+	var identity db.UserIdentity
+	err := txn.Run(ctx, d.pool, txn.Serializable, func(q *db.Queries) error {
+		if err := identityFree(ctx, q, arg.Provider, arg.Subject); err != nil {
+			return err
+		}
+		linked, err := q.ListUserIdentities(ctx, arg.UserID)
+		if err != nil {
+			return err
+		}
+		for _, existing := range linked {
+			if existing.Provider == arg.Provider {
+				return ErrProviderLinked
+			}
+		}
+		identity, err = q.CreateUserIdentity(ctx, arg)
+		return err
+	})
+	return identity, err
+}
+
+func (d *DatabaseWrapper) UnlinkIdentity(ctx context.Context, userID pgtype.UUID, provider string) (db.UserIdentity, error) {
+	var identity db.UserIdentity
+	err := txn.Run(ctx, d.pool, txn.Serializable, func(q *db.Queries) error {
+		user, err := q.GetUserById(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if user.PasswordHash == "" {
+			linked, err := q.CountUserIdentities(ctx, userID)
+			if err != nil {
+				return err
+			}
+			if linked <= 1 {
+				return ErrLastLogin
+			}
+		}
+		identity, err = q.DeleteUserIdentity(ctx, db.DeleteUserIdentityParams{UserID: userID, Provider: provider})
+		return err
+	})
+	return identity, err
+}
+
+// identityFree returns ErrIdentityTaken if the provider account is linked to any user
+func identityFree(ctx context.Context, q *db.Queries, provider, subject string) error {
+	_, err := q.GetUserIdentity(ctx, db.GetUserIdentityParams{Provider: provider, Subject: subject})
+	if err == nil {
+		return ErrIdentityTaken
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	return err
+}
+
+// LoggerInterface abstracts logging operations for dependency injection.
+type LoggerInterface interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+	With(keysAndValues ...interface{}) LoggerInterface
+}
+
+// DefaultLoggerWrapper wraps the internal logging package.
+type DefaultLoggerWrapper struct {
+	logger *log.Logger
+}
+
+func NewDefaultLoggerWrapper() LoggerInterface {
+	return &DefaultLoggerWrapper{logger: logging.GetLogger()}
+}
+
+func (l *DefaultLoggerWrapper) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.Debug(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Info(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.Warn(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.Error(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) With(keysAndValues ...interface{}) LoggerInterface {
+	return &DefaultLoggerWrapper{logger: l.logger.With(keysAndValues...)}
+}
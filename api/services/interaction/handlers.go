@@ -0,0 +1,75 @@
+package interaction
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/internal/uuid"
+	interactionV1 "github.com/VoidMesh/api/api/proto/interaction/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// OpenInterior steps the character into the structure's interior, as EnterInterior does
+func OpenInterior(movement InteriorMovement) Handler {
+	return func(ctx context.Context, target Target) (*interactionV1.InteractResponse, error) {
+		entered, err := movement.EnterInterior(ctx, target.UserID, uuid.PgtypeToString(target.Character.ID), target.Entity.ID)
+		if err != nil {
+			return nil, err
+		}
+		return &interactionV1.InteractResponse{Result: &interactionV1.InteractResponse_Interior{Interior: entered}}, nil
+	}
+}
+
+// OpenBank opens the character's personal vault at the bank, as OpenVault does
+func OpenBank(banks BankVaults) Handler {
+	return func(ctx context.Context, target Target) (*interactionV1.InteractResponse, error) {
+		vault, err := banks.OpenVault(ctx, target.UserID, uuid.PgtypeToString(target.Character.ID), target.Entity.ID, 0)
+		if err != nil {
+			return nil, err
+		}
+		return &interactionV1.InteractResponse{Result: &interactionV1.InteractResponse_Vault{Vault: vault}}, nil
+	}
+}
+
+// ActivateStation collects the character's ready jobs at the station and lists the
+// recipes it makes. Collecting stops once the inventory is full; the jobs left are
+// listed with the ones still processing.
+func ActivateStation(stations StationService) Handler {
+	return func(ctx context.Context, target Target) (*interactionV1.InteractResponse, error) {
+		characterID := uuid.PgtypeToString(target.Character.ID)
+		catalog, err := stations.Recipes(ctx)
+		if err != nil {
+			return nil, err
+		}
+		jobs, err := stations.ListJobs(ctx, target.UserID, characterID)
+		if err != nil {
+			return nil, err
+		}
+
+		use := &interactionV1.StationUse{StationEntityId: target.Entity.ID}
+		for _, recipe := range catalog.Recipes {
+			if recipe.StationType == target.Entity.Type {
+				use.Recipes = append(use.Recipes, recipe)
+			}
+		}
+		full := false
+		for _, job := range jobs {
+			if job.StationEntityId != target.Entity.ID {
+				continue
+			}
+			if job.Ready && !full {
+				items, err := stations.Collect(ctx, target.UserID, characterID, job.Id)
+				if err == nil {
+					use.Collected = append(use.Collected, items...)
+					continue
+				}
+				if status.Code(err) != codes.ResourceExhausted {
+					return nil, err
+				}
+				full = true
+			}
+			use.Jobs = append(use.Jobs, job)
+		}
+		return &interactionV1.InteractResponse{Result: &interactionV1.InteractResponse_Station{Station: use}}, nil
+	}
+}
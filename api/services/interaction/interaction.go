@@ -0,0 +1,232 @@
+// Package interaction dispatches generic verbs (examine, open, activate) aimed at world
+// entities to the handlers registered for them. Handlers are chosen by the entity's type
+// or by a component it has, so a new kind of object is a registration at startup rather
+// than an RPC of its own.
+package interaction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/authz"
+	"github.com/VoidMesh/api/api/internal/entity"
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/VoidMesh/api/api/internal/storage"
+	interactionV1 "github.com/VoidMesh/api/api/proto/interaction/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Verbs handled by this server
+const (
+	VerbExamine  = "examine"
+	VerbOpen     = "open"
+	VerbActivate = "activate"
+)
+
+// Target is what a handler acts on: an entity within reach of the character interacting
+// with it on behalf of the user
+type Target struct {
+	UserID    string
+	Character db.Character
+	Entity    *entity.Entity
+}
+
+// Handler carries out a verb on a target and returns the response with its result set.
+// Errors are returned to the client as they are, so they should be gRPC statuses.
+type Handler func(ctx context.Context, target Target) (*interactionV1.InteractResponse, error)
+
+// Match selects the entities a handler applies to: those of Type, or else those with the
+// component named Component. The zero Match applies to every entity.
+type Match struct {
+	Type      string
+	Component string
+}
+
+// specificity ranks matches so that a handler for a type wins over one for a component,
+// which wins over one for every entity
+func (m Match) specificity() int {
+	switch {
+	case m.Type != "":
+		return 2
+	case m.Component != "":
+		return 1
+	default:
+		return 0
+	}
+}
+
+func (m Match) matches(e *entity.Entity) bool {
+	switch {
+	case m.Type != "":
+		return e.Type == m.Type
+	case m.Component != "":
+		_, ok := e.Components[m.Component]
+		return ok
+	default:
+		return true
+	}
+}
+
+type registration struct {
+	match   Match
+	handler Handler
+}
+
+// Config sets how characters interact with entities
+type Config struct {
+	Reach int32 // Cells a character may stand from the entity
+}
+
+// DefaultConfig lets characters interact with entities as far as they can use stations
+func DefaultConfig() Config {
+	return Config{Reach: 2}
+}
+
+// Service dispatches interactions to the registered handlers.
+type Service struct {
+	db       DatabaseInterface
+	entities EntityStore
+	logger   LoggerInterface
+	config   Config
+
+	mu       sync.RWMutex
+	handlers map[string][]registration // By verb
+}
+
+// NewService creates a new interaction service with dependency injection. Examining any
+// entity is registered from the start.
+func NewService(db DatabaseInterface, entities EntityStore, logger LoggerInterface) *Service {
+	componentLogger := logger.With("component", "interaction-service")
+	componentLogger.Debug("Creating new interaction service")
+	s := &Service{
+		db:       db,
+		entities: entities,
+		logger:   componentLogger,
+		config:   DefaultConfig(),
+		handlers: make(map[string][]registration),
+	}
+	s.Register(VerbExamine, Match{}, s.examine)
+	return s
+}
+
+// NewServiceWithPool creates a service with concrete implementations (convenience constructor for production use).
+func NewServiceWithPool(pool storage.Pool) *Service {
+	return NewService(NewDatabaseWrapper(pool), entity.NewStoreWithPool(pool), NewDefaultLoggerWrapper())
+}
+
+// SetConfig replaces the interaction reach
+func (s *Service) SetConfig(config Config) {
+	s.config = config
+}
+
+// Register handles verb for the entities match selects. It is meant to be called while
+// wiring the server and panics when the verb is empty or already registered for match.
+func (s *Service) Register(verb string, match Match, handler Handler) {
+	if verb == "" {
+		panic("interaction: empty verb")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, r := range s.handlers[verb] {
+		if r.match == match {
+			panic(fmt.Sprintf("interaction: %q registered twice for %+v", verb, match))
+		}
+	}
+	s.handlers[verb] = append(s.handlers[verb], registration{match: match, handler: handler})
+}
+
+// handler returns the most specific handler of verb for an entity
+func (s *Service) handler(verb string, e *entity.Entity) (Handler, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var best *registration
+	for i, r := range s.handlers[verb] {
+		if r.match.matches(e) && (best == nil || r.match.specificity() > best.match.specificity()) {
+			best = &s.handlers[verb][i]
+		}
+	}
+	if best == nil {
+		return nil, false
+	}
+	return best.handler, true
+}
+
+// Verbs returns the verbs an entity accepts, sorted
+func (s *Service) Verbs(e *entity.Entity) []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var verbs []string
+	for verb, registrations := range s.handlers {
+		for _, r := range registrations {
+			if r.match.matches(e) {
+				verbs = append(verbs, verb)
+				break
+			}
+		}
+	}
+	sort.Strings(verbs)
+	return verbs
+}
+
+// Interact carries out a verb on an entity within reach of the character
+func (s *Service) Interact(ctx context.Context, userID, characterID string, entityID int64, verb string) (*interactionV1.InteractResponse, error) {
+	if verb == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "verb is required")
+	}
+	character, err := authz.OwnedCharacter(ctx, s.db, s.logger, userID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	target, err := s.entities.Get(ctx, entityID)
+	if errors.Is(err, entity.ErrNotFound) || (err == nil && target.WorldID != character.WorldID) {
+		return nil, status.Errorf(codes.NotFound, "entity not found")
+	}
+	if err != nil {
+		s.logger.Error("Failed to get entity", "entity_id", entityID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to interact")
+	}
+	if !geometry.InRange(geometry.Point{X: character.X, Y: character.Y}, target.Position(), s.config.Reach) {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s is out of reach", target.Type)
+	}
+	handler, ok := s.handler(verb, target)
+	if !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot %s a %s", verb, target.Type)
+	}
+
+	resp, err := handler(ctx, Target{UserID: userID, Character: character, Entity: target})
+	if err != nil {
+		return nil, err
+	}
+	resp.Verb, resp.TargetEntityId = verb, target.ID
+	s.logger.Debug("Interaction handled", "character_id", characterID, "entity_id", entityID, "type", target.Type, "verb", verb)
+	return resp, nil
+}
+
+// examine describes any entity from its components
+func (s *Service) examine(ctx context.Context, target Target) (*interactionV1.InteractResponse, error) {
+	e := target.Entity
+	examination := &interactionV1.Examination{
+		EntityId: e.ID,
+		Type:     e.Type,
+		X:        e.X,
+		Y:        e.Y,
+		Verbs:    s.Verbs(e),
+	}
+	// Components that fail to decode are left out rather than failing the examination
+	if owner, ok, err := entity.Get[entity.Owner](e.Components); err == nil && ok {
+		examination.OwnerCharacterId = owner.CharacterID
+	}
+	if health, ok, err := entity.Get[entity.Health](e.Components); err == nil && ok {
+		examination.Health, examination.MaxHealth = health.Current, health.Max
+	}
+	if expiry, ok, err := entity.Get[entity.Expiry](e.Components); err == nil && ok {
+		examination.ExpiresAt = timestamppb.New(expiry.ExpiresAt)
+	}
+	return &interactionV1.InteractResponse{Result: &interactionV1.InteractResponse_Examination{Examination: examination}}, nil
+}
@@ -0,0 +1,231 @@
+package interaction
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/entity"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	bankV1 "github.com/VoidMesh/api/api/proto/bank/v1"
+	interactionV1 "github.com/VoidMesh/api/api/proto/interaction/v1"
+	interiorV1 "github.com/VoidMesh/api/api/proto/interior/v1"
+	processingV1 "github.com/VoidMesh/api/api/proto/processing/v1"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeDB struct {
+	testmocks.Characters
+}
+
+type fakeEntities map[int64]*entity.Entity
+
+func (f fakeEntities) Get(ctx context.Context, id int64) (*entity.Entity, error) {
+	e, ok := f[id]
+	if !ok {
+		return nil, entity.ErrNotFound
+	}
+	return e, nil
+}
+
+// fakeMovement records the structures characters step into
+type fakeMovement struct {
+	entered []int64
+}
+
+func (f *fakeMovement) EnterInterior(ctx context.Context, userID, characterID string, structureID int64) (*interiorV1.EnterInteriorResponse, error) {
+	f.entered = append(f.entered, structureID)
+	return &interiorV1.EnterInteriorResponse{Position: &interiorV1.InteriorPosition{InteriorId: 3, X: 4, Y: 6}}, nil
+}
+
+// fakeStations has a furnace recipe and a campfire recipe, and fits one collected job in
+// the inventory
+type fakeStations struct {
+	jobs      []*processingV1.ProcessingJob
+	collected []int64
+	room      int
+}
+
+func (f *fakeStations) Recipes(ctx context.Context) (*processingV1.ListRecipesResponse, error) {
+	return &processingV1.ListRecipesResponse{Recipes: []*processingV1.Recipe{
+		{Id: "iron_ingot", StationType: "furnace"},
+		{Id: "cooked_fish", StationType: "campfire"},
+	}}, nil
+}
+
+func (f *fakeStations) ListJobs(ctx context.Context, userID, characterID string) ([]*processingV1.ProcessingJob, error) {
+	return f.jobs, nil
+}
+
+func (f *fakeStations) Collect(ctx context.Context, userID, characterID string, jobID int64) ([]*processingV1.ItemQuantity, error) {
+	if f.room == 0 {
+		return nil, status.Errorf(codes.ResourceExhausted, "inventory is full")
+	}
+	f.room--
+	f.collected = append(f.collected, jobID)
+	return []*processingV1.ItemQuantity{{ItemName: "Iron Ingot", Quantity: 1}}, nil
+}
+
+// fakeBanks opens a personal vault holding nothing
+type fakeBanks struct {
+	opened []int64
+}
+
+func (f *fakeBanks) OpenVault(ctx context.Context, userID, characterID string, bankID, vaultID int64) (*bankV1.Vault, error) {
+	f.opened = append(f.opened, bankID)
+	return &bankV1.Vault{Id: 7, Kind: bankV1.VaultKind_VAULT_KIND_PERSONAL, OwnerCharacterId: characterID}, nil
+}
+
+const (
+	userID      = "550e8400-e29b-41d4-a716-446655440000"
+	characterID = "01000000-0000-0000-0000-000000000000"
+)
+
+var world = pgtype.UUID{Bytes: [16]byte{9}, Valid: true}
+
+func newTestService(t *testing.T) (*Service, fakeEntities) {
+	character := db.Character{
+		ID:      pgtype.UUID{Bytes: [16]byte{1}, Valid: true},
+		UserID:  pgtype.UUID{Bytes: [16]byte{0x55, 0x0e, 0x84, 0x00, 0xe2, 0x9b, 0x41, 0xd4, 0xa7, 0x16, 0x44, 0x66, 0x55, 0x44, 0x00, 0x00}, Valid: true},
+		WorldID: world,
+		X:       10,
+		Y:       10,
+	}
+	owned := entity.Components{}
+	require.NoError(t, owned.Set(entity.Owner{CharacterID: characterID}))
+	require.NoError(t, owned.Set(entity.Health{Current: 40, Max: 50}))
+	drop := entity.Components{}
+	require.NoError(t, drop.Set(entity.Expiry{ExpiresAt: time.Date(2025, 1, 1, 0, 5, 0, 0, time.UTC)}))
+	entities := fakeEntities{
+		1: {ID: 1, WorldID: world, Type: "cottage", X: 10, Y: 11, Components: owned},
+		2: {ID: 2, WorldID: world, Type: "furnace", X: 11, Y: 10, Components: entity.Components{}},
+		3: {ID: 3, WorldID: world, Type: "drop", X: 9, Y: 9, Components: drop},
+		4: {ID: 4, WorldID: world, Type: "furnace", X: 13, Y: 10, Components: entity.Components{}},
+		5: {ID: 5, WorldID: pgtype.UUID{Bytes: [16]byte{8}, Valid: true}, Type: "furnace", X: 10, Y: 10},
+		6: {ID: 6, WorldID: world, Type: "bank", X: 9, Y: 10, Components: entity.Components{}},
+	}
+	database := &fakeDB{Characters: testmocks.NewCharacters(character)}
+	return NewService(database, entities, testmocks.NopLogger[LoggerInterface]{}), entities
+}
+
+func TestExamine(t *testing.T) {
+	service, _ := newTestService(t)
+	service.Register(VerbOpen, Match{Type: "cottage"}, OpenInterior(&fakeMovement{}))
+	ctx := playerContext()
+
+	resp, err := service.Interact(ctx, userID, characterID, 1, VerbExamine)
+	require.NoError(t, err)
+	assert.Equal(t, VerbExamine, resp.Verb)
+	assert.Equal(t, int64(1), resp.TargetEntityId)
+	examination := resp.GetExamination()
+	require.NotNil(t, examination)
+	assert.Equal(t, "cottage", examination.Type)
+	assert.Equal(t, characterID, examination.OwnerCharacterId)
+	assert.Equal(t, int32(40), examination.Health)
+	assert.Nil(t, examination.ExpiresAt)
+	assert.Equal(t, []string{VerbExamine, VerbOpen}, examination.Verbs)
+
+	resp, err = service.Interact(ctx, userID, characterID, 3, VerbExamine)
+	require.NoError(t, err)
+	assert.Empty(t, resp.GetExamination().OwnerCharacterId)
+	assert.Equal(t, time.Date(2025, 1, 1, 0, 5, 0, 0, time.UTC), resp.GetExamination().ExpiresAt.AsTime())
+	assert.Equal(t, []string{VerbExamine}, resp.GetExamination().Verbs)
+}
+
+func TestInteractChecks(t *testing.T) {
+	service, _ := newTestService(t)
+	ctx := playerContext()
+
+	_, err := service.Interact(ctx, userID, characterID, 1, "")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = service.Interact(ctx, "550e8400-e29b-41d4-a716-446655440001", characterID, 1, VerbExamine)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = service.Interact(ctx, userID, characterID, 99, VerbExamine)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = service.Interact(ctx, userID, characterID, 5, VerbExamine)
+	assert.Equal(t, codes.NotFound, status.Code(err), "entities of other worlds are not found")
+	_, err = service.Interact(ctx, userID, characterID, 4, VerbExamine)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "the furnace is three cells away")
+	_, err = service.Interact(ctx, userID, characterID, 2, VerbOpen)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "furnaces cannot be opened")
+}
+
+func TestHandlerSpecificity(t *testing.T) {
+	service, _ := newTestService(t)
+	ctx := playerContext()
+	handled := func(name string) Handler {
+		return func(ctx context.Context, target Target) (*interactionV1.InteractResponse, error) {
+			return &interactionV1.InteractResponse{Result: &interactionV1.InteractResponse_Examination{Examination: &interactionV1.Examination{Type: name}}}, nil
+		}
+	}
+	service.Register("kick", Match{Type: "cottage"}, handled("type"))
+	service.Register("kick", Match{}, handled("any"))
+	service.Register("kick", Match{Component: "owner"}, handled("component"))
+	service.Register("kick", Match{Component: "expiry"}, handled("expiry"))
+
+	for id, want := range map[int64]string{1: "type", 2: "any", 3: "expiry"} {
+		resp, err := service.Interact(ctx, userID, characterID, id, "kick")
+		require.NoError(t, err)
+		assert.Equal(t, want, resp.GetExamination().Type, "entity %d", id)
+	}
+	assert.Panics(t, func() { service.Register("kick", Match{Type: "cottage"}, handled("again")) })
+	assert.Panics(t, func() { service.Register("", Match{}, handled("none")) })
+}
+
+func TestOpenAndActivate(t *testing.T) {
+	service, _ := newTestService(t)
+	ctx := playerContext()
+	movement := &fakeMovement{}
+	stations := &fakeStations{
+		jobs: []*processingV1.ProcessingJob{
+			{Id: 1, StationEntityId: 2, Ready: true},
+			{Id: 2, StationEntityId: 4, Ready: true},
+			{Id: 3, StationEntityId: 2, Ready: false},
+			{Id: 4, StationEntityId: 2, Ready: true},
+		},
+		room: 1,
+	}
+	service.Register(VerbOpen, Match{Type: "cottage"}, OpenInterior(movement))
+	service.Register(VerbActivate, Match{Type: "furnace"}, ActivateStation(stations))
+
+	resp, err := service.Interact(ctx, userID, characterID, 1, VerbOpen)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), resp.GetInterior().Position.InteriorId)
+	assert.Equal(t, []int64{1}, movement.entered)
+
+	resp, err = service.Interact(ctx, userID, characterID, 2, VerbActivate)
+	require.NoError(t, err)
+	use := resp.GetStation()
+	require.NotNil(t, use)
+	require.Len(t, use.Recipes, 1)
+	assert.Equal(t, "iron_ingot", use.Recipes[0].Id)
+	assert.Equal(t, []int64{1}, stations.collected, "only jobs at this station, until the inventory is full")
+	assert.Len(t, use.Collected, 1)
+	require.Len(t, use.Jobs, 2)
+	assert.Equal(t, int64(3), use.Jobs[0].Id)
+	assert.Equal(t, int64(4), use.Jobs[1].Id)
+}
+
+func TestOpenBank(t *testing.T) {
+	service, _ := newTestService(t)
+	banks := &fakeBanks{}
+	service.Register(VerbOpen, Match{Type: "bank"}, OpenBank(banks))
+
+	resp, err := service.Interact(playerContext(), userID, characterID, 6, VerbOpen)
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), resp.GetVault().Id)
+	assert.Equal(t, characterID, resp.GetVault().OwnerCharacterId)
+	assert.Equal(t, []int64{6}, banks.opened)
+}
+
+// playerContext is the context of a session bound to the test world
+func playerContext() context.Context {
+	return session.WithWorldID(context.Background(), uuid.PgtypeToString(world))
+}
@@ -0,0 +1,95 @@
+package interaction
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/entity"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/storage"
+	bankV1 "github.com/VoidMesh/api/api/proto/bank/v1"
+	interiorV1 "github.com/VoidMesh/api/api/proto/interior/v1"
+	processingV1 "github.com/VoidMesh/api/api/proto/processing/v1"
+	"github.com/charmbracelet/log"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DatabaseInterface abstracts database operations for interactions.
+type DatabaseInterface interface {
+	GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error)
+}
+
+// EntityStore loads the world entities characters interact with.
+type EntityStore interface {
+	Get(ctx context.Context, id int64) (*entity.Entity, error)
+}
+
+// InteriorMovement steps characters into structure interiors. It is the movement service,
+// so opening a structure is validated like any other step.
+type InteriorMovement interface {
+	EnterInterior(ctx context.Context, userID, characterID string, structureID int64) (*interiorV1.EnterInteriorResponse, error)
+}
+
+// StationService lists recipes and runs the characters' processing jobs.
+type StationService interface {
+	Recipes(ctx context.Context) (*processingV1.ListRecipesResponse, error)
+	ListJobs(ctx context.Context, userID, characterID string) ([]*processingV1.ProcessingJob, error)
+	Collect(ctx context.Context, userID, characterID string, jobID int64) ([]*processingV1.ItemQuantity, error)
+}
+
+// BankVaults opens the characters' vaults at banks.
+type BankVaults interface {
+	OpenVault(ctx context.Context, userID, characterID string, bankID, vaultID int64) (*bankV1.Vault, error)
+}
+
+// DatabaseWrapper implements DatabaseInterface using the actual database connection.
+type DatabaseWrapper struct {
+	queries *db.Queries
+}
+
+// NewDatabaseWrapper creates a new database wrapper with the given connection pool.
+func NewDatabaseWrapper(pool storage.Pool) DatabaseInterface {
+	return &DatabaseWrapper{queries: db.New(pool)}
+}
+
+func (d *DatabaseWrapper) GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error) {
+	return d.queries.GetCharacterById(ctx, id)
+}
+
+// LoggerInterface abstracts logging operations for dependency injection.
+type LoggerInterface interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+	With(keysAndValues ...interface{}) LoggerInterface
+}
+
+// DefaultLoggerWrapper wraps the internal logging package.
+type DefaultLoggerWrapper struct {
+	logger *log.Logger
+}
+
+func NewDefaultLoggerWrapper() LoggerInterface {
+	return &DefaultLoggerWrapper{logger: logging.GetLogger()}
+}
+
+func (l *DefaultLoggerWrapper) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.Debug(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Info(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.Warn(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.Error(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) With(keysAndValues ...interface{}) LoggerInterface {
+	return &DefaultLoggerWrapper{logger: l.logger.With(keysAndValues...)}
+}
@@ -0,0 +1,186 @@
+package interior
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/entity"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/txn"
+	"github.com/VoidMesh/api/api/services/inventory"
+	"github.com/charmbracelet/log"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DatabaseInterface abstracts database operations for interiors.
+type DatabaseInterface interface {
+	GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error)
+	GetItem(ctx context.Context, id int32) (db.Item, error)
+	GetInterior(ctx context.Context, id int64) (db.Interior, error)
+	GetInteriorByStructure(ctx context.Context, structureID int64) (db.Interior, error)
+	CreateInterior(ctx context.Context, arg db.CreateInteriorParams) (db.Interior, error)
+	GetCharacterInterior(ctx context.Context, characterID pgtype.UUID) (db.CharacterInterior, error)
+	EnterInterior(ctx context.Context, arg db.EnterInteriorParams) (int64, error)
+	UpdateCharacterInteriorPosition(ctx context.Context, arg db.UpdateCharacterInteriorPositionParams) (int64, error)
+	ExitInterior(ctx context.Context, characterID pgtype.UUID) (int64, error)
+	ListInteriorFurniture(ctx context.Context, interiorID int64) ([]db.ListInteriorFurnitureRow, error)
+	InteriorFurnitureExistsAt(ctx context.Context, arg db.InteriorFurnitureExistsAtParams) (bool, error)
+	PlaceFurniture(ctx context.Context, arg db.CreateInteriorFurnitureParams) (db.InteriorFurniture, error)
+	RemoveFurniture(ctx context.Context, arg db.DeleteInteriorFurnitureParams, character db.Character) (db.InteriorFurniture, db.Item, error)
+}
+
+// StructureStore loads placed structures from the world entities.
+type StructureStore interface {
+	Get(ctx context.Context, id int64) (*entity.Entity, error)
+}
+
+// DatabaseWrapper implements DatabaseInterface using the actual database connection.
+type DatabaseWrapper struct {
+	pool    storage.Pool
+	queries *db.Queries
+}
+
+// NewDatabaseWrapper creates a new database wrapper with the given connection pool.
+func NewDatabaseWrapper(pool storage.Pool) DatabaseInterface {
+	return &DatabaseWrapper{
+		pool:    pool,
+		queries: db.New(pool),
+	}
+}
+
+func (d *DatabaseWrapper) GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error) {
+	return d.queries.GetCharacterById(ctx, id)
+}
+
+func (d *DatabaseWrapper) GetItem(ctx context.Context, id int32) (db.Item, error) {
+	return d.queries.GetItem(ctx, id)
+}
+
+func (d *DatabaseWrapper) GetInterior(ctx context.Context, id int64) (db.Interior, error) {
+	return d.queries.GetInterior(ctx, id)
+}
+
+func (d *DatabaseWrapper) GetInteriorByStructure(ctx context.Context, structureID int64) (db.Interior, error) {
+	return d.queries.GetInteriorByStructure(ctx, structureID)
+}
+
+func (d *DatabaseWrapper) CreateInterior(ctx context.Context, arg db.CreateInteriorParams) (db.Interior, error) {
+	return d.queries.CreateInterior(ctx, arg)
+}
+
+func (d *DatabaseWrapper) GetCharacterInterior(ctx context.Context, characterID pgtype.UUID) (db.CharacterInterior, error) {
+	return d.queries.GetCharacterInterior(ctx, characterID)
+}
+
+func (d *DatabaseWrapper) EnterInterior(ctx context.Context, arg db.EnterInteriorParams) (int64, error) {
+	return d.queries.EnterInterior(ctx, arg)
+}
+
+func (d *DatabaseWrapper) UpdateCharacterInteriorPosition(ctx context.Context, arg db.UpdateCharacterInteriorPositionParams) (int64, error) {
+	return d.queries.UpdateCharacterInteriorPosition(ctx, arg)
+}
+
+func (d *DatabaseWrapper) ExitInterior(ctx context.Context, characterID pgtype.UUID) (int64, error) {
+	return d.queries.ExitInterior(ctx, characterID)
+}
+
+func (d *DatabaseWrapper) ListInteriorFurniture(ctx context.Context, interiorID int64) ([]db.ListInteriorFurnitureRow, error) {
+	return d.queries.ListInteriorFurniture(ctx, interiorID)
+}
+
+func (d *DatabaseWrapper) InteriorFurnitureExistsAt(ctx context.Context, arg db.InteriorFurnitureExistsAtParams) (bool, error) {
+	return d.queries.InteriorFurnitureExistsAt(ctx, arg)
+}
+
+// PlaceFurniture takes the item from the placer's inventory and stores the furniture in
+// one serializable transaction. It returns ErrCellTaken, spending nothing, when the cell
+// is already furnished.
+func (d *DatabaseWrapper) PlaceFurniture(ctx context.Context, arg db.CreateInteriorFurnitureParams) (db.InteriorFurniture, error) {
+	var placed db.InteriorFurniture
+	err := txn.Run(ctx, d.pool, txn.Serializable, func(q *db.Queries) error {
+		var err error
+		placed, err = q.CreateInteriorFurniture(ctx, arg)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrCellTaken
+		}
+		if err != nil {
+			return fmt.Errorf("failed to create furniture: %w", err)
+		}
+		_, err = q.RemoveInventoryItemQuantity(ctx, db.RemoveInventoryItemQuantityParams{
+			CharacterID: arg.PlacedBy,
+			ItemID:      arg.ItemID,
+			Quantity:    1,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNoFurnitureItem
+		}
+		if err != nil {
+			return fmt.Errorf("failed to take item %d: %w", arg.ItemID, err)
+		}
+		return q.DeleteEmptyInventoryItems(ctx, arg.PlacedBy)
+	})
+	return placed, err
+}
+
+// RemoveFurniture deletes the furniture and gives its item to the character in one
+// serializable transaction; nothing changes when the item does not fit
+func (d *DatabaseWrapper) RemoveFurniture(ctx context.Context, arg db.DeleteInteriorFurnitureParams, character db.Character) (db.InteriorFurniture, db.Item, error) {
+	var removed db.InteriorFurniture
+	var item db.Item
+	err := txn.Run(ctx, d.pool, txn.Serializable, func(q *db.Queries) error {
+		var err error
+		removed, err = q.DeleteInteriorFurniture(ctx, arg)
+		if err != nil {
+			return err
+		}
+		item, err = q.GetItem(ctx, removed.ItemID)
+		if err != nil {
+			return fmt.Errorf("failed to get item %d: %w", removed.ItemID, err)
+		}
+		_, err = inventory.GrantAllInTx(ctx, q, character, []inventory.Grant{{ItemID: item.ID, StackSize: item.StackSize, Quantity: 1}})
+		return err
+	})
+	return removed, item, err
+}
+
+// LoggerInterface abstracts logging operations for dependency injection.
+type LoggerInterface interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+	With(keysAndValues ...interface{}) LoggerInterface
+}
+
+// DefaultLoggerWrapper wraps the internal logging package.
+type DefaultLoggerWrapper struct {
+	logger *log.Logger
+}
+
+func NewDefaultLoggerWrapper() LoggerInterface {
+	return &DefaultLoggerWrapper{logger: logging.GetLogger()}
+}
+
+func (l *DefaultLoggerWrapper) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.Debug(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Info(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.Warn(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.Error(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) With(keysAndValues ...interface{}) LoggerInterface {
+	return &DefaultLoggerWrapper{logger: l.logger.With(keysAndValues...)}
+}
@@ -0,0 +1,565 @@
+// Package interior manages the instanced interiors of placed structures. A structure
+// whose entity type has a template gets an interior the first time it is opened: a
+// small grid of terrain cells with its own coordinates, generated from the template and
+// stored, so template changes never reshape existing interiors.
+//
+// Characters step in and out through the character service, which validates the
+// transitions like any other move; while inside, a character keeps its world position
+// beside the structure and its moves are on the interior's grid. The structure's owner
+// furnishes the interior with furniture items from the inventory.
+package interior
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/authz"
+	"github.com/VoidMesh/api/api/internal/chunkdata"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/entity"
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	interiorV1 "github.com/VoidMesh/api/api/proto/interior/v1"
+	"github.com/VoidMesh/api/api/services/inventory"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// FurnitureItemType is the item type that can be placed in interiors
+const FurnitureItemType = "furniture"
+
+// cacheSize bounds the interiors kept in memory for moves
+const cacheSize = 1024
+
+var (
+	// ErrNoInterior means the structure's type has no interior template
+	ErrNoInterior      = errors.New("structure has no interior")
+	ErrCellTaken       = errors.New("cell is already furnished")
+	ErrNoFurnitureItem = errors.New("character has no such item")
+)
+
+// Template lays out the interior of a structure type. Layout rows, all the same length,
+// use '#' for walls, '.' for floor and 'D' for the door: the one floor cell characters
+// enter and leave on.
+type Template struct {
+	ID        string
+	Structure string // Entity type of the structures it is the interior of
+	Layout    []string
+}
+
+// Config sets the interior templates
+type Config struct {
+	Templates []Template
+}
+
+// DefaultConfig gives cottages a single room with the door in the bottom wall
+func DefaultConfig() Config {
+	return Config{
+		Templates: []Template{
+			{
+				ID:        "cottage",
+				Structure: "cottage",
+				Layout: []string{
+					"##########",
+					"#........#",
+					"#........#",
+					"#........#",
+					"#........#",
+					"#........#",
+					"####D#####",
+				},
+			},
+		},
+	}
+}
+
+// Interior is a structure's generated interior
+type Interior struct {
+	ID          int64
+	StructureID int64
+	WorldID     pgtype.UUID
+	Template    string
+	Width       int32
+	Height      int32
+	Cells       []chunkV1.TerrainType // Row-major
+	Door        geometry.Point
+}
+
+// Walkable reports whether a cell is inside the interior and its terrain can be walked on
+func (in *Interior) Walkable(x, y int32) bool {
+	if x < 0 || y < 0 || x >= in.Width || y >= in.Height {
+		return false
+	}
+	return chunkdata.Walkable(in.Cells[y*in.Width+x])
+}
+
+// Service generates interiors, tracks the characters inside and manages their furniture.
+type Service struct {
+	db         DatabaseInterface
+	structures StructureStore
+	clock      clock.Clock
+	config     Config
+	logger     LoggerInterface
+
+	mu    sync.Mutex
+	cache map[int64]*Interior // Interiors never change once generated
+}
+
+// NewService creates a new interior service with dependency injection.
+func NewService(database DatabaseInterface, structures StructureStore, logger LoggerInterface) *Service {
+	componentLogger := logger.With("component", "interior-service")
+	componentLogger.Debug("Creating new interior service")
+	return &Service{
+		db:         database,
+		structures: structures,
+		clock:      clock.New(),
+		config:     DefaultConfig(),
+		logger:     componentLogger,
+		cache:      make(map[int64]*Interior),
+	}
+}
+
+// NewServiceWithPool creates a service with concrete implementations (convenience constructor for production use).
+func NewServiceWithPool(pool storage.Pool, structures StructureStore) *Service {
+	return NewService(NewDatabaseWrapper(pool), structures, NewDefaultLoggerWrapper())
+}
+
+// SetClock replaces the clock used for timestamps (for simulation tests)
+func (s *Service) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// SetConfig replaces the templates
+func (s *Service) SetConfig(config Config) {
+	s.config = config
+}
+
+// StructureTypes returns the entity types with an interior template
+func (s *Service) StructureTypes() []string {
+	types := make([]string, 0, len(s.config.Templates))
+	for _, t := range s.config.Templates {
+		types = append(types, t.Structure)
+	}
+	return types
+}
+
+func (s *Service) template(structureType string) (Template, bool) {
+	for _, t := range s.config.Templates {
+		if t.Structure == structureType {
+			return t, true
+		}
+	}
+	return Template{}, false
+}
+
+// generate lays out the template's cells
+func generate(t Template) (width, height int32, cells []byte, door geometry.Point, err error) {
+	height = int32(len(t.Layout))
+	if height == 0 {
+		return 0, 0, nil, door, fmt.Errorf("template %s has no rows", t.ID)
+	}
+	width = int32(len(t.Layout[0]))
+	doors := 0
+	cells = make([]byte, 0, width*height)
+	for y, row := range t.Layout {
+		if int32(len(row)) != width {
+			return 0, 0, nil, door, fmt.Errorf("template %s: row %d is %d cells wide, not %d", t.ID, y, len(row), width)
+		}
+		for x, c := range row {
+			switch c {
+			case '#':
+				cells = append(cells, byte(chunkV1.TerrainType_TERRAIN_TYPE_STONE))
+			case '.':
+				cells = append(cells, byte(chunkV1.TerrainType_TERRAIN_TYPE_DIRT))
+			case 'D':
+				cells = append(cells, byte(chunkV1.TerrainType_TERRAIN_TYPE_DIRT))
+				door = geometry.Point{X: int32(x), Y: int32(y)}
+				doors++
+			default:
+				return 0, 0, nil, door, fmt.Errorf("template %s: unknown cell %q at (%d, %d)", t.ID, c, x, y)
+			}
+		}
+	}
+	if doors != 1 {
+		return 0, 0, nil, door, fmt.Errorf("template %s has %d doors, not 1", t.ID, doors)
+	}
+	return width, height, cells, door, nil
+}
+
+func fromRow(row db.Interior) *Interior {
+	cells := make([]chunkV1.TerrainType, len(row.Cells))
+	for i, c := range row.Cells {
+		cells[i] = chunkV1.TerrainType(c)
+	}
+	return &Interior{
+		ID:          row.ID,
+		StructureID: row.StructureID,
+		WorldID:     row.WorldID,
+		Template:    row.Template,
+		Width:       row.Width,
+		Height:      row.Height,
+		Cells:       cells,
+		Door:        geometry.Point{X: row.DoorX, Y: row.DoorY},
+	}
+}
+
+func (s *Service) remember(in *Interior) *Interior {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.cache) >= cacheSize {
+		clear(s.cache)
+	}
+	s.cache[in.ID] = in
+	return in
+}
+
+// interior returns an interior by ID
+func (s *Service) interior(ctx context.Context, id int64) (*Interior, error) {
+	s.mu.Lock()
+	in, ok := s.cache[id]
+	s.mu.Unlock()
+	if ok {
+		return in, nil
+	}
+	row, err := s.db.GetInterior(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get interior %d: %w", id, err)
+	}
+	return s.remember(fromRow(row)), nil
+}
+
+// Structure returns the placed structure with the given entity ID in a world, or
+// entity.ErrNotFound
+func (s *Service) Structure(ctx context.Context, worldID pgtype.UUID, structureID int64) (*entity.Entity, error) {
+	structure, err := s.structures.Get(ctx, structureID)
+	if err != nil {
+		return nil, err
+	}
+	if structure.WorldID != worldID {
+		return nil, entity.ErrNotFound
+	}
+	return structure, nil
+}
+
+// open returns a structure's interior, generating it from the structure type's template
+// the first time
+func (s *Service) open(ctx context.Context, structure *entity.Entity) (*Interior, error) {
+	row, err := s.db.GetInteriorByStructure(ctx, structure.ID)
+	if err == nil {
+		return s.remember(fromRow(row)), nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("failed to get interior of structure %d: %w", structure.ID, err)
+	}
+
+	t, ok := s.template(structure.Type)
+	if !ok {
+		return nil, ErrNoInterior
+	}
+	width, height, cells, door, err := generate(t)
+	if err != nil {
+		return nil, err
+	}
+	row, err = s.db.CreateInterior(ctx, db.CreateInteriorParams{
+		StructureID: structure.ID,
+		WorldID:     structure.WorldID,
+		Template:    t.ID,
+		Width:       width,
+		Height:      height,
+		Cells:       cells,
+		DoorX:       door.X,
+		DoorY:       door.Y,
+		CreatedAt:   pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		// Generated concurrently; use the stored interior
+		row, err = s.db.GetInteriorByStructure(ctx, structure.ID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create interior of structure %d: %w", structure.ID, err)
+	}
+	s.logger.Debug("Interior generated", "interior_id", row.ID, "structure_id", structure.ID, "template", t.ID)
+	return s.remember(fromRow(row)), nil
+}
+
+// Locate returns where a character is inside an interior, or nil when it is outside
+func (s *Service) Locate(ctx context.Context, characterID pgtype.UUID) (*interiorV1.InteriorPosition, error) {
+	row, err := s.db.GetCharacterInterior(ctx, characterID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to locate character: %w", err)
+	}
+	return &interiorV1.InteriorPosition{InteriorId: row.InteriorID, X: row.X, Y: row.Y}, nil
+}
+
+// Door returns the cell of an interior characters enter and leave on
+func (s *Service) Door(ctx context.Context, interiorID int64) (geometry.Point, error) {
+	in, err := s.interior(ctx, interiorID)
+	if err != nil {
+		return geometry.Point{}, err
+	}
+	return in.Door, nil
+}
+
+// Passable reports whether a character can step onto a cell of an interior: walkable
+// floor without furniture
+func (s *Service) Passable(ctx context.Context, interiorID int64, x, y int32) (bool, error) {
+	in, err := s.interior(ctx, interiorID)
+	if err != nil {
+		return false, err
+	}
+	if !in.Walkable(x, y) {
+		return false, nil
+	}
+	furnished, err := s.db.InteriorFurnitureExistsAt(ctx, db.InteriorFurnitureExistsAtParams{InteriorID: interiorID, X: x, Y: y})
+	if err != nil {
+		return false, fmt.Errorf("failed to check furniture: %w", err)
+	}
+	return !furnished, nil
+}
+
+// Enter opens a structure's interior and puts the character on its door cell. It fails
+// with a FailedPrecondition status when the structure has no interior or the character
+// is already inside one.
+func (s *Service) Enter(ctx context.Context, characterID pgtype.UUID, structure *entity.Entity) (*interiorV1.EnterInteriorResponse, error) {
+	in, err := s.open(ctx, structure)
+	if errors.Is(err, ErrNoInterior) {
+		return nil, status.Errorf(codes.FailedPrecondition, "structure has no interior")
+	}
+	if err != nil {
+		return nil, err
+	}
+	entered, err := s.db.EnterInterior(ctx, db.EnterInteriorParams{
+		CharacterID: characterID,
+		InteriorID:  in.ID,
+		X:           in.Door.X,
+		Y:           in.Door.Y,
+		EnteredAt:   pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to enter interior: %w", err)
+	}
+	if entered == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "character is already inside an interior")
+	}
+	described, err := s.describe(ctx, in, structure)
+	if err != nil {
+		return nil, err
+	}
+	return &interiorV1.EnterInteriorResponse{
+		Interior: described,
+		Position: &interiorV1.InteriorPosition{InteriorId: in.ID, X: in.Door.X, Y: in.Door.Y},
+	}, nil
+}
+
+// MoveTo moves a character inside its interior
+func (s *Service) MoveTo(ctx context.Context, characterID pgtype.UUID, x, y int32) error {
+	if _, err := s.db.UpdateCharacterInteriorPosition(ctx, db.UpdateCharacterInteriorPositionParams{CharacterID: characterID, X: x, Y: y}); err != nil {
+		return fmt.Errorf("failed to move inside interior: %w", err)
+	}
+	return nil
+}
+
+// Exit takes a character out of its interior
+func (s *Service) Exit(ctx context.Context, characterID pgtype.UUID) error {
+	if _, err := s.db.ExitInterior(ctx, characterID); err != nil {
+		return fmt.Errorf("failed to exit interior: %w", err)
+	}
+	return nil
+}
+
+// describe returns an interior with its furniture and the structure's owner
+func (s *Service) describe(ctx context.Context, in *Interior, structure *entity.Entity) (*interiorV1.Interior, error) {
+	furniture, err := s.db.ListInteriorFurniture(ctx, in.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list furniture: %w", err)
+	}
+	resp := &interiorV1.Interior{
+		Id:               in.ID,
+		StructureId:      in.StructureID,
+		Template:         in.Template,
+		Width:            in.Width,
+		Height:           in.Height,
+		Cells:            make([]*chunkV1.TerrainCell, len(in.Cells)),
+		DoorX:            in.Door.X,
+		DoorY:            in.Door.Y,
+		OwnerCharacterId: owner(structure),
+	}
+	for i, cell := range in.Cells {
+		resp.Cells[i] = &chunkV1.TerrainCell{TerrainType: cell}
+	}
+	for _, row := range furniture {
+		resp.Furniture = append(resp.Furniture, &interiorV1.Furniture{
+			Id:       row.ID,
+			ItemId:   row.ItemID,
+			ItemName: row.ItemName,
+			X:        row.X,
+			Y:        row.Y,
+			PlacedAt: timestamppb.New(row.PlacedAt.Time),
+		})
+	}
+	return resp, nil
+}
+
+// owner returns the character ID of a structure's owner, or "" for none
+func owner(structure *entity.Entity) string {
+	o, ok, err := entity.Get[entity.Owner](structure.Components)
+	if err != nil || !ok {
+		return ""
+	}
+	return o.CharacterID
+}
+
+// Get returns a structure's interior in the character's world
+func (s *Service) Get(ctx context.Context, userID, characterID string, structureID int64) (*interiorV1.Interior, error) {
+	character, err := authz.OwnedCharacter(ctx, s.db, s.logger, userID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	structure, err := s.Structure(ctx, character.WorldID, structureID)
+	if errors.Is(err, entity.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "structure not found")
+	}
+	if err != nil {
+		s.logger.Error("Failed to get structure", "structure_id", structureID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to get interior")
+	}
+	in, err := s.open(ctx, structure)
+	if errors.Is(err, ErrNoInterior) {
+		return nil, status.Errorf(codes.FailedPrecondition, "structure has no interior")
+	}
+	if err != nil {
+		s.logger.Error("Failed to open interior", "structure_id", structureID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to get interior")
+	}
+	resp, err := s.describe(ctx, in, structure)
+	if err != nil {
+		s.logger.Error("Failed to describe interior", "interior_id", in.ID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to get interior")
+	}
+	return resp, nil
+}
+
+// ownInterior returns the interior a character is inside, failing unless the character
+// owns its structure
+func (s *Service) ownInterior(ctx context.Context, character db.Character) (*Interior, *interiorV1.InteriorPosition, error) {
+	location, err := s.Locate(ctx, character.ID)
+	if err != nil {
+		s.logger.Error("Failed to locate character", "character_id", uuid.PgtypeToString(character.ID), "error", err)
+		return nil, nil, status.Errorf(codes.Internal, "failed to locate character")
+	}
+	if location == nil {
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "character is not inside an interior")
+	}
+	in, err := s.interior(ctx, location.InteriorId)
+	if err != nil {
+		s.logger.Error("Failed to get interior", "interior_id", location.InteriorId, "error", err)
+		return nil, nil, status.Errorf(codes.Internal, "failed to get interior")
+	}
+	structure, err := s.structures.Get(ctx, in.StructureID)
+	if err != nil {
+		s.logger.Error("Failed to get structure", "structure_id", in.StructureID, "error", err)
+		return nil, nil, status.Errorf(codes.Internal, "failed to get interior")
+	}
+	if !uuid.Compare(owner(structure), uuid.PgtypeToString(character.ID)) {
+		return nil, nil, status.Errorf(codes.PermissionDenied, "only the owner can furnish this interior")
+	}
+	return in, location, nil
+}
+
+// PlaceFurniture takes one furniture item from the character's inventory and places it on
+// a free floor cell of the interior the character is in, which it must own
+func (s *Service) PlaceFurniture(ctx context.Context, userID, characterID string, itemID, x, y int32) (*interiorV1.Furniture, error) {
+	character, err := authz.OwnedCharacter(ctx, s.db, s.logger, userID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	in, location, err := s.ownInterior(ctx, character)
+	if err != nil {
+		return nil, err
+	}
+	if !in.Walkable(x, y) || (geometry.Point{X: x, Y: y}) == in.Door {
+		return nil, status.Errorf(codes.InvalidArgument, "furniture must be placed on a floor cell other than the door")
+	}
+	if x == location.X && y == location.Y {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot place furniture where the character stands")
+	}
+	item, err := s.db.GetItem(ctx, itemID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "item not found")
+	}
+	if err != nil {
+		s.logger.Error("Failed to get item", "item_id", itemID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to place furniture")
+	}
+	if item.ItemType != FurnitureItemType {
+		return nil, status.Errorf(codes.InvalidArgument, "%s is not furniture", item.Name)
+	}
+
+	placed, err := s.db.PlaceFurniture(ctx, db.CreateInteriorFurnitureParams{
+		InteriorID: in.ID,
+		ItemID:     item.ID,
+		X:          x,
+		Y:          y,
+		PlacedBy:   character.ID,
+		PlacedAt:   pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
+	})
+	switch {
+	case errors.Is(err, ErrCellTaken):
+		return nil, status.Errorf(codes.AlreadyExists, "cell is already furnished")
+	case errors.Is(err, ErrNoFurnitureItem):
+		return nil, status.Errorf(codes.FailedPrecondition, "no %s in the inventory", item.Name)
+	case err != nil:
+		s.logger.Error("Failed to place furniture", "interior_id", in.ID, "item_id", itemID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to place furniture")
+	}
+	s.logger.Debug("Furniture placed", "interior_id", in.ID, "furniture_id", placed.ID, "item_id", itemID, "x", x, "y", y)
+	return furnitureToProto(placed, item.Name), nil
+}
+
+// RemoveFurniture picks a piece of furniture in the character's own interior back up
+// into its inventory
+func (s *Service) RemoveFurniture(ctx context.Context, userID, characterID string, furnitureID int64) (*interiorV1.Furniture, error) {
+	character, err := authz.OwnedCharacter(ctx, s.db, s.logger, userID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	in, _, err := s.ownInterior(ctx, character)
+	if err != nil {
+		return nil, err
+	}
+
+	removed, item, err := s.db.RemoveFurniture(ctx, db.DeleteInteriorFurnitureParams{ID: furnitureID, InteriorID: in.ID}, character)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, status.Errorf(codes.NotFound, "furniture not found")
+	case errors.Is(err, inventory.ErrInventoryFull):
+		return nil, status.Errorf(codes.ResourceExhausted, "inventory is full")
+	case err != nil:
+		s.logger.Error("Failed to remove furniture", "interior_id", in.ID, "furniture_id", furnitureID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to remove furniture")
+	}
+	s.logger.Debug("Furniture removed", "interior_id", in.ID, "furniture_id", furnitureID)
+	return furnitureToProto(removed, item.Name), nil
+}
+
+func furnitureToProto(row db.InteriorFurniture, itemName string) *interiorV1.Furniture {
+	return &interiorV1.Furniture{
+		Id:       row.ID,
+		ItemId:   row.ItemID,
+		ItemName: itemName,
+		X:        row.X,
+		Y:        row.Y,
+		PlacedAt: timestamppb.New(row.PlacedAt.Time),
+	}
+}
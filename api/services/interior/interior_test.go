@@ -0,0 +1,380 @@
+package interior
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/entity"
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	"github.com/VoidMesh/api/api/services/inventory"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeDB keeps interiors, the characters inside them, furniture and one character's
+// inventory in memory
+type fakeDB struct {
+	testmocks.Characters
+	items     map[int32]db.Item
+	inventory map[int32]int32
+	interiors map[int64]db.Interior
+	inside    map[[16]byte]db.CharacterInterior
+	furniture []db.InteriorFurniture
+	loads     int // GetInterior calls
+	full      bool
+}
+
+func (f *fakeDB) GetItem(ctx context.Context, id int32) (db.Item, error) {
+	item, ok := f.items[id]
+	if !ok {
+		return db.Item{}, pgx.ErrNoRows
+	}
+	return item, nil
+}
+
+func (f *fakeDB) GetInterior(ctx context.Context, id int64) (db.Interior, error) {
+	f.loads++
+	row, ok := f.interiors[id]
+	if !ok {
+		return db.Interior{}, pgx.ErrNoRows
+	}
+	return row, nil
+}
+
+func (f *fakeDB) GetInteriorByStructure(ctx context.Context, structureID int64) (db.Interior, error) {
+	for _, row := range f.interiors {
+		if row.StructureID == structureID {
+			return row, nil
+		}
+	}
+	return db.Interior{}, pgx.ErrNoRows
+}
+
+func (f *fakeDB) CreateInterior(ctx context.Context, arg db.CreateInteriorParams) (db.Interior, error) {
+	if _, err := f.GetInteriorByStructure(ctx, arg.StructureID); err == nil {
+		return db.Interior{}, pgx.ErrNoRows
+	}
+	row := db.Interior{
+		ID:          int64(len(f.interiors) + 1),
+		StructureID: arg.StructureID,
+		WorldID:     arg.WorldID,
+		Template:    arg.Template,
+		Width:       arg.Width,
+		Height:      arg.Height,
+		Cells:       arg.Cells,
+		DoorX:       arg.DoorX,
+		DoorY:       arg.DoorY,
+		CreatedAt:   arg.CreatedAt,
+	}
+	f.interiors[row.ID] = row
+	return row, nil
+}
+
+func (f *fakeDB) GetCharacterInterior(ctx context.Context, characterID pgtype.UUID) (db.CharacterInterior, error) {
+	row, ok := f.inside[characterID.Bytes]
+	if !ok {
+		return db.CharacterInterior{}, pgx.ErrNoRows
+	}
+	return row, nil
+}
+
+func (f *fakeDB) EnterInterior(ctx context.Context, arg db.EnterInteriorParams) (int64, error) {
+	if _, ok := f.inside[arg.CharacterID.Bytes]; ok {
+		return 0, nil
+	}
+	f.inside[arg.CharacterID.Bytes] = db.CharacterInterior(arg)
+	return 1, nil
+}
+
+func (f *fakeDB) UpdateCharacterInteriorPosition(ctx context.Context, arg db.UpdateCharacterInteriorPositionParams) (int64, error) {
+	row, ok := f.inside[arg.CharacterID.Bytes]
+	if !ok {
+		return 0, nil
+	}
+	row.X, row.Y = arg.X, arg.Y
+	f.inside[arg.CharacterID.Bytes] = row
+	return 1, nil
+}
+
+func (f *fakeDB) ExitInterior(ctx context.Context, characterID pgtype.UUID) (int64, error) {
+	if _, ok := f.inside[characterID.Bytes]; !ok {
+		return 0, nil
+	}
+	delete(f.inside, characterID.Bytes)
+	return 1, nil
+}
+
+func (f *fakeDB) ListInteriorFurniture(ctx context.Context, interiorID int64) ([]db.ListInteriorFurnitureRow, error) {
+	var rows []db.ListInteriorFurnitureRow
+	for _, row := range f.furniture {
+		if row.InteriorID == interiorID {
+			rows = append(rows, db.ListInteriorFurnitureRow{
+				ID: row.ID, InteriorID: row.InteriorID, ItemID: row.ItemID, X: row.X, Y: row.Y,
+				PlacedBy: row.PlacedBy, PlacedAt: row.PlacedAt, ItemName: f.items[row.ItemID].Name,
+			})
+		}
+	}
+	return rows, nil
+}
+
+func (f *fakeDB) InteriorFurnitureExistsAt(ctx context.Context, arg db.InteriorFurnitureExistsAtParams) (bool, error) {
+	for _, row := range f.furniture {
+		if row.InteriorID == arg.InteriorID && row.X == arg.X && row.Y == arg.Y {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (f *fakeDB) PlaceFurniture(ctx context.Context, arg db.CreateInteriorFurnitureParams) (db.InteriorFurniture, error) {
+	if taken, _ := f.InteriorFurnitureExistsAt(ctx, db.InteriorFurnitureExistsAtParams{InteriorID: arg.InteriorID, X: arg.X, Y: arg.Y}); taken {
+		return db.InteriorFurniture{}, ErrCellTaken
+	}
+	if f.inventory[arg.ItemID] < 1 {
+		return db.InteriorFurniture{}, ErrNoFurnitureItem
+	}
+	f.inventory[arg.ItemID]--
+	row := db.InteriorFurniture{
+		ID:         int64(len(f.furniture) + 1),
+		InteriorID: arg.InteriorID,
+		ItemID:     arg.ItemID,
+		X:          arg.X,
+		Y:          arg.Y,
+		PlacedBy:   arg.PlacedBy,
+		PlacedAt:   arg.PlacedAt,
+	}
+	f.furniture = append(f.furniture, row)
+	return row, nil
+}
+
+func (f *fakeDB) RemoveFurniture(ctx context.Context, arg db.DeleteInteriorFurnitureParams, character db.Character) (db.InteriorFurniture, db.Item, error) {
+	for i, row := range f.furniture {
+		if row.ID != arg.ID || row.InteriorID != arg.InteriorID {
+			continue
+		}
+		if f.full {
+			return db.InteriorFurniture{}, db.Item{}, inventory.ErrInventoryFull
+		}
+		f.furniture = append(f.furniture[:i], f.furniture[i+1:]...)
+		f.inventory[row.ItemID]++
+		return row, f.items[row.ItemID], nil
+	}
+	return db.InteriorFurniture{}, db.Item{}, pgx.ErrNoRows
+}
+
+type fakeStructures map[int64]*entity.Entity
+
+func (f fakeStructures) Get(ctx context.Context, id int64) (*entity.Entity, error) {
+	structure, ok := f[id]
+	if !ok {
+		return nil, entity.ErrNotFound
+	}
+	return structure, nil
+}
+
+const (
+	userID      = "550e8400-e29b-41d4-a716-446655440000"
+	characterID = "01000000-0000-0000-0000-000000000000"
+	visitorID   = "02000000-0000-0000-0000-000000000000"
+
+	cottageID  = 7
+	campfireID = 8
+	chairID    = 1
+	stoneID    = 2
+)
+
+var (
+	world     = pgtype.UUID{Bytes: [16]byte{9}, Valid: true}
+	userUUID  = pgtype.UUID{Bytes: [16]byte{0x55, 0x0e, 0x84, 0x00, 0xe2, 0x9b, 0x41, 0xd4, 0xa7, 0x16, 0x44, 0x66, 0x55, 0x44, 0x00, 0x00}, Valid: true}
+	character = db.Character{ID: pgtype.UUID{Bytes: [16]byte{1}, Valid: true}, UserID: userUUID, WorldID: world, X: 10, Y: 10}
+	visitor   = db.Character{ID: pgtype.UUID{Bytes: [16]byte{2}, Valid: true}, UserID: userUUID, WorldID: world, X: 10, Y: 11}
+)
+
+func newTestService(t *testing.T) (*Service, *fakeDB) {
+	cottage := &entity.Entity{ID: cottageID, WorldID: world, Type: "cottage", X: 10, Y: 9, Components: entity.Components{}}
+	require.NoError(t, cottage.Components.Set(entity.Owner{CharacterID: characterID}))
+	campfire := &entity.Entity{ID: campfireID, WorldID: world, Type: "campfire", X: 11, Y: 9, Components: entity.Components{}}
+
+	database := &fakeDB{
+		Characters: testmocks.NewCharacters(character, visitor),
+		items: map[int32]db.Item{
+			chairID: {ID: chairID, Name: "Wooden Chair", ItemType: FurnitureItemType},
+			stoneID: {ID: stoneID, Name: "Stone", ItemType: "material"},
+		},
+		inventory: map[int32]int32{chairID: 2, stoneID: 5},
+		interiors: make(map[int64]db.Interior),
+		inside:    make(map[[16]byte]db.CharacterInterior),
+	}
+	service := NewService(database, fakeStructures{cottageID: cottage, campfireID: campfire}, testmocks.NopLogger[LoggerInterface]{})
+	service.SetClock(clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)))
+	return service, database
+}
+
+func TestGenerate(t *testing.T) {
+	width, height, cells, door, err := generate(DefaultConfig().Templates[0])
+	require.NoError(t, err)
+	assert.Equal(t, int32(10), width)
+	assert.Equal(t, int32(7), height)
+	assert.Len(t, cells, 70)
+	assert.Equal(t, geometry.Point{X: 4, Y: 6}, door)
+	assert.Equal(t, byte(chunkV1.TerrainType_TERRAIN_TYPE_STONE), cells[0])
+	assert.Equal(t, byte(chunkV1.TerrainType_TERRAIN_TYPE_DIRT), cells[6*10+4])
+
+	_, _, _, _, err = generate(Template{ID: "ragged", Layout: []string{"###", "#D"}})
+	assert.Error(t, err)
+	_, _, _, _, err = generate(Template{ID: "sealed", Layout: []string{"###", "#.#", "###"}})
+	assert.Error(t, err, "a template needs exactly one door")
+	_, _, _, _, err = generate(Template{ID: "odd", Layout: []string{"#D?"}})
+	assert.Error(t, err)
+}
+
+func TestGet(t *testing.T) {
+	service, database := newTestService(t)
+	ctx := playerContext()
+
+	first, err := service.Get(ctx, userID, characterID, cottageID)
+	require.NoError(t, err)
+	assert.Equal(t, "cottage", first.Template)
+	assert.Len(t, first.Cells, 70)
+	assert.Equal(t, characterID, first.OwnerCharacterId)
+
+	again, err := service.Get(ctx, userID, characterID, cottageID)
+	require.NoError(t, err)
+	assert.Equal(t, first.Id, again.Id, "interiors are generated once")
+	assert.Len(t, database.interiors, 1)
+
+	_, err = service.Get(ctx, userID, characterID, campfireID)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "campfires have no interior")
+	_, err = service.Get(ctx, userID, characterID, 99)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = service.Get(ctx, "550e8400-e29b-41d4-a716-446655440001", characterID, cottageID)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestEnterMoveExit(t *testing.T) {
+	service, database := newTestService(t)
+	ctx := playerContext()
+	cottage, err := service.Structure(ctx, world, cottageID)
+	require.NoError(t, err)
+	_, err = service.Structure(ctx, pgtype.UUID{Bytes: [16]byte{8}, Valid: true}, cottageID)
+	assert.ErrorIs(t, err, entity.ErrNotFound, "structures in other worlds are not found")
+
+	location, err := service.Locate(ctx, character.ID)
+	require.NoError(t, err)
+	assert.Nil(t, location)
+
+	resp, err := service.Enter(ctx, character.ID, cottage)
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), resp.Position.X)
+	assert.Equal(t, int32(6), resp.Position.Y)
+	_, err = service.Enter(ctx, character.ID, cottage)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "characters enter one interior at a time")
+
+	interiorID := resp.Position.InteriorId
+	door, err := service.Door(ctx, interiorID)
+	require.NoError(t, err)
+	assert.Equal(t, geometry.Point{X: 4, Y: 6}, door)
+
+	passable, err := service.Passable(ctx, interiorID, 4, 5)
+	require.NoError(t, err)
+	assert.True(t, passable)
+	passable, err = service.Passable(ctx, interiorID, 0, 0)
+	require.NoError(t, err)
+	assert.False(t, passable, "walls are not passable")
+	passable, err = service.Passable(ctx, interiorID, 4, 7)
+	require.NoError(t, err)
+	assert.False(t, passable, "cells outside the interior are not passable")
+	assert.Zero(t, database.loads, "interiors opened are cached")
+
+	require.NoError(t, service.MoveTo(ctx, character.ID, 4, 5))
+	location, err = service.Locate(ctx, character.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int32(5), location.Y)
+
+	require.NoError(t, service.Exit(ctx, character.ID))
+	location, err = service.Locate(ctx, character.ID)
+	require.NoError(t, err)
+	assert.Nil(t, location)
+
+	campfire, err := service.Structure(ctx, world, campfireID)
+	require.NoError(t, err)
+	_, err = service.Enter(ctx, character.ID, campfire)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestFurniture(t *testing.T) {
+	service, database := newTestService(t)
+	ctx := playerContext()
+
+	_, err := service.PlaceFurniture(ctx, userID, characterID, chairID, 2, 2)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "only characters inside can furnish")
+
+	cottage, err := service.Structure(ctx, world, cottageID)
+	require.NoError(t, err)
+	resp, err := service.Enter(ctx, character.ID, cottage)
+	require.NoError(t, err)
+	interiorID := resp.Position.InteriorId
+
+	_, err = service.PlaceFurniture(ctx, userID, characterID, chairID, 0, 0)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "furniture goes on the floor")
+	_, err = service.PlaceFurniture(ctx, userID, characterID, chairID, 4, 6)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "the door stays clear")
+	require.NoError(t, service.MoveTo(ctx, character.ID, 4, 5))
+	_, err = service.PlaceFurniture(ctx, userID, characterID, chairID, 4, 5)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = service.PlaceFurniture(ctx, userID, characterID, stoneID, 2, 2)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "only furniture items can be placed")
+
+	placed, err := service.PlaceFurniture(ctx, userID, characterID, chairID, 2, 2)
+	require.NoError(t, err)
+	assert.Equal(t, "Wooden Chair", placed.ItemName)
+	assert.Equal(t, int32(1), database.inventory[chairID])
+	_, err = service.PlaceFurniture(ctx, userID, characterID, chairID, 2, 2)
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	passable, err := service.Passable(ctx, interiorID, 2, 2)
+	require.NoError(t, err)
+	assert.False(t, passable, "furniture blocks movement")
+
+	described, err := service.Get(ctx, userID, characterID, cottageID)
+	require.NoError(t, err)
+	require.Len(t, described.Furniture, 1)
+	assert.Equal(t, "Wooden Chair", described.Furniture[0].ItemName)
+
+	_, err = service.PlaceFurniture(ctx, userID, characterID, chairID, 3, 2)
+	require.NoError(t, err)
+	_, err = service.PlaceFurniture(ctx, userID, characterID, chairID, 5, 2)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "no chairs left")
+
+	// Visitors can walk in but not rearrange
+	_, err = service.Enter(ctx, visitor.ID, cottage)
+	require.NoError(t, err)
+	_, err = service.RemoveFurniture(ctx, userID, visitorID, placed.Id)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	database.full = true
+	_, err = service.RemoveFurniture(ctx, userID, characterID, placed.Id)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	database.full = false
+	removed, err := service.RemoveFurniture(ctx, userID, characterID, placed.Id)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), removed.X)
+	assert.Equal(t, int32(1), database.inventory[chairID])
+	_, err = service.RemoveFurniture(ctx, userID, characterID, placed.Id)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// playerContext is the context of a session bound to the test world
+func playerContext() context.Context {
+	return session.WithWorldID(context.Background(), uuid.PgtypeToString(world))
+}
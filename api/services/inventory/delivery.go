@@ -0,0 +1,402 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/alerting"
+	"github.com/VoidMesh/api/api/internal/authz"
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	inventoryV1 "github.com/VoidMesh/api/api/proto/inventory/v1"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Overflow policies as stored in world_inventory_settings.harvest_overflow
+const (
+	OverflowReject = "reject"
+	OverflowGround = "ground"
+	OverflowInbox  = "inbox"
+)
+
+const (
+	// GroundDropLifetime is how long overflow stays on the ground before it is lost
+	GroundDropLifetime = time.Hour
+	// GroundDropExpiryInterval is how often expired ground drops are deleted
+	GroundDropExpiryInterval = 5 * time.Minute
+	// PickupRange is how far, in world cells, a character reaches for ground drops
+	PickupRange = 3
+
+	// ReasonInventoryFull is the ErrorInfo reason attached to ResourceExhausted errors
+	ReasonInventoryFull = "INVENTORY_FULL"
+	errorDomain         = "inventory.voidmesh"
+)
+
+var (
+	ErrInventoryFull = errors.New("inventory is full")
+	ErrOutOfReach    = errors.New("ground drop is out of reach")
+)
+
+// overflowPolicies maps stored overflow policies to their proto values
+var overflowPolicies = map[string]inventoryV1.OverflowPolicy{
+	OverflowReject: inventoryV1.OverflowPolicy_OVERFLOW_POLICY_REJECT,
+	OverflowGround: inventoryV1.OverflowPolicy_OVERFLOW_POLICY_GROUND,
+	OverflowInbox:  inventoryV1.OverflowPolicy_OVERFLOW_POLICY_INBOX,
+}
+
+// Grant is a quantity of one item to put into an inventory
+type Grant struct {
+	ItemID    int32
+	StackSize int32
+	Quantity  int32
+}
+
+// Event is a domain event enqueued in the same transaction as a delivery
+type Event struct {
+	Type        string
+	AggregateID string
+	DedupKey    string
+	Payload     any
+}
+
+// Delivery grants items to a character. Whatever does not fit is handled by the world's
+// overflow policy: the delivery fails, or the rest is dropped on the ground at X, Y or
+// held in the character's inbox. Grants, overflow and Event commit together.
+type Delivery struct {
+	CharacterID pgtype.UUID
+	WorldID     pgtype.UUID
+	X, Y        int32 // Where overflow lands on the ground
+	Grants      []Grant
+	Reason      string // Recorded on inbox items
+	Event       *Event
+	Now         time.Time
+	// DropLifetime is how long overflow stays on the ground; Deliver sets it from
+	// GroundDropLifetime and the world's time scale
+	DropLifetime time.Duration
+}
+
+// DeliveredGrant is the outcome of one grant of a delivery
+type DeliveredGrant struct {
+	Grant
+	Granted  int32
+	Overflow int32
+	Stack    db.CharacterInventory // The stack the granted items went to; zero if none fit
+}
+
+// DeliveryResult is the outcome of a delivery
+type DeliveryResult struct {
+	Grants []DeliveredGrant
+	Policy string // The world's overflow policy
+}
+
+// TransferParams moves a ground drop or inbox item into a character's inventory
+type TransferParams struct {
+	Character db.Character
+	ID        int64 // Ground drop or inbox item
+	Now       time.Time
+}
+
+// Transfer is the outcome of moving a ground drop or inbox item into an inventory
+type Transfer struct {
+	Stack     db.CharacterInventory
+	Moved     int32
+	Remaining int32
+}
+
+// capacity tracks the free room of an inventory while items are put into it. Every stack
+// takes one slot per stack size items, rounded up.
+type capacity struct {
+	slots      int32 // 0: unlimited
+	quantities map[int32]int32
+	stackSizes map[int32]int32
+}
+
+func newCapacity(slots pgtype.Int4, rows []db.GetCharacterInventoryRow) *capacity {
+	c := &capacity{
+		quantities: make(map[int32]int32, len(rows)),
+		stackSizes: make(map[int32]int32, len(rows)),
+	}
+	if slots.Valid {
+		c.slots = slots.Int32
+	}
+	for _, row := range rows {
+		c.quantities[row.ItemID] += row.Quantity
+		c.stackSizes[row.ItemID] = row.StackSize
+	}
+	return c
+}
+
+// used returns the number of occupied slots
+func (c *capacity) used() int32 {
+	var used int32
+	for itemID, quantity := range c.quantities {
+		used += slotsFor(quantity, c.stackSizes[itemID])
+	}
+	return used
+}
+
+// take returns how much of quantity fits and reserves room for it
+func (c *capacity) take(itemID, stackSize, quantity int32) int32 {
+	if c.slots == 0 {
+		c.quantities[itemID] += quantity
+		return quantity
+	}
+	if known, ok := c.stackSizes[itemID]; ok {
+		stackSize = known
+	}
+	if stackSize < 1 {
+		stackSize = 1
+	}
+	existing := c.quantities[itemID]
+	room := int64(slotsFor(existing, stackSize))*int64(stackSize) - int64(existing)
+	if free := c.slots - c.used(); free > 0 {
+		room += int64(free) * int64(stackSize)
+	}
+	fit := int32(min(int64(quantity), max(room, 0)))
+	if fit > 0 {
+		c.quantities[itemID] = existing + fit
+		c.stackSizes[itemID] = stackSize
+	}
+	return fit
+}
+
+func slotsFor(quantity, stackSize int32) int32 {
+	if stackSize < 1 {
+		stackSize = 1
+	}
+	return int32((int64(quantity) + int64(stackSize) - 1) / int64(stackSize))
+}
+
+// Deliver grants items to a character, handling what does not fit by the world's
+// overflow policy. Fails with ResourceExhausted when the policy rejects overflow.
+func (s *Service) Deliver(ctx context.Context, delivery Delivery) (*DeliveryResult, error) {
+	delivery.Now = s.clock.Now()
+	delivery.DropLifetime = GroundDropLifetime
+	if s.timeScale != nil {
+		delivery.DropLifetime = s.timeScale.Scale(ctx, delivery.WorldID, GroundDropLifetime)
+	}
+	result, err := s.db.DeliverItems(ctx, delivery)
+	if errors.Is(err, ErrInventoryFull) {
+		return nil, inventoryFullError()
+	}
+	if err != nil {
+		s.logger.Error("Failed to deliver items", "character_id", uuid.PgtypeToString(delivery.CharacterID), "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to deliver items")
+	}
+	for _, grant := range result.Grants {
+		if grant.Overflow > 0 {
+			s.logger.Debug("Delivered overflow",
+				"character_id", uuid.PgtypeToString(delivery.CharacterID),
+				"item_id", grant.ItemID,
+				"overflow", grant.Overflow,
+				"policy", result.Policy)
+		}
+	}
+	return &result, nil
+}
+
+// OverflowPolicyProto converts a stored overflow policy to its proto value
+func OverflowPolicyProto(policy string) inventoryV1.OverflowPolicy {
+	return overflowPolicies[policy]
+}
+
+// ListGroundDrops returns the unexpired ground drops within reach of one of the user's characters
+func (s *Service) ListGroundDrops(ctx context.Context, userID, characterID string) ([]*inventoryV1.GroundDrop, error) {
+	character, err := s.OwnedCharacter(ctx, userID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.db.ListGroundDropsNear(ctx, db.ListGroundDropsNearParams{
+		WorldID: character.WorldID,
+		MinX:    character.X - PickupRange,
+		MaxX:    character.X + PickupRange,
+		MinY:    character.Y - PickupRange,
+		MaxY:    character.Y + PickupRange,
+		Now:     pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
+	})
+	if err != nil {
+		s.logger.Error("Failed to list ground drops", "character_id", characterID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list ground drops")
+	}
+
+	drops := make([]*inventoryV1.GroundDrop, 0, len(rows))
+	for _, row := range rows {
+		if !inPickupRange(character, row.X, row.Y) {
+			continue
+		}
+		drops = append(drops, &inventoryV1.GroundDrop{
+			Id:        row.ID,
+			ItemId:    row.ItemID,
+			ItemName:  row.ItemName,
+			Quantity:  row.Quantity,
+			X:         row.X,
+			Y:         row.Y,
+			ExpiresAt: timestamppb.New(row.ExpiresAt.Time),
+		})
+	}
+	return drops, nil
+}
+
+// PickUpGroundDrop moves as much of a ground drop as fits into the character's inventory
+func (s *Service) PickUpGroundDrop(ctx context.Context, userID, characterID string, dropID int64) (*inventoryV1.PickUpGroundDropResponse, error) {
+	character, err := s.OwnedCharacter(ctx, userID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	transfer, err := s.db.PickUpGroundDrop(ctx, TransferParams{Character: *character, ID: dropID, Now: s.clock.Now()})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, status.Errorf(codes.NotFound, "ground drop not found")
+	case errors.Is(err, ErrOutOfReach):
+		return nil, status.Errorf(codes.FailedPrecondition, "character is too far from ground drop")
+	case errors.Is(err, ErrInventoryFull):
+		return nil, inventoryFullError()
+	case err != nil:
+		s.logger.Error("Failed to pick up ground drop", "character_id", characterID, "drop_id", dropID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to pick up ground drop")
+	}
+
+	item, err := s.dbInventoryItemToProto(ctx, transfer.Stack)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to process inventory item")
+	}
+	s.logger.Debug("Picked up ground drop", "character_id", characterID, "drop_id", dropID, "picked_up", transfer.Moved, "remaining", transfer.Remaining)
+	return &inventoryV1.PickUpGroundDropResponse{
+		Item:      item,
+		PickedUp:  transfer.Moved,
+		Remaining: transfer.Remaining,
+	}, nil
+}
+
+// ListInboxItems returns the items held in the inbox of one of the user's characters
+func (s *Service) ListInboxItems(ctx context.Context, userID, characterID string) ([]*inventoryV1.InboxItem, error) {
+	character, err := s.OwnedCharacter(ctx, userID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.db.ListInboxItems(ctx, character.ID)
+	if err != nil {
+		s.logger.Error("Failed to list inbox items", "character_id", characterID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list inbox items")
+	}
+
+	items := make([]*inventoryV1.InboxItem, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, &inventoryV1.InboxItem{
+			Id:        row.ID,
+			ItemId:    row.ItemID,
+			ItemName:  row.ItemName,
+			Quantity:  row.Quantity,
+			Reason:    row.Reason,
+			CreatedAt: timestamppb.New(row.CreatedAt.Time),
+		})
+	}
+	return items, nil
+}
+
+// ClaimInboxItem moves as much of an inbox item as fits into the character's inventory
+func (s *Service) ClaimInboxItem(ctx context.Context, userID, characterID string, inboxItemID int64) (*inventoryV1.ClaimInboxItemResponse, error) {
+	character, err := s.OwnedCharacter(ctx, userID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	transfer, err := s.db.ClaimInboxItem(ctx, TransferParams{Character: *character, ID: inboxItemID, Now: s.clock.Now()})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, status.Errorf(codes.NotFound, "inbox item not found")
+	case errors.Is(err, ErrInventoryFull):
+		return nil, inventoryFullError()
+	case err != nil:
+		s.logger.Error("Failed to claim inbox item", "character_id", characterID, "inbox_item_id", inboxItemID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to claim inbox item")
+	}
+
+	item, err := s.dbInventoryItemToProto(ctx, transfer.Stack)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to process inventory item")
+	}
+	s.logger.Debug("Claimed inbox item", "character_id", characterID, "inbox_item_id", inboxItemID, "claimed", transfer.Moved, "remaining", transfer.Remaining)
+	return &inventoryV1.ClaimInboxItemResponse{
+		Item:      item,
+		Claimed:   transfer.Moved,
+		Remaining: transfer.Remaining,
+	}, nil
+}
+
+// ExpireGroundDrops deletes ground drops past their expiry
+func (s *Service) ExpireGroundDrops(ctx context.Context) (int64, error) {
+	deleted, err := s.db.DeleteExpiredGroundDrops(ctx, pgtype.Timestamp{Time: s.clock.Now(), Valid: true})
+	if err != nil {
+		return 0, fmt.Errorf("failed to expire ground drops: %w", err)
+	}
+	return deleted, nil
+}
+
+// Run expires ground drops every GroundDropExpiryInterval until ctx is cancelled
+func (s *Service) Run(ctx context.Context) {
+	s.logger.Info("Ground drop expiry started", "interval", GroundDropExpiryInterval, "lifetime", GroundDropLifetime)
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("Ground drop expiry stopped")
+			return
+		case <-s.clock.After(GroundDropExpiryInterval):
+		}
+
+		expired, err := s.ExpireGroundDrops(ctx)
+		if err != nil {
+			s.logger.Error("Ground drop expiry failed", "error", err)
+			alerting.ReportJobError("ground_drop_expiry", err)
+			continue
+		}
+		if expired > 0 {
+			s.logger.Debug("Ground drops expired", "deleted", expired)
+		}
+	}
+}
+
+// OwnedCharacter returns the character if it belongs to the user and is in the world
+// the session is bound to
+func (s *Service) OwnedCharacter(ctx context.Context, userID, characterID string) (*db.Character, error) {
+	if !uuid.ValidateFormat(characterID) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid character ID format")
+	}
+	character, err := s.characterService.GetCharacterByID(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authz.CheckOwner(*character, userID); err != nil {
+		return nil, err
+	}
+	if err := session.RequireWorld(ctx, character.WorldID); err != nil {
+		return nil, err
+	}
+	return character, nil
+}
+
+func inPickupRange(character *db.Character, x, y int32) bool {
+	return geometry.InRange(geometry.Point{X: character.X, Y: character.Y}, geometry.Point{X: x, Y: y}, PickupRange)
+}
+
+// inventoryFullError builds the ResourceExhausted status returned when items do not fit.
+// Clients can match on the INVENTORY_FULL ErrorInfo reason.
+func inventoryFullError() error {
+	st := status.New(codes.ResourceExhausted, ErrInventoryFull.Error())
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: ReasonInventoryFull,
+		Domain: errorDomain,
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
@@ -0,0 +1,210 @@
+package inventory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	adminV1 "github.com/VoidMesh/api/api/proto/admin/v1"
+	inventoryV1 "github.com/VoidMesh/api/api/proto/inventory/v1"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	deliveryUserID      = "12345678-9abc-def0-1234-56789abcdef0"
+	deliveryCharacterID = "550e8400e29b41d4a716446655440000"
+	deliveryWorldID     = "650e8400e29b41d4a716446655440000"
+)
+
+var deliveryNow = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func newDeliveryService(mockDB *MockDatabaseInterface, characters *MockCharacterServiceInterface) *Service {
+	return &Service{db: mockDB, characterService: characters, logger: testmocks.NopLogger[LoggerInterface]{}, clock: clock.NewFake(deliveryNow)}
+}
+
+func deliveryCharacter() *db.Character {
+	return &db.Character{
+		ID:      createTestCharacterUUID(deliveryCharacterID),
+		UserID:  createTestCharacterUUID("123456789abcdef0123456789abcdef0"),
+		WorldID: createTestCharacterUUID(deliveryWorldID),
+		X:       10,
+		Y:       10,
+	}
+}
+
+// deliveryContext is the context of a session bound to the delivery character's world
+func deliveryContext() context.Context {
+	return session.WithWorldID(context.Background(), deliveryWorldID)
+}
+
+func TestCapacity_Take(t *testing.T) {
+	rows := []db.GetCharacterInventoryRow{
+		{ItemID: 1, Quantity: 50, StackSize: 64}, // One slot, 14 free in the stack
+		{ItemID: 2, Quantity: 10, StackSize: 10}, // One full slot
+	}
+	tests := []struct {
+		name     string
+		slots    pgtype.Int4
+		itemID   int32
+		quantity int32
+		want     int32
+	}{
+		{name: "unlimited", slots: pgtype.Int4{}, itemID: 3, quantity: 1000, want: 1000},
+		{name: "tops up a partial stack", slots: pgtype.Int4{Int32: 2, Valid: true}, itemID: 1, quantity: 20, want: 14},
+		{name: "fills free slots", slots: pgtype.Int4{Int32: 4, Valid: true}, itemID: 3, quantity: 30, want: 20},
+		{name: "full stack has no room", slots: pgtype.Int4{Int32: 2, Valid: true}, itemID: 2, quantity: 5, want: 0},
+		{name: "partial stack and free slot", slots: pgtype.Int4{Int32: 3, Valid: true}, itemID: 1, quantity: 100, want: 78},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			room := newCapacity(tt.slots, rows)
+			assert.Equal(t, tt.want, room.take(tt.itemID, 10, tt.quantity))
+		})
+	}
+
+	t.Run("grants share the room", func(t *testing.T) {
+		room := newCapacity(pgtype.Int4{Int32: 3, Valid: true}, rows)
+		assert.Equal(t, int32(5), room.take(3, 5, 5), "the last free slot")
+		assert.Equal(t, int32(0), room.take(4, 5, 5), "no slot left")
+		assert.Equal(t, int32(14), room.take(1, 64, 30), "existing stacks still top up")
+	})
+}
+
+func TestService_Deliver_InventoryFull(t *testing.T) {
+	mockDB := &MockDatabaseInterface{}
+	mockDB.On("DeliverItems", mock.Anything, mock.MatchedBy(func(delivery Delivery) bool {
+		return delivery.Now.Equal(deliveryNow)
+	})).Return(DeliveryResult{}, ErrInventoryFull)
+	service := newDeliveryService(mockDB, nil)
+
+	_, err := service.Deliver(context.Background(), Delivery{Grants: []Grant{{ItemID: 1, StackSize: 10, Quantity: 5}}})
+	require.Error(t, err)
+	st := status.Convert(err)
+	assert.Equal(t, codes.ResourceExhausted, st.Code())
+	require.Len(t, st.Details(), 1)
+	assert.Equal(t, ReasonInventoryFull, st.Details()[0].(*errdetails.ErrorInfo).Reason)
+}
+
+func TestService_PickUpGroundDrop(t *testing.T) {
+	ctx := deliveryContext()
+
+	t.Run("picks up what fits", func(t *testing.T) {
+		mockDB := &MockDatabaseInterface{}
+		characters := &MockCharacterServiceInterface{}
+		character := deliveryCharacter()
+		characters.On("GetCharacterByID", ctx, deliveryCharacterID).Return(character, nil)
+		mockDB.On("PickUpGroundDrop", ctx, TransferParams{Character: *character, ID: 7, Now: deliveryNow}).Return(Transfer{
+			Stack:     createTestInventoryItem(3, deliveryCharacterID, 1, 10),
+			Moved:     4,
+			Remaining: 2,
+		}, nil)
+
+		resp, err := newDeliveryService(mockDB, characters).PickUpGroundDrop(ctx, deliveryUserID, deliveryCharacterID, 7)
+		require.NoError(t, err)
+		assert.Equal(t, int32(4), resp.PickedUp)
+		assert.Equal(t, int32(2), resp.Remaining)
+		assert.Equal(t, int32(10), resp.Item.Quantity)
+	})
+
+	t.Run("other users' characters are refused", func(t *testing.T) {
+		characters := &MockCharacterServiceInterface{}
+		characters.On("GetCharacterByID", ctx, deliveryCharacterID).Return(deliveryCharacter(), nil)
+
+		_, err := newDeliveryService(&MockDatabaseInterface{}, characters).PickUpGroundDrop(ctx, "00000000-0000-0000-0000-000000000001", deliveryCharacterID, 7)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("characters outside the session's world are refused", func(t *testing.T) {
+		elsewhere := session.WithWorldID(context.Background(), "00000000-0000-0000-0000-0000000000bb")
+		characters := &MockCharacterServiceInterface{}
+		characters.On("GetCharacterByID", elsewhere, deliveryCharacterID).Return(deliveryCharacter(), nil)
+
+		_, err := newDeliveryService(&MockDatabaseInterface{}, characters).PickUpGroundDrop(elsewhere, deliveryUserID, deliveryCharacterID, 7)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	errorCodes := map[error]codes.Code{
+		pgx.ErrNoRows:    codes.NotFound,
+		ErrOutOfReach:    codes.FailedPrecondition,
+		ErrInventoryFull: codes.ResourceExhausted,
+	}
+	for dbErr, code := range errorCodes {
+		t.Run(dbErr.Error(), func(t *testing.T) {
+			mockDB := &MockDatabaseInterface{}
+			characters := &MockCharacterServiceInterface{}
+			characters.On("GetCharacterByID", ctx, deliveryCharacterID).Return(deliveryCharacter(), nil)
+			mockDB.On("PickUpGroundDrop", ctx, mock.Anything).Return(Transfer{}, dbErr)
+
+			_, err := newDeliveryService(mockDB, characters).PickUpGroundDrop(ctx, deliveryUserID, deliveryCharacterID, 7)
+			assert.Equal(t, code, status.Code(err))
+		})
+	}
+}
+
+func TestService_ListGroundDrops_WithinReach(t *testing.T) {
+	ctx := deliveryContext()
+	mockDB := &MockDatabaseInterface{}
+	characters := &MockCharacterServiceInterface{}
+	characters.On("GetCharacterByID", ctx, deliveryCharacterID).Return(deliveryCharacter(), nil)
+	mockDB.On("ListGroundDropsNear", ctx, mock.MatchedBy(func(arg db.ListGroundDropsNearParams) bool {
+		return arg.MinX == 7 && arg.MaxX == 13 && arg.MinY == 7 && arg.MaxY == 13 && arg.Now.Time.Equal(deliveryNow)
+	})).Return([]db.ListGroundDropsNearRow{
+		{ID: 1, ItemID: 1, ItemName: "Wood", Quantity: 3, X: 12, Y: 12},
+		{ID: 2, ItemID: 1, ItemName: "Wood", Quantity: 3, X: 13, Y: 13}, // In the box, out of reach
+	}, nil)
+
+	drops, err := newDeliveryService(mockDB, characters).ListGroundDrops(ctx, deliveryUserID, deliveryCharacterID)
+	require.NoError(t, err)
+	require.Len(t, drops, 1)
+	assert.Equal(t, int64(1), drops[0].Id)
+}
+
+func TestService_WorldSettings(t *testing.T) {
+	ctx := context.Background()
+	worldID := "00000000-0000-0000-0000-0000000000aa"
+
+	t.Run("unconfigured worlds use the defaults", func(t *testing.T) {
+		mockDB := &MockDatabaseInterface{}
+		mockDB.On("GetWorldInventorySettings", ctx, mock.Anything).Return(db.WorldInventorySetting{}, pgx.ErrNoRows)
+
+		settings, err := newDeliveryService(mockDB, nil).WorldSettings(ctx, worldID)
+		require.NoError(t, err)
+		assert.Equal(t, int32(0), settings.InventorySlots)
+		assert.Equal(t, inventoryV1.OverflowPolicy_OVERFLOW_POLICY_REJECT, settings.HarvestOverflow)
+	})
+
+	t.Run("set stores the policy", func(t *testing.T) {
+		mockDB := &MockDatabaseInterface{}
+		mockDB.On("UpsertWorldInventorySettings", ctx, mock.MatchedBy(func(arg db.UpsertWorldInventorySettingsParams) bool {
+			return arg.InventorySlots == pgtype.Int4{Int32: 24, Valid: true} && arg.HarvestOverflow == OverflowInbox
+		})).Return(db.WorldInventorySetting{InventorySlots: pgtype.Int4{Int32: 24, Valid: true}, HarvestOverflow: OverflowInbox}, nil)
+
+		settings, err := newDeliveryService(mockDB, nil).SetWorldSettings(ctx, deliveryUserID, &adminV1.SetWorldInventorySettingsRequest{
+			WorldId:         worldID,
+			InventorySlots:  24,
+			HarvestOverflow: inventoryV1.OverflowPolicy_OVERFLOW_POLICY_INBOX,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(24), settings.InventorySlots)
+		assert.Equal(t, inventoryV1.OverflowPolicy_OVERFLOW_POLICY_INBOX, settings.HarvestOverflow)
+	})
+
+	t.Run("invalid settings are rejected", func(t *testing.T) {
+		service := newDeliveryService(&MockDatabaseInterface{}, nil)
+		_, err := service.SetWorldSettings(ctx, deliveryUserID, &adminV1.SetWorldInventorySettingsRequest{WorldId: worldID, InventorySlots: -1})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = service.SetWorldSettings(ctx, deliveryUserID, &adminV1.SetWorldInventorySettingsRequest{WorldId: worldID, HarvestOverflow: 42})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -2,34 +2,53 @@ package inventory
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/outbox"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/txn"
 	resourceNodeV1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
 	"github.com/VoidMesh/api/api/services/character"
 	"github.com/VoidMesh/api/api/services/resource_node"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
-	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 // DatabaseInterface abstracts database operations for the inventory service.
 type DatabaseInterface interface {
 	GetCharacterInventory(ctx context.Context, characterID pgtype.UUID) ([]db.GetCharacterInventoryRow, error)
-	InventoryItemExists(ctx context.Context, arg db.InventoryItemExistsParams) (bool, error)
-	AddInventoryItemQuantity(ctx context.Context, arg db.AddInventoryItemQuantityParams) (db.CharacterInventory, error)
-	CreateInventoryItem(ctx context.Context, arg db.CreateInventoryItemParams) (db.CharacterInventory, error)
-	RemoveInventoryItemQuantity(ctx context.Context, arg db.RemoveInventoryItemQuantityParams) (db.CharacterInventory, error)
-	DeleteInventoryItem(ctx context.Context, arg db.DeleteInventoryItemParams) error
+	GrantInventoryItem(ctx context.Context, arg db.CreateInventoryItemParams) (db.CharacterInventory, error)
+	TakeInventoryItem(ctx context.Context, arg db.RemoveInventoryItemQuantityParams) (db.CharacterInventory, error)
 	GetResourceNode(ctx context.Context, id int32) (db.ResourceNode, error)
+	ListInventoryFlags(ctx context.Context, characterID pgtype.UUID) ([]db.CharacterInventoryFlag, error)
+	UpsertInventoryFlags(ctx context.Context, arg db.UpsertInventoryFlagsParams) (db.CharacterInventoryFlag, error)
+	SetInventorySortPositions(ctx context.Context, arg db.SetInventorySortPositionsParams) error
+	ListDuplicateInventoryStacks(ctx context.Context) ([]db.ListDuplicateInventoryStacksRow, error)
+	ListOverfullInventoryStacks(ctx context.Context) ([]db.ListOverfullInventoryStacksRow, error)
+	MergeInventoryStacks(ctx context.Context, arg db.MergeInventoryStacksParams) (int32, error)
+	DeliverItems(ctx context.Context, delivery Delivery) (DeliveryResult, error)
+	ListGroundDropsNear(ctx context.Context, arg db.ListGroundDropsNearParams) ([]db.ListGroundDropsNearRow, error)
+	PickUpGroundDrop(ctx context.Context, arg TransferParams) (Transfer, error)
+	DeleteExpiredGroundDrops(ctx context.Context, now pgtype.Timestamp) (int64, error)
+	ListInboxItems(ctx context.Context, characterID pgtype.UUID) ([]db.ListInboxItemsRow, error)
+	ClaimInboxItem(ctx context.Context, arg TransferParams) (Transfer, error)
+	GetWorldInventorySettings(ctx context.Context, worldID pgtype.UUID) (db.WorldInventorySetting, error)
+	UpsertWorldInventorySettings(ctx context.Context, arg db.UpsertWorldInventorySettingsParams) (db.WorldInventorySetting, error)
 }
 
 // DatabaseWrapper implements DatabaseInterface using the actual database connection.
 type DatabaseWrapper struct {
+	pool    storage.Pool
 	queries *db.Queries
 }
 
 // NewDatabaseWrapper creates a new database wrapper with the given connection pool.
-func NewDatabaseWrapper(pool *pgxpool.Pool) DatabaseInterface {
+func NewDatabaseWrapper(pool storage.Pool) DatabaseInterface {
 	return &DatabaseWrapper{
+		pool:    pool,
 		queries: db.New(pool),
 	}
 }
@@ -38,33 +57,325 @@ func (d *DatabaseWrapper) GetCharacterInventory(ctx context.Context, characterID
 	return d.queries.GetCharacterInventory(ctx, characterID)
 }
 
-func (d *DatabaseWrapper) InventoryItemExists(ctx context.Context, arg db.InventoryItemExistsParams) (bool, error) {
-	return d.queries.InventoryItemExists(ctx, arg)
+// GrantInventoryItem adds quantity to the character's stack of the item, creating the
+// stack if it has none. The existence check and the write run in one serializable
+// transaction, so two concurrent grants cannot both try to create the stack.
+func (d *DatabaseWrapper) GrantInventoryItem(ctx context.Context, arg db.CreateInventoryItemParams) (db.CharacterInventory, error) {
+	var item db.CharacterInventory
+	err := txn.Run(ctx, d.pool, txn.Serializable, func(q *db.Queries) error {
+		var err error
+		item, err = grantInTx(ctx, q, arg)
+		return err
+	})
+	return item, err
 }
 
-func (d *DatabaseWrapper) AddInventoryItemQuantity(ctx context.Context, arg db.AddInventoryItemQuantityParams) (db.CharacterInventory, error) {
-	return d.queries.AddInventoryItemQuantity(ctx, arg)
+func grantInTx(ctx context.Context, q *db.Queries, arg db.CreateInventoryItemParams) (db.CharacterInventory, error) {
+	exists, err := q.InventoryItemExists(ctx, db.InventoryItemExistsParams{
+		CharacterID: arg.CharacterID,
+		ItemID:      arg.ItemID,
+	})
+	if err != nil {
+		return db.CharacterInventory{}, fmt.Errorf("failed to check inventory item existence: %w", err)
+	}
+	if exists {
+		return q.AddInventoryItemQuantity(ctx, db.AddInventoryItemQuantityParams(arg))
+	}
+	return q.CreateInventoryItem(ctx, arg)
+}
+
+// TakeInventoryItem removes quantity from the character's stack of the item and deletes
+// the stack once it is empty. Both run in one serializable transaction, so an item
+// granted in between is never deleted along with the emptied stack.
+func (d *DatabaseWrapper) TakeInventoryItem(ctx context.Context, arg db.RemoveInventoryItemQuantityParams) (db.CharacterInventory, error) {
+	var item db.CharacterInventory
+	err := txn.Run(ctx, d.pool, txn.Serializable, func(q *db.Queries) error {
+		var err error
+		item, err = q.RemoveInventoryItemQuantity(ctx, arg)
+		if err != nil {
+			return err
+		}
+		if item.Quantity > 0 {
+			return nil
+		}
+		if err := q.DeleteInventoryItem(ctx, db.DeleteInventoryItemParams{
+			CharacterID: arg.CharacterID,
+			ItemID:      arg.ItemID,
+		}); err != nil {
+			return fmt.Errorf("failed to delete empty inventory item: %w", err)
+		}
+		return nil
+	})
+	return item, err
 }
 
-func (d *DatabaseWrapper) CreateInventoryItem(ctx context.Context, arg db.CreateInventoryItemParams) (db.CharacterInventory, error) {
-	return d.queries.CreateInventoryItem(ctx, arg)
+func (d *DatabaseWrapper) GetResourceNode(ctx context.Context, id int32) (db.ResourceNode, error) {
+	return d.queries.GetResourceNode(ctx, id)
 }
 
-func (d *DatabaseWrapper) RemoveInventoryItemQuantity(ctx context.Context, arg db.RemoveInventoryItemQuantityParams) (db.CharacterInventory, error) {
-	return d.queries.RemoveInventoryItemQuantity(ctx, arg)
+func (d *DatabaseWrapper) ListInventoryFlags(ctx context.Context, characterID pgtype.UUID) ([]db.CharacterInventoryFlag, error) {
+	return d.queries.ListInventoryFlags(ctx, characterID)
 }
 
-func (d *DatabaseWrapper) DeleteInventoryItem(ctx context.Context, arg db.DeleteInventoryItemParams) error {
-	return d.queries.DeleteInventoryItem(ctx, arg)
+func (d *DatabaseWrapper) UpsertInventoryFlags(ctx context.Context, arg db.UpsertInventoryFlagsParams) (db.CharacterInventoryFlag, error) {
+	return d.queries.UpsertInventoryFlags(ctx, arg)
 }
 
-func (d *DatabaseWrapper) GetResourceNode(ctx context.Context, id int32) (db.ResourceNode, error) {
-	return d.queries.GetResourceNode(ctx, id)
+func (d *DatabaseWrapper) SetInventorySortPositions(ctx context.Context, arg db.SetInventorySortPositionsParams) error {
+	return d.queries.SetInventorySortPositions(ctx, arg)
+}
+
+func (d *DatabaseWrapper) ListDuplicateInventoryStacks(ctx context.Context) ([]db.ListDuplicateInventoryStacksRow, error) {
+	return d.queries.ListDuplicateInventoryStacks(ctx)
+}
+
+func (d *DatabaseWrapper) ListOverfullInventoryStacks(ctx context.Context) ([]db.ListOverfullInventoryStacksRow, error) {
+	return d.queries.ListOverfullInventoryStacks(ctx)
+}
+
+func (d *DatabaseWrapper) MergeInventoryStacks(ctx context.Context, arg db.MergeInventoryStacksParams) (int32, error) {
+	return d.queries.MergeInventoryStacks(ctx, arg)
+}
+
+// DeliverItems grants what fits of each grant and handles the rest by the world's
+// overflow policy, all in one serializable transaction together with the delivery's
+// event. Returns ErrInventoryFull, granting nothing, when the policy rejects overflow.
+func (d *DatabaseWrapper) DeliverItems(ctx context.Context, delivery Delivery) (DeliveryResult, error) {
+	var result DeliveryResult
+	err := txn.Run(ctx, d.pool, txn.Serializable, func(q *db.Queries) error {
+		result = DeliveryResult{Grants: make([]DeliveredGrant, 0, len(delivery.Grants))}
+		settings, err := worldSettingsInTx(ctx, q, delivery.WorldID)
+		if err != nil {
+			return err
+		}
+		result.Policy = settings.HarvestOverflow
+		room, err := capacityInTx(ctx, q, delivery.CharacterID, settings)
+		if err != nil {
+			return err
+		}
+
+		for _, grant := range delivery.Grants {
+			delivered := DeliveredGrant{Grant: grant}
+			delivered.Granted = room.take(grant.ItemID, grant.StackSize, grant.Quantity)
+			delivered.Overflow = grant.Quantity - delivered.Granted
+			if delivered.Overflow > 0 && settings.HarvestOverflow == OverflowReject {
+				return ErrInventoryFull
+			}
+			if delivered.Granted > 0 {
+				delivered.Stack, err = grantInTx(ctx, q, db.CreateInventoryItemParams{
+					CharacterID: delivery.CharacterID,
+					ItemID:      grant.ItemID,
+					Quantity:    delivered.Granted,
+				})
+				if err != nil {
+					return fmt.Errorf("failed to grant item %d: %w", grant.ItemID, err)
+				}
+			}
+			if delivered.Overflow > 0 {
+				if err := overflowInTx(ctx, q, delivery, settings.HarvestOverflow, grant.ItemID, delivered.Overflow); err != nil {
+					return err
+				}
+			}
+			result.Grants = append(result.Grants, delivered)
+		}
+
+		if delivery.Event != nil {
+			event := delivery.Event
+			return outbox.Enqueue(ctx, q, event.Type, event.AggregateID, event.DedupKey, event.Payload)
+		}
+		return nil
+	})
+	return result, err
+}
+
+// overflowInTx puts items that did not fit on the ground or into the inbox
+func overflowInTx(ctx context.Context, q *db.Queries, delivery Delivery, policy string, itemID, quantity int32) error {
+	if policy == OverflowInbox {
+		_, err := q.CreateInboxItem(ctx, db.CreateInboxItemParams{
+			CharacterID: delivery.CharacterID,
+			ItemID:      itemID,
+			Quantity:    quantity,
+			Reason:      delivery.Reason,
+			CreatedAt:   pgtype.Timestamp{Time: delivery.Now, Valid: true},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to hold item %d in inbox: %w", itemID, err)
+		}
+		return nil
+	}
+	_, err := q.CreateGroundDrop(ctx, db.CreateGroundDropParams{
+		WorldID:   delivery.WorldID,
+		X:         delivery.X,
+		Y:         delivery.Y,
+		ItemID:    itemID,
+		Quantity:  quantity,
+		DroppedBy: delivery.CharacterID,
+		CreatedAt: pgtype.Timestamp{Time: delivery.Now, Valid: true},
+		ExpiresAt: pgtype.Timestamp{Time: delivery.Now.Add(delivery.DropLifetime), Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to drop item %d on the ground: %w", itemID, err)
+	}
+	return nil
+}
+
+// worldSettingsInTx returns the world's inventory settings, or the defaults (unlimited
+// slots, overflow rejected) for worlds that were never configured
+func worldSettingsInTx(ctx context.Context, q *db.Queries, worldID pgtype.UUID) (db.WorldInventorySetting, error) {
+	settings, err := q.GetWorldInventorySettings(ctx, worldID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return db.WorldInventorySetting{WorldID: worldID, HarvestOverflow: OverflowReject}, nil
+	}
+	if err != nil {
+		return settings, fmt.Errorf("failed to get world inventory settings: %w", err)
+	}
+	return settings, nil
+}
+
+func capacityInTx(ctx context.Context, q *db.Queries, characterID pgtype.UUID, settings db.WorldInventorySetting) (*capacity, error) {
+	rows, err := q.GetCharacterInventory(ctx, characterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get inventory: %w", err)
+	}
+	return newCapacity(settings.InventorySlots, rows), nil
+}
+
+func (d *DatabaseWrapper) ListGroundDropsNear(ctx context.Context, arg db.ListGroundDropsNearParams) ([]db.ListGroundDropsNearRow, error) {
+	return d.queries.ListGroundDropsNear(ctx, arg)
+}
+
+// PickUpGroundDrop moves what fits of a ground drop into the character's inventory and
+// leaves the rest on the ground. The drop is locked for the transaction, so two
+// characters cannot both pick up the same items.
+func (d *DatabaseWrapper) PickUpGroundDrop(ctx context.Context, arg TransferParams) (Transfer, error) {
+	var transfer Transfer
+	err := txn.Run(ctx, d.pool, txn.Serializable, func(q *db.Queries) error {
+		drop, err := q.GetGroundDropForUpdate(ctx, arg.ID)
+		if err != nil {
+			return err
+		}
+		if drop.WorldID != arg.Character.WorldID || !drop.ExpiresAt.Time.After(arg.Now) {
+			return pgx.ErrNoRows
+		}
+		if !inPickupRange(&arg.Character, drop.X, drop.Y) {
+			return ErrOutOfReach
+		}
+
+		transfer, err = transferInTx(ctx, q, arg.Character, drop.ItemID, drop.StackSize, drop.Quantity)
+		if err != nil {
+			return err
+		}
+		if transfer.Remaining > 0 {
+			return q.UpdateGroundDropQuantity(ctx, db.UpdateGroundDropQuantityParams{ID: drop.ID, Quantity: transfer.Remaining})
+		}
+		return q.DeleteGroundDrop(ctx, drop.ID)
+	})
+	return transfer, err
+}
+
+func (d *DatabaseWrapper) DeleteExpiredGroundDrops(ctx context.Context, now pgtype.Timestamp) (int64, error) {
+	return d.queries.DeleteExpiredGroundDrops(ctx, now)
+}
+
+func (d *DatabaseWrapper) ListInboxItems(ctx context.Context, characterID pgtype.UUID) ([]db.ListInboxItemsRow, error) {
+	return d.queries.ListInboxItems(ctx, characterID)
+}
+
+// ClaimInboxItem moves what fits of an inbox item into the character's inventory and
+// leaves the rest in the inbox
+func (d *DatabaseWrapper) ClaimInboxItem(ctx context.Context, arg TransferParams) (Transfer, error) {
+	var transfer Transfer
+	err := txn.Run(ctx, d.pool, txn.Serializable, func(q *db.Queries) error {
+		item, err := q.GetInboxItemForUpdate(ctx, arg.ID)
+		if err != nil {
+			return err
+		}
+		if item.CharacterID != arg.Character.ID {
+			return pgx.ErrNoRows
+		}
+
+		transfer, err = transferInTx(ctx, q, arg.Character, item.ItemID, item.StackSize, item.Quantity)
+		if err != nil {
+			return err
+		}
+		if transfer.Remaining > 0 {
+			return q.UpdateInboxItemQuantity(ctx, db.UpdateInboxItemQuantityParams{ID: item.ID, Quantity: transfer.Remaining})
+		}
+		return q.DeleteInboxItem(ctx, item.ID)
+	})
+	return transfer, err
+}
+
+// transferInTx grants what fits of quantity to the character, failing with
+// ErrInventoryFull when nothing does
+func transferInTx(ctx context.Context, q *db.Queries, character db.Character, itemID, stackSize, quantity int32) (Transfer, error) {
+	settings, err := worldSettingsInTx(ctx, q, character.WorldID)
+	if err != nil {
+		return Transfer{}, err
+	}
+	room, err := capacityInTx(ctx, q, character.ID, settings)
+	if err != nil {
+		return Transfer{}, err
+	}
+	transfer := Transfer{Moved: room.take(itemID, stackSize, quantity)}
+	if transfer.Moved == 0 {
+		return transfer, ErrInventoryFull
+	}
+	transfer.Remaining = quantity - transfer.Moved
+	transfer.Stack, err = grantInTx(ctx, q, db.CreateInventoryItemParams{
+		CharacterID: character.ID,
+		ItemID:      itemID,
+		Quantity:    transfer.Moved,
+	})
+	if err != nil {
+		return transfer, fmt.Errorf("failed to grant item %d: %w", itemID, err)
+	}
+	return transfer, nil
+}
+
+// GrantAllInTx grants every grant to the character within the caller's transaction, or
+// fails with ErrInventoryFull, granting nothing, if they do not all fit. Other services
+// use it to move items into inventories atomically with their own writes.
+func GrantAllInTx(ctx context.Context, q *db.Queries, character db.Character, grants []Grant) ([]db.CharacterInventory, error) {
+	settings, err := worldSettingsInTx(ctx, q, character.WorldID)
+	if err != nil {
+		return nil, err
+	}
+	room, err := capacityInTx(ctx, q, character.ID, settings)
+	if err != nil {
+		return nil, err
+	}
+	for _, grant := range grants {
+		if room.take(grant.ItemID, grant.StackSize, grant.Quantity) < grant.Quantity {
+			return nil, ErrInventoryFull
+		}
+	}
+	stacks := make([]db.CharacterInventory, 0, len(grants))
+	for _, grant := range grants {
+		stack, err := grantInTx(ctx, q, db.CreateInventoryItemParams{
+			CharacterID: character.ID,
+			ItemID:      grant.ItemID,
+			Quantity:    grant.Quantity,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to grant item %d: %w", grant.ItemID, err)
+		}
+		stacks = append(stacks, stack)
+	}
+	return stacks, nil
+}
+
+func (d *DatabaseWrapper) GetWorldInventorySettings(ctx context.Context, worldID pgtype.UUID) (db.WorldInventorySetting, error) {
+	return d.queries.GetWorldInventorySettings(ctx, worldID)
+}
+
+func (d *DatabaseWrapper) UpsertWorldInventorySettings(ctx context.Context, arg db.UpsertWorldInventorySettingsParams) (db.WorldInventorySetting, error) {
+	return d.queries.UpsertWorldInventorySettings(ctx, arg)
 }
 
 // CharacterServiceInterface defines the interface for character service operations.
 type CharacterServiceInterface interface {
-	// Add any character service methods that inventory service needs
+	GetCharacterByID(ctx context.Context, characterID string) (*db.Character, error)
 }
 
 // ResourceNodeServiceInterface defines the interface for resource node service operations.
@@ -72,6 +383,11 @@ type ResourceNodeServiceInterface interface {
 	GetResourceNodeTypes(ctx context.Context) ([]*resourceNodeV1.ResourceNodeType, error)
 }
 
+// TimeScaleInterface speeds up ground drop expiry in a world for testing.
+type TimeScaleInterface interface {
+	Scale(ctx context.Context, worldID pgtype.UUID, d time.Duration) time.Duration
+}
+
 // LoggerInterface abstracts logging operations for dependency injection.
 type LoggerInterface interface {
 	Debug(msg string, keysAndValues ...interface{})
@@ -92,6 +408,10 @@ func NewCharacterServiceAdapter(service *character.Service) CharacterServiceInte
 	return &CharacterServiceAdapter{service: service}
 }
 
+func (c *CharacterServiceAdapter) GetCharacterByID(ctx context.Context, characterID string) (*db.Character, error) {
+	return c.service.GetCharacterByID(ctx, characterID)
+}
+
 // ResourceNodeServiceAdapter adapts a resource_node.NodeService to our interface.
 type ResourceNodeServiceAdapter struct {
 	service *resource_node.NodeService
@@ -130,4 +450,4 @@ func (l *DefaultLoggerWrapper) Error(msg string, keysAndValues ...interface{}) {
 
 func (l *DefaultLoggerWrapper) With(keysAndValues ...interface{}) LoggerInterface {
 	return l
-}
\ No newline at end of file
+}
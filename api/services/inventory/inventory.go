@@ -4,10 +4,11 @@ import (
 	"context"
 
 	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/storage"
 	"github.com/VoidMesh/api/api/internal/uuid"
 	inventoryV1 "github.com/VoidMesh/api/api/proto/inventory/v1"
 	"github.com/VoidMesh/api/api/services/character"
-	"github.com/jackc/pgx/v5/pgxpool"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -18,6 +19,8 @@ type Service struct {
 	db               DatabaseInterface
 	characterService CharacterServiceInterface
 	logger           LoggerInterface
+	clock            clock.Clock
+	timeScale        TimeScaleInterface // Nil runs every world at normal speed
 }
 
 // NewService creates a new inventory service with dependency injection.
@@ -32,12 +35,13 @@ func NewService(
 		db:               db,
 		characterService: characterService,
 		logger:           componentLogger,
+		clock:            clock.New(),
 	}
 }
 
 // NewServiceWithPool creates a service with concrete implementations (convenience constructor for production use).
 func NewServiceWithPool(
-	pool *pgxpool.Pool,
+	pool storage.Pool,
 	characterService *character.Service,
 ) *Service {
 	logger := NewDefaultLoggerWrapper()
@@ -48,6 +52,16 @@ func NewServiceWithPool(
 	)
 }
 
+// SetClock replaces the clock used for ground drop expiry (for simulation tests)
+func (s *Service) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// SetTimeScale makes ground drop lifetimes follow each world's time scale
+func (s *Service) SetTimeScale(timeScale TimeScaleInterface) {
+	s.timeScale = timeScale
+}
+
 // Helper function to convert DB inventory row to proto (for JOIN queries)
 func (s *Service) dbInventoryRowToProto(ctx context.Context, row db.GetCharacterInventoryRow) (*inventoryV1.InventoryItem, error) {
 	protoItem := &inventoryV1.InventoryItem{
@@ -73,7 +87,7 @@ func (s *Service) dbInventoryRowToProto(ctx context.Context, row db.GetCharacter
 	return protoItem, nil
 }
 
-// Helper function to convert simple DB inventory item to proto (for non-JOIN queries)  
+// Helper function to convert simple DB inventory item to proto (for non-JOIN queries)
 func (s *Service) dbInventoryItemToProto(ctx context.Context, item db.CharacterInventory) (*inventoryV1.InventoryItem, error) {
 	protoItem := &inventoryV1.InventoryItem{
 		Id:          item.ID,
@@ -122,6 +136,13 @@ func (s *Service) GetCharacterInventory(ctx context.Context, characterID string)
 		items = append(items, protoItem)
 	}
 
+	flags, err := s.db.ListInventoryFlags(ctx, characterPgUUID)
+	if err != nil {
+		s.logger.Error("Failed to get inventory flags", "character_id", characterID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to retrieve inventory")
+	}
+	applyFlags(items, flags)
+
 	s.logger.Debug("Retrieved character inventory", "character_id", characterID, "item_count", len(items))
 	return items, nil
 }
@@ -144,33 +165,11 @@ func (s *Service) AddInventoryItem(ctx context.Context, characterID string, item
 		return nil, status.Errorf(codes.InvalidArgument, "invalid character ID format")
 	}
 
-	// Check if item already exists
-	exists, err := s.db.InventoryItemExists(ctx, db.InventoryItemExistsParams{
+	dbItem, err := s.db.GrantInventoryItem(ctx, db.CreateInventoryItemParams{
 		CharacterID: characterPgUUID,
 		ItemID:      itemID,
+		Quantity:    quantity,
 	})
-	if err != nil {
-		s.logger.Error("Failed to check inventory item existence", "error", err)
-		return nil, status.Errorf(codes.Internal, "failed to check inventory")
-	}
-
-	var dbItem db.CharacterInventory
-	if exists {
-		// Update existing item
-		dbItem, err = s.db.AddInventoryItemQuantity(ctx, db.AddInventoryItemQuantityParams{
-			CharacterID: characterPgUUID,
-			ItemID:      itemID,
-			Quantity:    quantity,
-		})
-	} else {
-		// Create new item
-		dbItem, err = s.db.CreateInventoryItem(ctx, db.CreateInventoryItemParams{
-			CharacterID: characterPgUUID,
-			ItemID:      itemID,
-			Quantity:    quantity,
-		})
-	}
-
 	if err != nil {
 		s.logger.Error("Failed to add inventory item", "error", err)
 		return nil, status.Errorf(codes.Internal, "failed to add inventory item")
@@ -204,8 +203,8 @@ func (s *Service) RemoveInventoryItem(ctx context.Context, characterID string, i
 		return nil, status.Errorf(codes.InvalidArgument, "invalid character ID format")
 	}
 
-	// Try to remove quantity
-	dbItem, err := s.db.RemoveInventoryItemQuantity(ctx, db.RemoveInventoryItemQuantityParams{
+	// Remove quantity, deleting the item once none is left
+	dbItem, err := s.db.TakeInventoryItem(ctx, db.RemoveInventoryItemQuantityParams{
 		CharacterID: characterPgUUID,
 		ItemID:      itemID,
 		Quantity:    quantity,
@@ -215,15 +214,7 @@ func (s *Service) RemoveInventoryItem(ctx context.Context, characterID string, i
 		return nil, status.Errorf(codes.Internal, "failed to remove inventory item or insufficient quantity")
 	}
 
-	// If quantity is now 0 or less, delete the item
 	if dbItem.Quantity <= 0 {
-		err = s.db.DeleteInventoryItem(ctx, db.DeleteInventoryItemParams{
-			CharacterID: characterPgUUID,
-			ItemID:      itemID,
-		})
-		if err != nil {
-			s.logger.Warn("Failed to delete empty inventory item", "error", err)
-		}
 		return nil, nil // Item was completely removed
 	}
 
@@ -236,4 +227,3 @@ func (s *Service) RemoveInventoryItem(ctx context.Context, characterID string, i
 	s.logger.Debug("Removed inventory item quantity", "character_id", characterID, "item_id", itemID, "remaining_quantity", dbItem.Quantity)
 	return protoItem, nil
 }
-
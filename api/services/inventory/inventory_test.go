@@ -28,34 +28,89 @@ func (m *MockDatabaseInterface) GetCharacterInventory(ctx context.Context, chara
 	return args.Get(0).([]db.GetCharacterInventoryRow), args.Error(1)
 }
 
-func (m *MockDatabaseInterface) InventoryItemExists(ctx context.Context, arg db.InventoryItemExistsParams) (bool, error) {
+func (m *MockDatabaseInterface) GrantInventoryItem(ctx context.Context, arg db.CreateInventoryItemParams) (db.CharacterInventory, error) {
 	args := m.Called(ctx, arg)
-	return args.Bool(0), args.Error(1)
+	return args.Get(0).(db.CharacterInventory), args.Error(1)
 }
 
-func (m *MockDatabaseInterface) AddInventoryItemQuantity(ctx context.Context, arg db.AddInventoryItemQuantityParams) (db.CharacterInventory, error) {
+func (m *MockDatabaseInterface) TakeInventoryItem(ctx context.Context, arg db.RemoveInventoryItemQuantityParams) (db.CharacterInventory, error) {
 	args := m.Called(ctx, arg)
 	return args.Get(0).(db.CharacterInventory), args.Error(1)
 }
 
-func (m *MockDatabaseInterface) CreateInventoryItem(ctx context.Context, arg db.CreateInventoryItemParams) (db.CharacterInventory, error) {
-	args := m.Called(ctx, arg)
-	return args.Get(0).(db.CharacterInventory), args.Error(1)
+func (m *MockDatabaseInterface) GetResourceNode(ctx context.Context, id int32) (db.ResourceNode, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(db.ResourceNode), args.Error(1)
 }
 
-func (m *MockDatabaseInterface) RemoveInventoryItemQuantity(ctx context.Context, arg db.RemoveInventoryItemQuantityParams) (db.CharacterInventory, error) {
+func (m *MockDatabaseInterface) ListInventoryFlags(ctx context.Context, characterID pgtype.UUID) ([]db.CharacterInventoryFlag, error) {
+	args := m.Called(ctx, characterID)
+	return args.Get(0).([]db.CharacterInventoryFlag), args.Error(1)
+}
+
+func (m *MockDatabaseInterface) UpsertInventoryFlags(ctx context.Context, arg db.UpsertInventoryFlagsParams) (db.CharacterInventoryFlag, error) {
 	args := m.Called(ctx, arg)
-	return args.Get(0).(db.CharacterInventory), args.Error(1)
+	return args.Get(0).(db.CharacterInventoryFlag), args.Error(1)
 }
 
-func (m *MockDatabaseInterface) DeleteInventoryItem(ctx context.Context, arg db.DeleteInventoryItemParams) error {
+func (m *MockDatabaseInterface) SetInventorySortPositions(ctx context.Context, arg db.SetInventorySortPositionsParams) error {
 	args := m.Called(ctx, arg)
 	return args.Error(0)
 }
 
-func (m *MockDatabaseInterface) GetResourceNode(ctx context.Context, id int32) (db.ResourceNode, error) {
-	args := m.Called(ctx, id)
-	return args.Get(0).(db.ResourceNode), args.Error(1)
+func (m *MockDatabaseInterface) ListDuplicateInventoryStacks(ctx context.Context) ([]db.ListDuplicateInventoryStacksRow, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]db.ListDuplicateInventoryStacksRow), args.Error(1)
+}
+
+func (m *MockDatabaseInterface) ListOverfullInventoryStacks(ctx context.Context) ([]db.ListOverfullInventoryStacksRow, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]db.ListOverfullInventoryStacksRow), args.Error(1)
+}
+
+func (m *MockDatabaseInterface) MergeInventoryStacks(ctx context.Context, arg db.MergeInventoryStacksParams) (int32, error) {
+	args := m.Called(ctx, arg)
+	return args.Get(0).(int32), args.Error(1)
+}
+
+func (m *MockDatabaseInterface) DeliverItems(ctx context.Context, delivery Delivery) (DeliveryResult, error) {
+	args := m.Called(ctx, delivery)
+	return args.Get(0).(DeliveryResult), args.Error(1)
+}
+
+func (m *MockDatabaseInterface) ListGroundDropsNear(ctx context.Context, arg db.ListGroundDropsNearParams) ([]db.ListGroundDropsNearRow, error) {
+	args := m.Called(ctx, arg)
+	return args.Get(0).([]db.ListGroundDropsNearRow), args.Error(1)
+}
+
+func (m *MockDatabaseInterface) PickUpGroundDrop(ctx context.Context, arg TransferParams) (Transfer, error) {
+	args := m.Called(ctx, arg)
+	return args.Get(0).(Transfer), args.Error(1)
+}
+
+func (m *MockDatabaseInterface) DeleteExpiredGroundDrops(ctx context.Context, now pgtype.Timestamp) (int64, error) {
+	args := m.Called(ctx, now)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockDatabaseInterface) ListInboxItems(ctx context.Context, characterID pgtype.UUID) ([]db.ListInboxItemsRow, error) {
+	args := m.Called(ctx, characterID)
+	return args.Get(0).([]db.ListInboxItemsRow), args.Error(1)
+}
+
+func (m *MockDatabaseInterface) ClaimInboxItem(ctx context.Context, arg TransferParams) (Transfer, error) {
+	args := m.Called(ctx, arg)
+	return args.Get(0).(Transfer), args.Error(1)
+}
+
+func (m *MockDatabaseInterface) GetWorldInventorySettings(ctx context.Context, worldID pgtype.UUID) (db.WorldInventorySetting, error) {
+	args := m.Called(ctx, worldID)
+	return args.Get(0).(db.WorldInventorySetting), args.Error(1)
+}
+
+func (m *MockDatabaseInterface) UpsertWorldInventorySettings(ctx context.Context, arg db.UpsertWorldInventorySettingsParams) (db.WorldInventorySetting, error) {
+	args := m.Called(ctx, arg)
+	return args.Get(0).(db.WorldInventorySetting), args.Error(1)
 }
 
 // MockCharacterServiceInterface implements CharacterServiceInterface for testing
@@ -63,6 +118,14 @@ type MockCharacterServiceInterface struct {
 	mock.Mock
 }
 
+func (m *MockCharacterServiceInterface) GetCharacterByID(ctx context.Context, characterID string) (*db.Character, error) {
+	args := m.Called(ctx, characterID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*db.Character), args.Error(1)
+}
+
 
 // MockLoggerInterface implements LoggerInterface for testing
 type MockLoggerInterface struct {
@@ -207,6 +270,7 @@ func TestService_GetCharacterInventory(t *testing.T) {
 				}
 				
 				mockDB.On("GetCharacterInventory", mock.Anything, expectedUUID).Return(inventoryItems, nil)
+				mockDB.On("ListInventoryFlags", mock.Anything, expectedUUID).Return([]db.CharacterInventoryFlag{}, nil)
 				
 				mockLogger.On("Debug", "Getting character inventory", "character_id", "550e8400e29b41d4a716446655440000")
 				mockLogger.On("Debug", "Retrieved character inventory", "character_id", "550e8400e29b41d4a716446655440000", "item_count", 2)
@@ -221,6 +285,7 @@ func TestService_GetCharacterInventory(t *testing.T) {
 				expectedUUID := createTestCharacterUUID("550e8400e29b41d4a716446655440000")
 				
 				mockDB.On("GetCharacterInventory", mock.Anything, expectedUUID).Return([]db.GetCharacterInventoryRow{}, nil)
+				mockDB.On("ListInventoryFlags", mock.Anything, expectedUUID).Return([]db.CharacterInventoryFlag{}, nil)
 				
 				mockLogger.On("Debug", "Getting character inventory", "character_id", "550e8400e29b41d4a716446655440000")
 				mockLogger.On("Debug", "Retrieved character inventory", "character_id", "550e8400e29b41d4a716446655440000", "item_count", 0)
@@ -325,10 +390,6 @@ func TestService_AddInventoryItem(t *testing.T) {
 			quantity:    10,
 			setupMocks: func(mockDB *MockDatabaseInterface, mockLogger *MockLoggerInterface) {
 				expectedUUID := createTestCharacterUUID("550e8400e29b41d4a716446655440000")
-				expectedExistsParams := db.InventoryItemExistsParams{
-					CharacterID: expectedUUID,
-					ItemID:      1,
-				}
 				expectedCreateParams := db.CreateInventoryItemParams{
 					CharacterID: expectedUUID,
 					ItemID:      1,
@@ -337,8 +398,7 @@ func TestService_AddInventoryItem(t *testing.T) {
 				
 				createdItem := createTestInventoryItem(1, "550e8400e29b41d4a716446655440000", 1, 10)
 				
-				mockDB.On("InventoryItemExists", mock.Anything, expectedExistsParams).Return(false, nil)
-				mockDB.On("CreateInventoryItem", mock.Anything, expectedCreateParams).Return(createdItem, nil)
+				mockDB.On("GrantInventoryItem", mock.Anything, expectedCreateParams).Return(createdItem, nil)
 				
 				mockLogger.On("Debug", "Adding inventory item", "character_id", "550e8400e29b41d4a716446655440000", "item_id", int32(1), "quantity", int32(10))
 				mockLogger.On("Debug", "Added inventory item", "character_id", "550e8400e29b41d4a716446655440000", "item_id", int32(1), "new_quantity", int32(10))
@@ -446,7 +506,7 @@ func TestService_RemoveInventoryItem(t *testing.T) {
 				
 				remainingItem := createTestInventoryItem(1, "550e8400e29b41d4a716446655440000", 1, 5)
 				
-				mockDB.On("RemoveInventoryItemQuantity", mock.Anything, expectedRemoveParams).Return(remainingItem, nil)
+				mockDB.On("TakeInventoryItem", mock.Anything, expectedRemoveParams).Return(remainingItem, nil)
 				
 				mockLogger.On("Debug", "Removing inventory item", "character_id", "550e8400e29b41d4a716446655440000", "item_id", int32(1), "quantity", int32(5))
 				mockLogger.On("Debug", "Removed inventory item quantity", "character_id", "550e8400e29b41d4a716446655440000", "item_id", int32(1), "remaining_quantity", int32(5))
@@ -466,16 +526,10 @@ func TestService_RemoveInventoryItem(t *testing.T) {
 					ItemID:      1,
 					Quantity:    10,
 				}
-				expectedDeleteParams := db.DeleteInventoryItemParams{
-					CharacterID: expectedUUID,
-					ItemID:      1,
-				}
-				
 				// Item with zero quantity after removal
 				removedItem := createTestInventoryItem(1, "550e8400e29b41d4a716446655440000", 1, 0)
 				
-				mockDB.On("RemoveInventoryItemQuantity", mock.Anything, expectedRemoveParams).Return(removedItem, nil)
-				mockDB.On("DeleteInventoryItem", mock.Anything, expectedDeleteParams).Return(nil)
+				mockDB.On("TakeInventoryItem", mock.Anything, expectedRemoveParams).Return(removedItem, nil)
 				
 				mockLogger.On("Debug", "Removing inventory item", "character_id", "550e8400e29b41d4a716446655440000", "item_id", int32(1), "quantity", int32(10))
 			},
@@ -0,0 +1,226 @@
+package inventory
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	inventoryV1 "github.com/VoidMesh/api/api/proto/inventory/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	MaxTags      = 10
+	MaxTagLength = 24
+)
+
+// rarityRanks orders item rarities from most common to rarest
+var rarityRanks = map[string]int{
+	"common":    1,
+	"uncommon":  2,
+	"rare":      3,
+	"very_rare": 4,
+}
+
+// SetItemFlags replaces the favorite flag and tags of the character's stack of an item
+func (s *Service) SetItemFlags(ctx context.Context, characterID string, itemID int32, favorite bool, tags []string) (*inventoryV1.InventoryItem, error) {
+	tags, err := normalizeTags(tags)
+	if err != nil {
+		return nil, err
+	}
+	characterPgUUID, err := uuid.StringToPgtype(characterID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid character ID format")
+	}
+
+	rows, err := s.db.GetCharacterInventory(ctx, characterPgUUID)
+	if err != nil {
+		s.logger.Error("Failed to get character inventory", "character_id", characterID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to retrieve inventory")
+	}
+	index := slices.IndexFunc(rows, func(row db.GetCharacterInventoryRow) bool { return row.ItemID == itemID })
+	if index < 0 {
+		return nil, status.Errorf(codes.NotFound, "item not in inventory")
+	}
+
+	flags, err := s.db.UpsertInventoryFlags(ctx, db.UpsertInventoryFlagsParams{
+		InventoryID: rows[index].ID,
+		CharacterID: characterPgUUID,
+		Favorite:    favorite,
+		Tags:        tags,
+	})
+	if err != nil {
+		s.logger.Error("Failed to set inventory flags", "character_id", characterID, "item_id", itemID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to set inventory flags")
+	}
+
+	item, err := s.dbInventoryRowToProto(ctx, rows[index])
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to process inventory item")
+	}
+	item.Favorite = flags.Favorite
+	item.Tags = flags.Tags
+	s.logger.Debug("Set inventory flags", "character_id", characterID, "item_id", itemID, "favorite", favorite, "tags", len(tags))
+	return item, nil
+}
+
+// SortInventory stores a canonical ordering of the character's inventory and returns the
+// inventory in it
+func (s *Service) SortInventory(ctx context.Context, characterID string, order inventoryV1.InventorySortOrder, favoritesFirst bool) ([]*inventoryV1.InventoryItem, error) {
+	less, ok := sortOrders[order]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown sort order")
+	}
+	items, err := s.GetCharacterInventory(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := items[i], items[j]
+		if favoritesFirst && a.Favorite != b.Favorite {
+			return a.Favorite
+		}
+		if less(a, b) != less(b, a) {
+			return less(a, b)
+		}
+		if a.ItemName != b.ItemName {
+			return a.ItemName < b.ItemName
+		}
+		return a.ItemId < b.ItemId
+	})
+
+	ids := make([]int32, len(items))
+	for i, item := range items {
+		ids[i] = item.Id
+	}
+	characterPgUUID, err := uuid.StringToPgtype(characterID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid character ID format")
+	}
+	err = s.db.SetInventorySortPositions(ctx, db.SetInventorySortPositionsParams{
+		InventoryIds: ids,
+		CharacterID:  characterPgUUID,
+	})
+	if err != nil {
+		s.logger.Error("Failed to store inventory order", "character_id", characterID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to sort inventory")
+	}
+
+	s.logger.Debug("Sorted inventory", "character_id", characterID, "order", order.String(), "item_count", len(items))
+	return items, nil
+}
+
+// sortOrders holds the primary comparison of each canonical ordering
+var sortOrders = map[inventoryV1.InventorySortOrder]func(a, b *inventoryV1.InventoryItem) bool{
+	inventoryV1.InventorySortOrder_INVENTORY_SORT_ORDER_NAME: func(a, b *inventoryV1.InventoryItem) bool {
+		return strings.ToLower(a.ItemName) < strings.ToLower(b.ItemName)
+	},
+	inventoryV1.InventorySortOrder_INVENTORY_SORT_ORDER_TYPE: func(a, b *inventoryV1.InventoryItem) bool {
+		return a.ItemType < b.ItemType
+	},
+	inventoryV1.InventorySortOrder_INVENTORY_SORT_ORDER_RARITY: func(a, b *inventoryV1.InventoryItem) bool {
+		return rarityRanks[a.Rarity] > rarityRanks[b.Rarity]
+	},
+	inventoryV1.InventorySortOrder_INVENTORY_SORT_ORDER_QUANTITY: func(a, b *inventoryV1.InventoryItem) bool {
+		return a.Quantity > b.Quantity
+	},
+	inventoryV1.InventorySortOrder_INVENTORY_SORT_ORDER_RECENT: func(a, b *inventoryV1.InventoryItem) bool {
+		return a.UpdatedAt.AsTime().After(b.UpdatedAt.AsTime())
+	},
+}
+
+// FilterInventory returns the items matching every set field of the filter; a nil filter
+// matches everything
+func FilterInventory(items []*inventoryV1.InventoryItem, filter *inventoryV1.InventoryFilter) []*inventoryV1.InventoryItem {
+	if filter == nil {
+		return items
+	}
+	prefix := strings.ToLower(filter.NamePrefix)
+	tag := strings.ToLower(strings.TrimSpace(filter.Tag))
+
+	var matched []*inventoryV1.InventoryItem
+	for _, item := range items {
+		if len(filter.ItemTypes) > 0 && !containsFold(filter.ItemTypes, item.ItemType) {
+			continue
+		}
+		if len(filter.Rarities) > 0 && !containsFold(filter.Rarities, item.Rarity) {
+			continue
+		}
+		if !strings.HasPrefix(strings.ToLower(item.ItemName), prefix) {
+			continue
+		}
+		if filter.FavoritesOnly && !item.Favorite {
+			continue
+		}
+		if tag != "" && !slices.Contains(item.Tags, tag) {
+			continue
+		}
+		matched = append(matched, item)
+	}
+	return matched
+}
+
+// applyFlags copies stored flags onto the items and moves sorted stacks, in their stored
+// order, ahead of the rest
+func applyFlags(items []*inventoryV1.InventoryItem, flags []db.CharacterInventoryFlag) {
+	byInventoryID := make(map[int32]db.CharacterInventoryFlag, len(flags))
+	for _, flag := range flags {
+		byInventoryID[flag.InventoryID] = flag
+	}
+	positions := make(map[int32]int32, len(flags))
+	for _, item := range items {
+		flag, ok := byInventoryID[item.Id]
+		if !ok {
+			continue
+		}
+		item.Favorite = flag.Favorite
+		item.Tags = flag.Tags
+		if flag.SortPosition.Valid {
+			positions[item.Id] = flag.SortPosition.Int32
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		a, aSorted := positions[items[i].Id]
+		b, bSorted := positions[items[j].Id]
+		if aSorted != bSorted {
+			return aSorted
+		}
+		return a < b
+	})
+}
+
+// normalizeTags trims, lowercases and deduplicates tags
+func normalizeTags(tags []string) ([]string, error) {
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "tags must not be empty")
+		}
+		if utf8.RuneCountInString(tag) > MaxTagLength {
+			return nil, status.Errorf(codes.InvalidArgument, "tags must be at most %d characters", MaxTagLength)
+		}
+		if !slices.Contains(normalized, tag) {
+			normalized = append(normalized, tag)
+		}
+	}
+	if len(normalized) > MaxTags {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d tags are allowed", MaxTags)
+	}
+	return normalized, nil
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,154 @@
+package inventory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	inventoryV1 "github.com/VoidMesh/api/api/proto/inventory/v1"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const organizeCharacterID = "550e8400e29b41d4a716446655440000"
+
+func organizeInventoryRows() []db.GetCharacterInventoryRow {
+	return []db.GetCharacterInventoryRow{
+		createTestInventoryRow(1, organizeCharacterID, 10, 5, "Berries", "Sweet berries", "food", "common", 50),
+		createTestInventoryRow(2, organizeCharacterID, 11, 40, "Herb", "A valuable herb", "resource", "uncommon", 100),
+		createTestInventoryRow(3, organizeCharacterID, 12, 2, "Moonstone", "A glowing stone", "resource", "very_rare", 10),
+	}
+}
+
+func TestService_GetCharacterInventory_AppliesFlags(t *testing.T) {
+	mockDB := &MockDatabaseInterface{}
+	characterUUID := createTestCharacterUUID(organizeCharacterID)
+	mockDB.On("GetCharacterInventory", mock.Anything, characterUUID).Return(organizeInventoryRows(), nil)
+	mockDB.On("ListInventoryFlags", mock.Anything, characterUUID).Return([]db.CharacterInventoryFlag{
+		{InventoryID: 3, Favorite: true, Tags: []string{"quest"}, SortPosition: pgtype.Int4{Int32: 1, Valid: true}},
+		{InventoryID: 1, SortPosition: pgtype.Int4{Int32: 2, Valid: true}},
+	}, nil)
+	service := &Service{db: mockDB, logger: testmocks.NopLogger[LoggerInterface]{}}
+
+	items, err := service.GetCharacterInventory(context.Background(), organizeCharacterID)
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+	assert.Equal(t, "Moonstone", items[0].ItemName)
+	assert.True(t, items[0].Favorite)
+	assert.Equal(t, []string{"quest"}, items[0].Tags)
+	assert.Equal(t, "Berries", items[1].ItemName)
+	assert.Equal(t, "Herb", items[2].ItemName, "stacks gained since the last sort come last")
+	assert.False(t, items[2].Favorite)
+}
+
+func TestService_SetItemFlags(t *testing.T) {
+	mockDB := &MockDatabaseInterface{}
+	characterUUID := createTestCharacterUUID(organizeCharacterID)
+	mockDB.On("GetCharacterInventory", mock.Anything, characterUUID).Return(organizeInventoryRows(), nil)
+	mockDB.On("UpsertInventoryFlags", mock.Anything, db.UpsertInventoryFlagsParams{
+		InventoryID: 2,
+		CharacterID: characterUUID,
+		Favorite:    true,
+		Tags:        []string{"alchemy", "sell"},
+	}).Return(db.CharacterInventoryFlag{InventoryID: 2, Favorite: true, Tags: []string{"alchemy", "sell"}}, nil)
+	service := &Service{db: mockDB, logger: testmocks.NopLogger[LoggerInterface]{}}
+	ctx := context.Background()
+
+	item, err := service.SetItemFlags(ctx, organizeCharacterID, 11, true, []string{" Alchemy", "sell", "ALCHEMY"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(11), item.ItemId)
+	assert.True(t, item.Favorite)
+	assert.Equal(t, []string{"alchemy", "sell"}, item.Tags)
+
+	_, err = service.SetItemFlags(ctx, organizeCharacterID, 99, true, nil)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	tooMany := make([]string, MaxTags+1)
+	for i := range tooMany {
+		tooMany[i] = string(rune('a' + i))
+	}
+	for _, tags := range [][]string{{" "}, {"this-tag-is-far-too-long-to-keep"}, tooMany} {
+		_, err = service.SetItemFlags(ctx, organizeCharacterID, 11, false, tags)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+	mockDB.AssertExpectations(t)
+}
+
+func TestService_SortInventory(t *testing.T) {
+	characterUUID := createTestCharacterUUID(organizeCharacterID)
+	tests := []struct {
+		name           string
+		order          inventoryV1.InventorySortOrder
+		favoritesFirst bool
+		want           []int32
+	}{
+		{"name", inventoryV1.InventorySortOrder_INVENTORY_SORT_ORDER_NAME, false, []int32{1, 2, 3}},
+		{"type", inventoryV1.InventorySortOrder_INVENTORY_SORT_ORDER_TYPE, false, []int32{1, 2, 3}},
+		{"rarity", inventoryV1.InventorySortOrder_INVENTORY_SORT_ORDER_RARITY, false, []int32{3, 2, 1}},
+		{"quantity", inventoryV1.InventorySortOrder_INVENTORY_SORT_ORDER_QUANTITY, false, []int32{2, 1, 3}},
+		{"recent", inventoryV1.InventorySortOrder_INVENTORY_SORT_ORDER_RECENT, false, []int32{1, 3, 2}},
+		{"favorites first", inventoryV1.InventorySortOrder_INVENTORY_SORT_ORDER_QUANTITY, true, []int32{3, 2, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows := organizeInventoryRows()
+			now := time.Now()
+			rows[0].UpdatedAt = pgtype.Timestamp{Time: now, Valid: true}
+			rows[1].UpdatedAt = pgtype.Timestamp{Time: now.Add(-2 * time.Hour), Valid: true}
+			rows[2].UpdatedAt = pgtype.Timestamp{Time: now.Add(-time.Hour), Valid: true}
+
+			mockDB := &MockDatabaseInterface{}
+			mockDB.On("GetCharacterInventory", mock.Anything, characterUUID).Return(rows, nil)
+			mockDB.On("ListInventoryFlags", mock.Anything, characterUUID).Return([]db.CharacterInventoryFlag{{InventoryID: 3, Favorite: true}}, nil)
+			mockDB.On("SetInventorySortPositions", mock.Anything, db.SetInventorySortPositionsParams{
+				InventoryIds: tt.want,
+				CharacterID:  characterUUID,
+			}).Return(nil)
+			service := &Service{db: mockDB, logger: testmocks.NopLogger[LoggerInterface]{}}
+
+			items, err := service.SortInventory(context.Background(), organizeCharacterID, tt.order, tt.favoritesFirst)
+			require.NoError(t, err)
+			var got []int32
+			for _, item := range items {
+				got = append(got, item.Id)
+			}
+			assert.Equal(t, tt.want, got)
+			mockDB.AssertExpectations(t)
+		})
+	}
+
+	service := &Service{db: &MockDatabaseInterface{}, logger: testmocks.NopLogger[LoggerInterface]{}}
+	_, err := service.SortInventory(context.Background(), organizeCharacterID, inventoryV1.InventorySortOrder_INVENTORY_SORT_ORDER_UNSPECIFIED, false)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestFilterInventory(t *testing.T) {
+	items := []*inventoryV1.InventoryItem{
+		{Id: 1, ItemName: "Berries", ItemType: "food", Rarity: "common", UpdatedAt: timestamppb.Now()},
+		{Id: 2, ItemName: "Herb", ItemType: "resource", Rarity: "uncommon", Favorite: true, Tags: []string{"alchemy"}},
+		{Id: 3, ItemName: "Honey", ItemType: "food", Rarity: "rare", Tags: []string{"alchemy"}},
+	}
+	ids := func(items []*inventoryV1.InventoryItem) []int32 {
+		var got []int32
+		for _, item := range items {
+			got = append(got, item.Id)
+		}
+		return got
+	}
+
+	assert.Len(t, FilterInventory(items, nil), 3)
+	assert.Equal(t, []int32{1, 3}, ids(FilterInventory(items, &inventoryV1.InventoryFilter{ItemTypes: []string{"FOOD"}})))
+	assert.Equal(t, []int32{2, 3}, ids(FilterInventory(items, &inventoryV1.InventoryFilter{Rarities: []string{"uncommon", "rare"}})))
+	assert.Equal(t, []int32{2, 3}, ids(FilterInventory(items, &inventoryV1.InventoryFilter{NamePrefix: "h"})))
+	assert.Equal(t, []int32{2}, ids(FilterInventory(items, &inventoryV1.InventoryFilter{FavoritesOnly: true})))
+	assert.Equal(t, []int32{3}, ids(FilterInventory(items, &inventoryV1.InventoryFilter{Tag: "Alchemy", ItemTypes: []string{"food"}})))
+	assert.Empty(t, FilterInventory(items, &inventoryV1.InventoryFilter{NamePrefix: "z"}))
+}
@@ -0,0 +1,569 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"testing/quick"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	"github.com/VoidMesh/api/api/internal/testutil"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Property tests run random sequences of inventory operations and check after every
+// step that quantities stay positive, transfers conserve totals and deliveries respect
+// the slot limit. They run against an in-memory backend and, when TEST_DATABASE_URL is
+// set, against Postgres through DatabaseWrapper.
+
+// capacityCase is a random inventory and a random sequence of takes from it
+type capacityCase struct {
+	Slots      int32
+	StackSizes []int32 // Indexed by item ID
+	Existing   []int32 // Quantity held of each item
+	Takes      [][2]int32
+}
+
+func (capacityCase) Generate(r *rand.Rand, size int) reflect.Value {
+	items := 1 + r.Intn(4)
+	c := capacityCase{Slots: int32(r.Intn(8))}
+	for range items {
+		c.StackSizes = append(c.StackSizes, int32(1+r.Intn(10)))
+		c.Existing = append(c.Existing, int32(r.Intn(12)))
+	}
+	for range 1 + r.Intn(size+1) {
+		c.Takes = append(c.Takes, [2]int32{int32(r.Intn(items)), int32(1 + r.Intn(30))})
+	}
+	return reflect.ValueOf(c)
+}
+
+func TestCapacityTakeProperties(t *testing.T) {
+	property := func(c capacityCase) bool {
+		var rows []db.GetCharacterInventoryRow
+		for itemID, quantity := range c.Existing {
+			if quantity > 0 {
+				rows = append(rows, db.GetCharacterInventoryRow{ItemID: int32(itemID), Quantity: quantity, StackSize: c.StackSizes[itemID]})
+			}
+		}
+		room := newCapacity(pgtype.Int4{Int32: c.Slots, Valid: c.Slots > 0}, rows)
+		withinLimit := c.Slots == 0 || room.used() <= c.Slots
+
+		for _, take := range c.Takes {
+			itemID, quantity := take[0], take[1]
+			before := room.quantities[itemID]
+			fit := room.take(itemID, c.StackSizes[itemID], quantity)
+			if fit < 0 || fit > quantity {
+				t.Logf("take(%d, %d) fit %d", itemID, quantity, fit)
+				return false
+			}
+			if room.quantities[itemID] != before+fit {
+				t.Logf("take(%d, %d) moved %d to %d, fit %d", itemID, quantity, before, room.quantities[itemID], fit)
+				return false
+			}
+			if withinLimit && c.Slots > 0 && room.used() > c.Slots {
+				t.Logf("take(%d, %d) used %d of %d slots", itemID, quantity, room.used(), c.Slots)
+				return false
+			}
+			// Anything less than the full quantity means the item no longer fits at all
+			if fit < quantity && room.take(itemID, c.StackSizes[itemID], 1) != 0 {
+				t.Logf("take(%d, %d) fit %d but room was left", itemID, quantity, fit)
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(property, &quick.Config{MaxCount: 500}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSlotsForProperties(t *testing.T) {
+	property := func(quantity, stackSize uint16) bool {
+		q, size := int32(quantity), int32(stackSize)
+		slots := slotsFor(q, size)
+		if size < 1 {
+			size = 1
+		}
+		// Splitting the quantity into stacks needs exactly enough stacks to hold it
+		return slots >= 0 && int64(slots)*int64(size) >= int64(q) && int64(slots-1)*int64(size) < int64(max(q, 1))
+	}
+	if err := quick.Check(property, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+type opKind int
+
+const (
+	opAdd opKind = iota
+	opRemove
+	opTrade
+	opDeliver
+	opKinds
+)
+
+func (k opKind) String() string {
+	return [...]string{"add", "remove", "trade", "deliver"}[k]
+}
+
+// inventoryOp is one step of a random sequence. Characters and items are indexes into
+// the fixture.
+type inventoryOp struct {
+	Kind     opKind
+	From, To int
+	Item     int
+	Quantity int32
+	Extra    int   // Second item of a delivery
+	Second   int32 // Quantity of the second item; 0 delivers one item
+}
+
+func (op inventoryOp) String() string {
+	switch op.Kind {
+	case opTrade:
+		return fmt.Sprintf("trade %d of item %d from %d to %d", op.Quantity, op.Item, op.From, op.To)
+	case opDeliver:
+		return fmt.Sprintf("deliver %d of item %d and %d of item %d to %d", op.Quantity, op.Item, op.Second, op.Extra, op.From)
+	}
+	return fmt.Sprintf("%s %d of item %d for %d", op.Kind, op.Quantity, op.Item, op.From)
+}
+
+// opSequence is a random world configuration and the operations run in it
+type opSequence struct {
+	Slots  int32 // 0: unlimited
+	Policy string
+	Ops    []inventoryOp
+}
+
+func (s opSequence) String() string {
+	ops := make([]string, len(s.Ops))
+	for i, op := range s.Ops {
+		ops[i] = op.String()
+	}
+	return fmt.Sprintf("slots=%d policy=%s [%s]", s.Slots, s.Policy, strings.Join(ops, "; "))
+}
+
+func (opSequence) Generate(r *rand.Rand, size int) reflect.Value {
+	s := opSequence{
+		Slots:  int32(r.Intn(7)),
+		Policy: []string{OverflowReject, OverflowGround}[r.Intn(2)],
+	}
+	for range 1 + r.Intn(size+1) {
+		op := inventoryOp{
+			Kind:     opKind(r.Intn(int(opKinds))),
+			From:     r.Intn(2),
+			To:       r.Intn(2),
+			Item:     r.Intn(3),
+			Quantity: int32(1 + r.Intn(12)),
+			Extra:    r.Intn(3),
+		}
+		if r.Intn(2) == 0 {
+			op.Second = int32(1 + r.Intn(12))
+		}
+		s.Ops = append(s.Ops, op)
+	}
+	return reflect.ValueOf(s)
+}
+
+// propertyFixture is a backend with two characters in one world and three items
+type propertyFixture struct {
+	db         DatabaseInterface
+	worldID    pgtype.UUID
+	characters []pgtype.UUID
+	items      []Grant // ItemID and StackSize
+	// reset empties both inventories and configures the world for the sequence
+	reset func(ctx context.Context, s opSequence) error
+}
+
+// inventoryModel is what the inventories should hold
+type inventoryModel []map[int32]int32
+
+func (m inventoryModel) total(itemID int32) int32 {
+	var total int32
+	for _, held := range m {
+		total += held[itemID]
+	}
+	return total
+}
+
+func runSequence(ctx context.Context, fx *propertyFixture, s opSequence) error {
+	if err := fx.reset(ctx, s); err != nil {
+		return fmt.Errorf("reset: %w", err)
+	}
+	service := NewService(fx.db, nil, testmocks.NopLogger[LoggerInterface]{})
+	model := make(inventoryModel, len(fx.characters))
+	for i := range model {
+		model[i] = map[int32]int32{}
+	}
+
+	for step, op := range s.Ops {
+		if err := applyOp(ctx, service, fx, model, s, op); err != nil {
+			return fmt.Errorf("step %d (%s): %w", step, op, err)
+		}
+		if err := checkInventories(ctx, fx, model); err != nil {
+			return fmt.Errorf("after step %d (%s): %w", step, op, err)
+		}
+	}
+	return nil
+}
+
+func applyOp(ctx context.Context, service *Service, fx *propertyFixture, model inventoryModel, s opSequence, op inventoryOp) error {
+	from := uuid.PgtypeToString(fx.characters[op.From])
+	to := uuid.PgtypeToString(fx.characters[op.To])
+	item := fx.items[op.Item]
+
+	switch op.Kind {
+	case opAdd:
+		stack, err := service.AddInventoryItem(ctx, from, item.ItemID, op.Quantity)
+		if err != nil {
+			return err
+		}
+		model[op.From][item.ItemID] += op.Quantity
+		if stack.Quantity != model[op.From][item.ItemID] {
+			return fmt.Errorf("stack has %d, want %d", stack.Quantity, model[op.From][item.ItemID])
+		}
+
+	case opRemove:
+		return removeFromModel(ctx, service, model, op.From, from, item.ItemID, op.Quantity)
+
+	case opTrade:
+		total := model.total(item.ItemID)
+		if err := removeFromModel(ctx, service, model, op.From, from, item.ItemID, op.Quantity); err != nil {
+			return err
+		}
+		if model.total(item.ItemID) < total {
+			if _, err := service.AddInventoryItem(ctx, to, item.ItemID, op.Quantity); err != nil {
+				return err
+			}
+			model[op.To][item.ItemID] += op.Quantity
+		}
+		if model.total(item.ItemID) != total {
+			return fmt.Errorf("trade changed the total of item %d from %d to %d", item.ItemID, total, model.total(item.ItemID))
+		}
+
+	case opDeliver:
+		grants := []Grant{{ItemID: item.ItemID, StackSize: item.StackSize, Quantity: op.Quantity}}
+		if op.Second > 0 {
+			extra := fx.items[op.Extra]
+			grants = append(grants, Grant{ItemID: extra.ItemID, StackSize: extra.StackSize, Quantity: op.Second})
+		}
+		room := newCapacity(pgtype.Int4{Int32: s.Slots, Valid: s.Slots > 0}, modelRows(fx, model[op.From]))
+		withinLimit := s.Slots == 0 || room.used() <= s.Slots
+
+		result, err := service.Deliver(ctx, Delivery{
+			CharacterID: fx.characters[op.From],
+			WorldID:     fx.worldID,
+			Grants:      grants,
+			Reason:      "property test",
+		})
+		if status.Code(err) == codes.ResourceExhausted {
+			if s.Policy != OverflowReject {
+				return fmt.Errorf("delivery rejected under the %s policy", s.Policy)
+			}
+			return nil // Nothing was granted; checkInventories verifies it
+		}
+		if err != nil {
+			return err
+		}
+		for i, delivered := range result.Grants {
+			if delivered.Granted < 0 || delivered.Overflow < 0 || delivered.Granted+delivered.Overflow != grants[i].Quantity {
+				return fmt.Errorf("grant of %d split into %d granted and %d overflow", grants[i].Quantity, delivered.Granted, delivered.Overflow)
+			}
+			if delivered.Granted > 0 {
+				model[op.From][delivered.ItemID] += delivered.Granted
+			}
+		}
+		room = newCapacity(pgtype.Int4{Int32: s.Slots, Valid: s.Slots > 0}, modelRows(fx, model[op.From]))
+		if withinLimit && s.Slots > 0 && room.used() > s.Slots {
+			return fmt.Errorf("delivery filled %d of %d slots", room.used(), s.Slots)
+		}
+	}
+	return nil
+}
+
+// removeFromModel removes quantity from the character, expecting a failure that changes
+// nothing when the character holds less
+func removeFromModel(ctx context.Context, service *Service, model inventoryModel, index int, characterID string, itemID, quantity int32) error {
+	stack, err := service.RemoveInventoryItem(ctx, characterID, itemID, quantity)
+	held := model[index][itemID]
+	if held < quantity {
+		if err == nil {
+			return fmt.Errorf("removed %d of item %d while holding %d", quantity, itemID, held)
+		}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	model[index][itemID] = held - quantity
+	if model[index][itemID] == 0 {
+		delete(model[index], itemID)
+		if stack != nil {
+			return fmt.Errorf("emptied stack returned with %d", stack.Quantity)
+		}
+		return nil
+	}
+	if stack == nil || stack.Quantity != model[index][itemID] {
+		return fmt.Errorf("stack after removal is %v, want %d", stack, model[index][itemID])
+	}
+	return nil
+}
+
+func modelRows(fx *propertyFixture, held map[int32]int32) []db.GetCharacterInventoryRow {
+	var rows []db.GetCharacterInventoryRow
+	for _, item := range fx.items {
+		if quantity := held[item.ItemID]; quantity > 0 {
+			rows = append(rows, db.GetCharacterInventoryRow{ItemID: item.ItemID, Quantity: quantity, StackSize: item.StackSize})
+		}
+	}
+	return rows
+}
+
+// checkInventories compares the stored inventories with the model
+func checkInventories(ctx context.Context, fx *propertyFixture, model inventoryModel) error {
+	for i, characterID := range fx.characters {
+		rows, err := fx.db.GetCharacterInventory(ctx, characterID)
+		if err != nil {
+			return err
+		}
+		stored := map[int32]int32{}
+		for _, row := range rows {
+			if row.Quantity <= 0 {
+				return fmt.Errorf("character %d holds %d of item %d", i, row.Quantity, row.ItemID)
+			}
+			if _, ok := stored[row.ItemID]; ok {
+				return fmt.Errorf("character %d has two stacks of item %d", i, row.ItemID)
+			}
+			stored[row.ItemID] = row.Quantity
+		}
+		if !reflect.DeepEqual(stored, model[i]) {
+			return fmt.Errorf("character %d holds %v, want %v", i, stored, model[i])
+		}
+	}
+	return nil
+}
+
+func checkSequences(t *testing.T, fx *propertyFixture, count int) {
+	t.Helper()
+	ctx := context.Background()
+	property := func(s opSequence) bool {
+		if err := runSequence(ctx, fx, s); err != nil {
+			t.Log(err)
+			return false
+		}
+		return true
+	}
+	if err := quick.Check(property, &quick.Config{MaxCount: count}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInventoryOperationProperties(t *testing.T) {
+	t.Run("memory", func(t *testing.T) {
+		checkSequences(t, newMemoryFixture(), 300)
+	})
+
+	t.Run("postgres", func(t *testing.T) {
+		if os.Getenv("TEST_DATABASE_URL") == "" {
+			t.Skip("TEST_DATABASE_URL is not set")
+		}
+		checkSequences(t, newPostgresFixture(t), 25)
+	})
+}
+
+// memoryInventory is an in-memory DatabaseInterface covering the methods the property
+// tests use. Deliveries reuse capacity, so they exercise the same slot arithmetic as
+// DatabaseWrapper.
+type memoryInventory struct {
+	DatabaseInterface
+	stackSizes map[int32]int32
+	stacks     map[pgtype.UUID]map[int32]int32
+	settings   map[pgtype.UUID]db.WorldInventorySetting
+	ground     map[int32]int32
+}
+
+func newMemoryFixture() *propertyFixture {
+	mem := &memoryInventory{stackSizes: map[int32]int32{1: 5, 2: 3, 3: 1}}
+	fx := &propertyFixture{
+		db:      mem,
+		worldID: propertyUUID("650e8400-e29b-41d4-a716-446655440000"),
+		characters: []pgtype.UUID{
+			propertyUUID("750e8400-e29b-41d4-a716-446655440000"),
+			propertyUUID("750e8400-e29b-41d4-a716-446655440001"),
+		},
+		items: []Grant{{ItemID: 1, StackSize: 5}, {ItemID: 2, StackSize: 3}, {ItemID: 3, StackSize: 1}},
+	}
+	fx.reset = func(ctx context.Context, s opSequence) error {
+		mem.stacks = map[pgtype.UUID]map[int32]int32{}
+		mem.ground = map[int32]int32{}
+		mem.settings = map[pgtype.UUID]db.WorldInventorySetting{}
+		_, err := mem.UpsertWorldInventorySettings(ctx, db.UpsertWorldInventorySettingsParams{
+			WorldID:         fx.worldID,
+			InventorySlots:  pgtype.Int4{Int32: s.Slots, Valid: s.Slots > 0},
+			HarvestOverflow: s.Policy,
+		})
+		return err
+	}
+	return fx
+}
+
+func (m *memoryInventory) GetCharacterInventory(ctx context.Context, characterID pgtype.UUID) ([]db.GetCharacterInventoryRow, error) {
+	var rows []db.GetCharacterInventoryRow
+	for itemID, quantity := range m.stacks[characterID] {
+		rows = append(rows, db.GetCharacterInventoryRow{CharacterID: characterID, ItemID: itemID, Quantity: quantity, StackSize: m.stackSizes[itemID]})
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].ItemID < rows[j].ItemID })
+	return rows, nil
+}
+
+func (m *memoryInventory) GrantInventoryItem(ctx context.Context, arg db.CreateInventoryItemParams) (db.CharacterInventory, error) {
+	held := m.stacks[arg.CharacterID]
+	if held == nil {
+		held = map[int32]int32{}
+		m.stacks[arg.CharacterID] = held
+	}
+	held[arg.ItemID] += arg.Quantity
+	return db.CharacterInventory{CharacterID: arg.CharacterID, ItemID: arg.ItemID, Quantity: held[arg.ItemID]}, nil
+}
+
+func (m *memoryInventory) TakeInventoryItem(ctx context.Context, arg db.RemoveInventoryItemQuantityParams) (db.CharacterInventory, error) {
+	held := m.stacks[arg.CharacterID]
+	quantity, ok := held[arg.ItemID]
+	if !ok || quantity < arg.Quantity {
+		return db.CharacterInventory{}, pgx.ErrNoRows
+	}
+	quantity -= arg.Quantity
+	if quantity == 0 {
+		delete(held, arg.ItemID)
+	} else {
+		held[arg.ItemID] = quantity
+	}
+	return db.CharacterInventory{CharacterID: arg.CharacterID, ItemID: arg.ItemID, Quantity: quantity}, nil
+}
+
+func (m *memoryInventory) DeliverItems(ctx context.Context, delivery Delivery) (DeliveryResult, error) {
+	settings, ok := m.settings[delivery.WorldID]
+	if !ok {
+		settings = db.WorldInventorySetting{WorldID: delivery.WorldID, HarvestOverflow: OverflowReject}
+	}
+	rows, err := m.GetCharacterInventory(ctx, delivery.CharacterID)
+	if err != nil {
+		return DeliveryResult{}, err
+	}
+	room := newCapacity(settings.InventorySlots, rows)
+
+	result := DeliveryResult{Policy: settings.HarvestOverflow}
+	for _, grant := range delivery.Grants {
+		delivered := DeliveredGrant{Grant: grant}
+		delivered.Granted = room.take(grant.ItemID, grant.StackSize, grant.Quantity)
+		delivered.Overflow = grant.Quantity - delivered.Granted
+		if delivered.Overflow > 0 && settings.HarvestOverflow == OverflowReject {
+			return DeliveryResult{}, ErrInventoryFull
+		}
+		result.Grants = append(result.Grants, delivered)
+	}
+	// Nothing is written until every grant is accepted, like the rolled back transaction
+	for i, delivered := range result.Grants {
+		if delivered.Granted > 0 {
+			result.Grants[i].Stack, _ = m.GrantInventoryItem(ctx, db.CreateInventoryItemParams{
+				CharacterID: delivery.CharacterID,
+				ItemID:      delivered.ItemID,
+				Quantity:    delivered.Granted,
+			})
+		}
+		m.ground[delivered.ItemID] += delivered.Overflow
+	}
+	return result, nil
+}
+
+func (m *memoryInventory) UpsertWorldInventorySettings(ctx context.Context, arg db.UpsertWorldInventorySettingsParams) (db.WorldInventorySetting, error) {
+	settings := db.WorldInventorySetting{
+		WorldID:         arg.WorldID,
+		InventorySlots:  arg.InventorySlots,
+		HarvestOverflow: arg.HarvestOverflow,
+	}
+	m.settings[arg.WorldID] = settings
+	return settings, nil
+}
+
+// newPostgresFixture needs a database with the schema applied. It creates a world with
+// two characters and uses three of the seeded items.
+func newPostgresFixture(t *testing.T) *propertyFixture {
+	t.Helper()
+	ctx := context.Background()
+	config := testutil.DefaultDatabaseConfig()
+	config.SeedData = false
+	testDB := testutil.SetupTestDB(t, config)
+
+	fx := &propertyFixture{
+		db:      NewDatabaseWrapper(testDB.Pool),
+		worldID: propertyUUID(testutil.UUIDTestData.World1),
+	}
+	if _, err := testDB.Pool.Exec(ctx, `INSERT INTO worlds (id, name, seed) VALUES ($1, 'Inventory Properties', 1) ON CONFLICT (id) DO NOTHING`, fx.worldID); err != nil {
+		t.Fatalf("failed to create the test world: %v", err)
+	}
+	for i, id := range []string{"750e8400-e29b-41d4-a716-4466554400a0", "750e8400-e29b-41d4-a716-4466554400a1"} {
+		characterID := propertyUUID(id)
+		if _, err := testDB.Pool.Exec(ctx, `INSERT INTO characters (id, world_id, name) VALUES ($1, $2, $3) ON CONFLICT (id) DO NOTHING`,
+			characterID, fx.worldID, fmt.Sprintf("PropertyCharacter%d", i+1)); err != nil {
+			t.Fatalf("failed to create test character: %v", err)
+		}
+		fx.characters = append(fx.characters, characterID)
+	}
+	for _, name := range []string{"Herbs", "Berries", "Minerals"} {
+		item, err := testDB.Queries.GetItemByName(ctx, name)
+		if err != nil {
+			t.Fatalf("failed to get item %s: %v", name, err)
+		}
+		fx.items = append(fx.items, Grant{ItemID: item.ID, StackSize: item.StackSize})
+	}
+
+	clear := func(ctx context.Context) error {
+		for _, characterID := range fx.characters {
+			if _, err := testDB.Pool.Exec(ctx, `DELETE FROM character_inventories WHERE character_id = $1`, characterID); err != nil {
+				return err
+			}
+			if _, err := testDB.Pool.Exec(ctx, `DELETE FROM ground_drops WHERE dropped_by = $1`, characterID); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	fx.reset = func(ctx context.Context, s opSequence) error {
+		if err := clear(ctx); err != nil {
+			return err
+		}
+		_, err := fx.db.UpsertWorldInventorySettings(ctx, db.UpsertWorldInventorySettingsParams{
+			WorldID:         fx.worldID,
+			InventorySlots:  pgtype.Int4{Int32: s.Slots, Valid: s.Slots > 0},
+			HarvestOverflow: s.Policy,
+			UpdatedAt:       pgtype.Timestamp{Time: time.Now(), Valid: true},
+		})
+		return err
+	}
+	t.Cleanup(func() {
+		if err := clear(context.Background()); err != nil && !errors.Is(err, context.Canceled) {
+			t.Logf("failed to clean up inventories: %v", err)
+		}
+	})
+	return fx
+}
+
+func propertyUUID(id string) pgtype.UUID {
+	parsed, err := uuid.StringToPgtype(id)
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
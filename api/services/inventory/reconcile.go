@@ -0,0 +1,84 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/uuid"
+)
+
+// StackMerge describes duplicate rows for one character and item, merged into the
+// oldest of them
+type StackMerge struct {
+	CharacterID  string
+	ItemID       int32
+	InventoryIDs []int32 // The kept row first
+	Quantity     int32   // Quantity of the merged stack
+}
+
+// OverfullStack describes a stack holding more than its item's stack size. Stacks are
+// one row per character and item until item instances exist, so the overflow cannot be
+// split off yet and is only reported.
+type OverfullStack struct {
+	InventoryID int32
+	CharacterID string
+	ItemID      int32
+	Quantity    int32
+	StackSize   int32
+}
+
+// ReconcileReport lists what Reconcile merged, or would merge in a dry run, and the
+// overfull stacks it found
+type ReconcileReport struct {
+	DryRun   bool
+	Merges   []StackMerge
+	Overfull []OverfullStack
+}
+
+// Reconcile merges duplicate stacks of the same item into one row per character and
+// reports stacks over their item's stack size. A dry run only reports.
+func (s *Service) Reconcile(ctx context.Context, dryRun bool) (*ReconcileReport, error) {
+	report := &ReconcileReport{DryRun: dryRun}
+
+	duplicates, err := s.db.ListDuplicateInventoryStacks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list duplicate stacks: %w", err)
+	}
+	for _, duplicate := range duplicates {
+		merge := StackMerge{
+			CharacterID:  uuid.PgtypeToString(duplicate.CharacterID),
+			ItemID:       duplicate.ItemID,
+			InventoryIDs: duplicate.InventoryIds,
+			Quantity:     duplicate.TotalQuantity,
+		}
+		if !dryRun {
+			// Merge rather than trust the listed total, which may be stale by now
+			merge.Quantity, err = s.db.MergeInventoryStacks(ctx, db.MergeInventoryStacksParams{
+				KeepID:      duplicate.InventoryIds[0],
+				CharacterID: duplicate.CharacterID,
+				ItemID:      duplicate.ItemID,
+			})
+			if err != nil {
+				return report, fmt.Errorf("failed to merge stacks of item %d for character %s: %w", duplicate.ItemID, merge.CharacterID, err)
+			}
+			s.logger.Info("Merged duplicate inventory stacks", "character_id", merge.CharacterID, "item_id", merge.ItemID, "rows", len(merge.InventoryIDs), "quantity", merge.Quantity)
+		}
+		report.Merges = append(report.Merges, merge)
+	}
+
+	overfull, err := s.db.ListOverfullInventoryStacks(ctx)
+	if err != nil {
+		return report, fmt.Errorf("failed to list overfull stacks: %w", err)
+	}
+	for _, row := range overfull {
+		report.Overfull = append(report.Overfull, OverfullStack{
+			InventoryID: row.ID,
+			CharacterID: uuid.PgtypeToString(row.CharacterID),
+			ItemID:      row.ItemID,
+			Quantity:    row.Quantity,
+			StackSize:   row.StackSize,
+		})
+	}
+	return report, nil
+}
@@ -0,0 +1,66 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Reconcile(t *testing.T) {
+	characterUUID := createTestCharacterUUID(organizeCharacterID)
+	duplicates := []db.ListDuplicateInventoryStacksRow{
+		{CharacterID: characterUUID, ItemID: 10, InventoryIds: []int32{4, 9}, TotalQuantity: 30},
+	}
+	overfull := []db.ListOverfullInventoryStacksRow{
+		{ID: 7, CharacterID: characterUUID, ItemID: 11, Quantity: 150, StackSize: 100},
+	}
+
+	t.Run("dry run only reports", func(t *testing.T) {
+		mockDB := &MockDatabaseInterface{}
+		mockDB.On("ListDuplicateInventoryStacks", mock.Anything).Return(duplicates, nil)
+		mockDB.On("ListOverfullInventoryStacks", mock.Anything).Return(overfull, nil)
+		service := &Service{db: mockDB, logger: testmocks.NopLogger[LoggerInterface]{}}
+
+		report, err := service.Reconcile(context.Background(), true)
+		require.NoError(t, err)
+		assert.True(t, report.DryRun)
+		require.Len(t, report.Merges, 1)
+		assert.Equal(t, StackMerge{CharacterID: "550e8400-e29b-41d4-a716-446655440000", ItemID: 10, InventoryIDs: []int32{4, 9}, Quantity: 30}, report.Merges[0])
+		require.Len(t, report.Overfull, 1)
+		assert.Equal(t, int32(150), report.Overfull[0].Quantity)
+		mockDB.AssertNotCalled(t, "MergeInventoryStacks", mock.Anything, mock.Anything)
+	})
+
+	t.Run("merges into the oldest row", func(t *testing.T) {
+		mockDB := &MockDatabaseInterface{}
+		mockDB.On("ListDuplicateInventoryStacks", mock.Anything).Return(duplicates, nil)
+		mockDB.On("MergeInventoryStacks", mock.Anything, db.MergeInventoryStacksParams{KeepID: 4, CharacterID: characterUUID, ItemID: 10}).Return(int32(32), nil)
+		mockDB.On("ListOverfullInventoryStacks", mock.Anything).Return([]db.ListOverfullInventoryStacksRow{}, nil)
+		service := &Service{db: mockDB, logger: testmocks.NopLogger[LoggerInterface]{}}
+
+		report, err := service.Reconcile(context.Background(), false)
+		require.NoError(t, err)
+		require.Len(t, report.Merges, 1)
+		assert.Equal(t, int32(32), report.Merges[0].Quantity, "the merged quantity comes from the merge itself")
+		assert.Empty(t, report.Overfull)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("merge failure keeps the partial report", func(t *testing.T) {
+		mockDB := &MockDatabaseInterface{}
+		mockDB.On("ListDuplicateInventoryStacks", mock.Anything).Return(duplicates, nil)
+		mockDB.On("MergeInventoryStacks", mock.Anything, mock.Anything).Return(int32(0), errors.New("connection reset"))
+		service := &Service{db: mockDB, logger: testmocks.NopLogger[LoggerInterface]{}}
+
+		report, err := service.Reconcile(context.Background(), false)
+		assert.ErrorContains(t, err, "failed to merge stacks of item 10")
+		require.NotNil(t, report)
+		assert.Empty(t, report.Merges)
+	})
+}
@@ -0,0 +1,90 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	adminV1 "github.com/VoidMesh/api/api/proto/admin/v1"
+	inventoryV1 "github.com/VoidMesh/api/api/proto/inventory/v1"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// WorldSettings returns the inventory settings of a world, or the defaults for worlds
+// that were never configured
+func (s *Service) WorldSettings(ctx context.Context, worldID string) (*adminV1.WorldInventorySettings, error) {
+	id, err := uuid.StringToPgtype(worldID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid world ID format")
+	}
+	settings, err := s.db.GetWorldInventorySettings(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		settings = db.WorldInventorySetting{WorldID: id, HarvestOverflow: OverflowReject}
+	} else if err != nil {
+		s.logger.Error("Failed to get world inventory settings", "world_id", worldID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to get world inventory settings")
+	}
+	return dbWorldSettingsToProto(settings), nil
+}
+
+// SetWorldSettings replaces the inventory settings of a world. They apply to the next
+// delivery; inventories already over a lowered slot limit keep their items.
+func (s *Service) SetWorldSettings(ctx context.Context, updatedBy string, req *adminV1.SetWorldInventorySettingsRequest) (*adminV1.WorldInventorySettings, error) {
+	worldID, err := uuid.StringToPgtype(req.WorldId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid world ID format")
+	}
+	if req.InventorySlots < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "inventory_slots must not be negative")
+	}
+	policy := OverflowReject
+	if req.HarvestOverflow != inventoryV1.OverflowPolicy_OVERFLOW_POLICY_UNSPECIFIED {
+		policy = ""
+		for name, value := range overflowPolicies {
+			if value == req.HarvestOverflow {
+				policy = name
+			}
+		}
+		if policy == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown overflow policy")
+		}
+	}
+	updatedByID, err := uuid.StringToPgtype(updatedBy)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID format")
+	}
+
+	settings, err := s.db.UpsertWorldInventorySettings(ctx, db.UpsertWorldInventorySettingsParams{
+		WorldID:         worldID,
+		InventorySlots:  pgtype.Int4{Int32: req.InventorySlots, Valid: req.InventorySlots > 0},
+		HarvestOverflow: policy,
+		UpdatedBy:       updatedByID,
+		UpdatedAt:       pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
+	})
+	if err != nil {
+		s.logger.Error("Failed to set world inventory settings", "world_id", req.WorldId, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to set world inventory settings")
+	}
+	s.logger.Info("World inventory settings changed", "world_id", req.WorldId, "inventory_slots", req.InventorySlots, "harvest_overflow", policy, "updated_by", updatedBy)
+	return dbWorldSettingsToProto(settings), nil
+}
+
+func dbWorldSettingsToProto(settings db.WorldInventorySetting) *adminV1.WorldInventorySettings {
+	proto := &adminV1.WorldInventorySettings{
+		WorldId:         uuid.PgtypeToString(settings.WorldID),
+		InventorySlots:  settings.InventorySlots.Int32,
+		HarvestOverflow: overflowPolicies[settings.HarvestOverflow],
+	}
+	if settings.UpdatedBy.Valid {
+		proto.UpdatedBy = uuid.PgtypeToString(settings.UpdatedBy)
+	}
+	if settings.UpdatedAt.Valid {
+		proto.UpdatedAt = timestamppb.New(settings.UpdatedAt.Time)
+	}
+	return proto
+}
@@ -0,0 +1,207 @@
+package land_claim
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/outbox"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/txn"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	"github.com/VoidMesh/api/api/services/economy"
+	"github.com/charmbracelet/log"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DatabaseInterface abstracts database operations for land claims.
+type DatabaseInterface interface {
+	GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error)
+	GetItemByName(ctx context.Context, name string) (db.Item, error)
+	GetLandClaimAt(ctx context.Context, arg db.GetLandClaimAtParams) (db.LandClaim, error)
+	ListLandClaimsByCharacter(ctx context.Context, characterID pgtype.UUID) ([]db.LandClaim, error)
+	ListLandClaimsInRange(ctx context.Context, arg db.ListLandClaimsInRangeParams) ([]db.LandClaim, error)
+	ListLandClaimsDue(ctx context.Context, arg db.ListLandClaimsDueParams) ([]db.LandClaim, error)
+	DeleteLandClaim(ctx context.Context, arg db.DeleteLandClaimParams) (db.LandClaim, error)
+	CreateClaim(ctx context.Context, claim db.CreateLandClaimParams, payment Payment, maxClaims int64) (db.LandClaim, error)
+	PayUpkeep(ctx context.Context, claim db.LandClaim, payment Payment, paidUntil pgtype.Timestamp) error
+	ExpireClaim(ctx context.Context, claim db.LandClaim, userID pgtype.UUID) error
+}
+
+// DatabaseWrapper implements DatabaseInterface using the actual database connection.
+type DatabaseWrapper struct {
+	pool    storage.Pool
+	queries *db.Queries
+}
+
+// NewDatabaseWrapper creates a new database wrapper with the given connection pool.
+func NewDatabaseWrapper(pool storage.Pool) DatabaseInterface {
+	return &DatabaseWrapper{
+		pool:    pool,
+		queries: db.New(pool),
+	}
+}
+
+func (d *DatabaseWrapper) GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error) {
+	return d.queries.GetCharacterById(ctx, id)
+}
+
+func (d *DatabaseWrapper) GetItemByName(ctx context.Context, name string) (db.Item, error) {
+	return d.queries.GetItemByName(ctx, name)
+}
+
+func (d *DatabaseWrapper) GetLandClaimAt(ctx context.Context, arg db.GetLandClaimAtParams) (db.LandClaim, error) {
+	return d.queries.GetLandClaimAt(ctx, arg)
+}
+
+func (d *DatabaseWrapper) ListLandClaimsByCharacter(ctx context.Context, characterID pgtype.UUID) ([]db.LandClaim, error) {
+	return d.queries.ListLandClaimsByCharacter(ctx, characterID)
+}
+
+func (d *DatabaseWrapper) ListLandClaimsInRange(ctx context.Context, arg db.ListLandClaimsInRangeParams) ([]db.LandClaim, error) {
+	return d.queries.ListLandClaimsInRange(ctx, arg)
+}
+
+func (d *DatabaseWrapper) ListLandClaimsDue(ctx context.Context, arg db.ListLandClaimsDueParams) ([]db.LandClaim, error) {
+	return d.queries.ListLandClaimsDue(ctx, arg)
+}
+
+func (d *DatabaseWrapper) DeleteLandClaim(ctx context.Context, arg db.DeleteLandClaimParams) (db.LandClaim, error) {
+	return d.queries.DeleteLandClaim(ctx, arg)
+}
+
+// CreateClaim takes the claim cost from the character's inventory and stores the claim in
+// one serializable transaction, so two concurrent claims cannot both pass the claim limit
+// or spend the same items. The cost is recorded in the economy ledger as destroyed.
+func (d *DatabaseWrapper) CreateClaim(ctx context.Context, claim db.CreateLandClaimParams, payment Payment, maxClaims int64) (db.LandClaim, error) {
+	var created db.LandClaim
+	err := txn.Run(ctx, d.pool, txn.Serializable, func(q *db.Queries) error {
+		count, err := q.CountLandClaimsByCharacter(ctx, claim.CharacterID)
+		if err != nil {
+			return fmt.Errorf("failed to count claims: %w", err)
+		}
+		if count >= maxClaims {
+			return ErrTooManyClaims
+		}
+		if err := pay(ctx, q, claim.CharacterID, payment); err != nil {
+			return err
+		}
+		created, err = q.CreateLandClaim(ctx, claim)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrAlreadyClaimed
+		}
+		if err != nil {
+			return err
+		}
+		return economy.RecordInTx(ctx, q, economy.Flow{
+			WorldID:   created.WorldID,
+			Source:    economy.SourceLandClaim,
+			Destroyed: int64(payment.Quantity),
+			DedupKey:  fmt.Sprintf("%s:%d:cost", economy.SourceLandClaim, created.ID),
+			At:        created.ClaimedAt.Time,
+		})
+	})
+	return created, err
+}
+
+// PayUpkeep takes one upkeep payment from the owner's inventory and extends the claim.
+// The payment is recorded in the economy ledger as destroyed when the upkeep fell due.
+func (d *DatabaseWrapper) PayUpkeep(ctx context.Context, claim db.LandClaim, payment Payment, paidUntil pgtype.Timestamp) error {
+	return txn.Run(ctx, d.pool, txn.Serializable, func(q *db.Queries) error {
+		if err := pay(ctx, q, claim.CharacterID, payment); err != nil {
+			return err
+		}
+		if err := q.ExtendLandClaim(ctx, db.ExtendLandClaimParams{ID: claim.ID, PaidUntil: paidUntil}); err != nil {
+			return err
+		}
+		return economy.RecordInTx(ctx, q, economy.Flow{
+			WorldID:   claim.WorldID,
+			Source:    economy.SourceLandClaim,
+			Destroyed: int64(payment.Quantity),
+			DedupKey:  fmt.Sprintf("%s:%d:%d", economy.SourceLandClaim, claim.ID, paidUntil.Time.Unix()),
+			At:        claim.PaidUntil.Time,
+		})
+	})
+}
+
+// ExpireClaim deletes a claim and enqueues a LandClaimExpired event for its owner
+func (d *DatabaseWrapper) ExpireClaim(ctx context.Context, claim db.LandClaim, userID pgtype.UUID) error {
+	return txn.Run(ctx, d.pool, txn.ReadCommitted, func(q *db.Queries) error {
+		if _, err := q.DeleteLandClaim(ctx, db.DeleteLandClaimParams{ID: claim.ID, CharacterID: claim.CharacterID}); err != nil {
+			return fmt.Errorf("failed to delete claim: %w", err)
+		}
+		characterID := uuid.PgtypeToString(claim.CharacterID)
+		return outbox.Enqueue(ctx, q, events.LandClaimExpired, characterID,
+			fmt.Sprintf("%s:%d", events.LandClaimExpired, claim.ID),
+			events.LandClaimExpiredPayload{
+				ClaimID:     claim.ID,
+				CharacterID: characterID,
+				UserID:      uuid.PgtypeToString(userID),
+				WorldID:     uuid.PgtypeToString(claim.WorldID),
+				ChunkX:      claim.ChunkX,
+				ChunkY:      claim.ChunkY,
+			})
+	})
+}
+
+// pay removes the payment from the character's inventory, failing with
+// ErrInsufficientFunds when it holds too little
+func pay(ctx context.Context, q *db.Queries, characterID pgtype.UUID, payment Payment) error {
+	if payment.Quantity <= 0 {
+		return nil
+	}
+	_, err := q.RemoveInventoryItemQuantity(ctx, db.RemoveInventoryItemQuantityParams{
+		CharacterID: characterID,
+		ItemID:      payment.ItemID,
+		Quantity:    payment.Quantity,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrInsufficientFunds
+	}
+	if err != nil {
+		return fmt.Errorf("failed to take payment: %w", err)
+	}
+	return q.DeleteEmptyInventoryItems(ctx, characterID)
+}
+
+// LoggerInterface abstracts logging operations for dependency injection.
+type LoggerInterface interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+	With(keysAndValues ...interface{}) LoggerInterface
+}
+
+// DefaultLoggerWrapper wraps the internal logging package.
+type DefaultLoggerWrapper struct {
+	logger *log.Logger
+}
+
+func NewDefaultLoggerWrapper() LoggerInterface {
+	return &DefaultLoggerWrapper{logger: logging.GetLogger()}
+}
+
+func (l *DefaultLoggerWrapper) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.Debug(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Info(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.Warn(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.Error(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) With(keysAndValues ...interface{}) LoggerInterface {
+	return &DefaultLoggerWrapper{logger: l.logger.With(keysAndValues...)}
+}
@@ -0,0 +1,362 @@
+// Package land_claim lets characters buy ownership of chunks. A claim is paid for with a
+// currency item from the character's inventory and kept by paying upkeep every period;
+// the upkeep job releases claims whose owner cannot pay. Only the owner may harvest or
+// modify terrain in a claimed chunk: gameplay services call CheckAllowed before acting.
+package land_claim
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/alerting"
+	"github.com/VoidMesh/api/api/internal/authz"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	landClaimV1 "github.com/VoidMesh/api/api/proto/land_claim/v1"
+	"github.com/VoidMesh/api/api/services/feature_flag"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// MaxRangeChunks bounds each side of a GetClaimsInChunks rectangle
+	MaxRangeChunks = 64
+
+	// upkeepBatchSize is how many due claims the upkeep job loads per page
+	upkeepBatchSize = 200
+)
+
+var (
+	ErrAlreadyClaimed    = errors.New("chunk is already claimed")
+	ErrInsufficientFunds = errors.New("not enough currency")
+	ErrTooManyClaims     = errors.New("claim limit reached")
+)
+
+// Config sets what claims cost and how often upkeep is collected
+type Config struct {
+	CurrencyItem string        // Name of the item claims are paid in
+	ClaimCost    int32         // Paid when claiming; covers the first upkeep period
+	UpkeepCost   int32         // Paid every UpkeepPeriod to keep a claim
+	UpkeepPeriod time.Duration // How long each payment keeps a claim
+	MaxClaims    int64         // Claims a character may hold at once
+	Interval     time.Duration // Time between upkeep passes
+}
+
+// DefaultConfig charges 20 minerals per claim and 5 a day in upkeep, for up to 9 chunks
+func DefaultConfig() Config {
+	return Config{
+		CurrencyItem: "Minerals",
+		ClaimCost:    20,
+		UpkeepCost:   5,
+		UpkeepPeriod: 24 * time.Hour,
+		MaxClaims:    9,
+		Interval:     10 * time.Minute,
+	}
+}
+
+// Payment is a quantity of an item taken from a character's inventory
+type Payment struct {
+	ItemID   int32
+	Quantity int32
+}
+
+// FlagsInterface reports whether a feature flag is on for a user
+type FlagsInterface interface {
+	Enabled(ctx context.Context, flag feature_flag.Flag, userID, worldID pgtype.UUID) bool
+}
+
+// Service manages land claims and collects their upkeep.
+type Service struct {
+	db     DatabaseInterface
+	logger LoggerInterface
+	clock  clock.Clock
+	config Config
+	flags  FlagsInterface
+}
+
+// NewService creates a new land claim service with dependency injection.
+func NewService(db DatabaseInterface, logger LoggerInterface) *Service {
+	componentLogger := logger.With("component", "land-claim-service")
+	componentLogger.Debug("Creating new land claim service")
+	return &Service{
+		db:     db,
+		logger: componentLogger,
+		clock:  clock.New(),
+		config: DefaultConfig(),
+	}
+}
+
+// NewServiceWithPool creates a service with concrete implementations (convenience constructor for production use).
+func NewServiceWithPool(pool storage.Pool) *Service {
+	return NewService(NewDatabaseWrapper(pool), NewDefaultLoggerWrapper())
+}
+
+// SetClock replaces the clock used for timestamps and the upkeep schedule (for simulation tests)
+func (s *Service) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// SetConfig replaces the claim costs and upkeep schedule
+func (s *Service) SetConfig(config Config) {
+	s.config = config
+}
+
+// SetFlags gates new claims behind the land_claims feature flag
+func (s *Service) SetFlags(flags FlagsInterface) {
+	s.flags = flags
+}
+
+// currencyItemID looks up the item claims are paid in
+func (s *Service) currencyItemID(ctx context.Context) (int32, error) {
+	item, err := s.db.GetItemByName(ctx, s.config.CurrencyItem)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get currency item %q: %w", s.config.CurrencyItem, err)
+	}
+	return item.ID, nil
+}
+
+// Terms returns what claims currently cost
+func (s *Service) Terms(ctx context.Context) (*landClaimV1.ClaimTerms, error) {
+	itemID, err := s.currencyItemID(ctx)
+	if err != nil {
+		s.logger.Error("Failed to get claim currency", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to get claim terms")
+	}
+	return &landClaimV1.ClaimTerms{
+		CurrencyItemId:      itemID,
+		ClaimCost:           s.config.ClaimCost,
+		UpkeepCost:          s.config.UpkeepCost,
+		UpkeepPeriodSeconds: int64(s.config.UpkeepPeriod / time.Second),
+		MaxClaims:           int32(s.config.MaxClaims),
+	}, nil
+}
+
+// ClaimChunk claims the chunk the character stands in, paying the claim cost from its
+// inventory. The claim is paid up for one upkeep period.
+func (s *Service) ClaimChunk(ctx context.Context, userID, characterID string) (*landClaimV1.LandClaim, error) {
+	character, err := authz.OwnedCharacter(ctx, s.db, s.logger, userID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	if s.flags != nil && !s.flags.Enabled(ctx, feature_flag.LandClaims, character.UserID, character.WorldID) {
+		return nil, status.Errorf(codes.PermissionDenied, "land claims are not enabled")
+	}
+	itemID, err := s.currencyItemID(ctx)
+	if err != nil {
+		s.logger.Error("Failed to get claim currency", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to claim chunk")
+	}
+
+	now := s.clock.Now()
+	claim, err := s.db.CreateClaim(ctx, db.CreateLandClaimParams{
+		WorldID:     character.WorldID,
+		ChunkX:      character.ChunkX,
+		ChunkY:      character.ChunkY,
+		CharacterID: character.ID,
+		ClaimedAt:   pgtype.Timestamp{Time: now, Valid: true},
+		PaidUntil:   pgtype.Timestamp{Time: now.Add(s.config.UpkeepPeriod), Valid: true},
+	}, Payment{ItemID: itemID, Quantity: s.config.ClaimCost}, s.config.MaxClaims)
+	switch {
+	case errors.Is(err, ErrAlreadyClaimed):
+		return nil, status.Errorf(codes.AlreadyExists, "chunk (%d, %d) is already claimed", character.ChunkX, character.ChunkY)
+	case errors.Is(err, ErrTooManyClaims):
+		return nil, status.Errorf(codes.FailedPrecondition, "a character can hold at most %d claims", s.config.MaxClaims)
+	case errors.Is(err, ErrInsufficientFunds):
+		return nil, status.Errorf(codes.FailedPrecondition, "claiming a chunk costs %d %s", s.config.ClaimCost, s.config.CurrencyItem)
+	case err != nil:
+		s.logger.Error("Failed to create land claim", "character_id", characterID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to claim chunk")
+	}
+
+	s.logger.Info("Chunk claimed", "claim_id", claim.ID, "character_id", characterID, "chunk_x", claim.ChunkX, "chunk_y", claim.ChunkY)
+	return dbClaimToProto(claim), nil
+}
+
+// ReleaseClaim gives up one of the character's claims. Nothing is refunded.
+func (s *Service) ReleaseClaim(ctx context.Context, userID, characterID string, claimID int64) (*landClaimV1.LandClaim, error) {
+	character, err := authz.OwnedCharacter(ctx, s.db, s.logger, userID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	claim, err := s.db.DeleteLandClaim(ctx, db.DeleteLandClaimParams{ID: claimID, CharacterID: character.ID})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "claim not found")
+	}
+	if err != nil {
+		s.logger.Error("Failed to release land claim", "claim_id", claimID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to release claim")
+	}
+
+	s.logger.Info("Chunk released", "claim_id", claim.ID, "character_id", characterID, "chunk_x", claim.ChunkX, "chunk_y", claim.ChunkY)
+	return dbClaimToProto(claim), nil
+}
+
+// ListClaims returns the character's claims, oldest first
+func (s *Service) ListClaims(ctx context.Context, userID, characterID string) ([]*landClaimV1.LandClaim, error) {
+	character, err := authz.OwnedCharacter(ctx, s.db, s.logger, userID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.db.ListLandClaimsByCharacter(ctx, character.ID)
+	if err != nil {
+		s.logger.Error("Failed to list land claims", "character_id", characterID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list claims")
+	}
+	return dbClaimsToProto(rows), nil
+}
+
+// ClaimsInChunks returns the claims in a rectangle of chunks (bounds inclusive). The
+// world defaults to the caller's session world.
+func (s *Service) ClaimsInChunks(ctx context.Context, worldID string, minX, maxX, minY, maxY int32) ([]*landClaimV1.LandClaim, error) {
+	if minX > maxX || minY > maxY {
+		return nil, status.Errorf(codes.InvalidArgument, "min chunk coordinates must not exceed max")
+	}
+	if maxX-minX >= MaxRangeChunks || maxY-minY >= MaxRangeChunks {
+		return nil, status.Errorf(codes.InvalidArgument, "range must be at most %d chunks on each side", MaxRangeChunks)
+	}
+	if worldID == "" {
+		sessionWorldID, ok := session.WorldIDFromContext(ctx)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "world_id is required")
+		}
+		worldID = sessionWorldID
+	}
+	world, err := uuid.StringToPgtype(worldID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid world ID format")
+	}
+
+	rows, err := s.db.ListLandClaimsInRange(ctx, db.ListLandClaimsInRangeParams{
+		WorldID:   world,
+		MinChunkX: minX,
+		MaxChunkX: maxX,
+		MinChunkY: minY,
+		MaxChunkY: maxY,
+	})
+	if err != nil {
+		s.logger.Error("Failed to list land claims in range", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to get claims")
+	}
+	return dbClaimsToProto(rows), nil
+}
+
+// CheckAllowed returns a FailedPrecondition error when the chunk is claimed by a
+// character other than the one acting
+func (s *Service) CheckAllowed(ctx context.Context, worldID pgtype.UUID, chunkX, chunkY int32, characterID pgtype.UUID) error {
+	claim, err := s.db.GetLandClaimAt(ctx, db.GetLandClaimAtParams{WorldID: worldID, ChunkX: chunkX, ChunkY: chunkY})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		s.logger.Error("Failed to check land claim", "chunk_x", chunkX, "chunk_y", chunkY, "error", err)
+		return status.Errorf(codes.Internal, "failed to check land claims")
+	}
+	if claim.CharacterID != characterID {
+		return status.Errorf(codes.FailedPrecondition, "chunk (%d, %d) is claimed by another character", chunkX, chunkY)
+	}
+	return nil
+}
+
+// CollectUpkeep charges every claim whose paid period has ended, extending it by one
+// period, and releases claims whose owner cannot pay. A claim that is several periods
+// behind is charged for each of them. It returns how many claims were extended and
+// released.
+func (s *Service) CollectUpkeep(ctx context.Context) (extended, released int, err error) {
+	itemID, err := s.currencyItemID(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	payment := Payment{ItemID: itemID, Quantity: s.config.UpkeepCost}
+
+	for {
+		now := s.clock.Now()
+		claims, err := s.db.ListLandClaimsDue(ctx, db.ListLandClaimsDueParams{
+			PaidUntil: pgtype.Timestamp{Time: now, Valid: true},
+			Limit:     upkeepBatchSize,
+		})
+		if err != nil {
+			return extended, released, fmt.Errorf("failed to list due claims: %w", err)
+		}
+
+		for _, claim := range claims {
+			paidUntil := pgtype.Timestamp{Time: claim.PaidUntil.Time.Add(s.config.UpkeepPeriod), Valid: true}
+			err := s.db.PayUpkeep(ctx, claim, payment, paidUntil)
+			if err == nil {
+				extended++
+				continue
+			}
+			if !errors.Is(err, ErrInsufficientFunds) {
+				// Stop the pass: the claim is still due and would be picked up again
+				return extended, released, fmt.Errorf("failed to collect upkeep for claim %d: %w", claim.ID, err)
+			}
+
+			character, err := s.db.GetCharacterById(ctx, claim.CharacterID)
+			if err != nil {
+				return extended, released, fmt.Errorf("failed to get owner of claim %d: %w", claim.ID, err)
+			}
+			if err := s.db.ExpireClaim(ctx, claim, character.UserID); err != nil {
+				return extended, released, fmt.Errorf("failed to release claim %d: %w", claim.ID, err)
+			}
+			released++
+			s.logger.Info("Land claim released for unpaid upkeep",
+				"claim_id", claim.ID,
+				"character_id", uuid.PgtypeToString(claim.CharacterID),
+				"chunk_x", claim.ChunkX,
+				"chunk_y", claim.ChunkY)
+		}
+
+		if len(claims) < upkeepBatchSize {
+			return extended, released, nil
+		}
+	}
+}
+
+// Run collects upkeep every config.Interval until ctx is cancelled
+func (s *Service) Run(ctx context.Context) {
+	s.logger.Info("Land claim upkeep job started", "interval", s.config.Interval, "upkeep_period", s.config.UpkeepPeriod)
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("Land claim upkeep job stopped")
+			return
+		case <-s.clock.After(s.config.Interval):
+		}
+
+		extended, released, err := s.CollectUpkeep(ctx)
+		if err != nil {
+			s.logger.Error("Land claim upkeep pass failed", "error", err)
+			alerting.ReportJobError("land_claim_upkeep", err)
+		}
+		if extended > 0 || released > 0 {
+			s.logger.Debug("Land claim upkeep pass complete", "extended", extended, "released", released)
+		}
+	}
+}
+
+func dbClaimsToProto(rows []db.LandClaim) []*landClaimV1.LandClaim {
+	claims := make([]*landClaimV1.LandClaim, 0, len(rows))
+	for _, row := range rows {
+		claims = append(claims, dbClaimToProto(row))
+	}
+	return claims
+}
+
+func dbClaimToProto(row db.LandClaim) *landClaimV1.LandClaim {
+	return &landClaimV1.LandClaim{
+		Id:          row.ID,
+		WorldId:     uuid.PgtypeToString(row.WorldID),
+		ChunkX:      row.ChunkX,
+		ChunkY:      row.ChunkY,
+		CharacterId: uuid.PgtypeToString(row.CharacterID),
+		ClaimedAt:   timestamppb.New(row.ClaimedAt.Time),
+		PaidUntil:   timestamppb.New(row.PaidUntil.Time),
+	}
+}
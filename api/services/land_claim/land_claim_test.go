@@ -0,0 +1,298 @@
+package land_claim
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	"github.com/VoidMesh/api/api/services/feature_flag"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const mineralsID = 3
+
+// fakeDB keeps characters, their minerals and claims in memory
+type fakeDB struct {
+	testmocks.Characters
+	minerals map[pgtype.UUID]int32
+	claims   map[int64]db.LandClaim
+	nextID   int64
+	expired  []db.LandClaim
+}
+
+func newFakeDB() *fakeDB {
+	return &fakeDB{
+		Characters: testmocks.Characters{},
+		minerals:   map[pgtype.UUID]int32{},
+		claims:     map[int64]db.LandClaim{},
+	}
+}
+
+func (f *fakeDB) GetItemByName(ctx context.Context, name string) (db.Item, error) {
+	if name != "Minerals" {
+		return db.Item{}, pgx.ErrNoRows
+	}
+	return db.Item{ID: mineralsID, Name: name}, nil
+}
+
+func (f *fakeDB) GetLandClaimAt(ctx context.Context, arg db.GetLandClaimAtParams) (db.LandClaim, error) {
+	for _, claim := range f.claims {
+		if claim.WorldID == arg.WorldID && claim.ChunkX == arg.ChunkX && claim.ChunkY == arg.ChunkY {
+			return claim, nil
+		}
+	}
+	return db.LandClaim{}, pgx.ErrNoRows
+}
+
+func (f *fakeDB) ListLandClaimsByCharacter(ctx context.Context, characterID pgtype.UUID) ([]db.LandClaim, error) {
+	var claims []db.LandClaim
+	for id := int64(1); id <= f.nextID; id++ {
+		if claim, ok := f.claims[id]; ok && claim.CharacterID == characterID {
+			claims = append(claims, claim)
+		}
+	}
+	return claims, nil
+}
+
+func (f *fakeDB) ListLandClaimsInRange(ctx context.Context, arg db.ListLandClaimsInRangeParams) ([]db.LandClaim, error) {
+	var claims []db.LandClaim
+	for id := int64(1); id <= f.nextID; id++ {
+		claim, ok := f.claims[id]
+		if ok && claim.WorldID == arg.WorldID &&
+			claim.ChunkX >= arg.MinChunkX && claim.ChunkX <= arg.MaxChunkX &&
+			claim.ChunkY >= arg.MinChunkY && claim.ChunkY <= arg.MaxChunkY {
+			claims = append(claims, claim)
+		}
+	}
+	return claims, nil
+}
+
+func (f *fakeDB) ListLandClaimsDue(ctx context.Context, arg db.ListLandClaimsDueParams) ([]db.LandClaim, error) {
+	var claims []db.LandClaim
+	for id := int64(1); id <= f.nextID && len(claims) < int(arg.Limit); id++ {
+		if claim, ok := f.claims[id]; ok && !claim.PaidUntil.Time.After(arg.PaidUntil.Time) {
+			claims = append(claims, claim)
+		}
+	}
+	return claims, nil
+}
+
+func (f *fakeDB) DeleteLandClaim(ctx context.Context, arg db.DeleteLandClaimParams) (db.LandClaim, error) {
+	claim, ok := f.claims[arg.ID]
+	if !ok || claim.CharacterID != arg.CharacterID {
+		return db.LandClaim{}, pgx.ErrNoRows
+	}
+	delete(f.claims, arg.ID)
+	return claim, nil
+}
+
+func (f *fakeDB) pay(characterID pgtype.UUID, payment Payment) error {
+	if payment.ItemID != mineralsID {
+		return pgx.ErrNoRows
+	}
+	if f.minerals[characterID] < payment.Quantity {
+		return ErrInsufficientFunds
+	}
+	f.minerals[characterID] -= payment.Quantity
+	return nil
+}
+
+func (f *fakeDB) CreateClaim(ctx context.Context, claim db.CreateLandClaimParams, payment Payment, maxClaims int64) (db.LandClaim, error) {
+	owned, _ := f.ListLandClaimsByCharacter(ctx, claim.CharacterID)
+	if int64(len(owned)) >= maxClaims {
+		return db.LandClaim{}, ErrTooManyClaims
+	}
+	if _, err := f.GetLandClaimAt(ctx, db.GetLandClaimAtParams{WorldID: claim.WorldID, ChunkX: claim.ChunkX, ChunkY: claim.ChunkY}); err == nil {
+		return db.LandClaim{}, ErrAlreadyClaimed
+	}
+	if err := f.pay(claim.CharacterID, payment); err != nil {
+		return db.LandClaim{}, err
+	}
+	f.nextID++
+	row := db.LandClaim{
+		ID:          f.nextID,
+		WorldID:     claim.WorldID,
+		ChunkX:      claim.ChunkX,
+		ChunkY:      claim.ChunkY,
+		CharacterID: claim.CharacterID,
+		ClaimedAt:   claim.ClaimedAt,
+		PaidUntil:   claim.PaidUntil,
+	}
+	f.claims[row.ID] = row
+	return row, nil
+}
+
+func (f *fakeDB) PayUpkeep(ctx context.Context, claim db.LandClaim, payment Payment, paidUntil pgtype.Timestamp) error {
+	if err := f.pay(claim.CharacterID, payment); err != nil {
+		return err
+	}
+	claim.PaidUntil = paidUntil
+	f.claims[claim.ID] = claim
+	return nil
+}
+
+func (f *fakeDB) ExpireClaim(ctx context.Context, claim db.LandClaim, userID pgtype.UUID) error {
+	delete(f.claims, claim.ID)
+	f.expired = append(f.expired, claim)
+	return nil
+}
+
+var (
+	worldID  = pgtype.UUID{Bytes: [16]byte{15: 0xaa}, Valid: true}
+	aliceID  = "00000000-0000-0000-0000-000000000001"
+	bobID    = "00000000-0000-0000-0000-000000000002"
+	aliceChr = pgtype.UUID{Bytes: [16]byte{0: 1, 15: 1}, Valid: true}
+	bobChr   = pgtype.UUID{Bytes: [16]byte{0: 1, 15: 2}, Valid: true}
+)
+
+func newTestService(t *testing.T) (*Service, *fakeDB, *clock.Fake) {
+	database := newFakeDB()
+	for chr, user := range map[pgtype.UUID]string{aliceChr: aliceID, bobChr: bobID} {
+		userID, err := uuid.StringToPgtype(user)
+		require.NoError(t, err)
+		database.Characters[chr] = db.Character{ID: chr, UserID: userID, WorldID: worldID, ChunkX: 2, ChunkY: -1}
+	}
+	service := NewService(database, testmocks.NopLogger[LoggerInterface]{})
+	fake := clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	service.SetClock(fake)
+	return service, database, fake
+}
+
+func TestClaimChunk(t *testing.T) {
+	service, database, fake := newTestService(t)
+	ctx := session.WithWorldID(context.Background(), uuid.PgtypeToString(worldID))
+	alice := uuid.PgtypeToString(aliceChr)
+	bob := uuid.PgtypeToString(bobChr)
+
+	_, err := service.ClaimChunk(ctx, aliceID, alice)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.ErrorContains(t, err, "costs 20 Minerals")
+
+	database.minerals[aliceChr] = 25
+	_, err = service.ClaimChunk(ctx, bobID, alice)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	claim, err := service.ClaimChunk(ctx, aliceID, alice)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), claim.ChunkX)
+	assert.Equal(t, int32(-1), claim.ChunkY)
+	assert.Equal(t, fake.Now().Add(24*time.Hour), claim.PaidUntil.AsTime(), "the claim cost pays the first period")
+	assert.Equal(t, int32(5), database.minerals[aliceChr])
+
+	database.minerals[bobChr] = 100
+	_, err = service.ClaimChunk(ctx, bobID, bob)
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	assert.Equal(t, int32(100), database.minerals[bobChr], "nothing is charged for a failed claim")
+
+	// Only the owner may act in the chunk
+	assert.NoError(t, service.CheckAllowed(ctx, worldID, 2, -1, aliceChr))
+	err = service.CheckAllowed(ctx, worldID, 2, -1, bobChr)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.NoError(t, service.CheckAllowed(ctx, worldID, 3, -1, bobChr), "unclaimed chunks are open")
+
+	inRange, err := service.ClaimsInChunks(ctx, uuid.PgtypeToString(worldID), 0, 4, -4, 0)
+	require.NoError(t, err)
+	assert.Len(t, inRange, 1)
+	_, err = service.ClaimsInChunks(ctx, uuid.PgtypeToString(worldID), 0, 100, 0, 0)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = service.ReleaseClaim(ctx, bobID, bob, claim.Id)
+	assert.Equal(t, codes.NotFound, status.Code(err), "only the owner can release a claim")
+	_, err = service.ReleaseClaim(ctx, aliceID, alice, claim.Id)
+	require.NoError(t, err)
+	assert.NoError(t, service.CheckAllowed(ctx, worldID, 2, -1, bobChr))
+
+	_, err = service.ClaimChunk(ctx, bobID, bob)
+	require.NoError(t, err)
+}
+
+func TestClaimChunk_Limit(t *testing.T) {
+	service, database, _ := newTestService(t)
+	config := DefaultConfig()
+	config.MaxClaims = 1
+	service.SetConfig(config)
+	ctx := session.WithWorldID(context.Background(), uuid.PgtypeToString(worldID))
+	database.minerals[aliceChr] = 100
+
+	_, err := service.ClaimChunk(ctx, aliceID, uuid.PgtypeToString(aliceChr))
+	require.NoError(t, err)
+
+	alice := database.Characters[aliceChr]
+	alice.ChunkX++
+	database.Characters[aliceChr] = alice
+	_, err = service.ClaimChunk(ctx, aliceID, uuid.PgtypeToString(aliceChr))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.ErrorContains(t, err, "at most 1 claims")
+}
+
+// fakeFlags enables flags for the listed users only
+type fakeFlags map[pgtype.UUID]bool
+
+func (f fakeFlags) Enabled(ctx context.Context, flag feature_flag.Flag, userID, worldID pgtype.UUID) bool {
+	return f[userID]
+}
+
+func TestClaimChunk_FeatureFlag(t *testing.T) {
+	service, database, _ := newTestService(t)
+	ctx := session.WithWorldID(context.Background(), uuid.PgtypeToString(worldID))
+	database.minerals[aliceChr] = 100
+	database.minerals[bobChr] = 100
+	service.SetFlags(fakeFlags{database.Characters[bobChr].UserID: true})
+
+	_, err := service.ClaimChunk(ctx, aliceID, uuid.PgtypeToString(aliceChr))
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.ErrorContains(t, err, "not enabled")
+	assert.Equal(t, int32(100), database.minerals[aliceChr])
+
+	_, err = service.ClaimChunk(ctx, bobID, uuid.PgtypeToString(bobChr))
+	assert.NoError(t, err)
+}
+
+func TestCollectUpkeep(t *testing.T) {
+	service, database, fake := newTestService(t)
+	ctx := session.WithWorldID(context.Background(), uuid.PgtypeToString(worldID))
+	database.minerals[aliceChr] = 20 + 5
+	database.minerals[bobChr] = 20
+	_, err := service.ClaimChunk(ctx, aliceID, uuid.PgtypeToString(aliceChr))
+	require.NoError(t, err)
+	bob := database.Characters[bobChr]
+	bob.ChunkX = 9
+	database.Characters[bobChr] = bob
+	_, err = service.ClaimChunk(ctx, bobID, uuid.PgtypeToString(bobChr))
+	require.NoError(t, err)
+
+	extended, released, err := service.CollectUpkeep(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, extended+released, "nothing is due in the first period")
+
+	fake.Advance(24 * time.Hour)
+	extended, released, err = service.CollectUpkeep(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, extended)
+	assert.Equal(t, 1, released)
+	require.Len(t, database.expired, 1)
+	assert.Equal(t, bobChr, database.expired[0].CharacterID, "bob could not pay upkeep")
+
+	claims, err := service.ListClaims(ctx, aliceID, uuid.PgtypeToString(aliceChr))
+	require.NoError(t, err)
+	require.Len(t, claims, 1)
+	assert.Equal(t, fake.Now().Add(24*time.Hour), claims[0].PaidUntil.AsTime())
+	assert.Zero(t, database.minerals[aliceChr])
+
+	// Two missed periods are charged in one pass; alice can pay neither
+	fake.Advance(48 * time.Hour)
+	_, released, err = service.CollectUpkeep(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, released)
+	assert.Empty(t, database.claims)
+}
@@ -0,0 +1,330 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/txn"
+	"github.com/VoidMesh/api/api/services/economy"
+	"github.com/VoidMesh/api/api/services/inventory"
+	"github.com/charmbracelet/log"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DatabaseInterface abstracts database operations for mail.
+type DatabaseInterface interface {
+	GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error)
+	GetItemByName(ctx context.Context, name string) (db.Item, error)
+	IsBlockedBetween(ctx context.Context, arg db.IsBlockedBetweenParams) (bool, error)
+	ListMail(ctx context.Context, arg db.ListMailParams) ([]db.Mail, error)
+	ListMailAttachments(ctx context.Context, mailIDs []int64) ([]db.ListMailAttachmentsRow, error)
+	ListExpiredMail(ctx context.Context, arg db.ListExpiredMailParams) ([]int64, error)
+	SendMail(ctx context.Context, arg SendParams) (db.Mail, error)
+	ClaimMail(ctx context.Context, arg ClaimParams) (db.Mail, error)
+	DeleteMail(ctx context.Context, recipient db.Character, mailID int64) error
+	ExpireMail(ctx context.Context, arg ExpireParams) (bool, error)
+}
+
+// SendParams stores mail with its attachments. With Escrow the attachments and currency
+// are taken from the sender's inventory first, and Tax more currency is taken and recorded
+// as destroyed in the economy ledger of WorldID.
+type SendParams struct {
+	Mail           db.CreateMailParams
+	Attachments    []Attachment
+	Escrow         bool
+	CurrencyItemID int32
+	Tax            int32
+	WorldID        pgtype.UUID
+}
+
+// ClaimParams moves a mail's attachments into its recipient's inventory
+type ClaimParams struct {
+	Recipient    db.Character
+	MailID       int64
+	CurrencyItem db.Item
+	ClaimedAt    pgtype.Timestamp
+}
+
+// ExpireParams deletes expired mail, returning its attachments to the sender as mail
+// created at Now that expires at ReturnExpiresAt
+type ExpireParams struct {
+	MailID          int64
+	Now             pgtype.Timestamp
+	ReturnExpiresAt pgtype.Timestamp
+}
+
+// DatabaseWrapper implements DatabaseInterface using the actual database connection.
+type DatabaseWrapper struct {
+	pool    storage.Pool
+	queries *db.Queries
+}
+
+// NewDatabaseWrapper creates a new database wrapper with the given connection pool.
+func NewDatabaseWrapper(pool storage.Pool) DatabaseInterface {
+	return &DatabaseWrapper{
+		pool:    pool,
+		queries: db.New(pool),
+	}
+}
+
+func (d *DatabaseWrapper) GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error) {
+	return d.queries.GetCharacterById(ctx, id)
+}
+
+func (d *DatabaseWrapper) GetItemByName(ctx context.Context, name string) (db.Item, error) {
+	return d.queries.GetItemByName(ctx, name)
+}
+
+func (d *DatabaseWrapper) IsBlockedBetween(ctx context.Context, arg db.IsBlockedBetweenParams) (bool, error) {
+	return d.queries.IsBlockedBetween(ctx, arg)
+}
+
+func (d *DatabaseWrapper) ListMail(ctx context.Context, arg db.ListMailParams) ([]db.Mail, error) {
+	return d.queries.ListMail(ctx, arg)
+}
+
+func (d *DatabaseWrapper) ListMailAttachments(ctx context.Context, mailIDs []int64) ([]db.ListMailAttachmentsRow, error) {
+	return d.queries.ListMailAttachments(ctx, mailIDs)
+}
+
+func (d *DatabaseWrapper) ListExpiredMail(ctx context.Context, arg db.ListExpiredMailParams) ([]int64, error) {
+	return d.queries.ListExpiredMail(ctx, arg)
+}
+
+// SendMail takes the escrow from the sender and stores the mail in one serializable
+// transaction, so the same items can never be both sent and kept
+func (d *DatabaseWrapper) SendMail(ctx context.Context, arg SendParams) (db.Mail, error) {
+	var mail db.Mail
+	err := txn.Run(ctx, d.pool, txn.Serializable, func(q *db.Queries) error {
+		var err error
+		mail, err = SendInTx(ctx, q, arg)
+		return err
+	})
+	return mail, err
+}
+
+// SendInTx stores mail with its attachments inside the caller's transaction, taking the
+// escrow and the tax first when arg.Escrow is set. Other services use it to send mail atomically with
+// their own changes.
+func SendInTx(ctx context.Context, q *db.Queries, arg SendParams) (db.Mail, error) {
+	if arg.Escrow {
+		if err := takeEscrow(ctx, q, arg); err != nil {
+			return db.Mail{}, err
+		}
+	}
+	mail, err := q.CreateMail(ctx, arg.Mail)
+	if err != nil {
+		return db.Mail{}, fmt.Errorf("failed to create mail: %w", err)
+	}
+	for _, attachment := range arg.Attachments {
+		err := q.CreateMailAttachment(ctx, db.CreateMailAttachmentParams{
+			MailID:   mail.ID,
+			ItemID:   attachment.ItemID,
+			Quantity: attachment.Quantity,
+		})
+		if err != nil {
+			return db.Mail{}, fmt.Errorf("failed to attach item %d: %w", attachment.ItemID, err)
+		}
+	}
+	if arg.Escrow {
+		err = economy.RecordInTx(ctx, q, economy.Flow{
+			WorldID:   arg.WorldID,
+			Source:    economy.SourceTradeTax,
+			Destroyed: int64(arg.Tax),
+			DedupKey:  fmt.Sprintf("%s:%d", economy.SourceTradeTax, mail.ID),
+			At:        arg.Mail.CreatedAt.Time,
+		})
+		if err != nil {
+			return db.Mail{}, err
+		}
+	}
+	return mail, nil
+}
+
+// takeEscrow removes the attachments, currency and tax from the sender's inventory,
+// failing with ErrInsufficientItems when it holds too little of any
+func takeEscrow(ctx context.Context, q *db.Queries, arg SendParams) error {
+	taken := arg.Attachments
+	if currency := arg.Mail.Currency + arg.Tax; currency > 0 {
+		taken = append(taken[:len(taken):len(taken)], Attachment{ItemID: arg.CurrencyItemID, Quantity: currency})
+	}
+	for _, item := range taken {
+		_, err := q.RemoveInventoryItemQuantity(ctx, db.RemoveInventoryItemQuantityParams{
+			CharacterID: arg.Mail.SenderID,
+			ItemID:      item.ItemID,
+			Quantity:    item.Quantity,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrInsufficientItems
+		}
+		if err != nil {
+			return fmt.Errorf("failed to take item %d: %w", item.ItemID, err)
+		}
+	}
+	return q.DeleteEmptyInventoryItems(ctx, arg.Mail.SenderID)
+}
+
+// ClaimMail grants the mail's attachments and currency to the recipient and marks it
+// claimed in one serializable transaction. Nothing is granted unless everything fits.
+func (d *DatabaseWrapper) ClaimMail(ctx context.Context, arg ClaimParams) (db.Mail, error) {
+	var mail db.Mail
+	err := txn.Run(ctx, d.pool, txn.Serializable, func(q *db.Queries) error {
+		var err error
+		mail, err = recipientMailInTx(ctx, q, arg.Recipient, arg.MailID)
+		if err != nil {
+			return err
+		}
+		if mail.ClaimedAt.Valid {
+			return ErrAlreadyClaimed
+		}
+		attachments, err := q.ListMailAttachments(ctx, []int64{mail.ID})
+		if err != nil {
+			return fmt.Errorf("failed to list attachments: %w", err)
+		}
+		if len(attachments) == 0 && mail.Currency == 0 {
+			return ErrNoAttachments
+		}
+
+		grants := make([]inventory.Grant, 0, len(attachments)+1)
+		for _, attachment := range attachments {
+			grants = append(grants, inventory.Grant{ItemID: attachment.ItemID, StackSize: attachment.StackSize, Quantity: attachment.Quantity})
+		}
+		if mail.Currency > 0 {
+			grants = append(grants, inventory.Grant{ItemID: arg.CurrencyItem.ID, StackSize: arg.CurrencyItem.StackSize, Quantity: mail.Currency})
+		}
+		if _, err := inventory.GrantAllInTx(ctx, q, arg.Recipient, grants); err != nil {
+			return err
+		}
+		if err := q.MarkMailClaimed(ctx, db.MarkMailClaimedParams{ID: mail.ID, ClaimedAt: arg.ClaimedAt}); err != nil {
+			return fmt.Errorf("failed to mark mail claimed: %w", err)
+		}
+		mail.ClaimedAt = arg.ClaimedAt
+		return nil
+	})
+	return mail, err
+}
+
+// DeleteMail deletes mail that holds nothing unclaimed
+func (d *DatabaseWrapper) DeleteMail(ctx context.Context, recipient db.Character, mailID int64) error {
+	return txn.Run(ctx, d.pool, txn.ReadCommitted, func(q *db.Queries) error {
+		mail, err := recipientMailInTx(ctx, q, recipient, mailID)
+		if err != nil {
+			return err
+		}
+		if !mail.ClaimedAt.Valid {
+			attachments, err := q.ListMailAttachments(ctx, []int64{mail.ID})
+			if err != nil {
+				return fmt.Errorf("failed to list attachments: %w", err)
+			}
+			if len(attachments) > 0 || mail.Currency > 0 {
+				return ErrUnclaimedAttachments
+			}
+		}
+		return q.DeleteMail(ctx, mail.ID)
+	})
+}
+
+// ExpireMail deletes expired mail. Unclaimed attachments of player mail are sent back to
+// the sender first; returns whether they were. Attachments of system mail, returned mail
+// and mail whose sender no longer exists are lost.
+func (d *DatabaseWrapper) ExpireMail(ctx context.Context, arg ExpireParams) (bool, error) {
+	var returned bool
+	err := txn.Run(ctx, d.pool, txn.ReadCommitted, func(q *db.Queries) error {
+		returned = false
+		mail, err := q.GetMailForUpdate(ctx, arg.MailID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil // Deleted meanwhile
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get mail: %w", err)
+		}
+		attachments, err := q.ListMailAttachments(ctx, []int64{mail.ID})
+		if err != nil {
+			return fmt.Errorf("failed to list attachments: %w", err)
+		}
+
+		unclaimed := !mail.ClaimedAt.Valid && (len(attachments) > 0 || mail.Currency > 0)
+		if unclaimed && mail.SenderID.Valid && !mail.Returned {
+			recipient, err := q.GetCharacterById(ctx, mail.RecipientID)
+			if err != nil {
+				return fmt.Errorf("failed to get recipient: %w", err)
+			}
+			back, err := q.CreateMail(ctx, db.CreateMailParams{
+				RecipientID: mail.SenderID,
+				SenderID:    mail.RecipientID,
+				SenderName:  recipient.Name,
+				Subject:     returnSubject(mail.Subject),
+				Body:        mail.Body,
+				Currency:    mail.Currency,
+				Returned:    true,
+				CreatedAt:   arg.Now,
+				ExpiresAt:   arg.ReturnExpiresAt,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create returned mail: %w", err)
+			}
+			if err := q.MoveMailAttachments(ctx, db.MoveMailAttachmentsParams{FromMailID: mail.ID, ToMailID: back.ID}); err != nil {
+				return fmt.Errorf("failed to move attachments: %w", err)
+			}
+			returned = true
+		}
+		return q.DeleteMail(ctx, mail.ID)
+	})
+	return returned, err
+}
+
+// recipientMailInTx locks one of the recipient's mail; other characters' mail is reported
+// as not found
+func recipientMailInTx(ctx context.Context, q *db.Queries, recipient db.Character, mailID int64) (db.Mail, error) {
+	mail, err := q.GetMailForUpdate(ctx, mailID)
+	if err != nil {
+		return mail, err
+	}
+	if mail.RecipientID != recipient.ID {
+		return db.Mail{}, pgx.ErrNoRows
+	}
+	return mail, nil
+}
+
+// LoggerInterface abstracts logging operations for dependency injection.
+type LoggerInterface interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+	With(keysAndValues ...interface{}) LoggerInterface
+}
+
+// DefaultLoggerWrapper wraps the internal logging package.
+type DefaultLoggerWrapper struct {
+	logger *log.Logger
+}
+
+func NewDefaultLoggerWrapper() LoggerInterface {
+	return &DefaultLoggerWrapper{logger: logging.GetLogger()}
+}
+
+func (l *DefaultLoggerWrapper) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.Debug(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Info(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.Warn(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.Error(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) With(keysAndValues ...interface{}) LoggerInterface {
+	return &DefaultLoggerWrapper{logger: l.logger.With(keysAndValues...)}
+}
@@ -0,0 +1,467 @@
+// Package mail delivers items and currency to characters whether or not they are online.
+// Attachments a player sends are taken from their inventory when the mail is sent and held
+// in escrow until the recipient claims them; unclaimed attachments go back to the sender
+// when the mail expires. System mail (trade fallbacks, quest rewards) is sent with
+// SendSystem and grants items that were never in anyone's inventory. Currency a player
+// mails another is a trade: the trade tax is taken on top of it and destroyed, like the
+// market's sale tax, and recorded in the economy ledger.
+package mail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/alerting"
+	"github.com/VoidMesh/api/api/internal/authz"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	mailV1 "github.com/VoidMesh/api/api/proto/mail/v1"
+	"github.com/VoidMesh/api/api/services/economy"
+	"github.com/VoidMesh/api/api/services/inventory"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// SystemSenderName is shown as the sender of mail sent with SendSystem
+	SystemSenderName = "System"
+
+	// expiryBatchSize is how many expired mail the expiry job loads per page
+	expiryBatchSize = 200
+)
+
+var (
+	ErrInsufficientItems    = errors.New("not enough items")
+	ErrAlreadyClaimed       = errors.New("attachments already claimed")
+	ErrNoAttachments        = errors.New("mail has no attachments")
+	ErrUnclaimedAttachments = errors.New("mail has unclaimed attachments")
+)
+
+// Config bounds what mail may carry and sets how long it is kept
+type Config struct {
+	CurrencyItem     string        // Name of the item mail currency is paid in
+	Lifetime         time.Duration // How long mail is kept before it expires
+	ReturnLifetime   time.Duration // How long returned attachments are kept
+	MaxAttachments   int           // Distinct items per mail
+	MaxSubjectLength int
+	MaxBodyLength    int
+	MaxListedMail    int32         // Mail returned by ListMail
+	ExpiryInterval   time.Duration // Time between expiry passes
+	// Percent of the currency a player mails taken from them on top of it, rounded down.
+	// It is not refunded when the mail comes back.
+	TradeTaxPercent int32
+}
+
+// DefaultConfig keeps mail for 30 days with up to 8 attachments and taxes currency sent
+// between players 5%, the market's sale tax
+func DefaultConfig() Config {
+	return Config{
+		CurrencyItem:     "Minerals",
+		Lifetime:         30 * 24 * time.Hour,
+		ReturnLifetime:   30 * 24 * time.Hour,
+		MaxAttachments:   8,
+		MaxSubjectLength: 100,
+		MaxBodyLength:    1000,
+		MaxListedMail:    100,
+		ExpiryInterval:   10 * time.Minute,
+		TradeTaxPercent:  5,
+	}
+}
+
+// Attachment is a quantity of an item carried by mail
+type Attachment struct {
+	ItemID   int32
+	Quantity int32
+}
+
+// SystemMail is mail sent by the server rather than a character
+type SystemMail struct {
+	RecipientID pgtype.UUID
+	Subject     string
+	Body        string
+	Attachments []Attachment
+	Currency    int32
+}
+
+// Service sends, lists, claims and expires mail.
+type Service struct {
+	db     DatabaseInterface
+	logger LoggerInterface
+	clock  clock.Clock
+	config Config
+}
+
+// NewService creates a new mail service with dependency injection.
+func NewService(db DatabaseInterface, logger LoggerInterface) *Service {
+	componentLogger := logger.With("component", "mail-service")
+	componentLogger.Debug("Creating new mail service")
+	return &Service{
+		db:     db,
+		logger: componentLogger,
+		clock:  clock.New(),
+		config: DefaultConfig(),
+	}
+}
+
+// NewServiceWithPool creates a service with concrete implementations (convenience constructor for production use).
+func NewServiceWithPool(pool storage.Pool) *Service {
+	return NewService(NewDatabaseWrapper(pool), NewDefaultLoggerWrapper())
+}
+
+// SetClock replaces the clock used for timestamps and the expiry schedule (for simulation tests)
+func (s *Service) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// SetConfig replaces the mail limits and lifetimes
+func (s *Service) SetConfig(config Config) {
+	s.config = config
+}
+
+// currencyItem looks up the item mail currency is paid in
+func (s *Service) currencyItem(ctx context.Context) (db.Item, error) {
+	item, err := s.db.GetItemByName(ctx, s.config.CurrencyItem)
+	if err != nil {
+		return db.Item{}, fmt.Errorf("failed to get currency item %q: %w", s.config.CurrencyItem, err)
+	}
+	return item, nil
+}
+
+// Send mails attachments and currency from one of the user's characters to another
+// character in the same world. Everything sent, and the trade tax on the currency, is
+// taken from the sender's inventory right away.
+func (s *Service) Send(ctx context.Context, userID string, req *mailV1.SendMailRequest) (*mailV1.Mail, error) {
+	sender, err := authz.OwnedCharacter(ctx, s.db, s.logger, userID, req.CharacterId)
+	if err != nil {
+		return nil, err
+	}
+	recipientID, err := uuid.StringToPgtype(req.RecipientCharacterId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recipient character ID format")
+	}
+	if recipientID == sender.ID {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot mail yourself")
+	}
+	recipient, err := s.db.GetCharacterById(ctx, recipientID)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && recipient.WorldID != sender.WorldID) {
+		return nil, status.Errorf(codes.NotFound, "recipient not found")
+	}
+	if err != nil {
+		s.logger.Error("Failed to get mail recipient", "recipient_id", req.RecipientCharacterId, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to send mail")
+	}
+	blocked, err := s.db.IsBlockedBetween(ctx, db.IsBlockedBetweenParams{UserA: sender.UserID, UserB: recipient.UserID})
+	if err != nil {
+		s.logger.Error("Failed to check blocks", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to send mail")
+	}
+	if blocked {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot mail this character")
+	}
+
+	attachments := make([]Attachment, 0, len(req.Attachments))
+	for _, attachment := range req.Attachments {
+		attachments = append(attachments, Attachment{ItemID: attachment.ItemId, Quantity: attachment.Quantity})
+	}
+	attachments, err = s.validate(req.Subject, req.Body, attachments, req.Currency)
+	if err != nil {
+		return nil, err
+	}
+	currency, err := s.currencyItem(ctx)
+	if err != nil {
+		s.logger.Error("Failed to get mail currency", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to send mail")
+	}
+
+	now := s.clock.Now()
+	mail, err := s.db.SendMail(ctx, SendParams{
+		Mail: db.CreateMailParams{
+			RecipientID: recipient.ID,
+			SenderID:    sender.ID,
+			SenderName:  sender.Name,
+			Subject:     req.Subject,
+			Body:        req.Body,
+			Currency:    req.Currency,
+			CreatedAt:   pgtype.Timestamp{Time: now, Valid: true},
+			ExpiresAt:   pgtype.Timestamp{Time: now.Add(s.config.Lifetime), Valid: true},
+		},
+		Attachments:    attachments,
+		Escrow:         true,
+		CurrencyItemID: currency.ID,
+		Tax:            int32(economy.Tax(int64(req.Currency), s.config.TradeTaxPercent)),
+		WorldID:        sender.WorldID,
+	})
+	if errors.Is(err, ErrInsufficientItems) {
+		return nil, status.Errorf(codes.FailedPrecondition, "not enough items to send")
+	}
+	if err != nil {
+		s.logger.Error("Failed to send mail", "character_id", req.CharacterId, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to send mail")
+	}
+
+	s.logger.Info("Mail sent", "mail_id", mail.ID, "sender_id", req.CharacterId, "recipient_id", req.RecipientCharacterId)
+	return s.mailToProto(ctx, mail)
+}
+
+// SendSystem mails attachments and currency from the server. It is how trades that cannot
+// be delivered and quest rewards reach a character; nothing is taken from any inventory.
+func (s *Service) SendSystem(ctx context.Context, m SystemMail) (*mailV1.Mail, error) {
+	attachments, err := s.validate(m.Subject, m.Body, m.Attachments, m.Currency)
+	if err != nil {
+		return nil, err
+	}
+	now := s.clock.Now()
+	mail, err := s.db.SendMail(ctx, SendParams{
+		Mail: db.CreateMailParams{
+			RecipientID: m.RecipientID,
+			SenderName:  SystemSenderName,
+			Subject:     m.Subject,
+			Body:        m.Body,
+			Currency:    m.Currency,
+			CreatedAt:   pgtype.Timestamp{Time: now, Valid: true},
+			ExpiresAt:   pgtype.Timestamp{Time: now.Add(s.config.Lifetime), Valid: true},
+		},
+		Attachments: attachments,
+	})
+	if err != nil {
+		s.logger.Error("Failed to send system mail", "recipient_id", uuid.PgtypeToString(m.RecipientID), "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to send mail")
+	}
+
+	s.logger.Info("System mail sent", "mail_id", mail.ID, "recipient_id", uuid.PgtypeToString(m.RecipientID))
+	return s.mailToProto(ctx, mail)
+}
+
+// validate checks the mail against the configured limits and merges attachments of the
+// same item
+func (s *Service) validate(subject, body string, attachments []Attachment, currency int32) ([]Attachment, error) {
+	if strings.TrimSpace(subject) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "subject is required")
+	}
+	if len(subject) > s.config.MaxSubjectLength {
+		return nil, status.Errorf(codes.InvalidArgument, "subject must be at most %d characters", s.config.MaxSubjectLength)
+	}
+	if len(body) > s.config.MaxBodyLength {
+		return nil, status.Errorf(codes.InvalidArgument, "body must be at most %d characters", s.config.MaxBodyLength)
+	}
+	if currency < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "currency must not be negative")
+	}
+
+	merged := make([]Attachment, 0, len(attachments))
+	index := make(map[int32]int, len(attachments))
+	for _, attachment := range attachments {
+		if attachment.Quantity <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "attachment quantities must be positive")
+		}
+		if i, ok := index[attachment.ItemID]; ok {
+			merged[i].Quantity += attachment.Quantity
+			continue
+		}
+		index[attachment.ItemID] = len(merged)
+		merged = append(merged, attachment)
+	}
+	if len(merged) > s.config.MaxAttachments {
+		return nil, status.Errorf(codes.InvalidArgument, "mail can carry at most %d items", s.config.MaxAttachments)
+	}
+	return merged, nil
+}
+
+// List returns the character's mail, newest first
+func (s *Service) List(ctx context.Context, userID, characterID string) ([]*mailV1.Mail, error) {
+	character, err := authz.OwnedCharacter(ctx, s.db, s.logger, userID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.db.ListMail(ctx, db.ListMailParams{RecipientID: character.ID, MaxMail: s.config.MaxListedMail})
+	if err != nil {
+		s.logger.Error("Failed to list mail", "character_id", characterID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list mail")
+	}
+	mail, err := s.mailsToProto(ctx, rows)
+	if err != nil {
+		s.logger.Error("Failed to list mail attachments", "character_id", characterID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list mail")
+	}
+	return mail, nil
+}
+
+// Claim moves a mail's attachments and currency into the character's inventory. Nothing
+// is claimed unless all of it fits.
+func (s *Service) Claim(ctx context.Context, userID, characterID string, mailID int64) (*mailV1.Mail, error) {
+	character, err := authz.OwnedCharacter(ctx, s.db, s.logger, userID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	currency, err := s.currencyItem(ctx)
+	if err != nil {
+		s.logger.Error("Failed to get mail currency", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to claim attachments")
+	}
+
+	mail, err := s.db.ClaimMail(ctx, ClaimParams{
+		Recipient:    character,
+		MailID:       mailID,
+		CurrencyItem: currency,
+		ClaimedAt:    pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
+	})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, status.Errorf(codes.NotFound, "mail not found")
+	case errors.Is(err, ErrAlreadyClaimed):
+		return nil, status.Errorf(codes.FailedPrecondition, "attachments were already claimed")
+	case errors.Is(err, ErrNoAttachments):
+		return nil, status.Errorf(codes.FailedPrecondition, "mail has no attachments")
+	case errors.Is(err, inventory.ErrInventoryFull):
+		return nil, status.Errorf(codes.ResourceExhausted, "not enough inventory space for the attachments")
+	case err != nil:
+		s.logger.Error("Failed to claim mail", "mail_id", mailID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to claim attachments")
+	}
+
+	s.logger.Info("Mail attachments claimed", "mail_id", mail.ID, "character_id", characterID)
+	return s.mailToProto(ctx, mail)
+}
+
+// Delete removes one of the character's mail. Mail with unclaimed attachments cannot be
+// deleted, so nothing is lost by accident.
+func (s *Service) Delete(ctx context.Context, userID, characterID string, mailID int64) error {
+	character, err := authz.OwnedCharacter(ctx, s.db, s.logger, userID, characterID)
+	if err != nil {
+		return err
+	}
+	err = s.db.DeleteMail(ctx, character, mailID)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return status.Errorf(codes.NotFound, "mail not found")
+	case errors.Is(err, ErrUnclaimedAttachments):
+		return status.Errorf(codes.FailedPrecondition, "claim the attachments before deleting the mail")
+	case err != nil:
+		s.logger.Error("Failed to delete mail", "mail_id", mailID, "error", err)
+		return status.Errorf(codes.Internal, "failed to delete mail")
+	}
+	return nil
+}
+
+// Expire deletes mail past its expiry, returning unclaimed player attachments to their
+// sender. It returns how many mail expired and how many of those were returned.
+func (s *Service) Expire(ctx context.Context) (expired, returned int, err error) {
+	for {
+		now := s.clock.Now()
+		ids, err := s.db.ListExpiredMail(ctx, db.ListExpiredMailParams{
+			ExpiresAt: pgtype.Timestamp{Time: now, Valid: true},
+			Limit:     expiryBatchSize,
+		})
+		if err != nil {
+			return expired, returned, fmt.Errorf("failed to list expired mail: %w", err)
+		}
+
+		for _, id := range ids {
+			sentBack, err := s.db.ExpireMail(ctx, ExpireParams{
+				MailID:          id,
+				Now:             pgtype.Timestamp{Time: now, Valid: true},
+				ReturnExpiresAt: pgtype.Timestamp{Time: now.Add(s.config.ReturnLifetime), Valid: true},
+			})
+			if err != nil {
+				return expired, returned, fmt.Errorf("failed to expire mail %d: %w", id, err)
+			}
+			expired++
+			if sentBack {
+				returned++
+			}
+		}
+
+		if len(ids) < expiryBatchSize {
+			return expired, returned, nil
+		}
+	}
+}
+
+// Run expires mail every config.ExpiryInterval until ctx is cancelled
+func (s *Service) Run(ctx context.Context) {
+	s.logger.Info("Mail expiry job started", "interval", s.config.ExpiryInterval)
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("Mail expiry job stopped")
+			return
+		case <-s.clock.After(s.config.ExpiryInterval):
+		}
+
+		expired, returned, err := s.Expire(ctx)
+		if err != nil {
+			s.logger.Error("Mail expiry pass failed", "error", err)
+			alerting.ReportJobError("mail_expiry", err)
+		}
+		if expired > 0 {
+			s.logger.Debug("Mail expiry pass complete", "expired", expired, "returned", returned)
+		}
+	}
+}
+
+// returnSubject is the subject of mail that sends unclaimed attachments back
+func returnSubject(subject string) string {
+	return "Returned: " + subject
+}
+
+func (s *Service) mailToProto(ctx context.Context, row db.Mail) (*mailV1.Mail, error) {
+	mail, err := s.mailsToProto(ctx, []db.Mail{row})
+	if err != nil {
+		s.logger.Error("Failed to list mail attachments", "mail_id", row.ID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to get mail")
+	}
+	return mail[0], nil
+}
+
+// mailsToProto converts mail, loading all their attachments in one query
+func (s *Service) mailsToProto(ctx context.Context, rows []db.Mail) ([]*mailV1.Mail, error) {
+	ids := make([]int64, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.ID)
+	}
+	attachments := make(map[int64][]*mailV1.MailAttachment, len(rows))
+	if len(ids) > 0 {
+		attachmentRows, err := s.db.ListMailAttachments(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range attachmentRows {
+			attachments[row.MailID] = append(attachments[row.MailID], &mailV1.MailAttachment{
+				ItemId:   row.ItemID,
+				ItemName: row.ItemName,
+				Quantity: row.Quantity,
+			})
+		}
+	}
+
+	mail := make([]*mailV1.Mail, 0, len(rows))
+	for _, row := range rows {
+		m := &mailV1.Mail{
+			Id:          row.ID,
+			SenderName:  row.SenderName,
+			Subject:     row.Subject,
+			Body:        row.Body,
+			Attachments: attachments[row.ID],
+			Currency:    row.Currency,
+			Returned:    row.Returned,
+			CreatedAt:   timestamppb.New(row.CreatedAt.Time),
+			ExpiresAt:   timestamppb.New(row.ExpiresAt.Time),
+		}
+		if row.SenderID.Valid {
+			m.SenderCharacterId = uuid.PgtypeToString(row.SenderID)
+		}
+		if row.ClaimedAt.Valid {
+			m.ClaimedAt = timestamppb.New(row.ClaimedAt.Time)
+		}
+		mail = append(mail, m)
+	}
+	return mail, nil
+}
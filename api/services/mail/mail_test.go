@@ -0,0 +1,369 @@
+package mail
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	mailV1 "github.com/VoidMesh/api/api/proto/mail/v1"
+	"github.com/VoidMesh/api/api/services/inventory"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	mineralsID = 3
+	woodID     = 1
+)
+
+// fakeDB keeps characters, their items and mail in memory
+type fakeDB struct {
+	testmocks.Characters
+	items       map[pgtype.UUID]map[int32]int32
+	full        map[pgtype.UUID]bool
+	blocked     bool
+	mail        map[int64]db.Mail
+	attachments map[int64][]Attachment
+	nextID      int64
+	taxed       int32 // Currency destroyed by the trade tax
+}
+
+func newFakeDB() *fakeDB {
+	return &fakeDB{
+		Characters:  testmocks.Characters{},
+		items:       map[pgtype.UUID]map[int32]int32{},
+		full:        map[pgtype.UUID]bool{},
+		mail:        map[int64]db.Mail{},
+		attachments: map[int64][]Attachment{},
+	}
+}
+
+func (f *fakeDB) GetItemByName(ctx context.Context, name string) (db.Item, error) {
+	if name != "Minerals" {
+		return db.Item{}, pgx.ErrNoRows
+	}
+	return db.Item{ID: mineralsID, Name: name, StackSize: 100}, nil
+}
+
+func (f *fakeDB) IsBlockedBetween(ctx context.Context, arg db.IsBlockedBetweenParams) (bool, error) {
+	return f.blocked, nil
+}
+
+func (f *fakeDB) ListMail(ctx context.Context, arg db.ListMailParams) ([]db.Mail, error) {
+	var mail []db.Mail
+	for id := f.nextID; id > 0 && len(mail) < int(arg.MaxMail); id-- {
+		if m, ok := f.mail[id]; ok && m.RecipientID == arg.RecipientID {
+			mail = append(mail, m)
+		}
+	}
+	return mail, nil
+}
+
+func (f *fakeDB) ListMailAttachments(ctx context.Context, mailIDs []int64) ([]db.ListMailAttachmentsRow, error) {
+	var rows []db.ListMailAttachmentsRow
+	for _, id := range mailIDs {
+		for _, attachment := range f.attachments[id] {
+			rows = append(rows, db.ListMailAttachmentsRow{MailID: id, ItemID: attachment.ItemID, Quantity: attachment.Quantity, ItemName: "Wood"})
+		}
+	}
+	return rows, nil
+}
+
+func (f *fakeDB) ListExpiredMail(ctx context.Context, arg db.ListExpiredMailParams) ([]int64, error) {
+	var ids []int64
+	for id, m := range f.mail {
+		if !m.ExpiresAt.Time.After(arg.ExpiresAt.Time) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids, nil
+}
+
+func (f *fakeDB) create(arg db.CreateMailParams, attachments []Attachment) db.Mail {
+	f.nextID++
+	mail := db.Mail{
+		ID:          f.nextID,
+		RecipientID: arg.RecipientID,
+		SenderID:    arg.SenderID,
+		SenderName:  arg.SenderName,
+		Subject:     arg.Subject,
+		Body:        arg.Body,
+		Currency:    arg.Currency,
+		Returned:    arg.Returned,
+		CreatedAt:   arg.CreatedAt,
+		ExpiresAt:   arg.ExpiresAt,
+	}
+	f.mail[mail.ID] = mail
+	if len(attachments) > 0 {
+		f.attachments[mail.ID] = attachments
+	}
+	return mail
+}
+
+func (f *fakeDB) SendMail(ctx context.Context, arg SendParams) (db.Mail, error) {
+	if arg.Escrow {
+		held := f.items[arg.Mail.SenderID]
+		taken := append([]Attachment{{ItemID: arg.CurrencyItemID, Quantity: arg.Mail.Currency + arg.Tax}}, arg.Attachments...)
+		for _, item := range taken {
+			if held[item.ItemID] < item.Quantity {
+				return db.Mail{}, ErrInsufficientItems
+			}
+		}
+		for _, item := range taken {
+			held[item.ItemID] -= item.Quantity
+		}
+		f.taxed += arg.Tax
+	}
+	return f.create(arg.Mail, arg.Attachments), nil
+}
+
+func (f *fakeDB) ClaimMail(ctx context.Context, arg ClaimParams) (db.Mail, error) {
+	mail, ok := f.mail[arg.MailID]
+	if !ok || mail.RecipientID != arg.Recipient.ID {
+		return db.Mail{}, pgx.ErrNoRows
+	}
+	if mail.ClaimedAt.Valid {
+		return db.Mail{}, ErrAlreadyClaimed
+	}
+	if len(f.attachments[mail.ID]) == 0 && mail.Currency == 0 {
+		return db.Mail{}, ErrNoAttachments
+	}
+	if f.full[arg.Recipient.ID] {
+		return db.Mail{}, inventory.ErrInventoryFull
+	}
+	if f.items[arg.Recipient.ID] == nil {
+		f.items[arg.Recipient.ID] = map[int32]int32{}
+	}
+	for _, attachment := range f.attachments[mail.ID] {
+		f.items[arg.Recipient.ID][attachment.ItemID] += attachment.Quantity
+	}
+	f.items[arg.Recipient.ID][arg.CurrencyItem.ID] += mail.Currency
+	mail.ClaimedAt = arg.ClaimedAt
+	f.mail[mail.ID] = mail
+	return mail, nil
+}
+
+func (f *fakeDB) DeleteMail(ctx context.Context, recipient db.Character, mailID int64) error {
+	mail, ok := f.mail[mailID]
+	if !ok || mail.RecipientID != recipient.ID {
+		return pgx.ErrNoRows
+	}
+	if !mail.ClaimedAt.Valid && (len(f.attachments[mailID]) > 0 || mail.Currency > 0) {
+		return ErrUnclaimedAttachments
+	}
+	delete(f.mail, mailID)
+	delete(f.attachments, mailID)
+	return nil
+}
+
+func (f *fakeDB) ExpireMail(ctx context.Context, arg ExpireParams) (bool, error) {
+	mail, ok := f.mail[arg.MailID]
+	if !ok {
+		return false, nil
+	}
+	attachments := f.attachments[mail.ID]
+	delete(f.mail, mail.ID)
+	delete(f.attachments, mail.ID)
+
+	unclaimed := !mail.ClaimedAt.Valid && (len(attachments) > 0 || mail.Currency > 0)
+	if !unclaimed || !mail.SenderID.Valid || mail.Returned {
+		return false, nil
+	}
+	f.create(db.CreateMailParams{
+		RecipientID: mail.SenderID,
+		SenderID:    mail.RecipientID,
+		SenderName:  f.Characters[mail.RecipientID].Name,
+		Subject:     returnSubject(mail.Subject),
+		Currency:    mail.Currency,
+		Returned:    true,
+		CreatedAt:   arg.Now,
+		ExpiresAt:   arg.ReturnExpiresAt,
+	}, attachments)
+	return true, nil
+}
+
+var (
+	aliceID  = "00000000-0000-0000-0000-00000000000a"
+	bobID    = "00000000-0000-0000-0000-00000000000b"
+	worldID  = pgtype.UUID{Bytes: [16]byte{15: 0xaa}, Valid: true}
+	aliceChr = pgtype.UUID{Bytes: [16]byte{0: 1, 15: 1}, Valid: true}
+	bobChr   = pgtype.UUID{Bytes: [16]byte{0: 1, 15: 2}, Valid: true}
+)
+
+func newTestService(t *testing.T) (*Service, *fakeDB, *clock.Fake) {
+	database := newFakeDB()
+	for chr, user := range map[pgtype.UUID]string{aliceChr: aliceID, bobChr: bobID} {
+		userID, err := uuid.StringToPgtype(user)
+		require.NoError(t, err)
+		database.Characters[chr] = db.Character{ID: chr, UserID: userID, WorldID: worldID, Name: user[len(user)-1:]}
+		database.items[chr] = map[int32]int32{}
+	}
+	service := NewService(database, testmocks.NopLogger[LoggerInterface]{})
+	fake := clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	service.SetClock(fake)
+	return service, database, fake
+}
+
+func sendRequest(quantity, currency int32) *mailV1.SendMailRequest {
+	return &mailV1.SendMailRequest{
+		CharacterId:          uuid.PgtypeToString(aliceChr),
+		RecipientCharacterId: uuid.PgtypeToString(bobChr),
+		Subject:              "Logs",
+		Attachments:          []*mailV1.MailAttachment{{ItemId: woodID, Quantity: quantity}},
+		Currency:             currency,
+	}
+}
+
+func TestSendAndClaim(t *testing.T) {
+	service, database, _ := newTestService(t)
+	ctx := session.WithWorldID(context.Background(), uuid.PgtypeToString(worldID))
+	bob := uuid.PgtypeToString(bobChr)
+
+	_, err := service.Send(ctx, aliceID, sendRequest(5, 2))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "the sender must hold what is sent")
+
+	database.items[aliceChr][woodID] = 10
+	database.items[aliceChr][mineralsID] = 2
+	sent, err := service.Send(ctx, aliceID, sendRequest(5, 2))
+	require.NoError(t, err)
+	assert.Equal(t, int32(5), database.items[aliceChr][woodID], "attachments are held in escrow")
+	assert.Equal(t, int32(0), database.items[aliceChr][mineralsID])
+	assert.Equal(t, uuid.PgtypeToString(aliceChr), sent.SenderCharacterId)
+
+	listed, err := service.List(ctx, bobID, bob)
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	require.Len(t, listed[0].Attachments, 1)
+	assert.Equal(t, int32(5), listed[0].Attachments[0].Quantity)
+
+	err = service.Delete(ctx, bobID, bob, sent.Id)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "unclaimed mail cannot be deleted")
+
+	_, err = service.Claim(ctx, aliceID, uuid.PgtypeToString(aliceChr), sent.Id)
+	assert.Equal(t, codes.NotFound, status.Code(err), "only the recipient can claim")
+
+	database.full[bobChr] = true
+	_, err = service.Claim(ctx, bobID, bob, sent.Id)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	database.full[bobChr] = false
+
+	claimed, err := service.Claim(ctx, bobID, bob, sent.Id)
+	require.NoError(t, err)
+	assert.NotNil(t, claimed.ClaimedAt)
+	assert.Equal(t, int32(5), database.items[bobChr][woodID])
+	assert.Equal(t, int32(2), database.items[bobChr][mineralsID])
+
+	_, err = service.Claim(ctx, bobID, bob, sent.Id)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.NoError(t, service.Delete(ctx, bobID, bob, sent.Id))
+	assert.Empty(t, database.mail)
+}
+
+func TestSend_TradeTax(t *testing.T) {
+	service, database, fake := newTestService(t)
+	ctx := session.WithWorldID(context.Background(), uuid.PgtypeToString(worldID))
+	database.items[aliceChr][woodID] = 10
+	database.items[aliceChr][mineralsID] = 41
+
+	_, err := service.Send(ctx, aliceID, sendRequest(1, 40))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "the sender must hold the tax too")
+
+	database.items[aliceChr][mineralsID] = 42
+	sent, err := service.Send(ctx, aliceID, sendRequest(1, 40))
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), database.items[aliceChr][mineralsID], "5% of 40 is taken on top")
+	assert.Equal(t, int32(2), database.taxed)
+	assert.Equal(t, int32(40), sent.Currency, "the recipient gets everything sent")
+
+	// The tax is not refunded when the mail comes back
+	fake.Advance(DefaultConfig().Lifetime)
+	_, _, err = service.Expire(ctx)
+	require.NoError(t, err)
+	listed, err := service.List(ctx, aliceID, uuid.PgtypeToString(aliceChr))
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	assert.Equal(t, int32(40), listed[0].Currency)
+}
+
+func TestSend_Validation(t *testing.T) {
+	service, database, _ := newTestService(t)
+	ctx := session.WithWorldID(context.Background(), uuid.PgtypeToString(worldID))
+	database.items[aliceChr][woodID] = 100
+
+	self := sendRequest(1, 0)
+	self.RecipientCharacterId = self.CharacterId
+	_, err := service.Send(ctx, aliceID, self)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = service.Send(ctx, bobID, sendRequest(1, 0))
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "only the owner can send")
+
+	_, err = service.Send(ctx, aliceID, sendRequest(0, 0))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	noSubject := sendRequest(1, 0)
+	noSubject.Subject = " "
+	_, err = service.Send(ctx, aliceID, noSubject)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	database.blocked = true
+	_, err = service.Send(ctx, aliceID, sendRequest(1, 0))
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	database.blocked = false
+
+	// Repeated items are merged into one attachment
+	merged := sendRequest(1, 0)
+	merged.Attachments = append(merged.Attachments, &mailV1.MailAttachment{ItemId: woodID, Quantity: 2})
+	sent, err := service.Send(ctx, aliceID, merged)
+	require.NoError(t, err)
+	require.Len(t, sent.Attachments, 1)
+	assert.Equal(t, int32(3), sent.Attachments[0].Quantity)
+	assert.Equal(t, int32(97), database.items[aliceChr][woodID])
+}
+
+func TestExpire(t *testing.T) {
+	service, database, fake := newTestService(t)
+	ctx := session.WithWorldID(context.Background(), uuid.PgtypeToString(worldID))
+	database.items[aliceChr][woodID] = 10
+
+	sent, err := service.Send(ctx, aliceID, sendRequest(4, 0))
+	require.NoError(t, err)
+	_, err = service.SendSystem(ctx, SystemMail{RecipientID: bobChr, Subject: "Quest reward", Attachments: []Attachment{{ItemID: woodID, Quantity: 1}}})
+	require.NoError(t, err)
+
+	expired, returned, err := service.Expire(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, expired, "nothing is due yet")
+
+	fake.Advance(DefaultConfig().Lifetime)
+	expired, returned, err = service.Expire(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, expired)
+	assert.Equal(t, 1, returned, "system mail is not returned")
+
+	back, err := service.List(ctx, aliceID, uuid.PgtypeToString(aliceChr))
+	require.NoError(t, err)
+	require.Len(t, back, 1)
+	assert.True(t, back[0].Returned)
+	assert.Equal(t, "Returned: "+sent.Subject, back[0].Subject)
+	assert.Equal(t, fake.Now().Add(DefaultConfig().ReturnLifetime), back[0].ExpiresAt.AsTime())
+
+	// Returned mail that is never claimed is lost rather than bounced forever
+	fake.Advance(DefaultConfig().ReturnLifetime)
+	expired, returned, err = service.Expire(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, expired)
+	assert.Zero(t, returned)
+	assert.Empty(t, database.mail)
+}
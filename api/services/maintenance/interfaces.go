@@ -0,0 +1,78 @@
+package maintenance
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/services/notification"
+	"github.com/charmbracelet/log"
+)
+
+// DatabaseInterface abstracts database operations for maintenance mode.
+type DatabaseInterface interface {
+	GetMaintenanceMode(ctx context.Context) (db.MaintenanceMode, error)
+	UpsertMaintenanceMode(ctx context.Context, arg db.UpsertMaintenanceModeParams) (db.MaintenanceMode, error)
+}
+
+// BroadcasterInterface pushes transient notifications to every open stream on this instance.
+type BroadcasterInterface interface {
+	Broadcast(n notification.Notification) int
+}
+
+// DatabaseWrapper implements DatabaseInterface using the actual database connection.
+type DatabaseWrapper struct {
+	queries *db.Queries
+}
+
+// NewDatabaseWrapper creates a new database wrapper with the given connection pool.
+func NewDatabaseWrapper(pool storage.Pool) DatabaseInterface {
+	return &DatabaseWrapper{queries: db.New(pool)}
+}
+
+func (d *DatabaseWrapper) GetMaintenanceMode(ctx context.Context) (db.MaintenanceMode, error) {
+	return d.queries.GetMaintenanceMode(ctx)
+}
+
+func (d *DatabaseWrapper) UpsertMaintenanceMode(ctx context.Context, arg db.UpsertMaintenanceModeParams) (db.MaintenanceMode, error) {
+	return d.queries.UpsertMaintenanceMode(ctx, arg)
+}
+
+// LoggerInterface abstracts logging operations for dependency injection.
+type LoggerInterface interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+	With(keysAndValues ...interface{}) LoggerInterface
+}
+
+// DefaultLoggerWrapper wraps the internal logging package.
+type DefaultLoggerWrapper struct {
+	logger *log.Logger
+}
+
+func NewDefaultLoggerWrapper() LoggerInterface {
+	return &DefaultLoggerWrapper{logger: logging.GetLogger()}
+}
+
+func (l *DefaultLoggerWrapper) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.Debug(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Info(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.Warn(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.Error(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) With(keysAndValues ...interface{}) LoggerInterface {
+	return &DefaultLoggerWrapper{logger: l.logger.With(keysAndValues...)}
+}
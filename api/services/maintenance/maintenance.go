@@ -0,0 +1,351 @@
+// Package maintenance drains the server before planned downtime. While maintenance mode
+// is on, logins from non-admins are rejected with the expected end time, open
+// notification streams get a countdown, and once the grace period is over writes from
+// non-admins are rejected as well. The mode is stored in the database, so it survives
+// restarts; every instance refreshes it periodically.
+package maintenance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/alerting"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	adminV1 "github.com/VoidMesh/api/api/proto/admin/v1"
+	notificationV1 "github.com/VoidMesh/api/api/proto/notification/v1"
+	"github.com/VoidMesh/api/api/services/notification"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// DefaultRefreshInterval is how often the mode is reloaded and the countdown sent
+	DefaultRefreshInterval = 10 * time.Second
+	// DefaultGracePeriod is how long open sessions keep writing after maintenance starts
+	DefaultGracePeriod = 5 * time.Minute
+	MaxGracePeriod     = 24 * time.Hour
+	// CountdownInterval is how often open streams are reminded before writes freeze
+	CountdownInterval = time.Minute
+)
+
+// ReasonMaintenance is the ErrorInfo reason of requests rejected during maintenance
+const ReasonMaintenance = "MAINTENANCE"
+
+const errorDomain = "maintenance.voidmesh"
+
+// Countdown notification states, in the notification's "state" data
+const (
+	StateCountdown = "countdown"
+	StateFrozen    = "frozen"
+	StateEnded     = "ended"
+)
+
+// Service stores maintenance mode and enforces it from an in-memory copy.
+type Service struct {
+	db          DatabaseInterface
+	logger      LoggerInterface
+	clock       clock.Clock
+	interval    time.Duration
+	broadcaster BroadcasterInterface // Nil disables the countdown
+
+	mu     sync.RWMutex
+	mode   db.MaintenanceMode
+	loaded bool
+
+	// What the countdown last announced: the mode's update time, when, and whether the
+	// freeze was announced
+	announcedFor    time.Time
+	announcedAt     time.Time
+	announcedFrozen bool
+}
+
+// NewService creates a new maintenance service with dependency injection.
+func NewService(database DatabaseInterface, logger LoggerInterface) *Service {
+	componentLogger := logger.With("component", "maintenance-service")
+	componentLogger.Debug("Creating new maintenance service")
+	return &Service{
+		db:       database,
+		logger:   componentLogger,
+		clock:    clock.New(),
+		interval: DefaultRefreshInterval,
+	}
+}
+
+// NewServiceWithPool creates a service with concrete implementations (convenience constructor for production use).
+func NewServiceWithPool(pool storage.Pool) *Service {
+	return NewService(NewDatabaseWrapper(pool), NewDefaultLoggerWrapper())
+}
+
+// SetClock replaces the clock used for the grace period and the refresh schedule (for simulation tests)
+func (s *Service) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// SetBroadcaster enables the countdown on open notification streams
+func (s *Service) SetBroadcaster(broadcaster BroadcasterInterface) {
+	s.broadcaster = broadcaster
+}
+
+// current returns the cached mode, loading it on first use. If it cannot be loaded the
+// server stays open.
+func (s *Service) current(ctx context.Context) db.MaintenanceMode {
+	s.mu.RLock()
+	mode, loaded := s.mode, s.loaded
+	s.mu.RUnlock()
+	if loaded {
+		return mode
+	}
+	if err := s.Refresh(ctx); err != nil {
+		s.logger.Error("Failed to load maintenance mode", "error", err)
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.mode
+}
+
+// Refresh reloads the mode from the database
+func (s *Service) Refresh(ctx context.Context) error {
+	mode, err := s.db.GetMaintenanceMode(ctx)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("failed to get maintenance mode: %w", err)
+	}
+	s.mu.Lock()
+	s.mode = mode
+	s.loaded = true
+	s.mu.Unlock()
+	return nil
+}
+
+// Run refreshes the mode and sends the countdown until the context is cancelled
+func (s *Service) Run(ctx context.Context) {
+	s.logger.Info("Maintenance mode refresh started", "interval", s.interval)
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("Maintenance mode refresh stopped")
+			return
+		case <-s.clock.After(s.interval):
+		}
+
+		if err := s.Refresh(ctx); err != nil {
+			s.logger.Error("Maintenance mode refresh failed", "error", err)
+			alerting.ReportJobError("maintenance_refresh", err)
+			continue
+		}
+		s.announce()
+	}
+}
+
+// CheckLogin returns a typed Unavailable error while maintenance mode is on
+func (s *Service) CheckLogin(ctx context.Context) error {
+	mode := s.current(ctx)
+	if !mode.Enabled {
+		return nil
+	}
+	return s.maintenanceError(mode, "the server is down for maintenance")
+}
+
+// CheckWrite returns a typed Unavailable error once maintenance mode has frozen writes
+func (s *Service) CheckWrite(ctx context.Context) error {
+	mode := s.current(ctx)
+	if !s.frozen(mode) {
+		return nil
+	}
+	return s.maintenanceError(mode, "the server is read-only for maintenance")
+}
+
+func (s *Service) frozen(mode db.MaintenanceMode) bool {
+	return mode.Enabled && !s.clock.Now().Before(mode.FreezeAt.Time)
+}
+
+// maintenanceError tells the client why it was rejected and, when known, when to come back
+func (s *Service) maintenanceError(mode db.MaintenanceMode, message string) error {
+	st := status.New(codes.Unavailable, message)
+	info := &errdetails.ErrorInfo{
+		Reason:   ReasonMaintenance,
+		Domain:   errorDomain,
+		Metadata: map[string]string{"message": mode.Message},
+	}
+	details := []protoadapt.MessageV1{info}
+	if mode.Eta.Valid {
+		info.Metadata["eta"] = mode.Eta.Time.UTC().Format(time.RFC3339)
+		if wait := mode.Eta.Time.Sub(s.clock.Now()); wait > 0 {
+			details = append(details, &errdetails.RetryInfo{RetryDelay: durationpb.New(wait)})
+		}
+	}
+	detailed, err := st.WithDetails(details...)
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// Mode reloads and returns the current mode
+func (s *Service) Mode(ctx context.Context) (*adminV1.MaintenanceMode, error) {
+	if err := s.Refresh(ctx); err != nil {
+		s.logger.Error("Failed to get maintenance mode", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to get maintenance mode")
+	}
+	return s.modeToProto(s.current(ctx)), nil
+}
+
+// Set turns maintenance mode on or off. Turning it on again restarts the grace period.
+func (s *Service) Set(ctx context.Context, updatedBy string, req *adminV1.SetMaintenanceModeRequest) (*adminV1.MaintenanceMode, error) {
+	updatedByID, err := uuid.StringToPgtype(updatedBy)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID format")
+	}
+	now := s.clock.Now()
+	params := db.UpsertMaintenanceModeParams{
+		Enabled:   req.Enabled,
+		UpdatedBy: updatedByID,
+		UpdatedAt: pgtype.Timestamp{Time: now, Valid: true},
+	}
+	if req.Enabled {
+		grace := time.Duration(req.GracePeriodSeconds) * time.Second
+		if grace < 0 || grace > MaxGracePeriod {
+			return nil, status.Errorf(codes.InvalidArgument, "grace_period_seconds must be between 0 and %d", int(MaxGracePeriod.Seconds()))
+		}
+		if grace == 0 {
+			grace = DefaultGracePeriod
+		}
+		if req.Eta != nil {
+			if !req.Eta.AsTime().After(now) {
+				return nil, status.Errorf(codes.InvalidArgument, "eta must be in the future")
+			}
+			params.Eta = pgtype.Timestamp{Time: req.Eta.AsTime(), Valid: true}
+		}
+		params.Message = req.Message
+		params.FreezeAt = pgtype.Timestamp{Time: now.Add(grace), Valid: true}
+	}
+
+	mode, err := s.db.UpsertMaintenanceMode(ctx, params)
+	if err != nil {
+		s.logger.Error("Failed to set maintenance mode", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to set maintenance mode")
+	}
+	s.mu.Lock()
+	s.mode = mode
+	s.loaded = true
+	s.mu.Unlock()
+	s.logger.Info("Maintenance mode set", "enabled", mode.Enabled, "freeze_at", mode.FreezeAt.Time, "eta", mode.Eta.Time, "updated_by", updatedBy)
+
+	s.announce()
+	return s.modeToProto(mode), nil
+}
+
+// announce sends the countdown to open streams: every CountdownInterval until writes
+// freeze, once when they do, and once when maintenance ends
+func (s *Service) announce() {
+	if s.broadcaster == nil {
+		return
+	}
+	now := s.clock.Now()
+
+	s.mu.Lock()
+	mode := s.mode
+	current := !s.announcedFor.IsZero() && s.announcedFor.Equal(mode.UpdatedAt.Time)
+	var state string
+	switch {
+	case !mode.Enabled:
+		if !s.announcedFor.IsZero() {
+			state = StateEnded
+		}
+		s.announcedFor = time.Time{}
+	case now.Before(mode.FreezeAt.Time):
+		if !current || now.Sub(s.announcedAt) >= CountdownInterval {
+			state = StateCountdown
+		}
+	default:
+		if !current || !s.announcedFrozen {
+			state = StateFrozen
+		}
+	}
+	if state != "" && mode.Enabled {
+		s.announcedFor = mode.UpdatedAt.Time
+		s.announcedAt = now
+		s.announcedFrozen = state == StateFrozen
+	}
+	s.mu.Unlock()
+
+	if state == "" {
+		return
+	}
+	n := countdownNotification(state, mode, now)
+	delivered := s.broadcaster.Broadcast(n)
+	s.logger.Debug("Maintenance countdown sent", "state", state, "streams", delivered)
+}
+
+func countdownNotification(state string, mode db.MaintenanceMode, now time.Time) notification.Notification {
+	n := notification.Notification{
+		Type: notificationV1.NotificationType_NOTIFICATION_TYPE_MAINTENANCE,
+		Data: map[string]string{"state": state},
+	}
+	switch state {
+	case StateCountdown:
+		n.Title = "Maintenance soon"
+		n.Body = fmt.Sprintf("The server becomes read-only in %s.", formatRemaining(mode.FreezeAt.Time.Sub(now)))
+	case StateFrozen:
+		n.Title = "Maintenance started"
+		n.Body = "The server is read-only until maintenance is over."
+	case StateEnded:
+		n.Title = "Maintenance over"
+		n.Body = "The server is fully available again."
+		return n
+	}
+	if mode.Message != "" {
+		n.Body = mode.Message + " " + n.Body
+	}
+	n.Data["freeze_at"] = mode.FreezeAt.Time.UTC().Format(time.RFC3339)
+	if mode.Eta.Valid {
+		n.Data["eta"] = mode.Eta.Time.UTC().Format(time.RFC3339)
+	}
+	return n
+}
+
+// formatRemaining rounds up to whole minutes, or seconds under a minute
+func formatRemaining(d time.Duration) string {
+	if d < time.Minute {
+		seconds := int((d + time.Second - 1) / time.Second)
+		if seconds == 1 {
+			return "1 second"
+		}
+		return fmt.Sprintf("%d seconds", seconds)
+	}
+	minutes := int((d + time.Minute - 1) / time.Minute)
+	if minutes == 1 {
+		return "1 minute"
+	}
+	return fmt.Sprintf("%d minutes", minutes)
+}
+
+func (s *Service) modeToProto(mode db.MaintenanceMode) *adminV1.MaintenanceMode {
+	proto := &adminV1.MaintenanceMode{
+		Enabled:      mode.Enabled,
+		Message:      mode.Message,
+		WritesFrozen: s.frozen(mode),
+		UpdatedBy:    uuid.PgtypeToString(mode.UpdatedBy),
+	}
+	if mode.Eta.Valid {
+		proto.Eta = timestamppb.New(mode.Eta.Time)
+	}
+	if mode.FreezeAt.Valid {
+		proto.FreezeAt = timestamppb.New(mode.FreezeAt.Time)
+	}
+	if mode.UpdatedAt.Valid {
+		proto.UpdatedAt = timestamppb.New(mode.UpdatedAt.Time)
+	}
+	return proto
+}
@@ -0,0 +1,184 @@
+package maintenance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	adminV1 "github.com/VoidMesh/api/api/proto/admin/v1"
+	"github.com/VoidMesh/api/api/services/notification"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// fakeDB keeps the maintenance row in memory
+type fakeDB struct {
+	mode *db.MaintenanceMode
+}
+
+func (f *fakeDB) GetMaintenanceMode(ctx context.Context) (db.MaintenanceMode, error) {
+	if f.mode == nil {
+		return db.MaintenanceMode{}, pgx.ErrNoRows
+	}
+	return *f.mode, nil
+}
+
+func (f *fakeDB) UpsertMaintenanceMode(ctx context.Context, arg db.UpsertMaintenanceModeParams) (db.MaintenanceMode, error) {
+	mode := db.MaintenanceMode{
+		ID:        true,
+		Enabled:   arg.Enabled,
+		Message:   arg.Message,
+		Eta:       arg.Eta,
+		FreezeAt:  arg.FreezeAt,
+		UpdatedBy: arg.UpdatedBy,
+		UpdatedAt: arg.UpdatedAt,
+	}
+	f.mode = &mode
+	return mode, nil
+}
+
+// fakeBroadcaster records broadcast notifications
+type fakeBroadcaster struct {
+	sent []notification.Notification
+}
+
+func (f *fakeBroadcaster) Broadcast(n notification.Notification) int {
+	f.sent = append(f.sent, n)
+	return 1
+}
+
+const adminID = "00000000-0000-0000-0000-000000000001"
+
+func newTestService(database *fakeDB) (*Service, *clock.Fake, *fakeBroadcaster) {
+	service := NewService(database, testmocks.NopLogger[LoggerInterface]{})
+	fake := clock.NewFake(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	service.SetClock(fake)
+	broadcaster := &fakeBroadcaster{}
+	service.SetBroadcaster(broadcaster)
+	return service, fake, broadcaster
+}
+
+func TestService_LoginsAndWrites(t *testing.T) {
+	database := &fakeDB{}
+	service, fake, _ := newTestService(database)
+	ctx := context.Background()
+
+	assert.NoError(t, service.CheckLogin(ctx), "no stored mode leaves the server open")
+	assert.NoError(t, service.CheckWrite(ctx))
+
+	eta := fake.Now().Add(time.Hour)
+	mode, err := service.Set(ctx, adminID, &adminV1.SetMaintenanceModeRequest{
+		Enabled:            true,
+		Message:            "Upgrading the database.",
+		Eta:                timestamppb.New(eta),
+		GracePeriodSeconds: 120,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, fake.Now().Add(2*time.Minute), mode.FreezeAt.AsTime())
+	assert.False(t, mode.WritesFrozen)
+
+	err = service.CheckLogin(ctx)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	var info *errdetails.ErrorInfo
+	var retry *errdetails.RetryInfo
+	for _, detail := range status.Convert(err).Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			info = d
+		case *errdetails.RetryInfo:
+			retry = d
+		}
+	}
+	require.NotNil(t, info)
+	assert.Equal(t, ReasonMaintenance, info.Reason)
+	assert.Equal(t, "Upgrading the database.", info.Metadata["message"])
+	assert.Equal(t, eta.Format(time.RFC3339), info.Metadata["eta"])
+	require.NotNil(t, retry)
+	assert.Equal(t, time.Hour, retry.RetryDelay.AsDuration())
+
+	assert.NoError(t, service.CheckWrite(ctx), "writes continue during the grace period")
+	fake.Advance(2 * time.Minute)
+	assert.Equal(t, codes.Unavailable, status.Code(service.CheckWrite(ctx)))
+
+	// Another instance loads the stored mode, as after a restart
+	restarted, _, _ := newTestService(database)
+	restarted.SetClock(fake)
+	assert.Equal(t, codes.Unavailable, status.Code(restarted.CheckWrite(ctx)), "the mode persists")
+	got, err := restarted.Mode(ctx)
+	require.NoError(t, err)
+	assert.True(t, got.WritesFrozen)
+	assert.Equal(t, adminID, got.UpdatedBy)
+
+	_, err = service.Set(ctx, adminID, &adminV1.SetMaintenanceModeRequest{Enabled: false})
+	require.NoError(t, err)
+	assert.NoError(t, service.CheckLogin(ctx))
+	assert.NoError(t, service.CheckWrite(ctx))
+}
+
+func TestService_Set_Validation(t *testing.T) {
+	service, fake, _ := newTestService(&fakeDB{})
+	ctx := context.Background()
+
+	for _, req := range []*adminV1.SetMaintenanceModeRequest{
+		{Enabled: true, GracePeriodSeconds: -1},
+		{Enabled: true, GracePeriodSeconds: int32(MaxGracePeriod.Seconds()) + 1},
+		{Enabled: true, Eta: timestamppb.New(fake.Now())},
+	} {
+		_, err := service.Set(ctx, adminID, req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+
+	mode, err := service.Set(ctx, adminID, &adminV1.SetMaintenanceModeRequest{Enabled: true})
+	require.NoError(t, err)
+	assert.Equal(t, fake.Now().Add(DefaultGracePeriod), mode.FreezeAt.AsTime())
+	assert.Nil(t, mode.Eta)
+}
+
+func TestService_Countdown(t *testing.T) {
+	service, fake, broadcaster := newTestService(&fakeDB{})
+	ctx := context.Background()
+
+	service.announce()
+	assert.Empty(t, broadcaster.sent, "nothing is announced outside maintenance")
+
+	_, err := service.Set(ctx, adminID, &adminV1.SetMaintenanceModeRequest{Enabled: true, Message: "Patching.", GracePeriodSeconds: 150})
+	require.NoError(t, err)
+	require.Len(t, broadcaster.sent, 1)
+	assert.Equal(t, StateCountdown, broadcaster.sent[0].Data["state"])
+	assert.Equal(t, "Patching. The server becomes read-only in 3 minutes.", broadcaster.sent[0].Body)
+
+	fake.Advance(30 * time.Second)
+	service.announce()
+	assert.Len(t, broadcaster.sent, 1, "reminders wait for the countdown interval")
+	fake.Advance(30 * time.Second)
+	service.announce()
+	require.Len(t, broadcaster.sent, 2)
+	assert.Equal(t, "Patching. The server becomes read-only in 2 minutes.", broadcaster.sent[1].Body)
+
+	fake.Advance(90 * time.Second)
+	service.announce()
+	service.announce()
+	require.Len(t, broadcaster.sent, 3, "the freeze is announced once")
+	assert.Equal(t, StateFrozen, broadcaster.sent[2].Data["state"])
+
+	_, err = service.Set(ctx, adminID, &adminV1.SetMaintenanceModeRequest{Enabled: false})
+	require.NoError(t, err)
+	service.announce()
+	require.Len(t, broadcaster.sent, 4)
+	assert.Equal(t, StateEnded, broadcaster.sent[3].Data["state"])
+}
+
+func TestFormatRemaining(t *testing.T) {
+	assert.Equal(t, "1 second", formatRemaining(500*time.Millisecond))
+	assert.Equal(t, "45 seconds", formatRemaining(45*time.Second))
+	assert.Equal(t, "1 minute", formatRemaining(time.Minute))
+	assert.Equal(t, "5 minutes", formatRemaining(4*time.Minute+time.Second))
+}
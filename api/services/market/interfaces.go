@@ -0,0 +1,347 @@
+package market
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/outbox"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/txn"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	"github.com/VoidMesh/api/api/services/economy"
+	"github.com/VoidMesh/api/api/services/inventory"
+	"github.com/VoidMesh/api/api/services/mail"
+	"github.com/charmbracelet/log"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DatabaseInterface abstracts database operations for the market.
+type DatabaseInterface interface {
+	GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error)
+	GetItem(ctx context.Context, id int32) (db.Item, error)
+	GetItemByName(ctx context.Context, name string) (db.Item, error)
+	SearchMarketListings(ctx context.Context, arg db.SearchMarketListingsParams) ([]db.SearchMarketListingsRow, error)
+	ListMarketListingsBySeller(ctx context.Context, sellerID pgtype.UUID) ([]db.ListMarketListingsBySellerRow, error)
+	ListExpiredMarketListings(ctx context.Context, arg db.ListExpiredMarketListingsParams) ([]int64, error)
+	GetMarketPriceHistory(ctx context.Context, arg db.GetMarketPriceHistoryParams) ([]db.GetMarketPriceHistoryRow, error)
+	CreateListing(ctx context.Context, listing db.CreateMarketListingParams, maxListings int64, fee ListingFee) (db.MarketListing, error)
+	BuyListing(ctx context.Context, arg BuyParams) (db.MarketListing, error)
+	ExpireListing(ctx context.Context, arg ExpireParams) (bool, error)
+}
+
+// ListingFee is the currency taken from the seller when listing; nothing when Amount is 0
+type ListingFee struct {
+	CurrencyItemID int32
+	Amount         int32
+}
+
+// BuyParams settles a buyout: the price moves from the buyer to the seller's mail, less
+// the sale tax, and the listed items into the buyer's inventory
+type BuyParams struct {
+	Buyer         db.Character
+	ListingID     int64
+	CurrencyItem  db.Item
+	Now           pgtype.Timestamp
+	MailExpiresAt pgtype.Timestamp
+	TaxPercent    int32                                // Of the price, rounded down
+	Approve       func(listing db.MarketListing) error // Optional last check before settling
+}
+
+// ExpireParams deletes an expired listing, mailing its items back to the seller
+type ExpireParams struct {
+	ListingID     int64
+	Now           pgtype.Timestamp
+	MailExpiresAt pgtype.Timestamp
+}
+
+// DatabaseWrapper implements DatabaseInterface using the actual database connection.
+type DatabaseWrapper struct {
+	pool    storage.Pool
+	queries *db.Queries
+}
+
+// NewDatabaseWrapper creates a new database wrapper with the given connection pool.
+func NewDatabaseWrapper(pool storage.Pool) DatabaseInterface {
+	return &DatabaseWrapper{
+		pool:    pool,
+		queries: db.New(pool),
+	}
+}
+
+func (d *DatabaseWrapper) GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error) {
+	return d.queries.GetCharacterById(ctx, id)
+}
+
+func (d *DatabaseWrapper) GetItem(ctx context.Context, id int32) (db.Item, error) {
+	return d.queries.GetItem(ctx, id)
+}
+
+func (d *DatabaseWrapper) GetItemByName(ctx context.Context, name string) (db.Item, error) {
+	return d.queries.GetItemByName(ctx, name)
+}
+
+func (d *DatabaseWrapper) SearchMarketListings(ctx context.Context, arg db.SearchMarketListingsParams) ([]db.SearchMarketListingsRow, error) {
+	return d.queries.SearchMarketListings(ctx, arg)
+}
+
+func (d *DatabaseWrapper) ListMarketListingsBySeller(ctx context.Context, sellerID pgtype.UUID) ([]db.ListMarketListingsBySellerRow, error) {
+	return d.queries.ListMarketListingsBySeller(ctx, sellerID)
+}
+
+func (d *DatabaseWrapper) ListExpiredMarketListings(ctx context.Context, arg db.ListExpiredMarketListingsParams) ([]int64, error) {
+	return d.queries.ListExpiredMarketListings(ctx, arg)
+}
+
+func (d *DatabaseWrapper) GetMarketPriceHistory(ctx context.Context, arg db.GetMarketPriceHistoryParams) ([]db.GetMarketPriceHistoryRow, error) {
+	return d.queries.GetMarketPriceHistory(ctx, arg)
+}
+
+// CreateListing takes the listed items and the fee from the seller and stores the listing
+// in one serializable transaction, so the listing limit and the escrow cannot be raced.
+// The fee is recorded as destroyed in the economy ledger.
+func (d *DatabaseWrapper) CreateListing(ctx context.Context, listing db.CreateMarketListingParams, maxListings int64, fee ListingFee) (db.MarketListing, error) {
+	var created db.MarketListing
+	err := txn.Run(ctx, d.pool, txn.Serializable, func(q *db.Queries) error {
+		count, err := q.CountMarketListingsBySeller(ctx, listing.SellerID)
+		if err != nil {
+			return fmt.Errorf("failed to count listings: %w", err)
+		}
+		if count >= maxListings {
+			return ErrTooManyListings
+		}
+		if err := takeInTx(ctx, q, listing.SellerID, listing.ItemID, listing.Quantity, ErrInsufficientItems); err != nil {
+			return err
+		}
+		if fee.Amount > 0 {
+			if err := takeInTx(ctx, q, listing.SellerID, fee.CurrencyItemID, fee.Amount, ErrInsufficientFunds); err != nil {
+				return err
+			}
+		}
+		created, err = q.CreateMarketListing(ctx, listing)
+		if err != nil {
+			return fmt.Errorf("failed to create listing: %w", err)
+		}
+		return economy.RecordInTx(ctx, q, economy.Flow{
+			WorldID:   listing.WorldID,
+			Source:    economy.SourceMarketListingFee,
+			Destroyed: int64(fee.Amount),
+			DedupKey:  fmt.Sprintf("%s:%d", economy.SourceMarketListingFee, created.ID),
+			At:        listing.CreatedAt.Time,
+		})
+	})
+	return created, err
+}
+
+// BuyListing settles a buyout in one serializable transaction: the buyer pays, receives
+// the items, the seller is mailed the price less the tax, the tax is recorded as destroyed
+// in the economy ledger and MarketSaleCompleted and TradeCompleted events are enqueued.
+// Nothing changes unless all of it does.
+func (d *DatabaseWrapper) BuyListing(ctx context.Context, arg BuyParams) (db.MarketListing, error) {
+	var listing db.MarketListing
+	err := txn.Run(ctx, d.pool, txn.Serializable, func(q *db.Queries) error {
+		var err error
+		listing, err = q.GetMarketListingForUpdate(ctx, arg.ListingID)
+		if err != nil {
+			return err
+		}
+		// Listings in other worlds and those waiting to be returned are not for sale
+		if listing.WorldID != arg.Buyer.WorldID || !listing.ExpiresAt.Time.After(arg.Now.Time) {
+			return pgx.ErrNoRows
+		}
+		if listing.SellerID == arg.Buyer.ID {
+			return ErrOwnListing
+		}
+		if arg.Approve != nil {
+			if err := arg.Approve(listing); err != nil {
+				return err
+			}
+		}
+
+		price := listing.UnitPrice * listing.Quantity
+		if err := takeInTx(ctx, q, arg.Buyer.ID, arg.CurrencyItem.ID, price, ErrInsufficientFunds); err != nil {
+			return err
+		}
+		item, err := q.GetItem(ctx, listing.ItemID)
+		if err != nil {
+			return fmt.Errorf("failed to get item %d: %w", listing.ItemID, err)
+		}
+		grant := inventory.Grant{ItemID: item.ID, StackSize: item.StackSize, Quantity: listing.Quantity}
+		if _, err := inventory.GrantAllInTx(ctx, q, arg.Buyer, []inventory.Grant{grant}); err != nil {
+			return err
+		}
+
+		tax := int32(economy.Tax(int64(price), arg.TaxPercent))
+		body := ""
+		if tax > 0 {
+			body = fmt.Sprintf("Sold for %d, less %d sale tax.", price, tax)
+		}
+		_, err = mail.SendInTx(ctx, q, mail.SendParams{Mail: db.CreateMailParams{
+			RecipientID: listing.SellerID,
+			SenderName:  SenderName,
+			Subject:     fmt.Sprintf("Sold: %d %s", listing.Quantity, item.Name),
+			Body:        body,
+			Currency:    price - tax,
+			CreatedAt:   arg.Now,
+			ExpiresAt:   arg.MailExpiresAt,
+		}})
+		if err != nil {
+			return fmt.Errorf("failed to mail the seller: %w", err)
+		}
+		if err := q.DeleteMarketListing(ctx, listing.ID); err != nil {
+			return fmt.Errorf("failed to delete listing: %w", err)
+		}
+		saleID, err := q.CreateMarketSale(ctx, db.CreateMarketSaleParams{
+			WorldID:   listing.WorldID,
+			ItemID:    listing.ItemID,
+			Quantity:  listing.Quantity,
+			UnitPrice: listing.UnitPrice,
+			SoldAt:    arg.Now,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record sale: %w", err)
+		}
+		err = economy.RecordInTx(ctx, q, economy.Flow{
+			WorldID:   listing.WorldID,
+			Source:    economy.SourceMarketSaleTax,
+			Destroyed: int64(tax),
+			DedupKey:  fmt.Sprintf("%s:%d", economy.SourceMarketSaleTax, saleID),
+			At:        arg.Now.Time,
+		})
+		if err != nil {
+			return err
+		}
+		err = outbox.Enqueue(ctx, q, events.MarketSaleCompleted, strconv.FormatInt(saleID, 10),
+			fmt.Sprintf("%s:%d", events.MarketSaleCompleted, saleID),
+			events.MarketSaleCompletedPayload{
+				SaleID:    saleID,
+				WorldID:   uuid.PgtypeToString(listing.WorldID),
+				ItemID:    item.ID,
+				ItemName:  item.Name,
+				Quantity:  listing.Quantity,
+				UnitPrice: listing.UnitPrice,
+			})
+		if err != nil {
+			return err
+		}
+		seller, err := q.GetCharacterById(ctx, listing.SellerID)
+		if err != nil {
+			return fmt.Errorf("failed to get seller: %w", err)
+		}
+		trade := saleTrade(saleID, arg.Buyer, seller)
+		return outbox.Enqueue(ctx, q, events.TradeCompleted, trade.TradeID, events.TradeCompleted+":"+trade.TradeID, trade)
+	})
+	return listing, err
+}
+
+// saleTrade is the TradeCompleted payload of a market sale between two characters
+func saleTrade(saleID int64, buyer, seller db.Character) events.TradeCompletedPayload {
+	return events.TradeCompletedPayload{
+		TradeID:      fmt.Sprintf("market_sale:%d", saleID),
+		UserIDs:      []string{uuid.PgtypeToString(buyer.UserID), uuid.PgtypeToString(seller.UserID)},
+		CharacterIDs: []string{uuid.PgtypeToString(buyer.ID), uuid.PgtypeToString(seller.ID)},
+	}
+}
+
+// ExpireListing deletes a listing past its expiry and mails its items back to the seller;
+// returns false when the listing was bought or is no longer due
+func (d *DatabaseWrapper) ExpireListing(ctx context.Context, arg ExpireParams) (bool, error) {
+	var expired bool
+	err := txn.Run(ctx, d.pool, txn.ReadCommitted, func(q *db.Queries) error {
+		expired = false
+		listing, err := q.GetMarketListingForUpdate(ctx, arg.ListingID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil // Bought meanwhile
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get listing: %w", err)
+		}
+		if listing.ExpiresAt.Time.After(arg.Now.Time) {
+			return nil
+		}
+		item, err := q.GetItem(ctx, listing.ItemID)
+		if err != nil {
+			return fmt.Errorf("failed to get item %d: %w", listing.ItemID, err)
+		}
+
+		_, err = mail.SendInTx(ctx, q, mail.SendParams{
+			Mail: db.CreateMailParams{
+				RecipientID: listing.SellerID,
+				SenderName:  SenderName,
+				Subject:     fmt.Sprintf("Unsold: %d %s", listing.Quantity, item.Name),
+				CreatedAt:   arg.Now,
+				ExpiresAt:   arg.MailExpiresAt,
+			},
+			Attachments: []mail.Attachment{{ItemID: listing.ItemID, Quantity: listing.Quantity}},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to mail the seller: %w", err)
+		}
+		if err := q.DeleteMarketListing(ctx, listing.ID); err != nil {
+			return fmt.Errorf("failed to delete listing: %w", err)
+		}
+		expired = true
+		return nil
+	})
+	return expired, err
+}
+
+// takeInTx removes a quantity of an item from a character's inventory, failing with
+// insufficient when it holds too little
+func takeInTx(ctx context.Context, q *db.Queries, characterID pgtype.UUID, itemID, quantity int32, insufficient error) error {
+	_, err := q.RemoveInventoryItemQuantity(ctx, db.RemoveInventoryItemQuantityParams{
+		CharacterID: characterID,
+		ItemID:      itemID,
+		Quantity:    quantity,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return insufficient
+	}
+	if err != nil {
+		return fmt.Errorf("failed to take item %d: %w", itemID, err)
+	}
+	return q.DeleteEmptyInventoryItems(ctx, characterID)
+}
+
+// LoggerInterface abstracts logging operations for dependency injection.
+type LoggerInterface interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+	With(keysAndValues ...interface{}) LoggerInterface
+}
+
+// DefaultLoggerWrapper wraps the internal logging package.
+type DefaultLoggerWrapper struct {
+	logger *log.Logger
+}
+
+func NewDefaultLoggerWrapper() LoggerInterface {
+	return &DefaultLoggerWrapper{logger: logging.GetLogger()}
+}
+
+func (l *DefaultLoggerWrapper) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.Debug(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Info(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.Warn(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.Error(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) With(keysAndValues ...interface{}) LoggerInterface {
+	return &DefaultLoggerWrapper{logger: l.logger.With(keysAndValues...)}
+}
@@ -0,0 +1,492 @@
+// Package market lets characters sell items to anyone in their world. Listed items are
+// taken from the seller's inventory when the listing is created. A buyout takes the price
+// from the buyer and grants the items in the same transaction, and the seller is paid by
+// mail so they need not be online. Listings that expire unsold send the items back by
+// mail. Every sale is recorded in market_sales for price history. Listing fees and the
+// sale tax are currency sinks: they leave the game and are recorded in the economy ledger.
+package market
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/alerting"
+	"github.com/VoidMesh/api/api/internal/authz"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/scripting"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	marketV1 "github.com/VoidMesh/api/api/proto/market/v1"
+	"github.com/VoidMesh/api/api/services/economy"
+	"github.com/VoidMesh/api/api/services/inventory"
+	"github.com/VoidMesh/api/api/services/mail"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// SenderName is shown as the sender of mail the market sends
+	SenderName = "Market"
+
+	DefaultSearchLimit = 50
+	MaxSearchLimit     = 200
+	DefaultHistoryDays = 30
+	MaxHistoryDays     = 90
+
+	// expiryBatchSize is how many expired listings the expiry job loads per page
+	expiryBatchSize = 200
+)
+
+var (
+	ErrInsufficientItems = errors.New("not enough items")
+	ErrInsufficientFunds = errors.New("not enough currency")
+	ErrTooManyListings   = errors.New("listing limit reached")
+	ErrOwnListing        = errors.New("cannot buy own listing")
+	ErrTradeVetoed       = errors.New("trade not allowed")
+)
+
+// ScriptEngineInterface defines the scripting hooks run on buyouts.
+type ScriptEngineInterface interface {
+	Trade(ctx context.Context, input scripting.TradeInput) scripting.Result
+	Publish(ctx context.Context, hook, worldID string, spawned []scripting.Event)
+}
+
+// Config sets what listings are paid in, how long they last and how many a character
+// may hold
+type Config struct {
+	CurrencyItem    string        // Name of the item prices are paid in
+	DefaultDuration time.Duration // Listing duration when none is requested
+	MaxDuration     time.Duration
+	MaxListings     int64         // Listings a character may hold at once
+	MailLifetime    time.Duration // How long sale proceeds and returned items wait in the mailbox
+	ExpiryInterval  time.Duration // Time between expiry passes
+	// Percent of the total price taken from the seller when listing, rounded up
+	ListingFeePercent int32
+	// Percent of the price withheld from the seller's proceeds on a buyout, rounded down
+	SaleTaxPercent int32
+}
+
+// DefaultConfig lists items for a day by default, at most a week, up to 20 at a time, for
+// a 1% listing fee and a 5% sale tax
+func DefaultConfig() Config {
+	return Config{
+		CurrencyItem:      "Minerals",
+		DefaultDuration:   24 * time.Hour,
+		MaxDuration:       7 * 24 * time.Hour,
+		MaxListings:       20,
+		MailLifetime:      mail.DefaultConfig().Lifetime,
+		ExpiryInterval:    5 * time.Minute,
+		ListingFeePercent: 1,
+		SaleTaxPercent:    5,
+	}
+}
+
+// Service manages market listings, buyouts and price history.
+type Service struct {
+	db      DatabaseInterface
+	logger  LoggerInterface
+	clock   clock.Clock
+	config  Config
+	scripts ScriptEngineInterface
+}
+
+// NewService creates a new market service with dependency injection.
+func NewService(db DatabaseInterface, logger LoggerInterface) *Service {
+	componentLogger := logger.With("component", "market-service")
+	componentLogger.Debug("Creating new market service")
+	return &Service{
+		db:     db,
+		logger: componentLogger,
+		clock:  clock.New(),
+		config: DefaultConfig(),
+	}
+}
+
+// NewServiceWithPool creates a service with concrete implementations (convenience constructor for production use).
+func NewServiceWithPool(pool storage.Pool) *Service {
+	return NewService(NewDatabaseWrapper(pool), NewDefaultLoggerWrapper())
+}
+
+// SetScripts runs the on_trade scripting hook on buyouts
+func (s *Service) SetScripts(scripts ScriptEngineInterface) {
+	s.scripts = scripts
+}
+
+// SetClock replaces the clock used for timestamps and the expiry schedule (for simulation tests)
+func (s *Service) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// SetConfig replaces the listing limits, durations, fee and tax
+func (s *Service) SetConfig(config Config) {
+	s.config = config
+}
+
+// listingFee is what listing items for a total price costs
+func (s *Service) listingFee(total int64) int32 {
+	return int32((total*int64(s.config.ListingFeePercent) + 99) / 100)
+}
+
+// saleTax is what is withheld from a sale at a total price
+func (s *Service) saleTax(total int64) int64 {
+	return economy.Tax(total, s.config.SaleTaxPercent)
+}
+
+// world resolves a requested world, defaulting to the caller's session world
+func world(ctx context.Context, worldID string) (pgtype.UUID, error) {
+	if worldID == "" {
+		sessionWorldID, ok := session.WorldIDFromContext(ctx)
+		if !ok {
+			return pgtype.UUID{}, status.Errorf(codes.InvalidArgument, "world_id is required")
+		}
+		worldID = sessionWorldID
+	}
+	id, err := uuid.StringToPgtype(worldID)
+	if err != nil {
+		return pgtype.UUID{}, status.Errorf(codes.InvalidArgument, "invalid world ID format")
+	}
+	return id, nil
+}
+
+// CreateListing lists items from the character's inventory for sale in its world. The
+// items and the listing fee are taken from the inventory right away.
+func (s *Service) CreateListing(ctx context.Context, userID string, req *marketV1.CreateListingRequest) (*marketV1.CreateListingResponse, error) {
+	character, err := authz.OwnedCharacter(ctx, s.db, s.logger, userID, req.CharacterId)
+	if err != nil {
+		return nil, err
+	}
+	if req.Quantity <= 0 || req.UnitPrice <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "quantity and unit_price must be positive")
+	}
+	if int64(req.Quantity)*int64(req.UnitPrice) > math.MaxInt32 {
+		return nil, status.Errorf(codes.InvalidArgument, "total price must be at most %d", math.MaxInt32)
+	}
+	if req.DurationSeconds < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "duration_seconds must not be negative")
+	}
+	duration := s.config.DefaultDuration
+	if req.DurationSeconds > 0 {
+		duration = s.config.MaxDuration
+		if req.DurationSeconds < int64(s.config.MaxDuration/time.Second) {
+			duration = time.Duration(req.DurationSeconds) * time.Second
+		}
+	}
+
+	fee := ListingFee{Amount: s.listingFee(int64(req.Quantity) * int64(req.UnitPrice))}
+	if fee.Amount > 0 {
+		currency, err := s.db.GetItemByName(ctx, s.config.CurrencyItem)
+		if err != nil {
+			s.logger.Error("Failed to get market currency", "currency_item", s.config.CurrencyItem, "error", err)
+			return nil, status.Errorf(codes.Internal, "failed to create listing")
+		}
+		fee.CurrencyItemID = currency.ID
+	}
+
+	now := s.clock.Now()
+	listing, err := s.db.CreateListing(ctx, db.CreateMarketListingParams{
+		WorldID:   character.WorldID,
+		SellerID:  character.ID,
+		ItemID:    req.ItemId,
+		Quantity:  req.Quantity,
+		UnitPrice: req.UnitPrice,
+		CreatedAt: pgtype.Timestamp{Time: now, Valid: true},
+		ExpiresAt: pgtype.Timestamp{Time: now.Add(duration), Valid: true},
+	}, s.config.MaxListings, fee)
+	switch {
+	case errors.Is(err, ErrTooManyListings):
+		return nil, status.Errorf(codes.FailedPrecondition, "a character can hold at most %d listings", s.config.MaxListings)
+	case errors.Is(err, ErrInsufficientItems):
+		return nil, status.Errorf(codes.FailedPrecondition, "not enough items to list")
+	case errors.Is(err, ErrInsufficientFunds):
+		return nil, status.Errorf(codes.FailedPrecondition, "not enough %s for the listing fee of %d", s.config.CurrencyItem, fee.Amount)
+	case err != nil:
+		s.logger.Error("Failed to create listing", "character_id", req.CharacterId, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to create listing")
+	}
+
+	s.logger.Info("Market listing created", "listing_id", listing.ID, "character_id", req.CharacterId, "item_id", listing.ItemID, "quantity", listing.Quantity, "unit_price", listing.UnitPrice, "fee", fee.Amount)
+	return &marketV1.CreateListingResponse{Listing: s.withItem(ctx, listing), ListingFee: fee.Amount}, nil
+}
+
+// Search returns a page of open listings matching the filter, cheapest first
+func (s *Service) Search(ctx context.Context, req *marketV1.SearchListingsRequest) ([]*marketV1.Listing, error) {
+	worldID, err := world(ctx, req.WorldId)
+	if err != nil {
+		return nil, err
+	}
+	if req.Limit < 0 || req.AfterUnitPrice < 0 || req.AfterId < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "limit and cursor must not be negative")
+	}
+	limit := req.Limit
+	if limit == 0 {
+		limit = DefaultSearchLimit
+	}
+	if limit > MaxSearchLimit {
+		limit = MaxSearchLimit
+	}
+	filter := req.Filter
+	if filter == nil {
+		filter = &marketV1.ListingFilter{}
+	}
+
+	rows, err := s.db.SearchMarketListings(ctx, db.SearchMarketListingsParams{
+		WorldID:        worldID,
+		Now:            pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
+		AfterUnitPrice: req.AfterUnitPrice,
+		AfterID:        req.AfterId,
+		ItemID:         filter.ItemId,
+		ItemType:       filter.ItemType,
+		NamePrefix:     escapeLike(filter.NamePrefix),
+		MaxUnitPrice:   filter.MaxUnitPrice,
+		MaxListings:    limit,
+	})
+	if err != nil {
+		s.logger.Error("Failed to search listings", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to search listings")
+	}
+	listings := make([]*marketV1.Listing, 0, len(rows))
+	for _, row := range rows {
+		listings = append(listings, s.listingToProto(db.MarketListing{
+			ID:        row.ID,
+			WorldID:   row.WorldID,
+			SellerID:  row.SellerID,
+			ItemID:    row.ItemID,
+			Quantity:  row.Quantity,
+			UnitPrice: row.UnitPrice,
+			CreatedAt: row.CreatedAt,
+			ExpiresAt: row.ExpiresAt,
+		}, row.ItemName, row.ItemType, row.Rarity))
+	}
+	return listings, nil
+}
+
+// MyListings returns the character's listings, newest first
+func (s *Service) MyListings(ctx context.Context, userID, characterID string) ([]*marketV1.Listing, error) {
+	character, err := authz.OwnedCharacter(ctx, s.db, s.logger, userID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.db.ListMarketListingsBySeller(ctx, character.ID)
+	if err != nil {
+		s.logger.Error("Failed to list listings", "character_id", characterID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list listings")
+	}
+	listings := make([]*marketV1.Listing, 0, len(rows))
+	for _, row := range rows {
+		listings = append(listings, s.listingToProto(db.MarketListing{
+			ID:        row.ID,
+			WorldID:   row.WorldID,
+			SellerID:  row.SellerID,
+			ItemID:    row.ItemID,
+			Quantity:  row.Quantity,
+			UnitPrice: row.UnitPrice,
+			CreatedAt: row.CreatedAt,
+			ExpiresAt: row.ExpiresAt,
+		}, row.ItemName, row.ItemType, row.Rarity))
+	}
+	return listings, nil
+}
+
+// Buy buys a whole listing for the character. The price is taken from its inventory and
+// the items added to it in one transaction; the seller is paid by mail, less the sale tax.
+func (s *Service) Buy(ctx context.Context, userID, characterID string, listingID int64) (*marketV1.Listing, error) {
+	character, err := authz.OwnedCharacter(ctx, s.db, s.logger, userID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	currency, err := s.db.GetItemByName(ctx, s.config.CurrencyItem)
+	if err != nil {
+		s.logger.Error("Failed to get market currency", "currency_item", s.config.CurrencyItem, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to buy listing")
+	}
+
+	now := s.clock.Now()
+	buy := BuyParams{
+		Buyer:         character,
+		ListingID:     listingID,
+		CurrencyItem:  currency,
+		Now:           pgtype.Timestamp{Time: now, Valid: true},
+		MailExpiresAt: pgtype.Timestamp{Time: now.Add(s.config.MailLifetime), Valid: true},
+		TaxPercent:    s.config.SaleTaxPercent,
+	}
+	// Scripts see the locked listing, so what they approve is what settles
+	var spawned []scripting.Event
+	if s.scripts != nil {
+		buy.Approve = func(listing db.MarketListing) error {
+			result := s.scripts.Trade(ctx, scripting.TradeInput{
+				Kind:      "market_buyout",
+				WorldID:   uuid.PgtypeToString(listing.WorldID),
+				SellerID:  uuid.PgtypeToString(listing.SellerID),
+				BuyerID:   uuid.PgtypeToString(character.ID),
+				ItemID:    listing.ItemID,
+				Quantity:  listing.Quantity,
+				UnitPrice: listing.UnitPrice,
+			})
+			if result.Veto {
+				return fmt.Errorf("%w: %s", ErrTradeVetoed, result.Reason)
+			}
+			spawned = result.Events
+			return nil
+		}
+	}
+	listing, err := s.db.BuyListing(ctx, buy)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, status.Errorf(codes.NotFound, "listing not found")
+	case errors.Is(err, ErrOwnListing):
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot buy your own listing")
+	case errors.Is(err, ErrInsufficientFunds):
+		return nil, status.Errorf(codes.FailedPrecondition, "not enough %s", s.config.CurrencyItem)
+	case errors.Is(err, ErrTradeVetoed):
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	case errors.Is(err, inventory.ErrInventoryFull):
+		return nil, status.Errorf(codes.ResourceExhausted, "not enough inventory space for the listing")
+	case err != nil:
+		s.logger.Error("Failed to buy listing", "listing_id", listingID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to buy listing")
+	}
+
+	if s.scripts != nil {
+		s.scripts.Publish(ctx, scripting.HookTrade, uuid.PgtypeToString(listing.WorldID), spawned)
+	}
+	s.logger.Info("Market listing bought", "listing_id", listing.ID, "buyer_id", characterID, "seller_id", uuid.PgtypeToString(listing.SellerID), "price", listing.UnitPrice*listing.Quantity)
+	return s.withItem(ctx, listing), nil
+}
+
+// PriceHistory returns daily sale statistics for an item over the last days, oldest first
+func (s *Service) PriceHistory(ctx context.Context, worldID string, itemID, days int32) ([]*marketV1.PriceHistoryPoint, error) {
+	world, err := world(ctx, worldID)
+	if err != nil {
+		return nil, err
+	}
+	if days < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "days must not be negative")
+	}
+	if days == 0 {
+		days = DefaultHistoryDays
+	}
+	if days > MaxHistoryDays {
+		days = MaxHistoryDays
+	}
+
+	// Whole UTC days, so the oldest bucket is complete
+	since := s.clock.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, -int(days-1))
+	rows, err := s.db.GetMarketPriceHistory(ctx, db.GetMarketPriceHistoryParams{
+		WorldID: world,
+		ItemID:  itemID,
+		Since:   pgtype.Timestamp{Time: since, Valid: true},
+	})
+	if err != nil {
+		s.logger.Error("Failed to get price history", "item_id", itemID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to get price history")
+	}
+	points := make([]*marketV1.PriceHistoryPoint, 0, len(rows))
+	for _, row := range rows {
+		points = append(points, &marketV1.PriceHistoryPoint{
+			Day:          timestamppb.New(row.Day.Time),
+			Sales:        row.Sales,
+			Volume:       row.Volume,
+			MinUnitPrice: row.MinUnitPrice,
+			MaxUnitPrice: row.MaxUnitPrice,
+			AvgUnitPrice: row.AvgUnitPrice,
+		})
+	}
+	return points, nil
+}
+
+// Expire mails the items of every listing past its expiry back to the seller. It returns
+// how many listings expired.
+func (s *Service) Expire(ctx context.Context) (int, error) {
+	expired := 0
+	for {
+		now := s.clock.Now()
+		ids, err := s.db.ListExpiredMarketListings(ctx, db.ListExpiredMarketListingsParams{
+			ExpiresAt: pgtype.Timestamp{Time: now, Valid: true},
+			Limit:     expiryBatchSize,
+		})
+		if err != nil {
+			return expired, fmt.Errorf("failed to list expired listings: %w", err)
+		}
+
+		for _, id := range ids {
+			ok, err := s.db.ExpireListing(ctx, ExpireParams{
+				ListingID:     id,
+				Now:           pgtype.Timestamp{Time: now, Valid: true},
+				MailExpiresAt: pgtype.Timestamp{Time: now.Add(s.config.MailLifetime), Valid: true},
+			})
+			if err != nil {
+				return expired, fmt.Errorf("failed to expire listing %d: %w", id, err)
+			}
+			if ok {
+				expired++
+			}
+		}
+
+		if len(ids) < expiryBatchSize {
+			return expired, nil
+		}
+	}
+}
+
+// Run expires listings every config.ExpiryInterval until ctx is cancelled
+func (s *Service) Run(ctx context.Context) {
+	s.logger.Info("Market listing expiry job started", "interval", s.config.ExpiryInterval)
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("Market listing expiry job stopped")
+			return
+		case <-s.clock.After(s.config.ExpiryInterval):
+		}
+
+		expired, err := s.Expire(ctx)
+		if err != nil {
+			s.logger.Error("Market listing expiry pass failed", "error", err)
+			alerting.ReportJobError("market_listing_expiry", err)
+		}
+		if expired > 0 {
+			s.logger.Debug("Market listing expiry pass complete", "expired", expired)
+		}
+	}
+}
+
+// withItem converts a listing, filling in its item's details when they can be loaded
+func (s *Service) withItem(ctx context.Context, listing db.MarketListing) *marketV1.Listing {
+	item, err := s.db.GetItem(ctx, listing.ItemID)
+	if err != nil {
+		s.logger.Warn("Failed to get listed item", "item_id", listing.ItemID, "error", err)
+	}
+	return s.listingToProto(listing, item.Name, item.ItemType, item.Rarity)
+}
+
+// escapeLike makes a name prefix match literally in ILIKE
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+func (s *Service) listingToProto(row db.MarketListing, itemName, itemType, rarity string) *marketV1.Listing {
+	total := int64(row.UnitPrice) * int64(row.Quantity)
+	return &marketV1.Listing{
+		Id:                row.ID,
+		SellerCharacterId: uuid.PgtypeToString(row.SellerID),
+		ItemId:            row.ItemID,
+		ItemName:          itemName,
+		ItemType:          itemType,
+		Rarity:            rarity,
+		Quantity:          row.Quantity,
+		UnitPrice:         row.UnitPrice,
+		TotalPrice:        total,
+		CreatedAt:         timestamppb.New(row.CreatedAt.Time),
+		ExpiresAt:         timestamppb.New(row.ExpiresAt.Time),
+		SaleTax:           s.saleTax(total),
+	}
+}
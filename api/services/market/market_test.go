@@ -0,0 +1,489 @@
+package market
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/scripting"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	marketV1 "github.com/VoidMesh/api/api/proto/market/v1"
+	tutorialV1 "github.com/VoidMesh/api/api/proto/tutorial/v1"
+	"github.com/VoidMesh/api/api/services/economy"
+	"github.com/VoidMesh/api/api/services/inventory"
+	"github.com/VoidMesh/api/api/services/tutorial"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	mineralsID = 3
+	woodID     = 1
+)
+
+// fakeDB keeps characters, their items, listings and mailed proceeds in memory
+type fakeDB struct {
+	testmocks.Characters
+	items    map[pgtype.UUID]map[int32]int32
+	full     map[pgtype.UUID]bool
+	listings map[int64]db.MarketListing
+	nextID   int64
+	mailed   map[pgtype.UUID]map[int32]int32
+	sales    []db.CreateMarketSaleParams
+	search   db.SearchMarketListingsParams
+	history  db.GetMarketPriceHistoryParams
+	fees     int32 // Listing fees taken
+	taxes    int32 // Sale taxes withheld
+	outbox   []events.Event
+}
+
+func newFakeDB() *fakeDB {
+	return &fakeDB{
+		Characters: testmocks.Characters{},
+		items:      map[pgtype.UUID]map[int32]int32{},
+		full:       map[pgtype.UUID]bool{},
+		listings:   map[int64]db.MarketListing{},
+		mailed:     map[pgtype.UUID]map[int32]int32{},
+	}
+}
+
+func (f *fakeDB) GetItem(ctx context.Context, id int32) (db.Item, error) {
+	return db.Item{ID: id, Name: "Wood", ItemType: "material", Rarity: "common", StackSize: 64}, nil
+}
+
+func (f *fakeDB) GetItemByName(ctx context.Context, name string) (db.Item, error) {
+	if name != "Minerals" {
+		return db.Item{}, pgx.ErrNoRows
+	}
+	return db.Item{ID: mineralsID, Name: name, StackSize: 100}, nil
+}
+
+func (f *fakeDB) SearchMarketListings(ctx context.Context, arg db.SearchMarketListingsParams) ([]db.SearchMarketListingsRow, error) {
+	f.search = arg
+	var rows []db.SearchMarketListingsRow
+	for _, l := range f.listings {
+		if l.WorldID == arg.WorldID && l.ExpiresAt.Time.After(arg.Now.Time) {
+			rows = append(rows, db.SearchMarketListingsRow{ID: l.ID, SellerID: l.SellerID, ItemID: l.ItemID, Quantity: l.Quantity, UnitPrice: l.UnitPrice, ItemName: "Wood"})
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].UnitPrice < rows[j].UnitPrice })
+	return rows, nil
+}
+
+func (f *fakeDB) ListMarketListingsBySeller(ctx context.Context, sellerID pgtype.UUID) ([]db.ListMarketListingsBySellerRow, error) {
+	var rows []db.ListMarketListingsBySellerRow
+	for id := f.nextID; id > 0; id-- {
+		if l, ok := f.listings[id]; ok && l.SellerID == sellerID {
+			rows = append(rows, db.ListMarketListingsBySellerRow{ID: l.ID, SellerID: l.SellerID, ItemID: l.ItemID, Quantity: l.Quantity, UnitPrice: l.UnitPrice})
+		}
+	}
+	return rows, nil
+}
+
+func (f *fakeDB) ListExpiredMarketListings(ctx context.Context, arg db.ListExpiredMarketListingsParams) ([]int64, error) {
+	var ids []int64
+	for id, l := range f.listings {
+		if !l.ExpiresAt.Time.After(arg.ExpiresAt.Time) {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func (f *fakeDB) GetMarketPriceHistory(ctx context.Context, arg db.GetMarketPriceHistoryParams) ([]db.GetMarketPriceHistoryRow, error) {
+	f.history = arg
+	return []db.GetMarketPriceHistoryRow{{Day: pgtype.Timestamp{Time: arg.Since.Time, Valid: true}, Sales: 2, Volume: 7, MinUnitPrice: 2, MaxUnitPrice: 4, AvgUnitPrice: 3}}, nil
+}
+
+func (f *fakeDB) CreateListing(ctx context.Context, listing db.CreateMarketListingParams, maxListings int64, fee ListingFee) (db.MarketListing, error) {
+	rows, _ := f.ListMarketListingsBySeller(ctx, listing.SellerID)
+	if int64(len(rows)) >= maxListings {
+		return db.MarketListing{}, ErrTooManyListings
+	}
+	if f.items[listing.SellerID][listing.ItemID] < listing.Quantity {
+		return db.MarketListing{}, ErrInsufficientItems
+	}
+	if f.items[listing.SellerID][fee.CurrencyItemID] < fee.Amount {
+		return db.MarketListing{}, ErrInsufficientFunds
+	}
+	f.items[listing.SellerID][listing.ItemID] -= listing.Quantity
+	f.items[listing.SellerID][fee.CurrencyItemID] -= fee.Amount
+	f.fees += fee.Amount
+	f.nextID++
+	created := db.MarketListing{
+		ID:        f.nextID,
+		WorldID:   listing.WorldID,
+		SellerID:  listing.SellerID,
+		ItemID:    listing.ItemID,
+		Quantity:  listing.Quantity,
+		UnitPrice: listing.UnitPrice,
+		CreatedAt: listing.CreatedAt,
+		ExpiresAt: listing.ExpiresAt,
+	}
+	f.listings[created.ID] = created
+	return created, nil
+}
+
+func (f *fakeDB) mail(recipient pgtype.UUID, itemID, quantity int32) {
+	if f.mailed[recipient] == nil {
+		f.mailed[recipient] = map[int32]int32{}
+	}
+	f.mailed[recipient][itemID] += quantity
+}
+
+func (f *fakeDB) BuyListing(ctx context.Context, arg BuyParams) (db.MarketListing, error) {
+	listing, ok := f.listings[arg.ListingID]
+	if !ok || listing.WorldID != arg.Buyer.WorldID || !listing.ExpiresAt.Time.After(arg.Now.Time) {
+		return db.MarketListing{}, pgx.ErrNoRows
+	}
+	if listing.SellerID == arg.Buyer.ID {
+		return db.MarketListing{}, ErrOwnListing
+	}
+	if arg.Approve != nil {
+		if err := arg.Approve(listing); err != nil {
+			return db.MarketListing{}, err
+		}
+	}
+	price := listing.UnitPrice * listing.Quantity
+	if f.items[arg.Buyer.ID][arg.CurrencyItem.ID] < price {
+		return db.MarketListing{}, ErrInsufficientFunds
+	}
+	if f.full[arg.Buyer.ID] {
+		return db.MarketListing{}, inventory.ErrInventoryFull
+	}
+	f.items[arg.Buyer.ID][arg.CurrencyItem.ID] -= price
+	f.items[arg.Buyer.ID][listing.ItemID] += listing.Quantity
+	tax := int32(economy.Tax(int64(price), arg.TaxPercent))
+	f.mail(listing.SellerID, arg.CurrencyItem.ID, price-tax)
+	f.taxes += tax
+	delete(f.listings, listing.ID)
+	f.sales = append(f.sales, db.CreateMarketSaleParams{WorldID: listing.WorldID, ItemID: listing.ItemID, Quantity: listing.Quantity, UnitPrice: listing.UnitPrice, SoldAt: arg.Now})
+	trade := saleTrade(int64(len(f.sales)), arg.Buyer, f.Characters[listing.SellerID])
+	payload, _ := json.Marshal(trade)
+	f.outbox = append(f.outbox, events.Event{Type: events.TradeCompleted, AggregateID: trade.TradeID, Payload: payload, DedupKey: events.TradeCompleted + ":" + trade.TradeID})
+	return listing, nil
+}
+
+func (f *fakeDB) ExpireListing(ctx context.Context, arg ExpireParams) (bool, error) {
+	listing, ok := f.listings[arg.ListingID]
+	if !ok || listing.ExpiresAt.Time.After(arg.Now.Time) {
+		return false, nil
+	}
+	f.mail(listing.SellerID, listing.ItemID, listing.Quantity)
+	delete(f.listings, listing.ID)
+	return true, nil
+}
+
+var (
+	aliceID  = "00000000-0000-0000-0000-00000000000a"
+	bobID    = "00000000-0000-0000-0000-00000000000b"
+	worldID  = pgtype.UUID{Bytes: [16]byte{15: 0xaa}, Valid: true}
+	aliceChr = pgtype.UUID{Bytes: [16]byte{0: 1, 15: 1}, Valid: true}
+	bobChr   = pgtype.UUID{Bytes: [16]byte{0: 1, 15: 2}, Valid: true}
+)
+
+func newTestService(t *testing.T) (*Service, *fakeDB, *clock.Fake) {
+	database := newFakeDB()
+	for chr, user := range map[pgtype.UUID]string{aliceChr: aliceID, bobChr: bobID} {
+		userID, err := uuid.StringToPgtype(user)
+		require.NoError(t, err)
+		database.Characters[chr] = db.Character{ID: chr, UserID: userID, WorldID: worldID}
+		database.items[chr] = map[int32]int32{}
+	}
+	service := NewService(database, testmocks.NopLogger[LoggerInterface]{})
+	// Fees and taxes are left out here and covered by TestCreateListingAndBuy_Sinks
+	config := DefaultConfig()
+	config.ListingFeePercent, config.SaleTaxPercent = 0, 0
+	service.SetConfig(config)
+	fake := clock.NewFake(time.Date(2025, 1, 1, 15, 0, 0, 0, time.UTC))
+	service.SetClock(fake)
+	return service, database, fake
+}
+
+func listRequest(quantity, unitPrice int32) *marketV1.CreateListingRequest {
+	return &marketV1.CreateListingRequest{
+		CharacterId: uuid.PgtypeToString(aliceChr),
+		ItemId:      woodID,
+		Quantity:    quantity,
+		UnitPrice:   unitPrice,
+	}
+}
+
+func TestCreateListingAndBuy(t *testing.T) {
+	service, database, fake := newTestService(t)
+	ctx := session.WithWorldID(context.Background(), uuid.PgtypeToString(worldID))
+	bob := uuid.PgtypeToString(bobChr)
+
+	_, err := service.CreateListing(ctx, aliceID, listRequest(5, 3))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "the seller must hold what is listed")
+
+	database.items[aliceChr][woodID] = 10
+	created, err := service.CreateListing(ctx, aliceID, listRequest(5, 3))
+	require.NoError(t, err)
+	listing := created.Listing
+	assert.Equal(t, int32(5), database.items[aliceChr][woodID], "listed items are held in escrow")
+	assert.Equal(t, int64(15), listing.TotalPrice)
+	assert.Equal(t, "Wood", listing.ItemName)
+	assert.Equal(t, fake.Now().Add(24*time.Hour), listing.ExpiresAt.AsTime())
+
+	_, err = service.Buy(ctx, aliceID, uuid.PgtypeToString(aliceChr), listing.Id)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "sellers cannot buy their own listings")
+
+	database.items[bobChr][mineralsID] = 10
+	_, err = service.Buy(ctx, bobID, bob, listing.Id)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	database.items[bobChr][mineralsID] = 20
+	database.full[bobChr] = true
+	_, err = service.Buy(ctx, bobID, bob, listing.Id)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, int32(20), database.items[bobChr][mineralsID], "nothing is paid for a failed buyout")
+	database.full[bobChr] = false
+
+	_, err = service.Buy(ctx, bobID, bob, listing.Id)
+	require.NoError(t, err)
+	assert.Equal(t, int32(5), database.items[bobChr][mineralsID])
+	assert.Equal(t, int32(5), database.items[bobChr][woodID])
+	assert.Equal(t, int32(15), database.mailed[aliceChr][mineralsID], "the seller is paid by mail")
+	require.Len(t, database.sales, 1)
+	assert.Equal(t, int32(3), database.sales[0].UnitPrice)
+
+	_, err = service.Buy(ctx, bobID, bob, listing.Id)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// tutorialDB keeps the tutorial steps of the market's characters in memory
+type tutorialDB struct {
+	market *fakeDB
+	steps  []db.TutorialStep
+}
+
+func (d *tutorialDB) GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error) {
+	return d.market.GetCharacterById(ctx, id)
+}
+
+func (d *tutorialDB) ListTutorialSteps(ctx context.Context, characterID pgtype.UUID) ([]db.TutorialStep, error) {
+	var steps []db.TutorialStep
+	for _, step := range d.steps {
+		if step.CharacterID == characterID {
+			steps = append(steps, step)
+		}
+	}
+	return steps, nil
+}
+
+func (d *tutorialDB) CompleteTutorialStep(ctx context.Context, arg db.CompleteTutorialStepParams) error {
+	d.steps = append(d.steps, db.TutorialStep(arg))
+	return nil
+}
+
+func TestBuy_CompletesTutorialTrade(t *testing.T) {
+	service, database, fake := newTestService(t)
+	ctx := session.WithWorldID(context.Background(), uuid.PgtypeToString(worldID))
+	steps := &tutorialDB{market: database}
+	tutorials := tutorial.NewService(steps, tutorial.NewDefaultLoggerWrapper())
+	bus := events.NewBus()
+	tutorials.Subscribe(bus)
+
+	// Both characters have moved, harvested and crafted
+	for _, chr := range []pgtype.UUID{aliceChr, bobChr} {
+		for _, step := range []string{"move", "harvest", "craft"} {
+			steps.steps = append(steps.steps, db.TutorialStep{CharacterID: chr, Step: step, CompletedAt: pgtype.Timestamp{Time: fake.Now(), Valid: true}})
+		}
+	}
+
+	database.items[aliceChr][woodID] = 5
+	created, err := service.CreateListing(ctx, aliceID, listRequest(5, 3))
+	require.NoError(t, err)
+	database.items[bobChr][mineralsID] = 15
+	_, err = service.Buy(ctx, bobID, uuid.PgtypeToString(bobChr), created.Listing.Id)
+	require.NoError(t, err)
+
+	// Deliver the enqueued events as the outbox dispatcher would
+	require.Len(t, database.outbox, 1)
+	assert.Equal(t, "market_sale:1", database.outbox[0].AggregateID)
+	for _, event := range database.outbox {
+		require.NoError(t, bus.Publish(ctx, event))
+	}
+
+	for _, chr := range []struct {
+		userID, characterID string
+	}{{aliceID, uuid.PgtypeToString(aliceChr)}, {bobID, uuid.PgtypeToString(bobChr)}} {
+		state, err := tutorials.State(ctx, chr.userID, chr.characterID)
+		require.NoError(t, err)
+		assert.True(t, state.Completed, "buyer and seller both complete the trade step")
+		assert.Equal(t, tutorialV1.TutorialStep_TUTORIAL_STEP_UNSPECIFIED, state.CurrentStep)
+	}
+}
+
+func TestCreateListingAndBuy_Sinks(t *testing.T) {
+	service, database, _ := newTestService(t)
+	service.SetConfig(DefaultConfig())
+	ctx := session.WithWorldID(context.Background(), uuid.PgtypeToString(worldID))
+	database.items[aliceChr][woodID] = 20
+
+	_, err := service.CreateListing(ctx, aliceID, listRequest(10, 25))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "the seller must afford the listing fee")
+	assert.Contains(t, err.Error(), "listing fee of 3")
+	assert.Equal(t, int32(20), database.items[aliceChr][woodID], "nothing is escrowed when the fee fails")
+
+	database.items[aliceChr][mineralsID] = 4
+	created, err := service.CreateListing(ctx, aliceID, listRequest(10, 25))
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), created.ListingFee, "1% of 250, rounded up")
+	assert.Equal(t, int32(1), database.items[aliceChr][mineralsID])
+	assert.Equal(t, int64(12), created.Listing.SaleTax, "5% of 250, rounded down")
+
+	database.items[bobChr][mineralsID] = 250
+	_, err = service.Buy(ctx, bobID, uuid.PgtypeToString(bobChr), created.Listing.Id)
+	require.NoError(t, err)
+	assert.Equal(t, int32(238), database.mailed[aliceChr][mineralsID], "the tax is withheld from the proceeds")
+	assert.Equal(t, int32(3), database.fees)
+	assert.Equal(t, int32(12), database.taxes)
+}
+
+// vetoScripts vetoes trades while veto is set and keeps published events
+type vetoScripts struct {
+	veto      bool
+	input     scripting.TradeInput
+	published []scripting.Event
+}
+
+func (v *vetoScripts) Trade(ctx context.Context, input scripting.TradeInput) scripting.Result {
+	v.input = input
+	return scripting.Result{Veto: v.veto, Reason: "embargo", Events: []scripting.Event{{Name: "sale"}}}
+}
+
+func (v *vetoScripts) Publish(ctx context.Context, hook, worldID string, spawned []scripting.Event) {
+	v.published = append(v.published, spawned...)
+}
+
+func TestBuy_Scripts(t *testing.T) {
+	service, database, _ := newTestService(t)
+	scripts := &vetoScripts{veto: true}
+	service.SetScripts(scripts)
+	ctx := session.WithWorldID(context.Background(), uuid.PgtypeToString(worldID))
+	bob := uuid.PgtypeToString(bobChr)
+	database.items[aliceChr][woodID] = 5
+	database.items[bobChr][mineralsID] = 15
+	created, err := service.CreateListing(ctx, aliceID, listRequest(5, 3))
+	require.NoError(t, err)
+	listing := created.Listing
+
+	_, err = service.Buy(ctx, bobID, bob, listing.Id)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, err.Error(), "embargo")
+	assert.Equal(t, int32(15), database.items[bobChr][mineralsID], "nothing is paid for a vetoed trade")
+	assert.Equal(t, "market_buyout", scripts.input.Kind)
+	assert.Equal(t, bob, scripts.input.BuyerID)
+	assert.Equal(t, int32(5), scripts.input.Quantity)
+	assert.Empty(t, scripts.published)
+
+	scripts.veto = false
+	_, err = service.Buy(ctx, bobID, bob, listing.Id)
+	require.NoError(t, err)
+	require.Len(t, scripts.published, 1)
+}
+
+func TestCreateListing_Validation(t *testing.T) {
+	service, database, fake := newTestService(t)
+	ctx := session.WithWorldID(context.Background(), uuid.PgtypeToString(worldID))
+	database.items[aliceChr][woodID] = 100
+	config := DefaultConfig()
+	config.MaxListings = 1
+	config.ListingFeePercent = 0
+	service.SetConfig(config)
+
+	_, err := service.CreateListing(ctx, bobID, listRequest(1, 1))
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = service.CreateListing(ctx, aliceID, listRequest(0, 1))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = service.CreateListing(ctx, aliceID, listRequest(2, 1<<30))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "the total price must fit")
+
+	long := listRequest(1, 1)
+	long.DurationSeconds = 1 << 62
+	created, err := service.CreateListing(ctx, aliceID, long)
+	require.NoError(t, err)
+	assert.Equal(t, fake.Now().Add(config.MaxDuration), created.Listing.ExpiresAt.AsTime(), "durations are capped")
+
+	_, err = service.CreateListing(ctx, aliceID, listRequest(1, 1))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "the listing limit applies")
+}
+
+func TestSearch(t *testing.T) {
+	service, database, _ := newTestService(t)
+	database.items[aliceChr][woodID] = 10
+	for _, price := range []int32{4, 2} {
+		_, err := service.CreateListing(session.WithWorldID(context.Background(), uuid.PgtypeToString(worldID)), aliceID, listRequest(1, price))
+		require.NoError(t, err)
+	}
+
+	_, err := service.Search(context.Background(), &marketV1.SearchListingsRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "a world is required without a session world")
+
+	ctx := session.WithWorldID(context.Background(), uuid.PgtypeToString(worldID))
+	listings, err := service.Search(ctx, &marketV1.SearchListingsRequest{
+		Filter:         &marketV1.ListingFilter{NamePrefix: "50%_w"},
+		AfterUnitPrice: 1,
+		AfterId:        9,
+		Limit:          1000,
+	})
+	require.NoError(t, err)
+	require.Len(t, listings, 2)
+	assert.Equal(t, int32(2), listings[0].UnitPrice, "cheapest first")
+	assert.Equal(t, `50\%\_w`, database.search.NamePrefix, "LIKE wildcards match literally")
+	assert.Equal(t, int32(MaxSearchLimit), database.search.MaxListings)
+	assert.Equal(t, int32(1), database.search.AfterUnitPrice)
+	assert.Equal(t, int64(9), database.search.AfterID)
+}
+
+func TestPriceHistory(t *testing.T) {
+	service, database, _ := newTestService(t)
+	ctx := session.WithWorldID(context.Background(), uuid.PgtypeToString(worldID))
+
+	points, err := service.PriceHistory(ctx, "", woodID, 7)
+	require.NoError(t, err)
+	require.Len(t, points, 1)
+	assert.Equal(t, int32(3), points[0].AvgUnitPrice)
+	assert.Equal(t, time.Date(2024, 12, 26, 0, 0, 0, 0, time.UTC), database.history.Since.Time, "seven whole days including today")
+
+	_, err = service.PriceHistory(ctx, "", woodID, 1000)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, -(MaxHistoryDays-1)), database.history.Since.Time)
+}
+
+func TestExpire(t *testing.T) {
+	service, database, fake := newTestService(t)
+	ctx := session.WithWorldID(context.Background(), uuid.PgtypeToString(worldID))
+	database.items[aliceChr][woodID] = 10
+
+	short := listRequest(4, 1)
+	short.DurationSeconds = 3600
+	_, err := service.CreateListing(ctx, aliceID, short)
+	require.NoError(t, err)
+	_, err = service.CreateListing(ctx, aliceID, listRequest(1, 1))
+	require.NoError(t, err)
+
+	expired, err := service.Expire(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, expired)
+
+	fake.Advance(time.Hour)
+	expired, err = service.Expire(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, expired)
+	assert.Equal(t, int32(4), database.mailed[aliceChr][woodID], "unsold items go back by mail")
+	assert.Len(t, database.listings, 1)
+}
@@ -0,0 +1,211 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/alerting"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	notificationV1 "github.com/VoidMesh/api/api/proto/notification/v1"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	MaxAnnouncementLength = 500
+
+	// DefaultAnnouncementPollInterval is how often announcements made on other instances are picked up
+	DefaultAnnouncementPollInterval = 10 * time.Second
+)
+
+// severityNames maps announcement severities to their stored names
+var severityNames = map[notificationV1.AnnouncementSeverity]string{
+	notificationV1.AnnouncementSeverity_ANNOUNCEMENT_SEVERITY_INFO:     "info",
+	notificationV1.AnnouncementSeverity_ANNOUNCEMENT_SEVERITY_WARNING:  "warning",
+	notificationV1.AnnouncementSeverity_ANNOUNCEMENT_SEVERITY_CRITICAL: "critical",
+}
+
+// Announce stores an announcement and pushes it to every open stream on this instance.
+// Other instances push it when they next poll. An empty createdBy stores an announcement
+// made by the server itself.
+func (s *Service) Announce(ctx context.Context, createdBy, message string, severity notificationV1.AnnouncementSeverity, expiresAt *timestamppb.Timestamp) (*notificationV1.Announcement, error) {
+	message = strings.TrimSpace(message)
+	if message == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "message is required")
+	}
+	if utf8.RuneCountInString(message) > MaxAnnouncementLength {
+		return nil, status.Errorf(codes.InvalidArgument, "message must be at most %d characters", MaxAnnouncementLength)
+	}
+	if severity == notificationV1.AnnouncementSeverity_ANNOUNCEMENT_SEVERITY_UNSPECIFIED {
+		severity = notificationV1.AnnouncementSeverity_ANNOUNCEMENT_SEVERITY_INFO
+	}
+	severityName, ok := severityNames[severity]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown severity")
+	}
+	now := s.clock.Now()
+	var expires pgtype.Timestamp
+	if expiresAt != nil {
+		if !expiresAt.AsTime().After(now) {
+			return nil, status.Errorf(codes.InvalidArgument, "expires_at must be in the future")
+		}
+		expires = pgtype.Timestamp{Time: expiresAt.AsTime(), Valid: true}
+	}
+	var createdByID pgtype.UUID
+	if createdBy != "" {
+		var err error
+		if createdByID, err = uuid.StringToPgtype(createdBy); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid user ID format")
+		}
+	}
+
+	row, err := s.db.CreateAnnouncement(ctx, db.CreateAnnouncementParams{
+		Message:   message,
+		Severity:  severityName,
+		CreatedBy: createdByID,
+		CreatedAt: pgtype.Timestamp{Time: now, Valid: true},
+		ExpiresAt: expires,
+	})
+	if err != nil {
+		s.logger.Error("Failed to store announcement", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to store announcement")
+	}
+
+	s.announceMu.Lock()
+	s.announced[row.ID] = struct{}{}
+	s.announceMu.Unlock()
+	delivered := s.streams.Broadcast(announcementNotification(row))
+	s.logger.Info("Announcement broadcast", "announcement_id", row.ID, "severity", row.Severity, "streams", delivered, "created_by", createdBy)
+	return dbAnnouncementToProto(row), nil
+}
+
+// ListAnnouncements returns the announcements that have not expired, oldest first
+func (s *Service) ListAnnouncements(ctx context.Context) ([]*notificationV1.Announcement, error) {
+	rows, err := s.activeAnnouncements(ctx, 0)
+	if err != nil {
+		s.logger.Error("Failed to list announcements", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list announcements")
+	}
+	announcements := make([]*notificationV1.Announcement, 0, len(rows))
+	for _, row := range rows {
+		announcements = append(announcements, dbAnnouncementToProto(row))
+	}
+	return announcements, nil
+}
+
+// AnnouncementNotifications returns the active announcements as stream notifications, for
+// streams that have just opened
+func (s *Service) AnnouncementNotifications(ctx context.Context) ([]*notificationV1.Notification, error) {
+	rows, err := s.activeAnnouncements(ctx, 0)
+	if err != nil {
+		s.logger.Error("Failed to list announcements", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list announcements")
+	}
+	notifications := make([]*notificationV1.Notification, 0, len(rows))
+	for _, row := range rows {
+		notifications = append(notifications, announcementNotification(row))
+	}
+	return notifications, nil
+}
+
+func (s *Service) activeAnnouncements(ctx context.Context, afterID int64) ([]db.Announcement, error) {
+	return s.db.ListActiveAnnouncements(ctx, db.ListActiveAnnouncementsParams{
+		AfterID: afterID,
+		Now:     pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
+	})
+}
+
+// RunAnnouncements pushes announcements made on other instances to this instance's
+// streams until the context is cancelled. Announcements made before it started are not
+// pushed; streams get them when they open.
+func (s *Service) RunAnnouncements(ctx context.Context) {
+	s.logger.Info("Announcement polling started", "interval", s.pollInterval)
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("Announcement polling stopped")
+			return
+		case <-s.clock.After(s.pollInterval):
+		}
+
+		if err := s.PollAnnouncements(ctx); err != nil {
+			s.logger.Error("Announcement polling failed", "error", err)
+			alerting.ReportJobError("announcement_poll", err)
+		}
+	}
+}
+
+// PollAnnouncements pushes announcements stored since the last poll that this instance
+// has not pushed yet. The first poll only records where to start.
+func (s *Service) PollAnnouncements(ctx context.Context) error {
+	s.announceMu.Lock()
+	defer s.announceMu.Unlock()
+
+	if !s.polled {
+		latest, err := s.db.GetLatestAnnouncementID(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get latest announcement: %w", err)
+		}
+		s.pollID = latest
+		s.polled = true
+		return nil
+	}
+
+	rows, err := s.activeAnnouncements(ctx, s.pollID)
+	if err != nil {
+		return fmt.Errorf("failed to list announcements: %w", err)
+	}
+	for _, row := range rows {
+		if _, ok := s.announced[row.ID]; !ok {
+			s.streams.Broadcast(announcementNotification(row))
+		}
+		s.pollID = max(s.pollID, row.ID)
+	}
+	for id := range s.announced {
+		if id <= s.pollID {
+			delete(s.announced, id)
+		}
+	}
+	return nil
+}
+
+func announcementNotification(row db.Announcement) *notificationV1.Notification {
+	data := map[string]string{
+		"announcement_id": strconv.FormatInt(row.ID, 10),
+		"severity":        row.Severity,
+	}
+	if row.ExpiresAt.Valid {
+		data["expires_at"] = row.ExpiresAt.Time.UTC().Format(time.RFC3339)
+	}
+	return &notificationV1.Notification{
+		Type:      notificationV1.NotificationType_NOTIFICATION_TYPE_ANNOUNCEMENT,
+		Title:     "Announcement",
+		Body:      row.Message,
+		Data:      data,
+		CreatedAt: timestamppb.New(row.CreatedAt.Time),
+	}
+}
+
+func dbAnnouncementToProto(row db.Announcement) *notificationV1.Announcement {
+	announcement := &notificationV1.Announcement{
+		Id:        row.ID,
+		Message:   row.Message,
+		CreatedAt: timestamppb.New(row.CreatedAt.Time),
+	}
+	for severity, name := range severityNames {
+		if name == row.Severity {
+			announcement.Severity = severity
+		}
+	}
+	if row.ExpiresAt.Valid {
+		announcement.ExpiresAt = timestamppb.New(row.ExpiresAt.Time)
+	}
+	return announcement
+}
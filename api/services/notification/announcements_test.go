@@ -0,0 +1,109 @@
+package notification
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	notificationV1 "github.com/VoidMesh/api/api/proto/notification/v1"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestAnnounce(t *testing.T) {
+	service, _ := newTestService()
+	fake := clock.NewFake(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	service.SetClock(fake)
+	ctx := context.Background()
+
+	alice, unsubscribeAlice, err := service.SubscribeNotifications(ctx, aliceID)
+	require.NoError(t, err)
+	defer unsubscribeAlice()
+	bob, unsubscribeBob, err := service.SubscribeNotifications(ctx, bobID)
+	require.NoError(t, err)
+	defer unsubscribeBob()
+
+	for _, tt := range []struct {
+		message   string
+		severity  notificationV1.AnnouncementSeverity
+		expiresAt *timestamppb.Timestamp
+	}{
+		{message: "  "},
+		{message: strings.Repeat("a", MaxAnnouncementLength+1)},
+		{message: "Hi", severity: notificationV1.AnnouncementSeverity(42)},
+		{message: "Hi", expiresAt: timestamppb.New(fake.Now())},
+	} {
+		_, err := service.Announce(ctx, aliceID, tt.message, tt.severity, tt.expiresAt)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+
+	announcement, err := service.Announce(ctx, aliceID, "Double harvest this weekend!", 0, timestamppb.New(fake.Now().Add(time.Hour)))
+	require.NoError(t, err)
+	assert.Equal(t, notificationV1.AnnouncementSeverity_ANNOUNCEMENT_SEVERITY_INFO, announcement.Severity, "severity defaults to info")
+
+	for _, stream := range []<-chan *notificationV1.Notification{alice, bob} {
+		select {
+		case streamed := <-stream:
+			assert.Equal(t, notificationV1.NotificationType_NOTIFICATION_TYPE_ANNOUNCEMENT, streamed.Type)
+			assert.Equal(t, "Double harvest this weekend!", streamed.Body)
+			assert.Equal(t, "1", streamed.Data["announcement_id"])
+			assert.Equal(t, "info", streamed.Data["severity"])
+		case <-time.After(time.Second):
+			t.Fatal("announcement was not streamed to every user")
+		}
+	}
+
+	_, err = service.Announce(ctx, aliceID, "Welcome!", notificationV1.AnnouncementSeverity_ANNOUNCEMENT_SEVERITY_WARNING, nil)
+	require.NoError(t, err)
+	listed, err := service.ListAnnouncements(ctx)
+	require.NoError(t, err)
+	require.Len(t, listed, 2)
+	assert.Equal(t, notificationV1.AnnouncementSeverity_ANNOUNCEMENT_SEVERITY_WARNING, listed[1].Severity)
+	assert.Nil(t, listed[1].ExpiresAt)
+
+	fake.Advance(time.Hour)
+	notifications, err := service.AnnouncementNotifications(ctx)
+	require.NoError(t, err)
+	require.Len(t, notifications, 1, "expired announcements are no longer shown")
+	assert.Equal(t, "Welcome!", notifications[0].Body)
+}
+
+func TestPollAnnouncements(t *testing.T) {
+	service, database := newTestService()
+	ctx := context.Background()
+	stored := func(message string) {
+		database.announcements = append(database.announcements, db.Announcement{
+			ID:        int64(len(database.announcements) + 1),
+			Message:   message,
+			Severity:  "info",
+			CreatedAt: pgtype.Timestamp{Time: time.Now(), Valid: true},
+		})
+	}
+
+	notifications, unsubscribe, err := service.SubscribeNotifications(ctx, bobID)
+	require.NoError(t, err)
+	defer unsubscribe()
+
+	stored("before start")
+	require.NoError(t, service.PollAnnouncements(ctx))
+	assert.Empty(t, notifications, "the first poll only records where to start")
+
+	stored("from another instance")
+	_, err = service.Announce(ctx, aliceID, "from this instance", 0, nil)
+	require.NoError(t, err)
+	require.NoError(t, service.PollAnnouncements(ctx))
+	require.NoError(t, service.PollAnnouncements(ctx))
+
+	var bodies []string
+	for len(notifications) > 0 {
+		bodies = append(bodies, (<-notifications).Body)
+	}
+	assert.Equal(t, []string{"from this instance", "from another instance"}, bodies, "each announcement is pushed once")
+}
@@ -0,0 +1,205 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	notificationV1 "github.com/VoidMesh/api/api/proto/notification/v1"
+	"github.com/jackc/pgx/v5"
+)
+
+// Subscribe registers the handlers that turn domain events into notifications. Redeliveries
+// older than the dedup window are still caught by the notifications.dedup_key constraint.
+func (s *Service) Subscribe(bus *events.Bus) {
+	bus.Subscribe(events.FriendRequestSent, events.Dedup(s.handleFriendRequestSent, events.DefaultDedupCapacity))
+	bus.Subscribe(events.FriendRequestAccepted, events.Dedup(s.handleFriendRequestAccepted, events.DefaultDedupCapacity))
+	bus.Subscribe(events.TradeCompleted, events.Dedup(s.handleTradeCompleted, events.DefaultDedupCapacity))
+	bus.Subscribe(events.QuestCompleted, events.Dedup(s.handleQuestCompleted, events.DefaultDedupCapacity))
+	bus.Subscribe(events.LandClaimExpired, events.Dedup(s.handleLandClaimExpired, events.DefaultDedupCapacity))
+	bus.Subscribe(events.ProcessingCompleted, events.Dedup(s.handleProcessingCompleted, events.DefaultDedupCapacity))
+	bus.Subscribe(events.AchievementUnlocked, events.Dedup(s.handleAchievementUnlocked, events.DefaultDedupCapacity))
+	bus.Subscribe(events.UserNewDeviceLogin, events.Dedup(s.handleUserNewDeviceLogin, events.DefaultDedupCapacity))
+}
+
+func (s *Service) handleFriendRequestSent(ctx context.Context, event events.Event) error {
+	var payload events.FriendRequestPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("invalid %s payload: %w", event.Type, err)
+	}
+	requester, err := s.username(ctx, payload.RequesterID)
+	if err != nil {
+		return err
+	}
+	_, err = s.Notify(ctx, Notification{
+		UserID:   payload.AddresseeID,
+		Type:     notificationV1.NotificationType_NOTIFICATION_TYPE_FRIEND_REQUEST,
+		Title:    "New friend request",
+		Body:     fmt.Sprintf("%s wants to be your friend", requester),
+		Data:     map[string]string{"user_id": payload.RequesterID},
+		DedupKey: event.DedupKey,
+	})
+	return err
+}
+
+func (s *Service) handleFriendRequestAccepted(ctx context.Context, event events.Event) error {
+	var payload events.FriendRequestPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("invalid %s payload: %w", event.Type, err)
+	}
+	addressee, err := s.username(ctx, payload.AddresseeID)
+	if err != nil {
+		return err
+	}
+	_, err = s.Notify(ctx, Notification{
+		UserID:   payload.RequesterID,
+		Type:     notificationV1.NotificationType_NOTIFICATION_TYPE_FRIEND_ACCEPTED,
+		Title:    "Friend request accepted",
+		Body:     fmt.Sprintf("%s accepted your friend request", addressee),
+		Data:     map[string]string{"user_id": payload.AddresseeID},
+		DedupKey: event.DedupKey,
+	})
+	return err
+}
+
+func (s *Service) handleTradeCompleted(ctx context.Context, event events.Event) error {
+	var payload events.TradeCompletedPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("invalid %s payload: %w", event.Type, err)
+	}
+	var errs []error
+	for _, userID := range payload.UserIDs {
+		_, err := s.Notify(ctx, Notification{
+			UserID:   userID,
+			Type:     notificationV1.NotificationType_NOTIFICATION_TYPE_TRADE_COMPLETED,
+			Title:    "Trade completed",
+			Body:     "Your trade went through",
+			Data:     map[string]string{"trade_id": payload.TradeID},
+			DedupKey: event.DedupKey + ":" + uuid.Normalize(userID),
+		})
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+func (s *Service) handleQuestCompleted(ctx context.Context, event events.Event) error {
+	var payload events.QuestCompletedPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("invalid %s payload: %w", event.Type, err)
+	}
+	_, err := s.Notify(ctx, Notification{
+		UserID:   payload.UserID,
+		Type:     notificationV1.NotificationType_NOTIFICATION_TYPE_QUEST_COMPLETED,
+		Title:    "Quest complete",
+		Body:     fmt.Sprintf("You completed %s", payload.QuestName),
+		Data:     map[string]string{"quest_id": payload.QuestID, "character_id": payload.CharacterID},
+		DedupKey: event.DedupKey,
+	})
+	return err
+}
+
+func (s *Service) handleLandClaimExpired(ctx context.Context, event events.Event) error {
+	var payload events.LandClaimExpiredPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("invalid %s payload: %w", event.Type, err)
+	}
+	_, err := s.Notify(ctx, Notification{
+		UserID: payload.UserID,
+		Type:   notificationV1.NotificationType_NOTIFICATION_TYPE_LAND_CLAIM_EXPIRED,
+		Title:  "Land claim lost",
+		Body:   fmt.Sprintf("Your claim on chunk (%d, %d) was released because its upkeep could not be paid", payload.ChunkX, payload.ChunkY),
+		Data: map[string]string{
+			"claim_id":     strconv.FormatInt(payload.ClaimID, 10),
+			"character_id": payload.CharacterID,
+		},
+		DedupKey: event.DedupKey,
+	})
+	return err
+}
+
+func (s *Service) handleProcessingCompleted(ctx context.Context, event events.Event) error {
+	var payload events.ProcessingCompletedPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("invalid %s payload: %w", event.Type, err)
+	}
+	_, err := s.Notify(ctx, Notification{
+		UserID: payload.UserID,
+		Type:   notificationV1.NotificationType_NOTIFICATION_TYPE_PROCESSING_COMPLETE,
+		Title:  "Processing complete",
+		Body:   fmt.Sprintf("Your %s is ready to collect", payload.RecipeName),
+		Data: map[string]string{
+			"job_id":       strconv.FormatInt(payload.JobID, 10),
+			"character_id": payload.CharacterID,
+			"recipe_id":    payload.RecipeID,
+		},
+		DedupKey: event.DedupKey,
+	})
+	return err
+}
+
+func (s *Service) handleAchievementUnlocked(ctx context.Context, event events.Event) error {
+	var payload events.AchievementUnlockedPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("invalid %s payload: %w", event.Type, err)
+	}
+	_, err := s.Notify(ctx, Notification{
+		UserID: payload.UserID,
+		Type:   notificationV1.NotificationType_NOTIFICATION_TYPE_ACHIEVEMENT_UNLOCKED,
+		Title:  "Achievement unlocked",
+		Body:   fmt.Sprintf("You earned %s. Claim your reward!", payload.AchievementName),
+		Data: map[string]string{
+			"achievement_id": payload.AchievementID,
+			"character_id":   payload.CharacterID,
+		},
+		DedupKey: event.DedupKey,
+	})
+	return err
+}
+
+func (s *Service) handleUserNewDeviceLogin(ctx context.Context, event events.Event) error {
+	var payload events.UserNewDeviceLoginPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("invalid %s payload: %w", event.Type, err)
+	}
+	from := "an unknown address"
+	if payload.IPAddress != "" {
+		from = payload.IPAddress
+	}
+	_, err := s.Notify(ctx, Notification{
+		UserID: payload.UserID,
+		Type:   notificationV1.NotificationType_NOTIFICATION_TYPE_NEW_DEVICE_LOGIN,
+		Title:  "New login",
+		Body:   fmt.Sprintf("Your account was logged in to from a new device at %s. If this wasn't you, sign the session out and change your password.", from),
+		Data: map[string]string{
+			"session_id": payload.SessionID,
+			"ip_address": payload.IPAddress,
+			"user_agent": payload.UserAgent,
+		},
+		DedupKey: event.DedupKey,
+	})
+	return err
+}
+
+// username looks up a user's display name for notification text. A deleted user is not
+// an error: the event is obsolete and redelivering it would never succeed.
+func (s *Service) username(ctx context.Context, userID string) (string, error) {
+	id, err := uuid.StringToPgtype(userID)
+	if err != nil {
+		return "", fmt.Errorf("invalid user ID %q: %w", userID, err)
+	}
+	user, err := s.db.GetUserById(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "Someone", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get user: %w", err)
+	}
+	if user.DisplayName != "" {
+		return user.DisplayName, nil
+	}
+	return user.Username, nil
+}
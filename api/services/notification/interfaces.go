@@ -0,0 +1,110 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/charmbracelet/log"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DatabaseInterface abstracts database operations for notifications.
+type DatabaseInterface interface {
+	GetUserById(ctx context.Context, id pgtype.UUID) (db.User, error)
+	CreateNotification(ctx context.Context, arg db.CreateNotificationParams) (db.Notification, error)
+	ListNotifications(ctx context.Context, arg db.ListNotificationsParams) ([]db.Notification, error)
+	CountUnreadNotifications(ctx context.Context, userID pgtype.UUID) (int64, error)
+	MarkNotificationsRead(ctx context.Context, arg db.MarkNotificationsReadParams) (int64, error)
+	MarkAllNotificationsRead(ctx context.Context, arg db.MarkAllNotificationsReadParams) (int64, error)
+	CreateAnnouncement(ctx context.Context, arg db.CreateAnnouncementParams) (db.Announcement, error)
+	ListActiveAnnouncements(ctx context.Context, arg db.ListActiveAnnouncementsParams) ([]db.Announcement, error)
+	GetLatestAnnouncementID(ctx context.Context) (int64, error)
+}
+
+// DatabaseWrapper implements DatabaseInterface using the actual database connection.
+type DatabaseWrapper struct {
+	queries *db.Queries
+}
+
+// NewDatabaseWrapper creates a new database wrapper with the given connection pool.
+func NewDatabaseWrapper(pool storage.Pool) DatabaseInterface {
+	return &DatabaseWrapper{
+		queries: db.New(pool),
+	}
+}
+
+func (d *DatabaseWrapper) GetUserById(ctx context.Context, id pgtype.UUID) (db.User, error) {
+	return d.queries.GetUserById(ctx, id)
+}
+
+func (d *DatabaseWrapper) CreateNotification(ctx context.Context, arg db.CreateNotificationParams) (db.Notification, error) {
+	return d.queries.CreateNotification(ctx, arg)
+}
+
+func (d *DatabaseWrapper) ListNotifications(ctx context.Context, arg db.ListNotificationsParams) ([]db.Notification, error) {
+	return d.queries.ListNotifications(ctx, arg)
+}
+
+func (d *DatabaseWrapper) CountUnreadNotifications(ctx context.Context, userID pgtype.UUID) (int64, error) {
+	return d.queries.CountUnreadNotifications(ctx, userID)
+}
+
+func (d *DatabaseWrapper) MarkNotificationsRead(ctx context.Context, arg db.MarkNotificationsReadParams) (int64, error) {
+	return d.queries.MarkNotificationsRead(ctx, arg)
+}
+
+func (d *DatabaseWrapper) MarkAllNotificationsRead(ctx context.Context, arg db.MarkAllNotificationsReadParams) (int64, error) {
+	return d.queries.MarkAllNotificationsRead(ctx, arg)
+}
+
+func (d *DatabaseWrapper) CreateAnnouncement(ctx context.Context, arg db.CreateAnnouncementParams) (db.Announcement, error) {
+	return d.queries.CreateAnnouncement(ctx, arg)
+}
+
+func (d *DatabaseWrapper) ListActiveAnnouncements(ctx context.Context, arg db.ListActiveAnnouncementsParams) ([]db.Announcement, error) {
+	return d.queries.ListActiveAnnouncements(ctx, arg)
+}
+
+func (d *DatabaseWrapper) GetLatestAnnouncementID(ctx context.Context) (int64, error) {
+	return d.queries.GetLatestAnnouncementID(ctx)
+}
+
+// LoggerInterface abstracts logging operations for dependency injection.
+type LoggerInterface interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+	With(keysAndValues ...interface{}) LoggerInterface
+}
+
+// DefaultLoggerWrapper wraps the internal logging package.
+type DefaultLoggerWrapper struct {
+	logger *log.Logger
+}
+
+func NewDefaultLoggerWrapper() LoggerInterface {
+	return &DefaultLoggerWrapper{logger: logging.GetLogger()}
+}
+
+func (l *DefaultLoggerWrapper) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.Debug(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Info(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.Warn(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.Error(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) With(keysAndValues ...interface{}) LoggerInterface {
+	return &DefaultLoggerWrapper{logger: l.logger.With(keysAndValues...)}
+}
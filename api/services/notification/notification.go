@@ -0,0 +1,260 @@
+// Package notification stores server-generated notifications in a per-user inbox and
+// pushes them to the user's open notification streams.
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/userstream"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	notificationV1 "github.com/VoidMesh/api/api/proto/notification/v1"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	DefaultListLimit = 50
+	MaxListLimit     = 200
+
+	// streamBufferSize bounds notifications queued per stream; missed ones stay in the inbox
+	streamBufferSize = 32
+)
+
+// typeNames maps notification types to their stored names
+var typeNames = map[notificationV1.NotificationType]string{
+	notificationV1.NotificationType_NOTIFICATION_TYPE_FRIEND_REQUEST:       "friend_request",
+	notificationV1.NotificationType_NOTIFICATION_TYPE_FRIEND_ACCEPTED:      "friend_accepted",
+	notificationV1.NotificationType_NOTIFICATION_TYPE_TRADE_COMPLETED:      "trade_completed",
+	notificationV1.NotificationType_NOTIFICATION_TYPE_QUEST_COMPLETED:      "quest_completed",
+	notificationV1.NotificationType_NOTIFICATION_TYPE_LAND_CLAIM_EXPIRED:   "land_claim_expired",
+	notificationV1.NotificationType_NOTIFICATION_TYPE_PROCESSING_COMPLETE:  "processing_complete",
+	notificationV1.NotificationType_NOTIFICATION_TYPE_ACHIEVEMENT_UNLOCKED: "achievement_unlocked",
+	notificationV1.NotificationType_NOTIFICATION_TYPE_NEW_DEVICE_LOGIN:     "new_device_login",
+}
+
+// Notification is a notification to create
+type Notification struct {
+	UserID   string
+	Type     notificationV1.NotificationType
+	Title    string
+	Body     string
+	Data     map[string]string
+	DedupKey string // Notifications with a key that was already used are skipped
+}
+
+// Service stores notifications and streams them to their users.
+type Service struct {
+	db           DatabaseInterface
+	logger       LoggerInterface
+	clock        clock.Clock
+	streams      *userstream.Hub[*notificationV1.Notification]
+	pollInterval time.Duration
+
+	// Announcement polling: the last announcement ID seen by a poll, and the IDs pushed by
+	// Announce since then
+	announceMu sync.Mutex
+	pollID     int64
+	polled     bool
+	announced  map[int64]struct{}
+}
+
+// NewService creates a new notification service with dependency injection.
+func NewService(db DatabaseInterface, logger LoggerInterface) *Service {
+	componentLogger := logger.With("component", "notification-service")
+	componentLogger.Debug("Creating new notification service")
+	return &Service{
+		db:           db,
+		logger:       componentLogger,
+		clock:        clock.New(),
+		streams:      userstream.NewHub[*notificationV1.Notification](),
+		pollInterval: DefaultAnnouncementPollInterval,
+		announced:    make(map[int64]struct{}),
+	}
+}
+
+// NewServiceWithPool creates a service with concrete implementations (convenience constructor for production use).
+func NewServiceWithPool(pool storage.Pool) *Service {
+	return NewService(NewDatabaseWrapper(pool), NewDefaultLoggerWrapper())
+}
+
+// SetClock replaces the clock used for timestamps (for simulation tests)
+func (s *Service) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// Streams returns the number of open notification streams on this instance
+func (s *Service) Streams() int {
+	return s.streams.Len()
+}
+
+// Notify stores a notification and pushes it to the user's open streams. It returns nil
+// without error when the dedup key was already used.
+func (s *Service) Notify(ctx context.Context, n Notification) (*notificationV1.Notification, error) {
+	userID, err := uuid.StringToPgtype(n.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID %q: %w", n.UserID, err)
+	}
+	typeName, ok := typeNames[n.Type]
+	if !ok {
+		return nil, fmt.Errorf("unknown notification type %v", n.Type)
+	}
+	if n.Data == nil {
+		n.Data = map[string]string{}
+	}
+	data, err := json.Marshal(n.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode notification data: %w", err)
+	}
+
+	row, err := s.db.CreateNotification(ctx, db.CreateNotificationParams{
+		UserID:    userID,
+		Type:      typeName,
+		Title:     n.Title,
+		Body:      n.Body,
+		Data:      data,
+		DedupKey:  n.DedupKey,
+		CreatedAt: pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to store notification: %w", err)
+	}
+
+	notification, err := dbNotificationToProto(row)
+	if err != nil {
+		return nil, err
+	}
+	s.streams.Publish(n.UserID, notification)
+	return notification, nil
+}
+
+// Broadcast pushes a notification to every open stream on this instance without storing
+// it, for announcements that only matter while they are current. UserID and DedupKey are
+// ignored. It returns how many streams accepted it.
+func (s *Service) Broadcast(n Notification) int {
+	return s.streams.Broadcast(&notificationV1.Notification{
+		Type:      n.Type,
+		Title:     n.Title,
+		Body:      n.Body,
+		Data:      n.Data,
+		CreatedAt: timestamppb.New(s.clock.Now()),
+	})
+}
+
+// SubscribeNotifications streams notifications created for the user from now on. The
+// returned function ends the subscription and closes the channel.
+func (s *Service) SubscribeNotifications(ctx context.Context, userID string) (<-chan *notificationV1.Notification, func(), error) {
+	if !uuid.ValidateFormat(userID) {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "invalid user ID format")
+	}
+	sub := s.streams.Subscribe(userID, streamBufferSize)
+	return sub.C, func() { s.streams.Unsubscribe(sub) }, nil
+}
+
+// ListNotifications returns the user's notifications before beforeID (0 for the newest),
+// newest first, with their unread count
+func (s *Service) ListNotifications(ctx context.Context, userID string, beforeID int64, limit int32, unreadOnly bool) ([]*notificationV1.Notification, int64, error) {
+	id, err := uuid.StringToPgtype(userID)
+	if err != nil {
+		return nil, 0, status.Errorf(codes.InvalidArgument, "invalid user ID format")
+	}
+	if beforeID < 0 || limit < 0 {
+		return nil, 0, status.Errorf(codes.InvalidArgument, "before_id and limit must not be negative")
+	}
+	if beforeID == 0 {
+		beforeID = math.MaxInt64
+	}
+	if limit == 0 {
+		limit = DefaultListLimit
+	}
+	if limit > MaxListLimit {
+		limit = MaxListLimit
+	}
+
+	rows, err := s.db.ListNotifications(ctx, db.ListNotificationsParams{
+		UserID:           id,
+		BeforeID:         beforeID,
+		UnreadOnly:       unreadOnly,
+		MaxNotifications: limit,
+	})
+	if err != nil {
+		s.logger.Error("Failed to list notifications", "user_id", userID, "error", err)
+		return nil, 0, status.Errorf(codes.Internal, "failed to list notifications")
+	}
+	unread, err := s.db.CountUnreadNotifications(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to count unread notifications", "user_id", userID, "error", err)
+		return nil, 0, status.Errorf(codes.Internal, "failed to list notifications")
+	}
+
+	notifications := make([]*notificationV1.Notification, 0, len(rows))
+	for _, row := range rows {
+		notification, err := dbNotificationToProto(row)
+		if err != nil {
+			s.logger.Error("Failed to decode notification", "notification_id", row.ID, "error", err)
+			return nil, 0, status.Errorf(codes.Internal, "failed to decode notification")
+		}
+		notifications = append(notifications, notification)
+	}
+	return notifications, unread, nil
+}
+
+// MarkRead marks the user's notifications read, or all of them when all is set, and
+// returns how many changed. IDs of other users' notifications are ignored.
+func (s *Service) MarkRead(ctx context.Context, userID string, ids []int64, all bool) (int64, error) {
+	id, err := uuid.StringToPgtype(userID)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid user ID format")
+	}
+	readAt := pgtype.Timestamp{Time: s.clock.Now(), Valid: true}
+
+	var marked int64
+	switch {
+	case all:
+		marked, err = s.db.MarkAllNotificationsRead(ctx, db.MarkAllNotificationsReadParams{UserID: id, ReadAt: readAt})
+	case len(ids) == 0:
+		return 0, status.Errorf(codes.InvalidArgument, "notification_ids or all is required")
+	default:
+		marked, err = s.db.MarkNotificationsRead(ctx, db.MarkNotificationsReadParams{ReadAt: readAt, UserID: id, Ids: ids})
+	}
+	if err != nil {
+		s.logger.Error("Failed to mark notifications read", "user_id", userID, "error", err)
+		return 0, status.Errorf(codes.Internal, "failed to mark notifications read")
+	}
+	return marked, nil
+}
+
+func dbNotificationToProto(row db.Notification) (*notificationV1.Notification, error) {
+	var data map[string]string
+	if err := json.Unmarshal(row.Data, &data); err != nil {
+		return nil, fmt.Errorf("failed to decode notification data: %w", err)
+	}
+	notification := &notificationV1.Notification{
+		Id:        row.ID,
+		Title:     row.Title,
+		Body:      row.Body,
+		Data:      data,
+		CreatedAt: timestamppb.New(row.CreatedAt.Time),
+		Read:      row.ReadAt.Valid,
+	}
+	for notificationType, name := range typeNames {
+		if name == row.Type {
+			notification.Type = notificationType
+		}
+	}
+	return notification, nil
+}
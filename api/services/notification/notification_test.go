@@ -0,0 +1,252 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	notificationV1 "github.com/VoidMesh/api/api/proto/notification/v1"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeDB keeps users, notifications and announcements in memory
+type fakeDB struct {
+	users         []db.User
+	notifications []db.Notification
+	announcements []db.Announcement
+}
+
+func (f *fakeDB) GetUserById(ctx context.Context, id pgtype.UUID) (db.User, error) {
+	for _, user := range f.users {
+		if user.ID == id {
+			return user, nil
+		}
+	}
+	return db.User{}, pgx.ErrNoRows
+}
+
+func (f *fakeDB) CreateNotification(ctx context.Context, arg db.CreateNotificationParams) (db.Notification, error) {
+	for _, existing := range f.notifications {
+		if existing.DedupKey == arg.DedupKey {
+			return db.Notification{}, pgx.ErrNoRows
+		}
+	}
+	row := db.Notification{
+		ID:        int64(len(f.notifications) + 1),
+		UserID:    arg.UserID,
+		Type:      arg.Type,
+		Title:     arg.Title,
+		Body:      arg.Body,
+		Data:      arg.Data,
+		DedupKey:  arg.DedupKey,
+		CreatedAt: arg.CreatedAt,
+	}
+	f.notifications = append(f.notifications, row)
+	return row, nil
+}
+
+func (f *fakeDB) ListNotifications(ctx context.Context, arg db.ListNotificationsParams) ([]db.Notification, error) {
+	var rows []db.Notification
+	for i := len(f.notifications) - 1; i >= 0 && len(rows) < int(arg.MaxNotifications); i-- {
+		row := f.notifications[i]
+		if row.UserID == arg.UserID && row.ID < arg.BeforeID && (!arg.UnreadOnly || !row.ReadAt.Valid) {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+func (f *fakeDB) CountUnreadNotifications(ctx context.Context, userID pgtype.UUID) (int64, error) {
+	var unread int64
+	for _, row := range f.notifications {
+		if row.UserID == userID && !row.ReadAt.Valid {
+			unread++
+		}
+	}
+	return unread, nil
+}
+
+func (f *fakeDB) MarkNotificationsRead(ctx context.Context, arg db.MarkNotificationsReadParams) (int64, error) {
+	var marked int64
+	for _, id := range arg.Ids {
+		for i, row := range f.notifications {
+			if row.ID == id && row.UserID == arg.UserID && !row.ReadAt.Valid {
+				f.notifications[i].ReadAt = arg.ReadAt
+				marked++
+			}
+		}
+	}
+	return marked, nil
+}
+
+func (f *fakeDB) MarkAllNotificationsRead(ctx context.Context, arg db.MarkAllNotificationsReadParams) (int64, error) {
+	var marked int64
+	for i, row := range f.notifications {
+		if row.UserID == arg.UserID && !row.ReadAt.Valid {
+			f.notifications[i].ReadAt = arg.ReadAt
+			marked++
+		}
+	}
+	return marked, nil
+}
+
+func (f *fakeDB) CreateAnnouncement(ctx context.Context, arg db.CreateAnnouncementParams) (db.Announcement, error) {
+	row := db.Announcement{
+		ID:        int64(len(f.announcements) + 1),
+		Message:   arg.Message,
+		Severity:  arg.Severity,
+		CreatedBy: arg.CreatedBy,
+		CreatedAt: arg.CreatedAt,
+		ExpiresAt: arg.ExpiresAt,
+	}
+	f.announcements = append(f.announcements, row)
+	return row, nil
+}
+
+func (f *fakeDB) ListActiveAnnouncements(ctx context.Context, arg db.ListActiveAnnouncementsParams) ([]db.Announcement, error) {
+	var rows []db.Announcement
+	for _, row := range f.announcements {
+		if row.ID > arg.AfterID && (!row.ExpiresAt.Valid || row.ExpiresAt.Time.After(arg.Now.Time)) {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+func (f *fakeDB) GetLatestAnnouncementID(ctx context.Context) (int64, error) {
+	return int64(len(f.announcements)), nil
+}
+
+var (
+	aliceID = "00000000-0000-0000-0000-000000000001"
+	bobID   = "00000000-0000-0000-0000-000000000002"
+)
+
+func newTestService() (*Service, *fakeDB) {
+	alice, _ := uuid.StringToPgtype(aliceID)
+	bob, _ := uuid.StringToPgtype(bobID)
+	database := &fakeDB{users: []db.User{
+		{ID: alice, Username: "alice", DisplayName: "Alice"},
+		{ID: bob, Username: "bob"},
+	}}
+	return NewService(database, testmocks.NopLogger[LoggerInterface]{}), database
+}
+
+func TestNotify_StreamsAndDedups(t *testing.T) {
+	service, database := newTestService()
+	ctx := context.Background()
+
+	notifications, unsubscribe, err := service.SubscribeNotifications(ctx, bobID)
+	require.NoError(t, err)
+	defer unsubscribe()
+
+	n := Notification{
+		UserID:   bobID,
+		Type:     notificationV1.NotificationType_NOTIFICATION_TYPE_QUEST_COMPLETED,
+		Title:    "Quest complete",
+		DedupKey: "quest.completed:1",
+	}
+	created, err := service.Notify(ctx, n)
+	require.NoError(t, err)
+	require.NotNil(t, created)
+
+	select {
+	case streamed := <-notifications:
+		assert.Equal(t, created.Id, streamed.Id)
+		assert.Equal(t, notificationV1.NotificationType_NOTIFICATION_TYPE_QUEST_COMPLETED, streamed.Type)
+	case <-time.After(time.Second):
+		t.Fatal("notification was not streamed")
+	}
+
+	again, err := service.Notify(ctx, n)
+	require.NoError(t, err)
+	assert.Nil(t, again, "a reused dedup key is skipped")
+	assert.Len(t, database.notifications, 1)
+}
+
+func TestListAndMarkRead(t *testing.T) {
+	service, _ := newTestService()
+	ctx := context.Background()
+
+	for i, key := range []string{"a", "b", "c"} {
+		_, err := service.Notify(ctx, Notification{
+			UserID:   bobID,
+			Type:     notificationV1.NotificationType_NOTIFICATION_TYPE_TRADE_COMPLETED,
+			Title:    "Trade completed",
+			Data:     map[string]string{"trade_id": key},
+			DedupKey: key,
+		})
+		require.NoError(t, err, i)
+	}
+
+	listed, unread, err := service.ListNotifications(ctx, bobID, 0, 2, false)
+	require.NoError(t, err)
+	require.Len(t, listed, 2)
+	assert.Equal(t, int64(3), unread)
+	assert.Equal(t, "c", listed[0].Data["trade_id"], "newest first")
+
+	marked, err := service.MarkRead(ctx, bobID, []int64{listed[0].Id}, false)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), marked)
+
+	marked, err = service.MarkRead(ctx, aliceID, nil, true)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), marked, "other users' notifications are untouched")
+
+	listed, unread, err = service.ListNotifications(ctx, bobID, 0, 0, true)
+	require.NoError(t, err)
+	assert.Len(t, listed, 2)
+	assert.Equal(t, int64(2), unread)
+
+	_, err = service.MarkRead(ctx, bobID, nil, false)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func publish(t *testing.T, bus *events.Bus, eventType, dedupKey string, payload any) {
+	t.Helper()
+	data, err := json.Marshal(payload)
+	require.NoError(t, err)
+	require.NoError(t, bus.Publish(context.Background(), events.Event{Type: eventType, DedupKey: dedupKey, Payload: data}))
+}
+
+func TestSubscribe_EmitsFromEvents(t *testing.T) {
+	service, database := newTestService()
+	bus := events.NewBus()
+	service.Subscribe(bus)
+
+	request := events.FriendRequestPayload{RequesterID: aliceID, AddresseeID: bobID}
+	publish(t, bus, events.FriendRequestSent, "friend.request_sent:1", request)
+	publish(t, bus, events.FriendRequestSent, "friend.request_sent:1", request)
+	publish(t, bus, events.FriendRequestAccepted, "friend.request_accepted:1", request)
+	publish(t, bus, events.TradeCompleted, "trade.completed:7", events.TradeCompletedPayload{TradeID: "7", UserIDs: []string{aliceID, bobID}})
+	publish(t, bus, events.AchievementUnlocked, "achievement.unlocked:c1:gatherer", events.AchievementUnlockedPayload{
+		UserID: aliceID, CharacterID: "c1", AchievementID: "gatherer", AchievementName: "Gatherer",
+	})
+	publish(t, bus, events.UserNewDeviceLogin, "user.new_device_login:s1", events.UserNewDeviceLoginPayload{
+		UserID: aliceID, SessionID: "s1", IPAddress: "198.51.100.2", UserAgent: "VoidMesh/1.4 (Android 14)",
+	})
+
+	require.Len(t, database.notifications, 6)
+	assert.Equal(t, "friend_request", database.notifications[0].Type)
+	assert.Equal(t, "Alice wants to be your friend", database.notifications[0].Body)
+	assert.Equal(t, "friend_accepted", database.notifications[1].Type)
+	assert.Equal(t, "bob accepted your friend request", database.notifications[1].Body)
+	assert.Equal(t, "achievement_unlocked", database.notifications[4].Type)
+	assert.Equal(t, "You earned Gatherer. Claim your reward!", database.notifications[4].Body)
+	assert.Equal(t, "new_device_login", database.notifications[5].Type)
+	assert.Contains(t, database.notifications[5].Body, "198.51.100.2")
+
+	bobInbox, _, err := service.ListNotifications(context.Background(), bobID, 0, 0, false)
+	require.NoError(t, err)
+	assert.Len(t, bobInbox, 2, "friend request and trade")
+}
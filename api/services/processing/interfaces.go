@@ -0,0 +1,256 @@
+package processing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/entity"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/outbox"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/txn"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	"github.com/VoidMesh/api/api/services/inventory"
+	"github.com/charmbracelet/log"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DatabaseInterface abstracts database operations for processing.
+type DatabaseInterface interface {
+	GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error)
+	GetItemByName(ctx context.Context, name string) (db.Item, error)
+	ListProcessingJobsByCharacter(ctx context.Context, characterID pgtype.UUID) ([]db.ProcessingJob, error)
+	ListUnnotifiedReadyProcessingJobs(ctx context.Context, arg db.ListUnnotifiedReadyProcessingJobsParams) ([]db.ProcessingJob, error)
+	PlaceStation(ctx context.Context, station *entity.Entity, owner pgtype.UUID, cost []Payment) error
+	StartJob(ctx context.Context, job db.CreateProcessingJobParams, inputs []Payment, maxJobs int64) (db.ProcessingJob, error)
+	CollectJob(ctx context.Context, character db.Character, jobID int64, now pgtype.Timestamp) (db.ProcessingJob, error)
+	NotifyReady(ctx context.Context, job db.ProcessingJob, payload events.ProcessingCompletedPayload, notifiedAt pgtype.Timestamp) error
+}
+
+// StationStore loads placed stations from the world entities.
+type StationStore interface {
+	Get(ctx context.Context, id int64) (*entity.Entity, error)
+}
+
+// RegionServiceInterface defines the protected region checks needed.
+type RegionServiceInterface interface {
+	CheckAllowed(ctx context.Context, worldID pgtype.UUID, x, y int32, flag chunkV1.RegionFlag) error
+}
+
+// ClaimServiceInterface defines the land claim checks needed.
+type ClaimServiceInterface interface {
+	CheckAllowed(ctx context.Context, worldID pgtype.UUID, chunkX, chunkY int32, characterID pgtype.UUID) error
+}
+
+// TimeScaleInterface speeds up processing in a world for testing.
+type TimeScaleInterface interface {
+	Scale(ctx context.Context, worldID pgtype.UUID, d time.Duration) time.Duration
+}
+
+// DatabaseWrapper implements DatabaseInterface using the actual database connection.
+type DatabaseWrapper struct {
+	pool    storage.Pool
+	queries *db.Queries
+}
+
+// NewDatabaseWrapper creates a new database wrapper with the given connection pool.
+func NewDatabaseWrapper(pool storage.Pool) DatabaseInterface {
+	return &DatabaseWrapper{
+		pool:    pool,
+		queries: db.New(pool),
+	}
+}
+
+func (d *DatabaseWrapper) GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error) {
+	return d.queries.GetCharacterById(ctx, id)
+}
+
+func (d *DatabaseWrapper) GetItemByName(ctx context.Context, name string) (db.Item, error) {
+	return d.queries.GetItemByName(ctx, name)
+}
+
+func (d *DatabaseWrapper) ListProcessingJobsByCharacter(ctx context.Context, characterID pgtype.UUID) ([]db.ProcessingJob, error) {
+	return d.queries.ListProcessingJobsByCharacter(ctx, characterID)
+}
+
+func (d *DatabaseWrapper) ListUnnotifiedReadyProcessingJobs(ctx context.Context, arg db.ListUnnotifiedReadyProcessingJobsParams) ([]db.ProcessingJob, error) {
+	return d.queries.ListUnnotifiedReadyProcessingJobs(ctx, arg)
+}
+
+// PlaceStation takes the station's cost from the owner's inventory and stores the station
+// entity in one transaction
+func (d *DatabaseWrapper) PlaceStation(ctx context.Context, station *entity.Entity, owner pgtype.UUID, cost []Payment) error {
+	return txn.Run(ctx, d.pool, txn.Serializable, func(q *db.Queries) error {
+		if err := take(ctx, q, owner, cost); err != nil {
+			return err
+		}
+		return entity.NewStore(q).Create(ctx, station)
+	})
+}
+
+// StartJob takes the recipe's inputs from the character's inventory and stores the job in
+// one serializable transaction, so concurrent starts cannot pass the job limit or spend
+// the same items
+func (d *DatabaseWrapper) StartJob(ctx context.Context, job db.CreateProcessingJobParams, inputs []Payment, maxJobs int64) (db.ProcessingJob, error) {
+	var created db.ProcessingJob
+	err := txn.Run(ctx, d.pool, txn.Serializable, func(q *db.Queries) error {
+		count, err := q.CountProcessingJobsByCharacter(ctx, job.CharacterID)
+		if err != nil {
+			return fmt.Errorf("failed to count jobs: %w", err)
+		}
+		if count >= maxJobs {
+			return ErrTooManyJobs
+		}
+		if err := take(ctx, q, job.CharacterID, inputs); err != nil {
+			return err
+		}
+		created, err = q.CreateProcessingJob(ctx, job)
+		return err
+	})
+	return created, err
+}
+
+// CollectJob grants a ready job's outputs, deletes it and enqueues an ItemCrafted event
+// per output in one serializable transaction. Nothing is granted unless everything fits.
+func (d *DatabaseWrapper) CollectJob(ctx context.Context, character db.Character, jobID int64, now pgtype.Timestamp) (db.ProcessingJob, error) {
+	var job db.ProcessingJob
+	err := txn.Run(ctx, d.pool, txn.Serializable, func(q *db.Queries) error {
+		var err error
+		job, err = q.GetProcessingJobForUpdate(ctx, db.GetProcessingJobForUpdateParams{ID: jobID, CharacterID: character.ID})
+		if err != nil {
+			return err
+		}
+		if job.ReadyAt.Time.After(now.Time) {
+			return ErrNotReady
+		}
+		outputs, err := decodeOutputs(job.Outputs)
+		if err != nil {
+			return err
+		}
+		grants := make([]inventory.Grant, 0, len(outputs))
+		for _, output := range outputs {
+			grants = append(grants, inventory.Grant{ItemID: output.ItemID, StackSize: output.StackSize, Quantity: output.Quantity})
+		}
+		if _, err := inventory.GrantAllInTx(ctx, q, character, grants); err != nil {
+			return err
+		}
+		if err := q.DeleteProcessingJob(ctx, job.ID); err != nil {
+			return fmt.Errorf("failed to delete job: %w", err)
+		}
+
+		characterID := uuid.PgtypeToString(character.ID)
+		craftID := "processing:" + strconv.FormatInt(job.ID, 10)
+		for _, output := range outputs {
+			err := outbox.Enqueue(ctx, q, events.ItemCrafted, characterID,
+				fmt.Sprintf("%s:%s:%d", events.ItemCrafted, craftID, output.ItemID),
+				events.ItemCraftedPayload{
+					CraftID:     craftID,
+					CharacterID: characterID,
+					ItemID:      output.ItemID,
+					Quantity:    output.Quantity,
+				})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return job, err
+}
+
+// NotifyReady marks a job notified and enqueues a ProcessingCompleted event for its owner,
+// unless another instance already did
+func (d *DatabaseWrapper) NotifyReady(ctx context.Context, job db.ProcessingJob, payload events.ProcessingCompletedPayload, notifiedAt pgtype.Timestamp) error {
+	return txn.Run(ctx, d.pool, txn.ReadCommitted, func(q *db.Queries) error {
+		marked, err := q.MarkProcessingJobNotified(ctx, db.MarkProcessingJobNotifiedParams{ID: job.ID, NotifiedAt: notifiedAt})
+		if err != nil {
+			return fmt.Errorf("failed to mark job notified: %w", err)
+		}
+		if marked == 0 {
+			return nil
+		}
+		return outbox.Enqueue(ctx, q, events.ProcessingCompleted, payload.CharacterID,
+			fmt.Sprintf("%s:%d", events.ProcessingCompleted, job.ID), payload)
+	})
+}
+
+// take removes the payments from the character's inventory, failing with
+// ErrInsufficientItems when it holds too little of any
+func take(ctx context.Context, q *db.Queries, characterID pgtype.UUID, payments []Payment) error {
+	for _, payment := range payments {
+		_, err := q.RemoveInventoryItemQuantity(ctx, db.RemoveInventoryItemQuantityParams{
+			CharacterID: characterID,
+			ItemID:      payment.ItemID,
+			Quantity:    payment.Quantity,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrInsufficientItems
+		}
+		if err != nil {
+			return fmt.Errorf("failed to take item %d: %w", payment.ItemID, err)
+		}
+	}
+	return q.DeleteEmptyInventoryItems(ctx, characterID)
+}
+
+// output is one item a job yields, as stored in processing_jobs.outputs
+type output struct {
+	ItemID    int32  `json:"item_id"`
+	ItemName  string `json:"item_name"`
+	Quantity  int32  `json:"quantity"`
+	StackSize int32  `json:"stack_size"`
+}
+
+func decodeOutputs(data []byte) ([]output, error) {
+	var outputs []output
+	if err := json.Unmarshal(data, &outputs); err != nil {
+		return nil, fmt.Errorf("invalid job outputs: %w", err)
+	}
+	return outputs, nil
+}
+
+// LoggerInterface abstracts logging operations for dependency injection.
+type LoggerInterface interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+	With(keysAndValues ...interface{}) LoggerInterface
+}
+
+// DefaultLoggerWrapper wraps the internal logging package.
+type DefaultLoggerWrapper struct {
+	logger *log.Logger
+}
+
+func NewDefaultLoggerWrapper() LoggerInterface {
+	return &DefaultLoggerWrapper{logger: logging.GetLogger()}
+}
+
+func (l *DefaultLoggerWrapper) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.Debug(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Info(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.Warn(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.Error(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) With(keysAndValues ...interface{}) LoggerInterface {
+	return &DefaultLoggerWrapper{logger: l.logger.With(keysAndValues...)}
+}
@@ -0,0 +1,570 @@
+// Package processing turns gathered items into refined ones at placed stations. A
+// character builds a station (a campfire or furnace world entity) by paying its cost, then
+// starts recipes while standing near a station of the type the recipe needs. The inputs
+// are taken when a job starts and the outputs are collected once it is ready; a background
+// job notifies owners as their jobs finish.
+package processing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/alerting"
+	"github.com/VoidMesh/api/api/internal/authz"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/entity"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	processingV1 "github.com/VoidMesh/api/api/proto/processing/v1"
+	"github.com/VoidMesh/api/api/services/inventory"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// notifyBatchSize is how many ready jobs the notify job loads per page
+const notifyBatchSize = 200
+
+var (
+	ErrInsufficientItems = errors.New("not enough items")
+	ErrTooManyJobs       = errors.New("job limit reached")
+	ErrNotReady          = errors.New("job is not ready")
+)
+
+// ItemAmount is a quantity of an item, by name
+type ItemAmount struct {
+	Item     string
+	Quantity int32
+}
+
+// StationType is a structure characters can build to process items at. Types with an
+// interior template, like the cottage, can also be entered (see services/interior).
+type StationType struct {
+	Type string // Entity type of placed stations
+	Name string
+	Cost []ItemAmount
+}
+
+// Recipe turns inputs into outputs over Duration at a station of type Station
+type Recipe struct {
+	ID       string
+	Name     string
+	Station  string
+	Inputs   []ItemAmount
+	Outputs  []ItemAmount
+	Duration time.Duration
+}
+
+// Config sets the stations, the recipes and how jobs are run
+type Config struct {
+	Stations []StationType
+	Recipes  []Recipe
+	Range    int32         // Cells a character may stand from the station
+	MaxJobs  int64         // Jobs a character may have at once, ready or not
+	Interval time.Duration // Time between notify passes
+}
+
+// DefaultConfig offers cooking at campfires, smelting at furnaces and furniture making at
+// workbenches, and lets characters build cottages to furnish and banks to store items at
+func DefaultConfig() Config {
+	return Config{
+		Stations: []StationType{
+			{Type: "campfire", Name: "Campfire", Cost: []ItemAmount{{"Twigs", 5}, {"Stone", 3}}},
+			{Type: "furnace", Name: "Furnace", Cost: []ItemAmount{{"Stone", 12}, {"Minerals", 4}}},
+			{Type: "workbench", Name: "Workbench", Cost: []ItemAmount{{"Twigs", 10}, {"Stone", 4}}},
+			{Type: "cottage", Name: "Cottage", Cost: []ItemAmount{{"Stone", 30}, {"Twigs", 20}}},
+			{Type: "bank", Name: "Bank", Cost: []ItemAmount{{"Stone", 40}, {"Metal Ingot", 4}}},
+		},
+		Recipes: []Recipe{
+			{
+				ID: "grilled_fish", Name: "Grilled Fish", Station: "campfire",
+				Inputs:   []ItemAmount{{"Fish", 2}, {"Twigs", 1}},
+				Outputs:  []ItemAmount{{"Grilled Fish", 2}},
+				Duration: 30 * time.Second,
+			},
+			{
+				ID: "herbal_tea", Name: "Herbal Tea", Station: "campfire",
+				Inputs:   []ItemAmount{{"Herbs", 3}, {"Leaves", 1}},
+				Outputs:  []ItemAmount{{"Herbal Tea", 1}},
+				Duration: 20 * time.Second,
+			},
+			{
+				ID: "metal_ingot", Name: "Metal Ingot", Station: "furnace",
+				Inputs:   []ItemAmount{{"Minerals", 3}, {"Twigs", 2}},
+				Outputs:  []ItemAmount{{"Metal Ingot", 1}},
+				Duration: 2 * time.Minute,
+			},
+			{
+				ID: "glass", Name: "Glass", Station: "furnace",
+				Inputs:   []ItemAmount{{"Shells", 2}, {"Stone", 2}},
+				Outputs:  []ItemAmount{{"Glass", 1}},
+				Duration: 90 * time.Second,
+			},
+			{
+				ID: "wooden_chair", Name: "Wooden Chair", Station: "workbench",
+				Inputs:   []ItemAmount{{"Twigs", 8}},
+				Outputs:  []ItemAmount{{"Wooden Chair", 1}},
+				Duration: time.Minute,
+			},
+			{
+				ID: "wooden_table", Name: "Wooden Table", Station: "workbench",
+				Inputs:   []ItemAmount{{"Twigs", 12}, {"Stone", 2}},
+				Outputs:  []ItemAmount{{"Wooden Table", 1}},
+				Duration: 90 * time.Second,
+			},
+			{
+				ID: "glass_lamp", Name: "Glass Lamp", Station: "workbench",
+				Inputs:   []ItemAmount{{"Glass", 2}, {"Metal Ingot", 1}},
+				Outputs:  []ItemAmount{{"Glass Lamp", 1}},
+				Duration: 2 * time.Minute,
+			},
+		},
+		Range:    2,
+		MaxJobs:  5,
+		Interval: 15 * time.Second,
+	}
+}
+
+// Payment is a quantity of an item taken from a character's inventory
+type Payment struct {
+	ItemID   int32
+	Quantity int32
+}
+
+// Service places stations and runs processing jobs.
+type Service struct {
+	db        DatabaseInterface
+	stations  StationStore
+	logger    LoggerInterface
+	clock     clock.Clock
+	config    Config
+	regions   RegionServiceInterface
+	claims    ClaimServiceInterface
+	timeScale TimeScaleInterface
+}
+
+// NewService creates a new processing service with dependency injection.
+func NewService(db DatabaseInterface, stations StationStore, logger LoggerInterface) *Service {
+	componentLogger := logger.With("component", "processing-service")
+	componentLogger.Debug("Creating new processing service")
+	return &Service{
+		db:       db,
+		stations: stations,
+		logger:   componentLogger,
+		clock:    clock.New(),
+		config:   DefaultConfig(),
+	}
+}
+
+// NewServiceWithPool creates a service with concrete implementations (convenience constructor for production use).
+func NewServiceWithPool(pool storage.Pool) *Service {
+	return NewService(NewDatabaseWrapper(pool), entity.NewStoreWithPool(pool), NewDefaultLoggerWrapper())
+}
+
+// SetClock replaces the clock used for job times and the notify schedule (for simulation tests)
+func (s *Service) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// SetConfig replaces the stations, recipes and job limits
+func (s *Service) SetConfig(config Config) {
+	s.config = config
+}
+
+// SetRegionService enables protected region checks when placing stations
+func (s *Service) SetRegionService(regions RegionServiceInterface) {
+	s.regions = regions
+}
+
+// SetClaimService enables land claim checks when placing stations
+func (s *Service) SetClaimService(claims ClaimServiceInterface) {
+	s.claims = claims
+}
+
+// SetTimeScale speeds up job durations in worlds with a time scale
+func (s *Service) SetTimeScale(timeScale TimeScaleInterface) {
+	s.timeScale = timeScale
+}
+
+// resolved is an ItemAmount with the item looked up
+type resolved struct {
+	item     db.Item
+	quantity int32
+}
+
+// resolve looks up the items of amounts by name
+func (s *Service) resolve(ctx context.Context, amounts []ItemAmount) ([]resolved, error) {
+	items := make([]resolved, 0, len(amounts))
+	for _, amount := range amounts {
+		item, err := s.db.GetItemByName(ctx, amount.Item)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get item %q: %w", amount.Item, err)
+		}
+		items = append(items, resolved{item: item, quantity: amount.Quantity})
+	}
+	return items, nil
+}
+
+func payments(items []resolved) []Payment {
+	payments := make([]Payment, 0, len(items))
+	for _, r := range items {
+		payments = append(payments, Payment{ItemID: r.item.ID, Quantity: r.quantity})
+	}
+	return payments
+}
+
+func itemsToProto(items []resolved) []*processingV1.ItemQuantity {
+	quantities := make([]*processingV1.ItemQuantity, 0, len(items))
+	for _, r := range items {
+		quantities = append(quantities, &processingV1.ItemQuantity{ItemId: r.item.ID, ItemName: r.item.Name, Quantity: r.quantity})
+	}
+	return quantities
+}
+
+// RecipeStations returns the station types at least one recipe is made at
+func (s *Service) RecipeStations() []string {
+	var types []string
+	for _, st := range s.config.Stations {
+		for _, r := range s.config.Recipes {
+			if r.Station == st.Type {
+				types = append(types, st.Type)
+				break
+			}
+		}
+	}
+	return types
+}
+
+func (s *Service) stationType(stationType string) (StationType, bool) {
+	for _, st := range s.config.Stations {
+		if st.Type == stationType {
+			return st, true
+		}
+	}
+	return StationType{}, false
+}
+
+func (s *Service) recipe(id string) (Recipe, bool) {
+	for _, r := range s.config.Recipes {
+		if r.ID == id {
+			return r, true
+		}
+	}
+	return Recipe{}, false
+}
+
+// Recipes returns every recipe and station type with their items resolved
+func (s *Service) Recipes(ctx context.Context) (*processingV1.ListRecipesResponse, error) {
+	resp := &processingV1.ListRecipesResponse{}
+	for _, st := range s.config.Stations {
+		cost, err := s.resolve(ctx, st.Cost)
+		if err != nil {
+			s.logger.Error("Failed to resolve station cost", "station_type", st.Type, "error", err)
+			return nil, status.Errorf(codes.Internal, "failed to list recipes")
+		}
+		resp.StationTypes = append(resp.StationTypes, &processingV1.StationType{Type: st.Type, Name: st.Name, Cost: itemsToProto(cost)})
+	}
+	for _, r := range s.config.Recipes {
+		inputs, err := s.resolve(ctx, r.Inputs)
+		if err != nil {
+			s.logger.Error("Failed to resolve recipe inputs", "recipe_id", r.ID, "error", err)
+			return nil, status.Errorf(codes.Internal, "failed to list recipes")
+		}
+		outputs, err := s.resolve(ctx, r.Outputs)
+		if err != nil {
+			s.logger.Error("Failed to resolve recipe outputs", "recipe_id", r.ID, "error", err)
+			return nil, status.Errorf(codes.Internal, "failed to list recipes")
+		}
+		resp.Recipes = append(resp.Recipes, &processingV1.Recipe{
+			Id:              r.ID,
+			Name:            r.Name,
+			StationType:     r.Station,
+			Inputs:          itemsToProto(inputs),
+			Outputs:         itemsToProto(outputs),
+			DurationSeconds: int64(r.Duration / time.Second),
+		})
+	}
+	return resp, nil
+}
+
+// PlaceStation builds a station where the character stands, paying its cost from the
+// character's inventory
+func (s *Service) PlaceStation(ctx context.Context, userID, characterID, stationType string) (*processingV1.Station, error) {
+	st, ok := s.stationType(stationType)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown station type %q", stationType)
+	}
+	character, err := authz.OwnedCharacter(ctx, s.db, s.logger, userID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	if s.regions != nil {
+		if err := s.regions.CheckAllowed(ctx, character.WorldID, character.X, character.Y, chunkV1.RegionFlag_REGION_FLAG_NO_BUILD); err != nil {
+			return nil, err
+		}
+	}
+	if s.claims != nil {
+		if err := s.claims.CheckAllowed(ctx, character.WorldID, character.ChunkX, character.ChunkY, character.ID); err != nil {
+			return nil, err
+		}
+	}
+	cost, err := s.resolve(ctx, st.Cost)
+	if err != nil {
+		s.logger.Error("Failed to resolve station cost", "station_type", st.Type, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to place station")
+	}
+
+	station := &entity.Entity{
+		WorldID:    character.WorldID,
+		Type:       st.Type,
+		X:          character.X,
+		Y:          character.Y,
+		Components: entity.Components{},
+	}
+	if err := station.Components.Set(entity.Owner{CharacterID: characterID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to place station")
+	}
+	err = s.db.PlaceStation(ctx, station, character.ID, payments(cost))
+	if errors.Is(err, ErrInsufficientItems) {
+		return nil, status.Errorf(codes.FailedPrecondition, "building a %s needs more materials", st.Name)
+	}
+	if err != nil {
+		s.logger.Error("Failed to place station", "character_id", characterID, "station_type", st.Type, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to place station")
+	}
+
+	s.logger.Info("Station placed", "entity_id", station.ID, "station_type", st.Type, "character_id", characterID, "x", station.X, "y", station.Y)
+	return stationToProto(station, characterID), nil
+}
+
+// StartProcessing takes the recipe's inputs and starts a job at a station near the
+// character
+func (s *Service) StartProcessing(ctx context.Context, userID, characterID, recipeID string, stationID int64) (*processingV1.ProcessingJob, error) {
+	r, ok := s.recipe(recipeID)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown recipe %q", recipeID)
+	}
+	character, err := authz.OwnedCharacter(ctx, s.db, s.logger, userID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	station, err := s.stations.Get(ctx, stationID)
+	if errors.Is(err, entity.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "station not found")
+	}
+	if err != nil {
+		s.logger.Error("Failed to get station", "entity_id", stationID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to start processing")
+	}
+	if station.Type != r.Station || station.WorldID != character.WorldID {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s must be made at a %s", r.Name, r.Station)
+	}
+	if !geometry.InRange(geometry.Point{X: character.X, Y: character.Y}, station.Position(), s.config.Range) {
+		return nil, status.Errorf(codes.FailedPrecondition, "station is out of range")
+	}
+
+	inputs, err := s.resolve(ctx, r.Inputs)
+	if err != nil {
+		s.logger.Error("Failed to resolve recipe inputs", "recipe_id", r.ID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to start processing")
+	}
+	outputs, err := s.resolve(ctx, r.Outputs)
+	if err != nil {
+		s.logger.Error("Failed to resolve recipe outputs", "recipe_id", r.ID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to start processing")
+	}
+	stored := make([]output, 0, len(outputs))
+	for _, o := range outputs {
+		stored = append(stored, output{ItemID: o.item.ID, ItemName: o.item.Name, Quantity: o.quantity, StackSize: o.item.StackSize})
+	}
+	encoded, err := json.Marshal(stored)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to start processing")
+	}
+
+	duration := r.Duration
+	if s.timeScale != nil {
+		duration = s.timeScale.Scale(ctx, character.WorldID, duration)
+	}
+	now := s.clock.Now()
+	job, err := s.db.StartJob(ctx, db.CreateProcessingJobParams{
+		CharacterID:     character.ID,
+		WorldID:         character.WorldID,
+		RecipeID:        r.ID,
+		StationEntityID: station.ID,
+		Outputs:         encoded,
+		StartedAt:       pgtype.Timestamp{Time: now, Valid: true},
+		ReadyAt:         pgtype.Timestamp{Time: now.Add(duration), Valid: true},
+	}, payments(inputs), s.config.MaxJobs)
+	switch {
+	case errors.Is(err, ErrTooManyJobs):
+		return nil, status.Errorf(codes.FailedPrecondition, "a character can have at most %d processing jobs", s.config.MaxJobs)
+	case errors.Is(err, ErrInsufficientItems):
+		return nil, status.Errorf(codes.FailedPrecondition, "not enough ingredients for %s", r.Name)
+	case err != nil:
+		s.logger.Error("Failed to start processing job", "character_id", characterID, "recipe_id", r.ID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to start processing")
+	}
+
+	s.logger.Info("Processing started", "job_id", job.ID, "recipe_id", r.ID, "character_id", characterID, "ready_at", job.ReadyAt.Time)
+	return s.jobToProto(job, now)
+}
+
+// ListJobs returns the character's jobs, soonest ready first
+func (s *Service) ListJobs(ctx context.Context, userID, characterID string) ([]*processingV1.ProcessingJob, error) {
+	character, err := authz.OwnedCharacter(ctx, s.db, s.logger, userID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.db.ListProcessingJobsByCharacter(ctx, character.ID)
+	if err != nil {
+		s.logger.Error("Failed to list processing jobs", "character_id", characterID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list processing jobs")
+	}
+	now := s.clock.Now()
+	jobs := make([]*processingV1.ProcessingJob, 0, len(rows))
+	for _, row := range rows {
+		job, err := s.jobToProto(row, now)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+// Collect moves a ready job's outputs into the character's inventory. Collecting does
+// not need the station, which may have been removed since.
+func (s *Service) Collect(ctx context.Context, userID, characterID string, jobID int64) ([]*processingV1.ItemQuantity, error) {
+	character, err := authz.OwnedCharacter(ctx, s.db, s.logger, userID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	job, err := s.db.CollectJob(ctx, character, jobID, pgtype.Timestamp{Time: s.clock.Now(), Valid: true})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, status.Errorf(codes.NotFound, "processing job not found")
+	case errors.Is(err, ErrNotReady):
+		return nil, status.Errorf(codes.FailedPrecondition, "processing job is not ready")
+	case errors.Is(err, inventory.ErrInventoryFull):
+		return nil, status.Errorf(codes.ResourceExhausted, "inventory is full")
+	case err != nil:
+		s.logger.Error("Failed to collect processing job", "job_id", jobID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to collect processing job")
+	}
+
+	s.logger.Info("Processing job collected", "job_id", job.ID, "recipe_id", job.RecipeID, "character_id", characterID)
+	return outputsToProto(job)
+}
+
+// NotifyReady notifies the owners of jobs that have become ready. It returns how many
+// jobs were notified.
+func (s *Service) NotifyReady(ctx context.Context) (int, error) {
+	notified := 0
+	for {
+		now := pgtype.Timestamp{Time: s.clock.Now(), Valid: true}
+		jobs, err := s.db.ListUnnotifiedReadyProcessingJobs(ctx, db.ListUnnotifiedReadyProcessingJobsParams{
+			ReadyAt: now,
+			Limit:   notifyBatchSize,
+		})
+		if err != nil {
+			return notified, fmt.Errorf("failed to list ready jobs: %w", err)
+		}
+
+		for _, job := range jobs {
+			character, err := s.db.GetCharacterById(ctx, job.CharacterID)
+			if err != nil {
+				return notified, fmt.Errorf("failed to get owner of job %d: %w", job.ID, err)
+			}
+			recipeName := job.RecipeID
+			if r, ok := s.recipe(job.RecipeID); ok {
+				recipeName = r.Name
+			}
+			err = s.db.NotifyReady(ctx, job, events.ProcessingCompletedPayload{
+				JobID:       job.ID,
+				CharacterID: uuid.PgtypeToString(job.CharacterID),
+				UserID:      uuid.PgtypeToString(character.UserID),
+				RecipeID:    job.RecipeID,
+				RecipeName:  recipeName,
+			}, now)
+			if err != nil {
+				return notified, fmt.Errorf("failed to notify job %d: %w", job.ID, err)
+			}
+			notified++
+		}
+
+		if len(jobs) < notifyBatchSize {
+			return notified, nil
+		}
+	}
+}
+
+// Run notifies owners of ready jobs every config.Interval until ctx is cancelled
+func (s *Service) Run(ctx context.Context) {
+	s.logger.Info("Processing notify job started", "interval", s.config.Interval)
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("Processing notify job stopped")
+			return
+		case <-s.clock.After(s.config.Interval):
+		}
+
+		notified, err := s.NotifyReady(ctx)
+		if err != nil {
+			s.logger.Error("Processing notify pass failed", "error", err)
+			alerting.ReportJobError("processing_notify", err)
+		}
+		if notified > 0 {
+			s.logger.Debug("Processing notify pass complete", "notified", notified)
+		}
+	}
+}
+
+func stationToProto(station *entity.Entity, ownerCharacterID string) *processingV1.Station {
+	return &processingV1.Station{
+		EntityId:         station.ID,
+		Type:             station.Type,
+		X:                station.X,
+		Y:                station.Y,
+		OwnerCharacterId: ownerCharacterID,
+	}
+}
+
+func (s *Service) jobToProto(job db.ProcessingJob, now time.Time) (*processingV1.ProcessingJob, error) {
+	outputs, err := outputsToProto(job)
+	if err != nil {
+		return nil, err
+	}
+	return &processingV1.ProcessingJob{
+		Id:              job.ID,
+		CharacterId:     uuid.PgtypeToString(job.CharacterID),
+		RecipeId:        job.RecipeID,
+		StationEntityId: job.StationEntityID,
+		Outputs:         outputs,
+		StartedAt:       timestamppb.New(job.StartedAt.Time),
+		ReadyAt:         timestamppb.New(job.ReadyAt.Time),
+		Ready:           !job.ReadyAt.Time.After(now),
+	}, nil
+}
+
+func outputsToProto(job db.ProcessingJob) ([]*processingV1.ItemQuantity, error) {
+	outputs, err := decodeOutputs(job.Outputs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "job %d has invalid outputs", job.ID)
+	}
+	quantities := make([]*processingV1.ItemQuantity, 0, len(outputs))
+	for _, o := range outputs {
+		quantities = append(quantities, &processingV1.ItemQuantity{ItemId: o.ItemID, ItemName: o.ItemName, Quantity: o.Quantity})
+	}
+	return quantities, nil
+}
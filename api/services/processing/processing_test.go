@@ -0,0 +1,312 @@
+package processing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/entity"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeDB keeps inventories, stations and jobs in memory
+type fakeDB struct {
+	testmocks.Characters
+	items     map[string]db.Item
+	inventory map[int32]int32 // The one character's items by ID
+	stations  map[int64]*entity.Entity
+	jobs      []db.ProcessingJob
+	notified  []events.ProcessingCompletedPayload
+}
+
+func (f *fakeDB) GetItemByName(ctx context.Context, name string) (db.Item, error) {
+	item, ok := f.items[name]
+	if !ok {
+		return db.Item{}, pgx.ErrNoRows
+	}
+	return item, nil
+}
+
+func (f *fakeDB) ListProcessingJobsByCharacter(ctx context.Context, characterID pgtype.UUID) ([]db.ProcessingJob, error) {
+	var jobs []db.ProcessingJob
+	for _, job := range f.jobs {
+		if job.CharacterID == characterID {
+			jobs = append(jobs, job)
+		}
+	}
+	return jobs, nil
+}
+
+func (f *fakeDB) ListUnnotifiedReadyProcessingJobs(ctx context.Context, arg db.ListUnnotifiedReadyProcessingJobsParams) ([]db.ProcessingJob, error) {
+	var jobs []db.ProcessingJob
+	for _, job := range f.jobs {
+		if !job.NotifiedAt.Valid && !job.ReadyAt.Time.After(arg.ReadyAt.Time) {
+			jobs = append(jobs, job)
+		}
+	}
+	return jobs, nil
+}
+
+func (f *fakeDB) take(payments []Payment) error {
+	for _, p := range payments {
+		if f.inventory[p.ItemID] < p.Quantity {
+			return ErrInsufficientItems
+		}
+	}
+	for _, p := range payments {
+		f.inventory[p.ItemID] -= p.Quantity
+	}
+	return nil
+}
+
+func (f *fakeDB) PlaceStation(ctx context.Context, station *entity.Entity, owner pgtype.UUID, cost []Payment) error {
+	if err := f.take(cost); err != nil {
+		return err
+	}
+	station.ID = int64(len(f.stations) + 1)
+	f.stations[station.ID] = station
+	return nil
+}
+
+func (f *fakeDB) StartJob(ctx context.Context, job db.CreateProcessingJobParams, inputs []Payment, maxJobs int64) (db.ProcessingJob, error) {
+	if int64(len(f.jobs)) >= maxJobs {
+		return db.ProcessingJob{}, ErrTooManyJobs
+	}
+	if err := f.take(inputs); err != nil {
+		return db.ProcessingJob{}, err
+	}
+	created := db.ProcessingJob{
+		ID:              int64(len(f.jobs) + 1),
+		CharacterID:     job.CharacterID,
+		WorldID:         job.WorldID,
+		RecipeID:        job.RecipeID,
+		StationEntityID: job.StationEntityID,
+		Outputs:         job.Outputs,
+		StartedAt:       job.StartedAt,
+		ReadyAt:         job.ReadyAt,
+	}
+	f.jobs = append(f.jobs, created)
+	return created, nil
+}
+
+func (f *fakeDB) CollectJob(ctx context.Context, character db.Character, jobID int64, now pgtype.Timestamp) (db.ProcessingJob, error) {
+	for i, job := range f.jobs {
+		if job.ID != jobID || job.CharacterID != character.ID {
+			continue
+		}
+		if job.ReadyAt.Time.After(now.Time) {
+			return db.ProcessingJob{}, ErrNotReady
+		}
+		outputs, err := decodeOutputs(job.Outputs)
+		if err != nil {
+			return db.ProcessingJob{}, err
+		}
+		for _, o := range outputs {
+			f.inventory[o.ItemID] += o.Quantity
+		}
+		f.jobs = append(f.jobs[:i], f.jobs[i+1:]...)
+		return job, nil
+	}
+	return db.ProcessingJob{}, pgx.ErrNoRows
+}
+
+func (f *fakeDB) NotifyReady(ctx context.Context, job db.ProcessingJob, payload events.ProcessingCompletedPayload, notifiedAt pgtype.Timestamp) error {
+	for i := range f.jobs {
+		if f.jobs[i].ID == job.ID {
+			f.jobs[i].NotifiedAt = notifiedAt
+		}
+	}
+	f.notified = append(f.notified, payload)
+	return nil
+}
+
+type fakeStations struct{ db *fakeDB }
+
+func (f fakeStations) Get(ctx context.Context, id int64) (*entity.Entity, error) {
+	station, ok := f.db.stations[id]
+	if !ok {
+		return nil, entity.ErrNotFound
+	}
+	return station, nil
+}
+
+type halfTime struct{}
+
+func (halfTime) Scale(ctx context.Context, worldID pgtype.UUID, d time.Duration) time.Duration {
+	return d / 2
+}
+
+const (
+	userID      = "550e8400-e29b-41d4-a716-446655440000"
+	characterID = "01000000-0000-0000-0000-000000000000"
+)
+
+var (
+	character = db.Character{
+		ID:      pgtype.UUID{Bytes: [16]byte{1}, Valid: true},
+		UserID:  pgtype.UUID{Bytes: [16]byte{0x55, 0x0e, 0x84, 0x00, 0xe2, 0x9b, 0x41, 0xd4, 0xa7, 0x16, 0x44, 0x66, 0x55, 0x44, 0x00, 0x00}, Valid: true},
+		WorldID: pgtype.UUID{Bytes: [16]byte{9}, Valid: true},
+		X:       10,
+		Y:       10,
+	}
+	itemNames = []string{"Twigs", "Stone", "Minerals", "Fish", "Herbs", "Leaves", "Shells", "Grilled Fish", "Herbal Tea", "Metal Ingot", "Glass", "Wooden Chair", "Wooden Table", "Glass Lamp"}
+)
+
+func newTestService() (*Service, *fakeDB, *clock.Fake) {
+	database := &fakeDB{
+		Characters: testmocks.NewCharacters(character),
+		items:      make(map[string]db.Item),
+		inventory:  make(map[int32]int32),
+		stations:   make(map[int64]*entity.Entity),
+	}
+	for i, name := range itemNames {
+		database.items[name] = db.Item{ID: int32(i + 1), Name: name, StackSize: 64}
+	}
+	fake := clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	service := NewService(database, fakeStations{database}, testmocks.NopLogger[LoggerInterface]{})
+	service.SetClock(fake)
+	return service, database, fake
+}
+
+func (f *fakeDB) give(name string, quantity int32) {
+	f.inventory[f.items[name].ID] += quantity
+}
+
+func (f *fakeDB) count(name string) int32 {
+	return f.inventory[f.items[name].ID]
+}
+
+func TestRecipes(t *testing.T) {
+	service, _, _ := newTestService()
+
+	resp, err := service.Recipes(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, resp.StationTypes, 5)
+	require.Len(t, resp.Recipes, 7)
+	assert.Equal(t, "campfire", resp.Recipes[0].StationType)
+	assert.Equal(t, "Grilled Fish", resp.Recipes[0].Outputs[0].ItemName)
+	assert.Equal(t, int64(30), resp.Recipes[0].DurationSeconds)
+	assert.Equal(t, "workbench", resp.Recipes[6].StationType)
+	assert.Equal(t, []string{"campfire", "furnace", "workbench"}, service.RecipeStations(), "cottages and banks make nothing")
+}
+
+func TestPlaceStation(t *testing.T) {
+	service, database, _ := newTestService()
+	ctx := session.WithWorldID(context.Background(), uuid.PgtypeToString(character.WorldID))
+
+	_, err := service.PlaceStation(ctx, userID, characterID, "campfire")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "the cost must be paid")
+
+	database.give("Twigs", 5)
+	database.give("Stone", 4)
+	station, err := service.PlaceStation(ctx, userID, characterID, "campfire")
+	require.NoError(t, err)
+	assert.Equal(t, int32(10), station.X)
+	assert.Equal(t, characterID, station.OwnerCharacterId)
+	assert.Equal(t, int32(0), database.count("Twigs"))
+	assert.Equal(t, int32(1), database.count("Stone"))
+
+	owner, ok, err := entity.Get[entity.Owner](database.stations[station.EntityId].Components)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, characterID, owner.CharacterID)
+
+	_, err = service.PlaceStation(ctx, userID, characterID, "altar")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = service.PlaceStation(ctx, "650e8400-e29b-41d4-a716-446655440000", characterID, "campfire")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestProcessing_StartAndCollect(t *testing.T) {
+	service, database, fake := newTestService()
+	service.SetTimeScale(halfTime{})
+	ctx := session.WithWorldID(context.Background(), uuid.PgtypeToString(character.WorldID))
+	database.stations[1] = &entity.Entity{ID: 1, WorldID: character.WorldID, Type: "campfire", X: 11, Y: 9}
+	database.give("Fish", 2)
+	database.give("Twigs", 1)
+
+	job, err := service.StartProcessing(ctx, userID, characterID, "grilled_fish", 1)
+	require.NoError(t, err)
+	assert.False(t, job.Ready)
+	assert.Equal(t, fake.Now().Add(15*time.Second), job.ReadyAt.AsTime(), "durations follow the world's time scale")
+	assert.Equal(t, int32(0), database.count("Fish"), "inputs are taken when the job starts")
+
+	_, err = service.Collect(ctx, userID, characterID, job.Id)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "jobs can't be collected early")
+
+	fake.Advance(15 * time.Second)
+	jobs, err := service.ListJobs(ctx, userID, characterID)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	assert.True(t, jobs[0].Ready)
+
+	items, err := service.Collect(ctx, userID, characterID, job.Id)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "Grilled Fish", items[0].ItemName)
+	assert.Equal(t, int32(2), database.count("Grilled Fish"))
+
+	_, err = service.Collect(ctx, userID, characterID, job.Id)
+	assert.Equal(t, codes.NotFound, status.Code(err), "a job is collected once")
+}
+
+func TestStartProcessing_Station(t *testing.T) {
+	service, database, _ := newTestService()
+	ctx := session.WithWorldID(context.Background(), uuid.PgtypeToString(character.WorldID))
+	database.stations[1] = &entity.Entity{ID: 1, WorldID: character.WorldID, Type: "campfire", X: 10, Y: 10}
+	database.stations[2] = &entity.Entity{ID: 2, WorldID: character.WorldID, Type: "furnace", X: 20, Y: 10}
+	database.stations[3] = &entity.Entity{ID: 3, WorldID: character.WorldID, Type: "furnace", X: 11, Y: 11}
+	database.give("Minerals", 3)
+	database.give("Twigs", 2)
+
+	_, err := service.StartProcessing(ctx, userID, characterID, "metal_ingot", 1)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "the recipe needs a furnace")
+	_, err = service.StartProcessing(ctx, userID, characterID, "metal_ingot", 2)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "the furnace is out of range")
+	_, err = service.StartProcessing(ctx, userID, characterID, "metal_ingot", 9)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = service.StartProcessing(ctx, userID, characterID, "bread", 3)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = service.StartProcessing(ctx, userID, characterID, "metal_ingot", 3)
+	require.NoError(t, err)
+	_, err = service.StartProcessing(ctx, userID, characterID, "metal_ingot", 3)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "the ingredients were used up")
+}
+
+func TestNotifyReady(t *testing.T) {
+	service, database, fake := newTestService()
+	ctx := session.WithWorldID(context.Background(), uuid.PgtypeToString(character.WorldID))
+	database.stations[1] = &entity.Entity{ID: 1, WorldID: character.WorldID, Type: "campfire", X: 10, Y: 10}
+	database.give("Herbs", 6)
+	database.give("Leaves", 2)
+
+	_, err := service.StartProcessing(ctx, userID, characterID, "herbal_tea", 1)
+	require.NoError(t, err)
+	notified, err := service.NotifyReady(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, notified)
+
+	fake.Advance(20 * time.Second)
+	notified, err = service.NotifyReady(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, notified)
+	require.Len(t, database.notified, 1)
+	assert.Equal(t, userID, database.notified[0].UserID)
+	assert.Equal(t, "Herbal Tea", database.notified[0].RecipeName)
+
+	notified, err = service.NotifyReady(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, notified, "jobs are notified once")
+}
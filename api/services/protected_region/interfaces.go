@@ -0,0 +1,90 @@
+package protected_region
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/charmbracelet/log"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DatabaseInterface abstracts database operations for protected regions.
+type DatabaseInterface interface {
+	CreateProtectedRegion(ctx context.Context, arg db.CreateProtectedRegionParams) (db.ProtectedRegion, error)
+	UpdateProtectedRegion(ctx context.Context, arg db.UpdateProtectedRegionParams) (db.ProtectedRegion, error)
+	DeleteProtectedRegion(ctx context.Context, id int64) (db.ProtectedRegion, error)
+	ListProtectedRegions(ctx context.Context, worldID pgtype.UUID) ([]db.ProtectedRegion, error)
+	ListProtectedRegionsInBounds(ctx context.Context, arg db.ListProtectedRegionsInBoundsParams) ([]db.ProtectedRegion, error)
+}
+
+// DatabaseWrapper implements DatabaseInterface using the actual database connection.
+type DatabaseWrapper struct {
+	queries *db.Queries
+}
+
+// NewDatabaseWrapper creates a new database wrapper with the given connection pool.
+func NewDatabaseWrapper(pool storage.Pool) DatabaseInterface {
+	return &DatabaseWrapper{
+		queries: db.New(pool),
+	}
+}
+
+func (d *DatabaseWrapper) CreateProtectedRegion(ctx context.Context, arg db.CreateProtectedRegionParams) (db.ProtectedRegion, error) {
+	return d.queries.CreateProtectedRegion(ctx, arg)
+}
+
+func (d *DatabaseWrapper) UpdateProtectedRegion(ctx context.Context, arg db.UpdateProtectedRegionParams) (db.ProtectedRegion, error) {
+	return d.queries.UpdateProtectedRegion(ctx, arg)
+}
+
+func (d *DatabaseWrapper) DeleteProtectedRegion(ctx context.Context, id int64) (db.ProtectedRegion, error) {
+	return d.queries.DeleteProtectedRegion(ctx, id)
+}
+
+func (d *DatabaseWrapper) ListProtectedRegions(ctx context.Context, worldID pgtype.UUID) ([]db.ProtectedRegion, error) {
+	return d.queries.ListProtectedRegions(ctx, worldID)
+}
+
+func (d *DatabaseWrapper) ListProtectedRegionsInBounds(ctx context.Context, arg db.ListProtectedRegionsInBoundsParams) ([]db.ProtectedRegion, error) {
+	return d.queries.ListProtectedRegionsInBounds(ctx, arg)
+}
+
+// LoggerInterface abstracts logging operations for dependency injection.
+type LoggerInterface interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+	With(keysAndValues ...interface{}) LoggerInterface
+}
+
+// DefaultLoggerWrapper wraps the internal logging package.
+type DefaultLoggerWrapper struct {
+	logger *log.Logger
+}
+
+func NewDefaultLoggerWrapper() LoggerInterface {
+	return &DefaultLoggerWrapper{logger: logging.GetLogger()}
+}
+
+func (l *DefaultLoggerWrapper) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.Debug(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Info(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.Warn(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.Error(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) With(keysAndValues ...interface{}) LoggerInterface {
+	return &DefaultLoggerWrapper{logger: l.logger.With(keysAndValues...)}
+}
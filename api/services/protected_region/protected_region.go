@@ -0,0 +1,352 @@
+// Package protected_region manages protected regions: admin-defined areas of a world,
+// given as a polygon or a rectangle of chunks, whose flags restrict what players can do
+// inside them. Gameplay services call CheckAllowed before acting on a cell; chunk
+// responses carry the regions overlapping the requested chunks so clients can draw them.
+package protected_region
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/chunkdata"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	MaxNameLength = 100
+	MaxVertices   = 256
+)
+
+// flagNames are the stored names of region flags
+var flagNames = map[chunkV1.RegionFlag]string{
+	chunkV1.RegionFlag_REGION_FLAG_NO_HARVEST: "no_harvest",
+	chunkV1.RegionFlag_REGION_FLAG_NO_BUILD:   "no_build",
+	chunkV1.RegionFlag_REGION_FLAG_NO_PVP:     "no_pvp",
+	chunkV1.RegionFlag_REGION_FLAG_SAFE_ZONE:  "safe_zone",
+}
+
+// flagActions describe what a flag forbids, for error messages
+var flagActions = map[chunkV1.RegionFlag]string{
+	chunkV1.RegionFlag_REGION_FLAG_NO_HARVEST: "harvesting",
+	chunkV1.RegionFlag_REGION_FLAG_NO_BUILD:   "modifying terrain",
+	chunkV1.RegionFlag_REGION_FLAG_NO_PVP:     "attacking players",
+	chunkV1.RegionFlag_REGION_FLAG_SAFE_ZONE:  "combat",
+}
+
+// Definition is what an admin sets on a region. Exactly one of Polygon and ChunkRect
+// gives its shape.
+type Definition struct {
+	Name      string
+	Flags     []chunkV1.RegionFlag
+	Polygon   []*chunkV1.RegionPoint
+	ChunkRect *chunkV1.ChunkRect
+}
+
+// Service stores protected regions and checks actions against them.
+type Service struct {
+	db     DatabaseInterface
+	logger LoggerInterface
+	clock  clock.Clock
+}
+
+// NewService creates a new protected region service with dependency injection.
+func NewService(db DatabaseInterface, logger LoggerInterface) *Service {
+	componentLogger := logger.With("component", "protected-region-service")
+	componentLogger.Debug("Creating new protected region service")
+	return &Service{
+		db:     db,
+		logger: componentLogger,
+		clock:  clock.New(),
+	}
+}
+
+// NewServiceWithPool creates a service with concrete implementations (convenience constructor for production use).
+func NewServiceWithPool(pool storage.Pool) *Service {
+	return NewService(NewDatabaseWrapper(pool), NewDefaultLoggerWrapper())
+}
+
+// SetClock replaces the clock used for timestamps (for simulation tests)
+func (s *Service) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// shape is a validated region: its name, stored flags, flattened vertices and bounds
+type shape struct {
+	name                   string
+	flags                  []string
+	polygon                []int32
+	minX, minY, maxX, maxY int32
+}
+
+// validate checks a definition and converts it to its stored form
+func validate(def Definition) (shape, error) {
+	var sh shape
+	sh.name = strings.TrimSpace(def.Name)
+	if sh.name == "" {
+		return sh, status.Errorf(codes.InvalidArgument, "name is required")
+	}
+	if utf8.RuneCountInString(sh.name) > MaxNameLength {
+		return sh, status.Errorf(codes.InvalidArgument, "name must be at most %d characters", MaxNameLength)
+	}
+
+	if len(def.Flags) == 0 {
+		return sh, status.Errorf(codes.InvalidArgument, "at least one flag is required")
+	}
+	for _, flag := range def.Flags {
+		name, ok := flagNames[flag]
+		if !ok {
+			return sh, status.Errorf(codes.InvalidArgument, "unknown flag %s", flag)
+		}
+		sh.flags = append(sh.flags, name)
+	}
+	sh.flags = slices.Compact(slices.Sorted(slices.Values(sh.flags)))
+
+	switch {
+	case def.ChunkRect != nil && len(def.Polygon) > 0:
+		return sh, status.Errorf(codes.InvalidArgument, "set either polygon or chunk_rect, not both")
+	case def.ChunkRect != nil:
+		rect := def.ChunkRect
+		if rect.MaxChunkX < rect.MinChunkX || rect.MaxChunkY < rect.MinChunkY {
+			return sh, status.Errorf(codes.InvalidArgument, "max chunk coordinates must not be less than min")
+		}
+		left, top := rect.MinChunkX*chunkdata.Size, rect.MinChunkY*chunkdata.Size
+		right, bottom := (rect.MaxChunkX+1)*chunkdata.Size, (rect.MaxChunkY+1)*chunkdata.Size
+		sh.polygon = []int32{left, top, right, top, right, bottom, left, bottom}
+	case len(def.Polygon) < 3:
+		return sh, status.Errorf(codes.InvalidArgument, "a polygon needs at least three vertices")
+	case len(def.Polygon) > MaxVertices:
+		return sh, status.Errorf(codes.InvalidArgument, "a polygon may have at most %d vertices", MaxVertices)
+	default:
+		for _, point := range def.Polygon {
+			sh.polygon = append(sh.polygon, point.GetX(), point.GetY())
+		}
+	}
+
+	sh.minX, sh.minY, sh.maxX, sh.maxY = bounds(sh.polygon)
+	if sh.minX == sh.maxX || sh.minY == sh.maxY {
+		return sh, status.Errorf(codes.InvalidArgument, "the polygon has no area")
+	}
+	return sh, nil
+}
+
+// bounds returns the bounding box of flattened vertices
+func bounds(polygon []int32) (minX, minY, maxX, maxY int32) {
+	minX, minY, maxX, maxY = polygon[0], polygon[1], polygon[0], polygon[1]
+	for i := 2; i < len(polygon); i += 2 {
+		minX, maxX = min(minX, polygon[i]), max(maxX, polygon[i])
+		minY, maxY = min(minY, polygon[i+1]), max(maxY, polygon[i+1])
+	}
+	return minX, minY, maxX, maxY
+}
+
+// contains reports whether the centre of cell (x, y) lies inside the polygon (even-odd
+// rule). Coordinates are doubled so the centre and vertices are integers; a centre can
+// never lie on a horizontal edge, so no ray passes through a vertex.
+func contains(polygon []int32, x, y int32) bool {
+	px, py := 2*int64(x)+1, 2*int64(y)+1
+	inside := false
+	n := len(polygon) / 2
+	for i, j := 0, n-1; i < n; j, i = i, i+1 {
+		xi, yi := 2*int64(polygon[2*i]), 2*int64(polygon[2*i+1])
+		xj, yj := 2*int64(polygon[2*j]), 2*int64(polygon[2*j+1])
+		if (yi > py) == (yj > py) {
+			continue
+		}
+		// The edge crosses the row; is the crossing right of the centre?
+		// px < xi + (xj-xi)*(py-yi)/(yj-yi), compared without division
+		lhs, rhs := (px-xi)*(yj-yi), (xj-xi)*(py-yi)
+		if (yj > yi && lhs < rhs) || (yj < yi && lhs > rhs) {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// resolveWorldID parses the world ID, defaulting to the caller's session world
+func resolveWorldID(ctx context.Context, worldID string) (pgtype.UUID, error) {
+	if worldID == "" {
+		sessionWorldID, ok := session.WorldIDFromContext(ctx)
+		if !ok {
+			return pgtype.UUID{}, status.Errorf(codes.InvalidArgument, "world_id is required")
+		}
+		worldID = sessionWorldID
+	}
+	id, err := uuid.StringToPgtype(worldID)
+	if err != nil {
+		return pgtype.UUID{}, status.Errorf(codes.InvalidArgument, "invalid world ID format")
+	}
+	return id, nil
+}
+
+// CreateRegion stores a new region. The world defaults to the caller's session world.
+func (s *Service) CreateRegion(ctx context.Context, createdBy, worldID string, def Definition) (*chunkV1.ProtectedRegion, error) {
+	creator, err := uuid.StringToPgtype(createdBy)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID format")
+	}
+	world, err := resolveWorldID(ctx, worldID)
+	if err != nil {
+		return nil, err
+	}
+	sh, err := validate(def)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := s.db.CreateProtectedRegion(ctx, db.CreateProtectedRegionParams{
+		WorldID:   world,
+		Name:      sh.name,
+		Flags:     sh.flags,
+		Polygon:   sh.polygon,
+		MinX:      sh.minX,
+		MinY:      sh.minY,
+		MaxX:      sh.maxX,
+		MaxY:      sh.maxY,
+		CreatedBy: creator,
+		CreatedAt: pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
+	})
+	if err != nil {
+		s.logger.Error("Failed to store protected region", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to create protected region")
+	}
+
+	s.logger.Info("Protected region created", "id", row.ID, "name", row.Name, "flags", row.Flags, "created_by", createdBy)
+	return dbRegionToProto(row), nil
+}
+
+// UpdateRegion replaces a region's name, flags and shape
+func (s *Service) UpdateRegion(ctx context.Context, id int64, def Definition) (*chunkV1.ProtectedRegion, error) {
+	sh, err := validate(def)
+	if err != nil {
+		return nil, err
+	}
+	row, err := s.db.UpdateProtectedRegion(ctx, db.UpdateProtectedRegionParams{
+		ID:        id,
+		Name:      sh.name,
+		Flags:     sh.flags,
+		Polygon:   sh.polygon,
+		MinX:      sh.minX,
+		MinY:      sh.minY,
+		MaxX:      sh.maxX,
+		MaxY:      sh.maxY,
+		UpdatedAt: pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "protected region not found")
+	}
+	if err != nil {
+		s.logger.Error("Failed to update protected region", "id", id, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to update protected region")
+	}
+
+	s.logger.Info("Protected region updated", "id", row.ID, "name", row.Name, "flags", row.Flags)
+	return dbRegionToProto(row), nil
+}
+
+// DeleteRegion removes a region, returning it as it was
+func (s *Service) DeleteRegion(ctx context.Context, id int64) (*chunkV1.ProtectedRegion, error) {
+	row, err := s.db.DeleteProtectedRegion(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "protected region not found")
+	}
+	if err != nil {
+		s.logger.Error("Failed to delete protected region", "id", id, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to delete protected region")
+	}
+
+	s.logger.Info("Protected region deleted", "id", row.ID, "name", row.Name)
+	return dbRegionToProto(row), nil
+}
+
+// ListRegions returns every region in a world, oldest first. The world defaults to the
+// caller's session world.
+func (s *Service) ListRegions(ctx context.Context, worldID string) ([]*chunkV1.ProtectedRegion, error) {
+	world, err := resolveWorldID(ctx, worldID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.db.ListProtectedRegions(ctx, world)
+	if err != nil {
+		s.logger.Error("Failed to list protected regions", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list protected regions")
+	}
+	return dbRegionsToProto(rows), nil
+}
+
+// RegionsInChunks returns the regions whose bounds overlap a rectangle of chunks
+func (s *Service) RegionsInChunks(ctx context.Context, worldID pgtype.UUID, minX, maxX, minY, maxY int32) ([]*chunkV1.ProtectedRegion, error) {
+	rows, err := s.db.ListProtectedRegionsInBounds(ctx, db.ListProtectedRegionsInBoundsParams{
+		WorldID: worldID,
+		MinX:    minX * chunkdata.Size,
+		MaxX:    (maxX + 1) * chunkdata.Size,
+		MinY:    minY * chunkdata.Size,
+		MaxY:    (maxY + 1) * chunkdata.Size,
+	})
+	if err != nil {
+		s.logger.Error("Failed to list protected regions in chunks", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to get protected regions")
+	}
+	return dbRegionsToProto(rows), nil
+}
+
+// CheckAllowed returns a FailedPrecondition error when cell (x, y) lies in a region
+// with the flag
+func (s *Service) CheckAllowed(ctx context.Context, worldID pgtype.UUID, x, y int32, flag chunkV1.RegionFlag) error {
+	rows, err := s.db.ListProtectedRegionsInBounds(ctx, db.ListProtectedRegionsInBoundsParams{
+		WorldID: worldID,
+		MinX:    x,
+		MaxX:    x + 1,
+		MinY:    y,
+		MaxY:    y + 1,
+	})
+	if err != nil {
+		s.logger.Error("Failed to check protected regions", "x", x, "y", y, "error", err)
+		return status.Errorf(codes.Internal, "failed to check protected regions")
+	}
+	for _, row := range rows {
+		if slices.Contains(row.Flags, flagNames[flag]) && contains(row.Polygon, x, y) {
+			return status.Errorf(codes.FailedPrecondition, "%s is not allowed in %s", flagActions[flag], row.Name)
+		}
+	}
+	return nil
+}
+
+func dbRegionsToProto(rows []db.ProtectedRegion) []*chunkV1.ProtectedRegion {
+	regions := make([]*chunkV1.ProtectedRegion, 0, len(rows))
+	for _, row := range rows {
+		regions = append(regions, dbRegionToProto(row))
+	}
+	return regions
+}
+
+func dbRegionToProto(row db.ProtectedRegion) *chunkV1.ProtectedRegion {
+	region := &chunkV1.ProtectedRegion{
+		Id:        row.ID,
+		Name:      row.Name,
+		CreatedAt: timestamppb.New(row.CreatedAt.Time),
+		UpdatedAt: timestamppb.New(row.UpdatedAt.Time),
+	}
+	for flag, name := range flagNames {
+		if slices.Contains(row.Flags, name) {
+			region.Flags = append(region.Flags, flag)
+		}
+	}
+	slices.Sort(region.Flags)
+	for i := 0; i+1 < len(row.Polygon); i += 2 {
+		region.Polygon = append(region.Polygon, &chunkV1.RegionPoint{X: row.Polygon[i], Y: row.Polygon[i+1]})
+	}
+	return region
+}
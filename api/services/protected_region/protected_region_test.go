@@ -0,0 +1,199 @@
+package protected_region
+
+import (
+	"context"
+	"testing"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeDB keeps regions in memory; deleted regions leave a zero row behind
+type fakeDB struct {
+	regions []db.ProtectedRegion
+}
+
+func (f *fakeDB) CreateProtectedRegion(ctx context.Context, arg db.CreateProtectedRegionParams) (db.ProtectedRegion, error) {
+	row := db.ProtectedRegion{
+		ID:        int64(len(f.regions) + 1),
+		WorldID:   arg.WorldID,
+		Name:      arg.Name,
+		Flags:     arg.Flags,
+		Polygon:   arg.Polygon,
+		MinX:      arg.MinX,
+		MinY:      arg.MinY,
+		MaxX:      arg.MaxX,
+		MaxY:      arg.MaxY,
+		CreatedBy: arg.CreatedBy,
+		CreatedAt: arg.CreatedAt,
+		UpdatedAt: arg.CreatedAt,
+	}
+	f.regions = append(f.regions, row)
+	return row, nil
+}
+
+func (f *fakeDB) UpdateProtectedRegion(ctx context.Context, arg db.UpdateProtectedRegionParams) (db.ProtectedRegion, error) {
+	if arg.ID < 1 || arg.ID > int64(len(f.regions)) || f.regions[arg.ID-1].ID == 0 {
+		return db.ProtectedRegion{}, pgx.ErrNoRows
+	}
+	row := &f.regions[arg.ID-1]
+	row.Name, row.Flags, row.Polygon = arg.Name, arg.Flags, arg.Polygon
+	row.MinX, row.MinY, row.MaxX, row.MaxY = arg.MinX, arg.MinY, arg.MaxX, arg.MaxY
+	row.UpdatedAt = arg.UpdatedAt
+	return *row, nil
+}
+
+func (f *fakeDB) DeleteProtectedRegion(ctx context.Context, id int64) (db.ProtectedRegion, error) {
+	if id < 1 || id > int64(len(f.regions)) || f.regions[id-1].ID == 0 {
+		return db.ProtectedRegion{}, pgx.ErrNoRows
+	}
+	row := f.regions[id-1]
+	f.regions[id-1] = db.ProtectedRegion{}
+	return row, nil
+}
+
+func (f *fakeDB) ListProtectedRegions(ctx context.Context, worldID pgtype.UUID) ([]db.ProtectedRegion, error) {
+	var rows []db.ProtectedRegion
+	for _, row := range f.regions {
+		if row.ID != 0 && row.WorldID == worldID {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+func (f *fakeDB) ListProtectedRegionsInBounds(ctx context.Context, arg db.ListProtectedRegionsInBoundsParams) ([]db.ProtectedRegion, error) {
+	var rows []db.ProtectedRegion
+	for _, row := range f.regions {
+		if row.ID != 0 && row.WorldID == arg.WorldID &&
+			row.MinX < arg.MaxX && row.MaxX > arg.MinX && row.MinY < arg.MaxY && row.MaxY > arg.MinY {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+const (
+	adminID = "00000000-0000-0000-0000-000000000001"
+	worldID = "00000000-0000-0000-0000-0000000000aa"
+)
+
+func points(coords ...int32) []*chunkV1.RegionPoint {
+	var result []*chunkV1.RegionPoint
+	for i := 0; i < len(coords); i += 2 {
+		result = append(result, &chunkV1.RegionPoint{X: coords[i], Y: coords[i+1]})
+	}
+	return result
+}
+
+func TestContains(t *testing.T) {
+	// An L shape: the 4x4 square at the origin without its top-right 2x2 quarter
+	l := []int32{0, 0, 2, 0, 2, 2, 4, 2, 4, 4, 0, 4}
+	assert.True(t, contains(l, 0, 0))
+	assert.True(t, contains(l, 1, 1))
+	assert.False(t, contains(l, 2, 0), "the cut-out quarter")
+	assert.False(t, contains(l, 3, 1))
+	assert.True(t, contains(l, 3, 3))
+	assert.False(t, contains(l, 4, 3), "the right edge is exclusive")
+	assert.False(t, contains(l, -1, 2))
+
+	triangle := []int32{0, 0, 10, 0, 0, 10}
+	assert.True(t, contains(triangle, 4, 4), "centre (4.5, 4.5) is below the hypotenuse")
+	assert.False(t, contains(triangle, 5, 5))
+}
+
+func TestValidate(t *testing.T) {
+	noHarvest := []chunkV1.RegionFlag{chunkV1.RegionFlag_REGION_FLAG_NO_HARVEST}
+
+	sh, err := validate(Definition{Name: " Spawn ", Flags: noHarvest, ChunkRect: &chunkV1.ChunkRect{MinChunkX: -1, MinChunkY: 0, MaxChunkX: 0, MaxChunkY: 0}})
+	require.NoError(t, err)
+	assert.Equal(t, "Spawn", sh.name)
+	assert.Equal(t, []int32{-32, 0, 32, 0, 32, 32, -32, 32}, sh.polygon, "chunk rectangles become their corners")
+	assert.Equal(t, [4]int32{-32, 0, 32, 32}, [4]int32{sh.minX, sh.minY, sh.maxX, sh.maxY})
+
+	sh, err = validate(Definition{Name: "Town", Flags: []chunkV1.RegionFlag{4, 1, 4}, Polygon: points(0, 0, 5, 0, 0, 5)})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"no_harvest", "safe_zone"}, sh.flags)
+
+	for _, tt := range []struct {
+		def  Definition
+		want string
+	}{
+		{Definition{Flags: noHarvest, Polygon: points(0, 0, 5, 0, 0, 5)}, "name is required"},
+		{Definition{Name: "Town", Polygon: points(0, 0, 5, 0, 0, 5)}, "at least one flag"},
+		{Definition{Name: "Town", Flags: []chunkV1.RegionFlag{0}, Polygon: points(0, 0, 5, 0, 0, 5)}, "unknown flag"},
+		{Definition{Name: "Town", Flags: noHarvest, Polygon: points(0, 0, 5, 0)}, "at least three vertices"},
+		{Definition{Name: "Town", Flags: noHarvest, Polygon: points(0, 0, 5, 0, 9, 0)}, "no area"},
+		{Definition{Name: "Town", Flags: noHarvest, Polygon: points(0, 0, 5, 0, 0, 5), ChunkRect: &chunkV1.ChunkRect{}}, "not both"},
+		{Definition{Name: "Town", Flags: noHarvest, ChunkRect: &chunkV1.ChunkRect{MinChunkX: 1}}, "must not be less than"},
+	} {
+		_, err := validate(tt.def)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), tt.want)
+		assert.ErrorContains(t, err, tt.want)
+	}
+}
+
+func TestService_RegionLifecycle(t *testing.T) {
+	service := NewService(&fakeDB{}, testmocks.NopLogger[LoggerInterface]{})
+	ctx := session.WithWorldID(context.Background(), worldID)
+	world, err := uuid.StringToPgtype(worldID)
+	require.NoError(t, err)
+
+	_, err = service.CreateRegion(context.Background(), adminID, "", Definition{Name: "Spawn"})
+	assert.ErrorContains(t, err, "world_id is required")
+
+	region, err := service.CreateRegion(ctx, adminID, "", Definition{
+		Name:      "Spawn",
+		Flags:     []chunkV1.RegionFlag{chunkV1.RegionFlag_REGION_FLAG_NO_HARVEST},
+		ChunkRect: &chunkV1.ChunkRect{},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []chunkV1.RegionFlag{chunkV1.RegionFlag_REGION_FLAG_NO_HARVEST}, region.Flags)
+	assert.Len(t, region.Polygon, 4)
+
+	harvest := chunkV1.RegionFlag_REGION_FLAG_NO_HARVEST
+	err = service.CheckAllowed(ctx, world, 31, 31, harvest)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.ErrorContains(t, err, "harvesting is not allowed in Spawn")
+	assert.NoError(t, service.CheckAllowed(ctx, world, 32, 31, harvest), "outside the region")
+	assert.NoError(t, service.CheckAllowed(ctx, world, 0, 0, chunkV1.RegionFlag_REGION_FLAG_NO_BUILD), "flags the region does not have")
+
+	inChunks, err := service.RegionsInChunks(ctx, world, 0, 0, 0, 0)
+	require.NoError(t, err)
+	assert.Len(t, inChunks, 1)
+	inChunks, err = service.RegionsInChunks(ctx, world, 1, 2, 0, 0)
+	require.NoError(t, err)
+	assert.Empty(t, inChunks)
+
+	updated, err := service.UpdateRegion(ctx, region.Id, Definition{
+		Name:    "Spawn",
+		Flags:   []chunkV1.RegionFlag{chunkV1.RegionFlag_REGION_FLAG_NO_BUILD},
+		Polygon: points(0, 0, 8, 0, 0, 8),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []chunkV1.RegionFlag{chunkV1.RegionFlag_REGION_FLAG_NO_BUILD}, updated.Flags)
+	assert.NoError(t, service.CheckAllowed(ctx, world, 31, 31, harvest))
+	assert.Error(t, service.CheckAllowed(ctx, world, 1, 1, chunkV1.RegionFlag_REGION_FLAG_NO_BUILD))
+	assert.NoError(t, service.CheckAllowed(ctx, world, 6, 6, chunkV1.RegionFlag_REGION_FLAG_NO_BUILD), "inside the bounds but not the polygon")
+
+	listed, err := service.ListRegions(ctx, "")
+	require.NoError(t, err)
+	assert.Len(t, listed, 1)
+
+	_, err = service.DeleteRegion(ctx, region.Id)
+	require.NoError(t, err)
+	_, err = service.DeleteRegion(ctx, region.Id)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = service.UpdateRegion(ctx, region.Id, Definition{Name: "Spawn", Flags: updated.Flags, Polygon: points(0, 0, 8, 0, 0, 8)})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.NoError(t, service.CheckAllowed(ctx, world, 1, 1, chunkV1.RegionFlag_REGION_FLAG_NO_BUILD))
+}
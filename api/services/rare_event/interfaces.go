@@ -0,0 +1,233 @@
+package rare_event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/outbox"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/txn"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
+	mailV1 "github.com/VoidMesh/api/api/proto/mail/v1"
+	notificationV1 "github.com/VoidMesh/api/api/proto/notification/v1"
+	"github.com/VoidMesh/api/api/services/mail"
+	"github.com/charmbracelet/log"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DatabaseInterface abstracts database operations for rare events.
+type DatabaseInterface interface {
+	GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error)
+	GetItemByName(ctx context.Context, name string) (db.Item, error)
+	GetRareEvent(ctx context.Context, id int64) (db.RareEvent, error)
+	ListActiveRareEvents(ctx context.Context, worldID pgtype.UUID) ([]db.RareEvent, error)
+	ListRecentlyAccessedChunks(ctx context.Context, arg db.ListRecentlyAccessedChunksParams) ([]db.ListRecentlyAccessedChunksRow, error)
+	ExpireRareEvents(ctx context.Context, now pgtype.Timestamp) ([]db.RareEvent, error)
+	ListUnrewardedRareEvents(ctx context.Context, limit int32) ([]db.RareEvent, error)
+	MarkRareEventRewarded(ctx context.Context, arg db.MarkRareEventRewardedParams) (int64, error)
+	ListRareEventContributions(ctx context.Context, rareEventID int64) ([]db.RareEventContribution, error)
+	SpawnRareEvent(ctx context.Context, event db.CreateRareEventParams, maxActive int64, spawnedAfter pgtype.Timestamp) (db.RareEvent, error)
+	Contribute(ctx context.Context, contribution db.AddRareEventContributionParams) (db.RareEvent, int32, error)
+}
+
+// MailerInterface sends the rewards of completed events.
+type MailerInterface interface {
+	SendSystem(ctx context.Context, m mail.SystemMail) (*mailV1.Mail, error)
+}
+
+// AnnouncerInterface announces events to every player on every instance.
+type AnnouncerInterface interface {
+	Announce(ctx context.Context, createdBy, message string, severity notificationV1.AnnouncementSeverity, expiresAt *timestamppb.Timestamp) (*notificationV1.Announcement, error)
+}
+
+// TimeScaleInterface speeds up the schedule of a world for testing.
+type TimeScaleInterface interface {
+	Scale(ctx context.Context, worldID pgtype.UUID, d time.Duration) time.Duration
+}
+
+// WorldSeedsInterface looks up the seed of a world, which event placement derives from.
+type WorldSeedsInterface interface {
+	Seed(ctx context.Context, worldID pgtype.UUID) (int64, error)
+}
+
+// PauseInterface reports worlds an admin has paused.
+type PauseInterface interface {
+	Paused(ctx context.Context, worldID pgtype.UUID) bool
+}
+
+// StatusEffectInterface grants the status effects of completed events.
+type StatusEffectInterface interface {
+	Apply(ctx context.Context, characterID pgtype.UUID, key, source string) (*characterV1.StatusEffect, error)
+}
+
+// DatabaseWrapper implements DatabaseInterface using the actual database connection.
+type DatabaseWrapper struct {
+	pool    storage.Pool
+	queries *db.Queries
+}
+
+// NewDatabaseWrapper creates a new database wrapper with the given connection pool.
+func NewDatabaseWrapper(pool storage.Pool) DatabaseInterface {
+	return &DatabaseWrapper{
+		pool:    pool,
+		queries: db.New(pool),
+	}
+}
+
+func (d *DatabaseWrapper) GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error) {
+	return d.queries.GetCharacterById(ctx, id)
+}
+
+func (d *DatabaseWrapper) GetItemByName(ctx context.Context, name string) (db.Item, error) {
+	return d.queries.GetItemByName(ctx, name)
+}
+
+func (d *DatabaseWrapper) GetRareEvent(ctx context.Context, id int64) (db.RareEvent, error) {
+	return d.queries.GetRareEvent(ctx, id)
+}
+
+func (d *DatabaseWrapper) ListActiveRareEvents(ctx context.Context, worldID pgtype.UUID) ([]db.RareEvent, error) {
+	return d.queries.ListActiveRareEvents(ctx, worldID)
+}
+
+func (d *DatabaseWrapper) ListRecentlyAccessedChunks(ctx context.Context, arg db.ListRecentlyAccessedChunksParams) ([]db.ListRecentlyAccessedChunksRow, error) {
+	return d.queries.ListRecentlyAccessedChunks(ctx, arg)
+}
+
+func (d *DatabaseWrapper) ExpireRareEvents(ctx context.Context, now pgtype.Timestamp) ([]db.RareEvent, error) {
+	return d.queries.ExpireRareEvents(ctx, now)
+}
+
+func (d *DatabaseWrapper) ListUnrewardedRareEvents(ctx context.Context, limit int32) ([]db.RareEvent, error) {
+	return d.queries.ListUnrewardedRareEvents(ctx, limit)
+}
+
+func (d *DatabaseWrapper) MarkRareEventRewarded(ctx context.Context, arg db.MarkRareEventRewardedParams) (int64, error) {
+	return d.queries.MarkRareEventRewarded(ctx, arg)
+}
+
+func (d *DatabaseWrapper) ListRareEventContributions(ctx context.Context, rareEventID int64) ([]db.RareEventContribution, error) {
+	return d.queries.ListRareEventContributions(ctx, rareEventID)
+}
+
+// SpawnRareEvent stores the event unless the world already has maxActive events in
+// progress or one spawned after spawnedAfter, failing with ErrNotDue. The checks and the
+// insert run in one serializable transaction, so instances spawning at the same time
+// cannot both pass them.
+func (d *DatabaseWrapper) SpawnRareEvent(ctx context.Context, event db.CreateRareEventParams, maxActive int64, spawnedAfter pgtype.Timestamp) (db.RareEvent, error) {
+	var created db.RareEvent
+	err := txn.Run(ctx, d.pool, txn.Serializable, func(q *db.Queries) error {
+		active, err := q.CountActiveRareEvents(ctx, event.WorldID)
+		if err != nil {
+			return fmt.Errorf("failed to count active events: %w", err)
+		}
+		recent, err := q.CountRareEventsSpawnedSince(ctx, db.CountRareEventsSpawnedSinceParams{WorldID: event.WorldID, Since: spawnedAfter})
+		if err != nil {
+			return fmt.Errorf("failed to count recent events: %w", err)
+		}
+		if active >= maxActive || recent > 0 {
+			return ErrNotDue
+		}
+		created, err = q.CreateRareEvent(ctx, event)
+		if err != nil {
+			return err
+		}
+		return enqueueRareEvent(ctx, q, events.RareEventSpawned, created, "")
+	})
+	return created, err
+}
+
+// Contribute records a contribution and adds it to the event's progress in one
+// transaction, returning the event and the character's total contribution. It fails with
+// ErrCooldown when the character contributed too recently and ErrEventOver when the event
+// has ended.
+func (d *DatabaseWrapper) Contribute(ctx context.Context, contribution db.AddRareEventContributionParams) (db.RareEvent, int32, error) {
+	var event db.RareEvent
+	var total int32
+	err := txn.Run(ctx, d.pool, txn.ReadCommitted, func(q *db.Queries) error {
+		var err error
+		total, err = q.AddRareEventContribution(ctx, contribution)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrCooldown
+		}
+		if err != nil {
+			return fmt.Errorf("failed to record contribution: %w", err)
+		}
+		event, err = q.AdvanceRareEvent(ctx, db.AdvanceRareEventParams{
+			ID:     contribution.RareEventID,
+			Amount: contribution.Amount,
+			Now:    contribution.Now,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrEventOver
+		}
+		if err != nil || event.Outcome.String != "completed" {
+			return err
+		}
+		return enqueueRareEvent(ctx, q, events.RareEventCompleted, event, uuid.PgtypeToString(contribution.CharacterID))
+	})
+	return event, total, err
+}
+
+// enqueueRareEvent records a RareEventSpawned or RareEventCompleted event for the world timeline
+func enqueueRareEvent(ctx context.Context, q *db.Queries, eventType string, event db.RareEvent, characterID string) error {
+	return outbox.Enqueue(ctx, q, eventType, strconv.FormatInt(event.ID, 10),
+		fmt.Sprintf("%s:%d", eventType, event.ID),
+		events.RareEventPayload{
+			RareEventID: event.ID,
+			WorldID:     uuid.PgtypeToString(event.WorldID),
+			Kind:        event.Kind,
+			X:           event.X,
+			Y:           event.Y,
+			ChunkX:      event.ChunkX,
+			ChunkY:      event.ChunkY,
+			CharacterID: characterID,
+		})
+}
+
+// LoggerInterface abstracts logging operations for dependency injection.
+type LoggerInterface interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+	With(keysAndValues ...interface{}) LoggerInterface
+}
+
+// DefaultLoggerWrapper wraps the internal logging package.
+type DefaultLoggerWrapper struct {
+	logger *log.Logger
+}
+
+func NewDefaultLoggerWrapper() LoggerInterface {
+	return &DefaultLoggerWrapper{logger: logging.GetLogger()}
+}
+
+func (l *DefaultLoggerWrapper) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.Debug(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Info(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.Warn(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.Error(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) With(keysAndValues ...interface{}) LoggerInterface {
+	return &DefaultLoggerWrapper{logger: l.logger.With(keysAndValues...)}
+}
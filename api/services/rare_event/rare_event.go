@@ -0,0 +1,524 @@
+// Package rare_event spawns rare events (meteors, treasure chests) for a limited time in
+// chunks players were recently in. Every spawn is announced to all players. Characters
+// next to an event contribute to it, once per cooldown; when its progress reaches the
+// kind's requirement the rewards are mailed to every contributor, split by contribution.
+// Events nobody completes expire without rewards.
+package rare_event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/alerting"
+	"github.com/VoidMesh/api/api/internal/authz"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/VoidMesh/api/api/internal/rng"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	notificationV1 "github.com/VoidMesh/api/api/proto/notification/v1"
+	rareEventV1 "github.com/VoidMesh/api/api/proto/rare_event/v1"
+	"github.com/VoidMesh/api/api/services/mail"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Outcomes stored on ended events
+const (
+	OutcomeCompleted = "completed"
+	OutcomeExpired   = "expired"
+)
+
+// rewardBatchSize is how many completed events the reward pass loads per page
+const rewardBatchSize = 50
+
+var (
+	ErrNotDue    = errors.New("no rare event is due")
+	ErrCooldown  = errors.New("contributed too recently")
+	ErrEventOver = errors.New("rare event has ended")
+)
+
+// Kind describes a rare event that can spawn
+type Kind struct {
+	ID           string
+	Name         string
+	Weight       int           // Relative chance of spawning
+	Required     int32         // Contributions needed to complete it
+	Lifetime     time.Duration // Time to complete it before it expires
+	Rewards      []Reward      // Split between the contributors
+	Effect       string        // Status effect granted to every contributor; empty grants none
+	Announcement string        // Announced on spawn; %d, %d are the cell coordinates
+}
+
+// Reward is a quantity of an item split between an event's contributors
+type Reward struct {
+	Item     string
+	Quantity int32
+}
+
+// Config sets which events spawn, how often and where
+type Config struct {
+	Interval             time.Duration // Time between passes that expire, spawn and reward events
+	SpawnInterval        time.Duration // Minimum time between spawns in a world
+	MaxActive            int64         // Events in progress per world
+	ActiveWithin         time.Duration // Chunks read this recently are eligible
+	CandidateChunks      int32         // Eligible chunks considered per spawn
+	ContributionCooldown time.Duration // Per character and event
+	Range                int32         // Cells a character may be from an event to contribute
+	Kinds                []Kind
+}
+
+// DefaultConfig spawns an event every 20 minutes at most, with up to two in progress
+func DefaultConfig() Config {
+	return Config{
+		Interval:             time.Minute,
+		SpawnInterval:        20 * time.Minute,
+		MaxActive:            2,
+		ActiveWithin:         10 * time.Minute,
+		CandidateChunks:      500,
+		ContributionCooldown: time.Second,
+		Range:                3,
+		Kinds: []Kind{
+			{
+				ID:           "meteor",
+				Name:         "Meteor",
+				Weight:       2,
+				Required:     60,
+				Lifetime:     20 * time.Minute,
+				Rewards:      []Reward{{Item: "Minerals", Quantity: 40}, {Item: "Stone", Quantity: 80}},
+				Effect:       "bountiful",
+				Announcement: "A meteor has crashed near (%d, %d)! Mine it together before it cools.",
+			},
+			{
+				ID:           "treasure_chest",
+				Name:         "Treasure Chest",
+				Weight:       1,
+				Required:     20,
+				Lifetime:     30 * time.Minute,
+				Rewards:      []Reward{{Item: "Shells", Quantity: 20}, {Item: "Minerals", Quantity: 15}},
+				Effect:       "swiftness",
+				Announcement: "A treasure chest has been spotted near (%d, %d)! Pry it open before it vanishes.",
+			},
+		},
+	}
+}
+
+// kind returns the configured kind with the given ID
+func (c Config) kind(id string) (Kind, bool) {
+	for _, kind := range c.Kinds {
+		if kind.ID == id {
+			return kind, true
+		}
+	}
+	return Kind{}, false
+}
+
+// Service spawns, expires and rewards rare events.
+type Service struct {
+	db        DatabaseInterface
+	logger    LoggerInterface
+	clock     clock.Clock
+	config    Config
+	worldID   pgtype.UUID           // World events spawn in; unset disables spawning
+	seeds     WorldSeedsInterface   // Looks up the world's seed
+	mailer    MailerInterface       // Nil keeps rewards pending
+	announcer AnnouncerInterface    // Nil disables announcements
+	timeScale TimeScaleInterface    // Nil runs every world at normal speed
+	effects   StatusEffectInterface // Nil grants no status effects
+	pauses    PauseInterface        // Nil never skips a pass
+}
+
+// NewService creates a new rare event service with dependency injection.
+func NewService(db DatabaseInterface, logger LoggerInterface) *Service {
+	componentLogger := logger.With("component", "rare-event-service")
+	componentLogger.Debug("Creating new rare event service")
+	return &Service{
+		db:     db,
+		logger: componentLogger,
+		clock:  clock.New(),
+		config: DefaultConfig(),
+	}
+}
+
+// NewServiceWithPool creates a service with concrete implementations (convenience constructor for production use).
+func NewServiceWithPool(pool storage.Pool) *Service {
+	return NewService(NewDatabaseWrapper(pool), NewDefaultLoggerWrapper())
+}
+
+// SetClock replaces the clock used for timestamps and the spawn schedule (for simulation tests)
+func (s *Service) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// SetConfig replaces the kinds of events and the spawn schedule
+func (s *Service) SetConfig(config Config) {
+	s.config = config
+}
+
+// SetWorld makes events spawn in the world, placed by streams derived from its seed as
+// seeds looks it up
+func (s *Service) SetWorld(worldID pgtype.UUID, seeds WorldSeedsInterface) {
+	s.worldID = worldID
+	s.seeds = seeds
+}
+
+// SetMailer sends the rewards of completed events by mail
+func (s *Service) SetMailer(mailer MailerInterface) {
+	s.mailer = mailer
+}
+
+// SetAnnouncer announces spawned events to every player
+func (s *Service) SetAnnouncer(announcer AnnouncerInterface) {
+	s.announcer = announcer
+}
+
+// SetTimeScale makes event lifetimes, the spawn interval and the contribution cooldown
+// follow each world's time scale. Passes still run every config.Interval.
+func (s *Service) SetTimeScale(timeScale TimeScaleInterface) {
+	s.timeScale = timeScale
+}
+
+// SetStatusEffects grants each kind's status effect to the contributors of completed events
+func (s *Service) SetStatusEffects(effects StatusEffectInterface) {
+	s.effects = effects
+}
+
+// SetPauses makes the job skip its passes while an admin has paused the world
+func (s *Service) SetPauses(pauses PauseInterface) {
+	s.pauses = pauses
+}
+
+// scaled returns how long d lasts in the world
+func (s *Service) scaled(ctx context.Context, worldID pgtype.UUID, d time.Duration) time.Duration {
+	if s.timeScale == nil {
+		return d
+	}
+	return s.timeScale.Scale(ctx, worldID, d)
+}
+
+// Spawn places an event in a chunk players read within config.ActiveWithin, unless the
+// world has config.MaxActive events in progress or one spawned within
+// config.SpawnInterval. It returns nil when no event is due or no chunk is eligible.
+func (s *Service) Spawn(ctx context.Context) (*db.RareEvent, error) {
+	if !s.worldID.Valid || len(s.config.Kinds) == 0 {
+		return nil, nil
+	}
+	now := s.clock.Now()
+	chunks, err := s.db.ListRecentlyAccessedChunks(ctx, db.ListRecentlyAccessedChunksParams{
+		WorldID:       s.worldID,
+		AccessedSince: pgtype.Timestamp{Time: now.Add(-s.config.ActiveWithin), Valid: true},
+		MaxChunks:     s.config.CandidateChunks,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list eligible chunks: %w", err)
+	}
+	if len(chunks) == 0 {
+		return nil, nil
+	}
+	worldSeed, err := s.seeds.Seed(ctx, s.worldID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get world seed: %w", err)
+	}
+
+	// Placement depends only on the world seed and the spawn time, so a pass replayed at
+	// the same instant spawns the same event
+	stream := rng.New(worldSeed, rng.RareEvents, now.UnixNano())
+	chunk := chunks[stream.Intn(len(chunks))]
+	kind := s.pickKind(stream)
+	origin := geometry.ChunkOrigin(chunk.ChunkX, chunk.ChunkY)
+	x := origin.X + stream.Int31n(geometry.ChunkSize)
+	y := origin.Y + stream.Int31n(geometry.ChunkSize)
+
+	event, err := s.db.SpawnRareEvent(ctx, db.CreateRareEventParams{
+		WorldID:   s.worldID,
+		Kind:      kind.ID,
+		ChunkX:    chunk.ChunkX,
+		ChunkY:    chunk.ChunkY,
+		X:         x,
+		Y:         y,
+		Required:  kind.Required,
+		SpawnedAt: pgtype.Timestamp{Time: now, Valid: true},
+		ExpiresAt: pgtype.Timestamp{Time: now.Add(s.scaled(ctx, s.worldID, kind.Lifetime)), Valid: true},
+	}, s.config.MaxActive, pgtype.Timestamp{Time: now.Add(-s.scaled(ctx, s.worldID, s.config.SpawnInterval)), Valid: true})
+	if errors.Is(err, ErrNotDue) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to spawn rare event: %w", err)
+	}
+
+	s.logger.Info("Rare event spawned", "rare_event_id", event.ID, "kind", event.Kind, "x", event.X, "y", event.Y, "expires_at", event.ExpiresAt.Time)
+	if s.announcer != nil && kind.Announcement != "" {
+		message := fmt.Sprintf(kind.Announcement, event.X, event.Y)
+		if _, err := s.announcer.Announce(ctx, "", message, notificationV1.AnnouncementSeverity_ANNOUNCEMENT_SEVERITY_INFO, timestamppb.New(event.ExpiresAt.Time)); err != nil {
+			s.logger.Warn("Failed to announce rare event", "rare_event_id", event.ID, "error", err)
+		}
+	}
+	return &event, nil
+}
+
+// pickKind chooses a kind by weight
+func (s *Service) pickKind(stream *rng.Stream) Kind {
+	total := 0
+	for _, kind := range s.config.Kinds {
+		total += max(kind.Weight, 0)
+	}
+	if total == 0 {
+		return s.config.Kinds[0]
+	}
+	roll := stream.Intn(total)
+	for _, kind := range s.config.Kinds {
+		roll -= max(kind.Weight, 0)
+		if roll < 0 {
+			return kind
+		}
+	}
+	return s.config.Kinds[len(s.config.Kinds)-1]
+}
+
+// Contribute adds one contribution of the character to an event it stands next to and
+// returns the event and the character's total contribution. The contribution that
+// completes the event also sends its rewards.
+func (s *Service) Contribute(ctx context.Context, userID, characterID string, eventID int64) (*rareEventV1.RareEvent, int32, error) {
+	character, err := authz.OwnedCharacter(ctx, s.db, s.logger, userID, characterID)
+	if err != nil {
+		return nil, 0, err
+	}
+	event, err := s.db.GetRareEvent(ctx, eventID)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && event.WorldID != character.WorldID) {
+		return nil, 0, status.Errorf(codes.NotFound, "rare event not found")
+	}
+	if err != nil {
+		s.logger.Error("Failed to get rare event", "rare_event_id", eventID, "error", err)
+		return nil, 0, status.Errorf(codes.Internal, "failed to contribute")
+	}
+	if !geometry.InRange(geometry.Point{X: character.X, Y: character.Y}, geometry.Point{X: event.X, Y: event.Y}, s.config.Range) {
+		return nil, 0, status.Errorf(codes.FailedPrecondition, "character is too far from the event")
+	}
+
+	now := s.clock.Now()
+	cooldown := s.scaled(ctx, event.WorldID, s.config.ContributionCooldown)
+	event, total, err := s.db.Contribute(ctx, db.AddRareEventContributionParams{
+		RareEventID:      eventID,
+		CharacterID:      character.ID,
+		Amount:           1,
+		Now:              pgtype.Timestamp{Time: now, Valid: true},
+		CooledDownBefore: pgtype.Timestamp{Time: now.Add(-cooldown), Valid: true},
+	})
+	switch {
+	case errors.Is(err, ErrCooldown):
+		return nil, 0, status.Errorf(codes.ResourceExhausted, "wait %s between contributions", cooldown)
+	case errors.Is(err, ErrEventOver):
+		return nil, 0, status.Errorf(codes.FailedPrecondition, "the event has ended")
+	case err != nil:
+		s.logger.Error("Failed to contribute to rare event", "rare_event_id", eventID, "character_id", characterID, "error", err)
+		return nil, 0, status.Errorf(codes.Internal, "failed to contribute")
+	}
+
+	if event.Outcome.String == OutcomeCompleted {
+		s.logger.Info("Rare event completed", "rare_event_id", event.ID, "kind", event.Kind, "completed_by", characterID)
+		if err := s.reward(ctx, event); err != nil {
+			// The reward pass retries events whose rewards were not sent
+			s.logger.Error("Failed to reward rare event", "rare_event_id", event.ID, "error", err)
+		}
+	}
+	return s.eventToProto(event), total, nil
+}
+
+// List returns the events in progress in a world, which defaults to the caller's session
+// world
+func (s *Service) List(ctx context.Context, worldID string) ([]*rareEventV1.RareEvent, error) {
+	if worldID == "" {
+		sessionWorldID, ok := session.WorldIDFromContext(ctx)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "world_id is required")
+		}
+		worldID = sessionWorldID
+	}
+	world, err := uuid.StringToPgtype(worldID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid world ID format")
+	}
+	rows, err := s.db.ListActiveRareEvents(ctx, world)
+	if err != nil {
+		s.logger.Error("Failed to list rare events", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list rare events")
+	}
+	now := s.clock.Now()
+	events := make([]*rareEventV1.RareEvent, 0, len(rows))
+	for _, row := range rows {
+		// Expired events are only marked by the next pass
+		if row.ExpiresAt.Time.After(now) {
+			events = append(events, s.eventToProto(row))
+		}
+	}
+	return events, nil
+}
+
+// Expire ends the events whose lifetime is over and returns how many there were
+func (s *Service) Expire(ctx context.Context) (int, error) {
+	expired, err := s.db.ExpireRareEvents(ctx, pgtype.Timestamp{Time: s.clock.Now(), Valid: true})
+	if err != nil {
+		return 0, fmt.Errorf("failed to expire rare events: %w", err)
+	}
+	for _, event := range expired {
+		s.logger.Info("Rare event expired", "rare_event_id", event.ID, "kind", event.Kind, "progress", event.Progress, "required", event.Required)
+	}
+	return len(expired), nil
+}
+
+// RewardCompleted sends the rewards of completed events that have not been sent, e.g.
+// because the instance completing them stopped first. It returns how many were sent.
+func (s *Service) RewardCompleted(ctx context.Context) (int, error) {
+	if s.mailer == nil {
+		return 0, nil
+	}
+	rewarded := 0
+	for {
+		events, err := s.db.ListUnrewardedRareEvents(ctx, rewardBatchSize)
+		if err != nil {
+			return rewarded, fmt.Errorf("failed to list completed rare events: %w", err)
+		}
+		for _, event := range events {
+			if err := s.reward(ctx, event); err != nil {
+				return rewarded, fmt.Errorf("failed to reward rare event %d: %w", event.ID, err)
+			}
+			rewarded++
+		}
+		if len(events) < rewardBatchSize {
+			return rewarded, nil
+		}
+	}
+}
+
+// reward mails each contributor its share of the kind's rewards: every reward is split in
+// proportion to contribution, and everyone who contributed gets at least one of each.
+// The event is marked rewarded first, so two instances never both send the rewards; a
+// failure while sending loses the remaining shares rather than sending some twice.
+func (s *Service) reward(ctx context.Context, event db.RareEvent) error {
+	if s.mailer == nil {
+		return nil
+	}
+	kind, ok := s.config.kind(event.Kind)
+	if !ok {
+		return fmt.Errorf("unknown rare event kind %q", event.Kind)
+	}
+	contributions, err := s.db.ListRareEventContributions(ctx, event.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list contributions: %w", err)
+	}
+	items := make([]db.Item, 0, len(kind.Rewards))
+	for _, reward := range kind.Rewards {
+		item, err := s.db.GetItemByName(ctx, reward.Item)
+		if err != nil {
+			return fmt.Errorf("failed to get reward item %q: %w", reward.Item, err)
+		}
+		items = append(items, item)
+	}
+
+	claimed, err := s.db.MarkRareEventRewarded(ctx, db.MarkRareEventRewardedParams{
+		ID:         event.ID,
+		RewardedAt: pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to mark rewarded: %w", err)
+	}
+	if claimed == 0 {
+		return nil
+	}
+
+	total := int64(0)
+	for _, contribution := range contributions {
+		total += int64(contribution.Amount)
+	}
+	var errs []error
+	for _, contribution := range contributions {
+		attachments := make([]mail.Attachment, 0, len(items))
+		for i, reward := range kind.Rewards {
+			share := max(1, int32(int64(reward.Quantity)*int64(contribution.Amount)/total))
+			attachments = append(attachments, mail.Attachment{ItemID: items[i].ID, Quantity: share})
+		}
+		_, err := s.mailer.SendSystem(ctx, mail.SystemMail{
+			RecipientID: contribution.CharacterID,
+			Subject:     kind.Name + " rewards",
+			Body:        fmt.Sprintf("Thanks for your help with the %s: you contributed %d of %d.", kind.Name, contribution.Amount, total),
+			Attachments: attachments,
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to mail rewards to %s: %w", uuid.PgtypeToString(contribution.CharacterID), err))
+		}
+		if s.effects != nil && kind.Effect != "" {
+			if _, err := s.effects.Apply(ctx, contribution.CharacterID, kind.Effect, "event:"+kind.ID); err != nil {
+				errs = append(errs, fmt.Errorf("failed to grant %s to %s: %w", kind.Effect, uuid.PgtypeToString(contribution.CharacterID), err))
+			}
+		}
+	}
+	s.logger.Info("Rare event rewarded", "rare_event_id", event.ID, "contributors", len(contributions))
+	return errors.Join(errs...)
+}
+
+// Run expires, spawns and rewards events every config.Interval until ctx is cancelled
+func (s *Service) Run(ctx context.Context) {
+	s.logger.Info("Rare event job started", "interval", s.config.Interval, "spawn_interval", s.config.SpawnInterval)
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("Rare event job stopped")
+			return
+		case <-s.clock.After(s.config.Interval):
+		}
+
+		if err := s.pass(ctx); err != nil {
+			s.logger.Error("Rare event pass failed", "error", err)
+			alerting.ReportJobError("rare_events", err)
+		}
+	}
+}
+
+// pass expires, spawns and rewards events, unless the world is paused. Events of a paused
+// world neither expire nor pay out; the first pass after it resumes catches up.
+func (s *Service) pass(ctx context.Context) error {
+	if s.pauses != nil && s.pauses.Paused(ctx, s.worldID) {
+		s.logger.Debug("Rare event pass skipped, world is paused", "world_id", uuid.PgtypeToString(s.worldID))
+		return nil
+	}
+	var errs []error
+	if _, err := s.Expire(ctx); err != nil {
+		errs = append(errs, err)
+	}
+	if _, err := s.Spawn(ctx); err != nil {
+		errs = append(errs, err)
+	}
+	if _, err := s.RewardCompleted(ctx); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+func (s *Service) eventToProto(row db.RareEvent) *rareEventV1.RareEvent {
+	event := &rareEventV1.RareEvent{
+		Id:        row.ID,
+		WorldId:   uuid.PgtypeToString(row.WorldID),
+		Kind:      row.Kind,
+		ChunkX:    row.ChunkX,
+		ChunkY:    row.ChunkY,
+		X:         row.X,
+		Y:         row.Y,
+		Progress:  row.Progress,
+		Required:  row.Required,
+		SpawnedAt: timestamppb.New(row.SpawnedAt.Time),
+		ExpiresAt: timestamppb.New(row.ExpiresAt.Time),
+		Completed: row.Outcome.String == OutcomeCompleted,
+	}
+	if kind, ok := s.config.kind(row.Kind); ok {
+		event.Name = kind.Name
+	}
+	return event
+}
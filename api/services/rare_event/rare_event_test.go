@@ -0,0 +1,376 @@
+package rare_event
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
+	mailV1 "github.com/VoidMesh/api/api/proto/mail/v1"
+	notificationV1 "github.com/VoidMesh/api/api/proto/notification/v1"
+	"github.com/VoidMesh/api/api/services/mail"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// worldSeeds gives each listed world a fixed seed
+type worldSeeds map[[16]byte]int64
+
+func (w worldSeeds) Seed(ctx context.Context, worldID pgtype.UUID) (int64, error) {
+	seed, ok := w[worldID.Bytes]
+	if !ok {
+		return 0, pgx.ErrNoRows
+	}
+	return seed, nil
+}
+
+// fakeDB keeps characters, recently read chunks, events and contributions in memory
+type fakeDB struct {
+	testmocks.Characters
+	chunks        []db.ListRecentlyAccessedChunksRow
+	events        map[int64]db.RareEvent
+	contributions map[int64][]db.RareEventContribution
+	nextID        int64
+}
+
+func newFakeDB() *fakeDB {
+	return &fakeDB{
+		Characters:    testmocks.Characters{},
+		events:        map[int64]db.RareEvent{},
+		contributions: map[int64][]db.RareEventContribution{},
+	}
+}
+
+func (f *fakeDB) GetItemByName(ctx context.Context, name string) (db.Item, error) {
+	ids := map[string]int32{"Minerals": 3, "Stone": 9, "Shells": 12}
+	id, ok := ids[name]
+	if !ok {
+		return db.Item{}, pgx.ErrNoRows
+	}
+	return db.Item{ID: id, Name: name}, nil
+}
+
+func (f *fakeDB) GetRareEvent(ctx context.Context, id int64) (db.RareEvent, error) {
+	event, ok := f.events[id]
+	if !ok {
+		return db.RareEvent{}, pgx.ErrNoRows
+	}
+	return event, nil
+}
+
+func (f *fakeDB) ListActiveRareEvents(ctx context.Context, worldID pgtype.UUID) ([]db.RareEvent, error) {
+	var active []db.RareEvent
+	for _, event := range f.events {
+		if event.WorldID == worldID && !event.EndedAt.Valid {
+			active = append(active, event)
+		}
+	}
+	return active, nil
+}
+
+func (f *fakeDB) ListRecentlyAccessedChunks(ctx context.Context, arg db.ListRecentlyAccessedChunksParams) ([]db.ListRecentlyAccessedChunksRow, error) {
+	return f.chunks, nil
+}
+
+func (f *fakeDB) ExpireRareEvents(ctx context.Context, now pgtype.Timestamp) ([]db.RareEvent, error) {
+	var expired []db.RareEvent
+	for id, event := range f.events {
+		if !event.EndedAt.Valid && !event.ExpiresAt.Time.After(now.Time) {
+			event.EndedAt = now
+			event.Outcome = pgtype.Text{String: OutcomeExpired, Valid: true}
+			f.events[id] = event
+			expired = append(expired, event)
+		}
+	}
+	return expired, nil
+}
+
+func (f *fakeDB) ListUnrewardedRareEvents(ctx context.Context, limit int32) ([]db.RareEvent, error) {
+	var unrewarded []db.RareEvent
+	for _, event := range f.events {
+		if event.Outcome.String == OutcomeCompleted && !event.RewardedAt.Valid {
+			unrewarded = append(unrewarded, event)
+		}
+	}
+	return unrewarded, nil
+}
+
+func (f *fakeDB) MarkRareEventRewarded(ctx context.Context, arg db.MarkRareEventRewardedParams) (int64, error) {
+	event := f.events[arg.ID]
+	if event.RewardedAt.Valid {
+		return 0, nil
+	}
+	event.RewardedAt = arg.RewardedAt
+	f.events[arg.ID] = event
+	return 1, nil
+}
+
+func (f *fakeDB) ListRareEventContributions(ctx context.Context, rareEventID int64) ([]db.RareEventContribution, error) {
+	return f.contributions[rareEventID], nil
+}
+
+func (f *fakeDB) SpawnRareEvent(ctx context.Context, event db.CreateRareEventParams, maxActive int64, spawnedAfter pgtype.Timestamp) (db.RareEvent, error) {
+	active := int64(0)
+	for _, existing := range f.events {
+		if existing.WorldID != event.WorldID {
+			continue
+		}
+		if existing.SpawnedAt.Time.After(spawnedAfter.Time) {
+			return db.RareEvent{}, ErrNotDue
+		}
+		if !existing.EndedAt.Valid {
+			active++
+		}
+	}
+	if active >= maxActive {
+		return db.RareEvent{}, ErrNotDue
+	}
+	f.nextID++
+	created := db.RareEvent{
+		ID: f.nextID, WorldID: event.WorldID, Kind: event.Kind, ChunkX: event.ChunkX, ChunkY: event.ChunkY,
+		X: event.X, Y: event.Y, Required: event.Required, SpawnedAt: event.SpawnedAt, ExpiresAt: event.ExpiresAt,
+	}
+	f.events[created.ID] = created
+	return created, nil
+}
+
+func (f *fakeDB) Contribute(ctx context.Context, arg db.AddRareEventContributionParams) (db.RareEvent, int32, error) {
+	event := f.events[arg.RareEventID]
+	if event.EndedAt.Valid {
+		return db.RareEvent{}, 0, ErrEventOver
+	}
+	contributions := f.contributions[arg.RareEventID]
+	i := 0
+	for i < len(contributions) && contributions[i].CharacterID != arg.CharacterID {
+		i++
+	}
+	if i == len(contributions) {
+		contributions = append(contributions, db.RareEventContribution{RareEventID: arg.RareEventID, CharacterID: arg.CharacterID})
+	} else if contributions[i].LastContributedAt.Time.After(arg.CooledDownBefore.Time) {
+		return db.RareEvent{}, 0, ErrCooldown
+	}
+	contributions[i].Amount += arg.Amount
+	contributions[i].LastContributedAt = arg.Now
+	f.contributions[arg.RareEventID] = contributions
+
+	event.Progress += arg.Amount
+	if event.Progress >= event.Required {
+		event.EndedAt = arg.Now
+		event.Outcome = pgtype.Text{String: OutcomeCompleted, Valid: true}
+	}
+	f.events[event.ID] = event
+	return event, contributions[i].Amount, nil
+}
+
+// fakeMailer keeps sent mail
+type fakeMailer struct {
+	sent []mail.SystemMail
+}
+
+func (f *fakeMailer) SendSystem(ctx context.Context, m mail.SystemMail) (*mailV1.Mail, error) {
+	f.sent = append(f.sent, m)
+	return &mailV1.Mail{}, nil
+}
+
+// fakeAnnouncer keeps announced messages
+type fakeAnnouncer struct {
+	messages []string
+}
+
+func (f *fakeAnnouncer) Announce(ctx context.Context, createdBy, message string, severity notificationV1.AnnouncementSeverity, expiresAt *timestamppb.Timestamp) (*notificationV1.Announcement, error) {
+	f.messages = append(f.messages, message)
+	return &notificationV1.Announcement{Message: message}, nil
+}
+
+// fakeEffects keeps the status effects granted per character
+type fakeEffects map[pgtype.UUID][]string
+
+func (f fakeEffects) Apply(ctx context.Context, characterID pgtype.UUID, key, source string) (*characterV1.StatusEffect, error) {
+	f[characterID] = append(f[characterID], key+" from "+source)
+	return &characterV1.StatusEffect{Key: key, Source: source}, nil
+}
+
+var (
+	worldID  = pgtype.UUID{Bytes: [16]byte{15: 0xaa}, Valid: true}
+	aliceID  = "00000000-0000-0000-0000-000000000001"
+	bobID    = "00000000-0000-0000-0000-000000000002"
+	aliceChr = pgtype.UUID{Bytes: [16]byte{0: 1, 15: 1}, Valid: true}
+	bobChr   = pgtype.UUID{Bytes: [16]byte{0: 1, 15: 2}, Valid: true}
+)
+
+func newTestService(t *testing.T) (*Service, *fakeDB, *clock.Fake, *fakeMailer, *fakeAnnouncer) {
+	database := newFakeDB()
+	for chr, user := range map[pgtype.UUID]string{aliceChr: aliceID, bobChr: bobID} {
+		userID, err := uuid.StringToPgtype(user)
+		require.NoError(t, err)
+		database.Characters[chr] = db.Character{ID: chr, UserID: userID, WorldID: worldID}
+	}
+	service := NewService(database, testmocks.NopLogger[LoggerInterface]{})
+	fake := clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	service.SetClock(fake)
+	service.SetWorld(worldID, worldSeeds{worldID.Bytes: 42})
+	mailer := &fakeMailer{}
+	service.SetMailer(mailer)
+	announcer := &fakeAnnouncer{}
+	service.SetAnnouncer(announcer)
+	return service, database, fake, mailer, announcer
+}
+
+func TestSpawn(t *testing.T) {
+	service, database, fake, _, announcer := newTestService(t)
+	ctx := session.WithWorldID(context.Background(), uuid.PgtypeToString(worldID))
+
+	event, err := service.Spawn(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, event, "nothing spawns while no chunk was read recently")
+
+	database.chunks = []db.ListRecentlyAccessedChunksRow{{ChunkX: 2, ChunkY: -1}}
+	event, err = service.Spawn(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, event)
+	assert.Equal(t, int32(2), event.ChunkX)
+	assert.GreaterOrEqual(t, event.X, int32(64))
+	assert.Less(t, event.X, int32(96), "the event is placed inside its chunk")
+	assert.GreaterOrEqual(t, event.Y, int32(-32))
+	assert.Less(t, event.Y, int32(0))
+	require.Len(t, announcer.messages, 1)
+
+	fake.Advance(time.Minute)
+	event, err = service.Spawn(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, event, "events spawn at most once per spawn interval")
+
+	fake.Advance(DefaultConfig().SpawnInterval)
+	event, err = service.Spawn(ctx)
+	require.NoError(t, err)
+	assert.NotNil(t, event)
+	assert.Len(t, announcer.messages, 2)
+}
+
+// fakePauses pauses every world while set
+type fakePauses bool
+
+func (f *fakePauses) Paused(ctx context.Context, worldID pgtype.UUID) bool {
+	return bool(*f)
+}
+
+func TestPass_SkipsPausedWorld(t *testing.T) {
+	service, database, _, _, announcer := newTestService(t)
+	ctx := session.WithWorldID(context.Background(), uuid.PgtypeToString(worldID))
+	database.chunks = []db.ListRecentlyAccessedChunksRow{{ChunkX: 2, ChunkY: -1}}
+	paused := fakePauses(true)
+	service.SetPauses(&paused)
+
+	require.NoError(t, service.pass(ctx))
+	assert.Empty(t, database.events, "nothing spawns in a paused world")
+
+	paused = false
+	require.NoError(t, service.pass(ctx))
+	assert.Len(t, database.events, 1)
+	assert.Len(t, announcer.messages, 1)
+}
+
+func TestContribute(t *testing.T) {
+	service, database, fake, mailer, _ := newTestService(t)
+	ctx := session.WithWorldID(context.Background(), uuid.PgtypeToString(worldID))
+	alice := uuid.PgtypeToString(aliceChr)
+	bob := uuid.PgtypeToString(bobChr)
+
+	config := DefaultConfig()
+	config.Kinds = config.Kinds[:1]
+	config.Kinds[0].Required = 4
+	service.SetConfig(config)
+	effects := fakeEffects{}
+	service.SetStatusEffects(effects)
+	database.chunks = []db.ListRecentlyAccessedChunksRow{{ChunkX: 0, ChunkY: 0}}
+	event, err := service.Spawn(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, event)
+
+	_, _, err = service.Contribute(ctx, aliceID, alice, event.ID)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "characters must stand next to the event")
+
+	for _, chr := range []pgtype.UUID{aliceChr, bobChr} {
+		character := database.Characters[chr]
+		character.X, character.Y = event.X+1, event.Y
+		database.Characters[chr] = character
+	}
+	_, _, err = service.Contribute(ctx, bobID, alice, event.ID)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	progress, contribution, err := service.Contribute(ctx, aliceID, alice, event.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), progress.Progress)
+	assert.Equal(t, int32(1), contribution)
+	_, _, err = service.Contribute(ctx, aliceID, alice, event.ID)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err), "contributions have a cooldown")
+
+	fake.Advance(time.Second)
+	_, _, err = service.Contribute(ctx, aliceID, alice, event.ID)
+	require.NoError(t, err)
+	fake.Advance(time.Second)
+	_, _, err = service.Contribute(ctx, aliceID, alice, event.ID)
+	require.NoError(t, err)
+	assert.Empty(t, mailer.sent)
+
+	progress, contribution, err = service.Contribute(ctx, bobID, bob, event.ID)
+	require.NoError(t, err)
+	assert.True(t, progress.Completed)
+	assert.Equal(t, int32(1), contribution)
+
+	// Rewards are split by contribution: alice did 3 of 4, bob 1 of 4
+	require.Len(t, mailer.sent, 2)
+	shares := map[pgtype.UUID][]mail.Attachment{}
+	for _, m := range mailer.sent {
+		shares[m.RecipientID] = m.Attachments
+	}
+	assert.Equal(t, []mail.Attachment{{ItemID: 3, Quantity: 30}, {ItemID: 9, Quantity: 60}}, shares[aliceChr])
+	assert.Equal(t, []mail.Attachment{{ItemID: 3, Quantity: 10}, {ItemID: 9, Quantity: 20}}, shares[bobChr])
+	assert.Equal(t, fakeEffects{aliceChr: {"bountiful from event:meteor"}, bobChr: {"bountiful from event:meteor"}}, effects, "every contributor gets the kind's status effect")
+
+	fake.Advance(time.Second)
+	_, _, err = service.Contribute(ctx, aliceID, alice, event.ID)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "completed events take no contributions")
+
+	rewarded, err := service.RewardCompleted(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, rewarded, "rewards are sent once")
+}
+
+func TestExpire(t *testing.T) {
+	service, database, fake, mailer, _ := newTestService(t)
+	ctx := session.WithWorldID(context.Background(), uuid.PgtypeToString(worldID))
+
+	database.chunks = []db.ListRecentlyAccessedChunksRow{{ChunkX: 0, ChunkY: 0}}
+	event, err := service.Spawn(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, event)
+
+	listed, err := service.List(ctx, uuid.PgtypeToString(worldID))
+	require.NoError(t, err)
+	assert.Len(t, listed, 1)
+
+	fake.Advance(time.Hour)
+	listed, err = service.List(ctx, uuid.PgtypeToString(worldID))
+	require.NoError(t, err)
+	assert.Empty(t, listed, "events past their lifetime are not listed before the pass ends them")
+
+	expired, err := service.Expire(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, expired)
+	assert.Equal(t, OutcomeExpired, database.events[event.ID].Outcome.String)
+	rewarded, err := service.RewardCompleted(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, rewarded)
+	assert.Empty(t, mailer.sent, "expired events give no rewards")
+}
@@ -0,0 +1,83 @@
+package reference
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/charmbracelet/log"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DatabaseInterface abstracts database operations for reference resolution.
+type DatabaseInterface interface {
+	ListCharactersByIds(ctx context.Context, ids []pgtype.UUID) ([]db.Character, error)
+	GetAllItems(ctx context.Context) ([]db.Item, error)
+	ListWorlds(ctx context.Context) ([]db.World, error)
+	ListBlockRelations(ctx context.Context, userID pgtype.UUID) ([]pgtype.UUID, error)
+}
+
+// DatabaseWrapper implements DatabaseInterface using the actual database connection.
+type DatabaseWrapper struct {
+	queries *db.Queries
+}
+
+// NewDatabaseWrapper creates a new database wrapper with the given connection pool.
+func NewDatabaseWrapper(pool storage.Pool) DatabaseInterface {
+	return &DatabaseWrapper{queries: db.New(pool)}
+}
+
+func (d *DatabaseWrapper) ListCharactersByIds(ctx context.Context, ids []pgtype.UUID) ([]db.Character, error) {
+	return d.queries.ListCharactersByIds(ctx, ids)
+}
+
+func (d *DatabaseWrapper) GetAllItems(ctx context.Context) ([]db.Item, error) {
+	return d.queries.GetAllItems(ctx)
+}
+
+func (d *DatabaseWrapper) ListWorlds(ctx context.Context) ([]db.World, error) {
+	return d.queries.ListWorlds(ctx)
+}
+
+func (d *DatabaseWrapper) ListBlockRelations(ctx context.Context, userID pgtype.UUID) ([]pgtype.UUID, error) {
+	return d.queries.ListBlockRelations(ctx, userID)
+}
+
+// LoggerInterface abstracts logging operations for dependency injection.
+type LoggerInterface interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+	With(keysAndValues ...interface{}) LoggerInterface
+}
+
+// DefaultLoggerWrapper wraps the internal logging package.
+type DefaultLoggerWrapper struct {
+	logger *log.Logger
+}
+
+func NewDefaultLoggerWrapper() LoggerInterface {
+	return &DefaultLoggerWrapper{logger: logging.GetLogger()}
+}
+
+func (l *DefaultLoggerWrapper) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.Debug(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Info(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.Warn(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.Error(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) With(keysAndValues ...interface{}) LoggerInterface {
+	return &DefaultLoggerWrapper{logger: l.logger.With(keysAndValues...)}
+}
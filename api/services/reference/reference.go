@@ -0,0 +1,312 @@
+// Package reference resolves batches of character, item and world IDs to display
+// summaries. Rows are cached for a short time so the same IDs arriving in many events
+// cost one query; redaction depends on the caller and is applied per request.
+package reference
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/debugstats"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	referenceV1 "github.com/VoidMesh/api/api/proto/reference/v1"
+	"github.com/VoidMesh/api/api/services/cosmetic"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Config bounds requests and the cache
+type Config struct {
+	// MaxIDs is how many IDs of each kind one request may resolve
+	MaxIDs int
+	// CacheTTL is how long a resolved row is served from memory
+	CacheTTL time.Duration
+	// MaxCachedCharacters caps the character cache; expired entries are dropped when it is full
+	MaxCachedCharacters int
+}
+
+// DefaultConfig returns the production resolution settings
+func DefaultConfig() Config {
+	return Config{
+		MaxIDs:              100,
+		CacheTTL:            time.Minute,
+		MaxCachedCharacters: 10000,
+	}
+}
+
+type cachedCharacter struct {
+	character db.Character
+	fetchedAt time.Time
+}
+
+// Service resolves references. It is safe for concurrent use.
+type Service struct {
+	db     DatabaseInterface
+	config Config
+	logger LoggerInterface
+	clock  clock.Clock
+
+	mu         sync.Mutex
+	characters map[[16]byte]cachedCharacter
+	items      map[int32]db.Item
+	worlds     map[[16]byte]db.World
+	tablesAt   time.Time // When items and worlds were loaded
+}
+
+// NewService creates a new reference service with dependency injection.
+func NewService(database DatabaseInterface, logger LoggerInterface) *Service {
+	componentLogger := logger.With("component", "reference-service")
+	componentLogger.Debug("Creating new reference service")
+	s := &Service{
+		db:         database,
+		config:     DefaultConfig(),
+		logger:     componentLogger,
+		clock:      clock.New(),
+		characters: make(map[[16]byte]cachedCharacter),
+	}
+	debugstats.Register(debugstats.CacheEntries, "reference.characters", func() int64 {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return int64(len(s.characters))
+	})
+	return s
+}
+
+// NewServiceWithPool creates a service with concrete implementations (convenience constructor for production use).
+func NewServiceWithPool(pool storage.Pool) *Service {
+	return NewService(NewDatabaseWrapper(pool), NewDefaultLoggerWrapper())
+}
+
+// SetClock replaces the clock used for cache ages (for testing)
+func (s *Service) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// SetConfig replaces the resolution settings
+func (s *Service) SetConfig(config Config) {
+	s.config = config
+}
+
+// Subscribe drops renamed characters from the cache, so their new name is resolved on the
+// next request instead of once the cached row expires
+func (s *Service) Subscribe(bus *events.Bus) {
+	bus.Subscribe(events.CharacterRenamed, s.handleCharacterRenamed)
+}
+
+func (s *Service) handleCharacterRenamed(ctx context.Context, event events.Event) error {
+	var payload events.CharacterRenamedPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("invalid %s payload: %w", event.Type, err)
+	}
+	id, err := uuid.StringToPgtype(payload.CharacterID)
+	if err != nil {
+		return fmt.Errorf("invalid %s payload: %w", event.Type, err)
+	}
+	s.mu.Lock()
+	delete(s.characters, id.Bytes)
+	s.mu.Unlock()
+	return nil
+}
+
+// ResolveReferences returns summaries of the requested IDs in request order, without
+// duplicates. Unknown IDs are left out; characters the caller may not see are redacted.
+func (s *Service) ResolveReferences(ctx context.Context, userID string, req *referenceV1.ResolveReferencesRequest) (*referenceV1.ResolveReferencesResponse, error) {
+	if len(req.CharacterIds) > s.config.MaxIDs || len(req.ItemIds) > s.config.MaxIDs || len(req.WorldIds) > s.config.MaxIDs {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d IDs of each kind may be resolved at once", s.config.MaxIDs)
+	}
+	characterIDs, err := parseIDs(req.CharacterIds, "character")
+	if err != nil {
+		return nil, err
+	}
+	worldIDs, err := parseIDs(req.WorldIds, "world")
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &referenceV1.ResolveReferencesResponse{}
+
+	if len(characterIDs) > 0 {
+		characters, err := s.loadCharacters(ctx, characterIDs)
+		if err != nil {
+			s.logger.Error("Failed to load characters", "error", err)
+			return nil, status.Errorf(codes.Internal, "failed to resolve characters")
+		}
+		hidden, err := s.hiddenUsers(ctx, userID)
+		if err != nil {
+			s.logger.Error("Failed to load block relations", "user_id", userID, "error", err)
+			return nil, status.Errorf(codes.Internal, "failed to resolve characters")
+		}
+		sessionWorld, bound := session.WorldIDFromContext(ctx)
+		for _, id := range characterIDs {
+			character, ok := characters[id.Bytes]
+			if !ok {
+				continue
+			}
+			summary := &referenceV1.CharacterSummary{Id: uuid.PgtypeToString(character.ID)}
+			owner := uuid.PgtypeToString(character.UserID)
+			otherWorld := bound && !uuid.Compare(sessionWorld, uuid.PgtypeToString(character.WorldID))
+			if !uuid.Compare(owner, userID) && (hidden[character.UserID.Bytes] || otherWorld) {
+				summary.Redacted = true
+			} else {
+				summary.Name = character.Name
+				summary.WorldId = uuid.PgtypeToString(character.WorldID)
+				summary.Title = cosmetic.TitleName(character.EquippedTitle)
+			}
+			resp.Characters = append(resp.Characters, summary)
+		}
+	}
+
+	if len(req.ItemIds) > 0 || len(worldIDs) > 0 {
+		items, worlds, err := s.loadTables(ctx)
+		if err != nil {
+			s.logger.Error("Failed to load items and worlds", "error", err)
+			return nil, status.Errorf(codes.Internal, "failed to resolve references")
+		}
+		seen := make(map[int32]bool, len(req.ItemIds))
+		for _, id := range req.ItemIds {
+			item, ok := items[id]
+			if !ok || seen[id] {
+				continue
+			}
+			seen[id] = true
+			resp.Items = append(resp.Items, &referenceV1.ItemSummary{
+				Id:       item.ID,
+				Name:     item.Name,
+				ItemType: item.ItemType,
+				Rarity:   item.Rarity,
+			})
+		}
+		for _, id := range worldIDs {
+			world, ok := worlds[id.Bytes]
+			if !ok {
+				continue
+			}
+			resp.Worlds = append(resp.Worlds, &referenceV1.WorldSummary{Id: uuid.PgtypeToString(world.ID), Name: world.Name})
+		}
+	}
+
+	return resp, nil
+}
+
+// parseIDs converts UUID strings, dropping duplicates
+func parseIDs(ids []string, kind string) ([]pgtype.UUID, error) {
+	parsed := make([]pgtype.UUID, 0, len(ids))
+	seen := make(map[[16]byte]bool, len(ids))
+	for _, id := range ids {
+		pgID, err := uuid.StringToPgtype(id)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid %s ID %q", kind, id)
+		}
+		if seen[pgID.Bytes] {
+			continue
+		}
+		seen[pgID.Bytes] = true
+		parsed = append(parsed, pgID)
+	}
+	return parsed, nil
+}
+
+// loadCharacters returns the characters with the given IDs, querying only those not cached
+func (s *Service) loadCharacters(ctx context.Context, ids []pgtype.UUID) (map[[16]byte]db.Character, error) {
+	now := s.clock.Now()
+	found := make(map[[16]byte]db.Character, len(ids))
+	var missing []pgtype.UUID
+
+	s.mu.Lock()
+	for _, id := range ids {
+		cached, ok := s.characters[id.Bytes]
+		if ok && now.Sub(cached.fetchedAt) < s.config.CacheTTL {
+			found[id.Bytes] = cached.character
+			continue
+		}
+		missing = append(missing, id)
+	}
+	s.mu.Unlock()
+
+	if len(missing) == 0 {
+		return found, nil
+	}
+	characters, err := s.db.ListCharactersByIds(ctx, missing)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list characters: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.characters)+len(characters) > s.config.MaxCachedCharacters {
+		for id, cached := range s.characters {
+			if now.Sub(cached.fetchedAt) >= s.config.CacheTTL {
+				delete(s.characters, id)
+			}
+		}
+		if len(s.characters)+len(characters) > s.config.MaxCachedCharacters {
+			s.characters = make(map[[16]byte]cachedCharacter)
+		}
+	}
+	for _, character := range characters {
+		s.characters[character.ID.Bytes] = cachedCharacter{character: character, fetchedAt: now}
+		found[character.ID.Bytes] = character
+	}
+	return found, nil
+}
+
+// loadTables returns every item and world, reloading them once they are older than the cache TTL
+func (s *Service) loadTables(ctx context.Context) (map[int32]db.Item, map[[16]byte]db.World, error) {
+	now := s.clock.Now()
+	s.mu.Lock()
+	if s.items != nil && now.Sub(s.tablesAt) < s.config.CacheTTL {
+		items, worlds := s.items, s.worlds
+		s.mu.Unlock()
+		return items, worlds, nil
+	}
+	s.mu.Unlock()
+
+	itemRows, err := s.db.GetAllItems(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list items: %w", err)
+	}
+	worldRows, err := s.db.ListWorlds(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list worlds: %w", err)
+	}
+	items := make(map[int32]db.Item, len(itemRows))
+	for _, item := range itemRows {
+		items[item.ID] = item
+	}
+	worlds := make(map[[16]byte]db.World, len(worldRows))
+	for _, world := range worldRows {
+		worlds[world.ID.Bytes] = world
+	}
+
+	s.mu.Lock()
+	s.items, s.worlds, s.tablesAt = items, worlds, now
+	s.mu.Unlock()
+	return items, worlds, nil
+}
+
+// hiddenUsers returns the users the caller has blocked or been blocked by. Block changes
+// take effect immediately, so they are not cached.
+func (s *Service) hiddenUsers(ctx context.Context, userID string) (map[[16]byte]bool, error) {
+	id, err := uuid.StringToPgtype(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+	related, err := s.db.ListBlockRelations(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	hidden := make(map[[16]byte]bool, len(related))
+	for _, user := range related {
+		hidden[user.Bytes] = true
+	}
+	return hidden, nil
+}
@@ -0,0 +1,184 @@
+package reference
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	referenceV1 "github.com/VoidMesh/api/api/proto/reference/v1"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeDB serves fixed rows and counts queries, to check what the cache saves
+type fakeDB struct {
+	characters     []db.Character
+	items          []db.Item
+	worlds         []db.World
+	blocked        []pgtype.UUID
+	characterCalls int
+	tableCalls     int
+}
+
+func (f *fakeDB) ListCharactersByIds(ctx context.Context, ids []pgtype.UUID) ([]db.Character, error) {
+	f.characterCalls++
+	var found []db.Character
+	for _, character := range f.characters {
+		for _, id := range ids {
+			if character.ID == id {
+				found = append(found, character)
+			}
+		}
+	}
+	return found, nil
+}
+
+func (f *fakeDB) GetAllItems(ctx context.Context) ([]db.Item, error) {
+	f.tableCalls++
+	return f.items, nil
+}
+
+func (f *fakeDB) ListWorlds(ctx context.Context) ([]db.World, error) {
+	return f.worlds, nil
+}
+
+func (f *fakeDB) ListBlockRelations(ctx context.Context, userID pgtype.UUID) ([]pgtype.UUID, error) {
+	return f.blocked, nil
+}
+
+const (
+	userID       = "550e8400-e29b-41d4-a716-446655440000"
+	ownID        = "01000000-0000-0000-0000-000000000000"
+	strangerID   = "02000000-0000-0000-0000-000000000000"
+	blockedID    = "03000000-0000-0000-0000-000000000000"
+	travellerID  = "04000000-0000-0000-0000-000000000000"
+	worldID      = "09000000-0000-0000-0000-000000000000"
+	otherWorldID = "0a000000-0000-0000-0000-000000000000"
+)
+
+var (
+	user        = pgtype.UUID{Bytes: [16]byte{0x55, 0x0e, 0x84, 0x00, 0xe2, 0x9b, 0x41, 0xd4, 0xa7, 0x16, 0x44, 0x66, 0x55, 0x44, 0x00, 0x00}, Valid: true}
+	blockedUser = pgtype.UUID{Bytes: [16]byte{0xbb}, Valid: true}
+	world       = pgtype.UUID{Bytes: [16]byte{9}, Valid: true}
+	otherWorld  = pgtype.UUID{Bytes: [16]byte{10}, Valid: true}
+)
+
+func newTestService() (*Service, *fakeDB, *clock.Fake) {
+	database := &fakeDB{
+		characters: []db.Character{
+			{ID: pgtype.UUID{Bytes: [16]byte{1}, Valid: true}, UserID: user, WorldID: otherWorld, Name: "Own"},
+			{ID: pgtype.UUID{Bytes: [16]byte{2}, Valid: true}, UserID: pgtype.UUID{Bytes: [16]byte{0xaa}, Valid: true}, WorldID: world, Name: "Stranger", EquippedTitle: "pathfinder"},
+			{ID: pgtype.UUID{Bytes: [16]byte{3}, Valid: true}, UserID: blockedUser, WorldID: world, Name: "Blocked", EquippedTitle: "pathfinder"},
+			{ID: pgtype.UUID{Bytes: [16]byte{4}, Valid: true}, UserID: pgtype.UUID{Bytes: [16]byte{0xcc}, Valid: true}, WorldID: otherWorld, Name: "Traveller"},
+		},
+		items:   []db.Item{{ID: 1, Name: "Stone", ItemType: "material", Rarity: "common"}},
+		worlds:  []db.World{{ID: world, Name: "Home"}, {ID: otherWorld, Name: "Elsewhere"}},
+		blocked: []pgtype.UUID{blockedUser},
+	}
+	fake := clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	service := NewService(database, testmocks.NopLogger[LoggerInterface]{})
+	service.SetClock(fake)
+	return service, database, fake
+}
+
+func TestResolveReferences(t *testing.T) {
+	service, _, _ := newTestService()
+	ctx := session.WithWorldID(context.Background(), worldID)
+
+	resp, err := service.ResolveReferences(ctx, userID, &referenceV1.ResolveReferencesRequest{
+		CharacterIds: []string{strangerID, ownID, blockedID, travellerID, strangerID, "05000000-0000-0000-0000-000000000000"},
+		ItemIds:      []int32{1, 1, 99},
+		WorldIds:     []string{otherWorldID},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, resp.Characters, 4, "duplicates and unknown IDs are left out")
+	assert.Equal(t, &referenceV1.CharacterSummary{Id: strangerID, Name: "Stranger", WorldId: worldID, Title: "Pathfinder"}, resp.Characters[0])
+	assert.Equal(t, "Own", resp.Characters[1].Name, "the caller's own characters are never redacted")
+	assert.Equal(t, &referenceV1.CharacterSummary{Id: blockedID, Redacted: true}, resp.Characters[2])
+	assert.Equal(t, &referenceV1.CharacterSummary{Id: travellerID, Redacted: true}, resp.Characters[3], "characters in other worlds are redacted")
+
+	require.Len(t, resp.Items, 1)
+	assert.Equal(t, "Stone", resp.Items[0].Name)
+	require.Len(t, resp.Worlds, 1)
+	assert.Equal(t, "Elsewhere", resp.Worlds[0].Name)
+
+	// Without a session world only blocks redact
+	resp, err = service.ResolveReferences(context.Background(), userID, &referenceV1.ResolveReferencesRequest{CharacterIds: []string{travellerID}})
+	require.NoError(t, err)
+	assert.Equal(t, "Traveller", resp.Characters[0].Name)
+}
+
+func TestResolveReferences_Validation(t *testing.T) {
+	service, _, _ := newTestService()
+	ctx := context.Background()
+
+	_, err := service.ResolveReferences(ctx, userID, &referenceV1.ResolveReferencesRequest{CharacterIds: []string{"not-a-uuid"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	ids := make([]int32, service.config.MaxIDs+1)
+	_, err = service.ResolveReferences(ctx, userID, &referenceV1.ResolveReferencesRequest{ItemIds: ids})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestResolveReferences_Cache(t *testing.T) {
+	service, database, fake := newTestService()
+	ctx := context.Background()
+	req := &referenceV1.ResolveReferencesRequest{CharacterIds: []string{strangerID}, ItemIds: []int32{1}}
+
+	for range 3 {
+		_, err := service.ResolveReferences(ctx, userID, req)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, database.characterCalls)
+	assert.Equal(t, 1, database.tableCalls)
+
+	// Only uncached characters are queried
+	_, err := service.ResolveReferences(ctx, userID, &referenceV1.ResolveReferencesRequest{CharacterIds: []string{strangerID, ownID}})
+	require.NoError(t, err)
+	assert.Equal(t, 2, database.characterCalls)
+
+	database.characters[1].Name = "Renamed"
+	fake.Advance(service.config.CacheTTL)
+	resp, err := service.ResolveReferences(ctx, userID, req)
+	require.NoError(t, err)
+	assert.Equal(t, "Renamed", resp.Characters[0].Name)
+	assert.Equal(t, 2, database.tableCalls)
+
+	// Blocks are not cached
+	database.blocked = append(database.blocked, database.characters[1].UserID)
+	resp, err = service.ResolveReferences(ctx, userID, req)
+	require.NoError(t, err)
+	assert.True(t, resp.Characters[0].Redacted)
+}
+
+func TestResolveReferences_Renamed(t *testing.T) {
+	service, database, _ := newTestService()
+	bus := events.NewBus()
+	service.Subscribe(bus)
+	ctx := context.Background()
+	req := &referenceV1.ResolveReferencesRequest{CharacterIds: []string{strangerID}}
+
+	_, err := service.ResolveReferences(ctx, userID, req)
+	require.NoError(t, err)
+
+	// A rename drops the cached row before it expires
+	database.characters[1].Name = "Renamed"
+	data, err := json.Marshal(events.CharacterRenamedPayload{CharacterID: strangerID, OldName: "Stranger", NewName: "Renamed"})
+	require.NoError(t, err)
+	require.NoError(t, bus.Publish(ctx, events.Event{Type: events.CharacterRenamed, DedupKey: "rename:1", Payload: data}))
+
+	resp, err := service.ResolveReferences(ctx, userID, req)
+	require.NoError(t, err)
+	assert.Equal(t, "Renamed", resp.Characters[0].Name)
+	assert.Equal(t, 2, database.characterCalls)
+}
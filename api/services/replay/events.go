@@ -0,0 +1,108 @@
+package replay
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/geometry"
+)
+
+// Subscribe records the domain events that mutate chunks
+func (s *Service) Subscribe(bus *events.Bus) {
+	bus.Subscribe(events.CharacterCreated, events.Dedup(s.handleCharacterCreated, events.DefaultDedupCapacity))
+	bus.Subscribe(events.ChunkGenerated, events.Dedup(s.handleChunkGenerated, events.DefaultDedupCapacity))
+	bus.Subscribe(events.ResourceHarvested, events.Dedup(s.handleResourceHarvested, events.DefaultDedupCapacity))
+	bus.Subscribe(events.TerrainModified, events.Dedup(s.handleTerrainModified, events.DefaultDedupCapacity))
+}
+
+func (s *Service) handleCharacterCreated(ctx context.Context, event events.Event) error {
+	if s.Active() == 0 {
+		return nil
+	}
+	var payload events.CharacterCreatedPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("invalid %s payload: %w", event.Type, err)
+	}
+	chunkX, chunkY := geometry.ChunkOf(geometry.Point{X: payload.X, Y: payload.Y})
+	s.record(ctx, recorded{
+		eventType:   event.Type,
+		worldID:     payload.WorldID,
+		characterID: payload.CharacterID,
+		x:           payload.X,
+		y:           payload.Y,
+		chunkX:      chunkX,
+		chunkY:      chunkY,
+		payload:     event.Payload,
+		at:          event.OccurredAt,
+	})
+	return nil
+}
+
+func (s *Service) handleChunkGenerated(ctx context.Context, event events.Event) error {
+	if s.Active() == 0 {
+		return nil
+	}
+	var payload events.ChunkGeneratedPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("invalid %s payload: %w", event.Type, err)
+	}
+	origin := geometry.ChunkOrigin(payload.ChunkX, payload.ChunkY)
+	s.record(ctx, recorded{
+		eventType: event.Type,
+		worldID:   payload.WorldID,
+		x:         origin.X,
+		y:         origin.Y,
+		chunkX:    payload.ChunkX,
+		chunkY:    payload.ChunkY,
+		payload:   event.Payload,
+		at:        event.OccurredAt,
+	})
+	return nil
+}
+
+func (s *Service) handleResourceHarvested(ctx context.Context, event events.Event) error {
+	if s.Active() == 0 {
+		return nil
+	}
+	var payload events.ResourceHarvestedPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("invalid %s payload: %w", event.Type, err)
+	}
+	origin := geometry.ChunkOrigin(payload.ChunkX, payload.ChunkY)
+	s.record(ctx, recorded{
+		eventType:   event.Type,
+		worldID:     payload.WorldID,
+		characterID: payload.CharacterID,
+		x:           origin.X,
+		y:           origin.Y,
+		chunkX:      payload.ChunkX,
+		chunkY:      payload.ChunkY,
+		payload:     event.Payload,
+		at:          event.OccurredAt,
+	})
+	return nil
+}
+
+func (s *Service) handleTerrainModified(ctx context.Context, event events.Event) error {
+	if s.Active() == 0 {
+		return nil
+	}
+	var payload events.TerrainModifiedPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("invalid %s payload: %w", event.Type, err)
+	}
+	s.record(ctx, recorded{
+		eventType:   event.Type,
+		worldID:     payload.WorldID,
+		characterID: payload.CharacterID,
+		x:           payload.X,
+		y:           payload.Y,
+		chunkX:      payload.ChunkX,
+		chunkY:      payload.ChunkY,
+		payload:     event.Payload,
+		at:          event.OccurredAt,
+	})
+	return nil
+}
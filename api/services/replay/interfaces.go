@@ -0,0 +1,105 @@
+package replay
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/charmbracelet/log"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DatabaseInterface abstracts database operations for region recordings.
+type DatabaseInterface interface {
+	CreateRegionRecording(ctx context.Context, arg db.CreateRegionRecordingParams) (db.RegionRecording, error)
+	GetRegionRecording(ctx context.Context, id int64) (db.RegionRecording, error)
+	ListRegionRecordings(ctx context.Context, limit int32) ([]db.RegionRecording, error)
+	ListActiveRegionRecordings(ctx context.Context, at pgtype.Timestamp) ([]db.RegionRecording, error)
+	StopRegionRecording(ctx context.Context, arg db.StopRegionRecordingParams) (db.RegionRecording, error)
+	CreateRegionRecordingEvent(ctx context.Context, arg db.CreateRegionRecordingEventParams) error
+	CountRegionRecordingEvents(ctx context.Context, recordingID int64) (int64, error)
+	ListRegionRecordingEvents(ctx context.Context, arg db.ListRegionRecordingEventsParams) ([]db.RegionRecordingEvent, error)
+}
+
+// DatabaseWrapper implements DatabaseInterface using the actual database connection.
+type DatabaseWrapper struct {
+	queries *db.Queries
+}
+
+// NewDatabaseWrapper creates a new database wrapper with the given connection pool.
+func NewDatabaseWrapper(pool storage.Pool) DatabaseInterface {
+	return &DatabaseWrapper{
+		queries: db.New(pool),
+	}
+}
+
+func (d *DatabaseWrapper) CreateRegionRecording(ctx context.Context, arg db.CreateRegionRecordingParams) (db.RegionRecording, error) {
+	return d.queries.CreateRegionRecording(ctx, arg)
+}
+
+func (d *DatabaseWrapper) GetRegionRecording(ctx context.Context, id int64) (db.RegionRecording, error) {
+	return d.queries.GetRegionRecording(ctx, id)
+}
+
+func (d *DatabaseWrapper) ListRegionRecordings(ctx context.Context, limit int32) ([]db.RegionRecording, error) {
+	return d.queries.ListRegionRecordings(ctx, limit)
+}
+
+func (d *DatabaseWrapper) ListActiveRegionRecordings(ctx context.Context, at pgtype.Timestamp) ([]db.RegionRecording, error) {
+	return d.queries.ListActiveRegionRecordings(ctx, at)
+}
+
+func (d *DatabaseWrapper) StopRegionRecording(ctx context.Context, arg db.StopRegionRecordingParams) (db.RegionRecording, error) {
+	return d.queries.StopRegionRecording(ctx, arg)
+}
+
+func (d *DatabaseWrapper) CreateRegionRecordingEvent(ctx context.Context, arg db.CreateRegionRecordingEventParams) error {
+	return d.queries.CreateRegionRecordingEvent(ctx, arg)
+}
+
+func (d *DatabaseWrapper) CountRegionRecordingEvents(ctx context.Context, recordingID int64) (int64, error) {
+	return d.queries.CountRegionRecordingEvents(ctx, recordingID)
+}
+
+func (d *DatabaseWrapper) ListRegionRecordingEvents(ctx context.Context, arg db.ListRegionRecordingEventsParams) ([]db.RegionRecordingEvent, error) {
+	return d.queries.ListRegionRecordingEvents(ctx, arg)
+}
+
+// LoggerInterface abstracts logging operations for dependency injection.
+type LoggerInterface interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+	With(keysAndValues ...interface{}) LoggerInterface
+}
+
+// DefaultLoggerWrapper wraps the internal logging package.
+type DefaultLoggerWrapper struct {
+	logger *log.Logger
+}
+
+func NewDefaultLoggerWrapper() LoggerInterface {
+	return &DefaultLoggerWrapper{logger: logging.GetLogger()}
+}
+
+func (l *DefaultLoggerWrapper) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.Debug(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Info(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.Warn(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.Error(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) With(keysAndValues ...interface{}) LoggerInterface {
+	return &DefaultLoggerWrapper{logger: l.logger.With(keysAndValues...)}
+}
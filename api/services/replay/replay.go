@@ -0,0 +1,388 @@
+// Package replay records the mutating events in a rectangle of chunks for a time window
+// and replays them step by step, so duplication bugs and desyncs can be traced back to
+// the exact sequence of moves, harvests and terrain edits that caused them.
+package replay
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	debugV1 "github.com/VoidMesh/api/api/proto/debug/v1"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	DefaultDuration = 10 * time.Minute
+	MaxDuration     = time.Hour
+	MaxRegionChunks = 64 // Largest width * height a recording may cover
+
+	// MaxEvents bounds a single recording; later events in its window are dropped
+	MaxEvents = 10000
+
+	DefaultListLimit = 20
+	MaxListLimit     = 100
+)
+
+// CharacterMoved is recorded for character moves, which are not published as domain events
+const CharacterMoved = "character.moved"
+
+// CharacterMovedPayload is the recorded payload of a character move
+type CharacterMovedPayload struct {
+	CharacterID string `json:"character_id"`
+	WorldID     string `json:"world_id"`
+	X           int32  `json:"x"`
+	Y           int32  `json:"y"`
+	ChunkX      int32  `json:"chunk_x"`
+	ChunkY      int32  `json:"chunk_y"`
+}
+
+// Region is a rectangle of chunks, bounds inclusive
+type Region struct {
+	WorldID   string
+	MinChunkX int32
+	MinChunkY int32
+	MaxChunkX int32
+	MaxChunkY int32
+}
+
+// recorded is an event to store with every active recording covering its chunk
+type recorded struct {
+	eventType   string
+	worldID     string
+	characterID string
+	x, y        int32
+	chunkX      int32
+	chunkY      int32
+	payload     []byte
+	at          time.Time
+}
+
+// activeRecording is a recording this instance is still writing events to
+type activeRecording struct {
+	id        int64
+	worldID   string // Normalized
+	region    Region
+	startedAt time.Time
+	endsAt    time.Time
+	events    int
+	full      bool
+}
+
+func (r *activeRecording) covers(worldID string, chunkX, chunkY int32) bool {
+	return r.worldID == worldID &&
+		chunkX >= r.region.MinChunkX && chunkX <= r.region.MaxChunkX &&
+		chunkY >= r.region.MinChunkY && chunkY <= r.region.MaxChunkY
+}
+
+// Service manages region recordings and replays them.
+type Service struct {
+	db     DatabaseInterface
+	logger LoggerInterface
+	clock  clock.Clock
+
+	mu     sync.Mutex
+	active []*activeRecording
+}
+
+// NewService creates a new replay service with dependency injection.
+func NewService(db DatabaseInterface, logger LoggerInterface) *Service {
+	componentLogger := logger.With("component", "replay-service")
+	componentLogger.Debug("Creating new replay service")
+	return &Service{
+		db:     db,
+		logger: componentLogger,
+		clock:  clock.New(),
+	}
+}
+
+// NewServiceWithPool creates a service with concrete implementations (convenience constructor for production use).
+func NewServiceWithPool(pool storage.Pool) *Service {
+	return NewService(NewDatabaseWrapper(pool), NewDefaultLoggerWrapper())
+}
+
+// SetClock replaces the clock used for recording windows (for simulation tests)
+func (s *Service) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// Load resumes recordings whose window has not run out, e.g. after a restart
+func (s *Service) Load(ctx context.Context) error {
+	rows, err := s.db.ListActiveRegionRecordings(ctx, s.now())
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		count, err := s.db.CountRegionRecordingEvents(ctx, row.ID)
+		if err != nil {
+			return err
+		}
+		recording := newActiveRecording(row)
+		recording.events = int(count)
+		recording.full = recording.events >= MaxEvents
+		s.mu.Lock()
+		s.active = append(s.active, recording)
+		s.mu.Unlock()
+	}
+	if len(rows) > 0 {
+		s.logger.Info("Resumed region recordings", "count", len(rows))
+	}
+	return nil
+}
+
+// StartRecording begins recording the region for the given duration. The region's world
+// defaults to the caller's session world.
+func (s *Service) StartRecording(ctx context.Context, userID string, region Region, duration time.Duration) (*debugV1.RegionRecording, error) {
+	if region.WorldID == "" {
+		worldID, ok := session.WorldIDFromContext(ctx)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "world_id is required")
+		}
+		region.WorldID = worldID
+	}
+	worldID, err := uuid.StringToPgtype(region.WorldID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid world ID format")
+	}
+	if region.MinChunkX > region.MaxChunkX || region.MinChunkY > region.MaxChunkY {
+		return nil, status.Errorf(codes.InvalidArgument, "min chunk bounds must not exceed max chunk bounds")
+	}
+	width := int64(region.MaxChunkX) - int64(region.MinChunkX) + 1
+	height := int64(region.MaxChunkY) - int64(region.MinChunkY) + 1
+	if width*height > MaxRegionChunks {
+		return nil, status.Errorf(codes.InvalidArgument, "region covers %d chunks, at most %d may be recorded", width*height, MaxRegionChunks)
+	}
+	if duration < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "duration must not be negative")
+	}
+	if duration == 0 {
+		duration = DefaultDuration
+	}
+	if duration > MaxDuration {
+		duration = MaxDuration
+	}
+
+	// Admin tokens are not tied to a user row in every environment
+	startedBy, _ := uuid.StringToPgtype(userID)
+	now := s.clock.Now()
+	row, err := s.db.CreateRegionRecording(ctx, db.CreateRegionRecordingParams{
+		WorldID:   worldID,
+		MinChunkX: region.MinChunkX,
+		MinChunkY: region.MinChunkY,
+		MaxChunkX: region.MaxChunkX,
+		MaxChunkY: region.MaxChunkY,
+		StartedBy: startedBy,
+		StartedAt: pgtype.Timestamp{Time: now, Valid: true},
+		EndsAt:    pgtype.Timestamp{Time: now.Add(duration), Valid: true},
+	})
+	if err != nil {
+		s.logger.Error("Failed to create region recording", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to start recording")
+	}
+
+	s.mu.Lock()
+	s.active = append(s.active, newActiveRecording(row))
+	s.mu.Unlock()
+
+	s.logger.Info("Region recording started", "recording_id", row.ID, "world_id", region.WorldID,
+		"min_chunk_x", region.MinChunkX, "min_chunk_y", region.MinChunkY,
+		"max_chunk_x", region.MaxChunkX, "max_chunk_y", region.MaxChunkY, "duration", duration)
+	return s.dbRecordingToProto(row), nil
+}
+
+// StopRecording ends a recording before its window runs out
+func (s *Service) StopRecording(ctx context.Context, recordingID int64) (*debugV1.RegionRecording, error) {
+	row, err := s.db.StopRegionRecording(ctx, db.StopRegionRecordingParams{ID: recordingID, StoppedAt: s.now()})
+	if errors.Is(err, pgx.ErrNoRows) {
+		if _, getErr := s.db.GetRegionRecording(ctx, recordingID); getErr == nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "recording has already ended")
+		}
+		return nil, status.Errorf(codes.NotFound, "recording not found")
+	}
+	if err != nil {
+		s.logger.Error("Failed to stop region recording", "recording_id", recordingID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to stop recording")
+	}
+
+	s.mu.Lock()
+	for i, recording := range s.active {
+		if recording.id == recordingID {
+			s.active = append(s.active[:i], s.active[i+1:]...)
+			break
+		}
+	}
+	s.mu.Unlock()
+
+	s.logger.Info("Region recording stopped", "recording_id", recordingID)
+	return s.dbRecordingToProto(row), nil
+}
+
+// ListRecordings returns recordings, newest first
+func (s *Service) ListRecordings(ctx context.Context, limit int32) ([]*debugV1.RegionRecording, error) {
+	if limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must not be negative")
+	}
+	if limit == 0 {
+		limit = DefaultListLimit
+	}
+	if limit > MaxListLimit {
+		limit = MaxListLimit
+	}
+
+	rows, err := s.db.ListRegionRecordings(ctx, limit)
+	if err != nil {
+		s.logger.Error("Failed to list region recordings", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list recordings")
+	}
+	recordings := make([]*debugV1.RegionRecording, 0, len(rows))
+	for _, row := range rows {
+		recordings = append(recordings, s.dbRecordingToProto(row))
+	}
+	return recordings, nil
+}
+
+// Active returns how many recordings this instance is writing to
+func (s *Service) Active() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.active)
+}
+
+// record stores the event with every active recording covering its chunk. Recording
+// is best effort: failures are logged and never fail the mutation being recorded.
+func (s *Service) record(ctx context.Context, event recorded) {
+	worldID := uuid.Normalize(event.worldID)
+
+	s.mu.Lock()
+	var targets []int64
+	kept := s.active[:0]
+	for _, recording := range s.active {
+		if !s.clock.Now().Before(recording.endsAt) {
+			s.logger.Info("Region recording finished", "recording_id", recording.id, "events", recording.events)
+			continue
+		}
+		kept = append(kept, recording)
+		if recording.full || event.at.Before(recording.startedAt) || !recording.covers(worldID, event.chunkX, event.chunkY) {
+			continue
+		}
+		recording.events++
+		if recording.events >= MaxEvents {
+			recording.full = true
+			s.logger.Warn("Region recording is full, dropping further events", "recording_id", recording.id, "max_events", MaxEvents)
+		}
+		targets = append(targets, recording.id)
+	}
+	s.active = kept
+	s.mu.Unlock()
+
+	var characterID pgtype.UUID
+	if event.characterID != "" {
+		characterID, _ = uuid.StringToPgtype(event.characterID)
+	}
+	for _, recordingID := range targets {
+		err := s.db.CreateRegionRecordingEvent(ctx, db.CreateRegionRecordingEventParams{
+			RecordingID: recordingID,
+			EventType:   event.eventType,
+			CharacterID: characterID,
+			X:           event.x,
+			Y:           event.y,
+			ChunkX:      event.chunkX,
+			ChunkY:      event.chunkY,
+			Payload:     event.payload,
+			OccurredAt:  pgtype.Timestamp{Time: event.at, Valid: true},
+		})
+		if err != nil {
+			s.logger.Error("Failed to record region event", "recording_id", recordingID, "type", event.eventType, "error", err)
+		}
+	}
+}
+
+// RecordMove records a character move. The character service calls it after every
+// successful move.
+func (s *Service) RecordMove(ctx context.Context, character db.Character) {
+	if s.Active() == 0 {
+		return
+	}
+	payload, err := json.Marshal(CharacterMovedPayload{
+		CharacterID: uuid.PgtypeToString(character.ID),
+		WorldID:     uuid.PgtypeToString(character.WorldID),
+		X:           character.X,
+		Y:           character.Y,
+		ChunkX:      character.ChunkX,
+		ChunkY:      character.ChunkY,
+	})
+	if err != nil {
+		s.logger.Error("Failed to encode character move", "error", err)
+		return
+	}
+	s.record(ctx, recorded{
+		eventType:   CharacterMoved,
+		worldID:     uuid.PgtypeToString(character.WorldID),
+		characterID: uuid.PgtypeToString(character.ID),
+		x:           character.X,
+		y:           character.Y,
+		chunkX:      character.ChunkX,
+		chunkY:      character.ChunkY,
+		payload:     payload,
+		at:          s.clock.Now(),
+	})
+}
+
+func (s *Service) now() pgtype.Timestamp {
+	return pgtype.Timestamp{Time: s.clock.Now(), Valid: true}
+}
+
+func newActiveRecording(row db.RegionRecording) *activeRecording {
+	return &activeRecording{
+		id:      row.ID,
+		worldID: uuid.Normalize(uuid.PgtypeToString(row.WorldID)),
+		region: Region{
+			WorldID:   uuid.PgtypeToString(row.WorldID),
+			MinChunkX: row.MinChunkX,
+			MinChunkY: row.MinChunkY,
+			MaxChunkX: row.MaxChunkX,
+			MaxChunkY: row.MaxChunkY,
+		},
+		startedAt: row.StartedAt.Time,
+		endsAt:    row.EndsAt.Time,
+	}
+}
+
+func (s *Service) dbRecordingToProto(row db.RegionRecording) *debugV1.RegionRecording {
+	recording := &debugV1.RegionRecording{
+		Id:        row.ID,
+		WorldId:   uuid.PgtypeToString(row.WorldID),
+		MinChunkX: row.MinChunkX,
+		MinChunkY: row.MinChunkY,
+		MaxChunkX: row.MaxChunkX,
+		MaxChunkY: row.MaxChunkY,
+		StartedAt: timestamppb.New(row.StartedAt.Time),
+		EndsAt:    timestamppb.New(row.EndsAt.Time),
+	}
+	if row.StartedBy.Valid {
+		recording.StartedBy = uuid.PgtypeToString(row.StartedBy)
+	}
+	if row.StoppedAt.Valid {
+		recording.StoppedAt = timestamppb.New(row.StoppedAt.Time)
+	}
+
+	s.mu.Lock()
+	for _, active := range s.active {
+		if active.id == row.ID && s.clock.Now().Before(active.endsAt) {
+			recording.Active = true
+		}
+	}
+	s.mu.Unlock()
+	return recording
+}
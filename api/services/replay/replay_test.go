@@ -0,0 +1,286 @@
+package replay
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testWorld     = "650e8400-e29b-41d4-a716-446655440000"
+	otherWorld    = "650e8400-e29b-41d4-a716-446655440001"
+	testCharacter = "750e8400-e29b-41d4-a716-446655440000"
+	testAdmin     = "550e8400-e29b-41d4-a716-446655440000"
+)
+
+// fakeDB keeps recordings and their events in memory
+type fakeDB struct {
+	recordings []db.RegionRecording
+	events     []db.RegionRecordingEvent
+}
+
+func (f *fakeDB) CreateRegionRecording(ctx context.Context, arg db.CreateRegionRecordingParams) (db.RegionRecording, error) {
+	recording := db.RegionRecording{
+		ID:        int64(len(f.recordings) + 1),
+		WorldID:   arg.WorldID,
+		MinChunkX: arg.MinChunkX,
+		MinChunkY: arg.MinChunkY,
+		MaxChunkX: arg.MaxChunkX,
+		MaxChunkY: arg.MaxChunkY,
+		StartedBy: arg.StartedBy,
+		StartedAt: arg.StartedAt,
+		EndsAt:    arg.EndsAt,
+	}
+	f.recordings = append(f.recordings, recording)
+	return recording, nil
+}
+
+func (f *fakeDB) GetRegionRecording(ctx context.Context, id int64) (db.RegionRecording, error) {
+	if id < 1 || int(id) > len(f.recordings) {
+		return db.RegionRecording{}, pgx.ErrNoRows
+	}
+	return f.recordings[id-1], nil
+}
+
+func (f *fakeDB) ListRegionRecordings(ctx context.Context, limit int32) ([]db.RegionRecording, error) {
+	var recordings []db.RegionRecording
+	for i := len(f.recordings) - 1; i >= 0 && len(recordings) < int(limit); i-- {
+		recordings = append(recordings, f.recordings[i])
+	}
+	return recordings, nil
+}
+
+func (f *fakeDB) ListActiveRegionRecordings(ctx context.Context, at pgtype.Timestamp) ([]db.RegionRecording, error) {
+	var recordings []db.RegionRecording
+	for _, recording := range f.recordings {
+		if !recording.StoppedAt.Valid && recording.EndsAt.Time.After(at.Time) {
+			recordings = append(recordings, recording)
+		}
+	}
+	return recordings, nil
+}
+
+func (f *fakeDB) StopRegionRecording(ctx context.Context, arg db.StopRegionRecordingParams) (db.RegionRecording, error) {
+	recording, err := f.GetRegionRecording(ctx, arg.ID)
+	if err != nil || recording.StoppedAt.Valid || !recording.EndsAt.Time.After(arg.StoppedAt.Time) {
+		return db.RegionRecording{}, pgx.ErrNoRows
+	}
+	recording.StoppedAt = arg.StoppedAt
+	f.recordings[arg.ID-1] = recording
+	return recording, nil
+}
+
+func (f *fakeDB) CreateRegionRecordingEvent(ctx context.Context, arg db.CreateRegionRecordingEventParams) error {
+	f.events = append(f.events, db.RegionRecordingEvent{
+		ID:          int64(len(f.events) + 1),
+		RecordingID: arg.RecordingID,
+		EventType:   arg.EventType,
+		CharacterID: arg.CharacterID,
+		X:           arg.X,
+		Y:           arg.Y,
+		ChunkX:      arg.ChunkX,
+		ChunkY:      arg.ChunkY,
+		Payload:     arg.Payload,
+		OccurredAt:  arg.OccurredAt,
+	})
+	return nil
+}
+
+func (f *fakeDB) CountRegionRecordingEvents(ctx context.Context, recordingID int64) (int64, error) {
+	var count int64
+	for _, event := range f.events {
+		if event.RecordingID == recordingID {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (f *fakeDB) ListRegionRecordingEvents(ctx context.Context, arg db.ListRegionRecordingEventsParams) ([]db.RegionRecordingEvent, error) {
+	var events []db.RegionRecordingEvent
+	for _, event := range f.events {
+		if event.RecordingID == arg.RecordingID && len(events) < int(arg.Limit) {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+func newTestService() (*Service, *fakeDB, *clock.Fake) {
+	database := &fakeDB{}
+	service := NewService(database, testmocks.NopLogger[LoggerInterface]{})
+	fake := clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	service.SetClock(fake)
+	return service, database, fake
+}
+
+func mustUUID(t *testing.T, id string) pgtype.UUID {
+	t.Helper()
+	parsed, err := uuid.StringToPgtype(id)
+	require.NoError(t, err)
+	return parsed
+}
+
+func publish(t *testing.T, bus *events.Bus, eventType, dedupKey string, at time.Time, payload any) {
+	t.Helper()
+	data, err := json.Marshal(payload)
+	require.NoError(t, err)
+	require.NoError(t, bus.Publish(context.Background(), events.Event{Type: eventType, Payload: data, DedupKey: dedupKey, OccurredAt: at}))
+}
+
+func TestStartRecording_Validation(t *testing.T) {
+	service, _, _ := newTestService()
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		region Region
+	}{
+		{"missing world", Region{}},
+		{"inverted bounds", Region{WorldID: testWorld, MinChunkX: 1, MaxChunkX: 0}},
+		{"too many chunks", Region{WorldID: testWorld, MaxChunkX: 8, MaxChunkY: 7}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.StartRecording(ctx, testAdmin, tt.region, time.Minute)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+
+	recording, err := service.StartRecording(ctx, testAdmin, Region{WorldID: testWorld, MaxChunkX: 7, MaxChunkY: 7}, 3*time.Hour)
+	require.NoError(t, err)
+	assert.True(t, recording.Active)
+	assert.Equal(t, MaxDuration, recording.EndsAt.AsTime().Sub(recording.StartedAt.AsTime()), "duration is capped")
+}
+
+func TestRecord_OnlyInsideRegionAndWindow(t *testing.T) {
+	service, database, fake := newTestService()
+	bus := events.NewBus()
+	service.Subscribe(bus)
+	ctx := context.Background()
+
+	_, err := service.StartRecording(ctx, testAdmin, Region{WorldID: testWorld, MinChunkX: -1, MinChunkY: -1, MaxChunkX: 0, MaxChunkY: 0}, time.Minute)
+	require.NoError(t, err)
+	now := fake.Now()
+
+	// Inside: a move into chunk (-1, 0) and a terrain edit in chunk (0, 0)
+	service.RecordMove(ctx, db.Character{ID: mustUUID(t, testCharacter), WorldID: mustUUID(t, testWorld), X: -3, Y: 4, ChunkX: -1, ChunkY: 0})
+	terrain := events.TerrainModifiedPayload{WorldID: testWorld, X: 5, Y: 5, TerrainType: 2}
+	publish(t, bus, events.TerrainModified, "terrain:1", now, terrain)
+	publish(t, bus, events.TerrainModified, "terrain:1", now, terrain) // Redelivery
+
+	// Outside: another chunk, another world, and an event from before the recording started
+	publish(t, bus, events.ChunkGenerated, "chunk:1", now, events.ChunkGeneratedPayload{WorldID: testWorld, ChunkX: 1, ChunkY: 0})
+	publish(t, bus, events.ChunkGenerated, "chunk:2", now, events.ChunkGeneratedPayload{WorldID: otherWorld, ChunkX: 0, ChunkY: 0})
+	publish(t, bus, events.ChunkGenerated, "chunk:3", now.Add(-time.Second), events.ChunkGeneratedPayload{WorldID: testWorld, ChunkX: 0, ChunkY: 0})
+
+	// A character created at (-1, -1) is in chunk (-1, -1), not (0, 0)
+	publish(t, bus, events.CharacterCreated, "character:1", now, events.CharacterCreatedPayload{CharacterID: testCharacter, WorldID: testWorld, X: -1, Y: -1})
+
+	require.Len(t, database.events, 3)
+	assert.Equal(t, CharacterMoved, database.events[0].EventType)
+	assert.Equal(t, events.TerrainModified, database.events[1].EventType)
+	assert.Equal(t, events.CharacterCreated, database.events[2].EventType)
+	assert.Equal(t, int32(-1), database.events[2].ChunkX)
+
+	fake.Advance(time.Minute)
+	service.RecordMove(ctx, db.Character{ID: mustUUID(t, testCharacter), WorldID: mustUUID(t, testWorld), X: -2, Y: 4, ChunkX: -1, ChunkY: 0})
+	assert.Len(t, database.events, 3, "the window has run out")
+	assert.Equal(t, 0, service.Active())
+}
+
+func TestStopRecording(t *testing.T) {
+	service, database, _ := newTestService()
+	ctx := context.Background()
+
+	recording, err := service.StartRecording(ctx, testAdmin, Region{WorldID: testWorld}, time.Minute)
+	require.NoError(t, err)
+
+	stopped, err := service.StopRecording(ctx, recording.Id)
+	require.NoError(t, err)
+	assert.NotNil(t, stopped.StoppedAt)
+	assert.False(t, stopped.Active)
+
+	service.RecordMove(ctx, db.Character{ID: mustUUID(t, testCharacter), WorldID: mustUUID(t, testWorld)})
+	assert.Empty(t, database.events)
+
+	_, err = service.StopRecording(ctx, recording.Id)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = service.StopRecording(ctx, 99)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestLoad_ResumesActiveRecordings(t *testing.T) {
+	service, database, fake := newTestService()
+	ctx := context.Background()
+	_, err := service.StartRecording(ctx, testAdmin, Region{WorldID: testWorld}, time.Minute)
+	require.NoError(t, err)
+
+	// A restarted instance picks the recording back up
+	restarted := NewService(database, testmocks.NopLogger[LoggerInterface]{})
+	restarted.SetClock(fake)
+	require.NoError(t, restarted.Load(ctx))
+	assert.Equal(t, 1, restarted.Active())
+}
+
+func TestStep_RebuildsRegionState(t *testing.T) {
+	service, _, fake := newTestService()
+	bus := events.NewBus()
+	service.Subscribe(bus)
+	ctx := context.Background()
+
+	recording, err := service.StartRecording(ctx, testAdmin, Region{WorldID: testWorld}, time.Minute)
+	require.NoError(t, err)
+	now := fake.Now()
+
+	character := db.Character{ID: mustUUID(t, testCharacter), WorldID: mustUUID(t, testWorld), X: 1, Y: 1}
+	service.RecordMove(ctx, character) // Step 1
+	harvest := events.ResourceHarvestedPayload{ResourceNodeID: 7, CharacterID: testCharacter, WorldID: testWorld, Drops: 2}
+	publish(t, bus, events.ResourceHarvested, "harvest:1", now, harvest)                                                                                                                // Step 2
+	publish(t, bus, events.ResourceHarvested, "harvest:2", now, harvest)                                                                                                                // Step 3, a suspicious second harvest
+	publish(t, bus, events.TerrainModified, "terrain:1", now, events.TerrainModifiedPayload{WorldID: testWorld, X: 2, Y: 1, TerrainType: int32(chunkV1.TerrainType_TERRAIN_TYPE_DIRT)}) // Step 4
+	character.X = 2
+	service.RecordMove(ctx, character) // Step 5
+
+	start, err := service.Step(ctx, recording.Id, 0)
+	require.NoError(t, err)
+	assert.Nil(t, start.Event)
+	assert.Empty(t, start.State.Characters)
+	assert.Equal(t, int32(5), start.TotalSteps)
+
+	resp, err := service.Step(ctx, recording.Id, 3)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), resp.Event.Step)
+	assert.Equal(t, events.ResourceHarvested, resp.Event.Type)
+	require.Len(t, resp.State.ResourceNodes, 1)
+	assert.Equal(t, int32(2), resp.State.ResourceNodes[0].Harvests)
+	assert.Equal(t, int32(4), resp.State.ResourceNodes[0].Drops)
+	assert.Empty(t, resp.State.Cells)
+
+	resp, err = service.Step(ctx, recording.Id, 5)
+	require.NoError(t, err)
+	require.Len(t, resp.State.Characters, 1)
+	assert.Equal(t, int32(2), resp.State.Characters[0].X)
+	assert.Equal(t, int32(5), resp.State.Characters[0].LastStep)
+	require.Len(t, resp.State.Cells, 1)
+	assert.Equal(t, chunkV1.TerrainType_TERRAIN_TYPE_DIRT, resp.State.Cells[0].TerrainType)
+
+	_, err = service.Step(ctx, recording.Id, 6)
+	assert.Equal(t, codes.OutOfRange, status.Code(err))
+	_, err = service.Step(ctx, 99, 1)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
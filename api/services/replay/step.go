@@ -0,0 +1,169 @@
+package replay
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	debugV1 "github.com/VoidMesh/api/api/proto/debug/v1"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Step replays a recording up to step and returns the event at that step along with the
+// region state rebuilt from steps 1 through step. Step 0 returns the empty starting state.
+func (s *Service) Step(ctx context.Context, recordingID int64, step int32) (*debugV1.StepRegionReplayResponse, error) {
+	if step < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "step must not be negative")
+	}
+	row, err := s.db.GetRegionRecording(ctx, recordingID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "recording not found")
+	}
+	if err != nil {
+		s.logger.Error("Failed to get region recording", "recording_id", recordingID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to get recording")
+	}
+
+	total, err := s.db.CountRegionRecordingEvents(ctx, recordingID)
+	if err != nil {
+		s.logger.Error("Failed to count region recording events", "recording_id", recordingID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to get recording")
+	}
+	if int64(step) > total {
+		return nil, status.Errorf(codes.OutOfRange, "step %d is past the last step %d", step, total)
+	}
+
+	rows, err := s.db.ListRegionRecordingEvents(ctx, db.ListRegionRecordingEventsParams{RecordingID: recordingID, Limit: step})
+	if err != nil {
+		s.logger.Error("Failed to list region recording events", "recording_id", recordingID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to get recording")
+	}
+
+	state := newReplayState()
+	for i, event := range rows {
+		if err := state.apply(int32(i+1), event); err != nil {
+			// A corrupt row should not hide the rest of the recording
+			s.logger.Warn("Skipping unreadable recorded event", "recording_id", recordingID, "step", i+1, "error", err)
+		}
+	}
+
+	resp := &debugV1.StepRegionReplayResponse{
+		Recording:  s.dbRecordingToProto(row),
+		State:      state.toProto(),
+		TotalSteps: int32(total),
+	}
+	if step > 0 && len(rows) > 0 {
+		resp.Event = dbEventToProto(int32(len(rows)), rows[len(rows)-1])
+	}
+	return resp, nil
+}
+
+type cellKey struct{ x, y int32 }
+
+// replayState is the region state rebuilt from recorded events
+type replayState struct {
+	cells      map[cellKey]*debugV1.ReplayCell
+	characters map[string]*debugV1.ReplayCharacter
+	nodes      map[int32]*debugV1.ReplayResourceNode
+	chunks     []*chunkV1.ChunkCoordinate
+}
+
+func newReplayState() *replayState {
+	return &replayState{
+		cells:      make(map[cellKey]*debugV1.ReplayCell),
+		characters: make(map[string]*debugV1.ReplayCharacter),
+		nodes:      make(map[int32]*debugV1.ReplayResourceNode),
+	}
+}
+
+func (r *replayState) apply(step int32, event db.RegionRecordingEvent) error {
+	switch event.EventType {
+	case CharacterMoved, events.CharacterCreated:
+		id := uuid.PgtypeToString(event.CharacterID)
+		r.characters[id] = &debugV1.ReplayCharacter{CharacterId: id, X: event.X, Y: event.Y, LastStep: step}
+
+	case events.ChunkGenerated:
+		r.chunks = append(r.chunks, &chunkV1.ChunkCoordinate{ChunkX: event.ChunkX, ChunkY: event.ChunkY})
+
+	case events.ResourceHarvested:
+		var payload events.ResourceHarvestedPayload
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return err
+		}
+		node, ok := r.nodes[payload.ResourceNodeID]
+		if !ok {
+			node = &debugV1.ReplayResourceNode{ResourceNodeId: payload.ResourceNodeID}
+			r.nodes[payload.ResourceNodeID] = node
+		}
+		node.Harvests++
+		node.Drops += int32(payload.Drops)
+		node.LastStep = step
+
+	case events.TerrainModified:
+		var payload events.TerrainModifiedPayload
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return err
+		}
+		at := cellKey{payload.X, payload.Y}
+		cell, ok := r.cells[at]
+		if !ok {
+			cell = &debugV1.ReplayCell{X: payload.X, Y: payload.Y}
+			r.cells[at] = cell
+		}
+		cell.TerrainType = chunkV1.TerrainType(payload.TerrainType)
+		cell.Modifications++
+	}
+	return nil
+}
+
+// toProto returns the state with entries in a stable order so consecutive steps diff cleanly
+func (r *replayState) toProto() *debugV1.ReplayState {
+	state := &debugV1.ReplayState{GeneratedChunks: r.chunks}
+	for _, cell := range r.cells {
+		state.Cells = append(state.Cells, cell)
+	}
+	sort.Slice(state.Cells, func(i, j int) bool {
+		if state.Cells[i].Y != state.Cells[j].Y {
+			return state.Cells[i].Y < state.Cells[j].Y
+		}
+		return state.Cells[i].X < state.Cells[j].X
+	})
+	for _, character := range r.characters {
+		state.Characters = append(state.Characters, character)
+	}
+	sort.Slice(state.Characters, func(i, j int) bool {
+		return state.Characters[i].CharacterId < state.Characters[j].CharacterId
+	})
+	for _, node := range r.nodes {
+		state.ResourceNodes = append(state.ResourceNodes, node)
+	}
+	sort.Slice(state.ResourceNodes, func(i, j int) bool {
+		return state.ResourceNodes[i].ResourceNodeId < state.ResourceNodes[j].ResourceNodeId
+	})
+	return state
+}
+
+func dbEventToProto(step int32, event db.RegionRecordingEvent) *debugV1.RecordedEvent {
+	recorded := &debugV1.RecordedEvent{
+		Step:        step,
+		Type:        event.EventType,
+		X:           event.X,
+		Y:           event.Y,
+		ChunkX:      event.ChunkX,
+		ChunkY:      event.ChunkY,
+		PayloadJson: string(event.Payload),
+		OccurredAt:  timestamppb.New(event.OccurredAt.Time),
+	}
+	if event.CharacterID.Valid {
+		recorded.CharacterId = uuid.PgtypeToString(event.CharacterID)
+	}
+	return recorded
+}
@@ -0,0 +1,374 @@
+package resource_node
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/VoidMesh/api/api/config/balance"
+	resourceNodeV1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultBalanceSource identifies the embedded balance configuration in logs and RPC responses
+const DefaultBalanceSource = "embedded:config/balance/resource_nodes.yaml"
+
+// rarityKeys lists the rarity names every balance file must configure
+var rarityKeys = []string{"common", "uncommon", "rare", "very_rare"}
+
+// validTerrainTypes lists the terrain names resource types may spawn on
+var validTerrainTypes = map[string]bool{
+	"grass": true,
+	"water": true,
+	"sand":  true,
+	"dirt":  true,
+	"stone": true,
+}
+
+// BalanceConfig holds the tunable resource generation values loaded from a data file
+type BalanceConfig struct {
+	Version          int                    `yaml:"version"`
+	Generation       GenerationConfig       `yaml:"generation"`
+	RarityThresholds map[string]float64     `yaml:"rarity_thresholds"`
+	ClusterSizes     map[string]map[int]int `yaml:"cluster_sizes"`
+	ResourceTypes    []ResourceTypeConfig   `yaml:"resource_types"`
+	// Quality is optional; without it every node is normal quality
+	Quality map[string]QualityTierConfig `yaml:"quality"`
+}
+
+// QualityTierConfig is the share of nodes rolled at a quality tier and how much
+// harvests from those nodes yield
+type QualityTierConfig struct {
+	Share           float64 `yaml:"share"`
+	YieldMultiplier float64 `yaml:"yield_multiplier"`
+}
+
+// GenerationConfig holds per-chunk spawn limits
+type GenerationConfig struct {
+	MaxResourcesPerChunk int     `yaml:"max_resources_per_chunk"`
+	MinClusterDistance   float64 `yaml:"min_cluster_distance"`
+}
+
+// ResourceTypeConfig describes a single resource node type and its yields
+type ResourceTypeConfig struct {
+	ID          int32  `yaml:"id"`
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+	TerrainType string `yaml:"terrain_type"`
+	Rarity      string `yaml:"rarity"`
+	Sprite      string `yaml:"sprite"`
+	Color       string `yaml:"color"`
+	HarvestTime int32  `yaml:"harvest_time"`
+	RespawnTime int32  `yaml:"respawn_time"`
+	YieldMin    int32  `yaml:"yield_min"`
+	YieldMax    int32  `yaml:"yield_max"`
+}
+
+// BalanceConfigInfo describes the balance configuration currently applied to the service
+type BalanceConfigInfo struct {
+	Version           int
+	Checksum          string
+	Source            string
+	ResourceTypeCount int
+	LoadedAt          time.Time
+}
+
+// ParseBalanceConfig decodes and validates a balance configuration.
+// Unknown fields are rejected so typos in data files fail loudly instead of being ignored.
+func ParseBalanceConfig(data []byte) (*BalanceConfig, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	var cfg BalanceConfig
+	if err := decoder.Decode(&cfg); err != nil {
+		return nil, fmt.Errorf("failed to decode balance config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid balance config: %w", err)
+	}
+
+	return &cfg, nil
+}
+
+// Validate checks that the configuration is complete and internally consistent
+func (c *BalanceConfig) Validate() error {
+	var errs []error
+
+	if c.Version <= 0 {
+		errs = append(errs, fmt.Errorf("version must be positive, got %d", c.Version))
+	}
+	if c.Generation.MaxResourcesPerChunk <= 0 {
+		errs = append(errs, fmt.Errorf("generation.max_resources_per_chunk must be positive, got %d", c.Generation.MaxResourcesPerChunk))
+	}
+	if c.Generation.MinClusterDistance < 0 {
+		errs = append(errs, fmt.Errorf("generation.min_cluster_distance must not be negative, got %v", c.Generation.MinClusterDistance))
+	}
+
+	for _, rarity := range rarityKeys {
+		threshold, ok := c.RarityThresholds[rarity]
+		if !ok {
+			errs = append(errs, fmt.Errorf("rarity_thresholds.%s is missing", rarity))
+		} else if threshold < 0 || threshold >= 1 {
+			errs = append(errs, fmt.Errorf("rarity_thresholds.%s must be in [0, 1), got %v", rarity, threshold))
+		}
+
+		weights, ok := c.ClusterSizes[rarity]
+		if !ok {
+			errs = append(errs, fmt.Errorf("cluster_sizes.%s is missing", rarity))
+			continue
+		}
+		totalWeight := 0
+		for size, weight := range weights {
+			if size < 1 {
+				errs = append(errs, fmt.Errorf("cluster_sizes.%s has invalid size %d", rarity, size))
+			}
+			if weight < 0 {
+				errs = append(errs, fmt.Errorf("cluster_sizes.%s.%d has negative weight %d", rarity, size, weight))
+			}
+			totalWeight += weight
+		}
+		if totalWeight <= 0 {
+			errs = append(errs, fmt.Errorf("cluster_sizes.%s must have at least one positive weight", rarity))
+		}
+	}
+
+	for rarity := range c.RarityThresholds {
+		if rarityFromString(rarity) == resourceNodeV1.ResourceRarity_RESOURCE_RARITY_UNSPECIFIED {
+			errs = append(errs, fmt.Errorf("rarity_thresholds has unknown rarity %q", rarity))
+		}
+	}
+	for rarity := range c.ClusterSizes {
+		if rarityFromString(rarity) == resourceNodeV1.ResourceRarity_RESOURCE_RARITY_UNSPECIFIED {
+			errs = append(errs, fmt.Errorf("cluster_sizes has unknown rarity %q", rarity))
+		}
+	}
+
+	if len(c.Quality) > 0 {
+		errs = append(errs, c.validateQuality()...)
+	}
+
+	if len(c.ResourceTypes) == 0 {
+		errs = append(errs, errors.New("resource_types must not be empty"))
+	}
+	seenIDs := make(map[int32]bool, len(c.ResourceTypes))
+	for i, rt := range c.ResourceTypes {
+		prefix := fmt.Sprintf("resource_types[%d]", i)
+		if !isBuiltInResourceTypeID(rt.ID) {
+			errs = append(errs, fmt.Errorf("%s has unknown id %d", prefix, rt.ID))
+		}
+		if seenIDs[rt.ID] {
+			errs = append(errs, fmt.Errorf("%s duplicates id %d", prefix, rt.ID))
+		}
+		seenIDs[rt.ID] = true
+		errs = append(errs, rt.validate(prefix)...)
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateQuality checks that every quality tier is configured and the shares add up to 1
+func (c *BalanceConfig) validateQuality() []error {
+	var errs []error
+	totalShare := 0.0
+	for _, name := range qualityKeys {
+		tier, ok := c.Quality[name]
+		if !ok {
+			errs = append(errs, fmt.Errorf("quality.%s is missing", name))
+			continue
+		}
+		if tier.Share < 0 || tier.Share > 1 {
+			errs = append(errs, fmt.Errorf("quality.%s.share must be in [0, 1], got %v", name, tier.Share))
+		}
+		if tier.YieldMultiplier <= 0 {
+			errs = append(errs, fmt.Errorf("quality.%s.yield_multiplier must be positive, got %v", name, tier.YieldMultiplier))
+		}
+		totalShare += tier.Share
+	}
+	for name := range c.Quality {
+		if qualityFromString(name) == resourceNodeV1.ResourceNodeQuality_RESOURCE_NODE_QUALITY_UNSPECIFIED {
+			errs = append(errs, fmt.Errorf("quality has unknown tier %q", name))
+		}
+	}
+	if math.Abs(totalShare-1) > 1e-6 {
+		errs = append(errs, fmt.Errorf("quality shares must add up to 1, got %v", totalShare))
+	}
+	return errs
+}
+
+// validate checks everything about a resource type except its id, whose allowed
+// range depends on whether it comes from the balance file or a provider
+func (rt ResourceTypeConfig) validate(prefix string) []error {
+	var errs []error
+	if rt.Name == "" {
+		errs = append(errs, fmt.Errorf("%s is missing a name", prefix))
+	}
+	if !validTerrainTypes[rt.TerrainType] {
+		errs = append(errs, fmt.Errorf("%s has unknown terrain_type %q", prefix, rt.TerrainType))
+	}
+	if rarityFromString(rt.Rarity) == resourceNodeV1.ResourceRarity_RESOURCE_RARITY_UNSPECIFIED {
+		errs = append(errs, fmt.Errorf("%s has unknown rarity %q", prefix, rt.Rarity))
+	}
+	if rt.HarvestTime <= 0 || rt.RespawnTime <= 0 {
+		errs = append(errs, fmt.Errorf("%s must have positive harvest_time and respawn_time", prefix))
+	}
+	if rt.YieldMin < 1 || rt.YieldMax < rt.YieldMin {
+		errs = append(errs, fmt.Errorf("%s must satisfy 1 <= yield_min <= yield_max, got %d..%d", prefix, rt.YieldMin, rt.YieldMax))
+	}
+	return errs
+}
+
+// LoadBalanceConfigFile reads and validates a balance file, returning the parsed
+// configuration and the SHA-256 checksum of its contents. An empty path loads the
+// embedded default configuration.
+func LoadBalanceConfigFile(path string) (*BalanceConfig, string, error) {
+	data := balance.ResourceNodes
+	if path != "" {
+		var err error
+		data, err = os.ReadFile(path)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to read balance config %s: %w", path, err)
+		}
+	}
+
+	cfg, err := ParseBalanceConfig(data)
+	if err != nil {
+		return nil, "", err
+	}
+
+	sum := sha256.Sum256(data)
+	return cfg, hex.EncodeToString(sum[:]), nil
+}
+
+// LoadBalanceConfig loads the balance file at path and applies it to the service.
+// The path is remembered so later reloads read the same file. An empty path
+// selects the embedded default configuration.
+func (s *NodeService) LoadBalanceConfig(path string) (*BalanceConfigInfo, error) {
+	cfg, checksum, err := LoadBalanceConfigFile(path)
+	if err != nil {
+		s.logger.Error("Failed to load balance config", "path", path, "error", err)
+		return nil, err
+	}
+
+	source := path
+	if source == "" {
+		source = DefaultBalanceSource
+	}
+
+	s.applyBalanceConfig(cfg, checksum, source)
+	s.balanceMu.Lock()
+	s.balancePath = path
+	s.balanceMu.Unlock()
+
+	info := s.BalanceConfigInfo()
+	return &info, nil
+}
+
+// ReloadBalanceConfig re-reads the balance file the service was configured with.
+// If the new file fails validation the current configuration stays in effect.
+func (s *NodeService) ReloadBalanceConfig(ctx context.Context) (*resourceNodeV1.ReloadBalanceConfigResponse, error) {
+	s.balanceMu.RLock()
+	path := s.balancePath
+	s.balanceMu.RUnlock()
+
+	s.logger.Info("Reloading balance config", "source", path)
+	info, err := s.LoadBalanceConfig(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resourceNodeV1.ReloadBalanceConfigResponse{
+		Version:           int32(info.Version),
+		Checksum:          info.Checksum,
+		Source:            info.Source,
+		ResourceTypeCount: int32(info.ResourceTypeCount),
+		LoadedAt:          timestamppb.New(info.LoadedAt),
+	}, nil
+}
+
+// BalanceConfigInfo returns details about the balance configuration in effect
+func (s *NodeService) BalanceConfigInfo() BalanceConfigInfo {
+	s.balanceMu.RLock()
+	defer s.balanceMu.RUnlock()
+	return s.balanceInfo
+}
+
+// applyBalanceConfig swaps in a validated configuration and rebuilds the resource type
+// caches from its types and those of every registered provider
+func (s *NodeService) applyBalanceConfig(cfg *BalanceConfig, checksum, source string) {
+	configured := append(slices.Clone(cfg.ResourceTypes), ProvidedResourceTypes()...)
+	resourceTypes := make([]*resourceNodeV1.ResourceNodeType, 0, len(configured))
+	byTerrain := make(map[string][]*resourceNodeV1.ResourceNodeType)
+	byID := make(map[int32]*resourceNodeV1.ResourceNodeType, len(configured))
+
+	for _, rt := range configured {
+		resourceType := rt.toProto()
+		resourceTypes = append(resourceTypes, resourceType)
+		byTerrain[resourceType.TerrainType] = append(byTerrain[resourceType.TerrainType], resourceType)
+		byID[resourceType.Id] = resourceType
+	}
+
+	info := BalanceConfigInfo{
+		Version:           cfg.Version,
+		Checksum:          checksum,
+		Source:            source,
+		ResourceTypeCount: len(resourceTypes),
+		LoadedAt:          s.clock.Now(),
+	}
+
+	s.balanceMu.Lock()
+	s.balance = cfg
+	s.balanceInfo = info
+	s.resourceTypes = resourceTypes
+	s.resourceTypesByTerrain = byTerrain
+	s.resourceTypesByID = byID
+	s.balanceMu.Unlock()
+
+	s.logger.Info("Balance config applied",
+		"version", info.Version,
+		"checksum", info.Checksum,
+		"source", info.Source,
+		"resource_types", info.ResourceTypeCount)
+}
+
+// toProto converts a configured resource type into its API representation
+func (rt ResourceTypeConfig) toProto() *resourceNodeV1.ResourceNodeType {
+	return &resourceNodeV1.ResourceNodeType{
+		Id:          rt.ID,
+		Name:        rt.Name,
+		Description: rt.Description,
+		TerrainType: rt.TerrainType,
+		Rarity:      rarityFromString(rt.Rarity),
+		VisualData:  &resourceNodeV1.ResourceVisual{Sprite: rt.Sprite, Color: rt.Color},
+		Properties: &resourceNodeV1.ResourceProperties{
+			HarvestTime: rt.HarvestTime,
+			RespawnTime: rt.RespawnTime,
+			YieldMin:    rt.YieldMin,
+			YieldMax:    rt.YieldMax,
+		},
+	}
+}
+
+// rarityFromString maps a balance file rarity name to the proto enum
+func rarityFromString(rarity string) resourceNodeV1.ResourceRarity {
+	switch rarity {
+	case "common":
+		return resourceNodeV1.ResourceRarity_RESOURCE_RARITY_COMMON
+	case "uncommon":
+		return resourceNodeV1.ResourceRarity_RESOURCE_RARITY_UNCOMMON
+	case "rare":
+		return resourceNodeV1.ResourceRarity_RESOURCE_RARITY_RARE
+	case "very_rare":
+		return resourceNodeV1.ResourceRarity_RESOURCE_RARITY_VERY_RARE
+	default:
+		return resourceNodeV1.ResourceRarity_RESOURCE_RARITY_UNSPECIFIED
+	}
+}
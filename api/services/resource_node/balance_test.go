@@ -0,0 +1,223 @@
+package resource_node
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/VoidMesh/api/api/config/balance"
+	"github.com/VoidMesh/api/api/internal/clock"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	resourceNodeV1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
+)
+
+const minimalBalanceConfig = `
+version: 2
+generation:
+  max_resources_per_chunk: 8
+  min_cluster_distance: 2
+rarity_thresholds: { common: 0.1, uncommon: 0.2, rare: 0.3, very_rare: 0.4 }
+cluster_sizes:
+  common: { 1: 1 }
+  uncommon: { 1: 1 }
+  rare: { 1: 1 }
+  very_rare: { 1: 1 }
+resource_types:
+  - id: 1
+    name: Herb Patch
+    terrain_type: grass
+    rarity: common
+    harvest_time: 1
+    respawn_time: 10
+    yield_min: 5
+    yield_max: 7
+`
+
+func newBalanceTestService() *NodeService {
+	return NewNodeService(NewMockDatabase(), NewMockNoiseGenerator(12345), NewMockWorldService(), fixedStreams(NewMockRandomGenerator()), NewMockLogger())
+}
+
+func writeBalanceFile(t *testing.T, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "resource_nodes.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+	return path
+}
+
+func TestParseBalanceConfig_EmbeddedDefault(t *testing.T) {
+	cfg, err := ParseBalanceConfig(balance.ResourceNodes)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, cfg.Version)
+	assert.Equal(t, defaultMaxResourcesPerChunk, cfg.Generation.MaxResourcesPerChunk)
+	assert.Len(t, cfg.ResourceTypes, len(resourceNodeV1.ResourceNodeTypeId_name)-1, "every resource node type id should be configured")
+}
+
+func TestParseBalanceConfig_Invalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		mutate      func(string) string
+		errContains string
+	}{
+		{
+			name:        "unknown field",
+			mutate:      func(s string) string { return s + "spawn_weights: {}\n" },
+			errContains: "field spawn_weights not found",
+		},
+		{
+			name:        "missing rarity threshold",
+			mutate:      func(s string) string { return strings.Replace(s, "very_rare: 0.4", "", 1) },
+			errContains: "rarity_thresholds.very_rare is missing",
+		},
+		{
+			name:        "threshold out of range",
+			mutate:      func(s string) string { return strings.Replace(s, "common: 0.1", "common: 1.5", 1) },
+			errContains: "rarity_thresholds.common must be in [0, 1)",
+		},
+		{
+			name:        "all cluster weights zero",
+			mutate:      func(s string) string { return strings.Replace(s, "rare: { 1: 1 }", "rare: { 1: 0 }", 1) },
+			errContains: "cluster_sizes.rare must have at least one positive weight",
+		},
+		{
+			name:        "unknown resource type id",
+			mutate:      func(s string) string { return strings.Replace(s, "- id: 1", "- id: 99", 1) },
+			errContains: "unknown id 99",
+		},
+		{
+			name:        "unknown terrain",
+			mutate:      func(s string) string { return strings.Replace(s, "terrain_type: grass", "terrain_type: lava", 1) },
+			errContains: `unknown terrain_type "lava"`,
+		},
+		{
+			name: "quality shares do not add up",
+			mutate: func(s string) string {
+				return s + "quality: { poor: { share: 0.5, yield_multiplier: 0.5 }, normal: { share: 0.6, yield_multiplier: 1 }, rich: { share: 0.1, yield_multiplier: 2 } }\n"
+			},
+			errContains: "quality shares must add up to 1",
+		},
+		{
+			name: "missing quality tier",
+			mutate: func(s string) string {
+				return s + "quality: { poor: { share: 0.5, yield_multiplier: 0.5 }, normal: { share: 0.5, yield_multiplier: 1 } }\n"
+			},
+			errContains: "quality.rich is missing",
+		},
+		{
+			name:        "inverted yields",
+			mutate:      func(s string) string { return strings.Replace(s, "yield_max: 7", "yield_max: 2", 1) },
+			errContains: "yield_min <= yield_max",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseBalanceConfig([]byte(tt.mutate(minimalBalanceConfig)))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errContains)
+		})
+	}
+}
+
+func TestNodeService_LoadBalanceConfig(t *testing.T) {
+	service := newBalanceTestService()
+	assert.Equal(t, DefaultBalanceSource, service.BalanceConfigInfo().Source)
+
+	path := writeBalanceFile(t, minimalBalanceConfig)
+	info, err := service.LoadBalanceConfig(path)
+	require.NoError(t, err)
+
+	sum := sha256.Sum256([]byte(minimalBalanceConfig))
+	assert.Equal(t, 2, info.Version)
+	assert.Equal(t, hex.EncodeToString(sum[:]), info.Checksum)
+	assert.Equal(t, path, info.Source)
+	assert.Equal(t, 1, info.ResourceTypeCount)
+	assert.False(t, info.LoadedAt.IsZero())
+
+	types, err := service.GetResourceNodeTypes(context.Background())
+	require.NoError(t, err)
+	require.Len(t, types, 1)
+	assert.Equal(t, int32(5), types[0].Properties.YieldMin)
+	assert.Equal(t, int32(7), types[0].Properties.YieldMax)
+	assert.Equal(t, 0.1, service.getRarityThresholdFromEnum(resourceNodeV1.ResourceRarity_RESOURCE_RARITY_COMMON))
+}
+
+func TestNodeService_LoadBalanceConfig_UsesClock(t *testing.T) {
+	service := newBalanceTestService()
+	fakeClock := clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	service.SetClock(fakeClock)
+
+	info, err := service.LoadBalanceConfig(writeBalanceFile(t, minimalBalanceConfig))
+	require.NoError(t, err)
+	assert.Equal(t, fakeClock.Now(), info.LoadedAt)
+}
+
+func TestNodeService_SetExperimentParams(t *testing.T) {
+	service := newBalanceTestService()
+	assert.Equal(t, defaultMaxResourcesPerChunk, service.maxResourcesPerChunk())
+
+	service.SetExperimentParams(map[string]float64{DensityParam: 1.5, "unknown": 3})
+	assert.Equal(t, 36, service.maxResourcesPerChunk())
+
+	service.SetExperimentParams(map[string]float64{DensityParam: -1})
+	assert.Equal(t, defaultMaxResourcesPerChunk, service.maxResourcesPerChunk(), "invalid densities are ignored")
+
+	service.SetExperimentParams(map[string]float64{DensityParam: 1.5})
+	service.SetEventDensity(2)
+	assert.Equal(t, 72, service.maxResourcesPerChunk(), "event density scales the experiment density")
+}
+
+// fixedMaturity gives every region the same density
+type fixedMaturity float64
+
+func (m fixedMaturity) DensityAt(chunkX, chunkY int32) float64 { return float64(m) }
+
+func TestNodeService_SetMaturity(t *testing.T) {
+	service := NewNodeService(NewMockDatabase(), NewMockNoiseGenerator(12345), NewMockWorldService(), NewRandomStreams, NewMockLogger())
+	chunk := createTestChunkData(3, 3, chunkV1.TerrainType_TERRAIN_TYPE_GRASS)
+
+	full, err := service.GenerateResourcesForChunk(context.Background(), chunk)
+	require.NoError(t, err)
+	require.Greater(t, len(full), defaultMaxResourcesPerChunk/4)
+
+	service.SetMaturity(fixedMaturity(0.25))
+	depleted, err := service.GenerateResourcesForChunk(context.Background(), chunk)
+	require.NoError(t, err)
+	// Clusters started under the limit may overshoot it by a few nodes
+	assert.Less(t, len(depleted), len(full)/2, "the region density scales nodes per chunk")
+}
+
+func TestNodeService_ReloadBalanceConfig(t *testing.T) {
+	service := newBalanceTestService()
+	path := writeBalanceFile(t, minimalBalanceConfig)
+	_, err := service.LoadBalanceConfig(path)
+	require.NoError(t, err)
+
+	t.Run("picks up edits to the file", func(t *testing.T) {
+		updated := strings.Replace(minimalBalanceConfig, "version: 2", "version: 3", 1)
+		require.NoError(t, os.WriteFile(path, []byte(updated), 0o600))
+
+		resp, err := service.ReloadBalanceConfig(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), resp.Version)
+		assert.Equal(t, path, resp.Source)
+		assert.Equal(t, int32(1), resp.ResourceTypeCount)
+	})
+
+	t.Run("keeps current config when the file is invalid", func(t *testing.T) {
+		before := service.BalanceConfigInfo()
+		require.NoError(t, os.WriteFile(path, []byte("version: 0\n"), 0o600))
+
+		_, err := service.ReloadBalanceConfig(context.Background())
+		require.Error(t, err)
+		assert.Equal(t, before, service.BalanceConfigInfo())
+	})
+}
@@ -0,0 +1,116 @@
+package resource_node
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	resourceNodeV1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// layout reduces generated nodes to what generation decides, leaving out timestamps
+func layout(nodes []*resourceNodeV1.ResourceNode) []string {
+	var placed []string
+	for _, node := range nodes {
+		placed = append(placed, fmt.Sprintf("%d@%d,%d/%s", node.ResourceNodeTypeId, node.X, node.Y, node.ClusterId))
+	}
+	return placed
+}
+
+func TestGenerateResourcesForChunk_IndependentOfOrder(t *testing.T) {
+	chunks := []*chunkV1.ChunkData{
+		createTestChunkData(0, 0, chunkV1.TerrainType_TERRAIN_TYPE_GRASS),
+		createTestChunkData(1, 0, chunkV1.TerrainType_TERRAIN_TYPE_STONE),
+		createTestChunkData(-4, 7, chunkV1.TerrainType_TERRAIN_TYPE_WATER),
+	}
+	generate := func(order []int) map[int][]string {
+		service := NewNodeService(NewMockDatabase(), NewMockNoiseGenerator(12345), NewMockWorldService(), NewRandomStreams, NewMockLogger())
+		results := make(map[int][]string)
+		for _, i := range order {
+			nodes, err := service.GenerateResourcesForChunk(context.Background(), chunks[i])
+			require.NoError(t, err)
+			results[i] = layout(nodes)
+		}
+		return results
+	}
+
+	forward := generate([]int{0, 1, 2})
+	for i := range chunks {
+		assert.NotEmpty(t, forward[i], "chunk %d should get resources", i)
+	}
+	assert.Equal(t, forward, generate([]int{2, 0, 1}), "generation order must not change any chunk")
+	assert.Equal(t, forward, generate([]int{1, 2, 0, 0}), "regenerating a chunk gives the same nodes")
+
+	other := NewNodeService(NewMockDatabase(), NewMockNoiseGenerator(54321), NewMockWorldService(), NewRandomStreams, NewMockLogger())
+	nodes, err := other.GenerateResourcesForChunk(context.Background(), chunks[0])
+	require.NoError(t, err)
+	assert.NotEqual(t, forward[0], layout(nodes), "another world seed changes cluster shapes")
+}
+
+// worldNoise serves a noise generator per world
+type worldNoise map[pgtype.UUID]*MockNoiseGenerator
+
+func (w worldNoise) Noise(ctx context.Context, worldID pgtype.UUID) (NoiseGeneratorInterface, error) {
+	gen, ok := w[worldID]
+	if !ok {
+		return nil, pgx.ErrNoRows
+	}
+	return gen, nil
+}
+
+func TestGenerateResourcesForChunk_PerWorldSeed(t *testing.T) {
+	worlds := NewMockWorldService()
+	other := pgtype.UUID{Bytes: [16]byte{9}, Valid: true}
+	noises := worldNoise{worlds.defaultWorld.ID: NewMockNoiseGenerator(12345), other: NewMockNoiseGenerator(54321)}
+	service := NewNodeService(NewMockDatabase(), noises, worlds, NewRandomStreams, NewMockLogger())
+	chunk := createTestChunkData(0, 0, chunkV1.TerrainType_TERRAIN_TYPE_GRASS)
+
+	nodes, err := service.GenerateResourcesForChunk(context.Background(), chunk)
+	require.NoError(t, err)
+	otherNodes, err := service.GenerateResourcesForChunk(session.WithWorldID(context.Background(), uuid.PgtypeToString(other)), chunk)
+	require.NoError(t, err)
+	assert.NotEqual(t, layout(nodes), layout(otherNodes), "each world generates from its own seed")
+
+	again, err := service.GenerateResourcesForChunk(context.Background(), chunk)
+	require.NoError(t, err)
+	assert.Equal(t, layout(nodes), layout(again), "generating in another world leaves the default world's fields alone")
+}
+
+func TestScanSpawnPoints_MatchesSerialScan(t *testing.T) {
+	service := NewNodeService(NewMockDatabase(), NewMockNoiseGenerator(12345), NewMockWorldService(), NewRandomStreams, NewMockLogger())
+	chunk := createMixedTerrainChunk(3, -2)
+	field := service.newChunkField(chunk)
+	fields, err := service.fieldsFor(context.Background())
+	require.NoError(t, err)
+
+	service.balanceMu.RLock()
+	defer service.balanceMu.RUnlock()
+	concurrent := service.scanSpawnPoints(fields, field, service.resourceTypes)
+	require.Len(t, concurrent, len(service.resourceTypes))
+	for i, resourceType := range service.resourceTypes {
+		serial := field.spawnPoints(
+			fields.spawnNoise(resourceType.Id),
+			resourceType.TerrainType,
+			service.getRarityThresholdFromEnum(resourceType.Rarity),
+		)
+		assert.Equal(t, serial, concurrent[i], "resource type %s", resourceType.Name)
+	}
+}
+
+func BenchmarkGenerateResourcesForChunk(b *testing.B) {
+	service := NewNodeService(NewMockDatabase(), NewMockNoiseGenerator(12345), NewMockWorldService(), NewRandomStreams, NewMockLogger())
+	chunk := createMixedTerrainChunk(0, 0)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := service.GenerateResourcesForChunk(context.Background(), chunk); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -5,150 +5,206 @@ import (
 	"crypto/md5"
 	"encoding/hex"
 	"fmt"
-	"math/rand"
+	"math"
+	"sort"
 	"strings"
-	"time"
+	"sync"
 
 	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/chunkdata"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/VoidMesh/api/api/internal/rng"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/storage"
 	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
 	resourceNodeV1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
-	"github.com/VoidMesh/api/api/services/noise"
 	"github.com/VoidMesh/api/api/services/world"
-	"github.com/jackc/pgx/v5/pgxpool"
-	"google.golang.org/protobuf/proto"
+	"github.com/jackc/pgx/v5/pgtype"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
 	// Resource generation constants
-	ResourceNoiseScale   = 150.0 // Larger scale for more spread out resources
-	ResourceDetailScale  = 30.0  // Smaller scale for fine details
-	ResourceBufferZone   = 1     // Cells away from terrain transitions to avoid spawning
-	MinClusterDistance   = 4     // Minimum distance between cluster centers
-	MaxResourcesPerChunk = 24    // Maximum resources per chunk
-	ChunkSize            = 32    // Size of a chunk in cells
-
-	// Spawn thresholds by rarity
-	CommonThreshold   = 0.3  // Common resources (easily found)
-	UncommonThreshold = 0.5  // Uncommon resources (moderately rare)
-	RareThreshold     = 0.7  // Rare resources (hard to find)
-	VeryRareThreshold = 0.85 // Very rare resources (extremely rare)
-
-	// Cluster size probability weights
-	// Format: [min, max, weight]
-	// Higher weight = more likely
+	ResourceNoiseScale  = 150.0 // Larger scale for more spread out resources
+	ResourceDetailScale = 30.0  // Smaller scale for fine details
+	ResourceBufferZone  = 1     // Cells away from terrain transitions to avoid spawning
+	ChunkSize           = geometry.ChunkSize
 )
 
-// Cluster size probabilities by rarity
-// Maps rarity to [min size, max size, weight for sizes 1-6]
-var ClusterSizes = map[string]map[int]int{
-	"common": {
-		1: 10, // Size 1: weight 10
-		2: 30, // Size 2: weight 30
-		3: 40, // Size 3: weight 40
-		4: 15, // Size 4: weight 15
-		5: 5,  // Size 5: weight 5
-		6: 0,  // Size 6: weight 0
-	},
-	"uncommon": {
-		1: 15, // Size 1: weight 15
-		2: 35, // Size 2: weight 35
-		3: 30, // Size 3: weight 30
-		4: 15, // Size 4: weight 15
-		5: 5,  // Size 5: weight 5
-		6: 0,  // Size 6: weight 0
-	},
-	"rare": {
-		1: 25, // Size 1: weight 25
-		2: 40, // Size 2: weight 40
-		3: 25, // Size 3: weight 25
-		4: 10, // Size 4: weight 10
-		5: 0,  // Size 5: weight 0
-		6: 0,  // Size 6: weight 0
-	},
-	"very_rare": {
-		1: 60, // Size 1: weight 60
-		2: 30, // Size 2: weight 30
-		3: 10, // Size 3: weight 10
-		4: 0,  // Size 4: weight 0
-		5: 0,  // Size 5: weight 0
-		6: 0,  // Size 6: weight 0
-	},
-}
+// Spawn thresholds, cluster size weights, per-chunk limits and resource yields
+// are balance data loaded from config/balance/resource_nodes.yaml (see balance.go).
 
 // NodeService provides resource node generation functionality
 type NodeService struct {
-	db             DatabaseInterface
-	noiseGen       NoiseGeneratorInterface
-	worldService   WorldServiceInterface
-	rnd            RandomGeneratorInterface
-	logger         LoggerInterface
-	// Cache of hardcoded resource types to avoid rebuilding on each request
+	db           DatabaseInterface
+	worldNoise   WorldNoiseInterface
+	worldService WorldServiceInterface
+	newStreams   func(seed int64) RandomStreamsInterface
+	logger       LoggerInterface
+	clock        clock.Clock
+
+	// worldsMu guards worlds, the generation fields of each world by ID
+	worldsMu sync.Mutex
+	worlds   map[pgtype.UUID]*worldFields
+
+	// balanceMu guards the balance config and the resource type caches derived from it
+	balanceMu   sync.RWMutex
+	balance     *BalanceConfig
+	balanceInfo BalanceConfigInfo
+	balancePath string
+	// Cache of configured resource types to avoid rebuilding on each request
 	resourceTypes []*resourceNodeV1.ResourceNodeType
 	// Map of resource types by terrain for faster lookups
 	resourceTypesByTerrain map[string][]*resourceNodeV1.ResourceNodeType
 	// Map of resource types by ID for faster lookups
 	resourceTypesByID map[int32]*resourceNodeV1.ResourceNodeType
+	// density scales MaxResourcesPerChunk; set from the world's experiment variants
+	density float64
+	// eventDensity scales it further while seasonal events are active
+	eventDensity float64
+	// qualityShares overrides the balance quality distribution; set from the world's experiment variants
+	qualityShares *qualityShares
+	// maturity scales the density of each region as the world ages; optional
+	maturity MaturityInterface
 }
 
-// NewNodeService creates a new resource node service with dependency injection.
+// DensityParam is the experiment parameter that scales resource nodes per chunk, e.g.
+// 1.2 allows 20% more nodes in each chunk
+const DensityParam = "resource_density"
+
+// NewNodeService creates a new resource node service with dependency injection. Each
+// world generates from its own noise and from the random streams newStreams derives
+// from its seed.
 func NewNodeService(
 	db DatabaseInterface,
-	noiseGen NoiseGeneratorInterface,
+	worldNoise WorldNoiseInterface,
 	worldService WorldServiceInterface,
-	rnd RandomGeneratorInterface,
+	newStreams func(seed int64) RandomStreamsInterface,
 	logger LoggerInterface,
 ) *NodeService {
 	componentLogger := logger.With("component", "resource-node-service")
 	componentLogger.Debug("Creating new resource node service")
 
-	// Initialize resource type caches
 	service := &NodeService{
-		db:                     db,
-		noiseGen:               noiseGen,
-		worldService:           worldService,
-		rnd:                    rnd,
-		logger:                 componentLogger,
-		resourceTypesByTerrain: make(map[string][]*resourceNodeV1.ResourceNodeType),
-		resourceTypesByID:      make(map[int32]*resourceNodeV1.ResourceNodeType),
-	}
-
-	// Preload resource types
-	service.resourceTypes = service.getHardcodedResourceTypes()
-
-	// Group resource types by terrain for faster lookup
-	for _, r := range service.resourceTypes {
-		terrainType := r.TerrainType
-		service.resourceTypesByTerrain[terrainType] = append(service.resourceTypesByTerrain[terrainType], r)
-		service.resourceTypesByID[r.Id] = r
+		db:           db,
+		worldNoise:   worldNoise,
+		worldService: worldService,
+		newStreams:   newStreams,
+		logger:       componentLogger,
+		clock:        clock.New(),
+		worlds:       make(map[pgtype.UUID]*worldFields),
+		density:      1,
+		eventDensity: 1,
+	}
+
+	// Start from the embedded balance config; callers may load an override with LoadBalanceConfig
+	cfg, checksum, err := LoadBalanceConfigFile("")
+	if err != nil {
+		panic(fmt.Sprintf("embedded balance config is invalid: %v", err))
 	}
+	service.applyBalanceConfig(cfg, checksum, DefaultBalanceSource)
 
 	return service
 }
 
 // NewNodeServiceWithPool creates a service with concrete implementations (convenience constructor for production use).
 func NewNodeServiceWithPool(
-	pool *pgxpool.Pool,
-	noiseGen *noise.Generator,
+	pool storage.Pool,
+	seeds *world.Seeds,
 	worldService *world.Service,
 ) *NodeService {
-	// Create a deterministic random source based on the noise generator's seed
-	rnd := NewRandomGenerator(noiseGen.GetSeed())
 	logger := NewDefaultLoggerWrapper()
 
+	// Random streams are derived from each world's seed, like the noise
 	return NewNodeService(
 		NewDatabaseWrapper(pool),
-		NewNoiseGeneratorAdapter(noiseGen),
+		NewWorldNoiseAdapter(seeds),
 		NewWorldServiceAdapter(worldService),
-		rnd,
+		NewRandomStreams,
 		logger,
 	)
 }
 
+// SetClock replaces the clock used for timestamps (for simulation tests)
+func (s *NodeService) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// SetExperimentParams applies the generation parameters of the world's experiment
+// variants. Unknown parameters are ignored.
+func (s *NodeService) SetExperimentParams(params map[string]float64) {
+	s.balanceMu.Lock()
+	defer s.balanceMu.Unlock()
+	s.density = 1
+	if density, ok := params[DensityParam]; ok && density > 0 {
+		s.density = density
+	}
+
+	// Either quality share may be overridden; the other keeps its balance file value
+	s.qualityShares = nil
+	poor, poorOK := params[QualityPoorShareParam]
+	rich, richOK := params[QualityRichShareParam]
+	if !poorOK && !richOK {
+		return
+	}
+	if !poorOK {
+		poor = s.balance.Quality["poor"].Share
+	}
+	if !richOK {
+		rich = s.balance.Quality["rich"].Share
+	}
+	if poor < 0 || rich < 0 || poor+rich > 1 {
+		s.logger.Warn("Ignoring invalid quality shares", "poor_share", poor, "rich_share", rich)
+		return
+	}
+	s.qualityShares = &qualityShares{poor: poor, rich: rich}
+}
+
+// SetMaturity scales resource nodes per chunk by the density of the chunk's region,
+// which drops where players harvest heavily and grows on the unexplored frontier
+func (s *NodeService) SetMaturity(maturity MaturityInterface) {
+	s.maturity = maturity
+}
+
+// SetEventDensity scales resource nodes per chunk on top of the experiment density
+// while seasonal events are active; 1 restores the normal density
+func (s *NodeService) SetEventDensity(density float64) {
+	s.balanceMu.Lock()
+	defer s.balanceMu.Unlock()
+	s.eventDensity = 1
+	if density > 0 {
+		s.eventDensity = density
+	}
+}
+
+// RefreshProviders rebuilds the resource type caches from the current balance config
+// and the registered providers, without re-reading the balance file
+func (s *NodeService) RefreshProviders() {
+	s.balanceMu.RLock()
+	cfg, info := s.balance, s.balanceInfo
+	s.balanceMu.RUnlock()
+	s.applyBalanceConfig(cfg, info.Checksum, info.Source)
+}
+
+// maxResourcesPerChunk is the balance limit scaled by the experiment and event densities. The caller holds balanceMu.
+func (s *NodeService) maxResourcesPerChunk() int {
+	return int(math.Round(float64(s.balance.Generation.MaxResourcesPerChunk) * s.density * s.eventDensity))
+}
+
 // GenerateResourcesForChunk generates resource nodes for a chunk
 func (s *NodeService) GenerateResourcesForChunk(ctx context.Context, chunk *chunkV1.ChunkData) ([]*resourceNodeV1.ResourceNode, error) {
 	s.logger.Debug("Generating resource nodes for chunk", "chunk_x", chunk.ChunkX, "chunk_y", chunk.ChunkY)
 
+	fields, err := s.fieldsFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Hold the balance config for the whole pass so a concurrent reload can't mix old and new values
+	s.balanceMu.RLock()
+	defer s.balanceMu.RUnlock()
+
 	// Use the pre-grouped resource types by terrain from service initialization
 	s.logger.Debug("Using cached resource types", "count", len(s.resourceTypes))
 
@@ -164,178 +220,121 @@ func (s *NodeService) GenerateResourcesForChunk(ctx context.Context, chunk *chun
 
 	// List to collect all generated resources
 	var resourceNodes []*resourceNodeV1.ResourceNode
+	maxResources := s.maxResourcesPerChunk()
+	if s.maturity != nil {
+		maxResources = int(math.Round(float64(maxResources) * s.maturity.DensityAt(chunk.ChunkX, chunk.ChunkY)))
+	}
 
-	// Process each resource type for this chunk
-	for terrainType, resourceNodeTypes := range s.resourceTypesByTerrain {
-		s.logger.Debug("Processing terrain type", "terrain_type", terrainType, "resource_types_count", len(resourceNodeTypes))
-		for _, resourceNodeType := range resourceNodeTypes {
-			// Create a separate noise map for this resource type
-			// Use resource ID as additional seed to make different resources spawn in different patterns
-			resourceSeed := s.noiseGen.GetSeed() + int64(resourceNodeType.Id)
-			resourceRng := rand.New(rand.NewSource(resourceSeed))
-
-			// Generate potential spawn points
-			spawnPoints := s.findPotentialSpawnPoints(
-				chunk,
-				terrainType,
-				s.getRarityThresholdFromEnum(resourceNodeType.Rarity),
-				resourceSeed,
-			)
-
-			s.logger.Debug("Found spawn points", "resource_name", resourceNodeType.Name, "spawn_points_count", len(spawnPoints))
-
-			// Shuffle spawn points to avoid patterns
-			resourceRng.Shuffle(len(spawnPoints), func(i, j int) {
-				spawnPoints[i], spawnPoints[j] = spawnPoints[j], spawnPoints[i]
-			})
-
-			// Try to create clusters from the spawn points
-			for _, point := range spawnPoints {
-				// Check if we've reached the max resources per chunk
-				if len(resourceNodes) >= MaxResourcesPerChunk {
-					break
-				}
+	// Process terrain types in a fixed order; clusters of earlier types claim space first
+	terrainTypes := make([]string, 0, len(s.resourceTypesByTerrain))
+	for terrainType := range s.resourceTypesByTerrain {
+		terrainTypes = append(terrainTypes, terrainType)
+	}
+	sort.Strings(terrainTypes)
+	var resourceNodeTypes []*resourceNodeV1.ResourceNodeType
+	for _, terrainType := range terrainTypes {
+		resourceNodeTypes = append(resourceNodeTypes, s.resourceTypesByTerrain[terrainType]...)
+	}
 
-				// Check minimum distance from other clusters
-				tooClose := false
-				for _, center := range clusterCenters {
-					dist := distance(point.x, point.y, center.x, center.y)
-					if dist < MinClusterDistance {
-						tooClose = true
-						break
-					}
-				}
-				if tooClose {
-					continue
-				}
+	// Classify the chunk's cells once, then scan every resource type against them concurrently
+	field := s.newChunkField(chunk)
+	spawnPointsByType := s.scanSpawnPoints(fields, field, resourceNodeTypes)
 
-				// This point becomes a cluster center
-				clusterCenters = append(clusterCenters, struct{ x, y int32 }{point.x, point.y})
-
-				// Generate a unique cluster ID
-				clusterID := generateClusterID(chunk.ChunkX, chunk.ChunkY, point.x, point.y, resourceNodeType.Id)
-
-				// Determine cluster size
-				clusterSize := s.determineClusterSizeFromEnum(resourceNodeType.Rarity)
-
-				// Create the first resource node at the center point
-				posKey := fmt.Sprintf("%d,%d", point.x, point.y)
-				if !occupiedPositions[posKey] {
-					// Create the resource node
-					// Convert chunk-local coordinates to global coordinates
-					globalX := chunk.ChunkX*ChunkSize + point.x
-					globalY := chunk.ChunkY*ChunkSize + point.y
-					
-					resourceNode := &resourceNodeV1.ResourceNode{
-						ResourceNodeType:   resourceNodeType,
-						ResourceNodeTypeId: resourceNodeV1.ResourceNodeTypeId(resourceNodeType.Id),
-						ChunkX:             chunk.ChunkX,
-						ChunkY:             chunk.ChunkY,
-						X:                  globalX,
-						Y:                  globalY,
-						ClusterId:          clusterID,
-						Size:               1,
-						CreatedAt:          timestamppb.Now(),
-					}
-					resourceNodes = append(resourceNodes, resourceNode)
-					occupiedPositions[posKey] = true
-
-					// Generate additional nodes in the cluster
-					s.generateClusterNodes(
-						chunk,
-						resourceNode,
-						clusterSize-1, // Subtract 1 since we already created the center node
-						occupiedPositions,
-						resourceNodeType.TerrainType,
-						&resourceNodes,
-					)
-				}
-			}
+	// Place clusters type by type; this part claims space and must stay in order
+	for i, resourceNodeType := range resourceNodeTypes {
+		if err := ctx.Err(); err != nil {
+			return nil, err
 		}
-	}
-
-	return resourceNodes, nil
-}
+		spawnPoints := spawnPointsByType[i]
+		s.logger.Debug("Found spawn points", "resource_name", resourceNodeType.Name, "spawn_points_count", len(spawnPoints))
 
-// findPotentialSpawnPoints finds potential resource spawn points in a chunk
-func (s *NodeService) findPotentialSpawnPoints(
-	chunk *chunkV1.ChunkData,
-	terrainType string,
-	threshold float64,
-	resourceSeed int64,
-) []struct {
-	x, y       int32
-	noiseValue float64
-} {
-	// Create a specialized noise generator for this resource
-	resourceNoiseGen := noise.NewGenerator(resourceSeed)
-
-	var spawnPoints []struct {
-		x, y       int32
-		noiseValue float64
-	}
+		// Shuffle spawn points to avoid patterns
+		placementRng := fields.streams.Stream(rng.ClusterPlacement, int64(chunk.ChunkX), int64(chunk.ChunkY), int64(resourceNodeType.Id))
+		placementRng.Shuffle(len(spawnPoints), func(i, j int) {
+			spawnPoints[i], spawnPoints[j] = spawnPoints[j], spawnPoints[i]
+		})
 
-	// Scan the entire chunk
-	for y := int32(0); y < ChunkSize; y++ {
-		for x := int32(0); x < ChunkSize; x++ {
-			// Get cell's terrain type
-			cellIndex := y*ChunkSize + x
-			if cellIndex >= int32(len(chunk.Cells)) {
-				continue
+		// Try to create clusters from the spawn points
+		for _, point := range spawnPoints {
+			// Check if we've reached the max resources per chunk
+			if len(resourceNodes) >= maxResources {
+				break
 			}
-			cell := chunk.Cells[cellIndex]
 
-			// Check if terrain type matches the resource's terrain type
-			cellTerrainType := s.terrainTypeToString(cell.TerrainType)
-			if cellTerrainType != terrainType {
-				continue
+			// Check minimum distance from other clusters
+			tooClose := false
+			for _, center := range clusterCenters {
+				dist := distance(point.x, point.y, center.x, center.y)
+				if dist < s.balance.Generation.MinClusterDistance {
+					tooClose = true
+					break
+				}
 			}
-
-			// Check if cell is in a buffer zone near terrain transitions
-			if s.isNearTerrainTransition(chunk, x, y) {
+			if tooClose {
 				continue
 			}
 
-			// Get the world coordinates
-			worldX := chunk.ChunkX*ChunkSize + x
-			worldY := chunk.ChunkY*ChunkSize + y
-
-			// Calculate noise value for this position
-			// Combine a large-scale noise for overall distribution with a small-scale noise for detail
-			largeScaleNoise := resourceNoiseGen.GetTerrainNoise(int(worldX), int(worldY), ResourceNoiseScale)
-			detailNoise := resourceNoiseGen.GetTerrainNoise(int(worldX), int(worldY), ResourceDetailScale)
-
-			// Weight the large scale more heavily for better clustering
-			combinedNoise := largeScaleNoise*0.8 + detailNoise*0.2
-
-			// Normalize to 0-1 range
-			normalizedNoise := (combinedNoise + 1.0) / 2.0
-
-			// Check if noise exceeds the threshold for this resource's rarity
-			if normalizedNoise > threshold {
-				spawnPoints = append(spawnPoints, struct {
-					x, y       int32
-					noiseValue float64
-				}{
-					x:          x,
-					y:          y,
-					noiseValue: normalizedNoise,
-				})
+			// This point becomes a cluster center
+			clusterCenters = append(clusterCenters, struct{ x, y int32 }{point.x, point.y})
+
+			// Generate a unique cluster ID
+			clusterID := generateClusterID(chunk.ChunkX, chunk.ChunkY, point.x, point.y, resourceNodeType.Id)
+
+			// Each cluster's size and layout come from its own stream
+			shapeRng := fields.streams.Stream(rng.ClusterShape, int64(chunk.ChunkX), int64(chunk.ChunkY), int64(point.x), int64(point.y), int64(resourceNodeType.Id))
+			clusterSize := s.determineClusterSizeFromEnum(resourceNodeType.Rarity, shapeRng)
+
+			// Create the first resource node at the center point
+			posKey := fmt.Sprintf("%d,%d", point.x, point.y)
+			if !occupiedPositions[posKey] {
+				// Create the resource node
+				// Convert chunk-local coordinates to global coordinates
+				globalX := chunk.ChunkX*ChunkSize + point.x
+				globalY := chunk.ChunkY*ChunkSize + point.y
+
+				resourceNode := &resourceNodeV1.ResourceNode{
+					ResourceNodeType:   resourceNodeType,
+					ResourceNodeTypeId: resourceNodeV1.ResourceNodeTypeId(resourceNodeType.Id),
+					ChunkX:             chunk.ChunkX,
+					ChunkY:             chunk.ChunkY,
+					X:                  globalX,
+					Y:                  globalY,
+					ClusterId:          clusterID,
+					Size:               1,
+					CreatedAt:          timestamppb.Now(),
+					Quality:            s.rollQuality(fields, globalX, globalY),
+				}
+				resourceNodes = append(resourceNodes, resourceNode)
+				occupiedPositions[posKey] = true
+
+				// Generate additional nodes in the cluster
+				s.generateClusterNodes(
+					fields,
+					chunk,
+					resourceNode,
+					clusterSize-1, // Subtract 1 since we already created the center node
+					occupiedPositions,
+					resourceNodeType.TerrainType,
+					&resourceNodes,
+					shapeRng,
+				)
 			}
 		}
 	}
 
-	return spawnPoints
+	return resourceNodes, nil
 }
 
 // generateClusterNodes generates additional nodes around a cluster center
 func (s *NodeService) generateClusterNodes(
+	fields *worldFields,
 	chunk *chunkV1.ChunkData,
 	centerNode *resourceNodeV1.ResourceNode,
 	numNodes int,
 	occupiedPositions map[string]bool,
 	terrainType string,
 	resources *[]*resourceNodeV1.ResourceNode,
+	rnd RandomGeneratorInterface,
 ) {
 	// No additional nodes needed
 	if numNodes <= 0 {
@@ -364,8 +363,8 @@ func (s *NodeService) generateClusterNodes(
 		baseY := centerNode.Y % ChunkSize
 
 		// Choose a random direction and distance
-		dir := directions[s.rnd.Intn(len(directions))]
-		distance := int32(1 + s.rnd.Intn(2)) // 1-2 cells away
+		dir := directions[rnd.Intn(len(directions))]
+		distance := int32(1 + rnd.Intn(2)) // 1-2 cells away
 
 		// Calculate new position
 		newX := baseX + dir.dx*distance
@@ -403,7 +402,7 @@ func (s *NodeService) generateClusterNodes(
 		// Convert chunk-local coordinates to global coordinates
 		globalX := centerNode.ChunkX*ChunkSize + newX
 		globalY := centerNode.ChunkY*ChunkSize + newY
-		
+
 		resourceNode := &resourceNodeV1.ResourceNode{
 			ResourceNodeType:   centerNode.ResourceNodeType,
 			ResourceNodeTypeId: centerNode.ResourceNodeTypeId,
@@ -414,6 +413,7 @@ func (s *NodeService) generateClusterNodes(
 			ClusterId:          centerNode.ClusterId,
 			Size:               1,
 			CreatedAt:          timestamppb.Now(),
+			Quality:            s.rollQuality(fields, globalX, globalY),
 		}
 
 		// Add to the resources slice
@@ -469,13 +469,13 @@ func (s *NodeService) isNearTerrainTransition(chunk *chunkV1.ChunkData, x, y int
 }
 
 // determineClusterSize determines the size of a resource cluster based on rarity
-func (s *NodeService) determineClusterSize(rarity string) int {
+func (s *NodeService) determineClusterSize(rarity string, rnd RandomGeneratorInterface) int {
 	rarityLower := strings.ToLower(rarity)
 
 	// Default to common if rarity not found
-	clusterSizeWeights, ok := ClusterSizes[rarityLower]
+	clusterSizeWeights, ok := s.balance.ClusterSizes[rarityLower]
 	if !ok {
-		clusterSizeWeights = ClusterSizes["common"]
+		clusterSizeWeights = s.balance.ClusterSizes["common"]
 	}
 
 	// Calculate total weight
@@ -490,12 +490,18 @@ func (s *NodeService) determineClusterSize(rarity string) int {
 	}
 
 	// Roll a random number between 0 and total weight
-	roll := s.rnd.Intn(totalWeight)
+	roll := rnd.Intn(totalWeight)
 
-	// Find which size range this roll falls into
+	// Find which size range this roll falls into, walking sizes in order so the
+	// same roll always picks the same size
+	sizes := make([]int, 0, len(clusterSizeWeights))
+	for size := range clusterSizeWeights {
+		sizes = append(sizes, size)
+	}
+	sort.Ints(sizes)
 	cumWeight := 0
-	for size, weight := range clusterSizeWeights {
-		cumWeight += weight
+	for _, size := range sizes {
+		cumWeight += clusterSizeWeights[size]
 		if roll < cumWeight {
 			return size
 		}
@@ -505,153 +511,6 @@ func (s *NodeService) determineClusterSize(rarity string) int {
 	return 1
 }
 
-// getHardcodedResourceTypes returns all resource types defined in the proto
-// This is now only called during service initialization
-func (s *NodeService) getHardcodedResourceTypes() []*resourceNodeV1.ResourceNodeType {
-	return []*resourceNodeV1.ResourceNodeType{
-		// Grass Terrain Resources
-		{
-			Id:          int32(resourceNodeV1.ResourceNodeTypeId_RESOURCE_NODE_TYPE_ID_HERB_PATCH),
-			Name:        "Herb Patch",
-			Description: "Medicinal plants and cooking ingredients",
-			TerrainType: "grass",
-			Rarity:      resourceNodeV1.ResourceRarity_RESOURCE_RARITY_COMMON,
-			VisualData:  &resourceNodeV1.ResourceVisual{Sprite: "herb_patch", Color: "#4CAF50"},
-			Properties:  &resourceNodeV1.ResourceProperties{HarvestTime: 3, RespawnTime: 300, YieldMin: 1, YieldMax: 3},
-		},
-		{
-			Id:          int32(resourceNodeV1.ResourceNodeTypeId_RESOURCE_NODE_TYPE_ID_BERRY_BUSH),
-			Name:        "Berry Bush",
-			Description: "Food and crafting materials",
-			TerrainType: "grass",
-			Rarity:      resourceNodeV1.ResourceRarity_RESOURCE_RARITY_UNCOMMON,
-			VisualData:  &resourceNodeV1.ResourceVisual{Sprite: "berry_bush", Color: "#8BC34A"},
-			Properties:  &resourceNodeV1.ResourceProperties{HarvestTime: 4, RespawnTime: 450, YieldMin: 2, YieldMax: 4},
-		},
-		{
-			Id:          int32(resourceNodeV1.ResourceNodeTypeId_RESOURCE_NODE_TYPE_ID_MINERAL_OUTCROPPING),
-			Name:        "Mineral Outcropping",
-			Description: "Stone and metal deposits",
-			TerrainType: "grass",
-			Rarity:      resourceNodeV1.ResourceRarity_RESOURCE_RARITY_RARE,
-			VisualData:  &resourceNodeV1.ResourceVisual{Sprite: "mineral_outcropping", Color: "#795548"},
-			Properties:  &resourceNodeV1.ResourceProperties{HarvestTime: 8, RespawnTime: 900, YieldMin: 1, YieldMax: 2},
-		},
-		// Water Terrain Resources
-		{
-			Id:          int32(resourceNodeV1.ResourceNodeTypeId_RESOURCE_NODE_TYPE_ID_FISHING_SPOT),
-			Name:        "Fishing Spot",
-			Description: "Different fish varieties",
-			TerrainType: "water",
-			Rarity:      resourceNodeV1.ResourceRarity_RESOURCE_RARITY_COMMON,
-			VisualData:  &resourceNodeV1.ResourceVisual{Sprite: "fishing_spot", Color: "#2196F3"},
-			Properties:  &resourceNodeV1.ResourceProperties{HarvestTime: 10, RespawnTime: 600, YieldMin: 1, YieldMax: 2},
-		},
-		{
-			Id:          int32(resourceNodeV1.ResourceNodeTypeId_RESOURCE_NODE_TYPE_ID_KELP_BED),
-			Name:        "Kelp Bed",
-			Description: "Crafting materials and food",
-			TerrainType: "water",
-			Rarity:      resourceNodeV1.ResourceRarity_RESOURCE_RARITY_UNCOMMON,
-			VisualData:  &resourceNodeV1.ResourceVisual{Sprite: "kelp_bed", Color: "#009688"},
-			Properties:  &resourceNodeV1.ResourceProperties{HarvestTime: 5, RespawnTime: 400, YieldMin: 2, YieldMax: 4},
-		},
-		{
-			Id:          int32(resourceNodeV1.ResourceNodeTypeId_RESOURCE_NODE_TYPE_ID_PEARL_FORMATION),
-			Name:        "Pearl Formation",
-			Description: "Rare crafting components",
-			TerrainType: "water",
-			Rarity:      resourceNodeV1.ResourceRarity_RESOURCE_RARITY_VERY_RARE,
-			VisualData:  &resourceNodeV1.ResourceVisual{Sprite: "pearl_formation", Color: "#E1F5FE"},
-			Properties:  &resourceNodeV1.ResourceProperties{HarvestTime: 15, RespawnTime: 1800, YieldMin: 1, YieldMax: 1},
-		},
-		// Sand Terrain Resources
-		{
-			Id:          int32(resourceNodeV1.ResourceNodeTypeId_RESOURCE_NODE_TYPE_ID_CRYSTAL_FORMATION),
-			Name:        "Crystal Formation",
-			Description: "Crafting and magical components",
-			TerrainType: "sand",
-			Rarity:      resourceNodeV1.ResourceRarity_RESOURCE_RARITY_RARE,
-			VisualData:  &resourceNodeV1.ResourceVisual{Sprite: "crystal_formation", Color: "#9C27B0"},
-			Properties:  &resourceNodeV1.ResourceProperties{HarvestTime: 12, RespawnTime: 1200, YieldMin: 1, YieldMax: 2},
-		},
-		{
-			Id:          int32(resourceNodeV1.ResourceNodeTypeId_RESOURCE_NODE_TYPE_ID_CLAY_DEPOSIT),
-			Name:        "Clay Deposit",
-			Description: "Building materials and pottery",
-			TerrainType: "sand",
-			Rarity:      resourceNodeV1.ResourceRarity_RESOURCE_RARITY_COMMON,
-			VisualData:  &resourceNodeV1.ResourceVisual{Sprite: "clay_deposit", Color: "#FF9800"},
-			Properties:  &resourceNodeV1.ResourceProperties{HarvestTime: 6, RespawnTime: 450, YieldMin: 2, YieldMax: 5},
-		},
-		{
-			Id:          int32(resourceNodeV1.ResourceNodeTypeId_RESOURCE_NODE_TYPE_ID_DESERT_PLANT),
-			Name:        "Desert Plant",
-			Description: "Special ingredients and rare materials",
-			TerrainType: "sand",
-			Rarity:      resourceNodeV1.ResourceRarity_RESOURCE_RARITY_UNCOMMON,
-			VisualData:  &resourceNodeV1.ResourceVisual{Sprite: "desert_plant", Color: "#CDDC39"},
-			Properties:  &resourceNodeV1.ResourceProperties{HarvestTime: 7, RespawnTime: 600, YieldMin: 1, YieldMax: 3},
-		},
-		// Dirt Terrain Resources
-		{
-			Id:          int32(resourceNodeV1.ResourceNodeTypeId_RESOURCE_NODE_TYPE_ID_HARVESTABLE_TREE),
-			Name:        "Harvestable Tree",
-			Description: "Different wood types",
-			TerrainType: "dirt",
-			Rarity:      resourceNodeV1.ResourceRarity_RESOURCE_RARITY_COMMON,
-			VisualData:  &resourceNodeV1.ResourceVisual{Sprite: "harvestable_tree", Color: "#8D6E63"},
-			Properties:  &resourceNodeV1.ResourceProperties{HarvestTime: 10, RespawnTime: 900, YieldMin: 3, YieldMax: 6},
-		},
-		{
-			Id:          int32(resourceNodeV1.ResourceNodeTypeId_RESOURCE_NODE_TYPE_ID_MUSHROOM_CIRCLE),
-			Name:        "Mushroom Circle",
-			Description: "Alchemy ingredients and food",
-			TerrainType: "dirt",
-			Rarity:      resourceNodeV1.ResourceRarity_RESOURCE_RARITY_UNCOMMON,
-			VisualData:  &resourceNodeV1.ResourceVisual{Sprite: "mushroom_circle", Color: "#6D4C41"},
-			Properties:  &resourceNodeV1.ResourceProperties{HarvestTime: 5, RespawnTime: 450, YieldMin: 2, YieldMax: 4},
-		},
-		{
-			Id:          int32(resourceNodeV1.ResourceNodeTypeId_RESOURCE_NODE_TYPE_ID_WILD_HONEY_HIVE),
-			Name:        "Wild Honey Hive",
-			Description: "Food and crafting materials",
-			TerrainType: "dirt",
-			Rarity:      resourceNodeV1.ResourceRarity_RESOURCE_RARITY_RARE,
-			VisualData:  &resourceNodeV1.ResourceVisual{Sprite: "wild_honey_hive", Color: "#FFC107"},
-			Properties:  &resourceNodeV1.ResourceProperties{HarvestTime: 8, RespawnTime: 1200, YieldMin: 1, YieldMax: 2},
-		},
-		// Stone Terrain Resources
-		{
-			Id:          int32(resourceNodeV1.ResourceNodeTypeId_RESOURCE_NODE_TYPE_ID_STONE_VEIN),
-			Name:        "Stone Vein",
-			Description: "Building materials",
-			TerrainType: "stone",
-			Rarity:      resourceNodeV1.ResourceRarity_RESOURCE_RARITY_COMMON,
-			VisualData:  &resourceNodeV1.ResourceVisual{Sprite: "stone_vein", Color: "#607D8B"},
-			Properties:  &resourceNodeV1.ResourceProperties{HarvestTime: 8, RespawnTime: 600, YieldMin: 2, YieldMax: 4},
-		},
-		{
-			Id:          int32(resourceNodeV1.ResourceNodeTypeId_RESOURCE_NODE_TYPE_ID_GEM_DEPOSIT),
-			Name:        "Gem Deposit",
-			Description: "Valuable gems",
-			TerrainType: "stone",
-			Rarity:      resourceNodeV1.ResourceRarity_RESOURCE_RARITY_RARE,
-			VisualData:  &resourceNodeV1.ResourceVisual{Sprite: "gem_deposit", Color: "#E91E63"},
-			Properties:  &resourceNodeV1.ResourceProperties{HarvestTime: 15, RespawnTime: 1500, YieldMin: 1, YieldMax: 2},
-		},
-		{
-			Id:          int32(resourceNodeV1.ResourceNodeTypeId_RESOURCE_NODE_TYPE_ID_METAL_ORE),
-			Name:        "Metal Ore",
-			Description: "Crafting materials",
-			TerrainType: "stone",
-			Rarity:      resourceNodeV1.ResourceRarity_RESOURCE_RARITY_UNCOMMON,
-			VisualData:  &resourceNodeV1.ResourceVisual{Sprite: "metal_ore", Color: "#9E9E9E"},
-			Properties:  &resourceNodeV1.ResourceProperties{HarvestTime: 12, RespawnTime: 900, YieldMin: 1, YieldMax: 3},
-		},
-	}
-}
-
 // terrainTypeToString converts a terrain type enum to a string
 func (s *NodeService) terrainTypeToString(terrainType chunkV1.TerrainType) string {
 	// Convert terrain type enum to simplified string that matches resource definitions
@@ -675,20 +534,20 @@ func (s *NodeService) terrainTypeToString(terrainType chunkV1.TerrainType) strin
 func (s *NodeService) getRarityThresholdFromEnum(rarity resourceNodeV1.ResourceRarity) float64 {
 	switch rarity {
 	case resourceNodeV1.ResourceRarity_RESOURCE_RARITY_COMMON:
-		return CommonThreshold
+		return s.balance.RarityThresholds["common"]
 	case resourceNodeV1.ResourceRarity_RESOURCE_RARITY_UNCOMMON:
-		return UncommonThreshold
+		return s.balance.RarityThresholds["uncommon"]
 	case resourceNodeV1.ResourceRarity_RESOURCE_RARITY_RARE:
-		return RareThreshold
+		return s.balance.RarityThresholds["rare"]
 	case resourceNodeV1.ResourceRarity_RESOURCE_RARITY_VERY_RARE:
-		return VeryRareThreshold
+		return s.balance.RarityThresholds["very_rare"]
 	default:
-		return CommonThreshold
+		return s.balance.RarityThresholds["common"]
 	}
 }
 
 // determineClusterSizeFromEnum returns the cluster size based on rarity enum
-func (s *NodeService) determineClusterSizeFromEnum(rarity resourceNodeV1.ResourceRarity) int {
+func (s *NodeService) determineClusterSizeFromEnum(rarity resourceNodeV1.ResourceRarity, rnd RandomGeneratorInterface) int {
 	var rarityString string
 	switch rarity {
 	case resourceNodeV1.ResourceRarity_RESOURCE_RARITY_COMMON:
@@ -703,13 +562,13 @@ func (s *NodeService) determineClusterSizeFromEnum(rarity resourceNodeV1.Resourc
 		rarityString = "common"
 	}
 
-	return s.determineClusterSize(rarityString)
+	return s.determineClusterSize(rarityString, rnd)
 }
 
-// generateClusterID generates a unique ID for a resource cluster
+// generateClusterID generates the ID for a resource cluster. It is derived from the
+// cluster's position and type only, so regenerating a chunk gives the same IDs.
 func generateClusterID(chunkX, chunkY, posX, posY int32, resourceNodeTypeID int32) string {
-	// Create a unique string based on position and resource type
-	input := fmt.Sprintf("%d:%d:%d:%d:%d:%d", chunkX, chunkY, posX, posY, resourceNodeTypeID, time.Now().UnixNano())
+	input := fmt.Sprintf("%d:%d:%d:%d:%d", chunkX, chunkY, posX, posY, resourceNodeTypeID)
 
 	// Generate MD5 hash
 	hash := md5.Sum([]byte(input))
@@ -723,44 +582,52 @@ func distance(x1, y1, x2, y2 int32) float64 {
 	return (dx*dx + dy*dy)
 }
 
-// StoreResourceNodes stores generated resource nodes in the database
-func (s *NodeService) StoreResourceNodes(ctx context.Context, chunkX, chunkY int32, resources []*resourceNodeV1.ResourceNode) error {
-	s.logger.Debug("Storing resource nodes", "chunk_x", chunkX, "chunk_y", chunkY, "count", len(resources))
-
-	// Get default world to get the WorldID
+// worldID returns the world whose resource nodes ctx reads and writes: the session's
+// world, or the default world for contexts not acting for a player
+func (s *NodeService) worldID(ctx context.Context) (pgtype.UUID, error) {
+	worldID, err := session.WorldUUIDFromContext(ctx)
+	if err != nil {
+		return pgtype.UUID{}, fmt.Errorf("invalid session world: %w", err)
+	}
+	if worldID.Valid {
+		return worldID, nil
+	}
 	defaultWorld, err := s.worldService.GetDefaultWorld(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to get default world: %w", err)
+		return pgtype.UUID{}, fmt.Errorf("failed to get default world: %w", err)
 	}
+	return defaultWorld.ID, nil
+}
 
-	// Delete any existing resources for this chunk
-	err = s.db.DeleteResourceNodesInChunk(ctx, db.DeleteResourceNodesInChunkParams{
-		WorldID: defaultWorld.ID,
-		ChunkX:  chunkX,
-		ChunkY:  chunkY,
-	})
+// StoreResourceNodes stores generated resource nodes in the database
+func (s *NodeService) StoreResourceNodes(ctx context.Context, chunkX, chunkY int32, resources []*resourceNodeV1.ResourceNode) error {
+	s.logger.Debug("Storing resource nodes", "chunk_x", chunkX, "chunk_y", chunkY, "count", len(resources))
+
+	worldID, err := s.worldID(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to delete existing resources: %w", err)
+		return err
 	}
 
-	// Insert all new resources
+	// Replace whatever an earlier (or concurrent) generation stored for this chunk
+	nodes := make([]db.CreateResourceNodeParams, 0, len(resources))
 	for _, resource := range resources {
-		_, err := s.db.CreateResourceNode(ctx, db.CreateResourceNodeParams{
+		nodes = append(nodes, db.CreateResourceNodeParams{
 			ResourceNodeTypeID: int32(resource.ResourceNodeType.Id),
-			WorldID:            defaultWorld.ID,
+			WorldID:            worldID,
 			ChunkX:             resource.ChunkX,
 			ChunkY:             resource.ChunkY,
 			ClusterID:          resource.ClusterId,
 			X:                  resource.X,
 			Y:                  resource.Y,
 			Size:               resource.Size,
+			Quality:            storedQuality(resource.Quality),
 		})
-		if err != nil {
-			return fmt.Errorf("failed to create resource node: %w", err)
-		}
 	}
-
-	return nil
+	return s.db.ReplaceResourceNodesInChunk(ctx, db.DeleteResourceNodesInChunkParams{
+		WorldID: worldID,
+		ChunkX:  chunkX,
+		ChunkY:  chunkY,
+	}, nodes)
 }
 
 // GetResourcesForChunk retrieves all resources in a chunk, generating them if they don't exist
@@ -768,15 +635,14 @@ func (s *NodeService) GetResourcesForChunk(ctx context.Context, chunkX, chunkY i
 	// Single debug log instead of both info and debug
 	s.logger.Debug("Getting resource nodes for chunk", "chunk_x", chunkX, "chunk_y", chunkY)
 
-	// Get default world to get the WorldID
-	defaultWorld, err := s.worldService.GetDefaultWorld(ctx)
+	worldID, err := s.worldID(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get default world: %w", err)
+		return nil, err
 	}
 
 	// First, try to get existing resources from database
 	dbResources, err := s.db.GetResourceNodesInChunk(ctx, db.GetResourceNodesInChunkParams{
-		WorldID: defaultWorld.ID,
+		WorldID: worldID,
 		ChunkX:  chunkX,
 		ChunkY:  chunkY,
 	})
@@ -793,7 +659,7 @@ func (s *NodeService) GetResourcesForChunk(ctx context.Context, chunkX, chunkY i
 
 	// Check if chunk exists in database
 	chunkExists, err := s.db.ChunkExists(ctx, db.ChunkExistsParams{
-		WorldID: defaultWorld.ID,
+		WorldID: worldID,
 		ChunkX:  chunkX,
 		ChunkY:  chunkY,
 	})
@@ -853,15 +719,14 @@ func (s *NodeService) GetResourcesForChunks(ctx context.Context, chunks []*chunk
 		chunks = chunks[:5]
 	}
 
-	// Get default world to get the WorldID
-	defaultWorld, err := s.worldService.GetDefaultWorld(ctx)
+	worldID, err := s.worldID(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get default world: %w", err)
+		return nil, err
 	}
 
 	// Fill in the parameters
 	params := db.GetResourceNodesInChunksParams{
-		WorldID: defaultWorld.ID,
+		WorldID: worldID,
 	}
 
 	// Add parameters for each chunk
@@ -916,14 +781,13 @@ func (s *NodeService) GetResourcesForChunks(ctx context.Context, chunks []*chunk
 
 // GetResourcesInChunkRange retrieves all resources in a range of chunks
 func (s *NodeService) GetResourcesInChunkRange(ctx context.Context, minX, maxX, minY, maxY int32) ([]*resourceNodeV1.ResourceNode, error) {
-	// Get default world to get the WorldID
-	defaultWorld, err := s.worldService.GetDefaultWorld(ctx)
+	worldID, err := s.worldID(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get default world: %w", err)
+		return nil, err
 	}
 
 	dbResources, err := s.db.GetResourceNodesInChunkRange(ctx, db.GetResourceNodesInChunkRangeParams{
-		WorldID:  defaultWorld.ID,
+		WorldID:  worldID,
 		ChunkX:   minX,
 		ChunkX_2: maxX,
 		ChunkY:   minY,
@@ -956,9 +820,11 @@ func (s *NodeService) convertResourceRows(dbResources []db.ResourceNode) []*reso
 	result := make([]*resourceNodeV1.ResourceNode, 0, len(dbResources))
 
 	// Use the cached resource types map
+	s.balanceMu.RLock()
+	defer s.balanceMu.RUnlock()
 
 	for _, r := range dbResources {
-		// Get the hardcoded resource type from the cached map
+		// Get the configured resource type from the cached map
 		resourceNodeType, ok := s.resourceTypesByID[r.ResourceNodeTypeID]
 		if !ok {
 			// If type is not found, create a generic placeholder
@@ -991,6 +857,7 @@ func (s *NodeService) convertResourceRows(dbResources []db.ResourceNode) []*reso
 			ClusterId:          r.ClusterID,
 			Size:               r.Size,
 			CreatedAt:          createdAt,
+			Quality:            resourceNodeV1.ResourceNodeQuality(r.Quality),
 		}
 
 		result = append(result, node)
@@ -1001,15 +868,14 @@ func (s *NodeService) convertResourceRows(dbResources []db.ResourceNode) []*reso
 
 // getChunkDataForResourceGeneration retrieves chunk data from database for resource generation
 func (s *NodeService) getChunkDataForResourceGeneration(ctx context.Context, chunkX, chunkY int32) (*chunkV1.ChunkData, error) {
-	// Get default world to get the WorldID
-	defaultWorld, err := s.worldService.GetDefaultWorld(ctx)
+	worldID, err := s.worldID(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get default world: %w", err)
+		return nil, err
 	}
 
 	// Get chunk from database
 	dbChunk, err := s.db.GetChunk(ctx, db.GetChunkParams{
-		WorldID: defaultWorld.ID,
+		WorldID: worldID,
 		ChunkX:  chunkX,
 		ChunkY:  chunkY,
 	})
@@ -1017,17 +883,15 @@ func (s *NodeService) getChunkDataForResourceGeneration(ctx context.Context, chu
 		return nil, fmt.Errorf("failed to get chunk from database: %w", err)
 	}
 
-	// Deserialize protobuf data
-	var chunkData chunkV1.ChunkData
-	err = proto.Unmarshal(dbChunk.ChunkData, &chunkData)
+	// Corrupt chunks are repaired by the chunk service when they are next loaded
+	chunkData, err := chunkdata.Decode(dbChunk.ChunkData, chunkX, chunkY)
 	if err != nil {
 		return nil, fmt.Errorf("failed to deserialize chunk data: %w", err)
 	}
 
-	return &chunkData, nil
+	return chunkData, nil
 }
 
-
 // GetResourceNode retrieves a single resource node by ID
 func (s *NodeService) GetResourceNode(ctx context.Context, id int32) (db.ResourceNode, error) {
 	return s.db.GetResourceNode(ctx, id)
@@ -2,17 +2,21 @@ package resource_node
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/VoidMesh/api/api/db"
-	"github.com/VoidMesh/api/api/services/noise"
+	"github.com/VoidMesh/api/api/internal/rng"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/txn"
 	"github.com/VoidMesh/api/api/services/world"
-	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 // DatabaseInterface abstracts database operations for the resource node service.
 type DatabaseInterface interface {
 	CreateResourceNode(ctx context.Context, arg db.CreateResourceNodeParams) (db.ResourceNode, error)
 	DeleteResourceNodesInChunk(ctx context.Context, arg db.DeleteResourceNodesInChunkParams) error
+	ReplaceResourceNodesInChunk(ctx context.Context, chunk db.DeleteResourceNodesInChunkParams, nodes []db.CreateResourceNodeParams) error
 	GetResourceNodesInChunk(ctx context.Context, arg db.GetResourceNodesInChunkParams) ([]db.ResourceNode, error)
 	GetResourceNodesInChunks(ctx context.Context, arg db.GetResourceNodesInChunksParams) ([]db.ResourceNode, error)
 	GetResourceNodesInChunkRange(ctx context.Context, arg db.GetResourceNodesInChunkRangeParams) ([]db.ResourceNode, error)
@@ -23,12 +27,14 @@ type DatabaseInterface interface {
 
 // DatabaseWrapper implements DatabaseInterface using the actual database connection.
 type DatabaseWrapper struct {
+	pool    storage.Pool
 	queries *db.Queries
 }
 
 // NewDatabaseWrapper creates a new database wrapper with the given connection pool.
-func NewDatabaseWrapper(pool *pgxpool.Pool) DatabaseInterface {
+func NewDatabaseWrapper(pool storage.Pool) DatabaseInterface {
 	return &DatabaseWrapper{
+		pool:    pool,
 		queries: db.New(pool),
 	}
 }
@@ -41,6 +47,25 @@ func (d *DatabaseWrapper) DeleteResourceNodesInChunk(ctx context.Context, arg db
 	return d.queries.DeleteResourceNodesInChunk(ctx, arg)
 }
 
+// ReplaceResourceNodesInChunk deletes a chunk's resource nodes and stores nodes in their
+// place in one serializable transaction. Two requests generating the same chunk at once
+// would otherwise both delete and both insert, leaving every node stored twice.
+func (d *DatabaseWrapper) ReplaceResourceNodesInChunk(ctx context.Context, chunk db.DeleteResourceNodesInChunkParams, nodes []db.CreateResourceNodeParams) error {
+	return txn.Run(ctx, d.pool, txn.Serializable, func(q *db.Queries) error {
+		if err := q.DeleteResourceNodesInChunk(ctx, chunk); err != nil {
+			return fmt.Errorf("failed to delete existing resources: %w", err)
+		}
+		rows := make([]db.CopyResourceNodesParams, len(nodes))
+		for i, node := range nodes {
+			rows[i] = db.CopyResourceNodesParams(node)
+		}
+		if _, err := q.CopyResourceNodes(ctx, rows); err != nil {
+			return fmt.Errorf("failed to create resource nodes: %w", err)
+		}
+		return nil
+	})
+}
+
 func (d *DatabaseWrapper) GetResourceNodesInChunk(ctx context.Context, arg db.GetResourceNodesInChunkParams) ([]db.ResourceNode, error) {
 	return d.queries.GetResourceNodesInChunk(ctx, arg)
 }
@@ -71,6 +96,11 @@ type NoiseGeneratorInterface interface {
 	GetSeed() int64
 }
 
+// WorldNoiseInterface resolves the terrain noise of each world, seeded from the world's seed.
+type WorldNoiseInterface interface {
+	Noise(ctx context.Context, worldID pgtype.UUID) (NoiseGeneratorInterface, error)
+}
+
 // WorldServiceInterface defines the interface for world service operations.
 type WorldServiceInterface interface {
 	GetDefaultWorld(ctx context.Context) (db.World, error)
@@ -93,23 +123,25 @@ type RandomGeneratorInterface interface {
 	Shuffle(n int, swap func(i, j int))
 }
 
-// Adapter types to implement interfaces for existing services
-
-// NoiseGeneratorAdapter adapts a noise.GeneratorInterface to our interface.
-type NoiseGeneratorAdapter struct {
-	generator noise.GeneratorInterface
+// RandomStreamsInterface derives the random stream for one purpose and location, so
+// generation results do not depend on the order chunks are generated in.
+type RandomStreamsInterface interface {
+	Stream(purpose rng.Purpose, keys ...int64) RandomGeneratorInterface
 }
 
-func NewNoiseGeneratorAdapter(generator noise.GeneratorInterface) NoiseGeneratorInterface {
-	return &NoiseGeneratorAdapter{generator: generator}
+// Adapter types to implement interfaces for existing services
+
+// WorldNoiseAdapter adapts a world.Seeds cache to our interface.
+type WorldNoiseAdapter struct {
+	seeds *world.Seeds
 }
 
-func (n *NoiseGeneratorAdapter) GetTerrainNoise(x, y int, scale float64) float64 {
-	return n.generator.GetTerrainNoise(x, y, scale)
+func NewWorldNoiseAdapter(seeds *world.Seeds) WorldNoiseInterface {
+	return &WorldNoiseAdapter{seeds: seeds}
 }
 
-func (n *NoiseGeneratorAdapter) GetSeed() int64 {
-	return n.generator.GetSeed()
+func (w *WorldNoiseAdapter) Noise(ctx context.Context, worldID pgtype.UUID) (NoiseGeneratorInterface, error) {
+	return w.seeds.Noise(ctx, worldID)
 }
 
 // WorldServiceAdapter adapts a world.Service to our interface.
@@ -150,4 +182,9 @@ func (l *DefaultLoggerWrapper) Error(msg string, keysAndValues ...interface{}) {
 
 func (l *DefaultLoggerWrapper) With(keysAndValues ...interface{}) LoggerInterface {
 	return l
-}
\ No newline at end of file
+}
+
+// MaturityInterface reports how densely resources spawn in a chunk's region as the world ages.
+type MaturityInterface interface {
+	DensityAt(chunkX, chunkY int32) float64
+}
@@ -0,0 +1,214 @@
+package resource_node
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	resourceNodeV1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
+	"gopkg.in/yaml.v3"
+)
+
+// MinProvidedResourceTypeID is the lowest id a provided resource type may use. Lower
+// ids are reserved for the ResourceNodeTypeId enum, which clients know by name; a
+// provided type reaches clients as a plain number and is described by its
+// ResourceNodeType (name, rarity and visuals) from GetResourceNodeTypes.
+const MinProvidedResourceTypeID = 1000
+
+// ResourceTypeProvider supplies resource node types on top of the balance config,
+// such as a seasonal event or an expansion pack. Its types spawn by the same rules as
+// the configured ones: terrain type, rarity thresholds and cluster sizes.
+//
+// Harvest drops are not part of a type; add rows to resource_node_drops for each
+// provided id, as for the built-in types.
+type ResourceTypeProvider interface {
+	// Name identifies the pack in logs and errors; registering a name again replaces it
+	Name() string
+	// ResourceTypes returns the types the pack adds
+	ResourceTypes() []ResourceTypeConfig
+}
+
+var (
+	providersMu sync.RWMutex
+	providers   = make(map[string]ResourceTypeProvider)
+)
+
+// RegisterProvider adds a provider's types to every NodeService. Its types must be
+// valid, use ids from MinProvidedResourceTypeID up and not collide with another
+// provider's. Register providers before LoadBalanceConfig; a service already running
+// picks them up on its next ReloadBalanceConfig.
+func RegisterProvider(p ResourceTypeProvider) error {
+	name := p.Name()
+	if name == "" {
+		return errors.New("resource type provider is missing a name")
+	}
+
+	providersMu.Lock()
+	defer providersMu.Unlock()
+
+	owners := make(map[int32]string)
+	for other, provider := range providers {
+		if other == name {
+			continue
+		}
+		for _, rt := range provider.ResourceTypes() {
+			owners[rt.ID] = other
+		}
+	}
+
+	if err := validateProvidedTypes(p.ResourceTypes(), owners); err != nil {
+		return fmt.Errorf("invalid resource type provider %q: %w", name, err)
+	}
+
+	providers[name] = p
+	return nil
+}
+
+// validateProvidedTypes checks a provider's types; owners maps the ids of other
+// providers to their names
+func validateProvidedTypes(types []ResourceTypeConfig, owners map[int32]string) error {
+	var errs []error
+	if len(types) == 0 {
+		errs = append(errs, errors.New("resource_types must not be empty"))
+	}
+	seenIDs := make(map[int32]bool, len(types))
+	for i, rt := range types {
+		prefix := fmt.Sprintf("resource_types[%d]", i)
+		if rt.ID < MinProvidedResourceTypeID {
+			errs = append(errs, fmt.Errorf("%s has id %d, provided ids start at %d", prefix, rt.ID, MinProvidedResourceTypeID))
+		}
+		if seenIDs[rt.ID] {
+			errs = append(errs, fmt.Errorf("%s duplicates id %d", prefix, rt.ID))
+		}
+		seenIDs[rt.ID] = true
+		if owner, ok := owners[rt.ID]; ok {
+			errs = append(errs, fmt.Errorf("%s id %d is already provided by %q", prefix, rt.ID, owner))
+		}
+		errs = append(errs, rt.validate(prefix)...)
+	}
+	return errors.Join(errs...)
+}
+
+// UnregisterProvider removes a provider; like registering, it takes effect on the
+// next balance config load
+func UnregisterProvider(name string) {
+	providersMu.Lock()
+	defer providersMu.Unlock()
+	delete(providers, name)
+}
+
+// ProvidedResourceTypes returns the types of every registered provider, ordered by
+// provider name so generation does not depend on registration order
+func ProvidedResourceTypes() []ResourceTypeConfig {
+	providersMu.RLock()
+	defer providersMu.RUnlock()
+
+	names := make([]string, 0, len(providers))
+	for name := range providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var types []ResourceTypeConfig
+	for _, name := range names {
+		types = append(types, providers[name].ResourceTypes()...)
+	}
+	return types
+}
+
+// ResourcePack is a resource type provider read from a data file. The file uses the
+// balance config's resource_types format plus a pack name:
+//
+//	name: winter_festival
+//	resource_types:
+//	  - id: 1001
+//	    name: Frost Bloom
+//	    terrain_type: grass
+//	    ...
+type ResourcePack struct {
+	PackName string               `yaml:"name"`
+	Types    []ResourceTypeConfig `yaml:"resource_types"`
+}
+
+// Name returns the pack name
+func (p *ResourcePack) Name() string {
+	return p.PackName
+}
+
+// ResourceTypes returns the pack's types
+func (p *ResourcePack) ResourceTypes() []ResourceTypeConfig {
+	return slices.Clone(p.Types)
+}
+
+// Validate checks the pack's types as RegisterProvider does, except for collisions
+// with other providers
+func (p *ResourcePack) Validate() error {
+	return validateProvidedTypes(p.Types, nil)
+}
+
+// LoadResourcePack reads a pack file. Unknown fields are rejected as in balance files;
+// the types are validated when the pack is registered.
+func LoadResourcePack(path string) (*ResourcePack, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read resource pack %s: %w", path, err)
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	var pack ResourcePack
+	if err := decoder.Decode(&pack); err != nil {
+		return nil, fmt.Errorf("failed to decode resource pack %s: %w", path, err)
+	}
+	if pack.PackName == "" {
+		pack.PackName = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	return &pack, nil
+}
+
+// RegisterResourcePacks registers every .yaml and .yml pack in dir in file name order
+// and returns their names. It stops at the first pack that fails to load or register,
+// unregistering the packs it had registered.
+func RegisterResourcePacks(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read resource pack directory %s: %w", dir, err)
+	}
+
+	var packs []*ResourcePack
+	for _, entry := range entries {
+		ext := filepath.Ext(entry.Name())
+		if entry.IsDir() || (ext != ".yaml" && ext != ".yml") {
+			continue
+		}
+		pack, err := LoadResourcePack(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		packs = append(packs, pack)
+	}
+
+	var names []string
+	for _, pack := range packs {
+		if err := RegisterProvider(pack); err != nil {
+			for _, name := range names {
+				UnregisterProvider(name)
+			}
+			return nil, err
+		}
+		names = append(names, pack.Name())
+	}
+	return names, nil
+}
+
+// isBuiltInResourceTypeID reports whether id is a ResourceNodeTypeId enum value
+func isBuiltInResourceTypeID(id int32) bool {
+	_, ok := resourceNodeV1.ResourceNodeTypeId_name[id]
+	return ok && id != 0
+}
@@ -0,0 +1,121 @@
+package resource_node
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const winterPack = `
+name: winter_festival
+resource_types:
+  - id: 1001
+    name: Frost Bloom
+    description: Only grows in the snow
+    terrain_type: grass
+    rarity: rare
+    sprite: frost_bloom
+    color: "#a0e8ff"
+    harvest_time: 2
+    respawn_time: 600
+    yield_min: 1
+    yield_max: 2
+`
+
+// staticProvider is a provider registered from Go code
+type staticProvider struct {
+	name  string
+	types []ResourceTypeConfig
+}
+
+func (p staticProvider) Name() string                        { return p.name }
+func (p staticProvider) ResourceTypes() []ResourceTypeConfig { return p.types }
+
+func providedType(id int32) ResourceTypeConfig {
+	return ResourceTypeConfig{ID: id, Name: "Ember Vein", TerrainType: "stone", Rarity: "uncommon", HarvestTime: 3, RespawnTime: 60, YieldMin: 1, YieldMax: 3}
+}
+
+func registerTestProvider(t *testing.T, p ResourceTypeProvider) {
+	t.Helper()
+	require.NoError(t, RegisterProvider(p))
+	t.Cleanup(func() { UnregisterProvider(p.Name()) })
+}
+
+func TestRegisterProvider(t *testing.T) {
+	registerTestProvider(t, staticProvider{name: "expansion", types: []ResourceTypeConfig{providedType(2000)}})
+
+	tests := []struct {
+		name        string
+		provider    staticProvider
+		errContains string
+	}{
+		{"missing name", staticProvider{types: []ResourceTypeConfig{providedType(2001)}}, "missing a name"},
+		{"no types", staticProvider{name: "empty"}, "resource_types must not be empty"},
+		{"built-in id", staticProvider{name: "clash", types: []ResourceTypeConfig{providedType(1)}}, "provided ids start at 1000"},
+		{"duplicate id", staticProvider{name: "twice", types: []ResourceTypeConfig{providedType(2001), providedType(2001)}}, "duplicates id 2001"},
+		{"id of another provider", staticProvider{name: "copycat", types: []ResourceTypeConfig{providedType(2000)}}, `already provided by "expansion"`},
+		{"invalid type", staticProvider{name: "lava", types: []ResourceTypeConfig{{ID: 2001, Name: "Lava", TerrainType: "lava", Rarity: "common", HarvestTime: 1, RespawnTime: 1, YieldMin: 1, YieldMax: 1}}}, `unknown terrain_type "lava"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := RegisterProvider(tt.provider)
+			assert.ErrorContains(t, err, tt.errContains)
+		})
+	}
+
+	t.Run("registering a name again replaces it", func(t *testing.T) {
+		require.NoError(t, RegisterProvider(staticProvider{name: "expansion", types: []ResourceTypeConfig{providedType(2000), providedType(2002)}}))
+		assert.Len(t, ProvidedResourceTypes(), 2)
+	})
+}
+
+func TestNodeService_LoadBalanceConfig_Providers(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "winter.yaml"), []byte(winterPack), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("not a pack"), 0o600))
+	names, err := RegisterResourcePacks(dir)
+	require.NoError(t, err)
+	t.Cleanup(func() { UnregisterProvider("winter_festival") })
+	assert.Equal(t, []string{"winter_festival"}, names)
+
+	service := newBalanceTestService()
+	info, err := service.LoadBalanceConfig(writeBalanceFile(t, minimalBalanceConfig))
+	require.NoError(t, err)
+	assert.Equal(t, 2, info.ResourceTypeCount)
+
+	types, err := service.GetResourceNodeTypes(context.Background())
+	require.NoError(t, err)
+	require.Len(t, types, 2)
+	assert.Equal(t, int32(1001), types[1].Id)
+	assert.Equal(t, "Frost Bloom", types[1].Name)
+	assert.Equal(t, "frost_bloom", types[1].VisualData.Sprite)
+	assert.Equal(t, "#a0e8ff", types[1].VisualData.Color)
+	fields, err := service.fieldsFor(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, fields.seed+1001, fields.spawnNoise(1001).GetSeed(), "provided types spawn from their own noise")
+
+	t.Run("reload picks up providers registered later", func(t *testing.T) {
+		registerTestProvider(t, staticProvider{name: "expansion", types: []ResourceTypeConfig{providedType(2000)}})
+		resp, err := service.ReloadBalanceConfig(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), resp.ResourceTypeCount)
+	})
+}
+
+func TestRegisterResourcePacks_Invalid(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.yaml"), []byte(winterPack), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.yml"), []byte("name: broken\nresource_types:\n  - id: 3\n"), 0o600))
+
+	_, err := RegisterResourcePacks(dir)
+	assert.ErrorContains(t, err, `invalid resource type provider "broken"`)
+	assert.Empty(t, ProvidedResourceTypes(), "packs registered before the failing one are removed")
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.yml"), []byte("name: typo\nresource_type: []\n"), 0o600))
+	_, err = RegisterResourcePacks(dir)
+	assert.ErrorContains(t, err, "field resource_type not found")
+}
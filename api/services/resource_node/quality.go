@@ -0,0 +1,138 @@
+package resource_node
+
+import (
+	"math"
+	"slices"
+
+	resourceNodeV1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
+	"github.com/VoidMesh/api/api/services/noise"
+)
+
+const (
+	// QualityNoiseScale sets the size of the patches nodes of the same quality come in
+	QualityNoiseScale = 40.0
+
+	// qualitySeedOffset keeps the quality noise apart from the resource type noises,
+	// which are seeded from the world seed plus the type ID
+	qualitySeedOffset = 1 << 20
+
+	// The quality noise is sampled on a qualityCalibrationSize² grid, qualityCalibrationStep
+	// cells apart, to find the noise values that split the world into the configured shares
+	qualityCalibrationSize = 64
+	qualityCalibrationStep = 16
+)
+
+// Experiment parameters that override a world's quality distribution, e.g. 0.3 rolls
+// 30% of nodes at that tier. Normal quality takes the remaining share.
+const (
+	QualityPoorShareParam = "quality_poor_share"
+	QualityRichShareParam = "quality_rich_share"
+)
+
+// qualityKeys lists the quality tier names a balance file's quality section must configure
+var qualityKeys = []string{"poor", "normal", "rich"}
+
+// qualityFromString maps a balance file quality tier name to the proto enum
+func qualityFromString(quality string) resourceNodeV1.ResourceNodeQuality {
+	switch quality {
+	case "poor":
+		return resourceNodeV1.ResourceNodeQuality_RESOURCE_NODE_QUALITY_POOR
+	case "normal":
+		return resourceNodeV1.ResourceNodeQuality_RESOURCE_NODE_QUALITY_NORMAL
+	case "rich":
+		return resourceNodeV1.ResourceNodeQuality_RESOURCE_NODE_QUALITY_RICH
+	default:
+		return resourceNodeV1.ResourceNodeQuality_RESOURCE_NODE_QUALITY_UNSPECIFIED
+	}
+}
+
+// qualityShares overrides the balance file's poor and rich shares for one world
+type qualityShares struct {
+	poor, rich float64
+}
+
+// qualityField derives node quality from a noise field seeded by the world seed, so
+// rich and poor nodes come in patches rather than scattered at random. Noise values
+// are not uniformly distributed, so the field keeps a sorted sample of them and rolls
+// tiers by rank; that way the configured shares hold across the world.
+type qualityField struct {
+	noise   noise.GeneratorInterface
+	samples []float64
+}
+
+// newQualityField builds the quality field of a world
+func newQualityField(seed int64) *qualityField {
+	f := &qualityField{
+		noise:   noise.NewGenerator(seed + qualitySeedOffset),
+		samples: make([]float64, 0, qualityCalibrationSize*qualityCalibrationSize),
+	}
+	for y := 0; y < qualityCalibrationSize; y++ {
+		for x := 0; x < qualityCalibrationSize; x++ {
+			f.samples = append(f.samples, f.value(int32(x*qualityCalibrationStep), int32(y*qualityCalibrationStep)))
+		}
+	}
+	slices.Sort(f.samples)
+	return f
+}
+
+// value is the quality noise at a world cell
+func (f *qualityField) value(x, y int32) float64 {
+	return f.noise.GetTerrainNoise(int(x), int(y), QualityNoiseScale)
+}
+
+// quantile returns the noise value below which the given share of cells lie
+func (f *qualityField) quantile(share float64) float64 {
+	i := int(math.Round(share * float64(len(f.samples))))
+	if i <= 0 {
+		return math.Inf(-1)
+	}
+	if i >= len(f.samples) {
+		return math.Inf(1)
+	}
+	return f.samples[i]
+}
+
+// roll returns the quality of a node at a world cell given the share of poor and rich nodes
+func (f *qualityField) roll(x, y int32, poorShare, richShare float64) resourceNodeV1.ResourceNodeQuality {
+	v := f.value(x, y)
+	switch {
+	case v < f.quantile(poorShare):
+		return resourceNodeV1.ResourceNodeQuality_RESOURCE_NODE_QUALITY_POOR
+	case v >= f.quantile(1-richShare):
+		return resourceNodeV1.ResourceNodeQuality_RESOURCE_NODE_QUALITY_RICH
+	default:
+		return resourceNodeV1.ResourceNodeQuality_RESOURCE_NODE_QUALITY_NORMAL
+	}
+}
+
+// rollQuality returns the quality of a new node at a cell of the fields' world. The caller holds balanceMu.
+func (s *NodeService) rollQuality(fields *worldFields, x, y int32) resourceNodeV1.ResourceNodeQuality {
+	poor, rich := s.balance.Quality["poor"].Share, s.balance.Quality["rich"].Share
+	if s.qualityShares != nil {
+		poor, rich = s.qualityShares.poor, s.qualityShares.rich
+	}
+	return fields.quality.roll(x, y, poor, rich)
+}
+
+// QualityMultiplier returns how much harvests from a node of the given quality yield,
+// as stored in the quality column of resource_nodes. Tiers without a configured
+// multiplier yield normally.
+func (s *NodeService) QualityMultiplier(quality int32) float64 {
+	s.balanceMu.RLock()
+	defer s.balanceMu.RUnlock()
+	for name, tier := range s.balance.Quality {
+		if int32(qualityFromString(name)) == quality {
+			return tier.YieldMultiplier
+		}
+	}
+	return 1
+}
+
+// storedQuality is the value stored for a node's quality. Nodes built outside generation,
+// such as chunk template nodes, have no quality and are stored as normal.
+func storedQuality(quality resourceNodeV1.ResourceNodeQuality) int32 {
+	if quality == resourceNodeV1.ResourceNodeQuality_RESOURCE_NODE_QUALITY_UNSPECIFIED {
+		return int32(resourceNodeV1.ResourceNodeQuality_RESOURCE_NODE_QUALITY_NORMAL)
+	}
+	return int32(quality)
+}
@@ -0,0 +1,138 @@
+package resource_node
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	resourceNodeV1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
+)
+
+// qualityShareTolerance is how far generated shares may stray from the configured ones
+const qualityShareTolerance = 0.05
+
+// generatedQualityShares generates resources for a square of chunks and returns the
+// share of nodes at each quality tier
+func generatedQualityShares(t *testing.T, service *NodeService) map[resourceNodeV1.ResourceNodeQuality]float64 {
+	t.Helper()
+	counts := make(map[resourceNodeV1.ResourceNodeQuality]int)
+	total := 0
+	for chunkY := int32(-8); chunkY < 8; chunkY++ {
+		for chunkX := int32(-8); chunkX < 8; chunkX++ {
+			terrain := chunkV1.TerrainType_TERRAIN_TYPE_GRASS
+			if (chunkX+chunkY)%2 != 0 {
+				terrain = chunkV1.TerrainType_TERRAIN_TYPE_STONE
+			}
+			nodes, err := service.GenerateResourcesForChunk(context.Background(), createTestChunkData(chunkX, chunkY, terrain))
+			require.NoError(t, err)
+			for _, node := range nodes {
+				counts[node.Quality]++
+				total++
+			}
+		}
+	}
+	require.Greater(t, total, 1000, "too few nodes for meaningful statistics")
+
+	shares := make(map[resourceNodeV1.ResourceNodeQuality]float64, len(counts))
+	for quality, count := range counts {
+		shares[quality] = float64(count) / float64(total)
+	}
+	return shares
+}
+
+func newQualityTestService() *NodeService {
+	return NewNodeService(NewMockDatabase(), NewMockNoiseGenerator(12345), NewMockWorldService(), NewRandomStreams, NewMockLogger())
+}
+
+func TestGenerateResourcesForChunk_QualityStatistics(t *testing.T) {
+	service := newQualityTestService()
+
+	shares := generatedQualityShares(t, service)
+	assert.InDelta(t, 0.25, shares[resourceNodeV1.ResourceNodeQuality_RESOURCE_NODE_QUALITY_POOR], qualityShareTolerance)
+	assert.InDelta(t, 0.6, shares[resourceNodeV1.ResourceNodeQuality_RESOURCE_NODE_QUALITY_NORMAL], qualityShareTolerance)
+	assert.InDelta(t, 0.15, shares[resourceNodeV1.ResourceNodeQuality_RESOURCE_NODE_QUALITY_RICH], qualityShareTolerance)
+	assert.Zero(t, shares[resourceNodeV1.ResourceNodeQuality_RESOURCE_NODE_QUALITY_UNSPECIFIED], "every generated node has a quality")
+
+	// Quality derives from the world seed, so regenerating gives the same tiers
+	assert.Equal(t, shares, generatedQualityShares(t, newQualityTestService()))
+}
+
+func TestGenerateResourcesForChunk_QualityExperimentParams(t *testing.T) {
+	service := newQualityTestService()
+	service.SetExperimentParams(map[string]float64{QualityPoorShareParam: 0, QualityRichShareParam: 0.5})
+
+	shares := generatedQualityShares(t, service)
+	assert.Zero(t, shares[resourceNodeV1.ResourceNodeQuality_RESOURCE_NODE_QUALITY_POOR])
+	assert.InDelta(t, 0.5, shares[resourceNodeV1.ResourceNodeQuality_RESOURCE_NODE_QUALITY_NORMAL], qualityShareTolerance)
+	assert.InDelta(t, 0.5, shares[resourceNodeV1.ResourceNodeQuality_RESOURCE_NODE_QUALITY_RICH], qualityShareTolerance)
+
+	service.SetExperimentParams(map[string]float64{QualityRichShareParam: 0.9})
+	assert.Nil(t, service.qualityShares, "shares adding up to more than 1 are ignored")
+}
+
+func TestQualityField_Calibration(t *testing.T) {
+	field := newQualityField(987)
+
+	// Sample away from the calibration grid
+	counts := make(map[resourceNodeV1.ResourceNodeQuality]int)
+	const n = 200
+	for y := int32(0); y < n; y++ {
+		for x := int32(0); x < n; x++ {
+			counts[field.roll(x*5+2, y*5+3, 0.2, 0.1)]++
+		}
+	}
+	assert.InDelta(t, 0.2, float64(counts[resourceNodeV1.ResourceNodeQuality_RESOURCE_NODE_QUALITY_POOR])/n/n, qualityShareTolerance)
+	assert.InDelta(t, 0.1, float64(counts[resourceNodeV1.ResourceNodeQuality_RESOURCE_NODE_QUALITY_RICH])/n/n, qualityShareTolerance)
+
+	// Neighbouring nodes usually share a tier
+	same := 0
+	for x := int32(0); x < n; x++ {
+		if field.roll(x*7, 0, 0.2, 0.1) == field.roll(x*7+1, 0, 0.2, 0.1) {
+			same++
+		}
+	}
+	assert.Greater(t, same, n*9/10)
+}
+
+func TestNodeService_QualityMultiplier(t *testing.T) {
+	service := newQualityTestService()
+	assert.Equal(t, 0.5, service.QualityMultiplier(int32(resourceNodeV1.ResourceNodeQuality_RESOURCE_NODE_QUALITY_POOR)))
+	assert.Equal(t, 1.0, service.QualityMultiplier(int32(resourceNodeV1.ResourceNodeQuality_RESOURCE_NODE_QUALITY_NORMAL)))
+	assert.Equal(t, 1.5, service.QualityMultiplier(int32(resourceNodeV1.ResourceNodeQuality_RESOURCE_NODE_QUALITY_RICH)))
+
+	// Without a quality section every node is normal
+	_, err := service.LoadBalanceConfig(writeBalanceFile(t, minimalBalanceConfig))
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, service.QualityMultiplier(int32(resourceNodeV1.ResourceNodeQuality_RESOURCE_NODE_QUALITY_RICH)))
+	nodes, err := service.GenerateResourcesForChunk(context.Background(), createTestChunkData(0, 0, chunkV1.TerrainType_TERRAIN_TYPE_GRASS))
+	require.NoError(t, err)
+	for _, node := range nodes {
+		assert.Equal(t, resourceNodeV1.ResourceNodeQuality_RESOURCE_NODE_QUALITY_NORMAL, node.Quality)
+	}
+}
+
+func TestStoreResourceNodes_Quality(t *testing.T) {
+	database := NewMockDatabase()
+	service := NewNodeService(database, NewMockNoiseGenerator(12345), NewMockWorldService(), NewRandomStreams, NewMockLogger())
+	database.SetChunkExists(true)
+
+	nodes := []*resourceNodeV1.ResourceNode{
+		{ResourceNodeType: &resourceNodeV1.ResourceNodeType{Id: 1}, X: 1, Y: 1, Quality: resourceNodeV1.ResourceNodeQuality_RESOURCE_NODE_QUALITY_RICH},
+		{ResourceNodeType: &resourceNodeV1.ResourceNodeType{Id: 1}, X: 2, Y: 1},
+	}
+	require.NoError(t, service.StoreResourceNodes(context.Background(), 0, 0, nodes))
+
+	stored, err := service.GetResourcesForChunk(context.Background(), 0, 0)
+	require.NoError(t, err)
+	qualities := make(map[int32]resourceNodeV1.ResourceNodeQuality)
+	for _, node := range stored {
+		qualities[node.X] = node.Quality
+	}
+	assert.Equal(t, map[int32]resourceNodeV1.ResourceNodeQuality{
+		1: resourceNodeV1.ResourceNodeQuality_RESOURCE_NODE_QUALITY_RICH,
+		2: resourceNodeV1.ResourceNodeQuality_RESOURCE_NODE_QUALITY_NORMAL,
+	}, qualities, "nodes without a quality are stored as normal")
+}
@@ -0,0 +1,60 @@
+package resource_node
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/testutil"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+)
+
+// resourceRows returns n stored nodes cycling through the configured resource types
+func resourceRows(n int) *pgxmock.Rows {
+	rows := pgxmock.NewRows([]string{"id", "resource_node_type_id", "world_id", "chunk_x", "chunk_y", "cluster_id", "x", "y", "size", "quality", "created_at"})
+	for i := 0; i < n; i++ {
+		rows.AddRow(int32(i+1), int32(i%4+1), "550e8400-e29b-41d4-a716-446655440001", int32(0), int32(0), "cluster", int32(i%32), int32(i/32), int32(1), int32(2), pgtype.Timestamp{})
+	}
+	return rows
+}
+
+// newRecordedService builds a node service whose database calls go through a query recorder
+func newRecordedService(t *testing.T) (*NodeService, pgxmock.PgxPoolIface, *testutil.QueryRecorder) {
+	mock := testutil.GetMockPool(t)
+	recorder := testutil.NewQueryRecorder(mock)
+	database := &DatabaseWrapper{queries: db.New(recorder)}
+	service := NewNodeService(database, NewMockNoiseGenerator(12345), NewMockWorldService(), NewRandomStreams, NewMockLogger())
+	return service, mock, recorder
+}
+
+// Resource types come from the cached balance config, so converting stored nodes must
+// not look their types up one node at a time
+func TestGetResourcesForChunk_QueryBudget(t *testing.T) {
+	service, mock, recorder := newRecordedService(t)
+	budget := testutil.QueryBudget{MaxQueries: 1}
+
+	mock.ExpectQuery("SELECT (.+) FROM resource_nodes rn WHERE rn.world_id = \\$1 AND rn.chunk_x = \\$2 AND rn.chunk_y = \\$3").
+		WithArgs(pgxmock.AnyArg(), int32(0), int32(0)).
+		WillReturnRows(resourceRows(64))
+	testutil.AssertQueryBudget(t, recorder, budget, func() {
+		nodes, err := service.GetResourcesForChunk(context.Background(), 0, 0)
+		require.NoError(t, err)
+		assert.Len(t, nodes, 64)
+	})
+
+	mock.ExpectQuery("SELECT (.+) FROM resource_nodes").
+		WithArgs(pgxmock.AnyArg(), int32(0), int32(0), int32(1), int32(0), int32(-99999), int32(-99999), int32(-99999), int32(-99999), int32(-99999), int32(-99999)).
+		WillReturnRows(resourceRows(64))
+	testutil.AssertQueryBudget(t, recorder, budget, func() {
+		nodes, err := service.GetResourcesForChunks(context.Background(), []*chunkV1.ChunkCoordinate{{ChunkX: 0, ChunkY: 0}, {ChunkX: 1, ChunkY: 0}})
+		require.NoError(t, err)
+		assert.Len(t, nodes, 64)
+	})
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
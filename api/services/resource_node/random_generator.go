@@ -1,34 +1,19 @@
 package resource_node
 
 import (
-	"math/rand"
+	"github.com/VoidMesh/api/api/internal/rng"
 )
 
-// RandomGenerator implements RandomGeneratorInterface using math/rand.
-type RandomGenerator struct {
-	rand *rand.Rand
+// RandomStreams implements RandomStreamsInterface with streams derived from the world seed.
+type RandomStreams struct {
+	seed int64
 }
 
-// NewRandomGenerator creates a new random generator with the given seed.
-func NewRandomGenerator(seed int64) RandomGeneratorInterface {
-	source := rand.NewSource(seed)
-	return &RandomGenerator{
-		rand: rand.New(source),
-	}
+// NewRandomStreams creates the random streams for a world seed.
+func NewRandomStreams(seed int64) RandomStreamsInterface {
+	return &RandomStreams{seed: seed}
 }
 
-func (r *RandomGenerator) Intn(n int) int {
-	return r.rand.Intn(n)
+func (r *RandomStreams) Stream(purpose rng.Purpose, keys ...int64) RandomGeneratorInterface {
+	return rng.New(r.seed, purpose, keys...)
 }
-
-func (r *RandomGenerator) Int31n(n int32) int32 {
-	return r.rand.Int31n(n)
-}
-
-func (r *RandomGenerator) Float32() float32 {
-	return r.rand.Float32()
-}
-
-func (r *RandomGenerator) Shuffle(n int, swap func(i, j int)) {
-	r.rand.Shuffle(n, swap)
-}
\ No newline at end of file
@@ -14,9 +14,11 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/rng"
 	"github.com/VoidMesh/api/api/internal/testutil"
 	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
 	resourceNodeV1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
+	"github.com/VoidMesh/api/api/services/noise"
 )
 
 // Mock implementations for testing
@@ -68,6 +70,7 @@ func (m *MockDatabaseInterface) CreateResourceNode(ctx context.Context, arg db.C
 		X:               arg.X,
 		Y:               arg.Y,
 		Size:               arg.Size,
+		Quality:            arg.Quality,
 		CreatedAt:          pgtype.Timestamp{Valid: true, Time: time.Now()},
 	}
 
@@ -93,6 +96,18 @@ func (m *MockDatabaseInterface) DeleteResourceNodesInChunk(ctx context.Context,
 	return nil
 }
 
+func (m *MockDatabaseInterface) ReplaceResourceNodesInChunk(ctx context.Context, chunk db.DeleteResourceNodesInChunkParams, nodes []db.CreateResourceNodeParams) error {
+	if err := m.DeleteResourceNodesInChunk(ctx, chunk); err != nil {
+		return fmt.Errorf("failed to delete existing resources: %w", err)
+	}
+	for _, node := range nodes {
+		if _, err := m.CreateResourceNode(ctx, node); err != nil {
+			return fmt.Errorf("failed to create resource node: %w", err)
+		}
+	}
+	return nil
+}
+
 func (m *MockDatabaseInterface) GetResourceNodesInChunk(ctx context.Context, arg db.GetResourceNodesInChunkParams) ([]db.ResourceNode, error) {
 	if m.shouldReturnErr {
 		return nil, assert.AnError
@@ -214,6 +229,11 @@ func (m *MockNoiseGenerator) GetSeed() int64 {
 	return m.seed
 }
 
+// Noise serves the mock as the noise of every world
+func (m *MockNoiseGenerator) Noise(ctx context.Context, worldID pgtype.UUID) (NoiseGeneratorInterface, error) {
+	return m, nil
+}
+
 func (m *MockNoiseGenerator) SetNoiseValue(value float64) {
 	m.noiseValue = value
 }
@@ -251,7 +271,8 @@ func (m *MockWorldService) GetDefaultWorld(ctx context.Context) (db.World, error
 	return m.defaultWorld, nil
 }
 
-// MockRandomGenerator implements RandomGeneratorInterface for testing
+// MockRandomGenerator implements RandomGeneratorInterface and RandomStreamsInterface for
+// testing; every stream is the mock itself, so one sequence drives all of generation
 type MockRandomGenerator struct {
 	mock.Mock
 	intValues    []int
@@ -263,6 +284,13 @@ type MockRandomGenerator struct {
 	shuffleFunc  func(n int, swap func(i, j int))
 }
 
+// fixedStreams serves the same streams to every world
+func fixedStreams(streams RandomStreamsInterface) func(seed int64) RandomStreamsInterface {
+	return func(seed int64) RandomStreamsInterface {
+		return streams
+	}
+}
+
 func NewMockRandomGenerator() *MockRandomGenerator {
 	return &MockRandomGenerator{
 		intValues:   []int{0, 1, 2, 3, 4}, // Default sequence
@@ -271,6 +299,10 @@ func NewMockRandomGenerator() *MockRandomGenerator {
 	}
 }
 
+func (m *MockRandomGenerator) Stream(purpose rng.Purpose, keys ...int64) RandomGeneratorInterface {
+	return m
+}
+
 func (m *MockRandomGenerator) SetIntSequence(values []int) {
 	m.intValues = values
 	m.intIndex = 0
@@ -398,6 +430,9 @@ func createMixedTerrainChunk(chunkX, chunkY int32) *chunkV1.ChunkData {
 	}
 }
 
+// defaultMaxResourcesPerChunk mirrors generation.max_resources_per_chunk in the embedded balance config
+const defaultMaxResourcesPerChunk = 24
+
 // Test Service Creation
 
 func TestNewNodeService(t *testing.T) {
@@ -412,9 +447,9 @@ func TestNewNodeService(t *testing.T) {
 			name: "successful service creation with all dependencies",
 			expectFields: func(t *testing.T, service *NodeService) {
 				assert.NotNil(t, service.db)
-				assert.NotNil(t, service.noiseGen)
+				assert.NotNil(t, service.worldNoise)
 				assert.NotNil(t, service.worldService)
-				assert.NotNil(t, service.rnd)
+				assert.NotNil(t, service.newStreams)
 				assert.NotNil(t, service.logger)
 				assert.NotEmpty(t, service.resourceTypes)
 				assert.NotEmpty(t, service.resourceTypesByTerrain)
@@ -431,7 +466,7 @@ func TestNewNodeService(t *testing.T) {
 			mockRandom := NewMockRandomGenerator()
 			mockLogger := NewMockLogger()
 
-			service := NewNodeService(mockDB, mockNoise, mockWorld, mockRandom, mockLogger)
+			service := NewNodeService(mockDB, mockNoise, mockWorld, fixedStreams(mockRandom), mockLogger)
 			require.NotNil(t, service)
 			tt.expectFields(t, service)
 		})
@@ -448,7 +483,7 @@ func TestNodeService_terrainTypeToString(t *testing.T) {
 	mockRandom := NewMockRandomGenerator()
 	mockLogger := NewMockLogger()
 
-	service := NewNodeService(mockDB, mockNoise, mockWorld, mockRandom, mockLogger)
+	service := NewNodeService(mockDB, mockNoise, mockWorld, fixedStreams(mockRandom), mockLogger)
 
 	tests := []struct {
 		name        string
@@ -505,7 +540,7 @@ func TestNodeService_getRarityThresholdFromEnum(t *testing.T) {
 	mockRandom := NewMockRandomGenerator()
 	mockLogger := NewMockLogger()
 
-	service := NewNodeService(mockDB, mockNoise, mockWorld, mockRandom, mockLogger)
+	service := NewNodeService(mockDB, mockNoise, mockWorld, fixedStreams(mockRandom), mockLogger)
 
 	tests := []struct {
 		name     string
@@ -515,27 +550,27 @@ func TestNodeService_getRarityThresholdFromEnum(t *testing.T) {
 		{
 			name:     "common rarity",
 			rarity:   resourceNodeV1.ResourceRarity_RESOURCE_RARITY_COMMON,
-			expected: CommonThreshold,
+			expected: 0.3,
 		},
 		{
 			name:     "uncommon rarity",
 			rarity:   resourceNodeV1.ResourceRarity_RESOURCE_RARITY_UNCOMMON,
-			expected: UncommonThreshold,
+			expected: 0.5,
 		},
 		{
 			name:     "rare rarity",
 			rarity:   resourceNodeV1.ResourceRarity_RESOURCE_RARITY_RARE,
-			expected: RareThreshold,
+			expected: 0.7,
 		},
 		{
 			name:     "very rare rarity",
 			rarity:   resourceNodeV1.ResourceRarity_RESOURCE_RARITY_VERY_RARE,
-			expected: VeryRareThreshold,
+			expected: 0.85,
 		},
 		{
 			name:     "unspecified rarity defaults to common",
 			rarity:   resourceNodeV1.ResourceRarity_RESOURCE_RARITY_UNSPECIFIED,
-			expected: CommonThreshold,
+			expected: 0.3,
 		},
 	}
 
@@ -557,7 +592,7 @@ func TestNodeService_determineClusterSizeFromEnum(t *testing.T) {
 	mockRandom := NewMockRandomGenerator()
 	mockLogger := NewMockLogger()
 
-	service := NewNodeService(mockDB, mockNoise, mockWorld, mockRandom, mockLogger)
+	service := NewNodeService(mockDB, mockNoise, mockWorld, fixedStreams(mockRandom), mockLogger)
 
 	tests := []struct {
 		name         string
@@ -586,9 +621,8 @@ func TestNodeService_determineClusterSizeFromEnum(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRandom := NewMockRandomGenerator()
 			mockRandom.SetIntSequence(tt.randomValues)
-			service.rnd = mockRandom
 
-			result := service.determineClusterSizeFromEnum(tt.rarity)
+			result := service.determineClusterSizeFromEnum(tt.rarity, mockRandom)
 			assert.GreaterOrEqual(t, result, tt.expectedMin)
 			assert.LessOrEqual(t, result, tt.expectedMax)
 		})
@@ -605,7 +639,7 @@ func TestNodeService_isNearTerrainTransition(t *testing.T) {
 	mockRandom := NewMockRandomGenerator()
 	mockLogger := NewMockLogger()
 
-	service := NewNodeService(mockDB, mockNoise, mockWorld, mockRandom, mockLogger)
+	service := NewNodeService(mockDB, mockNoise, mockWorld, fixedStreams(mockRandom), mockLogger)
 
 	tests := []struct {
 		name     string
@@ -651,7 +685,7 @@ func TestNodeService_isNearTerrainTransition(t *testing.T) {
 	}
 }
 
-func TestNodeService_findPotentialSpawnPoints(t *testing.T) {
+func TestChunkField_spawnPoints(t *testing.T) {
 	cleanup := testutil.SetupTest(t, testutil.DefaultTestConfig())
 	defer cleanup()
 
@@ -661,7 +695,7 @@ func TestNodeService_findPotentialSpawnPoints(t *testing.T) {
 	mockRandom := NewMockRandomGenerator()
 	mockLogger := NewMockLogger()
 
-	service := NewNodeService(mockDB, mockNoise, mockWorld, mockRandom, mockLogger)
+	service := NewNodeService(mockDB, mockNoise, mockWorld, fixedStreams(mockRandom), mockLogger)
 
 	tests := []struct {
 		name           string
@@ -703,11 +737,10 @@ func TestNodeService_findPotentialSpawnPoints(t *testing.T) {
 			// Set up noise generator to return consistent values
 			mockNoise.SetNoiseValue(tt.noiseValue)
 
-			spawnPoints := service.findPotentialSpawnPoints(
-				tt.chunk,
+			spawnPoints := service.newChunkField(tt.chunk).spawnPoints(
+				noise.NewGenerator(12345),
 				tt.terrainType,
 				tt.threshold,
-				12345, // resourceSeed
 			)
 
 			assert.GreaterOrEqual(t, len(spawnPoints), tt.expectedMinMax[0],
@@ -745,7 +778,7 @@ func TestNodeService_GenerateResourcesForChunk(t *testing.T) {
 			noiseValue:      0.9, // High noise, should spawn resources
 			randomValues:    []int{0, 1, 2, 3, 4, 5, 6, 7}, // Deterministic random values
 			expectedMin:     1,
-			expectedMax:     MaxResourcesPerChunk + 5, // Allow some variation
+			expectedMax:     defaultMaxResourcesPerChunk + 5, // Allow some variation
 			expectedTerrain: "grass",
 		},
 		{
@@ -754,7 +787,7 @@ func TestNodeService_GenerateResourcesForChunk(t *testing.T) {
 			noiseValue:      0.9,
 			randomValues:    []int{0, 1, 2, 3, 4, 5, 6, 7},
 			expectedMin:     1,
-			expectedMax:     MaxResourcesPerChunk + 5, // Allow some variation
+			expectedMax:     defaultMaxResourcesPerChunk + 5, // Allow some variation
 			expectedTerrain: "water",
 		},
 		{
@@ -763,7 +796,7 @@ func TestNodeService_GenerateResourcesForChunk(t *testing.T) {
 			noiseValue:      0.1, // Very low noise
 			randomValues:    []int{0, 1, 2, 3, 4, 5, 6, 7},
 			expectedMin:     0,
-			expectedMax:     MaxResourcesPerChunk + 10, // Could still hit max if unlucky with noise patterns
+			expectedMax:     defaultMaxResourcesPerChunk + 10, // Could still hit max if unlucky with noise patterns
 			expectedTerrain: "grass",
 		},
 	}
@@ -776,7 +809,7 @@ func TestNodeService_GenerateResourcesForChunk(t *testing.T) {
 			mockRandom := NewMockRandomGenerator()
 			mockLogger := NewMockLogger()
 
-			service := NewNodeService(mockDB, mockNoise, mockWorld, mockRandom, mockLogger)
+			service := NewNodeService(mockDB, mockNoise, mockWorld, fixedStreams(mockRandom), mockLogger)
 
 			// Configure mocks
 			mockNoise.SetNoiseValue(tt.noiseValue)
@@ -927,7 +960,7 @@ func TestNodeService_StoreResourceNodes(t *testing.T) {
 			mockRandom := NewMockRandomGenerator()
 			mockLogger := NewMockLogger()
 
-			service := NewNodeService(mockDB, mockNoise, mockWorld, mockRandom, mockLogger)
+			service := NewNodeService(mockDB, mockNoise, mockWorld, fixedStreams(mockRandom), mockLogger)
 			tt.setupMocks(mockDB, mockWorld)
 
 			ctx := testutil.CreateTestContext()
@@ -1032,7 +1065,7 @@ func TestNodeService_GetResourcesForChunk(t *testing.T) {
 			mockRandom := NewMockRandomGenerator()
 			mockLogger := NewMockLogger()
 
-			service := NewNodeService(mockDB, mockNoise, mockWorld, mockRandom, mockLogger)
+			service := NewNodeService(mockDB, mockNoise, mockWorld, fixedStreams(mockRandom), mockLogger)
 			tt.setupMocks(mockDB, mockWorld)
 
 			// Set appropriate noise values based on test expectations
@@ -1154,7 +1187,7 @@ func TestNodeService_GetResourcesForChunks(t *testing.T) {
 			mockRandom := NewMockRandomGenerator()
 			mockLogger := NewMockLogger()
 
-			service := NewNodeService(mockDB, mockNoise, mockWorld, mockRandom, mockLogger)
+			service := NewNodeService(mockDB, mockNoise, mockWorld, fixedStreams(mockRandom), mockLogger)
 			tt.setupMocks(mockDB, mockWorld)
 
 			ctx := testutil.CreateTestContext()
@@ -1222,7 +1255,7 @@ func TestNodeService_GetResourcesInChunkRange(t *testing.T) {
 			mockRandom := NewMockRandomGenerator()
 			mockLogger := NewMockLogger()
 
-			service := NewNodeService(mockDB, mockNoise, mockWorld, mockRandom, mockLogger)
+			service := NewNodeService(mockDB, mockNoise, mockWorld, fixedStreams(mockRandom), mockLogger)
 			tt.setupMocks(mockDB, mockWorld)
 
 			ctx := testutil.CreateTestContext()
@@ -1251,7 +1284,7 @@ func TestNodeService_convertResourceRows(t *testing.T) {
 	mockRandom := NewMockRandomGenerator()
 	mockLogger := NewMockLogger()
 
-	service := NewNodeService(mockDB, mockNoise, mockWorld, mockRandom, mockLogger)
+	service := NewNodeService(mockDB, mockNoise, mockWorld, fixedStreams(mockRandom), mockLogger)
 
 	tests := []struct {
 		name        string
@@ -1386,7 +1419,7 @@ func TestNodeService_GetResourceNode(t *testing.T) {
 			mockRandom := NewMockRandomGenerator()
 			mockLogger := NewMockLogger()
 
-			service := NewNodeService(mockDB, mockNoise, mockWorld, mockRandom, mockLogger)
+			service := NewNodeService(mockDB, mockNoise, mockWorld, fixedStreams(mockRandom), mockLogger)
 			tt.setupMocks(mockDB)
 
 			ctx := testutil.CreateTestContext()
@@ -1437,36 +1470,11 @@ func TestDistance(t *testing.T) {
 }
 
 func TestGenerateClusterID(t *testing.T) {
-	tests := []struct {
-		name                     string
-		chunkX, chunkY           int32
-		posX, posY               int32
-		resourceNodeTypeID       int32
-		expectedLength           int
-		expectedUniqueness       bool
-	}{
-		{
-			name:               "generates consistent length",
-			chunkX: 0, chunkY: 0,
-			posX: 10, posY: 10,
-			resourceNodeTypeID: 1,
-			expectedLength:     16, // First 16 characters of MD5 hash
-			expectedUniqueness: true,
-		},
-	}
+	id1 := generateClusterID(0, 0, 10, 10, 1)
+	id2 := generateClusterID(0, 0, 10, 10, 1)
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			id1 := generateClusterID(tt.chunkX, tt.chunkY, tt.posX, tt.posY, tt.resourceNodeTypeID)
-			id2 := generateClusterID(tt.chunkX, tt.chunkY, tt.posX, tt.posY, tt.resourceNodeTypeID)
-
-			assert.Len(t, id1, tt.expectedLength)
-			assert.Len(t, id2, tt.expectedLength)
-
-			if tt.expectedUniqueness {
-				// IDs should be different due to timestamp component
-				assert.NotEqual(t, id1, id2)
-			}
-		})
-	}
-}
\ No newline at end of file
+	assert.Len(t, id1, 16) // First 16 characters of MD5 hash
+	assert.Equal(t, id1, id2, "regenerating a chunk gives the same cluster IDs")
+	assert.NotEqual(t, id1, generateClusterID(0, 0, 10, 11, 1))
+	assert.NotEqual(t, id1, generateClusterID(0, 0, 10, 10, 2))
+}
@@ -0,0 +1,102 @@
+package resource_node
+
+import (
+	"runtime"
+	"sync"
+
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	resourceNodeV1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
+	"github.com/VoidMesh/api/api/services/noise"
+)
+
+// spawnPoint is a chunk-local cell where a resource type's noise clears its rarity threshold
+type spawnPoint struct {
+	x, y       int32
+	noiseValue float64
+}
+
+// chunkField is the part of spawn evaluation that does not depend on the resource
+// type: which cells can hold a resource at all. It is built once per chunk and only
+// read afterwards, so every type can be scanned against it concurrently.
+type chunkField struct {
+	chunkX, chunkY int32
+	// cellsByTerrain lists the cells away from terrain transitions, as row-major indexes
+	cellsByTerrain map[string][]int32
+}
+
+// newChunkField classifies every cell of the chunk
+func (s *NodeService) newChunkField(chunk *chunkV1.ChunkData) *chunkField {
+	field := &chunkField{
+		chunkX:         chunk.ChunkX,
+		chunkY:         chunk.ChunkY,
+		cellsByTerrain: make(map[string][]int32),
+	}
+	for y := int32(0); y < ChunkSize; y++ {
+		for x := int32(0); x < ChunkSize; x++ {
+			cellIndex := y*ChunkSize + x
+			if cellIndex >= int32(len(chunk.Cells)) || s.isNearTerrainTransition(chunk, x, y) {
+				continue
+			}
+			terrainType := s.terrainTypeToString(chunk.Cells[cellIndex].TerrainType)
+			field.cellsByTerrain[terrainType] = append(field.cellsByTerrain[terrainType], cellIndex)
+		}
+	}
+	return field
+}
+
+// spawnPoints evaluates a resource type's noise over the cells of its terrain and
+// returns those above threshold, in row-major order
+func (f *chunkField) spawnPoints(resourceNoise noise.GeneratorInterface, terrainType string, threshold float64) []spawnPoint {
+	var points []spawnPoint
+	for _, cellIndex := range f.cellsByTerrain[terrainType] {
+		x, y := cellIndex%ChunkSize, cellIndex/ChunkSize
+		worldX := f.chunkX*ChunkSize + x
+		worldY := f.chunkY*ChunkSize + y
+
+		// Combine a large-scale noise for overall distribution with a small-scale noise for
+		// detail, weighting the large scale more heavily for better clustering
+		largeScaleNoise := resourceNoise.GetTerrainNoise(int(worldX), int(worldY), ResourceNoiseScale)
+		detailNoise := resourceNoise.GetTerrainNoise(int(worldX), int(worldY), ResourceDetailScale)
+		combinedNoise := largeScaleNoise*0.8 + detailNoise*0.2
+
+		// Normalize to 0-1 range
+		normalizedNoise := (combinedNoise + 1.0) / 2.0
+		if normalizedNoise > threshold {
+			points = append(points, spawnPoint{x: x, y: y, noiseValue: normalizedNoise})
+		}
+	}
+	return points
+}
+
+// scanSpawnPoints finds the spawn points of every resource type, spreading the types
+// over up to GOMAXPROCS goroutines. Result i belongs to resourceTypes[i], so callers
+// merge in a fixed order however the scans were scheduled. The caller holds balanceMu.
+func (s *NodeService) scanSpawnPoints(fields *worldFields, field *chunkField, resourceTypes []*resourceNodeV1.ResourceNodeType) [][]spawnPoint {
+	results := make([][]spawnPoint, len(resourceTypes))
+	spawnNoise := make([]noise.GeneratorInterface, len(resourceTypes))
+	for i, resourceType := range resourceTypes {
+		spawnNoise[i] = fields.spawnNoise(resourceType.Id)
+	}
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < min(runtime.GOMAXPROCS(0), len(resourceTypes)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				resourceType := resourceTypes[i]
+				results[i] = field.spawnPoints(
+					spawnNoise[i],
+					resourceType.TerrainType,
+					s.getRarityThresholdFromEnum(resourceType.Rarity),
+				)
+			}
+		}()
+	}
+	for i := range resourceTypes {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	return results
+}
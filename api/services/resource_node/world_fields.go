@@ -0,0 +1,63 @@
+package resource_node
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/VoidMesh/api/api/services/noise"
+)
+
+// worldFields are the generation inputs derived from one world's seed: the random
+// streams, the quality field and the spawn noise of each resource type
+type worldFields struct {
+	seed    int64
+	streams RandomStreamsInterface
+	quality *qualityField
+
+	// noiseMu guards resourceNoise, built per resource type on first use
+	noiseMu       sync.Mutex
+	resourceNoise map[int32]noise.GeneratorInterface
+}
+
+// spawnNoise returns the spawn noise of a resource type. Each type is seeded from the
+// world seed and its ID so different resources spawn in different patterns.
+func (f *worldFields) spawnNoise(resourceTypeID int32) noise.GeneratorInterface {
+	f.noiseMu.Lock()
+	defer f.noiseMu.Unlock()
+	generator, ok := f.resourceNoise[resourceTypeID]
+	if !ok {
+		generator = noise.NewGenerator(f.seed + int64(resourceTypeID))
+		f.resourceNoise[resourceTypeID] = generator
+	}
+	return generator
+}
+
+// fieldsFor returns the generation fields of the world ctx reads and writes, deriving
+// them from the world's seed on first use
+func (s *NodeService) fieldsFor(ctx context.Context) (*worldFields, error) {
+	worldID, err := s.worldID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.worldsMu.Lock()
+	defer s.worldsMu.Unlock()
+	if fields, ok := s.worlds[worldID]; ok {
+		return fields, nil
+	}
+
+	generator, err := s.worldNoise.Noise(ctx, worldID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get world noise: %w", err)
+	}
+	seed := generator.GetSeed()
+	fields := &worldFields{
+		seed:          seed,
+		streams:       s.newStreams(seed),
+		quality:       newQualityField(seed),
+		resourceNoise: make(map[int32]noise.GeneratorInterface),
+	}
+	s.worlds[worldID] = fields
+	return fields, nil
+}
@@ -0,0 +1,109 @@
+package social
+
+import (
+	"context"
+	"errors"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	socialV1 "github.com/VoidMesh/api/api/proto/social/v1"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// errBlocked is returned for any interaction between users where either blocked the other.
+// It does not say who blocked whom.
+var errBlocked = status.Errorf(codes.PermissionDenied, "cannot interact with this user")
+
+// BlockUser blocks another user and ends any friendship or pending request with them
+func (s *Service) BlockUser(ctx context.Context, userID, otherUserID string) error {
+	id, other, err := parsePair(userID, otherUserID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.db.GetUserById(ctx, other); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return status.Errorf(codes.NotFound, "user not found")
+		}
+		s.logger.Error("Failed to get user", "user_id", otherUserID, "error", err)
+		return status.Errorf(codes.Internal, "failed to get user")
+	}
+
+	if err := s.db.CreateUserBlock(ctx, db.CreateUserBlockParams{BlockerID: id, BlockedID: other, CreatedAt: s.now()}); err != nil {
+		s.logger.Error("Failed to block user", "user_id", userID, "error", err)
+		return status.Errorf(codes.Internal, "failed to block user")
+	}
+	if _, err := s.db.DeleteFriendship(ctx, db.DeleteFriendshipParams{UserA: id, UserB: other}); err != nil {
+		// The block already stops messages, so a leftover friendship is harmless
+		s.logger.Warn("Failed to remove friendship with blocked user", "user_id", userID, "error", err)
+	}
+
+	s.logger.Info("User blocked", "user_id", userID, "blocked_id", otherUserID)
+	return nil
+}
+
+// UnblockUser removes a block the user placed
+func (s *Service) UnblockUser(ctx context.Context, userID, otherUserID string) error {
+	id, other, err := parsePair(userID, otherUserID)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := s.db.DeleteUserBlock(ctx, db.DeleteUserBlockParams{BlockerID: id, BlockedID: other})
+	if err != nil {
+		s.logger.Error("Failed to unblock user", "user_id", userID, "error", err)
+		return status.Errorf(codes.Internal, "failed to unblock user")
+	}
+	if deleted == 0 {
+		return status.Errorf(codes.NotFound, "user is not blocked")
+	}
+	return nil
+}
+
+// ListBlockedUsers returns the users the user has blocked
+func (s *Service) ListBlockedUsers(ctx context.Context, userID string) ([]*socialV1.BlockedUser, error) {
+	id, err := uuid.StringToPgtype(userID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID format")
+	}
+
+	rows, err := s.db.ListUserBlocks(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to list blocked users", "user_id", userID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list blocked users")
+	}
+
+	users := make([]*socialV1.BlockedUser, 0, len(rows))
+	for _, row := range rows {
+		users = append(users, &socialV1.BlockedUser{
+			UserId:      uuid.PgtypeToString(row.BlockedID),
+			Username:    row.Username,
+			DisplayName: row.DisplayName,
+			BlockedAt:   timestamppb.New(row.CreatedAt.Time),
+		})
+	}
+	return users, nil
+}
+
+// Blocked reports whether either user has blocked the other. Features that let players
+// interact check it before acting.
+func (s *Service) Blocked(ctx context.Context, userID, otherUserID string) (bool, error) {
+	id, other, err := parsePair(userID, otherUserID)
+	if err != nil {
+		return false, err
+	}
+	return s.blocked(ctx, id, other)
+}
+
+func (s *Service) blocked(ctx context.Context, a, b pgtype.UUID) (bool, error) {
+	blocked, err := s.db.IsBlockedBetween(ctx, db.IsBlockedBetweenParams{UserA: a, UserB: b})
+	if err != nil {
+		s.logger.Error("Failed to check blocks", "user_id", uuid.PgtypeToString(a), "error", err)
+		return false, status.Errorf(codes.Internal, "failed to check blocks")
+	}
+	return blocked, nil
+}
@@ -0,0 +1,213 @@
+package social
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/outbox"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	"github.com/charmbracelet/log"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DatabaseInterface abstracts database operations for friendships and direct messages.
+type DatabaseInterface interface {
+	GetUserById(ctx context.Context, id pgtype.UUID) (db.User, error)
+	GetUserByUsername(ctx context.Context, username string) (db.User, error)
+	CreateFriendRequest(ctx context.Context, arg db.CreateFriendRequestParams) (db.Friendship, error)
+	GetFriendshipBetween(ctx context.Context, arg db.GetFriendshipBetweenParams) (db.Friendship, error)
+	AcceptFriendRequest(ctx context.Context, arg db.AcceptFriendRequestParams) (db.Friendship, error)
+	DeclineFriendRequest(ctx context.Context, arg db.DeclineFriendRequestParams) (int64, error)
+	DeleteFriendship(ctx context.Context, arg db.DeleteFriendshipParams) (int64, error)
+	ListFriendships(ctx context.Context, userID pgtype.UUID) ([]db.ListFriendshipsRow, error)
+	CreateDirectMessage(ctx context.Context, arg db.CreateDirectMessageParams) (db.DirectMessage, error)
+	ListUndeliveredDirectMessages(ctx context.Context, arg db.ListUndeliveredDirectMessagesParams) ([]db.DirectMessage, error)
+	MarkDirectMessagesDelivered(ctx context.Context, arg db.MarkDirectMessagesDeliveredParams) error
+	ListConversation(ctx context.Context, arg db.ListConversationParams) ([]db.DirectMessage, error)
+	CreateUserBlock(ctx context.Context, arg db.CreateUserBlockParams) error
+	DeleteUserBlock(ctx context.Context, arg db.DeleteUserBlockParams) (int64, error)
+	IsBlockedBetween(ctx context.Context, arg db.IsBlockedBetweenParams) (bool, error)
+	ListUserBlocks(ctx context.Context, blockerID pgtype.UUID) ([]db.ListUserBlocksRow, error)
+	CreatePlayerReport(ctx context.Context, arg db.CreatePlayerReportParams) (db.PlayerReport, error)
+	GetPlayerReport(ctx context.Context, id int64) (db.PlayerReport, error)
+	ListPlayerReports(ctx context.Context, arg db.ListPlayerReportsParams) ([]db.PlayerReport, error)
+	ResolvePlayerReport(ctx context.Context, arg db.ResolvePlayerReportParams) (db.PlayerReport, error)
+}
+
+// DatabaseWrapper implements DatabaseInterface using the actual database connection.
+type DatabaseWrapper struct {
+	pool    storage.Pool
+	queries *db.Queries
+}
+
+// NewDatabaseWrapper creates a new database wrapper with the given connection pool.
+func NewDatabaseWrapper(pool storage.Pool) DatabaseInterface {
+	return &DatabaseWrapper{
+		pool:    pool,
+		queries: db.New(pool),
+	}
+}
+
+func (d *DatabaseWrapper) GetUserById(ctx context.Context, id pgtype.UUID) (db.User, error) {
+	return d.queries.GetUserById(ctx, id)
+}
+
+func (d *DatabaseWrapper) GetUserByUsername(ctx context.Context, username string) (db.User, error) {
+	return d.queries.GetUserByUsername(ctx, username)
+}
+
+// CreateFriendRequest stores a pending request and enqueues a FriendRequestSent event in the same transaction.
+func (d *DatabaseWrapper) CreateFriendRequest(ctx context.Context, arg db.CreateFriendRequestParams) (db.Friendship, error) {
+	var friendship db.Friendship
+	err := outbox.InTx(ctx, d.pool, func(q *db.Queries) error {
+		var err error
+		friendship, err = q.CreateFriendRequest(ctx, arg)
+		if err != nil {
+			return err
+		}
+		return enqueueFriendEvent(ctx, q, events.FriendRequestSent, friendship, friendship.CreatedAt)
+	})
+	return friendship, err
+}
+
+func (d *DatabaseWrapper) GetFriendshipBetween(ctx context.Context, arg db.GetFriendshipBetweenParams) (db.Friendship, error) {
+	return d.queries.GetFriendshipBetween(ctx, arg)
+}
+
+// AcceptFriendRequest accepts a pending request and enqueues a FriendRequestAccepted event in the same transaction.
+func (d *DatabaseWrapper) AcceptFriendRequest(ctx context.Context, arg db.AcceptFriendRequestParams) (db.Friendship, error) {
+	var friendship db.Friendship
+	err := outbox.InTx(ctx, d.pool, func(q *db.Queries) error {
+		var err error
+		friendship, err = q.AcceptFriendRequest(ctx, arg)
+		if err != nil {
+			return err
+		}
+		return enqueueFriendEvent(ctx, q, events.FriendRequestAccepted, friendship, friendship.RespondedAt)
+	})
+	return friendship, err
+}
+
+// enqueueFriendEvent keys the event on the pair and the time of the change, so a request
+// sent again after being declined is a new event
+func enqueueFriendEvent(ctx context.Context, q *db.Queries, eventType string, friendship db.Friendship, at pgtype.Timestamp) error {
+	requesterID := uuid.PgtypeToString(friendship.RequesterID)
+	addresseeID := uuid.PgtypeToString(friendship.AddresseeID)
+	return outbox.Enqueue(ctx, q, eventType, requesterID,
+		fmt.Sprintf("%s:%s:%s:%d", eventType, requesterID, addresseeID, at.Time.UnixNano()),
+		events.FriendRequestPayload{RequesterID: requesterID, AddresseeID: addresseeID})
+}
+
+func (d *DatabaseWrapper) DeclineFriendRequest(ctx context.Context, arg db.DeclineFriendRequestParams) (int64, error) {
+	return d.queries.DeclineFriendRequest(ctx, arg)
+}
+
+func (d *DatabaseWrapper) DeleteFriendship(ctx context.Context, arg db.DeleteFriendshipParams) (int64, error) {
+	return d.queries.DeleteFriendship(ctx, arg)
+}
+
+func (d *DatabaseWrapper) ListFriendships(ctx context.Context, userID pgtype.UUID) ([]db.ListFriendshipsRow, error) {
+	return d.queries.ListFriendships(ctx, userID)
+}
+
+func (d *DatabaseWrapper) CreateDirectMessage(ctx context.Context, arg db.CreateDirectMessageParams) (db.DirectMessage, error) {
+	return d.queries.CreateDirectMessage(ctx, arg)
+}
+
+func (d *DatabaseWrapper) ListUndeliveredDirectMessages(ctx context.Context, arg db.ListUndeliveredDirectMessagesParams) ([]db.DirectMessage, error) {
+	return d.queries.ListUndeliveredDirectMessages(ctx, arg)
+}
+
+func (d *DatabaseWrapper) MarkDirectMessagesDelivered(ctx context.Context, arg db.MarkDirectMessagesDeliveredParams) error {
+	return d.queries.MarkDirectMessagesDelivered(ctx, arg)
+}
+
+func (d *DatabaseWrapper) ListConversation(ctx context.Context, arg db.ListConversationParams) ([]db.DirectMessage, error) {
+	return d.queries.ListConversation(ctx, arg)
+}
+
+func (d *DatabaseWrapper) CreateUserBlock(ctx context.Context, arg db.CreateUserBlockParams) error {
+	return d.queries.CreateUserBlock(ctx, arg)
+}
+
+func (d *DatabaseWrapper) DeleteUserBlock(ctx context.Context, arg db.DeleteUserBlockParams) (int64, error) {
+	return d.queries.DeleteUserBlock(ctx, arg)
+}
+
+func (d *DatabaseWrapper) IsBlockedBetween(ctx context.Context, arg db.IsBlockedBetweenParams) (bool, error) {
+	return d.queries.IsBlockedBetween(ctx, arg)
+}
+
+func (d *DatabaseWrapper) ListUserBlocks(ctx context.Context, blockerID pgtype.UUID) ([]db.ListUserBlocksRow, error) {
+	return d.queries.ListUserBlocks(ctx, blockerID)
+}
+
+func (d *DatabaseWrapper) CreatePlayerReport(ctx context.Context, arg db.CreatePlayerReportParams) (db.PlayerReport, error) {
+	return d.queries.CreatePlayerReport(ctx, arg)
+}
+
+func (d *DatabaseWrapper) GetPlayerReport(ctx context.Context, id int64) (db.PlayerReport, error) {
+	return d.queries.GetPlayerReport(ctx, id)
+}
+
+func (d *DatabaseWrapper) ListPlayerReports(ctx context.Context, arg db.ListPlayerReportsParams) ([]db.PlayerReport, error) {
+	return d.queries.ListPlayerReports(ctx, arg)
+}
+
+func (d *DatabaseWrapper) ResolvePlayerReport(ctx context.Context, arg db.ResolvePlayerReportParams) (db.PlayerReport, error) {
+	return d.queries.ResolvePlayerReport(ctx, arg)
+}
+
+// PresenceInterface reports whether a user is online
+type PresenceInterface interface {
+	Online(userID string) bool
+}
+
+// NewAccountPolicy keeps accounts from some actions for a while after signup
+// (implemented by signup.Guard)
+type NewAccountPolicy interface {
+	NewAccountRestricted(action string, createdAt time.Time) (bool, time.Duration)
+}
+
+// LoggerInterface abstracts logging operations for dependency injection.
+type LoggerInterface interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+	With(keysAndValues ...interface{}) LoggerInterface
+}
+
+// DefaultLoggerWrapper wraps the internal logging package.
+type DefaultLoggerWrapper struct {
+	logger *log.Logger
+}
+
+func NewDefaultLoggerWrapper() LoggerInterface {
+	return &DefaultLoggerWrapper{logger: logging.GetLogger()}
+}
+
+func (l *DefaultLoggerWrapper) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.Debug(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Info(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.Warn(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.Error(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) With(keysAndValues ...interface{}) LoggerInterface {
+	return &DefaultLoggerWrapper{logger: l.logger.With(keysAndValues...)}
+}
@@ -0,0 +1,201 @@
+package social
+
+import (
+	"context"
+	"math"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	socialV1 "github.com/VoidMesh/api/api/proto/social/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	MaxMessageLength         = 500 // Characters
+	DefaultConversationLimit = 50
+	MaxConversationLimit     = 200
+
+	// messageBufferSize bounds live messages queued per stream; when full, messages
+	// stay undelivered and are sent when the recipient reconnects
+	messageBufferSize = 64
+	// backlogBatchSize is how many stored messages are delivered when a stream opens
+	backlogBatchSize = 200
+)
+
+// SendDirectMessage stores a message to a friend and delivers it to the recipient's open
+// streams. The bool reports whether it was delivered now rather than left for later.
+func (s *Service) SendDirectMessage(ctx context.Context, userID, recipientID, body string) (*socialV1.DirectMessage, bool, error) {
+	sender, recipient, err := parsePair(userID, recipientID)
+	if err != nil {
+		return nil, false, err
+	}
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return nil, false, status.Errorf(codes.InvalidArgument, "message body is required")
+	}
+	if utf8.RuneCountInString(body) > MaxMessageLength {
+		return nil, false, status.Errorf(codes.InvalidArgument, "message must be at most %d characters", MaxMessageLength)
+	}
+
+	blocked, err := s.blocked(ctx, sender, recipient)
+	if err != nil {
+		return nil, false, err
+	}
+	if blocked {
+		return nil, false, errBlocked
+	}
+	friends, err := s.areFriends(ctx, sender, recipient)
+	if err != nil {
+		s.logger.Error("Failed to check friendship", "user_id", userID, "error", err)
+		return nil, false, status.Errorf(codes.Internal, "failed to check friendship")
+	}
+	if !friends {
+		return nil, false, status.Errorf(codes.FailedPrecondition, "direct messages can only be sent to friends")
+	}
+
+	row, err := s.db.CreateDirectMessage(ctx, db.CreateDirectMessageParams{
+		SenderID:    sender,
+		RecipientID: recipient,
+		Body:        body,
+		CreatedAt:   s.now(),
+	})
+	if err != nil {
+		s.logger.Error("Failed to store direct message", "user_id", userID, "error", err)
+		return nil, false, status.Errorf(codes.Internal, "failed to send message")
+	}
+
+	message := dbMessageToProto(row)
+	delivered := s.deliver(message)
+	if delivered {
+		s.markDelivered(ctx, []int64{row.ID})
+	}
+	return message, delivered, nil
+}
+
+// SubscribeDirectMessages streams messages sent to the user: first those stored while they
+// were offline, then new ones as they arrive. The returned function ends the subscription
+// and closes the channel.
+func (s *Service) SubscribeDirectMessages(ctx context.Context, userID string) (<-chan *socialV1.DirectMessage, func(), error) {
+	id, err := uuid.StringToPgtype(userID)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "invalid user ID format")
+	}
+
+	// Subscribe before loading the backlog so nothing sent in between is missed
+	sub := s.streams.Subscribe(userID, messageBufferSize)
+	backlog, err := s.db.ListUndeliveredDirectMessages(ctx, db.ListUndeliveredDirectMessagesParams{RecipientID: id, Limit: backlogBatchSize})
+	if err != nil {
+		s.streams.Unsubscribe(sub)
+		s.logger.Error("Failed to load undelivered messages", "user_id", userID, "error", err)
+		return nil, nil, status.Errorf(codes.Internal, "failed to load messages")
+	}
+
+	ids := make([]int64, 0, len(backlog))
+	for _, row := range backlog {
+		ids = append(ids, row.ID)
+	}
+	if len(ids) > 0 {
+		s.markDelivered(ctx, ids)
+	}
+
+	out := make(chan *socialV1.DirectMessage)
+	done := make(chan struct{})
+	go func() {
+		defer close(out)
+		seen := make(map[int64]bool, len(backlog))
+		for _, row := range backlog {
+			seen[row.ID] = true
+			select {
+			case out <- dbMessageToProto(row):
+			case <-done:
+				return
+			}
+		}
+		for {
+			select {
+			case message, ok := <-sub.C:
+				if !ok {
+					return
+				}
+				if seen[message.Id] {
+					continue // Also in the backlog
+				}
+				select {
+				case out <- message:
+				case <-done:
+					return
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	return out, func() {
+		once.Do(func() {
+			s.streams.Unsubscribe(sub)
+			close(done)
+		})
+	}, nil
+}
+
+// GetConversation returns messages between the user and another user before beforeID
+// (0 for the newest), newest first
+func (s *Service) GetConversation(ctx context.Context, userID, otherUserID string, beforeID int64, limit int32) ([]*socialV1.DirectMessage, error) {
+	id, other, err := parsePair(userID, otherUserID)
+	if err != nil {
+		return nil, err
+	}
+	if beforeID < 0 || limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "before_id and limit must not be negative")
+	}
+	if beforeID == 0 {
+		beforeID = math.MaxInt64
+	}
+	if limit == 0 {
+		limit = DefaultConversationLimit
+	}
+	if limit > MaxConversationLimit {
+		limit = MaxConversationLimit
+	}
+
+	rows, err := s.db.ListConversation(ctx, db.ListConversationParams{UserA: id, UserB: other, BeforeID: beforeID, MaxMessages: limit})
+	if err != nil {
+		s.logger.Error("Failed to list conversation", "user_id", userID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list messages")
+	}
+
+	messages := make([]*socialV1.DirectMessage, 0, len(rows))
+	for _, row := range rows {
+		messages = append(messages, dbMessageToProto(row))
+	}
+	return messages, nil
+}
+
+// deliver queues the message on the recipient's open streams and reports whether any accepted it
+func (s *Service) deliver(message *socialV1.DirectMessage) bool {
+	return s.streams.Publish(message.RecipientId, message) > 0
+}
+
+// markDelivered records delivery; a failure only means the messages are sent again on reconnect
+func (s *Service) markDelivered(ctx context.Context, ids []int64) {
+	if err := s.db.MarkDirectMessagesDelivered(ctx, db.MarkDirectMessagesDeliveredParams{DeliveredAt: s.now(), Ids: ids}); err != nil {
+		s.logger.Warn("Failed to mark direct messages delivered", "count", len(ids), "error", err)
+	}
+}
+
+func dbMessageToProto(row db.DirectMessage) *socialV1.DirectMessage {
+	return &socialV1.DirectMessage{
+		Id:          row.ID,
+		SenderId:    uuid.PgtypeToString(row.SenderID),
+		RecipientId: uuid.PgtypeToString(row.RecipientID),
+		Body:        row.Body,
+		SentAt:      timestamppb.New(row.CreatedAt.Time),
+	}
+}
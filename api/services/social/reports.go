@@ -0,0 +1,224 @@
+package social
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	socialV1 "github.com/VoidMesh/api/api/proto/social/v1"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	MaxReportDetailsLength = 1000 // Characters
+	MaxResolutionLength    = 1000
+	DefaultReportListLimit = 50
+	MaxReportListLimit     = 200
+
+	// reportContextMessages is how many recent messages between the users a report captures
+	reportContextMessages = 20
+
+	reportStatusOpen     = "open"
+	reportStatusResolved = "resolved"
+)
+
+// reportReasons maps reasons to their stored names
+var reportReasons = map[socialV1.ReportReason]string{
+	socialV1.ReportReason_REPORT_REASON_HARASSMENT:     "harassment",
+	socialV1.ReportReason_REPORT_REASON_CHEATING:       "cheating",
+	socialV1.ReportReason_REPORT_REASON_SPAM:           "spam",
+	socialV1.ReportReason_REPORT_REASON_OFFENSIVE_NAME: "offensive_name",
+	socialV1.ReportReason_REPORT_REASON_OTHER:          "other",
+}
+
+// reportContext is the snapshot stored with a report
+type reportContext struct {
+	ReporterUsername string                 `json:"reporter_username"`
+	ReportedUsername string                 `json:"reported_username"`
+	RecentMessages   []reportContextMessage `json:"recent_messages"` // Newest first
+}
+
+type reportContextMessage struct {
+	ID       int64     `json:"id"`
+	SenderID string    `json:"sender_id"`
+	Body     string    `json:"body"`
+	SentAt   time.Time `json:"sent_at"`
+}
+
+// ReportPlayer files a report against another user with a snapshot of their recent
+// messages to the reporter and returns the report ID
+func (s *Service) ReportPlayer(ctx context.Context, userID, reportedID string, reason socialV1.ReportReason, details string) (int64, error) {
+	reporter, reported, err := parsePair(userID, reportedID)
+	if err != nil {
+		return 0, err
+	}
+	reasonName, ok := reportReasons[reason]
+	if !ok {
+		return 0, status.Errorf(codes.InvalidArgument, "a report reason is required")
+	}
+	details = strings.TrimSpace(details)
+	if utf8.RuneCountInString(details) > MaxReportDetailsLength {
+		return 0, status.Errorf(codes.InvalidArgument, "details must be at most %d characters", MaxReportDetailsLength)
+	}
+
+	reporterUser, err := s.db.GetUserById(ctx, reporter)
+	if err != nil {
+		s.logger.Error("Failed to get reporter", "user_id", userID, "error", err)
+		return 0, status.Errorf(codes.Internal, "failed to get user")
+	}
+	reportedUser, err := s.db.GetUserById(ctx, reported)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, status.Errorf(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		s.logger.Error("Failed to get reported user", "user_id", reportedID, "error", err)
+		return 0, status.Errorf(codes.Internal, "failed to get user")
+	}
+
+	messages, err := s.db.ListConversation(ctx, db.ListConversationParams{
+		UserA:       reporter,
+		UserB:       reported,
+		BeforeID:    math.MaxInt64,
+		MaxMessages: reportContextMessages,
+	})
+	if err != nil {
+		s.logger.Error("Failed to load messages for report", "user_id", userID, "error", err)
+		return 0, status.Errorf(codes.Internal, "failed to file report")
+	}
+	snapshot := reportContext{
+		ReporterUsername: reporterUser.Username,
+		ReportedUsername: reportedUser.Username,
+		RecentMessages:   make([]reportContextMessage, 0, len(messages)),
+	}
+	for _, message := range messages {
+		snapshot.RecentMessages = append(snapshot.RecentMessages, reportContextMessage{
+			ID:       message.ID,
+			SenderID: uuid.PgtypeToString(message.SenderID),
+			Body:     message.Body,
+			SentAt:   message.CreatedAt.Time,
+		})
+	}
+	contextJSON, err := json.Marshal(snapshot)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to file report")
+	}
+
+	report, err := s.db.CreatePlayerReport(ctx, db.CreatePlayerReportParams{
+		ReporterID: reporter,
+		ReportedID: reported,
+		Reason:     reasonName,
+		Details:    details,
+		Context:    contextJSON,
+		CreatedAt:  s.now(),
+	})
+	if err != nil {
+		s.logger.Error("Failed to store report", "user_id", userID, "error", err)
+		return 0, status.Errorf(codes.Internal, "failed to file report")
+	}
+
+	s.logger.Info("Player reported", "report_id", report.ID, "reporter_id", userID, "reported_id", reportedID, "reason", reasonName)
+	return report.ID, nil
+}
+
+// ListReports returns reports with the given status (open when unspecified) after a
+// report ID, oldest first, for moderators
+func (s *Service) ListReports(ctx context.Context, reportStatus socialV1.ReportStatus, afterID int64, limit int32) ([]*socialV1.PlayerReport, error) {
+	if afterID < 0 || limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "after_id and limit must not be negative")
+	}
+	if limit == 0 {
+		limit = DefaultReportListLimit
+	}
+	if limit > MaxReportListLimit {
+		limit = MaxReportListLimit
+	}
+	statusName := reportStatusOpen
+	if reportStatus == socialV1.ReportStatus_REPORT_STATUS_RESOLVED {
+		statusName = reportStatusResolved
+	}
+
+	rows, err := s.db.ListPlayerReports(ctx, db.ListPlayerReportsParams{Status: statusName, AfterID: afterID, MaxReports: limit})
+	if err != nil {
+		s.logger.Error("Failed to list reports", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list reports")
+	}
+
+	reports := make([]*socialV1.PlayerReport, 0, len(rows))
+	for _, row := range rows {
+		reports = append(reports, dbReportToProto(row))
+	}
+	return reports, nil
+}
+
+// ResolveReport closes an open report with the moderator's resolution
+func (s *Service) ResolveReport(ctx context.Context, reportID int64, moderatorID, resolution string) (*socialV1.PlayerReport, error) {
+	moderator, err := uuid.StringToPgtype(moderatorID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID format")
+	}
+	resolution = strings.TrimSpace(resolution)
+	if resolution == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "resolution is required")
+	}
+	if utf8.RuneCountInString(resolution) > MaxResolutionLength {
+		return nil, status.Errorf(codes.InvalidArgument, "resolution must be at most %d characters", MaxResolutionLength)
+	}
+
+	report, err := s.db.ResolvePlayerReport(ctx, db.ResolvePlayerReportParams{
+		ID:         reportID,
+		Resolution: resolution,
+		ResolvedBy: moderator,
+		ResolvedAt: s.now(),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		// Either the report does not exist or it was already resolved
+		if _, getErr := s.db.GetPlayerReport(ctx, reportID); getErr == nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "report already resolved")
+		}
+		return nil, status.Errorf(codes.NotFound, "report not found")
+	}
+	if err != nil {
+		s.logger.Error("Failed to resolve report", "report_id", reportID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to resolve report")
+	}
+
+	s.logger.Info("Report resolved", "report_id", reportID, "moderator_id", moderatorID)
+	return dbReportToProto(report), nil
+}
+
+func dbReportToProto(row db.PlayerReport) *socialV1.PlayerReport {
+	report := &socialV1.PlayerReport{
+		Id:          row.ID,
+		ReporterId:  uuid.PgtypeToString(row.ReporterID),
+		ReportedId:  uuid.PgtypeToString(row.ReportedID),
+		Details:     row.Details,
+		ContextJson: string(row.Context),
+		Status:      socialV1.ReportStatus_REPORT_STATUS_OPEN,
+		Resolution:  row.Resolution,
+		CreatedAt:   timestamppb.New(row.CreatedAt.Time),
+	}
+	for reason, name := range reportReasons {
+		if name == row.Reason {
+			report.Reason = reason
+		}
+	}
+	if row.Status == reportStatusResolved {
+		report.Status = socialV1.ReportStatus_REPORT_STATUS_RESOLVED
+	}
+	if row.ResolvedBy.Valid {
+		report.ResolvedBy = uuid.PgtypeToString(row.ResolvedBy)
+	}
+	if row.ResolvedAt.Valid {
+		report.ResolvedAt = timestamppb.New(row.ResolvedAt.Time)
+	}
+	return report
+}
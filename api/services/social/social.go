@@ -0,0 +1,309 @@
+// Package social manages friendships between users and direct messages between friends.
+// Messages are stored before delivery, so recipients without an open stream on this
+// instance receive them when they next connect.
+package social
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/signup"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/userstream"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	socialV1 "github.com/VoidMesh/api/api/proto/social/v1"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// statusAccepted marks a friendship; requests are "pending" until accepted
+const statusAccepted = "accepted"
+
+// Service manages friendships and routes direct messages.
+type Service struct {
+	db       DatabaseInterface
+	presence PresenceInterface
+	logger   LoggerInterface
+	clock    clock.Clock
+	streams  *userstream.Hub[*socialV1.DirectMessage] // Open message streams on this instance
+
+	newAccounts NewAccountPolicy // Nil lets new accounts do everything
+}
+
+// NewService creates a new social service with dependency injection.
+func NewService(db DatabaseInterface, presence PresenceInterface, logger LoggerInterface) *Service {
+	componentLogger := logger.With("component", "social-service")
+	componentLogger.Debug("Creating new social service")
+	return &Service{
+		db:       db,
+		presence: presence,
+		logger:   componentLogger,
+		clock:    clock.New(),
+		streams:  userstream.NewHub[*socialV1.DirectMessage](),
+	}
+}
+
+// NewServiceWithPool creates a service with concrete implementations (convenience constructor for production use).
+func NewServiceWithPool(pool storage.Pool, presence PresenceInterface) *Service {
+	return NewService(NewDatabaseWrapper(pool), presence, NewDefaultLoggerWrapper())
+}
+
+// SetClock replaces the clock used for timestamps (for simulation tests)
+func (s *Service) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// SetNewAccountPolicy keeps new accounts from sending friend requests while the policy
+// restricts signup.ActionFriendRequest
+func (s *Service) SetNewAccountPolicy(policy NewAccountPolicy) {
+	s.newAccounts = policy
+}
+
+// SendFriendRequest asks the user with the given username to be friends. If they already
+// sent the caller a request, it is accepted instead.
+func (s *Service) SendFriendRequest(ctx context.Context, userID, username string) (*socialV1.Friend, error) {
+	id, err := uuid.StringToPgtype(userID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID format")
+	}
+	username = strings.TrimSpace(username)
+	if username == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "username is required")
+	}
+	if err := s.checkNewAccount(ctx, id, signup.ActionFriendRequest); err != nil {
+		return nil, err
+	}
+
+	other, err := s.db.GetUserByUsername(ctx, username)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		s.logger.Error("Failed to get user", "username", username, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if other.ID == id {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot send a friend request to yourself")
+	}
+	blocked, err := s.blocked(ctx, id, other.ID)
+	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		return nil, errBlocked
+	}
+
+	existing, err := s.db.GetFriendshipBetween(ctx, db.GetFriendshipBetweenParams{UserA: id, UserB: other.ID})
+	switch {
+	case err == nil && existing.Status == statusAccepted:
+		return nil, status.Errorf(codes.AlreadyExists, "already friends")
+	case err == nil && existing.RequesterID == id:
+		return nil, status.Errorf(codes.AlreadyExists, "friend request already sent")
+	case err == nil:
+		// They asked first, so sending a request back accepts theirs
+		return s.accept(ctx, other, id)
+	case !errors.Is(err, pgx.ErrNoRows):
+		s.logger.Error("Failed to get friendship", "user_id", userID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to get friendship")
+	}
+
+	friendship, err := s.db.CreateFriendRequest(ctx, db.CreateFriendRequestParams{
+		RequesterID: id,
+		AddresseeID: other.ID,
+		CreatedAt:   s.now(),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		// Another request between the pair was created concurrently
+		return nil, status.Errorf(codes.AlreadyExists, "friend request already exists")
+	}
+	if err != nil {
+		s.logger.Error("Failed to create friend request", "user_id", userID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to create friend request")
+	}
+
+	s.logger.Debug("Friend request sent", "user_id", userID, "addressee_id", uuid.PgtypeToString(other.ID))
+	return &socialV1.Friend{
+		UserId:      uuid.PgtypeToString(other.ID),
+		Username:    other.Username,
+		DisplayName: other.DisplayName,
+		Status:      socialV1.FriendStatus_FRIEND_STATUS_OUTGOING_REQUEST,
+		Since:       timestamppb.New(friendship.CreatedAt.Time),
+	}, nil
+}
+
+// checkNewAccount refuses action if the user's account is too new for it
+func (s *Service) checkNewAccount(ctx context.Context, userID pgtype.UUID, action string) error {
+	if s.newAccounts == nil {
+		return nil
+	}
+	user, err := s.db.GetUserById(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return status.Errorf(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		s.logger.Error("Failed to get user", "user_id", uuid.PgtypeToString(userID), "error", err)
+		return status.Errorf(codes.Internal, "failed to get user")
+	}
+	if restricted, remaining := s.newAccounts.NewAccountRestricted(action, user.CreatedAt.Time); restricted {
+		return status.Errorf(codes.FailedPrecondition, "new accounts can do this in %s", remaining.Round(time.Minute))
+	}
+	return nil
+}
+
+// AcceptFriendRequest accepts a pending request sent to the user by requesterID
+func (s *Service) AcceptFriendRequest(ctx context.Context, userID, requesterID string) (*socialV1.Friend, error) {
+	id, other, err := parsePair(userID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	requester, err := s.db.GetUserById(ctx, other)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "friend request not found")
+	}
+	if err != nil {
+		s.logger.Error("Failed to get user", "user_id", requesterID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	return s.accept(ctx, requester, id)
+}
+
+func (s *Service) accept(ctx context.Context, requester db.User, addresseeID pgtype.UUID) (*socialV1.Friend, error) {
+	friendship, err := s.db.AcceptFriendRequest(ctx, db.AcceptFriendRequestParams{
+		RequesterID: requester.ID,
+		AddresseeID: addresseeID,
+		RespondedAt: s.now(),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "friend request not found")
+	}
+	if err != nil {
+		s.logger.Error("Failed to accept friend request", "requester_id", uuid.PgtypeToString(requester.ID), "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to accept friend request")
+	}
+
+	requesterID := uuid.PgtypeToString(requester.ID)
+	s.logger.Debug("Friend request accepted", "requester_id", requesterID, "addressee_id", uuid.PgtypeToString(addresseeID))
+	return &socialV1.Friend{
+		UserId:      requesterID,
+		Username:    requester.Username,
+		DisplayName: requester.DisplayName,
+		Status:      socialV1.FriendStatus_FRIEND_STATUS_FRIENDS,
+		Online:      s.presence.Online(requesterID),
+		Since:       timestamppb.New(friendship.RespondedAt.Time),
+	}, nil
+}
+
+// DeclineFriendRequest deletes a pending request sent to the user by requesterID
+func (s *Service) DeclineFriendRequest(ctx context.Context, userID, requesterID string) error {
+	id, other, err := parsePair(userID, requesterID)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := s.db.DeclineFriendRequest(ctx, db.DeclineFriendRequestParams{RequesterID: other, AddresseeID: id})
+	if err != nil {
+		s.logger.Error("Failed to decline friend request", "user_id", userID, "error", err)
+		return status.Errorf(codes.Internal, "failed to decline friend request")
+	}
+	if deleted == 0 {
+		return status.Errorf(codes.NotFound, "friend request not found")
+	}
+	return nil
+}
+
+// RemoveFriend ends a friendship or cancels a pending request in either direction
+func (s *Service) RemoveFriend(ctx context.Context, userID, otherUserID string) error {
+	id, other, err := parsePair(userID, otherUserID)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := s.db.DeleteFriendship(ctx, db.DeleteFriendshipParams{UserA: id, UserB: other})
+	if err != nil {
+		s.logger.Error("Failed to remove friend", "user_id", userID, "error", err)
+		return status.Errorf(codes.Internal, "failed to remove friend")
+	}
+	if deleted == 0 {
+		return status.Errorf(codes.NotFound, "friendship not found")
+	}
+	return nil
+}
+
+// ListFriends returns the user's friends with their online status, followed by pending
+// requests in both directions
+func (s *Service) ListFriends(ctx context.Context, userID string) ([]*socialV1.Friend, error) {
+	id, err := uuid.StringToPgtype(userID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID format")
+	}
+
+	rows, err := s.db.ListFriendships(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to list friendships", "user_id", userID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list friends")
+	}
+
+	friends := make([]*socialV1.Friend, 0, len(rows))
+	var pending []*socialV1.Friend
+	for _, row := range rows {
+		friend := &socialV1.Friend{
+			UserId:      uuid.PgtypeToString(row.OtherUserID),
+			Username:    row.OtherUsername,
+			DisplayName: row.OtherDisplayName,
+			Since:       timestamppb.New(row.CreatedAt.Time),
+		}
+		switch {
+		case row.Status == statusAccepted:
+			friend.Status = socialV1.FriendStatus_FRIEND_STATUS_FRIENDS
+			friend.Online = s.presence.Online(friend.UserId)
+			friends = append(friends, friend)
+			continue
+		case row.RequesterID == id:
+			friend.Status = socialV1.FriendStatus_FRIEND_STATUS_OUTGOING_REQUEST
+		default:
+			friend.Status = socialV1.FriendStatus_FRIEND_STATUS_INCOMING_REQUEST
+		}
+		pending = append(pending, friend)
+	}
+	return append(friends, pending...), nil
+}
+
+// areFriends reports whether the two users have an accepted friendship
+func (s *Service) areFriends(ctx context.Context, a, b pgtype.UUID) (bool, error) {
+	friendship, err := s.db.GetFriendshipBetween(ctx, db.GetFriendshipBetweenParams{UserA: a, UserB: b})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return friendship.Status == statusAccepted, nil
+}
+
+func (s *Service) now() pgtype.Timestamp {
+	return pgtype.Timestamp{Time: s.clock.Now(), Valid: true}
+}
+
+// parsePair parses the caller's ID and another user's ID, rejecting the caller themselves
+func parsePair(userID, otherUserID string) (pgtype.UUID, pgtype.UUID, error) {
+	id, err := uuid.StringToPgtype(userID)
+	if err != nil {
+		return pgtype.UUID{}, pgtype.UUID{}, status.Errorf(codes.InvalidArgument, "invalid user ID format")
+	}
+	other, err := uuid.StringToPgtype(otherUserID)
+	if err != nil {
+		return pgtype.UUID{}, pgtype.UUID{}, status.Errorf(codes.InvalidArgument, "invalid user ID format")
+	}
+	if id == other {
+		return pgtype.UUID{}, pgtype.UUID{}, status.Errorf(codes.InvalidArgument, "cannot target yourself")
+	}
+	return id, other, nil
+}
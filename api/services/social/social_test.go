@@ -0,0 +1,487 @@
+package social
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/signup"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	socialV1 "github.com/VoidMesh/api/api/proto/social/v1"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakePresence map[string]bool
+
+func (p fakePresence) Online(userID string) bool {
+	return p[uuid.Normalize(userID)]
+}
+
+// fakeDB keeps users, friendships, messages, blocks and reports in memory
+type fakeDB struct {
+	users       []db.User
+	friendships []db.Friendship
+	messages    []db.DirectMessage
+	blocks      []db.CreateUserBlockParams
+	reports     []db.PlayerReport
+}
+
+func (f *fakeDB) GetUserById(ctx context.Context, id pgtype.UUID) (db.User, error) {
+	for _, user := range f.users {
+		if user.ID == id {
+			return user, nil
+		}
+	}
+	return db.User{}, pgx.ErrNoRows
+}
+
+func (f *fakeDB) GetUserByUsername(ctx context.Context, username string) (db.User, error) {
+	for _, user := range f.users {
+		if user.Username == username {
+			return user, nil
+		}
+	}
+	return db.User{}, pgx.ErrNoRows
+}
+
+func (f *fakeDB) find(a, b pgtype.UUID) int {
+	for i, friendship := range f.friendships {
+		if (friendship.RequesterID == a && friendship.AddresseeID == b) || (friendship.RequesterID == b && friendship.AddresseeID == a) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (f *fakeDB) CreateFriendRequest(ctx context.Context, arg db.CreateFriendRequestParams) (db.Friendship, error) {
+	for _, friendship := range f.friendships {
+		if friendship.RequesterID == arg.RequesterID && friendship.AddresseeID == arg.AddresseeID {
+			return db.Friendship{}, pgx.ErrNoRows
+		}
+	}
+	friendship := db.Friendship{RequesterID: arg.RequesterID, AddresseeID: arg.AddresseeID, Status: "pending", CreatedAt: arg.CreatedAt}
+	f.friendships = append(f.friendships, friendship)
+	return friendship, nil
+}
+
+func (f *fakeDB) GetFriendshipBetween(ctx context.Context, arg db.GetFriendshipBetweenParams) (db.Friendship, error) {
+	if i := f.find(arg.UserA, arg.UserB); i >= 0 {
+		return f.friendships[i], nil
+	}
+	return db.Friendship{}, pgx.ErrNoRows
+}
+
+func (f *fakeDB) AcceptFriendRequest(ctx context.Context, arg db.AcceptFriendRequestParams) (db.Friendship, error) {
+	for i, friendship := range f.friendships {
+		if friendship.RequesterID == arg.RequesterID && friendship.AddresseeID == arg.AddresseeID && friendship.Status == "pending" {
+			f.friendships[i].Status = statusAccepted
+			f.friendships[i].RespondedAt = arg.RespondedAt
+			return f.friendships[i], nil
+		}
+	}
+	return db.Friendship{}, pgx.ErrNoRows
+}
+
+func (f *fakeDB) DeclineFriendRequest(ctx context.Context, arg db.DeclineFriendRequestParams) (int64, error) {
+	for i, friendship := range f.friendships {
+		if friendship.RequesterID == arg.RequesterID && friendship.AddresseeID == arg.AddresseeID && friendship.Status == "pending" {
+			f.friendships = append(f.friendships[:i], f.friendships[i+1:]...)
+			return 1, nil
+		}
+	}
+	return 0, nil
+}
+
+func (f *fakeDB) DeleteFriendship(ctx context.Context, arg db.DeleteFriendshipParams) (int64, error) {
+	i := f.find(arg.UserA, arg.UserB)
+	if i < 0 {
+		return 0, nil
+	}
+	f.friendships = append(f.friendships[:i], f.friendships[i+1:]...)
+	return 1, nil
+}
+
+func (f *fakeDB) ListFriendships(ctx context.Context, userID pgtype.UUID) ([]db.ListFriendshipsRow, error) {
+	var rows []db.ListFriendshipsRow
+	for _, friendship := range f.friendships {
+		otherID := friendship.RequesterID
+		if otherID == userID {
+			otherID = friendship.AddresseeID
+		} else if friendship.AddresseeID != userID {
+			continue
+		}
+		other, _ := f.GetUserById(ctx, otherID)
+		rows = append(rows, db.ListFriendshipsRow{
+			RequesterID:      friendship.RequesterID,
+			AddresseeID:      friendship.AddresseeID,
+			Status:           friendship.Status,
+			CreatedAt:        friendship.CreatedAt,
+			OtherUserID:      other.ID,
+			OtherUsername:    other.Username,
+			OtherDisplayName: other.DisplayName,
+		})
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].OtherUsername < rows[j].OtherUsername })
+	return rows, nil
+}
+
+func (f *fakeDB) CreateDirectMessage(ctx context.Context, arg db.CreateDirectMessageParams) (db.DirectMessage, error) {
+	message := db.DirectMessage{
+		ID:          int64(len(f.messages) + 1),
+		SenderID:    arg.SenderID,
+		RecipientID: arg.RecipientID,
+		Body:        arg.Body,
+		CreatedAt:   arg.CreatedAt,
+	}
+	f.messages = append(f.messages, message)
+	return message, nil
+}
+
+func (f *fakeDB) ListUndeliveredDirectMessages(ctx context.Context, arg db.ListUndeliveredDirectMessagesParams) ([]db.DirectMessage, error) {
+	var messages []db.DirectMessage
+	for _, message := range f.messages {
+		if message.RecipientID == arg.RecipientID && !message.DeliveredAt.Valid && len(messages) < int(arg.Limit) {
+			messages = append(messages, message)
+		}
+	}
+	return messages, nil
+}
+
+func (f *fakeDB) MarkDirectMessagesDelivered(ctx context.Context, arg db.MarkDirectMessagesDeliveredParams) error {
+	for _, id := range arg.Ids {
+		if !f.messages[id-1].DeliveredAt.Valid {
+			f.messages[id-1].DeliveredAt = arg.DeliveredAt
+		}
+	}
+	return nil
+}
+
+func (f *fakeDB) ListConversation(ctx context.Context, arg db.ListConversationParams) ([]db.DirectMessage, error) {
+	var messages []db.DirectMessage
+	for i := len(f.messages) - 1; i >= 0 && len(messages) < int(arg.MaxMessages); i-- {
+		message := f.messages[i]
+		between := (message.SenderID == arg.UserA && message.RecipientID == arg.UserB) ||
+			(message.SenderID == arg.UserB && message.RecipientID == arg.UserA)
+		if between && message.ID < arg.BeforeID {
+			messages = append(messages, message)
+		}
+	}
+	return messages, nil
+}
+
+func (f *fakeDB) CreateUserBlock(ctx context.Context, arg db.CreateUserBlockParams) error {
+	for _, block := range f.blocks {
+		if block.BlockerID == arg.BlockerID && block.BlockedID == arg.BlockedID {
+			return nil
+		}
+	}
+	f.blocks = append(f.blocks, arg)
+	return nil
+}
+
+func (f *fakeDB) DeleteUserBlock(ctx context.Context, arg db.DeleteUserBlockParams) (int64, error) {
+	for i, block := range f.blocks {
+		if block.BlockerID == arg.BlockerID && block.BlockedID == arg.BlockedID {
+			f.blocks = append(f.blocks[:i], f.blocks[i+1:]...)
+			return 1, nil
+		}
+	}
+	return 0, nil
+}
+
+func (f *fakeDB) IsBlockedBetween(ctx context.Context, arg db.IsBlockedBetweenParams) (bool, error) {
+	for _, block := range f.blocks {
+		if (block.BlockerID == arg.UserA && block.BlockedID == arg.UserB) || (block.BlockerID == arg.UserB && block.BlockedID == arg.UserA) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (f *fakeDB) ListUserBlocks(ctx context.Context, blockerID pgtype.UUID) ([]db.ListUserBlocksRow, error) {
+	var rows []db.ListUserBlocksRow
+	for _, block := range f.blocks {
+		if block.BlockerID == blockerID {
+			user, _ := f.GetUserById(ctx, block.BlockedID)
+			rows = append(rows, db.ListUserBlocksRow{BlockedID: block.BlockedID, CreatedAt: block.CreatedAt, Username: user.Username, DisplayName: user.DisplayName})
+		}
+	}
+	return rows, nil
+}
+
+func (f *fakeDB) CreatePlayerReport(ctx context.Context, arg db.CreatePlayerReportParams) (db.PlayerReport, error) {
+	report := db.PlayerReport{
+		ID:         int64(len(f.reports) + 1),
+		ReporterID: arg.ReporterID,
+		ReportedID: arg.ReportedID,
+		Reason:     arg.Reason,
+		Details:    arg.Details,
+		Context:    arg.Context,
+		Status:     reportStatusOpen,
+		CreatedAt:  arg.CreatedAt,
+	}
+	f.reports = append(f.reports, report)
+	return report, nil
+}
+
+func (f *fakeDB) GetPlayerReport(ctx context.Context, id int64) (db.PlayerReport, error) {
+	if id < 1 || id > int64(len(f.reports)) {
+		return db.PlayerReport{}, pgx.ErrNoRows
+	}
+	return f.reports[id-1], nil
+}
+
+func (f *fakeDB) ListPlayerReports(ctx context.Context, arg db.ListPlayerReportsParams) ([]db.PlayerReport, error) {
+	var reports []db.PlayerReport
+	for _, report := range f.reports {
+		if report.Status == arg.Status && report.ID > arg.AfterID && len(reports) < int(arg.MaxReports) {
+			reports = append(reports, report)
+		}
+	}
+	return reports, nil
+}
+
+func (f *fakeDB) ResolvePlayerReport(ctx context.Context, arg db.ResolvePlayerReportParams) (db.PlayerReport, error) {
+	report, err := f.GetPlayerReport(ctx, arg.ID)
+	if err != nil || report.Status != reportStatusOpen {
+		return db.PlayerReport{}, pgx.ErrNoRows
+	}
+	report.Status = reportStatusResolved
+	report.Resolution = arg.Resolution
+	report.ResolvedBy = arg.ResolvedBy
+	report.ResolvedAt = arg.ResolvedAt
+	f.reports[arg.ID-1] = report
+	return report, nil
+}
+
+func testUser(b byte, username string) db.User {
+	return db.User{ID: pgtype.UUID{Bytes: [16]byte{15: b}, Valid: true}, Username: username, DisplayName: username}
+}
+
+func newTestService() (*Service, *fakeDB, fakePresence, db.User, db.User) {
+	alice, bob := testUser(1, "alice"), testUser(2, "bob")
+	database := &fakeDB{users: []db.User{alice, bob}}
+	presence := fakePresence{}
+	return NewService(database, presence, testmocks.NopLogger[LoggerInterface]{}), database, presence, alice, bob
+}
+
+func id(user db.User) string {
+	return uuid.PgtypeToString(user.ID)
+}
+
+func TestFriendRequests(t *testing.T) {
+	service, _, presence, alice, bob := newTestService()
+	ctx := context.Background()
+
+	friend, err := service.SendFriendRequest(ctx, id(alice), "bob")
+	require.NoError(t, err)
+	assert.Equal(t, socialV1.FriendStatus_FRIEND_STATUS_OUTGOING_REQUEST, friend.Status)
+
+	_, err = service.SendFriendRequest(ctx, id(alice), "bob")
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	_, err = service.SendFriendRequest(ctx, id(alice), "alice")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = service.SendFriendRequest(ctx, id(alice), "carol")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	friends, err := service.ListFriends(ctx, id(bob))
+	require.NoError(t, err)
+	require.Len(t, friends, 1)
+	assert.Equal(t, socialV1.FriendStatus_FRIEND_STATUS_INCOMING_REQUEST, friends[0].Status)
+
+	// Only the addressee can accept
+	_, err = service.AcceptFriendRequest(ctx, id(alice), id(bob))
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	presence[uuid.Normalize(id(alice))] = true
+	friend, err = service.AcceptFriendRequest(ctx, id(bob), id(alice))
+	require.NoError(t, err)
+	assert.Equal(t, socialV1.FriendStatus_FRIEND_STATUS_FRIENDS, friend.Status)
+	assert.True(t, friend.Online)
+
+	friends, err = service.ListFriends(ctx, id(bob))
+	require.NoError(t, err)
+	require.Len(t, friends, 1)
+	assert.Equal(t, "alice", friends[0].Username)
+	assert.True(t, friends[0].Online)
+
+	require.NoError(t, service.RemoveFriend(ctx, id(alice), id(bob)))
+	assert.Equal(t, codes.NotFound, status.Code(service.RemoveFriend(ctx, id(alice), id(bob))))
+}
+
+func TestSendFriendRequest_AcceptsReverseRequest(t *testing.T) {
+	service, _, _, alice, bob := newTestService()
+	ctx := context.Background()
+
+	_, err := service.SendFriendRequest(ctx, id(alice), "bob")
+	require.NoError(t, err)
+	friend, err := service.SendFriendRequest(ctx, id(bob), "alice")
+	require.NoError(t, err)
+	assert.Equal(t, socialV1.FriendStatus_FRIEND_STATUS_FRIENDS, friend.Status)
+}
+
+func TestDeclineFriendRequest(t *testing.T) {
+	service, database, _, alice, bob := newTestService()
+	ctx := context.Background()
+
+	_, err := service.SendFriendRequest(ctx, id(alice), "bob")
+	require.NoError(t, err)
+	require.NoError(t, service.DeclineFriendRequest(ctx, id(bob), id(alice)))
+	assert.Empty(t, database.friendships)
+	assert.Equal(t, codes.NotFound, status.Code(service.DeclineFriendRequest(ctx, id(bob), id(alice))))
+}
+
+func TestSendFriendRequest_NewAccountRestricted(t *testing.T) {
+	service, database, _, alice, _ := newTestService()
+	ctx := context.Background()
+	fake := clock.NewFake(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	guard := signup.NewGuard(signup.Config{NewAccountPeriod: 24 * time.Hour, NewAccountActions: []string{signup.ActionFriendRequest}})
+	guard.SetClock(fake)
+	service.SetNewAccountPolicy(guard)
+
+	database.users[0].CreatedAt = pgtype.Timestamp{Time: fake.Now().Add(-2 * time.Hour), Valid: true}
+	_, err := service.SendFriendRequest(ctx, id(alice), "bob")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, err.Error(), "22h0m0s")
+	assert.Empty(t, database.friendships)
+
+	fake.Advance(22 * time.Hour)
+	_, err = service.SendFriendRequest(ctx, id(alice), "bob")
+	require.NoError(t, err)
+}
+
+func befriend(t *testing.T, service *Service, a, b db.User) {
+	t.Helper()
+	_, err := service.SendFriendRequest(context.Background(), id(a), b.Username)
+	require.NoError(t, err)
+	_, err = service.AcceptFriendRequest(context.Background(), id(b), id(a))
+	require.NoError(t, err)
+}
+
+func TestSendDirectMessage_RequiresFriendship(t *testing.T) {
+	service, _, _, alice, bob := newTestService()
+	ctx := context.Background()
+
+	_, _, err := service.SendDirectMessage(ctx, id(alice), id(bob), "hi")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	befriend(t, service, alice, bob)
+	_, _, err = service.SendDirectMessage(ctx, id(alice), id(bob), "   ")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, _, err = service.SendDirectMessage(ctx, id(alice), id(bob), string(make([]byte, MaxMessageLength+1)))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func receive(t *testing.T, ch <-chan *socialV1.DirectMessage) *socialV1.DirectMessage {
+	t.Helper()
+	select {
+	case message := <-ch:
+		return message
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for message")
+		return nil
+	}
+}
+
+func TestDirectMessages_OfflineThenLive(t *testing.T) {
+	service, database, _, alice, bob := newTestService()
+	ctx := context.Background()
+	befriend(t, service, alice, bob)
+
+	// Bob is offline, so the message is stored
+	_, delivered, err := service.SendDirectMessage(ctx, id(alice), id(bob), "are you there?")
+	require.NoError(t, err)
+	assert.False(t, delivered)
+
+	messages, unsubscribe, err := service.SubscribeDirectMessages(ctx, id(bob))
+	require.NoError(t, err)
+	defer unsubscribe()
+
+	assert.Equal(t, "are you there?", receive(t, messages).Body)
+	assert.True(t, database.messages[0].DeliveredAt.Valid)
+
+	_, delivered, err = service.SendDirectMessage(ctx, id(alice), id(bob), "welcome back")
+	require.NoError(t, err)
+	assert.True(t, delivered)
+	assert.Equal(t, "welcome back", receive(t, messages).Body)
+
+	unsubscribe()
+	_, ok := <-messages
+	assert.False(t, ok, "channel closes when the subscription ends")
+
+	conversation, err := service.GetConversation(ctx, id(bob), id(alice), 0, 1)
+	require.NoError(t, err)
+	require.Len(t, conversation, 1)
+	assert.Equal(t, "welcome back", conversation[0].Body, "newest first")
+}
+
+func TestBlockUser(t *testing.T) {
+	service, database, _, alice, bob := newTestService()
+	ctx := context.Background()
+	befriend(t, service, alice, bob)
+
+	require.NoError(t, service.BlockUser(ctx, id(bob), id(alice)))
+	assert.Empty(t, database.friendships, "blocking ends the friendship")
+
+	// Blocks apply in both directions
+	_, _, err := service.SendDirectMessage(ctx, id(alice), id(bob), "hi")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = service.SendFriendRequest(ctx, id(alice), "bob")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = service.SendFriendRequest(ctx, id(bob), "alice")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	blocked, err := service.ListBlockedUsers(ctx, id(bob))
+	require.NoError(t, err)
+	require.Len(t, blocked, 1)
+	assert.Equal(t, "alice", blocked[0].Username)
+
+	assert.Equal(t, codes.NotFound, status.Code(service.UnblockUser(ctx, id(alice), id(bob))), "only the blocker can unblock")
+	require.NoError(t, service.UnblockUser(ctx, id(bob), id(alice)))
+	_, err = service.SendFriendRequest(ctx, id(alice), "bob")
+	assert.NoError(t, err)
+}
+
+func TestReports(t *testing.T) {
+	service, _, _, alice, bob := newTestService()
+	ctx := context.Background()
+	befriend(t, service, alice, bob)
+	_, _, err := service.SendDirectMessage(ctx, id(bob), id(alice), "rude message")
+	require.NoError(t, err)
+
+	_, err = service.ReportPlayer(ctx, id(alice), id(bob), socialV1.ReportReason_REPORT_REASON_UNSPECIFIED, "")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	reportID, err := service.ReportPlayer(ctx, id(alice), id(bob), socialV1.ReportReason_REPORT_REASON_HARASSMENT, "keeps messaging me")
+	require.NoError(t, err)
+
+	open, err := service.ListReports(ctx, socialV1.ReportStatus_REPORT_STATUS_UNSPECIFIED, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, open, 1)
+	assert.Equal(t, socialV1.ReportReason_REPORT_REASON_HARASSMENT, open[0].Reason)
+	assert.Contains(t, open[0].ContextJson, "rude message", "the snapshot keeps recent messages")
+
+	moderator := "00000000-0000-0000-0000-0000000000ff"
+	resolved, err := service.ResolveReport(ctx, reportID, moderator, "warned")
+	require.NoError(t, err)
+	assert.Equal(t, socialV1.ReportStatus_REPORT_STATUS_RESOLVED, resolved.Status)
+	assert.Equal(t, moderator, resolved.ResolvedBy)
+
+	_, err = service.ResolveReport(ctx, reportID, moderator, "again")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = service.ResolveReport(ctx, 99, moderator, "warned")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	open, err = service.ListReports(ctx, socialV1.ReportStatus_REPORT_STATUS_OPEN, 0, 0)
+	require.NoError(t, err)
+	assert.Empty(t, open)
+}
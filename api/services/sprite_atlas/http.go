@@ -0,0 +1,91 @@
+package sprite_atlas
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// AtlasPath is the HTTP path of the atlas metadata, the GetSpriteAtlas response as JSON
+const AtlasPath = "/sprites/atlas.json"
+
+// Handler serves the atlas metadata and sheet images over HTTP. Both carry the atlas
+// checksum as ETag so clients revalidate cheaply; images are only served when the atlas
+// was loaded from a directory.
+func (s *Service) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+AtlasPath, s.serveAtlas)
+	mux.HandleFunc("GET "+SheetPathPrefix+"{image}", s.serveSheet)
+	return mux
+}
+
+func (s *Service) serveAtlas(w http.ResponseWriter, r *http.Request) {
+	resp := s.GetSpriteAtlas("")
+	if !setETag(w, r, resp.Checksum) {
+		return
+	}
+	body, err := protojson.Marshal(resp)
+	if err != nil {
+		s.logger.Error("Failed to encode sprite atlas", "error", err)
+		http.Error(w, "failed to encode sprite atlas", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(body)
+}
+
+func (s *Service) serveSheet(w http.ResponseWriter, r *http.Request) {
+	image := r.PathValue("image")
+
+	s.mu.RLock()
+	dir, checksum := s.dir, s.info.Checksum
+	known := false
+	for _, sheet := range s.atlas.Sheets {
+		if sheet.Image == image {
+			known = true
+			break
+		}
+	}
+	s.mu.RUnlock()
+
+	// Only images the atlas names are served, never other files in its directory
+	if dir == "" || !known {
+		http.NotFound(w, r)
+		return
+	}
+	file, err := os.Open(filepath.Join(dir, image))
+	if err != nil {
+		s.logger.Error("Failed to open sprite sheet", "image", image, "error", err)
+		http.NotFound(w, r)
+		return
+	}
+	defer file.Close()
+	stat, err := file.Stat()
+	if err != nil {
+		http.Error(w, "failed to read sprite sheet", http.StatusInternalServerError)
+		return
+	}
+
+	// ServeContent answers If-None-Match from the ETag and sets the content type
+	w.Header().Set("ETag", `"`+checksum+`"`)
+	w.Header().Set("Cache-Control", "public, no-cache")
+	http.ServeContent(w, r, image, stat.ModTime(), file)
+}
+
+// setETag sets the checksum as ETag and answers 304 when the client has it, reporting
+// whether the body should still be written
+func setETag(w http.ResponseWriter, r *http.Request, checksum string) bool {
+	etag := `"` + checksum + `"`
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "public, no-cache")
+	for _, match := range strings.Split(r.Header.Get("If-None-Match"), ",") {
+		if strings.TrimSpace(match) == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return false
+		}
+	}
+	return true
+}
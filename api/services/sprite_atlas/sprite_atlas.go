@@ -0,0 +1,301 @@
+// Package sprite_atlas describes where each sprite the server names is drawn from, so
+// clients look sprites up instead of bundling their own mapping that drifts from the
+// server's resource types.
+//
+// The atlas is a YAML manifest of sheets (images) and sprites (a frame rectangle in a
+// sheet). It is served as metadata through ResourceNodeService/GetSpriteAtlas and, when
+// it was loaded from a directory holding the sheet images, the images over HTTP.
+package sprite_atlas
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/VoidMesh/api/api/config/atlas"
+	"github.com/VoidMesh/api/api/internal/logging"
+	resourceNodeV1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
+	"github.com/charmbracelet/log"
+	"gopkg.in/yaml.v3"
+)
+
+// ManifestFile is the name of the atlas manifest in an atlas directory
+const ManifestFile = "atlas.yaml"
+
+// DefaultSource identifies the embedded atlas in logs
+const DefaultSource = "embedded:config/atlas/sprites.yaml"
+
+// SheetPathPrefix is the HTTP path sheet images are served under
+const SheetPathPrefix = "/sprites/sheets/"
+
+// Atlas is a parsed sprite atlas manifest
+type Atlas struct {
+	Version int            `yaml:"version"`
+	Sheets  []SheetConfig  `yaml:"sheets"`
+	Sprites []SpriteConfig `yaml:"sprites"`
+}
+
+// SheetConfig describes one sheet image
+type SheetConfig struct {
+	Name   string `yaml:"name"`
+	Image  string `yaml:"image"` // File name, relative to the atlas directory
+	Width  int32  `yaml:"width"`
+	Height int32  `yaml:"height"`
+}
+
+// SpriteConfig places one sprite in a sheet
+type SpriteConfig struct {
+	Name            string `yaml:"name"`
+	Sheet           string `yaml:"sheet"`
+	X               int32  `yaml:"x"`
+	Y               int32  `yaml:"y"`
+	Width           int32  `yaml:"width"`
+	Height          int32  `yaml:"height"`
+	Frames          int32  `yaml:"frames"` // Defaults to 1
+	FrameDurationMs int32  `yaml:"frame_duration_ms"`
+}
+
+// Info describes the atlas currently served
+type Info struct {
+	Version      int
+	Checksum     string
+	Source       string
+	SpriteCount  int
+	ServesImages bool
+}
+
+// ParseAtlas decodes and validates an atlas manifest. Unknown fields are rejected so
+// typos fail loudly instead of being ignored.
+func ParseAtlas(data []byte) (*Atlas, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	var a Atlas
+	if err := decoder.Decode(&a); err != nil {
+		return nil, fmt.Errorf("failed to decode sprite atlas: %w", err)
+	}
+	for i := range a.Sprites {
+		if a.Sprites[i].Frames == 0 {
+			a.Sprites[i].Frames = 1
+		}
+	}
+
+	if err := a.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid sprite atlas: %w", err)
+	}
+	return &a, nil
+}
+
+// Validate checks that every sprite lies within a declared sheet
+func (a *Atlas) Validate() error {
+	var errs []error
+
+	if a.Version <= 0 {
+		errs = append(errs, fmt.Errorf("version must be positive, got %d", a.Version))
+	}
+
+	sheets := make(map[string]SheetConfig, len(a.Sheets))
+	for i, sheet := range a.Sheets {
+		prefix := fmt.Sprintf("sheets[%d]", i)
+		if sheet.Name == "" {
+			errs = append(errs, fmt.Errorf("%s is missing a name", prefix))
+		} else if _, ok := sheets[sheet.Name]; ok {
+			errs = append(errs, fmt.Errorf("%s has duplicate name %q", prefix, sheet.Name))
+		}
+		if sheet.Image == "" || filepath.Base(sheet.Image) != sheet.Image {
+			errs = append(errs, fmt.Errorf("%s image must be a file name, got %q", prefix, sheet.Image))
+		}
+		if sheet.Width <= 0 || sheet.Height <= 0 {
+			errs = append(errs, fmt.Errorf("%s must have a positive size, got %dx%d", prefix, sheet.Width, sheet.Height))
+		}
+		sheets[sheet.Name] = sheet
+	}
+
+	seen := make(map[string]bool, len(a.Sprites))
+	for i, sprite := range a.Sprites {
+		prefix := fmt.Sprintf("sprites[%d]", i)
+		if sprite.Name == "" {
+			errs = append(errs, fmt.Errorf("%s is missing a name", prefix))
+		} else if seen[sprite.Name] {
+			errs = append(errs, fmt.Errorf("%s has duplicate name %q", prefix, sprite.Name))
+		}
+		seen[sprite.Name] = true
+
+		if sprite.Width <= 0 || sprite.Height <= 0 || sprite.X < 0 || sprite.Y < 0 || sprite.Frames < 1 {
+			errs = append(errs, fmt.Errorf("%s (%s) must have a positive size and frame count and a non-negative offset", prefix, sprite.Name))
+			continue
+		}
+		if sprite.Frames > 1 && sprite.FrameDurationMs <= 0 {
+			errs = append(errs, fmt.Errorf("%s (%s) is animated but has no frame_duration_ms", prefix, sprite.Name))
+		}
+		sheet, ok := sheets[sprite.Sheet]
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s (%s) has unknown sheet %q", prefix, sprite.Name, sprite.Sheet))
+			continue
+		}
+		if sprite.X+sprite.Width*sprite.Frames > sheet.Width || sprite.Y+sprite.Height > sheet.Height {
+			errs = append(errs, fmt.Errorf("%s (%s) extends past sheet %q", prefix, sprite.Name, sheet.Name))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Missing returns the names that have no sprite in the atlas
+func (a *Atlas) Missing(names []string) []string {
+	sprites := make(map[string]bool, len(a.Sprites))
+	for _, sprite := range a.Sprites {
+		sprites[sprite.Name] = true
+	}
+	var missing []string
+	for _, name := range names {
+		if name != "" && !sprites[name] {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
+
+// Service serves the sprite atlas. It is safe for concurrent use.
+type Service struct {
+	logger  *log.Logger
+	baseURL string
+
+	mu       sync.RWMutex
+	atlas    *Atlas
+	info     Info
+	dir      string // Directory sheet images are read from; empty when none are served
+	response *resourceNodeV1.GetSpriteAtlasResponse
+}
+
+// NewService creates a service serving the embedded atlas. baseURL is prefixed to sheet
+// image paths in responses, e.g. "https://assets.example.com"; empty leaves them relative.
+func NewService(baseURL string) (*Service, error) {
+	s := &Service{
+		logger:  logging.WithComponent("sprite-atlas"),
+		baseURL: baseURL,
+	}
+	if _, err := s.Load(""); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Load reads the atlas manifest and sheet images from dir and serves them. An empty dir
+// selects the embedded atlas, which has no images. The checksum covers the images too,
+// so redrawing a sheet changes it.
+func (s *Service) Load(dir string) (*Info, error) {
+	data := atlas.Sprites
+	source := DefaultSource
+	if dir != "" {
+		source = filepath.Join(dir, ManifestFile)
+		var err error
+		data, err = os.ReadFile(source)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read sprite atlas %s: %w", source, err)
+		}
+	}
+
+	a, err := ParseAtlas(data)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.New()
+	hash.Write(data)
+	if dir != "" {
+		for _, sheet := range a.Sheets {
+			image, err := os.ReadFile(filepath.Join(dir, sheet.Image))
+			if err != nil {
+				return nil, fmt.Errorf("failed to read sprite sheet %s: %w", sheet.Name, err)
+			}
+			hash.Write(image)
+		}
+	}
+
+	info := Info{
+		Version:      a.Version,
+		Checksum:     hex.EncodeToString(hash.Sum(nil)),
+		Source:       source,
+		SpriteCount:  len(a.Sprites),
+		ServesImages: dir != "",
+	}
+
+	s.mu.Lock()
+	s.atlas = a
+	s.info = info
+	s.dir = dir
+	s.response = s.buildResponse(a, info)
+	s.mu.Unlock()
+
+	s.logger.Info("Sprite atlas loaded", "version", info.Version, "checksum", info.Checksum, "source", info.Source, "sprites", info.SpriteCount)
+	return &info, nil
+}
+
+// Info returns details about the atlas in effect
+func (s *Service) Info() Info {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.info
+}
+
+// Missing returns the names that have no sprite in the atlas in effect
+func (s *Service) Missing(names []string) []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.atlas.Missing(names)
+}
+
+// GetSpriteAtlas returns the atlas metadata, or only its version when knownChecksum is
+// the current checksum
+func (s *Service) GetSpriteAtlas(knownChecksum string) *resourceNodeV1.GetSpriteAtlasResponse {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if knownChecksum != "" && knownChecksum == s.info.Checksum {
+		return &resourceNodeV1.GetSpriteAtlasResponse{
+			Version:     int32(s.info.Version),
+			Checksum:    s.info.Checksum,
+			NotModified: true,
+		}
+	}
+	return s.response
+}
+
+// buildResponse converts the atlas to its RPC response, shared by every request
+func (s *Service) buildResponse(a *Atlas, info Info) *resourceNodeV1.GetSpriteAtlasResponse {
+	resp := &resourceNodeV1.GetSpriteAtlasResponse{
+		Version:  int32(info.Version),
+		Checksum: info.Checksum,
+		Sheets:   make([]*resourceNodeV1.SpriteSheet, len(a.Sheets)),
+		Sprites:  make([]*resourceNodeV1.SpriteFrame, len(a.Sprites)),
+	}
+	for i, sheet := range a.Sheets {
+		resp.Sheets[i] = &resourceNodeV1.SpriteSheet{
+			Name:   sheet.Name,
+			Image:  sheet.Image,
+			Width:  sheet.Width,
+			Height: sheet.Height,
+		}
+		if info.ServesImages {
+			resp.Sheets[i].Url = s.baseURL + SheetPathPrefix + sheet.Image
+		}
+	}
+	for i, sprite := range a.Sprites {
+		resp.Sprites[i] = &resourceNodeV1.SpriteFrame{
+			Name:            sprite.Name,
+			Sheet:           sprite.Sheet,
+			X:               sprite.X,
+			Y:               sprite.Y,
+			Width:           sprite.Width,
+			Height:          sprite.Height,
+			Frames:          sprite.Frames,
+			FrameDurationMs: sprite.FrameDurationMs,
+		}
+	}
+	return resp
+}
@@ -0,0 +1,148 @@
+package sprite_atlas
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/VoidMesh/api/api/config/atlas"
+	"github.com/VoidMesh/api/api/services/resource_node"
+)
+
+const testAtlas = `
+version: 3
+sheets:
+  - name: nodes
+    image: nodes.png
+    width: 64
+    height: 32
+sprites:
+  - name: herb_patch
+    sheet: nodes
+    x: 0
+    y: 0
+    width: 32
+    height: 32
+  - name: fishing_spot
+    sheet: nodes
+    x: 32
+    y: 0
+    width: 16
+    height: 16
+    frames: 2
+    frame_duration_ms: 100
+`
+
+func writeAtlasDir(t *testing.T, image string) string {
+	t.Helper()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ManifestFile), []byte(testAtlas), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "nodes.png"), []byte(image), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "secret.txt"), []byte("not a sheet"), 0o644))
+	return dir
+}
+
+func TestEmbeddedAtlasCoversResourceTypes(t *testing.T) {
+	a, err := ParseAtlas(atlas.Sprites)
+	require.NoError(t, err)
+
+	cfg, _, err := resource_node.LoadBalanceConfigFile("")
+	require.NoError(t, err)
+	var sprites []string
+	for _, rt := range cfg.ResourceTypes {
+		sprites = append(sprites, rt.Sprite)
+	}
+	assert.Empty(t, a.Missing(sprites), "every embedded resource type has a sprite")
+}
+
+func TestParseAtlas_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{"unknown field", "version: 1\nsheets: []\nsprites: []\ncolour: red\n", "field colour not found"},
+		{"unknown sheet", "version: 1\nsprites:\n  - {name: a, sheet: b, width: 1, height: 1}\n", `unknown sheet "b"`},
+		{"past the sheet", "version: 1\nsheets:\n  - {name: s, image: s.png, width: 32, height: 32}\nsprites:\n  - {name: a, sheet: s, x: 16, width: 16, height: 16, frames: 2, frame_duration_ms: 50}\n", "extends past sheet"},
+		{"animated without duration", "version: 1\nsheets:\n  - {name: s, image: s.png, width: 64, height: 32}\nsprites:\n  - {name: a, sheet: s, width: 16, height: 16, frames: 2}\n", "frame_duration_ms"},
+		{"image path", "version: 1\nsheets:\n  - {name: s, image: ../s.png, width: 32, height: 32}\n", "must be a file name"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseAtlas([]byte(tt.data))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestService_Load(t *testing.T) {
+	service, err := NewService("https://assets.example.com")
+	require.NoError(t, err)
+	embedded := service.GetSpriteAtlas("")
+	assert.Empty(t, embedded.Sheets[0].Url, "the embedded atlas has no images to link")
+
+	dir := writeAtlasDir(t, "first")
+	info, err := service.Load(dir)
+	require.NoError(t, err)
+	assert.Equal(t, 3, info.Version)
+	assert.True(t, info.ServesImages)
+
+	resp := service.GetSpriteAtlas(embedded.Checksum)
+	assert.False(t, resp.NotModified)
+	assert.Equal(t, "https://assets.example.com/sprites/sheets/nodes.png", resp.Sheets[0].Url)
+	require.Len(t, resp.Sprites, 2)
+	assert.Equal(t, int32(1), resp.Sprites[0].Frames, "frames default to 1")
+	assert.Equal(t, int32(2), resp.Sprites[1].Frames)
+
+	unchanged := service.GetSpriteAtlas(resp.Checksum)
+	assert.True(t, unchanged.NotModified)
+	assert.Empty(t, unchanged.Sprites)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "nodes.png"), []byte("second"), 0o644))
+	reloaded, err := service.Load(dir)
+	require.NoError(t, err)
+	assert.NotEqual(t, info.Checksum, reloaded.Checksum, "changing a sheet image changes the checksum")
+
+	_, err = service.Load(t.TempDir())
+	assert.Error(t, err)
+	assert.Equal(t, reloaded.Checksum, service.Info().Checksum, "a failed load keeps the atlas in effect")
+}
+
+func TestService_Handler(t *testing.T) {
+	service, err := NewService("")
+	require.NoError(t, err)
+	handler := service.Handler()
+	get := func(path, etag string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	assert.Equal(t, http.StatusNotFound, get("/sprites/sheets/resource_nodes.png", "").Code, "the embedded atlas serves no images")
+
+	_, err = service.Load(writeAtlasDir(t, "pixels"))
+	require.NoError(t, err)
+
+	rec := get(AtlasPath, "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"fishing_spot"`)
+	etag := rec.Header().Get("ETag")
+	assert.Equal(t, http.StatusNotModified, get(AtlasPath, etag).Code)
+
+	rec = get("/sprites/sheets/nodes.png", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "pixels", rec.Body.String())
+	assert.Equal(t, http.StatusNotModified, get("/sprites/sheets/nodes.png", etag).Code)
+
+	assert.Equal(t, http.StatusNotFound, get("/sprites/sheets/secret.txt", "").Code, "files the atlas doesn't name are not served")
+}
@@ -0,0 +1,90 @@
+package static_data
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/storage"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	resourceNodeV1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
+	terrainV1 "github.com/VoidMesh/api/api/proto/terrain/v1"
+	"github.com/charmbracelet/log"
+)
+
+// DatabaseInterface abstracts the reference data read from the database.
+type DatabaseInterface interface {
+	GetAllItems(ctx context.Context) ([]db.Item, error)
+	GetAllResourceNodeDrops(ctx context.Context) ([]db.GetAllResourceNodeDropsRow, error)
+}
+
+// DatabaseWrapper implements DatabaseInterface using the actual database connection.
+type DatabaseWrapper struct {
+	queries *db.Queries
+}
+
+// NewDatabaseWrapper creates a new database wrapper with the given connection pool.
+func NewDatabaseWrapper(pool storage.Pool) DatabaseInterface {
+	return &DatabaseWrapper{queries: db.New(pool)}
+}
+
+func (d *DatabaseWrapper) GetAllItems(ctx context.Context) ([]db.Item, error) {
+	return d.queries.GetAllItems(ctx)
+}
+
+func (d *DatabaseWrapper) GetAllResourceNodeDrops(ctx context.Context) ([]db.GetAllResourceNodeDropsRow, error) {
+	return d.queries.GetAllResourceNodeDrops(ctx)
+}
+
+// ResourceTypeSource lists the resource node types in effect
+type ResourceTypeSource interface {
+	GetResourceNodeTypes(ctx context.Context) ([]*resourceNodeV1.ResourceNodeType, error)
+}
+
+// TerrainSource lists the terrain types
+type TerrainSource interface {
+	GetTerrainTypes(ctx context.Context) ([]*terrainV1.TerrainTypeInfo, error)
+}
+
+// RegionSource lists the protected regions of a world
+type RegionSource interface {
+	ListRegions(ctx context.Context, worldID string) ([]*chunkV1.ProtectedRegion, error)
+}
+
+// LoggerInterface abstracts logging operations for dependency injection.
+type LoggerInterface interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+	With(keysAndValues ...interface{}) LoggerInterface
+}
+
+// DefaultLoggerWrapper wraps the internal logging package.
+type DefaultLoggerWrapper struct {
+	logger *log.Logger
+}
+
+func NewDefaultLoggerWrapper() LoggerInterface {
+	return &DefaultLoggerWrapper{logger: logging.GetLogger()}
+}
+
+func (l *DefaultLoggerWrapper) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.Debug(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Info(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.Warn(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.Error(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) With(keysAndValues ...interface{}) LoggerInterface {
+	return &DefaultLoggerWrapper{logger: l.logger.With(keysAndValues...)}
+}
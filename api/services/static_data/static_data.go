@@ -0,0 +1,222 @@
+// Package static_data bundles the reference data clients need (items, resource types and
+// their drops, terrain types and protected regions) into one response per world, versioned
+// by a hash of its contents. Clients keep the bundle and call CheckVersion, downloading it
+// again only when the hash changes.
+package static_data
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/debugstats"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	staticDataV1 "github.com/VoidMesh/api/api/proto/static_data/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DefaultMaxAge is how long a built bundle is served before it is rebuilt, and so how long
+// a change to the reference data takes to reach clients
+const DefaultMaxAge = time.Minute
+
+// Service builds and caches the bundles. It is safe for concurrent use.
+type Service struct {
+	db            DatabaseInterface
+	resourceTypes ResourceTypeSource
+	terrain       TerrainSource
+	regions       RegionSource
+	logger        LoggerInterface
+	clock         clock.Clock
+	maxAge        time.Duration
+
+	buildMu sync.Mutex // Held while building, so concurrent misses build once
+	mu      sync.RWMutex
+	bundles map[string]*builtBundle // By world ID without dashes
+}
+
+type builtBundle struct {
+	hash    string
+	bundle  *staticDataV1.StaticDataBundle
+	builtAt time.Time
+}
+
+// NewService creates a new static data service with dependency injection.
+func NewService(database DatabaseInterface, resourceTypes ResourceTypeSource, terrain TerrainSource, regions RegionSource, logger LoggerInterface) *Service {
+	componentLogger := logger.With("component", "static-data-service")
+	componentLogger.Debug("Creating new static data service")
+	s := &Service{
+		db:            database,
+		resourceTypes: resourceTypes,
+		terrain:       terrain,
+		regions:       regions,
+		logger:        componentLogger,
+		clock:         clock.New(),
+		maxAge:        DefaultMaxAge,
+		bundles:       make(map[string]*builtBundle),
+	}
+	debugstats.Register(debugstats.CacheEntries, "static_data.bundles", func() int64 {
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+		return int64(len(s.bundles))
+	})
+	return s
+}
+
+// SetClock replaces the clock used for bundle ages (for testing)
+func (s *Service) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// GetStaticDataBundle returns the world's bundle, or only its hash when knownHash is current.
+// The world defaults to the caller's session world.
+func (s *Service) GetStaticDataBundle(ctx context.Context, worldID, knownHash string) (*staticDataV1.GetStaticDataBundleResponse, error) {
+	built, err := s.bundle(ctx, worldID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &staticDataV1.GetStaticDataBundleResponse{
+		ContentHash: built.hash,
+		BuiltAt:     timestamppb.New(built.builtAt),
+	}
+	if knownHash != "" && knownHash == built.hash {
+		resp.NotModified = true
+		return resp, nil
+	}
+	resp.Bundle = built.bundle
+	return resp, nil
+}
+
+// CheckVersion returns the content hash of the world's bundle
+func (s *Service) CheckVersion(ctx context.Context, worldID string) (string, error) {
+	built, err := s.bundle(ctx, worldID)
+	if err != nil {
+		return "", err
+	}
+	return built.hash, nil
+}
+
+// bundle returns the world's cached bundle, building it when missing or older than maxAge
+func (s *Service) bundle(ctx context.Context, worldID string) (*builtBundle, error) {
+	if worldID == "" {
+		sessionWorldID, ok := session.WorldIDFromContext(ctx)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "world_id is required")
+		}
+		worldID = sessionWorldID
+	}
+	id, err := uuid.StringToPgtype(worldID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid world ID format")
+	}
+	key := uuid.PgtypeToNormalizedString(id)
+
+	if built := s.cached(key); built != nil {
+		return built, nil
+	}
+
+	s.buildMu.Lock()
+	defer s.buildMu.Unlock()
+	if built := s.cached(key); built != nil {
+		return built, nil
+	}
+
+	bundle, err := s.build(ctx, uuid.PgtypeToString(id))
+	if err != nil {
+		s.logger.Error("Failed to build static data bundle", "world_id", key, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to build static data bundle")
+	}
+	encoded, err := proto.MarshalOptions{Deterministic: true}.Marshal(bundle)
+	if err != nil {
+		s.logger.Error("Failed to encode static data bundle", "world_id", key, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to build static data bundle")
+	}
+	sum := sha256.Sum256(encoded)
+	built := &builtBundle{hash: hex.EncodeToString(sum[:]), bundle: bundle, builtAt: s.clock.Now()}
+
+	s.mu.Lock()
+	previous := s.bundles[key]
+	s.bundles[key] = built
+	s.mu.Unlock()
+
+	if previous == nil || previous.hash != built.hash {
+		s.logger.Info("Static data bundle built", "world_id", key, "content_hash", built.hash, "bytes", len(encoded))
+	}
+	return built, nil
+}
+
+// cached returns the world's bundle if it is younger than maxAge
+func (s *Service) cached(key string) *builtBundle {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	built, ok := s.bundles[key]
+	if !ok || s.clock.Since(built.builtAt) >= s.maxAge {
+		return nil
+	}
+	return built
+}
+
+// build reads every part of the bundle. Parts are sorted by their sources, so unchanged
+// data encodes to the same bytes and hash.
+func (s *Service) build(ctx context.Context, worldID string) (*staticDataV1.StaticDataBundle, error) {
+	items, err := s.db.GetAllItems(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list items: %w", err)
+	}
+	drops, err := s.db.GetAllResourceNodeDrops(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list resource node drops: %w", err)
+	}
+	resourceTypes, err := s.resourceTypes.GetResourceNodeTypes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list resource node types: %w", err)
+	}
+	terrainTypes, err := s.terrain.GetTerrainTypes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list terrain types: %w", err)
+	}
+	regions, err := s.regions.ListRegions(ctx, worldID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list protected regions: %w", err)
+	}
+
+	bundle := &staticDataV1.StaticDataBundle{
+		Items:             make([]*staticDataV1.Item, len(items)),
+		ResourceNodeTypes: resourceTypes,
+		ResourceDrops:     make([]*staticDataV1.ResourceDrop, len(drops)),
+		TerrainTypes:      terrainTypes,
+		Regions:           regions,
+	}
+	for i, item := range items {
+		bundle.Items[i] = &staticDataV1.Item{
+			Id:          item.ID,
+			Name:        item.Name,
+			Description: item.Description,
+			ItemType:    item.ItemType,
+			Rarity:      item.Rarity,
+			StackSize:   item.StackSize,
+			VisualData:  item.VisualData,
+		}
+	}
+	for i, drop := range drops {
+		chance, err := drop.Chance.Float64Value()
+		if err != nil {
+			return nil, fmt.Errorf("invalid chance of drop %d: %w", drop.ID, err)
+		}
+		bundle.ResourceDrops[i] = &staticDataV1.ResourceDrop{
+			ResourceNodeTypeId: drop.ResourceNodeTypeID,
+			ItemId:             drop.ItemID,
+			Chance:             float32(chance.Float64),
+			MinQuantity:        drop.MinQuantity,
+			MaxQuantity:        drop.MaxQuantity,
+		}
+	}
+	return bundle, nil
+}
@@ -0,0 +1,133 @@
+package static_data
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	resourceNodeV1 "github.com/VoidMesh/api/api/proto/resource_node/v1"
+	terrainV1 "github.com/VoidMesh/api/api/proto/terrain/v1"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeSources serves every part of the bundle from memory and counts item reads
+type fakeSources struct {
+	items       []db.Item
+	itemReads   int
+	regions     map[string][]*chunkV1.ProtectedRegion
+	regionWorld string
+}
+
+func (f *fakeSources) GetAllItems(ctx context.Context) ([]db.Item, error) {
+	f.itemReads++
+	return f.items, nil
+}
+
+func (f *fakeSources) GetAllResourceNodeDrops(ctx context.Context) ([]db.GetAllResourceNodeDropsRow, error) {
+	return []db.GetAllResourceNodeDropsRow{{
+		ID:                 1,
+		ResourceNodeTypeID: 1,
+		ItemID:             1,
+		Chance:             pgtype.Numeric{Int: big.NewInt(250), Exp: -3, Valid: true},
+		MinQuantity:        1,
+		MaxQuantity:        3,
+	}}, nil
+}
+
+func (f *fakeSources) GetResourceNodeTypes(ctx context.Context) ([]*resourceNodeV1.ResourceNodeType, error) {
+	return []*resourceNodeV1.ResourceNodeType{{Id: 1, Name: "Herb Patch"}}, nil
+}
+
+func (f *fakeSources) GetTerrainTypes(ctx context.Context) ([]*terrainV1.TerrainTypeInfo, error) {
+	return []*terrainV1.TerrainTypeInfo{{Name: "grass"}}, nil
+}
+
+func (f *fakeSources) ListRegions(ctx context.Context, worldID string) ([]*chunkV1.ProtectedRegion, error) {
+	f.regionWorld = worldID
+	return f.regions[worldID], nil
+}
+
+const (
+	worldA = "650e8400-e29b-41d4-a716-446655440000"
+	worldB = "650e8400-e29b-41d4-a716-446655440001"
+)
+
+func newTestService() (*Service, *fakeSources, *clock.Fake) {
+	sources := &fakeSources{
+		items: []db.Item{{ID: 1, Name: "Herb", ItemType: "material", Rarity: "common", StackSize: 50, VisualData: []byte(`{"sprite":"herb"}`)}},
+		regions: map[string][]*chunkV1.ProtectedRegion{
+			worldB: {{Id: 7, Name: "Spawn"}},
+		},
+	}
+	fake := clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	service := NewService(sources, sources, sources, sources, testmocks.NopLogger[LoggerInterface]{})
+	service.SetClock(fake)
+	return service, sources, fake
+}
+
+func TestGetStaticDataBundle(t *testing.T) {
+	service, sources, _ := newTestService()
+	ctx := context.Background()
+
+	resp, err := service.GetStaticDataBundle(ctx, worldA, "")
+	require.NoError(t, err)
+	require.NotNil(t, resp.Bundle)
+	assert.Len(t, resp.ContentHash, 64)
+	assert.Equal(t, "Herb", resp.Bundle.Items[0].Name)
+	assert.Equal(t, "Herb Patch", resp.Bundle.ResourceNodeTypes[0].Name)
+	assert.InDelta(t, 0.25, resp.Bundle.ResourceDrops[0].Chance, 1e-6)
+	assert.Equal(t, "grass", resp.Bundle.TerrainTypes[0].Name)
+	assert.Empty(t, resp.Bundle.Regions)
+
+	unchanged, err := service.GetStaticDataBundle(ctx, worldA, resp.ContentHash)
+	require.NoError(t, err)
+	assert.True(t, unchanged.NotModified)
+	assert.Nil(t, unchanged.Bundle, "a current bundle is not sent again")
+	assert.Equal(t, 1, sources.itemReads, "bundles are served from cache")
+
+	other, err := service.GetStaticDataBundle(ctx, worldB, resp.ContentHash)
+	require.NoError(t, err)
+	assert.False(t, other.NotModified, "each world has its own regions and hash")
+	assert.Len(t, other.Bundle.Regions, 1)
+}
+
+func TestCheckVersion(t *testing.T) {
+	service, sources, fake := newTestService()
+	ctx := session.WithWorldID(context.Background(), worldA)
+
+	hash, err := service.CheckVersion(ctx, "")
+	require.NoError(t, err)
+	assert.Equal(t, worldA, sources.regionWorld, "the world defaults to the session's")
+
+	again, err := service.CheckVersion(ctx, worldA)
+	require.NoError(t, err)
+	assert.Equal(t, hash, again, "unchanged data hashes the same")
+	assert.Equal(t, 1, sources.itemReads)
+
+	sources.items = append(sources.items, db.Item{ID: 2, Name: "Berry"})
+	fake.Advance(DefaultMaxAge)
+	changed, err := service.CheckVersion(ctx, worldA)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, changed, "changes show once the bundle is rebuilt")
+	assert.Equal(t, 2, sources.itemReads)
+}
+
+func TestCheckVersion_InvalidWorld(t *testing.T) {
+	service, _, _ := newTestService()
+
+	_, err := service.CheckVersion(context.Background(), "")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "a world is required without a session")
+
+	_, err = service.CheckVersion(context.Background(), "not-a-uuid")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,88 @@
+package status_effect
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/charmbracelet/log"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DatabaseInterface abstracts database operations for status effects.
+type DatabaseInterface interface {
+	GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error)
+	ListActiveStatusEffects(ctx context.Context, arg db.ListActiveStatusEffectsParams) ([]db.CharacterStatusEffect, error)
+	ApplyStatusEffect(ctx context.Context, arg db.ApplyStatusEffectParams) (db.CharacterStatusEffect, error)
+	RemoveStatusEffect(ctx context.Context, arg db.RemoveStatusEffectParams) (int64, error)
+	DeleteExpiredStatusEffects(ctx context.Context, expiresAt pgtype.Timestamp) (int64, error)
+}
+
+// DatabaseWrapper implements DatabaseInterface using the actual database connection.
+type DatabaseWrapper struct {
+	queries *db.Queries
+}
+
+// NewDatabaseWrapper creates a new database wrapper with the given connection pool.
+func NewDatabaseWrapper(pool storage.Pool) DatabaseInterface {
+	return &DatabaseWrapper{queries: db.New(pool)}
+}
+
+func (d *DatabaseWrapper) GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error) {
+	return d.queries.GetCharacterById(ctx, id)
+}
+
+func (d *DatabaseWrapper) ListActiveStatusEffects(ctx context.Context, arg db.ListActiveStatusEffectsParams) ([]db.CharacterStatusEffect, error) {
+	return d.queries.ListActiveStatusEffects(ctx, arg)
+}
+
+func (d *DatabaseWrapper) ApplyStatusEffect(ctx context.Context, arg db.ApplyStatusEffectParams) (db.CharacterStatusEffect, error) {
+	return d.queries.ApplyStatusEffect(ctx, arg)
+}
+
+func (d *DatabaseWrapper) RemoveStatusEffect(ctx context.Context, arg db.RemoveStatusEffectParams) (int64, error) {
+	return d.queries.RemoveStatusEffect(ctx, arg)
+}
+
+func (d *DatabaseWrapper) DeleteExpiredStatusEffects(ctx context.Context, expiresAt pgtype.Timestamp) (int64, error) {
+	return d.queries.DeleteExpiredStatusEffects(ctx, expiresAt)
+}
+
+// LoggerInterface abstracts logging operations for dependency injection.
+type LoggerInterface interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+	With(keysAndValues ...interface{}) LoggerInterface
+}
+
+// DefaultLoggerWrapper wraps the internal logging package.
+type DefaultLoggerWrapper struct {
+	logger *log.Logger
+}
+
+func NewDefaultLoggerWrapper() LoggerInterface {
+	return &DefaultLoggerWrapper{logger: logging.GetLogger()}
+}
+
+func (l *DefaultLoggerWrapper) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.Debug(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Info(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.Warn(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.Error(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) With(keysAndValues ...interface{}) LoggerInterface {
+	return &DefaultLoggerWrapper{logger: l.logger.With(keysAndValues...)}
+}
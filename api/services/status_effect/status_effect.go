@@ -0,0 +1,317 @@
+// Package status_effect applies time-limited buffs and debuffs to characters. Each effect
+// is a named definition with a type (what it changes), a magnitude, a duration and a rule
+// for applying it again while it is active. Items, quests and events apply effects by
+// name; the services they change ask Multiplier for the combined effect of a type.
+package status_effect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/alerting"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/debugstats"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	adminV1 "github.com/VoidMesh/api/api/proto/admin/v1"
+	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// DefaultCacheTTL is how long a character's effects are served from memory, so effects
+	// applied by another instance take at most this long to take hold here
+	DefaultCacheTTL = 5 * time.Second
+	// DefaultExpiryInterval is how often expired effects are deleted
+	DefaultExpiryInterval = time.Minute
+	// MinMultiplier and MaxMultiplier bound the combined effect of a type
+	MinMultiplier = 0.25
+	MaxMultiplier = 4
+)
+
+// Stacking decides what applying an effect does while it is still active
+type Stacking string
+
+const (
+	StackRefresh   Stacking = "refresh"   // Restarts the duration
+	StackExtend    Stacking = "extend"    // Adds the duration to the time remaining
+	StackIntensity Stacking = "intensity" // Adds a stack, up to MaxStacks, and restarts the duration
+)
+
+// Definition describes an effect that can be applied
+type Definition struct {
+	Key       string
+	Type      characterV1.StatusEffectType
+	Magnitude float64 // Per stack, e.g. 0.25 for 25% faster; negative slows
+	Duration  time.Duration
+	Stacking  Stacking
+	MaxStacks int32 // StackIntensity only
+}
+
+// DefaultDefinitions are the effects items, quests and events can apply
+func DefaultDefinitions() []Definition {
+	return []Definition{
+		{Key: "swiftness", Type: characterV1.StatusEffectType_STATUS_EFFECT_TYPE_MOVEMENT_SPEED, Magnitude: 0.25, Duration: 5 * time.Minute, Stacking: StackRefresh},
+		{Key: "slowed", Type: characterV1.StatusEffectType_STATUS_EFFECT_TYPE_MOVEMENT_SPEED, Magnitude: -0.3, Duration: 30 * time.Second, Stacking: StackRefresh},
+		{Key: "forager", Type: characterV1.StatusEffectType_STATUS_EFFECT_TYPE_HARVEST_YIELD, Magnitude: 0.1, Duration: 10 * time.Minute, Stacking: StackIntensity, MaxStacks: 5},
+		{Key: "bountiful", Type: characterV1.StatusEffectType_STATUS_EFFECT_TYPE_HARVEST_YIELD, Magnitude: 0.5, Duration: 15 * time.Minute, Stacking: StackExtend},
+	}
+}
+
+// Service applies status effects and reports their combined effect. It is safe for
+// concurrent use.
+type Service struct {
+	db          DatabaseInterface
+	logger      LoggerInterface
+	clock       clock.Clock
+	definitions map[string]Definition
+	cacheTTL    time.Duration
+	interval    time.Duration
+
+	mu    sync.RWMutex
+	cache map[[16]byte]cachedEffects // By character ID
+}
+
+type cachedEffects struct {
+	rows     []db.CharacterStatusEffect
+	loadedAt time.Time
+}
+
+// NewService creates a new status effect service with dependency injection.
+func NewService(database DatabaseInterface, logger LoggerInterface) *Service {
+	componentLogger := logger.With("component", "status-effect-service")
+	componentLogger.Debug("Creating new status effect service")
+	s := &Service{
+		db:          database,
+		logger:      componentLogger,
+		clock:       clock.New(),
+		definitions: make(map[string]Definition),
+		cacheTTL:    DefaultCacheTTL,
+		interval:    DefaultExpiryInterval,
+		cache:       make(map[[16]byte]cachedEffects),
+	}
+	for _, definition := range DefaultDefinitions() {
+		s.definitions[definition.Key] = definition
+	}
+	debugstats.Register(debugstats.CacheEntries, "status_effect.characters", func() int64 {
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+		return int64(len(s.cache))
+	})
+	return s
+}
+
+// NewServiceWithPool creates a service with concrete implementations (convenience constructor for production use).
+func NewServiceWithPool(pool storage.Pool) *Service {
+	return NewService(NewDatabaseWrapper(pool), NewDefaultLoggerWrapper())
+}
+
+// SetClock replaces the clock used for durations and the cache (for simulation tests)
+func (s *Service) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// SetDefinitions replaces the effects that can be applied
+func (s *Service) SetDefinitions(definitions []Definition) {
+	s.definitions = make(map[string]Definition, len(definitions))
+	for _, definition := range definitions {
+		s.definitions[definition.Key] = definition
+	}
+}
+
+// Apply applies the named effect to a character, stacking it with an active application
+// by the effect's rule. source records what applied it, e.g. "item:12".
+func (s *Service) Apply(ctx context.Context, characterID pgtype.UUID, key, source string) (*characterV1.StatusEffect, error) {
+	definition, ok := s.definitions[key]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown status effect %q", key)
+	}
+	if _, err := s.db.GetCharacterById(ctx, characterID); errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "character not found")
+	} else if err != nil {
+		s.logger.Error("Failed to get character", "character_id", uuid.PgtypeToString(characterID), "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to apply status effect")
+	}
+
+	now := s.clock.Now()
+	row, err := s.db.ApplyStatusEffect(ctx, db.ApplyStatusEffectParams{
+		CharacterID: characterID,
+		EffectKey:   key,
+		EffectType:  int32(definition.Type),
+		Magnitude:   definition.Magnitude,
+		Source:      source,
+		AppliedAt:   pgtype.Timestamp{Time: now, Valid: true},
+		ExpiresAt:   pgtype.Timestamp{Time: now.Add(definition.Duration), Valid: true},
+		Stacking:    string(definition.Stacking),
+		MaxStacks:   max(1, definition.MaxStacks),
+	})
+	if err != nil {
+		s.logger.Error("Failed to apply status effect", "character_id", uuid.PgtypeToString(characterID), "effect", key, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to apply status effect")
+	}
+	s.forget(characterID)
+	s.logger.Info("Status effect applied", "character_id", uuid.PgtypeToString(characterID), "effect", key, "source", source, "stacks", row.Stacks, "expires_at", row.ExpiresAt.Time)
+	return effectToProto(row), nil
+}
+
+// Remove ends the named effect on a character early, reporting whether it had the effect
+func (s *Service) Remove(ctx context.Context, characterID pgtype.UUID, key string) (bool, error) {
+	removed, err := s.db.RemoveStatusEffect(ctx, db.RemoveStatusEffectParams{CharacterID: characterID, EffectKey: key})
+	if err != nil {
+		s.logger.Error("Failed to remove status effect", "character_id", uuid.PgtypeToString(characterID), "effect", key, "error", err)
+		return false, status.Errorf(codes.Internal, "failed to remove status effect")
+	}
+	s.forget(characterID)
+	return removed > 0, nil
+}
+
+// ApplyStatusEffect applies an effect on an admin's request
+func (s *Service) ApplyStatusEffect(ctx context.Context, req *adminV1.ApplyStatusEffectRequest) (*characterV1.StatusEffect, error) {
+	characterID, err := uuid.StringToPgtype(req.CharacterId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid character ID format")
+	}
+	source := req.Source
+	if source == "" {
+		source = "admin"
+	}
+	return s.Apply(ctx, characterID, req.EffectKey, source)
+}
+
+// RemoveStatusEffect removes an effect on an admin's request
+func (s *Service) RemoveStatusEffect(ctx context.Context, req *adminV1.RemoveStatusEffectRequest) (bool, error) {
+	characterID, err := uuid.StringToPgtype(req.CharacterId)
+	if err != nil {
+		return false, status.Errorf(codes.InvalidArgument, "invalid character ID format")
+	}
+	return s.Remove(ctx, characterID, req.EffectKey)
+}
+
+// Active returns the active effects of each character, soonest to expire first.
+// Characters without effects are missing from the map.
+func (s *Service) Active(ctx context.Context, characterIDs []pgtype.UUID) (map[[16]byte][]*characterV1.StatusEffect, error) {
+	effects := make(map[[16]byte][]*characterV1.StatusEffect)
+	if len(characterIDs) == 0 {
+		return effects, nil
+	}
+	rows, err := s.db.ListActiveStatusEffects(ctx, db.ListActiveStatusEffectsParams{
+		CharacterIds: characterIDs,
+		Now:          pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list status effects: %w", err)
+	}
+	for _, row := range rows {
+		effects[row.CharacterID.Bytes] = append(effects[row.CharacterID.Bytes], effectToProto(row))
+	}
+	return effects, nil
+}
+
+// Multiplier returns the combined effect of a type on a character, e.g. 1.25 with one
+// 25% buff. Effects multiply and the result is kept within MinMultiplier and
+// MaxMultiplier. If the effects cannot be loaded the character is unaffected.
+func (s *Service) Multiplier(ctx context.Context, characterID pgtype.UUID, effectType characterV1.StatusEffectType) float64 {
+	rows, err := s.effects(ctx, characterID)
+	if err != nil {
+		s.logger.Error("Failed to load status effects", "character_id", uuid.PgtypeToString(characterID), "error", err)
+		return 1
+	}
+	now := s.clock.Now()
+	multiplier := 1.0
+	for _, row := range rows {
+		if row.EffectType == int32(effectType) && row.ExpiresAt.Time.After(now) {
+			multiplier *= 1 + row.Magnitude*float64(row.Stacks)
+		}
+	}
+	return min(max(multiplier, MinMultiplier), MaxMultiplier)
+}
+
+// effects returns a character's effects from the cache, loading them when missing or stale
+func (s *Service) effects(ctx context.Context, characterID pgtype.UUID) ([]db.CharacterStatusEffect, error) {
+	s.mu.RLock()
+	cached, ok := s.cache[characterID.Bytes]
+	s.mu.RUnlock()
+	if ok && s.clock.Since(cached.loadedAt) < s.cacheTTL {
+		return cached.rows, nil
+	}
+
+	now := s.clock.Now()
+	rows, err := s.db.ListActiveStatusEffects(ctx, db.ListActiveStatusEffectsParams{
+		CharacterIds: []pgtype.UUID{characterID},
+		Now:          pgtype.Timestamp{Time: now, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	s.cache[characterID.Bytes] = cachedEffects{rows: rows, loadedAt: now}
+	s.mu.Unlock()
+	return rows, nil
+}
+
+// forget drops a character's cached effects after they changed
+func (s *Service) forget(characterID pgtype.UUID) {
+	s.mu.Lock()
+	delete(s.cache, characterID.Bytes)
+	s.mu.Unlock()
+}
+
+// Expire deletes expired effects and stale cache entries, returning how many effects
+// were deleted. Expired effects already have no effect; this only keeps the table small.
+func (s *Service) Expire(ctx context.Context) (int64, error) {
+	now := s.clock.Now()
+	s.mu.Lock()
+	for characterID, cached := range s.cache {
+		if now.Sub(cached.loadedAt) >= s.cacheTTL {
+			delete(s.cache, characterID)
+		}
+	}
+	s.mu.Unlock()
+
+	deleted, err := s.db.DeleteExpiredStatusEffects(ctx, pgtype.Timestamp{Time: now, Valid: true})
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired status effects: %w", err)
+	}
+	return deleted, nil
+}
+
+// Run expires effects every interval until ctx is cancelled
+func (s *Service) Run(ctx context.Context) {
+	s.logger.Info("Status effect expiry job started", "interval", s.interval)
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("Status effect expiry job stopped")
+			return
+		case <-s.clock.After(s.interval):
+		}
+
+		deleted, err := s.Expire(ctx)
+		if err != nil {
+			s.logger.Error("Status effect expiry pass failed", "error", err)
+			alerting.ReportJobError("status_effect_expiry", err)
+		}
+		if deleted > 0 {
+			s.logger.Debug("Status effect expiry pass complete", "deleted", deleted)
+		}
+	}
+}
+
+func effectToProto(row db.CharacterStatusEffect) *characterV1.StatusEffect {
+	return &characterV1.StatusEffect{
+		Key:       row.EffectKey,
+		Type:      characterV1.StatusEffectType(row.EffectType),
+		Magnitude: row.Magnitude * float64(row.Stacks),
+		Stacks:    row.Stacks,
+		Source:    row.Source,
+		ExpiresAt: timestamppb.New(row.ExpiresAt.Time),
+	}
+}
@@ -0,0 +1,212 @@
+package status_effect
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	adminV1 "github.com/VoidMesh/api/api/proto/admin/v1"
+	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type effectKey struct {
+	characterID [16]byte
+	key         string
+}
+
+// fakeDB keeps effects in memory, stacking them like the ApplyStatusEffect query
+type fakeDB struct {
+	characters map[[16]byte]bool
+	effects    map[effectKey]db.CharacterStatusEffect
+	listCalls  int
+}
+
+func (f *fakeDB) GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error) {
+	if !f.characters[id.Bytes] {
+		return db.Character{}, pgx.ErrNoRows
+	}
+	return db.Character{ID: id}, nil
+}
+
+func (f *fakeDB) ListActiveStatusEffects(ctx context.Context, arg db.ListActiveStatusEffectsParams) ([]db.CharacterStatusEffect, error) {
+	f.listCalls++
+	var rows []db.CharacterStatusEffect
+	for _, id := range arg.CharacterIds {
+		for k, row := range f.effects {
+			if k.characterID == id.Bytes && row.ExpiresAt.Time.After(arg.Now.Time) {
+				rows = append(rows, row)
+			}
+		}
+	}
+	return rows, nil
+}
+
+func (f *fakeDB) ApplyStatusEffect(ctx context.Context, arg db.ApplyStatusEffectParams) (db.CharacterStatusEffect, error) {
+	k := effectKey{arg.CharacterID.Bytes, arg.EffectKey}
+	row, ok := f.effects[k]
+	next := db.CharacterStatusEffect{
+		CharacterID: arg.CharacterID,
+		EffectKey:   arg.EffectKey,
+		EffectType:  arg.EffectType,
+		Magnitude:   arg.Magnitude,
+		Stacks:      1,
+		Source:      arg.Source,
+		AppliedAt:   arg.AppliedAt,
+		ExpiresAt:   arg.ExpiresAt,
+	}
+	if ok && row.ExpiresAt.Time.After(arg.AppliedAt.Time) {
+		switch arg.Stacking {
+		case "intensity":
+			next.Stacks = min(row.Stacks+1, arg.MaxStacks)
+		case "extend":
+			next.Stacks = row.Stacks
+			next.ExpiresAt.Time = row.ExpiresAt.Time.Add(arg.ExpiresAt.Time.Sub(arg.AppliedAt.Time))
+		default:
+			next.Stacks = row.Stacks
+		}
+	}
+	f.effects[k] = next
+	return next, nil
+}
+
+func (f *fakeDB) RemoveStatusEffect(ctx context.Context, arg db.RemoveStatusEffectParams) (int64, error) {
+	k := effectKey{arg.CharacterID.Bytes, arg.EffectKey}
+	if _, ok := f.effects[k]; !ok {
+		return 0, nil
+	}
+	delete(f.effects, k)
+	return 1, nil
+}
+
+func (f *fakeDB) DeleteExpiredStatusEffects(ctx context.Context, expiresAt pgtype.Timestamp) (int64, error) {
+	var deleted int64
+	for k, row := range f.effects {
+		if !row.ExpiresAt.Time.After(expiresAt.Time) {
+			delete(f.effects, k)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+var characterID = pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+
+func newTestService() (*Service, *fakeDB, *clock.Fake) {
+	database := &fakeDB{
+		characters: map[[16]byte]bool{characterID.Bytes: true},
+		effects:    make(map[effectKey]db.CharacterStatusEffect),
+	}
+	fake := clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	service := NewService(database, testmocks.NopLogger[LoggerInterface]{})
+	service.SetClock(fake)
+	return service, database, fake
+}
+
+func TestApply_Stacking(t *testing.T) {
+	service, _, fake := newTestService()
+	ctx := context.Background()
+	start := fake.Now()
+
+	// Refresh restarts the duration
+	_, err := service.Apply(ctx, characterID, "swiftness", "item:1")
+	require.NoError(t, err)
+	fake.Advance(time.Minute)
+	effect, err := service.Apply(ctx, characterID, "swiftness", "item:1")
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), effect.Stacks)
+	assert.Equal(t, start.Add(6*time.Minute), effect.ExpiresAt.AsTime())
+
+	// Intensity adds stacks up to the maximum
+	for range 7 {
+		effect, err = service.Apply(ctx, characterID, "forager", "quest:gather")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(5), effect.Stacks)
+	assert.InDelta(t, 0.5, effect.Magnitude, 1e-9, "the magnitude covers every stack")
+
+	// Extend adds to the time remaining
+	_, err = service.Apply(ctx, characterID, "bountiful", "event:meteor")
+	require.NoError(t, err)
+	effect, err = service.Apply(ctx, characterID, "bountiful", "event:meteor")
+	require.NoError(t, err)
+	assert.Equal(t, fake.Now().Add(30*time.Minute), effect.ExpiresAt.AsTime())
+
+	// Once expired, an effect starts over
+	fake.Advance(time.Hour)
+	effect, err = service.Apply(ctx, characterID, "forager", "quest:gather")
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), effect.Stacks)
+}
+
+func TestApply_Invalid(t *testing.T) {
+	service, _, _ := newTestService()
+	ctx := context.Background()
+
+	_, err := service.Apply(ctx, characterID, "invisibility", "admin")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = service.Apply(ctx, pgtype.UUID{Bytes: [16]byte{9}, Valid: true}, "swiftness", "admin")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = service.ApplyStatusEffect(ctx, &adminV1.ApplyStatusEffectRequest{CharacterId: "nope", EffectKey: "swiftness"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestMultiplier(t *testing.T) {
+	service, database, fake := newTestService()
+	ctx := context.Background()
+	movement := characterV1.StatusEffectType_STATUS_EFFECT_TYPE_MOVEMENT_SPEED
+	harvest := characterV1.StatusEffectType_STATUS_EFFECT_TYPE_HARVEST_YIELD
+
+	assert.Equal(t, 1.0, service.Multiplier(ctx, characterID, movement))
+
+	effect, err := service.ApplyStatusEffect(ctx, &adminV1.ApplyStatusEffectRequest{CharacterId: "01000000-0000-0000-0000-000000000000", EffectKey: "swiftness"})
+	require.NoError(t, err)
+	assert.Equal(t, "admin", effect.Source)
+	_, err = service.Apply(ctx, characterID, "slowed", "event:storm")
+	require.NoError(t, err)
+
+	assert.InDelta(t, 1.25*0.7, service.Multiplier(ctx, characterID, movement), 1e-9, "effects of a type multiply")
+	assert.Equal(t, 1.0, service.Multiplier(ctx, characterID, harvest))
+	calls := database.listCalls
+	service.Multiplier(ctx, characterID, movement)
+	assert.Equal(t, calls, database.listCalls, "effects are cached")
+
+	fake.Advance(time.Minute)
+	assert.InDelta(t, 1.25, service.Multiplier(ctx, characterID, movement), 1e-9, "expired effects stop applying")
+
+	removed, err := service.Remove(ctx, characterID, "swiftness")
+	require.NoError(t, err)
+	assert.True(t, removed)
+	assert.Equal(t, 1.0, service.Multiplier(ctx, characterID, movement), "removing an effect clears the cache")
+}
+
+func TestActiveAndExpire(t *testing.T) {
+	service, database, fake := newTestService()
+	ctx := context.Background()
+
+	_, err := service.Apply(ctx, characterID, "slowed", "admin")
+	require.NoError(t, err)
+	_, err = service.Apply(ctx, characterID, "swiftness", "admin")
+	require.NoError(t, err)
+
+	active, err := service.Active(ctx, []pgtype.UUID{characterID, {Bytes: [16]byte{2}, Valid: true}})
+	require.NoError(t, err)
+	assert.Len(t, active[characterID.Bytes], 2)
+	assert.Len(t, active, 1, "characters without effects are left out")
+
+	fake.Advance(time.Minute)
+	deleted, err := service.Expire(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+	assert.Len(t, database.effects, 1)
+}
@@ -0,0 +1,78 @@
+package time_scale
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/charmbracelet/log"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DatabaseInterface abstracts database operations for world time scales.
+type DatabaseInterface interface {
+	ListWorldTimeScales(ctx context.Context) ([]db.WorldTimeScale, error)
+	GetWorldTimeScale(ctx context.Context, worldID pgtype.UUID) (db.WorldTimeScale, error)
+	UpsertWorldTimeScale(ctx context.Context, arg db.UpsertWorldTimeScaleParams) (db.WorldTimeScale, error)
+}
+
+// DatabaseWrapper implements DatabaseInterface using the actual database connection.
+type DatabaseWrapper struct {
+	queries *db.Queries
+}
+
+// NewDatabaseWrapper creates a new database wrapper with the given connection pool.
+func NewDatabaseWrapper(pool storage.Pool) DatabaseInterface {
+	return &DatabaseWrapper{queries: db.New(pool)}
+}
+
+func (d *DatabaseWrapper) ListWorldTimeScales(ctx context.Context) ([]db.WorldTimeScale, error) {
+	return d.queries.ListWorldTimeScales(ctx)
+}
+
+func (d *DatabaseWrapper) GetWorldTimeScale(ctx context.Context, worldID pgtype.UUID) (db.WorldTimeScale, error) {
+	return d.queries.GetWorldTimeScale(ctx, worldID)
+}
+
+func (d *DatabaseWrapper) UpsertWorldTimeScale(ctx context.Context, arg db.UpsertWorldTimeScaleParams) (db.WorldTimeScale, error) {
+	return d.queries.UpsertWorldTimeScale(ctx, arg)
+}
+
+// LoggerInterface abstracts logging operations for dependency injection.
+type LoggerInterface interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+	With(keysAndValues ...interface{}) LoggerInterface
+}
+
+// DefaultLoggerWrapper wraps the internal logging package.
+type DefaultLoggerWrapper struct {
+	logger *log.Logger
+}
+
+func NewDefaultLoggerWrapper() LoggerInterface {
+	return &DefaultLoggerWrapper{logger: logging.GetLogger()}
+}
+
+func (l *DefaultLoggerWrapper) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.Debug(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Info(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.Warn(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.Error(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) With(keysAndValues ...interface{}) LoggerInterface {
+	return &DefaultLoggerWrapper{logger: l.logger.With(keysAndValues...)}
+}
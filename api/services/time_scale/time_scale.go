@@ -0,0 +1,198 @@
+// Package time_scale lets admins speed up (or slow down) the time-dependent systems of a
+// world, so QA can check long-running mechanics on staging without waiting. Systems ask
+// Scale for each duration they apply in a world; the multipliers are stored in the
+// database and every instance refreshes its copy periodically.
+package time_scale
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/alerting"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	adminV1 "github.com/VoidMesh/api/api/proto/admin/v1"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// DefaultRefreshInterval is how often the multipliers are reloaded
+	DefaultRefreshInterval = 30 * time.Second
+	MinMultiplier          = 0.1
+	MaxMultiplier          = 1000
+)
+
+// Service stores world time scales and applies them from an in-memory copy.
+type Service struct {
+	db       DatabaseInterface
+	logger   LoggerInterface
+	clock    clock.Clock
+	interval time.Duration
+
+	mu     sync.RWMutex
+	scales map[string]float64 // By world ID without dashes; missing worlds run at 1
+	loaded bool
+}
+
+// NewService creates a new time scale service with dependency injection.
+func NewService(database DatabaseInterface, logger LoggerInterface) *Service {
+	componentLogger := logger.With("component", "time-scale-service")
+	componentLogger.Debug("Creating new time scale service")
+	return &Service{
+		db:       database,
+		logger:   componentLogger,
+		clock:    clock.New(),
+		interval: DefaultRefreshInterval,
+		scales:   make(map[string]float64),
+	}
+}
+
+// NewServiceWithPool creates a service with concrete implementations (convenience constructor for production use).
+func NewServiceWithPool(pool storage.Pool) *Service {
+	return NewService(NewDatabaseWrapper(pool), NewDefaultLoggerWrapper())
+}
+
+// SetClock replaces the clock used for timestamps and the refresh schedule (for simulation tests)
+func (s *Service) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// Multiplier returns how many times faster than normal the world runs. If the multipliers
+// cannot be loaded every world runs at normal speed.
+func (s *Service) Multiplier(ctx context.Context, worldID pgtype.UUID) float64 {
+	s.mu.RLock()
+	loaded := s.loaded
+	s.mu.RUnlock()
+	if !loaded {
+		if err := s.Refresh(ctx); err != nil {
+			s.logger.Error("Failed to load world time scales", "error", err)
+		}
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if scale, ok := s.scales[uuid.PgtypeToNormalizedString(worldID)]; ok {
+		return scale
+	}
+	return 1
+}
+
+// Scale returns how long d lasts in the world: d divided by the world's multiplier
+func (s *Service) Scale(ctx context.Context, worldID pgtype.UUID, d time.Duration) time.Duration {
+	scale := s.Multiplier(ctx, worldID)
+	if scale == 1 {
+		return d
+	}
+	return time.Duration(float64(d) / scale)
+}
+
+// Refresh reloads the multipliers from the database
+func (s *Service) Refresh(ctx context.Context) error {
+	rows, err := s.db.ListWorldTimeScales(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list world time scales: %w", err)
+	}
+	scales := make(map[string]float64, len(rows))
+	for _, row := range rows {
+		scales[uuid.PgtypeToNormalizedString(row.WorldID)] = row.TimeScale
+	}
+	s.mu.Lock()
+	s.scales = scales
+	s.loaded = true
+	s.mu.Unlock()
+	return nil
+}
+
+// Run refreshes the multipliers until the context is cancelled
+func (s *Service) Run(ctx context.Context) {
+	s.logger.Info("World time scale refresh started", "interval", s.interval)
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("World time scale refresh stopped")
+			return
+		case <-s.clock.After(s.interval):
+		}
+
+		if err := s.Refresh(ctx); err != nil {
+			s.logger.Error("World time scale refresh failed", "error", err)
+			alerting.ReportJobError("time_scale_refresh", err)
+		}
+	}
+}
+
+// TimeScale returns the time scale of a world, multiplier 1 for worlds never scaled
+func (s *Service) TimeScale(ctx context.Context, worldID string) (*adminV1.WorldTimeScale, error) {
+	id, err := uuid.StringToPgtype(worldID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid world ID format")
+	}
+	row, err := s.db.GetWorldTimeScale(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		row = db.WorldTimeScale{WorldID: id, TimeScale: 1}
+	} else if err != nil {
+		s.logger.Error("Failed to get world time scale", "world_id", worldID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to get world time scale")
+	}
+	return timeScaleToProto(row), nil
+}
+
+// SetTimeScale changes how fast a world runs. Durations already applied, such as the
+// expiry of a spawned rare event, keep the scale they were started with.
+func (s *Service) SetTimeScale(ctx context.Context, updatedBy string, req *adminV1.SetWorldTimeScaleRequest) (*adminV1.WorldTimeScale, error) {
+	worldID, err := uuid.StringToPgtype(req.WorldId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid world ID format")
+	}
+	multiplier := req.Multiplier
+	if multiplier == 0 {
+		multiplier = 1
+	}
+	if multiplier < MinMultiplier || multiplier > MaxMultiplier {
+		return nil, status.Errorf(codes.InvalidArgument, "multiplier must be between %g and %g", MinMultiplier, float64(MaxMultiplier))
+	}
+	updatedByID, err := uuid.StringToPgtype(updatedBy)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID format")
+	}
+
+	row, err := s.db.UpsertWorldTimeScale(ctx, db.UpsertWorldTimeScaleParams{
+		WorldID:   worldID,
+		TimeScale: multiplier,
+		UpdatedBy: updatedByID,
+		UpdatedAt: pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
+	})
+	if err != nil {
+		s.logger.Error("Failed to set world time scale", "world_id", req.WorldId, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to set world time scale")
+	}
+	// Other instances pick the change up on their next refresh
+	s.mu.Lock()
+	s.scales[uuid.PgtypeToNormalizedString(worldID)] = multiplier
+	s.mu.Unlock()
+	s.logger.Info("World time scale changed", "world_id", req.WorldId, "multiplier", multiplier, "updated_by", updatedBy)
+	return timeScaleToProto(row), nil
+}
+
+func timeScaleToProto(row db.WorldTimeScale) *adminV1.WorldTimeScale {
+	proto := &adminV1.WorldTimeScale{
+		WorldId:    uuid.PgtypeToString(row.WorldID),
+		Multiplier: row.TimeScale,
+	}
+	if row.UpdatedBy.Valid {
+		proto.UpdatedBy = uuid.PgtypeToString(row.UpdatedBy)
+	}
+	if row.UpdatedAt.Valid {
+		proto.UpdatedAt = timestamppb.New(row.UpdatedAt.Time)
+	}
+	return proto
+}
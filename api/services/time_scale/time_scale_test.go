@@ -0,0 +1,93 @@
+package time_scale
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	adminV1 "github.com/VoidMesh/api/api/proto/admin/v1"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeDB keeps time scales in memory
+type fakeDB struct {
+	rows  map[pgtype.UUID]db.WorldTimeScale
+	lists int
+}
+
+func (f *fakeDB) ListWorldTimeScales(ctx context.Context) ([]db.WorldTimeScale, error) {
+	f.lists++
+	var rows []db.WorldTimeScale
+	for _, row := range f.rows {
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func (f *fakeDB) GetWorldTimeScale(ctx context.Context, worldID pgtype.UUID) (db.WorldTimeScale, error) {
+	row, ok := f.rows[worldID]
+	if !ok {
+		return db.WorldTimeScale{}, pgx.ErrNoRows
+	}
+	return row, nil
+}
+
+func (f *fakeDB) UpsertWorldTimeScale(ctx context.Context, arg db.UpsertWorldTimeScaleParams) (db.WorldTimeScale, error) {
+	row := db.WorldTimeScale{WorldID: arg.WorldID, TimeScale: arg.TimeScale, UpdatedBy: arg.UpdatedBy, UpdatedAt: arg.UpdatedAt}
+	f.rows[arg.WorldID] = row
+	return row, nil
+}
+
+const (
+	worldID = "650e8400-e29b-41d4-a716-446655440000"
+	adminID = "11111111-1111-1111-1111-111111111111"
+)
+
+func TestService_Scale(t *testing.T) {
+	world, _ := uuid.StringToPgtype(worldID)
+	other, _ := uuid.StringToPgtype("750e8400-e29b-41d4-a716-446655440000")
+	database := &fakeDB{rows: map[pgtype.UUID]db.WorldTimeScale{world: {WorldID: world, TimeScale: 60}}}
+	service := NewService(database, testmocks.NopLogger[LoggerInterface]{})
+	ctx := context.Background()
+
+	assert.Equal(t, time.Minute, service.Scale(ctx, world, time.Hour), "loaded on first use")
+	assert.Equal(t, time.Hour, service.Scale(ctx, other, time.Hour), "unscaled worlds run at normal speed")
+	assert.Equal(t, 1, database.lists)
+
+	_, err := service.SetTimeScale(ctx, adminID, &adminV1.SetWorldTimeScaleRequest{WorldId: worldID, Multiplier: 0.5})
+	require.NoError(t, err)
+	assert.Equal(t, 2*time.Hour, service.Scale(ctx, world, time.Hour), "changes apply on this instance at once")
+}
+
+func TestService_SetTimeScale(t *testing.T) {
+	service := NewService(&fakeDB{rows: map[pgtype.UUID]db.WorldTimeScale{}}, testmocks.NopLogger[LoggerInterface]{})
+	ctx := context.Background()
+
+	got, err := service.TimeScale(ctx, worldID)
+	require.NoError(t, err)
+	assert.Equal(t, float64(1), got.Multiplier)
+
+	set, err := service.SetTimeScale(ctx, adminID, &adminV1.SetWorldTimeScaleRequest{WorldId: worldID, Multiplier: 100})
+	require.NoError(t, err)
+	assert.Equal(t, float64(100), set.Multiplier)
+	assert.Equal(t, adminID, set.UpdatedBy)
+
+	reset, err := service.SetTimeScale(ctx, adminID, &adminV1.SetWorldTimeScaleRequest{WorldId: worldID})
+	require.NoError(t, err)
+	assert.Equal(t, float64(1), reset.Multiplier, "0 resets to normal speed")
+
+	for _, multiplier := range []float64{-1, 0.01, MaxMultiplier + 1} {
+		_, err := service.SetTimeScale(ctx, adminID, &adminV1.SetWorldTimeScaleRequest{WorldId: worldID, Multiplier: multiplier})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), "multiplier %g", multiplier)
+	}
+	_, err = service.SetTimeScale(ctx, adminID, &adminV1.SetWorldTimeScaleRequest{WorldId: "bad", Multiplier: 2})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,204 @@
+// Package tutorial tracks each character's progress through the starter tutorial. Steps
+// are completed in order by playing: moves are reported by the character service and the
+// rest by domain events, so every client sees the same onboarding state. An event for a
+// step other than the current one is ignored.
+package tutorial
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	tutorialV1 "github.com/VoidMesh/api/api/proto/tutorial/v1"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Steps are the tutorial steps in the order they must be completed
+var Steps = []tutorialV1.TutorialStep{
+	tutorialV1.TutorialStep_TUTORIAL_STEP_MOVE,
+	tutorialV1.TutorialStep_TUTORIAL_STEP_HARVEST,
+	tutorialV1.TutorialStep_TUTORIAL_STEP_CRAFT,
+	tutorialV1.TutorialStep_TUTORIAL_STEP_TRADE,
+}
+
+// stepName is how a step is stored, e.g. "harvest"
+func stepName(step tutorialV1.TutorialStep) string {
+	return strings.ToLower(strings.TrimPrefix(step.String(), "TUTORIAL_STEP_"))
+}
+
+// Service tracks tutorial progress.
+type Service struct {
+	db     DatabaseInterface
+	logger LoggerInterface
+	clock  clock.Clock
+
+	// moved holds the IDs of characters known to be past the move step, so moves
+	// only touch the database until the step is done
+	moved sync.Map
+}
+
+// NewService creates a new tutorial service with dependency injection.
+func NewService(db DatabaseInterface, logger LoggerInterface) *Service {
+	componentLogger := logger.With("component", "tutorial-service")
+	componentLogger.Debug("Creating new tutorial service")
+	return &Service{
+		db:     db,
+		logger: componentLogger,
+		clock:  clock.New(),
+	}
+}
+
+// NewServiceWithPool creates a service with concrete implementations (convenience constructor for production use).
+func NewServiceWithPool(pool storage.Pool) *Service {
+	return NewService(NewDatabaseWrapper(pool), NewDefaultLoggerWrapper())
+}
+
+// SetClock replaces the clock used for completion timestamps (for simulation tests)
+func (s *Service) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// State returns the tutorial progress of one of the user's characters
+func (s *Service) State(ctx context.Context, userID, characterID string) (*tutorialV1.TutorialState, error) {
+	id, err := uuid.StringToPgtype(characterID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid character ID format")
+	}
+	character, err := s.db.GetCharacterById(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "character not found")
+	}
+	if err != nil {
+		s.logger.Error("Failed to get character", "character_id", characterID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to get character")
+	}
+	if !uuid.Compare(uuid.PgtypeToString(character.UserID), userID) {
+		return nil, status.Errorf(codes.PermissionDenied, "character does not belong to user")
+	}
+	if err := session.RequireWorld(ctx, character.WorldID); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.ListTutorialSteps(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to list tutorial steps", "character_id", characterID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to get tutorial state")
+	}
+	return buildState(uuid.PgtypeToString(character.ID), rows), nil
+}
+
+// buildState lists every step with its completion time; the current step is the first
+// one not completed
+func buildState(characterID string, rows []db.TutorialStep) *tutorialV1.TutorialState {
+	completed := make(map[string]pgtype.Timestamp, len(rows))
+	for _, row := range rows {
+		completed[row.Step] = row.CompletedAt
+	}
+
+	state := &tutorialV1.TutorialState{CharacterId: characterID}
+	for _, step := range Steps {
+		stepState := &tutorialV1.TutorialStepState{Step: step}
+		if at, ok := completed[stepName(step)]; ok {
+			stepState.CompletedAt = timestamppb.New(at.Time)
+		} else if state.CurrentStep == tutorialV1.TutorialStep_TUTORIAL_STEP_UNSPECIFIED {
+			state.CurrentStep = step
+		}
+		state.Steps = append(state.Steps, stepState)
+	}
+	state.Completed = state.CurrentStep == tutorialV1.TutorialStep_TUTORIAL_STEP_UNSPECIFIED
+	return state
+}
+
+// Complete marks the step done if it is the character's current step; otherwise it does
+// nothing. Completing a step twice is harmless, so events can be redelivered.
+func (s *Service) Complete(ctx context.Context, characterID pgtype.UUID, step tutorialV1.TutorialStep) error {
+	rows, err := s.db.ListTutorialSteps(ctx, characterID)
+	if err != nil {
+		return fmt.Errorf("failed to list tutorial steps: %w", err)
+	}
+	if current := buildState("", rows).CurrentStep; current != step {
+		return nil
+	}
+
+	err = s.db.CompleteTutorialStep(ctx, db.CompleteTutorialStepParams{
+		CharacterID: characterID,
+		Step:        stepName(step),
+		CompletedAt: pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to complete tutorial step %s: %w", stepName(step), err)
+	}
+	s.logger.Info("Tutorial step completed", "character_id", uuid.PgtypeToString(characterID), "step", stepName(step))
+	return nil
+}
+
+// RecordMove completes the move step. The character service calls it after every
+// successful move; moves are not published as domain events.
+func (s *Service) RecordMove(ctx context.Context, character db.Character) {
+	key := uuid.PgtypeToString(character.ID)
+	if _, ok := s.moved.Load(key); ok {
+		return
+	}
+	if err := s.Complete(ctx, character.ID, tutorialV1.TutorialStep_TUTORIAL_STEP_MOVE); err != nil {
+		s.logger.Error("Failed to record tutorial move", "character_id", key, "error", err)
+		return
+	}
+	s.moved.Store(key, struct{}{})
+}
+
+// Subscribe completes tutorial steps from the domain events that describe them
+func (s *Service) Subscribe(bus *events.Bus) {
+	bus.Subscribe(events.ResourceHarvested, events.Dedup(s.handleResourceHarvested, events.DefaultDedupCapacity))
+	bus.Subscribe(events.ItemCrafted, events.Dedup(s.handleItemCrafted, events.DefaultDedupCapacity))
+	bus.Subscribe(events.TradeCompleted, events.Dedup(s.handleTradeCompleted, events.DefaultDedupCapacity))
+}
+
+func (s *Service) handleResourceHarvested(ctx context.Context, event events.Event) error {
+	var payload events.ResourceHarvestedPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("invalid %s payload: %w", event.Type, err)
+	}
+	return s.completeFor(ctx, payload.CharacterID, tutorialV1.TutorialStep_TUTORIAL_STEP_HARVEST)
+}
+
+func (s *Service) handleItemCrafted(ctx context.Context, event events.Event) error {
+	var payload events.ItemCraftedPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("invalid %s payload: %w", event.Type, err)
+	}
+	return s.completeFor(ctx, payload.CharacterID, tutorialV1.TutorialStep_TUTORIAL_STEP_CRAFT)
+}
+
+// handleTradeCompleted completes the trade step for the character of each participant
+func (s *Service) handleTradeCompleted(ctx context.Context, event events.Event) error {
+	var payload events.TradeCompletedPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("invalid %s payload: %w", event.Type, err)
+	}
+	var errs []error
+	for _, characterID := range payload.CharacterIDs {
+		errs = append(errs, s.completeFor(ctx, characterID, tutorialV1.TutorialStep_TUTORIAL_STEP_TRADE))
+	}
+	return errors.Join(errs...)
+}
+
+func (s *Service) completeFor(ctx context.Context, characterID string, step tutorialV1.TutorialStep) error {
+	id, err := uuid.StringToPgtype(characterID)
+	if err != nil {
+		return fmt.Errorf("invalid character ID %q: %w", characterID, err)
+	}
+	return s.Complete(ctx, id, step)
+}
@@ -0,0 +1,127 @@
+package tutorial
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	tutorialV1 "github.com/VoidMesh/api/api/proto/tutorial/v1"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeDB keeps characters and completed steps in memory and counts step lookups
+type fakeDB struct {
+	testmocks.Characters
+	steps   []db.TutorialStep
+	lookups int
+}
+
+func (f *fakeDB) ListTutorialSteps(ctx context.Context, characterID pgtype.UUID) ([]db.TutorialStep, error) {
+	f.lookups++
+	var steps []db.TutorialStep
+	for _, step := range f.steps {
+		if step.CharacterID == characterID {
+			steps = append(steps, step)
+		}
+	}
+	return steps, nil
+}
+
+func (f *fakeDB) CompleteTutorialStep(ctx context.Context, arg db.CompleteTutorialStepParams) error {
+	for _, step := range f.steps {
+		if step.CharacterID == arg.CharacterID && step.Step == arg.Step {
+			return nil
+		}
+	}
+	f.steps = append(f.steps, db.TutorialStep(arg))
+	return nil
+}
+
+const (
+	userID      = "00000000-0000-0000-0000-000000000001"
+	characterID = "00000000-0000-0000-0000-0000000000c1"
+	worldID     = "00000000-0000-0000-0000-0000000000f1"
+)
+
+func event(t *testing.T, eventType, key string, payload any) events.Event {
+	data, err := json.Marshal(payload)
+	require.NoError(t, err)
+	return events.Event{Type: eventType, DedupKey: key, Payload: data}
+}
+
+func TestService_StepsCompleteInOrder(t *testing.T) {
+	user, err := uuid.StringToPgtype(userID)
+	require.NoError(t, err)
+	id, err := uuid.StringToPgtype(characterID)
+	require.NoError(t, err)
+	world, err := uuid.StringToPgtype(worldID)
+	require.NoError(t, err)
+	database := &fakeDB{Characters: testmocks.NewCharacters(db.Character{ID: id, UserID: user, WorldID: world})}
+	service := NewService(database, testmocks.NopLogger[LoggerInterface]{})
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	service.SetClock(clock.NewFake(start))
+	bus := events.NewBus()
+	service.Subscribe(bus)
+	ctx := session.WithWorldID(context.Background(), worldID)
+
+	current := func() tutorialV1.TutorialStep {
+		state, err := service.State(ctx, userID, characterID)
+		require.NoError(t, err)
+		return state.CurrentStep
+	}
+	assert.Equal(t, tutorialV1.TutorialStep_TUTORIAL_STEP_MOVE, current())
+
+	harvest := event(t, events.ResourceHarvested, "h1", events.ResourceHarvestedPayload{HarvestID: "h1", CharacterID: characterID})
+	require.NoError(t, bus.Publish(ctx, harvest))
+	assert.Equal(t, tutorialV1.TutorialStep_TUTORIAL_STEP_MOVE, current(), "harvesting before moving does not count")
+
+	service.RecordMove(ctx, db.Character{ID: id})
+	assert.Equal(t, tutorialV1.TutorialStep_TUTORIAL_STEP_HARVEST, current())
+	lookups := database.lookups
+	service.RecordMove(ctx, db.Character{ID: id})
+	assert.Equal(t, lookups, database.lookups, "later moves skip the database")
+
+	require.NoError(t, bus.Publish(ctx, event(t, events.ResourceHarvested, "h2", events.ResourceHarvestedPayload{HarvestID: "h2", CharacterID: characterID})))
+	require.NoError(t, bus.Publish(ctx, event(t, events.TradeCompleted, "t1", events.TradeCompletedPayload{TradeID: "t1", UserIDs: []string{userID}, CharacterIDs: []string{characterID}})))
+	assert.Equal(t, tutorialV1.TutorialStep_TUTORIAL_STEP_CRAFT, current(), "trading before crafting does not count")
+
+	require.NoError(t, bus.Publish(ctx, event(t, events.ItemCrafted, "c1", events.ItemCraftedPayload{CraftID: "c1", CharacterID: characterID})))
+	require.NoError(t, bus.Publish(ctx, event(t, events.TradeCompleted, "t2", events.TradeCompletedPayload{TradeID: "t2", UserIDs: []string{userID}, CharacterIDs: []string{characterID}})))
+
+	state, err := service.State(ctx, userID, characterID)
+	require.NoError(t, err)
+	assert.True(t, state.Completed)
+	assert.Equal(t, tutorialV1.TutorialStep_TUTORIAL_STEP_UNSPECIFIED, state.CurrentStep)
+	require.Len(t, state.Steps, len(Steps))
+	for i, step := range state.Steps {
+		assert.Equal(t, Steps[i], step.Step)
+		assert.Equal(t, start, step.CompletedAt.AsTime())
+	}
+}
+
+func TestService_State_Errors(t *testing.T) {
+	user, err := uuid.StringToPgtype(userID)
+	require.NoError(t, err)
+	id, err := uuid.StringToPgtype(characterID)
+	require.NoError(t, err)
+	service := NewService(&fakeDB{Characters: testmocks.NewCharacters(db.Character{ID: id, UserID: user})}, testmocks.NopLogger[LoggerInterface]{})
+	ctx := session.WithWorldID(context.Background(), worldID)
+
+	_, err = service.State(ctx, userID, "not-a-uuid")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = service.State(ctx, userID, "00000000-0000-0000-0000-0000000000c2")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = service.State(ctx, "00000000-0000-0000-0000-000000000002", characterID)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -0,0 +1,135 @@
+package user_session
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/outbox"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	"github.com/charmbracelet/log"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DatabaseInterface abstracts database operations for login sessions.
+type DatabaseInterface interface {
+	// CreateSession stores a session and reports whether it came from a device the user
+	// has not logged in with before. New devices of users with earlier sessions enqueue a
+	// UserNewDeviceLogin event in the same transaction.
+	CreateSession(ctx context.Context, arg db.CreateUserSessionParams) (db.UserSession, bool, error)
+	GetUserSessionByTokenHash(ctx context.Context, tokenHash []byte) (db.UserSession, error)
+	ListActiveUserSessions(ctx context.Context, arg db.ListActiveUserSessionsParams) ([]db.UserSession, error)
+	RevokeUserSession(ctx context.Context, arg db.RevokeUserSessionParams) (db.UserSession, error)
+	TouchUserSession(ctx context.Context, arg db.TouchUserSessionParams) error
+	DeleteUserSessionsEndedBefore(ctx context.Context, cutoff pgtype.Timestamp) (int64, error)
+}
+
+// DatabaseWrapper implements DatabaseInterface using the actual database connection.
+type DatabaseWrapper struct {
+	pool    storage.Pool
+	queries *db.Queries
+}
+
+// NewDatabaseWrapper creates a new database wrapper with the given connection pool.
+func NewDatabaseWrapper(pool storage.Pool) DatabaseInterface {
+	return &DatabaseWrapper{
+		pool:    pool,
+		queries: db.New(pool),
+	}
+}
+
+func (d *DatabaseWrapper) CreateSession(ctx context.Context, arg db.CreateUserSessionParams) (db.UserSession, bool, error) {
+	var session db.UserSession
+	var newDevice bool
+	err := outbox.InTx(ctx, d.pool, func(q *db.Queries) error {
+		earlier, err := q.CountUserSessions(ctx, arg.UserID)
+		if err != nil {
+			return err
+		}
+		known, err := q.UserSessionDeviceKnown(ctx, db.UserSessionDeviceKnownParams{UserID: arg.UserID, UserAgent: arg.UserAgent})
+		if err != nil {
+			return err
+		}
+		session, err = q.CreateUserSession(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		// The first login of an account is not news to its owner
+		newDevice = earlier > 0 && !known
+		if !newDevice {
+			return nil
+		}
+		sessionID := uuid.PgtypeToString(session.ID)
+		return outbox.Enqueue(ctx, q, events.UserNewDeviceLogin, uuid.PgtypeToString(session.UserID),
+			fmt.Sprintf("%s:%s", events.UserNewDeviceLogin, sessionID),
+			events.UserNewDeviceLoginPayload{
+				UserID:    uuid.PgtypeToString(session.UserID),
+				SessionID: sessionID,
+				IPAddress: session.IpAddress,
+				UserAgent: session.UserAgent,
+			})
+	})
+	return session, newDevice, err
+}
+
+func (d *DatabaseWrapper) GetUserSessionByTokenHash(ctx context.Context, tokenHash []byte) (db.UserSession, error) {
+	return d.queries.GetUserSessionByTokenHash(ctx, tokenHash)
+}
+
+func (d *DatabaseWrapper) ListActiveUserSessions(ctx context.Context, arg db.ListActiveUserSessionsParams) ([]db.UserSession, error) {
+	return d.queries.ListActiveUserSessions(ctx, arg)
+}
+
+func (d *DatabaseWrapper) RevokeUserSession(ctx context.Context, arg db.RevokeUserSessionParams) (db.UserSession, error) {
+	return d.queries.RevokeUserSession(ctx, arg)
+}
+
+func (d *DatabaseWrapper) TouchUserSession(ctx context.Context, arg db.TouchUserSessionParams) error {
+	return d.queries.TouchUserSession(ctx, arg)
+}
+
+func (d *DatabaseWrapper) DeleteUserSessionsEndedBefore(ctx context.Context, cutoff pgtype.Timestamp) (int64, error) {
+	return d.queries.DeleteUserSessionsEndedBefore(ctx, cutoff)
+}
+
+// LoggerInterface abstracts logging operations for dependency injection.
+type LoggerInterface interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+	With(keysAndValues ...interface{}) LoggerInterface
+}
+
+// DefaultLoggerWrapper wraps the internal logging package.
+type DefaultLoggerWrapper struct {
+	logger *log.Logger
+}
+
+func NewDefaultLoggerWrapper() LoggerInterface {
+	return &DefaultLoggerWrapper{logger: logging.GetLogger()}
+}
+
+func (l *DefaultLoggerWrapper) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.Debug(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Info(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.Warn(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.Error(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) With(keysAndValues ...interface{}) LoggerInterface {
+	return &DefaultLoggerWrapper{logger: l.logger.With(keysAndValues...)}
+}
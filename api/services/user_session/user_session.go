@@ -0,0 +1,294 @@
+// Package user_session records a session for every login so players can see where their
+// account is signed in and sign devices out. A session is keyed by a hash of the access
+// token it was issued with; the auth interceptor rejects tokens whose session was revoked.
+// Logging in from a device the account has not used before raises a UserNewDeviceLogin
+// event.
+package user_session
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/alerting"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/debugstats"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	userV1 "github.com/VoidMesh/api/api/proto/user/v1"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// MaxUserAgentLength bounds the stored user agent, in bytes
+	MaxUserAgentLength = 512
+	// Retention is how long ended sessions are kept, so returning devices are still known
+	Retention = 90 * 24 * time.Hour
+	// PruneInterval is how often old sessions are deleted
+	PruneInterval = time.Hour
+
+	// cacheTTL is how long a session is served from memory, so a revocation on another
+	// instance takes at most this long to take hold here
+	cacheTTL = 10 * time.Second
+	// touchInterval limits how often a session's last use is written back
+	touchInterval = time.Minute
+)
+
+// Service records login sessions and authenticates requests against them. It is safe for
+// concurrent use.
+type Service struct {
+	db     DatabaseInterface
+	logger LoggerInterface
+	clock  clock.Clock
+
+	mu    sync.RWMutex
+	cache map[[sha256.Size]byte]cachedSession // By token hash
+}
+
+// cachedSession is a session row, or found false for tokens issued before sessions existed
+type cachedSession struct {
+	row      db.UserSession
+	found    bool
+	loadedAt time.Time
+}
+
+// NewService creates a new user session service with dependency injection.
+func NewService(db DatabaseInterface, logger LoggerInterface) *Service {
+	componentLogger := logger.With("component", "user-session-service")
+	componentLogger.Debug("Creating new user session service")
+	s := &Service{
+		db:     db,
+		logger: componentLogger,
+		clock:  clock.New(),
+		cache:  make(map[[sha256.Size]byte]cachedSession),
+	}
+	debugstats.Register(debugstats.CacheEntries, "user_session.sessions", func() int64 {
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+		return int64(len(s.cache))
+	})
+	return s
+}
+
+// NewServiceWithPool creates a service with concrete implementations (convenience constructor for production use).
+func NewServiceWithPool(pool storage.Pool) *Service {
+	return NewService(NewDatabaseWrapper(pool), NewDefaultLoggerWrapper())
+}
+
+// SetClock replaces the clock used for timestamps, expiry and the cache (for simulation tests)
+func (s *Service) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// Start records a session for a token just issued to userID, returning its ID
+func (s *Service) Start(ctx context.Context, userID, token, ipAddress, userAgent string, expiresAt time.Time) (string, error) {
+	user, err := uuid.StringToPgtype(userID)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "invalid user ID format")
+	}
+	userAgent = strings.ToValidUTF8(strings.TrimSpace(userAgent), "")
+	if len(userAgent) > MaxUserAgentLength {
+		userAgent = strings.ToValidUTF8(userAgent[:MaxUserAgentLength], "")
+	}
+
+	now := s.clock.Now()
+	hash := tokenHash(token)
+	row, newDevice, err := s.db.CreateSession(ctx, db.CreateUserSessionParams{
+		UserID:    user,
+		TokenHash: hash[:],
+		IpAddress: ipAddress,
+		UserAgent: userAgent,
+		CreatedAt: pgtype.Timestamp{Time: now, Valid: true},
+		ExpiresAt: pgtype.Timestamp{Time: expiresAt, Valid: true},
+	})
+	if err != nil {
+		s.logger.Error("Failed to store session", "user_id", userID, "error", err)
+		return "", status.Errorf(codes.Internal, "failed to start session")
+	}
+
+	s.mu.Lock()
+	s.cache[hash] = cachedSession{row: row, found: true, loadedAt: now}
+	s.mu.Unlock()
+
+	sessionID := uuid.PgtypeToString(row.ID)
+	s.logger.Info("Session started", "user_id", userID, "session_id", sessionID, "ip_address", ipAddress, "new_device", newDevice)
+	return sessionID, nil
+}
+
+// AuthenticateSession checks that the session a token was issued with is still live, and
+// returns its ID. Tokens issued before sessions were recorded have none and are accepted
+// with an empty ID until they expire.
+func (s *Service) AuthenticateSession(ctx context.Context, token string) (string, error) {
+	hash := tokenHash(token)
+	now := s.clock.Now()
+
+	s.mu.RLock()
+	cached, ok := s.cache[hash]
+	s.mu.RUnlock()
+	if !ok || now.Sub(cached.loadedAt) >= cacheTTL {
+		row, err := s.db.GetUserSessionByTokenHash(ctx, hash[:])
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			s.logger.Error("Failed to get session", "error", err)
+			return "", status.Errorf(codes.Internal, "failed to check session")
+		}
+		cached = cachedSession{row: row, found: err == nil, loadedAt: now}
+		s.mu.Lock()
+		s.cache[hash] = cached
+		s.mu.Unlock()
+	}
+	if !cached.found {
+		return "", nil
+	}
+
+	row := cached.row
+	if row.RevokedAt.Valid {
+		return "", status.Errorf(codes.Unauthenticated, "session revoked")
+	}
+	if !now.Before(row.ExpiresAt.Time) {
+		return "", status.Errorf(codes.Unauthenticated, "session expired")
+	}
+
+	sessionID := uuid.PgtypeToString(row.ID)
+	if now.Sub(row.LastSeenAt.Time) >= touchInterval {
+		if err := s.db.TouchUserSession(ctx, db.TouchUserSessionParams{
+			ID:         row.ID,
+			LastSeenAt: pgtype.Timestamp{Time: now, Valid: true},
+		}); err != nil {
+			s.logger.Warn("Failed to record session use", "session_id", sessionID, "error", err)
+		} else {
+			cached.row.LastSeenAt = pgtype.Timestamp{Time: now, Valid: true}
+			s.mu.Lock()
+			s.cache[hash] = cached
+			s.mu.Unlock()
+		}
+	}
+	return sessionID, nil
+}
+
+// List returns the user's live sessions, most recently seen first. currentSessionID marks
+// the session making the request.
+func (s *Service) List(ctx context.Context, userID, currentSessionID string) ([]*userV1.Session, error) {
+	user, err := uuid.StringToPgtype(userID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID format")
+	}
+	rows, err := s.db.ListActiveUserSessions(ctx, db.ListActiveUserSessionsParams{
+		UserID: user,
+		Now:    pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
+	})
+	if err != nil {
+		s.logger.Error("Failed to list sessions", "user_id", userID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list sessions")
+	}
+	sessions := make([]*userV1.Session, 0, len(rows))
+	for _, row := range rows {
+		sessions = append(sessions, dbSessionToProto(row, currentSessionID))
+	}
+	return sessions, nil
+}
+
+// Revoke signs one of the user's sessions out. Requests already in flight finish, and
+// other instances stop accepting the session within the cache TTL.
+func (s *Service) Revoke(ctx context.Context, userID, sessionID string) (*userV1.Session, error) {
+	user, err := uuid.StringToPgtype(userID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID format")
+	}
+	id, err := uuid.StringToPgtype(sessionID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid session ID format")
+	}
+	row, err := s.db.RevokeUserSession(ctx, db.RevokeUserSessionParams{
+		RevokedAt: pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
+		ID:        id,
+		UserID:    user,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "session not found or already revoked")
+	}
+	if err != nil {
+		s.logger.Error("Failed to revoke session", "user_id", userID, "session_id", sessionID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to revoke session")
+	}
+
+	var hash [sha256.Size]byte
+	copy(hash[:], row.TokenHash)
+	s.mu.Lock()
+	delete(s.cache, hash)
+	s.mu.Unlock()
+
+	s.logger.Info("Session revoked", "user_id", userID, "session_id", sessionID)
+	return dbSessionToProto(row, ""), nil
+}
+
+// Prune deletes sessions that ended more than Retention ago and stale cache entries
+func (s *Service) Prune(ctx context.Context) (int64, error) {
+	now := s.clock.Now()
+	s.mu.Lock()
+	for hash, cached := range s.cache {
+		if now.Sub(cached.loadedAt) >= cacheTTL {
+			delete(s.cache, hash)
+		}
+	}
+	s.mu.Unlock()
+
+	deleted, err := s.db.DeleteUserSessionsEndedBefore(ctx, pgtype.Timestamp{Time: now.Add(-Retention), Valid: true})
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune sessions: %w", err)
+	}
+	return deleted, nil
+}
+
+// Run prunes old sessions every PruneInterval until ctx is cancelled
+func (s *Service) Run(ctx context.Context) {
+	s.logger.Info("Session pruning started", "interval", PruneInterval, "retention", Retention)
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("Session pruning stopped")
+			return
+		case <-s.clock.After(PruneInterval):
+		}
+
+		pruned, err := s.Prune(ctx)
+		if err != nil {
+			s.logger.Error("Session pruning failed", "error", err)
+			alerting.ReportJobError("session_prune", err)
+			continue
+		}
+		if pruned > 0 {
+			s.logger.Debug("Sessions pruned", "deleted", pruned)
+		}
+	}
+}
+
+// tokenHash is how sessions are looked up; tokens themselves are not stored
+func tokenHash(token string) [sha256.Size]byte {
+	return sha256.Sum256([]byte(token))
+}
+
+func dbSessionToProto(row db.UserSession, currentSessionID string) *userV1.Session {
+	id := uuid.PgtypeToString(row.ID)
+	session := &userV1.Session{
+		Id:         id,
+		CreatedAt:  timestamppb.New(row.CreatedAt.Time),
+		LastSeenAt: timestamppb.New(row.LastSeenAt.Time),
+		ExpiresAt:  timestamppb.New(row.ExpiresAt.Time),
+		IpAddress:  row.IpAddress,
+		UserAgent:  row.UserAgent,
+		Current:    currentSessionID != "" && id == currentSessionID,
+	}
+	if row.RevokedAt.Valid {
+		session.RevokedAt = timestamppb.New(row.RevokedAt.Time)
+	}
+	return session
+}
@@ -0,0 +1,253 @@
+package user_session
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeDB keeps sessions in memory
+type fakeDB struct {
+	sessions   []db.UserSession
+	newDevices []string // Session IDs that raised a new device event
+	lookups    int
+	touches    int
+}
+
+func (f *fakeDB) CreateSession(ctx context.Context, arg db.CreateUserSessionParams) (db.UserSession, bool, error) {
+	earlier, known := 0, false
+	for _, session := range f.sessions {
+		if session.UserID == arg.UserID {
+			earlier++
+			known = known || session.UserAgent == arg.UserAgent
+		}
+	}
+	id, _ := uuid.StringToPgtype(uuid.GenerateNew())
+	row := db.UserSession{
+		ID:         id,
+		UserID:     arg.UserID,
+		TokenHash:  arg.TokenHash,
+		IpAddress:  arg.IpAddress,
+		UserAgent:  arg.UserAgent,
+		CreatedAt:  arg.CreatedAt,
+		LastSeenAt: arg.CreatedAt,
+		ExpiresAt:  arg.ExpiresAt,
+	}
+	f.sessions = append(f.sessions, row)
+	newDevice := earlier > 0 && !known
+	if newDevice {
+		f.newDevices = append(f.newDevices, uuid.PgtypeToString(id))
+	}
+	return row, newDevice, nil
+}
+
+func (f *fakeDB) GetUserSessionByTokenHash(ctx context.Context, tokenHash []byte) (db.UserSession, error) {
+	f.lookups++
+	for _, session := range f.sessions {
+		if bytes.Equal(session.TokenHash, tokenHash) {
+			return session, nil
+		}
+	}
+	return db.UserSession{}, pgx.ErrNoRows
+}
+
+func (f *fakeDB) ListActiveUserSessions(ctx context.Context, arg db.ListActiveUserSessionsParams) ([]db.UserSession, error) {
+	var sessions []db.UserSession
+	for _, session := range f.sessions {
+		if session.UserID == arg.UserID && !session.RevokedAt.Valid && session.ExpiresAt.Time.After(arg.Now.Time) {
+			sessions = append(sessions, session)
+		}
+	}
+	return sessions, nil
+}
+
+func (f *fakeDB) RevokeUserSession(ctx context.Context, arg db.RevokeUserSessionParams) (db.UserSession, error) {
+	for i, session := range f.sessions {
+		if session.ID == arg.ID && session.UserID == arg.UserID && !session.RevokedAt.Valid {
+			f.sessions[i].RevokedAt = arg.RevokedAt
+			return f.sessions[i], nil
+		}
+	}
+	return db.UserSession{}, pgx.ErrNoRows
+}
+
+func (f *fakeDB) TouchUserSession(ctx context.Context, arg db.TouchUserSessionParams) error {
+	for i, session := range f.sessions {
+		if session.ID == arg.ID {
+			f.sessions[i].LastSeenAt = arg.LastSeenAt
+		}
+	}
+	f.touches++
+	return nil
+}
+
+func (f *fakeDB) DeleteUserSessionsEndedBefore(ctx context.Context, cutoff pgtype.Timestamp) (int64, error) {
+	var kept []db.UserSession
+	for _, session := range f.sessions {
+		if !session.ExpiresAt.Time.Before(cutoff.Time) && !(session.RevokedAt.Valid && session.RevokedAt.Time.Before(cutoff.Time)) {
+			kept = append(kept, session)
+		}
+	}
+	deleted := int64(len(f.sessions) - len(kept))
+	f.sessions = kept
+	return deleted, nil
+}
+
+const (
+	userID  = "00000000-0000-0000-0000-000000000001"
+	otherID = "00000000-0000-0000-0000-000000000002"
+	laptop  = "Mozilla/5.0 (X11; Linux x86_64)"
+	phone   = "VoidMesh/1.4 (Android 14)"
+)
+
+func newTestService() (*Service, *fakeDB, *clock.Fake) {
+	database := &fakeDB{}
+	fake := clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	service := NewService(database, testmocks.NopLogger[LoggerInterface]{})
+	service.SetClock(fake)
+	return service, database, fake
+}
+
+func TestStart_NewDevice(t *testing.T) {
+	service, database, fake := newTestService()
+	ctx := context.Background()
+	expiresAt := fake.Now().Add(7 * 24 * time.Hour)
+
+	first, err := service.Start(ctx, userID, "token-1", "203.0.113.7", laptop, expiresAt)
+	require.NoError(t, err)
+	assert.Empty(t, database.newDevices, "the first login of an account is not a new device")
+	assert.NotContains(t, string(database.sessions[0].TokenHash), "token-1", "only the hash is stored")
+
+	_, err = service.Start(ctx, userID, "token-2", "203.0.113.8", laptop, expiresAt)
+	require.NoError(t, err)
+	assert.Empty(t, database.newDevices, "the laptop is known")
+
+	third, err := service.Start(ctx, userID, "token-3", "198.51.100.2", phone, expiresAt)
+	require.NoError(t, err)
+	assert.Equal(t, []string{third}, database.newDevices)
+	assert.NotEqual(t, first, third)
+
+	_, err = service.Start(ctx, otherID, "token-4", "198.51.100.2", phone, expiresAt)
+	require.NoError(t, err)
+	assert.Len(t, database.newDevices, 1, "devices are known per user")
+
+	_, err = service.Start(ctx, "not-a-uuid", "token-5", "", phone, expiresAt)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestStart_TruncatesUserAgent(t *testing.T) {
+	service, database, fake := newTestService()
+
+	long := string(bytes.Repeat([]byte("é"), MaxUserAgentLength))
+	_, err := service.Start(context.Background(), userID, "token", "", long, fake.Now().Add(time.Hour))
+	require.NoError(t, err)
+	stored := database.sessions[0].UserAgent
+	assert.LessOrEqual(t, len(stored), MaxUserAgentLength)
+	assert.Equal(t, MaxUserAgentLength/2, len([]rune(stored)), "truncation keeps whole characters")
+}
+
+func TestAuthenticateSession(t *testing.T) {
+	service, database, fake := newTestService()
+	ctx := context.Background()
+
+	sessionID, err := service.Start(ctx, userID, "token", "203.0.113.7", laptop, fake.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	got, err := service.AuthenticateSession(ctx, "token")
+	require.NoError(t, err)
+	assert.Equal(t, sessionID, got)
+	assert.Zero(t, database.lookups, "Start caches the session")
+	assert.Zero(t, database.touches, "last seen was just set")
+
+	fake.Advance(2 * time.Minute)
+	_, err = service.AuthenticateSession(ctx, "token")
+	require.NoError(t, err)
+	assert.Equal(t, 1, database.lookups, "the cache entry went stale")
+	assert.Equal(t, 1, database.touches)
+	assert.Equal(t, fake.Now(), database.sessions[0].LastSeenAt.Time)
+
+	_, err = service.AuthenticateSession(ctx, "token")
+	require.NoError(t, err)
+	assert.Equal(t, 1, database.touches, "last seen is written back at most once a minute")
+
+	got, err = service.AuthenticateSession(ctx, "token-from-before-sessions")
+	require.NoError(t, err)
+	assert.Empty(t, got, "tokens without a session are accepted")
+
+	fake.Advance(time.Hour)
+	_, err = service.AuthenticateSession(ctx, "token")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "expired")
+}
+
+func TestRevoke(t *testing.T) {
+	service, _, fake := newTestService()
+	ctx := context.Background()
+	expiresAt := fake.Now().Add(time.Hour)
+
+	current, err := service.Start(ctx, userID, "token-1", "203.0.113.7", laptop, expiresAt)
+	require.NoError(t, err)
+	fake.Advance(time.Minute)
+	stolen, err := service.Start(ctx, userID, "token-2", "198.51.100.2", phone, expiresAt)
+	require.NoError(t, err)
+
+	sessions, err := service.List(ctx, userID, current)
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	for _, session := range sessions {
+		assert.Equal(t, session.Id == current, session.Current)
+	}
+
+	_, err = service.Revoke(ctx, otherID, stolen)
+	assert.Equal(t, codes.NotFound, status.Code(err), "users can only revoke their own sessions")
+	_, err = service.Revoke(ctx, userID, "not-a-uuid")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	revoked, err := service.Revoke(ctx, userID, stolen)
+	require.NoError(t, err)
+	assert.Equal(t, phone, revoked.UserAgent)
+	assert.Equal(t, fake.Now(), revoked.RevokedAt.AsTime())
+
+	_, err = service.AuthenticateSession(ctx, "token-2")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err), "revocation takes hold at once on this instance")
+	_, err = service.AuthenticateSession(ctx, "token-1")
+	assert.NoError(t, err)
+	_, err = service.Revoke(ctx, userID, stolen)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	sessions, err = service.List(ctx, userID, current)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, current, sessions[0].Id)
+}
+
+func TestPrune(t *testing.T) {
+	service, database, fake := newTestService()
+	ctx := context.Background()
+
+	_, err := service.Start(ctx, userID, "token-1", "", laptop, fake.Now().Add(time.Hour))
+	require.NoError(t, err)
+	fake.Advance(Retention)
+	_, err = service.Start(ctx, userID, "token-2", "", phone, fake.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	fake.Advance(2 * time.Hour)
+	deleted, err := service.Prune(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+	require.Len(t, database.sessions, 1)
+	assert.Equal(t, phone, database.sessions[0].UserAgent, "recently ended sessions are kept")
+	assert.Empty(t, service.cache, "stale cache entries are dropped")
+}
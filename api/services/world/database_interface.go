@@ -4,8 +4,8 @@ import (
 	"context"
 
 	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/storage"
 	"github.com/jackc/pgx/v5/pgtype"
-	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 // DatabaseInterface abstracts database operations for the world service.
@@ -26,7 +26,7 @@ type DatabaseWrapper struct {
 }
 
 // NewDatabaseWrapper creates a new database wrapper with the given connection pool.
-func NewDatabaseWrapper(pool *pgxpool.Pool) DatabaseInterface {
+func NewDatabaseWrapper(pool storage.Pool) DatabaseInterface {
 	return &DatabaseWrapper{
 		queries: db.New(pool),
 	}
@@ -60,4 +60,4 @@ func (d *DatabaseWrapper) UpdateWorld(ctx context.Context, arg db.UpdateWorldPar
 // DeleteWorld deletes a world by ID.
 func (d *DatabaseWrapper) DeleteWorld(ctx context.Context, id pgtype.UUID) error {
 	return d.queries.DeleteWorld(ctx, id)
-}
\ No newline at end of file
+}
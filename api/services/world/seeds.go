@@ -0,0 +1,57 @@
+package world
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/VoidMesh/api/api/services/noise"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Seeds caches the seed and terrain noise generator of each world by ID. A world's seed
+// never changes once it is created, so entries are kept for the life of the process.
+type Seeds struct {
+	service *Service
+
+	mu         sync.Mutex
+	generators map[pgtype.UUID]*noise.Generator
+}
+
+// NewSeeds creates a seed cache that loads worlds through the given service
+func NewSeeds(service *Service) *Seeds {
+	return &Seeds{
+		service:    service,
+		generators: make(map[pgtype.UUID]*noise.Generator),
+	}
+}
+
+// Seed returns the seed of a world
+func (s *Seeds) Seed(ctx context.Context, worldID pgtype.UUID) (int64, error) {
+	generator, err := s.Noise(ctx, worldID)
+	if err != nil {
+		return 0, err
+	}
+	return generator.GetSeed(), nil
+}
+
+// Noise returns the terrain noise generator of a world, seeded from the world's seed
+func (s *Seeds) Noise(ctx context.Context, worldID pgtype.UUID) (*noise.Generator, error) {
+	if !worldID.Valid {
+		return nil, fmt.Errorf("no world given")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if generator, ok := s.generators[worldID]; ok {
+		return generator, nil
+	}
+
+	world, err := s.service.GetWorldByID(ctx, worldID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get world seed: %w", err)
+	}
+	generator := noise.NewGenerator(world.Seed).(*noise.Generator)
+	s.generators[worldID] = generator
+	return generator, nil
+}
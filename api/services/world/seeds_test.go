@@ -0,0 +1,39 @@
+package world
+
+import (
+	"context"
+	"testing"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeeds(t *testing.T) {
+	database := NewMockDatabase()
+	first := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	second := pgtype.UUID{Bytes: [16]byte{2}, Valid: true}
+	database.AddWorld(db.World{ID: first, Name: "First", Seed: 11})
+	database.AddWorld(db.World{ID: second, Name: "Second", Seed: 22})
+	seeds := NewSeeds(NewService(database, NewMockLogger()))
+	ctx := context.Background()
+
+	seed, err := seeds.Seed(ctx, first)
+	require.NoError(t, err)
+	assert.Equal(t, int64(11), seed)
+	generator, err := seeds.Noise(ctx, second)
+	require.NoError(t, err)
+	assert.Equal(t, int64(22), generator.GetSeed(), "each world has its own generator")
+
+	calls := database.getCallCount
+	again, err := seeds.Noise(ctx, second)
+	require.NoError(t, err)
+	assert.Same(t, generator, again)
+	assert.Equal(t, calls, database.getCallCount, "seeds are loaded once per world")
+
+	_, err = seeds.Seed(ctx, pgtype.UUID{Bytes: [16]byte{3}, Valid: true})
+	assert.Error(t, err, "unknown world")
+	_, err = seeds.Seed(ctx, pgtype.UUID{})
+	assert.Error(t, err, "no world")
+}
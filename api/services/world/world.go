@@ -6,8 +6,8 @@ import (
 	"math/rand"
 
 	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/storage"
 	"github.com/jackc/pgx/v5/pgtype"
-	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/VoidMesh/api/api/db"
 )
@@ -71,7 +71,7 @@ func NewService(db DatabaseInterface, logger LoggerInterface) *Service {
 }
 
 // NewServiceWithPool creates a service with a database pool (convenience constructor for production use).
-func NewServiceWithPool(pool *pgxpool.Pool, logger LoggerInterface) *Service {
+func NewServiceWithPool(pool storage.Pool, logger LoggerInterface) *Service {
 	return NewService(NewDatabaseWrapper(pool), logger)
 }
 
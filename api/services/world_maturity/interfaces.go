@@ -0,0 +1,83 @@
+package world_maturity
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/charmbracelet/log"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DatabaseInterface abstracts database operations for region maturity.
+type DatabaseInterface interface {
+	SumChunkHarvestsByRegion(ctx context.Context, arg db.SumChunkHarvestsByRegionParams) ([]db.SumChunkHarvestsByRegionRow, error)
+	ListRegionMaturity(ctx context.Context, worldID pgtype.UUID) ([]db.RegionMaturity, error)
+	UpsertRegionMaturity(ctx context.Context, arg db.UpsertRegionMaturityParams) error
+}
+
+// PauseInterface reports worlds an admin has paused.
+type PauseInterface interface {
+	Paused(ctx context.Context, worldID pgtype.UUID) bool
+}
+
+// DatabaseWrapper implements DatabaseInterface using the actual database connection.
+type DatabaseWrapper struct {
+	queries *db.Queries
+}
+
+// NewDatabaseWrapper creates a new database wrapper with the given connection pool.
+func NewDatabaseWrapper(pool storage.Pool) DatabaseInterface {
+	return &DatabaseWrapper{queries: db.New(pool)}
+}
+
+func (d *DatabaseWrapper) SumChunkHarvestsByRegion(ctx context.Context, arg db.SumChunkHarvestsByRegionParams) ([]db.SumChunkHarvestsByRegionRow, error) {
+	return d.queries.SumChunkHarvestsByRegion(ctx, arg)
+}
+
+func (d *DatabaseWrapper) ListRegionMaturity(ctx context.Context, worldID pgtype.UUID) ([]db.RegionMaturity, error) {
+	return d.queries.ListRegionMaturity(ctx, worldID)
+}
+
+func (d *DatabaseWrapper) UpsertRegionMaturity(ctx context.Context, arg db.UpsertRegionMaturityParams) error {
+	return d.queries.UpsertRegionMaturity(ctx, arg)
+}
+
+// LoggerInterface abstracts logging operations for dependency injection.
+type LoggerInterface interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+	With(keysAndValues ...interface{}) LoggerInterface
+}
+
+// DefaultLoggerWrapper wraps the internal logging package.
+type DefaultLoggerWrapper struct {
+	logger *log.Logger
+}
+
+func NewDefaultLoggerWrapper() LoggerInterface {
+	return &DefaultLoggerWrapper{logger: logging.GetLogger()}
+}
+
+func (l *DefaultLoggerWrapper) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.Debug(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Info(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.Warn(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.Error(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) With(keysAndValues ...interface{}) LoggerInterface {
+	return &DefaultLoggerWrapper{logger: l.logger.With(keysAndValues...)}
+}
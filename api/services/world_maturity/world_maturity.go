@@ -0,0 +1,305 @@
+// Package world_maturity models how a world ages: regions players harvest heavily slowly
+// spawn fewer resource nodes in newly generated chunks, while regions left alone recover
+// and the unexplored frontier grows richer the older the world is, drawing players
+// outward. Densities are computed from the chunk_summaries projection on a schedule and
+// served from memory to resource generation.
+package world_maturity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/alerting"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Config tunes how region densities change
+type Config struct {
+	// RegionSize is the side of a region in chunks
+	RegionSize int32
+	// Interval is how often densities are updated
+	Interval time.Duration
+	// BatchSize caps the regions one update works through, up to RegionSize² chunks each.
+	// The next update resumes after the last region, so a large world is covered over
+	// several intervals; 0 updates every region each time.
+	BatchSize int
+	// Workers is how many regions are stored concurrently
+	Workers int
+	// HeavyHarvests is how many new harvests per generated chunk in one update make a
+	// region heavily harvested
+	HeavyHarvests float64
+	// DepletionStep is how much a heavily harvested region's density drops per update
+	DepletionStep float64
+	// RecoveryStep is how much a region without new harvests gains per update
+	RecoveryStep float64
+	// FrontierGrowth is how much the density of regions nobody has reached yet grows per
+	// day of world age, starting from 1
+	FrontierGrowth float64
+	MinDensity     float64
+	MaxDensity     float64
+}
+
+// DefaultConfig returns the production maturity settings
+func DefaultConfig() Config {
+	return Config{
+		RegionSize:     8,
+		Interval:       time.Hour,
+		BatchSize:      512,
+		Workers:        4,
+		HeavyHarvests:  2,
+		DepletionStep:  0.05,
+		RecoveryStep:   0.01,
+		FrontierGrowth: 0.02,
+		MinDensity:     0.5,
+		MaxDensity:     1.5,
+	}
+}
+
+type region struct {
+	x, y int32
+}
+
+// maturity is what the service remembers of a region between updates
+type maturity struct {
+	density  float64
+	harvests int64 // Harvest total the density was last moved for
+}
+
+// startCursor sorts before every region, so an update from it starts at the beginning
+var startCursor = region{math.MinInt32, math.MinInt32}
+
+// Service updates and serves the region densities of one world.
+type Service struct {
+	db     DatabaseInterface
+	world  db.World
+	config Config
+	logger LoggerInterface
+	clock  clock.Clock
+	pauses PauseInterface // Nil never skips an update
+
+	mu       sync.RWMutex
+	regions  map[region]maturity
+	frontier float64 // Density of regions without a row
+
+	// Only Update, which runs on one goroutine, uses these
+	loaded bool
+	cursor region // Last region of the previous batch, in (y, x) order
+}
+
+// NewService creates a new world maturity service with dependency injection.
+func NewService(database DatabaseInterface, world db.World, logger LoggerInterface) *Service {
+	componentLogger := logger.With("component", "world-maturity-service")
+	componentLogger.Debug("Creating new world maturity service")
+	return &Service{
+		db:       database,
+		world:    world,
+		config:   DefaultConfig(),
+		logger:   componentLogger,
+		clock:    clock.New(),
+		regions:  make(map[region]maturity),
+		frontier: 1,
+		cursor:   startCursor,
+	}
+}
+
+// NewServiceWithPool creates a service with concrete implementations (convenience constructor for production use).
+func NewServiceWithPool(pool storage.Pool, world db.World) *Service {
+	return NewService(NewDatabaseWrapper(pool), world, NewDefaultLoggerWrapper())
+}
+
+// SetClock replaces the clock used for world age and the update schedule (for simulation tests)
+func (s *Service) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// SetConfig replaces the maturity settings
+func (s *Service) SetConfig(config Config) {
+	s.config = config
+}
+
+// SetPauses makes updates skip the world while an admin has paused it
+func (s *Service) SetPauses(pauses PauseInterface) {
+	s.pauses = pauses
+}
+
+// DensityAt returns the factor scaling resource nodes per chunk for a newly generated chunk
+func (s *Service) DensityAt(chunkX, chunkY int32) float64 {
+	r := region{geometry.FloorDiv(chunkX, s.config.RegionSize), geometry.FloorDiv(chunkY, s.config.RegionSize)}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if m, ok := s.regions[r]; ok {
+		return m.density
+	}
+	return s.frontier
+}
+
+// frontierDensity is the density of regions nobody has reached yet, which grows with world age
+func (s *Service) frontierDensity(now time.Time) float64 {
+	age := now.Sub(s.world.CreatedAt.Time)
+	if !s.world.CreatedAt.Valid || age < 0 {
+		age = 0
+	}
+	days := age.Hours() / 24
+	return s.clamp(1 + s.config.FrontierGrowth*days)
+}
+
+func (s *Service) clamp(density float64) float64 {
+	return math.Min(s.config.MaxDensity, math.Max(s.config.MinDensity, density))
+}
+
+// Update moves the density of the next batch of regions according to the harvests
+// recorded in their chunk summaries since the last update, stores the results and
+// returns how many regions were updated. Regions seen for the first time start at the
+// frontier density. Stored densities are loaded on the first update; a paused world is
+// skipped.
+func (s *Service) Update(ctx context.Context) (int, error) {
+	if s.pauses != nil && s.pauses.Paused(ctx, s.world.ID) {
+		s.logger.Debug("Region maturity update skipped, world is paused")
+		return 0, nil
+	}
+	now := s.clock.Now()
+	if !s.loaded {
+		if err := s.load(ctx); err != nil {
+			return 0, err
+		}
+	}
+
+	limit := s.config.BatchSize
+	if limit <= 0 {
+		limit = math.MaxInt32
+	}
+	harvests, err := s.db.SumChunkHarvestsByRegion(ctx, db.SumChunkHarvestsByRegionParams{
+		RegionSize:   s.config.RegionSize,
+		WorldID:      s.world.ID,
+		MinChunkY:    int32(max(math.MinInt32, int64(s.cursor.y)*int64(s.config.RegionSize))),
+		AfterRegionY: s.cursor.y,
+		AfterRegionX: s.cursor.x,
+		RowLimit:     int32(limit),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to sum harvests by region: %w", err)
+	}
+
+	frontier := s.frontierDensity(now)
+	s.mu.RLock()
+	updates := make([]db.UpsertRegionMaturityParams, len(harvests))
+	depleted, recovered := 0, 0
+	for i, h := range harvests {
+		density := frontier
+		var newHarvests int64
+		if prev, ok := s.regions[region{h.RegionX, h.RegionY}]; ok {
+			density = prev.density
+			newHarvests = max(0, h.HarvestCount-prev.harvests)
+		} else {
+			newHarvests = h.HarvestCount
+		}
+
+		switch {
+		case newHarvests == 0:
+			density = s.clamp(density + s.config.RecoveryStep)
+			recovered++
+		case float64(newHarvests)/float64(max(1, h.ChunkCount)) >= s.config.HeavyHarvests:
+			density = s.clamp(density - s.config.DepletionStep)
+			depleted++
+		}
+		updates[i] = db.UpsertRegionMaturityParams{
+			WorldID:      s.world.ID,
+			RegionX:      h.RegionX,
+			RegionY:      h.RegionY,
+			Density:      density,
+			HarvestCount: h.HarvestCount,
+			UpdatedAt:    pgtype.Timestamp{Time: now, Valid: true},
+		}
+	}
+	s.mu.RUnlock()
+
+	// A failed store leaves memory and the cursor alone, so the retry computes the same
+	// densities again rather than moving them twice
+	if err := s.store(ctx, updates); err != nil {
+		return 0, err
+	}
+
+	s.mu.Lock()
+	for _, u := range updates {
+		s.regions[region{u.RegionX, u.RegionY}] = maturity{density: u.Density, harvests: u.HarvestCount}
+	}
+	s.frontier = frontier
+	s.mu.Unlock()
+
+	if len(harvests) < limit {
+		s.cursor = startCursor
+	} else {
+		last := harvests[len(harvests)-1]
+		s.cursor = region{last.RegionX, last.RegionY}
+	}
+
+	s.logger.Debug("Updated region maturity", "regions", len(harvests), "depleted", depleted, "recovered", recovered,
+		"frontier_density", frontier, "wrapped", s.cursor == startCursor)
+	return len(harvests), nil
+}
+
+// load reads the stored densities, so regions outside the first batches keep theirs
+func (s *Service) load(ctx context.Context) error {
+	rows, err := s.db.ListRegionMaturity(ctx, s.world.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list region maturity: %w", err)
+	}
+	s.mu.Lock()
+	for _, row := range rows {
+		s.regions[region{row.RegionX, row.RegionY}] = maturity{density: row.Density, harvests: row.HarvestCount}
+	}
+	s.mu.Unlock()
+	s.loaded = true
+	return nil
+}
+
+// store upserts the updated regions on config.Workers goroutines
+func (s *Service) store(ctx context.Context, updates []db.UpsertRegionMaturityParams) error {
+	next := make(chan db.UpsertRegionMaturityParams)
+	errs := make([]error, max(1, s.config.Workers))
+	var wg sync.WaitGroup
+	for w := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for u := range next {
+				if errs[w] != nil {
+					continue
+				}
+				if err := s.db.UpsertRegionMaturity(ctx, u); err != nil {
+					errs[w] = fmt.Errorf("failed to store region maturity: %w", err)
+				}
+			}
+		}()
+	}
+	for _, u := range updates {
+		next <- u
+	}
+	close(next)
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// Run updates region densities every interval until ctx is cancelled
+func (s *Service) Run(ctx context.Context) {
+	for {
+		if _, err := s.Update(ctx); err != nil {
+			s.logger.Error("Region maturity update failed", "error", err)
+			alerting.ReportJobError("world_maturity", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.clock.After(s.config.Interval):
+		}
+	}
+}
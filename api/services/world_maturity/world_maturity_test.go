@@ -0,0 +1,238 @@
+package world_maturity
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDB serves fixed region harvest totals and keeps stored maturity rows
+type fakeDB struct {
+	mu        sync.Mutex
+	harvests  []db.SumChunkHarvestsByRegionRow
+	maturity  map[region]db.RegionMaturity
+	batches   []db.SumChunkHarvestsByRegionParams
+	upsertErr error
+}
+
+// SumChunkHarvestsByRegion pages through the harvests in (y, x) order like the query
+func (f *fakeDB) SumChunkHarvestsByRegion(ctx context.Context, arg db.SumChunkHarvestsByRegionParams) ([]db.SumChunkHarvestsByRegionRow, error) {
+	f.batches = append(f.batches, arg)
+	sorted := slices.Clone(f.harvests)
+	slices.SortStableFunc(sorted, func(a, b db.SumChunkHarvestsByRegionRow) int {
+		return cmp.Or(cmp.Compare(a.RegionY, b.RegionY), cmp.Compare(a.RegionX, b.RegionX))
+	})
+	var rows []db.SumChunkHarvestsByRegionRow
+	for _, h := range sorted {
+		after := cmp.Or(cmp.Compare(h.RegionY, arg.AfterRegionY), cmp.Compare(h.RegionX, arg.AfterRegionX)) > 0
+		if after && len(rows) < int(arg.RowLimit) {
+			rows = append(rows, h)
+		}
+	}
+	return rows, nil
+}
+
+func (f *fakeDB) ListRegionMaturity(ctx context.Context, worldID pgtype.UUID) ([]db.RegionMaturity, error) {
+	rows := make([]db.RegionMaturity, 0, len(f.maturity))
+	for _, row := range f.maturity {
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func (f *fakeDB) UpsertRegionMaturity(ctx context.Context, arg db.UpsertRegionMaturityParams) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.upsertErr != nil {
+		return f.upsertErr
+	}
+	f.maturity[region{arg.RegionX, arg.RegionY}] = db.RegionMaturity{
+		WorldID:      arg.WorldID,
+		RegionX:      arg.RegionX,
+		RegionY:      arg.RegionY,
+		Density:      arg.Density,
+		HarvestCount: arg.HarvestCount,
+		UpdatedAt:    arg.UpdatedAt,
+	}
+	return nil
+}
+
+var created = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func newTestService() (*Service, *fakeDB, *clock.Fake) {
+	database := &fakeDB{maturity: make(map[region]db.RegionMaturity)}
+	world := db.World{ID: pgtype.UUID{Bytes: [16]byte{9}, Valid: true}, CreatedAt: pgtype.Timestamp{Time: created, Valid: true}}
+	fake := clock.NewFake(created)
+	service := NewService(database, world, testmocks.NopLogger[LoggerInterface]{})
+	service.SetClock(fake)
+	return service, database, fake
+}
+
+func TestUpdate_DepletesAndRecovers(t *testing.T) {
+	service, database, _ := newTestService()
+	ctx := context.Background()
+	database.harvests = []db.SumChunkHarvestsByRegionRow{
+		{RegionX: 0, RegionY: 0, HarvestCount: 40, ChunkCount: 10}, // Heavily harvested
+		{RegionX: 1, RegionY: 0, HarvestCount: 5, ChunkCount: 10},  // Lightly harvested
+		{RegionX: -1, RegionY: 0, ChunkCount: 10},                  // Untouched
+	}
+
+	updated, err := service.Update(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, updated)
+	assert.InDelta(t, 0.95, service.DensityAt(0, 7), 1e-9)
+	assert.InDelta(t, 1.0, service.DensityAt(8, 0), 1e-9)
+	assert.InDelta(t, 1.01, service.DensityAt(-1, 0), 1e-9, "chunk -1 is in region -1")
+	assert.Equal(t, int64(40), database.maturity[region{0, 0}].HarvestCount)
+
+	// Only harvests since the last update count
+	database.harvests[0].HarvestCount = 45
+	_, err = service.Update(ctx)
+	require.NoError(t, err)
+	assert.InDelta(t, 0.95, service.DensityAt(0, 0), 1e-9, "5 new harvests over 10 chunks is not heavy")
+
+	database.harvests[0].HarvestCount = 45
+	for range 100 {
+		_, err = service.Update(ctx)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, service.config.MaxDensity, service.DensityAt(0, 0), "untouched regions recover up to the maximum")
+
+	for i := range 100 {
+		database.harvests[0].HarvestCount = 45 + int64(i+1)*20
+		_, err = service.Update(ctx)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, service.config.MinDensity, service.DensityAt(0, 0), "depletion stops at the minimum")
+}
+
+func TestUpdate_FrontierGrowsWithWorldAge(t *testing.T) {
+	service, database, fake := newTestService()
+	ctx := context.Background()
+
+	assert.Equal(t, 1.0, service.DensityAt(100, 100))
+
+	fake.Advance(10 * 24 * time.Hour)
+	_, err := service.Update(ctx)
+	require.NoError(t, err)
+	assert.InDelta(t, 1.2, service.DensityAt(100, 100), 1e-9)
+
+	// A region reached for the first time starts from the frontier density
+	database.harvests = []db.SumChunkHarvestsByRegionRow{{RegionX: 12, RegionY: 12, ChunkCount: 1}}
+	_, err = service.Update(ctx)
+	require.NoError(t, err)
+	assert.InDelta(t, 1.21, service.DensityAt(100, 100), 1e-9)
+
+	fake.Advance(1000 * 24 * time.Hour)
+	_, err = service.Update(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, service.config.MaxDensity, service.DensityAt(-500, 300))
+}
+
+func TestUpdate_LoadsStoredDensities(t *testing.T) {
+	service, database, _ := newTestService()
+	database.maturity[region{2, 3}] = db.RegionMaturity{RegionX: 2, RegionY: 3, Density: 0.7}
+
+	_, err := service.Update(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0.7, service.DensityAt(16, 24), "regions without chunk summaries keep their stored density")
+}
+
+// fakePauses pauses every world while set
+type fakePauses bool
+
+func (f *fakePauses) Paused(ctx context.Context, worldID pgtype.UUID) bool {
+	return bool(*f)
+}
+
+func TestUpdate_SkipsPausedWorld(t *testing.T) {
+	service, database, _ := newTestService()
+	database.harvests = []db.SumChunkHarvestsByRegionRow{{RegionX: 0, RegionY: 0, HarvestCount: 500, ChunkCount: 64}}
+	paused := fakePauses(true)
+	service.SetPauses(&paused)
+
+	updated, err := service.Update(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, updated)
+	assert.Empty(t, database.batches, "a paused world is not scanned")
+
+	paused = false
+	updated, err = service.Update(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, updated)
+}
+
+func TestUpdate_ResumesFromCursor(t *testing.T) {
+	service, database, _ := newTestService()
+	config := DefaultConfig()
+	config.BatchSize = 2
+	service.SetConfig(config)
+	ctx := context.Background()
+	for _, r := range []region{{0, 0}, {1, 0}, {-1, 1}, {0, 1}, {5, -3}} {
+		database.harvests = append(database.harvests, db.SumChunkHarvestsByRegionRow{RegionX: r.x, RegionY: r.y, ChunkCount: 1})
+	}
+
+	updated, err := service.Update(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, updated)
+	assert.Equal(t, []region{{5, -3}, {0, 0}}, storedRegions(database))
+
+	updated, err = service.Update(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, updated, "the second update resumes after (0, 0)")
+	assert.Equal(t, int32(0), database.batches[1].AfterRegionX)
+	assert.Equal(t, int32(0), database.batches[1].AfterRegionY)
+	assert.Equal(t, int32(0), database.batches[1].MinChunkY, "rows before the cursor's chunk row are skipped")
+
+	updated, err = service.Update(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, updated, "the last batch is short")
+	assert.Len(t, database.maturity, 5)
+	assert.Equal(t, int32(8), database.batches[2].MinChunkY)
+
+	_, err = service.Update(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, startCursor, region{database.batches[3].AfterRegionX, database.batches[3].AfterRegionY}, "after a short batch the next update starts over")
+	assert.InDelta(t, 1.02, service.DensityAt(40, -24), 1e-9, "a region recovers once per pass over the world")
+}
+
+func TestUpdate_FailedStoreKeepsCursor(t *testing.T) {
+	service, database, _ := newTestService()
+	config := DefaultConfig()
+	config.BatchSize = 1
+	service.SetConfig(config)
+	ctx := context.Background()
+	database.harvests = []db.SumChunkHarvestsByRegionRow{{RegionX: 0, RegionY: 0, ChunkCount: 1}, {RegionX: 1, RegionY: 0, ChunkCount: 1}}
+
+	database.upsertErr = errors.New("connection reset")
+	_, err := service.Update(ctx)
+	require.Error(t, err)
+	assert.Equal(t, 1.0, service.DensityAt(0, 0))
+
+	database.upsertErr = nil
+	_, err = service.Update(ctx)
+	require.NoError(t, err)
+	assert.InDelta(t, 1.01, service.DensityAt(0, 0), 1e-9, "the retry moves the density once")
+	assert.Equal(t, startCursor, region{database.batches[1].AfterRegionX, database.batches[1].AfterRegionY}, "the failed batch is retried")
+}
+
+// storedRegions lists the regions with a stored row in (y, x) order
+func storedRegions(database *fakeDB) []region {
+	regions := make([]region, 0, len(database.maturity))
+	for r := range database.maturity {
+		regions = append(regions, r)
+	}
+	slices.SortFunc(regions, func(a, b region) int { return cmp.Or(cmp.Compare(a.y, b.y), cmp.Compare(a.x, b.x)) })
+	return regions
+}
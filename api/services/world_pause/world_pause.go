@@ -0,0 +1,233 @@
+// Package world_pause lets admins freeze a single world, e.g. to investigate an exploit
+// or repair its data, without taking down every world on the instance. While a world is
+// paused, writes from non-admins in it are rejected and its background jobs skip it;
+// reads keep working. Pauses are stored in the database and every instance refreshes its
+// copy periodically.
+package world_pause
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/alerting"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	adminV1 "github.com/VoidMesh/api/api/proto/admin/v1"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// DefaultRefreshInterval is how often the pauses are reloaded
+	DefaultRefreshInterval = 10 * time.Second
+	MaxReasonLength        = 500
+)
+
+// ReasonWorldPaused is the ErrorInfo reason of writes rejected in a paused world
+const ReasonWorldPaused = "WORLD_PAUSED"
+
+const errorDomain = "world_pause.voidmesh"
+
+// Service stores world pauses and enforces them from an in-memory copy.
+type Service struct {
+	db       DatabaseInterface
+	logger   LoggerInterface
+	clock    clock.Clock
+	interval time.Duration
+
+	mu     sync.RWMutex
+	pauses map[string]db.WorldPause // By world ID without dashes
+	loaded bool
+}
+
+// NewService creates a new world pause service with dependency injection.
+func NewService(database DatabaseInterface, logger LoggerInterface) *Service {
+	componentLogger := logger.With("component", "world-pause-service")
+	componentLogger.Debug("Creating new world pause service")
+	return &Service{
+		db:       database,
+		logger:   componentLogger,
+		clock:    clock.New(),
+		interval: DefaultRefreshInterval,
+		pauses:   make(map[string]db.WorldPause),
+	}
+}
+
+// NewServiceWithPool creates a service with concrete implementations (convenience constructor for production use).
+func NewServiceWithPool(pool storage.Pool) *Service {
+	return NewService(NewDatabaseWrapper(pool), NewDefaultLoggerWrapper())
+}
+
+// SetClock replaces the clock used for timestamps and the refresh schedule (for simulation tests)
+func (s *Service) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// pause returns the pause of a world, loading the pauses on first use. If they cannot be
+// loaded every world stays open.
+func (s *Service) pause(ctx context.Context, worldID pgtype.UUID) (db.WorldPause, bool) {
+	s.mu.RLock()
+	loaded := s.loaded
+	s.mu.RUnlock()
+	if !loaded {
+		if err := s.Refresh(ctx); err != nil {
+			s.logger.Error("Failed to load world pauses", "error", err)
+		}
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	pause, ok := s.pauses[uuid.PgtypeToNormalizedString(worldID)]
+	return pause, ok
+}
+
+// Paused reports whether a world is paused; background jobs skip paused worlds
+func (s *Service) Paused(ctx context.Context, worldID pgtype.UUID) bool {
+	_, paused := s.pause(ctx, worldID)
+	return paused
+}
+
+// CheckWorldWrite returns a typed Unavailable error while the world is paused
+func (s *Service) CheckWorldWrite(ctx context.Context, worldID string) error {
+	id, err := uuid.StringToPgtype(worldID)
+	if err != nil {
+		return nil
+	}
+	pause, paused := s.pause(ctx, id)
+	if !paused {
+		return nil
+	}
+	st := status.New(codes.Unavailable, "the world is paused")
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   ReasonWorldPaused,
+		Domain:   errorDomain,
+		Metadata: map[string]string{"world_id": worldID, "message": pause.Reason},
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// Refresh reloads the pauses from the database
+func (s *Service) Refresh(ctx context.Context) error {
+	rows, err := s.db.ListWorldPauses(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list world pauses: %w", err)
+	}
+	pauses := make(map[string]db.WorldPause, len(rows))
+	for _, row := range rows {
+		pauses[uuid.PgtypeToNormalizedString(row.WorldID)] = row
+	}
+	s.mu.Lock()
+	s.pauses = pauses
+	s.loaded = true
+	s.mu.Unlock()
+	return nil
+}
+
+// Run refreshes the pauses until the context is cancelled
+func (s *Service) Run(ctx context.Context) {
+	s.logger.Info("World pause refresh started", "interval", s.interval)
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("World pause refresh stopped")
+			return
+		case <-s.clock.After(s.interval):
+		}
+
+		if err := s.Refresh(ctx); err != nil {
+			s.logger.Error("World pause refresh failed", "error", err)
+			alerting.ReportJobError("world_pause_refresh", err)
+		}
+	}
+}
+
+// Pause freezes a world. Pausing a paused world replaces the reason.
+func (s *Service) Pause(ctx context.Context, pausedBy string, req *adminV1.PauseWorldRequest) (*adminV1.WorldPause, error) {
+	worldID, err := uuid.StringToPgtype(req.WorldId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid world ID format")
+	}
+	if utf8.RuneCountInString(req.Reason) > MaxReasonLength {
+		return nil, status.Errorf(codes.InvalidArgument, "reason must be at most %d characters", MaxReasonLength)
+	}
+	pausedByID, err := uuid.StringToPgtype(pausedBy)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID format")
+	}
+
+	row, err := s.db.UpsertWorldPause(ctx, db.UpsertWorldPauseParams{
+		WorldID:  worldID,
+		Reason:   req.Reason,
+		PausedBy: pausedByID,
+		PausedAt: pgtype.Timestamp{Time: s.clock.Now(), Valid: true},
+	})
+	if err != nil {
+		s.logger.Error("Failed to pause world", "world_id", req.WorldId, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to pause world")
+	}
+	// Other instances pick the change up on their next refresh
+	s.mu.Lock()
+	s.pauses[uuid.PgtypeToNormalizedString(worldID)] = row
+	s.mu.Unlock()
+	s.logger.Info("World paused", "world_id", req.WorldId, "reason", req.Reason, "paused_by", pausedBy)
+	return pauseToProto(row), nil
+}
+
+// Resume unfreezes a world and reports whether it was paused
+func (s *Service) Resume(ctx context.Context, worldID string, resumedBy string) (bool, error) {
+	id, err := uuid.StringToPgtype(worldID)
+	if err != nil {
+		return false, status.Errorf(codes.InvalidArgument, "invalid world ID format")
+	}
+	deleted, err := s.db.DeleteWorldPause(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to resume world", "world_id", worldID, "error", err)
+		return false, status.Errorf(codes.Internal, "failed to resume world")
+	}
+	s.mu.Lock()
+	delete(s.pauses, uuid.PgtypeToNormalizedString(id))
+	s.mu.Unlock()
+	if deleted > 0 {
+		s.logger.Info("World resumed", "world_id", worldID, "resumed_by", resumedBy)
+	}
+	return deleted > 0, nil
+}
+
+// List returns the paused worlds from the database, oldest pause first
+func (s *Service) List(ctx context.Context) ([]*adminV1.WorldPause, error) {
+	rows, err := s.db.ListWorldPauses(ctx)
+	if err != nil {
+		s.logger.Error("Failed to list world pauses", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list world pauses")
+	}
+	pauses := make([]*adminV1.WorldPause, 0, len(rows))
+	for _, row := range rows {
+		pauses = append(pauses, pauseToProto(row))
+	}
+	return pauses, nil
+}
+
+func pauseToProto(row db.WorldPause) *adminV1.WorldPause {
+	proto := &adminV1.WorldPause{
+		WorldId: uuid.PgtypeToString(row.WorldID),
+		Reason:  row.Reason,
+	}
+	if row.PausedBy.Valid {
+		proto.PausedBy = uuid.PgtypeToString(row.PausedBy)
+	}
+	if row.PausedAt.Valid {
+		proto.PausedAt = timestamppb.New(row.PausedAt.Time)
+	}
+	return proto
+}
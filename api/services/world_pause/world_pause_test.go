@@ -0,0 +1,134 @@
+package world_pause
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	adminV1 "github.com/VoidMesh/api/api/proto/admin/v1"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeDB keeps pauses in memory
+type fakeDB struct {
+	rows    map[pgtype.UUID]db.WorldPause
+	lists   int
+	listErr error
+}
+
+func (f *fakeDB) ListWorldPauses(ctx context.Context) ([]db.WorldPause, error) {
+	f.lists++
+	if f.listErr != nil {
+		return nil, f.listErr
+	}
+	var rows []db.WorldPause
+	for _, row := range f.rows {
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func (f *fakeDB) UpsertWorldPause(ctx context.Context, arg db.UpsertWorldPauseParams) (db.WorldPause, error) {
+	row := db.WorldPause{WorldID: arg.WorldID, Reason: arg.Reason, PausedBy: arg.PausedBy, PausedAt: arg.PausedAt}
+	f.rows[arg.WorldID] = row
+	return row, nil
+}
+
+func (f *fakeDB) DeleteWorldPause(ctx context.Context, worldID pgtype.UUID) (int64, error) {
+	if _, ok := f.rows[worldID]; !ok {
+		return 0, nil
+	}
+	delete(f.rows, worldID)
+	return 1, nil
+}
+
+const (
+	worldID = "650e8400-e29b-41d4-a716-446655440000"
+	otherID = "750e8400-e29b-41d4-a716-446655440000"
+	adminID = "11111111-1111-1111-1111-111111111111"
+)
+
+func TestService_PauseAndResume(t *testing.T) {
+	database := &fakeDB{rows: map[pgtype.UUID]db.WorldPause{}}
+	service := NewService(database, testmocks.NopLogger[LoggerInterface]{})
+	ctx := context.Background()
+	world, _ := uuid.StringToPgtype(worldID)
+	other, _ := uuid.StringToPgtype(otherID)
+
+	assert.False(t, service.Paused(ctx, world), "loaded on first use")
+	assert.NoError(t, service.CheckWorldWrite(ctx, worldID))
+
+	pause, err := service.Pause(ctx, adminID, &adminV1.PauseWorldRequest{WorldId: worldID, Reason: "duplication exploit"})
+	require.NoError(t, err)
+	assert.Equal(t, adminID, pause.PausedBy)
+	assert.NotNil(t, pause.PausedAt)
+	assert.True(t, service.Paused(ctx, world), "pauses apply on this instance at once")
+	assert.False(t, service.Paused(ctx, other), "other worlds keep running")
+	assert.NoError(t, service.CheckWorldWrite(ctx, otherID))
+	assert.Equal(t, 1, database.lists)
+
+	err = service.CheckWorldWrite(ctx, worldID)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	var info *errdetails.ErrorInfo
+	for _, detail := range status.Convert(err).Details() {
+		if i, ok := detail.(*errdetails.ErrorInfo); ok {
+			info = i
+		}
+	}
+	require.NotNil(t, info)
+	assert.Equal(t, ReasonWorldPaused, info.Reason)
+	assert.Equal(t, "duplication exploit", info.Metadata["message"])
+
+	pauses, err := service.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, pauses, 1)
+	assert.Equal(t, worldID, pauses[0].WorldId)
+
+	resumed, err := service.Resume(ctx, worldID, adminID)
+	require.NoError(t, err)
+	assert.True(t, resumed)
+	assert.False(t, service.Paused(ctx, world))
+	resumed, err = service.Resume(ctx, worldID, adminID)
+	require.NoError(t, err)
+	assert.False(t, resumed, "the world was no longer paused")
+}
+
+func TestService_Pause_Validation(t *testing.T) {
+	service := NewService(&fakeDB{rows: map[pgtype.UUID]db.WorldPause{}}, testmocks.NopLogger[LoggerInterface]{})
+	ctx := context.Background()
+
+	_, err := service.Pause(ctx, adminID, &adminV1.PauseWorldRequest{WorldId: "not-a-uuid"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = service.Pause(ctx, adminID, &adminV1.PauseWorldRequest{WorldId: worldID, Reason: strings.Repeat("x", MaxReasonLength+1)})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = service.Resume(ctx, "not-a-uuid", adminID)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestService_Refresh(t *testing.T) {
+	world, _ := uuid.StringToPgtype(worldID)
+	database := &fakeDB{rows: map[pgtype.UUID]db.WorldPause{}, listErr: errors.New("connection refused")}
+	service := NewService(database, testmocks.NopLogger[LoggerInterface]{})
+	ctx := context.Background()
+
+	assert.False(t, service.Paused(ctx, world), "worlds stay open when the pauses cannot be loaded")
+
+	// Another instance pauses the world
+	database.listErr = nil
+	database.rows[world] = db.WorldPause{WorldID: world, Reason: "maintenance"}
+	require.NoError(t, service.Refresh(ctx))
+	assert.True(t, service.Paused(ctx, world))
+
+	delete(database.rows, world)
+	require.NoError(t, service.Refresh(ctx))
+	assert.False(t, service.Paused(ctx, world), "resumes on other instances are picked up too")
+}
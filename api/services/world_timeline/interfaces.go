@@ -0,0 +1,98 @@
+package world_timeline
+
+import (
+	"context"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/logging"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/charmbracelet/log"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DatabaseInterface abstracts database operations for the world timeline.
+type DatabaseInterface interface {
+	GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error)
+	CountMarketSalesUpTo(ctx context.Context, arg db.CountMarketSalesUpToParams) (db.CountMarketSalesUpToRow, error)
+	CreateWorldTimelineEntry(ctx context.Context, arg db.CreateWorldTimelineEntryParams) error
+	ListWorldTimeline(ctx context.Context, arg db.ListWorldTimelineParams) ([]db.WorldTimeline, error)
+	ListWorldTimelineAfter(ctx context.Context, arg db.ListWorldTimelineAfterParams) ([]db.WorldTimeline, error)
+	ListAllWorldTimelineAfter(ctx context.Context, arg db.ListAllWorldTimelineAfterParams) ([]db.WorldTimeline, error)
+	GetLatestWorldTimelineID(ctx context.Context) (int64, error)
+}
+
+// DatabaseWrapper implements DatabaseInterface using the actual database connection.
+type DatabaseWrapper struct {
+	queries *db.Queries
+}
+
+// NewDatabaseWrapper creates a new database wrapper with the given connection pool.
+func NewDatabaseWrapper(pool storage.Pool) DatabaseInterface {
+	return &DatabaseWrapper{queries: db.New(pool)}
+}
+
+func (d *DatabaseWrapper) GetCharacterById(ctx context.Context, id pgtype.UUID) (db.Character, error) {
+	return d.queries.GetCharacterById(ctx, id)
+}
+
+func (d *DatabaseWrapper) CountMarketSalesUpTo(ctx context.Context, arg db.CountMarketSalesUpToParams) (db.CountMarketSalesUpToRow, error) {
+	return d.queries.CountMarketSalesUpTo(ctx, arg)
+}
+
+func (d *DatabaseWrapper) CreateWorldTimelineEntry(ctx context.Context, arg db.CreateWorldTimelineEntryParams) error {
+	return d.queries.CreateWorldTimelineEntry(ctx, arg)
+}
+
+func (d *DatabaseWrapper) ListWorldTimeline(ctx context.Context, arg db.ListWorldTimelineParams) ([]db.WorldTimeline, error) {
+	return d.queries.ListWorldTimeline(ctx, arg)
+}
+
+func (d *DatabaseWrapper) ListWorldTimelineAfter(ctx context.Context, arg db.ListWorldTimelineAfterParams) ([]db.WorldTimeline, error) {
+	return d.queries.ListWorldTimelineAfter(ctx, arg)
+}
+
+func (d *DatabaseWrapper) ListAllWorldTimelineAfter(ctx context.Context, arg db.ListAllWorldTimelineAfterParams) ([]db.WorldTimeline, error) {
+	return d.queries.ListAllWorldTimelineAfter(ctx, arg)
+}
+
+func (d *DatabaseWrapper) GetLatestWorldTimelineID(ctx context.Context) (int64, error) {
+	return d.queries.GetLatestWorldTimelineID(ctx)
+}
+
+// LoggerInterface abstracts logging operations for dependency injection.
+type LoggerInterface interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+	With(keysAndValues ...interface{}) LoggerInterface
+}
+
+// DefaultLoggerWrapper wraps the internal logging package.
+type DefaultLoggerWrapper struct {
+	logger *log.Logger
+}
+
+func NewDefaultLoggerWrapper() LoggerInterface {
+	return &DefaultLoggerWrapper{logger: logging.GetLogger()}
+}
+
+func (l *DefaultLoggerWrapper) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.Debug(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Info(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.Warn(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.Error(msg, keysAndValues...)
+}
+
+func (l *DefaultLoggerWrapper) With(keysAndValues ...interface{}) LoggerInterface {
+	return &DefaultLoggerWrapper{logger: l.logger.With(keysAndValues...)}
+}
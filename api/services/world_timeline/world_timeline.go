@@ -0,0 +1,446 @@
+// Package world_timeline maintains the world_timeline read model: each world's shared
+// history of rare events, first discoveries of regions and market milestones, projected
+// from domain events. Players page through it and stream it as a ticker; every instance
+// polls for new entries so streams see entries recorded by any instance.
+package world_timeline
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/alerting"
+	"github.com/VoidMesh/api/api/internal/clock"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/geometry"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/storage"
+	"github.com/VoidMesh/api/api/internal/userstream"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	timelineV1 "github.com/VoidMesh/api/api/proto/timeline/v1"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	DefaultListLimit = 50
+	MaxListLimit     = 200
+
+	// DefaultPollInterval is how often new entries are pushed to streams
+	DefaultPollInterval = 2 * time.Second
+	// RegionSize is the side of a region in chunks, as in world_maturity
+	RegionSize = 8
+	// FirstMilestone is the first market sale count recorded; later milestones are every
+	// tenfold of it
+	FirstMilestone = 100
+	// streamBufferSize is how many entries a slow stream may fall behind before missing some
+	streamBufferSize = 64
+)
+
+// kindNames maps entry types to their stored names
+var kindNames = map[timelineV1.TimelineEntryType]string{
+	timelineV1.TimelineEntryType_TIMELINE_ENTRY_TYPE_RARE_EVENT_SPAWNED:   "rare_event_spawned",
+	timelineV1.TimelineEntryType_TIMELINE_ENTRY_TYPE_RARE_EVENT_COMPLETED: "rare_event_completed",
+	timelineV1.TimelineEntryType_TIMELINE_ENTRY_TYPE_REGION_DISCOVERED:    "region_discovered",
+	timelineV1.TimelineEntryType_TIMELINE_ENTRY_TYPE_MARKET_FIRST_SALE:    "market_first_sale",
+	timelineV1.TimelineEntryType_TIMELINE_ENTRY_TYPE_MARKET_MILESTONE:     "market_milestone",
+}
+
+// Service projects events into the world timeline and serves it.
+type Service struct {
+	db           DatabaseInterface
+	logger       LoggerInterface
+	clock        clock.Clock
+	streams      *userstream.Hub[*timelineV1.TimelineEntry] // By world ID
+	pollInterval time.Duration
+
+	pollMu sync.Mutex
+	pollID int64 // The last entry pushed to streams
+	polled bool
+}
+
+// NewService creates a new world timeline service with dependency injection.
+func NewService(database DatabaseInterface, logger LoggerInterface) *Service {
+	componentLogger := logger.With("component", "world-timeline-service")
+	componentLogger.Debug("Creating new world timeline service")
+	return &Service{
+		db:           database,
+		logger:       componentLogger,
+		clock:        clock.New(),
+		streams:      userstream.NewHub[*timelineV1.TimelineEntry](),
+		pollInterval: DefaultPollInterval,
+	}
+}
+
+// NewServiceWithPool creates a service with concrete implementations (convenience constructor for production use).
+func NewServiceWithPool(pool storage.Pool) *Service {
+	return NewService(NewDatabaseWrapper(pool), NewDefaultLoggerWrapper())
+}
+
+// SetClock replaces the clock used for the poll schedule (for simulation tests)
+func (s *Service) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// Streams returns the number of open timeline streams on this instance
+func (s *Service) Streams() int {
+	return s.streams.Len()
+}
+
+// Subscribe records timeline entries from the events that make history
+func (s *Service) Subscribe(bus *events.Bus) {
+	bus.Subscribe(events.RareEventSpawned, events.Dedup(s.handleRareEventSpawned, events.DefaultDedupCapacity))
+	bus.Subscribe(events.RareEventCompleted, events.Dedup(s.handleRareEventCompleted, events.DefaultDedupCapacity))
+	bus.Subscribe(events.ChunkGenerated, events.Dedup(s.handleChunkGenerated, events.DefaultDedupCapacity))
+	bus.Subscribe(events.MarketSaleCompleted, events.Dedup(s.handleMarketSaleCompleted, events.DefaultDedupCapacity))
+}
+
+func (s *Service) handleRareEventSpawned(ctx context.Context, event events.Event) error {
+	var payload events.RareEventPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("invalid %s payload: %w", event.Type, err)
+	}
+	return s.record(ctx, payload.WorldID, timelineV1.TimelineEntryType_TIMELINE_ENTRY_TYPE_RARE_EVENT_SPAWNED,
+		rareEventDetails(payload), event.DedupKey, event.OccurredAt)
+}
+
+// handleRareEventCompleted records who completed the event; characters deleted since are
+// left out
+func (s *Service) handleRareEventCompleted(ctx context.Context, event events.Event) error {
+	var payload events.RareEventPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("invalid %s payload: %w", event.Type, err)
+	}
+	details := rareEventDetails(payload)
+	if id, err := uuid.StringToPgtype(payload.CharacterID); err == nil {
+		character, err := s.db.GetCharacterById(ctx, id)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("failed to get character %s: %w", payload.CharacterID, err)
+		}
+		if err == nil {
+			details["character_id"] = payload.CharacterID
+			details["character_name"] = character.Name
+		}
+	}
+	return s.record(ctx, payload.WorldID, timelineV1.TimelineEntryType_TIMELINE_ENTRY_TYPE_RARE_EVENT_COMPLETED,
+		details, event.DedupKey, event.OccurredAt)
+}
+
+// handleChunkGenerated records the discovery of the chunk's region. Every chunk of the
+// region tries; the dedup key keeps the first.
+func (s *Service) handleChunkGenerated(ctx context.Context, event events.Event) error {
+	var payload events.ChunkGeneratedPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("invalid %s payload: %w", event.Type, err)
+	}
+	regionX := geometry.FloorDiv(payload.ChunkX, RegionSize)
+	regionY := geometry.FloorDiv(payload.ChunkY, RegionSize)
+	return s.record(ctx, payload.WorldID, timelineV1.TimelineEntryType_TIMELINE_ENTRY_TYPE_REGION_DISCOVERED, map[string]string{
+		"region_x": strconv.Itoa(int(regionX)),
+		"region_y": strconv.Itoa(int(regionY)),
+		"chunk_x":  strconv.Itoa(int(payload.ChunkX)),
+		"chunk_y":  strconv.Itoa(int(payload.ChunkY)),
+	}, fmt.Sprintf("region_discovered:%s:%d:%d", uuid.Normalize(payload.WorldID), regionX, regionY), event.OccurredAt)
+}
+
+// handleMarketSaleCompleted records the first sale of an item in the world and the
+// world's milestone sales
+func (s *Service) handleMarketSaleCompleted(ctx context.Context, event events.Event) error {
+	var payload events.MarketSaleCompletedPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("invalid %s payload: %w", event.Type, err)
+	}
+	worldID, err := uuid.StringToPgtype(payload.WorldID)
+	if err != nil {
+		return fmt.Errorf("invalid world ID %q: %w", payload.WorldID, err)
+	}
+	counts, err := s.db.CountMarketSalesUpTo(ctx, db.CountMarketSalesUpToParams{
+		WorldID: worldID,
+		ItemID:  payload.ItemID,
+		SaleID:  payload.SaleID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to count market sales: %w", err)
+	}
+
+	world := uuid.Normalize(payload.WorldID)
+	var errs []error
+	if counts.ItemSales == 1 {
+		errs = append(errs, s.record(ctx, payload.WorldID, timelineV1.TimelineEntryType_TIMELINE_ENTRY_TYPE_MARKET_FIRST_SALE, map[string]string{
+			"sale_id":    strconv.FormatInt(payload.SaleID, 10),
+			"item_id":    strconv.Itoa(int(payload.ItemID)),
+			"item_name":  payload.ItemName,
+			"quantity":   strconv.Itoa(int(payload.Quantity)),
+			"unit_price": strconv.Itoa(int(payload.UnitPrice)),
+		}, fmt.Sprintf("market_first_sale:%s:%d", world, payload.ItemID), event.OccurredAt))
+	}
+	if IsMilestone(counts.Sales) {
+		errs = append(errs, s.record(ctx, payload.WorldID, timelineV1.TimelineEntryType_TIMELINE_ENTRY_TYPE_MARKET_MILESTONE, map[string]string{
+			"sale_id":   strconv.FormatInt(payload.SaleID, 10),
+			"sales":     strconv.FormatInt(counts.Sales, 10),
+			"item_id":   strconv.Itoa(int(payload.ItemID)),
+			"item_name": payload.ItemName,
+		}, fmt.Sprintf("market_milestone:%s:%d", world, counts.Sales), event.OccurredAt))
+	}
+	return errors.Join(errs...)
+}
+
+// IsMilestone reports whether a world's sales count is FirstMilestone or a tenfold of it
+func IsMilestone(sales int64) bool {
+	if sales < FirstMilestone {
+		return false
+	}
+	for sales > FirstMilestone {
+		if sales%10 != 0 {
+			return false
+		}
+		sales /= 10
+	}
+	return sales == FirstMilestone
+}
+
+func rareEventDetails(payload events.RareEventPayload) map[string]string {
+	return map[string]string{
+		"rare_event_id": strconv.FormatInt(payload.RareEventID, 10),
+		"kind":          payload.Kind,
+		"x":             strconv.Itoa(int(payload.X)),
+		"y":             strconv.Itoa(int(payload.Y)),
+		"chunk_x":       strconv.Itoa(int(payload.ChunkX)),
+		"chunk_y":       strconv.Itoa(int(payload.ChunkY)),
+	}
+}
+
+func (s *Service) record(ctx context.Context, worldID string, kind timelineV1.TimelineEntryType, details map[string]string, dedupKey string, occurredAt time.Time) error {
+	id, err := uuid.StringToPgtype(worldID)
+	if err != nil {
+		return fmt.Errorf("invalid world ID %q: %w", worldID, err)
+	}
+	data, err := json.Marshal(details)
+	if err != nil {
+		return fmt.Errorf("failed to encode timeline details: %w", err)
+	}
+	err = s.db.CreateWorldTimelineEntry(ctx, db.CreateWorldTimelineEntryParams{
+		WorldID:    id,
+		Kind:       kindNames[kind],
+		Details:    data,
+		DedupKey:   dedupKey,
+		OccurredAt: pgtype.Timestamp{Time: occurredAt, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record %s timeline entry: %w", kindNames[kind], err)
+	}
+	return nil
+}
+
+// resolveWorld parses worldID, defaulting to the caller's session world
+func resolveWorld(ctx context.Context, worldID string) (pgtype.UUID, error) {
+	if worldID == "" {
+		sessionWorldID, ok := session.WorldIDFromContext(ctx)
+		if !ok {
+			return pgtype.UUID{}, status.Errorf(codes.InvalidArgument, "world_id is required")
+		}
+		worldID = sessionWorldID
+	}
+	id, err := uuid.StringToPgtype(worldID)
+	if err != nil {
+		return pgtype.UUID{}, status.Errorf(codes.InvalidArgument, "invalid world ID format")
+	}
+	return id, nil
+}
+
+// List returns a world's entries before beforeID (0 for the newest), newest first
+func (s *Service) List(ctx context.Context, worldID string, beforeID int64, limit int32) ([]*timelineV1.TimelineEntry, error) {
+	world, err := resolveWorld(ctx, worldID)
+	if err != nil {
+		return nil, err
+	}
+	if beforeID < 0 || limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "before_id and limit must not be negative")
+	}
+	if beforeID == 0 {
+		beforeID = math.MaxInt64
+	}
+	if limit == 0 {
+		limit = DefaultListLimit
+	}
+	if limit > MaxListLimit {
+		limit = MaxListLimit
+	}
+
+	rows, err := s.db.ListWorldTimeline(ctx, db.ListWorldTimelineParams{
+		WorldID:    world,
+		BeforeID:   beforeID,
+		MaxEntries: limit,
+	})
+	if err != nil {
+		s.logger.Error("Failed to list world timeline", "world_id", uuid.PgtypeToString(world), "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list world timeline")
+	}
+	return s.entriesToProto(rows)
+}
+
+// SubscribeWorld opens a stream of a world's new entries and returns the stored entries
+// after afterID, oldest first, to send before them. Live entries can repeat the last
+// stored ones; callers skip IDs they have sent. afterID 0 returns no stored entries.
+func (s *Service) SubscribeWorld(ctx context.Context, worldID string, afterID int64) ([]*timelineV1.TimelineEntry, <-chan *timelineV1.TimelineEntry, func(), error) {
+	world, err := resolveWorld(ctx, worldID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if afterID < 0 {
+		return nil, nil, nil, status.Errorf(codes.InvalidArgument, "after_id must not be negative")
+	}
+
+	// Subscribe before reading the backlog so nothing recorded in between is missed
+	sub := s.streams.Subscribe(uuid.PgtypeToString(world), streamBufferSize)
+	unsubscribe := func() { s.streams.Unsubscribe(sub) }
+	var backlog []*timelineV1.TimelineEntry
+	for afterID > 0 {
+		rows, err := s.db.ListWorldTimelineAfter(ctx, db.ListWorldTimelineAfterParams{
+			WorldID:    world,
+			AfterID:    afterID,
+			MaxEntries: MaxListLimit,
+		})
+		if err != nil {
+			unsubscribe()
+			s.logger.Error("Failed to list world timeline", "world_id", uuid.PgtypeToString(world), "error", err)
+			return nil, nil, nil, status.Errorf(codes.Internal, "failed to list world timeline")
+		}
+		entries, err := s.entriesToProto(rows)
+		if err != nil {
+			unsubscribe()
+			return nil, nil, nil, err
+		}
+		backlog = append(backlog, entries...)
+		if len(rows) < MaxListLimit {
+			break
+		}
+		afterID = rows[len(rows)-1].ID
+	}
+	return backlog, sub.C, unsubscribe, nil
+}
+
+// Run pushes new entries to streams every poll interval until ctx is cancelled
+func (s *Service) Run(ctx context.Context) {
+	s.logger.Info("World timeline polling started", "interval", s.pollInterval)
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("World timeline polling stopped")
+			return
+		case <-s.clock.After(s.pollInterval):
+		}
+
+		if err := s.Poll(ctx); err != nil {
+			s.logger.Error("World timeline polling failed", "error", err)
+			alerting.ReportJobError("world_timeline_poll", err)
+		}
+	}
+}
+
+// Poll pushes the entries stored since the last poll, by any instance, to the streams of
+// their worlds. The first poll only records where to start.
+func (s *Service) Poll(ctx context.Context) error {
+	s.pollMu.Lock()
+	defer s.pollMu.Unlock()
+
+	if !s.polled {
+		latest, err := s.db.GetLatestWorldTimelineID(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get latest timeline entry: %w", err)
+		}
+		s.pollID = latest
+		s.polled = true
+		return nil
+	}
+
+	for {
+		rows, err := s.db.ListAllWorldTimelineAfter(ctx, db.ListAllWorldTimelineAfterParams{
+			AfterID:    s.pollID,
+			MaxEntries: MaxListLimit,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list timeline entries: %w", err)
+		}
+		for _, row := range rows {
+			entry, err := dbEntryToProto(row)
+			if err != nil {
+				s.logger.Error("Failed to decode timeline entry", "entry_id", row.ID, "error", err)
+			} else {
+				s.streams.Publish(entry.WorldId, entry)
+			}
+			s.pollID = row.ID
+		}
+		if len(rows) < MaxListLimit {
+			return nil
+		}
+	}
+}
+
+func (s *Service) entriesToProto(rows []db.WorldTimeline) ([]*timelineV1.TimelineEntry, error) {
+	entries := make([]*timelineV1.TimelineEntry, 0, len(rows))
+	for _, row := range rows {
+		entry, err := dbEntryToProto(row)
+		if err != nil {
+			s.logger.Error("Failed to decode timeline entry", "entry_id", row.ID, "error", err)
+			return nil, status.Errorf(codes.Internal, "failed to decode world timeline")
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func dbEntryToProto(row db.WorldTimeline) (*timelineV1.TimelineEntry, error) {
+	var details map[string]string
+	if err := json.Unmarshal(row.Details, &details); err != nil {
+		return nil, fmt.Errorf("failed to decode timeline details: %w", err)
+	}
+	entry := &timelineV1.TimelineEntry{
+		Id:         row.ID,
+		WorldId:    uuid.PgtypeToString(row.WorldID),
+		Details:    details,
+		OccurredAt: timestamppb.New(row.OccurredAt.Time),
+	}
+	for kind, name := range kindNames {
+		if name == row.Kind {
+			entry.Type = kind
+		}
+	}
+	entry.Summary = summary(entry.Type, details)
+	return entry, nil
+}
+
+// summary describes an entry in a line
+func summary(kind timelineV1.TimelineEntryType, details map[string]string) string {
+	switch kind {
+	case timelineV1.TimelineEntryType_TIMELINE_ENTRY_TYPE_RARE_EVENT_SPAWNED:
+		return fmt.Sprintf("A %s appeared at (%s, %s)", kindName(details["kind"]), details["x"], details["y"])
+	case timelineV1.TimelineEntryType_TIMELINE_ENTRY_TYPE_RARE_EVENT_COMPLETED:
+		if name := details["character_name"]; name != "" {
+			return fmt.Sprintf("%s completed the %s at (%s, %s)", name, kindName(details["kind"]), details["x"], details["y"])
+		}
+		return fmt.Sprintf("The %s at (%s, %s) was completed", kindName(details["kind"]), details["x"], details["y"])
+	case timelineV1.TimelineEntryType_TIMELINE_ENTRY_TYPE_REGION_DISCOVERED:
+		return fmt.Sprintf("Region (%s, %s) was discovered", details["region_x"], details["region_y"])
+	case timelineV1.TimelineEntryType_TIMELINE_ENTRY_TYPE_MARKET_FIRST_SALE:
+		return fmt.Sprintf("The first %s was sold on the market for %s each", details["item_name"], details["unit_price"])
+	case timelineV1.TimelineEntryType_TIMELINE_ENTRY_TYPE_MARKET_MILESTONE:
+		return fmt.Sprintf("The market made its %sth sale", details["sales"])
+	}
+	return ""
+}
+
+// kindName turns a stored rare event kind such as treasure_chest into words
+func kindName(kind string) string {
+	return strings.ReplaceAll(kind, "_", " ")
+}
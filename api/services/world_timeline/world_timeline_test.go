@@ -0,0 +1,218 @@
+package world_timeline
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	"github.com/VoidMesh/api/api/internal/events"
+	"github.com/VoidMesh/api/api/internal/session"
+	"github.com/VoidMesh/api/api/internal/testmocks"
+	"github.com/VoidMesh/api/api/internal/uuid"
+	timelineV1 "github.com/VoidMesh/api/api/proto/timeline/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeDB keeps characters, market sale counts and the timeline in memory
+type fakeDB struct {
+	testmocks.Characters
+	sales   map[int64]db.CountMarketSalesUpToRow // By sale ID
+	entries []db.WorldTimeline
+}
+
+func (f *fakeDB) CountMarketSalesUpTo(ctx context.Context, arg db.CountMarketSalesUpToParams) (db.CountMarketSalesUpToRow, error) {
+	return f.sales[arg.SaleID], nil
+}
+
+func (f *fakeDB) CreateWorldTimelineEntry(ctx context.Context, arg db.CreateWorldTimelineEntryParams) error {
+	for _, row := range f.entries {
+		if row.DedupKey == arg.DedupKey {
+			return nil
+		}
+	}
+	f.entries = append(f.entries, db.WorldTimeline{
+		ID:         int64(len(f.entries) + 1),
+		WorldID:    arg.WorldID,
+		Kind:       arg.Kind,
+		Details:    arg.Details,
+		DedupKey:   arg.DedupKey,
+		OccurredAt: arg.OccurredAt,
+	})
+	return nil
+}
+
+func (f *fakeDB) ListWorldTimeline(ctx context.Context, arg db.ListWorldTimelineParams) ([]db.WorldTimeline, error) {
+	var rows []db.WorldTimeline
+	for i := len(f.entries) - 1; i >= 0 && len(rows) < int(arg.MaxEntries); i-- {
+		if row := f.entries[i]; row.WorldID == arg.WorldID && row.ID < arg.BeforeID {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+func (f *fakeDB) ListWorldTimelineAfter(ctx context.Context, arg db.ListWorldTimelineAfterParams) ([]db.WorldTimeline, error) {
+	var rows []db.WorldTimeline
+	for _, row := range f.entries {
+		if row.WorldID == arg.WorldID && row.ID > arg.AfterID && len(rows) < int(arg.MaxEntries) {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+func (f *fakeDB) ListAllWorldTimelineAfter(ctx context.Context, arg db.ListAllWorldTimelineAfterParams) ([]db.WorldTimeline, error) {
+	var rows []db.WorldTimeline
+	for _, row := range f.entries {
+		if row.ID > arg.AfterID && len(rows) < int(arg.MaxEntries) {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+func (f *fakeDB) GetLatestWorldTimelineID(ctx context.Context) (int64, error) {
+	return int64(len(f.entries)), nil
+}
+
+const (
+	worldID      = "650e8400-e29b-41d4-a716-446655440000"
+	otherWorldID = "750e8400-e29b-41d4-a716-446655440000"
+	characterID  = "00000000-0000-0000-0000-0000000000c1"
+)
+
+func publish(t *testing.T, bus *events.Bus, eventType, key string, payload any) {
+	t.Helper()
+	data, err := json.Marshal(payload)
+	require.NoError(t, err)
+	event := events.Event{Type: eventType, DedupKey: key, Payload: data, OccurredAt: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)}
+	require.NoError(t, bus.Publish(context.Background(), event))
+}
+
+func newTestService(t *testing.T) (*Service, *fakeDB, *events.Bus) {
+	character, err := uuid.StringToPgtype(characterID)
+	require.NoError(t, err)
+	database := &fakeDB{
+		Characters: testmocks.NewCharacters(db.Character{ID: character, Name: "Ada"}),
+		sales:      make(map[int64]db.CountMarketSalesUpToRow),
+	}
+	service := NewService(database, testmocks.NopLogger[LoggerInterface]{})
+	bus := events.NewBus()
+	service.Subscribe(bus)
+	return service, database, bus
+}
+
+func TestSubscribe_RecordsHistory(t *testing.T) {
+	service, database, bus := newTestService(t)
+	ctx := session.WithWorldID(context.Background(), worldID)
+
+	meteor := events.RareEventPayload{RareEventID: 7, WorldID: worldID, Kind: "treasure_chest", X: 12, Y: -3, ChunkX: 0, ChunkY: -1}
+	publish(t, bus, events.RareEventSpawned, "rare_event.spawned:7", meteor)
+	meteor.CharacterID = characterID
+	publish(t, bus, events.RareEventCompleted, "rare_event.completed:7", meteor)
+	publish(t, bus, events.RareEventCompleted, "rare_event.completed:7", meteor) // Redelivered
+
+	// Both chunks are in region (-1, 0); only the first discovers it
+	publish(t, bus, events.ChunkGenerated, "chunk.generated:a", events.ChunkGeneratedPayload{WorldID: worldID, ChunkX: -1, ChunkY: 0})
+	publish(t, bus, events.ChunkGenerated, "chunk.generated:b", events.ChunkGeneratedPayload{WorldID: worldID, ChunkX: -8, ChunkY: 7})
+
+	// Sale 3 is the first of its item and the world's 100th
+	database.sales[2] = db.CountMarketSalesUpToRow{Sales: 99, ItemSales: 4}
+	database.sales[3] = db.CountMarketSalesUpToRow{Sales: 100, ItemSales: 1}
+	sale := events.MarketSaleCompletedPayload{SaleID: 2, WorldID: worldID, ItemID: 5, ItemName: "Iron Ore", Quantity: 10, UnitPrice: 3}
+	publish(t, bus, events.MarketSaleCompleted, "market.sale_completed:2", sale)
+	sale.SaleID, sale.ItemID, sale.ItemName = 3, 6, "Gold Ore"
+	publish(t, bus, events.MarketSaleCompleted, "market.sale_completed:3", sale)
+
+	entries, err := service.List(ctx, "", 0, 0)
+	require.NoError(t, err)
+	require.Len(t, entries, 5)
+
+	assert.Equal(t, timelineV1.TimelineEntryType_TIMELINE_ENTRY_TYPE_MARKET_MILESTONE, entries[0].Type)
+	assert.Equal(t, "The market made its 100th sale", entries[0].Summary)
+	assert.Equal(t, timelineV1.TimelineEntryType_TIMELINE_ENTRY_TYPE_MARKET_FIRST_SALE, entries[1].Type)
+	assert.Equal(t, "The first Gold Ore was sold on the market for 3 each", entries[1].Summary)
+	assert.Equal(t, timelineV1.TimelineEntryType_TIMELINE_ENTRY_TYPE_REGION_DISCOVERED, entries[2].Type)
+	assert.Equal(t, "-1", entries[2].Details["region_x"])
+	assert.Equal(t, "0", entries[2].Details["region_y"])
+	assert.Equal(t, timelineV1.TimelineEntryType_TIMELINE_ENTRY_TYPE_RARE_EVENT_COMPLETED, entries[3].Type)
+	assert.Equal(t, "Ada completed the treasure chest at (12, -3)", entries[3].Summary)
+	assert.Equal(t, timelineV1.TimelineEntryType_TIMELINE_ENTRY_TYPE_RARE_EVENT_SPAWNED, entries[4].Type)
+	assert.Equal(t, "A treasure chest appeared at (12, -3)", entries[4].Summary)
+	assert.Equal(t, worldID, entries[4].WorldId)
+}
+
+func TestIsMilestone(t *testing.T) {
+	for sales, want := range map[int64]bool{
+		0: false, 1: false, 10: false, 99: false, 100: true, 200: false,
+		1000: true, 1100: false, 10000: true, 100000: true, 100010: false,
+	} {
+		assert.Equal(t, want, IsMilestone(sales), "sales %d", sales)
+	}
+}
+
+func TestList(t *testing.T) {
+	service, _, bus := newTestService(t)
+	ctx := context.Background()
+	for x := int32(0); x < 5; x++ {
+		publish(t, bus, events.ChunkGenerated, "chunk.generated:"+string('a'+rune(x)), events.ChunkGeneratedPayload{WorldID: worldID, ChunkX: x * RegionSize})
+	}
+	publish(t, bus, events.ChunkGenerated, "chunk.generated:other", events.ChunkGeneratedPayload{WorldID: otherWorldID})
+
+	page, err := service.List(ctx, worldID, 0, 2)
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	assert.Equal(t, []int64{5, 4}, []int64{page[0].Id, page[1].Id})
+
+	page, err = service.List(ctx, worldID, page[1].Id, 10)
+	require.NoError(t, err)
+	require.Len(t, page, 3, "other worlds' entries are not listed")
+	assert.Equal(t, int64(1), page[2].Id)
+
+	_, err = service.List(ctx, "", 0, 0)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "a world is needed without a session world")
+	_, err = service.List(ctx, "not-a-uuid", 0, 0)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = service.List(ctx, worldID, -1, 0)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSubscribeWorldAndPoll(t *testing.T) {
+	service, _, bus := newTestService(t)
+	ctx := context.Background()
+	publish(t, bus, events.ChunkGenerated, "chunk.generated:a", events.ChunkGeneratedPayload{WorldID: worldID, ChunkX: 0})
+	publish(t, bus, events.ChunkGenerated, "chunk.generated:b", events.ChunkGeneratedPayload{WorldID: worldID, ChunkX: RegionSize})
+	require.NoError(t, service.Poll(ctx), "the first poll starts after the stored entries")
+
+	backlog, live, unsubscribe, err := service.SubscribeWorld(ctx, worldID, 1)
+	require.NoError(t, err)
+	defer unsubscribe()
+	require.Len(t, backlog, 1, "entries after after_id are resent")
+	assert.Equal(t, int64(2), backlog[0].Id)
+	assert.Equal(t, 1, service.Streams())
+
+	// Recorded on another instance, picked up by this one's poll
+	publish(t, bus, events.ChunkGenerated, "chunk.generated:other", events.ChunkGeneratedPayload{WorldID: otherWorldID})
+	publish(t, bus, events.ChunkGenerated, "chunk.generated:c", events.ChunkGeneratedPayload{WorldID: worldID, ChunkX: 2 * RegionSize})
+	require.NoError(t, service.Poll(ctx))
+
+	select {
+	case entry := <-live:
+		assert.Equal(t, int64(4), entry.Id, "entries of other worlds are not streamed")
+	default:
+		t.Fatal("expected the new entry on the stream")
+	}
+	select {
+	case entry := <-live:
+		t.Fatalf("unexpected entry %d", entry.Id)
+	default:
+	}
+
+	unsubscribe()
+	assert.Zero(t, service.Streams())
+}
@@ -0,0 +1,353 @@
+// Package contract checks the RPCs the web frontend (/web) calls against the API server,
+// so proto or handler changes that would break the frontend fail here rather than in the
+// browser. The web module builds its own client from the generated protos, so the tests
+// call the API the way web/handlers does: the same requests, the same metadata and a
+// JWT minted the way web/handlers/middleware.go mints it.
+package contract
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/VoidMesh/api/api/db"
+	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
+	userV1 "github.com/VoidMesh/api/api/proto/user/v1"
+	"github.com/VoidMesh/api/api/server/handlers"
+	"github.com/VoidMesh/api/api/server/middleware"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// jwtSecret is the development default both the API and the web server fall back to
+const jwtSecret = "dev-jwt-secret-change-for-production-use-minimum-32-chars"
+
+// webMethods are the RPCs web/handlers calls. Add to it when the frontend calls a new one.
+var webMethods = []string{
+	"/user.v1.UserService/CreateUser",
+	"/user.v1.UserService/Login",
+	"/user.v1.UserService/Logout",
+	"/character.v1.CharacterService/GetMyCharacters",
+	"/character.v1.CharacterService/CreateCharacter",
+}
+
+// webServer serves the user and character services on bufconn behind the JWT
+// interceptors, with in-memory storage
+type webServer struct {
+	server     *grpc.Server
+	users      userV1.UserServiceClient
+	characters characterV1.CharacterServiceClient
+}
+
+func newWebServer(t *testing.T) *webServer {
+	t.Helper()
+	jwtService, err := handlers.NewJWTService(jwtSecret)
+	require.NoError(t, err)
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(
+		grpc.UnaryInterceptor(middleware.JWTAuthInterceptor([]byte(jwtSecret))),
+		grpc.StreamInterceptor(middleware.JWTStreamAuthInterceptor([]byte(jwtSecret))),
+	)
+	userV1.RegisterUserServiceServer(server, handlers.NewUserServer(newMemoryUsers(), jwtService, handlers.NewPasswordService(), handlers.NewTokenGenerator()))
+	characterV1.RegisterCharacterServiceServer(server, handlers.NewCharacterServer(newMemoryCharacters(), nil, nil, nil, nil, nil))
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return &webServer{
+		server:     server,
+		users:      userV1.NewUserServiceClient(conn),
+		characters: characterV1.NewCharacterServiceClient(conn),
+	}
+}
+
+// withAuth adds the authorization metadata like grpc.WithAuth in web/grpc/client.go
+func withAuth(ctx context.Context, token string) context.Context {
+	return metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{
+		"authorization": "Bearer " + token,
+	}))
+}
+
+// webToken mints a token with the claims of generateJWTToken in web/handlers/middleware.go,
+// which the web server signs itself for users it keeps in its session
+func webToken(t *testing.T, userID, username string) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"user_id":  userID,
+		"username": username,
+		"exp":      time.Now().Add(time.Hour * 24 * 7).Unix(),
+		"iat":      time.Now().Unix(),
+		"iss":      "voidmesh-api",
+	}).SignedString([]byte(jwtSecret))
+	require.NoError(t, err)
+	return token
+}
+
+func TestWebMethodsAreServed(t *testing.T) {
+	s := newWebServer(t)
+	served := map[string]bool{}
+	for name, info := range s.server.GetServiceInfo() {
+		for _, method := range info.Methods {
+			served["/"+name+"/"+method.Name] = true
+		}
+	}
+	for _, method := range webMethods {
+		assert.True(t, served[method], "%s is called by the web frontend but not served", method)
+	}
+}
+
+func TestWebSignupLoginAndCharacters(t *testing.T) {
+	s := newWebServer(t)
+	ctx := context.Background()
+
+	// Signup (Auth.Signup) stores the user in the session right away
+	created, err := s.users.CreateUser(ctx, &userV1.CreateUserRequest{
+		Username:    "webuser",
+		DisplayName: "Web User",
+		Email:       "web@example.com",
+		Password:    "correct horse battery",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, created.User)
+	assert.Equal(t, "webuser", created.User.Username)
+	assert.Equal(t, "Web User", created.User.DisplayName)
+	require.NotEmpty(t, created.User.Id)
+
+	// Login (Auth.Login) by username and by email keeps the token and the user
+	for _, name := range []string{"webuser", "web@example.com"} {
+		login, err := s.users.Login(ctx, &userV1.LoginRequest{UsernameOrEmail: name, Password: "correct horse battery"})
+		require.NoError(t, err, name)
+		assert.NotEmpty(t, login.Token)
+		require.NotNil(t, login.User)
+		assert.Equal(t, created.User.Id, login.User.Id, "signup and login must report the same user ID")
+		assert.Equal(t, "webuser", login.User.Username)
+		assert.Equal(t, "Web User", login.User.DisplayName)
+	}
+
+	// The session's user ID goes into the JWT the web server signs (RequireAuth), so the
+	// API must accept the ID in the encoding it returned it
+	token := webToken(t, created.User.Id, created.User.Username)
+	authed := withAuth(ctx, token)
+
+	listed, err := s.characters.GetMyCharacters(authed, &characterV1.GetMyCharactersRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, listed.Characters)
+
+	_, err = s.characters.CreateCharacter(authed, &characterV1.CreateCharacterRequest{Name: "Wanderer"})
+	require.NoError(t, err)
+
+	// The character list (views/game/character_list.templ) shows these fields
+	listed, err = s.characters.GetMyCharacters(authed, &characterV1.GetMyCharactersRequest{})
+	require.NoError(t, err)
+	require.Len(t, listed.Characters, 1)
+	character := listed.Characters[0]
+	assert.NotEmpty(t, character.Id)
+	assert.Equal(t, "Wanderer", character.Name)
+	assert.Equal(t, created.User.Id, character.UserId)
+	assert.Equal(t, int32(0), character.X)
+	assert.Equal(t, int32(0), character.Y)
+	assert.Equal(t, int32(0), character.ChunkX)
+	assert.Equal(t, int32(0), character.ChunkY)
+
+	// Logout (Auth.Logout) sends the session's token
+	logout, err := s.users.Logout(withAuth(ctx, token), &userV1.LogoutRequest{})
+	require.NoError(t, err)
+	assert.True(t, logout.Success)
+}
+
+func TestWebSignupErrors(t *testing.T) {
+	s := newWebServer(t)
+	ctx := context.Background()
+	_, err := s.users.CreateUser(ctx, &userV1.CreateUserRequest{Username: "taken", DisplayName: "Taken", Email: "taken@example.com", Password: "password123"})
+	require.NoError(t, err)
+
+	// Auth.Signup tells the two conflicts apart by the words in the message
+	_, err = s.users.CreateUser(ctx, &userV1.CreateUserRequest{Username: "taken", DisplayName: "Other", Email: "other@example.com", Password: "password123"})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "username")
+
+	_, err = s.users.CreateUser(ctx, &userV1.CreateUserRequest{Username: "other", DisplayName: "Other", Email: "taken@example.com", Password: "password123"})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "email")
+}
+
+func TestWebLoginErrors(t *testing.T) {
+	s := newWebServer(t)
+	ctx := context.Background()
+	_, err := s.users.CreateUser(ctx, &userV1.CreateUserRequest{Username: "locked", DisplayName: "Locked", Email: "locked@example.com", Password: "password123"})
+	require.NoError(t, err)
+
+	// Auth.Login shows "Invalid credentials" for Unauthenticated
+	_, err = s.users.Login(ctx, &userV1.LoginRequest{UsernameOrEmail: "nobody", Password: "password123"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// and "Account is locked" for PermissionDenied, after five wrong passwords
+	for range 5 {
+		_, err = s.users.Login(ctx, &userV1.LoginRequest{UsernameOrEmail: "locked", Password: "wrong"})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	}
+	_, err = s.users.Login(ctx, &userV1.LoginRequest{UsernameOrEmail: "locked", Password: "password123"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestWebRequestsWithoutTokenAreRejected(t *testing.T) {
+	s := newWebServer(t)
+	ctx := context.Background()
+
+	_, err := s.characters.GetMyCharacters(ctx, &characterV1.GetMyCharactersRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = s.users.Logout(ctx, &userV1.LogoutRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+// memoryUsers stores users in memory with the unique constraints of the users table
+type memoryUsers struct {
+	handlers.UserRepository
+	mu    sync.Mutex
+	users []db.User
+	next  byte
+}
+
+func newMemoryUsers() *memoryUsers {
+	return &memoryUsers{}
+}
+
+func (m *memoryUsers) CreateUser(ctx context.Context, params db.CreateUserParams) (db.User, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, user := range m.users {
+		// Postgres reports the violated constraint in the message, which the handler reads
+		if user.Username == params.Username {
+			return db.User{}, fmt.Errorf(`ERROR: duplicate key value violates unique constraint "users_username_key"`)
+		}
+		if user.Email == params.Email {
+			return db.User{}, fmt.Errorf(`ERROR: duplicate key value violates unique constraint "users_email_key"`)
+		}
+	}
+	m.next++
+	user := db.User{
+		ID:           pgtype.UUID{Bytes: [16]byte{0x55, 0x0e, 0x84, 0x00, 15: m.next}, Valid: true},
+		Username:     params.Username,
+		DisplayName:  params.DisplayName,
+		Email:        params.Email,
+		PasswordHash: params.PasswordHash,
+		CreatedAt:    pgtype.Timestamp{Time: time.Now(), Valid: true},
+	}
+	m.users = append(m.users, user)
+	return user, nil
+}
+
+func (m *memoryUsers) find(match func(db.User) bool) (db.User, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, user := range m.users {
+		if match(user) {
+			return user, nil
+		}
+	}
+	return db.User{}, pgx.ErrNoRows
+}
+
+func (m *memoryUsers) update(id pgtype.UUID, apply func(*db.User)) (db.User, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i := range m.users {
+		if m.users[i].ID == id {
+			apply(&m.users[i])
+			return m.users[i], nil
+		}
+	}
+	return db.User{}, pgx.ErrNoRows
+}
+
+func (m *memoryUsers) GetUserByEmail(ctx context.Context, email string) (db.User, error) {
+	return m.find(func(user db.User) bool { return user.Email == email })
+}
+
+func (m *memoryUsers) GetUserByUsername(ctx context.Context, username string) (db.User, error) {
+	return m.find(func(user db.User) bool { return user.Username == username })
+}
+
+func (m *memoryUsers) UpdateLoginAttempts(ctx context.Context, params db.UpdateLoginAttemptsParams) (db.User, error) {
+	return m.update(params.ID, func(user *db.User) {
+		user.FailedLoginAttempts = params.FailedLoginAttempts
+		user.AccountLocked = params.AccountLocked
+	})
+}
+
+func (m *memoryUsers) UpdateLastLoginAt(ctx context.Context, params db.UpdateLastLoginAtParams) (db.User, error) {
+	return m.update(params.ID, func(user *db.User) { user.LastLoginAt = params.LastLoginAt })
+}
+
+// memoryCharacters stores characters in memory. User IDs are parsed the way the
+// character service parses them, so an ID the API cannot read fails here too.
+type memoryCharacters struct {
+	handlers.CharacterService
+	mu         sync.Mutex
+	characters []*characterV1.Character
+}
+
+func newMemoryCharacters() *memoryCharacters {
+	return &memoryCharacters{}
+}
+
+func parseUserID(userID string) (string, error) {
+	var id pgtype.UUID
+	if err := id.Scan(userID); err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+	return strings.ReplaceAll(id.String(), "-", ""), nil
+}
+
+func (m *memoryCharacters) CreateCharacter(ctx context.Context, userID string, req *characterV1.CreateCharacterRequest) (*characterV1.CreateCharacterResponse, error) {
+	owner, err := parseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	character := &characterV1.Character{
+		Id:     fmt.Sprintf("750e84000000000000000000000000%02x", len(m.characters)+1),
+		UserId: owner,
+		Name:   req.Name,
+		X:      req.SpawnX,
+		Y:      req.SpawnY,
+	}
+	m.characters = append(m.characters, character)
+	return &characterV1.CreateCharacterResponse{Character: character}, nil
+}
+
+func (m *memoryCharacters) GetUserCharacters(ctx context.Context, userID string) (*characterV1.GetMyCharactersResponse, error) {
+	owner, err := parseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	resp := &characterV1.GetMyCharactersResponse{}
+	for _, character := range m.characters {
+		if character.UserId == owner {
+			resp.Characters = append(resp.Characters, character)
+		}
+	}
+	return resp, nil
+}
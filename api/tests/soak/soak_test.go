@@ -0,0 +1,108 @@
+// Package soak holds the long-running leak tests. They are skipped unless SOAK_DURATION
+// is set; run them with
+//
+//	SOAK_DURATION=2h SOAK_PROFILE_DIR=/tmp/soak go test ./tests/soak -run Soak -timeout 0 -v
+//
+// and compare the heap profiles with go tool pprof -base heap-warm.pprof heap-end.pprof.
+package soak
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/VoidMesh/api/api/internal/debugstats"
+	"github.com/VoidMesh/api/api/internal/interest"
+	"github.com/VoidMesh/api/api/internal/soak"
+	"github.com/VoidMesh/api/api/internal/userstream"
+	"github.com/VoidMesh/api/api/server/middleware"
+	"github.com/stretchr/testify/require"
+)
+
+// soakConfig returns the run's config, skipping the test when no soak run was asked for
+func soakConfig(t *testing.T) soak.Config {
+	t.Helper()
+	config, enabled, err := soak.ConfigFromEnv()
+	require.NoError(t, err)
+	if !enabled {
+		t.Skip("Set SOAK_DURATION to run soak tests")
+	}
+	return config
+}
+
+// requireNoLeaks fails the test for every metric that grew through the run
+func requireNoLeaks(t *testing.T, report *soak.Report) {
+	t.Helper()
+	t.Logf("%d operations, %d errors, %d samples", report.Operations, report.Errors, len(report.Samples))
+	if report.FirstError != nil {
+		t.Errorf("load failed %d times, first: %v", report.Errors, report.FirstError)
+	}
+	for _, leak := range report.Leaks {
+		t.Errorf("leak: %s", leak)
+	}
+}
+
+// Streams open, receive events while their owner moves about, and close again, the way
+// notification and nearby-character streams churn as players connect and disconnect.
+// Every stream's reader goroutine must exit when it is unsubscribed.
+func TestSoak_StreamSubscriptions(t *testing.T) {
+	config := soakConfig(t)
+
+	hub := userstream.NewHub[string]()
+	nearby := interest.NewManager[string](16)
+	debugstats.Register(debugstats.StreamSubscriptions, "soak.user_streams", func() int64 { return int64(hub.Len()) })
+	debugstats.Register(debugstats.StreamSubscriptions, "soak.interest_streams", func() int64 { return int64(nearby.Len()) })
+
+	report, err := soak.Run(context.Background(), config, func(ctx context.Context, worker int) error {
+		userID := fmt.Sprintf("00000000-0000-0000-0000-%012d", worker)
+		anchor := fmt.Sprintf("character-%d", worker)
+		notifications := hub.Subscribe(userID, 8)
+		area := nearby.Subscribe("world", anchor, interest.Point{X: int32(worker) * 64}, 8, 8)
+
+		done := make(chan struct{}, 2)
+		go func() {
+			for range notifications.C {
+			}
+			done <- struct{}{}
+		}()
+		go func() {
+			for range area.C {
+			}
+			done <- struct{}{}
+		}()
+
+		for step := int32(0); step < 32; step++ {
+			at := interest.Point{X: int32(worker)*64 + step, Y: step}
+			nearby.Move(anchor, at)
+			nearby.Publish("world", at, "moved")
+			hub.Publish(userID, "notification")
+		}
+		hub.Broadcast("announcement")
+
+		hub.Unsubscribe(notifications)
+		nearby.Unsubscribe(area)
+		<-done
+		<-done
+		return nil
+	})
+	require.NoError(t, err)
+	requireNoLeaks(t, report)
+}
+
+// The circuit breaker's response cache sees a new key for every distinct read; it must
+// stay bounded however many keys pass through it
+func TestSoak_ResponseCache(t *testing.T) {
+	config := soakConfig(t)
+
+	cache := middleware.NewResponseCache(middleware.DefaultResponseCacheSize)
+	var requests atomic.Int64
+	report, err := soak.Run(context.Background(), config, func(ctx context.Context, worker int) error {
+		key := fmt.Sprintf("/chunk.v1.ChunkService/GetChunk:%d", requests.Add(1))
+		cache.Put(key, key)
+		cache.Get(key)
+		return nil
+	})
+	require.NoError(t, err)
+	requireNoLeaks(t, report)
+}
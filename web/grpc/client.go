@@ -5,9 +5,9 @@ import (
 	"os"
 
 	characterV1 "github.com/VoidMesh/api/api/proto/character/v1"
-	chunkV1 "github.com/VoidMesh/api/api/proto/chunk/v1"
+	chunkV2 "github.com/VoidMesh/api/api/proto/chunk/v2"
 	userV1 "github.com/VoidMesh/api/api/proto/user/v1"
-	worldV1 "github.com/VoidMesh/api/api/proto/world/v1"
+	worldV2 "github.com/VoidMesh/api/api/proto/world/v2"
 	"github.com/charmbracelet/log"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -21,8 +21,8 @@ const (
 type Client struct {
 	UserService      userV1.UserServiceClient
 	CharacterService characterV1.CharacterServiceClient
-	WorldService     worldV1.WorldServiceClient
-	ChunkService     chunkV1.ChunkServiceClient
+	WorldService     worldV2.WorldServiceClient
+	ChunkService     chunkV2.ChunkServiceClient
 	conn             *grpc.ClientConn
 }
 
@@ -35,9 +35,9 @@ func NewClient() *Client {
 
 	client := &Client{
 		CharacterService: characterV1.NewCharacterServiceClient(conn),
-		ChunkService:     chunkV1.NewChunkServiceClient(conn),
+		ChunkService:     chunkV2.NewChunkServiceClient(conn),
 		UserService:      userV1.NewUserServiceClient(conn),
-		WorldService:     worldV1.NewWorldServiceClient(conn),
+		WorldService:     worldV2.NewWorldServiceClient(conn),
 		conn:             conn,
 	}
 
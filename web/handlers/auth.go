@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/VoidMesh/api/web/grpc"
 	"github.com/VoidMesh/api/web/routes"
 	"github.com/VoidMesh/api/web/views/pages/auth"
 
@@ -191,6 +192,9 @@ func (a *Auth) Logout(c *fiber.Ctx) error {
 	// Call the gRPC API
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
+	if token, ok := sess.Get("token").(string); ok {
+		ctx = grpc.WithAuth(ctx, token)
+	}
 
 	_, err = a.App.API.UserService.Logout(ctx, &userV1.LogoutRequest{})
 	if err != nil {
@@ -15,12 +15,8 @@ func (h *Game) ListCharacters(c *fiber.Ctx) error {
 	jwtToken := c.Locals("jwt_token").(string)
 	ctx := grpc.WithAuth(c.Context(), jwtToken)
 
-	// Get user ID from JWT token
-	userID := c.Locals("user_id").(string)
-
-	// Get characters for this user
-	req := &characterV1.GetCharactersByUserRequest{UserId: userID}
-	resp, err := h.API.CharacterService.GetCharactersByUser(ctx, req)
+	// Get characters for the user the JWT token belongs to
+	resp, err := h.API.CharacterService.GetMyCharacters(ctx, &characterV1.GetMyCharactersRequest{})
 	if err != nil {
 		return err
 	}
@@ -39,12 +35,8 @@ func (h *Game) CreateCharacter(c *fiber.Ctx) error {
 	jwtToken := c.Locals("jwt_token").(string)
 	ctx := grpc.WithAuth(c.Context(), jwtToken)
 
-	// Get user ID from JWT token
-	userID := c.Locals("user_id").(string)
-
-	// Create character
+	// Create character for the user the JWT token belongs to
 	req := &characterV1.CreateCharacterRequest{
-		UserId: userID,
 		Name:   name,
 		SpawnX: 0, // Default spawn at origin
 		SpawnY: 0,